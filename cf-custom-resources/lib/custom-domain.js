@@ -197,15 +197,15 @@ exports.handler = async function (event, context) {
   const [app, env, domain] = [props.AppName, props.EnvName, props.DomainName];
   var aliasTypes = {
     EnvDomainZone: {
-      regex: new RegExp(`^([^\.]+\.)?${env}.${app}.${domain}`),
+      regex: new RegExp(`^(\\*\\.)?([^\.]+\.)?${env}.${app}.${domain}`),
       domain: `${env}.${app}.${domain}`,
     },
     AppDomainZone: {
-      regex: new RegExp(`^([^\.]+\.)?${app}.${domain}`),
+      regex: new RegExp(`^(\\*\\.)?([^\.]+\.)?${app}.${domain}`),
       domain: `${app}.${domain}`,
     },
     RootDomainZone: {
-      regex: new RegExp(`^([^\.]+\.)?${domain}`),
+      regex: new RegExp(`^(\\*\\.)?([^\.]+\.)?${domain}`),
       domain: `${domain}`,
     },
     OtherDomainZone: { regex: new RegExp(`.*`) },
@@ -587,15 +587,15 @@ exports.certificateRequestHandler = async function (event, context) {
   const [app, env, domain] = [props.AppName, props.EnvName, props.DomainName, ];
   domainTypes = {
     EnvDomainZone: {
-      regex: new RegExp(`^([^\.]+\.)?${env}.${app}.${domain}`),
+      regex: new RegExp(`^(\\*\\.)?([^\.]+\.)?${env}.${app}.${domain}`),
       domain: `${env}.${app}.${domain}`,
     },
     AppDomainZone: {
-      regex: new RegExp(`^([^\.]+\.)?${app}.${domain}`),
+      regex: new RegExp(`^(\\*\\.)?([^\.]+\.)?${app}.${domain}`),
       domain: `${app}.${domain}`,
     },
     RootDomainZone: {
-      regex: new RegExp(`^([^\.]+\.)?${domain}`),
+      regex: new RegExp(`^(\\*\\.)?([^\.]+\.)?${domain}`),
       domain: `${domain}`,
     },
     OtherDomainZone: {},
@@ -610,7 +610,7 @@ exports.certificateRequestHandler = async function (event, context) {
   const sansToUse = [...uniqueSansToUse, ];
   const [acm, envRoute53, appRoute53] = clients(props.Region, props.RootDNSRole);
 
-  try {
+  const handle = async function () {
     let response = {};
     let options = {};
     switch (event.RequestType) {
@@ -662,6 +662,10 @@ exports.certificateRequestHandler = async function (event, context) {
       default:
         throw new Error(`Unsupported request type ${event.RequestType}`);
     }
+  };
+
+  try {
+    await Promise.race([exports.deadlineExpired(context), handle()]);
     await report(event, context, "SUCCESS", physicalResourceId, responseData);
   } catch (err) {
     console.log(`Caught error ${err}.`);
@@ -678,6 +682,25 @@ exports.certificateRequestHandler = async function (event, context) {
   }
 };
 
+/**
+ * Rejects 30 seconds before the Lambda function times out so that a failure is reported to CloudFormation
+ * instead of leaving the stack waiting for a response until the custom resource times out.
+ */
+exports.deadlineExpired = function (context) {
+  const remaining = context.getRemainingTimeInMillis() - 30 * 1000;
+  return new Promise(function (resolve, reject) {
+    setTimeout(
+      reject,
+      Math.max(remaining, 0),
+      new Error(
+        `Lambda took longer than ${Math.round(
+          remaining / 1000
+        )} seconds to validate the certificate`
+      )
+    );
+  });
+};
+
 /**
  * @private
  */
@@ -270,21 +270,27 @@ exports.domainDelegationHandler = async function (event, context) {
     switch (event.RequestType) {
       case "Create":
       case "Update":
-        await createSubdomainInRoot(
-          event.RequestId,
-          props.DomainName,
-          props.SubdomainName,
-          props.NameServers,
-          props.RootDNSRole
-        );
+        await Promise.race([
+          exports.deadlineExpired(context),
+          createSubdomainInRoot(
+            event.RequestId,
+            props.DomainName,
+            props.SubdomainName,
+            props.NameServers,
+            props.RootDNSRole
+          ),
+        ]);
         break;
       case "Delete":
-        await deleteSubdomainInRoot(
-          event.RequestId,
-          props.DomainName,
-          props.SubdomainName,
-          props.RootDNSRole
-        );
+        await Promise.race([
+          exports.deadlineExpired(context),
+          deleteSubdomainInRoot(
+            event.RequestId,
+            props.DomainName,
+            props.SubdomainName,
+            props.RootDNSRole
+          ),
+        ]);
         break;
       default:
         throw new Error(`Unsupported request type ${event.RequestType}`);
@@ -307,6 +313,25 @@ exports.domainDelegationHandler = async function (event, context) {
   }
 };
 
+/**
+ * Rejects 30 seconds before the Lambda function times out so that a failure is reported to CloudFormation
+ * instead of leaving the stack waiting for a response until the custom resource times out.
+ */
+exports.deadlineExpired = function (context) {
+  const remaining = context.getRemainingTimeInMillis() - 30 * 1000;
+  return new Promise(function (resolve, reject) {
+    setTimeout(
+      reject,
+      Math.max(remaining, 0),
+      new Error(
+        `Lambda took longer than ${Math.round(
+          remaining / 1000
+        )} seconds to delegate the subdomain`
+      )
+    );
+  });
+};
+
 /**
  * @private
  */
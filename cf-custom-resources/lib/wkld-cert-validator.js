@@ -178,15 +178,15 @@ exports.handler = async function (event, context) {
   certificateDomain = isCloudFrontCert ? `${serviceName}.${envName}.${appName}.${domainName}` : `${serviceName}-nlb.${envName}.${appName}.${domainName}`;
   domainTypes = {
     EnvDomainZone: {
-      regex: new RegExp(`^([^\.]+\.)?${envName}.${appName}.${domainName}`),
+      regex: new RegExp(`^(\\*\\.)?([^\.]+\.)?${envName}.${appName}.${domainName}`),
       domain: `${envName}.${appName}.${domainName}`,
     },
     AppDomainZone: {
-      regex: new RegExp(`^([^\.]+\.)?${appName}.${domainName}`),
+      regex: new RegExp(`^(\\*\\.)?([^\.]+\.)?${appName}.${domainName}`),
       domain: `${appName}.${domainName}`,
     },
     RootDomainZone: {
-      regex: new RegExp(`^([^\.]+\.)?${domainName}`),
+      regex: new RegExp(`^(\\*\\.)?([^\.]+\.)?${domainName}`),
       domain: `${domainName}`,
     },
   };
@@ -722,7 +722,8 @@ function targetRecordExists(targetDomainName, recordSet) {
   if (!recordSet || recordSet.length === 0) {
     return false;
   }
-  return recordSet[0].Name === `${targetDomainName}.`;
+  // Route 53 returns the "*" label of wildcard records as the octal code "\052".
+  return recordSet[0].Name.replace(/^\\052\./, "*.") === `${targetDomainName}.`;
 }
 
 async function hostedZoneIDByName(domain) {
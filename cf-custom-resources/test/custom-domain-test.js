@@ -242,6 +242,75 @@ describe("DNS Validated Certificate Handler", () => {
       });
   });
 
+  test("Create success with wildcard aliases", () => {
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: {
+        Id: "bogus",
+      },
+    });
+
+    const listHostedZonesByNameFake = sinon.fake.resolves({
+      HostedZones: [
+        {
+          Id: `/hostedzone/${testHostedZoneId}`,
+        },
+      ],
+    });
+
+    AWS.mock(
+      "Route53",
+      "changeResourceRecordSets",
+      changeResourceRecordSetsFake
+    );
+    AWS.mock("Route53", "listHostedZonesByName", listHostedZonesByNameFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    return LambdaTester(handler.handler)
+      .event({
+        RequestType: "Create",
+        ResourceProperties: {
+          AppName: testAppName,
+          EnvName: testEnvName,
+          DomainName: testDomainName,
+          Aliases: `{"frontend": ["*.${testEnvName}.${testAppName}.${testDomainName}", "*.${testDomainName}"]}`,
+          Region: "us-east-1",
+          PublicAccessDNS: testAccessDNS,
+          PublicAccessHostedZone: testLBHostedZone,
+          AppDNSRole: testRootDNSRole,
+        },
+      })
+      .expectResolve(() => {
+        // The env hosted zone is cached by the previous test, only the root hosted zone is looked up.
+        sinon.assert.calledOnce(listHostedZonesByNameFake);
+        sinon.assert.calledWith(
+          listHostedZonesByNameFake,
+          sinon.match({
+            DNSName: testDomainName,
+            MaxItems: "1",
+          })
+        );
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match.hasNested(
+            "ChangeBatch.Changes[0].ResourceRecordSet.Name",
+            `*.${testEnvName}.${testAppName}.${testDomainName}`
+          )
+        );
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match.hasNested(
+            "ChangeBatch.Changes[0].ResourceRecordSet.Name",
+            `*.${testDomainName}`
+          )
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
   test("Update success", () => {
     const changeResourceRecordSetsFake = sinon.fake.resolves({
       ChangeInfo: {
@@ -237,6 +237,9 @@ describe("DNS Validated Certificate Handler", () => {
       };
     });
     handler.withSleep(spySleep);
+    handler.deadlineExpired = function () {
+      return new Promise(function (resolve, reject) {});
+    };
     console.log = function () {};
   });
   afterEach(() => {
@@ -275,6 +278,42 @@ describe("DNS Validated Certificate Handler", () => {
       });
   });
 
+  test("Fails before the Lambda function times out", () => {
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            "Lambda took longer than 870 seconds to validate the certificate (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)"
+        );
+      })
+      .reply(200);
+    handler.deadlineExpired = function () {
+      return Promise.reject(
+        new Error("Lambda took longer than 870 seconds to validate the certificate")
+      );
+    };
+    const requestCertificateFake = sinon.fake.returns(new Promise(() => {}));
+    AWS.mock("ACM", "requestCertificate", requestCertificateFake);
+    return LambdaTester(handler.certificateRequestHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: {
+          AppName: testAppName,
+          EnvName: testEnvName,
+          DomainName: testDomainName,
+          EnvHostedZoneId: testHostedZoneId,
+          Aliases: testAliases,
+          Region: "us-east-1",
+          RootDNSRole: testRootDNSRole,
+        },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
   test("Bogus operation fails", () => {
     const bogusType = "bogus";
     const request = nock(ResponseURL)
@@ -537,6 +576,120 @@ describe("DNS Validated Certificate Handler", () => {
       });
   });
 
+  test("Create operation validates wildcard and apex aliases in the hosted zone of their parent domain", () => {
+    const testRootHostedZoneId = "P5QSUBK4POTIZ3";
+    const validationOption = (domainName, recordName) => ({
+      DomainName: domainName,
+      ResourceRecord: {
+        Name: recordName,
+        Type: "CNAME",
+        Value: testRRValue1,
+      },
+    });
+    const requestCertificateFake = sinon.fake.resolves({
+      CertificateArn: testCertificateArn,
+    });
+
+    const describeCertificateFake = sinon.fake.resolves({
+      Certificate: {
+        CertificateArn: testCertificateArn,
+        DomainValidationOptions: [
+          validationOption(`${testEnvName}.${testAppName}.${testDomainName}`, "_env.test.myapp.example.com"),
+          validationOption(`*.${testEnvName}.${testAppName}.${testDomainName}`, "_env.test.myapp.example.com"),
+          validationOption(`*.api.${testAppName}.${testDomainName}`, "_wildcard.api.myapp.example.com"),
+          validationOption(testDomainName, "_apex.example.com"),
+        ],
+      },
+    });
+
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: {
+        Id: "bogus",
+      },
+    });
+
+    const listHostedZonesByNameFake = sinon.stub();
+    listHostedZonesByNameFake.withArgs(sinon.match.has("DNSName", `${testAppName}.${testDomainName}`)).resolves({
+      HostedZones: [
+        {
+          Id: `/hostedzone/${testAppHostedZoneId}`,
+        },
+      ],
+    });
+    listHostedZonesByNameFake.withArgs(sinon.match.has("DNSName", testDomainName)).resolves({
+      HostedZones: [
+        {
+          Id: `/hostedzone/${testRootHostedZoneId}`,
+        },
+      ],
+    });
+
+    AWS.mock("ACM", "requestCertificate", requestCertificateFake);
+    AWS.mock("ACM", "describeCertificate", describeCertificateFake);
+    AWS.mock(
+      "Route53",
+      "changeResourceRecordSets",
+      changeResourceRecordSetsFake
+    );
+    AWS.mock("Route53", "listHostedZonesByName", listHostedZonesByNameFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+
+    return LambdaTester(handler.certificateRequestHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: {
+          AppName: testAppName,
+          EnvName: testEnvName,
+          DomainName: testDomainName,
+          EnvHostedZoneId: testHostedZoneId,
+          Aliases: `{"frontend": ["*.api.${testAppName}.${testDomainName}", "${testDomainName}"]}`,
+          Region: "us-east-1",
+          RootDNSRole: testRootDNSRole,
+        },
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          requestCertificateFake,
+          sinon.match({
+            DomainName: `${testEnvName}.${testAppName}.${testDomainName}`,
+            SubjectAlternativeNames: [
+              `${testEnvName}.${testAppName}.${testDomainName}`,
+              `*.${testEnvName}.${testAppName}.${testDomainName}`,
+              `*.api.${testAppName}.${testDomainName}`,
+              testDomainName,
+            ],
+            ValidationMethod: "DNS",
+            Tags: testCopilotTags,
+          })
+        );
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match({ HostedZoneId: testHostedZoneId }).and(
+            sinon.match.hasNested("ChangeBatch.Changes[0].ResourceRecordSet.Name", "_env.test.myapp.example.com")
+          )
+        );
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match({ HostedZoneId: testAppHostedZoneId }).and(
+            sinon.match.hasNested("ChangeBatch.Changes[0].ResourceRecordSet.Name", "_wildcard.api.myapp.example.com")
+          )
+        );
+        sinon.assert.calledWith(
+          changeResourceRecordSetsFake,
+          sinon.match({ HostedZoneId: testRootHostedZoneId }).and(
+            sinon.match.hasNested("ChangeBatch.Changes[0].ResourceRecordSet.Name", "_apex.example.com")
+          )
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
   test("Create operation fails after more than 60s if certificate has no DomainValidationOptions", () => {
     handler.withRandom(() => 0);
     const requestCertificateFake = sinon.fake.resolves({
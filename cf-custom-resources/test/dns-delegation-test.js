@@ -24,6 +24,9 @@ describe("DNS Delegation Handler", () => {
     dnsDelegationHandler.withDefaultResponseURL(ResponseURL);
     dnsDelegationHandler.withDefaultLogGroup(LogGroup);
     dnsDelegationHandler.withDefaultLogStream(LogStream);
+    dnsDelegationHandler.deadlineExpired = function () {
+      return new Promise(function (resolve, reject) {});
+    };
     console.log = function () {};
   });
   afterEach(() => {
@@ -56,6 +59,39 @@ describe("DNS Delegation Handler", () => {
       });
   });
 
+  test("Fails before the Lambda function times out", () => {
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            "Lambda took longer than 570 seconds to delegate the subdomain (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)"
+        );
+      })
+      .reply(200);
+    dnsDelegationHandler.deadlineExpired = function () {
+      return Promise.reject(
+        new Error("Lambda took longer than 570 seconds to delegate the subdomain")
+      );
+    };
+    const listHostedZonesByNameFake = sinon.fake.returns(new Promise(() => {}));
+    AWS.mock("Route53", "listHostedZonesByName", listHostedZonesByNameFake);
+    return LambdaTester(dnsDelegationHandler.domainDelegationHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: {
+          DomainName: testDomainName,
+          SubdomainName: testSubDomainName,
+          NameServers: testNameServers,
+          RootDNSRole: testIAMRole,
+        },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
   test("Bogus operation fails", () => {
     const bogusType = "bogus";
     const request = nock(ResponseURL)
@@ -474,6 +474,108 @@ describe("DNS Certificate Validation And Custom Domains", () => {
           sinon.assert.callCount(mockWaitForCertificateValidation, 1);
         });
     });
+
+    test("wildcard aliases are requested and validated in the hosted zone of their parent domain", () => {
+      const mockDescribeCertificate = sinon.fake.resolves({
+        Certificate: {
+          DomainValidationOptions: [
+            {
+              ResourceRecord: {
+                Name: "mock-validate-default-cert",
+                Value: "mock-validate-default-cert-value",
+                Type: "mock-validate-default-cert-type",
+              },
+              DomainName: `${mockServiceName}-nlb.${mockEnvName}.${mockAppName}.${mockDomainName}`,
+            },
+            {
+              ResourceRecord: {
+                Name: "mock-validate-alias-1",
+                Value: "mock-validate-alias-1-value",
+                Type: "mock-validate-alias-1-type",
+              },
+              DomainName: "*.mockDomain.com",
+            },
+            {
+              ResourceRecord: {
+                Name: "mock-validate-alias-2",
+                Value: "mock-validate-alias-2-value",
+                Type: "mock-validate-alias-2-type",
+              },
+              DomainName: "*.mockEnv.mockApp.mockDomain.com",
+            },
+          ],
+        },
+      });
+      AWS.mock("Route53", "listHostedZonesByName", mockListHostedZonesByName);
+      AWS.mock("Route53", "listResourceRecordSets", mockListResourceRecordSets);
+      AWS.mock("ACM", "requestCertificate", mockRequestCertificate);
+      AWS.mock("ACM", "describeCertificate", mockDescribeCertificate);
+      AWS.mock("Route53", "changeResourceRecordSets", mockChangeResourceRecordSets);
+      AWS.mock("Route53", "waitFor", mockWaitForRecordsChange);
+      AWS.mock("ACM", "waitFor", mockWaitForCertificateValidation);
+
+      let request = nock(mockResponseURL)
+        .put("/", (body) => {
+          return body.Status === "SUCCESS" && body.PhysicalResourceId === "mockCertArn";
+        })
+        .reply(200);
+
+      return LambdaTester(handler)
+        .event({
+          ...mockRequest,
+          ResourceProperties: {
+            ...mockRequest.ResourceProperties,
+            Aliases: ["*.mockDomain.com", "*.mockEnv.mockApp.mockDomain.com"],
+          },
+        })
+        .expectResolve(() => {
+          expect(request.isDone()).toBe(true);
+          sinon.assert.calledWithMatch(mockRequestCertificate, {
+            SubjectAlternativeNames: ["*.mockDomain.com", "*.mockEnv.mockApp.mockDomain.com"],
+          });
+          sinon.assert.callCount(mockChangeResourceRecordSets, 3); // 1 call for each validation option.
+          sinon.assert.calledWithMatch(mockChangeResourceRecordSets, {
+            ChangeBatch: { Comment: "Validate the certificate for the alias *.mockDomain.com" },
+            HostedZoneId: mockRootHostedZoneID,
+          });
+          sinon.assert.calledWithMatch(mockChangeResourceRecordSets, {
+            ChangeBatch: { Comment: "Validate the certificate for the alias *.mockEnv.mockApp.mockDomain.com" },
+            HostedZoneId: mockEnvHostedZoneID,
+          });
+        });
+    });
+
+    test("wildcard alias returned by Route 53 with an escaped label is in use by other service", () => {
+      const mockListResourceRecordSets = sinon.fake.resolves({
+        ResourceRecordSets: [
+          {
+            AliasTarget: {
+              DNSName: "other-lb-DNS",
+            },
+            Name: "\\052.mockDomain.com.",
+            Type: "A",
+          },
+        ],
+      });
+      AWS.mock("Route53", "listHostedZonesByName", mockListHostedZonesByName);
+      AWS.mock("Route53", "listResourceRecordSets", mockListResourceRecordSets);
+
+      let request = mockFailedRequest(
+        /^Alias \*.mockDomain.com is already in use by other-lb-DNS. This could be another load balancer of a different service. \(Log: .*\)$/
+      );
+      return LambdaTester(handler)
+        .event({
+          ...mockRequest,
+          ResourceProperties: {
+            ...mockRequest.ResourceProperties,
+            Aliases: ["*.mockDomain.com"],
+          },
+        })
+        .expectResolve(() => {
+          expect(request.isDone()).toBe(true);
+          sinon.assert.callCount(mockListResourceRecordSets, 1);
+        });
+    });
   });
 
   describe("During DELETE", () => {
@@ -316,6 +316,114 @@ describe("DNS Certificate Validation And Custom Domains for NLB", () => {
           sinon.assert.alwaysCalledWithMatch(mockChangeResourceRecordSets, sinon.match.hasNested("ChangeBatch.Changes[0].Action", "UPSERT"));
         });
     });
+
+    test("wildcard aliases are upserted into the hosted zone of their parent domain", () => {
+      AWS.mock("Route53", "listHostedZonesByName", mockListHostedZonesByName);
+      AWS.mock("Route53", "listResourceRecordSets", mockListResourceRecordSets);
+      AWS.mock("Route53", "changeResourceRecordSets", mockChangeResourceRecordSets);
+      AWS.mock("Route53", "waitFor", mockWaitForRecordsChange);
+
+      let request = nock(mockResponseURL)
+        .put("/", (body) => {
+          return body.Status === "SUCCESS" && body.PhysicalResourceId === "mockID";
+        })
+        .reply(200);
+
+      return LambdaTester(handler)
+        .event({
+          ...mockRequest,
+          ResourceProperties: {
+            ...mockRequest.ResourceProperties,
+            Aliases: ["*.mockDomain.com", "*.mockApp.mockDomain.com", "*.mockEnv.mockApp.mockDomain.com"],
+          },
+        })
+        .expectResolve(() => {
+          expect(request.isDone()).toBe(true);
+          sinon.assert.callCount(mockListHostedZonesByName, 2); // 1 call for each alias that is not env-level; there are 2 such aliases.
+          sinon.assert.callCount(mockChangeResourceRecordSets, 3); // 1 call for each alias; 3 aliases in total.
+          sinon.assert.calledWithMatch(
+            mockChangeResourceRecordSets,
+            sinon.match({ HostedZoneId: mockRootHostedZoneID }).and(sinon.match.hasNested("ChangeBatch.Changes[0].ResourceRecordSet.Name", "*.mockDomain.com"))
+          );
+          sinon.assert.calledWithMatch(
+            mockChangeResourceRecordSets,
+            sinon.match({ HostedZoneId: mockAppHostedZoneID }).and(sinon.match.hasNested("ChangeBatch.Changes[0].ResourceRecordSet.Name", "*.mockApp.mockDomain.com"))
+          );
+          sinon.assert.calledWithMatch(
+            mockChangeResourceRecordSets,
+            sinon.match({ HostedZoneId: mockEnvHostedZoneID }).and(sinon.match.hasNested("ChangeBatch.Changes[0].ResourceRecordSet.Name", "*.mockEnv.mockApp.mockDomain.com"))
+          );
+        });
+    });
+
+    test("wildcard alias returned by Route 53 with an escaped label is in use by other service", () => {
+      const mockListResourceRecordSets = sinon.fake.resolves({
+        ResourceRecordSets: [
+          {
+            AliasTarget: {
+              DNSName: "other-lb-DNS",
+            },
+            Name: "\\052.mockEnv.mockApp.mockDomain.com.",
+            Type: "A",
+          },
+        ],
+      });
+      AWS.mock("Route53", "listHostedZonesByName", mockListHostedZonesByName);
+      AWS.mock("Route53", "listResourceRecordSets", mockListResourceRecordSets);
+
+      let request = mockFailedRequest(
+        /^Alias \*.mockEnv.mockApp.mockDomain.com is already in use by other-lb-DNS. This could be another load balancer of a different service. \(Log: .*\)$/
+      );
+      return LambdaTester(handler)
+        .event({
+          ...mockRequest,
+          ResourceProperties: {
+            ...mockRequest.ResourceProperties,
+            Aliases: ["*.mockEnv.mockApp.mockDomain.com"],
+          },
+        })
+        .expectResolve(() => {
+          expect(request.isDone()).toBe(true);
+          sinon.assert.callCount(mockListResourceRecordSets, 1);
+        });
+    });
+
+    test("wildcard alias returned by Route 53 with an escaped label is valid if it's in use by the service itself", () => {
+      const mockListResourceRecordSets = sinon.fake.resolves({
+        ResourceRecordSets: [
+          {
+            AliasTarget: {
+              DNSName: `${mockLBDNS}.`,
+            },
+            Name: "\\052.mockEnv.mockApp.mockDomain.com.",
+            Type: "A",
+          },
+        ],
+      });
+      AWS.mock("Route53", "listHostedZonesByName", mockListHostedZonesByName);
+      AWS.mock("Route53", "listResourceRecordSets", mockListResourceRecordSets);
+      AWS.mock("Route53", "changeResourceRecordSets", mockChangeResourceRecordSets);
+      AWS.mock("Route53", "waitFor", mockWaitForRecordsChange);
+
+      let request = nock(mockResponseURL)
+        .put("/", (body) => {
+          return body.Status === "SUCCESS" && body.PhysicalResourceId === "mockID";
+        })
+        .reply(200);
+
+      return LambdaTester(handler)
+        .event({
+          ...mockRequest,
+          ResourceProperties: {
+            ...mockRequest.ResourceProperties,
+            Aliases: ["*.mockEnv.mockApp.mockDomain.com"],
+          },
+        })
+        .expectResolve(() => {
+          expect(request.isDone()).toBe(true);
+          sinon.assert.callCount(mockChangeResourceRecordSets, 1);
+        });
+    });
   });
 
   describe("During DELETE", () => {
@@ -30,33 +30,74 @@ func init() {
 }
 
 func main() {
+	if exitCode, ran := cli.RunPinnedVersion(os.Args[1:]); ran {
+		os.Exit(exitCode)
+	}
+	notifier := cli.StartUpgradeCheck()
 	cmd := buildRootCmd()
-	if err := cmd.Execute(); err != nil {
+	executed, err := cmd.ExecuteC()
+	exitCode := handleResult(executed, err)
+	// Only notify of the fixes of a command that ran successfully, since the notification would hide its error.
+	if err == nil && jsonOut == nil && executed != nil && executed.Runnable() {
+		notifier.Notify(executed.CommandPath())
+	}
+	os.Exit(exitCode)
+}
+
+// handleResult reports the result of the executed command, and returns the exit code of the process.
+func handleResult(executed *cobra.Command, err error) int {
+	if err != nil {
 		var ac actionRecommender
 		var exitCodeErr exitCodeError
 
 		if errors.As(err, &ac) {
 			log.Infoln(ac.RecommendActions())
 		}
+		if jsonOut != nil {
+			// The error is reported in the result event.
+			jsonOut.emitResult(executed.CommandPath(), err)
+		}
 		if errors.As(err, &exitCodeErr) {
-			log.Infoln(err.Error())
-			os.Exit(exitCodeErr.ExitCode())
+			if jsonOut == nil {
+				log.Infoln(err.Error())
+			}
+			return exitCodeErr.ExitCode()
+		}
+		if jsonOut == nil {
+			log.Errorln(err.Error())
 		}
-		log.Errorln(err.Error())
-		os.Exit(1)
+		return 1
 	}
+	if jsonOut != nil {
+		jsonOut.emitResult(executed.CommandPath(), nil)
+	}
+	return 0
 }
 
+// jsonOut is set when the command is run with --output json.
+var jsonOut *jsonOutput
+
 func buildRootCmd() *cobra.Command {
+	var outputFormat string
 	cmd := &cobra.Command{
 		Use:   "copilot",
 		Short: shortDescription,
 		Example: `
   Displays the help menu for the "init" command.
   /code $ copilot init --help`,
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			// If we don't set a Run() function the help menu doesn't show up.
 			// See https://github.com/spf13/cobra/issues/790
+			if err := validateOutputFormat(outputFormat); err != nil {
+				return err
+			}
+			if outputFormat == outputJSON {
+				color.DisableColor()
+				jsonOut = enableJSONOutput(log.OutputWriter)
+				cmd.Root().SetOut(log.OutputWriter)
+				cmd.Root().SetErr(log.DiagnosticWriter)
+			}
+			return nil
 		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
@@ -69,6 +110,7 @@ func buildRootCmd() *cobra.Command {
 	// version information.
 	cmd.Version = version.Version
 	cmd.SetVersionTemplate("copilot version: {{.Version}}\n")
+	cmd.PersistentFlags().StringVar(&outputFormat, outputFlag, outputText, outputFlagUsage)
 
 	// NOTE: Order for each grouping below is significant in that it affects help menu output ordering.
 	// "Getting Started" command group.
@@ -82,6 +124,9 @@ func buildRootCmd() *cobra.Command {
 	cmd.AddCommand(cli.BuildJobCmd())
 	cmd.AddCommand(cli.BuildTaskCmd())
 	cmd.AddCommand(cli.BuildRunLocalCmd())
+	cmd.AddCommand(cli.BuildSandboxCmd())
+	cmd.AddCommand(cli.BuildFindCmd())
+	cmd.AddCommand(cli.BuildUICmd())
 
 	// "Extend" command group
 	cmd.AddCommand(cli.BuildStorageCmd())
@@ -89,11 +134,14 @@ func buildRootCmd() *cobra.Command {
 
 	// "Settings" command group.
 	cmd.AddCommand(cli.BuildVersionCmd())
+	cmd.AddCommand(cli.BuildUpgradeCmd())
+	cmd.AddCommand(cli.BuildBundleCmd())
 	cmd.AddCommand(cli.BuildCompletionCmd(cmd))
 
 	// "Release" command group.
 	cmd.AddCommand(cli.BuildPipelineCmd())
 	cmd.AddCommand(cli.BuildDeployCmd())
+	cmd.AddCommand(cli.BuildDeploymentCmd())
 
 	cmd.SetUsageTemplate(template.RootUsage)
 	return cmd
@@ -0,0 +1,82 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+// Values of the --output flag.
+const (
+	outputFlag      = "output"
+	outputText      = "text"
+	outputJSON      = "json"
+	outputFlagUsage = `Optional. Format of the output of the command, "text" or "json".
+With "json", the progress is written to standard output as newline-delimited JSON events
+followed by a "result" event instead of being rendered in the terminal.`
+)
+
+// jsonOutput replaces the terminal output of a command with newline-delimited JSON events.
+type jsonOutput struct {
+	logs   *progress.JSONLogWriter
+	output bytes.Buffer // Collects what the command writes to standard output, to add it to the result event.
+	start  time.Time
+}
+
+func validateOutputFormat(format string) error {
+	if format != outputText && format != outputJSON {
+		return fmt.Errorf(`invalid value %q for flag --%s: must be one of "%s" or "%s"`, format, outputFlag, outputText, outputJSON)
+	}
+	return nil
+}
+
+// enableJSONOutput writes the events to w and redirects the writers of the log package.
+func enableJSONOutput(w io.Writer) *jsonOutput {
+	o := &jsonOutput{
+		logs:  &progress.JSONLogWriter{},
+		start: time.Now(),
+	}
+	progress.EnableJSONOutput(w)
+	log.DiagnosticWriter = o.logs
+	log.OutputWriter = &o.output
+	return o
+}
+
+// emitResult emits the event with the outcome of the command, which is the last one.
+func (o *jsonOutput) emitResult(command string, err error) {
+	o.logs.Flush()
+	res := progress.Event{
+		Type:    progress.EventTypeResult,
+		Command: command,
+		Status:  progress.StatusSucceeded,
+		Output:  resultOutput(o.output.Bytes()),
+		Elapsed: time.Since(o.start).Round(time.Millisecond).String(),
+	}
+	if err != nil {
+		res.Status = progress.StatusFailed
+		res.Error = err.Error()
+	}
+	progress.Emit(res)
+}
+
+// resultOutput returns the standard output of a command as is if it's a JSON document, or as a JSON string otherwise.
+func resultOutput(out []byte) json.RawMessage {
+	trimmed := bytes.TrimSpace(out)
+	if len(trimmed) == 0 {
+		return nil
+	}
+	if json.Valid(trimmed) {
+		return trimmed
+	}
+	data, _ := json.Marshal(strings.TrimSpace(string(out))) // Marshaling a string can't fail.
+	return data
+}
@@ -127,6 +127,31 @@ func (s *stack) Template() (string, error) {
 	return s.encode(s.template)
 }
 
+// Resource is a resource defined in the CloudFormation template of a Stack.
+type Resource struct {
+	LogicalID string
+	Type      string
+}
+
+// Resources returns the resources of Stack's CloudFormation template in the order they're defined.
+func (s *stack) Resources() []Resource {
+	if s.template == nil {
+		return nil
+	}
+	var resources []Resource
+	for _, content := range mappingContents(&s.template.Resources) {
+		var resource struct {
+			Type string `yaml:"Type"`
+		}
+		_ = content.valueNode.Decode(&resource) // Swallow the error as a resource without a type is invalid regardless.
+		resources = append(resources, Resource{
+			LogicalID: content.keyNode.Value,
+			Type:      resource.Type,
+		})
+	}
+	return resources
+}
+
 // Parameters returns Stack's CloudFormation parameters as a yaml string.
 func (s *stack) Parameters() (string, error) {
 	if s.parameters.IsZero() {
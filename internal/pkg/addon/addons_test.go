@@ -378,6 +378,33 @@ DiscoveryServiceArn: !GetAtt DiscoveryService.Arn
 	}
 }
 
+func TestWorkload_Resources(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ws := mocks.NewMockWorkspaceAddonsReader(ctrl)
+	ws.EXPECT().WorkloadAddonsAbsPath("mysvc").Return("mockPath")
+	ws.EXPECT().ListFiles("mockPath").Return([]string{"first.yaml", "second.yaml"}, nil)
+	first, _ := os.ReadFile(filepath.Join("testdata", "merge", "first.yaml"))
+	ws.EXPECT().WorkloadAddonFileAbsPath("mysvc", "first.yaml").Return("firstPath")
+	ws.EXPECT().ReadFile("firstPath").Return(first, nil)
+	second, _ := os.ReadFile(filepath.Join("testdata", "merge", "second.yaml"))
+	ws.EXPECT().WorkloadAddonFileAbsPath("mysvc", "second.yaml").Return("secondPath")
+	ws.EXPECT().ReadFile("secondPath").Return(second, nil)
+
+	// WHEN
+	stack, err := ParseFromWorkload("mysvc", ws)
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, []Resource{
+		{LogicalID: "MyTable", Type: "AWS::DynamoDB::Table"},
+		{LogicalID: "MyTableAccessPolicy", Type: "AWS::IAM::ManagedPolicy"},
+		{LogicalID: "MyBucket", Type: "AWS::S3::Bucket"},
+		{LogicalID: "MyBucketAccessPolicy", Type: "AWS::IAM::ManagedPolicy"},
+	}, stack.Resources())
+}
+
 func TestEnv_Template(t *testing.T) {
 	testErr := errors.New("some error")
 	testCases := map[string]struct {
@@ -21,6 +21,13 @@ const (
 
 type api interface {
 	DescribeScalingPolicies(input *aas.DescribeScalingPoliciesInput) (*aas.DescribeScalingPoliciesOutput, error)
+	DescribeScalableTargets(input *aas.DescribeScalableTargetsInput) (*aas.DescribeScalableTargetsOutput, error)
+}
+
+// ScalableTarget is the range of the desired count of an ECS service that scales automatically.
+type ScalableTarget struct {
+	MinCapacity int
+	MaxCapacity int
 }
 
 // ApplicationAutoscaling wraps an Amazon Application Auto Scaling client.
@@ -62,3 +69,23 @@ func (a *ApplicationAutoscaling) ECSServiceAlarmNames(cluster, service string) (
 	}
 	return alarms, nil
 }
+
+// ECSServiceScalableTarget returns the range of the desired count of the ECS service, or nil if it doesn't scale automatically.
+func (a *ApplicationAutoscaling) ECSServiceScalableTarget(cluster, service string) (*ScalableTarget, error) {
+	resp, err := a.client.DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{fmt.Sprintf(fmtECSResourceID, cluster, service)}),
+		ScalableDimension: aws.String(aas.ScalableDimensionEcsServiceDesiredCount),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scalable targets for ECS service %s/%s: %w", cluster, service, err)
+	}
+	if len(resp.ScalableTargets) == 0 {
+		return nil, nil
+	}
+	target := resp.ScalableTargets[0]
+	return &ScalableTarget{
+		MinCapacity: int(aws.Int64Value(target.MinCapacity)),
+		MaxCapacity: int(aws.Int64Value(target.MaxCapacity)),
+	}, nil
+}
@@ -139,3 +139,72 @@ func TestCloudWatch_ECSServiceAutoscalingAlarms(t *testing.T) {
 
 	}
 }
+
+func TestApplicationAutoscaling_ECSServiceScalableTarget(t *testing.T) {
+	wantedInput := &aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{"service/mockCluster/mockService"}),
+		ScalableDimension: aws.String("ecs:service:DesiredCount"),
+		ServiceNamespace:  aws.String("ecs"),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr    error
+		wantTarget *ScalableTarget
+	}{
+		"errors if failed to describe the scalable targets": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(wantedInput).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe scalable targets for ECS service mockCluster/mockService: some error"),
+		},
+		"returns nil if the service doesn't scale automatically": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(wantedInput).Return(&aas.DescribeScalableTargetsOutput{}, nil)
+			},
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(wantedInput).Return(&aas.DescribeScalableTargetsOutput{
+					ScalableTargets: []*aas.ScalableTarget{
+						{
+							MinCapacity: aws.Int64(1),
+							MaxCapacity: aws.Int64(10),
+						},
+					},
+				}, nil)
+			},
+			wantTarget: &ScalableTarget{
+				MinCapacity: 1,
+				MaxCapacity: 10,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(aasMocks{
+				client: mockClient,
+			})
+			aasSvc := ApplicationAutoscaling{
+				client: mockClient,
+			}
+
+			// WHEN
+			got, err := aasSvc.ECSServiceScalableTarget("mockCluster", "mockService")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantTarget, got)
+			}
+		})
+	}
+}
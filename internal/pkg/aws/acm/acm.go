@@ -80,6 +80,43 @@ func (a *ACM) ValidateCertAliases(aliases []string, certs []string) error {
 	return nil
 }
 
+// Certificate holds the properties of an ACM certificate.
+type Certificate struct {
+	ARN       string
+	Domains   []string  // Domain name and subject alternative names of the certificate.
+	Status    string    // Such as "ISSUED" or "PENDING_VALIDATION".
+	Type      string    // "AMAZON_ISSUED", "IMPORTED", or "PRIVATE".
+	CreatedAt time.Time // Time at which the certificate was requested or imported.
+	NotAfter  time.Time // Time after which the certificate is not valid, zero until the certificate is issued.
+}
+
+// Certificate returns the properties of the certificate.
+func (a *ACM) Certificate(arn string) (*Certificate, error) {
+	resp, err := a.client.DescribeCertificateWithContext(context.Background(), &acm.DescribeCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe certificate %s: %w", arn, err)
+	}
+	cert := resp.Certificate
+	domains := aws.StringValueSlice(cert.SubjectAlternativeNames)
+	if len(domains) == 0 && cert.DomainName != nil {
+		domains = []string{aws.StringValue(cert.DomainName)}
+	}
+	createdAt := aws.TimeValue(cert.CreatedAt)
+	if cert.ImportedAt != nil {
+		createdAt = aws.TimeValue(cert.ImportedAt)
+	}
+	return &Certificate{
+		ARN:       arn,
+		Domains:   domains,
+		Status:    aws.StringValue(cert.Status),
+		Type:      aws.StringValue(cert.Type),
+		CreatedAt: createdAt,
+		NotAfter:  aws.TimeValue(cert.NotAfter),
+	}, nil
+}
+
 func (a *ACM) validDomainsOfCert(ctx context.Context, cert string) ([]string, error) {
 	resp, err := a.client.DescribeCertificateWithContext(ctx, &acm.DescribeCertificateInput{
 		CertificateArn: aws.String(cert),
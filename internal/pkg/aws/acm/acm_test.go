@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
@@ -107,3 +108,89 @@ func TestACM_ValidateCertAliases(t *testing.T) {
 
 	}
 }
+
+func TestACM_Certificate(t *testing.T) {
+	createdAt := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	importedAt := time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m acmMocks)
+
+		wanted    *Certificate
+		wantedErr error
+	}{
+		"errors if failed to describe the certificate": {
+			setupMocks: func(m acmMocks) {
+				m.client.EXPECT().DescribeCertificateWithContext(gomock.Any(), &acm.DescribeCertificateInput{
+					CertificateArn: aws.String("mockCertARN"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe certificate mockCertARN: some error"),
+		},
+		"amazon issued certificate": {
+			setupMocks: func(m acmMocks) {
+				m.client.EXPECT().DescribeCertificateWithContext(gomock.Any(), gomock.Any()).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						DomainName:              aws.String("example.com"),
+						SubjectAlternativeNames: aws.StringSlice([]string{"example.com", "*.example.com"}),
+						Status:                  aws.String(acm.CertificateStatusIssued),
+						Type:                    aws.String(acm.CertificateTypeAmazonIssued),
+						CreatedAt:               aws.Time(createdAt),
+						NotAfter:                aws.Time(notAfter),
+					},
+				}, nil)
+			},
+			wanted: &Certificate{
+				ARN:       "mockCertARN",
+				Domains:   []string{"example.com", "*.example.com"},
+				Status:    "ISSUED",
+				Type:      "AMAZON_ISSUED",
+				CreatedAt: createdAt,
+				NotAfter:  notAfter,
+			},
+		},
+		"imported certificate without subject alternative names": {
+			setupMocks: func(m acmMocks) {
+				m.client.EXPECT().DescribeCertificateWithContext(gomock.Any(), gomock.Any()).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						DomainName: aws.String("example.com"),
+						Status:     aws.String(acm.CertificateStatusIssued),
+						Type:       aws.String(acm.CertificateTypeImported),
+						ImportedAt: aws.Time(importedAt),
+						NotAfter:   aws.Time(notAfter),
+					},
+				}, nil)
+			},
+			wanted: &Certificate{
+				ARN:       "mockCertARN",
+				Domains:   []string{"example.com"},
+				Status:    "ISSUED",
+				Type:      "IMPORTED",
+				CreatedAt: importedAt,
+				NotAfter:  notAfter,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := acmMocks{
+				client: mocks.NewMockapi(ctrl),
+			}
+			tc.setupMocks(m)
+			client := ACM{
+				client: m.client,
+			}
+
+			got, err := client.Certificate("mockCertARN")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 const (
 	fmtAppRunnerServiceLogGroupName     = "/aws/apprunner/%s/%s/service"
 	fmtAppRunnerApplicationLogGroupName = "/aws/apprunner/%s/%s/application"
+	fmtAppRunnerDeploymentLogStreamName = "deployment/%s"
 
 	// App Runner Statuses
 	opStatusSucceeded = "SUCCEEDED"
@@ -206,6 +207,34 @@ func (a *AppRunner) DescribeOperation(operationId, svcARN string) (*apprunner.Op
 	return nil, fmt.Errorf("no operation found %s", operationId)
 }
 
+// Operations returns at most limit of the most recent operations performed on a service, from the newest to the oldest.
+func (a *AppRunner) Operations(svcARN string, limit int) ([]Operation, error) {
+	resp, err := a.client.ListOperations(&apprunner.ListOperationsInput{
+		ServiceArn: aws.String(svcARN),
+		MaxResults: aws.Int64(int64(limit)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list operations of service %s: %w", svcARN, err)
+	}
+	var operations []Operation
+	for _, op := range resp.OperationSummaryList {
+		operation := Operation{
+			ID:     aws.StringValue(op.Id),
+			Type:   aws.StringValue(op.Type),
+			Status: aws.StringValue(op.Status),
+		}
+		if op.StartedAt != nil {
+			operation.StartedAt = *op.StartedAt
+		}
+		if op.EndedAt != nil {
+			endedAt := *op.EndedAt
+			operation.EndedAt = &endedAt
+		}
+		operations = append(operations, operation)
+	}
+	return operations, nil
+}
+
 // WaitForOperation waits for a service operation.
 func (a *AppRunner) WaitForOperation(operationId, svcARN string) error {
 	for {
@@ -295,6 +324,12 @@ func SystemLogGroupName(svcARN string) (string, error) {
 	return fmt.Sprintf(fmtAppRunnerServiceLogGroupName, svcName, svcID), nil
 }
 
+// DeploymentLogStreamName returns the name of the log stream of the service log group
+// that App Runner writes the logs of a deployment operation to.
+func DeploymentLogStreamName(operationID string) string {
+	return fmt.Sprintf(fmtAppRunnerDeploymentLogStreamName, operationID)
+}
+
 // ImageIsSupported returns true if the image identifier is supported by App Runner.
 func ImageIsSupported(imageIdentifier string) bool {
 	return imageIsECR(imageIdentifier) || imageIsECRPublic(imageIdentifier)
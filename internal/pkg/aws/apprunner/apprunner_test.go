@@ -409,6 +409,92 @@ func TestAppRunner_DescribeOperation(t *testing.T) {
 	}
 }
 
+func TestAppRunner_Operations(t *testing.T) {
+	const mockSvcARN = "mockSvcArn"
+	startedAt := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(5 * time.Minute)
+	testCases := map[string]struct {
+		mockAppRunnerClient func(m *mocks.Mockapi)
+
+		wanted    []Operation
+		wantedErr error
+	}{
+		"error if fail to list the operations": {
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListOperations(&apprunner.ListOperationsInput{
+					ServiceArn: aws.String(mockSvcARN),
+					MaxResults: aws.Int64(5),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list operations of service mockSvcArn: some error"),
+		},
+		"return the operations": {
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListOperations(&apprunner.ListOperationsInput{
+					ServiceArn: aws.String(mockSvcARN),
+					MaxResults: aws.Int64(5),
+				}).Return(&apprunner.ListOperationsOutput{
+					OperationSummaryList: []*apprunner.OperationSummary{
+						{
+							Id:        aws.String("op2"),
+							Type:      aws.String("START_DEPLOYMENT"),
+							Status:    aws.String("IN_PROGRESS"),
+							StartedAt: aws.Time(endedAt),
+						},
+						{
+							Id:        aws.String("op1"),
+							Type:      aws.String("CREATE_SERVICE"),
+							Status:    aws.String("SUCCEEDED"),
+							StartedAt: aws.Time(startedAt),
+							EndedAt:   aws.Time(endedAt),
+						},
+					},
+				}, nil)
+			},
+			wanted: []Operation{
+				{
+					ID:        "op2",
+					Type:      "START_DEPLOYMENT",
+					Status:    "IN_PROGRESS",
+					StartedAt: endedAt,
+				},
+				{
+					ID:        "op1",
+					Type:      "CREATE_SERVICE",
+					Status:    "SUCCEEDED",
+					StartedAt: startedAt,
+					EndedAt:   &endedAt,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAppRunnerClient := mocks.NewMockapi(ctrl)
+			tc.mockAppRunnerClient(mockAppRunnerClient)
+			service := AppRunner{
+				client: mockAppRunnerClient,
+			}
+
+			got, err := service.Operations(mockSvcARN, 5)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestOperation_IsDeployment(t *testing.T) {
+	require.True(t, Operation{Type: "UPDATE_SERVICE"}.IsDeployment())
+	require.False(t, Operation{Type: "PAUSE_SERVICE"}.IsDeployment())
+}
+
 func TestAppRunner_PrivateURL(t *testing.T) {
 	const mockARN = "mockVicArn"
 	tests := map[string]struct {
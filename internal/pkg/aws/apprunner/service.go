@@ -47,3 +47,21 @@ type ObservabilityConfiguration struct {
 
 // TraceConfiguration wraps AppRunner TraceConfiguration.
 type TraceConfiguration apprunner.TraceConfiguration
+
+// Operation contains the description of an operation that App Runner performed on a service.
+type Operation struct {
+	ID        string     `json:"id"`
+	Type      string     `json:"type"`
+	Status    string     `json:"status"`
+	StartedAt time.Time  `json:"startedAt"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+}
+
+// IsDeployment returns true if the operation deployed a new version of the service.
+func (o Operation) IsDeployment() bool {
+	switch o.Type {
+	case apprunner.OperationTypeCreateService, apprunner.OperationTypeStartDeployment, apprunner.OperationTypeUpdateService:
+		return true
+	}
+	return false
+}
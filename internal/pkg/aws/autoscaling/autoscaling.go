@@ -0,0 +1,127 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package autoscaling provides a client to make API requests to Amazon EC2 Auto Scaling.
+package autoscaling
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+)
+
+const (
+	waitInServicePollInterval = 15 * time.Second
+	waitInServiceMaxTries     = 80
+
+	describeInstancesMaxNum = 50
+)
+
+type api interface {
+	DescribeAutoScalingInstances(input *autoscaling.DescribeAutoScalingInstancesInput) (*autoscaling.DescribeAutoScalingInstancesOutput, error)
+	DescribeAutoScalingGroups(input *autoscaling.DescribeAutoScalingGroupsInput) (*autoscaling.DescribeAutoScalingGroupsOutput, error)
+}
+
+// ErrWaitInServiceTimeout occurs when an Auto Scaling group still doesn't have as many instances in service
+// as its desired capacity after the max number of retries.
+type ErrWaitInServiceTimeout struct {
+	group      string
+	maxRetries int
+}
+
+func (e *ErrWaitInServiceTimeout) Error() string {
+	return fmt.Sprintf("max retries %v exceeded waiting for Auto Scaling group %s to be in service", e.maxRetries, e.group)
+}
+
+// Timeout allows ErrWaitInServiceTimeout to implement a timeout error interface.
+func (e *ErrWaitInServiceTimeout) Timeout() bool {
+	return true
+}
+
+// AutoScaling wraps an Amazon EC2 Auto Scaling client.
+type AutoScaling struct {
+	client api
+
+	pollInterval time.Duration
+	maxTries     int
+}
+
+// New returns an AutoScaling configured against the input session.
+func New(s *session.Session) *AutoScaling {
+	return &AutoScaling{
+		client:       autoscaling.New(s),
+		pollInterval: waitInServicePollInterval,
+		maxTries:     waitInServiceMaxTries,
+	}
+}
+
+// GroupNames returns the names of the Auto Scaling groups of the EC2 instances keyed by instance ID.
+// The instances that don't belong to an Auto Scaling group are left out.
+func (a *AutoScaling) GroupNames(instanceIDs []string) (map[string]string, error) {
+	groups := make(map[string]string)
+	for start := 0; start < len(instanceIDs); start += describeInstancesMaxNum {
+		end := start + describeInstancesMaxNum
+		if end > len(instanceIDs) {
+			end = len(instanceIDs)
+		}
+		in := &autoscaling.DescribeAutoScalingInstancesInput{
+			InstanceIds: aws.StringSlice(instanceIDs[start:end]),
+		}
+		for {
+			resp, err := a.client.DescribeAutoScalingInstances(in)
+			if err != nil {
+				return nil, fmt.Errorf("describe auto scaling instances: %w", err)
+			}
+			for _, instance := range resp.AutoScalingInstances {
+				groups[aws.StringValue(instance.InstanceId)] = aws.StringValue(instance.AutoScalingGroupName)
+			}
+			if resp.NextToken == nil {
+				break
+			}
+			in.NextToken = resp.NextToken
+		}
+	}
+	return groups, nil
+}
+
+// WaitUntilInService waits until the Auto Scaling group has as many instances in service as its desired capacity,
+// without counting the excluded instances, such as the ones just terminated that the group hasn't replaced yet.
+func (a *AutoScaling) WaitUntilInService(group string, excluded []string) error {
+	skip := make(map[string]bool)
+	for _, id := range excluded {
+		skip[id] = true
+	}
+	var tryNum int
+	for {
+		resp, err := a.client.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice([]string{group}),
+		})
+		if err != nil {
+			return fmt.Errorf("describe auto scaling group %s: %w", group, err)
+		}
+		if len(resp.AutoScalingGroups) == 0 {
+			return fmt.Errorf("auto scaling group %s not found", group)
+		}
+		asg := resp.AutoScalingGroups[0]
+		var inService int64
+		for _, instance := range asg.Instances {
+			if !skip[aws.StringValue(instance.InstanceId)] && aws.StringValue(instance.LifecycleState) == autoscaling.LifecycleStateInService {
+				inService++
+			}
+		}
+		if inService >= aws.Int64Value(asg.DesiredCapacity) {
+			return nil
+		}
+		if tryNum >= a.maxTries {
+			return &ErrWaitInServiceTimeout{
+				group:      group,
+				maxRetries: a.maxTries,
+			}
+		}
+		tryNum++
+		time.Sleep(a.pollInterval)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package autoscaling
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/copilot-cli/internal/pkg/aws/autoscaling/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoScaling_GroupNames(t *testing.T) {
+	var ids []string
+	for i := 0; i < 51; i++ {
+		ids = append(ids, fmt.Sprintf("i-%d", i))
+	}
+	testCases := map[string]struct {
+		inIDs      []string
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    map[string]string
+		wantedErr error
+	}{
+		"error if the instances can't be described": {
+			inIDs: []string{"i-1"},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAutoScalingInstances(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe auto scaling instances: some error"),
+		},
+		"returns the groups of the instances in batches": {
+			inIDs: ids,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAutoScalingInstances(&autoscaling.DescribeAutoScalingInstancesInput{
+					InstanceIds: aws.StringSlice(ids[:50]),
+				}).Return(&autoscaling.DescribeAutoScalingInstancesOutput{
+					AutoScalingInstances: []*autoscaling.InstanceDetails{
+						{InstanceId: aws.String("i-0"), AutoScalingGroupName: aws.String("capacity")},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeAutoScalingInstances(&autoscaling.DescribeAutoScalingInstancesInput{
+					InstanceIds: aws.StringSlice(ids[:50]),
+					NextToken:   aws.String("next"),
+				}).Return(&autoscaling.DescribeAutoScalingInstancesOutput{
+					AutoScalingInstances: []*autoscaling.InstanceDetails{
+						{InstanceId: aws.String("i-1"), AutoScalingGroupName: aws.String("capacity")},
+					},
+				}, nil)
+				m.EXPECT().DescribeAutoScalingInstances(&autoscaling.DescribeAutoScalingInstancesInput{
+					InstanceIds: aws.StringSlice(ids[50:]),
+				}).Return(&autoscaling.DescribeAutoScalingInstancesOutput{
+					AutoScalingInstances: []*autoscaling.InstanceDetails{
+						{InstanceId: aws.String("i-50"), AutoScalingGroupName: aws.String("spot")},
+					},
+				}, nil)
+			},
+			wanted: map[string]string{
+				"i-0":  "capacity",
+				"i-1":  "capacity",
+				"i-50": "spot",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := AutoScaling{client: m}
+
+			// WHEN
+			got, err := client.GroupNames(tc.inIDs)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestAutoScaling_WaitUntilInService(t *testing.T) {
+	group := func(desired int64, instances ...*autoscaling.Instance) *autoscaling.DescribeAutoScalingGroupsOutput {
+		return &autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{
+				{DesiredCapacity: aws.Int64(desired), Instances: instances},
+			},
+		}
+	}
+	instance := func(id, state string) *autoscaling.Instance {
+		return &autoscaling.Instance{InstanceId: aws.String(id), LifecycleState: aws.String(state)}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedErr error
+	}{
+		"error if the group can't be described": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAutoScalingGroups(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe auto scaling group capacity: some error"),
+		},
+		"error if the group doesn't exist": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAutoScalingGroups(gomock.Any()).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+			},
+			wantedErr: errors.New("auto scaling group capacity not found"),
+		},
+		"wait until the terminated instance is replaced": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+					AutoScalingGroupNames: aws.StringSlice([]string{"capacity"}),
+				}).Return(group(2,
+					instance("i-1", autoscaling.LifecycleStateInService),
+					instance("i-2", autoscaling.LifecycleStateInService),
+				), nil)
+				m.EXPECT().DescribeAutoScalingGroups(gomock.Any()).Return(group(2,
+					instance("i-1", autoscaling.LifecycleStateTerminating),
+					instance("i-2", autoscaling.LifecycleStateInService),
+					instance("i-3", autoscaling.LifecycleStatePending),
+				), nil)
+				m.EXPECT().DescribeAutoScalingGroups(gomock.Any()).Return(group(2,
+					instance("i-2", autoscaling.LifecycleStateInService),
+					instance("i-3", autoscaling.LifecycleStateInService),
+				), nil)
+			},
+		},
+		"error if the group isn't in service after the max number of retries": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAutoScalingGroups(gomock.Any()).Return(group(2,
+					instance("i-2", autoscaling.LifecycleStateInService),
+				), nil).Times(3)
+			},
+			wantedErr: errors.New("max retries 2 exceeded waiting for Auto Scaling group capacity to be in service"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := AutoScaling{
+				client:   m,
+				maxTries: 2,
+			}
+
+			// WHEN
+			err := client.WaitUntilInService("capacity", []string{"i-1"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -245,6 +245,9 @@ func (cs *changeSet) createAndExecute(conf *stackConfig) error {
 		}
 		return fmt.Errorf("%w: %s", err, descr.StatusReason)
 	}
+	if conf.CreateChangeSetOnly {
+		return nil
+	}
 	if conf.DisableRollback {
 		return cs.executeWithNoRollback()
 	}
@@ -26,6 +26,11 @@ var eventErrorStates = []string{
 	cloudformation.ResourceStatusImportRollbackFailed,
 }
 
+var hookErrorStates = []string{
+	cloudformation.HookStatusHookCompleteFailed,
+	cloudformation.HookStatusHookFailed,
+}
+
 var waiters = []request.WaiterOption{
 	request.WithWaiterDelay(request.ConstantWaiterDelay(5 * time.Second)), // How long to wait in between poll cfn for updates.
 	request.WithWaiterMaxAttempts(1080),                                   // Wait for at most 90 mins for any cfn action.
@@ -53,15 +58,19 @@ func (c *CloudFormation) Create(stack *Stack) (changeSetID string, err error) {
 			return "", err
 		}
 		// If the stack does not exist, create it.
-		return c.create(stack)
+		return c.createWithSettings(stack)
 	}
 	status := StackStatus(aws.StringValue(descr.StackStatus))
+	if status == cloudformation.StackStatusReviewInProgress {
+		// The stack was only created by a change set that was never executed, so it can be proposed again.
+		return c.createWithSettings(stack)
+	}
 	if status.requiresCleanup() {
 		// If the stack exists, but failed to create, we'll clean it up and then re-create it.
 		if err := c.DeleteAndWait(stack.Name); err != nil {
 			return "", fmt.Errorf("clean up previously failed stack %s: %w", stack.Name, err)
 		}
-		return c.create(stack)
+		return c.createWithSettings(stack)
 	}
 	if status.InProgress() {
 		return "", &ErrStackUpdateInProgress{
@@ -92,6 +101,25 @@ func (c *CloudFormation) DescribeChangeSet(changeSetID, stackName string) (*Chan
 	return out, nil
 }
 
+// ExecuteChangeSet executes a change set that was created without being executed, and returns the name of its stack.
+func (c *CloudFormation) ExecuteChangeSet(changeSetID string, disableRollback bool) (stackName string, err error) {
+	out, err := c.client.DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe change set %s: %w", changeSetID, err)
+	}
+	cs := &changeSet{name: changeSetID, stackName: aws.StringValue(out.StackName), client: c.client}
+	execute := cs.execute
+	if disableRollback {
+		execute = cs.executeWithNoRollback
+	}
+	if err := execute(); err != nil {
+		return "", err
+	}
+	return cs.stackName, nil
+}
+
 // WaitForCreate blocks until the stack is created or until the max attempt window expires.
 func (c *CloudFormation) WaitForCreate(ctx context.Context, stackName string) error {
 	err := c.client.WaitUntilStackCreateCompleteWithContext(ctx, &cloudformation.DescribeStacksInput{
@@ -116,6 +144,10 @@ func (c *CloudFormation) Update(stack *Stack) (changeSetID string, err error) {
 			Name: stack.Name,
 		}
 	}
+	// Apply the settings before the update, so that a new stack policy already protects the resources from it.
+	if err := c.applySettings(stack, descr); err != nil {
+		return "", err
+	}
 	return c.update(stack)
 }
 
@@ -138,10 +170,10 @@ func (c *CloudFormation) WaitForUpdate(ctx context.Context, stackName string) er
 	return nil
 }
 
-// Delete removes an existing CloudFormation stack.
+// Delete removes an existing CloudFormation stack, disabling its termination protection if it's enabled.
 // If the stack doesn't exist then do nothing.
 func (c *CloudFormation) Delete(stackName string) error {
-	_, err := c.client.DeleteStack(&cloudformation.DeleteStackInput{
+	err := c.deleteStack(&cloudformation.DeleteStackInput{
 		StackName: aws.String(stackName),
 	})
 	if err != nil {
@@ -334,6 +366,9 @@ func (c *CloudFormation) events(stackName string, match eventMatcher) ([]StackEv
 // ErrorEvents returns the list of events with "failed" status in **chronological order**
 func (c *CloudFormation) ErrorEvents(stackName string) ([]StackEvent, error) {
 	return c.events(stackName, func(in *cloudformation.StackEvent) bool {
+		if IsHookFailure(aws.StringValue(in.HookStatus), aws.StringValue(in.HookFailureMode)) {
+			return true
+		}
 		for _, status := range eventErrorStates {
 			if aws.StringValue(in.ResourceStatus) == status {
 				return true
@@ -343,6 +378,19 @@ func (c *CloudFormation) ErrorEvents(stackName string) ([]StackEvent, error) {
 	})
 }
 
+// IsHookFailure returns true if a CloudFormation Hook failed and, since its failure mode is FAIL, failed the operation on the resource.
+func IsHookFailure(status, failureMode string) bool {
+	if failureMode != cloudformation.HookFailureModeFail {
+		return false
+	}
+	for _, errStatus := range hookErrorStates {
+		if status == errStatus {
+			return true
+		}
+	}
+	return false
+}
+
 // ListStacksWithTags returns all the stacks in the current AWS account and region with the specified matching
 // tags. If a tag key is provided but the value is empty, the method will match tags with any value for the given key.
 func (c *CloudFormation) ListStacksWithTags(tags map[string]string) ([]StackDescription, error) {
@@ -373,6 +421,30 @@ func (c *CloudFormation) ListStacksWithTags(tags map[string]string) ([]StackDesc
 	return summaries, nil
 }
 
+// ListImports returns the names of the stacks that import the exported output value.
+func (c *CloudFormation) ListImports(exportName string) ([]string, error) {
+	var nextToken *string
+	var stacks []string
+	for {
+		out, err := c.client.ListImports(&cloudformation.ListImportsInput{
+			ExportName: aws.String(exportName),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			if exportNotImported(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("list imports of export %s: %w", exportName, err)
+		}
+		stacks = append(stacks, aws.StringValueSlice(out.Imports)...)
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return stacks, nil
+}
+
 // CancelUpdateStack attempts to cancel the update for a CloudFormation stack specified by the stackName.
 // Returns an error if failed to cancel CloudFormation stack update.
 func (c *CloudFormation) CancelUpdateStack(stackName string) error {
@@ -409,7 +481,7 @@ func (c *CloudFormation) update(stack *Stack) (string, error) {
 }
 
 func (c *CloudFormation) deleteAndWait(in *cloudformation.DeleteStackInput) error {
-	_, err := c.client.DeleteStack(in)
+	err := c.deleteStack(in)
 	if err != nil {
 		if !stackDoesNotExist(err) {
 			return fmt.Errorf("delete stack %s: %w", aws.StringValue(in.StackName), err)
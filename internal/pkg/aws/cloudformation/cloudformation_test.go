@@ -29,6 +29,7 @@ var (
 
 	errDoesNotExist             = awserr.New("ValidationError", "does not exist", nil)
 	errStackNotInUpdateProgress = awserr.New("ValidationError", "CancelUpdateStack cannot be called from current stack status", nil)
+	errTerminationProtected     = awserr.New("ValidationError", "Stack [id] cannot be deleted while TerminationProtection is enabled", nil)
 )
 
 func TestCloudFormation_Create(t *testing.T) {
@@ -143,6 +144,28 @@ func TestCloudFormation_Create(t *testing.T) {
 				return m
 			},
 		},
+		"only creates the change set of a stack that was never executed": {
+			inStack: NewStack("id", "template", WithCreateChangeSetOnly()),
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusReviewInProgress),
+						},
+					},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{
+					Id:      aws.String(mockChangeSetID),
+					StackId: aws.String(mockStack.Name),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), &cloudformation.DescribeChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+				}, gomock.Any())
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+		},
 		"creates the stack with templateURL": {
 			inStack: mockStack,
 			createMock: func(ctrl *gomock.Controller) client {
@@ -173,6 +196,34 @@ func TestCloudFormation_Create(t *testing.T) {
 				return m
 			},
 		},
+		"protects the stack once it's created": {
+			inStack: NewStack("id", "template", WithTerminationProtection(true), WithStackPolicy(`{"Statement":[]}`)),
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				addCreateDeployCalls(m)
+				m.EXPECT().UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+					StackName:                   aws.String(mockStack.Name),
+					EnableTerminationProtection: aws.Bool(true),
+				}).Return(nil, nil)
+				m.EXPECT().SetStackPolicy(&cloudformation.SetStackPolicyInput{
+					StackName:       aws.String(mockStack.Name),
+					StackPolicyBody: aws.String(`{"Statement":[]}`),
+				}).Return(nil, nil)
+				return m
+			},
+		},
+		"error if the termination protection can't be enabled": {
+			inStack: NewStack("id", "template", WithTerminationProtection(true)),
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				addCreateDeployCalls(m)
+				m.EXPECT().UpdateTerminationProtection(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("update termination protection of stack id: %w", errors.New("some error")),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -275,6 +326,87 @@ func TestCloudFormation_DescribeChangeSet(t *testing.T) {
 	})
 }
 
+func TestCloudFormation_ExecuteChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		inDisableRollback bool
+		createMock        func(m *mocks.Mockclient)
+
+		wantedStackName string
+		wantedErr       error
+	}{
+		"error if the change set can't be described": {
+			createMock: func(m *mocks.Mockclient) {
+				m.EXPECT().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("describe change set %s: some error", mockChangeSetID),
+		},
+		"error if the change set isn't executable": {
+			createMock: func(m *mocks.Mockclient) {
+				m.EXPECT().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+				}).Return(&cloudformation.DescribeChangeSetOutput{
+					StackName: aws.String("phonetool-test"),
+				}, nil)
+				m.EXPECT().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+					StackName:     aws.String("phonetool-test"),
+				}).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusObsolete),
+					StatusReason:    aws.String("some reason"),
+				}, nil)
+			},
+			wantedErr: fmt.Errorf("execute change set %s for stack phonetool-test because status is OBSOLETE with reason some reason", mockChangeSetID),
+		},
+		"executes the change set of the stack": {
+			inDisableRollback: true,
+			createMock: func(m *mocks.Mockclient) {
+				m.EXPECT().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+				}).Return(&cloudformation.DescribeChangeSetOutput{
+					StackName: aws.String("phonetool-test"),
+				}, nil)
+				m.EXPECT().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+					StackName:     aws.String("phonetool-test"),
+				}).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				m.EXPECT().ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+					ChangeSetName:   aws.String(mockChangeSetID),
+					StackName:       aws.String("phonetool-test"),
+					DisableRollback: aws.Bool(true),
+				})
+			},
+			wantedStackName: "phonetool-test",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockclient(ctrl)
+			tc.createMock(m)
+			c := CloudFormation{
+				client: m,
+			}
+
+			// WHEN
+			stackName, err := c.ExecuteChangeSet(mockChangeSetID, tc.inDisableRollback)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStackName, stackName)
+		})
+	}
+}
+
 func TestCloudFormation_WaitForCreate(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
@@ -510,6 +642,44 @@ func TestCloudFormation_Update(t *testing.T) {
 			},
 			wantedErr: fmt.Errorf("execute change set copilot-31323334-3536-4738-b930-313233333435 for stack id: some error"),
 		},
+		"applies the stack settings that changed before the update": {
+			inStack: NewStack("id", "template", WithTerminationProtection(true), WithStackPolicy(AllowAllStackPolicy)),
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{
+						StackStatus:                 aws.String(cloudformation.StackStatusUpdateComplete),
+						EnableTerminationProtection: aws.Bool(true),
+					}},
+				}, nil)
+				m.EXPECT().UpdateTerminationProtection(gomock.Any()).Times(0)
+				m.EXPECT().SetStackPolicy(&cloudformation.SetStackPolicyInput{
+					StackName:       aws.String(mockStack.Name),
+					StackPolicyBody: aws.String(AllowAllStackPolicy),
+				}).Return(nil, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{
+					Id: aws.String(mockChangeSetName),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Return(&cloudformation.ExecuteChangeSetOutput{}, nil)
+				return m
+			},
+		},
+		"error if the stack policy can't be set": {
+			inStack: NewStack("id", "template", WithStackPolicy(`{"Statement":[]}`)),
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+				}, nil)
+				m.EXPECT().SetStackPolicy(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("set stack policy of stack id: %w", errors.New("some error")),
+		},
 		"updates the stack with automatic stack rollback disabled": {
 			inStack: NewStack("id", "template", WithDisableRollback()),
 			createMock: func(ctrl *gomock.Controller) client {
@@ -691,6 +861,31 @@ func TestCloudFormation_Delete(t *testing.T) {
 				return m
 			},
 		},
+		"disables the termination protection of the stack to delete it": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				gomock.InOrder(
+					m.EXPECT().DeleteStack(gomock.Any()).Return(nil, errTerminationProtected),
+					m.EXPECT().UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+						StackName:                   aws.String(mockStack.Name),
+						EnableTerminationProtection: aws.Bool(false),
+					}).Return(nil, nil),
+					m.EXPECT().DeleteStack(&cloudformation.DeleteStackInput{
+						StackName: aws.String(mockStack.Name),
+					}).Return(nil, nil),
+				)
+				return m
+			},
+		},
+		"fails if the termination protection can't be disabled": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DeleteStack(gomock.Any()).Return(nil, errTerminationProtected)
+				m.EXPECT().UpdateTerminationProtection(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("delete stack %s: %w", mockStack.Name, fmt.Errorf("update termination protection of stack %s: %w", mockStack.Name, errors.New("some error"))),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -736,6 +931,16 @@ func TestCloudFormation_DeleteAndWait(t *testing.T) {
 				return m
 			},
 		},
+		"wait for the deletion of a stack with termination protection": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DeleteStack(gomock.Any()).Return(nil, errTerminationProtected)
+				m.EXPECT().UpdateTerminationProtection(gomock.Any()).Return(nil, nil)
+				m.EXPECT().DeleteStack(gomock.Any()).Return(nil, nil)
+				m.EXPECT().WaitUntilStackDeleteCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any())
+				return m
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1151,6 +1356,22 @@ func TestCloudFormation_ErrorEvents(t *testing.T) {
 			ResourceStatus:       aws.String("CREATE_COMPLETE"),
 			ResourceStatusReason: aws.String("Moon landing achieved. (Service moonshot)"),
 		},
+		{
+			LogicalResourceId: aws.String("bucket"),
+			ResourceType:      aws.String("S3::Bucket"),
+			HookType:          aws.String("Acme::Guard::Encryption"),
+			HookStatus:        aws.String("HOOK_COMPLETE_FAILED"),
+			HookStatusReason:  aws.String("Bucket is not encrypted."),
+			HookFailureMode:   aws.String("WARN"),
+		},
+		{
+			LogicalResourceId: aws.String("bucket"),
+			ResourceType:      aws.String("S3::Bucket"),
+			HookType:          aws.String("Acme::Guard::RequiredMetadata"),
+			HookStatus:        aws.String("HOOK_COMPLETE_FAILED"),
+			HookStatusReason:  aws.String("Rule [stack_has_cost_center] failed."),
+			HookFailureMode:   aws.String("FAIL"),
+		},
 	}
 	testCases := map[string]struct {
 		mockCf       func(mockclient *mocks.Mockclient)
@@ -1166,6 +1387,14 @@ func TestCloudFormation_ErrorEvents(t *testing.T) {
 				}, nil)
 			},
 			wantedEvents: []StackEvent{
+				{
+					LogicalResourceId: aws.String("bucket"),
+					ResourceType:      aws.String("S3::Bucket"),
+					HookType:          aws.String("Acme::Guard::RequiredMetadata"),
+					HookStatus:        aws.String("HOOK_COMPLETE_FAILED"),
+					HookStatusReason:  aws.String("Rule [stack_has_cost_center] failed."),
+					HookFailureMode:   aws.String("FAIL"),
+				},
 				{
 					LogicalResourceId:    aws.String("abc123"),
 					ResourceType:         aws.String("ECS::Service"),
@@ -1319,6 +1548,73 @@ func TestStackDescriber_StackResources(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_ListImports(t *testing.T) {
+	t.Run("should wrap the error on unexpected list imports error", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockclient(ctrl)
+		m.EXPECT().ListImports(gomock.Any()).Return(nil, errors.New("some error"))
+		c := CloudFormation{
+			client: m,
+		}
+
+		// WHEN
+		_, err := c.ListImports("phonetool-test-api-Endpoint")
+
+		// THEN
+		require.EqualError(t, err, "list imports of export phonetool-test-api-Endpoint: some error")
+	})
+	t.Run("should return no stacks if the export is not imported", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockclient(ctrl)
+		m.EXPECT().ListImports(gomock.Any()).Return(nil, awserr.New("ValidationError", "Export 'phonetool-test-api-Endpoint' is not imported by any stack.", nil))
+		c := CloudFormation{
+			client: m,
+		}
+
+		// WHEN
+		stacks, err := c.ListImports("phonetool-test-api-Endpoint")
+
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, stacks)
+	})
+	t.Run("should return the importing stacks of every page", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockclient(ctrl)
+		m.EXPECT().ListImports(&cloudformation.ListImportsInput{
+			ExportName: aws.String("phonetool-test-api-Endpoint"),
+		}).Return(&cloudformation.ListImportsOutput{
+			Imports:   aws.StringSlice([]string{"phonetool-test-frontend"}),
+			NextToken: aws.String("1111"),
+		}, nil)
+		m.EXPECT().ListImports(&cloudformation.ListImportsInput{
+			ExportName: aws.String("phonetool-test-api-Endpoint"),
+			NextToken:  aws.String("1111"),
+		}).Return(&cloudformation.ListImportsOutput{
+			Imports: aws.StringSlice([]string{"phonetool-test-worker"}),
+		}, nil)
+		c := CloudFormation{
+			client: m,
+		}
+
+		// WHEN
+		stacks, err := c.ListImports("phonetool-test-api-Endpoint")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []string{"phonetool-test-frontend", "phonetool-test-worker"}, stacks)
+	})
+}
+
 func TestCloudFormation_ListStacksWithTags(t *testing.T) {
 	mockAppTag := cloudformation.Tag{
 		Key:   aws.String("copilot-application"),
@@ -15,6 +15,7 @@ type Double struct {
 	CreateFn                    func(stack *cfn.Stack) (string, error)
 	CreateAndWaitFn             func(stack *cfn.Stack) error
 	DescribeChangeSetFn         func(changeSetID, stackName string) (*cfn.ChangeSetDescription, error)
+	ExecuteChangeSetFn          func(changeSetID string, disableRollback bool) (string, error)
 	WaitForCreateFn             func(ctx context.Context, stackName string) error
 	UpdateFn                    func(stack *cfn.Stack) (string, error)
 	UpdateAndWaitFn             func(stack *cfn.Stack) error
@@ -31,6 +32,7 @@ type Double struct {
 	EventsFn                    func(stackName string) ([]cfn.StackEvent, error)
 	StackResourcesFn            func(name string) ([]*cfn.StackResource, error)
 	ErrorEventsFn               func(stackName string) ([]cfn.StackEvent, error)
+	RootCauseFn                 func(stackName string) (*cfn.StackFailure, error)
 	ListStacksWithTagsFn        func(tags map[string]string) ([]cfn.StackDescription, error)
 	DescribeStackEventsFn       func(input *sdk.DescribeStackEventsInput) (*sdk.DescribeStackEventsOutput, error)
 	CancelUpdateStackFn         func(stackName string) error
@@ -51,6 +53,11 @@ func (d *Double) DescribeChangeSet(id, stack string) (*cfn.ChangeSetDescription,
 	return d.DescribeChangeSetFn(id, stack)
 }
 
+// ExecuteChangeSet calls the stubbed function.
+func (d *Double) ExecuteChangeSet(changeSetID string, disableRollback bool) (string, error) {
+	return d.ExecuteChangeSetFn(changeSetID, disableRollback)
+}
+
 // WaitForCreate calls the stubbed function.
 func (d *Double) WaitForCreate(ctx context.Context, stack string) error {
 	return d.WaitForCreateFn(ctx, stack)
@@ -131,6 +138,11 @@ func (d *Double) ErrorEvents(stackName string) ([]cfn.StackEvent, error) {
 	return d.ErrorEventsFn(stackName)
 }
 
+// RootCause calls the stubbed function.
+func (d *Double) RootCause(stackName string) (*cfn.StackFailure, error) {
+	return d.RootCauseFn(stackName)
+}
+
 // ListStacksWithTags calls the stubbed function.
 func (d *Double) ListStacksWithTags(tags map[string]string) ([]cfn.StackDescription, error) {
 	return d.ListStacksWithTagsFn(tags)
@@ -81,6 +81,15 @@ func stackDoesNotExist(err error) bool {
 	return false
 }
 
+// exportNotImported returns true if the underlying error is ListImports being called on an export
+// that no stack imports.
+func exportNotImported(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == "ValidationError" && strings.Contains(aerr.Message(), "is not imported by any stack")
+	}
+	return false
+}
+
 // cancelUpdateStackNotInUpdateProgress returns true if the underlying error is CancelUpdateStack
 // cannot be called for a stack that is not in UPDATE_IN_PROGRESS state.
 func cancelUpdateStackNotInUpdateProgress(err error) bool {
@@ -94,3 +103,16 @@ func cancelUpdateStackNotInUpdateProgress(err error) bool {
 	}
 	return false
 }
+
+// terminationProtectionEnabled returns true if the stack can't be deleted because its termination protection is enabled.
+func terminationProtectionEnabled(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case "ValidationError":
+			if strings.Contains(aerr.Message(), "TerminationProtection is enabled") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+const nestedStackResourceType = "AWS::CloudFormation::Stack"
+
+var (
+	// operationStartStates are the statuses of a stack when an operation on it begins.
+	operationStartStates = []string{
+		cloudformation.StackStatusCreateInProgress,
+		cloudformation.StackStatusUpdateInProgress,
+		cloudformation.StackStatusDeleteInProgress,
+		cloudformation.StackStatusImportInProgress,
+	}
+
+	// handlerMessagePattern matches the reasons of the resources created with the Cloud Control API, such as:
+	// Resource handler returned message: "Invalid request provided: ..." (RequestToken: 1a2b, HandlerErrorCode: InvalidRequest)
+	handlerMessagePattern = regexp.MustCompile(`(?s)^Resource handler returned message: "(.*)" \(RequestToken: [^,]*, HandlerErrorCode: ([A-Za-z]+)\)$`)
+	// serviceErrorPattern matches the details of the failed request that end the reasons of the resources, such as:
+	// (Service: AmazonECS; Status Code: 400; Error Code: InvalidParameterException; Request ID: 1a2b; Proxy: null)
+	serviceErrorPattern = regexp.MustCompile(`(?s)^(.*?)\.? ?\(Service: ([^;,)]+)[;,] Status Code: \d+[;,](?: Error Code: ([^;,)]+)[;,])? Request ID: ([^;,)]+)[^)]*\)\.?$`)
+)
+
+// StackFailure is the failure of a resource that caused an operation on a stack to fail.
+type StackFailure struct {
+	StackName         string // Name of the stack of the resource, which is a nested stack if the failure comes from one.
+	LogicalResourceID string
+	ResourceType      string
+	Status            string
+	Message           string // Reason of the failure, without the details of the request to the service.
+	Service           string // Optional. Service that returned the error to CloudFormation.
+	ErrorCode         string // Optional. Error code returned by the service, or by the resource handler.
+	RequestID         string // Optional. ID of the failed request to the service, to look it up in CloudTrail.
+}
+
+// RootCause returns the first resource that failed during the latest operation on the stack.
+// If the resource is a nested stack, it returns the failure in the nested stack that caused it to fail instead.
+// It returns nil if no resource failed during the operation.
+func (c *CloudFormation) RootCause(stackName string) (*StackFailure, error) {
+	events, err := c.latestOperationEvents(stackName)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		if !isResourceFailure(event) {
+			continue
+		}
+		if aws.StringValue(event.ResourceType) == nestedStackResourceType && aws.StringValue(event.PhysicalResourceId) != "" {
+			nested, err := c.RootCause(aws.StringValue(event.PhysicalResourceId))
+			if err != nil {
+				return nil, err
+			}
+			if nested != nil {
+				return nested, nil
+			}
+		}
+		return newStackFailure(stackName, event), nil
+	}
+	return nil, nil
+}
+
+// latestOperationEvents returns the events of the latest operation on the stack in chronological order.
+func (c *CloudFormation) latestOperationEvents(stackName string) ([]StackEvent, error) {
+	var nextToken *string
+	var events []StackEvent
+	for {
+		out, err := c.client.DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+			NextToken: nextToken,
+			StackName: aws.String(stackName),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe stack events for stack %s: %w", stackName, err)
+		}
+		started := false
+		for _, event := range out.StackEvents {
+			events = append(events, StackEvent(*event))
+			if isStackEvent(event) && contains(aws.StringValue(event.ResourceStatus), operationStartStates) {
+				started = true
+				break
+			}
+		}
+		nextToken = out.NextToken
+		if started || nextToken == nil {
+			break
+		}
+	}
+	// The events are returned from the most recent one.
+	for i := len(events)/2 - 1; i >= 0; i-- {
+		opp := len(events) - 1 - i
+		events[i], events[opp] = events[opp], events[i]
+	}
+	return events, nil
+}
+
+// isStackEvent returns true if the event is about the stack itself rather than one of its resources.
+func isStackEvent(event *cloudformation.StackEvent) bool {
+	id := aws.StringValue(event.StackId)
+	return id != "" && aws.StringValue(event.PhysicalResourceId) == id
+}
+
+// isResourceFailure returns true if a resource failed, rather than being canceled because another resource failed.
+func isResourceFailure(event StackEvent) bool {
+	e := cloudformation.StackEvent(event)
+	if isStackEvent(&e) || !contains(aws.StringValue(e.ResourceStatus), eventErrorStates) {
+		return false
+	}
+	reason := aws.StringValue(e.ResourceStatusReason)
+	return !strings.HasPrefix(reason, "Resource creation cancelled") && !strings.HasPrefix(reason, "Resource update cancelled")
+}
+
+func newStackFailure(stackName string, event StackEvent) *StackFailure {
+	failure := &StackFailure{
+		StackName:         aws.StringValue(event.StackName),
+		LogicalResourceID: aws.StringValue(event.LogicalResourceId),
+		ResourceType:      aws.StringValue(event.ResourceType),
+		Status:            aws.StringValue(event.ResourceStatus),
+		Message:           strings.TrimSpace(aws.StringValue(event.ResourceStatusReason)),
+	}
+	if failure.StackName == "" {
+		failure.StackName = stackName
+	}
+	if match := handlerMessagePattern.FindStringSubmatch(failure.Message); match != nil {
+		failure.Message, failure.ErrorCode = match[1], match[2]
+	}
+	if match := serviceErrorPattern.FindStringSubmatch(failure.Message); match != nil {
+		failure.Message, failure.Service, failure.RequestID = match[1], match[2], match[4]
+		if match[3] != "" {
+			failure.ErrorCode = match[3]
+		}
+	}
+	return failure
+}
+
+func contains(s string, items []string) bool {
+	for _, item := range items {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFormation_RootCause(t *testing.T) {
+	const (
+		stackID       = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api/1"
+		nestedStackID = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack-ABC/2"
+	)
+	stackEvent := func(status, reason string) *cloudformation.StackEvent {
+		return &cloudformation.StackEvent{
+			StackId:              aws.String(stackID),
+			StackName:            aws.String("phonetool-test-api"),
+			LogicalResourceId:    aws.String("phonetool-test-api"),
+			PhysicalResourceId:   aws.String(stackID),
+			ResourceType:         aws.String(nestedStackResourceType),
+			ResourceStatus:       aws.String(status),
+			ResourceStatusReason: aws.String(reason),
+		}
+	}
+	resourceEvent := func(logicalID, resourceType, status, reason string) *cloudformation.StackEvent {
+		return &cloudformation.StackEvent{
+			StackId:              aws.String(stackID),
+			StackName:            aws.String("phonetool-test-api"),
+			LogicalResourceId:    aws.String(logicalID),
+			PhysicalResourceId:   aws.String(logicalID + "-id"),
+			ResourceType:         aws.String(resourceType),
+			ResourceStatus:       aws.String(status),
+			ResourceStatusReason: aws.String(reason),
+		}
+	}
+	testCases := map[string]struct {
+		setUpMocks func(m *mocks.Mockclient)
+
+		wantedFailure *StackFailure
+		wantedErr     error
+	}{
+		"error if fail to describe the events": {
+			setUpMocks: func(m *mocks.Mockclient) {
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe stack events for stack phonetool-test-api: some error"),
+		},
+		"returns nil if no resource failed during the latest operation": {
+			setUpMocks: func(m *mocks.Mockclient) {
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String("phonetool-test-api"),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						stackEvent("UPDATE_COMPLETE", ""),
+						resourceEvent("Service", "AWS::ECS::Service", "UPDATE_COMPLETE", ""),
+						stackEvent("UPDATE_IN_PROGRESS", "User Initiated"),
+						resourceEvent("Service", "AWS::ECS::Service", "UPDATE_FAILED", "Previous failure."),
+					},
+				}, nil)
+			},
+		},
+		"returns the first failed resource of the latest operation in any page": {
+			setUpMocks: func(m *mocks.Mockclient) {
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String("phonetool-test-api"),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						stackEvent("UPDATE_ROLLBACK_COMPLETE", ""),
+						resourceEvent("TaskRole", "AWS::IAM::Role", "UPDATE_FAILED", "Resource update cancelled"),
+						stackEvent("UPDATE_ROLLBACK_IN_PROGRESS", "The following resource(s) failed to update: [Service]."),
+					},
+					NextToken: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String("phonetool-test-api"),
+					NextToken: aws.String("1"),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						resourceEvent("Service", "AWS::ECS::Service", "UPDATE_FAILED", "Invalid request provided: UpdateService error: the task definition is invalid. (Service: AmazonECS; Status Code: 400; Error Code: InvalidParameterException; Request ID: 1a2b; Proxy: null)"),
+						stackEvent("UPDATE_IN_PROGRESS", "User Initiated"),
+					},
+					NextToken: aws.String("2"),
+				}, nil)
+			},
+			wantedFailure: &StackFailure{
+				StackName:         "phonetool-test-api",
+				LogicalResourceID: "Service",
+				ResourceType:      "AWS::ECS::Service",
+				Status:            "UPDATE_FAILED",
+				Message:           "Invalid request provided: UpdateService error: the task definition is invalid",
+				Service:           "AmazonECS",
+				ErrorCode:         "InvalidParameterException",
+				RequestID:         "1a2b",
+			},
+		},
+		"follows the failure of a nested stack": {
+			setUpMocks: func(m *mocks.Mockclient) {
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String("phonetool-test-api"),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						stackEvent("ROLLBACK_COMPLETE", ""),
+						{
+							StackId:              aws.String(stackID),
+							StackName:            aws.String("phonetool-test-api"),
+							LogicalResourceId:    aws.String("AddonsStack"),
+							PhysicalResourceId:   aws.String(nestedStackID),
+							ResourceType:         aws.String(nestedStackResourceType),
+							ResourceStatus:       aws.String("CREATE_FAILED"),
+							ResourceStatusReason: aws.String("Embedded stack was not successfully created: The following resource(s) failed to create: [Table]."),
+						},
+						stackEvent("CREATE_IN_PROGRESS", "User Initiated"),
+					},
+				}, nil)
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String(nestedStackID),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						{
+							StackId:              aws.String(nestedStackID),
+							StackName:            aws.String("phonetool-test-api-AddonsStack-ABC"),
+							LogicalResourceId:    aws.String("Table"),
+							ResourceType:         aws.String("AWS::DynamoDB::Table"),
+							ResourceStatus:       aws.String("CREATE_FAILED"),
+							ResourceStatusReason: aws.String(`Resource handler returned message: "Table already exists: orders" (RequestToken: 3c4d, HandlerErrorCode: AlreadyExists)`),
+						},
+						{
+							StackId:            aws.String(nestedStackID),
+							PhysicalResourceId: aws.String(nestedStackID),
+							ResourceStatus:     aws.String("CREATE_IN_PROGRESS"),
+						},
+					},
+				}, nil)
+			},
+			wantedFailure: &StackFailure{
+				StackName:         "phonetool-test-api-AddonsStack-ABC",
+				LogicalResourceID: "Table",
+				ResourceType:      "AWS::DynamoDB::Table",
+				Status:            "CREATE_FAILED",
+				Message:           "Table already exists: orders",
+				ErrorCode:         "AlreadyExists",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockclient(ctrl)
+			tc.setUpMocks(m)
+			c := CloudFormation{
+				client: m,
+			}
+
+			// WHEN
+			failure, err := c.RootCause("phonetool-test-api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedFailure, failure)
+		})
+	}
+}
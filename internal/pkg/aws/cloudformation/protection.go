@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// AllowAllStackPolicy is the body of a stack policy that allows updates to all the resources of a stack.
+// A stack policy can't be removed once it's set, so setting this policy is the way to clear it.
+const AllowAllStackPolicy = `{"Statement":[{"Effect":"Allow","Action":"Update:*","Principal":"*","Resource":"*"}]}`
+
+// applySettings updates the termination protection and the stack policy of an existing stack
+// if the stack configures them.
+func (c *CloudFormation) applySettings(stack *Stack, descr *StackDescription) error {
+	if stack.CreateChangeSetOnly {
+		// The settings are part of the deployment, which doesn't happen until the change set is executed.
+		return nil
+	}
+	if stack.TerminationProtection != nil && aws.BoolValue(stack.TerminationProtection) != aws.BoolValue(descr.EnableTerminationProtection) {
+		if err := c.updateTerminationProtection(stack.Name, aws.BoolValue(stack.TerminationProtection)); err != nil {
+			return err
+		}
+	}
+	if stack.StackPolicyBody != nil {
+		if _, err := c.client.SetStackPolicy(&cloudformation.SetStackPolicyInput{
+			StackName:       aws.String(stack.Name),
+			StackPolicyBody: stack.StackPolicyBody,
+		}); err != nil {
+			return fmt.Errorf("set stack policy of stack %s: %w", stack.Name, err)
+		}
+	}
+	return nil
+}
+
+// createWithSettings creates the stack and then applies its settings, which requires the stack to exist.
+func (c *CloudFormation) createWithSettings(stack *Stack) (string, error) {
+	id, err := c.create(stack)
+	if err != nil {
+		return "", err
+	}
+	// The new stack has neither termination protection nor stack policy.
+	if err := c.applySettings(stack, &StackDescription{}); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// deleteStack deletes a stack, and disables its termination protection first if it prevents the deletion.
+func (c *CloudFormation) deleteStack(in *cloudformation.DeleteStackInput) error {
+	_, err := c.client.DeleteStack(in)
+	if err == nil || !terminationProtectionEnabled(err) {
+		return err
+	}
+	if err := c.updateTerminationProtection(aws.StringValue(in.StackName), false); err != nil {
+		return err
+	}
+	_, err = c.client.DeleteStack(in)
+	return err
+}
+
+func (c *CloudFormation) updateTerminationProtection(stackName string, enabled bool) error {
+	if _, err := c.client.UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+		StackName:                   aws.String(stackName),
+		EnableTerminationProtection: aws.Bool(enabled),
+	}); err != nil {
+		return fmt.Errorf("update termination protection of stack %s: %w", stackName, err)
+	}
+	return nil
+}
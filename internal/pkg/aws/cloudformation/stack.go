@@ -21,6 +21,13 @@ type stackConfig struct {
 	Tags            []*cloudformation.Tag
 	RoleARN         *string
 	DisableRollback bool
+
+	// CreateChangeSetOnly leaves the change set to be executed later, for example after it's approved.
+	CreateChangeSetOnly bool
+
+	// Settings of the stack that are left as they are when nil.
+	TerminationProtection *bool
+	StackPolicyBody       *string
 }
 
 // StackOption allows you to initialize a Stack with additional properties.
@@ -96,6 +103,28 @@ func WithDisableRollback() StackOption {
 	}
 }
 
+// WithCreateChangeSetOnly creates the change set of the stack without executing it.
+func WithCreateChangeSetOnly() StackOption {
+	return func(s *Stack) {
+		s.CreateChangeSetOnly = true
+	}
+}
+
+// WithTerminationProtection enables or disables the termination protection of the stack when it's deployed.
+func WithTerminationProtection(enabled bool) StackOption {
+	return func(s *Stack) {
+		s.TerminationProtection = aws.Bool(enabled)
+	}
+}
+
+// WithStackPolicy sets the stack policy that protects the resources of the stack from updates when it's deployed.
+// Use AllowAllStackPolicy to clear the policy of the stack.
+func WithStackPolicy(body string) StackOption {
+	return func(s *Stack) {
+		s.StackPolicyBody = aws.String(body)
+	}
+}
+
 // StackEvent is an alias the SDK's StackEvent type.
 type StackEvent cloudformation.StackEvent
 
@@ -21,10 +21,12 @@ type api interface {
 	DeleteStackSet(*cloudformation.DeleteStackSetInput) (*cloudformation.DeleteStackSetOutput, error)
 	DescribeStackSet(*cloudformation.DescribeStackSetInput) (*cloudformation.DescribeStackSetOutput, error)
 	DescribeStackSetOperation(*cloudformation.DescribeStackSetOperationInput) (*cloudformation.DescribeStackSetOperationOutput, error)
+	DetectStackSetDrift(*cloudformation.DetectStackSetDriftInput) (*cloudformation.DetectStackSetDriftOutput, error)
 
 	CreateStackInstances(*cloudformation.CreateStackInstancesInput) (*cloudformation.CreateStackInstancesOutput, error)
 	DeleteStackInstances(*cloudformation.DeleteStackInstancesInput) (*cloudformation.DeleteStackInstancesOutput, error)
 	ListStackInstances(*cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error)
+	UpdateStackInstances(*cloudformation.UpdateStackInstancesInput) (*cloudformation.UpdateStackInstancesOutput, error)
 }
 
 // StackSet represents an AWS CloudFormation client to interact with stack sets.
@@ -217,12 +219,47 @@ func (ss *StackSet) CreateInstancesAndWait(name string, accounts, regions []stri
 	return ss.WaitForOperation(name, id)
 }
 
+// UpdateInstancesAndWait re-deploys the stack set's current template to the existing stack instances
+// within the regions of the specified AWS accounts, and waits until the operation completes.
+func (ss *StackSet) UpdateInstancesAndWait(name string, accounts, regions []string) error {
+	resp, err := ss.client.UpdateStackInstances(&cloudformation.UpdateStackInstancesInput{
+		StackSetName: aws.String(name),
+		Accounts:     aws.StringSlice(accounts),
+		Regions:      aws.StringSlice(regions),
+		OperationPreferences: &cloudformation.StackSetOperationPreferences{
+			RegionConcurrencyType: aws.String(cloudformation.RegionConcurrencyTypeParallel),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("update stack instances for stack set %s in regions %v for accounts %v: %w",
+			name, regions, accounts, err)
+	}
+	return ss.WaitForOperation(name, aws.StringValue(resp.OperationId))
+}
+
+// DetectDriftAndWait detects drift on the stack instances of the stack set, and waits until the operation completes
+// so that the drift status of the instances is up to date.
+func (ss *StackSet) DetectDriftAndWait(name string) error {
+	resp, err := ss.client.DetectStackSetDrift(&cloudformation.DetectStackSetDriftInput{
+		StackSetName: aws.String(name),
+		OperationPreferences: &cloudformation.StackSetOperationPreferences{
+			RegionConcurrencyType: aws.String(cloudformation.RegionConcurrencyTypeParallel),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("detect drift of stack set %s: %w", name, err)
+	}
+	return ss.WaitForOperation(name, aws.StringValue(resp.OperationId))
+}
+
 // InstanceSummary represents the identifiers for a stack instance.
 type InstanceSummary struct {
-	StackID string
-	Account string
-	Region  string
-	Status  InstanceStatus
+	StackID      string
+	Account      string
+	Region       string
+	Status       InstanceStatus
+	StatusReason string
+	DriftStatus  string // Result of the last drift detection operation, "NOT_CHECKED" if drift was never detected.
 }
 
 // InstanceSummariesOption allows to filter instance summaries to retrieve for the stack set.
@@ -245,9 +282,11 @@ func (ss *StackSet) InstanceSummaries(name string, opts ...InstanceSummariesOpti
 		}
 		for _, cfnSummary := range resp.Summaries {
 			summary := InstanceSummary{
-				StackID: aws.StringValue(cfnSummary.StackId),
-				Account: aws.StringValue(cfnSummary.Account),
-				Region:  aws.StringValue(cfnSummary.Region),
+				StackID:      aws.StringValue(cfnSummary.StackId),
+				Account:      aws.StringValue(cfnSummary.Account),
+				Region:       aws.StringValue(cfnSummary.Region),
+				StatusReason: aws.StringValue(cfnSummary.StatusReason),
+				DriftStatus:  aws.StringValue(cfnSummary.DriftStatus),
 			}
 			if status := cfnSummary.StackInstanceStatus; status != nil {
 				summary.Status = InstanceStatus(aws.StringValue(status.DetailedStatus))
@@ -724,6 +724,126 @@ func TestStackSet_CreateInstances(t *testing.T) {
 	}
 }
 
+func TestStackSet_UpdateInstancesAndWait(t *testing.T) {
+	var (
+		testAccounts = []string{"1234"}
+		testRegions  = []string{"us-west-1", "us-east-1"}
+	)
+	testCases := map[string]struct {
+		mockClient  func(ctrl *gomock.Controller) api
+		wantedError error
+	}{
+		"waits until operation succeeds": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().UpdateStackInstances(&cloudformation.UpdateStackInstancesInput{
+					StackSetName: aws.String(testName),
+					Accounts:     aws.StringSlice(testAccounts),
+					Regions:      aws.StringSlice(testRegions),
+					OperationPreferences: &cloudformation.StackSetOperationPreferences{
+						RegionConcurrencyType: aws.String(cloudformation.RegionConcurrencyTypeParallel),
+					},
+				}).Return(&cloudformation.UpdateStackInstancesOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+					StackSetName: aws.String(testName),
+					OperationId:  aws.String("1"),
+				}).Return(&cloudformation.DescribeStackSetOperationOutput{
+					StackSetOperation: &cloudformation.StackSetOperation{
+						Status: aws.String(opStatusSucceeded),
+					},
+				}, nil)
+				return m
+			},
+		},
+		"wraps error on unexpected failure": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().UpdateStackInstances(gomock.Any()).Return(nil, testError)
+				return m
+			},
+			wantedError: fmt.Errorf("update stack instances for stack set %s in regions %v for accounts %v: %w",
+				testName,
+				testRegions,
+				testAccounts,
+				testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := StackSet{
+				client: tc.mockClient(ctrl),
+			}
+
+			// WHEN
+			err := client.UpdateInstancesAndWait(testName, testAccounts, testRegions)
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+		})
+	}
+}
+
+func TestStackSet_DetectDriftAndWait(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient  func(ctrl *gomock.Controller) api
+		wantedError error
+	}{
+		"waits until the drift detection succeeds": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DetectStackSetDrift(&cloudformation.DetectStackSetDriftInput{
+					StackSetName: aws.String(testName),
+					OperationPreferences: &cloudformation.StackSetOperationPreferences{
+						RegionConcurrencyType: aws.String(cloudformation.RegionConcurrencyTypeParallel),
+					},
+				}).Return(&cloudformation.DetectStackSetDriftOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+					StackSetName: aws.String(testName),
+					OperationId:  aws.String("1"),
+				}).Return(&cloudformation.DescribeStackSetOperationOutput{
+					StackSetOperation: &cloudformation.StackSetOperation{
+						Status: aws.String(opStatusSucceeded),
+					},
+				}, nil)
+				return m
+			},
+		},
+		"wraps error on unexpected failure": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DetectStackSetDrift(gomock.Any()).Return(nil, testError)
+				return m
+			},
+			wantedError: fmt.Errorf("detect drift of stack set %s: %w", testName, testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := StackSet{
+				client: tc.mockClient(ctrl),
+			}
+
+			// WHEN
+			err := client.DetectDriftAndWait(testName)
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+		})
+	}
+}
+
 func TestStackSet_InstanceSummaries(t *testing.T) {
 	const (
 		testAccountID = "1234"
@@ -744,9 +864,11 @@ func TestStackSet_InstanceSummaries(t *testing.T) {
 				}).Return(&cloudformation.ListStackInstancesOutput{
 					Summaries: []*cloudformation.StackInstanceSummary{
 						{
-							StackId: aws.String(testName),
-							Account: aws.String(testAccountID),
-							Region:  aws.String(testRegion),
+							StackId:      aws.String(testName),
+							Account:      aws.String(testAccountID),
+							Region:       aws.String(testRegion),
+							StatusReason: aws.String("some reason"),
+							DriftStatus:  aws.String(cloudformation.StackDriftStatusDrifted),
 						},
 					},
 				}, nil)
@@ -754,9 +876,11 @@ func TestStackSet_InstanceSummaries(t *testing.T) {
 			},
 			wantedSummaries: []InstanceSummary{
 				{
-					StackID: testName,
-					Account: testAccountID,
-					Region:  testRegion,
+					StackID:      testName,
+					Account:      testAccountID,
+					Region:       testRegion,
+					StatusReason: "some reason",
+					DriftStatus:  "DRIFTED",
 				},
 			},
 		},
@@ -79,6 +79,11 @@ func (s InstanceStatus) IsFailure() bool {
 	return s == instanceStatusFailed || s == instanceStatusCancelled || s == instanceStatusInoperable
 }
 
+// IsInoperable returns true if the instance can no longer be updated and must be deleted while retaining its stack.
+func (s InstanceStatus) IsInoperable() bool {
+	return s == instanceStatusInoperable
+}
+
 // String implements the fmt.Stringer interface.
 func (s InstanceStatus) String() string {
 	return string(s)
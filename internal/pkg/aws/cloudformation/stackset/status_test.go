@@ -294,3 +294,8 @@ func TestInstanceStatus_String(t *testing.T) {
 	var s InstanceStatus = "hello"
 	require.Equal(t, "hello", s.String())
 }
+
+func TestInstanceStatus_IsInoperable(t *testing.T) {
+	require.True(t, InstanceStatus(cloudformation.StackInstanceDetailedStatusInoperable).IsInoperable())
+	require.False(t, InstanceStatus(cloudformation.StackInstanceDetailedStatusFailed).IsInoperable())
+}
@@ -16,6 +16,8 @@ const (
 	anomalyDetectionBandExpression = "ANOMALY_DETECTION_BAND"
 	// {metricTitle} {breachingRelationship} {threshold} for {datapointsCount} datapoints within {duration}
 	fmtStaticMetricCondition = "%s %s %.2f for %d datapoints within %s"
+	// {metricTitle} {breachingRelationship} the expected band for {datapointsCount} datapoints within {duration}
+	fmtPredictiveMetricCondition = "%s %s the expected band for %d datapoints within %s"
 )
 
 type alarmThresholdTypes int
@@ -66,6 +68,13 @@ func (a metricAlarm) condition() string {
 	case static:
 		return fmt.Sprintf(fmtStaticMetricCondition, metricName, operator.humanString(),
 			aws.Float64Value(a.Threshold), datapointsToAlarm, humanizePeriod(evaluationPeriod, period))
+	case predictive:
+		predicted := a.predictedMetric()
+		if predicted == nil || predicted.MetricStat == nil || predicted.MetricStat.Metric == nil {
+			return "-"
+		}
+		return fmt.Sprintf(fmtPredictiveMetricCondition, aws.StringValue(predicted.MetricStat.Metric.MetricName), operator.humanString(),
+			datapointsToAlarm, humanizePeriod(evaluationPeriod, aws.Int64Value(predicted.MetricStat.Period)))
 	default:
 		return "-"
 	}
@@ -96,6 +105,19 @@ func (a metricAlarm) thresholdMetric() *cloudwatch.MetricDataQuery {
 	return nil
 }
 
+// predictedMetric returns the metric whose anomaly detection band is the threshold of the alarm.
+func (a metricAlarm) predictedMetric() *cloudwatch.MetricDataQuery {
+	// The expression of the threshold looks like "ANOMALY_DETECTION_BAND(m1, 2)".
+	args := strings.TrimPrefix(aws.StringValue(a.thresholdMetric().Expression), anomalyDetectionBandExpression)
+	id := strings.TrimSpace(strings.SplitN(strings.Trim(args, "()"), ",", 2)[0])
+	for _, m := range a.Metrics {
+		if aws.StringValue(m.Id) == id {
+			return m
+		}
+	}
+	return nil
+}
+
 func humanizePeriod(evaluationPeriod, period int64) string {
 	durationPeriod := time.Duration(evaluationPeriod*period) * time.Second
 	return strings.TrimSpace(humanizeDuration(time.Now(), time.Now().Add(durationPeriod), "", ""))
@@ -6,6 +6,7 @@ package cloudwatch
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -29,6 +30,7 @@ var humanizeDuration = humanize.RelTime
 
 type api interface {
 	DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
+	GetMetricData(input *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error)
 }
 
 type resourceGetter interface {
@@ -59,6 +61,16 @@ type AlarmDescription struct {
 	Environment string `json:"environment"`
 }
 
+// Metric identifies a statistic of a CloudWatch metric to retrieve.
+type Metric struct {
+	ID         string // Unique identifier of the metric in a request, must start with a lowercase letter.
+	Namespace  string
+	Name       string
+	Dimensions map[string]string
+	Stat       string // Statistic to aggregate the datapoints of each period with, such as "Sum" or "p99".
+	Period     time.Duration
+}
+
 // New returns a CloudWatch struct configured against the input session.
 func New(s *session.Session) *CloudWatch {
 	return &CloudWatch{
@@ -225,6 +237,109 @@ func (cw *CloudWatch) metricAlarmsStatus(alarms []*cloudwatch.MetricAlarm) []Ala
 	return alarmStatusList
 }
 
+// LatestMetricValues returns the value of the most recent datapoint between start and end of each metric, keyed by the ID of the metric.
+// Metrics that don't have any datapoint in the time range are omitted.
+func (cw *CloudWatch) LatestMetricValues(metrics []Metric, start, end time.Time) (map[string]float64, error) {
+	if len(metrics) == 0 {
+		return nil, nil
+	}
+	in := metricDataInput(metrics, start, end, cloudwatch.ScanByTimestampDescending)
+	values := make(map[string]float64)
+	for {
+		out, err := cw.client.GetMetricData(in)
+		if err != nil {
+			return nil, fmt.Errorf("get CloudWatch metric data: %w", err)
+		}
+		for _, result := range out.MetricDataResults {
+			id := aws.StringValue(result.Id)
+			if _, ok := values[id]; ok || len(result.Values) == 0 {
+				continue
+			}
+			// Datapoints are sorted from the newest to the oldest.
+			values[id] = aws.Float64Value(result.Values[0])
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	return values, nil
+}
+
+// MetricSeries holds the datapoints of a metric, sorted from the oldest to the newest.
+type MetricSeries struct {
+	Timestamps []time.Time
+	Values     []float64
+}
+
+// MetricValues returns all the datapoints between start and end of each metric, keyed by the ID of the metric.
+// Metrics that don't have any datapoint in the time range are omitted.
+func (cw *CloudWatch) MetricValues(metrics []Metric, start, end time.Time) (map[string]*MetricSeries, error) {
+	if len(metrics) == 0 {
+		return nil, nil
+	}
+	in := metricDataInput(metrics, start, end, cloudwatch.ScanByTimestampAscending)
+	series := make(map[string]*MetricSeries)
+	for {
+		out, err := cw.client.GetMetricData(in)
+		if err != nil {
+			return nil, fmt.Errorf("get CloudWatch metric data: %w", err)
+		}
+		for _, result := range out.MetricDataResults {
+			if len(result.Values) == 0 {
+				continue
+			}
+			id := aws.StringValue(result.Id)
+			if _, ok := series[id]; !ok {
+				series[id] = &MetricSeries{}
+			}
+			// Datapoints are sorted from the oldest to the newest, and the pages of a metric follow each other.
+			series[id].Timestamps = append(series[id].Timestamps, aws.TimeValueSlice(result.Timestamps)...)
+			series[id].Values = append(series[id].Values, aws.Float64ValueSlice(result.Values)...)
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	return series, nil
+}
+
+func metricDataInput(metrics []Metric, start, end time.Time, scanBy string) *cloudwatch.GetMetricDataInput {
+	in := &cloudwatch.GetMetricDataInput{
+		StartTime: aws.Time(start),
+		EndTime:   aws.Time(end),
+		ScanBy:    aws.String(scanBy),
+	}
+	for _, metric := range metrics {
+		in.MetricDataQueries = append(in.MetricDataQueries, &cloudwatch.MetricDataQuery{
+			Id: aws.String(metric.ID),
+			MetricStat: &cloudwatch.MetricStat{
+				Metric: &cloudwatch.Metric{
+					Namespace:  aws.String(metric.Namespace),
+					MetricName: aws.String(metric.Name),
+					Dimensions: metricDimensions(metric.Dimensions),
+				},
+				Stat:   aws.String(metric.Stat),
+				Period: aws.Int64(int64(metric.Period.Seconds())),
+			},
+		})
+	}
+	return in
+}
+
+func metricDimensions(dimensions map[string]string) []*cloudwatch.Dimension {
+	var out []*cloudwatch.Dimension
+	for name, value := range dimensions {
+		out = append(out, &cloudwatch.Dimension{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return aws.StringValue(out[i].Name) < aws.StringValue(out[j].Name) })
+	return out
+}
+
 // getAlarmName gets the alarm name given a specific alarm ARN.
 // For example: arn:aws:cloudwatch:us-west-2:1234567890:alarm:SDc-ReadCapacityUnitsLimit-BasicAlarm
 // returns SDc-ReadCapacityUnitsLimit-BasicAlarm
@@ -276,6 +276,8 @@ func TestCloudWatch_AlarmStatuses(t *testing.T) {
 										ReturnData: aws.Bool(true),
 									},
 								},
+								EvaluationPeriods:     aws.Int64(5),
+								DatapointsToAlarm:     aws.Int64(3),
 								ThresholdMetricId:     aws.String("m2"),
 								StateValue:            aws.String("mockState"),
 								StateUpdatedTimestamp: &mockTime,
@@ -290,7 +292,7 @@ func TestCloudWatch_AlarmStatuses(t *testing.T) {
 					Arn:          mockArn1,
 					Name:         mockName,
 					Type:         "Metric",
-					Condition:    "-",
+					Condition:    "mockMetricName outside the expected band for 3 datapoints within 10 minutes",
 					Status:       "mockState",
 					UpdatedTimes: mockTime,
 				},
@@ -602,3 +604,232 @@ func TestCloudWatch_AlarmDescriptions(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudWatch_LatestMetricValues(t *testing.T) {
+	start := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	metrics := []Metric{
+		{
+			ID:        "requests",
+			Namespace: "AWS/AppRunner",
+			Name:      "Requests",
+			Dimensions: map[string]string{
+				"ServiceName": "mockSvc",
+				"ServiceID":   "mockID",
+			},
+			Stat:   "Sum",
+			Period: time.Hour,
+		},
+		{
+			ID:        "instances",
+			Namespace: "AWS/AppRunner",
+			Name:      "ActiveInstances",
+			Stat:      "Maximum",
+			Period:    5 * time.Minute,
+		},
+	}
+	wantedInput := &cloudwatch.GetMetricDataInput{
+		StartTime: aws.Time(start),
+		EndTime:   aws.Time(end),
+		ScanBy:    aws.String("TimestampDescending"),
+		MetricDataQueries: []*cloudwatch.MetricDataQuery{
+			{
+				Id: aws.String("requests"),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{
+						Namespace:  aws.String("AWS/AppRunner"),
+						MetricName: aws.String("Requests"),
+						Dimensions: []*cloudwatch.Dimension{
+							{Name: aws.String("ServiceID"), Value: aws.String("mockID")},
+							{Name: aws.String("ServiceName"), Value: aws.String("mockSvc")},
+						},
+					},
+					Stat:   aws.String("Sum"),
+					Period: aws.Int64(3600),
+				},
+			},
+			{
+				Id: aws.String("instances"),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{
+						Namespace:  aws.String("AWS/AppRunner"),
+						MetricName: aws.String("ActiveInstances"),
+					},
+					Stat:   aws.String("Maximum"),
+					Period: aws.Int64(300),
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    map[string]float64
+		wantedErr error
+	}{
+		"error if fail to get the metric data": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricData(wantedInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get CloudWatch metric data: some error"),
+		},
+		"return the most recent value of each metric": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricData(wantedInput).Return(&cloudwatch.GetMetricDataOutput{
+					MetricDataResults: []*cloudwatch.MetricDataResult{
+						{
+							Id: aws.String("instances"),
+						},
+						{
+							Id:     aws.String("requests"),
+							Values: aws.Float64Slice([]float64{120}),
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetMetricData(gomock.Any()).DoAndReturn(func(in *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+					require.Equal(t, "next", aws.StringValue(in.NextToken))
+					return &cloudwatch.GetMetricDataOutput{
+						MetricDataResults: []*cloudwatch.MetricDataResult{
+							{
+								Id:     aws.String("instances"),
+								Values: aws.Float64Slice([]float64{3, 2}),
+							},
+							{
+								Id:     aws.String("requests"),
+								Values: aws.Float64Slice([]float64{80}),
+							},
+						},
+					}, nil
+				})
+			},
+			wanted: map[string]float64{
+				"requests":  120,
+				"instances": 3,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			cw := CloudWatch{
+				client: m,
+			}
+
+			// WHEN
+			got, err := cw.LatestMetricValues(metrics, start, end)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestCloudWatch_MetricValues(t *testing.T) {
+	start := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Minute)
+	metrics := []Metric{
+		{
+			ID:        "cpu",
+			Namespace: "AWS/ECS",
+			Name:      "CPUUtilization",
+			Dimensions: map[string]string{
+				"ClusterName": "mockCluster",
+				"ServiceName": "mockSvc",
+			},
+			Stat:   "Average",
+			Period: time.Minute,
+		},
+		{
+			ID:        "memory",
+			Namespace: "AWS/ECS",
+			Name:      "MemoryUtilization",
+			Stat:      "Average",
+			Period:    time.Minute,
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    map[string]*MetricSeries
+		wantedErr error
+	}{
+		"error if fail to get the metric data": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricData(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get CloudWatch metric data: some error"),
+		},
+		"return the datapoints of each metric across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricData(gomock.Any()).DoAndReturn(func(in *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+					require.Equal(t, "TimestampAscending", aws.StringValue(in.ScanBy))
+					require.Len(t, in.MetricDataQueries, 2)
+					return &cloudwatch.GetMetricDataOutput{
+						MetricDataResults: []*cloudwatch.MetricDataResult{
+							{
+								Id:         aws.String("cpu"),
+								Timestamps: aws.TimeSlice([]time.Time{start, start.Add(time.Minute)}),
+								Values:     aws.Float64Slice([]float64{40, 60}),
+							},
+							{
+								Id: aws.String("memory"),
+							},
+						},
+						NextToken: aws.String("next"),
+					}, nil
+				})
+				m.EXPECT().GetMetricData(gomock.Any()).DoAndReturn(func(in *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+					require.Equal(t, "next", aws.StringValue(in.NextToken))
+					return &cloudwatch.GetMetricDataOutput{
+						MetricDataResults: []*cloudwatch.MetricDataResult{
+							{
+								Id:         aws.String("cpu"),
+								Timestamps: aws.TimeSlice([]time.Time{start.Add(2 * time.Minute)}),
+								Values:     aws.Float64Slice([]float64{80}),
+							},
+						},
+					}, nil
+				})
+			},
+			wanted: map[string]*MetricSeries{
+				"cpu": {
+					Timestamps: []time.Time{start, start.Add(time.Minute), start.Add(2 * time.Minute)},
+					Values:     []float64{40, 60, 80},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			cw := CloudWatch{
+				client: m,
+			}
+
+			// WHEN
+			got, err := cw.MetricValues(metrics, start, end)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codedeploy provides a client to make API requests to AWS CodeDeploy.
+package codedeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+)
+
+const (
+	waitDeploymentPollingInterval = 15 * time.Second
+	// A deployment waits for the bake time and for each traffic shift, so it can take much longer than a rolling update.
+	waitDeploymentMaxTry = 480
+)
+
+type api interface {
+	CreateDeployment(input *codedeploy.CreateDeploymentInput) (*codedeploy.CreateDeploymentOutput, error)
+	GetDeployment(input *codedeploy.GetDeploymentInput) (*codedeploy.GetDeploymentOutput, error)
+}
+
+// CodeDeploy wraps an AWS CodeDeploy client.
+type CodeDeploy struct {
+	client api
+
+	maxDeploymentTries   int
+	pollIntervalDuration time.Duration
+}
+
+// New returns a CodeDeploy client configured against the input session.
+func New(s *session.Session) *CodeDeploy {
+	return &CodeDeploy{
+		client:               codedeploy.New(s),
+		maxDeploymentTries:   waitDeploymentMaxTry,
+		pollIntervalDuration: waitDeploymentPollingInterval,
+	}
+}
+
+// ECSDeployment holds the configuration of a deployment of a new task definition to an ECS service.
+type ECSDeployment struct {
+	ApplicationName     string
+	DeploymentGroupName string
+	TaskDefinitionARN   string
+	ContainerName       string // Name of the container that receives the traffic of the load balancer.
+	ContainerPort       uint16
+	Description         string
+}
+
+// DeployECSService starts a deployment that shifts the traffic of the ECS service of the deployment group
+// to tasks running the task definition, and returns the ID of the deployment.
+func (c *CodeDeploy) DeployECSService(in ECSDeployment) (string, error) {
+	appSpec, err := in.appSpec()
+	if err != nil {
+		return "", err
+	}
+	out, err := c.client.CreateDeployment(&codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(in.ApplicationName),
+		DeploymentGroupName: aws.String(in.DeploymentGroupName),
+		Description:         aws.String(in.Description),
+		Revision: &codedeploy.RevisionLocation{
+			RevisionType: aws.String(codedeploy.RevisionLocationTypeAppSpecContent),
+			AppSpecContent: &codedeploy.AppSpecContent{
+				Content: aws.String(appSpec),
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("create deployment for deployment group %s: %w", in.DeploymentGroupName, err)
+	}
+	return aws.StringValue(out.DeploymentId), nil
+}
+
+// WaitForDeployment blocks until the deployment succeeds, and returns ErrDeploymentFailed if it fails or is stopped.
+func (c *CodeDeploy) WaitForDeployment(deploymentID string) error {
+	for tryNum := 0; ; tryNum++ {
+		out, err := c.client.GetDeployment(&codedeploy.GetDeploymentInput{
+			DeploymentId: aws.String(deploymentID),
+		})
+		if err != nil {
+			return fmt.Errorf("get deployment %s: %w", deploymentID, err)
+		}
+		info := out.DeploymentInfo
+		switch status := aws.StringValue(info.Status); status {
+		case codedeploy.DeploymentStatusSucceeded:
+			return nil
+		case codedeploy.DeploymentStatusFailed, codedeploy.DeploymentStatusStopped:
+			e := &ErrDeploymentFailed{
+				ID:         deploymentID,
+				Status:     status,
+				RolledBack: info.RollbackInfo != nil && info.RollbackInfo.RollbackDeploymentId != nil,
+			}
+			if info.ErrorInformation != nil {
+				e.Message = aws.StringValue(info.ErrorInformation.Message)
+			}
+			return e
+		}
+		if tryNum >= c.maxDeploymentTries {
+			return &ErrWaitDeploymentTimeout{
+				ID:         deploymentID,
+				maxRetries: c.maxDeploymentTries,
+			}
+		}
+		time.Sleep(c.pollIntervalDuration)
+	}
+}
+
+type appSpec struct {
+	Version   string                          `json:"version"`
+	Resources []map[string]appSpecECSResource `json:"Resources"`
+}
+
+type appSpecECSResource struct {
+	Type       string `json:"Type"`
+	Properties struct {
+		TaskDefinition   string `json:"TaskDefinition"`
+		LoadBalancerInfo struct {
+			ContainerName string `json:"ContainerName"`
+			ContainerPort uint16 `json:"ContainerPort"`
+		} `json:"LoadBalancerInfo"`
+	} `json:"Properties"`
+}
+
+// appSpec returns the AppSpec file of the deployment.
+// See https://docs.aws.amazon.com/codedeploy/latest/userguide/reference-appspec-file-structure-resources.html#reference-appspec-file-structure-resources-ecs
+func (in ECSDeployment) appSpec() (string, error) {
+	var svc appSpecECSResource
+	svc.Type = "AWS::ECS::Service"
+	svc.Properties.TaskDefinition = in.TaskDefinitionARN
+	svc.Properties.LoadBalancerInfo.ContainerName = in.ContainerName
+	svc.Properties.LoadBalancerInfo.ContainerPort = in.ContainerPort
+	out, err := json.Marshal(appSpec{
+		Version: "0.0",
+		Resources: []map[string]appSpecECSResource{
+			{"TargetService": svc},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal AppSpec: %w", err)
+	}
+	return string(out), nil
+}
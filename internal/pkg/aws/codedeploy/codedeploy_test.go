@@ -0,0 +1,150 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codedeploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeDeploy_DeployECSService(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedID  string
+		wantedErr error
+	}{
+		"starts a deployment of the task definition": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(&codedeploy.CreateDeploymentInput{
+					ApplicationName:     aws.String("phonetool-test-frontend"),
+					DeploymentGroupName: aws.String("phonetool-test-frontend"),
+					Description:         aws.String("Deployed by copilot"),
+					Revision: &codedeploy.RevisionLocation{
+						RevisionType: aws.String(codedeploy.RevisionLocationTypeAppSpecContent),
+						AppSpecContent: &codedeploy.AppSpecContent{
+							Content: aws.String(`{"version":"0.0","Resources":[{"TargetService":{"Type":"AWS::ECS::Service","Properties":{"TaskDefinition":"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:2","LoadBalancerInfo":{"ContainerName":"frontend","ContainerPort":80}}}}]}`),
+						},
+					},
+				}).Return(&codedeploy.CreateDeploymentOutput{DeploymentId: aws.String("d-ABCDEF")}, nil)
+			},
+			wantedID: "d-ABCDEF",
+		},
+		"wraps the error if the deployment can't be created": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("create deployment for deployment group phonetool-test-frontend: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			c := CodeDeploy{client: m}
+
+			// WHEN
+			id, err := c.DeployECSService(ECSDeployment{
+				ApplicationName:     "phonetool-test-frontend",
+				DeploymentGroupName: "phonetool-test-frontend",
+				TaskDefinitionARN:   "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:2",
+				ContainerName:       "frontend",
+				ContainerPort:       80,
+				Description:         "Deployed by copilot",
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCodeDeploy_WaitForDeployment(t *testing.T) {
+	deployment := func(status string) *codedeploy.GetDeploymentOutput {
+		return &codedeploy.GetDeploymentOutput{
+			DeploymentInfo: &codedeploy.DeploymentInfo{Status: aws.String(status)},
+		}
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedErr error
+	}{
+		"waits until the deployment succeeds": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetDeployment(&codedeploy.GetDeploymentInput{
+						DeploymentId: aws.String("d-ABCDEF"),
+					}).Return(deployment(codedeploy.DeploymentStatusInProgress), nil),
+					m.EXPECT().GetDeployment(gomock.Any()).Return(deployment(codedeploy.DeploymentStatusSucceeded), nil),
+				)
+			},
+		},
+		"returns the reason of a deployment stopped by an alarm": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						Status: aws.String(codedeploy.DeploymentStatusStopped),
+						ErrorInformation: &codedeploy.ErrorInformation{
+							Message: aws.String("One or more alarms have been activated"),
+						},
+						RollbackInfo: &codedeploy.RollbackInfo{
+							RollbackDeploymentId: aws.String("d-GHIJKL"),
+						},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("deployment d-ABCDEF is Stopped: One or more alarms have been activated (the deployment was rolled back)"),
+		},
+		"times out if the deployment doesn't complete": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(deployment(codedeploy.DeploymentStatusInProgress), nil).Times(3)
+			},
+			wantedErr: errors.New("deployment d-ABCDEF did not complete: max retries 2 exceeded"),
+		},
+		"wraps the error if the deployment can't be described": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get deployment d-ABCDEF: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			c := CodeDeploy{
+				client:             m,
+				maxDeploymentTries: 2,
+			}
+
+			// WHEN
+			err := c.WaitForDeployment("d-ABCDEF")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codedeploy
+
+import "fmt"
+
+// ErrDeploymentFailed occurs when a deployment fails or is stopped, for example by an alarm.
+type ErrDeploymentFailed struct {
+	ID         string
+	Status     string
+	Message    string
+	RolledBack bool // True if the traffic was shifted back to the original tasks.
+}
+
+func (e *ErrDeploymentFailed) Error() string {
+	msg := fmt.Sprintf("deployment %s is %s", e.ID, e.Status)
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+	if e.RolledBack {
+		msg += " (the deployment was rolled back)"
+	}
+	return msg
+}
+
+// ErrWaitDeploymentTimeout occurs when a deployment doesn't complete within the max number of retries.
+type ErrWaitDeploymentTimeout struct {
+	ID         string
+	maxRetries int
+}
+
+func (e *ErrWaitDeploymentTimeout) Error() string {
+	return fmt.Sprintf("deployment %s did not complete: max retries %v exceeded", e.ID, e.maxRetries)
+}
+
+// Timeout allows ErrWaitDeploymentTimeout to implement a timeout error interface.
+func (e *ErrWaitDeploymentTimeout) Timeout() bool {
+	return true
+}
@@ -24,6 +24,8 @@ type api interface {
 	GetPipelineState(*cp.GetPipelineStateInput) (*cp.GetPipelineStateOutput, error)
 	ListPipelineExecutions(input *cp.ListPipelineExecutionsInput) (*cp.ListPipelineExecutionsOutput, error)
 	RetryStageExecution(input *cp.RetryStageExecutionInput) (*cp.RetryStageExecutionOutput, error)
+	ListActionExecutions(input *cp.ListActionExecutionsInput) (*cp.ListActionExecutionsOutput, error)
+	GetPipelineExecution(input *cp.GetPipelineExecutionInput) (*cp.GetPipelineExecutionOutput, error)
 }
 
 type resourceGetter interface {
@@ -67,6 +69,31 @@ type StageState struct {
 	StageName  string        `json:"stageName"`
 	Actions    []StageAction `json:"actions,omitempty"`
 	Transition string        `json:"transition"`
+	// Revision is the source revision that the stage last deployed successfully, if any.
+	Revision string `json:"revision,omitempty"`
+}
+
+// StageDeployment is a pipeline execution whose deploy actions all succeeded in a stage.
+type StageDeployment struct {
+	PipelineExecutionID string
+	// Actions are the deploy actions of the stage in the order they started.
+	Actions []DeployAction
+}
+
+// DeployAction is an execution of a deploy action of a stage.
+type DeployAction struct {
+	Name   string
+	Region string
+	// Configuration is the configuration of the action resolved for the execution, such as its "StackName".
+	Configuration  map[string]string
+	InputArtifacts []ArtifactLocation
+}
+
+// ArtifactLocation is the location of an input artifact of an action in S3.
+type ArtifactLocation struct {
+	Name   string
+	Bucket string
+	Key    string
 }
 
 // StageAction wraps a CodePipeline stage action.
@@ -226,7 +253,7 @@ func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 func (ss *StageState) HumanString() string {
 	status := ss.AggregateStatus()
 	transition := ss.Transition
-	stageString := fmt.Sprintf("%s\t%s\t%s", ss.StageName, fmtStatus(transition), fmtStatus(status))
+	stageString := fmt.Sprintf("%s\t%s\t%s\t%s", ss.StageName, fmtStatus(transition), fmtStatus(status), fmtRevision(ss.Revision))
 	tree := treeprint.NewWithRoot(stageString)
 	for _, action := range ss.Actions {
 		tree.AddNode(action.humanString())
@@ -292,8 +319,155 @@ func (c *CodePipeline) pipelineExecutionID(pipelineName string) (string, error)
 	return aws.StringValue(output.PipelineExecutionSummaries[0].PipelineExecutionId), nil
 }
 
+// StageDeployments returns up to limit of the most recent successful deployments of each stage, latest first.
+// A deployment is successful if all the deploy actions of the stage succeeded during the pipeline execution.
+func (c *CodePipeline) StageDeployments(pipelineName string, stageNames []string, limit int) (map[string][]StageDeployment, error) {
+	stages := make(map[string]*stageExecutions, len(stageNames))
+	for _, name := range stageNames {
+		stages[name] = &stageExecutions{
+			byID: make(map[string]*stageExecution),
+		}
+	}
+	in := &cp.ListActionExecutionsInput{
+		PipelineName: aws.String(pipelineName),
+		MaxResults:   aws.Int64(actionExecutionsMaxResults),
+	}
+	for {
+		out, err := c.client.ListActionExecutions(in)
+		if err != nil {
+			return nil, fmt.Errorf("list action executions of pipeline %s: %w", pipelineName, err)
+		}
+		// Action executions are listed from the most recent to the oldest.
+		for _, detail := range out.ActionExecutionDetails {
+			stage, ok := stages[aws.StringValue(detail.StageName)]
+			if !ok || detail.Input == nil || detail.Input.ActionTypeId == nil {
+				continue
+			}
+			if aws.StringValue(detail.Input.ActionTypeId.Category) != cp.ActionCategoryDeploy {
+				continue
+			}
+			stage.add(detail)
+		}
+		if out.NextToken == nil || allFound(stages, limit) {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	deployments := make(map[string][]StageDeployment, len(stages))
+	for name, stage := range stages {
+		deployments[name] = successfulDeployments(stage.executions, limit)
+	}
+	return deployments, nil
+}
+
+// SourceRevision returns the revision of the source artifact of a pipeline execution, such as a commit ID.
+func (c *CodePipeline) SourceRevision(pipelineName, executionID string) (string, error) {
+	out, err := c.client.GetPipelineExecution(&cp.GetPipelineExecutionInput{
+		PipelineName:        aws.String(pipelineName),
+		PipelineExecutionId: aws.String(executionID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get execution %s of pipeline %s: %w", executionID, pipelineName, err)
+	}
+	if out.PipelineExecution == nil || len(out.PipelineExecution.ArtifactRevisions) == 0 {
+		return "", fmt.Errorf("no source revision found for execution %s of pipeline %s", executionID, pipelineName)
+	}
+	return aws.StringValue(out.PipelineExecution.ArtifactRevisions[0].RevisionId), nil
+}
+
+// actionExecutionsMaxResults is the maximum page size of ListActionExecutions.
+const actionExecutionsMaxResults = 100
+
+// stageExecutions groups the deploy action executions of a stage by pipeline execution.
+type stageExecutions struct {
+	executions []*stageExecution // From the most recent to the oldest.
+	byID       map[string]*stageExecution
+}
+
+type stageExecution struct {
+	deployment StageDeployment
+	succeeded  bool
+}
+
+func (s *stageExecutions) add(detail *cp.ActionExecutionDetail) {
+	id := aws.StringValue(detail.PipelineExecutionId)
+	exec, ok := s.byID[id]
+	if !ok {
+		exec = &stageExecution{
+			deployment: StageDeployment{PipelineExecutionID: id},
+			succeeded:  true,
+		}
+		s.byID[id] = exec
+		s.executions = append(s.executions, exec)
+	}
+	if aws.StringValue(detail.Status) != cp.ActionExecutionStatusSucceeded {
+		exec.succeeded = false
+	}
+	config := detail.Input.ResolvedConfiguration
+	if len(config) == 0 {
+		config = detail.Input.Configuration
+	}
+	action := DeployAction{
+		Name:          aws.StringValue(detail.ActionName),
+		Region:        aws.StringValue(detail.Input.Region),
+		Configuration: aws.StringValueMap(config),
+	}
+	for _, artifact := range detail.Input.InputArtifacts {
+		if artifact.S3location == nil {
+			continue
+		}
+		action.InputArtifacts = append(action.InputArtifacts, ArtifactLocation{
+			Name:   aws.StringValue(artifact.Name),
+			Bucket: aws.StringValue(artifact.S3location.Bucket),
+			Key:    aws.StringValue(artifact.S3location.Key),
+		})
+	}
+	// Prepend the action since the older executions are listed last.
+	exec.deployment.Actions = append([]DeployAction{action}, exec.deployment.Actions...)
+}
+
+// successfulDeployments returns up to limit deployments of the executions that succeeded.
+func successfulDeployments(executions []*stageExecution, limit int) []StageDeployment {
+	var deployments []StageDeployment
+	for _, exec := range executions {
+		if len(deployments) == limit {
+			break
+		}
+		if exec.succeeded {
+			deployments = append(deployments, exec.deployment)
+		}
+	}
+	return deployments
+}
+
+// allFound returns true if each stage has at least limit successful deployments whose actions are all listed.
+func allFound(stages map[string]*stageExecutions, limit int) bool {
+	for _, stage := range stages {
+		if len(stage.executions) == 0 {
+			return false
+		}
+		// The actions of the oldest listed execution may continue on the next page.
+		if len(successfulDeployments(stage.executions[:len(stage.executions)-1], limit)) < limit {
+			return false
+		}
+	}
+	return true
+}
+
 func (sa StageAction) humanString() string {
-	return sa.Name + "\t\t" + fmtStatus(sa.Status)
+	return sa.Name + "\t\t" + fmtStatus(sa.Status) + "\t"
+}
+
+// fmtRevision shortens commit IDs to the length that git abbreviates them to.
+func fmtRevision(revision string) string {
+	const shortCommitIDLength = 7
+	if revision == "" {
+		return "  -"
+	}
+	if len(revision) == 40 {
+		return revision[:shortCommitIDLength]
+	}
+	return revision
 }
 
 func fmtStatus(status string) string {
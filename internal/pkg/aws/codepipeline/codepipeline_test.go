@@ -579,3 +579,220 @@ func TestCodePipeline_RetryStageExecution(t *testing.T) {
 		})
 	}
 }
+
+func TestCodePipeline_StageDeployments(t *testing.T) {
+	const mockPipelineName = "pipeline-dinder-badgoose-repo"
+	mockErr := errors.New("some error")
+	deployActionExecution := func(execID, stage, action, status string) *codepipeline.ActionExecutionDetail {
+		return &codepipeline.ActionExecutionDetail{
+			ActionName:          aws.String(action),
+			PipelineExecutionId: aws.String(execID),
+			StageName:           aws.String(stage),
+			Status:              aws.String(status),
+			Input: &codepipeline.ActionExecutionInput{
+				ActionTypeId: &codepipeline.ActionTypeId{
+					Category: aws.String(codepipeline.ActionCategoryDeploy),
+				},
+				Region: aws.String("us-west-2"),
+				ResolvedConfiguration: map[string]*string{
+					"StackName": aws.String("dinder-prod-" + action),
+				},
+				InputArtifacts: []*codepipeline.ArtifactDetail{
+					{
+						Name: aws.String("BuildOutput"),
+						S3location: &codepipeline.S3Location{
+							Bucket: aws.String("bucket"),
+							Key:    aws.String(execID + "/BuildOutput"),
+						},
+					},
+				},
+			},
+		}
+	}
+	deployAction := func(execID, action string) DeployAction {
+		return DeployAction{
+			Name:   action,
+			Region: "us-west-2",
+			Configuration: map[string]string{
+				"StackName": "dinder-prod-" + action,
+			},
+			InputArtifacts: []ArtifactLocation{
+				{
+					Name:   "BuildOutput",
+					Bucket: "bucket",
+					Key:    execID + "/BuildOutput",
+				},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wanted      map[string][]StageDeployment
+		wantedError error
+	}{
+		"returns wrapped error if ListActionExecutions fails": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListActionExecutions(gomock.Any()).Return(nil, mockErr)
+			},
+			wantedError: errors.New("list action executions of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns the latest successful deployments and stops listing once they are complete": {
+			callMocks: func(m codepipelineMocks) {
+				testAction := deployActionExecution("exec-3", "DeployTo-prod", "TestCommands", codepipeline.ActionExecutionStatusSucceeded)
+				testAction.Input.ActionTypeId.Category = aws.String(codepipeline.ActionCategoryTest)
+				m.cp.EXPECT().ListActionExecutions(&codepipeline.ListActionExecutionsInput{
+					PipelineName: aws.String(mockPipelineName),
+					MaxResults:   aws.Int64(100),
+				}).Return(&codepipeline.ListActionExecutionsOutput{
+					ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+						deployActionExecution("exec-4", "DeployTo-prod", "svc", codepipeline.ActionExecutionStatusFailed),
+						testAction,
+						deployActionExecution("exec-3", "DeployTo-prod", "job", codepipeline.ActionExecutionStatusSucceeded),
+						deployActionExecution("exec-3", "DeployTo-prod", "svc", codepipeline.ActionExecutionStatusSucceeded),
+						deployActionExecution("exec-3", "DeployTo-test", "svc", codepipeline.ActionExecutionStatusSucceeded),
+						deployActionExecution("exec-2", "DeployTo-prod", "svc", codepipeline.ActionExecutionStatusSucceeded),
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.cp.EXPECT().ListActionExecutions(&codepipeline.ListActionExecutionsInput{
+					PipelineName: aws.String(mockPipelineName),
+					MaxResults:   aws.Int64(100),
+					NextToken:    aws.String("token"),
+				}).Return(&codepipeline.ListActionExecutionsOutput{
+					ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+						deployActionExecution("exec-2", "DeployTo-prod", "job", codepipeline.ActionExecutionStatusSucceeded),
+						deployActionExecution("exec-1", "DeployTo-prod", "svc", codepipeline.ActionExecutionStatusSucceeded),
+					},
+					NextToken: aws.String("another token"),
+				}, nil)
+			},
+			wanted: map[string][]StageDeployment{
+				"DeployTo-prod": {
+					{
+						PipelineExecutionID: "exec-3",
+						Actions:             []DeployAction{deployAction("exec-3", "svc"), deployAction("exec-3", "job")},
+					},
+					{
+						PipelineExecutionID: "exec-2",
+						Actions:             []DeployAction{deployAction("exec-2", "job"), deployAction("exec-2", "svc")},
+					},
+				},
+			},
+		},
+		"returns fewer deployments if the stage wasn't deployed enough times": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListActionExecutions(gomock.Any()).Return(&codepipeline.ListActionExecutionsOutput{
+					ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+						deployActionExecution("exec-2", "DeployTo-prod", "svc", codepipeline.ActionExecutionStatusInProgress),
+						deployActionExecution("exec-1", "DeployTo-prod", "svc", codepipeline.ActionExecutionStatusSucceeded),
+					},
+				}, nil)
+			},
+			wanted: map[string][]StageDeployment{
+				"DeployTo-prod": {
+					{
+						PipelineExecutionID: "exec-1",
+						Actions:             []DeployAction{deployAction("exec-1", "svc")},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			deployments, err := cp.StageDeployments(mockPipelineName, []string{"DeployTo-prod"}, 2)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, deployments)
+			}
+		})
+	}
+}
+
+func TestCodePipeline_SourceRevision(t *testing.T) {
+	const (
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+		mockExecutionID  = "exec-1"
+	)
+	tests := map[string]struct {
+		out *codepipeline.GetPipelineExecutionOutput
+		err error
+
+		wanted      string
+		wantedError error
+	}{
+		"returns wrapped error if GetPipelineExecution fails": {
+			err:         errors.New("some error"),
+			wantedError: errors.New("get execution exec-1 of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns an error if the execution has no artifact revision": {
+			out: &codepipeline.GetPipelineExecutionOutput{
+				PipelineExecution: &codepipeline.PipelineExecution{},
+			},
+			wantedError: errors.New("no source revision found for execution exec-1 of pipeline pipeline-dinder-badgoose-repo"),
+		},
+		"returns the revision of the source artifact": {
+			out: &codepipeline.GetPipelineExecutionOutput{
+				PipelineExecution: &codepipeline.PipelineExecution{
+					ArtifactRevisions: []*codepipeline.ArtifactRevision{
+						{
+							Name:       aws.String("SCCheckoutArtifact"),
+							RevisionId: aws.String("b8a6e2c0f1d4a3b5c6d7e8f90a1b2c3d4e5f6a7b"),
+						},
+					},
+				},
+			},
+			wanted: "b8a6e2c0f1d4a3b5c6d7e8f90a1b2c3d4e5f6a7b",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			mockClient.EXPECT().GetPipelineExecution(&codepipeline.GetPipelineExecutionInput{
+				PipelineName:        aws.String(mockPipelineName),
+				PipelineExecutionId: aws.String(mockExecutionID),
+			}).Return(tc.out, tc.err)
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			revision, err := cp.SourceRevision(mockPipelineName, mockExecutionID)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, revision)
+			}
+		})
+	}
+}
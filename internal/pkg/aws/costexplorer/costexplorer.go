@@ -0,0 +1,105 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package costexplorer provides a client to make API requests to AWS Cost Explorer.
+package costexplorer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+)
+
+const (
+	// Cost Explorer is a global service whose API is served from us-east-1.
+	apiRegion = "us-east-1"
+
+	costMetric = "UnblendedCost"
+	dateLayout = "2006-01-02"
+)
+
+type api interface {
+	GetCostAndUsage(input *costexplorer.GetCostAndUsageInput) (*costexplorer.GetCostAndUsageOutput, error)
+}
+
+// Cost is an amount spent on AWS resources.
+type Cost struct {
+	Amount float64
+	Unit   string
+}
+
+// CostExplorer wraps an AWS Cost Explorer client.
+type CostExplorer struct {
+	client api
+}
+
+// New returns a CostExplorer struct configured against the input session, which calls the API in us-east-1.
+func New(s *session.Session) *CostExplorer {
+	return &CostExplorer{
+		client: costexplorer.New(s, aws.NewConfig().WithRegion(apiRegion)),
+	}
+}
+
+// CostsByTag returns the cost of the resources tagged with filterKey=filterValue between the start and end dates,
+// grouped by the value of their groupKey tag. The cost of the resources without the groupKey tag is under an empty key.
+// Tags must be activated as cost allocation tags for Cost Explorer to group costs by them.
+func (c *CostExplorer) CostsByTag(filterKey, filterValue, groupKey string, start, end time.Time) (map[string]Cost, error) {
+	in := &costexplorer.GetCostAndUsageInput{
+		TimePeriod: &costexplorer.DateInterval{
+			Start: aws.String(start.Format(dateLayout)),
+			End:   aws.String(end.Format(dateLayout)),
+		},
+		Granularity: aws.String(costexplorer.GranularityMonthly),
+		Metrics:     aws.StringSlice([]string{costMetric}),
+		Filter: &costexplorer.Expression{
+			Tags: &costexplorer.TagValues{
+				Key:    aws.String(filterKey),
+				Values: aws.StringSlice([]string{filterValue}),
+			},
+		},
+		GroupBy: []*costexplorer.GroupDefinition{
+			{
+				Type: aws.String(costexplorer.GroupDefinitionTypeTag),
+				Key:  aws.String(groupKey),
+			},
+		},
+	}
+	costs := make(map[string]Cost)
+	for {
+		out, err := c.client.GetCostAndUsage(in)
+		if err != nil {
+			return nil, fmt.Errorf("get cost and usage grouped by tag %s: %w", groupKey, err)
+		}
+		for _, result := range out.ResultsByTime {
+			for _, group := range result.Groups {
+				if len(group.Keys) == 0 {
+					continue
+				}
+				metric, ok := group.Metrics[costMetric]
+				if !ok {
+					continue
+				}
+				amount, err := strconv.ParseFloat(aws.StringValue(metric.Amount), 64)
+				if err != nil {
+					return nil, fmt.Errorf("parse cost amount %q: %w", aws.StringValue(metric.Amount), err)
+				}
+				// Keys of tag groups are formatted as "<tag key>$<tag value>".
+				value := strings.TrimPrefix(aws.StringValue(group.Keys[0]), groupKey+"$")
+				cost := costs[value]
+				cost.Amount += amount
+				cost.Unit = aws.StringValue(metric.Unit)
+				costs[value] = cost
+			}
+		}
+		if out.NextPageToken == nil {
+			break
+		}
+		in.NextPageToken = out.NextPageToken
+	}
+	return costs, nil
+}
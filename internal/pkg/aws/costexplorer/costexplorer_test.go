@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package costexplorer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostExplorer_CostsByTag(t *testing.T) {
+	start := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	wantedInput := func(token *string) *costexplorer.GetCostAndUsageInput {
+		return &costexplorer.GetCostAndUsageInput{
+			TimePeriod: &costexplorer.DateInterval{
+				Start: aws.String("2023-05-01"),
+				End:   aws.String("2023-06-01"),
+			},
+			Granularity: aws.String("MONTHLY"),
+			Metrics:     aws.StringSlice([]string{"UnblendedCost"}),
+			Filter: &costexplorer.Expression{
+				Tags: &costexplorer.TagValues{
+					Key:    aws.String("copilot-application"),
+					Values: aws.StringSlice([]string{"phonetool"}),
+				},
+			},
+			GroupBy: []*costexplorer.GroupDefinition{
+				{Type: aws.String("TAG"), Key: aws.String("copilot-environment")},
+			},
+			NextPageToken: token,
+		}
+	}
+	group := func(key, amount string) *costexplorer.Group {
+		return &costexplorer.Group{
+			Keys: aws.StringSlice([]string{key}),
+			Metrics: map[string]*costexplorer.MetricValue{
+				"UnblendedCost": {Amount: aws.String(amount), Unit: aws.String("USD")},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    map[string]Cost
+		wantedErr error
+	}{
+		"error if the costs can't be retrieved": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostAndUsage(wantedInput(nil)).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get cost and usage grouped by tag copilot-environment: some error"),
+		},
+		"error if an amount is not a number": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostAndUsage(wantedInput(nil)).Return(&costexplorer.GetCostAndUsageOutput{
+					ResultsByTime: []*costexplorer.ResultByTime{
+						{Groups: []*costexplorer.Group{group("copilot-environment$test", "abc")}},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New(`parse cost amount "abc": strconv.ParseFloat: parsing "abc": invalid syntax`),
+		},
+		"sums the costs of each tag value across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetCostAndUsage(wantedInput(nil)).Return(&costexplorer.GetCostAndUsageOutput{
+					ResultsByTime: []*costexplorer.ResultByTime{
+						{Groups: []*costexplorer.Group{group("copilot-environment$test", "1.5"), group("copilot-environment$", "0.25")}},
+					},
+					NextPageToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetCostAndUsage(wantedInput(aws.String("next"))).Return(&costexplorer.GetCostAndUsageOutput{
+					ResultsByTime: []*costexplorer.ResultByTime{
+						{Groups: []*costexplorer.Group{group("copilot-environment$test", "2"), group("copilot-environment$prod", "10")}},
+					},
+				}, nil)
+			},
+			wanted: map[string]Cost{
+				"test": {Amount: 3.5, Unit: "USD"},
+				"prod": {Amount: 10, Unit: "USD"},
+				"":     {Amount: 0.25, Unit: "USD"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			ce := CostExplorer{client: m}
+
+			got, err := ce.CostsByTag("copilot-application", "phonetool", "copilot-environment", start, end)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
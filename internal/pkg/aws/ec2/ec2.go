@@ -5,10 +5,12 @@
 package ec2
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 )
@@ -18,6 +20,8 @@ const (
 	internetGatewayIDPrefix  = "igw-"
 	cloudFrontPrefixListName = "com.amazonaws.global.cloudfront.origin-facing"
 
+	networkInterfaceNotFoundErrCode = "InvalidNetworkInterfaceID.NotFound"
+
 	// FmtTagFilter is the filter name format for tag filters
 	FmtTagFilter = "tag:%s"
 	tagKeyFilter = "tag-key"
@@ -40,6 +44,8 @@ type api interface {
 	DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error)
 	DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
 	DescribeManagedPrefixLists(input *ec2.DescribeManagedPrefixListsInput) (*ec2.DescribeManagedPrefixListsOutput, error)
+	TerminateInstances(input *ec2.TerminateInstancesInput) (*ec2.TerminateInstancesOutput, error)
+	DeleteNetworkInterface(input *ec2.DeleteNetworkInterfaceInput) (*ec2.DeleteNetworkInterfaceOutput, error)
 }
 
 // Filter contains the name and values of a filter.
@@ -88,7 +94,9 @@ type VPC struct {
 // Subnet contains the ID and name of a subnet.
 type Subnet struct {
 	Resource
-	CIDRBlock string
+	CIDRBlock        string
+	AvailabilityZone string
+	AvailableIPs     int // The number of available IPv4 addresses.
 }
 
 // AZ represents an availability zone.
@@ -165,6 +173,55 @@ func (c *EC2) PublicIP(eni string) (string, error) {
 	return aws.StringValue(association.PublicIp), nil
 }
 
+// TerminateInstances terminates the EC2 instances with the given IDs.
+func (c *EC2) TerminateInstances(ids ...string) error {
+	if _, err := c.client.TerminateInstances(&ec2.TerminateInstancesInput{
+		InstanceIds: aws.StringSlice(ids),
+	}); err != nil {
+		return fmt.Errorf("terminate instances %s: %w", strings.Join(ids, ", "), err)
+	}
+	return nil
+}
+
+// DeleteDetachedNetworkInterfaces deletes the network interfaces that match the filters and aren't attached
+// to any resource, and returns their IDs.
+// Services such as AWS Lambda release their network interfaces some time after their resources are deleted,
+// and until then the interfaces prevent their subnets and security groups from being deleted.
+func (c *EC2) DeleteDetachedNetworkInterfaces(filters ...Filter) ([]string, error) {
+	inputFilters := toEC2Filter(append(filters, Filter{
+		Name:   "status",
+		Values: []string{ec2.NetworkInterfaceStatusAvailable},
+	}))
+	var ids []string
+	var nextToken *string
+	for {
+		response, err := c.client.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+			Filters:   inputFilters,
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe network interfaces: %w", err)
+		}
+		for _, eni := range response.NetworkInterfaces {
+			ids = append(ids, aws.StringValue(eni.NetworkInterfaceId))
+		}
+		nextToken = response.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	for _, id := range ids {
+		_, err := c.client.DeleteNetworkInterface(&ec2.DeleteNetworkInterfaceInput{
+			NetworkInterfaceId: aws.String(id),
+		})
+		var aerr awserr.Error
+		if err != nil && !(errors.As(err, &aerr) && aerr.Code() == networkInterfaceNotFoundErrCode) {
+			return nil, fmt.Errorf("delete network interface %s: %w", id, err)
+		}
+	}
+	return ids, nil
+}
+
 // ListVPCs returns names and IDs (or just IDs, if Name tag does not exist) of all VPCs.
 func (c *EC2) ListVPCs() ([]VPC, error) {
 	var ec2vpcs []*ec2.Vpc
@@ -277,7 +334,9 @@ func (c *EC2) ListVPCSubnets(vpcID string) (*VPCSubnets, error) {
 				ID:   aws.StringValue(subnet.SubnetId),
 				Name: name,
 			},
-			CIDRBlock: aws.StringValue(subnet.CidrBlock),
+			CIDRBlock:        aws.StringValue(subnet.CidrBlock),
+			AvailabilityZone: aws.StringValue(subnet.AvailabilityZone),
+			AvailableIPs:     int(aws.Int64Value(subnet.AvailableIpAddressCount)),
 		}
 		if rtIndex.IsPublicSubnet(s.ID) {
 			publicSubnets = append(publicSubnets, s)
@@ -305,6 +364,22 @@ func (c *EC2) SubnetIDs(filters ...Filter) ([]string, error) {
 	return subnetIDs, nil
 }
 
+// AvailableIPs returns the number of available IPv4 addresses of each subnet, keyed by subnet ID.
+func (c *EC2) AvailableIPs(subnetIDs ...string) (map[string]int, error) {
+	subnets, err := c.subnets(Filter{
+		Name:   "subnet-id",
+		Values: subnetIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ips := make(map[string]int, len(subnets))
+	for _, subnet := range subnets {
+		ips[aws.StringValue(subnet.SubnetId)] = int(aws.Int64Value(subnet.AvailableIpAddressCount))
+	}
+	return ips, nil
+}
+
 // SecurityGroups finds the security group IDs with optional filters.
 func (c *EC2) SecurityGroups(filters ...Filter) ([]string, error) {
 	inputFilters := toEC2Filter(filters)
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2/mocks"
 	"github.com/golang/mock/gomock"
@@ -535,8 +536,10 @@ func TestEC2_ListVPCSubnets(t *testing.T) {
 							CidrBlock: aws.String("10.0.0.0/24"),
 						},
 						{
-							SubnetId:  aws.String("subnet2"),
-							CidrBlock: aws.String("10.0.1.0/24"),
+							SubnetId:                aws.String("subnet2"),
+							CidrBlock:               aws.String("10.0.1.0/24"),
+							AvailabilityZone:        aws.String("us-west-2a"),
+							AvailableIpAddressCount: aws.Int64(240),
 						},
 						{
 							SubnetId: aws.String("subnet3"),
@@ -556,7 +559,9 @@ func TestEC2_ListVPCSubnets(t *testing.T) {
 					Resource: Resource{
 						ID: "subnet2",
 					},
-					CIDRBlock: "10.0.1.0/24",
+					CIDRBlock:        "10.0.1.0/24",
+					AvailabilityZone: "us-west-2a",
+					AvailableIPs:     240,
 				},
 				{
 					Resource: Resource{
@@ -898,6 +903,73 @@ func TestEC2_SubnetIDs(t *testing.T) {
 	}
 }
 
+func TestEC2_AvailableIPs(t *testing.T) {
+	subnetFilter := []*ec2.Filter{
+		{
+			Name:   aws.String("subnet-id"),
+			Values: aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedIPs   map[string]int
+	}{
+		"failed to get subnets": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+					Filters: subnetFilter,
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe subnets: some error"),
+		},
+		"successfully get the available IPs of each subnet": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+					Filters: subnetFilter,
+				}).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{
+							SubnetId:                aws.String("subnet-1"),
+							AvailableIpAddressCount: aws.Int64(250),
+						},
+						{
+							SubnetId:                aws.String("subnet-2"),
+							AvailableIpAddressCount: aws.Int64(0),
+						},
+					},
+				}, nil)
+			},
+			wantedIPs: map[string]int{
+				"subnet-1": 250,
+				"subnet-2": 0,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			ips, err := ec2Client.AvailableIPs("subnet-1", "subnet-2")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedIPs, ips)
+			}
+		})
+	}
+}
+
 func TestEC2_SecurityGroups(t *testing.T) {
 	testCases := map[string]struct {
 		inFilter []Filter
@@ -1011,3 +1083,130 @@ func TestEC2_HasDNSSupport(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2_TerminateInstances(t *testing.T) {
+	testCases := map[string]struct {
+		inIDs         []string
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedErr error
+	}{
+		"failed to terminate instances": {
+			inIDs: []string{"i-1", "i-2"},
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().TerminateInstances(&ec2.TerminateInstancesInput{
+					InstanceIds: aws.StringSlice([]string{"i-1", "i-2"}),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("terminate instances i-1, i-2: some error"),
+		},
+		"successfully terminate instances": {
+			inIDs: []string{"i-1"},
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().TerminateInstances(&ec2.TerminateInstancesInput{
+					InstanceIds: aws.StringSlice([]string{"i-1"}),
+				}).Return(&ec2.TerminateInstancesOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			err := ec2Client.TerminateInstances(tc.inIDs...)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEC2_DeleteDetachedNetworkInterfaces(t *testing.T) {
+	inFilters := []*ec2.Filter{
+		{
+			Name:   aws.String("group-id"),
+			Values: aws.StringSlice([]string{"sg-1"}),
+		},
+		{
+			Name:   aws.String("status"),
+			Values: aws.StringSlice([]string{"available"}),
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedIDs []string
+		wantedErr error
+	}{
+		"failed to describe network interfaces": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeNetworkInterfaces(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe network interfaces: some error"),
+		},
+		"failed to delete a network interface": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeNetworkInterfaces(gomock.Any()).Return(&ec2.DescribeNetworkInterfacesOutput{
+					NetworkInterfaces: []*ec2.NetworkInterface{{NetworkInterfaceId: aws.String("eni-1")}},
+				}, nil)
+				m.EXPECT().DeleteNetworkInterface(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("delete network interface eni-1: some error"),
+		},
+		"deletes the detached network interfaces of every page": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+					Filters: inFilters,
+				}).Return(&ec2.DescribeNetworkInterfacesOutput{
+					NetworkInterfaces: []*ec2.NetworkInterface{{NetworkInterfaceId: aws.String("eni-1")}},
+					NextToken:         aws.String("token"),
+				}, nil)
+				m.EXPECT().DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+					Filters:   inFilters,
+					NextToken: aws.String("token"),
+				}).Return(&ec2.DescribeNetworkInterfacesOutput{
+					NetworkInterfaces: []*ec2.NetworkInterface{{NetworkInterfaceId: aws.String("eni-2")}},
+				}, nil)
+				m.EXPECT().DeleteNetworkInterface(&ec2.DeleteNetworkInterfaceInput{
+					NetworkInterfaceId: aws.String("eni-1"),
+				}).Return(&ec2.DeleteNetworkInterfaceOutput{}, nil)
+				m.EXPECT().DeleteNetworkInterface(&ec2.DeleteNetworkInterfaceInput{
+					NetworkInterfaceId: aws.String("eni-2"),
+				}).Return(nil, awserr.New("InvalidNetworkInterfaceID.NotFound", "already deleted", nil))
+			},
+			wantedIDs: []string{"eni-1", "eni-2"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			ids, err := ec2Client.DeleteDetachedNetworkInterfaces(Filter{
+				Name:   "group-id",
+				Values: []string{"sg-1"},
+			})
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedIDs, ids)
+			}
+		})
+	}
+}
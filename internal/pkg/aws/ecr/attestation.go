@@ -0,0 +1,303 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecr
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecr"
+)
+
+// Media types of the OCI artifacts that hold attestations.
+const (
+	// InTotoMediaType is the media type of an in-toto statement.
+	InTotoMediaType = "application/vnd.in-toto+json"
+
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	ociEmptyMediaType    = "application/vnd.oci.empty.v1+json"
+)
+
+// ociEmptyConfig is the config of OCI artifacts that don't have one.
+var ociEmptyConfig = []byte("{}")
+
+// imageURIPattern matches the URI of an image in ECR, for example 123456789012.dkr.ecr.us-west-2.amazonaws.com/app/api:latest.
+var imageURIPattern = regexp.MustCompile(`^(\d{12})\.dkr\.ecr\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?/([^:@]+)(?::([^@]+))?(?:@(sha256:[a-f0-9]{64}))?$`)
+
+// ImageRef identifies an image in an ECR repository.
+type ImageRef struct {
+	RegistryID string // Optional. Account ID of the registry, defaults to the account of the credentials.
+	Repository string
+	Tag        string // Optional if the digest is specified.
+	Digest     string // Optional if the tag is specified.
+}
+
+// ParseImageURI returns the image that the ECR image URI refers to along with the region of its repository.
+func ParseImageURI(uri string) (img ImageRef, region string, err error) {
+	matches := imageURIPattern.FindStringSubmatch(uri)
+	if matches == nil {
+		return ImageRef{}, "", fmt.Errorf("image %s is not in an Amazon ECR repository", uri)
+	}
+	img = ImageRef{
+		RegistryID: matches[1],
+		Repository: matches[3],
+		Tag:        matches[4],
+		Digest:     matches[5],
+	}
+	if img.Tag == "" && img.Digest == "" {
+		img.Tag = "latest"
+	}
+	return img, matches[2], nil
+}
+
+// AttestationTag returns the tag of the attestation of the image with the digest.
+func AttestationTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1) + ".att"
+}
+
+// ErrAttestationNotFound means that the image doesn't have an attestation in its repository.
+type ErrAttestationNotFound struct {
+	Repository string
+	Digest     string
+}
+
+func (e *ErrAttestationNotFound) Error() string {
+	return fmt.Sprintf("image %s in repository %s does not have an attestation", e.Digest, e.Repository)
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+type ociManifest struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	MediaType     string          `json:"mediaType"`
+	ArtifactType  string          `json:"artifactType,omitempty"`
+	Config        ociDescriptor   `json:"config"`
+	Layers        []ociDescriptor `json:"layers"`
+	Subject       *ociDescriptor  `json:"subject,omitempty"`
+}
+
+// ImageDigest returns the digest of the image, resolving its tag if the digest isn't specified.
+// Tags of a registry resolved within the last few minutes are read from the cache.
+func (c ECR) ImageDigest(img ImageRef) (string, error) {
+	if img.Digest != "" {
+		return img.Digest, nil
+	}
+	var key string
+	if img.RegistryID != "" {
+		key = fmt.Sprintf("%s:%s", fmt.Sprintf(urlFmtString, img.RegistryID, c.region, img.Repository), img.Tag)
+		if digest, ok := c.cache.Digest(key); ok {
+			return digest, nil
+		}
+	}
+	image, err := c.getImage(img, &ecr.ImageIdentifier{ImageTag: aws.String(img.Tag)})
+	if err != nil {
+		return "", err
+	}
+	digest := aws.StringValue(image.ImageId.ImageDigest)
+	if key != "" {
+		c.cache.SetDigest(key, digest)
+	}
+	return digest, nil
+}
+
+// PutAttestation stores the in-toto statement as an OCI artifact that refers to the image with the digest.
+// The artifact is tagged with AttestationTag so that it can be found from the digest of the image.
+func (c ECR) PutAttestation(img ImageRef, statement []byte) error {
+	subject, err := c.getImage(img, &ecr.ImageIdentifier{ImageDigest: aws.String(img.Digest)})
+	if err != nil {
+		return err
+	}
+	configDigest, err := c.uploadBlob(img, ociEmptyConfig)
+	if err != nil {
+		return fmt.Errorf("upload config of the attestation: %w", err)
+	}
+	layerDigest, err := c.uploadBlob(img, statement)
+	if err != nil {
+		return fmt.Errorf("upload attestation: %w", err)
+	}
+	manifest, err := json.Marshal(ociManifest{
+		SchemaVersion: 2,
+		MediaType:     ociManifestMediaType,
+		ArtifactType:  InTotoMediaType,
+		Config: ociDescriptor{
+			MediaType: ociEmptyMediaType,
+			Digest:    configDigest,
+			Size:      int64(len(ociEmptyConfig)),
+		},
+		Layers: []ociDescriptor{
+			{
+				MediaType: InTotoMediaType,
+				Digest:    layerDigest,
+				Size:      int64(len(statement)),
+			},
+		},
+		Subject: &ociDescriptor{
+			MediaType: aws.StringValue(subject.ImageManifestMediaType),
+			Digest:    img.Digest,
+			Size:      int64(len(aws.StringValue(subject.ImageManifest))),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal attestation manifest: %w", err)
+	}
+	_, err = c.client.PutImage(&ecr.PutImageInput{
+		RegistryId:             registryID(img),
+		RepositoryName:         aws.String(img.Repository),
+		ImageManifest:          aws.String(string(manifest)),
+		ImageManifestMediaType: aws.String(ociManifestMediaType),
+		ImageTag:               aws.String(AttestationTag(img.Digest)),
+	})
+	if err != nil && !isErrCode(err, ecr.ErrCodeImageAlreadyExistsException) {
+		return fmt.Errorf("put attestation of image %s in repository %s: %w", img.Digest, img.Repository, err)
+	}
+	return nil
+}
+
+// Attestation returns the in-toto statement that is attested for the image with the digest.
+func (c ECR) Attestation(img ImageRef) ([]byte, error) {
+	artifact, err := c.getImage(img, &ecr.ImageIdentifier{ImageTag: aws.String(AttestationTag(img.Digest))})
+	if err != nil {
+		if isErrCode(err, ecr.ErrCodeImageNotFoundException) {
+			return nil, &ErrAttestationNotFound{
+				Repository: img.Repository,
+				Digest:     img.Digest,
+			}
+		}
+		return nil, err
+	}
+	var manifest ociManifest
+	if err := json.Unmarshal([]byte(aws.StringValue(artifact.ImageManifest)), &manifest); err != nil {
+		return nil, fmt.Errorf("unmarshal attestation manifest of image %s: %w", img.Digest, err)
+	}
+	if manifest.Subject == nil || manifest.Subject.Digest != img.Digest || len(manifest.Layers) != 1 || manifest.Layers[0].MediaType != InTotoMediaType {
+		return nil, fmt.Errorf("artifact %s in repository %s is not an in-toto attestation of image %s", AttestationTag(img.Digest), img.Repository, img.Digest)
+	}
+	layer := manifest.Layers[0]
+	out, err := c.client.GetDownloadUrlForLayer(&ecr.GetDownloadUrlForLayerInput{
+		RegistryId:     registryID(img),
+		RepositoryName: aws.String(img.Repository),
+		LayerDigest:    aws.String(layer.Digest),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get download URL of attestation %s: %w", layer.Digest, err)
+	}
+	statement, err := c.download(aws.StringValue(out.DownloadUrl))
+	if err != nil {
+		return nil, fmt.Errorf("download attestation %s: %w", layer.Digest, err)
+	}
+	if blobDigest(statement) != layer.Digest {
+		return nil, fmt.Errorf("digest of the downloaded attestation does not match %s", layer.Digest)
+	}
+	return statement, nil
+}
+
+func (c ECR) getImage(img ImageRef, id *ecr.ImageIdentifier) (*ecr.Image, error) {
+	out, err := c.client.BatchGetImage(&ecr.BatchGetImageInput{
+		RegistryId:         registryID(img),
+		RepositoryName:     aws.String(img.Repository),
+		ImageIds:           []*ecr.ImageIdentifier{id},
+		AcceptedMediaTypes: aws.StringSlice([]string{ociManifestMediaType, "application/vnd.oci.image.index.v1+json", "application/vnd.docker.distribution.manifest.v2+json", "application/vnd.docker.distribution.manifest.list.v2+json"}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get image %s from repository %s: %w", imageIDString(id), img.Repository, err)
+	}
+	if len(out.Images) == 0 {
+		code, reason := ecr.ErrCodeImageNotFoundException, "image not found"
+		if len(out.Failures) > 0 {
+			reason = aws.StringValue(out.Failures[0].FailureReason)
+		}
+		return nil, fmt.Errorf("get image %s from repository %s: %w", imageIDString(id), img.Repository, awserr.New(code, reason, nil))
+	}
+	return out.Images[0], nil
+}
+
+// uploadBlob uploads the blob as a layer of the repository if it doesn't exist yet, and returns its digest.
+func (c ECR) uploadBlob(img ImageRef, blob []byte) (string, error) {
+	digest := blobDigest(blob)
+	availability, err := c.client.BatchCheckLayerAvailability(&ecr.BatchCheckLayerAvailabilityInput{
+		RegistryId:     registryID(img),
+		RepositoryName: aws.String(img.Repository),
+		LayerDigests:   aws.StringSlice([]string{digest}),
+	})
+	if err != nil {
+		return "", fmt.Errorf("check availability of layer %s: %w", digest, err)
+	}
+	if len(availability.Layers) == 1 && aws.StringValue(availability.Layers[0].LayerAvailability) == ecr.LayerAvailabilityAvailable {
+		return digest, nil
+	}
+	upload, err := c.client.InitiateLayerUpload(&ecr.InitiateLayerUploadInput{
+		RegistryId:     registryID(img),
+		RepositoryName: aws.String(img.Repository),
+	})
+	if err != nil {
+		return "", fmt.Errorf("initiate upload of layer %s: %w", digest, err)
+	}
+	if _, err := c.client.UploadLayerPart(&ecr.UploadLayerPartInput{
+		RegistryId:     registryID(img),
+		RepositoryName: aws.String(img.Repository),
+		UploadId:       upload.UploadId,
+		PartFirstByte:  aws.Int64(0),
+		PartLastByte:   aws.Int64(int64(len(blob) - 1)),
+		LayerPartBlob:  blob,
+	}); err != nil {
+		return "", fmt.Errorf("upload layer %s: %w", digest, err)
+	}
+	_, err = c.client.CompleteLayerUpload(&ecr.CompleteLayerUploadInput{
+		RegistryId:     registryID(img),
+		RepositoryName: aws.String(img.Repository),
+		UploadId:       upload.UploadId,
+		LayerDigests:   aws.StringSlice([]string{digest}),
+	})
+	if err != nil && !isErrCode(err, ecr.ErrCodeLayerAlreadyExistsException) {
+		return "", fmt.Errorf("complete upload of layer %s: %w", digest, err)
+	}
+	return digest, nil
+}
+
+func blobDigest(blob []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(blob))
+}
+
+func registryID(img ImageRef) *string {
+	if img.RegistryID == "" {
+		return nil
+	}
+	return aws.String(img.RegistryID)
+}
+
+func imageIDString(id *ecr.ImageIdentifier) string {
+	if id.ImageDigest != nil {
+		return aws.StringValue(id.ImageDigest)
+	}
+	return aws.StringValue(id.ImageTag)
+}
+
+func isErrCode(err error, code string) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == code
+}
+
+func download(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,251 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockImageDigest = "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49"
+	mockStatement   = `{"_type":"https://in-toto.io/Statement/v1"}`
+)
+
+func TestParseImageURI(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedImage  ImageRef
+		wantedRegion string
+		wantedErr    string
+	}{
+		"error if the image is not in ECR": {
+			in:        "public.ecr.aws/nginx/nginx:latest",
+			wantedErr: "image public.ecr.aws/nginx/nginx:latest is not in an Amazon ECR repository",
+		},
+		"image with a tag": {
+			in: "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api:v1.2",
+			wantedImage: ImageRef{
+				RegistryID: "123456789012",
+				Repository: "my-app/api",
+				Tag:        "v1.2",
+			},
+			wantedRegion: "us-west-2",
+		},
+		"image with a digest": {
+			in: "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/my-app/api@" + mockImageDigest,
+			wantedImage: ImageRef{
+				RegistryID: "123456789012",
+				Repository: "my-app/api",
+				Digest:     mockImageDigest,
+			},
+			wantedRegion: "cn-north-1",
+		},
+		"image without a tag defaults to latest": {
+			in: "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api",
+			wantedImage: ImageRef{
+				RegistryID: "123456789012",
+				Repository: "my-app/api",
+				Tag:        "latest",
+			},
+			wantedRegion: "us-west-2",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			img, region, err := ParseImageURI(tc.in)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedImage, img)
+			require.Equal(t, tc.wantedRegion, region)
+		})
+	}
+}
+
+func TestECR_PutAttestation(t *testing.T) {
+	img := ImageRef{
+		Repository: "my-app/api",
+		Digest:     mockImageDigest,
+	}
+	subject := func(m *mocks.Mockapi) {
+		m.EXPECT().BatchGetImage(gomock.Any()).DoAndReturn(func(in *ecr.BatchGetImageInput) (*ecr.BatchGetImageOutput, error) {
+			require.Nil(t, in.RegistryId)
+			require.Equal(t, mockImageDigest, aws.StringValue(in.ImageIds[0].ImageDigest))
+			return &ecr.BatchGetImageOutput{
+				Images: []*ecr.Image{
+					{
+						ImageManifest:          aws.String(`{"schemaVersion":2}`),
+						ImageManifestMediaType: aws.String("application/vnd.docker.distribution.manifest.v2+json"),
+					},
+				},
+			}, nil
+		})
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedErr string
+	}{
+		"error if the image does not exist": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(&ecr.BatchGetImageOutput{
+					Failures: []*ecr.ImageFailure{
+						{FailureReason: aws.String("Requested image not found")},
+					},
+				}, nil)
+			},
+			wantedErr: "get image " + mockImageDigest + " from repository my-app/api: ImageNotFoundException: Requested image not found",
+		},
+		"error if fail to upload the attestation": {
+			setupMocks: func(m *mocks.Mockapi) {
+				subject(m)
+				m.EXPECT().BatchCheckLayerAvailability(gomock.Any()).Return(&ecr.BatchCheckLayerAvailabilityOutput{
+					Layers: []*ecr.Layer{{LayerAvailability: aws.String(ecr.LayerAvailabilityAvailable)}},
+				}, nil)
+				m.EXPECT().BatchCheckLayerAvailability(gomock.Any()).Return(&ecr.BatchCheckLayerAvailabilityOutput{}, nil)
+				m.EXPECT().InitiateLayerUpload(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "upload attestation: initiate upload of layer sha256:efd35cf5b72b5b9eeee4ee292f5d6b079191324b19ee9231892fb391c82c1d51: some error",
+		},
+		"upload the attestation and tag it after the digest of the image": {
+			setupMocks: func(m *mocks.Mockapi) {
+				subject(m)
+				m.EXPECT().BatchCheckLayerAvailability(gomock.Any()).Return(&ecr.BatchCheckLayerAvailabilityOutput{
+					Layers: []*ecr.Layer{{LayerAvailability: aws.String(ecr.LayerAvailabilityAvailable)}},
+				}, nil)
+				m.EXPECT().BatchCheckLayerAvailability(gomock.Any()).Return(&ecr.BatchCheckLayerAvailabilityOutput{
+					Layers: []*ecr.Layer{{LayerAvailability: aws.String(ecr.LayerAvailabilityUnavailable)}},
+				}, nil)
+				m.EXPECT().InitiateLayerUpload(gomock.Any()).Return(&ecr.InitiateLayerUploadOutput{UploadId: aws.String("upload")}, nil)
+				m.EXPECT().UploadLayerPart(&ecr.UploadLayerPartInput{
+					RepositoryName: aws.String("my-app/api"),
+					UploadId:       aws.String("upload"),
+					PartFirstByte:  aws.Int64(0),
+					PartLastByte:   aws.Int64(int64(len(mockStatement) - 1)),
+					LayerPartBlob:  []byte(mockStatement),
+				}).Return(&ecr.UploadLayerPartOutput{}, nil)
+				m.EXPECT().CompleteLayerUpload(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeLayerAlreadyExistsException, "exists", nil))
+				m.EXPECT().PutImage(&ecr.PutImageInput{
+					RepositoryName:         aws.String("my-app/api"),
+					ImageManifestMediaType: aws.String("application/vnd.oci.image.manifest.v1+json"),
+					ImageTag:               aws.String("sha256-741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49.att"),
+					ImageManifest: aws.String(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","artifactType":"application/vnd.in-toto+json",` +
+						`"config":{"mediaType":"application/vnd.oci.empty.v1+json","digest":"sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a","size":2},` +
+						`"layers":[{"mediaType":"application/vnd.in-toto+json","digest":"sha256:efd35cf5b72b5b9eeee4ee292f5d6b079191324b19ee9231892fb391c82c1d51","size":43}],` +
+						`"subject":{"mediaType":"application/vnd.docker.distribution.manifest.v2+json","digest":"` + mockImageDigest + `","size":19}}`),
+				}).Return(&ecr.PutImageOutput{}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := ECR{
+				client: m,
+			}
+
+			err := client.PutAttestation(img, []byte(mockStatement))
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestECR_Attestation(t *testing.T) {
+	img := ImageRef{
+		RegistryID: "123456789012",
+		Repository: "my-app/api",
+		Digest:     mockImageDigest,
+	}
+	artifact := func(m *mocks.Mockapi, manifest string) {
+		m.EXPECT().BatchGetImage(gomock.Any()).DoAndReturn(func(in *ecr.BatchGetImageInput) (*ecr.BatchGetImageOutput, error) {
+			require.Equal(t, "123456789012", aws.StringValue(in.RegistryId))
+			require.Equal(t, "sha256-741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49.att", aws.StringValue(in.ImageIds[0].ImageTag))
+			return &ecr.BatchGetImageOutput{
+				Images: []*ecr.Image{{ImageManifest: aws.String(manifest)}},
+			}, nil
+		})
+	}
+	const attestationManifest = `{"layers":[{"mediaType":"application/vnd.in-toto+json","digest":"sha256:efd35cf5b72b5b9eeee4ee292f5d6b079191324b19ee9231892fb391c82c1d51"}],` +
+		`"subject":{"digest":"` + mockImageDigest + `"}}`
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+		download   func(url string) ([]byte, error)
+
+		wantedErr error
+	}{
+		"error if the image does not have an attestation": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(&ecr.BatchGetImageOutput{}, nil)
+			},
+			wantedErr: &ErrAttestationNotFound{Repository: "my-app/api", Digest: mockImageDigest},
+		},
+		"error if the artifact refers to another image": {
+			setupMocks: func(m *mocks.Mockapi) {
+				artifact(m, `{"layers":[{"mediaType":"application/vnd.in-toto+json"}],"subject":{"digest":"sha256:other"}}`)
+			},
+			wantedErr: errors.New("artifact sha256-741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49.att in repository my-app/api is not an in-toto attestation of image " + mockImageDigest),
+		},
+		"error if the downloaded attestation does not match the digest of the layer": {
+			setupMocks: func(m *mocks.Mockapi) {
+				artifact(m, attestationManifest)
+				m.EXPECT().GetDownloadUrlForLayer(gomock.Any()).Return(&ecr.GetDownloadUrlForLayerOutput{DownloadUrl: aws.String("https://layer")}, nil)
+			},
+			download: func(url string) ([]byte, error) {
+				return []byte("tampered"), nil
+			},
+			wantedErr: errors.New("digest of the downloaded attestation does not match sha256:efd35cf5b72b5b9eeee4ee292f5d6b079191324b19ee9231892fb391c82c1d51"),
+		},
+		"return the attestation": {
+			setupMocks: func(m *mocks.Mockapi) {
+				artifact(m, attestationManifest)
+				m.EXPECT().GetDownloadUrlForLayer(gomock.Any()).Return(&ecr.GetDownloadUrlForLayerOutput{DownloadUrl: aws.String("https://layer")}, nil)
+			},
+			download: func(url string) ([]byte, error) {
+				require.Equal(t, "https://layer", url)
+				return []byte(mockStatement), nil
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := ECR{
+				client:   m,
+				download: tc.download,
+			}
+
+			got, err := client.Attestation(img)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, mockStatement, string(got))
+		})
+	}
+}
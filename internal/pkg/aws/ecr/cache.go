@@ -0,0 +1,188 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecr
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	// DisableCacheEnvVar turns off the cache of the logins to ECR registries and of the digests of image tags.
+	DisableCacheEnvVar = "COPILOT_DISABLE_ECR_CACHE"
+
+	// loginCacheTTL is how long a Docker login to a registry is reused. ECR auth tokens are valid for 12 hours.
+	loginCacheTTL = time.Hour
+	// digestCacheTTL is how long the digest of an image tag is reused. It's short since tags can be pushed again.
+	digestCacheTTL = 5 * time.Minute
+)
+
+// cacheFileName is the file, relative to the home directory of the user, that caches the logins and the digests.
+var cacheFileName = filepath.Join(".copilot", "cache", "ecr.json")
+
+// Cache remembers, in the Copilot directory of the user, the registries that Docker recently logged in to
+// and the digests of image tags that were recently resolved, so that successive commands skip the calls to ECR and Docker.
+// A Cache without a file is disabled: it never hits and doesn't store anything.
+type Cache struct {
+	fs   afero.Fs
+	path string
+	now  func() time.Time
+
+	mu sync.Mutex
+}
+
+type cacheContent struct {
+	Logins  map[string]time.Time    `json:"logins,omitempty"`  // Registry to the time Docker logged in to it.
+	Digests map[string]cachedDigest `json:"digests,omitempty"` // Image tag, like "registry/repo:tag", to its digest.
+}
+
+type cachedDigest struct {
+	Digest     string    `json:"digest"`
+	ResolvedAt time.Time `json:"resolvedAt"`
+}
+
+// NewCache returns the cache in the home directory of the user.
+// The cache is disabled if the user has no home directory or if DisableCacheEnvVar is "true".
+func NewCache() *Cache {
+	if os.Getenv(DisableCacheEnvVar) == "true" {
+		return &Cache{}
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return &Cache{}
+	}
+	return &Cache{
+		fs:   afero.NewOsFs(),
+		path: filepath.Join(home, cacheFileName),
+		now:  time.Now,
+	}
+}
+
+// LoggedIn returns true if Docker logged in to the registry recently enough for the login to still be valid.
+func (c *Cache) LoggedIn(registry string) bool {
+	if c.disabled() {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	at, ok := c.read().Logins[registry]
+	return ok && c.fresh(at, loginCacheTTL)
+}
+
+// SetLoggedIn records that Docker just logged in to the registry.
+func (c *Cache) SetLoggedIn(registry string) {
+	c.update(func(content *cacheContent) {
+		if content.Logins == nil {
+			content.Logins = make(map[string]time.Time)
+		}
+		content.Logins[registry] = c.now()
+	})
+}
+
+// ForgetLogin removes the login to the registry, for example because a push to it failed, so that Docker logs in again.
+func (c *Cache) ForgetLogin(registry string) {
+	c.update(func(content *cacheContent) {
+		delete(content.Logins, registry)
+	})
+}
+
+// Digest returns the digest of the image tag, like "registry/repo:tag", if it was resolved recently.
+func (c *Cache) Digest(image string) (string, bool) {
+	if c.disabled() {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.read().Digests[image]
+	if !ok || !c.fresh(cached.ResolvedAt, digestCacheTTL) {
+		return "", false
+	}
+	return cached.Digest, true
+}
+
+// SetDigest records the digest of the image tag, like "registry/repo:tag".
+func (c *Cache) SetDigest(image, digest string) {
+	c.update(func(content *cacheContent) {
+		if content.Digests == nil {
+			content.Digests = make(map[string]cachedDigest)
+		}
+		content.Digests[image] = cachedDigest{
+			Digest:     digest,
+			ResolvedAt: c.now(),
+		}
+	})
+}
+
+func (c *Cache) disabled() bool {
+	return c == nil || c.path == ""
+}
+
+func (c *Cache) fresh(at time.Time, ttl time.Duration) bool {
+	age := c.now().Sub(at)
+	return age >= 0 && age < ttl
+}
+
+// update applies the change to the content of the cache, and drops the expired entries.
+// Errors are ignored: the cache only speeds up commands.
+func (c *Cache) update(change func(content *cacheContent)) {
+	if c.disabled() {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	content := c.read()
+	change(&content)
+	for registry, at := range content.Logins {
+		if !c.fresh(at, loginCacheTTL) {
+			delete(content.Logins, registry)
+		}
+	}
+	for image, cached := range content.Digests {
+		if !c.fresh(cached.ResolvedAt, digestCacheTTL) {
+			delete(content.Digests, image)
+		}
+	}
+	c.write(content)
+}
+
+func (c *Cache) read() cacheContent {
+	var content cacheContent
+	raw, err := afero.ReadFile(c.fs, c.path)
+	if err != nil {
+		return cacheContent{}
+	}
+	if err := json.Unmarshal(raw, &content); err != nil {
+		// A corrupted cache is overwritten by the next update.
+		return cacheContent{}
+	}
+	return content
+}
+
+func (c *Cache) write(content cacheContent) {
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return
+	}
+	if err := c.fs.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return
+	}
+	// Write to a temporary file first so that concurrent commands never read a partially written cache.
+	tmp, err := afero.TempFile(c.fs, filepath.Dir(c.path), filepath.Base(c.path)+".*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(raw)
+	if closeErr := tmp.Close(); err != nil || closeErr != nil {
+		_ = c.fs.Remove(tmp.Name())
+		return
+	}
+	if err := c.fs.Rename(tmp.Name(), c.path); err != nil {
+		_ = c.fs.Remove(tmp.Name())
+	}
+}
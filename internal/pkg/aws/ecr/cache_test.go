@@ -0,0 +1,162 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+const mockRegistry = "123456789012.dkr.ecr.us-west-2.amazonaws.com"
+
+func TestCache_LoggedIn(t *testing.T) {
+	loggedInAt := time.Date(2024, 11, 1, 9, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setup func(c *Cache)
+		now   time.Time
+
+		wanted bool
+	}{
+		"never logged in": {
+			now: loggedInAt,
+		},
+		"logged in recently": {
+			setup: func(c *Cache) {
+				c.SetLoggedIn(mockRegistry)
+			},
+			now:    loggedInAt.Add(59 * time.Minute),
+			wanted: true,
+		},
+		"login expired": {
+			setup: func(c *Cache) {
+				c.SetLoggedIn(mockRegistry)
+			},
+			now: loggedInAt.Add(time.Hour),
+		},
+		"login forgotten": {
+			setup: func(c *Cache) {
+				c.SetLoggedIn(mockRegistry)
+				c.ForgetLogin(mockRegistry)
+			},
+			now: loggedInAt,
+		},
+		"logged in to another registry": {
+			setup: func(c *Cache) {
+				c.SetLoggedIn("210987654321.dkr.ecr.us-west-2.amazonaws.com")
+			},
+			now: loggedInAt,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			now := loggedInAt
+			c := &Cache{
+				fs:   afero.NewMemMapFs(),
+				path: "/home/.copilot/cache/ecr.json",
+				now:  func() time.Time { return now },
+			}
+			if tc.setup != nil {
+				tc.setup(c)
+			}
+			now = tc.now
+
+			require.Equal(t, tc.wanted, c.LoggedIn(mockRegistry))
+		})
+	}
+}
+
+func TestCache_Digest(t *testing.T) {
+	resolvedAt := time.Date(2024, 11, 1, 9, 0, 0, 0, time.UTC)
+	fs := afero.NewMemMapFs()
+	now := resolvedAt
+	c := &Cache{
+		fs:   fs,
+		path: "/home/.copilot/cache/ecr.json",
+		now:  func() time.Time { return now },
+	}
+
+	c.SetDigest(mockRegistry+"/app/api:latest", mockImageDigest)
+
+	got, ok := c.Digest(mockRegistry + "/app/api:latest")
+	require.True(t, ok)
+	require.Equal(t, mockImageDigest, got)
+	_, ok = c.Digest(mockRegistry + "/app/api:v1")
+	require.False(t, ok)
+
+	now = resolvedAt.Add(5 * time.Minute)
+	_, ok = c.Digest(mockRegistry + "/app/api:latest")
+	require.False(t, ok, "digests expire")
+
+	c.SetLoggedIn(mockRegistry)
+	raw, err := afero.ReadFile(fs, "/home/.copilot/cache/ecr.json")
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), mockImageDigest, "expired entries are dropped")
+}
+
+func TestCache_Disabled(t *testing.T) {
+	var c Cache
+
+	c.SetLoggedIn(mockRegistry)
+	c.SetDigest(mockRegistry+"/app/api:latest", mockImageDigest)
+
+	require.False(t, c.LoggedIn(mockRegistry))
+	_, ok := c.Digest(mockRegistry + "/app/api:latest")
+	require.False(t, ok)
+}
+
+func TestCache_CorruptedFile(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/home/.copilot/cache/ecr.json", []byte("{"), 0600))
+	c := &Cache{
+		fs:   fs,
+		path: "/home/.copilot/cache/ecr.json",
+		now:  time.Now,
+	}
+
+	require.False(t, c.LoggedIn(mockRegistry))
+	c.SetLoggedIn(mockRegistry)
+	require.True(t, c.LoggedIn(mockRegistry))
+}
+
+func TestECR_ImageDigest(t *testing.T) {
+	img := ImageRef{
+		RegistryID: "123456789012",
+		Repository: "app/api",
+		Tag:        "latest",
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockapi(ctrl)
+	m.EXPECT().BatchGetImage(gomock.Any()).DoAndReturn(func(in *ecr.BatchGetImageInput) (*ecr.BatchGetImageOutput, error) {
+		require.Equal(t, "latest", aws.StringValue(in.ImageIds[0].ImageTag))
+		return &ecr.BatchGetImageOutput{
+			Images: []*ecr.Image{{ImageId: &ecr.ImageIdentifier{ImageDigest: aws.String(mockImageDigest)}}},
+		}, nil
+	}).Times(1)
+	client := ECR{
+		client: m,
+		region: "us-west-2",
+		cache: &Cache{
+			fs:   afero.NewMemMapFs(),
+			path: "/home/.copilot/cache/ecr.json",
+			now:  time.Now,
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		got, err := client.ImageDigest(img)
+		require.NoError(t, err)
+		require.Equal(t, mockImageDigest, got)
+	}
+	got, ok := client.cache.Digest(mockRegistry + "/app/api:latest")
+	require.True(t, ok)
+	require.Equal(t, mockImageDigest, got)
+}
@@ -31,17 +31,30 @@ type api interface {
 	GetAuthorizationToken(*ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error)
 	DescribeRepositories(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
 	BatchDeleteImage(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error)
+	BatchGetImage(*ecr.BatchGetImageInput) (*ecr.BatchGetImageOutput, error)
+	PutImage(*ecr.PutImageInput) (*ecr.PutImageOutput, error)
+	BatchCheckLayerAvailability(*ecr.BatchCheckLayerAvailabilityInput) (*ecr.BatchCheckLayerAvailabilityOutput, error)
+	InitiateLayerUpload(*ecr.InitiateLayerUploadInput) (*ecr.InitiateLayerUploadOutput, error)
+	UploadLayerPart(*ecr.UploadLayerPartInput) (*ecr.UploadLayerPartOutput, error)
+	CompleteLayerUpload(*ecr.CompleteLayerUploadInput) (*ecr.CompleteLayerUploadOutput, error)
+	GetDownloadUrlForLayer(*ecr.GetDownloadUrlForLayerInput) (*ecr.GetDownloadUrlForLayerOutput, error)
 }
 
 // ECR wraps an AWS ECR client.
 type ECR struct {
-	client api
+	client   api
+	region   string
+	cache    *Cache
+	download func(url string) ([]byte, error)
 }
 
 // New returns a ECR configured against the input session.
 func New(s *session.Session) ECR {
 	return ECR{
-		client: ecr.New(s),
+		client:   ecr.New(s),
+		region:   aws.StringValue(s.Config.Region),
+		cache:    NewCache(),
+		download: download,
 	}
 }
 
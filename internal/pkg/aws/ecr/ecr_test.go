@@ -63,7 +63,7 @@ func TestAuth(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotUsername, gotPassword, gotErr := client.Auth()
@@ -130,7 +130,7 @@ func TestRepositoryURI(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotURI, gotErr := client.RepositoryURI(mockRepoName)
@@ -253,7 +253,7 @@ func TestListImages(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotImages, gotError := client.ListImages(mockRepoName)
@@ -362,7 +362,7 @@ func TestDeleteImages(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			got := client.DeleteImages(tc.images, mockRepoName)
@@ -441,7 +441,7 @@ func TestClearRepository(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotError := client.ClearRepository(mockRepoName)
@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+const (
+	// ContainerInstanceStatusActive is the status of a container instance that accepts new tasks.
+	ContainerInstanceStatusActive = ecs.ContainerInstanceStatusActive
+	// ContainerInstanceStatusDraining is the status of a container instance that no longer accepts new tasks.
+	ContainerInstanceStatusDraining = ecs.ContainerInstanceStatusDraining
+)
+
+// ContainerInstance contains information of an EC2 instance registered to an ECS cluster.
+type ContainerInstance ecs.ContainerInstance
+
+// ErrWaitContainerInstancesDrainedTimeout occurs when the container instances still have running tasks
+// after the max number of retries.
+type ErrWaitContainerInstancesDrainedTimeout struct {
+	maxRetries int
+}
+
+func (e *ErrWaitContainerInstancesDrainedTimeout) Error() string {
+	return fmt.Sprintf("max retries %v exceeded waiting for container instances to drain", e.maxRetries)
+}
+
+// Timeout allows ErrWaitContainerInstancesDrainedTimeout to implement a timeout error interface.
+func (e *ErrWaitContainerInstancesDrainedTimeout) Timeout() bool {
+	return true
+}
+
+// ContainerInstances calls ECS API and returns all the container instances registered to the cluster.
+func (e *ECS) ContainerInstances(cluster string) ([]*ContainerInstance, error) {
+	var arns []*string
+	in := &ecs.ListContainerInstancesInput{
+		Cluster: aws.String(cluster),
+	}
+	for {
+		resp, err := e.client.ListContainerInstances(in)
+		if err != nil {
+			return nil, fmt.Errorf("list container instances in cluster %s: %w", cluster, err)
+		}
+		arns = append(arns, resp.ContainerInstanceArns...)
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	return e.describeContainerInstances(cluster, arns)
+}
+
+// DrainContainerInstances calls ECS API to set the state of the container instances to DRAINING,
+// so that ECS stops placing new tasks on them and replaces the service tasks running on them.
+func (e *ECS) DrainContainerInstances(cluster string, arns []string) error {
+	resp, err := e.client.UpdateContainerInstancesState(&ecs.UpdateContainerInstancesStateInput{
+		Cluster:            aws.String(cluster),
+		ContainerInstances: aws.StringSlice(arns),
+		Status:             aws.String(ContainerInstanceStatusDraining),
+	})
+	if err != nil {
+		return fmt.Errorf("drain container instances in cluster %s: %w", cluster, err)
+	}
+	if len(resp.Failures) > 0 {
+		failure := resp.Failures[0]
+		return fmt.Errorf("drain container instance %s: %s", aws.StringValue(failure.Arn), aws.StringValue(failure.Reason))
+	}
+	return nil
+}
+
+// WaitUntilContainerInstancesDrained waits until none of the container instances have running tasks.
+func (e *ECS) WaitUntilContainerInstancesDrained(cluster string, arns []string) error {
+	var tryNum int
+	for {
+		instances, err := e.describeContainerInstances(cluster, aws.StringSlice(arns))
+		if err != nil {
+			return err
+		}
+		drained := true
+		for _, instance := range instances {
+			if aws.Int64Value(instance.RunningTasksCount) > 0 {
+				drained = false
+				break
+			}
+		}
+		if drained {
+			return nil
+		}
+		if tryNum >= e.maxInstanceDrainedTries {
+			return &ErrWaitContainerInstancesDrainedTimeout{
+				maxRetries: e.maxInstanceDrainedTries,
+			}
+		}
+		tryNum++
+		time.Sleep(e.instanceDrainedPollInterval)
+	}
+}
+
+func (e *ECS) describeContainerInstances(cluster string, arns []*string) ([]*ContainerInstance, error) {
+	var instances []*ContainerInstance
+	for start := 0; start < len(arns); start += describeContainerInstancesMaxNum {
+		end := start + describeContainerInstancesMaxNum
+		if end > len(arns) {
+			end = len(arns)
+		}
+		resp, err := e.client.DescribeContainerInstances(&ecs.DescribeContainerInstancesInput{
+			Cluster:            aws.String(cluster),
+			ContainerInstances: arns[start:end],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe container instances in cluster %s: %w", cluster, err)
+		}
+		for _, instance := range resp.ContainerInstances {
+			ci := ContainerInstance(*instance)
+			instances = append(instances, &ci)
+		}
+	}
+	return instances, nil
+}
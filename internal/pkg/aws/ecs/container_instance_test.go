@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestECS_ContainerInstances(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedErr       error
+		wantedInstances []*ContainerInstance
+	}{
+		"errors if failed to list container instances": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListContainerInstances(&ecs.ListContainerInstancesInput{
+					Cluster: aws.String("mockCluster"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list container instances in cluster mockCluster: some error"),
+		},
+		"errors if failed to describe container instances": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListContainerInstances(gomock.Any()).Return(&ecs.ListContainerInstancesOutput{
+					ContainerInstanceArns: aws.StringSlice([]string{"arn1"}),
+				}, nil)
+				m.EXPECT().DescribeContainerInstances(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe container instances in cluster mockCluster: some error"),
+		},
+		"returns nothing if there are no container instances": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListContainerInstances(gomock.Any()).Return(&ecs.ListContainerInstancesOutput{}, nil)
+			},
+		},
+		"success with pagination": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListContainerInstances(&ecs.ListContainerInstancesInput{
+					Cluster: aws.String("mockCluster"),
+				}).Return(&ecs.ListContainerInstancesOutput{
+					ContainerInstanceArns: aws.StringSlice([]string{"arn1"}),
+					NextToken:             aws.String("token"),
+				}, nil)
+				m.EXPECT().ListContainerInstances(&ecs.ListContainerInstancesInput{
+					Cluster:   aws.String("mockCluster"),
+					NextToken: aws.String("token"),
+				}).Return(&ecs.ListContainerInstancesOutput{
+					ContainerInstanceArns: aws.StringSlice([]string{"arn2"}),
+				}, nil)
+				m.EXPECT().DescribeContainerInstances(&ecs.DescribeContainerInstancesInput{
+					Cluster:            aws.String("mockCluster"),
+					ContainerInstances: aws.StringSlice([]string{"arn1", "arn2"}),
+				}).Return(&ecs.DescribeContainerInstancesOutput{
+					ContainerInstances: []*ecs.ContainerInstance{
+						{
+							ContainerInstanceArn: aws.String("arn1"),
+							Ec2InstanceId:        aws.String("i-1"),
+						},
+						{
+							ContainerInstanceArn: aws.String("arn2"),
+							Ec2InstanceId:        aws.String("i-2"),
+						},
+					},
+				}, nil)
+			},
+			wantedInstances: []*ContainerInstance{
+				{
+					ContainerInstanceArn: aws.String("arn1"),
+					Ec2InstanceId:        aws.String("i-1"),
+				},
+				{
+					ContainerInstanceArn: aws.String("arn2"),
+					Ec2InstanceId:        aws.String("i-2"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			client := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			got, err := client.ContainerInstances("mockCluster")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedInstances, got)
+			}
+		})
+	}
+}
+
+func TestECS_DrainContainerInstances(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedErr error
+	}{
+		"errors if failed to update container instances state": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UpdateContainerInstancesState(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("drain container instances in cluster mockCluster: some error"),
+		},
+		"errors if an instance failed to drain": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UpdateContainerInstancesState(gomock.Any()).Return(&ecs.UpdateContainerInstancesStateOutput{
+					Failures: []*ecs.Failure{
+						{
+							Arn:    aws.String("arn1"),
+							Reason: aws.String("MISSING"),
+						},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("drain container instance arn1: MISSING"),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UpdateContainerInstancesState(&ecs.UpdateContainerInstancesStateInput{
+					Cluster:            aws.String("mockCluster"),
+					ContainerInstances: aws.StringSlice([]string{"arn1"}),
+					Status:             aws.String("DRAINING"),
+				}).Return(&ecs.UpdateContainerInstancesStateOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			client := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			err := client.DrainContainerInstances("mockCluster", []string{"arn1"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestECS_WaitUntilContainerInstancesDrained(t *testing.T) {
+	instanceWithTasks := func(count int64) *ecs.DescribeContainerInstancesOutput {
+		return &ecs.DescribeContainerInstancesOutput{
+			ContainerInstances: []*ecs.ContainerInstance{
+				{
+					ContainerInstanceArn: aws.String("arn1"),
+					RunningTasksCount:    aws.Int64(count),
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedErr error
+	}{
+		"errors if failed to describe container instances": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeContainerInstances(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe container instances in cluster mockCluster: some error"),
+		},
+		"errors if the instances do not drain in time": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeContainerInstances(gomock.Any()).Return(instanceWithTasks(2), nil).Times(3)
+			},
+			wantedErr: errors.New("max retries 2 exceeded waiting for container instances to drain"),
+		},
+		"success after tasks are replaced": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DescribeContainerInstances(&ecs.DescribeContainerInstancesInput{
+						Cluster:            aws.String("mockCluster"),
+						ContainerInstances: aws.StringSlice([]string{"arn1"}),
+					}).Return(instanceWithTasks(1), nil),
+					m.EXPECT().DescribeContainerInstances(gomock.Any()).Return(instanceWithTasks(0), nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			client := ECS{
+				client:                      mockECSClient,
+				maxInstanceDrainedTries:     2,
+				instanceDrainedPollInterval: 0,
+			}
+
+			// WHEN
+			err := client.WaitUntilContainerInstancesDrained("mockCluster", []string{"arn1"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,8 +5,10 @@
 package ecs
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -24,19 +26,28 @@ const (
 	waitServiceStableMaxTry          = 80
 	stableServiceDeploymentNum       = 1
 
+	waitInstanceDrainedPollingInterval = 15 * time.Second
+	waitInstanceDrainedMaxTry          = 120
+	describeContainerInstancesMaxNum   = 100
+
+	startSessionPluginAction = "StartSession"
+
 	// EndpointsID is the ID to look up the ECS service endpoint.
 	EndpointsID = ecs.EndpointsID
 )
 
 type api interface {
 	DescribeClusters(input *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
+	DescribeContainerInstances(input *ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error)
 	DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
 	DescribeTasks(input *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
 	DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
 	ExecuteCommand(input *ecs.ExecuteCommandInput) (*ecs.ExecuteCommandOutput, error)
+	ListContainerInstances(input *ecs.ListContainerInstancesInput) (*ecs.ListContainerInstancesOutput, error)
 	ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
 	RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
 	StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
+	UpdateContainerInstancesState(input *ecs.UpdateContainerInstancesStateInput) (*ecs.UpdateContainerInstancesStateOutput, error)
 	UpdateService(input *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
 	WaitUntilTasksRunning(input *ecs.DescribeTasksInput) error
 }
@@ -48,10 +59,14 @@ type ssmSessionStarter interface {
 // ECS wraps an AWS ECS client.
 type ECS struct {
 	client         api
+	region         string
 	newSessStarter func() ssmSessionStarter
 
 	maxServiceStableTries int
 	pollIntervalDuration  time.Duration
+
+	maxInstanceDrainedTries     int
+	instanceDrainedPollInterval time.Duration
 }
 
 // RunTaskInput holds the fields needed to run tasks.
@@ -64,6 +79,9 @@ type RunTaskInput struct {
 	StartedBy       string
 	PlatformVersion string
 	EnableExec      bool
+
+	AssignPublicIP  string                       // Optional. Defaults to ENABLED.
+	EnvVarOverrides map[string]map[string]string // Optional. Environment variables to override by container name.
 }
 
 // ExecuteCommandInput holds the fields needed to execute commands in a running container.
@@ -78,11 +96,14 @@ type ExecuteCommandInput struct {
 func New(s *session.Session) *ECS {
 	return &ECS{
 		client: ecs.New(s),
+		region: aws.StringValue(s.Config.Region),
 		newSessStarter: func() ssmSessionStarter {
 			return exec.NewSSMPluginCommand(s)
 		},
-		maxServiceStableTries: waitServiceStableMaxTry,
-		pollIntervalDuration:  waitServiceStablePollingInterval,
+		maxServiceStableTries:       waitServiceStableMaxTry,
+		pollIntervalDuration:        waitServiceStablePollingInterval,
+		maxInstanceDrainedTries:     waitInstanceDrainedMaxTry,
+		instanceDrainedPollInterval: waitInstanceDrainedPollingInterval,
 	}
 }
 
@@ -194,6 +215,12 @@ func (e *ECS) RunningTasks(cluster string) ([]*Task, error) {
 	return e.listTasks(cluster, withRunningTasks())
 }
 
+// RunningTasksStartedBy calls ECS API and returns ECS tasks with the desired status to be RUNNING
+// that were started by the given identifier.
+func (e *ECS) RunningTasksStartedBy(cluster, startedBy string) ([]*Task, error) {
+	return e.listTasks(cluster, withStartedBy(startedBy), withRunningTasks())
+}
+
 type listTasksOpts func(*ecs.ListTasksInput)
 
 func withService(svcName string) listTasksOpts {
@@ -208,6 +235,12 @@ func withFamily(family string) listTasksOpts {
 	}
 }
 
+func withStartedBy(startedBy string) listTasksOpts {
+	return func(in *ecs.ListTasksInput) {
+		in.StartedBy = aws.String(startedBy)
+	}
+}
+
 func withRunningTasks() listTasksOpts {
 	return func(in *ecs.ListTasksInput) {
 		in.DesiredStatus = aws.String(ecs.DesiredStatusRunning)
@@ -341,6 +374,44 @@ func (e *ECS) ActiveClusters(arns ...string) ([]string, error) {
 	return active, nil
 }
 
+// ExecConfig holds the configuration of the sessions started with ECS Exec in the containers of a cluster.
+type ExecConfig struct {
+	KMSKeyID     string // ID or ARN of the KMS key that encrypts the sessions. Empty if the sessions are not encrypted with a KMS key.
+	Logging      string // Either DEFAULT, NONE or OVERRIDE.
+	LogGroupName string // Log group that records the output of the sessions if Logging is OVERRIDE.
+}
+
+// ClusterExecConfig returns the configuration of the sessions started with ECS Exec in the cluster.
+func (e *ECS) ClusterExecConfig(cluster string) (*ExecConfig, error) {
+	resp, err := e.client.DescribeClusters(&ecs.DescribeClustersInput{
+		Clusters: aws.StringSlice([]string{cluster}),
+		Include:  aws.StringSlice([]string{ecs.ClusterFieldConfigurations}),
+	})
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("describe cluster %s: %w", cluster, err)
+	case len(resp.Failures) > 0:
+		return nil, fmt.Errorf("describe cluster %s: %s", cluster, resp.Failures[0].GoString())
+	case len(resp.Clusters) == 0:
+		return nil, fmt.Errorf("cluster %s not found", cluster)
+	}
+	cfg := &ExecConfig{
+		Logging: ecs.ExecuteCommandLoggingDefault,
+	}
+	if resp.Clusters[0].Configuration == nil || resp.Clusters[0].Configuration.ExecuteCommandConfiguration == nil {
+		return cfg, nil
+	}
+	exec := resp.Clusters[0].Configuration.ExecuteCommandConfiguration
+	cfg.KMSKeyID = aws.StringValue(exec.KmsKeyId)
+	if exec.Logging != nil {
+		cfg.Logging = aws.StringValue(exec.Logging)
+	}
+	if exec.LogConfiguration != nil {
+		cfg.LogGroupName = aws.StringValue(exec.LogConfiguration.CloudWatchLogGroupName)
+	}
+	return cfg, nil
+}
+
 // ActiveServices returns the subset of service arns that have an ACTIVE status from the given cluster.
 func (e *ECS) ActiveServices(clusterARN string, serviceARNs ...string) ([]string, error) {
 	// All the filteredSvcARNs will belong to the given Cluster.
@@ -372,6 +443,10 @@ func (e *ECS) ActiveServices(clusterARN string, serviceARNs ...string) ([]string
 // RunTask runs a number of tasks with the task definition and network configurations in a cluster, and returns after
 // the task(s) is running or fails to run, along with task ARNs if possible.
 func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
+	assignPublicIP := ecs.AssignPublicIpEnabled
+	if input.AssignPublicIP != "" {
+		assignPublicIP = input.AssignPublicIP
+	}
 	resp, err := e.client.RunTask(&ecs.RunTaskInput{
 		Cluster:        aws.String(input.Cluster),
 		Count:          aws.Int64(int64(input.Count)),
@@ -380,11 +455,12 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 		TaskDefinition: aws.String(input.TaskFamilyName),
 		NetworkConfiguration: &ecs.NetworkConfiguration{
 			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
-				AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+				AssignPublicIp: aws.String(assignPublicIP),
 				Subnets:        aws.StringSlice(input.Subnets),
 				SecurityGroups: aws.StringSlice(input.SecurityGroups),
 			},
 		},
+		Overrides:            taskOverride(input.EnvVarOverrides),
 		EnableExecuteCommand: aws.Bool(input.EnableExec),
 		PlatformVersion:      aws.String(input.PlatformVersion),
 		PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
@@ -420,6 +496,38 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 	return tasks, nil
 }
 
+// taskOverride returns the overrides of the environment variables of the containers, sorted by name, or nil if there are none.
+func taskOverride(envVars map[string]map[string]string) *ecs.TaskOverride {
+	if len(envVars) == 0 {
+		return nil
+	}
+	containers := make([]string, 0, len(envVars))
+	for container := range envVars {
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+	override := &ecs.TaskOverride{}
+	for _, container := range containers {
+		names := make([]string, 0, len(envVars[container]))
+		for name := range envVars[container] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		var env []*ecs.KeyValuePair
+		for _, name := range names {
+			env = append(env, &ecs.KeyValuePair{
+				Name:  aws.String(name),
+				Value: aws.String(envVars[container][name]),
+			})
+		}
+		override.ContainerOverrides = append(override.ContainerOverrides, &ecs.ContainerOverride{
+			Name:        aws.String(container),
+			Environment: env,
+		})
+	}
+	return override
+}
+
 // DescribeTasks returns the tasks with the taskARNs in the cluster.
 func (e *ECS) DescribeTasks(cluster string, taskARNs []string) ([]*Task, error) {
 	resp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
@@ -458,6 +566,35 @@ func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (err error) {
 	return err
 }
 
+// CommandSession holds a session started by StartCommandSession.
+type CommandSession struct {
+	ID         string
+	PluginArgs []string // The arguments of the session manager plugin to connect to the session.
+}
+
+// StartCommandSession starts a session that executes commands in a running container without connecting to it.
+// The commands only run once the session manager plugin connects to the session, so that its output can be captured.
+func (e *ECS) StartCommandSession(in ExecuteCommandInput) (*CommandSession, error) {
+	execCmdresp, err := e.client.ExecuteCommand(&ecs.ExecuteCommandInput{
+		Cluster:     aws.String(in.Cluster),
+		Command:     aws.String(in.Command),
+		Container:   aws.String(in.Container),
+		Interactive: aws.Bool(true),
+		Task:        aws.String(in.Task),
+	})
+	if err != nil {
+		return nil, &ErrExecuteCommand{err: err}
+	}
+	rawSess, err := json.Marshal(execCmdresp.Session)
+	if err != nil {
+		return nil, fmt.Errorf("marshal session response: %w", err)
+	}
+	return &CommandSession{
+		ID:         aws.StringValue(execCmdresp.Session.SessionId),
+		PluginArgs: []string{string(rawSess), e.region, startSessionPluginAction},
+	}, nil
+}
+
 // NetworkConfiguration returns the network configuration of a service.
 func (e *ECS) NetworkConfiguration(cluster, serviceName string) (*NetworkConfiguration, error) {
 	service, err := e.service(cluster, serviceName)
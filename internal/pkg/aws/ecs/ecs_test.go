@@ -643,6 +643,77 @@ func TestECS_StoppedServiceTasks(t *testing.T) {
 	}
 }
 
+func TestECS_RunningTasksStartedBy(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr   error
+		wantTasks []*Task
+	}{
+		"errors if failed to list running tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					StartedBy:     aws.String("copilot-task"),
+					DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list running tasks: some error"),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					StartedBy:     aws.String("copilot-task"),
+					DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+				}).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn"}),
+					Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("mockTaskArn"),
+						},
+					},
+				}, nil)
+			},
+			wantTasks: []*Task{
+				{
+					TaskArn: aws.String("mockTaskArn"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			gotTasks, gotErr := service.RunningTasksStartedBy("mockCluster", "copilot-task")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantTasks, gotTasks)
+			}
+		})
+	}
+}
+
 func TestECS_StopTasks(t *testing.T) {
 	mockTasks := []string{"mockTask1", "mockTask2"}
 	mockError := errors.New("some error")
@@ -928,6 +999,86 @@ func TestECS_ActiveClusters(t *testing.T) {
 	}
 }
 
+func TestECS_ClusterExecConfig(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedError  error
+		wantedConfig *ExecConfig
+	}{
+		"describe cluster returns error": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(gomock.Any()).
+					Return(nil, fmt.Errorf("some error"))
+			},
+			wantedError: fmt.Errorf("describe cluster cluster1: some error"),
+		},
+		"default configuration if the cluster doesn't configure exec": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(gomock.Any()).
+					Return(&ecs.DescribeClustersOutput{
+						Clusters: []*ecs.Cluster{{}},
+					}, nil)
+			},
+			wantedConfig: &ExecConfig{
+				Logging: "DEFAULT",
+			},
+		},
+		"returns the key and log group of the sessions": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(&ecs.DescribeClustersInput{
+						Clusters: aws.StringSlice([]string{"cluster1"}),
+						Include:  aws.StringSlice([]string{"CONFIGURATIONS"}),
+					}).
+					Return(&ecs.DescribeClustersOutput{
+						Clusters: []*ecs.Cluster{
+							{
+								Configuration: &ecs.ClusterConfiguration{
+									ExecuteCommandConfiguration: &ecs.ExecuteCommandConfiguration{
+										KmsKeyId: aws.String("1234"),
+										Logging:  aws.String("OVERRIDE"),
+										LogConfiguration: &ecs.ExecuteCommandLogConfiguration{
+											CloudWatchLogGroupName: aws.String("/copilot/phonetool-test-exec-sessions"),
+										},
+									},
+								},
+							},
+						},
+					}, nil)
+			},
+			wantedConfig: &ExecConfig{
+				KMSKeyID:     "1234",
+				Logging:      "OVERRIDE",
+				LogGroupName: "/copilot/phonetool-test-exec-sessions",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			ecs := ECS{
+				client: mockECSClient,
+			}
+			cfg, err := ecs.ClusterExecConfig("cluster1")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedConfig, cfg)
+			}
+		})
+	}
+}
+
 func TestECS_ActiveServices(t *testing.T) {
 	mockClusterArn := "arn:aws:ecs:us-west-2:1234567890:cluster/cluster1"
 	testCases := map[string]struct {
@@ -1004,6 +1155,8 @@ func TestECS_RunTask(t *testing.T) {
 		startedBy       string
 		platformVersion string
 		enableExec      bool
+		assignPublicIP  string
+		envVarOverrides map[string]map[string]string
 	}
 
 	runTaskInput := input{
@@ -1079,6 +1232,65 @@ func TestECS_RunTask(t *testing.T) {
 				},
 			},
 		},
+		"run task with overridden environment variables in private subnets": {
+			input: input{
+				cluster:         "my-cluster",
+				count:           1,
+				subnets:         []string{"subnet-1"},
+				securityGroups:  []string{"sg-1"},
+				taskFamilyName:  "my-job",
+				startedBy:       "job",
+				platformVersion: "LATEST",
+				assignPublicIP:  ecs.AssignPublicIpDisabled,
+				envVarOverrides: map[string]map[string]string{
+					"my-job": {
+						"REPORT_DATE": "2023-01-01",
+						"DRY_RUN":     "true",
+					},
+				},
+			},
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(&ecs.RunTaskInput{
+					Cluster:        aws.String("my-cluster"),
+					Count:          aws.Int64(1),
+					LaunchType:     aws.String(ecs.LaunchTypeFargate),
+					StartedBy:      aws.String("job"),
+					TaskDefinition: aws.String("my-job"),
+					NetworkConfiguration: &ecs.NetworkConfiguration{
+						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+							AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
+							Subnets:        aws.StringSlice([]string{"subnet-1"}),
+							SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+						},
+					},
+					Overrides: &ecs.TaskOverride{
+						ContainerOverrides: []*ecs.ContainerOverride{
+							{
+								Name: aws.String("my-job"),
+								Environment: []*ecs.KeyValuePair{
+									{Name: aws.String("DRY_RUN"), Value: aws.String("true")},
+									{Name: aws.String("REPORT_DATE"), Value: aws.String("2023-01-01")},
+								},
+							},
+						},
+					},
+					EnableExecuteCommand: aws.Bool(false),
+					PlatformVersion:      aws.String("LATEST"),
+					PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
+				}).Return(&ecs.RunTaskOutput{
+					Tasks: ecsTasks[:1],
+				}, nil)
+				m.EXPECT().WaitUntilTasksRunning(gomock.Any()).Times(1)
+				m.EXPECT().DescribeTasks(gomock.Any()).Return(&ecs.DescribeTasksOutput{
+					Tasks: ecsTasks[:1],
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskArn: aws.String("task-1"),
+				},
+			},
+		},
 		"run task failed": {
 			input: runTaskInput,
 
@@ -1204,6 +1416,8 @@ func TestECS_RunTask(t *testing.T) {
 				StartedBy:       tc.startedBy,
 				PlatformVersion: tc.platformVersion,
 				EnableExec:      tc.enableExec,
+				AssignPublicIP:  tc.assignPublicIP,
+				EnvVarOverrides: tc.envVarOverrides,
 			})
 
 			if tc.wantedError != nil {
@@ -1375,6 +1589,76 @@ func TestECS_ExecuteCommand(t *testing.T) {
 	}
 }
 
+func TestECS_StartCommandSession(t *testing.T) {
+	mockExecCmdIn := &ecs.ExecuteCommandInput{
+		Cluster:     aws.String("mockCluster"),
+		Command:     aws.String("mockCommand"),
+		Interactive: aws.Bool(true),
+		Container:   aws.String("mockContainer"),
+		Task:        aws.String("mockTask"),
+	}
+	testCases := map[string]struct {
+		mockAPI func(m *mocks.Mockapi)
+
+		wanted      *CommandSession
+		wantedError error
+	}{
+		"return error if fail to call ExecuteCommand": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(nil, errors.New("some error"))
+			},
+			wantedError: &ErrExecuteCommand{err: errors.New("some error")},
+		},
+		"success": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
+					Session: &ecs.Session{
+						SessionId:  aws.String("mockSessID"),
+						StreamUrl:  aws.String("wss://ssmmessages.us-west-2.amazonaws.com/v1/data-channel/mockSessID"),
+						TokenValue: aws.String("mockToken"),
+					},
+				}, nil)
+			},
+			wanted: &CommandSession{
+				ID: "mockSessID",
+				PluginArgs: []string{
+					`{"SessionId":"mockSessID","StreamUrl":"wss://ssmmessages.us-west-2.amazonaws.com/v1/data-channel/mockSessID","TokenValue":"mockToken"}`,
+					"us-west-2",
+					"StartSession",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockAPI(mockAPI)
+
+			ecs := ECS{
+				client: mockAPI,
+				region: "us-west-2",
+			}
+
+			got, err := ecs.StartCommandSession(ExecuteCommandInput{
+				Cluster:   "mockCluster",
+				Command:   "mockCommand",
+				Container: "mockContainer",
+				Task:      "mockTask",
+			})
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestECS_NetworkConfiguration(t *testing.T) {
 	testCases := map[string]struct {
 		mockAPI func(m *mocks.Mockapi)
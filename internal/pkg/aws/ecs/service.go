@@ -70,8 +70,29 @@ func (s *Service) ServiceStatus() ServiceStatus {
 	}
 }
 
+// ServiceConnectClientAlias is an endpoint that the clients of a service connect to with ECS Service Connect.
+type ServiceConnectClientAlias struct {
+	PortName string // Name of the port mapping of the task definition that receives the connections.
+	DNSName  string
+	Port     int64 // Zero if the clients connect to the container port of the port mapping.
+}
+
 // ServiceConnectAliases returns the ECS Service Connect client aliases for a service.
 func (s *Service) ServiceConnectAliases() []string {
+	var aliases []string
+	for _, alias := range s.ServiceConnectClientAliases() {
+		if alias.Port == 0 {
+			aliases = append(aliases, alias.DNSName)
+			continue
+		}
+		aliases = append(aliases, fmt.Sprintf("%s:%v", alias.DNSName, alias.Port))
+	}
+	return aliases
+}
+
+// ServiceConnectClientAliases returns the ECS Service Connect client aliases of the last deployment of a service.
+// A service without client aliases is reachable at its discovery name in the namespace.
+func (s *Service) ServiceConnectClientAliases() []ServiceConnectClientAlias {
 	if len(s.Deployments) == 0 {
 		return nil
 	}
@@ -80,15 +101,19 @@ func (s *Service) ServiceConnectAliases() []string {
 	if scConfig == nil || !aws.BoolValue(scConfig.Enabled) {
 		return nil
 	}
-	var aliases []string
+	var aliases []ServiceConnectClientAlias
 	for _, service := range scConfig.Services {
-		defaultName := aws.StringValue(service.PortName)
+		portName := aws.StringValue(service.PortName)
+		defaultName := portName
 		if aws.StringValue(service.DiscoveryName) != "" {
 			defaultName = aws.StringValue(service.DiscoveryName)
 		}
 		defaultAlias := fmt.Sprintf("%s.%s", defaultName, aws.StringValue(scConfig.Namespace))
 		if len(service.ClientAliases) == 0 {
-			aliases = append(aliases, defaultAlias)
+			aliases = append(aliases, ServiceConnectClientAlias{
+				PortName: portName,
+				DNSName:  defaultAlias,
+			})
 			continue
 		}
 		for _, clientAlias := range service.ClientAliases {
@@ -96,7 +121,11 @@ func (s *Service) ServiceConnectAliases() []string {
 			if aws.StringValue(clientAlias.DnsName) != "" {
 				alias = aws.StringValue(clientAlias.DnsName)
 			}
-			aliases = append(aliases, fmt.Sprintf("%s:%v", alias, aws.Int64Value(clientAlias.Port)))
+			aliases = append(aliases, ServiceConnectClientAlias{
+				PortName: portName,
+				DNSName:  alias,
+				Port:     aws.Int64Value(clientAlias.Port),
+			})
 		}
 	}
 	return aliases
@@ -180,6 +180,74 @@ func TestService_ServiceConnectAliases(t *testing.T) {
 	}
 }
 
+func TestService_ServiceConnectClientAliases(t *testing.T) {
+	tests := map[string]struct {
+		inService *Service
+
+		wanted []ServiceConnectClientAlias
+	}{
+		"no deployments": {
+			inService: &Service{},
+		},
+		"not enabled": {
+			inService: &Service{
+				Deployments: []*ecs.Deployment{
+					{
+						ServiceConnectConfiguration: &ecs.ServiceConnectConfiguration{
+							Enabled: aws.Bool(false),
+						},
+					},
+				},
+			},
+		},
+		"success": {
+			inService: &Service{
+				Deployments: []*ecs.Deployment{
+					{
+						ServiceConnectConfiguration: &ecs.ServiceConnectConfiguration{
+							Enabled:   aws.Bool(true),
+							Namespace: aws.String("foobar.local"),
+							Services: []*ecs.ServiceConnectService{
+								{
+									PortName:      aws.String("target"),
+									DiscoveryName: aws.String("api-sc"),
+									ClientAliases: []*ecs.ServiceConnectClientAlias{
+										{
+											DnsName: aws.String("api"),
+											Port:    aws.Int64(80),
+										},
+										{
+											Port: aws.Int64(8080),
+										},
+									},
+								},
+								{
+									PortName: aws.String("admin"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wanted: []ServiceConnectClientAlias{
+				{PortName: "target", DNSName: "api", Port: 80},
+				{PortName: "target", DNSName: "api-sc.foobar.local", Port: 8080},
+				{PortName: "admin", DNSName: "admin.foobar.local"},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got := tc.inService.ServiceConnectClientAliases()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestParseServiceArn(t *testing.T) {
 	tests := map[string]struct {
 		inArnStr string
@@ -9,23 +9,38 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/dustin/go-humanize/english"
 )
 
+// describeTagsMaxARNs is the maximum number of resources whose tags can be described at once.
+const describeTagsMaxARNs = 20
+
 const (
 	// TargetHealthStateHealthy wraps the ELBV2 health status HEALTHY.
 	TargetHealthStateHealthy = elbv2.TargetHealthStateEnumHealthy
+	// ProtocolHTTP wraps the ELBV2 listener protocol HTTP.
+	ProtocolHTTP = elbv2.ProtocolEnumHttp
+	// SchemeInternetFacing wraps the ELBV2 load balancer scheme internet-facing.
+	SchemeInternetFacing = elbv2.LoadBalancerSchemeEnumInternetFacing
+	// TypeApplication wraps the ELBV2 load balancer type application.
+	TypeApplication = elbv2.LoadBalancerTypeEnumApplication
 )
 
 type api interface {
 	DescribeTargetHealth(*elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
 	DescribeRules(*elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error)
 	DescribeRulesWithContext(context.Context, *elbv2.DescribeRulesInput, ...request.Option) (*elbv2.DescribeRulesOutput, error)
+	DescribeLoadBalancers(*elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error)
+	DescribeListeners(*elbv2.DescribeListenersInput) (*elbv2.DescribeListenersOutput, error)
+	DescribeTargetGroups(*elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error)
+	DescribeTags(*elbv2.DescribeTagsInput) (*elbv2.DescribeTagsOutput, error)
 }
 
 // ELBV2 wraps an AWS ELBV2 client.
@@ -107,6 +122,163 @@ func (r *Rule) HasRedirectAction() bool {
 	return false
 }
 
+// LoadBalancer contains information about a load balancer.
+type LoadBalancer struct {
+	ARN            string
+	Name           string
+	FullName       string // The "app/<name>/<id>" suffix of the ARN used as the dimension of the load balancer's metrics.
+	DNSName        string
+	HostedZoneID   string
+	Scheme         string // "internet-facing" or "internal".
+	Type           string // "application", "network", or "gateway".
+	VPCID          string
+	SecurityGroups []string
+	Listeners      []Listener
+}
+
+// Listener contains information about a listener of a load balancer.
+type Listener struct {
+	ARN      string
+	Port     int64
+	Protocol string
+}
+
+// LoadBalancer returns information about the load balancer and its listeners given the name or the ARN of the load balancer.
+func (e *ELBV2) LoadBalancer(nameOrARN string) (*LoadBalancer, error) {
+	in := &elbv2.DescribeLoadBalancersInput{}
+	if arn.IsARN(nameOrARN) {
+		in.LoadBalancerArns = aws.StringSlice([]string{nameOrARN})
+	} else {
+		in.Names = aws.StringSlice([]string{nameOrARN})
+	}
+	resp, err := e.client.DescribeLoadBalancers(in)
+	if err != nil {
+		return nil, fmt.Errorf("describe load balancer %q: %w", nameOrARN, err)
+	}
+	if len(resp.LoadBalancers) == 0 {
+		return nil, fmt.Errorf("no load balancer %q found", nameOrARN)
+	}
+	lb := resp.LoadBalancers[0]
+	listeners, err := e.listeners(aws.StringValue(lb.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	return &LoadBalancer{
+		ARN:            aws.StringValue(lb.LoadBalancerArn),
+		Name:           aws.StringValue(lb.LoadBalancerName),
+		FullName:       lbFullName(aws.StringValue(lb.LoadBalancerArn)),
+		DNSName:        aws.StringValue(lb.DNSName),
+		HostedZoneID:   aws.StringValue(lb.CanonicalHostedZoneId),
+		Scheme:         aws.StringValue(lb.Scheme),
+		Type:           aws.StringValue(lb.Type),
+		VPCID:          aws.StringValue(lb.VpcId),
+		SecurityGroups: aws.StringValueSlice(lb.SecurityGroups),
+		Listeners:      listeners,
+	}, nil
+}
+
+func (e *ELBV2) listeners(lbARN string) ([]Listener, error) {
+	var listeners []Listener
+	in := &elbv2.DescribeListenersInput{
+		LoadBalancerArn: aws.String(lbARN),
+	}
+	for {
+		resp, err := e.client.DescribeListeners(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe listeners of load balancer %s: %w", lbARN, err)
+		}
+		for _, listener := range resp.Listeners {
+			listeners = append(listeners, Listener{
+				ARN:      aws.StringValue(listener.ListenerArn),
+				Port:     aws.Int64Value(listener.Port),
+				Protocol: aws.StringValue(listener.Protocol),
+			})
+		}
+		if resp.NextMarker == nil {
+			break
+		}
+		in.Marker = resp.NextMarker
+	}
+	return listeners, nil
+}
+
+// lbFullName returns the "app/<name>/<id>" portion of a load balancer ARN.
+func lbFullName(lbARN string) string {
+	parsed, err := arn.Parse(lbARN)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(parsed.Resource, "loadbalancer/")
+}
+
+// Listener returns the first listener of the load balancer on the given port with the given protocol.
+func (lb *LoadBalancer) Listener(port int64, protocol string) (Listener, bool) {
+	for _, listener := range lb.Listeners {
+		if listener.Port == port && listener.Protocol == protocol {
+			return listener, true
+		}
+	}
+	return Listener{}, false
+}
+
+// TargetGroup contains information about a target group.
+type TargetGroup struct {
+	ARN  string
+	Tags map[string]string
+}
+
+// TargetGroups returns the target groups that the load balancer routes traffic to, along with their tags.
+func (e *ELBV2) TargetGroups(lbARN string) ([]TargetGroup, error) {
+	var tgs []TargetGroup
+	in := &elbv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: aws.String(lbARN),
+	}
+	for {
+		resp, err := e.client.DescribeTargetGroups(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe target groups of load balancer %s: %w", lbARN, err)
+		}
+		for _, tg := range resp.TargetGroups {
+			tgs = append(tgs, TargetGroup{
+				ARN:  aws.StringValue(tg.TargetGroupArn),
+				Tags: make(map[string]string),
+			})
+		}
+		if resp.NextMarker == nil {
+			break
+		}
+		in.Marker = resp.NextMarker
+	}
+	for start := 0; start < len(tgs); start += describeTagsMaxARNs {
+		end := start + describeTagsMaxARNs
+		if end > len(tgs) {
+			end = len(tgs)
+		}
+		idx := make(map[string]int, end-start)
+		arns := make([]string, 0, end-start)
+		for i := start; i < end; i++ {
+			idx[tgs[i].ARN] = i
+			arns = append(arns, tgs[i].ARN)
+		}
+		resp, err := e.client.DescribeTags(&elbv2.DescribeTagsInput{
+			ResourceArns: aws.StringSlice(arns),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe tags of target groups: %w", err)
+		}
+		for _, desc := range resp.TagDescriptions {
+			i, ok := idx[aws.StringValue(desc.ResourceArn)]
+			if !ok {
+				continue
+			}
+			for _, tag := range desc.Tags {
+				tgs[i].Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+		}
+	}
+	return tgs, nil
+}
+
 // TargetHealth wraps up elbv2.TargetHealthDescription.
 type TargetHealth elbv2.TargetHealthDescription
 
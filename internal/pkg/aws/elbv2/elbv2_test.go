@@ -330,6 +330,245 @@ func TestELBV2_DescribeRule(t *testing.T) {
 	}
 }
 
+func TestELBV2_LoadBalancer(t *testing.T) {
+	mockLBARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/demo/50dc6c495c0c9188"
+	testCases := map[string]struct {
+		nameOrARN string
+		setUpMock func(m *mocks.Mockapi)
+
+		wanted      *LoadBalancer
+		wantedError string
+	}{
+		"fail to describe load balancers": {
+			nameOrARN: "demo",
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+					Names: aws.StringSlice([]string{"demo"}),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: `describe load balancer "demo": some error`,
+		},
+		"no load balancer found": {
+			nameOrARN: mockLBARN,
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+					LoadBalancerArns: aws.StringSlice([]string{mockLBARN}),
+				}).Return(&elbv2.DescribeLoadBalancersOutput{}, nil)
+			},
+			wantedError: fmt.Sprintf(`no load balancer %q found`, mockLBARN),
+		},
+		"fail to describe listeners": {
+			nameOrARN: mockLBARN,
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(gomock.Any()).Return(&elbv2.DescribeLoadBalancersOutput{
+					LoadBalancers: []*elbv2.LoadBalancer{
+						{
+							LoadBalancerArn: aws.String(mockLBARN),
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeListeners(&elbv2.DescribeListenersInput{
+					LoadBalancerArn: aws.String(mockLBARN),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Sprintf("describe listeners of load balancer %s: some error", mockLBARN),
+		},
+		"success": {
+			nameOrARN: mockLBARN,
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+					LoadBalancerArns: aws.StringSlice([]string{mockLBARN}),
+				}).Return(&elbv2.DescribeLoadBalancersOutput{
+					LoadBalancers: []*elbv2.LoadBalancer{
+						{
+							LoadBalancerArn:       aws.String(mockLBARN),
+							LoadBalancerName:      aws.String("demo"),
+							DNSName:               aws.String("demo-1234.us-west-2.elb.amazonaws.com"),
+							CanonicalHostedZoneId: aws.String("Z1H1FL5HABSF5"),
+							Scheme:                aws.String("internet-facing"),
+							Type:                  aws.String("application"),
+							VpcId:                 aws.String("vpc-1234"),
+							SecurityGroups:        aws.StringSlice([]string{"sg-1", "sg-2"}),
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeListeners(&elbv2.DescribeListenersInput{
+					LoadBalancerArn: aws.String(mockLBARN),
+				}).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{
+						{
+							ListenerArn: aws.String("listener-80"),
+							Port:        aws.Int64(80),
+							Protocol:    aws.String("HTTP"),
+						},
+					},
+					NextMarker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeListeners(&elbv2.DescribeListenersInput{
+					LoadBalancerArn: aws.String(mockLBARN),
+					Marker:          aws.String("next"),
+				}).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{
+						{
+							ListenerArn: aws.String("listener-443"),
+							Port:        aws.Int64(443),
+							Protocol:    aws.String("HTTPS"),
+						},
+					},
+				}, nil)
+			},
+			wanted: &LoadBalancer{
+				ARN:            mockLBARN,
+				Name:           "demo",
+				FullName:       "app/demo/50dc6c495c0c9188",
+				DNSName:        "demo-1234.us-west-2.elb.amazonaws.com",
+				HostedZoneID:   "Z1H1FL5HABSF5",
+				Scheme:         "internet-facing",
+				Type:           "application",
+				VPCID:          "vpc-1234",
+				SecurityGroups: []string{"sg-1", "sg-2"},
+				Listeners: []Listener{
+					{
+						ARN:      "listener-80",
+						Port:     80,
+						Protocol: "HTTP",
+					},
+					{
+						ARN:      "listener-443",
+						Port:     443,
+						Protocol: "HTTPS",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			actual, err := elbv2Client.LoadBalancer(tc.nameOrARN)
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
+
+func TestELBV2_TargetGroups(t *testing.T) {
+	const mockLBARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/demo/50dc6c495c0c9188"
+	tgARN := func(i int) string {
+		return fmt.Sprintf("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-%d/73e2d6bc24d8a067", i)
+	}
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wanted      []TargetGroup
+		wantedError string
+	}{
+		"fail to describe target groups": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+					LoadBalancerArn: aws.String(mockLBARN),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Sprintf("describe target groups of load balancer %s: some error", mockLBARN),
+		},
+		"fail to describe tags": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetGroups(gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []*elbv2.TargetGroup{{TargetGroupArn: aws.String(tgARN(0))}},
+				}, nil)
+				m.EXPECT().DescribeTags(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: "describe tags of target groups: some error",
+		},
+		"describe the tags of the target groups across pages in batches": {
+			setUpMock: func(m *mocks.Mockapi) {
+				var page1 []*elbv2.TargetGroup
+				for i := 0; i < 20; i++ {
+					page1 = append(page1, &elbv2.TargetGroup{TargetGroupArn: aws.String(tgARN(i))})
+				}
+				m.EXPECT().DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+					LoadBalancerArn: aws.String(mockLBARN),
+				}).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: page1,
+					NextMarker:   aws.String("marker"),
+				}, nil)
+				m.EXPECT().DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+					LoadBalancerArn: aws.String(mockLBARN),
+					Marker:          aws.String("marker"),
+				}).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []*elbv2.TargetGroup{{TargetGroupArn: aws.String(tgARN(20))}},
+				}, nil)
+				m.EXPECT().DescribeTags(gomock.Any()).DoAndReturn(func(in *elbv2.DescribeTagsInput) (*elbv2.DescribeTagsOutput, error) {
+					require.Len(t, in.ResourceArns, 20)
+					return &elbv2.DescribeTagsOutput{
+						TagDescriptions: []*elbv2.TagDescription{
+							{
+								ResourceArn: aws.String(tgARN(0)),
+								Tags:        []*elbv2.Tag{{Key: aws.String("copilot-service"), Value: aws.String("api")}},
+							},
+						},
+					}, nil
+				})
+				m.EXPECT().DescribeTags(&elbv2.DescribeTagsInput{
+					ResourceArns: aws.StringSlice([]string{tgARN(20)}),
+				}).Return(&elbv2.DescribeTagsOutput{
+					TagDescriptions: []*elbv2.TagDescription{
+						{
+							ResourceArn: aws.String(tgARN(20)),
+							Tags:        []*elbv2.Tag{{Key: aws.String("copilot-service"), Value: aws.String("fe")}},
+						},
+					},
+				}, nil)
+			},
+			wanted: func() []TargetGroup {
+				var tgs []TargetGroup
+				for i := 0; i <= 20; i++ {
+					tgs = append(tgs, TargetGroup{ARN: tgARN(i), Tags: map[string]string{}})
+				}
+				tgs[0].Tags["copilot-service"] = "api"
+				tgs[20].Tags["copilot-service"] = "fe"
+				return tgs
+			}(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			actual, err := elbv2Client.TargetGroups(mockLBARN)
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
+
 func TestELBV2Rule_HasRedirectAction(t *testing.T) {
 	testCases := map[string]struct {
 		rule     Rule
@@ -6,6 +6,7 @@ package iam
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -26,6 +27,8 @@ type api interface {
 	DeleteRole(input *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error)
 	CreateServiceLinkedRole(input *iam.CreateServiceLinkedRoleInput) (*iam.CreateServiceLinkedRoleOutput, error)
 	ListPolicies(input *iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error)
+	ListOpenIDConnectProviders(input *iam.ListOpenIDConnectProvidersInput) (*iam.ListOpenIDConnectProvidersOutput, error)
+	SimulatePrincipalPolicy(input *iam.SimulatePrincipalPolicyInput) (*iam.SimulatePolicyResponse, error)
 }
 
 // IAM wraps the AWS SDK's IAM client.
@@ -125,6 +128,70 @@ func (c *IAM) ListPolicyNames() ([]string, error) {
 	return policyNames, nil
 }
 
+// OpenIDConnectProviderARN returns the ARN of the IAM OIDC provider whose URL has the host, or an empty string if there is none.
+func (c *IAM) OpenIDConnectProviderARN(host string) (string, error) {
+	out, err := c.client.ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return "", fmt.Errorf("list IAM OIDC providers: %w", err)
+	}
+	for _, provider := range out.OpenIDConnectProviderList {
+		// The ARN of a provider ends with its URL without the scheme, e.g. arn:aws:iam::1111:oidc-provider/token.actions.githubusercontent.com
+		providerARN := aws.StringValue(provider.Arn)
+		if strings.HasSuffix(providerARN, ":oidc-provider/"+host) {
+			return providerARN, nil
+		}
+	}
+	return "", nil
+}
+
+// SimulatePolicyInput holds the fields needed to simulate whether a principal is allowed to call actions.
+type SimulatePolicyInput struct {
+	PrincipalARN string
+	Actions      []string
+
+	ResourceARN  string            // Optional. Defaults to all resources.
+	ResourceTags map[string]string // Optional. Tags of the resource, to evaluate the conditions on them.
+}
+
+// DeniedActions simulates the policies attached to the principal and returns the actions that it is not allowed to call on the resource.
+func (c *IAM) DeniedActions(in SimulatePolicyInput) ([]string, error) {
+	input := &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(in.PrincipalARN),
+		ActionNames:     aws.StringSlice(in.Actions),
+	}
+	if in.ResourceARN != "" {
+		input.ResourceArns = aws.StringSlice([]string{in.ResourceARN})
+	}
+	keys := make([]string, 0, len(in.ResourceTags))
+	for key := range in.ResourceTags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		input.ContextEntries = append(input.ContextEntries, &iam.ContextEntry{
+			ContextKeyName:   aws.String("aws:ResourceTag/" + key),
+			ContextKeyType:   aws.String(iam.ContextKeyTypeEnumString),
+			ContextKeyValues: aws.StringSlice([]string{in.ResourceTags[key]}),
+		})
+	}
+	var denied []string
+	for {
+		out, err := c.client.SimulatePrincipalPolicy(input)
+		if err != nil {
+			return nil, fmt.Errorf("simulate policies of %s: %w", in.PrincipalARN, err)
+		}
+		for _, result := range out.EvaluationResults {
+			if aws.StringValue(result.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+				denied = append(denied, aws.StringValue(result.EvalActionName))
+			}
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return denied, nil
+		}
+		input.Marker = out.Marker
+	}
+}
+
 func (c *IAM) deleteRolePolicies(roleName string) error {
 	policyNames, err := c.listRolePolicyNames(roleName)
 	if err != nil {
@@ -303,3 +303,154 @@ func TestIAM_ListPolicies(t *testing.T) {
 		})
 	}
 }
+
+func TestIAM_OpenIDConnectProviderARN(t *testing.T) {
+	testCases := map[string]struct {
+		inClient func(ctrl *gomock.Controller) *mocks.Mockapi
+
+		wantedARN string
+		wantedErr error
+	}{
+		"wraps error on failure": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListOpenIDConnectProviders(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("list IAM OIDC providers: some error"),
+		},
+		"returns the ARN of the provider with the host": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListOpenIDConnectProviders(gomock.Any()).Return(&iam.ListOpenIDConnectProvidersOutput{
+					OpenIDConnectProviderList: []*iam.OpenIDConnectProviderListEntry{
+						{Arn: aws.String("arn:aws:iam::1111:oidc-provider/gitlab.com")},
+						{Arn: aws.String("arn:aws:iam::1111:oidc-provider/token.actions.githubusercontent.com")},
+					},
+				}, nil)
+				return m
+			},
+			wantedARN: "arn:aws:iam::1111:oidc-provider/token.actions.githubusercontent.com",
+		},
+		"returns an empty string if there is no provider with the host": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListOpenIDConnectProviders(gomock.Any()).Return(&iam.ListOpenIDConnectProvidersOutput{
+					OpenIDConnectProviderList: []*iam.OpenIDConnectProviderListEntry{
+						{Arn: aws.String("arn:aws:iam::1111:oidc-provider/gitlab.com")},
+					},
+				}, nil)
+				return m
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := &IAM{
+				client: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			arn, err := client.OpenIDConnectProviderARN("token.actions.githubusercontent.com")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, arn)
+		})
+	}
+}
+
+func TestIAM_DeniedActions(t *testing.T) {
+	in := SimulatePolicyInput{
+		PrincipalARN: "arn:aws:iam::1111:role/phonetool-test-api-TaskRole",
+		Actions:      []string{"kms:Decrypt"},
+		ResourceARN:  "arn:aws:kms:us-west-2:1111:key/1234",
+		ResourceTags: map[string]string{
+			"copilot-environment": "test",
+			"copilot-application": "phonetool",
+		},
+	}
+	wantedInput := &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String("arn:aws:iam::1111:role/phonetool-test-api-TaskRole"),
+		ActionNames:     aws.StringSlice([]string{"kms:Decrypt"}),
+		ResourceArns:    aws.StringSlice([]string{"arn:aws:kms:us-west-2:1111:key/1234"}),
+		ContextEntries: []*iam.ContextEntry{
+			{
+				ContextKeyName:   aws.String("aws:ResourceTag/copilot-application"),
+				ContextKeyType:   aws.String("string"),
+				ContextKeyValues: aws.StringSlice([]string{"phonetool"}),
+			},
+			{
+				ContextKeyName:   aws.String("aws:ResourceTag/copilot-environment"),
+				ContextKeyType:   aws.String("string"),
+				ContextKeyValues: aws.StringSlice([]string{"test"}),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inClient func(ctrl *gomock.Controller) *mocks.Mockapi
+
+		wantedActions []string
+		wantedErr     error
+	}{
+		"wraps error on failure": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().SimulatePrincipalPolicy(wantedInput).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("simulate policies of arn:aws:iam::1111:role/phonetool-test-api-TaskRole: some error"),
+		},
+		"returns the actions that are not allowed across pages": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().SimulatePrincipalPolicy(wantedInput).Return(&iam.SimulatePolicyResponse{
+					EvaluationResults: []*iam.EvaluationResult{
+						{EvalActionName: aws.String("kms:Decrypt"), EvalDecision: aws.String("implicitDeny")},
+					},
+					IsTruncated: aws.Bool(true),
+					Marker:      aws.String("next"),
+				}, nil)
+				m.EXPECT().SimulatePrincipalPolicy(gomock.Any()).DoAndReturn(func(input *iam.SimulatePrincipalPolicyInput) (*iam.SimulatePolicyResponse, error) {
+					require.Equal(t, "next", aws.StringValue(input.Marker))
+					return &iam.SimulatePolicyResponse{
+						EvaluationResults: []*iam.EvaluationResult{
+							{EvalActionName: aws.String("kms:Decrypt"), EvalDecision: aws.String("allowed")},
+							{EvalActionName: aws.String("kms:Decrypt"), EvalDecision: aws.String("explicitDeny")},
+						},
+					}, nil
+				})
+				return m
+			},
+			wantedActions: []string{"kms:Decrypt", "kms:Decrypt"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := &IAM{
+				client: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			actions, err := client.DeniedActions(in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedActions, actions)
+		})
+	}
+}
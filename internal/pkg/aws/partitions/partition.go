@@ -5,6 +5,7 @@ package partitions
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 )
@@ -34,3 +35,54 @@ func IsAvailableInRegion(sID string, region string) (bool, error) {
 	_, existInRegion := regions[region]
 	return existInRegion, nil
 }
+
+// IsFIPSAvailableInRegion returns true if the service ID has a FIPS endpoint in the given region.
+func IsFIPSAvailableInRegion(sID string, region string) (bool, error) {
+	partition, err := Region(region).Partition()
+	if err != nil {
+		return false, err
+	}
+	_, err = partition.EndpointFor(sID, region, func(opts *endpoints.Options) {
+		opts.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+		opts.StrictMatching = true
+	})
+	return err == nil, nil
+}
+
+// RegionsWithServices returns the sorted regions, in the same partition as the given region,
+// where all the service IDs are available, and have a FIPS endpoint if fips is true.
+func RegionsWithServices(region string, sIDs []string, fips bool) ([]string, error) {
+	partition, err := Region(region).Partition()
+	if err != nil {
+		return nil, err
+	}
+	var regions []string
+	for candidate := range partition.Regions() {
+		ok, err := isEveryServiceAvailableInRegion(sIDs, candidate, fips)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			regions = append(regions, candidate)
+		}
+	}
+	sort.Strings(regions)
+	return regions, nil
+}
+
+func isEveryServiceAvailableInRegion(sIDs []string, region string, fips bool) (bool, error) {
+	isAvailable := IsAvailableInRegion
+	if fips {
+		isAvailable = IsFIPSAvailableInRegion
+	}
+	for _, sID := range sIDs {
+		ok, err := isAvailable(sID, region)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
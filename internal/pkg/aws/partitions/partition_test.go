@@ -79,3 +79,78 @@ func TestRegion_IsAvailableInRegion(t *testing.T) {
 		})
 	}
 }
+
+func TestIsFIPSAvailableInRegion(t *testing.T) {
+	testCases := map[string]struct {
+		sID       string
+		region    string
+		want      bool
+		wantedErr error
+	}{
+		"error finding the partition": {
+			region:    "weird region",
+			sID:       ecs.EndpointsID,
+			wantedErr: errors.New("find the partition for region weird region"),
+		},
+		"ecs service has a FIPS endpoint in the given region": {
+			region: "us-west-2",
+			sID:    ecs.EndpointsID,
+			want:   true,
+		},
+		"ecs service does not have a FIPS endpoint in the given region": {
+			region: "eu-west-1",
+			sID:    ecs.EndpointsID,
+			want:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsFIPSAvailableInRegion(tc.sID, tc.region)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRegionsWithServices(t *testing.T) {
+	testCases := map[string]struct {
+		region    string
+		sIDs      []string
+		fips      bool
+		want      []string
+		wantedErr error
+	}{
+		"error finding the partition": {
+			region:    "weird region",
+			wantedErr: errors.New("find the partition for region weird region"),
+		},
+		"regions with a FIPS endpoint for every service": {
+			region: "eu-west-1",
+			sIDs:   []string{ecs.EndpointsID, apprunner.EndpointsID},
+			fips:   true,
+			want:   []string{"us-east-1", "us-east-2", "us-west-2"},
+		},
+		"only regions in the same partition": {
+			region: "us-gov-west-1",
+			sIDs:   []string{ecs.EndpointsID},
+			want:   []string{"us-gov-east-1", "us-gov-west-1"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := RegionsWithServices(tc.region, tc.sIDs, tc.fips)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
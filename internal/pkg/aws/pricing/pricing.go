@@ -0,0 +1,143 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pricing provides a client to make API requests to the AWS Price List Query API.
+package pricing
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/pricing"
+)
+
+const (
+	// The Price List Query API is only available in a few regions, and returns the prices of every region.
+	apiRegion = "us-east-1"
+
+	regionCodeAttribute = "regionCode"
+	currencyUSD         = "USD"
+)
+
+type api interface {
+	GetProductsPages(input *pricing.GetProductsInput, fn func(*pricing.GetProductsOutput, bool) bool) error
+}
+
+// Product is a product of an AWS service with its On-Demand price.
+type Product struct {
+	UsageType    string
+	Unit         string
+	PricePerUnit float64 // In USD.
+}
+
+// Pricing wraps an AWS Price List Query API client.
+type Pricing struct {
+	client api
+}
+
+// New returns a Pricing struct configured against the input session, which calls the API in us-east-1.
+func New(s *session.Session) *Pricing {
+	return &Pricing{
+		client: pricing.New(s, aws.NewConfig().WithRegion(apiRegion)),
+	}
+}
+
+// Products returns the products of the service in the region whose attributes have the given values.
+func (p *Pricing) Products(serviceCode, region string, attributes map[string]string) ([]Product, error) {
+	filters := []*pricing.Filter{termMatch(regionCodeAttribute, region)}
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		filters = append(filters, termMatch(name, attributes[name]))
+	}
+	var products []Product
+	var parseErr error
+	err := p.client.GetProductsPages(&pricing.GetProductsInput{
+		ServiceCode:   aws.String(serviceCode),
+		Filters:       filters,
+		FormatVersion: aws.String("aws_v1"),
+	}, func(out *pricing.GetProductsOutput, lastPage bool) bool {
+		for _, item := range out.PriceList {
+			product, ok, err := parseProduct(item)
+			if err != nil {
+				parseErr = err
+				return false
+			}
+			if ok {
+				products = append(products, product)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get products of service %s in region %s: %w", serviceCode, region, err)
+	}
+	if parseErr != nil {
+		return nil, fmt.Errorf("parse products of service %s in region %s: %w", serviceCode, region, parseErr)
+	}
+	return products, nil
+}
+
+func termMatch(field, value string) *pricing.Filter {
+	return &pricing.Filter{
+		Type:  aws.String(pricing.FilterTypeTermMatch),
+		Field: aws.String(field),
+		Value: aws.String(value),
+	}
+}
+
+// priceListItem is a product of the price list, along with its pricing terms.
+type priceListItem struct {
+	Product struct {
+		Attributes map[string]string `json:"attributes"`
+	} `json:"product"`
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				Unit         string            `json:"unit"`
+				BeginRange   string            `json:"beginRange"`
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+// parseProduct returns the On-Demand price of the first usage tier of a product, and false if it doesn't have one in USD.
+func parseProduct(item aws.JSONValue) (Product, bool, error) {
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return Product{}, false, err
+	}
+	var parsed priceListItem
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return Product{}, false, err
+	}
+	for _, term := range parsed.Terms.OnDemand {
+		for _, dimension := range term.PriceDimensions {
+			if dimension.BeginRange != "" && dimension.BeginRange != "0" {
+				continue
+			}
+			usd, ok := dimension.PricePerUnit[currencyUSD]
+			if !ok {
+				continue
+			}
+			price, err := strconv.ParseFloat(usd, 64)
+			if err != nil {
+				return Product{}, false, fmt.Errorf("parse price %q of usage type %s: %w", usd, parsed.Product.Attributes["usagetype"], err)
+			}
+			return Product{
+				UsageType:    parsed.Product.Attributes["usagetype"],
+				Unit:         dimension.Unit,
+				PricePerUnit: price,
+			}, true, nil
+		}
+	}
+	return Product{}, false, nil
+}
@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package pricing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockPriceListItem(usageType, unit, beginRange, usd string) aws.JSONValue {
+	return aws.JSONValue{
+		"product": map[string]interface{}{
+			"productFamily": "Compute",
+			"attributes": map[string]interface{}{
+				"usagetype":  usageType,
+				"regionCode": "us-west-2",
+			},
+		},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"ABC.JRTCKXETXF": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"ABC.JRTCKXETXF.6YS6EN2CT7": map[string]interface{}{
+							"unit":       unit,
+							"beginRange": beginRange,
+							"pricePerUnit": map[string]interface{}{
+								"USD": usd,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPricing_Products(t *testing.T) {
+	wantedInput := &pricing.GetProductsInput{
+		ServiceCode: aws.String("AmazonECS"),
+		Filters: []*pricing.Filter{
+			{Type: aws.String("TERM_MATCH"), Field: aws.String("regionCode"), Value: aws.String("us-west-2")},
+			{Type: aws.String("TERM_MATCH"), Field: aws.String("productFamily"), Value: aws.String("Compute")},
+			{Type: aws.String("TERM_MATCH"), Field: aws.String("tenancy"), Value: aws.String("Shared")},
+		},
+		FormatVersion: aws.String("aws_v1"),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedProducts []Product
+		wantedErr      error
+	}{
+		"error if the products can't be retrieved": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetProductsPages(wantedInput, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("get products of service AmazonECS in region us-west-2: some error"),
+		},
+		"error if a price can't be parsed": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetProductsPages(wantedInput, gomock.Any()).DoAndReturn(func(_ *pricing.GetProductsInput, fn func(*pricing.GetProductsOutput, bool) bool) error {
+					fn(&pricing.GetProductsOutput{
+						PriceList: []aws.JSONValue{mockPriceListItem("USW2-Fargate-vCPU-Hours:perCPU", "hours", "0", "cheap")},
+					}, true)
+					return nil
+				})
+			},
+			wantedErr: errors.New(`parse products of service AmazonECS in region us-west-2: parse price "cheap" of usage type USW2-Fargate-vCPU-Hours:perCPU: strconv.ParseFloat: parsing "cheap": invalid syntax`),
+		},
+		"return the price of the first tier of the products of every page": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetProductsPages(wantedInput, gomock.Any()).DoAndReturn(func(_ *pricing.GetProductsInput, fn func(*pricing.GetProductsOutput, bool) bool) error {
+					if !fn(&pricing.GetProductsOutput{
+						PriceList: []aws.JSONValue{
+							mockPriceListItem("USW2-Fargate-vCPU-Hours:perCPU", "hours", "0", "0.0404800000"),
+							mockPriceListItem("USW2-DataTransfer-Out-Bytes", "GB", "10240", "0.0850000000"),
+						},
+					}, false) {
+						return nil
+					}
+					fn(&pricing.GetProductsOutput{
+						PriceList: []aws.JSONValue{mockPriceListItem("USW2-Fargate-GB-Hours", "hours", "0", "0.0044450000")},
+					}, true)
+					return nil
+				})
+			},
+			wantedProducts: []Product{
+				{UsageType: "USW2-Fargate-vCPU-Hours:perCPU", Unit: "hours", PricePerUnit: 0.04048},
+				{UsageType: "USW2-Fargate-GB-Hours", Unit: "hours", PricePerUnit: 0.004445},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			p := &Pricing{
+				client: m,
+			}
+
+			products, err := p.Products("AmazonECS", "us-west-2", map[string]string{
+				"tenancy":       "Shared",
+				"productFamily": "Compute",
+			})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedProducts, products)
+		})
+	}
+}
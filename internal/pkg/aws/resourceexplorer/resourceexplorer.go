@@ -0,0 +1,66 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package resourceexplorer provides a client to make API requests to AWS Resource Explorer.
+package resourceexplorer
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/resourceexplorer2"
+)
+
+// RegionGlobal is the region of global resources, like IAM roles.
+const RegionGlobal = "global"
+
+type api interface {
+	Search(input *resourceexplorer2.SearchInput) (*resourceexplorer2.SearchOutput, error)
+}
+
+// ResourceExplorer wraps an AWS Resource Explorer client.
+type ResourceExplorer struct {
+	client api
+}
+
+// Resource is a resource found by AWS Resource Explorer.
+type Resource struct {
+	ARN    string
+	Type   string // For example, "ecs:service".
+	Region string // The region of the resource, or RegionGlobal.
+}
+
+// New returns a ResourceExplorer configured against the input session.
+func New(s *session.Session) *ResourceExplorer {
+	return &ResourceExplorer{
+		client: resourceexplorer2.New(s),
+	}
+}
+
+// Search returns the resources that match the query string with the default view of the region of the session.
+// See https://docs.aws.amazon.com/resource-explorer/latest/userguide/using-search-query-syntax.html for the syntax of queries.
+func (re *ResourceExplorer) Search(query string) ([]*Resource, error) {
+	var resources []*Resource
+	var token *string
+	for {
+		out, err := re.client.Search(&resourceexplorer2.SearchInput{
+			QueryString: aws.String(query),
+			NextToken:   token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("search resources: %w", err)
+		}
+		for _, r := range out.Resources {
+			resources = append(resources, &Resource{
+				ARN:    aws.StringValue(r.Arn),
+				Type:   aws.StringValue(r.ResourceType),
+				Region: aws.StringValue(r.Region),
+			})
+		}
+		if token = out.NextToken; aws.StringValue(token) == "" {
+			break
+		}
+	}
+	return resources, nil
+}
@@ -0,0 +1,99 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceexplorer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourceexplorer2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourceexplorer/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceExplorer_Search(t *testing.T) {
+	const (
+		mockQuery  = "tag:copilot-application=demo db"
+		mockTable  = "arn:aws:dynamodb:us-west-2:123456789012:table/demo-test-db"
+		mockBucket = "arn:aws:s3:::demo-test-db-backups"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    []*Resource
+		wantedErr error
+	}{
+		"wraps error from API call": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().Search(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("search resources: some error"),
+		},
+		"success with pagination": {
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().Search(&resourceexplorer2.SearchInput{
+						QueryString: aws.String(mockQuery),
+					}).Return(&resourceexplorer2.SearchOutput{
+						NextToken: aws.String("mockNextToken"),
+						Resources: []*resourceexplorer2.Resource{
+							{
+								Arn:          aws.String(mockTable),
+								ResourceType: aws.String("dynamodb:table"),
+								Region:       aws.String("us-west-2"),
+							},
+						},
+					}, nil),
+					m.EXPECT().Search(&resourceexplorer2.SearchInput{
+						QueryString: aws.String(mockQuery),
+						NextToken:   aws.String("mockNextToken"),
+					}).Return(&resourceexplorer2.SearchOutput{
+						Resources: []*resourceexplorer2.Resource{
+							{
+								Arn:          aws.String(mockBucket),
+								ResourceType: aws.String("s3:bucket"),
+								Region:       aws.String("us-west-2"),
+							},
+						},
+					}, nil),
+				)
+			},
+			wanted: []*Resource{
+				{
+					ARN:    mockTable,
+					Type:   "dynamodb:table",
+					Region: "us-west-2",
+				},
+				{
+					ARN:    mockBucket,
+					Type:   "s3:bucket",
+					Region: "us-west-2",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			re := &ResourceExplorer{client: m}
+
+			// WHEN
+			got, err := re.Search(mockQuery)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -16,6 +16,9 @@ import (
 const (
 	// ResourceTypeStateMachine is the resource type for the state machine of a job.
 	ResourceTypeStateMachine = "states:stateMachine"
+
+	// maxResourceARNsPerRequest is the maximum number of ARNs that GetResources accepts.
+	maxResourceARNsPerRequest = 100
 )
 
 type api interface {
@@ -65,19 +68,7 @@ func (rg *ResourceGroups) GetResourcesByTags(resourceType string, tags map[strin
 		if err != nil {
 			return nil, fmt.Errorf("get resource: %w", err)
 		}
-		for _, resourceTagMapping := range resourceResp.ResourceTagMappingList {
-			tags := make(map[string]string)
-			for _, tag := range resourceTagMapping.Tags {
-				if tag.Key == nil {
-					continue
-				}
-				tags[*tag.Key] = aws.StringValue(tag.Value)
-			}
-			resources = append(resources, &Resource{
-				ARN:  aws.StringValue(resourceTagMapping.ResourceARN),
-				Tags: tags,
-			})
-		}
+		resources = append(resources, toResources(resourceResp.ResourceTagMappingList)...)
 		// usually pagination token is "" when it doesn't have any next page. However, since it
 		// is type *string, it is safer for us to check nil value for it as well.
 		if token := resourceResp.PaginationToken; aws.StringValue(token) == "" {
@@ -87,3 +78,41 @@ func (rg *ResourceGroups) GetResourcesByTags(resourceType string, tags map[strin
 
 	return resources, nil
 }
+
+// GetResourcesByARNs gets the tag set of the resources with the input ARNs.
+// Resources that don't exist or that the Resource Groups Tagging API doesn't support are not returned.
+func (rg *ResourceGroups) GetResourcesByARNs(arns []string) ([]*Resource, error) {
+	var resources []*Resource
+	for start := 0; start < len(arns); start += maxResourceARNsPerRequest {
+		end := start + maxResourceARNsPerRequest
+		if end > len(arns) {
+			end = len(arns)
+		}
+		resp, err := rg.client.GetResources(&resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: aws.StringSlice(arns[start:end]),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get resources: %w", err)
+		}
+		resources = append(resources, toResources(resp.ResourceTagMappingList)...)
+	}
+	return resources, nil
+}
+
+func toResources(mappings []*resourcegroupstaggingapi.ResourceTagMapping) []*Resource {
+	resources := make([]*Resource, 0, len(mappings))
+	for _, resourceTagMapping := range mappings {
+		tags := make(map[string]string)
+		for _, tag := range resourceTagMapping.Tags {
+			if tag.Key == nil {
+				continue
+			}
+			tags[*tag.Key] = aws.StringValue(tag.Value)
+		}
+		resources = append(resources, &Resource{
+			ARN:  aws.StringValue(resourceTagMapping.ResourceARN),
+			Tags: tags,
+		})
+	}
+	return resources
+}
@@ -153,3 +153,86 @@ func TestResourceGroups_GetResourcesByTags(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceGroups_GetResourcesByARNs(t *testing.T) {
+	manyARNs := make([]string, 101)
+	for i := range manyARNs {
+		manyARNs[i] = fmt.Sprintf("arn:aws:cloudwatch:us-west-2:1234567890:alarm:mockAlarmName%d", i)
+	}
+	testCases := map[string]struct {
+		inARNs      []string
+		setupMocks  func(m *mocks.Mockapi)
+		expectedOut []*Resource
+		expectedErr error
+	}{
+		"no resources": {
+			setupMocks: func(m *mocks.Mockapi) {},
+		},
+		"wraps error from API call": {
+			inARNs: []string{mockArn1},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetResources(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedErr: fmt.Errorf("get resources: some error"),
+		},
+		"gets the tags of the resources in batches of 100": {
+			inARNs: manyARNs,
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetResources(&rgapi.GetResourcesInput{
+						ResourceARNList: aws.StringSlice(manyARNs[:100]),
+					}).Return(&rgapi.GetResourcesOutput{
+						ResourceTagMappingList: []*rgapi.ResourceTagMapping{
+							{
+								ResourceARN: aws.String(manyARNs[0]),
+								Tags:        []*rgapi.Tag{{Key: aws.String("copilot-environment"), Value: aws.String("test")}},
+							},
+						},
+					}, nil),
+					m.EXPECT().GetResources(&rgapi.GetResourcesInput{
+						ResourceARNList: aws.StringSlice(manyARNs[100:]),
+					}).Return(&rgapi.GetResourcesOutput{
+						ResourceTagMappingList: []*rgapi.ResourceTagMapping{
+							{
+								ResourceARN: aws.String(manyARNs[100]),
+							},
+						},
+					}, nil),
+				)
+			},
+			expectedOut: []*Resource{
+				{
+					ARN:  manyARNs[0],
+					Tags: testTags,
+				},
+				{
+					ARN:  manyARNs[100],
+					Tags: map[string]string{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			rg := &ResourceGroups{client: mockClient}
+			tc.setupMocks(mockClient)
+
+			// WHEN
+			actualOut, actualErr := rg.GetResourcesByARNs(tc.inARNs)
+
+			// THEN
+			if tc.expectedErr != nil {
+				require.EqualError(t, actualErr, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, actualErr)
+			require.Equal(t, tc.expectedOut, actualOut)
+		})
+	}
+}
@@ -20,11 +20,18 @@ const (
 	// See https://docs.aws.amazon.com/general/latest/gr/r53.html
 	// For Route53 API endpoint, "Route 53 in AWS Regions other than the Beijing and Ningxia Regions: specify us-east-1 as the Region."
 	route53Region = "us-east-1"
+
+	// Route 53 considers an endpoint healthy if more than 18% of its health checkers report it as healthy.
+	// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-determining-health-of-endpoints.html
+	healthyCheckersPercentThreshold = 18
+	healthCheckSuccessPrefix        = "Success"
 )
 
 type api interface {
 	ListHostedZonesByName(*route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error)
 	ListResourceRecordSets(*route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error)
+	GetHealthCheck(*route53.GetHealthCheckInput) (*route53.GetHealthCheckOutput, error)
+	GetHealthCheckStatus(*route53.GetHealthCheckStatusInput) (*route53.GetHealthCheckStatusOutput, error)
 }
 
 type nameserverResolver interface {
@@ -147,6 +154,55 @@ func (r53 *Route53) lookupNSRecords(domainName string) ([]string, error) {
 	return records, nil
 }
 
+// HealthCheckStatus holds the status of a Route 53 health check as reported by its health checkers.
+type HealthCheckStatus struct {
+	ID                string `json:"id"`
+	Domain            string `json:"domain"`
+	Healthy           bool   `json:"healthy"`
+	HealthyCheckers   int    `json:"healthyCheckers"`
+	Checkers          int    `json:"checkers"`
+	LastFailureReason string `json:"lastFailureReason,omitempty"`
+}
+
+// HealthCheckStatus returns the status of the health check with the given ID.
+func (r53 *Route53) HealthCheckStatus(id string) (*HealthCheckStatus, error) {
+	check, err := r53.client.GetHealthCheck(&route53.GetHealthCheckInput{
+		HealthCheckId: aws.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get health check %s: %w", id, err)
+	}
+	out, err := r53.client.GetHealthCheckStatus(&route53.GetHealthCheckStatusInput{
+		HealthCheckId: aws.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get status of health check %s: %w", id, err)
+	}
+	status := &HealthCheckStatus{
+		ID:       id,
+		Checkers: len(out.HealthCheckObservations),
+	}
+	if cfg := check.HealthCheck.HealthCheckConfig; cfg != nil {
+		status.Domain = aws.StringValue(cfg.FullyQualifiedDomainName)
+	}
+	var lastFailure time.Time
+	for _, observation := range out.HealthCheckObservations {
+		if observation.StatusReport == nil {
+			continue
+		}
+		report := aws.StringValue(observation.StatusReport.Status)
+		if strings.HasPrefix(report, healthCheckSuccessPrefix) {
+			status.HealthyCheckers++
+			continue
+		}
+		if checkedAt := aws.TimeValue(observation.StatusReport.CheckedTime); status.LastFailureReason == "" || checkedAt.After(lastFailure) {
+			status.LastFailureReason, lastFailure = report, checkedAt
+		}
+	}
+	status.Healthy = status.HealthyCheckers*100 > status.Checkers*healthyCheckersPercentThreshold
+	return status, nil
+}
+
 type filterZoneFunc func(*route53.HostedZone) bool
 
 func filterHostedZones(zones []*route53.HostedZone, fn filterZoneFunc) []*route53.HostedZone {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
@@ -399,3 +400,113 @@ func TestRoute53_ValidateDomainOwnership(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestRoute53_HealthCheckStatus(t *testing.T) {
+	observation := func(status string, checkedAt time.Time) *route53.HealthCheckObservation {
+		return &route53.HealthCheckObservation{
+			StatusReport: &route53.StatusReport{
+				Status:      aws.String(status),
+				CheckedTime: aws.Time(checkedAt),
+			},
+		}
+	}
+	now := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    *HealthCheckStatus
+		wantedErr error
+	}{
+		"error if fail to get the health check": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHealthCheck(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get health check abc: some error"),
+		},
+		"error if fail to get the status of the health check": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHealthCheck(gomock.Any()).Return(&route53.GetHealthCheckOutput{
+					HealthCheck: &route53.HealthCheck{},
+				}, nil)
+				m.EXPECT().GetHealthCheckStatus(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get status of health check abc: some error"),
+		},
+		"healthy if more than 18% of the checkers report success": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHealthCheck(&route53.GetHealthCheckInput{
+					HealthCheckId: aws.String("abc"),
+				}).Return(&route53.GetHealthCheckOutput{
+					HealthCheck: &route53.HealthCheck{
+						HealthCheckConfig: &route53.HealthCheckConfig{
+							FullyQualifiedDomainName: aws.String("example.com"),
+						},
+					},
+				}, nil)
+				m.EXPECT().GetHealthCheckStatus(&route53.GetHealthCheckStatusInput{
+					HealthCheckId: aws.String("abc"),
+				}).Return(&route53.GetHealthCheckStatusOutput{
+					HealthCheckObservations: []*route53.HealthCheckObservation{
+						observation("Success: HTTP Status Code 200, OK", now),
+						observation("Failure: HTTP Status Code 503, Service Unavailable", now.Add(-time.Minute)),
+						observation("Failure: Connection timed out.", now),
+						observation("Failure: HTTP Status Code 502, Bad Gateway", now.Add(-2*time.Minute)),
+					},
+				}, nil)
+			},
+			wanted: &HealthCheckStatus{
+				ID:                "abc",
+				Domain:            "example.com",
+				Healthy:           true,
+				HealthyCheckers:   1,
+				Checkers:          4,
+				LastFailureReason: "Failure: Connection timed out.",
+			},
+		},
+		"unhealthy if no checker reports success": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHealthCheck(gomock.Any()).Return(&route53.GetHealthCheckOutput{
+					HealthCheck: &route53.HealthCheck{
+						HealthCheckConfig: &route53.HealthCheckConfig{
+							FullyQualifiedDomainName: aws.String("example.com"),
+						},
+					},
+				}, nil)
+				m.EXPECT().GetHealthCheckStatus(gomock.Any()).Return(&route53.GetHealthCheckStatusOutput{
+					HealthCheckObservations: []*route53.HealthCheckObservation{
+						observation("Failure: HTTP Status Code 503, Service Unavailable", now),
+					},
+				}, nil)
+			},
+			wanted: &HealthCheckStatus{
+				ID:                "abc",
+				Domain:            "example.com",
+				Checkers:          1,
+				LastFailureReason: "Failure: HTTP Status Code 503, Service Unavailable",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			r53 := Route53{
+				client: m,
+			}
+
+			// WHEN
+			got, err := r53.HealthCheckStatus("abc")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
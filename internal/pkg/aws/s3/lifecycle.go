@@ -0,0 +1,231 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	errCodeNoSuchLifecycleConfiguration = "NoSuchLifecycleConfiguration"
+
+	// maxDeleteObjects is the maximum number of objects that a DeleteObjects request can delete.
+	maxDeleteObjects = 1000
+)
+
+// LifecycleRule expires the objects under a prefix of a bucket.
+type LifecycleRule struct {
+	ID             string
+	Prefix         string
+	ExpirationDays int
+}
+
+// ObjectVersion is a version of an object, or a delete marker, in a versioned bucket.
+type ObjectVersion struct {
+	Key          string
+	VersionID    string
+	Size         int64
+	LastModified time.Time
+}
+
+// LifecycleRule returns the rule of the lifecycle configuration of the bucket with the ID, or nil if there is no such rule.
+func (s *S3) LifecycleRule(bucket, id string) (*LifecycleRule, error) {
+	rules, err := s.lifecycleRules(bucket)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if aws.StringValue(rule.ID) != id {
+			continue
+		}
+		out := &LifecycleRule{
+			ID:     id,
+			Prefix: aws.StringValue(rule.Prefix),
+		}
+		if rule.Filter != nil && rule.Filter.Prefix != nil {
+			out.Prefix = aws.StringValue(rule.Filter.Prefix)
+		}
+		if rule.Expiration != nil {
+			out.ExpirationDays = int(aws.Int64Value(rule.Expiration.Days))
+		}
+		return out, nil
+	}
+	return nil, nil
+}
+
+// PutLifecycleRule adds the rule to the lifecycle configuration of the bucket, or replaces the rule with the same ID.
+// Since the bucket is versioned, the objects that expire are deleted permanently one day after they became noncurrent.
+func (s *S3) PutLifecycleRule(bucket string, rule LifecycleRule) error {
+	rules, err := s.lifecycleRules(bucket)
+	if err != nil {
+		return err
+	}
+	newRule := &s3.LifecycleRule{
+		ID:     aws.String(rule.ID),
+		Status: aws.String(s3.ExpirationStatusEnabled),
+		Expiration: &s3.LifecycleExpiration{
+			Days: aws.Int64(int64(rule.ExpirationDays)),
+		},
+		NoncurrentVersionExpiration: &s3.NoncurrentVersionExpiration{
+			NoncurrentDays: aws.Int64(1),
+		},
+		AbortIncompleteMultipartUpload: &s3.AbortIncompleteMultipartUpload{
+			DaysAfterInitiation: aws.Int64(1),
+		},
+	}
+	// A lifecycle configuration can't mix rules with a top-level prefix and rules with a filter.
+	legacyPrefix := false
+	for _, existing := range rules {
+		if existing.Prefix != nil {
+			legacyPrefix = true
+			break
+		}
+	}
+	if legacyPrefix {
+		newRule.Prefix = aws.String(rule.Prefix)
+	} else {
+		newRule.Filter = &s3.LifecycleRuleFilter{
+			Prefix: aws.String(rule.Prefix),
+		}
+	}
+	updated := []*s3.LifecycleRule{newRule}
+	for _, existing := range rules {
+		if aws.StringValue(existing.ID) != rule.ID {
+			updated = append(updated, existing)
+		}
+	}
+	return s.putLifecycleRules(bucket, updated)
+}
+
+// DeleteLifecycleRule removes the rule with the ID from the lifecycle configuration of the bucket, if it exists.
+func (s *S3) DeleteLifecycleRule(bucket, id string) error {
+	rules, err := s.lifecycleRules(bucket)
+	if err != nil {
+		return err
+	}
+	var updated []*s3.LifecycleRule
+	for _, rule := range rules {
+		if aws.StringValue(rule.ID) != id {
+			updated = append(updated, rule)
+		}
+	}
+	if len(updated) == len(rules) {
+		return nil
+	}
+	if len(updated) == 0 {
+		if _, err := s.s3Client.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{
+			Bucket: aws.String(bucket),
+		}); err != nil {
+			return fmt.Errorf("delete lifecycle configuration of bucket %s: %w", bucket, err)
+		}
+		return nil
+	}
+	return s.putLifecycleRules(bucket, updated)
+}
+
+// ObjectVersionsBefore returns the versions of the objects, and the delete markers, under the prefix of the bucket
+// that were last modified before the time.
+func (s *S3) ObjectVersionsBefore(bucket, prefix string, before time.Time) ([]ObjectVersion, error) {
+	var versions []ObjectVersion
+	in := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}
+	for {
+		out, err := s.s3Client.ListObjectVersions(in)
+		if err != nil {
+			return nil, fmt.Errorf("list object versions of bucket %s: %w", bucket, err)
+		}
+		for _, version := range out.Versions {
+			if aws.TimeValue(version.LastModified).Before(before) {
+				versions = append(versions, ObjectVersion{
+					Key:          aws.StringValue(version.Key),
+					VersionID:    aws.StringValue(version.VersionId),
+					Size:         aws.Int64Value(version.Size),
+					LastModified: aws.TimeValue(version.LastModified),
+				})
+			}
+		}
+		for _, marker := range out.DeleteMarkers {
+			if aws.TimeValue(marker.LastModified).Before(before) {
+				versions = append(versions, ObjectVersion{
+					Key:          aws.StringValue(marker.Key),
+					VersionID:    aws.StringValue(marker.VersionId),
+					LastModified: aws.TimeValue(marker.LastModified),
+				})
+			}
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return versions, nil
+		}
+		in.KeyMarker = out.NextKeyMarker
+		in.VersionIdMarker = out.NextVersionIdMarker
+	}
+}
+
+// DeleteObjectVersions permanently deletes the versions of objects from the bucket.
+func (s *S3) DeleteObjectVersions(bucket string, versions []ObjectVersion) error {
+	for start := 0; start < len(versions); start += maxDeleteObjects {
+		end := start + maxDeleteObjects
+		if end > len(versions) {
+			end = len(versions)
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, version := range versions[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key:       aws.String(version.Key),
+				VersionId: aws.String(version.VersionID),
+			})
+		}
+		out, err := s.s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		switch {
+		case err != nil:
+			return fmt.Errorf("delete objects from bucket %s: %w", bucket, err)
+		case len(out.Errors) > 0:
+			return errors.Join(
+				fmt.Errorf("%d/%d objects failed to delete", len(out.Errors), len(objects)),
+				fmt.Errorf("first failed on key %q: %s", aws.StringValue(out.Errors[0].Key), aws.StringValue(out.Errors[0].Message)),
+			)
+		}
+	}
+	return nil
+}
+
+func (s *S3) lifecycleRules(bucket string) ([]*s3.LifecycleRule, error) {
+	out, err := s.s3Client.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == errCodeNoSuchLifecycleConfiguration {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get lifecycle configuration of bucket %s: %w", bucket, err)
+	}
+	return out.Rules, nil
+}
+
+func (s *S3) putLifecycleRules(bucket string, rules []*s3.LifecycleRule) error {
+	if _, err := s.s3Client.PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}); err != nil {
+		return fmt.Errorf("put lifecycle configuration of bucket %s: %w", bucket, err)
+	}
+	return nil
+}
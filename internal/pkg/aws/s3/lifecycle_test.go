@@ -0,0 +1,324 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3_PutLifecycleRule(t *testing.T) {
+	rule := LifecycleRule{
+		ID:             "ExpirePipelineArtifacts-pipeline-app-api-Pi",
+		Prefix:         "pipeline-app-api-Pi/",
+		ExpirationDays: 30,
+	}
+	wantedRule := func(legacyPrefix bool) *s3.LifecycleRule {
+		r := &s3.LifecycleRule{
+			ID:     aws.String("ExpirePipelineArtifacts-pipeline-app-api-Pi"),
+			Status: aws.String("Enabled"),
+			Expiration: &s3.LifecycleExpiration{
+				Days: aws.Int64(30),
+			},
+			NoncurrentVersionExpiration: &s3.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(1),
+			},
+			AbortIncompleteMultipartUpload: &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(1),
+			},
+		}
+		if legacyPrefix {
+			r.Prefix = aws.String("pipeline-app-api-Pi/")
+		} else {
+			r.Filter = &s3.LifecycleRuleFilter{Prefix: aws.String("pipeline-app-api-Pi/")}
+		}
+		return r
+	}
+	localAssetsRule := &s3.LifecycleRule{
+		ID:     aws.String("ExpireLocalAssets"),
+		Status: aws.String("Enabled"),
+		Prefix: aws.String("local-assets"),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mocks3API)
+
+		wantedErr string
+	}{
+		"error if the lifecycle configuration can't be retrieved": {
+			setupMocks: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get lifecycle configuration of bucket mockBucket: some error",
+		},
+		"add the rule to a bucket without a lifecycle configuration": {
+			setupMocks: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(nil, awserr.New(errCodeNoSuchLifecycleConfiguration, "", nil))
+				m.EXPECT().PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+					Bucket: aws.String("mockBucket"),
+					LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+						Rules: []*s3.LifecycleRule{wantedRule(false)},
+					},
+				}).Return(&s3.PutBucketLifecycleConfigurationOutput{}, nil)
+			},
+		},
+		"replace the rule and keep the other rules of the bucket": {
+			setupMocks: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+					Bucket: aws.String("mockBucket"),
+				}).Return(&s3.GetBucketLifecycleConfigurationOutput{
+					Rules: []*s3.LifecycleRule{
+						localAssetsRule,
+						{
+							ID:     aws.String("ExpirePipelineArtifacts-pipeline-app-api-Pi"),
+							Prefix: aws.String("pipeline-app-api-Pi/"),
+						},
+					},
+				}, nil)
+				m.EXPECT().PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+					Bucket: aws.String("mockBucket"),
+					LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+						Rules: []*s3.LifecycleRule{wantedRule(true), localAssetsRule},
+					},
+				}).Return(&s3.PutBucketLifecycleConfigurationOutput{}, nil)
+			},
+		},
+		"error if the lifecycle configuration can't be updated": {
+			setupMocks: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(&s3.GetBucketLifecycleConfigurationOutput{}, nil)
+				m.EXPECT().PutBucketLifecycleConfiguration(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "put lifecycle configuration of bucket mockBucket: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocks3API(ctrl)
+			tc.setupMocks(m)
+			service := S3{
+				s3Client: m,
+			}
+
+			// WHEN
+			err := service.PutLifecycleRule("mockBucket", rule)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestS3_DeleteLifecycleRule(t *testing.T) {
+	pipelineRule := &s3.LifecycleRule{
+		ID:     aws.String("ExpirePipelineArtifacts-pipeline-app-api-Pi"),
+		Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("pipeline-app-api-Pi/")},
+	}
+	otherRule := &s3.LifecycleRule{
+		ID:     aws.String("ExpireLocalAssets"),
+		Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("local-assets")},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mocks3API)
+
+		wantedErr string
+	}{
+		"no-op if the rule doesn't exist": {
+			setupMocks: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(&s3.GetBucketLifecycleConfigurationOutput{
+					Rules: []*s3.LifecycleRule{otherRule},
+				}, nil)
+			},
+		},
+		"keep the other rules of the bucket": {
+			setupMocks: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(&s3.GetBucketLifecycleConfigurationOutput{
+					Rules: []*s3.LifecycleRule{otherRule, pipelineRule},
+				}, nil)
+				m.EXPECT().PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+					Bucket: aws.String("mockBucket"),
+					LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+						Rules: []*s3.LifecycleRule{otherRule},
+					},
+				}).Return(&s3.PutBucketLifecycleConfigurationOutput{}, nil)
+			},
+		},
+		"delete the lifecycle configuration without other rules": {
+			setupMocks: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(&s3.GetBucketLifecycleConfigurationOutput{
+					Rules: []*s3.LifecycleRule{pipelineRule},
+				}, nil)
+				m.EXPECT().DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{
+					Bucket: aws.String("mockBucket"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "delete lifecycle configuration of bucket mockBucket: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocks3API(ctrl)
+			tc.setupMocks(m)
+			service := S3{
+				s3Client: m,
+			}
+
+			// WHEN
+			err := service.DeleteLifecycleRule("mockBucket", "ExpirePipelineArtifacts-pipeline-app-api-Pi")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestS3_LifecycleRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMocks3API(ctrl)
+	m.EXPECT().GetBucketLifecycleConfiguration(gomock.Any()).Return(&s3.GetBucketLifecycleConfigurationOutput{
+		Rules: []*s3.LifecycleRule{
+			{
+				ID:         aws.String("ExpirePipelineArtifacts-pipeline-app-api-Pi"),
+				Filter:     &s3.LifecycleRuleFilter{Prefix: aws.String("pipeline-app-api-Pi/")},
+				Expiration: &s3.LifecycleExpiration{Days: aws.Int64(14)},
+			},
+		},
+	}, nil).Times(2)
+	service := S3{
+		s3Client: m,
+	}
+
+	got, err := service.LifecycleRule("mockBucket", "ExpirePipelineArtifacts-pipeline-app-api-Pi")
+	require.NoError(t, err)
+	require.Equal(t, &LifecycleRule{
+		ID:             "ExpirePipelineArtifacts-pipeline-app-api-Pi",
+		Prefix:         "pipeline-app-api-Pi/",
+		ExpirationDays: 14,
+	}, got)
+
+	got, err = service.LifecycleRule("mockBucket", "ExpireLocalAssets")
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestS3_ObjectVersionsBefore(t *testing.T) {
+	before := time.Date(2023, time.November, 1, 0, 0, 0, 0, time.UTC)
+	old, recent := before.Add(-time.Hour), before.Add(time.Hour)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMocks3API(ctrl)
+	gomock.InOrder(
+		m.EXPECT().ListObjectVersions(&s3.ListObjectVersionsInput{
+			Bucket: aws.String("mockBucket"),
+			Prefix: aws.String("pipeline-app-api-Pi/"),
+		}).Return(&s3.ListObjectVersionsOutput{
+			Versions: []*s3.ObjectVersion{
+				{Key: aws.String("pipeline-app-api-Pi/BuildOutpu/a"), VersionId: aws.String("1"), Size: aws.Int64(10), LastModified: aws.Time(old)},
+				{Key: aws.String("pipeline-app-api-Pi/BuildOutpu/b"), VersionId: aws.String("1"), Size: aws.Int64(20), LastModified: aws.Time(recent)},
+			},
+			IsTruncated:         aws.Bool(true),
+			NextKeyMarker:       aws.String("pipeline-app-api-Pi/BuildOutpu/b"),
+			NextVersionIdMarker: aws.String("1"),
+		}, nil),
+		m.EXPECT().ListObjectVersions(&s3.ListObjectVersionsInput{
+			Bucket:          aws.String("mockBucket"),
+			Prefix:          aws.String("pipeline-app-api-Pi/"),
+			KeyMarker:       aws.String("pipeline-app-api-Pi/BuildOutpu/b"),
+			VersionIdMarker: aws.String("1"),
+		}).Return(&s3.ListObjectVersionsOutput{
+			DeleteMarkers: []*s3.DeleteMarkerEntry{
+				{Key: aws.String("pipeline-app-api-Pi/SCCheckout/c"), VersionId: aws.String("2"), LastModified: aws.Time(old)},
+			},
+		}, nil),
+	)
+	service := S3{
+		s3Client: m,
+	}
+
+	got, err := service.ObjectVersionsBefore("mockBucket", "pipeline-app-api-Pi/", before)
+
+	require.NoError(t, err)
+	require.Equal(t, []ObjectVersion{
+		{Key: "pipeline-app-api-Pi/BuildOutpu/a", VersionID: "1", Size: 10, LastModified: old},
+		{Key: "pipeline-app-api-Pi/SCCheckout/c", VersionID: "2", LastModified: old},
+	}, got)
+}
+
+func TestS3_DeleteObjectVersions(t *testing.T) {
+	versions := make([]ObjectVersion, maxDeleteObjects+1)
+	for i := range versions {
+		versions[i] = ObjectVersion{Key: fmt.Sprintf("key-%d", i), VersionID: "1"}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mocks3API)
+
+		wantedErr string
+	}{
+		"delete the versions in batches": {
+			setupMocks: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(gomock.Any()).DoAndReturn(func(in *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+					require.Len(t, in.Delete.Objects, maxDeleteObjects)
+					return &s3.DeleteObjectsOutput{}, nil
+				})
+				m.EXPECT().DeleteObjects(gomock.Any()).DoAndReturn(func(in *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+					require.Equal(t, []*s3.ObjectIdentifier{{Key: aws.String("key-1000"), VersionId: aws.String("1")}}, in.Delete.Objects)
+					return &s3.DeleteObjectsOutput{}, nil
+				})
+			},
+		},
+		"error if some versions failed to delete": {
+			setupMocks: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Return(&s3.DeleteObjectsOutput{
+					Errors: []*s3.Error{{Key: aws.String("key-0"), Message: aws.String("access denied")}},
+				}, nil)
+			},
+			wantedErr: "1/1000 objects failed to delete\nfirst failed on key \"key-0\": access denied",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocks3API(ctrl)
+			tc.setupMocks(m)
+			service := S3{
+				s3Client: m,
+			}
+
+			// WHEN
+			err := service.DeleteObjectVersions("mockBucket", versions)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -120,9 +120,22 @@ func (s *SecretsManager) DescribeSecret(secretName string) (*DescribeSecretOutpu
 // GetSecretValue retrieves the value of a secret from AWS Secrets Manager.
 // It takes the name of the secret as input and returns the corresponding value as a string.
 func (s *SecretsManager) GetSecretValue(ctx context.Context, name string) (string, error) {
-	resp, err := s.secretsManager.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{
+	return s.GetSecretVersionValue(ctx, name, "", "")
+}
+
+// GetSecretVersionValue retrieves the value of a specific version of a secret from AWS Secrets Manager.
+// The version is identified by either its staging label or its ID, if both are empty then the current version is retrieved.
+func (s *SecretsManager) GetSecretVersionValue(ctx context.Context, name, versionStage, versionID string) (string, error) {
+	in := &secretsmanager.GetSecretValueInput{
 		SecretId: aws.String(name),
-	})
+	}
+	if versionStage != "" {
+		in.VersionStage = aws.String(versionStage)
+	}
+	if versionID != "" {
+		in.VersionId = aws.String(versionID)
+	}
+	resp, err := s.secretsManager.GetSecretValueWithContext(ctx, in)
 	if err != nil {
 		return "", fmt.Errorf("get secret %q from secrets manager: %w", name, err)
 	}
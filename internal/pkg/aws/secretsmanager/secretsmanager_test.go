@@ -302,3 +302,56 @@ func TestSecretsManager_GetSecretValue(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_GetSecretVersionValue(t *testing.T) {
+	tests := map[string]struct {
+		versionStage string
+		versionID    string
+		setupMock    func(m *mocks.Mockapi)
+
+		want string
+	}{
+		"by version stage": {
+			versionStage: "AWSPREVIOUS",
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValueWithContext(gomock.Any(), &secretsmanager.GetSecretValueInput{
+					SecretId:     aws.String("asdf"),
+					VersionStage: aws.String("AWSPREVIOUS"),
+				}).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String("previous"),
+				}, nil)
+			},
+			want: "previous",
+		},
+		"by version ID": {
+			versionID: "1234",
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValueWithContext(gomock.Any(), &secretsmanager.GetSecretValueInput{
+					SecretId:  aws.String("asdf"),
+					VersionId: aws.String("1234"),
+				}).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String("pinned"),
+				}, nil)
+			},
+			want: "pinned",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			api := mocks.NewMockapi(ctrl)
+			tc.setupMock(api)
+
+			sm := SecretsManager{
+				secretsManager: api,
+			}
+
+			got, err := sm.GetSecretVersionValue(context.Background(), "asdf", tc.versionStage, tc.versionID)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
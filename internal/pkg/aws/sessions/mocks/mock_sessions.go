@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	credentials "github.com/aws/aws-sdk-go/aws/credentials"
 	session "github.com/aws/aws-sdk-go/aws/session"
@@ -36,16 +37,16 @@ func (m *MocksessionValidator) EXPECT() *MocksessionValidatorMockRecorder {
 }
 
 // ValidateCredentials mocks base method.
-func (m *MocksessionValidator) ValidateCredentials(sess *session.Session) (credentials.Value, error) {
+func (m *MocksessionValidator) ValidateCredentials(sess *session.Session, timeout time.Duration) (credentials.Value, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateCredentials", sess)
+	ret := m.ctrl.Call(m, "ValidateCredentials", sess, timeout)
 	ret0, _ := ret[0].(credentials.Value)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ValidateCredentials indicates an expected call of ValidateCredentials.
-func (mr *MocksessionValidatorMockRecorder) ValidateCredentials(sess interface{}) *gomock.Call {
+func (mr *MocksessionValidatorMockRecorder) ValidateCredentials(sess, timeout interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCredentials", reflect.TypeOf((*MocksessionValidator)(nil).ValidateCredentials), sess)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCredentials", reflect.TypeOf((*MocksessionValidator)(nil).ValidateCredentials), sess, timeout)
 }
@@ -6,19 +6,28 @@ package sessions
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"runtime"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"gopkg.in/ini.v1"
 )
 
 // Timeout settings.
@@ -26,8 +35,29 @@ const (
 	maxRetriesOnRecoverableFailures = 8 // Default provided by SDK is 3 which means requests are retried up to only 2 seconds.
 	credsTimeout                    = 10 * time.Second
 	clientTimeout                   = 30 * time.Second
+	interactiveCredsTimeout         = 2 * time.Minute // Long enough to run a credential process or to prompt for an MFA token code.
 )
 
+// STSEndpointEnvVar is the URL of the STS endpoint to get credentials from, such as the one of a VPC endpoint.
+const STSEndpointEnvVar = "AWS_ENDPOINT_URL_STS"
+
+// Shared AWS config and credentials files settings.
+const (
+	configFileEnvVar        = "AWS_CONFIG_FILE"
+	credsFileEnvVar         = "AWS_SHARED_CREDENTIALS_FILE"
+	profileEnvVar           = "AWS_PROFILE"
+	defaultProfileEnvVar    = "AWS_DEFAULT_PROFILE"
+	defaultProfileName      = "default"
+	configFileProfilePrefix = "profile "
+
+	credentialProcessKey = "credential_process"
+	mfaSerialKey         = "mfa_serial"
+	sourceProfileKey     = "source_profile"
+)
+
+// maxSourceIdentityLength is the maximum length of the source identity of a role session.
+const maxSourceIdentityLength = 64
+
 // User-Agent settings.
 const (
 	userAgentProductName = "aws-copilot"
@@ -36,15 +66,21 @@ const (
 // Provider provides methods to create sessions.
 // Once a session is created, it's cached locally so that the same session is not re-created.
 type Provider struct {
-	defaultSess *session.Session
+	mu           sync.Mutex // Guards the cached sessions, which can be requested concurrently.
+	defaultSess  *session.Session
+	defaultCreds *credentials.Credentials    // Credentials shared by all the default sessions.
+	profileSess  map[string]*session.Session // Sessions by profile name.
+	roleSession  *RoleSession                // Settings of the sessions of assumed roles.
+	sourceID     string                      // Source identity resolved from the caller of the default session.
 
 	// Metadata associated with the provider.
 	userAgentExtras  []string
 	sessionValidator sessionValidator
+	ctx              context.Context // Context of the requests of the sessions, nil if they aren't scoped to an operation.
 }
 
 type sessionValidator interface {
-	ValidateCredentials(sess *session.Session) (credentials.Value, error)
+	ValidateCredentials(sess *session.Session, timeout time.Duration) (credentials.Value, error)
 }
 
 var instance *Provider
@@ -53,16 +89,31 @@ var once sync.Once
 // ImmutableProvider returns an immutable session Provider with the options applied.
 func ImmutableProvider(options ...func(*Provider)) *Provider {
 	once.Do(func() {
-		instance = &Provider{
-			sessionValidator: &validator{},
-		}
-		for _, option := range options {
-			option(instance)
-		}
+		instance = NewProvider(options...)
 	})
 	return instance
 }
 
+// NewProvider returns a session Provider with the options applied. Unlike ImmutableProvider, the provider isn't shared
+// with the rest of the process, so that its sessions can be scoped to a single operation with WithContext.
+func NewProvider(options ...func(*Provider)) *Provider {
+	p := &Provider{
+		sessionValidator: &validator{},
+	}
+	for _, option := range options {
+		option(p)
+	}
+	return p
+}
+
+// WithContext scopes the sessions of a provider to ctx: their requests use ctx unless they have a context of their own,
+// such as the requests of waiters, and all of them fail once ctx is done.
+func WithContext(ctx context.Context) func(*Provider) {
+	return func(p *Provider) {
+		p.ctx = ctx
+	}
+}
+
 // UserAgentExtras augments a session provider with additional User-Agent extras.
 func UserAgentExtras(extras ...string) func(*Provider) {
 	return func(p *Provider) {
@@ -78,6 +129,18 @@ func (p *Provider) UserAgentExtras(extras ...string) {
 	}
 }
 
+// RoleSession holds the settings of the sessions of the roles that the provider assumes,
+// so that CloudTrail records who initiated an operation even through a shared role.
+type RoleSession struct {
+	Tags           map[string]string // Session tags, passed to the sessions of the roles.
+	SourceIdentity bool              // If true, the source identity is set to the name of the caller of the default session.
+}
+
+// RoleSession sets the session tags and source identity of the roles assumed from now on.
+func (p *Provider) RoleSession(rs *RoleSession) {
+	p.roleSession = rs
+}
+
 // Default returns a session configured against the "default" AWS profile.
 // Default assumes that a region must be present with a session, otherwise it returns an error.
 func (p *Provider) Default() (*session.Session, error) {
@@ -93,20 +156,21 @@ func (p *Provider) Default() (*session.Session, error) {
 
 // DefaultWithRegion returns a session configured against the "default" AWS profile and the input region.
 func (p *Provider) DefaultWithRegion(region string) (*session.Session, error) {
-	sess, err := session.NewSessionWithOptions(session.Options{
-		Config:                  *newConfig().WithRegion(region),
-		SharedConfigState:       session.SharedConfigEnable,
-		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
-	})
+	sess, err := p.newDefaultSession(newConfig().WithRegion(region))
 	if err != nil {
 		return nil, err
 	}
-	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	p.addHandlers(sess)
 	return sess, nil
 }
 
 // FromProfile returns a session configured against the input profile name.
 func (p *Provider) FromProfile(name string) (*session.Session, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if sess, ok := p.profileSess[name]; ok {
+		return sess, nil
+	}
 	sess, err := session.NewSessionWithOptions(session.Options{
 		Config:                  *newConfig(),
 		SharedConfigState:       session.SharedConfigEnable,
@@ -119,13 +183,17 @@ func (p *Provider) FromProfile(name string) (*session.Session, error) {
 	if aws.StringValue(sess.Config.Region) == "" {
 		return nil, &errMissingRegion{}
 	}
-	if _, err := p.sessionValidator.ValidateCredentials(sess); err != nil {
+	if _, err := p.sessionValidator.ValidateCredentials(sess, validateCredsTimeout(name)); err != nil {
 		if isCredRetrievalErr(err) {
 			return nil, &errCredRetrieval{profile: name, parentErr: err}
 		}
 		return nil, err
 	}
-	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	p.addHandlers(sess)
+	if p.profileSess == nil {
+		p.profileSess = make(map[string]*session.Session)
+	}
+	p.profileSess[name] = sess
 	return sess, nil
 }
 
@@ -136,7 +204,21 @@ func (p *Provider) FromRole(roleARN string, region string) (*session.Session, er
 		return nil, fmt.Errorf("create default session: %w", err)
 	}
 
-	creds := stscreds.NewCredentials(defaultSession, roleARN)
+	opts, err := p.assumeRoleOptions(defaultSession)
+	if err != nil {
+		return nil, err
+	}
+	// Assume the role with the STS endpoint of the region, which is the regional one if "sts_regional_endpoints" is set.
+	stsSession := defaultSession.Copy(&aws.Config{
+		Region: aws.String(region),
+	})
+	creds := stscreds.NewCredentials(stsSession, roleARN)
+	if len(opts) > 0 {
+		creds = credentials.NewCredentials(&roleSessionProvider{
+			withSession:    newAssumeRoleProvider(stsSession, roleARN, opts...),
+			withoutSession: newAssumeRoleProvider(stsSession, roleARN),
+		})
+	}
 	sess, err := session.NewSession(
 		newConfig().
 			WithCredentials(creds).
@@ -145,10 +227,117 @@ func (p *Provider) FromRole(roleARN string, region string) (*session.Session, er
 	if err != nil {
 		return nil, err
 	}
-	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	p.addHandlers(sess)
 	return sess, nil
 }
 
+// assumeRoleOptions returns the options that set the session tags and source identity of an assumed role.
+func (p *Provider) assumeRoleOptions(defaultSession *session.Session) ([]func(*stscreds.AssumeRoleProvider), error) {
+	if p.roleSession == nil {
+		return nil, nil
+	}
+	var opts []func(*stscreds.AssumeRoleProvider)
+	if len(p.roleSession.Tags) > 0 {
+		tags := sessionTags(p.roleSession.Tags)
+		opts = append(opts, func(arp *stscreds.AssumeRoleProvider) {
+			arp.Tags = tags
+		})
+	}
+	if p.roleSession.SourceIdentity {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.sourceID == "" {
+			out, err := sts.New(defaultSession).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+			if err != nil {
+				return nil, fmt.Errorf("get caller identity for the source identity: %w", err)
+			}
+			p.sourceID = sourceIdentity(aws.StringValue(out.Arn))
+		}
+		sourceID := p.sourceID
+		opts = append(opts, func(arp *stscreds.AssumeRoleProvider) {
+			arp.SourceIdentity = aws.String(sourceID)
+		})
+	}
+	return opts, nil
+}
+
+func newAssumeRoleProvider(sess *session.Session, roleARN string, opts ...func(*stscreds.AssumeRoleProvider)) *stscreds.AssumeRoleProvider {
+	p := &stscreds.AssumeRoleProvider{
+		Client:   sts.New(sess),
+		RoleARN:  roleARN,
+		Duration: stscreds.DefaultDuration,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// roleSessionProvider assumes a role with session tags and a source identity, and without them if the role denies it.
+// The trust policies of the roles of environments deployed before Copilot set them don't allow sts:TagSession
+// and sts:SetSourceIdentity, and these environments must remain deployable to get the updated roles.
+type roleSessionProvider struct {
+	withSession    credentials.Provider
+	withoutSession credentials.Provider
+	denied         bool // Set once the role denied the session tags or the source identity.
+}
+
+// Retrieve returns the credentials of the role.
+func (p *roleSessionProvider) Retrieve() (credentials.Value, error) {
+	if !p.denied {
+		creds, err := p.withSession.Retrieve()
+		if !isAccessDenied(err) {
+			return creds, err
+		}
+		p.denied = true
+	}
+	return p.withoutSession.Retrieve()
+}
+
+// IsExpired returns true if the credentials of the role must be retrieved again.
+func (p *roleSessionProvider) IsExpired() bool {
+	if p.denied {
+		return p.withoutSession.IsExpired()
+	}
+	return p.withSession.IsExpired()
+}
+
+func isAccessDenied(err error) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == "AccessDenied"
+}
+
+// sessionTags returns the tags sorted by key, so that the AssumeRole requests are deterministic.
+func sessionTags(tags map[string]string) []*sts.Tag {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	out := make([]*sts.Tag, len(keys))
+	for i, key := range keys {
+		out[i] = &sts.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(tags[key]),
+		}
+	}
+	return out
+}
+
+// sourceIdentity returns the name of the caller with the ARN, like "alice" for "arn:aws:iam::123456789012:user/alice",
+// or the session name for an assumed role, like "alice@example.com" for "arn:aws:sts::123456789012:assumed-role/Admin/alice@example.com".
+func sourceIdentity(callerARN string) string {
+	sep := "/"
+	if !strings.Contains(callerARN, sep) {
+		sep = ":" // The root user, like "arn:aws:iam::123456789012:root".
+	}
+	name := callerARN[strings.LastIndex(callerARN, sep)+1:]
+	if len(name) > maxSourceIdentityLength {
+		name = name[:maxSourceIdentityLength]
+	}
+	return name
+}
+
 // FromStaticCreds returns a session from static credentials.
 func (p *Provider) FromStaticCreds(accessKeyID, secretAccessKey, sessionToken string) (*session.Session, error) {
 	conf := newConfig()
@@ -159,35 +348,51 @@ func (p *Provider) FromStaticCreds(accessKeyID, secretAccessKey, sessionToken st
 	if err != nil {
 		return nil, fmt.Errorf("create session from static credentials: %w", err)
 	}
-	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	p.addHandlers(sess)
 	return sess, nil
 }
 
 func (p *Provider) defaultSession() (*session.Session, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	if p.defaultSess != nil {
 		return p.defaultSess, nil
 	}
 
-	sess, err := session.NewSessionWithOptions(session.Options{
-		Config:                  *newConfig(),
-		SharedConfigState:       session.SharedConfigEnable,
-		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
-	})
+	sess, err := p.newDefaultSession(newConfig())
 	if err != nil {
 		return nil, err
 	}
-	if _, err = p.sessionValidator.ValidateCredentials(sess); err != nil {
+	if _, err = p.sessionValidator.ValidateCredentials(sess, validateCredsTimeout("")); err != nil {
 		if isCredRetrievalErr(err) {
 			return nil, &errCredRetrieval{parentErr: err}
 		}
 		return nil, err
 	}
 
-	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	p.addHandlers(sess)
 	p.defaultSess = sess
 	return sess, nil
 }
 
+// newDefaultSession returns a session with the credentials of the default chain.
+// All the default sessions share the same credentials, so that an MFA token code is only prompted for once.
+func (p *Provider) newDefaultSession(conf *aws.Config) (*session.Session, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:                  *conf,
+		SharedConfigState:       session.SharedConfigEnable,
+		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if p.defaultCreds == nil {
+		p.defaultCreds = sess.Config.Credentials
+	}
+	sess.Config.Credentials = p.defaultCreds
+	return sess, nil
+}
+
 // AreCredsFromEnvVars returns true if the session's credentials provider is environment variables, false otherwise.
 // An error is returned if the credentials are invalid or the request times out.
 func AreCredsFromEnvVars(sess *session.Session) (bool, error) {
@@ -215,10 +420,29 @@ func newConfig() *aws.Config {
 	c := &http.Client{
 		Timeout: clientTimeout,
 	}
-	return aws.NewConfig().
+	conf := aws.NewConfig().
 		WithHTTPClient(c).
 		WithCredentialsChainVerboseErrors(true).
 		WithMaxRetries(maxRetriesOnRecoverableFailures)
+	if url := os.Getenv(STSEndpointEnvVar); url != "" {
+		conf.EndpointResolver = stsEndpointResolver(url)
+	}
+	return conf
+}
+
+// stsEndpointResolver returns a resolver that resolves the endpoints of STS to the URL,
+// and the endpoints of the other services to their default ones.
+func stsEndpointResolver(url string) endpoints.Resolver {
+	return endpoints.ResolverFunc(func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		if service != endpoints.StsServiceID {
+			return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+		}
+		return endpoints.ResolvedEndpoint{
+			URL:           url,
+			SigningRegion: region,
+			SigningMethod: "v4",
+		}, nil
+	})
 }
 
 // userAgentHandler returns a http request handler that sets the AWS Copilot custom user agent to all aws requests.
@@ -231,10 +455,100 @@ func (p *Provider) userAgentHandler() request.NamedHandler {
 	}
 }
 
+// addHandlers adds the handlers of the provider to a new session.
+func (p *Provider) addHandlers(sess *session.Session) {
+	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	if p.ctx != nil {
+		sess.Handlers.Validate.PushFrontNamed(contextHandler(p.ctx))
+	}
+}
+
+// contextHandler returns a request handler that sets ctx as the context of the requests that don't have one,
+// and fails the other requests once ctx is done.
+func contextHandler(ctx context.Context) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "ContextHandler",
+		Fn: func(r *request.Request) {
+			if r.Context() == aws.BackgroundContext() {
+				r.SetContext(ctx)
+				return
+			}
+			if err := ctx.Err(); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+			}
+		},
+	}
+}
+
+// validateCredsTimeout returns how long to wait for the credentials of the profile, or of the profile of the
+// environment if name is empty. The wait is longer if the profile, or a profile it assumes a role from, gets
+// its credentials from a "credential_process" or prompts for an MFA token code with "mfa_serial".
+func validateCredsTimeout(name string) time.Duration {
+	if name == "" {
+		name = envProfile()
+	}
+	files, err := ini.LooseLoad(sharedFile(configFileEnvVar, "config"), sharedFile(credsFileEnvVar, "credentials"))
+	if err != nil {
+		return credsTimeout
+	}
+	for visited := make(map[string]bool); name != "" && !visited[name]; {
+		visited[name] = true
+		var next string
+		for _, section := range profileSections(files, name) {
+			if section.HasKey(credentialProcessKey) || section.HasKey(mfaSerialKey) {
+				return interactiveCredsTimeout
+			}
+			if section.HasKey(sourceProfileKey) {
+				next = section.Key(sourceProfileKey).String()
+			}
+		}
+		name = next
+	}
+	return credsTimeout
+}
+
+// profileSections returns the sections of the profile in the config file, "[profile name]" or "[default]",
+// and in the credentials file, "[name]".
+func profileSections(files *ini.File, name string) []*ini.Section {
+	names := []string{name}
+	if name != defaultProfileName {
+		names = append(names, configFileProfilePrefix+name)
+	}
+	var sections []*ini.Section
+	for _, name := range names {
+		if section, err := files.GetSection(name); err == nil {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
+
+// envProfile returns the name of the profile that the default sessions use.
+func envProfile() string {
+	for _, envVar := range []string{profileEnvVar, defaultProfileEnvVar} {
+		if name := os.Getenv(envVar); name != "" {
+			return name
+		}
+	}
+	return defaultProfileName
+}
+
+// sharedFile returns the path of a shared AWS file, set by the environment variable or in the ".aws" directory of the home directory.
+func sharedFile(envVar, name string) string {
+	if path := os.Getenv(envVar); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".aws", name)
+}
+
 type validator struct{}
 
-func (v *validator) ValidateCredentials(sess *session.Session) (credentials.Value, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), credsTimeout)
+func (v *validator) ValidateCredentials(sess *session.Session, timeout time.Duration) (credentials.Value, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 	return sess.Config.Credentials.GetWithContext(ctx)
 }
@@ -6,9 +6,13 @@ package ssm
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -16,23 +20,43 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"golang.org/x/sync/errgroup"
 )
 
 type api interface {
 	PutParameter(*ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
 	AddTagsToResource(*ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
 	GetParameterWithContext(context.Context, *ssm.GetParameterInput, ...request.Option) (*ssm.GetParameterOutput, error)
+	GetParametersWithContext(context.Context, *ssm.GetParametersInput, ...request.Option) (*ssm.GetParametersOutput, error)
+	DescribeParameters(*ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error)
+	StartSession(*ssm.StartSessionInput) (*ssm.StartSessionOutput, error)
+	GetDocument(*ssm.GetDocumentInput) (*ssm.GetDocumentOutput, error)
 }
 
+const (
+	portForwardingDocumentName = "AWS-StartPortForwardingSessionToRemoteHost"
+	startSessionPluginAction   = "StartSession"
+
+	// maxParametersPerRequest is the maximum number of parameters that GetParameters retrieves at once.
+	maxParametersPerRequest = 10
+	// maxConcurrentRequests limits the GetParameters requests in flight, so that services with many secrets aren't throttled.
+	maxConcurrentRequests = 5
+)
+
 // SSM wraps an AWS SSM client.
 type SSM struct {
-	client api
+	client   api
+	region   string
+	endpoint string
 }
 
 // New returns a SSM service configured against the input session.
 func New(s *session.Session) *SSM {
+	client := ssm.New(s)
 	return &SSM{
-		client: ssm.New(s),
+		client:   client,
+		region:   aws.StringValue(s.Config.Region),
+		endpoint: client.Endpoint,
 	}
 }
 
@@ -77,6 +101,162 @@ func (s *SSM) GetSecretValue(ctx context.Context, name string) (string, error) {
 	return aws.StringValue(resp.Parameter.Value), nil
 }
 
+// GetSecretValues retrieves the values of parameters, each specified by its name or ARN, from AWS Systems Manager Parameter Store.
+// The parameters are retrieved in batches with a limited number of concurrent requests, and duplicated names are
+// retrieved once. It returns the values keyed by the names or ARNs of the input.
+func (s *SSM) GetSecretValues(ctx context.Context, names []string) (map[string]string, error) {
+	values := make(map[string]string, len(names))
+	var unique []string
+	for _, name := range names {
+		if _, ok := values[name]; !ok {
+			unique = append(unique, name)
+			values[name] = ""
+		}
+	}
+	mu := &sync.Mutex{}
+	var invalid []string
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentRequests)
+	for start := 0; start < len(unique); start += maxParametersPerRequest {
+		end := start + maxParametersPerRequest
+		if end > len(unique) {
+			end = len(unique)
+		}
+		batch := unique[start:end]
+		g.Go(func() error {
+			resp, err := s.client.GetParametersWithContext(ctx, &ssm.GetParametersInput{
+				Names:          aws.StringSlice(batch),
+				WithDecryption: aws.Bool(true),
+			})
+			if err != nil {
+				return fmt.Errorf("get parameters %s from SSM: %w", strings.Join(batch, ", "), err)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			invalid = append(invalid, aws.StringValueSlice(resp.InvalidParameters)...)
+			for _, param := range resp.Parameters {
+				for _, key := range []string{aws.StringValue(param.Name), aws.StringValue(param.ARN)} {
+					if _, ok := values[key]; ok {
+						values[key] = aws.StringValue(param.Value)
+					}
+				}
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return nil, fmt.Errorf("parameters %s not found in SSM", strings.Join(invalid, ", "))
+	}
+	return values, nil
+}
+
+// DocumentContent returns the content of the latest version of an AWS Systems Manager document,
+// such as the iCalendar of a Change Calendar document.
+func (s *SSM) DocumentContent(name string) (string, error) {
+	resp, err := s.client.GetDocument(&ssm.GetDocumentInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get document %q from SSM: %w", name, err)
+	}
+	return aws.StringValue(resp.Content), nil
+}
+
+// SecretMetadata holds the attributes of a secret without its value.
+type SecretMetadata struct {
+	Name             string
+	Type             string
+	Version          int64
+	LastModifiedDate time.Time
+	LastModifiedUser string
+}
+
+// ListSecrets returns the metadata of all the parameters under the path, sorted by name.
+// The values of the parameters are never retrieved.
+func (s *SSM) ListSecrets(path string) ([]SecretMetadata, error) {
+	var secrets []SecretMetadata
+	in := &ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{
+			{
+				Key:    aws.String("Path"),
+				Option: aws.String("Recursive"),
+				Values: aws.StringSlice([]string{path}),
+			},
+		},
+	}
+	for {
+		out, err := s.client.DescribeParameters(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe parameters under path %s: %w", path, err)
+		}
+		for _, param := range out.Parameters {
+			secrets = append(secrets, SecretMetadata{
+				Name:             aws.StringValue(param.Name),
+				Type:             aws.StringValue(param.Type),
+				Version:          aws.Int64Value(param.Version),
+				LastModifiedDate: aws.TimeValue(param.LastModifiedDate),
+				LastModifiedUser: aws.StringValue(param.LastModifiedUser),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, nil
+}
+
+// PortForwardingSessionInput contains the fields needed to forward a local port to a remote host.
+type PortForwardingSessionInput struct {
+	Target    string // The managed node that forwards the traffic, for example "ecs:<cluster>_<task ID>_<container runtime ID>".
+	Host      string // The remote host to forward the traffic to, reachable from the target.
+	Port      int    // The port of the remote host.
+	LocalPort int    // The port that the session manager plugin listens on.
+}
+
+// PortForwardingSession holds a port forwarding session started by StartPortForwardingSession.
+type PortForwardingSession struct {
+	ID         string
+	PluginArgs []string // The arguments of the session manager plugin to connect to the session.
+}
+
+// StartPortForwardingSession starts a session that forwards a local port to a remote host through a target.
+// The traffic is only forwarded once the session manager plugin connects to the session.
+func (s *SSM) StartPortForwardingSession(in PortForwardingSessionInput) (*PortForwardingSession, error) {
+	input := &ssm.StartSessionInput{
+		DocumentName: aws.String(portForwardingDocumentName),
+		Target:       aws.String(in.Target),
+		Parameters: map[string][]*string{
+			"host":            aws.StringSlice([]string{in.Host}),
+			"portNumber":      aws.StringSlice([]string{fmt.Sprintf("%d", in.Port)}),
+			"localPortNumber": aws.StringSlice([]string{fmt.Sprintf("%d", in.LocalPort)}),
+		},
+	}
+	out, err := s.client.StartSession(input)
+	if err != nil {
+		return nil, fmt.Errorf("start session to forward port %d of %s through %s: %w", in.Port, in.Host, in.Target, err)
+	}
+	rawOut, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("marshal start session response: %w", err)
+	}
+	rawIn, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshal start session request: %w", err)
+	}
+	return &PortForwardingSession{
+		ID:         aws.StringValue(out.SessionId),
+		PluginArgs: []string{string(rawOut), s.region, startSessionPluginAction, "", string(rawIn), s.endpoint},
+	}, nil
+}
+
 func (s *SSM) createSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	// Create a secret while adding the tags in a single call instead of separate calls to `PutParameter` and
 	// `AddTagsToResource` so that there won't be a case where the parameter is created while the tags are not added.
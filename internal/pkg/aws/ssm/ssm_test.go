@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -413,3 +414,319 @@ func TestSSM_GetSecretValue(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_GetSecretValues(t *testing.T) {
+	names := func(from, to int) []string {
+		var out []string
+		for i := from; i < to; i++ {
+			out = append(out, fmt.Sprintf("/copilot/secret%02d", i))
+		}
+		return out
+	}
+	parameters := func(names []string) []*ssm.Parameter {
+		var out []*ssm.Parameter
+		for _, name := range names {
+			out = append(out, &ssm.Parameter{
+				Name:  aws.String(name),
+				ARN:   aws.String("arn:aws:ssm:us-west-2:123456789012:parameter" + name),
+				Value: aws.String("value of " + name),
+			})
+		}
+		return out
+	}
+	tests := map[string]struct {
+		inNames   []string
+		setupMock func(m *mocks.Mockapi)
+
+		want      map[string]string
+		wantError string
+	}{
+		"retrieves the unique parameters in batches of 10": {
+			inNames: append(names(0, 12), "/copilot/secret00", "arn:aws:ssm:us-west-2:123456789012:parameter/copilot/arn"),
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParametersWithContext(gomock.Any(), &ssm.GetParametersInput{
+					Names:          aws.StringSlice(names(0, 10)),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParametersOutput{
+					Parameters: parameters(names(0, 10)),
+				}, nil)
+				m.EXPECT().GetParametersWithContext(gomock.Any(), &ssm.GetParametersInput{
+					Names:          aws.StringSlice(append(names(10, 12), "arn:aws:ssm:us-west-2:123456789012:parameter/copilot/arn")),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParametersOutput{
+					Parameters: parameters(append(names(10, 12), "/copilot/arn")),
+				}, nil)
+			},
+			want: map[string]string{
+				"/copilot/secret00": "value of /copilot/secret00",
+				"/copilot/secret01": "value of /copilot/secret01",
+				"/copilot/secret02": "value of /copilot/secret02",
+				"/copilot/secret03": "value of /copilot/secret03",
+				"/copilot/secret04": "value of /copilot/secret04",
+				"/copilot/secret05": "value of /copilot/secret05",
+				"/copilot/secret06": "value of /copilot/secret06",
+				"/copilot/secret07": "value of /copilot/secret07",
+				"/copilot/secret08": "value of /copilot/secret08",
+				"/copilot/secret09": "value of /copilot/secret09",
+				"/copilot/secret10": "value of /copilot/secret10",
+				"/copilot/secret11": "value of /copilot/secret11",
+				"arn:aws:ssm:us-west-2:123456789012:parameter/copilot/arn": "value of /copilot/arn",
+			},
+		},
+		"error if parameters don't exist": {
+			inNames: []string{"/copilot/b", "/copilot/a", "/copilot/c"},
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParametersWithContext(gomock.Any(), gomock.Any()).Return(&ssm.GetParametersOutput{
+					Parameters:        parameters([]string{"/copilot/b"}),
+					InvalidParameters: aws.StringSlice([]string{"/copilot/c", "/copilot/a"}),
+				}, nil)
+			},
+			wantError: "parameters /copilot/a, /copilot/c not found in SSM",
+		},
+		"error if a request fails": {
+			inNames: []string{"/copilot/a"},
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParametersWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: "get parameters /copilot/a from SSM: some error",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			api := mocks.NewMockapi(ctrl)
+			tc.setupMock(api)
+
+			ssm := SSM{
+				client: api,
+			}
+
+			got, err := ssm.GetSecretValues(context.Background(), tc.inNames)
+			if tc.wantError != "" {
+				require.EqualError(t, err, tc.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSSM_DocumentContent(t *testing.T) {
+	tests := map[string]struct {
+		setupMock func(m *mocks.Mockapi)
+
+		want      string
+		wantError string
+	}{
+		"error": {
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDocument(&ssm.GetDocumentInput{
+					Name: aws.String("holidays"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantError: `get document "holidays" from SSM: some error`,
+		},
+		"success": {
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDocument(&ssm.GetDocumentInput{
+					Name: aws.String("holidays"),
+				}).Return(&ssm.GetDocumentOutput{
+					Content: aws.String("BEGIN:VCALENDAR"),
+				}, nil)
+			},
+			want: "BEGIN:VCALENDAR",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			api := mocks.NewMockapi(ctrl)
+			tc.setupMock(api)
+
+			ssm := SSM{
+				client: api,
+			}
+
+			got, err := ssm.DocumentContent("holidays")
+			if tc.wantError != "" {
+				require.EqualError(t, err, tc.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSSM_ListSecrets(t *testing.T) {
+	const mockPath = "/copilot/myapp/myenv/secrets/"
+	mockTime := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	wantedIn := func(token *string) *ssm.DescribeParametersInput {
+		return &ssm.DescribeParametersInput{
+			ParameterFilters: []*ssm.ParameterStringFilter{
+				{
+					Key:    aws.String("Path"),
+					Option: aws.String("Recursive"),
+					Values: aws.StringSlice([]string{mockPath}),
+				},
+			},
+			NextToken: token,
+		}
+	}
+	tests := map[string]struct {
+		setupMock func(m *mocks.Mockapi)
+
+		want      []SecretMetadata
+		wantError string
+	}{
+		"error": {
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(wantedIn(nil)).Return(nil, errors.New("some error"))
+			},
+			wantError: "describe parameters under path /copilot/myapp/myenv/secrets/: some error",
+		},
+		"success with pagination": {
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(wantedIn(nil)).Return(&ssm.DescribeParametersOutput{
+					Parameters: []*ssm.ParameterMetadata{
+						{
+							Name:             aws.String(mockPath + "db_password"),
+							Type:             aws.String("SecureString"),
+							Version:          aws.Int64(2),
+							LastModifiedDate: aws.Time(mockTime),
+							LastModifiedUser: aws.String("arn:aws:iam::123456789012:user/alice"),
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().DescribeParameters(wantedIn(aws.String("token"))).Return(&ssm.DescribeParametersOutput{
+					Parameters: []*ssm.ParameterMetadata{
+						{
+							Name:    aws.String(mockPath + "api_key"),
+							Type:    aws.String("SecureString"),
+							Version: aws.Int64(1),
+						},
+					},
+				}, nil)
+			},
+			want: []SecretMetadata{
+				{
+					Name:    mockPath + "api_key",
+					Type:    "SecureString",
+					Version: 1,
+				},
+				{
+					Name:             mockPath + "db_password",
+					Type:             "SecureString",
+					Version:          2,
+					LastModifiedDate: mockTime,
+					LastModifiedUser: "arn:aws:iam::123456789012:user/alice",
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			api := mocks.NewMockapi(ctrl)
+			tc.setupMock(api)
+
+			ssm := SSM{
+				client: api,
+			}
+
+			got, err := ssm.ListSecrets(mockPath)
+			if tc.wantError != "" {
+				require.EqualError(t, err, tc.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSSM_StartPortForwardingSession(t *testing.T) {
+	in := PortForwardingSessionInput{
+		Target:    "ecs:my-cluster_abc123_abc123-1234",
+		Host:      "db.cluster-xyz.us-west-2.rds.amazonaws.com",
+		Port:      5432,
+		LocalPort: 50000,
+	}
+	wantedInput := &ssm.StartSessionInput{
+		DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+		Target:       aws.String("ecs:my-cluster_abc123_abc123-1234"),
+		Parameters: map[string][]*string{
+			"host":            aws.StringSlice([]string{"db.cluster-xyz.us-west-2.rds.amazonaws.com"}),
+			"portNumber":      aws.StringSlice([]string{"5432"}),
+			"localPortNumber": aws.StringSlice([]string{"50000"}),
+		},
+	}
+	tests := map[string]struct {
+		setupMock func(m *mocks.Mockapi)
+
+		wanted    *PortForwardingSession
+		wantError string
+	}{
+		"error": {
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(wantedInput).Return(nil, errors.New("some error"))
+			},
+			wantError: "start session to forward port 5432 of db.cluster-xyz.us-west-2.rds.amazonaws.com through ecs:my-cluster_abc123_abc123-1234: some error",
+		},
+		"success": {
+			setupMock: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(wantedInput).Return(&ssm.StartSessionOutput{
+					SessionId:  aws.String("session-1"),
+					StreamUrl:  aws.String("wss://ssmmessages.us-west-2.amazonaws.com/v1/data-channel/session-1"),
+					TokenValue: aws.String("token"),
+				}, nil)
+			},
+			wanted: &PortForwardingSession{
+				ID: "session-1",
+				PluginArgs: []string{
+					`{"SessionId":"session-1","StreamUrl":"wss://ssmmessages.us-west-2.amazonaws.com/v1/data-channel/session-1","TokenValue":"token"}`,
+					"us-west-2",
+					"StartSession",
+					"",
+					`{"DocumentName":"AWS-StartPortForwardingSessionToRemoteHost","Parameters":{"host":["db.cluster-xyz.us-west-2.rds.amazonaws.com"],"localPortNumber":["50000"],"portNumber":["5432"]},"Reason":null,"Target":"ecs:my-cluster_abc123_abc123-1234"}`,
+					"https://ssm.us-west-2.amazonaws.com",
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			api := mocks.NewMockapi(ctrl)
+			tc.setupMock(api)
+
+			ssm := SSM{
+				client:   api,
+				region:   "us-west-2",
+				endpoint: "https://ssm.us-west-2.amazonaws.com",
+			}
+
+			got, err := ssm.StartPortForwardingSession(in)
+			if tc.wantError != "" {
+				require.EqualError(t, err, tc.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+const (
+	alarmStateAlarm = "ALARM"
+
+	// defaultAlarmPollInterval is how often the states of the alarms are retrieved while they're watched.
+	defaultAlarmPollInterval = 15 * time.Second
+)
+
+// alarmWatcher reports the state changes of the alarms of a service for a window of time.
+type alarmWatcher struct {
+	app string
+	env string
+	svc string
+
+	alarms   alarmStatusGetter
+	now      func() time.Time
+	sleep    func(time.Duration)
+	interval time.Duration
+}
+
+// Watch polls the alarms of the service until the window elapses and reports each change of their state.
+// If stopOnAlarm is true, it returns the first alarm that goes into the ALARM state without waiting for the end of the window.
+// Alarms that are already in the ALARM state when the watch starts are reported, but not returned.
+func (w *alarmWatcher) Watch(window time.Duration, stopOnAlarm bool) (*cloudwatch.AlarmStatus, error) {
+	deadline := w.now().Add(window)
+	states := make(map[string]string)
+	for first := true; ; first = false {
+		alarms, err := w.serviceAlarms()
+		if err != nil {
+			return nil, err
+		}
+		if first {
+			log.Infof("Watching %d alarms of service %s for %s.\n", len(alarms), color.HighlightUserInput(w.svc), window)
+		}
+		for i, alarm := range alarms {
+			prev, seen := states[alarm.Name]
+			states[alarm.Name] = alarm.Status
+			if prev == alarm.Status || (!seen && alarm.Status != alarmStateAlarm) {
+				continue
+			}
+			reportAlarmState(alarm, prev)
+			if stopOnAlarm && seen && alarm.Status == alarmStateAlarm {
+				return &alarms[i], nil
+			}
+		}
+		if !w.now().Before(deadline) {
+			return nil, nil
+		}
+		w.sleep(w.interval)
+	}
+}
+
+// serviceAlarms returns the alarms tagged with the service and the alarms that Copilot created for it, sorted by name.
+func (w *alarmWatcher) serviceAlarms() ([]cloudwatch.AlarmStatus, error) {
+	tagged, err := w.alarms.AlarmsWithTags(map[string]string{
+		deploy.AppTagKey:     w.app,
+		deploy.EnvTagKey:     w.env,
+		deploy.ServiceTagKey: w.svc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get tagged CloudWatch alarms: %w", err)
+	}
+	copilotAlarms, err := w.alarms.AlarmStatuses(cloudwatch.WithPrefix(fmt.Sprintf("%s-%s-%s-Copilot", w.app, w.env, w.svc)))
+	if err != nil {
+		return nil, fmt.Errorf("get Copilot-created CloudWatch alarms: %w", err)
+	}
+	byName := make(map[string]cloudwatch.AlarmStatus)
+	for _, alarm := range append(tagged, copilotAlarms...) {
+		byName[alarm.Name] = alarm
+	}
+	alarms := make([]cloudwatch.AlarmStatus, 0, len(byName))
+	for _, alarm := range byName {
+		alarms = append(alarms, alarm)
+	}
+	sort.Slice(alarms, func(i, j int) bool {
+		return alarms[i].Name < alarms[j].Name
+	})
+	return alarms, nil
+}
+
+// reportAlarmState writes the new state of an alarm to the terminal, or emits it as an event if the JSON output is enabled.
+func reportAlarmState(alarm cloudwatch.AlarmStatus, prev string) {
+	if termprogress.JSONOutputEnabled() {
+		termprogress.Emit(termprogress.Event{
+			Type:      termprogress.EventTypeAlarm,
+			Timestamp: alarm.UpdatedTimes,
+			Resource:  alarm.Name,
+			Status:    alarm.Status,
+			Message:   alarm.Condition,
+		})
+		return
+	}
+	msg := fmt.Sprintf("Alarm %s is in state %s", color.HighlightResource(alarm.Name), alarm.Status)
+	if prev != "" {
+		msg = fmt.Sprintf("Alarm %s changed from %s to %s", color.HighlightResource(alarm.Name), prev, alarm.Status)
+	}
+	if alarm.Condition != "" {
+		msg = fmt.Sprintf("%s: %s", msg, alarm.Condition)
+	}
+	if alarm.Status == alarmStateAlarm {
+		log.Warningln(msg)
+		return
+	}
+	log.Infoln(msg)
+}
+
+// deployedStack is the configuration of a stack as it's currently deployed, used to deploy it again.
+type deployedStack struct {
+	name       string
+	template   string
+	parameters map[string]string
+}
+
+// previousWorkloadStack returns the deployed configuration of the stack, or nil if the stack doesn't exist yet.
+func previousWorkloadStack(getter workloadStackRollbacker, stackName string) (*deployedStack, error) {
+	tpl, err := getter.Template(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get template of stack %s: %w", stackName, err)
+	}
+	params, err := getter.StackParameters(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("get parameters of stack %s: %w", stackName, err)
+	}
+	return &deployedStack{
+		name:       stackName,
+		template:   tpl,
+		parameters: params,
+	}, nil
+}
+
+// StackName returns the name of the stack.
+func (s *deployedStack) StackName() string {
+	return s.name
+}
+
+// Template returns the template of the stack.
+func (s *deployedStack) Template() (string, error) {
+	return s.template, nil
+}
+
+// Parameters returns the parameters of the stack sorted by key.
+func (s *deployedStack) Parameters() ([]*sdkcloudformation.Parameter, error) {
+	var params []*sdkcloudformation.Parameter
+	for _, key := range sortedKeys(s.parameters) {
+		params = append(params, &sdkcloudformation.Parameter{
+			ParameterKey:   aws.String(key),
+			ParameterValue: aws.String(s.parameters[key]),
+		})
+	}
+	return params, nil
+}
+
+// Tags returns nil so that the tags of the stack are left unchanged.
+func (s *deployedStack) Tags() []*sdkcloudformation.Tag {
+	return nil
+}
+
+// SerializedParameters returns the parameters of the stack as a JSON object.
+func (s *deployedStack) SerializedParameters() (string, error) {
+	out, err := json.MarshalIndent(s.parameters, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal parameters of stack %s: %w", s.name, err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlarmWatcher_Watch(t *testing.T) {
+	tags := map[string]string{
+		"copilot-application": "phonetool",
+		"copilot-environment": "test",
+		"copilot-service":     "api",
+	}
+	ok := func(name string) cloudwatch.AlarmStatus {
+		return cloudwatch.AlarmStatus{Name: name, Status: "OK"}
+	}
+	alarm := func(name string) cloudwatch.AlarmStatus {
+		return cloudwatch.AlarmStatus{Name: name, Status: "ALARM"}
+	}
+	testCases := map[string]struct {
+		inStopOnAlarm bool
+		polls         [][]cloudwatch.AlarmStatus // Copilot-created alarms returned by each poll.
+
+		wantedAlarm *cloudwatch.AlarmStatus
+		wantedPolls int
+		wantedErr   string
+	}{
+		"polls until the end of the window": {
+			polls: [][]cloudwatch.AlarmStatus{
+				{ok("CopilotRollbackCPU")},
+				{alarm("CopilotRollbackCPU")},
+				{ok("CopilotRollbackCPU")},
+				{ok("CopilotRollbackCPU")},
+			},
+			wantedPolls: 4,
+		},
+		"stops at the first alarm that goes off": {
+			inStopOnAlarm: true,
+			polls: [][]cloudwatch.AlarmStatus{
+				{ok("CopilotRollbackCPU"), ok("CopilotRollbackMem")},
+				{ok("CopilotRollbackCPU"), alarm("CopilotRollbackMem")},
+			},
+			wantedAlarm: &cloudwatch.AlarmStatus{Name: "CopilotRollbackMem", Status: "ALARM"},
+			wantedPolls: 2,
+		},
+		"ignores the alarms that are already in the ALARM state": {
+			inStopOnAlarm: true,
+			polls: [][]cloudwatch.AlarmStatus{
+				{alarm("CopilotRollbackCPU")},
+				{alarm("CopilotRollbackCPU")},
+				{alarm("CopilotRollbackCPU")},
+				{alarm("CopilotRollbackCPU")},
+			},
+			wantedPolls: 4,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockalarmStatusGetter(ctrl)
+			var polls int
+			m.EXPECT().AlarmsWithTags(tags).Return(nil, nil).Times(tc.wantedPolls)
+			m.EXPECT().AlarmStatuses(gomock.Any()).DoAndReturn(func(_ ...cloudwatch.DescribeAlarmOpts) ([]cloudwatch.AlarmStatus, error) {
+				polls++
+				return tc.polls[polls-1], nil
+			}).Times(tc.wantedPolls)
+
+			start := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+			elapsed := time.Duration(0)
+			watcher := &alarmWatcher{
+				app:      "phonetool",
+				env:      "test",
+				svc:      "api",
+				alarms:   m,
+				now:      func() time.Time { return start.Add(elapsed) },
+				sleep:    func(d time.Duration) { elapsed += d },
+				interval: time.Minute,
+			}
+
+			got, err := watcher.Watch(3*time.Minute, tc.inStopOnAlarm)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAlarm, got)
+			require.Equal(t, tc.wantedPolls, polls)
+		})
+	}
+	t.Run("error getting the tagged alarms", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockalarmStatusGetter(ctrl)
+		m.EXPECT().AlarmsWithTags(tags).Return(nil, errors.New("some error"))
+		watcher := &alarmWatcher{
+			app:    "phonetool",
+			env:    "test",
+			svc:    "api",
+			alarms: m,
+			now:    time.Now,
+		}
+
+		_, err := watcher.Watch(time.Minute, false)
+
+		require.EqualError(t, err, "get tagged CloudWatch alarms: some error")
+	})
+}
+
+func TestPreviousWorkloadStack(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockworkloadStackRollbacker)
+
+		wantedStack *deployedStack
+		wantedErr   string
+	}{
+		"returns nil if the stack doesn't exist": {
+			setupMocks: func(m *mocks.MockworkloadStackRollbacker) {
+				m.EXPECT().Template("phonetool-test-api").Return("", &awscloudformation.ErrStackNotFound{})
+			},
+		},
+		"returns the template and parameters of the stack": {
+			setupMocks: func(m *mocks.MockworkloadStackRollbacker) {
+				m.EXPECT().Template("phonetool-test-api").Return("Resources: {}", nil)
+				m.EXPECT().StackParameters("phonetool-test-api").Return(map[string]string{"ContainerImage": "nginx"}, nil)
+			},
+			wantedStack: &deployedStack{
+				name:       "phonetool-test-api",
+				template:   "Resources: {}",
+				parameters: map[string]string{"ContainerImage": "nginx"},
+			},
+		},
+		"error getting the parameters": {
+			setupMocks: func(m *mocks.MockworkloadStackRollbacker) {
+				m.EXPECT().Template("phonetool-test-api").Return("Resources: {}", nil)
+				m.EXPECT().StackParameters("phonetool-test-api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get parameters of stack phonetool-test-api: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockworkloadStackRollbacker(ctrl)
+			tc.setupMocks(m)
+
+			got, err := previousWorkloadStack(m, "phonetool-test-api")
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStack, got)
+		})
+	}
+}
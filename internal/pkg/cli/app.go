@@ -23,8 +23,14 @@ Applications are a collection of services and environments.`,
 	cmd.AddCommand(buildAppInitCommand())
 	cmd.AddCommand(buildAppListCommand())
 	cmd.AddCommand(buildAppShowCmd())
+	cmd.AddCommand(buildAppGraphCmd())
+	cmd.AddCommand(buildAppDashboardCmd())
 	cmd.AddCommand(buildAppDeleteCommand())
 	cmd.AddCommand(buildAppUpgradeCmd())
+	cmd.AddCommand(buildAppMigrateCmd())
+	cmd.AddCommand(buildAppExportCmd())
+	cmd.AddCommand(buildAppRestoreCmd())
+	cmd.AddCommand(buildAppCISetupCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
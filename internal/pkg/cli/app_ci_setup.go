@@ -0,0 +1,321 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appCISetupNamePrompt     = "Which application would you like to deploy from your CI?"
+	appCISetupNameHelpPrompt = "An IAM role will let the CI jobs of your repository deploy the services and jobs of the application."
+	appCISetupURLPrompt      = "What is the URL of the repository whose CI jobs deploy the application?"
+	appCISetupURLHelpPrompt  = `The CI jobs of this repository will be able to assume the IAM role.
+For example: https://github.com/{owner}/{repositoryName}`
+
+	ciSnippetTemplatePath = "cicd/ci/%s.yml"
+
+	fmtCopilotBinaryURL    = "https://github.com/aws/copilot-cli/releases/download/%s/copilot-linux"
+	latestCopilotBinaryURL = "https://github.com/aws/copilot-cli/releases/latest/download/copilot-linux"
+)
+
+var ciProviders = []string{deploy.CIProviderGitHub}
+
+type ciSetupAppVars struct {
+	name         string
+	provider     string
+	repoURL      string
+	branch       string
+	workloads    []string
+	environments []string
+}
+
+type ciSetupAppOpts struct {
+	ciSetupAppVars
+
+	store    store
+	sel      appSelector
+	prompt   prompter
+	oidc     oidcProviderGetter
+	deployer ciRoleDeployer
+	parser   template.Parser
+	region   string
+	w        io.Writer
+
+	// Cached variables.
+	repository string
+}
+
+func newCISetupAppOpts(vars ciSetupAppVars) (*ciSetupAppOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app ci-setup")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	region := aws.StringValue(sess.Config.Region)
+	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), region)
+	prompter := prompt.New()
+	return &ciSetupAppOpts{
+		ciSetupAppVars: vars,
+		store:          store,
+		sel:            selector.NewAppEnvSelector(prompter, store),
+		prompt:         prompter,
+		oidc:           iam.New(sess),
+		deployer:       cloudformation.New(sess, cloudformation.WithProgressTracker(os.Stderr)),
+		parser:         template.New(),
+		region:         region,
+		w:              os.Stdout,
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *ciSetupAppOpts) Validate() error {
+	if !contains(o.provider, ciProviders) {
+		return fmt.Errorf("CI provider %q is not supported: must be one of %s", o.provider, prettify(ciProviders))
+	}
+	if o.repoURL != "" {
+		return o.parseRepoURL()
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *ciSetupAppOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Application(appCISetupNamePrompt, appCISetupNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application name: %w", err)
+		}
+		o.name = name
+	}
+	if o.repoURL == "" {
+		url, err := o.prompt.Get(appCISetupURLPrompt, appCISetupURLHelpPrompt, func(v interface{}) error {
+			_, err := ghRepoURL(v.(string)).parse()
+			return err
+		}, prompt.WithFinalMessage("Repository URL:"))
+		if err != nil {
+			return fmt.Errorf("get repository URL: %w", err)
+		}
+		o.repoURL = url
+		return o.parseRepoURL()
+	}
+	return nil
+}
+
+// Execute deploys the IAM role that the CI jobs of the repository assume, and writes the configuration of a pipeline that uses it.
+func (o *ciSetupAppOpts) Execute() error {
+	envs, err := o.targetEnvironments()
+	if err != nil {
+		return err
+	}
+	wklds, err := o.targetWorkloads()
+	if err != nil {
+		return err
+	}
+	host, _ := stack.CIOIDCProviderHost(o.provider)
+	providerARN, err := o.oidc.OpenIDConnectProviderARN(host)
+	if err != nil {
+		return fmt.Errorf("get IAM OIDC provider of %s: %w", host, err)
+	}
+	in := &deploy.CreateCIRoleInput{
+		App:             o.name,
+		Provider:        o.provider,
+		Repository:      o.repository,
+		Branch:          o.branch,
+		OIDCProviderARN: providerARN,
+	}
+	for _, wkld := range wklds {
+		in.Workloads = append(in.Workloads, wkld.Name)
+	}
+	for _, env := range envs {
+		in.Environments = append(in.Environments, deploy.CIEnvironment{
+			Name:      env.Name,
+			AccountID: env.AccountID,
+		})
+	}
+	roleARN, err := o.deployer.DeployCIRole(in)
+	if err != nil {
+		return fmt.Errorf("deploy CI role of application %s: %w", o.name, err)
+	}
+	log.Successf("The CI jobs of %s can deploy the application %s with the IAM role %s.\n",
+		color.HighlightUserInput(o.repository), color.HighlightUserInput(o.name), color.HighlightResource(roleARN))
+	return o.writeSnippet(roleARN, envs, wklds)
+}
+
+func (o *ciSetupAppOpts) parseRepoURL() error {
+	repo, err := ghRepoURL(o.repoURL).parse()
+	if err != nil {
+		return err
+	}
+	o.repository = fmt.Sprintf("%s/%s", repo.owner, repo.name)
+	return nil
+}
+
+// targetEnvironments returns the environments that the role can deploy to, in the order of the flag.
+func (o *ciSetupAppOpts) targetEnvironments() ([]*config.Environment, error) {
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.name, err)
+	}
+	if len(envs) == 0 {
+		return nil, fmt.Errorf("application %s does not have any environments", o.name)
+	}
+	if len(o.environments) == 0 {
+		return envs, nil
+	}
+	byName := make(map[string]*config.Environment)
+	for _, env := range envs {
+		byName[env.Name] = env
+	}
+	var targets []*config.Environment
+	for _, name := range o.environments {
+		env, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("environment %s does not exist in application %s", name, o.name)
+		}
+		targets = append(targets, env)
+	}
+	return targets, nil
+}
+
+// targetWorkloads returns the services and jobs that the role can deploy.
+func (o *ciSetupAppOpts) targetWorkloads() ([]*config.Workload, error) {
+	wklds, err := o.store.ListWorkloads(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in application %s: %w", o.name, err)
+	}
+	if len(wklds) == 0 {
+		return nil, errors.New("no service or job found in the application")
+	}
+	if len(o.workloads) == 0 {
+		return wklds, nil
+	}
+	byName := make(map[string]*config.Workload)
+	for _, wkld := range wklds {
+		byName[wkld.Name] = wkld
+	}
+	var targets []*config.Workload
+	for _, name := range o.workloads {
+		wkld, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("workload %s does not exist in application %s", name, o.name)
+		}
+		targets = append(targets, wkld)
+	}
+	return targets, nil
+}
+
+func (o *ciSetupAppOpts) writeSnippet(roleARN string, envs []*config.Environment, wklds []*config.Workload) error {
+	type snippetEnv struct {
+		Name  string
+		Needs string // Name of the environment to deploy to first.
+	}
+	type snippetWorkload struct {
+		Name string
+		Cmd  string
+	}
+	data := struct {
+		App          string
+		Repository   string
+		Branch       string
+		RoleARN      string
+		Region       string
+		BinaryURL    string
+		Environments []snippetEnv
+		Workloads    []snippetWorkload
+	}{
+		App:        o.name,
+		Repository: o.repository,
+		Branch:     o.branch,
+		RoleARN:    roleARN,
+		Region:     o.region,
+		BinaryURL:  latestCopilotBinaryURL,
+	}
+	if data.Branch == "" {
+		data.Branch = deploy.DefaultPipelineBranch
+	}
+	if version.Version != "" {
+		data.BinaryURL = fmt.Sprintf(fmtCopilotBinaryURL, version.Version)
+	}
+	for i, env := range envs {
+		e := snippetEnv{Name: env.Name}
+		if i > 0 {
+			e.Needs = envs[i-1].Name
+		}
+		data.Environments = append(data.Environments, e)
+	}
+	for _, wkld := range wklds {
+		cmd := svcWkldType
+		if contains(wkld.Type, manifestinfo.JobTypes()) {
+			cmd = jobWkldType
+		}
+		data.Workloads = append(data.Workloads, snippetWorkload{
+			Name: wkld.Name,
+			Cmd:  cmd,
+		})
+	}
+	content, err := o.parser.Parse(fmt.Sprintf(ciSnippetTemplatePath, o.provider), data)
+	if err != nil {
+		return fmt.Errorf("render configuration of %s pipeline: %w", o.provider, err)
+	}
+	log.Infoln("Add this workflow to your repository to deploy the workloads from its CI jobs:")
+	fmt.Fprint(o.w, content.String())
+	return nil
+}
+
+// RecommendActions is a no-op for this command.
+func (o *ciSetupAppOpts) RecommendActions() error {
+	return nil
+}
+
+// buildAppCISetupCmd builds the command to let the CI jobs of a repository deploy an application.
+func buildAppCISetupCmd() *cobra.Command {
+	vars := ciSetupAppVars{}
+	cmd := &cobra.Command{
+		Use:   "ci-setup",
+		Short: "Create an IAM role to deploy an application from an external CI.",
+		Long: `Create an IAM role to deploy an application from an external CI.
+The CI jobs of the repository assume the role with OpenID Connect, without long-lived credentials,
+and can only deploy the selected services and jobs to the selected environments.`,
+		Example: `
+  Let the GitHub Actions of the repository "octocat/hello" deploy the services of the application "my-app".
+  /code $ copilot app ci-setup --name my-app --provider github --url https://github.com/octocat/hello
+  Only let the main branch deploy the service "api" to the environments "test" and "prod".
+  /code $ copilot app ci-setup -n my-app --url octocat/hello --git-branch main --workloads api -e test,prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newCISetupAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.provider, ciProviderFlag, deploy.CIProviderGitHub, ciProviderFlagDescription)
+	cmd.Flags().StringVar(&vars.repoURL, repoURLFlag, "", ciRepoURLFlagDescription)
+	cmd.Flags().StringVar(&vars.branch, gitBranchFlag, "", ciBranchFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.workloads, workloadsFlag, nil, ciWorkloadsFlagDescription)
+	cmd.Flags().StringSliceVarP(&vars.environments, envsFlag, envsFlagShort, nil, ciEnvsFlagDescription)
+	return cmd
+}
@@ -0,0 +1,305 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type ciSetupAppMocks struct {
+	store    *mocks.Mockstore
+	sel      *mocks.MockappSelector
+	prompt   *mocks.Mockprompter
+	oidc     *mocks.MockoidcProviderGetter
+	deployer *mocks.MockciRoleDeployer
+}
+
+func TestCISetupAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProvider string
+		inURL      string
+
+		wantedRepository string
+		wantedErr        error
+	}{
+		"error if the provider isn't supported": {
+			inProvider: "jenkins",
+			wantedErr:  errors.New(`CI provider "jenkins" is not supported: must be one of "github"`),
+		},
+		"error if the repository URL can't be parsed": {
+			inProvider: "github",
+			inURL:      "https://github.com/octocat",
+			wantedErr:  errors.New("unable to parse the GitHub repository owner and name from https://github.com/octocat: please pass the repository URL with the format `--url https://github.com/{owner}/{repositoryName}`"),
+		},
+		"parses the repository from the URL": {
+			inProvider:       "github",
+			inURL:            "git@github.com:octocat/hello.git",
+			wantedRepository: "octocat/hello",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &ciSetupAppOpts{
+				ciSetupAppVars: ciSetupAppVars{
+					provider: tc.inProvider,
+					repoURL:  tc.inURL,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRepository, opts.repository)
+		})
+	}
+}
+
+func TestCISetupAppOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName    string
+		inURL     string
+		callMocks func(m ciSetupAppMocks)
+
+		wantedName       string
+		wantedRepository string
+		wantedErr        error
+	}{
+		"error if fail to select the application": {
+			callMocks: func(m ciSetupAppMocks) {
+				m.sel.EXPECT().Application(appCISetupNamePrompt, appCISetupNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select application name: some error"),
+		},
+		"error if fail to get the repository URL": {
+			inName: "my-app",
+			callMocks: func(m ciSetupAppMocks) {
+				m.prompt.EXPECT().Get(appCISetupURLPrompt, appCISetupURLHelpPrompt, gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get repository URL: some error"),
+		},
+		"prompts for the application and the repository URL": {
+			callMocks: func(m ciSetupAppMocks) {
+				m.sel.EXPECT().Application(appCISetupNamePrompt, appCISetupNameHelpPrompt).Return("my-app", nil)
+				m.prompt.EXPECT().Get(appCISetupURLPrompt, appCISetupURLHelpPrompt, gomock.Any(), gomock.Any()).Return("https://github.com/octocat/hello", nil)
+			},
+			wantedName:       "my-app",
+			wantedRepository: "octocat/hello",
+		},
+		"skips prompting if the flags are provided": {
+			inName:     "my-app",
+			inURL:      "https://github.com/octocat/hello",
+			callMocks:  func(m ciSetupAppMocks) {},
+			wantedName: "my-app",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := ciSetupAppMocks{
+				sel:    mocks.NewMockappSelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.callMocks(m)
+			opts := &ciSetupAppOpts{
+				ciSetupAppVars: ciSetupAppVars{
+					name:    tc.inName,
+					repoURL: tc.inURL,
+				},
+				sel:    m.sel,
+				prompt: m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+			require.Equal(t, tc.wantedRepository, opts.repository)
+		})
+	}
+}
+
+func TestCISetupAppOpts_Execute(t *testing.T) {
+	const oidcProviderARN = "arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com"
+	testEnvs := []*config.Environment{
+		{Name: "test", AccountID: "123456789012"},
+		{Name: "staging", AccountID: "123456789012"},
+		{Name: "prod", AccountID: "210987654321"},
+	}
+	testWorkloads := []*config.Workload{
+		{Name: "api", Type: "Load Balanced Web Service"},
+		{Name: "fe", Type: "Backend Service"},
+		{Name: "report", Type: "Scheduled Job"},
+	}
+	testCases := map[string]struct {
+		inWorkloads    []string
+		inEnvironments []string
+		callMocks      func(m ciSetupAppMocks)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"error if an environment doesn't exist": {
+			inEnvironments: []string{"test", "dev"},
+			callMocks: func(m ciSetupAppMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(testEnvs, nil)
+			},
+			wantedErr: errors.New("environment dev does not exist in application my-app"),
+		},
+		"error if a workload doesn't exist": {
+			inWorkloads: []string{"db"},
+			callMocks: func(m ciSetupAppMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(testEnvs, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(testWorkloads, nil)
+			},
+			wantedErr: errors.New("workload db does not exist in application my-app"),
+		},
+		"error if the OIDC provider can't be retrieved": {
+			callMocks: func(m ciSetupAppMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(testEnvs, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(testWorkloads, nil)
+				m.oidc.EXPECT().OpenIDConnectProviderARN("token.actions.githubusercontent.com").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get IAM OIDC provider of token.actions.githubusercontent.com: some error"),
+		},
+		"error if the role can't be deployed": {
+			callMocks: func(m ciSetupAppMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(testEnvs, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(testWorkloads, nil)
+				m.oidc.EXPECT().OpenIDConnectProviderARN("token.actions.githubusercontent.com").Return("", nil)
+				m.deployer.EXPECT().DeployCIRole(gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("deploy CI role of application my-app: some error"),
+		},
+		"deploys a role scoped to the workloads and environments and writes the workflow": {
+			inWorkloads:    []string{"api", "report"},
+			inEnvironments: []string{"test", "prod"},
+			callMocks: func(m ciSetupAppMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(testEnvs, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(testWorkloads, nil)
+				m.oidc.EXPECT().OpenIDConnectProviderARN("token.actions.githubusercontent.com").Return(oidcProviderARN, nil)
+				m.deployer.EXPECT().DeployCIRole(&deploy.CreateCIRoleInput{
+					App:             "my-app",
+					Provider:        "github",
+					Repository:      "octocat/hello",
+					OIDCProviderARN: oidcProviderARN,
+					Workloads:       []string{"api", "report"},
+					Environments: []deploy.CIEnvironment{
+						{Name: "test", AccountID: "123456789012"},
+						{Name: "prod", AccountID: "210987654321"},
+					},
+				}).Return("arn:aws:iam::123456789012:role/my-app-ci-github-Role", nil)
+			},
+			wantedOutput: `# Deploys the workloads of the application my-app with Copilot.
+# Save this workflow to .github/workflows/copilot-deploy.yml in octocat/hello.
+name: copilot-deploy
+on:
+  push:
+    branches: [main]
+  workflow_dispatch: {}
+permissions:
+  id-token: write # Required to assume the IAM role with the OIDC token of the job.
+  contents: read
+jobs:
+  deploy-test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - uses: aws-actions/configure-aws-credentials@v4
+        with:
+          role-to-assume: arn:aws:iam::123456789012:role/my-app-ci-github-Role
+          aws-region: us-west-2
+      - name: Install Copilot
+        run: |
+          curl -Lo copilot https://github.com/aws/copilot-cli/releases/latest/download/copilot-linux
+          chmod +x copilot && sudo mv copilot /usr/local/bin/copilot
+      - name: Deploy to test
+        run: |
+          copilot svc deploy --app my-app --env test --name api
+          copilot job deploy --app my-app --env test --name report
+
+  deploy-prod:
+    runs-on: ubuntu-latest
+    needs: deploy-test
+    steps:
+      - uses: actions/checkout@v4
+      - uses: aws-actions/configure-aws-credentials@v4
+        with:
+          role-to-assume: arn:aws:iam::123456789012:role/my-app-ci-github-Role
+          aws-region: us-west-2
+      - name: Install Copilot
+        run: |
+          curl -Lo copilot https://github.com/aws/copilot-cli/releases/latest/download/copilot-linux
+          chmod +x copilot && sudo mv copilot /usr/local/bin/copilot
+      - name: Deploy to prod
+        run: |
+          copilot svc deploy --app my-app --env prod --name api
+          copilot job deploy --app my-app --env prod --name report
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := ciSetupAppMocks{
+				store:    mocks.NewMockstore(ctrl),
+				oidc:     mocks.NewMockoidcProviderGetter(ctrl),
+				deployer: mocks.NewMockciRoleDeployer(ctrl),
+			}
+			tc.callMocks(m)
+			out := new(strings.Builder)
+			opts := &ciSetupAppOpts{
+				ciSetupAppVars: ciSetupAppVars{
+					name:         "my-app",
+					provider:     "github",
+					workloads:    tc.inWorkloads,
+					environments: tc.inEnvironments,
+				},
+				store:      m.store,
+				oidc:       m.oidc,
+				deployer:   m.deployer,
+				parser:     template.New(),
+				region:     "us-west-2",
+				w:          out,
+				repository: "octocat/hello",
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, out.String())
+		})
+	}
+}
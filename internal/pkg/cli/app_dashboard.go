@@ -0,0 +1,144 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appDashboardNamePrompt     = "Which application would you like to generate a dashboard for?"
+	appDashboardNameHelpPrompt = "The dashboard reports the services deployed in each environment of the application, and the cost of the application."
+)
+
+type dashboardAppVars struct {
+	name         string
+	outputFormat string
+}
+
+type dashboardAppOpts struct {
+	dashboardAppVars
+
+	store store
+	sel   appSelector
+	w     io.Writer
+
+	newDescriber func(app string) (appDashboardDescriber, error)
+}
+
+func newDashboardAppOpts(vars dashboardAppVars) (*dashboardAppOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app dashboard"))
+	sess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &dashboardAppOpts{
+		dashboardAppVars: vars,
+		store:            store,
+		sel:              selector.NewAppEnvSelector(prompt.New(), store),
+		w:                os.Stdout,
+		newDescriber: func(app string) (appDashboardDescriber, error) {
+			return describe.NewAppDashboardDescriber(describe.NewAppDashboardDescriberConfig{
+				App:         app,
+				ConfigStore: store,
+				DeployStore: deployStore,
+			})
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *dashboardAppOpts) Validate() error {
+	for _, format := range describe.DashboardFormats {
+		if o.outputFormat == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid --%s %q: must be one of %s", outputFormatFlag, o.outputFormat, strings.Join(applyAll(describe.DashboardFormats, strconv.Quote), ", "))
+}
+
+// Ask prompts for and validates any required flags.
+func (o *dashboardAppOpts) Ask() error {
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("get application %s: %w", o.name, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Application(appDashboardNamePrompt, appDashboardNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute writes the dashboard of the application in the requested format.
+func (o *dashboardAppOpts) Execute() error {
+	d, err := o.newDescriber(o.name)
+	if err != nil {
+		return fmt.Errorf("create dashboard describer for application %s: %w", o.name, err)
+	}
+	dashboard, err := d.Describe()
+	if err != nil {
+		return fmt.Errorf("describe dashboard of application %s: %w", o.name, err)
+	}
+	if dashboard.Costs != nil && dashboard.Costs.Error != "" {
+		log.Warningf("Costs of application %s are unavailable: %s\n", o.name, dashboard.Costs.Error)
+	}
+	out, err := dashboard.Render(o.outputFormat)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, out)
+	return nil
+}
+
+// buildAppDashboardCmd builds the command for generating a report of an application.
+func buildAppDashboardCmd() *cobra.Command {
+	vars := dashboardAppVars{}
+	cmd := &cobra.Command{
+		Use:   "dashboard",
+		Short: "Generates a status report of an application.",
+		Long: `Generates a status report of an application as a Markdown document or an HTML page.
+The report lists the services deployed in each environment with their version, endpoint,
+alarms and recent deployments, and the cost of each environment over the last 30 days.`,
+		Example: `
+  Write the report of the "my-app" application as Markdown to post it to a wiki.
+  /code $ copilot app dashboard -n my-app > my-app.md
+  Write the report as a standalone HTML page.
+  /code $ copilot app dashboard -n my-app --output-format html > my-app.html`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDashboardAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, describe.DashboardFormatMarkdown, appDashboardOutputFormatFlagDescription)
+	return cmd
+}
@@ -0,0 +1,194 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDashboardAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inFormat string
+
+		wantedErr error
+	}{
+		"valid format": {
+			inFormat: "html",
+		},
+		"invalid format": {
+			inFormat:  "pdf",
+			wantedErr: errors.New(`invalid --output-format "pdf": must be one of "markdown", "html", "json"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &dashboardAppOpts{
+				dashboardAppVars: dashboardAppVars{
+					outputFormat: tc.inFormat,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDashboardAppOpts_Ask(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockappSelector)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"validate the application name if it's set": {
+			inName: "my-app",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			wantedName: "my-app",
+		},
+		"error if the application doesn't exist": {
+			inName: "my-app",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				store.EXPECT().GetApplication("my-app").Return(nil, testErr)
+			},
+			wantedErr: errors.New("get application my-app: some error"),
+		},
+		"prompt for the application": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				sel.EXPECT().Application(appDashboardNamePrompt, appDashboardNameHelpPrompt).Return("my-app", nil)
+			},
+			wantedName: "my-app",
+		},
+		"error if fail to select the application": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", testErr)
+			},
+			wantedErr: errors.New("select application: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockappSelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &dashboardAppOpts{
+				dashboardAppVars: dashboardAppVars{
+					name: tc.inName,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestDashboardAppOpts_Execute(t *testing.T) {
+	generatedAt := time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inFormat   string
+		setupMocks func(m *mocks.MockappDashboardDescriber)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"error if fail to describe the dashboard": {
+			inFormat: describe.DashboardFormatMarkdown,
+			setupMocks: func(m *mocks.MockappDashboardDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe dashboard of application my-app: some error"),
+		},
+		"write the dashboard in the requested format": {
+			inFormat: describe.DashboardFormatJSON,
+			setupMocks: func(m *mocks.MockappDashboardDescriber) {
+				m.EXPECT().Describe().Return(&describe.AppDashboard{
+					App:         "my-app",
+					GeneratedAt: generatedAt,
+				}, nil)
+			},
+			wantedOutput: `{"app":"my-app","generatedAt":"2023-10-02T12:00:00Z","environments":null,"costs":null}` + "\n",
+		},
+		"write the dashboard even if the costs are unavailable": {
+			inFormat: describe.DashboardFormatMarkdown,
+			setupMocks: func(m *mocks.MockappDashboardDescriber) {
+				m.EXPECT().Describe().Return(&describe.AppDashboard{
+					App:         "my-app",
+					GeneratedAt: generatedAt,
+					Costs: &describe.DashboardCosts{
+						Start: generatedAt.AddDate(0, 0, -30),
+						End:   generatedAt,
+						Error: "access denied",
+					},
+				}, nil)
+			},
+			wantedOutput: `# Application my-app
+
+Generated on 2023-10-02 12:00 UTC.
+
+## Costs
+
+From 2023-09-02 to 2023-10-02.
+
+The costs are unavailable: access denied
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			describer := mocks.NewMockappDashboardDescriber(ctrl)
+			tc.setupMocks(describer)
+			b := new(strings.Builder)
+			opts := &dashboardAppOpts{
+				dashboardAppVars: dashboardAppVars{
+					name:         "my-app",
+					outputFormat: tc.inFormat,
+				},
+				w: b,
+				newDescriber: func(app string) (appDashboardDescriber, error) {
+					return describer, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
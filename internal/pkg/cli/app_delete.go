@@ -6,8 +6,10 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -19,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -52,6 +55,7 @@ var (
 type deleteAppVars struct {
 	name             string
 	skipConfirmation bool
+	dryRun           bool
 }
 
 type deleteAppOpts struct {
@@ -71,11 +75,14 @@ type deleteAppOpts struct {
 	taskDeleteExecutor     func(envName, taskName string) (executor, error)
 	pipelineDeleteExecutor func(pipelineName string) (executor, error)
 	existingWorkSpace      func() (wsAppManagerDeleter, error)
+
+	// Dependencies to list the resources to delete with --dry-run.
+	w                 io.Writer
+	appStackResources stackResourcesLister
 }
 
-func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
-	provider := sessions.ImmutableProvider(sessions.UserAgentExtras("app delete"))
-	defaultSession, err := provider.Default()
+func newDeleteAppOpts(vars deleteAppVars, sessProvider *sessions.Provider) (*deleteAppOpts, error) {
+	defaultSession, err := sessProvider.Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %w", err)
 	}
@@ -85,7 +92,7 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 		deleteAppVars: vars,
 		spinner:       termprogress.NewSpinner(log.DiagnosticWriter),
 		store:         store,
-		sessProvider:  provider,
+		sessProvider:  sessProvider,
 		cfn:           cloudformation.New(defaultSession, cloudformation.WithProgressTracker(os.Stderr)),
 		prompt:        prompter,
 		s3: func(session *session.Session) bucketEmptier {
@@ -98,7 +105,10 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 				skipConfirmation: true, // always skip sub-confirmations
 				name:             svcName,
 				appName:          vars.name,
-			})
+				dryRun:           vars.dryRun,
+				// The whole application is deleted, so services that other workloads depend on are deleted too.
+				force: true,
+			}, sessProvider)
 			if err != nil {
 				return nil, err
 			}
@@ -109,7 +119,8 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 				skipConfirmation: true,
 				name:             jobName,
 				appName:          vars.name,
-			})
+				dryRun:           vars.dryRun,
+			}, sessProvider)
 			if err != nil {
 				return nil, err
 			}
@@ -120,7 +131,8 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 				skipConfirmation: true,
 				appName:          vars.name,
 				name:             envName,
-			})
+				dryRun:           vars.dryRun,
+			}, sessProvider)
 			if err != nil {
 				return nil, err
 			}
@@ -132,6 +144,7 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 				env:              envName,
 				name:             taskName,
 				skipConfirmation: true,
+				dryRun:           vars.dryRun,
 			})
 			if err != nil {
 				return nil, err
@@ -144,6 +157,7 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 				name:               pipelineName,
 				skipConfirmation:   true,
 				shouldDeleteSecret: true,
+				dryRun:             vars.dryRun,
 			})
 			if err != nil {
 				return nil, err
@@ -153,6 +167,8 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 		existingWorkSpace: func() (wsAppManagerDeleter, error) {
 			return workspace.Use(afero.NewOsFs())
 		},
+		w:                 os.Stdout,
+		appStackResources: awscloudformation.New(defaultSession),
 	}, nil
 }
 
@@ -166,7 +182,7 @@ func (o *deleteAppOpts) Ask() error {
 	if err := o.validateOrAskAppName(); err != nil {
 		return err
 	}
-	if o.skipConfirmation {
+	if o.skipConfirmation || o.dryRun {
 		return nil
 	}
 
@@ -204,6 +220,11 @@ func (o *deleteAppOpts) Execute() error {
 		return err
 	}
 
+	if o.dryRun {
+		// The commands above only listed the resources of the workloads, environments and pipelines.
+		return o.writeDeletionPlan()
+	}
+
 	if err := o.emptyS3Bucket(); err != nil {
 		return err
 	}
@@ -335,6 +356,29 @@ func (o *deleteAppOpts) emptyS3Bucket() error {
 	return nil
 }
 
+// writeDeletionPlan writes the application-level resources that deleting the application would remove.
+func (o *deleteAppOpts) writeDeletionPlan() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	appResources, err := o.cfn.GetRegionalAppResources(app)
+	if err != nil {
+		return fmt.Errorf("get regional application resources for %s: %w", app.Name, err)
+	}
+	var plan deletionPlan
+	for _, resource := range appResources {
+		plan.add(deletionKindBucket, resource.S3Bucket, dataLossBucket)
+		plan.add("KMS key", resource.KMSKeyARN, "")
+	}
+	if err := plan.addStack(o.appStackResources, stack.NameForAppStack(o.name)); err != nil {
+		return err
+	}
+	plan.add(deletionKindConfig, fmt.Sprintf("application %s", o.name), "")
+	plan.write(o.w, fmt.Sprintf("Deleting application %s", o.name))
+	return nil
+}
+
 func (o *deleteAppOpts) deletePipelines() error {
 	pipelines, err := o.pipelineLister.ListDeployedPipelines(o.name)
 	if err != nil {
@@ -397,9 +441,12 @@ func buildAppDeleteCommand() *cobra.Command {
 		Short: "Delete all resources associated with the application.",
 		Example: `
   Force delete the application with environments "test" and "prod".
-  /code $ copilot app delete --yes`,
+  /code $ copilot app delete --yes
+
+  List the resources that deleting the application would remove, without deleting them.
+  /code $ copilot app delete --dry-run`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeleteAppOpts(vars)
+			opts, err := newDeleteAppOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("app delete")))
 			if err != nil {
 				return err
 			}
@@ -409,5 +456,7 @@ func buildAppDeleteCommand() *cobra.Command {
 
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, deleteDryRunFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(dryRunFlag, yesFlag)
 	return cmd
 }
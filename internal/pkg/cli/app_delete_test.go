@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -36,6 +37,7 @@ type deleteAppMocks struct {
 	pipelineDeleter *mocks.Mockexecutor
 	prompt          *mocks.Mockprompter
 	sel             *mocks.MockappSelector
+	stackResources  *mocks.MockstackResourcesLister
 }
 
 func TestDeleteAppOpts_Ask(t *testing.T) {
@@ -178,10 +180,53 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 	}
 	tests := map[string]struct {
 		appName    string
+		inDryRun   bool
 		setupMocks func(mocks deleteAppMocks)
 
-		wantedError error
+		wantedOutput string
+		wantedError  error
 	}{
+		"list the application resources to delete with --dry-run without deleting them": {
+			appName:  mockAppName,
+			inDryRun: true,
+			setupMocks: func(mocks deleteAppMocks) {
+				gomock.InOrder(
+					// The delete commands of the pipelines, workloads and environments list their own resources.
+					mocks.codepipeline.EXPECT().ListDeployedPipelines(mockAppName).Return(mockPipelines, nil),
+					mocks.pipelineDeleter.EXPECT().Execute().Return(nil).Times(2),
+					mocks.store.EXPECT().ListServices(mockAppName).Return(mockServices, nil),
+					mocks.svcDeleter.EXPECT().Execute().Return(nil).Times(2),
+					mocks.store.EXPECT().ListJobs(mockAppName).Return(mockJobs, nil),
+					mocks.jobDeleter.EXPECT().Execute().Return(nil).Times(2),
+					mocks.store.EXPECT().ListEnvironments(mockAppName).Return(mockEnvs, nil),
+					mocks.deployer.EXPECT().ListTaskStacks(mockAppName, mockEnvs[0].Name).Return(mockTaskStacks, nil),
+					mocks.taskDeleter.EXPECT().Execute().Return(nil),
+					mocks.envDeleter.EXPECT().Ask().Return(nil),
+					mocks.envDeleter.EXPECT().Execute().Return(nil),
+
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
+					mocks.deployer.EXPECT().GetRegionalAppResources(mockApp).Return([]*stack.AppRegionalResources{
+						{
+							Region:    "us-west-2",
+							S3Bucket:  "goose-bucket",
+							KMSKeyARN: "arn:aws:kms:us-west-2:1111:key/goose",
+						},
+					}, nil),
+					mocks.stackResources.EXPECT().TemplateBody("phonetool-infrastructure-roles").Return("Resources: {}", nil),
+					mocks.stackResources.EXPECT().StackResources("phonetool-infrastructure-roles").Return(nil, nil),
+				)
+			},
+			wantedOutput: `Deleting application phonetool would remove:
+
+  Type                   Name                                  Data loss
+  ----                   ----                                  ---------
+  S3 bucket              goose-bucket                          All the objects in the bucket
+  KMS key                arn:aws:kms:us-west-2:1111:key/goose  -
+  CloudFormation stack   phonetool-infrastructure-roles        -
+  Copilot configuration  application phonetool                 -
+
+`,
+		},
 		"success deleting all the resources along with workspace summary": {
 			appName: mockAppName,
 			setupMocks: func(mocks deleteAppMocks) {
@@ -338,12 +383,15 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				taskDeleter:     mockTaskDeleteExecutor,
 				bucketEmptier:   mockBucketEmptier,
 				pipelineDeleter: mockPipelineDeleteExecutor,
+				stackResources:  mocks.NewMockstackResourcesLister(ctrl),
 			}
 			test.setupMocks(mocks)
+			b := new(strings.Builder)
 
 			opts := deleteAppOpts{
 				deleteAppVars: deleteAppVars{
-					name: mockAppName,
+					name:   mockAppName,
+					dryRun: test.inDryRun,
 				},
 				spinner: mockSpinner,
 				store:   mockStore,
@@ -359,6 +407,8 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				envDeleteExecutor:      mockAskExecutorProvider,
 				taskDeleteExecutor:     mockTaskDeleteProvider,
 				pipelineDeleteExecutor: mockPipelineExecutorProvider,
+				w:                      b,
+				appStackResources:      mocks.stackResources,
 			}
 
 			// WHEN
@@ -366,6 +416,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 
 			// THEN
 			require.Equal(t, test.wantedError, err)
+			require.Equal(t, test.wantedOutput, b.String())
 		})
 	}
 }
@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appExportNamePrompt     = "Which application would you like to export?"
+	appExportNameHelpPrompt = "The metadata of the application, its environments, services and jobs is written as JSON."
+)
+
+// appBackup is the metadata of an application in the config store, exported by "app export" and restored by "app restore".
+type appBackup struct {
+	Application  *config.Application   `json:"application"`
+	Environments []*config.Environment `json:"environments"`
+	Services     []*config.Workload    `json:"services"`
+	Jobs         []*config.Workload    `json:"jobs"`
+}
+
+type exportAppVars struct {
+	name string
+}
+
+type exportAppOpts struct {
+	exportAppVars
+
+	store store
+	sel   appSelector
+	w     io.Writer
+}
+
+func newExportAppOpts(vars exportAppVars) (*exportAppOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app export")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	return &exportAppOpts{
+		exportAppVars: vars,
+		store:         store,
+		sel:           selector.NewAppEnvSelector(prompt.New(), store),
+		w:             os.Stdout,
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *exportAppOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *exportAppOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appExportNamePrompt, appExportNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application name: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute writes the metadata of the application as JSON.
+func (o *exportAppOpts) Execute() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.name, err)
+	}
+	svcs, err := o.store.ListServices(o.name)
+	if err != nil {
+		return fmt.Errorf("list services in application %s: %w", o.name, err)
+	}
+	jobs, err := o.store.ListJobs(o.name)
+	if err != nil {
+		return fmt.Errorf("list jobs in application %s: %w", o.name, err)
+	}
+	data, err := json.MarshalIndent(appBackup{
+		Application:  app,
+		Environments: envs,
+		Services:     svcs,
+		Jobs:         jobs,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal application %s: %w", o.name, err)
+	}
+	fmt.Fprintln(o.w, string(data))
+	return nil
+}
+
+// buildAppExportCmd builds the command to export the metadata of an application.
+func buildAppExportCmd() *cobra.Command {
+	vars := exportAppVars{}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the metadata of an application as JSON.",
+		Long: `Export the metadata of an application as JSON.
+The metadata of the application, its environments, services and jobs
+can be recreated from the output with "copilot app restore".`,
+		Example: `
+  Back up the metadata of the application "my-app".
+  /code $ copilot app export --name my-app > my-app-backup.json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newExportAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
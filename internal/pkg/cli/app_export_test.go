@@ -0,0 +1,163 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportAppOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m *mocks.MockappSelector)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"skip prompting if the name is provided": {
+			inName:     "my-app",
+			setupMocks: func(m *mocks.MockappSelector) {},
+			wantedName: "my-app",
+		},
+		"error if fail to select the application": {
+			setupMocks: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(appExportNamePrompt, appExportNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select application name: some error"),
+		},
+		"select the application": {
+			setupMocks: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(appExportNamePrompt, appExportNameHelpPrompt).Return("my-app", nil)
+			},
+			wantedName: "my-app",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			sel := mocks.NewMockappSelector(ctrl)
+			tc.setupMocks(sel)
+			opts := &exportAppOpts{
+				exportAppVars: exportAppVars{
+					name: tc.inName,
+				},
+				sel: sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestExportAppOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"error if fail to list environments": {
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().ListEnvironments("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments in application my-app: some error"),
+		},
+		"write the metadata of the application": {
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:      "my-app",
+					AccountID: "123456789012",
+					Version:   "1.0",
+				}, nil)
+				m.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						App:              "my-app",
+						Name:             "test",
+						Region:           "us-west-2",
+						AccountID:        "123456789012",
+						RegistryURL:      "123456789012.dkr.ecr.us-west-2.amazonaws.com",
+						ExecutionRoleARN: "arn:aws:iam::123456789012:role/my-app-test-CFNExecutionRole",
+						ManagerRoleARN:   "arn:aws:iam::123456789012:role/my-app-test-EnvManagerRole",
+					},
+				}, nil)
+				m.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{App: "my-app", Name: "api", Type: "Load Balanced Web Service"},
+				}, nil)
+				m.EXPECT().ListJobs("my-app").Return(nil, nil)
+			},
+			wantedOutput: `{
+  "application": {
+    "name": "my-app",
+    "account": "123456789012",
+    "domain": "",
+    "domainHostedZoneID": "",
+    "version": "1.0"
+  },
+  "environments": [
+    {
+      "app": "my-app",
+      "name": "test",
+      "region": "us-west-2",
+      "accountID": "123456789012",
+      "registryURL": "123456789012.dkr.ecr.us-west-2.amazonaws.com",
+      "executionRoleARN": "arn:aws:iam::123456789012:role/my-app-test-CFNExecutionRole",
+      "managerRoleARN": "arn:aws:iam::123456789012:role/my-app-test-EnvManagerRole"
+    }
+  ],
+  "services": [
+    {
+      "app": "my-app",
+      "name": "api",
+      "type": "Load Balanced Web Service"
+    }
+  ],
+  "jobs": null
+}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			tc.setupMocks(store)
+			b := &strings.Builder{}
+			opts := &exportAppOpts{
+				exportAppVars: exportAppVars{
+					name: "my-app",
+				},
+				store: store,
+				w:     b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
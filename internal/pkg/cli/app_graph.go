@@ -0,0 +1,154 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/topology"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appGraphNamePrompt     = "Which application would you like to graph?"
+	appGraphNameHelpPrompt = "The graph shows the environments, workloads, and resources of the application, and how they are connected."
+)
+
+type graphAppVars struct {
+	name         string
+	outputFormat string
+}
+
+type graphAppOpts struct {
+	graphAppVars
+
+	store store
+	sel   appSelector
+	w     io.Writer
+
+	wsAppName   string                                    // Name of the application of the workspace, if any.
+	newResolver func(withWorkspace bool) topologyResolver // Resolves the manifests and addons of the workspace if withWorkspace is true.
+}
+
+func newGraphAppOpts(vars graphAppVars) (*graphAppOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app graph"))
+	sess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		var errNoWorkspace *workspace.ErrWorkspaceNotFound
+		if !errors.As(err, &errNoWorkspace) {
+			return nil, err
+		}
+	}
+	var wsAppName string
+	if ws != nil {
+		wsAppName = tryReadingAppName()
+	}
+	return &graphAppOpts{
+		graphAppVars: vars,
+		store:        store,
+		sel:          selector.NewAppEnvSelector(prompt.New(), store),
+		w:            os.Stdout,
+		wsAppName:    wsAppName,
+		newResolver: func(withWorkspace bool) topologyResolver {
+			if !withWorkspace {
+				return topology.NewResolver(store, deployStore, nil)
+			}
+			return topology.NewResolver(store, deployStore, ws)
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *graphAppOpts) Validate() error {
+	for _, format := range topology.Formats {
+		if o.outputFormat == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid --%s %q: must be one of %s", outputFormatFlag, o.outputFormat, strings.Join(applyAll(topology.Formats, strconv.Quote), ", "))
+}
+
+// Ask prompts for and validates any required flags.
+func (o *graphAppOpts) Ask() error {
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("get application %s: %w", o.name, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Application(appGraphNamePrompt, appGraphNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute writes the graph of the application in the requested format.
+func (o *graphAppOpts) Execute() error {
+	// The manifests of the workspace only describe the workloads of the application if the workspace belongs to it.
+	t, err := o.newResolver(o.wsAppName != "" && o.wsAppName == o.name).Resolve(o.name)
+	if err != nil {
+		return fmt.Errorf("resolve topology of application %s: %w", o.name, err)
+	}
+	out, err := t.Render(o.outputFormat)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, out)
+	return nil
+}
+
+// buildAppGraphCmd builds the command for graphing the topology of an application.
+func buildAppGraphCmd() *cobra.Command {
+	vars := graphAppVars{}
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Draws a graph of the environments, workloads, and resources of an application.",
+		Long: `Draws a graph of the environments, workloads, and resources of an application.
+The graph includes the environments that each workload is deployed to. If the current workspace belongs
+to the application, the graph also includes the sidecars and their "depends_on" order, the services that
+workloads call through service discovery or Service Connect, the topics and queues of publishers
+and subscribers, and the addon resources.`,
+		Example: `
+  Write the graph of the "my-app" application in the DOT format of Graphviz and render it as an image.
+  /code $ copilot app graph -n my-app | dot -Tsvg -o my-app.svg
+  Write the graph as a Mermaid flowchart to paste in a Markdown document.
+  /code $ copilot app graph --output-format mermaid`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newGraphAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, topology.FormatDOT, appGraphOutputFormatFlagDescription)
+	return cmd
+}
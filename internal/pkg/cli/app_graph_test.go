@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/topology"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inFormat string
+
+		wantedErr error
+	}{
+		"valid format": {
+			inFormat: "mermaid",
+		},
+		"invalid format": {
+			inFormat:  "svg",
+			wantedErr: errors.New(`invalid --output-format "svg": must be one of "dot", "mermaid", "json"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &graphAppOpts{
+				graphAppVars: graphAppVars{
+					outputFormat: tc.inFormat,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGraphAppOpts_Ask(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockappSelector)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"validate the application name if it's set": {
+			inName: "my-app",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			wantedName: "my-app",
+		},
+		"error if the application doesn't exist": {
+			inName: "my-app",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				store.EXPECT().GetApplication("my-app").Return(nil, testErr)
+			},
+			wantedErr: errors.New("get application my-app: some error"),
+		},
+		"prompt for the application": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				sel.EXPECT().Application(appGraphNamePrompt, appGraphNameHelpPrompt).Return("my-app", nil)
+			},
+			wantedName: "my-app",
+		},
+		"error if fail to select the application": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", testErr)
+			},
+			wantedErr: errors.New("select application: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockappSelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &graphAppOpts{
+				graphAppVars: graphAppVars{
+					name: tc.inName,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestGraphAppOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inWsAppName string
+		inFormat    string
+		setupMocks  func(m *mocks.MocktopologyResolver)
+
+		wantedWithWorkspace bool
+		wantedOutput        string
+		wantedErr           error
+	}{
+		"error if fail to resolve the topology": {
+			inFormat: topology.FormatDOT,
+			setupMocks: func(m *mocks.MocktopologyResolver) {
+				m.EXPECT().Resolve("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("resolve topology of application my-app: some error"),
+		},
+		"ignore the workspace of another application": {
+			inWsAppName: "other-app",
+			inFormat:    topology.FormatJSON,
+			setupMocks: func(m *mocks.MocktopologyResolver) {
+				m.EXPECT().Resolve("my-app").Return(&topology.Topology{App: "my-app"}, nil)
+			},
+			wantedOutput: `{"app":"my-app","nodes":null,"edges":null}` + "\n",
+		},
+		"use the workspace of the application": {
+			inWsAppName: "my-app",
+			inFormat:    topology.FormatMermaid,
+			setupMocks: func(m *mocks.MocktopologyResolver) {
+				m.EXPECT().Resolve("my-app").Return(&topology.Topology{
+					App:   "my-app",
+					Nodes: []topology.Node{{ID: "env:test", Kind: topology.NodeKindEnvironment, Name: "test"}},
+				}, nil)
+			},
+			wantedWithWorkspace: true,
+			wantedOutput:        "flowchart LR\n  n0[[\"test\"]]\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			resolver := mocks.NewMocktopologyResolver(ctrl)
+			tc.setupMocks(resolver)
+			var gotWithWorkspace bool
+			b := new(strings.Builder)
+			opts := &graphAppOpts{
+				graphAppVars: graphAppVars{
+					name:         "my-app",
+					outputFormat: tc.inFormat,
+				},
+				w:         b,
+				wsAppName: tc.inWsAppName,
+				newResolver: func(withWorkspace bool) topologyResolver {
+					gotWithWorkspace = withWorkspace
+					return resolver
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWithWorkspace, gotWithWorkspace)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
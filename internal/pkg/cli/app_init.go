@@ -15,6 +15,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ssm"
 
 	"github.com/spf13/cobra"
@@ -25,6 +26,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -32,6 +34,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
+// maxRoleSessionTags is the maximum number of session tags that can be passed when a role is assumed.
+const maxRoleSessionTags = 50
+
 const (
 	fmtAppInitNamePrompt    = "What would you like to %s your application?"
 	fmtAppInitNewNamePrompt = `Ok, let's create a new application then.
@@ -44,6 +49,15 @@ type initAppVars struct {
 	permissionsBoundary string
 	domainName          string
 	resourceTags        map[string]string
+	conventionsFile     string
+
+	appRegistry           bool
+	appRegistryAttributes map[string]string
+	stackMetadata         map[string]string
+	roleSessionTags       map[string]string
+	roleSourceIdentity    bool
+	noRoleSession         bool
+	helperImages          map[string]string
 }
 
 type initAppOpts struct {
@@ -57,17 +71,22 @@ type initAppOpts struct {
 	prog                 progress
 	iam                  policyLister
 	iamRoleManager       roleManager
+	fs                   afero.Fs
 	isSessionFromEnvVars func() (bool, error)
+	region               string // Region of the application.
+	useFIPSEndpoint      bool
 
 	existingWorkspace func() (wsAppManager, error)
 	newWorkspace      func(appName string) (wsAppManager, error)
 
 	// Cached variables.
 	cachedHostedZoneID string
+	conventions        *config.NamingConventions
+	existingApp        *config.Application
 }
 
-func newInitAppOpts(vars initAppVars) (*initAppOpts, error) {
-	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app init")).Default()
+func newInitAppOpts(vars initAppVars, sessProvider *sessions.Provider) (*initAppOpts, error) {
+	sess, err := sessProvider.Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %w", err)
 	}
@@ -84,9 +103,12 @@ func newInitAppOpts(vars initAppVars) (*initAppOpts, error) {
 		prog:           termprogress.NewSpinner(log.DiagnosticWriter),
 		iam:            iamClient,
 		iamRoleManager: iamClient,
+		fs:             fs,
 		isSessionFromEnvVars: func() (bool, error) {
 			return sessions.AreCredsFromEnvVars(sess)
 		},
+		region:          aws.StringValue(sess.Config.Region),
+		useFIPSEndpoint: sess.Config.UseFIPSEndpoint == endpoints.FIPSEndpointStateEnabled,
 		existingWorkspace: func() (wsAppManager, error) {
 			return workspace.Use(fs)
 		},
@@ -98,6 +120,33 @@ func newInitAppOpts(vars initAppVars) (*initAppOpts, error) {
 
 // Validate returns an error if the user's input is invalid.
 func (o *initAppOpts) Validate() error {
+	if o.domainName != "" {
+		// Route 53 names the hosted zones of internationalized domain names after their punycode form.
+		domainName, err := domainNameToASCII(o.domainName)
+		if err != nil {
+			return fmt.Errorf("domain name %s is invalid: %w", o.domainName, err)
+		}
+		o.domainName = domainName
+	}
+	if o.conventionsFile != "" {
+		if err := o.readConventions(); err != nil {
+			return err
+		}
+	}
+	if len(o.roleSessionTags) > maxRoleSessionTags {
+		return fmt.Errorf("--%s must have at most %d tags", roleSessionTagsFlag, maxRoleSessionTags)
+	}
+	if o.noRoleSession && o.hasRoleSession() {
+		return fmt.Errorf("--%s cannot be specified with --%s or --%s", noRoleSessionFlag, roleSessionTagsFlag, roleSourceIdentityFlag)
+	}
+	for name, uri := range o.helperImages {
+		if err := template.ValidateHelperImageName(name); err != nil {
+			return fmt.Errorf("--%s: %w", helperImagesFlag, err)
+		}
+		if uri == "" {
+			return fmt.Errorf("--%s: image of %q must not be empty", helperImagesFlag, name)
+		}
+	}
 	if o.name != "" {
 		if err := o.validateAppName(o.name); err != nil {
 			return err
@@ -217,6 +266,14 @@ func (o *initAppOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
 	}
+	// Environments are usually deployed to the region of the application, warn early if they can't be.
+	msg, err := unsupportedRegionWarning(o.region, o.useFIPSEndpoint, envRegionServices)
+	if err != nil {
+		return err
+	}
+	if msg != "" {
+		log.Warning(msg)
+	}
 
 	_, err = o.newWorkspace(o.name)
 	if err != nil {
@@ -229,6 +286,10 @@ func (o *initAppOpts) Execute() error {
 			return err
 		}
 	}
+	appRegistry := o.appRegistryConfig()
+	stackMetadata := o.stackMetadataConfig()
+	roleSession := o.roleSessionConfig()
+	helperImages := o.helperImagesConfig()
 	err = o.cfn.DeployApp(&deploy.CreateAppInput{
 		Name:                o.name,
 		AccountID:           caller.Account,
@@ -236,6 +297,8 @@ func (o *initAppOpts) Execute() error {
 		DomainHostedZoneID:  hostedZoneID,
 		PermissionsBoundary: o.permissionsBoundary,
 		AdditionalTags:      o.resourceTags,
+		AppRegistry:         appRegistry,
+		StackMetadata:       stackMetadata,
 		Version:             version.LatestTemplateVersion(),
 	})
 	if err != nil {
@@ -249,14 +312,108 @@ func (o *initAppOpts) Execute() error {
 		DomainHostedZoneID:  hostedZoneID,
 		PermissionsBoundary: o.permissionsBoundary,
 		Tags:                o.resourceTags,
+		Conventions:         o.conventions,
+		AppRegistry:         appRegistry,
+		StackMetadata:       stackMetadata,
+		RoleSession:         roleSession,
+		HelperImages:        helperImages,
 	}); err != nil {
 		return err
 	}
+	if o.existingApp != nil && o.conventions != nil {
+		// CreateApplication doesn't overwrite an existing application.
+		o.existingApp.Conventions = o.conventions
+		if err := o.store.UpdateApplication(o.existingApp); err != nil {
+			return fmt.Errorf("update naming conventions of application %s: %w", o.name, err)
+		}
+	}
+	if o.existingApp != nil && o.registerInAppRegistry() {
+		o.existingApp.AppRegistry = appRegistry
+		if err := o.store.UpdateApplication(o.existingApp); err != nil {
+			return fmt.Errorf("update AppRegistry attributes of application %s: %w", o.name, err)
+		}
+	}
+	if o.existingApp != nil && len(o.stackMetadata) > 0 {
+		o.existingApp.StackMetadata = stackMetadata
+		if err := o.store.UpdateApplication(o.existingApp); err != nil {
+			return fmt.Errorf("update stack metadata of application %s: %w", o.name, err)
+		}
+	}
+	if o.existingApp != nil && (o.hasRoleSession() || o.noRoleSession && o.existingApp.RoleSession != nil) {
+		o.existingApp.RoleSession = roleSession
+		if err := o.store.UpdateApplication(o.existingApp); err != nil {
+			return fmt.Errorf("update role session settings of application %s: %w", o.name, err)
+		}
+	}
+	if o.existingApp != nil && len(o.helperImages) > 0 {
+		o.existingApp.HelperImages = helperImages
+		if err := o.store.UpdateApplication(o.existingApp); err != nil {
+			return fmt.Errorf("update helper images of application %s: %w", o.name, err)
+		}
+	}
 	log.Successf("The directory %s will hold service manifests for application %s.\n", color.HighlightResource(workspace.CopilotDirName), color.HighlightUserInput(o.name))
 	log.Infoln()
 	return nil
 }
 
+func (o *initAppOpts) registerInAppRegistry() bool {
+	return o.appRegistry || len(o.appRegistryAttributes) > 0
+}
+
+// appRegistryConfig returns how the application is registered in AWS Service Catalog AppRegistry.
+// An existing application stays registered unless the flags provide new attributes.
+func (o *initAppOpts) appRegistryConfig() *config.AppRegistry {
+	if o.registerInAppRegistry() {
+		return &config.AppRegistry{
+			Attributes: o.appRegistryAttributes,
+		}
+	}
+	if o.existingApp != nil {
+		return o.existingApp.AppRegistry
+	}
+	return nil
+}
+
+// stackMetadataConfig returns the keys to add to the Metadata section of every stack of the application.
+// An existing application keeps its keys unless the flag provides new ones.
+func (o *initAppOpts) stackMetadataConfig() map[string]string {
+	if len(o.stackMetadata) == 0 && o.existingApp != nil {
+		return o.existingApp.StackMetadata
+	}
+	return o.stackMetadata
+}
+
+// helperImagesConfig returns the locations of the helper images that the application overrides.
+// An existing application keeps its locations unless the flag provides new ones.
+func (o *initAppOpts) helperImagesConfig() map[string]string {
+	if len(o.helperImages) == 0 && o.existingApp != nil {
+		return o.existingApp.HelperImages
+	}
+	return o.helperImages
+}
+
+func (o *initAppOpts) hasRoleSession() bool {
+	return len(o.roleSessionTags) > 0 || o.roleSourceIdentity
+}
+
+// roleSessionConfig returns the session tags and source identity of the sessions of the environment manager roles.
+// An existing application keeps its settings unless the flags provide new ones or clear them.
+func (o *initAppOpts) roleSessionConfig() *config.RoleSession {
+	if o.noRoleSession {
+		return nil
+	}
+	if o.hasRoleSession() {
+		return &config.RoleSession{
+			Tags:           o.roleSessionTags,
+			SourceIdentity: o.roleSourceIdentity,
+		}
+	}
+	if o.existingApp != nil {
+		return o.existingApp.RoleSession
+	}
+	return nil
+}
+
 func (o *initAppOpts) validateAppName(name string) error {
 	if err := validateAppNameString(name); err != nil {
 		return err
@@ -266,10 +423,14 @@ func (o *initAppOpts) validateAppName(name string) error {
 		if o.domainName != "" && app.Domain != o.domainName {
 			return fmt.Errorf("application named %s already exists with a different domain name %s", name, app.Domain)
 		}
+		o.existingApp = app
 		return nil
 	}
 	var noSuchAppErr *config.ErrNoSuchApplication
 	if errors.As(err, &noSuchAppErr) {
+		if err := o.conventions.Validate(config.ApplicationKind, name); err != nil {
+			return err
+		}
 		roleName := fmt.Sprintf("%s-adminrole", name)
 		tags, err := o.iamRoleManager.ListRoleTags(roleName)
 		// NOTE: This is a best-effort attempt to check if the app exists in other regions.
@@ -290,6 +451,19 @@ func (o *initAppOpts) validateAppName(name string) error {
 	return fmt.Errorf("get application %s: %w", name, err)
 }
 
+func (o *initAppOpts) readConventions() error {
+	content, err := afero.ReadFile(o.fs, o.conventionsFile)
+	if err != nil {
+		return fmt.Errorf("read naming conventions file %s: %w", o.conventionsFile, err)
+	}
+	conventions, err := config.ParseNamingConventions(content)
+	if err != nil {
+		return fmt.Errorf("parse naming conventions file %s: %w", o.conventionsFile, err)
+	}
+	o.conventions = conventions
+	return nil
+}
+
 func (o *initAppOpts) validatePermBound(policyName string) error {
 	IAMPolicies, err := o.iam.ListPolicyNames()
 	if err != nil {
@@ -411,10 +585,22 @@ An application is a collection of containerized services that operate together.`
   Create a new application with an existing IAM policy as the permissions boundary for roles.
   /code $ copilot app init --permissions-boundary myPermissionsBoundaryPolicy
   Create a new application with resource tags.
-  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam`,
+  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam
+  Create a new application whose resources must follow naming conventions.
+  /code $ copilot app init --conventions ./conventions.yml
+  Create a new application registered in AWS Service Catalog AppRegistry with attributes.
+  /code $ copilot app init --app-registry-attributes owner=payments,tier=1,repo=github.com/acme/payments
+  Create a new application whose stacks have the metadata required by CloudFormation Hooks.
+  /code $ copilot app init --stack-metadata CostCenter=1234,DataClassification=internal
+  Create a new application whose operations are attributed to the caller in CloudTrail.
+  /code $ copilot app init --role-source-identity --role-session-tags team=payments
+  Stop setting the source identity and session tags of the roles of an existing application.
+  /code $ copilot app init --no-role-session
+  Create a new application whose workloads pull the Fluent Bit and Envoy images from a private mirror.
+  /code $ copilot app init --helper-images fluent-bit=123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/aws-for-fluent-bit:2.31.12,envoy=123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/envoy:v1.27.2`,
 		Args: reservedArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newInitAppOpts(vars)
+			opts, err := newInitAppOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("app init")))
 			if err != nil {
 				return err
 			}
@@ -427,5 +613,13 @@ An application is a collection of containerized services that operate together.`
 	cmd.Flags().StringVar(&vars.domainName, domainNameFlag, "", domainNameFlagDescription)
 	cmd.Flags().StringVar(&vars.permissionsBoundary, permissionsBoundaryFlag, "", permissionsBoundaryFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().StringVar(&vars.conventionsFile, conventionsFlag, "", conventionsFlagDescription)
+	cmd.Flags().BoolVar(&vars.appRegistry, appRegistryFlag, false, appRegistryFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.appRegistryAttributes, appRegistryAttributesFlag, nil, appRegistryAttributesFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.stackMetadata, stackMetadataFlag, nil, stackMetadataFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.roleSessionTags, roleSessionTagsFlag, nil, roleSessionTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.roleSourceIdentity, roleSourceIdentityFlag, false, roleSourceIdentityFlagDescription)
+	cmd.Flags().BoolVar(&vars.noRoleSession, noRoleSessionFlag, false, noRoleSessionFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.helperImages, helperImagesFlag, nil, helperImagesFlagDescription)
 	return cmd
 }
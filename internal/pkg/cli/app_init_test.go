@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,9 +30,13 @@ type initAppMocks struct {
 
 func TestInitAppOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName      string
-		inDomainName   string
-		inPBPolicyName string
+		inAppName         string
+		inDomainName      string
+		inPBPolicyName    string
+		inConventionsFile string
+		inHelperImages    map[string]string
+		inRoleSourceID    bool
+		inNoRoleSession   bool
 
 		mock func(m *initAppMocks)
 
@@ -85,6 +90,36 @@ func TestInitAppOpts_Validate(t *testing.T) {
 			},
 			wantedError: errors.New("IAM admin role \"metrics-adminrole\" already exists in this account"),
 		},
+		"errors if the naming conventions file doesn't exist": {
+			inConventionsFile: "missing.yml",
+			mock:              func(m *initAppMocks) {},
+
+			wantedError: errors.New("read naming conventions file missing.yml: open missing.yml: file does not exist"),
+		},
+		"errors if the naming conventions file is invalid": {
+			inConventionsFile: "invalid.yml",
+			mock:              func(m *initAppMocks) {},
+
+			wantedError: errors.New("parse naming conventions file invalid.yml: parse application naming pattern \"team-(\": error parsing regexp: missing closing ): `^(?:team-()$`"),
+		},
+		"errors if a new app name doesn't follow the naming conventions": {
+			inAppName:         "metrics",
+			inConventionsFile: "conventions.yml",
+			mock: func(m *initAppMocks) {
+				m.mockStore.EXPECT().GetApplication("metrics").Return(nil, &config.ErrNoSuchApplication{
+					ApplicationName: "metrics",
+				})
+			},
+
+			wantedError: errors.New(`application name "metrics" doesn't match the naming convention "team-[a-z]+"`),
+		},
+		"skip the naming conventions for an existing app": {
+			inAppName:         "metrics",
+			inConventionsFile: "conventions.yml",
+			mock: func(m *initAppMocks) {
+				m.mockStore.EXPECT().GetApplication("metrics").Return(&config.Application{Name: "metrics"}, nil)
+			},
+		},
 		"invalid app name": {
 			inAppName: "123chicken",
 			mock:      func(m *initAppMocks) {},
@@ -183,6 +218,15 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				m.mockRoute53Svc.EXPECT().DomainHostedZoneID("mockDomain.com").Return("mockHostedZoneID", nil)
 			},
 		},
+		"convert an internationalized domain name to punycode": {
+			inDomainName: "bücher.example",
+			mock: func(m *initAppMocks) {
+				m.mockProg.EXPECT().Start(`Validating ownership of "xn--bcher-kva.example"`)
+				m.mockProg.EXPECT().Stop("")
+				m.mockRoute53Svc.EXPECT().ValidateDomainOwnership("xn--bcher-kva.example").Return(nil)
+				m.mockRoute53Svc.EXPECT().DomainHostedZoneID("xn--bcher-kva.example").Return("mockHostedZoneID", nil)
+			},
+		},
 		"valid domain name containing multiple dots": {
 			inDomainName: "hello.dog.com",
 			mock: func(m *initAppMocks) {
@@ -192,6 +236,21 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				m.mockRoute53Svc.EXPECT().DomainHostedZoneID("hello.dog.com").Return("mockHostedZoneID", nil)
 			},
 		},
+		"invalid helper image name": {
+			inHelperImages: map[string]string{"nginx": "nginx:latest"},
+			mock:           func(m *initAppMocks) {},
+			wantedError:    errors.New(`--helper-images: unknown helper image "nginx": must be one of "fluent-bit", "aws-otel-collector", "envoy" or "pause"`),
+		},
+		"valid helper images": {
+			inHelperImages: map[string]string{"envoy": "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/envoy:v1.27.2"},
+			mock:           func(m *initAppMocks) {},
+		},
+		"error if the role session is both set and cleared": {
+			inRoleSourceID:  true,
+			inNoRoleSession: true,
+			mock:            func(m *initAppMocks) {},
+			wantedError:     errors.New("--no-role-session cannot be specified with --role-session-tags or --role-source-identity"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -208,6 +267,9 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				mockProg:         mocks.NewMockprogress(ctrl),
 			}
 			tc.mock(m)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "conventions.yml", []byte("applications:\n  pattern: team-[a-z]+\n"), 0644))
+			require.NoError(t, afero.WriteFile(fs, "invalid.yml", []byte("applications:\n  pattern: team-(\n"), 0644))
 
 			opts := &initAppOpts{
 				route53:        m.mockRoute53Svc,
@@ -215,10 +277,15 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				iam:            m.mockPolicyLister,
 				iamRoleManager: m.mockRoleManager,
 				prog:           m.mockProg,
+				fs:             fs,
 				initAppVars: initAppVars{
 					name:                tc.inAppName,
 					domainName:          tc.inDomainName,
 					permissionsBoundary: tc.inPBPolicyName,
+					conventionsFile:     tc.inConventionsFile,
+					helperImages:        tc.inHelperImages,
+					roleSourceIdentity:  tc.inRoleSourceID,
+					noRoleSession:       tc.inNoRoleSession,
 				},
 			}
 
@@ -635,6 +702,14 @@ func TestInitAppOpts_Execute(t *testing.T) {
 		inDomainName                string
 		inDomainHostedZoneID        string
 		inPermissionsBoundaryPolicy string
+		inConventions               *config.NamingConventions
+		inExistingApp               *config.Application
+		inAppRegistryAttributes     map[string]string
+		inStackMetadata             map[string]string
+		inRoleSessionTags           map[string]string
+		inRoleSourceIdentity        bool
+		inNoRoleSession             bool
+		inHelperImages              map[string]string
 
 		expectedError error
 		mocking       func(m *initAppExecuteMocks)
@@ -697,6 +772,268 @@ func TestInitAppOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().DeployApp(gomock.Any()).Return(mockError)
 			},
 		},
+		"update the naming conventions of an existing app": {
+			inConventions: &config.NamingConventions{
+				Services: &config.NamingRule{Pattern: "svc-.+"},
+			},
+			inExistingApp: &config.Application{
+				Name:      "myapp",
+				AccountID: "12345",
+			},
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				m.store.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				m.store.EXPECT().UpdateApplication(&config.Application{
+					Name:      "myapp",
+					AccountID: "12345",
+					Conventions: &config.NamingConventions{
+						Services: &config.NamingRule{Pattern: "svc-.+"},
+					},
+				}).Return(nil)
+			},
+		},
+		"register an existing app in AppRegistry": {
+			inExistingApp: &config.Application{
+				Name:      "myapp",
+				AccountID: "12345",
+			},
+			inAppRegistryAttributes: map[string]string{
+				"tier": "1",
+			},
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+					Name:      "myapp",
+					AccountID: "12345",
+					AdditionalTags: map[string]string{
+						"owner": "boss",
+					},
+					AppRegistry: &config.AppRegistry{
+						Attributes: map[string]string{"tier": "1"},
+					},
+					Version: version.LatestTemplateVersion(),
+				}).Return(nil)
+				m.store.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				m.store.EXPECT().UpdateApplication(&config.Application{
+					Name:      "myapp",
+					AccountID: "12345",
+					AppRegistry: &config.AppRegistry{
+						Attributes: map[string]string{"tier": "1"},
+					},
+				}).Return(nil)
+			},
+		},
+		"keep an existing app registered in AppRegistry": {
+			inExistingApp: &config.Application{
+				Name:        "myapp",
+				AccountID:   "12345",
+				AppRegistry: &config.AppRegistry{},
+			},
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+					Name:      "myapp",
+					AccountID: "12345",
+					AdditionalTags: map[string]string{
+						"owner": "boss",
+					},
+					AppRegistry: &config.AppRegistry{},
+					Version:     version.LatestTemplateVersion(),
+				}).Return(nil)
+				m.store.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+			},
+		},
+		"add stack metadata to an existing app": {
+			inExistingApp: &config.Application{
+				Name:      "myapp",
+				AccountID: "12345",
+			},
+			inStackMetadata: map[string]string{
+				"CostCenter": "1234",
+			},
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+					Name:      "myapp",
+					AccountID: "12345",
+					AdditionalTags: map[string]string{
+						"owner": "boss",
+					},
+					StackMetadata: map[string]string{"CostCenter": "1234"},
+					Version:       version.LatestTemplateVersion(),
+				}).Return(nil)
+				m.store.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				m.store.EXPECT().UpdateApplication(&config.Application{
+					Name:          "myapp",
+					AccountID:     "12345",
+					StackMetadata: map[string]string{"CostCenter": "1234"},
+				}).Return(nil)
+			},
+		},
+		"keep the stack metadata of an existing app": {
+			inExistingApp: &config.Application{
+				Name:          "myapp",
+				AccountID:     "12345",
+				StackMetadata: map[string]string{"CostCenter": "1234"},
+			},
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+					Name:      "myapp",
+					AccountID: "12345",
+					AdditionalTags: map[string]string{
+						"owner": "boss",
+					},
+					StackMetadata: map[string]string{"CostCenter": "1234"},
+					Version:       version.LatestTemplateVersion(),
+				}).Return(nil)
+				m.store.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+			},
+		},
+		"add role session settings to an existing app": {
+			inExistingApp: &config.Application{
+				Name:      "myapp",
+				AccountID: "12345",
+			},
+			inRoleSessionTags:    map[string]string{"team": "payments"},
+			inRoleSourceIdentity: true,
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				m.store.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				m.store.EXPECT().UpdateApplication(&config.Application{
+					Name:      "myapp",
+					AccountID: "12345",
+					RoleSession: &config.RoleSession{
+						Tags:           map[string]string{"team": "payments"},
+						SourceIdentity: true,
+					},
+				}).Return(nil)
+			},
+		},
+		"keep the role session settings of an existing app": {
+			inExistingApp: &config.Application{
+				Name:        "myapp",
+				AccountID:   "12345",
+				RoleSession: &config.RoleSession{SourceIdentity: true},
+			},
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				m.store.EXPECT().CreateApplication(&config.Application{
+					Name:        "myapp",
+					AccountID:   "12345",
+					Tags:        map[string]string{"owner": "boss"},
+					RoleSession: &config.RoleSession{SourceIdentity: true},
+				}).Return(nil)
+			},
+		},
+		"clear the role session settings of an existing app": {
+			inExistingApp: &config.Application{
+				Name:        "myapp",
+				AccountID:   "12345",
+				RoleSession: &config.RoleSession{SourceIdentity: true},
+			},
+			inNoRoleSession: true,
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				m.store.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				m.store.EXPECT().UpdateApplication(&config.Application{
+					Name:      "myapp",
+					AccountID: "12345",
+				}).Return(nil)
+			},
+		},
+		"override the helper images of an existing app": {
+			inExistingApp: &config.Application{
+				Name:      "myapp",
+				AccountID: "12345",
+			},
+			inHelperImages: map[string]string{"fluent-bit": "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/fluent-bit:2.31.12"},
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				m.store.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				m.store.EXPECT().UpdateApplication(&config.Application{
+					Name:         "myapp",
+					AccountID:    "12345",
+					HelperImages: map[string]string{"fluent-bit": "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/fluent-bit:2.31.12"},
+				}).Return(nil)
+			},
+		},
+		"should return error from UpdateApplication": {
+			inConventions: &config.NamingConventions{},
+			inExistingApp: &config.Application{Name: "myapp"},
+			expectedError: mockError,
+
+			mocking: func(m *initAppExecuteMocks) {
+				m.identityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				m.newWorkspace = func(appName string) (wsAppManager, error) {
+					return m.ws, nil
+				}
+				m.deployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				m.store.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				m.store.EXPECT().UpdateApplication(gomock.Any()).Return(mockError)
+			},
+		},
 		"should return error from CreateApplication": {
 			expectedError: mockError,
 			mocking: func(m *initAppExecuteMocks) {
@@ -734,13 +1071,22 @@ func TestInitAppOpts_Execute(t *testing.T) {
 					resourceTags: map[string]string{
 						"owner": "boss",
 					},
+					appRegistryAttributes: tc.inAppRegistryAttributes,
+					stackMetadata:         tc.inStackMetadata,
+					roleSessionTags:       tc.inRoleSessionTags,
+					roleSourceIdentity:    tc.inRoleSourceIdentity,
+					noRoleSession:         tc.inNoRoleSession,
+					helperImages:          tc.inHelperImages,
 				},
 				store:    m.store,
 				identity: m.identityService,
 				cfn:      m.deployer,
+				region:   "us-west-2",
 				// ws:                 m.ws,
 				prog:               m.progress,
 				cachedHostedZoneID: tc.inDomainHostedZoneID,
+				conventions:        tc.inConventions,
+				existingApp:        tc.inExistingApp,
 				newWorkspace:       m.newWorkspace,
 			}
 
@@ -0,0 +1,490 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appMigrateNamePrompt       = "Which application would you like to migrate?"
+	fmtAppMigrateConfirmPrompt = "Are you sure you want to migrate application %s to %s in account %s?"
+	appMigrateConfirmHelp      = "This will create a copy of the application and list the steps to move its environments and workloads to it."
+)
+
+var errAppMigrateCancelled = errors.New("app migrate cancelled - no changes made")
+
+type migrateAppVars struct {
+	name             string
+	newName          string
+	profile          string
+	skipConfirmation bool
+}
+
+type migrateAppOpts struct {
+	migrateAppVars
+
+	// Interfaces to dependencies.
+	store             store
+	deployStore       deployedEnvironmentLister
+	targetStore       store
+	targetDeployStore deployedEnvironmentLister
+	targetIdentity    identityService
+	targetCFN         appDeployer
+	ws                wsAppUpdater // Nil if the command isn't run in a workspace.
+	prompt            prompter
+	sel               appSelector
+	newSecretLister   func(env *config.Environment) (secretLister, error)
+
+	// Cached variables.
+	app            *config.Application
+	targetAccount  string
+	remainingSteps []string
+}
+
+func newMigrateAppOpts(vars migrateAppVars) (*migrateAppOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app migrate"))
+	defaultSession, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSession), awsssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+
+	targetSession := defaultSession
+	if vars.profile != "" {
+		targetSession, err = sessProvider.FromProfile(vars.profile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	targetIdentity := identity.New(targetSession)
+	targetStore := config.NewSSMStore(targetIdentity, awsssm.New(targetSession), aws.StringValue(targetSession.Config.Region))
+	targetDeployStore, err := deploy.NewStore(sessProvider, targetStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+
+	prompter := prompt.New()
+	opts := &migrateAppOpts{
+		migrateAppVars: vars,
+
+		store:             store,
+		deployStore:       deployStore,
+		targetStore:       targetStore,
+		targetDeployStore: targetDeployStore,
+		targetIdentity:    targetIdentity,
+		targetCFN:         cloudformation.New(targetSession, cloudformation.WithProgressTracker(os.Stderr)),
+		prompt:            prompter,
+		sel:               selector.NewAppEnvSelector(prompter, store),
+		newSecretLister: func(env *config.Environment) (secretLister, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return ssm.New(sess), nil
+		},
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err == nil {
+		opts.ws = ws
+	} else {
+		var errNoWorkspace *workspace.ErrWorkspaceNotFound
+		if !errors.As(err, &errNoWorkspace) {
+			return nil, err
+		}
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *migrateAppOpts) Validate() error {
+	if o.newName == "" {
+		return nil
+	}
+	return validateAppNameString(o.newName)
+}
+
+// Ask prompts for and validates any required flags.
+func (o *migrateAppOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Application(appMigrateNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select application name: %w", err)
+		}
+		o.name = name
+	}
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	o.app = app
+	if o.newName == "" {
+		o.newName = o.name
+	}
+
+	caller, err := o.targetIdentity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity of the destination account: %w", err)
+	}
+	o.targetAccount = caller.Account
+	if o.newName == o.name && o.targetAccount == app.AccountID {
+		return fmt.Errorf("application %s is already in account %s: specify a new name with --%s or another account with --%s",
+			o.name, o.targetAccount, newNameFlag, profileFlag)
+	}
+	if app.Domain != "" && o.targetAccount != app.AccountID {
+		return fmt.Errorf("application %s with domain %s cannot be moved to another account: the hosted zone of the domain is in account %s",
+			o.name, app.Domain, app.AccountID)
+	}
+
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtAppMigrateConfirmPrompt, o.name, o.newName, o.targetAccount),
+		appMigrateConfirmHelp,
+		prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("app migrate confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errAppMigrateCancelled
+	}
+	return nil
+}
+
+// Execute migrates the application. Each step is skipped if it was already completed by a previous run,
+// so that the command can be run again to resume the migration:
+//  1. Create the application with its new name in the destination account.
+//  2. Add the services and jobs of the application to it.
+//  3. Associate the workspace with the new application.
+//
+// Environments and workloads can't be moved in place, as their stacks and roles are named after the application.
+// Creating them in the new application and deleting them from the old one are left to the user.
+func (o *migrateAppOpts) Execute() error {
+	if err := o.createTargetApp(); err != nil {
+		return err
+	}
+	if err := o.copyWorkloads(); err != nil {
+		return err
+	}
+	if err := o.updateWorkspace(); err != nil {
+		return err
+	}
+	steps, err := o.environmentSteps()
+	if err != nil {
+		return err
+	}
+	if len(steps) > 0 {
+		o.remainingSteps = steps
+		log.Infoln()
+		log.Infof("Environments of application %s are not migrated yet.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	log.Infoln()
+	log.Successf("Migrated application %s to %s in account %s.\n", o.name, o.newName, o.targetAccount)
+	return nil
+}
+
+func (o *migrateAppOpts) createTargetApp() error {
+	_, err := o.targetStore.GetApplication(o.newName)
+	if err == nil {
+		// Resuming a previous migration.
+		return nil
+	}
+	var errNoSuchApp *config.ErrNoSuchApplication
+	if !errors.As(err, &errNoSuchApp) {
+		return fmt.Errorf("get application %s: %w", o.newName, err)
+	}
+	if err := o.targetCFN.DeployApp(&deploy.CreateAppInput{
+		Name:                o.newName,
+		AccountID:           o.targetAccount,
+		DomainName:          o.app.Domain,
+		DomainHostedZoneID:  o.app.DomainHostedZoneID,
+		PermissionsBoundary: o.app.PermissionsBoundary,
+		AdditionalTags:      o.app.Tags,
+		AppRegistry:         o.app.AppRegistry,
+		StackMetadata:       o.app.StackMetadata,
+		Version:             version.LatestTemplateVersion(),
+	}); err != nil {
+		return fmt.Errorf("deploy application %s: %w", o.newName, err)
+	}
+	if err := o.targetStore.CreateApplication(&config.Application{
+		AccountID:           o.targetAccount,
+		Name:                o.newName,
+		Domain:              o.app.Domain,
+		DomainHostedZoneID:  o.app.DomainHostedZoneID,
+		PermissionsBoundary: o.app.PermissionsBoundary,
+		Tags:                o.app.Tags,
+		AppRegistry:         o.app.AppRegistry,
+		StackMetadata:       o.app.StackMetadata,
+	}); err != nil {
+		return fmt.Errorf("save application %s: %w", o.newName, err)
+	}
+	log.Successf("Created application %s in account %s.\n", o.newName, o.targetAccount)
+	return nil
+}
+
+func (o *migrateAppOpts) copyWorkloads() error {
+	wklds, err := o.store.ListWorkloads(o.name)
+	if err != nil {
+		return fmt.Errorf("list workloads in application %s: %w", o.name, err)
+	}
+	if len(wklds) == 0 {
+		return nil
+	}
+	existing, err := o.targetStore.ListWorkloads(o.newName)
+	if err != nil {
+		return fmt.Errorf("list workloads in application %s: %w", o.newName, err)
+	}
+	var existingNames []string
+	for _, wkld := range existing {
+		existingNames = append(existingNames, wkld.Name)
+	}
+	app, err := o.targetStore.GetApplication(o.newName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.newName, err)
+	}
+	for _, wkld := range wklds {
+		if contains(wkld.Name, existingNames) {
+			continue
+		}
+		var addOpts []cloudformation.AddWorkloadToAppOpt
+		if wkld.Type == manifestinfo.StaticSiteType {
+			addOpts = append(addOpts, cloudformation.AddWorkloadToAppOptWithoutECR)
+		}
+		copied := &config.Workload{
+			App:  o.newName,
+			Name: wkld.Name,
+			Type: wkld.Type,
+		}
+		if manifestinfo.IsTypeAJob(wkld.Type) {
+			if err := o.targetCFN.AddJobToApp(app, wkld.Name, addOpts...); err != nil {
+				return fmt.Errorf("add job %s to application %s: %w", wkld.Name, o.newName, err)
+			}
+			if err := o.targetStore.CreateJob(copied); err != nil {
+				return fmt.Errorf("save job %s: %w", wkld.Name, err)
+			}
+		} else {
+			if err := o.targetCFN.AddServiceToApp(app, wkld.Name, addOpts...); err != nil {
+				return fmt.Errorf("add service %s to application %s: %w", wkld.Name, o.newName, err)
+			}
+			if err := o.targetStore.CreateService(copied); err != nil {
+				return fmt.Errorf("save service %s: %w", wkld.Name, err)
+			}
+		}
+		log.Successf("Added %s %s to application %s.\n", strings.ToLower(wkld.Type), wkld.Name, o.newName)
+	}
+	return nil
+}
+
+func (o *migrateAppOpts) updateWorkspace() error {
+	if o.ws == nil || o.newName == o.name {
+		return nil
+	}
+	summary, err := o.ws.Summary()
+	if err != nil {
+		var errNoAppAssociated *workspace.ErrNoAssociatedApplication
+		if errors.As(err, &errNoAppAssociated) {
+			return nil
+		}
+		return fmt.Errorf("get workspace summary: %w", err)
+	}
+	if summary.Application != o.name {
+		return nil
+	}
+	if err := o.ws.UpdateApplication(o.newName); err != nil {
+		return fmt.Errorf("associate the workspace with application %s: %w", o.newName, err)
+	}
+	log.Successf("Associated the workspace with application %s.\n", o.newName)
+	return nil
+}
+
+// environmentSteps returns the commands left to move the environments of the application and
+// the workloads deployed in them to the new application.
+func (o *migrateAppOpts) environmentSteps() ([]string, error) {
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.name, err)
+	}
+	var steps []string
+	for _, env := range envs {
+		envSteps, err := o.stepsForEnv(env)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, envSteps...)
+	}
+	if len(steps) == 0 {
+		return nil, nil
+	}
+	cmd := fmt.Sprintf("copilot app migrate --name %s --new-name %s", o.name, o.newName)
+	if o.profile != "" {
+		cmd += fmt.Sprintf(" --profile %s", o.profile)
+	}
+	return append(steps, fmt.Sprintf("Run %s again to finish the migration.", color.HighlightCode(cmd))), nil
+}
+
+func (o *migrateAppOpts) stepsForEnv(env *config.Environment) ([]string, error) {
+	svcs, err := o.deployStore.ListDeployedServices(o.name, env.Name)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed in environment %s: %w", env.Name, err)
+	}
+	jobs, err := o.deployStore.ListDeployedJobs(o.name, env.Name)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs deployed in environment %s: %w", env.Name, err)
+	}
+
+	var steps []string
+	_, err = o.targetStore.GetEnvironment(o.newName, env.Name)
+	if err == nil {
+		for _, wkld := range append(svcs, jobs...) {
+			deployed, err := o.targetDeployStore.IsServiceDeployed(o.newName, env.Name, wkld)
+			if err != nil {
+				return nil, fmt.Errorf("check if %s is deployed in environment %s: %w", wkld, env.Name, err)
+			}
+			if !deployed {
+				steps = append(steps, o.deployStep(env.Name, wkld, contains(wkld, jobs)))
+			}
+		}
+		return steps, nil
+	}
+	var errNoSuchEnv *config.ErrNoSuchEnvironment
+	if !errors.As(err, &errNoSuchEnv) {
+		return nil, fmt.Errorf("get environment %s in application %s: %w", env.Name, o.newName, err)
+	}
+
+	if o.newName == o.name {
+		// The stacks of the environment are named after the application, so the environment must be
+		// deleted from the source account before it can be created in the destination account.
+		for _, svc := range svcs {
+			steps = append(steps, fmt.Sprintf("With the credentials of account %s, run %s.", o.app.AccountID,
+				color.HighlightCode(fmt.Sprintf("copilot svc delete --app %s --name %s --env %s --yes", o.name, svc, env.Name))))
+		}
+		for _, job := range jobs {
+			steps = append(steps, fmt.Sprintf("With the credentials of account %s, run %s.", o.app.AccountID,
+				color.HighlightCode(fmt.Sprintf("copilot job delete --app %s --name %s --env %s --yes", o.name, job, env.Name))))
+		}
+		steps = append(steps, fmt.Sprintf("With the credentials of account %s, run %s.", o.app.AccountID,
+			color.HighlightCode(fmt.Sprintf("copilot env delete --app %s --name %s --yes", o.name, env.Name))))
+	}
+	steps = append(steps,
+		fmt.Sprintf("Run %s to create the environment in application %s.", color.HighlightCode(
+			fmt.Sprintf("copilot env init --app %s --name %s --region %s", o.newName, env.Name, env.Region)), o.newName),
+		fmt.Sprintf("Run %s to deploy the environment.", color.HighlightCode(
+			fmt.Sprintf("copilot env deploy --app %s --name %s", o.newName, env.Name))))
+	secretSteps, err := o.secretSteps(env)
+	if err != nil {
+		return nil, err
+	}
+	steps = append(steps, secretSteps...)
+	for _, svc := range svcs {
+		steps = append(steps, o.deployStep(env.Name, svc, false))
+	}
+	for _, job := range jobs {
+		steps = append(steps, o.deployStep(env.Name, job, true))
+	}
+	return steps, nil
+}
+
+// secretSteps returns the commands to recreate the secrets of the environment in the new application,
+// as the workloads read them from SSM parameters named after the application. The values of the secrets aren't read.
+func (o *migrateAppOpts) secretSteps(env *config.Environment) ([]string, error) {
+	lister, err := o.newSecretLister(env)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf(fmtSecretParameterPath, o.name, env.Name)
+	secrets, err := lister.ListSecrets(path)
+	if err != nil {
+		return nil, fmt.Errorf("list secrets of environment %s: %w", env.Name, err)
+	}
+	steps := make([]string, len(secrets))
+	for i, secret := range secrets {
+		name := strings.TrimPrefix(secret.Name, path)
+		steps[i] = fmt.Sprintf("Run %s to recreate secret %s of environment %s in application %s.", color.HighlightCode(
+			fmt.Sprintf("copilot secret init --app %s --name %s", o.newName, name)), name, env.Name, o.newName)
+	}
+	return steps, nil
+}
+
+func (o *migrateAppOpts) deployStep(env, wkld string, isJob bool) string {
+	cmd := "svc"
+	if isJob {
+		cmd = "job"
+	}
+	return fmt.Sprintf("Run %s to deploy %s in application %s.", color.HighlightCode(
+		fmt.Sprintf("copilot %s deploy --app %s --name %s --env %s", cmd, o.newName, wkld, env)), wkld, o.newName)
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *migrateAppOpts) RecommendActions() error {
+	if len(o.remainingSteps) > 0 {
+		logRecommendedActions(o.remainingSteps)
+		return nil
+	}
+	var actions []string
+	if o.newName != o.name {
+		actions = append(actions, fmt.Sprintf("With the credentials of account %s, run %s to delete the old application.",
+			o.app.AccountID, color.HighlightCode(fmt.Sprintf("copilot app delete --name %s", o.name))))
+	}
+	actions = append(actions, fmt.Sprintf("Run %s to recreate the pipelines of the application if there are any.",
+		color.HighlightCode(fmt.Sprintf("copilot pipeline deploy --app %s", o.newName))))
+	logRecommendedActions(actions)
+	return nil
+}
+
+// buildAppMigrateCmd builds the command to migrate an application to a new name or account.
+func buildAppMigrateCmd() *cobra.Command {
+	vars := migrateAppVars{}
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Move an application to a new name or account.",
+		Long: `Move an application to a new name or account.
+The application, its services and jobs are created under the new name or account.
+The command then lists the steps left to recreate the environments and redeploy the workloads.
+Run the command again after the steps to finish the migration.`,
+		Example: `
+  Rename the application "my-app" to "shop".
+  /code $ copilot app migrate --name my-app --new-name shop
+  Move the application "my-app" to the account of the "prod-account" profile.
+  /code $ copilot app migrate --name my-app --profile prod-account`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newMigrateAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.newName, newNameFlag, "", appMigrateNewNameFlagDescription)
+	cmd.Flags().StringVar(&vars.profile, profileFlag, "", appMigrateProfileFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,344 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type migrateAppMocks struct {
+	store             *mocks.Mockstore
+	deployStore       *mocks.MockdeployedEnvironmentLister
+	targetStore       *mocks.Mockstore
+	targetDeployStore *mocks.MockdeployedEnvironmentLister
+	targetIdentity    *mocks.MockidentityService
+	targetCFN         *mocks.MockappDeployer
+	ws                *mocks.MockwsAppUpdater
+	prompt            *mocks.Mockprompter
+	sel               *mocks.MockappSelector
+	secretLister      *mocks.MocksecretLister
+}
+
+func TestMigrateAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inNewName string
+
+		wantedErr error
+	}{
+		"valid without a new name": {},
+		"valid new name": {
+			inNewName: "shop",
+		},
+		"error if the new name is invalid": {
+			inNewName: "Shop!",
+			wantedErr: fmt.Errorf("application name Shop! is invalid: %w", errBasicNameRegexNotMatched),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &migrateAppOpts{
+				migrateAppVars: migrateAppVars{
+					name:    "my-app",
+					newName: tc.inNewName,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMigrateAppOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName           string
+		inNewName        string
+		skipConfirmation bool
+		setupMocks       func(m migrateAppMocks)
+
+		wantedNewName string
+		wantedErr     error
+	}{
+		"error if fail to select the application": {
+			setupMocks: func(m migrateAppMocks) {
+				m.sel.EXPECT().Application(appMigrateNamePrompt, "").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select application name: some error"),
+		},
+		"error if neither the name nor the account changes": {
+			inName: "my-app",
+			setupMocks: func(m migrateAppMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", AccountID: "1111"}, nil)
+				m.targetIdentity.EXPECT().Get().Return(identity.Caller{Account: "1111"}, nil)
+			},
+			wantedErr: errors.New("application my-app is already in account 1111: specify a new name with --new-name or another account with --profile"),
+		},
+		"error if an application with a domain changes account": {
+			inName: "my-app",
+			setupMocks: func(m migrateAppMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", AccountID: "1111", Domain: "example.com"}, nil)
+				m.targetIdentity.EXPECT().Get().Return(identity.Caller{Account: "2222"}, nil)
+			},
+			wantedErr: errors.New("application my-app with domain example.com cannot be moved to another account: the hosted zone of the domain is in account 1111"),
+		},
+		"error if the migration is cancelled": {
+			inName:    "my-app",
+			inNewName: "shop",
+			setupMocks: func(m migrateAppMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", AccountID: "1111"}, nil)
+				m.targetIdentity.EXPECT().Get().Return(identity.Caller{Account: "1111"}, nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtAppMigrateConfirmPrompt, "my-app", "shop", "1111"), appMigrateConfirmHelp, gomock.Any()).Return(false, nil)
+			},
+			wantedErr: errAppMigrateCancelled,
+		},
+		"select the application and keep its name in another account": {
+			skipConfirmation: true,
+			setupMocks: func(m migrateAppMocks) {
+				m.sel.EXPECT().Application(appMigrateNamePrompt, "").Return("my-app", nil)
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", AccountID: "1111"}, nil)
+				m.targetIdentity.EXPECT().Get().Return(identity.Caller{Account: "2222"}, nil)
+			},
+			wantedNewName: "my-app",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := migrateAppMocks{
+				store:          mocks.NewMockstore(ctrl),
+				targetIdentity: mocks.NewMockidentityService(ctrl),
+				prompt:         mocks.NewMockprompter(ctrl),
+				sel:            mocks.NewMockappSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &migrateAppOpts{
+				migrateAppVars: migrateAppVars{
+					name:             tc.inName,
+					newName:          tc.inNewName,
+					skipConfirmation: tc.skipConfirmation,
+				},
+				store:          m.store,
+				targetIdentity: m.targetIdentity,
+				prompt:         m.prompt,
+				sel:            m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNewName, opts.newName)
+		})
+	}
+}
+
+func TestMigrateAppOpts_Execute(t *testing.T) {
+	oldApp := &config.Application{Name: "my-app", AccountID: "1111", PermissionsBoundary: "boundary", Tags: map[string]string{"owner": "me"}}
+	newApp := &config.Application{Name: "shop", AccountID: "1111", PermissionsBoundary: "boundary", Tags: map[string]string{"owner": "me"}}
+	testEnv := &config.Environment{App: "my-app", Name: "test", Region: "us-west-2"}
+	testCases := map[string]struct {
+		inNewName  string
+		setupMocks func(m migrateAppMocks)
+
+		wantedSteps []string
+		wantedErr   error
+	}{
+		"error if fail to deploy the new application": {
+			inNewName: "shop",
+			setupMocks: func(m migrateAppMocks) {
+				m.targetStore.EXPECT().GetApplication("shop").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "shop"})
+				m.targetCFN.EXPECT().DeployApp(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("deploy application shop: some error"),
+		},
+		"create the application and its workloads, and list the steps to move the environments": {
+			inNewName: "shop",
+			setupMocks: func(m migrateAppMocks) {
+				m.targetStore.EXPECT().GetApplication("shop").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "shop"})
+				m.targetCFN.EXPECT().DeployApp(gomock.Any()).DoAndReturn(func(in *deploy.CreateAppInput) error {
+					require.Equal(t, "shop", in.Name)
+					require.Equal(t, "1111", in.AccountID)
+					require.Equal(t, "boundary", in.PermissionsBoundary)
+					require.Equal(t, map[string]string{"owner": "me"}, in.AdditionalTags)
+					return nil
+				})
+				m.targetStore.EXPECT().CreateApplication(newApp).Return(nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return([]*config.Workload{
+					{App: "my-app", Name: "api", Type: manifestinfo.BackendServiceType},
+					{App: "my-app", Name: "site", Type: manifestinfo.StaticSiteType},
+					{App: "my-app", Name: "report", Type: manifestinfo.ScheduledJobType},
+				}, nil)
+				m.targetStore.EXPECT().ListWorkloads("shop").Return([]*config.Workload{
+					{App: "shop", Name: "api", Type: manifestinfo.BackendServiceType},
+				}, nil)
+				m.targetStore.EXPECT().GetApplication("shop").Return(newApp, nil)
+				m.targetCFN.EXPECT().AddServiceToApp(newApp, "site", gomock.Len(1)).Return(nil)
+				m.targetStore.EXPECT().CreateService(&config.Workload{App: "shop", Name: "site", Type: manifestinfo.StaticSiteType}).Return(nil)
+				m.targetCFN.EXPECT().AddJobToApp(newApp, "report").Return(nil)
+				m.targetStore.EXPECT().CreateJob(&config.Workload{App: "shop", Name: "report", Type: manifestinfo.ScheduledJobType}).Return(nil)
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "my-app"}, nil)
+				m.ws.EXPECT().UpdateApplication("shop").Return(nil)
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{testEnv}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"api"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("my-app", "test").Return([]string{"report"}, nil)
+				m.targetStore.EXPECT().GetEnvironment("shop", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "shop", EnvironmentName: "test"})
+				m.secretLister.EXPECT().ListSecrets("/copilot/my-app/test/secrets/").Return([]ssm.SecretMetadata{
+					{Name: "/copilot/my-app/test/secrets/DB_PASSWORD"},
+					{Name: "/copilot/my-app/test/secrets/GITHUB_TOKEN"},
+				}, nil)
+			},
+			wantedSteps: []string{
+				fmt.Sprintf("Run %s to create the environment in application shop.", color.HighlightCode("copilot env init --app shop --name test --region us-west-2")),
+				fmt.Sprintf("Run %s to deploy the environment.", color.HighlightCode("copilot env deploy --app shop --name test")),
+				fmt.Sprintf("Run %s to recreate secret DB_PASSWORD of environment test in application shop.", color.HighlightCode("copilot secret init --app shop --name DB_PASSWORD")),
+				fmt.Sprintf("Run %s to recreate secret GITHUB_TOKEN of environment test in application shop.", color.HighlightCode("copilot secret init --app shop --name GITHUB_TOKEN")),
+				fmt.Sprintf("Run %s to deploy api in application shop.", color.HighlightCode("copilot svc deploy --app shop --name api --env test")),
+				fmt.Sprintf("Run %s to deploy report in application shop.", color.HighlightCode("copilot job deploy --app shop --name report --env test")),
+				fmt.Sprintf("Run %s again to finish the migration.", color.HighlightCode("copilot app migrate --name my-app --new-name shop")),
+			},
+		},
+		"delete the environment from the old account first if the name doesn't change": {
+			inNewName: "my-app",
+			setupMocks: func(m migrateAppMocks) {
+				m.targetStore.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", AccountID: "2222"}, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(nil, nil)
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{testEnv}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"api"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("my-app", "test").Return(nil, nil)
+				m.targetStore.EXPECT().GetEnvironment("my-app", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "my-app", EnvironmentName: "test"})
+				m.secretLister.EXPECT().ListSecrets("/copilot/my-app/test/secrets/").Return(nil, nil)
+			},
+			wantedSteps: []string{
+				fmt.Sprintf("With the credentials of account 1111, run %s.", color.HighlightCode("copilot svc delete --app my-app --name api --env test --yes")),
+				fmt.Sprintf("With the credentials of account 1111, run %s.", color.HighlightCode("copilot env delete --app my-app --name test --yes")),
+				fmt.Sprintf("Run %s to create the environment in application my-app.", color.HighlightCode("copilot env init --app my-app --name test --region us-west-2")),
+				fmt.Sprintf("Run %s to deploy the environment.", color.HighlightCode("copilot env deploy --app my-app --name test")),
+				fmt.Sprintf("Run %s to deploy api in application my-app.", color.HighlightCode("copilot svc deploy --app my-app --name api --env test")),
+				fmt.Sprintf("Run %s again to finish the migration.", color.HighlightCode("copilot app migrate --name my-app --new-name my-app --profile prod")),
+			},
+		},
+		"error if fail to list the secrets of an environment": {
+			inNewName: "shop",
+			setupMocks: func(m migrateAppMocks) {
+				m.targetStore.EXPECT().GetApplication("shop").Return(newApp, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(nil, nil)
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "shop"}, nil)
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{testEnv}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("my-app", "test").Return(nil, nil)
+				m.targetStore.EXPECT().GetEnvironment("shop", "test").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "shop", EnvironmentName: "test"})
+				m.secretLister.EXPECT().ListSecrets("/copilot/my-app/test/secrets/").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list secrets of environment test: some error"),
+		},
+		"resume by deploying the workloads that are missing in the new environment": {
+			inNewName: "shop",
+			setupMocks: func(m migrateAppMocks) {
+				m.targetStore.EXPECT().GetApplication("shop").Return(newApp, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(nil, nil)
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "shop"}, nil)
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{testEnv}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"api", "web"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("my-app", "test").Return(nil, nil)
+				m.targetStore.EXPECT().GetEnvironment("shop", "test").Return(&config.Environment{App: "shop", Name: "test"}, nil)
+				m.targetDeployStore.EXPECT().IsServiceDeployed("shop", "test", "api").Return(true, nil)
+				m.targetDeployStore.EXPECT().IsServiceDeployed("shop", "test", "web").Return(false, nil)
+			},
+			wantedSteps: []string{
+				fmt.Sprintf("Run %s to deploy web in application shop.", color.HighlightCode("copilot svc deploy --app shop --name web --env test")),
+				fmt.Sprintf("Run %s again to finish the migration.", color.HighlightCode("copilot app migrate --name my-app --new-name shop")),
+			},
+		},
+		"complete once every workload is deployed in the new application": {
+			inNewName: "shop",
+			setupMocks: func(m migrateAppMocks) {
+				m.targetStore.EXPECT().GetApplication("shop").Return(newApp, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(nil, nil)
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "shop"}, nil)
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{testEnv}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"api"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("my-app", "test").Return(nil, nil)
+				m.targetStore.EXPECT().GetEnvironment("shop", "test").Return(&config.Environment{App: "shop", Name: "test"}, nil)
+				m.targetDeployStore.EXPECT().IsServiceDeployed("shop", "test", "api").Return(true, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := migrateAppMocks{
+				store:             mocks.NewMockstore(ctrl),
+				deployStore:       mocks.NewMockdeployedEnvironmentLister(ctrl),
+				targetStore:       mocks.NewMockstore(ctrl),
+				targetDeployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				targetCFN:         mocks.NewMockappDeployer(ctrl),
+				ws:                mocks.NewMockwsAppUpdater(ctrl),
+				secretLister:      mocks.NewMocksecretLister(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &migrateAppOpts{
+				migrateAppVars: migrateAppVars{
+					name:    "my-app",
+					newName: tc.inNewName,
+				},
+				store:             m.store,
+				deployStore:       m.deployStore,
+				targetStore:       m.targetStore,
+				targetDeployStore: m.targetDeployStore,
+				targetCFN:         m.targetCFN,
+				ws:                m.ws,
+				newSecretLister: func(_ *config.Environment) (secretLister, error) {
+					return m.secretLister, nil
+				},
+				app:           oldApp,
+				targetAccount: "1111",
+			}
+			if tc.inNewName == "my-app" {
+				opts.profile = "prod"
+				opts.targetAccount = "2222"
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSteps, opts.remainingSteps)
+		})
+	}
+}
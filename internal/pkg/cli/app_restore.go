@@ -0,0 +1,153 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type restoreAppVars struct {
+	file string
+}
+
+type restoreAppOpts struct {
+	restoreAppVars
+
+	store    store
+	identity identityService
+	fs       afero.Fs
+
+	// Cached variables.
+	backup *appBackup
+}
+
+func newRestoreAppOpts(vars restoreAppVars) (*restoreAppOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app restore")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	id := identity.New(sess)
+	return &restoreAppOpts{
+		restoreAppVars: vars,
+		store:          config.NewSSMStore(id, ssm.New(sess), aws.StringValue(sess.Config.Region)),
+		identity:       id,
+		fs:             afero.NewOsFs(),
+	}, nil
+}
+
+// Validate returns an error if the backup file is missing or invalid.
+func (o *restoreAppOpts) Validate() error {
+	if o.file == "" {
+		return fmt.Errorf("--%s must be specified", fileFlag)
+	}
+	raw, err := afero.ReadFile(o.fs, o.file)
+	if err != nil {
+		return fmt.Errorf("read backup file %s: %w", o.file, err)
+	}
+	var backup appBackup
+	if err := json.Unmarshal(raw, &backup); err != nil {
+		return fmt.Errorf("unmarshal backup file %s: %w", o.file, err)
+	}
+	if backup.Application == nil || backup.Application.Name == "" {
+		return fmt.Errorf("backup file %s does not contain an application", o.file)
+	}
+	app := backup.Application.Name
+	for _, env := range backup.Environments {
+		if env.App != app {
+			return fmt.Errorf("environment %s in backup file %s belongs to application %s instead of %s", env.Name, o.file, env.App, app)
+		}
+	}
+	for _, wkld := range append(backup.Services, backup.Jobs...) {
+		if wkld.App != app {
+			return fmt.Errorf("workload %s in backup file %s belongs to application %s instead of %s", wkld.Name, o.file, wkld.App, app)
+		}
+	}
+	o.backup = &backup
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *restoreAppOpts) Ask() error {
+	return nil
+}
+
+// Execute recreates the metadata of the application from the backup.
+// The application, environments, services and jobs that are already in the config store are left unchanged.
+func (o *restoreAppOpts) Execute() error {
+	caller, err := o.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+	app := o.backup.Application
+	if app.AccountID != caller.Account {
+		return fmt.Errorf("application %s in backup file %s belongs to account %s, but the credentials are for account %s",
+			app.Name, o.file, app.AccountID, caller.Account)
+	}
+	if _, err := o.store.GetApplication(app.Name); err != nil {
+		var errNoSuchApp *config.ErrNoSuchApplication
+		if !errors.As(err, &errNoSuchApp) {
+			return fmt.Errorf("get application %s: %w", app.Name, err)
+		}
+		if err := o.store.CreateApplication(app); err != nil {
+			return fmt.Errorf("restore application %s: %w", app.Name, err)
+		}
+	}
+	for _, env := range o.backup.Environments {
+		if err := o.store.CreateEnvironment(env); err != nil {
+			return fmt.Errorf("restore environment %s: %w", env.Name, err)
+		}
+	}
+	for _, svc := range o.backup.Services {
+		if err := o.store.CreateService(svc); err != nil {
+			return fmt.Errorf("restore service %s: %w", svc.Name, err)
+		}
+	}
+	for _, job := range o.backup.Jobs {
+		if err := o.store.CreateJob(job); err != nil {
+			return fmt.Errorf("restore job %s: %w", job.Name, err)
+		}
+	}
+	log.Successf("Restored application %s with %s, %s and %s.\n", color.HighlightUserInput(app.Name),
+		english.Plural(len(o.backup.Environments), "environment", ""),
+		english.Plural(len(o.backup.Services), "service", ""),
+		english.Plural(len(o.backup.Jobs), "job", ""))
+	return nil
+}
+
+// buildAppRestoreCmd builds the command to restore the metadata of an application.
+func buildAppRestoreCmd() *cobra.Command {
+	vars := restoreAppVars{}
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore the metadata of an application from a backup.",
+		Long: `Restore the metadata of an application from a backup.
+Recreates the application, environments, services and jobs
+exported by "copilot app export" that are missing in your account.`,
+		Example: `
+  Restore the metadata of an application from a backup.
+  /code $ copilot app restore --file my-app-backup.json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRestoreAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVar(&vars.file, fileFlag, "", appRestoreFileFlagDescription)
+	return cmd
+}
@@ -0,0 +1,173 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inFile  string
+		content string
+
+		wantedErr string
+	}{
+		"error if the file is not specified": {
+			wantedErr: "--file must be specified",
+		},
+		"error if the file does not exist": {
+			inFile:    "missing.json",
+			wantedErr: "read backup file missing.json: open missing.json: file does not exist",
+		},
+		"error if the file is not valid JSON": {
+			inFile:    "backup.json",
+			content:   "not json",
+			wantedErr: "unmarshal backup file backup.json: invalid character 'o' in literal null (expecting 'u')",
+		},
+		"error if the file does not contain an application": {
+			inFile:    "backup.json",
+			content:   `{"environments": []}`,
+			wantedErr: "backup file backup.json does not contain an application",
+		},
+		"error if an environment belongs to another application": {
+			inFile:    "backup.json",
+			content:   `{"application": {"name": "my-app"}, "environments": [{"app": "other", "name": "test"}]}`,
+			wantedErr: "environment test in backup file backup.json belongs to application other instead of my-app",
+		},
+		"error if a job belongs to another application": {
+			inFile:    "backup.json",
+			content:   `{"application": {"name": "my-app"}, "jobs": [{"app": "other", "name": "report"}]}`,
+			wantedErr: "workload report in backup file backup.json belongs to application other instead of my-app",
+		},
+		"valid backup": {
+			inFile:  "backup.json",
+			content: `{"application": {"name": "my-app"}, "services": [{"app": "my-app", "name": "api"}]}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if tc.content != "" {
+				require.NoError(t, afero.WriteFile(fs, tc.inFile, []byte(tc.content), 0644))
+			}
+			opts := &restoreAppOpts{
+				restoreAppVars: restoreAppVars{
+					file: tc.inFile,
+				},
+				fs: fs,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "my-app", opts.backup.Application.Name)
+		})
+	}
+}
+
+func TestRestoreAppOpts_Execute(t *testing.T) {
+	backup := func() *appBackup {
+		return &appBackup{
+			Application: &config.Application{Name: "my-app", AccountID: "123456789012"},
+			Environments: []*config.Environment{
+				{App: "my-app", Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+			},
+			Services: []*config.Workload{
+				{App: "my-app", Name: "api", Type: "Load Balanced Web Service"},
+			},
+			Jobs: []*config.Workload{
+				{App: "my-app", Name: "report", Type: "Scheduled Job"},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(s *mocks.Mockstore, id *mocks.MockidentityService)
+
+		wantedErr error
+	}{
+		"error if the credentials are for another account": {
+			setupMocks: func(s *mocks.Mockstore, id *mocks.MockidentityService) {
+				id.EXPECT().Get().Return(identity.Caller{Account: "210987654321"}, nil)
+			},
+			wantedErr: errors.New("application my-app in backup file backup.json belongs to account 123456789012, but the credentials are for account 210987654321"),
+		},
+		"error if fail to get the application": {
+			setupMocks: func(s *mocks.Mockstore, id *mocks.MockidentityService) {
+				id.EXPECT().Get().Return(identity.Caller{Account: "123456789012"}, nil)
+				s.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application my-app: some error"),
+		},
+		"error if fail to restore an environment": {
+			setupMocks: func(s *mocks.Mockstore, id *mocks.MockidentityService) {
+				id.EXPECT().Get().Return(identity.Caller{Account: "123456789012"}, nil)
+				s.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				s.EXPECT().CreateEnvironment(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("restore environment test: some error"),
+		},
+		"create the application if it does not exist": {
+			setupMocks: func(s *mocks.Mockstore, id *mocks.MockidentityService) {
+				id.EXPECT().Get().Return(identity.Caller{Account: "123456789012"}, nil)
+				gomock.InOrder(
+					s.EXPECT().GetApplication("my-app").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "my-app"}),
+					s.EXPECT().CreateApplication(&config.Application{Name: "my-app", AccountID: "123456789012"}).Return(nil),
+					s.EXPECT().CreateEnvironment(&config.Environment{App: "my-app", Name: "test", AccountID: "123456789012", Region: "us-west-2"}).Return(nil),
+					s.EXPECT().CreateService(&config.Workload{App: "my-app", Name: "api", Type: "Load Balanced Web Service"}).Return(nil),
+					s.EXPECT().CreateJob(&config.Workload{App: "my-app", Name: "report", Type: "Scheduled Job"}).Return(nil),
+				)
+			},
+		},
+		"keep the existing application": {
+			setupMocks: func(s *mocks.Mockstore, id *mocks.MockidentityService) {
+				id.EXPECT().Get().Return(identity.Caller{Account: "123456789012"}, nil)
+				s.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				s.EXPECT().CreateApplication(gomock.Any()).Times(0)
+				s.EXPECT().CreateEnvironment(gomock.Any()).Return(nil)
+				s.EXPECT().CreateService(gomock.Any()).Return(nil)
+				s.EXPECT().CreateJob(gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			s := mocks.NewMockstore(ctrl)
+			id := mocks.NewMockidentityService(ctrl)
+			tc.setupMocks(s, id)
+			opts := &restoreAppOpts{
+				restoreAppVars: restoreAppVars{
+					file: "backup.json",
+				},
+				store:    s,
+				identity: id,
+				backup:   backup(),
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
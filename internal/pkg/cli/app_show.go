@@ -39,6 +39,8 @@ const (
 type showAppVars struct {
 	name             string
 	shouldOutputJSON bool
+	outputFormat     outputFormatVars
+	showImages       bool
 }
 
 type showAppOpts struct {
@@ -53,8 +55,7 @@ type showAppOpts struct {
 	newVersionGetter func(string) (versionGetter, error)
 }
 
-func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app show"))
+func newShowAppOpts(vars showAppVars, sessProvider *sessions.Provider) (*showAppOpts, error) {
 	defaultSession, err := sessProvider.Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %w", err)
@@ -84,6 +85,9 @@ func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *showAppOpts) Validate() error {
+	if err := o.outputFormat.validate(); err != nil {
+		return err
+	}
 	if o.name != "" {
 		_, err := o.store.GetApplication(o.name)
 		if err != nil {
@@ -103,22 +107,30 @@ func (o *showAppOpts) Ask() error {
 	return nil
 }
 
-// Execute writes the application's description.
+// Execute writes the application's description, or the helper images of the application.
 func (o *showAppOpts) Execute() error {
-	description, err := o.description()
+	var description describe.HumanJSONStringer
+	var err error
+	if o.showImages {
+		description, err = o.helperImages()
+	} else {
+		description, err = o.description()
+	}
 	if err != nil {
 		return err
 	}
-	if !o.shouldOutputJSON {
+	if !o.shouldOutputJSON && !o.outputFormat.isSet() {
 		fmt.Fprint(o.w, description.HumanString())
 		return nil
 	}
-	data, err := description.JSONString()
-	if err != nil {
-		return fmt.Errorf("get JSON string: %w", err)
-	}
-	fmt.Fprint(o.w, data)
-	return nil
+	return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+		data, err := description.JSONString()
+		if err != nil {
+			return fmt.Errorf("get JSON string: %w", err)
+		}
+		fmt.Fprint(w, data)
+		return nil
+	})
 }
 func (o *showAppOpts) populateDeployedWorkloads(listWorkloads func(app, env string) ([]string, error), deployedEnvsFor map[string][]string, env string, lock sync.Locker) error {
 	deployedworkload, err := listWorkloads(o.name, env)
@@ -229,6 +241,15 @@ func (o *showAppOpts) description() (*describe.App, error) {
 	}, nil
 }
 
+// helperImages returns the images that Copilot injects into the tasks of the workloads of the application.
+func (o *showAppOpts) helperImages() (*describe.HelperImages, error) {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	return describe.NewHelperImages(app), nil
+}
+
 func (o *showAppOpts) askName() error {
 	if o.name != "" {
 		return nil
@@ -250,9 +271,11 @@ func buildAppShowCmd() *cobra.Command {
 		Long:  "Shows configuration, environments and services for an application.",
 		Example: `
   Shows info about the application "my-app"
-  /code $ copilot app show -n my-app`,
+  /code $ copilot app show -n my-app
+  Shows the sidecar and helper images that Copilot injects into the workloads of "my-app"
+  /code $ copilot app show -n my-app --images`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newShowAppOpts(vars)
+			opts, err := newShowAppOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("app show")))
 			if err != nil {
 				return err
 			}
@@ -261,6 +284,9 @@ func buildAppShowCmd() *cobra.Command {
 	}
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.format, formatFlag, "", formatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.query, queryFlag, "", queryFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.showImages, imagesFlag, false, appShowImagesFlagDescription)
 	return cmd
 }
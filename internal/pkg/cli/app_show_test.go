@@ -178,12 +178,36 @@ func TestShowAppOpts_Execute(t *testing.T) {
 	testError := errors.New("some error")
 	testCases := map[string]struct {
 		shouldOutputJSON bool
+		showImages       bool
 
 		setupMocks func(mocks showAppMocks)
 
 		wantedContent string
 		wantedError   error
 	}{
+		"shows the helper images of the application in json": {
+			shouldOutputJSON: true,
+			showImages:       true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name: "my-app",
+					HelperImages: map[string]string{
+						"envoy": "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/envoy:v1.27.2",
+					},
+				}, nil)
+			},
+			wantedContent: `{"application":"my-app","images":[{"name":"fluent-bit","image":"public.ecr.aws/aws-observability/aws-for-fluent-bit:stable","source":"default","injectedBy":"logging"},{"name":"aws-otel-collector","image":"public.ecr.aws/aws-observability/aws-otel-collector:v0.17.0","source":"default","injectedBy":"observability.tracing"},{"name":"envoy","image":"123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/envoy:v1.27.2","source":"application","injectedBy":"http.mirror"},{"name":"pause","image":"public.ecr.aws/amazonlinux/amazonlinux:2023","source":"default","injectedBy":"copilot run local"}]}
+`,
+		},
+		"error if fail to get the application of the helper images": {
+			showImages: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("get application my-app: some error"),
+		},
 		"correctly shows json output": {
 			shouldOutputJSON: true,
 
@@ -841,6 +865,7 @@ Pipelines
 			opts := &showAppOpts{
 				showAppVars: showAppVars{
 					shouldOutputJSON: tc.shouldOutputJSON,
+					showImages:       tc.showImages,
 					name:             mockAppName,
 				},
 				store:          mockStoreReader,
@@ -5,20 +5,27 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/patch"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/version"
@@ -33,11 +40,16 @@ const (
 
 	appUpgradeNamePrompt     = "Which application would you like to upgrade?"
 	appUpgradeNameHelpPrompt = "An application is a collection of related services."
+
+	stackSetDriftStatusDrifted = "DRIFTED"
 )
 
 // appUpgradeVars holds flag values.
 type appUpgradeVars struct {
-	name string
+	name                string
+	dryRun              bool
+	retryFailed         bool
+	customResourcesOnly bool
 }
 
 // appUpgradeOpts represents the app upgrade command and holds the necessary data
@@ -51,13 +63,16 @@ type appUpgradeOpts struct {
 	identity identityService
 	upgrader appUpgrader
 
-	newVersionGetter func(string) (versionGetter, error)
+	newVersionGetter           func(string) (versionGetter, error)
+	newCustomResourcesUpgrader func(*config.Environment) (customResourcesUpgrader, error)
+	w                          io.Writer
 
 	templateVersion string // Overridden in tests.
 }
 
 func newAppUpgradeOpts(vars appUpgradeVars) (*appUpgradeOpts, error) {
-	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app upgrade")).Default()
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app upgrade"))
+	sess, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +91,18 @@ func newAppUpgradeOpts(vars appUpgradeVars) (*appUpgradeOpts, error) {
 			}
 			return d, nil
 		},
+		newCustomResourcesUpgrader: func(env *config.Environment) (customResourcesUpgrader, error) {
+			envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("get session for environment %s: %w", env.Name, err)
+			}
+			return &patch.EnvironmentPatcher{
+				Prog:            termprogress.NewSpinner(log.DiagnosticWriter),
+				Env:             env,
+				TemplatePatcher: cloudformation.New(envSess, cloudformation.WithProgressTracker(os.Stderr)),
+			}, nil
+		},
+		w:               log.OutputWriter,
 		templateVersion: version.LatestTemplateVersion(),
 	}, nil
 }
@@ -102,6 +129,12 @@ func (o *appUpgradeOpts) Ask() error {
 // Execute updates the cloudformation stack as well as the stackset of an application to the latest version.
 // If any stack is busy updating, it spins and waits until the stack can be updated.
 func (o *appUpgradeOpts) Execute() error {
+	if o.retryFailed {
+		return o.retryFailedStackSetInstances()
+	}
+	if o.customResourcesOnly {
+		return o.upgradeCustomResources()
+	}
 	vg, err := o.newVersionGetter(o.name)
 	if err != nil {
 		return err
@@ -111,6 +144,9 @@ func (o *appUpgradeOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get template version of application %s: %v", o.name, err)
 	}
+	if o.dryRun {
+		return o.previewUpgrade(appVersion)
+	}
 	if !o.shouldUpgradeApp(appVersion) {
 		return nil
 	}
@@ -133,6 +169,107 @@ func (o *appUpgradeOpts) Execute() error {
 	return nil
 }
 
+func (o *appUpgradeOpts) previewUpgrade(appVersion string) error {
+	if semver.Compare(appVersion, o.templateVersion) >= 0 {
+		fmt.Fprintf(o.w, "Application %s is on version %s, no changes would be made to upgrade it to version %s.\n", o.name, appVersion, o.templateVersion)
+		return nil
+	}
+	if err := o.upgrader.DetectAppStackSetDrift(o.name); err != nil {
+		return fmt.Errorf("detect drift of application %s: %w", o.name, err)
+	}
+	instances, err := o.upgrader.AppStackSetInstances(o.name)
+	if err != nil {
+		return err
+	}
+	conf := stack.NewAppStackConfig(&deploy.CreateAppInput{Name: o.name})
+	fmt.Fprintf(o.w, "Upgrading application %s from version %s to version %s would update:\n", o.name, appVersion, o.templateVersion)
+	fmt.Fprintf(o.w, "  - Stack %s\n", conf.StackName())
+	fmt.Fprintf(o.w, "  - %d instances of stack set %s\n\n", len(instances), conf.StackSetName())
+	if len(instances) == 0 {
+		return nil
+	}
+	writeStackSetInstances(o.w, instances)
+
+	var drifted, failed, inoperable int
+	for _, instance := range instances {
+		if instance.DriftStatus == stackSetDriftStatusDrifted {
+			drifted++
+		}
+		switch {
+		case instance.Status.IsInoperable():
+			inoperable++
+		case instance.Status.IsFailure():
+			failed++
+		}
+	}
+	if drifted > 0 {
+		log.Warningf("%d stack instances have drifted, the upgrade overwrites any change made to their resources outside of CloudFormation.\n", drifted)
+	}
+	if failed > 0 {
+		log.Warningf("%d stack instances failed their last update, run %s to redeploy them before upgrading.\n",
+			failed, color.HighlightCode(fmt.Sprintf("copilot app upgrade -n %s --%s", o.name, retryFailedFlag)))
+	}
+	if inoperable > 0 {
+		log.Warningf("%d stack instances are inoperable and would fail the upgrade, delete them from the stack set while retaining their stacks first.\n", inoperable)
+	}
+	return nil
+}
+
+func (o *appUpgradeOpts) retryFailedStackSetInstances() error {
+	retried, err := o.upgrader.RetryFailedAppStackSetInstances(o.name)
+	if err != nil {
+		return fmt.Errorf("retry failed stack set instances of application %s: %w", o.name, err)
+	}
+	if len(retried) == 0 {
+		log.Infof("No failed stack set instances to retry for application %s.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	log.Successf("Redeployed %d failed stack set instances of application %s.\n", len(retried), color.HighlightUserInput(o.name))
+	return nil
+}
+
+func (o *appUpgradeOpts) upgradeCustomResources() error {
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return fmt.Errorf("list environments of application %s: %w", o.name, err)
+	}
+	var upgraded int
+	for _, env := range envs {
+		upgrader, err := o.newCustomResourcesUpgrader(env)
+		if err != nil {
+			return err
+		}
+		ok, err := upgrader.UpgradeCustomResourceRuntimes()
+		if err != nil {
+			return fmt.Errorf("upgrade custom resources of environment %s: %w", env.Name, err)
+		}
+		if ok {
+			upgraded++
+		}
+	}
+	if upgraded == 0 {
+		log.Infof("The custom resources of application %s are already on a supported runtime.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	log.Successf("Upgraded the custom resources of %d environments of application %s.\n", upgraded, color.HighlightUserInput(o.name))
+	return nil
+}
+
+func writeStackSetInstances(w io.Writer, instances []stackset.InstanceSummary) {
+	tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+	headers := []string{"Account", "Region", "Status", "Drift", "Reason"}
+	fmt.Fprintf(tw, "  %s\n", strings.Join(headers, "\t"))
+	separators := make([]string, len(headers))
+	for i, header := range headers {
+		separators[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(tw, "  %s\n", strings.Join(separators, "\t"))
+	for _, instance := range instances {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\n", instance.Account, instance.Region, instance.Status, instance.DriftStatus, instance.StatusReason)
+	}
+	tw.Flush()
+}
+
 func (o *appUpgradeOpts) askName() error {
 	if o.name != "" {
 		return nil
@@ -179,6 +316,8 @@ func (o *appUpgradeOpts) upgradeApplication(app *config.Application, fromVersion
 		AccountID:          caller.Account,
 		DomainName:         app.Domain,
 		DomainHostedZoneID: app.DomainHostedZoneID,
+		AppRegistry:        app.AppRegistry,
+		StackMetadata:      app.StackMetadata,
 		Version:            toVersion,
 	}); err != nil {
 		return fmt.Errorf("upgrade application %s from version %s to version %s: %v", app.Name, fromVersion, toVersion, err)
@@ -208,7 +347,13 @@ func buildAppUpgradeCmd() *cobra.Command {
 		Short: "Upgrades the template of an application to the latest version.",
 		Example: `
     Upgrade the application "my-app" to the latest version
-    /code $ copilot app upgrade -n my-app`,
+    /code $ copilot app upgrade -n my-app
+    Preview the stack set instances that upgrading "my-app" would update
+    /code $ copilot app upgrade -n my-app --dry-run
+    Redeploy only the stack set instances of "my-app" that failed to update
+    /code $ copilot app upgrade -n my-app --retry-failed
+    Move the custom resources of the environments of "my-app" off deprecated Lambda runtimes
+    /code $ copilot app upgrade -n my-app --custom-resources-only`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newAppUpgradeOpts(vars)
 			if err != nil {
@@ -218,5 +363,9 @@ func buildAppUpgradeCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, appUpgradeDryRunFlagDescription)
+	cmd.Flags().BoolVar(&vars.retryFailed, retryFailedFlag, false, retryFailedFlagDescription)
+	cmd.Flags().BoolVar(&vars.customResourcesOnly, customResourcesOnlyFlag, false, customResourcesOnlyFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(dryRunFlag, retryFailedFlag, customResourcesOnlyFlag)
 	return cmd
 }
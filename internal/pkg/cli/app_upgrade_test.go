@@ -6,8 +6,10 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -292,6 +294,135 @@ func TestAppUpgradeOpts_Execute(t *testing.T) {
 			},
 			wantedErr: fmt.Errorf("upgrade application phonetool from version v0.0.0 to version %s: some error", mockTemplateVersion),
 		},
+		"should return error if fail to retry failed stack set instances": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+				mockUpgrader.EXPECT().RetryFailedAppStackSetInstances("phonetool").Return(nil, errors.New("some error"))
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:        "phonetool",
+						retryFailed: true,
+					},
+					upgrader: mockUpgrader,
+				}
+			},
+			wantedErr: fmt.Errorf("retry failed stack set instances of application phonetool: some error"),
+		},
+		"should only retry failed stack set instances": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+				mockUpgrader.EXPECT().RetryFailedAppStackSetInstances("phonetool").Return([]stackset.InstanceSummary{
+					{StackID: "1", Account: "1234", Region: "us-west-2", Status: "OUTDATED"},
+				}, nil)
+				mockUpgrader.EXPECT().UpgradeApplication(gomock.Any()).Times(0)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:        "phonetool",
+						retryFailed: true,
+					},
+					upgrader: mockUpgrader,
+				}
+			},
+		},
+		"should return error if fail to list environments when upgrading custom resources": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:                "phonetool",
+						customResourcesOnly: true,
+					},
+					store: mockStore,
+				}
+			},
+			wantedErr: errors.New("list environments of application phonetool: some error"),
+		},
+		"should return error if fail to upgrade the custom resources of an environment": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test"},
+				}, nil)
+				mockUpgrader := mocks.NewMockcustomResourcesUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeCustomResourceRuntimes().Return(false, errors.New("some error"))
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:                "phonetool",
+						customResourcesOnly: true,
+					},
+					store: mockStore,
+					newCustomResourcesUpgrader: func(*config.Environment) (customResourcesUpgrader, error) {
+						return mockUpgrader, nil
+					},
+				}
+			},
+			wantedErr: errors.New("upgrade custom resources of environment test: some error"),
+		},
+		"should only upgrade the custom resources of every environment": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test"},
+					{App: "phonetool", Name: "prod"},
+				}, nil)
+				mockUpgrader := mocks.NewMockcustomResourcesUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeCustomResourceRuntimes().Return(true, nil)
+				mockUpgrader.EXPECT().UpgradeCustomResourceRuntimes().Return(false, nil)
+				mockAppUpgrader := mocks.NewMockappUpgrader(ctrl)
+				mockAppUpgrader.EXPECT().UpgradeApplication(gomock.Any()).Times(0)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:                "phonetool",
+						customResourcesOnly: true,
+					},
+					store:    mockStore,
+					upgrader: mockAppUpgrader,
+					newCustomResourcesUpgrader: func(*config.Environment) (customResourcesUpgrader, error) {
+						return mockUpgrader, nil
+					},
+				}
+			},
+		},
+		"should return error if fail to detect drift of the stack set instances during a dry run": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+				mockUpgrader.EXPECT().DetectAppStackSetDrift("phonetool").Return(errors.New("some error"))
+				mockUpgrader.EXPECT().AppStackSetInstances(gomock.Any()).Times(0)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:   "phonetool",
+						dryRun: true,
+					},
+					newVersionGetter: versionGetterLegacy,
+					upgrader:         mockUpgrader,
+				}
+			},
+			wantedErr: errors.New("detect drift of application phonetool: some error"),
+		},
+		"should return error if fail to get stack set instances during a dry run": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+				mockUpgrader.EXPECT().DetectAppStackSetDrift("phonetool").Return(nil)
+				mockUpgrader.EXPECT().AppStackSetInstances("phonetool").Return(nil, errors.New("some error"))
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:   "phonetool",
+						dryRun: true,
+					},
+					newVersionGetter: versionGetterLegacy,
+					upgrader:         mockUpgrader,
+				}
+			},
+			wantedErr: errors.New("some error"),
+		},
 		"success": {
 			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
 				mockIdentity := mocks.NewMockidentityService(ctrl)
@@ -351,3 +482,79 @@ func TestAppUpgradeOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestAppUpgradeOpts_ExecuteDryRun(t *testing.T) {
+	const mockTemplateVersion = "v1.29.0"
+	testCases := map[string]struct {
+		inAppVersion string
+		setupMocks   func(m *mocks.MockappUpgrader)
+
+		wantedOutput []string
+	}{
+		"no changes if the app is up-to-date": {
+			inAppVersion: mockTemplateVersion,
+			setupMocks:   func(m *mocks.MockappUpgrader) {},
+			wantedOutput: []string{
+				"Application phonetool is on version v1.29.0, no changes would be made to upgrade it to version v1.29.0.",
+			},
+		},
+		"lists the stack set instances that would be updated": {
+			inAppVersion: version.LegacyAppTemplate,
+			setupMocks: func(m *mocks.MockappUpgrader) {
+				gomock.InOrder(
+					m.EXPECT().DetectAppStackSetDrift("phonetool").Return(nil),
+					m.EXPECT().AppStackSetInstances("phonetool").Return([]stackset.InstanceSummary{
+						{StackID: "1", Account: "1234", Region: "us-west-2", Status: "CURRENT", DriftStatus: "IN_SYNC"},
+						{StackID: "2", Account: "5678", Region: "eu-west-1", Status: "OUTDATED", DriftStatus: "DRIFTED", StatusReason: "Internal failure."},
+					}, nil),
+				)
+				m.EXPECT().UpgradeApplication(gomock.Any()).Times(0)
+			},
+			wantedOutput: []string{
+				"Upgrading application phonetool from version v0.0.0 to version v1.29.0 would update:",
+				"  - Stack phonetool-infrastructure-roles",
+				"  - 2 instances of stack set phonetool-infrastructure",
+				"1234",
+				"eu-west-1",
+				"DRIFTED",
+				"Internal failure.",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+			tc.setupMocks(mockUpgrader)
+			b := &strings.Builder{}
+			opts := &appUpgradeOpts{
+				appUpgradeVars: appUpgradeVars{
+					name:   "phonetool",
+					dryRun: true,
+				},
+				newVersionGetter: func(string) (versionGetter, error) {
+					return &versionGetterDouble{
+						VersionFn: func() (string, error) {
+							return tc.inAppVersion, nil
+						},
+					}, nil
+				},
+				upgrader:        mockUpgrader,
+				w:               b,
+				templateVersion: mockTemplateVersion,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.NoError(t, err)
+			for _, wanted := range tc.wantedOutput {
+				require.Contains(t, b.String(), wanted)
+			}
+		})
+	}
+}
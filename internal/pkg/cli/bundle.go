@@ -0,0 +1,386 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// bundleDirName is the directory that the content of a bundle is extracted to.
+	bundleDirName            = "copilot-bundle"
+	bundleManifestFileName   = "bundle.json"
+	bundleSSMPluginDirName   = "session-manager-plugin"
+	bundlePauseImageFileName = "pause.tar"
+	bundledPauseImageRepo    = "copilot/pause"
+
+	// pauseImageDockerfile builds the pause image of "copilot run local" with the proxy tools preinstalled,
+	// so that the image doesn't need network access to install them once it's loaded in an air-gapped network.
+	pauseImageDockerfile = `FROM ` + pauseContainerURI + `
+COPY setup.sh /tmp/setup.sh
+RUN /bin/bash /tmp/setup.sh && rm /tmp/setup.sh
+`
+)
+
+// bundledPauseImageURI is the pause image of the bundles of this version of Copilot, once loaded.
+var bundledPauseImageURI = fmt.Sprintf("%s:%s", bundledPauseImageRepo, version.Version)
+
+// bundleManifest describes the content of a bundle.
+type bundleManifest struct {
+	Version    string `json:"version"`    // Version of Copilot in the bundle.
+	OS         string `json:"os"`         // OS of the binaries of the bundle.
+	Arch       string `json:"arch"`       // Architecture of the binaries of the bundle.
+	Copilot    string `json:"copilot"`    // Path of the Copilot binary in the bundle.
+	SSMPlugin  string `json:"ssmPlugin"`  // Path of the session manager plugin in the bundle.
+	PauseImage string `json:"pauseImage"` // Path of the archive of the pause image in the bundle.
+}
+
+type bundleVars struct {
+	file string
+}
+
+type bundleOpts struct {
+	bundleVars
+
+	fs         afero.Fs
+	executable func() (string, error)
+	ssmPlugin  ssmPluginDownloader
+	docker     bundleImageBuilder
+	prog       progress
+}
+
+func newBundleOpts(vars bundleVars) *bundleOpts {
+	return &bundleOpts{
+		bundleVars: vars,
+		fs:         afero.NewOsFs(),
+		executable: os.Executable,
+		ssmPlugin:  exec.NewSSMPluginCommand(nil),
+		docker:     dockerengine.New(exec.NewCmd()),
+		prog:       termprogress.NewSpinner(log.DiagnosticWriter),
+	}
+}
+
+// Validate is a no-op for this command.
+func (o *bundleOpts) Validate() error {
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *bundleOpts) Ask() error {
+	return nil
+}
+
+// Execute writes a bundle with the running binary of Copilot, the session manager plugin, and the pause image of
+// "copilot run local" for the current OS and architecture, to install Copilot in an air-gapped network.
+func (o *bundleOpts) Execute() error {
+	exe, err := o.executable()
+	if err != nil {
+		return fmt.Errorf("find the path of the running binary: %w", err)
+	}
+	copilot, err := afero.ReadFile(o.fs, exe)
+	if err != nil {
+		return fmt.Errorf("read copilot binary %s: %w", exe, err)
+	}
+
+	o.prog.Start("Downloading the session manager plugin")
+	plugin, err := o.ssmPlugin.DownloadBinary()
+	if err != nil {
+		o.prog.Stop(log.Serror("Failed to download the session manager plugin.\n"))
+		return err
+	}
+	o.prog.Stop(log.Ssuccess("Downloaded the session manager plugin.\n"))
+
+	tmpDir, err := afero.TempDir(o.fs, "", "copilot-bundle")
+	if err != nil {
+		return fmt.Errorf("create temporary directory: %w", err)
+	}
+	defer o.fs.RemoveAll(tmpDir)
+	image, err := o.savePauseImage(tmpDir)
+	if err != nil {
+		return err
+	}
+
+	mft := bundleManifest{
+		Version:    version.Version,
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		Copilot:    "copilot",
+		SSMPlugin:  path.Join(bundleSSMPluginDirName, "session-manager-plugin"),
+		PauseImage: path.Join("images", bundlePauseImageFileName),
+	}
+	if runtime.GOOS == "windows" {
+		mft.Copilot, mft.SSMPlugin = mft.Copilot+".exe", mft.SSMPlugin+".exe"
+	}
+	if err := o.writeBundle(mft, copilot, plugin, image); err != nil {
+		_ = o.fs.Remove(o.file)
+		return fmt.Errorf("write bundle %s: %w", o.file, err)
+	}
+	log.Successf("Wrote the bundle of Copilot %s for %s/%s to %s.\n", mft.Version, mft.OS, mft.Arch, color.HighlightResource(o.file))
+	return nil
+}
+
+// savePauseImage builds the pause image with the proxy tools preinstalled, and saves it to an archive in dir.
+func (o *bundleOpts) savePauseImage(dir string) (string, error) {
+	dockerfile := filepath.Join(dir, "Dockerfile")
+	if err := afero.WriteFile(o.fs, dockerfile, []byte(pauseImageDockerfile), 0644); err != nil {
+		return "", fmt.Errorf("write Dockerfile of the pause image: %w", err)
+	}
+	if err := afero.WriteFile(o.fs, filepath.Join(dir, "setup.sh"), []byte(proxySetupScript), 0644); err != nil {
+		return "", fmt.Errorf("write setup script of the pause image: %w", err)
+	}
+	o.prog.Start("Building the pause image")
+	out := &bytes.Buffer{}
+	if err := o.docker.Build(context.Background(), &dockerengine.BuildArguments{
+		URI:        bundledPauseImageRepo,
+		Tags:       []string{version.Version},
+		Dockerfile: dockerfile,
+		Context:    dir,
+	}, out); err != nil {
+		o.prog.Stop(log.Serror("Failed to build the pause image.\n"))
+		return "", fmt.Errorf("build pause image: %w", withCommandOutput(err, out))
+	}
+	o.prog.Stop(log.Ssuccess("Built the pause image.\n"))
+
+	archive := filepath.Join(dir, bundlePauseImageFileName)
+	f, err := o.fs.Create(archive)
+	if err != nil {
+		return "", fmt.Errorf("create %s: %w", archive, err)
+	}
+	defer f.Close()
+	if err := o.docker.SaveImages(context.Background(), f, bundledPauseImageURI); err != nil {
+		return "", fmt.Errorf("save pause image: %w", err)
+	}
+	return archive, nil
+}
+
+func (o *bundleOpts) writeBundle(mft bundleManifest, copilot, plugin []byte, image string) (err error) {
+	f, err := o.fs.Create(o.file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	content, err := json.MarshalIndent(mft, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal bundle manifest: %w", err)
+	}
+	if err := addToTar(tw, bundleManifestFileName, 0644, bytes.NewReader(content), int64(len(content))); err != nil {
+		return err
+	}
+	if err := addToTar(tw, mft.Copilot, 0755, bytes.NewReader(copilot), int64(len(copilot))); err != nil {
+		return err
+	}
+	if err := addToTar(tw, mft.SSMPlugin, 0755, bytes.NewReader(plugin), int64(len(plugin))); err != nil {
+		return err
+	}
+	info, err := o.fs.Stat(image)
+	if err != nil {
+		return err
+	}
+	img, err := o.fs.Open(image)
+	if err != nil {
+		return err
+	}
+	defer img.Close()
+	if err := addToTar(tw, mft.PauseImage, 0644, img, info.Size()); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// addToTar adds a file to the bundle directory of the archive.
+func addToTar(tw *tar.Writer, name string, mode int64, r io.Reader, size int64) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     path.Join(bundleDirName, name),
+		Typeflag: tar.TypeReg,
+		Mode:     mode,
+		Size:     size,
+	}); err != nil {
+		return fmt.Errorf("add %s: %w", name, err)
+	}
+	if _, err := io.Copy(tw, r); err != nil {
+		return fmt.Errorf("add %s: %w", name, err)
+	}
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *bundleOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Transfer %s into the air-gapped network and extract it with %s.", color.HighlightResource(o.file), color.HighlightCode(fmt.Sprintf("tar -xzf %s", filepath.Base(o.file)))),
+		fmt.Sprintf("Run %s to install the session manager plugin and load the pause image.", color.HighlightCode(fmt.Sprintf("./%s/copilot bundle install", bundleDirName))),
+	})
+	return nil
+}
+
+type installBundleVars struct {
+	dir string
+}
+
+type installBundleOpts struct {
+	installBundleVars
+
+	fs         afero.Fs
+	executable func() (string, error)
+	ssmPlugin  ssmPluginBundleInstaller
+	docker     bundleImageLoader
+	prog       progress
+}
+
+func newInstallBundleOpts(vars installBundleVars) *installBundleOpts {
+	return &installBundleOpts{
+		installBundleVars: vars,
+		fs:                afero.NewOsFs(),
+		executable:        os.Executable,
+		ssmPlugin:         exec.NewSSMPluginCommand(nil),
+		docker:            dockerengine.New(exec.NewCmd()),
+		prog:              termprogress.NewSpinner(log.DiagnosticWriter),
+	}
+}
+
+// Validate is a no-op for this command.
+func (o *installBundleOpts) Validate() error {
+	return nil
+}
+
+// Ask defaults the bundle directory to the directory of the running binary, which is the one of the bundle once extracted.
+func (o *installBundleOpts) Ask() error {
+	if o.dir != "" {
+		return nil
+	}
+	exe, err := o.executable()
+	if err != nil {
+		return fmt.Errorf("find the path of the running binary: %w", err)
+	}
+	o.dir = filepath.Dir(exe)
+	return nil
+}
+
+// Execute installs the session manager plugin of the extracted bundle in Copilot's data directory
+// and loads its pause image, so that Copilot uses them instead of downloading them.
+func (o *installBundleOpts) Execute() error {
+	content, err := afero.ReadFile(o.fs, filepath.Join(o.dir, bundleManifestFileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("directory %s isn't an extracted bundle: %s not found", o.dir, bundleManifestFileName)
+		}
+		return fmt.Errorf("read bundle manifest: %w", err)
+	}
+	var mft bundleManifest
+	if err := json.Unmarshal(content, &mft); err != nil {
+		return fmt.Errorf("unmarshal bundle manifest: %w", err)
+	}
+	if mft.OS != runtime.GOOS || mft.Arch != runtime.GOARCH {
+		return fmt.Errorf("bundle is for %s/%s instead of %s/%s", mft.OS, mft.Arch, runtime.GOOS, runtime.GOARCH)
+	}
+
+	plugin, err := afero.ReadFile(o.fs, filepath.Join(o.dir, filepath.FromSlash(mft.SSMPlugin)))
+	if err != nil {
+		return fmt.Errorf("read session manager plugin of the bundle: %w", err)
+	}
+	o.prog.Start("Installing the session manager plugin")
+	if err := o.ssmPlugin.InstallLocalBinaryFrom(plugin); err != nil {
+		o.prog.Stop(log.Serror("Failed to install the session manager plugin.\n"))
+		return fmt.Errorf("install session manager plugin: %w", err)
+	}
+	o.prog.Stop(log.Ssuccess("Installed the session manager plugin in Copilot's data directory.\n"))
+
+	image, err := o.fs.Open(filepath.Join(o.dir, filepath.FromSlash(mft.PauseImage)))
+	if err != nil {
+		return fmt.Errorf("open pause image of the bundle: %w", err)
+	}
+	defer image.Close()
+	o.prog.Start("Loading the pause image")
+	if err := o.docker.LoadImages(context.Background(), image); err != nil {
+		o.prog.Stop(log.Serror("Failed to load the pause image.\n"))
+		return fmt.Errorf("load pause image: %w", err)
+	}
+	o.prog.Stop(log.Ssuccess("Loaded the pause image.\n"))
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *installBundleOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Move %s to a directory in your PATH, like %s.", color.HighlightResource(filepath.Join(o.dir, "copilot")), color.HighlightResource("/usr/local/bin")),
+	})
+	return nil
+}
+
+// buildBundleInstallCmd builds the command for installing an extracted bundle.
+func buildBundleInstallCmd() *cobra.Command {
+	vars := installBundleVars{}
+	cmd := &cobra.Command{
+		Use:   "install",
+		Short: "Installs the content of an extracted bundle.",
+		Long: `Installs the content of an extracted bundle.
+Installs the session manager plugin of the bundle in Copilot's data directory, and loads the pause image of the bundle
+for "copilot run local", so that Copilot doesn't need network access to download them.`,
+		Example: `
+  Installs the bundle that the binary was extracted from.
+  /code $ ./copilot-bundle/copilot bundle install
+  Installs the bundle extracted to another directory.
+  /code $ copilot bundle install --dir /tmp/copilot-bundle`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(newInstallBundleOpts(vars))
+		}),
+	}
+	cmd.Flags().StringVar(&vars.dir, bundleDirFlag, "", bundleDirFlagDescription)
+	return cmd
+}
+
+// BuildBundleCmd builds the command for bundling Copilot for an air-gapped network.
+func BuildBundleCmd() *cobra.Command {
+	vars := bundleVars{}
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Bundles Copilot to install it in an air-gapped network.",
+		Long: `Bundles Copilot to install it in an air-gapped network.
+Writes a tarball with the running binary of Copilot, the session manager plugin, and the pause image of "copilot run local"
+for the current OS and architecture. Copilot embeds its templates, so the binary brings them along.`,
+		Example: `
+  Bundles Copilot into copilot-bundle.tar.gz.
+  /code $ copilot bundle --file copilot-bundle.tar.gz`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(newBundleOpts(vars))
+		}),
+		Annotations: map[string]string{
+			"group": group.Settings,
+		},
+	}
+	cmd.Flags().StringVar(&vars.file, fileFlag, fmt.Sprintf("copilot-bundle-%s-%s-%s.tar.gz", version.Version, runtime.GOOS, runtime.GOARCH), bundleFileFlagDescription)
+	cmd.AddCommand(buildBundleInstallCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
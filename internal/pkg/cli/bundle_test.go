@@ -0,0 +1,287 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type bundleMocks struct {
+	ssmPlugin *mocks.MockssmPluginDownloader
+	docker    *mocks.MockbundleImageBuilder
+}
+
+func TestBundleOpts_Execute(t *testing.T) {
+	const (
+		exe    = "/usr/local/bin/copilot"
+		bundle = "/tmp/copilot-bundle.tar.gz"
+	)
+	copilot, plugin := "copilot", "session-manager-plugin"
+	if runtime.GOOS == "windows" {
+		copilot, plugin = copilot+".exe", plugin+".exe"
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m bundleMocks)
+
+		wantedError error
+	}{
+		"error if the session manager plugin can't be downloaded": {
+			setupMocks: func(m bundleMocks) {
+				m.ssmPlugin.EXPECT().DownloadBinary().Return(nil, errors.New("download ssm plugin: some error"))
+			},
+			wantedError: errors.New("download ssm plugin: some error"),
+		},
+		"error if the pause image can't be built": {
+			setupMocks: func(m bundleMocks) {
+				m.ssmPlugin.EXPECT().DownloadBinary().Return([]byte("plugin"), nil)
+				m.docker.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, _ *dockerengine.BuildArguments, w io.Writer) error {
+					_, _ = w.Write([]byte("no space left on device"))
+					return errors.New("exit status 1")
+				})
+			},
+			wantedError: errors.New("build pause image: exit status 1: no space left on device"),
+		},
+		"error if the pause image can't be saved": {
+			setupMocks: func(m bundleMocks) {
+				m.ssmPlugin.EXPECT().DownloadBinary().Return([]byte("plugin"), nil)
+				m.docker.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.docker.EXPECT().SaveImages(gomock.Any(), gomock.Any(), bundledPauseImageURI).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("save pause image: some error"),
+		},
+		"write the bundle": {
+			setupMocks: func(m bundleMocks) {
+				m.ssmPlugin.EXPECT().DownloadBinary().Return([]byte("plugin"), nil)
+				m.docker.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, in *dockerengine.BuildArguments, _ io.Writer) error {
+					require.Equal(t, bundledPauseImageRepo, in.URI)
+					require.Equal(t, []string{version.Version}, in.Tags)
+					require.Equal(t, filepath.Join(in.Context, "Dockerfile"), in.Dockerfile)
+					return nil
+				})
+				m.docker.EXPECT().SaveImages(gomock.Any(), gomock.Any(), bundledPauseImageURI).DoAndReturn(func(_ interface{}, w io.Writer, _ ...string) error {
+					_, err := w.Write([]byte("image"))
+					return err
+				})
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := bundleMocks{
+				ssmPlugin: mocks.NewMockssmPluginDownloader(ctrl),
+				docker:    mocks.NewMockbundleImageBuilder(ctrl),
+			}
+			tc.setupMocks(m)
+			prog := mocks.NewMockprogress(ctrl)
+			prog.EXPECT().Start(gomock.Any()).AnyTimes()
+			prog.EXPECT().Stop(gomock.Any()).AnyTimes()
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, exe, []byte("binary"), 0755))
+			opts := bundleOpts{
+				bundleVars: bundleVars{
+					file: bundle,
+				},
+				fs: fs,
+				executable: func() (string, error) {
+					return exe, nil
+				},
+				ssmPlugin: m.ssmPlugin,
+				docker:    m.docker,
+				prog:      prog,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				exists, err := afero.Exists(fs, bundle)
+				require.NoError(t, err)
+				require.False(t, exists)
+				return
+			}
+			require.NoError(t, err)
+			files := readBundle(t, fs, bundle)
+			require.Equal(t, map[string]string{
+				"copilot-bundle/bundle.json":                      files["copilot-bundle/bundle.json"],
+				"copilot-bundle/" + copilot:                       "binary",
+				"copilot-bundle/session-manager-plugin/" + plugin: "plugin",
+				"copilot-bundle/images/pause.tar":                 "image",
+			}, files)
+			var mft bundleManifest
+			require.NoError(t, json.Unmarshal([]byte(files["copilot-bundle/bundle.json"]), &mft))
+			require.Equal(t, bundleManifest{
+				Version:    version.Version,
+				OS:         runtime.GOOS,
+				Arch:       runtime.GOARCH,
+				Copilot:    copilot,
+				SSMPlugin:  "session-manager-plugin/" + plugin,
+				PauseImage: "images/pause.tar",
+			}, mft)
+		})
+	}
+}
+
+// readBundle returns the content of each file of the bundle.
+func readBundle(t *testing.T, fs afero.Fs, path string) map[string]string {
+	f, err := fs.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := make(map[string]string)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(content)
+	}
+}
+
+type installBundleMocks struct {
+	ssmPlugin *mocks.MockssmPluginBundleInstaller
+	docker    *mocks.MockbundleImageLoader
+}
+
+func TestInstallBundleOpts_Execute(t *testing.T) {
+	const dir = "/tmp/copilot-bundle"
+
+	testCases := map[string]struct {
+		os         string
+		arch       string
+		noManifest bool
+		setupMocks func(m installBundleMocks)
+
+		wantedError error
+	}{
+		"error if the directory isn't an extracted bundle": {
+			noManifest:  true,
+			setupMocks:  func(m installBundleMocks) {},
+			wantedError: fmt.Errorf("directory %s isn't an extracted bundle: bundle.json not found", dir),
+		},
+		"error if the bundle is for another platform": {
+			os:          "plan9",
+			arch:        runtime.GOARCH,
+			setupMocks:  func(m installBundleMocks) {},
+			wantedError: fmt.Errorf("bundle is for plan9/%s instead of %s/%s", runtime.GOARCH, runtime.GOOS, runtime.GOARCH),
+		},
+		"error if the session manager plugin can't be installed": {
+			os:   runtime.GOOS,
+			arch: runtime.GOARCH,
+			setupMocks: func(m installBundleMocks) {
+				m.ssmPlugin.EXPECT().InstallLocalBinaryFrom([]byte("plugin")).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("install session manager plugin: some error"),
+		},
+		"error if the pause image can't be loaded": {
+			os:   runtime.GOOS,
+			arch: runtime.GOARCH,
+			setupMocks: func(m installBundleMocks) {
+				m.ssmPlugin.EXPECT().InstallLocalBinaryFrom([]byte("plugin")).Return(nil)
+				m.docker.EXPECT().LoadImages(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("load pause image: some error"),
+		},
+		"install the bundle": {
+			os:   runtime.GOOS,
+			arch: runtime.GOARCH,
+			setupMocks: func(m installBundleMocks) {
+				m.ssmPlugin.EXPECT().InstallLocalBinaryFrom([]byte("plugin")).Return(nil)
+				m.docker.EXPECT().LoadImages(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, r io.Reader) error {
+					content, err := io.ReadAll(r)
+					require.NoError(t, err)
+					require.Equal(t, "image", string(content))
+					return nil
+				})
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := installBundleMocks{
+				ssmPlugin: mocks.NewMockssmPluginBundleInstaller(ctrl),
+				docker:    mocks.NewMockbundleImageLoader(ctrl),
+			}
+			tc.setupMocks(m)
+			prog := mocks.NewMockprogress(ctrl)
+			prog.EXPECT().Start(gomock.Any()).AnyTimes()
+			prog.EXPECT().Stop(gomock.Any()).AnyTimes()
+			fs := afero.NewMemMapFs()
+			if !tc.noManifest {
+				mft, err := json.Marshal(bundleManifest{
+					Version:    "v1.32.0",
+					OS:         tc.os,
+					Arch:       tc.arch,
+					Copilot:    "copilot",
+					SSMPlugin:  "session-manager-plugin/session-manager-plugin",
+					PauseImage: "images/pause.tar",
+				})
+				require.NoError(t, err)
+				require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "bundle.json"), mft, 0644))
+			}
+			require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "session-manager-plugin", "session-manager-plugin"), []byte("plugin"), 0755))
+			require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "images", "pause.tar"), []byte("image"), 0644))
+			opts := installBundleOpts{
+				installBundleVars: installBundleVars{
+					dir: dir,
+				},
+				fs:        fs,
+				ssmPlugin: m.ssmPlugin,
+				docker:    m.docker,
+				prog:      prog,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestInstallBundleOpts_Ask(t *testing.T) {
+	opts := installBundleOpts{
+		executable: func() (string, error) {
+			return filepath.Join("/tmp", "copilot-bundle", "copilot"), nil
+		},
+	}
+
+	err := opts.Ask()
+
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("/tmp", "copilot-bundle"), opts.dir)
+}
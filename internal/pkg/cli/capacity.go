@@ -0,0 +1,185 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/dustin/go-humanize/english"
+)
+
+const (
+	// enisPerTask is the number of network interfaces, and so of IP addresses, that a task takes in its subnets
+	// with the awsvpc network mode.
+	enisPerTask = 1
+	// maxTargetGroupsPerALB is the quota of target groups per Application Load Balancer.
+	// See https://docs.aws.amazon.com/elasticloadbalancing/latest/application/load-balancer-limits.html.
+	maxTargetGroupsPerALB = 100
+)
+
+// workloadCapacity is what a service requires from its environment when it runs its maximum number of tasks.
+type workloadCapacity struct {
+	maxTasks  int
+	placement manifest.PlacementString // The type of subnets of the tasks, empty if they're placed in specific subnets.
+	subnetIDs []string
+
+	targetGroups int    // The number of target groups of the service on the load balancer of the environment.
+	lbOutput     string // The environment stack output with the full name of the load balancer.
+}
+
+// capacityOf returns what the service requires from its environment, and false if the service doesn't run tasks in the environment VPC.
+func capacityOf(mft interface{}) (workloadCapacity, bool, error) {
+	var count manifest.Count
+	var network manifest.NetworkConfig
+	var capacity workloadCapacity
+	switch mft := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		count, network = mft.Count, mft.Network
+		capacity.targetGroups = len(mft.HTTPOrBool.RoutingRules())
+		capacity.lbOutput = stack.EnvOutputPublicLoadBalancerFullName
+	case *manifest.BackendService:
+		count, network = mft.Count, mft.Network
+		capacity.targetGroups = len(mft.HTTP.RoutingRules())
+		capacity.lbOutput = stack.EnvOutputInternalLoadBalancerFullName
+	case *manifest.WorkerService:
+		count, network = mft.Count, mft.Network
+	default:
+		return workloadCapacity{}, false, nil
+	}
+	max, err := count.Max()
+	if err != nil {
+		return workloadCapacity{}, false, err
+	}
+	capacity.maxTasks = 1 // Services run a single task by default.
+	if max != nil {
+		capacity.maxTasks = aws.IntValue(max)
+	}
+	placement := network.VPC.Placement
+	switch {
+	case placement.PlacementString != nil:
+		capacity.placement = *placement.PlacementString
+	case len(placement.PlacementArgs.Subnets.IDs) > 0:
+		capacity.subnetIDs = placement.PlacementArgs.Subnets.IDs
+	default:
+		capacity.placement = manifest.PublicSubnetPlacement
+	}
+	return capacity, true, nil
+}
+
+type checkCapacityInput struct {
+	name    string
+	envName string
+	mft     interface{} // The manifest of the service with the environment overrides applied.
+
+	envOutputs   envOutputsGetter
+	subnetIPs    subnetIPsCounter
+	targetGroups lbTargetGroupsLister
+}
+
+// checkCapacity returns warnings if the environment doesn't have enough available IP addresses for the service to scale out
+// to its maximum number of tasks, or if the load balancer of the environment would exceed its quota of target groups.
+func checkCapacity(in checkCapacityInput) ([]string, error) {
+	capacity, ok, err := capacityOf(in.mft)
+	if err != nil || !ok {
+		return nil, err
+	}
+	outputs, err := in.envOutputs.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("get outputs of environment %s: %w", in.envName, err)
+	}
+	var warnings []string
+	ipsWarning, err := checkSubnetIPs(in, capacity, outputs)
+	if err != nil {
+		return nil, err
+	}
+	if ipsWarning != "" {
+		warnings = append(warnings, ipsWarning)
+	}
+	tgsWarning, err := checkTargetGroups(in, capacity, outputs)
+	if err != nil {
+		return nil, err
+	}
+	if tgsWarning != "" {
+		warnings = append(warnings, tgsWarning)
+	}
+	return warnings, nil
+}
+
+func checkSubnetIPs(in checkCapacityInput, capacity workloadCapacity, outputs map[string]string) (string, error) {
+	subnetIDs, subnetsDesc := capacity.subnetIDs, "the subnets"
+	switch capacity.placement {
+	case manifest.PublicSubnetPlacement:
+		subnetIDs, subnetsDesc = splitOutput(outputs[stack.EnvOutputPublicSubnets]), "the public subnets"
+	case manifest.PrivateSubnetPlacement:
+		subnetIDs, subnetsDesc = splitOutput(outputs[stack.EnvOutputPrivateSubnets]), "the private subnets"
+	}
+	if len(subnetIDs) == 0 {
+		return "", nil
+	}
+	ipsBySubnet, err := in.subnetIPs.AvailableIPs(subnetIDs...)
+	if err != nil {
+		return "", fmt.Errorf("get available IP addresses of %s of environment %s: %w", subnetsDesc, in.envName, err)
+	}
+	var available int
+	for _, ips := range ipsBySubnet {
+		available += ips
+	}
+	// During a deployment, the new tasks take IP addresses before the tasks that they replace are stopped.
+	required := capacity.maxTasks * enisPerTask
+	if required <= available {
+		return "", nil
+	}
+	return fmt.Sprintf("Service %s can run up to %s, which %s %s in %s of environment %s, but only %d %s available.",
+		in.name, english.Plural(capacity.maxTasks, "task", ""), english.PluralWord(capacity.maxTasks, "takes", "take"), english.Plural(required, "IP address", "IP addresses"),
+		subnetsDesc, in.envName, available, english.PluralWord(available, "is", "are")), nil
+}
+
+func checkTargetGroups(in checkCapacityInput, capacity workloadCapacity, outputs map[string]string) (string, error) {
+	if capacity.targetGroups == 0 {
+		return "", nil
+	}
+	fullName := outputs[capacity.lbOutput]
+	if fullName == "" {
+		// The load balancer isn't created until a service requires it.
+		return "", nil
+	}
+	// The full name of a load balancer is "app/<name>/<id>".
+	parts := strings.Split(fullName, "/")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("parse load balancer full name %q", fullName)
+	}
+	lb, err := in.targetGroups.LoadBalancer(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("get load balancer of environment %s: %w", in.envName, err)
+	}
+	tgs, err := in.targetGroups.TargetGroups(lb.ARN)
+	if err != nil {
+		return "", fmt.Errorf("get target groups of load balancer %s: %w", lb.Name, err)
+	}
+	total := capacity.targetGroups
+	for _, tg := range tgs {
+		if tg.Tags[deploy.ServiceTagKey] == in.name {
+			// The target groups of the service are replaced by the ones of the manifest.
+			continue
+		}
+		total++
+	}
+	if total <= maxTargetGroupsPerALB {
+		return "", nil
+	}
+	return fmt.Sprintf("Service %s would bring the number of target groups of load balancer %s in environment %s to %d, above the quota of %d per Application Load Balancer.",
+		in.name, lb.Name, in.envName, total, maxTargetGroupsPerALB), nil
+}
+
+func splitOutput(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type checkCapacityMocks struct {
+	envOutputs   *mocks.MockenvOutputsGetter
+	subnetIPs    *mocks.MocksubnetIPsCounter
+	targetGroups *mocks.MocklbTargetGroupsLister
+}
+
+func TestCheckCapacity(t *testing.T) {
+	const lbARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/app-test-PublicLoadBalancer/50dc6c495c0c9188"
+	envOutputs := map[string]string{
+		"PublicSubnets":              "subnet-1,subnet-2",
+		"PrivateSubnets":             "subnet-3,subnet-4",
+		"PublicLoadBalancerFullName": "app/app-test-PublicLoadBalancer/50dc6c495c0c9188",
+	}
+	countRange := manifest.IntRangeBand("1-50")
+	privatePlacement := manifest.PrivateSubnetPlacement
+	targetGroups := func(n int, svc string) []elbv2.TargetGroup {
+		tgs := make([]elbv2.TargetGroup, n)
+		for i := range tgs {
+			tgs[i] = elbv2.TargetGroup{
+				ARN:  fmt.Sprintf("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/%s-%d/73e2d6bc24d8a067", svc, i),
+				Tags: map[string]string{"copilot-service": svc},
+			}
+		}
+		return tgs
+	}
+	lbws := func(count manifest.Count) *manifest.LoadBalancedWebService {
+		mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+			WorkloadProps: &manifest.WorkloadProps{
+				Name:       "api",
+				Dockerfile: "./Dockerfile",
+			},
+			Path: "/",
+			Port: 80,
+		})
+		mft.Count = count
+		return mft
+	}
+
+	testCases := map[string]struct {
+		inMft      interface{}
+		setupMocks func(m checkCapacityMocks)
+
+		wantedWarnings []string
+		wantedErr      error
+	}{
+		"skip services that don't run tasks in the environment VPC": {
+			inMft:      &manifest.RequestDrivenWebService{},
+			setupMocks: func(m checkCapacityMocks) {},
+		},
+		"error if fail to get the outputs of the environment": {
+			inMft: lbws(manifest.Count{Value: aws.Int(1)}),
+			setupMocks: func(m checkCapacityMocks) {
+				m.envOutputs.EXPECT().Outputs().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get outputs of environment test: some error"),
+		},
+		"error if fail to get the available IP addresses": {
+			inMft: lbws(manifest.Count{Value: aws.Int(1)}),
+			setupMocks: func(m checkCapacityMocks) {
+				m.envOutputs.EXPECT().Outputs().Return(envOutputs, nil)
+				m.subnetIPs.EXPECT().AvailableIPs("subnet-1", "subnet-2").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get available IP addresses of the public subnets of environment test: some error"),
+		},
+		"no warnings if the environment has enough capacity": {
+			inMft: lbws(manifest.Count{Value: aws.Int(3)}),
+			setupMocks: func(m checkCapacityMocks) {
+				m.envOutputs.EXPECT().Outputs().Return(envOutputs, nil)
+				m.subnetIPs.EXPECT().AvailableIPs("subnet-1", "subnet-2").Return(map[string]int{"subnet-1": 2, "subnet-2": 1}, nil)
+				m.targetGroups.EXPECT().LoadBalancer("app-test-PublicLoadBalancer").Return(&elbv2.LoadBalancer{ARN: lbARN, Name: "app-test-PublicLoadBalancer"}, nil)
+				m.targetGroups.EXPECT().TargetGroups(lbARN).Return(append(targetGroups(98, "fe"), targetGroups(2, "api")...), nil)
+			},
+		},
+		"warn if the subnets don't have enough IP addresses for the maximum count": {
+			inMft: lbws(manifest.Count{
+				AdvancedCount: manifest.AdvancedCount{
+					Range: manifest.Range{Value: &countRange},
+				},
+			}),
+			setupMocks: func(m checkCapacityMocks) {
+				m.envOutputs.EXPECT().Outputs().Return(envOutputs, nil)
+				m.subnetIPs.EXPECT().AvailableIPs("subnet-1", "subnet-2").Return(map[string]int{"subnet-1": 20, "subnet-2": 10}, nil)
+				m.targetGroups.EXPECT().LoadBalancer("app-test-PublicLoadBalancer").Return(&elbv2.LoadBalancer{ARN: lbARN, Name: "app-test-PublicLoadBalancer"}, nil)
+				m.targetGroups.EXPECT().TargetGroups(lbARN).Return(targetGroups(3, "fe"), nil)
+			},
+			wantedWarnings: []string{
+				"Service api can run up to 50 tasks, which take 50 IP addresses in the public subnets of environment test, but only 30 are available.",
+			},
+		},
+		"warn if the load balancer would have too many target groups": {
+			inMft: func() interface{} {
+				mft := lbws(manifest.Count{})
+				mft.HTTPOrBool.AdditionalRoutingRules = []manifest.RoutingRule{{Path: aws.String("/admin")}}
+				return mft
+			}(),
+			setupMocks: func(m checkCapacityMocks) {
+				m.envOutputs.EXPECT().Outputs().Return(envOutputs, nil)
+				m.subnetIPs.EXPECT().AvailableIPs("subnet-1", "subnet-2").Return(map[string]int{"subnet-1": 20, "subnet-2": 10}, nil)
+				m.targetGroups.EXPECT().LoadBalancer("app-test-PublicLoadBalancer").Return(&elbv2.LoadBalancer{ARN: lbARN, Name: "app-test-PublicLoadBalancer"}, nil)
+				m.targetGroups.EXPECT().TargetGroups(lbARN).Return(append(targetGroups(99, "fe"), targetGroups(1, "api")...), nil)
+			},
+			wantedWarnings: []string{
+				"Service api would bring the number of target groups of load balancer app-test-PublicLoadBalancer in environment test to 101, above the quota of 100 per Application Load Balancer.",
+			},
+		},
+		"check the public subnets of a worker service by default": {
+			inMft: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					TaskConfig: manifest.TaskConfig{
+						Count: manifest.Count{Value: aws.Int(5)},
+					},
+				},
+			},
+			setupMocks: func(m checkCapacityMocks) {
+				m.envOutputs.EXPECT().Outputs().Return(envOutputs, nil)
+				m.subnetIPs.EXPECT().AvailableIPs("subnet-1", "subnet-2").Return(map[string]int{"subnet-1": 1}, nil)
+			},
+			wantedWarnings: []string{
+				"Service api can run up to 5 tasks, which take 5 IP addresses in the public subnets of environment test, but only 1 is available.",
+			},
+		},
+		"skip the target groups of a backend service if the environment has no internal load balancer": {
+			inMft: func() interface{} {
+				mft := &manifest.BackendService{}
+				mft.Network.VPC.Placement.PlacementString = &privatePlacement
+				mft.HTTP.Main.Path = aws.String("/")
+				return mft
+			}(),
+			setupMocks: func(m checkCapacityMocks) {
+				m.envOutputs.EXPECT().Outputs().Return(envOutputs, nil)
+				m.subnetIPs.EXPECT().AvailableIPs("subnet-3", "subnet-4").Return(map[string]int{"subnet-3": 0, "subnet-4": 0}, nil)
+			},
+			wantedWarnings: []string{
+				"Service api can run up to 1 task, which takes 1 IP address in the private subnets of environment test, but only 0 are available.",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := checkCapacityMocks{
+				envOutputs:   mocks.NewMockenvOutputsGetter(ctrl),
+				subnetIPs:    mocks.NewMocksubnetIPsCounter(ctrl),
+				targetGroups: mocks.NewMocklbTargetGroupsLister(ctrl),
+			}
+			tc.setupMocks(m)
+
+			warnings, err := checkCapacity(checkCapacityInput{
+				name:         "api",
+				envName:      "test",
+				mft:          tc.inMft,
+				envOutputs:   m.envOutputs,
+				subnetIPs:    m.subnetIPs,
+				targetGroups: m.targetGroups,
+			})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWarnings, warnings)
+		})
+	}
+}
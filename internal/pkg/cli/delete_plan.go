@@ -0,0 +1,217 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize/english"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	deletionKindStack  = "CloudFormation stack"
+	deletionKindRole   = "IAM role"
+	deletionKindBucket = "S3 bucket"
+	deletionKindRepo   = "ECR repository"
+	deletionKindSecret = "Secrets Manager secret"
+	deletionKindConfig = "Copilot configuration"
+
+	dataLossBucket = "All the objects in the bucket"
+	dataLossRepo   = "All the images in the repository"
+)
+
+// deletionKinds are the resources of a stack listed in a deletion plan, by CloudFormation resource type.
+var deletionKinds = map[string]struct {
+	kind     string
+	dataLoss string
+}{
+	"AWS::IAM::Role":                  {kind: deletionKindRole},
+	"AWS::S3::Bucket":                 {kind: deletionKindBucket, dataLoss: dataLossBucket},
+	"AWS::ECR::Repository":            {kind: deletionKindRepo, dataLoss: dataLossRepo},
+	"AWS::ECS::Service":               {kind: "ECS service"},
+	"AWS::CodePipeline::Pipeline":     {kind: "CodePipeline pipeline"},
+	"AWS::Route53::HostedZone":        {kind: "Route 53 hosted zone", dataLoss: "All the records in the hosted zone"},
+	"AWS::Route53::RecordSet":         {kind: "DNS record"},
+	"AWS::Route53::RecordSetGroup":    {kind: "DNS records"},
+	"Custom::DNSDelegationFunction":   {kind: "DNS records"},
+	"Custom::CustomDomainFunction":    {kind: "DNS records"},
+	"Custom::NLBCustomDomainFunction": {kind: "DNS records"},
+	"AWS::DynamoDB::Table":            {kind: "DynamoDB table", dataLoss: "All the items in the table"},
+	"AWS::RDS::DBCluster":             {kind: "RDS cluster", dataLoss: "All the data in the database"},
+	"AWS::RDS::DBInstance":            {kind: "RDS instance", dataLoss: "All the data in the database"},
+	"AWS::EFS::FileSystem":            {kind: "EFS file system", dataLoss: "All the files in the file system"},
+	"AWS::Logs::LogGroup":             {kind: "Log group", dataLoss: "All the log events"},
+	"AWS::SecretsManager::Secret":     {kind: deletionKindSecret, dataLoss: "The value of the secret"},
+}
+
+// plannedDeletion is a resource that a delete command would remove.
+type plannedDeletion struct {
+	kind     string // Such as "IAM role".
+	name     string
+	dataLoss string // The data lost by removing the resource, empty if none.
+}
+
+// deletionPlan lists the resources that a delete command would remove when it's run with --dry-run.
+type deletionPlan struct {
+	resources []plannedDeletion
+}
+
+func (p *deletionPlan) add(kind, name, dataLoss string) {
+	p.resources = append(p.resources, plannedDeletion{
+		kind:     kind,
+		name:     name,
+		dataLoss: dataLoss,
+	})
+}
+
+// addRepos adds the ECR repository of a workload in each region of the environments to the plan.
+func (p *deletionPlan) addRepos(name string, envs []*config.Environment) {
+	var regions []string
+	for _, env := range envs {
+		if !contains(env.Region, regions) {
+			regions = append(regions, env.Region)
+		}
+	}
+	for _, region := range regions {
+		p.add(deletionKindRepo, fmt.Sprintf("%s (%s)", name, region), dataLossRepo)
+	}
+}
+
+// addStack adds a stack and the resources that are deleted along with it to the plan, including the ones of its nested stacks.
+// The resources retained by their deletion policy are skipped, and so is the stack if it doesn't exist.
+func (p *deletionPlan) addStack(cfn stackResourcesLister, name string) error {
+	body, err := cfn.TemplateBody(name)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil
+		}
+		return fmt.Errorf("get template of stack %s: %w", name, err)
+	}
+	policies, err := deletionPolicies(body)
+	if err != nil {
+		return fmt.Errorf("parse template of stack %s: %w", name, err)
+	}
+	resources, err := cfn.StackResources(name)
+	if err != nil {
+		return err
+	}
+	p.add(deletionKindStack, stackNameFromID(name), "")
+	for _, resource := range resources {
+		logicalID, physicalID := aws.StringValue(resource.LogicalResourceId), aws.StringValue(resource.PhysicalResourceId)
+		policy := policies[logicalID]
+		if policy == "Retain" || policy == "RetainExceptOnCreate" {
+			continue
+		}
+		resourceType := aws.StringValue(resource.ResourceType)
+		if resourceType == "AWS::CloudFormation::Stack" {
+			if physicalID == "" {
+				continue
+			}
+			if err := p.addStack(cfn, physicalID); err != nil {
+				return err
+			}
+			continue
+		}
+		kind, ok := deletionKinds[resourceType]
+		if !ok {
+			continue
+		}
+		dataLoss := kind.dataLoss
+		if policy == "Snapshot" {
+			// A final snapshot of the resource is taken before it's deleted.
+			dataLoss = ""
+		}
+		if physicalID == "" || strings.HasPrefix(resourceType, "Custom::") {
+			// The physical IDs of custom resources aren't meaningful.
+			physicalID = logicalID
+		}
+		p.add(kind.kind, physicalID, dataLoss)
+	}
+	return nil
+}
+
+// write writes the plan as a table and warns about the data that would be lost.
+// The title describes the deletion, such as "Deleting service api from application my-app".
+func (p *deletionPlan) write(w io.Writer, title string) {
+	if len(p.resources) == 0 {
+		fmt.Fprintf(w, "%s would not remove any resource.\n", title)
+		return
+	}
+	fmt.Fprintf(w, "%s would remove:\n\n", title)
+	tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+	headers := []string{"Type", "Name", "Data loss"}
+	fmt.Fprintf(tw, "  %s\n", strings.Join(headers, "\t"))
+	separators := make([]string, len(headers))
+	for i, header := range headers {
+		separators[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(tw, "  %s\n", strings.Join(separators, "\t"))
+	var dataLoss int
+	for _, resource := range p.resources {
+		loss := "-"
+		if resource.dataLoss != "" {
+			loss = resource.dataLoss
+			dataLoss++
+		}
+		fmt.Fprintf(tw, "  %s\t%s\t%s\n", resource.kind, resource.name, loss)
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+	if dataLoss > 0 {
+		log.Warningf("%s would permanently lose the data of %s.\n", title, english.Plural(dataLoss, "resource", ""))
+	}
+}
+
+// deletionPolicies returns the deletion policy of each resource of a template that has one, by logical ID.
+func deletionPolicies(body string) (map[string]string, error) {
+	var tpl struct {
+		Resources map[string]struct {
+			DeletionPolicy yaml.Node `yaml:"DeletionPolicy"`
+		} `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal([]byte(body), &tpl); err != nil {
+		return nil, err
+	}
+	policies := make(map[string]string)
+	for logicalID, resource := range tpl.Resources {
+		if resource.DeletionPolicy.Kind == yaml.ScalarNode {
+			policies[logicalID] = resource.DeletionPolicy.Value
+		}
+	}
+	return policies, nil
+}
+
+// roleNameFromARN returns the name of an IAM role from its ARN.
+func roleNameFromARN(roleARN string) string {
+	parsed, err := arn.Parse(roleARN)
+	if err != nil {
+		return roleARN
+	}
+	return strings.TrimPrefix(parsed.Resource, "role/")
+}
+
+// stackNameFromID returns the name of a stack from its name or ID.
+func stackNameFromID(id string) string {
+	parsed, err := arn.Parse(id)
+	if err != nil {
+		return id
+	}
+	// The resource of a stack ARN is "stack/<name>/<uuid>".
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) < 2 {
+		return id
+	}
+	return parts[1]
+}
@@ -0,0 +1,172 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func stackResource(logicalID, physicalID, resourceType string) *awscloudformation.StackResource {
+	return &awscloudformation.StackResource{
+		LogicalResourceId:  aws.String(logicalID),
+		PhysicalResourceId: aws.String(physicalID),
+		ResourceType:       aws.String(resourceType),
+	}
+}
+
+func TestDeletionPlan_AddStack(t *testing.T) {
+	const addonsStackID = "arn:aws:cloudformation:us-west-2:123456789012:stack/app-test-api-AddonsStack-1ABC/5e8c0a40"
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockstackResourcesLister)
+
+		wantedResources []plannedDeletion
+		wantedErr       error
+	}{
+		"skip the stack if it doesn't exist": {
+			setupMocks: func(m *mocks.MockstackResourcesLister) {
+				m.EXPECT().TemplateBody("app-test-api").Return("", &awscloudformation.ErrStackNotFound{})
+			},
+		},
+		"error if fail to get the template of the stack": {
+			setupMocks: func(m *mocks.MockstackResourcesLister) {
+				m.EXPECT().TemplateBody("app-test-api").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get template of stack app-test-api: some error"),
+		},
+		"list the resources of the stack and its nested stacks": {
+			setupMocks: func(m *mocks.MockstackResourcesLister) {
+				m.EXPECT().TemplateBody("app-test-api").Return(`
+Resources:
+  TaskRole:
+    Type: AWS::IAM::Role
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    DeletionPolicy: Retain
+  Service:
+    Type: AWS::ECS::Service
+  RecordSet:
+    Type: AWS::Route53::RecordSetGroup
+  AddonsStack:
+    Type: AWS::CloudFormation::Stack
+`, nil)
+				m.EXPECT().StackResources("app-test-api").Return([]*awscloudformation.StackResource{
+					stackResource("TaskRole", "app-test-api-TaskRole-1ABC", "AWS::IAM::Role"),
+					stackResource("LogGroup", "/copilot/app-test-api", "AWS::Logs::LogGroup"),
+					stackResource("Service", "arn:aws:ecs:us-west-2:123456789012:service/app-test-Cluster/app-test-api-Service", "AWS::ECS::Service"),
+					stackResource("TaskDefinition", "arn:aws:ecs:us-west-2:123456789012:task-definition/app-test-api:1", "AWS::ECS::TaskDefinition"),
+					stackResource("RecordSet", "api.test.app.example.com", "AWS::Route53::RecordSetGroup"),
+					stackResource("AddonsStack", addonsStackID, "AWS::CloudFormation::Stack"),
+				}, nil)
+				m.EXPECT().TemplateBody(addonsStackID).Return(`{"Resources": {"Bucket": {"Type": "AWS::S3::Bucket"}, "Cluster": {"Type": "AWS::RDS::DBCluster", "DeletionPolicy": "Snapshot"}}}`, nil)
+				m.EXPECT().StackResources(addonsStackID).Return([]*awscloudformation.StackResource{
+					stackResource("Bucket", "app-test-api-bucket", "AWS::S3::Bucket"),
+					stackResource("Cluster", "app-test-api-cluster", "AWS::RDS::DBCluster"),
+				}, nil)
+			},
+			wantedResources: []plannedDeletion{
+				{kind: "CloudFormation stack", name: "app-test-api"},
+				{kind: "IAM role", name: "app-test-api-TaskRole-1ABC"},
+				{kind: "ECS service", name: "arn:aws:ecs:us-west-2:123456789012:service/app-test-Cluster/app-test-api-Service"},
+				{kind: "DNS records", name: "api.test.app.example.com"},
+				{kind: "CloudFormation stack", name: "app-test-api-AddonsStack-1ABC"},
+				{kind: "S3 bucket", name: "app-test-api-bucket", dataLoss: "All the objects in the bucket"},
+				{kind: "RDS cluster", name: "app-test-api-cluster"},
+			},
+		},
+		"name the custom DNS resources by their logical ID": {
+			setupMocks: func(m *mocks.MockstackResourcesLister) {
+				m.EXPECT().TemplateBody("app-test-api").Return("Resources: {}", nil)
+				m.EXPECT().StackResources("app-test-api").Return([]*awscloudformation.StackResource{
+					stackResource("CustomDomainAction", "2023/10/02/[$LATEST]1234", "Custom::CustomDomainFunction"),
+				}, nil)
+			},
+			wantedResources: []plannedDeletion{
+				{kind: "CloudFormation stack", name: "app-test-api"},
+				{kind: "DNS records", name: "CustomDomainAction"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstackResourcesLister(ctrl)
+			tc.setupMocks(m)
+
+			var plan deletionPlan
+			err := plan.addStack(m, "app-test-api")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedResources, plan.resources)
+		})
+	}
+}
+
+func TestDeletionPlan_AddRepos(t *testing.T) {
+	var plan deletionPlan
+	plan.addRepos("app/api", []*config.Environment{
+		{Name: "test", Region: "us-west-2"},
+		{Name: "staging", Region: "us-west-2"},
+		{Name: "prod", Region: "us-east-1"},
+	})
+
+	require.Equal(t, []plannedDeletion{
+		{kind: "ECR repository", name: "app/api (us-west-2)", dataLoss: "All the images in the repository"},
+		{kind: "ECR repository", name: "app/api (us-east-1)", dataLoss: "All the images in the repository"},
+	}, plan.resources)
+}
+
+func TestDeletionPlan_Write(t *testing.T) {
+	testCases := map[string]struct {
+		inResources []plannedDeletion
+
+		wantedOutput string
+	}{
+		"no resources": {
+			wantedOutput: "Deleting task db-migrate would not remove any resource.\n",
+		},
+		"write the resources as a table": {
+			inResources: []plannedDeletion{
+				{kind: "CloudFormation stack", name: "task-db-migrate"},
+				{kind: "ECR repository", name: "copilot-db-migrate", dataLoss: "All the images in the repository"},
+			},
+			wantedOutput: `Deleting task db-migrate would remove:
+
+  Type                  Name                Data loss
+  ----                  ----                ---------
+  CloudFormation stack  task-db-migrate     -
+  ECR repository        copilot-db-migrate  All the images in the repository
+
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b := new(strings.Builder)
+			plan := deletionPlan{resources: tc.inResources}
+
+			plan.write(b, "Deleting task db-migrate")
+
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
+
+func TestRoleNameFromARN(t *testing.T) {
+	require.Equal(t, "app-test-EnvManagerRole", roleNameFromARN("arn:aws:iam::123456789012:role/app-test-EnvManagerRole"))
+	require.Equal(t, "not-an-arn", roleNameFromARN("not-an-arn"))
+}
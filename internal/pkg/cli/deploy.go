@@ -6,6 +6,8 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"os"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,10 +18,13 @@ import (
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
@@ -40,6 +45,8 @@ const (
 type deployVars struct {
 	deployWkldVars
 
+	all         bool
+	selectWklds bool
 	yesInitWkld *bool
 	deployEnv   *bool
 	yesInitEnv  *bool
@@ -59,6 +66,8 @@ type deployOpts struct {
 	newInitEnvCmd   func(o *deployOpts) (cmd, error)
 	newDeployEnvCmd func(o *deployOpts) (cmd, error)
 
+	newStacksWaiter func(o *deployOpts) (workloadStacksWaiter, error)
+
 	sel    wsSelector
 	store  store
 	ws     wsWlDirReader
@@ -75,8 +84,7 @@ type deployOpts struct {
 	wsEnvironments []string
 }
 
-func newDeployOpts(vars deployVars) (*deployOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("deploy"))
+func newDeployOpts(vars deployVars, sessProvider *sessions.Provider) (*deployOpts, error) {
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %v", err)
@@ -113,7 +121,8 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 				skipDiffPrompt:    o.skipDiffPrompt,
 				allowEnvDowngrade: o.allowWkldDowngrade,
 				detach:            o.detach,
-			})
+				noCache:           o.noCache,
+			}, sessProvider)
 		},
 
 		newInitEnvCmd: func(o *deployOpts) (cmd, error) {
@@ -127,7 +136,19 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 				allowAppDowngrade: o.allowWkldDowngrade,
 				tempCreds:         o.tempCreds,
 				region:            o.region,
-			})
+			}, sessProvider)
+		},
+
+		newStacksWaiter: func(o *deployOpts) (workloadStacksWaiter, error) {
+			env, err := o.store.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return nil, fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+			}
+			envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, err
+			}
+			return cloudformation.New(envSess, cloudformation.WithProgressTracker(os.Stderr)), nil
 		},
 
 		setupDeployCmd: func(o *deployOpts, workloadType string) {
@@ -144,6 +165,7 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 					cmd:             exec.NewCmd(),
 					templateVersion: version.LatestTemplateVersion(),
 					sessProvider:    sessProvider,
+					retrier:         newTransientRetrier(o.deployWkldVars),
 				}
 				opts.newJobDeployer = func() (workloadDeployer, error) {
 					return newJobDeployer(opts)
@@ -162,6 +184,7 @@ func newDeployOpts(vars deployVars) (*deployOpts, error) {
 					prompt:          o.prompt,
 					cmd:             exec.NewCmd(),
 					sessProvider:    sessProvider,
+					retrier:         newTransientRetrier(o.deployWkldVars),
 					templateVersion: version.LatestTemplateVersion(),
 				}
 				opts.newSvcDeployer = func() (workloadDeployer, error) {
@@ -223,6 +246,20 @@ func (o *deployOpts) maybeInitWkld() error {
 }
 
 func (o *deployOpts) Run() error {
+	if o.all {
+		if o.name != "" {
+			return fmt.Errorf("cannot specify both --%s and --%s", allFlag, nameFlag)
+		}
+		if o.detach {
+			return fmt.Errorf("cannot specify both --%s and --%s", allFlag, detachFlag)
+		}
+		if o.changeSetOnly {
+			return fmt.Errorf("cannot specify both --%s and --%s", allFlag, changeSetOnlyFlag)
+		}
+	}
+	if o.selectWklds && !o.all {
+		return fmt.Errorf("--%s must be specified with --%s", selectWorkloadsFlag, allFlag)
+	}
 	if err := o.askName(); err != nil {
 		return err
 	}
@@ -243,6 +280,10 @@ func (o *deployOpts) Run() error {
 		return err
 	}
 
+	if o.all {
+		return o.deployAll()
+	}
+
 	if err := o.maybeInitWkld(); err != nil {
 		return err
 	}
@@ -260,7 +301,7 @@ func (o *deployOpts) Run() error {
 }
 
 func (o *deployOpts) askName() error {
-	if o.name != "" {
+	if o.name != "" || o.all {
 		return nil
 	}
 	name, err := o.sel.Workload("Select a service or job in your workspace", "")
@@ -448,6 +489,166 @@ func (o *deployOpts) loadWkldCmd() error {
 	return nil
 }
 
+// deployAll deploys every workload of the workspace, or the workloads selected by the user, in the order of their dependencies.
+// The workloads that don't depend on each other are deployed in parallel,
+// and the workloads that depend on a workload that failed to deploy are skipped.
+func (o *deployOpts) deployAll() error {
+	wklds, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	if len(wklds) == 0 {
+		return errors.New("no workloads found in the workspace")
+	}
+	if o.selectWklds {
+		wklds, err = o.sel.Workloads("Select the services and jobs to deploy", "")
+		if err != nil {
+			return fmt.Errorf("select services or jobs: %w", err)
+		}
+	}
+	for _, wkld := range wklds {
+		o.name = wkld
+		if err := o.maybeInitWkld(); err != nil {
+			return err
+		}
+	}
+	waves, dependencies, err := o.deploymentWaves(wklds)
+	if err != nil {
+		return err
+	}
+	log.Infof("Deploying %d workloads to environment %s in %d steps:\n", len(wklds), color.HighlightUserInput(o.envName), len(waves))
+	for i, wave := range waves {
+		log.Infof("%d. %s\n", i+1, strings.Join(wave, ", "))
+	}
+
+	waiter, err := o.newStacksWaiter(o)
+	if err != nil {
+		return err
+	}
+	// Each workload starts deploying without waiting, so that the deployments of a step are rendered together.
+	o.detach = true
+	failed := make(map[string]error)
+	for _, wave := range waves {
+		var deploying []string
+		for _, wkld := range wave {
+			if dep, ok := failedDependency(dependencies[wkld], failed); ok {
+				log.Warningf("Skipping %s since %s failed to deploy.\n", wkld, dep)
+				failed[wkld] = fmt.Errorf("skipped since %s failed to deploy", dep)
+				continue
+			}
+			o.name = wkld
+			if err := o.startDeployWkld(); err != nil {
+				var errNoChanges *errNoInfrastructureChanges
+				if errors.As(err, &errNoChanges) {
+					log.Infof("No infrastructure changes for %s.\n", wkld)
+					continue
+				}
+				log.Errorf("Failed to deploy %s: %v\n", wkld, err)
+				failed[wkld] = err
+				continue
+			}
+			deploying = append(deploying, wkld)
+		}
+		if len(deploying) == 0 {
+			continue
+		}
+		stackNames := make([]string, len(deploying))
+		for i, wkld := range deploying {
+			stackNames[i] = stack.NameForWorkload(o.appName, o.envName, wkld)
+		}
+		failedStacks, err := waiter.WaitForWorkloadStacks(stackNames)
+		if err != nil {
+			return fmt.Errorf("wait for workloads %s to deploy: %w", strings.Join(deploying, ", "), err)
+		}
+		for i, wkld := range deploying {
+			if err, ok := failedStacks[stackNames[i]]; ok {
+				log.Errorf("Failed to deploy %s: %v\n", wkld, err)
+				failed[wkld] = err
+				continue
+			}
+			log.Successf("Deployed %s.\n", color.HighlightUserInput(wkld))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	var reasons []string
+	for _, wkld := range wklds {
+		if err, ok := failed[wkld]; ok {
+			reasons = append(reasons, fmt.Sprintf("%s: %v", wkld, err))
+		}
+	}
+	return fmt.Errorf("%d of %d workloads were not deployed:\n%s", len(failed), len(wklds), strings.Join(reasons, "\n"))
+}
+
+// startDeployWkld starts deploying the workload o.name.
+func (o *deployOpts) startDeployWkld() error {
+	if err := o.loadWkld(); err != nil {
+		return err
+	}
+	if err := o.deployWkld.Execute(); err != nil {
+		return fmt.Errorf("execute %s deploy: %w", o.wlType, err)
+	}
+	return nil
+}
+
+// deploymentWaves groups the workloads into steps that can be deployed in parallel, after the steps before them.
+// A workload depends on the other workloads whose endpoints it references in its variables, or whose topics it subscribes to.
+// It also returns the workloads that each workload depends on.
+func (o *deployOpts) deploymentWaves(wklds []string) ([][]string, map[string][]string, error) {
+	digraph := graph.New(wklds...)
+	dependencies := make(map[string][]string)
+	for _, wkld := range wklds {
+		raw, err := o.ws.ReadWorkloadManifest(wkld)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read manifest file for %s: %w", wkld, err)
+		}
+		for _, dep := range wklds {
+			if dep == wkld {
+				continue
+			}
+			refs, err := manifestReferences(wkld, raw, dep, o.envName)
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(refs) == 0 {
+				continue
+			}
+			digraph.Add(graph.Edge[string]{
+				From: dep,
+				To:   wkld,
+			})
+			dependencies[wkld] = append(dependencies[wkld], dep)
+		}
+	}
+	topo, err := graph.TopologicalOrder(digraph)
+	if err != nil {
+		return nil, nil, fmt.Errorf("resolve the order of the workloads: %w", err)
+	}
+	var waves [][]string
+	for _, wkld := range wklds {
+		rank, _ := topo.Rank(wkld)
+		for len(waves) <= rank {
+			waves = append(waves, nil)
+		}
+		waves[rank] = append(waves[rank], wkld)
+	}
+	for _, wave := range waves {
+		sort.Strings(wave)
+	}
+	return waves, dependencies, nil
+}
+
+// failedDependency returns the first dependency that failed to deploy.
+func failedDependency(dependencies []string, failed map[string]error) (string, bool) {
+	for _, dep := range dependencies {
+		if _, ok := failed[dep]; ok {
+			return dep, true
+		}
+	}
+	return "", false
+}
+
 // BuildDeployCmd is the deploy command.
 func BuildDeployCmd() *cobra.Command {
 	vars := deployVars{}
@@ -468,10 +669,14 @@ func BuildDeployCmd() *cobra.Command {
     then deploys a service named "api"
   /code $ copilot deploy --init-env --deploy-env --env test --name api --profile default --region us-west-2
   Initializes and deploys a service named "backend" to a "prod" environment.
-  /code $ copilot deploy --init-wkld --deploy-env=false --env prod --name backend`,
+  /code $ copilot deploy --init-wkld --deploy-env=false --env prod --name backend
+  Deploys all the services and jobs of the workspace to a "test" environment, in the order of their dependencies.
+  /code $ copilot deploy --all --env test --deploy-env=false
+  Selects some of the services and jobs of the workspace and deploys them to a "test" environment.
+  /code $ copilot deploy --all --select --env test`,
 
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeployOpts(vars)
+			opts, err := newDeployOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("deploy")))
 			if err != nil {
 				return err
 			}
@@ -505,6 +710,8 @@ func BuildDeployCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", workloadFlagDescription)
+	cmd.Flags().BoolVar(&vars.all, allFlag, false, deployAllFlagDescription)
+	cmd.Flags().BoolVar(&vars.selectWklds, selectWorkloadsFlag, false, selectWorkloadsFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
@@ -512,6 +719,11 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 	cmd.Flags().BoolVar(&vars.detach, detachFlag, false, detachFlagDescription)
+	cmd.Flags().BoolVar(&vars.changeSetOnly, changeSetOnlyFlag, false, changeSetOnlyFlagDescription)
+	cmd.Flags().BoolVar(&vars.noRetry, noRetryFlag, false, noRetryFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
+	cmd.Flags().Var(&vars.maxContextSize, maxContextSizeFlag, maxContextSizeFlagDescription)
+	cmd.Flags().UintVar(&vars.maxParallelBuilds, maxParallelBuildsFlag, clideploy.DefaultMaxParallelBuilds, maxParallelBuildsFlagDescription)
 
 	cmd.Flags().BoolVar(&deployEnvironment, deployEnvFlag, false, deployEnvFlagDescription)
 	cmd.Flags().BoolVar(&initEnvironment, yesInitEnvFlag, false, yesInitEnvFlagDescription)
@@ -39,6 +39,9 @@ func NewBackendDeployer(in *WorkloadDeployerInput) (*backendSvcDeployer, error)
 	if !ok {
 		return nil, fmt.Errorf("manifest is not of type %s", manifestinfo.BackendServiceType)
 	}
+	if !bsMft.HTTP.IsEmpty() {
+		svcDeployer.lock = svcDeployer.envLoadBalancerLock()
+	}
 	return &backendSvcDeployer{
 		svcDeployer:        svcDeployer,
 		backendMft:         bsMft,
@@ -94,6 +97,9 @@ func (d *backendSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (
 	if err := d.validateALBRuntime(); err != nil {
 		return nil, err
 	}
+	if err := d.resolveScalingCalendars(&d.backendMft.Count.AdvancedCount); err != nil {
+		return nil, err
+	}
 
 	var conf cloudformation.StackConfiguration
 	switch {
@@ -158,6 +164,9 @@ func (d *backendSvcDeployer) validateRuntimeRoutingRule(rule manifest.RoutingRul
 	if err != nil {
 		return fmt.Errorf("convert aliases to string slice: %w", err)
 	}
+	if err := validateAliasConventions(d.app, aliases...); err != nil {
+		return fmt.Errorf(`validate 'alias': %w`, err)
+	}
 
 	if err := d.aliasCertValidator.ValidateCertAliases(aliases, d.envConfig.HTTPConfig.Private.Certificates); err != nil {
 		return fmt.Errorf("validate aliases against the imported certificate for env %s: %w", d.env.Name, err)
@@ -0,0 +1,244 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ComposeFileName is the name of the Docker Compose file generated for a workload.
+	ComposeFileName = "docker-compose.yml"
+
+	// composeNetworkService is the name of the service that holds the network shared by the containers, like the task's ENI.
+	composeNetworkService = "pause"
+	composeNetworkImage   = template.DefaultPauseImage
+)
+
+// ComposeInput holds the configuration of a workload to convert to a Docker Compose file.
+type ComposeInput struct {
+	Name           string                 // Name of the workload.
+	TaskDefinition *awsecs.TaskDefinition // Task definition of the workload deployed in the environment.
+	// Environment variables of each container, by container name.
+	EnvVars map[string]map[string]string
+	// References to the SSM parameters or Secrets Manager secrets injected in each container, by container name.
+	Secrets map[string]map[string]string
+	// Build contexts of the containers whose image is built from the workspace, by container name.
+	BuildContexts map[string]ContainerBuildContext
+	// Directory that the Compose file is written to, build contexts are relative to it.
+	Dir string
+	// Image of the service that holds the network shared by the containers. Defaults to the pause image of Copilot.
+	PauseImage string
+}
+
+type composeFile struct {
+	Name     string                    `yaml:"name"`
+	Services map[string]composeService `yaml:"services"`
+}
+
+type composeService struct {
+	Image       string                       `yaml:"image,omitempty"`
+	Build       *composeBuild                `yaml:"build,omitempty"`
+	Entrypoint  []string                     `yaml:"entrypoint,omitempty"`
+	Command     []string                     `yaml:"command,omitempty"`
+	Environment map[string]string            `yaml:"environment,omitempty"`
+	Ports       []string                     `yaml:"ports,omitempty"`
+	NetworkMode string                       `yaml:"network_mode,omitempty"`
+	DependsOn   map[string]composeDependency `yaml:"depends_on,omitempty"`
+	HealthCheck *composeHealthCheck          `yaml:"healthcheck,omitempty"`
+}
+
+type composeBuild struct {
+	Context    string `yaml:"context"`
+	Dockerfile string `yaml:"dockerfile,omitempty"`
+}
+
+type composeDependency struct {
+	Condition string `yaml:"condition"`
+}
+
+type composeHealthCheck struct {
+	Test        []string `yaml:"test"`
+	Interval    string   `yaml:"interval,omitempty"`
+	Timeout     string   `yaml:"timeout,omitempty"`
+	Retries     int64    `yaml:"retries,omitempty"`
+	StartPeriod string   `yaml:"start_period,omitempty"`
+}
+
+// GenerateComposeFile returns a Docker Compose file that runs the containers of the workload the way they run in their task:
+// the containers share the network of a "pause" service that publishes their ports, start in the order of their dependencies,
+// and are passed the same environment variables. Secrets are read from the variables of the same name in the shell running Compose.
+func GenerateComposeFile(in ComposeInput) ([]byte, error) {
+	if in.TaskDefinition == nil {
+		return nil, fmt.Errorf("task definition of %s is required", in.Name)
+	}
+	pauseImage := composeNetworkImage
+	if in.PauseImage != "" {
+		pauseImage = in.PauseImage
+	}
+	services := map[string]composeService{
+		composeNetworkService: {
+			Image:   pauseImage,
+			Command: []string{"sleep", "infinity"},
+			Ports:   composePorts(in.TaskDefinition.ContainerDefinitions),
+		},
+	}
+	for _, def := range in.TaskDefinition.ContainerDefinitions {
+		name := aws.StringValue(def.Name)
+		if name == composeNetworkService {
+			return nil, fmt.Errorf("container name %q is reserved by the Compose file", composeNetworkService)
+		}
+		svc, err := composeContainer(def, in)
+		if err != nil {
+			return nil, err
+		}
+		services[name] = svc
+	}
+
+	buf := &bytes.Buffer{}
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(composeFile{
+		Name:     in.Name,
+		Services: services,
+	}); err != nil {
+		return nil, fmt.Errorf("marshal Compose file of %s: %w", in.Name, err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("marshal Compose file of %s: %w", in.Name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+func composeContainer(def *sdkecs.ContainerDefinition, in ComposeInput) (composeService, error) {
+	name := aws.StringValue(def.Name)
+	svc := composeService{
+		Entrypoint:  aws.StringValueSlice(def.EntryPoint),
+		Command:     aws.StringValueSlice(def.Command),
+		NetworkMode: "service:" + composeNetworkService,
+		DependsOn: map[string]composeDependency{
+			composeNetworkService: {
+				Condition: "service_started",
+			},
+		},
+		HealthCheck: composeContainerHealthCheck(def.HealthCheck),
+	}
+	if bc, ok := in.BuildContexts[name]; ok {
+		build, err := composeBuildContext(bc, in.Dir)
+		if err != nil {
+			return composeService{}, fmt.Errorf("build context of container %s: %w", name, err)
+		}
+		svc.Build = build
+	} else {
+		svc.Image = aws.StringValue(def.Image)
+	}
+
+	env := make(map[string]string, len(in.EnvVars[name])+len(in.Secrets[name]))
+	for k, v := range in.EnvVars[name] {
+		env[k] = strings.ReplaceAll(v, "$", "$$") // Escape the values from being interpolated by Compose.
+	}
+	for k, ref := range in.Secrets[name] {
+		env[k] = fmt.Sprintf("${%s:?set %s to the value of the secret %s}", k, k, ref)
+	}
+	if len(env) > 0 {
+		svc.Environment = env
+	}
+
+	for _, dep := range def.DependsOn {
+		condition, err := composeDependencyCondition(aws.StringValue(dep.Condition))
+		if err != nil {
+			return composeService{}, fmt.Errorf("dependency of container %s on %s: %w", name, aws.StringValue(dep.ContainerName), err)
+		}
+		svc.DependsOn[aws.StringValue(dep.ContainerName)] = composeDependency{
+			Condition: condition,
+		}
+	}
+	return svc, nil
+}
+
+// composeBuildContext returns the build context relative to dir, and the Dockerfile relative to the context.
+func composeBuildContext(bc ContainerBuildContext, dir string) (*composeBuild, error) {
+	context, err := filepath.Rel(dir, bc.Context)
+	if err != nil {
+		return nil, err
+	}
+	build := &composeBuild{
+		Context: filepath.ToSlash(context),
+	}
+	if bc.Dockerfile != "" {
+		dockerfile, err := filepath.Rel(bc.Context, bc.Dockerfile)
+		if err != nil {
+			return nil, err
+		}
+		build.Dockerfile = filepath.ToSlash(dockerfile)
+	}
+	return build, nil
+}
+
+// composePorts returns the host:container ports of all the containers. Like in awsvpc mode, a port is published on the same port of the host.
+func composePorts(defs []*sdkecs.ContainerDefinition) []string {
+	seen := make(map[string]bool)
+	var ports []string
+	for _, def := range defs {
+		for _, mapping := range def.PortMappings {
+			port := aws.Int64Value(mapping.ContainerPort)
+			if port == 0 {
+				continue
+			}
+			p := fmt.Sprintf("%d:%d", port, port)
+			if protocol := aws.StringValue(mapping.Protocol); protocol != "" && protocol != sdkecs.TransportProtocolTcp {
+				p += "/" + protocol
+			}
+			if seen[p] {
+				continue
+			}
+			seen[p] = true
+			ports = append(ports, p)
+		}
+	}
+	return ports
+}
+
+func composeDependencyCondition(condition string) (string, error) {
+	switch condition {
+	case sdkecs.ContainerConditionStart:
+		return "service_started", nil
+	case sdkecs.ContainerConditionHealthy:
+		return "service_healthy", nil
+	case sdkecs.ContainerConditionComplete, sdkecs.ContainerConditionSuccess:
+		// Compose can only wait for a container to exit successfully.
+		return "service_completed_successfully", nil
+	default:
+		return "", fmt.Errorf("unsupported condition %q", condition)
+	}
+}
+
+func composeContainerHealthCheck(hc *sdkecs.HealthCheck) *composeHealthCheck {
+	if hc == nil || len(hc.Command) == 0 {
+		return nil
+	}
+	return &composeHealthCheck{
+		Test:        aws.StringValueSlice(hc.Command),
+		Interval:    composeSeconds(hc.Interval),
+		Timeout:     composeSeconds(hc.Timeout),
+		Retries:     aws.Int64Value(hc.Retries),
+		StartPeriod: composeSeconds(hc.StartPeriod),
+	}
+}
+
+func composeSeconds(seconds *int64) string {
+	if seconds == nil {
+		return ""
+	}
+	return fmt.Sprintf("%ds", aws.Int64Value(seconds))
+}
@@ -0,0 +1,188 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateComposeFile(t *testing.T) {
+	testCases := map[string]struct {
+		in ComposeInput
+
+		wanted      string
+		wantedError string
+	}{
+		"error if the task definition is missing": {
+			in: ComposeInput{
+				Name: "api",
+			},
+			wantedError: "task definition of api is required",
+		},
+		"error if a container is named like the network service": {
+			in: ComposeInput{
+				Name: "api",
+				TaskDefinition: &awsecs.TaskDefinition{
+					ContainerDefinitions: []*sdkecs.ContainerDefinition{
+						{Name: aws.String("pause")},
+					},
+				},
+			},
+			wantedError: `container name "pause" is reserved by the Compose file`,
+		},
+		"error if a dependency condition is unknown": {
+			in: ComposeInput{
+				Name: "api",
+				TaskDefinition: &awsecs.TaskDefinition{
+					ContainerDefinitions: []*sdkecs.ContainerDefinition{
+						{
+							Name: aws.String("api"),
+							DependsOn: []*sdkecs.ContainerDependency{
+								{ContainerName: aws.String("nginx"), Condition: aws.String("READY")},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `dependency of container api on nginx: unsupported condition "READY"`,
+		},
+		"converts the containers of the task definition": {
+			in: ComposeInput{
+				Name: "api",
+				TaskDefinition: &awsecs.TaskDefinition{
+					ContainerDefinitions: []*sdkecs.ContainerDefinition{
+						{
+							Name:    aws.String("api"),
+							Image:   aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/app/api:latest"),
+							Command: aws.StringSlice([]string{"serve", "--port", "8080"}),
+							PortMappings: []*sdkecs.PortMapping{
+								{ContainerPort: aws.Int64(8080), Protocol: aws.String("tcp")},
+							},
+							DependsOn: []*sdkecs.ContainerDependency{
+								{ContainerName: aws.String("migrate"), Condition: aws.String("SUCCESS")},
+								{ContainerName: aws.String("nginx"), Condition: aws.String("HEALTHY")},
+							},
+						},
+						{
+							Name:       aws.String("nginx"),
+							Image:      aws.String("public.ecr.aws/nginx/nginx:latest"),
+							EntryPoint: aws.StringSlice([]string{"/docker-entrypoint.sh"}),
+							PortMappings: []*sdkecs.PortMapping{
+								{ContainerPort: aws.Int64(80)},
+								{ContainerPort: aws.Int64(53), Protocol: aws.String("udp")},
+							},
+							HealthCheck: &sdkecs.HealthCheck{
+								Command:     aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"}),
+								Interval:    aws.Int64(10),
+								Retries:     aws.Int64(2),
+								StartPeriod: aws.Int64(0),
+								Timeout:     aws.Int64(5),
+							},
+						},
+						{
+							Name:  aws.String("migrate"),
+							Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/app/api:migrate-latest"),
+						},
+					},
+				},
+				EnvVars: map[string]map[string]string{
+					"api": {
+						"COPILOT_APPLICATION_NAME": "app",
+						"PRICE":                    "$5",
+					},
+				},
+				Secrets: map[string]map[string]string{
+					"api": {
+						"DB_PASSWORD": "/copilot/app/test/secrets/db_password",
+					},
+				},
+				BuildContexts: map[string]ContainerBuildContext{
+					"api": {
+						Context:    "/ws/api",
+						Dockerfile: "/ws/api/Dockerfile",
+					},
+					"migrate": {
+						Context:    "/ws",
+						Dockerfile: "/ws/migrate/Dockerfile",
+					},
+				},
+				Dir: "/ws/compose",
+			},
+			wanted: `name: api
+services:
+  api:
+    build:
+      context: ../api
+      dockerfile: Dockerfile
+    command:
+      - serve
+      - --port
+      - "8080"
+    environment:
+      COPILOT_APPLICATION_NAME: app
+      DB_PASSWORD: ${DB_PASSWORD:?set DB_PASSWORD to the value of the secret /copilot/app/test/secrets/db_password}
+      PRICE: $$5
+    network_mode: service:pause
+    depends_on:
+      migrate:
+        condition: service_completed_successfully
+      nginx:
+        condition: service_healthy
+      pause:
+        condition: service_started
+  migrate:
+    build:
+      context: ..
+      dockerfile: migrate/Dockerfile
+    network_mode: service:pause
+    depends_on:
+      pause:
+        condition: service_started
+  nginx:
+    image: public.ecr.aws/nginx/nginx:latest
+    entrypoint:
+      - /docker-entrypoint.sh
+    network_mode: service:pause
+    depends_on:
+      pause:
+        condition: service_started
+    healthcheck:
+      test:
+        - CMD-SHELL
+        - curl -f http://localhost/ || exit 1
+      interval: 10s
+      timeout: 5s
+      retries: 2
+      start_period: 0s
+  pause:
+    image: public.ecr.aws/amazonlinux/amazonlinux:2023
+    command:
+      - sleep
+      - infinity
+    ports:
+      - 8080:8080
+      - 80:80
+      - 53:53/udp
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateComposeFile(tc.in)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
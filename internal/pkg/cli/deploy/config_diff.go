@@ -0,0 +1,576 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	taskDefinitionResourceType = "AWS::ECS::TaskDefinition"
+	serviceResourceType        = "AWS::ECS::Service"
+	scalableTargetResourceType = "AWS::ApplicationAutoScaling::ScalableTarget"
+
+	unknownConfigValue = "(known after deployment)"
+)
+
+var subVariablePattern = regexp.MustCompile(`\$\{([^}]+)\}`) // e.g. ${AWS::Region} in !Sub 'arn:${AWS::Partition}:...'
+
+// taskConfig is the configuration of the task of a workload that its manifest controls.
+type taskConfig struct {
+	cpu        *configValue
+	memory     *configValue
+	count      *configValue // Desired count of a service, or "min-max" if it scales automatically. Nil for jobs.
+	containers []*containerConfig
+}
+
+type containerConfig struct {
+	name    string
+	envVars map[string]configValue
+	secrets map[string]configValue
+	ports   []string // e.g. "80/tcp".
+}
+
+// configValue is a value of the configuration of a task, which is unknown if it depends on the resources of the stack.
+type configValue struct {
+	value string
+	known bool
+}
+
+func knownConfigValue(value string) *configValue {
+	return &configValue{
+		value: value,
+		known: true,
+	}
+}
+
+func (v configValue) String() string {
+	if !v.known {
+		return unknownConfigValue
+	}
+	return v.value
+}
+
+// ConfigDiff returns the stringified changes of the environment variables, secrets, ports, containers and scaling
+// of the task of the workload from its live ECS task definition to the ones of the template.
+func (d *workloadDeployer) ConfigDiff(template, params string) (string, error) {
+	next, err := d.templateTaskConfig(template, params)
+	if err != nil {
+		return "", fmt.Errorf("resolve the task configuration of %q from its template: %w", d.name, err)
+	}
+	live, err := d.liveTaskConfig(next.count != nil)
+	if err != nil {
+		return "", err
+	}
+	return next.diff(live), nil
+}
+
+// VariablesDiff returns the environment variables and secrets that the template adds to, removes from, or changes in
+// the containers of the live ECS task definition of the workload, with the values of the secrets redacted.
+// It returns an empty string if the workload isn't deployed yet, or if none of them change.
+func (d *workloadDeployer) VariablesDiff(template, params string) (string, error) {
+	next, err := d.templateTaskConfig(template, params)
+	if err != nil {
+		return "", fmt.Errorf("resolve the task configuration of %q from its template: %w", d.name, err)
+	}
+	live, err := d.liveTaskConfig(next.count != nil)
+	if err != nil {
+		return "", err
+	}
+	return next.variablesDiff(live), nil
+}
+
+func (d *workloadDeployer) templateTaskConfig(template, params string) (*taskConfig, error) {
+	var serialized struct {
+		Parameters map[string]string `json:"Parameters"`
+	}
+	if err := json.Unmarshal([]byte(params), &serialized); err != nil {
+		return nil, fmt.Errorf("unmarshal the parameters of the template: %w", err)
+	}
+	pseudoParams, err := pseudoParameters(d.env.AccountID, d.env.Region)
+	if err != nil {
+		return nil, err
+	}
+	return taskConfigFromTemplate(template, serialized.Parameters, pseudoParams)
+}
+
+func (d *workloadDeployer) liveTaskConfig(isService bool) (*taskConfig, error) {
+	stackName := stack.NameForWorkload(d.app.Name, d.env.Name, d.name)
+	if _, err := d.tmplGetter.Template(stackName); err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return &taskConfig{}, nil
+		}
+		return nil, fmt.Errorf("retrieve the deployed template for %q: %w", d.name, err)
+	}
+	taskDefName := fmt.Sprintf("%s-%s-%s", d.app.Name, d.env.Name, d.name)
+	var count *configValue
+	if isService {
+		svc, err := d.ecsServiceGetter.Service(d.app.Name, d.env.Name, d.name)
+		if err != nil {
+			return nil, fmt.Errorf("get the ECS service of %q: %w", d.name, err)
+		}
+		taskDefName = aws.StringValue(svc.TaskDefinition)
+		svcARN, err := awsecs.ParseServiceArn(aws.StringValue(svc.ServiceArn))
+		if err != nil {
+			return nil, err
+		}
+		target, err := d.scalableTargetGetter.ECSServiceScalableTarget(svcARN.ClusterName(), svcARN.ServiceName())
+		if err != nil {
+			return nil, fmt.Errorf("get the range of the desired count of %q: %w", d.name, err)
+		}
+		count = knownConfigValue(strconv.FormatInt(aws.Int64Value(svc.DesiredCount), 10))
+		if target != nil {
+			count = knownConfigValue(fmt.Sprintf("%d-%d", target.MinCapacity, target.MaxCapacity))
+		}
+	}
+	taskDef, err := d.taskDefGetter.TaskDefinition(taskDefName)
+	if err != nil {
+		return nil, fmt.Errorf("get the task definition of %q: %w", d.name, err)
+	}
+	cfg := taskConfigFromTaskDefinition(taskDef)
+	cfg.count = count
+	return cfg, nil
+}
+
+// taskConfigFromTaskDefinition returns the configuration of a task running with the task definition.
+func taskConfigFromTaskDefinition(taskDef *awsecs.TaskDefinition) *taskConfig {
+	cfg := &taskConfig{
+		cpu:    knownConfigValue(aws.StringValue(taskDef.Cpu)),
+		memory: knownConfigValue(aws.StringValue(taskDef.Memory)),
+	}
+	for _, def := range taskDef.ContainerDefinitions {
+		container := &containerConfig{
+			name:    aws.StringValue(def.Name),
+			envVars: make(map[string]configValue),
+			secrets: make(map[string]configValue),
+		}
+		for _, env := range def.Environment {
+			container.envVars[aws.StringValue(env.Name)] = *knownConfigValue(aws.StringValue(env.Value))
+		}
+		for _, secret := range def.Secrets {
+			container.secrets[aws.StringValue(secret.Name)] = *knownConfigValue(aws.StringValue(secret.ValueFrom))
+		}
+		for _, mapping := range def.PortMappings {
+			container.ports = append(container.ports, portString(strconv.FormatInt(aws.Int64Value(mapping.ContainerPort), 10), aws.StringValue(mapping.Protocol)))
+		}
+		cfg.containers = append(cfg.containers, container)
+	}
+	return cfg
+}
+
+// taskConfigFromTemplate returns the configuration of the task that the stack of the template would run.
+// The values that depend on the resources of the stack, like the outputs of the addons, are unknown.
+func taskConfigFromTemplate(template string, params, pseudoParams map[string]string) (*taskConfig, error) {
+	root, err := parseTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	r := newTemplateResolver(root, params, pseudoParams)
+
+	cfg := &taskConfig{}
+	var taskDef, svc, scalableTarget *yaml.Node
+	resources := mappingValue(root, "Resources")
+	for i := 0; resources != nil && i+1 < len(resources.Content); i += 2 {
+		resource := resources.Content[i+1]
+		properties := mappingValue(resource, "Properties")
+		switch resourceType := mappingValue(resource, "Type"); {
+		case resourceType == nil:
+		case resourceType.Value == taskDefinitionResourceType && taskDef == nil:
+			taskDef = properties
+		case resourceType.Value == serviceResourceType && svc == nil:
+			svc = properties
+		case resourceType.Value == scalableTargetResourceType && scalableTarget == nil:
+			scalableTarget = properties
+		}
+	}
+	if taskDef == nil {
+		return nil, fmt.Errorf("the template doesn't have an %s resource", taskDefinitionResourceType)
+	}
+	cfg.cpu, cfg.memory = r.resolve(mappingValue(taskDef, "Cpu")), r.resolve(mappingValue(taskDef, "Memory"))
+	for _, def := range sequenceItems(mappingValue(taskDef, "ContainerDefinitions")) {
+		container := &containerConfig{
+			name:    r.resolve(mappingValue(def, "Name")).String(),
+			envVars: r.resolveNamedValues(mappingValue(def, "Environment"), "Value"),
+			secrets: r.resolveNamedValues(mappingValue(def, "Secrets"), "ValueFrom"),
+		}
+		for _, mapping := range sequenceItems(mappingValue(def, "PortMappings")) {
+			protocol := r.resolve(mappingValue(mapping, "Protocol"))
+			container.ports = append(container.ports, portString(r.resolve(mappingValue(mapping, "ContainerPort")).String(), protocol.value))
+		}
+		cfg.containers = append(cfg.containers, container)
+	}
+	switch {
+	case scalableTarget != nil:
+		min, max := r.resolve(mappingValue(scalableTarget, "MinCapacity")), r.resolve(mappingValue(scalableTarget, "MaxCapacity"))
+		cfg.count = &configValue{
+			value: fmt.Sprintf("%s-%s", min.value, max.value),
+			known: min.known && max.known,
+		}
+	case svc != nil:
+		cfg.count = r.resolve(mappingValue(svc, "DesiredCount"))
+	}
+	return cfg, nil
+}
+
+func portString(port, protocol string) string {
+	if protocol == "" {
+		protocol = "tcp" // The default protocol of the port mappings of ECS.
+	}
+	return fmt.Sprintf("%s/%s", port, strings.ToLower(protocol))
+}
+
+// parseTemplate returns the root node of a CloudFormation template.
+func parseTemplate(template string) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(template), &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal the template: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, errors.New("the template is empty")
+	}
+	return doc.Content[0], nil
+}
+
+// templateResolver resolves the values of a CloudFormation template that only depend on its parameters.
+type templateResolver struct {
+	params     map[string]string // Values of the parameters and pseudo parameters by name.
+	conditions *yaml.Node        // Conditions section of the template.
+}
+
+// newTemplateResolver returns a resolver of the template with the values of its parameters, which default to the ones of the template.
+func newTemplateResolver(root *yaml.Node, params, pseudoParams map[string]string) *templateResolver {
+	r := &templateResolver{
+		params:     make(map[string]string),
+		conditions: mappingValue(root, "Conditions"),
+	}
+	paramsNode := mappingValue(root, "Parameters")
+	for i := 0; paramsNode != nil && i+1 < len(paramsNode.Content); i += 2 {
+		if def := mappingValue(paramsNode.Content[i+1], "Default"); def != nil && def.Kind == yaml.ScalarNode {
+			r.params[paramsNode.Content[i].Value] = def.Value
+		}
+	}
+	for _, values := range []map[string]string{params, pseudoParams} {
+		for name, value := range values {
+			r.params[name] = value
+		}
+	}
+	return r
+}
+
+// resolveNamedValues resolves the values of a list of objects with a "Name" and a value field, like the environment variables of a container.
+func (r *templateResolver) resolveNamedValues(list *yaml.Node, valueField string) map[string]configValue {
+	values := make(map[string]configValue)
+	for _, item := range sequenceItems(list) {
+		name := r.resolve(mappingValue(item, "Name"))
+		if !name.known {
+			continue
+		}
+		values[name.value] = *r.resolve(mappingValue(item, valueField))
+	}
+	return values
+}
+
+// resolve returns the value of the node, which is unknown if it calls an intrinsic function other than Ref, Fn::Sub and Fn::Join
+// or refers to anything else than a parameter.
+func (r *templateResolver) resolve(n *yaml.Node) *configValue {
+	if n == nil {
+		return &configValue{}
+	}
+	switch {
+	case n.Kind == yaml.ScalarNode && n.Tag == "!Ref":
+		return r.ref(n.Value)
+	case n.Kind == yaml.ScalarNode && n.Tag == "!Sub":
+		return r.sub(n.Value)
+	case n.Kind == yaml.SequenceNode && n.Tag == "!Join":
+		return r.join(n)
+	case n.Kind == yaml.ScalarNode && (n.Tag == "" || strings.HasPrefix(n.Tag, "!!")):
+		return knownConfigValue(n.Value)
+	case n.Kind == yaml.MappingNode && len(n.Content) == 2:
+		fn, arg := n.Content[0].Value, n.Content[1]
+		switch {
+		case fn == "Ref" && arg.Kind == yaml.ScalarNode:
+			return r.ref(arg.Value)
+		case fn == "Fn::Sub" && arg.Kind == yaml.ScalarNode:
+			return r.sub(arg.Value)
+		case fn == "Fn::Join":
+			return r.join(arg)
+		}
+	}
+	return &configValue{}
+}
+
+// condition evaluates the condition of the template with the name. It isn't known if it depends on anything else than parameters.
+func (r *templateResolver) condition(name string) (value, known bool) {
+	return r.evaluate(mappingValue(r.conditions, name))
+}
+
+// evaluate evaluates a condition function, one of Fn::Equals, Fn::Not, Fn::And, Fn::Or and Condition.
+func (r *templateResolver) evaluate(n *yaml.Node) (value, known bool) {
+	if n == nil {
+		return false, false
+	}
+	fn, arg := strings.TrimPrefix(n.Tag, "!"), n
+	if n.Kind == yaml.MappingNode && len(n.Content) == 2 {
+		fn, arg = strings.TrimPrefix(n.Content[0].Value, "Fn::"), n.Content[1]
+	}
+	if fn == "Condition" {
+		if arg.Kind != yaml.ScalarNode {
+			return false, false
+		}
+		return r.condition(arg.Value)
+	}
+	if arg.Kind != yaml.SequenceNode {
+		return false, false
+	}
+	args := arg.Content
+	switch fn {
+	case "Equals":
+		if len(args) != 2 {
+			return false, false
+		}
+		a, b := r.resolve(args[0]), r.resolve(args[1])
+		return a.value == b.value, a.known && b.known
+	case "Not":
+		if len(args) != 1 {
+			return false, false
+		}
+		v, ok := r.evaluate(args[0])
+		return !v, ok
+	case "And", "Or":
+		// The result is known as soon as a condition short-circuits it, even if others are unknown.
+		shortCircuit := fn == "Or"
+		known = true
+		for _, item := range args {
+			v, ok := r.evaluate(item)
+			if ok && v == shortCircuit {
+				return shortCircuit, true
+			}
+			known = known && ok
+		}
+		return !shortCircuit, known
+	}
+	return false, false
+}
+
+func (r *templateResolver) ref(name string) *configValue {
+	value, ok := r.params[name]
+	return &configValue{
+		value: value,
+		known: ok,
+	}
+}
+
+func (r *templateResolver) sub(s string) *configValue {
+	known := true
+	value := subVariablePattern.ReplaceAllStringFunc(s, func(match string) string {
+		name := match[2 : len(match)-1]
+		if strings.HasPrefix(name, "!") {
+			return "${" + name[1:] + "}" // ${!Literal} is written as ${Literal}.
+		}
+		v := r.ref(name)
+		known = known && v.known
+		return v.value
+	})
+	return &configValue{
+		value: value,
+		known: known,
+	}
+}
+
+func (r *templateResolver) join(n *yaml.Node) *configValue {
+	args := sequenceItems(n)
+	if len(args) != 2 || args[0].Kind != yaml.ScalarNode {
+		return &configValue{}
+	}
+	var parts []string
+	for _, item := range sequenceItems(args[1]) {
+		v := r.resolve(item)
+		if !v.known {
+			return &configValue{}
+		}
+		parts = append(parts, v.value)
+	}
+	return knownConfigValue(strings.Join(parts, args[0].Value))
+}
+
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// sequenceItems returns the items of a list that are objects or values, skipping the ones that call intrinsic functions like !If.
+func sequenceItems(n *yaml.Node) []*yaml.Node {
+	if n == nil || n.Kind != yaml.SequenceNode || n.Tag == "!If" {
+		return nil
+	}
+	return n.Content
+}
+
+// diff returns the changes from the deployed configuration to c, or "No configuration changes." if there are none.
+func (c *taskConfig) diff(deployed *taskConfig) string {
+	var b strings.Builder
+	writeValueDiff(&b, "", "CPU", deployed.cpu, c.cpu)
+	writeValueDiff(&b, "", "Memory", deployed.memory, c.memory)
+	writeValueDiff(&b, "", "Count", deployed.count, c.count)
+	deployedContainers := make(map[string]*containerConfig)
+	for _, container := range deployed.containers {
+		deployedContainers[container.name] = container
+	}
+	containers := make(map[string]bool)
+	for _, container := range c.containers {
+		containers[container.name] = true
+		prev, ok := deployedContainers[container.name]
+		if !ok {
+			fmt.Fprintf(&b, "+ Container %s:\n", container.name)
+			container.writeDiff(&b, &containerConfig{})
+			continue
+		}
+		var changes strings.Builder
+		container.writeDiff(&changes, prev)
+		if changes.Len() > 0 {
+			fmt.Fprintf(&b, "  Container %s:\n%s", container.name, changes.String())
+		}
+	}
+	for _, container := range deployed.containers {
+		if !containers[container.name] {
+			fmt.Fprintf(&b, "- Container %s\n", container.name)
+		}
+	}
+	if b.Len() == 0 {
+		return "No configuration changes.\n"
+	}
+	return b.String()
+}
+
+// variablesDiff returns the changes of the environment variables and secrets of the containers that are both deployed and in c.
+func (c *taskConfig) variablesDiff(deployed *taskConfig) string {
+	deployedContainers := make(map[string]*containerConfig)
+	for _, container := range deployed.containers {
+		deployedContainers[container.name] = container
+	}
+	var b strings.Builder
+	for _, container := range c.containers {
+		prev, ok := deployedContainers[container.name]
+		if !ok {
+			continue
+		}
+		var changes strings.Builder
+		writeValuesDiff(&changes, "Environment variables", prev.envVars, container.envVars, false)
+		writeValuesDiff(&changes, "Secrets", prev.secrets, container.secrets, true)
+		if changes.Len() > 0 {
+			fmt.Fprintf(&b, "  Container %s:\n%s", container.name, changes.String())
+		}
+	}
+	return b.String()
+}
+
+func (c *containerConfig) writeDiff(b *strings.Builder, deployed *containerConfig) {
+	writeValuesDiff(b, "Environment variables", deployed.envVars, c.envVars, false)
+	writeValuesDiff(b, "Secrets", deployed.secrets, c.secrets, false)
+	var ports strings.Builder
+	for _, port := range missingStrings(c.ports, deployed.ports) {
+		fmt.Fprintf(&ports, "      + %s\n", port)
+	}
+	for _, port := range missingStrings(deployed.ports, c.ports) {
+		fmt.Fprintf(&ports, "      - %s\n", port)
+	}
+	if ports.Len() > 0 {
+		fmt.Fprintf(b, "    Ports:\n%s", ports.String())
+	}
+}
+
+// writeValuesDiff writes the changes of named values, with only their names if they are redacted.
+func writeValuesDiff(b *strings.Builder, title string, deployed, next map[string]configValue, redacted bool) {
+	names := make(map[string]bool)
+	for name := range deployed {
+		names[name] = true
+	}
+	for name := range next {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	var changes strings.Builder
+	for _, name := range sorted {
+		var prev, curr *configValue
+		if v, ok := deployed[name]; ok {
+			prev = &v
+		}
+		if v, ok := next[name]; ok {
+			curr = &v
+		}
+		if redacted {
+			writeRedactedValueDiff(&changes, "      ", name, prev, curr)
+			continue
+		}
+		writeValueDiff(&changes, "      ", name, prev, curr)
+	}
+	if changes.Len() > 0 {
+		fmt.Fprintf(b, "    %s:\n%s", title, changes.String())
+	}
+}
+
+// writeValueDiff writes the change of a value, unless its new value is unknown since it may not change.
+func writeValueDiff(b *strings.Builder, indent, name string, deployed, next *configValue) {
+	switch {
+	case deployed == nil && next == nil:
+	case deployed == nil:
+		fmt.Fprintf(b, "%s+ %s: %s\n", indent, name, next)
+	case next == nil:
+		fmt.Fprintf(b, "%s- %s: %s\n", indent, name, deployed)
+	case next.known && deployed.value != next.value:
+		fmt.Fprintf(b, "%s~ %s: %s -> %s\n", indent, name, deployed, next)
+	}
+}
+
+// writeRedactedValueDiff writes the change of a value like writeValueDiff, without the value.
+func writeRedactedValueDiff(b *strings.Builder, indent, name string, deployed, next *configValue) {
+	switch {
+	case deployed == nil && next == nil:
+	case deployed == nil:
+		fmt.Fprintf(b, "%s+ %s\n", indent, name)
+	case next == nil:
+		fmt.Fprintf(b, "%s- %s\n", indent, name)
+	case next.known && deployed.value != next.value:
+		fmt.Fprintf(b, "%s~ %s (value redacted)\n", indent, name)
+	}
+}
+
+// missingStrings returns the elements of a that aren't in b.
+func missingStrings(a, b []string) []string {
+	in := make(map[string]bool)
+	for _, s := range b {
+		in[s] = true
+	}
+	var missing []string
+	for _, s := range a {
+		if !in[s] {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,561 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigDiffTemplate = `Parameters:
+  EnvName:
+    Type: String
+  ContainerPort:
+    Type: Number
+    Default: 80
+Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: !Ref TaskCPU
+      Memory: 1024
+      ContainerDefinitions:
+        - Name: frontend
+          Environment:
+            - Name: COPILOT_ENVIRONMENT_NAME
+              Value: !Ref EnvName
+            - Name: LOG_LEVEL
+              Value: debug
+            - Name: QUEUE_URL
+              Value: !GetAtt Queue.QueueUrl
+            - Name: TABLE_ARN
+              Value: !Sub 'arn:${AWS::Partition}:dynamodb:${AWS::Region}:${AWS::AccountId}:table/${EnvName}-${!Name}'
+          Secrets:
+            - Name: DB_PASSWORD
+              ValueFrom: !Join ['', ['/copilot/', !Ref EnvName, '/db']]
+          PortMappings:
+            - ContainerPort: !Ref ContainerPort
+        - Name: nginx
+          PortMappings:
+            - ContainerPort: 443
+              Protocol: TCP
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: !Ref TaskCount
+  DynamicDesiredCountAction:
+    Type: Custom::DynamicDesiredCountFunction
+`
+
+func TestTaskConfigFromTemplate(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+		wanted     *taskConfig
+		wantedErr  string
+	}{
+		"error if the template doesn't have a task definition": {
+			inTemplate: `Resources:
+  Distribution:
+    Type: AWS::CloudFront::Distribution`,
+			wantedErr: "the template doesn't have an AWS::ECS::TaskDefinition resource",
+		},
+		"resolves the values that only depend on the parameters": {
+			inTemplate: testConfigDiffTemplate,
+			wanted: &taskConfig{
+				cpu:    knownConfigValue("256"),
+				memory: knownConfigValue("1024"),
+				count:  knownConfigValue("1"),
+				containers: []*containerConfig{
+					{
+						name: "frontend",
+						envVars: map[string]configValue{
+							"COPILOT_ENVIRONMENT_NAME": *knownConfigValue("test"),
+							"LOG_LEVEL":                *knownConfigValue("debug"),
+							"QUEUE_URL":                {},
+							"TABLE_ARN":                *knownConfigValue("arn:aws:dynamodb:us-west-2:123456789012:table/test-${Name}"),
+						},
+						secrets: map[string]configValue{
+							"DB_PASSWORD": *knownConfigValue("/copilot/test/db"),
+						},
+						ports: []string{"80/tcp"},
+					},
+					{
+						name:    "nginx",
+						envVars: map[string]configValue{},
+						secrets: map[string]configValue{},
+						ports:   []string{"443/tcp"},
+					},
+				},
+			},
+		},
+		"uses the range of the scalable target as the count": {
+			inTemplate: `Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+      Memory: 512
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: !GetAtt DynamicDesiredCountAction.DesiredCount
+  AutoScalingTarget:
+    Type: AWS::ApplicationAutoScaling::ScalableTarget
+    Properties:
+      MinCapacity: 1
+      MaxCapacity: 10`,
+			wanted: &taskConfig{
+				cpu:    knownConfigValue("256"),
+				memory: knownConfigValue("512"),
+				count:  knownConfigValue("1-10"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := taskConfigFromTemplate(tc.inTemplate, map[string]string{
+				"EnvName":   "test",
+				"TaskCPU":   "256",
+				"TaskCount": "1",
+			}, map[string]string{
+				"AWS::AccountId": "123456789012",
+				"AWS::Partition": "aws",
+				"AWS::Region":    "us-west-2",
+			})
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestTaskConfig_diff(t *testing.T) {
+	testCases := map[string]struct {
+		deployed *taskConfig
+		next     *taskConfig
+		wanted   string
+	}{
+		"no changes": {
+			deployed: &taskConfig{
+				cpu: knownConfigValue("256"),
+				containers: []*containerConfig{
+					{
+						name:    "frontend",
+						envVars: map[string]configValue{"LOG_LEVEL": *knownConfigValue("debug")},
+						ports:   []string{"80/tcp"},
+					},
+				},
+			},
+			next: &taskConfig{
+				cpu: knownConfigValue("256"),
+				containers: []*containerConfig{
+					{
+						name:    "frontend",
+						envVars: map[string]configValue{"LOG_LEVEL": *knownConfigValue("debug")},
+						ports:   []string{"80/tcp"},
+					},
+				},
+			},
+			wanted: "No configuration changes.\n",
+		},
+		"unknown values don't change the deployed ones": {
+			deployed: &taskConfig{
+				containers: []*containerConfig{
+					{
+						name:    "frontend",
+						envVars: map[string]configValue{"QUEUE_URL": *knownConfigValue("https://sqs")},
+					},
+				},
+			},
+			next: &taskConfig{
+				containers: []*containerConfig{
+					{
+						name:    "frontend",
+						envVars: map[string]configValue{"QUEUE_URL": {}},
+					},
+				},
+			},
+			wanted: "No configuration changes.\n",
+		},
+		"writes the changes of the task and its containers": {
+			deployed: &taskConfig{
+				cpu:    knownConfigValue("256"),
+				memory: knownConfigValue("512"),
+				count:  knownConfigValue("1"),
+				containers: []*containerConfig{
+					{
+						name: "frontend",
+						envVars: map[string]configValue{
+							"LOG_LEVEL": *knownConfigValue("debug"),
+							"OLD":       *knownConfigValue("value"),
+						},
+						secrets: map[string]configValue{
+							"DB_PASSWORD": *knownConfigValue("/copilot/test/db"),
+						},
+						ports: []string{"80/tcp"},
+					},
+					{
+						name: "envoy",
+					},
+				},
+			},
+			next: &taskConfig{
+				cpu:    knownConfigValue("512"),
+				memory: knownConfigValue("512"),
+				count:  knownConfigValue("1-10"),
+				containers: []*containerConfig{
+					{
+						name: "frontend",
+						envVars: map[string]configValue{
+							"LOG_LEVEL": *knownConfigValue("info"),
+							"QUEUE_URL": {},
+						},
+						secrets: map[string]configValue{
+							"DB_PASSWORD": *knownConfigValue("/copilot/test/db"),
+						},
+						ports: []string{"8080/tcp"},
+					},
+					{
+						name:    "nginx",
+						secrets: map[string]configValue{"CERT": *knownConfigValue("arn:aws:secretsmanager:cert")},
+						ports:   []string{"443/tcp"},
+					},
+				},
+			},
+			wanted: `~ CPU: 256 -> 512
+~ Count: 1 -> 1-10
+  Container frontend:
+    Environment variables:
+      ~ LOG_LEVEL: debug -> info
+      - OLD: value
+      + QUEUE_URL: (known after deployment)
+    Ports:
+      + 8080/tcp
+      - 80/tcp
++ Container nginx:
+    Secrets:
+      + CERT: arn:aws:secretsmanager:cert
+    Ports:
+      + 443/tcp
+- Container envoy
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.next.diff(tc.deployed))
+		})
+	}
+}
+
+func TestTaskConfig_variablesDiff(t *testing.T) {
+	testCases := map[string]struct {
+		deployed *taskConfig
+		next     *taskConfig
+		wanted   string
+	}{
+		"no changes if the workload isn't deployed": {
+			deployed: &taskConfig{},
+			next: &taskConfig{
+				containers: []*containerConfig{
+					{
+						name:    "frontend",
+						envVars: map[string]configValue{"LOG_LEVEL": *knownConfigValue("debug")},
+					},
+				},
+			},
+		},
+		"writes the changes of the variables of the containers, and redacts the secrets": {
+			deployed: &taskConfig{
+				cpu: knownConfigValue("256"),
+				containers: []*containerConfig{
+					{
+						name: "frontend",
+						envVars: map[string]configValue{
+							"DB_HOST":   *knownConfigValue("db.local"),
+							"LOG_LEVEL": *knownConfigValue("debug"),
+							"QUEUE_URL": *knownConfigValue("https://sqs"),
+						},
+						secrets: map[string]configValue{
+							"API_KEY":     *knownConfigValue("/copilot/test/api-key"),
+							"DB_PASSWORD": *knownConfigValue("/copilot/test/db"),
+						},
+					},
+					{
+						name:    "envoy",
+						envVars: map[string]configValue{"PORT": *knownConfigValue("9901")},
+					},
+				},
+			},
+			next: &taskConfig{
+				cpu: knownConfigValue("512"),
+				containers: []*containerConfig{
+					{
+						name: "frontend",
+						envVars: map[string]configValue{
+							"LOG_LEVEL": *knownConfigValue("info"),
+							"QUEUE_URL": {},
+						},
+						secrets: map[string]configValue{
+							"DB_PASSWORD": *knownConfigValue("/copilot/test/db-v2"),
+							"TOKEN":       *knownConfigValue("/copilot/test/token"),
+						},
+					},
+					{
+						name:    "nginx",
+						envVars: map[string]configValue{"PORT": *knownConfigValue("443")},
+					},
+				},
+			},
+			wanted: `  Container frontend:
+    Environment variables:
+      - DB_HOST: db.local
+      ~ LOG_LEVEL: debug -> info
+    Secrets:
+      - API_KEY
+      ~ DB_PASSWORD (value redacted)
+      + TOKEN
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.next.variablesDiff(tc.deployed))
+		})
+	}
+}
+
+type configDiffMocks struct {
+	tmplGetter           *mocks.MockdeployedTemplateGetter
+	ecsServiceGetter     *mocks.MockecsServiceGetter
+	taskDefGetter        *mocks.MocktaskDefinitionGetter
+	scalableTargetGetter *mocks.MockscalableTargetGetter
+}
+
+func TestWorkloadDeployer_ConfigDiff(t *testing.T) {
+	const (
+		mockParams  = `{"Parameters":{"EnvName":"mockEnv","TaskCPU":"256","TaskCount":"1"}}`
+		mockSvcARN  = "arn:aws:ecs:us-west-2:123456789012:service/mockApp-mockEnv-Cluster/mockApp-mockEnv-mockSvc-Service"
+		mockTaskDef = "arn:aws:ecs:us-west-2:123456789012:task-definition/mockApp-mockEnv-mockSvc:3"
+	)
+	mockTaskDefinition := &ecs.TaskDefinition{
+		Cpu:    aws.String("256"),
+		Memory: aws.String("1024"),
+		ContainerDefinitions: []*sdkecs.ContainerDefinition{
+			{
+				Name: aws.String("frontend"),
+				Environment: []*sdkecs.KeyValuePair{
+					{Name: aws.String("COPILOT_ENVIRONMENT_NAME"), Value: aws.String("mockEnv")},
+					{Name: aws.String("LOG_LEVEL"), Value: aws.String("info")},
+					{Name: aws.String("QUEUE_URL"), Value: aws.String("https://sqs.us-west-2.amazonaws.com/123456789012/queue")},
+					{Name: aws.String("TABLE_ARN"), Value: aws.String("arn:aws:dynamodb:us-west-2:123456789012:table/mockEnv-${Name}")},
+				},
+				Secrets: []*sdkecs.Secret{
+					{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("/copilot/mockEnv/db")},
+				},
+				PortMappings: []*sdkecs.PortMapping{
+					{ContainerPort: aws.Int64(80), Protocol: aws.String("tcp")},
+				},
+			},
+			{
+				Name: aws.String("nginx"),
+				PortMappings: []*sdkecs.PortMapping{
+					{ContainerPort: aws.Int64(443), Protocol: aws.String("tcp")},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inTemplate string
+		setUpMocks func(m *configDiffMocks)
+		wanted     string
+		wantedErr  string
+	}{
+		"error if the template can't be resolved": {
+			inTemplate: `Resources: {}`,
+			setUpMocks: func(m *configDiffMocks) {},
+			wantedErr:  `resolve the task configuration of "mockSvc" from its template: the template doesn't have an AWS::ECS::TaskDefinition resource`,
+		},
+		"error getting the deployed template": {
+			inTemplate: testConfigDiffTemplate,
+			setUpMocks: func(m *configDiffMocks) {
+				m.tmplGetter.EXPECT().Template(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).Return("", errors.New("some error"))
+			},
+			wantedErr: `retrieve the deployed template for "mockSvc": some error`,
+		},
+		"everything is added if the workload isn't deployed": {
+			inTemplate: `Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+      Memory: 512
+      ContainerDefinitions:
+        - Name: mockSvc
+          Environment:
+            - Name: LOG_LEVEL
+              Value: debug`,
+			setUpMocks: func(m *configDiffMocks) {
+				m.tmplGetter.EXPECT().Template(gomock.Any()).Return("", &cloudformation.ErrStackNotFound{})
+			},
+			wanted: `+ CPU: 256
++ Memory: 512
++ Container mockSvc:
+    Environment variables:
+      + LOG_LEVEL: debug
+`,
+		},
+		"error getting the ECS service": {
+			inTemplate: testConfigDiffTemplate,
+			setUpMocks: func(m *configDiffMocks) {
+				m.tmplGetter.EXPECT().Template(gomock.Any()).Return("template", nil)
+				m.ecsServiceGetter.EXPECT().Service("mockApp", "mockEnv", "mockSvc").Return(nil, errors.New("some error"))
+			},
+			wantedErr: `get the ECS service of "mockSvc": some error`,
+		},
+		"error getting the scalable target": {
+			inTemplate: testConfigDiffTemplate,
+			setUpMocks: func(m *configDiffMocks) {
+				m.tmplGetter.EXPECT().Template(gomock.Any()).Return("template", nil)
+				m.ecsServiceGetter.EXPECT().Service("mockApp", "mockEnv", "mockSvc").Return(&ecs.Service{
+					ServiceArn:     aws.String(mockSvcARN),
+					TaskDefinition: aws.String(mockTaskDef),
+				}, nil)
+				m.scalableTargetGetter.EXPECT().ECSServiceScalableTarget("mockApp-mockEnv-Cluster", "mockApp-mockEnv-mockSvc-Service").Return(nil, errors.New("some error"))
+			},
+			wantedErr: `get the range of the desired count of "mockSvc": some error`,
+		},
+		"error getting the task definition": {
+			inTemplate: testConfigDiffTemplate,
+			setUpMocks: func(m *configDiffMocks) {
+				m.tmplGetter.EXPECT().Template(gomock.Any()).Return("template", nil)
+				m.ecsServiceGetter.EXPECT().Service("mockApp", "mockEnv", "mockSvc").Return(&ecs.Service{
+					ServiceArn:     aws.String(mockSvcARN),
+					TaskDefinition: aws.String(mockTaskDef),
+				}, nil)
+				m.scalableTargetGetter.EXPECT().ECSServiceScalableTarget(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition(mockTaskDef).Return(nil, errors.New("some error"))
+			},
+			wantedErr: `get the task definition of "mockSvc": some error`,
+		},
+		"diffs against the running task definition of the service": {
+			inTemplate: testConfigDiffTemplate,
+			setUpMocks: func(m *configDiffMocks) {
+				m.tmplGetter.EXPECT().Template(gomock.Any()).Return("template", nil)
+				m.ecsServiceGetter.EXPECT().Service("mockApp", "mockEnv", "mockSvc").Return(&ecs.Service{
+					ServiceArn:     aws.String(mockSvcARN),
+					TaskDefinition: aws.String(mockTaskDef),
+					DesiredCount:   aws.Int64(2),
+				}, nil)
+				m.scalableTargetGetter.EXPECT().ECSServiceScalableTarget(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition(mockTaskDef).Return(mockTaskDefinition, nil)
+			},
+			wanted: `~ Count: 2 -> 1
+  Container frontend:
+    Environment variables:
+      ~ LOG_LEVEL: info -> debug
+`,
+		},
+		"diffs against the range of the desired count of the service": {
+			inTemplate: testConfigDiffTemplate,
+			setUpMocks: func(m *configDiffMocks) {
+				m.tmplGetter.EXPECT().Template(gomock.Any()).Return("template", nil)
+				m.ecsServiceGetter.EXPECT().Service("mockApp", "mockEnv", "mockSvc").Return(&ecs.Service{
+					ServiceArn:     aws.String(mockSvcARN),
+					TaskDefinition: aws.String(mockTaskDef),
+					DesiredCount:   aws.Int64(1),
+				}, nil)
+				m.scalableTargetGetter.EXPECT().ECSServiceScalableTarget(gomock.Any(), gomock.Any()).Return(&aas.ScalableTarget{
+					MinCapacity: 1,
+					MaxCapacity: 4,
+				}, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition(mockTaskDef).Return(mockTaskDefinition, nil)
+			},
+			wanted: `~ Count: 1-4 -> 1
+  Container frontend:
+    Environment variables:
+      ~ LOG_LEVEL: info -> debug
+`,
+		},
+		"diffs against the latest task definition of a job": {
+			inTemplate: `Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+      Memory: 1024
+      ContainerDefinitions:
+        - Name: frontend
+          Environment:
+            - Name: COPILOT_ENVIRONMENT_NAME
+              Value: !Ref EnvName`,
+			setUpMocks: func(m *configDiffMocks) {
+				m.tmplGetter.EXPECT().Template(gomock.Any()).Return("template", nil)
+				m.taskDefGetter.EXPECT().TaskDefinition("mockApp-mockEnv-mockSvc").Return(mockTaskDefinition, nil)
+			},
+			wanted: `  Container frontend:
+    Environment variables:
+      - LOG_LEVEL: info
+      - QUEUE_URL: https://sqs.us-west-2.amazonaws.com/123456789012/queue
+      - TABLE_ARN: arn:aws:dynamodb:us-west-2:123456789012:table/mockEnv-${Name}
+    Secrets:
+      - DB_PASSWORD: /copilot/mockEnv/db
+    Ports:
+      - 80/tcp
+- Container nginx
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &configDiffMocks{
+				tmplGetter:           mocks.NewMockdeployedTemplateGetter(ctrl),
+				ecsServiceGetter:     mocks.NewMockecsServiceGetter(ctrl),
+				taskDefGetter:        mocks.NewMocktaskDefinitionGetter(ctrl),
+				scalableTargetGetter: mocks.NewMockscalableTargetGetter(ctrl),
+			}
+			tc.setUpMocks(m)
+			deployer := workloadDeployer{
+				name: "mockSvc",
+				app: &config.Application{
+					Name: "mockApp",
+				},
+				env: &config.Environment{
+					Name:      "mockEnv",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				},
+				tmplGetter:           m.tmplGetter,
+				ecsServiceGetter:     m.ecsServiceGetter,
+				taskDefGetter:        m.taskDefGetter,
+				scalableTargetGetter: m.scalableTargetGetter,
+			}
+
+			got, err := deployer.ConfigDiff(tc.inTemplate, mockParams)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
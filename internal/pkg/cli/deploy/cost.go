@@ -0,0 +1,449 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	hoursPerMonth = 730 // The number of hours in a month that AWS uses for its pricing examples.
+
+	natGatewayResourceType   = "AWS::EC2::NatGateway"
+	loadBalancerResourceType = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+	nestedStackResourceType  = "AWS::CloudFormation::Stack"
+
+	// Service codes and product families of the AWS Price List Query API.
+	ecsServiceCode        = "AmazonECS"
+	ec2ServiceCode        = "AmazonEC2"
+	elbServiceCode        = "AWSELB"
+	natGatewayFamily      = "NAT Gateway"
+	applicationLBFamily   = "Load Balancer-Application"
+	networkLBFamily       = "Load Balancer-Network"
+	productFamilyAttibute = "productFamily"
+
+	// Suffixes of the usage types of the products, which are prefixed by the region, e.g. "USW2-Fargate-GB-Hours".
+	fargateVCPUUsage    = "Fargate-vCPU-Hours:perCPU"
+	fargateGBUsage      = "Fargate-GB-Hours"
+	fargateARMVCPUUsage = "Fargate-ARM-vCPU-Hours:perCPU"
+	fargateARMGBUsage   = "Fargate-ARM-GB-Hours"
+	natGatewayUsage     = "NatGateway-Hours"
+	loadBalancerUsage   = "LoadBalancerUsage"
+)
+
+// usageBasedResourceTypes are the types of the resources that are billed by usage, whose cost can't be estimated from a template.
+var usageBasedResourceTypes = map[string]bool{
+	"AWS::S3::Bucket":                  true,
+	"AWS::DynamoDB::Table":             true,
+	"AWS::SQS::Queue":                  true,
+	"AWS::SNS::Topic":                  true,
+	"AWS::RDS::DBCluster":              true,
+	"AWS::EFS::FileSystem":             true,
+	"AWS::CloudFront::Distribution":    true,
+	"AWS::Logs::LogGroup":              true,
+	"AWS::AppRunner::Service":          true,
+	"AWS::Lambda::Function":            true,
+	"AWS::StepFunctions::StateMachine": true,
+}
+
+// monthlyCost is the range of the monthly cost of a resource in USD, which depends on its scaling.
+type monthlyCost struct {
+	min float64
+	max float64
+}
+
+func (c monthlyCost) add(other monthlyCost) monthlyCost {
+	return monthlyCost{
+		min: c.min + other.min,
+		max: c.max + other.max,
+	}
+}
+
+func (c monthlyCost) String() string {
+	if c.min == c.max {
+		return dollars(c.min, false)
+	}
+	return fmt.Sprintf("%s to %s", dollars(c.min, false), dollars(c.max, false))
+}
+
+// delta returns the stringified change of the cost from the deployed cost, e.g. "+$9.01".
+func (c monthlyCost) delta(deployed monthlyCost) string {
+	min, max := c.min-deployed.min, c.max-deployed.max
+	if min == max {
+		return dollars(min, true)
+	}
+	return fmt.Sprintf("%s to %s", dollars(min, true), dollars(max, true))
+}
+
+func dollars(amount float64, signed bool) string {
+	rounded := math.Round(amount*100) / 100
+	switch {
+	case !signed || rounded == 0:
+		return fmt.Sprintf("$%.2f", math.Abs(rounded))
+	case rounded > 0:
+		return fmt.Sprintf("+$%.2f", rounded)
+	default:
+		return fmt.Sprintf("-$%.2f", -rounded)
+	}
+}
+
+// resourceCost is the estimated monthly cost of a resource of a stack.
+type resourceCost struct {
+	description string
+	cost        monthlyCost
+}
+
+// templateCost is the estimated monthly cost of the resources of a template.
+type templateCost struct {
+	resources    map[string]*resourceCost // Keyed by logical ID.
+	notEstimated []string                 // Resources whose cost isn't estimated, with the reason.
+}
+
+func (c *templateCost) total() monthlyCost {
+	var total monthlyCost
+	for _, resource := range c.resources {
+		total = total.add(resource.cost)
+	}
+	return total
+}
+
+// costEstimator estimates the monthly cost of the resources of CloudFormation templates with the On-Demand prices of a region.
+type costEstimator struct {
+	pricing productsGetter
+	region  string
+
+	products map[string][]pricing.Product // Cached products keyed by service code and product family.
+}
+
+func newCostEstimator(prices productsGetter, region string) *costEstimator {
+	return &costEstimator{
+		pricing:  prices,
+		region:   region,
+		products: make(map[string][]pricing.Product),
+	}
+}
+
+// EstimateCost returns the estimated monthly cost of the resources of the template of the workload,
+// compared with the ones of its deployed stack.
+func (d *workloadDeployer) EstimateCost(template, params string) (string, error) {
+	pseudoParams, err := pseudoParameters(d.env.AccountID, d.env.Region)
+	if err != nil {
+		return "", err
+	}
+	return estimateCost(&costEstimateInput{
+		estimator:    newCostEstimator(d.pricing, d.env.Region),
+		tmplGetter:   d.tmplGetter,
+		paramsGetter: d.stackParamsGetter,
+		stackName:    cfnstack.NameForWorkload(d.app.Name, d.env.Name, d.name),
+		template:     template,
+		params:       params,
+		pseudoParams: pseudoParams,
+	})
+}
+
+// EstimateCost returns the estimated monthly cost of the resources of the template of the environment,
+// compared with the ones of its deployed stack.
+func (d *envDeployer) EstimateCost(template, params string) (string, error) {
+	pseudoParams, err := pseudoParameters(d.env.AccountID, d.env.Region)
+	if err != nil {
+		return "", err
+	}
+	return estimateCost(&costEstimateInput{
+		estimator:    newCostEstimator(d.pricing, d.env.Region),
+		tmplGetter:   d.tmplGetter,
+		paramsGetter: d.stackParamsGetter,
+		stackName:    cfnstack.NameForEnv(d.app.Name, d.env.Name),
+		template:     template,
+		params:       params,
+		pseudoParams: pseudoParams,
+	})
+}
+
+type costEstimateInput struct {
+	estimator    *costEstimator
+	tmplGetter   deployedTemplateGetter
+	paramsGetter stackParametersGetter
+	stackName    string
+	template     string
+	params       string // Serialized as {"Parameters": {"Name": "Value"}}.
+	pseudoParams map[string]string
+}
+
+func estimateCost(in *costEstimateInput) (string, error) {
+	var serialized struct {
+		Parameters map[string]string `json:"Parameters"`
+	}
+	if err := json.Unmarshal([]byte(in.params), &serialized); err != nil {
+		return "", fmt.Errorf("unmarshal the parameters of the template: %w", err)
+	}
+	planned, err := in.estimator.templateCost(in.template, serialized.Parameters, in.pseudoParams)
+	if err != nil {
+		return "", fmt.Errorf("estimate the cost of the template: %w", err)
+	}
+	deployed := &templateCost{}
+	deployedTmpl, err := in.tmplGetter.Template(in.stackName)
+	var errNotFound *awscloudformation.ErrStackNotFound
+	switch {
+	case errors.As(err, &errNotFound):
+	case err != nil:
+		return "", fmt.Errorf("retrieve the deployed template of stack %s: %w", in.stackName, err)
+	default:
+		deployedParams, err := in.paramsGetter.StackParameters(in.stackName)
+		if err != nil {
+			return "", fmt.Errorf("retrieve the parameters of stack %s: %w", in.stackName, err)
+		}
+		deployed, err = in.estimator.templateCost(deployedTmpl, deployedParams, in.pseudoParams)
+		if err != nil {
+			return "", fmt.Errorf("estimate the cost of the deployed template: %w", err)
+		}
+	}
+	return in.estimator.report(deployed, planned), nil
+}
+
+func pseudoParameters(accountID, region string) (map[string]string, error) {
+	partition, err := partitions.Region(region).Partition()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		"AWS::AccountId": accountID,
+		"AWS::Partition": partition.ID(),
+		"AWS::Region":    region,
+		"AWS::URLSuffix": partition.DNSSuffix(),
+	}, nil
+}
+
+// templateCost estimates the monthly cost of the resources that the template would create with the parameters.
+// Resources whose condition can't be evaluated before the deployment are assumed to be created.
+func (e *costEstimator) templateCost(template string, params, pseudoParams map[string]string) (*templateCost, error) {
+	root, err := parseTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	r := newTemplateResolver(root, params, pseudoParams)
+	cost := &templateCost{
+		resources: make(map[string]*resourceCost),
+	}
+	var taskDef, svc, scalableTarget *yaml.Node
+	var services []string
+	resources := mappingValue(root, "Resources")
+	for i := 0; resources != nil && i+1 < len(resources.Content); i += 2 {
+		logicalID, resource := resources.Content[i].Value, resources.Content[i+1]
+		if cond := mappingValue(resource, "Condition"); cond != nil {
+			if created, known := r.condition(cond.Value); known && !created {
+				continue
+			}
+		}
+		resourceType := mappingValue(resource, "Type")
+		if resourceType == nil {
+			continue
+		}
+		properties := mappingValue(resource, "Properties")
+		switch {
+		case resourceType.Value == taskDefinitionResourceType && taskDef == nil:
+			taskDef = properties
+		case resourceType.Value == scalableTargetResourceType && scalableTarget == nil:
+			scalableTarget = properties
+		case resourceType.Value == serviceResourceType:
+			services = append(services, logicalID)
+			if svc == nil {
+				svc = properties
+			}
+		case resourceType.Value == natGatewayResourceType:
+			hourly, err := e.price(ec2ServiceCode, natGatewayFamily, natGatewayUsage)
+			if err != nil {
+				return nil, err
+			}
+			cost.resources[logicalID] = &resourceCost{
+				description: "NAT gateway hours",
+				cost:        fixedMonthlyCost(hourly),
+			}
+		case resourceType.Value == loadBalancerResourceType:
+			family, description := applicationLBFamily, "Application Load Balancer hours"
+			if lbType := r.resolve(mappingValue(properties, "Type")); lbType.known && lbType.value == "network" {
+				family, description = networkLBFamily, "Network Load Balancer hours"
+			}
+			hourly, err := e.price(elbServiceCode, family, loadBalancerUsage)
+			if err != nil {
+				return nil, err
+			}
+			cost.resources[logicalID] = &resourceCost{
+				description: description,
+				cost:        fixedMonthlyCost(hourly),
+			}
+		case resourceType.Value == nestedStackResourceType:
+			cost.notEstimated = append(cost.notEstimated, fmt.Sprintf("%s (%s): the resources of nested stacks, like addons, aren't estimated", logicalID, resourceType.Value))
+		case usageBasedResourceTypes[resourceType.Value]:
+			cost.notEstimated = append(cost.notEstimated, fmt.Sprintf("%s (%s): billed by usage", logicalID, resourceType.Value))
+		}
+	}
+	if len(services) == 0 {
+		if taskDef != nil {
+			cost.notEstimated = append(cost.notEstimated, "Tasks of the job: billed by the duration of each run")
+		}
+		return cost, nil
+	}
+	if taskDef == nil {
+		return cost, nil
+	}
+	if scalableTarget == nil {
+		// Services that don't scale automatically run their desired count.
+		scalableTarget = svc
+	}
+	fargate, reason, err := e.fargateCost(r, taskDef, scalableTarget)
+	if err != nil {
+		return nil, err
+	}
+	if fargate == nil {
+		cost.notEstimated = append(cost.notEstimated, fmt.Sprintf("%s (%s): %s", services[0], serviceResourceType, reason))
+		return cost, nil
+	}
+	cost.resources[services[0]] = fargate
+	return cost, nil
+}
+
+// fargateCost estimates the monthly cost of the Fargate tasks of a service, whose count is the range of the scalable target
+// or the desired count of the service. It returns the reason why the cost can't be estimated if it depends on values unknown before the deployment.
+func (e *costEstimator) fargateCost(r *templateResolver, taskDef, scaling *yaml.Node) (*resourceCost, string, error) {
+	platform := mappingValue(taskDef, "RuntimePlatform")
+	if family := r.resolve(mappingValue(platform, "OperatingSystemFamily")); family.known && !strings.HasPrefix(family.value, "LINUX") {
+		return nil, "Windows tasks aren't estimated", nil
+	}
+	vCPUUsage, gbUsage := fargateVCPUUsage, fargateGBUsage
+	if arch := r.resolve(mappingValue(platform, "CpuArchitecture")); arch.known && arch.value == "ARM64" {
+		vCPUUsage, gbUsage = fargateARMVCPUUsage, fargateARMGBUsage
+	}
+	cpu, cpuOK := resolveFloat(r, mappingValue(taskDef, "Cpu"))
+	memory, memoryOK := resolveFloat(r, mappingValue(taskDef, "Memory"))
+	if !cpuOK || !memoryOK {
+		return nil, "the CPU and memory of the tasks are known after deployment", nil
+	}
+	min, minOK := resolveFloat(r, mappingValue(scaling, "MinCapacity"))
+	max, maxOK := resolveFloat(r, mappingValue(scaling, "MaxCapacity"))
+	if !minOK || !maxOK {
+		count, ok := resolveFloat(r, mappingValue(scaling, "DesiredCount"))
+		if !ok {
+			return nil, "the number of tasks is known after deployment", nil
+		}
+		min, max = count, count
+	}
+	vCPUPrice, err := e.price(ecsServiceCode, "", vCPUUsage)
+	if err != nil {
+		return nil, "", err
+	}
+	gbPrice, err := e.price(ecsServiceCode, "", gbUsage)
+	if err != nil {
+		return nil, "", err
+	}
+	vCPU, gb := cpu/1024, memory/1024
+	perTask := (vCPU*vCPUPrice + gb*gbPrice) * hoursPerMonth
+	tasks := strconv.FormatFloat(min, 'f', -1, 64)
+	if min != max {
+		tasks = fmt.Sprintf("%s-%s", tasks, strconv.FormatFloat(max, 'f', -1, 64))
+	}
+	return &resourceCost{
+		description: fmt.Sprintf("Fargate: %s x %s vCPU, %s GB tasks", tasks, strconv.FormatFloat(vCPU, 'f', -1, 64), strconv.FormatFloat(gb, 'f', -1, 64)),
+		cost: monthlyCost{
+			min: min * perTask,
+			max: max * perTask,
+		},
+	}, "", nil
+}
+
+func resolveFloat(r *templateResolver, n *yaml.Node) (float64, bool) {
+	v := r.resolve(n)
+	if !v.known {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(v.value, 64)
+	return f, err == nil
+}
+
+func fixedMonthlyCost(hourly float64) monthlyCost {
+	return monthlyCost{
+		min: hourly * hoursPerMonth,
+		max: hourly * hoursPerMonth,
+	}
+}
+
+// price returns the hourly On-Demand price of the product of the service whose usage type ends with the suffix.
+func (e *costEstimator) price(serviceCode, family, usageSuffix string) (float64, error) {
+	key := serviceCode + "/" + family
+	products, ok := e.products[key]
+	if !ok {
+		var attributes map[string]string
+		if family != "" {
+			attributes = map[string]string{
+				productFamilyAttibute: family,
+			}
+		}
+		var err error
+		products, err = e.pricing.Products(serviceCode, e.region, attributes)
+		if err != nil {
+			return 0, err
+		}
+		e.products[key] = products
+	}
+	for _, product := range products {
+		if product.UsageType == usageSuffix || strings.HasSuffix(product.UsageType, "-"+usageSuffix) {
+			return product.PricePerUnit, nil
+		}
+	}
+	return 0, fmt.Errorf("find the price of %s in region %s", usageSuffix, e.region)
+}
+
+// report returns the table of the estimated monthly costs of the deployed and planned resources, followed by the resources that aren't estimated.
+func (e *costEstimator) report(deployed, planned *templateCost) string {
+	var logicalIDs []string
+	for id := range deployed.resources {
+		logicalIDs = append(logicalIDs, id)
+	}
+	for id := range planned.resources {
+		if _, ok := deployed.resources[id]; !ok {
+			logicalIDs = append(logicalIDs, id)
+		}
+	}
+	sort.Strings(logicalIDs)
+
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "Estimated monthly cost in %s:\n\n", e.region)
+	if len(logicalIDs) == 0 {
+		fmt.Fprintln(b, "  No resources with a fixed cost.")
+	} else {
+		tw := tabwriter.NewWriter(b, 4, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "  Resource\tDescription\tDeployed\tPlanned\tChange")
+		for _, id := range logicalIDs {
+			var before, after monthlyCost
+			beforeCol, afterCol, description := "-", "-", ""
+			if r, ok := deployed.resources[id]; ok {
+				before, beforeCol, description = r.cost, r.cost.String(), r.description
+			}
+			if r, ok := planned.resources[id]; ok {
+				after, afterCol, description = r.cost, r.cost.String(), r.description
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\n", id, description, beforeCol, afterCol, after.delta(before))
+		}
+		fmt.Fprintf(tw, "  Total\t\t%s\t%s\t%s\n", deployed.total(), planned.total(), planned.total().delta(deployed.total()))
+		tw.Flush()
+	}
+	notEstimated := append([]string{"Load balancer capacity units, NAT gateway data processing and data transfer: billed by usage"}, planned.notEstimated...)
+	fmt.Fprintf(b, "\nNot estimated:\n")
+	for _, item := range notEstimated {
+		fmt.Fprintf(b, "  - %s\n", item)
+	}
+	return b.String()
+}
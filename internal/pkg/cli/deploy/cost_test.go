@@ -0,0 +1,382 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCostTemplate = `Parameters:
+  TaskCount:
+    Type: Number
+    Default: 1
+Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+      Memory: 512
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: !Ref TaskCount
+  Bucket:
+    Type: AWS::S3::Bucket
+`
+
+func mockCostPricing(m *mocks.MockproductsGetter) {
+	m.EXPECT().Products("AmazonECS", "us-west-2", gomock.Nil()).Return([]pricing.Product{
+		{UsageType: "USW2-Fargate-vCPU-Hours:perCPU", PricePerUnit: 0.04048},
+		{UsageType: "USW2-Fargate-GB-Hours", PricePerUnit: 0.004445},
+		{UsageType: "USW2-Fargate-ARM-vCPU-Hours:perCPU", PricePerUnit: 0.03238},
+		{UsageType: "USW2-Fargate-ARM-GB-Hours", PricePerUnit: 0.00356},
+	}, nil).AnyTimes()
+	m.EXPECT().Products("AmazonEC2", "us-west-2", map[string]string{"productFamily": "NAT Gateway"}).Return([]pricing.Product{
+		{UsageType: "USW2-NatGateway-Bytes", PricePerUnit: 0.045},
+		{UsageType: "USW2-NatGateway-Hours", PricePerUnit: 0.045},
+	}, nil).AnyTimes()
+	m.EXPECT().Products("AWSELB", "us-west-2", map[string]string{"productFamily": "Load Balancer-Application"}).Return([]pricing.Product{
+		{UsageType: "USW2-LoadBalancerUsage", PricePerUnit: 0.0252},
+	}, nil).AnyTimes()
+	m.EXPECT().Products("AWSELB", "us-west-2", map[string]string{"productFamily": "Load Balancer-Network"}).Return([]pricing.Product{
+		{UsageType: "USW2-LoadBalancerUsage", PricePerUnit: 0.0225},
+	}, nil).AnyTimes()
+}
+
+func TestCostEstimator_templateCost(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+		inParams   map[string]string
+		setupMocks func(m *mocks.MockproductsGetter)
+
+		wanted    *templateCost
+		wantedErr string
+	}{
+		"error if the price list can't be retrieved": {
+			inTemplate: testCostTemplate,
+			setupMocks: func(m *mocks.MockproductsGetter) {
+				m.EXPECT().Products("AmazonECS", "us-west-2", gomock.Nil()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+		"error if the price of a usage type can't be found": {
+			inTemplate: testCostTemplate,
+			setupMocks: func(m *mocks.MockproductsGetter) {
+				m.EXPECT().Products("AmazonECS", "us-west-2", gomock.Nil()).Return([]pricing.Product{
+					{UsageType: "USW2-Fargate-EphemeralStorage-GB-Hours", PricePerUnit: 0.000111},
+				}, nil)
+			},
+			wantedErr: "find the price of Fargate-vCPU-Hours:perCPU in region us-west-2",
+		},
+		"estimates the tasks of a service with its desired count": {
+			inTemplate: testCostTemplate,
+			inParams: map[string]string{
+				"TaskCount": "2",
+			},
+			setupMocks: mockCostPricing,
+			wanted: &templateCost{
+				resources: map[string]*resourceCost{
+					"Service": {
+						description: "Fargate: 2 x 0.25 vCPU, 0.5 GB tasks",
+						cost:        monthlyCost{min: 18.02005, max: 18.02005},
+					},
+				},
+				notEstimated: []string{"Bucket (AWS::S3::Bucket): billed by usage"},
+			},
+		},
+		"estimates the range of the tasks of a service that scales automatically on ARM": {
+			inTemplate: `Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 1024
+      Memory: 2048
+      RuntimePlatform:
+        CpuArchitecture: ARM64
+        OperatingSystemFamily: LINUX
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: !GetAtt DynamicDesiredCountAction.DesiredCount
+  AutoScalingTarget:
+    Type: AWS::ApplicationAutoScaling::ScalableTarget
+    Properties:
+      MinCapacity: 1
+      MaxCapacity: 3
+  AddonsStack:
+    Type: AWS::CloudFormation::Stack`,
+			setupMocks: mockCostPricing,
+			wanted: &templateCost{
+				resources: map[string]*resourceCost{
+					"Service": {
+						description: "Fargate: 1-3 x 1 vCPU, 2 GB tasks",
+						cost:        monthlyCost{min: 28.835, max: 86.505},
+					},
+				},
+				notEstimated: []string{"AddonsStack (AWS::CloudFormation::Stack): the resources of nested stacks, like addons, aren't estimated"},
+			},
+		},
+		"doesn't estimate the tasks of services whose count is unknown or that run on Windows": {
+			inTemplate: `Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 1024
+      Memory: 2048
+      RuntimePlatform:
+        OperatingSystemFamily: !Ref OS
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: !GetAtt DynamicDesiredCountAction.DesiredCount`,
+			inParams: map[string]string{
+				"OS": "WINDOWS_SERVER_2019_CORE",
+			},
+			setupMocks: func(m *mocks.MockproductsGetter) {},
+			wanted: &templateCost{
+				resources:    map[string]*resourceCost{},
+				notEstimated: []string{"Service (AWS::ECS::Service): Windows tasks aren't estimated"},
+			},
+		},
+		"doesn't estimate the tasks of jobs": {
+			inTemplate: `Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+      Memory: 512`,
+			setupMocks: func(m *mocks.MockproductsGetter) {},
+			wanted: &templateCost{
+				resources:    map[string]*resourceCost{},
+				notEstimated: []string{"Tasks of the job: billed by the duration of each run"},
+			},
+		},
+		"estimates the NAT gateways and load balancers whose condition is true or unknown": {
+			inTemplate: `Parameters:
+  NATWorkloads:
+    Type: String
+  ALBWorkloads:
+    Type: String
+Conditions:
+  CreateNATGateways: !Not [!Equals [!Ref NATWorkloads, ""]]
+  CreateALB:
+    Fn::Not:
+      - Fn::Equals: [!Ref ALBWorkloads, ""]
+  CreateNLB: !And
+    - !Condition CreateALB
+    - !Equals [!GetAtt Custom.Value, "true"]
+Resources:
+  NatGateway1:
+    Type: AWS::EC2::NatGateway
+    Condition: CreateNATGateways
+  PublicLoadBalancer:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Condition: CreateALB
+  NetworkLoadBalancer:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Condition: CreateNLB
+    Properties:
+      Type: network`,
+			inParams: map[string]string{
+				"NATWorkloads": "",
+				"ALBWorkloads": "frontend",
+			},
+			setupMocks: mockCostPricing,
+			wanted: &templateCost{
+				resources: map[string]*resourceCost{
+					"PublicLoadBalancer": {
+						description: "Application Load Balancer hours",
+						cost:        monthlyCost{min: 18.396, max: 18.396},
+					},
+					"NetworkLoadBalancer": {
+						description: "Network Load Balancer hours",
+						cost:        monthlyCost{min: 16.425, max: 16.425},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockproductsGetter(ctrl)
+			tc.setupMocks(m)
+
+			got, err := newCostEstimator(m, "us-west-2").templateCost(tc.inTemplate, tc.inParams, nil)
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, len(tc.wanted.resources), len(got.resources))
+			for id, wanted := range tc.wanted.resources {
+				require.Contains(t, got.resources, id)
+				require.Equal(t, wanted.description, got.resources[id].description)
+				require.InDelta(t, wanted.cost.min, got.resources[id].cost.min, 0.0001)
+				require.InDelta(t, wanted.cost.max, got.resources[id].cost.max, 0.0001)
+			}
+			require.Equal(t, tc.wanted.notEstimated, got.notEstimated)
+		})
+	}
+}
+
+func TestWorkloadDeployer_EstimateCost(t *testing.T) {
+	const stackName = "phonetool-test-frontend"
+	testCases := map[string]struct {
+		setupMocks func(tmpl *mocks.MockdeployedTemplateGetter, params *mocks.MockstackParametersGetter)
+
+		wanted    string
+		wantedErr string
+	}{
+		"error if the deployed template can't be retrieved": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, params *mocks.MockstackParametersGetter) {
+				tmpl.EXPECT().Template(stackName).Return("", errors.New("some error"))
+			},
+			wantedErr: "retrieve the deployed template of stack phonetool-test-frontend: some error",
+		},
+		"error if the parameters of the deployed stack can't be retrieved": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, params *mocks.MockstackParametersGetter) {
+				tmpl.EXPECT().Template(stackName).Return(testCostTemplate, nil)
+				params.EXPECT().StackParameters(stackName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "retrieve the parameters of stack phonetool-test-frontend: some error",
+		},
+		"estimates the cost of a workload that isn't deployed": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, params *mocks.MockstackParametersGetter) {
+				tmpl.EXPECT().Template(stackName).Return("", &cloudformation.ErrStackNotFound{})
+			},
+			wanted: `Estimated monthly cost in us-west-2:
+
+  Resource  Description                           Deployed  Planned  Change
+  Service   Fargate: 2 x 0.25 vCPU, 0.5 GB tasks  -         $18.02   +$18.02
+  Total                                           $0.00     $18.02   +$18.02
+
+Not estimated:
+  - Load balancer capacity units, NAT gateway data processing and data transfer: billed by usage
+  - Bucket (AWS::S3::Bucket): billed by usage
+`,
+		},
+		"compares the cost with the deployed stack": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, params *mocks.MockstackParametersGetter) {
+				tmpl.EXPECT().Template(stackName).Return(testCostTemplate, nil)
+				params.EXPECT().StackParameters(stackName).Return(map[string]string{
+					"TaskCount": "1",
+				}, nil)
+			},
+			wanted: `Estimated monthly cost in us-west-2:
+
+  Resource  Description                           Deployed  Planned  Change
+  Service   Fargate: 2 x 0.25 vCPU, 0.5 GB tasks  $9.01     $18.02   +$9.01
+  Total                                           $9.01     $18.02   +$9.01
+
+Not estimated:
+  - Load balancer capacity units, NAT gateway data processing and data transfer: billed by usage
+  - Bucket (AWS::S3::Bucket): billed by usage
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			tmplGetter := mocks.NewMockdeployedTemplateGetter(ctrl)
+			paramsGetter := mocks.NewMockstackParametersGetter(ctrl)
+			prices := mocks.NewMockproductsGetter(ctrl)
+			tc.setupMocks(tmplGetter, paramsGetter)
+			mockCostPricing(prices)
+			d := &workloadDeployer{
+				name: "frontend",
+				app:  &config.Application{Name: "phonetool"},
+				env: &config.Environment{
+					Name:      "test",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				},
+				tmplGetter:        tmplGetter,
+				stackParamsGetter: paramsGetter,
+				pricing:           prices,
+			}
+
+			got, err := d.EstimateCost(testCostTemplate, `{"Parameters": {"TaskCount": "2"}}`)
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestTemplateResolver_condition(t *testing.T) {
+	root, err := parseTemplate(`Conditions:
+  IsProd: !Equals [!Ref EnvName, prod]
+  HasDomain: !Not [!Equals [!Ref Domain, ""]]
+  IsProdWithDomain: !And [!Condition IsProd, !Condition HasDomain]
+  IsProdOrCustom:
+    Fn::Or:
+      - Condition: IsProd
+      - !Equals [!GetAtt Custom.Value, "true"]
+  IsCustom: !Equals [!GetAtt Custom.Value, "true"]`)
+	require.NoError(t, err)
+	testCases := map[string]struct {
+		inParams  map[string]string
+		inName    string
+		wanted    bool
+		wantKnown bool
+	}{
+		"equals": {
+			inParams:  map[string]string{"EnvName": "prod"},
+			inName:    "IsProd",
+			wanted:    true,
+			wantKnown: true,
+		},
+		"not": {
+			inParams:  map[string]string{"Domain": ""},
+			inName:    "HasDomain",
+			wanted:    false,
+			wantKnown: true,
+		},
+		"and of conditions": {
+			inParams:  map[string]string{"EnvName": "prod", "Domain": "example.com"},
+			inName:    "IsProdWithDomain",
+			wanted:    true,
+			wantKnown: true,
+		},
+		"or short-circuits an unknown condition": {
+			inParams:  map[string]string{"EnvName": "prod"},
+			inName:    "IsProdOrCustom",
+			wanted:    true,
+			wantKnown: true,
+		},
+		"or is unknown if it depends on an unknown condition": {
+			inParams: map[string]string{"EnvName": "test"},
+			inName:   "IsProdOrCustom",
+		},
+		"unknown if it depends on resources": {
+			inName: "IsCustom",
+		},
+		"unknown if it doesn't exist": {
+			inName: "Missing",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, known := newTemplateResolver(root, tc.inParams, nil).condition(tc.inName)
+			require.Equal(t, tc.wantKnown, known)
+			if known {
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -13,13 +13,19 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing"
 	awss3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/patch"
@@ -31,7 +37,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
-	"github.com/aws/copilot-cli/internal/pkg/override"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 	"github.com/aws/copilot-cli/internal/pkg/template/diff"
@@ -72,6 +77,11 @@ type envDescriber interface {
 
 type lbDescriber interface {
 	DescribeRule(context.Context, string) (elbv2.Rule, error)
+	LoadBalancer(nameOrARN string) (*elbv2.LoadBalancer, error)
+}
+
+type clusterDescriber interface {
+	ActiveClusters(arns ...string) ([]string, error)
 }
 
 type stackDescriber interface {
@@ -84,8 +94,9 @@ type addons struct {
 }
 
 type envDeployer struct {
-	app *config.Application
-	env *config.Environment
+	app       *config.Application
+	env       *config.Environment
+	appRegion string // Region of the application, where its SSM parameters and stack live.
 
 	// Dependencies to upload artifacts.
 	templateFS       template.Reader
@@ -100,13 +111,19 @@ type envDeployer struct {
 	newStack                 func(input *cfnstack.EnvConfig, forceUpdateID string, prevParams []*awscfn.Parameter) (deploycfn.StackConfiguration, error)
 	envDescriber             envDescriber
 	lbDescriber              lbDescriber
+	clusterDescriber         clusterDescriber
 	newServiceStackDescriber func(string) stackDescriber
+	lock                     *deploymentLock
 
 	// Dependencies for parsing addons.
 	ws              WorkspaceAddonsReaderPathGetter
 	parseAddonsOnce sync.Once
 	parseAddons     func() (stackBuilder, error)
 
+	// Dependencies to estimate the cost of an environment.
+	stackParamsGetter stackParametersGetter
+	pricing           productsGetter
+
 	// Cached variables.
 	appRegionalResources *cfnstack.AppRegionalResources
 	addons               addons
@@ -132,6 +149,7 @@ func NewEnvDeployer(in *NewEnvDeployerInput) (*envDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get default session in env region %s: %w", in.Env.Region, err)
 	}
+	in.SessionProvider.RoleSession(roleSession(in.App))
 	envManagerSession, err := in.SessionProvider.FromRole(in.Env.ManagerRoleARN, in.Env.Region)
 	if err != nil {
 		return nil, fmt.Errorf("get env session: %w", err)
@@ -144,14 +162,13 @@ func NewEnvDeployer(in *NewEnvDeployerInput) (*envDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("initialize env describer: %w", err)
 	}
-	overrider := in.Overrider
-	if overrider == nil {
-		overrider = new(override.Noop)
-	}
+	overrider := WithStackMetadata(in.Overrider, in.App.StackMetadata)
 	cfnClient := deploycfn.New(envManagerSession, deploycfn.WithProgressTracker(os.Stderr))
+	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
 	deployer := &envDeployer{
-		app: in.App,
-		env: in.Env,
+		app:       in.App,
+		env:       in.Env,
+		appRegion: aws.StringValue(defaultSession.Config.Region),
 
 		templateFS:       template.New(),
 		s3:               awss3.New(envManagerSession),
@@ -172,13 +189,20 @@ func NewEnvDeployer(in *NewEnvDeployerInput) (*envDeployer, error) {
 			}
 			return deploycfn.WrapWithTemplateOverrider(stack, overrider), nil
 		},
-		envDescriber: envDescriber,
-		lbDescriber:  elbv2.New(envManagerSession),
+		envDescriber:     envDescriber,
+		lbDescriber:      elbv2.New(envManagerSession),
+		clusterDescriber: awsecs.New(envManagerSession),
 		newServiceStackDescriber: func(svc string) stackDescriber {
 			return stack.NewStackDescriber(cfnstack.NameForWorkload(in.App.Name, in.Env.Name, svc), envManagerSession)
 		},
+		lock: newEnvLoadBalancerLock(store, termprogress.NewSpinner(log.DiagnosticWriter), awscloudformation.New(envManagerSession),
+			in.App.Name, in.Env.Name, fmt.Sprintf("environment %s", in.Env.Name), cfnstack.NameForEnv(in.App.Name, in.Env.Name), ""),
 
 		ws: in.Workspace,
+
+		stackParamsGetter: cfnClient,
+		// The Price List Query API isn't part of the permissions of the environment manager role.
+		pricing: pricing.New(defaultSession),
 	}
 	deployer.parseAddons = func() (stackBuilder, error) {
 		deployer.parseAddonsOnce.Do(func() {
@@ -327,11 +351,20 @@ func (d *envDeployer) DeployEnvironment(in *DeployEnvironmentInput) error {
 	if in.DisableRollback {
 		opts = append(opts, awscloudformation.WithDisableRollback())
 	}
+	if in.Manifest != nil {
+		settingsOpts, err := stackSettingsOptions(in.Manifest)
+		if err != nil {
+			return fmt.Errorf("stack settings of environment %s: %w", d.env.Name, err)
+		}
+		opts = append(opts, settingsOpts...)
+	}
 	stack, err := d.newStack(stackInput, lastForceUpdateID, oldParams)
 	if err != nil {
 		return err
 	}
-	return d.envDeployer.UpdateAndRenderEnvironment(stack, stackInput.ArtifactBucketARN, in.Detach, opts...)
+	return d.lock.Do(func() error {
+		return d.envDeployer.UpdateAndRenderEnvironment(stack, stackInput.ArtifactBucketARN, in.Detach, opts...)
+	})
 }
 
 func (d *envDeployer) getAppRegionalResources() (*cfnstack.AppRegionalResources, error) {
@@ -409,12 +442,21 @@ func (d *envDeployer) buildStackInput(in *DeployEnvironmentInput) (*cfnstack.Env
 	if err != nil {
 		return nil, err
 	}
+	importedClusterName, err := d.importedClusterName(in.Manifest)
+	if err != nil {
+		return nil, err
+	}
+	importedPublicALB, err := d.importedPublicALB(in.Manifest)
+	if err != nil {
+		return nil, err
+	}
 	return &cfnstack.EnvConfig{
 		Name: d.env.Name,
 		App: deploy.AppInformation{
 			Name:                d.app.Name,
 			Domain:              d.app.Domain,
 			AccountPrincipalARN: in.RootUserARN,
+			AppRegistry:         d.associateWithAppRegistry(),
 		},
 		AdditionalTags:       d.app.Tags,
 		Addons:               addons,
@@ -423,6 +465,8 @@ func (d *envDeployer) buildStackInput(in *DeployEnvironmentInput) (*cfnstack.Env
 		ArtifactBucketKeyARN: resources.KMSKeyARN,
 		CIDRPrefixListIDs:    cidrPrefixListIDs,
 		PublicALBSourceIPs:   d.publicALBSourceIPs(in),
+		ImportedClusterName:  importedClusterName,
+		ImportedPublicALB:    importedPublicALB,
 		Mft:                  in.Manifest,
 		ForceUpdate:          in.ForceNewUpdate,
 		RawMft:               in.RawManifest,
@@ -585,6 +629,58 @@ func (d *envDeployer) publicALBSourceIPs(in *DeployEnvironmentInput) []string {
 	return ips
 }
 
+// importedClusterName returns the name of the existing cluster imported in the manifest after verifying that it is active.
+func (d *envDeployer) importedClusterName(mft *manifest.Environment) (string, error) {
+	if mft == nil || mft.Cluster.IsEmpty() {
+		return "", nil
+	}
+	id := aws.StringValue(mft.Cluster.ID)
+	active, err := d.clusterDescriber.ActiveClusters(id)
+	if err != nil {
+		return "", fmt.Errorf("describe imported cluster %q: %w", id, err)
+	}
+	if len(active) == 0 {
+		return "", fmt.Errorf("imported cluster %q is not active", id)
+	}
+	parsed, err := arn.Parse(active[0])
+	if err != nil {
+		return "", fmt.Errorf("parse ARN %s of imported cluster: %w", active[0], err)
+	}
+	return strings.TrimPrefix(parsed.Resource, "cluster/"), nil
+}
+
+// importedPublicALB returns the existing load balancer imported in the manifest after verifying
+// that workloads in the environment can attach to it.
+func (d *envDeployer) importedPublicALB(mft *manifest.Environment) (*elbv2.LoadBalancer, error) {
+	if mft == nil || mft.HTTPConfig.Public.ImportedLoadBalancer() == "" {
+		return nil, nil
+	}
+	id := mft.HTTPConfig.Public.ImportedLoadBalancer()
+	lb, err := d.lbDescriber.LoadBalancer(id)
+	if err != nil {
+		return nil, fmt.Errorf("describe imported public load balancer: %w", err)
+	}
+	if lb.Type != elbv2.TypeApplication {
+		return nil, fmt.Errorf("imported public load balancer %q must be an Application Load Balancer instead of type %q", id, lb.Type)
+	}
+	if lb.Scheme != elbv2.SchemeInternetFacing {
+		return nil, fmt.Errorf("imported public load balancer %q must be internet-facing instead of %q", id, lb.Scheme)
+	}
+	if vpcID := aws.StringValue(mft.Network.VPC.ID); lb.VPCID != vpcID {
+		return nil, fmt.Errorf("imported public load balancer %q is in VPC %s instead of the environment VPC %s", id, lb.VPCID, vpcID)
+	}
+	if _, ok := lb.Listener(80, elbv2.ProtocolHTTP); !ok {
+		return nil, fmt.Errorf("imported public load balancer %q must have an HTTP listener on port 80", id)
+	}
+	return lb, nil
+}
+
+// associateWithAppRegistry returns true if the environment stack should be associated with the application in AWS Service Catalog AppRegistry.
+// AppRegistry only associates stacks in the same account and region as the application.
+func (d *envDeployer) associateWithAppRegistry() bool {
+	return d.app.AppRegistry != nil && d.env.Region == d.appRegion && d.env.AccountID == d.app.AccountID
+}
+
 func (d *envDeployer) cfManagedPrefixListID() (string, error) {
 	id, err := d.prefixListGetter.CloudFrontManagedPrefixListID()
 	if err != nil {
@@ -30,6 +30,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 type envDeployerMocks struct {
@@ -41,6 +42,7 @@ type envDeployerMocks struct {
 	stackSerializer  *cfnmocks.MockStackConfiguration
 	envDescriber     *mocks.MockenvDescriber
 	lbDescriber      *mocks.MocklbDescriber
+	clusterDescriber *mocks.MockclusterDescriber
 	stackDescribers  map[string]*mocks.MockstackDescriber
 	ws               *mocks.MockWorkspaceAddonsReaderPathGetter
 
@@ -727,6 +729,242 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 	}
 }
 
+func TestEnvDeployer_importedClusterName(t *testing.T) {
+	const mftWithCluster = `name: test
+type: Environment
+cluster:
+  id: shared`
+	testCases := map[string]struct {
+		inManifest string
+		setUpMocks func(m *envDeployerMocks)
+
+		wanted      string
+		wantedError string
+	}{
+		"no cluster imported": {
+			inManifest: "name: test\ntype: Environment",
+			setUpMocks: func(m *envDeployerMocks) {},
+		},
+		"fail to describe the cluster": {
+			inManifest: mftWithCluster,
+			setUpMocks: func(m *envDeployerMocks) {
+				m.clusterDescriber.EXPECT().ActiveClusters("shared").Return(nil, errors.New("some error"))
+			},
+			wantedError: `describe imported cluster "shared": some error`,
+		},
+		"cluster is not active": {
+			inManifest: mftWithCluster,
+			setUpMocks: func(m *envDeployerMocks) {
+				m.clusterDescriber.EXPECT().ActiveClusters("shared").Return(nil, nil)
+			},
+			wantedError: `imported cluster "shared" is not active`,
+		},
+		"returns the name of the active cluster": {
+			inManifest: mftWithCluster,
+			setUpMocks: func(m *envDeployerMocks) {
+				m.clusterDescriber.EXPECT().ActiveClusters("shared").Return([]string{"arn:aws:ecs:us-west-2:123456789012:cluster/shared"}, nil)
+			},
+			wanted: "shared",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &envDeployerMocks{
+				clusterDescriber: mocks.NewMockclusterDescriber(ctrl),
+			}
+			tc.setUpMocks(m)
+			var mft manifest.Environment
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inManifest), &mft))
+			d := envDeployer{
+				clusterDescriber: m.clusterDescriber,
+			}
+
+			got, err := d.importedClusterName(&mft)
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestEnvDeployer_importedPublicALB(t *testing.T) {
+	const mftWithALB = `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-1234
+    subnets:
+      private:
+        - id: subnet-1
+        - id: subnet-2
+http:
+  public:
+    load_balancer: shared`
+	validLB := func() *elbv2.LoadBalancer {
+		return &elbv2.LoadBalancer{
+			ARN:    "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188",
+			Type:   "application",
+			Scheme: "internet-facing",
+			VPCID:  "vpc-1234",
+			Listeners: []elbv2.Listener{
+				{
+					ARN:      "listener-80",
+					Port:     80,
+					Protocol: "HTTP",
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		inManifest string
+		setUpMocks func(m *envDeployerMocks)
+
+		wanted      *elbv2.LoadBalancer
+		wantedError string
+	}{
+		"no load balancer imported": {
+			inManifest: "name: test\ntype: Environment",
+			setUpMocks: func(m *envDeployerMocks) {},
+		},
+		"fail to describe the load balancer": {
+			inManifest: mftWithALB,
+			setUpMocks: func(m *envDeployerMocks) {
+				m.lbDescriber.EXPECT().LoadBalancer("shared").Return(nil, errors.New("some error"))
+			},
+			wantedError: "describe imported public load balancer: some error",
+		},
+		"load balancer is not an application load balancer": {
+			inManifest: mftWithALB,
+			setUpMocks: func(m *envDeployerMocks) {
+				lb := validLB()
+				lb.Type = "network"
+				m.lbDescriber.EXPECT().LoadBalancer("shared").Return(lb, nil)
+			},
+			wantedError: `imported public load balancer "shared" must be an Application Load Balancer instead of type "network"`,
+		},
+		"load balancer is internal": {
+			inManifest: mftWithALB,
+			setUpMocks: func(m *envDeployerMocks) {
+				lb := validLB()
+				lb.Scheme = "internal"
+				m.lbDescriber.EXPECT().LoadBalancer("shared").Return(lb, nil)
+			},
+			wantedError: `imported public load balancer "shared" must be internet-facing instead of "internal"`,
+		},
+		"load balancer is in a different vpc": {
+			inManifest: mftWithALB,
+			setUpMocks: func(m *envDeployerMocks) {
+				lb := validLB()
+				lb.VPCID = "vpc-5678"
+				m.lbDescriber.EXPECT().LoadBalancer("shared").Return(lb, nil)
+			},
+			wantedError: `imported public load balancer "shared" is in VPC vpc-5678 instead of the environment VPC vpc-1234`,
+		},
+		"load balancer does not have an http listener on port 80": {
+			inManifest: mftWithALB,
+			setUpMocks: func(m *envDeployerMocks) {
+				lb := validLB()
+				lb.Listeners = []elbv2.Listener{
+					{
+						ARN:      "listener-443",
+						Port:     443,
+						Protocol: "HTTPS",
+					},
+				}
+				m.lbDescriber.EXPECT().LoadBalancer("shared").Return(lb, nil)
+			},
+			wantedError: `imported public load balancer "shared" must have an HTTP listener on port 80`,
+		},
+		"returns the load balancer": {
+			inManifest: mftWithALB,
+			setUpMocks: func(m *envDeployerMocks) {
+				m.lbDescriber.EXPECT().LoadBalancer("shared").Return(validLB(), nil)
+			},
+			wanted: validLB(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &envDeployerMocks{
+				lbDescriber: mocks.NewMocklbDescriber(ctrl),
+			}
+			tc.setUpMocks(m)
+			var mft manifest.Environment
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inManifest), &mft))
+			d := envDeployer{
+				lbDescriber: m.lbDescriber,
+			}
+
+			got, err := d.importedPublicALB(&mft)
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestEnvDeployer_associateWithAppRegistry(t *testing.T) {
+	testCases := map[string]struct {
+		inAppRegistry *config.AppRegistry
+		inEnvAccount  string
+		inEnvRegion   string
+
+		wanted bool
+	}{
+		"app is not registered": {
+			inEnvAccount: "123456789012",
+			inEnvRegion:  "us-west-2",
+		},
+		"env in the account and region of the app": {
+			inAppRegistry: &config.AppRegistry{},
+			inEnvAccount:  "123456789012",
+			inEnvRegion:   "us-west-2",
+			wanted:        true,
+		},
+		"env in another region": {
+			inAppRegistry: &config.AppRegistry{},
+			inEnvAccount:  "123456789012",
+			inEnvRegion:   "us-east-1",
+		},
+		"env in another account": {
+			inAppRegistry: &config.AppRegistry{},
+			inEnvAccount:  "210987654321",
+			inEnvRegion:   "us-west-2",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := envDeployer{
+				app: &config.Application{
+					Name:        "demo",
+					AccountID:   "123456789012",
+					AppRegistry: tc.inAppRegistry,
+				},
+				env: &config.Environment{
+					Name:      "test",
+					AccountID: tc.inEnvAccount,
+					Region:    tc.inEnvRegion,
+				},
+				appRegion: "us-west-2",
+			}
+
+			require.Equal(t, tc.wanted, d.associateWithAppRegistry())
+		})
+	}
+}
+
 func TestEnvDeployer_Validate(t *testing.T) {
 	listenerRuleNoRedirect := elbv2.Rule{
 		Actions: []*awselb.Action{
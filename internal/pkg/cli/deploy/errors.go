@@ -8,7 +8,9 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/dustin/go-humanize"
 	"github.com/dustin/go-humanize/english"
 )
 
@@ -84,3 +86,33 @@ If you'd like to use %s without a CDN, ensure %s A record is pointed to the ALB.
 		english.PluralWord(len(e.services), "its", "each service's"),
 	)
 }
+
+type errBuildContextTooLarge struct {
+	container string
+	context   *dockerengine.BuildContext
+	max       int64
+}
+
+func (e *errBuildContextTooLarge) Error() string {
+	return fmt.Sprintf("build context %s of container %q is %s, which exceeds the maximum size of %s",
+		e.context.Dir, e.container, humanize.IBytes(uint64(e.context.Size)), humanize.IBytes(uint64(e.max)))
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errBuildContextTooLarge) RecommendActions() string {
+	if len(e.context.Suggestions) == 0 {
+		return fmt.Sprintf("Exclude the files that aren't needed to build the image in %s, or increase %s.",
+			e.context.DockerignorePath, color.HighlightCode("--max-context-size"))
+	}
+	return fmt.Sprintf("Add the following entries to %s to exclude files that are likely unintended:\n%s",
+		e.context.DockerignorePath, fmtDockerignoreSuggestions(e.context.Suggestions))
+}
+
+func fmtDockerignoreSuggestions(suggestions []dockerengine.DockerignoreSuggestion) string {
+	lines := make([]string, len(suggestions))
+	for i, suggestion := range suggestions {
+		lines[i] = fmt.Sprintf("  %s  # %s, %s", suggestion.Pattern, suggestion.Reason, humanize.IBytes(uint64(suggestion.Size)))
+	}
+	return strings.Join(lines, "\n")
+}
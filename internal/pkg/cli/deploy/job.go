@@ -78,6 +78,14 @@ func (d *jobDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommender
 	if in.DisableRollback {
 		opts = append(opts, awscloudformation.WithDisableRollback())
 	}
+	if in.CreateChangeSetOnly {
+		opts = append(opts, awscloudformation.WithCreateChangeSetOnly())
+	}
+	settingsOpts, err := stackSettingsOptions(d.mft)
+	if err != nil {
+		return nil, fmt.Errorf("stack settings of job %s: %w", d.name, err)
+	}
+	opts = append(opts, settingsOpts...)
 	stackConfigOutput, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
 	if err != nil {
 		return nil, err
@@ -4,12 +4,18 @@
 package deploy
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudfront"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -21,10 +27,12 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/openapi"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/spf13/afero"
 )
 
 var (
@@ -42,15 +50,34 @@ var (
 		color.HighlightCode("copilot app init --domain example.com"))
 )
 
+const (
+	fmtCodeDeploySvcStart    = "Shifting the traffic of service %s in environment %s to its new tasks"
+	fmtCodeDeploySvcFailed   = "Failed to shift the traffic of service %s in environment %s to its new tasks: %v.\n"
+	fmtCodeDeploySvcComplete = "Shifted the traffic of service %s in environment %s to its new tasks.\n"
+	fmtCodeDeploySvcDetached = "Started deployment %s to shift the traffic of service %s in environment %s to its new tasks.\n"
+)
+
 type publicCIDRBlocksGetter interface {
 	PublicCIDRBlocks() ([]string, error)
 }
 
+type ecsServiceDescriber interface {
+	Service(app, env, svc string) (*awsecs.Service, error)
+	TaskDefinition(app, env, svc string) (*awsecs.TaskDefinition, error)
+}
+
+type codeDeployer interface {
+	DeployECSService(in codedeploy.ECSDeployment) (string, error)
+	WaitForDeployment(deploymentID string) error
+}
+
 type lbWebSvcDeployer struct {
 	*svcDeployer
 	appVersionGetter       versionGetter
 	publicCIDRBlocksGetter publicCIDRBlocksGetter
 	lbMft                  *manifest.LoadBalancedWebService
+	ecsDescriber           ecsServiceDescriber
+	codeDeployer           codeDeployer
 
 	// Overriden in tests.
 	newAliasCertValidator func(optionalRegion *string) aliasCertValidator
@@ -85,11 +112,14 @@ func NewLBWSDeployer(in *WorkloadDeployerInput) (*lbWebSvcDeployer, error) {
 	if !ok {
 		return nil, fmt.Errorf("manifest is not of type %s", manifestinfo.LoadBalancedWebServiceType)
 	}
+	svcDeployer.lock = svcDeployer.envLoadBalancerLock()
 	return &lbWebSvcDeployer{
 		svcDeployer:            svcDeployer,
 		appVersionGetter:       versionGetter,
 		publicCIDRBlocksGetter: envDescriber,
 		lbMft:                  lbMft,
+		ecsDescriber:           ecs.New(svcDeployer.envSess),
+		codeDeployer:           codedeploy.New(svcDeployer.envSess),
 		newAliasCertValidator: func(optionalRegion *string) aliasCertValidator {
 			sess := svcDeployer.envSess.Copy(&aws.Config{
 				Region: optionalRegion,
@@ -136,9 +166,160 @@ func (d *lbWebSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecomm
 	if err := d.deploy(in.Options, *stackConfigOutput); err != nil {
 		return nil, err
 	}
+	// With --force, the service updater already deployed the latest task definition with CodeDeploy.
+	if d.lbMft.DeployConfig.Strategy.IsEmpty() || in.Options.CreateChangeSetOnly || in.Options.ForceNewUpdate {
+		return noopActionRecommender{}, nil
+	}
+	if err := d.shiftTraffic(in.Options.Detach); err != nil {
+		return nil, err
+	}
 	return noopActionRecommender{}, nil
 }
 
+// shiftTraffic deploys the task definition created by the stack update with CodeDeploy,
+// as CloudFormation can't update the task definition of a service deployed by CodeDeploy.
+func (d *lbWebSvcDeployer) shiftTraffic(detach bool) error {
+	updater, err := d.codeDeployServiceUpdater()
+	if err != nil {
+		return err
+	}
+	svc, err := d.ecsDescriber.Service(d.app.Name, d.env.Name, d.name)
+	if err != nil {
+		return fmt.Errorf("get ECS service of %s: %w", d.name, err)
+	}
+	taskDef, err := d.ecsDescriber.TaskDefinition(d.app.Name, d.env.Name, d.name)
+	if err != nil {
+		return err
+	}
+	if aws.StringValue(svc.TaskDefinition) == aws.StringValue(taskDef.TaskDefinitionArn) {
+		return nil
+	}
+	d.spinner.Start(fmt.Sprintf(fmtCodeDeploySvcStart, color.HighlightUserInput(d.name), color.HighlightUserInput(d.env.Name)))
+	id, err := updater.deploy(d.app.Name, d.env.Name, d.name, aws.StringValue(taskDef.TaskDefinitionArn))
+	if err == nil && detach {
+		d.spinner.Stop(log.Ssuccessf(fmtCodeDeploySvcDetached, id, color.HighlightUserInput(d.name), color.HighlightUserInput(d.env.Name)))
+		return nil
+	}
+	if err == nil {
+		err = d.codeDeployer.WaitForDeployment(id)
+	}
+	if err != nil {
+		d.spinner.Stop(log.Serror(fmt.Sprintf(fmtCodeDeploySvcFailed, color.HighlightUserInput(d.name), color.HighlightUserInput(d.env.Name), err)))
+		return fmt.Errorf("shift traffic of service %s to its new tasks: %w", d.name, err)
+	}
+	d.spinner.Stop(log.Ssuccessf(fmtCodeDeploySvcComplete, color.HighlightUserInput(d.name), color.HighlightUserInput(d.env.Name)))
+	return nil
+}
+
+// codeDeployedService returns the ECS service if it's deployed by CodeDeploy,
+// or nil if the service doesn't exist yet or is deployed with rolling updates.
+func (d *lbWebSvcDeployer) codeDeployedService() (*awsecs.Service, error) {
+	svc, err := d.ecsDescriber.Service(d.app.Name, d.env.Name, d.name)
+	if err != nil {
+		var errNotFound *ecs.ErrNoServiceFound
+		if errors.As(err, &errNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get ECS service of %s: %w", d.name, err)
+	}
+	if svc.DeploymentController == nil || aws.StringValue(svc.DeploymentController.Type) != sdkecs.DeploymentControllerTypeCodeDeploy {
+		// The service is replaced when its deployment controller changes.
+		return nil, nil
+	}
+	return svc, nil
+}
+
+// activeTargetGroup returns the target group that receives the traffic of a service deployed by CodeDeploy.
+// The service keeps the target group it was created with, while the primary task set is registered to
+// the target group that CodeDeploy last shifted the traffic to.
+func activeTargetGroup(svc *awsecs.Service) string {
+	if svc == nil || len(svc.LoadBalancers) == 0 {
+		return stack.BlueTargetGroup
+	}
+	blue := aws.StringValue(svc.LoadBalancers[0].TargetGroupArn)
+	for _, taskSet := range svc.TaskSets {
+		if aws.StringValue(taskSet.Status) != "PRIMARY" || len(taskSet.LoadBalancers) == 0 {
+			continue
+		}
+		if aws.StringValue(taskSet.LoadBalancers[0].TargetGroupArn) != blue {
+			return stack.GreenTargetGroup
+		}
+	}
+	return stack.BlueTargetGroup
+}
+
+func (d *lbWebSvcDeployer) codeDeployServiceUpdater() (*codeDeployServiceUpdater, error) {
+	exposedPorts, err := d.lbMft.ExposedPorts()
+	if err != nil {
+		return nil, fmt.Errorf("parse exposed ports in service manifest %s: %w", d.name, err)
+	}
+	container, port, err := d.lbMft.HTTPOrBool.Main.Target(exposedPorts)
+	if err != nil {
+		return nil, err
+	}
+	containerPort, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("parse target port %s: %w", port, err)
+	}
+	return &codeDeployServiceUpdater{
+		ecsDescriber:  d.ecsDescriber,
+		codeDeployer:  d.codeDeployer,
+		containerName: container,
+		containerPort: uint16(containerPort),
+	}, nil
+}
+
+// codeDeployServiceUpdater forces an update of a service deployed by CodeDeploy
+// by shifting its traffic to new tasks running the latest task definition.
+type codeDeployServiceUpdater struct {
+	ecsDescriber  ecsServiceDescriber
+	codeDeployer  codeDeployer
+	containerName string
+	containerPort uint16
+}
+
+// ForceUpdateService deploys the latest task definition of the service and waits until all the traffic is shifted.
+func (u *codeDeployServiceUpdater) ForceUpdateService(app, env, svc string) error {
+	taskDef, err := u.ecsDescriber.TaskDefinition(app, env, svc)
+	if err != nil {
+		return err
+	}
+	id, err := u.deploy(app, env, svc, aws.StringValue(taskDef.TaskDefinitionArn))
+	if err != nil {
+		return err
+	}
+	return u.codeDeployer.WaitForDeployment(id)
+}
+
+// LastUpdatedAt returns the zero time as CloudFormation never updates the tasks of a service deployed by CodeDeploy.
+func (u *codeDeployServiceUpdater) LastUpdatedAt(_, _, _ string) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (u *codeDeployServiceUpdater) deploy(app, env, svc, taskDefARN string) (string, error) {
+	return u.codeDeployer.DeployECSService(codedeploy.ECSDeployment{
+		ApplicationName:     fmt.Sprintf("%s-%s-%s", app, env, svc),
+		DeploymentGroupName: svc,
+		TaskDefinitionARN:   taskDefARN,
+		ContainerName:       u.containerName,
+		ContainerPort:       u.containerPort,
+		Description:         fmt.Sprintf("Deployment of service %s in environment %s by Copilot", svc, env),
+	})
+}
+
+// openAPISpec reads and parses the OpenAPI spec at the path relative to the workspace root.
+func (d *lbWebSvcDeployer) openAPISpec(path string) (*openapi.Spec, error) {
+	content, err := afero.ReadFile(d.fs, filepath.Join(d.workspacePath, path))
+	if err != nil {
+		return nil, fmt.Errorf("read OpenAPI spec %s: %w", path, err)
+	}
+	spec, err := openapi.Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("parse OpenAPI spec %s: %w", path, err)
+	}
+	return spec, nil
+}
+
 func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*svcStackConfigurationOutput, error) {
 	rc, err := d.runtimeConfig(in)
 	if err != nil {
@@ -150,6 +331,9 @@ func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*s
 	if err := d.validateNLBRuntime(); err != nil {
 		return nil, err
 	}
+	if err := d.resolveScalingCalendars(&d.lbMft.Count.AdvancedCount); err != nil {
+		return nil, err
+	}
 	var opts []stack.LoadBalancedWebServiceOption
 	if !d.lbMft.NLBConfig.IsEmpty() {
 		cidrBlocks, err := d.publicCIDRBlocksGetter.PublicCIDRBlocks()
@@ -158,6 +342,30 @@ func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*s
 		}
 		opts = append(opts, stack.WithNLB(cidrBlocks))
 	}
+	if spec := d.lbMft.HTTPOrBool.OpenAPI.Spec; spec != nil {
+		openAPISpec, err := d.openAPISpec(aws.StringValue(spec))
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, stack.WithOpenAPISpec(openAPISpec))
+	}
+	svcUpdater := d.newSvcUpdater(func(s *session.Session) serviceForceUpdater {
+		return ecs.New(s)
+	})
+	if !d.lbMft.DeployConfig.Strategy.IsEmpty() {
+		svc, err := d.codeDeployedService()
+		if err != nil {
+			return nil, err
+		}
+		var taskDef string
+		if svc != nil {
+			taskDef = aws.StringValue(svc.TaskDefinition)
+		}
+		opts = append(opts, stack.WithDeployedTaskDefinition(taskDef), stack.WithActiveTargetGroup(activeTargetGroup(svc)))
+		if svcUpdater, err = d.codeDeployServiceUpdater(); err != nil {
+			return nil, err
+		}
+	}
 
 	var conf cloudformation.StackConfiguration
 	switch {
@@ -180,10 +388,8 @@ func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*s
 	}
 
 	return &svcStackConfigurationOutput{
-		conf: cloudformation.WrapWithTemplateOverrider(conf, d.overrider),
-		svcUpdater: d.newSvcUpdater(func(s *session.Session) serviceForceUpdater {
-			return ecs.New(s)
-		}),
+		conf:       cloudformation.WrapWithTemplateOverrider(conf, d.overrider),
+		svcUpdater: svcUpdater,
 	}, nil
 }
 
@@ -205,6 +411,15 @@ func (d *lbWebSvcDeployer) validateALBRuntime() error {
 }
 
 func (d *lbWebSvcDeployer) validateRuntimeRoutingRule(rule manifest.RoutingRule) error {
+	if d.envConfig.HTTPConfig.Public.ImportedLoadBalancer() != "" {
+		if !rule.Alias.IsEmpty() {
+			return fmt.Errorf(`cannot specify "alias" when env %q imports an existing public load balancer`, d.env.Name)
+		}
+		if aws.BoolValue(rule.RedirectToHTTPS) {
+			return fmt.Errorf(`cannot configure http to https redirect when env %q imports an existing public load balancer`, d.env.Name)
+		}
+		return nil
+	}
 	hasALBCerts := len(d.envConfig.HTTPConfig.Public.Certificates) != 0
 	hasCDNCerts := d.envConfig.CDNConfig.Config.Certificate != nil
 	hasImportedCerts := hasALBCerts || hasCDNCerts
@@ -236,6 +451,9 @@ func (d *lbWebSvcDeployer) validateRuntimeRoutingRule(rule manifest.RoutingRule)
 		if err != nil {
 			return fmt.Errorf("convert aliases to string slice: %w", err)
 		}
+		if err := validateAliasConventions(d.app, aliases...); err != nil {
+			return fmt.Errorf(`validate 'alias': %w`, err)
+		}
 
 		if hasALBCerts {
 			albCertValidator := d.newAliasCertValidator(nil)
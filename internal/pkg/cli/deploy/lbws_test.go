@@ -21,8 +21,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/override"
+	"github.com/spf13/afero"
 )
 
 func TestLbWebSvcDeployer_GenerateCloudFormationTemplate(t *testing.T) {
@@ -124,3 +127,91 @@ func mockLoadBalancedWebServiceDeployer(opts ...func(deployer *lbWebSvcDeployer)
 	}
 	return deployer
 }
+
+func TestLbWebSvcDeployer_GenerateCloudFormationTemplate_invalidOpenAPISpec(t *testing.T) {
+	withSpec := func(content string) func(*lbWebSvcDeployer) {
+		return func(d *lbWebSvcDeployer) {
+			fs := afero.NewMemMapFs()
+			if content != "" {
+				_ = afero.WriteFile(fs, "/ws/api/openapi.yml", []byte(content), 0644)
+			}
+			d.fs = fs
+			d.workspacePath = "/ws"
+			d.lbMft.HTTPOrBool.OpenAPI = manifest.OpenAPI{
+				Spec:                aws.String("api/openapi.yml"),
+				BlockUndefinedPaths: aws.Bool(true),
+			}
+		}
+	}
+	testCases := map[string]struct {
+		deployer *lbWebSvcDeployer
+
+		wantedErr string
+	}{
+		"error if the spec can't be read": {
+			deployer:  mockLoadBalancedWebServiceDeployer(withSpec("")),
+			wantedErr: "read OpenAPI spec api/openapi.yml: open /ws/api/openapi.yml: file does not exist",
+		},
+		"error if the spec is invalid": {
+			deployer:  mockLoadBalancedWebServiceDeployer(withSpec("openapi: 3.0.0\n")),
+			wantedErr: `parse OpenAPI spec api/openapi.yml: OpenAPI spec must define at least one path under "paths"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.deployer.GenerateCloudFormationTemplate(&GenerateCloudFormationTemplateInput{})
+
+			require.EqualError(t, err, tc.wantedErr)
+		})
+	}
+}
+
+func TestActiveTargetGroup(t *testing.T) {
+	const (
+		blueARN  = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/phonetool-test-Target-1/1234"
+		greenARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/phonetool-test-Target-2/5678"
+	)
+	taskSet := func(status, targetGroup string) *sdkecs.TaskSet {
+		return &sdkecs.TaskSet{
+			Status:        aws.String(status),
+			LoadBalancers: []*sdkecs.LoadBalancer{{TargetGroupArn: aws.String(targetGroup)}},
+		}
+	}
+	testCases := map[string]struct {
+		in     *awsecs.Service
+		wanted string
+	}{
+		"blue if the service doesn't exist yet": {
+			wanted: stack.BlueTargetGroup,
+		},
+		"blue if the primary task set is registered to the target group of the service": {
+			in: &awsecs.Service{
+				LoadBalancers: []*sdkecs.LoadBalancer{{TargetGroupArn: aws.String(blueARN)}},
+				TaskSets:      []*sdkecs.TaskSet{taskSet("PRIMARY", blueARN)},
+			},
+			wanted: stack.BlueTargetGroup,
+		},
+		"blue while the replacement tasks are registered to the green target group": {
+			in: &awsecs.Service{
+				LoadBalancers: []*sdkecs.LoadBalancer{{TargetGroupArn: aws.String(blueARN)}},
+				TaskSets: []*sdkecs.TaskSet{
+					taskSet("PRIMARY", blueARN),
+					taskSet("ACTIVE", greenARN),
+				},
+			},
+			wanted: stack.BlueTargetGroup,
+		},
+		"green once CodeDeploy shifted the traffic to the other target group": {
+			in: &awsecs.Service{
+				LoadBalancers: []*sdkecs.LoadBalancer{{TargetGroupArn: aws.String(blueARN)}},
+				TaskSets:      []*sdkecs.TaskSet{taskSet("PRIMARY", greenARN)},
+			},
+			wanted: stack.GreenTargetGroup,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, activeTargetGroup(tc.in))
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	// The lock outlives a single deployment only if Copilot is interrupted before it can release it.
+	deploymentLockTTL          = 2 * time.Hour
+	deploymentLockPollInterval = 10 * time.Second
+	deploymentLockWaitTimeout  = time.Hour
+
+	fmtEnvLoadBalancerLockName = "%s-load-balancer"
+
+	// The ECS service of a workload depends on its listener rules and on the environment controller,
+	// so CloudFormation only starts updating it once the updates that conflict with other deployments are done.
+	workloadLockReleaseResource = "Service"
+)
+
+type deploymentLocker interface {
+	AcquireLock(app, name, owner string, ttl time.Duration) error
+	ReleaseLock(app, name, owner string) error
+}
+
+type stackProgressDescriber interface {
+	Describe(name string) (*awscloudformation.StackDescription, error)
+	StackResources(name string) ([]*awscloudformation.StackResource, error)
+}
+
+// deploymentLock serializes the portion of a deployment that conflicts with concurrent deployments in the same environment,
+// such as updates to the listener rules of a shared load balancer or to the environment stack's exports.
+// Artifacts are still built and uploaded in parallel, only the stack update waits for its turn.
+// Pipelines deploy their stacks with CloudFormation directly, so their deployments don't take the lock.
+type deploymentLock struct {
+	locker  deploymentLocker
+	spinner spinner
+	app     string
+	name    string
+	owner   string
+
+	stackDescriber stackProgressDescriber
+	stackName      string
+	// releaseAt is the logical ID of the first resource that CloudFormation updates once the conflicting updates of the stack are done.
+	// If empty, the lock is held until the stack update is done.
+	releaseAt string
+
+	pollInterval time.Duration
+	timeout      time.Duration
+}
+
+// newEnvLoadBalancerLock returns a lock shared by all the deployments in an environment that update its load balancers.
+// The lock is held while the stack named stackName updates, until the resource releaseAt starts updating if it's not empty.
+func newEnvLoadBalancerLock(locker deploymentLocker, spinner spinner, describer stackProgressDescriber, app, env, owner, stackName, releaseAt string) *deploymentLock {
+	return &deploymentLock{
+		locker:         locker,
+		spinner:        spinner,
+		app:            app,
+		name:           fmt.Sprintf(fmtEnvLoadBalancerLockName, env),
+		owner:          fmt.Sprintf("%s/%d", owner, time.Now().UnixNano()),
+		stackDescriber: describer,
+		stackName:      stackName,
+		releaseAt:      releaseAt,
+		pollInterval:   deploymentLockPollInterval,
+		timeout:        deploymentLockWaitTimeout,
+	}
+}
+
+// Do acquires the lock, waiting for any other holder to release it, and runs deploy, which updates the stack of the lock.
+// The lock is released as soon as the conflicting updates of the stack are done, which can be before deploy returns.
+// If deploy returns before then, for example because it doesn't wait for the stack update, Do waits for them.
+// A nil lock runs deploy right away.
+func (l *deploymentLock) Do(deploy func() error) error {
+	if l == nil {
+		return deploy()
+	}
+	if err := l.acquire(); err != nil {
+		return err
+	}
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			if err := l.locker.ReleaseLock(l.app, l.name, l.owner); err != nil {
+				log.Warningf("Failed to release deployment lock %s, it will expire in %s: %v\n", l.name, deploymentLockTTL, err)
+			}
+		})
+	}
+	defer release()
+
+	// Describe the stack before the deployment updates it, to tell its update apart from a previous one.
+	initial, err := l.describeStack()
+	if err != nil {
+		log.Debugf("Failed to describe stack %s, holding deployment lock %s until the deployment returns: %v\n", l.stackName, l.name, err)
+		return deploy()
+	}
+	deployed := make(chan struct{})
+	waiting := make(chan struct{}, 1)
+	conflictsDone := make(chan struct{})
+	go func() {
+		defer close(conflictsDone)
+		if err := l.waitForConflictingUpdates(initial, deployed, waiting); err != nil {
+			// Hold the lock until deploy returns.
+			log.Debugf("Failed to track the updates of stack %s: %v\n", l.stackName, err)
+			return
+		}
+		release()
+	}()
+	err = deploy()
+	close(deployed)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-conflictsDone:
+	case <-waiting:
+		l.spinner.Start(fmt.Sprintf("Waiting for stack %s to finish the updates that conflict with other deployments.", l.stackName))
+		<-conflictsDone
+		l.spinner.Stop(log.Ssuccessf("Released deployment lock %s.\n", l.name))
+	}
+	return nil
+}
+
+// waitForConflictingUpdates returns once the stack update started by the deployment is past its conflicting updates.
+// It returns right away if the deployment is over without the stack being updated,
+// and notifies waiting if the deployment is over while the conflicting updates are still in progress.
+func (l *deploymentLock) waitForConflictingUpdates(initial *awscloudformation.StackDescription, deployed <-chan struct{}, waiting chan<- struct{}) error {
+	var started bool
+	for {
+		stack, err := l.describeStack()
+		if err != nil {
+			return err
+		}
+		// A previous update of the stack may still be in progress until the deployment starts its own.
+		inProgress := isStackInProgress(stack) && (!isStackInProgress(initial) || !stackUpdateTime(stack).Equal(stackUpdateTime(initial)))
+		switch {
+		case inProgress:
+			started = true
+			done, err := l.isPastConflictingUpdates(stack)
+			if err != nil {
+				return err
+			}
+			if done {
+				return nil
+			}
+		case started:
+			return nil
+		}
+		select {
+		case <-deployed:
+			if !inProgress {
+				return nil
+			}
+			select {
+			case waiting <- struct{}{}:
+			default:
+			}
+		default:
+		}
+		time.Sleep(l.pollInterval)
+	}
+}
+
+// describeStack returns an empty description if the stack doesn't exist yet.
+func (l *deploymentLock) describeStack() (*awscloudformation.StackDescription, error) {
+	stack, err := l.stackDescriber.Describe(l.stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return &awscloudformation.StackDescription{}, nil
+		}
+		return nil, err
+	}
+	return stack, nil
+}
+
+func (l *deploymentLock) isPastConflictingUpdates(stack *awscloudformation.StackDescription) (bool, error) {
+	if l.releaseAt == "" {
+		return false, nil
+	}
+	resources, err := l.stackDescriber.StackResources(l.stackName)
+	if err != nil {
+		return false, err
+	}
+	for _, resource := range resources {
+		if aws.StringValue(resource.LogicalResourceId) != l.releaseAt {
+			continue
+		}
+		if awscloudformation.StackStatus(aws.StringValue(resource.ResourceStatus)).InProgress() {
+			return true, nil
+		}
+		return !aws.TimeValue(resource.Timestamp).Before(stackUpdateTime(stack)), nil
+	}
+	return false, nil
+}
+
+func isStackInProgress(stack *awscloudformation.StackDescription) bool {
+	return awscloudformation.StackStatus(aws.StringValue(stack.StackStatus)).InProgress()
+}
+
+// stackUpdateTime returns the time at which the last update of the stack started.
+func stackUpdateTime(stack *awscloudformation.StackDescription) time.Time {
+	if stack.LastUpdatedTime != nil {
+		return aws.TimeValue(stack.LastUpdatedTime)
+	}
+	return aws.TimeValue(stack.CreationTime)
+}
+
+func (l *deploymentLock) acquire() error {
+	var waiting bool
+	deadline := time.Now().Add(l.timeout)
+	for {
+		err := l.locker.AcquireLock(l.app, l.name, l.owner, deploymentLockTTL)
+		if err == nil {
+			if waiting {
+				l.spinner.Stop(log.Ssuccessf("Acquired deployment lock %s.\n", l.name))
+			}
+			return nil
+		}
+		var errHeld *config.ErrLockHeld
+		if !errors.As(err, &errHeld) {
+			if waiting {
+				l.spinner.Stop(log.Serrorf("Failed to acquire deployment lock %s.\n", l.name))
+			}
+			return err
+		}
+		if time.Now().After(deadline) {
+			if waiting {
+				l.spinner.Stop(log.Serrorf("Timed out waiting for deployment lock %s.\n", l.name))
+			}
+			return fmt.Errorf("wait for %s to release deployment lock %s: timed out after %s", errHeld.Owner, l.name, l.timeout)
+		}
+		if !waiting {
+			l.spinner.Start(fmt.Sprintf("Waiting for %s to release deployment lock %s.", errHeld.Owner, l.name))
+			waiting = true
+		}
+		time.Sleep(l.pollInterval)
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentLock_Do(t *testing.T) {
+	const (
+		mockApp   = "phonetool"
+		mockLock  = "test-load-balancer"
+		mockOwner = "service frontend/1"
+		mockStack = "phonetool-test-frontend"
+	)
+	errHeld := &config.ErrLockHeld{App: mockApp, Name: mockLock, Owner: "service backend/2"}
+	testCases := map[string]struct {
+		setupMocks func(locker *mocks.MockdeploymentLocker, spinner *mocks.Mockspinner)
+		inTimeout  time.Duration
+		inFnErr    error
+
+		wantedFnCalled bool
+		wantedErr      error
+	}{
+		"runs the function while holding the lock": {
+			setupMocks: func(locker *mocks.MockdeploymentLocker, spinner *mocks.Mockspinner) {
+				gomock.InOrder(
+					locker.EXPECT().AcquireLock(mockApp, mockLock, mockOwner, deploymentLockTTL).Return(nil),
+					locker.EXPECT().ReleaseLock(mockApp, mockLock, mockOwner).Return(nil),
+				)
+			},
+			inTimeout:      time.Minute,
+			wantedFnCalled: true,
+		},
+		"releases the lock even if the function fails": {
+			setupMocks: func(locker *mocks.MockdeploymentLocker, spinner *mocks.Mockspinner) {
+				locker.EXPECT().AcquireLock(mockApp, mockLock, mockOwner, deploymentLockTTL).Return(nil)
+				locker.EXPECT().ReleaseLock(mockApp, mockLock, mockOwner).Return(nil)
+			},
+			inTimeout:      time.Minute,
+			inFnErr:        errors.New("some error"),
+			wantedFnCalled: true,
+			wantedErr:      errors.New("some error"),
+		},
+		"does not fail the deployment if the lock cannot be released": {
+			setupMocks: func(locker *mocks.MockdeploymentLocker, spinner *mocks.Mockspinner) {
+				locker.EXPECT().AcquireLock(mockApp, mockLock, mockOwner, deploymentLockTTL).Return(nil)
+				locker.EXPECT().ReleaseLock(mockApp, mockLock, mockOwner).Return(errors.New("some error"))
+			},
+			inTimeout:      time.Minute,
+			wantedFnCalled: true,
+		},
+		"waits for another holder to release the lock": {
+			setupMocks: func(locker *mocks.MockdeploymentLocker, spinner *mocks.Mockspinner) {
+				gomock.InOrder(
+					locker.EXPECT().AcquireLock(mockApp, mockLock, mockOwner, deploymentLockTTL).Return(errHeld),
+					spinner.EXPECT().Start("Waiting for service backend/2 to release deployment lock test-load-balancer."),
+					locker.EXPECT().AcquireLock(mockApp, mockLock, mockOwner, deploymentLockTTL).Return(errHeld),
+					locker.EXPECT().AcquireLock(mockApp, mockLock, mockOwner, deploymentLockTTL).Return(nil),
+					spinner.EXPECT().Stop(gomock.Any()),
+					locker.EXPECT().ReleaseLock(mockApp, mockLock, mockOwner).Return(nil),
+				)
+			},
+			inTimeout:      time.Minute,
+			wantedFnCalled: true,
+		},
+		"errors if the lock cannot be acquired": {
+			setupMocks: func(locker *mocks.MockdeploymentLocker, spinner *mocks.Mockspinner) {
+				locker.EXPECT().AcquireLock(mockApp, mockLock, mockOwner, deploymentLockTTL).Return(errors.New("some error"))
+			},
+			inTimeout: time.Minute,
+			wantedErr: errors.New("some error"),
+		},
+		"errors if the lock is not released in time": {
+			setupMocks: func(locker *mocks.MockdeploymentLocker, spinner *mocks.Mockspinner) {
+				locker.EXPECT().AcquireLock(mockApp, mockLock, mockOwner, deploymentLockTTL).Return(errHeld).MinTimes(1)
+				spinner.EXPECT().Start(gomock.Any()).AnyTimes()
+				spinner.EXPECT().Stop(gomock.Any()).AnyTimes()
+			},
+			inTimeout: 0,
+			wantedErr: errors.New("wait for service backend/2 to release deployment lock test-load-balancer: timed out after 0s"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLocker := mocks.NewMockdeploymentLocker(ctrl)
+			mockSpinner := mocks.NewMockspinner(ctrl)
+			mockDescriber := mocks.NewMockstackProgressDescriber(ctrl)
+			mockDescriber.EXPECT().Describe(mockStack).Return(&awscloudformation.StackDescription{
+				StackStatus: aws.String(sdkcloudformation.StackStatusUpdateComplete),
+			}, nil).AnyTimes()
+			tc.setupMocks(mockLocker, mockSpinner)
+			lock := &deploymentLock{
+				locker:         mockLocker,
+				spinner:        mockSpinner,
+				app:            mockApp,
+				name:           mockLock,
+				owner:          mockOwner,
+				stackDescriber: mockDescriber,
+				stackName:      mockStack,
+				releaseAt:      workloadLockReleaseResource,
+				pollInterval:   time.Millisecond,
+				timeout:        tc.inTimeout,
+			}
+			var called bool
+
+			// WHEN
+			err := lock.Do(func() error {
+				called = true
+				return tc.inFnErr
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedFnCalled, called)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeploymentLock_DoReleasesAfterConflictingUpdates(t *testing.T) {
+	const (
+		mockApp   = "phonetool"
+		mockLock  = "test-load-balancer"
+		mockOwner = "service frontend/1"
+		mockStack = "phonetool-test-frontend"
+	)
+	lastDeployedAt := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	updatedAt := lastDeployedAt.Add(time.Hour)
+	stackWithStatus := func(status string, updatedAt time.Time) *awscloudformation.StackDescription {
+		return &awscloudformation.StackDescription{
+			StackStatus:     aws.String(status),
+			LastUpdatedTime: aws.Time(updatedAt),
+		}
+	}
+	service := func(status string, updatedAt time.Time) []*awscloudformation.StackResource {
+		return []*awscloudformation.StackResource{
+			{
+				LogicalResourceId: aws.String("HTTPListenerRule"),
+				ResourceStatus:    aws.String(sdkcloudformation.ResourceStatusUpdateInProgress),
+				Timestamp:         aws.Time(updatedAt),
+			},
+			{
+				LogicalResourceId: aws.String("Service"),
+				ResourceStatus:    aws.String(status),
+				Timestamp:         aws.Time(updatedAt),
+			},
+		}
+	}
+	t.Run("releases the lock once the service starts updating, before the deployment is done", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockLocker := mocks.NewMockdeploymentLocker(ctrl)
+		mockDescriber := mocks.NewMockstackProgressDescriber(ctrl)
+		released := make(chan struct{})
+		gomock.InOrder(
+			mockLocker.EXPECT().AcquireLock(mockApp, mockLock, mockOwner, deploymentLockTTL).Return(nil),
+			mockDescriber.EXPECT().Describe(mockStack).Return(stackWithStatus(sdkcloudformation.StackStatusUpdateComplete, lastDeployedAt), nil),
+			mockDescriber.EXPECT().Describe(mockStack).Return(stackWithStatus(sdkcloudformation.StackStatusUpdateInProgress, updatedAt), nil),
+			mockDescriber.EXPECT().StackResources(mockStack).Return(service(sdkcloudformation.ResourceStatusUpdateComplete, lastDeployedAt), nil),
+			mockDescriber.EXPECT().Describe(mockStack).Return(stackWithStatus(sdkcloudformation.StackStatusUpdateInProgress, updatedAt), nil),
+			mockDescriber.EXPECT().StackResources(mockStack).Return(service(sdkcloudformation.ResourceStatusUpdateInProgress, updatedAt), nil),
+			mockLocker.EXPECT().ReleaseLock(mockApp, mockLock, mockOwner).DoAndReturn(func(_, _, _ string) error {
+				close(released)
+				return nil
+			}),
+		)
+		lock := &deploymentLock{
+			locker:         mockLocker,
+			app:            mockApp,
+			name:           mockLock,
+			owner:          mockOwner,
+			stackDescriber: mockDescriber,
+			stackName:      mockStack,
+			releaseAt:      workloadLockReleaseResource,
+			pollInterval:   time.Millisecond,
+			timeout:        time.Minute,
+		}
+
+		// WHEN
+		err := lock.Do(func() error {
+			// The deployment renders the stack until it's done, which happens after the lock is released.
+			<-released
+			return nil
+		})
+
+		// THEN
+		require.NoError(t, err)
+	})
+	t.Run("holds the lock until the conflicting updates are done when the deployment is detached", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockLocker := mocks.NewMockdeploymentLocker(ctrl)
+		mockSpinner := mocks.NewMockspinner(ctrl)
+		mockDescriber := mocks.NewMockstackProgressDescriber(ctrl)
+		detached := make(chan struct{})
+		gomock.InOrder(
+			mockLocker.EXPECT().AcquireLock(mockApp, mockLock, mockOwner, deploymentLockTTL).Return(nil),
+			mockDescriber.EXPECT().Describe(mockStack).Return(stackWithStatus(sdkcloudformation.StackStatusUpdateComplete, lastDeployedAt), nil),
+			mockDescriber.EXPECT().Describe(mockStack).DoAndReturn(func(_ string) (*awscloudformation.StackDescription, error) {
+				<-detached
+				return stackWithStatus(sdkcloudformation.StackStatusUpdateInProgress, updatedAt), nil
+			}),
+			mockDescriber.EXPECT().StackResources(mockStack).Return(service(sdkcloudformation.ResourceStatusUpdateComplete, lastDeployedAt), nil),
+			mockDescriber.EXPECT().Describe(mockStack).Return(stackWithStatus(sdkcloudformation.StackStatusUpdateInProgress, updatedAt), nil),
+			mockDescriber.EXPECT().StackResources(mockStack).Return(service(sdkcloudformation.ResourceStatusUpdateComplete, updatedAt), nil),
+			mockLocker.EXPECT().ReleaseLock(mockApp, mockLock, mockOwner).Return(nil),
+		)
+		mockSpinner.EXPECT().Start("Waiting for stack phonetool-test-frontend to finish the updates that conflict with other deployments.")
+		mockSpinner.EXPECT().Stop(gomock.Any())
+		lock := &deploymentLock{
+			locker:         mockLocker,
+			spinner:        mockSpinner,
+			app:            mockApp,
+			name:           mockLock,
+			owner:          mockOwner,
+			stackDescriber: mockDescriber,
+			stackName:      mockStack,
+			releaseAt:      workloadLockReleaseResource,
+			pollInterval:   time.Millisecond,
+			timeout:        time.Minute,
+		}
+
+		// WHEN
+		err := lock.Do(func() error {
+			close(detached)
+			return nil
+		})
+
+		// THEN
+		require.NoError(t, err)
+	})
+	t.Run("holds the lock until the environment stack is updated", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockLocker := mocks.NewMockdeploymentLocker(ctrl)
+		mockSpinner := mocks.NewMockspinner(ctrl)
+		mockDescriber := mocks.NewMockstackProgressDescriber(ctrl)
+		detached := make(chan struct{})
+		gomock.InOrder(
+			mockLocker.EXPECT().AcquireLock(mockApp, mockLock, mockOwner, deploymentLockTTL).Return(nil),
+			mockDescriber.EXPECT().Describe("phonetool-test").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateComplete, lastDeployedAt), nil),
+			mockDescriber.EXPECT().Describe("phonetool-test").DoAndReturn(func(_ string) (*awscloudformation.StackDescription, error) {
+				<-detached
+				return stackWithStatus(sdkcloudformation.StackStatusUpdateInProgress, updatedAt), nil
+			}),
+			mockDescriber.EXPECT().Describe("phonetool-test").Return(stackWithStatus(sdkcloudformation.StackStatusUpdateComplete, updatedAt), nil),
+			mockLocker.EXPECT().ReleaseLock(mockApp, mockLock, mockOwner).Return(nil),
+		)
+		mockSpinner.EXPECT().Start(gomock.Any())
+		mockSpinner.EXPECT().Stop(gomock.Any())
+		lock := &deploymentLock{
+			locker:         mockLocker,
+			spinner:        mockSpinner,
+			app:            mockApp,
+			name:           mockLock,
+			owner:          mockOwner,
+			stackDescriber: mockDescriber,
+			stackName:      "phonetool-test",
+			pollInterval:   time.Millisecond,
+			timeout:        time.Minute,
+		}
+
+		// WHEN
+		err := lock.Do(func() error {
+			close(detached)
+			return nil
+		})
+
+		// THEN
+		require.NoError(t, err)
+	})
+}
+
+func TestDeploymentLock_DoWithoutLock(t *testing.T) {
+	var lock *deploymentLock
+	var called bool
+
+	err := lock.Do(func() error {
+		called = true
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.True(t, called)
+}
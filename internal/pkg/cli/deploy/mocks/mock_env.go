@@ -416,6 +416,63 @@ func (mr *MocklbDescriberMockRecorder) DescribeRule(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRule", reflect.TypeOf((*MocklbDescriber)(nil).DescribeRule), arg0, arg1)
 }
 
+// LoadBalancer mocks base method.
+func (m *MocklbDescriber) LoadBalancer(nameOrARN string) (*elbv2.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadBalancer", nameOrARN)
+	ret0, _ := ret[0].(*elbv2.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadBalancer indicates an expected call of LoadBalancer.
+func (mr *MocklbDescriberMockRecorder) LoadBalancer(nameOrARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancer", reflect.TypeOf((*MocklbDescriber)(nil).LoadBalancer), nameOrARN)
+}
+
+// MockclusterDescriber is a mock of clusterDescriber interface.
+type MockclusterDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockclusterDescriberMockRecorder
+}
+
+// MockclusterDescriberMockRecorder is the mock recorder for MockclusterDescriber.
+type MockclusterDescriberMockRecorder struct {
+	mock *MockclusterDescriber
+}
+
+// NewMockclusterDescriber creates a new mock instance.
+func NewMockclusterDescriber(ctrl *gomock.Controller) *MockclusterDescriber {
+	mock := &MockclusterDescriber{ctrl: ctrl}
+	mock.recorder = &MockclusterDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclusterDescriber) EXPECT() *MockclusterDescriberMockRecorder {
+	return m.recorder
+}
+
+// ActiveClusters mocks base method.
+func (m *MockclusterDescriber) ActiveClusters(arns ...string) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ActiveClusters", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveClusters indicates an expected call of ActiveClusters.
+func (mr *MockclusterDescriberMockRecorder) ActiveClusters(arns ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveClusters", reflect.TypeOf((*MockclusterDescriber)(nil).ActiveClusters), arns...)
+}
+
 // MockstackDescriber is a mock of stackDescriber interface.
 type MockstackDescriber struct {
 	ctrl     *gomock.Controller
@@ -7,6 +7,8 @@ package mocks
 import (
 	reflect "reflect"
 
+	codedeploy "github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -47,3 +49,108 @@ func (mr *MockpublicCIDRBlocksGetterMockRecorder) PublicCIDRBlocks() *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicCIDRBlocks", reflect.TypeOf((*MockpublicCIDRBlocksGetter)(nil).PublicCIDRBlocks))
 }
+
+// MockecsServiceDescriber is a mock of ecsServiceDescriber interface.
+type MockecsServiceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceDescriberMockRecorder
+}
+
+// MockecsServiceDescriberMockRecorder is the mock recorder for MockecsServiceDescriber.
+type MockecsServiceDescriberMockRecorder struct {
+	mock *MockecsServiceDescriber
+}
+
+// NewMockecsServiceDescriber creates a new mock instance.
+func NewMockecsServiceDescriber(ctrl *gomock.Controller) *MockecsServiceDescriber {
+	mock := &MockecsServiceDescriber{ctrl: ctrl}
+	mock.recorder = &MockecsServiceDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsServiceDescriber) EXPECT() *MockecsServiceDescriberMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method.
+func (m *MockecsServiceDescriber) Service(app, env, svc string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", app, env, svc)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockecsServiceDescriberMockRecorder) Service(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceDescriber)(nil).Service), app, env, svc)
+}
+
+// TaskDefinition mocks base method.
+func (m *MockecsServiceDescriber) TaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", app, env, svc)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MockecsServiceDescriberMockRecorder) TaskDefinition(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockecsServiceDescriber)(nil).TaskDefinition), app, env, svc)
+}
+
+// MockcodeDeployer is a mock of codeDeployer interface.
+type MockcodeDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockcodeDeployerMockRecorder
+}
+
+// MockcodeDeployerMockRecorder is the mock recorder for MockcodeDeployer.
+type MockcodeDeployerMockRecorder struct {
+	mock *MockcodeDeployer
+}
+
+// NewMockcodeDeployer creates a new mock instance.
+func NewMockcodeDeployer(ctrl *gomock.Controller) *MockcodeDeployer {
+	mock := &MockcodeDeployer{ctrl: ctrl}
+	mock.recorder = &MockcodeDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcodeDeployer) EXPECT() *MockcodeDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployECSService mocks base method.
+func (m *MockcodeDeployer) DeployECSService(in codedeploy.ECSDeployment) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployECSService", in)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployECSService indicates an expected call of DeployECSService.
+func (mr *MockcodeDeployerMockRecorder) DeployECSService(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployECSService", reflect.TypeOf((*MockcodeDeployer)(nil).DeployECSService), in)
+}
+
+// WaitForDeployment mocks base method.
+func (m *MockcodeDeployer) WaitForDeployment(deploymentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForDeployment", deploymentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForDeployment indicates an expected call of WaitForDeployment.
+func (mr *MockcodeDeployerMockRecorder) WaitForDeployment(deploymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForDeployment", reflect.TypeOf((*MockcodeDeployer)(nil).WaitForDeployment), deploymentID)
+}
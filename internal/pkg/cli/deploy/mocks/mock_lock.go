@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/cli/deploy/lock.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockdeploymentLocker is a mock of deploymentLocker interface.
+type MockdeploymentLocker struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentLockerMockRecorder
+}
+
+// MockdeploymentLockerMockRecorder is the mock recorder for MockdeploymentLocker.
+type MockdeploymentLockerMockRecorder struct {
+	mock *MockdeploymentLocker
+}
+
+// NewMockdeploymentLocker creates a new mock instance.
+func NewMockdeploymentLocker(ctrl *gomock.Controller) *MockdeploymentLocker {
+	mock := &MockdeploymentLocker{ctrl: ctrl}
+	mock.recorder = &MockdeploymentLockerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploymentLocker) EXPECT() *MockdeploymentLockerMockRecorder {
+	return m.recorder
+}
+
+// AcquireLock mocks base method.
+func (m *MockdeploymentLocker) AcquireLock(app, name, owner string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireLock", app, name, owner, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcquireLock indicates an expected call of AcquireLock.
+func (mr *MockdeploymentLockerMockRecorder) AcquireLock(app, name, owner, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireLock", reflect.TypeOf((*MockdeploymentLocker)(nil).AcquireLock), app, name, owner, ttl)
+}
+
+// ReleaseLock mocks base method.
+func (m *MockdeploymentLocker) ReleaseLock(app, name, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLock", app, name, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseLock indicates an expected call of ReleaseLock.
+func (mr *MockdeploymentLockerMockRecorder) ReleaseLock(app, name, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLock", reflect.TypeOf((*MockdeploymentLocker)(nil).ReleaseLock), app, name, owner)
+}
+
+// MockstackProgressDescriber is a mock of stackProgressDescriber interface.
+type MockstackProgressDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackProgressDescriberMockRecorder
+}
+
+// MockstackProgressDescriberMockRecorder is the mock recorder for MockstackProgressDescriber.
+type MockstackProgressDescriberMockRecorder struct {
+	mock *MockstackProgressDescriber
+}
+
+// NewMockstackProgressDescriber creates a new mock instance.
+func NewMockstackProgressDescriber(ctrl *gomock.Controller) *MockstackProgressDescriber {
+	mock := &MockstackProgressDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackProgressDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackProgressDescriber) EXPECT() *MockstackProgressDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstackProgressDescriber) Describe(name string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstackProgressDescriberMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackProgressDescriber)(nil).Describe), name)
+}
+
+// StackResources mocks base method.
+func (m *MockstackProgressDescriber) StackResources(name string) ([]*cloudformation.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources", name)
+	ret0, _ := ret[0].([]*cloudformation.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockstackProgressDescriberMockRecorder) StackResources(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackProgressDescriber)(nil).StackResources), name)
+}
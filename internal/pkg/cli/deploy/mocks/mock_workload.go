@@ -10,7 +10,11 @@ import (
 	reflect "reflect"
 
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	pricing "github.com/aws/copilot-cli/internal/pkg/aws/pricing"
+	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	gomock "github.com/golang/mock/gomock"
@@ -397,6 +401,196 @@ func (mr *MockdeployedTemplateGetterMockRecorder) Template(stackName interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockdeployedTemplateGetter)(nil).Template), stackName)
 }
 
+// MockecsServiceGetter is a mock of ecsServiceGetter interface.
+type MockecsServiceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceGetterMockRecorder
+}
+
+// MockecsServiceGetterMockRecorder is the mock recorder for MockecsServiceGetter.
+type MockecsServiceGetterMockRecorder struct {
+	mock *MockecsServiceGetter
+}
+
+// NewMockecsServiceGetter creates a new mock instance.
+func NewMockecsServiceGetter(ctrl *gomock.Controller) *MockecsServiceGetter {
+	mock := &MockecsServiceGetter{ctrl: ctrl}
+	mock.recorder = &MockecsServiceGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsServiceGetter) EXPECT() *MockecsServiceGetterMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method.
+func (m *MockecsServiceGetter) Service(app, env, svc string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", app, env, svc)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockecsServiceGetterMockRecorder) Service(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceGetter)(nil).Service), app, env, svc)
+}
+
+// MocktaskDefinitionGetter is a mock of taskDefinitionGetter interface.
+type MocktaskDefinitionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskDefinitionGetterMockRecorder
+}
+
+// MocktaskDefinitionGetterMockRecorder is the mock recorder for MocktaskDefinitionGetter.
+type MocktaskDefinitionGetterMockRecorder struct {
+	mock *MocktaskDefinitionGetter
+}
+
+// NewMocktaskDefinitionGetter creates a new mock instance.
+func NewMocktaskDefinitionGetter(ctrl *gomock.Controller) *MocktaskDefinitionGetter {
+	mock := &MocktaskDefinitionGetter{ctrl: ctrl}
+	mock.recorder = &MocktaskDefinitionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskDefinitionGetter) EXPECT() *MocktaskDefinitionGetterMockRecorder {
+	return m.recorder
+}
+
+// TaskDefinition mocks base method.
+func (m *MocktaskDefinitionGetter) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MocktaskDefinitionGetterMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MocktaskDefinitionGetter)(nil).TaskDefinition), taskDefName)
+}
+
+// MockscalableTargetGetter is a mock of scalableTargetGetter interface.
+type MockscalableTargetGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockscalableTargetGetterMockRecorder
+}
+
+// MockscalableTargetGetterMockRecorder is the mock recorder for MockscalableTargetGetter.
+type MockscalableTargetGetterMockRecorder struct {
+	mock *MockscalableTargetGetter
+}
+
+// NewMockscalableTargetGetter creates a new mock instance.
+func NewMockscalableTargetGetter(ctrl *gomock.Controller) *MockscalableTargetGetter {
+	mock := &MockscalableTargetGetter{ctrl: ctrl}
+	mock.recorder = &MockscalableTargetGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscalableTargetGetter) EXPECT() *MockscalableTargetGetterMockRecorder {
+	return m.recorder
+}
+
+// ECSServiceScalableTarget mocks base method.
+func (m *MockscalableTargetGetter) ECSServiceScalableTarget(cluster, service string) (*aas.ScalableTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScalableTarget", cluster, service)
+	ret0, _ := ret[0].(*aas.ScalableTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScalableTarget indicates an expected call of ECSServiceScalableTarget.
+func (mr *MockscalableTargetGetterMockRecorder) ECSServiceScalableTarget(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScalableTarget", reflect.TypeOf((*MockscalableTargetGetter)(nil).ECSServiceScalableTarget), cluster, service)
+}
+
+// MockproductsGetter is a mock of productsGetter interface.
+type MockproductsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockproductsGetterMockRecorder
+}
+
+// MockproductsGetterMockRecorder is the mock recorder for MockproductsGetter.
+type MockproductsGetterMockRecorder struct {
+	mock *MockproductsGetter
+}
+
+// NewMockproductsGetter creates a new mock instance.
+func NewMockproductsGetter(ctrl *gomock.Controller) *MockproductsGetter {
+	mock := &MockproductsGetter{ctrl: ctrl}
+	mock.recorder = &MockproductsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproductsGetter) EXPECT() *MockproductsGetterMockRecorder {
+	return m.recorder
+}
+
+// Products mocks base method.
+func (m *MockproductsGetter) Products(serviceCode, region string, attributes map[string]string) ([]pricing.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Products", serviceCode, region, attributes)
+	ret0, _ := ret[0].([]pricing.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Products indicates an expected call of Products.
+func (mr *MockproductsGetterMockRecorder) Products(serviceCode, region, attributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Products", reflect.TypeOf((*MockproductsGetter)(nil).Products), serviceCode, region, attributes)
+}
+
+// MockstackParametersGetter is a mock of stackParametersGetter interface.
+type MockstackParametersGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackParametersGetterMockRecorder
+}
+
+// MockstackParametersGetterMockRecorder is the mock recorder for MockstackParametersGetter.
+type MockstackParametersGetterMockRecorder struct {
+	mock *MockstackParametersGetter
+}
+
+// NewMockstackParametersGetter creates a new mock instance.
+func NewMockstackParametersGetter(ctrl *gomock.Controller) *MockstackParametersGetter {
+	mock := &MockstackParametersGetter{ctrl: ctrl}
+	mock.recorder = &MockstackParametersGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackParametersGetter) EXPECT() *MockstackParametersGetterMockRecorder {
+	return m.recorder
+}
+
+// StackParameters mocks base method.
+func (m *MockstackParametersGetter) StackParameters(stackName string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackParameters", stackName)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackParameters indicates an expected call of StackParameters.
+func (mr *MockstackParametersGetterMockRecorder) StackParameters(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackParameters", reflect.TypeOf((*MockstackParametersGetter)(nil).StackParameters), stackName)
+}
+
 // Mockspinner is a mock of spinner interface.
 type Mockspinner struct {
 	ctrl     *gomock.Controller
@@ -493,6 +687,120 @@ func (mr *MockLabeledTermPrinterMockRecorder) Print() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockLabeledTermPrinter)(nil).Print))
 }
 
+// MocksecretGetter is a mock of secretGetter interface.
+type MocksecretGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretGetterMockRecorder
+}
+
+// MocksecretGetterMockRecorder is the mock recorder for MocksecretGetter.
+type MocksecretGetterMockRecorder struct {
+	mock *MocksecretGetter
+}
+
+// NewMocksecretGetter creates a new mock instance.
+func NewMocksecretGetter(ctrl *gomock.Controller) *MocksecretGetter {
+	mock := &MocksecretGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretGetter) EXPECT() *MocksecretGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSecretValue mocks base method.
+func (m *MocksecretGetter) GetSecretValue(ctx context.Context, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretValue", ctx, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretValue indicates an expected call of GetSecretValue.
+func (mr *MocksecretGetterMockRecorder) GetSecretValue(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MocksecretGetter)(nil).GetSecretValue), ctx, name)
+}
+
+// MockssmDocumentGetter is a mock of ssmDocumentGetter interface.
+type MockssmDocumentGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmDocumentGetterMockRecorder
+}
+
+// MockssmDocumentGetterMockRecorder is the mock recorder for MockssmDocumentGetter.
+type MockssmDocumentGetterMockRecorder struct {
+	mock *MockssmDocumentGetter
+}
+
+// NewMockssmDocumentGetter creates a new mock instance.
+func NewMockssmDocumentGetter(ctrl *gomock.Controller) *MockssmDocumentGetter {
+	mock := &MockssmDocumentGetter{ctrl: ctrl}
+	mock.recorder = &MockssmDocumentGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmDocumentGetter) EXPECT() *MockssmDocumentGetterMockRecorder {
+	return m.recorder
+}
+
+// DocumentContent mocks base method.
+func (m *MockssmDocumentGetter) DocumentContent(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DocumentContent", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DocumentContent indicates an expected call of DocumentContent.
+func (mr *MockssmDocumentGetterMockRecorder) DocumentContent(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DocumentContent", reflect.TypeOf((*MockssmDocumentGetter)(nil).DocumentContent), name)
+}
+
+// MockssmParameterLister is a mock of ssmParameterLister interface.
+type MockssmParameterLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmParameterListerMockRecorder
+}
+
+// MockssmParameterListerMockRecorder is the mock recorder for MockssmParameterLister.
+type MockssmParameterListerMockRecorder struct {
+	mock *MockssmParameterLister
+}
+
+// NewMockssmParameterLister creates a new mock instance.
+func NewMockssmParameterLister(ctrl *gomock.Controller) *MockssmParameterLister {
+	mock := &MockssmParameterLister{ctrl: ctrl}
+	mock.recorder = &MockssmParameterListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmParameterLister) EXPECT() *MockssmParameterListerMockRecorder {
+	return m.recorder
+}
+
+// ListSecrets mocks base method.
+func (m *MockssmParameterLister) ListSecrets(path string) ([]ssm.SecretMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", path)
+	ret0, _ := ret[0].([]ssm.SecretMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockssmParameterListerMockRecorder) ListSecrets(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockssmParameterLister)(nil).ListSecrets), path)
+}
+
 // MockdockerEngineRunChecker is a mock of dockerEngineRunChecker interface.
 type MockdockerEngineRunChecker struct {
 	ctrl     *gomock.Controller
@@ -516,18 +824,83 @@ func (m *MockdockerEngineRunChecker) EXPECT() *MockdockerEngineRunCheckerMockRec
 	return m.recorder
 }
 
-// CheckDockerEngineRunning mocks base method.
-func (m *MockdockerEngineRunChecker) CheckDockerEngineRunning() error {
+// AnalyzeBuildContext mocks base method.
+func (m *MockdockerEngineRunChecker) AnalyzeBuildContext(contextDir, dockerfile string) (*dockerengine.BuildContext, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "AnalyzeBuildContext", contextDir, dockerfile)
+	ret0, _ := ret[0].(*dockerengine.BuildContext)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnalyzeBuildContext indicates an expected call of AnalyzeBuildContext.
+func (mr *MockdockerEngineRunCheckerMockRecorder) AnalyzeBuildContext(contextDir, dockerfile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeBuildContext", reflect.TypeOf((*MockdockerEngineRunChecker)(nil).AnalyzeBuildContext), contextDir, dockerfile)
+}
+
+// Preflight mocks base method.
+func (m *MockdockerEngineRunChecker) Preflight(platforms []string) ([]dockerengine.PreflightWarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preflight", platforms)
+	ret0, _ := ret[0].([]dockerengine.PreflightWarning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preflight indicates an expected call of Preflight.
+func (mr *MockdockerEngineRunCheckerMockRecorder) Preflight(platforms interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preflight", reflect.TypeOf((*MockdockerEngineRunChecker)(nil).Preflight), platforms)
+}
+
+// MockssmParametersInjector is a mock of ssmParametersInjector interface.
+type MockssmParametersInjector struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmParametersInjectorMockRecorder
+}
+
+// MockssmParametersInjectorMockRecorder is the mock recorder for MockssmParametersInjector.
+type MockssmParametersInjectorMockRecorder struct {
+	mock *MockssmParametersInjector
+}
+
+// NewMockssmParametersInjector creates a new mock instance.
+func NewMockssmParametersInjector(ctrl *gomock.Controller) *MockssmParametersInjector {
+	mock := &MockssmParametersInjector{ctrl: ctrl}
+	mock.recorder = &MockssmParametersInjectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmParametersInjector) EXPECT() *MockssmParametersInjectorMockRecorder {
+	return m.recorder
+}
+
+// InjectSSMParameters mocks base method.
+func (m *MockssmParametersInjector) InjectSSMParameters(names []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InjectSSMParameters", names)
+}
+
+// InjectSSMParameters indicates an expected call of InjectSSMParameters.
+func (mr *MockssmParametersInjectorMockRecorder) InjectSSMParameters(names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectSSMParameters", reflect.TypeOf((*MockssmParametersInjector)(nil).InjectSSMParameters), names)
+}
+
+// SSMParameterPath mocks base method.
+func (m *MockssmParametersInjector) SSMParameterPath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSMParameterPath")
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
-func (mr *MockdockerEngineRunCheckerMockRecorder) CheckDockerEngineRunning() *gomock.Call {
+// SSMParameterPath indicates an expected call of SSMParameterPath.
+func (mr *MockssmParametersInjectorMockRecorder) SSMParameterPath() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockdockerEngineRunChecker)(nil).CheckDockerEngineRunning))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSMParameterPath", reflect.TypeOf((*MockssmParametersInjector)(nil).SSMParameterPath))
 }
 
 // MocktimeoutError is a mock of timeoutError interface.
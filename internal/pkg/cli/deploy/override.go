@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/aws/copilot-cli/internal/pkg/override"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/spf13/afero"
 )
@@ -22,9 +23,28 @@ type UserAgentAdder interface {
 	UserAgentExtras(extras ...string)
 }
 
+// OverriderOption is a functional option to configure the Overrider returned by NewOverrider.
+type OverriderOption func(opts *overriderOpts)
+
+type overriderOpts struct {
+	disableCache bool
+}
+
+// WithOverrideCacheDisabled forces CDK overrides to be synthesized instead of reusing a cached template.
+func WithOverrideCacheDisabled() OverriderOption {
+	return func(opts *overriderOpts) {
+		opts.disableCache = true
+	}
+}
+
 // NewOverrider looks up if a CDK or YAMLPatch Overrider exists at pathsToOverriderDir and initializes the respective Overrider.
 // If the directory is empty, then returns a noop Overrider.
-func NewOverrider(pathToOverridesDir, app, env string, fs afero.Fs, sess UserAgentAdder) (Overrider, error) {
+func NewOverrider(pathToOverridesDir, app, env string, fs afero.Fs, sess UserAgentAdder, opts ...OverriderOption) (Overrider, error) {
+	var conf overriderOpts
+	for _, opt := range opts {
+		opt(&conf)
+	}
+
 	info, err := override.Lookup(pathToOverridesDir, fs)
 	if err != nil {
 		var errNotExist *override.ErrNotExist
@@ -38,18 +58,19 @@ func NewOverrider(pathToOverridesDir, app, env string, fs afero.Fs, sess UserAge
 		sess.UserAgentExtras("override cdk")
 		// Write out-of-band info from sub-commands to stderr as users expect stdout to only
 		// contain the final override output.
-		opts := override.CDKOpts{
+		cdkOpts := override.CDKOpts{
 			FS:         fs,
 			ExecWriter: log.DiagnosticWriter,
 			EnvVars: map[string]string{
 				"COPILOT_APPLICATION_NAME": app,
 			},
+			DisableCache: conf.disableCache,
 		}
 		if env != "" {
-			opts.EnvVars["COPILOT_ENVIRONMENT_NAME"] = env
+			cdkOpts.EnvVars["COPILOT_ENVIRONMENT_NAME"] = env
 		}
 
-		return override.WithCDK(info.Path(), opts), nil
+		return override.WithCDK(info.Path(), cdkOpts), nil
 	case info.IsYAMLPatch():
 		sess.UserAgentExtras("override yamlpatch")
 		return override.WithPatch(info.Path(), override.PatchOpts{
@@ -59,3 +80,36 @@ func NewOverrider(pathToOverridesDir, app, env string, fs afero.Fs, sess UserAge
 		return new(override.Noop), nil
 	}
 }
+
+type stackMetadataOverrider struct {
+	overrider Overrider
+	metadata  map[string]string
+}
+
+// Override applies the overrides and then adds the metadata to the template.
+func (o *stackMetadataOverrider) Override(body []byte) ([]byte, error) {
+	out, err := o.overrider.Override(body)
+	if err != nil {
+		return nil, err
+	}
+	tpl, err := template.AddStackMetadata(string(out), o.metadata)
+	if err != nil {
+		return nil, fmt.Errorf("add stack metadata: %w", err)
+	}
+	return []byte(tpl), nil
+}
+
+// WithStackMetadata returns an Overrider that adds the application's stack metadata to the template
+// after overrider runs, so that the keys required by CloudFormation Hooks can't be overridden away.
+func WithStackMetadata(overrider Overrider, metadata map[string]string) Overrider {
+	if overrider == nil {
+		overrider = new(override.Noop)
+	}
+	if len(metadata) == 0 {
+		return overrider
+	}
+	return &stackMetadataOverrider{
+		overrider: overrider,
+		metadata:  metadata,
+	}
+}
@@ -4,6 +4,7 @@
 package deploy
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
 
@@ -64,3 +65,60 @@ func TestNewOverrider(t *testing.T) {
 		require.Contains(t, sess.UserAgent, "override cdk")
 	})
 }
+
+type mockTemplateOverrider struct {
+	out []byte
+	err error
+}
+
+func (m *mockTemplateOverrider) Override(_ []byte) ([]byte, error) {
+	return m.out, m.err
+}
+
+func TestWithStackMetadata(t *testing.T) {
+	testCases := map[string]struct {
+		overrider Overrider
+		metadata  map[string]string
+
+		wanted    string
+		wantedErr string
+	}{
+		"should not transform the template without metadata": {
+			overrider: new(override.Noop),
+			wanted:    "Resources: {}\n",
+		},
+		"should add the metadata after the overrides are applied": {
+			overrider: &mockTemplateOverrider{
+				out: []byte("Metadata:\n  Version: v1.30.0\nResources: {}\n"),
+			},
+			metadata: map[string]string{
+				"CostCenter": "1234",
+				"Version":    "v0.0.0",
+			},
+			wanted: "Metadata:\n  Version: v1.30.0\n  CostCenter: \"1234\"\nResources: {}\n",
+		},
+		"should return the error from the overrider": {
+			overrider: &mockTemplateOverrider{
+				err: errors.New("some error"),
+			},
+			metadata: map[string]string{
+				"CostCenter": "1234",
+			},
+			wantedErr: "some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			out, err := WithStackMetadata(tc.overrider, tc.metadata).Override([]byte("Resources: {}\n"))
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(out))
+		})
+	}
+}
@@ -137,3 +137,64 @@ func isManagerRoleAllowedToUpload(body string) (bool, error) {
 	}
 	return true, nil
 }
+
+// customResourceRuntime is the Lambda runtime of the functions backing the custom resources of an environment.
+const customResourceRuntime = "nodejs16.x"
+
+// deprecatedLambdaRuntimes are the Node.js runtimes that Lambda no longer supports for function updates.
+var deprecatedLambdaRuntimes = map[string]bool{
+	"nodejs":     true,
+	"nodejs4.3":  true,
+	"nodejs6.10": true,
+	"nodejs8.10": true,
+	"nodejs10.x": true,
+	"nodejs12.x": true,
+	"nodejs14.x": true,
+}
+
+// UpgradeCustomResourceRuntimes moves the Lambda functions of the environment that run on a deprecated runtime
+// to the runtime of the latest custom resources, without changing the rest of the environment template.
+// It returns false if none of the functions needed to be upgraded.
+func (p *EnvironmentPatcher) UpgradeCustomResourceRuntimes() (bool, error) {
+	body, err := p.TemplatePatcher.Template(stack.NameForEnv(p.Env.App, p.Env.Name))
+	if err != nil {
+		return false, fmt.Errorf("get environment template for %q: %w", p.Env.Name, err)
+	}
+	type Template struct {
+		Resources map[string]struct {
+			Type       string `yaml:"Type"`
+			Properties struct {
+				Runtime yaml.Node `yaml:"Runtime"`
+			} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+	var tpl Template
+	if err := yaml.Unmarshal([]byte(body), &tpl); err != nil {
+		return false, fmt.Errorf("unmarshal environment template to find Lambda function runtimes: %v", err)
+	}
+	lines := strings.Split(body, "\n")
+	var upgraded bool
+	for _, resource := range tpl.Resources {
+		runtime := resource.Properties.Runtime
+		if resource.Type != "AWS::Lambda::Function" || !deprecatedLambdaRuntimes[runtime.Value] {
+			continue
+		}
+		// lines and columns are 1-indexed, so we have to subtract one from each.
+		line, col := runtime.Line-1, runtime.Column-1
+		lines[line] = lines[line][:col] + strings.Replace(lines[line][col:], runtime.Value, customResourceRuntime, 1)
+		upgraded = true
+	}
+	if !upgraded {
+		return false, nil
+	}
+
+	var errEmptyChangeSet *cloudformation.ErrChangeSetEmpty
+	p.Prog.Start(fmt.Sprintf("Upgrade the custom resources of environment %s to the %s runtime", p.Env.Name, customResourceRuntime))
+	err = p.TemplatePatcher.UpdateEnvironmentTemplate(p.Env.App, p.Env.Name, strings.Join(lines, "\n"), p.Env.ExecutionRoleARN)
+	if err != nil && !errors.As(err, &errEmptyChangeSet) {
+		p.Prog.Stop(log.Serrorf("Unable to upgrade the custom resources of environment %s\n", p.Env.Name))
+		return false, fmt.Errorf("update environment template with the %s runtime: %v", customResourceRuntime, err)
+	}
+	p.Prog.Stop(log.Ssuccessf("Upgraded the custom resources of environment %s to the %s runtime\n", p.Env.Name, customResourceRuntime))
+	return true, nil
+}
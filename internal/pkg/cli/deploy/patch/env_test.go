@@ -135,3 +135,134 @@ Resources:
 		})
 	}
 }
+
+func TestEnvironmentPatcher_UpgradeCustomResourceRuntimes(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks     func(m *envPatcherMock)
+		wantedUpgraded bool
+		wantedError    error
+	}{
+		"error getting environment template": {
+			setupMocks: func(m *envPatcherMock) {
+				m.templatePatcher.EXPECT().Template(stack.NameForEnv("mockApp", "mockEnv")).
+					Return("", errors.New("some error"))
+			},
+			wantedError: errors.New(`get environment template for "mockEnv": some error`),
+		},
+		"does not update the environment if no function is on a deprecated runtime": {
+			setupMocks: func(m *envPatcherMock) {
+				m.templatePatcher.EXPECT().Template(stack.NameForEnv("mockApp", "mockEnv")).Return(`
+Resources:
+  DNSDelegationFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Runtime: nodejs16.x
+  Cluster:
+    Type: AWS::ECS::Cluster`, nil)
+			},
+		},
+		"error updating the environment template with the new runtime": {
+			setupMocks: func(m *envPatcherMock) {
+				m.templatePatcher.EXPECT().Template(stack.NameForEnv("mockApp", "mockEnv")).Return(`
+Resources:
+  DNSDelegationFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Runtime: nodejs12.x`, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.templatePatcher.EXPECT().UpdateEnvironmentTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("update environment template with the nodejs16.x runtime: some error"),
+		},
+		"upgrades only the functions on a deprecated runtime": {
+			setupMocks: func(m *envPatcherMock) {
+				m.templatePatcher.EXPECT().Template(stack.NameForEnv("mockApp", "mockEnv")).Return(`
+Resources:
+  CertificateValidationFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Handler: "index.certificateRequestHandler"
+      Runtime: nodejs12.x
+  CustomDomainFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Runtime: "nodejs14.x" # Quoted.
+  EnableLongARNsFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Runtime: nodejs16.x
+  Runtime:
+    Type: AWS::SSM::Parameter
+    Properties:
+      Value: nodejs12.x`, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.templatePatcher.EXPECT().UpdateEnvironmentTemplate("mockApp", "mockEnv", `
+Resources:
+  CertificateValidationFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Handler: "index.certificateRequestHandler"
+      Runtime: nodejs16.x
+  CustomDomainFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Runtime: "nodejs16.x" # Quoted.
+  EnableLongARNsFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Runtime: nodejs16.x
+  Runtime:
+    Type: AWS::SSM::Parameter
+    Properties:
+      Value: nodejs12.x`, "mockExecutionRoleARN").Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedUpgraded: true,
+		},
+		"ignores ErrChangeSetEmpty": {
+			setupMocks: func(m *envPatcherMock) {
+				m.templatePatcher.EXPECT().Template(stack.NameForEnv("mockApp", "mockEnv")).Return(`
+Resources:
+  DNSDelegationFunction:
+    Type: AWS::Lambda::Function
+    Properties:
+      Runtime: nodejs14.x`, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.templatePatcher.EXPECT().UpdateEnvironmentTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("wrapped err: %w", &cloudformation.ErrChangeSetEmpty{}))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedUpgraded: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &envPatcherMock{
+				templatePatcher: mocks.NewMockenvironmentTemplateUpdateGetter(ctrl),
+				prog:            mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			p := EnvironmentPatcher{
+				Env: &config.Environment{
+					App:              "mockApp",
+					Name:             "mockEnv",
+					ExecutionRoleARN: "mockExecutionRoleARN",
+				},
+				TemplatePatcher: m.templatePatcher,
+				Prog:            m.prog,
+			}
+
+			got, err := p.UpgradeCustomResourceRuntimes()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedUpgraded, got)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/apprunner"
 	awsapprunner "github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -32,13 +33,19 @@ var rdwsAliasUsedWithoutDomainFriendlyText = fmt.Sprintf("To use %s, your applic
 	color.HighlightCode("http.alias"),
 	color.HighlightCode("copilot app init --domain example.com"))
 
+type serviceLister interface {
+	ListServices(appName string) ([]*config.Workload, error)
+}
+
 type rdwsDeployer struct {
 	*svcDeployer
-	rdwsMft *manifest.RequestDrivenWebService
+	rdwsMft        *manifest.RequestDrivenWebService
+	codeRepository stack.CodeRepository
 
 	// Overriden in tests.
 	customResourceS3Client uploader
 	appVersionGetter       versionGetter
+	svcLister              serviceLister
 	newStack               func() cloudformation.StackConfiguration
 }
 
@@ -61,7 +68,9 @@ func NewRDWSDeployer(in *WorkloadDeployerInput) (*rdwsDeployer, error) {
 		svcDeployer:            svcDeployer,
 		customResourceS3Client: s3.New(svcDeployer.defaultSessWithEnvRegion),
 		appVersionGetter:       versionGetter,
+		svcLister:              svcDeployer.store,
 		rdwsMft:                rdwsMft,
+		codeRepository:         in.CodeRepository,
 	}, nil
 }
 
@@ -130,6 +139,13 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 	if err != nil {
 		return nil, err
 	}
+	rc.CodeRepository = d.codeRepository
+	if !d.rdwsMft.Network.IsEmpty() {
+		// The VPC connector of the service can reach the Backend Services of the environment.
+		if rc.BackendServicePorts, err = d.backendServicePorts(); err != nil {
+			return nil, err
+		}
+	}
 
 	if d.app.Domain == "" && d.rdwsMft.Alias != nil {
 		log.Errorf(rdwsAliasUsedWithoutDomainFriendlyText)
@@ -186,6 +202,36 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 	}, nil
 }
 
+// backendServicePorts returns the ports that the Backend Services deployed in the environment
+// register in the service discovery namespace, keyed by service name.
+func (d *rdwsDeployer) backendServicePorts() (map[string]string, error) {
+	svcs, err := d.svcLister.ListServices(d.app.Name)
+	if err != nil {
+		return nil, fmt.Errorf("list services in application %s: %w", d.app.Name, err)
+	}
+	ports := make(map[string]string)
+	for _, svc := range svcs {
+		if svc.Type != manifestinfo.BackendServiceType {
+			continue
+		}
+		params, err := d.stackParamsGetter.StackParameters(stack.NameForWorkload(d.app.Name, d.env.Name, svc.Name))
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			// The service isn't deployed in the environment.
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get parameters of service %s in environment %s: %w", svc.Name, d.env.Name, err)
+		}
+		port := params[stack.WorkloadTargetPortParamKey]
+		if port == "" || port == template.NoExposedContainerPort {
+			continue
+		}
+		ports[svc.Name] = port
+	}
+	return ports, nil
+}
+
 func validateRDSvcAliasAndAppVersion(svcName, alias, envName string, app *config.Application, appVersionGetter versionGetter) error {
 	if alias == "" {
 		return nil
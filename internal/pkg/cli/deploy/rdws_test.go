@@ -16,10 +16,12 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -252,6 +254,83 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 	}
 }
 
+func TestRdwsDeployer_backendServicePorts(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(svcLister *mocks.MockserviceLister, paramsGetter *mocks.MockstackParametersGetter)
+
+		wanted    map[string]string
+		wantedErr error
+	}{
+		"error if fail to list the services": {
+			setupMocks: func(svcLister *mocks.MockserviceLister, _ *mocks.MockstackParametersGetter) {
+				svcLister.EXPECT().ListServices("demo").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list services in application demo: some error"),
+		},
+		"error if fail to get the parameters of a backend service": {
+			setupMocks: func(svcLister *mocks.MockserviceLister, paramsGetter *mocks.MockstackParametersGetter) {
+				svcLister.EXPECT().ListServices("demo").Return([]*config.Workload{
+					{Name: "api", Type: manifestinfo.BackendServiceType},
+				}, nil)
+				paramsGetter.EXPECT().StackParameters("demo-test-api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get parameters of service api in environment test: some error"),
+		},
+		"return the ports of the backend services deployed in the environment": {
+			setupMocks: func(svcLister *mocks.MockserviceLister, paramsGetter *mocks.MockstackParametersGetter) {
+				svcLister.EXPECT().ListServices("demo").Return([]*config.Workload{
+					{Name: "example", Type: manifestinfo.RequestDrivenWebServiceType},
+					{Name: "frontend", Type: manifestinfo.LoadBalancedWebServiceType},
+					{Name: "api", Type: manifestinfo.BackendServiceType},
+					{Name: "orders", Type: manifestinfo.BackendServiceType},
+					{Name: "worker", Type: manifestinfo.BackendServiceType},
+					{Name: "undeployed", Type: manifestinfo.BackendServiceType},
+				}, nil)
+				paramsGetter.EXPECT().StackParameters("demo-test-api").Return(map[string]string{
+					stack.WorkloadContainerPortParamKey: "80",
+					stack.WorkloadTargetPortParamKey:    "8080",
+				}, nil)
+				paramsGetter.EXPECT().StackParameters("demo-test-orders").Return(map[string]string{
+					stack.WorkloadTargetPortParamKey: "443",
+				}, nil)
+				paramsGetter.EXPECT().StackParameters("demo-test-worker").Return(map[string]string{
+					stack.WorkloadTargetPortParamKey: "-1",
+				}, nil)
+				paramsGetter.EXPECT().StackParameters("demo-test-undeployed").Return(nil, &awscloudformation.ErrStackNotFound{})
+			},
+			wanted: map[string]string{
+				"api":    "8080",
+				"orders": "443",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svcLister := mocks.NewMockserviceLister(ctrl)
+			paramsGetter := mocks.NewMockstackParametersGetter(ctrl)
+			tc.setupMocks(svcLister, paramsGetter)
+			deployer := mockRDWSDeployer(func(d *rdwsDeployer) {
+				d.svcLister = svcLister
+				d.stackParamsGetter = paramsGetter
+			})
+
+			// WHEN
+			got, err := deployer.backendServicePorts()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func mockRDWSDeployer(opts ...func(*rdwsDeployer)) *rdwsDeployer {
 	deployer := &rdwsDeployer{
 		svcDeployer: &svcDeployer{
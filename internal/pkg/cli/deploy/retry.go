@@ -0,0 +1,73 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	transientRetryMaxAttempts = 3
+	transientRetryBaseDelay   = 10 * time.Second
+)
+
+// IsTransientErr returns true if the error is likely to go away when the failed phase of the deployment is run again,
+// such as a throttled or timed out call to AWS, or a dropped network connection.
+func IsTransientErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		return request.IsErrorThrottle(aerr) || request.IsErrorRetryable(aerr)
+	}
+	if errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// TransientRetrier runs the phases of a deployment again when they fail with a transient error,
+// so that a throttled call doesn't abort the entire deployment.
+// Every phase it retries must be safe to run more than once.
+type TransientRetrier struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	sleep       func(time.Duration)
+}
+
+// NewTransientRetrier returns a retrier that makes up to three attempts with an exponential backoff.
+func NewTransientRetrier() *TransientRetrier {
+	return &TransientRetrier{
+		maxAttempts: transientRetryMaxAttempts,
+		baseDelay:   transientRetryBaseDelay,
+		sleep:       time.Sleep,
+	}
+}
+
+// Do runs fn and, while it fails with a transient error, waits and runs it again until it runs out of attempts.
+// A nil retrier runs fn once.
+func (r *TransientRetrier) Do(phase string, fn func() error) error {
+	if r == nil {
+		return fn()
+	}
+	delay := r.baseDelay
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= r.maxAttempts || !IsTransientErr(err) {
+			return err
+		}
+		log.Warningf("Failed to %s with a transient error, retrying in %s (attempt %d of %d): %v\n", phase, delay, attempt+1, r.maxAttempts, err)
+		r.sleep(delay)
+		delay *= 2
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTransientErr(t *testing.T) {
+	testCases := map[string]struct {
+		in     error
+		wanted bool
+	}{
+		"nil error": {
+			in: nil,
+		},
+		"wrapped throttling error from the SDK": {
+			in:     fmt.Errorf("describe stack: %w", awserr.New("Throttling", "Rate exceeded", nil)),
+			wanted: true,
+		},
+		"retryable error from the SDK": {
+			in:     awserr.New("RequestTimeout", "the request timed out", nil),
+			wanted: true,
+		},
+		"connection reset while sending a request to AWS": {
+			in:     awserr.New(request.ErrCodeRequestError, "send request failed", &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}),
+			wanted: true,
+		},
+		"connection reset outside of the SDK": {
+			in:     fmt.Errorf("push image: %w", &net.OpError{Op: "write", Net: "tcp", Err: syscall.ECONNRESET}),
+			wanted: true,
+		},
+		"network timeout outside of the SDK": {
+			in:     fmt.Errorf("login to registry: %w", &net.DNSError{Err: "i/o timeout", IsTimeout: true}),
+			wanted: true,
+		},
+		"message that reads like a transient error but isn't an AWS or network error": {
+			in: errors.New("deployment failed: Rate exceeded"),
+		},
+		"validation error": {
+			in: awserr.New("ValidationError", "Template format error", nil),
+		},
+		"deployment failure": {
+			in: errors.New("deployment failed: the task definition is invalid"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, IsTransientErr(tc.in))
+		})
+	}
+}
+
+func TestTransientRetrier_Do(t *testing.T) {
+	errTransient := awserr.New("Throttling", "Rate exceeded", nil)
+	testCases := map[string]struct {
+		inErrs []error
+
+		wantedAttempts int
+		wantedDelays   []time.Duration
+		wantedErr      error
+	}{
+		"runs the phase once if it succeeds": {
+			inErrs:         []error{nil},
+			wantedAttempts: 1,
+		},
+		"does not retry an error that isn't transient": {
+			inErrs:         []error{errors.New("some error")},
+			wantedAttempts: 1,
+			wantedErr:      errors.New("some error"),
+		},
+		"retries a transient error with an exponential backoff": {
+			inErrs:         []error{errTransient, errTransient, nil},
+			wantedAttempts: 3,
+			wantedDelays:   []time.Duration{time.Second, 2 * time.Second},
+		},
+		"gives up after the last attempt": {
+			inErrs:         []error{errTransient, errTransient, errTransient},
+			wantedAttempts: 3,
+			wantedDelays:   []time.Duration{time.Second, 2 * time.Second},
+			wantedErr:      errTransient,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var delays []time.Duration
+			r := &TransientRetrier{
+				maxAttempts: 3,
+				baseDelay:   time.Second,
+				sleep: func(d time.Duration) {
+					delays = append(delays, d)
+				},
+			}
+			var attempts int
+
+			// WHEN
+			err := r.Do("deploy", func() error {
+				err := tc.inErrs[attempts]
+				attempts++
+				return err
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedAttempts, attempts)
+			require.Equal(t, tc.wantedDelays, delays)
+		})
+	}
+
+	t.Run("a nil retrier runs the phase once", func(t *testing.T) {
+		var r *TransientRetrier
+		var attempts int
+
+		err := r.Do("deploy", func() error {
+			attempts++
+			return errTransient
+		})
+
+		require.Equal(t, errTransient, err)
+		require.Equal(t, 1, attempts)
+	})
+}
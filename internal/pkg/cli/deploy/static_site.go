@@ -124,6 +124,14 @@ func (d *staticSiteDeployer) deploy(deployOptions Options, stackConfigOutput svc
 	if deployOptions.DisableRollback {
 		opts = append(opts, awscloudformation.WithDisableRollback())
 	}
+	if deployOptions.CreateChangeSetOnly {
+		opts = append(opts, awscloudformation.WithCreateChangeSetOnly())
+	}
+	settingsOpts, err := stackSettingsOptions(d.mft)
+	if err != nil {
+		return fmt.Errorf("stack settings of service %s: %w", d.name, err)
+	}
+	opts = append(opts, settingsOpts...)
 	if err := d.deployer.DeployService(stackConfigOutput.conf, d.resources.S3Bucket, deployOptions.Detach, opts...); err != nil {
 		return fmt.Errorf("deploy service: %w", err)
 	}
@@ -219,5 +227,9 @@ func (d *staticSiteDeployer) validateAlias() error {
 		return fmt.Errorf("cannot specify alias when application is not associated with a domain")
 	}
 
-	return validateAliases(d.app, d.env.Name, d.staticSiteMft.HTTP.Alias)
+	alias, err := manifest.AliasToASCII(d.staticSiteMft.HTTP.Alias)
+	if err != nil {
+		return err
+	}
+	return validateAliases(d.app, d.env.Name, alias)
 }
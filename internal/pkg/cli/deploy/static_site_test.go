@@ -319,6 +319,43 @@ func TestStaticSiteDeployer_stackConfiguration(t *testing.T) {
 			},
 			wantErr: `alias "hi.com" is not supported in hosted zones managed by Copilot`,
 		},
+		"error bc alias is reserved by the naming conventions of the app": {
+			deployer: &staticSiteDeployer{
+				svcDeployer: &svcDeployer{
+					workloadDeployer: &workloadDeployer{
+						app: &config.Application{
+							Name:   "mockApp",
+							Domain: "example.com",
+							Conventions: &config.NamingConventions{
+								Aliases: &config.NamingRule{Reserved: []string{"www.example.com"}},
+							},
+						},
+						env: &config.Environment{
+							Name: "mockEnv",
+						},
+						envConfig: &manifest.Environment{},
+						endpointGetter: &endpointGetterDouble{
+							ServiceDiscoveryEndpointFn: ReturnsValues("", error(nil)),
+						},
+						envVersionGetter: &versionGetterDouble{
+							VersionFn: ReturnsValues("", error(nil)),
+						},
+						resources: &stack.AppRegionalResources{},
+					},
+				},
+				appVersionGetter: &versionGetterDouble{
+					VersionFn: ReturnsValues("v1.2.0", error(nil)),
+				},
+				staticSiteMft: &manifest.StaticSite{
+					StaticSiteConfig: manifest.StaticSiteConfig{
+						HTTP: manifest.StaticSiteHTTP{
+							Alias: "www.example.com",
+						},
+					},
+				},
+			},
+			wantErr: `alias name "www.example.com" is reserved`,
+		},
 		"error creating stack": {
 			deployer: &staticSiteDeployer{
 				svcDeployer: &svcDeployer{
@@ -404,6 +441,42 @@ func TestStaticSiteDeployer_stackConfiguration(t *testing.T) {
 				},
 			},
 		},
+		"success with an internationalized wildcard alias": {
+			deployer: &staticSiteDeployer{
+				svcDeployer: &svcDeployer{
+					workloadDeployer: &workloadDeployer{
+						app: &config.Application{
+							Name:   "mockApp",
+							Domain: "example.com",
+						},
+						env: &config.Environment{
+							Name: "mockEnv",
+						},
+						envConfig: &manifest.Environment{},
+						endpointGetter: &endpointGetterDouble{
+							ServiceDiscoveryEndpointFn: ReturnsValues("", error(nil)),
+						},
+						envVersionGetter: &versionGetterDouble{
+							VersionFn: ReturnsValues("", error(nil)),
+						},
+						resources: &stack.AppRegionalResources{},
+					},
+				},
+				appVersionGetter: &versionGetterDouble{
+					VersionFn: ReturnsValues("v1.2.0", error(nil)),
+				},
+				staticSiteMft: &manifest.StaticSite{
+					StaticSiteConfig: manifest.StaticSiteConfig{
+						HTTP: manifest.StaticSiteHTTP{
+							Alias: "*.bücher.example.com",
+						},
+					},
+				},
+				newStack: func(*stack.StaticSiteConfig) (deployCFN.StackConfiguration, error) {
+					return nil, nil
+				},
+			},
+		},
 		"success with overrider": {
 			deployer: &staticSiteDeployer{
 				svcDeployer: &svcDeployer{
@@ -8,19 +8,31 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
 	"regexp"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/spf13/afero"
 	"golang.org/x/mod/semver"
 
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/ical"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
 
+// maxScheduledActions is the maximum number of scheduled actions of an Application Auto Scaling scalable target.
+const maxScheduledActions = 200
+
+// awsScalingTimeLayout is the layout of the time of an "at" expression of Application Auto Scaling.
+const awsScalingTimeLayout = "2006-01-02T15:04:05"
+
 type uploader interface {
 	Upload(bucket, key string, data io.Reader) (string, error)
 }
@@ -42,6 +54,9 @@ type svcDeployer struct {
 	*workloadDeployer
 	newSvcUpdater func(func(*session.Session) serviceForceUpdater) serviceForceUpdater
 	now           func() time.Time
+
+	// lock is nil if the service stack can be updated concurrently with the other stacks in the environment.
+	lock *deploymentLock
 }
 
 func newSvcDeployer(in *WorkloadDeployerInput) (*svcDeployer, error) {
@@ -58,6 +73,12 @@ func newSvcDeployer(in *WorkloadDeployerInput) (*svcDeployer, error) {
 	}, nil
 }
 
+// envLoadBalancerLock returns the lock of the environment's load balancers, held while the service stack updates its listener rules.
+func (d *svcDeployer) envLoadBalancerLock() *deploymentLock {
+	return newEnvLoadBalancerLock(d.store, d.spinner, awscloudformation.New(d.envSess), d.app.Name, d.env.Name,
+		fmt.Sprintf("service %s", d.name), stack.NameForWorkload(d.app.Name, d.env.Name, d.name), workloadLockReleaseResource)
+}
+
 func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackConfigurationOutput) error {
 	opts := []awscloudformation.StackOption{
 		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
@@ -65,10 +86,20 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 	if deployOptions.DisableRollback {
 		opts = append(opts, awscloudformation.WithDisableRollback())
 	}
+	if deployOptions.CreateChangeSetOnly {
+		opts = append(opts, awscloudformation.WithCreateChangeSetOnly())
+	}
+	settingsOpts, err := stackSettingsOptions(d.mft)
+	if err != nil {
+		return fmt.Errorf("stack settings of service %s: %w", d.name, err)
+	}
+	opts = append(opts, settingsOpts...)
 	cmdRunAt := d.now()
-	if err := d.deployer.DeployService(stackConfigOutput.conf, d.resources.S3Bucket, deployOptions.Detach, opts...); err != nil {
+	if err := d.lock.Do(func() error {
+		return d.deployer.DeployService(stackConfigOutput.conf, d.resources.S3Bucket, deployOptions.Detach, opts...)
+	}); err != nil {
 		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
-		if !errors.As(err, &errEmptyCS) {
+		if !errors.As(err, &errEmptyCS) || deployOptions.CreateChangeSetOnly {
 			return fmt.Errorf("deploy service: %w", err)
 		}
 		if !deployOptions.ForceNewUpdate {
@@ -77,7 +108,7 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 		}
 	}
 	// Force update the service if --force is set and the service is not updated by the CFN.
-	if deployOptions.ForceNewUpdate {
+	if deployOptions.ForceNewUpdate && !deployOptions.CreateChangeSetOnly {
 		lastUpdatedAt, err := stackConfigOutput.svcUpdater.LastUpdatedAt(d.app.Name, d.env.Name, d.name)
 		if err != nil {
 			return fmt.Errorf("get the last updated deployment time for %s: %w", d.name, err)
@@ -94,6 +125,87 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 	return nil
 }
 
+// resolveScalingCalendars replaces each schedule of count that references a calendar with a scheduled change of the
+// range at the start of each upcoming event, and a change back to the range of count at its end.
+// Overlapping events of a calendar are merged.
+func (d *svcDeployer) resolveScalingCalendars(count *manifest.AdvancedCount) error {
+	var schedules []manifest.ScheduledScaling
+	for idx, schedule := range count.Schedules {
+		if schedule.Calendar.IsEmpty() {
+			schedules = append(schedules, schedule)
+			continue
+		}
+		events, err := d.scalingCalendarEvents(schedule)
+		if err != nil {
+			return fmt.Errorf(`read the calendar of "count.schedules[%d]": %w`, idx, err)
+		}
+		for _, event := range upcomingEvents(events, d.now()) {
+			schedules = append(schedules,
+				manifest.ScheduledScaling{
+					Schedule: aws.String(fmt.Sprintf("at(%s)", event.Start.UTC().Format(awsScalingTimeLayout))),
+					Range:    schedule.Range,
+				},
+				manifest.ScheduledScaling{
+					Schedule: aws.String(fmt.Sprintf("at(%s)", event.End.UTC().Format(awsScalingTimeLayout))),
+					Range:    count.Range,
+				})
+		}
+	}
+	if len(schedules) > maxScheduledActions {
+		return fmt.Errorf(`"count.schedules" results in %d scheduled actions, more than the maximum of %d: remove events from your calendars`, len(schedules), maxScheduledActions)
+	}
+	count.Schedules = schedules
+	return nil
+}
+
+// scalingCalendarEvents returns the events of the calendar of a schedule, sorted by start time.
+func (d *svcDeployer) scalingCalendarEvents(schedule manifest.ScheduledScaling) ([]ical.Event, error) {
+	loc := time.UTC
+	if tz := aws.StringValue(schedule.Timezone); tz != "" {
+		var err error
+		if loc, err = time.LoadLocation(tz); err != nil {
+			return nil, fmt.Errorf("load time zone %q: %w", tz, err)
+		}
+	}
+	var content []byte
+	if file := aws.StringValue(schedule.Calendar.File); file != "" {
+		var err error
+		if content, err = afero.ReadFile(d.fs, filepath.Join(d.workspacePath, file)); err != nil {
+			return nil, fmt.Errorf("read calendar %s: %w", file, err)
+		}
+	} else {
+		doc, err := d.ssmDocGetter.DocumentContent(aws.StringValue(schedule.Calendar.SSMDocument))
+		if err != nil {
+			return nil, err
+		}
+		content = []byte(doc)
+	}
+	return ical.Parse(content, loc)
+}
+
+// upcomingEvents merges the overlapping events, sorted by start time, and drops the ones that are over by the next minute.
+// An event in progress starts at the next minute, so that its scheduled action isn't in the past.
+func upcomingEvents(events []ical.Event, now time.Time) []ical.Event {
+	next := now.Truncate(time.Minute).Add(time.Minute)
+	var merged []ical.Event
+	for _, event := range events {
+		if !event.End.After(next) {
+			continue
+		}
+		if event.Start.Before(next) {
+			event.Start = next
+		}
+		if last := len(merged) - 1; last >= 0 && !event.Start.After(merged[last].End) {
+			if event.End.After(merged[last].End) {
+				merged[last].End = event.End
+			}
+			continue
+		}
+		merged = append(merged, event)
+	}
+	return merged
+}
+
 type svcStackConfigurationOutput struct {
 	conf       cloudformation.StackConfiguration
 	svcUpdater serviceForceUpdater
@@ -136,15 +248,16 @@ func validateMinAppVersion(app, svc string, appVersionGetter versionGetter, minV
 
 func validateAliases(app *config.Application, env string, aliases ...string) error {
 	// Alias should be within either env, app, or root hosted zone.
-	regRoot, err := regexp.Compile(fmt.Sprintf(`^([^\.]+\.)?%s`, app.Domain))
+	// A wildcard alias can have one more label, for example "*.api.example.com" in the root hosted zone.
+	regRoot, err := regexp.Compile(fmt.Sprintf(`^(\*\.)?([^\.]+\.)?%s`, app.Domain))
 	if err != nil {
 		return err
 	}
-	regApp, err := regexp.Compile(fmt.Sprintf(`^([^\.]+\.)?%s.%s`, app.Name, app.Domain))
+	regApp, err := regexp.Compile(fmt.Sprintf(`^(\*\.)?([^\.]+\.)?%s.%s`, app.Name, app.Domain))
 	if err != nil {
 		return err
 	}
-	regEnv, err := regexp.Compile(fmt.Sprintf(`^([^\.]+\.)?%s.%s.%s`, env, app.Name, app.Domain))
+	regEnv, err := regexp.Compile(fmt.Sprintf(`^(\*\.)?([^\.]+\.)?%s.%s.%s`, env, app.Name, app.Domain))
 	if err != nil {
 		return err
 	}
@@ -170,6 +283,19 @@ func validateAliases(app *config.Application, env string, aliases ...string) err
 		}
 	}
 
+	return validateAliasConventions(app, aliases...)
+}
+
+// validateAliasConventions returns an error if an alias violates the naming conventions of the application.
+func validateAliasConventions(app *config.Application, aliases ...string) error {
+	if app == nil {
+		return nil
+	}
+	for _, alias := range aliases {
+		if err := app.Conventions.Validate(config.AliasKind, alias); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -191,10 +317,12 @@ func (e *errInvalidAlias) RecommmendActions() string {
 - %s.%s
 - <name>.%s
 - %s
+Wildcard aliases can add a "*" label in front of these patterns, for example *.<name>.%s.
 `, e.env, e.app.Name, e.app.Domain,
 		e.env, e.app.Name, e.app.Domain,
 		e.app.Name, e.app.Domain,
 		e.app.Name, e.app.Domain,
 		e.app.Domain,
+		e.app.Domain,
 		e.app.Domain)
 }
@@ -3,6 +3,20 @@
 
 package deploy
 
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
 type versionGetterDouble struct {
 	VersionFn func() (string, error)
 }
@@ -10,3 +24,139 @@ type versionGetterDouble struct {
 func (d *versionGetterDouble) Version() (string, error) {
 	return d.VersionFn()
 }
+
+func TestSvcDeployer_resolveScalingCalendars(t *testing.T) {
+	const holidays = `BEGIN:VCALENDAR
+BEGIN:VEVENT
+SUMMARY:Last year
+DTSTART;VALUE=DATE:20231225
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Black Friday
+DTSTART:20241129T060000
+DTEND:20241130T000000
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Black Friday weekend
+DTSTART;VALUE=DATE:20241130
+DTEND;VALUE=DATE:20241202
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Christmas
+DTSTART;VALUE=DATE:20241225
+END:VEVENT
+END:VCALENDAR
+`
+	mockNow := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	baseRange := manifest.Range{Value: (*manifest.IntRangeBand)(aws.String("1-10"))}
+	eventRange := manifest.Range{Value: (*manifest.IntRangeBand)(aws.String("20-50"))}
+	cronSchedule := manifest.ScheduledScaling{
+		Schedule: aws.String("0 8 * * 1-5"),
+		Range:    eventRange,
+	}
+	testCases := map[string]struct {
+		inSchedules []manifest.ScheduledScaling
+		inNow       time.Time
+		setupMocks  func(m *mocks.MockssmDocumentGetter)
+
+		wantedSchedules []manifest.ScheduledScaling
+		wantedErr       string
+	}{
+		"schedules without a calendar are kept": {
+			inSchedules:     []manifest.ScheduledScaling{cronSchedule},
+			inNow:           mockNow,
+			wantedSchedules: []manifest.ScheduledScaling{cronSchedule},
+		},
+		"upcoming events of a file are merged and converted to UTC": {
+			inSchedules: []manifest.ScheduledScaling{
+				cronSchedule,
+				{
+					Calendar: manifest.ScalingCalendar{File: aws.String("calendars/holidays.ics")},
+					Timezone: aws.String("America/New_York"),
+					Range:    eventRange,
+				},
+			},
+			inNow: mockNow,
+			wantedSchedules: []manifest.ScheduledScaling{
+				cronSchedule,
+				{Schedule: aws.String("at(2024-11-29T11:00:00)"), Range: eventRange},
+				{Schedule: aws.String("at(2024-12-02T05:00:00)"), Range: baseRange},
+				{Schedule: aws.String("at(2024-12-25T05:00:00)"), Range: eventRange},
+				{Schedule: aws.String("at(2024-12-26T05:00:00)"), Range: baseRange},
+			},
+		},
+		"events in progress start at the next minute": {
+			inSchedules: []manifest.ScheduledScaling{
+				{
+					Calendar: manifest.ScalingCalendar{SSMDocument: aws.String("holidays")},
+					Range:    eventRange,
+				},
+			},
+			inNow: time.Date(2024, 12, 25, 13, 30, 15, 0, time.UTC),
+			setupMocks: func(m *mocks.MockssmDocumentGetter) {
+				m.EXPECT().DocumentContent("holidays").Return(holidays, nil)
+			},
+			wantedSchedules: []manifest.ScheduledScaling{
+				{Schedule: aws.String("at(2024-12-25T13:31:00)"), Range: eventRange},
+				{Schedule: aws.String("at(2024-12-26T00:00:00)"), Range: baseRange},
+			},
+		},
+		"error if the SSM document can't be read": {
+			inSchedules: []manifest.ScheduledScaling{
+				cronSchedule,
+				{
+					Calendar: manifest.ScalingCalendar{SSMDocument: aws.String("holidays")},
+					Range:    eventRange,
+				},
+			},
+			inNow: mockNow,
+			setupMocks: func(m *mocks.MockssmDocumentGetter) {
+				m.EXPECT().DocumentContent("holidays").Return("", errors.New("some error"))
+			},
+			wantedErr: `read the calendar of "count.schedules[1]": some error`,
+		},
+		"error if the file doesn't exist": {
+			inSchedules: []manifest.ScheduledScaling{
+				{
+					Calendar: manifest.ScalingCalendar{File: aws.String("missing.ics")},
+					Range:    eventRange,
+				},
+			},
+			inNow:     mockNow,
+			wantedErr: `read the calendar of "count.schedules[0]": read calendar missing.ics: open /ws/missing.ics: file does not exist`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			docGetter := mocks.NewMockssmDocumentGetter(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(docGetter)
+			}
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "/ws/calendars/holidays.ics", []byte(holidays), 0644))
+			deployer := &svcDeployer{
+				workloadDeployer: &workloadDeployer{
+					fs:            fs,
+					workspacePath: "/ws",
+					ssmDocGetter:  docGetter,
+				},
+				now: func() time.Time { return tc.inNow },
+			}
+			count := &manifest.AdvancedCount{
+				Range:     baseRange,
+				Schedules: tc.inSchedules,
+			}
+
+			err := deployer.resolveScalingCalendars(count)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSchedules, count.Schedules)
+		})
+	}
+}
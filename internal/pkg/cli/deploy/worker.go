@@ -21,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/dustin/go-humanize/english"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -84,11 +85,16 @@ func (d *workerSvcDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
 }
 
 type workerSvcDeployOutput struct {
-	subs []manifest.TopicSubscription
+	subs     []manifest.TopicSubscription
+	consumer manifest.SQSConsumer
 }
 
 // RecommendedActions returns the recommended actions after deployment.
 func (d *workerSvcDeployOutput) RecommendedActions() []string {
+	return append(d.subscriptionActions(), d.consumerActions()...)
+}
+
+func (d *workerSvcDeployOutput) subscriptionActions() []string {
 	if d.subs == nil {
 		return nil
 	}
@@ -133,10 +139,43 @@ func (d *workerSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecom
 		return nil, err
 	}
 	return &workerSvcDeployOutput{
-		subs: stackConfigOutput.subscriptions,
+		subs:     stackConfigOutput.subscriptions,
+		consumer: d.wsMft.Subscribe.Consumer,
 	}, nil
 }
 
+// consumerActions explains how to consume the events queue with the settings injected as environment variables.
+func (d *workerSvcDeployOutput) consumerActions() []string {
+	if d.consumer.IsEmpty() {
+		return nil
+	}
+	var limits []string
+	if d.consumer.BatchSize != nil {
+		limits = append(limits, fmt.Sprintf("receive at most %s messages per request", color.HighlightCode("$COPILOT_QUEUE_BATCH_SIZE")))
+	}
+	if d.consumer.MaxConcurrentMessages != nil {
+		limits = append(limits, fmt.Sprintf("process at most %s messages at once", color.HighlightCode("$COPILOT_QUEUE_MAX_CONCURRENT_MESSAGES")))
+	}
+	if d.consumer.ProcessingTimeout != nil {
+		limits = append(limits, fmt.Sprintf("stop processing a message after %s seconds", color.HighlightCode("$COPILOT_QUEUE_PROCESSING_TIMEOUT")))
+	}
+	var recs []string
+	if len(limits) > 0 {
+		recs = append(recs, fmt.Sprintf("Have each task of the worker service %s.", english.OxfordWordSeries(limits, "and")))
+	}
+	if aws.BoolValue(d.consumer.PartialBatchFailures) {
+		recs = append(recs, fmt.Sprintf(`When %s is set, delete only the messages of a batch that were processed successfully.
+    The failed messages are received again after %s seconds, until they're moved to the dead-letter queue.`,
+			color.HighlightCode("$COPILOT_QUEUE_PARTIAL_BATCH_FAILURES"), color.HighlightCode("$COPILOT_QUEUE_VISIBILITY_TIMEOUT")))
+	}
+	if d.consumer.DeduplicationTable.IsEnabled() {
+		recs = append(recs, fmt.Sprintf(`Skip the messages that were already processed by conditionally putting their IDs in the table %s.
+    Set the %s attribute of each item to the time, in epoch seconds, after which it can be forgotten, like now + %s.`,
+			color.HighlightCode("$COPILOT_DEDUPLICATION_TABLE_NAME"), color.HighlightCode("expires_at"), color.HighlightCode("$COPILOT_DEDUPLICATION_TTL")))
+	}
+	return recs
+}
+
 func (d *workerSvcDeployOutput) buildWorkerQueueNames() string {
 	var queueNames []string
 	for _, subscription := range d.subs {
@@ -160,6 +199,9 @@ func (d *workerSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*
 	if err != nil {
 		return nil, err
 	}
+	if err := d.resolveScalingCalendars(&d.wsMft.Count.AdvancedCount); err != nil {
+		return nil, err
+	}
 	var topics []deploy.Topic
 	topics, err = d.topicLister.ListSNSTopics(d.app.Name, d.env.Name)
 	if err != nil {
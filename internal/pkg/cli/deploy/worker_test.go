@@ -294,3 +294,55 @@ func mockWorkerServiceDeployer(opts ...func(*workerSvcDeployer)) *workerSvcDeplo
 	}
 	return deployer
 }
+
+func TestWorkerSvcDeployOutput_RecommendedActions(t *testing.T) {
+	processingTimeout := 90 * time.Second
+	testCases := map[string]struct {
+		in workerSvcDeployOutput
+
+		wantedCount    int
+		wantedContains []string
+	}{
+		"no recommendations without subscriptions or consumer settings": {},
+		"recommends the limits of the consumer": {
+			in: workerSvcDeployOutput{
+				consumer: manifest.SQSConsumer{
+					BatchSize:         aws.Int(10),
+					ProcessingTimeout: &processingTimeout,
+				},
+			},
+			wantedCount:    1,
+			wantedContains: []string{"$COPILOT_QUEUE_BATCH_SIZE", "$COPILOT_QUEUE_PROCESSING_TIMEOUT"},
+		},
+		"recommends deleting only the processed messages on partial batch failures": {
+			in: workerSvcDeployOutput{
+				consumer: manifest.SQSConsumer{
+					PartialBatchFailures: aws.Bool(true),
+				},
+			},
+			wantedCount:    1,
+			wantedContains: []string{"$COPILOT_QUEUE_PARTIAL_BATCH_FAILURES", "$COPILOT_QUEUE_VISIBILITY_TIMEOUT"},
+		},
+		"recommends skipping the messages recorded in the deduplication table": {
+			in: workerSvcDeployOutput{
+				consumer: manifest.SQSConsumer{
+					DeduplicationTable: manifest.DeduplicationTableOrBool{
+						Enabled: aws.Bool(true),
+					},
+				},
+			},
+			wantedCount:    1,
+			wantedContains: []string{"$COPILOT_DEDUPLICATION_TABLE_NAME", "$COPILOT_DEDUPLICATION_TTL"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.in.RecommendedActions()
+
+			require.Len(t, got, tc.wantedCount)
+			for _, wanted := range tc.wantedContains {
+				require.Contains(t, got[0], wanted)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -20,18 +21,24 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	awsssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
@@ -45,6 +52,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/syncbuffer"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/afero"
 	"golang.org/x/sync/errgroup"
 )
@@ -62,6 +70,10 @@ const (
 	labelForBuilder       = "com.aws.copilot.image.builder"
 	labelForVersion       = "com.aws.copilot.image.version"
 	labelForContainerName = "com.aws.copilot.image.container.name"
+	labelForGitBranch     = "com.aws.copilot.image.git.branch"
+	labelForGitDirty      = "com.aws.copilot.image.git.dirty"
+	labelForManifestHash  = "com.aws.copilot.image.manifest.sha256"
+	labelForRevision      = "org.opencontainers.image.revision" // Pre-defined annotation key of the OCI image spec.
 )
 const (
 	paddingInSpacesForBuildAndPush = 5
@@ -69,6 +81,9 @@ const (
 	defaultNumLinesForBuildAndPush = 5
 )
 
+// DefaultMaxParallelBuilds is the maximum number of images of a workload built at the same time, unless set otherwise.
+const DefaultMaxParallelBuilds = 4
+
 // ActionRecommender contains methods that output action recommendation.
 type ActionRecommender interface {
 	RecommendedActions() []string
@@ -113,6 +128,26 @@ type deployedTemplateGetter interface {
 	Template(stackName string) (string, error)
 }
 
+type ecsServiceGetter interface {
+	Service(app, env, svc string) (*awsecs.Service, error)
+}
+
+type taskDefinitionGetter interface {
+	TaskDefinition(taskDefName string) (*awsecs.TaskDefinition, error)
+}
+
+type scalableTargetGetter interface {
+	ECSServiceScalableTarget(cluster, service string) (*aas.ScalableTarget, error)
+}
+
+type productsGetter interface {
+	Products(serviceCode, region string, attributes map[string]string) ([]pricing.Product, error)
+}
+
+type stackParametersGetter interface {
+	StackParameters(stackName string) (map[string]string, error)
+}
+
 type spinner interface {
 	Start(label string)
 	Stop(label string)
@@ -124,8 +159,21 @@ type LabeledTermPrinter interface {
 	Print()
 }
 
+type secretGetter interface {
+	GetSecretValue(ctx context.Context, name string) (string, error)
+}
+
+type ssmDocumentGetter interface {
+	DocumentContent(name string) (string, error)
+}
+
+type ssmParameterLister interface {
+	ListSecrets(path string) ([]awsssm.SecretMetadata, error)
+}
+
 type dockerEngineRunChecker interface {
-	CheckDockerEngineRunning() error
+	Preflight(platforms []string) ([]dockerengine.PreflightWarning, error)
+	AnalyzeBuildContext(contextDir, dockerfile string) (*dockerengine.BuildContext, error)
 }
 
 // StackRuntimeConfiguration contains runtime configuration for a workload CloudFormation stack.
@@ -148,9 +196,10 @@ type DeployWorkloadInput struct {
 
 // Options specifies options for the deployment.
 type Options struct {
-	ForceNewUpdate  bool
-	DisableRollback bool
-	Detach          bool
+	ForceNewUpdate      bool
+	DisableRollback     bool
+	Detach              bool
+	CreateChangeSetOnly bool // Create the change set of the stack without executing it.
 }
 
 // GenerateCloudFormationTemplateInput is the input of GenerateCloudFormationTemplate.
@@ -180,20 +229,31 @@ type workloadDeployer struct {
 	workspacePath string
 
 	// Dependencies.
-	fs                 afero.Fs
-	s3Client           uploader
-	addons             stackBuilder
-	repository         repositoryService
-	deployer           serviceDeployer
-	tmplGetter         deployedTemplateGetter
-	endpointGetter     endpointGetter
-	spinner            spinner
-	templateFS         template.Reader
-	envVersionGetter   versionGetter
-	overrider          Overrider
-	docker             dockerEngineRunChecker
-	customResources    customResourcesFunc
-	labeledTermPrinter func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) LabeledTermPrinter
+	fs                   afero.Fs
+	s3Client             uploader
+	addons               stackBuilder
+	repository           repositoryService
+	deployer             serviceDeployer
+	tmplGetter           deployedTemplateGetter
+	ecsServiceGetter     ecsServiceGetter
+	taskDefGetter        taskDefinitionGetter
+	scalableTargetGetter scalableTargetGetter
+	stackParamsGetter    stackParametersGetter
+	pricing              productsGetter
+	ssm                  secretGetter
+	ssmParamLister       ssmParameterLister
+	ssmDocGetter         ssmDocumentGetter
+	endpointGetter       endpointGetter
+	spinner              spinner
+	templateFS           template.Reader
+	envVersionGetter     versionGetter
+	overrider            Overrider
+	docker               dockerEngineRunChecker
+	maxContextSize       int64
+	maxParallelBuilds    int
+	provenance           deploy.Provenance
+	customResources      customResourcesFunc
+	labeledTermPrinter   func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) LabeledTermPrinter
 
 	// Cached variables.
 	defaultSess              *session.Session
@@ -221,6 +281,15 @@ type WorkloadDeployerInput struct {
 	EnvVersionGetter versionGetter
 	Overrider        Overrider
 
+	// MaxBuildContextSize is the maximum size in bytes of the build context of each image. Zero means no limit.
+	MaxBuildContextSize int64
+	// MaxParallelBuilds is the maximum number of images built at the same time. Zero means DefaultMaxParallelBuilds.
+	MaxParallelBuilds int
+	// Provenance of the workload, recorded in the labels of the images built from Dockerfiles.
+	Provenance deploy.Provenance
+	// CodeRepository is the remote repository and branch of the workspace, that App Runner builds services deployed from source code from.
+	CodeRepository stack.CodeRepository
+
 	// Workload specific configuration.
 	customResources customResourcesFunc
 }
@@ -243,9 +312,31 @@ type ImageActionInput struct {
 	GitShortCommitTag string
 	Mft               interface{}
 
-	Login              func() (string, error)
-	CheckDockerEngine  func() error
-	LabeledTermPrinter func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) LabeledTermPrinter
+	Login               func() (string, error)
+	CheckDockerEngine   func(platforms []string) ([]dockerengine.PreflightWarning, error)
+	AnalyzeBuildContext func(contextDir, dockerfile string) (*dockerengine.BuildContext, error)
+	MaxBuildContextSize int64 // Zero means no limit.
+	MaxParallelBuilds   int   // Maximum number of images built at the same time. Zero means DefaultMaxParallelBuilds.
+	// Use the images pushed previously to the repository as cache sources, and push the build cache along with the images.
+	CacheFromRepository bool
+	Provenance          deploy.Provenance
+	Containers          []string // Names of the containers to build. If empty, every container built from the workspace is built.
+	Platform            string   // Optional. Overrides the platform of the manifest to build the images for.
+	LabeledTermPrinter  func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) LabeledTermPrinter
+
+	// Reads the build args and build secrets that are sourced from SSM parameters.
+	GetSSMParameter func(ctx context.Context, name string) (string, error)
+}
+
+// roleSession returns the settings of the sessions of the environment manager roles of the application.
+func roleSession(app *config.Application) *sessions.RoleSession {
+	if app.RoleSession == nil {
+		return nil
+	}
+	return &sessions.RoleSession{
+		Tags:           app.RoleSession.Tags,
+		SourceIdentity: app.RoleSession.SourceIdentity,
+	}
 }
 
 // newWorkloadDeployer is the constructor for workloadDeployer.
@@ -258,6 +349,7 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("create default: %w", err)
 	}
+	in.SessionProvider.RoleSession(roleSession(in.App))
 	envSession, err := in.SessionProvider.FromRole(in.Env.ManagerRoleARN, in.Env.Region)
 	if err != nil {
 		return nil, fmt.Errorf("create env session with region %s: %w", in.Env.Region, err)
@@ -302,6 +394,7 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal the manifest used to deploy environment %s: %w", in.Env.Name, err)
 	}
+	manifest.ApplyEnvProfile(in.Mft, envConfig)
 
 	cfn := cloudformation.New(envSession, cloudformation.WithProgressTracker(os.Stderr))
 
@@ -309,6 +402,7 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		return syncbuffer.NewLabeledTermPrinter(fw, bufs, opts...)
 	}
 	docker := dockerengine.New(exec.NewCmd())
+	ssmClient := awsssm.New(envSession)
 	return &workloadDeployer{
 		name:                     in.Name,
 		app:                      in.App,
@@ -322,12 +416,23 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		repository:               repository,
 		deployer:                 cfn,
 		tmplGetter:               cfn,
+		ecsServiceGetter:         ecs.New(envSession),
+		taskDefGetter:            awsecs.New(envSession),
+		scalableTargetGetter:     aas.New(envSession),
+		stackParamsGetter:        cfn,
+		pricing:                  pricing.New(defaultSession),
+		ssm:                      ssmClient,
+		ssmParamLister:           ssmClient,
+		ssmDocGetter:             ssmClient,
 		endpointGetter:           envDescriber,
 		spinner:                  termprogress.NewSpinner(log.DiagnosticWriter),
 		templateFS:               template.New(),
 		envVersionGetter:         in.EnvVersionGetter,
-		overrider:                in.Overrider,
+		overrider:                WithStackMetadata(in.Overrider, in.App.StackMetadata),
 		docker:                   docker,
+		maxContextSize:           in.MaxBuildContextSize,
+		maxParallelBuilds:        in.MaxParallelBuilds,
+		provenance:               in.Provenance,
 		customResources:          in.customResources,
 		defaultSess:              defaultSession,
 		defaultSessWithEnvRegion: defaultSessEnvRegion,
@@ -420,15 +525,21 @@ func (img ContainerImageIdentifier) Tag() string {
 
 func (d *workloadDeployer) buildAndPushContainerImages(out *UploadArtifactsOutput) error {
 	return processContainerImages(&ImageActionInput{
-		Name:               d.name,
-		WorkspacePath:      d.workspacePath,
-		Image:              d.image,
-		Mft:                d.mft,
-		CustomTag:          d.image.CustomTag,
-		GitShortCommitTag:  d.image.GitShortCommitTag,
-		Login:              d.repository.Login,
-		CheckDockerEngine:  d.docker.CheckDockerEngineRunning,
-		LabeledTermPrinter: d.labeledTermPrinter,
+		Name:                d.name,
+		WorkspacePath:       d.workspacePath,
+		Image:               d.image,
+		Mft:                 d.mft,
+		CustomTag:           d.image.CustomTag,
+		GitShortCommitTag:   d.image.GitShortCommitTag,
+		Login:               d.repository.Login,
+		CheckDockerEngine:   d.docker.Preflight,
+		AnalyzeBuildContext: d.docker.AnalyzeBuildContext,
+		MaxBuildContextSize: d.maxContextSize,
+		MaxParallelBuilds:   d.maxParallelBuilds,
+		CacheFromRepository: true,
+		Provenance:          d.provenance,
+		GetSSMParameter:     d.ssm.GetSecretValue,
+		LabeledTermPrinter:  d.labeledTermPrinter,
 	}, out, d.repository.BuildAndPush)
 
 }
@@ -440,16 +551,58 @@ func BuildContainerImages(in *ImageActionInput, out *UploadArtifactsOutput) erro
 
 func processContainerImages(in *ImageActionInput, out *UploadArtifactsOutput, buildFunc func(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (string, error)) error {
 	//this function could either build or buildAndPush the image based on the function received
-	buildArgsPerContainer, err := buildArgsPerContainer(in.Name, in.WorkspacePath, in.Image, in.Mft)
+	buildArgsPerContainer, err := buildArgsPerContainer(in.Name, in.WorkspacePath, in.Image, in.Mft, in.Provenance, &buildValues{
+		lookupEnv:       os.LookupEnv,
+		getSSMParameter: in.GetSSMParameter,
+	})
 	if err != nil {
 		return err
 	}
+	if len(in.Containers) > 0 {
+		wanted := make(map[string]bool, len(in.Containers))
+		for _, container := range in.Containers {
+			wanted[container] = true
+		}
+		for container := range buildArgsPerContainer {
+			if !wanted[container] {
+				delete(buildArgsPerContainer, container)
+			}
+		}
+	}
 	if len(buildArgsPerContainer) == 0 {
 		return nil
 	}
-	if err := in.CheckDockerEngine(); err != nil {
+	platforms := make([]string, 0, len(buildArgsPerContainer))
+	for _, buildArgs := range buildArgsPerContainer {
+		if in.Platform != "" {
+			buildArgs.Platform = in.Platform
+		}
+		// pack keeps its own cache, and can't use the cache of the images built by docker.
+		buildArgs.CacheFromRepository = in.CacheFromRepository && buildArgs.Builder == ""
+		platforms = append(platforms, buildArgs.Platform)
+	}
+	sort.Strings(platforms)
+	warnings, err := in.CheckDockerEngine(platforms)
+	if err != nil {
 		return fmt.Errorf("check if docker engine is running: %w", err)
 	}
+	for _, warning := range warnings {
+		log.Warningln(warning.String())
+	}
+	containers := make([]string, 0, len(buildArgsPerContainer))
+	for container := range buildArgsPerContainer {
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+	for _, container := range containers {
+		if buildArgsPerContainer[container].Builder != "" {
+			// pack doesn't read the .dockerignore file, so the suggestions wouldn't apply.
+			continue
+		}
+		if err := checkBuildContext(in, container, buildArgsPerContainer[container]); err != nil {
+			return err
+		}
+	}
 	uri, err := in.Login()
 	if err != nil {
 		return fmt.Errorf("login to image repository: %w", err)
@@ -461,6 +614,29 @@ func processContainerImages(in *ImageActionInput, out *UploadArtifactsOutput, bu
 	return buildSingleContainerImage(in, uri, buildArgsPerContainer, buildFunc, out)
 }
 
+// checkBuildContext warns about files that are likely unintended in the build context of the container,
+// and errors if the context is larger than the maximum size.
+func checkBuildContext(in *ImageActionInput, container string, buildArgs *dockerengine.BuildArguments) error {
+	bc, err := in.AnalyzeBuildContext(buildArgs.Context, buildArgs.Dockerfile)
+	if err != nil {
+		return fmt.Errorf("analyze build context of container %q: %w", container, err)
+	}
+	if in.MaxBuildContextSize > 0 && bc.Size > in.MaxBuildContextSize {
+		return &errBuildContextTooLarge{
+			container: container,
+			context:   bc,
+			max:       in.MaxBuildContextSize,
+		}
+	}
+	if len(bc.Suggestions) == 0 {
+		return nil
+	}
+	log.Warningf("Build context %s of container %q is %s (%d files) and includes files that are likely unintended:\n%s\n",
+		bc.Dir, container, humanize.IBytes(uint64(bc.Size)), bc.Files, fmtDockerignoreSuggestions(bc.Suggestions))
+	log.Infof("Add these entries to %s to exclude them from the image build.\n", bc.DockerignorePath)
+	return nil
+}
+
 func buildSingleContainerImage(in *ImageActionInput, uri string, buildArgsPerContainer map[string]*dockerengine.BuildArguments, buildFunc func(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (string, error), out *UploadArtifactsOutput) error {
 	out.ImageDigests = make(map[string]ContainerImageIdentifier, len(buildArgsPerContainer))
 	for name, buildArgs := range buildArgsPerContainer {
@@ -490,21 +666,34 @@ func buildContainerImagesInParallel(in *ImageActionInput, uri string, buildArgsP
 	g, ctx := errgroup.WithContext(context.Background())
 	cursor := cursor.New()
 	cursor.Hide()
+	maxParallelBuilds := in.MaxParallelBuilds
+	if maxParallelBuilds <= 0 {
+		maxParallelBuilds = DefaultMaxParallelBuilds
+	}
+	// Only the builds are limited, the output of every image is copied and printed while it waits for its turn.
+	builds := make(chan struct{}, maxParallelBuilds)
 	for name, buildArgs := range buildArgsPerContainer {
 		// create a copy of loop variables to avoid data race.
 		name := name
 		buildArgs := buildArgs
 
 		buildArgs.URI = uri
-		buildArgsList, err := buildArgs.GenerateDockerBuildArgs(dockerengine.New(exec.NewCmd()))
+		cmdName, buildArgsList, err := buildArgs.BuildCommand(dockerengine.New(exec.NewCmd()))
 		if err != nil {
-			return fmt.Errorf("generate docker build args for %q: %w", name, err)
+			return fmt.Errorf("generate %s build args for %q: %w", cmdName, name, err)
 		}
 		buf := syncbuffer.New()
-		labeledBuffers = append(labeledBuffers, buf.WithLabel(fmt.Sprintf("Building your container image %q: docker %s", name, strings.Join(buildArgsList, " "))))
+		labeledBuffers = append(labeledBuffers, buf.WithLabel(fmt.Sprintf("Building your container image %q: %s %s", name, cmdName, strings.Join(buildArgsList, " "))))
 		pr, pw := io.Pipe()
 		g.Go(func() error {
 			defer pw.Close()
+			select {
+			case builds <- struct{}{}:
+				defer func() { <-builds }()
+			case <-ctx.Done():
+				return nil
+			}
+			buf.Start()
 			digest, err := buildFunc(ctx, buildArgs, pw)
 			if err != nil {
 				return fmt.Errorf("build and push the image %q: %w", name, err)
@@ -555,7 +744,121 @@ func buildContainerImagesInParallel(in *ImageActionInput, uri string, buildArgsP
 	return nil
 }
 
-func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentifier, unmarshaledManifest interface{}) (map[string]*dockerengine.BuildArguments, error) {
+// ContainerBuildContext is the location of the files that the image of a container is built from.
+type ContainerBuildContext struct {
+	Context    string
+	Dockerfile string
+}
+
+// ContainerBuildContexts returns the build context of each container of the workload whose image is built from the workspace.
+func ContainerBuildContexts(name, workspacePath string, mft interface{}) (map[string]ContainerBuildContext, error) {
+	args, err := buildArgsPerContainer(name, workspacePath, ContainerImageIdentifier{}, mft, deploy.Provenance{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	contexts := make(map[string]ContainerBuildContext, len(args))
+	for container, arg := range args {
+		contexts[container] = ContainerBuildContext{
+			Context:    arg.Context,
+			Dockerfile: arg.Dockerfile,
+		}
+	}
+	return contexts, nil
+}
+
+// buildValues reads the values of the build args and secrets that the manifest sources from
+// environment variables or SSM parameters.
+type buildValues struct {
+	lookupEnv       func(key string) (string, bool)
+	getSSMParameter func(ctx context.Context, name string) (string, error)
+}
+
+// args returns the value of each build arg.
+// If v is nil, the args that aren't set in the manifest are left empty.
+func (v *buildValues) args(args map[string]manifest.BuildArg) (map[string]string, error) {
+	if args == nil {
+		return nil, nil
+	}
+	values := make(map[string]string, len(args))
+	for name, arg := range args {
+		switch {
+		case arg.FromEnv != nil:
+			if v == nil {
+				continue
+			}
+			value, ok := v.lookupEnv(aws.StringValue(arg.FromEnv))
+			if !ok {
+				return nil, fmt.Errorf("environment variable %s of build arg %s is not set", aws.StringValue(arg.FromEnv), name)
+			}
+			values[name] = value
+		case arg.FromSSM != nil:
+			if v == nil {
+				continue
+			}
+			value, err := v.ssmParameter(aws.StringValue(arg.FromSSM))
+			if err != nil {
+				return nil, fmt.Errorf("get the value of build arg %s: %w", name, err)
+			}
+			values[name] = value
+		default:
+			values[name] = aws.StringValue(arg.Plain)
+		}
+	}
+	return values, nil
+}
+
+// secrets returns the build secrets sorted by ID. Relative files are relative to the workspace.
+// If v is nil, no secrets are returned.
+func (v *buildValues) secrets(secrets map[string]manifest.BuildSecret, workspacePath string) ([]dockerengine.BuildSecret, error) {
+	if v == nil || len(secrets) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(secrets))
+	for id := range secrets {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	out := make([]dockerengine.BuildSecret, 0, len(secrets))
+	for _, id := range ids {
+		secret := secrets[id]
+		buildSecret := dockerengine.BuildSecret{
+			ID: id,
+		}
+		switch {
+		case secret.FromEnv != nil:
+			if _, ok := v.lookupEnv(aws.StringValue(secret.FromEnv)); !ok {
+				return nil, fmt.Errorf("environment variable %s of build secret %s is not set", aws.StringValue(secret.FromEnv), id)
+			}
+			buildSecret.Env = aws.StringValue(secret.FromEnv)
+		case secret.FromFile != nil:
+			buildSecret.Src = aws.StringValue(secret.FromFile)
+			if !filepath.IsAbs(buildSecret.Src) {
+				buildSecret.Src = filepath.Join(workspacePath, buildSecret.Src)
+			}
+		case secret.FromSSM != nil:
+			value, err := v.ssmParameter(aws.StringValue(secret.FromSSM))
+			if err != nil {
+				return nil, fmt.Errorf("get the value of build secret %s: %w", id, err)
+			}
+			buildSecret.Value = value
+		}
+		out = append(out, buildSecret)
+	}
+	return out, nil
+}
+
+func (v *buildValues) ssmParameter(name string) (string, error) {
+	if v.getSSMParameter == nil {
+		return "", fmt.Errorf("SSM parameter %s can't be read", name)
+	}
+	value, err := v.getSSMParameter(context.Background(), name)
+	if err != nil {
+		return "", fmt.Errorf("get SSM parameter %s: %w", name, err)
+	}
+	return value, nil
+}
+
+func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentifier, unmarshaledManifest interface{}, provenance deploy.Provenance, values *buildValues) (map[string]*dockerengine.BuildArguments, error) {
 	type dfArgs interface {
 		BuildArgs(rootDirectory string) (map[string]*manifest.DockerBuildArgs, error)
 		ContainerPlatform() string
@@ -586,15 +889,39 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 			labels[labelForVersion] = version.Version
 		}
 		labels[labelForContainerName] = container
+		if provenance.GitCommit != "" {
+			labels[labelForRevision] = provenance.GitCommit
+			labels[labelForGitDirty] = strconv.FormatBool(provenance.GitDirty)
+		}
+		if provenance.GitBranch != "" {
+			labels[labelForGitBranch] = provenance.GitBranch
+		}
+		if provenance.ManifestSHA256 != "" {
+			labels[labelForManifestHash] = provenance.ManifestSHA256
+		}
+		args, err := values.args(buildArgs.Args)
+		if err != nil {
+			return nil, fmt.Errorf("container %s: %w", container, err)
+		}
+		secrets, err := values.secrets(buildArgs.Secrets, workspacePath)
+		if err != nil {
+			return nil, fmt.Errorf("container %s: %w", container, err)
+		}
 		dArgs[container] = &dockerengine.BuildArguments{
 			Dockerfile: aws.StringValue(buildArgs.Dockerfile),
 			Context:    aws.StringValue(buildArgs.Context),
-			Args:       buildArgs.Args,
+			Args:       args,
 			CacheFrom:  buildArgs.CacheFrom,
 			Target:     aws.StringValue(buildArgs.Target),
 			Platform:   mf.ContainerPlatform(),
 			Tags:       tags,
 			Labels:     labels,
+			Builder:    aws.StringValue(buildArgs.Buildpack.Builder),
+			Buildpacks: buildArgs.Buildpack.Buildpacks,
+			Secrets:    secrets,
+			SSH:        buildArgs.SSH,
+			Network:    aws.StringValue(buildArgs.Network),
+			SOCI:       aws.BoolValue(buildArgs.SOCI),
 		}
 	}
 	return dArgs, nil
@@ -735,6 +1062,34 @@ func envFiles(unmarshaledManifest interface{}) map[string]string {
 	return nil
 }
 
+// stackSettingsOptions returns the options to protect the stack as configured by the "stack" field of the manifest.
+// If the field is absent, the termination protection and the stack policy of the stack are left as they are.
+func stackSettingsOptions(unmarshaledManifest interface{}) ([]awscloudformation.StackOption, error) {
+	type stackSettings interface {
+		StackSettings() manifest.StackSettings
+	}
+	mf, ok := unmarshaledManifest.(stackSettings)
+	if !ok {
+		return nil, nil
+	}
+	settings := mf.StackSettings()
+	if settings.IsEmpty() {
+		return nil, nil
+	}
+	policy, err := settings.StackPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if policy == "" {
+		// Clear the policy that was set by a previous deployment.
+		policy = awscloudformation.AllowAllStackPolicy
+	}
+	return []awscloudformation.StackOption{
+		awscloudformation.WithTerminationProtection(settings.IsTerminationProtected()),
+		awscloudformation.WithStackPolicy(policy),
+	}, nil
+}
+
 func (d *workloadDeployer) pushAddonsTemplateToS3Bucket() (string, error) {
 	if d.addons == nil {
 		return "", nil
@@ -765,6 +1120,9 @@ func (d *workloadDeployer) pushAddonsTemplateToS3Bucket() (string, error) {
 }
 
 func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.RuntimeConfig, error) {
+	if err := d.injectSSMParameters(); err != nil {
+		return nil, err
+	}
 	endpoint, err := d.endpointGetter.ServiceDiscoveryEndpoint()
 	if err != nil {
 		return nil, fmt.Errorf("get service discovery endpoint: %w", err)
@@ -773,11 +1131,13 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 	if err != nil {
 		return nil, fmt.Errorf("get version of environment %q: %w", d.env.Name, err)
 	}
+	helperImages := d.helperImages()
 	if len(in.ImageDigests) == 0 {
 		return &stack.RuntimeConfig{
 			AddonsTemplateURL:        in.AddonsURL,
 			EnvFileARNs:              in.EnvFileARNs,
 			AdditionalTags:           in.Tags,
+			HelperImages:             helperImages,
 			ServiceDiscoveryEndpoint: endpoint,
 			AccountID:                d.env.AccountID,
 			Region:                   d.env.Region,
@@ -808,6 +1168,7 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 		EnvFileARNs:              in.EnvFileARNs,
 		AdditionalTags:           in.Tags,
 		PushedImages:             images,
+		HelperImages:             helperImages,
 		ServiceDiscoveryEndpoint: endpoint,
 		AccountID:                d.env.AccountID,
 		Region:                   d.env.Region,
@@ -817,6 +1178,50 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 	}, nil
 }
 
+// helperImages returns the locations of the helper images that Copilot injects into the tasks of the workload,
+// overridden by the application.
+func (d *workloadDeployer) helperImages() map[string]string {
+	names := manifest.InjectedHelperImages(d.mft)
+	if len(names) == 0 {
+		return nil
+	}
+	images := make(map[string]string, len(names))
+	for _, name := range names {
+		images[name] = template.HelperImageURI(name, d.app.HelperImages)
+	}
+	return images
+}
+
+// ssmParametersInjector is implemented by the manifests of workloads that can inject
+// all the SSM parameters under a path as environment variables with "variables.from_ssm_path".
+type ssmParametersInjector interface {
+	SSMParameterPath() string
+	InjectSSMParameters(names []string)
+}
+
+// injectSSMParameters lists the SSM parameters under the path of "variables.from_ssm_path" on every deployment,
+// so that the parameters added since the last one are injected in the containers.
+func (d *workloadDeployer) injectSSMParameters() error {
+	mft, ok := d.mft.(ssmParametersInjector)
+	if !ok {
+		return nil
+	}
+	path := mft.SSMParameterPath()
+	if path == "" {
+		return nil
+	}
+	params, err := d.ssmParamLister.ListSecrets(path)
+	if err != nil {
+		return fmt.Errorf("list SSM parameters under path %s: %w", path, err)
+	}
+	names := make([]string, len(params))
+	for i, param := range params {
+		names[i] = param.Name
+	}
+	mft.InjectSSMParameters(names)
+	return nil
+}
+
 type timeoutError interface {
 	error
 	Timeout() bool
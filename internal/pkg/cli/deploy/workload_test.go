@@ -5,11 +5,13 @@ package deploy
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,14 +22,18 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	awsssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/override"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -62,6 +68,9 @@ type deployMocks struct {
 	mockValidator              *mocks.MockaliasCertValidator
 	mockLabeledTermPrinter     *mocks.MockLabeledTermPrinter
 	mockdockerEngineRunChecker *mocks.MockdockerEngineRunChecker
+	mockSecretGetter           *mocks.MocksecretGetter
+	mockECSServiceDescriber    *mocks.MockecsServiceDescriber
+	mockCodeDeployer           *mocks.MockcodeDeployer
 }
 
 type mockTemplateFS struct {
@@ -195,6 +204,8 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 		inMockUserTag     string
 		inMockGitTag      string
 		inDockerBuildArgs map[string]*manifest.DockerBuildArgs
+		inMaxContextSize  int64
+		inProvenance      deploy.Provenance
 
 		mock                func(t *testing.T, m *deployMocks)
 		mockServiceDeployer func(deployer *workloadDeployer) artifactsUploader
@@ -215,10 +226,47 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				},
 			},
 			mock: func(t *testing.T, m *deployMocks) {
-				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(errors.New("some error"))
+				m.mockdockerEngineRunChecker.EXPECT().Preflight([]string{"mockContainerPlatform"}).Return(nil, errors.New("some error"))
 			},
 			wantErr: fmt.Errorf("check if docker engine is running: some error"),
 		},
+		"error if fail to analyze the build context": {
+			inMockUserTag: "v1.0",
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().Preflight([]string{"mockContainerPlatform"}).Return(nil, nil)
+				m.mockdockerEngineRunChecker.EXPECT().AnalyzeBuildContext("mockContext", "mockDockerfile").Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf(`analyze build context of container "mockWkld": some error`),
+		},
+		"error if the build context is larger than the maximum size": {
+			inMockUserTag:    "v1.0",
+			inMaxContextSize: 1 << 20,
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().Preflight([]string{"mockContainerPlatform"}).Return(nil, nil)
+				m.mockdockerEngineRunChecker.EXPECT().AnalyzeBuildContext("mockContext", "mockDockerfile").Return(&dockerengine.BuildContext{
+					Dir:              "mockContext",
+					DockerignorePath: "mockContext/.dockerignore",
+					Size:             3 << 20,
+					Files:            2,
+					Suggestions: []dockerengine.DockerignoreSuggestion{
+						{Pattern: "node_modules", Reason: "installed Node.js dependencies", Size: 2 << 20},
+					},
+				}, nil)
+			},
+			wantErr: fmt.Errorf(`build context mockContext of container "mockWkld" is 3.0 MiB, which exceeds the maximum size of 1.0 MiB`),
+		},
 		"error if failed to build and push image": {
 			inMockUserTag: "v1.0",
 			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
@@ -228,14 +276,16 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				},
 			},
 			mock: func(t *testing.T, m *deployMocks) {
-				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockdockerEngineRunChecker.EXPECT().Preflight(gomock.Any()).Return(nil, nil)
+				m.mockdockerEngineRunChecker.EXPECT().AnalyzeBuildContext(gomock.Any(), gomock.Any()).Return(&dockerengine.BuildContext{}, nil).AnyTimes()
 				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
 				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
-					URI:        mockURI,
-					Dockerfile: "mockDockerfile",
-					Context:    "mockContext",
-					Platform:   "mockContainerPlatform",
-					Tags:       []string{"latest", "v1.0"},
+					URI:                 mockURI,
+					CacheFromRepository: true,
+					Dockerfile:          "mockDockerfile",
+					Context:             "mockContext",
+					Platform:            "mockContainerPlatform",
+					Tags:                []string{"latest", "v1.0"},
 					Labels: map[string]string{
 						"com.aws.copilot.image.builder":        "copilot-cli",
 						"com.aws.copilot.image.container.name": "mockWkld",
@@ -254,14 +304,16 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				},
 			},
 			mock: func(t *testing.T, m *deployMocks) {
-				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockdockerEngineRunChecker.EXPECT().Preflight(gomock.Any()).Return(nil, nil)
+				m.mockdockerEngineRunChecker.EXPECT().AnalyzeBuildContext(gomock.Any(), gomock.Any()).Return(&dockerengine.BuildContext{}, nil).AnyTimes()
 				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
 				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
-					URI:        mockURI,
-					Dockerfile: "mockDockerfile",
-					Context:    "mockContext",
-					Platform:   "mockContainerPlatform",
-					Tags:       []string{"latest", "v1.0"},
+					URI:                 mockURI,
+					CacheFromRepository: true,
+					Dockerfile:          "mockDockerfile",
+					Context:             "mockContext",
+					Platform:            "mockContainerPlatform",
+					Tags:                []string{"latest", "v1.0"},
 					Labels: map[string]string{
 						"com.aws.copilot.image.builder":        "copilot-cli",
 						"com.aws.copilot.image.container.name": "mockWkld",
@@ -281,6 +333,53 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				},
 			},
 		},
+		"build and push image labeled with its provenance": {
+			inMockGitTag: "gitTag",
+			inProvenance: deploy.Provenance{
+				GitCommit:      "3f2c1a9e",
+				GitBranch:      "main",
+				GitDirty:       true,
+				ManifestSHA256: "5f2b",
+			},
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().Preflight(gomock.Any()).Return(nil, nil)
+				m.mockdockerEngineRunChecker.EXPECT().AnalyzeBuildContext(gomock.Any(), gomock.Any()).Return(&dockerengine.BuildContext{}, nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:                 mockURI,
+					CacheFromRepository: true,
+					Dockerfile:          "mockDockerfile",
+					Context:             "mockContext",
+					Platform:            "mockContainerPlatform",
+					Tags:                []string{"latest", "gitTag"},
+					Labels: map[string]string{
+						"com.aws.copilot.image.builder":         "copilot-cli",
+						"com.aws.copilot.image.container.name":  "mockWkld",
+						"com.aws.copilot.image.git.branch":      "main",
+						"com.aws.copilot.image.git.dirty":       "true",
+						"com.aws.copilot.image.manifest.sha256": "5f2b",
+						"org.opencontainers.image.revision":     "3f2c1a9e",
+					},
+				}, gomock.Any()).Return("mockDigest", nil)
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest:            "mockDigest",
+					GitShortCommitTag: "gitTag",
+					RepoTags: []string{
+						"mockRepoURI:gitTag",
+						"mockRepoURI:latest",
+					},
+				},
+			},
+		},
 		"build and push image with gitshortcommit successfully": {
 			inMockGitTag: "gitTag",
 			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
@@ -290,14 +389,16 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				},
 			},
 			mock: func(t *testing.T, m *deployMocks) {
-				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockdockerEngineRunChecker.EXPECT().Preflight(gomock.Any()).Return(nil, nil)
+				m.mockdockerEngineRunChecker.EXPECT().AnalyzeBuildContext(gomock.Any(), gomock.Any()).Return(&dockerengine.BuildContext{}, nil).AnyTimes()
 				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
 				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
-					URI:        mockURI,
-					Dockerfile: "mockDockerfile",
-					Context:    "mockContext",
-					Platform:   "mockContainerPlatform",
-					Tags:       []string{"latest", "gitTag"},
+					URI:                 mockURI,
+					CacheFromRepository: true,
+					Dockerfile:          "mockDockerfile",
+					Context:             "mockContext",
+					Platform:            "mockContainerPlatform",
+					Tags:                []string{"latest", "gitTag"},
 					Labels: map[string]string{
 						"com.aws.copilot.image.builder":        "copilot-cli",
 						"com.aws.copilot.image.container.name": "mockWkld",
@@ -329,25 +430,28 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 			},
 			inMockGitTag: "gitTag",
 			mock: func(t *testing.T, m *deployMocks) {
-				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockdockerEngineRunChecker.EXPECT().Preflight(gomock.Any()).Return(nil, nil)
+				m.mockdockerEngineRunChecker.EXPECT().AnalyzeBuildContext(gomock.Any(), gomock.Any()).Return(&dockerengine.BuildContext{}, nil).AnyTimes()
 				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
 				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
-					URI:        mockURI,
-					Dockerfile: "sidecarMockDockerfile",
-					Context:    "sidecarMockContext",
-					Platform:   "mockContainerPlatform",
-					Tags:       []string{fmt.Sprintf("nginx-%s", "latest"), fmt.Sprintf("nginx-%s", "gitTag")},
+					URI:                 mockURI,
+					CacheFromRepository: true,
+					Dockerfile:          "sidecarMockDockerfile",
+					Context:             "sidecarMockContext",
+					Platform:            "mockContainerPlatform",
+					Tags:                []string{fmt.Sprintf("nginx-%s", "latest"), fmt.Sprintf("nginx-%s", "gitTag")},
 					Labels: map[string]string{
 						"com.aws.copilot.image.builder":        "copilot-cli",
 						"com.aws.copilot.image.container.name": "nginx",
 					},
 				}, gomock.Any()).Return("sidecarMockDigest1", nil)
 				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
-					URI:        mockURI,
-					Dockerfile: "web/Dockerfile",
-					Context:    "Users/bowie",
-					Platform:   "mockContainerPlatform",
-					Tags:       []string{"logging-latest", fmt.Sprintf("logging-%s", "gitTag")},
+					URI:                 mockURI,
+					CacheFromRepository: true,
+					Dockerfile:          "web/Dockerfile",
+					Context:             "Users/bowie",
+					Platform:            "mockContainerPlatform",
+					Tags:                []string{"logging-latest", fmt.Sprintf("logging-%s", "gitTag")},
 					Labels: map[string]string{
 						"com.aws.copilot.image.builder":        "copilot-cli",
 						"com.aws.copilot.image.container.name": "logging",
@@ -646,6 +750,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				mockFileSystem:             afero.NewMemMapFs(),
 				mockLabeledTermPrinter:     mocks.NewMockLabeledTermPrinter(ctrl),
 				mockdockerEngineRunChecker: mocks.NewMockdockerEngineRunChecker(ctrl),
+				mockSecretGetter:           mocks.NewMocksecretGetter(ctrl),
 			}
 			tc.mock(t, m)
 
@@ -679,6 +784,9 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				fs:              m.mockFileSystem,
 				s3Client:        m.mockUploader,
 				docker:          m.mockdockerEngineRunChecker,
+				ssm:             m.mockSecretGetter,
+				maxContextSize:  tc.inMaxContextSize,
+				provenance:      tc.inProvenance,
 				repository:      m.mockRepositoryService,
 				templateFS:      fakeTemplateFS(),
 				overrider:       new(override.Noop),
@@ -744,13 +852,16 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 	mockBeforeTime := time.Unix(1494505743, 0)
 	mockAfterTime := time.Unix(1494505756, 0)
 	tests := map[string]struct {
-		inAliases         manifest.Alias
-		inNLB             manifest.NetworkLoadBalancerConfiguration
-		inApp             *config.Application
-		inEnvironment     *config.Environment
-		inForceDeploy     bool
-		inDisableRollback bool
-		inRedirectToHTTPS *bool
+		inAliases             manifest.Alias
+		inNLB                 manifest.NetworkLoadBalancerConfiguration
+		inApp                 *config.Application
+		inEnvironment         *config.Environment
+		inForceDeploy         bool
+		inDisableRollback     bool
+		inCreateChangeSetOnly bool
+		inDetach              bool
+		inRedirectToHTTPS     *bool
+		inDeploymentStrategy  *string
 
 		// Cached variables.
 		inEnvironmentConfig func() *manifest.Environment
@@ -812,6 +923,48 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf(`validate ALB runtime configuration for "http": cannot configure http to https redirect without having a domain associated with the app "mockApp" or importing any certificates in env "mockEnv"`),
 		},
+		"cannot specify alias when the env imports a public load balancer": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.HTTPConfig.Public.LoadBalancer = aws.String("shared")
+				return envConfig
+			},
+			inAliases: manifest.Alias{AdvancedAliases: mockAlias},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			wantErr: fmt.Errorf(`validate ALB runtime configuration for "http": cannot specify "alias" when env "mockEnv" imports an existing public load balancer`),
+		},
+		"cannot redirect http to https when the env imports a public load balancer": {
+			inRedirectToHTTPS: aws.Bool(true),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.HTTPConfig.Public.LoadBalancer = aws.String("shared")
+				return envConfig
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			wantErr: fmt.Errorf(`validate ALB runtime configuration for "http": cannot configure http to https redirect when env "mockEnv" imports an existing public load balancer`),
+		},
 		"cannot specify alias hosted zone when no certificates are imported in the env": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -1332,6 +1485,22 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(nil)
 			},
 		},
+		"does not force update the service if only its change set is created": {
+			inForceDeploy:         true,
+			inCreateChangeSetOnly: true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(nil)
+			},
+		},
 		"success with force update": {
 			inForceDeploy: true,
 			inEnvironment: &config.Environment{
@@ -1353,6 +1522,158 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockSpinner.EXPECT().Stop(log.Ssuccessf(fmtForceUpdateSvcComplete, mockName, mockEnvName))
 			},
 		},
+		"error if fail to get the ECS service of a service deployed with CodeDeploy": {
+			inDeploymentStrategy: aws.String(manifest.CanaryDeploymentStrategy),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockECSServiceDescriber.EXPECT().Service(mockAppName, mockEnvName, mockName).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("get ECS service of mockWkld: some error"),
+		},
+		"skip CodeDeploy when the service is created": {
+			inDeploymentStrategy: aws.String(manifest.CanaryDeploymentStrategy),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockECSServiceDescriber.EXPECT().Service(mockAppName, mockEnvName, mockName).Return(nil, &ecs0.ErrNoServiceFound{})
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(nil)
+				m.mockECSServiceDescriber.EXPECT().Service(mockAppName, mockEnvName, mockName).Return(&ecs.Service{
+					TaskDefinition: aws.String("mockTaskDef:1"),
+				}, nil)
+				m.mockECSServiceDescriber.EXPECT().TaskDefinition(mockAppName, mockEnvName, mockName).Return(&ecs.TaskDefinition{
+					TaskDefinitionArn: aws.String("mockTaskDef:1"),
+				}, nil)
+			},
+		},
+		"error if the CodeDeploy deployment fails": {
+			inDeploymentStrategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockECSServiceDescriber.EXPECT().Service(mockAppName, mockEnvName, mockName).Return(&ecs.Service{
+					TaskDefinition:       aws.String("mockTaskDef:1"),
+					DeploymentController: &sdkecs.DeploymentController{Type: aws.String(sdkecs.DeploymentControllerTypeCodeDeploy)},
+				}, nil).Times(2)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(nil)
+				m.mockECSServiceDescriber.EXPECT().TaskDefinition(mockAppName, mockEnvName, mockName).Return(&ecs.TaskDefinition{
+					TaskDefinitionArn: aws.String("mockTaskDef:2"),
+				}, nil)
+				m.mockSpinner.EXPECT().Start(fmt.Sprintf(fmtCodeDeploySvcStart, mockName, mockEnvName))
+				m.mockCodeDeployer.EXPECT().DeployECSService(gomock.Any()).Return("d-1", nil)
+				m.mockCodeDeployer.EXPECT().WaitForDeployment("d-1").Return(mockError)
+				m.mockSpinner.EXPECT().Stop(log.Serror(fmt.Sprintf(fmtCodeDeploySvcFailed, mockName, mockEnvName, mockError)))
+			},
+			wantErr: fmt.Errorf("shift traffic of service mockWkld to its new tasks: some error"),
+		},
+		"shift the traffic to the new task definition with CodeDeploy": {
+			inDeploymentStrategy: aws.String(manifest.CanaryDeploymentStrategy),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockECSServiceDescriber.EXPECT().Service(mockAppName, mockEnvName, mockName).Return(&ecs.Service{
+					TaskDefinition:       aws.String("mockTaskDef:1"),
+					DeploymentController: &sdkecs.DeploymentController{Type: aws.String(sdkecs.DeploymentControllerTypeCodeDeploy)},
+				}, nil).Times(2)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).Return(nil)
+				m.mockECSServiceDescriber.EXPECT().TaskDefinition(mockAppName, mockEnvName, mockName).Return(&ecs.TaskDefinition{
+					TaskDefinitionArn: aws.String("mockTaskDef:2"),
+				}, nil)
+				m.mockSpinner.EXPECT().Start(fmt.Sprintf(fmtCodeDeploySvcStart, mockName, mockEnvName))
+				m.mockCodeDeployer.EXPECT().DeployECSService(codedeploy.ECSDeployment{
+					ApplicationName:     "mockApp-mockEnv-mockWkld",
+					DeploymentGroupName: mockName,
+					TaskDefinitionARN:   "mockTaskDef:2",
+					ContainerName:       mockName,
+					ContainerPort:       80,
+					Description:         "Deployment of service mockWkld in environment mockEnv by Copilot",
+				}).Return("d-1", nil)
+				m.mockCodeDeployer.EXPECT().WaitForDeployment("d-1").Return(nil)
+				m.mockSpinner.EXPECT().Stop(log.Ssuccessf(fmtCodeDeploySvcComplete, mockName, mockEnvName))
+			},
+		},
+		"do not wait for the CodeDeploy deployment when detached": {
+			inDeploymentStrategy: aws.String(manifest.CanaryDeploymentStrategy),
+			inDetach:             true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockECSServiceDescriber.EXPECT().Service(mockAppName, mockEnvName, mockName).Return(&ecs.Service{
+					TaskDefinition:       aws.String("mockTaskDef:1"),
+					DeploymentController: &sdkecs.DeploymentController{Type: aws.String(sdkecs.DeploymentControllerTypeCodeDeploy)},
+				}, nil).Times(2)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", true, gomock.Any()).Return(nil)
+				m.mockECSServiceDescriber.EXPECT().TaskDefinition(mockAppName, mockEnvName, mockName).Return(&ecs.TaskDefinition{
+					TaskDefinitionArn: aws.String("mockTaskDef:2"),
+				}, nil)
+				m.mockSpinner.EXPECT().Start(fmt.Sprintf(fmtCodeDeploySvcStart, mockName, mockEnvName))
+				m.mockCodeDeployer.EXPECT().DeployECSService(gomock.Any()).Return("d-1", nil)
+				m.mockSpinner.EXPECT().Stop(log.Ssuccessf(fmtCodeDeploySvcDetached, "d-1", mockName, mockEnvName))
+			},
+		},
+		"force an update with CodeDeploy": {
+			inDeploymentStrategy: aws.String(manifest.BlueGreenDeploymentStrategy),
+			inForceDeploy:        true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockECSServiceDescriber.EXPECT().Service(mockAppName, mockEnvName, mockName).Return(&ecs.Service{
+					TaskDefinition:       aws.String("mockTaskDef:1"),
+					DeploymentController: &sdkecs.DeploymentController{Type: aws.String(sdkecs.DeploymentControllerTypeCodeDeploy)},
+				}, nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", false, gomock.Any()).
+					Return(cloudformation.NewMockErrChangeSetEmpty())
+				m.mockSpinner.EXPECT().Start(fmt.Sprintf(fmtForceUpdateSvcStart, mockName, mockEnvName))
+				m.mockECSServiceDescriber.EXPECT().TaskDefinition(mockAppName, mockEnvName, mockName).Return(&ecs.TaskDefinition{
+					TaskDefinitionArn: aws.String("mockTaskDef:1"),
+				}, nil)
+				m.mockCodeDeployer.EXPECT().DeployECSService(gomock.Any()).Return("d-1", nil)
+				m.mockCodeDeployer.EXPECT().WaitForDeployment("d-1").Return(nil)
+				m.mockSpinner.EXPECT().Stop(log.Ssuccessf(fmtForceUpdateSvcComplete, mockName, mockEnvName))
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -1369,6 +1690,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				mockSpinner:                mocks.NewMockspinner(ctrl),
 				mockPublicCIDRBlocksGetter: mocks.NewMockpublicCIDRBlocksGetter(ctrl),
 				mockValidator:              mocks.NewMockaliasCertValidator(ctrl),
+				mockECSServiceDescriber:    mocks.NewMockecsServiceDescriber(ctrl),
+				mockCodeDeployer:           mocks.NewMockcodeDeployer(ctrl),
 			}
 			tc.mock(m)
 
@@ -1400,6 +1723,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				},
 				appVersionGetter:       m.mockAppVersionGetter,
 				publicCIDRBlocksGetter: m.mockPublicCIDRBlocksGetter,
+				ecsDescriber:           m.mockECSServiceDescriber,
+				codeDeployer:           m.mockCodeDeployer,
 				newAliasCertValidator: func(region *string) aliasCertValidator {
 					return m.mockValidator
 				},
@@ -1435,6 +1760,11 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 							},
 						},
 						NLBConfig: tc.inNLB,
+						DeployConfig: manifest.DeploymentConfig{
+							Strategy: manifest.DeploymentStrategy{
+								Type: tc.inDeploymentStrategy,
+							},
+						},
 					},
 				},
 				newStack: func() cloudformation0.StackConfiguration {
@@ -1444,8 +1774,10 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 
 			_, gotErr := deployer.DeployWorkload(&DeployWorkloadInput{
 				Options: Options{
-					ForceNewUpdate:  tc.inForceDeploy,
-					DisableRollback: tc.inDisableRollback,
+					ForceNewUpdate:      tc.inForceDeploy,
+					DisableRollback:     tc.inDisableRollback,
+					CreateChangeSetOnly: tc.inCreateChangeSetOnly,
+					Detach:              tc.inDetach,
 				},
 			})
 
@@ -1482,6 +1814,342 @@ func TestUploadArtifacts(t *testing.T) {
 
 }
 
+func TestContainerBuildContexts(t *testing.T) {
+	mft := &mockWorkloadMft{
+		dockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+			"api": {
+				Dockerfile: aws.String("api/Dockerfile"),
+				Context:    aws.String("api"),
+			},
+			"logrouter": {
+				Dockerfile: aws.String("logrouter/Dockerfile"),
+				Context:    aws.String("logrouter"),
+			},
+		},
+	}
+
+	got, err := ContainerBuildContexts("api", ".", mft)
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]ContainerBuildContext{
+		"api": {
+			Context:    "api",
+			Dockerfile: "api/Dockerfile",
+		},
+		"logrouter": {
+			Context:    "logrouter",
+			Dockerfile: "logrouter/Dockerfile",
+		},
+	}, got)
+}
+
+func TestBuildContainerImages_Containers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	builder := mocks.NewMockrepositoryService(ctrl)
+	builder.EXPECT().Login().Return("mockURI", nil)
+	builder.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, args *dockerengine.BuildArguments, _ io.Writer) (string, error) {
+		require.Equal(t, "logrouter", args.Context)
+		return "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil
+	})
+	out := &UploadArtifactsOutput{}
+
+	err := BuildContainerImages(&ImageActionInput{
+		Name:          "api",
+		WorkspacePath: ".",
+		Mft: &mockWorkloadMft{
+			dockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"api": {
+					Dockerfile: aws.String("api/Dockerfile"),
+					Context:    aws.String("api"),
+				},
+				"logrouter": {
+					Dockerfile: aws.String("logrouter/Dockerfile"),
+					Context:    aws.String("logrouter"),
+				},
+			},
+		},
+		Builder:    builder,
+		Login:      builder.Login,
+		Containers: []string{"logrouter"},
+		CheckDockerEngine: func(platforms []string) ([]dockerengine.PreflightWarning, error) {
+			require.Equal(t, []string{"mockContainerPlatform"}, platforms)
+			return nil, nil
+		},
+		AnalyzeBuildContext: func(contextDir, dockerfile string) (*dockerengine.BuildContext, error) {
+			return &dockerengine.BuildContext{Dir: contextDir}, nil
+		},
+	}, out)
+
+	require.NoError(t, err)
+	require.Len(t, out.ImageDigests, 1)
+	require.Contains(t, out.ImageDigests, "logrouter")
+}
+
+func TestBuildContainerImages_Platform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	builder := mocks.NewMockrepositoryService(ctrl)
+	builder.EXPECT().Login().Return("mockURI", nil)
+	builder.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, args *dockerengine.BuildArguments, _ io.Writer) (string, error) {
+		require.Equal(t, "linux/arm64", args.Platform)
+		return "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil
+	})
+	out := &UploadArtifactsOutput{}
+
+	err := BuildContainerImages(&ImageActionInput{
+		Name:          "api",
+		WorkspacePath: ".",
+		Mft: &mockWorkloadMft{
+			dockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"api": {
+					Dockerfile: aws.String("api/Dockerfile"),
+					Context:    aws.String("api"),
+				},
+			},
+		},
+		Builder:  builder,
+		Login:    builder.Login,
+		Platform: "linux/arm64",
+		CheckDockerEngine: func(platforms []string) ([]dockerengine.PreflightWarning, error) {
+			require.Equal(t, []string{"linux/arm64"}, platforms)
+			return nil, nil
+		},
+		AnalyzeBuildContext: func(contextDir, dockerfile string) (*dockerengine.BuildContext, error) {
+			return &dockerengine.BuildContext{Dir: contextDir}, nil
+		},
+	}, out)
+
+	require.NoError(t, err)
+	require.Contains(t, out.ImageDigests, "api")
+}
+
+func TestBuildContainerImages_BuildValues(t *testing.T) {
+	mft := func() *mockWorkloadMft {
+		return &mockWorkloadMft{
+			dockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"api": {
+					Dockerfile: aws.String("api/Dockerfile"),
+					Context:    aws.String("api"),
+					Args: map[string]manifest.BuildArg{
+						"NODE_ENV":  {Plain: aws.String("production")},
+						"NPM_TOKEN": {FromEnv: aws.String("MY_NPM_TOKEN")},
+						"REGISTRY":  {FromSSM: aws.String("/myapp/registry")},
+					},
+					Secrets: map[string]manifest.BuildSecret{
+						"npmrc": {FromFile: aws.String(".npmrc")},
+						"pip":   {FromSSM: aws.String("/myapp/pip-token")},
+						"token": {FromEnv: aws.String("MY_NPM_TOKEN")},
+					},
+					SSH:     []string{"default"},
+					Network: aws.String("host"),
+				},
+			},
+		}
+	}
+	getSSMParameter := func(_ context.Context, name string) (string, error) {
+		switch name {
+		case "/myapp/registry":
+			return "registry.example.com", nil
+		case "/myapp/pip-token":
+			return "s3cr3t", nil
+		}
+		return "", errors.New("parameter not found")
+	}
+	t.Run("resolves the build args and secrets from the environment, files and SSM", func(t *testing.T) {
+		t.Setenv("MY_NPM_TOKEN", "npm_123")
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		builder := mocks.NewMockrepositoryService(ctrl)
+		builder.EXPECT().Login().Return("mockURI", nil)
+		builder.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, args *dockerengine.BuildArguments, _ io.Writer) (string, error) {
+			require.Equal(t, map[string]string{
+				"NODE_ENV":  "production",
+				"NPM_TOKEN": "npm_123",
+				"REGISTRY":  "registry.example.com",
+			}, args.Args)
+			require.Equal(t, []dockerengine.BuildSecret{
+				{ID: "npmrc", Src: filepath.Join("/ws", ".npmrc")},
+				{ID: "pip", Value: "s3cr3t"},
+				{ID: "token", Env: "MY_NPM_TOKEN"},
+			}, args.Secrets)
+			require.Equal(t, []string{"default"}, args.SSH)
+			require.Equal(t, "host", args.Network)
+			return "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil
+		})
+
+		err := BuildContainerImages(&ImageActionInput{
+			Name:            "api",
+			WorkspacePath:   "/ws",
+			Mft:             mft(),
+			Builder:         builder,
+			Login:           builder.Login,
+			GetSSMParameter: getSSMParameter,
+			CheckDockerEngine: func(platforms []string) ([]dockerengine.PreflightWarning, error) {
+				return nil, nil
+			},
+			AnalyzeBuildContext: func(contextDir, dockerfile string) (*dockerengine.BuildContext, error) {
+				return &dockerengine.BuildContext{Dir: contextDir}, nil
+			},
+		}, &UploadArtifactsOutput{})
+
+		require.NoError(t, err)
+	})
+	t.Run("returns an error if the environment variable of a build arg is not set", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		err := BuildContainerImages(&ImageActionInput{
+			Name:            "api",
+			WorkspacePath:   "/ws",
+			Mft:             mft(),
+			Builder:         mocks.NewMockrepositoryService(ctrl),
+			GetSSMParameter: getSSMParameter,
+		}, &UploadArtifactsOutput{})
+
+		require.EqualError(t, err, "container api: environment variable MY_NPM_TOKEN of build arg NPM_TOKEN is not set")
+	})
+	t.Run("returns an error if the SSM parameter of a build secret can't be read", func(t *testing.T) {
+		t.Setenv("MY_NPM_TOKEN", "npm_123")
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		err := BuildContainerImages(&ImageActionInput{
+			Name:          "api",
+			WorkspacePath: "/ws",
+			Mft:           mft(),
+			Builder:       mocks.NewMockrepositoryService(ctrl),
+			GetSSMParameter: func(_ context.Context, name string) (string, error) {
+				if name == "/myapp/pip-token" {
+					return "", errors.New("access denied")
+				}
+				return getSSMParameter(context.Background(), name)
+			},
+		}, &UploadArtifactsOutput{})
+
+		require.EqualError(t, err, "container api: get the value of build secret pip: get SSM parameter /myapp/pip-token: access denied")
+	})
+}
+
+func TestBuildContainerImages_Buildpack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	builder := mocks.NewMockrepositoryService(ctrl)
+	builder.EXPECT().Login().Return("mockURI", nil)
+	builder.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, args *dockerengine.BuildArguments, _ io.Writer) (string, error) {
+		require.Equal(t, "api", args.Context)
+		require.Empty(t, args.Dockerfile)
+		require.Equal(t, "paketobuildpacks/builder-jammy-base", args.Builder)
+		require.Equal(t, []string{"paketo-buildpacks/nodejs"}, args.Buildpacks)
+		require.Equal(t, []string{"latest", "gitTag"}, args.Tags)
+		require.False(t, args.CacheFromRepository)
+		return "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil
+	})
+	out := &UploadArtifactsOutput{}
+
+	err := BuildContainerImages(&ImageActionInput{
+		Name:              "api",
+		WorkspacePath:     ".",
+		GitShortCommitTag: "gitTag",
+		Image:             ContainerImageIdentifier{GitShortCommitTag: "gitTag"},
+		Mft: &mockWorkloadMft{
+			dockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"api": {
+					Context: aws.String("api"),
+					Buildpack: manifest.BuildpackArgs{
+						Builder:    aws.String("paketobuildpacks/builder-jammy-base"),
+						Buildpacks: []string{"paketo-buildpacks/nodejs"},
+					},
+				},
+			},
+		},
+		Builder:             builder,
+		Login:               builder.Login,
+		CacheFromRepository: true,
+		CheckDockerEngine: func(platforms []string) ([]dockerengine.PreflightWarning, error) {
+			return nil, nil
+		},
+		AnalyzeBuildContext: func(contextDir, dockerfile string) (*dockerengine.BuildContext, error) {
+			return nil, errors.New("should not analyze the build context of a buildpack")
+		},
+	}, out)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"mockURI:gitTag", "mockURI:latest"}, out.ImageDigests["api"].RepoTags)
+}
+
+func TestBuildContainerImages_MaxParallelBuilds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	builder := mocks.NewMockrepositoryService(ctrl)
+	printer := mocks.NewMockLabeledTermPrinter(ctrl)
+	builder.EXPECT().Login().Return("mockURI", nil)
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	builder.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, args *dockerengine.BuildArguments, _ io.Writer) (string, error) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		require.True(t, args.CacheFromRepository)
+		return "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil
+	}).Times(3)
+	var bufs []*syncbuffer.LabeledSyncBuffer
+	printer.EXPECT().IsDone().DoAndReturn(func() bool {
+		for _, buf := range bufs {
+			if !buf.IsDone() {
+				return false
+			}
+		}
+		return true
+	}).AnyTimes()
+	printer.EXPECT().Print().AnyTimes()
+	out := &UploadArtifactsOutput{}
+
+	err := BuildContainerImages(&ImageActionInput{
+		Name:          "api",
+		WorkspacePath: ".",
+		Mft: &mockWorkloadMft{
+			dockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"api": {
+					Dockerfile: aws.String("api/Dockerfile"),
+					Context:    aws.String("api"),
+				},
+				"logrouter": {
+					Dockerfile: aws.String("logrouter/Dockerfile"),
+					Context:    aws.String("logrouter"),
+				},
+				"nginx": {
+					Dockerfile: aws.String("nginx/Dockerfile"),
+					Context:    aws.String("nginx"),
+				},
+			},
+		},
+		Builder:             builder,
+		Login:               builder.Login,
+		MaxParallelBuilds:   2,
+		CacheFromRepository: true,
+		CheckDockerEngine: func(platforms []string) ([]dockerengine.PreflightWarning, error) {
+			return nil, nil
+		},
+		AnalyzeBuildContext: func(contextDir, dockerfile string) (*dockerengine.BuildContext, error) {
+			return &dockerengine.BuildContext{Dir: contextDir}, nil
+		},
+		LabeledTermPrinter: func(fw syncbuffer.FileWriter, labeled []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) LabeledTermPrinter {
+			bufs = labeled
+			return printer
+		},
+	}, out)
+
+	require.NoError(t, err)
+	require.Len(t, out.ImageDigests, 3)
+	require.Equal(t, 2, maxRunning)
+}
+
 type deployDiffMocks struct {
 	mockDeployedTmplGetter *mocks.MockdeployedTemplateGetter
 }
@@ -1564,3 +2232,203 @@ func TestWorkloadDeployer_DeployDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadDeployer_injectSSMParameters(t *testing.T) {
+	plainVar := func(val string) manifest.Variable {
+		return manifest.Variable{
+			StringOrFromCFN: manifest.StringOrFromCFN{
+				Plain: aws.String(val),
+			},
+		}
+	}
+	testCases := map[string]struct {
+		inVariables map[string]manifest.Variable
+		setUpMocks  func(m *mocks.MockssmParameterLister)
+
+		wantedVariables map[string]string
+		wantedSecrets   map[string]string
+		wantedErr       error
+	}{
+		"no-op without a path of SSM parameters": {
+			inVariables: map[string]manifest.Variable{
+				"LOG_LEVEL": plainVar("info"),
+			},
+			setUpMocks: func(m *mocks.MockssmParameterLister) {
+				m.EXPECT().ListSecrets(gomock.Any()).Times(0)
+			},
+			wantedVariables: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+			wantedSecrets: map[string]string{},
+		},
+		"error listing the parameters under the path": {
+			inVariables: map[string]manifest.Variable{
+				"from_ssm_path": plainVar("/myapp/test/"),
+			},
+			setUpMocks: func(m *mocks.MockssmParameterLister) {
+				m.EXPECT().ListSecrets("/myapp/test/").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list SSM parameters under path /myapp/test/: some error"),
+		},
+		"injects every parameter under the path as a secret": {
+			inVariables: map[string]manifest.Variable{
+				"from_ssm_path": plainVar("/myapp/test/"),
+				"LOG_LEVEL":     plainVar("info"),
+			},
+			setUpMocks: func(m *mocks.MockssmParameterLister) {
+				m.EXPECT().ListSecrets("/myapp/test/").Return([]awsssm.SecretMetadata{
+					{Name: "/myapp/test/db-host"},
+					{Name: "/myapp/test/log_level"},
+					{Name: "/myapp/test/payments/api.key"},
+				}, nil)
+			},
+			wantedVariables: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+			wantedSecrets: map[string]string{
+				"DB_HOST":          "/myapp/test/db-host",
+				"PAYMENTS_API_KEY": "/myapp/test/payments/api.key",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockssmParameterLister(ctrl)
+			tc.setUpMocks(m)
+			mft := &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					TaskConfig: manifest.TaskConfig{
+						Variables: tc.inVariables,
+					},
+				},
+			}
+			deployer := workloadDeployer{
+				mft:            mft,
+				ssmParamLister: m,
+			}
+
+			err := deployer.injectSSMParameters()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			gotVariables := make(map[string]string)
+			for k, v := range mft.Variables {
+				gotVariables[k] = v.Value()
+			}
+			gotSecrets := make(map[string]string)
+			for k, v := range mft.Secrets {
+				gotSecrets[k] = v.Value()
+			}
+			require.Equal(t, tc.wantedVariables, gotVariables)
+			require.Equal(t, tc.wantedSecrets, gotSecrets)
+		})
+	}
+}
+
+func TestStackSettingsOptions(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest interface{}
+
+		wantedTerminationProtection *bool
+		wantedStackPolicy           *string
+	}{
+		"leaves the stack settings as they are if the manifest has no stack field": {
+			inManifest: &manifest.LoadBalancedWebService{},
+		},
+		"clears the stack policy if the manifest has none": {
+			inManifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					Stack: manifest.StackSettings{
+						TerminationProtection: aws.Bool(true),
+					},
+				},
+			},
+			wantedTerminationProtection: aws.Bool(true),
+			wantedStackPolicy:           aws.String(cloudformation.AllowAllStackPolicy),
+		},
+		"sets the stack policy of the manifest": {
+			inManifest: &manifest.ScheduledJob{
+				ScheduledJobConfig: manifest.ScheduledJobConfig{
+					Stack: manifest.StackSettings{
+						Policy: map[string]interface{}{
+							"Statement": []interface{}{
+								map[string]interface{}{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "*"},
+							},
+						},
+					},
+				},
+			},
+			wantedTerminationProtection: aws.Bool(false),
+			wantedStackPolicy:           aws.String(`{"Statement":[{"Action":"Update:Replace","Effect":"Deny","Principal":"*","Resource":"*"}]}`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts, err := stackSettingsOptions(tc.inManifest)
+			require.NoError(t, err)
+
+			got := cloudformation.NewStack("phonetool-test-api", "template", opts...)
+			require.Equal(t, tc.wantedTerminationProtection, got.TerminationProtection)
+			require.Equal(t, tc.wantedStackPolicy, got.StackPolicyBody)
+		})
+	}
+}
+
+func TestWorkloadDeployer_helperImages(t *testing.T) {
+	mft := &manifest.BackendService{
+		BackendServiceConfig: manifest.BackendServiceConfig{
+			Logging: manifest.Logging{
+				Destination: map[string]string{"Name": "cloudwatch"},
+			},
+			Observability: manifest.Observability{
+				Tracing: aws.String("awsxray"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inMft          interface{}
+		inHelperImages map[string]string
+
+		wanted map[string]string
+	}{
+		"no helper images are injected": {
+			inMft: &manifest.BackendService{},
+		},
+		"default images": {
+			inMft: mft,
+			wanted: map[string]string{
+				"fluent-bit":         template.DefaultFluentBitImage,
+				"aws-otel-collector": template.DefaultOTelCollectorImage,
+			},
+		},
+		"images of the application": {
+			inMft: mft,
+			inHelperImages: map[string]string{
+				"fluent-bit": "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/fluent-bit@sha256:3333",
+			},
+			wanted: map[string]string{
+				"fluent-bit":         "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/fluent-bit@sha256:3333",
+				"aws-otel-collector": template.DefaultOTelCollectorImage,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			deployer := workloadDeployer{
+				app: &config.Application{
+					Name:         "phonetool",
+					HelperImages: tc.inHelperImages,
+				},
+				mft: tc.inMft,
+			}
+
+			require.Equal(t, tc.wanted, deployer.helperImages())
+		})
+	}
+}
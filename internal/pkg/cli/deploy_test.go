@@ -682,6 +682,210 @@ type: Load Balanced Web Service`)
 	}
 }
 
+func TestDeployOpts_RunAll(t *testing.T) {
+	manifests := map[string]string{
+		"api": `
+name: api
+type: Backend Service
+publish:
+  topics:
+    - name: orders`,
+		"cron": `
+name: cron
+type: Scheduled Job`,
+		"fe": `
+name: fe
+type: Load Balanced Web Service
+variables:
+  API_URL: http://api.test.app.local:8080`,
+		"worker": `
+name: worker
+type: Worker Service
+subscribe:
+  topics:
+    - name: orders
+      service: api`,
+	}
+	type deployAllMocks struct {
+		store  *mocks.Mockstore
+		ws     *mocks.MockwsWlDirReader
+		sel    *mocks.MockwsSelector
+		waiter *mocks.MockworkloadStacksWaiter
+		cmds   map[string]*mocks.MockactionCommand
+	}
+	mockWorkspace := func(m deployAllMocks) {
+		m.store.EXPECT().GetEnvironment("app", "test").Return(&config.Environment{App: "app", Name: "test"}, nil)
+		m.ws.EXPECT().ListEnvironments().Return(nil, nil)
+		m.ws.EXPECT().ListWorkloads().Return([]string{"api", "cron", "fe", "worker"}, nil)
+		m.store.EXPECT().ListWorkloads("app").Return([]*config.Workload{
+			{Name: "api"}, {Name: "cron"}, {Name: "fe"}, {Name: "worker"},
+		}, nil).Times(4)
+		for name, mft := range manifests {
+			m.ws.EXPECT().ReadWorkloadManifest(name).Return(workspace.WorkloadManifest(mft), nil)
+		}
+	}
+	mockDeploy := func(m deployAllMocks, name string, err error) {
+		m.store.EXPECT().GetWorkload("app", name).Return(&config.Workload{Name: name, Type: "Backend Service"}, nil)
+		m.cmds[name].EXPECT().Ask().Return(nil)
+		m.cmds[name].EXPECT().Validate().Return(nil)
+		m.cmds[name].EXPECT().Execute().Return(err)
+	}
+	testCases := map[string]struct {
+		inName    string
+		inDetach  bool
+		inSelect  bool
+		callMocks func(m deployAllMocks)
+
+		wantedErr         string
+		wantedErrContains string
+	}{
+		"error if a workload is also specified": {
+			inName:    "fe",
+			callMocks: func(m deployAllMocks) {},
+			wantedErr: "cannot specify both --all and --name",
+		},
+		"error if the deployments are detached": {
+			inDetach:  true,
+			callMocks: func(m deployAllMocks) {},
+			wantedErr: "cannot specify both --all and --detach",
+		},
+		"deploys only the selected workloads": {
+			inSelect: true,
+			callMocks: func(m deployAllMocks) {
+				m.store.EXPECT().GetEnvironment("app", "test").Return(&config.Environment{App: "app", Name: "test"}, nil)
+				m.ws.EXPECT().ListEnvironments().Return(nil, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "cron", "fe", "worker"}, nil)
+				m.sel.EXPECT().Workloads(gomock.Any(), gomock.Any()).Return([]string{"api", "worker"}, nil)
+				m.store.EXPECT().ListWorkloads("app").Return([]*config.Workload{
+					{Name: "api"}, {Name: "cron"}, {Name: "fe"}, {Name: "worker"},
+				}, nil).Times(2)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(manifests["api"]), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("worker").Return(workspace.WorkloadManifest(manifests["worker"]), nil)
+				mockDeploy(m, "api", nil)
+				mockDeploy(m, "worker", nil)
+				gomock.InOrder(
+					m.waiter.EXPECT().WaitForWorkloadStacks([]string{"app-test-api"}).Return(nil, nil),
+					m.waiter.EXPECT().WaitForWorkloadStacks([]string{"app-test-worker"}).Return(nil, nil),
+				)
+			},
+		},
+		"error if fail to select the workloads": {
+			inSelect: true,
+			callMocks: func(m deployAllMocks) {
+				m.store.EXPECT().GetEnvironment("app", "test").Return(&config.Environment{App: "app", Name: "test"}, nil)
+				m.ws.EXPECT().ListEnvironments().Return(nil, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "cron", "fe", "worker"}, nil)
+				m.sel.EXPECT().Workloads(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "select services or jobs: some error",
+		},
+		"error if the workloads depend on each other": {
+			callMocks: func(m deployAllMocks) {
+				m.store.EXPECT().GetEnvironment("app", "test").Return(&config.Environment{App: "app", Name: "test"}, nil)
+				m.ws.EXPECT().ListEnvironments().Return(nil, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "fe"}, nil)
+				m.store.EXPECT().ListWorkloads("app").Return([]*config.Workload{{Name: "api"}, {Name: "fe"}}, nil).Times(2)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(`
+variables:
+  FE_URL: http://fe:80`), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("fe").Return(workspace.WorkloadManifest(manifests["fe"]), nil)
+			},
+			wantedErrContains: "resolve the order of the workloads: graph contains a cycle: ",
+		},
+		"deploys the workloads after the workloads they depend on": {
+			callMocks: func(m deployAllMocks) {
+				mockWorkspace(m)
+				mockDeploy(m, "api", nil)
+				mockDeploy(m, "cron", &errNoInfrastructureChanges{parentErr: errors.New("change set with name copilot-1 for stack app-test-cron has no changes")})
+				mockDeploy(m, "fe", nil)
+				mockDeploy(m, "worker", nil)
+				gomock.InOrder(
+					m.waiter.EXPECT().WaitForWorkloadStacks([]string{"app-test-api"}).Return(nil, nil),
+					m.waiter.EXPECT().WaitForWorkloadStacks([]string{"app-test-fe", "app-test-worker"}).Return(nil, nil),
+				)
+			},
+		},
+		"skips the workloads that depend on a workload that failed to deploy": {
+			callMocks: func(m deployAllMocks) {
+				mockWorkspace(m)
+				mockDeploy(m, "api", nil)
+				mockDeploy(m, "cron", errors.New("some error"))
+				m.waiter.EXPECT().WaitForWorkloadStacks([]string{"app-test-api"}).Return(map[string]error{
+					"app-test-api": errors.New("stack app-test-api did not complete successfully and exited with status UPDATE_ROLLBACK_COMPLETE"),
+				}, nil)
+			},
+			wantedErr: `4 of 4 workloads were not deployed:
+api: stack app-test-api did not complete successfully and exited with status UPDATE_ROLLBACK_COMPLETE
+cron: execute svc deploy: some error
+fe: skipped since api failed to deploy
+worker: skipped since api failed to deploy`,
+		},
+		"error if the deployments can't be waited for": {
+			callMocks: func(m deployAllMocks) {
+				mockWorkspace(m)
+				mockDeploy(m, "api", nil)
+				mockDeploy(m, "cron", nil)
+				m.waiter.EXPECT().WaitForWorkloadStacks([]string{"app-test-api", "app-test-cron"}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "wait for workloads api, cron to deploy: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := deployAllMocks{
+				store:  mocks.NewMockstore(ctrl),
+				ws:     mocks.NewMockwsWlDirReader(ctrl),
+				sel:    mocks.NewMockwsSelector(ctrl),
+				waiter: mocks.NewMockworkloadStacksWaiter(ctrl),
+				cmds:   make(map[string]*mocks.MockactionCommand),
+			}
+			for name := range manifests {
+				m.cmds[name] = mocks.NewMockactionCommand(ctrl)
+			}
+			tc.callMocks(m)
+			opts := &deployOpts{
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: "app",
+						name:    tc.inName,
+						envName: "test",
+						detach:  tc.inDetach,
+					},
+					all:         true,
+					selectWklds: tc.inSelect,
+				},
+				store: m.store,
+				ws:    m.ws,
+				sel:   m.sel,
+				newStacksWaiter: func(o *deployOpts) (workloadStacksWaiter, error) {
+					return m.waiter, nil
+				},
+				setupDeployCmd: func(o *deployOpts, wlType string) {
+					require.True(t, o.detach)
+					o.deployWkld = m.cmds[o.name]
+				},
+			}
+
+			// WHEN
+			err := opts.Run()
+
+			// THEN
+			if tc.wantedErrContains != "" {
+				require.ErrorContains(t, err, tc.wantedErrContains)
+				return
+			}
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func Test_deployOpts_checkEnvExists(t *testing.T) {
 	mockError := errors.New("some error")
 	tests := map[string]struct {
@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildDeploymentCmd is the top level command for deployments.
+func BuildDeploymentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "deployment",
+		Short: `Commands for deployments.
+Deployments are the change sets that Copilot proposes to update the stacks of your application.`,
+	}
+
+	cmd.AddCommand(buildDeploymentExecuteCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Release,
+	}
+	return cmd
+}
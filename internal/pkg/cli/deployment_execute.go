@@ -0,0 +1,96 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const changeSetResourcePrefix = "changeSet/"
+
+type executeDeploymentVars struct {
+	changeSetARN    string
+	disableRollback bool
+}
+
+type executeDeploymentOpts struct {
+	executeDeploymentVars
+
+	newExecutor func(region string) (changeSetExecutor, error)
+
+	// cached variables
+	region string
+}
+
+func newExecuteDeploymentOpts(vars executeDeploymentVars) *executeDeploymentOpts {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("deployment execute"))
+	return &executeDeploymentOpts{
+		executeDeploymentVars: vars,
+		newExecutor: func(region string) (changeSetExecutor, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, err
+			}
+			return cloudformation.New(sess, cloudformation.WithProgressTracker(os.Stderr)), nil
+		},
+	}
+}
+
+// Validate returns an error if the argument isn't the ARN of a CloudFormation change set.
+func (o *executeDeploymentOpts) Validate() error {
+	parsed, err := arn.Parse(o.changeSetARN)
+	if err != nil || parsed.Service != "cloudformation" || !strings.HasPrefix(parsed.Resource, changeSetResourcePrefix) {
+		return fmt.Errorf("%s is not the ARN of a CloudFormation change set", o.changeSetARN)
+	}
+	o.region = parsed.Region
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *executeDeploymentOpts) Ask() error {
+	return nil
+}
+
+// Execute executes the change set with the current credentials and renders the deployment until it's done.
+func (o *executeDeploymentOpts) Execute() error {
+	executor, err := o.newExecutor(o.region)
+	if err != nil {
+		return err
+	}
+	if err := executor.ExecuteChangeSet(o.changeSetARN, o.disableRollback); err != nil {
+		return fmt.Errorf("execute change set %s: %w", o.changeSetARN, err)
+	}
+	log.Successf("Executed change set %s.\n", o.changeSetARN)
+	return nil
+}
+
+// buildDeploymentExecuteCmd builds the command for executing a change set created with --create-change-set-only.
+func buildDeploymentExecuteCmd() *cobra.Command {
+	vars := executeDeploymentVars{}
+	cmd := &cobra.Command{
+		Use:   "execute <change set ARN>",
+		Short: "Executes a change set that a deployment created without executing it.",
+		Long: `Executes a change set that a deployment created without executing it.
+The change set is executed with the current credentials in the region of the change set,
+so that it can be approved by a different identity than the one that proposed it.`,
+		Example: `
+  Executes the change set created by "copilot svc deploy --create-change-set-only".
+  /code $ copilot deployment execute arn:aws:cloudformation:us-west-2:123456789012:changeSet/copilot-a1b2/c3d4`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.changeSetARN = args[0]
+			return run(newExecuteDeploymentOpts(vars))
+		}),
+	}
+	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	return cmd
+}
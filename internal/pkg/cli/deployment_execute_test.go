@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockChangeSetARN = "arn:aws:cloudformation:us-west-2:123456789012:changeSet/copilot-a1b2/c3d4"
+
+func TestExecuteDeploymentOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inARN string
+
+		wantedRegion string
+		wantedErr    error
+	}{
+		"error if the argument isn't an ARN": {
+			inARN:     "copilot-a1b2",
+			wantedErr: errors.New("copilot-a1b2 is not the ARN of a CloudFormation change set"),
+		},
+		"error if the ARN isn't a change set": {
+			inARN:     "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api/c3d4",
+			wantedErr: errors.New("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api/c3d4 is not the ARN of a CloudFormation change set"),
+		},
+		"uses the region of the change set": {
+			inARN:        mockChangeSetARN,
+			wantedRegion: "us-west-2",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &executeDeploymentOpts{
+				executeDeploymentVars: executeDeploymentVars{
+					changeSetARN: tc.inARN,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRegion, opts.region)
+		})
+	}
+}
+
+func TestExecuteDeploymentOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inDisableRollback bool
+		mockExecutor      func(m *mocks.MockchangeSetExecutor)
+
+		wantedErr error
+	}{
+		"error if the change set fails to execute": {
+			mockExecutor: func(m *mocks.MockchangeSetExecutor) {
+				m.EXPECT().ExecuteChangeSet(mockChangeSetARN, false).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("execute change set " + mockChangeSetARN + ": some error"),
+		},
+		"executes the change set without rollback": {
+			inDisableRollback: true,
+			mockExecutor: func(m *mocks.MockchangeSetExecutor) {
+				m.EXPECT().ExecuteChangeSet(mockChangeSetARN, true).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockchangeSetExecutor(ctrl)
+			tc.mockExecutor(m)
+			var gotRegion string
+			opts := &executeDeploymentOpts{
+				executeDeploymentVars: executeDeploymentVars{
+					changeSetARN:    mockChangeSetARN,
+					disableRollback: tc.inDisableRollback,
+				},
+				newExecutor: func(region string) (changeSetExecutor, error) {
+					gotRegion = region
+					return m, nil
+				},
+				region: "us-west-2",
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.Equal(t, "us-west-2", gotRegion)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
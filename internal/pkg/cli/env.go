@@ -41,11 +41,14 @@ Environments are deployment stages shared between services.`,
 	cmd.AddCommand(buildEnvInitCmd())
 	cmd.AddCommand(buildEnvListCmd())
 	cmd.AddCommand(buildEnvShowCmd())
+	cmd.AddCommand(buildEnvCertificatesCmd())
 	cmd.AddCommand(buildEnvUpgradeCmd())
 	cmd.AddCommand(buildEnvPkgCmd())
 	cmd.AddCommand(buildEnvOverrideCmd())
 	cmd.AddCommand(buildEnvDeployCmd())
+	cmd.AddCommand(buildEnvDrainInstanceCmd())
 	cmd.AddCommand(buildEnvDeleteCmd())
+	cmd.AddCommand(buildEnvRunLocalCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.Develop,
@@ -0,0 +1,176 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envCertificatesNamePrompt = "Which environment of %s would you like to list the certificates of?"
+	envCertificatesHelpPrompt = "The certificates used by the load balancers and the CloudFront distribution of the environment will be listed."
+)
+
+type certificatesEnvVars struct {
+	appName          string
+	name             string
+	shouldOutputJSON bool
+	outputFormat     outputFormatVars
+}
+
+type certificatesEnvOpts struct {
+	certificatesEnvVars
+
+	w                io.Writer
+	store            store
+	describer        envCertificatesDescriber
+	sel              configSelector
+	initEnvDescriber func() error
+}
+
+func newCertificatesEnvOpts(vars certificatesEnvVars) (*certificatesEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env certificates"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
+
+	opts := &certificatesEnvOpts{
+		certificatesEnvVars: vars,
+		store:               store,
+		w:                   log.OutputWriter,
+		sel:                 selector.NewConfigSelector(prompt.New(), store),
+	}
+	opts.initEnvDescriber = func() error {
+		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.name,
+			ConfigStore: store,
+			DeployStore: deployStore,
+		})
+		if err != nil {
+			return fmt.Errorf("creating describer for environment %s in application %s: %w", opts.name, opts.appName, err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if any optional flags are invalid.
+func (o *certificatesEnvOpts) Validate() error {
+	return o.outputFormat.validate()
+}
+
+// Ask validates required fields that users passed in, otherwise it prompts for them.
+func (o *certificatesEnvOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnv()
+}
+
+// Execute lists the certificates used by the environment.
+func (o *certificatesEnvOpts) Execute() error {
+	if err := o.initEnvDescriber(); err != nil {
+		return err
+	}
+	certs, err := o.describer.Certificates()
+	if err != nil {
+		return fmt.Errorf("list certificates of environment %s: %w", o.name, err)
+	}
+	if o.shouldOutputJSON || o.outputFormat.isSet() {
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			data, err := certs.JSONString()
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(w, data)
+			return nil
+		})
+	}
+	fmt.Fprint(o.w, certs.HumanString())
+	return nil
+}
+
+func (o *certificatesEnvOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(envShowAppNamePrompt, envShowAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *certificatesEnvOpts) validateOrAskEnv() error {
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return fmt.Errorf("validate environment name %q in application %q: %v", o.name, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envCertificatesNamePrompt, color.HighlightUserInput(o.appName)), envCertificatesHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.name = env
+	return nil
+}
+
+// buildEnvCertificatesCmd builds the command for listing the certificates of an environment.
+func buildEnvCertificatesCmd() *cobra.Command {
+	vars := certificatesEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "certificates",
+		Short: "Lists the certificates used by a deployed environment.",
+		Long: `Lists the ACM certificates used by the load balancers and the CloudFront distribution of a deployed environment,
+with their domains, validation status, and expiry dates.
+Warns about the certificates that expire soon or are stuck in pending validation.`,
+
+		Example: `
+  List the certificates of the "prod" environment.
+  /code $ copilot env certificates -n prod
+  Print the certificates that have warnings.
+  /code $ copilot env certificates -n prod --query "certificates[?warnings]"`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newCertificatesEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.format, formatFlag, "", formatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.query, queryFlag, "", queryFlagDescription)
+	return cmd
+}
@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type certificatesEnvMocks struct {
+	store     *mocks.Mockstore
+	describer *mocks.MockenvCertificatesDescriber
+	sel       *mocks.MockconfigSelector
+}
+
+func TestEnvCertificates_Ask(t *testing.T) {
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp string
+		inputEnv string
+
+		setupMocks func(m certificatesEnvMocks)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"validate the flags": {
+			inputApp: "my-app",
+			inputEnv: "my-env",
+			setupMocks: func(m certificatesEnvMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(nil, nil)
+			},
+			wantedApp: "my-app",
+			wantedEnv: "my-env",
+		},
+		"error if the environment doesn't exist": {
+			inputApp: "my-app",
+			inputEnv: "my-env",
+			setupMocks: func(m certificatesEnvMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(nil, mockErr)
+			},
+			wantedError: errors.New(`validate environment name "my-env" in application "my-app": some error`),
+		},
+		"error if fail to select the environment": {
+			inputApp: "my-app",
+			setupMocks: func(m certificatesEnvMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(nil, nil)
+				m.sel.EXPECT().Environment(fmt.Sprintf(envCertificatesNamePrompt, "my-app"), envCertificatesHelpPrompt, "my-app").Return("", mockErr)
+			},
+			wantedError: errors.New("select environment for application my-app: some error"),
+		},
+		"prompt for the application and the environment": {
+			setupMocks: func(m certificatesEnvMocks) {
+				m.sel.EXPECT().Application(envShowAppNamePrompt, envShowAppNameHelpPrompt).Return("my-app", nil)
+				m.sel.EXPECT().Environment(fmt.Sprintf(envCertificatesNamePrompt, "my-app"), envCertificatesHelpPrompt, "my-app").Return("my-env", nil)
+			},
+			wantedApp: "my-app",
+			wantedEnv: "my-env",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := certificatesEnvMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockconfigSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &certificatesEnvOpts{
+				certificatesEnvVars: certificatesEnvVars{
+					appName: tc.inputApp,
+					name:    tc.inputEnv,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.name)
+		})
+	}
+}
+
+func TestEnvCertificates_Execute(t *testing.T) {
+	certs := &describe.EnvCertificates{
+		Certificates: []*describe.EnvCertificate{
+			{
+				ARN:     "arn:aws:acm:us-west-2:123456789012:certificate/abc",
+				UsedBy:  []string{"Public load balancer"},
+				Managed: true,
+				Domains: []string{"example.com"},
+				Status:  "PENDING_VALIDATION",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		outputFormat     outputFormatVars
+
+		setupMocks func(m certificatesEnvMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"error if fail to list the certificates": {
+			setupMocks: func(m certificatesEnvMocks) {
+				m.describer.EXPECT().Certificates().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list certificates of environment my-env: some error"),
+		},
+		"print the certificates in human format": {
+			setupMocks: func(m certificatesEnvMocks) {
+				m.describer.EXPECT().Certificates().Return(certs, nil)
+			},
+			wantedContent: `Certificates
+
+  ID      Used By               Domains      Status              Expires
+  --      -------               -------      ------              -------
+  abc     Public load balancer  example.com  PENDING_VALIDATION  -
+`,
+		},
+		"print the certificates in JSON format": {
+			shouldOutputJSON: true,
+			setupMocks: func(m certificatesEnvMocks) {
+				m.describer.EXPECT().Certificates().Return(certs, nil)
+			},
+			wantedContent: `{"certificates":[{"arn":"arn:aws:acm:us-west-2:123456789012:certificate/abc","usedBy":["Public load balancer"],"managed":true,"domains":["example.com"],"status":"PENDING_VALIDATION"}]}` + "\n",
+		},
+		"query the JSON output": {
+			outputFormat: outputFormatVars{query: "certificates[].status"},
+			setupMocks: func(m certificatesEnvMocks) {
+				m.describer.EXPECT().Certificates().Return(certs, nil)
+			},
+			wantedContent: `[
+  "PENDING_VALIDATION"
+]
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := certificatesEnvMocks{
+				describer: mocks.NewMockenvCertificatesDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &certificatesEnvOpts{
+				certificatesEnvVars: certificatesEnvVars{
+					appName:          "my-app",
+					name:             "my-env",
+					shouldOutputJSON: tc.shouldOutputJSON,
+					outputFormat:     tc.outputFormat,
+				},
+				w:                b,
+				describer:        m.describer,
+				initEnvDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -6,41 +6,74 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
-	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	awss3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/clean"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stackdescr "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/s3"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 	"gopkg.in/yaml.v3"
 )
 
 const (
-	envDeleteAppNameHelpPrompt = "An environment will be deleted in the selected application."
-	envDeleteNamePrompt        = "Which environment would you like to delete?"
-	fmtDeleteEnvPrompt         = "Are you sure you want to delete environment %q from application %q?"
+	envDeleteAppNameHelpPrompt  = "An environment will be deleted in the selected application."
+	envDeleteNamePrompt         = "Which environment would you like to delete?"
+	fmtDeleteEnvPrompt          = "Are you sure you want to delete environment %q from application %q?"
+	fmtDeleteEnvWorkloadsPrompt = "Environment %q has %s deployed to it. Delete them along with the environment?"
 )
 
+const (
+	fmtDeleteEnvWorkloadsStart    = "Deleting %s from environment %q"
+	fmtDeleteEnvWorkloadsFailed   = "Failed to delete the services and jobs of environment %q.\n"
+	fmtDeleteEnvWorkloadsComplete = "Deleted the services and jobs of environment %q.\n"
+)
+
+const (
+	maxConcurrentWorkloadDeletions = 5                // Number of workload stacks deleted at the same time.
+	maxStackDeleteAttempts         = 3                // Number of times a stack deletion is attempted before giving up.
+	stackDeleteRetryDelay          = 30 * time.Second // Time to wait for the network interfaces to be released before retrying.
+)
+
+// eniDependencyReasons are parts of the status reasons of resources that fail to delete
+// because network interfaces released asynchronously are still attached to them.
+var eniDependencyReasons = []string{
+	"dependencyviolation",
+	"has dependencies",
+	"dependent object",
+	"network interface",
+}
+
 const (
 	fmtRetainEnvRolesStart    = "Retain IAM roles before deleting the %q environment"
 	fmtRetainEnvRolesFailed   = "Failed to retain IAM roles for the %q environment\n"
@@ -68,6 +101,8 @@ type deleteEnvVars struct {
 	appName          string
 	name             string
 	skipConfirmation bool
+	dryRun           bool
+	deleteWorkloads  bool
 }
 
 type deleteEnvOpts struct {
@@ -86,6 +121,14 @@ type deleteEnvOpts struct {
 	prog                   progress
 	prompt                 prompter
 	sel                    configSelector
+	envStackResources      stackResourcesLister // Lists the resources of the stacks to delete.
+	stackDeleter           stackDeleter
+	eniDeleter             networkInterfaceDeleter
+	w                      io.Writer
+
+	newWkldCleaner func(o *deleteEnvOpts, wkld string) cleaner
+	retryDelay     time.Duration
+	sleep          func(time.Duration)
 
 	// cached data to avoid fetching the same information multiple times.
 	envConfig *config.Environment
@@ -95,8 +138,7 @@ type deleteEnvOpts struct {
 	initRuntimeClients func(*deleteEnvOpts) error
 }
 
-func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env delete"))
+func newDeleteEnvOpts(vars deleteEnvVars, sessProvider *sessions.Provider) (*deleteEnvOpts, error) {
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %v", err)
@@ -111,6 +153,10 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 		prog:   termprogress.NewSpinner(log.DiagnosticWriter),
 		sel:    selector.NewConfigSelector(prompter, store),
 		prompt: prompter,
+		w:      os.Stdout,
+
+		retryDelay: stackDeleteRetryDelay,
+		sleep:      time.Sleep,
 
 		initRuntimeClients: func(o *deleteEnvOpts) error {
 			env, err := o.getEnvConfig()
@@ -123,8 +169,15 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 			}
 			o.rg = resourcegroupstaggingapi.New(sess)
 			o.iam = iam.New(sess)
-			o.s3 = s3.New(sess)
+			o.s3 = awss3.New(sess)
 			o.envStackDescriber = stackdescr.NewStackDescriber(stack.NameForEnv(o.appName, o.name), sess)
+			o.envStackResources = awscfn.New(sess)
+			o.stackDeleter = awscfn.New(sess)
+			o.eniDeleter = ec2.New(sess)
+			o.newWkldCleaner = func(o *deleteEnvOpts, wkld string) cleaner {
+				// Only static sites have a bucket to empty, the cleaner is a no-op for the other workloads.
+				return clean.StaticSite(o.appName, o.name, wkld, s3.New(sess), awss3.New(sess))
+			}
 			o.deployer = cloudformation.New(sess, cloudformation.WithProgressTracker(os.Stderr))
 			o.envDeleterFromApp = cloudformation.New(defaultSess, cloudformation.WithProgressTracker(os.Stderr))
 			o.pipelineGetter = codepipeline.New(defaultSess)
@@ -152,7 +205,7 @@ func (o *deleteEnvOpts) Ask() error {
 	if err := o.askEnvName(); err != nil {
 		return err
 	}
-	if o.skipConfirmation {
+	if o.skipConfirmation || o.dryRun {
 		return nil
 	}
 	deleteConfirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtDeleteEnvPrompt, o.name, o.appName), "", prompt.WithConfirmFinalMessage())
@@ -166,17 +219,26 @@ func (o *deleteEnvOpts) Ask() error {
 }
 
 // Execute deletes the environment from the application by:
-// 1. Emptying environment managed S3 buckets.
-// 2. Deleting the cloudformation stack.
-// 3. Deleting the EnvManagerRole and CFNExecutionRole.
-// 4. Deleting the parameter from the SSM store.
-// The environment is removed from the store only if other delete operations succeed.
+// 1. Deleting the stacks of the services and jobs deployed to the environment, if the user opted in.
+// 2. Emptying environment managed S3 buckets.
+// 3. Deleting the cloudformation stack.
+// 4. Deleting the EnvManagerRole and CFNExecutionRole.
+// 5. Deleting the parameter from the SSM store.
+// The environment is removed from the store only if other delete operations succeed,
+// so running the command again resumes the deletion where it failed.
 // Execute assumes that Validate is invoked first.
 func (o *deleteEnvOpts) Execute() error {
 	if err := o.initRuntimeClients(o); err != nil {
 		return err
 	}
-	if err := o.validateNoRunningServices(); err != nil {
+	if o.dryRun {
+		return o.writeDeletionPlan()
+	}
+	wklds, err := o.deployedWorkloads()
+	if err != nil {
+		return err
+	}
+	if err := o.validateNoRunningServices(wklds); err != nil {
 		return err
 	}
 
@@ -184,6 +246,10 @@ func (o *deleteEnvOpts) Execute() error {
 		return err
 	}
 
+	if err := o.deleteWorkloadStacks(wklds); err != nil {
+		return err
+	}
+
 	o.prog.Start(fmt.Sprintf(fmtRetainEnvRolesStart, o.name))
 	if err := o.ensureRolesAreRetained(); err != nil {
 		o.prog.Stop(log.Serrorf(fmtRetainEnvRolesFailed, o.name))
@@ -233,6 +299,34 @@ func (o *deleteEnvOpts) RecommendActions() error {
 	return nil
 }
 
+// writeDeletionPlan writes the resources that deleting the environment would remove.
+func (o *deleteEnvOpts) writeDeletionPlan() error {
+	env, err := o.getEnvConfig()
+	if err != nil {
+		return err
+	}
+	wklds, err := o.deployedWorkloads()
+	if err != nil {
+		return err
+	}
+	var plan deletionPlan
+	for _, wkld := range wklds {
+		if err := plan.addStack(o.envStackResources, stack.NameForWorkload(o.appName, o.name, wkld)); err != nil {
+			return err
+		}
+	}
+	if err := plan.addStack(o.envStackResources, stack.NameForEnv(o.appName, o.name)); err != nil {
+		return err
+	}
+	// The roles are retained by the stack, and deleted once the stack is deleted.
+	for _, roleARN := range []string{env.ExecutionRoleARN, env.ManagerRoleARN} {
+		plan.add(deletionKindRole, roleNameFromARN(roleARN), "")
+	}
+	plan.add(deletionKindConfig, fmt.Sprintf("environment %s", o.name), "")
+	plan.write(o.w, fmt.Sprintf("Deleting environment %s from application %s", o.name, o.appName))
+	return nil
+}
+
 func (o *deleteEnvOpts) validateEnvName() error {
 	if _, err := o.getEnvConfig(); err != nil {
 		return err
@@ -265,7 +359,8 @@ func (o *deleteEnvOpts) askEnvName() error {
 	return nil
 }
 
-func (o *deleteEnvOpts) validateNoRunningServices() error {
+// deployedWorkloads returns the names of the services and jobs deployed to the environment.
+func (o *deleteEnvOpts) deployedWorkloads() ([]string, error) {
 	stacks, err := o.rg.GetResources(&resourcegroupstaggingapi.GetResourcesInput{
 		ResourceTypeFilters: []*string{aws.String("cloudformation")},
 		TagFilters: []*resourcegroupstaggingapi.TagFilter{
@@ -284,19 +379,147 @@ func (o *deleteEnvOpts) validateNoRunningServices() error {
 		},
 	})
 	if err != nil {
-		return fmt.Errorf("find service cloudformation stacks: %w", err)
-	}
-	if len(stacks.ResourceTagMappingList) > 0 {
-		var svcNames []string
-		for _, cfnStack := range stacks.ResourceTagMappingList {
-			for _, t := range cfnStack.Tags {
-				if *t.Key != deploy.ServiceTagKey {
-					continue
-				}
-				svcNames = append(svcNames, *t.Value)
+		return nil, fmt.Errorf("find service cloudformation stacks: %w", err)
+	}
+	var names []string
+	for _, cfnStack := range stacks.ResourceTagMappingList {
+		for _, t := range cfnStack.Tags {
+			if aws.StringValue(t.Key) == deploy.ServiceTagKey {
+				names = append(names, aws.StringValue(t.Value))
 			}
 		}
-		return fmt.Errorf("service %q still exist within the environment %s", strings.Join(svcNames, ", "), o.name)
+	}
+	return names, nil
+}
+
+// validateNoRunningServices returns an error if services or jobs are deployed to the environment,
+// unless the user opts in to delete them with --delete-workloads or by confirming the prompt.
+func (o *deleteEnvOpts) validateNoRunningServices(wklds []string) error {
+	if len(wklds) == 0 || o.deleteWorkloads {
+		return nil
+	}
+	if o.skipConfirmation {
+		return fmt.Errorf("%s %s still %s within the environment %s: use --%s to delete %s along with the environment",
+			english.PluralWord(len(wklds), "service", "services"), english.WordSeries(wklds, "and"), english.PluralWord(len(wklds), "exists", "exist"),
+			o.name, deleteWorkloadsFlag, english.PluralWord(len(wklds), "it", "them"))
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtDeleteEnvWorkloadsPrompt, o.name, english.WordSeries(wklds, "and")), "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm to delete the services and jobs of environment %s: %w", o.name, err)
+	}
+	if !confirmed {
+		return errEnvDeleteCancelled
+	}
+	o.deleteWorkloads = true
+	return nil
+}
+
+// deleteWorkloadStacks deletes the stacks of the services and jobs deployed to the environment.
+// The workload stacks only depend on the environment stack, so they are deleted in parallel.
+func (o *deleteEnvOpts) deleteWorkloadStacks(wklds []string) error {
+	if len(wklds) == 0 {
+		return nil
+	}
+	env, err := o.getEnvConfig()
+	if err != nil {
+		return err
+	}
+	o.prog.Start(fmt.Sprintf(fmtDeleteEnvWorkloadsStart, english.WordSeries(wklds, "and"), o.name))
+	var g errgroup.Group
+	g.SetLimit(maxConcurrentWorkloadDeletions)
+	var mu sync.Mutex
+	var errs []error
+	for _, wkld := range wklds {
+		wkld := wkld
+		g.Go(func() error {
+			// Keep deleting the other workloads if one of them fails, so that a rerun has less left to do.
+			if err := o.deleteWorkloadStack(wkld, env.ExecutionRoleARN); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				errs = append(errs, fmt.Errorf("delete %s: %w", wkld, err))
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	if len(errs) > 0 {
+		o.prog.Stop(log.Serrorf(fmtDeleteEnvWorkloadsFailed, o.name))
+		return errors.Join(errs...)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtDeleteEnvWorkloadsComplete, o.name))
+	return nil
+}
+
+func (o *deleteEnvOpts) deleteWorkloadStack(wkld, execRoleARN string) error {
+	if err := o.newWkldCleaner(o, wkld).Clean(); err != nil {
+		return fmt.Errorf("clean resources: %w", err)
+	}
+	stackName := stack.NameForWorkload(o.appName, o.name, wkld)
+	return o.deleteStackWithRetries(stackName, func() error {
+		return o.stackDeleter.DeleteAndWaitWithRoleARN(stackName, execRoleARN)
+	})
+}
+
+// deleteStackWithRetries calls deleteFn up to maxStackDeleteAttempts times, as long as the deletion fails for a transient reason:
+// either the request is throttled, or resources of the stack fail to delete because of network interfaces that are still attached.
+// Network interfaces are released some time after the resources that used them, so before retrying,
+// it deletes the detached network interfaces left in the security groups of the stack.
+func (o *deleteEnvOpts) deleteStackWithRetries(stackName string, deleteFn func() error) error {
+	var err error
+	for attempt := 1; attempt <= maxStackDeleteAttempts; attempt++ {
+		if err = deleteFn(); err == nil {
+			return nil
+		}
+		if attempt == maxStackDeleteAttempts {
+			break
+		}
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && request.IsErrorThrottle(aerr) {
+			o.sleep(o.retryDelay)
+			continue
+		}
+		resources, listErr := o.envStackResources.StackResources(stackName)
+		if listErr != nil || !failedOnNetworkInterfaces(resources) {
+			return err
+		}
+		// Best-effort: the manager role of environments deployed with older versions can't delete network interfaces.
+		_ = o.deleteDetachedNetworkInterfaces(stackName, resources)
+		o.sleep(o.retryDelay)
+	}
+	return err
+}
+
+// failedOnNetworkInterfaces returns true if a resource of the stack failed to delete because network interfaces are still attached to it.
+func failedOnNetworkInterfaces(resources []*awscfn.StackResource) bool {
+	for _, resource := range resources {
+		if aws.StringValue(resource.ResourceStatus) != sdkcloudformation.ResourceStatusDeleteFailed {
+			continue
+		}
+		reason := strings.ToLower(aws.StringValue(resource.ResourceStatusReason))
+		for _, part := range eniDependencyReasons {
+			if strings.Contains(reason, part) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (o *deleteEnvOpts) deleteDetachedNetworkInterfaces(stackName string, resources []*awscfn.StackResource) error {
+	var securityGroups []string
+	for _, resource := range resources {
+		if aws.StringValue(resource.ResourceType) == "AWS::EC2::SecurityGroup" && resource.PhysicalResourceId != nil {
+			securityGroups = append(securityGroups, aws.StringValue(resource.PhysicalResourceId))
+		}
+	}
+	if len(securityGroups) == 0 {
+		return nil
+	}
+	if _, err := o.eniDeleter.DeleteDetachedNetworkInterfaces(ec2.Filter{
+		Name:   "group-id",
+		Values: securityGroups,
+	}); err != nil {
+		return fmt.Errorf("delete network interfaces left in stack %s: %w", stackName, err)
 	}
 	return nil
 }
@@ -474,7 +697,9 @@ func (o *deleteEnvOpts) deleteStack() error {
 	if err != nil {
 		return err
 	}
-	if err := o.deployer.DeleteEnvironment(o.appName, o.name, env.ExecutionRoleARN); err != nil {
+	if err := o.deleteStackWithRetries(stack.NameForEnv(o.appName, o.name), func() error {
+		return o.deployer.DeleteEnvironment(o.appName, o.name, env.ExecutionRoleARN)
+	}); err != nil {
 		return fmt.Errorf("delete environment %s stack: %w", o.name, err)
 	}
 	return nil
@@ -562,14 +787,24 @@ func buildEnvDeleteCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Deletes an environment from your application.",
+		Long: `Deletes an environment from your application.
+If services or jobs are deployed to the environment, they are deleted along with it
+only if you confirm the prompt or pass --delete-workloads.
+If the deletion fails, run the command again to resume it.`,
 		Example: `
   Delete the "test" environment.
   /code $ copilot env delete --name test
 
   Delete the "test" environment without prompting.
-  /code $ copilot env delete --name test --yes`,
+  /code $ copilot env delete --name test --yes
+
+  Delete the "test" environment along with its services and jobs, without prompting.
+  /code $ copilot env delete --name test --delete-workloads --yes
+
+  List the resources that deleting the "test" environment would remove, without deleting them.
+  /code $ copilot env delete --name test --dry-run`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeleteEnvOpts(vars)
+			opts, err := newDeleteEnvOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("env delete")))
 			if err != nil {
 				return err
 			}
@@ -579,5 +814,8 @@ func buildEnvDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, deleteDryRunFlagDescription)
+	cmd.Flags().BoolVar(&vars.deleteWorkloads, deleteWorkloadsFlag, false, envDeleteWorkloadsFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(dryRunFlag, yesFlag)
 	return cmd
 }
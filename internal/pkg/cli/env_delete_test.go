@@ -6,13 +6,19 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/copilot-cli/internal/pkg/cli/clean/cleantest"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -169,6 +175,28 @@ func TestDeleteEnvOpts_Ask(t *testing.T) {
 	}
 }
 
+func runningWorkloads(names ...string) *resourcegroupstaggingapi.GetResourcesOutput {
+	out := &resourcegroupstaggingapi.GetResourcesOutput{}
+	for _, name := range names {
+		out.ResourceTagMappingList = append(out.ResourceTagMappingList, &resourcegroupstaggingapi.ResourceTagMapping{
+			Tags: []*resourcegroupstaggingapi.Tag{
+				{
+					Key:   aws.String(deploy.ServiceTagKey),
+					Value: aws.String(name),
+				},
+			},
+		})
+	}
+	return out
+}
+
+func deleteFailedStackResource(logicalID, physicalID, resourceType, reason string) *awscfn.StackResource {
+	resource := stackResource(logicalID, physicalID, resourceType)
+	resource.ResourceStatus = aws.String("DELETE_FAILED")
+	resource.ResourceStatusReason = aws.String(reason)
+	return resource
+}
+
 func TestDeleteEnvOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		given func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts
@@ -181,39 +209,138 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 		mockPipelineLister func(ctrl *gomock.Controller) *mocks.MockdeployedPipelineLister
 		mockPipelineGetter func(ctrl *gomock.Controller) *mocks.MockpipelineGetter
 
-		wantedError error
+		wantedOutput string
+		wantedError  error
 	}{
+		"lists the resources to delete with --dry-run without deleting them": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					App:              "phonetool",
+					Name:             "test",
+					ExecutionRoleARN: "arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole",
+					ManagerRoleARN:   "arn:aws:iam::1111:role/phonetool-test-EnvManagerRole",
+				}, nil)
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+						{
+							Tags: []*resourcegroupstaggingapi.Tag{
+								{
+									Key:   aws.String(deploy.ServiceTagKey),
+									Value: aws.String("api"),
+								},
+							},
+						},
+					},
+				}, nil)
+				cfn := mocks.NewMockstackResourcesLister(ctrl)
+				cfn.EXPECT().TemplateBody("phonetool-test-api").Return(`
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+`, nil)
+				cfn.EXPECT().StackResources("phonetool-test-api").Return([]*awscfn.StackResource{
+					stackResource("LogGroup", "/copilot/phonetool-test-api", "AWS::Logs::LogGroup"),
+				}, nil)
+				cfn.EXPECT().TemplateBody("phonetool-test").Return(`
+Resources:
+  CloudformationExecutionRole:
+    Type: AWS::IAM::Role
+    DeletionPolicy: Retain
+  ELBAccessLogsBucket:
+    Type: AWS::S3::Bucket
+`, nil)
+				cfn.EXPECT().StackResources("phonetool-test").Return([]*awscfn.StackResource{
+					stackResource("CloudformationExecutionRole", "phonetool-test-CFNExecutionRole", "AWS::IAM::Role"),
+					stackResource("ELBAccessLogsBucket", "phonetool-test-elbaccesslogsbucket", "AWS::S3::Bucket"),
+				}, nil)
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+						dryRun:  true,
+					},
+					store:              store,
+					rg:                 rg,
+					envStackResources:  cfn,
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+			wantedOutput: `Deleting environment test from application phonetool would remove:
+
+  Type                   Name                                Data loss
+  ----                   ----                                ---------
+  CloudFormation stack   phonetool-test-api                  -
+  Log group              /copilot/phonetool-test-api         All the log events
+  CloudFormation stack   phonetool-test                      -
+  S3 bucket              phonetool-test-elbaccesslogsbucket  All the objects in the bucket
+  IAM role               phonetool-test-CFNExecutionRole     -
+  IAM role               phonetool-test-EnvManagerRole       -
+  Copilot configuration  environment test                    -
+
+`,
+		},
 		"returns wrapped errors when failed to retrieve running services in the environment": {
 			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
 				m := mocks.NewMockresourceGetter(ctrl)
 				m.EXPECT().GetResources(gomock.Any()).Return(nil, errors.New("some error"))
 
 				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
 					rg:                 m,
 					initRuntimeClients: noopInitRuntimeClients,
 				}
 			},
 			wantedError: errors.New("find service cloudformation stacks: some error"),
 		},
-		"returns error when there are running services": {
+		"returns error when there are running services and --delete-workloads isn't passed with --yes": {
 			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
 				m := mocks.NewMockresourceGetter(ctrl)
-				m.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
-					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
-						{
-							Tags: []*resourcegroupstaggingapi.Tag{
-								{
-									Key:   aws.String(deploy.ServiceTagKey),
-									Value: aws.String("frontend"),
-								},
-								{
-									Key:   aws.String(deploy.ServiceTagKey),
-									Value: aws.String("backend"),
-								},
-							},
-						},
+				m.EXPECT().GetResources(gomock.Any()).Return(runningWorkloads("frontend", "backend"), nil)
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName:          "phonetool",
+						name:             "test",
+						skipConfirmation: true,
 					},
-				}, nil)
+					rg:                 m,
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+
+			wantedError: errors.New(`services frontend and backend still exist within the environment test: use --delete-workloads to delete them along with the environment`),
+		},
+		"returns error when there is a running service and --delete-workloads isn't passed with --yes": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				m := mocks.NewMockresourceGetter(ctrl)
+				m.EXPECT().GetResources(gomock.Any()).Return(runningWorkloads("frontend"), nil)
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName:          "phonetool",
+						name:             "test",
+						skipConfirmation: true,
+					},
+					rg:                 m,
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+
+			wantedError: errors.New(`service frontend still exists within the environment test: use --delete-workloads to delete it along with the environment`),
+		},
+		"returns error when the user declines to delete the running services": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				m := mocks.NewMockresourceGetter(ctrl)
+				m.EXPECT().GetResources(gomock.Any()).Return(runningWorkloads("frontend", "backend"), nil)
+
+				p := mocks.NewMockprompter(ctrl)
+				p.EXPECT().Confirm(`Environment "test" has frontend and backend deployed to it. Delete them along with the environment?`, "", gomock.Any()).Return(false, nil)
 
 				return &deleteEnvOpts{
 					deleteEnvVars: deleteEnvVars{
@@ -221,11 +348,116 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 						name:    "test",
 					},
 					rg:                 m,
+					prompt:             p,
 					initRuntimeClients: noopInitRuntimeClients,
 				}
 			},
 
-			wantedError: errors.New(`service "frontend, backend" still exist within the environment test`),
+			wantedError: errEnvDeleteCancelled,
+		},
+		"does not retry deleting a service stack that fails for another reason than network interfaces": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				m := mocks.NewMockresourceGetter(ctrl)
+				m.EXPECT().GetResources(gomock.Any()).Return(runningWorkloads("backend"), nil)
+
+				p := mocks.NewMockprompter(ctrl)
+				p.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(true, nil)
+
+				lister := mocks.NewMockdeployedPipelineLister(ctrl)
+				lister.EXPECT().ListDeployedPipelines("phonetool").Return([]deploy.Pipeline{}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(`Deleting backend from environment "test"`)
+				prog.EXPECT().Stop(log.Serror("Failed to delete the services and jobs of environment \"test\".\n"))
+
+				deleter := mocks.NewMockstackDeleter(ctrl)
+				deleter.EXPECT().DeleteAndWaitWithRoleARN("phonetool-test-backend", "execARN").Return(errors.New("some error"))
+
+				cfn := mocks.NewMockstackResourcesLister(ctrl)
+				cfn.EXPECT().StackResources("phonetool-test-backend").Return([]*awscfn.StackResource{
+					deleteFailedStackResource("Bucket", "bucket", "AWS::S3::Bucket", "The bucket you tried to delete is not empty"),
+				}, nil)
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					rg:                     m,
+					prompt:                 p,
+					deployedPipelineLister: lister,
+					prog:                   prog,
+					envStackResources:      cfn,
+					stackDeleter:           deleter,
+					newWkldCleaner: func(o *deleteEnvOpts, wkld string) cleaner {
+						return &cleantest.Succeeds{}
+					},
+					sleep: func(time.Duration) {
+						t.Fatal("should not wait before giving up")
+					},
+					envConfig: &config.Environment{
+						ExecutionRoleARN: "execARN",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+
+			wantedError: errors.New(`delete backend: some error`),
+		},
+		"deletes the services in parallel and retries the ones that fail after deleting their network interfaces": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				m := mocks.NewMockresourceGetter(ctrl)
+				m.EXPECT().GetResources(gomock.Any()).Return(runningWorkloads("frontend", "backend"), nil)
+
+				lister := mocks.NewMockdeployedPipelineLister(ctrl)
+				lister.EXPECT().ListDeployedPipelines("phonetool").Return([]deploy.Pipeline{}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(`Deleting frontend and backend from environment "test"`)
+				prog.EXPECT().Stop(log.Serror("Failed to delete the services and jobs of environment \"test\".\n"))
+
+				deleter := mocks.NewMockstackDeleter(ctrl)
+				deleter.EXPECT().DeleteAndWaitWithRoleARN("phonetool-test-frontend", "execARN").Return(nil)
+				deleter.EXPECT().DeleteAndWaitWithRoleARN("phonetool-test-backend", "execARN").Return(errors.New("some error")).Times(3)
+
+				cfn := mocks.NewMockstackResourcesLister(ctrl)
+				cfn.EXPECT().StackResources("phonetool-test-backend").Return([]*awscfn.StackResource{
+					stackResource("LogGroup", "/copilot/phonetool-test-backend", "AWS::Logs::LogGroup"),
+					deleteFailedStackResource("ServiceSecurityGroup", "sg-1", "AWS::EC2::SecurityGroup",
+						"resource sg-1 has a dependent object (Service: AmazonEC2; Status Code: 400; Error Code: DependencyViolation)"),
+				}, nil).Times(2)
+
+				eni := mocks.NewMocknetworkInterfaceDeleter(ctrl)
+				eni.EXPECT().DeleteDetachedNetworkInterfaces(ec2.Filter{
+					Name:   "group-id",
+					Values: []string{"sg-1"},
+				}).Return([]string{"eni-1"}, nil).Times(2)
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName:          "phonetool",
+						name:             "test",
+						skipConfirmation: true,
+						deleteWorkloads:  true,
+					},
+					rg:                     m,
+					deployedPipelineLister: lister,
+					prog:                   prog,
+					envStackResources:      cfn,
+					stackDeleter:           deleter,
+					eniDeleter:             eni,
+					newWkldCleaner: func(o *deleteEnvOpts, wkld string) cleaner {
+						return &cleantest.Succeeds{}
+					},
+					sleep: func(time.Duration) {},
+					envConfig: &config.Environment{
+						ExecutionRoleARN: "execARN",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+
+			wantedError: errors.New(`delete backend: some error`),
 		},
 		"returns error when more pipelines are using the env": {
 			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
@@ -358,6 +590,9 @@ Resources:
 
 				deployer.EXPECT().DeleteEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
 
+				cfn := mocks.NewMockstackResourcesLister(ctrl)
+				cfn.EXPECT().StackResources("phonetool-test").Return(nil, nil)
+
 				prog.EXPECT().Stop(gomock.Any()).Times(1)
 
 				return &deleteEnvOpts{
@@ -369,6 +604,7 @@ Resources:
 					envStackDescriber:      descr,
 					deployedPipelineLister: lister,
 					deployer:               deployer,
+					envStackResources:      cfn,
 					prog:                   prog,
 					envConfig:              &config.Environment{},
 					initRuntimeClients:     noopInitRuntimeClients,
@@ -775,6 +1011,8 @@ Resources:
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			opts := tc.given(t, ctrl)
+			b := new(strings.Builder)
+			opts.w = b
 
 			// WHEN
 			err := opts.Execute()
@@ -785,6 +1023,107 @@ Resources:
 			} else {
 				require.NoError(t, err)
 			}
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
+
+func TestDeleteEnvOpts_deleteStackWithRetries(t *testing.T) {
+	throttled := awserr.New("Throttling", "Rate exceeded", nil)
+	testCases := map[string]struct {
+		inErrs    []error
+		setupMock func(cfn *mocks.MockstackResourcesLister, eni *mocks.MocknetworkInterfaceDeleter)
+
+		wantedAttempts int
+		wantedSleeps   int
+		wantedError    error
+	}{
+		"succeeds on the first attempt": {
+			inErrs:         []error{nil},
+			setupMock:      func(_ *mocks.MockstackResourcesLister, _ *mocks.MocknetworkInterfaceDeleter) {},
+			wantedAttempts: 1,
+		},
+		"retries throttled deletions": {
+			inErrs:         []error{throttled, nil},
+			setupMock:      func(_ *mocks.MockstackResourcesLister, _ *mocks.MocknetworkInterfaceDeleter) {},
+			wantedAttempts: 2,
+			wantedSleeps:   1,
+		},
+		"retries deletions that fail because of network interfaces after deleting the detached ones": {
+			inErrs: []error{errors.New("some error"), nil},
+			setupMock: func(cfn *mocks.MockstackResourcesLister, eni *mocks.MocknetworkInterfaceDeleter) {
+				cfn.EXPECT().StackResources("phonetool-test-api").Return([]*awscfn.StackResource{
+					deleteFailedStackResource("ServiceSecurityGroup", "sg-1", "AWS::EC2::SecurityGroup",
+						"resource sg-1 has a dependent object"),
+				}, nil)
+				eni.EXPECT().DeleteDetachedNetworkInterfaces(ec2.Filter{
+					Name:   "group-id",
+					Values: []string{"sg-1"},
+				}).Return(nil, errors.New("access denied"))
+			},
+			wantedAttempts: 2,
+			wantedSleeps:   1,
+		},
+		"gives up after the maximum number of attempts": {
+			inErrs:         []error{throttled, throttled, throttled},
+			setupMock:      func(_ *mocks.MockstackResourcesLister, _ *mocks.MocknetworkInterfaceDeleter) {},
+			wantedAttempts: 3,
+			wantedSleeps:   2,
+			wantedError:    throttled,
+		},
+		"does not retry other failures": {
+			inErrs: []error{errors.New("some error")},
+			setupMock: func(cfn *mocks.MockstackResourcesLister, _ *mocks.MocknetworkInterfaceDeleter) {
+				cfn.EXPECT().StackResources("phonetool-test-api").Return([]*awscfn.StackResource{
+					deleteFailedStackResource("Bucket", "bucket", "AWS::S3::Bucket", "The bucket you tried to delete is not empty"),
+				}, nil)
+			},
+			wantedAttempts: 1,
+			wantedError:    errors.New("some error"),
+		},
+		"does not retry if the resources of the stack can't be listed": {
+			inErrs: []error{errors.New("some error")},
+			setupMock: func(cfn *mocks.MockstackResourcesLister, _ *mocks.MocknetworkInterfaceDeleter) {
+				cfn.EXPECT().StackResources("phonetool-test-api").Return(nil, errors.New("list error"))
+			},
+			wantedAttempts: 1,
+			wantedError:    errors.New("some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cfn := mocks.NewMockstackResourcesLister(ctrl)
+			eni := mocks.NewMocknetworkInterfaceDeleter(ctrl)
+			tc.setupMock(cfn, eni)
+			var sleeps int
+			opts := &deleteEnvOpts{
+				envStackResources: cfn,
+				eniDeleter:        eni,
+				retryDelay:        time.Minute,
+				sleep: func(d time.Duration) {
+					require.Equal(t, time.Minute, d)
+					sleeps++
+				},
+			}
+			var attempts int
+
+			// WHEN
+			err := opts.deleteStackWithRetries("phonetool-test-api", func() error {
+				attempts++
+				return tc.inErrs[attempts-1]
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedAttempts, attempts)
+			require.Equal(t, tc.wantedSleeps, sleeps)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
 		})
 	}
 }
@@ -42,6 +42,7 @@ type deployEnvVars struct {
 	skipDiffPrompt    bool
 	allowEnvDowngrade bool
 	detach            bool
+	noCache           bool
 }
 
 type deployEnvOpts struct {
@@ -71,8 +72,7 @@ type deployEnvOpts struct {
 	templateVersion string
 }
 
-func newEnvDeployOpts(vars deployEnvVars) (*deployEnvOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env deploy"))
+func newEnvDeployOpts(vars deployEnvVars, sessProvider *sessions.Provider) (*deployEnvOpts, error) {
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
@@ -92,9 +92,10 @@ func newEnvDeployOpts(vars deployEnvVars) (*deployEnvOpts, error) {
 		sel:             selector.NewLocalEnvironmentSelector(prompter, store, ws),
 		newEnvVersionGetter: func(appName, envName string) (versionGetter, error) {
 			return describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
-				App:         appName,
-				Env:         envName,
-				ConfigStore: store,
+				App:             appName,
+				Env:             envName,
+				ConfigStore:     store,
+				SessionProvider: sessProvider,
 			})
 		},
 		prompt: prompter,
@@ -120,7 +121,7 @@ func newEnvDeployer(opts *deployEnvOpts, ws deploy.WorkspaceAddonsReaderPathGett
 	if err != nil {
 		return nil, err
 	}
-	ovrdr, err := deploy.NewOverrider(opts.ws.EnvOverridesPath(), env.App, env.Name, opts.fs, opts.sessionProvider)
+	ovrdr, err := deploy.NewOverrider(opts.ws.EnvOverridesPath(), env.App, env.Name, opts.fs, opts.sessionProvider, overriderOptions(opts.noCache)...)
 	if err != nil {
 		return nil, err
 	}
@@ -387,7 +388,7 @@ func buildEnvDeployCmd() *cobra.Command {
 Deploy an environment named "test".
 /code $copilot env deploy --name test`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newEnvDeployOpts(vars)
+			opts, err := newEnvDeployOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("env deploy")))
 			if err != nil {
 				return err
 			}
@@ -402,5 +403,6 @@ Deploy an environment named "test".
 	cmd.Flags().BoolVar(&vars.skipDiffPrompt, diffAutoApproveFlag, false, diffAutoApproveFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowEnvDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 	cmd.Flags().BoolVar(&vars.detach, detachFlag, false, detachFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
 	return cmd
 }
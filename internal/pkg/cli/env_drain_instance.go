@@ -0,0 +1,331 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/autoscaling"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDrainInstanceAppNamePrompt = "Which application is the environment in?"
+	envDrainInstanceEnvNamePrompt = "Which environment of %s has the instances you would like to drain?"
+	envDrainInstanceEnvHelpPrompt = "Tasks on the drained instances are rescheduled onto other instances in the environment's cluster."
+
+	fmtEnvDrainInstanceConfirmPrompt     = "Are you sure you want to drain and terminate instance %s in environment %s?"
+	fmtEnvDrainAllInstancesConfirmPrompt = "Are you sure you want to drain and terminate all %d instances in environment %s?"
+
+	fmtEnvDrainInstanceStart       = "Draining instance %s and waiting for its tasks to be replaced."
+	fmtEnvDrainInstanceFailed      = "Failed to drain instance %s.\n"
+	fmtEnvDrainInstanceSucceed     = "Drained instance %s.\n"
+	fmtEnvTerminateInstanceStart   = "Terminating instance %s."
+	fmtEnvTerminateInstanceFailed  = "Failed to terminate instance %s.\n"
+	fmtEnvTerminateInstanceSucceed = "Terminated instance %s.\n"
+	fmtEnvWaitInServiceStart       = "Waiting for Auto Scaling group %s to replace the terminated instances."
+	fmtEnvWaitInServiceFailed      = "Auto Scaling group %s didn't replace the terminated instances.\n"
+	fmtEnvWaitInServiceSucceed     = "Auto Scaling group %s is in service.\n"
+)
+
+type envDrainInstanceVars struct {
+	appName          string
+	envName          string
+	instance         string
+	all              bool
+	batchSize        int
+	skipConfirmation bool
+}
+
+type envDrainInstanceOpts struct {
+	envDrainInstanceVars
+
+	store      store
+	sel        configSelector
+	prompt     prompter
+	prog       progress
+	cluster    envClusterGetter
+	drainer    containerInstanceDrainer
+	terminator instanceTerminator
+	asg        autoScalingGroupWaiter
+
+	initClients func() error
+
+	// cached variables.
+	clusterARN string
+	targets    []*awsecs.ContainerInstance
+}
+
+func newEnvDrainInstanceOpts(vars envDrainInstanceVars) (*envDrainInstanceOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env drain-instance"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	opts := &envDrainInstanceOpts{
+		envDrainInstanceVars: vars,
+		store:                store,
+		sel:                  selector.NewConfigSelector(prompter, store),
+		prompt:               prompter,
+		prog:                 termprogress.NewSpinner(log.DiagnosticWriter),
+	}
+	opts.initClients = func() error {
+		env, err := opts.store.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", opts.envName, err)
+		}
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		opts.cluster = ecs.New(sess)
+		opts.drainer = awsecs.New(sess)
+		opts.terminator = ec2.New(sess)
+		opts.asg = autoscaling.New(sess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *envDrainInstanceOpts) Validate() error {
+	if o.instance == "" && !o.all {
+		return fmt.Errorf("must specify an instance ID or the --%s flag", allFlag)
+	}
+	if o.instance != "" && o.all {
+		return fmt.Errorf("cannot specify both an instance ID and the --%s flag", allFlag)
+	}
+	if o.batchSize < 1 {
+		return fmt.Errorf("--%s must be at least 1", batchSizeFlag)
+	}
+	if o.batchSize > 1 && !o.all {
+		return fmt.Errorf("--%s can only be specified with the --%s flag", batchSizeFlag, allFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *envDrainInstanceOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnv()
+}
+
+func (o *envDrainInstanceOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(envDrainInstanceAppNamePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *envDrainInstanceOpts) validateOrAskEnv() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("validate environment name %q in application %q: %v", o.envName, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envDrainInstanceEnvNamePrompt, color.HighlightUserInput(o.appName)), envDrainInstanceEnvHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.envName = env
+	return nil
+}
+
+// Execute drains the target container instances in batches, waits for ECS to reschedule their tasks,
+// terminates the underlying EC2 instances, and waits for their Auto Scaling groups to replace them
+// before it moves on to the next batch.
+func (o *envDrainInstanceOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	if err := o.findTargets(); err != nil {
+		return err
+	}
+	if err := o.confirm(); err != nil {
+		return err
+	}
+	for start := 0; start < len(o.targets); start += o.batchSize {
+		end := start + o.batchSize
+		if end > len(o.targets) {
+			end = len(o.targets)
+		}
+		if err := o.drainAndTerminate(o.targets[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *envDrainInstanceOpts) findTargets() error {
+	clusterARN, err := o.cluster.ClusterARN(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get cluster for environment %s: %w", o.envName, err)
+	}
+	o.clusterARN = clusterARN
+	instances, err := o.drainer.ContainerInstances(clusterARN)
+	if err != nil {
+		return err
+	}
+	if len(instances) == 0 {
+		return fmt.Errorf("environment %s has no EC2 container instances registered to its cluster", o.envName)
+	}
+	for _, instance := range instances {
+		if o.all {
+			if aws.StringValue(instance.Status) == awsecs.ContainerInstanceStatusActive {
+				o.targets = append(o.targets, instance)
+			}
+			continue
+		}
+		if aws.StringValue(instance.Ec2InstanceId) == o.instance || aws.StringValue(instance.ContainerInstanceArn) == o.instance {
+			o.targets = append(o.targets, instance)
+			return nil
+		}
+	}
+	if o.all {
+		if len(o.targets) == 0 {
+			return fmt.Errorf("environment %s has no active EC2 container instances to drain", o.envName)
+		}
+		return nil
+	}
+	return fmt.Errorf("instance %s is not registered to the cluster of environment %s", o.instance, o.envName)
+}
+
+func (o *envDrainInstanceOpts) confirm() error {
+	if o.skipConfirmation {
+		return nil
+	}
+	msg := fmt.Sprintf(fmtEnvDrainInstanceConfirmPrompt, color.HighlightUserInput(o.instance), color.HighlightUserInput(o.envName))
+	if o.all {
+		msg = fmt.Sprintf(fmtEnvDrainAllInstancesConfirmPrompt, len(o.targets), color.HighlightUserInput(o.envName))
+	}
+	confirmed, err := o.prompt.Confirm(msg, "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("env drain-instance confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errors.New("env drain-instance cancelled - no changes made")
+	}
+	return nil
+}
+
+func (o *envDrainInstanceOpts) drainAndTerminate(batch []*awsecs.ContainerInstance) error {
+	var ids, arns []string
+	for _, instance := range batch {
+		ids = append(ids, aws.StringValue(instance.Ec2InstanceId))
+		arns = append(arns, aws.StringValue(instance.ContainerInstanceArn))
+	}
+	id := strings.Join(ids, ", ")
+
+	o.prog.Start(fmt.Sprintf(fmtEnvDrainInstanceStart, id))
+	if err := o.drainer.DrainContainerInstances(o.clusterARN, arns); err != nil {
+		o.prog.Stop(log.Serrorf(fmtEnvDrainInstanceFailed, id))
+		return err
+	}
+	if err := o.drainer.WaitUntilContainerInstancesDrained(o.clusterARN, arns); err != nil {
+		o.prog.Stop(log.Serrorf(fmtEnvDrainInstanceFailed, id))
+		return fmt.Errorf("wait for instance %s to drain: %w", id, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtEnvDrainInstanceSucceed, id))
+	groups, err := o.asg.GroupNames(ids)
+	if err != nil {
+		return fmt.Errorf("get auto scaling groups of instance %s: %w", id, err)
+	}
+	o.prog.Start(fmt.Sprintf(fmtEnvTerminateInstanceStart, id))
+	if err := o.terminator.TerminateInstances(ids...); err != nil {
+		o.prog.Stop(log.Serrorf(fmtEnvTerminateInstanceFailed, id))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtEnvTerminateInstanceSucceed, id))
+	return o.waitUntilReplaced(groups, ids)
+}
+
+// waitUntilReplaced waits for the Auto Scaling groups of the terminated instances, if any, to be in service again,
+// so that the cluster has its capacity back before the next batch of instances is drained.
+func (o *envDrainInstanceOpts) waitUntilReplaced(groups map[string]string, terminated []string) error {
+	var names []string
+	seen := make(map[string]bool)
+	for _, group := range groups {
+		if !seen[group] {
+			seen[group] = true
+			names = append(names, group)
+		}
+	}
+	sort.Strings(names)
+	for _, group := range names {
+		o.prog.Start(fmt.Sprintf(fmtEnvWaitInServiceStart, group))
+		if err := o.asg.WaitUntilInService(group, terminated); err != nil {
+			o.prog.Stop(log.Serrorf(fmtEnvWaitInServiceFailed, group))
+			return fmt.Errorf("wait for auto scaling group %s to be in service: %w", group, err)
+		}
+		o.prog.Stop(log.Ssuccessf(fmtEnvWaitInServiceSucceed, group))
+	}
+	return nil
+}
+
+// buildEnvDrainInstanceCmd builds the command for draining and terminating EC2 instances in an environment.
+func buildEnvDrainInstanceCmd() *cobra.Command {
+	vars := envDrainInstanceVars{}
+	cmd := &cobra.Command{
+		Use:   "drain-instance [instance-id]",
+		Short: "Drains and terminates EC2 instances in an environment.",
+		Long: `Drains and terminates EC2 instances in an environment.
+Each instance is first set to DRAINING so that ECS replaces its service tasks on other instances,
+then it is terminated so that its Auto Scaling group can launch a replacement.
+With --all, the instances are drained in batches, and each batch waits for the Auto Scaling groups
+to be in service again.`,
+		Example: `
+  Drain and terminate the instance "i-0123456789abcdef0" in the "test" environment.
+  /code $ copilot env drain-instance i-0123456789abcdef0 -n test
+  Roll every instance in the "prod" environment, for example after updating its AMI.
+  /code $ copilot env drain-instance --all -n prod
+  Roll the instances in the "prod" environment two at a time.
+  /code $ copilot env drain-instance --all --batch-size 2 -n prod`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				vars.instance = args[0]
+			}
+			opts, err := newEnvDrainInstanceOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.all, allFlag, false, drainAllInstancesFlagDescription)
+	cmd.Flags().IntVar(&vars.batchSize, batchSizeFlag, 1, drainBatchSizeFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,375 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDrainInstanceOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inInstance  string
+		inAll       bool
+		inBatchSize int
+
+		wantedErr error
+	}{
+		"errors if neither an instance nor --all is specified": {
+			inBatchSize: 1,
+			wantedErr:   errors.New("must specify an instance ID or the --all flag"),
+		},
+		"errors if both an instance and --all are specified": {
+			inInstance:  "i-1",
+			inAll:       true,
+			inBatchSize: 1,
+			wantedErr:   errors.New("cannot specify both an instance ID and the --all flag"),
+		},
+		"errors if the batch size is less than 1": {
+			inAll:       true,
+			inBatchSize: 0,
+			wantedErr:   errors.New("--batch-size must be at least 1"),
+		},
+		"errors if the batch size is specified without --all": {
+			inInstance:  "i-1",
+			inBatchSize: 2,
+			wantedErr:   errors.New("--batch-size can only be specified with the --all flag"),
+		},
+		"valid with an instance": {
+			inInstance:  "i-1",
+			inBatchSize: 1,
+		},
+		"valid with --all in batches": {
+			inAll:       true,
+			inBatchSize: 3,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &envDrainInstanceOpts{
+				envDrainInstanceVars: envDrainInstanceVars{
+					instance:  tc.inInstance,
+					all:       tc.inAll,
+					batchSize: tc.inBatchSize,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEnvDrainInstanceOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockconfigSelector)
+
+		wantedApp string
+		wantedEnv string
+		wantedErr error
+	}{
+		"validates flags": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"errors if the environment does not exist": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New(`validate environment name "test" in application "phonetool": some error`),
+		},
+		"prompts for app and env": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				sel.EXPECT().Application(envDrainInstanceAppNamePrompt, "").Return("phonetool", nil)
+				sel.EXPECT().Environment(gomock.Any(), envDrainInstanceEnvHelpPrompt, "phonetool").Return("test", nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"errors if failed to select environment": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				sel.EXPECT().Environment(gomock.Any(), gomock.Any(), "phonetool").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select environment for application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockStore, mockSel)
+			opts := &envDrainInstanceOpts{
+				envDrainInstanceVars: envDrainInstanceVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+				},
+				store: mockStore,
+				sel:   mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+type envDrainInstanceMocks struct {
+	cluster    *mocks.MockenvClusterGetter
+	drainer    *mocks.MockcontainerInstanceDrainer
+	terminator *mocks.MockinstanceTerminator
+	asg        *mocks.MockautoScalingGroupWaiter
+	prompt     *mocks.Mockprompter
+	prog       *mocks.Mockprogress
+}
+
+func TestEnvDrainInstanceOpts_Execute(t *testing.T) {
+	const mockCluster = "mockClusterARN"
+	instances := []*awsecs.ContainerInstance{
+		{
+			ContainerInstanceArn: aws.String("arn1"),
+			Ec2InstanceId:        aws.String("i-1"),
+			Status:               aws.String("ACTIVE"),
+		},
+		{
+			ContainerInstanceArn: aws.String("arn2"),
+			Ec2InstanceId:        aws.String("i-2"),
+			Status:               aws.String("DRAINING"),
+		},
+		{
+			ContainerInstanceArn: aws.String("arn3"),
+			Ec2InstanceId:        aws.String("i-3"),
+			Status:               aws.String("ACTIVE"),
+		},
+		{
+			ContainerInstanceArn: aws.String("arn4"),
+			Ec2InstanceId:        aws.String("i-4"),
+			Status:               aws.String("ACTIVE"),
+		},
+	}
+	testCases := map[string]struct {
+		inInstance  string
+		inAll       bool
+		inBatchSize int
+		inYes       bool
+
+		setupMocks func(m envDrainInstanceMocks)
+
+		wantedErr error
+	}{
+		"errors if the cluster has no container instances": {
+			inInstance: "i-1",
+			setupMocks: func(m envDrainInstanceMocks) {
+				m.cluster.EXPECT().ClusterARN("phonetool", "test").Return(mockCluster, nil)
+				m.drainer.EXPECT().ContainerInstances(mockCluster).Return(nil, nil)
+			},
+			wantedErr: errors.New("environment test has no EC2 container instances registered to its cluster"),
+		},
+		"errors if the instance is not in the cluster": {
+			inInstance: "i-5",
+			setupMocks: func(m envDrainInstanceMocks) {
+				m.cluster.EXPECT().ClusterARN("phonetool", "test").Return(mockCluster, nil)
+				m.drainer.EXPECT().ContainerInstances(mockCluster).Return(instances, nil)
+			},
+			wantedErr: errors.New("instance i-5 is not registered to the cluster of environment test"),
+		},
+		"does nothing if the user cancels": {
+			inInstance: "i-1",
+			setupMocks: func(m envDrainInstanceMocks) {
+				m.cluster.EXPECT().ClusterARN("phonetool", "test").Return(mockCluster, nil)
+				m.drainer.EXPECT().ContainerInstances(mockCluster).Return(instances, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(false, nil)
+			},
+			wantedErr: errors.New("env drain-instance cancelled - no changes made"),
+		},
+		"errors if the instance fails to drain": {
+			inInstance: "arn1",
+			inYes:      true,
+			setupMocks: func(m envDrainInstanceMocks) {
+				m.cluster.EXPECT().ClusterARN("phonetool", "test").Return(mockCluster, nil)
+				m.drainer.EXPECT().ContainerInstances(mockCluster).Return(instances, nil)
+				m.prog.EXPECT().Start("Draining instance i-1 and waiting for its tasks to be replaced.")
+				m.drainer.EXPECT().DrainContainerInstances(mockCluster, []string{"arn1"}).Return(nil)
+				m.drainer.EXPECT().WaitUntilContainerInstancesDrained(mockCluster, []string{"arn1"}).Return(errors.New("some error"))
+				m.prog.EXPECT().Stop(log.Serrorf("Failed to drain instance i-1.\n"))
+			},
+			wantedErr: errors.New("wait for instance i-1 to drain: some error"),
+		},
+		"drains and terminates a single instance": {
+			inInstance: "i-1",
+			setupMocks: func(m envDrainInstanceMocks) {
+				m.cluster.EXPECT().ClusterARN("phonetool", "test").Return(mockCluster, nil)
+				m.drainer.EXPECT().ContainerInstances(mockCluster).Return(instances, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(true, nil)
+				gomock.InOrder(
+					m.prog.EXPECT().Start("Draining instance i-1 and waiting for its tasks to be replaced."),
+					m.drainer.EXPECT().DrainContainerInstances(mockCluster, []string{"arn1"}).Return(nil),
+					m.drainer.EXPECT().WaitUntilContainerInstancesDrained(mockCluster, []string{"arn1"}).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf("Drained instance i-1.\n")),
+					m.asg.EXPECT().GroupNames([]string{"i-1"}).Return(map[string]string{"i-1": "capacity"}, nil),
+					m.prog.EXPECT().Start("Terminating instance i-1."),
+					m.terminator.EXPECT().TerminateInstances("i-1").Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf("Terminated instance i-1.\n")),
+					m.prog.EXPECT().Start("Waiting for Auto Scaling group capacity to replace the terminated instances."),
+					m.asg.EXPECT().WaitUntilInService("capacity", []string{"i-1"}).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf("Auto Scaling group capacity is in service.\n")),
+				)
+			},
+		},
+		"terminates an instance that isn't in an auto scaling group without waiting": {
+			inInstance: "i-1",
+			inYes:      true,
+			setupMocks: func(m envDrainInstanceMocks) {
+				m.cluster.EXPECT().ClusterARN("phonetool", "test").Return(mockCluster, nil)
+				m.drainer.EXPECT().ContainerInstances(mockCluster).Return(instances, nil)
+				m.prog.EXPECT().Start(gomock.Any()).Times(2)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(2)
+				m.drainer.EXPECT().DrainContainerInstances(mockCluster, []string{"arn1"}).Return(nil)
+				m.drainer.EXPECT().WaitUntilContainerInstancesDrained(mockCluster, []string{"arn1"}).Return(nil)
+				m.asg.EXPECT().GroupNames([]string{"i-1"}).Return(map[string]string{}, nil)
+				m.terminator.EXPECT().TerminateInstances("i-1").Return(nil)
+				m.asg.EXPECT().WaitUntilInService(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"rolls through every active instance in batches": {
+			inAll:       true,
+			inBatchSize: 2,
+			inYes:       true,
+			setupMocks: func(m envDrainInstanceMocks) {
+				m.cluster.EXPECT().ClusterARN("phonetool", "test").Return(mockCluster, nil)
+				m.drainer.EXPECT().ContainerInstances(mockCluster).Return(instances, nil)
+				m.prog.EXPECT().Start(gomock.Any()).Times(6)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(6)
+				gomock.InOrder(
+					m.drainer.EXPECT().DrainContainerInstances(mockCluster, []string{"arn1", "arn3"}).Return(nil),
+					m.drainer.EXPECT().WaitUntilContainerInstancesDrained(mockCluster, []string{"arn1", "arn3"}).Return(nil),
+					m.asg.EXPECT().GroupNames([]string{"i-1", "i-3"}).Return(map[string]string{"i-1": "capacity", "i-3": "capacity"}, nil),
+					m.terminator.EXPECT().TerminateInstances("i-1", "i-3").Return(nil),
+					m.asg.EXPECT().WaitUntilInService("capacity", []string{"i-1", "i-3"}).Return(nil),
+					m.drainer.EXPECT().DrainContainerInstances(mockCluster, []string{"arn4"}).Return(nil),
+					m.drainer.EXPECT().WaitUntilContainerInstancesDrained(mockCluster, []string{"arn4"}).Return(nil),
+					m.asg.EXPECT().GroupNames([]string{"i-4"}).Return(map[string]string{"i-4": "capacity"}, nil),
+					m.terminator.EXPECT().TerminateInstances("i-4").Return(nil),
+					m.asg.EXPECT().WaitUntilInService("capacity", []string{"i-4"}).Return(nil),
+				)
+			},
+		},
+		"stops rolling if an instance fails to terminate": {
+			inAll: true,
+			inYes: true,
+			setupMocks: func(m envDrainInstanceMocks) {
+				m.cluster.EXPECT().ClusterARN("phonetool", "test").Return(mockCluster, nil)
+				m.drainer.EXPECT().ContainerInstances(mockCluster).Return(instances, nil)
+				m.prog.EXPECT().Start(gomock.Any()).Times(2)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(2)
+				m.drainer.EXPECT().DrainContainerInstances(mockCluster, []string{"arn1"}).Return(nil)
+				m.drainer.EXPECT().WaitUntilContainerInstancesDrained(mockCluster, []string{"arn1"}).Return(nil)
+				m.asg.EXPECT().GroupNames([]string{"i-1"}).Return(map[string]string{"i-1": "capacity"}, nil)
+				m.terminator.EXPECT().TerminateInstances("i-1").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"stops rolling if the auto scaling group doesn't replace the instance": {
+			inAll: true,
+			inYes: true,
+			setupMocks: func(m envDrainInstanceMocks) {
+				m.cluster.EXPECT().ClusterARN("phonetool", "test").Return(mockCluster, nil)
+				m.drainer.EXPECT().ContainerInstances(mockCluster).Return(instances, nil)
+				m.prog.EXPECT().Start(gomock.Any()).Times(3)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(3)
+				m.drainer.EXPECT().DrainContainerInstances(mockCluster, []string{"arn1"}).Return(nil)
+				m.drainer.EXPECT().WaitUntilContainerInstancesDrained(mockCluster, []string{"arn1"}).Return(nil)
+				m.asg.EXPECT().GroupNames([]string{"i-1"}).Return(map[string]string{"i-1": "capacity"}, nil)
+				m.terminator.EXPECT().TerminateInstances("i-1").Return(nil)
+				m.asg.EXPECT().WaitUntilInService("capacity", []string{"i-1"}).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("wait for auto scaling group capacity to be in service: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := envDrainInstanceMocks{
+				cluster:    mocks.NewMockenvClusterGetter(ctrl),
+				drainer:    mocks.NewMockcontainerInstanceDrainer(ctrl),
+				terminator: mocks.NewMockinstanceTerminator(ctrl),
+				asg:        mocks.NewMockautoScalingGroupWaiter(ctrl),
+				prompt:     mocks.NewMockprompter(ctrl),
+				prog:       mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			batchSize := tc.inBatchSize
+			if batchSize == 0 {
+				batchSize = 1 // Default value of the flag.
+			}
+			opts := &envDrainInstanceOpts{
+				envDrainInstanceVars: envDrainInstanceVars{
+					appName:          "phonetool",
+					envName:          "test",
+					instance:         tc.inInstance,
+					all:              tc.inAll,
+					batchSize:        batchSize,
+					skipConfirmation: tc.inYes,
+				},
+				cluster:     m.cluster,
+				drainer:     m.drainer,
+				terminator:  m.terminator,
+				asg:         m.asg,
+				prompt:      m.prompt,
+				prog:        m.prog,
+				initClients: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
@@ -23,6 +24,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
@@ -40,6 +42,7 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/terraform"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -148,11 +151,18 @@ type initEnvVars struct {
 	adjustVPC          adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
 	telemetry          telemetryVars // Configure observability and monitoring settings.
 	importCerts        []string      // Additional existing ACM certificates to use.
+	importCluster      string        // Name or ARN of an existing ECS cluster to use instead of creating a new one.
+	importPublicALB    string        // Name or ARN of an existing public ALB to use instead of creating a new one.
 	internalALBSubnets []string      // Subnets to be used for internal ALB placement.
 	allowVPCIngress    bool          // True means the env stack will create ingress to the internal ALB from ports 80/443.
+	terraformOutput    string        // Path to the file written by "terraform output -json" to import the resources from.
 
 	tempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
 	region    string        // The region to create the environment in.
+
+	// Set by "copilot sandbox up" to create a sandbox environment.
+	envProfile string     // Profile of the environment in its manifest.
+	expiresAt  *time.Time // Time after which the environment can be deleted.
 }
 
 type initEnvOpts struct {
@@ -176,19 +186,21 @@ type initEnvOpts struct {
 	selApp              appSelector
 	appCFN              appResourcesGetter
 	manifestWriter      environmentManifestWriter
+	ws                  wsWorkloadManifestLister
+	fs                  afero.Fs
 
 	sess *session.Session // Session pointing to environment's AWS account and region.
 
 	// Cached variables.
 	wsAppName        string
+	app              *config.Application
 	mftDisplayedPath string
 
 	// Overridden in tests.
 	templateVersion string
 }
 
-func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env init"))
+func newInitEnvOpts(vars initEnvVars, sessProvider *sessions.Provider) (*initEnvOpts, error) {
 	defaultSession, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
@@ -224,6 +236,8 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		selApp:         selector.NewAppEnvSelector(prompt.New(), store),
 		appCFN:         deploycfn.New(defaultSession, deploycfn.WithProgressTracker(os.Stderr)),
 		manifestWriter: ws,
+		ws:             ws,
+		fs:             afero.NewOsFs(),
 
 		wsAppName:       tryReadingAppName(),
 		templateVersion: version.LatestTemplateVersion(),
@@ -232,20 +246,28 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 
 // Validate returns an error if the values passed by flags are invalid.
 func (o *initEnvOpts) Validate() error {
-	if err := validateWorkspaceApp(o.wsAppName, o.appName, o.store); err != nil {
+	app, err := validateWorkspaceApp(o.wsAppName, o.appName, o.store)
+	if err != nil {
 		return err
 	}
 	o.appName = o.wsAppName
+	o.app = app
 
 	if o.name != "" {
 		if err := validateEnvironmentName(o.name); err != nil {
 			return err
 		}
+		if err := validateNamingConvention(o.app, config.EnvironmentKind, o.name); err != nil {
+			return err
+		}
 		if err := o.validateDuplicateEnv(); err != nil {
 			return err
 		}
 	}
 
+	if err := o.importTerraformOutput(); err != nil {
+		return err
+	}
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
@@ -280,6 +302,9 @@ func (o *initEnvOpts) Execute() error {
 			return err
 		}
 	}
+	if err := o.warnUnsupportedRegion(); err != nil {
+		return err
+	}
 	app, err := o.store.GetApplication(o.appName)
 	if err != nil {
 		// Ensure the app actually exists before we write the manifest.
@@ -329,6 +354,7 @@ func (o *initEnvOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get environment struct for %s: %w", o.name, err)
 	}
+	env.ExpiresAt = o.expiresAt
 	if err := o.store.CreateEnvironment(env); err != nil {
 		return fmt.Errorf("store environment: %w", err)
 	}
@@ -364,6 +390,23 @@ func (o *initEnvOpts) initRuntimeClients() error {
 	return nil
 }
 
+// warnUnsupportedRegion warns early if the workloads in the workspace need services that aren't available in the environment's region.
+func (o *initEnvOpts) warnUnsupportedRegion() error {
+	services, err := workspaceRegionServices(o.ws)
+	if err != nil {
+		return err
+	}
+	fips := o.sess.Config.UseFIPSEndpoint == endpoints.FIPSEndpointStateEnabled
+	msg, err := unsupportedRegionWarning(aws.StringValue(o.sess.Config.Region), fips, services)
+	if err != nil {
+		return err
+	}
+	if msg != "" {
+		log.Warning(msg)
+	}
+	return nil
+}
+
 func (o *initEnvOpts) validateCustomizedResources() error {
 	if o.importVPC.isSet() && o.adjustVPC.isSet() {
 		return errors.New("cannot specify both import vpc flags and configure vpc flags")
@@ -371,6 +414,20 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 	if (o.importVPC.isSet() || o.adjustVPC.isSet()) && o.defaultConfig {
 		return fmt.Errorf("cannot import or configure vpc if --%s is set", defaultConfigFlag)
 	}
+	if (o.importCluster != "" || o.importPublicALB != "") && o.defaultConfig {
+		return fmt.Errorf("cannot import a cluster or a public load balancer if --%s is set", defaultConfigFlag)
+	}
+	if o.importCluster != "" && o.telemetry.EnableContainerInsights {
+		return fmt.Errorf("cannot specify both --%s and --%s", importClusterFlag, enableContainerInsightsFlag)
+	}
+	if o.importPublicALB != "" {
+		if o.importVPC.ID == "" {
+			return fmt.Errorf("--%s must be specified to import a public load balancer with --%s", vpcIDFlag, importPublicALBFlag)
+		}
+		if len(o.importCerts) != 0 {
+			return fmt.Errorf("cannot specify both --%s and --%s", importPublicALBFlag, certsFlag)
+		}
+	}
 	if o.internalALBSubnets != nil && (o.adjustVPC.isSet() || o.defaultConfig) {
 		log.Error(`To specify internal ALB subnet placement, you must import existing resources, including subnets.
 For default config without subnet placement specification, Copilot will place the internal ALB in the generated private subnets.`)
@@ -396,6 +453,47 @@ For default config without subnet placement specification, Copilot will place th
 	return nil
 }
 
+// importTerraformOutput sets the resources to import from the outputs of Terraform, if any.
+func (o *initEnvOpts) importTerraformOutput() error {
+	if o.terraformOutput == "" {
+		return nil
+	}
+	if o.importVPC.isSet() || len(o.importCerts) != 0 || o.importCluster != "" || o.importPublicALB != "" {
+		return fmt.Errorf("cannot specify both --%s and import resources flags", fromTerraformOutputFlag)
+	}
+	if o.adjustVPC.isSet() || o.defaultConfig {
+		return fmt.Errorf("cannot specify --%s with --%s or configure vpc flags", fromTerraformOutputFlag, defaultConfigFlag)
+	}
+	content, err := afero.ReadFile(o.fs, o.terraformOutput)
+	if err != nil {
+		return fmt.Errorf("read Terraform outputs: %w", err)
+	}
+	res, err := terraform.ParseEnvResources(content)
+	if err != nil {
+		return fmt.Errorf("parse Terraform outputs in %s: %w", o.terraformOutput, err)
+	}
+	if res.VPCID == "" {
+		return fmt.Errorf("Terraform outputs in %s must include the ID of the VPC as output %s", o.terraformOutput,
+			english.WordSeries(terraform.VPCIDOutputs, "or"))
+	}
+	if res.PublicLoadBalancer != "" && len(res.CertificateARNs) != 0 {
+		return fmt.Errorf("Terraform outputs in %s cannot include both a public load balancer and certificates: the certificates of an imported load balancer are configured on its listeners", o.terraformOutput)
+	}
+	o.importVPC = importVPCVars{
+		ID:               res.VPCID,
+		PublicSubnetIDs:  res.PublicSubnetIDs,
+		PrivateSubnetIDs: res.PrivateSubnetIDs,
+	}
+	o.importCerts = res.CertificateARNs
+	o.importCluster = res.Cluster
+	o.importPublicALB = res.PublicLoadBalancer
+	if res.HostedZoneID != "" {
+		log.Infof("Environments don't configure a hosted zone: to use the hosted zone %s, set %s in the manifests of your Load Balanced Web Services.\n",
+			res.HostedZoneID, color.HighlightCode("http.hosted_zone"))
+	}
+	return nil
+}
+
 func (o *initEnvOpts) askEnvName() error {
 	if o.name != "" {
 		return nil
@@ -406,6 +504,9 @@ func (o *initEnvOpts) askEnvName() error {
 		return fmt.Errorf("get environment name: %w", err)
 	}
 	o.name = envName
+	if err := validateNamingConvention(o.app, config.EnvironmentKind, o.name); err != nil {
+		return err
+	}
 	return o.validateDuplicateEnv()
 }
 
@@ -860,12 +961,15 @@ func (o *initEnvOpts) writeManifest() (string, error) {
 		ImportCertARNs:              o.importCerts,
 		InternalALBSubnets:          o.internalALBSubnets,
 		EnableInternalALBVPCIngress: o.allowVPCIngress,
+		ImportCluster:               o.importCluster,
+		ImportPublicALB:             o.importPublicALB,
 	}
 	if customizedEnv.IsEmpty() {
 		customizedEnv = nil
 	}
 	props := manifest.EnvironmentProps{
 		Name:         o.name,
+		Profile:      o.envProfile,
 		CustomConfig: customizedEnv,
 		Telemetry:    o.telemetry.toConfig(),
 	}
@@ -924,13 +1028,23 @@ func buildEnvInitCmd() *cobra.Command {
   /code --import-private-subnets subnet-055fafef48fb3c547,subnet-00c9e76f288363e7f \
   /code --import-cert-arns arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012
 
+  Creates an environment that uses an existing ECS cluster and public load balancer.
+  /code $ copilot env init --import-vpc-id vpc-099c32d2b98cdcf47 \
+  /code --import-private-subnets subnet-055fafef48fb3c547,subnet-00c9e76f288363e7f \
+  /code --import-cluster shared-cluster \
+  /code --import-public-alb shared-alb
+
+  Creates an environment with the VPC, subnets and certificates that Terraform manages.
+  /code $ terraform output -json > outputs.json
+  /code $ copilot env init --from-terraform-output outputs.json
+
   Creates an environment with overridden CIDRs and AZs.
   /code $ copilot env init --override-vpc-cidr 10.1.0.0/16 \
   /code --override-az-names us-west-2b,us-west-2c \
   /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24 \
   /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newInitEnvOpts(vars)
+			opts, err := newInitEnvOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("env init")))
 			if err != nil {
 				return err
 			}
@@ -953,6 +1067,8 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&vars.importVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PrivateSubnetIDs, privateSubnetsFlag, nil, privateSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importCerts, certsFlag, nil, certsFlagDescription)
+	cmd.Flags().StringVar(&vars.importCluster, importClusterFlag, "", importClusterFlagDescription)
+	cmd.Flags().StringVar(&vars.importPublicALB, importPublicALBFlag, "", importPublicALBFlagDescription)
 	cmd.Flags().IPNetVar(&vars.adjustVPC.CIDR, overrideVPCCIDRFlag, net.IPNet{}, overrideVPCCIDRFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.AZs, overrideAZsFlag, nil, overrideAZsFlagDescription)
 	// TODO: use IPNetSliceVar when it is available (https://github.com/spf13/pflag/issues/273).
@@ -961,6 +1077,7 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&vars.internalALBSubnets, internalALBSubnetsFlag, nil, internalALBSubnetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowVPCIngress, allowVPCIngressFlag, false, allowVPCIngressFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultConfig, defaultConfigFlag, false, defaultConfigFlagDescription)
+	cmd.Flags().StringVar(&vars.terraformOutput, fromTerraformOutputFlag, "", fromTerraformOutputFlagDescription)
 
 	flags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -978,6 +1095,9 @@ func buildEnvInitCmd() *cobra.Command {
 	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(publicSubnetsFlag))
 	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(privateSubnetsFlag))
 	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(certsFlag))
+	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(importClusterFlag))
+	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(importPublicALBFlag))
+	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(fromTerraformOutputFlag))
 
 	resourcesConfigFlags := pflag.NewFlagSet("Configure Default Resources", pflag.ContinueOnError)
 	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(overrideVPCCIDRFlag))
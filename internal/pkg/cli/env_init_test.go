@@ -25,6 +25,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
@@ -51,6 +52,10 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 		inPublicIDs          []string
 		inPrivateIDs         []string
 		inInternalALBSubnets []string
+		inCerts              []string
+		inCluster            string
+		inPublicALB          string
+		inContainerInsights  bool
 
 		inVPCCIDR     net.IPNet
 		inAZs         []string
@@ -93,6 +98,20 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			},
 			wantedErrMsg: "get application phonetool configuration: some error",
 		},
+		"fail if the environment name doesn't follow the naming conventions of the application": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Conventions: &config.NamingConventions{
+						Environments: &config.NamingRule{Reserved: []string{"test-pdx"}},
+					},
+				}, nil)
+			},
+			wantedErrMsg: `environment name "test-pdx" is reserved`,
+		},
 		"invalid environment name": {
 			inEnvName: "123env",
 			inAppName: "phonetool",
@@ -147,6 +166,58 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			},
 			wantedErrMsg: fmt.Sprintf("cannot import or configure vpc if --%s is set", defaultConfigFlag),
 		},
+		"cannot import a cluster if use default flag is set": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+
+			inDefault: true,
+			inCluster: "mockCluster",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: fmt.Sprintf("cannot import a cluster or a public load balancer if --%s is set", defaultConfigFlag),
+		},
+		"cannot enable container insights on an imported cluster": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+
+			inCluster:           "mockCluster",
+			inContainerInsights: true,
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: fmt.Sprintf("cannot specify both --%s and --%s", importClusterFlag, enableContainerInsightsFlag),
+		},
+		"cannot import a public load balancer without importing a vpc": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+
+			inPublicALB: "mockALB",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: fmt.Sprintf("--%s must be specified to import a public load balancer with --%s", vpcIDFlag, importPublicALBFlag),
+		},
+		"cannot import certificates with a public load balancer": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+
+			inVPCID:     "mockID",
+			inPublicALB: "mockALB",
+			inCerts:     []string{"mockCert"},
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: fmt.Sprintf("cannot specify both --%s and --%s", importPublicALBFlag, certsFlag),
+		},
 		"should err if both profile and access key id are set": {
 			inAppName:     "phonetool",
 			inEnvName:     "test",
@@ -282,6 +353,9 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 					name:               tc.inEnvName,
 					defaultConfig:      tc.inDefault,
 					internalALBSubnets: tc.inInternalALBSubnets,
+					importCerts:        tc.inCerts,
+					importCluster:      tc.inCluster,
+					importPublicALB:    tc.inPublicALB,
 					adjustVPC: adjustVPCVars{
 						AZs:               tc.inAZs,
 						PublicSubnetCIDRs: tc.inPublicCIDRs,
@@ -292,6 +366,9 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 						PrivateSubnetIDs: tc.inPrivateIDs,
 						ID:               tc.inVPCID,
 					},
+					telemetry: telemetryVars{
+						EnableContainerInsights: tc.inContainerInsights,
+					},
 					appName: tc.inAppName,
 					profile: tc.inProfileName,
 					tempCreds: tempCredsVars{
@@ -317,6 +394,112 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 	}
 }
 
+func TestInitEnvOpts_importTerraformOutput(t *testing.T) {
+	const outputs = `{
+  "vpc_id": {"type": "string", "value": "vpc-123"},
+  "public_subnets": {"type": ["list", "string"], "value": ["subnet-1", "subnet-2"]},
+  "private_subnets": {"type": ["list", "string"], "value": ["subnet-3", "subnet-4"]},
+  "certificate_arns": {"type": ["list", "string"], "value": ["arn:aws:acm:us-west-2:123456789012:certificate/abc"]},
+  "ecs_cluster_arn": {"type": "string", "value": "arn:aws:ecs:us-west-2:123456789012:cluster/shared"}
+}`
+	testCases := map[string]struct {
+		inVars  initEnvVars
+		content string
+
+		wanted       initEnvVars
+		wantedErrMsg string
+	}{
+		"error if import flags are also specified": {
+			inVars: initEnvVars{
+				terraformOutput: "outputs.json",
+				importCluster:   "shared",
+			},
+			wantedErrMsg: "cannot specify both --from-terraform-output and import resources flags",
+		},
+		"error if the default config is also requested": {
+			inVars: initEnvVars{
+				terraformOutput: "outputs.json",
+				defaultConfig:   true,
+			},
+			wantedErrMsg: "cannot specify --from-terraform-output with --default-config or configure vpc flags",
+		},
+		"error if the file can't be read": {
+			inVars: initEnvVars{
+				terraformOutput: "missing.json",
+			},
+			wantedErrMsg: "read Terraform outputs: open missing.json: file does not exist",
+		},
+		"error if the outputs are malformed": {
+			inVars: initEnvVars{
+				terraformOutput: "outputs.json",
+			},
+			content:      `{"vpc_id": {"value": 1}}`,
+			wantedErrMsg: `parse Terraform outputs in outputs.json: output "vpc_id" must be a string`,
+		},
+		"error if the outputs don't include a vpc": {
+			inVars: initEnvVars{
+				terraformOutput: "outputs.json",
+			},
+			content:      `{"public_subnets": {"value": ["subnet-1", "subnet-2"]}}`,
+			wantedErrMsg: "Terraform outputs in outputs.json must include the ID of the VPC as output vpc_id",
+		},
+		"error if the outputs include both a public load balancer and certificates": {
+			inVars: initEnvVars{
+				terraformOutput: "outputs.json",
+			},
+			content:      `{"vpc_id": {"value": "vpc-123"}, "alb_arn": {"value": "shared-alb"}, "certificate_arn": {"value": "arn:aws:acm:us-west-2:123456789012:certificate/abc"}}`,
+			wantedErrMsg: "Terraform outputs in outputs.json cannot include both a public load balancer and certificates: the certificates of an imported load balancer are configured on its listeners",
+		},
+		"import the resources in the outputs": {
+			inVars: initEnvVars{
+				name:            "test",
+				terraformOutput: "outputs.json",
+			},
+			content: outputs,
+			wanted: initEnvVars{
+				name:            "test",
+				terraformOutput: "outputs.json",
+				importVPC: importVPCVars{
+					ID:               "vpc-123",
+					PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+					PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+				},
+				importCerts:   []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+				importCluster: "arn:aws:ecs:us-west-2:123456789012:cluster/shared",
+			},
+		},
+		"nothing to import without the flag": {
+			inVars: initEnvVars{
+				name: "test",
+			},
+			wanted: initEnvVars{
+				name: "test",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if tc.content != "" {
+				require.NoError(t, afero.WriteFile(fs, "outputs.json", []byte(tc.content), 0644))
+			}
+			opts := &initEnvOpts{
+				initEnvVars: tc.inVars,
+				fs:          fs,
+			}
+
+			err := opts.importTerraformOutput()
+
+			if tc.wantedErrMsg != "" {
+				require.EqualError(t, err, tc.wantedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, opts.initEnvVars)
+		})
+	}
+}
+
 func TestInitEnvOpts_Ask(t *testing.T) {
 	const (
 		mockApp         = "test-app"
@@ -974,6 +1157,7 @@ type initEnvExecuteMocks struct {
 	appCFN           *mocks.MockappResourcesGetter
 	manifestWriter   *mocks.MockenvironmentManifestWriter
 	appVersionGetter *mocks.MockversionGetter
+	ws               *mocks.MockwsWorkloadManifestLister
 }
 
 func TestInitEnvOpts_Execute(t *testing.T) {
@@ -1288,7 +1472,9 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				appCFN:           mocks.NewMockappResourcesGetter(ctrl),
 				manifestWriter:   mocks.NewMockenvironmentManifestWriter(ctrl),
 				appVersionGetter: mocks.NewMockversionGetter(ctrl),
+				ws:               mocks.NewMockwsWorkloadManifestLister(ctrl),
 			}
+			m.ws.EXPECT().ListWorkloads().Return(nil, nil).AnyTimes()
 			tc.setupMocks(m)
 			provider := sessions.ImmutableProvider()
 			sess, _ := provider.DefaultWithRegion("us-west-2")
@@ -1316,6 +1502,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 					return m.appVersionGetter, nil
 				},
 				manifestWriter:  m.manifestWriter,
+				ws:              m.ws,
 				templateVersion: mockCurrVersion,
 			}
 
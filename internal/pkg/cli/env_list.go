@@ -8,12 +8,18 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -28,7 +34,9 @@ const (
 
 type listEnvVars struct {
 	appName          string
+	allApps          bool
 	shouldOutputJSON bool
+	outputFormat     outputFormatVars
 }
 
 type listEnvOpts struct {
@@ -37,6 +45,10 @@ type listEnvOpts struct {
 	prompt prompter
 	sel    configSelector
 
+	// Discover the applications of every region with --all-apps.
+	regions  func() ([]string, error)
+	newStore func(region string) (store, error)
+
 	w io.Writer
 }
 
@@ -46,20 +58,30 @@ func newListEnvOpts(vars listEnvVars) (*listEnvOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	defaultStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
 	prompter := prompt.New()
 	return &listEnvOpts{
 		listEnvVars: vars,
-		store:       store,
-		sel:         selector.NewConfigSelector(prompter, store),
+		store:       defaultStore,
+		sel:         selector.NewConfigSelector(prompter, defaultStore),
 		prompt:      prompter,
-		w:           os.Stdout,
+		regions: func() ([]string, error) {
+			return partitionRegions(aws.StringValue(defaultSess.Config.Region))
+		},
+		newStore: func(region string) (store, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, err
+			}
+			return config.NewSSMStore(identity.New(sess), ssm.New(sess), region), nil
+		},
+		w: os.Stdout,
 	}, nil
 }
 
 // Ask asks for fields that are required but not passed in.
 func (o *listEnvOpts) Ask() error {
-	if o.appName != "" {
+	if o.appName != "" || o.allApps {
 		return nil
 	}
 	app, err := o.sel.Application(envListAppNamePrompt, envListAppNameHelper)
@@ -72,6 +94,9 @@ func (o *listEnvOpts) Ask() error {
 
 // Execute lists the environments through the prompt.
 func (o *listEnvOpts) Execute() error {
+	if o.allApps {
+		return o.listAllApps()
+	}
 	// Ensure the application actually exists before we try to list its environments.
 	if _, err := o.store.GetApplication(o.appName); err != nil {
 		return err
@@ -82,18 +107,17 @@ func (o *listEnvOpts) Execute() error {
 		return err
 	}
 
-	var out string
-	if o.shouldOutputJSON {
-		data, err := o.jsonOutput(envs)
-		if err != nil {
-			return err
-		}
-		out = data
-	} else {
-		out = o.humanOutput(envs)
+	if o.shouldOutputJSON || o.outputFormat.isSet() {
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			data, err := o.jsonOutput(envs)
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(w, data)
+			return nil
+		})
 	}
-	fmt.Fprint(o.w, out)
-
+	fmt.Fprint(o.w, o.humanOutput(envs))
 	return nil
 }
 
@@ -116,6 +140,126 @@ func (o *listEnvOpts) jsonOutput(envs []*config.Environment) (string, error) {
 	return fmt.Sprintf("%s\n", b), nil
 }
 
+// appEnvironments is an application found in the SSM store of a region, and its environments.
+type appEnvironments struct {
+	Name         string                `json:"name"`
+	Region       string                `json:"region"`
+	AccountID    string                `json:"account"`
+	Environments []*config.Environment `json:"environments"`
+}
+
+// listAllApps lists the environments of every application in the SSM store of every region of the partition.
+// Regions whose store can't be read, like opt-in regions that aren't enabled, are skipped with a warning.
+func (o *listEnvOpts) listAllApps() error {
+	regions, err := o.regions()
+	if err != nil {
+		return err
+	}
+	found := make([][]*appEnvironments, len(regions))
+	g := new(errgroup.Group)
+	for i := range regions {
+		i, region := i, regions[i]
+		g.Go(func() error {
+			apps, err := o.regionApps(region)
+			if err != nil {
+				log.Warningf("Skip region %s: %v\n", region, err)
+				return nil
+			}
+			found[i] = apps
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	var apps []*appEnvironments
+	for _, regionApps := range found {
+		apps = append(apps, regionApps...)
+	}
+
+	if o.shouldOutputJSON || o.outputFormat.isSet() {
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			b, err := json.Marshal(struct {
+				Applications []*appEnvironments `json:"applications"`
+			}{Applications: apps})
+			if err != nil {
+				return fmt.Errorf("marshal applications: %w", err)
+			}
+			fmt.Fprintf(w, "%s\n", b)
+			return nil
+		})
+	}
+	allAppsHumanOutput(o.w, apps)
+	return nil
+}
+
+// regionApps returns the applications in the SSM store of the region, sorted by name, with their environments.
+func (o *listEnvOpts) regionApps(region string) ([]*appEnvironments, error) {
+	regionStore, err := o.newStore(region)
+	if err != nil {
+		return nil, fmt.Errorf("create config store: %w", err)
+	}
+	apps, err := regionStore.ListApplications()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Name < apps[j].Name })
+	out := make([]*appEnvironments, 0, len(apps))
+	for _, app := range apps {
+		envs, err := regionStore.ListEnvironments(app.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list environments in application %s: %w", app.Name, err)
+		}
+		out = append(out, &appEnvironments{
+			Name:         app.Name,
+			Region:       region,
+			AccountID:    app.AccountID,
+			Environments: envs,
+		})
+	}
+	return out, nil
+}
+
+func allAppsHumanOutput(w io.Writer, apps []*appEnvironments) {
+	if len(apps) == 0 {
+		fmt.Fprintln(w, "No applications found.")
+		return
+	}
+	tw := tabwriter.NewWriter(w, 4, 4, 2, ' ', 0)
+	headers := []string{"Application", "App Region", "Environment", "Account ID", "Region"}
+	fmt.Fprintf(tw, "%s\n", strings.Join(headers, "\t"))
+	separators := make([]string, len(headers))
+	for i, header := range headers {
+		separators[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(tw, "%s\n", strings.Join(separators, "\t"))
+	for _, app := range apps {
+		if len(app.Environments) == 0 {
+			fmt.Fprintf(tw, "%s\t%s\t-\t-\t-\n", app.Name, app.Region)
+			continue
+		}
+		for _, env := range app.Environments {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", app.Name, app.Region, env.Name, env.AccountID, env.Region)
+		}
+	}
+	tw.Flush()
+}
+
+// partitionRegions returns the regions where SSM is available in the partition of the region, sorted.
+func partitionRegions(region string) ([]string, error) {
+	partition, err := partitions.Region(region).Partition()
+	if err != nil {
+		return nil, err
+	}
+	available, _ := endpoints.RegionsForService(endpoints.DefaultPartitions(), partition.ID(), ssm.EndpointsID)
+	regions := make([]string, 0, len(available))
+	for region := range available {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions, nil
+}
+
 // buildEnvListCmd builds the command for listing environments in an application.
 func buildEnvListCmd() *cobra.Command {
 	vars := listEnvVars{}
@@ -124,8 +268,13 @@ func buildEnvListCmd() *cobra.Command {
 		Short: "Lists all the environments in an application.",
 		Example: `
   Lists all the environments for the frontend application.
-  /code $ copilot env ls -a frontend`,
+  /code $ copilot env ls -a frontend
+  Lists the environments of every application in every region of the account.
+  /code $ copilot env ls --all-apps`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := vars.outputFormat.validate(); err != nil {
+				return err
+			}
 			opts, err := newListEnvOpts(vars)
 			if err != nil {
 				return err
@@ -137,6 +286,9 @@ func buildEnvListCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.allApps, allAppsFlag, false, allAppsFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.format, formatFlag, "", formatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.query, queryFlag, "", queryFlagDescription)
 	return cmd
 }
@@ -201,3 +201,91 @@ func TestEnvList_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvList_Execute_AllApps(t *testing.T) {
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(stores map[string]*mocks.Mockstore)
+
+		wantedContent string
+	}{
+		"lists the environments of the applications of every region and skips the regions that can't be read": {
+			setupMocks: func(stores map[string]*mocks.Mockstore) {
+				stores["us-east-1"].EXPECT().ListApplications().Return([]*config.Application{
+					{Name: "payments", AccountID: "111111111111"},
+					{Name: "api", AccountID: "111111111111"},
+				}, nil)
+				stores["us-east-1"].EXPECT().ListEnvironments("api").Return(nil, nil)
+				stores["us-east-1"].EXPECT().ListEnvironments("payments").Return([]*config.Environment{
+					{Name: "test", AccountID: "111111111111", Region: "us-east-1"},
+					{Name: "prod", AccountID: "222222222222", Region: "eu-west-1"},
+				}, nil)
+				stores["me-south-1"].EXPECT().ListApplications().Return(nil, errors.New("UnrecognizedClientException"))
+			},
+			wantedContent: `Application  App Region  Environment  Account ID    Region
+-----------  ----------  -----------  ----------    ------
+api          us-east-1   -            -             -
+payments     us-east-1   test         111111111111  us-east-1
+payments     us-east-1   prod         222222222222  eu-west-1
+`,
+		},
+		"json output": {
+			shouldOutputJSON: true,
+			setupMocks: func(stores map[string]*mocks.Mockstore) {
+				stores["us-east-1"].EXPECT().ListApplications().Return([]*config.Application{
+					{Name: "api", AccountID: "111111111111"},
+				}, nil)
+				stores["us-east-1"].EXPECT().ListEnvironments("api").Return([]*config.Environment{
+					{Name: "test"},
+				}, nil)
+				stores["me-south-1"].EXPECT().ListApplications().Return(nil, nil)
+			},
+			wantedContent: `{"applications":[{"name":"api","region":"us-east-1","account":"111111111111","environments":[{"app":"","name":"test","region":"","accountID":"","registryURL":"","executionRoleARN":"","managerRoleARN":""}]}]}` + "\n",
+		},
+		"no applications": {
+			setupMocks: func(stores map[string]*mocks.Mockstore) {
+				stores["us-east-1"].EXPECT().ListApplications().Return(nil, nil)
+				stores["me-south-1"].EXPECT().ListApplications().Return(nil, nil)
+			},
+			wantedContent: "No applications found.\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			stores := map[string]*mocks.Mockstore{
+				"me-south-1": mocks.NewMockstore(ctrl),
+				"us-east-1":  mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(stores)
+			b := &bytes.Buffer{}
+			opts := &listEnvOpts{
+				listEnvVars: listEnvVars{
+					allApps:          true,
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				regions: func() ([]string, error) {
+					return []string{"me-south-1", "us-east-1"}, nil
+				},
+				newStore: func(region string) (store, error) {
+					return stores[region], nil
+				},
+				w: b,
+			}
+
+			require.NoError(t, opts.Ask())
+			err := opts.Execute()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func Test_partitionRegions(t *testing.T) {
+	regions, err := partitionRegions("cn-north-1")
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"cn-north-1", "cn-northwest-1"}, regions)
+}
@@ -4,11 +4,16 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
@@ -30,14 +35,34 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 const (
 	envCFNTemplateNameFmt              = "%s.env.yml"
 	envCFNTemplateConfigurationNameFmt = "%s.env.params.json"
 	envAddonsCFNTemplateName           = "env.addons.yml"
+	envAddonsCFNParamsNameFmt          = "%s.env.addons.params.json"
 )
 
+const (
+	// Output formats for "env package".
+	stackSetOutputFormat = "stackset"
+
+	// StackSet and Customizations for AWS Control Tower layout under the output directory.
+	stackSetTemplatesDirName = "templates"
+	stackSetParamsDirName    = "parameters"
+	stackSetManifestFileName = "manifest.yaml"
+	stackSetManifestVersion  = "2021-03-15"
+	stackSetDeployMethod     = "stack_set"
+	stackSetResourceNameFmt  = "copilot-%s-%s"
+	stackSetResourceDescFmt  = "AWS Copilot environment %q of application %q."
+	stackSetAddonsNameFmt    = "copilot-%s-%s-addons"
+	stackSetAddonsDescFmt    = "Addons of AWS Copilot environment %q of application %q."
+)
+
+var validEnvPackageOutputFormats = []string{stackSetOutputFormat}
+
 type packageEnvVars struct {
 	name              string
 	appName           string
@@ -45,7 +70,12 @@ type packageEnvVars struct {
 	uploadAssets      bool
 	forceNewUpdate    bool
 	showDiff          bool
+	estimateCost      bool
 	allowEnvDowngrade bool
+	noCache           bool
+	outputFormat      string
+	targetAccounts    []string
+	targetOUs         []string
 }
 
 type discardFile struct{}
@@ -132,7 +162,7 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 		if err != nil {
 			return nil, err
 		}
-		ovrdr, err := deploy.NewOverrider(ws.EnvOverridesPath(), envCfg.App, envCfg.Name, fs, sessProvider)
+		ovrdr, err := deploy.NewOverrider(ws.EnvOverridesPath(), envCfg.App, envCfg.Name, fs, sessProvider, overriderOptions(opts.noCache)...)
 		if err != nil {
 			return nil, err
 		}
@@ -150,6 +180,19 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *packageEnvOpts) Validate() error {
+	if o.outputFormat == "" {
+		if len(o.targetAccounts) > 0 || len(o.targetOUs) > 0 {
+			return fmt.Errorf("--%s and --%s can only be used with --%s %s", targetAccountsFlag, targetOUsFlag, outputFormatFlag, stackSetOutputFormat)
+		}
+		return nil
+	}
+	if o.outputFormat != stackSetOutputFormat {
+		return fmt.Errorf("invalid output format %q: must be one of %s",
+			o.outputFormat, strings.Join(applyAll(validEnvPackageOutputFormats, strconv.Quote), ", "))
+	}
+	if o.outputDir == "" {
+		return fmt.Errorf("--%s is required with --%s %s", stackOutputDirFlag, outputFormatFlag, o.outputFormat)
+	}
 	return nil
 }
 
@@ -204,9 +247,14 @@ func (o *packageEnvOpts) Execute() error {
 		}
 		uploadArtifactsOut = *out
 	}
+	addonsURL := uploadArtifactsOut.AddonsURL
+	if o.outputFormat == stackSetOutputFormat {
+		// StackSets deploy the addons as a resource of their own, after the environment, instead of a nested stack.
+		addonsURL = ""
+	}
 	res, err := packager.GenerateCloudFormationTemplate(&deploy.DeployEnvironmentInput{
 		RootUserARN:         principal.RootUserARN,
-		AddonsURL:           uploadArtifactsOut.AddonsURL,
+		AddonsURL:           addonsURL,
 		CustomResourcesURLs: uploadArtifactsOut.CustomResourceURLs,
 		Manifest:            mft,
 		RawManifest:         rawMft,
@@ -217,6 +265,11 @@ func (o *packageEnvOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("generate CloudFormation template from environment %q manifest: %v", o.name, err)
 	}
+	if o.estimateCost {
+		if err := costEstimate(packager, res.Template, res.Parameters, o.diffWriter); err != nil {
+			return err
+		}
+	}
 	if o.showDiff {
 		if err := diff(packager, res.Template, o.diffWriter); err != nil {
 			var errHasDiff *errHasDiff
@@ -232,6 +285,9 @@ func (o *packageEnvOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("retrieve environment addons template: %w", err)
 	}
+	if o.outputFormat == stackSetOutputFormat {
+		return o.writeStackSetArtifacts(res, addonsTemplate)
+	}
 	if err := o.setWriters(); err != nil {
 		return err
 	}
@@ -329,6 +385,145 @@ func (o *packageEnvOpts) setAddonsWriter() error {
 	return nil
 }
 
+// writeStackSetArtifacts writes the environment template and parameters under the output directory in a layout that
+// can be consumed by CloudFormation StackSets or by a Customizations for AWS Control Tower manifest:
+//
+//	manifest.yaml
+//	templates/<env>.env.yml
+//	templates/env.addons.yml
+//	parameters/<env>.env.params.json
+//	parameters/<env>.env.addons.params.json
+//
+// The addons are a resource of the manifest deployed after the environment, to the same accounts and organizational units.
+func (o *packageEnvOpts) writeStackSetArtifacts(res *deploy.GenerateCloudFormationTemplateOutput, addonsTemplate string) error {
+	env, err := o.getEnvCfg()
+	if err != nil {
+		return err
+	}
+	params, err := stackSetParameters(res.Parameters)
+	if err != nil {
+		return err
+	}
+	targets := stackSetDeploymentTargets{
+		Accounts:            o.targetAccounts,
+		OrganizationalUnits: o.targetOUs,
+	}
+	if len(targets.Accounts) == 0 && len(targets.OrganizationalUnits) == 0 {
+		targets.Accounts = []string{env.AccountID}
+	}
+	tplPath := path.Join(stackSetTemplatesDirName, fmt.Sprintf(envCFNTemplateNameFmt, o.name))
+	paramsPath := path.Join(stackSetParamsDirName, fmt.Sprintf(envCFNTemplateConfigurationNameFmt, o.name))
+	files := map[string]string{
+		tplPath:    res.Template,
+		paramsPath: params,
+	}
+	resources := []stackSetManifestResource{
+		{
+			Name:              fmt.Sprintf(stackSetResourceNameFmt, o.appName, o.name),
+			Description:       fmt.Sprintf(stackSetResourceDescFmt, o.name, o.appName),
+			ResourceFile:      tplPath,
+			ParameterFile:     paramsPath,
+			DeployMethod:      stackSetDeployMethod,
+			DeploymentTargets: targets,
+			Regions:           []string{env.Region},
+		},
+	}
+	if addonsTemplate != "" {
+		addonsParams, err := stackSetParameters(fmt.Sprintf(`{"Parameters": {"App": %q, "Env": %q}}`, o.appName, o.name))
+		if err != nil {
+			return err
+		}
+		addonsPath := path.Join(stackSetTemplatesDirName, envAddonsCFNTemplateName)
+		addonsParamsPath := path.Join(stackSetParamsDirName, fmt.Sprintf(envAddonsCFNParamsNameFmt, o.name))
+		files[addonsPath] = addonsTemplate
+		files[addonsParamsPath] = addonsParams
+		resources = append(resources, stackSetManifestResource{
+			Name:              fmt.Sprintf(stackSetAddonsNameFmt, o.appName, o.name),
+			Description:       fmt.Sprintf(stackSetAddonsDescFmt, o.name, o.appName),
+			ResourceFile:      addonsPath,
+			ParameterFile:     addonsParamsPath,
+			DeployMethod:      stackSetDeployMethod,
+			DeploymentTargets: targets,
+			Regions:           []string{env.Region},
+		})
+	}
+	mft := new(strings.Builder)
+	enc := yaml.NewEncoder(mft)
+	enc.SetIndent(2)
+	err = enc.Encode(stackSetManifest{
+		Region:    env.Region,
+		Version:   stackSetManifestVersion,
+		Resources: resources,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal StackSet manifest: %w", err)
+	}
+	files[stackSetManifestFileName] = mft.String()
+
+	for name, content := range files {
+		fpath := filepath.Join(o.outputDir, filepath.FromSlash(name))
+		if err := o.fs.MkdirAll(filepath.Dir(fpath), 0755); err != nil {
+			return fmt.Errorf("create directory %q: %w", filepath.Dir(fpath), err)
+		}
+		if err := afero.WriteFile(o.fs, fpath, []byte(content), 0644); err != nil {
+			return fmt.Errorf("write file at %q: %w", fpath, err)
+		}
+	}
+	log.Successf("Wrote StackSet artifacts for environment %s under %s\n", color.HighlightUserInput(o.name), displayPath(o.outputDir))
+	return nil
+}
+
+type stackSetManifest struct {
+	Region    string                     `yaml:"region"`
+	Version   string                     `yaml:"version"`
+	Resources []stackSetManifestResource `yaml:"resources"`
+}
+
+type stackSetManifestResource struct {
+	Name              string                    `yaml:"name"`
+	Description       string                    `yaml:"description"`
+	ResourceFile      string                    `yaml:"resource_file"`
+	ParameterFile     string                    `yaml:"parameter_file"`
+	DeployMethod      string                    `yaml:"deploy_method"`
+	DeploymentTargets stackSetDeploymentTargets `yaml:"deployment_targets"`
+	Regions           []string                  `yaml:"regions"`
+}
+
+type stackSetDeploymentTargets struct {
+	Accounts            []string `yaml:"accounts,omitempty"`
+	OrganizationalUnits []string `yaml:"organizational_units,omitempty"`
+}
+
+// stackSetParameters transforms a CloudFormation template configuration file into the list of
+// parameters accepted by StackSets, sorted by parameter key.
+func stackSetParameters(templateConfig string) (string, error) {
+	var config struct {
+		Parameters map[string]string `json:"Parameters"`
+	}
+	if err := json.Unmarshal([]byte(templateConfig), &config); err != nil {
+		return "", fmt.Errorf("unmarshal template configuration: %w", err)
+	}
+	type parameter struct {
+		ParameterKey   string `json:"ParameterKey"`
+		ParameterValue string `json:"ParameterValue"`
+	}
+	params := make([]parameter, 0, len(config.Parameters))
+	for k, v := range config.Parameters {
+		params = append(params, parameter{
+			ParameterKey:   k,
+			ParameterValue: v,
+		})
+	}
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].ParameterKey < params[j].ParameterKey
+	})
+	out, err := json.MarshalIndent(params, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal StackSet parameters: %w", err)
+	}
+	return string(out), nil
+}
+
 func (o *packageEnvOpts) writeAndClose(wc io.WriteCloser, dat string) error {
 	if _, err := wc.Write([]byte(dat)); err != nil {
 		return err
@@ -352,6 +547,13 @@ func buildEnvPkgCmd() *cobra.Command {
   $ copilot env package -n test --output-dir ./infrastructure --upload-assets
   $ ls ./infrastructure
   test.env.yml      test.env.params.json
+  /endcodeblock
+
+  Write the CloudFormation template and parameters in a layout consumable by StackSets or Customizations for AWS Control Tower.
+  /startcodeblock
+  $ copilot env package -n test --output-dir ./infrastructure --upload-assets --output-format stackset
+  $ ls ./infrastructure
+  manifest.yaml      parameters      templates
   /endcodeblock`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPackageEnvOpts(vars)
@@ -367,7 +569,12 @@ func buildEnvPkgCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceEnvDeployFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.estimateCost, estimateCostFlag, false, estimateCostFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowEnvDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", envPackageOutputFormatFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.targetAccounts, targetAccountsFlag, nil, targetAccountsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.targetOUs, targetOUsFlag, nil, targetOUsFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, stackOutputDirFlag)
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, uploadAssetsFlag)
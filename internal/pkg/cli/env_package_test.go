@@ -27,6 +27,59 @@ import (
 	"github.com/golang/mock/gomock"
 )
 
+func TestPackageEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     packageEnvVars
+		wanted error
+	}{
+		"should return nil if no output format is set": {},
+		"should return an error if the output format is invalid": {
+			in: packageEnvVars{
+				outputFormat: "terraform",
+				outputDir:    "infrastructure",
+			},
+			wanted: errors.New(`invalid output format "terraform": must be one of "stackset"`),
+		},
+		"should return an error if the stackset output format is used without an output directory": {
+			in: packageEnvVars{
+				outputFormat: "stackset",
+			},
+			wanted: errors.New(`--output-dir is required with --output-format stackset`),
+		},
+		"should return an error if the StackSet targets are set without the stackset output format": {
+			in: packageEnvVars{
+				targetOUs: []string{"ou-1234-abcd"},
+			},
+			wanted: errors.New(`--target-accounts and --target-ous can only be used with --output-format stackset`),
+		},
+		"should return nil if the stackset output format is used with an output directory": {
+			in: packageEnvVars{
+				outputFormat: "stackset",
+				outputDir:    "infrastructure",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &packageEnvOpts{
+				packageEnvVars: tc.in,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wanted == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wanted.Error())
+			}
+		})
+	}
+}
+
 func TestPackageEnvOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		in        packageEnvVars
@@ -437,6 +490,79 @@ func TestPackageEnvOpts_Execute(t *testing.T) {
 			wantedDiff: "mock diff",
 			wantedErr:  &errHasDiff{},
 		},
+		"should return a wrapped error if fail to estimate the cost": {
+			mockedCmd: func(ctrl *gomock.Controller) *packageEnvOpts {
+				ws := mocks.NewMockwsEnvironmentReader(ctrl)
+				ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: test\ntype: Environment\n"), nil)
+				interop := mocks.NewMockinterpolator(ctrl)
+				interop.EXPECT().Interpolate(gomock.Any()).Return("name: test\ntype: Environment\n", nil)
+				caller := mocks.NewMockidentityService(ctrl)
+				caller.EXPECT().Get().Return(identity.Caller{}, nil)
+				deployer := mocks.NewMockenvPackager(ctrl)
+				deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template:   "template",
+					Parameters: "parameters",
+				}, nil)
+				deployer.EXPECT().EstimateCost("template", "parameters").Return("", errors.New("some error"))
+				return &packageEnvOpts{
+					packageEnvVars: packageEnvVars{
+						name:         "test",
+						estimateCost: true,
+					},
+					ws:     ws,
+					caller: caller,
+					newInterpolator: func(_, _ string) interpolator {
+						return interop
+					},
+					newEnvPackager: func() (envPackager, error) {
+						return deployer, nil
+					},
+					envCfg:     &config.Environment{Name: "test"},
+					appCfg:     &config.Application{},
+					diffWriter: &strings.Builder{},
+				}
+			},
+			wantedErr: errors.New("estimate the monthly cost: some error"),
+		},
+		"should write the estimated cost before the diff": {
+			mockedCmd: func(ctrl *gomock.Controller) *packageEnvOpts {
+				ws := mocks.NewMockwsEnvironmentReader(ctrl)
+				ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: test\ntype: Environment\n"), nil)
+				interop := mocks.NewMockinterpolator(ctrl)
+				interop.EXPECT().Interpolate(gomock.Any()).Return("name: test\ntype: Environment\n", nil)
+				caller := mocks.NewMockidentityService(ctrl)
+				caller.EXPECT().Get().Return(identity.Caller{}, nil)
+				deployer := mocks.NewMockenvPackager(ctrl)
+				deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template:   "template",
+					Parameters: "parameters",
+				}, nil)
+				deployer.EXPECT().EstimateCost("template", "parameters").Return("mock estimate\n", nil)
+				deployer.EXPECT().DeployDiff("template").Return("mock diff", nil)
+				return &packageEnvOpts{
+					packageEnvVars: packageEnvVars{
+						name:         "test",
+						showDiff:     true,
+						estimateCost: true,
+					},
+					ws:     ws,
+					caller: caller,
+					newInterpolator: func(_, _ string) interpolator {
+						return interop
+					},
+					newEnvPackager: func() (envPackager, error) {
+						return deployer, nil
+					},
+					envCfg:     &config.Environment{Name: "test"},
+					appCfg:     &config.Application{},
+					diffWriter: &strings.Builder{},
+				}
+			},
+			wantedDiff: "\nmock estimate\nmock diff",
+			wantedErr:  &errHasDiff{},
+		},
 		"should write files to output directories without addons": {
 			mockedCmd: func(ctrl *gomock.Controller) *packageEnvOpts {
 				ws := mocks.NewMockwsEnvironmentReader(ctrl)
@@ -574,6 +700,193 @@ func TestPackageEnvOpts_Execute(t *testing.T) {
 				require.Equal(t, []byte("addons"), actual)
 			},
 		},
+		"should write StackSet artifacts to the output directory": {
+			mockedCmd: func(ctrl *gomock.Controller) *packageEnvOpts {
+				ws := mocks.NewMockwsEnvironmentReader(ctrl)
+				ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte("name: test\ntype: Environment\n"), nil)
+				interop := mocks.NewMockinterpolator(ctrl)
+				interop.EXPECT().Interpolate("name: test\ntype: Environment\n").Return("name: test\ntype: Environment\n", nil)
+				caller := mocks.NewMockidentityService(ctrl)
+				caller.EXPECT().Get().Return(identity.Caller{}, nil)
+				deployer := mocks.NewMockenvPackager(ctrl)
+				deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "template",
+					Parameters: `{
+  "Parameters": {
+    "EnvironmentName": "test",
+    "AppName": "phonetool"
+  },
+  "Tags": {
+    "copilot-application": "phonetool"
+  }
+}`,
+				}, nil)
+				deployer.EXPECT().AddonsTemplate().Return("addons", nil)
+
+				return &packageEnvOpts{
+					packageEnvVars: packageEnvVars{
+						appName:      "phonetool",
+						name:         "test",
+						outputDir:    "infrastructure",
+						outputFormat: "stackset",
+					},
+					ws:     ws,
+					caller: caller,
+					newInterpolator: func(_, _ string) interpolator {
+						return interop
+					},
+					newEnvPackager: func() (envPackager, error) {
+						return deployer, nil
+					},
+					fs: afero.NewMemMapFs(),
+					envCfg: &config.Environment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					appCfg: &config.Application{},
+				}
+			},
+			wantedFS: func(t *testing.T, fs afero.Fs) {
+				actual, err := afero.ReadFile(fs, "infrastructure/templates/test.env.yml")
+				require.NoError(t, err)
+				require.Equal(t, "template", string(actual))
+
+				actual, err = afero.ReadFile(fs, "infrastructure/templates/env.addons.yml")
+				require.NoError(t, err)
+				require.Equal(t, "addons", string(actual))
+
+				actual, err = afero.ReadFile(fs, "infrastructure/parameters/test.env.params.json")
+				require.NoError(t, err)
+				require.Equal(t, `[
+  {
+    "ParameterKey": "AppName",
+    "ParameterValue": "phonetool"
+  },
+  {
+    "ParameterKey": "EnvironmentName",
+    "ParameterValue": "test"
+  }
+]`, string(actual))
+
+				actual, err = afero.ReadFile(fs, "infrastructure/parameters/test.env.addons.params.json")
+				require.NoError(t, err)
+				require.Equal(t, `[
+  {
+    "ParameterKey": "App",
+    "ParameterValue": "phonetool"
+  },
+  {
+    "ParameterKey": "Env",
+    "ParameterValue": "test"
+  }
+]`, string(actual))
+
+				actual, err = afero.ReadFile(fs, "infrastructure/manifest.yaml")
+				require.NoError(t, err)
+				require.Equal(t, `region: us-west-2
+version: "2021-03-15"
+resources:
+  - name: copilot-phonetool-test
+    description: AWS Copilot environment "test" of application "phonetool".
+    resource_file: templates/test.env.yml
+    parameter_file: parameters/test.env.params.json
+    deploy_method: stack_set
+    deployment_targets:
+      accounts:
+        - "123456789012"
+    regions:
+      - us-west-2
+  - name: copilot-phonetool-test-addons
+    description: Addons of AWS Copilot environment "test" of application "phonetool".
+    resource_file: templates/env.addons.yml
+    parameter_file: parameters/test.env.addons.params.json
+    deploy_method: stack_set
+    deployment_targets:
+      accounts:
+        - "123456789012"
+    regions:
+      - us-west-2
+`, string(actual))
+
+				exists, _ := afero.Exists(fs, "infrastructure/test.env.yml")
+				require.False(t, exists, "should not write the default layout")
+			},
+		},
+		"should deploy the StackSet artifacts to the target accounts and organizational units": {
+			mockedCmd: func(ctrl *gomock.Controller) *packageEnvOpts {
+				ws := mocks.NewMockwsEnvironmentReader(ctrl)
+				ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte("name: test\ntype: Environment\n"), nil)
+				interop := mocks.NewMockinterpolator(ctrl)
+				interop.EXPECT().Interpolate("name: test\ntype: Environment\n").Return("name: test\ntype: Environment\n", nil)
+				caller := mocks.NewMockidentityService(ctrl)
+				caller.EXPECT().Get().Return(identity.Caller{}, nil)
+				deployer := mocks.NewMockenvPackager(ctrl)
+				deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{
+					AddonsURL: "https://bucket.s3.amazonaws.com/addons.yml",
+				}, nil)
+				deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+					require.Empty(t, in.AddonsURL, "addons should not be a nested stack of the environment")
+					return &deploy.GenerateCloudFormationTemplateOutput{
+						Template:   "template",
+						Parameters: `{"Parameters": {"EnvironmentName": "test"}}`,
+					}, nil
+				})
+				deployer.EXPECT().AddonsTemplate().Return("", nil)
+
+				return &packageEnvOpts{
+					packageEnvVars: packageEnvVars{
+						appName:        "phonetool",
+						name:           "test",
+						outputDir:      "infrastructure",
+						outputFormat:   "stackset",
+						uploadAssets:   true,
+						targetAccounts: []string{"111111111111"},
+						targetOUs:      []string{"ou-1234-abcd"},
+					},
+					ws:     ws,
+					caller: caller,
+					newInterpolator: func(_, _ string) interpolator {
+						return interop
+					},
+					newEnvPackager: func() (envPackager, error) {
+						return deployer, nil
+					},
+					fs: afero.NewMemMapFs(),
+					envCfg: &config.Environment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					appCfg: &config.Application{},
+				}
+			},
+			wantedFS: func(t *testing.T, fs afero.Fs) {
+				actual, err := afero.ReadFile(fs, "infrastructure/manifest.yaml")
+				require.NoError(t, err)
+				require.Equal(t, `region: us-west-2
+version: "2021-03-15"
+resources:
+  - name: copilot-phonetool-test
+    description: AWS Copilot environment "test" of application "phonetool".
+    resource_file: templates/test.env.yml
+    parameter_file: parameters/test.env.params.json
+    deploy_method: stack_set
+    deployment_targets:
+      accounts:
+        - "111111111111"
+      organizational_units:
+        - ou-1234-abcd
+    regions:
+      - us-west-2
+`, string(actual))
+
+				exists, _ := afero.Exists(fs, "infrastructure/templates/env.addons.yml")
+				require.False(t, exists, "should not write addons artifacts without addons")
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,380 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	termcolor "github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	envRunLocalNamePrompt     = "Which environment would you like to run locally?"
+	envRunLocalNameHelpPrompt = "The services and jobs deployed to the environment will run on your machine."
+
+	// fmtLocalNetworkName is the name of the Docker network shared by the workloads of an environment run locally.
+	fmtLocalNetworkName = "copilot-%s-%s"
+	// fmtDefaultServiceDiscoveryEndpoint is the service discovery namespace of an environment, if its workloads don't say otherwise.
+	fmtDefaultServiceDiscoveryEndpoint = "%s.%s.local"
+	envVarServiceDiscoveryEndpoint     = "COPILOT_SERVICE_DISCOVERY_ENDPOINT"
+)
+
+type runLocalEnvVars struct {
+	appName    string
+	name       string
+	workloads  []string
+	logsFormat string
+}
+
+type runLocalEnvOpts struct {
+	runLocalEnvVars
+
+	store        store
+	deployStore  deployedEnvironmentLister
+	sel          appEnvSelector
+	dockerEngine dockerNetworkManager
+	prog         progress
+	targetApp    *config.Application
+	targetEnv    *config.Environment
+
+	// newWkldOpts returns the options to run a deployed workload of the environment locally.
+	newWkldOpts func(wkld *config.Workload) (*runLocalOpts, error)
+}
+
+// envLocalWorkload is a workload of the environment that is ready to run locally.
+type envLocalWorkload struct {
+	name string
+	opts *runLocalOpts
+	*localWorkload
+}
+
+func newRunLocalEnvOpts(vars runLocalEnvVars) (*runLocalEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env run local"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), sdkssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
+	opts := &runLocalEnvOpts{
+		runLocalEnvVars: vars,
+		store:           store,
+		deployStore:     deployStore,
+		sel:             selector.NewAppEnvSelector(prompt.New(), store),
+		dockerEngine:    dockerengine.New(exec.NewCmd()),
+		prog:            termprogress.NewSpinner(log.DiagnosticWriter),
+	}
+	newColor := termcolor.ColorGenerator()
+	opts.newWkldOpts = func(wkld *config.Workload) (*runLocalOpts, error) {
+		wkldOpts, err := newRunLocalOpts(runLocalVars{
+			wkldName:   wkld.Name,
+			wkldType:   wkld.Type,
+			appName:    opts.appName,
+			envName:    opts.name,
+			logsFormat: opts.logsFormat,
+		})
+		if err != nil {
+			return nil, err
+		}
+		wkldOpts.targetApp = opts.targetApp
+		wkldOpts.targetEnv = opts.targetEnv
+		wkldOpts.containerSuffix = wkldOpts.getContainerSuffix()
+		wkldOpts.network = opts.networkName()
+		// Share the colors between the workloads, so that the logs of each container have a different color.
+		wkldOpts.newColor = newColor
+		return wkldOpts, nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *runLocalEnvOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.logsFormat != "" && !contains(o.logsFormat, logsFormats) {
+		return fmt.Errorf("invalid logs format %q: must be one of %s",
+			o.logsFormat, english.WordSeries(applyAll(logsFormats, strconv.Quote), "or"))
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	o.targetApp = app
+	return nil
+}
+
+// Ask prompts for the environment if it's not provided, and validates it.
+func (o *runLocalEnvOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Environment(envRunLocalNamePrompt, envRunLocalNameHelpPrompt, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.name = name
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	o.targetEnv = env
+	return nil
+}
+
+// Execute builds and runs the workloads deployed to the environment locally, on a network where
+// they reach each other at their service discovery and service connect hostnames.
+func (o *runLocalEnvOpts) Execute() error {
+	deployed, err := o.deployedWorkloads()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	var wklds []envLocalWorkload
+	defer func() {
+		for _, wkld := range wklds {
+			wkld.close()
+		}
+	}()
+	for _, wkld := range deployed {
+		opts, err := o.newWkldOpts(wkld)
+		if err != nil {
+			return err
+		}
+		if err := opts.configureClients(opts); err != nil {
+			return fmt.Errorf("prepare %s to run locally: %w", wkld.Name, err)
+		}
+		prepared, err := opts.prepare(ctx)
+		if err != nil {
+			return fmt.Errorf("prepare %s to run locally: %w", wkld.Name, err)
+		}
+		opts.networkAliases = workloadHostnames(wkld.Name, o.name, o.appName, prepared.envVars[wkld.Name], prepared.serviceConnect)
+		wklds = append(wklds, envLocalWorkload{
+			name:          wkld.Name,
+			opts:          opts,
+			localWorkload: prepared,
+		})
+	}
+	allocateHostPorts(wklds)
+
+	network := o.networkName()
+	if err := o.dockerEngine.CreateNetwork(network); err != nil {
+		return fmt.Errorf("create network %s: %w", network, err)
+	}
+	for _, wkld := range wklds {
+		log.Infof("%s is reachable from the other workloads at %s%s.\n", wkld.name,
+			english.WordSeries(applyAll(wkld.opts.networkAliases, termcolor.HighlightResource), "and"), publishedPortsMessage(wkld.ports))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	g, ctx := errgroup.WithContext(ctx)
+	gotSigInt := &atomic.Bool{}
+	var running sync.WaitGroup
+	for _, wkld := range wklds {
+		wkld := wkld
+		running.Add(1)
+		g.Go(func() error {
+			defer running.Done()
+			if err := wkld.opts.run(ctx, g, wkld.localWorkload, gotSigInt); err != nil {
+				return fmt.Errorf("run %s: %w", wkld.name, err)
+			}
+			return nil
+		})
+	}
+	go func() {
+		// A workload whose containers exit successfully, like a job, doesn't stop the other workloads.
+		running.Wait()
+		cancel()
+	}()
+
+	g.Go(func() error {
+		waitForInterrupt(ctx, gotSigInt, func() {})
+		return o.cleanUp(wklds)
+	})
+
+	return g.Wait()
+}
+
+// deployedWorkloads returns the workloads deployed to the environment, sorted by name.
+// If workloads are passed by flag, it only returns them.
+func (o *runLocalEnvOpts) deployedWorkloads() ([]*config.Workload, error) {
+	svcs, err := o.deployStore.ListDeployedServices(o.appName, o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed to environment %s: %w", o.name, err)
+	}
+	jobs, err := o.deployStore.ListDeployedJobs(o.appName, o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs deployed to environment %s: %w", o.name, err)
+	}
+	deployed := make(map[string]bool)
+	for _, name := range append(svcs, jobs...) {
+		deployed[name] = true
+	}
+	for _, name := range o.workloads {
+		if !deployed[name] {
+			return nil, fmt.Errorf("workload %s is not deployed to environment %s", name, o.name)
+		}
+	}
+	all, err := o.store.ListWorkloads(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in application %s: %w", o.appName, err)
+	}
+	var wklds []*config.Workload
+	for _, wkld := range all {
+		if !deployed[wkld.Name] || (len(o.workloads) > 0 && !contains(wkld.Name, o.workloads)) {
+			continue
+		}
+		wklds = append(wklds, wkld)
+	}
+	if len(wklds) == 0 {
+		return nil, fmt.Errorf("no workloads are deployed to environment %s", o.name)
+	}
+	sort.Slice(wklds, func(i, j int) bool {
+		return wklds[i].Name < wklds[j].Name
+	})
+	return wklds, nil
+}
+
+func (o *runLocalEnvOpts) networkName() string {
+	return fmt.Sprintf(fmtLocalNetworkName, o.appName, o.name)
+}
+
+// cleanUp stops and removes the containers of the workloads, then the network they share.
+func (o *runLocalEnvOpts) cleanUp(wklds []envLocalWorkload) error {
+	var errs []error
+	for _, wkld := range wklds {
+		if err := wkld.opts.cleanUpContainers(context.Background(), wkld.containerURIs); err != nil {
+			errs = append(errs, fmt.Errorf("clean up %s: %w", wkld.name, err))
+		}
+	}
+	network := o.networkName()
+	o.prog.Start(fmt.Sprintf("Removing network %q", network))
+	if err := o.dockerEngine.RemoveNetwork(network); err != nil {
+		o.prog.Stop(log.Serrorf("Failed to remove network %q\n", network))
+		errs = append(errs, fmt.Errorf("remove network %s: %w", network, err))
+	} else {
+		o.prog.Stop(log.Ssuccessf("Removed network %q\n", network))
+	}
+	return errors.Join(errs...)
+}
+
+// workloadHostnames returns the hostnames that the other workloads of the environment connect to the workload with:
+// its service discovery name, and the DNS names of its Service Connect client aliases.
+func workloadHostnames(wkld, env, app string, mainContainerEnv containerEnv, serviceConnect []serviceConnectEndpoint) []string {
+	namespace := mainContainerEnv[envVarServiceDiscoveryEndpoint].Value
+	if namespace == "" {
+		namespace = fmt.Sprintf(fmtDefaultServiceDiscoveryEndpoint, env, app)
+	}
+	hosts := []string{fmt.Sprintf("%s.%s", wkld, namespace)}
+	seen := map[string]bool{hosts[0]: true}
+	for _, endpoint := range serviceConnect {
+		if seen[endpoint.host] {
+			continue
+		}
+		seen[endpoint.host] = true
+		hosts = append(hosts, endpoint.host)
+	}
+	return hosts
+}
+
+// allocateHostPorts publishes the ports of the workloads on distinct ports of localhost.
+// A port that an earlier workload already publishes is published on the next free port instead.
+func allocateHostPorts(wklds []envLocalWorkload) {
+	used := make(map[int]string) // Host port to the workload that publishes it.
+	for _, wkld := range wklds {
+		ctrPorts := make([]string, 0, len(wkld.ports))
+		for ctr := range wkld.ports {
+			ctrPorts = append(ctrPorts, ctr)
+		}
+		sort.Slice(ctrPorts, func(i, j int) bool {
+			a, _ := strconv.Atoi(ctrPorts[i])
+			b, _ := strconv.Atoi(ctrPorts[j])
+			return a < b
+		})
+		for _, ctr := range ctrPorts {
+			host, err := strconv.Atoi(wkld.ports[ctr])
+			if err != nil {
+				continue
+			}
+			port := host
+			for used[port] != "" {
+				port++
+			}
+			if port != host {
+				log.Infof("Publishing port %s of %s on localhost:%d, since %s already publishes localhost:%d.\n", ctr, wkld.name, port, used[host], host)
+				wkld.ports[ctr] = strconv.Itoa(port)
+			}
+			used[port] = wkld.name
+		}
+	}
+}
+
+// publishedPortsMessage returns the part of a sentence that lists the ports of localhost that a workload is published on.
+func publishedPortsMessage(ports map[string]string) string {
+	if len(ports) == 0 {
+		return ""
+	}
+	var hosts []string
+	for _, host := range ports {
+		hosts = append(hosts, "localhost:"+host)
+	}
+	sort.Strings(hosts)
+	return fmt.Sprintf(", and published on %s", strings.Join(hosts, ", "))
+}
+
+// buildEnvRunLocalCmd builds the command to run the workloads deployed to an environment locally.
+func buildEnvRunLocalCmd() *cobra.Command {
+	vars := runLocalEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "run local",
+		Short: "Run the workloads deployed to an environment locally.",
+		Long: `Run the workloads deployed to an environment locally.
+The workloads share a Docker network where they reach each other at their service discovery
+and service connect hostnames, and their ports are published on distinct ports of localhost.`,
+		Example: `
+  Run all the services and jobs deployed to the "test" environment.
+  /code $ copilot env run local --name test
+  Only run the services "api" and "worker", and write the logs of their containers to files.
+  /code $ copilot env run local -n test --workloads api,worker --logs-format file`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunLocalEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.workloads, workloadsFlag, nil, envRunLocalWorkloadsFlagDescription)
+	cmd.Flags().StringVar(&vars.logsFormat, logsFormatFlag, logsFormatRaw, logsFormatFlagDescription)
+	return cmd
+}
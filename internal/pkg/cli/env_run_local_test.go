@@ -0,0 +1,294 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type runLocalEnvMocks struct {
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedEnvironmentLister
+	sel         *mocks.MockappEnvSelector
+}
+
+func TestRunLocalEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName    string
+		inLogsFormat string
+		callMocks    func(m runLocalEnvMocks)
+
+		wantedErr error
+	}{
+		"error if no application in the workspace": {
+			callMocks: func(m runLocalEnvMocks) {},
+			wantedErr: errNoAppInWorkspace,
+		},
+		"error if the logs format is invalid": {
+			inAppName:    "my-app",
+			inLogsFormat: "yaml",
+			callMocks:    func(m runLocalEnvMocks) {},
+			wantedErr:    errors.New(`invalid logs format "yaml": must be one of "raw", "json-pretty" or "file"`),
+		},
+		"error if the application can't be retrieved": {
+			inAppName: "my-app",
+			callMocks: func(m runLocalEnvMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application my-app: some error"),
+		},
+		"success": {
+			inAppName: "my-app",
+			callMocks: func(m runLocalEnvMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := runLocalEnvMocks{
+				store: mocks.NewMockstore(ctrl),
+			}
+			tc.callMocks(m)
+			opts := &runLocalEnvOpts{
+				runLocalEnvVars: runLocalEnvVars{
+					appName:    tc.inAppName,
+					logsFormat: tc.inLogsFormat,
+				},
+				store: m.store,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "my-app", opts.targetApp.Name)
+		})
+	}
+}
+
+func TestRunLocalEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName    string
+		callMocks func(m runLocalEnvMocks)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"error if fail to select the environment": {
+			callMocks: func(m runLocalEnvMocks) {
+				m.sel.EXPECT().Environment(envRunLocalNamePrompt, envRunLocalNameHelpPrompt, "my-app").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select environment: some error"),
+		},
+		"error if the environment doesn't exist": {
+			inName: "test",
+			callMocks: func(m runLocalEnvMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get environment test configuration: some error"),
+		},
+		"prompts for the environment": {
+			callMocks: func(m runLocalEnvMocks) {
+				m.sel.EXPECT().Environment(envRunLocalNamePrompt, envRunLocalNameHelpPrompt, "my-app").Return("test", nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+			wantedName: "test",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := runLocalEnvMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockappEnvSelector(ctrl),
+			}
+			tc.callMocks(m)
+			opts := &runLocalEnvOpts{
+				runLocalEnvVars: runLocalEnvVars{
+					appName: "my-app",
+					name:    tc.inName,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+			require.Equal(t, tc.wantedName, opts.targetEnv.Name)
+		})
+	}
+}
+
+func TestRunLocalEnvOpts_Execute(t *testing.T) {
+	testWorkloads := []*config.Workload{
+		{Name: "fe", Type: "Load Balanced Web Service"},
+		{Name: "api", Type: "Backend Service"},
+		{Name: "report", Type: "Scheduled Job"},
+		{Name: "draft", Type: "Backend Service"},
+	}
+	testCases := map[string]struct {
+		inWorkloads []string
+		callMocks   func(m runLocalEnvMocks)
+
+		wantedPrepared []string
+		wantedErr      error
+	}{
+		"error if fail to list the deployed services": {
+			callMocks: func(m runLocalEnvMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list services deployed to environment test: some error"),
+		},
+		"error if a workload of the flag isn't deployed": {
+			inWorkloads: []string{"api", "draft"},
+			callMocks: func(m runLocalEnvMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"api", "fe"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("my-app", "test").Return(nil, nil)
+			},
+			wantedErr: errors.New("workload draft is not deployed to environment test"),
+		},
+		"error if no workload is deployed": {
+			callMocks: func(m runLocalEnvMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("my-app", "test").Return(nil, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(testWorkloads, nil)
+			},
+			wantedErr: errors.New("no workloads are deployed to environment test"),
+		},
+		"prepares the deployed workloads in the order of their names": {
+			callMocks: func(m runLocalEnvMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"fe", "api"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("my-app", "test").Return([]string{"report"}, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(testWorkloads, nil)
+			},
+			wantedPrepared: []string{"api"},
+			wantedErr:      errors.New("prepare api to run locally: some error"),
+		},
+		"only prepares the workloads of the flag": {
+			inWorkloads: []string{"report"},
+			callMocks: func(m runLocalEnvMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"fe", "api"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("my-app", "test").Return([]string{"report"}, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(testWorkloads, nil)
+			},
+			wantedPrepared: []string{"report"},
+			wantedErr:      errors.New("prepare report to run locally: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := runLocalEnvMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+			}
+			tc.callMocks(m)
+			var prepared []string
+			opts := &runLocalEnvOpts{
+				runLocalEnvVars: runLocalEnvVars{
+					appName:   "my-app",
+					name:      "test",
+					workloads: tc.inWorkloads,
+				},
+				store:       m.store,
+				deployStore: m.deployStore,
+				newWkldOpts: func(wkld *config.Workload) (*runLocalOpts, error) {
+					prepared = append(prepared, wkld.Name)
+					return &runLocalOpts{
+						configureClients: func(o *runLocalOpts) error {
+							return errors.New("some error")
+						},
+					}, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.EqualError(t, err, tc.wantedErr.Error())
+			require.Equal(t, tc.wantedPrepared, prepared)
+		})
+	}
+}
+
+func TestWorkloadHostnames(t *testing.T) {
+	t.Run("uses the service discovery endpoint of the container", func(t *testing.T) {
+		hosts := workloadHostnames("api", "test", "my-app", containerEnv{
+			envVarServiceDiscoveryEndpoint: {Value: "my-app.local"},
+		}, nil)
+
+		require.Equal(t, []string{"api.my-app.local"}, hosts)
+	})
+	t.Run("defaults to the namespace of the environment", func(t *testing.T) {
+		hosts := workloadHostnames("api", "test", "my-app", nil, nil)
+
+		require.Equal(t, []string{"api.test.my-app.local"}, hosts)
+	})
+	t.Run("adds the service connect aliases once", func(t *testing.T) {
+		hosts := workloadHostnames("api", "test", "my-app", nil, []serviceConnectEndpoint{
+			{host: "api", port: "80", targetPort: "8080"},
+			{host: "api", port: "8080", targetPort: "8080"},
+			{host: "backend", port: "80", targetPort: "8080"},
+		})
+
+		require.Equal(t, []string{"api.test.my-app.local", "api", "backend"}, hosts)
+	})
+}
+
+func TestAllocateHostPorts(t *testing.T) {
+	wklds := []envLocalWorkload{
+		{
+			name: "api",
+			localWorkload: &localWorkload{
+				ports: map[string]string{"8080": "8080", "9090": "9090"},
+			},
+		},
+		{
+			name: "fe",
+			localWorkload: &localWorkload{
+				ports: map[string]string{"80": "80", "8080": "8080"},
+			},
+		},
+		{
+			name: "worker",
+			localWorkload: &localWorkload{
+				ports: map[string]string{"8080": "8080"},
+			},
+		},
+	}
+
+	allocateHostPorts(wklds)
+
+	require.Equal(t, map[string]string{"8080": "8080", "9090": "9090"}, wklds[0].ports)
+	require.Equal(t, map[string]string{"80": "80", "8080": "8081"}, wklds[1].ports)
+	require.Equal(t, map[string]string{"8080": "8082"}, wklds[2].ports)
+}
@@ -33,22 +33,24 @@ type showEnvVars struct {
 	appName               string
 	name                  string
 	shouldOutputJSON      bool
+	outputFormat          outputFormatVars
 	shouldOutputResources bool
 	shouldOutputManifest  bool
+	shouldOutputCapacity  bool
 }
 
 type showEnvOpts struct {
 	showEnvVars
 
-	w                io.Writer
-	store            store
-	describer        envDescriber
-	sel              configSelector
-	initEnvDescriber func() error
+	w                 io.Writer
+	store             store
+	describer         envDescriber
+	capacityDescriber envCapacityDescriber
+	sel               configSelector
+	initEnvDescriber  func() error
 }
 
-func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env show"))
+func newShowEnvOpts(vars showEnvVars, sessProvider *sessions.Provider) (*showEnvOpts, error) {
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
@@ -73,11 +75,13 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 			ConfigStore:     store,
 			DeployStore:     deployStore,
 			EnableResources: opts.shouldOutputResources,
+			SessionProvider: sessProvider,
 		})
 		if err != nil {
 			return fmt.Errorf("creating describer for environment %s in application %s: %w", opts.name, opts.appName, err)
 		}
 		opts.describer = d
+		opts.capacityDescriber = d
 		return nil
 	}
 	return opts, nil
@@ -85,7 +89,7 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 
 // Validate returns an error if any optional flags are invalid.
 func (o *showEnvOpts) Validate() error {
-	return nil
+	return o.outputFormat.validate()
 }
 
 // Ask validates required fields that users passed in, otherwise it prompts for them.
@@ -104,20 +108,25 @@ func (o *showEnvOpts) Execute() error {
 	if o.shouldOutputManifest {
 		return o.writeManifest()
 	}
+	if o.shouldOutputCapacity {
+		return o.writeCapacity()
+	}
 
 	env, err := o.describer.Describe()
 	if err != nil {
 		return fmt.Errorf("describe environment %s: %w", o.name, err)
 	}
-	content := env.HumanString()
-	if o.shouldOutputJSON {
-		data, err := env.JSONString()
-		if err != nil {
-			return err
-		}
-		content = data
+	if o.shouldOutputJSON || o.outputFormat.isSet() {
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			data, err := env.JSONString()
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(w, data)
+			return nil
+		})
 	}
-	fmt.Fprint(o.w, content)
+	fmt.Fprint(o.w, env.HumanString())
 	return nil
 }
 
@@ -168,6 +177,25 @@ func (o *showEnvOpts) writeManifest() error {
 	return nil
 }
 
+func (o *showEnvOpts) writeCapacity() error {
+	capacity, err := o.capacityDescriber.Capacity()
+	if err != nil {
+		return fmt.Errorf("describe capacity of environment %s: %w", o.name, err)
+	}
+	if o.shouldOutputJSON || o.outputFormat.isSet() {
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			data, err := capacity.JSONString()
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(w, data)
+			return nil
+		})
+	}
+	fmt.Fprint(o.w, capacity.HumanString())
+	return nil
+}
+
 // buildEnvShowCmd builds the command for showing environments in an application.
 func buildEnvShowCmd() *cobra.Command {
 	vars := showEnvVars{}
@@ -180,9 +208,11 @@ func buildEnvShowCmd() *cobra.Command {
   Print configuration for the "test" environment.
   /code $ copilot env show -n test
   Print manifest file for deploying the "prod" environment.
-  /code $ copilot env show -n prod --manifest`,
+  /code $ copilot env show -n prod --manifest
+  Check whether the subnets of the "prod" environment have enough IP addresses for its services to scale out.
+  /code $ copilot env show -n prod --capacity`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newShowEnvOpts(vars)
+			opts, err := newShowEnvOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("env show")))
 			if err != nil {
 				return err
 			}
@@ -192,10 +222,17 @@ func buildEnvShowCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.format, formatFlag, "", formatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.query, queryFlag, "", queryFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, envResourcesFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputManifest, manifestFlag, false, manifestFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputCapacity, capacityFlag, false, envCapacityFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(jsonFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(formatFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(queryFlag, manifestFlag)
 	cmd.MarkFlagsMutuallyExclusive(resourcesFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(capacityFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(capacityFlag, resourcesFlag)
 	return cmd
 }
@@ -21,9 +21,10 @@ import (
 )
 
 type showEnvMocks struct {
-	storeSvc  *mocks.Mockstore
-	describer *mocks.MockenvDescriber
-	sel       *mocks.MockconfigSelector
+	storeSvc          *mocks.Mockstore
+	describer         *mocks.MockenvDescriber
+	capacityDescriber *mocks.MockenvCapacityDescriber
+	sel               *mocks.MockconfigSelector
 }
 
 func TestEnvShow_Ask(t *testing.T) {
@@ -193,7 +194,9 @@ func TestEnvShow_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		inputEnv             string
 		shouldOutputJSON     bool
+		outputFormat         outputFormatVars
 		shouldOutputManifest bool
+		shouldOutputCapacity bool
 
 		setupMocks func(mocks showEnvMocks)
 
@@ -263,6 +266,24 @@ Resources
 
 			wantedContent: "{\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"jobs\":[{\"app\":\"testApp\",\"name\":\"testJob1\",\"type\":\"Scheduled Job\"},{\"app\":\"testApp\",\"name\":\"testJob2\",\"type\":\"Scheduled Job\"}],\"tags\":{\"copilot-application\":\"testApp\",\"copilot-environment\":\"testEnv\",\"key1\":\"value1\",\"key2\":\"value2\"},\"resources\":[{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"testApp-testEnv-CFNExecutionRole\"},{\"type\":\"testApp-testEnv-Cluster\",\"physicalID\":\"AWS::ECS::Cluster-jI63pYBWU6BZ\"}],\"environmentVPC\":{\"id\":\"\",\"publicSubnetIDs\":null,\"privateSubnetIDs\":null}}\n",
 		},
+		"should print JSON formatted with a template": {
+			inputEnv:     "testEnv",
+			outputFormat: outputFormatVars{format: "{{.environment.name}} {{.environment.region}}"},
+			setupMocks: func(m showEnvMocks) {
+				m.describer.EXPECT().Describe().Return(&mockEnvDescription, nil)
+			},
+
+			wantedContent: "testEnv us-west-2\n",
+		},
+		"should print the result of a query": {
+			inputEnv:     "testEnv",
+			outputFormat: outputFormatVars{query: "services[].name"},
+			setupMocks: func(m showEnvMocks) {
+				m.describer.EXPECT().Describe().Return(&mockEnvDescription, nil)
+			},
+
+			wantedContent: "[\n  \"testSvc1\",\n  \"testSvc2\",\n  \"testSvc3\"\n]\n",
+		},
 		"should print manifest file": {
 			inputEnv:             "testEnv",
 			shouldOutputManifest: true,
@@ -272,6 +293,33 @@ Resources
 
 			wantedContent: "hello\n",
 		},
+		"return error if fail to describe the capacity of the env": {
+			inputEnv:             "testEnv",
+			shouldOutputCapacity: true,
+			setupMocks: func(m showEnvMocks) {
+				m.capacityDescriber.EXPECT().Capacity().Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("describe capacity of environment testEnv: some error"),
+		},
+		"should print the capacity of the env in JSON format": {
+			inputEnv:             "testEnv",
+			shouldOutputJSON:     true,
+			shouldOutputCapacity: true,
+			setupMocks: func(m showEnvMocks) {
+				m.capacityDescriber.EXPECT().Capacity().Return(&describe.EnvCapacity{
+					Subnets: []*describe.SubnetCapacity{
+						{ID: "subnet-1", Type: "public", AvailabilityZone: "us-west-2a", CIDRBlock: "10.0.0.0/24", TotalIPs: 251, AvailableIPs: 240},
+					},
+					OtherENIs: 11,
+					Headroom: []*describe.SubnetsHeadroom{
+						{Subnets: "public", AvailableIPs: 240, Headroom: 240},
+					},
+				}, nil)
+			},
+
+			wantedContent: `{"subnets":[{"id":"subnet-1","type":"public","availabilityZone":"us-west-2a","cidrBlock":"10.0.0.0/24","totalIPs":251,"availableIPs":240}],"services":null,"otherENIs":11,"headroom":[{"subnets":"public","availableIPs":240,"scaleOutIPs":0,"headroom":240}]}` + "\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -282,9 +330,11 @@ Resources
 			b := &bytes.Buffer{}
 			mockStoreReader := mocks.NewMockstore(ctrl)
 			mockEnvDescriber := mocks.NewMockenvDescriber(ctrl)
+			mockCapacityDescriber := mocks.NewMockenvCapacityDescriber(ctrl)
 
 			mocks := showEnvMocks{
-				describer: mockEnvDescriber,
+				describer:         mockEnvDescriber,
+				capacityDescriber: mockCapacityDescriber,
 			}
 
 			tc.setupMocks(mocks)
@@ -293,12 +343,15 @@ Resources
 				showEnvVars: showEnvVars{
 					name:                 tc.inputEnv,
 					shouldOutputJSON:     tc.shouldOutputJSON,
+					outputFormat:         tc.outputFormat,
 					shouldOutputManifest: tc.shouldOutputManifest,
+					shouldOutputCapacity: tc.shouldOutputCapacity,
 				},
-				store:            mockStoreReader,
-				describer:        mockEnvDescriber,
-				initEnvDescriber: func() error { return nil },
-				w:                b,
+				store:             mockStoreReader,
+				describer:         mockEnvDescriber,
+				capacityDescriber: mockCapacityDescriber,
+				initEnvDescriber:  func() error { return nil },
+				w:                 b,
 			}
 
 			// WHEN
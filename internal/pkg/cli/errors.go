@@ -6,11 +6,30 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/dustin/go-humanize/english"
 )
 
+type errSvcHasDependents struct {
+	name       string
+	dependents []svcDependent
+}
+
+func (e *errSvcHasDependents) Error() string {
+	return fmt.Sprintf("service %s has %d %s", e.name, len(e.dependents), english.PluralWord(len(e.dependents), "dependent", "dependents"))
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+func (e *errSvcHasDependents) RecommendActions() string {
+	return fmt.Sprintf(`The following workloads depend on service %s:
+%s
+Remove the references from these workloads first, or run %s to delete the service anyway.`,
+		e.name, strings.TrimSuffix(fmtSvcDependents(e.dependents), "\n"), color.HighlightCode(fmt.Sprintf("copilot svc delete --name %s --force", e.name)))
+}
+
 type errCannotDowngradePipelineVersion struct {
 	name            string
 	version         string
@@ -34,6 +53,24 @@ func (e *errCannotDowngradePipelineVersion) RecommendActions() string {
 	return e.init().RecommendActions()
 }
 
+type errOverlappingPipelines struct {
+	pipeline string
+	other    string
+	stacks   []string
+}
+
+func (e *errOverlappingPipelines) Error() string {
+	return fmt.Sprintf("pipelines %s and %s both deploy %s %s", e.pipeline, e.other,
+		english.PluralWord(len(e.stacks), "stack", "stacks"), english.WordSeries(e.stacks, "and"))
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+func (e *errOverlappingPipelines) RecommendActions() string {
+	return fmt.Sprintf(`Each stack must be deployed by a single pipeline, so that the pipelines don't override each other's deployments.
+List the workloads that each pipeline deploys under %s in their manifests, or the %s of their stages.`,
+		color.HighlightCode("filters.workloads"), color.HighlightCode("deployments"))
+}
+
 type errCannotDowngradeWkldVersion struct {
 	name            string
 	version         string
@@ -163,3 +200,19 @@ func (e *errPipelineDependsOnEnv) RecommendActions() string {
 or run %s to delete the pipeline before running %s to delete the environment`,
 		e.pipeline, e.env, color.HighlightCode(fmt.Sprintf("copilot pipeline delete -n %s", e.pipeline)), color.HighlightCode(fmt.Sprintf("copilot env delete -n %s", e.env)))
 }
+
+type errAlarmRolledBack struct {
+	svc   string
+	alarm cloudwatch.AlarmStatus
+}
+
+func (e *errAlarmRolledBack) Error() string {
+	return fmt.Sprintf("alarm %s of service %s went into the %s state after the deployment", e.alarm.Name, e.svc, e.alarm.Status)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+func (e *errAlarmRolledBack) RecommendActions() string {
+	return fmt.Sprintf(`The service was rolled back to the configuration it had before the deployment.
+Run %s to inspect the logs of the service, and %s to see the state of its alarms.`,
+		color.HighlightCode(fmt.Sprintf("copilot svc logs --name %s", e.svc)), color.HighlightCode(fmt.Sprintf("copilot svc status --name %s", e.svc)))
+}
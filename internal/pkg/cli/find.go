@@ -0,0 +1,476 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourceexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	findSourceWorkload    = "workload"
+	findSourceEnvironment = "environment"
+)
+
+type findVars struct {
+	appName          string
+	term             string
+	shouldOutputJSON bool
+	live             bool
+}
+
+type findOpts struct {
+	findVars
+
+	store           environmentLister
+	ws              wsManifestReader
+	w               io.Writer
+	newInterpolator func(app, env string) interpolator
+
+	// Dependencies to search the live resources of the application.
+	resourceSearcher      resourceSearcher
+	newResourceTagsGetter func(region string) (resourceTagsGetter, error)
+}
+
+func newFindOpts(vars findVars) (*findOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("find"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	return &findOpts{
+		findVars: vars,
+		store:    config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region)),
+		ws:       ws,
+		w:        os.Stdout,
+		newInterpolator: func(app, env string) interpolator {
+			return manifest.NewInterpolator(app, env)
+		},
+		resourceSearcher: resourceexplorer.New(defaultSess),
+		newResourceTagsGetter: func(region string) (resourceTagsGetter, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, err
+			}
+			return resourcegroups.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the search term is empty or the application name is missing.
+func (o *findOpts) Validate() error {
+	if strings.TrimSpace(o.term) == "" {
+		return errors.New("search term must not be empty")
+	}
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	return nil
+}
+
+// Execute searches the manifests of the workspace, with the overrides of each environment applied,
+// and writes the fields that reference the search term.
+func (o *findOpts) Execute() error {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	var refs findReferences
+	wklds, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	for _, wkld := range wklds {
+		raw, err := o.ws.ReadWorkloadManifest(wkld)
+		if err != nil {
+			return fmt.Errorf("read manifest file for %s: %w", wkld, err)
+		}
+		for _, env := range envs {
+			node, err := o.workloadManifestNode(wkld, env.Name, raw)
+			if err != nil {
+				return err
+			}
+			refs.add(findSourceWorkload, wkld, env.Name, node, o.term)
+		}
+	}
+	wsEnvs, err := o.ws.ListEnvironments()
+	if err != nil {
+		return fmt.Errorf("list environments in the workspace: %w", err)
+	}
+	for _, env := range wsEnvs {
+		node, err := o.envManifestNode(env)
+		if err != nil {
+			return err
+		}
+		refs.add(findSourceEnvironment, env, env, node, o.term)
+	}
+	var liveResources []*findLiveResource
+	if o.live {
+		if liveResources, err = o.searchLiveResources(); err != nil {
+			return err
+		}
+	}
+
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			References    []*findReference    `json:"references"`
+			LiveResources []*findLiveResource `json:"liveResources,omitempty"`
+		}{
+			References:    refs.sorted(),
+			LiveResources: liveResources,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal references: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", data)
+		return nil
+	}
+	writeFindReferences(o.w, o.term, refs.sorted())
+	if o.live {
+		fmt.Fprintln(o.w)
+		writeFindLiveResources(o.w, o.term, liveResources)
+	}
+	return nil
+}
+
+// searchLiveResources returns the resources tagged with the application that AWS Resource Explorer finds for the search term,
+// along with the stack that owns each of them. Resources without a stack weren't created by CloudFormation.
+func (o *findOpts) searchLiveResources() ([]*findLiveResource, error) {
+	resources, err := o.resourceSearcher.Search(fmt.Sprintf("tag:%s=%s %s", deploy.AppTagKey, o.appName, o.term))
+	if err != nil {
+		return nil, fmt.Errorf("search AWS Resource Explorer: %w", err)
+	}
+	arnsByRegion := make(map[string][]string)
+	var regions []string
+	for _, resource := range resources {
+		if resource.Region == "" || resource.Region == resourceexplorer.RegionGlobal {
+			// The Resource Groups Tagging API is regional.
+			continue
+		}
+		if _, ok := arnsByRegion[resource.Region]; !ok {
+			regions = append(regions, resource.Region)
+		}
+		arnsByRegion[resource.Region] = append(arnsByRegion[resource.Region], resource.ARN)
+	}
+	tagsByARN := make(map[string]map[string]string)
+	for _, region := range regions {
+		getter, err := o.newResourceTagsGetter(region)
+		if err != nil {
+			return nil, fmt.Errorf("create Resource Groups Tagging API client in region %s: %w", region, err)
+		}
+		tagged, err := getter.GetResourcesByARNs(arnsByRegion[region])
+		if err != nil {
+			return nil, fmt.Errorf("get tags of resources in region %s: %w", region, err)
+		}
+		for _, resource := range tagged {
+			tagsByARN[resource.ARN] = resource.Tags
+		}
+	}
+	liveResources := make([]*findLiveResource, len(resources))
+	for i, resource := range resources {
+		tags := tagsByARN[resource.ARN]
+		liveResources[i] = &findLiveResource{
+			ARN:         resource.ARN,
+			Type:        resource.Type,
+			Region:      resource.Region,
+			Environment: tags[deploy.EnvTagKey],
+			Workload:    tags[deploy.ServiceTagKey],
+			Stack:       tags[stack.StackNameTagKey],
+		}
+	}
+	sort.SliceStable(liveResources, func(i, j int) bool {
+		return liveResources[i].ARN < liveResources[j].ARN
+	})
+	return liveResources, nil
+}
+
+func (o *findOpts) workloadManifestNode(name, env string, raw []byte) (*yaml.Node, error) {
+	interpolated, err := o.newInterpolator(o.appName, env).Interpolate(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", name, err)
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(interpolated), &node); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for %s: %w", name, err)
+	}
+	applyEnvOverrideNode(&node, env)
+	return &node, nil
+}
+
+func (o *findOpts) envManifestNode(name string) (*yaml.Node, error) {
+	raw, err := o.ws.ReadEnvironmentManifest(name)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest file for environment %s: %w", name, err)
+	}
+	interpolated, err := o.newInterpolator(o.appName, name).Interpolate(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for environment %s manifest: %w", name, err)
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(interpolated), &node); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for environment %s: %w", name, err)
+	}
+	return &node, nil
+}
+
+// applyEnvOverrideNode removes the "environments" field of a workload manifest and merges the override
+// of the environment into the rest of the manifest. Like the manifest's ApplyEnv, maps are merged
+// recursively and any other value is replaced.
+func applyEnvOverrideNode(doc *yaml.Node, env string) {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return
+	}
+	root := doc.Content[0]
+	var override *yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value != "environments" {
+			continue
+		}
+		override = mappingValue(root.Content[i+1], env)
+		root.Content = append(root.Content[:i:i], root.Content[i+2:]...)
+		break
+	}
+	if override != nil {
+		mergeYAMLNodes(root, override)
+	}
+}
+
+func mergeYAMLNodes(dst, src *yaml.Node) {
+	if dst.Kind != yaml.MappingNode || src.Kind != yaml.MappingNode {
+		*dst = *src
+		return
+	}
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+		if existing := mappingValue(dst, key.Value); existing != nil {
+			mergeYAMLNodes(existing, value)
+			continue
+		}
+		dst.Content = append(dst.Content, key, value)
+	}
+}
+
+// mappingValue returns the value of the key in a mapping node, or nil if the key doesn't exist.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// findReference is a manifest field that references the search term.
+type findReference struct {
+	Source       string   `json:"source"` // Either a workload or an environment.
+	Name         string   `json:"name"`
+	Field        string   `json:"field"`
+	Value        string   `json:"value"`
+	Environments []string `json:"environments"`
+}
+
+// findReferences groups the references to the search term that are the same across environments.
+type findReferences struct {
+	refs  []*findReference
+	index map[string]*findReference
+}
+
+func (r *findReferences) add(source, name, env string, node *yaml.Node, term string) {
+	walkYAMLScalars(node, "", func(field, value string) {
+		if !containsFold(field, term) && !containsFold(value, term) {
+			return
+		}
+		key := strings.Join([]string{source, name, field, value}, "\x00")
+		if r.index == nil {
+			r.index = make(map[string]*findReference)
+		}
+		ref, ok := r.index[key]
+		if !ok {
+			ref = &findReference{
+				Source: source,
+				Name:   name,
+				Field:  field,
+				Value:  value,
+			}
+			r.index[key] = ref
+			r.refs = append(r.refs, ref)
+		}
+		ref.Environments = append(ref.Environments, env)
+	})
+}
+
+func (r *findReferences) sorted() []*findReference {
+	refs := make([]*findReference, len(r.refs))
+	copy(refs, r.refs)
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].Source != refs[j].Source {
+			return refs[i].Source == findSourceWorkload
+		}
+		return refs[i].Name < refs[j].Name
+	})
+	return refs
+}
+
+// walkYAMLScalars calls fn with the dotted path and the value of every scalar in the node.
+func walkYAMLScalars(node *yaml.Node, path string, fn func(field, value string)) {
+	walkYAMLScalarNodes(node, path, func(field string, scalar *yaml.Node) {
+		fn(field, scalar.Value)
+	})
+}
+
+// walkYAMLScalarNodes calls fn with the dotted path and the node of every scalar in the node.
+func walkYAMLScalarNodes(node *yaml.Node, path string, fn func(field string, scalar *yaml.Node)) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			walkYAMLScalarNodes(child, path, fn)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			field := node.Content[i].Value
+			if path != "" {
+				field = path + "." + field
+			}
+			walkYAMLScalarNodes(node.Content[i+1], field, fn)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			walkYAMLScalarNodes(child, fmt.Sprintf("%s[%d]", path, i), fn)
+		}
+	case yaml.ScalarNode:
+		fn(path, node)
+	}
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// findLiveResource is a resource of the application that AWS Resource Explorer found for the search term.
+type findLiveResource struct {
+	ARN         string `json:"arn"`
+	Type        string `json:"type"`
+	Region      string `json:"region"`
+	Environment string `json:"environment,omitempty"`
+	Workload    string `json:"workload,omitempty"`
+	Stack       string `json:"stack,omitempty"` // Empty if the resource wasn't created by a CloudFormation stack.
+}
+
+func writeFindLiveResources(w io.Writer, term string, resources []*findLiveResource) {
+	if len(resources) == 0 {
+		fmt.Fprintf(w, "No live resources matching %q found.\n", term)
+		return
+	}
+	fmt.Fprintf(w, "Found %d live resources matching %q.\n\n", len(resources), term)
+	tw := tabwriter.NewWriter(w, 4, 4, 2, ' ', 0)
+	headers := []string{"ARN", "Type", "Region", "Environment", "Workload", "Stack"}
+	fmt.Fprintf(tw, "  %s\n", strings.Join(headers, "\t"))
+	separators := make([]string, len(headers))
+	for i, header := range headers {
+		separators[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(tw, "  %s\n", strings.Join(separators, "\t"))
+	var unowned bool
+	for _, resource := range resources {
+		owner := resource.Stack
+		if owner == "" {
+			owner = "-"
+			unowned = true
+		}
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\t%s\n", resource.ARN, resource.Type, resource.Region,
+			valueOrDash(resource.Environment), valueOrDash(resource.Workload), owner)
+	}
+	tw.Flush()
+	if unowned {
+		fmt.Fprintln(w, "\nResources without a stack weren't created by CloudFormation, for example they were created manually.")
+	}
+}
+
+func writeFindReferences(w io.Writer, term string, refs []*findReference) {
+	if len(refs) == 0 {
+		fmt.Fprintf(w, "No references to %q found.\n", term)
+		return
+	}
+	fmt.Fprintf(w, "Found %d references to %q.\n\n", len(refs), term)
+	tw := tabwriter.NewWriter(w, 4, 4, 2, ' ', 0)
+	headers := []string{"Name", "Type", "Environments", "Field", "Value"}
+	fmt.Fprintf(tw, "  %s\n", strings.Join(headers, "\t"))
+	separators := make([]string, len(headers))
+	for i, header := range headers {
+		separators[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(tw, "  %s\n", strings.Join(separators, "\t"))
+	for _, ref := range refs {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\n", ref.Name, ref.Source, strings.Join(ref.Environments, ", "), ref.Field, ref.Value)
+	}
+	tw.Flush()
+}
+
+// BuildFindCmd builds the command for searching the manifests of an application.
+func BuildFindCmd() *cobra.Command {
+	vars := findVars{}
+	cmd := &cobra.Command{
+		Use:   "find <term>",
+		Short: "Search the manifests of an application for a resource, variable, secret, alias or image.",
+		Long: `Search the manifests of an application for a resource, variable, secret, alias or image.
+The workload manifests are searched with the overrides of each environment in the application applied.`,
+		Example: `
+  Find the workloads and environments that reference the "db-password" secret.
+  /code $ copilot find db-password
+  Find the manifest fields that mention the "example.com" domain in JSON format.
+  /code $ copilot find example.com --json
+  Also find the live resources of the application that match "orders" with AWS Resource Explorer.
+  /code $ copilot find orders --live`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.term = args[0]
+			opts, err := newFindOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+		Annotations: map[string]string{
+			"group": group.Develop,
+		},
+	}
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.live, liveFlag, false, findLiveFlagDescription)
+	return cmd
+}
@@ -0,0 +1,305 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourceexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type findMocks struct {
+	store            *mocks.MockenvironmentLister
+	ws               *mocks.MockwsManifestReader
+	resourceSearcher *mocks.MockresourceSearcher
+	tagsGetter       *mocks.MockresourceTagsGetter
+}
+
+func TestFindOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp  string
+		inTerm string
+
+		wantedErr error
+	}{
+		"error if the term is empty": {
+			inApp:     "my-app",
+			inTerm:    "  ",
+			wantedErr: errors.New("search term must not be empty"),
+		},
+		"error if there is no application": {
+			inTerm:    "db-password",
+			wantedErr: errNoAppInWorkspace,
+		},
+		"valid": {
+			inApp:  "my-app",
+			inTerm: "db-password",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &findOpts{
+				findVars: findVars{
+					appName: tc.inApp,
+					term:    tc.inTerm,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFindOpts_Execute(t *testing.T) {
+	const apiManifest = `name: api
+type: Backend Service
+image:
+  location: nginx
+secrets:
+  DB_PASSWORD: /copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/db-password
+environments:
+  prod:
+    image:
+      location: nginx:1.25
+`
+	const workerManifest = `name: worker
+type: Worker Service
+image:
+  location: nginx
+variables:
+  LOG_LEVEL: info
+`
+	const prodManifest = `name: prod
+type: Environment
+http:
+  public:
+    certificates:
+      - arn:aws:acm:us-west-2:123456789012:certificate/db-password-is-not-a-cert
+`
+	testCases := map[string]struct {
+		inTerm           string
+		shouldOutputJSON bool
+		inLive           bool
+		setupMocks       func(m findMocks)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"error if fail to list environments": {
+			inTerm: "nginx",
+			setupMocks: func(m findMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments in application my-app: some error"),
+		},
+		"error if fail to read a workload manifest": {
+			inTerm: "nginx",
+			setupMocks: func(m findMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("read manifest file for api: some error"),
+		},
+		"group the references that are the same across environments": {
+			inTerm: "NGINX",
+			setupMocks: func(m findMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"worker", "api"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(apiManifest), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("worker").Return([]byte(workerManifest), nil)
+				m.ws.EXPECT().ListEnvironments().Return(nil, nil)
+			},
+			wantedOutput: `Found 3 references to "NGINX".
+
+  Name    Type      Environments  Field           Value
+  ----    ----      ------------  -----           -----
+  api     workload  test          image.location  nginx
+  api     workload  prod          image.location  nginx:1.25
+  worker  workload  test, prod    image.location  nginx
+`,
+		},
+		"search workload and environment manifests in JSON": {
+			inTerm:           "db-password",
+			shouldOutputJSON: true,
+			setupMocks: func(m findMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "prod"}}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(apiManifest), nil)
+				m.ws.EXPECT().ListEnvironments().Return([]string{"prod"}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("prod").Return([]byte(prodManifest), nil)
+			},
+			wantedOutput: `{"references":[{"source":"workload","name":"api","field":"secrets.DB_PASSWORD","value":"/copilot/my-app/prod/secrets/db-password","environments":["prod"]},{"source":"environment","name":"prod","field":"http.public.certificates[0]","value":"arn:aws:acm:us-west-2:123456789012:certificate/db-password-is-not-a-cert","environments":["prod"]}]}
+`,
+		},
+		"no references found": {
+			inTerm: "example.com",
+			setupMocks: func(m findMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"worker"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("worker").Return([]byte(workerManifest), nil)
+				m.ws.EXPECT().ListEnvironments().Return(nil, nil)
+			},
+			wantedOutput: "No references to \"example.com\" found.\n",
+		},
+		"error if fail to search AWS Resource Explorer": {
+			inTerm: "orders",
+			inLive: true,
+			setupMocks: func(m findMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.ws.EXPECT().ListWorkloads().Return(nil, nil)
+				m.ws.EXPECT().ListEnvironments().Return(nil, nil)
+				m.resourceSearcher.EXPECT().Search("tag:copilot-application=my-app orders").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("search AWS Resource Explorer: some error"),
+		},
+		"error if fail to get the tags of live resources": {
+			inTerm: "orders",
+			inLive: true,
+			setupMocks: func(m findMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.ws.EXPECT().ListWorkloads().Return(nil, nil)
+				m.ws.EXPECT().ListEnvironments().Return(nil, nil)
+				m.resourceSearcher.EXPECT().Search(gomock.Any()).Return([]*resourceexplorer.Resource{
+					{ARN: "arn:aws:dynamodb:us-west-2:123456789012:table/orders", Type: "dynamodb:table", Region: "us-west-2"},
+				}, nil)
+				m.tagsGetter.EXPECT().GetResourcesByARNs(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get tags of resources in region us-west-2: some error"),
+		},
+		"show the stack that owns each live resource": {
+			inTerm: "orders",
+			inLive: true,
+			setupMocks: func(m findMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"worker"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("worker").Return([]byte(workerManifest), nil)
+				m.ws.EXPECT().ListEnvironments().Return(nil, nil)
+				m.resourceSearcher.EXPECT().Search("tag:copilot-application=my-app orders").Return([]*resourceexplorer.Resource{
+					{ARN: "arn:aws:sqs:us-west-2:123456789012:orders-dlq", Type: "sqs:queue", Region: "us-west-2"},
+					{ARN: "arn:aws:iam::123456789012:role/my-app-test-orders-role", Type: "iam:role", Region: "global"},
+					{ARN: "arn:aws:dynamodb:us-west-2:123456789012:table/orders", Type: "dynamodb:table", Region: "us-west-2"},
+				}, nil)
+				m.tagsGetter.EXPECT().GetResourcesByARNs([]string{
+					"arn:aws:sqs:us-west-2:123456789012:orders-dlq",
+					"arn:aws:dynamodb:us-west-2:123456789012:table/orders",
+				}).Return([]*resourcegroups.Resource{
+					{
+						ARN: "arn:aws:dynamodb:us-west-2:123456789012:table/orders",
+						Tags: map[string]string{
+							"copilot-application":           "my-app",
+							"copilot-environment":           "test",
+							"copilot-service":               "worker",
+							"aws:cloudformation:stack-name": "my-app-test-worker-AddonsStack-1A2B3C",
+						},
+					},
+					{
+						ARN: "arn:aws:sqs:us-west-2:123456789012:orders-dlq",
+						Tags: map[string]string{
+							"copilot-application": "my-app",
+							"copilot-environment": "test",
+						},
+					},
+				}, nil)
+			},
+			wantedOutput: `No references to "orders" found.
+
+Found 3 live resources matching "orders".
+
+  ARN                                                     Type            Region     Environment  Workload  Stack
+  ---                                                     ----            ------     -----------  --------  -----
+  arn:aws:dynamodb:us-west-2:123456789012:table/orders    dynamodb:table  us-west-2  test         worker    my-app-test-worker-AddonsStack-1A2B3C
+  arn:aws:iam::123456789012:role/my-app-test-orders-role  iam:role        global     -            -         -
+  arn:aws:sqs:us-west-2:123456789012:orders-dlq           sqs:queue       us-west-2  test         -         -
+
+Resources without a stack weren't created by CloudFormation, for example they were created manually.
+`,
+		},
+		"live resources in JSON": {
+			inTerm:           "orders",
+			inLive:           true,
+			shouldOutputJSON: true,
+			setupMocks: func(m findMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.ws.EXPECT().ListWorkloads().Return(nil, nil)
+				m.ws.EXPECT().ListEnvironments().Return(nil, nil)
+				m.resourceSearcher.EXPECT().Search("tag:copilot-application=my-app orders").Return([]*resourceexplorer.Resource{
+					{ARN: "arn:aws:sqs:us-west-2:123456789012:orders-dlq", Type: "sqs:queue", Region: "us-west-2"},
+				}, nil)
+				m.tagsGetter.EXPECT().GetResourcesByARNs([]string{"arn:aws:sqs:us-west-2:123456789012:orders-dlq"}).Return([]*resourcegroups.Resource{
+					{
+						ARN: "arn:aws:sqs:us-west-2:123456789012:orders-dlq",
+						Tags: map[string]string{
+							"copilot-environment":           "test",
+							"aws:cloudformation:stack-name": "my-app-test",
+						},
+					},
+				}, nil)
+			},
+			wantedOutput: `{"references":[],"liveResources":[{"arn":"arn:aws:sqs:us-west-2:123456789012:orders-dlq","type":"sqs:queue","region":"us-west-2","environment":"test","stack":"my-app-test"}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := findMocks{
+				store:            mocks.NewMockenvironmentLister(ctrl),
+				ws:               mocks.NewMockwsManifestReader(ctrl),
+				resourceSearcher: mocks.NewMockresourceSearcher(ctrl),
+				tagsGetter:       mocks.NewMockresourceTagsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &strings.Builder{}
+			opts := &findOpts{
+				findVars: findVars{
+					appName:          "my-app",
+					term:             tc.inTerm,
+					shouldOutputJSON: tc.shouldOutputJSON,
+					live:             tc.inLive,
+				},
+				store: m.store,
+				ws:    m.ws,
+				w:     b,
+				newInterpolator: func(app, env string) interpolator {
+					return manifest.NewInterpolator(app, env)
+				},
+				resourceSearcher: m.resourceSearcher,
+				newResourceTagsGetter: func(region string) (resourceTagsGetter, error) {
+					require.Equal(t, "us-west-2", region)
+					return m.tagsGetter, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
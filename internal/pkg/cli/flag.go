@@ -9,35 +9,64 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/topology"
+	"github.com/dustin/go-humanize"
 	"github.com/dustin/go-humanize/english"
 )
 
 // Long flag names.
 const (
 	// Common flags.
-	nameFlag           = "name"
-	appFlag            = "app"
-	envFlag            = "env"
-	workloadFlag       = "workload"
-	svcTypeFlag        = "svc-type"
-	jobTypeFlag        = "job-type"
-	typeFlag           = "type"
-	profileFlag        = "profile"
-	yesFlag            = "yes"
-	jsonFlag           = "json"
-	allFlag            = "all"
-	forceFlag          = "force"
-	allowDowngradeFlag = "allow-downgrade"
-	noRollbackFlag     = "no-rollback"
-	manifestFlag       = "manifest"
-	resourceTagsFlag   = "resource-tags"
-	detachFlag         = "detach"
+	nameFlag            = "name"
+	appFlag             = "app"
+	envFlag             = "env"
+	workloadFlag        = "workload"
+	svcTypeFlag         = "svc-type"
+	jobTypeFlag         = "job-type"
+	typeFlag            = "type"
+	profileFlag         = "profile"
+	yesFlag             = "yes"
+	jsonFlag            = "json"
+	formatFlag          = "format"
+	queryFlag           = "query"
+	allFlag             = "all"
+	batchSizeFlag       = "batch-size"
+	selectWorkloadsFlag = "select"
+	defaultsFromFlag    = "defaults-from"
+	forceFlag           = "force"
+	allowDowngradeFlag  = "allow-downgrade"
+	noRollbackFlag      = "no-rollback"
+	noRetryFlag         = "no-retry"
+	changeSetOnlyFlag   = "create-change-set-only"
+	manifestFlag        = "manifest"
+	resourceTagsFlag    = "resource-tags"
+	detachFlag          = "detach"
+	dryRunFlag          = "dry-run"
+	retryFailedFlag     = "retry-failed"
+	newNameFlag         = "new-name"
+	fileFlag            = "file"
+	liveFlag            = "live"
+	deleteWorkloadsFlag = "delete-workloads"
 
 	// Deploy flags.
 	yesInitWorkloadFlag = "init-wkld"
 
+	// Upgrade flags.
+	customResourcesOnlyFlag = "custom-resources-only"
+
+	// Config diff flags.
+	showConfigFlag = "show-config"
+
+	// Cost estimation flags.
+	estimateCostFlag = "estimate-cost"
+
+	// Alarm flags.
+	watchAlarmsFlag     = "watch-alarms"
+	rollbackOnAlarmFlag = "rollback-on-alarm"
+
 	// Build flags.
 	dockerFileFlag        = "dockerfile"
 	dockerFileContextFlag = "build-context"
@@ -48,12 +77,17 @@ const (
 	diffFlag              = "diff"
 	diffAutoApproveFlag   = "diff-yes"
 	sourcesFlag           = "sources"
+	maxContextSizeFlag    = "max-context-size"
+	maxParallelBuildsFlag = "max-parallel-builds"
+	requireProvenanceFlag = "require-provenance"
+	assertFlag            = "assert"
 
 	// Flags for operational commands.
 	limitFlag                   = "limit"
 	lastFlag                    = "last"
 	followFlag                  = "follow"
 	previousFlag                = "previous"
+	latestDeploymentFlag        = "latest-deployment"
 	sinceFlag                   = "since"
 	startTimeFlag               = "start-time"
 	endTimeFlag                 = "end-time"
@@ -62,12 +96,31 @@ const (
 	containerLogFlag            = "container"
 	includeStateMachineLogsFlag = "include-state-machine"
 	resourcesFlag               = "resources"
+	capacityFlag                = "capacity"
+	provenanceFlag              = "provenance"
+	clientsConfigFlag           = "clients-config"
 	taskIDFlag                  = "task-id"
 	containerFlag               = "container"
 
 	// Run local flags
-	portOverrideFlag   = "port-override"
-	envVarOverrideFlag = "env-var-override"
+	portOverrideFlag           = "port-override"
+	envVarOverrideFlag         = "env-var-override"
+	volumeOverrideFlag         = "volume-override"
+	seedVolumesFlag            = "seed-volumes"
+	watchFlag                  = "watch"
+	proxyFlag                  = "proxy"
+	proxyNetworkFlag           = "proxy-network"
+	debugFlag                  = "debug"
+	logsFormatFlag             = "logs-format"
+	refreshSecretsFlag         = "refresh-secrets"
+	offlineFlag                = "offline"
+	firelensFlag               = "firelens"
+	useTaskRoleFlag            = "use-task-role"
+	offsetPortsFlag            = "offset-ports"
+	syncFlag                   = "sync"
+	localAWSFlag               = "local-aws"
+	platformFlag               = "platform"
+	secretsRefreshIntervalFlag = "secrets-refresh-interval"
 
 	// Flags for CI/CD.
 	githubURLFlag         = "github-url"
@@ -76,9 +129,14 @@ const (
 	gitBranchFlag         = "git-branch"
 	envsFlag              = "environments"
 	pipelineTypeFlag      = "pipeline-type"
+	retentionFlag         = "retention"
+	stageFlag             = "stage"
+	ciProviderFlag        = "provider"
+	workloadsFlag         = "workloads"
 
 	// Flags for ls.
-	localFlag = "local"
+	localFlag    = "local"
+	deployedFlag = "deployed"
 
 	// Flags for storage.
 	storageTypeFlag                    = "storage-type"
@@ -97,6 +155,8 @@ const (
 	// Flags for one-off tasks.
 	taskGroupNameFlag            = "task-group-name"
 	countFlag                    = "count"
+	spreadAZFlag                 = "spread-az"
+	likeSvcFlag                  = "like-svc"
 	cpuFlag                      = "cpu"
 	memoryFlag                   = "memory"
 	imageFlag                    = "image"
@@ -112,6 +172,8 @@ const (
 	commandFlag                  = "command"
 	entrypointFlag               = "entrypoint"
 	taskDefaultFlag              = "default"
+	staleFlag                    = "stale"
+	removeTaskFlag               = "rm"
 	generateCommandFlag          = "generate-cmd"
 	osFlag                       = "platform-os"
 	archFlag                     = "platform-arch"
@@ -121,6 +183,9 @@ const (
 	publicSubnetsFlag              = "import-public-subnets"
 	privateSubnetsFlag             = "import-private-subnets"
 	certsFlag                      = "import-cert-arns"
+	importClusterFlag              = "import-cluster"
+	importPublicALBFlag            = "import-public-alb"
+	fromTerraformOutputFlag        = "from-terraform-output"
 	internalALBSubnetsFlag         = "internal-alb-subnets"
 	allowVPCIngressFlag            = "internal-alb-allow-vpc-ingress"
 	overrideVPCCIDRFlag            = "override-vpc-cidr"
@@ -140,26 +205,48 @@ const (
 	valuesFlag        = "values"
 	overwriteFlag     = "overwrite"
 	inputFilePathFlag = "cli-input-yaml"
+	envFilePathFlag   = "from-env-file"
+	secretEnvsFlag    = "envs"
 
 	// Flags for overriding templates.
 	iacToolFlag       = "tool"
 	cdkLanguageFlag   = "cdk-language"
+	noCacheFlag       = "no-cache"
 	skipResourcesFlag = "skip-resources"
 
 	// Other.
-	svcPortFlag             = "port"
-	noSubscriptionFlag      = "no-subscribe"
-	subscribeTopicsFlag     = "subscribe-topics"
-	ingressTypeFlag         = "ingress-type"
-	retriesFlag             = "retries"
-	timeoutFlag             = "timeout"
-	scheduleFlag            = "schedule"
-	domainNameFlag          = "domain"
-	permissionsBoundaryFlag = "permissions-boundary"
-	prodEnvFlag             = "prod"
-	deleteSecretFlag        = "delete-secret"
-	deployEnvFlag           = "deploy-env"
-	yesInitEnvFlag          = "init-env"
+	outputFormatFlag          = "output-format"
+	targetAccountsFlag        = "target-accounts"
+	targetOUsFlag             = "target-ous"
+	svcPortFlag               = "port"
+	noSubscriptionFlag        = "no-subscribe"
+	subscribeTopicsFlag       = "subscribe-topics"
+	ingressTypeFlag           = "ingress-type"
+	retriesFlag               = "retries"
+	timeoutFlag               = "timeout"
+	scheduleFlag              = "schedule"
+	domainNameFlag            = "domain"
+	permissionsBoundaryFlag   = "permissions-boundary"
+	conventionsFlag           = "conventions"
+	appRegistryFlag           = "app-registry"
+	appRegistryAttributesFlag = "app-registry-attributes"
+	stackMetadataFlag         = "stack-metadata"
+	roleSessionTagsFlag       = "role-session-tags"
+	roleSourceIdentityFlag    = "role-source-identity"
+	noRoleSessionFlag         = "no-role-session"
+	allAppsFlag               = "all-apps"
+	helperImagesFlag          = "helper-images"
+	imagesFlag                = "images"
+	execCheckFlag             = "check"
+	prodEnvFlag               = "prod"
+	deleteSecretFlag          = "delete-secret"
+	deployEnvFlag             = "deploy-env"
+	yesInitEnvFlag            = "init-env"
+	upgradeChannelFlag        = "channel"
+	bundleDirFlag             = "dir"
+	presetFlag                = "preset"
+	ttlFlag                   = "ttl"
+	expiredFlag               = "expired"
 )
 
 // Short flag names.
@@ -227,6 +314,14 @@ Cannot be specified with --%s, --%s or --%s.`, appFlag, envFlag, subnetsFlag)
 Cannot be specified with --%s or --%s.`, appFlag, envFlag)
 	taskDeleteDefaultFlagDescription = fmt.Sprintf(`Optional. Delete a task which was launched in the default cluster and subnets.
 Cannot be specified with --%s or --%s.`, appFlag, envFlag)
+	taskDeleteStaleFlagDescription = fmt.Sprintf(`Optional. Delete all the tasks that have not been run for more than the duration, for example "72h".
+Defaults to %s if specified without a value. Cannot be specified with --%s.`, defaultTaskStaleAfter, nameFlag)
+	taskListDefaultFlagDescription = fmt.Sprintf(`Optional. List the tasks which were launched in the default cluster and subnets.
+Cannot be specified with --%s or --%s.`, appFlag, envFlag)
+	taskListStaleFlagDescription = fmt.Sprintf(`Optional. Only list the tasks that have not been run for more than the duration, for example "72h".
+Defaults to %s if specified without a value.`, defaultTaskStaleAfter)
+	removeTaskFlagDescription = fmt.Sprintf(`Optional. Delete the resources of the task once the tasks stop.
+Must be specified with --%s. Cannot be specified with --%s.`, followFlag, scheduleFlag)
 	taskEnvFlagDescription = fmt.Sprintf(`Optional. Name of the environment.
 Cannot be specified with --%s, --%s or --%s.`, taskDefaultFlag, subnetsFlag, securityGroupsFlag)
 	taskAppFlagDescription = fmt.Sprintf(`Optional. Name of the application.
@@ -240,10 +335,37 @@ Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 	secretInputFilePathFlagDescription = fmt.Sprintf(`Optional. A YAML file in which the secret values are specified.
 Mutually exclusive with the -%s ,--%s and --%s flags.`, nameFlagShort, nameFlag, valuesFlag)
+	secretEnvFilePathFlagDescription = fmt.Sprintf(`Optional. A dotenv, JSON or CSV file of secret names and values to import.
+Must be used with the --%s flag. Mutually exclusive with the -%s ,--%s, --%s and --%s flags.`, secretEnvsFlag, nameFlagShort, nameFlag, valuesFlag, inputFilePathFlag)
+	secretEnvsFlagDescription       = fmt.Sprintf(`Environments to import the secrets of the --%s file to, separated by commas.`, envFilePathFlag)
+	secretExportEnvsFlagDescription = "Optional. Environments to list the secrets of, separated by commas. Defaults to all environments."
 
 	iacToolFlagDescription = fmt.Sprintf(`Infrastructure as Code tool to override a template.
 Must be one of: %s.`, strings.Join(applyAll(validIaCTools, strconv.Quote), ", "))
-	cdkLanguageFlagDescription = `Optional. The Cloud Development Kit language.`
+	envPackageOutputFormatFlagDescription = `Optional. Write files under --output-dir in an alternative layout.
+Must be one of: "stackset".`
+	targetAccountsFlagDescription = `Optional. IDs of the accounts to deploy the StackSet artifacts to, separated by commas.
+Defaults to the account of the environment unless --target-ous is set.`
+	targetOUsFlagDescription              = `Optional. IDs of the organizational units to deploy the StackSet artifacts to, separated by commas.`
+	svcPackageOutputFormatFlagDescription = `Optional. Write a Docker Compose file of the service deployed
+in the environment instead of its CloudFormation template.
+Must be one of: "compose".`
+	assertFlagDescription = `Optional. Path to a YAML file of assertions on the resources of the
+generated CloudFormation templates. Fails if any assertion doesn't hold.`
+	appGraphOutputFormatFlagDescription = fmt.Sprintf(`Optional. Format of the graph.
+Must be one of: %s.`, strings.Join(applyAll(topology.Formats, strconv.Quote), ", "))
+	appDashboardOutputFormatFlagDescription = fmt.Sprintf(`Optional. Format of the report.
+Must be one of: %s.`, strings.Join(applyAll(describe.DashboardFormats, strconv.Quote), ", "))
+	noCacheFlagDescription = `Optional. Synthesize CDK overrides instead of reusing
+the template cached from a previous run with the same override source and template.`
+	maxContextSizeFlagDescription = `Optional. Fail if the build context of a container image is larger than this size.
+For example: "500MB", "1GiB".`
+	maxParallelBuildsFlagDescription = `Optional. Maximum number of container images
+of the workload to build at the same time.`
+	requireProvenanceFlagDescription = `Optional. Fail unless every container runs an Amazon ECR image
+with a SLSA provenance attesting that it was built by a Copilot pipeline.`
+	cdkLanguageFlagDescription = `Optional. The Cloud Development Kit language.
+Must be one of: "typescript", "go", or "python".`
 	overrideEnvFlagDescription = `Optional. Name of the environment to use when retrieving resources in a template.
 Defaults to a random environment.`
 	skipResourcesFlagDescription = `Optional. Skip asking for which resources to override and generate empty IaC extension files.`
@@ -253,6 +375,11 @@ Supported providers are: %s.`, strings.Join(manifest.PipelineProviders, ", "))
 
 	ingressTypeFlagDescription = fmt.Sprintf(`Required for a Request-Driven Web Service. Allowed source of traffic to your service.
 Must be one of %s.`, english.OxfordWordSeries(rdwsIngressOptions, "or"))
+
+	presetFlagDescription = fmt.Sprintf(`Optional. Tune the manifest of a Load Balanced Web Service for a kind of traffic.
+Must be one of %s.
+"websocket" is for long-lived connections: sticky sessions, long connection draining,
+and scaling on the number of active connections per task.`, english.OxfordWordSeries(manifest.LoadBalancedWebServicePresets, "or"))
 )
 
 const (
@@ -273,6 +400,13 @@ Allows you to categorize resources.`
 	deployFlagDescription         = `Deploy your service or job to a new or existing environment.`
 	allowDowngradeFlagDescription = `Optional. Allow using an older version of Copilot to update Copilot components
 updated by a newer version of Copilot.`
+	svcDeleteForceFlagDescription     = "Optional. Delete the service even if other workloads depend on it."
+	envDeleteWorkloadsFlagDescription = "Optional. Delete the services and jobs deployed to the environment along with it."
+	svcRenameNewNameFlagDescription   = "New name of the service."
+	appRestoreFileFlagDescription     = "Path to the backup file written by app export."
+	appMigrateNewNameFlagDescription  = "Optional. New name of the application. Defaults to its current name."
+	appMigrateProfileFlagDescription  = `Optional. Name of the profile for the account to move the application to.
+Defaults to the account of the current credentials.`
 	forceFlagDescription = `Optional. Force a new service deployment using the existing image.
 Not available with the "Static Site" service type.`
 	noRollbackFlagDescription = `Optional. Disable automatic stack 
@@ -282,19 +416,65 @@ production environment.`
 	forceEnvDeployFlagDescription  = "Optional. Force update the environment stack template."
 	yesInitWorkloadFlagDescription = "Optional. Initialize a workload before deploying it."
 	detachFlagDescription          = "Optional. Skip displaying CloudFormation deployment progress."
+	changeSetOnlyFlagDescription   = `Optional. Create the change set of the stack without executing it,
+so that it can be approved and executed later with "copilot deployment execute".`
+	watchAlarmsFlagDescription = `Optional. Report the state changes of the service's alarms
+for this long after the deployment completes. For example: "10m".`
+	rollbackOnAlarmFlagDescription = `Optional. Deploy the previous configuration of the service again
+if one of its alarms goes off while they're watched. Must be specified with --watch-alarms.`
+	noRetryFlagDescription = `Optional. Disable the automatic retries of the deployment phases
+that fail with transient errors, such as throttling.`
+	deployAllFlagDescription = `Optional. Deploy all the services and jobs of the workspace
+in the order of their dependencies, in parallel when they don't depend on each other.`
+	selectWorkloadsFlagDescription = `Optional. Used with --all. Select the services and jobs
+of the workspace to deploy from a list instead of deploying all of them.`
+	defaultsFromFlagDescription = `Optional. Reuse the answers to the prompts of the last run
+of the command in the workspace, and only prompt for the new questions. Must be "last".`
+	appUpgradeDryRunFlagDescription = `Optional. List the stack set instances that the upgrade
+would update along with their drift, without making any changes.`
+	retryFailedFlagDescription = `Optional. Only redeploy the stack set instances
+that failed their last update.`
+	customResourcesOnlyFlagDescription = `Optional. Only move the custom resources of the environments
+that run on a deprecated Lambda runtime to a supported runtime.`
+
+	// Job run flags.
+	jobRunEnvVarsFlagDescription = `Optional. Environment variables of the main container to override, specified by key=value separated by commas.
+The task of the job then runs outside of its state machine.`
+	jobRunFollowFlagDescription = `Optional. Stream the logs of the task of the job until it stops, and exit with the exit code of its main container.
+The task of the job then runs outside of its state machine.`
+
+	// Config diff flags.
+	showConfigFlagDescription = `Optional. Also compare the environment variables, secrets, ports, containers and scaling of the task
+to the ones that are deployed. Must be specified with --diff.`
+
+	// Cost estimation flags.
+	estimateCostFlagDescription = `Optional. Estimate the monthly cost of the Fargate tasks, NAT gateways and load balancers of the stack
+with the On-Demand prices of the region, and compare it with the deployed stack.`
 
 	// Operational.
-	jsonFlagDescription = "Optional. Output in JSON format."
+	jsonFlagDescription   = "Optional. Output in JSON format."
+	formatFlagDescription = `Optional. Format the JSON output with a Go template.
+For example: "{{.name}} {{.type}}", or "{{range .services}}{{.name}}{{println}}{{end}}".`
+	queryFlagDescription = `Optional. JMESPath expression to extract fields from the JSON output.
+Strings are written without quotes. For example: "services[].name".`
+	findLiveFlagDescription = `Optional. Also search the live resources tagged with the application in AWS Resource Explorer,
+and show the stack that owns each of them.`
 
 	limitFlagDescription = `Optional. The maximum number of log events returned. Default is 10
 unless any time filtering flags are set.`
 	lastFlagDescription = `Optional. The number of executions of the scheduled job for which
 logs should be shown.`
-	followFlagDescription   = "Optional. Specifies if the logs should be streamed."
-	previousFlagDescription = "Optional. Print logs for the last stopped task if exists."
-	sinceFlagDescription    = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
+	followFlagDescription    = "Optional. Specifies if the logs should be streamed."
+	svcFollowFlagDescription = `Optional. Specifies if the logs should be streamed.
+The logs of the tasks that a deployment starts are streamed as soon as they run.`
+	previousFlagDescription         = "Optional. Print logs for the last stopped task if exists."
+	latestDeploymentFlagDescription = `Optional. Only return logs from the tasks of the latest deployment.
+For Request-Driven Web Services, return the logs of the latest deployment operation.`
+	sinceFlagDescription = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
 Defaults to all logs. Only one of start-time / since may be used.`
-	startTimeFlagDescription = `Optional. Only return logs after a specific date (RFC3339).
+	compareSinceFlagDescription         = "Optional. Only compare the requests served within a relative duration like 15m or 3h."
+	simulateScalingSinceFlagDescription = "Optional. Simulate the metrics within a relative duration like 3h or 168h."
+	startTimeFlagDescription            = `Optional. Only return logs after a specific date (RFC3339).
 Defaults to all logs. Only one of start-time / since may be used.`
 	endTimeFlagDescription = `Optional. Only return logs before a specific date (RFC3339).
 Defaults to all logs. Only one of end-time / follow may be used.`
@@ -302,11 +482,25 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	includeStateMachineLogsFlagDescription = "Optional. Include logs from the state machine executions."
 	logGroupFlagDescription                = "Optional. Only return logs from specific log group."
 	containerLogFlagDescription            = "Optional. Return only logs from a specific container."
+	localLogsFlagDescription               = `Optional. Return the logs that the containers wrote while the service
+was run locally with "copilot run local --logs-format file".`
+
+	drainAllInstancesFlagDescription = `Optional. Drain and terminate every EC2 instance in the environment cluster
+in batches, for example to roll out a new AMI.`
+	drainBatchSizeFlagDescription = `Optional. Number of instances that --all drains and terminates at a time.
+Each batch waits for the Auto Scaling groups to replace the terminated instances.`
+
+	envCapacityFlagDescription = `Optional. Show the available IP addresses of the subnets, the network interfaces
+of the services, the traffic of the NAT gateways, and the headroom left
+if the services scale out to their maximum count.`
 
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
+	svcProvenanceFlagDescription     = "Optional. Show the git commit, manifest and Copilot version that the running tasks were deployed from."
+	svcClientsConfigFlagDescription  = "Optional. Print the endpoints of the dependencies of the service in an environment."
 	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
 	localSvcFlagDescription          = "Only show services in the workspace."
+	deployedSvcFlagDescription       = "Optional. Show the image tag and last deployment time of each service in every environment."
 	localJobFlagDescription          = "Only show jobs in the workspace."
 	localPipelineFlagDescription     = "Only show pipelines in the workspace."
 
@@ -315,16 +509,66 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 Format: [container]:KEY=VALUE. Omit container name to apply to all containers.`
 	portOverridesFlagDescription = `Optional. Override ports exposed by service. Format: <host port>:<service port>.
 Example: --port-override 5000:80 binds localhost:5000 to the service's port 80.`
+	volumeOverrideFlagDescription = `Optional. Override the directories of the host bind mounted for the volumes of the task.
+Format: <volume>=<path>. By default, EFS volumes are mounted from .copilot/local/volumes/.`
+	seedVolumesFlagDescription = `Optional. Copy the files of the EFS volumes from a running task of the service
+to their directories of the host before starting the containers. The task must have ECS Exec enabled.`
+	watchFlagDescription = `Optional. Watch the build contexts of the images for changes,
+and rebuild and restart only the containers whose files changed.`
+	proxyFlagDescription = `Optional. Proxy the connections of the containers to the endpoints
+in their environment variables and secrets that are only reachable
+from the environment's VPC, through a running task of the service.`
+	proxyNetworkFlagDescription = `Optional. The CIDR range that the proxied hostnames
+resolve to in the containers. Must not overlap with addresses the containers use.`
+	debugFlagDescription = `Optional. Start a container with a debugger listening on a port of localhost.
+Format: <container>=[<runtime>:]<port>, where the runtime is one of java, node, python or go.
+Omit the runtime to detect it from the image of the container.`
+	logsFormatFlagDescription = `Optional. How to output the logs of the containers. Must be one of "raw", "json-pretty" or "file".
+"json-pretty" formats JSON log lines as their colored level, message and fields.
+"file" also writes the logs of each container to a file under .copilot/logs/.`
+	refreshSecretsFlagDescription = `Optional. Fetch the values of the secrets again instead of using
+the ones cached by a previous run. Cached values expire after an hour.`
+	offlineFlagDescription = `Optional. Run without network access, using the task definition, secrets and
+image repository cached by a previous run. Images are built and run from the local Docker cache.`
+	firelensFlagDescription = `Optional. Route the logs of the containers that use FireLens to the log router of the task,
+run with its Fluent Bit configuration file, and print the records it parses instead of sending them.`
+	useTaskRoleFlagDescription = `Optional. Vend the credentials of the task role of the workload to the containers
+instead of your own, so that they have the same permissions as when they're deployed.`
+	offsetPortsFlagDescription = `Optional. Publish the ports of the host that other workloads running locally
+already publish on the next free ports instead.`
+	syncFlagDescription = `Optional. Copy the files listed in the "sync" field of the images in the manifest
+into the running containers when they change, instead of rebuilding the images.`
+	localAWSFlagDescription = `Optional. Emulate AWS services in a container that the containers reach on localhost,
+and point the AWS SDKs to it with AWS_ENDPOINT_URL_<SERVICE> environment variables.
+Must be one of "localstack" for DynamoDB, S3 and SQS, or "dynamodb-local" for DynamoDB.`
+	runLocalPlatformFlagDescription = `Optional. Platform to pull, build and run the images of the containers for,
+instead of the one of the task. Must be one of "linux/amd64" or "linux/arm64".`
+	secretsRefreshIntervalFlagDescription = `Optional. Resolve the secrets of the containers again at this interval, like "15m",
+and restart the containers whose secrets changed, for example after a rotation. Must be at least 1m.`
+	runLocalExecContainerFlagDescription = `Optional. The container of the workload running locally to execute in.
+Defaults to the main container of the workload.`
+	envRunLocalWorkloadsFlagDescription = `Optional. Services and jobs deployed to the environment to run, separated by commas.
+Defaults to all the workloads deployed to the environment.`
 
 	svcManifestFlagDescription = `Optional. Name of the environment in which the service was deployed;
 output the manifest file used for that deployment.`
 	manifestFlagDescription = "Optional. Output the manifest file used for the deployment."
 
-	execYesFlagDescription     = "Optional. Whether to update the Session Manager Plugin."
+	execYesFlagDescription   = "Optional. Whether to update the Session Manager Plugin."
+	execCheckFlagDescription = `Optional. Diagnose why commands can't be executed in the service
+instead of executing the command.`
 	taskIDFlagDescription      = "Optional. ID of the task you want to exec in."
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
 	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
 
+	// Sandbox.
+	sandboxNameFlagDescription      = "Name of the sandbox environment."
+	sandboxWorkloadsFlagDescription = `Optional. Services and jobs in the workspace to deploy to the sandbox,
+separated by commas.`
+	sandboxTTLFlagDescription = `Optional. Duration after which the sandbox expires
+and is deleted by "copilot sandbox down --expired".`
+	sandboxExpiredFlagDescription = "Optional. Delete every sandbox of the application that has expired."
+
 	// Build.
 	imageTagFlagDescription     = `Optional. The tag for the container images Copilot builds from Dockerfiles.`
 	uploadAssetsFlagDescription = `Optional. Whether to upload assets (container images, Lambda functions, etc.).
@@ -336,8 +580,21 @@ Uploaded asset locations are filled in the template configuration.`
 	githubURLFlagDescription         = "(Deprecated.) Use '--url' instead. Repository URL to trigger your pipeline."
 	githubAccessTokenFlagDescription = "GitHub personal access token for your repository."
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
-	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
-	pipelineTypeFlagDescription      = `The type of pipeline. Must be either "Workloads" or "Environments".`
+	ciProviderFlagDescription        = `Optional. The CI provider whose jobs deploy the application. Must be "github".`
+	ciRepoURLFlagDescription         = "The URL of the repository whose CI jobs deploy the application."
+	ciBranchFlagDescription          = "Optional. Only let the CI jobs of this branch deploy. Defaults to any branch."
+	ciWorkloadsFlagDescription       = "Optional. Services and jobs that the CI jobs can deploy, separated by commas. Defaults to all workloads."
+	ciEnvsFlagDescription            = `Optional. Environments that the CI jobs can deploy to, separated by commas,
+in the order to deploy to them. Defaults to all environments.`
+	pipelineEnvsFlagDescription = "Environments to add to the pipeline."
+	pipelineTypeFlagDescription = `The type of pipeline. Must be either "Workloads" or "Environments".`
+	retentionFlagDescription    = `Optional. Delete the artifacts older than this number of days.
+Defaults to the "artifacts.retention" of the deployed pipeline.`
+	pipelineGCDryRunFlagDescription  = "Optional. List the number and size of the artifacts to delete, without deleting them."
+	pipelineStageFlagDescription     = "Name of the environment of the pipeline stage."
+	pipelineTestStageFlagDescription = `Optional. Name of the environment of the pipeline stage whose test commands to run.
+Defaults to running the install, pre_build and build phases of the buildspec.`
+	pipelineTestLocalFlagDescription = "Run the commands locally in the image of the CodeBuild project."
 
 	// Storage.
 	storageFlagDescription             = "Name of the storage resource to create."
@@ -373,7 +630,14 @@ Values specified with --env-vars take precedence over --env-file.`
 	taskGroupFlagDescription  = `Optional. The group name of the task. 
 Tasks with the same group name share the same set of resources. 
 (default directory name)`
-	taskImageTagFlagDescription    = `Optional. The container image tag in addition to "latest".`
+	taskImageTagFlagDescription = `Optional. The container image tag in addition to "latest".`
+	spreadAZFlagDescription     = "Optional. Spread the tasks evenly across the subnets, and therefore the Availability Zones, of the network."
+	likeSvcFlagDescription      = `Optional. Name of a service deployed in the environment specified by --env.
+The tasks reuse the service's subnets, security groups, task role, execution role, environment variables and secrets.
+Cannot be specified with --task-role or --execution-role.`
+	taskScheduleFlagDescription = `Optional. Run the tasks on a schedule instead of once, until the task is deleted. 
+Accepts cron expressions of the format (M H DoM M DoW) and schedule definition strings. 
+For example: "0 * * * *", "@daily", "@weekly", "@every 1h30m".`
 	generateCommandFlagDescription = `Optional. Generate a command with a pre-filled value for each flag.
 To use it for an ECS service, specify --generate-cmd <cluster name>/<service name>.
 Alternatively, if the service or job is created with Copilot, specify --generate-cmd <application>/<environment>/<service or job name>.
@@ -384,9 +648,16 @@ Cannot be specified with any other flags.`
 	publicSubnetsFlagDescription      = "Optional. Use existing public subnet IDs."
 	privateSubnetsFlagDescription     = "Optional. Use existing private subnet IDs."
 	certsFlagDescription              = "Optional. Apply existing ACM certificates to the internet-facing load balancer."
+	importClusterFlagDescription      = "Optional. Use an existing ECS cluster name or ARN."
 	internalALBSubnetsFlagDescription = `Optional. Specify subnet IDs for an internal load balancer.
 By default, the load balancer will be placed in your private subnets.
 Cannot be specified with --default-config or any of the --override flags.`
+	importPublicALBFlagDescription = `Optional. Use an existing internet-facing Application Load Balancer name or ARN.
+Must be specified with --import-vpc-id and cannot be specified with --import-cert-arns.`
+	fromTerraformOutputFlagDescription = `Optional. Import the existing resources in the file written by "terraform output -json".
+Reads the outputs vpc_id, public_subnets, private_subnets, certificate_arns,
+ecs_cluster_arn and public_alb_arn, or their aliases.
+Cannot be specified with --default-config, the import flags or the override flags.`
 	allowVPCIngressFlagDescription = `Optional. Allow internal ALB ingress from port 80 and/or port 443.`
 	overrideVPCCIDRFlagDescription = `Optional. Global CIDR to use for VPC.
 (default 10.0.0.0/16)`
@@ -425,9 +696,38 @@ are also accepted.`
 	secretOverwriteFlagDescription     = "Optional. Whether to overwrite an existing secret."
 	permissionsBoundaryFlagDescription = `Optional. The name or ARN of an existing IAM policy with which to set a
 permissions boundary for all roles generated within the application.`
-	prodEnvFlagDescription    = "If the environment contains production services."
-	deployEnvFlagDescription  = "Deploy the target environment before deploying the workload."
-	yesInitEnvFlagDescription = "Confirm initializing the target environment if it does not exist."
+	conventionsFlagDescription = `Optional. Path to a YAML file with the naming conventions that the application,
+and the environments, services, jobs and aliases created within it, must follow.`
+	appRegistryFlagDescription = `Optional. Register the application and its environments
+in AWS Service Catalog AppRegistry.`
+	appRegistryAttributesFlagDescription = `Optional. Attributes of the application in AWS Service Catalog AppRegistry,
+with a key and value separated by commas. Implies --app-registry.`
+	stackMetadataFlagDescription = `Optional. Keys with a value separated by commas, added to the Metadata section
+of every CloudFormation stack of the application. For example, to pass CloudFormation Hooks or Guard rules.`
+	roleSessionTagsFlagDescription = `Optional. Session tags with a value separated by commas, passed when Copilot
+assumes the environment manager roles of the application.`
+	allAppsFlagDescription = `Optional. List the environments of every application in the SSM store
+of every region of the account, instead of the ones of a single application.`
+	roleSourceIdentityFlagDescription = `Optional. Set the source identity of the sessions of the environment manager roles
+to the name of the caller, so that CloudTrail records who initiated an operation.`
+	noRoleSessionFlagDescription = `Optional. Stop passing session tags and the source identity when Copilot
+assumes the environment manager roles of an existing application.`
+	helperImagesFlagDescription = `Optional. Locations of the sidecar and helper images that Copilot injects into workloads,
+with a name and image separated by commas. For example, to pull them from a mirror.
+Names must be "fluent-bit", "aws-otel-collector", "envoy" or "pause".`
+	appShowImagesFlagDescription = `Optional. Show the sidecar and helper images that Copilot injects
+into the workloads of the application, and where their locations come from.`
+
+	prodEnvFlagDescription        = "If the environment contains production services."
+	deployEnvFlagDescription      = "Deploy the target environment before deploying the workload."
+	yesInitEnvFlagDescription     = "Confirm initializing the target environment if it does not exist."
+	upgradeChannelFlagDescription = `Optional. The release channel to upgrade from. Must be one of "stable"
+for the latest release, or "pre" for the latest pre-release or release.`
+	bundleFileFlagDescription = "Optional. Path to write the bundle to."
+	bundleDirFlagDescription  = `Optional. Path to the extracted bundle.
+Defaults to the directory of the running binary.`
+	deleteDryRunFlagDescription = `Optional. List the stacks, roles, buckets, repositories and DNS records
+that would be deleted, without deleting them.`
 )
 
 type portOverride struct {
@@ -464,3 +764,72 @@ func (p *portOverrides) Type() string {
 func (p *portOverrides) String() string {
 	return fmt.Sprintf("%+v", *p)
 }
+
+// debugTarget is a container to attach a debugger to.
+type debugTarget struct {
+	container string
+	runtime   string // Empty to detect the runtime from the image of the container.
+	port      string
+}
+
+type debugTargets []debugTarget
+
+func (d *debugTargets) Set(val string) error {
+	err := errors.New("should be in format container=port or container=runtime:port")
+	container, value, ok := strings.Cut(val, "=")
+	if !ok || container == "" {
+		return err
+	}
+	runtime, port, ok := strings.Cut(value, ":")
+	if !ok {
+		runtime, port = "", value
+	}
+	if n, convErr := strconv.Atoi(port); convErr != nil || n < 1 || n > 65535 {
+		return err
+	}
+	if runtime != "" && !contains(runtime, debugRuntimes) {
+		return fmt.Errorf("runtime %q should be one of %s", runtime, english.WordSeries(debugRuntimes, "or"))
+	}
+	for _, target := range *d {
+		if target.container == container {
+			return fmt.Errorf("container %q is already debugged", container)
+		}
+	}
+	*d = append(*d, debugTarget{
+		container: container,
+		runtime:   runtime,
+		port:      port,
+	})
+	return nil
+}
+
+func (d *debugTargets) Type() string {
+	return "list"
+}
+
+func (d *debugTargets) String() string {
+	return fmt.Sprintf("%+v", *d)
+}
+
+// byteSize is a size in bytes that can be parsed from human-readable values like "500MB".
+type byteSize int64
+
+func (b *byteSize) Set(val string) error {
+	size, err := humanize.ParseBytes(val)
+	if err != nil {
+		return fmt.Errorf(`should be a size like "500MB" or "1GiB"`)
+	}
+	*b = byteSize(size)
+	return nil
+}
+
+func (b *byteSize) Type() string {
+	return "size"
+}
+
+func (b *byteSize) String() string {
+	if *b == 0 {
+		return "0"
+	}
+	return humanize.IBytes(uint64(*b))
+}
@@ -73,3 +73,97 @@ func TestFlag_portOverrides(t *testing.T) {
 		})
 	}
 }
+
+func TestFlag_debugTargets(t *testing.T) {
+	tests := map[string]struct {
+		in      []string
+		want    debugTargets
+		wantErr string
+	}{
+		"error: no port": {
+			in:      []string{"--debug", "api"},
+			wantErr: `invalid argument "api" for "--debug" flag: should be in format container=port or container=runtime:port`,
+		},
+		"error: port not a number": {
+			in:      []string{"--debug", "api=java:debug"},
+			wantErr: `invalid argument "api=java:debug" for "--debug" flag: should be in format container=port or container=runtime:port`,
+		},
+		"error: unsupported runtime": {
+			in:      []string{"--debug", "api=ruby:1234"},
+			wantErr: `invalid argument "api=ruby:1234" for "--debug" flag: runtime "ruby" should be one of java, node, python or go`,
+		},
+		"error: container debugged twice": {
+			in:      []string{"--debug", "api=5005", "--debug", "api=5006"},
+			wantErr: `invalid argument "api=5006" for "--debug" flag: container "api" is already debugged`,
+		},
+		"success: no debugged containers": {},
+		"success: multiple debugged containers": {
+			in: []string{"--debug", "api=5005", "--debug=worker=node:9229"},
+			want: debugTargets{
+				{
+					container: "api",
+					port:      "5005",
+				},
+				{
+					container: "worker",
+					runtime:   "node",
+					port:      "9229",
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got debugTargets
+			f := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			f.Var(&got, "debug", "")
+
+			err := f.Parse(tc.in)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFlag_byteSize(t *testing.T) {
+	tests := map[string]struct {
+		in      []string
+		want    byteSize
+		wantErr string
+	}{
+		"error: not a size": {
+			in:      []string{"--size", "big"},
+			wantErr: `invalid argument "big" for "--size" flag: should be a size like "500MB" or "1GiB"`,
+		},
+		"success: no size": {},
+		"success: decimal unit": {
+			in:   []string{"--size", "500MB"},
+			want: 500000000,
+		},
+		"success: binary unit": {
+			in:   []string{"--size=1GiB"},
+			want: 1 << 30,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got byteSize
+			f := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			f.Var(&got, "size", "")
+
+			err := f.Parse(tc.in)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -5,9 +5,16 @@ package cli
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"regexp"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 )
 
 func describeGitChanges(r execRunner) (string, error) {
@@ -20,6 +27,15 @@ func describeGitChanges(r execRunner) (string, error) {
 	return strings.TrimSpace(stdout.String()), nil
 }
 
+func gitRevParse(r execRunner, args ...string) (string, error) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := r.Run("git", append([]string{"rev-parse"}, args...), exec.Stdout(&stdout), exec.Stderr(&stderr)); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
 func hasUncommitedGitChanges(r execRunner) (bool, error) {
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
@@ -43,3 +59,58 @@ func imageTagFromGit(r execRunner) string {
 	}
 	return commit
 }
+
+// newProvenance returns the provenance of a workload deployed with the manifest from the current directory.
+// The git metadata is left empty if the user is not in a git repository.
+func newProvenance(r execRunner, rawMft []byte) deploy.Provenance {
+	p := deploy.Provenance{
+		ManifestSHA256: fmt.Sprintf("%x", sha256.Sum256(rawMft)),
+		CopilotVersion: version.Version,
+	}
+	commit, err := gitRevParse(r, "HEAD")
+	if err != nil {
+		return p
+	}
+	p.GitCommit = commit
+	// A detached HEAD has no branch.
+	if branch, err := gitRevParse(r, "--abbrev-ref", "HEAD"); err == nil && branch != "HEAD" {
+		p.GitBranch = branch
+	}
+	p.GitDirty, _ = hasUncommitedGitChanges(r)
+	return p
+}
+
+// scpLikeGitURL matches the URLs of remotes in the scp-like syntax, such as "git@github.com:user/repo.git".
+var scpLikeGitURL = regexp.MustCompile(`^(?:[^@/]+@)?([^:/]+):(.+)$`)
+
+// codeRepositoryFromGit returns the remote "origin" and the branch checked out in the workspace,
+// that App Runner builds services deployed from source code from.
+// The fields are left empty if they are unknown.
+func codeRepositoryFromGit(r execRunner) stack.CodeRepository {
+	var repo stack.CodeRepository
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := r.Run("git", []string{"remote", "get-url", "origin"}, exec.Stdout(&stdout), exec.Stderr(&stderr)); err == nil {
+		repo.URL = httpsRepositoryURL(strings.TrimSpace(stdout.String()))
+	}
+	// A detached HEAD has no branch.
+	if branch, err := gitRevParse(r, "--abbrev-ref", "HEAD"); err == nil && branch != "HEAD" {
+		repo.Branch = branch
+	}
+	return repo
+}
+
+// httpsRepositoryURL converts the URL of a remote, such as "git@github.com:user/repo.git",
+// to the HTTPS URL of the repository that App Runner expects, such as "https://github.com/user/repo".
+func httpsRepositoryURL(remote string) string {
+	if u, err := url.Parse(remote); err == nil && u.Scheme != "" && u.Host != "" {
+		u.Scheme = "https"
+		u.User = nil
+		u.Host = u.Hostname()
+		return strings.TrimSuffix(u.String(), ".git")
+	}
+	if m := scpLikeGitURL.FindStringSubmatch(remote); m != nil {
+		return fmt.Sprintf("https://%s/%s", m[1], strings.TrimSuffix(strings.TrimPrefix(m[2], "/"), ".git"))
+	}
+	return strings.TrimSuffix(remote, ".git")
+}
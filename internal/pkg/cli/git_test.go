@@ -0,0 +1,157 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	osexec "os/exec"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvenance(t *testing.T) {
+	const (
+		mockMft     = "name: api\ntype: Backend Service\n"
+		mockMftHash = "d4f25e94bbb8dc26e3081450f1893638fb66f88d2ad52b71bfc9bcfa8aab846b"
+	)
+	writeStdout := func(out string) func(string, []string, ...exec.CmdOption) {
+		return func(_ string, _ []string, opts ...exec.CmdOption) {
+			cmd := &osexec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			_, _ = cmd.Stdout.Write([]byte(out))
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockexecRunner)
+
+		wanted deploy.Provenance
+	}{
+		"outside of a git repository": {
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "HEAD"}, gomock.Any()).Return(errors.New("not a git repository"))
+			},
+			wanted: deploy.Provenance{
+				ManifestSHA256: mockMftHash,
+			},
+		},
+		"on a branch with uncommitted changes": {
+			setupMocks: func(m *mocks.MockexecRunner) {
+				gomock.InOrder(
+					m.EXPECT().Run("git", []string{"rev-parse", "HEAD"}, gomock.Any()).Do(writeStdout("3f2c1a9e\n")).Return(nil),
+					m.EXPECT().Run("git", []string{"rev-parse", "--abbrev-ref", "HEAD"}, gomock.Any()).Do(writeStdout("main\n")).Return(nil),
+					m.EXPECT().Run("git", []string{"status", "--porcelain"}, gomock.Any()).Do(writeStdout(" M manifest.yml\n")).Return(nil),
+				)
+			},
+			wanted: deploy.Provenance{
+				GitCommit:      "3f2c1a9e",
+				GitBranch:      "main",
+				GitDirty:       true,
+				ManifestSHA256: mockMftHash,
+			},
+		},
+		"on a detached HEAD": {
+			setupMocks: func(m *mocks.MockexecRunner) {
+				gomock.InOrder(
+					m.EXPECT().Run("git", []string{"rev-parse", "HEAD"}, gomock.Any()).Do(writeStdout("3f2c1a9e\n")).Return(nil),
+					m.EXPECT().Run("git", []string{"rev-parse", "--abbrev-ref", "HEAD"}, gomock.Any()).Do(writeStdout("HEAD\n")).Return(nil),
+					m.EXPECT().Run("git", []string{"status", "--porcelain"}, gomock.Any()).Return(nil),
+				)
+			},
+			wanted: deploy.Provenance{
+				GitCommit:      "3f2c1a9e",
+				ManifestSHA256: mockMftHash,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockexecRunner(ctrl)
+			tc.setupMocks(m)
+
+			// WHEN
+			got := newProvenance(m, []byte(mockMft))
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestCodeRepositoryFromGit(t *testing.T) {
+	writeStdout := func(out string) func(string, []string, ...exec.CmdOption) {
+		return func(_ string, _ []string, opts ...exec.CmdOption) {
+			cmd := &osexec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			_, _ = cmd.Stdout.Write([]byte(out))
+		}
+	}
+	testCases := map[string]struct {
+		remote string
+
+		wanted stack.CodeRepository
+	}{
+		"https remote": {
+			remote: "https://github.com/user/repo.git\n",
+			wanted: stack.CodeRepository{URL: "https://github.com/user/repo", Branch: "main"},
+		},
+		"https remote with credentials": {
+			remote: "https://user@bitbucket.org/team/repo.git\n",
+			wanted: stack.CodeRepository{URL: "https://bitbucket.org/team/repo", Branch: "main"},
+		},
+		"scp-like ssh remote": {
+			remote: "git@github.com:user/repo.git\n",
+			wanted: stack.CodeRepository{URL: "https://github.com/user/repo", Branch: "main"},
+		},
+		"ssh remote with a port": {
+			remote: "ssh://git@bitbucket.org:22/team/repo.git\n",
+			wanted: stack.CodeRepository{URL: "https://bitbucket.org/team/repo", Branch: "main"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockexecRunner(ctrl)
+			m.EXPECT().Run("git", []string{"remote", "get-url", "origin"}, gomock.Any()).Do(writeStdout(tc.remote)).Return(nil)
+			m.EXPECT().Run("git", []string{"rev-parse", "--abbrev-ref", "HEAD"}, gomock.Any()).Do(writeStdout("main\n")).Return(nil)
+
+			// WHEN
+			got := codeRepositoryFromGit(m)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+
+	t.Run("outside of a git repository", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockexecRunner(ctrl)
+		m.EXPECT().Run("git", []string{"remote", "get-url", "origin"}, gomock.Any()).Return(errors.New("not a git repository"))
+		m.EXPECT().Run("git", []string{"rev-parse", "--abbrev-ref", "HEAD"}, gomock.Any()).Return(errors.New("not a git repository"))
+
+		// WHEN
+		got := codeRepositoryFromGit(m)
+
+		// THEN
+		require.Equal(t, stack.CodeRepository{}, got)
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+)
+
+// imageSourcedManifest is a workload manifest whose containers run images that are either built or pulled.
+type imageSourcedManifest interface {
+	BuildArgs(contextDir string) (map[string]*manifest.DockerBuildArgs, error)
+	ImageLocations() map[string]string
+}
+
+type attestImagesInput struct {
+	workload string
+	images   map[string]clideploy.ContainerImageIdentifier // Container name to image.
+	build    deploy.PipelineBuild
+
+	fs          afero.Fs
+	newAttester func(region string) (imageAttester, error)
+}
+
+// attestImages stores the SLSA provenance of the images built by a pipeline alongside them in their ECR repositories.
+func attestImages(in attestImagesInput) error {
+	buildspecDigest, err := buildspecSHA256(in.fs, in.build)
+	if err != nil {
+		return err
+	}
+	for _, container := range sortedImageContainers(in.images) {
+		id := in.images[container]
+		if id.Digest == "" || len(id.RepoTags) == 0 {
+			continue
+		}
+		img, region, err := ecr.ParseImageURI(id.RepoTags[0])
+		if err != nil {
+			return fmt.Errorf("attest provenance of the image for container %q: %w", container, err)
+		}
+		statement, err := deploy.NewSLSAProvenance(deploy.SLSAProvenanceInput{
+			Build:           in.build,
+			BuildspecSHA256: buildspecDigest,
+			Workload:        in.workload,
+			Container:       container,
+			ImageURI:        strings.TrimSuffix(id.RepoTags[0], ":"+img.Tag),
+			ImageDigest:     id.Digest,
+			CopilotVersion:  version.Version,
+		})
+		if err != nil {
+			return fmt.Errorf("generate provenance of the image for container %q: %w", container, err)
+		}
+		raw, err := json.Marshal(statement)
+		if err != nil {
+			return fmt.Errorf("marshal provenance of the image for container %q: %w", container, err)
+		}
+		attester, err := in.newAttester(region)
+		if err != nil {
+			return err
+		}
+		img.Tag, img.Digest = "", id.Digest
+		if err := attester.PutAttestation(img, raw); err != nil {
+			return fmt.Errorf("attest provenance of the image for container %q: %w", container, err)
+		}
+		log.Successf("Attested the provenance of image %s for container %q.\n", id.Digest, container)
+	}
+	return nil
+}
+
+func buildspecSHA256(fs afero.Fs, build deploy.PipelineBuild) (string, error) {
+	if build.BuildspecPath == "" {
+		return "", nil
+	}
+	path := filepath.Join(build.SourceDir, filepath.FromSlash(build.BuildspecPath))
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return "", fmt.Errorf("read buildspec %s: %w", path, err)
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(content)), nil
+}
+
+// verifyImageProvenance returns nil if every container of the workload runs an ECR image
+// that has a valid SLSA provenance attesting that it was built by a Copilot pipeline.
+func verifyImageProvenance(workload string, mft interface{}, newGetter func(region string) (imageAttestationGetter, error)) error {
+	wkld, ok := mft.(imageSourcedManifest)
+	if !ok {
+		return fmt.Errorf("%s is not supported for workload %s", color.HighlightCode("--"+requireProvenanceFlag), workload)
+	}
+	buildArgs, err := wkld.BuildArgs("")
+	if err != nil {
+		return fmt.Errorf("get images of workload %s: %w", workload, err)
+	}
+	if len(buildArgs) > 0 {
+		var containers []string
+		for container := range buildArgs {
+			containers = append(containers, container)
+		}
+		sort.Strings(containers)
+		return fmt.Errorf("%s requires images built by a pipeline, but the manifest builds the image of %s; use %s instead",
+			color.HighlightCode("--"+requireProvenanceFlag), english.WordSeries(applyAll(containers, strconv.Quote), "and"), color.HighlightCode("image.location"))
+	}
+	locations := wkld.ImageLocations()
+	containers := make([]string, 0, len(locations))
+	for container := range locations {
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+	for _, container := range containers {
+		uri := locations[container]
+		img, region, err := ecr.ParseImageURI(uri)
+		if err != nil {
+			return fmt.Errorf("verify provenance of the image for container %q: %w", container, err)
+		}
+		getter, err := newGetter(region)
+		if err != nil {
+			return err
+		}
+		digest, err := getter.ImageDigest(img)
+		if err != nil {
+			return fmt.Errorf("get digest of image %s: %w", uri, err)
+		}
+		img.Digest = digest
+		raw, err := getter.Attestation(img)
+		if err != nil {
+			return fmt.Errorf("verify provenance of image %s: %w", uri, err)
+		}
+		statement, err := deploy.VerifySLSAProvenance(raw, digest)
+		if err != nil {
+			return fmt.Errorf("verify provenance of image %s: %w", uri, err)
+		}
+		log.Successf("Verified that image %s for container %q was built by %s.\n", uri, container, statement.Predicate.RunDetails.Builder.ID)
+	}
+	return nil
+}
+
+// ecrClientInRegion returns an ECR client in the region of an image repository.
+func ecrClientInRegion(provider *sessions.Provider, region string) (ecr.ECR, error) {
+	sess, err := provider.DefaultWithRegion(region)
+	if err != nil {
+		return ecr.ECR{}, fmt.Errorf("create session in region %s: %w", region, err)
+	}
+	return ecr.New(sess), nil
+}
+
+func sortedImageContainers(images map[string]clideploy.ContainerImageIdentifier) []string {
+	containers := make([]string, 0, len(images))
+	for container := range images {
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+	return containers
+}
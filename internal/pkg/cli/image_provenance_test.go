@@ -0,0 +1,224 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockPipelineBuildARN = "arn:aws:codebuild:us-west-2:123456789012:build/pipeline-my-app-BuildProject:a1b2c3d4"
+	mockProvenanceDigest = "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49"
+	mockECRRepoURI       = "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api"
+)
+
+func TestAttestImages(t *testing.T) {
+	build := deploy.PipelineBuild{
+		BuildARN:      mockPipelineBuildARN,
+		SourceRepoURL: "https://github.com/acme/api",
+		SourceVersion: "c0ffee",
+		SourceDir:     "/codebuild/src",
+		BuildspecPath: "copilot/pipelines/my-pipeline/buildspec.yml",
+	}
+	images := map[string]clideploy.ContainerImageIdentifier{
+		"api": {
+			Digest:   mockProvenanceDigest,
+			RepoTags: []string{mockECRRepoURI + ":latest"},
+		},
+	}
+	testCases := map[string]struct {
+		images     map[string]clideploy.ContainerImageIdentifier
+		buildspec  string
+		setupMocks func(m *mocks.MockimageAttester)
+
+		wantedErr string
+	}{
+		"error if the buildspec can't be read": {
+			images:     images,
+			setupMocks: func(m *mocks.MockimageAttester) {},
+			wantedErr:  "read buildspec /codebuild/src/copilot/pipelines/my-pipeline/buildspec.yml: open /codebuild/src/copilot/pipelines/my-pipeline/buildspec.yml: file does not exist",
+		},
+		"error if the attestation can't be stored": {
+			images:    images,
+			buildspec: "version: 0.2",
+			setupMocks: func(m *mocks.MockimageAttester) {
+				m.EXPECT().PutAttestation(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: `attest provenance of the image for container "api": some error`,
+		},
+		"skip images that were not pushed": {
+			images: map[string]clideploy.ContainerImageIdentifier{
+				"api": {},
+			},
+			buildspec:  "version: 0.2",
+			setupMocks: func(m *mocks.MockimageAttester) {},
+		},
+		"attest the provenance of the images": {
+			images:    images,
+			buildspec: "version: 0.2",
+			setupMocks: func(m *mocks.MockimageAttester) {
+				m.EXPECT().PutAttestation(ecr.ImageRef{
+					RegistryID: "123456789012",
+					Repository: "my-app/api",
+					Digest:     mockProvenanceDigest,
+				}, gomock.Any()).DoAndReturn(func(_ ecr.ImageRef, raw []byte) error {
+					var statement deploy.ProvenanceStatement
+					require.NoError(t, json.Unmarshal(raw, &statement))
+					require.Equal(t, mockECRRepoURI, statement.Subject[0].Name)
+					params := statement.Predicate.BuildDefinition.ExternalParameters
+					require.Equal(t, "api", params.Workload)
+					require.Equal(t, "api", params.Container)
+					require.Equal(t, map[string]string{"gitCommit": "c0ffee"}, params.Source.Digest)
+					require.Equal(t, map[string]string{"sha256": "7c2efb5e79c49ae56085797fabc28903fb9dbb567629aa407e0c8257fc472148"}, params.Buildspec.Digest)
+					return nil
+				})
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockimageAttester(ctrl)
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			if tc.buildspec != "" {
+				require.NoError(t, afero.WriteFile(fs, "/codebuild/src/copilot/pipelines/my-pipeline/buildspec.yml", []byte(tc.buildspec), 0644))
+			}
+
+			err := attestImages(attestImagesInput{
+				workload: "api",
+				images:   tc.images,
+				build:    build,
+				fs:       fs,
+				newAttester: func(region string) (imageAttester, error) {
+					require.Equal(t, "us-west-2", region)
+					return m, nil
+				},
+			})
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifyImageProvenance(t *testing.T) {
+	svc := func(location *string, build manifest.BuildArgsOrString) *manifest.BackendService {
+		return &manifest.BackendService{
+			Workload: manifest.Workload{
+				Name: aws.String("api"),
+			},
+			BackendServiceConfig: manifest.BackendServiceConfig{
+				ImageConfig: manifest.ImageWithHealthcheckAndOptionalPort{
+					ImageWithOptionalPort: manifest.ImageWithOptionalPort{
+						Image: manifest.Image{
+							ImageLocationOrBuild: manifest.ImageLocationOrBuild{
+								Location: location,
+								Build:    build,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	statement, err := deploy.NewSLSAProvenance(deploy.SLSAProvenanceInput{
+		Build:       deploy.PipelineBuild{BuildARN: mockPipelineBuildARN},
+		ImageURI:    mockECRRepoURI,
+		ImageDigest: mockProvenanceDigest,
+	})
+	require.NoError(t, err)
+	rawStatement, err := json.Marshal(statement)
+	require.NoError(t, err)
+	img := ecr.ImageRef{
+		RegistryID: "123456789012",
+		Repository: "my-app/api",
+		Tag:        "v1",
+	}
+	testCases := map[string]struct {
+		mft        interface{}
+		setupMocks func(m *mocks.MockimageAttestationGetter)
+
+		wantedErr string
+	}{
+		"error if the workload has no images": {
+			mft:        &manifest.StaticSite{},
+			setupMocks: func(m *mocks.MockimageAttestationGetter) {},
+			wantedErr:  "`--require-provenance` is not supported for workload api",
+		},
+		"error if the image is built locally": {
+			mft:        svc(nil, manifest.BuildArgsOrString{BuildString: aws.String("Dockerfile")}),
+			setupMocks: func(m *mocks.MockimageAttestationGetter) {},
+			wantedErr:  "`--require-provenance` requires images built by a pipeline, but the manifest builds the image of \"api\"; use `image.location` instead",
+		},
+		"error if the image is not in ECR": {
+			mft:        svc(aws.String("public.ecr.aws/nginx/nginx"), manifest.BuildArgsOrString{}),
+			setupMocks: func(m *mocks.MockimageAttestationGetter) {},
+			wantedErr:  `verify provenance of the image for container "api": image public.ecr.aws/nginx/nginx is not in an Amazon ECR repository`,
+		},
+		"error if the image has no attestation": {
+			mft: svc(aws.String(mockECRRepoURI+":v1"), manifest.BuildArgsOrString{}),
+			setupMocks: func(m *mocks.MockimageAttestationGetter) {
+				m.EXPECT().ImageDigest(img).Return(mockProvenanceDigest, nil)
+				m.EXPECT().Attestation(gomock.Any()).Return(nil, &ecr.ErrAttestationNotFound{Repository: "my-app/api", Digest: mockProvenanceDigest})
+			},
+			wantedErr: fmt.Sprintf("verify provenance of image %s:v1: image %s in repository my-app/api does not have an attestation", mockECRRepoURI, mockProvenanceDigest),
+		},
+		"error if the attestation is about another image": {
+			mft: svc(aws.String(mockECRRepoURI+":v1"), manifest.BuildArgsOrString{}),
+			setupMocks: func(m *mocks.MockimageAttestationGetter) {
+				m.EXPECT().ImageDigest(img).Return("sha256:other", nil)
+				m.EXPECT().Attestation(gomock.Any()).Return(rawStatement, nil)
+			},
+			wantedErr: fmt.Sprintf("verify provenance of image %s:v1: image sha256:other is not a subject of the statement", mockECRRepoURI),
+		},
+		"verify the provenance of the image": {
+			mft: svc(aws.String(mockECRRepoURI+":v1"), manifest.BuildArgsOrString{}),
+			setupMocks: func(m *mocks.MockimageAttestationGetter) {
+				m.EXPECT().ImageDigest(img).Return(mockProvenanceDigest, nil)
+				m.EXPECT().Attestation(ecr.ImageRef{
+					RegistryID: "123456789012",
+					Repository: "my-app/api",
+					Tag:        "v1",
+					Digest:     mockProvenanceDigest,
+				}).Return(rawStatement, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockimageAttestationGetter(ctrl)
+			tc.setupMocks(m)
+
+			err := verifyImageProvenance("api", tc.mft, func(region string) (imageAttestationGetter, error) {
+				return m, nil
+			})
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
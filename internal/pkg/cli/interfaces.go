@@ -7,14 +7,24 @@ import (
 	"context"
 	"encoding"
 	"io"
+	"net/http"
+	"time"
 
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourceexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
@@ -35,6 +45,8 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/term/tui"
+	"github.com/aws/copilot-cli/internal/pkg/topology"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
@@ -183,10 +195,24 @@ type repositoryService interface {
 	imageBuilderPusher
 }
 
-type ecsLocalClient interface {
+type taskDefinitionGetter interface {
 	TaskDefinition(app, env, svc string) (*awsecs.TaskDefinition, error)
 }
 
+type ecsLocalClient interface {
+	taskDefinitionGetter
+	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
+	Service(app, env, svc string) (*awsecs.Service, error)
+}
+
+type portForwarder interface {
+	StartPortForwardingSession(in ssm.PortForwardingSessionInput) (*ssm.PortForwardingSession, error)
+}
+
+type commandSessionStarter interface {
+	StartCommandSession(in awsecs.ExecuteCommandInput) (*awsecs.CommandSession, error)
+}
+
 type logEventsWriter interface {
 	WriteLogEvents(opts logging.WriteLogEventsOpts) error
 }
@@ -252,6 +278,10 @@ type workspacePathGetter interface {
 	Path() string
 }
 
+type httpGetter interface {
+	Get(url string) (*http.Response, error)
+}
+
 type wsPipelineManifestReader interface {
 	ReadPipelineManifest(path string) (*manifest.Pipeline, error)
 }
@@ -293,6 +323,11 @@ type wlLister interface {
 	ListWorkloads() ([]string, error)
 }
 
+type wsWorkloadManifestLister interface {
+	wlLister
+	manifestReader
+}
+
 type wsWorkloadReader interface {
 	manifestReader
 	ReadFile(path string) ([]byte, error)
@@ -326,6 +361,62 @@ type wsWlDirReader interface {
 	Summary() (*workspace.Summary, error)
 }
 
+type changeSetExecutor interface {
+	ExecuteChangeSet(changeSetID string, disableRollback bool) error
+}
+
+type ciRoleDeployer interface {
+	DeployCIRole(in *deploy.CreateCIRoleInput) (string, error)
+}
+
+type oidcProviderGetter interface {
+	OpenIDConnectProviderARN(host string) (string, error)
+}
+
+type workloadStacksWaiter interface {
+	WaitForWorkloadStacks(stackNames []string) (map[string]error, error)
+}
+
+type stackImportsLister interface {
+	Describe(name string) (*awscloudformation.StackDescription, error)
+	ListImports(exportName string) ([]string, error)
+}
+
+type stackResourcesLister interface {
+	TemplateBody(name string) (string, error)
+	StackResources(name string) ([]*awscloudformation.StackResource, error)
+}
+
+type stackDeleter interface {
+	DeleteAndWaitWithRoleARN(stackName, roleARN string) error
+}
+
+type networkInterfaceDeleter interface {
+	DeleteDetachedNetworkInterfaces(filters ...ec2.Filter) ([]string, error)
+}
+
+type wsManifestReader interface {
+	wlLister
+	wsEnvironmentsLister
+	ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error)
+	ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error)
+}
+
+type resourceSearcher interface {
+	Search(query string) ([]*resourceexplorer.Resource, error)
+}
+
+type resourceTagsGetter interface {
+	GetResourcesByARNs(arns []string) ([]*resourcegroups.Resource, error)
+}
+
+type wsWorkloadRenamer interface {
+	wsManifestReader
+	RenameWorkload(from, to string) (string, error)
+	RenameWorkloadInPipelines(from, to string) ([]string, error)
+	OverwriteWorkloadManifest(name string, raw []byte) (string, error)
+}
+
 type wsEnvironmentReader interface {
 	wsEnvironmentsLister
 	HasEnvironments() (bool, error)
@@ -348,10 +439,27 @@ type wsPipelineGetter interface {
 	ListPipelines() ([]workspace.PipelineManifest, error)
 }
 
+type wsPipelineTester interface {
+	wsPipelineManifestReader
+	relPath
+	ListPipelines() ([]workspace.PipelineManifest, error)
+	Path() string
+}
+
+type localCommandRunner interface {
+	CheckDockerEngineRunning() error
+	Run(context.Context, *dockerengine.RunOptions) error
+}
+
 type wsAppManager interface {
 	Summary() (*workspace.Summary, error)
 }
 
+type wsAppUpdater interface {
+	wsAppManager
+	UpdateApplication(name string) error
+}
+
 type wsAppManagerDeleter interface {
 	wsAppManager
 	wsFileDeleter
@@ -369,6 +477,17 @@ type bucketEmptier interface {
 	EmptyBucket(bucket string) error
 }
 
+type bucketLifecycleConfigurer interface {
+	PutLifecycleRule(bucket string, rule s3.LifecycleRule) error
+	DeleteLifecycleRule(bucket, id string) error
+}
+
+type artifactsCollector interface {
+	LifecycleRule(bucket, id string) (*s3.LifecycleRule, error)
+	ObjectVersionsBefore(bucket, prefix string, before time.Time) ([]s3.ObjectVersion, error)
+	DeleteObjectVersions(bucket string, versions []s3.ObjectVersion) error
+}
+
 type stackDescriber interface {
 	Resources() ([]*stackdescr.Resource, error)
 }
@@ -390,6 +509,11 @@ type svcRemoverFromApp interface {
 	RemoveServiceFromApp(app *config.Application, svcName string) error
 }
 
+type svcRegistrarInApp interface {
+	AddServiceToApp(app *config.Application, svcName string, opts ...cloudformation.AddWorkloadToAppOpt) error
+	svcRemoverFromApp
+}
+
 type jobRemoverFromApp interface {
 	RemoveJobFromApp(app *config.Application, jobName string) error
 }
@@ -398,10 +522,20 @@ type imageRemover interface {
 	ClearRepository(repoName string) error // implemented by ECR Service
 }
 
+type imageAttester interface {
+	PutAttestation(img ecr.ImageRef, statement []byte) error
+}
+
+type imageAttestationGetter interface {
+	ImageDigest(img ecr.ImageRef) (string, error)
+	Attestation(img ecr.ImageRef) ([]byte, error)
+}
+
 type pipelineDeployer interface {
 	CreatePipeline(bucketName string, stackConfig cloudformation.StackConfiguration) error
 	UpdatePipeline(bucketName string, stackConfig cloudformation.StackConfiguration) error
 	PipelineExists(stackConfig cloudformation.StackConfiguration) (bool, error)
+	PipelineResourceName(stackConfig cloudformation.StackConfiguration) (string, error)
 	DeletePipeline(pipeline deploy.Pipeline) error
 	AddPipelineResourcesToApp(app *config.Application, region string) error
 	Template(stackName string) (string, error)
@@ -438,8 +572,18 @@ type taskStackManager interface {
 	GetTaskStack(taskName string) (*deploy.TaskStackInfo, error)
 }
 
+type taskStackLister interface {
+	ListTaskStacks(appName, envName string) ([]deploy.TaskStackInfo, error)
+	ListDefaultTaskStacks() ([]deploy.TaskStackInfo, error)
+}
+
+type taskResourcesDeleter interface {
+	DeleteResources() error
+}
+
 type taskRunner interface {
 	Run() ([]*task.Task, error)
+	NetworkConfig() (*task.NetworkConfig, error)
 	CheckNonZeroExitCode([]*task.Task) error
 }
 
@@ -468,6 +612,18 @@ type statusDescriber interface {
 	Describe() (describe.HumanJSONStringer, error)
 }
 
+type trafficMirrorComparer interface {
+	Compare(since time.Duration) (*describe.TrafficMirrorComparison, error)
+}
+
+type scalingSimulator interface {
+	Simulate(cfg describe.ScalingConfig, since time.Duration) (*describe.ScalingSimulation, error)
+}
+
+type execChecker interface {
+	Check() (*describe.ExecDiagnosis, error)
+}
+
 type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 	PublicCIDRBlocks() ([]string, error)
@@ -475,6 +631,14 @@ type envDescriber interface {
 	ValidateCFServiceDomainAliases() error
 }
 
+type envCertificatesDescriber interface {
+	Certificates() (*describe.EnvCertificates, error)
+}
+
+type envCapacityDescriber interface {
+	Capacity() (*describe.EnvCapacity, error)
+}
+
 type versionCompatibilityChecker interface {
 	versionGetter
 	AvailableFeatures() ([]string, error)
@@ -486,12 +650,44 @@ type versionGetter interface {
 
 type appUpgrader interface {
 	UpgradeApplication(in *deploy.CreateAppInput) error
+	DetectAppStackSetDrift(appName string) error
+	AppStackSetInstances(appName string) ([]stackset.InstanceSummary, error)
+	RetryFailedAppStackSetInstances(appName string) ([]stackset.InstanceSummary, error)
+}
+
+type customResourcesUpgrader interface {
+	UpgradeCustomResourceRuntimes() (bool, error)
 }
 
 type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
 }
 
+type stageDeploymentsGetter interface {
+	StageDeployments(pipelineName string, stageNames []string, limit int) (map[string][]codepipeline.StageDeployment, error)
+	SourceRevision(pipelineName, executionID string) (string, error)
+}
+
+type artifactDownloader interface {
+	Download(bucket, key string) ([]byte, error)
+}
+
+type stackConfigDeployer interface {
+	DeployService(conf cloudformation.StackConfiguration, bucketName string, detach bool, opts ...awscloudformation.StackOption) error
+}
+
+type alarmStatusGetter interface {
+	AlarmsWithTags(tags map[string]string) ([]cloudwatch.AlarmStatus, error)
+	AlarmStatuses(opts ...cloudwatch.DescribeAlarmOpts) ([]cloudwatch.AlarmStatus, error)
+}
+
+type workloadStackRollbacker interface {
+	Template(stackName string) (string, error)
+	StackParameters(stackName string) (map[string]string, error)
+	GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error)
+	DeployService(conf cloudformation.StackConfiguration, bucketName string, detach bool, opts ...awscloudformation.StackOption) error
+}
+
 type deployedPipelineLister interface {
 	ListDeployedPipelines(appName string) ([]deploy.Pipeline, error)
 }
@@ -554,6 +750,7 @@ type wsSelector interface {
 	Service(prompt, help string) (string, error)
 	Job(prompt, help string) (string, error)
 	Workload(msg, help string) (string, error)
+	Workloads(msg, help string) ([]string, error)
 }
 
 type staticSourceSelector interface {
@@ -590,6 +787,38 @@ type ec2Client interface {
 	ListAZs() ([]ec2.AZ, error)
 }
 
+type subnetIPsCounter interface {
+	AvailableIPs(subnetIDs ...string) (map[string]int, error)
+}
+
+type lbTargetGroupsLister interface {
+	LoadBalancer(nameOrARN string) (*elbv2.LoadBalancer, error)
+	TargetGroups(lbARN string) ([]elbv2.TargetGroup, error)
+}
+
+type envOutputsGetter interface {
+	Outputs() (map[string]string, error)
+}
+
+type instanceTerminator interface {
+	TerminateInstances(ids ...string) error
+}
+
+type autoScalingGroupWaiter interface {
+	GroupNames(instanceIDs []string) (map[string]string, error)
+	WaitUntilInService(group string, excluded []string) error
+}
+
+type containerInstanceDrainer interface {
+	ContainerInstances(cluster string) ([]*awsecs.ContainerInstance, error)
+	DrainContainerInstances(cluster string, arns []string) error
+	WaitUntilContainerInstancesDrained(cluster string, arns []string) error
+}
+
+type envClusterGetter interface {
+	ClusterARN(app, env string) (string, error)
+}
+
 type serviceResumer interface {
 	ResumeService(string) error
 }
@@ -622,6 +851,10 @@ type apprunnerServiceDescriber interface {
 	ServiceARN(env string) (string, error)
 }
 
+type apprunnerServiceGetter interface {
+	Service(env string) (*apprunner.Service, error)
+}
+
 type ecsCommandExecutor interface {
 	ExecuteCommand(in awsecs.ExecuteCommandInput) error
 }
@@ -629,6 +862,24 @@ type ecsCommandExecutor interface {
 type ssmPluginManager interface {
 	ValidateBinary() error
 	InstallLatestBinary() error
+	InstallLocalBinary() error
+}
+
+type ssmPluginDownloader interface {
+	DownloadBinary() ([]byte, error)
+}
+
+type ssmPluginBundleInstaller interface {
+	InstallLocalBinaryFrom(bin []byte) error
+}
+
+type bundleImageBuilder interface {
+	Build(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) error
+	SaveImages(ctx context.Context, w io.Writer, uris ...string) error
+}
+
+type bundleImageLoader interface {
+	LoadImages(ctx context.Context, r io.Reader) error
 }
 
 type taskStopper interface {
@@ -680,6 +931,10 @@ type secretPutter interface {
 	PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error)
 }
 
+type secretLister interface {
+	ListSecrets(path string) ([]ssm.SecretMetadata, error)
+}
+
 type servicePauser interface {
 	PauseService(svcARN string) error
 }
@@ -695,18 +950,67 @@ type workloadDeployer interface {
 	DeployWorkload(in *clideploy.DeployWorkloadInput) (clideploy.ActionRecommender, error)
 	IsServiceAvailableInRegion(region string) (bool, error)
 	templateDiffer
+	configDiffer
+	variablesDiffer
+	costEstimator
 }
 
 type templateDiffer interface {
 	DeployDiff(inTmpl string) (string, error)
 }
 
+type configDiffer interface {
+	ConfigDiff(tmpl, params string) (string, error)
+}
+
+type variablesDiffer interface {
+	VariablesDiff(tmpl, params string) (string, error)
+}
+
+type costEstimator interface {
+	EstimateCost(tmpl, params string) (string, error)
+}
+
+type dockerNetworkManager interface {
+	CreateNetwork(name string) error
+	RemoveNetwork(name string) error
+}
+
 type dockerEngineRunner interface {
-	CheckDockerEngineRunning() error
+	Preflight(platforms []string) ([]dockerengine.PreflightWarning, error)
+	AnalyzeBuildContext(contextDir, dockerfile string) (*dockerengine.BuildContext, error)
+	BuildContextDigest(contextDir, dockerfile string) (string, error)
 	Run(context.Context, *dockerengine.RunOptions) error
+	Exec(ctx context.Context, container string, w io.Writer, cmd string, args ...string) error
+	CopyToContainer(ctx context.Context, src, container, dst string) error
+	Kill(ctx context.Context, container, signal string) error
 	IsContainerRunning(string) (bool, error)
+	ContainerState(ctx context.Context, containerName string) (dockerengine.ContainerState, error)
 	Stop(string) error
 	Rm(string) error
+	GetPlatform() (string, string, error)
+	runningContainerLister
+}
+
+type runningContainerLister interface {
+	RunningContainers(ctx context.Context, label string) ([]dockerengine.RunningContainer, error)
+}
+
+type localContainerExecutor interface {
+	runningContainerLister
+	IsContainerRunning(string) (bool, error)
+	InteractiveExec(ctx context.Context, container string, cmd []string) error
+}
+
+type localContainerInspector interface {
+	ContainerState(ctx context.Context, containerName string) (dockerengine.ContainerState, error)
+}
+
+type localContainerCleaner interface {
+	localContainerInspector
+	Stop(string) error
+	Rm(string) error
+	RemoveNetwork(name string) error
 }
 
 type workloadStackGenerator interface {
@@ -715,12 +1019,21 @@ type workloadStackGenerator interface {
 		*clideploy.GenerateCloudFormationTemplateOutput, error)
 	AddonsTemplate() (string, error)
 	templateDiffer
+	configDiffer
 }
 
 type runner interface {
 	Run() error
 }
 
+type jobTaskRunner interface {
+	RunTask(envVars map[string]string) (*awsecs.Task, error)
+}
+
+type nonZeroExitCodeChecker interface {
+	HasNonZeroExitCode(taskARNs []string, cluster string) error
+}
+
 type envDeployer interface {
 	DeployEnvironment(in *clideploy.DeployEnvironmentInput) error
 	Validate(*manifest.Environment) error
@@ -736,6 +1049,7 @@ type envPackager interface {
 	UploadArtifacts() (*clideploy.UploadEnvArtifactsOutput, error)
 	AddonsTemplate() (string, error)
 	templateDiffer
+	costEstimator
 }
 
 type stackConfiguration interface {
@@ -748,4 +1062,26 @@ type stackConfiguration interface {
 
 type secretGetter interface {
 	GetSecretValue(context.Context, string) (string, error)
+	GetSecretValues(ctx context.Context, names []string) (map[string]string, error)
+}
+
+type secretVersionGetter interface {
+	GetSecretVersionValue(ctx context.Context, name, versionStage, versionID string) (string, error)
+}
+
+type tuiProgram interface {
+	Run(m tui.Model) (tui.Model, error)
+	Send(msg tui.Msg)
+}
+
+type topologyResolver interface {
+	Resolve(app string) (*topology.Topology, error)
+}
+
+type clientsConfigDescriber interface {
+	Describe() (*describe.ClientsConfig, error)
+}
+
+type appDashboardDescriber interface {
+	Describe() (*describe.AppDashboard, error)
 }
@@ -6,10 +6,12 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
@@ -24,6 +26,7 @@ import (
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -55,6 +58,7 @@ type deleteJobVars struct {
 	skipConfirmation bool
 	name             string
 	envName          string
+	dryRun           bool
 }
 
 type deleteJobOpts struct {
@@ -70,11 +74,14 @@ type deleteJobOpts struct {
 	newWlDeleter    func(sess *session.Session) wlDeleter
 	newImageRemover func(sess *session.Session) imageRemover
 	newTaskStopper  func(sess *session.Session) taskStopper
+
+	// Dependencies to list the resources to delete with --dry-run.
+	w                 io.Writer
+	newStackResources func(sess *session.Session) stackResourcesLister
 }
 
-func newDeleteJobOpts(vars deleteJobVars) (*deleteJobOpts, error) {
-	provider := sessions.ImmutableProvider(sessions.UserAgentExtras("job delete"))
-	defaultSession, err := provider.Default()
+func newDeleteJobOpts(vars deleteJobVars, sessProvider *sessions.Provider) (*deleteJobOpts, error) {
+	defaultSession, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +94,7 @@ func newDeleteJobOpts(vars deleteJobVars) (*deleteJobOpts, error) {
 		spinner: termprogress.NewSpinner(log.DiagnosticWriter),
 		prompt:  prompt.New(),
 		sel:     selector.NewConfigSelector(prompter, store),
-		sess:    provider,
+		sess:    sessProvider,
 		appCFN:  cloudformation.New(defaultSession, cloudformation.WithProgressTracker(os.Stderr)),
 		newWlDeleter: func(session *session.Session) wlDeleter {
 			return cloudformation.New(session, cloudformation.WithProgressTracker(os.Stderr))
@@ -98,6 +105,10 @@ func newDeleteJobOpts(vars deleteJobVars) (*deleteJobOpts, error) {
 		newTaskStopper: func(session *session.Session) taskStopper {
 			return ecs.New(session)
 		},
+		w: os.Stdout,
+		newStackResources: func(session *session.Session) stackResourcesLister {
+			return awscloudformation.New(session)
+		},
 	}, nil
 }
 
@@ -123,7 +134,7 @@ func (o *deleteJobOpts) Ask() error {
 		return err
 	}
 
-	if o.skipConfirmation {
+	if o.skipConfirmation || o.dryRun {
 		return nil
 	}
 
@@ -161,6 +172,9 @@ func (o *deleteJobOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.dryRun {
+		return o.writeDeletionPlan(envs)
+	}
 
 	if err := o.deleteJobs(envs); err != nil {
 		return err
@@ -187,6 +201,28 @@ func (o *deleteJobOpts) Execute() error {
 	return nil
 }
 
+// writeDeletionPlan writes the resources that deleting the job would remove.
+func (o *deleteJobOpts) writeDeletionPlan(envs []*config.Environment) error {
+	var plan deletionPlan
+	for _, env := range envs {
+		sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		if err := plan.addStack(o.newStackResources(sess), stack.NameForWorkload(o.appName, env.Name, o.name)); err != nil {
+			return err
+		}
+	}
+	if !o.needsAppCleanup() {
+		plan.write(o.w, fmt.Sprintf("Deleting job %s from environment %s", o.name, o.envName))
+		return nil
+	}
+	plan.addRepos(clideploy.RepoName(o.appName, o.name), envs)
+	plan.add(deletionKindConfig, fmt.Sprintf("job %s", o.name), "")
+	plan.write(o.w, fmt.Sprintf("Deleting job %s from application %s", o.name, o.appName))
+	return nil
+}
+
 func (o *deleteJobOpts) validateEnvName() error {
 	if _, err := o.targetEnv(); err != nil {
 		return err
@@ -366,9 +402,12 @@ func buildJobDeleteCmd() *cobra.Command {
   /code $ copilot job delete --name report-generator --app my-app
 
   Delete the "report-generator" job without confirmation prompt.
-  /code $ copilot job delete --name report-generator --yes`,
+  /code $ copilot job delete --name report-generator --yes
+
+  List the resources that deleting the "report-generator" job would remove, without deleting them.
+  /code $ copilot job delete --name report-generator --dry-run`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeleteJobOpts(vars)
+			opts, err := newDeleteJobOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("job delete")))
 			if err != nil {
 				return err
 			}
@@ -380,5 +419,7 @@ func buildJobDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, deleteDryRunFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(dryRunFlag, yesFlag)
 	return cmd
 }
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -299,6 +300,7 @@ type deleteJobMocks struct {
 	jobCFN         *mocks.MockwlDeleter
 	ecr            *mocks.MockimageRemover
 	ecs            *mocks.MocktaskStopper
+	stackResources *mocks.MockstackResourcesLister
 }
 
 func TestDeleteJobOpts_Execute(t *testing.T) {
@@ -323,10 +325,12 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 		inAppName string
 		inEnvName string
 		inJobName string
+		inDryRun  bool
 
 		setupMocks func(mocks deleteJobMocks)
 
-		wantedError error
+		wantedOutput string
+		wantedError  error
 	}{
 		"happy path with no environment passed in as flag": {
 			inAppName: mockAppName,
@@ -407,6 +411,25 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("delete job stack: %w", testError),
 		},
+		"list the resources to delete from the environment with --dry-run without deleting them": {
+			inAppName: mockAppName,
+			inJobName: mockJobName,
+			inEnvName: mockEnvName,
+			inDryRun:  true,
+			setupMocks: func(mocks deleteJobMocks) {
+				mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil)
+				mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil)
+				mocks.stackResources.EXPECT().TemplateBody("badgoose-test-resizer").Return("Resources: {}", nil)
+				mocks.stackResources.EXPECT().StackResources("badgoose-test-resizer").Return(nil, nil)
+			},
+			wantedOutput: `Deleting job resizer from environment test would remove:
+
+  Type                  Name                   Data loss
+  ----                  ----                   ---------
+  CloudFormation stack  badgoose-test-resizer  -
+
+`,
+		},
 		"errors when deleting orphan tasks: failed to stop tasks": {
 			inAppName: mockAppName,
 			inJobName: mockJobName,
@@ -465,15 +488,18 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 				jobCFN:         mockJobCFN,
 				ecr:            mockImageRemover,
 				ecs:            mockTaskStopper,
+				stackResources: mocks.NewMockstackResourcesLister(ctrl),
 			}
 
 			test.setupMocks(mocks)
+			b := new(strings.Builder)
 
 			opts := deleteJobOpts{
 				deleteJobVars: deleteJobVars{
 					appName: test.inAppName,
 					name:    test.inJobName,
 					envName: test.inEnvName,
+					dryRun:  test.inDryRun,
 				},
 				store:           mockstore,
 				sess:            mockSession,
@@ -482,6 +508,10 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 				newWlDeleter:    mockGetJobCFN,
 				newImageRemover: mockGetImageRemover,
 				newTaskStopper:  mockNewTaskStopper,
+				w:               b,
+				newStackResources: func(_ *session.Session) stackResourcesLister {
+					return mocks.stackResources
+				},
 			}
 
 			// WHEN
@@ -493,6 +523,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			require.Equal(t, test.wantedOutput, b.String())
 		})
 	}
 }
@@ -52,6 +52,9 @@ type deployJobOpts struct {
 	prompt               prompter
 	gitShortCommit       string
 	diffWriter           io.Writer
+	retrier              *deploy.TransientRetrier
+
+	newImageAttestationGetter func(region string) (imageAttestationGetter, error)
 
 	// cached variables
 	targetApp         *config.Application
@@ -59,13 +62,13 @@ type deployJobOpts struct {
 	envSess           *session.Session
 	appliedDynamicMft manifest.DynamicWorkload
 	rootUserARN       string
+	provenanceTags    map[string]string
 
 	// Overridden in tests.
 	templateVersion string
 }
 
-func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("job deploy"))
+func newJobDeployOpts(vars deployWkldVars, sessProvider *sessions.Provider) (*deployJobOpts, error) {
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
@@ -89,6 +92,10 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 		cmd:             exec.NewCmd(),
 		templateVersion: version.LatestTemplateVersion(),
 		diffWriter:      os.Stdout,
+		retrier:         newTransientRetrier(vars),
+		newImageAttestationGetter: func(region string) (imageAttestationGetter, error) {
+			return ecrClientInRegion(sessProvider, region)
+		},
 	}
 	opts.newJobDeployer = func() (workloadDeployer, error) {
 		// NOTE: Defined as a struct member to facilitate unit testing.
@@ -102,12 +109,14 @@ func newJobDeployer(o *deployJobOpts) (workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read manifest file for %s: %w", o.name, err)
 	}
-	ovrdr, err := deploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider)
+	ovrdr, err := deploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider, overriderOptions(o.noCache)...)
 	if err != nil {
 		return nil, err
 	}
 
 	content := o.appliedDynamicMft.Manifest()
+	provenance := newProvenance(o.cmd, raw)
+	o.provenanceTags = provenance.Tags()
 	in := deploy.WorkloadDeployerInput{
 		SessionProvider: o.sessProvider,
 		Name:            o.name,
@@ -117,10 +126,13 @@ func newJobDeployer(o *deployJobOpts) (workloadDeployer, error) {
 			CustomTag:         o.imageTag,
 			GitShortCommitTag: o.gitShortCommit,
 		},
-		Mft:              content,
-		RawMft:           raw,
-		EnvVersionGetter: o.envFeaturesDescriber,
-		Overrider:        ovrdr,
+		Mft:                 content,
+		RawMft:              raw,
+		EnvVersionGetter:    o.envFeaturesDescriber,
+		Overrider:           ovrdr,
+		MaxBuildContextSize: int64(o.maxContextSize),
+		MaxParallelBuilds:   int(o.maxParallelBuilds),
+		Provenance:          provenance,
 	}
 	var deployer workloadDeployer
 	switch t := content.(type) {
@@ -192,6 +204,11 @@ func (o *deployJobOpts) Execute() error {
 	if err := validateWorkloadManifestCompatibilityWithEnv(o.ws, o.envFeaturesDescriber, mft, o.envName); err != nil {
 		return err
 	}
+	if o.requireProvenance {
+		if err := verifyImageProvenance(o.name, mft.Manifest(), o.newImageAttestationGetter); err != nil {
+			return err
+		}
+	}
 	deployer, err := o.newJobDeployer()
 	if err != nil {
 		return err
@@ -205,7 +222,11 @@ func (o *deployJobOpts) Execute() error {
 		log.Warningf(`Scheduled Job might not be available in region %s; proceed with caution.
 `, o.targetEnv.Region)
 	}
-	uploadOut, err := deployer.UploadArtifacts()
+	var uploadOut *deploy.UploadArtifactsOutput
+	err = o.retrier.Do("upload deploy resources", func() (err error) {
+		uploadOut, err = deployer.UploadArtifacts()
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("upload deploy resources for job %s: %w", o.name, err)
 	}
@@ -238,21 +259,27 @@ func (o *deployJobOpts) Execute() error {
 			return nil
 		}
 	}
-	if _, err = deployer.DeployWorkload(&deploy.DeployWorkloadInput{
+	deployIn := &deploy.DeployWorkloadInput{
 		StackRuntimeConfiguration: deploy.StackRuntimeConfiguration{
 			ImageDigests:       uploadOut.ImageDigests,
 			EnvFileARNs:        uploadOut.EnvFileARNs,
 			AddonsURL:          uploadOut.AddonsURL,
 			RootUserARN:        o.rootUserARN,
 			Version:            o.templateVersion,
-			Tags:               tags.Merge(o.targetApp.Tags, o.resourceTags),
+			Tags:               tags.Merge(o.targetApp.Tags, o.resourceTags, o.provenanceTags),
 			CustomResourceURLs: uploadOut.CustomResourceURLs,
 		},
 		Options: deploy.Options{
-			DisableRollback: o.disableRollback,
-			Detach:          o.detach,
+			DisableRollback:     o.disableRollback,
+			Detach:              o.detach,
+			CreateChangeSetOnly: o.changeSetOnly,
 		},
-	}); err != nil {
+	}
+	err = stackRetrier(o.retrier, o.disableRollback).Do("deploy the stack", func() error {
+		_, err := deployer.DeployWorkload(deployIn)
+		return err
+	})
+	if err != nil {
 		var errStackDeletedOnInterrupt *deploycfn.ErrStackDeletedOnInterrupt
 		var errStackUpdateCanceledOnInterrupt *deploycfn.ErrStackUpdateCanceledOnInterrupt
 		var errEmptyChangeSet *awscfn.ErrChangeSetEmpty
@@ -277,6 +304,10 @@ After fixing the deployment, you can:
 		}
 		return fmt.Errorf("deploy job %s to environment %s: %w", o.name, o.envName, err)
 	}
+	if o.changeSetOnly {
+		log.Infof("Run %s once the change set is approved to deploy job %s.\n", color.HighlightCode("copilot deployment execute <change set ARN>"), color.HighlightUserInput(o.name))
+		return nil
+	}
 	if o.detach {
 		return nil
 	}
@@ -316,9 +347,10 @@ func (o *deployJobOpts) configureClients() error {
 	o.rootUserARN = caller.RootUserARN
 
 	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
-		App:         o.appName,
-		Env:         o.envName,
-		ConfigStore: o.store,
+		App:             o.appName,
+		Env:             o.envName,
+		ConfigStore:     o.store,
+		SessionProvider: o.sessProvider,
 	})
 	if err != nil {
 		return err
@@ -330,6 +362,8 @@ func (o *deployJobOpts) configureClients() error {
 		Env:         o.envName,
 		Name:        o.name,
 		ConfigStore: o.store,
+
+		SessionProvider: o.sessProvider,
 	})
 	if err != nil {
 		return err
@@ -402,7 +436,7 @@ func buildJobDeployCmd() *cobra.Command {
   Deploys a job with additional resource tags.
   /code $ copilot job deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newJobDeployOpts(vars)
+			opts, err := newJobDeployOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("job deploy")))
 			if err != nil {
 				return err
 			}
@@ -418,5 +452,11 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 	cmd.Flags().BoolVar(&vars.detach, detachFlag, false, detachFlagDescription)
+	cmd.Flags().BoolVar(&vars.changeSetOnly, changeSetOnlyFlag, false, changeSetOnlyFlagDescription)
+	cmd.Flags().BoolVar(&vars.noRetry, noRetryFlag, false, noRetryFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
+	cmd.Flags().Var(&vars.maxContextSize, maxContextSizeFlag, maxContextSizeFlagDescription)
+	cmd.Flags().UintVar(&vars.maxParallelBuilds, maxParallelBuildsFlag, deploy.DefaultMaxParallelBuilds, maxParallelBuildsFlagDescription)
+	cmd.Flags().BoolVar(&vars.requireProvenance, requireProvenanceFlag, false, requireProvenanceFlagDescription)
 	return cmd
 }
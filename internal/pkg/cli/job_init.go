@@ -80,6 +80,7 @@ type initJobOpts struct {
 	// For workspace validation.
 	wsPendingCreation bool
 	wsAppName         string
+	app               *config.Application // Nil if the app is pending creation.
 
 	initParser          func(path string) dockerfileParser
 	initEnvDescriber    func(appName, envName string) (envDescriber, error)
@@ -151,10 +152,12 @@ func newInitJobOpts(vars initJobVars) (*initJobOpts, error) {
 func (o *initJobOpts) Validate() error {
 	// If this app is pending creation, we'll skip validation.
 	if !o.wsPendingCreation {
-		if err := validateWorkspaceApp(o.wsAppName, o.appName, o.store); err != nil {
+		app, err := validateWorkspaceApp(o.wsAppName, o.appName, o.store)
+		if err != nil {
 			return err
 		}
 		o.appName = o.wsAppName
+		o.app = app
 	}
 	if o.dockerfilePath != "" && o.image != "" {
 		return fmt.Errorf("--%s and --%s cannot be specified together", dockerFileFlag, imageFlag)
@@ -194,6 +197,9 @@ func (o *initJobOpts) Ask() error {
 	if err := validateJobName(o.name); err != nil {
 		return err
 	}
+	if err := validateNamingConvention(o.app, config.JobKind, o.name); err != nil {
+		return err
+	}
 	if err := o.validateDuplicateJob(); err != nil {
 		return err
 	}
@@ -186,6 +186,7 @@ func TestJobInitOpts_Ask(t *testing.T) {
 		inImage          string
 		inDockerfilePath string
 		inJobSchedule    string
+		inApp            *config.Application
 
 		setupMocks func(mocks initJobMocks)
 
@@ -201,6 +202,16 @@ func TestJobInitOpts_Ask(t *testing.T) {
 			inJobName: "1234",
 			wantedErr: fmt.Errorf("job name 1234 is invalid: %s", errBasicNameRegexNotMatched),
 		},
+		"error if the job name is reserved by the application": {
+			inJobType: wantedJobType,
+			inJobName: wantedJobName,
+			inApp: &config.Application{
+				Conventions: &config.NamingConventions{
+					Jobs: &config.NamingRule{Reserved: []string{wantedJobName}},
+				},
+			},
+			wantedErr: errors.New(`job name "cuteness-aggregator" is reserved`),
+		},
 		"error if fail to get job name": {
 			inJobType:        wantedJobType,
 			inJobName:        "",
@@ -526,6 +537,7 @@ type: Scheduled Job`), nil)
 				dockerEngine:     m.mockDockerEngine,
 				mftReader:        m.mockMftReader,
 				prompt:           m.mockPrompt,
+				app:              tc.inApp,
 			}
 
 			// WHEN
@@ -31,6 +31,7 @@ type listJobOpts struct {
 	// Dependencies
 	sel  appSelector
 	list workloadListWriter
+	out  *formattedWriter
 }
 
 func newListJobOpts(vars listWkldVars) (*listJobOpts, error) {
@@ -47,13 +48,17 @@ func newListJobOpts(vars listWkldVars) (*listJobOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	out := &formattedWriter{
+		format: vars.outputFormat,
+		w:      os.Stdout,
+	}
 	jobLister := &list.JobListWriter{
 		Ws:    ws,
 		Store: store,
-		Out:   os.Stdout,
+		Out:   out,
 
 		ShowLocalJobs: vars.shouldShowLocalWorkloads,
-		OutputJSON:    vars.shouldOutputJSON,
+		OutputJSON:    vars.shouldOutputJSON || vars.outputFormat.isSet(),
 	}
 
 	return &listJobOpts{
@@ -61,12 +66,13 @@ func newListJobOpts(vars listWkldVars) (*listJobOpts, error) {
 
 		list: jobLister,
 		sel:  selector.NewAppEnvSelector(prompt.New(), store),
+		out:  out,
 	}, nil
 }
 
-// Validate is a no-op for this command.
+// Validate returns an error if the values provided by the user are invalid.
 func (o *listJobOpts) Validate() error {
-	return nil
+	return o.outputFormat.validate()
 }
 
 // Ask asks for fields that are required but not passed in.
@@ -88,7 +94,7 @@ func (o *listJobOpts) Execute() error {
 	if err := o.list.Write(o.appName); err != nil {
 		return err
 	}
-	return nil
+	return o.out.flush()
 }
 
 func buildJobListCmd() *cobra.Command {
@@ -112,6 +118,8 @@ func buildJobListCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.format, formatFlag, "", formatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.query, queryFlag, "", queryFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldShowLocalWorkloads, localFlag, false, localJobFlagDescription)
 	return cmd
 }
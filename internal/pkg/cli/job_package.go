@@ -37,6 +37,7 @@ type packageJobVars struct {
 	uploadAssets       bool
 	showDiff           bool
 	allowWkldDowngrade bool
+	noCache            bool
 }
 
 type packageJobOpts struct {
@@ -86,6 +87,7 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 				outputDir:          o.outputDir,
 				uploadAssets:       o.uploadAssets,
 				allowWkldDowngrade: o.allowWkldDowngrade,
+				noCache:            o.noCache,
 			},
 			runner:            o.runner,
 			ws:                ws,
@@ -100,6 +102,10 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 			newStackGenerator: newWorkloadStackGenerator,
 			gitShortCommit:    imageTagFromGit(o.runner),
 			templateVersion:   version.LatestTemplateVersion(),
+			getenv:            os.Getenv,
+			newImageAttester: func(region string) (imageAttester, error) {
+				return ecrClientInRegion(sessProvider, region)
+			},
 		}
 	}
 	return opts, nil
@@ -208,6 +214,7 @@ func buildJobPackageCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, stackOutputDirFlag)
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, uploadAssetsFlag)
@@ -13,12 +13,16 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/runner/jobrunner"
+	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -29,6 +33,9 @@ type jobRunVars struct {
 	appName string
 	envName string
 	jobName string
+
+	envVars map[string]string
+	follow  bool
 }
 
 type jobRunOpts struct {
@@ -43,7 +50,11 @@ type jobRunOpts struct {
 	sessProvider *sessions.Provider
 
 	newRunner                  func() (runner, error)
+	newTaskRunner              func() (jobTaskRunner, error)
 	newEnvCompatibilityChecker func() (versionCompatibilityChecker, error)
+	// NOTE: newEventsWriter and newExitCodeChecker are only called when following the logs of the task (i.e. --follow is specified).
+	newEventsWriter    func(tasks []*task.Task) (eventsWriter, error)
+	newExitCodeChecker func() (nonZeroExitCodeChecker, error)
 }
 
 func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
@@ -84,6 +95,35 @@ func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
 			StateMachine: stepfunctions.New(sess),
 		}), nil
 	}
+	opts.newTaskRunner = func() (jobTaskRunner, error) {
+		sess, err := opts.envSession()
+		if err != nil {
+			return nil, err
+		}
+		return jobrunner.New(&jobrunner.Config{
+			App: opts.appName,
+			Env: opts.envName,
+			Job: opts.jobName,
+
+			CFN:                   cloudformation.New(sess),
+			StateMachineDescriber: stepfunctions.New(sess),
+			TaskRunner:            awsecs.New(sess),
+		}), nil
+	}
+	opts.newEventsWriter = func(tasks []*task.Task) (eventsWriter, error) {
+		sess, err := opts.envSession()
+		if err != nil {
+			return nil, err
+		}
+		return logging.NewJobTaskClient(sess, opts.appName, opts.envName, opts.jobName, tasks), nil
+	}
+	opts.newExitCodeChecker = func() (nonZeroExitCodeChecker, error) {
+		sess, err := opts.envSession()
+		if err != nil {
+			return nil, err
+		}
+		return ecs.New(sess), nil
+	}
 	opts.newEnvCompatibilityChecker = func() (versionCompatibilityChecker, error) {
 		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 			App:         opts.appName,
@@ -123,6 +163,9 @@ func (o *jobRunOpts) Execute() error {
 	if err := o.validateEnvCompatible(); err != nil {
 		return err
 	}
+	if len(o.envVars) != 0 || o.follow {
+		return o.runTask()
+	}
 	runner, err := o.newRunner()
 	if err != nil {
 		return err
@@ -134,6 +177,48 @@ func (o *jobRunOpts) Execute() error {
 	return nil
 }
 
+// runTask starts the task of the job directly in the cluster, since its state machine can't override
+// its environment variables nor tell which task it started.
+func (o *jobRunOpts) runTask() error {
+	runner, err := o.newTaskRunner()
+	if err != nil {
+		return err
+	}
+	ecsTask, err := runner.RunTask(o.envVars)
+	if err != nil {
+		return fmt.Errorf("execute job %q: %w", o.jobName, err)
+	}
+	taskARN, cluster := aws.StringValue(ecsTask.TaskArn), aws.StringValue(ecsTask.ClusterArn)
+	taskID, err := awsecs.TaskID(taskARN)
+	if err != nil {
+		return err
+	}
+	log.Successf("Started task %s of job %q.\n", taskID, o.jobName)
+	if !o.follow {
+		return nil
+	}
+
+	w, err := o.newEventsWriter([]*task.Task{
+		{
+			TaskARN:    taskARN,
+			ClusterARN: cluster,
+			StartedAt:  ecsTask.StartedAt,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if err := w.WriteEventsUntilStopped(); err != nil {
+		return fmt.Errorf("write events: %w", err)
+	}
+	log.Infof("Task %s of job %q has stopped.\n", taskID, o.jobName)
+	checker, err := o.newExitCodeChecker()
+	if err != nil {
+		return err
+	}
+	return checker.HasNonZeroExitCode([]string{taskARN}, cluster)
+}
+
 func (o *jobRunOpts) validateOrAskApp() error {
 	if o.appName != "" {
 		_, err := o.configStore.GetApplication(o.appName)
@@ -216,7 +301,10 @@ func buildJobRunCmd() *cobra.Command {
 		Long:  "Invoke a job in an environment.",
 		Example: `
   Run a job named "report-gen" in an application named "report" within a "test" environment
-  /code $ copilot job run -a report -n report-gen -e test`,
+  /code $ copilot job run -a report -n report-gen -e test
+  Run the task of the job with an overridden environment variable, stream its logs until it stops,
+  and exit with the exit code of its main container.
+  /code $ copilot job run -n report-gen -e test --env-vars REPORT_DATE=2023-01-01 --follow`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobRunOpts(vars)
 			if err != nil {
@@ -228,5 +316,7 @@ func buildJobRunCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.jobName, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.envVars, envVarsFlag, nil, jobRunEnvVarsFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, jobRunFollowFlagDescription)
 	return cmd
 }
@@ -8,8 +8,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -252,5 +255,147 @@ func TestJobRun_Execute(t *testing.T) {
 			}
 		})
 	}
+}
+
+func TestJobRun_ExecuteTask(t *testing.T) {
+	const (
+		taskARN    = "arn:aws:ecs:us-west-2:123456789012:task/my-cluster/4082490ee6c245e09d2145010aa1ba8d"
+		clusterARN = "arn:aws:ecs:us-west-2:123456789012:cluster/my-cluster"
+	)
+	runningTask := &awsecs.Task{
+		TaskArn:    aws.String(taskARN),
+		ClusterArn: aws.String(clusterARN),
+	}
+	testCases := map[string]struct {
+		envVars map[string]string
+		follow  bool
+
+		mockTaskRunner   func(m *mocks.MockjobTaskRunner)
+		mockEventsWriter func(m *mocks.MockeventsWriter)
+		mockExitCode     func(m *mocks.MocknonZeroExitCodeChecker)
+
+		wantedTasks []*task.Task
+		wantedError error
+	}{
+		"should return a wrapped error when the task cannot be run": {
+			envVars: map[string]string{"REPORT_DATE": "2023-01-01"},
+			mockTaskRunner: func(m *mocks.MockjobTaskRunner) {
+				m.EXPECT().RunTask(map[string]string{"REPORT_DATE": "2023-01-01"}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`execute job "report": some error`),
+		},
+		"runs the task with overridden environment variables without following its logs": {
+			envVars: map[string]string{"REPORT_DATE": "2023-01-01"},
+			mockTaskRunner: func(m *mocks.MockjobTaskRunner) {
+				m.EXPECT().RunTask(map[string]string{"REPORT_DATE": "2023-01-01"}).Return(runningTask, nil)
+			},
+		},
+		"should return a wrapped error when the logs cannot be written": {
+			follow: true,
+			mockTaskRunner: func(m *mocks.MockjobTaskRunner) {
+				m.EXPECT().RunTask(nil).Return(runningTask, nil)
+			},
+			mockEventsWriter: func(m *mocks.MockeventsWriter) {
+				m.EXPECT().WriteEventsUntilStopped().Return(errors.New("some error"))
+			},
+			wantedTasks: []*task.Task{{TaskARN: taskARN, ClusterARN: clusterARN}},
+			wantedError: errors.New("write events: some error"),
+		},
+		"returns the exit code of the task after following its logs": {
+			follow: true,
+			mockTaskRunner: func(m *mocks.MockjobTaskRunner) {
+				m.EXPECT().RunTask(nil).Return(runningTask, nil)
+			},
+			mockEventsWriter: func(m *mocks.MockeventsWriter) {
+				m.EXPECT().WriteEventsUntilStopped().Return(nil)
+			},
+			mockExitCode: func(m *mocks.MocknonZeroExitCodeChecker) {
+				m.EXPECT().HasNonZeroExitCode([]string{taskARN}, clusterARN).Return(&errExitCodeStub{code: 3})
+			},
+			wantedTasks: []*task.Task{{TaskARN: taskARN, ClusterARN: clusterARN}},
+			wantedError: &errExitCodeStub{code: 3},
+		},
+		"succeeds if the task exits successfully": {
+			follow: true,
+			mockTaskRunner: func(m *mocks.MockjobTaskRunner) {
+				m.EXPECT().RunTask(nil).Return(runningTask, nil)
+			},
+			mockEventsWriter: func(m *mocks.MockeventsWriter) {
+				m.EXPECT().WriteEventsUntilStopped().Return(nil)
+			},
+			mockExitCode: func(m *mocks.MocknonZeroExitCodeChecker) {
+				m.EXPECT().HasNonZeroExitCode([]string{taskARN}, clusterARN).Return(nil)
+			},
+			wantedTasks: []*task.Task{{TaskARN: taskARN, ClusterARN: clusterARN}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			envChecker := mocks.NewMockversionCompatibilityChecker(ctrl)
+			envChecker.EXPECT().Version().Return("v1.12.0", nil)
+			taskRunner := mocks.NewMockjobTaskRunner(ctrl)
+			tc.mockTaskRunner(taskRunner)
+			logsWriter := mocks.NewMockeventsWriter(ctrl)
+			if tc.mockEventsWriter != nil {
+				tc.mockEventsWriter(logsWriter)
+			}
+			exitCodeChecker := mocks.NewMocknonZeroExitCodeChecker(ctrl)
+			if tc.mockExitCode != nil {
+				tc.mockExitCode(exitCodeChecker)
+			}
+			var followedTasks []*task.Task
+
+			jobRunOpts := &jobRunOpts{
+				jobRunVars: jobRunVars{
+					appName: "finance",
+					envName: "test",
+					jobName: "report",
+					envVars: tc.envVars,
+					follow:  tc.follow,
+				},
+				newRunner: func() (runner, error) {
+					return mocks.NewMockrunner(ctrl), nil // The state machine should not be executed.
+				},
+				newTaskRunner: func() (jobTaskRunner, error) {
+					return taskRunner, nil
+				},
+				newEventsWriter: func(tasks []*task.Task) (eventsWriter, error) {
+					followedTasks = tasks
+					return logsWriter, nil
+				},
+				newExitCodeChecker: func() (nonZeroExitCodeChecker, error) {
+					return exitCodeChecker, nil
+				},
+				newEnvCompatibilityChecker: func() (versionCompatibilityChecker, error) {
+					return envChecker, nil
+				},
+			}
+
+			err := jobRunOpts.Execute()
+
+			// THEN
+			require.Equal(t, tc.wantedTasks, followedTasks)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type errExitCodeStub struct {
+	code int
+}
+
+func (e *errExitCodeStub) Error() string {
+	return fmt.Sprintf("exited with status code %d", e.code)
+}
 
+func (e *errExitCodeStub) ExitCode() int {
+	return e.code
 }
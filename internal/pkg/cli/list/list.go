@@ -5,12 +5,19 @@ package list
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/dustin/go-humanize"
 )
 
 const (
@@ -24,13 +31,23 @@ const (
 	// Workload types.
 	jobWorkloadType = "job"
 	svcWorkloadType = "service"
+
+	shortCommitLength = 7
 )
 
+var humanizeTime = humanize.Time // Overridden in tests.
+
 // Store wraps the methods required for interacting with config stores.
 type Store interface {
 	GetApplication(appName string) (*config.Application, error)
 	ListJobs(appName string) ([]*config.Workload, error)
 	ListServices(appName string) ([]*config.Workload, error)
+	ListEnvironments(appName string) ([]*config.Environment, error)
+}
+
+// StackDescriber wraps the method to describe a CloudFormation stack.
+type StackDescriber interface {
+	Describe(name string) (*cloudformation.StackDescription, error)
 }
 
 // Workspace wraps the methods required to interact with a local workspace.
@@ -55,11 +72,15 @@ type JobListWriter struct {
 // workspace or app in a human- or machine-readable format.
 type SvcListWriter struct {
 	ShowLocalSvcs bool
+	ShowDeployed  bool // Show what is deployed in each environment instead of the service types.
 	OutputJSON    bool
 
 	Store Store     // Client to retrieve application configuration and service metadata.
 	Ws    Workspace // Client to retrieve local jobs.
 	Out   io.Writer // The writer where output will be written.
+
+	// NewStackDescriber creates a client to describe the workload stacks in an environment.
+	NewStackDescriber func(env *config.Environment) (StackDescriber, error)
 }
 
 // ServiceJSONOutput is the output struct for service list.
@@ -67,6 +88,37 @@ type ServiceJSONOutput struct {
 	Services []*config.Workload `json:"services"`
 }
 
+// DeployedServicesJSONOutput is the output struct for service list with the deployments per environment.
+type DeployedServicesJSONOutput struct {
+	Environments []string                    `json:"environments"`
+	Services     []DeployedServiceJSONOutput `json:"services"`
+}
+
+// DeployedServiceJSONOutput is a service and its deployments keyed by environment name.
+type DeployedServiceJSONOutput struct {
+	Name        string                   `json:"name"`
+	Type        string                   `json:"type"`
+	Deployments map[string]SvcDeployment `json:"deployments"`
+}
+
+// SvcDeployment is the version of a service deployed in an environment.
+type SvcDeployment struct {
+	ImageTag       string    `json:"imageTag,omitempty"`
+	GitCommit      string    `json:"gitCommit,omitempty"`
+	LastDeployedAt time.Time `json:"lastDeployedAt"`
+}
+
+// version returns the image tag or, if the image isn't tagged, the short git commit of the deployment.
+func (d SvcDeployment) version() string {
+	if d.ImageTag != "" {
+		return d.ImageTag
+	}
+	if len(d.GitCommit) > shortCommitLength {
+		return d.GitCommit[:shortCommitLength]
+	}
+	return d.GitCommit
+}
+
 // JobJSONOutput is the output struct for job list.
 type JobJSONOutput struct {
 	Jobs []*config.Workload `json:"jobs"`
@@ -116,6 +168,9 @@ func (l *SvcListWriter) Write(appName string) error {
 		}
 		wklds = filterByName(wklds, localWklds)
 	}
+	if l.ShowDeployed {
+		return l.writeDeployed(appName, wklds)
+	}
 	if l.OutputJSON {
 		data, err := l.jsonOutputSvcs(wklds)
 		if err != nil {
@@ -128,6 +183,117 @@ func (l *SvcListWriter) Write(appName string) error {
 	return nil
 }
 
+// writeDeployed writes a matrix of the services and the environments of the application, where each cell
+// is the version of the service deployed in the environment and when it was last deployed.
+func (l *SvcListWriter) writeDeployed(appName string, svcs []*config.Workload) error {
+	envs, err := l.Store.ListEnvironments(appName)
+	if err != nil {
+		return fmt.Errorf("list environments: %w", err)
+	}
+	out := DeployedServicesJSONOutput{
+		Environments: make([]string, 0, len(envs)),
+		Services:     make([]DeployedServiceJSONOutput, 0, len(svcs)),
+	}
+	for _, svc := range svcs {
+		out.Services = append(out.Services, DeployedServiceJSONOutput{
+			Name:        svc.Name,
+			Type:        svc.Type,
+			Deployments: make(map[string]SvcDeployment),
+		})
+	}
+	for _, env := range envs {
+		out.Environments = append(out.Environments, env.Name)
+		describer, err := l.NewStackDescriber(env)
+		if err != nil {
+			return err
+		}
+		for i, svc := range svcs {
+			deployment, err := describeDeployment(describer, stack.NameForWorkload(appName, env.Name, svc.Name))
+			if err != nil {
+				return fmt.Errorf("describe %s %s in environment %s: %w", svcWorkloadType, svc.Name, env.Name, err)
+			}
+			if deployment != nil {
+				out.Services[i].Deployments[env.Name] = *deployment
+			}
+		}
+	}
+	if l.OutputJSON {
+		b, err := json.Marshal(out)
+		if err != nil {
+			return fmt.Errorf("marshal services: %w", err)
+		}
+		fmt.Fprintf(l.Out, "%s\n", b)
+		return nil
+	}
+	deployedHumanOutput(out, l.Out)
+	return nil
+}
+
+// describeDeployment returns the deployment of the workload stack, or nil if the stack doesn't exist.
+func describeDeployment(describer StackDescriber, stackName string) (*SvcDeployment, error) {
+	desc, err := describer.Describe(stackName)
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	deployment := &SvcDeployment{
+		LastDeployedAt: aws.TimeValue(desc.CreationTime),
+	}
+	if desc.LastUpdatedTime != nil {
+		deployment.LastDeployedAt = aws.TimeValue(desc.LastUpdatedTime)
+	}
+	for _, param := range desc.Parameters {
+		if aws.StringValue(param.ParameterKey) == stack.WorkloadContainerImageParamKey {
+			deployment.ImageTag = imageTag(aws.StringValue(param.ParameterValue))
+		}
+	}
+	for _, tag := range desc.Tags {
+		if aws.StringValue(tag.Key) == deploy.GitCommitTagKey {
+			deployment.GitCommit = aws.StringValue(tag.Value)
+		}
+	}
+	return deployment, nil
+}
+
+// imageTag returns the tag or the digest of an image reference such as "nginx:1.25" or "nginx@sha256:...".
+func imageTag(image string) string {
+	if i := strings.LastIndex(image, "@"); i != -1 {
+		return image[i+1:]
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	if i := strings.LastIndex(name, ":"); i != -1 {
+		return name[i+1:]
+	}
+	return ""
+}
+
+func deployedHumanOutput(out DeployedServicesJSONOutput, w io.Writer) {
+	writer := tabwriter.NewWriter(w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := append([]string{"Name"}, out.Environments...)
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underline(headers), "\t"))
+	for _, svc := range out.Services {
+		cells := []string{svc.Name}
+		for _, env := range out.Environments {
+			deployment, ok := svc.Deployments[env]
+			if !ok {
+				cells = append(cells, "-")
+				continue
+			}
+			version := deployment.version()
+			if version == "" {
+				version = "unknown version"
+			}
+			cells = append(cells, fmt.Sprintf("%s (%s)", version, humanizeTime(deployment.LastDeployedAt)))
+		}
+		fmt.Fprintf(writer, "%s\n", strings.Join(cells, "\t"))
+	}
+	writer.Flush()
+}
+
 func filterByName(wklds []*config.Workload, wantedNames []string) []*config.Workload {
 	isWanted := make(map[string]bool)
 	for _, name := range wantedNames {
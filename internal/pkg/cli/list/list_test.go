@@ -5,9 +5,14 @@ package list
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/cli/list/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/golang/mock/gomock"
@@ -339,3 +344,124 @@ func TestList_SvcListWriter(t *testing.T) {
 		})
 	}
 }
+
+func TestList_SvcListWriter_Deployed(t *testing.T) {
+	mockTime := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		return "2 days ago"
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	mockStack := func(image string, tags map[string]string) *cloudformation.StackDescription {
+		desc := &cloudformation.StackDescription{
+			CreationTime:    aws.Time(mockTime.Add(-time.Hour)),
+			LastUpdatedTime: aws.Time(mockTime),
+			Parameters: []*sdkcloudformation.Parameter{
+				{ParameterKey: aws.String("ContainerImage"), ParameterValue: aws.String(image)},
+			},
+		}
+		for k, v := range tags {
+			desc.Tags = append(desc.Tags, &sdkcloudformation.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		return desc
+	}
+	testCases := map[string]struct {
+		inputWriteJSON bool
+		mocking        func(store *mocks.MockStore, describer *mocks.MockStackDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"error if fail to list environments": {
+			mocking: func(store *mocks.MockStore, _ *mocks.MockStackDescriber) {
+				store.EXPECT().GetApplication("barnyard").Return(&config.Application{}, nil)
+				store.EXPECT().ListServices("barnyard").Return([]*config.Workload{{Name: "trough"}}, nil)
+				store.EXPECT().ListEnvironments("barnyard").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list environments: some error"),
+		},
+		"error if fail to describe a workload stack": {
+			mocking: func(store *mocks.MockStore, describer *mocks.MockStackDescriber) {
+				store.EXPECT().GetApplication("barnyard").Return(&config.Application{}, nil)
+				store.EXPECT().ListServices("barnyard").Return([]*config.Workload{{Name: "trough"}}, nil)
+				store.EXPECT().ListEnvironments("barnyard").Return([]*config.Environment{{Name: "test"}}, nil)
+				describer.EXPECT().Describe("barnyard-test-trough").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe service trough in environment test: some error"),
+		},
+		"should write a matrix of services and environments": {
+			mocking: func(store *mocks.MockStore, describer *mocks.MockStackDescriber) {
+				store.EXPECT().GetApplication("barnyard").Return(&config.Application{}, nil)
+				store.EXPECT().ListServices("barnyard").Return([]*config.Workload{
+					{Name: "trough", Type: "Backend Service"},
+					{Name: "gaggle", Type: "Load Balanced Web Service"},
+				}, nil)
+				store.EXPECT().ListEnvironments("barnyard").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				describer.EXPECT().Describe("barnyard-test-trough").Return(mockStack("1234.dkr.ecr.us-west-2.amazonaws.com/barnyard/trough:a1b2c3d", nil), nil)
+				describer.EXPECT().Describe("barnyard-test-gaggle").Return(mockStack("1234.dkr.ecr.us-west-2.amazonaws.com/barnyard/gaggle@sha256:abcd", map[string]string{
+					"copilot-git-commit": "e5f6a7b8c9d0e1f2",
+				}), nil)
+				describer.EXPECT().Describe("barnyard-prod-trough").Return(mockStack("localhost:5000/trough", map[string]string{
+					"copilot-git-commit": "e5f6a7b8c9d0e1f2",
+				}), nil)
+				describer.EXPECT().Describe("barnyard-prod-gaggle").Return(nil, &cloudformation.ErrStackNotFound{})
+			},
+			wantedContent: `Name                test                      prod
+----                ----                      ----
+trough              a1b2c3d (2 days ago)      e5f6a7b (2 days ago)
+gaggle              sha256:abcd (2 days ago)  -
+`,
+		},
+		"should write the deployments in JSON": {
+			inputWriteJSON: true,
+			mocking: func(store *mocks.MockStore, describer *mocks.MockStackDescriber) {
+				store.EXPECT().GetApplication("barnyard").Return(&config.Application{}, nil)
+				store.EXPECT().ListServices("barnyard").Return([]*config.Workload{
+					{Name: "trough", Type: "Backend Service"},
+				}, nil)
+				store.EXPECT().ListEnvironments("barnyard").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				describer.EXPECT().Describe("barnyard-test-trough").Return(mockStack("barnyard/trough:a1b2c3d", map[string]string{
+					"copilot-git-commit": "a1b2c3d4e5f6",
+				}), nil)
+				describer.EXPECT().Describe("barnyard-prod-trough").Return(nil, &cloudformation.ErrStackNotFound{})
+			},
+			wantedContent: `{"environments":["test","prod"],"services":[{"name":"trough","type":"Backend Service","deployments":{"test":{"imageTag":"a1b2c3d","gitCommit":"a1b2c3d4e5f6","lastDeployedAt":"2023-06-01T12:00:00Z"}}}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockStore(ctrl)
+			mockDescriber := mocks.NewMockStackDescriber(ctrl)
+			tc.mocking(mockStore, mockDescriber)
+			b := &bytes.Buffer{}
+			list := &SvcListWriter{
+				Store: mockStore,
+				Out:   b,
+
+				ShowDeployed: true,
+				OutputJSON:   tc.inputWriteJSON,
+				NewStackDescriber: func(env *config.Environment) (StackDescriber, error) {
+					return mockDescriber, nil
+				},
+			}
+
+			// WHEN
+			err := list.Write("barnyard")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
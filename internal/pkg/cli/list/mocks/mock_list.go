@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -49,6 +50,21 @@ func (mr *MockStoreMockRecorder) GetApplication(appName interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplication", reflect.TypeOf((*MockStore)(nil).GetApplication), appName)
 }
 
+// ListEnvironments mocks base method.
+func (m *MockStore) ListEnvironments(appName string) ([]*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments", appName)
+	ret0, _ := ret[0].([]*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockStoreMockRecorder) ListEnvironments(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockStore)(nil).ListEnvironments), appName)
+}
+
 // ListJobs mocks base method.
 func (m *MockStore) ListJobs(appName string) ([]*config.Workload, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +95,44 @@ func (mr *MockStoreMockRecorder) ListServices(appName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockStore)(nil).ListServices), appName)
 }
 
+// MockStackDescriber is a mock of StackDescriber interface.
+type MockStackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockStackDescriberMockRecorder
+}
+
+// MockStackDescriberMockRecorder is the mock recorder for MockStackDescriber.
+type MockStackDescriberMockRecorder struct {
+	mock *MockStackDescriber
+}
+
+// NewMockStackDescriber creates a new mock instance.
+func NewMockStackDescriber(ctrl *gomock.Controller) *MockStackDescriber {
+	mock := &MockStackDescriber{ctrl: ctrl}
+	mock.recorder = &MockStackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStackDescriber) EXPECT() *MockStackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockStackDescriber) Describe(name string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockStackDescriberMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockStackDescriber)(nil).Describe), name)
+}
+
 // MockWorkspace is a mock of Workspace interface.
 type MockWorkspace struct {
 	ctrl     *gomock.Controller
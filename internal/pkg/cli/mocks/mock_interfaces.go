@@ -8,14 +8,24 @@ import (
 	context "context"
 	encoding "encoding"
 	io "io"
+	http "net/http"
 	reflect "reflect"
+	time "time"
 
 	session "github.com/aws/aws-sdk-go/aws/session"
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	apprunner "github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	resourceexplorer "github.com/aws/copilot-cli/internal/pkg/aws/resourceexplorer"
+	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	secretsmanager "github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	deploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
@@ -36,6 +46,8 @@ import (
 	template "github.com/aws/copilot-cli/internal/pkg/template"
 	prompt "github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	selector "github.com/aws/copilot-cli/internal/pkg/term/selector"
+	tui "github.com/aws/copilot-cli/internal/pkg/term/tui"
+	topology "github.com/aws/copilot-cli/internal/pkg/topology"
 	workspace "github.com/aws/copilot-cli/internal/pkg/workspace"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -1715,6 +1727,44 @@ func (mr *MockrepositoryServiceMockRecorder) Login() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockrepositoryService)(nil).Login))
 }
 
+// MocktaskDefinitionGetter is a mock of taskDefinitionGetter interface.
+type MocktaskDefinitionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskDefinitionGetterMockRecorder
+}
+
+// MocktaskDefinitionGetterMockRecorder is the mock recorder for MocktaskDefinitionGetter.
+type MocktaskDefinitionGetterMockRecorder struct {
+	mock *MocktaskDefinitionGetter
+}
+
+// NewMocktaskDefinitionGetter creates a new mock instance.
+func NewMocktaskDefinitionGetter(ctrl *gomock.Controller) *MocktaskDefinitionGetter {
+	mock := &MocktaskDefinitionGetter{ctrl: ctrl}
+	mock.recorder = &MocktaskDefinitionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskDefinitionGetter) EXPECT() *MocktaskDefinitionGetterMockRecorder {
+	return m.recorder
+}
+
+// TaskDefinition mocks base method.
+func (m *MocktaskDefinitionGetter) TaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", app, env, svc)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MocktaskDefinitionGetterMockRecorder) TaskDefinition(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MocktaskDefinitionGetter)(nil).TaskDefinition), app, env, svc)
+}
+
 // MockecsLocalClient is a mock of ecsLocalClient interface.
 type MockecsLocalClient struct {
 	ctrl     *gomock.Controller
@@ -1738,6 +1788,36 @@ func (m *MockecsLocalClient) EXPECT() *MockecsLocalClientMockRecorder {
 	return m.recorder
 }
 
+// DescribeService mocks base method.
+func (m *MockecsLocalClient) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
+	ret0, _ := ret[0].(*ecs0.ServiceDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockecsLocalClientMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockecsLocalClient)(nil).DescribeService), app, env, svc)
+}
+
+// Service mocks base method.
+func (m *MockecsLocalClient) Service(app, env, svc string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", app, env, svc)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockecsLocalClientMockRecorder) Service(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsLocalClient)(nil).Service), app, env, svc)
+}
+
 // TaskDefinition mocks base method.
 func (m *MockecsLocalClient) TaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error) {
 	m.ctrl.T.Helper()
@@ -1753,6 +1833,82 @@ func (mr *MockecsLocalClientMockRecorder) TaskDefinition(app, env, svc interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockecsLocalClient)(nil).TaskDefinition), app, env, svc)
 }
 
+// MockportForwarder is a mock of portForwarder interface.
+type MockportForwarder struct {
+	ctrl     *gomock.Controller
+	recorder *MockportForwarderMockRecorder
+}
+
+// MockportForwarderMockRecorder is the mock recorder for MockportForwarder.
+type MockportForwarderMockRecorder struct {
+	mock *MockportForwarder
+}
+
+// NewMockportForwarder creates a new mock instance.
+func NewMockportForwarder(ctrl *gomock.Controller) *MockportForwarder {
+	mock := &MockportForwarder{ctrl: ctrl}
+	mock.recorder = &MockportForwarderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockportForwarder) EXPECT() *MockportForwarderMockRecorder {
+	return m.recorder
+}
+
+// StartPortForwardingSession mocks base method.
+func (m *MockportForwarder) StartPortForwardingSession(in ssm.PortForwardingSessionInput) (*ssm.PortForwardingSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPortForwardingSession", in)
+	ret0, _ := ret[0].(*ssm.PortForwardingSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPortForwardingSession indicates an expected call of StartPortForwardingSession.
+func (mr *MockportForwarderMockRecorder) StartPortForwardingSession(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPortForwardingSession", reflect.TypeOf((*MockportForwarder)(nil).StartPortForwardingSession), in)
+}
+
+// MockcommandSessionStarter is a mock of commandSessionStarter interface.
+type MockcommandSessionStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockcommandSessionStarterMockRecorder
+}
+
+// MockcommandSessionStarterMockRecorder is the mock recorder for MockcommandSessionStarter.
+type MockcommandSessionStarterMockRecorder struct {
+	mock *MockcommandSessionStarter
+}
+
+// NewMockcommandSessionStarter creates a new mock instance.
+func NewMockcommandSessionStarter(ctrl *gomock.Controller) *MockcommandSessionStarter {
+	mock := &MockcommandSessionStarter{ctrl: ctrl}
+	mock.recorder = &MockcommandSessionStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcommandSessionStarter) EXPECT() *MockcommandSessionStarterMockRecorder {
+	return m.recorder
+}
+
+// StartCommandSession mocks base method.
+func (m *MockcommandSessionStarter) StartCommandSession(in ecs.ExecuteCommandInput) (*ecs.CommandSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartCommandSession", in)
+	ret0, _ := ret[0].(*ecs.CommandSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartCommandSession indicates an expected call of StartCommandSession.
+func (mr *MockcommandSessionStarterMockRecorder) StartCommandSession(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartCommandSession", reflect.TypeOf((*MockcommandSessionStarter)(nil).StartCommandSession), in)
+}
+
 // MocklogEventsWriter is a mock of logEventsWriter interface.
 type MocklogEventsWriter struct {
 	ctrl     *gomock.Controller
@@ -2398,6 +2554,44 @@ func (mr *MockworkspacePathGetterMockRecorder) Path() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Path", reflect.TypeOf((*MockworkspacePathGetter)(nil).Path))
 }
 
+// MockhttpGetter is a mock of httpGetter interface.
+type MockhttpGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockhttpGetterMockRecorder
+}
+
+// MockhttpGetterMockRecorder is the mock recorder for MockhttpGetter.
+type MockhttpGetterMockRecorder struct {
+	mock *MockhttpGetter
+}
+
+// NewMockhttpGetter creates a new mock instance.
+func NewMockhttpGetter(ctrl *gomock.Controller) *MockhttpGetter {
+	mock := &MockhttpGetter{ctrl: ctrl}
+	mock.recorder = &MockhttpGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhttpGetter) EXPECT() *MockhttpGetterMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockhttpGetter) Get(url string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", url)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockhttpGetterMockRecorder) Get(url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockhttpGetter)(nil).Get), url)
+}
+
 // MockwsPipelineManifestReader is a mock of wsPipelineManifestReader interface.
 type MockwsPipelineManifestReader struct {
 	ctrl     *gomock.Controller
@@ -2777,6 +2971,59 @@ func (mr *MockwlListerMockRecorder) ListWorkloads() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwlLister)(nil).ListWorkloads))
 }
 
+// MockwsWorkloadManifestLister is a mock of wsWorkloadManifestLister interface.
+type MockwsWorkloadManifestLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWorkloadManifestListerMockRecorder
+}
+
+// MockwsWorkloadManifestListerMockRecorder is the mock recorder for MockwsWorkloadManifestLister.
+type MockwsWorkloadManifestListerMockRecorder struct {
+	mock *MockwsWorkloadManifestLister
+}
+
+// NewMockwsWorkloadManifestLister creates a new mock instance.
+func NewMockwsWorkloadManifestLister(ctrl *gomock.Controller) *MockwsWorkloadManifestLister {
+	mock := &MockwsWorkloadManifestLister{ctrl: ctrl}
+	mock.recorder = &MockwsWorkloadManifestListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWorkloadManifestLister) EXPECT() *MockwsWorkloadManifestListerMockRecorder {
+	return m.recorder
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsWorkloadManifestLister) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsWorkloadManifestListerMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsWorkloadManifestLister)(nil).ListWorkloads))
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWorkloadManifestLister) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWorkloadManifestListerMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWorkloadManifestLister)(nil).ReadWorkloadManifest), name)
+}
+
 // MockwsWorkloadReader is a mock of wsWorkloadReader interface.
 type MockwsWorkloadReader struct {
 	ctrl     *gomock.Controller
@@ -3390,1544 +3637,1501 @@ func (mr *MockwsWlDirReaderMockRecorder) WorkloadOverridesPath(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadOverridesPath", reflect.TypeOf((*MockwsWlDirReader)(nil).WorkloadOverridesPath), arg0)
 }
 
-// MockwsEnvironmentReader is a mock of wsEnvironmentReader interface.
-type MockwsEnvironmentReader struct {
+// MockchangeSetExecutor is a mock of changeSetExecutor interface.
+type MockchangeSetExecutor struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsEnvironmentReaderMockRecorder
+	recorder *MockchangeSetExecutorMockRecorder
 }
 
-// MockwsEnvironmentReaderMockRecorder is the mock recorder for MockwsEnvironmentReader.
-type MockwsEnvironmentReaderMockRecorder struct {
-	mock *MockwsEnvironmentReader
+// MockchangeSetExecutorMockRecorder is the mock recorder for MockchangeSetExecutor.
+type MockchangeSetExecutorMockRecorder struct {
+	mock *MockchangeSetExecutor
 }
 
-// NewMockwsEnvironmentReader creates a new mock instance.
-func NewMockwsEnvironmentReader(ctrl *gomock.Controller) *MockwsEnvironmentReader {
-	mock := &MockwsEnvironmentReader{ctrl: ctrl}
-	mock.recorder = &MockwsEnvironmentReaderMockRecorder{mock}
+// NewMockchangeSetExecutor creates a new mock instance.
+func NewMockchangeSetExecutor(ctrl *gomock.Controller) *MockchangeSetExecutor {
+	mock := &MockchangeSetExecutor{ctrl: ctrl}
+	mock.recorder = &MockchangeSetExecutorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsEnvironmentReader) EXPECT() *MockwsEnvironmentReaderMockRecorder {
+func (m *MockchangeSetExecutor) EXPECT() *MockchangeSetExecutorMockRecorder {
 	return m.recorder
 }
 
-// EnvAddonFileAbsPath mocks base method.
-func (m *MockwsEnvironmentReader) EnvAddonFileAbsPath(fName string) string {
+// ExecuteChangeSet mocks base method.
+func (m *MockchangeSetExecutor) ExecuteChangeSet(changeSetID string, disableRollback bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnvAddonFileAbsPath", fName)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", changeSetID, disableRollback)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// EnvAddonFileAbsPath indicates an expected call of EnvAddonFileAbsPath.
-func (mr *MockwsEnvironmentReaderMockRecorder) EnvAddonFileAbsPath(fName interface{}) *gomock.Call {
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet.
+func (mr *MockchangeSetExecutorMockRecorder) ExecuteChangeSet(changeSetID, disableRollback interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonFileAbsPath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvAddonFileAbsPath), fName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockchangeSetExecutor)(nil).ExecuteChangeSet), changeSetID, disableRollback)
 }
 
-// EnvAddonFilePath mocks base method.
-func (m *MockwsEnvironmentReader) EnvAddonFilePath(fName string) string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnvAddonFilePath", fName)
-	ret0, _ := ret[0].(string)
-	return ret0
+// MockciRoleDeployer is a mock of ciRoleDeployer interface.
+type MockciRoleDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockciRoleDeployerMockRecorder
 }
 
-// EnvAddonFilePath indicates an expected call of EnvAddonFilePath.
-func (mr *MockwsEnvironmentReaderMockRecorder) EnvAddonFilePath(fName interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonFilePath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvAddonFilePath), fName)
+// MockciRoleDeployerMockRecorder is the mock recorder for MockciRoleDeployer.
+type MockciRoleDeployerMockRecorder struct {
+	mock *MockciRoleDeployer
 }
 
-// EnvOverridesPath mocks base method.
-func (m *MockwsEnvironmentReader) EnvOverridesPath() string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnvOverridesPath")
-	ret0, _ := ret[0].(string)
-	return ret0
+// NewMockciRoleDeployer creates a new mock instance.
+func NewMockciRoleDeployer(ctrl *gomock.Controller) *MockciRoleDeployer {
+	mock := &MockciRoleDeployer{ctrl: ctrl}
+	mock.recorder = &MockciRoleDeployerMockRecorder{mock}
+	return mock
 }
 
-// EnvOverridesPath indicates an expected call of EnvOverridesPath.
-func (mr *MockwsEnvironmentReaderMockRecorder) EnvOverridesPath() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvOverridesPath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvOverridesPath))
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockciRoleDeployer) EXPECT() *MockciRoleDeployerMockRecorder {
+	return m.recorder
 }
 
-// HasEnvironments mocks base method.
-func (m *MockwsEnvironmentReader) HasEnvironments() (bool, error) {
+// DeployCIRole mocks base method.
+func (m *MockciRoleDeployer) DeployCIRole(in *deploy0.CreateCIRoleInput) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasEnvironments")
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "DeployCIRole", in)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// HasEnvironments indicates an expected call of HasEnvironments.
-func (mr *MockwsEnvironmentReaderMockRecorder) HasEnvironments() *gomock.Call {
+// DeployCIRole indicates an expected call of DeployCIRole.
+func (mr *MockciRoleDeployerMockRecorder) DeployCIRole(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasEnvironments", reflect.TypeOf((*MockwsEnvironmentReader)(nil).HasEnvironments))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployCIRole", reflect.TypeOf((*MockciRoleDeployer)(nil).DeployCIRole), in)
 }
 
-// ListEnvironments mocks base method.
-func (m *MockwsEnvironmentReader) ListEnvironments() ([]string, error) {
+// MockoidcProviderGetter is a mock of oidcProviderGetter interface.
+type MockoidcProviderGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockoidcProviderGetterMockRecorder
+}
+
+// MockoidcProviderGetterMockRecorder is the mock recorder for MockoidcProviderGetter.
+type MockoidcProviderGetterMockRecorder struct {
+	mock *MockoidcProviderGetter
+}
+
+// NewMockoidcProviderGetter creates a new mock instance.
+func NewMockoidcProviderGetter(ctrl *gomock.Controller) *MockoidcProviderGetter {
+	mock := &MockoidcProviderGetter{ctrl: ctrl}
+	mock.recorder = &MockoidcProviderGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoidcProviderGetter) EXPECT() *MockoidcProviderGetterMockRecorder {
+	return m.recorder
+}
+
+// OpenIDConnectProviderARN mocks base method.
+func (m *MockoidcProviderGetter) OpenIDConnectProviderARN(host string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEnvironments")
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "OpenIDConnectProviderARN", host)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEnvironments indicates an expected call of ListEnvironments.
-func (mr *MockwsEnvironmentReaderMockRecorder) ListEnvironments() *gomock.Call {
+// OpenIDConnectProviderARN indicates an expected call of OpenIDConnectProviderARN.
+func (mr *MockoidcProviderGetterMockRecorder) OpenIDConnectProviderARN(host interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockwsEnvironmentReader)(nil).ListEnvironments))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenIDConnectProviderARN", reflect.TypeOf((*MockoidcProviderGetter)(nil).OpenIDConnectProviderARN), host)
 }
 
-// ReadEnvironmentManifest mocks base method.
-func (m *MockwsEnvironmentReader) ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error) {
+// MockworkloadStacksWaiter is a mock of workloadStacksWaiter interface.
+type MockworkloadStacksWaiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadStacksWaiterMockRecorder
+}
+
+// MockworkloadStacksWaiterMockRecorder is the mock recorder for MockworkloadStacksWaiter.
+type MockworkloadStacksWaiterMockRecorder struct {
+	mock *MockworkloadStacksWaiter
+}
+
+// NewMockworkloadStacksWaiter creates a new mock instance.
+func NewMockworkloadStacksWaiter(ctrl *gomock.Controller) *MockworkloadStacksWaiter {
+	mock := &MockworkloadStacksWaiter{ctrl: ctrl}
+	mock.recorder = &MockworkloadStacksWaiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadStacksWaiter) EXPECT() *MockworkloadStacksWaiterMockRecorder {
+	return m.recorder
+}
+
+// WaitForWorkloadStacks mocks base method.
+func (m *MockworkloadStacksWaiter) WaitForWorkloadStacks(stackNames []string) (map[string]error, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", mftDirName)
-	ret0, _ := ret[0].(workspace.EnvironmentManifest)
+	ret := m.ctrl.Call(m, "WaitForWorkloadStacks", stackNames)
+	ret0, _ := ret[0].(map[string]error)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
-func (mr *MockwsEnvironmentReaderMockRecorder) ReadEnvironmentManifest(mftDirName interface{}) *gomock.Call {
+// WaitForWorkloadStacks indicates an expected call of WaitForWorkloadStacks.
+func (mr *MockworkloadStacksWaiterMockRecorder) WaitForWorkloadStacks(stackNames interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvironmentReader)(nil).ReadEnvironmentManifest), mftDirName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForWorkloadStacks", reflect.TypeOf((*MockworkloadStacksWaiter)(nil).WaitForWorkloadStacks), stackNames)
 }
 
-// MockwsPipelineReader is a mock of wsPipelineReader interface.
-type MockwsPipelineReader struct {
+// MockstackImportsLister is a mock of stackImportsLister interface.
+type MockstackImportsLister struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsPipelineReaderMockRecorder
+	recorder *MockstackImportsListerMockRecorder
 }
 
-// MockwsPipelineReaderMockRecorder is the mock recorder for MockwsPipelineReader.
-type MockwsPipelineReaderMockRecorder struct {
-	mock *MockwsPipelineReader
+// MockstackImportsListerMockRecorder is the mock recorder for MockstackImportsLister.
+type MockstackImportsListerMockRecorder struct {
+	mock *MockstackImportsLister
 }
 
-// NewMockwsPipelineReader creates a new mock instance.
-func NewMockwsPipelineReader(ctrl *gomock.Controller) *MockwsPipelineReader {
-	mock := &MockwsPipelineReader{ctrl: ctrl}
-	mock.recorder = &MockwsPipelineReaderMockRecorder{mock}
+// NewMockstackImportsLister creates a new mock instance.
+func NewMockstackImportsLister(ctrl *gomock.Controller) *MockstackImportsLister {
+	mock := &MockstackImportsLister{ctrl: ctrl}
+	mock.recorder = &MockstackImportsListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsPipelineReader) EXPECT() *MockwsPipelineReaderMockRecorder {
+func (m *MockstackImportsLister) EXPECT() *MockstackImportsListerMockRecorder {
 	return m.recorder
 }
 
-// ListPipelines mocks base method.
-func (m *MockwsPipelineReader) ListPipelines() ([]workspace.PipelineManifest, error) {
+// Describe mocks base method.
+func (m *MockstackImportsLister) Describe(name string) (*cloudformation0.StackDescription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPipelines")
-	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation0.StackDescription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPipelines indicates an expected call of ListPipelines.
-func (mr *MockwsPipelineReaderMockRecorder) ListPipelines() *gomock.Call {
+// Describe indicates an expected call of Describe.
+func (mr *MockstackImportsListerMockRecorder) Describe(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineReader)(nil).ListPipelines))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackImportsLister)(nil).Describe), name)
 }
 
-// ListWorkloads mocks base method.
-func (m *MockwsPipelineReader) ListWorkloads() ([]string, error) {
+// ListImports mocks base method.
+func (m *MockstackImportsLister) ListImports(exportName string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret := m.ctrl.Call(m, "ListImports", exportName)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListWorkloads indicates an expected call of ListWorkloads.
-func (mr *MockwsPipelineReaderMockRecorder) ListWorkloads() *gomock.Call {
+// ListImports indicates an expected call of ListImports.
+func (mr *MockstackImportsListerMockRecorder) ListImports(exportName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsPipelineReader)(nil).ListWorkloads))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImports", reflect.TypeOf((*MockstackImportsLister)(nil).ListImports), exportName)
 }
 
-// PipelineOverridesPath mocks base method.
-func (m *MockwsPipelineReader) PipelineOverridesPath(arg0 string) string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PipelineOverridesPath", arg0)
-	ret0, _ := ret[0].(string)
-	return ret0
+// MockstackResourcesLister is a mock of stackResourcesLister interface.
+type MockstackResourcesLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackResourcesListerMockRecorder
 }
 
-// PipelineOverridesPath indicates an expected call of PipelineOverridesPath.
-func (mr *MockwsPipelineReaderMockRecorder) PipelineOverridesPath(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineOverridesPath", reflect.TypeOf((*MockwsPipelineReader)(nil).PipelineOverridesPath), arg0)
+// MockstackResourcesListerMockRecorder is the mock recorder for MockstackResourcesLister.
+type MockstackResourcesListerMockRecorder struct {
+	mock *MockstackResourcesLister
 }
 
-// ReadPipelineManifest mocks base method.
-func (m *MockwsPipelineReader) ReadPipelineManifest(path string) (*manifest.Pipeline, error) {
+// NewMockstackResourcesLister creates a new mock instance.
+func NewMockstackResourcesLister(ctrl *gomock.Controller) *MockstackResourcesLister {
+	mock := &MockstackResourcesLister{ctrl: ctrl}
+	mock.recorder = &MockstackResourcesListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackResourcesLister) EXPECT() *MockstackResourcesListerMockRecorder {
+	return m.recorder
+}
+
+// StackResources mocks base method.
+func (m *MockstackResourcesLister) StackResources(name string) ([]*cloudformation0.StackResource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
-	ret0, _ := ret[0].(*manifest.Pipeline)
+	ret := m.ctrl.Call(m, "StackResources", name)
+	ret0, _ := ret[0].([]*cloudformation0.StackResource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
-func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
+// StackResources indicates an expected call of StackResources.
+func (mr *MockstackResourcesListerMockRecorder) StackResources(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest), path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackResourcesLister)(nil).StackResources), name)
 }
 
-// Rel mocks base method.
-func (m *MockwsPipelineReader) Rel(path string) (string, error) {
+// TemplateBody mocks base method.
+func (m *MockstackResourcesLister) TemplateBody(name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Rel", path)
+	ret := m.ctrl.Call(m, "TemplateBody", name)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Rel indicates an expected call of Rel.
-func (mr *MockwsPipelineReaderMockRecorder) Rel(path interface{}) *gomock.Call {
+// TemplateBody indicates an expected call of TemplateBody.
+func (mr *MockstackResourcesListerMockRecorder) TemplateBody(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rel", reflect.TypeOf((*MockwsPipelineReader)(nil).Rel), path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBody", reflect.TypeOf((*MockstackResourcesLister)(nil).TemplateBody), name)
 }
 
-// MockwsPipelineGetter is a mock of wsPipelineGetter interface.
-type MockwsPipelineGetter struct {
+// MockstackDeleter is a mock of stackDeleter interface.
+type MockstackDeleter struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsPipelineGetterMockRecorder
+	recorder *MockstackDeleterMockRecorder
 }
 
-// MockwsPipelineGetterMockRecorder is the mock recorder for MockwsPipelineGetter.
-type MockwsPipelineGetterMockRecorder struct {
-	mock *MockwsPipelineGetter
+// MockstackDeleterMockRecorder is the mock recorder for MockstackDeleter.
+type MockstackDeleterMockRecorder struct {
+	mock *MockstackDeleter
 }
 
-// NewMockwsPipelineGetter creates a new mock instance.
-func NewMockwsPipelineGetter(ctrl *gomock.Controller) *MockwsPipelineGetter {
-	mock := &MockwsPipelineGetter{ctrl: ctrl}
-	mock.recorder = &MockwsPipelineGetterMockRecorder{mock}
+// NewMockstackDeleter creates a new mock instance.
+func NewMockstackDeleter(ctrl *gomock.Controller) *MockstackDeleter {
+	mock := &MockstackDeleter{ctrl: ctrl}
+	mock.recorder = &MockstackDeleterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsPipelineGetter) EXPECT() *MockwsPipelineGetterMockRecorder {
+func (m *MockstackDeleter) EXPECT() *MockstackDeleterMockRecorder {
 	return m.recorder
 }
 
-// ListPipelines mocks base method.
-func (m *MockwsPipelineGetter) ListPipelines() ([]workspace.PipelineManifest, error) {
+// DeleteAndWaitWithRoleARN mocks base method.
+func (m *MockstackDeleter) DeleteAndWaitWithRoleARN(stackName, roleARN string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPipelines")
-	ret0, _ := ret[0].([]workspace.PipelineManifest)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteAndWaitWithRoleARN", stackName, roleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListPipelines indicates an expected call of ListPipelines.
-func (mr *MockwsPipelineGetterMockRecorder) ListPipelines() *gomock.Call {
+// DeleteAndWaitWithRoleARN indicates an expected call of DeleteAndWaitWithRoleARN.
+func (mr *MockstackDeleterMockRecorder) DeleteAndWaitWithRoleARN(stackName, roleARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineGetter)(nil).ListPipelines))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAndWaitWithRoleARN", reflect.TypeOf((*MockstackDeleter)(nil).DeleteAndWaitWithRoleARN), stackName, roleARN)
 }
 
-// ListWorkloads mocks base method.
-func (m *MockwsPipelineGetter) ListWorkloads() ([]string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListWorkloads")
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MocknetworkInterfaceDeleter is a mock of networkInterfaceDeleter interface.
+type MocknetworkInterfaceDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MocknetworkInterfaceDeleterMockRecorder
 }
 
-// ListWorkloads indicates an expected call of ListWorkloads.
-func (mr *MockwsPipelineGetterMockRecorder) ListWorkloads() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsPipelineGetter)(nil).ListWorkloads))
+// MocknetworkInterfaceDeleterMockRecorder is the mock recorder for MocknetworkInterfaceDeleter.
+type MocknetworkInterfaceDeleterMockRecorder struct {
+	mock *MocknetworkInterfaceDeleter
 }
 
-// ReadPipelineManifest mocks base method.
-func (m *MockwsPipelineGetter) ReadPipelineManifest(path string) (*manifest.Pipeline, error) {
+// NewMocknetworkInterfaceDeleter creates a new mock instance.
+func NewMocknetworkInterfaceDeleter(ctrl *gomock.Controller) *MocknetworkInterfaceDeleter {
+	mock := &MocknetworkInterfaceDeleter{ctrl: ctrl}
+	mock.recorder = &MocknetworkInterfaceDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknetworkInterfaceDeleter) EXPECT() *MocknetworkInterfaceDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteDetachedNetworkInterfaces mocks base method.
+func (m *MocknetworkInterfaceDeleter) DeleteDetachedNetworkInterfaces(filters ...ec2.Filter) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
-	ret0, _ := ret[0].(*manifest.Pipeline)
+	varargs := []interface{}{}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDetachedNetworkInterfaces", varargs...)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
-func (mr *MockwsPipelineGetterMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
+// DeleteDetachedNetworkInterfaces indicates an expected call of DeleteDetachedNetworkInterfaces.
+func (mr *MocknetworkInterfaceDeleterMockRecorder) DeleteDetachedNetworkInterfaces(filters ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineGetter)(nil).ReadPipelineManifest), path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDetachedNetworkInterfaces", reflect.TypeOf((*MocknetworkInterfaceDeleter)(nil).DeleteDetachedNetworkInterfaces), filters...)
 }
 
-// MockwsAppManager is a mock of wsAppManager interface.
-type MockwsAppManager struct {
+// MockwsManifestReader is a mock of wsManifestReader interface.
+type MockwsManifestReader struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsAppManagerMockRecorder
+	recorder *MockwsManifestReaderMockRecorder
 }
 
-// MockwsAppManagerMockRecorder is the mock recorder for MockwsAppManager.
-type MockwsAppManagerMockRecorder struct {
-	mock *MockwsAppManager
+// MockwsManifestReaderMockRecorder is the mock recorder for MockwsManifestReader.
+type MockwsManifestReaderMockRecorder struct {
+	mock *MockwsManifestReader
 }
 
-// NewMockwsAppManager creates a new mock instance.
-func NewMockwsAppManager(ctrl *gomock.Controller) *MockwsAppManager {
-	mock := &MockwsAppManager{ctrl: ctrl}
-	mock.recorder = &MockwsAppManagerMockRecorder{mock}
+// NewMockwsManifestReader creates a new mock instance.
+func NewMockwsManifestReader(ctrl *gomock.Controller) *MockwsManifestReader {
+	mock := &MockwsManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsManifestReaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsAppManager) EXPECT() *MockwsAppManagerMockRecorder {
+func (m *MockwsManifestReader) EXPECT() *MockwsManifestReaderMockRecorder {
 	return m.recorder
 }
 
-// Summary mocks base method.
-func (m *MockwsAppManager) Summary() (*workspace.Summary, error) {
+// ListEnvironments mocks base method.
+func (m *MockwsManifestReader) ListEnvironments() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Summary")
-	ret0, _ := ret[0].(*workspace.Summary)
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Summary indicates an expected call of Summary.
-func (mr *MockwsAppManagerMockRecorder) Summary() *gomock.Call {
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockwsManifestReaderMockRecorder) ListEnvironments() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsAppManager)(nil).Summary))
-}
-
-// MockwsAppManagerDeleter is a mock of wsAppManagerDeleter interface.
-type MockwsAppManagerDeleter struct {
-	ctrl     *gomock.Controller
-	recorder *MockwsAppManagerDeleterMockRecorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockwsManifestReader)(nil).ListEnvironments))
 }
 
-// MockwsAppManagerDeleterMockRecorder is the mock recorder for MockwsAppManagerDeleter.
-type MockwsAppManagerDeleterMockRecorder struct {
-	mock *MockwsAppManagerDeleter
-}
-
-// NewMockwsAppManagerDeleter creates a new mock instance.
-func NewMockwsAppManagerDeleter(ctrl *gomock.Controller) *MockwsAppManagerDeleter {
-	mock := &MockwsAppManagerDeleter{ctrl: ctrl}
-	mock.recorder = &MockwsAppManagerDeleterMockRecorder{mock}
-	return mock
+// ListWorkloads mocks base method.
+func (m *MockwsManifestReader) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsAppManagerDeleter) EXPECT() *MockwsAppManagerDeleterMockRecorder {
-	return m.recorder
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsManifestReaderMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsManifestReader)(nil).ListWorkloads))
 }
 
-// DeleteWorkspaceFile mocks base method.
-func (m *MockwsAppManagerDeleter) DeleteWorkspaceFile() error {
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsManifestReader) ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteWorkspaceFile")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", mftDirName)
+	ret0, _ := ret[0].(workspace.EnvironmentManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteWorkspaceFile indicates an expected call of DeleteWorkspaceFile.
-func (mr *MockwsAppManagerDeleterMockRecorder) DeleteWorkspaceFile() *gomock.Call {
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsManifestReaderMockRecorder) ReadEnvironmentManifest(mftDirName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceFile", reflect.TypeOf((*MockwsAppManagerDeleter)(nil).DeleteWorkspaceFile))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsManifestReader)(nil).ReadEnvironmentManifest), mftDirName)
 }
 
-// Summary mocks base method.
-func (m *MockwsAppManagerDeleter) Summary() (*workspace.Summary, error) {
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsManifestReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Summary")
-	ret0, _ := ret[0].(*workspace.Summary)
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Summary indicates an expected call of Summary.
-func (mr *MockwsAppManagerDeleterMockRecorder) Summary() *gomock.Call {
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsManifestReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsAppManagerDeleter)(nil).Summary))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsManifestReader)(nil).ReadWorkloadManifest), name)
 }
 
-// MockwsWriter is a mock of wsWriter interface.
-type MockwsWriter struct {
+// MockresourceSearcher is a mock of resourceSearcher interface.
+type MockresourceSearcher struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsWriterMockRecorder
+	recorder *MockresourceSearcherMockRecorder
 }
 
-// MockwsWriterMockRecorder is the mock recorder for MockwsWriter.
-type MockwsWriterMockRecorder struct {
-	mock *MockwsWriter
+// MockresourceSearcherMockRecorder is the mock recorder for MockresourceSearcher.
+type MockresourceSearcherMockRecorder struct {
+	mock *MockresourceSearcher
 }
 
-// NewMockwsWriter creates a new mock instance.
-func NewMockwsWriter(ctrl *gomock.Controller) *MockwsWriter {
-	mock := &MockwsWriter{ctrl: ctrl}
-	mock.recorder = &MockwsWriterMockRecorder{mock}
+// NewMockresourceSearcher creates a new mock instance.
+func NewMockresourceSearcher(ctrl *gomock.Controller) *MockresourceSearcher {
+	mock := &MockresourceSearcher{ctrl: ctrl}
+	mock.recorder = &MockresourceSearcherMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsWriter) EXPECT() *MockwsWriterMockRecorder {
+func (m *MockresourceSearcher) EXPECT() *MockresourceSearcherMockRecorder {
 	return m.recorder
 }
 
-// Write mocks base method.
-func (m *MockwsWriter) Write(content encoding.BinaryMarshaler, path string) (string, error) {
+// Search mocks base method.
+func (m *MockresourceSearcher) Search(query string) ([]*resourceexplorer.Resource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Write", content, path)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Search", query)
+	ret0, _ := ret[0].([]*resourceexplorer.Resource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Write indicates an expected call of Write.
-func (mr *MockwsWriterMockRecorder) Write(content, path interface{}) *gomock.Call {
+// Search indicates an expected call of Search.
+func (mr *MockresourceSearcherMockRecorder) Search(query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockwsWriter)(nil).Write), content, path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockresourceSearcher)(nil).Search), query)
 }
 
-// Mockuploader is a mock of uploader interface.
-type Mockuploader struct {
+// MockresourceTagsGetter is a mock of resourceTagsGetter interface.
+type MockresourceTagsGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockuploaderMockRecorder
+	recorder *MockresourceTagsGetterMockRecorder
 }
 
-// MockuploaderMockRecorder is the mock recorder for Mockuploader.
-type MockuploaderMockRecorder struct {
-	mock *Mockuploader
+// MockresourceTagsGetterMockRecorder is the mock recorder for MockresourceTagsGetter.
+type MockresourceTagsGetterMockRecorder struct {
+	mock *MockresourceTagsGetter
 }
 
-// NewMockuploader creates a new mock instance.
-func NewMockuploader(ctrl *gomock.Controller) *Mockuploader {
-	mock := &Mockuploader{ctrl: ctrl}
-	mock.recorder = &MockuploaderMockRecorder{mock}
+// NewMockresourceTagsGetter creates a new mock instance.
+func NewMockresourceTagsGetter(ctrl *gomock.Controller) *MockresourceTagsGetter {
+	mock := &MockresourceTagsGetter{ctrl: ctrl}
+	mock.recorder = &MockresourceTagsGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockuploader) EXPECT() *MockuploaderMockRecorder {
+func (m *MockresourceTagsGetter) EXPECT() *MockresourceTagsGetterMockRecorder {
 	return m.recorder
 }
 
-// Upload mocks base method.
-func (m *Mockuploader) Upload(bucket, key string, data io.Reader) (string, error) {
+// GetResourcesByARNs mocks base method.
+func (m *MockresourceTagsGetter) GetResourcesByARNs(arns []string) ([]*resourcegroups.Resource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Upload", bucket, key, data)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetResourcesByARNs", arns)
+	ret0, _ := ret[0].([]*resourcegroups.Resource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Upload indicates an expected call of Upload.
-func (mr *MockuploaderMockRecorder) Upload(bucket, key, data interface{}) *gomock.Call {
+// GetResourcesByARNs indicates an expected call of GetResourcesByARNs.
+func (mr *MockresourceTagsGetterMockRecorder) GetResourcesByARNs(arns interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*Mockuploader)(nil).Upload), bucket, key, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesByARNs", reflect.TypeOf((*MockresourceTagsGetter)(nil).GetResourcesByARNs), arns)
 }
 
-// MockbucketEmptier is a mock of bucketEmptier interface.
-type MockbucketEmptier struct {
+// MockwsWorkloadRenamer is a mock of wsWorkloadRenamer interface.
+type MockwsWorkloadRenamer struct {
 	ctrl     *gomock.Controller
-	recorder *MockbucketEmptierMockRecorder
+	recorder *MockwsWorkloadRenamerMockRecorder
 }
 
-// MockbucketEmptierMockRecorder is the mock recorder for MockbucketEmptier.
-type MockbucketEmptierMockRecorder struct {
-	mock *MockbucketEmptier
+// MockwsWorkloadRenamerMockRecorder is the mock recorder for MockwsWorkloadRenamer.
+type MockwsWorkloadRenamerMockRecorder struct {
+	mock *MockwsWorkloadRenamer
 }
 
-// NewMockbucketEmptier creates a new mock instance.
-func NewMockbucketEmptier(ctrl *gomock.Controller) *MockbucketEmptier {
-	mock := &MockbucketEmptier{ctrl: ctrl}
-	mock.recorder = &MockbucketEmptierMockRecorder{mock}
+// NewMockwsWorkloadRenamer creates a new mock instance.
+func NewMockwsWorkloadRenamer(ctrl *gomock.Controller) *MockwsWorkloadRenamer {
+	mock := &MockwsWorkloadRenamer{ctrl: ctrl}
+	mock.recorder = &MockwsWorkloadRenamerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockbucketEmptier) EXPECT() *MockbucketEmptierMockRecorder {
+func (m *MockwsWorkloadRenamer) EXPECT() *MockwsWorkloadRenamerMockRecorder {
 	return m.recorder
 }
 
-// EmptyBucket mocks base method.
-func (m *MockbucketEmptier) EmptyBucket(bucket string) error {
+// ListEnvironments mocks base method.
+func (m *MockwsWorkloadRenamer) ListEnvironments() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EmptyBucket", bucket)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EmptyBucket indicates an expected call of EmptyBucket.
-func (mr *MockbucketEmptierMockRecorder) EmptyBucket(bucket interface{}) *gomock.Call {
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockwsWorkloadRenamerMockRecorder) ListEnvironments() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyBucket", reflect.TypeOf((*MockbucketEmptier)(nil).EmptyBucket), bucket)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockwsWorkloadRenamer)(nil).ListEnvironments))
 }
 
-// MockstackDescriber is a mock of stackDescriber interface.
-type MockstackDescriber struct {
-	ctrl     *gomock.Controller
-	recorder *MockstackDescriberMockRecorder
+// ListWorkloads mocks base method.
+func (m *MockwsWorkloadRenamer) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockstackDescriberMockRecorder is the mock recorder for MockstackDescriber.
-type MockstackDescriberMockRecorder struct {
-	mock *MockstackDescriber
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsWorkloadRenamerMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsWorkloadRenamer)(nil).ListWorkloads))
 }
 
-// NewMockstackDescriber creates a new mock instance.
-func NewMockstackDescriber(ctrl *gomock.Controller) *MockstackDescriber {
-	mock := &MockstackDescriber{ctrl: ctrl}
-	mock.recorder = &MockstackDescriberMockRecorder{mock}
-	return mock
+// OverwriteWorkloadManifest mocks base method.
+func (m *MockwsWorkloadRenamer) OverwriteWorkloadManifest(name string, raw []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverwriteWorkloadManifest", name, raw)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockstackDescriber) EXPECT() *MockstackDescriberMockRecorder {
-	return m.recorder
+// OverwriteWorkloadManifest indicates an expected call of OverwriteWorkloadManifest.
+func (mr *MockwsWorkloadRenamerMockRecorder) OverwriteWorkloadManifest(name, raw interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverwriteWorkloadManifest", reflect.TypeOf((*MockwsWorkloadRenamer)(nil).OverwriteWorkloadManifest), name, raw)
 }
 
-// Resources mocks base method.
-func (m *MockstackDescriber) Resources() ([]*stack0.Resource, error) {
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsWorkloadRenamer) ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Resources")
-	ret0, _ := ret[0].([]*stack0.Resource)
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", mftDirName)
+	ret0, _ := ret[0].(workspace.EnvironmentManifest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Resources indicates an expected call of Resources.
-func (mr *MockstackDescriberMockRecorder) Resources() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockstackDescriber)(nil).Resources))
-}
-
-// MockenvironmentDeployer is a mock of environmentDeployer interface.
-type MockenvironmentDeployer struct {
-	ctrl     *gomock.Controller
-	recorder *MockenvironmentDeployerMockRecorder
-}
-
-// MockenvironmentDeployerMockRecorder is the mock recorder for MockenvironmentDeployer.
-type MockenvironmentDeployerMockRecorder struct {
-	mock *MockenvironmentDeployer
-}
-
-// NewMockenvironmentDeployer creates a new mock instance.
-func NewMockenvironmentDeployer(ctrl *gomock.Controller) *MockenvironmentDeployer {
-	mock := &MockenvironmentDeployer{ctrl: ctrl}
-	mock.recorder = &MockenvironmentDeployerMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockenvironmentDeployer) EXPECT() *MockenvironmentDeployerMockRecorder {
-	return m.recorder
-}
-
-// CreateAndRenderEnvironment mocks base method.
-func (m *MockenvironmentDeployer) CreateAndRenderEnvironment(conf cloudformation1.StackConfiguration, bucketARN string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAndRenderEnvironment", conf, bucketARN)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CreateAndRenderEnvironment indicates an expected call of CreateAndRenderEnvironment.
-func (mr *MockenvironmentDeployerMockRecorder) CreateAndRenderEnvironment(conf, bucketARN interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndRenderEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).CreateAndRenderEnvironment), conf, bucketARN)
-}
-
-// DeleteEnvironment mocks base method.
-func (m *MockenvironmentDeployer) DeleteEnvironment(appName, envName, cfnExecRoleARN string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEnvironment", appName, envName, cfnExecRoleARN)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteEnvironment indicates an expected call of DeleteEnvironment.
-func (mr *MockenvironmentDeployerMockRecorder) DeleteEnvironment(appName, envName, cfnExecRoleARN interface{}) *gomock.Call {
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsWorkloadRenamerMockRecorder) ReadEnvironmentManifest(mftDirName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeleteEnvironment), appName, envName, cfnExecRoleARN)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsWorkloadRenamer)(nil).ReadEnvironmentManifest), mftDirName)
 }
 
-// GetEnvironment mocks base method.
-func (m *MockenvironmentDeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWorkloadRenamer) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEnvironment", appName, envName)
-	ret0, _ := ret[0].(*config.Environment)
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEnvironment indicates an expected call of GetEnvironment.
-func (mr *MockenvironmentDeployerMockRecorder) GetEnvironment(appName, envName interface{}) *gomock.Call {
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWorkloadRenamerMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).GetEnvironment), appName, envName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWorkloadRenamer)(nil).ReadWorkloadManifest), name)
 }
 
-// Template mocks base method.
-func (m *MockenvironmentDeployer) Template(stackName string) (string, error) {
+// RenameWorkload mocks base method.
+func (m *MockwsWorkloadRenamer) RenameWorkload(from, to string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Template", stackName)
+	ret := m.ctrl.Call(m, "RenameWorkload", from, to)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Template indicates an expected call of Template.
-func (mr *MockenvironmentDeployerMockRecorder) Template(stackName interface{}) *gomock.Call {
+// RenameWorkload indicates an expected call of RenameWorkload.
+func (mr *MockwsWorkloadRenamerMockRecorder) RenameWorkload(from, to interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockenvironmentDeployer)(nil).Template), stackName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameWorkload", reflect.TypeOf((*MockwsWorkloadRenamer)(nil).RenameWorkload), from, to)
 }
 
-// UpdateEnvironmentTemplate mocks base method.
-func (m *MockenvironmentDeployer) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN string) error {
+// RenameWorkloadInPipelines mocks base method.
+func (m *MockwsWorkloadRenamer) RenameWorkloadInPipelines(from, to string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEnvironmentTemplate", appName, envName, templateBody, cfnExecRoleARN)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RenameWorkloadInPipelines", from, to)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateEnvironmentTemplate indicates an expected call of UpdateEnvironmentTemplate.
-func (mr *MockenvironmentDeployerMockRecorder) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN interface{}) *gomock.Call {
+// RenameWorkloadInPipelines indicates an expected call of RenameWorkloadInPipelines.
+func (mr *MockwsWorkloadRenamerMockRecorder) RenameWorkloadInPipelines(from, to interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentTemplate", reflect.TypeOf((*MockenvironmentDeployer)(nil).UpdateEnvironmentTemplate), appName, envName, templateBody, cfnExecRoleARN)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameWorkloadInPipelines", reflect.TypeOf((*MockwsWorkloadRenamer)(nil).RenameWorkloadInPipelines), from, to)
 }
 
-// MockwlDeleter is a mock of wlDeleter interface.
-type MockwlDeleter struct {
+// MockwsEnvironmentReader is a mock of wsEnvironmentReader interface.
+type MockwsEnvironmentReader struct {
 	ctrl     *gomock.Controller
-	recorder *MockwlDeleterMockRecorder
+	recorder *MockwsEnvironmentReaderMockRecorder
 }
 
-// MockwlDeleterMockRecorder is the mock recorder for MockwlDeleter.
-type MockwlDeleterMockRecorder struct {
-	mock *MockwlDeleter
+// MockwsEnvironmentReaderMockRecorder is the mock recorder for MockwsEnvironmentReader.
+type MockwsEnvironmentReaderMockRecorder struct {
+	mock *MockwsEnvironmentReader
 }
 
-// NewMockwlDeleter creates a new mock instance.
-func NewMockwlDeleter(ctrl *gomock.Controller) *MockwlDeleter {
-	mock := &MockwlDeleter{ctrl: ctrl}
-	mock.recorder = &MockwlDeleterMockRecorder{mock}
+// NewMockwsEnvironmentReader creates a new mock instance.
+func NewMockwsEnvironmentReader(ctrl *gomock.Controller) *MockwsEnvironmentReader {
+	mock := &MockwsEnvironmentReader{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentReaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwlDeleter) EXPECT() *MockwlDeleterMockRecorder {
+func (m *MockwsEnvironmentReader) EXPECT() *MockwsEnvironmentReaderMockRecorder {
 	return m.recorder
 }
 
-// DeleteWorkload mocks base method.
-func (m *MockwlDeleter) DeleteWorkload(in deploy0.DeleteWorkloadInput) error {
+// EnvAddonFileAbsPath mocks base method.
+func (m *MockwsEnvironmentReader) EnvAddonFileAbsPath(fName string) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteWorkload", in)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "EnvAddonFileAbsPath", fName)
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// DeleteWorkload indicates an expected call of DeleteWorkload.
-func (mr *MockwlDeleterMockRecorder) DeleteWorkload(in interface{}) *gomock.Call {
+// EnvAddonFileAbsPath indicates an expected call of EnvAddonFileAbsPath.
+func (mr *MockwsEnvironmentReaderMockRecorder) EnvAddonFileAbsPath(fName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkload", reflect.TypeOf((*MockwlDeleter)(nil).DeleteWorkload), in)
-}
-
-// MocksvcRemoverFromApp is a mock of svcRemoverFromApp interface.
-type MocksvcRemoverFromApp struct {
-	ctrl     *gomock.Controller
-	recorder *MocksvcRemoverFromAppMockRecorder
-}
-
-// MocksvcRemoverFromAppMockRecorder is the mock recorder for MocksvcRemoverFromApp.
-type MocksvcRemoverFromAppMockRecorder struct {
-	mock *MocksvcRemoverFromApp
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonFileAbsPath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvAddonFileAbsPath), fName)
 }
 
-// NewMocksvcRemoverFromApp creates a new mock instance.
-func NewMocksvcRemoverFromApp(ctrl *gomock.Controller) *MocksvcRemoverFromApp {
-	mock := &MocksvcRemoverFromApp{ctrl: ctrl}
-	mock.recorder = &MocksvcRemoverFromAppMockRecorder{mock}
-	return mock
+// EnvAddonFilePath mocks base method.
+func (m *MockwsEnvironmentReader) EnvAddonFilePath(fName string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvAddonFilePath", fName)
+	ret0, _ := ret[0].(string)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocksvcRemoverFromApp) EXPECT() *MocksvcRemoverFromAppMockRecorder {
-	return m.recorder
+// EnvAddonFilePath indicates an expected call of EnvAddonFilePath.
+func (mr *MockwsEnvironmentReaderMockRecorder) EnvAddonFilePath(fName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonFilePath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvAddonFilePath), fName)
 }
 
-// RemoveServiceFromApp mocks base method.
-func (m *MocksvcRemoverFromApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
+// EnvOverridesPath mocks base method.
+func (m *MockwsEnvironmentReader) EnvOverridesPath() string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveServiceFromApp", app, svcName)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "EnvOverridesPath")
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// RemoveServiceFromApp indicates an expected call of RemoveServiceFromApp.
-func (mr *MocksvcRemoverFromAppMockRecorder) RemoveServiceFromApp(app, svcName interface{}) *gomock.Call {
+// EnvOverridesPath indicates an expected call of EnvOverridesPath.
+func (mr *MockwsEnvironmentReaderMockRecorder) EnvOverridesPath() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceFromApp", reflect.TypeOf((*MocksvcRemoverFromApp)(nil).RemoveServiceFromApp), app, svcName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvOverridesPath", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvOverridesPath))
 }
 
-// MockjobRemoverFromApp is a mock of jobRemoverFromApp interface.
-type MockjobRemoverFromApp struct {
-	ctrl     *gomock.Controller
-	recorder *MockjobRemoverFromAppMockRecorder
+// HasEnvironments mocks base method.
+func (m *MockwsEnvironmentReader) HasEnvironments() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasEnvironments")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockjobRemoverFromAppMockRecorder is the mock recorder for MockjobRemoverFromApp.
-type MockjobRemoverFromAppMockRecorder struct {
-	mock *MockjobRemoverFromApp
+// HasEnvironments indicates an expected call of HasEnvironments.
+func (mr *MockwsEnvironmentReaderMockRecorder) HasEnvironments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasEnvironments", reflect.TypeOf((*MockwsEnvironmentReader)(nil).HasEnvironments))
 }
 
-// NewMockjobRemoverFromApp creates a new mock instance.
-func NewMockjobRemoverFromApp(ctrl *gomock.Controller) *MockjobRemoverFromApp {
-	mock := &MockjobRemoverFromApp{ctrl: ctrl}
-	mock.recorder = &MockjobRemoverFromAppMockRecorder{mock}
-	return mock
+// ListEnvironments mocks base method.
+func (m *MockwsEnvironmentReader) ListEnvironments() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockjobRemoverFromApp) EXPECT() *MockjobRemoverFromAppMockRecorder {
-	return m.recorder
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockwsEnvironmentReaderMockRecorder) ListEnvironments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockwsEnvironmentReader)(nil).ListEnvironments))
 }
 
-// RemoveJobFromApp mocks base method.
-func (m *MockjobRemoverFromApp) RemoveJobFromApp(app *config.Application, jobName string) error {
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsEnvironmentReader) ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveJobFromApp", app, jobName)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", mftDirName)
+	ret0, _ := ret[0].(workspace.EnvironmentManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RemoveJobFromApp indicates an expected call of RemoveJobFromApp.
-func (mr *MockjobRemoverFromAppMockRecorder) RemoveJobFromApp(app, jobName interface{}) *gomock.Call {
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsEnvironmentReaderMockRecorder) ReadEnvironmentManifest(mftDirName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveJobFromApp", reflect.TypeOf((*MockjobRemoverFromApp)(nil).RemoveJobFromApp), app, jobName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvironmentReader)(nil).ReadEnvironmentManifest), mftDirName)
 }
 
-// MockimageRemover is a mock of imageRemover interface.
-type MockimageRemover struct {
+// MockwsPipelineReader is a mock of wsPipelineReader interface.
+type MockwsPipelineReader struct {
 	ctrl     *gomock.Controller
-	recorder *MockimageRemoverMockRecorder
+	recorder *MockwsPipelineReaderMockRecorder
 }
 
-// MockimageRemoverMockRecorder is the mock recorder for MockimageRemover.
-type MockimageRemoverMockRecorder struct {
-	mock *MockimageRemover
+// MockwsPipelineReaderMockRecorder is the mock recorder for MockwsPipelineReader.
+type MockwsPipelineReaderMockRecorder struct {
+	mock *MockwsPipelineReader
 }
 
-// NewMockimageRemover creates a new mock instance.
-func NewMockimageRemover(ctrl *gomock.Controller) *MockimageRemover {
-	mock := &MockimageRemover{ctrl: ctrl}
-	mock.recorder = &MockimageRemoverMockRecorder{mock}
+// NewMockwsPipelineReader creates a new mock instance.
+func NewMockwsPipelineReader(ctrl *gomock.Controller) *MockwsPipelineReader {
+	mock := &MockwsPipelineReader{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineReaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockimageRemover) EXPECT() *MockimageRemoverMockRecorder {
+func (m *MockwsPipelineReader) EXPECT() *MockwsPipelineReaderMockRecorder {
 	return m.recorder
 }
 
-// ClearRepository mocks base method.
-func (m *MockimageRemover) ClearRepository(repoName string) error {
+// ListPipelines mocks base method.
+func (m *MockwsPipelineReader) ListPipelines() ([]workspace.PipelineManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ClearRepository", repoName)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ClearRepository indicates an expected call of ClearRepository.
-func (mr *MockimageRemoverMockRecorder) ClearRepository(repoName interface{}) *gomock.Call {
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelineReaderMockRecorder) ListPipelines() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRepository", reflect.TypeOf((*MockimageRemover)(nil).ClearRepository), repoName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineReader)(nil).ListPipelines))
 }
 
-// MockpipelineDeployer is a mock of pipelineDeployer interface.
-type MockpipelineDeployer struct {
-	ctrl     *gomock.Controller
-	recorder *MockpipelineDeployerMockRecorder
-}
-
-// MockpipelineDeployerMockRecorder is the mock recorder for MockpipelineDeployer.
-type MockpipelineDeployerMockRecorder struct {
-	mock *MockpipelineDeployer
-}
-
-// NewMockpipelineDeployer creates a new mock instance.
-func NewMockpipelineDeployer(ctrl *gomock.Controller) *MockpipelineDeployer {
-	mock := &MockpipelineDeployer{ctrl: ctrl}
-	mock.recorder = &MockpipelineDeployerMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpipelineDeployer) EXPECT() *MockpipelineDeployerMockRecorder {
-	return m.recorder
-}
-
-// AddPipelineResourcesToApp mocks base method.
-func (m *MockpipelineDeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
+// ListWorkloads mocks base method.
+func (m *MockwsPipelineReader) ListWorkloads() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddPipelineResourcesToApp", app, region)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// AddPipelineResourcesToApp indicates an expected call of AddPipelineResourcesToApp.
-func (mr *MockpipelineDeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsPipelineReaderMockRecorder) ListWorkloads() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*MockpipelineDeployer)(nil).AddPipelineResourcesToApp), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsPipelineReader)(nil).ListWorkloads))
 }
 
-// CreatePipeline mocks base method.
-func (m *MockpipelineDeployer) CreatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
+// PipelineOverridesPath mocks base method.
+func (m *MockwsPipelineReader) PipelineOverridesPath(arg0 string) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePipeline", bucketName, stackConfig)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "PipelineOverridesPath", arg0)
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// CreatePipeline indicates an expected call of CreatePipeline.
-func (mr *MockpipelineDeployerMockRecorder) CreatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
+// PipelineOverridesPath indicates an expected call of PipelineOverridesPath.
+func (mr *MockwsPipelineReaderMockRecorder) PipelineOverridesPath(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).CreatePipeline), bucketName, stackConfig)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineOverridesPath", reflect.TypeOf((*MockwsPipelineReader)(nil).PipelineOverridesPath), arg0)
 }
 
-// DeletePipeline mocks base method.
-func (m *MockpipelineDeployer) DeletePipeline(pipeline deploy0.Pipeline) error {
+// ReadPipelineManifest mocks base method.
+func (m *MockwsPipelineReader) ReadPipelineManifest(path string) (*manifest.Pipeline, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePipeline", pipeline)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
+	ret0, _ := ret[0].(*manifest.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeletePipeline indicates an expected call of DeletePipeline.
-func (mr *MockpipelineDeployerMockRecorder) DeletePipeline(pipeline interface{}) *gomock.Call {
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
+func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).DeletePipeline), pipeline)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest), path)
 }
 
-// GetAppResourcesByRegion mocks base method.
-func (m *MockpipelineDeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+// Rel mocks base method.
+func (m *MockwsPipelineReader) Rel(path string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
-	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "Rel", path)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion.
-func (mr *MockpipelineDeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+// Rel indicates an expected call of Rel.
+func (mr *MockwsPipelineReaderMockRecorder) Rel(path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockpipelineDeployer)(nil).GetAppResourcesByRegion), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rel", reflect.TypeOf((*MockwsPipelineReader)(nil).Rel), path)
 }
 
-// GetRegionalAppResources mocks base method.
-func (m *MockpipelineDeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
-	ret0, _ := ret[0].([]*stack.AppRegionalResources)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockwsPipelineGetter is a mock of wsPipelineGetter interface.
+type MockwsPipelineGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineGetterMockRecorder
 }
 
-// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
-func (mr *MockpipelineDeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockpipelineDeployer)(nil).GetRegionalAppResources), app)
+// MockwsPipelineGetterMockRecorder is the mock recorder for MockwsPipelineGetter.
+type MockwsPipelineGetterMockRecorder struct {
+	mock *MockwsPipelineGetter
 }
 
-// PipelineExists mocks base method.
-func (m *MockpipelineDeployer) PipelineExists(stackConfig cloudformation1.StackConfiguration) (bool, error) {
+// NewMockwsPipelineGetter creates a new mock instance.
+func NewMockwsPipelineGetter(ctrl *gomock.Controller) *MockwsPipelineGetter {
+	mock := &MockwsPipelineGetter{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPipelineGetter) EXPECT() *MockwsPipelineGetterMockRecorder {
+	return m.recorder
+}
+
+// ListPipelines mocks base method.
+func (m *MockwsPipelineGetter) ListPipelines() ([]workspace.PipelineManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PipelineExists", stackConfig)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PipelineExists indicates an expected call of PipelineExists.
-func (mr *MockpipelineDeployerMockRecorder) PipelineExists(stackConfig interface{}) *gomock.Call {
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelineGetterMockRecorder) ListPipelines() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*MockpipelineDeployer)(nil).PipelineExists), stackConfig)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineGetter)(nil).ListPipelines))
 }
 
-// Template mocks base method.
-func (m *MockpipelineDeployer) Template(stackName string) (string, error) {
+// ListWorkloads mocks base method.
+func (m *MockwsPipelineGetter) ListWorkloads() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Template", stackName)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Template indicates an expected call of Template.
-func (mr *MockpipelineDeployerMockRecorder) Template(stackName interface{}) *gomock.Call {
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsPipelineGetterMockRecorder) ListWorkloads() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockpipelineDeployer)(nil).Template), stackName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsPipelineGetter)(nil).ListWorkloads))
 }
 
-// UpdatePipeline mocks base method.
-func (m *MockpipelineDeployer) UpdatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
+// ReadPipelineManifest mocks base method.
+func (m *MockwsPipelineGetter) ReadPipelineManifest(path string) (*manifest.Pipeline, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePipeline", bucketName, stackConfig)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
+	ret0, _ := ret[0].(*manifest.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdatePipeline indicates an expected call of UpdatePipeline.
-func (mr *MockpipelineDeployerMockRecorder) UpdatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
+func (mr *MockwsPipelineGetterMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).UpdatePipeline), bucketName, stackConfig)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineGetter)(nil).ReadPipelineManifest), path)
 }
 
-// MockappDeployer is a mock of appDeployer interface.
-type MockappDeployer struct {
+// MockwsPipelineTester is a mock of wsPipelineTester interface.
+type MockwsPipelineTester struct {
 	ctrl     *gomock.Controller
-	recorder *MockappDeployerMockRecorder
+	recorder *MockwsPipelineTesterMockRecorder
 }
 
-// MockappDeployerMockRecorder is the mock recorder for MockappDeployer.
-type MockappDeployerMockRecorder struct {
-	mock *MockappDeployer
+// MockwsPipelineTesterMockRecorder is the mock recorder for MockwsPipelineTester.
+type MockwsPipelineTesterMockRecorder struct {
+	mock *MockwsPipelineTester
 }
 
-// NewMockappDeployer creates a new mock instance.
-func NewMockappDeployer(ctrl *gomock.Controller) *MockappDeployer {
-	mock := &MockappDeployer{ctrl: ctrl}
-	mock.recorder = &MockappDeployerMockRecorder{mock}
+// NewMockwsPipelineTester creates a new mock instance.
+func NewMockwsPipelineTester(ctrl *gomock.Controller) *MockwsPipelineTester {
+	mock := &MockwsPipelineTester{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineTesterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappDeployer) EXPECT() *MockappDeployerMockRecorder {
+func (m *MockwsPipelineTester) EXPECT() *MockwsPipelineTesterMockRecorder {
 	return m.recorder
 }
 
-// AddEnvToApp mocks base method.
-func (m *MockappDeployer) AddEnvToApp(opts *cloudformation1.AddEnvToAppOpts) error {
+// ListPipelines mocks base method.
+func (m *MockwsPipelineTester) ListPipelines() ([]workspace.PipelineManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddEnvToApp", opts)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// AddEnvToApp indicates an expected call of AddEnvToApp.
-func (mr *MockappDeployerMockRecorder) AddEnvToApp(opts interface{}) *gomock.Call {
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelineTesterMockRecorder) ListPipelines() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*MockappDeployer)(nil).AddEnvToApp), opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineTester)(nil).ListPipelines))
 }
 
-// AddJobToApp mocks base method.
-func (m *MockappDeployer) AddJobToApp(app *config.Application, jobName string, opts ...cloudformation1.AddWorkloadToAppOpt) error {
+// Path mocks base method.
+func (m *MockwsPipelineTester) Path() string {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{app, jobName}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "AddJobToApp", varargs...)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Path")
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// AddJobToApp indicates an expected call of AddJobToApp.
-func (mr *MockappDeployerMockRecorder) AddJobToApp(app, jobName interface{}, opts ...interface{}) *gomock.Call {
+// Path indicates an expected call of Path.
+func (mr *MockwsPipelineTesterMockRecorder) Path() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{app, jobName}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddJobToApp", reflect.TypeOf((*MockappDeployer)(nil).AddJobToApp), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Path", reflect.TypeOf((*MockwsPipelineTester)(nil).Path))
 }
 
-// AddServiceToApp mocks base method.
-func (m *MockappDeployer) AddServiceToApp(app *config.Application, svcName string, opts ...cloudformation1.AddWorkloadToAppOpt) error {
+// ReadPipelineManifest mocks base method.
+func (m *MockwsPipelineTester) ReadPipelineManifest(path string) (*manifest.Pipeline, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{app, svcName}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "AddServiceToApp", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
+	ret0, _ := ret[0].(*manifest.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// AddServiceToApp indicates an expected call of AddServiceToApp.
-func (mr *MockappDeployerMockRecorder) AddServiceToApp(app, svcName interface{}, opts ...interface{}) *gomock.Call {
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
+func (mr *MockwsPipelineTesterMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{app, svcName}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*MockappDeployer)(nil).AddServiceToApp), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineTester)(nil).ReadPipelineManifest), path)
 }
 
-// DelegateDNSPermissions mocks base method.
-func (m *MockappDeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
+// Rel mocks base method.
+func (m *MockwsPipelineTester) Rel(path string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Rel", path)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions.
-func (mr *MockappDeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
+// Rel indicates an expected call of Rel.
+func (mr *MockwsPipelineTesterMockRecorder) Rel(path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*MockappDeployer)(nil).DelegateDNSPermissions), app, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rel", reflect.TypeOf((*MockwsPipelineTester)(nil).Rel), path)
 }
 
-// DeleteApp mocks base method.
-func (m *MockappDeployer) DeleteApp(name string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteApp", name)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MocklocalCommandRunner is a mock of localCommandRunner interface.
+type MocklocalCommandRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MocklocalCommandRunnerMockRecorder
 }
 
-// DeleteApp indicates an expected call of DeleteApp.
-func (mr *MockappDeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*MockappDeployer)(nil).DeleteApp), name)
+// MocklocalCommandRunnerMockRecorder is the mock recorder for MocklocalCommandRunner.
+type MocklocalCommandRunnerMockRecorder struct {
+	mock *MocklocalCommandRunner
 }
 
-// DeployApp mocks base method.
-func (m *MockappDeployer) DeployApp(in *deploy0.CreateAppInput) error {
+// NewMocklocalCommandRunner creates a new mock instance.
+func NewMocklocalCommandRunner(ctrl *gomock.Controller) *MocklocalCommandRunner {
+	mock := &MocklocalCommandRunner{ctrl: ctrl}
+	mock.recorder = &MocklocalCommandRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklocalCommandRunner) EXPECT() *MocklocalCommandRunnerMockRecorder {
+	return m.recorder
+}
+
+// CheckDockerEngineRunning mocks base method.
+func (m *MocklocalCommandRunner) CheckDockerEngineRunning() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployApp", in)
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeployApp indicates an expected call of DeployApp.
-func (mr *MockappDeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MocklocalCommandRunnerMockRecorder) CheckDockerEngineRunning() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*MockappDeployer)(nil).DeployApp), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MocklocalCommandRunner)(nil).CheckDockerEngineRunning))
 }
 
-// MockappResourcesGetter is a mock of appResourcesGetter interface.
-type MockappResourcesGetter struct {
-	ctrl     *gomock.Controller
-	recorder *MockappResourcesGetterMockRecorder
+// Run mocks base method.
+func (m *MocklocalCommandRunner) Run(arg0 context.Context, arg1 *dockerengine.RunOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockappResourcesGetterMockRecorder is the mock recorder for MockappResourcesGetter.
-type MockappResourcesGetterMockRecorder struct {
-	mock *MockappResourcesGetter
+// Run indicates an expected call of Run.
+func (mr *MocklocalCommandRunnerMockRecorder) Run(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocklocalCommandRunner)(nil).Run), arg0, arg1)
 }
 
-// NewMockappResourcesGetter creates a new mock instance.
-func NewMockappResourcesGetter(ctrl *gomock.Controller) *MockappResourcesGetter {
-	mock := &MockappResourcesGetter{ctrl: ctrl}
-	mock.recorder = &MockappResourcesGetterMockRecorder{mock}
-	return mock
+// MockwsAppManager is a mock of wsAppManager interface.
+type MockwsAppManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsAppManagerMockRecorder
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappResourcesGetter) EXPECT() *MockappResourcesGetterMockRecorder {
-	return m.recorder
+// MockwsAppManagerMockRecorder is the mock recorder for MockwsAppManager.
+type MockwsAppManagerMockRecorder struct {
+	mock *MockwsAppManager
 }
 
-// GetAppResourcesByRegion mocks base method.
-func (m *MockappResourcesGetter) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
-	ret0, _ := ret[0].(*stack.AppRegionalResources)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// NewMockwsAppManager creates a new mock instance.
+func NewMockwsAppManager(ctrl *gomock.Controller) *MockwsAppManager {
+	mock := &MockwsAppManager{ctrl: ctrl}
+	mock.recorder = &MockwsAppManagerMockRecorder{mock}
+	return mock
 }
 
-// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion.
-func (mr *MockappResourcesGetterMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockappResourcesGetter)(nil).GetAppResourcesByRegion), app, region)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsAppManager) EXPECT() *MockwsAppManagerMockRecorder {
+	return m.recorder
 }
 
-// GetRegionalAppResources mocks base method.
-func (m *MockappResourcesGetter) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+// Summary mocks base method.
+func (m *MockwsAppManager) Summary() (*workspace.Summary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
-	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
-func (mr *MockappResourcesGetterMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+// Summary indicates an expected call of Summary.
+func (mr *MockwsAppManagerMockRecorder) Summary() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockappResourcesGetter)(nil).GetRegionalAppResources), app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsAppManager)(nil).Summary))
 }
 
-// MockenvDeleterFromApp is a mock of envDeleterFromApp interface.
-type MockenvDeleterFromApp struct {
+// MockwsAppUpdater is a mock of wsAppUpdater interface.
+type MockwsAppUpdater struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvDeleterFromAppMockRecorder
+	recorder *MockwsAppUpdaterMockRecorder
 }
 
-// MockenvDeleterFromAppMockRecorder is the mock recorder for MockenvDeleterFromApp.
-type MockenvDeleterFromAppMockRecorder struct {
-	mock *MockenvDeleterFromApp
+// MockwsAppUpdaterMockRecorder is the mock recorder for MockwsAppUpdater.
+type MockwsAppUpdaterMockRecorder struct {
+	mock *MockwsAppUpdater
 }
 
-// NewMockenvDeleterFromApp creates a new mock instance.
-func NewMockenvDeleterFromApp(ctrl *gomock.Controller) *MockenvDeleterFromApp {
-	mock := &MockenvDeleterFromApp{ctrl: ctrl}
-	mock.recorder = &MockenvDeleterFromAppMockRecorder{mock}
+// NewMockwsAppUpdater creates a new mock instance.
+func NewMockwsAppUpdater(ctrl *gomock.Controller) *MockwsAppUpdater {
+	mock := &MockwsAppUpdater{ctrl: ctrl}
+	mock.recorder = &MockwsAppUpdaterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockenvDeleterFromApp) EXPECT() *MockenvDeleterFromAppMockRecorder {
+func (m *MockwsAppUpdater) EXPECT() *MockwsAppUpdaterMockRecorder {
 	return m.recorder
 }
 
-// GetAppResourcesByRegion mocks base method.
-func (m *MockenvDeleterFromApp) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
-	ret0, _ := ret[0].(*stack.AppRegionalResources)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion.
-func (mr *MockenvDeleterFromAppMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockenvDeleterFromApp)(nil).GetAppResourcesByRegion), app, region)
-}
-
-// GetRegionalAppResources mocks base method.
-func (m *MockenvDeleterFromApp) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+// Summary mocks base method.
+func (m *MockwsAppUpdater) Summary() (*workspace.Summary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
-	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
-func (mr *MockenvDeleterFromAppMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+// Summary indicates an expected call of Summary.
+func (mr *MockwsAppUpdaterMockRecorder) Summary() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockenvDeleterFromApp)(nil).GetRegionalAppResources), app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsAppUpdater)(nil).Summary))
 }
 
-// RemoveEnvFromApp mocks base method.
-func (m *MockenvDeleterFromApp) RemoveEnvFromApp(opts *cloudformation1.RemoveEnvFromAppOpts) error {
+// UpdateApplication mocks base method.
+func (m *MockwsAppUpdater) UpdateApplication(name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveEnvFromApp", opts)
+	ret := m.ctrl.Call(m, "UpdateApplication", name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RemoveEnvFromApp indicates an expected call of RemoveEnvFromApp.
-func (mr *MockenvDeleterFromAppMockRecorder) RemoveEnvFromApp(opts interface{}) *gomock.Call {
+// UpdateApplication indicates an expected call of UpdateApplication.
+func (mr *MockwsAppUpdaterMockRecorder) UpdateApplication(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEnvFromApp", reflect.TypeOf((*MockenvDeleterFromApp)(nil).RemoveEnvFromApp), opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*MockwsAppUpdater)(nil).UpdateApplication), name)
 }
 
-// MocktaskDeployer is a mock of taskDeployer interface.
-type MocktaskDeployer struct {
+// MockwsAppManagerDeleter is a mock of wsAppManagerDeleter interface.
+type MockwsAppManagerDeleter struct {
 	ctrl     *gomock.Controller
-	recorder *MocktaskDeployerMockRecorder
+	recorder *MockwsAppManagerDeleterMockRecorder
 }
 
-// MocktaskDeployerMockRecorder is the mock recorder for MocktaskDeployer.
-type MocktaskDeployerMockRecorder struct {
-	mock *MocktaskDeployer
+// MockwsAppManagerDeleterMockRecorder is the mock recorder for MockwsAppManagerDeleter.
+type MockwsAppManagerDeleterMockRecorder struct {
+	mock *MockwsAppManagerDeleter
 }
 
-// NewMocktaskDeployer creates a new mock instance.
-func NewMocktaskDeployer(ctrl *gomock.Controller) *MocktaskDeployer {
-	mock := &MocktaskDeployer{ctrl: ctrl}
-	mock.recorder = &MocktaskDeployerMockRecorder{mock}
+// NewMockwsAppManagerDeleter creates a new mock instance.
+func NewMockwsAppManagerDeleter(ctrl *gomock.Controller) *MockwsAppManagerDeleter {
+	mock := &MockwsAppManagerDeleter{ctrl: ctrl}
+	mock.recorder = &MockwsAppManagerDeleterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktaskDeployer) EXPECT() *MocktaskDeployerMockRecorder {
+func (m *MockwsAppManagerDeleter) EXPECT() *MockwsAppManagerDeleterMockRecorder {
 	return m.recorder
 }
 
-// DeployTask mocks base method.
-func (m *MocktaskDeployer) DeployTask(input *deploy0.CreateTaskResourcesInput, opts ...cloudformation0.StackOption) error {
+// DeleteWorkspaceFile mocks base method.
+func (m *MockwsAppManagerDeleter) DeleteWorkspaceFile() error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{input}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "DeployTask", varargs...)
+	ret := m.ctrl.Call(m, "DeleteWorkspaceFile")
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeployTask indicates an expected call of DeployTask.
-func (mr *MocktaskDeployerMockRecorder) DeployTask(input interface{}, opts ...interface{}) *gomock.Call {
+// DeleteWorkspaceFile indicates an expected call of DeleteWorkspaceFile.
+func (mr *MockwsAppManagerDeleterMockRecorder) DeleteWorkspaceFile() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{input}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployTask", reflect.TypeOf((*MocktaskDeployer)(nil).DeployTask), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspaceFile", reflect.TypeOf((*MockwsAppManagerDeleter)(nil).DeleteWorkspaceFile))
 }
 
-// GetTaskStack mocks base method.
-func (m *MocktaskDeployer) GetTaskStack(taskName string) (*deploy0.TaskStackInfo, error) {
+// Summary mocks base method.
+func (m *MockwsAppManagerDeleter) Summary() (*workspace.Summary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTaskStack", taskName)
-	ret0, _ := ret[0].(*deploy0.TaskStackInfo)
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTaskStack indicates an expected call of GetTaskStack.
-func (mr *MocktaskDeployerMockRecorder) GetTaskStack(taskName interface{}) *gomock.Call {
+// Summary indicates an expected call of Summary.
+func (mr *MockwsAppManagerDeleterMockRecorder) Summary() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskStack", reflect.TypeOf((*MocktaskDeployer)(nil).GetTaskStack), taskName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsAppManagerDeleter)(nil).Summary))
 }
 
-// MocktaskStackManager is a mock of taskStackManager interface.
-type MocktaskStackManager struct {
+// MockwsWriter is a mock of wsWriter interface.
+type MockwsWriter struct {
 	ctrl     *gomock.Controller
-	recorder *MocktaskStackManagerMockRecorder
+	recorder *MockwsWriterMockRecorder
 }
 
-// MocktaskStackManagerMockRecorder is the mock recorder for MocktaskStackManager.
-type MocktaskStackManagerMockRecorder struct {
-	mock *MocktaskStackManager
+// MockwsWriterMockRecorder is the mock recorder for MockwsWriter.
+type MockwsWriterMockRecorder struct {
+	mock *MockwsWriter
 }
 
-// NewMocktaskStackManager creates a new mock instance.
-func NewMocktaskStackManager(ctrl *gomock.Controller) *MocktaskStackManager {
-	mock := &MocktaskStackManager{ctrl: ctrl}
-	mock.recorder = &MocktaskStackManagerMockRecorder{mock}
+// NewMockwsWriter creates a new mock instance.
+func NewMockwsWriter(ctrl *gomock.Controller) *MockwsWriter {
+	mock := &MockwsWriter{ctrl: ctrl}
+	mock.recorder = &MockwsWriterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktaskStackManager) EXPECT() *MocktaskStackManagerMockRecorder {
+func (m *MockwsWriter) EXPECT() *MockwsWriterMockRecorder {
 	return m.recorder
 }
 
-// DeleteTask mocks base method.
-func (m *MocktaskStackManager) DeleteTask(task deploy0.TaskStackInfo) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTask", task)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteTask indicates an expected call of DeleteTask.
-func (mr *MocktaskStackManagerMockRecorder) DeleteTask(task interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MocktaskStackManager)(nil).DeleteTask), task)
-}
-
-// GetTaskStack mocks base method.
-func (m *MocktaskStackManager) GetTaskStack(taskName string) (*deploy0.TaskStackInfo, error) {
+// Write mocks base method.
+func (m *MockwsWriter) Write(content encoding.BinaryMarshaler, path string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTaskStack", taskName)
-	ret0, _ := ret[0].(*deploy0.TaskStackInfo)
+	ret := m.ctrl.Call(m, "Write", content, path)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTaskStack indicates an expected call of GetTaskStack.
-func (mr *MocktaskStackManagerMockRecorder) GetTaskStack(taskName interface{}) *gomock.Call {
+// Write indicates an expected call of Write.
+func (mr *MockwsWriterMockRecorder) Write(content, path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskStack", reflect.TypeOf((*MocktaskStackManager)(nil).GetTaskStack), taskName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockwsWriter)(nil).Write), content, path)
 }
 
-// MocktaskRunner is a mock of taskRunner interface.
-type MocktaskRunner struct {
+// Mockuploader is a mock of uploader interface.
+type Mockuploader struct {
 	ctrl     *gomock.Controller
-	recorder *MocktaskRunnerMockRecorder
+	recorder *MockuploaderMockRecorder
 }
 
-// MocktaskRunnerMockRecorder is the mock recorder for MocktaskRunner.
-type MocktaskRunnerMockRecorder struct {
-	mock *MocktaskRunner
+// MockuploaderMockRecorder is the mock recorder for Mockuploader.
+type MockuploaderMockRecorder struct {
+	mock *Mockuploader
 }
 
-// NewMocktaskRunner creates a new mock instance.
-func NewMocktaskRunner(ctrl *gomock.Controller) *MocktaskRunner {
-	mock := &MocktaskRunner{ctrl: ctrl}
-	mock.recorder = &MocktaskRunnerMockRecorder{mock}
+// NewMockuploader creates a new mock instance.
+func NewMockuploader(ctrl *gomock.Controller) *Mockuploader {
+	mock := &Mockuploader{ctrl: ctrl}
+	mock.recorder = &MockuploaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktaskRunner) EXPECT() *MocktaskRunnerMockRecorder {
+func (m *Mockuploader) EXPECT() *MockuploaderMockRecorder {
 	return m.recorder
 }
 
-// CheckNonZeroExitCode mocks base method.
-func (m *MocktaskRunner) CheckNonZeroExitCode(arg0 []*task.Task) error {
+// Upload mocks base method.
+func (m *Mockuploader) Upload(bucket, key string, data io.Reader) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckNonZeroExitCode", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Upload", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CheckNonZeroExitCode indicates an expected call of CheckNonZeroExitCode.
-func (mr *MocktaskRunnerMockRecorder) CheckNonZeroExitCode(arg0 interface{}) *gomock.Call {
+// Upload indicates an expected call of Upload.
+func (mr *MockuploaderMockRecorder) Upload(bucket, key, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckNonZeroExitCode", reflect.TypeOf((*MocktaskRunner)(nil).CheckNonZeroExitCode), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*Mockuploader)(nil).Upload), bucket, key, data)
 }
 
-// Run mocks base method.
-func (m *MocktaskRunner) Run() ([]*task.Task, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Run")
-	ret0, _ := ret[0].([]*task.Task)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Run indicates an expected call of Run.
-func (mr *MocktaskRunnerMockRecorder) Run() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocktaskRunner)(nil).Run))
-}
-
-// MockdefaultClusterGetter is a mock of defaultClusterGetter interface.
-type MockdefaultClusterGetter struct {
+// MockbucketEmptier is a mock of bucketEmptier interface.
+type MockbucketEmptier struct {
 	ctrl     *gomock.Controller
-	recorder *MockdefaultClusterGetterMockRecorder
+	recorder *MockbucketEmptierMockRecorder
 }
 
-// MockdefaultClusterGetterMockRecorder is the mock recorder for MockdefaultClusterGetter.
-type MockdefaultClusterGetterMockRecorder struct {
-	mock *MockdefaultClusterGetter
+// MockbucketEmptierMockRecorder is the mock recorder for MockbucketEmptier.
+type MockbucketEmptierMockRecorder struct {
+	mock *MockbucketEmptier
 }
 
-// NewMockdefaultClusterGetter creates a new mock instance.
-func NewMockdefaultClusterGetter(ctrl *gomock.Controller) *MockdefaultClusterGetter {
-	mock := &MockdefaultClusterGetter{ctrl: ctrl}
-	mock.recorder = &MockdefaultClusterGetterMockRecorder{mock}
+// NewMockbucketEmptier creates a new mock instance.
+func NewMockbucketEmptier(ctrl *gomock.Controller) *MockbucketEmptier {
+	mock := &MockbucketEmptier{ctrl: ctrl}
+	mock.recorder = &MockbucketEmptierMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdefaultClusterGetter) EXPECT() *MockdefaultClusterGetterMockRecorder {
+func (m *MockbucketEmptier) EXPECT() *MockbucketEmptierMockRecorder {
 	return m.recorder
 }
 
-// HasDefaultCluster mocks base method.
-func (m *MockdefaultClusterGetter) HasDefaultCluster() (bool, error) {
+// EmptyBucket mocks base method.
+func (m *MockbucketEmptier) EmptyBucket(bucket string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasDefaultCluster")
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "EmptyBucket", bucket)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// HasDefaultCluster indicates an expected call of HasDefaultCluster.
-func (mr *MockdefaultClusterGetterMockRecorder) HasDefaultCluster() *gomock.Call {
+// EmptyBucket indicates an expected call of EmptyBucket.
+func (mr *MockbucketEmptierMockRecorder) EmptyBucket(bucket interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDefaultCluster", reflect.TypeOf((*MockdefaultClusterGetter)(nil).HasDefaultCluster))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyBucket", reflect.TypeOf((*MockbucketEmptier)(nil).EmptyBucket), bucket)
 }
 
-// Mockdeployer is a mock of deployer interface.
-type Mockdeployer struct {
+// MockbucketLifecycleConfigurer is a mock of bucketLifecycleConfigurer interface.
+type MockbucketLifecycleConfigurer struct {
 	ctrl     *gomock.Controller
-	recorder *MockdeployerMockRecorder
+	recorder *MockbucketLifecycleConfigurerMockRecorder
 }
 
-// MockdeployerMockRecorder is the mock recorder for Mockdeployer.
-type MockdeployerMockRecorder struct {
-	mock *Mockdeployer
+// MockbucketLifecycleConfigurerMockRecorder is the mock recorder for MockbucketLifecycleConfigurer.
+type MockbucketLifecycleConfigurerMockRecorder struct {
+	mock *MockbucketLifecycleConfigurer
 }
 
-// NewMockdeployer creates a new mock instance.
-func NewMockdeployer(ctrl *gomock.Controller) *Mockdeployer {
-	mock := &Mockdeployer{ctrl: ctrl}
-	mock.recorder = &MockdeployerMockRecorder{mock}
+// NewMockbucketLifecycleConfigurer creates a new mock instance.
+func NewMockbucketLifecycleConfigurer(ctrl *gomock.Controller) *MockbucketLifecycleConfigurer {
+	mock := &MockbucketLifecycleConfigurer{ctrl: ctrl}
+	mock.recorder = &MockbucketLifecycleConfigurerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockdeployer) EXPECT() *MockdeployerMockRecorder {
+func (m *MockbucketLifecycleConfigurer) EXPECT() *MockbucketLifecycleConfigurerMockRecorder {
 	return m.recorder
 }
 
-// AddEnvToApp mocks base method.
-func (m *Mockdeployer) AddEnvToApp(opts *cloudformation1.AddEnvToAppOpts) error {
+// DeleteLifecycleRule mocks base method.
+func (m *MockbucketLifecycleConfigurer) DeleteLifecycleRule(bucket, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddEnvToApp", opts)
+	ret := m.ctrl.Call(m, "DeleteLifecycleRule", bucket, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddEnvToApp indicates an expected call of AddEnvToApp.
-func (mr *MockdeployerMockRecorder) AddEnvToApp(opts interface{}) *gomock.Call {
+// DeleteLifecycleRule indicates an expected call of DeleteLifecycleRule.
+func (mr *MockbucketLifecycleConfigurerMockRecorder) DeleteLifecycleRule(bucket, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*Mockdeployer)(nil).AddEnvToApp), opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLifecycleRule", reflect.TypeOf((*MockbucketLifecycleConfigurer)(nil).DeleteLifecycleRule), bucket, id)
 }
 
-// AddJobToApp mocks base method.
-func (m *Mockdeployer) AddJobToApp(app *config.Application, jobName string, opts ...cloudformation1.AddWorkloadToAppOpt) error {
+// PutLifecycleRule mocks base method.
+func (m *MockbucketLifecycleConfigurer) PutLifecycleRule(bucket string, rule s3.LifecycleRule) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{app, jobName}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "AddJobToApp", varargs...)
+	ret := m.ctrl.Call(m, "PutLifecycleRule", bucket, rule)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddJobToApp indicates an expected call of AddJobToApp.
-func (mr *MockdeployerMockRecorder) AddJobToApp(app, jobName interface{}, opts ...interface{}) *gomock.Call {
+// PutLifecycleRule indicates an expected call of PutLifecycleRule.
+func (mr *MockbucketLifecycleConfigurerMockRecorder) PutLifecycleRule(bucket, rule interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{app, jobName}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddJobToApp", reflect.TypeOf((*Mockdeployer)(nil).AddJobToApp), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLifecycleRule", reflect.TypeOf((*MockbucketLifecycleConfigurer)(nil).PutLifecycleRule), bucket, rule)
 }
 
-// AddPipelineResourcesToApp mocks base method.
-func (m *Mockdeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddPipelineResourcesToApp", app, region)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MockartifactsCollector is a mock of artifactsCollector interface.
+type MockartifactsCollector struct {
+	ctrl     *gomock.Controller
+	recorder *MockartifactsCollectorMockRecorder
 }
 
-// AddPipelineResourcesToApp indicates an expected call of AddPipelineResourcesToApp.
-func (mr *MockdeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*Mockdeployer)(nil).AddPipelineResourcesToApp), app, region)
+// MockartifactsCollectorMockRecorder is the mock recorder for MockartifactsCollector.
+type MockartifactsCollectorMockRecorder struct {
+	mock *MockartifactsCollector
 }
 
-// AddServiceToApp mocks base method.
-func (m *Mockdeployer) AddServiceToApp(app *config.Application, svcName string, opts ...cloudformation1.AddWorkloadToAppOpt) error {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{app, svcName}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "AddServiceToApp", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+// NewMockartifactsCollector creates a new mock instance.
+func NewMockartifactsCollector(ctrl *gomock.Controller) *MockartifactsCollector {
+	mock := &MockartifactsCollector{ctrl: ctrl}
+	mock.recorder = &MockartifactsCollectorMockRecorder{mock}
+	return mock
 }
 
-// AddServiceToApp indicates an expected call of AddServiceToApp.
-func (mr *MockdeployerMockRecorder) AddServiceToApp(app, svcName interface{}, opts ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{app, svcName}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*Mockdeployer)(nil).AddServiceToApp), varargs...)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockartifactsCollector) EXPECT() *MockartifactsCollectorMockRecorder {
+	return m.recorder
 }
 
-// CreateAndRenderEnvironment mocks base method.
-func (m *Mockdeployer) CreateAndRenderEnvironment(conf cloudformation1.StackConfiguration, bucketARN string) error {
+// DeleteObjectVersions mocks base method.
+func (m *MockartifactsCollector) DeleteObjectVersions(bucket string, versions []s3.ObjectVersion) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAndRenderEnvironment", conf, bucketARN)
+	ret := m.ctrl.Call(m, "DeleteObjectVersions", bucket, versions)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateAndRenderEnvironment indicates an expected call of CreateAndRenderEnvironment.
-func (mr *MockdeployerMockRecorder) CreateAndRenderEnvironment(conf, bucketARN interface{}) *gomock.Call {
+// DeleteObjectVersions indicates an expected call of DeleteObjectVersions.
+func (mr *MockartifactsCollectorMockRecorder) DeleteObjectVersions(bucket, versions interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndRenderEnvironment", reflect.TypeOf((*Mockdeployer)(nil).CreateAndRenderEnvironment), conf, bucketARN)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectVersions", reflect.TypeOf((*MockartifactsCollector)(nil).DeleteObjectVersions), bucket, versions)
 }
 
-// CreatePipeline mocks base method.
-func (m *Mockdeployer) CreatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
+// LifecycleRule mocks base method.
+func (m *MockartifactsCollector) LifecycleRule(bucket, id string) (*s3.LifecycleRule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePipeline", bucketName, stackConfig)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "LifecycleRule", bucket, id)
+	ret0, _ := ret[0].(*s3.LifecycleRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreatePipeline indicates an expected call of CreatePipeline.
-func (mr *MockdeployerMockRecorder) CreatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
+// LifecycleRule indicates an expected call of LifecycleRule.
+func (mr *MockartifactsCollectorMockRecorder) LifecycleRule(bucket, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*Mockdeployer)(nil).CreatePipeline), bucketName, stackConfig)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LifecycleRule", reflect.TypeOf((*MockartifactsCollector)(nil).LifecycleRule), bucket, id)
 }
 
-// DelegateDNSPermissions mocks base method.
-func (m *Mockdeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
+// ObjectVersionsBefore mocks base method.
+func (m *MockartifactsCollector) ObjectVersionsBefore(bucket, prefix string, before time.Time) ([]s3.ObjectVersion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ObjectVersionsBefore", bucket, prefix, before)
+	ret0, _ := ret[0].([]s3.ObjectVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions.
-func (mr *MockdeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
+// ObjectVersionsBefore indicates an expected call of ObjectVersionsBefore.
+func (mr *MockartifactsCollectorMockRecorder) ObjectVersionsBefore(bucket, prefix, before interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*Mockdeployer)(nil).DelegateDNSPermissions), app, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectVersionsBefore", reflect.TypeOf((*MockartifactsCollector)(nil).ObjectVersionsBefore), bucket, prefix, before)
 }
 
-// DeleteApp mocks base method.
-func (m *Mockdeployer) DeleteApp(name string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteApp", name)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MockstackDescriber is a mock of stackDescriber interface.
+type MockstackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDescriberMockRecorder
 }
 
-// DeleteApp indicates an expected call of DeleteApp.
-func (mr *MockdeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*Mockdeployer)(nil).DeleteApp), name)
+// MockstackDescriberMockRecorder is the mock recorder for MockstackDescriber.
+type MockstackDescriberMockRecorder struct {
+	mock *MockstackDescriber
 }
 
-// DeleteEnvironment mocks base method.
-func (m *Mockdeployer) DeleteEnvironment(appName, envName, cfnExecRoleARN string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEnvironment", appName, envName, cfnExecRoleARN)
-	ret0, _ := ret[0].(error)
-	return ret0
+// NewMockstackDescriber creates a new mock instance.
+func NewMockstackDescriber(ctrl *gomock.Controller) *MockstackDescriber {
+	mock := &MockstackDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDescriberMockRecorder{mock}
+	return mock
 }
 
-// DeleteEnvironment indicates an expected call of DeleteEnvironment.
-func (mr *MockdeployerMockRecorder) DeleteEnvironment(appName, envName, cfnExecRoleARN interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeleteEnvironment), appName, envName, cfnExecRoleARN)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDescriber) EXPECT() *MockstackDescriberMockRecorder {
+	return m.recorder
 }
 
-// DeletePipeline mocks base method.
-func (m *Mockdeployer) DeletePipeline(pipeline deploy0.Pipeline) error {
+// Resources mocks base method.
+func (m *MockstackDescriber) Resources() ([]*stack0.Resource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePipeline", pipeline)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Resources")
+	ret0, _ := ret[0].([]*stack0.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeletePipeline indicates an expected call of DeletePipeline.
-func (mr *MockdeployerMockRecorder) DeletePipeline(pipeline interface{}) *gomock.Call {
+// Resources indicates an expected call of Resources.
+func (mr *MockstackDescriberMockRecorder) Resources() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*Mockdeployer)(nil).DeletePipeline), pipeline)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockstackDescriber)(nil).Resources))
 }
 
-// DeployApp mocks base method.
-func (m *Mockdeployer) DeployApp(in *deploy0.CreateAppInput) error {
+// MockenvironmentDeployer is a mock of environmentDeployer interface.
+type MockenvironmentDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentDeployerMockRecorder
+}
+
+// MockenvironmentDeployerMockRecorder is the mock recorder for MockenvironmentDeployer.
+type MockenvironmentDeployerMockRecorder struct {
+	mock *MockenvironmentDeployer
+}
+
+// NewMockenvironmentDeployer creates a new mock instance.
+func NewMockenvironmentDeployer(ctrl *gomock.Controller) *MockenvironmentDeployer {
+	mock := &MockenvironmentDeployer{ctrl: ctrl}
+	mock.recorder = &MockenvironmentDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvironmentDeployer) EXPECT() *MockenvironmentDeployerMockRecorder {
+	return m.recorder
+}
+
+// CreateAndRenderEnvironment mocks base method.
+func (m *MockenvironmentDeployer) CreateAndRenderEnvironment(conf cloudformation1.StackConfiguration, bucketARN string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployApp", in)
+	ret := m.ctrl.Call(m, "CreateAndRenderEnvironment", conf, bucketARN)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeployApp indicates an expected call of DeployApp.
-func (mr *MockdeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
+// CreateAndRenderEnvironment indicates an expected call of CreateAndRenderEnvironment.
+func (mr *MockenvironmentDeployerMockRecorder) CreateAndRenderEnvironment(conf, bucketARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*Mockdeployer)(nil).DeployApp), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndRenderEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).CreateAndRenderEnvironment), conf, bucketARN)
 }
 
-// GetAppResourcesByRegion mocks base method.
-func (m *Mockdeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+// DeleteEnvironment mocks base method.
+func (m *MockenvironmentDeployer) DeleteEnvironment(appName, envName, cfnExecRoleARN string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
-	ret0, _ := ret[0].(*stack.AppRegionalResources)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteEnvironment", appName, envName, cfnExecRoleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion.
-func (mr *MockdeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+// DeleteEnvironment indicates an expected call of DeleteEnvironment.
+func (mr *MockenvironmentDeployerMockRecorder) DeleteEnvironment(appName, envName, cfnExecRoleARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*Mockdeployer)(nil).GetAppResourcesByRegion), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeleteEnvironment), appName, envName, cfnExecRoleARN)
 }
 
 // GetEnvironment mocks base method.
-func (m *Mockdeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
+func (m *MockenvironmentDeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetEnvironment", appName, envName)
 	ret0, _ := ret[0].(*config.Environment)
@@ -4936,983 +5140,2782 @@ func (m *Mockdeployer) GetEnvironment(appName, envName string) (*config.Environm
 }
 
 // GetEnvironment indicates an expected call of GetEnvironment.
-func (mr *MockdeployerMockRecorder) GetEnvironment(appName, envName interface{}) *gomock.Call {
+func (mr *MockenvironmentDeployerMockRecorder) GetEnvironment(appName, envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*Mockdeployer)(nil).GetEnvironment), appName, envName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).GetEnvironment), appName, envName)
 }
 
-// GetRegionalAppResources mocks base method.
-func (m *Mockdeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+// Template mocks base method.
+func (m *MockenvironmentDeployer) Template(stackName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
-	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "Template", stackName)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
-func (mr *MockdeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+// Template indicates an expected call of Template.
+func (mr *MockenvironmentDeployerMockRecorder) Template(stackName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*Mockdeployer)(nil).GetRegionalAppResources), app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockenvironmentDeployer)(nil).Template), stackName)
 }
 
-// ListTaskStacks mocks base method.
-func (m *Mockdeployer) ListTaskStacks(appName, envName string) ([]deploy0.TaskStackInfo, error) {
+// UpdateEnvironmentTemplate mocks base method.
+func (m *MockenvironmentDeployer) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTaskStacks", appName, envName)
-	ret0, _ := ret[0].([]deploy0.TaskStackInfo)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateEnvironmentTemplate", appName, envName, templateBody, cfnExecRoleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListTaskStacks indicates an expected call of ListTaskStacks.
-func (mr *MockdeployerMockRecorder) ListTaskStacks(appName, envName interface{}) *gomock.Call {
+// UpdateEnvironmentTemplate indicates an expected call of UpdateEnvironmentTemplate.
+func (mr *MockenvironmentDeployerMockRecorder) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskStacks", reflect.TypeOf((*Mockdeployer)(nil).ListTaskStacks), appName, envName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentTemplate", reflect.TypeOf((*MockenvironmentDeployer)(nil).UpdateEnvironmentTemplate), appName, envName, templateBody, cfnExecRoleARN)
 }
 
-// PipelineExists mocks base method.
-func (m *Mockdeployer) PipelineExists(stackConfig cloudformation1.StackConfiguration) (bool, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PipelineExists", stackConfig)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockwlDeleter is a mock of wlDeleter interface.
+type MockwlDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwlDeleterMockRecorder
 }
 
-// PipelineExists indicates an expected call of PipelineExists.
-func (mr *MockdeployerMockRecorder) PipelineExists(stackConfig interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*Mockdeployer)(nil).PipelineExists), stackConfig)
+// MockwlDeleterMockRecorder is the mock recorder for MockwlDeleter.
+type MockwlDeleterMockRecorder struct {
+	mock *MockwlDeleter
 }
 
-// Template mocks base method.
-func (m *Mockdeployer) Template(stackName string) (string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Template", stackName)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// NewMockwlDeleter creates a new mock instance.
+func NewMockwlDeleter(ctrl *gomock.Controller) *MockwlDeleter {
+	mock := &MockwlDeleter{ctrl: ctrl}
+	mock.recorder = &MockwlDeleterMockRecorder{mock}
+	return mock
 }
 
-// Template indicates an expected call of Template.
-func (mr *MockdeployerMockRecorder) Template(stackName interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*Mockdeployer)(nil).Template), stackName)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwlDeleter) EXPECT() *MockwlDeleterMockRecorder {
+	return m.recorder
 }
 
-// UpdateEnvironmentTemplate mocks base method.
-func (m *Mockdeployer) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN string) error {
+// DeleteWorkload mocks base method.
+func (m *MockwlDeleter) DeleteWorkload(in deploy0.DeleteWorkloadInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEnvironmentTemplate", appName, envName, templateBody, cfnExecRoleARN)
+	ret := m.ctrl.Call(m, "DeleteWorkload", in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateEnvironmentTemplate indicates an expected call of UpdateEnvironmentTemplate.
-func (mr *MockdeployerMockRecorder) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN interface{}) *gomock.Call {
+// DeleteWorkload indicates an expected call of DeleteWorkload.
+func (mr *MockwlDeleterMockRecorder) DeleteWorkload(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentTemplate", reflect.TypeOf((*Mockdeployer)(nil).UpdateEnvironmentTemplate), appName, envName, templateBody, cfnExecRoleARN)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkload", reflect.TypeOf((*MockwlDeleter)(nil).DeleteWorkload), in)
 }
 
-// UpdatePipeline mocks base method.
-func (m *Mockdeployer) UpdatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
+// MocksvcRemoverFromApp is a mock of svcRemoverFromApp interface.
+type MocksvcRemoverFromApp struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcRemoverFromAppMockRecorder
+}
+
+// MocksvcRemoverFromAppMockRecorder is the mock recorder for MocksvcRemoverFromApp.
+type MocksvcRemoverFromAppMockRecorder struct {
+	mock *MocksvcRemoverFromApp
+}
+
+// NewMocksvcRemoverFromApp creates a new mock instance.
+func NewMocksvcRemoverFromApp(ctrl *gomock.Controller) *MocksvcRemoverFromApp {
+	mock := &MocksvcRemoverFromApp{ctrl: ctrl}
+	mock.recorder = &MocksvcRemoverFromAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksvcRemoverFromApp) EXPECT() *MocksvcRemoverFromAppMockRecorder {
+	return m.recorder
+}
+
+// RemoveServiceFromApp mocks base method.
+func (m *MocksvcRemoverFromApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePipeline", bucketName, stackConfig)
+	ret := m.ctrl.Call(m, "RemoveServiceFromApp", app, svcName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdatePipeline indicates an expected call of UpdatePipeline.
-func (mr *MockdeployerMockRecorder) UpdatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
+// RemoveServiceFromApp indicates an expected call of RemoveServiceFromApp.
+func (mr *MocksvcRemoverFromAppMockRecorder) RemoveServiceFromApp(app, svcName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*Mockdeployer)(nil).UpdatePipeline), bucketName, stackConfig)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceFromApp", reflect.TypeOf((*MocksvcRemoverFromApp)(nil).RemoveServiceFromApp), app, svcName)
 }
 
-// MockdomainHostedZoneGetter is a mock of domainHostedZoneGetter interface.
-type MockdomainHostedZoneGetter struct {
+// MocksvcRegistrarInApp is a mock of svcRegistrarInApp interface.
+type MocksvcRegistrarInApp struct {
 	ctrl     *gomock.Controller
-	recorder *MockdomainHostedZoneGetterMockRecorder
+	recorder *MocksvcRegistrarInAppMockRecorder
 }
 
-// MockdomainHostedZoneGetterMockRecorder is the mock recorder for MockdomainHostedZoneGetter.
-type MockdomainHostedZoneGetterMockRecorder struct {
-	mock *MockdomainHostedZoneGetter
+// MocksvcRegistrarInAppMockRecorder is the mock recorder for MocksvcRegistrarInApp.
+type MocksvcRegistrarInAppMockRecorder struct {
+	mock *MocksvcRegistrarInApp
 }
 
-// NewMockdomainHostedZoneGetter creates a new mock instance.
-func NewMockdomainHostedZoneGetter(ctrl *gomock.Controller) *MockdomainHostedZoneGetter {
-	mock := &MockdomainHostedZoneGetter{ctrl: ctrl}
-	mock.recorder = &MockdomainHostedZoneGetterMockRecorder{mock}
+// NewMocksvcRegistrarInApp creates a new mock instance.
+func NewMocksvcRegistrarInApp(ctrl *gomock.Controller) *MocksvcRegistrarInApp {
+	mock := &MocksvcRegistrarInApp{ctrl: ctrl}
+	mock.recorder = &MocksvcRegistrarInAppMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdomainHostedZoneGetter) EXPECT() *MockdomainHostedZoneGetterMockRecorder {
+func (m *MocksvcRegistrarInApp) EXPECT() *MocksvcRegistrarInAppMockRecorder {
 	return m.recorder
 }
 
-// DomainHostedZoneID mocks base method.
-func (m *MockdomainHostedZoneGetter) DomainHostedZoneID(domainName string) (string, error) {
+// AddServiceToApp mocks base method.
+func (m *MocksvcRegistrarInApp) AddServiceToApp(app *config.Application, svcName string, opts ...cloudformation1.AddWorkloadToAppOpt) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DomainHostedZoneID", domainName)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []interface{}{app, svcName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddServiceToApp", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// DomainHostedZoneID indicates an expected call of DomainHostedZoneID.
-func (mr *MockdomainHostedZoneGetterMockRecorder) DomainHostedZoneID(domainName interface{}) *gomock.Call {
+// AddServiceToApp indicates an expected call of AddServiceToApp.
+func (mr *MocksvcRegistrarInAppMockRecorder) AddServiceToApp(app, svcName interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainHostedZoneID", reflect.TypeOf((*MockdomainHostedZoneGetter)(nil).DomainHostedZoneID), domainName)
+	varargs := append([]interface{}{app, svcName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*MocksvcRegistrarInApp)(nil).AddServiceToApp), varargs...)
 }
 
-// ValidateDomainOwnership mocks base method.
-func (m *MockdomainHostedZoneGetter) ValidateDomainOwnership(domainName string) error {
+// RemoveServiceFromApp mocks base method.
+func (m *MocksvcRegistrarInApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateDomainOwnership", domainName)
+	ret := m.ctrl.Call(m, "RemoveServiceFromApp", app, svcName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ValidateDomainOwnership indicates an expected call of ValidateDomainOwnership.
-func (mr *MockdomainHostedZoneGetterMockRecorder) ValidateDomainOwnership(domainName interface{}) *gomock.Call {
+// RemoveServiceFromApp indicates an expected call of RemoveServiceFromApp.
+func (mr *MocksvcRegistrarInAppMockRecorder) RemoveServiceFromApp(app, svcName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDomainOwnership", reflect.TypeOf((*MockdomainHostedZoneGetter)(nil).ValidateDomainOwnership), domainName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceFromApp", reflect.TypeOf((*MocksvcRegistrarInApp)(nil).RemoveServiceFromApp), app, svcName)
 }
 
-// MockdockerfileParser is a mock of dockerfileParser interface.
-type MockdockerfileParser struct {
+// MockjobRemoverFromApp is a mock of jobRemoverFromApp interface.
+type MockjobRemoverFromApp struct {
 	ctrl     *gomock.Controller
-	recorder *MockdockerfileParserMockRecorder
+	recorder *MockjobRemoverFromAppMockRecorder
 }
 
-// MockdockerfileParserMockRecorder is the mock recorder for MockdockerfileParser.
-type MockdockerfileParserMockRecorder struct {
-	mock *MockdockerfileParser
+// MockjobRemoverFromAppMockRecorder is the mock recorder for MockjobRemoverFromApp.
+type MockjobRemoverFromAppMockRecorder struct {
+	mock *MockjobRemoverFromApp
 }
 
-// NewMockdockerfileParser creates a new mock instance.
-func NewMockdockerfileParser(ctrl *gomock.Controller) *MockdockerfileParser {
-	mock := &MockdockerfileParser{ctrl: ctrl}
-	mock.recorder = &MockdockerfileParserMockRecorder{mock}
+// NewMockjobRemoverFromApp creates a new mock instance.
+func NewMockjobRemoverFromApp(ctrl *gomock.Controller) *MockjobRemoverFromApp {
+	mock := &MockjobRemoverFromApp{ctrl: ctrl}
+	mock.recorder = &MockjobRemoverFromAppMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdockerfileParser) EXPECT() *MockdockerfileParserMockRecorder {
+func (m *MockjobRemoverFromApp) EXPECT() *MockjobRemoverFromAppMockRecorder {
 	return m.recorder
 }
 
-// GetExposedPorts mocks base method.
-func (m *MockdockerfileParser) GetExposedPorts() ([]dockerfile.Port, error) {
+// RemoveJobFromApp mocks base method.
+func (m *MockjobRemoverFromApp) RemoveJobFromApp(app *config.Application, jobName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetExposedPorts")
-	ret0, _ := ret[0].([]dockerfile.Port)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RemoveJobFromApp", app, jobName)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetExposedPorts indicates an expected call of GetExposedPorts.
-func (mr *MockdockerfileParserMockRecorder) GetExposedPorts() *gomock.Call {
+// RemoveJobFromApp indicates an expected call of RemoveJobFromApp.
+func (mr *MockjobRemoverFromAppMockRecorder) RemoveJobFromApp(app, jobName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExposedPorts", reflect.TypeOf((*MockdockerfileParser)(nil).GetExposedPorts))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveJobFromApp", reflect.TypeOf((*MockjobRemoverFromApp)(nil).RemoveJobFromApp), app, jobName)
 }
 
-// GetHealthCheck mocks base method.
-func (m *MockdockerfileParser) GetHealthCheck() (*dockerfile.HealthCheck, error) {
+// MockimageRemover is a mock of imageRemover interface.
+type MockimageRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageRemoverMockRecorder
+}
+
+// MockimageRemoverMockRecorder is the mock recorder for MockimageRemover.
+type MockimageRemoverMockRecorder struct {
+	mock *MockimageRemover
+}
+
+// NewMockimageRemover creates a new mock instance.
+func NewMockimageRemover(ctrl *gomock.Controller) *MockimageRemover {
+	mock := &MockimageRemover{ctrl: ctrl}
+	mock.recorder = &MockimageRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageRemover) EXPECT() *MockimageRemoverMockRecorder {
+	return m.recorder
+}
+
+// ClearRepository mocks base method.
+func (m *MockimageRemover) ClearRepository(repoName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHealthCheck")
-	ret0, _ := ret[0].(*dockerfile.HealthCheck)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ClearRepository", repoName)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetHealthCheck indicates an expected call of GetHealthCheck.
-func (mr *MockdockerfileParserMockRecorder) GetHealthCheck() *gomock.Call {
+// ClearRepository indicates an expected call of ClearRepository.
+func (mr *MockimageRemoverMockRecorder) ClearRepository(repoName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthCheck", reflect.TypeOf((*MockdockerfileParser)(nil).GetHealthCheck))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRepository", reflect.TypeOf((*MockimageRemover)(nil).ClearRepository), repoName)
 }
 
-// MockstatusDescriber is a mock of statusDescriber interface.
-type MockstatusDescriber struct {
+// MockimageAttester is a mock of imageAttester interface.
+type MockimageAttester struct {
 	ctrl     *gomock.Controller
-	recorder *MockstatusDescriberMockRecorder
+	recorder *MockimageAttesterMockRecorder
 }
 
-// MockstatusDescriberMockRecorder is the mock recorder for MockstatusDescriber.
-type MockstatusDescriberMockRecorder struct {
-	mock *MockstatusDescriber
+// MockimageAttesterMockRecorder is the mock recorder for MockimageAttester.
+type MockimageAttesterMockRecorder struct {
+	mock *MockimageAttester
 }
 
-// NewMockstatusDescriber creates a new mock instance.
-func NewMockstatusDescriber(ctrl *gomock.Controller) *MockstatusDescriber {
-	mock := &MockstatusDescriber{ctrl: ctrl}
-	mock.recorder = &MockstatusDescriberMockRecorder{mock}
+// NewMockimageAttester creates a new mock instance.
+func NewMockimageAttester(ctrl *gomock.Controller) *MockimageAttester {
+	mock := &MockimageAttester{ctrl: ctrl}
+	mock.recorder = &MockimageAttesterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockstatusDescriber) EXPECT() *MockstatusDescriberMockRecorder {
+func (m *MockimageAttester) EXPECT() *MockimageAttesterMockRecorder {
 	return m.recorder
 }
 
-// Describe mocks base method.
-func (m *MockstatusDescriber) Describe() (describe.HumanJSONStringer, error) {
+// PutAttestation mocks base method.
+func (m *MockimageAttester) PutAttestation(img ecr.ImageRef, statement []byte) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Describe")
-	ret0, _ := ret[0].(describe.HumanJSONStringer)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "PutAttestation", img, statement)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Describe indicates an expected call of Describe.
-func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
+// PutAttestation indicates an expected call of PutAttestation.
+func (mr *MockimageAttesterMockRecorder) PutAttestation(img, statement interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAttestation", reflect.TypeOf((*MockimageAttester)(nil).PutAttestation), img, statement)
 }
 
-// MockenvDescriber is a mock of envDescriber interface.
-type MockenvDescriber struct {
+// MockimageAttestationGetter is a mock of imageAttestationGetter interface.
+type MockimageAttestationGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvDescriberMockRecorder
+	recorder *MockimageAttestationGetterMockRecorder
 }
 
-// MockenvDescriberMockRecorder is the mock recorder for MockenvDescriber.
-type MockenvDescriberMockRecorder struct {
-	mock *MockenvDescriber
+// MockimageAttestationGetterMockRecorder is the mock recorder for MockimageAttestationGetter.
+type MockimageAttestationGetterMockRecorder struct {
+	mock *MockimageAttestationGetter
 }
 
-// NewMockenvDescriber creates a new mock instance.
-func NewMockenvDescriber(ctrl *gomock.Controller) *MockenvDescriber {
-	mock := &MockenvDescriber{ctrl: ctrl}
-	mock.recorder = &MockenvDescriberMockRecorder{mock}
+// NewMockimageAttestationGetter creates a new mock instance.
+func NewMockimageAttestationGetter(ctrl *gomock.Controller) *MockimageAttestationGetter {
+	mock := &MockimageAttestationGetter{ctrl: ctrl}
+	mock.recorder = &MockimageAttestationGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockenvDescriber) EXPECT() *MockenvDescriberMockRecorder {
+func (m *MockimageAttestationGetter) EXPECT() *MockimageAttestationGetterMockRecorder {
 	return m.recorder
 }
 
-// Describe mocks base method.
-func (m *MockenvDescriber) Describe() (*describe.EnvDescription, error) {
+// Attestation mocks base method.
+func (m *MockimageAttestationGetter) Attestation(img ecr.ImageRef) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Describe")
-	ret0, _ := ret[0].(*describe.EnvDescription)
+	ret := m.ctrl.Call(m, "Attestation", img)
+	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Describe indicates an expected call of Describe.
-func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
+// Attestation indicates an expected call of Attestation.
+func (mr *MockimageAttestationGetterMockRecorder) Attestation(img interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attestation", reflect.TypeOf((*MockimageAttestationGetter)(nil).Attestation), img)
 }
 
-// Manifest mocks base method.
-func (m *MockenvDescriber) Manifest() ([]byte, error) {
+// ImageDigest mocks base method.
+func (m *MockimageAttestationGetter) ImageDigest(img ecr.ImageRef) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Manifest")
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "ImageDigest", img)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Manifest indicates an expected call of Manifest.
-func (mr *MockenvDescriberMockRecorder) Manifest() *gomock.Call {
+// ImageDigest indicates an expected call of ImageDigest.
+func (mr *MockimageAttestationGetterMockRecorder) ImageDigest(img interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockenvDescriber)(nil).Manifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDigest", reflect.TypeOf((*MockimageAttestationGetter)(nil).ImageDigest), img)
 }
 
-// PublicCIDRBlocks mocks base method.
-func (m *MockenvDescriber) PublicCIDRBlocks() ([]string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublicCIDRBlocks")
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockpipelineDeployer is a mock of pipelineDeployer interface.
+type MockpipelineDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineDeployerMockRecorder
 }
 
-// PublicCIDRBlocks indicates an expected call of PublicCIDRBlocks.
-func (mr *MockenvDescriberMockRecorder) PublicCIDRBlocks() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicCIDRBlocks", reflect.TypeOf((*MockenvDescriber)(nil).PublicCIDRBlocks))
+// MockpipelineDeployerMockRecorder is the mock recorder for MockpipelineDeployer.
+type MockpipelineDeployerMockRecorder struct {
+	mock *MockpipelineDeployer
 }
 
-// ValidateCFServiceDomainAliases mocks base method.
-func (m *MockenvDescriber) ValidateCFServiceDomainAliases() error {
+// NewMockpipelineDeployer creates a new mock instance.
+func NewMockpipelineDeployer(ctrl *gomock.Controller) *MockpipelineDeployer {
+	mock := &MockpipelineDeployer{ctrl: ctrl}
+	mock.recorder = &MockpipelineDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineDeployer) EXPECT() *MockpipelineDeployerMockRecorder {
+	return m.recorder
+}
+
+// AddPipelineResourcesToApp mocks base method.
+func (m *MockpipelineDeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateCFServiceDomainAliases")
+	ret := m.ctrl.Call(m, "AddPipelineResourcesToApp", app, region)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ValidateCFServiceDomainAliases indicates an expected call of ValidateCFServiceDomainAliases.
-func (mr *MockenvDescriberMockRecorder) ValidateCFServiceDomainAliases() *gomock.Call {
+// AddPipelineResourcesToApp indicates an expected call of AddPipelineResourcesToApp.
+func (mr *MockpipelineDeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCFServiceDomainAliases", reflect.TypeOf((*MockenvDescriber)(nil).ValidateCFServiceDomainAliases))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*MockpipelineDeployer)(nil).AddPipelineResourcesToApp), app, region)
 }
 
-// MockversionCompatibilityChecker is a mock of versionCompatibilityChecker interface.
-type MockversionCompatibilityChecker struct {
-	ctrl     *gomock.Controller
-	recorder *MockversionCompatibilityCheckerMockRecorder
+// CreatePipeline mocks base method.
+func (m *MockpipelineDeployer) CreatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePipeline", bucketName, stackConfig)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockversionCompatibilityCheckerMockRecorder is the mock recorder for MockversionCompatibilityChecker.
-type MockversionCompatibilityCheckerMockRecorder struct {
-	mock *MockversionCompatibilityChecker
+// CreatePipeline indicates an expected call of CreatePipeline.
+func (mr *MockpipelineDeployerMockRecorder) CreatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).CreatePipeline), bucketName, stackConfig)
 }
 
-// NewMockversionCompatibilityChecker creates a new mock instance.
-func NewMockversionCompatibilityChecker(ctrl *gomock.Controller) *MockversionCompatibilityChecker {
-	mock := &MockversionCompatibilityChecker{ctrl: ctrl}
-	mock.recorder = &MockversionCompatibilityCheckerMockRecorder{mock}
-	return mock
+// DeletePipeline mocks base method.
+func (m *MockpipelineDeployer) DeletePipeline(pipeline deploy0.Pipeline) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePipeline", pipeline)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockversionCompatibilityChecker) EXPECT() *MockversionCompatibilityCheckerMockRecorder {
-	return m.recorder
+// DeletePipeline indicates an expected call of DeletePipeline.
+func (mr *MockpipelineDeployerMockRecorder) DeletePipeline(pipeline interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).DeletePipeline), pipeline)
 }
 
-// AvailableFeatures mocks base method.
-func (m *MockversionCompatibilityChecker) AvailableFeatures() ([]string, error) {
+// GetAppResourcesByRegion mocks base method.
+func (m *MockpipelineDeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AvailableFeatures")
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
+	ret0, _ := ret[0].(*stack.AppRegionalResources)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AvailableFeatures indicates an expected call of AvailableFeatures.
-func (mr *MockversionCompatibilityCheckerMockRecorder) AvailableFeatures() *gomock.Call {
+// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion.
+func (mr *MockpipelineDeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableFeatures", reflect.TypeOf((*MockversionCompatibilityChecker)(nil).AvailableFeatures))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockpipelineDeployer)(nil).GetAppResourcesByRegion), app, region)
 }
 
-// Version mocks base method.
-func (m *MockversionCompatibilityChecker) Version() (string, error) {
+// GetRegionalAppResources mocks base method.
+func (m *MockpipelineDeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Version")
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
+	ret0, _ := ret[0].([]*stack.AppRegionalResources)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Version indicates an expected call of Version.
-func (mr *MockversionCompatibilityCheckerMockRecorder) Version() *gomock.Call {
+// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
+func (mr *MockpipelineDeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionCompatibilityChecker)(nil).Version))
-}
-
-// MockversionGetter is a mock of versionGetter interface.
-type MockversionGetter struct {
-	ctrl     *gomock.Controller
-	recorder *MockversionGetterMockRecorder
-}
-
-// MockversionGetterMockRecorder is the mock recorder for MockversionGetter.
-type MockversionGetterMockRecorder struct {
-	mock *MockversionGetter
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockpipelineDeployer)(nil).GetRegionalAppResources), app)
 }
 
-// NewMockversionGetter creates a new mock instance.
-func NewMockversionGetter(ctrl *gomock.Controller) *MockversionGetter {
-	mock := &MockversionGetter{ctrl: ctrl}
-	mock.recorder = &MockversionGetterMockRecorder{mock}
-	return mock
+// PipelineExists mocks base method.
+func (m *MockpipelineDeployer) PipelineExists(stackConfig cloudformation1.StackConfiguration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineExists", stackConfig)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockversionGetter) EXPECT() *MockversionGetterMockRecorder {
-	return m.recorder
+// PipelineExists indicates an expected call of PipelineExists.
+func (mr *MockpipelineDeployerMockRecorder) PipelineExists(stackConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*MockpipelineDeployer)(nil).PipelineExists), stackConfig)
 }
 
-// Version mocks base method.
-func (m *MockversionGetter) Version() (string, error) {
+// PipelineResourceName mocks base method.
+func (m *MockpipelineDeployer) PipelineResourceName(stackConfig cloudformation1.StackConfiguration) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Version")
+	ret := m.ctrl.Call(m, "PipelineResourceName", stackConfig)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Version indicates an expected call of Version.
-func (mr *MockversionGetterMockRecorder) Version() *gomock.Call {
+// PipelineResourceName indicates an expected call of PipelineResourceName.
+func (mr *MockpipelineDeployerMockRecorder) PipelineResourceName(stackConfig interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionGetter)(nil).Version))
-}
-
-// MockappUpgrader is a mock of appUpgrader interface.
-type MockappUpgrader struct {
-	ctrl     *gomock.Controller
-	recorder *MockappUpgraderMockRecorder
-}
-
-// MockappUpgraderMockRecorder is the mock recorder for MockappUpgrader.
-type MockappUpgraderMockRecorder struct {
-	mock *MockappUpgrader
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineResourceName", reflect.TypeOf((*MockpipelineDeployer)(nil).PipelineResourceName), stackConfig)
 }
 
-// NewMockappUpgrader creates a new mock instance.
-func NewMockappUpgrader(ctrl *gomock.Controller) *MockappUpgrader {
-	mock := &MockappUpgrader{ctrl: ctrl}
-	mock.recorder = &MockappUpgraderMockRecorder{mock}
-	return mock
+// Template mocks base method.
+func (m *MockpipelineDeployer) Template(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappUpgrader) EXPECT() *MockappUpgraderMockRecorder {
-	return m.recorder
+// Template indicates an expected call of Template.
+func (mr *MockpipelineDeployerMockRecorder) Template(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockpipelineDeployer)(nil).Template), stackName)
 }
 
-// UpgradeApplication mocks base method.
-func (m *MockappUpgrader) UpgradeApplication(in *deploy0.CreateAppInput) error {
+// UpdatePipeline mocks base method.
+func (m *MockpipelineDeployer) UpdatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpgradeApplication", in)
+	ret := m.ctrl.Call(m, "UpdatePipeline", bucketName, stackConfig)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpgradeApplication indicates an expected call of UpgradeApplication.
-func (mr *MockappUpgraderMockRecorder) UpgradeApplication(in interface{}) *gomock.Call {
+// UpdatePipeline indicates an expected call of UpdatePipeline.
+func (mr *MockpipelineDeployerMockRecorder) UpdatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeApplication", reflect.TypeOf((*MockappUpgrader)(nil).UpgradeApplication), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).UpdatePipeline), bucketName, stackConfig)
 }
 
-// MockpipelineGetter is a mock of pipelineGetter interface.
-type MockpipelineGetter struct {
+// MockappDeployer is a mock of appDeployer interface.
+type MockappDeployer struct {
 	ctrl     *gomock.Controller
-	recorder *MockpipelineGetterMockRecorder
+	recorder *MockappDeployerMockRecorder
 }
 
-// MockpipelineGetterMockRecorder is the mock recorder for MockpipelineGetter.
-type MockpipelineGetterMockRecorder struct {
-	mock *MockpipelineGetter
+// MockappDeployerMockRecorder is the mock recorder for MockappDeployer.
+type MockappDeployerMockRecorder struct {
+	mock *MockappDeployer
 }
 
-// NewMockpipelineGetter creates a new mock instance.
-func NewMockpipelineGetter(ctrl *gomock.Controller) *MockpipelineGetter {
-	mock := &MockpipelineGetter{ctrl: ctrl}
-	mock.recorder = &MockpipelineGetterMockRecorder{mock}
+// NewMockappDeployer creates a new mock instance.
+func NewMockappDeployer(ctrl *gomock.Controller) *MockappDeployer {
+	mock := &MockappDeployer{ctrl: ctrl}
+	mock.recorder = &MockappDeployerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpipelineGetter) EXPECT() *MockpipelineGetterMockRecorder {
+func (m *MockappDeployer) EXPECT() *MockappDeployerMockRecorder {
 	return m.recorder
 }
 
-// GetPipeline mocks base method.
-func (m *MockpipelineGetter) GetPipeline(pipelineName string) (*codepipeline.Pipeline, error) {
+// AddEnvToApp mocks base method.
+func (m *MockappDeployer) AddEnvToApp(opts *cloudformation1.AddEnvToAppOpts) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipeline", pipelineName)
-	ret0, _ := ret[0].(*codepipeline.Pipeline)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "AddEnvToApp", opts)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPipeline indicates an expected call of GetPipeline.
-func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{}) *gomock.Call {
+// AddEnvToApp indicates an expected call of AddEnvToApp.
+func (mr *MockappDeployerMockRecorder) AddEnvToApp(opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*MockappDeployer)(nil).AddEnvToApp), opts)
 }
 
-// MockdeployedPipelineLister is a mock of deployedPipelineLister interface.
-type MockdeployedPipelineLister struct {
-	ctrl     *gomock.Controller
-	recorder *MockdeployedPipelineListerMockRecorder
+// AddJobToApp mocks base method.
+func (m *MockappDeployer) AddJobToApp(app *config.Application, jobName string, opts ...cloudformation1.AddWorkloadToAppOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{app, jobName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddJobToApp", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockdeployedPipelineListerMockRecorder is the mock recorder for MockdeployedPipelineLister.
-type MockdeployedPipelineListerMockRecorder struct {
-	mock *MockdeployedPipelineLister
+// AddJobToApp indicates an expected call of AddJobToApp.
+func (mr *MockappDeployerMockRecorder) AddJobToApp(app, jobName interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{app, jobName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddJobToApp", reflect.TypeOf((*MockappDeployer)(nil).AddJobToApp), varargs...)
 }
 
-// NewMockdeployedPipelineLister creates a new mock instance.
-func NewMockdeployedPipelineLister(ctrl *gomock.Controller) *MockdeployedPipelineLister {
-	mock := &MockdeployedPipelineLister{ctrl: ctrl}
-	mock.recorder = &MockdeployedPipelineListerMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdeployedPipelineLister) EXPECT() *MockdeployedPipelineListerMockRecorder {
-	return m.recorder
-}
-
-// ListDeployedPipelines mocks base method.
-func (m *MockdeployedPipelineLister) ListDeployedPipelines(appName string) ([]deploy0.Pipeline, error) {
+// AddServiceToApp mocks base method.
+func (m *MockappDeployer) AddServiceToApp(app *config.Application, svcName string, opts ...cloudformation1.AddWorkloadToAppOpt) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDeployedPipelines", appName)
-	ret0, _ := ret[0].([]deploy0.Pipeline)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []interface{}{app, svcName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddServiceToApp", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListDeployedPipelines indicates an expected call of ListDeployedPipelines.
-func (mr *MockdeployedPipelineListerMockRecorder) ListDeployedPipelines(appName interface{}) *gomock.Call {
+// AddServiceToApp indicates an expected call of AddServiceToApp.
+func (mr *MockappDeployerMockRecorder) AddServiceToApp(app, svcName interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedPipelines", reflect.TypeOf((*MockdeployedPipelineLister)(nil).ListDeployedPipelines), appName)
+	varargs := append([]interface{}{app, svcName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*MockappDeployer)(nil).AddServiceToApp), varargs...)
 }
 
-// Mockexecutor is a mock of executor interface.
-type Mockexecutor struct {
-	ctrl     *gomock.Controller
-	recorder *MockexecutorMockRecorder
+// DelegateDNSPermissions mocks base method.
+func (m *MockappDeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockexecutorMockRecorder is the mock recorder for Mockexecutor.
-type MockexecutorMockRecorder struct {
-	mock *Mockexecutor
+// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions.
+func (mr *MockappDeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*MockappDeployer)(nil).DelegateDNSPermissions), app, accountID)
 }
 
-// NewMockexecutor creates a new mock instance.
-func NewMockexecutor(ctrl *gomock.Controller) *Mockexecutor {
-	mock := &Mockexecutor{ctrl: ctrl}
-	mock.recorder = &MockexecutorMockRecorder{mock}
-	return mock
+// DeleteApp mocks base method.
+func (m *MockappDeployer) DeleteApp(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApp", name)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockexecutor) EXPECT() *MockexecutorMockRecorder {
-	return m.recorder
+// DeleteApp indicates an expected call of DeleteApp.
+func (mr *MockappDeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*MockappDeployer)(nil).DeleteApp), name)
 }
 
-// Execute mocks base method.
-func (m *Mockexecutor) Execute() error {
+// DeployApp mocks base method.
+func (m *MockappDeployer) DeployApp(in *deploy0.CreateAppInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute")
+	ret := m.ctrl.Call(m, "DeployApp", in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Execute indicates an expected call of Execute.
-func (mr *MockexecutorMockRecorder) Execute() *gomock.Call {
+// DeployApp indicates an expected call of DeployApp.
+func (mr *MockappDeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*Mockexecutor)(nil).Execute))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*MockappDeployer)(nil).DeployApp), in)
 }
 
-// MockexecuteAsker is a mock of executeAsker interface.
-type MockexecuteAsker struct {
+// MockappResourcesGetter is a mock of appResourcesGetter interface.
+type MockappResourcesGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockexecuteAskerMockRecorder
+	recorder *MockappResourcesGetterMockRecorder
 }
 
-// MockexecuteAskerMockRecorder is the mock recorder for MockexecuteAsker.
-type MockexecuteAskerMockRecorder struct {
-	mock *MockexecuteAsker
+// MockappResourcesGetterMockRecorder is the mock recorder for MockappResourcesGetter.
+type MockappResourcesGetterMockRecorder struct {
+	mock *MockappResourcesGetter
 }
 
-// NewMockexecuteAsker creates a new mock instance.
-func NewMockexecuteAsker(ctrl *gomock.Controller) *MockexecuteAsker {
-	mock := &MockexecuteAsker{ctrl: ctrl}
-	mock.recorder = &MockexecuteAskerMockRecorder{mock}
+// NewMockappResourcesGetter creates a new mock instance.
+func NewMockappResourcesGetter(ctrl *gomock.Controller) *MockappResourcesGetter {
+	mock := &MockappResourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockappResourcesGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockexecuteAsker) EXPECT() *MockexecuteAskerMockRecorder {
+func (m *MockappResourcesGetter) EXPECT() *MockappResourcesGetterMockRecorder {
 	return m.recorder
 }
 
-// Ask mocks base method.
-func (m *MockexecuteAsker) Ask() error {
+// GetAppResourcesByRegion mocks base method.
+func (m *MockappResourcesGetter) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Ask")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
+	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Ask indicates an expected call of Ask.
-func (mr *MockexecuteAskerMockRecorder) Ask() *gomock.Call {
+// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion.
+func (mr *MockappResourcesGetterMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ask", reflect.TypeOf((*MockexecuteAsker)(nil).Ask))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockappResourcesGetter)(nil).GetAppResourcesByRegion), app, region)
 }
 
-// Execute mocks base method.
-func (m *MockexecuteAsker) Execute() error {
+// GetRegionalAppResources mocks base method.
+func (m *MockappResourcesGetter) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
+	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Execute indicates an expected call of Execute.
-func (mr *MockexecuteAskerMockRecorder) Execute() *gomock.Call {
+// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
+func (mr *MockappResourcesGetterMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockexecuteAsker)(nil).Execute))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockappResourcesGetter)(nil).GetRegionalAppResources), app)
 }
 
-// MockappSelector is a mock of appSelector interface.
-type MockappSelector struct {
+// MockenvDeleterFromApp is a mock of envDeleterFromApp interface.
+type MockenvDeleterFromApp struct {
 	ctrl     *gomock.Controller
-	recorder *MockappSelectorMockRecorder
+	recorder *MockenvDeleterFromAppMockRecorder
 }
 
-// MockappSelectorMockRecorder is the mock recorder for MockappSelector.
-type MockappSelectorMockRecorder struct {
-	mock *MockappSelector
+// MockenvDeleterFromAppMockRecorder is the mock recorder for MockenvDeleterFromApp.
+type MockenvDeleterFromAppMockRecorder struct {
+	mock *MockenvDeleterFromApp
 }
 
-// NewMockappSelector creates a new mock instance.
-func NewMockappSelector(ctrl *gomock.Controller) *MockappSelector {
-	mock := &MockappSelector{ctrl: ctrl}
-	mock.recorder = &MockappSelectorMockRecorder{mock}
+// NewMockenvDeleterFromApp creates a new mock instance.
+func NewMockenvDeleterFromApp(ctrl *gomock.Controller) *MockenvDeleterFromApp {
+	mock := &MockenvDeleterFromApp{ctrl: ctrl}
+	mock.recorder = &MockenvDeleterFromAppMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappSelector) EXPECT() *MockappSelectorMockRecorder {
+func (m *MockenvDeleterFromApp) EXPECT() *MockenvDeleterFromAppMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockappSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// GetAppResourcesByRegion mocks base method.
+func (m *MockenvDeleterFromApp) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
+	ret0, _ := ret[0].(*stack.AppRegionalResources)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockappSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion.
+func (mr *MockenvDeleterFromAppMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappSelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockenvDeleterFromApp)(nil).GetAppResourcesByRegion), app, region)
 }
 
-// MockappEnvSelector is a mock of appEnvSelector interface.
-type MockappEnvSelector struct {
+// GetRegionalAppResources mocks base method.
+func (m *MockenvDeleterFromApp) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
+	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
+func (mr *MockenvDeleterFromAppMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockenvDeleterFromApp)(nil).GetRegionalAppResources), app)
+}
+
+// RemoveEnvFromApp mocks base method.
+func (m *MockenvDeleterFromApp) RemoveEnvFromApp(opts *cloudformation1.RemoveEnvFromAppOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEnvFromApp", opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveEnvFromApp indicates an expected call of RemoveEnvFromApp.
+func (mr *MockenvDeleterFromAppMockRecorder) RemoveEnvFromApp(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEnvFromApp", reflect.TypeOf((*MockenvDeleterFromApp)(nil).RemoveEnvFromApp), opts)
+}
+
+// MocktaskDeployer is a mock of taskDeployer interface.
+type MocktaskDeployer struct {
 	ctrl     *gomock.Controller
-	recorder *MockappEnvSelectorMockRecorder
+	recorder *MocktaskDeployerMockRecorder
 }
 
-// MockappEnvSelectorMockRecorder is the mock recorder for MockappEnvSelector.
-type MockappEnvSelectorMockRecorder struct {
-	mock *MockappEnvSelector
+// MocktaskDeployerMockRecorder is the mock recorder for MocktaskDeployer.
+type MocktaskDeployerMockRecorder struct {
+	mock *MocktaskDeployer
 }
 
-// NewMockappEnvSelector creates a new mock instance.
-func NewMockappEnvSelector(ctrl *gomock.Controller) *MockappEnvSelector {
-	mock := &MockappEnvSelector{ctrl: ctrl}
-	mock.recorder = &MockappEnvSelectorMockRecorder{mock}
+// NewMocktaskDeployer creates a new mock instance.
+func NewMocktaskDeployer(ctrl *gomock.Controller) *MocktaskDeployer {
+	mock := &MocktaskDeployer{ctrl: ctrl}
+	mock.recorder = &MocktaskDeployerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappEnvSelector) EXPECT() *MockappEnvSelectorMockRecorder {
+func (m *MocktaskDeployer) EXPECT() *MocktaskDeployerMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockappEnvSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// DeployTask mocks base method.
+func (m *MocktaskDeployer) DeployTask(input *deploy0.CreateTaskResourcesInput, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
+	varargs := []interface{}{input}
+	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeployTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockappEnvSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// DeployTask indicates an expected call of DeployTask.
+func (mr *MocktaskDeployerMockRecorder) DeployTask(input interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappEnvSelector)(nil).Application), varargs...)
+	varargs := append([]interface{}{input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployTask", reflect.TypeOf((*MocktaskDeployer)(nil).DeployTask), varargs...)
 }
 
-// Environment mocks base method.
-func (m *MockappEnvSelector) Environment(prompt, help, app string, additionalOpts ...prompt.Option) (string, error) {
+// GetTaskStack mocks base method.
+func (m *MocktaskDeployer) GetTaskStack(taskName string) (*deploy0.TaskStackInfo, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Environment", varargs...)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetTaskStack", taskName)
+	ret0, _ := ret[0].(*deploy0.TaskStackInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Environment indicates an expected call of Environment.
-func (mr *MockappEnvSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+// GetTaskStack indicates an expected call of GetTaskStack.
+func (mr *MocktaskDeployerMockRecorder) GetTaskStack(taskName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockappEnvSelector)(nil).Environment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskStack", reflect.TypeOf((*MocktaskDeployer)(nil).GetTaskStack), taskName)
 }
 
-// MockcfnSelector is a mock of cfnSelector interface.
-type MockcfnSelector struct {
+// MocktaskStackManager is a mock of taskStackManager interface.
+type MocktaskStackManager struct {
 	ctrl     *gomock.Controller
-	recorder *MockcfnSelectorMockRecorder
+	recorder *MocktaskStackManagerMockRecorder
 }
 
-// MockcfnSelectorMockRecorder is the mock recorder for MockcfnSelector.
-type MockcfnSelectorMockRecorder struct {
-	mock *MockcfnSelector
+// MocktaskStackManagerMockRecorder is the mock recorder for MocktaskStackManager.
+type MocktaskStackManagerMockRecorder struct {
+	mock *MocktaskStackManager
 }
 
-// NewMockcfnSelector creates a new mock instance.
-func NewMockcfnSelector(ctrl *gomock.Controller) *MockcfnSelector {
-	mock := &MockcfnSelector{ctrl: ctrl}
-	mock.recorder = &MockcfnSelectorMockRecorder{mock}
+// NewMocktaskStackManager creates a new mock instance.
+func NewMocktaskStackManager(ctrl *gomock.Controller) *MocktaskStackManager {
+	mock := &MocktaskStackManager{ctrl: ctrl}
+	mock.recorder = &MocktaskStackManagerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcfnSelector) EXPECT() *MockcfnSelectorMockRecorder {
+func (m *MocktaskStackManager) EXPECT() *MocktaskStackManagerMockRecorder {
 	return m.recorder
 }
 
-// Resources mocks base method.
-func (m *MockcfnSelector) Resources(msg, finalMsg, help, body string) ([]template.CFNResource, error) {
+// DeleteTask mocks base method.
+func (m *MocktaskStackManager) DeleteTask(task deploy0.TaskStackInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Resources", msg, finalMsg, help, body)
-	ret0, _ := ret[0].([]template.CFNResource)
+	ret := m.ctrl.Call(m, "DeleteTask", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTask indicates an expected call of DeleteTask.
+func (mr *MocktaskStackManagerMockRecorder) DeleteTask(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MocktaskStackManager)(nil).DeleteTask), task)
+}
+
+// GetTaskStack mocks base method.
+func (m *MocktaskStackManager) GetTaskStack(taskName string) (*deploy0.TaskStackInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskStack", taskName)
+	ret0, _ := ret[0].(*deploy0.TaskStackInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Resources indicates an expected call of Resources.
-func (mr *MockcfnSelectorMockRecorder) Resources(msg, finalMsg, help, body interface{}) *gomock.Call {
+// GetTaskStack indicates an expected call of GetTaskStack.
+func (mr *MocktaskStackManagerMockRecorder) GetTaskStack(taskName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockcfnSelector)(nil).Resources), msg, finalMsg, help, body)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskStack", reflect.TypeOf((*MocktaskStackManager)(nil).GetTaskStack), taskName)
 }
 
-// MockconfigSelector is a mock of configSelector interface.
-type MockconfigSelector struct {
+// MocktaskStackLister is a mock of taskStackLister interface.
+type MocktaskStackLister struct {
 	ctrl     *gomock.Controller
-	recorder *MockconfigSelectorMockRecorder
+	recorder *MocktaskStackListerMockRecorder
 }
 
-// MockconfigSelectorMockRecorder is the mock recorder for MockconfigSelector.
-type MockconfigSelectorMockRecorder struct {
-	mock *MockconfigSelector
+// MocktaskStackListerMockRecorder is the mock recorder for MocktaskStackLister.
+type MocktaskStackListerMockRecorder struct {
+	mock *MocktaskStackLister
 }
 
-// NewMockconfigSelector creates a new mock instance.
-func NewMockconfigSelector(ctrl *gomock.Controller) *MockconfigSelector {
-	mock := &MockconfigSelector{ctrl: ctrl}
-	mock.recorder = &MockconfigSelectorMockRecorder{mock}
+// NewMocktaskStackLister creates a new mock instance.
+func NewMocktaskStackLister(ctrl *gomock.Controller) *MocktaskStackLister {
+	mock := &MocktaskStackLister{ctrl: ctrl}
+	mock.recorder = &MocktaskStackListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockconfigSelector) EXPECT() *MockconfigSelectorMockRecorder {
+func (m *MocktaskStackLister) EXPECT() *MocktaskStackListerMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockconfigSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// ListDefaultTaskStacks mocks base method.
+func (m *MocktaskStackLister) ListDefaultTaskStacks() ([]deploy0.TaskStackInfo, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListDefaultTaskStacks")
+	ret0, _ := ret[0].([]deploy0.TaskStackInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockconfigSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// ListDefaultTaskStacks indicates an expected call of ListDefaultTaskStacks.
+func (mr *MocktaskStackListerMockRecorder) ListDefaultTaskStacks() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockconfigSelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDefaultTaskStacks", reflect.TypeOf((*MocktaskStackLister)(nil).ListDefaultTaskStacks))
 }
 
-// Environment mocks base method.
-func (m *MockconfigSelector) Environment(prompt, help, app string, additionalOpts ...prompt.Option) (string, error) {
+// ListTaskStacks mocks base method.
+func (m *MocktaskStackLister) ListTaskStacks(appName, envName string) ([]deploy0.TaskStackInfo, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Environment", varargs...)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListTaskStacks", appName, envName)
+	ret0, _ := ret[0].([]deploy0.TaskStackInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Environment indicates an expected call of Environment.
-func (mr *MockconfigSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+// ListTaskStacks indicates an expected call of ListTaskStacks.
+func (mr *MocktaskStackListerMockRecorder) ListTaskStacks(appName, envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockconfigSelector)(nil).Environment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskStacks", reflect.TypeOf((*MocktaskStackLister)(nil).ListTaskStacks), appName, envName)
 }
 
-// Job mocks base method.
-func (m *MockconfigSelector) Job(prompt, help, app string) (string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Job", prompt, help, app)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MocktaskResourcesDeleter is a mock of taskResourcesDeleter interface.
+type MocktaskResourcesDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskResourcesDeleterMockRecorder
 }
 
-// Job indicates an expected call of Job.
-func (mr *MockconfigSelectorMockRecorder) Job(prompt, help, app interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockconfigSelector)(nil).Job), prompt, help, app)
+// MocktaskResourcesDeleterMockRecorder is the mock recorder for MocktaskResourcesDeleter.
+type MocktaskResourcesDeleterMockRecorder struct {
+	mock *MocktaskResourcesDeleter
 }
 
-// Service mocks base method.
-func (m *MockconfigSelector) Service(prompt, help, app string) (string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Service", prompt, help, app)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// NewMocktaskResourcesDeleter creates a new mock instance.
+func NewMocktaskResourcesDeleter(ctrl *gomock.Controller) *MocktaskResourcesDeleter {
+	mock := &MocktaskResourcesDeleter{ctrl: ctrl}
+	mock.recorder = &MocktaskResourcesDeleterMockRecorder{mock}
+	return mock
 }
 
-// Service indicates an expected call of Service.
-func (mr *MockconfigSelectorMockRecorder) Service(prompt, help, app interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockconfigSelector)(nil).Service), prompt, help, app)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskResourcesDeleter) EXPECT() *MocktaskResourcesDeleterMockRecorder {
+	return m.recorder
 }
 
-// Workload mocks base method.
-func (m *MockconfigSelector) Workload(prompt, help, app string) (string, error) {
+// DeleteResources mocks base method.
+func (m *MocktaskResourcesDeleter) DeleteResources() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Workload", prompt, help, app)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteResources")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Workload indicates an expected call of Workload.
-func (mr *MockconfigSelectorMockRecorder) Workload(prompt, help, app interface{}) *gomock.Call {
+// DeleteResources indicates an expected call of DeleteResources.
+func (mr *MocktaskResourcesDeleterMockRecorder) DeleteResources() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockconfigSelector)(nil).Workload), prompt, help, app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResources", reflect.TypeOf((*MocktaskResourcesDeleter)(nil).DeleteResources))
 }
 
-// MockdeploySelector is a mock of deploySelector interface.
-type MockdeploySelector struct {
+// MocktaskRunner is a mock of taskRunner interface.
+type MocktaskRunner struct {
 	ctrl     *gomock.Controller
-	recorder *MockdeploySelectorMockRecorder
+	recorder *MocktaskRunnerMockRecorder
 }
 
-// MockdeploySelectorMockRecorder is the mock recorder for MockdeploySelector.
-type MockdeploySelectorMockRecorder struct {
-	mock *MockdeploySelector
+// MocktaskRunnerMockRecorder is the mock recorder for MocktaskRunner.
+type MocktaskRunnerMockRecorder struct {
+	mock *MocktaskRunner
 }
 
-// NewMockdeploySelector creates a new mock instance.
-func NewMockdeploySelector(ctrl *gomock.Controller) *MockdeploySelector {
-	mock := &MockdeploySelector{ctrl: ctrl}
-	mock.recorder = &MockdeploySelectorMockRecorder{mock}
+// NewMocktaskRunner creates a new mock instance.
+func NewMocktaskRunner(ctrl *gomock.Controller) *MocktaskRunner {
+	mock := &MocktaskRunner{ctrl: ctrl}
+	mock.recorder = &MocktaskRunnerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdeploySelector) EXPECT() *MockdeploySelectorMockRecorder {
+func (m *MocktaskRunner) EXPECT() *MocktaskRunnerMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockdeploySelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// CheckNonZeroExitCode mocks base method.
+func (m *MocktaskRunner) CheckNonZeroExitCode(arg0 []*task.Task) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CheckNonZeroExitCode", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockdeploySelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// CheckNonZeroExitCode indicates an expected call of CheckNonZeroExitCode.
+func (mr *MocktaskRunnerMockRecorder) CheckNonZeroExitCode(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeploySelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckNonZeroExitCode", reflect.TypeOf((*MocktaskRunner)(nil).CheckNonZeroExitCode), arg0)
 }
 
-// DeployedJob mocks base method.
-func (m *MockdeploySelector) DeployedJob(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedJob, error) {
+// NetworkConfig mocks base method.
+func (m *MocktaskRunner) NetworkConfig() (*task.NetworkConfig, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "DeployedJob", varargs...)
-	ret0, _ := ret[0].(*selector.DeployedJob)
+	ret := m.ctrl.Call(m, "NetworkConfig")
+	ret0, _ := ret[0].(*task.NetworkConfig)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeployedJob indicates an expected call of DeployedJob.
-func (mr *MockdeploySelectorMockRecorder) DeployedJob(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+// NetworkConfig indicates an expected call of NetworkConfig.
+func (mr *MocktaskRunnerMockRecorder) NetworkConfig() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedJob", reflect.TypeOf((*MockdeploySelector)(nil).DeployedJob), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkConfig", reflect.TypeOf((*MocktaskRunner)(nil).NetworkConfig))
 }
 
-// DeployedService mocks base method.
-func (m *MockdeploySelector) DeployedService(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedService, error) {
+// Run mocks base method.
+func (m *MocktaskRunner) Run() ([]*task.Task, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "DeployedService", varargs...)
-	ret0, _ := ret[0].(*selector.DeployedService)
+	ret := m.ctrl.Call(m, "Run")
+	ret0, _ := ret[0].([]*task.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeployedService indicates an expected call of DeployedService.
-func (mr *MockdeploySelectorMockRecorder) DeployedService(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+// Run indicates an expected call of Run.
+func (mr *MocktaskRunnerMockRecorder) Run() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedService", reflect.TypeOf((*MockdeploySelector)(nil).DeployedService), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocktaskRunner)(nil).Run))
 }
 
-// DeployedWorkload mocks base method.
-func (m *MockdeploySelector) DeployedWorkload(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedWorkload, error) {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "DeployedWorkload", varargs...)
-	ret0, _ := ret[0].(*selector.DeployedWorkload)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockdefaultClusterGetter is a mock of defaultClusterGetter interface.
+type MockdefaultClusterGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockdefaultClusterGetterMockRecorder
 }
 
-// DeployedWorkload indicates an expected call of DeployedWorkload.
-func (mr *MockdeploySelectorMockRecorder) DeployedWorkload(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedWorkload", reflect.TypeOf((*MockdeploySelector)(nil).DeployedWorkload), varargs...)
+// MockdefaultClusterGetterMockRecorder is the mock recorder for MockdefaultClusterGetter.
+type MockdefaultClusterGetterMockRecorder struct {
+	mock *MockdefaultClusterGetter
 }
 
-// MockpipelineEnvSelector is a mock of pipelineEnvSelector interface.
-type MockpipelineEnvSelector struct {
-	ctrl     *gomock.Controller
-	recorder *MockpipelineEnvSelectorMockRecorder
+// NewMockdefaultClusterGetter creates a new mock instance.
+func NewMockdefaultClusterGetter(ctrl *gomock.Controller) *MockdefaultClusterGetter {
+	mock := &MockdefaultClusterGetter{ctrl: ctrl}
+	mock.recorder = &MockdefaultClusterGetterMockRecorder{mock}
+	return mock
 }
 
-// MockpipelineEnvSelectorMockRecorder is the mock recorder for MockpipelineEnvSelector.
-type MockpipelineEnvSelectorMockRecorder struct {
-	mock *MockpipelineEnvSelector
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdefaultClusterGetter) EXPECT() *MockdefaultClusterGetterMockRecorder {
+	return m.recorder
 }
 
-// NewMockpipelineEnvSelector creates a new mock instance.
+// HasDefaultCluster mocks base method.
+func (m *MockdefaultClusterGetter) HasDefaultCluster() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDefaultCluster")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDefaultCluster indicates an expected call of HasDefaultCluster.
+func (mr *MockdefaultClusterGetterMockRecorder) HasDefaultCluster() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDefaultCluster", reflect.TypeOf((*MockdefaultClusterGetter)(nil).HasDefaultCluster))
+}
+
+// Mockdeployer is a mock of deployer interface.
+type Mockdeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployerMockRecorder
+}
+
+// MockdeployerMockRecorder is the mock recorder for Mockdeployer.
+type MockdeployerMockRecorder struct {
+	mock *Mockdeployer
+}
+
+// NewMockdeployer creates a new mock instance.
+func NewMockdeployer(ctrl *gomock.Controller) *Mockdeployer {
+	mock := &Mockdeployer{ctrl: ctrl}
+	mock.recorder = &MockdeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockdeployer) EXPECT() *MockdeployerMockRecorder {
+	return m.recorder
+}
+
+// AddEnvToApp mocks base method.
+func (m *Mockdeployer) AddEnvToApp(opts *cloudformation1.AddEnvToAppOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEnvToApp", opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEnvToApp indicates an expected call of AddEnvToApp.
+func (mr *MockdeployerMockRecorder) AddEnvToApp(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*Mockdeployer)(nil).AddEnvToApp), opts)
+}
+
+// AddJobToApp mocks base method.
+func (m *Mockdeployer) AddJobToApp(app *config.Application, jobName string, opts ...cloudformation1.AddWorkloadToAppOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{app, jobName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddJobToApp", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddJobToApp indicates an expected call of AddJobToApp.
+func (mr *MockdeployerMockRecorder) AddJobToApp(app, jobName interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{app, jobName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddJobToApp", reflect.TypeOf((*Mockdeployer)(nil).AddJobToApp), varargs...)
+}
+
+// AddPipelineResourcesToApp mocks base method.
+func (m *Mockdeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPipelineResourcesToApp", app, region)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPipelineResourcesToApp indicates an expected call of AddPipelineResourcesToApp.
+func (mr *MockdeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*Mockdeployer)(nil).AddPipelineResourcesToApp), app, region)
+}
+
+// AddServiceToApp mocks base method.
+func (m *Mockdeployer) AddServiceToApp(app *config.Application, svcName string, opts ...cloudformation1.AddWorkloadToAppOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{app, svcName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddServiceToApp", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddServiceToApp indicates an expected call of AddServiceToApp.
+func (mr *MockdeployerMockRecorder) AddServiceToApp(app, svcName interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{app, svcName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*Mockdeployer)(nil).AddServiceToApp), varargs...)
+}
+
+// CreateAndRenderEnvironment mocks base method.
+func (m *Mockdeployer) CreateAndRenderEnvironment(conf cloudformation1.StackConfiguration, bucketARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAndRenderEnvironment", conf, bucketARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAndRenderEnvironment indicates an expected call of CreateAndRenderEnvironment.
+func (mr *MockdeployerMockRecorder) CreateAndRenderEnvironment(conf, bucketARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndRenderEnvironment", reflect.TypeOf((*Mockdeployer)(nil).CreateAndRenderEnvironment), conf, bucketARN)
+}
+
+// CreatePipeline mocks base method.
+func (m *Mockdeployer) CreatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePipeline", bucketName, stackConfig)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePipeline indicates an expected call of CreatePipeline.
+func (mr *MockdeployerMockRecorder) CreatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*Mockdeployer)(nil).CreatePipeline), bucketName, stackConfig)
+}
+
+// DelegateDNSPermissions mocks base method.
+func (m *Mockdeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions.
+func (mr *MockdeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*Mockdeployer)(nil).DelegateDNSPermissions), app, accountID)
+}
+
+// DeleteApp mocks base method.
+func (m *Mockdeployer) DeleteApp(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApp", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApp indicates an expected call of DeleteApp.
+func (mr *MockdeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*Mockdeployer)(nil).DeleteApp), name)
+}
+
+// DeleteEnvironment mocks base method.
+func (m *Mockdeployer) DeleteEnvironment(appName, envName, cfnExecRoleARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnvironment", appName, envName, cfnExecRoleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnvironment indicates an expected call of DeleteEnvironment.
+func (mr *MockdeployerMockRecorder) DeleteEnvironment(appName, envName, cfnExecRoleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeleteEnvironment), appName, envName, cfnExecRoleARN)
+}
+
+// DeletePipeline mocks base method.
+func (m *Mockdeployer) DeletePipeline(pipeline deploy0.Pipeline) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePipeline", pipeline)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePipeline indicates an expected call of DeletePipeline.
+func (mr *MockdeployerMockRecorder) DeletePipeline(pipeline interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*Mockdeployer)(nil).DeletePipeline), pipeline)
+}
+
+// DeployApp mocks base method.
+func (m *Mockdeployer) DeployApp(in *deploy0.CreateAppInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployApp", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployApp indicates an expected call of DeployApp.
+func (mr *MockdeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*Mockdeployer)(nil).DeployApp), in)
+}
+
+// GetAppResourcesByRegion mocks base method.
+func (m *Mockdeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
+	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion.
+func (mr *MockdeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*Mockdeployer)(nil).GetAppResourcesByRegion), app, region)
+}
+
+// GetEnvironment mocks base method.
+func (m *Mockdeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironment", appName, envName)
+	ret0, _ := ret[0].(*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironment indicates an expected call of GetEnvironment.
+func (mr *MockdeployerMockRecorder) GetEnvironment(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*Mockdeployer)(nil).GetEnvironment), appName, envName)
+}
+
+// GetRegionalAppResources mocks base method.
+func (m *Mockdeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
+	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
+func (mr *MockdeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*Mockdeployer)(nil).GetRegionalAppResources), app)
+}
+
+// ListTaskStacks mocks base method.
+func (m *Mockdeployer) ListTaskStacks(appName, envName string) ([]deploy0.TaskStackInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskStacks", appName, envName)
+	ret0, _ := ret[0].([]deploy0.TaskStackInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskStacks indicates an expected call of ListTaskStacks.
+func (mr *MockdeployerMockRecorder) ListTaskStacks(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskStacks", reflect.TypeOf((*Mockdeployer)(nil).ListTaskStacks), appName, envName)
+}
+
+// PipelineExists mocks base method.
+func (m *Mockdeployer) PipelineExists(stackConfig cloudformation1.StackConfiguration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineExists", stackConfig)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineExists indicates an expected call of PipelineExists.
+func (mr *MockdeployerMockRecorder) PipelineExists(stackConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*Mockdeployer)(nil).PipelineExists), stackConfig)
+}
+
+// PipelineResourceName mocks base method.
+func (m *Mockdeployer) PipelineResourceName(stackConfig cloudformation1.StackConfiguration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineResourceName", stackConfig)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineResourceName indicates an expected call of PipelineResourceName.
+func (mr *MockdeployerMockRecorder) PipelineResourceName(stackConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineResourceName", reflect.TypeOf((*Mockdeployer)(nil).PipelineResourceName), stackConfig)
+}
+
+// Template mocks base method.
+func (m *Mockdeployer) Template(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockdeployerMockRecorder) Template(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*Mockdeployer)(nil).Template), stackName)
+}
+
+// UpdateEnvironmentTemplate mocks base method.
+func (m *Mockdeployer) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironmentTemplate", appName, envName, templateBody, cfnExecRoleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironmentTemplate indicates an expected call of UpdateEnvironmentTemplate.
+func (mr *MockdeployerMockRecorder) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentTemplate", reflect.TypeOf((*Mockdeployer)(nil).UpdateEnvironmentTemplate), appName, envName, templateBody, cfnExecRoleARN)
+}
+
+// UpdatePipeline mocks base method.
+func (m *Mockdeployer) UpdatePipeline(bucketName string, stackConfig cloudformation1.StackConfiguration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePipeline", bucketName, stackConfig)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePipeline indicates an expected call of UpdatePipeline.
+func (mr *MockdeployerMockRecorder) UpdatePipeline(bucketName, stackConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*Mockdeployer)(nil).UpdatePipeline), bucketName, stackConfig)
+}
+
+// MockdomainHostedZoneGetter is a mock of domainHostedZoneGetter interface.
+type MockdomainHostedZoneGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockdomainHostedZoneGetterMockRecorder
+}
+
+// MockdomainHostedZoneGetterMockRecorder is the mock recorder for MockdomainHostedZoneGetter.
+type MockdomainHostedZoneGetterMockRecorder struct {
+	mock *MockdomainHostedZoneGetter
+}
+
+// NewMockdomainHostedZoneGetter creates a new mock instance.
+func NewMockdomainHostedZoneGetter(ctrl *gomock.Controller) *MockdomainHostedZoneGetter {
+	mock := &MockdomainHostedZoneGetter{ctrl: ctrl}
+	mock.recorder = &MockdomainHostedZoneGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdomainHostedZoneGetter) EXPECT() *MockdomainHostedZoneGetterMockRecorder {
+	return m.recorder
+}
+
+// DomainHostedZoneID mocks base method.
+func (m *MockdomainHostedZoneGetter) DomainHostedZoneID(domainName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainHostedZoneID", domainName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DomainHostedZoneID indicates an expected call of DomainHostedZoneID.
+func (mr *MockdomainHostedZoneGetterMockRecorder) DomainHostedZoneID(domainName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainHostedZoneID", reflect.TypeOf((*MockdomainHostedZoneGetter)(nil).DomainHostedZoneID), domainName)
+}
+
+// ValidateDomainOwnership mocks base method.
+func (m *MockdomainHostedZoneGetter) ValidateDomainOwnership(domainName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateDomainOwnership", domainName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateDomainOwnership indicates an expected call of ValidateDomainOwnership.
+func (mr *MockdomainHostedZoneGetterMockRecorder) ValidateDomainOwnership(domainName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDomainOwnership", reflect.TypeOf((*MockdomainHostedZoneGetter)(nil).ValidateDomainOwnership), domainName)
+}
+
+// MockdockerfileParser is a mock of dockerfileParser interface.
+type MockdockerfileParser struct {
+	ctrl     *gomock.Controller
+	recorder *MockdockerfileParserMockRecorder
+}
+
+// MockdockerfileParserMockRecorder is the mock recorder for MockdockerfileParser.
+type MockdockerfileParserMockRecorder struct {
+	mock *MockdockerfileParser
+}
+
+// NewMockdockerfileParser creates a new mock instance.
+func NewMockdockerfileParser(ctrl *gomock.Controller) *MockdockerfileParser {
+	mock := &MockdockerfileParser{ctrl: ctrl}
+	mock.recorder = &MockdockerfileParserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdockerfileParser) EXPECT() *MockdockerfileParserMockRecorder {
+	return m.recorder
+}
+
+// GetExposedPorts mocks base method.
+func (m *MockdockerfileParser) GetExposedPorts() ([]dockerfile.Port, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExposedPorts")
+	ret0, _ := ret[0].([]dockerfile.Port)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExposedPorts indicates an expected call of GetExposedPorts.
+func (mr *MockdockerfileParserMockRecorder) GetExposedPorts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExposedPorts", reflect.TypeOf((*MockdockerfileParser)(nil).GetExposedPorts))
+}
+
+// GetHealthCheck mocks base method.
+func (m *MockdockerfileParser) GetHealthCheck() (*dockerfile.HealthCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealthCheck")
+	ret0, _ := ret[0].(*dockerfile.HealthCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHealthCheck indicates an expected call of GetHealthCheck.
+func (mr *MockdockerfileParserMockRecorder) GetHealthCheck() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthCheck", reflect.TypeOf((*MockdockerfileParser)(nil).GetHealthCheck))
+}
+
+// MockstatusDescriber is a mock of statusDescriber interface.
+type MockstatusDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstatusDescriberMockRecorder
+}
+
+// MockstatusDescriberMockRecorder is the mock recorder for MockstatusDescriber.
+type MockstatusDescriberMockRecorder struct {
+	mock *MockstatusDescriber
+}
+
+// NewMockstatusDescriber creates a new mock instance.
+func NewMockstatusDescriber(ctrl *gomock.Controller) *MockstatusDescriber {
+	mock := &MockstatusDescriber{ctrl: ctrl}
+	mock.recorder = &MockstatusDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstatusDescriber) EXPECT() *MockstatusDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstatusDescriber) Describe() (describe.HumanJSONStringer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(describe.HumanJSONStringer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
+}
+
+// MocktrafficMirrorComparer is a mock of trafficMirrorComparer interface.
+type MocktrafficMirrorComparer struct {
+	ctrl     *gomock.Controller
+	recorder *MocktrafficMirrorComparerMockRecorder
+}
+
+// MocktrafficMirrorComparerMockRecorder is the mock recorder for MocktrafficMirrorComparer.
+type MocktrafficMirrorComparerMockRecorder struct {
+	mock *MocktrafficMirrorComparer
+}
+
+// NewMocktrafficMirrorComparer creates a new mock instance.
+func NewMocktrafficMirrorComparer(ctrl *gomock.Controller) *MocktrafficMirrorComparer {
+	mock := &MocktrafficMirrorComparer{ctrl: ctrl}
+	mock.recorder = &MocktrafficMirrorComparerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktrafficMirrorComparer) EXPECT() *MocktrafficMirrorComparerMockRecorder {
+	return m.recorder
+}
+
+// Compare mocks base method.
+func (m *MocktrafficMirrorComparer) Compare(since time.Duration) (*describe.TrafficMirrorComparison, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compare", since)
+	ret0, _ := ret[0].(*describe.TrafficMirrorComparison)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Compare indicates an expected call of Compare.
+func (mr *MocktrafficMirrorComparerMockRecorder) Compare(since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compare", reflect.TypeOf((*MocktrafficMirrorComparer)(nil).Compare), since)
+}
+
+// MockscalingSimulator is a mock of scalingSimulator interface.
+type MockscalingSimulator struct {
+	ctrl     *gomock.Controller
+	recorder *MockscalingSimulatorMockRecorder
+}
+
+// MockscalingSimulatorMockRecorder is the mock recorder for MockscalingSimulator.
+type MockscalingSimulatorMockRecorder struct {
+	mock *MockscalingSimulator
+}
+
+// NewMockscalingSimulator creates a new mock instance.
+func NewMockscalingSimulator(ctrl *gomock.Controller) *MockscalingSimulator {
+	mock := &MockscalingSimulator{ctrl: ctrl}
+	mock.recorder = &MockscalingSimulatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscalingSimulator) EXPECT() *MockscalingSimulatorMockRecorder {
+	return m.recorder
+}
+
+// Simulate mocks base method.
+func (m *MockscalingSimulator) Simulate(cfg describe.ScalingConfig, since time.Duration) (*describe.ScalingSimulation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Simulate", cfg, since)
+	ret0, _ := ret[0].(*describe.ScalingSimulation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Simulate indicates an expected call of Simulate.
+func (mr *MockscalingSimulatorMockRecorder) Simulate(cfg, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Simulate", reflect.TypeOf((*MockscalingSimulator)(nil).Simulate), cfg, since)
+}
+
+// MockexecChecker is a mock of execChecker interface.
+type MockexecChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecCheckerMockRecorder
+}
+
+// MockexecCheckerMockRecorder is the mock recorder for MockexecChecker.
+type MockexecCheckerMockRecorder struct {
+	mock *MockexecChecker
+}
+
+// NewMockexecChecker creates a new mock instance.
+func NewMockexecChecker(ctrl *gomock.Controller) *MockexecChecker {
+	mock := &MockexecChecker{ctrl: ctrl}
+	mock.recorder = &MockexecCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexecChecker) EXPECT() *MockexecCheckerMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockexecChecker) Check() (*describe.ExecDiagnosis, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check")
+	ret0, _ := ret[0].(*describe.ExecDiagnosis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockexecCheckerMockRecorder) Check() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockexecChecker)(nil).Check))
+}
+
+// MockenvDescriber is a mock of envDescriber interface.
+type MockenvDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvDescriberMockRecorder
+}
+
+// MockenvDescriberMockRecorder is the mock recorder for MockenvDescriber.
+type MockenvDescriberMockRecorder struct {
+	mock *MockenvDescriber
+}
+
+// NewMockenvDescriber creates a new mock instance.
+func NewMockenvDescriber(ctrl *gomock.Controller) *MockenvDescriber {
+	mock := &MockenvDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvDescriber) EXPECT() *MockenvDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockenvDescriber) Describe() (*describe.EnvDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.EnvDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
+}
+
+// Manifest mocks base method.
+func (m *MockenvDescriber) Manifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Manifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Manifest indicates an expected call of Manifest.
+func (mr *MockenvDescriberMockRecorder) Manifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockenvDescriber)(nil).Manifest))
+}
+
+// PublicCIDRBlocks mocks base method.
+func (m *MockenvDescriber) PublicCIDRBlocks() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicCIDRBlocks")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicCIDRBlocks indicates an expected call of PublicCIDRBlocks.
+func (mr *MockenvDescriberMockRecorder) PublicCIDRBlocks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicCIDRBlocks", reflect.TypeOf((*MockenvDescriber)(nil).PublicCIDRBlocks))
+}
+
+// ValidateCFServiceDomainAliases mocks base method.
+func (m *MockenvDescriber) ValidateCFServiceDomainAliases() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCFServiceDomainAliases")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateCFServiceDomainAliases indicates an expected call of ValidateCFServiceDomainAliases.
+func (mr *MockenvDescriberMockRecorder) ValidateCFServiceDomainAliases() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCFServiceDomainAliases", reflect.TypeOf((*MockenvDescriber)(nil).ValidateCFServiceDomainAliases))
+}
+
+// MockenvCertificatesDescriber is a mock of envCertificatesDescriber interface.
+type MockenvCertificatesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvCertificatesDescriberMockRecorder
+}
+
+// MockenvCertificatesDescriberMockRecorder is the mock recorder for MockenvCertificatesDescriber.
+type MockenvCertificatesDescriberMockRecorder struct {
+	mock *MockenvCertificatesDescriber
+}
+
+// NewMockenvCertificatesDescriber creates a new mock instance.
+func NewMockenvCertificatesDescriber(ctrl *gomock.Controller) *MockenvCertificatesDescriber {
+	mock := &MockenvCertificatesDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvCertificatesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvCertificatesDescriber) EXPECT() *MockenvCertificatesDescriberMockRecorder {
+	return m.recorder
+}
+
+// Certificates mocks base method.
+func (m *MockenvCertificatesDescriber) Certificates() (*describe.EnvCertificates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Certificates")
+	ret0, _ := ret[0].(*describe.EnvCertificates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Certificates indicates an expected call of Certificates.
+func (mr *MockenvCertificatesDescriberMockRecorder) Certificates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Certificates", reflect.TypeOf((*MockenvCertificatesDescriber)(nil).Certificates))
+}
+
+// MockenvCapacityDescriber is a mock of envCapacityDescriber interface.
+type MockenvCapacityDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvCapacityDescriberMockRecorder
+}
+
+// MockenvCapacityDescriberMockRecorder is the mock recorder for MockenvCapacityDescriber.
+type MockenvCapacityDescriberMockRecorder struct {
+	mock *MockenvCapacityDescriber
+}
+
+// NewMockenvCapacityDescriber creates a new mock instance.
+func NewMockenvCapacityDescriber(ctrl *gomock.Controller) *MockenvCapacityDescriber {
+	mock := &MockenvCapacityDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvCapacityDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvCapacityDescriber) EXPECT() *MockenvCapacityDescriberMockRecorder {
+	return m.recorder
+}
+
+// Capacity mocks base method.
+func (m *MockenvCapacityDescriber) Capacity() (*describe.EnvCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capacity")
+	ret0, _ := ret[0].(*describe.EnvCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capacity indicates an expected call of Capacity.
+func (mr *MockenvCapacityDescriberMockRecorder) Capacity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capacity", reflect.TypeOf((*MockenvCapacityDescriber)(nil).Capacity))
+}
+
+// MockversionCompatibilityChecker is a mock of versionCompatibilityChecker interface.
+type MockversionCompatibilityChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockversionCompatibilityCheckerMockRecorder
+}
+
+// MockversionCompatibilityCheckerMockRecorder is the mock recorder for MockversionCompatibilityChecker.
+type MockversionCompatibilityCheckerMockRecorder struct {
+	mock *MockversionCompatibilityChecker
+}
+
+// NewMockversionCompatibilityChecker creates a new mock instance.
+func NewMockversionCompatibilityChecker(ctrl *gomock.Controller) *MockversionCompatibilityChecker {
+	mock := &MockversionCompatibilityChecker{ctrl: ctrl}
+	mock.recorder = &MockversionCompatibilityCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockversionCompatibilityChecker) EXPECT() *MockversionCompatibilityCheckerMockRecorder {
+	return m.recorder
+}
+
+// AvailableFeatures mocks base method.
+func (m *MockversionCompatibilityChecker) AvailableFeatures() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailableFeatures")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AvailableFeatures indicates an expected call of AvailableFeatures.
+func (mr *MockversionCompatibilityCheckerMockRecorder) AvailableFeatures() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableFeatures", reflect.TypeOf((*MockversionCompatibilityChecker)(nil).AvailableFeatures))
+}
+
+// Version mocks base method.
+func (m *MockversionCompatibilityChecker) Version() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Version")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Version indicates an expected call of Version.
+func (mr *MockversionCompatibilityCheckerMockRecorder) Version() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionCompatibilityChecker)(nil).Version))
+}
+
+// MockversionGetter is a mock of versionGetter interface.
+type MockversionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockversionGetterMockRecorder
+}
+
+// MockversionGetterMockRecorder is the mock recorder for MockversionGetter.
+type MockversionGetterMockRecorder struct {
+	mock *MockversionGetter
+}
+
+// NewMockversionGetter creates a new mock instance.
+func NewMockversionGetter(ctrl *gomock.Controller) *MockversionGetter {
+	mock := &MockversionGetter{ctrl: ctrl}
+	mock.recorder = &MockversionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockversionGetter) EXPECT() *MockversionGetterMockRecorder {
+	return m.recorder
+}
+
+// Version mocks base method.
+func (m *MockversionGetter) Version() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Version")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Version indicates an expected call of Version.
+func (mr *MockversionGetterMockRecorder) Version() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionGetter)(nil).Version))
+}
+
+// MockappUpgrader is a mock of appUpgrader interface.
+type MockappUpgrader struct {
+	ctrl     *gomock.Controller
+	recorder *MockappUpgraderMockRecorder
+}
+
+// MockappUpgraderMockRecorder is the mock recorder for MockappUpgrader.
+type MockappUpgraderMockRecorder struct {
+	mock *MockappUpgrader
+}
+
+// NewMockappUpgrader creates a new mock instance.
+func NewMockappUpgrader(ctrl *gomock.Controller) *MockappUpgrader {
+	mock := &MockappUpgrader{ctrl: ctrl}
+	mock.recorder = &MockappUpgraderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappUpgrader) EXPECT() *MockappUpgraderMockRecorder {
+	return m.recorder
+}
+
+// AppStackSetInstances mocks base method.
+func (m *MockappUpgrader) AppStackSetInstances(appName string) ([]stackset.InstanceSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppStackSetInstances", appName)
+	ret0, _ := ret[0].([]stackset.InstanceSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppStackSetInstances indicates an expected call of AppStackSetInstances.
+func (mr *MockappUpgraderMockRecorder) AppStackSetInstances(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppStackSetInstances", reflect.TypeOf((*MockappUpgrader)(nil).AppStackSetInstances), appName)
+}
+
+// DetectAppStackSetDrift mocks base method.
+func (m *MockappUpgrader) DetectAppStackSetDrift(appName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectAppStackSetDrift", appName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetectAppStackSetDrift indicates an expected call of DetectAppStackSetDrift.
+func (mr *MockappUpgraderMockRecorder) DetectAppStackSetDrift(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectAppStackSetDrift", reflect.TypeOf((*MockappUpgrader)(nil).DetectAppStackSetDrift), appName)
+}
+
+// RetryFailedAppStackSetInstances mocks base method.
+func (m *MockappUpgrader) RetryFailedAppStackSetInstances(appName string) ([]stackset.InstanceSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryFailedAppStackSetInstances", appName)
+	ret0, _ := ret[0].([]stackset.InstanceSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryFailedAppStackSetInstances indicates an expected call of RetryFailedAppStackSetInstances.
+func (mr *MockappUpgraderMockRecorder) RetryFailedAppStackSetInstances(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryFailedAppStackSetInstances", reflect.TypeOf((*MockappUpgrader)(nil).RetryFailedAppStackSetInstances), appName)
+}
+
+// UpgradeApplication mocks base method.
+func (m *MockappUpgrader) UpgradeApplication(in *deploy0.CreateAppInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeApplication", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpgradeApplication indicates an expected call of UpgradeApplication.
+func (mr *MockappUpgraderMockRecorder) UpgradeApplication(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeApplication", reflect.TypeOf((*MockappUpgrader)(nil).UpgradeApplication), in)
+}
+
+// MockcustomResourcesUpgrader is a mock of customResourcesUpgrader interface.
+type MockcustomResourcesUpgrader struct {
+	ctrl     *gomock.Controller
+	recorder *MockcustomResourcesUpgraderMockRecorder
+}
+
+// MockcustomResourcesUpgraderMockRecorder is the mock recorder for MockcustomResourcesUpgrader.
+type MockcustomResourcesUpgraderMockRecorder struct {
+	mock *MockcustomResourcesUpgrader
+}
+
+// NewMockcustomResourcesUpgrader creates a new mock instance.
+func NewMockcustomResourcesUpgrader(ctrl *gomock.Controller) *MockcustomResourcesUpgrader {
+	mock := &MockcustomResourcesUpgrader{ctrl: ctrl}
+	mock.recorder = &MockcustomResourcesUpgraderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcustomResourcesUpgrader) EXPECT() *MockcustomResourcesUpgraderMockRecorder {
+	return m.recorder
+}
+
+// UpgradeCustomResourceRuntimes mocks base method.
+func (m *MockcustomResourcesUpgrader) UpgradeCustomResourceRuntimes() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeCustomResourceRuntimes")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeCustomResourceRuntimes indicates an expected call of UpgradeCustomResourceRuntimes.
+func (mr *MockcustomResourcesUpgraderMockRecorder) UpgradeCustomResourceRuntimes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeCustomResourceRuntimes", reflect.TypeOf((*MockcustomResourcesUpgrader)(nil).UpgradeCustomResourceRuntimes))
+}
+
+// MockpipelineGetter is a mock of pipelineGetter interface.
+type MockpipelineGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineGetterMockRecorder
+}
+
+// MockpipelineGetterMockRecorder is the mock recorder for MockpipelineGetter.
+type MockpipelineGetterMockRecorder struct {
+	mock *MockpipelineGetter
+}
+
+// NewMockpipelineGetter creates a new mock instance.
+func NewMockpipelineGetter(ctrl *gomock.Controller) *MockpipelineGetter {
+	mock := &MockpipelineGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineGetter) EXPECT() *MockpipelineGetterMockRecorder {
+	return m.recorder
+}
+
+// GetPipeline mocks base method.
+func (m *MockpipelineGetter) GetPipeline(pipelineName string) (*codepipeline.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipeline", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipeline indicates an expected call of GetPipeline.
+func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
+}
+
+// MockstageDeploymentsGetter is a mock of stageDeploymentsGetter interface.
+type MockstageDeploymentsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstageDeploymentsGetterMockRecorder
+}
+
+// MockstageDeploymentsGetterMockRecorder is the mock recorder for MockstageDeploymentsGetter.
+type MockstageDeploymentsGetterMockRecorder struct {
+	mock *MockstageDeploymentsGetter
+}
+
+// NewMockstageDeploymentsGetter creates a new mock instance.
+func NewMockstageDeploymentsGetter(ctrl *gomock.Controller) *MockstageDeploymentsGetter {
+	mock := &MockstageDeploymentsGetter{ctrl: ctrl}
+	mock.recorder = &MockstageDeploymentsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstageDeploymentsGetter) EXPECT() *MockstageDeploymentsGetterMockRecorder {
+	return m.recorder
+}
+
+// SourceRevision mocks base method.
+func (m *MockstageDeploymentsGetter) SourceRevision(pipelineName, executionID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourceRevision", pipelineName, executionID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SourceRevision indicates an expected call of SourceRevision.
+func (mr *MockstageDeploymentsGetterMockRecorder) SourceRevision(pipelineName, executionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourceRevision", reflect.TypeOf((*MockstageDeploymentsGetter)(nil).SourceRevision), pipelineName, executionID)
+}
+
+// StageDeployments mocks base method.
+func (m *MockstageDeploymentsGetter) StageDeployments(pipelineName string, stageNames []string, limit int) (map[string][]codepipeline.StageDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StageDeployments", pipelineName, stageNames, limit)
+	ret0, _ := ret[0].(map[string][]codepipeline.StageDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StageDeployments indicates an expected call of StageDeployments.
+func (mr *MockstageDeploymentsGetterMockRecorder) StageDeployments(pipelineName, stageNames, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StageDeployments", reflect.TypeOf((*MockstageDeploymentsGetter)(nil).StageDeployments), pipelineName, stageNames, limit)
+}
+
+// MockartifactDownloader is a mock of artifactDownloader interface.
+type MockartifactDownloader struct {
+	ctrl     *gomock.Controller
+	recorder *MockartifactDownloaderMockRecorder
+}
+
+// MockartifactDownloaderMockRecorder is the mock recorder for MockartifactDownloader.
+type MockartifactDownloaderMockRecorder struct {
+	mock *MockartifactDownloader
+}
+
+// NewMockartifactDownloader creates a new mock instance.
+func NewMockartifactDownloader(ctrl *gomock.Controller) *MockartifactDownloader {
+	mock := &MockartifactDownloader{ctrl: ctrl}
+	mock.recorder = &MockartifactDownloaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockartifactDownloader) EXPECT() *MockartifactDownloaderMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockartifactDownloader) Download(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockartifactDownloaderMockRecorder) Download(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockartifactDownloader)(nil).Download), bucket, key)
+}
+
+// MockstackConfigDeployer is a mock of stackConfigDeployer interface.
+type MockstackConfigDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackConfigDeployerMockRecorder
+}
+
+// MockstackConfigDeployerMockRecorder is the mock recorder for MockstackConfigDeployer.
+type MockstackConfigDeployerMockRecorder struct {
+	mock *MockstackConfigDeployer
+}
+
+// NewMockstackConfigDeployer creates a new mock instance.
+func NewMockstackConfigDeployer(ctrl *gomock.Controller) *MockstackConfigDeployer {
+	mock := &MockstackConfigDeployer{ctrl: ctrl}
+	mock.recorder = &MockstackConfigDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackConfigDeployer) EXPECT() *MockstackConfigDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployService mocks base method.
+func (m *MockstackConfigDeployer) DeployService(conf cloudformation1.StackConfiguration, bucketName string, detach bool, opts ...cloudformation0.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf, bucketName, detach}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployService", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployService indicates an expected call of DeployService.
+func (mr *MockstackConfigDeployerMockRecorder) DeployService(conf, bucketName, detach interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf, bucketName, detach}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockstackConfigDeployer)(nil).DeployService), varargs...)
+}
+
+// MockalarmStatusGetter is a mock of alarmStatusGetter interface.
+type MockalarmStatusGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockalarmStatusGetterMockRecorder
+}
+
+// MockalarmStatusGetterMockRecorder is the mock recorder for MockalarmStatusGetter.
+type MockalarmStatusGetterMockRecorder struct {
+	mock *MockalarmStatusGetter
+}
+
+// NewMockalarmStatusGetter creates a new mock instance.
+func NewMockalarmStatusGetter(ctrl *gomock.Controller) *MockalarmStatusGetter {
+	mock := &MockalarmStatusGetter{ctrl: ctrl}
+	mock.recorder = &MockalarmStatusGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockalarmStatusGetter) EXPECT() *MockalarmStatusGetterMockRecorder {
+	return m.recorder
+}
+
+// AlarmStatuses mocks base method.
+func (m *MockalarmStatusGetter) AlarmStatuses(opts ...cloudwatch.DescribeAlarmOpts) ([]cloudwatch.AlarmStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AlarmStatuses", varargs...)
+	ret0, _ := ret[0].([]cloudwatch.AlarmStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlarmStatuses indicates an expected call of AlarmStatuses.
+func (mr *MockalarmStatusGetterMockRecorder) AlarmStatuses(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmStatuses", reflect.TypeOf((*MockalarmStatusGetter)(nil).AlarmStatuses), opts...)
+}
+
+// AlarmsWithTags mocks base method.
+func (m *MockalarmStatusGetter) AlarmsWithTags(tags map[string]string) ([]cloudwatch.AlarmStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlarmsWithTags", tags)
+	ret0, _ := ret[0].([]cloudwatch.AlarmStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlarmsWithTags indicates an expected call of AlarmsWithTags.
+func (mr *MockalarmStatusGetterMockRecorder) AlarmsWithTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmsWithTags", reflect.TypeOf((*MockalarmStatusGetter)(nil).AlarmsWithTags), tags)
+}
+
+// MockworkloadStackRollbacker is a mock of workloadStackRollbacker interface.
+type MockworkloadStackRollbacker struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadStackRollbackerMockRecorder
+}
+
+// MockworkloadStackRollbackerMockRecorder is the mock recorder for MockworkloadStackRollbacker.
+type MockworkloadStackRollbackerMockRecorder struct {
+	mock *MockworkloadStackRollbacker
+}
+
+// NewMockworkloadStackRollbacker creates a new mock instance.
+func NewMockworkloadStackRollbacker(ctrl *gomock.Controller) *MockworkloadStackRollbacker {
+	mock := &MockworkloadStackRollbacker{ctrl: ctrl}
+	mock.recorder = &MockworkloadStackRollbackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadStackRollbacker) EXPECT() *MockworkloadStackRollbackerMockRecorder {
+	return m.recorder
+}
+
+// DeployService mocks base method.
+func (m *MockworkloadStackRollbacker) DeployService(conf cloudformation1.StackConfiguration, bucketName string, detach bool, opts ...cloudformation0.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf, bucketName, detach}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployService", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployService indicates an expected call of DeployService.
+func (mr *MockworkloadStackRollbackerMockRecorder) DeployService(conf, bucketName, detach interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf, bucketName, detach}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockworkloadStackRollbacker)(nil).DeployService), varargs...)
+}
+
+// GetAppResourcesByRegion mocks base method.
+func (m *MockworkloadStackRollbacker) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
+	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion.
+func (mr *MockworkloadStackRollbackerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockworkloadStackRollbacker)(nil).GetAppResourcesByRegion), app, region)
+}
+
+// StackParameters mocks base method.
+func (m *MockworkloadStackRollbacker) StackParameters(stackName string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackParameters", stackName)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackParameters indicates an expected call of StackParameters.
+func (mr *MockworkloadStackRollbackerMockRecorder) StackParameters(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackParameters", reflect.TypeOf((*MockworkloadStackRollbacker)(nil).StackParameters), stackName)
+}
+
+// Template mocks base method.
+func (m *MockworkloadStackRollbacker) Template(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockworkloadStackRollbackerMockRecorder) Template(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockworkloadStackRollbacker)(nil).Template), stackName)
+}
+
+// MockdeployedPipelineLister is a mock of deployedPipelineLister interface.
+type MockdeployedPipelineLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedPipelineListerMockRecorder
+}
+
+// MockdeployedPipelineListerMockRecorder is the mock recorder for MockdeployedPipelineLister.
+type MockdeployedPipelineListerMockRecorder struct {
+	mock *MockdeployedPipelineLister
+}
+
+// NewMockdeployedPipelineLister creates a new mock instance.
+func NewMockdeployedPipelineLister(ctrl *gomock.Controller) *MockdeployedPipelineLister {
+	mock := &MockdeployedPipelineLister{ctrl: ctrl}
+	mock.recorder = &MockdeployedPipelineListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedPipelineLister) EXPECT() *MockdeployedPipelineListerMockRecorder {
+	return m.recorder
+}
+
+// ListDeployedPipelines mocks base method.
+func (m *MockdeployedPipelineLister) ListDeployedPipelines(appName string) ([]deploy0.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedPipelines", appName)
+	ret0, _ := ret[0].([]deploy0.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedPipelines indicates an expected call of ListDeployedPipelines.
+func (mr *MockdeployedPipelineListerMockRecorder) ListDeployedPipelines(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedPipelines", reflect.TypeOf((*MockdeployedPipelineLister)(nil).ListDeployedPipelines), appName)
+}
+
+// Mockexecutor is a mock of executor interface.
+type Mockexecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecutorMockRecorder
+}
+
+// MockexecutorMockRecorder is the mock recorder for Mockexecutor.
+type MockexecutorMockRecorder struct {
+	mock *Mockexecutor
+}
+
+// NewMockexecutor creates a new mock instance.
+func NewMockexecutor(ctrl *gomock.Controller) *Mockexecutor {
+	mock := &Mockexecutor{ctrl: ctrl}
+	mock.recorder = &MockexecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockexecutor) EXPECT() *MockexecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *Mockexecutor) Execute() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockexecutorMockRecorder) Execute() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*Mockexecutor)(nil).Execute))
+}
+
+// MockexecuteAsker is a mock of executeAsker interface.
+type MockexecuteAsker struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecuteAskerMockRecorder
+}
+
+// MockexecuteAskerMockRecorder is the mock recorder for MockexecuteAsker.
+type MockexecuteAskerMockRecorder struct {
+	mock *MockexecuteAsker
+}
+
+// NewMockexecuteAsker creates a new mock instance.
+func NewMockexecuteAsker(ctrl *gomock.Controller) *MockexecuteAsker {
+	mock := &MockexecuteAsker{ctrl: ctrl}
+	mock.recorder = &MockexecuteAskerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexecuteAsker) EXPECT() *MockexecuteAskerMockRecorder {
+	return m.recorder
+}
+
+// Ask mocks base method.
+func (m *MockexecuteAsker) Ask() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ask")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ask indicates an expected call of Ask.
+func (mr *MockexecuteAskerMockRecorder) Ask() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ask", reflect.TypeOf((*MockexecuteAsker)(nil).Ask))
+}
+
+// Execute mocks base method.
+func (m *MockexecuteAsker) Execute() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockexecuteAskerMockRecorder) Execute() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockexecuteAsker)(nil).Execute))
+}
+
+// MockappSelector is a mock of appSelector interface.
+type MockappSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockappSelectorMockRecorder
+}
+
+// MockappSelectorMockRecorder is the mock recorder for MockappSelector.
+type MockappSelectorMockRecorder struct {
+	mock *MockappSelector
+}
+
+// NewMockappSelector creates a new mock instance.
+func NewMockappSelector(ctrl *gomock.Controller) *MockappSelector {
+	mock := &MockappSelector{ctrl: ctrl}
+	mock.recorder = &MockappSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappSelector) EXPECT() *MockappSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockappSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockappSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappSelector)(nil).Application), varargs...)
+}
+
+// MockappEnvSelector is a mock of appEnvSelector interface.
+type MockappEnvSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockappEnvSelectorMockRecorder
+}
+
+// MockappEnvSelectorMockRecorder is the mock recorder for MockappEnvSelector.
+type MockappEnvSelectorMockRecorder struct {
+	mock *MockappEnvSelector
+}
+
+// NewMockappEnvSelector creates a new mock instance.
+func NewMockappEnvSelector(ctrl *gomock.Controller) *MockappEnvSelector {
+	mock := &MockappEnvSelector{ctrl: ctrl}
+	mock.recorder = &MockappEnvSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappEnvSelector) EXPECT() *MockappEnvSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockappEnvSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockappEnvSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappEnvSelector)(nil).Application), varargs...)
+}
+
+// Environment mocks base method.
+func (m *MockappEnvSelector) Environment(prompt, help, app string, additionalOpts ...prompt.Option) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Environment", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environment indicates an expected call of Environment.
+func (mr *MockappEnvSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockappEnvSelector)(nil).Environment), varargs...)
+}
+
+// MockcfnSelector is a mock of cfnSelector interface.
+type MockcfnSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockcfnSelectorMockRecorder
+}
+
+// MockcfnSelectorMockRecorder is the mock recorder for MockcfnSelector.
+type MockcfnSelectorMockRecorder struct {
+	mock *MockcfnSelector
+}
+
+// NewMockcfnSelector creates a new mock instance.
+func NewMockcfnSelector(ctrl *gomock.Controller) *MockcfnSelector {
+	mock := &MockcfnSelector{ctrl: ctrl}
+	mock.recorder = &MockcfnSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcfnSelector) EXPECT() *MockcfnSelectorMockRecorder {
+	return m.recorder
+}
+
+// Resources mocks base method.
+func (m *MockcfnSelector) Resources(msg, finalMsg, help, body string) ([]template.CFNResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources", msg, finalMsg, help, body)
+	ret0, _ := ret[0].([]template.CFNResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockcfnSelectorMockRecorder) Resources(msg, finalMsg, help, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockcfnSelector)(nil).Resources), msg, finalMsg, help, body)
+}
+
+// MockconfigSelector is a mock of configSelector interface.
+type MockconfigSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockconfigSelectorMockRecorder
+}
+
+// MockconfigSelectorMockRecorder is the mock recorder for MockconfigSelector.
+type MockconfigSelectorMockRecorder struct {
+	mock *MockconfigSelector
+}
+
+// NewMockconfigSelector creates a new mock instance.
+func NewMockconfigSelector(ctrl *gomock.Controller) *MockconfigSelector {
+	mock := &MockconfigSelector{ctrl: ctrl}
+	mock.recorder = &MockconfigSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockconfigSelector) EXPECT() *MockconfigSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockconfigSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockconfigSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockconfigSelector)(nil).Application), varargs...)
+}
+
+// Environment mocks base method.
+func (m *MockconfigSelector) Environment(prompt, help, app string, additionalOpts ...prompt.Option) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Environment", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environment indicates an expected call of Environment.
+func (mr *MockconfigSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockconfigSelector)(nil).Environment), varargs...)
+}
+
+// Job mocks base method.
+func (m *MockconfigSelector) Job(prompt, help, app string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", prompt, help, app)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job.
+func (mr *MockconfigSelectorMockRecorder) Job(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockconfigSelector)(nil).Job), prompt, help, app)
+}
+
+// Service mocks base method.
+func (m *MockconfigSelector) Service(prompt, help, app string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", prompt, help, app)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockconfigSelectorMockRecorder) Service(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockconfigSelector)(nil).Service), prompt, help, app)
+}
+
+// Workload mocks base method.
+func (m *MockconfigSelector) Workload(prompt, help, app string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workload", prompt, help, app)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Workload indicates an expected call of Workload.
+func (mr *MockconfigSelectorMockRecorder) Workload(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockconfigSelector)(nil).Workload), prompt, help, app)
+}
+
+// MockdeploySelector is a mock of deploySelector interface.
+type MockdeploySelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploySelectorMockRecorder
+}
+
+// MockdeploySelectorMockRecorder is the mock recorder for MockdeploySelector.
+type MockdeploySelectorMockRecorder struct {
+	mock *MockdeploySelector
+}
+
+// NewMockdeploySelector creates a new mock instance.
+func NewMockdeploySelector(ctrl *gomock.Controller) *MockdeploySelector {
+	mock := &MockdeploySelector{ctrl: ctrl}
+	mock.recorder = &MockdeploySelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploySelector) EXPECT() *MockdeploySelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockdeploySelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockdeploySelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeploySelector)(nil).Application), varargs...)
+}
+
+// DeployedJob mocks base method.
+func (m *MockdeploySelector) DeployedJob(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployedJob", varargs...)
+	ret0, _ := ret[0].(*selector.DeployedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedJob indicates an expected call of DeployedJob.
+func (mr *MockdeploySelectorMockRecorder) DeployedJob(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedJob", reflect.TypeOf((*MockdeploySelector)(nil).DeployedJob), varargs...)
+}
+
+// DeployedService mocks base method.
+func (m *MockdeploySelector) DeployedService(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedService, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployedService", varargs...)
+	ret0, _ := ret[0].(*selector.DeployedService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedService indicates an expected call of DeployedService.
+func (mr *MockdeploySelectorMockRecorder) DeployedService(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedService", reflect.TypeOf((*MockdeploySelector)(nil).DeployedService), varargs...)
+}
+
+// DeployedWorkload mocks base method.
+func (m *MockdeploySelector) DeployedWorkload(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedWorkload, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployedWorkload", varargs...)
+	ret0, _ := ret[0].(*selector.DeployedWorkload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedWorkload indicates an expected call of DeployedWorkload.
+func (mr *MockdeploySelectorMockRecorder) DeployedWorkload(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedWorkload", reflect.TypeOf((*MockdeploySelector)(nil).DeployedWorkload), varargs...)
+}
+
+// MockpipelineEnvSelector is a mock of pipelineEnvSelector interface.
+type MockpipelineEnvSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineEnvSelectorMockRecorder
+}
+
+// MockpipelineEnvSelectorMockRecorder is the mock recorder for MockpipelineEnvSelector.
+type MockpipelineEnvSelectorMockRecorder struct {
+	mock *MockpipelineEnvSelector
+}
+
+// NewMockpipelineEnvSelector creates a new mock instance.
 func NewMockpipelineEnvSelector(ctrl *gomock.Controller) *MockpipelineEnvSelector {
 	mock := &MockpipelineEnvSelector{ctrl: ctrl}
 	mock.recorder = &MockpipelineEnvSelectorMockRecorder{mock}
@@ -5920,1780 +7923,2940 @@ func NewMockpipelineEnvSelector(ctrl *gomock.Controller) *MockpipelineEnvSelecto
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpipelineEnvSelector) EXPECT() *MockpipelineEnvSelectorMockRecorder {
+func (m *MockpipelineEnvSelector) EXPECT() *MockpipelineEnvSelectorMockRecorder {
+	return m.recorder
+}
+
+// Environments mocks base method.
+func (m *MockpipelineEnvSelector) Environments(prompt, help, app string, finalMsgFunc func(int) prompt.PromptConfig) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Environments", prompt, help, app, finalMsgFunc)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environments indicates an expected call of Environments.
+func (mr *MockpipelineEnvSelectorMockRecorder) Environments(prompt, help, app, finalMsgFunc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environments", reflect.TypeOf((*MockpipelineEnvSelector)(nil).Environments), prompt, help, app, finalMsgFunc)
+}
+
+// MockwsPipelineSelector is a mock of wsPipelineSelector interface.
+type MockwsPipelineSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineSelectorMockRecorder
+}
+
+// MockwsPipelineSelectorMockRecorder is the mock recorder for MockwsPipelineSelector.
+type MockwsPipelineSelectorMockRecorder struct {
+	mock *MockwsPipelineSelector
+}
+
+// NewMockwsPipelineSelector creates a new mock instance.
+func NewMockwsPipelineSelector(ctrl *gomock.Controller) *MockwsPipelineSelector {
+	mock := &MockwsPipelineSelector{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPipelineSelector) EXPECT() *MockwsPipelineSelectorMockRecorder {
+	return m.recorder
+}
+
+// WsPipeline mocks base method.
+func (m *MockwsPipelineSelector) WsPipeline(prompt, help string) (*workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WsPipeline", prompt, help)
+	ret0, _ := ret[0].(*workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WsPipeline indicates an expected call of WsPipeline.
+func (mr *MockwsPipelineSelectorMockRecorder) WsPipeline(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WsPipeline", reflect.TypeOf((*MockwsPipelineSelector)(nil).WsPipeline), prompt, help)
+}
+
+// MockwsEnvironmentSelector is a mock of wsEnvironmentSelector interface.
+type MockwsEnvironmentSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvironmentSelectorMockRecorder
+}
+
+// MockwsEnvironmentSelectorMockRecorder is the mock recorder for MockwsEnvironmentSelector.
+type MockwsEnvironmentSelectorMockRecorder struct {
+	mock *MockwsEnvironmentSelector
+}
+
+// NewMockwsEnvironmentSelector creates a new mock instance.
+func NewMockwsEnvironmentSelector(ctrl *gomock.Controller) *MockwsEnvironmentSelector {
+	mock := &MockwsEnvironmentSelector{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsEnvironmentSelector) EXPECT() *MockwsEnvironmentSelectorMockRecorder {
+	return m.recorder
+}
+
+// LocalEnvironment mocks base method.
+func (m *MockwsEnvironmentSelector) LocalEnvironment(msg, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocalEnvironment", msg, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LocalEnvironment indicates an expected call of LocalEnvironment.
+func (mr *MockwsEnvironmentSelectorMockRecorder) LocalEnvironment(msg, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocalEnvironment", reflect.TypeOf((*MockwsEnvironmentSelector)(nil).LocalEnvironment), msg, help)
+}
+
+// MockcodePipelineSelector is a mock of codePipelineSelector interface.
+type MockcodePipelineSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockcodePipelineSelectorMockRecorder
+}
+
+// MockcodePipelineSelectorMockRecorder is the mock recorder for MockcodePipelineSelector.
+type MockcodePipelineSelectorMockRecorder struct {
+	mock *MockcodePipelineSelector
+}
+
+// NewMockcodePipelineSelector creates a new mock instance.
+func NewMockcodePipelineSelector(ctrl *gomock.Controller) *MockcodePipelineSelector {
+	mock := &MockcodePipelineSelector{ctrl: ctrl}
+	mock.recorder = &MockcodePipelineSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcodePipelineSelector) EXPECT() *MockcodePipelineSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockcodePipelineSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockcodePipelineSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockcodePipelineSelector)(nil).Application), varargs...)
+}
+
+// DeployedPipeline mocks base method.
+func (m *MockcodePipelineSelector) DeployedPipeline(prompt, help, app string) (deploy0.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployedPipeline", prompt, help, app)
+	ret0, _ := ret[0].(deploy0.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedPipeline indicates an expected call of DeployedPipeline.
+func (mr *MockcodePipelineSelectorMockRecorder) DeployedPipeline(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedPipeline", reflect.TypeOf((*MockcodePipelineSelector)(nil).DeployedPipeline), prompt, help, app)
+}
+
+// MockwsSelector is a mock of wsSelector interface.
+type MockwsSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsSelectorMockRecorder
+}
+
+// MockwsSelectorMockRecorder is the mock recorder for MockwsSelector.
+type MockwsSelectorMockRecorder struct {
+	mock *MockwsSelector
+}
+
+// NewMockwsSelector creates a new mock instance.
+func NewMockwsSelector(ctrl *gomock.Controller) *MockwsSelector {
+	mock := &MockwsSelector{ctrl: ctrl}
+	mock.recorder = &MockwsSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsSelector) EXPECT() *MockwsSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockwsSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockwsSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockwsSelector)(nil).Application), varargs...)
+}
+
+// Environment mocks base method.
+func (m *MockwsSelector) Environment(prompt, help, app string, additionalOpts ...prompt.Option) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Environment", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environment indicates an expected call of Environment.
+func (mr *MockwsSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockwsSelector)(nil).Environment), varargs...)
+}
+
+// Job mocks base method.
+func (m *MockwsSelector) Job(prompt, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", prompt, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job.
+func (mr *MockwsSelectorMockRecorder) Job(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockwsSelector)(nil).Job), prompt, help)
+}
+
+// Service mocks base method.
+func (m *MockwsSelector) Service(prompt, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", prompt, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockwsSelectorMockRecorder) Service(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockwsSelector)(nil).Service), prompt, help)
+}
+
+// Workload mocks base method.
+func (m *MockwsSelector) Workload(msg, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workload", msg, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Workload indicates an expected call of Workload.
+func (mr *MockwsSelectorMockRecorder) Workload(msg, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockwsSelector)(nil).Workload), msg, help)
+}
+
+// Workloads mocks base method.
+func (m *MockwsSelector) Workloads(msg, help string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workloads", msg, help)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Workloads indicates an expected call of Workloads.
+func (mr *MockwsSelectorMockRecorder) Workloads(msg, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workloads", reflect.TypeOf((*MockwsSelector)(nil).Workloads), msg, help)
+}
+
+// MockstaticSourceSelector is a mock of staticSourceSelector interface.
+type MockstaticSourceSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockstaticSourceSelectorMockRecorder
+}
+
+// MockstaticSourceSelectorMockRecorder is the mock recorder for MockstaticSourceSelector.
+type MockstaticSourceSelectorMockRecorder struct {
+	mock *MockstaticSourceSelector
+}
+
+// NewMockstaticSourceSelector creates a new mock instance.
+func NewMockstaticSourceSelector(ctrl *gomock.Controller) *MockstaticSourceSelector {
+	mock := &MockstaticSourceSelector{ctrl: ctrl}
+	mock.recorder = &MockstaticSourceSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstaticSourceSelector) EXPECT() *MockstaticSourceSelectorMockRecorder {
+	return m.recorder
+}
+
+// StaticSources mocks base method.
+func (m *MockstaticSourceSelector) StaticSources(selPrompt, selHelp, anotherPathPrompt, anotherPathHelp string, pathValidator prompt.ValidatorFunc) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StaticSources", selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StaticSources indicates an expected call of StaticSources.
+func (mr *MockstaticSourceSelectorMockRecorder) StaticSources(selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StaticSources", reflect.TypeOf((*MockstaticSourceSelector)(nil).StaticSources), selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator)
+}
+
+// MockscheduleSelector is a mock of scheduleSelector interface.
+type MockscheduleSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockscheduleSelectorMockRecorder
+}
+
+// MockscheduleSelectorMockRecorder is the mock recorder for MockscheduleSelector.
+type MockscheduleSelectorMockRecorder struct {
+	mock *MockscheduleSelector
+}
+
+// NewMockscheduleSelector creates a new mock instance.
+func NewMockscheduleSelector(ctrl *gomock.Controller) *MockscheduleSelector {
+	mock := &MockscheduleSelector{ctrl: ctrl}
+	mock.recorder = &MockscheduleSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscheduleSelector) EXPECT() *MockscheduleSelectorMockRecorder {
+	return m.recorder
+}
+
+// Schedule mocks base method.
+func (m *MockscheduleSelector) Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator prompt.ValidatorFunc) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockscheduleSelectorMockRecorder) Schedule(scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockscheduleSelector)(nil).Schedule), scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
+}
+
+// MockcfTaskSelector is a mock of cfTaskSelector interface.
+type MockcfTaskSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockcfTaskSelectorMockRecorder
+}
+
+// MockcfTaskSelectorMockRecorder is the mock recorder for MockcfTaskSelector.
+type MockcfTaskSelectorMockRecorder struct {
+	mock *MockcfTaskSelector
+}
+
+// NewMockcfTaskSelector creates a new mock instance.
+func NewMockcfTaskSelector(ctrl *gomock.Controller) *MockcfTaskSelector {
+	mock := &MockcfTaskSelector{ctrl: ctrl}
+	mock.recorder = &MockcfTaskSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcfTaskSelector) EXPECT() *MockcfTaskSelectorMockRecorder {
+	return m.recorder
+}
+
+// Task mocks base method.
+func (m *MockcfTaskSelector) Task(prompt, help string, opts ...selector.GetDeployedTaskOpts) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Task", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Task indicates an expected call of Task.
+func (mr *MockcfTaskSelectorMockRecorder) Task(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockcfTaskSelector)(nil).Task), varargs...)
+}
+
+// MockdockerfileSelector is a mock of dockerfileSelector interface.
+type MockdockerfileSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockdockerfileSelectorMockRecorder
+}
+
+// MockdockerfileSelectorMockRecorder is the mock recorder for MockdockerfileSelector.
+type MockdockerfileSelectorMockRecorder struct {
+	mock *MockdockerfileSelector
+}
+
+// NewMockdockerfileSelector creates a new mock instance.
+func NewMockdockerfileSelector(ctrl *gomock.Controller) *MockdockerfileSelector {
+	mock := &MockdockerfileSelector{ctrl: ctrl}
+	mock.recorder = &MockdockerfileSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdockerfileSelector) EXPECT() *MockdockerfileSelectorMockRecorder {
+	return m.recorder
+}
+
+// Dockerfile mocks base method.
+func (m *MockdockerfileSelector) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp string, pv prompt.ValidatorFunc) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dockerfile", selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dockerfile indicates an expected call of Dockerfile.
+func (mr *MockdockerfileSelectorMockRecorder) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dockerfile", reflect.TypeOf((*MockdockerfileSelector)(nil).Dockerfile), selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+}
+
+// MocktopicSelector is a mock of topicSelector interface.
+type MocktopicSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MocktopicSelectorMockRecorder
+}
+
+// MocktopicSelectorMockRecorder is the mock recorder for MocktopicSelector.
+type MocktopicSelectorMockRecorder struct {
+	mock *MocktopicSelector
+}
+
+// NewMocktopicSelector creates a new mock instance.
+func NewMocktopicSelector(ctrl *gomock.Controller) *MocktopicSelector {
+	mock := &MocktopicSelector{ctrl: ctrl}
+	mock.recorder = &MocktopicSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktopicSelector) EXPECT() *MocktopicSelectorMockRecorder {
+	return m.recorder
+}
+
+// Topics mocks base method.
+func (m *MocktopicSelector) Topics(prompt, help, app string) ([]deploy0.Topic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Topics", prompt, help, app)
+	ret0, _ := ret[0].([]deploy0.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Topics indicates an expected call of Topics.
+func (mr *MocktopicSelectorMockRecorder) Topics(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Topics", reflect.TypeOf((*MocktopicSelector)(nil).Topics), prompt, help, app)
+}
+
+// Mockec2Selector is a mock of ec2Selector interface.
+type Mockec2Selector struct {
+	ctrl     *gomock.Controller
+	recorder *Mockec2SelectorMockRecorder
+}
+
+// Mockec2SelectorMockRecorder is the mock recorder for Mockec2Selector.
+type Mockec2SelectorMockRecorder struct {
+	mock *Mockec2Selector
+}
+
+// NewMockec2Selector creates a new mock instance.
+func NewMockec2Selector(ctrl *gomock.Controller) *Mockec2Selector {
+	mock := &Mockec2Selector{ctrl: ctrl}
+	mock.recorder = &Mockec2SelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockec2Selector) EXPECT() *Mockec2SelectorMockRecorder {
+	return m.recorder
+}
+
+// Subnets mocks base method.
+func (m *Mockec2Selector) Subnets(input selector.SubnetsInput) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subnets", input)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subnets indicates an expected call of Subnets.
+func (mr *Mockec2SelectorMockRecorder) Subnets(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subnets", reflect.TypeOf((*Mockec2Selector)(nil).Subnets), input)
+}
+
+// VPC mocks base method.
+func (m *Mockec2Selector) VPC(prompt, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPC", prompt, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPC indicates an expected call of VPC.
+func (mr *Mockec2SelectorMockRecorder) VPC(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPC", reflect.TypeOf((*Mockec2Selector)(nil).VPC), prompt, help)
+}
+
+// MockcredsSelector is a mock of credsSelector interface.
+type MockcredsSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockcredsSelectorMockRecorder
+}
+
+// MockcredsSelectorMockRecorder is the mock recorder for MockcredsSelector.
+type MockcredsSelectorMockRecorder struct {
+	mock *MockcredsSelector
+}
+
+// NewMockcredsSelector creates a new mock instance.
+func NewMockcredsSelector(ctrl *gomock.Controller) *MockcredsSelector {
+	mock := &MockcredsSelector{ctrl: ctrl}
+	mock.recorder = &MockcredsSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcredsSelector) EXPECT() *MockcredsSelectorMockRecorder {
+	return m.recorder
+}
+
+// Creds mocks base method.
+func (m *MockcredsSelector) Creds(prompt, help string) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Creds", prompt, help)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Creds indicates an expected call of Creds.
+func (mr *MockcredsSelectorMockRecorder) Creds(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Creds", reflect.TypeOf((*MockcredsSelector)(nil).Creds), prompt, help)
+}
+
+// Mockec2Client is a mock of ec2Client interface.
+type Mockec2Client struct {
+	ctrl     *gomock.Controller
+	recorder *Mockec2ClientMockRecorder
+}
+
+// Mockec2ClientMockRecorder is the mock recorder for Mockec2Client.
+type Mockec2ClientMockRecorder struct {
+	mock *Mockec2Client
+}
+
+// NewMockec2Client creates a new mock instance.
+func NewMockec2Client(ctrl *gomock.Controller) *Mockec2Client {
+	mock := &Mockec2Client{ctrl: ctrl}
+	mock.recorder = &Mockec2ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockec2Client) EXPECT() *Mockec2ClientMockRecorder {
+	return m.recorder
+}
+
+// HasDNSSupport mocks base method.
+func (m *Mockec2Client) HasDNSSupport(vpcID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDNSSupport", vpcID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDNSSupport indicates an expected call of HasDNSSupport.
+func (mr *Mockec2ClientMockRecorder) HasDNSSupport(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDNSSupport", reflect.TypeOf((*Mockec2Client)(nil).HasDNSSupport), vpcID)
+}
+
+// ListAZs mocks base method.
+func (m *Mockec2Client) ListAZs() ([]ec2.AZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAZs")
+	ret0, _ := ret[0].([]ec2.AZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAZs indicates an expected call of ListAZs.
+func (mr *Mockec2ClientMockRecorder) ListAZs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAZs", reflect.TypeOf((*Mockec2Client)(nil).ListAZs))
+}
+
+// MocksubnetIPsCounter is a mock of subnetIPsCounter interface.
+type MocksubnetIPsCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksubnetIPsCounterMockRecorder
+}
+
+// MocksubnetIPsCounterMockRecorder is the mock recorder for MocksubnetIPsCounter.
+type MocksubnetIPsCounterMockRecorder struct {
+	mock *MocksubnetIPsCounter
+}
+
+// NewMocksubnetIPsCounter creates a new mock instance.
+func NewMocksubnetIPsCounter(ctrl *gomock.Controller) *MocksubnetIPsCounter {
+	mock := &MocksubnetIPsCounter{ctrl: ctrl}
+	mock.recorder = &MocksubnetIPsCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksubnetIPsCounter) EXPECT() *MocksubnetIPsCounterMockRecorder {
+	return m.recorder
+}
+
+// AvailableIPs mocks base method.
+func (m *MocksubnetIPsCounter) AvailableIPs(subnetIDs ...string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range subnetIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AvailableIPs", varargs...)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AvailableIPs indicates an expected call of AvailableIPs.
+func (mr *MocksubnetIPsCounterMockRecorder) AvailableIPs(subnetIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableIPs", reflect.TypeOf((*MocksubnetIPsCounter)(nil).AvailableIPs), subnetIDs...)
+}
+
+// MocklbTargetGroupsLister is a mock of lbTargetGroupsLister interface.
+type MocklbTargetGroupsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MocklbTargetGroupsListerMockRecorder
+}
+
+// MocklbTargetGroupsListerMockRecorder is the mock recorder for MocklbTargetGroupsLister.
+type MocklbTargetGroupsListerMockRecorder struct {
+	mock *MocklbTargetGroupsLister
+}
+
+// NewMocklbTargetGroupsLister creates a new mock instance.
+func NewMocklbTargetGroupsLister(ctrl *gomock.Controller) *MocklbTargetGroupsLister {
+	mock := &MocklbTargetGroupsLister{ctrl: ctrl}
+	mock.recorder = &MocklbTargetGroupsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklbTargetGroupsLister) EXPECT() *MocklbTargetGroupsListerMockRecorder {
+	return m.recorder
+}
+
+// LoadBalancer mocks base method.
+func (m *MocklbTargetGroupsLister) LoadBalancer(nameOrARN string) (*elbv2.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadBalancer", nameOrARN)
+	ret0, _ := ret[0].(*elbv2.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadBalancer indicates an expected call of LoadBalancer.
+func (mr *MocklbTargetGroupsListerMockRecorder) LoadBalancer(nameOrARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancer", reflect.TypeOf((*MocklbTargetGroupsLister)(nil).LoadBalancer), nameOrARN)
+}
+
+// TargetGroups mocks base method.
+func (m *MocklbTargetGroupsLister) TargetGroups(lbARN string) ([]elbv2.TargetGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetGroups", lbARN)
+	ret0, _ := ret[0].([]elbv2.TargetGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetGroups indicates an expected call of TargetGroups.
+func (mr *MocklbTargetGroupsListerMockRecorder) TargetGroups(lbARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetGroups", reflect.TypeOf((*MocklbTargetGroupsLister)(nil).TargetGroups), lbARN)
+}
+
+// MockenvOutputsGetter is a mock of envOutputsGetter interface.
+type MockenvOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvOutputsGetterMockRecorder
+}
+
+// MockenvOutputsGetterMockRecorder is the mock recorder for MockenvOutputsGetter.
+type MockenvOutputsGetterMockRecorder struct {
+	mock *MockenvOutputsGetter
+}
+
+// NewMockenvOutputsGetter creates a new mock instance.
+func NewMockenvOutputsGetter(ctrl *gomock.Controller) *MockenvOutputsGetter {
+	mock := &MockenvOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MockenvOutputsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvOutputsGetter) EXPECT() *MockenvOutputsGetterMockRecorder {
+	return m.recorder
+}
+
+// Outputs mocks base method.
+func (m *MockenvOutputsGetter) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockenvOutputsGetterMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockenvOutputsGetter)(nil).Outputs))
+}
+
+// MockinstanceTerminator is a mock of instanceTerminator interface.
+type MockinstanceTerminator struct {
+	ctrl     *gomock.Controller
+	recorder *MockinstanceTerminatorMockRecorder
+}
+
+// MockinstanceTerminatorMockRecorder is the mock recorder for MockinstanceTerminator.
+type MockinstanceTerminatorMockRecorder struct {
+	mock *MockinstanceTerminator
+}
+
+// NewMockinstanceTerminator creates a new mock instance.
+func NewMockinstanceTerminator(ctrl *gomock.Controller) *MockinstanceTerminator {
+	mock := &MockinstanceTerminator{ctrl: ctrl}
+	mock.recorder = &MockinstanceTerminatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinstanceTerminator) EXPECT() *MockinstanceTerminatorMockRecorder {
+	return m.recorder
+}
+
+// TerminateInstances mocks base method.
+func (m *MockinstanceTerminator) TerminateInstances(ids ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TerminateInstances", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TerminateInstances indicates an expected call of TerminateInstances.
+func (mr *MockinstanceTerminatorMockRecorder) TerminateInstances(ids ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateInstances", reflect.TypeOf((*MockinstanceTerminator)(nil).TerminateInstances), ids...)
+}
+
+// MockautoScalingGroupWaiter is a mock of autoScalingGroupWaiter interface.
+type MockautoScalingGroupWaiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockautoScalingGroupWaiterMockRecorder
+}
+
+// MockautoScalingGroupWaiterMockRecorder is the mock recorder for MockautoScalingGroupWaiter.
+type MockautoScalingGroupWaiterMockRecorder struct {
+	mock *MockautoScalingGroupWaiter
+}
+
+// NewMockautoScalingGroupWaiter creates a new mock instance.
+func NewMockautoScalingGroupWaiter(ctrl *gomock.Controller) *MockautoScalingGroupWaiter {
+	mock := &MockautoScalingGroupWaiter{ctrl: ctrl}
+	mock.recorder = &MockautoScalingGroupWaiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockautoScalingGroupWaiter) EXPECT() *MockautoScalingGroupWaiterMockRecorder {
+	return m.recorder
+}
+
+// GroupNames mocks base method.
+func (m *MockautoScalingGroupWaiter) GroupNames(instanceIDs []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupNames", instanceIDs)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupNames indicates an expected call of GroupNames.
+func (mr *MockautoScalingGroupWaiterMockRecorder) GroupNames(instanceIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupNames", reflect.TypeOf((*MockautoScalingGroupWaiter)(nil).GroupNames), instanceIDs)
+}
+
+// WaitUntilInService mocks base method.
+func (m *MockautoScalingGroupWaiter) WaitUntilInService(group string, excluded []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilInService", group, excluded)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilInService indicates an expected call of WaitUntilInService.
+func (mr *MockautoScalingGroupWaiterMockRecorder) WaitUntilInService(group, excluded interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilInService", reflect.TypeOf((*MockautoScalingGroupWaiter)(nil).WaitUntilInService), group, excluded)
+}
+
+// MockcontainerInstanceDrainer is a mock of containerInstanceDrainer interface.
+type MockcontainerInstanceDrainer struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontainerInstanceDrainerMockRecorder
+}
+
+// MockcontainerInstanceDrainerMockRecorder is the mock recorder for MockcontainerInstanceDrainer.
+type MockcontainerInstanceDrainerMockRecorder struct {
+	mock *MockcontainerInstanceDrainer
+}
+
+// NewMockcontainerInstanceDrainer creates a new mock instance.
+func NewMockcontainerInstanceDrainer(ctrl *gomock.Controller) *MockcontainerInstanceDrainer {
+	mock := &MockcontainerInstanceDrainer{ctrl: ctrl}
+	mock.recorder = &MockcontainerInstanceDrainerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontainerInstanceDrainer) EXPECT() *MockcontainerInstanceDrainerMockRecorder {
 	return m.recorder
 }
 
-// Environments mocks base method.
-func (m *MockpipelineEnvSelector) Environments(prompt, help, app string, finalMsgFunc func(int) prompt.PromptConfig) ([]string, error) {
+// ContainerInstances mocks base method.
+func (m *MockcontainerInstanceDrainer) ContainerInstances(cluster string) ([]*ecs.ContainerInstance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Environments", prompt, help, app, finalMsgFunc)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "ContainerInstances", cluster)
+	ret0, _ := ret[0].([]*ecs.ContainerInstance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Environments indicates an expected call of Environments.
-func (mr *MockpipelineEnvSelectorMockRecorder) Environments(prompt, help, app, finalMsgFunc interface{}) *gomock.Call {
+// ContainerInstances indicates an expected call of ContainerInstances.
+func (mr *MockcontainerInstanceDrainerMockRecorder) ContainerInstances(cluster interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environments", reflect.TypeOf((*MockpipelineEnvSelector)(nil).Environments), prompt, help, app, finalMsgFunc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerInstances", reflect.TypeOf((*MockcontainerInstanceDrainer)(nil).ContainerInstances), cluster)
 }
 
-// MockwsPipelineSelector is a mock of wsPipelineSelector interface.
-type MockwsPipelineSelector struct {
+// DrainContainerInstances mocks base method.
+func (m *MockcontainerInstanceDrainer) DrainContainerInstances(cluster string, arns []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrainContainerInstances", cluster, arns)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DrainContainerInstances indicates an expected call of DrainContainerInstances.
+func (mr *MockcontainerInstanceDrainerMockRecorder) DrainContainerInstances(cluster, arns interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainContainerInstances", reflect.TypeOf((*MockcontainerInstanceDrainer)(nil).DrainContainerInstances), cluster, arns)
+}
+
+// WaitUntilContainerInstancesDrained mocks base method.
+func (m *MockcontainerInstanceDrainer) WaitUntilContainerInstancesDrained(cluster string, arns []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilContainerInstancesDrained", cluster, arns)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilContainerInstancesDrained indicates an expected call of WaitUntilContainerInstancesDrained.
+func (mr *MockcontainerInstanceDrainerMockRecorder) WaitUntilContainerInstancesDrained(cluster, arns interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilContainerInstancesDrained", reflect.TypeOf((*MockcontainerInstanceDrainer)(nil).WaitUntilContainerInstancesDrained), cluster, arns)
+}
+
+// MockenvClusterGetter is a mock of envClusterGetter interface.
+type MockenvClusterGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsPipelineSelectorMockRecorder
+	recorder *MockenvClusterGetterMockRecorder
 }
 
-// MockwsPipelineSelectorMockRecorder is the mock recorder for MockwsPipelineSelector.
-type MockwsPipelineSelectorMockRecorder struct {
-	mock *MockwsPipelineSelector
+// MockenvClusterGetterMockRecorder is the mock recorder for MockenvClusterGetter.
+type MockenvClusterGetterMockRecorder struct {
+	mock *MockenvClusterGetter
 }
 
-// NewMockwsPipelineSelector creates a new mock instance.
-func NewMockwsPipelineSelector(ctrl *gomock.Controller) *MockwsPipelineSelector {
-	mock := &MockwsPipelineSelector{ctrl: ctrl}
-	mock.recorder = &MockwsPipelineSelectorMockRecorder{mock}
+// NewMockenvClusterGetter creates a new mock instance.
+func NewMockenvClusterGetter(ctrl *gomock.Controller) *MockenvClusterGetter {
+	mock := &MockenvClusterGetter{ctrl: ctrl}
+	mock.recorder = &MockenvClusterGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvClusterGetter) EXPECT() *MockenvClusterGetterMockRecorder {
+	return m.recorder
+}
+
+// ClusterARN mocks base method.
+func (m *MockenvClusterGetter) ClusterARN(app, env string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterARN", app, env)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterARN indicates an expected call of ClusterARN.
+func (mr *MockenvClusterGetterMockRecorder) ClusterARN(app, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterARN", reflect.TypeOf((*MockenvClusterGetter)(nil).ClusterARN), app, env)
+}
+
+// MockserviceResumer is a mock of serviceResumer interface.
+type MockserviceResumer struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceResumerMockRecorder
+}
+
+// MockserviceResumerMockRecorder is the mock recorder for MockserviceResumer.
+type MockserviceResumerMockRecorder struct {
+	mock *MockserviceResumer
+}
+
+// NewMockserviceResumer creates a new mock instance.
+func NewMockserviceResumer(ctrl *gomock.Controller) *MockserviceResumer {
+	mock := &MockserviceResumer{ctrl: ctrl}
+	mock.recorder = &MockserviceResumerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceResumer) EXPECT() *MockserviceResumerMockRecorder {
+	return m.recorder
+}
+
+// ResumeService mocks base method.
+func (m *MockserviceResumer) ResumeService(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeService", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeService indicates an expected call of ResumeService.
+func (mr *MockserviceResumerMockRecorder) ResumeService(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeService", reflect.TypeOf((*MockserviceResumer)(nil).ResumeService), arg0)
+}
+
+// MockjobInitializer is a mock of jobInitializer interface.
+type MockjobInitializer struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobInitializerMockRecorder
+}
+
+// MockjobInitializerMockRecorder is the mock recorder for MockjobInitializer.
+type MockjobInitializerMockRecorder struct {
+	mock *MockjobInitializer
+}
+
+// NewMockjobInitializer creates a new mock instance.
+func NewMockjobInitializer(ctrl *gomock.Controller) *MockjobInitializer {
+	mock := &MockjobInitializer{ctrl: ctrl}
+	mock.recorder = &MockjobInitializerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobInitializer) EXPECT() *MockjobInitializerMockRecorder {
+	return m.recorder
+}
+
+// Job mocks base method.
+func (m *MockjobInitializer) Job(props *initialize.JobProps) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job.
+func (mr *MockjobInitializerMockRecorder) Job(props interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockjobInitializer)(nil).Job), props)
+}
+
+// MocksvcInitializer is a mock of svcInitializer interface.
+type MocksvcInitializer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcInitializerMockRecorder
+}
+
+// MocksvcInitializerMockRecorder is the mock recorder for MocksvcInitializer.
+type MocksvcInitializerMockRecorder struct {
+	mock *MocksvcInitializer
+}
+
+// NewMocksvcInitializer creates a new mock instance.
+func NewMocksvcInitializer(ctrl *gomock.Controller) *MocksvcInitializer {
+	mock := &MocksvcInitializer{ctrl: ctrl}
+	mock.recorder = &MocksvcInitializerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksvcInitializer) EXPECT() *MocksvcInitializerMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method.
+func (m *MocksvcInitializer) Service(props *initialize.ServiceProps) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MocksvcInitializerMockRecorder) Service(props interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MocksvcInitializer)(nil).Service), props)
+}
+
+// MockwkldInitializerWithoutManifest is a mock of wkldInitializerWithoutManifest interface.
+type MockwkldInitializerWithoutManifest struct {
+	ctrl     *gomock.Controller
+	recorder *MockwkldInitializerWithoutManifestMockRecorder
+}
+
+// MockwkldInitializerWithoutManifestMockRecorder is the mock recorder for MockwkldInitializerWithoutManifest.
+type MockwkldInitializerWithoutManifestMockRecorder struct {
+	mock *MockwkldInitializerWithoutManifest
+}
+
+// NewMockwkldInitializerWithoutManifest creates a new mock instance.
+func NewMockwkldInitializerWithoutManifest(ctrl *gomock.Controller) *MockwkldInitializerWithoutManifest {
+	mock := &MockwkldInitializerWithoutManifest{ctrl: ctrl}
+	mock.recorder = &MockwkldInitializerWithoutManifestMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwkldInitializerWithoutManifest) EXPECT() *MockwkldInitializerWithoutManifestMockRecorder {
+	return m.recorder
+}
+
+// AddWorkloadToApp mocks base method.
+func (m *MockwkldInitializerWithoutManifest) AddWorkloadToApp(appName, name, workloadType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkloadToApp", appName, name, workloadType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWorkloadToApp indicates an expected call of AddWorkloadToApp.
+func (mr *MockwkldInitializerWithoutManifestMockRecorder) AddWorkloadToApp(appName, name, workloadType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkloadToApp", reflect.TypeOf((*MockwkldInitializerWithoutManifest)(nil).AddWorkloadToApp), appName, name, workloadType)
+}
+
+// MockroleDeleter is a mock of roleDeleter interface.
+type MockroleDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockroleDeleterMockRecorder
+}
+
+// MockroleDeleterMockRecorder is the mock recorder for MockroleDeleter.
+type MockroleDeleterMockRecorder struct {
+	mock *MockroleDeleter
+}
+
+// NewMockroleDeleter creates a new mock instance.
+func NewMockroleDeleter(ctrl *gomock.Controller) *MockroleDeleter {
+	mock := &MockroleDeleter{ctrl: ctrl}
+	mock.recorder = &MockroleDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockroleDeleter) EXPECT() *MockroleDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteRole mocks base method.
+func (m *MockroleDeleter) DeleteRole(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockroleDeleterMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleDeleter)(nil).DeleteRole), arg0)
+}
+
+// MockpolicyLister is a mock of policyLister interface.
+type MockpolicyLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockpolicyListerMockRecorder
+}
+
+// MockpolicyListerMockRecorder is the mock recorder for MockpolicyLister.
+type MockpolicyListerMockRecorder struct {
+	mock *MockpolicyLister
+}
+
+// NewMockpolicyLister creates a new mock instance.
+func NewMockpolicyLister(ctrl *gomock.Controller) *MockpolicyLister {
+	mock := &MockpolicyLister{ctrl: ctrl}
+	mock.recorder = &MockpolicyListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsPipelineSelector) EXPECT() *MockwsPipelineSelectorMockRecorder {
+func (m *MockpolicyLister) EXPECT() *MockpolicyListerMockRecorder {
 	return m.recorder
 }
 
-// WsPipeline mocks base method.
-func (m *MockwsPipelineSelector) WsPipeline(prompt, help string) (*workspace.PipelineManifest, error) {
+// ListPolicyNames mocks base method.
+func (m *MockpolicyLister) ListPolicyNames() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WsPipeline", prompt, help)
-	ret0, _ := ret[0].(*workspace.PipelineManifest)
+	ret := m.ctrl.Call(m, "ListPolicyNames")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// WsPipeline indicates an expected call of WsPipeline.
-func (mr *MockwsPipelineSelectorMockRecorder) WsPipeline(prompt, help interface{}) *gomock.Call {
+// ListPolicyNames indicates an expected call of ListPolicyNames.
+func (mr *MockpolicyListerMockRecorder) ListPolicyNames() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WsPipeline", reflect.TypeOf((*MockwsPipelineSelector)(nil).WsPipeline), prompt, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicyNames", reflect.TypeOf((*MockpolicyLister)(nil).ListPolicyNames))
 }
 
-// MockwsEnvironmentSelector is a mock of wsEnvironmentSelector interface.
-type MockwsEnvironmentSelector struct {
+// MockserviceDescriber is a mock of serviceDescriber interface.
+type MockserviceDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsEnvironmentSelectorMockRecorder
+	recorder *MockserviceDescriberMockRecorder
 }
 
-// MockwsEnvironmentSelectorMockRecorder is the mock recorder for MockwsEnvironmentSelector.
-type MockwsEnvironmentSelectorMockRecorder struct {
-	mock *MockwsEnvironmentSelector
+// MockserviceDescriberMockRecorder is the mock recorder for MockserviceDescriber.
+type MockserviceDescriberMockRecorder struct {
+	mock *MockserviceDescriber
 }
 
-// NewMockwsEnvironmentSelector creates a new mock instance.
-func NewMockwsEnvironmentSelector(ctrl *gomock.Controller) *MockwsEnvironmentSelector {
-	mock := &MockwsEnvironmentSelector{ctrl: ctrl}
-	mock.recorder = &MockwsEnvironmentSelectorMockRecorder{mock}
+// NewMockserviceDescriber creates a new mock instance.
+func NewMockserviceDescriber(ctrl *gomock.Controller) *MockserviceDescriber {
+	mock := &MockserviceDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsEnvironmentSelector) EXPECT() *MockwsEnvironmentSelectorMockRecorder {
+func (m *MockserviceDescriber) EXPECT() *MockserviceDescriberMockRecorder {
 	return m.recorder
 }
 
-// LocalEnvironment mocks base method.
-func (m *MockwsEnvironmentSelector) LocalEnvironment(msg, help string) (string, error) {
+// DescribeService mocks base method.
+func (m *MockserviceDescriber) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LocalEnvironment", msg, help)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
+	ret0, _ := ret[0].(*ecs0.ServiceDesc)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// LocalEnvironment indicates an expected call of LocalEnvironment.
-func (mr *MockwsEnvironmentSelectorMockRecorder) LocalEnvironment(msg, help interface{}) *gomock.Call {
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockserviceDescriberMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocalEnvironment", reflect.TypeOf((*MockwsEnvironmentSelector)(nil).LocalEnvironment), msg, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockserviceDescriber)(nil).DescribeService), app, env, svc)
 }
 
-// MockcodePipelineSelector is a mock of codePipelineSelector interface.
-type MockcodePipelineSelector struct {
+// MockapprunnerServiceDescriber is a mock of apprunnerServiceDescriber interface.
+type MockapprunnerServiceDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockcodePipelineSelectorMockRecorder
+	recorder *MockapprunnerServiceDescriberMockRecorder
 }
 
-// MockcodePipelineSelectorMockRecorder is the mock recorder for MockcodePipelineSelector.
-type MockcodePipelineSelectorMockRecorder struct {
-	mock *MockcodePipelineSelector
+// MockapprunnerServiceDescriberMockRecorder is the mock recorder for MockapprunnerServiceDescriber.
+type MockapprunnerServiceDescriberMockRecorder struct {
+	mock *MockapprunnerServiceDescriber
 }
 
-// NewMockcodePipelineSelector creates a new mock instance.
-func NewMockcodePipelineSelector(ctrl *gomock.Controller) *MockcodePipelineSelector {
-	mock := &MockcodePipelineSelector{ctrl: ctrl}
-	mock.recorder = &MockcodePipelineSelectorMockRecorder{mock}
+// NewMockapprunnerServiceDescriber creates a new mock instance.
+func NewMockapprunnerServiceDescriber(ctrl *gomock.Controller) *MockapprunnerServiceDescriber {
+	mock := &MockapprunnerServiceDescriber{ctrl: ctrl}
+	mock.recorder = &MockapprunnerServiceDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcodePipelineSelector) EXPECT() *MockcodePipelineSelectorMockRecorder {
+func (m *MockapprunnerServiceDescriber) EXPECT() *MockapprunnerServiceDescriberMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockcodePipelineSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// ServiceARN mocks base method.
+func (m *MockapprunnerServiceDescriber) ServiceARN(env string) (string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret := m.ctrl.Call(m, "ServiceARN", env)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockcodePipelineSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockcodePipelineSelector)(nil).Application), varargs...)
-}
-
-// DeployedPipeline mocks base method.
-func (m *MockcodePipelineSelector) DeployedPipeline(prompt, help, app string) (deploy0.Pipeline, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployedPipeline", prompt, help, app)
-	ret0, _ := ret[0].(deploy0.Pipeline)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// DeployedPipeline indicates an expected call of DeployedPipeline.
-func (mr *MockcodePipelineSelectorMockRecorder) DeployedPipeline(prompt, help, app interface{}) *gomock.Call {
+// ServiceARN indicates an expected call of ServiceARN.
+func (mr *MockapprunnerServiceDescriberMockRecorder) ServiceARN(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedPipeline", reflect.TypeOf((*MockcodePipelineSelector)(nil).DeployedPipeline), prompt, help, app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceARN", reflect.TypeOf((*MockapprunnerServiceDescriber)(nil).ServiceARN), env)
 }
 
-// MockwsSelector is a mock of wsSelector interface.
-type MockwsSelector struct {
+// MockapprunnerServiceGetter is a mock of apprunnerServiceGetter interface.
+type MockapprunnerServiceGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsSelectorMockRecorder
+	recorder *MockapprunnerServiceGetterMockRecorder
 }
 
-// MockwsSelectorMockRecorder is the mock recorder for MockwsSelector.
-type MockwsSelectorMockRecorder struct {
-	mock *MockwsSelector
+// MockapprunnerServiceGetterMockRecorder is the mock recorder for MockapprunnerServiceGetter.
+type MockapprunnerServiceGetterMockRecorder struct {
+	mock *MockapprunnerServiceGetter
 }
 
-// NewMockwsSelector creates a new mock instance.
-func NewMockwsSelector(ctrl *gomock.Controller) *MockwsSelector {
-	mock := &MockwsSelector{ctrl: ctrl}
-	mock.recorder = &MockwsSelectorMockRecorder{mock}
+// NewMockapprunnerServiceGetter creates a new mock instance.
+func NewMockapprunnerServiceGetter(ctrl *gomock.Controller) *MockapprunnerServiceGetter {
+	mock := &MockapprunnerServiceGetter{ctrl: ctrl}
+	mock.recorder = &MockapprunnerServiceGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsSelector) EXPECT() *MockwsSelectorMockRecorder {
+func (m *MockapprunnerServiceGetter) EXPECT() *MockapprunnerServiceGetterMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockwsSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Application indicates an expected call of Application.
-func (mr *MockwsSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockwsSelector)(nil).Application), varargs...)
-}
-
-// Environment mocks base method.
-func (m *MockwsSelector) Environment(prompt, help, app string, additionalOpts ...prompt.Option) (string, error) {
+// Service mocks base method.
+func (m *MockapprunnerServiceGetter) Service(env string) (*apprunner.Service, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Environment", varargs...)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Service", env)
+	ret0, _ := ret[0].(*apprunner.Service)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Environment indicates an expected call of Environment.
-func (mr *MockwsSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+// Service indicates an expected call of Service.
+func (mr *MockapprunnerServiceGetterMockRecorder) Service(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockwsSelector)(nil).Environment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockapprunnerServiceGetter)(nil).Service), env)
 }
 
-// Job mocks base method.
-func (m *MockwsSelector) Job(prompt, help string) (string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Job", prompt, help)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockecsCommandExecutor is a mock of ecsCommandExecutor interface.
+type MockecsCommandExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsCommandExecutorMockRecorder
 }
 
-// Job indicates an expected call of Job.
-func (mr *MockwsSelectorMockRecorder) Job(prompt, help interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockwsSelector)(nil).Job), prompt, help)
+// MockecsCommandExecutorMockRecorder is the mock recorder for MockecsCommandExecutor.
+type MockecsCommandExecutorMockRecorder struct {
+	mock *MockecsCommandExecutor
 }
 
-// Service mocks base method.
-func (m *MockwsSelector) Service(prompt, help string) (string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Service", prompt, help)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// NewMockecsCommandExecutor creates a new mock instance.
+func NewMockecsCommandExecutor(ctrl *gomock.Controller) *MockecsCommandExecutor {
+	mock := &MockecsCommandExecutor{ctrl: ctrl}
+	mock.recorder = &MockecsCommandExecutorMockRecorder{mock}
+	return mock
 }
 
-// Service indicates an expected call of Service.
-func (mr *MockwsSelectorMockRecorder) Service(prompt, help interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockwsSelector)(nil).Service), prompt, help)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsCommandExecutor) EXPECT() *MockecsCommandExecutorMockRecorder {
+	return m.recorder
 }
 
-// Workload mocks base method.
-func (m *MockwsSelector) Workload(msg, help string) (string, error) {
+// ExecuteCommand mocks base method.
+func (m *MockecsCommandExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Workload", msg, help)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ExecuteCommand", in)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Workload indicates an expected call of Workload.
-func (mr *MockwsSelectorMockRecorder) Workload(msg, help interface{}) *gomock.Call {
+// ExecuteCommand indicates an expected call of ExecuteCommand.
+func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockwsSelector)(nil).Workload), msg, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
 }
 
-// MockstaticSourceSelector is a mock of staticSourceSelector interface.
-type MockstaticSourceSelector struct {
+// MockssmPluginManager is a mock of ssmPluginManager interface.
+type MockssmPluginManager struct {
 	ctrl     *gomock.Controller
-	recorder *MockstaticSourceSelectorMockRecorder
+	recorder *MockssmPluginManagerMockRecorder
 }
 
-// MockstaticSourceSelectorMockRecorder is the mock recorder for MockstaticSourceSelector.
-type MockstaticSourceSelectorMockRecorder struct {
-	mock *MockstaticSourceSelector
+// MockssmPluginManagerMockRecorder is the mock recorder for MockssmPluginManager.
+type MockssmPluginManagerMockRecorder struct {
+	mock *MockssmPluginManager
 }
 
-// NewMockstaticSourceSelector creates a new mock instance.
-func NewMockstaticSourceSelector(ctrl *gomock.Controller) *MockstaticSourceSelector {
-	mock := &MockstaticSourceSelector{ctrl: ctrl}
-	mock.recorder = &MockstaticSourceSelectorMockRecorder{mock}
+// NewMockssmPluginManager creates a new mock instance.
+func NewMockssmPluginManager(ctrl *gomock.Controller) *MockssmPluginManager {
+	mock := &MockssmPluginManager{ctrl: ctrl}
+	mock.recorder = &MockssmPluginManagerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockstaticSourceSelector) EXPECT() *MockstaticSourceSelectorMockRecorder {
+func (m *MockssmPluginManager) EXPECT() *MockssmPluginManagerMockRecorder {
 	return m.recorder
 }
 
-// StaticSources mocks base method.
-func (m *MockstaticSourceSelector) StaticSources(selPrompt, selHelp, anotherPathPrompt, anotherPathHelp string, pathValidator prompt.ValidatorFunc) ([]string, error) {
+// InstallLatestBinary mocks base method.
+func (m *MockssmPluginManager) InstallLatestBinary() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StaticSources", selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator)
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "InstallLatestBinary")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallLatestBinary indicates an expected call of InstallLatestBinary.
+func (mr *MockssmPluginManagerMockRecorder) InstallLatestBinary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallLatestBinary", reflect.TypeOf((*MockssmPluginManager)(nil).InstallLatestBinary))
+}
+
+// InstallLocalBinary mocks base method.
+func (m *MockssmPluginManager) InstallLocalBinary() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallLocalBinary")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallLocalBinary indicates an expected call of InstallLocalBinary.
+func (mr *MockssmPluginManagerMockRecorder) InstallLocalBinary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallLocalBinary", reflect.TypeOf((*MockssmPluginManager)(nil).InstallLocalBinary))
+}
+
+// ValidateBinary mocks base method.
+func (m *MockssmPluginManager) ValidateBinary() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateBinary")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// StaticSources indicates an expected call of StaticSources.
-func (mr *MockstaticSourceSelectorMockRecorder) StaticSources(selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator interface{}) *gomock.Call {
+// ValidateBinary indicates an expected call of ValidateBinary.
+func (mr *MockssmPluginManagerMockRecorder) ValidateBinary() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StaticSources", reflect.TypeOf((*MockstaticSourceSelector)(nil).StaticSources), selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBinary", reflect.TypeOf((*MockssmPluginManager)(nil).ValidateBinary))
 }
 
-// MockscheduleSelector is a mock of scheduleSelector interface.
-type MockscheduleSelector struct {
+// MockssmPluginDownloader is a mock of ssmPluginDownloader interface.
+type MockssmPluginDownloader struct {
 	ctrl     *gomock.Controller
-	recorder *MockscheduleSelectorMockRecorder
+	recorder *MockssmPluginDownloaderMockRecorder
 }
 
-// MockscheduleSelectorMockRecorder is the mock recorder for MockscheduleSelector.
-type MockscheduleSelectorMockRecorder struct {
-	mock *MockscheduleSelector
+// MockssmPluginDownloaderMockRecorder is the mock recorder for MockssmPluginDownloader.
+type MockssmPluginDownloaderMockRecorder struct {
+	mock *MockssmPluginDownloader
 }
 
-// NewMockscheduleSelector creates a new mock instance.
-func NewMockscheduleSelector(ctrl *gomock.Controller) *MockscheduleSelector {
-	mock := &MockscheduleSelector{ctrl: ctrl}
-	mock.recorder = &MockscheduleSelectorMockRecorder{mock}
+// NewMockssmPluginDownloader creates a new mock instance.
+func NewMockssmPluginDownloader(ctrl *gomock.Controller) *MockssmPluginDownloader {
+	mock := &MockssmPluginDownloader{ctrl: ctrl}
+	mock.recorder = &MockssmPluginDownloaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockscheduleSelector) EXPECT() *MockscheduleSelectorMockRecorder {
+func (m *MockssmPluginDownloader) EXPECT() *MockssmPluginDownloaderMockRecorder {
 	return m.recorder
 }
 
-// Schedule mocks base method.
-func (m *MockscheduleSelector) Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator prompt.ValidatorFunc) (string, error) {
+// DownloadBinary mocks base method.
+func (m *MockssmPluginDownloader) DownloadBinary() ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Schedule", scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "DownloadBinary")
+	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Schedule indicates an expected call of Schedule.
-func (mr *MockscheduleSelectorMockRecorder) Schedule(scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator interface{}) *gomock.Call {
+// DownloadBinary indicates an expected call of DownloadBinary.
+func (mr *MockssmPluginDownloaderMockRecorder) DownloadBinary() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockscheduleSelector)(nil).Schedule), scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadBinary", reflect.TypeOf((*MockssmPluginDownloader)(nil).DownloadBinary))
 }
 
-// MockcfTaskSelector is a mock of cfTaskSelector interface.
-type MockcfTaskSelector struct {
+// MockssmPluginBundleInstaller is a mock of ssmPluginBundleInstaller interface.
+type MockssmPluginBundleInstaller struct {
 	ctrl     *gomock.Controller
-	recorder *MockcfTaskSelectorMockRecorder
+	recorder *MockssmPluginBundleInstallerMockRecorder
 }
 
-// MockcfTaskSelectorMockRecorder is the mock recorder for MockcfTaskSelector.
-type MockcfTaskSelectorMockRecorder struct {
-	mock *MockcfTaskSelector
+// MockssmPluginBundleInstallerMockRecorder is the mock recorder for MockssmPluginBundleInstaller.
+type MockssmPluginBundleInstallerMockRecorder struct {
+	mock *MockssmPluginBundleInstaller
 }
 
-// NewMockcfTaskSelector creates a new mock instance.
-func NewMockcfTaskSelector(ctrl *gomock.Controller) *MockcfTaskSelector {
-	mock := &MockcfTaskSelector{ctrl: ctrl}
-	mock.recorder = &MockcfTaskSelectorMockRecorder{mock}
+// NewMockssmPluginBundleInstaller creates a new mock instance.
+func NewMockssmPluginBundleInstaller(ctrl *gomock.Controller) *MockssmPluginBundleInstaller {
+	mock := &MockssmPluginBundleInstaller{ctrl: ctrl}
+	mock.recorder = &MockssmPluginBundleInstallerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcfTaskSelector) EXPECT() *MockcfTaskSelectorMockRecorder {
+func (m *MockssmPluginBundleInstaller) EXPECT() *MockssmPluginBundleInstallerMockRecorder {
 	return m.recorder
 }
 
-// Task mocks base method.
-func (m *MockcfTaskSelector) Task(prompt, help string, opts ...selector.GetDeployedTaskOpts) (string, error) {
+// InstallLocalBinaryFrom mocks base method.
+func (m *MockssmPluginBundleInstaller) InstallLocalBinaryFrom(bin []byte) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Task", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "InstallLocalBinaryFrom", bin)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Task indicates an expected call of Task.
-func (mr *MockcfTaskSelectorMockRecorder) Task(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+// InstallLocalBinaryFrom indicates an expected call of InstallLocalBinaryFrom.
+func (mr *MockssmPluginBundleInstallerMockRecorder) InstallLocalBinaryFrom(bin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockcfTaskSelector)(nil).Task), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallLocalBinaryFrom", reflect.TypeOf((*MockssmPluginBundleInstaller)(nil).InstallLocalBinaryFrom), bin)
 }
 
-// MockdockerfileSelector is a mock of dockerfileSelector interface.
-type MockdockerfileSelector struct {
+// MockbundleImageBuilder is a mock of bundleImageBuilder interface.
+type MockbundleImageBuilder struct {
 	ctrl     *gomock.Controller
-	recorder *MockdockerfileSelectorMockRecorder
+	recorder *MockbundleImageBuilderMockRecorder
 }
 
-// MockdockerfileSelectorMockRecorder is the mock recorder for MockdockerfileSelector.
-type MockdockerfileSelectorMockRecorder struct {
-	mock *MockdockerfileSelector
+// MockbundleImageBuilderMockRecorder is the mock recorder for MockbundleImageBuilder.
+type MockbundleImageBuilderMockRecorder struct {
+	mock *MockbundleImageBuilder
 }
 
-// NewMockdockerfileSelector creates a new mock instance.
-func NewMockdockerfileSelector(ctrl *gomock.Controller) *MockdockerfileSelector {
-	mock := &MockdockerfileSelector{ctrl: ctrl}
-	mock.recorder = &MockdockerfileSelectorMockRecorder{mock}
+// NewMockbundleImageBuilder creates a new mock instance.
+func NewMockbundleImageBuilder(ctrl *gomock.Controller) *MockbundleImageBuilder {
+	mock := &MockbundleImageBuilder{ctrl: ctrl}
+	mock.recorder = &MockbundleImageBuilderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdockerfileSelector) EXPECT() *MockdockerfileSelectorMockRecorder {
+func (m *MockbundleImageBuilder) EXPECT() *MockbundleImageBuilderMockRecorder {
 	return m.recorder
 }
 
-// Dockerfile mocks base method.
-func (m *MockdockerfileSelector) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp string, pv prompt.ValidatorFunc) (string, error) {
+// Build mocks base method.
+func (m *MockbundleImageBuilder) Build(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Dockerfile", selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Build", ctx, args, w)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Dockerfile indicates an expected call of Dockerfile.
-func (mr *MockdockerfileSelectorMockRecorder) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv interface{}) *gomock.Call {
+// Build indicates an expected call of Build.
+func (mr *MockbundleImageBuilderMockRecorder) Build(ctx, args, w interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dockerfile", reflect.TypeOf((*MockdockerfileSelector)(nil).Dockerfile), selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockbundleImageBuilder)(nil).Build), ctx, args, w)
 }
 
-// MocktopicSelector is a mock of topicSelector interface.
-type MocktopicSelector struct {
+// SaveImages mocks base method.
+func (m *MockbundleImageBuilder) SaveImages(ctx context.Context, w io.Writer, uris ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, w}
+	for _, a := range uris {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveImages", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveImages indicates an expected call of SaveImages.
+func (mr *MockbundleImageBuilderMockRecorder) SaveImages(ctx, w interface{}, uris ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, w}, uris...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveImages", reflect.TypeOf((*MockbundleImageBuilder)(nil).SaveImages), varargs...)
+}
+
+// MockbundleImageLoader is a mock of bundleImageLoader interface.
+type MockbundleImageLoader struct {
 	ctrl     *gomock.Controller
-	recorder *MocktopicSelectorMockRecorder
+	recorder *MockbundleImageLoaderMockRecorder
 }
 
-// MocktopicSelectorMockRecorder is the mock recorder for MocktopicSelector.
-type MocktopicSelectorMockRecorder struct {
-	mock *MocktopicSelector
+// MockbundleImageLoaderMockRecorder is the mock recorder for MockbundleImageLoader.
+type MockbundleImageLoaderMockRecorder struct {
+	mock *MockbundleImageLoader
 }
 
-// NewMocktopicSelector creates a new mock instance.
-func NewMocktopicSelector(ctrl *gomock.Controller) *MocktopicSelector {
-	mock := &MocktopicSelector{ctrl: ctrl}
-	mock.recorder = &MocktopicSelectorMockRecorder{mock}
+// NewMockbundleImageLoader creates a new mock instance.
+func NewMockbundleImageLoader(ctrl *gomock.Controller) *MockbundleImageLoader {
+	mock := &MockbundleImageLoader{ctrl: ctrl}
+	mock.recorder = &MockbundleImageLoaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktopicSelector) EXPECT() *MocktopicSelectorMockRecorder {
+func (m *MockbundleImageLoader) EXPECT() *MockbundleImageLoaderMockRecorder {
 	return m.recorder
 }
 
-// Topics mocks base method.
-func (m *MocktopicSelector) Topics(prompt, help, app string) ([]deploy0.Topic, error) {
+// LoadImages mocks base method.
+func (m *MockbundleImageLoader) LoadImages(ctx context.Context, r io.Reader) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Topics", prompt, help, app)
-	ret0, _ := ret[0].([]deploy0.Topic)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "LoadImages", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Topics indicates an expected call of Topics.
-func (mr *MocktopicSelectorMockRecorder) Topics(prompt, help, app interface{}) *gomock.Call {
+// LoadImages indicates an expected call of LoadImages.
+func (mr *MockbundleImageLoaderMockRecorder) LoadImages(ctx, r interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Topics", reflect.TypeOf((*MocktopicSelector)(nil).Topics), prompt, help, app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadImages", reflect.TypeOf((*MockbundleImageLoader)(nil).LoadImages), ctx, r)
 }
 
-// Mockec2Selector is a mock of ec2Selector interface.
-type Mockec2Selector struct {
+// MocktaskStopper is a mock of taskStopper interface.
+type MocktaskStopper struct {
 	ctrl     *gomock.Controller
-	recorder *Mockec2SelectorMockRecorder
+	recorder *MocktaskStopperMockRecorder
 }
 
-// Mockec2SelectorMockRecorder is the mock recorder for Mockec2Selector.
-type Mockec2SelectorMockRecorder struct {
-	mock *Mockec2Selector
+// MocktaskStopperMockRecorder is the mock recorder for MocktaskStopper.
+type MocktaskStopperMockRecorder struct {
+	mock *MocktaskStopper
 }
 
-// NewMockec2Selector creates a new mock instance.
-func NewMockec2Selector(ctrl *gomock.Controller) *Mockec2Selector {
-	mock := &Mockec2Selector{ctrl: ctrl}
-	mock.recorder = &Mockec2SelectorMockRecorder{mock}
+// NewMocktaskStopper creates a new mock instance.
+func NewMocktaskStopper(ctrl *gomock.Controller) *MocktaskStopper {
+	mock := &MocktaskStopper{ctrl: ctrl}
+	mock.recorder = &MocktaskStopperMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockec2Selector) EXPECT() *Mockec2SelectorMockRecorder {
+func (m *MocktaskStopper) EXPECT() *MocktaskStopperMockRecorder {
 	return m.recorder
 }
 
-// Subnets mocks base method.
-func (m *Mockec2Selector) Subnets(input selector.SubnetsInput) ([]string, error) {
+// StopDefaultClusterTasks mocks base method.
+func (m *MocktaskStopper) StopDefaultClusterTasks(familyName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Subnets", input)
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "StopDefaultClusterTasks", familyName)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Subnets indicates an expected call of Subnets.
-func (mr *Mockec2SelectorMockRecorder) Subnets(input interface{}) *gomock.Call {
+// StopDefaultClusterTasks indicates an expected call of StopDefaultClusterTasks.
+func (mr *MocktaskStopperMockRecorder) StopDefaultClusterTasks(familyName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subnets", reflect.TypeOf((*Mockec2Selector)(nil).Subnets), input)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopDefaultClusterTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopDefaultClusterTasks), familyName)
 }
 
-// VPC mocks base method.
-func (m *Mockec2Selector) VPC(prompt, help string) (string, error) {
+// StopOneOffTasks mocks base method.
+func (m *MocktaskStopper) StopOneOffTasks(app, env, family string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VPC", prompt, help)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "StopOneOffTasks", app, env, family)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// VPC indicates an expected call of VPC.
-func (mr *Mockec2SelectorMockRecorder) VPC(prompt, help interface{}) *gomock.Call {
+// StopOneOffTasks indicates an expected call of StopOneOffTasks.
+func (mr *MocktaskStopperMockRecorder) StopOneOffTasks(app, env, family interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPC", reflect.TypeOf((*Mockec2Selector)(nil).VPC), prompt, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopOneOffTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopOneOffTasks), app, env, family)
 }
 
-// MockcredsSelector is a mock of credsSelector interface.
-type MockcredsSelector struct {
+// StopWorkloadTasks mocks base method.
+func (m *MocktaskStopper) StopWorkloadTasks(app, env, workload string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopWorkloadTasks", app, env, workload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopWorkloadTasks indicates an expected call of StopWorkloadTasks.
+func (mr *MocktaskStopperMockRecorder) StopWorkloadTasks(app, env, workload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopWorkloadTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopWorkloadTasks), app, env, workload)
+}
+
+// MockserviceLinkedRoleCreator is a mock of serviceLinkedRoleCreator interface.
+type MockserviceLinkedRoleCreator struct {
 	ctrl     *gomock.Controller
-	recorder *MockcredsSelectorMockRecorder
+	recorder *MockserviceLinkedRoleCreatorMockRecorder
 }
 
-// MockcredsSelectorMockRecorder is the mock recorder for MockcredsSelector.
-type MockcredsSelectorMockRecorder struct {
-	mock *MockcredsSelector
+// MockserviceLinkedRoleCreatorMockRecorder is the mock recorder for MockserviceLinkedRoleCreator.
+type MockserviceLinkedRoleCreatorMockRecorder struct {
+	mock *MockserviceLinkedRoleCreator
 }
 
-// NewMockcredsSelector creates a new mock instance.
-func NewMockcredsSelector(ctrl *gomock.Controller) *MockcredsSelector {
-	mock := &MockcredsSelector{ctrl: ctrl}
-	mock.recorder = &MockcredsSelectorMockRecorder{mock}
+// NewMockserviceLinkedRoleCreator creates a new mock instance.
+func NewMockserviceLinkedRoleCreator(ctrl *gomock.Controller) *MockserviceLinkedRoleCreator {
+	mock := &MockserviceLinkedRoleCreator{ctrl: ctrl}
+	mock.recorder = &MockserviceLinkedRoleCreatorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcredsSelector) EXPECT() *MockcredsSelectorMockRecorder {
+func (m *MockserviceLinkedRoleCreator) EXPECT() *MockserviceLinkedRoleCreatorMockRecorder {
 	return m.recorder
 }
 
-// Creds mocks base method.
-func (m *MockcredsSelector) Creds(prompt, help string) (*session.Session, error) {
+// CreateECSServiceLinkedRole mocks base method.
+func (m *MockserviceLinkedRoleCreator) CreateECSServiceLinkedRole() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Creds", prompt, help)
-	ret0, _ := ret[0].(*session.Session)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Creds indicates an expected call of Creds.
-func (mr *MockcredsSelectorMockRecorder) Creds(prompt, help interface{}) *gomock.Call {
+// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
+func (mr *MockserviceLinkedRoleCreatorMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Creds", reflect.TypeOf((*MockcredsSelector)(nil).Creds), prompt, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockserviceLinkedRoleCreator)(nil).CreateECSServiceLinkedRole))
 }
 
-// Mockec2Client is a mock of ec2Client interface.
-type Mockec2Client struct {
+// MockroleTagsLister is a mock of roleTagsLister interface.
+type MockroleTagsLister struct {
 	ctrl     *gomock.Controller
-	recorder *Mockec2ClientMockRecorder
-}
-
-// Mockec2ClientMockRecorder is the mock recorder for Mockec2Client.
-type Mockec2ClientMockRecorder struct {
-	mock *Mockec2Client
-}
-
-// NewMockec2Client creates a new mock instance.
-func NewMockec2Client(ctrl *gomock.Controller) *Mockec2Client {
-	mock := &Mockec2Client{ctrl: ctrl}
-	mock.recorder = &Mockec2ClientMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockec2Client) EXPECT() *Mockec2ClientMockRecorder {
-	return m.recorder
+	recorder *MockroleTagsListerMockRecorder
 }
 
-// HasDNSSupport mocks base method.
-func (m *Mockec2Client) HasDNSSupport(vpcID string) (bool, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasDNSSupport", vpcID)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockroleTagsListerMockRecorder is the mock recorder for MockroleTagsLister.
+type MockroleTagsListerMockRecorder struct {
+	mock *MockroleTagsLister
 }
 
-// HasDNSSupport indicates an expected call of HasDNSSupport.
-func (mr *Mockec2ClientMockRecorder) HasDNSSupport(vpcID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDNSSupport", reflect.TypeOf((*Mockec2Client)(nil).HasDNSSupport), vpcID)
+// NewMockroleTagsLister creates a new mock instance.
+func NewMockroleTagsLister(ctrl *gomock.Controller) *MockroleTagsLister {
+	mock := &MockroleTagsLister{ctrl: ctrl}
+	mock.recorder = &MockroleTagsListerMockRecorder{mock}
+	return mock
 }
 
-// ListAZs mocks base method.
-func (m *Mockec2Client) ListAZs() ([]ec2.AZ, error) {
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockroleTagsLister) EXPECT() *MockroleTagsListerMockRecorder {
+	return m.recorder
+}
+
+// ListRoleTags mocks base method.
+func (m *MockroleTagsLister) ListRoleTags(arg0 string) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAZs")
-	ret0, _ := ret[0].([]ec2.AZ)
+	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
+	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAZs indicates an expected call of ListAZs.
-func (mr *Mockec2ClientMockRecorder) ListAZs() *gomock.Call {
+// ListRoleTags indicates an expected call of ListRoleTags.
+func (mr *MockroleTagsListerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAZs", reflect.TypeOf((*Mockec2Client)(nil).ListAZs))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleTagsLister)(nil).ListRoleTags), arg0)
 }
 
-// MockserviceResumer is a mock of serviceResumer interface.
-type MockserviceResumer struct {
+// MockroleManager is a mock of roleManager interface.
+type MockroleManager struct {
 	ctrl     *gomock.Controller
-	recorder *MockserviceResumerMockRecorder
+	recorder *MockroleManagerMockRecorder
 }
 
-// MockserviceResumerMockRecorder is the mock recorder for MockserviceResumer.
-type MockserviceResumerMockRecorder struct {
-	mock *MockserviceResumer
+// MockroleManagerMockRecorder is the mock recorder for MockroleManager.
+type MockroleManagerMockRecorder struct {
+	mock *MockroleManager
 }
 
-// NewMockserviceResumer creates a new mock instance.
-func NewMockserviceResumer(ctrl *gomock.Controller) *MockserviceResumer {
-	mock := &MockserviceResumer{ctrl: ctrl}
-	mock.recorder = &MockserviceResumerMockRecorder{mock}
+// NewMockroleManager creates a new mock instance.
+func NewMockroleManager(ctrl *gomock.Controller) *MockroleManager {
+	mock := &MockroleManager{ctrl: ctrl}
+	mock.recorder = &MockroleManagerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockserviceResumer) EXPECT() *MockserviceResumerMockRecorder {
+func (m *MockroleManager) EXPECT() *MockroleManagerMockRecorder {
 	return m.recorder
 }
 
-// ResumeService mocks base method.
-func (m *MockserviceResumer) ResumeService(arg0 string) error {
+// CreateECSServiceLinkedRole mocks base method.
+func (m *MockroleManager) CreateECSServiceLinkedRole() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResumeService", arg0)
+	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ResumeService indicates an expected call of ResumeService.
-func (mr *MockserviceResumerMockRecorder) ResumeService(arg0 interface{}) *gomock.Call {
+// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
+func (mr *MockroleManagerMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeService", reflect.TypeOf((*MockserviceResumer)(nil).ResumeService), arg0)
-}
-
-// MockjobInitializer is a mock of jobInitializer interface.
-type MockjobInitializer struct {
-	ctrl     *gomock.Controller
-	recorder *MockjobInitializerMockRecorder
-}
-
-// MockjobInitializerMockRecorder is the mock recorder for MockjobInitializer.
-type MockjobInitializerMockRecorder struct {
-	mock *MockjobInitializer
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockroleManager)(nil).CreateECSServiceLinkedRole))
 }
 
-// NewMockjobInitializer creates a new mock instance.
-func NewMockjobInitializer(ctrl *gomock.Controller) *MockjobInitializer {
-	mock := &MockjobInitializer{ctrl: ctrl}
-	mock.recorder = &MockjobInitializerMockRecorder{mock}
-	return mock
+// DeleteRole mocks base method.
+func (m *MockroleManager) DeleteRole(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockjobInitializer) EXPECT() *MockjobInitializerMockRecorder {
-	return m.recorder
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockroleManagerMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleManager)(nil).DeleteRole), arg0)
 }
 
-// Job mocks base method.
-func (m *MockjobInitializer) Job(props *initialize.JobProps) (string, error) {
+// ListRoleTags mocks base method.
+func (m *MockroleManager) ListRoleTags(arg0 string) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Job", props)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
+	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Job indicates an expected call of Job.
-func (mr *MockjobInitializerMockRecorder) Job(props interface{}) *gomock.Call {
+// ListRoleTags indicates an expected call of ListRoleTags.
+func (mr *MockroleManagerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockjobInitializer)(nil).Job), props)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleManager)(nil).ListRoleTags), arg0)
 }
 
-// MocksvcInitializer is a mock of svcInitializer interface.
-type MocksvcInitializer struct {
+// MockstackExistChecker is a mock of stackExistChecker interface.
+type MockstackExistChecker struct {
 	ctrl     *gomock.Controller
-	recorder *MocksvcInitializerMockRecorder
+	recorder *MockstackExistCheckerMockRecorder
 }
 
-// MocksvcInitializerMockRecorder is the mock recorder for MocksvcInitializer.
-type MocksvcInitializerMockRecorder struct {
-	mock *MocksvcInitializer
+// MockstackExistCheckerMockRecorder is the mock recorder for MockstackExistChecker.
+type MockstackExistCheckerMockRecorder struct {
+	mock *MockstackExistChecker
 }
 
-// NewMocksvcInitializer creates a new mock instance.
-func NewMocksvcInitializer(ctrl *gomock.Controller) *MocksvcInitializer {
-	mock := &MocksvcInitializer{ctrl: ctrl}
-	mock.recorder = &MocksvcInitializerMockRecorder{mock}
+// NewMockstackExistChecker creates a new mock instance.
+func NewMockstackExistChecker(ctrl *gomock.Controller) *MockstackExistChecker {
+	mock := &MockstackExistChecker{ctrl: ctrl}
+	mock.recorder = &MockstackExistCheckerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocksvcInitializer) EXPECT() *MocksvcInitializerMockRecorder {
+func (m *MockstackExistChecker) EXPECT() *MockstackExistCheckerMockRecorder {
 	return m.recorder
 }
 
-// Service mocks base method.
-func (m *MocksvcInitializer) Service(props *initialize.ServiceProps) (string, error) {
+// Exists mocks base method.
+func (m *MockstackExistChecker) Exists(arg0 string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Service", props)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Exists", arg0)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Service indicates an expected call of Service.
-func (mr *MocksvcInitializerMockRecorder) Service(props interface{}) *gomock.Call {
+// Exists indicates an expected call of Exists.
+func (mr *MockstackExistCheckerMockRecorder) Exists(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MocksvcInitializer)(nil).Service), props)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockstackExistChecker)(nil).Exists), arg0)
 }
 
-// MockwkldInitializerWithoutManifest is a mock of wkldInitializerWithoutManifest interface.
-type MockwkldInitializerWithoutManifest struct {
+// MockrunningTaskSelector is a mock of runningTaskSelector interface.
+type MockrunningTaskSelector struct {
 	ctrl     *gomock.Controller
-	recorder *MockwkldInitializerWithoutManifestMockRecorder
+	recorder *MockrunningTaskSelectorMockRecorder
 }
 
-// MockwkldInitializerWithoutManifestMockRecorder is the mock recorder for MockwkldInitializerWithoutManifest.
-type MockwkldInitializerWithoutManifestMockRecorder struct {
-	mock *MockwkldInitializerWithoutManifest
+// MockrunningTaskSelectorMockRecorder is the mock recorder for MockrunningTaskSelector.
+type MockrunningTaskSelectorMockRecorder struct {
+	mock *MockrunningTaskSelector
 }
 
-// NewMockwkldInitializerWithoutManifest creates a new mock instance.
-func NewMockwkldInitializerWithoutManifest(ctrl *gomock.Controller) *MockwkldInitializerWithoutManifest {
-	mock := &MockwkldInitializerWithoutManifest{ctrl: ctrl}
-	mock.recorder = &MockwkldInitializerWithoutManifestMockRecorder{mock}
+// NewMockrunningTaskSelector creates a new mock instance.
+func NewMockrunningTaskSelector(ctrl *gomock.Controller) *MockrunningTaskSelector {
+	mock := &MockrunningTaskSelector{ctrl: ctrl}
+	mock.recorder = &MockrunningTaskSelectorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwkldInitializerWithoutManifest) EXPECT() *MockwkldInitializerWithoutManifestMockRecorder {
+func (m *MockrunningTaskSelector) EXPECT() *MockrunningTaskSelectorMockRecorder {
 	return m.recorder
 }
 
-// AddWorkloadToApp mocks base method.
-func (m *MockwkldInitializerWithoutManifest) AddWorkloadToApp(appName, name, workloadType string) error {
+// RunningTask mocks base method.
+func (m *MockrunningTaskSelector) RunningTask(prompt, help string, opts ...selector.TaskOpts) (*ecs.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddWorkloadToApp", appName, name, workloadType)
-	ret0, _ := ret[0].(error)
-	return ret0
+	varargs := []interface{}{prompt, help}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunningTask", varargs...)
+	ret0, _ := ret[0].(*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// AddWorkloadToApp indicates an expected call of AddWorkloadToApp.
-func (mr *MockwkldInitializerWithoutManifestMockRecorder) AddWorkloadToApp(appName, name, workloadType interface{}) *gomock.Call {
+// RunningTask indicates an expected call of RunningTask.
+func (mr *MockrunningTaskSelectorMockRecorder) RunningTask(prompt, help interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkloadToApp", reflect.TypeOf((*MockwkldInitializerWithoutManifest)(nil).AddWorkloadToApp), appName, name, workloadType)
+	varargs := append([]interface{}{prompt, help}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTask", reflect.TypeOf((*MockrunningTaskSelector)(nil).RunningTask), varargs...)
 }
 
-// MockroleDeleter is a mock of roleDeleter interface.
-type MockroleDeleter struct {
+// MockdockerEngine is a mock of dockerEngine interface.
+type MockdockerEngine struct {
 	ctrl     *gomock.Controller
-	recorder *MockroleDeleterMockRecorder
+	recorder *MockdockerEngineMockRecorder
 }
 
-// MockroleDeleterMockRecorder is the mock recorder for MockroleDeleter.
-type MockroleDeleterMockRecorder struct {
-	mock *MockroleDeleter
+// MockdockerEngineMockRecorder is the mock recorder for MockdockerEngine.
+type MockdockerEngineMockRecorder struct {
+	mock *MockdockerEngine
 }
 
-// NewMockroleDeleter creates a new mock instance.
-func NewMockroleDeleter(ctrl *gomock.Controller) *MockroleDeleter {
-	mock := &MockroleDeleter{ctrl: ctrl}
-	mock.recorder = &MockroleDeleterMockRecorder{mock}
+// NewMockdockerEngine creates a new mock instance.
+func NewMockdockerEngine(ctrl *gomock.Controller) *MockdockerEngine {
+	mock := &MockdockerEngine{ctrl: ctrl}
+	mock.recorder = &MockdockerEngineMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockroleDeleter) EXPECT() *MockroleDeleterMockRecorder {
+func (m *MockdockerEngine) EXPECT() *MockdockerEngineMockRecorder {
 	return m.recorder
 }
 
-// DeleteRole mocks base method.
-func (m *MockroleDeleter) DeleteRole(arg0 string) error {
+// CheckDockerEngineRunning mocks base method.
+func (m *MockdockerEngine) CheckDockerEngineRunning() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteRole indicates an expected call of DeleteRole.
-func (mr *MockroleDeleterMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MockdockerEngineMockRecorder) CheckDockerEngineRunning() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleDeleter)(nil).DeleteRole), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockdockerEngine)(nil).CheckDockerEngineRunning))
 }
 
-// MockpolicyLister is a mock of policyLister interface.
-type MockpolicyLister struct {
+// GetPlatform mocks base method.
+func (m *MockdockerEngine) GetPlatform() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlatform")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPlatform indicates an expected call of GetPlatform.
+func (mr *MockdockerEngineMockRecorder) GetPlatform() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatform", reflect.TypeOf((*MockdockerEngine)(nil).GetPlatform))
+}
+
+// Mockcodestar is a mock of codestar interface.
+type Mockcodestar struct {
 	ctrl     *gomock.Controller
-	recorder *MockpolicyListerMockRecorder
+	recorder *MockcodestarMockRecorder
 }
 
-// MockpolicyListerMockRecorder is the mock recorder for MockpolicyLister.
-type MockpolicyListerMockRecorder struct {
-	mock *MockpolicyLister
+// MockcodestarMockRecorder is the mock recorder for Mockcodestar.
+type MockcodestarMockRecorder struct {
+	mock *Mockcodestar
 }
 
-// NewMockpolicyLister creates a new mock instance.
-func NewMockpolicyLister(ctrl *gomock.Controller) *MockpolicyLister {
-	mock := &MockpolicyLister{ctrl: ctrl}
-	mock.recorder = &MockpolicyListerMockRecorder{mock}
+// NewMockcodestar creates a new mock instance.
+func NewMockcodestar(ctrl *gomock.Controller) *Mockcodestar {
+	mock := &Mockcodestar{ctrl: ctrl}
+	mock.recorder = &MockcodestarMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpolicyLister) EXPECT() *MockpolicyListerMockRecorder {
+func (m *Mockcodestar) EXPECT() *MockcodestarMockRecorder {
 	return m.recorder
 }
 
-// ListPolicyNames mocks base method.
-func (m *MockpolicyLister) ListPolicyNames() ([]string, error) {
+// GetConnectionARN mocks base method.
+func (m *Mockcodestar) GetConnectionARN(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPolicyNames")
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetConnectionARN", arg0)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPolicyNames indicates an expected call of ListPolicyNames.
-func (mr *MockpolicyListerMockRecorder) ListPolicyNames() *gomock.Call {
+// GetConnectionARN indicates an expected call of GetConnectionARN.
+func (mr *MockcodestarMockRecorder) GetConnectionARN(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicyNames", reflect.TypeOf((*MockpolicyLister)(nil).ListPolicyNames))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionARN", reflect.TypeOf((*Mockcodestar)(nil).GetConnectionARN), arg0)
 }
 
-// MockserviceDescriber is a mock of serviceDescriber interface.
-type MockserviceDescriber struct {
+// MockpublicIPGetter is a mock of publicIPGetter interface.
+type MockpublicIPGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockserviceDescriberMockRecorder
+	recorder *MockpublicIPGetterMockRecorder
 }
 
-// MockserviceDescriberMockRecorder is the mock recorder for MockserviceDescriber.
-type MockserviceDescriberMockRecorder struct {
-	mock *MockserviceDescriber
+// MockpublicIPGetterMockRecorder is the mock recorder for MockpublicIPGetter.
+type MockpublicIPGetterMockRecorder struct {
+	mock *MockpublicIPGetter
 }
 
-// NewMockserviceDescriber creates a new mock instance.
-func NewMockserviceDescriber(ctrl *gomock.Controller) *MockserviceDescriber {
-	mock := &MockserviceDescriber{ctrl: ctrl}
-	mock.recorder = &MockserviceDescriberMockRecorder{mock}
+// NewMockpublicIPGetter creates a new mock instance.
+func NewMockpublicIPGetter(ctrl *gomock.Controller) *MockpublicIPGetter {
+	mock := &MockpublicIPGetter{ctrl: ctrl}
+	mock.recorder = &MockpublicIPGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockserviceDescriber) EXPECT() *MockserviceDescriberMockRecorder {
+func (m *MockpublicIPGetter) EXPECT() *MockpublicIPGetterMockRecorder {
 	return m.recorder
 }
 
-// DescribeService mocks base method.
-func (m *MockserviceDescriber) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
+// PublicIP mocks base method.
+func (m *MockpublicIPGetter) PublicIP(ENI string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
-	ret0, _ := ret[0].(*ecs0.ServiceDesc)
+	ret := m.ctrl.Call(m, "PublicIP", ENI)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeService indicates an expected call of DescribeService.
-func (mr *MockserviceDescriberMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+// PublicIP indicates an expected call of PublicIP.
+func (mr *MockpublicIPGetterMockRecorder) PublicIP(ENI interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockserviceDescriber)(nil).DescribeService), app, env, svc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIP", reflect.TypeOf((*MockpublicIPGetter)(nil).PublicIP), ENI)
 }
 
-// MockapprunnerServiceDescriber is a mock of apprunnerServiceDescriber interface.
-type MockapprunnerServiceDescriber struct {
+// MockcliStringer is a mock of cliStringer interface.
+type MockcliStringer struct {
 	ctrl     *gomock.Controller
-	recorder *MockapprunnerServiceDescriberMockRecorder
+	recorder *MockcliStringerMockRecorder
 }
 
-// MockapprunnerServiceDescriberMockRecorder is the mock recorder for MockapprunnerServiceDescriber.
-type MockapprunnerServiceDescriberMockRecorder struct {
-	mock *MockapprunnerServiceDescriber
+// MockcliStringerMockRecorder is the mock recorder for MockcliStringer.
+type MockcliStringerMockRecorder struct {
+	mock *MockcliStringer
 }
 
-// NewMockapprunnerServiceDescriber creates a new mock instance.
-func NewMockapprunnerServiceDescriber(ctrl *gomock.Controller) *MockapprunnerServiceDescriber {
-	mock := &MockapprunnerServiceDescriber{ctrl: ctrl}
-	mock.recorder = &MockapprunnerServiceDescriberMockRecorder{mock}
+// NewMockcliStringer creates a new mock instance.
+func NewMockcliStringer(ctrl *gomock.Controller) *MockcliStringer {
+	mock := &MockcliStringer{ctrl: ctrl}
+	mock.recorder = &MockcliStringerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockapprunnerServiceDescriber) EXPECT() *MockapprunnerServiceDescriberMockRecorder {
+func (m *MockcliStringer) EXPECT() *MockcliStringerMockRecorder {
 	return m.recorder
 }
 
-// ServiceARN mocks base method.
-func (m *MockapprunnerServiceDescriber) ServiceARN(env string) (string, error) {
+// CLIString mocks base method.
+func (m *MockcliStringer) CLIString() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ServiceARN", env)
+	ret := m.ctrl.Call(m, "CLIString")
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ServiceARN indicates an expected call of ServiceARN.
-func (mr *MockapprunnerServiceDescriberMockRecorder) ServiceARN(env interface{}) *gomock.Call {
+// CLIString indicates an expected call of CLIString.
+func (mr *MockcliStringerMockRecorder) CLIString() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceARN", reflect.TypeOf((*MockapprunnerServiceDescriber)(nil).ServiceARN), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CLIString", reflect.TypeOf((*MockcliStringer)(nil).CLIString))
 }
 
-// MockecsCommandExecutor is a mock of ecsCommandExecutor interface.
-type MockecsCommandExecutor struct {
+// MocksecretPutter is a mock of secretPutter interface.
+type MocksecretPutter struct {
 	ctrl     *gomock.Controller
-	recorder *MockecsCommandExecutorMockRecorder
+	recorder *MocksecretPutterMockRecorder
 }
 
-// MockecsCommandExecutorMockRecorder is the mock recorder for MockecsCommandExecutor.
-type MockecsCommandExecutorMockRecorder struct {
-	mock *MockecsCommandExecutor
+// MocksecretPutterMockRecorder is the mock recorder for MocksecretPutter.
+type MocksecretPutterMockRecorder struct {
+	mock *MocksecretPutter
 }
 
-// NewMockecsCommandExecutor creates a new mock instance.
-func NewMockecsCommandExecutor(ctrl *gomock.Controller) *MockecsCommandExecutor {
-	mock := &MockecsCommandExecutor{ctrl: ctrl}
-	mock.recorder = &MockecsCommandExecutorMockRecorder{mock}
+// NewMocksecretPutter creates a new mock instance.
+func NewMocksecretPutter(ctrl *gomock.Controller) *MocksecretPutter {
+	mock := &MocksecretPutter{ctrl: ctrl}
+	mock.recorder = &MocksecretPutterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockecsCommandExecutor) EXPECT() *MockecsCommandExecutorMockRecorder {
+func (m *MocksecretPutter) EXPECT() *MocksecretPutterMockRecorder {
 	return m.recorder
 }
 
-// ExecuteCommand mocks base method.
-func (m *MockecsCommandExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) error {
+// PutSecret mocks base method.
+func (m *MocksecretPutter) PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExecuteCommand", in)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(*ssm.PutSecretOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ExecuteCommand indicates an expected call of ExecuteCommand.
-func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
 }
 
-// MockssmPluginManager is a mock of ssmPluginManager interface.
-type MockssmPluginManager struct {
+// MocksecretLister is a mock of secretLister interface.
+type MocksecretLister struct {
 	ctrl     *gomock.Controller
-	recorder *MockssmPluginManagerMockRecorder
+	recorder *MocksecretListerMockRecorder
 }
 
-// MockssmPluginManagerMockRecorder is the mock recorder for MockssmPluginManager.
-type MockssmPluginManagerMockRecorder struct {
-	mock *MockssmPluginManager
+// MocksecretListerMockRecorder is the mock recorder for MocksecretLister.
+type MocksecretListerMockRecorder struct {
+	mock *MocksecretLister
 }
 
-// NewMockssmPluginManager creates a new mock instance.
-func NewMockssmPluginManager(ctrl *gomock.Controller) *MockssmPluginManager {
-	mock := &MockssmPluginManager{ctrl: ctrl}
-	mock.recorder = &MockssmPluginManagerMockRecorder{mock}
+// NewMocksecretLister creates a new mock instance.
+func NewMocksecretLister(ctrl *gomock.Controller) *MocksecretLister {
+	mock := &MocksecretLister{ctrl: ctrl}
+	mock.recorder = &MocksecretListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockssmPluginManager) EXPECT() *MockssmPluginManagerMockRecorder {
+func (m *MocksecretLister) EXPECT() *MocksecretListerMockRecorder {
 	return m.recorder
 }
 
-// InstallLatestBinary mocks base method.
-func (m *MockssmPluginManager) InstallLatestBinary() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InstallLatestBinary")
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// InstallLatestBinary indicates an expected call of InstallLatestBinary.
-func (mr *MockssmPluginManagerMockRecorder) InstallLatestBinary() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallLatestBinary", reflect.TypeOf((*MockssmPluginManager)(nil).InstallLatestBinary))
-}
-
-// ValidateBinary mocks base method.
-func (m *MockssmPluginManager) ValidateBinary() error {
+// ListSecrets mocks base method.
+func (m *MocksecretLister) ListSecrets(path string) ([]ssm.SecretMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateBinary")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListSecrets", path)
+	ret0, _ := ret[0].([]ssm.SecretMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ValidateBinary indicates an expected call of ValidateBinary.
-func (mr *MockssmPluginManagerMockRecorder) ValidateBinary() *gomock.Call {
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MocksecretListerMockRecorder) ListSecrets(path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBinary", reflect.TypeOf((*MockssmPluginManager)(nil).ValidateBinary))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MocksecretLister)(nil).ListSecrets), path)
 }
 
-// MocktaskStopper is a mock of taskStopper interface.
-type MocktaskStopper struct {
+// MockservicePauser is a mock of servicePauser interface.
+type MockservicePauser struct {
 	ctrl     *gomock.Controller
-	recorder *MocktaskStopperMockRecorder
+	recorder *MockservicePauserMockRecorder
 }
 
-// MocktaskStopperMockRecorder is the mock recorder for MocktaskStopper.
-type MocktaskStopperMockRecorder struct {
-	mock *MocktaskStopper
+// MockservicePauserMockRecorder is the mock recorder for MockservicePauser.
+type MockservicePauserMockRecorder struct {
+	mock *MockservicePauser
 }
 
-// NewMocktaskStopper creates a new mock instance.
-func NewMocktaskStopper(ctrl *gomock.Controller) *MocktaskStopper {
-	mock := &MocktaskStopper{ctrl: ctrl}
-	mock.recorder = &MocktaskStopperMockRecorder{mock}
+// NewMockservicePauser creates a new mock instance.
+func NewMockservicePauser(ctrl *gomock.Controller) *MockservicePauser {
+	mock := &MockservicePauser{ctrl: ctrl}
+	mock.recorder = &MockservicePauserMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktaskStopper) EXPECT() *MocktaskStopperMockRecorder {
+func (m *MockservicePauser) EXPECT() *MockservicePauserMockRecorder {
 	return m.recorder
 }
 
-// StopDefaultClusterTasks mocks base method.
-func (m *MocktaskStopper) StopDefaultClusterTasks(familyName string) error {
+// PauseService mocks base method.
+func (m *MockservicePauser) PauseService(svcARN string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopDefaultClusterTasks", familyName)
+	ret := m.ctrl.Call(m, "PauseService", svcARN)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// StopDefaultClusterTasks indicates an expected call of StopDefaultClusterTasks.
-func (mr *MocktaskStopperMockRecorder) StopDefaultClusterTasks(familyName interface{}) *gomock.Call {
+// PauseService indicates an expected call of PauseService.
+func (mr *MockservicePauserMockRecorder) PauseService(svcARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopDefaultClusterTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopDefaultClusterTasks), familyName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockservicePauser)(nil).PauseService), svcARN)
 }
 
-// StopOneOffTasks mocks base method.
-func (m *MocktaskStopper) StopOneOffTasks(app, env, family string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopOneOffTasks", app, env, family)
-	ret0, _ := ret[0].(error)
-	return ret0
+// Mockinterpolator is a mock of interpolator interface.
+type Mockinterpolator struct {
+	ctrl     *gomock.Controller
+	recorder *MockinterpolatorMockRecorder
 }
 
-// StopOneOffTasks indicates an expected call of StopOneOffTasks.
-func (mr *MocktaskStopperMockRecorder) StopOneOffTasks(app, env, family interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopOneOffTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopOneOffTasks), app, env, family)
+// MockinterpolatorMockRecorder is the mock recorder for Mockinterpolator.
+type MockinterpolatorMockRecorder struct {
+	mock *Mockinterpolator
 }
 
-// StopWorkloadTasks mocks base method.
-func (m *MocktaskStopper) StopWorkloadTasks(app, env, workload string) error {
+// NewMockinterpolator creates a new mock instance.
+func NewMockinterpolator(ctrl *gomock.Controller) *Mockinterpolator {
+	mock := &Mockinterpolator{ctrl: ctrl}
+	mock.recorder = &MockinterpolatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockinterpolator) EXPECT() *MockinterpolatorMockRecorder {
+	return m.recorder
+}
+
+// Interpolate mocks base method.
+func (m *Mockinterpolator) Interpolate(s string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopWorkloadTasks", app, env, workload)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Interpolate", s)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// StopWorkloadTasks indicates an expected call of StopWorkloadTasks.
-func (mr *MocktaskStopperMockRecorder) StopWorkloadTasks(app, env, workload interface{}) *gomock.Call {
+// Interpolate indicates an expected call of Interpolate.
+func (mr *MockinterpolatorMockRecorder) Interpolate(s interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopWorkloadTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopWorkloadTasks), app, env, workload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interpolate", reflect.TypeOf((*Mockinterpolator)(nil).Interpolate), s)
 }
 
-// MockserviceLinkedRoleCreator is a mock of serviceLinkedRoleCreator interface.
-type MockserviceLinkedRoleCreator struct {
+// MockworkloadDeployer is a mock of workloadDeployer interface.
+type MockworkloadDeployer struct {
 	ctrl     *gomock.Controller
-	recorder *MockserviceLinkedRoleCreatorMockRecorder
+	recorder *MockworkloadDeployerMockRecorder
 }
 
-// MockserviceLinkedRoleCreatorMockRecorder is the mock recorder for MockserviceLinkedRoleCreator.
-type MockserviceLinkedRoleCreatorMockRecorder struct {
-	mock *MockserviceLinkedRoleCreator
+// MockworkloadDeployerMockRecorder is the mock recorder for MockworkloadDeployer.
+type MockworkloadDeployerMockRecorder struct {
+	mock *MockworkloadDeployer
 }
 
-// NewMockserviceLinkedRoleCreator creates a new mock instance.
-func NewMockserviceLinkedRoleCreator(ctrl *gomock.Controller) *MockserviceLinkedRoleCreator {
-	mock := &MockserviceLinkedRoleCreator{ctrl: ctrl}
-	mock.recorder = &MockserviceLinkedRoleCreatorMockRecorder{mock}
+// NewMockworkloadDeployer creates a new mock instance.
+func NewMockworkloadDeployer(ctrl *gomock.Controller) *MockworkloadDeployer {
+	mock := &MockworkloadDeployer{ctrl: ctrl}
+	mock.recorder = &MockworkloadDeployerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockserviceLinkedRoleCreator) EXPECT() *MockserviceLinkedRoleCreatorMockRecorder {
+func (m *MockworkloadDeployer) EXPECT() *MockworkloadDeployerMockRecorder {
 	return m.recorder
 }
 
-// CreateECSServiceLinkedRole mocks base method.
-func (m *MockserviceLinkedRoleCreator) CreateECSServiceLinkedRole() error {
+// ConfigDiff mocks base method.
+func (m *MockworkloadDeployer) ConfigDiff(tmpl, params string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ConfigDiff", tmpl, params)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
-func (mr *MockserviceLinkedRoleCreatorMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
+// ConfigDiff indicates an expected call of ConfigDiff.
+func (mr *MockworkloadDeployerMockRecorder) ConfigDiff(tmpl, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockserviceLinkedRoleCreator)(nil).CreateECSServiceLinkedRole))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).ConfigDiff), tmpl, params)
 }
 
-// MockroleTagsLister is a mock of roleTagsLister interface.
-type MockroleTagsLister struct {
-	ctrl     *gomock.Controller
-	recorder *MockroleTagsListerMockRecorder
-}
-
-// MockroleTagsListerMockRecorder is the mock recorder for MockroleTagsLister.
-type MockroleTagsListerMockRecorder struct {
-	mock *MockroleTagsLister
-}
-
-// NewMockroleTagsLister creates a new mock instance.
-func NewMockroleTagsLister(ctrl *gomock.Controller) *MockroleTagsLister {
-	mock := &MockroleTagsLister{ctrl: ctrl}
-	mock.recorder = &MockroleTagsListerMockRecorder{mock}
-	return mock
+// DeployDiff mocks base method.
+func (m *MockworkloadDeployer) DeployDiff(inTmpl string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockroleTagsLister) EXPECT() *MockroleTagsListerMockRecorder {
-	return m.recorder
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MockworkloadDeployerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployDiff), inTmpl)
 }
 
-// ListRoleTags mocks base method.
-func (m *MockroleTagsLister) ListRoleTags(arg0 string) (map[string]string, error) {
+// DeployWorkload mocks base method.
+func (m *MockworkloadDeployer) DeployWorkload(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
-	ret0, _ := ret[0].(map[string]string)
+	ret := m.ctrl.Call(m, "DeployWorkload", in)
+	ret0, _ := ret[0].(deploy.ActionRecommender)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRoleTags indicates an expected call of ListRoleTags.
-func (mr *MockroleTagsListerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
+// DeployWorkload indicates an expected call of DeployWorkload.
+func (mr *MockworkloadDeployerMockRecorder) DeployWorkload(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleTagsLister)(nil).ListRoleTags), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployWorkload", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployWorkload), in)
 }
 
-// MockroleManager is a mock of roleManager interface.
-type MockroleManager struct {
-	ctrl     *gomock.Controller
-	recorder *MockroleManagerMockRecorder
+// EstimateCost mocks base method.
+func (m *MockworkloadDeployer) EstimateCost(tmpl, params string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateCost", tmpl, params)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockroleManagerMockRecorder is the mock recorder for MockroleManager.
-type MockroleManagerMockRecorder struct {
-	mock *MockroleManager
+// EstimateCost indicates an expected call of EstimateCost.
+func (mr *MockworkloadDeployerMockRecorder) EstimateCost(tmpl, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateCost", reflect.TypeOf((*MockworkloadDeployer)(nil).EstimateCost), tmpl, params)
 }
 
-// NewMockroleManager creates a new mock instance.
-func NewMockroleManager(ctrl *gomock.Controller) *MockroleManager {
-	mock := &MockroleManager{ctrl: ctrl}
-	mock.recorder = &MockroleManagerMockRecorder{mock}
-	return mock
+// GenerateCloudFormationTemplate mocks base method.
+func (m *MockworkloadDeployer) GenerateCloudFormationTemplate(in *deploy.GenerateCloudFormationTemplateInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
+	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockroleManager) EXPECT() *MockroleManagerMockRecorder {
-	return m.recorder
+// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
+func (mr *MockworkloadDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockworkloadDeployer)(nil).GenerateCloudFormationTemplate), in)
 }
 
-// CreateECSServiceLinkedRole mocks base method.
-func (m *MockroleManager) CreateECSServiceLinkedRole() error {
+// IsServiceAvailableInRegion mocks base method.
+func (m *MockworkloadDeployer) IsServiceAvailableInRegion(region string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "IsServiceAvailableInRegion", region)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
-func (mr *MockroleManagerMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
+// IsServiceAvailableInRegion indicates an expected call of IsServiceAvailableInRegion.
+func (mr *MockworkloadDeployerMockRecorder) IsServiceAvailableInRegion(region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockroleManager)(nil).CreateECSServiceLinkedRole))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsServiceAvailableInRegion", reflect.TypeOf((*MockworkloadDeployer)(nil).IsServiceAvailableInRegion), region)
 }
 
-// DeleteRole mocks base method.
-func (m *MockroleManager) DeleteRole(arg0 string) error {
+// UploadArtifacts mocks base method.
+func (m *MockworkloadDeployer) UploadArtifacts() (*deploy.UploadArtifactsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRole", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UploadArtifacts")
+	ret0, _ := ret[0].(*deploy.UploadArtifactsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteRole indicates an expected call of DeleteRole.
-func (mr *MockroleManagerMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
+// UploadArtifacts indicates an expected call of UploadArtifacts.
+func (mr *MockworkloadDeployerMockRecorder) UploadArtifacts() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleManager)(nil).DeleteRole), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadDeployer)(nil).UploadArtifacts))
 }
 
-// ListRoleTags mocks base method.
-func (m *MockroleManager) ListRoleTags(arg0 string) (map[string]string, error) {
+// VariablesDiff mocks base method.
+func (m *MockworkloadDeployer) VariablesDiff(tmpl, params string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
-	ret0, _ := ret[0].(map[string]string)
+	ret := m.ctrl.Call(m, "VariablesDiff", tmpl, params)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRoleTags indicates an expected call of ListRoleTags.
-func (mr *MockroleManagerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
+// VariablesDiff indicates an expected call of VariablesDiff.
+func (mr *MockworkloadDeployerMockRecorder) VariablesDiff(tmpl, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleManager)(nil).ListRoleTags), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VariablesDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).VariablesDiff), tmpl, params)
 }
 
-// MockstackExistChecker is a mock of stackExistChecker interface.
-type MockstackExistChecker struct {
+// MocktemplateDiffer is a mock of templateDiffer interface.
+type MocktemplateDiffer struct {
 	ctrl     *gomock.Controller
-	recorder *MockstackExistCheckerMockRecorder
+	recorder *MocktemplateDifferMockRecorder
 }
 
-// MockstackExistCheckerMockRecorder is the mock recorder for MockstackExistChecker.
-type MockstackExistCheckerMockRecorder struct {
-	mock *MockstackExistChecker
+// MocktemplateDifferMockRecorder is the mock recorder for MocktemplateDiffer.
+type MocktemplateDifferMockRecorder struct {
+	mock *MocktemplateDiffer
 }
 
-// NewMockstackExistChecker creates a new mock instance.
-func NewMockstackExistChecker(ctrl *gomock.Controller) *MockstackExistChecker {
-	mock := &MockstackExistChecker{ctrl: ctrl}
-	mock.recorder = &MockstackExistCheckerMockRecorder{mock}
+// NewMocktemplateDiffer creates a new mock instance.
+func NewMocktemplateDiffer(ctrl *gomock.Controller) *MocktemplateDiffer {
+	mock := &MocktemplateDiffer{ctrl: ctrl}
+	mock.recorder = &MocktemplateDifferMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockstackExistChecker) EXPECT() *MockstackExistCheckerMockRecorder {
+func (m *MocktemplateDiffer) EXPECT() *MocktemplateDifferMockRecorder {
 	return m.recorder
 }
 
-// Exists mocks base method.
-func (m *MockstackExistChecker) Exists(arg0 string) (bool, error) {
+// DeployDiff mocks base method.
+func (m *MocktemplateDiffer) DeployDiff(inTmpl string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Exists", arg0)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Exists indicates an expected call of Exists.
-func (mr *MockstackExistCheckerMockRecorder) Exists(arg0 interface{}) *gomock.Call {
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MocktemplateDifferMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockstackExistChecker)(nil).Exists), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MocktemplateDiffer)(nil).DeployDiff), inTmpl)
 }
 
-// MockrunningTaskSelector is a mock of runningTaskSelector interface.
-type MockrunningTaskSelector struct {
+// MockconfigDiffer is a mock of configDiffer interface.
+type MockconfigDiffer struct {
 	ctrl     *gomock.Controller
-	recorder *MockrunningTaskSelectorMockRecorder
+	recorder *MockconfigDifferMockRecorder
 }
 
-// MockrunningTaskSelectorMockRecorder is the mock recorder for MockrunningTaskSelector.
-type MockrunningTaskSelectorMockRecorder struct {
-	mock *MockrunningTaskSelector
+// MockconfigDifferMockRecorder is the mock recorder for MockconfigDiffer.
+type MockconfigDifferMockRecorder struct {
+	mock *MockconfigDiffer
 }
 
-// NewMockrunningTaskSelector creates a new mock instance.
-func NewMockrunningTaskSelector(ctrl *gomock.Controller) *MockrunningTaskSelector {
-	mock := &MockrunningTaskSelector{ctrl: ctrl}
-	mock.recorder = &MockrunningTaskSelectorMockRecorder{mock}
+// NewMockconfigDiffer creates a new mock instance.
+func NewMockconfigDiffer(ctrl *gomock.Controller) *MockconfigDiffer {
+	mock := &MockconfigDiffer{ctrl: ctrl}
+	mock.recorder = &MockconfigDifferMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockrunningTaskSelector) EXPECT() *MockrunningTaskSelectorMockRecorder {
+func (m *MockconfigDiffer) EXPECT() *MockconfigDifferMockRecorder {
 	return m.recorder
 }
 
-// RunningTask mocks base method.
-func (m *MockrunningTaskSelector) RunningTask(prompt, help string, opts ...selector.TaskOpts) (*ecs.Task, error) {
+// ConfigDiff mocks base method.
+func (m *MockconfigDiffer) ConfigDiff(tmpl, params string) (string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "RunningTask", varargs...)
-	ret0, _ := ret[0].(*ecs.Task)
+	ret := m.ctrl.Call(m, "ConfigDiff", tmpl, params)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunningTask indicates an expected call of RunningTask.
-func (mr *MockrunningTaskSelectorMockRecorder) RunningTask(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+// ConfigDiff indicates an expected call of ConfigDiff.
+func (mr *MockconfigDifferMockRecorder) ConfigDiff(tmpl, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTask", reflect.TypeOf((*MockrunningTaskSelector)(nil).RunningTask), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigDiff", reflect.TypeOf((*MockconfigDiffer)(nil).ConfigDiff), tmpl, params)
 }
 
-// MockdockerEngine is a mock of dockerEngine interface.
-type MockdockerEngine struct {
+// MockvariablesDiffer is a mock of variablesDiffer interface.
+type MockvariablesDiffer struct {
 	ctrl     *gomock.Controller
-	recorder *MockdockerEngineMockRecorder
+	recorder *MockvariablesDifferMockRecorder
 }
 
-// MockdockerEngineMockRecorder is the mock recorder for MockdockerEngine.
-type MockdockerEngineMockRecorder struct {
-	mock *MockdockerEngine
+// MockvariablesDifferMockRecorder is the mock recorder for MockvariablesDiffer.
+type MockvariablesDifferMockRecorder struct {
+	mock *MockvariablesDiffer
 }
 
-// NewMockdockerEngine creates a new mock instance.
-func NewMockdockerEngine(ctrl *gomock.Controller) *MockdockerEngine {
-	mock := &MockdockerEngine{ctrl: ctrl}
-	mock.recorder = &MockdockerEngineMockRecorder{mock}
+// NewMockvariablesDiffer creates a new mock instance.
+func NewMockvariablesDiffer(ctrl *gomock.Controller) *MockvariablesDiffer {
+	mock := &MockvariablesDiffer{ctrl: ctrl}
+	mock.recorder = &MockvariablesDifferMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdockerEngine) EXPECT() *MockdockerEngineMockRecorder {
+func (m *MockvariablesDiffer) EXPECT() *MockvariablesDifferMockRecorder {
 	return m.recorder
 }
 
-// CheckDockerEngineRunning mocks base method.
-func (m *MockdockerEngine) CheckDockerEngineRunning() error {
+// VariablesDiff mocks base method.
+func (m *MockvariablesDiffer) VariablesDiff(tmpl, params string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "VariablesDiff", tmpl, params)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
-func (mr *MockdockerEngineMockRecorder) CheckDockerEngineRunning() *gomock.Call {
+// VariablesDiff indicates an expected call of VariablesDiff.
+func (mr *MockvariablesDifferMockRecorder) VariablesDiff(tmpl, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockdockerEngine)(nil).CheckDockerEngineRunning))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VariablesDiff", reflect.TypeOf((*MockvariablesDiffer)(nil).VariablesDiff), tmpl, params)
 }
 
-// GetPlatform mocks base method.
-func (m *MockdockerEngine) GetPlatform() (string, string, error) {
+// MockcostEstimator is a mock of costEstimator interface.
+type MockcostEstimator struct {
+	ctrl     *gomock.Controller
+	recorder *MockcostEstimatorMockRecorder
+}
+
+// MockcostEstimatorMockRecorder is the mock recorder for MockcostEstimator.
+type MockcostEstimatorMockRecorder struct {
+	mock *MockcostEstimator
+}
+
+// NewMockcostEstimator creates a new mock instance.
+func NewMockcostEstimator(ctrl *gomock.Controller) *MockcostEstimator {
+	mock := &MockcostEstimator{ctrl: ctrl}
+	mock.recorder = &MockcostEstimatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcostEstimator) EXPECT() *MockcostEstimatorMockRecorder {
+	return m.recorder
+}
+
+// EstimateCost mocks base method.
+func (m *MockcostEstimator) EstimateCost(tmpl, params string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPlatform")
+	ret := m.ctrl.Call(m, "EstimateCost", tmpl, params)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(string)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetPlatform indicates an expected call of GetPlatform.
-func (mr *MockdockerEngineMockRecorder) GetPlatform() *gomock.Call {
+// EstimateCost indicates an expected call of EstimateCost.
+func (mr *MockcostEstimatorMockRecorder) EstimateCost(tmpl, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatform", reflect.TypeOf((*MockdockerEngine)(nil).GetPlatform))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateCost", reflect.TypeOf((*MockcostEstimator)(nil).EstimateCost), tmpl, params)
 }
 
-// Mockcodestar is a mock of codestar interface.
-type Mockcodestar struct {
+// MockdockerNetworkManager is a mock of dockerNetworkManager interface.
+type MockdockerNetworkManager struct {
 	ctrl     *gomock.Controller
-	recorder *MockcodestarMockRecorder
+	recorder *MockdockerNetworkManagerMockRecorder
 }
 
-// MockcodestarMockRecorder is the mock recorder for Mockcodestar.
-type MockcodestarMockRecorder struct {
-	mock *Mockcodestar
+// MockdockerNetworkManagerMockRecorder is the mock recorder for MockdockerNetworkManager.
+type MockdockerNetworkManagerMockRecorder struct {
+	mock *MockdockerNetworkManager
 }
 
-// NewMockcodestar creates a new mock instance.
-func NewMockcodestar(ctrl *gomock.Controller) *Mockcodestar {
-	mock := &Mockcodestar{ctrl: ctrl}
-	mock.recorder = &MockcodestarMockRecorder{mock}
+// NewMockdockerNetworkManager creates a new mock instance.
+func NewMockdockerNetworkManager(ctrl *gomock.Controller) *MockdockerNetworkManager {
+	mock := &MockdockerNetworkManager{ctrl: ctrl}
+	mock.recorder = &MockdockerNetworkManagerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockcodestar) EXPECT() *MockcodestarMockRecorder {
+func (m *MockdockerNetworkManager) EXPECT() *MockdockerNetworkManagerMockRecorder {
 	return m.recorder
 }
 
-// GetConnectionARN mocks base method.
-func (m *Mockcodestar) GetConnectionARN(arg0 string) (string, error) {
+// CreateNetwork mocks base method.
+func (m *MockdockerNetworkManager) CreateNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MockdockerNetworkManagerMockRecorder) CreateNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockdockerNetworkManager)(nil).CreateNetwork), name)
+}
+
+// RemoveNetwork mocks base method.
+func (m *MockdockerNetworkManager) RemoveNetwork(name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetConnectionARN", arg0)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RemoveNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetConnectionARN indicates an expected call of GetConnectionARN.
-func (mr *MockcodestarMockRecorder) GetConnectionARN(arg0 interface{}) *gomock.Call {
+// RemoveNetwork indicates an expected call of RemoveNetwork.
+func (mr *MockdockerNetworkManagerMockRecorder) RemoveNetwork(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionARN", reflect.TypeOf((*Mockcodestar)(nil).GetConnectionARN), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetwork", reflect.TypeOf((*MockdockerNetworkManager)(nil).RemoveNetwork), name)
 }
 
-// MockpublicIPGetter is a mock of publicIPGetter interface.
-type MockpublicIPGetter struct {
+// MockdockerEngineRunner is a mock of dockerEngineRunner interface.
+type MockdockerEngineRunner struct {
 	ctrl     *gomock.Controller
-	recorder *MockpublicIPGetterMockRecorder
+	recorder *MockdockerEngineRunnerMockRecorder
 }
 
-// MockpublicIPGetterMockRecorder is the mock recorder for MockpublicIPGetter.
-type MockpublicIPGetterMockRecorder struct {
-	mock *MockpublicIPGetter
+// MockdockerEngineRunnerMockRecorder is the mock recorder for MockdockerEngineRunner.
+type MockdockerEngineRunnerMockRecorder struct {
+	mock *MockdockerEngineRunner
 }
 
-// NewMockpublicIPGetter creates a new mock instance.
-func NewMockpublicIPGetter(ctrl *gomock.Controller) *MockpublicIPGetter {
-	mock := &MockpublicIPGetter{ctrl: ctrl}
-	mock.recorder = &MockpublicIPGetterMockRecorder{mock}
+// NewMockdockerEngineRunner creates a new mock instance.
+func NewMockdockerEngineRunner(ctrl *gomock.Controller) *MockdockerEngineRunner {
+	mock := &MockdockerEngineRunner{ctrl: ctrl}
+	mock.recorder = &MockdockerEngineRunnerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpublicIPGetter) EXPECT() *MockpublicIPGetterMockRecorder {
+func (m *MockdockerEngineRunner) EXPECT() *MockdockerEngineRunnerMockRecorder {
 	return m.recorder
 }
 
-// PublicIP mocks base method.
-func (m *MockpublicIPGetter) PublicIP(ENI string) (string, error) {
+// AnalyzeBuildContext mocks base method.
+func (m *MockdockerEngineRunner) AnalyzeBuildContext(contextDir, dockerfile string) (*dockerengine.BuildContext, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublicIP", ENI)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "AnalyzeBuildContext", contextDir, dockerfile)
+	ret0, _ := ret[0].(*dockerengine.BuildContext)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PublicIP indicates an expected call of PublicIP.
-func (mr *MockpublicIPGetterMockRecorder) PublicIP(ENI interface{}) *gomock.Call {
+// AnalyzeBuildContext indicates an expected call of AnalyzeBuildContext.
+func (mr *MockdockerEngineRunnerMockRecorder) AnalyzeBuildContext(contextDir, dockerfile interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIP", reflect.TypeOf((*MockpublicIPGetter)(nil).PublicIP), ENI)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeBuildContext", reflect.TypeOf((*MockdockerEngineRunner)(nil).AnalyzeBuildContext), contextDir, dockerfile)
 }
 
-// MockcliStringer is a mock of cliStringer interface.
-type MockcliStringer struct {
-	ctrl     *gomock.Controller
-	recorder *MockcliStringerMockRecorder
+// BuildContextDigest mocks base method.
+func (m *MockdockerEngineRunner) BuildContextDigest(contextDir, dockerfile string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildContextDigest", contextDir, dockerfile)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockcliStringerMockRecorder is the mock recorder for MockcliStringer.
-type MockcliStringerMockRecorder struct {
-	mock *MockcliStringer
+// BuildContextDigest indicates an expected call of BuildContextDigest.
+func (mr *MockdockerEngineRunnerMockRecorder) BuildContextDigest(contextDir, dockerfile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildContextDigest", reflect.TypeOf((*MockdockerEngineRunner)(nil).BuildContextDigest), contextDir, dockerfile)
 }
 
-// NewMockcliStringer creates a new mock instance.
-func NewMockcliStringer(ctrl *gomock.Controller) *MockcliStringer {
-	mock := &MockcliStringer{ctrl: ctrl}
-	mock.recorder = &MockcliStringerMockRecorder{mock}
-	return mock
+// ContainerState mocks base method.
+func (m *MockdockerEngineRunner) ContainerState(ctx context.Context, containerName string) (dockerengine.ContainerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerState", ctx, containerName)
+	ret0, _ := ret[0].(dockerengine.ContainerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcliStringer) EXPECT() *MockcliStringerMockRecorder {
-	return m.recorder
+// ContainerState indicates an expected call of ContainerState.
+func (mr *MockdockerEngineRunnerMockRecorder) ContainerState(ctx, containerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerState", reflect.TypeOf((*MockdockerEngineRunner)(nil).ContainerState), ctx, containerName)
 }
 
-// CLIString mocks base method.
-func (m *MockcliStringer) CLIString() (string, error) {
+// CopyToContainer mocks base method.
+func (m *MockdockerEngineRunner) CopyToContainer(ctx context.Context, src, container, dst string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CLIString")
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CopyToContainer", ctx, src, container, dst)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CLIString indicates an expected call of CLIString.
-func (mr *MockcliStringerMockRecorder) CLIString() *gomock.Call {
+// CopyToContainer indicates an expected call of CopyToContainer.
+func (mr *MockdockerEngineRunnerMockRecorder) CopyToContainer(ctx, src, container, dst interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CLIString", reflect.TypeOf((*MockcliStringer)(nil).CLIString))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyToContainer", reflect.TypeOf((*MockdockerEngineRunner)(nil).CopyToContainer), ctx, src, container, dst)
 }
 
-// MocksecretPutter is a mock of secretPutter interface.
-type MocksecretPutter struct {
-	ctrl     *gomock.Controller
-	recorder *MocksecretPutterMockRecorder
+// Exec mocks base method.
+func (m *MockdockerEngineRunner) Exec(ctx context.Context, container string, w io.Writer, cmd string, args ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, container, w, cmd}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Exec", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MocksecretPutterMockRecorder is the mock recorder for MocksecretPutter.
-type MocksecretPutterMockRecorder struct {
-	mock *MocksecretPutter
+// Exec indicates an expected call of Exec.
+func (mr *MockdockerEngineRunnerMockRecorder) Exec(ctx, container, w, cmd interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, container, w, cmd}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockdockerEngineRunner)(nil).Exec), varargs...)
 }
 
-// NewMocksecretPutter creates a new mock instance.
-func NewMocksecretPutter(ctrl *gomock.Controller) *MocksecretPutter {
-	mock := &MocksecretPutter{ctrl: ctrl}
-	mock.recorder = &MocksecretPutterMockRecorder{mock}
-	return mock
+// GetPlatform mocks base method.
+func (m *MockdockerEngineRunner) GetPlatform() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlatform")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocksecretPutter) EXPECT() *MocksecretPutterMockRecorder {
-	return m.recorder
+// GetPlatform indicates an expected call of GetPlatform.
+func (mr *MockdockerEngineRunnerMockRecorder) GetPlatform() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatform", reflect.TypeOf((*MockdockerEngineRunner)(nil).GetPlatform))
 }
 
-// PutSecret mocks base method.
-func (m *MocksecretPutter) PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error) {
+// IsContainerRunning mocks base method.
+func (m *MockdockerEngineRunner) IsContainerRunning(arg0 string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutSecret", in)
-	ret0, _ := ret[0].(*ssm.PutSecretOutput)
+	ret := m.ctrl.Call(m, "IsContainerRunning", arg0)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PutSecret indicates an expected call of PutSecret.
-func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
+// IsContainerRunning indicates an expected call of IsContainerRunning.
+func (mr *MockdockerEngineRunnerMockRecorder) IsContainerRunning(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsContainerRunning", reflect.TypeOf((*MockdockerEngineRunner)(nil).IsContainerRunning), arg0)
 }
 
-// MockservicePauser is a mock of servicePauser interface.
-type MockservicePauser struct {
-	ctrl     *gomock.Controller
-	recorder *MockservicePauserMockRecorder
+// Kill mocks base method.
+func (m *MockdockerEngineRunner) Kill(ctx context.Context, container, signal string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Kill", ctx, container, signal)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockservicePauserMockRecorder is the mock recorder for MockservicePauser.
-type MockservicePauserMockRecorder struct {
-	mock *MockservicePauser
+// Kill indicates an expected call of Kill.
+func (mr *MockdockerEngineRunnerMockRecorder) Kill(ctx, container, signal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Kill", reflect.TypeOf((*MockdockerEngineRunner)(nil).Kill), ctx, container, signal)
 }
 
-// NewMockservicePauser creates a new mock instance.
-func NewMockservicePauser(ctrl *gomock.Controller) *MockservicePauser {
-	mock := &MockservicePauser{ctrl: ctrl}
-	mock.recorder = &MockservicePauserMockRecorder{mock}
-	return mock
+// Preflight mocks base method.
+func (m *MockdockerEngineRunner) Preflight(platforms []string) ([]dockerengine.PreflightWarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preflight", platforms)
+	ret0, _ := ret[0].([]dockerengine.PreflightWarning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockservicePauser) EXPECT() *MockservicePauserMockRecorder {
-	return m.recorder
+// Preflight indicates an expected call of Preflight.
+func (mr *MockdockerEngineRunnerMockRecorder) Preflight(platforms interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preflight", reflect.TypeOf((*MockdockerEngineRunner)(nil).Preflight), platforms)
 }
 
-// PauseService mocks base method.
-func (m *MockservicePauser) PauseService(svcARN string) error {
+// Rm mocks base method.
+func (m *MockdockerEngineRunner) Rm(arg0 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PauseService", svcARN)
+	ret := m.ctrl.Call(m, "Rm", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PauseService indicates an expected call of PauseService.
-func (mr *MockservicePauserMockRecorder) PauseService(svcARN interface{}) *gomock.Call {
+// Rm indicates an expected call of Rm.
+func (mr *MockdockerEngineRunnerMockRecorder) Rm(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockservicePauser)(nil).PauseService), svcARN)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rm", reflect.TypeOf((*MockdockerEngineRunner)(nil).Rm), arg0)
 }
 
-// Mockinterpolator is a mock of interpolator interface.
-type Mockinterpolator struct {
-	ctrl     *gomock.Controller
-	recorder *MockinterpolatorMockRecorder
+// Run mocks base method.
+func (m *MockdockerEngineRunner) Run(arg0 context.Context, arg1 *dockerengine.RunOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockinterpolatorMockRecorder is the mock recorder for Mockinterpolator.
-type MockinterpolatorMockRecorder struct {
-	mock *Mockinterpolator
+// Run indicates an expected call of Run.
+func (mr *MockdockerEngineRunnerMockRecorder) Run(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockdockerEngineRunner)(nil).Run), arg0, arg1)
 }
 
-// NewMockinterpolator creates a new mock instance.
-func NewMockinterpolator(ctrl *gomock.Controller) *Mockinterpolator {
-	mock := &Mockinterpolator{ctrl: ctrl}
-	mock.recorder = &MockinterpolatorMockRecorder{mock}
-	return mock
+// RunningContainers mocks base method.
+func (m *MockdockerEngineRunner) RunningContainers(ctx context.Context, label string) ([]dockerengine.RunningContainer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningContainers", ctx, label)
+	ret0, _ := ret[0].([]dockerengine.RunningContainer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockinterpolator) EXPECT() *MockinterpolatorMockRecorder {
-	return m.recorder
+// RunningContainers indicates an expected call of RunningContainers.
+func (mr *MockdockerEngineRunnerMockRecorder) RunningContainers(ctx, label interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningContainers", reflect.TypeOf((*MockdockerEngineRunner)(nil).RunningContainers), ctx, label)
 }
 
-// Interpolate mocks base method.
-func (m *Mockinterpolator) Interpolate(s string) (string, error) {
+// Stop mocks base method.
+func (m *MockdockerEngineRunner) Stop(arg0 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Interpolate", s)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Stop", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Interpolate indicates an expected call of Interpolate.
-func (mr *MockinterpolatorMockRecorder) Interpolate(s interface{}) *gomock.Call {
+// Stop indicates an expected call of Stop.
+func (mr *MockdockerEngineRunnerMockRecorder) Stop(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interpolate", reflect.TypeOf((*Mockinterpolator)(nil).Interpolate), s)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockdockerEngineRunner)(nil).Stop), arg0)
 }
 
-// MockworkloadDeployer is a mock of workloadDeployer interface.
-type MockworkloadDeployer struct {
+// MockrunningContainerLister is a mock of runningContainerLister interface.
+type MockrunningContainerLister struct {
 	ctrl     *gomock.Controller
-	recorder *MockworkloadDeployerMockRecorder
+	recorder *MockrunningContainerListerMockRecorder
 }
 
-// MockworkloadDeployerMockRecorder is the mock recorder for MockworkloadDeployer.
-type MockworkloadDeployerMockRecorder struct {
-	mock *MockworkloadDeployer
+// MockrunningContainerListerMockRecorder is the mock recorder for MockrunningContainerLister.
+type MockrunningContainerListerMockRecorder struct {
+	mock *MockrunningContainerLister
 }
 
-// NewMockworkloadDeployer creates a new mock instance.
-func NewMockworkloadDeployer(ctrl *gomock.Controller) *MockworkloadDeployer {
-	mock := &MockworkloadDeployer{ctrl: ctrl}
-	mock.recorder = &MockworkloadDeployerMockRecorder{mock}
+// NewMockrunningContainerLister creates a new mock instance.
+func NewMockrunningContainerLister(ctrl *gomock.Controller) *MockrunningContainerLister {
+	mock := &MockrunningContainerLister{ctrl: ctrl}
+	mock.recorder = &MockrunningContainerListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockworkloadDeployer) EXPECT() *MockworkloadDeployerMockRecorder {
+func (m *MockrunningContainerLister) EXPECT() *MockrunningContainerListerMockRecorder {
 	return m.recorder
 }
 
-// DeployDiff mocks base method.
-func (m *MockworkloadDeployer) DeployDiff(inTmpl string) (string, error) {
+// RunningContainers mocks base method.
+func (m *MockrunningContainerLister) RunningContainers(ctx context.Context, label string) ([]dockerengine.RunningContainer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "RunningContainers", ctx, label)
+	ret0, _ := ret[0].([]dockerengine.RunningContainer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockworkloadDeployerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+// RunningContainers indicates an expected call of RunningContainers.
+func (mr *MockrunningContainerListerMockRecorder) RunningContainers(ctx, label interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployDiff), inTmpl)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningContainers", reflect.TypeOf((*MockrunningContainerLister)(nil).RunningContainers), ctx, label)
 }
 
-// DeployWorkload mocks base method.
-func (m *MockworkloadDeployer) DeployWorkload(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployWorkload", in)
-	ret0, _ := ret[0].(deploy.ActionRecommender)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MocklocalContainerExecutor is a mock of localContainerExecutor interface.
+type MocklocalContainerExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MocklocalContainerExecutorMockRecorder
 }
 
-// DeployWorkload indicates an expected call of DeployWorkload.
-func (mr *MockworkloadDeployerMockRecorder) DeployWorkload(in interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployWorkload", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployWorkload), in)
+// MocklocalContainerExecutorMockRecorder is the mock recorder for MocklocalContainerExecutor.
+type MocklocalContainerExecutorMockRecorder struct {
+	mock *MocklocalContainerExecutor
 }
 
-// GenerateCloudFormationTemplate mocks base method.
-func (m *MockworkloadDeployer) GenerateCloudFormationTemplate(in *deploy.GenerateCloudFormationTemplateInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+// NewMocklocalContainerExecutor creates a new mock instance.
+func NewMocklocalContainerExecutor(ctrl *gomock.Controller) *MocklocalContainerExecutor {
+	mock := &MocklocalContainerExecutor{ctrl: ctrl}
+	mock.recorder = &MocklocalContainerExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklocalContainerExecutor) EXPECT() *MocklocalContainerExecutorMockRecorder {
+	return m.recorder
+}
+
+// InteractiveExec mocks base method.
+func (m *MocklocalContainerExecutor) InteractiveExec(ctx context.Context, container string, cmd []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
-	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "InteractiveExec", ctx, container, cmd)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
-func (mr *MockworkloadDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
+// InteractiveExec indicates an expected call of InteractiveExec.
+func (mr *MocklocalContainerExecutorMockRecorder) InteractiveExec(ctx, container, cmd interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockworkloadDeployer)(nil).GenerateCloudFormationTemplate), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InteractiveExec", reflect.TypeOf((*MocklocalContainerExecutor)(nil).InteractiveExec), ctx, container, cmd)
 }
 
-// IsServiceAvailableInRegion mocks base method.
-func (m *MockworkloadDeployer) IsServiceAvailableInRegion(region string) (bool, error) {
+// IsContainerRunning mocks base method.
+func (m *MocklocalContainerExecutor) IsContainerRunning(arg0 string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsServiceAvailableInRegion", region)
+	ret := m.ctrl.Call(m, "IsContainerRunning", arg0)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsServiceAvailableInRegion indicates an expected call of IsServiceAvailableInRegion.
-func (mr *MockworkloadDeployerMockRecorder) IsServiceAvailableInRegion(region interface{}) *gomock.Call {
+// IsContainerRunning indicates an expected call of IsContainerRunning.
+func (mr *MocklocalContainerExecutorMockRecorder) IsContainerRunning(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsServiceAvailableInRegion", reflect.TypeOf((*MockworkloadDeployer)(nil).IsServiceAvailableInRegion), region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsContainerRunning", reflect.TypeOf((*MocklocalContainerExecutor)(nil).IsContainerRunning), arg0)
 }
 
-// UploadArtifacts mocks base method.
-func (m *MockworkloadDeployer) UploadArtifacts() (*deploy.UploadArtifactsOutput, error) {
+// RunningContainers mocks base method.
+func (m *MocklocalContainerExecutor) RunningContainers(ctx context.Context, label string) ([]dockerengine.RunningContainer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadArtifacts")
-	ret0, _ := ret[0].(*deploy.UploadArtifactsOutput)
+	ret := m.ctrl.Call(m, "RunningContainers", ctx, label)
+	ret0, _ := ret[0].([]dockerengine.RunningContainer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UploadArtifacts indicates an expected call of UploadArtifacts.
-func (mr *MockworkloadDeployerMockRecorder) UploadArtifacts() *gomock.Call {
+// RunningContainers indicates an expected call of RunningContainers.
+func (mr *MocklocalContainerExecutorMockRecorder) RunningContainers(ctx, label interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadDeployer)(nil).UploadArtifacts))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningContainers", reflect.TypeOf((*MocklocalContainerExecutor)(nil).RunningContainers), ctx, label)
 }
 
-// MocktemplateDiffer is a mock of templateDiffer interface.
-type MocktemplateDiffer struct {
+// MocklocalContainerInspector is a mock of localContainerInspector interface.
+type MocklocalContainerInspector struct {
 	ctrl     *gomock.Controller
-	recorder *MocktemplateDifferMockRecorder
+	recorder *MocklocalContainerInspectorMockRecorder
 }
 
-// MocktemplateDifferMockRecorder is the mock recorder for MocktemplateDiffer.
-type MocktemplateDifferMockRecorder struct {
-	mock *MocktemplateDiffer
+// MocklocalContainerInspectorMockRecorder is the mock recorder for MocklocalContainerInspector.
+type MocklocalContainerInspectorMockRecorder struct {
+	mock *MocklocalContainerInspector
 }
 
-// NewMocktemplateDiffer creates a new mock instance.
-func NewMocktemplateDiffer(ctrl *gomock.Controller) *MocktemplateDiffer {
-	mock := &MocktemplateDiffer{ctrl: ctrl}
-	mock.recorder = &MocktemplateDifferMockRecorder{mock}
+// NewMocklocalContainerInspector creates a new mock instance.
+func NewMocklocalContainerInspector(ctrl *gomock.Controller) *MocklocalContainerInspector {
+	mock := &MocklocalContainerInspector{ctrl: ctrl}
+	mock.recorder = &MocklocalContainerInspectorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktemplateDiffer) EXPECT() *MocktemplateDifferMockRecorder {
+func (m *MocklocalContainerInspector) EXPECT() *MocklocalContainerInspectorMockRecorder {
 	return m.recorder
 }
 
-// DeployDiff mocks base method.
-func (m *MocktemplateDiffer) DeployDiff(inTmpl string) (string, error) {
+// ContainerState mocks base method.
+func (m *MocklocalContainerInspector) ContainerState(ctx context.Context, containerName string) (dockerengine.ContainerState, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ContainerState", ctx, containerName)
+	ret0, _ := ret[0].(dockerengine.ContainerState)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeployDiff indicates an expected call of DeployDiff.
-func (mr *MocktemplateDifferMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+// ContainerState indicates an expected call of ContainerState.
+func (mr *MocklocalContainerInspectorMockRecorder) ContainerState(ctx, containerName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MocktemplateDiffer)(nil).DeployDiff), inTmpl)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerState", reflect.TypeOf((*MocklocalContainerInspector)(nil).ContainerState), ctx, containerName)
 }
 
-// MockdockerEngineRunner is a mock of dockerEngineRunner interface.
-type MockdockerEngineRunner struct {
+// MocklocalContainerCleaner is a mock of localContainerCleaner interface.
+type MocklocalContainerCleaner struct {
 	ctrl     *gomock.Controller
-	recorder *MockdockerEngineRunnerMockRecorder
+	recorder *MocklocalContainerCleanerMockRecorder
 }
 
-// MockdockerEngineRunnerMockRecorder is the mock recorder for MockdockerEngineRunner.
-type MockdockerEngineRunnerMockRecorder struct {
-	mock *MockdockerEngineRunner
+// MocklocalContainerCleanerMockRecorder is the mock recorder for MocklocalContainerCleaner.
+type MocklocalContainerCleanerMockRecorder struct {
+	mock *MocklocalContainerCleaner
 }
 
-// NewMockdockerEngineRunner creates a new mock instance.
-func NewMockdockerEngineRunner(ctrl *gomock.Controller) *MockdockerEngineRunner {
-	mock := &MockdockerEngineRunner{ctrl: ctrl}
-	mock.recorder = &MockdockerEngineRunnerMockRecorder{mock}
+// NewMocklocalContainerCleaner creates a new mock instance.
+func NewMocklocalContainerCleaner(ctrl *gomock.Controller) *MocklocalContainerCleaner {
+	mock := &MocklocalContainerCleaner{ctrl: ctrl}
+	mock.recorder = &MocklocalContainerCleanerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdockerEngineRunner) EXPECT() *MockdockerEngineRunnerMockRecorder {
+func (m *MocklocalContainerCleaner) EXPECT() *MocklocalContainerCleanerMockRecorder {
 	return m.recorder
 }
 
-// CheckDockerEngineRunning mocks base method.
-func (m *MockdockerEngineRunner) CheckDockerEngineRunning() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
-func (mr *MockdockerEngineRunnerMockRecorder) CheckDockerEngineRunning() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockdockerEngineRunner)(nil).CheckDockerEngineRunning))
-}
-
-// IsContainerRunning mocks base method.
-func (m *MockdockerEngineRunner) IsContainerRunning(arg0 string) (bool, error) {
+// ContainerState mocks base method.
+func (m *MocklocalContainerCleaner) ContainerState(ctx context.Context, containerName string) (dockerengine.ContainerState, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsContainerRunning", arg0)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "ContainerState", ctx, containerName)
+	ret0, _ := ret[0].(dockerengine.ContainerState)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsContainerRunning indicates an expected call of IsContainerRunning.
-func (mr *MockdockerEngineRunnerMockRecorder) IsContainerRunning(arg0 interface{}) *gomock.Call {
+// ContainerState indicates an expected call of ContainerState.
+func (mr *MocklocalContainerCleanerMockRecorder) ContainerState(ctx, containerName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsContainerRunning", reflect.TypeOf((*MockdockerEngineRunner)(nil).IsContainerRunning), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerState", reflect.TypeOf((*MocklocalContainerCleaner)(nil).ContainerState), ctx, containerName)
 }
 
-// Rm mocks base method.
-func (m *MockdockerEngineRunner) Rm(arg0 string) error {
+// RemoveNetwork mocks base method.
+func (m *MocklocalContainerCleaner) RemoveNetwork(name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Rm", arg0)
+	ret := m.ctrl.Call(m, "RemoveNetwork", name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Rm indicates an expected call of Rm.
-func (mr *MockdockerEngineRunnerMockRecorder) Rm(arg0 interface{}) *gomock.Call {
+// RemoveNetwork indicates an expected call of RemoveNetwork.
+func (mr *MocklocalContainerCleanerMockRecorder) RemoveNetwork(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rm", reflect.TypeOf((*MockdockerEngineRunner)(nil).Rm), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetwork", reflect.TypeOf((*MocklocalContainerCleaner)(nil).RemoveNetwork), name)
 }
 
-// Run mocks base method.
-func (m *MockdockerEngineRunner) Run(arg0 context.Context, arg1 *dockerengine.RunOptions) error {
+// Rm mocks base method.
+func (m *MocklocalContainerCleaner) Rm(arg0 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Run", arg0, arg1)
+	ret := m.ctrl.Call(m, "Rm", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Run indicates an expected call of Run.
-func (mr *MockdockerEngineRunnerMockRecorder) Run(arg0, arg1 interface{}) *gomock.Call {
+// Rm indicates an expected call of Rm.
+func (mr *MocklocalContainerCleanerMockRecorder) Rm(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockdockerEngineRunner)(nil).Run), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rm", reflect.TypeOf((*MocklocalContainerCleaner)(nil).Rm), arg0)
 }
 
 // Stop mocks base method.
-func (m *MockdockerEngineRunner) Stop(arg0 string) error {
+func (m *MocklocalContainerCleaner) Stop(arg0 string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Stop", arg0)
 	ret0, _ := ret[0].(error)
@@ -7701,9 +10864,9 @@ func (m *MockdockerEngineRunner) Stop(arg0 string) error {
 }
 
 // Stop indicates an expected call of Stop.
-func (mr *MockdockerEngineRunnerMockRecorder) Stop(arg0 interface{}) *gomock.Call {
+func (mr *MocklocalContainerCleanerMockRecorder) Stop(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockdockerEngineRunner)(nil).Stop), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MocklocalContainerCleaner)(nil).Stop), arg0)
 }
 
 // MockworkloadStackGenerator is a mock of workloadStackGenerator interface.
@@ -7744,6 +10907,21 @@ func (mr *MockworkloadStackGeneratorMockRecorder) AddonsTemplate() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsTemplate", reflect.TypeOf((*MockworkloadStackGenerator)(nil).AddonsTemplate))
 }
 
+// ConfigDiff mocks base method.
+func (m *MockworkloadStackGenerator) ConfigDiff(tmpl, params string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigDiff", tmpl, params)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigDiff indicates an expected call of ConfigDiff.
+func (mr *MockworkloadStackGeneratorMockRecorder) ConfigDiff(tmpl, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigDiff", reflect.TypeOf((*MockworkloadStackGenerator)(nil).ConfigDiff), tmpl, params)
+}
+
 // DeployDiff mocks base method.
 func (m *MockworkloadStackGenerator) DeployDiff(inTmpl string) (string, error) {
 	m.ctrl.T.Helper()
@@ -7826,6 +11004,81 @@ func (mr *MockrunnerMockRecorder) Run() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*Mockrunner)(nil).Run))
 }
 
+// MockjobTaskRunner is a mock of jobTaskRunner interface.
+type MockjobTaskRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobTaskRunnerMockRecorder
+}
+
+// MockjobTaskRunnerMockRecorder is the mock recorder for MockjobTaskRunner.
+type MockjobTaskRunnerMockRecorder struct {
+	mock *MockjobTaskRunner
+}
+
+// NewMockjobTaskRunner creates a new mock instance.
+func NewMockjobTaskRunner(ctrl *gomock.Controller) *MockjobTaskRunner {
+	mock := &MockjobTaskRunner{ctrl: ctrl}
+	mock.recorder = &MockjobTaskRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobTaskRunner) EXPECT() *MockjobTaskRunnerMockRecorder {
+	return m.recorder
+}
+
+// RunTask mocks base method.
+func (m *MockjobTaskRunner) RunTask(envVars map[string]string) (*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunTask", envVars)
+	ret0, _ := ret[0].(*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunTask indicates an expected call of RunTask.
+func (mr *MockjobTaskRunnerMockRecorder) RunTask(envVars interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MockjobTaskRunner)(nil).RunTask), envVars)
+}
+
+// MocknonZeroExitCodeChecker is a mock of nonZeroExitCodeChecker interface.
+type MocknonZeroExitCodeChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MocknonZeroExitCodeCheckerMockRecorder
+}
+
+// MocknonZeroExitCodeCheckerMockRecorder is the mock recorder for MocknonZeroExitCodeChecker.
+type MocknonZeroExitCodeCheckerMockRecorder struct {
+	mock *MocknonZeroExitCodeChecker
+}
+
+// NewMocknonZeroExitCodeChecker creates a new mock instance.
+func NewMocknonZeroExitCodeChecker(ctrl *gomock.Controller) *MocknonZeroExitCodeChecker {
+	mock := &MocknonZeroExitCodeChecker{ctrl: ctrl}
+	mock.recorder = &MocknonZeroExitCodeCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknonZeroExitCodeChecker) EXPECT() *MocknonZeroExitCodeCheckerMockRecorder {
+	return m.recorder
+}
+
+// HasNonZeroExitCode mocks base method.
+func (m *MocknonZeroExitCodeChecker) HasNonZeroExitCode(taskARNs []string, cluster string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasNonZeroExitCode", taskARNs, cluster)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HasNonZeroExitCode indicates an expected call of HasNonZeroExitCode.
+func (mr *MocknonZeroExitCodeCheckerMockRecorder) HasNonZeroExitCode(taskARNs, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasNonZeroExitCode", reflect.TypeOf((*MocknonZeroExitCodeChecker)(nil).HasNonZeroExitCode), taskARNs, cluster)
+}
+
 // MockenvDeployer is a mock of envDeployer interface.
 type MockenvDeployer struct {
 	ctrl     *gomock.Controller
@@ -7975,6 +11228,21 @@ func (mr *MockenvPackagerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvPackager)(nil).DeployDiff), inTmpl)
 }
 
+// EstimateCost mocks base method.
+func (m *MockenvPackager) EstimateCost(tmpl, params string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateCost", tmpl, params)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateCost indicates an expected call of EstimateCost.
+func (mr *MockenvPackagerMockRecorder) EstimateCost(tmpl, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateCost", reflect.TypeOf((*MockenvPackager)(nil).EstimateCost), tmpl, params)
+}
+
 // GenerateCloudFormationTemplate mocks base method.
 func (m *MockenvPackager) GenerateCloudFormationTemplate(in *deploy.DeployEnvironmentInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
 	m.ctrl.T.Helper()
@@ -8152,3 +11420,220 @@ func (mr *MocksecretGetterMockRecorder) GetSecretValue(arg0, arg1 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MocksecretGetter)(nil).GetSecretValue), arg0, arg1)
 }
+
+// GetSecretValues mocks base method.
+func (m *MocksecretGetter) GetSecretValues(ctx context.Context, names []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretValues", ctx, names)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretValues indicates an expected call of GetSecretValues.
+func (mr *MocksecretGetterMockRecorder) GetSecretValues(ctx, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValues", reflect.TypeOf((*MocksecretGetter)(nil).GetSecretValues), ctx, names)
+}
+
+// MocksecretVersionGetter is a mock of secretVersionGetter interface.
+type MocksecretVersionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretVersionGetterMockRecorder
+}
+
+// MocksecretVersionGetterMockRecorder is the mock recorder for MocksecretVersionGetter.
+type MocksecretVersionGetterMockRecorder struct {
+	mock *MocksecretVersionGetter
+}
+
+// NewMocksecretVersionGetter creates a new mock instance.
+func NewMocksecretVersionGetter(ctrl *gomock.Controller) *MocksecretVersionGetter {
+	mock := &MocksecretVersionGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretVersionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretVersionGetter) EXPECT() *MocksecretVersionGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSecretVersionValue mocks base method.
+func (m *MocksecretVersionGetter) GetSecretVersionValue(ctx context.Context, name, versionStage, versionID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretVersionValue", ctx, name, versionStage, versionID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretVersionValue indicates an expected call of GetSecretVersionValue.
+func (mr *MocksecretVersionGetterMockRecorder) GetSecretVersionValue(ctx, name, versionStage, versionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretVersionValue", reflect.TypeOf((*MocksecretVersionGetter)(nil).GetSecretVersionValue), ctx, name, versionStage, versionID)
+}
+
+// MocktuiProgram is a mock of tuiProgram interface.
+type MocktuiProgram struct {
+	ctrl     *gomock.Controller
+	recorder *MocktuiProgramMockRecorder
+}
+
+// MocktuiProgramMockRecorder is the mock recorder for MocktuiProgram.
+type MocktuiProgramMockRecorder struct {
+	mock *MocktuiProgram
+}
+
+// NewMocktuiProgram creates a new mock instance.
+func NewMocktuiProgram(ctrl *gomock.Controller) *MocktuiProgram {
+	mock := &MocktuiProgram{ctrl: ctrl}
+	mock.recorder = &MocktuiProgramMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktuiProgram) EXPECT() *MocktuiProgramMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m_2 *MocktuiProgram) Run(m tui.Model) (tui.Model, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Run", m)
+	ret0, _ := ret[0].(tui.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MocktuiProgramMockRecorder) Run(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocktuiProgram)(nil).Run), m)
+}
+
+// Send mocks base method.
+func (m *MocktuiProgram) Send(msg tui.Msg) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Send", msg)
+}
+
+// Send indicates an expected call of Send.
+func (mr *MocktuiProgramMockRecorder) Send(msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MocktuiProgram)(nil).Send), msg)
+}
+
+// MocktopologyResolver is a mock of topologyResolver interface.
+type MocktopologyResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MocktopologyResolverMockRecorder
+}
+
+// MocktopologyResolverMockRecorder is the mock recorder for MocktopologyResolver.
+type MocktopologyResolverMockRecorder struct {
+	mock *MocktopologyResolver
+}
+
+// NewMocktopologyResolver creates a new mock instance.
+func NewMocktopologyResolver(ctrl *gomock.Controller) *MocktopologyResolver {
+	mock := &MocktopologyResolver{ctrl: ctrl}
+	mock.recorder = &MocktopologyResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktopologyResolver) EXPECT() *MocktopologyResolverMockRecorder {
+	return m.recorder
+}
+
+// Resolve mocks base method.
+func (m *MocktopologyResolver) Resolve(app string) (*topology.Topology, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", app)
+	ret0, _ := ret[0].(*topology.Topology)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MocktopologyResolverMockRecorder) Resolve(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MocktopologyResolver)(nil).Resolve), app)
+}
+
+// MockclientsConfigDescriber is a mock of clientsConfigDescriber interface.
+type MockclientsConfigDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientsConfigDescriberMockRecorder
+}
+
+// MockclientsConfigDescriberMockRecorder is the mock recorder for MockclientsConfigDescriber.
+type MockclientsConfigDescriberMockRecorder struct {
+	mock *MockclientsConfigDescriber
+}
+
+// NewMockclientsConfigDescriber creates a new mock instance.
+func NewMockclientsConfigDescriber(ctrl *gomock.Controller) *MockclientsConfigDescriber {
+	mock := &MockclientsConfigDescriber{ctrl: ctrl}
+	mock.recorder = &MockclientsConfigDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclientsConfigDescriber) EXPECT() *MockclientsConfigDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockclientsConfigDescriber) Describe() (*describe.ClientsConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.ClientsConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockclientsConfigDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockclientsConfigDescriber)(nil).Describe))
+}
+
+// MockappDashboardDescriber is a mock of appDashboardDescriber interface.
+type MockappDashboardDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockappDashboardDescriberMockRecorder
+}
+
+// MockappDashboardDescriberMockRecorder is the mock recorder for MockappDashboardDescriber.
+type MockappDashboardDescriberMockRecorder struct {
+	mock *MockappDashboardDescriber
+}
+
+// NewMockappDashboardDescriber creates a new mock instance.
+func NewMockappDashboardDescriber(ctrl *gomock.Controller) *MockappDashboardDescriber {
+	mock := &MockappDashboardDescriber{ctrl: ctrl}
+	mock.recorder = &MockappDashboardDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappDashboardDescriber) EXPECT() *MockappDashboardDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockappDashboardDescriber) Describe() (*describe.AppDashboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.AppDashboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockappDashboardDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockappDashboardDescriber)(nil).Describe))
+}
@@ -0,0 +1,162 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+// outputFormatVars holds the flags that extract fields from the JSON output of a command.
+type outputFormatVars struct {
+	format string // Go template executed with the JSON output.
+	query  string // JMESPath expression evaluated against the JSON output.
+}
+
+// outputFormatFuncs are the functions available in the templates of the --format flag, in addition to the built-in ones.
+var outputFormatFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	},
+	"join": func(elems []interface{}, sep string) string {
+		strs := make([]string, len(elems))
+		for i, elem := range elems {
+			strs[i] = fmt.Sprint(elem)
+		}
+		return strings.Join(strs, sep)
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// isSet returns true if the output of the command is formatted with a template or a query.
+func (v outputFormatVars) isSet() bool {
+	return v.format != "" || v.query != ""
+}
+
+// validate returns an error if both flags are set, or if the template or the query is invalid.
+func (v outputFormatVars) validate() error {
+	if v.format != "" && v.query != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", formatFlag, queryFlag)
+	}
+	if v.format != "" {
+		if _, err := v.template(); err != nil {
+			return fmt.Errorf("parse --%s template: %w", formatFlag, err)
+		}
+	}
+	if v.query != "" {
+		if _, err := jmespath.Compile(v.query); err != nil {
+			return fmt.Errorf("parse --%s expression: %w", queryFlag, err)
+		}
+	}
+	return nil
+}
+
+func (v outputFormatVars) template() (*template.Template, error) {
+	return template.New(formatFlag).Funcs(outputFormatFuncs).Option("missingkey=zero").Parse(v.format)
+}
+
+// writeJSON calls write, that writes the JSON output of the command, with w if no template or query is set.
+// Otherwise, it writes the JSON output formatted with the template, or the result of the query, to w.
+func (v outputFormatVars) writeJSON(w io.Writer, write func(w io.Writer) error) error {
+	if !v.isSet() {
+		return write(w)
+	}
+	buf := new(bytes.Buffer)
+	if err := write(buf); err != nil {
+		return err
+	}
+	if v.format != "" {
+		return v.writeTemplate(w, buf.Bytes())
+	}
+	return v.writeQuery(w, buf.Bytes())
+}
+
+func (v outputFormatVars) writeTemplate(w io.Writer, data []byte) error {
+	tpl, err := v.template()
+	if err != nil {
+		return fmt.Errorf("parse --%s template: %w", formatFlag, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber() // Write numbers as they are, instead of in the exponent format of large floats.
+	var out interface{}
+	if err := dec.Decode(&out); err != nil {
+		return fmt.Errorf("unmarshal JSON output: %w", err)
+	}
+	formatted := new(bytes.Buffer)
+	if err := tpl.Execute(formatted, out); err != nil {
+		return fmt.Errorf("execute --%s template: %w", formatFlag, err)
+	}
+	return writeLine(w, formatted.String())
+}
+
+func (v outputFormatVars) writeQuery(w io.Writer, data []byte) error {
+	var out interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return fmt.Errorf("unmarshal JSON output: %w", err)
+	}
+	result, err := jmespath.Search(v.query, out)
+	if err != nil {
+		return fmt.Errorf("evaluate --%s expression: %w", queryFlag, err)
+	}
+	switch result := result.(type) {
+	case nil:
+		return nil
+	case string:
+		// Write strings without quotes, like `jq -r`, so that scripts can use them as is.
+		return writeLine(w, result)
+	default:
+		b, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal --%s result: %w", queryFlag, err)
+		}
+		return writeLine(w, string(b))
+	}
+}
+
+func writeLine(w io.Writer, s string) error {
+	if !strings.HasSuffix(s, "\n") {
+		s += "\n"
+	}
+	_, err := io.WriteString(w, s)
+	return err
+}
+
+// formattedWriter writes to w as is if no template or query is set.
+// Otherwise, it buffers the JSON output written to it until flush is called.
+type formattedWriter struct {
+	format outputFormatVars
+	w      io.Writer
+	buf    bytes.Buffer
+}
+
+// Write writes p to the underlying writer, or to the buffer if the output is formatted.
+func (fw *formattedWriter) Write(p []byte) (int, error) {
+	if !fw.format.isSet() {
+		return fw.w.Write(p)
+	}
+	return fw.buf.Write(p)
+}
+
+// flush writes the buffered JSON output formatted with the template or the query.
+func (fw *formattedWriter) flush() error {
+	if !fw.format.isSet() {
+		return nil
+	}
+	defer fw.buf.Reset()
+	return fw.format.writeJSON(fw.w, func(w io.Writer) error {
+		_, err := fw.buf.WriteTo(w)
+		return err
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputFormatVars_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in outputFormatVars
+
+		wantedErr string
+	}{
+		"no flags": {},
+		"valid template": {
+			in: outputFormatVars{format: "{{.name}}"},
+		},
+		"valid query": {
+			in: outputFormatVars{query: "services[].name"},
+		},
+		"both flags": {
+			in:        outputFormatVars{format: "{{.name}}", query: "name"},
+			wantedErr: "cannot specify both --format and --query",
+		},
+		"invalid template": {
+			in:        outputFormatVars{format: "{{.name"},
+			wantedErr: "parse --format template: ",
+		},
+		"invalid query": {
+			in:        outputFormatVars{query: "services[."},
+			wantedErr: "parse --query expression: ",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+			if tc.wantedErr != "" {
+				require.Error(t, err)
+				require.True(t, strings.HasPrefix(err.Error(), tc.wantedErr), "got error %q", err.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestOutputFormatVars_writeJSON(t *testing.T) {
+	const data = `{"name":"api","type":"Load Balanced Web Service","count":1234567,"services":[{"name":"api"},{"name":"worker"}],"tags":{"team":"payments"}}` + "\n"
+	testCases := map[string]struct {
+		in    outputFormatVars
+		write func(w io.Writer) error
+
+		wanted    string
+		wantedErr error
+	}{
+		"writes JSON as is if no flag is set": {
+			wanted: data,
+		},
+		"formats with a template": {
+			in:     outputFormatVars{format: "{{.name}} {{.type}} {{.count}}"},
+			wanted: "api Load Balanced Web Service 1234567\n",
+		},
+		"formats with a template using functions": {
+			in:     outputFormatVars{format: `{{range .services}}{{upper .name}}{{println}}{{end}}{{json .tags}}`},
+			wanted: "API\nWORKER\n{\"team\":\"payments\"}\n",
+		},
+		"does not error on missing keys": {
+			in:     outputFormatVars{format: "{{.name}}:{{.missing}}"},
+			wanted: "api:<no value>\n",
+		},
+		"writes strings of a query without quotes": {
+			in:     outputFormatVars{query: "tags.team"},
+			wanted: "payments\n",
+		},
+		"writes other results of a query as JSON": {
+			in:     outputFormatVars{query: "services[].name"},
+			wanted: "[\n  \"api\",\n  \"worker\"\n]\n",
+		},
+		"writes nothing if the query doesn't match": {
+			in: outputFormatVars{query: "missing"},
+		},
+		"returns the error of write": {
+			in: outputFormatVars{query: "name"},
+			write: func(w io.Writer) error {
+				return errors.New("some error")
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns an error if the template fails": {
+			in:        outputFormatVars{format: `{{index .services 5}}`},
+			wantedErr: errors.New(`execute --format template: template: format:1:2: executing "format" at <index .services 5>: error calling index: index out of range: 5`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			write := tc.write
+			if write == nil {
+				write = func(w io.Writer) error {
+					fmt.Fprint(w, data)
+					return nil
+				}
+			}
+			b := new(strings.Builder)
+
+			err := tc.in.writeJSON(b, write)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
+
+func TestFormattedWriter(t *testing.T) {
+	t.Run("writes as is if no flag is set", func(t *testing.T) {
+		b := new(strings.Builder)
+		fw := &formattedWriter{w: b}
+
+		fmt.Fprint(fw, `{"name":"api"}`)
+		require.Equal(t, `{"name":"api"}`, b.String())
+		require.NoError(t, fw.flush())
+		require.Equal(t, `{"name":"api"}`, b.String())
+	})
+	t.Run("writes the formatted output on flush", func(t *testing.T) {
+		b := new(strings.Builder)
+		fw := &formattedWriter{
+			format: outputFormatVars{query: "services[].name | join(',', @)"},
+			w:      b,
+		}
+
+		fmt.Fprint(fw, `{"services":[{"name":"api"},`)
+		fmt.Fprint(fw, `{"name":"worker"}]}`)
+		require.Empty(t, b.String())
+		require.NoError(t, fw.flush())
+		require.Equal(t, "api,worker\n", b.String())
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/override"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -24,6 +25,8 @@ const (
 
 	// IaC toolkit configuration.
 	typescriptCDKLang = "typescript"
+	goCDKLang         = "go"
+	pythonCDKLang     = "python"
 )
 
 var validIaCTools = []string{
@@ -33,6 +36,8 @@ var validIaCTools = []string{
 
 var validCDKLangs = []string{
 	typescriptCDKLang,
+	goCDKLang,
+	pythonCDKLang,
 }
 
 type stringWriteCloser interface {
@@ -100,7 +105,7 @@ func (o *overrideOpts) Execute() error {
 	dir := o.dir()
 	switch o.iacTool {
 	case cdkIaCTool:
-		if err := override.ScaffoldWithCDK(o.fs, dir, o.resources, o.requiresEnv); err != nil {
+		if err := override.ScaffoldWithCDK(o.fs, dir, o.resources, o.requiresEnv, o.cdkLang); err != nil {
 			return fmt.Errorf("scaffold CDK application under %q: %v", dir, err)
 		}
 		log.Successf("Created a new CDK application at %q to override resources\n", displayPath(dir))
@@ -207,3 +212,11 @@ func (o *overrideOpts) askResourcesToOverride() error {
 	o.resources = resources
 	return nil
 }
+
+// overriderOptions returns the options to initialize the Overrider of "package" and "deploy" commands.
+func overriderOptions(noCache bool) []clideploy.OverriderOption {
+	if noCache {
+		return []clideploy.OverriderOption{clideploy.WithOverrideCacheDisabled()}
+	}
+	return nil
+}
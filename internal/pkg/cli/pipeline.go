@@ -23,6 +23,9 @@ Continuous delivery pipelines to release services.`,
 	cmd.AddCommand(buildPipelineOverrideCmd())
 	cmd.AddCommand(buildPipelineDeployCmd())
 	cmd.AddCommand(buildPipelineDeleteCmd())
+	cmd.AddCommand(buildPipelineGCCmd())
+	cmd.AddCommand(buildPipelineRollbackCmd())
+	cmd.AddCommand(buildPipelineTestCmd())
 	cmd.AddCommand(buildPipelineShowCmd())
 	cmd.AddCommand(buildPipelineStatusCmd())
 	cmd.AddCommand(buildPipelineListCmd())
@@ -6,12 +6,14 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
@@ -20,6 +22,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -54,6 +57,7 @@ type deletePipelineVars struct {
 	name               string
 	skipConfirmation   bool
 	shouldDeleteSecret bool
+	dryRun             bool
 }
 
 type deletePipelineOpts struct {
@@ -72,6 +76,10 @@ type deletePipelineOpts struct {
 	deployedPipelineLister deployedPipelineLister
 	store                  store
 
+	// Dependencies to list the resources to delete with --dry-run.
+	w              io.Writer
+	stackResources stackResourcesLister
+
 	// Cached variables.
 	targetPipeline *deploy.Pipeline
 }
@@ -102,6 +110,8 @@ func newDeletePipelineOpts(vars deletePipelineVars) (*deletePipelineOpts, error)
 		ws:                     ws,
 		store:                  ssmStore,
 		sel:                    selector.NewAppPipelineSelector(prompter, ssmStore, pipelineLister),
+		w:                      os.Stdout,
+		stackResources:         awscloudformation.New(defaultSess),
 	}
 
 	return opts, nil
@@ -137,7 +147,7 @@ func (o *deletePipelineOpts) Ask() error {
 		o.targetPipeline = &pipeline
 	}
 
-	if o.skipConfirmation {
+	if o.skipConfirmation || o.dryRun {
 		return nil
 	}
 	deleteConfirmed, err := o.prompt.Confirm(
@@ -160,6 +170,9 @@ func (o *deletePipelineOpts) Execute() error {
 	if err := o.getSecret(); err != nil {
 		return err
 	}
+	if o.dryRun {
+		return o.writeDeletionPlan()
+	}
 	if err := o.deleteSecret(); err != nil {
 		return err
 	}
@@ -171,6 +184,27 @@ func (o *deletePipelineOpts) Execute() error {
 	return nil
 }
 
+// writeDeletionPlan writes the resources that deleting the pipeline would remove.
+func (o *deletePipelineOpts) writeDeletionPlan() error {
+	pipeline, err := o.getTargetPipeline()
+	if err != nil {
+		return err
+	}
+	var plan deletionPlan
+	if err := plan.addStack(o.stackResources, stack.NameForPipeline(pipeline.AppName, pipeline.Name, pipeline.IsLegacy)); err != nil {
+		return err
+	}
+	if o.ghAccessTokenSecretName != "" {
+		if o.shouldDeleteSecret {
+			plan.add(deletionKindSecret, o.ghAccessTokenSecretName, "The access token to the source repository")
+		} else {
+			log.Infof("Deleting the pipeline would ask whether to delete secret %s, unless --%s is set.\n", o.ghAccessTokenSecretName, deleteSecretFlag)
+		}
+	}
+	plan.write(o.w, fmt.Sprintf("Deleting pipeline %s from application %s", o.name, o.appName))
+	return nil
+}
+
 func (o *deletePipelineOpts) getTargetPipeline() (deploy.Pipeline, error) {
 	if o.targetPipeline != nil {
 		return *o.targetPipeline, nil
@@ -303,6 +337,9 @@ func buildPipelineDeleteCmd() *cobra.Command {
 		Example: `
   Delete the pipeline associated with your workspace.
   /code $ copilot pipeline delete
+
+  List the resources that deleting the pipeline would remove, without deleting them.
+  /code $ copilot pipeline delete --dry-run
 `,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeletePipelineOpts(vars)
@@ -316,5 +353,7 @@ func buildPipelineDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldDeleteSecret, deleteSecretFlag, false, deleteSecretFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, deleteDryRunFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(dryRunFlag, yesFlag)
 	return cmd
 }
@@ -6,11 +6,13 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkSecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 
@@ -30,6 +32,7 @@ type deletePipelineMocks struct {
 	codepipeline           *mocks.MockpipelineGetter
 	sel                    *mocks.MockcodePipelineSelector
 	deployedPipelineLister *mocks.MockdeployedPipelineLister
+	stackResources         *mocks.MockstackResourcesLister
 }
 
 func TestDeletePipelineOpts_Ask(t *testing.T) {
@@ -245,11 +248,35 @@ func TestDeletePipelineOpts_Execute(t *testing.T) {
 		deleteSecret   bool
 		inAppName      string
 		inPipelineName string
+		inDryRun       bool
 
 		setupMocks func(mocks deletePipelineMocks)
 
-		wantedError error
+		wantedOutput string
+		wantedError  error
 	}{
+		"lists the resources to delete with --dry-run without deleting them": {
+			deleteSecret:   true,
+			inAppName:      testAppName,
+			inPipelineName: testPipelineName,
+			inDryRun:       true,
+			setupMocks: func(mocks deletePipelineMocks) {
+				mocks.secretsmanager.EXPECT().DescribeSecret(testPipelineSecret).Return(mockResp, nil)
+				mocks.stackResources.EXPECT().TemplateBody(testPipelineName).Return("Resources: {}", nil)
+				mocks.stackResources.EXPECT().StackResources(testPipelineName).Return([]*awscloudformation.StackResource{
+					stackResource("Pipeline", "pipeline-badgoose-honkpipes-Pipeline", "AWS::CodePipeline::Pipeline"),
+				}, nil)
+			},
+			wantedOutput: `Deleting pipeline pipeline-badgoose-honkpipes from application badgoose would remove:
+
+  Type                    Name                                  Data loss
+  ----                    ----                                  ---------
+  CloudFormation stack    pipeline-badgoose-honkpipes           -
+  CodePipeline pipeline   pipeline-badgoose-honkpipes-Pipeline  -
+  Secrets Manager secret  github-token-badgoose-honkpipes       The access token to the source repository
+
+`,
+		},
 		"skips delete secret confirmation (and deletion attempt) if there is no secret": {
 			inAppName:      testAppName,
 			inPipelineName: testPipelineName,
@@ -385,15 +412,18 @@ func TestDeletePipelineOpts_Execute(t *testing.T) {
 				secretsmanager: mockSecretsManager,
 				deployer:       mockDeployer,
 				ws:             mockWorkspace,
+				stackResources: mocks.NewMockstackResourcesLister(ctrl),
 			}
 
 			tc.setupMocks(mocks)
+			b := new(strings.Builder)
 
 			opts := &deletePipelineOpts{
 				deletePipelineVars: deletePipelineVars{
 					shouldDeleteSecret: tc.deleteSecret,
 					appName:            tc.inAppName,
 					name:               tc.inPipelineName,
+					dryRun:             tc.inDryRun,
 				},
 				secretsmanager:   mockSecretsManager,
 				pipelineDeployer: mockDeployer,
@@ -401,6 +431,8 @@ func TestDeletePipelineOpts_Execute(t *testing.T) {
 				prog:             mockProg,
 				prompt:           mockPrompter,
 				targetPipeline:   &targetPipeline,
+				w:                b,
+				stackResources:   mocks.stackResources,
 			}
 
 			// WHEN
@@ -410,6 +442,7 @@ func TestDeletePipelineOpts_Execute(t *testing.T) {
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
 			}
+			require.Equal(t, tc.wantedOutput, b.String())
 		})
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -22,6 +23,7 @@ import (
 	cs "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/list"
@@ -53,6 +55,10 @@ const (
 	fmtPipelineDeployResourcesFailed   = "Failed to add pipeline resources to your application: %s\n"
 	fmtPipelineDeployResourcesComplete = "Successfully added pipeline resources to your application: %s\n"
 
+	fmtPipelineDeployRegionalResourcesStart    = "Adding pipeline artifact resources of application %s to region %s"
+	fmtPipelineDeployRegionalResourcesFailed   = "Failed to add pipeline artifact resources of application %s to region %s\n"
+	fmtPipelineDeployRegionalResourcesComplete = "Successfully added pipeline artifact resources of application %s to region %s\n"
+
 	fmtPipelineDeployStart    = "Creating a new pipeline: %s"
 	fmtPipelineDeployFailed   = "Failed to create a new pipeline: %s.\n"
 	fmtPipelineDeployComplete = "Successfully created a new pipeline: %s\n"
@@ -62,6 +68,10 @@ const (
 	fmtPipelineDeployProposalComplete = "Successfully deployed pipeline: %s\n"
 
 	fmtPipelineDeployExistPrompt = "Are you sure you want to redeploy an existing pipeline: %s?"
+
+	fmtPipelineArtifactsRetentionStart    = "Updating the retention of the artifacts of pipeline: %s"
+	fmtPipelineArtifactsRetentionFailed   = "Failed to update the retention of the artifacts of pipeline: %s.\n"
+	fmtPipelineArtifactsRetentionComplete = "Successfully updated the retention of the artifacts of pipeline: %s\n"
 )
 
 const connectionsURL = "https://console.aws.amazon.com/codesuite/settings/connections"
@@ -101,6 +111,7 @@ type deployPipelineOpts struct {
 	pipelineStackConfig   func(in *deploy.CreatePipelineInput) stackConfiguration
 
 	configureDeployedPipelineLister func() deployedPipelineLister
+	newBucketLifecycleConfigurer    func(region string) (bucketLifecycleConfigurer, error)
 
 	// cached variables
 	wsAppName                    string
@@ -194,6 +205,13 @@ func newDeployPipelineOpts(vars deployPipelineVars) (*deployPipelineOpts, error)
 	opts.pipelineVersionGetter = func(appName, name string, isLegacy bool) (versionGetter, error) {
 		return describe.NewPipelineStackDescriber(appName, name, isLegacy)
 	}
+	opts.newBucketLifecycleConfigurer = func(region string) (bucketLifecycleConfigurer, error) {
+		sess, err := sessProvider.DefaultWithRegion(region)
+		if err != nil {
+			return nil, fmt.Errorf("create session with region %s: %w", region, err)
+		}
+		return s3.New(sess), nil
+	}
 	return opts, nil
 }
 
@@ -288,10 +306,20 @@ func (o *deployPipelineOpts) Execute() error {
 	}
 
 	// Convert environments to deployment stages.
-	stages, err := o.convertStages(pipeline.Stages)
+	wsWorkloads, err := o.getLocalWorkloads()
 	if err != nil {
 		return fmt.Errorf("convert environments to deployment stage: %w", err)
 	}
+	if err := validatePipelineFilters(pipeline, wsWorkloads); err != nil {
+		return err
+	}
+	stages, err := o.convertStages(pipeline.Stages, pipeline.Workloads(wsWorkloads))
+	if err != nil {
+		return fmt.Errorf("convert environments to deployment stage: %w", err)
+	}
+	if err := o.validateNoOverlappingPipelines(stages, wsWorkloads); err != nil {
+		return err
+	}
 
 	// Get cross-regional resources.
 	artifactBuckets, err := o.getArtifactBuckets()
@@ -332,7 +360,7 @@ func (o *deployPipelineOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	stackConfig := deploycfn.WrapWithTemplateOverrider(o.pipelineStackConfig(deployPipelineInput), overrider)
+	stackConfig := deploycfn.WrapWithTemplateOverrider(o.pipelineStackConfig(deployPipelineInput), clideploy.WithStackMetadata(overrider, o.app.StackMetadata))
 
 	if o.showDiff {
 		tpl, err := stackConfig.Template()
@@ -365,6 +393,11 @@ func (o *deployPipelineOpts) Execute() error {
 	}
 	o.prog.Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, color.HighlightUserInput(o.appName)))
 
+	// CodePipeline replicates the artifacts to a bucket in the region of each deployment.
+	if err := o.addRegionalArtifactBuckets(deployPipelineInput); err != nil {
+		return err
+	}
+
 	if err := o.deployPipeline(deployPipelineInput, stackConfig); err != nil {
 		return err
 	}
@@ -462,12 +495,8 @@ func (o *deployPipelineOpts) getPipelineMft() (*manifest.Pipeline, error) {
 	return pipelineMft, nil
 }
 
-func (o *deployPipelineOpts) convertStages(manifestStages []manifest.PipelineStage) ([]deploy.PipelineStage, error) {
+func (o *deployPipelineOpts) convertStages(manifestStages []manifest.PipelineStage, workloads []string) ([]deploy.PipelineStage, error) {
 	var stages []deploy.PipelineStage
-	workloads, err := o.getLocalWorkloads()
-	if err != nil {
-		return nil, err
-	}
 	for _, stage := range manifestStages {
 		env, err := o.store.GetEnvironment(o.appName, stage.Name)
 		if err != nil {
@@ -476,11 +505,89 @@ func (o *deployPipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 
 		var stg deploy.PipelineStage
 		stg.Init(env, &stage, workloads)
+		if len(stages) > 0 {
+			stg.PromoteFrom(&stages[len(stages)-1])
+		}
 		stages = append(stages, stg)
 	}
 	return stages, nil
 }
 
+// validateNoOverlappingPipelines returns an error if another pipeline of the workspace deploys any of the stacks
+// that the stages deploy, since the pipelines would override each other's deployments.
+func (o *deployPipelineOpts) validateNoOverlappingPipelines(stages []deploy.PipelineStage, wsWorkloads []string) error {
+	stacks, err := stageStacks(stages)
+	if err != nil {
+		return err
+	}
+	pipelines, err := o.ws.ListPipelines()
+	if err != nil {
+		return fmt.Errorf("list pipelines in the workspace: %w", err)
+	}
+	for _, other := range pipelines {
+		if other.Name == o.pipeline.Name {
+			continue
+		}
+		mft, err := o.ws.ReadPipelineManifest(other.Path)
+		if err != nil {
+			return fmt.Errorf("read manifest of pipeline %s: %w", other.Name, err)
+		}
+		// The environments of the other pipeline are only needed to name the stacks that it deploys.
+		otherStages := make([]deploy.PipelineStage, len(mft.Stages))
+		for i := range mft.Stages {
+			otherStages[i].Init(&config.Environment{App: o.appName}, &mft.Stages[i], mft.Workloads(wsWorkloads))
+		}
+		otherStacks, err := stageStacks(otherStages)
+		if err != nil {
+			return fmt.Errorf("get stacks deployed by pipeline %s: %w", other.Name, err)
+		}
+		var overlap []string
+		for stack := range stacks {
+			if otherStacks[stack] {
+				overlap = append(overlap, stack)
+			}
+		}
+		if len(overlap) == 0 {
+			continue
+		}
+		sort.Strings(overlap)
+		return &errOverlappingPipelines{
+			pipeline: o.pipeline.Name,
+			other:    other.Name,
+			stacks:   overlap,
+		}
+	}
+	return nil
+}
+
+// stageStacks returns the names of the stacks that the stages deploy.
+func stageStacks(stages []deploy.PipelineStage) (map[string]bool, error) {
+	stacks := make(map[string]bool)
+	for i := range stages {
+		actions, err := stages[i].Deployments()
+		if err != nil {
+			return nil, err
+		}
+		for j := range actions {
+			stacks[actions[j].StackName()] = true
+		}
+	}
+	return stacks, nil
+}
+
+// validatePipelineFilters returns an error if the filters of the pipeline list a workload that isn't in the workspace.
+func validatePipelineFilters(mft *manifest.Pipeline, wsWorkloads []string) error {
+	if mft.Filters == nil {
+		return nil
+	}
+	for _, workload := range mft.Filters.Workloads {
+		if !contains(workload, wsWorkloads) {
+			return fmt.Errorf(`workload %s in the "filters" of pipeline %s is not in the workspace`, workload, mft.Name)
+		}
+	}
+	return nil
+}
+
 func (o deployPipelineOpts) getLocalWorkloads() ([]string, error) {
 	var localWklds []string
 	if err := o.newSvcListCmd(o.svcBuffer, o.appName).Execute(); err != nil {
@@ -523,6 +630,64 @@ func (o *deployPipelineOpts) getArtifactBuckets() ([]deploy.ArtifactBucket, erro
 	return buckets, nil
 }
 
+// addRegionalArtifactBuckets adds the pipeline resources of the application to the regions, other than the pipeline's,
+// that stages deploy to without an artifact bucket, for example the first time the pipeline promotes to a new region.
+func (o *deployPipelineOpts) addRegionalArtifactBuckets(in *deploy.CreatePipelineInput) error {
+	missing, err := regionsWithoutArtifactBucket(in.Stages, in.ArtifactBuckets)
+	if err != nil {
+		return err
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	for _, region := range missing {
+		if region == o.region {
+			// The resources were just added to the pipeline region.
+			continue
+		}
+		o.prog.Start(fmt.Sprintf(fmtPipelineDeployRegionalResourcesStart, color.HighlightUserInput(o.appName), region))
+		if err := o.pipelineDeployer.AddPipelineResourcesToApp(o.app, region); err != nil {
+			o.prog.Stop(log.Serrorf(fmtPipelineDeployRegionalResourcesFailed, color.HighlightUserInput(o.appName), region))
+			return fmt.Errorf("add pipeline resources to application %s in %s: %w", o.appName, region, err)
+		}
+		o.prog.Stop(log.Ssuccessf(fmtPipelineDeployRegionalResourcesComplete, color.HighlightUserInput(o.appName), region))
+	}
+	buckets, err := o.getArtifactBuckets()
+	if err != nil {
+		return fmt.Errorf("get cross-regional resources: %w", err)
+	}
+	if missing, err = regionsWithoutArtifactBucket(in.Stages, buckets); err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("no artifact bucket in region %s to deploy the stages to", strings.Join(missing, ", "))
+	}
+	in.ArtifactBuckets = buckets
+	return nil
+}
+
+// regionsWithoutArtifactBucket returns the regions that stages deploy to without an artifact bucket.
+func regionsWithoutArtifactBucket(stages []deploy.PipelineStage, buckets []deploy.ArtifactBucket) ([]string, error) {
+	bucketRegions := make(map[string]bool)
+	for _, bucket := range buckets {
+		region, err := bucket.Region()
+		if err != nil {
+			return nil, err
+		}
+		bucketRegions[region] = true
+	}
+	var missing []string
+	for _, stage := range stages {
+		region := stage.Region()
+		if bucketRegions[region] {
+			continue
+		}
+		bucketRegions[region] = true // Report each region once.
+		missing = append(missing, region)
+	}
+	return missing, nil
+}
+
 func (o *deployPipelineOpts) getBucketName() (string, error) {
 	resources, err := o.pipelineDeployer.GetAppResourcesByRegion(o.app, o.region)
 	if err != nil {
@@ -581,7 +746,7 @@ func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput, stac
 			}
 		}
 		o.prog.Stop(log.Ssuccessf(fmtPipelineDeployComplete, color.HighlightUserInput(o.pipeline.Name)))
-		return nil
+		return o.updateArtifactsRetention(in, stackConfig)
 	}
 
 	// If the stack already exists - we update it
@@ -601,6 +766,55 @@ func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput, stac
 		return fmt.Errorf("update pipeline: %w", err)
 	}
 	o.prog.Stop(log.Ssuccessf(fmtPipelineDeployProposalComplete, color.HighlightUserInput(o.pipeline.Name)))
+	return o.updateArtifactsRetention(in, stackConfig)
+}
+
+// updateArtifactsRetention sets the lifecycle rule that expires the artifacts of the pipeline in each artifact bucket,
+// or removes it if the manifest doesn't specify a retention.
+func (o *deployPipelineOpts) updateArtifactsRetention(in *deploy.CreatePipelineInput, stackConfig deploycfn.StackConfiguration) error {
+	var retention *int
+	if o.pipelineMft.Artifacts != nil {
+		retention = o.pipelineMft.Artifacts.Retention
+	}
+	resourceName, err := o.pipelineDeployer.PipelineResourceName(stackConfig)
+	if err != nil {
+		return fmt.Errorf("get the name of pipeline %s: %w", o.pipeline.Name, err)
+	}
+	ruleID := deploy.PipelineArtifactsLifecycleRuleID(resourceName)
+
+	o.prog.Start(fmt.Sprintf(fmtPipelineArtifactsRetentionStart, color.HighlightUserInput(o.pipeline.Name)))
+	for _, bucket := range in.ArtifactBuckets {
+		if err := o.updateBucketRetention(bucket, ruleID, deploy.PipelineArtifactsPrefix(resourceName), retention); err != nil {
+			o.prog.Stop(log.Serrorf(fmtPipelineArtifactsRetentionFailed, color.HighlightUserInput(o.pipeline.Name)))
+			return err
+		}
+	}
+	o.prog.Stop(log.Ssuccessf(fmtPipelineArtifactsRetentionComplete, color.HighlightUserInput(o.pipeline.Name)))
+	return nil
+}
+
+func (o *deployPipelineOpts) updateBucketRetention(bucket deploy.ArtifactBucket, ruleID, prefix string, retention *int) error {
+	region, err := bucket.Region()
+	if err != nil {
+		return fmt.Errorf("get region of artifact bucket %s: %w", bucket.BucketName, err)
+	}
+	client, err := o.newBucketLifecycleConfigurer(region)
+	if err != nil {
+		return err
+	}
+	if retention == nil {
+		if err := client.DeleteLifecycleRule(bucket.BucketName, ruleID); err != nil {
+			return fmt.Errorf("remove the retention of the artifacts in bucket %s: %w", bucket.BucketName, err)
+		}
+		return nil
+	}
+	if err := client.PutLifecycleRule(bucket.BucketName, s3.LifecycleRule{
+		ID:             ruleID,
+		Prefix:         prefix,
+		ExpirationDays: aws.IntValue(retention),
+	}); err != nil {
+		return fmt.Errorf("set the retention of the artifacts in bucket %s: %w", bucket.BucketName, err)
+	}
 	return nil
 }
 
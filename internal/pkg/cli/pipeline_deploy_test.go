@@ -11,6 +11,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -33,6 +35,7 @@ type deployPipelineMocks struct {
 	actionCmd              *mocks.MockactionCommand
 	deployedPipelineLister *mocks.MockdeployedPipelineLister
 	versionGetter          *mocks.MockversionGetter
+	lifecycle              *mocks.MockbucketLifecycleConfigurer
 }
 
 func TestDeployPipelineOpts_Ask(t *testing.T) {
@@ -183,6 +186,8 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 		relativePath         = "/copilot/pipelines/pipepiper/manifest.yml"
 		mockTemplateVersion  = "v1.28.0"
 		mockFutureVersion    = "v1.30.0"
+
+		mockPipelineResourceName = "pipeline-badgoose-pipepiper-Pipeline-1A2B3C"
 	)
 	mockPipelineManifest := &manifest.Pipeline{
 		Name:    "pipepiper",
@@ -213,18 +218,50 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 	mockResources := []*stack.AppRegionalResources{
 		{
 			S3Bucket:  "someBucket",
-			KMSKeyARN: "someKey",
+			KMSKeyARN: "arn:aws:kms:us-west-2:123456789012:key/someKey",
 		},
 	}
 	mockResource := &stack.AppRegionalResources{
 		S3Bucket: "someOtherBucket",
 	}
+	mockPipelineManifestWithRetention := *mockPipelineManifest
+	mockPipelineManifestWithRetention.Artifacts = &manifest.PipelineArtifacts{
+		Retention: aws.Int(30),
+	}
+	mockPipelineManifestWithFilters := *mockPipelineManifest
+	mockPipelineManifestWithFilters.Filters = &manifest.PipelineFilters{
+		Workloads: []string{"frontend", "api"},
+	}
+	mockOtherPipelineManifest := &manifest.Pipeline{
+		Name:    "other",
+		Version: 1,
+		Stages: []manifest.PipelineStage{
+			{
+				Name: "wings",
+			},
+		},
+		Filters: &manifest.PipelineFilters{
+			Workloads: []string{"frontend"},
+		},
+	}
 	mockEnv := &config.Environment{
 		Name:      "test",
 		App:       appName,
 		Region:    region,
 		AccountID: accountID,
 	}
+	mockProdEnv := &config.Environment{
+		Name:      "prod",
+		App:       appName,
+		Region:    "eu-west-1",
+		AccountID: "210987654321",
+	}
+	mockCrossRegionResources := append([]*stack.AppRegionalResources{
+		{
+			S3Bucket:  "euBucket",
+			KMSKeyARN: "arn:aws:kms:eu-west-1:123456789012:key/euKey",
+		},
+	}, mockResources...)
 	testCases := map[string]struct {
 		inApp            *config.Application
 		inAppName        string
@@ -251,6 +288,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -268,10 +306,105 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployStart, pipelineName)).Times(1),
 					m.deployer.EXPECT().CreatePipeline(gomock.Any(), gomock.Any()).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployComplete, pipelineName)).Times(1),
+
+					// updateArtifactsRetention
+					m.deployer.EXPECT().PipelineResourceName(gomock.Any()).Return(mockPipelineResourceName, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineArtifactsRetentionStart, pipelineName)).Times(1),
+					m.lifecycle.EXPECT().DeleteLifecycleRule("someBucket", "ExpirePipelineArtifacts-pipeline-badgoose-pi").Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineArtifactsRetentionComplete, pipelineName)).Times(1),
 				)
 			},
 			expectedError: nil,
 		},
+		"create pipeline and set the retention of its artifacts": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(&mockPipelineManifestWithRetention, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+
+					// convertStages
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+
+					m.ws.EXPECT().PipelineOverridesPath(pipelineName).Return("path"),
+
+					// bootstrap pipeline resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+
+					// deployPipeline
+					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployStart, pipelineName)).Times(1),
+					m.deployer.EXPECT().CreatePipeline(gomock.Any(), gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployComplete, pipelineName)).Times(1),
+
+					// updateArtifactsRetention
+					m.deployer.EXPECT().PipelineResourceName(gomock.Any()).Return(mockPipelineResourceName, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineArtifactsRetentionStart, pipelineName)).Times(1),
+					m.lifecycle.EXPECT().PutLifecycleRule("someBucket", s3.LifecycleRule{
+						ID:             "ExpirePipelineArtifacts-pipeline-badgoose-pi",
+						Prefix:         "pipeline-badgoose-pi/",
+						ExpirationDays: 30,
+					}).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineArtifactsRetentionComplete, pipelineName)).Times(1),
+				)
+			},
+		},
+		"error if the retention of the artifacts can't be set": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(&mockPipelineManifestWithRetention, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+
+					// convertStages
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+
+					m.ws.EXPECT().PipelineOverridesPath(pipelineName).Return("path"),
+
+					// bootstrap pipeline resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+
+					// deployPipeline
+					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployStart, pipelineName)).Times(1),
+					m.deployer.EXPECT().CreatePipeline(gomock.Any(), gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployComplete, pipelineName)).Times(1),
+
+					// updateArtifactsRetention
+					m.deployer.EXPECT().PipelineResourceName(gomock.Any()).Return(mockPipelineResourceName, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineArtifactsRetentionStart, pipelineName)).Times(1),
+					m.lifecycle.EXPECT().PutLifecycleRule("someBucket", gomock.Any()).Return(errors.New("some error")),
+					m.prog.EXPECT().Stop(log.Serrorf(fmtPipelineArtifactsRetentionFailed, pipelineName)).Times(1),
+				)
+			},
+			expectedError: errors.New("set the retention of the artifacts in bucket someBucket: some error"),
+		},
 		"update and deploy pipeline with new naming": {
 			inApp:     &app,
 			inAppName: appName,
@@ -287,6 +420,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -305,6 +439,12 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployProposalStart, pipelineName)).Times(1),
 					m.deployer.EXPECT().UpdatePipeline(gomock.Any(), gomock.Any()).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployProposalComplete, pipelineName)).Times(1),
+
+					// updateArtifactsRetention
+					m.deployer.EXPECT().PipelineResourceName(gomock.Any()).Return(mockPipelineResourceName, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineArtifactsRetentionStart, pipelineName)).Times(1),
+					m.lifecycle.EXPECT().DeleteLifecycleRule("someBucket", "ExpirePipelineArtifacts-pipeline-badgoose-pi").Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineArtifactsRetentionComplete, pipelineName)).Times(1),
 				)
 			},
 			expectedError: nil,
@@ -329,6 +469,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -347,6 +488,12 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployProposalStart, pipelineName)).Times(1),
 					m.deployer.EXPECT().UpdatePipeline(gomock.Any(), gomock.Any()).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployProposalComplete, pipelineName)).Times(1),
+
+					// updateArtifactsRetention
+					m.deployer.EXPECT().PipelineResourceName(gomock.Any()).Return(mockPipelineResourceName, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineArtifactsRetentionStart, pipelineName)).Times(1),
+					m.lifecycle.EXPECT().DeleteLifecycleRule("someBucket", "ExpirePipelineArtifacts-pipeline-badgoose-pi").Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineArtifactsRetentionComplete, pipelineName)).Times(1),
 				)
 			},
 			expectedError: nil,
@@ -366,6 +513,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -400,6 +548,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -448,6 +597,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -462,6 +612,91 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("add pipeline resources to application %s in %s: some error", appName, region),
 		},
+		"add the pipeline resources to the regions of the stages without an artifact bucket": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+
+					// convertStages
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockProdEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+
+					m.ws.EXPECT().PipelineOverridesPath(pipelineName).Return("path"),
+
+					// bootstrap pipeline resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+
+					// addRegionalArtifactBuckets
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionalResourcesStart, appName, "eu-west-1")).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, "eu-west-1").Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployRegionalResourcesComplete, appName, "eu-west-1")).Times(1),
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockCrossRegionResources, nil),
+
+					// deployPipeline
+					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployStart, pipelineName)).Times(1),
+					m.deployer.EXPECT().CreatePipeline(gomock.Any(), gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployComplete, pipelineName)).Times(1),
+
+					// updateArtifactsRetention
+					m.deployer.EXPECT().PipelineResourceName(gomock.Any()).Return(mockPipelineResourceName, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineArtifactsRetentionStart, pipelineName)).Times(1),
+					m.lifecycle.EXPECT().DeleteLifecycleRule("euBucket", "ExpirePipelineArtifacts-pipeline-badgoose-pi").Return(nil),
+					m.lifecycle.EXPECT().DeleteLifecycleRule("someBucket", "ExpirePipelineArtifacts-pipeline-badgoose-pi").Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineArtifactsRetentionComplete, pipelineName)).Times(1),
+				)
+			},
+		},
+		"returns an error if a region of the stages still doesn't have an artifact bucket": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+
+					// convertStages
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockProdEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+
+					m.ws.EXPECT().PipelineOverridesPath(pipelineName).Return("path"),
+
+					// bootstrap pipeline resources
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+
+					// addRegionalArtifactBuckets
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployRegionalResourcesStart, appName, "eu-west-1")).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, "eu-west-1").Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployRegionalResourcesComplete, appName, "eu-west-1")).Times(1),
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+				)
+			},
+			expectedError: errors.New("no artifact bucket in region eu-west-1 to deploy the stages to"),
+		},
 		"returns an error if fail to read pipeline file": {
 			inApp:     &app,
 			inRegion:  region,
@@ -567,6 +802,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, errors.New("some error")),
@@ -589,6 +825,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -621,6 +858,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -657,6 +895,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -719,6 +958,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -736,6 +976,12 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployProposalStart, pipelineName)).Times(1),
 					m.deployer.EXPECT().UpdatePipeline(gomock.Any(), gomock.Any()).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployProposalComplete, pipelineName)).Times(1),
+
+					// updateArtifactsRetention
+					m.deployer.EXPECT().PipelineResourceName(gomock.Any()).Return(mockPipelineResourceName, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineArtifactsRetentionStart, pipelineName)).Times(1),
+					m.lifecycle.EXPECT().DeleteLifecycleRule("someBucket", "ExpirePipelineArtifacts-pipeline-badgoose-pi").Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineArtifactsRetentionComplete, pipelineName)).Times(1),
 				)
 			},
 			expectedError: nil,
@@ -756,6 +1002,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -784,6 +1031,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 					// convertStages
 					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
 					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil),
 
 					// getArtifactBuckets
 					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
@@ -795,6 +1043,47 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("retrieve the deployed template for %q: some error", pipelineName),
 		},
+		"error if the filters list a workload that isn't in the workspace": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(&mockPipelineManifestWithFilters, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+				)
+			},
+			expectedError: errors.New(`workload api in the "filters" of pipeline pipepiper is not in the workspace`),
+		},
+		"error if another pipeline of the workspace deploys the same workloads": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.versionGetter.EXPECT().Version().Return(mockTemplateVersion, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+
+					// convertStages
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+
+					// validateNoOverlappingPipelines
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+						{Name: pipelineName, Path: pipelineManifestPath},
+						{Name: "other", Path: "copilot/pipelines/other/manifest.yml"},
+					}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipelines/other/manifest.yml").Return(mockOtherPipelineManifest, nil),
+				)
+			},
+			expectedError: errors.New("pipelines pipepiper and other both deploy stack badgoose-wings-frontend"),
+		},
 		"failed prompt to accept diff": {
 			inApp:      &app,
 			inAppName:  appName,
@@ -810,6 +1099,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				// convertStages
 				m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1)
 				m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1)
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil)
 
 				// getArtifactBuckets
 				m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil)
@@ -840,6 +1130,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				// convertStages
 				m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1)
 				m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1)
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil)
 
 				// getArtifactBuckets
 				m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil)
@@ -864,6 +1155,12 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().CreatePipeline(gomock.Any(), gomock.Any()).Return(nil)
 				m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployComplete, pipelineName)).Times(1)
 
+				// updateArtifactsRetention
+				m.deployer.EXPECT().PipelineResourceName(gomock.Any()).Return(mockPipelineResourceName, nil)
+				m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineArtifactsRetentionStart, pipelineName)).Times(1)
+				m.lifecycle.EXPECT().DeleteLifecycleRule("someBucket", "ExpirePipelineArtifacts-pipeline-badgoose-pi").Return(nil)
+				m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineArtifactsRetentionComplete, pipelineName)).Times(1)
+
 			},
 		},
 		"Successfully show diff and redeploy an existing pipeline": {
@@ -880,6 +1177,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				// convertStages
 				m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1)
 				m.store.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1)
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: pipelineName, Path: pipelineManifestPath}}, nil)
 
 				// getArtifactBuckets
 				m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil)
@@ -904,6 +1202,12 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().UpdatePipeline(gomock.Any(), gomock.Any()).Return(nil)
 				m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployProposalComplete, pipelineName)).Times(1)
 
+				// updateArtifactsRetention
+				m.deployer.EXPECT().PipelineResourceName(gomock.Any()).Return(mockPipelineResourceName, nil)
+				m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineArtifactsRetentionStart, pipelineName)).Times(1)
+				m.lifecycle.EXPECT().DeleteLifecycleRule("someBucket", "ExpirePipelineArtifacts-pipeline-badgoose-pi").Return(nil)
+				m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineArtifactsRetentionComplete, pipelineName)).Times(1)
+
 			},
 		},
 	}
@@ -923,6 +1227,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				pipelineStackConfig:    mocks.NewMockstackConfiguration(ctrl),
 				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
 				versionGetter:          mocks.NewMockversionGetter(ctrl),
+				lifecycle:              mocks.NewMockbucketLifecycleConfigurer(ctrl),
 				mockDiffWriter:         &strings.Builder{},
 			}
 
@@ -959,6 +1264,10 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				configureDeployedPipelineLister: func() deployedPipelineLister {
 					return mocks.deployedPipelineLister
 				},
+				newBucketLifecycleConfigurer: func(region string) (bucketLifecycleConfigurer, error) {
+					require.Contains(t, []string{"us-west-2", mockProdEnv.Region}, region, "the client should be in the region of the bucket")
+					return mocks.lifecycle, nil
+				},
 				pipeline: &workspace.PipelineManifest{
 					Name: "pipepiper",
 					Path: pipelineManifestPath,
@@ -0,0 +1,300 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineGCAppNamePrompt     = "Which application's pipeline would you like to clean up?"
+	pipelineGCAppNameHelpPrompt = "An application is a collection of related services."
+	pipelineGCConfirmHelp       = "The deleted artifacts can't be recovered, and executions that still need them will fail."
+
+	fmtPipelineGCPrompt        = "Which deployed pipeline of application %s would you like to clean up?"
+	fmtPipelineGCConfirmPrompt = "Are you sure you want to delete %s (%s) of pipeline %s?"
+	fmtPipelineGCStart         = "Deleting the artifacts of pipeline %s from bucket %s."
+	fmtPipelineGCFailed        = "Failed to delete the artifacts of pipeline %s from bucket %s.\n"
+	fmtPipelineGCComplete      = "Deleted the artifacts of pipeline %s from bucket %s.\n"
+)
+
+var (
+	errPipelineGCCancelled = errors.New("pipeline gc cancelled - no artifacts deleted")
+)
+
+type gcPipelineVars struct {
+	appName          string
+	name             string
+	retention        int
+	dryRun           bool
+	skipConfirmation bool
+}
+
+type gcPipelineOpts struct {
+	gcPipelineVars
+
+	// Interfaces to dependencies.
+	store                  store
+	appResources           appResourcesGetter
+	deployedPipelineLister deployedPipelineLister
+	sel                    codePipelineSelector
+	prompt                 prompter
+	prog                   progress
+	newArtifactsCollector  func(region string) (artifactsCollector, error)
+	now                    func() time.Time
+
+	// Cached variables.
+	targetPipeline *deploy.Pipeline
+}
+
+// artifactVersions are the versions of the artifacts of a pipeline to delete from an artifact bucket.
+type artifactVersions struct {
+	bucket    string
+	region    string
+	collector artifactsCollector
+	versions  []s3.ObjectVersion
+}
+
+func newGCPipelineOpts(vars gcPipelineVars) (*gcPipelineOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline gc"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	ssmStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	pipelineLister := deploy.NewPipelineStore(rg.New(defaultSess))
+
+	return &gcPipelineOpts{
+		gcPipelineVars:         vars,
+		store:                  ssmStore,
+		appResources:           cloudformation.New(defaultSess, cloudformation.WithProgressTracker(os.Stderr)),
+		deployedPipelineLister: pipelineLister,
+		sel:                    selector.NewAppPipelineSelector(prompter, ssmStore, pipelineLister),
+		prompt:                 prompter,
+		prog:                   termprogress.NewSpinner(log.DiagnosticWriter),
+		newArtifactsCollector: func(region string) (artifactsCollector, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %w", region, err)
+			}
+			return s3.New(sess), nil
+		},
+		now: time.Now,
+	}, nil
+}
+
+// Validate returns an error if the flag values for optional fields are invalid.
+func (o *gcPipelineOpts) Validate() error {
+	if o.retention < 0 {
+		return fmt.Errorf("--%s must be at least 1 day", retentionFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates required fields.
+func (o *gcPipelineOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	} else {
+		app, err := o.sel.Application(pipelineGCAppNamePrompt, pipelineGCAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+
+	if o.name != "" {
+		pipeline, err := getDeployedPipelineInfo(o.deployedPipelineLister, o.appName, o.name)
+		if err != nil {
+			return fmt.Errorf("validate pipeline name %s: %w", o.name, err)
+		}
+		o.targetPipeline = &pipeline
+		return nil
+	}
+	pipeline, err := askDeployedPipelineName(o.sel, fmt.Sprintf(fmtPipelineGCPrompt, color.HighlightUserInput(o.appName)), o.appName)
+	if err != nil {
+		return err
+	}
+	o.name = pipeline.Name
+	o.targetPipeline = &pipeline
+	return nil
+}
+
+// Execute deletes the artifacts of the pipeline that are older than the retention from the artifact buckets.
+func (o *gcPipelineOpts) Execute() error {
+	if err := o.warnSharedPrefix(); err != nil {
+		return err
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	resources, err := o.appResources.GetRegionalAppResources(app)
+	if err != nil {
+		return fmt.Errorf("get regional resources of application %s: %w", o.appName, err)
+	}
+
+	var artifacts []artifactVersions
+	var count int
+	var size int64
+	for _, resource := range resources {
+		bucketArtifacts, err := o.expiredArtifacts(resource.S3Bucket, resource.Region)
+		if err != nil {
+			return err
+		}
+		for _, version := range bucketArtifacts.versions {
+			size += version.Size
+		}
+		count += len(bucketArtifacts.versions)
+		artifacts = append(artifacts, bucketArtifacts)
+	}
+	if count == 0 {
+		log.Infof("There are no artifacts of pipeline %s to delete.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	if o.dryRun {
+		for _, bucketArtifacts := range artifacts {
+			log.Infof("Would delete %s from bucket %s in %s.\n",
+				english.Plural(len(bucketArtifacts.versions), "artifact version", ""),
+				color.HighlightResource(bucketArtifacts.bucket), bucketArtifacts.region)
+		}
+		log.Infof("Would delete %s (%s) of pipeline %s in total.\n",
+			english.Plural(count, "artifact version", ""), humanize.IBytes(uint64(size)), color.HighlightUserInput(o.name))
+		return nil
+	}
+	if !o.skipConfirmation {
+		confirmed, err := o.prompt.Confirm(
+			fmt.Sprintf(fmtPipelineGCConfirmPrompt, english.Plural(count, "artifact version", ""), humanize.IBytes(uint64(size)), o.name),
+			pipelineGCConfirmHelp,
+			prompt.WithConfirmFinalMessage())
+		if err != nil {
+			return fmt.Errorf("pipeline gc confirmation prompt: %w", err)
+		}
+		if !confirmed {
+			return errPipelineGCCancelled
+		}
+	}
+	for _, bucketArtifacts := range artifacts {
+		if len(bucketArtifacts.versions) == 0 {
+			continue
+		}
+		o.prog.Start(fmt.Sprintf(fmtPipelineGCStart, color.HighlightUserInput(o.name), color.HighlightResource(bucketArtifacts.bucket)))
+		if err := bucketArtifacts.collector.DeleteObjectVersions(bucketArtifacts.bucket, bucketArtifacts.versions); err != nil {
+			o.prog.Stop(log.Serrorf(fmtPipelineGCFailed, color.HighlightUserInput(o.name), color.HighlightResource(bucketArtifacts.bucket)))
+			return fmt.Errorf("delete artifacts of pipeline %s: %w", o.name, err)
+		}
+		o.prog.Stop(log.Ssuccessf(fmtPipelineGCComplete, color.HighlightUserInput(o.name), color.HighlightResource(bucketArtifacts.bucket)))
+	}
+	return nil
+}
+
+// expiredArtifacts returns the versions of the artifacts of the pipeline in the bucket that are older than the retention.
+func (o *gcPipelineOpts) expiredArtifacts(bucket, region string) (artifactVersions, error) {
+	collector, err := o.newArtifactsCollector(region)
+	if err != nil {
+		return artifactVersions{}, err
+	}
+	retention := o.retention
+	if retention == 0 {
+		rule, err := collector.LifecycleRule(bucket, deploy.PipelineArtifactsLifecycleRuleID(o.targetPipeline.ResourceName))
+		if err != nil {
+			return artifactVersions{}, fmt.Errorf("get the retention of the artifacts of pipeline %s: %w", o.name, err)
+		}
+		if rule == nil {
+			return artifactVersions{}, fmt.Errorf(`pipeline %s does not have an artifact retention: specify it with %s or "artifacts.retention" in the pipeline manifest`,
+				o.name, color.HighlightCode(fmt.Sprintf("--%s", retentionFlag)))
+		}
+		retention = rule.ExpirationDays
+	}
+	versions, err := collector.ObjectVersionsBefore(bucket, deploy.PipelineArtifactsPrefix(o.targetPipeline.ResourceName), o.now().AddDate(0, 0, -retention))
+	if err != nil {
+		return artifactVersions{}, fmt.Errorf("list artifacts of pipeline %s: %w", o.name, err)
+	}
+	return artifactVersions{
+		bucket:    bucket,
+		region:    region,
+		collector: collector,
+		versions:  versions,
+	}, nil
+}
+
+// warnSharedPrefix warns if other pipelines of the application store their artifacts under the same prefix.
+func (o *gcPipelineOpts) warnSharedPrefix() error {
+	pipelines, err := o.deployedPipelineLister.ListDeployedPipelines(o.appName)
+	if err != nil {
+		return fmt.Errorf("list deployed pipelines: %w", err)
+	}
+	prefix := deploy.PipelineArtifactsPrefix(o.targetPipeline.ResourceName)
+	var shared []string
+	for _, pipeline := range pipelines {
+		if pipeline.ResourceName != o.targetPipeline.ResourceName && deploy.PipelineArtifactsPrefix(pipeline.ResourceName) == prefix {
+			shared = append(shared, pipeline.Name)
+		}
+	}
+	if len(shared) > 0 {
+		log.Warningf("Pipeline %s stores its artifacts under the same prefix %s as %s, whose artifacts will be deleted too.\n",
+			o.name, prefix, english.WordSeries(shared, "and"))
+	}
+	return nil
+}
+
+// RecommendActions is a no-op for this command.
+func (o *gcPipelineOpts) RecommendActions() error {
+	return nil
+}
+
+// buildPipelineGCCmd builds the command for deleting the old artifacts of a pipeline.
+func buildPipelineGCCmd() *cobra.Command {
+	vars := gcPipelineVars{}
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Deletes the old artifacts of a pipeline from the artifact buckets.",
+		Long: `Deletes the old artifacts of a pipeline from the artifact buckets.
+Artifacts are older than the retention when they were created more than that many days ago.`,
+		Example: `
+  Delete the artifacts of the pipeline "my-pipeline" that are older than its retention.
+  /code $ copilot pipeline gc -n my-pipeline
+  List the artifacts of the pipeline "my-pipeline" older than 7 days, without deleting them.
+  /code $ copilot pipeline gc -n my-pipeline --retention 7 --dry-run
+`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newGCPipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().IntVar(&vars.retention, retentionFlag, 0, retentionFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, pipelineGCDryRunFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(dryRunFlag, yesFlag)
+	return cmd
+}
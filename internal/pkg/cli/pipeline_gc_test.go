@@ -0,0 +1,277 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type gcPipelineMocks struct {
+	store                  *mocks.Mockstore
+	appResources           *mocks.MockappResourcesGetter
+	deployedPipelineLister *mocks.MockdeployedPipelineLister
+	sel                    *mocks.MockcodePipelineSelector
+	prompt                 *mocks.Mockprompter
+	prog                   *mocks.Mockprogress
+	collector              *mocks.MockartifactsCollector
+}
+
+func TestGCPipelineOpts_Validate(t *testing.T) {
+	require.EqualError(t, (&gcPipelineOpts{gcPipelineVars: gcPipelineVars{retention: -1}}).Validate(), "--retention must be at least 1 day")
+	require.NoError(t, (&gcPipelineOpts{gcPipelineVars: gcPipelineVars{retention: 7}}).Validate())
+}
+
+func TestGCPipelineOpts_Ask(t *testing.T) {
+	const (
+		testAppName      = "badgoose"
+		testPipelineName = "honkpipes"
+	)
+	testPipeline := deploy.Pipeline{
+		AppName:      testAppName,
+		ResourceName: "pipeline-badgoose-honkpipes-Pipeline-1A2B",
+		Name:         testPipelineName,
+	}
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+
+		callMocks          func(m gcPipelineMocks)
+		wantedAppName      string
+		wantedPipelineName string
+		wantedError        error
+	}{
+		"prompts for the app and the pipeline": {
+			callMocks: func(m gcPipelineMocks) {
+				m.sel.EXPECT().Application(pipelineGCAppNamePrompt, pipelineGCAppNameHelpPrompt).Return(testAppName, nil)
+				m.sel.EXPECT().DeployedPipeline(fmt.Sprintf(fmtPipelineGCPrompt, testAppName), "", testAppName).Return(testPipeline, nil)
+			},
+			wantedAppName:      testAppName,
+			wantedPipelineName: testPipelineName,
+		},
+		"errors if passed-in app name is invalid": {
+			inAppName: "badAppName",
+			callMocks: func(m gcPipelineMocks) {
+				m.store.EXPECT().GetApplication("badAppName").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"errors if passed-in pipeline name is invalid": {
+			inAppName:      testAppName,
+			inPipelineName: "badPipelineName",
+			callMocks: func(m gcPipelineMocks) {
+				m.store.EXPECT().GetApplication(testAppName).Return(nil, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{testPipeline}, nil)
+			},
+			wantedError: errors.New("validate pipeline name badPipelineName: cannot find pipeline named badPipelineName"),
+		},
+		"validates the passed-in app and pipeline": {
+			inAppName:      testAppName,
+			inPipelineName: testPipelineName,
+			callMocks: func(m gcPipelineMocks) {
+				m.store.EXPECT().GetApplication(testAppName).Return(nil, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{testPipeline}, nil)
+			},
+			wantedAppName:      testAppName,
+			wantedPipelineName: testPipelineName,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := gcPipelineMocks{
+				store:                  mocks.NewMockstore(ctrl),
+				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
+				sel:                    mocks.NewMockcodePipelineSelector(ctrl),
+			}
+			tc.callMocks(m)
+			opts := &gcPipelineOpts{
+				gcPipelineVars: gcPipelineVars{
+					appName: tc.inAppName,
+					name:    tc.inPipelineName,
+				},
+				store:                  m.store,
+				deployedPipelineLister: m.deployedPipelineLister,
+				sel:                    m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.appName)
+			require.Equal(t, tc.wantedPipelineName, opts.name)
+			require.Equal(t, testPipeline, *opts.targetPipeline)
+		})
+	}
+}
+
+func TestGCPipelineOpts_Execute(t *testing.T) {
+	const (
+		testAppName      = "badgoose"
+		testPipelineName = "honkpipes"
+		testBucket       = "stackset-badgoose-pipelinebuiltartifactbuc"
+		testPrefix       = "pipeline-badgoose-ho/"
+		testRuleID       = "ExpirePipelineArtifacts-pipeline-badgoose-ho"
+	)
+	now := time.Date(2023, time.November, 15, 0, 0, 0, 0, time.UTC)
+	testPipeline := deploy.Pipeline{
+		AppName:      testAppName,
+		ResourceName: "pipeline-badgoose-honkpipes-Pipeline-1A2B",
+		Name:         testPipelineName,
+	}
+	testApp := &config.Application{Name: testAppName}
+	testVersions := []s3.ObjectVersion{
+		{Key: testPrefix + "BuildOutpu/a", VersionID: "1", Size: 1024},
+		{Key: testPrefix + "SCCheckout/b", VersionID: "1", Size: 1024},
+	}
+	expectResources := func(m gcPipelineMocks) {
+		m.store.EXPECT().GetApplication(testAppName).Return(testApp, nil)
+		m.appResources.EXPECT().GetRegionalAppResources(testApp).Return([]*stack.AppRegionalResources{
+			{
+				Region:   "us-west-2",
+				S3Bucket: testBucket,
+			},
+		}, nil)
+	}
+	testCases := map[string]struct {
+		inRetention        int
+		inDryRun           bool
+		inSkipConfirmation bool
+
+		callMocks   func(m gcPipelineMocks)
+		wantedError error
+	}{
+		"error if the pipeline has no retention and none is passed in": {
+			callMocks: func(m gcPipelineMocks) {
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{testPipeline}, nil)
+				expectResources(m)
+				m.collector.EXPECT().LifecycleRule(testBucket, testRuleID).Return(nil, nil)
+			},
+			wantedError: errors.New("pipeline honkpipes does not have an artifact retention: specify it with `--retention` or \"artifacts.retention\" in the pipeline manifest"),
+		},
+		"does nothing if there are no old artifacts": {
+			callMocks: func(m gcPipelineMocks) {
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{testPipeline}, nil)
+				expectResources(m)
+				m.collector.EXPECT().LifecycleRule(testBucket, testRuleID).Return(&s3.LifecycleRule{ExpirationDays: 14}, nil)
+				m.collector.EXPECT().ObjectVersionsBefore(testBucket, testPrefix, now.AddDate(0, 0, -14)).Return(nil, nil)
+			},
+		},
+		"lists the old artifacts on dry run": {
+			inRetention: 7,
+			inDryRun:    true,
+			callMocks: func(m gcPipelineMocks) {
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{
+					testPipeline,
+					{
+						ResourceName: "pipeline-badgoose-honkpipes-prod-Pipeline-3C4D",
+						Name:         "honkpipes-prod",
+					},
+				}, nil)
+				expectResources(m)
+				m.collector.EXPECT().ObjectVersionsBefore(testBucket, testPrefix, now.AddDate(0, 0, -7)).Return(testVersions, nil)
+			},
+		},
+		"cancelled when the deletion isn't confirmed": {
+			inRetention: 7,
+			callMocks: func(m gcPipelineMocks) {
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{testPipeline}, nil)
+				expectResources(m)
+				m.collector.EXPECT().ObjectVersionsBefore(testBucket, testPrefix, gomock.Any()).Return(testVersions, nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtPipelineGCConfirmPrompt, "2 artifact versions", "2.0 KiB", testPipelineName), pipelineGCConfirmHelp, gomock.Any()).Return(false, nil)
+			},
+			wantedError: errPipelineGCCancelled,
+		},
+		"deletes the old artifacts": {
+			inRetention:        7,
+			inSkipConfirmation: true,
+			callMocks: func(m gcPipelineMocks) {
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{testPipeline}, nil)
+				expectResources(m)
+				m.collector.EXPECT().ObjectVersionsBefore(testBucket, testPrefix, gomock.Any()).Return(testVersions, nil)
+				m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineGCStart, testPipelineName, testBucket))
+				m.collector.EXPECT().DeleteObjectVersions(testBucket, testVersions).Return(nil)
+				m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineGCComplete, testPipelineName, testBucket))
+			},
+		},
+		"error if the artifacts can't be deleted": {
+			inRetention:        7,
+			inSkipConfirmation: true,
+			callMocks: func(m gcPipelineMocks) {
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{testPipeline}, nil)
+				expectResources(m)
+				m.collector.EXPECT().ObjectVersionsBefore(testBucket, testPrefix, gomock.Any()).Return(testVersions, nil)
+				m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineGCStart, testPipelineName, testBucket))
+				m.collector.EXPECT().DeleteObjectVersions(testBucket, testVersions).Return(errors.New("some error"))
+				m.prog.EXPECT().Stop(log.Serrorf(fmtPipelineGCFailed, testPipelineName, testBucket))
+			},
+			wantedError: errors.New("delete artifacts of pipeline honkpipes: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := gcPipelineMocks{
+				store:                  mocks.NewMockstore(ctrl),
+				appResources:           mocks.NewMockappResourcesGetter(ctrl),
+				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
+				prompt:                 mocks.NewMockprompter(ctrl),
+				prog:                   mocks.NewMockprogress(ctrl),
+				collector:              mocks.NewMockartifactsCollector(ctrl),
+			}
+			tc.callMocks(m)
+			opts := &gcPipelineOpts{
+				gcPipelineVars: gcPipelineVars{
+					appName:          testAppName,
+					name:             testPipelineName,
+					retention:        tc.inRetention,
+					dryRun:           tc.inDryRun,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:                  m.store,
+				appResources:           m.appResources,
+				deployedPipelineLister: m.deployedPipelineLister,
+				prompt:                 m.prompt,
+				prog:                   m.prog,
+				newArtifactsCollector: func(region string) (artifactsCollector, error) {
+					require.Equal(t, "us-west-2", region)
+					return m.collector, nil
+				},
+				now:            func() time.Time { return now },
+				targetPipeline: &testPipeline,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -115,9 +115,10 @@ type envPipelineInitializer struct {
 
 func (ini *workloadPipelineInitializer) writeManifest() error {
 	var stages []manifest.PipelineStage
-	for _, env := range ini.cmd.envConfigs {
+	for i, env := range ini.cmd.envConfigs {
 		stage := manifest.PipelineStage{
-			Name: env.Name,
+			Name:             env.Name,
+			RequiresApproval: isCrossAccountPromotion(ini.cmd.envConfigs, i),
 		}
 		stages = append(stages, stage)
 	}
@@ -135,9 +136,10 @@ Update the "build" phase to unit test your services before pushing the images.`)
 
 func (ini *envPipelineInitializer) writeManifest() error {
 	var stages []manifest.PipelineStage
-	for _, env := range ini.cmd.envConfigs {
+	for i, env := range ini.cmd.envConfigs {
 		stage := manifest.PipelineStage{
-			Name: env.Name,
+			Name:             env.Name,
+			RequiresApproval: isCrossAccountPromotion(ini.cmd.envConfigs, i),
 			Deployments: manifest.Deployments{
 				"deploy-env": &manifest.Deployment{
 					TemplatePath:   path.Join(deploy.DefaultPipelineArtifactsDir, fmt.Sprintf(envCFNTemplateNameFmt, env.Name)),
@@ -151,6 +153,13 @@ func (ini *envPipelineInitializer) writeManifest() error {
 	return ini.cmd.createPipelineManifest(stages)
 }
 
+// isCrossAccountPromotion returns true if the pipeline promotes changes to the environment at index i
+// from an environment in another account, such as from a staging account to a production account.
+// These stages require a manual approval by default.
+func isCrossAccountPromotion(envs []*config.Environment, i int) bool {
+	return i > 0 && envs[i].AccountID != envs[i-1].AccountID
+}
+
 func (ini *envPipelineInitializer) writeBuildspec() error {
 	if err := ini.cmd.createBuildspec(environmentsPipelineBuildspecTemplatePath); err != nil {
 		return err
@@ -181,6 +190,7 @@ type initPipelineVars struct {
 	repoBranch        string
 	githubAccessToken string
 	pipelineType      string
+	defaultsFrom      string
 }
 
 type initPipelineOpts struct {
@@ -230,7 +240,7 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 	}
 
 	ssmStore := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
-	prompter := prompt.New()
+	prompter := newPromptWithHistory(ws, "pipeline init", vars.defaultsFrom)
 
 	wsAppName := tryReadingAppName()
 	if vars.appName == "" {
@@ -255,13 +265,13 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 
 // Validate returns an error if the optional flag values passed by the user are invalid.
 func (o *initPipelineOpts) Validate() error {
-	return nil
+	return validateDefaultsFrom(o.defaultsFrom)
 }
 
 // Ask prompts for required fields that are not passed in and validates them.
 func (o *initPipelineOpts) Ask() error {
 	// This command must be executed in the app's workspace because the pipeline manifest and buildspec will be created and stored.
-	if err := validateWorkspaceApp(o.wsAppName, o.appName, o.store); err != nil {
+	if _, err := validateWorkspaceApp(o.wsAppName, o.appName, o.store); err != nil {
 		return err
 	}
 	o.appName = o.wsAppName
@@ -917,5 +927,6 @@ func buildPipelineInitCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.repoBranch, gitBranchFlag, gitBranchFlagShort, "", gitBranchFlagDescription)
 	cmd.Flags().StringSliceVarP(&vars.environments, envsFlag, envsFlagShort, []string{}, pipelineEnvsFlagDescription)
 	cmd.Flags().StringVarP(&vars.pipelineType, pipelineTypeFlag, pipelineTypeShort, "", pipelineTypeFlagDescription)
+	cmd.Flags().StringVar(&vars.defaultsFrom, defaultsFromFlag, "", defaultsFromFlagDescription)
 	return cmd
 }
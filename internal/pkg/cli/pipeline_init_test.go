@@ -5,6 +5,7 @@ package cli
 
 import (
 	"bytes"
+	"encoding"
 	"errors"
 	"fmt"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	templatemocks "github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -527,6 +529,52 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"requires an approval before promoting to an environment in another account": {
+			inName: wantedName,
+			inType: pipelineTypeWorkloads,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name:      "test",
+					AccountID: "123456789012",
+				},
+				{
+					Name:      "staging",
+					AccountID: "123456789012",
+				},
+				{
+					Name:      "prod",
+					AccountID: "210987654321",
+				},
+			},
+			inRepoURL: "git@github.com:badgoose/goose.git",
+			inAppName: "badgoose",
+			setupMocks: func(m pipelineInitMocks) {
+				m.workspace.EXPECT().WritePipelineManifest(gomock.Any(), wantedName).DoAndReturn(func(mft encoding.BinaryMarshaler, _ string) (string, error) {
+					require.Equal(t, []manifest.PipelineStage{
+						{Name: "test"},
+						{Name: "staging"},
+						{Name: "prod", RequiresApproval: true},
+					}, mft.(*manifest.Pipeline).Stages)
+					return wantedManifestFile, nil
+				})
+				m.workspace.EXPECT().WritePipelineBuildspec(gomock.Any(), wantedName).Return(wantedBuildspecFile, nil)
+				m.workspace.EXPECT().Rel(wantedManifestFile).Return(wantedManifestRelPath, nil)
+				m.parser.EXPECT().Parse(workloadsPipelineBuildspecTemplatePath, gomock.Any(), gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+				m.store.EXPECT().GetApplication("badgoose").Return(&config.Application{
+					Name: "badgoose",
+				}, nil)
+				m.cfnClient.EXPECT().GetRegionalAppResources(&config.Application{
+					Name: "badgoose",
+				}).Return([]*stack.AppRegionalResources{
+					{
+						Region:   "us-west-2",
+						S3Bucket: "gooseBucket",
+					},
+				}, nil)
+			},
+		},
 		"writes workloads pipeline manifest and buildspec for GH(v2) provider": {
 			inName: wantedName,
 			inType: pipelineTypeWorkloads,
@@ -40,6 +40,7 @@ const (
 type listPipelineVars struct {
 	appName                  string
 	shouldOutputJSON         bool
+	outputFormat             outputFormatVars
 	shouldShowLocalPipelines bool
 }
 
@@ -95,7 +96,8 @@ func newListPipelinesOpts(vars listPipelineVars) (*listPipelineOpts, error) {
 // Ask asks for and validates fields that are required but not passed in.
 func (o *listPipelineOpts) Ask() error {
 	if o.shouldShowLocalPipelines {
-		return validateWorkspaceApp(o.wsAppName, o.appName, o.store)
+		_, err := validateWorkspaceApp(o.wsAppName, o.appName, o.store)
+		return err
 	}
 
 	if o.appName != "" {
@@ -118,13 +120,18 @@ func (o *listPipelineOpts) Execute() error {
 	ctx, cancel := context.WithTimeout(context.Background(), pipelineListTimeout)
 	defer cancel()
 
+	outputJSON := o.shouldOutputJSON || o.outputFormat.isSet()
 	switch {
-	case o.shouldShowLocalPipelines && o.shouldOutputJSON:
-		return o.jsonOutputLocal(ctx)
+	case o.shouldShowLocalPipelines && outputJSON:
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			return o.jsonOutputLocal(ctx, w)
+		})
 	case o.shouldShowLocalPipelines:
 		return o.humanOutputLocal()
-	case o.shouldOutputJSON:
-		return o.jsonOutputDeployed(ctx)
+	case outputJSON:
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			return o.jsonOutputDeployed(ctx, w)
+		})
 	}
 
 	return o.humanOutputDeployed()
@@ -132,7 +139,7 @@ func (o *listPipelineOpts) Execute() error {
 
 // jsonOutputLocal prints data about all pipelines in the current workspace.
 // If a local pipeline has been deployed, data from codepipeline is included.
-func (o *listPipelineOpts) jsonOutputLocal(ctx context.Context) error {
+func (o *listPipelineOpts) jsonOutputLocal(ctx context.Context, w io.Writer) error {
 	local, err := o.workspace.ListPipelines()
 	if err != nil {
 		return err
@@ -175,7 +182,7 @@ func (o *listPipelineOpts) jsonOutputLocal(ctx context.Context) error {
 		return fmt.Errorf("marshal pipelines: %w", err)
 	}
 
-	fmt.Fprintf(o.w, "%s\n", b)
+	fmt.Fprintf(w, "%s\n", b)
 	return nil
 }
 
@@ -194,7 +201,7 @@ func (o *listPipelineOpts) humanOutputLocal() error {
 }
 
 // jsonOutputDeployed prints data about all pipelines in the given app that have been deployed.
-func (o *listPipelineOpts) jsonOutputDeployed(ctx context.Context) error {
+func (o *listPipelineOpts) jsonOutputDeployed(ctx context.Context, w io.Writer) error {
 	pipelines, err := getDeployedPipelines(ctx, o.appName, o.pipelineLister, o.newDescriber)
 	if err != nil {
 		return err
@@ -208,7 +215,7 @@ func (o *listPipelineOpts) jsonOutputDeployed(ctx context.Context) error {
 		return fmt.Errorf("marshal pipelines: %w", err)
 	}
 
-	fmt.Fprintf(o.w, "%s\n", b)
+	fmt.Fprintf(w, "%s\n", b)
 	return nil
 }
 
@@ -287,6 +294,9 @@ func buildPipelineListCmd() *cobra.Command {
   Lists all the pipelines for the frontend application.
   /code $ copilot pipeline ls -a frontend`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := vars.outputFormat.validate(); err != nil {
+				return err
+			}
 			opts, err := newListPipelinesOpts(vars)
 			if err != nil {
 				return err
@@ -300,6 +310,8 @@ func buildPipelineListCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.format, formatFlag, "", formatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.query, queryFlag, "", queryFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldShowLocalPipelines, localFlag, false, localPipelineFlagDescription)
 	return cmd
 }
@@ -160,7 +160,14 @@ func (o *packagePipelineOpts) Execute() error {
 		return fmt.Errorf("convert manifest path to relative path: %w", err)
 	}
 
-	stages, err := o.convertStages(pipelineMft.Stages)
+	wsWorkloads, err := o.getLocalWorkloads()
+	if err != nil {
+		return fmt.Errorf("get local workloads: %w", err)
+	}
+	if err := validatePipelineFilters(pipelineMft, wsWorkloads); err != nil {
+		return err
+	}
+	stages, err := o.convertStages(pipelineMft.Stages, pipelineMft.Workloads(wsWorkloads))
 	if err != nil {
 		return fmt.Errorf("convert environments to deployment stage: %w", err)
 	}
@@ -204,7 +211,7 @@ func (o *packagePipelineOpts) Execute() error {
 		PermissionsBoundary: o.app.PermissionsBoundary,
 	}
 
-	stackConfig := deploycfn.WrapWithTemplateOverrider(o.pipelineStackConfig(deployPipelineInput), overrider)
+	stackConfig := deploycfn.WrapWithTemplateOverrider(o.pipelineStackConfig(deployPipelineInput), clideploy.WithStackMetadata(overrider, o.app.StackMetadata))
 	tpl, err := stackConfig.Template()
 	if err != nil {
 		return err
@@ -247,12 +254,8 @@ func (o *packagePipelineOpts) isLegacy(inputName string) (bool, error) {
 	return false, nil
 }
 
-func (o *packagePipelineOpts) convertStages(manifestStages []manifest.PipelineStage) ([]deploy.PipelineStage, error) {
+func (o *packagePipelineOpts) convertStages(manifestStages []manifest.PipelineStage, workloads []string) ([]deploy.PipelineStage, error) {
 	var stages []deploy.PipelineStage
-	workloads, err := o.getLocalWorkloads()
-	if err != nil {
-		return nil, err
-	}
 	for _, stage := range manifestStages {
 		env, err := o.store.GetEnvironment(o.appName, stage.Name)
 		if err != nil {
@@ -261,6 +264,9 @@ func (o *packagePipelineOpts) convertStages(manifestStages []manifest.PipelineSt
 
 		var stg deploy.PipelineStage
 		stg.Init(env, &stage, workloads)
+		if len(stages) > 0 {
+			stg.PromoteFrom(&stages[len(stages)-1])
+		}
 		stages = append(stages, stg)
 	}
 	return stages, nil
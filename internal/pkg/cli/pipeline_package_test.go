@@ -174,7 +174,27 @@ func TestPipelinePackageOpts_Execute(t *testing.T) {
 					m.actionCmd.EXPECT().Execute().Return(someError),
 				)
 			},
-			expectedError: fmt.Errorf("convert environments to deployment stage: get local services: some error"),
+			expectedError: fmt.Errorf("get local workloads: get local services: some error"),
+		},
+		"returns an error if the filters list a workload that isn't in the workspace": {
+			callMocks: func(m packagePipelineMocks) {
+				mockFilteredPipelineManifest := &manifest.Pipeline{
+					Name:    pipelineName,
+					Version: 1,
+					Source:  mockPipelineManifest.Source,
+					Filters: &manifest.PipelineFilters{
+						Workloads: []string{"frontend", "api"},
+					},
+					Stages: mockPipelineManifest.Stages,
+				}
+				gomock.InOrder(
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{pipeline}, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockFilteredPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+				)
+			},
+			expectedError: errors.New(`workload api in the "filters" of pipeline pipepiper is not in the workspace`),
 		},
 		"returns an error if fails to fetch an application": {
 			callMocks: func(m packagePipelineMocks) {
@@ -0,0 +1,405 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineRollbackAppNamePrompt     = "Which application's pipeline would you like to roll back?"
+	pipelineRollbackAppNameHelpPrompt = "An application is a collection of related services."
+	pipelineRollbackStagePrompt       = "Which stage would you like to roll back?"
+	pipelineRollbackStageHelpPrompt   = "The stage is deployed again with the artifacts of its previous successful deployment."
+	pipelineRollbackConfirmHelp       = "The stacks of the stage are updated with the templates and parameters that the previous deployment deployed."
+
+	fmtPipelineRollbackPrompt        = "Which deployed pipeline of application %s would you like to roll back?"
+	fmtPipelineRollbackConfirmPrompt = "Are you sure you want to roll back stage %s of pipeline %s from revision %s to %s?"
+
+	// The template path and configuration of a CloudFormation deploy action are in the format "<artifact name>::<file path>".
+	pipelineArtifactPathSeparator = "::"
+)
+
+var (
+	errPipelineRollbackCancelled = errors.New("pipeline rollback cancelled - no stacks updated")
+)
+
+type rollbackPipelineVars struct {
+	appName          string
+	name             string
+	stage            string
+	skipConfirmation bool
+}
+
+type rollbackPipelineOpts struct {
+	rollbackPipelineVars
+
+	// Interfaces to dependencies.
+	store                  store
+	deployedPipelineLister deployedPipelineLister
+	pipelineSvc            pipelineGetter
+	deployments            stageDeploymentsGetter
+	sel                    codePipelineSelector
+	prompt                 prompter
+	newArtifactDownloader  func(region string) (artifactDownloader, error)
+	newStackDeployer       func(env *config.Environment) (stackConfigDeployer, error)
+
+	// Cached variables.
+	targetPipeline *deploy.Pipeline
+}
+
+func newRollbackPipelineOpts(vars rollbackPipelineVars) (*rollbackPipelineOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline rollback"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	ssmStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	pipelineLister := deploy.NewPipelineStore(rg.New(defaultSess))
+	pipelineSvc := codepipeline.New(defaultSess)
+
+	return &rollbackPipelineOpts{
+		rollbackPipelineVars:   vars,
+		store:                  ssmStore,
+		deployedPipelineLister: pipelineLister,
+		pipelineSvc:            pipelineSvc,
+		deployments:            pipelineSvc,
+		sel:                    selector.NewAppPipelineSelector(prompter, ssmStore, pipelineLister),
+		prompt:                 prompter,
+		newArtifactDownloader: func(region string) (artifactDownloader, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %w", region, err)
+			}
+			return s3.New(sess), nil
+		},
+		newStackDeployer: func(env *config.Environment) (stackConfigDeployer, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("assume role for environment %s: %w", env.Name, err)
+			}
+			return cloudformation.New(sess, cloudformation.WithProgressTracker(os.Stderr)), nil
+		},
+	}, nil
+}
+
+// Validate is a no-op for this command.
+func (o *rollbackPipelineOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates required fields.
+func (o *rollbackPipelineOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	} else {
+		app, err := o.sel.Application(pipelineRollbackAppNamePrompt, pipelineRollbackAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+
+	if o.name != "" {
+		pipeline, err := getDeployedPipelineInfo(o.deployedPipelineLister, o.appName, o.name)
+		if err != nil {
+			return fmt.Errorf("validate pipeline name %s: %w", o.name, err)
+		}
+		o.targetPipeline = &pipeline
+	} else {
+		pipeline, err := askDeployedPipelineName(o.sel, fmt.Sprintf(fmtPipelineRollbackPrompt, color.HighlightUserInput(o.appName)), o.appName)
+		if err != nil {
+			return err
+		}
+		o.name = pipeline.Name
+		o.targetPipeline = &pipeline
+	}
+	return o.askStage()
+}
+
+func (o *rollbackPipelineOpts) askStage() error {
+	pipeline, err := o.pipelineSvc.GetPipeline(o.targetPipeline.ResourceName)
+	if err != nil {
+		return fmt.Errorf("get pipeline %s: %w", o.name, err)
+	}
+	var envs []string
+	for _, stage := range pipeline.Stages {
+		if stage.Category == "Deploy" && strings.HasPrefix(stage.Name, deploy.StageFullNamePrefix) {
+			envs = append(envs, strings.TrimPrefix(stage.Name, deploy.StageFullNamePrefix))
+		}
+	}
+	if o.stage != "" {
+		for _, env := range envs {
+			if env == o.stage {
+				return nil
+			}
+		}
+		return fmt.Errorf("pipeline %s does not have a stage that deploys to environment %s", o.name, o.stage)
+	}
+	if len(envs) == 0 {
+		return fmt.Errorf("pipeline %s does not have any deploy stages", o.name)
+	}
+	env, err := o.prompt.SelectOne(pipelineRollbackStagePrompt, pipelineRollbackStageHelpPrompt, envs, prompt.WithFinalMessage("Stage:"))
+	if err != nil {
+		return fmt.Errorf("select stage: %w", err)
+	}
+	o.stage = env
+	return nil
+}
+
+// Execute deploys the stacks of the stage again with the artifacts of the previous successful deployment of the stage.
+func (o *rollbackPipelineOpts) Execute() error {
+	stageName := deploy.StageFullNamePrefix + o.stage
+	deployments, err := o.deployments.StageDeployments(o.targetPipeline.ResourceName, []string{stageName}, 2)
+	if err != nil {
+		return fmt.Errorf("get deployments of stage %s: %w", stageName, err)
+	}
+	if len(deployments[stageName]) < 2 {
+		return fmt.Errorf("stage %s of pipeline %s does not have a previous successful deployment to roll back to", stageName, o.name)
+	}
+	current, previous := deployments[stageName][0], deployments[stageName][1]
+	currentRevision, err := o.deployments.SourceRevision(o.targetPipeline.ResourceName, current.PipelineExecutionID)
+	if err != nil {
+		return fmt.Errorf("get current revision of stage %s: %w", stageName, err)
+	}
+	previousRevision, err := o.deployments.SourceRevision(o.targetPipeline.ResourceName, previous.PipelineExecutionID)
+	if err != nil {
+		return fmt.Errorf("get previous revision of stage %s: %w", stageName, err)
+	}
+	if !o.skipConfirmation {
+		confirmed, err := o.prompt.Confirm(
+			fmt.Sprintf(fmtPipelineRollbackConfirmPrompt, stageName, o.name, currentRevision, previousRevision),
+			pipelineRollbackConfirmHelp,
+			prompt.WithConfirmFinalMessage())
+		if err != nil {
+			return fmt.Errorf("pipeline rollback confirmation prompt: %w", err)
+		}
+		if !confirmed {
+			return errPipelineRollbackCancelled
+		}
+	}
+
+	env, err := o.store.GetEnvironment(o.appName, o.stage)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.stage, err)
+	}
+	deployer, err := o.newStackDeployer(env)
+	if err != nil {
+		return err
+	}
+	for _, action := range previous.Actions {
+		conf, bucket, err := o.actionStack(action)
+		if err != nil {
+			return err
+		}
+		err = deployer.DeployService(conf, bucket, false, awscloudformation.WithRoleARN(action.Configuration["RoleArn"]))
+		var errEmptyChangeSet *awscloudformation.ErrChangeSetEmpty
+		if err != nil && !errors.As(err, &errEmptyChangeSet) {
+			return fmt.Errorf("roll back stack %s: %w", conf.StackName(), err)
+		}
+	}
+	log.Successf("Rolled back stage %s of pipeline %s to revision %s.\n", color.HighlightUserInput(stageName), color.HighlightUserInput(o.name), previousRevision)
+	log.Infof("The next execution of the pipeline will deploy the stage again. Disable the transition to the stage to prevent it.\n")
+	return nil
+}
+
+// actionStack returns the stack deployed by the action, configured from the template and the template configuration in its input artifact,
+// and the bucket of the input artifact.
+func (o *rollbackPipelineOpts) actionStack(action codepipeline.DeployAction) (*pipelineArtifactStack, string, error) {
+	artifactName, templatePath, err := parsePipelineArtifactPath(action.Configuration["TemplatePath"])
+	if err != nil {
+		return nil, "", fmt.Errorf("parse template path of action %s: %w", action.Name, err)
+	}
+	var artifact *codepipeline.ArtifactLocation
+	for i := range action.InputArtifacts {
+		if action.InputArtifacts[i].Name == artifactName {
+			artifact = &action.InputArtifacts[i]
+			break
+		}
+	}
+	if artifact == nil {
+		return nil, "", fmt.Errorf("action %s does not have input artifact %s", action.Name, artifactName)
+	}
+	downloader, err := o.newArtifactDownloader(action.Region)
+	if err != nil {
+		return nil, "", err
+	}
+	content, err := downloader.Download(artifact.Bucket, artifact.Key)
+	if err != nil {
+		return nil, "", fmt.Errorf("download artifact %s of action %s: %w", artifactName, action.Name, err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, "", fmt.Errorf("read artifact %s of action %s: %w", artifactName, action.Name, err)
+	}
+	tpl, err := readZipFile(archive, templatePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("read template of action %s: %w", action.Name, err)
+	}
+	stack := &pipelineArtifactStack{
+		name:     action.Configuration["StackName"],
+		template: string(tpl),
+	}
+	if configPath, ok := action.Configuration["TemplateConfiguration"]; ok {
+		// The template configuration is in the same artifact as the template in Copilot pipelines.
+		_, path, err := parsePipelineArtifactPath(configPath)
+		if err != nil {
+			return nil, "", fmt.Errorf("parse template configuration path of action %s: %w", action.Name, err)
+		}
+		data, err := readZipFile(archive, path)
+		if err != nil {
+			return nil, "", fmt.Errorf("read template configuration of action %s: %w", action.Name, err)
+		}
+		if err := json.Unmarshal(data, &stack.config); err != nil {
+			return nil, "", fmt.Errorf("unmarshal template configuration of action %s: %w", action.Name, err)
+		}
+	}
+	return stack, artifact.Bucket, nil
+}
+
+func parsePipelineArtifactPath(path string) (artifact, file string, err error) {
+	artifact, file, ok := strings.Cut(path, pipelineArtifactPathSeparator)
+	if !ok {
+		return "", "", fmt.Errorf(`path %q is not in the format "<artifact>%s<file>"`, path, pipelineArtifactPathSeparator)
+	}
+	return artifact, file, nil
+}
+
+func readZipFile(archive *zip.Reader, path string) ([]byte, error) {
+	f, err := archive.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// pipelineArtifactStack is a stack deployed by a pipeline from the artifacts of one of its executions.
+type pipelineArtifactStack struct {
+	name     string
+	template string
+	config   pipelineTemplateConfig
+}
+
+// pipelineTemplateConfig is the template configuration file of a CloudFormation deploy action.
+type pipelineTemplateConfig struct {
+	Parameters map[string]string `json:"Parameters"`
+	Tags       map[string]string `json:"Tags,omitempty"`
+}
+
+// StackName returns the name of the stack.
+func (s *pipelineArtifactStack) StackName() string {
+	return s.name
+}
+
+// Template returns the template of the stack.
+func (s *pipelineArtifactStack) Template() (string, error) {
+	return s.template, nil
+}
+
+// Parameters returns the parameters of the stack sorted by key.
+func (s *pipelineArtifactStack) Parameters() ([]*sdkcloudformation.Parameter, error) {
+	var params []*sdkcloudformation.Parameter
+	for _, key := range sortedKeys(s.config.Parameters) {
+		params = append(params, &sdkcloudformation.Parameter{
+			ParameterKey:   aws.String(key),
+			ParameterValue: aws.String(s.config.Parameters[key]),
+		})
+	}
+	return params, nil
+}
+
+// Tags returns the tags of the stack sorted by key.
+func (s *pipelineArtifactStack) Tags() []*sdkcloudformation.Tag {
+	var tags []*sdkcloudformation.Tag
+	for _, key := range sortedKeys(s.config.Tags) {
+		tags = append(tags, &sdkcloudformation.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(s.config.Tags[key]),
+		})
+	}
+	return tags
+}
+
+// SerializedParameters returns the template configuration of the stack.
+func (s *pipelineArtifactStack) SerializedParameters() (string, error) {
+	out, err := json.MarshalIndent(s.config, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal template configuration of stack %s: %w", s.name, err)
+	}
+	return string(out), nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// RecommendActions is a no-op for this command.
+func (o *rollbackPipelineOpts) RecommendActions() error {
+	return nil
+}
+
+// buildPipelineRollbackCmd builds the command for rolling back a stage of a pipeline.
+func buildPipelineRollbackCmd() *cobra.Command {
+	vars := rollbackPipelineVars{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rolls back a stage of a pipeline to its previous successful deployment.",
+		Long: `Rolls back a stage of a pipeline to its previous successful deployment.
+The stacks of the stage are deployed again with the artifacts of the previous pipeline execution that deployed the stage successfully.`,
+		Example: `
+  Roll back the stage "prod" of the pipeline "my-pipeline".
+  /code $ copilot pipeline rollback -n my-pipeline --stage prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRollbackPipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVar(&vars.stage, stageFlag, "", pipelineStageFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
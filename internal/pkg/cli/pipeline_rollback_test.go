@@ -0,0 +1,372 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type rollbackPipelineMocks struct {
+	store                  *mocks.Mockstore
+	deployedPipelineLister *mocks.MockdeployedPipelineLister
+	pipelineSvc            *mocks.MockpipelineGetter
+	deployments            *mocks.MockstageDeploymentsGetter
+	sel                    *mocks.MockcodePipelineSelector
+	prompt                 *mocks.Mockprompter
+	downloader             *mocks.MockartifactDownloader
+	deployer               *mocks.MockstackConfigDeployer
+}
+
+func TestRollbackPipelineOpts_Ask(t *testing.T) {
+	const (
+		testAppName      = "badgoose"
+		testPipelineName = "honkpipes"
+	)
+	testPipeline := deploy.Pipeline{
+		AppName:      testAppName,
+		ResourceName: "pipeline-badgoose-honkpipes-Pipeline-1A2B",
+		Name:         testPipelineName,
+	}
+	testStages := &codepipeline.Pipeline{
+		Stages: []*codepipeline.Stage{
+			{Name: "Source", Category: "Source"},
+			{Name: "Build", Category: "Build"},
+			{Name: "DeployTo-test", Category: "Deploy"},
+			{Name: "DeployTo-prod", Category: "Deploy"},
+		},
+	}
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		inStage        string
+
+		callMocks          func(m rollbackPipelineMocks)
+		wantedAppName      string
+		wantedPipelineName string
+		wantedStage        string
+		wantedError        error
+	}{
+		"prompts for the app, the pipeline and the stage": {
+			callMocks: func(m rollbackPipelineMocks) {
+				m.sel.EXPECT().Application(pipelineRollbackAppNamePrompt, pipelineRollbackAppNameHelpPrompt).Return(testAppName, nil)
+				m.sel.EXPECT().DeployedPipeline(fmt.Sprintf(fmtPipelineRollbackPrompt, testAppName), "", testAppName).Return(testPipeline, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(testPipeline.ResourceName).Return(testStages, nil)
+				m.prompt.EXPECT().SelectOne(pipelineRollbackStagePrompt, pipelineRollbackStageHelpPrompt, []string{"test", "prod"}, gomock.Any()).Return("prod", nil)
+			},
+			wantedAppName:      testAppName,
+			wantedPipelineName: testPipelineName,
+			wantedStage:        "prod",
+		},
+		"errors if passed-in pipeline name is invalid": {
+			inAppName:      testAppName,
+			inPipelineName: "badPipelineName",
+			callMocks: func(m rollbackPipelineMocks) {
+				m.store.EXPECT().GetApplication(testAppName).Return(nil, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{testPipeline}, nil)
+			},
+			wantedError: errors.New("validate pipeline name badPipelineName: cannot find pipeline named badPipelineName"),
+		},
+		"errors if the pipeline can't be retrieved": {
+			inAppName:      testAppName,
+			inPipelineName: testPipelineName,
+			callMocks: func(m rollbackPipelineMocks) {
+				m.store.EXPECT().GetApplication(testAppName).Return(nil, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{testPipeline}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(testPipeline.ResourceName).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get pipeline honkpipes: some error"),
+		},
+		"errors if the pipeline doesn't deploy to the passed-in stage": {
+			inAppName:      testAppName,
+			inPipelineName: testPipelineName,
+			inStage:        "staging",
+			callMocks: func(m rollbackPipelineMocks) {
+				m.store.EXPECT().GetApplication(testAppName).Return(nil, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{testPipeline}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(testPipeline.ResourceName).Return(testStages, nil)
+			},
+			wantedError: errors.New("pipeline honkpipes does not have a stage that deploys to environment staging"),
+		},
+		"validates the passed-in app, pipeline and stage": {
+			inAppName:      testAppName,
+			inPipelineName: testPipelineName,
+			inStage:        "prod",
+			callMocks: func(m rollbackPipelineMocks) {
+				m.store.EXPECT().GetApplication(testAppName).Return(nil, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(testAppName).Return([]deploy.Pipeline{testPipeline}, nil)
+				m.pipelineSvc.EXPECT().GetPipeline(testPipeline.ResourceName).Return(testStages, nil)
+			},
+			wantedAppName:      testAppName,
+			wantedPipelineName: testPipelineName,
+			wantedStage:        "prod",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := rollbackPipelineMocks{
+				store:                  mocks.NewMockstore(ctrl),
+				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
+				pipelineSvc:            mocks.NewMockpipelineGetter(ctrl),
+				sel:                    mocks.NewMockcodePipelineSelector(ctrl),
+				prompt:                 mocks.NewMockprompter(ctrl),
+			}
+			tc.callMocks(m)
+			opts := &rollbackPipelineOpts{
+				rollbackPipelineVars: rollbackPipelineVars{
+					appName: tc.inAppName,
+					name:    tc.inPipelineName,
+					stage:   tc.inStage,
+				},
+				store:                  m.store,
+				deployedPipelineLister: m.deployedPipelineLister,
+				pipelineSvc:            m.pipelineSvc,
+				sel:                    m.sel,
+				prompt:                 m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.appName)
+			require.Equal(t, tc.wantedPipelineName, opts.name)
+			require.Equal(t, tc.wantedStage, opts.stage)
+			require.Equal(t, testPipeline, *opts.targetPipeline)
+		})
+	}
+}
+
+func TestRollbackPipelineOpts_Execute(t *testing.T) {
+	const (
+		testAppName      = "badgoose"
+		testPipelineName = "honkpipes"
+		testBucket       = "stackset-badgoose-pipelinebuiltartifactbuc"
+		testStageName    = "DeployTo-prod"
+		testExecRole     = "arn:aws:iam::123456789012:role/badgoose-prod-CFNExecutionRole"
+	)
+	testPipeline := deploy.Pipeline{
+		AppName:      testAppName,
+		ResourceName: "pipeline-badgoose-honkpipes-Pipeline-1A2B",
+		Name:         testPipelineName,
+	}
+	testEnv := &config.Environment{
+		Name:           "prod",
+		Region:         "us-west-2",
+		ManagerRoleARN: "arn:aws:iam::123456789012:role/badgoose-prod-EnvManagerRole",
+	}
+	testArtifact := func(t *testing.T, files map[string]string) []byte {
+		var buf bytes.Buffer
+		w := zip.NewWriter(&buf)
+		for name, content := range files {
+			f, err := w.Create(name)
+			require.NoError(t, err)
+			_, err = f.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+	testAction := func(name string) codepipeline.DeployAction {
+		return codepipeline.DeployAction{
+			Name:   "CreateOrUpdate-" + name + "-prod",
+			Region: "us-west-2",
+			Configuration: map[string]string{
+				"StackName":             "badgoose-prod-" + name,
+				"TemplatePath":          "BuildOutput::infrastructure/" + name + "-prod.stack.yml",
+				"TemplateConfiguration": "BuildOutput::infrastructure/" + name + "-prod.params.json",
+				"RoleArn":               testExecRole,
+			},
+			InputArtifacts: []codepipeline.ArtifactLocation{
+				{
+					Name:   "BuildOutput",
+					Bucket: testBucket,
+					Key:    "pipeline-badgoose-ho/BuildOutpu/" + name,
+				},
+			},
+		}
+	}
+	testDeployments := map[string][]codepipeline.StageDeployment{
+		testStageName: {
+			{
+				PipelineExecutionID: "exec-2",
+				Actions:             []codepipeline.DeployAction{testAction("api")},
+			},
+			{
+				PipelineExecutionID: "exec-1",
+				Actions:             []codepipeline.DeployAction{testAction("api"), testAction("worker")},
+			},
+		},
+	}
+	expectRevisions := func(m rollbackPipelineMocks) {
+		m.deployments.EXPECT().StageDeployments(testPipeline.ResourceName, []string{testStageName}, 2).Return(testDeployments, nil)
+		m.deployments.EXPECT().SourceRevision(testPipeline.ResourceName, "exec-2").Return("b8a6e2c", nil)
+		m.deployments.EXPECT().SourceRevision(testPipeline.ResourceName, "exec-1").Return("0a1b2c3", nil)
+	}
+	testCases := map[string]struct {
+		inSkipConfirmation bool
+
+		callMocks   func(m rollbackPipelineMocks)
+		wantedError error
+	}{
+		"error if the stage deployments can't be retrieved": {
+			callMocks: func(m rollbackPipelineMocks) {
+				m.deployments.EXPECT().StageDeployments(testPipeline.ResourceName, []string{testStageName}, 2).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get deployments of stage DeployTo-prod: some error"),
+		},
+		"error if the stage doesn't have a previous deployment": {
+			callMocks: func(m rollbackPipelineMocks) {
+				m.deployments.EXPECT().StageDeployments(testPipeline.ResourceName, []string{testStageName}, 2).Return(map[string][]codepipeline.StageDeployment{
+					testStageName: testDeployments[testStageName][:1],
+				}, nil)
+			},
+			wantedError: errors.New("stage DeployTo-prod of pipeline honkpipes does not have a previous successful deployment to roll back to"),
+		},
+		"cancelled when the rollback isn't confirmed": {
+			callMocks: func(m rollbackPipelineMocks) {
+				expectRevisions(m)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtPipelineRollbackConfirmPrompt, testStageName, testPipelineName, "b8a6e2c", "0a1b2c3"), pipelineRollbackConfirmHelp, gomock.Any()).Return(false, nil)
+			},
+			wantedError: errPipelineRollbackCancelled,
+		},
+		"error if the template isn't in the artifact": {
+			inSkipConfirmation: true,
+			callMocks: func(m rollbackPipelineMocks) {
+				expectRevisions(m)
+				m.store.EXPECT().GetEnvironment(testAppName, "prod").Return(testEnv, nil)
+				m.downloader.EXPECT().Download(testBucket, "pipeline-badgoose-ho/BuildOutpu/api").Return(testArtifact(t, map[string]string{
+					"infrastructure/api-prod.params.json": "{}",
+				}), nil)
+			},
+			wantedError: errors.New("read template of action CreateOrUpdate-api-prod: open infrastructure/api-prod.stack.yml: open infrastructure/api-prod.stack.yml: file does not exist"),
+		},
+		"error if a stack fails to deploy": {
+			inSkipConfirmation: true,
+			callMocks: func(m rollbackPipelineMocks) {
+				expectRevisions(m)
+				m.store.EXPECT().GetEnvironment(testAppName, "prod").Return(testEnv, nil)
+				m.downloader.EXPECT().Download(testBucket, "pipeline-badgoose-ho/BuildOutpu/api").Return(testArtifact(t, map[string]string{
+					"infrastructure/api-prod.stack.yml":   "api template",
+					"infrastructure/api-prod.params.json": "{}",
+				}), nil)
+				m.deployer.EXPECT().DeployService(gomock.Any(), testBucket, false, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("roll back stack badgoose-prod-api: some error"),
+		},
+		"deploys the stacks of the previous deployment": {
+			callMocks: func(m rollbackPipelineMocks) {
+				expectRevisions(m)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.store.EXPECT().GetEnvironment(testAppName, "prod").Return(testEnv, nil)
+				m.downloader.EXPECT().Download(testBucket, "pipeline-badgoose-ho/BuildOutpu/api").Return(testArtifact(t, map[string]string{
+					"infrastructure/api-prod.stack.yml":   "api template",
+					"infrastructure/api-prod.params.json": `{"Parameters": {"EnvName": "prod", "AppName": "badgoose"}, "Tags": {"copilot-application": "badgoose"}}`,
+				}), nil)
+				m.downloader.EXPECT().Download(testBucket, "pipeline-badgoose-ho/BuildOutpu/worker").Return(testArtifact(t, map[string]string{
+					"infrastructure/worker-prod.stack.yml":   "worker template",
+					"infrastructure/worker-prod.params.json": `{"Parameters": {}}`,
+				}), nil)
+				gomock.InOrder(
+					m.deployer.EXPECT().DeployService(gomock.Any(), testBucket, false, gomock.Any()).DoAndReturn(
+						func(conf deploycfn.StackConfiguration, _ string, _ bool, opts ...awscloudformation.StackOption) error {
+							require.Equal(t, "badgoose-prod-api", conf.StackName())
+							tpl, err := conf.Template()
+							require.NoError(t, err)
+							require.Equal(t, "api template", tpl)
+							params, err := conf.Parameters()
+							require.NoError(t, err)
+							require.Equal(t, []*sdkcloudformation.Parameter{
+								{ParameterKey: aws.String("AppName"), ParameterValue: aws.String("badgoose")},
+								{ParameterKey: aws.String("EnvName"), ParameterValue: aws.String("prod")},
+							}, params)
+							require.Equal(t, []*sdkcloudformation.Tag{
+								{Key: aws.String("copilot-application"), Value: aws.String("badgoose")},
+							}, conf.Tags())
+							stack := awscloudformation.NewStack("badgoose-prod-api", "")
+							for _, opt := range opts {
+								opt(stack)
+							}
+							require.Equal(t, testExecRole, aws.StringValue(stack.RoleARN))
+							return nil
+						}),
+					m.deployer.EXPECT().DeployService(gomock.Any(), testBucket, false, gomock.Any()).DoAndReturn(
+						func(conf deploycfn.StackConfiguration, _ string, _ bool, _ ...awscloudformation.StackOption) error {
+							require.Equal(t, "badgoose-prod-worker", conf.StackName())
+							return &awscloudformation.ErrChangeSetEmpty{}
+						}),
+				)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := rollbackPipelineMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployments: mocks.NewMockstageDeploymentsGetter(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+				downloader:  mocks.NewMockartifactDownloader(ctrl),
+				deployer:    mocks.NewMockstackConfigDeployer(ctrl),
+			}
+			tc.callMocks(m)
+			opts := &rollbackPipelineOpts{
+				rollbackPipelineVars: rollbackPipelineVars{
+					appName:          testAppName,
+					name:             testPipelineName,
+					stage:            "prod",
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:       m.store,
+				deployments: m.deployments,
+				prompt:      m.prompt,
+				newArtifactDownloader: func(region string) (artifactDownloader, error) {
+					require.Equal(t, "us-west-2", region)
+					return m.downloader, nil
+				},
+				newStackDeployer: func(env *config.Environment) (stackConfigDeployer, error) {
+					require.Equal(t, testEnv, env)
+					return m.deployer, nil
+				},
+				targetPipeline: &testPipeline,
+			}
+			log.DiagnosticWriter = &bytes.Buffer{}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
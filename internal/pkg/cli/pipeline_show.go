@@ -38,6 +38,7 @@ type showPipelineVars struct {
 	appName               string
 	name                  string
 	shouldOutputJSON      bool
+	outputFormat          outputFormatVars
 	shouldOutputResources bool
 }
 
@@ -101,7 +102,7 @@ func newShowPipelineOpts(vars showPipelineVars) (*showPipelineOpts, error) {
 
 // Validate returns an error if the optional flag values passed by the user are invalid.
 func (o *showPipelineOpts) Validate() error {
-	return nil
+	return o.outputFormat.validate()
 }
 
 // Ask prompts for fields that are required but not passed in, and validates those that are.
@@ -142,16 +143,17 @@ func (o *showPipelineOpts) Execute() error {
 		return fmt.Errorf("describe pipeline %s: %w", o.name, err)
 	}
 
-	if o.shouldOutputJSON {
-		data, err := pipeline.JSONString()
-		if err != nil {
-			return err
-		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, pipeline.HumanString())
+	if o.shouldOutputJSON || o.outputFormat.isSet() {
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			data, err := pipeline.JSONString()
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(w, data)
+			return nil
+		})
 	}
-
+	fmt.Fprint(o.w, pipeline.HumanString())
 	return nil
 }
 
@@ -197,6 +199,8 @@ func buildPipelineShowCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.format, formatFlag, "", formatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.query, queryFlag, "", queryFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, pipelineResourcesFlagDescription)
 
 	return cmd
@@ -37,6 +37,7 @@ const (
 
 type pipelineStatusVars struct {
 	appName          string
+	outputFormat     outputFormatVars
 	shouldOutputJSON bool
 	name             string
 }
@@ -98,7 +99,7 @@ func newPipelineStatusOpts(vars pipelineStatusVars) (*pipelineStatusOpts, error)
 
 // Validate returns an error if the optional flag values provided by the user are invalid.
 func (o *pipelineStatusOpts) Validate() error {
-	return nil
+	return o.outputFormat.validate()
 }
 
 // Ask prompts for fields that are required but not passed in, and validates those that are.
@@ -138,16 +139,17 @@ func (o *pipelineStatusOpts) Execute() error {
 		return fmt.Errorf("describe status of pipeline: %w", err)
 	}
 
-	if o.shouldOutputJSON {
-		data, err := pipelineStatus.JSONString()
-		if err != nil {
-			return err
-		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, pipelineStatus.HumanString())
+	if o.shouldOutputJSON || o.outputFormat.isSet() {
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			data, err := pipelineStatus.JSONString()
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(w, data)
+			return nil
+		})
 	}
-
+	fmt.Fprint(o.w, pipelineStatus.HumanString())
 	return nil
 }
 
@@ -194,6 +196,8 @@ Shows status of the pipeline "my-repo-my-branch".
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.format, formatFlag, "", formatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.query, queryFlag, "", queryFlagDescription)
 
 	return cmd
 }
@@ -0,0 +1,288 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	pipelineTestSelectPrompt = "Select a pipeline from your workspace to test"
+
+	// codeBuildLocalSrcDir is where the workspace is mounted in the container, like the source artifact in CodeBuild.
+	codeBuildLocalSrcDir = "/codebuild/output/src"
+	// CodeBuild curated images are also published to the ECR Public Gallery, which doesn't require credentials.
+	codeBuildImagePrefix       = "aws/codebuild/"
+	codeBuildPublicImagePrefix = "public.ecr.aws/codebuild/"
+)
+
+var errPipelineTestNotLocal = fmt.Errorf("running the commands in CodeBuild is not supported yet: specify %s", color.HighlightCode("--"+localFlag))
+
+type testPipelineVars struct {
+	name  string
+	stage string
+	local bool
+}
+
+type testPipelineOpts struct {
+	testPipelineVars
+
+	ws     wsPipelineTester
+	fs     afero.Fs
+	sel    wsPipelineSelector
+	docker localCommandRunner
+	sess   *session.Session
+
+	// Cached variables.
+	pipeline *workspace.PipelineManifest
+}
+
+// codeBuildBuildspec is the part of a buildspec file that Copilot runs locally.
+type codeBuildBuildspec struct {
+	Env struct {
+		Variables map[string]string `yaml:"variables"`
+	} `yaml:"env"`
+	Phases struct {
+		Install  codeBuildPhase `yaml:"install"`
+		PreBuild codeBuildPhase `yaml:"pre_build"`
+		Build    codeBuildPhase `yaml:"build"`
+	} `yaml:"phases"`
+}
+
+type codeBuildPhase struct {
+	Commands []string `yaml:"commands"`
+}
+
+// localCodeBuildRun is a set of commands to run in the image of a CodeBuild project.
+type localCodeBuildRun struct {
+	image    string
+	commands []string
+	envVars  map[string]string
+}
+
+func newTestPipelineOpts(vars testPipelineVars) (*testPipelineOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline test"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+	return &testPipelineOpts{
+		testPipelineVars: vars,
+		ws:               ws,
+		fs:               fs,
+		sel:              selector.NewWsPipelineSelector(prompt.New(), ws),
+		docker:           dockerengine.New(exec.NewCmd()),
+		sess:             defaultSess,
+	}, nil
+}
+
+// Validate returns an error if the flag values are invalid.
+func (o *testPipelineOpts) Validate() error {
+	if !o.local {
+		return errPipelineTestNotLocal
+	}
+	return o.docker.CheckDockerEngineRunning()
+}
+
+// Ask prompts for and validates the pipeline.
+func (o *testPipelineOpts) Ask() error {
+	if o.name == "" {
+		pipeline, err := o.sel.WsPipeline(pipelineTestSelectPrompt, "")
+		if err != nil {
+			return fmt.Errorf("select pipeline: %w", err)
+		}
+		o.pipeline = pipeline
+		o.name = pipeline.Name
+		return nil
+	}
+	pipelines, err := o.ws.ListPipelines()
+	if err != nil {
+		return fmt.Errorf("list pipelines: %w", err)
+	}
+	for i := range pipelines {
+		if pipelines[i].Name == o.name {
+			o.pipeline = &pipelines[i]
+			return nil
+		}
+	}
+	return fmt.Errorf(`pipeline %s not found in the workspace`, color.HighlightUserInput(o.name))
+}
+
+// Execute runs the commands of the build stage or of the test commands of a stage in a local container.
+func (o *testPipelineOpts) Execute() error {
+	mft, err := o.ws.ReadPipelineManifest(o.pipeline.Path)
+	if err != nil {
+		return fmt.Errorf("read pipeline manifest: %w", err)
+	}
+	if err := mft.Validate(); err != nil {
+		return fmt.Errorf("validate pipeline manifest: %w", err)
+	}
+	var run *localCodeBuildRun
+	if o.stage != "" {
+		run, err = o.testCommandsRun(mft)
+	} else {
+		run, err = o.buildRun(mft)
+	}
+	if err != nil {
+		return err
+	}
+	if len(run.commands) == 0 {
+		log.Infoln("There are no commands to run.")
+		return nil
+	}
+	creds, err := o.sess.Config.Credentials.Get()
+	if err != nil {
+		return fmt.Errorf("get IAM credentials: %w", err)
+	}
+	envVars := map[string]string{
+		"CODEBUILD_SRC_DIR": codeBuildLocalSrcDir,
+	}
+	for key, value := range run.envVars {
+		envVars[key] = value
+	}
+	if region := aws.StringValue(o.sess.Config.Region); region != "" {
+		envVars["AWS_REGION"] = region
+		envVars["AWS_DEFAULT_REGION"] = region
+	}
+	image := localCodeBuildImage(run.image)
+	log.Infof("Running the commands in image %s.\n", color.HighlightResource(image))
+	if err := o.docker.Run(context.Background(), &dockerengine.RunOptions{
+		ImageURI: image,
+		EnvVars:  envVars,
+		Secrets: map[string]string{
+			"AWS_ACCESS_KEY_ID":     creds.AccessKeyID,
+			"AWS_SECRET_ACCESS_KEY": creds.SecretAccessKey,
+			"AWS_SESSION_TOKEN":     creds.SessionToken,
+		},
+		Mounts: []dockerengine.Mount{
+			{
+				Source: o.ws.Path(),
+				Target: codeBuildLocalSrcDir,
+			},
+		},
+		WorkingDir: codeBuildLocalSrcDir,
+		Remove:     true,
+		// Like CodeBuild, stop at the first command that fails.
+		Entrypoint: []string{"/bin/sh", "-c", "set -e\n" + strings.Join(run.commands, "\n")},
+	}); err != nil {
+		return fmt.Errorf("run commands of pipeline %s: %w", o.name, err)
+	}
+	log.Successf("Ran the commands of pipeline %s.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+// buildRun returns the commands of the buildspec of the build stage that run before the artifacts are generated.
+func (o *testPipelineOpts) buildRun(mft *manifest.Pipeline) (*localCodeBuildRun, error) {
+	relPath, err := o.ws.Rel(o.pipeline.Path)
+	if err != nil {
+		return nil, fmt.Errorf("convert manifest path to relative path: %w", err)
+	}
+	var build deploy.Build
+	if err := build.Init(mft.Build, filepath.Dir(relPath)); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(o.ws.Path(), filepath.FromSlash(build.BuildspecPath))
+	content, err := afero.ReadFile(o.fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("read buildspec %s: %w", build.BuildspecPath, err)
+	}
+	var buildspec codeBuildBuildspec
+	if err := yaml.Unmarshal(content, &buildspec); err != nil {
+		return nil, fmt.Errorf("unmarshal buildspec %s: %w", build.BuildspecPath, err)
+	}
+	// The variables of the CodeBuild project take precedence over the ones of the buildspec.
+	envVars := make(map[string]string)
+	for key, value := range buildspec.Env.Variables {
+		envVars[key] = value
+	}
+	for key, value := range build.Variables {
+		envVars[key] = value
+	}
+	var commands []string
+	commands = append(commands, buildspec.Phases.Install.Commands...)
+	commands = append(commands, buildspec.Phases.PreBuild.Commands...)
+	commands = append(commands, buildspec.Phases.Build.Commands...)
+	return &localCodeBuildRun{
+		image:    build.Image,
+		commands: commands,
+		envVars:  envVars,
+	}, nil
+}
+
+// testCommandsRun returns the test commands of the stage.
+func (o *testPipelineOpts) testCommandsRun(mft *manifest.Pipeline) (*localCodeBuildRun, error) {
+	for _, stage := range mft.Stages {
+		if stage.Name == o.stage {
+			return &localCodeBuildRun{
+				image:    deploy.PipelineTestCommandsImage,
+				commands: stage.TestCommands,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("pipeline %s does not have a stage that deploys to environment %s", o.name, o.stage)
+}
+
+// localCodeBuildImage returns the image to pull for a CodeBuild image.
+func localCodeBuildImage(image string) string {
+	if strings.HasPrefix(image, codeBuildImagePrefix) {
+		return codeBuildPublicImagePrefix + strings.TrimPrefix(image, codeBuildImagePrefix)
+	}
+	return image
+}
+
+// RecommendActions is a no-op for this command.
+func (o *testPipelineOpts) RecommendActions() error {
+	return nil
+}
+
+// buildPipelineTestCmd builds the command for running the commands of a pipeline locally.
+func buildPipelineTestCmd() *cobra.Command {
+	vars := testPipelineVars{}
+	cmd := &cobra.Command{
+		Use:   "test",
+		Short: "Runs the build or test commands of a pipeline locally.",
+		Long: `Runs the build or test commands of a pipeline locally.
+The commands run in a container of the image that CodeBuild uses, with the workspace mounted as the source directory.`,
+		Example: `
+  Run the install, pre_build and build phases of the buildspec of the pipeline "my-pipeline".
+  /code $ copilot pipeline test --local -n my-pipeline
+  Run the test commands of the stage "test" of the pipeline "my-pipeline".
+  /code $ copilot pipeline test --local -n my-pipeline --stage test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newTestPipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVar(&vars.stage, stageFlag, "", pipelineTestStageFlagDescription)
+	cmd.Flags().BoolVar(&vars.local, localFlag, false, pipelineTestLocalFlagDescription)
+	return cmd
+}
@@ -0,0 +1,63 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+)
+
+// defaultsFromLast is the value of the --defaults-from flag that replays the answers of the last run of a command.
+const defaultsFromLast = "last"
+
+type promptHistoryReadWriter interface {
+	ReadPromptHistory() ([]byte, error)
+	WritePromptHistory(marshaler encoding.BinaryMarshaler) (string, error)
+}
+
+// newPromptWithHistory returns a prompt that saves the answers to the prompts of the command cmdName in the workspace.
+// If defaultsFrom is "last", the prompts answered the last time the command ran in the workspace aren't asked again.
+// Failing to read or save the answers doesn't fail the command.
+func newPromptWithHistory(ws promptHistoryReadWriter, cmdName, defaultsFrom string) prompt.Prompt {
+	readHistory := func() (prompt.History, error) {
+		data, err := ws.ReadPromptHistory()
+		if err != nil {
+			return nil, fmt.Errorf("read prompt history: %w", err)
+		}
+		return prompt.ParseHistory(data)
+	}
+	replay := defaultsFrom == defaultsFromLast
+	answers := make(prompt.Answers)
+	if replay {
+		history, err := readHistory()
+		if err != nil {
+			log.Warningf("Couldn't read the answers of the last %s: %v\n", cmdName, err)
+		} else if prev, ok := history[cmdName]; ok {
+			answers = prev
+		}
+	}
+	return prompt.New().WithHistory(answers, replay, func(answers prompt.Answers) error {
+		history, err := readHistory()
+		if err != nil {
+			log.Warningf("Couldn't save the answers of %s: %v\n", cmdName, err)
+			return nil
+		}
+		history[cmdName] = answers
+		if _, err := ws.WritePromptHistory(history); err != nil {
+			log.Warningf("Couldn't save the answers of %s: %v\n", cmdName, err)
+		}
+		return nil
+	})
+}
+
+// validateDefaultsFrom returns an error if the value of the --defaults-from flag is invalid.
+func validateDefaultsFrom(defaultsFrom string) error {
+	if defaultsFrom == "" || defaultsFrom == defaultsFromLast {
+		return nil
+	}
+	return fmt.Errorf("invalid --%s %q: must be %q", defaultsFromFlag, defaultsFrom, defaultsFromLast)
+}
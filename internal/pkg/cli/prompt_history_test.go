@@ -0,0 +1,62 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakePromptHistory struct {
+	data    []byte
+	readErr error
+}
+
+func (f *fakePromptHistory) ReadPromptHistory() ([]byte, error) {
+	return f.data, f.readErr
+}
+
+func (f *fakePromptHistory) WritePromptHistory(marshaler encoding.BinaryMarshaler) (string, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	f.data = data
+	return "/copilot/.prompts.yml", nil
+}
+
+func TestNewPromptWithHistory(t *testing.T) {
+	t.Run("replays the answers of the last run of the command", func(t *testing.T) {
+		ws := &fakePromptHistory{
+			data: []byte(`
+storage init:
+  What type of storage would you like to create?: DynamoDB
+pipeline init:
+  What type of storage would you like to create?: S3
+`),
+		}
+		p := newPromptWithHistory(ws, "storage init", defaultsFromLast)
+
+		got, err := p.SelectOne("What type of storage would you like to create?", "", []string{"DynamoDB", "S3"})
+
+		require.NoError(t, err)
+		require.Equal(t, "DynamoDB", got)
+	})
+	t.Run("tolerates a history that can't be read", func(t *testing.T) {
+		ws := &fakePromptHistory{
+			readErr: errors.New("some error"),
+		}
+
+		require.NotNil(t, newPromptWithHistory(ws, "storage init", defaultsFromLast))
+	})
+}
+
+func TestValidateDefaultsFrom(t *testing.T) {
+	require.NoError(t, validateDefaultsFrom(""))
+	require.NoError(t, validateDefaultsFrom("last"))
+	require.EqualError(t, validateDefaultsFrom("first"), `invalid --defaults-from "first": must be "last"`)
+}
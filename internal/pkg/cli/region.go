@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+)
+
+// regionService is an AWS service that must be available in the region of an environment.
+type regionService struct {
+	id   string // Endpoint ID of the service.
+	name string
+
+	workloads []string // Workloads in the workspace that need the service, empty if every environment needs it.
+}
+
+var (
+	ecsRegionService       = regionService{id: awsecs.EndpointsID, name: "Amazon ECS"}
+	elbRegionService       = regionService{id: elbv2.EndpointsID, name: "Elastic Load Balancing"}
+	appRunnerRegionService = regionService{id: apprunner.EndpointsID, name: "AWS App Runner"}
+	sqsRegionService       = regionService{id: sqs.EndpointsID, name: "Amazon SQS"}
+	snsRegionService       = regionService{id: sns.EndpointsID, name: "Amazon SNS"}
+	s3RegionService        = regionService{id: s3.EndpointsID, name: "Amazon S3"}
+	sfnRegionService       = regionService{id: sfn.EndpointsID, name: "AWS Step Functions"}
+)
+
+// envRegionServices are the services that every environment needs.
+var envRegionServices = []regionService{ecsRegionService}
+
+// workloadRegionServices are the services that each workload type needs on top of the ones of its environment.
+var workloadRegionServices = map[string][]regionService{
+	manifestinfo.LoadBalancedWebServiceType:  {elbRegionService},
+	manifestinfo.RequestDrivenWebServiceType: {appRunnerRegionService},
+	manifestinfo.WorkerServiceType:           {sqsRegionService, snsRegionService},
+	manifestinfo.StaticSiteType:              {s3RegionService},
+	manifestinfo.ScheduledJobType:            {sfnRegionService},
+}
+
+// workspaceRegionServices returns the services that the environments of the workloads in the workspace need.
+// Workloads whose manifest can't be read are ignored, they are reported when they are deployed.
+func workspaceRegionServices(ws wsWorkloadManifestLister) ([]regionService, error) {
+	services := append([]regionService{}, envRegionServices...)
+	names, err := ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	for _, name := range names {
+		mft, err := ws.ReadWorkloadManifest(name)
+		if err != nil {
+			continue
+		}
+		typ, err := mft.WorkloadType()
+		if err != nil {
+			continue
+		}
+		for _, required := range workloadRegionServices[typ] {
+			services = addRegionServiceWorkload(services, required, name)
+		}
+	}
+	return services, nil
+}
+
+func addRegionServiceWorkload(services []regionService, required regionService, workload string) []regionService {
+	for i, svc := range services {
+		if svc.id != required.id {
+			continue
+		}
+		if len(svc.workloads) != 0 {
+			services[i].workloads = append(svc.workloads, workload)
+		}
+		return services
+	}
+	required.workloads = []string{workload}
+	return append(services, required)
+}
+
+// unsupportedRegionWarning returns a warning if any of the services isn't available in the region,
+// or doesn't have a FIPS endpoint in the region if fips is true, along with the regions that support all of them.
+// It returns an empty string if the region supports every service.
+func unsupportedRegionWarning(region string, fips bool, services []regionService) (string, error) {
+	isAvailable, unsupported := partitions.IsAvailableInRegion, "aren't available"
+	if fips {
+		isAvailable, unsupported = partitions.IsFIPSAvailableInRegion, "don't have a FIPS endpoint"
+	}
+	var missing []string
+	ids := make([]string, len(services))
+	for i, svc := range services {
+		ids[i] = svc.id
+		ok, err := isAvailable(svc.id, region)
+		if err != nil {
+			return "", fmt.Errorf("check if %s is available in region %s: %w", svc.name, region, err)
+		}
+		if ok {
+			continue
+		}
+		if len(svc.workloads) == 0 {
+			missing = append(missing, fmt.Sprintf("  - %s, needed by every environment", svc.name))
+			continue
+		}
+		missing = append(missing, fmt.Sprintf("  - %s, needed by %s", svc.name, strings.Join(svc.workloads, ", ")))
+	}
+	if len(missing) == 0 {
+		return "", nil
+	}
+	recommended, err := partitions.RegionsWithServices(region, ids, fips)
+	if err != nil {
+		return "", fmt.Errorf("find regions that support %s: %w", strings.Join(ids, ", "), err)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "These services %s in region %s, deployments there will fail:\n", unsupported, region)
+	fmt.Fprintf(&b, "%s\n", strings.Join(missing, "\n"))
+	if len(recommended) == 0 {
+		fmt.Fprintf(&b, "No region in the same partition supports all of them.\n")
+		return b.String(), nil
+	}
+	fmt.Fprintf(&b, "Consider one of these regions instead: %s\n", strings.Join(recommended, ", "))
+	return b.String(), nil
+}
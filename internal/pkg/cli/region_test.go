@@ -0,0 +1,114 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceRegionServices(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockwsWorkloadManifestLister)
+
+		wanted    []regionService
+		wantedErr string
+	}{
+		"error if the workloads can't be listed": {
+			setupMocks: func(m *mocks.MockwsWorkloadManifestLister) {
+				m.EXPECT().ListWorkloads().Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list workloads in the workspace: some error",
+		},
+		"services of the environment and of each workload type": {
+			setupMocks: func(m *mocks.MockwsWorkloadManifestLister) {
+				m.EXPECT().ListWorkloads().Return([]string{"api", "fe", "worker", "queue", "broken"}, nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest("type: Request-Driven Web Service"), nil)
+				m.EXPECT().ReadWorkloadManifest("fe").Return(workspace.WorkloadManifest("type: Backend Service"), nil)
+				m.EXPECT().ReadWorkloadManifest("worker").Return(workspace.WorkloadManifest("type: Worker Service"), nil)
+				m.EXPECT().ReadWorkloadManifest("queue").Return(workspace.WorkloadManifest("type: Worker Service"), nil)
+				m.EXPECT().ReadWorkloadManifest("broken").Return(nil, errors.New("some error"))
+			},
+			wanted: []regionService{
+				ecsRegionService,
+				{id: "apprunner", name: "AWS App Runner", workloads: []string{"api"}},
+				{id: "sqs", name: "Amazon SQS", workloads: []string{"worker", "queue"}},
+				{id: "sns", name: "Amazon SNS", workloads: []string{"worker", "queue"}},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockwsWorkloadManifestLister(ctrl)
+			tc.setupMocks(m)
+
+			got, err := workspaceRegionServices(m)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestUnsupportedRegionWarning(t *testing.T) {
+	appRunner := regionService{id: "apprunner", name: "AWS App Runner", workloads: []string{"api", "web"}}
+	testCases := map[string]struct {
+		region   string
+		fips     bool
+		services []regionService
+
+		wanted    string
+		wantedErr string
+	}{
+		"error if the region isn't in any partition": {
+			region:    "weird region",
+			services:  envRegionServices,
+			wantedErr: "check if Amazon ECS is available in region weird region: find the partition for region weird region",
+		},
+		"no warning if every service is available": {
+			region:   "us-west-2",
+			services: []regionService{ecsRegionService, appRunner},
+		},
+		"warns about the services that aren't available with the regions that support all of them": {
+			region:   "us-west-1",
+			services: []regionService{ecsRegionService, appRunner},
+			wanted: `These services aren't available in region us-west-1, deployments there will fail:
+  - AWS App Runner, needed by api, web
+Consider one of these regions instead: ap-northeast-1, ap-southeast-1, ap-southeast-2, eu-central-1, eu-west-1, us-east-1, us-east-2, us-west-2
+`,
+		},
+		"warns about the services without a FIPS endpoint": {
+			region:   "eu-west-1",
+			fips:     true,
+			services: []regionService{ecsRegionService},
+			wanted: `These services don't have a FIPS endpoint in region eu-west-1, deployments there will fail:
+  - Amazon ECS, needed by every environment
+Consider one of these regions instead: us-east-1, us-east-2, us-west-1, us-west-2
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := unsupportedRegionWarning(tc.region, tc.fips, tc.services)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -4,11 +4,22 @@
 package cli
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"net"
 	"os"
 	"os/signal"
+	"path"
+	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -19,10 +30,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
 	sdksecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
 	sdkssm "github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
@@ -34,11 +49,16 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
+	wkldtemplate "github.com/aws/copilot-cli/internal/pkg/template"
 	termcolor "github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -46,26 +66,150 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/term/syncbuffer"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
 	"github.com/fatih/color"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v3"
 )
 
 const (
 	workloadAskPrompt = "Which workload would you like to run locally?"
 
-	pauseContainerURI  = "public.ecr.aws/amazonlinux/amazonlinux:2023"
+	pauseContainerURI  = wkldtemplate.DefaultPauseImage
 	pauseContainerName = "pause"
+	// otelCollectorContainerName is the ADOT collector sidecar that Copilot adds to the services with tracing.
+	// It runs locally like in the task, so that the containers export their traces to it on localhost.
+	otelCollectorContainerName = "aws-otel-collector"
+
+	watchInterval = time.Second
+	// minSecretsRefreshInterval is the shortest interval to resolve the secrets of the containers again at,
+	// so that refreshing them doesn't exceed the request quotas of SSM and Secrets Manager.
+	minSecretsRefreshInterval = time.Minute
+	// maxConcurrentSecretRequests limits the requests to Secrets Manager in flight while the secrets are resolved,
+	// so that tasks with many secrets aren't throttled.
+	maxConcurrentSecretRequests = 5
+	dependencyPollInterval      = time.Second
+
+	proxyLocalPortStart = 61000 // First port of the pause container that the session manager plugin listens on.
+	// proxySetupScript installs iptables and the session manager plugin in the pause container.
+	proxySetupScript = `set -e
+case "$(uname -m)" in
+  aarch64) arch=linux_arm64 ;;
+  *) arch=linux_64bit ;;
+esac
+dnf install -y -q iptables-nft
+curl -sSfL -o /tmp/session-manager-plugin.rpm "https://s3.amazonaws.com/session-manager-downloads/plugin/latest/${arch}/session-manager-plugin.rpm"
+dnf install -y -q /tmp/session-manager-plugin.rpm`
+
+	debugRuntimeJava   = "java"
+	debugRuntimeNode   = "node"
+	debugRuntimePython = "python"
+	debugRuntimeGo     = "go"
+
+	// Markers of the archive of a volume in the output of the command that copies it from a running task.
+	volumeArchiveStart = "copilot-volume-archive-start"
+	volumeArchiveEnd   = "copilot-volume-archive-end"
+
+	logsFormatRaw        = "raw"
+	logsFormatJSONPretty = "json-pretty"
+	logsFormatFile       = "file"
+
+	// The local FireLens log router receives the logs of the containers with the forward protocol of Fluentd
+	// on a port of the host, since the Docker daemon sends them, and prints the records to its output.
+	firelensForwardPort    = "24224"
+	firelensConfigPath     = "/fluent-bit/etc/fluent-bit.conf"
+	firelensLogDriver      = "awsfirelens"
+	firelensLocalTagSuffix = "-firelens-local"
+	fmtFireLensConfig      = `# Generated by Copilot to run the FireLens log router of %s locally.
+[SERVICE]
+    Flush 1
+    Grace 1
+
+[INPUT]
+    Name   forward
+    Listen 0.0.0.0
+    Port   %s
+%s
+[OUTPUT]
+    Name   stdout
+    Match  *
+    Format json_lines
+`
+)
+
+var (
+	// localRunDirName is the directory, relative to the workspace, that stores the configuration and the volumes of the workloads run locally.
+	localRunDirName = filepath.Join(".copilot", "local")
+
+	defaultProxyNetwork = net.IPNet{
+		IP:   net.IPv4(172, 20, 0, 0),
+		Mask: net.CIDRMask(16, 32),
+	}
+
+	// proxyEndpointPattern matches "host:port" and URLs in the values of environment variables.
+	proxyEndpointPattern = regexp.MustCompile(`(?:([a-zA-Z][a-zA-Z0-9+.-]*)://(?:[^@/\s]*@)?)?([A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?(?:\.[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?)*)(?::([0-9]{1,5}))?`)
+	// proxySchemeDefaultPorts are the ports of the URL schemes when a URL doesn't specify one.
+	proxySchemeDefaultPorts = map[string]int{
+		"http":       80,
+		"https":      443,
+		"postgres":   5432,
+		"postgresql": 5432,
+		"mysql":      3306,
+		"redis":      6379,
+		"rediss":     6379,
+		"mongodb":    27017,
+	}
+	// proxyHostSuffixes are the suffixes of the hostnames that are only reachable from the environment's VPC,
+	// such as service discovery names and the endpoints of RDS, ElastiCache, DocumentDB and OpenSearch.
+	proxyHostSuffixes = []string{
+		".local",
+		".internal",
+		".rds.amazonaws.com",
+		".cache.amazonaws.com",
+		".docdb.amazonaws.com",
+		".es.amazonaws.com",
+	}
+
+	debugRuntimes = []string{debugRuntimeJava, debugRuntimeNode, debugRuntimePython, debugRuntimeGo}
+	logsFormats   = []string{logsFormatRaw, logsFormatJSONPretty, logsFormatFile}
+	runPlatforms  = []string{dockerengine.PlatformString(dockerengine.OSLinux, dockerengine.ArchAMD64), dockerengine.PlatformString(dockerengine.OSLinux, dockerengine.ArchARM64)}
+	// debugRuntimeImageKeywords are the words in the names of the images of each runtime, in the order to match them.
+	debugRuntimeImageKeywords = []struct {
+		runtime  string
+		keywords []string
+	}{
+		{debugRuntimeJava, []string{"java", "jdk", "jre", "corretto", "temurin", "maven", "gradle"}},
+		{debugRuntimeNode, []string{"node"}},
+		{debugRuntimePython, []string{"python"}},
+		{debugRuntimeGo, []string{"golang"}},
+	}
 )
 
 type runLocalVars struct {
-	wkldName      string
-	wkldType      string
-	appName       string
-	envName       string
-	envOverrides  map[string]string
-	portOverrides portOverrides
+	wkldName               string
+	wkldType               string
+	appName                string
+	envName                string
+	envOverrides           map[string]string
+	portOverrides          portOverrides
+	volumeOverrides        map[string]string
+	seedVolumes            bool
+	watch                  bool
+	sync                   bool
+	proxy                  bool
+	proxyNetwork           net.IPNet
+	debug                  debugTargets
+	logsFormat             string
+	refreshSecrets         bool
+	offline                bool
+	firelens               bool
+	useTaskRole            bool
+	offsetPorts            bool
+	localAWS               string
+	platform               string
+	secretsRefreshInterval time.Duration
 }
 
 type runLocalOpts struct {
@@ -73,8 +217,11 @@ type runLocalOpts struct {
 
 	sel             deploySelector
 	ecsLocalClient  ecsLocalClient
+	rdwsDescriber   apprunnerServiceGetter // Nil unless the workload is a Request-Driven Web Service.
 	ssm             secretGetter
-	secretsManager  secretGetter
+	portForwarder   portForwarder
+	commandSessions commandSessionStarter
+	secretsManager  secretVersionGetter
 	sessProvider    sessionProvider
 	sess            *session.Session
 	envSess         *session.Session
@@ -88,12 +235,32 @@ type runLocalOpts struct {
 	containerSuffix string
 	newColor        func() *color.Color
 	prog            progress
-
-	buildContainerImages func(mft manifest.DynamicWorkload) (map[string]string, error)
-	configureClients     func(o *runLocalOpts) error
-	labeledTermPrinter   func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) clideploy.LabeledTermPrinter
-	unmarshal            func([]byte) (manifest.DynamicWorkload, error)
-	newInterpolator      func(app, env string) interpolator
+	watchInterval   time.Duration
+	pollInterval    time.Duration      // Interval to check whether the dependencies of a container meet their conditions.
+	restarts        *containerRestarts // Nil unless the containers are restarted when their images are rebuilt.
+	debugged        map[string]debugSettings
+	logFiles        map[string]io.WriteCloser    // Files that the logs of each container are copied to with the file logs format.
+	logEntryStarts  map[string]func(string) bool // Whether a line starts a log event for containers with a multiline awslogs configuration.
+	firelensRouter  string                       // Name of the FireLens log router container that the logs are routed to, if any.
+	firelensConfig  string                       // Fluent Bit configuration file of the host mounted in the log router.
+	firelensPort    string                       // Port of the host that the log router receives the logs on.
+	volumes         map[string]string            // Directories of the host bind mounted for each volume of the task definition.
+	pluginInstalled bool                         // Whether the session manager plugin is installed in the pause container.
+	pauseImage      string                       // Image of the pause container instead of the default one, if any.
+	network         string                       // User-defined network that the pause container joins, shared with other workloads run locally.
+	networkAliases  []string                     // Hostnames that resolve to the pause container in the network.
+	buildPlatform   string                       // Platform to build the images for instead of the one of the manifest, if any.
+	fs              afero.Fs
+	cache           *runLocalCache      // Nil if there is no cache directory.
+	cached          *runLocalCacheEntry // Nil until the cache is read.
+
+	buildContainerImages   func(mft manifest.DynamicWorkload, containers ...string) (map[string]string, error)
+	containerBuildContexts func(mft manifest.DynamicWorkload) (map[string]clideploy.ContainerBuildContext, error)
+	configureClients       func(o *runLocalOpts) error
+	labeledTermPrinter     func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) clideploy.LabeledTermPrinter
+	unmarshal              func([]byte) (manifest.DynamicWorkload, error)
+	newInterpolator        func(app, env string) interpolator
+	taskRoleCredentials    func(roleARN string) *credentials.Credentials
 }
 
 func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
@@ -130,6 +297,12 @@ func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
 		labeledTermPrinter: labeledTermPrinter,
 		newColor:           termcolor.ColorGenerator(),
 		prog:               termprogress.NewSpinner(log.DiagnosticWriter),
+		watchInterval:      watchInterval,
+		pollInterval:       dependencyPollInterval,
+		fs:                 afero.NewOsFs(),
+	}
+	if cache, err := newRunLocalCache(opts.fs); err == nil {
+		opts.cache = cache
 	}
 	opts.configureClients = func(o *runLocalOpts) error {
 		defaultSessEnvRegion, err := o.sessProvider.DefaultWithRegion(o.targetEnv.Region)
@@ -146,7 +319,24 @@ func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
 		// so use the default sess and *hope* they have permissions.
 		o.ecsLocalClient = ecs.New(o.envSess)
 		o.ssm = ssm.New(o.envSess)
+		// The task role of a workload trusts the EnvManagerRole, and not the user.
+		o.taskRoleCredentials = func(roleARN string) *credentials.Credentials {
+			return stscreds.NewCredentials(o.envSess, roleARN)
+		}
 		o.secretsManager = secretsmanager.New(defaultSessEnvRegion)
+		// Same as for Secrets Manager, the EnvManagerRole can't start sessions.
+		o.portForwarder = ssm.New(defaultSessEnvRegion)
+		o.commandSessions = awsecs.New(defaultSessEnvRegion)
+		if o.wkldType == manifestinfo.RequestDrivenWebServiceType {
+			o.rdwsDescriber, err = describe.NewRDWebServiceDescriber(describe.NewServiceConfig{
+				App:         o.appName,
+				Svc:         o.wkldName,
+				ConfigStore: o.store,
+			})
+			if err != nil {
+				return fmt.Errorf("create describer for service %s: %w", o.wkldName, err)
+			}
+		}
 
 		resources, err := cloudformation.New(o.sess, cloudformation.WithProgressTracker(os.Stderr)).GetAppResourcesByRegion(o.targetApp, o.targetEnv.Region)
 		if err != nil {
@@ -154,24 +344,43 @@ func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
 		}
 		repoName := clideploy.RepoName(o.appName, o.wkldName)
 		o.repository = repository.NewWithURI(ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[o.wkldName])
+		if o.cached != nil {
+			o.cached.RepositoryURI = resources.RepositoryURLs[o.wkldName]
+		}
+
+		// The application can pull the pause image from a mirror instead.
+		if uri, ok := o.targetApp.HelperImages[wkldtemplate.HelperImagePause]; ok {
+			o.pauseImage = uri
+			return nil
+		}
+		// The pause image loaded by "copilot bundle install" has the session manager plugin preinstalled,
+		// so that it runs in an air-gapped network.
+		if exists, _ := dockerengine.New(exec.NewCmd()).ImageExists(context.Background(), bundledPauseImageURI); exists {
+			o.pauseImage = bundledPauseImageURI
+			o.pluginInstalled = true
+		}
 		return nil
 	}
-	opts.buildContainerImages = func(mft manifest.DynamicWorkload) (map[string]string, error) {
+	opts.buildContainerImages = func(mft manifest.DynamicWorkload, containers ...string) (map[string]string, error) {
 		gitShortCommit := imageTagFromGit(opts.cmd)
 		image := clideploy.ContainerImageIdentifier{
 			GitShortCommitTag: gitShortCommit,
 		}
 		out := &clideploy.UploadArtifactsOutput{}
 		if err := clideploy.BuildContainerImages(&clideploy.ImageActionInput{
-			Name:               opts.wkldName,
-			WorkspacePath:      opts.ws.Path(),
-			Image:              image,
-			Mft:                mft.Manifest(),
-			GitShortCommitTag:  gitShortCommit,
-			Builder:            opts.repository,
-			Login:              opts.repository.Login,
-			CheckDockerEngine:  opts.dockerEngine.CheckDockerEngineRunning,
-			LabeledTermPrinter: opts.labeledTermPrinter,
+			Name:                opts.wkldName,
+			WorkspacePath:       opts.ws.Path(),
+			Image:               image,
+			Mft:                 mft.Manifest(),
+			GitShortCommitTag:   gitShortCommit,
+			Builder:             opts.repository,
+			Login:               opts.repository.Login,
+			CheckDockerEngine:   opts.dockerEngine.Preflight,
+			AnalyzeBuildContext: opts.dockerEngine.AnalyzeBuildContext,
+			Containers:          containers,
+			Platform:            opts.buildPlatform,
+			LabeledTermPrinter:  opts.labeledTermPrinter,
+			GetSSMParameter:     opts.ssm.GetSecretValue,
 		}, out); err != nil {
 			return nil, err
 		}
@@ -186,6 +395,9 @@ func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
 		}
 		return containerURIs, nil
 	}
+	opts.containerBuildContexts = func(mft manifest.DynamicWorkload) (map[string]clideploy.ContainerBuildContext, error) {
+		return clideploy.ContainerBuildContexts(opts.wkldName, opts.ws.Path(), mft.Manifest())
+	}
 	return opts, nil
 }
 
@@ -194,6 +406,27 @@ func (o *runLocalOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if o.watch && o.sync {
+		return fmt.Errorf("--%s and --%s cannot be specified together", watchFlag, syncFlag)
+	}
+	if o.logsFormat != "" && !contains(o.logsFormat, logsFormats) {
+		return fmt.Errorf("invalid logs format %q: must be one of %s",
+			o.logsFormat, english.WordSeries(applyAll(logsFormats, strconv.Quote), "or"))
+	}
+	if o.localAWS != "" && !contains(o.localAWS, localAWSEmulators) {
+		return fmt.Errorf("invalid AWS emulator %q: must be one of %s",
+			o.localAWS, english.WordSeries(applyAll(localAWSEmulators, strconv.Quote), "or"))
+	}
+	if o.platform != "" && !contains(o.platform, runPlatforms) {
+		return fmt.Errorf("invalid platform %q: must be one of %s",
+			o.platform, english.WordSeries(applyAll(runPlatforms, strconv.Quote), "or"))
+	}
+	if o.secretsRefreshInterval < 0 || (o.secretsRefreshInterval > 0 && o.secretsRefreshInterval < minSecretsRefreshInterval) {
+		return fmt.Errorf("invalid --%s %s: must be at least %s", secretsRefreshIntervalFlag, o.secretsRefreshInterval, minSecretsRefreshInterval)
+	}
+	if o.offline {
+		return o.validateOffline()
+	}
 	// Ensure that the application name provided exists in the workspace
 	app, err := o.store.GetApplication(o.appName)
 	if err != nil {
@@ -203,8 +436,47 @@ func (o *runLocalOpts) Validate() error {
 	return nil
 }
 
+// validateOffline validates the flags of an offline run, and reads the configuration of the workload from the cache.
+func (o *runLocalOpts) validateOffline() error {
+	if o.refreshSecrets {
+		return fmt.Errorf("cannot specify both --%s and --%s", offlineFlag, refreshSecretsFlag)
+	}
+	if o.secretsRefreshInterval > 0 {
+		return fmt.Errorf("cannot specify both --%s and --%s: refreshing secrets requires network access", offlineFlag, secretsRefreshIntervalFlag)
+	}
+	if o.proxy {
+		return fmt.Errorf("cannot specify both --%s and --%s: proxying connections requires network access", offlineFlag, proxyFlag)
+	}
+	if o.seedVolumes {
+		return fmt.Errorf("cannot specify both --%s and --%s: seeding volumes requires network access", offlineFlag, seedVolumesFlag)
+	}
+	if o.wkldName == "" || o.envName == "" {
+		return fmt.Errorf("--%s and --%s are required with --%s", nameFlag, envFlag, offlineFlag)
+	}
+	if o.cache == nil {
+		return errors.New("no cache directory to read the configuration of the workload from")
+	}
+	entry, err := o.cache.read(o.appName, o.envName, o.wkldName)
+	if errors.Is(err, errRunLocalCacheMiss) {
+		return fmt.Errorf("nothing is cached for %s in environment %s: run it once with network access first", o.wkldName, o.envName)
+	}
+	if err != nil {
+		return fmt.Errorf("read cache: %w", err)
+	}
+	o.cached = entry
+	o.targetApp = entry.App
+	o.targetEnv = entry.Env
+	o.wkldType = entry.WorkloadType
+	return nil
+}
+
 // Ask prompts the user for any unprovided required fields and validates them.
 func (o *runLocalOpts) Ask() error {
+	if o.offline {
+		// The workload and environment are validated against the cache instead.
+		o.containerSuffix = o.getContainerSuffix()
+		return nil
+	}
 	return o.validateAndAskWkldEnvName()
 }
 
@@ -243,21 +515,122 @@ func (o *runLocalOpts) validateAndAskWkldEnvName() error {
 
 // Execute builds and runs the workload images locally.
 func (o *runLocalOpts) Execute() error {
-	if err := o.configureClients(o); err != nil {
-		return err
+	if o.proxy && manifestinfo.IsTypeAJob(o.wkldType) {
+		return fmt.Errorf("cannot proxy connections through job %s: only services have running tasks", o.wkldName)
+	}
+	if o.seedVolumes && manifestinfo.IsTypeAJob(o.wkldType) {
+		return fmt.Errorf("cannot seed volumes from job %s: only services have running tasks", o.wkldName)
+	}
+	if o.proxy && o.wkldType == manifestinfo.RequestDrivenWebServiceType {
+		return fmt.Errorf("cannot proxy connections through Request-Driven Web Service %s: only ECS services have running tasks", o.wkldName)
+	}
+	if o.seedVolumes && o.wkldType == manifestinfo.RequestDrivenWebServiceType {
+		return fmt.Errorf("cannot seed volumes from Request-Driven Web Service %s: only ECS services have running tasks", o.wkldName)
+	}
+	if o.offline {
+		o.configureOfflineClients()
+	} else {
+		o.readCache()
+		if err := o.configureClients(o); err != nil {
+			return err
+		}
 	}
 
 	ctx := context.Background()
+	wkld, err := o.prepare(ctx)
+	if err != nil {
+		return err
+	}
+	defer wkld.close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	g, ctx := errgroup.WithContext(ctx)
+	gotSigInt := &atomic.Bool{}
+	watchCtx, stopWatching := context.WithCancel(ctx)
+	defer stopWatching()
+
+	g.Go(func() error {
+		defer cancel() // needed in case all containers exit successfully
+		return o.run(ctx, g, wkld, gotSigInt)
+	})
+
+	g.Go(func() error {
+		waitForInterrupt(ctx, gotSigInt, stopWatching)
+		return o.cleanUpContainers(context.Background(), wkld.containerURIs)
+	})
 
-	taskDef, err := o.ecsLocalClient.TaskDefinition(o.appName, o.envName, o.wkldName)
+	if o.restarts != nil {
+		g.Go(func() error {
+			// Containers can only be restarted once they joined the network of the pause container.
+			select {
+			case <-wkld.pauseStarted:
+			case <-watchCtx.Done():
+				return nil
+			}
+			return o.watchBuildContexts(watchCtx, wkld.mft, wkld.buildContexts)
+		})
+	}
+	if len(wkld.fileSyncs) > 0 {
+		g.Go(func() error {
+			select {
+			case <-wkld.pauseStarted:
+			case <-watchCtx.Done():
+				return nil
+			}
+			return o.watchFileSyncs(watchCtx, wkld.fileSyncs)
+		})
+	}
+	if wkld.refreshSecrets {
+		g.Go(func() error {
+			select {
+			case <-wkld.pauseStarted:
+			case <-watchCtx.Done():
+				return nil
+			}
+			return o.watchSecrets(watchCtx, wkld.envVars, wkld.taskDef)
+		})
+	}
+
+	return g.Wait()
+}
+
+// localWorkload is a workload whose images are built and that is ready to run locally.
+type localWorkload struct {
+	taskDef        *awsecs.TaskDefinition
+	envVars        map[string]containerEnv
+	ports          map[string]string // Container port to host port.
+	containerURIs  map[string]string
+	mft            manifest.DynamicWorkload
+	buildContexts  map[string]clideploy.ContainerBuildContext
+	fileSyncs      map[string]manifest.ImageSync // Container name to the files of the workspace copied into it when they change.
+	refreshSecrets bool                          // Whether the secrets of the containers are resolved again periodically.
+	endpoints      []proxyEndpoint
+	proxyTarget    string
+	serviceConnect []serviceConnectEndpoint // Service Connect client aliases that the other workloads of the network connect to.
+	seedTarget     *volumeSeedTarget
+	pauseStarted   chan struct{} // Closed once the pause container is running.
+	close          func()        // Closes the log files of the containers.
+}
+
+// prepare gets the configuration of the deployed workload and builds its images.
+func (o *runLocalOpts) prepare(ctx context.Context) (*localWorkload, error) {
+	sessions, err := o.otherLocalSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	taskDef, err := o.taskDefinition()
 	if err != nil {
-		return fmt.Errorf("get task definition: %w", err)
+		return nil, err
 	}
 
 	envVars, err := o.getEnvVars(ctx, taskDef)
 	if err != nil {
-		return fmt.Errorf("get env vars: %w", err)
+		return nil, fmt.Errorf("get env vars: %w", err)
 	}
+	o.writeCache()
 
 	// map of containerPort -> hostPort
 	ports := make(map[string]string)
@@ -276,6 +649,42 @@ func (o *runLocalOpts) Execute() error {
 		ports[port.container] = port.host
 	}
 
+	if err := o.configureVolumes(taskDef); err != nil {
+		return nil, err
+	}
+	var seedTarget *volumeSeedTarget
+	if o.seedVolumes {
+		seedTarget, err = o.volumeSeedTarget()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var proxyTarget string
+	var endpoints []proxyEndpoint
+	if o.proxy {
+		endpoints, err = proxyEndpoints(envVars, o.proxyNetwork)
+		if err != nil {
+			return nil, err
+		}
+		if len(endpoints) == 0 {
+			log.Warningf("No endpoint in the environment variables and secrets of %s is only reachable from the VPC, so there is nothing to proxy.\n", o.wkldName)
+		} else {
+			proxyTarget, err = o.proxyTarget()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var serviceConnect []serviceConnectEndpoint
+	if o.network != "" {
+		serviceConnect, err = o.serviceConnect(taskDef)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	mft, err := workloadManifest(&workloadManifestInput{
 		name:         o.wkldName,
 		appName:      o.appName,
@@ -286,12 +695,15 @@ func (o *runLocalOpts) Execute() error {
 		sess:         o.envSess,
 	})
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if err := o.configurePlatform(taskDef, mft); err != nil {
+		return nil, err
 	}
 
 	containerURIs, err := o.buildContainerImages(mft)
 	if err != nil {
-		return fmt.Errorf("build images: %w", err)
+		return nil, fmt.Errorf("build images: %w", err)
 	}
 
 	// fill the location from the task def for containers without a URI
@@ -302,74 +714,435 @@ func (o *runLocalOpts) Execute() error {
 		}
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	if o.localAWS != "" {
+		if err := o.configureLocalAWS(taskDef, envVars, ports, containerURIs); err != nil {
+			return nil, err
+		}
+	}
 
-	g, ctx := errgroup.WithContext(ctx)
-	gotSigInt := &atomic.Bool{}
+	var buildContexts map[string]clideploy.ContainerBuildContext
+	if o.watch || len(o.debug) > 0 {
+		buildContexts, err = o.containerBuildContexts(mft)
+		if err != nil {
+			return nil, fmt.Errorf("get build contexts: %w", err)
+		}
+	}
+	if o.watch {
+		if len(buildContexts) == 0 {
+			log.Warningf("No image of %s is built from the workspace, so there are no files to watch.\n", o.wkldName)
+		} else {
+			o.restarts = newContainerRestarts()
+		}
+	}
+	var syncs map[string]manifest.ImageSync
+	if o.sync {
+		syncs = fileSyncs(mft, taskDef)
+		if len(syncs) == 0 {
+			log.Warningf("No image of %s has files to sync in its manifest, so there are no files to copy into the containers.\n", o.wkldName)
+		}
+	}
+	var refreshSecrets bool
+	if o.secretsRefreshInterval > 0 {
+		if len(taskDef.Secrets()) == 0 {
+			log.Warningf("No container of %s has secrets, so there are no secrets to refresh.\n", o.wkldName)
+		} else {
+			refreshSecrets = true
+			if o.restarts == nil {
+				o.restarts = newContainerRestarts()
+			}
+		}
+	}
+	if len(o.debug) > 0 {
+		if err := o.configureDebuggers(taskDef, buildContexts, ports, envVars); err != nil {
+			return nil, err
+		}
+	}
+	if err := o.configureLogs(taskDef, ports); err != nil {
+		return nil, err
+	}
+	if err := o.offsetUsedPorts(ports, sessions); err != nil {
+		return nil, err
+	}
+	closeLogFiles := func() {}
+	if o.logsFormat == logsFormatFile {
+		closeLogFiles, err = o.openLogFiles(taskDef)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &localWorkload{
+		taskDef:        taskDef,
+		envVars:        envVars,
+		ports:          ports,
+		containerURIs:  containerURIs,
+		mft:            mft,
+		buildContexts:  buildContexts,
+		fileSyncs:      syncs,
+		refreshSecrets: refreshSecrets,
+		endpoints:      endpoints,
+		proxyTarget:    proxyTarget,
+		serviceConnect: serviceConnect,
+		seedTarget:     seedTarget,
+		pauseStarted:   make(chan struct{}),
+		close:          closeLogFiles,
+	}, nil
+}
 
-	g.Go(func() error {
-		defer cancel() // needed in case all containers exit successfully
+// run starts the pause container of the workload, sets up its Service Connect redirects, proxy and volumes, and runs its containers until they exit.
+// The connections to the proxied endpoints are forwarded in goroutines of g.
+// Errors are ignored once the user interrupted the command, since stopping the containers makes them fail.
+func (o *runLocalOpts) run(ctx context.Context, g *errgroup.Group, wkld *localWorkload, gotSigInt *atomic.Bool) error {
+	if err := o.saveSessionState(wkld); err != nil {
+		return err
+	}
+	redirects := serviceConnectRedirects(wkld.serviceConnect)
+	if err := o.runPauseContainer(ctx, wkld.ports, wkld.endpoints, redirects); err != nil {
+		// if we've received a sigint, we want to ignore
+		// any errors coming from this goroutine
+		if gotSigInt.Load() {
+			return nil
+		}
+		return fmt.Errorf("run pause container: %w", err)
+	}
+	close(wkld.pauseStarted)
 
-		if err := o.runPauseContainer(ctx, ports); err != nil {
-			// if we've received a sigint, we want to ignore
-			// any errors coming from this goroutine
+	if err := o.setUpServiceConnect(ctx, redirects); err != nil {
+		if gotSigInt.Load() {
+			return nil
+		}
+		return fmt.Errorf("set up service connect: %w", err)
+	}
+	if len(wkld.endpoints) > 0 {
+		if err := o.setUpProxy(ctx, wkld.endpoints); err != nil {
+			if gotSigInt.Load() {
+				return nil
+			}
+			return fmt.Errorf("set up proxy: %w", err)
+		}
+		for _, endpoint := range wkld.endpoints {
+			endpoint := endpoint
+			g.Go(func() error {
+				err := o.forwardPort(ctx, wkld.proxyTarget, endpoint)
+				if gotSigInt.Load() {
+					return nil
+				}
+				return err
+			})
+		}
+	}
+	if wkld.seedTarget != nil {
+		if err := o.seedVolumesFrom(ctx, wkld.seedTarget, wkld.taskDef); err != nil {
 			if gotSigInt.Load() {
 				return nil
 			}
-			return fmt.Errorf("run pause container: %w", err)
+			return fmt.Errorf("seed volumes: %w", err)
 		}
+	}
 
-		err := o.runContainers(ctx, containerURIs, envVars)
-		if gotSigInt.Load() {
-			return nil
+	err := o.runContainers(ctx, wkld.containerURIs, wkld.envVars, wkld.taskDef)
+	if gotSigInt.Load() {
+		return nil
+	}
+	return err
+}
+
+// saveSessionState persists the containers, network and ports of the workload before they're started,
+// so that "copilot run local stop" can clean them up if the command exits without stopping them.
+func (o *runLocalOpts) saveSessionState(wkld *localWorkload) error {
+	state := o.sessionState()
+	state.PID = os.Getpid()
+	state.Network = o.network
+	state.Started = time.Now()
+	names := make([]string, 0, len(wkld.containerURIs))
+	for name := range wkld.containerURIs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		state.Containers = append(state.Containers, fmt.Sprintf("%s-%s", name, o.containerSuffix))
+	}
+	state.Containers = append(state.Containers, fmt.Sprintf("%s-%s", pauseContainerName, o.containerSuffix))
+	state.Ports = make(map[string]string, len(wkld.ports))
+	for ctr, host := range wkld.ports {
+		state.Ports[host] = ctr
+	}
+	if err := writeLocalSessionState(o.fs, filepath.Join(o.ws.Path(), localSessionsDirName), state); err != nil {
+		return fmt.Errorf("save local session: %w", err)
+	}
+	return nil
+}
+
+// sessionState returns the state that identifies the local session of the workload.
+func (o *runLocalOpts) sessionState() *localSessionState {
+	return &localSessionState{
+		App:         o.appName,
+		Environment: o.envName,
+		Workload:    o.wkldName,
+	}
+}
+
+// waitForInterrupt blocks until the context is canceled or the user interrupts the command.
+// On interrupt, it sets gotSigInt and calls stop before the containers are stopped.
+func waitForInterrupt(ctx context.Context, gotSigInt *atomic.Bool, stop func()) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+
+	select {
+	case <-ctx.Done():
+	case <-sigCh:
+		gotSigInt.Store(true)
+		stop()
+		// reset signal handler in case we get ctrl+c again
+		// while trying to stop containers
+		signal.Stop(sigCh)
+		fmt.Printf("\nStopping containers...\n\n")
+	}
+}
+
+// taskDefinition returns the task definition of the workload.
+// Request-Driven Web Services don't have one, so it's emulated from the configuration of their App Runner service.
+func (o *runLocalOpts) taskDefinition() (*awsecs.TaskDefinition, error) {
+	if o.offline {
+		if o.cached.TaskDef == nil {
+			return nil, fmt.Errorf("no task definition is cached for %s in environment %s", o.wkldName, o.envName)
 		}
-		return err
-	})
+		return o.cached.TaskDef, nil
+	}
+	taskDef, err := o.fetchTaskDefinition()
+	if err != nil {
+		return nil, err
+	}
+	if o.cached != nil {
+		o.cached.TaskDef = taskDef
+	}
+	return taskDef, nil
+}
 
-	g.Go(func() error {
-		sigCh := make(chan os.Signal, 1)
-		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
-		defer signal.Stop(sigCh)
+func (o *runLocalOpts) fetchTaskDefinition() (*awsecs.TaskDefinition, error) {
+	if o.wkldType != manifestinfo.RequestDrivenWebServiceType {
+		taskDef, err := o.ecsLocalClient.TaskDefinition(o.appName, o.envName, o.wkldName)
+		if err != nil {
+			return nil, fmt.Errorf("get task definition: %w", err)
+		}
+		return taskDef, nil
+	}
+	svc, err := o.rdwsDescriber.Service(o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get App Runner service: %w", err)
+	}
+	return appRunnerTaskDefinition(o.wkldName, svc)
+}
 
-		select {
-		case <-ctx.Done():
-		case <-sigCh:
-			gotSigInt.Store(true)
-			// reset signal handler in case we get ctrl+c again
-			// while trying to stop containers
-			signal.Stop(sigCh)
-			fmt.Printf("\nStopping containers...\n\n")
+// appRunnerTaskDefinition returns a task definition with a single container that runs like the App Runner service:
+// it gets the runtime environment variables and secrets of the service, and listens on the port of the service,
+// which App Runner also injects as the PORT environment variable.
+func appRunnerTaskDefinition(name string, svc *apprunner.Service) (*awsecs.TaskDefinition, error) {
+	port, err := strconv.ParseInt(svc.Port, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse port %q of App Runner service %s: %w", svc.Port, svc.Name, err)
+	}
+	env := []*sdkecs.KeyValuePair{
+		{
+			Name:  aws.String("PORT"),
+			Value: aws.String(svc.Port),
+		},
+	}
+	for _, v := range svc.EnvironmentVariables {
+		if v.Name == "PORT" {
+			continue
 		}
+		env = append(env, &sdkecs.KeyValuePair{
+			Name:  aws.String(v.Name),
+			Value: aws.String(v.Value),
+		})
+	}
+	var secrets []*sdkecs.Secret
+	for _, s := range svc.EnvironmentSecrets {
+		secrets = append(secrets, &sdkecs.Secret{
+			Name:      aws.String(s.Name),
+			ValueFrom: aws.String(s.Value),
+		})
+	}
+	return &awsecs.TaskDefinition{
+		ContainerDefinitions: []*sdkecs.ContainerDefinition{
+			{
+				Name:        aws.String(name),
+				Image:       aws.String(svc.ImageID),
+				Environment: env,
+				Secrets:     secrets,
+				PortMappings: []*sdkecs.PortMapping{
+					{
+						ContainerPort: aws.Int64(port),
+						HostPort:      aws.Int64(port),
+					},
+				},
+			},
+		},
+	}, nil
+}
 
-		return o.cleanUpContainers(context.Background(), containerURIs)
-	})
+// openLogFiles creates the files that the logs of the containers of the task definition are copied to,
+// and returns a function that closes them.
+func (o *runLocalOpts) openLogFiles(taskDef *awsecs.TaskDefinition) (func(), error) {
+	dir := logging.LocalLogsDir(o.ws.Path(), o.wkldName)
+	o.logFiles = make(map[string]io.WriteCloser)
+	closeAll := func() {
+		for _, f := range o.logFiles {
+			f.Close()
+		}
+	}
+	for _, container := range taskDef.ContainerDefinitions {
+		name := aws.StringValue(container.Name)
+		f, err := logging.NewLocalLogFile(o.fs, dir, name)
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("open log file of container %q: %w", name, err)
+		}
+		o.logFiles[name] = f
+	}
+	log.Infof("Writing the logs of the containers to %s, run %s to view them.\n", dir,
+		termcolor.HighlightCode(fmt.Sprintf("copilot svc logs --local -n %s", o.wkldName)))
+	return closeAll, nil
+}
 
-	return g.Wait()
+// configureLogs groups the output of the containers into log events like their awslogs configuration,
+// and with the --firelens flag, sets up the log router of the task to receive the logs routed to FireLens.
+func (o *runLocalOpts) configureLogs(taskDef *awsecs.TaskDefinition, ports map[string]string) error {
+	o.logEntryStarts = make(map[string]func(string) bool)
+	var router *sdkecs.ContainerDefinition
+	var routed bool
+	for _, container := range taskDef.ContainerDefinitions {
+		if container.FirelensConfiguration != nil {
+			router = container
+		}
+		if container.LogConfiguration == nil {
+			continue
+		}
+		name := aws.StringValue(container.Name)
+		if aws.StringValue(container.LogConfiguration.LogDriver) == firelensLogDriver {
+			routed = true
+		}
+		isEntryStart, err := logging.AWSLogsEntryStart(container.LogConfiguration.Options)
+		if err != nil {
+			return fmt.Errorf("parse the log configuration of container %q: %w", name, err)
+		}
+		if isEntryStart != nil {
+			o.logEntryStarts[name] = isEntryStart
+		}
+	}
+	if !o.firelens {
+		return nil
+	}
+	if router == nil || !routed {
+		return fmt.Errorf("%s doesn't route the logs of any container to a FireLens log router", o.wkldName)
+	}
+	if typ := aws.StringValue(router.FirelensConfiguration.Type); typ != sdkecs.FirelensConfigurationTypeFluentbit {
+		return fmt.Errorf("FireLens log router of type %q is not supported locally, only %q is", typ, sdkecs.FirelensConfigurationTypeFluentbit)
+	}
+	dir := filepath.Join(o.ws.Path(), localRunDirName, "firelens", o.wkldName)
+	if err := o.fs.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", dir, err)
+	}
+	path := filepath.Join(dir, "fluent-bit.conf")
+	if err := afero.WriteFile(o.fs, path, []byte(o.fireLensConfig(router)), 0644); err != nil {
+		return fmt.Errorf("write FireLens configuration to %s: %w", path, err)
+	}
+	o.firelensRouter = aws.StringValue(router.Name)
+	o.firelensConfig = path
+	o.firelensPort = firelensForwardPort
+	ports[firelensForwardPort] = firelensForwardPort
+	return nil
+}
+
+// fireLensConfig returns the Fluent Bit configuration of the local log router: like in ECS, it includes the configuration
+// file of the router, but its records are printed instead of being sent to the destinations of the containers.
+func (o *runLocalOpts) fireLensConfig(router *sdkecs.ContainerDefinition) string {
+	var include string
+	opts := router.FirelensConfiguration.Options
+	switch aws.StringValue(opts["config-file-type"]) {
+	case "file":
+		include = fmt.Sprintf("\n@INCLUDE %s\n", aws.StringValue(opts["config-file-value"]))
+	case "s3":
+		log.Warningf("The configuration file of the FireLens log router of %s is in S3, so it isn't used locally.\n", o.wkldName)
+	}
+	return fmt.Sprintf(fmtFireLensConfig, o.wkldName, firelensForwardPort, include)
+}
+
+// containerLogDriver returns the logging driver that sends the logs of the container to the local FireLens log router, if any.
+func (o *runLocalOpts) containerLogDriver(def *sdkecs.ContainerDefinition) *dockerengine.LogDriver {
+	if o.firelensRouter == "" || def == nil || def.LogConfiguration == nil || aws.StringValue(def.LogConfiguration.LogDriver) != firelensLogDriver {
+		return nil
+	}
+	return &dockerengine.LogDriver{
+		Name: "fluentd",
+		Options: map[string]string{
+			"fluentd-address": "localhost:" + o.firelensPort,
+			"fluentd-async":   "true", // Don't fail to start the container before the log router is listening.
+			"tag":             aws.StringValue(def.Name) + firelensLocalTagSuffix,
+		},
+	}
+}
+
+// containerLogOptions returns how the logs of the container are printed, and copied to a file, based on the logs format.
+func (o *runLocalOpts) containerLogOptions(name string) dockerengine.RunLogOptions {
+	opts := dockerengine.RunLogOptions{
+		Color:        o.newColor(),
+		LinePrefix:   fmt.Sprintf("[%s] ", name),
+		IsEntryStart: o.logEntryStarts[name],
+	}
+	switch o.logsFormat {
+	case logsFormatJSONPretty:
+		opts.FormatLine = logging.FormatJSONLogLine
+	case logsFormatFile:
+		if f, ok := o.logFiles[name]; ok {
+			opts.Tee = f
+		}
+	}
+	return opts
 }
 
 func (o *runLocalOpts) getContainerSuffix() string {
 	return fmt.Sprintf("%s-%s-%s", o.appName, o.envName, o.wkldName)
 }
 
-func (o *runLocalOpts) runPauseContainer(ctx context.Context, ports map[string]string) error {
+func (o *runLocalOpts) runPauseContainer(ctx context.Context, ports map[string]string, endpoints []proxyEndpoint, redirects []serviceConnectEndpoint) error {
 	// flip ports to be host->ctr
 	flippedPorts := make(map[string]string, len(ports))
 	for k, v := range ports {
 		flippedPorts[v] = k
 	}
 	containerNameWithSuffix := fmt.Sprintf("%s-%s", pauseContainerName, o.containerSuffix)
+	imageURI := pauseContainerURI
+	if o.pauseImage != "" {
+		imageURI = o.pauseImage
+	}
 	runOptions := &dockerengine.RunOptions{
-		ImageURI:       pauseContainerURI,
+		ImageURI:       imageURI,
 		ContainerName:  containerNameWithSuffix,
 		ContainerPorts: flippedPorts,
 		Command:        []string{"sleep", "infinity"},
+		Network:        o.network,
+		NetworkAliases: o.networkAliases,
+		Labels:         o.localSessionLabels(flippedPorts),
 		LogOptions: dockerengine.RunLogOptions{
 			Color:      o.newColor(),
 			LinePrefix: "[pause] ",
 		},
 	}
-
+	if len(endpoints) > 0 {
+		// The containers share the network namespace and the /etc/hosts file of the pause container,
+		// which redirects the connections to the proxied endpoints to the session manager plugin.
+		runOptions.Hosts = proxyHosts(endpoints)
+		runOptions.Sysctls = map[string]string{
+			"net.ipv4.conf.all.route_localnet": "1",
+		}
+	}
+	if len(endpoints) > 0 || len(redirects) > 0 {
+		// The iptables rules of the proxy and of the Service Connect redirects are added to the network namespace of the pause container.
+		runOptions.Capabilities = []string{"NET_ADMIN"}
+	}
+
 	//channel to receive any error from the goroutine
 	errCh := make(chan error, 1)
 
@@ -379,66 +1152,1408 @@ func (o *runLocalOpts) runPauseContainer(ctx context.Context, ports map[string]s
 		}
 	}()
 
-	// go routine to check if pause container is running
-	go func() {
-		for {
-			isRunning, err := o.dockerEngine.IsContainerRunning(containerNameWithSuffix)
-			if err != nil {
-				errCh <- fmt.Errorf("check if container is running: %w", err)
-				return
+	// go routine to check if pause container is running
+	go func() {
+		for {
+			isRunning, err := o.dockerEngine.IsContainerRunning(containerNameWithSuffix)
+			if err != nil {
+				errCh <- fmt.Errorf("check if container is running: %w", err)
+				return
+			}
+			if isRunning {
+				errCh <- nil
+				return
+			}
+			// If the container isn't running yet, sleep for a short duration before checking again.
+			time.Sleep(time.Second)
+		}
+	}()
+	err := <-errCh
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// otherLocalSessions returns the other workloads running locally, or an error if the workload is already running locally.
+func (o *runLocalOpts) otherLocalSessions(ctx context.Context) ([]*localSession, error) {
+	sessions, err := listLocalSessions(ctx, o.dockerEngine)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if session.app == o.appName && session.env == o.envName && session.workload == o.wkldName {
+			return nil, fmt.Errorf("%s is already running locally in environment %s in container %q: stop it before running it again", o.wkldName, o.envName, session.container)
+		}
+	}
+	return sessions, nil
+}
+
+// offsetUsedPorts publishes the container ports on the next free ports of the host if other local sessions already publish
+// their ports of the host with --offset-ports, and returns an error listing these host ports otherwise.
+func (o *runLocalOpts) offsetUsedPorts(ports map[string]string, sessions []*localSession) error {
+	usedBy := make(map[string]*localSession) // Host port to the session publishing it.
+	for _, session := range sessions {
+		for host := range session.ports {
+			usedBy[host] = session
+		}
+	}
+	published := make(map[string]bool)
+	for _, host := range ports {
+		published[host] = true
+	}
+	ctrPorts := make([]string, 0, len(ports))
+	for ctr := range ports {
+		ctrPorts = append(ctrPorts, ctr)
+	}
+	sort.Slice(ctrPorts, func(i, j int) bool {
+		return ports[ctrPorts[i]] < ports[ctrPorts[j]]
+	})
+	var conflicts []string
+	for _, ctr := range ctrPorts {
+		host := ports[ctr]
+		session, ok := usedBy[host]
+		if !ok {
+			continue
+		}
+		if !o.offsetPorts {
+			conflicts = append(conflicts, fmt.Sprintf("%s (used by %s)", host, session))
+			continue
+		}
+		next, err := nextFreeHostPort(host, func(port string) bool {
+			_, used := usedBy[port]
+			return used || published[port]
+		})
+		if err != nil {
+			return err
+		}
+		log.Infof("Port %s of the host is used by %s, so port %s of %s is published on port %s instead.\n", host, session, ctr, o.wkldName, next)
+		ports[ctr] = next
+		published[next] = true
+		if ctr == firelensForwardPort && o.firelensRouter != "" {
+			o.firelensPort = next
+		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("ports %s of the host are used by other workloads running locally: pass --%s to publish them on the next free ports, or choose them with --%s",
+			strings.Join(conflicts, ", "), offsetPortsFlag, portOverrideFlag)
+	}
+	return nil
+}
+
+// nextFreeHostPort returns the first port after port that isn't used.
+func nextFreeHostPort(port string, used func(port string) bool) (string, error) {
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return "", fmt.Errorf("parse port %q of the host: %w", port, err)
+	}
+	for next := p + 1; next <= math.MaxUint16; next++ {
+		if s := strconv.Itoa(next); !used(s) {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("no port of the host after %s is free", port)
+}
+
+// localSessionLabels returns the labels of the pause container that identify the local session of the workload
+// and the host ports it publishes.
+func (o *runLocalOpts) localSessionLabels(hostPorts map[string]string) map[string]string {
+	ports := make([]string, 0, len(hostPorts))
+	for host, ctr := range hostPorts {
+		ports = append(ports, fmt.Sprintf("%s:%s", host, ctr))
+	}
+	sort.Strings(ports)
+	return map[string]string{
+		localSessionAppLabel:      o.appName,
+		localSessionEnvLabel:      o.envName,
+		localSessionWorkloadLabel: o.wkldName,
+		localSessionPortsLabel:    strings.Join(ports, " "),
+	}
+}
+
+// proxyEndpoint is a remote endpoint that the local containers connect to through the proxy.
+type proxyEndpoint struct {
+	host      string
+	port      int
+	ip        string // Address that the containers connect to, from the proxy network unless the host is an IP address.
+	localPort int    // Port of the pause container that the session manager plugin listens on.
+}
+
+func (e proxyEndpoint) String() string {
+	return net.JoinHostPort(e.host, strconv.Itoa(e.port))
+}
+
+// proxyEndpoints returns the endpoints that are only reachable from the environment's VPC in the values of the
+// environment variables and secrets of the containers, sorted by host and port. Each hostname is assigned an
+// address from the proxy network.
+func proxyEndpoints(envVars map[string]containerEnv, network net.IPNet) ([]proxyEndpoint, error) {
+	containers := make(map[string]bool, len(envVars))
+	for name := range envVars {
+		containers[name] = true
+	}
+	unique := make(map[proxyEndpoint]bool)
+	for _, vars := range envVars {
+		for _, v := range vars {
+			for _, endpoint := range endpointsInValue(v.Value) {
+				if isProxiedHost(endpoint.host, containers) {
+					unique[endpoint] = true
+				}
+			}
+		}
+	}
+	endpoints := make([]proxyEndpoint, 0, len(unique))
+	for endpoint := range unique {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].host != endpoints[j].host {
+			return endpoints[i].host < endpoints[j].host
+		}
+		return endpoints[i].port < endpoints[j].port
+	})
+
+	ips := make(map[string]string)
+	base := network.IP.Mask(network.Mask).To4()
+	if base == nil {
+		return nil, fmt.Errorf("proxy network %s must be an IPv4 CIDR range", network.String())
+	}
+	ones, bits := network.Mask.Size()
+	for i := range endpoints {
+		endpoints[i].localPort = proxyLocalPortStart + i
+		if net.ParseIP(endpoints[i].host) != nil {
+			endpoints[i].ip = endpoints[i].host
+			continue
+		}
+		if ip, ok := ips[endpoints[i].host]; ok {
+			endpoints[i].ip = ip
+			continue
+		}
+		offset := uint32(len(ips) + 1)
+		if bits-ones < 32 && offset >= 1<<(bits-ones)-1 {
+			return nil, fmt.Errorf("proxy network %s is too small for %d hosts", network.String(), len(ips)+1)
+		}
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(base)+offset)
+		ips[endpoints[i].host] = ip.String()
+		endpoints[i].ip = ip.String()
+	}
+	return endpoints, nil
+}
+
+// endpointsInValue returns the endpoints in the value of an environment variable, which is either a
+// JSON object with "host" and "port" fields like the secrets of RDS, or a string of "host:port" or URLs.
+func endpointsInValue(value string) []proxyEndpoint {
+	var obj struct {
+		Host string      `json:"host"`
+		Port json.Number `json:"port"`
+	}
+	if err := json.Unmarshal([]byte(value), &obj); err == nil && obj.Host != "" {
+		port, err := strconv.Atoi(obj.Port.String())
+		if err != nil {
+			return nil
+		}
+		return []proxyEndpoint{{host: strings.ToLower(obj.Host), port: port}}
+	}
+	var endpoints []proxyEndpoint
+	for _, match := range proxyEndpointPattern.FindAllStringSubmatch(value, -1) {
+		scheme, host, rawPort := strings.ToLower(match[1]), strings.ToLower(match[2]), match[3]
+		if scheme == "" && rawPort == "" {
+			continue
+		}
+		if scheme == "" && !strings.Contains(host, ".") {
+			// Only URLs can point to service connect aliases, otherwise values like "key:1" would be endpoints.
+			continue
+		}
+		port := proxySchemeDefaultPorts[scheme]
+		if rawPort != "" {
+			port, _ = strconv.Atoi(rawPort)
+		}
+		if port <= 0 || port > 65535 {
+			continue
+		}
+		endpoints = append(endpoints, proxyEndpoint{host: host, port: port})
+	}
+	return endpoints
+}
+
+// isProxiedHost returns true if the host is only reachable from the environment's VPC:
+// a private IP address, a service connect alias, a service discovery name, or the endpoint of a VPC resource.
+func isProxiedHost(host string, containers map[string]bool) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.IsPrivate()
+	}
+	if !strings.Contains(host, ".") {
+		return host != "localhost" && !containers[host]
+	}
+	for _, suffix := range proxyHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// proxyHosts returns the /etc/hosts entries that resolve the hostnames of the endpoints to their proxy addresses.
+func proxyHosts(endpoints []proxyEndpoint) []dockerengine.Host {
+	var hosts []dockerengine.Host
+	seen := make(map[string]bool)
+	for _, endpoint := range endpoints {
+		if endpoint.host == endpoint.ip || seen[endpoint.host] {
+			continue
+		}
+		seen[endpoint.host] = true
+		hosts = append(hosts, dockerengine.Host{Name: endpoint.host, IP: endpoint.ip})
+	}
+	return hosts
+}
+
+// proxyTarget returns the SSM target of a running task of the service with ECS Exec enabled,
+// in the format "ecs:<cluster>_<task ID>_<container runtime ID>".
+func (o *runLocalOpts) proxyTarget() (string, error) {
+	cluster, tasks, err := o.execTasks()
+	if err != nil {
+		return "", err
+	}
+	for _, task := range tasks {
+		taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return "", err
+		}
+		for _, container := range task.Containers {
+			if runtimeID := aws.StringValue(container.RuntimeId); runtimeID != "" {
+				return fmt.Sprintf("ecs:%s_%s_%s", cluster, taskID, runtimeID), nil
+			}
+		}
+	}
+	return "", o.errNoExecTask()
+}
+
+// execTasks returns the cluster and the running tasks of the service with ECS Exec enabled.
+func (o *runLocalOpts) execTasks() (string, []*awsecs.Task, error) {
+	desc, err := o.ecsLocalClient.DescribeService(o.appName, o.envName, o.wkldName)
+	if err != nil {
+		return "", nil, fmt.Errorf("describe service %s: %w", o.wkldName, err)
+	}
+	var tasks []*awsecs.Task
+	for _, task := range desc.Tasks {
+		if aws.BoolValue(task.EnableExecuteCommand) && aws.StringValue(task.LastStatus) == "RUNNING" {
+			tasks = append(tasks, task)
+		}
+	}
+	if len(tasks) == 0 {
+		return "", nil, o.errNoExecTask()
+	}
+	return desc.ClusterName, tasks, nil
+}
+
+func (o *runLocalOpts) errNoExecTask() error {
+	return fmt.Errorf("no running task of service %s in environment %s has ECS Exec enabled: set %s in its manifest and deploy it",
+		o.wkldName, o.envName, termcolor.HighlightCode("exec: true"))
+}
+
+// setUpProxy installs the session manager plugin in the pause container, and redirects the connections
+// to the proxy address of each endpoint to the port that the plugin listens on for the endpoint.
+func (o *runLocalOpts) setUpProxy(ctx context.Context, endpoints []proxyEndpoint) error {
+	if err := o.installSessionManagerPlugin(ctx); err != nil {
+		return err
+	}
+	pauseCtr := fmt.Sprintf("%s-%s", pauseContainerName, o.containerSuffix)
+	out := &bytes.Buffer{}
+	for _, endpoint := range endpoints {
+		out.Reset()
+		if err := o.dockerEngine.Exec(ctx, pauseCtr, out, "iptables", "-t", "nat", "-A", "OUTPUT", "-p", "tcp",
+			"-d", endpoint.ip, "--dport", strconv.Itoa(endpoint.port),
+			"-j", "DNAT", "--to-destination", fmt.Sprintf("127.0.0.1:%d", endpoint.localPort)); err != nil {
+			return fmt.Errorf("redirect connections to %s: %w", endpoint, withCommandOutput(err, out))
+		}
+	}
+	return nil
+}
+
+// installSessionManagerPlugin installs the session manager plugin in the pause container, unless it's already installed.
+func (o *runLocalOpts) installSessionManagerPlugin(ctx context.Context) error {
+	if o.pluginInstalled {
+		return nil
+	}
+	pauseCtr := fmt.Sprintf("%s-%s", pauseContainerName, o.containerSuffix)
+	o.prog.Start("Installing the session manager plugin in the pause container")
+	out := &bytes.Buffer{}
+	if err := o.dockerEngine.Exec(ctx, pauseCtr, out, "/bin/bash", "-c", proxySetupScript); err != nil {
+		o.prog.Stop(log.Serror("Failed to install the session manager plugin in the pause container\n"))
+		return fmt.Errorf("install session manager plugin: %w", withCommandOutput(err, out))
+	}
+	o.prog.Stop(log.Ssuccess("Installed the session manager plugin in the pause container\n"))
+	o.pluginInstalled = true
+	return nil
+}
+
+// forwardPort forwards the connections to the endpoint through the target until the context is canceled.
+func (o *runLocalOpts) forwardPort(ctx context.Context, target string, endpoint proxyEndpoint) error {
+	sess, err := o.portForwarder.StartPortForwardingSession(ssm.PortForwardingSessionInput{
+		Target:    target,
+		Host:      endpoint.host,
+		Port:      endpoint.port,
+		LocalPort: endpoint.localPort,
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("Proxying connections to %s through session %s.\n", endpoint, sess.ID)
+	pauseCtr := fmt.Sprintf("%s-%s", pauseContainerName, o.containerSuffix)
+	out := &bytes.Buffer{}
+	if err := o.dockerEngine.Exec(ctx, pauseCtr, out, "session-manager-plugin", sess.PluginArgs...); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("proxy connections to %s: %w", endpoint, withCommandOutput(err, out))
+	}
+	return nil
+}
+
+// withCommandOutput adds the output of a failed command to its error.
+func withCommandOutput(err error, out *bytes.Buffer) error {
+	if msg := strings.TrimSpace(out.String()); msg != "" {
+		return fmt.Errorf("%w: %s", err, msg)
+	}
+	return err
+}
+
+// localRunConfig is the configuration of a workload run locally, read from .copilot/local/<workload>.yml.
+type localRunConfig struct {
+	// Directories of the host to bind mount for each volume of the task, either absolute or relative to the workspace.
+	Volumes map[string]string `yaml:"volumes"`
+}
+
+// configureVolumes sets the directories of the host bind mounted for the volumes of the task definition.
+// A volume is mounted from the directory of the --volume-override flag, else of the configuration of the workload
+// in .copilot/local/, else, for EFS volumes, from a directory under .copilot/local/volumes/ that persists across runs.
+func (o *runLocalOpts) configureVolumes(taskDef *awsecs.TaskDefinition) error {
+	volumes := make(map[string]*sdkecs.Volume, len(taskDef.Volumes))
+	for _, volume := range taskDef.Volumes {
+		volumes[aws.StringValue(volume.Name)] = volume
+	}
+	for name := range o.volumeOverrides {
+		if _, ok := volumes[name]; !ok {
+			return fmt.Errorf("volume %q of flag --%s is not a volume of %s", name, volumeOverrideFlag, o.wkldName)
+		}
+	}
+	if len(volumes) == 0 {
+		return nil
+	}
+	cfgPath := filepath.Join(o.ws.Path(), localRunDirName, o.wkldName+".yml")
+	cfg, err := readLocalRunConfig(o.fs, cfgPath)
+	if err != nil {
+		return err
+	}
+	for name := range cfg.Volumes {
+		if _, ok := volumes[name]; !ok {
+			return fmt.Errorf("volume %q of %s is not a volume of %s", name, cfgPath, o.wkldName)
+		}
+	}
+
+	o.volumes = make(map[string]string)
+	for _, volume := range taskDef.Volumes {
+		name := aws.StringValue(volume.Name)
+		var dir string
+		switch {
+		case o.volumeOverrides[name] != "":
+			dir, err = filepath.Abs(o.volumeOverrides[name])
+			if err != nil {
+				return fmt.Errorf("get absolute path to the directory of volume %s: %w", name, err)
+			}
+		case cfg.Volumes[name] != "":
+			dir = cfg.Volumes[name]
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(o.ws.Path(), dir)
+			}
+		case volume.EfsVolumeConfiguration != nil:
+			dir = filepath.Join(o.ws.Path(), localRunDirName, "volumes", o.wkldName, name)
+		default:
+			// The other volumes are the ephemeral storage of the task, the containers keep the files of their image.
+			continue
+		}
+		if err := o.fs.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("create directory %s of volume %s: %w", dir, name, err)
+		}
+		o.volumes[name] = dir
+	}
+	return nil
+}
+
+func readLocalRunConfig(fs afero.Fs, path string) (localRunConfig, error) {
+	var cfg localRunConfig
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("read file %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(content, &cfg); err != nil {
+		return cfg, fmt.Errorf("unmarshal %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// containerMounts returns the directories of the host bind mounted at the mount points of the container.
+func (o *runLocalOpts) containerMounts(def *sdkecs.ContainerDefinition) []dockerengine.Mount {
+	if def == nil {
+		return nil
+	}
+	var mounts []dockerengine.Mount
+	if o.firelensRouter != "" && aws.StringValue(def.Name) == o.firelensRouter {
+		mounts = append(mounts, dockerengine.Mount{
+			Source:   o.firelensConfig,
+			Target:   firelensConfigPath,
+			ReadOnly: true,
+		})
+	}
+	for _, mountPoint := range def.MountPoints {
+		dir, ok := o.volumes[aws.StringValue(mountPoint.SourceVolume)]
+		if !ok {
+			continue
+		}
+		mounts = append(mounts, dockerengine.Mount{
+			Source:   dir,
+			Target:   aws.StringValue(mountPoint.ContainerPath),
+			ReadOnly: aws.BoolValue(mountPoint.ReadOnly),
+		})
+	}
+	return mounts
+}
+
+// volumeSeedTarget is the running task of the service that the files of the EFS volumes are copied from.
+type volumeSeedTarget struct {
+	cluster string
+	taskID  string
+}
+
+func (o *runLocalOpts) volumeSeedTarget() (*volumeSeedTarget, error) {
+	cluster, tasks, err := o.execTasks()
+	if err != nil {
+		return nil, err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(tasks[0].TaskArn))
+	if err != nil {
+		return nil, err
+	}
+	return &volumeSeedTarget{
+		cluster: cluster,
+		taskID:  taskID,
+	}, nil
+}
+
+// seedVolumesFrom copies the files of the EFS volumes, as mounted in the containers of the task, to their directories of the host.
+// The files are archived by a command run with ECS Exec, whose output is read by the session manager plugin in the pause container.
+func (o *runLocalOpts) seedVolumesFrom(ctx context.Context, target *volumeSeedTarget, taskDef *awsecs.TaskDefinition) error {
+	for _, volume := range taskDef.Volumes {
+		name := aws.StringValue(volume.Name)
+		dir, ok := o.volumes[name]
+		if !ok || volume.EfsVolumeConfiguration == nil {
+			continue
+		}
+		container, path := volumeMountPoint(taskDef, name)
+		if container == "" {
+			continue
+		}
+		if err := o.installSessionManagerPlugin(ctx); err != nil {
+			return err
+		}
+		o.prog.Start(fmt.Sprintf("Copying the files of volume %s from task %s", name, target.taskID))
+		if err := o.seedVolume(ctx, target, container, path, dir); err != nil {
+			o.prog.Stop(log.Serrorf("Failed to copy the files of volume %s\n", name))
+			return fmt.Errorf("copy the files of volume %s from container %s: %w", name, container, err)
+		}
+		o.prog.Stop(log.Ssuccessf("Copied the files of volume %s to %s\n", name, dir))
+	}
+	return nil
+}
+
+func (o *runLocalOpts) seedVolume(ctx context.Context, target *volumeSeedTarget, container, path, dir string) error {
+	sess, err := o.commandSessions.StartCommandSession(awsecs.ExecuteCommandInput{
+		Cluster:   target.cluster,
+		Task:      target.taskID,
+		Container: container,
+		Command: fmt.Sprintf(`/bin/sh -c "echo %s && tar -cf - -C '%s' . | base64 && echo %s"`,
+			volumeArchiveStart, path, volumeArchiveEnd),
+	})
+	if err != nil {
+		return err
+	}
+	pauseCtr := fmt.Sprintf("%s-%s", pauseContainerName, o.containerSuffix)
+	out := &bytes.Buffer{}
+	if err := o.dockerEngine.Exec(ctx, pauseCtr, out, "session-manager-plugin", sess.PluginArgs...); err != nil {
+		return fmt.Errorf("run session %s: %w", sess.ID, err)
+	}
+	return extractVolumeArchive(o.fs, dir, out.Bytes())
+}
+
+// volumeMountPoint returns the first container of the task definition that mounts the volume, and the path it's mounted at.
+func volumeMountPoint(taskDef *awsecs.TaskDefinition, volume string) (container, path string) {
+	for _, def := range taskDef.ContainerDefinitions {
+		for _, mountPoint := range def.MountPoints {
+			if aws.StringValue(mountPoint.SourceVolume) == volume {
+				return aws.StringValue(def.Name), aws.StringValue(mountPoint.ContainerPath)
+			}
+		}
+	}
+	return "", ""
+}
+
+// extractVolumeArchive extracts the base64 encoded tar archive between the markers of the output to the directory.
+func extractVolumeArchive(fs afero.Fs, dir string, output []byte) error {
+	start := bytes.Index(output, []byte(volumeArchiveStart))
+	end := bytes.LastIndex(output, []byte(volumeArchiveEnd))
+	if start == -1 || end < start {
+		return errors.New("the output of the command doesn't hold an archive: the image of the container must have sh, tar and base64")
+	}
+	// The output of the session is a terminal, so the lines of the encoded archive end in "\r\n".
+	encoded := strings.Join(strings.Fields(string(output[start+len(volumeArchiveStart):end])), "")
+	archive, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("decode archive: %w", err)
+	}
+	if len(archive) == 0 {
+		return errors.New("the archive is empty: the image of the container must have tar")
+	}
+	tr := tar.NewReader(bytes.NewReader(archive))
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read archive: %w", err)
+		}
+		name := filepath.Clean(filepath.FromSlash(hdr.Name))
+		if name == "." {
+			continue
+		}
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("file %s of the archive is outside of the volume", hdr.Name)
+		}
+		path := filepath.Join(dir, name)
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := fs.MkdirAll(path, 0755); err != nil {
+				return fmt.Errorf("create directory %s: %w", path, err)
+			}
+		case tar.TypeReg:
+			if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return fmt.Errorf("create directory %s: %w", filepath.Dir(path), err)
+			}
+			if err := writeArchiveFile(fs, path, hdr.FileInfo().Mode().Perm(), tr); err != nil {
+				return err
+			}
+		default:
+			// Links and special files aren't copied.
+		}
+	}
+}
+
+func writeArchiveFile(fs afero.Fs, path string, perm os.FileMode, r io.Reader) error {
+	f, err := fs.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return fmt.Errorf("create file %s: %w", path, err)
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, r); err != nil {
+		return fmt.Errorf("write file %s: %w", path, err)
+	}
+	return nil
+}
+
+func (o *runLocalOpts) runContainers(ctx context.Context, containerURIs map[string]string, envVars map[string]containerEnv, taskDef *awsecs.TaskDefinition) error {
+	defs := make(map[string]*sdkecs.ContainerDefinition, len(taskDef.ContainerDefinitions))
+	for _, def := range taskDef.ContainerDefinitions {
+		defs[aws.StringValue(def.Name)] = def
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	for name, uri := range containerURIs {
+		name := name
+		uri := uri
+		def := defs[name]
+
+		vars, secrets := make(map[string]string), make(map[string]string)
+		for k, v := range envVars[name] {
+			if v.Secret {
+				secrets[k] = v.Value
+			} else {
+				vars[k] = v.Value
+			}
+		}
+
+		// Execute each container run in a separate goroutine
+		g.Go(func() error {
+			runOptions := &dockerengine.RunOptions{
+				ImageURI:         uri,
+				ContainerName:    fmt.Sprintf("%s-%s", name, o.containerSuffix),
+				Secrets:          secrets,
+				EnvVars:          vars,
+				ContainerNetwork: fmt.Sprintf("%s-%s", pauseContainerName, o.containerSuffix),
+				Mounts:           o.containerMounts(def),
+				HealthCheck:      containerHealthCheck(def),
+				LogDriver:        o.containerLogDriver(def),
+				LogOptions:       o.containerLogOptions(name),
+			}
+			if def != nil {
+				// The containers that aren't part of the task, like the emulator of AWS services, run on the platform of the engine.
+				runOptions.Platform = o.platform
+			}
+			if def != nil && name == otelCollectorContainerName {
+				// The collector picks the configuration of its tracing vendor with its command.
+				runOptions.Command = aws.StringValueSlice(def.Command)
+			}
+			if debug, ok := o.debugged[name]; ok {
+				runOptions.Entrypoint = debug.entrypoint
+				// A container that is paused at a breakpoint fails its healthcheck.
+				runOptions.HealthCheck = &dockerengine.HealthCheck{Command: []string{"NONE"}}
+			}
+			if err := o.waitForDependencies(ctx, name, def); err != nil {
+				return err
+			}
+			for {
+				err := o.dockerEngine.Run(ctx, runOptions)
+				restart, ok, restartErr := o.restarts.next(ctx, name)
+				if restartErr != nil {
+					return restartErr
+				}
+				if ok {
+					if restart.uri != "" {
+						runOptions.ImageURI = restart.uri
+					}
+					if restart.secrets != nil {
+						runOptions.Secrets = restart.secrets
+					}
+					continue
+				}
+				if err != nil {
+					return fmt.Errorf("run container %q: %w", name, err)
+				}
+				return nil
+			}
+		})
+	}
+
+	return g.Wait()
+}
+
+// configurePlatform sets the platform to build the images for, and warns when the containers of the task run with emulation
+// because its architecture differs from the one of the Docker engine, unless the platform to run them on is overridden.
+// The images of a "multiarch" workload are built for the architecture of the engine, and Docker pulls the matching
+// image of the manifest lists in the repository.
+func (o *runLocalOpts) configurePlatform(taskDef *awsecs.TaskDefinition, mft manifest.DynamicWorkload) error {
+	if o.platform != "" {
+		o.buildPlatform = o.platform
+		return nil
+	}
+	platform := taskDef.Platform()
+	if platform != nil && platform.OperatingSystem != "" && platform.OperatingSystem != sdkecs.OSFamilyLinux {
+		return nil
+	}
+	taskArch := dockerengine.ArchAMD64
+	if platform != nil && platform.Architecture == sdkecs.CPUArchitectureArm64 {
+		taskArch = dockerengine.ArchARM64
+	}
+	_, engineArch, err := o.dockerEngine.GetPlatform()
+	if err != nil {
+		return fmt.Errorf("get docker engine platform: %w", err)
+	}
+	if manifest.IsArmArch(engineArch) {
+		engineArch = dockerengine.ArchARM64
+	} else {
+		engineArch = dockerengine.ArchAMD64
+	}
+	if isMultiArch(mft) {
+		o.buildPlatform = dockerengine.PlatformString(dockerengine.OSLinux, engineArch)
+		return nil
+	}
+	if taskArch == engineArch {
+		return nil
+	}
+	log.Warningf("The task of %s runs on %s but the Docker engine runs on %s, so its containers run with emulation: they are slower and may behave differently.\n",
+		o.wkldName, dockerengine.PlatformString(dockerengine.OSLinux, taskArch), dockerengine.PlatformString(dockerengine.OSLinux, engineArch))
+	log.Infof("Run %s with --%s %s to pull and build the images for the architecture of the engine instead.\n",
+		o.wkldName, platformFlag, dockerengine.PlatformString(dockerengine.OSLinux, engineArch))
+	return nil
+}
+
+// isMultiArch returns true if the images of the workload are built for several platforms.
+func isMultiArch(mft manifest.DynamicWorkload) bool {
+	wkld, ok := mft.Manifest().(interface{ ContainerPlatform() string })
+	return ok && dockerengine.IsMultiPlatform(wkld.ContainerPlatform())
+}
+
+// containerHealthCheck returns the healthcheck of the container definition, if any.
+func containerHealthCheck(def *sdkecs.ContainerDefinition) *dockerengine.HealthCheck {
+	if def == nil || def.HealthCheck == nil {
+		return nil
+	}
+	hc := def.HealthCheck
+	return &dockerengine.HealthCheck{
+		Command:     aws.StringValueSlice(hc.Command),
+		Interval:    time.Duration(aws.Int64Value(hc.Interval)) * time.Second,
+		Retries:     int(aws.Int64Value(hc.Retries)),
+		StartPeriod: time.Duration(aws.Int64Value(hc.StartPeriod)) * time.Second,
+		Timeout:     time.Duration(aws.Int64Value(hc.Timeout)) * time.Second,
+	}
+}
+
+// waitForDependencies blocks until the containers that the container depends on meet their conditions,
+// like ECS does before it starts the container.
+// It returns an error if a condition can't be met anymore, or if the start timeout of the container expires.
+func (o *runLocalOpts) waitForDependencies(ctx context.Context, name string, def *sdkecs.ContainerDefinition) error {
+	if def == nil || len(def.DependsOn) == 0 {
+		return nil
+	}
+	if timeout := aws.Int64Value(def.StartTimeout); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+	}
+	for _, dep := range def.DependsOn {
+		depName, condition := aws.StringValue(dep.ContainerName), aws.StringValue(dep.Condition)
+		for logged := false; ; logged = true {
+			met, err := o.isDependencyMet(ctx, depName, condition)
+			if err != nil {
+				return fmt.Errorf("start container %q: %w", name, err)
+			}
+			if met {
+				break
+			}
+			if !logged {
+				log.Infof("Waiting for container %q to %s before starting %q.\n", depName, dependencyConditionVerbs[condition], name)
+			}
+			select {
+			case <-ctx.Done():
+				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					return fmt.Errorf("start container %q: timed out waiting for container %q to %s", name, depName, dependencyConditionVerbs[condition])
+				}
+				return ctx.Err()
+			case <-time.After(o.pollInterval):
+			}
+		}
+	}
+	return nil
+}
+
+// dependencyConditionVerbs describes what the dependency of a container must do to meet its condition.
+var dependencyConditionVerbs = map[string]string{
+	sdkecs.ContainerConditionStart:    "start",
+	sdkecs.ContainerConditionComplete: "complete",
+	sdkecs.ContainerConditionSuccess:  "exit successfully",
+	sdkecs.ContainerConditionHealthy:  "become healthy",
+}
+
+func (o *runLocalOpts) isDependencyMet(ctx context.Context, name, condition string) (bool, error) {
+	state, err := o.dockerEngine.ContainerState(ctx, fmt.Sprintf("%s-%s", name, o.containerSuffix))
+	var errNotExist *dockerengine.ErrContainerNotExist
+	if errors.As(err, &errNotExist) || ctx.Err() != nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get state of container %q: %w", name, err)
+	}
+	if state.Status == dockerengine.ContainerStatusCreated {
+		return false, nil
+	}
+	if _, ok := o.debugged[name]; ok && condition == sdkecs.ContainerConditionHealthy {
+		// The healthcheck of a debugged container is disabled.
+		condition = sdkecs.ContainerConditionStart
+	}
+	exited := state.Status == dockerengine.ContainerStatusExited
+	switch condition {
+	case sdkecs.ContainerConditionStart:
+		return true, nil
+	case sdkecs.ContainerConditionComplete:
+		return exited, nil
+	case sdkecs.ContainerConditionSuccess:
+		if exited && state.ExitCode != 0 {
+			return false, fmt.Errorf("container %q exited with code %d", name, state.ExitCode)
+		}
+		return exited, nil
+	case sdkecs.ContainerConditionHealthy:
+		if state.Health == nil {
+			return false, fmt.Errorf("container %q does not have a healthcheck", name)
+		}
+		if state.Health.Status == dockerengine.ContainerHealthUnhealthy {
+			return false, fmt.Errorf("container %q is unhealthy", name)
+		}
+		if exited {
+			return false, fmt.Errorf("container %q exited before it became healthy", name)
+		}
+		return state.Health.Status == dockerengine.ContainerHealthHealthy, nil
+	}
+	return false, fmt.Errorf("unsupported condition %q on container %q", condition, name)
+}
+
+// debugSettings are the changes to a container that let a debugger attach to it.
+type debugSettings struct {
+	runtime    string
+	port       string
+	envVars    map[string]string // Environment variables to set in the container.
+	entrypoint []string          // Overrides the entrypoint of the container if not empty.
+	attach     string            // Instructions to attach a debugger to the container.
+}
+
+// configureDebuggers publishes the debugger port of each debugged container and sets up its runtime to listen on it.
+func (o *runLocalOpts) configureDebuggers(taskDef *awsecs.TaskDefinition, buildContexts map[string]clideploy.ContainerBuildContext, ports map[string]string, envVars map[string]containerEnv) error {
+	defs := make(map[string]*sdkecs.ContainerDefinition, len(taskDef.ContainerDefinitions))
+	for _, def := range taskDef.ContainerDefinitions {
+		defs[aws.StringValue(def.Name)] = def
+	}
+	o.debugged = make(map[string]debugSettings, len(o.debug))
+	for _, target := range o.debug {
+		def, ok := defs[target.container]
+		if !ok {
+			return fmt.Errorf("cannot debug container %q: it is not a container of %s", target.container, o.wkldName)
+		}
+		for ctr, host := range ports {
+			if ctr == target.port || host == target.port {
+				return fmt.Errorf("cannot debug container %q on port %s: the port is already published", target.container, target.port)
+			}
+		}
+		runtime := target.runtime
+		if runtime == "" {
+			var err error
+			if runtime, err = o.detectDebugRuntime(target.container, aws.StringValue(def.Image), buildContexts); err != nil {
+				return err
+			}
+		}
+		command := append(aws.StringValueSlice(def.EntryPoint), aws.StringValueSlice(def.Command)...)
+		settings, err := newDebugSettings(target.container, runtime, target.port, command, envVars[target.container])
+		if err != nil {
+			return err
+		}
+		for k, v := range settings.envVars {
+			envVars[target.container][k] = envVarValue{
+				Value:    v,
+				Override: true,
+			}
+		}
+		ports[target.port] = target.port
+		o.debugged[target.container] = settings
+
+		log.Infof("Debugging container %q as a %s program. %s\n", target.container, runtime, settings.attach)
+		if def.HealthCheck != nil {
+			log.Warningf("The healthcheck of container %q is disabled while it's debugged, so that pausing at a breakpoint doesn't fail it.\n", target.container)
+		}
+	}
+	return nil
+}
+
+// detectDebugRuntime returns the runtime of the container from the base images of its Dockerfile, or else from its image.
+func (o *runLocalOpts) detectDebugRuntime(container, image string, buildContexts map[string]clideploy.ContainerBuildContext) (string, error) {
+	images := []string{image}
+	if buildCtx, ok := buildContexts[container]; ok {
+		var err error
+		images, err = dockerfile.New(o.fs, buildCtx.Dockerfile).GetBaseImages()
+		if err != nil {
+			return "", fmt.Errorf("get base images of container %q: %w", container, err)
+		}
+	}
+	// The last stage of a Dockerfile is the image that runs.
+	for i := len(images) - 1; i >= 0; i-- {
+		if runtime := debugRuntimeOfImage(images[i]); runtime != "" {
+			return runtime, nil
+		}
+	}
+	return "", fmt.Errorf("detect the runtime of container %q from its image: specify it with %s", container,
+		termcolor.HighlightCode(fmt.Sprintf("--%s %s=<runtime>:<port>", debugFlag, container)))
+}
+
+func debugRuntimeOfImage(image string) string {
+	name := image[strings.LastIndex(image, "/")+1:]
+	if i := strings.IndexAny(name, ":@"); i != -1 {
+		name = name[:i]
+	}
+	name = strings.ToLower(name)
+	for _, runtime := range debugRuntimeImageKeywords {
+		for _, keyword := range runtime.keywords {
+			if strings.Contains(name, keyword) {
+				return runtime.runtime
+			}
+		}
+	}
+	return ""
+}
+
+// newDebugSettings returns the settings for the runtime of a container to listen for a debugger on the port.
+// The command is the entrypoint followed by the command of the container.
+func newDebugSettings(container, runtime, port string, command []string, env containerEnv) (debugSettings, error) {
+	settings := debugSettings{
+		runtime: runtime,
+		port:    port,
+	}
+	withOption := func(key, option string) map[string]string {
+		if existing := env[key].Value; existing != "" {
+			option = existing + " " + option
+		}
+		return map[string]string{key: option}
+	}
+	switch runtime {
+	case debugRuntimeJava:
+		settings.envVars = withOption("JAVA_TOOL_OPTIONS", fmt.Sprintf("-agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=*:%s", port))
+		settings.attach = fmt.Sprintf("Attach a Java debugger to localhost:%s.", port)
+	case debugRuntimeNode:
+		settings.envVars = withOption("NODE_OPTIONS", fmt.Sprintf("--inspect=0.0.0.0:%s", port))
+		settings.attach = fmt.Sprintf("Attach a Node.js inspector to localhost:%s, for example from chrome://inspect.", port)
+	case debugRuntimePython:
+		if len(command) == 0 || !strings.HasPrefix(path.Base(command[0]), "python") {
+			return debugSettings{}, fmt.Errorf("debug container %q: its %s or %s in the manifest must start with %s to run it with debugpy",
+				container, termcolor.HighlightCode("entrypoint"), termcolor.HighlightCode("command"), termcolor.HighlightCode("python"))
+		}
+		settings.entrypoint = append([]string{command[0], "-m", "debugpy", "--listen", "0.0.0.0:" + port}, command[1:]...)
+		settings.attach = fmt.Sprintf("Attach a debugpy client to localhost:%s. The image must have debugpy installed.", port)
+	case debugRuntimeGo:
+		if len(command) == 0 {
+			return debugSettings{}, fmt.Errorf("debug container %q: set its %s or %s in the manifest to run the program with Delve",
+				container, termcolor.HighlightCode("entrypoint"), termcolor.HighlightCode("command"))
+		}
+		settings.entrypoint = []string{"dlv", "exec", "--headless", "--listen=:" + port, "--api-version=2", "--accept-multiclient", "--continue", command[0]}
+		if len(command) > 1 {
+			settings.entrypoint = append(append(settings.entrypoint, "--"), command[1:]...)
+		}
+		settings.attach = fmt.Sprintf("Attach a Delve client to localhost:%s, for example with %s. The image must have dlv installed.",
+			port, termcolor.HighlightCode("dlv connect localhost:"+port))
+	default:
+		return debugSettings{}, fmt.Errorf("debug container %q: unsupported runtime %q", container, runtime)
+	}
+	return settings, nil
+}
+
+// watchBuildContexts polls the build contexts of the containers until the context is canceled,
+// and rebuilds and restarts the containers whose files changed.
+func (o *runLocalOpts) watchBuildContexts(ctx context.Context, mft manifest.DynamicWorkload, buildContexts map[string]clideploy.ContainerBuildContext) error {
+	digests, err := o.buildContextDigests(buildContexts)
+	if err != nil {
+		return err
+	}
+	log.Infof("Watching the build contexts of %s for changes.\n", english.WordSeries(watchedContainers(buildContexts), "and"))
+	ticker := time.NewTicker(o.watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := o.rebuildChangedContainers(ctx, mft, buildContexts, digests); err != nil {
+			// Keep watching so that the next change can fix the build.
+			log.Errorf("%v\n", err)
+		}
+	}
+}
+
+// rebuildChangedContainers rebuilds the images of the containers whose build context changed since the digests
+// were computed, and restarts the containers of the new images. The digests are updated in place.
+func (o *runLocalOpts) rebuildChangedContainers(ctx context.Context, mft manifest.DynamicWorkload, buildContexts map[string]clideploy.ContainerBuildContext, digests map[string]string) error {
+	latest, err := o.buildContextDigests(buildContexts)
+	if err != nil {
+		return err
+	}
+	var changed []string
+	for name, digest := range latest {
+		if digests[name] != digest {
+			changed = append(changed, name)
+		}
+		// Only retry a failed build after the next change.
+		digests[name] = digest
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	sort.Strings(changed)
+	log.Infof("\nDetected changes to %s, rebuilding.\n", english.WordSeries(changed, "and"))
+	containerURIs, err := o.buildContainerImages(mft, changed...)
+	if err != nil {
+		return fmt.Errorf("rebuild images: %w", err)
+	}
+	for _, name := range changed {
+		if ctx.Err() != nil {
+			return nil
+		}
+		uri, ok := containerURIs[name]
+		if !ok {
+			continue
+		}
+		if err := o.restartContainer(name, containerRestart{uri: uri}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *runLocalOpts) buildContextDigests(buildContexts map[string]clideploy.ContainerBuildContext) (map[string]string, error) {
+	digests := make(map[string]string, len(buildContexts))
+	for _, name := range watchedContainers(buildContexts) {
+		bc := buildContexts[name]
+		digest, err := o.dockerEngine.BuildContextDigest(bc.Context, bc.Dockerfile)
+		if err != nil {
+			return nil, fmt.Errorf("compute digest of build context of container %q: %w", name, err)
+		}
+		digests[name] = digest
+	}
+	return digests, nil
+}
+
+func watchedContainers(buildContexts map[string]clideploy.ContainerBuildContext) []string {
+	containers := make([]string, 0, len(buildContexts))
+	for name := range buildContexts {
+		containers = append(containers, name)
+	}
+	sort.Strings(containers)
+	return containers
+}
+
+// watchSecrets resolves the secrets of the containers again at every interval, and restarts the containers
+// whose secrets changed, for example after Secrets Manager rotated them. The containers are restarted rather than
+// signaled because the environment variables of a running container can't be updated.
+func (o *runLocalOpts) watchSecrets(ctx context.Context, envVars map[string]containerEnv, taskDef *awsecs.TaskDefinition) error {
+	current := containerSecrets(envVars)
+	log.Infof("Refreshing the secrets of %s every %s.\n", o.wkldName, o.secretsRefreshInterval)
+	ticker := time.NewTicker(o.secretsRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := o.refreshChangedSecrets(ctx, envVars, taskDef, current); err != nil {
+			// Keep the containers running with their current secrets, and retry at the next interval.
+			log.Errorf("%v\n", err)
+		}
+	}
+}
+
+// refreshChangedSecrets resolves the secrets of the task definition again the same way as when the containers started,
+// and restarts the containers whose secrets changed since current. The secrets in current are updated in place.
+func (o *runLocalOpts) refreshChangedSecrets(ctx context.Context, envVars map[string]containerEnv, taskDef *awsecs.TaskDefinition, current map[string]map[string]string) error {
+	fresh := make(map[string]containerEnv, len(envVars))
+	for name, vars := range envVars {
+		fresh[name] = make(containerEnv)
+		for k, v := range vars {
+			if !v.Secret {
+				fresh[name][k] = v
 			}
-			if isRunning {
-				errCh <- nil
-				return
+		}
+	}
+	// Fetch the secrets instead of reading them from the cache, since they may have been rotated since.
+	if err := o.fillSecrets(ctx, fresh, taskDef, true); err != nil {
+		return fmt.Errorf("refresh secrets: %w", err)
+	}
+	o.writeCache()
+	latest := containerSecrets(fresh)
+	var changed []string
+	for name, secrets := range latest {
+		if !equalSecrets(current[name], secrets) {
+			changed = append(changed, name)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	sort.Strings(changed)
+	log.Infof("\nThe secrets of %s changed, restarting.\n", english.WordSeries(changed, "and"))
+	for _, name := range changed {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := o.restartContainer(name, containerRestart{secrets: latest[name]}); err != nil {
+			return err
+		}
+		current[name] = latest[name]
+	}
+	return nil
+}
+
+// containerSecrets returns the values of the secrets of each container.
+func containerSecrets(envVars map[string]containerEnv) map[string]map[string]string {
+	secrets := make(map[string]map[string]string, len(envVars))
+	for name, vars := range envVars {
+		secrets[name] = make(map[string]string)
+		for k, v := range vars {
+			if v.Secret {
+				secrets[name][k] = v.Value
 			}
-			// If the container isn't running yet, sleep for a short duration before checking again.
-			time.Sleep(time.Second)
 		}
-	}()
-	err := <-errCh
-	if err != nil {
-		return err
 	}
+	return secrets
+}
 
-	return nil
+func equalSecrets(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if other, ok := b[k]; !ok || other != v {
+			return false
+		}
+	}
+	return true
 }
 
-func (o *runLocalOpts) runContainers(ctx context.Context, containerURIs map[string]string, envVars map[string]containerEnv) error {
-	g, ctx := errgroup.WithContext(ctx)
-	for name, uri := range containerURIs {
-		name := name
-		uri := uri
+// fileSyncs returns the files to sync of the containers of the task definition listed in the manifest.
+func fileSyncs(mft manifest.DynamicWorkload, taskDef *awsecs.TaskDefinition) map[string]manifest.ImageSync {
+	type fileSyncer interface {
+		FileSyncs() map[string]manifest.ImageSync
+	}
+	wkld, ok := mft.Manifest().(fileSyncer)
+	if !ok {
+		return nil
+	}
+	all := wkld.FileSyncs()
+	syncs := make(map[string]manifest.ImageSync, len(all))
+	for _, ctr := range taskDef.ContainerDefinitions {
+		name := aws.StringValue(ctr.Name)
+		if files, ok := all[name]; ok {
+			syncs[name] = files
+		}
+	}
+	return syncs
+}
 
-		vars, secrets := make(map[string]string), make(map[string]string)
-		for k, v := range envVars[name] {
-			if v.Secret {
-				secrets[k] = v.Value
-			} else {
-				vars[k] = v.Value
+// syncedFile is a file of the host copied into a container.
+type syncedFile struct {
+	target  string // Path in the container.
+	modTime time.Time
+	size    int64
+}
+
+// watchFileSyncs polls the files to sync of the containers until the context is canceled,
+// and copies the files that changed into the running containers.
+func (o *runLocalOpts) watchFileSyncs(ctx context.Context, syncs map[string]manifest.ImageSync) error {
+	containers := make([]string, 0, len(syncs))
+	for name := range syncs {
+		containers = append(containers, name)
+	}
+	sort.Strings(containers)
+	snapshots := make(map[string]map[string]syncedFile, len(syncs))
+	for _, name := range containers {
+		files, err := o.syncedFiles(syncs[name])
+		if err != nil {
+			return fmt.Errorf("list the files to sync of container %q: %w", name, err)
+		}
+		snapshots[name] = files
+	}
+	log.Infof("Syncing the files of %s into the running containers.\n", english.WordSeries(containers, "and"))
+	ticker := time.NewTicker(o.watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		for _, name := range containers {
+			if err := o.syncChangedFiles(ctx, name, syncs[name], snapshots[name]); err != nil {
+				// Keep watching so that the next change can be synced.
+				log.Errorf("%v\n", err)
 			}
 		}
+	}
+}
 
-		// Execute each container run in a separate goroutine
-		g.Go(func() error {
-			runOptions := &dockerengine.RunOptions{
-				ImageURI:         uri,
-				ContainerName:    fmt.Sprintf("%s-%s", name, o.containerSuffix),
-				Secrets:          secrets,
-				EnvVars:          vars,
-				ContainerNetwork: fmt.Sprintf("%s-%s", pauseContainerName, o.containerSuffix),
-				LogOptions: dockerengine.RunLogOptions{
-					Color:      o.newColor(),
-					LinePrefix: fmt.Sprintf("[%s] ", name),
-				},
+// syncChangedFiles copies the files that changed since the snapshot was taken into the running container,
+// removes the deleted files from it, and then sends the signal of the sync to the container. The snapshot is updated in place.
+func (o *runLocalOpts) syncChangedFiles(ctx context.Context, name string, files manifest.ImageSync, snapshot map[string]syncedFile) error {
+	latest, err := o.syncedFiles(files)
+	if err != nil {
+		return fmt.Errorf("list the files to sync of container %q: %w", name, err)
+	}
+	var changed, deleted []string
+	for src, file := range latest {
+		if prev, ok := snapshot[src]; !ok || !prev.modTime.Equal(file.modTime) || prev.size != file.size {
+			changed = append(changed, src)
+		}
+	}
+	for src := range snapshot {
+		if _, ok := latest[src]; !ok {
+			deleted = append(deleted, snapshot[src].target)
+		}
+	}
+	// Only retry a failed sync after the next change.
+	for src := range snapshot {
+		delete(snapshot, src)
+	}
+	for src, file := range latest {
+		snapshot[src] = file
+	}
+	if len(changed) == 0 && len(deleted) == 0 {
+		return nil
+	}
+	sort.Strings(changed)
+	sort.Strings(deleted)
+
+	ctr := fmt.Sprintf("%s-%s", name, o.containerSuffix)
+	if len(deleted) > 0 {
+		if err := o.dockerEngine.Exec(ctx, ctr, io.Discard, "rm", append([]string{"-rf"}, deleted...)...); err != nil {
+			return fmt.Errorf("remove deleted files from %q: %w", ctr, err)
+		}
+	}
+	if len(changed) > 0 {
+		var dirs []string
+		seen := make(map[string]bool)
+		for _, src := range changed {
+			if dir := path.Dir(latest[src].target); !seen[dir] {
+				seen[dir] = true
+				dirs = append(dirs, dir)
+			}
+		}
+		if err := o.dockerEngine.Exec(ctx, ctr, io.Discard, "mkdir", append([]string{"-p"}, dirs...)...); err != nil {
+			return fmt.Errorf("create directories of changed files in %q: %w", ctr, err)
+		}
+		for _, src := range changed {
+			if err := o.dockerEngine.CopyToContainer(ctx, src, ctr, latest[src].target); err != nil {
+				return fmt.Errorf("copy %s to %q: %w", src, ctr, err)
+			}
+		}
+	}
+	log.Infof("Synced %s into %q.\n", english.Plural(len(changed)+len(deleted), "changed file", "changed files"), ctr)
+	if files.Signal == nil {
+		return nil
+	}
+	sig := aws.StringValue(files.Signal)
+	if err := o.dockerEngine.Kill(ctx, ctr, sig); err != nil {
+		return fmt.Errorf("send %s to %q: %w", sig, ctr, err)
+	}
+	log.Infof("Sent %s to %q.\n", sig, ctr)
+	return nil
+}
+
+// syncedFiles returns the files of the host to copy into the container, keyed by their path.
+func (o *runLocalOpts) syncedFiles(files manifest.ImageSync) (map[string]syncedFile, error) {
+	synced := make(map[string]syncedFile)
+	for _, p := range files.Paths {
+		root := filepath.Join(o.ws.Path(), aws.StringValue(p.Source))
+		target := aws.StringValue(p.Target)
+		err := afero.Walk(o.fs, root, func(src string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(root, src)
+			if err != nil {
+				return err
+			}
+			if rel != "." && isExcludedFromSync(filepath.ToSlash(rel), p.Exclude) {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !info.Mode().IsRegular() {
+				return nil
 			}
-			if err := o.dockerEngine.Run(ctx, runOptions); err != nil {
-				return fmt.Errorf("run container %q: %w", name, err)
+			synced[src] = syncedFile{
+				target:  path.Join(target, filepath.ToSlash(rel)),
+				modTime: info.ModTime(),
+				size:    info.Size(),
 			}
 			return nil
 		})
+		if err != nil {
+			return nil, fmt.Errorf("walk %s: %w", root, err)
+		}
 	}
+	return synced, nil
+}
 
-	return g.Wait()
+// isExcludedFromSync returns true if the path, relative to the source of the sync, or its base name matches any of the patterns.
+func isExcludedFromSync(rel string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// restartContainer replaces the running container with one from the image URI,
+// in the same network as the pause container.
+func (o *runLocalOpts) restartContainer(name string, restart containerRestart) error {
+	ctr := fmt.Sprintf("%s-%s", name, o.containerSuffix)
+	ch := o.restarts.begin(name)
+	o.prog.Start(fmt.Sprintf("Restarting %q", ctr))
+	if err := o.dockerEngine.Stop(ctr); err != nil {
+		o.restarts.abort(name, ch)
+		o.prog.Stop(log.Serrorf("Failed to stop %q\n", ctr))
+		return fmt.Errorf("stop %q: %w", ctr, err)
+	}
+	if err := o.dockerEngine.Rm(ctr); err != nil {
+		o.restarts.abort(name, ch)
+		o.prog.Stop(log.Serrorf("Failed to remove %q\n", ctr))
+		return fmt.Errorf("rm %q: %w", ctr, err)
+	}
+	ch <- restart
+	o.prog.Stop(log.Ssuccessf("Restarted %q\n", ctr))
+	return nil
+}
+
+// containerRestarts hands over the containers being restarted from the watcher, which stops and removes them,
+// to the goroutines running them, which run them again once they're removed.
+type containerRestarts struct {
+	mu      sync.Mutex
+	pending map[string]chan containerRestart // Container name to how to run it once it's removed.
+}
+
+// containerRestart is how to run a container again once it's removed.
+type containerRestart struct {
+	uri     string            // Image URI to run the container with, or empty to keep the current one.
+	secrets map[string]string // Secrets to inject into the container, or nil to keep the current ones.
+}
+
+func newContainerRestarts() *containerRestarts {
+	return &containerRestarts{
+		pending: make(map[string]chan containerRestart),
+	}
+}
+
+// begin marks the container as being restarted, and returns the channel to send how to run it again to.
+func (r *containerRestarts) begin(name string) chan<- containerRestart {
+	ch := make(chan containerRestart, 1)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending[name] = ch
+	return ch
+}
+
+// abort cancels the restart of the container.
+func (r *containerRestarts) abort(name string, ch chan<- containerRestart) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.pending[name] == ch {
+		delete(r.pending, name)
+	}
+	close(ch)
+}
+
+// next returns how to run the container again if the container exited because it's being restarted.
+// It blocks until the container is removed.
+func (r *containerRestarts) next(ctx context.Context, name string) (restart containerRestart, ok bool, err error) {
+	if r == nil {
+		return containerRestart{}, false, nil
+	}
+	r.mu.Lock()
+	ch, pending := r.pending[name]
+	delete(r.pending, name)
+	r.mu.Unlock()
+	if !pending {
+		return containerRestart{}, false, nil
+	}
+	select {
+	case restart, ok := <-ch:
+		if !ok {
+			return containerRestart{}, false, fmt.Errorf("restart container %q", name)
+		}
+		return restart, true, nil
+	case <-ctx.Done():
+		return containerRestart{}, false, nil
+	}
 }
 
 func (o *runLocalOpts) cleanUpContainers(ctx context.Context, containerURIs map[string]string) error {
@@ -479,7 +2594,8 @@ func (o *runLocalOpts) cleanUpContainers(ctx context.Context, containerURIs map[
 		})
 		return errors.Join(errs...)
 	}
-	return nil
+	// The state is kept if a container couldn't be cleaned up, so that "copilot run local stop" can retry.
+	return removeLocalSessionState(o.fs, filepath.Join(o.ws.Path(), localSessionsDirName), o.sessionState())
 }
 
 type containerEnv map[string]envVarValue
@@ -495,9 +2611,9 @@ type envVarValue struct {
 // continer defined in the TaskDefinition. The returned map is a map of container names,
 // each of which contains a mapping of key->envVarValue, which defines if the variable is a secret or not.
 func (o *runLocalOpts) getEnvVars(ctx context.Context, taskDef *awsecs.TaskDefinition) (map[string]containerEnv, error) {
-	creds, err := o.sess.Config.Credentials.GetWithContext(ctx)
+	creds, err := o.credentials(ctx, taskDef)
 	if err != nil {
-		return nil, fmt.Errorf("get IAM credentials: %w", err)
+		return nil, err
 	}
 
 	envVars := make(map[string]containerEnv)
@@ -523,22 +2639,54 @@ func (o *runLocalOpts) getEnvVars(ctx context.Context, taskDef *awsecs.TaskDefin
 		}
 	}
 
-	for _, e := range taskDef.EnvironmentVariables() {
-		envVars[e.Container][e.Name] = envVarValue{
-			Value: e.Value,
-		}
-	}
+	fillTaskDefEnvVars(envVars, taskDef)
 
 	if err := o.fillEnvOverrides(envVars); err != nil {
 		return nil, fmt.Errorf("parse env overrides: %w", err)
 	}
 
-	if err := o.fillSecrets(ctx, envVars, taskDef); err != nil {
+	if err := o.fillSecrets(ctx, envVars, taskDef, o.refreshSecrets); err != nil {
 		return nil, fmt.Errorf("get secrets: %w", err)
 	}
 	return envVars, nil
 }
 
+// credentials returns the IAM credentials vended to the containers: the ones of the task role with --use-task-role,
+// and the ones of the user otherwise.
+func (o *runLocalOpts) credentials(ctx context.Context, taskDef *awsecs.TaskDefinition) (credentials.Value, error) {
+	if !o.useTaskRole {
+		creds, err := o.sess.Config.Credentials.GetWithContext(ctx)
+		if err != nil {
+			return credentials.Value{}, fmt.Errorf("get IAM credentials: %w", err)
+		}
+		return creds, nil
+	}
+	roleARN := aws.StringValue(taskDef.TaskRoleArn)
+	if roleARN == "" {
+		return credentials.Value{}, fmt.Errorf("the task definition of %q doesn't have a task role", o.wkldName)
+	}
+	creds, err := o.taskRoleCredentials(roleARN).GetWithContext(ctx)
+	if err != nil {
+		return credentials.Value{}, fmt.Errorf("assume task role %s: %w", roleARN, err)
+	}
+	return creds, nil
+}
+
+// fillTaskDefEnvVars sets the environment variables of each container to the values in the task definition.
+func fillTaskDefEnvVars(envVars map[string]containerEnv, taskDef *awsecs.TaskDefinition) {
+	for _, ctr := range taskDef.ContainerDefinitions {
+		name := aws.StringValue(ctr.Name)
+		if _, ok := envVars[name]; !ok {
+			envVars[name] = make(containerEnv)
+		}
+	}
+	for _, e := range taskDef.EnvironmentVariables() {
+		envVars[e.Container][e.Name] = envVarValue{
+			Value: e.Value,
+		}
+	}
+}
+
 // fillEnvOverrides parses environment variable overrides passed via flag.
 // The expected format of the flag values is KEY=VALUE, with an optional container name
 // in the format of [containerName]:KEY=VALUE. If the container name is omitted,
@@ -573,34 +2721,52 @@ func (o *runLocalOpts) fillEnvOverrides(envVars map[string]containerEnv) error {
 
 // fillSecrets collects non-overridden secrets from the task definition and
 // makes requests to SSM and Secrets Manager to get their value.
-func (o *runLocalOpts) fillSecrets(ctx context.Context, envVars map[string]containerEnv, taskDef *awsecs.TaskDefinition) error {
-	// figure out which secrets we need to get, set value to ValueFrom
-	unique := make(map[string]string)
-	for _, s := range taskDef.Secrets() {
-		cur, ok := envVars[s.Container][s.Name]
-		if cur.Override {
-			// ignore secrets that were overridden
+// SSM parameters are retrieved in batches, and the other secrets with a limited number of concurrent requests.
+// Cached values are used instead unless refresh is true.
+func (o *runLocalOpts) fillSecrets(ctx context.Context, envVars map[string]containerEnv, taskDef *awsecs.TaskDefinition, refresh bool) error {
+	unique, err := fillSecretRefs(envVars, taskDef)
+	if err != nil {
+		return err
+	}
+
+	toFetch, err := o.cachedSecrets(unique, refresh)
+	if err != nil {
+		return err
+	}
+
+	var ssmParams, others []string
+	for _, valueFrom := range toFetch {
+		if isSSMParameter(valueFrom) {
+			ssmParams = append(ssmParams, valueFrom)
 			continue
 		}
-		if ok {
-			return fmt.Errorf("secret names must be unique, but an environment variable %q already exists", s.Name)
+		others = append(others, valueFrom)
+	}
+	sort.Strings(ssmParams)
+	if len(ssmParams) > 0 {
+		values, err := o.ssm.GetSecretValues(ctx, ssmParams)
+		if err != nil {
+			return fmt.Errorf("get secrets from SSM: %w", err)
 		}
-
-		envVars[s.Container][s.Name] = envVarValue{
-			Value:  s.ValueFrom,
-			Secret: true,
+		for name, value := range values {
+			unique[name] = value
+			if o.cached != nil {
+				o.cache.putSecret(o.cached, name, value)
+			}
 		}
-		unique[s.ValueFrom] = ""
 	}
 
-	// get value of all needed secrets
+	versions := &secretVersionCache{
+		getter: o.secretsManager,
+		values: make(map[[3]string]*secretVersion),
+	}
 	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentSecretRequests)
 	mu := &sync.Mutex{}
-	mu.Lock() // lock until finished ranging over unique
-	for valueFrom := range unique {
+	for _, valueFrom := range others {
 		valueFrom := valueFrom
 		g.Go(func() error {
-			val, err := o.getSecret(ctx, valueFrom)
+			val, err := getSecret(ctx, versions, valueFrom)
 			if err != nil {
 				return fmt.Errorf("get secret %q: %w", valueFrom, err)
 			}
@@ -608,10 +2774,12 @@ func (o *runLocalOpts) fillSecrets(ctx context.Context, envVars map[string]conta
 			mu.Lock()
 			defer mu.Unlock()
 			unique[valueFrom] = val
+			if o.cached != nil {
+				o.cache.putSecret(o.cached, valueFrom, val)
+			}
 			return nil
 		})
 	}
-	mu.Unlock()
 	if err := g.Wait(); err != nil {
 		return err
 	}
@@ -631,29 +2799,161 @@ func (o *runLocalOpts) fillSecrets(ctx context.Context, envVars map[string]conta
 	return nil
 }
 
-func (o *runLocalOpts) getSecret(ctx context.Context, valueFrom string) (string, error) {
-	// SSM secrets can be specified as parameter name instead of an ARN.
-	// Default to ssm if valueFrom is not an ARN.
-	getter := o.ssm
-	if parsed, err := arn.Parse(valueFrom); err == nil { // only overwrite if successful
-		switch parsed.Service {
-		case sdkssm.ServiceName:
-			getter = o.ssm
-		case sdksecretsmanager.ServiceName:
-			getter = o.secretsManager
-		default:
-			return "", fmt.Errorf("invalid ARN; not a SSM or Secrets Manager ARN")
+// fillSecretRefs sets the non-overridden secrets from the task definition to their ValueFrom,
+// and returns the set of ValueFroms to resolve.
+func fillSecretRefs(envVars map[string]containerEnv, taskDef *awsecs.TaskDefinition) (map[string]string, error) {
+	unique := make(map[string]string)
+	for _, s := range taskDef.Secrets() {
+		cur, ok := envVars[s.Container][s.Name]
+		if cur.Override {
+			// ignore secrets that were overridden
+			continue
+		}
+		if ok {
+			return nil, fmt.Errorf("secret names must be unique, but an environment variable %q already exists", s.Name)
+		}
+
+		envVars[s.Container][s.Name] = envVarValue{
+			Value:  s.ValueFrom,
+			Secret: true,
+		}
+		unique[s.ValueFrom] = ""
+	}
+	return unique, nil
+}
+
+// cachedSecrets sets the values of the secrets that are cached in unique, and returns the ValueFroms left to fetch.
+// Cached values are used until they expire, unless the secrets are refreshed. Offline, they never expire.
+func (o *runLocalOpts) cachedSecrets(unique map[string]string, refresh bool) ([]string, error) {
+	var toFetch []string
+	for valueFrom := range unique {
+		if o.cached == nil || refresh {
+			toFetch = append(toFetch, valueFrom)
+			continue
+		}
+		ttl := defaultSecretCacheTTL
+		if o.offline {
+			ttl = -1
+		}
+		if val, ok := o.cache.secret(o.cached, valueFrom, ttl); ok {
+			unique[valueFrom] = val
+			continue
+		}
+		if o.offline {
+			return nil, fmt.Errorf("secret %q is not cached", valueFrom)
+		}
+		toFetch = append(toFetch, valueFrom)
+	}
+	return toFetch, nil
+}
+
+// isSSMParameter returns true if the secret is an SSM parameter, specified by either its name or its ARN.
+func isSSMParameter(valueFrom string) bool {
+	parsed, err := arn.Parse(valueFrom)
+	if err != nil {
+		// SSM secrets can be specified as parameter name instead of an ARN.
+		return true
+	}
+	// Like ECS, StringList parameters are injected as their comma-separated value.
+	return parsed.Service == sdkssm.ServiceName
+}
+
+// getSecret resolves a secret that isn't an SSM parameter.
+func getSecret(ctx context.Context, getter secretVersionGetter, valueFrom string) (string, error) {
+	parsed, err := arn.Parse(valueFrom)
+	if err != nil || parsed.Service != sdksecretsmanager.ServiceName {
+		return "", fmt.Errorf("invalid ARN; not a SSM or Secrets Manager ARN")
+	}
+	return getSecretsManagerSecret(ctx, getter, parsed)
+}
+
+// getSecretsManagerSecret resolves a Secrets Manager secret the same way ECS does, honoring the optional
+// "<json-key>:<version-stage>:<version-id>" specifiers that follow the name of the secret in its ARN.
+func getSecretsManagerSecret(ctx context.Context, getter secretVersionGetter, secretARN arn.ARN) (string, error) {
+	name, specifiers, _ := strings.Cut(strings.TrimPrefix(secretARN.Resource, "secret:"), ":")
+	var jsonKey, versionStage, versionID string
+	if specifiers != "" {
+		parts := strings.Split(specifiers, ":")
+		if len(parts) != 3 {
+			return "", fmt.Errorf("secret %s must be in the format <name>:<json-key>:<version-stage>:<version-id>", strings.TrimPrefix(secretARN.Resource, "secret:"))
 		}
+		jsonKey, versionStage, versionID = parts[0], parts[1], parts[2]
+	}
+	secretARN.Resource = "secret:" + name
+	value, err := getter.GetSecretVersionValue(ctx, secretARN.String(), versionStage, versionID)
+	if err != nil {
+		return "", err
 	}
+	if jsonKey == "" {
+		return value, nil
+	}
+	var kv map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value), &kv); err != nil {
+		return "", fmt.Errorf("secret %s is not a JSON object: %w", name, err)
+	}
+	raw, ok := kv[jsonKey]
+	if !ok {
+		return "", fmt.Errorf("key %q does not exist in secret %s", jsonKey, name)
+	}
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		return str, nil
+	}
+	// Non-string values, such as numbers or nested objects, are injected as their JSON representation.
+	return string(raw), nil
+}
+
+// secretVersionCache retrieves each version of a Secrets Manager secret once while the secrets of a task are resolved,
+// even if several secrets reference different JSON keys of it.
+type secretVersionCache struct {
+	getter secretVersionGetter
+
+	mu     sync.Mutex
+	values map[[3]string]*secretVersion // Keyed by the ARN, version stage and version ID of the secret.
+}
 
-	return getter.GetSecretValue(ctx, valueFrom)
+type secretVersion struct {
+	once  sync.Once
+	value string
+	err   error
 }
 
-// BuildRunLocalCmd builds the command for running a workload locally
+// GetSecretVersionValue implements the secretVersionGetter interface.
+func (c *secretVersionCache) GetSecretVersionValue(ctx context.Context, name, versionStage, versionID string) (string, error) {
+	key := [3]string{name, versionStage, versionID}
+	c.mu.Lock()
+	version, ok := c.values[key]
+	if !ok {
+		version = &secretVersion{}
+		c.values[key] = version
+	}
+	c.mu.Unlock()
+	version.once.Do(func() {
+		version.value, version.err = c.getter.GetSecretVersionValue(ctx, name, versionStage, versionID)
+	})
+	return version.value, version.err
+}
+
+// BuildRunLocalCmd builds the command for running workloads locally.
 func BuildRunLocalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Commands for running workloads locally.",
+		Long:  "Commands for running workloads locally.",
+		Annotations: map[string]string{
+			"group": group.Develop,
+		},
+	}
+	cmd.AddCommand(buildRunLocalCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
+
+// buildRunLocalCmd builds the command for running a workload locally.
+func buildRunLocalCmd() *cobra.Command {
 	vars := runLocalVars{}
 	cmd := &cobra.Command{
-		Use:   "run local",
+		Use:   "local",
 		Short: "Run the workload locally.",
 		Long:  "Run the workload locally.",
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
@@ -663,10 +2963,11 @@ func BuildRunLocalCmd() *cobra.Command {
 			}
 			return run(opts)
 		}),
-		Annotations: map[string]string{
-			"group": group.Develop,
-		},
 	}
+	cmd.AddCommand(buildRunLocalListCmd())
+	cmd.AddCommand(buildRunLocalExecCmd())
+	cmd.AddCommand(buildRunLocalStatusCmd())
+	cmd.AddCommand(buildRunLocalStopCmd())
 	cmd.SetUsageTemplate(template.Usage)
 
 	cmd.Flags().StringVarP(&vars.wkldName, nameFlag, nameFlagShort, "", workloadFlagDescription)
@@ -674,5 +2975,21 @@ func BuildRunLocalCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().Var(&vars.portOverrides, portOverrideFlag, portOverridesFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.envOverrides, envVarOverrideFlag, nil, envVarOverrideFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.volumeOverrides, volumeOverrideFlag, nil, volumeOverrideFlagDescription)
+	cmd.Flags().BoolVar(&vars.seedVolumes, seedVolumesFlag, false, seedVolumesFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, watchFlagDescription)
+	cmd.Flags().BoolVar(&vars.sync, syncFlag, false, syncFlagDescription)
+	cmd.Flags().BoolVar(&vars.proxy, proxyFlag, false, proxyFlagDescription)
+	cmd.Flags().IPNetVar(&vars.proxyNetwork, proxyNetworkFlag, defaultProxyNetwork, proxyNetworkFlagDescription)
+	cmd.Flags().Var(&vars.debug, debugFlag, debugFlagDescription)
+	cmd.Flags().StringVar(&vars.logsFormat, logsFormatFlag, logsFormatRaw, logsFormatFlagDescription)
+	cmd.Flags().BoolVar(&vars.refreshSecrets, refreshSecretsFlag, false, refreshSecretsFlagDescription)
+	cmd.Flags().BoolVar(&vars.offline, offlineFlag, false, offlineFlagDescription)
+	cmd.Flags().BoolVar(&vars.firelens, firelensFlag, false, firelensFlagDescription)
+	cmd.Flags().BoolVar(&vars.useTaskRole, useTaskRoleFlag, false, useTaskRoleFlagDescription)
+	cmd.Flags().BoolVar(&vars.offsetPorts, offsetPortsFlag, false, offsetPortsFlagDescription)
+	cmd.Flags().StringVar(&vars.localAWS, localAWSFlag, "", localAWSFlagDescription)
+	cmd.Flags().StringVar(&vars.platform, platformFlag, "", runLocalPlatformFlagDescription)
+	cmd.Flags().DurationVar(&vars.secretsRefreshInterval, secretsRefreshIntervalFlag, 0, secretsRefreshIntervalFlagDescription)
 	return cmd
 }
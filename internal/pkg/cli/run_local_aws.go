@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize/english"
+)
+
+const (
+	localAWSLocalStack    = "localstack"
+	localAWSDynamoDBLocal = "dynamodb-local"
+
+	// localAWSContainerName is the name of the container that emulates AWS services, which joins the network namespace
+	// of the pause container so that the containers of the task reach it on localhost.
+	localAWSContainerName = "local-aws"
+
+	fmtEndpointURLEnvVar = "AWS_ENDPOINT_URL_%s" // Service-specific endpoint of the AWS SDKs and the AWS CLI.
+)
+
+var (
+	localAWSEmulators = []string{localAWSLocalStack, localAWSDynamoDBLocal}
+
+	// sqsQueueURLPattern matches the URLs of SQS queues, like the one of the queue of a Worker Service in COPILOT_QUEUE_URI.
+	sqsQueueURLPattern = regexp.MustCompile(`https://sqs\.[a-z0-9-]+\.amazonaws\.com(?:\.cn)?/([0-9]{12}/[A-Za-z0-9_.-]+)`)
+)
+
+// localAWSEmulator is an image that emulates AWS services locally.
+type localAWSEmulator struct {
+	displayName string
+	imageURI    string
+	port        string
+	envVars     map[string]string
+	services    map[string]string // Identifiers of the emulated services in the endpoint env vars to their names.
+	resources   string            // Kinds of resources to create in the emulator.
+}
+
+func newLocalAWSEmulator(name string) *localAWSEmulator {
+	switch name {
+	case localAWSLocalStack:
+		return &localAWSEmulator{
+			displayName: "LocalStack",
+			imageURI:    "public.ecr.aws/localstack/localstack:3",
+			port:        "4566",
+			envVars: map[string]string{
+				"SERVICES": "dynamodb,s3,sqs",
+			},
+			services: map[string]string{
+				"DYNAMODB": "DynamoDB",
+				"S3":       "S3",
+				"SQS":      "SQS",
+			},
+			resources: "tables, buckets and queues",
+		}
+	case localAWSDynamoDBLocal:
+		return &localAWSEmulator{
+			displayName: "DynamoDB Local",
+			imageURI:    "public.ecr.aws/aws-dynamodb-local/aws-dynamodb-local:2",
+			port:        "8000",
+			services: map[string]string{
+				"DYNAMODB": "DynamoDB",
+			},
+			resources: "tables",
+		}
+	}
+	return nil
+}
+
+func (e *localAWSEmulator) endpoint() string {
+	return "http://localhost:" + e.port
+}
+
+// configureLocalAWS adds the container of the emulator of AWS services to the containers to run, publishes its port,
+// and points the AWS SDKs of the containers of the task to it. The names of the env vars of the task are unchanged,
+// but the URLs of SQS queues in their values are rewritten to the emulator if it emulates SQS.
+func (o *runLocalOpts) configureLocalAWS(taskDef *awsecs.TaskDefinition, envVars map[string]containerEnv, ports map[string]string, containerURIs map[string]string) error {
+	emulator := newLocalAWSEmulator(o.localAWS)
+	if _, ok := containerURIs[localAWSContainerName]; ok {
+		return fmt.Errorf("cannot emulate AWS services: %s already has a container named %q", o.wkldName, localAWSContainerName)
+	}
+	if _, ok := ports[emulator.port]; ok {
+		return fmt.Errorf("cannot emulate AWS services: port %s of %s is already used by the task of %s", emulator.port, emulator.displayName, o.wkldName)
+	}
+	ports[emulator.port] = emulator.port
+	containerURIs[localAWSContainerName] = emulator.imageURI
+	emulatorEnv := make(containerEnv, len(emulator.envVars))
+	for k, v := range emulator.envVars {
+		emulatorEnv[k] = envVarValue{
+			Value: v,
+		}
+	}
+	envVars[localAWSContainerName] = emulatorEnv
+
+	_, emulatesSQS := emulator.services["SQS"]
+	for _, def := range taskDef.ContainerDefinitions {
+		env := envVars[aws.StringValue(def.Name)]
+		if emulatesSQS {
+			for k, v := range env {
+				if v.Override {
+					continue
+				}
+				v.Value = sqsQueueURLPattern.ReplaceAllString(v.Value, emulator.endpoint()+"/$1")
+				env[k] = v
+			}
+		}
+		for id := range emulator.services {
+			key := fmt.Sprintf(fmtEndpointURLEnvVar, id)
+			if v, ok := env[key]; ok && v.Override {
+				continue
+			}
+			env[key] = envVarValue{
+				Value: emulator.endpoint(),
+			}
+		}
+	}
+
+	names := make([]string, 0, len(emulator.services))
+	for _, name := range emulator.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	log.Infof("Emulating %s with %s on port %s: create the %s that %s uses in it.\n",
+		english.WordSeries(names, "and"), emulator.displayName, emulator.port, emulator.resources, o.wkldName)
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLocalOpts_configureLocalAWS(t *testing.T) {
+	taskDef := &awsecs.TaskDefinition{
+		ContainerDefinitions: []*sdkecs.ContainerDefinition{
+			{Name: aws.String("worker")},
+			{Name: aws.String("sidecar")},
+		},
+	}
+	testCases := map[string]struct {
+		inLocalAWS      string
+		inEnvVars       map[string]containerEnv
+		inPorts         map[string]string
+		inContainerURIs map[string]string
+
+		wantEnvVars       map[string]containerEnv
+		wantPorts         map[string]string
+		wantContainerURIs map[string]string
+		wantErr           error
+	}{
+		"error if the port of the emulator is used by the task": {
+			inLocalAWS: localAWSDynamoDBLocal,
+			inPorts: map[string]string{
+				"8000": "8080",
+			},
+			inContainerURIs: map[string]string{},
+			wantErr:         errors.New("cannot emulate AWS services: port 8000 of DynamoDB Local is already used by the task of queue"),
+		},
+		"error if the task has a container named like the emulator": {
+			inLocalAWS: localAWSLocalStack,
+			inPorts:    map[string]string{},
+			inContainerURIs: map[string]string{
+				"local-aws": "local-aws:latest",
+			},
+			wantErr: errors.New(`cannot emulate AWS services: queue already has a container named "local-aws"`),
+		},
+		"localstack points the SDKs to it and rewrites the URLs of the queues": {
+			inLocalAWS: localAWSLocalStack,
+			inEnvVars: map[string]containerEnv{
+				"worker": {
+					"COPILOT_QUEUE_URI": {
+						Value: "https://sqs.us-west-2.amazonaws.com/123456789012/app-test-queue-EventsQueue",
+					},
+					"AWS_ENDPOINT_URL_S3": {
+						Value:    "http://minio:9000",
+						Override: true,
+					},
+					"DEAD_LETTERS": {
+						Value:    "https://sqs.us-west-2.amazonaws.com/123456789012/dead-letters",
+						Override: true,
+					},
+				},
+				"sidecar": {},
+			},
+			inPorts: map[string]string{
+				"80": "8080",
+			},
+			inContainerURIs: map[string]string{
+				"worker":  "worker:latest",
+				"sidecar": "sidecar:latest",
+			},
+			wantEnvVars: map[string]containerEnv{
+				"worker": {
+					"COPILOT_QUEUE_URI": {
+						Value: "http://localhost:4566/123456789012/app-test-queue-EventsQueue",
+					},
+					"AWS_ENDPOINT_URL_S3": {
+						Value:    "http://minio:9000",
+						Override: true,
+					},
+					"DEAD_LETTERS": {
+						Value:    "https://sqs.us-west-2.amazonaws.com/123456789012/dead-letters",
+						Override: true,
+					},
+					"AWS_ENDPOINT_URL_DYNAMODB": {Value: "http://localhost:4566"},
+					"AWS_ENDPOINT_URL_SQS":      {Value: "http://localhost:4566"},
+				},
+				"sidecar": {
+					"AWS_ENDPOINT_URL_DYNAMODB": {Value: "http://localhost:4566"},
+					"AWS_ENDPOINT_URL_S3":       {Value: "http://localhost:4566"},
+					"AWS_ENDPOINT_URL_SQS":      {Value: "http://localhost:4566"},
+				},
+				"local-aws": {
+					"SERVICES": {Value: "dynamodb,s3,sqs"},
+				},
+			},
+			wantPorts: map[string]string{
+				"80":   "8080",
+				"4566": "4566",
+			},
+			wantContainerURIs: map[string]string{
+				"worker":    "worker:latest",
+				"sidecar":   "sidecar:latest",
+				"local-aws": "public.ecr.aws/localstack/localstack:3",
+			},
+		},
+		"dynamodb local only points DynamoDB to it": {
+			inLocalAWS: localAWSDynamoDBLocal,
+			inEnvVars: map[string]containerEnv{
+				"worker": {
+					"COPILOT_QUEUE_URI": {
+						Value: "https://sqs.us-west-2.amazonaws.com/123456789012/app-test-queue-EventsQueue",
+					},
+				},
+				"sidecar": {},
+			},
+			inPorts: map[string]string{},
+			inContainerURIs: map[string]string{
+				"worker":  "worker:latest",
+				"sidecar": "sidecar:latest",
+			},
+			wantEnvVars: map[string]containerEnv{
+				"worker": {
+					"COPILOT_QUEUE_URI": {
+						Value: "https://sqs.us-west-2.amazonaws.com/123456789012/app-test-queue-EventsQueue",
+					},
+					"AWS_ENDPOINT_URL_DYNAMODB": {Value: "http://localhost:8000"},
+				},
+				"sidecar": {
+					"AWS_ENDPOINT_URL_DYNAMODB": {Value: "http://localhost:8000"},
+				},
+				"local-aws": {},
+			},
+			wantPorts: map[string]string{
+				"8000": "8000",
+			},
+			wantContainerURIs: map[string]string{
+				"worker":    "worker:latest",
+				"sidecar":   "sidecar:latest",
+				"local-aws": "public.ecr.aws/aws-dynamodb-local/aws-dynamodb-local:2",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					wkldName: "queue",
+					localAWS: tc.inLocalAWS,
+				},
+			}
+
+			err := opts.configureLocalAWS(taskDef, tc.inEnvVars, tc.inPorts, tc.inContainerURIs)
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantEnvVars, tc.inEnvVars)
+			require.Equal(t, tc.wantPorts, tc.inPorts)
+			require.Equal(t, tc.wantContainerURIs, tc.inContainerURIs)
+		})
+	}
+}
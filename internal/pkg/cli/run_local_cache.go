@@ -0,0 +1,231 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
+)
+
+const (
+	// defaultSecretCacheTTL is how long a cached secret value is used before it's fetched again.
+	defaultSecretCacheTTL = time.Hour
+
+	runLocalCacheKeyFile = "key"
+	runLocalCacheKeySize = 32 // AES-256.
+)
+
+var errRunLocalCacheMiss = errors.New("not cached")
+
+// runLocalCache stores the configuration that run local fetches from AWS in encrypted files on disk,
+// so that later runs can skip the requests or run without network access.
+// The files and the key that encrypts them are only readable by the user.
+type runLocalCache struct {
+	fs  afero.Fs
+	dir string
+	now func() time.Time
+}
+
+// runLocalCacheEntry is the cached configuration of a workload in an environment.
+type runLocalCacheEntry struct {
+	App           *config.Application     `json:"app"`
+	Env           *config.Environment     `json:"env"`
+	WorkloadType  string                  `json:"workloadType"`
+	TaskDef       *awsecs.TaskDefinition  `json:"taskDefinition,omitempty"`
+	RepositoryURI string                  `json:"repositoryURI,omitempty"`
+	Secrets       map[string]cachedSecret `json:"secrets,omitempty"` // Keyed by the ValueFrom of the secret.
+}
+
+type cachedSecret struct {
+	Value     string    `json:"value"`
+	FetchedAt time.Time `json:"fetchedAt"`
+}
+
+// newRunLocalCache returns a cache in the cache directory of the user.
+func newRunLocalCache(fs afero.Fs) (*runLocalCache, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	return &runLocalCache{
+		fs:  fs,
+		dir: filepath.Join(dir, "copilot", "run-local"),
+		now: time.Now,
+	}, nil
+}
+
+// read returns the cached configuration of the workload, or errRunLocalCacheMiss if nothing is cached.
+func (c *runLocalCache) read(app, env, wkld string) (*runLocalCacheEntry, error) {
+	ciphertext, err := afero.ReadFile(c.fs, c.path(app, env, wkld))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, errRunLocalCacheMiss
+		}
+		return nil, fmt.Errorf("read cache file: %w", err)
+	}
+	gcm, err := c.cipher(false)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("cache file is corrupted")
+	}
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt cache file: %w", err)
+	}
+	var entry runLocalCacheEntry
+	if err := json.Unmarshal(plaintext, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal cache file: %w", err)
+	}
+	return &entry, nil
+}
+
+// write encrypts the configuration of the workload and stores it in the cache.
+func (c *runLocalCache) write(app, env, wkld string, entry *runLocalCacheEntry) error {
+	plaintext, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal cache entry: %w", err)
+	}
+	gcm, err := c.cipher(true)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return fmt.Errorf("generate nonce: %w", err)
+	}
+	path := c.path(app, env, wkld)
+	if err := c.fs.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("create cache directory: %w", err)
+	}
+	if err := afero.WriteFile(c.fs, path, gcm.Seal(nonce, nonce, plaintext, nil), 0600); err != nil {
+		return fmt.Errorf("write cache file: %w", err)
+	}
+	return nil
+}
+
+// secret returns the cached value of the secret if it was fetched less than ttl ago.
+// A negative ttl returns the value regardless of its age.
+func (c *runLocalCache) secret(entry *runLocalCacheEntry, valueFrom string, ttl time.Duration) (string, bool) {
+	s, ok := entry.Secrets[valueFrom]
+	if !ok {
+		return "", false
+	}
+	if ttl >= 0 && c.now().Sub(s.FetchedAt) >= ttl {
+		return "", false
+	}
+	return s.Value, true
+}
+
+// putSecret records the value of the secret in the entry.
+func (c *runLocalCache) putSecret(entry *runLocalCacheEntry, valueFrom, value string) {
+	if entry.Secrets == nil {
+		entry.Secrets = make(map[string]cachedSecret)
+	}
+	entry.Secrets[valueFrom] = cachedSecret{
+		Value:     value,
+		FetchedAt: c.now(),
+	}
+}
+
+func (c *runLocalCache) path(app, env, wkld string) string {
+	return filepath.Join(c.dir, app, env, wkld)
+}
+
+// cipher returns the AES-GCM cipher of the cache. If create is true, the key is generated if it doesn't exist yet.
+func (c *runLocalCache) cipher(create bool) (cipher.AEAD, error) {
+	path := filepath.Join(c.dir, runLocalCacheKeyFile)
+	key, err := afero.ReadFile(c.fs, path)
+	switch {
+	case errors.Is(err, os.ErrNotExist) && create:
+		key = make([]byte, runLocalCacheKeySize)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, fmt.Errorf("generate cache key: %w", err)
+		}
+		if err := c.fs.MkdirAll(c.dir, 0700); err != nil {
+			return nil, fmt.Errorf("create cache directory: %w", err)
+		}
+		if err := afero.WriteFile(c.fs, path, key, 0600); err != nil {
+			return nil, fmt.Errorf("write cache key: %w", err)
+		}
+	case err != nil:
+		return nil, fmt.Errorf("read cache key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// readCache reads the cached configuration of the workload to reuse its secrets.
+// The cache is best-effort: if it can't be read, the configuration is fetched again.
+func (o *runLocalOpts) readCache() {
+	if o.cache == nil {
+		return
+	}
+	entry, err := o.cache.read(o.appName, o.envName, o.wkldName)
+	if err != nil {
+		if !errors.Is(err, errRunLocalCacheMiss) {
+			log.Warningf("Ignoring the cached configuration of %s: %v\n", o.wkldName, err)
+		}
+		entry = &runLocalCacheEntry{}
+	}
+	o.cached = entry
+}
+
+// writeCache stores the configuration fetched for the workload, so that it can be run offline later.
+func (o *runLocalOpts) writeCache() {
+	if o.cached == nil || o.offline {
+		return
+	}
+	o.cached.App = o.targetApp
+	o.cached.Env = o.targetEnv
+	o.cached.WorkloadType = o.wkldType
+	if err := o.cache.write(o.appName, o.envName, o.wkldName, o.cached); err != nil {
+		log.Warningf("Couldn't cache the configuration of %s: %v\n", o.wkldName, err)
+	}
+}
+
+// configureOfflineClients sets up the clients of an offline run from the cache.
+func (o *runLocalOpts) configureOfflineClients() {
+	// Dynamic content of the manifest, such as subnet filters, can't be loaded offline anyway,
+	// so the default session stands in for the environment session.
+	o.envSess = o.sess
+	o.repository = &offlineRepository{
+		repositoryService: repository.NewWithURI(nil, clideploy.RepoName(o.appName, o.wkldName), o.cached.RepositoryURI),
+		uri:               o.cached.RepositoryURI,
+	}
+}
+
+// offlineRepository builds images locally and tags them with the cached URI of their repository without logging in to it.
+type offlineRepository struct {
+	repositoryService
+	uri string
+}
+
+// Login returns the cached URI of the repository.
+func (r *offlineRepository) Login() (string, error) {
+	if r.uri == "" {
+		return "", errors.New("no image repository is cached")
+	}
+	return r.uri, nil
+}
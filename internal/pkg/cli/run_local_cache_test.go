@@ -0,0 +1,243 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLocalCache_readWrite(t *testing.T) {
+	now := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	newCache := func(fs afero.Fs) *runLocalCache {
+		return &runLocalCache{
+			fs:  fs,
+			dir: "/cache",
+			now: func() time.Time { return now },
+		}
+	}
+	entry := &runLocalCacheEntry{
+		App:          &config.Application{Name: "app"},
+		Env:          &config.Environment{App: "app", Name: "test", Region: "us-west-2"},
+		WorkloadType: "Load Balanced Web Service",
+		TaskDef: &awsecs.TaskDefinition{
+			ContainerDefinitions: []*sdkecs.ContainerDefinition{
+				{
+					Name:  aws.String("api"),
+					Image: aws.String("nginx"),
+				},
+			},
+		},
+		RepositoryURI: "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/api",
+		Secrets: map[string]cachedSecret{
+			"/copilot/app/test/secrets/DB_PASSWORD": {
+				Value:     "hunter2",
+				FetchedAt: now,
+			},
+		},
+	}
+
+	t.Run("returns a miss if nothing is cached", func(t *testing.T) {
+		_, err := newCache(afero.NewMemMapFs()).read("app", "test", "api")
+		require.ErrorIs(t, err, errRunLocalCacheMiss)
+	})
+	t.Run("reads back the entry it wrote, encrypted and only readable by the user", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		cache := newCache(fs)
+
+		require.NoError(t, cache.write("app", "test", "api", entry))
+		got, err := cache.read("app", "test", "api")
+
+		require.NoError(t, err)
+		require.Equal(t, entry, got)
+		raw, err := afero.ReadFile(fs, "/cache/app/test/api")
+		require.NoError(t, err)
+		require.NotContains(t, string(raw), "hunter2")
+		for _, path := range []string{"/cache/app/test/api", "/cache/key"} {
+			info, err := fs.Stat(path)
+			require.NoError(t, err)
+			require.Equal(t, "-rw-------", info.Mode().String())
+		}
+	})
+	t.Run("fails to decrypt the entry with another key", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		cache := newCache(fs)
+		require.NoError(t, cache.write("app", "test", "api", entry))
+		require.NoError(t, afero.WriteFile(fs, "/cache/key", make([]byte, runLocalCacheKeySize), 0600))
+
+		_, err := cache.read("app", "test", "api")
+
+		require.ErrorContains(t, err, "decrypt cache file")
+	})
+}
+
+func TestRunLocalOpts_cachedSecrets(t *testing.T) {
+	now := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	cached := func() *runLocalCacheEntry {
+		return &runLocalCacheEntry{
+			Secrets: map[string]cachedSecret{
+				"fresh": {
+					Value:     "fresh-value",
+					FetchedAt: now.Add(-time.Minute),
+				},
+				"expired": {
+					Value:     "expired-value",
+					FetchedAt: now.Add(-2 * defaultSecretCacheTTL),
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		inCached         *runLocalCacheEntry
+		inRefreshSecrets bool
+		inOffline        bool
+
+		wantedValues  map[string]string
+		wantedToFetch []string
+		wantedError   error
+	}{
+		"fetches every secret without a cache": {
+			wantedValues:  map[string]string{"fresh": "", "expired": "", "missing": ""},
+			wantedToFetch: []string{"expired", "fresh", "missing"},
+		},
+		"uses the secrets that haven't expired": {
+			inCached:      cached(),
+			wantedValues:  map[string]string{"fresh": "fresh-value", "expired": "", "missing": ""},
+			wantedToFetch: []string{"expired", "missing"},
+		},
+		"fetches every secret when they are refreshed": {
+			inCached:         cached(),
+			inRefreshSecrets: true,
+			wantedValues:     map[string]string{"fresh": "", "expired": "", "missing": ""},
+			wantedToFetch:    []string{"expired", "fresh", "missing"},
+		},
+		"error if a secret isn't cached offline": {
+			inCached:    cached(),
+			inOffline:   true,
+			wantedError: errors.New(`secret "missing" is not cached`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					offline: tc.inOffline,
+				},
+				cache: &runLocalCache{
+					now: func() time.Time { return now },
+				},
+				cached: tc.inCached,
+			}
+			unique := map[string]string{"fresh": "", "expired": "", "missing": ""}
+
+			// WHEN
+			toFetch, err := opts.cachedSecrets(unique, tc.inRefreshSecrets)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			sort.Strings(toFetch)
+			require.Equal(t, tc.wantedToFetch, toFetch)
+			require.Equal(t, tc.wantedValues, unique)
+		})
+	}
+
+	t.Run("uses expired secrets offline", func(t *testing.T) {
+		opts := runLocalOpts{
+			runLocalVars: runLocalVars{
+				offline: true,
+			},
+			cache: &runLocalCache{
+				now: func() time.Time { return now },
+			},
+			cached: cached(),
+		}
+		unique := map[string]string{"fresh": "", "expired": ""}
+
+		toFetch, err := opts.cachedSecrets(unique, false)
+
+		require.NoError(t, err)
+		require.Empty(t, toFetch)
+		require.Equal(t, map[string]string{"fresh": "fresh-value", "expired": "expired-value"}, unique)
+	})
+}
+
+func TestRunLocalOpts_validateOffline(t *testing.T) {
+	entry := &runLocalCacheEntry{
+		App:          &config.Application{Name: "app"},
+		Env:          &config.Environment{App: "app", Name: "test"},
+		WorkloadType: "Backend Service",
+	}
+	testCases := map[string]struct {
+		inVars      runLocalVars
+		inCached    bool
+		wantedError error
+	}{
+		"error with --refresh-secrets": {
+			inVars:      runLocalVars{refreshSecrets: true},
+			wantedError: errors.New("cannot specify both --offline and --refresh-secrets"),
+		},
+		"error with --proxy": {
+			inVars:      runLocalVars{proxy: true},
+			wantedError: errors.New("cannot specify both --offline and --proxy: proxying connections requires network access"),
+		},
+		"error without a workload and an environment": {
+			inVars:      runLocalVars{wkldName: "api"},
+			wantedError: errors.New("--name and --env are required with --offline"),
+		},
+		"error if nothing is cached": {
+			inVars:      runLocalVars{wkldName: "api", envName: "test"},
+			wantedError: errors.New("nothing is cached for api in environment test: run it once with network access first"),
+		},
+		"reads the application, environment and workload type from the cache": {
+			inVars:   runLocalVars{wkldName: "api", envName: "test"},
+			inCached: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			cache := &runLocalCache{
+				fs:  afero.NewMemMapFs(),
+				dir: "/cache",
+				now: time.Now,
+			}
+			if tc.inCached {
+				require.NoError(t, cache.write("app", "test", "api", entry))
+			}
+			tc.inVars.appName = "app"
+			tc.inVars.offline = true
+			opts := runLocalOpts{
+				runLocalVars: tc.inVars,
+				cache:        cache,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, entry.App, opts.targetApp)
+			require.Equal(t, entry.Env, opts.targetEnv)
+			require.Equal(t, "Backend Service", opts.wkldType)
+		})
+	}
+}
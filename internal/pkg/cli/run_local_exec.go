@@ -0,0 +1,174 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+)
+
+const (
+	runLocalExecSessionPrompt     = "Into which workload running locally would you like to execute?"
+	runLocalExecSessionHelpPrompt = `Copilot runs your command in a container of the workload started with "copilot run local".
+The main container of the workload is used unless --container is set.`
+)
+
+// containerName returns the name of a container of the session, which shares the suffix of its pause container.
+func (s *localSession) containerName(name string) string {
+	return name + strings.TrimPrefix(s.container, pauseContainerName)
+}
+
+type execRunLocalVars struct {
+	appName       string
+	envName       string
+	wkldName      string
+	containerName string
+	command       string
+	args          []string // Command and arguments after "--", which take precedence over the command flag.
+}
+
+type execRunLocalOpts struct {
+	execRunLocalVars
+
+	docker localContainerExecutor
+	prompt prompter
+}
+
+func newExecRunLocalOpts(vars execRunLocalVars) *execRunLocalOpts {
+	return &execRunLocalOpts{
+		execRunLocalVars: vars,
+		docker:           dockerengine.New(exec.NewCmd()),
+		prompt:           prompt.New(),
+	}
+}
+
+// Validate returns an error if the command to run can't be parsed.
+func (o *execRunLocalOpts) Validate() error {
+	_, err := o.commandArgs()
+	return err
+}
+
+// Ask is a no-op: the workload running locally is selected on execution.
+func (o *execRunLocalOpts) Ask() error {
+	return nil
+}
+
+// Execute opens an interactive session in a container of a workload running locally.
+func (o *execRunLocalOpts) Execute() error {
+	ctx := context.Background()
+	session, err := o.selectSession(ctx)
+	if err != nil {
+		return err
+	}
+	container := o.containerName
+	if container == "" {
+		container = session.workload
+	}
+	name := session.containerName(container)
+	running, err := o.docker.IsContainerRunning(name)
+	if err != nil {
+		return fmt.Errorf("check if container %q is running: %w", name, err)
+	}
+	if !running {
+		return fmt.Errorf("container %q of %s is not running", container, session)
+	}
+	cmd, err := o.commandArgs()
+	if err != nil {
+		return err
+	}
+	return o.docker.InteractiveExec(ctx, name, cmd)
+}
+
+func (o *execRunLocalOpts) commandArgs() ([]string, error) {
+	if len(o.args) > 0 {
+		return o.args, nil
+	}
+	args, err := shlex.Split(o.command)
+	if err != nil {
+		return nil, fmt.Errorf("parse command %q: %w", o.command, err)
+	}
+	if len(args) == 0 {
+		return nil, errors.New("the command to run in the container must not be empty")
+	}
+	return args, nil
+}
+
+// selectSession returns the workload running locally that matches the flags, and prompts for one if several match.
+func (o *execRunLocalOpts) selectSession(ctx context.Context) (*localSession, error) {
+	sessions, err := listLocalSessions(ctx, o.docker)
+	if err != nil {
+		return nil, err
+	}
+	var matches []*localSession
+	for _, session := range sessions {
+		if o.appName != "" && session.app != o.appName {
+			continue
+		}
+		if o.envName != "" && session.env != o.envName {
+			continue
+		}
+		if o.wkldName != "" && session.workload != o.wkldName {
+			continue
+		}
+		matches = append(matches, session)
+	}
+	switch len(matches) {
+	case 0:
+		return nil, errors.New(`no matching workload is running locally: run one with "copilot run local"`)
+	case 1:
+		return matches[0], nil
+	}
+	opts := make([]prompt.Option, len(matches))
+	for i, session := range matches {
+		opts[i] = prompt.Option{
+			Value:        session.container,
+			FriendlyText: session.String(),
+			Hint:         session.app,
+		}
+	}
+	selected, err := o.prompt.SelectOption(runLocalExecSessionPrompt, runLocalExecSessionHelpPrompt, opts, prompt.WithFinalMessage("Workload:"))
+	if err != nil {
+		return nil, fmt.Errorf("select a workload running locally: %w", err)
+	}
+	for _, session := range matches {
+		if session.container == selected {
+			return session, nil
+		}
+	}
+	return nil, fmt.Errorf("workload running locally in container %q not found", selected)
+}
+
+// buildRunLocalExecCmd builds the command for running a command in a container of a workload running locally.
+func buildRunLocalExecCmd() *cobra.Command {
+	vars := execRunLocalVars{}
+	cmd := &cobra.Command{
+		Use:   "exec [-- command]",
+		Short: `Execute a command in a container of a workload running locally with "copilot run local".`,
+		Example: `
+  Start an interactive shell in the main container of the workload running locally.
+  /code $ copilot run local exec
+  Start a shell in the "api" container of the "frontend" service running locally.
+  /code $ copilot run local exec -n frontend --container api -- sh
+  Run a command in the container.
+  /code $ copilot run local exec -c "ls -la /app"`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.args = args
+			return run(newExecRunLocalOpts(vars))
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.wkldName, nameFlag, nameFlagShort, "", workloadFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", runLocalExecContainerFlagDescription)
+	cmd.Flags().StringVarP(&vars.command, commandFlag, commandFlagShort, defaultCommand, execCommandFlagDescription)
+	return cmd
+}
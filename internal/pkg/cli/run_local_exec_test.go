@@ -0,0 +1,168 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecRunLocalOpts_Validate(t *testing.T) {
+	tests := map[string]struct {
+		inVars execRunLocalVars
+
+		wantedErr error
+	}{
+		"error if the command is empty": {
+			inVars: execRunLocalVars{
+				command: " ",
+			},
+			wantedErr: errors.New("the command to run in the container must not be empty"),
+		},
+		"error if the command can't be parsed": {
+			inVars: execRunLocalVars{
+				command: `sh -c "echo`,
+			},
+			wantedErr: errors.New(`parse command "sh -c \"echo": EOF found when expecting closing quote`),
+		},
+		"arguments after the dash take precedence over the command": {
+			inVars: execRunLocalVars{
+				command: " ",
+				args:    []string{"sh"},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts := &execRunLocalOpts{
+				execRunLocalVars: tc.inVars,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestExecRunLocalOpts_Execute(t *testing.T) {
+	containers := []dockerengine.RunningContainer{
+		{
+			Name: "pause-app-test-web",
+			Labels: map[string]string{
+				localSessionAppLabel:      "app",
+				localSessionEnvLabel:      "test",
+				localSessionWorkloadLabel: "web",
+			},
+		},
+		{
+			Name: "pause-app-test-api",
+			Labels: map[string]string{
+				localSessionAppLabel:      "app",
+				localSessionEnvLabel:      "test",
+				localSessionWorkloadLabel: "api",
+			},
+		},
+	}
+	tests := map[string]struct {
+		inVars     execRunLocalVars
+		setupMocks func(docker *mocks.MocklocalContainerExecutor, prompt *mocks.Mockprompter)
+
+		wantedErr error
+	}{
+		"error if the running containers can't be listed": {
+			setupMocks: func(docker *mocks.MocklocalContainerExecutor, _ *mocks.Mockprompter) {
+				docker.EXPECT().RunningContainers(gomock.Any(), localSessionWorkloadLabel).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list the workloads running locally: some error"),
+		},
+		"error if no workload matches the flags": {
+			inVars: execRunLocalVars{
+				wkldName: "worker",
+			},
+			setupMocks: func(docker *mocks.MocklocalContainerExecutor, _ *mocks.Mockprompter) {
+				docker.EXPECT().RunningContainers(gomock.Any(), localSessionWorkloadLabel).Return(containers, nil)
+			},
+			wantedErr: errors.New(`no matching workload is running locally: run one with "copilot run local"`),
+		},
+		"error if the container isn't running": {
+			inVars: execRunLocalVars{
+				wkldName:      "api",
+				containerName: "nginx",
+				command:       "/bin/sh",
+			},
+			setupMocks: func(docker *mocks.MocklocalContainerExecutor, _ *mocks.Mockprompter) {
+				docker.EXPECT().RunningContainers(gomock.Any(), localSessionWorkloadLabel).Return(containers, nil)
+				docker.EXPECT().IsContainerRunning("nginx-app-test-api").Return(false, nil)
+			},
+			wantedErr: errors.New(`container "nginx" of api in environment test is not running`),
+		},
+		"execute in the main container of the only matching workload": {
+			inVars: execRunLocalVars{
+				wkldName: "api",
+				command:  "/bin/sh",
+			},
+			setupMocks: func(docker *mocks.MocklocalContainerExecutor, _ *mocks.Mockprompter) {
+				docker.EXPECT().RunningContainers(gomock.Any(), localSessionWorkloadLabel).Return(containers, nil)
+				docker.EXPECT().IsContainerRunning("api-app-test-api").Return(true, nil)
+				docker.EXPECT().InteractiveExec(gomock.Any(), "api-app-test-api", []string{"/bin/sh"}).Return(nil)
+			},
+		},
+		"prompt for the workload if several match": {
+			inVars: execRunLocalVars{
+				containerName: "nginx",
+				command:       "/bin/sh",
+				args:          []string{"ls", "-la"},
+			},
+			setupMocks: func(docker *mocks.MocklocalContainerExecutor, p *mocks.Mockprompter) {
+				docker.EXPECT().RunningContainers(gomock.Any(), localSessionWorkloadLabel).Return(containers, nil)
+				p.EXPECT().SelectOption(runLocalExecSessionPrompt, runLocalExecSessionHelpPrompt, []prompt.Option{
+					{Value: "pause-app-test-api", FriendlyText: "api in environment test", Hint: "app"},
+					{Value: "pause-app-test-web", FriendlyText: "web in environment test", Hint: "app"},
+				}, gomock.Any()).Return("pause-app-test-web", nil)
+				docker.EXPECT().IsContainerRunning("nginx-app-test-web").Return(true, nil)
+				docker.EXPECT().InteractiveExec(gomock.Any(), "nginx-app-test-web", []string{"ls", "-la"}).Return(nil)
+			},
+		},
+		"error if the prompt fails": {
+			setupMocks: func(docker *mocks.MocklocalContainerExecutor, p *mocks.Mockprompter) {
+				docker.EXPECT().RunningContainers(gomock.Any(), localSessionWorkloadLabel).Return(containers, nil)
+				p.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select a workload running locally: some error"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			docker := mocks.NewMocklocalContainerExecutor(ctrl)
+			p := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(docker, p)
+			opts := &execRunLocalOpts{
+				execRunLocalVars: tc.inVars,
+				docker:           docker,
+				prompt:           p,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
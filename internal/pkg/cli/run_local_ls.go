@@ -0,0 +1,212 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/spf13/cobra"
+)
+
+// Labels of the pause container of a workload run locally, which identify its local session.
+const (
+	localSessionAppLabel      = "com.aws.copilot.local.application"
+	localSessionEnvLabel      = "com.aws.copilot.local.environment"
+	localSessionWorkloadLabel = "com.aws.copilot.local.workload"
+	localSessionPortsLabel    = "com.aws.copilot.local.ports" // Published ports as <host port>:<container port>, separated by spaces.
+)
+
+// localSession is a workload running locally with "copilot run local".
+type localSession struct {
+	app        string
+	env        string
+	workload   string
+	container  string            // Name of the pause container of the session.
+	ports      map[string]string // Host port to container port.
+	runningFor string
+}
+
+func (s *localSession) String() string {
+	return fmt.Sprintf("%s in environment %s", s.workload, s.env)
+}
+
+// hostPorts returns the ports of the host published by the session in ascending order.
+func (s *localSession) hostPorts() []string {
+	return sortedHostPorts(s.ports)
+}
+
+// sortedHostPorts returns the host ports of a mapping of host port to container port in ascending order.
+func sortedHostPorts(ports map[string]string) []string {
+	hosts := make([]string, 0, len(ports))
+	for host := range ports {
+		hosts = append(hosts, host)
+	}
+	sort.Slice(hosts, func(i, j int) bool {
+		if len(hosts[i]) != len(hosts[j]) {
+			return len(hosts[i]) < len(hosts[j])
+		}
+		return hosts[i] < hosts[j]
+	})
+	return hosts
+}
+
+// listLocalSessions returns the workloads running locally, sorted by application, environment and name.
+func listLocalSessions(ctx context.Context, lister runningContainerLister) ([]*localSession, error) {
+	containers, err := lister.RunningContainers(ctx, localSessionWorkloadLabel)
+	if err != nil {
+		return nil, fmt.Errorf("list the workloads running locally: %w", err)
+	}
+	sessions := make([]*localSession, 0, len(containers))
+	for _, ctr := range containers {
+		session := &localSession{
+			app:        ctr.Labels[localSessionAppLabel],
+			env:        ctr.Labels[localSessionEnvLabel],
+			workload:   ctr.Labels[localSessionWorkloadLabel],
+			container:  ctr.Name,
+			ports:      make(map[string]string),
+			runningFor: ctr.RunningFor,
+		}
+		for _, mapping := range strings.Fields(ctr.Labels[localSessionPortsLabel]) {
+			if host, port, ok := strings.Cut(mapping, ":"); ok {
+				session.ports[host] = port
+			}
+		}
+		sessions = append(sessions, session)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		a, b := sessions[i], sessions[j]
+		if a.app != b.app {
+			return a.app < b.app
+		}
+		if a.env != b.env {
+			return a.env < b.env
+		}
+		return a.workload < b.workload
+	})
+	return sessions, nil
+}
+
+type listRunLocalVars struct {
+	shouldOutputJSON bool
+}
+
+type listRunLocalOpts struct {
+	listRunLocalVars
+
+	lister runningContainerLister
+	w      io.Writer
+}
+
+func newListRunLocalOpts(vars listRunLocalVars) *listRunLocalOpts {
+	return &listRunLocalOpts{
+		listRunLocalVars: vars,
+		lister:           dockerengine.New(exec.NewCmd()),
+		w:                os.Stdout,
+	}
+}
+
+// Execute lists the workloads running locally.
+func (o *listRunLocalOpts) Execute() error {
+	sessions, err := listLocalSessions(context.Background(), o.lister)
+	if err != nil {
+		return err
+	}
+	if o.shouldOutputJSON {
+		return o.writeJSON(sessions)
+	}
+	o.writeHuman(sessions)
+	return nil
+}
+
+func (o *listRunLocalOpts) writeHuman(sessions []*localSession) {
+	if len(sessions) == 0 {
+		fmt.Fprintln(o.w, "No workloads are running locally.")
+		return
+	}
+	tw := tabwriter.NewWriter(o.w, 4, 4, 2, ' ', 0)
+	headers := []string{"Application", "Environment", "Workload", "Ports", "Started"}
+	fmt.Fprintf(tw, "%s\n", strings.Join(headers, "\t"))
+	separators := make([]string, len(headers))
+	for i, header := range headers {
+		separators[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(tw, "%s\n", strings.Join(separators, "\t"))
+	for _, session := range sessions {
+		var ports []string
+		for _, host := range session.hostPorts() {
+			ports = append(ports, fmt.Sprintf("localhost:%s->%s", host, session.ports[host]))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", session.app, session.env, session.workload, strings.Join(ports, ", "), session.runningFor)
+	}
+	tw.Flush()
+}
+
+func (o *listRunLocalOpts) writeJSON(sessions []*localSession) error {
+	type serializedPort struct {
+		Host      string `json:"host"`
+		Container string `json:"container"`
+	}
+	type serializedSession struct {
+		App         string           `json:"app"`
+		Environment string           `json:"environment"`
+		Workload    string           `json:"workload"`
+		Container   string           `json:"container"`
+		Ports       []serializedPort `json:"ports"`
+		Started     string           `json:"started"`
+	}
+	out := struct {
+		Sessions []serializedSession `json:"sessions"`
+	}{
+		Sessions: make([]serializedSession, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		ports := make([]serializedPort, 0, len(session.ports))
+		for _, host := range session.hostPorts() {
+			ports = append(ports, serializedPort{
+				Host:      host,
+				Container: session.ports[host],
+			})
+		}
+		out.Sessions = append(out.Sessions, serializedSession{
+			App:         session.app,
+			Environment: session.env,
+			Workload:    session.workload,
+			Container:   session.container,
+			Ports:       ports,
+			Started:     session.runningFor,
+		})
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("marshal local sessions: %w", err)
+	}
+	fmt.Fprintf(o.w, "%s\n", data)
+	return nil
+}
+
+// buildRunLocalListCmd builds the command for listing the workloads running locally.
+func buildRunLocalListCmd() *cobra.Command {
+	vars := listRunLocalVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: `Lists the workloads running locally with "copilot run local".`,
+		Example: `
+  Lists the workloads running locally across applications and environments, with the ports of the host they publish.
+  /code $ copilot run local ls`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return newListRunLocalOpts(vars).Execute()
+		}),
+	}
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,109 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRunLocalOpts_Execute(t *testing.T) {
+	containers := []dockerengine.RunningContainer{
+		{
+			Name: "pause-app-test-web",
+			Labels: map[string]string{
+				localSessionAppLabel:      "app",
+				localSessionEnvLabel:      "test",
+				localSessionWorkloadLabel: "web",
+				localSessionPortsLabel:    "8081:80",
+			},
+			RunningFor: "2 minutes ago",
+		},
+		{
+			Name: "pause-app-test-api",
+			Labels: map[string]string{
+				localSessionAppLabel:      "app",
+				localSessionEnvLabel:      "test",
+				localSessionWorkloadLabel: "api",
+				localSessionPortsLabel:    "24224:24224 8080:8080",
+			},
+			RunningFor: "5 minutes ago",
+		},
+	}
+	tests := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(m *mocks.MockdockerEngineRunner)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"error if the running containers can't be listed": {
+			setupMocks: func(m *mocks.MockdockerEngineRunner) {
+				m.EXPECT().RunningContainers(gomock.Any(), localSessionWorkloadLabel).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list the workloads running locally: some error"),
+		},
+		"no workloads are running locally": {
+			setupMocks: func(m *mocks.MockdockerEngineRunner) {
+				m.EXPECT().RunningContainers(gomock.Any(), localSessionWorkloadLabel).Return(nil, nil)
+			},
+			wantedContent: "No workloads are running locally.\n",
+		},
+		"human output": {
+			setupMocks: func(m *mocks.MockdockerEngineRunner) {
+				m.EXPECT().RunningContainers(gomock.Any(), localSessionWorkloadLabel).Return(containers, nil)
+			},
+			wantedContent: `Application  Environment  Workload  Ports                                         Started
+-----------  -----------  --------  -----                                         -------
+app          test         api       localhost:8080->8080, localhost:24224->24224  5 minutes ago
+app          test         web       localhost:8081->80                            2 minutes ago
+`,
+		},
+		"json output": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockdockerEngineRunner) {
+				m.EXPECT().RunningContainers(gomock.Any(), localSessionWorkloadLabel).Return(containers, nil)
+			},
+			wantedContent: `{"sessions":[{"app":"app","environment":"test","workload":"api","container":"pause-app-test-api","ports":[{"host":"8080","container":"8080"},{"host":"24224","container":"24224"}],"started":"5 minutes ago"},{"app":"app","environment":"test","workload":"web","container":"pause-app-test-web","ports":[{"host":"8081","container":"80"}],"started":"2 minutes ago"}]}
+`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			lister := mocks.NewMockdockerEngineRunner(ctrl)
+			tc.setupMocks(lister)
+			out := &strings.Builder{}
+			opts := &listRunLocalOpts{
+				listRunLocalVars: listRunLocalVars{
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				lister: lister,
+				w:      out,
+			}
+
+			err := opts.Execute()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, out.String())
+		})
+	}
+}
+
+func TestLocalSession_hostPorts(t *testing.T) {
+	session := &localSession{
+		ports: map[string]string{"8080": "80", "443": "443", "10000": "10000", "80": "80"},
+	}
+	require.Equal(t, []string{"80", "443", "8080", "10000"}, session.hostPorts())
+}
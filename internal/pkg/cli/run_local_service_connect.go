@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+)
+
+// serviceConnectSetupScript installs iptables in the pause container, unless its image already has it.
+const serviceConnectSetupScript = `command -v iptables >/dev/null || dnf install -y -q iptables-nft`
+
+// serviceConnectEndpoint is a Service Connect client alias of a service run locally, which the other workloads
+// on the shared network connect to at the same hostname and port as in the ECS cluster.
+type serviceConnectEndpoint struct {
+	host       string // DNS name of the client alias, registered as an alias of the pause container in the network.
+	port       string // Port that the clients connect to.
+	targetPort string // Port of the container that receives the connections.
+}
+
+func (e serviceConnectEndpoint) String() string {
+	return net.JoinHostPort(e.host, e.port)
+}
+
+// serviceConnectEndpoints returns the Service Connect client aliases of the service, with the container ports
+// of the port mappings of the task definition that they target.
+func serviceConnectEndpoints(svc *awsecs.Service, taskDef *awsecs.TaskDefinition) []serviceConnectEndpoint {
+	targetPorts := make(map[string]string) // Name of the port mapping to its container port.
+	for _, ctr := range taskDef.ContainerDefinitions {
+		for _, mapping := range ctr.PortMappings {
+			if name := aws.StringValue(mapping.Name); name != "" && mapping.ContainerPort != nil {
+				targetPorts[name] = strconv.FormatInt(aws.Int64Value(mapping.ContainerPort), 10)
+			}
+		}
+	}
+	var endpoints []serviceConnectEndpoint
+	for _, alias := range svc.ServiceConnectClientAliases() {
+		target, ok := targetPorts[alias.PortName]
+		if !ok {
+			continue
+		}
+		port := target
+		if alias.Port != 0 {
+			port = strconv.FormatInt(alias.Port, 10)
+		}
+		endpoints = append(endpoints, serviceConnectEndpoint{
+			host:       alias.DNSName,
+			port:       port,
+			targetPort: target,
+		})
+	}
+	return endpoints
+}
+
+// serviceConnect returns the Service Connect endpoints of the deployed service, or nil if the workload doesn't use Service Connect.
+func (o *runLocalOpts) serviceConnect(taskDef *awsecs.TaskDefinition) ([]serviceConnectEndpoint, error) {
+	if manifestinfo.IsTypeAJob(o.wkldType) || o.wkldType == manifestinfo.RequestDrivenWebServiceType {
+		return nil, nil
+	}
+	svc, err := o.ecsLocalClient.Service(o.appName, o.envName, o.wkldName)
+	if err != nil {
+		return nil, fmt.Errorf("get service %s: %w", o.wkldName, err)
+	}
+	return serviceConnectEndpoints(svc, taskDef), nil
+}
+
+// serviceConnectRedirects returns the endpoints whose clients connect to a different port than the one of the container.
+// Since the pause container only has one port with each number, the first endpoint of a port wins.
+func serviceConnectRedirects(endpoints []serviceConnectEndpoint) []serviceConnectEndpoint {
+	var redirects []serviceConnectEndpoint
+	seen := make(map[string]bool)
+	for _, endpoint := range endpoints {
+		if endpoint.port == endpoint.targetPort || seen[endpoint.port] {
+			continue
+		}
+		seen[endpoint.port] = true
+		redirects = append(redirects, endpoint)
+	}
+	return redirects
+}
+
+// setUpServiceConnect redirects the connections to the port of each client alias to the port of the container that
+// it targets, like the Service Connect proxy of the task. The rule of the OUTPUT chain redirects the connections of
+// the containers of the workload to its own aliases, and the one of the PREROUTING chain those of the other workloads.
+func (o *runLocalOpts) setUpServiceConnect(ctx context.Context, redirects []serviceConnectEndpoint) error {
+	if len(redirects) == 0 {
+		return nil
+	}
+	pauseCtr := fmt.Sprintf("%s-%s", pauseContainerName, o.containerSuffix)
+	out := &bytes.Buffer{}
+	if err := o.dockerEngine.Exec(ctx, pauseCtr, out, "/bin/bash", "-c", serviceConnectSetupScript); err != nil {
+		return fmt.Errorf("install iptables: %w", withCommandOutput(err, out))
+	}
+	for _, endpoint := range redirects {
+		for _, chain := range [][]string{
+			{"PREROUTING"},
+			{"OUTPUT", "-m", "addrtype", "--dst-type", "LOCAL"},
+		} {
+			out.Reset()
+			args := append([]string{"-t", "nat", "-A"}, chain...)
+			args = append(args, "-p", "tcp", "--dport", endpoint.port, "-j", "REDIRECT", "--to-ports", endpoint.targetPort)
+			if err := o.dockerEngine.Exec(ctx, pauseCtr, out, "iptables", args...); err != nil {
+				return fmt.Errorf("redirect connections to %s: %w", endpoint, withCommandOutput(err, out))
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func testServiceConnectService() *awsecs.Service {
+	return &awsecs.Service{
+		Deployments: []*sdkecs.Deployment{
+			{
+				ServiceConnectConfiguration: &sdkecs.ServiceConnectConfiguration{
+					Enabled:   aws.Bool(true),
+					Namespace: aws.String("test.my-app.local"),
+					Services: []*sdkecs.ServiceConnectService{
+						{
+							PortName:      aws.String("target"),
+							DiscoveryName: aws.String("api-sc"),
+							ClientAliases: []*sdkecs.ServiceConnectClientAlias{
+								{DnsName: aws.String("api"), Port: aws.Int64(80)},
+								{DnsName: aws.String("backend"), Port: aws.Int64(8080)},
+							},
+						},
+						{
+							PortName: aws.String("admin"),
+						},
+						{
+							PortName: aws.String("unknown"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func testServiceConnectTaskDef() *awsecs.TaskDefinition {
+	return &awsecs.TaskDefinition{
+		ContainerDefinitions: []*sdkecs.ContainerDefinition{
+			{
+				Name: aws.String("api"),
+				PortMappings: []*sdkecs.PortMapping{
+					{Name: aws.String("target"), ContainerPort: aws.Int64(8080)},
+					{Name: aws.String("admin"), ContainerPort: aws.Int64(9000)},
+				},
+			},
+		},
+	}
+}
+
+func TestServiceConnectEndpoints(t *testing.T) {
+	t.Run("no endpoints if service connect isn't enabled", func(t *testing.T) {
+		got := serviceConnectEndpoints(&awsecs.Service{}, testServiceConnectTaskDef())
+
+		require.Empty(t, got)
+	})
+	t.Run("targets the container ports of the port mappings", func(t *testing.T) {
+		got := serviceConnectEndpoints(testServiceConnectService(), testServiceConnectTaskDef())
+
+		require.Equal(t, []serviceConnectEndpoint{
+			{host: "api", port: "80", targetPort: "8080"},
+			{host: "backend", port: "8080", targetPort: "8080"},
+			{host: "admin.test.my-app.local", port: "9000", targetPort: "9000"},
+		}, got)
+	})
+}
+
+func TestServiceConnectRedirects(t *testing.T) {
+	got := serviceConnectRedirects([]serviceConnectEndpoint{
+		{host: "api", port: "80", targetPort: "8080"},
+		{host: "backend", port: "8080", targetPort: "8080"},
+		{host: "admin", port: "80", targetPort: "9000"},
+	})
+
+	require.Equal(t, []serviceConnectEndpoint{{host: "api", port: "80", targetPort: "8080"}}, got)
+}
+
+func TestRunLocalOpts_serviceConnect(t *testing.T) {
+	testCases := map[string]struct {
+		inWkldType string
+		setupMocks func(m *mocks.MockecsLocalClient)
+
+		wanted    []serviceConnectEndpoint
+		wantedErr error
+	}{
+		"jobs don't use service connect": {
+			inWkldType: manifestinfo.ScheduledJobType,
+			setupMocks: func(m *mocks.MockecsLocalClient) {},
+		},
+		"error if fail to get the service": {
+			inWkldType: manifestinfo.BackendServiceType,
+			setupMocks: func(m *mocks.MockecsLocalClient) {
+				m.EXPECT().Service("my-app", "test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get service api: some error"),
+		},
+		"returns the client aliases of the service": {
+			inWkldType: manifestinfo.BackendServiceType,
+			setupMocks: func(m *mocks.MockecsLocalClient) {
+				m.EXPECT().Service("my-app", "test", "api").Return(testServiceConnectService(), nil)
+			},
+			wanted: []serviceConnectEndpoint{
+				{host: "api", port: "80", targetPort: "8080"},
+				{host: "backend", port: "8080", targetPort: "8080"},
+				{host: "admin.test.my-app.local", port: "9000", targetPort: "9000"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := mocks.NewMockecsLocalClient(ctrl)
+			tc.setupMocks(client)
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:  "my-app",
+					envName:  "test",
+					wkldName: "api",
+					wkldType: tc.inWkldType,
+				},
+				ecsLocalClient: client,
+			}
+
+			got, err := opts.serviceConnect(testServiceConnectTaskDef())
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestRunLocalOpts_setUpServiceConnect(t *testing.T) {
+	redirect := serviceConnectEndpoint{host: "api", port: "80", targetPort: "8080"}
+
+	t.Run("nothing to set up without redirects", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		opts := runLocalOpts{
+			dockerEngine: mocks.NewMockdockerEngineRunner(ctrl),
+		}
+
+		err := opts.setUpServiceConnect(context.Background(), nil)
+
+		require.NoError(t, err)
+	})
+	t.Run("redirect the connections to the port of the container", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		dockerEngine := mocks.NewMockdockerEngineRunner(ctrl)
+		opts := runLocalOpts{
+			dockerEngine:    dockerEngine,
+			containerSuffix: "app-env-wkld",
+		}
+		gomock.InOrder(
+			dockerEngine.EXPECT().Exec(gomock.Any(), "pause-app-env-wkld", gomock.Any(), "/bin/bash", "-c", serviceConnectSetupScript).Return(nil),
+			dockerEngine.EXPECT().Exec(gomock.Any(), "pause-app-env-wkld", gomock.Any(), "iptables", "-t", "nat", "-A", "PREROUTING",
+				"-p", "tcp", "--dport", "80", "-j", "REDIRECT", "--to-ports", "8080").Return(nil),
+			dockerEngine.EXPECT().Exec(gomock.Any(), "pause-app-env-wkld", gomock.Any(), "iptables", "-t", "nat", "-A", "OUTPUT",
+				"-m", "addrtype", "--dst-type", "LOCAL", "-p", "tcp", "--dport", "80", "-j", "REDIRECT", "--to-ports", "8080").Return(nil),
+		)
+
+		err := opts.setUpServiceConnect(context.Background(), []serviceConnectEndpoint{redirect})
+
+		require.NoError(t, err)
+	})
+	t.Run("error if fail to add a rule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		dockerEngine := mocks.NewMockdockerEngineRunner(ctrl)
+		opts := runLocalOpts{
+			dockerEngine:    dockerEngine,
+			containerSuffix: "app-env-wkld",
+		}
+		dockerEngine.EXPECT().Exec(gomock.Any(), "pause-app-env-wkld", gomock.Any(), "/bin/bash", "-c", serviceConnectSetupScript).Return(nil)
+		dockerEngine.EXPECT().Exec(gomock.Any(), "pause-app-env-wkld", gomock.Any(), "iptables", gomock.Any()).Return(errors.New("some error"))
+
+		err := opts.setUpServiceConnect(context.Background(), []serviceConnectEndpoint{redirect})
+
+		require.EqualError(t, err, "redirect connections to api:80: some error")
+	})
+}
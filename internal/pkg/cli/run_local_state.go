@@ -0,0 +1,107 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+const localSessionStateFileExt = ".json"
+
+// localSessionsDirName is the directory, relative to the workspace, that stores the state of the workloads running locally.
+var localSessionsDirName = filepath.Join(localRunDirName, "sessions")
+
+// localSessionState is the state of a workload run locally, persisted for as long as it runs,
+// so that its containers can be found and cleaned up if the process that started them exits without stopping them.
+type localSessionState struct {
+	App         string            `json:"app"`
+	Environment string            `json:"environment"`
+	Workload    string            `json:"workload"`
+	PID         int               `json:"pid"`        // Process of "copilot run local" that runs the workload.
+	Containers  []string          `json:"containers"` // Names of the containers, with the pause container last.
+	Network     string            `json:"network,omitempty"`
+	Ports       map[string]string `json:"ports,omitempty"` // Host port to container port.
+	Started     time.Time         `json:"started"`
+}
+
+func (s *localSessionState) String() string {
+	return fmt.Sprintf("%s in environment %s", s.Workload, s.Environment)
+}
+
+func (s *localSessionState) fileName() string {
+	return fmt.Sprintf("%s-%s-%s%s", s.App, s.Environment, s.Workload, localSessionStateFileExt)
+}
+
+// writeLocalSessionState persists the state of a workload running locally under dir, replacing any previous state of the workload.
+func writeLocalSessionState(fs afero.Fs, dir string, state *localSessionState) error {
+	if err := fs.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", dir, err)
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal state of %s: %w", state, err)
+	}
+	path := filepath.Join(dir, state.fileName())
+	if err := afero.WriteFile(fs, path, data, 0644); err != nil {
+		return fmt.Errorf("write state of %s to %s: %w", state, path, err)
+	}
+	return nil
+}
+
+// removeLocalSessionState deletes the persisted state of a workload once it stopped running locally.
+func removeLocalSessionState(fs afero.Fs, dir string, state *localSessionState) error {
+	path := filepath.Join(dir, state.fileName())
+	if err := fs.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove state of %s: %w", state, err)
+	}
+	return nil
+}
+
+// readLocalSessionStates returns the persisted states of the workloads run locally under dir,
+// sorted by application, environment and workload.
+func readLocalSessionStates(fs afero.Fs, dir string) ([]*localSessionState, error) {
+	entries, err := afero.ReadDir(fs, dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read directory %s: %w", dir, err)
+	}
+	var states []*localSessionState
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), localSessionStateFileExt) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := afero.ReadFile(fs, path)
+		if err != nil {
+			return nil, fmt.Errorf("read file %s: %w", path, err)
+		}
+		state := &localSessionState{}
+		if err := json.Unmarshal(data, state); err != nil {
+			return nil, fmt.Errorf("unmarshal local session state %s: %w", path, err)
+		}
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		a, b := states[i], states[j]
+		if a.App != b.App {
+			return a.App < b.App
+		}
+		if a.Environment != b.Environment {
+			return a.Environment < b.Environment
+		}
+		return a.Workload < b.Workload
+	})
+	return states, nil
+}
@@ -0,0 +1,72 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalSessionState(t *testing.T) {
+	const dir = "/ws/.copilot/local/sessions"
+	web := &localSessionState{
+		App:         "app",
+		Environment: "test",
+		Workload:    "web",
+		PID:         1234,
+		Containers:  []string{"web-app-test-web", "pause-app-test-web"},
+		Ports:       map[string]string{"8080": "80"},
+		Started:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	api := &localSessionState{
+		App:         "app",
+		Environment: "test",
+		Workload:    "api",
+		PID:         5678,
+		Containers:  []string{"api-app-test-api", "pause-app-test-api"},
+		Network:     "copilot-app-test",
+		Started:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	t.Run("returns no states if no workload was run locally", func(t *testing.T) {
+		states, err := readLocalSessionStates(afero.NewMemMapFs(), dir)
+
+		require.NoError(t, err)
+		require.Empty(t, states)
+	})
+	t.Run("reads back the written states sorted by workload", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, writeLocalSessionState(fs, dir, web))
+		require.NoError(t, writeLocalSessionState(fs, dir, api))
+		require.NoError(t, afero.WriteFile(fs, dir+"/README", []byte("not a state"), 0644))
+
+		states, err := readLocalSessionStates(fs, dir)
+
+		require.NoError(t, err)
+		require.Equal(t, []*localSessionState{api, web}, states)
+	})
+	t.Run("removes a state", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, writeLocalSessionState(fs, dir, web))
+		require.NoError(t, writeLocalSessionState(fs, dir, api))
+
+		require.NoError(t, removeLocalSessionState(fs, dir, web))
+		require.NoError(t, removeLocalSessionState(fs, dir, web), "removing a missing state should succeed")
+
+		states, err := readLocalSessionStates(fs, dir)
+		require.NoError(t, err)
+		require.Equal(t, []*localSessionState{api}, states)
+	})
+	t.Run("error if a state can't be unmarshaled", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, dir+"/app-test-web.json", []byte("{"), 0644))
+
+		_, err := readLocalSessionStates(fs, dir)
+
+		require.EqualError(t, err, "unmarshal local session state /ws/.copilot/local/sessions/app-test-web.json: unexpected end of JSON input")
+	})
+}
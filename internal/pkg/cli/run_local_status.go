@@ -0,0 +1,224 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	termcolor "github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// Statuses of a workload run locally.
+const (
+	localSessionStatusRunning  = "running"  // The "copilot run local" process that started the workload is running.
+	localSessionStatusOrphaned = "orphaned" // The process exited, but it left containers of the workload behind.
+	localSessionStatusStopped  = "stopped"  // The process exited, and none of the containers of the workload are left.
+)
+
+// localSessionStatus is the status of a workload run locally, with the containers of the workload that still exist.
+type localSessionStatus struct {
+	*localSessionState
+	status     string
+	containers []string
+}
+
+// localSessionStatuses returns the status of the workloads whose state is persisted under dir.
+func localSessionStatuses(ctx context.Context, fs afero.Fs, dir string, docker localContainerInspector, isRunning func(pid int) bool) ([]*localSessionStatus, error) {
+	states, err := readLocalSessionStates(fs, dir)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*localSessionStatus, 0, len(states))
+	for _, state := range states {
+		status := &localSessionStatus{
+			localSessionState: state,
+		}
+		for _, name := range state.Containers {
+			_, err := docker.ContainerState(ctx, name)
+			var errNotExist *dockerengine.ErrContainerNotExist
+			switch {
+			case errors.As(err, &errNotExist):
+				continue
+			case err != nil:
+				return nil, fmt.Errorf("get state of container %q of %s: %w", name, state, err)
+			}
+			status.containers = append(status.containers, name)
+		}
+		switch {
+		case isRunning(state.PID):
+			status.status = localSessionStatusRunning
+		case len(status.containers) > 0:
+			status.status = localSessionStatusOrphaned
+		default:
+			status.status = localSessionStatusStopped
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+type statusRunLocalVars struct {
+	shouldOutputJSON bool
+}
+
+type statusRunLocalOpts struct {
+	statusRunLocalVars
+
+	ws               workspacePathGetter
+	fs               afero.Fs
+	docker           localContainerInspector
+	isProcessRunning func(pid int) bool
+	now              func() time.Time
+	w                io.Writer
+}
+
+func newStatusRunLocalOpts(vars statusRunLocalVars) (*statusRunLocalOpts, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+	return &statusRunLocalOpts{
+		statusRunLocalVars: vars,
+		ws:                 ws,
+		fs:                 fs,
+		docker:             dockerengine.New(exec.NewCmd()),
+		isProcessRunning:   isProcessRunning,
+		now:                time.Now,
+		w:                  os.Stdout,
+	}, nil
+}
+
+// Execute shows the status of the workloads run locally from the workspace, including the ones left behind
+// by a "copilot run local" process that exited without stopping them.
+func (o *statusRunLocalOpts) Execute() error {
+	statuses, err := localSessionStatuses(context.Background(), o.fs, filepath.Join(o.ws.Path(), localSessionsDirName), o.docker, o.isProcessRunning)
+	if err != nil {
+		return err
+	}
+	if o.shouldOutputJSON {
+		return o.writeJSON(statuses)
+	}
+	o.writeHuman(statuses)
+	return nil
+}
+
+func (o *statusRunLocalOpts) writeHuman(statuses []*localSessionStatus) {
+	if len(statuses) == 0 {
+		fmt.Fprintln(o.w, "No workloads were run locally from this workspace.")
+		return
+	}
+	tw := tabwriter.NewWriter(o.w, 4, 4, 2, ' ', 0)
+	headers := []string{"Application", "Environment", "Workload", "Status", "Containers", "Ports", "Started"}
+	fmt.Fprintf(tw, "%s\n", strings.Join(headers, "\t"))
+	separators := make([]string, len(headers))
+	for i, header := range headers {
+		separators[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(tw, "%s\n", strings.Join(separators, "\t"))
+	var hasOrphans bool
+	for _, status := range statuses {
+		var ports []string
+		for _, host := range sortedHostPorts(status.Ports) {
+			ports = append(ports, fmt.Sprintf("localhost:%s->%s", host, status.Ports[host]))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d/%d\t%s\t%s\n", status.App, status.Environment, status.Workload, status.status,
+			len(status.containers), len(status.Containers), strings.Join(ports, ", "), humanize.RelTime(status.Started, o.now(), "ago", "from now"))
+		if status.status != localSessionStatusRunning {
+			hasOrphans = true
+		}
+	}
+	tw.Flush()
+	if hasOrphans {
+		log.Infof("\nRun %s to clean up the workloads that are no longer running.\n", termcolor.HighlightCode("copilot run local stop"))
+	}
+}
+
+func (o *statusRunLocalOpts) writeJSON(statuses []*localSessionStatus) error {
+	type serializedPort struct {
+		Host      string `json:"host"`
+		Container string `json:"container"`
+	}
+	type serializedStatus struct {
+		App         string           `json:"app"`
+		Environment string           `json:"environment"`
+		Workload    string           `json:"workload"`
+		Status      string           `json:"status"`
+		PID         int              `json:"pid"`
+		Containers  []string         `json:"containers"`
+		Network     string           `json:"network,omitempty"`
+		Ports       []serializedPort `json:"ports"`
+		Started     time.Time        `json:"started"`
+	}
+	out := struct {
+		Sessions []serializedStatus `json:"sessions"`
+	}{
+		Sessions: make([]serializedStatus, 0, len(statuses)),
+	}
+	for _, status := range statuses {
+		ports := make([]serializedPort, 0, len(status.Ports))
+		for _, host := range sortedHostPorts(status.Ports) {
+			ports = append(ports, serializedPort{
+				Host:      host,
+				Container: status.Ports[host],
+			})
+		}
+		out.Sessions = append(out.Sessions, serializedStatus{
+			App:         status.App,
+			Environment: status.Environment,
+			Workload:    status.Workload,
+			Status:      status.status,
+			PID:         status.PID,
+			Containers:  append([]string{}, status.containers...),
+			Network:     status.Network,
+			Ports:       ports,
+			Started:     status.Started,
+		})
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("marshal local session statuses: %w", err)
+	}
+	fmt.Fprintf(o.w, "%s\n", data)
+	return nil
+}
+
+// buildRunLocalStatusCmd builds the command for showing the status of the workloads run locally from the workspace.
+func buildRunLocalStatusCmd() *cobra.Command {
+	vars := statusRunLocalVars{}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: `Shows the status of the workloads run locally with "copilot run local" from the workspace.`,
+		Long: `Shows the status of the workloads run locally with "copilot run local" from the workspace.
+A workload is "orphaned" if the command that ran it exited without removing its containers.`,
+		Example: `
+  Shows whether the workloads run locally are still running, and the containers they left behind.
+  /code $ copilot run local status`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newStatusRunLocalOpts(vars)
+			if err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,142 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusRunLocalOpts_Execute(t *testing.T) {
+	const dir = "/ws/.copilot/local/sessions"
+	started := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	states := []*localSessionState{
+		{
+			App:         "app",
+			Environment: "test",
+			Workload:    "api",
+			PID:         1,
+			Containers:  []string{"api-app-test-api", "pause-app-test-api"},
+			Ports:       map[string]string{"8080": "8080"},
+			Started:     started,
+		},
+		{
+			App:         "app",
+			Environment: "test",
+			Workload:    "web",
+			PID:         2,
+			Containers:  []string{"web-app-test-web", "pause-app-test-web"},
+			Network:     "copilot-app-test",
+			Ports:       map[string]string{"8081": "80"},
+			Started:     started,
+		},
+		{
+			App:         "app",
+			Environment: "test",
+			Workload:    "worker",
+			PID:         3,
+			Containers:  []string{"worker-app-test-worker", "pause-app-test-worker"},
+			Started:     started,
+		},
+	}
+	exists := func(m *mocks.MocklocalContainerInspector, names ...string) {
+		for _, name := range names {
+			m.EXPECT().ContainerState(gomock.Any(), name).Return(dockerengine.ContainerState{Status: "running"}, nil)
+		}
+	}
+	notExists := func(m *mocks.MocklocalContainerInspector, names ...string) {
+		for _, name := range names {
+			m.EXPECT().ContainerState(gomock.Any(), name).Return(dockerengine.ContainerState{}, &dockerengine.ErrContainerNotExist{Name: name})
+		}
+	}
+	tests := map[string]struct {
+		inStates         []*localSessionState
+		shouldOutputJSON bool
+		setupMocks       func(m *mocks.MocklocalContainerInspector)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"no workloads were run locally": {
+			setupMocks:    func(m *mocks.MocklocalContainerInspector) {},
+			wantedContent: "No workloads were run locally from this workspace.\n",
+		},
+		"error if the state of a container can't be retrieved": {
+			inStates: states[:1],
+			setupMocks: func(m *mocks.MocklocalContainerInspector) {
+				m.EXPECT().ContainerState(gomock.Any(), "api-app-test-api").Return(dockerengine.ContainerState{}, errors.New("some error"))
+			},
+			wantedErr: errors.New(`get state of container "api-app-test-api" of api in environment test: some error`),
+		},
+		"human output": {
+			inStates: states,
+			setupMocks: func(m *mocks.MocklocalContainerInspector) {
+				exists(m, "api-app-test-api", "pause-app-test-api", "pause-app-test-web")
+				notExists(m, "web-app-test-web", "worker-app-test-worker", "pause-app-test-worker")
+			},
+			wantedContent: `Application  Environment  Workload  Status    Containers  Ports                 Started
+-----------  -----------  --------  ------    ----------  -----                 -------
+app          test         api       running   2/2         localhost:8080->8080  2 hours ago
+app          test         web       orphaned  1/2         localhost:8081->80    2 hours ago
+app          test         worker    stopped   0/2                               2 hours ago
+`,
+		},
+		"json output": {
+			inStates:         states[:2],
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MocklocalContainerInspector) {
+				exists(m, "api-app-test-api", "pause-app-test-api", "pause-app-test-web")
+				notExists(m, "web-app-test-web")
+			},
+			wantedContent: `{"sessions":[{"app":"app","environment":"test","workload":"api","status":"running","pid":1,"containers":["api-app-test-api","pause-app-test-api"],"ports":[{"host":"8080","container":"8080"}],"started":"2024-01-02T03:04:05Z"},{"app":"app","environment":"test","workload":"web","status":"orphaned","pid":2,"containers":["pause-app-test-web"],"network":"copilot-app-test","ports":[{"host":"8081","container":"80"}],"started":"2024-01-02T03:04:05Z"}]}
+`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			docker := mocks.NewMocklocalContainerInspector(ctrl)
+			tc.setupMocks(docker)
+			ws := mocks.NewMockworkspacePathGetter(ctrl)
+			ws.EXPECT().Path().Return("/ws")
+			fs := afero.NewMemMapFs()
+			for _, state := range tc.inStates {
+				require.NoError(t, writeLocalSessionState(fs, dir, state))
+			}
+			out := &strings.Builder{}
+			opts := &statusRunLocalOpts{
+				statusRunLocalVars: statusRunLocalVars{
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				ws:     ws,
+				fs:     fs,
+				docker: docker,
+				isProcessRunning: func(pid int) bool {
+					return pid == 1
+				},
+				now: func() time.Time {
+					return started.Add(2 * time.Hour)
+				},
+				w: out,
+			}
+
+			err := opts.Execute()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, out.String())
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type stopRunLocalVars struct {
+	appName  string
+	envName  string
+	wkldName string
+}
+
+type stopRunLocalOpts struct {
+	stopRunLocalVars
+
+	ws               workspacePathGetter
+	fs               afero.Fs
+	docker           localContainerCleaner
+	isProcessRunning func(pid int) bool
+	prog             progress
+}
+
+func newStopRunLocalOpts(vars stopRunLocalVars) (*stopRunLocalOpts, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+	return &stopRunLocalOpts{
+		stopRunLocalVars: vars,
+		ws:               ws,
+		fs:               fs,
+		docker:           dockerengine.New(exec.NewCmd()),
+		isProcessRunning: isProcessRunning,
+		prog:             termprogress.NewSpinner(log.DiagnosticWriter),
+	}, nil
+}
+
+// Execute removes the containers and networks left behind by the workloads run locally from the workspace
+// whose "copilot run local" process is no longer running.
+func (o *stopRunLocalOpts) Execute() error {
+	dir := filepath.Join(o.ws.Path(), localSessionsDirName)
+	statuses, err := localSessionStatuses(context.Background(), o.fs, dir, o.docker, o.isProcessRunning)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	var stopped int
+	removableNetworks := make(map[string]bool)
+	usedNetworks := make(map[string]bool) // Networks of the sessions that are left after the command.
+	for _, status := range statuses {
+		if !o.matches(status.localSessionState) {
+			usedNetworks[status.Network] = true
+			continue
+		}
+		if status.status == localSessionStatusRunning {
+			log.Warningf("Skipping %s: it is still running in process %d, interrupt the process to stop it.\n", status, status.PID)
+			usedNetworks[status.Network] = true
+			continue
+		}
+		if err := o.cleanUp(dir, status); err != nil {
+			errs = append(errs, fmt.Errorf("clean up %s: %w", status, err))
+			usedNetworks[status.Network] = true
+			continue
+		}
+		removableNetworks[status.Network] = true
+		stopped++
+	}
+	for network := range removableNetworks {
+		if network == "" || usedNetworks[network] {
+			continue
+		}
+		o.prog.Start(fmt.Sprintf("Removing network %q", network))
+		if err := o.docker.RemoveNetwork(network); err != nil {
+			o.prog.Stop(log.Serrorf("Failed to remove network %q\n", network))
+			errs = append(errs, fmt.Errorf("remove network %s: %w", network, err))
+			continue
+		}
+		o.prog.Stop(log.Ssuccessf("Removed network %q\n", network))
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	if stopped == 0 {
+		log.Infoln("There are no orphaned workloads to clean up.")
+	}
+	return nil
+}
+
+func (o *stopRunLocalOpts) matches(state *localSessionState) bool {
+	if o.appName != "" && state.App != o.appName {
+		return false
+	}
+	if o.envName != "" && state.Environment != o.envName {
+		return false
+	}
+	return o.wkldName == "" || state.Workload == o.wkldName
+}
+
+// cleanUp stops and removes the containers left behind by the session, then deletes its state.
+func (o *stopRunLocalOpts) cleanUp(dir string, status *localSessionStatus) error {
+	for _, name := range status.containers {
+		o.prog.Start(fmt.Sprintf("Stopping %q", name))
+		if err := o.docker.Stop(name); err != nil {
+			o.prog.Stop(log.Serrorf("Failed to stop %q\n", name))
+			return fmt.Errorf("stop %q: %w", name, err)
+		}
+		o.prog.Start(fmt.Sprintf("Removing %q", name))
+		if err := o.docker.Rm(name); err != nil {
+			o.prog.Stop(log.Serrorf("Failed to remove %q\n", name))
+			return fmt.Errorf("rm %q: %w", name, err)
+		}
+		o.prog.Stop(log.Ssuccessf("Cleaned up %q\n", name))
+	}
+	return removeLocalSessionState(o.fs, dir, status.localSessionState)
+}
+
+// buildRunLocalStopCmd builds the command for cleaning up the workloads left behind by "copilot run local".
+func buildRunLocalStopCmd() *cobra.Command {
+	vars := stopRunLocalVars{}
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: `Cleans up the workloads left behind by "copilot run local" in the workspace.`,
+		Long: `Cleans up the workloads left behind by "copilot run local" in the workspace.
+Stops and removes the containers and networks of the workloads whose "copilot run local" command is no longer running.`,
+		Example: `
+  Cleans up all the orphaned workloads across environments.
+  /code $ copilot run local stop
+  Cleans up the "frontend" service if it was left behind.
+  /code $ copilot run local stop -n frontend`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newStopRunLocalOpts(vars)
+			if err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.wkldName, nameFlag, nameFlagShort, "", workloadFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,168 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopRunLocalOpts_Execute(t *testing.T) {
+	const dir = "/ws/.copilot/local/sessions"
+	newStates := func() []*localSessionState {
+		return []*localSessionState{
+			{
+				App:         "app",
+				Environment: "test",
+				Workload:    "api",
+				PID:         1,
+				Containers:  []string{"api-app-test-api", "pause-app-test-api"},
+				Network:     "copilot-app-test",
+			},
+			{
+				App:         "app",
+				Environment: "test",
+				Workload:    "web",
+				PID:         2,
+				Containers:  []string{"web-app-test-web", "pause-app-test-web"},
+				Network:     "copilot-app-test",
+			},
+			{
+				App:         "app",
+				Environment: "test",
+				Workload:    "worker",
+				PID:         3,
+				Containers:  []string{"worker-app-test-worker", "pause-app-test-worker"},
+				Network:     "copilot-app-test",
+			},
+		}
+	}
+	exists := func(m *mocks.MocklocalContainerCleaner, names ...string) {
+		for _, name := range names {
+			m.EXPECT().ContainerState(gomock.Any(), name).Return(dockerengine.ContainerState{Status: "exited"}, nil)
+		}
+	}
+	notExists := func(m *mocks.MocklocalContainerCleaner, names ...string) {
+		for _, name := range names {
+			m.EXPECT().ContainerState(gomock.Any(), name).Return(dockerengine.ContainerState{}, &dockerengine.ErrContainerNotExist{Name: name})
+		}
+	}
+	tests := map[string]struct {
+		inVars     stopRunLocalVars
+		inStates   []*localSessionState
+		inRunning  map[int]bool
+		setupMocks func(m *mocks.MocklocalContainerCleaner)
+
+		wantedStates []string // Workloads whose state is left.
+		wantedErr    error
+	}{
+		"nothing to clean up": {
+			setupMocks: func(m *mocks.MocklocalContainerCleaner) {},
+		},
+		"keeps the network used by a running workload": {
+			inStates:  newStates(),
+			inRunning: map[int]bool{1: true},
+			setupMocks: func(m *mocks.MocklocalContainerCleaner) {
+				exists(m, "api-app-test-api", "pause-app-test-api", "pause-app-test-web")
+				notExists(m, "web-app-test-web", "worker-app-test-worker", "pause-app-test-worker")
+				m.EXPECT().Stop("pause-app-test-web").Return(nil)
+				m.EXPECT().Rm("pause-app-test-web").Return(nil)
+			},
+			wantedStates: []string{"api"},
+		},
+		"removes the network once none of its workloads are left": {
+			inStates: newStates(),
+			setupMocks: func(m *mocks.MocklocalContainerCleaner) {
+				exists(m, "api-app-test-api", "pause-app-test-api")
+				notExists(m, "web-app-test-web", "pause-app-test-web", "worker-app-test-worker", "pause-app-test-worker")
+				m.EXPECT().Stop("api-app-test-api").Return(nil)
+				m.EXPECT().Rm("api-app-test-api").Return(nil)
+				m.EXPECT().Stop("pause-app-test-api").Return(nil)
+				m.EXPECT().Rm("pause-app-test-api").Return(nil)
+				m.EXPECT().RemoveNetwork("copilot-app-test").Return(nil)
+			},
+		},
+		"only cleans up the workload passed by flag": {
+			inVars: stopRunLocalVars{
+				wkldName: "web",
+			},
+			inStates: newStates(),
+			setupMocks: func(m *mocks.MocklocalContainerCleaner) {
+				notExists(m, "api-app-test-api", "pause-app-test-api", "worker-app-test-worker", "pause-app-test-worker")
+				exists(m, "web-app-test-web", "pause-app-test-web")
+				m.EXPECT().Stop("web-app-test-web").Return(nil)
+				m.EXPECT().Rm("web-app-test-web").Return(nil)
+				m.EXPECT().Stop("pause-app-test-web").Return(nil)
+				m.EXPECT().Rm("pause-app-test-web").Return(nil)
+			},
+			wantedStates: []string{"api", "worker"},
+		},
+		"keeps the state of a workload whose containers can't be removed": {
+			inStates: newStates()[:1],
+			setupMocks: func(m *mocks.MocklocalContainerCleaner) {
+				exists(m, "api-app-test-api")
+				notExists(m, "pause-app-test-api")
+				m.EXPECT().Stop("api-app-test-api").Return(nil)
+				m.EXPECT().Rm("api-app-test-api").Return(errors.New("some error"))
+			},
+			wantedStates: []string{"api"},
+			wantedErr:    errors.New(`clean up api in environment test: rm "api-app-test-api": some error`),
+		},
+		"error if the network can't be removed": {
+			inStates: newStates()[:1],
+			setupMocks: func(m *mocks.MocklocalContainerCleaner) {
+				notExists(m, "api-app-test-api", "pause-app-test-api")
+				m.EXPECT().RemoveNetwork("copilot-app-test").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("remove network copilot-app-test: some error"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			docker := mocks.NewMocklocalContainerCleaner(ctrl)
+			tc.setupMocks(docker)
+			prog := mocks.NewMockprogress(ctrl)
+			prog.EXPECT().Start(gomock.Any()).AnyTimes()
+			prog.EXPECT().Stop(gomock.Any()).AnyTimes()
+			ws := mocks.NewMockworkspacePathGetter(ctrl)
+			ws.EXPECT().Path().Return("/ws")
+			fs := afero.NewMemMapFs()
+			for _, state := range tc.inStates {
+				require.NoError(t, writeLocalSessionState(fs, dir, state))
+			}
+			opts := &stopRunLocalOpts{
+				stopRunLocalVars: tc.inVars,
+				ws:               ws,
+				fs:               fs,
+				docker:           docker,
+				isProcessRunning: func(pid int) bool {
+					return tc.inRunning[pid]
+				},
+				prog: prog,
+			}
+
+			err := opts.Execute()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			states, err := readLocalSessionStates(fs, dir)
+			require.NoError(t, err)
+			var left []string
+			for _, state := range states {
+				left = append(left, state.Workload)
+			}
+			require.Equal(t, tc.wantedStates, left)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"time"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+const defaultSandboxTTL = 72 * time.Hour
+
+// isSandbox returns true if the environment was created by "copilot sandbox up".
+func isSandbox(env *config.Environment) bool {
+	return env.ExpiresAt != nil
+}
+
+// BuildSandboxCmd is the top level command for sandboxes.
+func BuildSandboxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "sandbox",
+		Short: `Commands for sandboxes.
+Sandboxes are short-lived personal environments to try out your services.`,
+		Long: `Commands for sandboxes.
+Sandboxes are short-lived personal environments to try out your services.`,
+	}
+
+	cmd.AddCommand(buildSandboxUpCmd())
+	cmd.AddCommand(buildSandboxDownCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,296 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/spf13/cobra"
+)
+
+const (
+	sandboxDownNamePrompt     = "Which sandbox would you like to delete?"
+	fmtSandboxDownConfirm     = "Are you sure you want to delete sandbox %q and the workloads deployed to it?"
+	fmtSandboxDownExpiredHelp = "These sandboxes will be deleted with the workloads deployed to them: %s."
+	fmtSandboxDownExpired     = "Are you sure you want to delete %d expired sandboxes?"
+)
+
+var errSandboxDownCancelled = errors.New("sandbox down cancelled - no changes made")
+
+type sandboxDownVars struct {
+	appName          string
+	name             string
+	expired          bool
+	skipConfirmation bool
+}
+
+type sandboxDownOpts struct {
+	sandboxDownVars
+
+	store       store
+	deployStore deployedEnvironmentLister
+	taskStacks  taskStackLister
+	prompt      prompter
+	now         func() time.Time
+	svcDeleter  func(o *sandboxDownOpts, svc, env string) (executor, error)
+	jobDeleter  func(o *sandboxDownOpts, job, env string) (executor, error)
+	taskDeleter func(o *sandboxDownOpts, task, env string) (executor, error)
+	envDeleter  func(o *sandboxDownOpts, env string) (executeAsker, error)
+
+	// Cached variables.
+	sandboxes []string // Names of the sandboxes to delete.
+}
+
+func newSandboxDownOpts(vars sandboxDownVars) (*sandboxDownOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("sandbox down"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &sandboxDownOpts{
+		sandboxDownVars: vars,
+		store:           store,
+		deployStore:     deployStore,
+		taskStacks:      cloudformation.New(defaultSess, cloudformation.WithProgressTracker(os.Stderr)),
+		prompt:          prompt.New(),
+		now:             time.Now,
+		svcDeleter: func(o *sandboxDownOpts, svc, env string) (executor, error) {
+			// The whole sandbox is deleted, so services that other workloads depend on are deleted too.
+			return newDeleteSvcOpts(deleteSvcVars{
+				appName:          o.appName,
+				name:             svc,
+				envName:          env,
+				skipConfirmation: true,
+				force:            true,
+			}, sessProvider)
+		},
+		jobDeleter: func(o *sandboxDownOpts, job, env string) (executor, error) {
+			return newDeleteJobOpts(deleteJobVars{
+				appName:          o.appName,
+				name:             job,
+				envName:          env,
+				skipConfirmation: true,
+			}, sessProvider)
+		},
+		taskDeleter: func(o *sandboxDownOpts, task, env string) (executor, error) {
+			return newDeleteTaskOpts(deleteTaskVars{
+				app:              o.appName,
+				env:              env,
+				name:             task,
+				skipConfirmation: true,
+			})
+		},
+		envDeleter: func(o *sandboxDownOpts, env string) (executeAsker, error) {
+			return newDeleteEnvOpts(deleteEnvVars{
+				appName:          o.appName,
+				name:             env,
+				skipConfirmation: true,
+			}, sessProvider)
+		},
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *sandboxDownOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name == "" {
+		return nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	if !isSandbox(env) {
+		return fmt.Errorf("environment %s is not a sandbox, run %s to delete it", o.name,
+			color.HighlightCode(fmt.Sprintf("copilot env delete --name %s", o.name)))
+	}
+	return nil
+}
+
+// Ask prompts for the sandbox to delete if it's not passed in, and confirms the deletion.
+func (o *sandboxDownOpts) Ask() error {
+	if o.expired {
+		return o.askExpired()
+	}
+	if o.name == "" {
+		sandboxes, err := o.listSandboxes(func(*config.Environment) bool { return true })
+		if err != nil {
+			return err
+		}
+		if len(sandboxes) == 0 {
+			return fmt.Errorf("no sandboxes found in application %s", o.appName)
+		}
+		name, err := o.prompt.SelectOne(sandboxDownNamePrompt, "", sandboxes, prompt.WithFinalMessage("Sandbox:"))
+		if err != nil {
+			return fmt.Errorf("select sandbox: %w", err)
+		}
+		o.name = name
+	}
+	o.sandboxes = []string{o.name}
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSandboxDownConfirm, o.name), "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm deletion of sandbox %s: %w", o.name, err)
+	}
+	if !confirmed {
+		return errSandboxDownCancelled
+	}
+	return nil
+}
+
+func (o *sandboxDownOpts) askExpired() error {
+	sandboxes, err := o.listSandboxes(func(env *config.Environment) bool {
+		return env.ExpiresAt.Before(o.now())
+	})
+	if err != nil {
+		return err
+	}
+	o.sandboxes = sandboxes
+	if len(sandboxes) == 0 || o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSandboxDownExpired, len(sandboxes)),
+		fmt.Sprintf(fmtSandboxDownExpiredHelp, strings.Join(sandboxes, ", ")), prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm deletion of expired sandboxes: %w", err)
+	}
+	if !confirmed {
+		return errSandboxDownCancelled
+	}
+	return nil
+}
+
+// Execute deletes the workloads deployed to each sandbox, then the sandbox environment.
+func (o *sandboxDownOpts) Execute() error {
+	if len(o.sandboxes) == 0 {
+		log.Infof("No expired sandboxes in application %s.\n", o.appName)
+		return nil
+	}
+	for _, sandbox := range o.sandboxes {
+		if err := o.deleteSandbox(sandbox); err != nil {
+			return err
+		}
+		log.Successf("Deleted sandbox %s.\n", color.HighlightUserInput(sandbox))
+	}
+	return nil
+}
+
+func (o *sandboxDownOpts) listSandboxes(filter func(*config.Environment) bool) ([]string, error) {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	var sandboxes []string
+	for _, env := range envs {
+		if isSandbox(env) && filter(env) {
+			sandboxes = append(sandboxes, env.Name)
+		}
+	}
+	return sandboxes, nil
+}
+
+func (o *sandboxDownOpts) deleteSandbox(env string) error {
+	svcs, err := o.deployStore.ListDeployedServices(o.appName, env)
+	if err != nil {
+		return fmt.Errorf("list services deployed to sandbox %s: %w", env, err)
+	}
+	for _, svc := range svcs {
+		cmd, err := o.svcDeleter(o, svc, env)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Execute(); err != nil {
+			return fmt.Errorf("delete service %s from sandbox %s: %w", svc, env, err)
+		}
+	}
+	jobs, err := o.deployStore.ListDeployedJobs(o.appName, env)
+	if err != nil {
+		return fmt.Errorf("list jobs deployed to sandbox %s: %w", env, err)
+	}
+	for _, job := range jobs {
+		cmd, err := o.jobDeleter(o, job, env)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Execute(); err != nil {
+			return fmt.Errorf("delete job %s from sandbox %s: %w", job, env, err)
+		}
+	}
+	tasks, err := o.taskStacks.ListTaskStacks(o.appName, env)
+	if err != nil {
+		return fmt.Errorf("list tasks in sandbox %s: %w", env, err)
+	}
+	for _, task := range tasks {
+		cmd, err := o.taskDeleter(o, task.TaskName(), env)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Execute(); err != nil {
+			return fmt.Errorf("delete task %s from sandbox %s: %w", task.TaskName(), env, err)
+		}
+	}
+	cmd, err := o.envDeleter(o, env)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Ask(); err != nil {
+		return fmt.Errorf("ask env delete: %w", err)
+	}
+	if err := cmd.Execute(); err != nil {
+		return fmt.Errorf("delete sandbox %s: %w", env, err)
+	}
+	return nil
+}
+
+// buildSandboxDownCmd builds the command to delete a sandbox and the workloads deployed to it.
+func buildSandboxDownCmd() *cobra.Command {
+	vars := sandboxDownVars{}
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Deletes a sandbox and the services and jobs deployed to it.",
+		Long:  "Deletes a sandbox and the services and jobs deployed to it.",
+		Example: `
+  Delete the "sandbox-jane" sandbox.
+  /code $ copilot sandbox down --name sandbox-jane
+
+  Delete every expired sandbox of the application without prompting, for example from a scheduled job.
+  /code $ copilot sandbox down --expired --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSandboxDownOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", sandboxNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.expired, expiredFlag, false, sandboxExpiredFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(nameFlag, expiredFlag)
+	return cmd
+}
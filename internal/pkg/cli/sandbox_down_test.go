@@ -0,0 +1,275 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type sandboxDownMocks struct {
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedEnvironmentLister
+	taskStacks  *mocks.MocktaskStackLister
+	prompt      *mocks.Mockprompter
+	svcDeleter  *mocks.Mockexecutor
+	jobDeleter  *mocks.Mockexecutor
+	taskDeleter *mocks.Mockexecutor
+	envDeleter  *mocks.MockexecuteAsker
+}
+
+func TestSandboxDownOpts_Validate(t *testing.T) {
+	expiresAt := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inApp      string
+		inName     string
+		setupMocks func(m *sandboxDownMocks)
+
+		wantedErr error
+	}{
+		"error if no app in the workspace": {
+			wantedErr: errNoAppInWorkspace,
+		},
+		"error if the environment isn't a sandbox": {
+			inApp:  "phonetool",
+			inName: "test",
+			setupMocks: func(m *sandboxDownMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+			wantedErr: errors.New("environment test is not a sandbox, run `copilot env delete --name test` to delete it"),
+		},
+		"success": {
+			inApp:  "phonetool",
+			inName: "sandbox-jane",
+			setupMocks: func(m *sandboxDownMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "sandbox-jane").Return(&config.Environment{
+					Name:      "sandbox-jane",
+					ExpiresAt: &expiresAt,
+				}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &sandboxDownMocks{
+				store: mocks.NewMockstore(ctrl),
+			}
+			if tc.setupMocks != nil {
+				tc.setupMocks(m)
+			}
+			opts := &sandboxDownOpts{
+				sandboxDownVars: sandboxDownVars{
+					appName: tc.inApp,
+					name:    tc.inName,
+				},
+				store: m.store,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSandboxDownOpts_Ask(t *testing.T) {
+	now := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	expired, active := now.Add(-time.Hour), now.Add(time.Hour)
+	envs := []*config.Environment{
+		{Name: "test"},
+		{Name: "sandbox-jane", ExpiresAt: &expired},
+		{Name: "sandbox-john", ExpiresAt: &active},
+	}
+	testCases := map[string]struct {
+		inName             string
+		inExpired          bool
+		inSkipConfirmation bool
+		setupMocks         func(m *sandboxDownMocks)
+
+		wantedSandboxes []string
+		wantedErr       error
+	}{
+		"selects among the sandboxes of the application": {
+			setupMocks: func(m *sandboxDownMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				m.prompt.EXPECT().SelectOne(sandboxDownNamePrompt, "", []string{"sandbox-jane", "sandbox-john"}, gomock.Any()).
+					Return("sandbox-john", nil)
+				m.prompt.EXPECT().Confirm(`Are you sure you want to delete sandbox "sandbox-john" and the workloads deployed to it?`, "", gomock.Any()).
+					Return(true, nil)
+			},
+			wantedSandboxes: []string{"sandbox-john"},
+		},
+		"error if the application has no sandboxes": {
+			setupMocks: func(m *sandboxDownMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(envs[:1], nil)
+			},
+			wantedErr: errors.New("no sandboxes found in application phonetool"),
+		},
+		"error if the deletion isn't confirmed": {
+			inName: "sandbox-jane",
+			setupMocks: func(m *sandboxDownMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedErr: errSandboxDownCancelled,
+		},
+		"only keeps expired sandboxes with --expired": {
+			inExpired: true,
+			setupMocks: func(m *sandboxDownMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				m.prompt.EXPECT().Confirm("Are you sure you want to delete 1 expired sandboxes?",
+					"These sandboxes will be deleted with the workloads deployed to them: sandbox-jane.", gomock.Any()).
+					Return(true, nil)
+			},
+			wantedSandboxes: []string{"sandbox-jane"},
+		},
+		"doesn't confirm with --yes": {
+			inExpired:          true,
+			inSkipConfirmation: true,
+			setupMocks: func(m *sandboxDownMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+			},
+			wantedSandboxes: []string{"sandbox-jane"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &sandboxDownMocks{
+				store:  mocks.NewMockstore(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &sandboxDownOpts{
+				sandboxDownVars: sandboxDownVars{
+					appName:          "phonetool",
+					name:             tc.inName,
+					expired:          tc.inExpired,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:  m.store,
+				prompt: m.prompt,
+				now:    func() time.Time { return now },
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSandboxes, opts.sandboxes)
+		})
+	}
+}
+
+func TestSandboxDownOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inSandboxes []string
+		setupMocks  func(m *sandboxDownMocks)
+
+		wantedErr error
+	}{
+		"nothing to delete": {
+			setupMocks: func(m *sandboxDownMocks) {},
+		},
+		"deletes the workloads and tasks before the sandbox": {
+			inSandboxes: []string{"sandbox-jane"},
+			setupMocks: func(m *sandboxDownMocks) {
+				gomock.InOrder(
+					m.deployStore.EXPECT().ListDeployedServices("phonetool", "sandbox-jane").Return([]string{"api"}, nil),
+					m.svcDeleter.EXPECT().Execute().Return(nil),
+					m.deployStore.EXPECT().ListDeployedJobs("phonetool", "sandbox-jane").Return([]string{"report"}, nil),
+					m.jobDeleter.EXPECT().Execute().Return(nil),
+					m.taskStacks.EXPECT().ListTaskStacks("phonetool", "sandbox-jane").Return([]deploy.TaskStackInfo{
+						{StackName: "task-db-migrate", App: "phonetool", Env: "sandbox-jane"},
+					}, nil),
+					m.taskDeleter.EXPECT().Execute().Return(nil),
+					m.envDeleter.EXPECT().Ask().Return(nil),
+					m.envDeleter.EXPECT().Execute().Return(nil),
+				)
+			},
+		},
+		"error if a service can't be deleted": {
+			inSandboxes: []string{"sandbox-jane"},
+			setupMocks: func(m *sandboxDownMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "sandbox-jane").Return([]string{"api"}, nil)
+				m.svcDeleter.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("delete service api from sandbox sandbox-jane: some error"),
+		},
+		"error if the environment can't be deleted": {
+			inSandboxes: []string{"sandbox-jane"},
+			setupMocks: func(m *sandboxDownMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "sandbox-jane").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "sandbox-jane").Return(nil, nil)
+				m.taskStacks.EXPECT().ListTaskStacks("phonetool", "sandbox-jane").Return(nil, nil)
+				m.envDeleter.EXPECT().Ask().Return(nil)
+				m.envDeleter.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("delete sandbox sandbox-jane: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &sandboxDownMocks{
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				taskStacks:  mocks.NewMocktaskStackLister(ctrl),
+				svcDeleter:  mocks.NewMockexecutor(ctrl),
+				jobDeleter:  mocks.NewMockexecutor(ctrl),
+				taskDeleter: mocks.NewMockexecutor(ctrl),
+				envDeleter:  mocks.NewMockexecuteAsker(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &sandboxDownOpts{
+				sandboxDownVars: sandboxDownVars{
+					appName: "phonetool",
+				},
+				deployStore: m.deployStore,
+				taskStacks:  m.taskStacks,
+				svcDeleter: func(o *sandboxDownOpts, svc, env string) (executor, error) {
+					return m.svcDeleter, nil
+				},
+				jobDeleter: func(o *sandboxDownOpts, job, env string) (executor, error) {
+					return m.jobDeleter, nil
+				},
+				taskDeleter: func(o *sandboxDownOpts, task, env string) (executor, error) {
+					require.Equal(t, "db-migrate", task)
+					return m.taskDeleter, nil
+				},
+				envDeleter: func(o *sandboxDownOpts, env string) (executeAsker, error) {
+					return m.envDeleter, nil
+				},
+				sandboxes: tc.inSandboxes,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os/user"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	sandboxUpNamePrompt     = "What would you like to name your sandbox?"
+	sandboxUpNameHelpPrompt = "The name of the environment that is created for the sandbox."
+
+	sandboxUpWorkloadsPrompt     = "Which services and jobs would you like to deploy to the sandbox?"
+	sandboxUpWorkloadsHelpPrompt = "The workloads are deployed to the sandbox one after the other, in the order that they are selected."
+
+	defaultSandboxName = "sandbox"
+)
+
+var invalidSandboxNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+type sandboxUpVars struct {
+	appName   string
+	name      string
+	workloads []string
+	ttl       time.Duration
+}
+
+type sandboxUpOpts struct {
+	sandboxUpVars
+
+	store  store
+	ws     wlLister
+	sel    wsSelector
+	prompt prompter
+	now    func() time.Time
+
+	newInitEnvCmd    func(o *sandboxUpOpts, expiresAt time.Time) (cmd, error)
+	newDeployEnvCmd  func(o *sandboxUpOpts) (cmd, error)
+	newDeployWkldCmd func(o *sandboxUpOpts, wkld *config.Workload) (cmd, error)
+
+	// Cached variables.
+	expiresAt time.Time
+}
+
+func newSandboxUpOpts(vars sandboxUpVars) (*sandboxUpOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("sandbox up"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	prompter := prompt.New()
+	return &sandboxUpOpts{
+		sandboxUpVars: vars,
+		store:         store,
+		ws:            ws,
+		sel:           selector.NewLocalWorkloadSelector(prompter, store, ws, selector.OnlyInitializedWorkloads),
+		prompt:        prompter,
+		now:           time.Now,
+		newInitEnvCmd: func(o *sandboxUpOpts, expiresAt time.Time) (cmd, error) {
+			// Sandboxes use the default configuration, the manifest only sets the profile of the environment.
+			return newInitEnvOpts(initEnvVars{
+				appName:       o.appName,
+				name:          o.name,
+				defaultConfig: true,
+				envProfile:    manifest.EnvProfileSandbox,
+				expiresAt:     &expiresAt,
+			}, sessProvider)
+		},
+		newDeployEnvCmd: func(o *sandboxUpOpts) (cmd, error) {
+			return newEnvDeployOpts(deployEnvVars{
+				appName: o.appName,
+				name:    o.name,
+			}, sessProvider)
+		},
+		newDeployWkldCmd: func(o *sandboxUpOpts, wkld *config.Workload) (cmd, error) {
+			vars := deployWkldVars{
+				appName: o.appName,
+				name:    wkld.Name,
+				envName: o.name,
+			}
+			if contains(wkld.Type, manifestinfo.JobTypes()) {
+				return newJobDeployOpts(vars, sessProvider)
+			}
+			return newSvcDeployOpts(vars, sessProvider)
+		},
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *sandboxUpOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	if o.name != "" {
+		if err := validateEnvironmentName(o.name); err != nil {
+			return err
+		}
+	}
+	if o.ttl <= 0 {
+		return fmt.Errorf("--%s must be greater than 0", ttlFlag)
+	}
+	if len(o.workloads) == 0 {
+		return nil
+	}
+	wsWorkloads, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	for _, wkld := range o.workloads {
+		if !contains(wkld, wsWorkloads) {
+			return fmt.Errorf("workload %s is not in the workspace", wkld)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *sandboxUpOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.prompt.Get(sandboxUpNamePrompt, sandboxUpNameHelpPrompt, validateEnvironmentName,
+			prompt.WithDefaultInput(defaultSandboxNameForUser()), prompt.WithFinalMessage("Sandbox name:"))
+		if err != nil {
+			return fmt.Errorf("get sandbox name: %w", err)
+		}
+		o.name = name
+	}
+	if len(o.workloads) == 0 {
+		workloads, err := o.sel.Workloads(sandboxUpWorkloadsPrompt, sandboxUpWorkloadsHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select services or jobs: %w", err)
+		}
+		o.workloads = workloads
+	}
+	return nil
+}
+
+// Execute creates the sandbox environment if it doesn't exist yet, deploys it, and deploys the workloads to it.
+func (o *sandboxUpOpts) Execute() error {
+	if err := o.warnExpiredSandboxes(); err != nil {
+		return err
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err == nil {
+		if !isSandbox(env) {
+			return fmt.Errorf("environment %s is not a sandbox", o.name)
+		}
+		o.expiresAt = *env.ExpiresAt
+		log.Infof("Sandbox %s already exists, deploying it again.\n", color.HighlightUserInput(o.name))
+	} else {
+		var errNoSuchEnv *config.ErrNoSuchEnvironment
+		if !errors.As(err, &errNoSuchEnv) {
+			return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+		}
+		if err := o.initEnv(); err != nil {
+			return err
+		}
+	}
+	if err := o.deployEnv(); err != nil {
+		return err
+	}
+	for _, name := range o.workloads {
+		if err := o.deployWkld(name); err != nil {
+			return err
+		}
+	}
+	log.Successf("Sandbox %s is up until %s.\n", color.HighlightUserInput(o.name), o.expiresAt.Format(time.RFC1123))
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *sandboxUpOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to delete the sandbox and its workloads once you're done.",
+			color.HighlightCode(fmt.Sprintf("copilot sandbox down --name %s", o.name))),
+	})
+	return nil
+}
+
+func (o *sandboxUpOpts) warnExpiredSandboxes() error {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	var expired []string
+	for _, env := range envs {
+		if isSandbox(env) && env.ExpiresAt.Before(o.now()) {
+			expired = append(expired, env.Name)
+		}
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	log.Warningf("Sandboxes %s have expired, run %s to delete them.\n",
+		strings.Join(expired, ", "), color.HighlightCode("copilot sandbox down --expired"))
+	return nil
+}
+
+func (o *sandboxUpOpts) initEnv() error {
+	o.expiresAt = o.now().Add(o.ttl)
+	cmd, err := o.newInitEnvCmd(o, o.expiresAt)
+	if err != nil {
+		return fmt.Errorf("set up env init command: %w", err)
+	}
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+	if err := cmd.Ask(); err != nil {
+		return err
+	}
+	return cmd.Execute()
+}
+
+func (o *sandboxUpOpts) deployEnv() error {
+	cmd, err := o.newDeployEnvCmd(o)
+	if err != nil {
+		return fmt.Errorf("set up env deploy command: %w", err)
+	}
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+	if err := cmd.Ask(); err != nil {
+		return err
+	}
+	if err := cmd.Execute(); err != nil {
+		var errEmptyChangeSet *awscfn.ErrChangeSetEmpty
+		if !errors.As(err, &errEmptyChangeSet) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *sandboxUpOpts) deployWkld(name string) error {
+	wkld, err := o.store.GetWorkload(o.appName, name)
+	if err != nil {
+		return fmt.Errorf("get workload %s: %w", name, err)
+	}
+	cmd, err := o.newDeployWkldCmd(o, wkld)
+	if err != nil {
+		return fmt.Errorf("set up deploy command for %s: %w", name, err)
+	}
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+	if err := cmd.Ask(); err != nil {
+		return err
+	}
+	if err := cmd.Execute(); err != nil {
+		return fmt.Errorf("deploy %s to sandbox %s: %w", name, o.name, err)
+	}
+	return nil
+}
+
+// defaultSandboxNameForUser returns a sandbox name that's personal to the current user.
+func defaultSandboxNameForUser() string {
+	u, err := user.Current()
+	if err != nil {
+		return defaultSandboxName
+	}
+	name := strings.Trim(invalidSandboxNameChars.ReplaceAllString(strings.ToLower(u.Username), "-"), "-")
+	if name == "" {
+		return defaultSandboxName
+	}
+	return fmt.Sprintf("%s-%s", defaultSandboxName, name)
+}
+
+// buildSandboxUpCmd builds the command to create a sandbox and deploy workloads to it.
+func buildSandboxUpCmd() *cobra.Command {
+	vars := sandboxUpVars{}
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Creates a sandbox environment and deploys services and jobs to it.",
+		Long: `Creates a sandbox environment and deploys services and jobs to it.
+Sandboxes use the "sandbox" environment profile, which is optimized for cost.`,
+		Example: `
+  Create a sandbox named "sandbox-jane" and deploy the "api" and "worker" services to it.
+  /code $ copilot sandbox up --name sandbox-jane --workloads api,worker
+
+  Create a sandbox that expires after a day.
+  /code $ copilot sandbox up --name sandbox-jane --workloads api --ttl 24h`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSandboxUpOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", sandboxNameFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.workloads, workloadsFlag, nil, sandboxWorkloadsFlagDescription)
+	cmd.Flags().DurationVar(&vars.ttl, ttlFlag, defaultSandboxTTL, sandboxTTLFlagDescription)
+	return cmd
+}
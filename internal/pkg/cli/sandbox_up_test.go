@@ -0,0 +1,304 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type sandboxUpMocks struct {
+	store  *mocks.Mockstore
+	ws     *mocks.MockwlLister
+	sel    *mocks.MockwsSelector
+	prompt *mocks.Mockprompter
+	init   *mocks.Mockcmd
+	deploy *mocks.Mockcmd
+	wkld   *mocks.Mockcmd
+}
+
+func TestSandboxUpOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp       string
+		inName      string
+		inWorkloads []string
+		inTTL       time.Duration
+		setupMocks  func(m *sandboxUpMocks)
+
+		wantedErr error
+	}{
+		"error if no app in the workspace": {
+			wantedErr: errNoAppInWorkspace,
+		},
+		"error if the app doesn't exist": {
+			inApp: "phonetool",
+			setupMocks: func(m *sandboxUpMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application phonetool configuration: some error"),
+		},
+		"error if the ttl isn't positive": {
+			inApp:  "phonetool",
+			inName: "sandbox-jane",
+			setupMocks: func(m *sandboxUpMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedErr: errors.New("--ttl must be greater than 0"),
+		},
+		"error if a workload isn't in the workspace": {
+			inApp:       "phonetool",
+			inWorkloads: []string{"api", "worker"},
+			inTTL:       time.Hour,
+			setupMocks: func(m *sandboxUpMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+			},
+			wantedErr: errors.New("workload worker is not in the workspace"),
+		},
+		"success": {
+			inApp:       "phonetool",
+			inName:      "sandbox-jane",
+			inWorkloads: []string{"api"},
+			inTTL:       time.Hour,
+			setupMocks: func(m *sandboxUpMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &sandboxUpMocks{
+				store: mocks.NewMockstore(ctrl),
+				ws:    mocks.NewMockwlLister(ctrl),
+			}
+			if tc.setupMocks != nil {
+				tc.setupMocks(m)
+			}
+			opts := &sandboxUpOpts{
+				sandboxUpVars: sandboxUpVars{
+					appName:   tc.inApp,
+					name:      tc.inName,
+					workloads: tc.inWorkloads,
+					ttl:       tc.inTTL,
+				},
+				store: m.store,
+				ws:    m.ws,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSandboxUpOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName      string
+		inWorkloads []string
+		setupMocks  func(m *sandboxUpMocks)
+
+		wantedName      string
+		wantedWorkloads []string
+		wantedErr       error
+	}{
+		"prompts for the name and the workloads": {
+			setupMocks: func(m *sandboxUpMocks) {
+				m.prompt.EXPECT().Get(sandboxUpNamePrompt, sandboxUpNameHelpPrompt, gomock.Any(), gomock.Any()).Return("sandbox-jane", nil)
+				m.sel.EXPECT().Workloads(sandboxUpWorkloadsPrompt, sandboxUpWorkloadsHelpPrompt).Return([]string{"api", "worker"}, nil)
+			},
+			wantedName:      "sandbox-jane",
+			wantedWorkloads: []string{"api", "worker"},
+		},
+		"error if the workloads can't be selected": {
+			inName: "sandbox-jane",
+			setupMocks: func(m *sandboxUpMocks) {
+				m.sel.EXPECT().Workloads(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("select services or jobs: some error"),
+		},
+		"doesn't prompt for flags that are set": {
+			inName:          "sandbox-jane",
+			inWorkloads:     []string{"api"},
+			setupMocks:      func(m *sandboxUpMocks) {},
+			wantedName:      "sandbox-jane",
+			wantedWorkloads: []string{"api"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &sandboxUpMocks{
+				sel:    mocks.NewMockwsSelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &sandboxUpOpts{
+				sandboxUpVars: sandboxUpVars{
+					name:      tc.inName,
+					workloads: tc.inWorkloads,
+				},
+				sel:    m.sel,
+				prompt: m.prompt,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+			require.Equal(t, tc.wantedWorkloads, opts.workloads)
+		})
+	}
+}
+
+func TestSandboxUpOpts_Execute(t *testing.T) {
+	now := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(2 * time.Hour)
+	expired := now.Add(-time.Hour)
+	testCases := map[string]struct {
+		setupMocks func(m *sandboxUpMocks)
+
+		wantedExpiresAt time.Time
+		wantedErr       error
+	}{
+		"error if the environment exists and isn't a sandbox": {
+			setupMocks: func(m *sandboxUpMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "sandbox-jane").Return(&config.Environment{Name: "sandbox-jane"}, nil)
+			},
+			wantedErr: errors.New("environment sandbox-jane is not a sandbox"),
+		},
+		"error if the environment can't be retrieved": {
+			setupMocks: func(m *sandboxUpMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "sandbox-jane").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get environment sandbox-jane configuration: some error"),
+		},
+		"creates the sandbox and deploys the workloads to it": {
+			setupMocks: func(m *sandboxUpMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "sandbox-john", ExpiresAt: &expired},
+				}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "sandbox-jane").Return(nil, &config.ErrNoSuchEnvironment{
+					ApplicationName: "phonetool",
+					EnvironmentName: "sandbox-jane",
+				})
+				m.init.EXPECT().Validate().Return(nil)
+				m.init.EXPECT().Ask().Return(nil)
+				m.init.EXPECT().Execute().Return(nil)
+				m.deploy.EXPECT().Validate().Return(nil)
+				m.deploy.EXPECT().Ask().Return(nil)
+				m.deploy.EXPECT().Execute().Return(nil)
+				m.store.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Name: "api"}, nil)
+				m.wkld.EXPECT().Validate().Return(nil)
+				m.wkld.EXPECT().Ask().Return(nil)
+				m.wkld.EXPECT().Execute().Return(nil)
+			},
+			wantedExpiresAt: expiresAt,
+		},
+		"redeploys an existing sandbox without changing its expiry": {
+			setupMocks: func(m *sandboxUpMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "sandbox-jane").Return(&config.Environment{
+					Name:      "sandbox-jane",
+					ExpiresAt: &expired,
+				}, nil)
+				m.deploy.EXPECT().Validate().Return(nil)
+				m.deploy.EXPECT().Ask().Return(nil)
+				m.deploy.EXPECT().Execute().Return(nil)
+				m.store.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Name: "api"}, nil)
+				m.wkld.EXPECT().Validate().Return(nil)
+				m.wkld.EXPECT().Ask().Return(nil)
+				m.wkld.EXPECT().Execute().Return(nil)
+			},
+			wantedExpiresAt: expired,
+		},
+		"error if a workload fails to deploy": {
+			setupMocks: func(m *sandboxUpMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "sandbox-jane").Return(&config.Environment{
+					Name:      "sandbox-jane",
+					ExpiresAt: &expiresAt,
+				}, nil)
+				m.deploy.EXPECT().Validate().Return(nil)
+				m.deploy.EXPECT().Ask().Return(nil)
+				m.deploy.EXPECT().Execute().Return(nil)
+				m.store.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Name: "api"}, nil)
+				m.wkld.EXPECT().Validate().Return(nil)
+				m.wkld.EXPECT().Ask().Return(nil)
+				m.wkld.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("deploy api to sandbox sandbox-jane: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &sandboxUpMocks{
+				store:  mocks.NewMockstore(ctrl),
+				init:   mocks.NewMockcmd(ctrl),
+				deploy: mocks.NewMockcmd(ctrl),
+				wkld:   mocks.NewMockcmd(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &sandboxUpOpts{
+				sandboxUpVars: sandboxUpVars{
+					appName:   "phonetool",
+					name:      "sandbox-jane",
+					workloads: []string{"api"},
+					ttl:       2 * time.Hour,
+				},
+				store: m.store,
+				now:   func() time.Time { return now },
+				newInitEnvCmd: func(o *sandboxUpOpts, got time.Time) (cmd, error) {
+					require.Equal(t, expiresAt, got)
+					return m.init, nil
+				},
+				newDeployEnvCmd: func(o *sandboxUpOpts) (cmd, error) {
+					return m.deploy, nil
+				},
+				newDeployWkldCmd: func(o *sandboxUpOpts, wkld *config.Workload) (cmd, error) {
+					if wkld.Name != "api" {
+						return nil, fmt.Errorf("unexpected workload %s", wkld.Name)
+					}
+					return m.wkld, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedExpiresAt, opts.expiresAt)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+)
+
+// The functions below run the commands for the public SDK in pkg/sdk.
+// All the inputs of a command are set so that it never prompts, and the AWS requests of the command are made with ctx:
+// once ctx is done, the requests fail and the command returns.
+// Each call creates its own sessions, so calls can run concurrently.
+
+// InitApp creates an application like "copilot app init".
+func InitApp(ctx context.Context, name, domain, permissionsBoundary string, tags map[string]string) error {
+	opts, err := newInitAppOpts(initAppVars{
+		name:                name,
+		domainName:          domain,
+		permissionsBoundary: permissionsBoundary,
+		resourceTags:        tags,
+	}, sdkSessionProvider(ctx, "app init"))
+	if err != nil {
+		return err
+	}
+	return runWithoutRecommendations(opts)
+}
+
+// DeleteApp deletes an application and all of its resources like "copilot app delete --yes".
+func DeleteApp(ctx context.Context, name string) error {
+	opts, err := newDeleteAppOpts(deleteAppVars{
+		name:             name,
+		skipConfirmation: true,
+	}, sdkSessionProvider(ctx, "app delete"))
+	if err != nil {
+		return err
+	}
+	return runWithoutRecommendations(opts)
+}
+
+// DescribeApp returns the description of an application like "copilot app show".
+func DescribeApp(ctx context.Context, name string) (*describe.App, error) {
+	opts, err := newShowAppOpts(showAppVars{
+		name: name,
+	}, sdkSessionProvider(ctx, "app show"))
+	if err != nil {
+		return nil, err
+	}
+	if err := ask(opts); err != nil {
+		return nil, err
+	}
+	return opts.description()
+}
+
+// InitEnv creates an environment with the default network configuration like "copilot env init --default-config".
+func InitEnv(ctx context.Context, app, name, profile, region string) error {
+	opts, err := newInitEnvOpts(initEnvVars{
+		appName:       app,
+		name:          name,
+		profile:       profile,
+		region:        region,
+		defaultConfig: true,
+	}, sdkSessionProvider(ctx, "env init"))
+	if err != nil {
+		return err
+	}
+	return runWithoutRecommendations(opts)
+}
+
+// DeployEnv deploys an environment from its manifest in the workspace like "copilot env deploy".
+func DeployEnv(ctx context.Context, app, name string, force, disableRollback bool) error {
+	opts, err := newEnvDeployOpts(deployEnvVars{
+		appName:         app,
+		name:            name,
+		forceNewUpdate:  force,
+		disableRollback: disableRollback,
+	}, sdkSessionProvider(ctx, "env deploy"))
+	if err != nil {
+		return err
+	}
+	return runWithoutRecommendations(opts)
+}
+
+// DeleteEnv deletes an environment without any workloads deployed to it like "copilot env delete --yes".
+func DeleteEnv(ctx context.Context, app, name string) error {
+	opts, err := newDeleteEnvOpts(deleteEnvVars{
+		appName:          app,
+		name:             name,
+		skipConfirmation: true,
+	}, sdkSessionProvider(ctx, "env delete"))
+	if err != nil {
+		return err
+	}
+	return runWithoutRecommendations(opts)
+}
+
+// DescribeEnv returns the description of an environment like "copilot env show".
+func DescribeEnv(ctx context.Context, app, name string) (*describe.EnvDescription, error) {
+	opts, err := newShowEnvOpts(showEnvVars{
+		appName: app,
+		name:    name,
+	}, sdkSessionProvider(ctx, "env show"))
+	if err != nil {
+		return nil, err
+	}
+	if err := ask(opts); err != nil {
+		return nil, err
+	}
+	if err := opts.initEnvDescriber(); err != nil {
+		return nil, err
+	}
+	env, err := opts.describer.Describe()
+	if err != nil {
+		return nil, fmt.Errorf("describe environment %s: %w", name, err)
+	}
+	return env, nil
+}
+
+// DeployWorkload deploys an initialized service or job to an existing environment like "copilot deploy".
+func DeployWorkload(ctx context.Context, app, name, env, imageTag string, tags map[string]string, force, disableRollback bool) error {
+	opts, err := newDeployOpts(deployVars{
+		deployWkldVars: deployWkldVars{
+			appName:           app,
+			name:              name,
+			envName:           env,
+			imageTag:          imageTag,
+			resourceTags:      tags,
+			forceNewUpdate:    force,
+			disableRollback:   disableRollback,
+			maxParallelBuilds: clideploy.DefaultMaxParallelBuilds,
+		},
+		yesInitWkld: aws.Bool(false),
+		yesInitEnv:  aws.Bool(false),
+		deployEnv:   aws.Bool(false),
+	}, sdkSessionProvider(ctx, "deploy"))
+	if err != nil {
+		return err
+	}
+	return opts.Run()
+}
+
+// DeleteWorkload deletes a service, or a job if isJob is true, like "copilot svc delete --yes".
+// If env is empty, the workload is deleted from all of its environments and from the application.
+func DeleteWorkload(ctx context.Context, app, name, env string, isJob bool) error {
+	if isJob {
+		opts, err := newDeleteJobOpts(deleteJobVars{
+			appName:          app,
+			name:             name,
+			envName:          env,
+			skipConfirmation: true,
+		}, sdkSessionProvider(ctx, "job delete"))
+		if err != nil {
+			return err
+		}
+		return runWithoutRecommendations(opts)
+	}
+	opts, err := newDeleteSvcOpts(deleteSvcVars{
+		appName:          app,
+		name:             name,
+		envName:          env,
+		skipConfirmation: true,
+	}, sdkSessionProvider(ctx, "svc delete"))
+	if err != nil {
+		return err
+	}
+	return runWithoutRecommendations(opts)
+}
+
+// DescribeServiceStatus returns the status of a service in an environment like "copilot svc status".
+// The status is a *describe.ECSServiceStatus, *describe.AppRunnerServiceStatus or *describe.StaticSiteServiceStatus
+// depending on the type of the service.
+func DescribeServiceStatus(ctx context.Context, app, name, env string) (describe.HumanJSONStringer, error) {
+	opts, err := newSvcStatusOpts(svcStatusVars{
+		appName: app,
+		svcName: name,
+		envName: env,
+	}, sdkSessionProvider(ctx, "svc status"))
+	if err != nil {
+		return nil, err
+	}
+	if err := ask(opts); err != nil {
+		return nil, err
+	}
+	if err := opts.initStatusDescriber(opts); err != nil {
+		return nil, err
+	}
+	status, err := opts.statusDescriber.Describe()
+	if err != nil {
+		return nil, fmt.Errorf("describe status of service %s: %w", name, err)
+	}
+	return status, nil
+}
+
+func sdkSessionProvider(ctx context.Context, cmd string) *sessions.Provider {
+	return sessions.NewProvider(sessions.UserAgentExtras("sdk", cmd), sessions.WithContext(ctx))
+}
+
+// ask validates the inputs of the command. None of them are missing, so it never prompts.
+func ask(c cmd) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	return c.Ask()
+}
+
+// runWithoutRecommendations runs the command without logging the follow-up commands recommended to CLI users.
+func runWithoutRecommendations(c cmd) error {
+	if err := ask(c); err != nil {
+		return err
+	}
+	return c.Execute()
+}
@@ -0,0 +1,157 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// parseSecretsEnvFile parses a file of secret names and values in the format inferred from its extension:
+// JSON for ".json", CSV for ".csv", and dotenv otherwise.
+func parseSecretsEnvFile(path string, raw []byte) (map[string]string, error) {
+	var (
+		secrets map[string]string
+		err     error
+	)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		secrets, err = parseJSONSecrets(raw)
+	case ".csv":
+		secrets, err = parseCSVSecrets(raw)
+	default:
+		secrets, err = parseDotenvSecrets(raw)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse secrets file %s: %w", path, err)
+	}
+	for name := range secrets {
+		if err := validateSecretName(name); err != nil {
+			return nil, fmt.Errorf("invalid secret name %q in %s: %w", name, path, err)
+		}
+	}
+	return secrets, nil
+}
+
+// parseJSONSecrets parses a flat JSON object, such as {"db_password": "hunter2"}.
+func parseJSONSecrets(raw []byte) (map[string]string, error) {
+	var kv map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber() // Keep numbers as written, without rounding large integers or reformatting decimals.
+	if err := dec.Decode(&kv); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the JSON object")
+	}
+	secrets := make(map[string]string, len(kv))
+	for name, value := range kv {
+		switch v := value.(type) {
+		case string:
+			secrets[name] = v
+		case json.Number:
+			secrets[name] = v.String()
+		case bool:
+			secrets[name] = fmt.Sprint(v)
+		default:
+			return nil, fmt.Errorf("value of secret %s must be a string, a number or a boolean", name)
+		}
+	}
+	return secrets, nil
+}
+
+// parseCSVSecrets parses records of the form "<name>,<value>". A "name,value" header row is skipped.
+func parseCSVSecrets(raw []byte) (map[string]string, error) {
+	r := csv.NewReader(bytes.NewReader(raw))
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	secrets := make(map[string]string)
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		name, value := strings.TrimSpace(record[0]), record[1]
+		if line == 1 && strings.EqualFold(name, "name") && strings.EqualFold(value, "value") {
+			continue
+		}
+		if err := addParsedSecret(secrets, name, value, line); err != nil {
+			return nil, err
+		}
+	}
+	return secrets, nil
+}
+
+// parseDotenvSecrets parses lines of the form "[export] <name>=<value>".
+// Values can be wrapped in single quotes to be taken literally, or in double quotes to support escape sequences.
+// Blank lines and lines starting with "#" are ignored.
+func parseDotenvSecrets(raw []byte) (map[string]string, error) {
+	secrets := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		text = strings.TrimSpace(strings.TrimPrefix(text, "export "))
+		name, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected <name>=<value>", line)
+		}
+		name = strings.TrimSpace(name)
+		value, err := unquoteDotenvValue(name, strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := addParsedSecret(secrets, name, value, line); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// unquoteDotenvValue returns the value of the secret name without its quotes.
+// Errors mention the name of the secret rather than its value, so that the value isn't printed.
+func unquoteDotenvValue(name, value string) (string, error) {
+	switch {
+	case len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"':
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return "", fmt.Errorf("unquote value of secret %s: %w", name, err)
+		}
+		return unquoted, nil
+	case len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'':
+		return value[1 : len(value)-1], nil
+	}
+	// Unquoted values can have trailing comments.
+	if i := strings.Index(value, " #"); i != -1 {
+		value = strings.TrimSpace(value[:i])
+	}
+	return value, nil
+}
+
+func addParsedSecret(secrets map[string]string, name, value string, line int) error {
+	if name == "" {
+		return fmt.Errorf("line %d: secret name cannot be empty", line)
+	}
+	if _, ok := secrets[name]; ok {
+		return fmt.Errorf("line %d: secret %s is defined more than once", line, name)
+	}
+	secrets[name] = value
+	return nil
+}
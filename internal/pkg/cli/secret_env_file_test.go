@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSecretsEnvFile(t *testing.T) {
+	testCases := map[string]struct {
+		inPath string
+		inRaw  string
+
+		wanted    map[string]string
+		wantedErr error
+	}{
+		"dotenv file": {
+			inPath: "secrets.env",
+			inRaw: `# Database credentials.
+DB_USER=admin
+export DB_PASSWORD="p@ss\nword"
+
+API_KEY='literal\nkey'
+GITHUB_TOKEN=ghp_123 # personal token`,
+			wanted: map[string]string{
+				"DB_USER":      "admin",
+				"DB_PASSWORD":  "p@ss\nword",
+				"API_KEY":      `literal\nkey`,
+				"GITHUB_TOKEN": "ghp_123",
+			},
+		},
+		"dotenv file with a malformed line": {
+			inPath:    ".env",
+			inRaw:     "DB_USER=admin\nDB_PASSWORD",
+			wantedErr: errors.New("parse secrets file .env: line 2: expected <name>=<value>"),
+		},
+		"dotenv file with an invalid quoted value doesn't print the value": {
+			inPath:    ".env",
+			inRaw:     "DB_USER=admin\nDB_PASSWORD=\"s3cr3t\\q\"",
+			wantedErr: errors.New("parse secrets file .env: line 2: unquote value of secret DB_PASSWORD: invalid syntax"),
+		},
+		"dotenv file with a duplicated secret": {
+			inPath:    ".env",
+			inRaw:     "DB_USER=admin\nDB_USER=root",
+			wantedErr: errors.New("parse secrets file .env: line 2: secret DB_USER is defined more than once"),
+		},
+		"JSON file": {
+			inPath: "secrets.JSON",
+			inRaw:  `{"DB_USER": "admin", "DB_PORT": 5432, "DEBUG": true}`,
+			wanted: map[string]string{
+				"DB_USER": "admin",
+				"DB_PORT": "5432",
+				"DEBUG":   "true",
+			},
+		},
+		"JSON file with large numbers and decimals": {
+			inPath: "secrets.json",
+			inRaw:  `{"ACCOUNT_ID": 123456789012345678, "RATE": 0.10, "BIG": 1e21, "NEGATIVE": -42}`,
+			wanted: map[string]string{
+				"ACCOUNT_ID": "123456789012345678",
+				"RATE":       "0.10",
+				"BIG":        "1e21",
+				"NEGATIVE":   "-42",
+			},
+		},
+		"JSON file with trailing data": {
+			inPath:    "secrets.json",
+			inRaw:     `{"DB_USER": "admin"} {"DB_USER": "root"}`,
+			wantedErr: errors.New("parse secrets file secrets.json: unexpected data after the JSON object"),
+		},
+		"JSON file with a nested value": {
+			inPath:    "secrets.json",
+			inRaw:     `{"DB": {"USER": "admin"}}`,
+			wantedErr: errors.New("parse secrets file secrets.json: value of secret DB must be a string, a number or a boolean"),
+		},
+		"CSV file with a header": {
+			inPath: "secrets.csv",
+			inRaw: `name,value
+DB_USER,admin
+DB_PASSWORD,"comma,separated"`,
+			wanted: map[string]string{
+				"DB_USER":     "admin",
+				"DB_PASSWORD": "comma,separated",
+			},
+		},
+		"CSV file with the wrong number of fields": {
+			inPath:    "secrets.csv",
+			inRaw:     "DB_USER,admin,extra",
+			wantedErr: errors.New("parse secrets file secrets.csv: record on line 1: wrong number of fields"),
+		},
+		"invalid secret name": {
+			inPath:    "secrets.env",
+			inRaw:     "DB USER=admin",
+			wantedErr: errors.New(`invalid secret name "DB USER" in secrets.env: ` + errInvalidSecretNameCharacters.Error()),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := parseSecretsEnvFile(tc.inPath, []byte(tc.inRaw))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
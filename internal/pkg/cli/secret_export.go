@@ -0,0 +1,223 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretExportAppPrompt     = "Which application's secrets would you like to export?"
+	secretExportAppPromptHelp = "Secrets are listed for each environment of the application."
+
+	fmtSecretParameterPath = "/copilot/%s/%s/secrets/"
+)
+
+type secretExportVars struct {
+	appName          string
+	envs             []string
+	shouldOutputJSON bool
+}
+
+type secretExportOpts struct {
+	secretExportVars
+
+	store    store
+	selector appSelector
+	w        io.Writer
+
+	newSecretLister func(env *config.Environment) (secretLister, error)
+}
+
+func newSecretExportOpts(vars secretExportVars) (*secretExportOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret export"))
+	defaultSession, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSession), awsssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	return &secretExportOpts{
+		secretExportVars: vars,
+		store:            store,
+		selector:         selector.NewAppEnvSelector(prompt.New(), store),
+		w:                os.Stdout,
+		newSecretLister: func(env *config.Environment) (secretLister, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return ssm.New(sess), nil
+		},
+	}, nil
+}
+
+// Ask prompts for the application if it's not provided.
+func (o *secretExportOpts) Ask() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.selector.Application(secretExportAppPrompt, secretExportAppPromptHelp)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute writes the metadata of the secrets in each environment. Secret values are never retrieved.
+func (o *secretExportOpts) Execute() error {
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	envs, err := o.targetEnvs()
+	if err != nil {
+		return err
+	}
+	exported := make([]exportedEnvSecrets, 0, len(envs))
+	for _, env := range envs {
+		lister, err := o.newSecretLister(env)
+		if err != nil {
+			return err
+		}
+		path := fmt.Sprintf(fmtSecretParameterPath, o.appName, env.Name)
+		secrets, err := lister.ListSecrets(path)
+		if err != nil {
+			return fmt.Errorf("list secrets in environment %s: %w", env.Name, err)
+		}
+		out := exportedEnvSecrets{
+			Name:    env.Name,
+			Secrets: make([]exportedSecret, 0, len(secrets)),
+		}
+		for _, secret := range secrets {
+			out.Secrets = append(out.Secrets, exportedSecret{
+				Name:             strings.TrimPrefix(secret.Name, path),
+				Parameter:        secret.Name,
+				Type:             secret.Type,
+				Version:          secret.Version,
+				LastModifiedDate: secret.LastModifiedDate,
+				LastModifiedUser: secret.LastModifiedUser,
+			})
+		}
+		exported = append(exported, out)
+	}
+
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Environments []exportedEnvSecrets `json:"environments"`
+		}{
+			Environments: exported,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal secrets: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", data)
+		return nil
+	}
+	writeExportedSecrets(o.w, exported)
+	return nil
+}
+
+func (o *secretExportOpts) targetEnvs() ([]*config.Environment, error) {
+	if len(o.envs) == 0 {
+		envs, err := o.store.ListEnvironments(o.appName)
+		if err != nil {
+			return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+		}
+		return envs, nil
+	}
+	envs := make([]*config.Environment, 0, len(o.envs))
+	for _, name := range o.envs {
+		env, err := o.store.GetEnvironment(o.appName, name)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in application %s: %w", name, o.appName, err)
+		}
+		envs = append(envs, env)
+	}
+	return envs, nil
+}
+
+type exportedEnvSecrets struct {
+	Name    string           `json:"name"`
+	Secrets []exportedSecret `json:"secrets"`
+}
+
+type exportedSecret struct {
+	Name             string    `json:"name"`
+	Parameter        string    `json:"parameter"`
+	Type             string    `json:"type"`
+	Version          int64     `json:"version"`
+	LastModifiedDate time.Time `json:"lastModifiedDate"`
+	LastModifiedUser string    `json:"lastModifiedUser,omitempty"`
+}
+
+func writeExportedSecrets(w io.Writer, envs []exportedEnvSecrets) {
+	for i, env := range envs {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "Environment %s\n", env.Name)
+		if len(env.Secrets) == 0 {
+			fmt.Fprintln(w, "  No secrets found.")
+			continue
+		}
+		tw := tabwriter.NewWriter(w, 10, 4, 2, ' ', 0)
+		headers := []string{"Name", "Version", "Last Modified", "Modified By"}
+		fmt.Fprintf(tw, "  %s\n", strings.Join(headers, "\t"))
+		separators := make([]string, len(headers))
+		for i, header := range headers {
+			separators[i] = strings.Repeat("-", len(header))
+		}
+		fmt.Fprintf(tw, "  %s\n", strings.Join(separators, "\t"))
+		for _, secret := range env.Secrets {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", secret.Name, strconv.FormatInt(secret.Version, 10),
+				secret.LastModifiedDate.Format(time.RFC3339), secret.LastModifiedUser)
+		}
+		tw.Flush()
+	}
+}
+
+// buildSecretExportCmd builds the command for listing the secrets of an application without their values.
+func buildSecretExportCmd() *cobra.Command {
+	vars := secretExportVars{}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Lists the secrets in each environment of an application without their values.",
+		Example: `
+List the secrets of every environment in the application.
+/code $ copilot secret export
+List the secrets of the test and prod environments in JSON format.
+/code $ copilot secret export --envs test,prod --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretExportOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.envs, secretEnvsFlag, nil, secretExportEnvsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,191 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretExportMocks struct {
+	store  *mocks.Mockstore
+	lister *mocks.MocksecretLister
+}
+
+func TestSecretExportOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp        string
+		mockSelector func(m *mocks.MockappSelector)
+
+		wantedApp string
+		wantedErr error
+	}{
+		"skip prompting if the app is provided": {
+			inApp:        "my-app",
+			mockSelector: func(m *mocks.MockappSelector) {},
+			wantedApp:    "my-app",
+		},
+		"prompt for the app": {
+			mockSelector: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(secretExportAppPrompt, secretExportAppPromptHelp).Return("my-app", nil)
+			},
+			wantedApp: "my-app",
+		},
+		"error if fail to select the app": {
+			mockSelector: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(secretExportAppPrompt, secretExportAppPromptHelp).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSelector := mocks.NewMockappSelector(ctrl)
+			tc.mockSelector(mockSelector)
+			opts := &secretExportOpts{
+				secretExportVars: secretExportVars{
+					appName: tc.inApp,
+				},
+				selector: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+		})
+	}
+}
+
+func TestSecretExportOpts_Execute(t *testing.T) {
+	mockTime := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inEnvs           []string
+		shouldOutputJSON bool
+		setupMocks       func(m secretExportMocks)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"error if fail to get the application": {
+			setupMocks: func(m secretExportMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application my-app: some error"),
+		},
+		"error if fail to get an environment": {
+			inEnvs: []string{"test"},
+			setupMocks: func(m secretExportMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get environment test in application my-app: some error"),
+		},
+		"error if fail to list the secrets": {
+			setupMocks: func(m secretExportMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.lister.EXPECT().ListSecrets("/copilot/my-app/test/secrets/").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list secrets in environment test: some error"),
+		},
+		"writes the secrets of every environment": {
+			setupMocks: func(m secretExportMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.lister.EXPECT().ListSecrets("/copilot/my-app/test/secrets/").Return([]ssm.SecretMetadata{
+					{
+						Name:             "/copilot/my-app/test/secrets/db_password",
+						Type:             "SecureString",
+						Version:          2,
+						LastModifiedDate: mockTime,
+						LastModifiedUser: "arn:aws:iam::123456789012:user/alice",
+					},
+				}, nil)
+				m.lister.EXPECT().ListSecrets("/copilot/my-app/prod/secrets/").Return(nil, nil)
+			},
+			wantedOutput: `Environment test
+  Name         Version   Last Modified         Modified By
+  ----         -------   -------------         -----------
+  db_password  2         2023-06-01T00:00:00Z  arn:aws:iam::123456789012:user/alice
+
+Environment prod
+  No secrets found.
+`,
+		},
+		"writes the secrets of the selected environments in JSON": {
+			inEnvs:           []string{"test"},
+			shouldOutputJSON: true,
+			setupMocks: func(m secretExportMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.lister.EXPECT().ListSecrets("/copilot/my-app/test/secrets/").Return([]ssm.SecretMetadata{
+					{
+						Name:             "/copilot/my-app/test/secrets/db_password",
+						Type:             "SecureString",
+						Version:          2,
+						LastModifiedDate: mockTime,
+					},
+				}, nil)
+			},
+			wantedOutput: `{"environments":[{"name":"test","secrets":[{"name":"db_password","parameter":"/copilot/my-app/test/secrets/db_password","type":"SecureString","version":2,"lastModifiedDate":"2023-06-01T00:00:00Z"}]}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretExportMocks{
+				store:  mocks.NewMockstore(ctrl),
+				lister: mocks.NewMocksecretLister(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &strings.Builder{}
+			opts := &secretExportOpts{
+				secretExportVars: secretExportVars{
+					appName:          "my-app",
+					envs:             tc.inEnvs,
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				store: m.store,
+				w:     b,
+				newSecretLister: func(env *config.Environment) (secretLister, error) {
+					return m.lister, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
@@ -60,6 +60,8 @@ type secretInitVars struct {
 	name          string
 	values        map[string]string
 	inputFilePath string
+	envFilePath   string
+	envs          []string
 	overwrite     bool
 }
 
@@ -132,14 +134,18 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 	}
 
 	opts.readFile = func() ([]byte, error) {
-		file, err := opts.fs.Open(opts.inputFilePath)
+		path := opts.inputFilePath
+		if opts.envFilePath != "" {
+			path = opts.envFilePath
+		}
+		file, err := opts.fs.Open(path)
 		if err != nil {
-			return nil, fmt.Errorf("open input file %s: %w", opts.inputFilePath, err)
+			return nil, fmt.Errorf("open input file %s: %w", path, err)
 		}
 		defer file.Close()
 		f, err := afero.ReadFile(opts.fs, file.Name())
 		if err != nil {
-			return nil, fmt.Errorf("read input file %s: %w", opts.inputFilePath, err)
+			return nil, fmt.Errorf("read input file %s: %w", path, err)
 		}
 
 		return f, nil
@@ -157,6 +163,17 @@ func (o *secretInitOpts) Validate() error {
 		return errors.New("cannot specify `--cli-input-yaml` with `--values`")
 	}
 
+	if o.envFilePath != "" {
+		if o.name != "" || o.values != nil || o.inputFilePath != "" {
+			return errors.New("cannot specify `--from-env-file` with `--name`, `--values` or `--cli-input-yaml`")
+		}
+		if len(o.envs) == 0 {
+			return errors.New("`--envs` must be specified with `--from-env-file`")
+		}
+	} else if len(o.envs) != 0 {
+		return errors.New("`--envs` can only be specified with `--from-env-file`")
+	}
+
 	if o.appName != "" {
 		_, err := o.store.GetApplication(o.appName)
 		if err != nil {
@@ -169,6 +186,11 @@ func (o *secretInitOpts) Validate() error {
 				}
 			}
 		}
+		for _, env := range o.envs {
+			if _, err := o.targetEnv(env); err != nil {
+				return err
+			}
+		}
 	}
 
 	if o.name != "" {
@@ -182,6 +204,11 @@ func (o *secretInitOpts) Validate() error {
 			return err
 		}
 	}
+	if o.envFilePath != "" {
+		if _, err := o.fs.Stat(o.envFilePath); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -198,6 +225,9 @@ func (o *secretInitOpts) Ask() error {
 	if err := o.askForAppName(); err != nil {
 		return err
 	}
+	if o.envFilePath != "" {
+		return nil
+	}
 	if err := o.askForSecretName(); err != nil {
 		return err
 	}
@@ -214,27 +244,14 @@ func (o *secretInitOpts) Execute() error {
 		if err != nil {
 			return err
 		}
-
-		o.secretValues = secrets
-
-		if err := o.configureClientsAndUpgradeForEnvironments(secrets); err != nil {
+		return o.putSecrets(secrets)
+	}
+	if o.envFilePath != "" {
+		secrets, err := o.parseSecretsEnvFile()
+		if err != nil {
 			return err
 		}
-
-		var errs []*errSecretFailedInSomeEnvironments
-		for secretName, secretValues := range secrets {
-			if err := o.putSecret(secretName, secretValues); err != nil {
-				errs = append(errs, err.(*errSecretFailedInSomeEnvironments))
-			}
-			log.Infoln("")
-		}
-
-		if len(errs) != 0 {
-			return &errBatchPutSecretsFailed{
-				errors: errs,
-			}
-		}
-		return nil
+		return o.putSecrets(secrets)
 	}
 
 	o.secretValues = map[string]map[string]string{
@@ -246,6 +263,29 @@ func (o *secretInitOpts) Execute() error {
 	return o.putSecret(o.name, o.values)
 }
 
+func (o *secretInitOpts) putSecrets(secrets map[string]map[string]string) error {
+	o.secretValues = secrets
+
+	if err := o.configureClientsAndUpgradeForEnvironments(secrets); err != nil {
+		return err
+	}
+
+	var errs []*errSecretFailedInSomeEnvironments
+	for secretName, secretValues := range secrets {
+		if err := o.putSecret(secretName, secretValues); err != nil {
+			errs = append(errs, err.(*errSecretFailedInSomeEnvironments))
+		}
+		log.Infoln("")
+	}
+
+	if len(errs) != 0 {
+		return &errBatchPutSecretsFailed{
+			errors: errs,
+		}
+	}
+	return nil
+}
+
 func (o *secretInitOpts) configureClientsAndUpgradeForEnvironments(secrets map[string]map[string]string) error {
 	envNames := make(map[string]struct{})
 	for _, values := range secrets {
@@ -350,6 +390,26 @@ func (o *secretInitOpts) parseSecretsInputFile() (map[string]map[string]string,
 	return f.Secrets, nil
 }
 
+// parseSecretsEnvFile reads the secrets in the dotenv, JSON or CSV file and assigns the same values in every target environment.
+func (o *secretInitOpts) parseSecretsEnvFile() (map[string]map[string]string, error) {
+	raw, err := o.readFile()
+	if err != nil {
+		return nil, err
+	}
+	values, err := parseSecretsEnvFile(o.envFilePath, raw)
+	if err != nil {
+		return nil, err
+	}
+	secrets := make(map[string]map[string]string, len(values))
+	for name, value := range values {
+		secrets[name] = make(map[string]string, len(o.envs))
+		for _, env := range o.envs {
+			secrets[name][env] = value
+		}
+	}
+	return secrets, nil
+}
+
 func (o *secretInitOpts) askForAppName() error {
 	if o.appName != "" {
 		return nil
@@ -490,7 +550,9 @@ Create a secret with prompts.
 Create a secret named db-password in multiple environments.
 /code $ copilot secret init --name db-password
 Create secrets from input.yml. For the format of the YAML file, please see https://aws.github.io/copilot-cli/docs/commands/secret-init/.
-/code $ copilot secret init --cli-input-yaml input.yml`,
+/code $ copilot secret init --cli-input-yaml input.yml
+Import the secrets of a dotenv file to the test and prod environments.
+/code $ copilot secret init --from-env-file secrets.env --envs test,prod`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSecretInitOpts(vars)
 			if err != nil {
@@ -523,5 +585,7 @@ Create secrets from input.yml. For the format of the YAML file, please see https
 	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, secretValuesFlagDescription)
 	cmd.Flags().BoolVar(&vars.overwrite, overwriteFlag, false, secretOverwriteFlagDescription)
 	cmd.Flags().StringVar(&vars.inputFilePath, inputFilePathFlag, "", secretInputFilePathFlagDescription)
+	cmd.Flags().StringVar(&vars.envFilePath, envFilePathFlag, "", secretEnvFilePathFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.envs, secretEnvsFlag, nil, secretEnvsFlagDescription)
 	return cmd
 }
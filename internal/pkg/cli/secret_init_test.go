@@ -35,6 +35,8 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 		inValues        map[string]string
 		inOverwrite     bool
 		inInputFilePath string
+		inEnvFilePath   string
+		inEnvs          []string
 
 		setupMocks func(m secretInitMocks)
 
@@ -110,6 +112,41 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 			setupMocks:      func(m secretInitMocks) {},
 			wantedError:     errors.New("cannot specify `--cli-input-yaml` with `--values`"),
 		},
+		"valid with env file": {
+			inApp:         "dragon_slaying",
+			inEnvFilePath: "secrets.env",
+			inEnvs:        []string{"good_village", "bad_village"},
+
+			setupMocks: func(m secretInitMocks) {
+				afero.WriteFile(m.mockFS, "secrets.env", []byte("DRAGON=asleep"), 0644)
+				m.mockStore.EXPECT().GetApplication("dragon_slaying").Return(&config.Application{}, nil)
+				m.mockStore.EXPECT().GetEnvironment("dragon_slaying", "good_village").Return(&config.Environment{}, nil)
+				m.mockStore.EXPECT().GetEnvironment("dragon_slaying", "bad_village").Return(&config.Environment{}, nil)
+			},
+		},
+		"error if env file is specified with name": {
+			inName:        "db-password",
+			inEnvFilePath: "secrets.env",
+			inEnvs:        []string{"test"},
+			setupMocks:    func(m secretInitMocks) {},
+			wantedError:   errors.New("cannot specify `--from-env-file` with `--name`, `--values` or `--cli-input-yaml`"),
+		},
+		"error if env file is specified without envs": {
+			inEnvFilePath: "secrets.env",
+			setupMocks:    func(m secretInitMocks) {},
+			wantedError:   errors.New("`--envs` must be specified with `--from-env-file`"),
+		},
+		"error if envs are specified without env file": {
+			inEnvs:      []string{"test"},
+			setupMocks:  func(m secretInitMocks) {},
+			wantedError: errors.New("`--envs` can only be specified with `--from-env-file`"),
+		},
+		"invalid env file name": {
+			inEnvFilePath: "weird/path/to/secrets.env",
+			inEnvs:        []string{"test"},
+			setupMocks:    func(m secretInitMocks) {},
+			wantedError:   fmt.Errorf("open %s: file does not exist", filepath.FromSlash("weird/path/to/secrets.env")),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -125,6 +162,8 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 					name:          tc.inName,
 					values:        tc.inValues,
 					inputFilePath: tc.inInputFilePath,
+					envFilePath:   tc.inEnvFilePath,
+					envs:          tc.inEnvs,
 					overwrite:     tc.inOverwrite,
 				},
 				fs:    &afero.Afero{Fs: afero.NewMemMapFs()},
@@ -363,6 +402,8 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 		inValues map[string]string
 
 		inInputFilePath string
+		inEnvFilePath   string
+		inEnvs          []string
 
 		inOverwrite bool
 
@@ -499,6 +540,38 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 				},
 			},
 		},
+		"successfully import secrets from an env file to every environment": {
+			inAppName:     testApp,
+			inEnvFilePath: "secrets.env",
+			inEnvs:        []string{"test", "prod"},
+
+			mockInputFileContent: []byte("db-password=shared-password"),
+			setupMocks: func(m secretInitExecuteMocks) {
+				for _, env := range []string{"test", "prod"} {
+					m.mockSecretPutter.EXPECT().PutSecret(ssm.PutSecretInput{
+						Name:  fmt.Sprintf("/copilot/test-app/%s/secrets/db-password", env),
+						Value: "shared-password",
+						Tags: map[string]string{
+							deploy.AppTagKey: "test-app",
+							deploy.EnvTagKey: env,
+						},
+					}).Return(&ssm.PutSecretOutput{
+						Version: aws.Int64(1),
+					}, nil)
+				}
+				m.mockEnvCompatibilityChecker.EXPECT().Version().Return("v1.10.0", nil).Times(2)
+			},
+		},
+		"error if the env file cannot be parsed": {
+			inAppName:     testApp,
+			inEnvFilePath: "secrets.env",
+			inEnvs:        []string{"test"},
+
+			mockInputFileContent: []byte("db-password"),
+			setupMocks:           func(m secretInitExecuteMocks) {},
+
+			wantedError: errors.New("parse secrets file secrets.env: line 1: expected <name>=<value>"),
+		},
 		"some secrets fail to create during a batch operation": {
 			inAppName:       testApp,
 			inInputFilePath: "some/file",
@@ -579,6 +652,8 @@ db-host:
 					values:        tc.inValues,
 					overwrite:     tc.inOverwrite,
 					inputFilePath: tc.inInputFilePath,
+					envFilePath:   tc.inEnvFilePath,
+					envs:          tc.inEnvs,
 				},
 				store: m.mockStore,
 
@@ -170,6 +170,8 @@ type initStorageVars struct {
 	rdsEngine               string
 	rdsParameterGroup       string
 	rdsInitialDBName        string
+
+	defaultsFrom string
 }
 
 type initStorageOpts struct {
@@ -203,7 +205,7 @@ func newStorageInitOpts(vars initStorageVars) (*initStorageOpts, error) {
 	}
 
 	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
-	prompter := prompt.New()
+	prompter := newPromptWithHistory(ws, "storage init", vars.defaultsFrom)
 	return &initStorageOpts{
 		initStorageVars: vars,
 		appName:         tryReadingAppName(),
@@ -222,6 +224,9 @@ func (o *initStorageOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if err := validateDefaultsFrom(o.defaultsFrom); err != nil {
+		return err
+	}
 	if o.addIngressFrom != "" {
 		if err := o.validateAddIngressFrom(); err != nil {
 			return err
@@ -1222,7 +1227,9 @@ Storage resources are addons, either for a workload or the environments.`,
   Create a DynamoDB table with a sort key.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --no-lsi
   Create an RDS Aurora Serverless v2 cluster using PostgreSQL.
-  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL --initial-db testdb`,
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL --initial-db testdb
+  Create a storage resource like the last one, and only prompt for what changed.
+  /code $ copilot storage init -n my-other-table --defaults-from last`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
@@ -1236,6 +1243,7 @@ Storage resources are addons, either for a workload or the environments.`,
 	cmd.Flags().StringVarP(&vars.workloadName, workloadFlag, workloadFlagShort, "", storageWorkloadFlagDescription)
 	cmd.Flags().StringVarP(&vars.lifecycle, storageLifecycleFlag, storageLifecycleShort, "", storageLifecycleFlagDescription)
 	cmd.Flags().StringVarP(&vars.addIngressFrom, storageAddIngressFromFlag, "", "", storageAddIngressFromFlagDescription)
+	cmd.Flags().StringVar(&vars.defaultsFrom, defaultsFromFlag, "", defaultsFromFlagDescription)
 
 	cmd.Flags().StringVar(&vars.partitionKey, storagePartitionKeyFlag, "", storagePartitionKeyFlagDescription)
 	cmd.Flags().StringVar(&vars.sortKey, storageSortKeyFlag, "", storageSortKeyFlagDescription)
@@ -1270,6 +1278,7 @@ Storage resources are addons, either for a workload or the environments.`,
 
 	optionalFlagSet := pflag.NewFlagSet("Optional", pflag.ContinueOnError)
 	optionalFlagSet.AddFlag(cmd.Flags().Lookup(storageAddIngressFromFlag))
+	optionalFlagSet.AddFlag(cmd.Flags().Lookup(defaultsFromFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
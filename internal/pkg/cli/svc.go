@@ -29,8 +29,11 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcOverrideCmd())
 	cmd.AddCommand(buildSvcDeployCmd())
 	cmd.AddCommand(buildSvcDeleteCmd())
+	cmd.AddCommand(buildSvcRenameCmd())
 	cmd.AddCommand(buildSvcShowCmd())
 	cmd.AddCommand(buildSvcStatusCmd())
+	cmd.AddCommand(buildSvcCompareCmd())
+	cmd.AddCommand(buildSvcSimulateScalingCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
 	cmd.AddCommand(buildSvcExecCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
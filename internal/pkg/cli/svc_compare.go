@@ -0,0 +1,189 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcCompareNamePrompt     = "Which service's mirrored traffic would you like to compare?"
+	svcCompareNameHelpPrompt = "Compares the responses of the service with the responses of the service its requests are mirrored to."
+
+	defaultCompareSince = time.Hour
+)
+
+type svcCompareVars struct {
+	shouldOutputJSON bool
+	svcName          string
+	envName          string
+	appName          string
+	since            time.Duration
+}
+
+type svcCompareOpts struct {
+	svcCompareVars
+
+	w            io.Writer
+	store        store
+	sel          deploySelector
+	comparer     trafficMirrorComparer
+	initComparer func(*svcCompareOpts) error
+}
+
+func newSvcCompareOpts(vars svcCompareVars) (*svcCompareOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc compare"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcCompareOpts{
+		svcCompareVars: vars,
+		store:          configStore,
+		w:              log.OutputWriter,
+		sel:            selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		initComparer: func(o *svcCompareOpts) error {
+			wkld, err := configStore.GetWorkload(o.appName, o.svcName)
+			if err != nil {
+				return fmt.Errorf("retrieve %s from application %s: %w", o.svcName, o.appName, err)
+			}
+			if wkld.Type != manifestinfo.LoadBalancedWebServiceType {
+				return fmt.Errorf("traffic mirroring is only supported for %s, but service %s is a %s", manifestinfo.LoadBalancedWebServiceType, o.svcName, wkld.Type)
+			}
+			c, err := describe.NewTrafficMirrorComparer(describe.NewServiceConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create traffic mirror comparer for service %s in application %s: %w", o.svcName, o.appName, err)
+			}
+			o.comparer = c
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcCompareOpts) Validate() error {
+	if o.since <= 0 {
+		return errors.New("--since must be greater than 0")
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcCompareOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// Execute compares the responses of the service with the responses to the copies of its requests.
+func (o *svcCompareOpts) Execute() error {
+	if err := o.initComparer(o); err != nil {
+		return err
+	}
+	comparison, err := o.comparer.Compare(o.since)
+	if err != nil {
+		return fmt.Errorf("compare mirrored traffic of service %s: %w", o.svcName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := comparison.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, comparison.HumanString())
+	return nil
+}
+
+func (o *svcCompareOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcCompareOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcCompareNamePrompt, svcCompareNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcCompareCmd builds the command for comparing the responses of a service with the responses of its traffic mirror.
+func buildSvcCompareCmd() *cobra.Command {
+	vars := svcCompareVars{}
+	cmd := &cobra.Command{
+		Use:   "compare",
+		Short: "Compares the responses of a service with the responses to its mirrored requests.",
+		Long: `Compares the responses of a service with the responses of the service that its requests are mirrored to with "http.mirror".
+Shows the number of requests, the rates of 2xx, 4xx and 5xx responses, and the latency percentiles of each side.`,
+
+		Example: `
+  Compares the responses of "my-svc" in the "test" environment over the last hour.
+  /code $ copilot svc compare -n my-svc -e test
+  Compares the responses over the last 15 minutes in JSON.
+  /code $ copilot svc compare -n my-svc -e test --since 15m --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcCompareOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().DurationVar(&vars.since, sinceFlag, defaultCompareSince, compareSinceFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
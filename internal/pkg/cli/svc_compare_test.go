@@ -0,0 +1,182 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcCompare_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSince time.Duration
+
+		wantedErr string
+	}{
+		"error if the duration is not positive": {
+			inSince:   -time.Minute,
+			wantedErr: "--since must be greater than 0",
+		},
+		"valid duration": {
+			inSince: 15 * time.Minute,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcCompareOpts{
+				svcCompareVars: svcCompareVars{
+					since: tc.inSince,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcCompare_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inSvc string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector)
+
+		wantedEnv string
+		wantedSvc string
+		wantedErr string
+	}{
+		"select the deployed service": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				sel.EXPECT().DeployedService(svcCompareNamePrompt, svcCompareNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Name: "api"}, nil)
+			},
+			wantedEnv: "test",
+			wantedSvc: "api",
+		},
+		"error if the environment doesn't exist": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+		"error selecting the deployed service": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedErr: "select deployed services for application phonetool: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(mockStore, mockSel)
+
+			opts := &svcCompareOpts{
+				svcCompareVars: svcCompareVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					svcName: tc.inSvc,
+				},
+				store: mockStore,
+				sel:   mockSel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+func TestSvcCompare_Execute(t *testing.T) {
+	comparison := &describe.TrafficMirrorComparison{
+		Service: describe.TrafficStats{Requests: 2, Rate2xx: 1, LatencyP50Ms: 10, LatencyP90Ms: 20, LatencyP99Ms: 20},
+		Mirror:  describe.TrafficStats{Requests: 1, Rate5xx: 1, LatencyP50Ms: 5, LatencyP90Ms: 5, LatencyP99Ms: 5},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m *mocks.MocktrafficMirrorComparer)
+
+		wantedOutput string
+		wantedErr    string
+	}{
+		"error if fail to compare the traffic": {
+			setupMocks: func(m *mocks.MocktrafficMirrorComparer) {
+				m.EXPECT().Compare(time.Hour).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "compare mirrored traffic of service api: some error",
+		},
+		"write the comparison in JSON": {
+			inJSON: true,
+			setupMocks: func(m *mocks.MocktrafficMirrorComparer) {
+				m.EXPECT().Compare(time.Hour).Return(comparison, nil)
+			},
+			wantedOutput: `{"service":{"requests":2,"2xxRate":1,"4xxRate":0,"5xxRate":0,"p50LatencyMs":10,"p90LatencyMs":20,"p99LatencyMs":20},"mirror":{"requests":1,"2xxRate":0,"4xxRate":0,"5xxRate":1,"p50LatencyMs":5,"p90LatencyMs":5,"p99LatencyMs":5}}
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocktrafficMirrorComparer(ctrl)
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+
+			opts := &svcCompareOpts{
+				svcCompareVars: svcCompareVars{
+					appName:          "phonetool",
+					envName:          "test",
+					svcName:          "api",
+					since:            time.Hour,
+					shouldOutputJSON: tc.inJSON,
+				},
+				comparer:     m,
+				initComparer: func(*svcCompareOpts) error { return nil },
+				w:            b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
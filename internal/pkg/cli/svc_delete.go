@@ -9,15 +9,21 @@ import (
 	"errors"
 	"fmt"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"io"
 	"os"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	awss3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/cli/clean"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/s3"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
@@ -27,11 +33,15 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -44,6 +54,8 @@ const (
 
 var (
 	errSvcDeleteCancelled = errors.New("svc delete cancelled - no changes made")
+
+	topicSubscriptionServiceField = regexp.MustCompile(`(^|\.)subscribe\.topics\[\d+\]\.service$`)
 )
 
 type cleaner interface {
@@ -55,6 +67,8 @@ type deleteSvcVars struct {
 	skipConfirmation bool
 	name             string
 	envName          string
+	force            bool
+	dryRun           bool
 }
 
 type deleteSvcOpts struct {
@@ -70,10 +84,17 @@ type deleteSvcOpts struct {
 	getSvcCFN     func(sess *awssession.Session) wlDeleter
 	getECR        func(sess *awssession.Session) imageRemover
 	newSvcCleaner func(sess *awssession.Session, manifestType string) cleaner
+
+	// Dependencies to list the resources to delete with --dry-run.
+	w                 io.Writer
+	getStackResources func(sess *awssession.Session) stackResourcesLister
+
+	// Dependencies to find the workloads that depend on the service.
+	ws              wsManifestReader // Nil if the command isn't run in a workspace.
+	getStackImports func(sess *awssession.Session) stackImportsLister
 }
 
-func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc delete"))
+func newDeleteSvcOpts(vars deleteSvcVars, sessProvider *sessions.Provider) (*deleteSvcOpts, error) {
 	defaultSession, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
@@ -96,6 +117,22 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 		getECR: func(sess *awssession.Session) imageRemover {
 			return ecr.New(sess)
 		},
+		getStackImports: func(sess *awssession.Session) stackImportsLister {
+			return awscloudformation.New(sess)
+		},
+		w: os.Stdout,
+		getStackResources: func(sess *awssession.Session) stackResourcesLister {
+			return awscloudformation.New(sess)
+		},
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err == nil {
+		opts.ws = ws
+	} else {
+		var errNoWorkspace *workspace.ErrWorkspaceNotFound
+		if !errors.As(err, &errNoWorkspace) {
+			return nil, err
+		}
 	}
 	opts.newSvcCleaner = func(sess *awssession.Session, manifestType string) cleaner {
 		if manifestType == manifestinfo.StaticSiteType {
@@ -138,7 +175,7 @@ func (o *deleteSvcOpts) Ask() error {
 			return err
 		}
 	}
-	if o.skipConfirmation {
+	if o.skipConfirmation || o.dryRun {
 		return nil
 	}
 
@@ -182,6 +219,23 @@ func (o *deleteSvcOpts) Execute() error {
 		return err
 	}
 
+	dependents, err := o.dependents(envs)
+	if err != nil {
+		return err
+	}
+	if len(dependents) > 0 {
+		if !o.force {
+			return &errSvcHasDependents{
+				name:       o.name,
+				dependents: dependents,
+			}
+		}
+		log.Warningf("Deleting service %s even though other workloads depend on it:\n%s", o.name, fmtSvcDependents(dependents))
+	}
+	if o.dryRun {
+		return o.writeDeletionPlan(envs)
+	}
+
 	if err := o.deleteStacks(wkld.Type, envs); err != nil {
 		return err
 	}
@@ -208,6 +262,28 @@ func (o *deleteSvcOpts) Execute() error {
 	return nil
 }
 
+// writeDeletionPlan writes the resources that deleting the service would remove.
+func (o *deleteSvcOpts) writeDeletionPlan(envs []*config.Environment) error {
+	var plan deletionPlan
+	for _, env := range envs {
+		sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		if err := plan.addStack(o.getStackResources(sess), stack.NameForWorkload(o.appName, env.Name, o.name)); err != nil {
+			return err
+		}
+	}
+	if !o.needsAppCleanup() {
+		plan.write(o.w, fmt.Sprintf("Deleting service %s from environment %s", o.name, o.envName))
+		return nil
+	}
+	plan.addRepos(clideploy.RepoName(o.appName, o.name), envs)
+	plan.add(deletionKindConfig, fmt.Sprintf("service %s", o.name), "")
+	plan.write(o.w, fmt.Sprintf("Deleting service %s from application %s", o.name, o.appName))
+	return nil
+}
+
 func (o *deleteSvcOpts) validateEnvName() error {
 	if _, err := o.targetEnv(); err != nil {
 		return err
@@ -267,6 +343,179 @@ func (o *deleteSvcOpts) appEnvironments() ([]*config.Environment, error) {
 	return envs, nil
 }
 
+// svcDependent is a workload or stack that depends on the service being deleted.
+type svcDependent struct {
+	name   string
+	env    string // Empty if the dependency applies to every environment.
+	reason string
+}
+
+func (d svcDependent) String() string {
+	if d.env == "" {
+		return fmt.Sprintf("%s %s", d.name, d.reason)
+	}
+	return fmt.Sprintf("%s in environment %s %s", d.name, d.env, d.reason)
+}
+
+func fmtSvcDependents(dependents []svcDependent) string {
+	var sb strings.Builder
+	for _, d := range dependents {
+		sb.WriteString(fmt.Sprintf("  - %s\n", d))
+	}
+	return sb.String()
+}
+
+// dependents returns the workloads that depend on the service: the stacks that import the outputs exported by the
+// service's stack in the environments, and the local workloads whose manifests reference it.
+func (o *deleteSvcOpts) dependents(envs []*config.Environment) ([]svcDependent, error) {
+	var dependents []svcDependent
+	for _, env := range envs {
+		sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, err
+		}
+		importers, err := o.stackImporters(o.getStackImports(sess), env.Name)
+		if err != nil {
+			return nil, err
+		}
+		dependents = append(dependents, importers...)
+	}
+	local, err := o.manifestDependents()
+	if err != nil {
+		return nil, err
+	}
+	dependents = append(dependents, local...)
+	sort.SliceStable(dependents, func(i, j int) bool {
+		return dependents[i].name < dependents[j].name
+	})
+	return dependents, nil
+}
+
+func (o *deleteSvcOpts) stackImporters(cfn stackImportsLister, env string) ([]svcDependent, error) {
+	stackName := stack.NameForWorkload(o.appName, env, o.name)
+	desc, err := cfn.Describe(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	var dependents []svcDependent
+	for _, output := range desc.Outputs {
+		if output.ExportName == nil {
+			continue
+		}
+		exportName := aws.StringValue(output.ExportName)
+		importers, err := cfn.ListImports(exportName)
+		if err != nil {
+			return nil, err
+		}
+		for _, importer := range importers {
+			if importer == stackName || isAddonsStackOf(importer, stackName) {
+				// The addons nested stack of the service is deleted along with it.
+				continue
+			}
+			dependents = append(dependents, svcDependent{
+				name:   strings.TrimPrefix(importer, fmt.Sprintf("%s-%s-", o.appName, env)),
+				env:    env,
+				reason: fmt.Sprintf("imports export %s", exportName),
+			})
+		}
+	}
+	return dependents, nil
+}
+
+// isAddonsStackOf returns true if name is the name that CloudFormation gives to the addons nested stack of the
+// stack parent, "<parent>-AddonsStack-<random suffix>", rather than the stack of another workload prefixed by parent.
+func isAddonsStackOf(name, parent string) bool {
+	suffix, ok := strings.CutPrefix(name, fmt.Sprintf("%s-%s-", parent, template.AddonsStackLogicalID))
+	return ok && suffix != "" && !strings.Contains(suffix, "-")
+}
+
+// manifestDependents returns the workloads in the workspace that subscribe to the topics of the service,
+// or whose variables reference its Service Connect or service discovery endpoint.
+func (o *deleteSvcOpts) manifestDependents() ([]svcDependent, error) {
+	if o.ws == nil {
+		return nil, nil
+	}
+	wklds, err := o.ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	var dependents []svcDependent
+	for _, wkld := range wklds {
+		if wkld == o.name {
+			continue
+		}
+		raw, err := o.ws.ReadWorkloadManifest(wkld)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest file for %s: %w", wkld, err)
+		}
+		refs, err := manifestReferences(wkld, raw, o.name, o.envName)
+		if err != nil {
+			return nil, err
+		}
+		dependents = append(dependents, refs...)
+	}
+	sort.Slice(dependents, func(i, j int) bool {
+		return dependents[i].String() < dependents[j].String()
+	})
+	return dependents, nil
+}
+
+// manifestReferences returns how the manifest of the workload wkld depends on the service name,
+// ignoring the overrides of the environments other than env if env isn't empty.
+func manifestReferences(wkld string, raw []byte, name, env string) ([]svcDependent, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(raw, &node); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for %s: %w", wkld, err)
+	}
+	fields := make(map[string]string)
+	walkYAMLScalars(&node, "", func(field, value string) {
+		fields[field] = value
+	})
+	endpoint := svcEndpointReference(name)
+	var refs []svcDependent
+	for field, value := range fields {
+		overridden := overriddenEnv(field)
+		if env != "" && overridden != "" && overridden != env {
+			continue
+		}
+		ref := svcDependent{
+			name: wkld,
+			env:  overridden,
+		}
+		switch {
+		case topicSubscriptionServiceField.MatchString(field) && value == name:
+			topic := fields[strings.TrimSuffix(field, "service")+"name"]
+			ref.reason = fmt.Sprintf("subscribes to topic %s", topic)
+		case strings.Contains("."+field, ".variables.") && endpoint.MatchString(value):
+			ref.reason = fmt.Sprintf("references %s in variable %s", value, field[strings.LastIndex(field, ".")+1:])
+		default:
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+// svcEndpointReference matches the values that reference the Service Connect or service discovery endpoint
+// of a service, optionally followed by a port and a path.
+func svcEndpointReference(name string) *regexp.Regexp {
+	return regexp.MustCompile(fmt.Sprintf(`(^|[/@])%s(\.([A-Za-z0-9-]+\.)*local)?(:[0-9]+)?(/.*)?$`, regexp.QuoteMeta(name)))
+}
+
+// overriddenEnv returns the environment whose override contains the manifest field, or an empty string.
+func overriddenEnv(field string) string {
+	const prefix = "environments."
+	if !strings.HasPrefix(field, prefix) {
+		return ""
+	}
+	env, _, _ := strings.Cut(strings.TrimPrefix(field, prefix), ".")
+	return env
+}
+
 func (o *deleteSvcOpts) deleteStacks(wkldType string, envs []*config.Environment) error {
 	for _, env := range envs {
 		sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
@@ -363,9 +612,15 @@ func buildSvcDeleteCmd() *cobra.Command {
   /code $ copilot svc delete --name test --app my-app
 
   Delete the "test" service without confirmation prompt.
-  /code $ copilot svc delete --name test --yes`,
+  /code $ copilot svc delete --name test --yes
+
+  Delete the "test" service even if other workloads depend on it.
+  /code $ copilot svc delete --name test --force
+
+  List the resources that deleting the "test" service would remove, without deleting them.
+  /code $ copilot svc delete --name test --dry-run`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeleteSvcOpts(vars)
+			opts, err := newDeleteSvcOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("svc delete")))
 			if err != nil {
 				return err
 			}
@@ -377,5 +632,8 @@ func buildSvcDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.force, forceFlag, false, svcDeleteForceFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, deleteDryRunFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(dryRunFlag, yesFlag)
 	return cmd
 }
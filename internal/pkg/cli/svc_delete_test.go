@@ -6,9 +6,13 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/cli/clean/cleantest"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -312,6 +316,9 @@ type deleteSvcMocks struct {
 	spinner        *mocks.Mockprogress
 	svcCFN         *mocks.MockwlDeleter
 	ecr            *mocks.MockimageRemover
+	stackImports   *mocks.MockstackImportsLister
+	stackResources *mocks.MockstackResourcesLister
+	ws             *mocks.MockwsManifestReader
 }
 
 func TestDeleteSvcOpts_Execute(t *testing.T) {
@@ -338,10 +345,13 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 		inSvcName string
 		opts      *deleteSvcOpts
 
+		inWorkspace bool
 		wkldCleaner cleaner
 		setupMocks  func(mocks deleteSvcMocks)
 
-		wantedError error
+		wantedError   error
+		wantedActions string
+		wantedOutput  string
 	}{
 		"happy path with no environment passed in as flag": {
 			opts: &deleteSvcOpts{
@@ -362,6 +372,10 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
 
+					// dependents
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					mocks.stackImports.EXPECT().Describe("badgoose-test-backend").Return(&awscloudformation.StackDescription{}, nil),
+
 					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
 					// deleteStacks
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
@@ -404,6 +418,10 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 
+					// dependents
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					mocks.stackImports.EXPECT().Describe("badgoose-test-backend").Return(&awscloudformation.StackDescription{}, nil),
+
 					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
 					// deleteStacks
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
@@ -451,6 +469,10 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 						Type: manifestinfo.LoadBalancedWebServiceType,
 					}, nil),
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
+
+					// dependents
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					mocks.stackImports.EXPECT().Describe("badgoose-test-backend").Return(&awscloudformation.StackDescription{}, nil),
 					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
 				)
 			},
@@ -476,6 +498,10 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 
+					// dependents
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					mocks.stackImports.EXPECT().Describe("badgoose-test-backend").Return(&awscloudformation.StackDescription{}, nil),
+
 					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
 					// deleteStacks
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(testError),
@@ -483,6 +509,138 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("delete service: %w", testError),
 		},
+		"error if other workloads depend on the service": {
+			opts: &deleteSvcOpts{
+				deleteSvcVars: deleteSvcVars{
+					appName: mockAppName,
+					envName: mockEnvName,
+					name:    mockSvcName,
+				},
+			},
+			inWorkspace: true,
+			setupMocks: func(mocks deleteSvcMocks) {
+				mocks.store.EXPECT().GetWorkload(mockAppName, mockSvcName).Return(&config.Workload{
+					Type: manifestinfo.BackendServiceType,
+				}, nil)
+				mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil)
+				mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil)
+				mocks.stackImports.EXPECT().Describe("badgoose-test-backend").Return(&awscloudformation.StackDescription{
+					Outputs: []*sdkcloudformation.Output{
+						{OutputKey: aws.String("DiscoveryServiceARN")},
+						{OutputKey: aws.String("Table"), ExportName: aws.String("badgoose-test-backend-Table")},
+					},
+				}, nil)
+				mocks.stackImports.EXPECT().ListImports("badgoose-test-backend-Table").Return([]string{
+					"badgoose-test-backend-AddonsStack-1A2B3C",
+					"badgoose-test-backend-worker",
+					"badgoose-test-frontend",
+				}, nil)
+				mocks.ws.EXPECT().ListWorkloads().Return([]string{"backend", "worker", "frontend"}, nil)
+				mocks.ws.EXPECT().ReadWorkloadManifest("worker").Return([]byte(`name: worker
+type: Worker Service
+subscribe:
+  topics:
+    - name: events
+      service: backend
+variables:
+  BACKEND_URL: http://backend:8080/api
+  BACKEND_KEY: backend-key
+environments:
+  prod:
+    variables:
+      BACKEND_URL: http://backend.prod.badgoose.local:8080
+`), nil)
+				mocks.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(`name: frontend
+type: Load Balanced Web Service
+variables:
+  API_URL: https://backend.example.com
+`), nil)
+				mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("service backend has 4 dependents"),
+			wantedActions: "The following workloads depend on service backend:\n" +
+				"  - backend-worker in environment test imports export badgoose-test-backend-Table\n" +
+				"  - frontend in environment test imports export badgoose-test-backend-Table\n" +
+				"  - worker references http://backend:8080/api in variable BACKEND_URL\n" +
+				"  - worker subscribes to topic events\n" +
+				"Remove the references from these workloads first, or run `copilot svc delete --name backend --force` to delete the service anyway.",
+		},
+		"delete the service with --force even if other workloads depend on it": {
+			opts: &deleteSvcOpts{
+				deleteSvcVars: deleteSvcVars{
+					appName: mockAppName,
+					envName: mockEnvName,
+					name:    mockSvcName,
+					force:   true,
+				},
+				newSvcCleaner: func(*session.Session, string) cleaner {
+					return &cleantest.Succeeds{}
+				},
+			},
+			setupMocks: func(mocks deleteSvcMocks) {
+				mocks.store.EXPECT().GetWorkload(mockAppName, mockSvcName).Return(&config.Workload{
+					Type: manifestinfo.BackendServiceType,
+				}, nil)
+				mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil)
+				mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil).Times(2)
+				mocks.stackImports.EXPECT().Describe("badgoose-test-backend").Return(&awscloudformation.StackDescription{
+					Outputs: []*sdkcloudformation.Output{
+						{OutputKey: aws.String("Table"), ExportName: aws.String("badgoose-test-backend-Table")},
+					},
+				}, nil)
+				mocks.stackImports.EXPECT().ListImports("badgoose-test-backend-Table").Return([]string{"badgoose-test-frontend"}, nil)
+				mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil)
+			},
+		},
+		"list the resources to delete with --dry-run without deleting them": {
+			opts: &deleteSvcOpts{
+				deleteSvcVars: deleteSvcVars{
+					appName: mockAppName,
+					name:    mockSvcName,
+					dryRun:  true,
+				},
+			},
+			setupMocks: func(mocks deleteSvcMocks) {
+				mocks.store.EXPECT().GetWorkload(mockAppName, mockSvcName).Return(&config.Workload{
+					Type: manifestinfo.BackendServiceType,
+				}, nil)
+				mocks.store.EXPECT().ListEnvironments(mockAppName).Return(mockEnvs, nil)
+				mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil).Times(2)
+				mocks.stackImports.EXPECT().Describe("badgoose-test-backend").Return(&awscloudformation.StackDescription{}, nil)
+				mocks.stackResources.EXPECT().TemplateBody("badgoose-test-backend").Return("Resources: {}", nil)
+				mocks.stackResources.EXPECT().StackResources("badgoose-test-backend").Return([]*awscloudformation.StackResource{
+					stackResource("TaskRole", "badgoose-test-backend-TaskRole", "AWS::IAM::Role"),
+				}, nil)
+			},
+			wantedOutput: `Deleting service backend from application badgoose would remove:
+
+  Type                   Name                            Data loss
+  ----                   ----                            ---------
+  CloudFormation stack   badgoose-test-backend           -
+  IAM role               badgoose-test-backend-TaskRole  -
+  ECR repository         badgoose/backend (us-west-2)    All the images in the repository
+  Copilot configuration  service backend                 -
+
+`,
+		},
+		"error if fail to describe the service stack": {
+			opts: &deleteSvcOpts{
+				deleteSvcVars: deleteSvcVars{
+					appName: mockAppName,
+					envName: mockEnvName,
+					name:    mockSvcName,
+				},
+			},
+			setupMocks: func(mocks deleteSvcMocks) {
+				mocks.store.EXPECT().GetWorkload(mockAppName, mockSvcName).Return(&config.Workload{
+					Type: manifestinfo.BackendServiceType,
+				}, nil)
+				mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil)
+				mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil)
+				mocks.stackImports.EXPECT().Describe("badgoose-test-backend").Return(nil, testError)
+			},
+			wantedError: errors.New("describe stack badgoose-test-backend: some error"),
+		},
 	}
 
 	for name, tc := range tests {
@@ -498,6 +656,9 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				spinner:        mocks.NewMockprogress(ctrl),
 				svcCFN:         mocks.NewMockwlDeleter(ctrl),
 				ecr:            mocks.NewMockimageRemover(ctrl),
+				stackImports:   mocks.NewMockstackImportsLister(ctrl),
+				stackResources: mocks.NewMockstackResourcesLister(ctrl),
+				ws:             mocks.NewMockwsManifestReader(ctrl),
 			}
 
 			tc.setupMocks(mocks)
@@ -512,6 +673,17 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			tc.opts.getECR = func(_ *session.Session) imageRemover {
 				return mocks.ecr
 			}
+			tc.opts.getStackImports = func(_ *session.Session) stackImportsLister {
+				return mocks.stackImports
+			}
+			tc.opts.getStackResources = func(_ *session.Session) stackResourcesLister {
+				return mocks.stackResources
+			}
+			b := new(strings.Builder)
+			tc.opts.w = b
+			if tc.inWorkspace {
+				tc.opts.ws = mocks.ws
+			}
 
 			// WHEN
 			err := tc.opts.Execute()
@@ -522,6 +694,12 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			if tc.wantedActions != "" {
+				var actionRecommender interface{ RecommendActions() string }
+				require.ErrorAs(t, err, &actionRecommender)
+				require.Equal(t, tc.wantedActions, actionRecommender.RecommendActions())
+			}
+			require.Equal(t, tc.wantedOutput, b.String())
 		})
 	}
 }
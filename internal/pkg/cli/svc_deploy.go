@@ -10,12 +10,14 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -51,9 +53,19 @@ type deployWkldVars struct {
 	forceNewUpdate     bool // NOTE: this variable is not applicable for a job workload currently.
 	disableRollback    bool
 	showDiff           bool
+	showConfig         bool
+	estimateCost       bool
 	skipDiffPrompt     bool
 	allowWkldDowngrade bool
 	detach             bool
+	changeSetOnly      bool
+	noRetry            bool
+	noCache            bool
+	maxContextSize     byteSize
+	maxParallelBuilds  uint
+	requireProvenance  bool
+	watchAlarms        time.Duration
+	rollbackOnAlarm    bool
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -72,6 +84,13 @@ type deploySvcOpts struct {
 	svcVersionGetter     versionGetter
 	envFeaturesDescriber versionCompatibilityChecker
 	diffWriter           io.Writer
+	retrier              *clideploy.TransientRetrier
+	alarmGetter          alarmStatusGetter
+	stackRollbacker      workloadStackRollbacker
+	now                  func() time.Time
+	sleep                func(time.Duration)
+
+	newImageAttestationGetter func(region string) (imageAttestationGetter, error)
 
 	spinner        progress
 	sel            wsSelector
@@ -85,20 +104,21 @@ type deploySvcOpts struct {
 	svcType           string
 	appliedDynamicMft manifest.DynamicWorkload
 	rootUserARN       string
+	provenanceTags    map[string]string
 	deployRecs        clideploy.ActionRecommender
 	noDeploy          bool
+	previousStack     *deployedStack
 
 	// Overridden in tests.
 	templateVersion string
 }
 
-func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
+func newSvcDeployOpts(vars deployWkldVars, sessProvider *sessions.Provider) (*deploySvcOpts, error) {
 	ws, err := workspace.Use(afero.NewOsFs())
 	if err != nil {
 		return nil, err
 	}
 
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc deploy"))
 	defaultSession, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
@@ -120,7 +140,13 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		cmd:             exec.NewCmd(),
 		sessProvider:    sessProvider,
 		diffWriter:      os.Stdout,
+		retrier:         newTransientRetrier(vars),
 		templateVersion: version.LatestTemplateVersion(),
+		now:             time.Now,
+		sleep:           time.Sleep,
+		newImageAttestationGetter: func(region string) (imageAttestationGetter, error) {
+			return ecrClientInRegion(sessProvider, region)
+		},
 	}
 	opts.newSvcDeployer = func() (workloadDeployer, error) {
 		// NOTE: Defined as a struct member to facilitate unit testing.
@@ -138,12 +164,14 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read manifest file for %s: %w", o.name, err)
 	}
-	ovrdr, err := clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider)
+	ovrdr, err := clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider, overriderOptions(o.noCache)...)
 	if err != nil {
 		return nil, err
 	}
 
 	content := o.appliedDynamicMft.Manifest()
+	provenance := newProvenance(o.cmd, raw)
+	o.provenanceTags = provenance.Tags()
 	var deployer workloadDeployer
 	in := clideploy.WorkloadDeployerInput{
 		SessionProvider: o.sessProvider,
@@ -154,10 +182,13 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 			CustomTag:         o.imageTag,
 			GitShortCommitTag: o.gitShortCommit,
 		},
-		Mft:              content,
-		RawMft:           raw,
-		EnvVersionGetter: o.envFeaturesDescriber,
-		Overrider:        ovrdr,
+		Mft:                 content,
+		RawMft:              raw,
+		EnvVersionGetter:    o.envFeaturesDescriber,
+		Overrider:           ovrdr,
+		MaxBuildContextSize: int64(o.maxContextSize),
+		MaxParallelBuilds:   int(o.maxParallelBuilds),
+		Provenance:          provenance,
 	}
 	switch t := content.(type) {
 	case *manifest.LoadBalancedWebService:
@@ -165,6 +196,9 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 	case *manifest.BackendService:
 		deployer, err = clideploy.NewBackendDeployer(&in)
 	case *manifest.RequestDrivenWebService:
+		if !t.Source.IsEmpty() {
+			in.CodeRepository = codeRepositoryFromGit(o.cmd)
+		}
 		deployer, err = clideploy.NewRDWSDeployer(&in)
 	case *manifest.WorkerService:
 		deployer, err = clideploy.NewWorkerSvcDeployer(&in)
@@ -185,6 +219,21 @@ func newManifestInterpolator(app, env string) interpolator {
 
 // Validate returns an error for any invalid optional flags.
 func (o *deploySvcOpts) Validate() error {
+	if o.showConfig && !o.showDiff {
+		return fmt.Errorf("--%s must be specified with --%s", showConfigFlag, diffFlag)
+	}
+	if o.watchAlarms < 0 {
+		return fmt.Errorf("--%s must be a positive duration", watchAlarmsFlag)
+	}
+	if o.rollbackOnAlarm && o.watchAlarms == 0 {
+		return fmt.Errorf("--%s must be specified with --%s", rollbackOnAlarmFlag, watchAlarmsFlag)
+	}
+	if o.watchAlarms > 0 && o.detach {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", watchAlarmsFlag, detachFlag)
+	}
+	if o.watchAlarms > 0 && o.changeSetOnly {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", watchAlarmsFlag, changeSetOnlyFlag)
+	}
 	return nil
 }
 
@@ -240,6 +289,11 @@ func (o *deploySvcOpts) Execute() error {
 	if err := validateWorkloadManifestCompatibilityWithEnv(o.ws, o.envFeaturesDescriber, mft, o.envName); err != nil {
 		return err
 	}
+	if o.requireProvenance {
+		if err := verifyImageProvenance(o.name, mft.Manifest(), o.newImageAttestationGetter); err != nil {
+			return err
+		}
+	}
 	deployer, err := o.newSvcDeployer()
 	if err != nil {
 		return err
@@ -252,7 +306,11 @@ func (o *deploySvcOpts) Execute() error {
 		log.Warningf(`%s might not be available in region %s; proceed with caution.
 `, o.svcType, o.targetEnv.Region)
 	}
-	uploadOut, err := deployer.UploadArtifacts()
+	var uploadOut *clideploy.UploadArtifactsOutput
+	err = o.retrier.Do("upload deploy resources", func() (err error) {
+		uploadOut, err = deployer.UploadArtifacts()
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("upload deploy resources for service %s: %w", o.name, err)
 	}
@@ -260,7 +318,10 @@ func (o *deploySvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	if o.showDiff {
+	// Services with a task definition warn about the environment variables and secrets that the deployment changes.
+	hasTaskDef := o.svcType == manifestinfo.LoadBalancedWebServiceType || o.svcType == manifestinfo.BackendServiceType ||
+		o.svcType == manifestinfo.WorkerServiceType
+	if o.showDiff || o.estimateCost || hasTaskDef {
 		output, err := deployer.GenerateCloudFormationTemplate(&clideploy.GenerateCloudFormationTemplateInput{
 			StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
 				RootUserARN:               o.rootUserARN,
@@ -276,40 +337,68 @@ func (o *deploySvcOpts) Execute() error {
 		if err != nil {
 			return fmt.Errorf("generate the template for workload %q against environment %q: %w", o.name, o.envName, err)
 		}
-		if err := diff(deployer, output.Template, o.diffWriter); err != nil {
-			var errHasDiff *errHasDiff
-			if !errors.As(err, &errHasDiff) {
+		if hasTaskDef {
+			warnVariablesDiff(deployer, o.name, output.Template, output.Parameters)
+		}
+		if o.showDiff {
+			if err := diff(deployer, output.Template, o.diffWriter); err != nil {
+				var errHasDiff *errHasDiff
+				if !errors.As(err, &errHasDiff) {
+					return err
+				}
+			}
+		}
+		if o.showConfig {
+			if err := configDiff(deployer, output.Template, output.Parameters, o.diffWriter); err != nil {
 				return err
 			}
 		}
-		contd, err := o.skipDiffPrompt, nil
-		if !o.skipDiffPrompt {
-			contd, err = o.prompt.Confirm(continueDeploymentPrompt, "")
+		if o.estimateCost {
+			if err := costEstimate(deployer, output.Template, output.Parameters, o.diffWriter); err != nil {
+				return err
+			}
 		}
-		if err != nil {
-			return fmt.Errorf("ask whether to continue with the deployment: %w", err)
+	}
+	if o.showDiff || o.estimateCost {
+		contd := o.skipDiffPrompt
+		if !o.skipDiffPrompt {
+			if contd, err = o.prompt.Confirm(continueDeploymentPrompt, ""); err != nil {
+				return fmt.Errorf("ask whether to continue with the deployment: %w", err)
+			}
 		}
 		if !contd {
 			o.noDeploy = true
 			return nil
 		}
 	}
-	deployRecs, err := deployer.DeployWorkload(&clideploy.DeployWorkloadInput{
+	deployIn := &clideploy.DeployWorkloadInput{
 		StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
 			ImageDigests:              uploadOut.ImageDigests,
 			EnvFileARNs:               uploadOut.EnvFileARNs,
 			AddonsURL:                 uploadOut.AddonsURL,
 			RootUserARN:               o.rootUserARN,
-			Tags:                      tags.Merge(targetApp.Tags, o.resourceTags),
+			Tags:                      tags.Merge(targetApp.Tags, o.resourceTags, o.provenanceTags),
 			CustomResourceURLs:        uploadOut.CustomResourceURLs,
 			StaticSiteAssetMappingURL: uploadOut.StaticSiteAssetMappingLocation,
 			Version:                   o.templateVersion,
 		},
 		Options: clideploy.Options{
-			ForceNewUpdate:  o.forceNewUpdate,
-			DisableRollback: o.disableRollback,
-			Detach:          o.detach,
+			ForceNewUpdate:      o.forceNewUpdate,
+			DisableRollback:     o.disableRollback,
+			Detach:              o.detach,
+			CreateChangeSetOnly: o.changeSetOnly,
 		},
+	}
+	if o.rollbackOnAlarm {
+		stackName := stack.NameForWorkload(o.appName, o.envName, o.name)
+		if o.previousStack, err = previousWorkloadStack(o.stackRollbacker, stackName); err != nil {
+			return err
+		}
+	}
+	var deployRecs clideploy.ActionRecommender
+	err = stackRetrier(o.retrier, o.disableRollback).Do("deploy the stack", func() (err error) {
+		deployRecs, err = deployer.DeployWorkload(deployIn)
+		return err
 	})
 	if err != nil {
 		var errStackDeletedOnInterrupt *deploycfn.ErrStackDeletedOnInterrupt
@@ -340,17 +429,72 @@ After fixing the deployment, you can:
 		}
 		return fmt.Errorf("deploy service %s to environment %s: %w", o.name, o.envName, err)
 	}
+	if o.changeSetOnly {
+		log.Infof("Run %s once the change set is approved to deploy service %s.\n", color.HighlightCode("copilot deployment execute <change set ARN>"), color.HighlightUserInput(o.name))
+		return nil
+	}
 	if o.detach {
 		return nil
 	}
 	log.Successf("Deployed service %s.\n", color.HighlightUserInput(o.name))
 	o.deployRecs = deployRecs
+	if o.watchAlarms > 0 {
+		return o.watchServiceAlarms()
+	}
+	return nil
+}
+
+// watchServiceAlarms reports the state changes of the alarms of the service after it's deployed,
+// and deploys the previous configuration of the stack again if an alarm goes off and --rollback-on-alarm is set.
+func (o *deploySvcOpts) watchServiceAlarms() error {
+	watcher := &alarmWatcher{
+		app:      o.appName,
+		env:      o.envName,
+		svc:      o.name,
+		alarms:   o.alarmGetter,
+		now:      o.now,
+		sleep:    o.sleep,
+		interval: defaultAlarmPollInterval,
+	}
+	alarm, err := watcher.Watch(o.watchAlarms, o.rollbackOnAlarm)
+	if err != nil {
+		return fmt.Errorf("watch alarms of service %s: %w", o.name, err)
+	}
+	if alarm == nil {
+		return nil
+	}
+	if err := o.rollbackStack(); err != nil {
+		return fmt.Errorf("roll back service %s after alarm %s went off: %w", o.name, alarm.Name, err)
+	}
+	return &errAlarmRolledBack{svc: o.name, alarm: *alarm}
+}
+
+// rollbackStack deploys the stack of the service again with the template and parameters it had before the deployment.
+func (o *deploySvcOpts) rollbackStack() error {
+	if o.previousStack == nil {
+		log.Warningf("Service %s was deployed for the first time, so there is no previous configuration to roll back to.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	targetApp, err := o.getTargetApp()
+	if err != nil {
+		return err
+	}
+	resources, err := o.stackRollbacker.GetAppResourcesByRegion(targetApp, o.targetEnv.Region)
+	if err != nil {
+		return fmt.Errorf("get application %s resources from region %s: %w", targetApp.Name, o.targetEnv.Region, err)
+	}
+	err = o.stackRollbacker.DeployService(o.previousStack, resources.S3Bucket, false, awscfn.WithRoleARN(o.targetEnv.ExecutionRoleARN))
+	var errEmptyChangeSet *awscfn.ErrChangeSetEmpty
+	if err != nil && !errors.As(err, &errEmptyChangeSet) {
+		return err
+	}
+	log.Successf("Rolled back service %s to its previous configuration.\n", color.HighlightUserInput(o.name))
 	return nil
 }
 
 // RecommendActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deploySvcOpts) RecommendActions() error {
-	if o.noDeploy || o.detach {
+	if o.noDeploy || o.detach || o.changeSetOnly {
 		return nil
 	}
 	var recommendations []string
@@ -445,9 +589,10 @@ func (o *deploySvcOpts) configureClients() error {
 	o.rootUserARN = caller.RootUserARN
 
 	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
-		App:         o.appName,
-		Env:         o.envName,
-		ConfigStore: o.store,
+		App:             o.appName,
+		Env:             o.envName,
+		ConfigStore:     o.store,
+		SessionProvider: o.sessProvider,
 	})
 	if err != nil {
 		return err
@@ -459,11 +604,15 @@ func (o *deploySvcOpts) configureClients() error {
 		Env:         o.envName,
 		Name:        o.name,
 		ConfigStore: o.store,
+
+		SessionProvider: o.sessProvider,
 	})
 	if err != nil {
 		return err
 	}
 	o.svcVersionGetter = wkldDescriber
+	o.alarmGetter = cloudwatch.New(envSess)
+	o.stackRollbacker = deploycfn.New(envSess, deploycfn.WithProgressTracker(os.Stderr))
 	return nil
 }
 
@@ -511,6 +660,9 @@ func validateWorkloadManifestCompatibilityWithEnv(ws wsEnvironmentsLister, env v
 	if currVersion == version.EnvTemplateBootstrap {
 		return fmt.Errorf(`cannot deploy a service to an undeployed environment. Please run "copilot env deploy --name %s" to deploy the environment first`, envName)
 	}
+	if msg := newerVersionWarning("environment", envName, currVersion, version.LatestTemplateVersion()); msg != "" {
+		log.Warningln(msg)
+	}
 	availableFeatures, err := env.AvailableFeatures()
 	if err != nil {
 		return fmt.Errorf("get available features of the %s environment stack: %w", envName, err)
@@ -541,6 +693,18 @@ func validateWorkloadManifestCompatibilityWithEnv(ws wsEnvironmentsLister, env v
 	return nil
 }
 
+// newerVersionWarning returns a warning if a stack was last deployed by a newer version of Copilot than this one,
+// so that workloads deployed with an older version don't silently miss the features of the stack.
+// Development builds, whose version isn't a semantic version, are never warned about.
+func newerVersionWarning(componentType, name, deployedVersion, cliVersion string) string {
+	if !semver.IsValid(cliVersion) || semver.Compare(deployedVersion, cliVersion) <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("The %s %q was last deployed by Copilot %s, which is newer than this version %s. "+
+		"Upgrade Copilot so that your deployment uses the same templates as the rest of your team.",
+		componentType, name, deployedVersion, cliVersion)
+}
+
 func validateWkldVersion(vg versionGetter, name, templateVersion string) error {
 	svcVersion, err := vg.Version()
 	if err != nil {
@@ -668,6 +832,44 @@ func (e *errHasDiff) ExitCode() int {
 	return 1
 }
 
+// configDiff writes the changes of the configuration of the task of the workload.
+func configDiff(differ configDiffer, tmpl, params string, writer io.Writer) error {
+	out, err := differ.ConfigDiff(tmpl, params)
+	if err != nil {
+		return fmt.Errorf("compare the task configuration to the deployed one: %w", err)
+	}
+	if _, err := writer.Write([]byte("\nTask configuration:\n" + out)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// warnVariablesDiff warns about the environment variables and secrets of the workload that the template adds, removes or changes,
+// since removing one by accident is a common cause of broken deployments. Failing to compare them doesn't stop the deployment.
+func warnVariablesDiff(differ variablesDiffer, name, tmpl, params string) {
+	out, err := differ.VariablesDiff(tmpl, params)
+	if err != nil {
+		log.Warningf("Failed to compare the environment variables and secrets of %s with the deployed ones: %v\n", name, err)
+		return
+	}
+	if out == "" {
+		return
+	}
+	log.Warningf("This deployment changes the environment variables and secrets of %s:\n%s", color.HighlightUserInput(name), out)
+}
+
+// costEstimate writes the estimated monthly cost of the template compared with the deployed stack.
+func costEstimate(estimator costEstimator, tmpl, params string, writer io.Writer) error {
+	out, err := estimator.EstimateCost(tmpl, params)
+	if err != nil {
+		return fmt.Errorf("estimate the monthly cost: %w", err)
+	}
+	if _, err := writer.Write([]byte("\n" + out)); err != nil {
+		return err
+	}
+	return nil
+}
+
 func diff(differ templateDiffer, tmpl string, writer io.Writer) error {
 	if out, err := differ.DeployDiff(tmpl); err != nil {
 		return err
@@ -683,6 +885,23 @@ func diff(differ templateDiffer, tmpl string, writer io.Writer) error {
 	return nil
 }
 
+// newTransientRetrier returns the retrier of the phases of a workload deployment, or nil if the retries are disabled.
+func newTransientRetrier(vars deployWkldVars) *clideploy.TransientRetrier {
+	if vars.noRetry {
+		return nil
+	}
+	return clideploy.NewTransientRetrier()
+}
+
+// stackRetrier returns the retrier of the stack deployment.
+// A stack whose rollback is disabled is left in its failed state so that it can be debugged, so it isn't retried.
+func stackRetrier(retrier *clideploy.TransientRetrier, disableRollback bool) *clideploy.TransientRetrier {
+	if disableRollback {
+		return nil
+	}
+	return retrier
+}
+
 // buildSvcDeployCmd builds the `svc deploy` subcommand.
 func buildSvcDeployCmd() *cobra.Command {
 	vars := deployWkldVars{}
@@ -694,9 +913,11 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Deploys a service and rolls it back if one of its alarms goes off in the next 10 minutes.
+  /code $ copilot svc deploy --watch-alarms 10m --rollback-on-alarm`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newSvcDeployOpts(vars)
+			opts, err := newSvcDeployOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("svc deploy")))
 			if err != nil {
 				return err
 			}
@@ -711,8 +932,18 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.showConfig, showConfigFlag, false, showConfigFlagDescription)
+	cmd.Flags().BoolVar(&vars.estimateCost, estimateCostFlag, false, estimateCostFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipDiffPrompt, diffAutoApproveFlag, false, diffAutoApproveFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 	cmd.Flags().BoolVar(&vars.detach, detachFlag, false, detachFlagDescription)
+	cmd.Flags().BoolVar(&vars.changeSetOnly, changeSetOnlyFlag, false, changeSetOnlyFlagDescription)
+	cmd.Flags().BoolVar(&vars.noRetry, noRetryFlag, false, noRetryFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
+	cmd.Flags().Var(&vars.maxContextSize, maxContextSizeFlag, maxContextSizeFlagDescription)
+	cmd.Flags().UintVar(&vars.maxParallelBuilds, maxParallelBuildsFlag, clideploy.DefaultMaxParallelBuilds, maxParallelBuildsFlagDescription)
+	cmd.Flags().BoolVar(&vars.requireProvenance, requireProvenanceFlag, false, requireProvenanceFlagDescription)
+	cmd.Flags().DurationVar(&vars.watchAlarms, watchAlarmsFlag, 0, watchAlarmsFlagDescription)
+	cmd.Flags().BoolVar(&vars.rollbackOnAlarm, rollbackOnAlarmFlag, false, rollbackOnAlarmFlagDescription)
 	return cmd
 }
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -24,7 +27,59 @@ import (
 )
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
-	// There is no validation for svc deploy.
+	testCases := map[string]struct {
+		inShowDiff        bool
+		inShowConfig      bool
+		inWatchAlarms     time.Duration
+		inRollbackOnAlarm bool
+		inDetach          bool
+
+		wantedError string
+	}{
+		"valid without flags": {},
+		"valid with --show-config and --diff": {
+			inShowDiff:   true,
+			inShowConfig: true,
+		},
+		"error if --show-config is specified without --diff": {
+			inShowConfig: true,
+			wantedError:  "--show-config must be specified with --diff",
+		},
+		"valid with --watch-alarms and --rollback-on-alarm": {
+			inWatchAlarms:     10 * time.Minute,
+			inRollbackOnAlarm: true,
+		},
+		"error if --rollback-on-alarm is specified without --watch-alarms": {
+			inRollbackOnAlarm: true,
+			wantedError:       "--rollback-on-alarm must be specified with --watch-alarms",
+		},
+		"error if --watch-alarms is specified with --detach": {
+			inWatchAlarms: 10 * time.Minute,
+			inDetach:      true,
+			wantedError:   "cannot specify both `--watch-alarms` and `--detach`",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					showDiff:        tc.inShowDiff,
+					showConfig:      tc.inShowConfig,
+					watchAlarms:     tc.inWatchAlarms,
+					rollbackOnAlarm: tc.inRollbackOnAlarm,
+					detach:          tc.inDetach,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
 }
 
 type svcDeployAskMocks struct {
@@ -153,9 +208,12 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 	mockErrStackNotFound := cloudformation.ErrStackNotFound{}
 	testCases := map[string]struct {
 		inShowDiff       bool
+		inShowConfig     bool
+		inEstimateCost   bool
 		inSkipDiffPrompt bool
 		inForceFlag      bool
 		inAllowDowngrade bool
+		inChangeSetOnly  bool
 		inSvcType        string
 		mock             func(m *deployMocks)
 		wantedDiff       string
@@ -331,6 +389,103 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 			},
 			wantedDiff: "mock diff",
 		},
+		"error if fail to compare the task configuration": {
+			inShowDiff:   true,
+			inShowConfig: true,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
+				m.mockDeployer.EXPECT().ConfigDiff(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+				m.mockDiffWriter = &strings.Builder{}
+			},
+			wantedError: errors.New("compare the task configuration to the deployed one: some error"),
+		},
+		"write the task configuration changes after the diff": {
+			inShowDiff:   true,
+			inShowConfig: true,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{
+					Template:   "mock template",
+					Parameters: "mock params",
+				}, nil)
+				m.mockDeployer.EXPECT().DeployDiff("mock template").Return("mock diff\n", nil)
+				m.mockDeployer.EXPECT().ConfigDiff("mock template", "mock params").Return("~ CPU: 256 -> 512\n", nil)
+				m.mockDiffWriter = &strings.Builder{}
+				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedDiff: "mock diff\n\nTask configuration:\n~ CPU: 256 -> 512\n",
+		},
+		"error if fail to estimate the monthly cost": {
+			inEstimateCost: true,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
+				m.mockDeployer.EXPECT().EstimateCost(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+				m.mockDiffWriter = &strings.Builder{}
+			},
+			wantedError: errors.New("estimate the monthly cost: some error"),
+		},
+		"write the estimated monthly cost without the diff before asking to continue": {
+			inEstimateCost: true,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{
+					Template:   "mock template",
+					Parameters: "mock params",
+				}, nil)
+				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Times(0)
+				m.mockDeployer.EXPECT().EstimateCost("mock template", "mock params").Return("Estimated monthly cost in us-west-2:\n", nil)
+				m.mockDiffWriter = &strings.Builder{}
+				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedDiff: "\nEstimated monthly cost in us-west-2:\n",
+		},
 		"error if fail to ask whether to continue the deployment": {
 			inShowDiff: true,
 			mock: func(m *deployMocks) {
@@ -457,6 +612,75 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 			},
 		},
+		"only creates the change set of the stack": {
+			inChangeSetOnly: true,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).DoAndReturn(func(in *clideploy.DeployWorkloadInput) (clideploy.ActionRecommender, error) {
+					require.True(t, in.CreateChangeSetOnly)
+					return nil, nil
+				})
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+			},
+		},
+		"warn about the changes of the environment variables and secrets of a service before deploying it": {
+			inSvcType: manifestinfo.LoadBalancedWebServiceType,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{
+					Template:   "template",
+					Parameters: "params",
+				}, nil)
+				m.mockDeployer.EXPECT().VariablesDiff("template", "params").Return("  Container frontend:\n    Environment variables:\n      - DB_HOST: db.local\n", nil)
+				m.mockPrompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil)
+			},
+		},
+		"deploy even if the environment variables and secrets can't be compared": {
+			inSvcType: manifestinfo.LoadBalancedWebServiceType,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{
+					Template:   "template",
+					Parameters: "params",
+				}, nil)
+				m.mockDeployer.EXPECT().VariablesDiff("template", "params").Return("", mockError)
+				m.mockPrompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil)
+			},
+		},
 		"success for new deployment": {
 			mock: func(m *deployMocks) {
 				m.mockVersionGetter.EXPECT().Version().Return("", &mockErrStackNotFound)
@@ -498,9 +722,12 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 					name:               mockSvcName,
 					envName:            mockEnvName,
 					showDiff:           tc.inShowDiff,
+					showConfig:         tc.inShowConfig,
+					estimateCost:       tc.inEstimateCost,
 					skipDiffPrompt:     tc.inSkipDiffPrompt,
 					forceNewUpdate:     tc.inForceFlag,
 					allowWkldDowngrade: tc.inAllowDowngrade,
+					changeSetOnly:      tc.inChangeSetOnly,
 					clientConfigured:   true,
 				},
 				svcType: tc.inSvcType,
@@ -539,6 +766,88 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 	}
 }
 
+func TestSvcDeployOpts_watchServiceAlarms(t *testing.T) {
+	previous := &deployedStack{
+		name:     "phonetool-test-api",
+		template: "Resources: {}",
+	}
+	testCases := map[string]struct {
+		inRollbackOnAlarm bool
+		inPreviousStack   *deployedStack
+		setupMocks        func(alarms *mocks.MockalarmStatusGetter, rollbacker *mocks.MockworkloadStackRollbacker)
+
+		wantedErr string
+	}{
+		"only reports the alarms without --rollback-on-alarm": {
+			setupMocks: func(alarms *mocks.MockalarmStatusGetter, rollbacker *mocks.MockworkloadStackRollbacker) {
+				alarms.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil).Times(2)
+				alarms.EXPECT().AlarmStatuses(gomock.Any()).Return([]cloudwatch.AlarmStatus{{Name: "mem", Status: "OK"}}, nil)
+				alarms.EXPECT().AlarmStatuses(gomock.Any()).Return([]cloudwatch.AlarmStatus{{Name: "mem", Status: "ALARM"}}, nil)
+			},
+		},
+		"rolls back the stack when an alarm goes off": {
+			inRollbackOnAlarm: true,
+			inPreviousStack:   previous,
+			setupMocks: func(alarms *mocks.MockalarmStatusGetter, rollbacker *mocks.MockworkloadStackRollbacker) {
+				alarms.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil).Times(2)
+				alarms.EXPECT().AlarmStatuses(gomock.Any()).Return([]cloudwatch.AlarmStatus{{Name: "mem", Status: "OK"}}, nil)
+				alarms.EXPECT().AlarmStatuses(gomock.Any()).Return([]cloudwatch.AlarmStatus{{Name: "mem", Status: "ALARM"}}, nil)
+				rollbacker.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: "bucket"}, nil)
+				rollbacker.EXPECT().DeployService(previous, "bucket", false, gomock.Any()).Return(nil)
+			},
+			wantedErr: "alarm mem of service api went into the ALARM state after the deployment",
+		},
+		"error rolling back the stack": {
+			inRollbackOnAlarm: true,
+			inPreviousStack:   previous,
+			setupMocks: func(alarms *mocks.MockalarmStatusGetter, rollbacker *mocks.MockworkloadStackRollbacker) {
+				alarms.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil).Times(2)
+				alarms.EXPECT().AlarmStatuses(gomock.Any()).Return([]cloudwatch.AlarmStatus{{Name: "mem", Status: "OK"}}, nil)
+				alarms.EXPECT().AlarmStatuses(gomock.Any()).Return([]cloudwatch.AlarmStatus{{Name: "mem", Status: "ALARM"}}, nil)
+				rollbacker.EXPECT().GetAppResourcesByRegion(gomock.Any(), gomock.Any()).Return(&stack.AppRegionalResources{S3Bucket: "bucket"}, nil)
+				rollbacker.EXPECT().DeployService(previous, "bucket", false, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: "roll back service api after alarm mem went off: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAlarms := mocks.NewMockalarmStatusGetter(ctrl)
+			mockRollbacker := mocks.NewMockworkloadStackRollbacker(ctrl)
+			tc.setupMocks(mockAlarms, mockRollbacker)
+
+			start := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+			elapsed := time.Duration(0)
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName:         "phonetool",
+					envName:         "test",
+					name:            "api",
+					watchAlarms:     defaultAlarmPollInterval,
+					rollbackOnAlarm: tc.inRollbackOnAlarm,
+				},
+				alarmGetter:     mockAlarms,
+				stackRollbacker: mockRollbacker,
+				now:             func() time.Time { return start.Add(elapsed) },
+				sleep:           func(d time.Duration) { elapsed += d },
+				targetApp:       &config.Application{Name: "phonetool"},
+				targetEnv:       &config.Environment{Region: "us-west-2"},
+				previousStack:   tc.inPreviousStack,
+			}
+
+			err := opts.watchServiceAlarms()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 type checkEnvironmentCompatibilityMocks struct {
 	ws                              *mocks.MockwsEnvironmentsLister
 	versionFeatureGetter            *mocks.MockversionCompatibilityChecker
@@ -645,3 +954,35 @@ func (m *mockWorkloadMft) Manifest() interface{} {
 func (m *mockWorkloadMft) RequiredEnvironmentFeatures() []string {
 	return m.mockRequiredEnvironmentFeatures()
 }
+
+func Test_newerVersionWarning(t *testing.T) {
+	testCases := map[string]struct {
+		deployedVersion string
+		cliVersion      string
+
+		wanted string
+	}{
+		"no warning if the stack was deployed by the same version": {
+			deployedVersion: "v1.32.0",
+			cliVersion:      "v1.32.0",
+		},
+		"no warning if the stack was deployed by an older version": {
+			deployedVersion: "v1.31.1",
+			cliVersion:      "v1.32.0",
+		},
+		"no warning for development builds": {
+			deployedVersion: "v1.32.0",
+			cliVersion:      "",
+		},
+		"warns if the stack was deployed by a newer version": {
+			deployedVersion: "v1.33.0",
+			cliVersion:      "v1.32.0",
+			wanted:          `The environment "test" was last deployed by Copilot v1.33.0, which is newer than this version v1.32.0. Upgrade Copilot so that your deployment uses the same templates as the rest of your team.`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, newerVersionWarning("environment", "test", tc.deployedVersion, tc.cliVersion))
+		})
+	}
+}
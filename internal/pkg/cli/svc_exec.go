@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"math/rand"
 	"strings"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -36,10 +38,13 @@ The task is chosen at random, and the first essential container is used.`
 
 	ssmPluginInstallPrompt = `Looks like the Session Manager plugin is not installed yet.
 Would you like to install the plugin to execute into the container?`
-	ssmPluginInstallPromptHelp = `You must install the Session Manager plugin on your local machine to be able to execute into the container
+	ssmPluginInstallPromptHelp = `You must install the Session Manager plugin on your local machine to be able to execute into the container.
+Copilot installs it in ~/.copilot/session-manager-plugin once it verifies its checksum, or system-wide with sudo otherwise.
 See https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html`
 	ssmPluginUpdatePrompt = `Looks like the Session Manager plugin is using version %s.
-Would you like to update it to the latest version %s?`
+Would you like to update it to version %s?`
+
+	execCheckNameSSMPlugin = "Session Manager plugin"
 )
 
 var (
@@ -55,6 +60,10 @@ type svcExecOpts struct {
 	ssmPluginManager   ssmPluginManager
 	prompter           prompter
 	sessProvider       sessionProvider
+	newExecChecker     func(app, env, svc string) (execChecker, error)
+	w                  io.Writer
+
+	check bool // If true, diagnose why commands can't be executed instead of executing the command.
 	// Override in unit test
 	randInt func(int) int
 }
@@ -86,11 +95,24 @@ func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
 		ssmPluginManager: exec.NewSSMPluginCommand(nil),
 		prompter:         prompt.New(),
 		sessProvider:     sessProvider,
+		newExecChecker: func(app, env, svc string) (execChecker, error) {
+			return describe.NewExecChecker(describe.NewServiceConfig{
+				App:         app,
+				Env:         env,
+				Svc:         svc,
+				ConfigStore: ssmStore,
+			})
+		},
+		w: log.OutputWriter,
 	}, nil
 }
 
 // Validate returns an error for any invalid optional flags.
 func (o *svcExecOpts) Validate() error {
+	if o.check {
+		// The plugin is diagnosed with the other requirements instead of being installed.
+		return nil
+	}
 	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
 }
 
@@ -114,6 +136,9 @@ func (o *svcExecOpts) Execute() error {
 	if wkld.Type == manifestinfo.RequestDrivenWebServiceType {
 		return fmt.Errorf("executing a command in a running container part of a service is not supported for services with type: '%s'", manifestinfo.RequestDrivenWebServiceType)
 	}
+	if o.check {
+		return o.diagnose()
+	}
 	sess, err := o.envSession()
 	if err != nil {
 		return err
@@ -144,6 +169,51 @@ func (o *svcExecOpts) Execute() error {
 	return nil
 }
 
+// diagnose writes whether the local machine and the deployed service meet the requirements of ECS Exec.
+func (o *svcExecOpts) diagnose() error {
+	checker, err := o.newExecChecker(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("create exec checker for service %s: %w", o.name, err)
+	}
+	diagnosis, err := checker.Check()
+	if err != nil {
+		return fmt.Errorf("check exec requirements of service %s in environment %s: %w", o.name, o.envName, err)
+	}
+	diagnosis.Checks = append([]*describe.ExecCheck{o.checkSSMPlugin()}, diagnosis.Checks...)
+	fmt.Fprint(o.w, diagnosis.HumanString())
+	if diagnosis.Failed() {
+		return fmt.Errorf("commands can't be executed in service %s in environment %s", o.name, o.envName)
+	}
+	return nil
+}
+
+func (o *svcExecOpts) checkSSMPlugin() *describe.ExecCheck {
+	err := o.ssmPluginManager.ValidateBinary()
+	if err == nil {
+		return &describe.ExecCheck{
+			Name:   execCheckNameSSMPlugin,
+			Status: describe.ExecCheckPassed,
+			Detail: "An up-to-date version of the plugin is installed.",
+		}
+	}
+	check := &describe.ExecCheck{
+		Name:   execCheckNameSSMPlugin,
+		Status: describe.ExecCheckFailed,
+		Detail: fmt.Sprintf("Failed to validate the plugin: %v.", err),
+		Remedy: `Run "copilot svc exec" without --check to install the plugin.`,
+	}
+	var errNotExist *exec.ErrSSMPluginNotExist
+	var errOutdated *exec.ErrOutdatedSSMPlugin
+	switch {
+	case errors.As(err, &errNotExist):
+		check.Detail = "The plugin is not installed."
+	case errors.As(err, &errOutdated):
+		check.Detail = fmt.Sprintf("The plugin uses version %s instead of version %s.", errOutdated.CurrentVersion, errOutdated.LatestVersion)
+		check.Remedy = `Run "copilot svc exec" without --check to update the plugin.`
+	}
+	return check
+}
+
 func (o *svcExecOpts) validateOrAskApp() error {
 	if o.appName != "" {
 		_, err := o.store.GetApplication(o.appName)
@@ -230,7 +300,6 @@ func validateSSMBinary(prompt prompter, manager ssmPluginManager, skipConfirmati
 	}
 	switch v := err.(type) {
 	case *exec.ErrSSMPluginNotExist:
-		// If ssm plugin is not install, prompt users to install the plugin.
 		if skipConfirmation == nil {
 			confirmInstall, err := prompt.Confirm(ssmPluginInstallPrompt, ssmPluginInstallPromptHelp)
 			if err != nil {
@@ -240,6 +309,14 @@ func validateSSMBinary(prompt prompter, manager ssmPluginManager, skipConfirmati
 				return errSSMPluginCommandInstallCancelled
 			}
 		}
+		// Install the plugin in Copilot's data directory first, which doesn't require elevated permissions.
+		localErr := manager.InstallLocalBinary()
+		if localErr == nil {
+			log.Successln("Installed the Session Manager plugin in Copilot's data directory.")
+			return nil
+		}
+		log.Warningf("Failed to install the Session Manager plugin in Copilot's data directory: %v\n", localErr)
+		log.Infoln("Installing the Session Manager plugin system-wide instead.")
 		if err := manager.InstallLatestBinary(); err != nil {
 			return fmt.Errorf("install ssm plugin: %w", err)
 		}
@@ -259,6 +336,13 @@ func validateSSMBinary(prompt prompter, manager ssmPluginManager, skipConfirmati
 				return nil
 			}
 		}
+		if v.Local {
+			// The plugin installed by Copilot keeps working if the latest version can't be verified yet.
+			if err := manager.InstallLocalBinary(); err != nil {
+				log.Warningf("Failed to update the Session Manager plugin in Copilot's data directory: %v\n", err)
+			}
+			return nil
+		}
 		if err := manager.InstallLatestBinary(); err != nil {
 			return fmt.Errorf("update ssm plugin: %w", err)
 		}
@@ -274,7 +358,7 @@ https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-wor
 // buildSvcExecCmd builds the command for execute a running container in a service.
 func buildSvcExecCmd() *cobra.Command {
 	vars := execVars{}
-	var skipPrompt bool
+	var skipPrompt, check bool
 	cmd := &cobra.Command{
 		Use:   "exec",
 		Short: "Execute a command in a running container part of a service.",
@@ -282,12 +366,15 @@ func buildSvcExecCmd() *cobra.Command {
   Start an interactive bash session with a task part of the "frontend" service.
   /code $ copilot svc exec -a my-app -e test -n frontend
   Runs the 'ls' command in the task prefixed with ID "8c38184" within the "backend" service.
-  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"`,
+  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"
+  Diagnose why commands can't be executed in the "frontend" service.
+  /code $ copilot svc exec -e test -n frontend --check`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcExecOpts(vars)
 			if err != nil {
 				return err
 			}
+			opts.check = check
 			if cmd.Flags().Changed(yesFlag) {
 				opts.skipConfirmation = aws.Bool(false)
 				if skipPrompt {
@@ -304,6 +391,7 @@ func buildSvcExecCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
 	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+	cmd.Flags().BoolVar(&check, execCheckFlag, false, execCheckFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
 	return cmd
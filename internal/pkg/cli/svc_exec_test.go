@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -99,6 +101,7 @@ func TestSvcExec_Validate(t *testing.T) {
 					m.ssmPluginManager.EXPECT().ValidateBinary().Return(&exec.ErrSSMPluginNotExist{}),
 					m.prompter.EXPECT().Confirm(ssmPluginInstallPrompt, ssmPluginInstallPromptHelp).
 						Return(true, nil),
+					m.ssmPluginManager.EXPECT().InstallLocalBinary().Return(mockErr),
 					m.ssmPluginManager.EXPECT().InstallLatestBinary().Return(mockErr),
 				)
 			},
@@ -176,6 +179,64 @@ func TestSvcExec_Validate(t *testing.T) {
 
 			wantedError: nil,
 		},
+		"install ssm plugin in the data directory once confirmed": {
+			inputApp: mockApp,
+			inputEnv: mockEnv,
+			inputSvc: mockSvc,
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.ssmPluginManager.EXPECT().ValidateBinary().Return(&exec.ErrSSMPluginNotExist{}),
+					m.prompter.EXPECT().Confirm(ssmPluginInstallPrompt, ssmPluginInstallPromptHelp).Return(true, nil),
+					m.ssmPluginManager.EXPECT().InstallLocalBinary().Return(nil),
+				)
+			},
+		},
+		"install ssm plugin in the data directory without prompting if yes flag is set": {
+			inputApp:         mockApp,
+			inputEnv:         mockEnv,
+			inputSvc:         mockSvc,
+			skipConfirmation: aws.Bool(true),
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.ssmPluginManager.EXPECT().ValidateBinary().Return(&exec.ErrSSMPluginNotExist{}),
+					m.ssmPluginManager.EXPECT().InstallLocalBinary().Return(nil),
+				)
+			},
+		},
+		"update the ssm plugin of the data directory once confirmed": {
+			inputApp: mockApp,
+			inputEnv: mockEnv,
+			inputSvc: mockSvc,
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.ssmPluginManager.EXPECT().ValidateBinary().Return(&exec.ErrOutdatedSSMPlugin{
+						CurrentVersion: "mockCurrentVersion",
+						LatestVersion:  "mockLatestVersion",
+						Local:          true,
+					}),
+					m.prompter.EXPECT().Confirm(fmt.Sprintf(ssmPluginUpdatePrompt, "mockCurrentVersion", "mockLatestVersion"), "").
+						Return(true, nil),
+					m.ssmPluginManager.EXPECT().InstallLocalBinary().Return(nil),
+				)
+			},
+		},
+		"should proceed with the current ssm plugin of the data directory if cannot update it": {
+			inputApp: mockApp,
+			inputEnv: mockEnv,
+			inputSvc: mockSvc,
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.ssmPluginManager.EXPECT().ValidateBinary().Return(&exec.ErrOutdatedSSMPlugin{
+						CurrentVersion: "mockCurrentVersion",
+						LatestVersion:  "mockLatestVersion",
+						Local:          true,
+					}),
+					m.prompter.EXPECT().Confirm(fmt.Sprintf(ssmPluginUpdatePrompt, "mockCurrentVersion", "mockLatestVersion"), "").
+						Return(true, nil),
+					m.ssmPluginManager.EXPECT().InstallLocalBinary().Return(mockErr),
+				)
+			},
+		},
 		"valid case with ssm plugin installing": {
 			inputApp: mockApp,
 			inputEnv: mockEnv,
@@ -184,6 +245,7 @@ func TestSvcExec_Validate(t *testing.T) {
 				gomock.InOrder(
 					m.ssmPluginManager.EXPECT().ValidateBinary().Return(&exec.ErrSSMPluginNotExist{}),
 					m.prompter.EXPECT().Confirm(ssmPluginInstallPrompt, ssmPluginInstallPromptHelp).Return(true, nil),
+					m.ssmPluginManager.EXPECT().InstallLocalBinary().Return(mockErr),
 					m.ssmPluginManager.EXPECT().InstallLatestBinary().Return(nil),
 				)
 			},
@@ -626,3 +688,102 @@ func TestSvcExec_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcExec_ExecuteCheck(t *testing.T) {
+	mockWl := config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Load Balanced Web Service",
+	}
+	passedChecks := func() []*describe.ExecCheck {
+		return []*describe.ExecCheck{
+			{Name: "Exec enabled", Status: "passed", Detail: "The service allows executing commands in its tasks."},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(store *mocks.Mockstore, plugin *mocks.MockssmPluginManager, checker *mocks.MockexecChecker)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"return error if fail to check the service": {
+			setupMocks: func(store *mocks.Mockstore, plugin *mocks.MockssmPluginManager, checker *mocks.MockexecChecker) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				checker.EXPECT().Check().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("check exec requirements of service mockSvc in environment mockEnv: some error"),
+		},
+		"writes the checks of the plugin and the service": {
+			setupMocks: func(store *mocks.Mockstore, plugin *mocks.MockssmPluginManager, checker *mocks.MockexecChecker) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				checker.EXPECT().Check().Return(&describe.ExecDiagnosis{Service: "mockSvc", Environment: "mockEnv", Checks: passedChecks()}, nil)
+				plugin.EXPECT().ValidateBinary().Return(nil)
+			},
+			wantedOutput: `Exec Checks
+
+  Check                   Status    Detail
+  -----                   ------    ------
+  Session Manager plugin  passed    An up-to-date version of the plugin is installed.
+  Exec enabled            passed    The service allows executing commands in its tasks.
+`,
+		},
+		"return error if the plugin is outdated": {
+			setupMocks: func(store *mocks.Mockstore, plugin *mocks.MockssmPluginManager, checker *mocks.MockexecChecker) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				checker.EXPECT().Check().Return(&describe.ExecDiagnosis{Service: "mockSvc", Environment: "mockEnv", Checks: passedChecks()}, nil)
+				plugin.EXPECT().ValidateBinary().Return(&exec.ErrOutdatedSSMPlugin{CurrentVersion: "1.2.30.0", LatestVersion: "1.2.463.0"})
+			},
+			wantedOutput: `Exec Checks
+
+  Check                   Status    Detail
+  -----                   ------    ------
+  Session Manager plugin  failed    The plugin uses version 1.2.30.0 instead of version 1.2.463.0.
+  Exec enabled            passed    The service allows executing commands in its tasks.
+
+Remedies
+
+  - Session Manager plugin: Run "copilot svc exec" without --check to update the plugin.
+`,
+			wantedError: errors.New("commands can't be executed in service mockSvc in environment mockEnv"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			plugin := mocks.NewMockssmPluginManager(ctrl)
+			checker := mocks.NewMockexecChecker(ctrl)
+			tc.setupMocks(store, plugin, checker)
+			b := &bytes.Buffer{}
+
+			opts := &svcExecOpts{
+				execVars: execVars{
+					name:    "mockSvc",
+					envName: "mockEnv",
+					appName: "mockApp",
+				},
+				store:            store,
+				ssmPluginManager: plugin,
+				newExecChecker: func(app, env, svc string) (execChecker, error) {
+					return checker, nil
+				},
+				w:     b,
+				check: true,
+			}
+
+			// WHEN
+			require.NoError(t, opts.Validate())
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
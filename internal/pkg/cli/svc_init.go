@@ -135,6 +135,7 @@ type initSvcVars struct {
 
 	port        uint16
 	ingressType string
+	preset      string
 }
 
 type initSvcOpts struct {
@@ -160,6 +161,7 @@ type initSvcOpts struct {
 	// For workspace validation.
 	wsAppName         string
 	wsPendingCreation bool
+	app               *config.Application // Nil if the app is pending creation.
 
 	// Cache variables
 	df                  dockerfileParser
@@ -251,10 +253,12 @@ func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 func (o *initSvcOpts) Validate() error {
 	// If this app is pending creation, we'll skip validation.
 	if !o.wsPendingCreation {
-		if err := validateWorkspaceApp(o.wsAppName, o.appName, o.store); err != nil {
+		app, err := validateWorkspaceApp(o.wsAppName, o.appName, o.store)
+		if err != nil {
 			return err
 		}
 		o.appName = o.wsAppName
+		o.app = app
 	}
 	if o.dockerfilePath != "" && o.image != "" {
 		return fmt.Errorf("--%s and --%s cannot be specified together", dockerFileFlag, imageFlag)
@@ -294,6 +298,9 @@ func (o *initSvcOpts) Validate() error {
 	if err := o.validateIngressType(); err != nil {
 		return err
 	}
+	if err := o.validatePreset(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -347,6 +354,9 @@ func (o *initSvcOpts) Ask() error {
 	if err := o.validateSvc(); err != nil {
 		return err
 	}
+	if err := o.validatePreset(); err != nil {
+		return err
+	}
 	if err := o.askIngressType(); err != nil {
 		return err
 	}
@@ -413,6 +423,7 @@ func (o *initSvcOpts) Execute() error {
 		HealthCheck: hc,
 		Private:     strings.EqualFold(o.ingressType, ingressTypeEnvironment),
 		FileUploads: o.staticAssets,
+		Preset:      o.preset,
 	})
 	if err != nil {
 		return err
@@ -480,6 +491,9 @@ func (o *initSvcOpts) validateSvc() error {
 	if err := validateSvcName(o.name, o.wkldType); err != nil {
 		return err
 	}
+	if err := validateNamingConvention(o.app, config.ServiceKind, o.name); err != nil {
+		return err
+	}
 	return o.validateDuplicateSvc()
 }
 
@@ -588,6 +602,21 @@ func (o *initSvcOpts) validateIngressType() error {
 	return fmt.Errorf("invalid ingress type %q: must be one of %s", o.ingressType, english.OxfordWordSeries(rdwsIngressOptions, "or"))
 }
 
+// validatePreset returns nil if the preset is empty, or is a preset of the manifest of the service type.
+// The service type is only checked once it's known.
+func (o *initSvcOpts) validatePreset() error {
+	if o.preset == "" {
+		return nil
+	}
+	if !contains(o.preset, manifest.LoadBalancedWebServicePresets) {
+		return fmt.Errorf("invalid preset %q: must be one of %s", o.preset, english.OxfordWordSeries(manifest.LoadBalancedWebServicePresets, "or"))
+	}
+	if o.wkldType != "" && o.wkldType != manifestinfo.LoadBalancedWebServiceType {
+		return fmt.Errorf("'--%s' must be specified with '--%s %q'", presetFlag, svcTypeFlag, manifestinfo.LoadBalancedWebServiceType)
+	}
+	return nil
+}
+
 func (o *initSvcOpts) askImage() error {
 	if o.image != "" {
 		return nil
@@ -822,19 +851,20 @@ func (o *initSvcOpts) askSvcPublishers() (err error) {
 	return nil
 }
 
-func validateWorkspaceApp(wsApp, inputApp string, store store) error {
+func validateWorkspaceApp(wsApp, inputApp string, store store) (*config.Application, error) {
 	if wsApp == "" {
 		// NOTE: This command is required to be executed under a workspace. We don't prompt for it.
-		return errNoAppInWorkspace
+		return nil, errNoAppInWorkspace
 	}
 	// This command must be run within the app's workspace.
 	if inputApp != "" && inputApp != wsApp {
-		return fmt.Errorf("cannot specify app %s because the workspace is already registered with app %s", inputApp, wsApp)
+		return nil, fmt.Errorf("cannot specify app %s because the workspace is already registered with app %s", inputApp, wsApp)
 	}
-	if _, err := store.GetApplication(wsApp); err != nil {
-		return fmt.Errorf("get application %s configuration: %w", wsApp, err)
+	app, err := store.GetApplication(wsApp)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s configuration: %w", wsApp, err)
 	}
-	return nil
+	return app, nil
 }
 
 func (o initSvcOpts) convertStringsToAssets(sources []string) ([]manifest.FileUpload, error) {
@@ -912,7 +942,10 @@ This command is also run as part of "copilot init".`,
   /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile
 
   Create a "subscribers" backend service.
-  /code $ copilot svc init --name subscribers --svc-type "Backend Service"`,
+  /code $ copilot svc init --name subscribers --svc-type "Backend Service"
+
+  Create a "chat" load balanced web service tuned for WebSocket connections.
+  /code $ copilot svc init --name chat --svc-type "Load Balanced Web Service" --preset websocket`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitSvcOpts(vars)
 			if err != nil {
@@ -943,6 +976,7 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().StringArrayVar(&vars.subscriptions, subscribeTopicsFlag, []string{}, subscribeTopicsFlagDescription)
 	cmd.Flags().BoolVar(&vars.noSubscribe, noSubscriptionFlag, false, noSubscriptionFlagDescription)
 	cmd.Flags().StringVar(&vars.ingressType, ingressTypeFlag, "", ingressTypeFlagDescription)
+	cmd.Flags().StringVar(&vars.preset, presetFlag, "", presetFlagDescription)
 	cmd.Flags().StringArrayVar(&vars.sourcePaths, sourcesFlag, nil, sourcesFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowAppDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
 
@@ -50,6 +50,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 		inNoSubscribe    bool
 		inIngressType    string
 		inSources        []string
+		inPreset         string
 
 		setupMocks     func(mocks *initSvcMocks)
 		mockFileSystem func(mockFS afero.Fs)
@@ -124,6 +125,26 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			},
 			wantedErr: errors.New(`invalid ingress type "invalid": must be one of Environment or Internet`),
 		},
+		"error if the preset is invalid": {
+			inSvcName: "chat",
+			inSvcType: "Load Balanced Web Service",
+			inPreset:  "realtime",
+
+			setupMocks: func(m *initSvcMocks) {
+				m.mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedErr: errors.New(`invalid preset "realtime": must be one of websocket`),
+		},
+		"error if the preset is used with another service type": {
+			inSvcName: "chat",
+			inSvcType: "Backend Service",
+			inPreset:  "websocket",
+
+			setupMocks: func(m *initSvcMocks) {
+				m.mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedErr: errors.New(`'--preset' must be specified with '--svc-type "Load Balanced Web Service"'`),
+		},
 		"error if sources flag used without Static Site type": {
 			inSvcName: "frontend",
 			inSvcType: "Load Balanced Web Service",
@@ -245,6 +266,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 					},
 					port:        tc.inSvcPort,
 					ingressType: tc.inIngressType,
+					preset:      tc.inPreset,
 				},
 				store:     m.mockStore,
 				fs:        &afero.Afero{Fs: afero.NewMemMapFs()},
@@ -295,6 +317,7 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 		inNoSubscribe       bool
 		inIngressType       string
 		inWsPendingCreation bool
+		inApp               *config.Application
 		mockFileSystem      func(mockFS afero.Fs)
 
 		setupMocks func(mocks *initSvcMocks)
@@ -311,6 +334,16 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 			inSvcName: "1234",
 			wantedErr: fmt.Errorf("service name 1234 is invalid: %s", errBasicNameRegexNotMatched),
 		},
+		"returns an error if the service name doesn't follow the naming conventions of the application": {
+			inSvcType: wantedSvcType,
+			inSvcName: wantedSvcName,
+			inApp: &config.Application{
+				Conventions: &config.NamingConventions{
+					Services: &config.NamingRule{Pattern: "svc-[a-z]+"},
+				},
+			},
+			wantedErr: errors.New(`service name "frontend" doesn't match the naming convention "svc-[a-z]+"`),
+		},
 		"prompt for service name": {
 			inSvcType:        wantedSvcType,
 			inSvcPort:        wantedSvcPort,
@@ -875,6 +908,7 @@ type: Request-Driven Web Service`), nil)
 				sourceSel:         m.mockSourceSel,
 				dockerEngine:      m.mockDockerEngine,
 				wsPendingCreation: tc.inWsPendingCreation,
+				app:               tc.inApp,
 				wsRoot:            m.mockCachedWSRoot,
 			}
 			if tc.mockFileSystem != nil {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/spf13/afero"
@@ -24,7 +25,9 @@ import (
 type listWkldVars struct {
 	appName                  string
 	shouldOutputJSON         bool
+	outputFormat             outputFormatVars
 	shouldShowLocalWorkloads bool
+	shouldShowDeployed       bool
 }
 
 type listSvcOpts struct {
@@ -33,6 +36,7 @@ type listSvcOpts struct {
 	// Interfaces to dependencies.
 	sel  appSelector
 	list workloadListWriter
+	out  *formattedWriter
 }
 
 func newListSvcOpts(vars listWkldVars) (*listSvcOpts, error) {
@@ -41,19 +45,33 @@ func newListSvcOpts(vars listWkldVars) (*listSvcOpts, error) {
 		return nil, err
 	}
 
-	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("svc ls")).Default()
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc ls"))
+	sess, err := sessProvider.Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
 	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	out := &formattedWriter{
+		format: vars.outputFormat,
+		w:      os.Stdout,
+	}
 	svcLister := &list.SvcListWriter{
 		Ws:    ws,
 		Store: store,
-		Out:   os.Stdout,
+		Out:   out,
 
 		ShowLocalSvcs: vars.shouldShowLocalWorkloads,
-		OutputJSON:    vars.shouldOutputJSON,
+		ShowDeployed:  vars.shouldShowDeployed,
+		OutputJSON:    vars.shouldOutputJSON || vars.outputFormat.isSet(),
+
+		NewStackDescriber: func(env *config.Environment) (list.StackDescriber, error) {
+			envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return cloudformation.New(envSess), nil
+		},
 	}
 
 	return &listSvcOpts{
@@ -61,12 +79,13 @@ func newListSvcOpts(vars listWkldVars) (*listSvcOpts, error) {
 
 		list: svcLister,
 		sel:  selector.NewAppEnvSelector(prompt.New(), store),
+		out:  out,
 	}, nil
 }
 
-// Validate is a no-op for this command.
+// Validate returns an error if the values provided by the user are invalid.
 func (o *listSvcOpts) Validate() error {
-	return nil
+	return o.outputFormat.validate()
 }
 
 // Ask prompts for and validates any required flags.
@@ -90,8 +109,7 @@ func (o *listSvcOpts) Execute() error {
 	if err := o.list.Write(o.appName); err != nil {
 		return err
 	}
-
-	return nil
+	return o.out.flush()
 }
 
 // buildSvcListCmd builds the command for listing services in an appication.
@@ -102,7 +120,9 @@ func buildSvcListCmd() *cobra.Command {
 		Short: "Lists all the services in an application.",
 		Example: `
   Lists all the services for the "myapp" application.
-  /code $ copilot svc ls --app myapp`,
+  /code $ copilot svc ls --app myapp
+  Shows the version of each service deployed in every environment.
+  /code $ copilot svc ls --deployed`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newListSvcOpts(vars)
 			if err != nil {
@@ -116,6 +136,9 @@ func buildSvcListCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.format, formatFlag, "", formatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.query, queryFlag, "", queryFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldShowLocalWorkloads, localFlag, false, localSvcFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowDeployed, deployedFlag, false, deployedSvcFlagDescription)
 	return cmd
 }
@@ -30,6 +30,7 @@ func TestListSvcOpts_Execute(t *testing.T) {
 					appName:          "coolapp",
 				},
 				list: mockLister,
+				out:  &formattedWriter{},
 			},
 			mocking: func() {
 				mockLister.EXPECT().
@@ -43,6 +44,7 @@ func TestListSvcOpts_Execute(t *testing.T) {
 					appName: "coolapp",
 				},
 				list: mockLister,
+				out:  &formattedWriter{},
 			},
 			mocking: func() {
 				mockLister.EXPECT().
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
 	"sort"
 	"time"
 
@@ -22,15 +23,20 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 const (
-	svcLogNamePrompt     = "Which service's logs would you like to show?"
-	svcLogNameHelpPrompt = "The logs of the indicated deployed service will be shown."
+	svcLogNamePrompt          = "Which service's logs would you like to show?"
+	svcLogNameHelpPrompt      = "The logs of the indicated deployed service will be shown."
+	svcLocalLogNamePrompt     = "Which service's local logs would you like to show?"
+	svcLocalLogNameHelpPrompt = "The logs that the containers of the indicated service wrote while it was run locally will be shown."
 
 	cwGetLogEventsLimitMin = 1
 	cwGetLogEventsLimitMax = 10000
@@ -58,9 +64,11 @@ type wkldLogsVars struct {
 type svcLogsVars struct {
 	wkldLogsVars
 
-	logGroup      string
-	containerName string
-	previous      bool
+	logGroup         string
+	containerName    string
+	previous         bool
+	latestDeployment bool
+	local            bool
 }
 
 type svcLogsOpts struct {
@@ -70,6 +78,11 @@ type svcLogsOpts struct {
 	// Cached variables.
 	targetEnv     *config.Environment
 	targetSvcType string
+
+	// Dependencies to read the logs of services run locally.
+	fs     afero.Fs
+	ws     workspacePathGetter
+	prompt prompter
 }
 
 type wkldLogOpts struct {
@@ -108,8 +121,21 @@ func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
 			deployStore: deployStore,
 			sel:         selector.NewDeploySelect(prompt.New(), configStore, deployStore),
 		},
+		fs:     afero.NewOsFs(),
+		prompt: prompt.New(),
+	}
+	if vars.local {
+		ws, err := workspace.Use(opts.fs)
+		if err != nil {
+			return nil, err
+		}
+		opts.ws = ws
 	}
 	opts.initRuntimeClients = func() error {
+		if opts.local {
+			opts.logsSvc = logging.NewLocalWorkloadLogger(opts.fs, logging.LocalLogsDir(opts.ws.Path(), opts.name))
+			return nil
+		}
 		env, err := opts.getTargetEnv()
 		if err != nil {
 			return fmt.Errorf("get environment: %w", err)
@@ -185,11 +211,22 @@ func (o *svcLogsOpts) Validate() error {
 			return err
 		}
 	}
+	if o.latestDeployment {
+		if err := o.validateLatestDeployment(); err != nil {
+			return err
+		}
+	}
+	if o.local {
+		return o.validateLocal()
+	}
 	return nil
 }
 
 // Ask prompts for and validates any required flags.
 func (o *svcLogsOpts) Ask() error {
+	if o.local {
+		return o.askLocalSvcName()
+	}
 	if err := o.validateOrAskApp(); err != nil {
 		return err
 	}
@@ -222,14 +259,15 @@ func (o *svcLogsOpts) Execute() error {
 		log.Infoln("previously stopped task:", taskID)
 	}
 	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
-		Follow:        o.follow,
-		Limit:         limit,
-		EndTime:       o.endTime,
-		StartTime:     o.startTime,
-		TaskIDs:       o.taskIDs,
-		OnEvents:      eventsWriter,
-		ContainerName: o.containerName,
-		LogGroup:      o.logGroup,
+		Follow:           o.follow,
+		Limit:            limit,
+		EndTime:          o.endTime,
+		StartTime:        o.startTime,
+		TaskIDs:          o.taskIDs,
+		OnEvents:         eventsWriter,
+		ContainerName:    o.containerName,
+		LogGroup:         o.logGroup,
+		LatestDeployment: o.latestDeployment,
 	})
 	if err != nil {
 		return fmt.Errorf("write log events for service %s: %w", o.name, err)
@@ -305,6 +343,69 @@ func (o *svcLogsOpts) validatePrevious() error {
 	return nil
 }
 
+func (o *svcLogsOpts) validateLatestDeployment() error {
+	switch {
+	case len(o.taskIDs) != 0:
+		return fmt.Errorf("cannot specify both --%s and --%s", latestDeploymentFlag, tasksFlag)
+	case o.previous:
+		return fmt.Errorf("cannot specify both --%s and --%s", latestDeploymentFlag, previousFlag)
+	case o.logGroup != "":
+		return fmt.Errorf("cannot specify both --%s and --%s", latestDeploymentFlag, logGroupFlag)
+	}
+	return nil
+}
+
+func (o *svcLogsOpts) validateLocal() error {
+	switch {
+	case o.envName != "":
+		return fmt.Errorf("cannot specify both --%s and --%s", localFlag, envFlag)
+	case len(o.taskIDs) != 0:
+		return fmt.Errorf("cannot specify both --%s and --%s", localFlag, tasksFlag)
+	case o.logGroup != "":
+		return fmt.Errorf("cannot specify both --%s and --%s", localFlag, logGroupFlag)
+	case o.previous:
+		return fmt.Errorf("cannot specify both --%s and --%s", localFlag, previousFlag)
+	case o.latestDeployment:
+		return fmt.Errorf("cannot specify both --%s and --%s", localFlag, latestDeploymentFlag)
+	}
+	return nil
+}
+
+// askLocalSvcName selects the service among the ones that wrote logs while they were run locally.
+func (o *svcLogsOpts) askLocalSvcName() error {
+	if o.name != "" {
+		return nil
+	}
+	dir := filepath.Join(o.ws.Path(), logging.LocalLogsDirName)
+	var names []string
+	if exists, _ := afero.DirExists(o.fs, dir); exists {
+		files, err := afero.ReadDir(o.fs, dir)
+		if err != nil {
+			return fmt.Errorf("read directory %s: %w", dir, err)
+		}
+		for _, file := range files {
+			if file.IsDir() {
+				names = append(names, file.Name())
+			}
+		}
+	}
+	switch len(names) {
+	case 0:
+		return fmt.Errorf("no local logs found in %s: run %s to write them", dir,
+			color.HighlightCode(fmt.Sprintf("copilot run local --%s %s", logsFormatFlag, logsFormatFile)))
+	case 1:
+		o.name = names[0]
+		log.Infof("Found local logs of service %s.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	name, err := o.prompt.SelectOne(svcLocalLogNamePrompt, svcLocalLogNameHelpPrompt, names, prompt.WithFinalMessage("Service name:"))
+	if err != nil {
+		return fmt.Errorf("select service with local logs: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
 func (o *svcLogsOpts) getTargetEnv() (*config.Environment, error) {
 	if o.targetEnv != nil {
 		return o.targetEnv, nil
@@ -349,8 +450,12 @@ func buildSvcLogsCmd() *cobra.Command {
   /code $ copilot svc logs --tasks 709c7eae05f947f6861b150372ddc443,1de57fd63c6a4920ac416d02add891b9
   Displays logs in real time.
   /code $ copilot svc logs --follow
+  Displays logs in real time from the tasks of the latest deployment only.
+  /code $ copilot svc logs --follow --latest-deployment
   Display logs from specific log group.
-  /code $ copilot svc logs --log-group system`,
+  /code $ copilot svc logs --log-group system
+  Displays the logs written by "copilot run local --logs-format file".
+  /code $ copilot svc logs -n my-svc --local`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcLogOpts(vars)
 			if err != nil {
@@ -365,12 +470,14 @@ func buildSvcLogsCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.humanStartTime, startTimeFlag, "", startTimeFlagDescription)
 	cmd.Flags().StringVar(&vars.humanEndTime, endTimeFlag, "", endTimeFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
-	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, svcFollowFlagDescription)
 	cmd.Flags().DurationVar(&vars.since, sinceFlag, 0, sinceFlagDescription)
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
 	cmd.Flags().StringVar(&vars.logGroup, logGroupFlag, "", logGroupFlagDescription)
 	cmd.Flags().BoolVarP(&vars.previous, previousFlag, previousFlagShort, false, previousFlagDescription)
+	cmd.Flags().BoolVar(&vars.latestDeployment, latestDeploymentFlag, false, latestDeploymentFlagDescription)
 	cmd.Flags().StringVar(&vars.containerName, containerLogFlag, "", containerLogFlagDescription)
+	cmd.Flags().BoolVar(&vars.local, localFlag, false, localLogsFlagDescription)
 	return cmd
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -50,6 +51,9 @@ func TestSvcLogs_Validate(t *testing.T) {
 		inputSince     time.Duration
 		inputPrevious  bool
 		inputTaskIDs   []string
+		inputLocal     bool
+		inputLatest    bool
+		inputLogGroup  string
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -117,6 +121,52 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("cannot specify both --previous and --tasks"),
 		},
+		"returns error if both local and env flags are defined": {
+			inputLocal:   true,
+			inputEnvName: "test",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("cannot specify both --local and --env"),
+		},
+		"returns error if both local and tasks flags are defined": {
+			inputLocal:   true,
+			inputTaskIDs: []string{"taskId"},
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("cannot specify both --local and --tasks"),
+		},
+		"returns error if both latest-deployment and tasks flags are defined": {
+			inputLatest:  true,
+			inputTaskIDs: []string{"taskId"},
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("cannot specify both --latest-deployment and --tasks"),
+		},
+		"returns error if both latest-deployment and log-group flags are defined": {
+			inputLatest:   true,
+			inputLogGroup: "system",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("cannot specify both --latest-deployment and --log-group"),
+		},
+		"returns error if both local and latest-deployment flags are defined": {
+			inputLocal:  true,
+			inputLatest: true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("cannot specify both --local and --latest-deployment"),
+		},
+		"valid latest-deployment flag": {
+			inputLatest: true,
+			inputFollow: true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -140,7 +190,10 @@ func TestSvcLogs_Validate(t *testing.T) {
 						appName:        tc.inputApp,
 						taskIDs:        tc.inputTaskIDs,
 					},
-					previous: tc.inputPrevious,
+					previous:         tc.inputPrevious,
+					local:            tc.inputLocal,
+					latestDeployment: tc.inputLatest,
+					logGroup:         tc.inputLogGroup,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
@@ -171,6 +224,7 @@ func TestSvcLogs_Ask(t *testing.T) {
 		inputSvc     string
 		inputEnvName string
 		inputTaskIDs []string
+		inputLatest  bool
 
 		setupMocks func(mocks wkldLogsMock)
 
@@ -286,6 +340,22 @@ func TestSvcLogs_Ask(t *testing.T) {
 			},
 			wantedError: errors.New("cannot use `--tasks` for App Runner service logs"),
 		},
+		"allow latest deployment for an RDWS": {
+			inputApp:    inputApp,
+			inputLatest: true,
+			setupMocks: func(m wkldLogsMock) {
+				m.configStore.EXPECT().GetApplication(gomock.Any()).AnyTimes()
+				m.sel.EXPECT().DeployedService(svcLogNamePrompt, svcLogNameHelpPrompt, inputApp, gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:     inputEnv,
+						Name:    inputSvc,
+						SvcType: manifestinfo.RequestDrivenWebServiceType,
+					}, nil)
+			},
+			wantedApp: inputApp,
+			wantedEnv: inputEnv,
+			wantedSvc: inputSvc,
+		},
 		"return error if selected svc is of Static Site type": {
 			inputApp: inputApp,
 			setupMocks: func(m wkldLogsMock) {
@@ -324,6 +394,7 @@ func TestSvcLogs_Ask(t *testing.T) {
 						appName: tc.inputApp,
 						taskIDs: tc.inputTaskIDs,
 					},
+					latestDeployment: tc.inputLatest,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
@@ -347,6 +418,93 @@ func TestSvcLogs_Ask(t *testing.T) {
 	}
 }
 
+func TestSvcLogs_AskLocal(t *testing.T) {
+	testCases := map[string]struct {
+		inputSvc   string
+		setupFs    func(fs afero.Fs)
+		setupMocks func(m *mocks.Mockprompter)
+
+		wantedSvc   string
+		wantedError error
+	}{
+		"does not read the workspace if the service name is passed in": {
+			inputSvc:  "my-svc",
+			wantedSvc: "my-svc",
+		},
+		"returns error if no service has local logs": {
+			wantedError: errors.New("no local logs found in /ws/.copilot/logs: run `copilot run local --logs-format file` to write them"),
+		},
+		"selects the only service with local logs": {
+			setupFs: func(fs afero.Fs) {
+				_ = fs.MkdirAll("/ws/.copilot/logs/my-svc", 0755)
+				_ = afero.WriteFile(fs, "/ws/.copilot/logs/README", []byte("not a service"), 0644)
+			},
+			wantedSvc: "my-svc",
+		},
+		"prompts for the service if several have local logs": {
+			setupFs: func(fs afero.Fs) {
+				_ = fs.MkdirAll("/ws/.copilot/logs/api", 0755)
+				_ = fs.MkdirAll("/ws/.copilot/logs/worker", 0755)
+			},
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(svcLocalLogNamePrompt, svcLocalLogNameHelpPrompt, []string{"api", "worker"}, gomock.Any()).Return("worker", nil)
+			},
+			wantedSvc: "worker",
+		},
+		"returns error if fail to select the service": {
+			setupFs: func(fs afero.Fs) {
+				_ = fs.MkdirAll("/ws/.copilot/logs/api", 0755)
+				_ = fs.MkdirAll("/ws/.copilot/logs/worker", 0755)
+			},
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select service with local logs: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockPrompt)
+			}
+			mockWs := mocks.NewMockworkspacePathGetter(ctrl)
+			mockWs.EXPECT().Path().Return("/ws").AnyTimes()
+			fs := afero.NewMemMapFs()
+			if tc.setupFs != nil {
+				tc.setupFs(fs)
+			}
+
+			svcLogs := &svcLogsOpts{
+				svcLogsVars: svcLogsVars{
+					wkldLogsVars: wkldLogsVars{
+						name: tc.inputSvc,
+					},
+					local: true,
+				},
+				fs:     fs,
+				ws:     mockWs,
+				prompt: mockPrompt,
+			}
+
+			// WHEN
+			err := svcLogs.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSvc, svcLogs.name)
+			}
+		})
+	}
+}
+
 func TestSvcLogs_Execute(t *testing.T) {
 	mockTaskARN := "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
 	mockOtherTaskARN := "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID1"
@@ -126,8 +126,8 @@ func TestOverrideSvc_Validate(t *testing.T) {
 			wanted error
 		}{
 			"return an error when an unknown language is selected": {
-				lang:   "python",
-				wanted: errors.New(`"python" is not a valid CDK language: must be one of: "typescript"`),
+				lang:   "java",
+				wanted: errors.New(`"java" is not a valid CDK language: must be one of: "typescript", "go", "python"`),
 			},
 			"typescript is a valid CDK language": {
 				lang: "typescript",
@@ -9,6 +9,9 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -16,15 +19,23 @@ import (
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/assertion"
 	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -35,6 +46,13 @@ const (
 	svcPackageEnvNamePrompt = "Which environment would you like to package this stack for?"
 )
 
+const (
+	// Output formats for "svc package".
+	composeOutputFormat = "compose"
+)
+
+var validSvcPackageOutputFormats = []string{composeOutputFormat}
+
 type packageSvcVars struct {
 	name               string
 	envName            string
@@ -43,7 +61,11 @@ type packageSvcVars struct {
 	outputDir          string
 	uploadAssets       bool
 	showDiff           bool
+	showConfig         bool
 	allowWkldDowngrade bool
+	noCache            bool
+	outputFormat       string
+	assertionsFile     string
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -60,6 +82,7 @@ type packageSvcOpts struct {
 	paramsWriter         io.WriteCloser
 	addonsWriter         io.WriteCloser
 	diffWriter           io.Writer
+	assertionsWriter     io.Writer
 	runner               execRunner
 	svcVersionGetter     versionGetter
 	sessProvider         *sessions.Provider
@@ -69,6 +92,12 @@ type packageSvcOpts struct {
 	newStackGenerator    func(*packageSvcOpts) (workloadStackGenerator, error)
 	envFeaturesDescriber versionCompatibilityChecker
 	gitShortCommit       string
+	getenv               func(string) string
+	newImageAttester     func(region string) (imageAttester, error)
+	taskDefGetter        taskDefinitionGetter
+	envOutputs           envOutputsGetter
+	subnetIPs            subnetIPsCounter
+	targetGroups         lbTargetGroupsLister
 
 	// cached variables
 	targetApp         *config.Application
@@ -108,10 +137,15 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		paramsWriter:      discardFile{},
 		addonsWriter:      discardFile{},
 		diffWriter:        os.Stdout,
+		assertionsWriter:  log.DiagnosticWriter,
 		templateVersion:   version.LatestTemplateVersion(),
 		newInterpolator:   newManifestInterpolator,
 		sessProvider:      sessProvider,
 		newStackGenerator: newWorkloadStackGenerator,
+		getenv:            os.Getenv,
+		newImageAttester: func(region string) (imageAttester, error) {
+			return ecrClientInRegion(sessProvider, region)
+		},
 	}
 	return opts, nil
 }
@@ -129,7 +163,7 @@ func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error
 	if err != nil {
 		return nil, fmt.Errorf("read manifest file for %s: %w", o.name, err)
 	}
-	ovrdr, err := clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, o.fs, o.sessProvider)
+	ovrdr, err := clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, o.fs, o.sessProvider, overriderOptions(o.noCache)...)
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +190,9 @@ func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error
 	case *manifest.BackendService:
 		deployer, err = clideploy.NewBackendDeployer(&in)
 	case *manifest.RequestDrivenWebService:
+		if !t.Source.IsEmpty() {
+			in.CodeRepository = codeRepositoryFromGit(o.runner)
+		}
 		deployer, err = clideploy.NewRDWSDeployer(&in)
 	case *manifest.WorkerService:
 		deployer, err = clideploy.NewWorkerSvcDeployer(&in)
@@ -174,6 +211,25 @@ func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error
 
 // Validate returns an error for any invalid optional flags.
 func (o *packageSvcOpts) Validate() error {
+	if o.showConfig && !o.showDiff {
+		return fmt.Errorf("--%s must be specified with --%s", showConfigFlag, diffFlag)
+	}
+	if o.outputFormat == "" {
+		return nil
+	}
+	if o.outputFormat != composeOutputFormat {
+		return fmt.Errorf("invalid output format %q: must be one of %s",
+			o.outputFormat, strings.Join(applyAll(validSvcPackageOutputFormats, strconv.Quote), ", "))
+	}
+	if o.uploadAssets {
+		return fmt.Errorf("--%s cannot be specified with --%s %s", uploadAssetsFlag, outputFormatFlag, o.outputFormat)
+	}
+	if o.showDiff {
+		return fmt.Errorf("--%s cannot be specified with --%s %s", diffFlag, outputFormatFlag, o.outputFormat)
+	}
+	if o.assertionsFile != "" {
+		return fmt.Errorf("--%s cannot be specified with --%s %s", assertFlag, outputFormatFlag, o.outputFormat)
+	}
 	return nil
 }
 
@@ -203,6 +259,9 @@ func (o *packageSvcOpts) Execute() error {
 			return err
 		}
 	}
+	if o.outputFormat == composeOutputFormat {
+		return o.writeComposeFile()
+	}
 	if !o.allowWkldDowngrade {
 		if err := validateWkldVersion(o.svcVersionGetter, o.name, o.templateVersion); err != nil {
 			return err
@@ -221,20 +280,34 @@ func (o *packageSvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	o.warnCapacity()
 	stack, err := o.getWorkloadStack(gen)
 	if err != nil {
 		return err
 	}
 	if o.showDiff {
-		if err := diff(gen, stack.template, o.diffWriter); err != nil {
+		err := diff(gen, stack.template, o.diffWriter)
+		if err != nil {
 			var errHasDiff *errHasDiff
-			if errors.As(err, &errHasDiff) {
-				return err
+			if !errors.As(err, &errHasDiff) {
+				return &errDiffNotAvailable{
+					parentErr: err,
+				}
 			}
-			return &errDiffNotAvailable{
-				parentErr: err,
+		}
+		if o.showConfig {
+			if err := configDiff(gen, stack.template, stack.parameters, o.diffWriter); err != nil {
+				return err
 			}
 		}
+		if err != nil {
+			return err
+		}
+	}
+	if o.assertionsFile != "" {
+		if err := o.assert(gen, stack.template); err != nil {
+			return err
+		}
 	}
 	if err := o.writeAndClose(o.templateWriter, stack.template); err != nil {
 		return err
@@ -258,6 +331,75 @@ func (o *packageSvcOpts) Execute() error {
 	return o.writeAndClose(o.addonsWriter, addonsTemplate)
 }
 
+// assert checks the service and addons templates against the assertions file, and writes a diff of each value that fails an assertion.
+func (o *packageSvcOpts) assert(gen workloadStackGenerator, tpl string) error {
+	raw, err := afero.ReadFile(o.fs, o.assertionsFile)
+	if err != nil {
+		return fmt.Errorf("read assertions file %s: %w", o.assertionsFile, err)
+	}
+	suite, err := assertion.ParseSuite(raw)
+	if err != nil {
+		return fmt.Errorf("parse assertions file %s: %w", o.assertionsFile, err)
+	}
+	failures, err := suite.Check(fmt.Sprintf(deploy.WorkloadCfnTemplateNameFormat, o.name, o.envName), tpl)
+	if err != nil {
+		return err
+	}
+	addonsTpl, err := gen.AddonsTemplate()
+	if err != nil {
+		return fmt.Errorf("retrieve addons template: %w", err)
+	}
+	if addonsTpl != "" {
+		addonsFailures, err := suite.Check(fmt.Sprintf(deploy.AddonsCfnTemplateNameFormat, o.name), addonsTpl)
+		if err != nil {
+			return err
+		}
+		failures = append(failures, addonsFailures...)
+	}
+	if len(failures) == 0 {
+		log.Successf("All %s passed.\n", english.Plural(len(suite.Assertions), "assertion", ""))
+		return nil
+	}
+	// Group the failures of the service and addons templates by assertion.
+	order := make(map[string]int, len(suite.Assertions))
+	for i, a := range suite.Assertions {
+		order[a.Name] = i
+	}
+	sort.SliceStable(failures, func(i, j int) bool {
+		return order[failures[i].Assertion] < order[failures[j].Assertion]
+	})
+	if err := assertion.WriteFailures(o.assertionsWriter, failures); err != nil {
+		return fmt.Errorf("write failed assertions: %w", err)
+	}
+	failed := make(map[string]bool)
+	for _, failure := range failures {
+		failed[failure.Assertion] = true
+	}
+	return &errAssertionsFailed{
+		failed: len(failed),
+		total:  len(suite.Assertions),
+	}
+}
+
+// warnCapacity warns if the environment can't fit the service at its maximum count.
+func (o *packageSvcOpts) warnCapacity() {
+	warnings, err := checkCapacity(checkCapacityInput{
+		name:         o.name,
+		envName:      o.envName,
+		mft:          o.appliedDynamicMft.Manifest(),
+		envOutputs:   o.envOutputs,
+		subnetIPs:    o.subnetIPs,
+		targetGroups: o.targetGroups,
+	})
+	if err != nil {
+		log.Warningf("Couldn't check the capacity of environment %s for service %s: %v\n", o.envName, o.name, err)
+		return
+	}
+	for _, warning := range warnings {
+		log.Warningln(warning)
+	}
+}
+
 func (o *packageSvcOpts) validateOrAskSvcName() error {
 	if o.name != "" {
 		names, err := o.ws.ListServices()
@@ -324,6 +466,7 @@ func (o *packageSvcOpts) configureClients() error {
 		return err
 	}
 	o.envFeaturesDescriber = envDescriber
+	o.envOutputs = envDescriber
 
 	wkldDescriber, err := describe.NewWorkloadStackDescriber(describe.NewWorkloadConfig{
 		App:         o.appName,
@@ -335,6 +478,9 @@ func (o *packageSvcOpts) configureClients() error {
 		return err
 	}
 	o.svcVersionGetter = wkldDescriber
+	o.taskDefGetter = ecs.New(envSess)
+	o.subnetIPs = ec2.New(envSess)
+	o.targetGroups = elbv2.New(envSess)
 	return nil
 }
 
@@ -376,6 +522,17 @@ func (o *packageSvcOpts) getWorkloadStack(generator workloadStackGenerator) (*cf
 			return nil, fmt.Errorf("upload resources required for deployment for %s: %w", o.name, err)
 		}
 		uploadOut = *out
+		if build, ok := deploy.PipelineBuildFromEnv(o.getenv); ok {
+			if err := attestImages(attestImagesInput{
+				workload:    o.name,
+				images:      uploadOut.ImageDigests,
+				build:       build,
+				fs:          o.fs,
+				newAttester: o.newImageAttester,
+			}); err != nil {
+				return nil, err
+			}
+		}
 	}
 	output, err := generator.GenerateCloudFormationTemplate(&clideploy.GenerateCloudFormationTemplateInput{
 		StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
@@ -397,6 +554,87 @@ func (o *packageSvcOpts) getWorkloadStack(generator workloadStackGenerator) (*cf
 		parameters: output.Parameters}, nil
 }
 
+// writeComposeFile writes a Docker Compose file that runs the containers of the service deployed in the environment.
+func (o *packageSvcOpts) writeComposeFile() error {
+	mft, err := workloadManifest(&workloadManifestInput{
+		name:         o.name,
+		appName:      o.appName,
+		envName:      o.envName,
+		interpolator: o.newInterpolator(o.appName, o.envName),
+		ws:           o.ws,
+		unmarshal:    o.unmarshal,
+		sess:         o.envSess,
+	})
+	if err != nil {
+		return err
+	}
+	switch mft.Manifest().(type) {
+	case *manifest.RequestDrivenWebService:
+		return fmt.Errorf("--%s %s is not supported for %s", outputFormatFlag, o.outputFormat, manifestinfo.RequestDrivenWebServiceType)
+	case *manifest.StaticSite:
+		return fmt.Errorf("--%s %s is not supported for %s", outputFormatFlag, o.outputFormat, manifestinfo.StaticSiteType)
+	}
+	buildContexts, err := clideploy.ContainerBuildContexts(o.name, o.ws.Path(), mft.Manifest())
+	if err != nil {
+		return fmt.Errorf("get build contexts: %w", err)
+	}
+	taskDef, err := o.taskDefGetter.TaskDefinition(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("get task definition: %w", err)
+	}
+	app, err := o.getTargetApp()
+	if err != nil {
+		return err
+	}
+	envVars := make(map[string]containerEnv)
+	fillTaskDefEnvVars(envVars, taskDef)
+	if _, err := fillSecretRefs(envVars, taskDef); err != nil {
+		return fmt.Errorf("get secrets: %w", err)
+	}
+	in := clideploy.ComposeInput{
+		Name:           o.name,
+		TaskDefinition: taskDef,
+		EnvVars:        make(map[string]map[string]string),
+		Secrets:        make(map[string]map[string]string),
+		BuildContexts:  buildContexts,
+		Dir:            o.ws.Path(),
+		PauseImage:     app.HelperImages[template.HelperImagePause],
+	}
+	for ctr, vars := range envVars {
+		in.EnvVars[ctr] = make(map[string]string)
+		in.Secrets[ctr] = make(map[string]string)
+		for k, v := range vars {
+			if v.Secret {
+				in.Secrets[ctr][k] = v.Value
+				continue
+			}
+			in.EnvVars[ctr][k] = v.Value
+		}
+	}
+
+	if o.outputDir != "" {
+		dir, err := filepath.Abs(o.outputDir)
+		if err != nil {
+			return fmt.Errorf("get absolute path of %s: %w", o.outputDir, err)
+		}
+		if err := o.fs.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("create directory %s: %w", o.outputDir, err)
+		}
+		path := filepath.Join(o.outputDir, clideploy.ComposeFileName)
+		f, err := o.fs.Create(path)
+		if err != nil {
+			return fmt.Errorf("create file %s: %w", path, err)
+		}
+		o.templateWriter = f
+		in.Dir = dir
+	}
+	content, err := clideploy.GenerateComposeFile(in)
+	if err != nil {
+		return fmt.Errorf("generate Compose file for %s: %w", o.name, err)
+	}
+	return o.writeAndClose(o.templateWriter, string(content))
+}
+
 // setOutputFileWriters creates the output directory, and updates the template and param writers to file writers in the directory.
 func (o *packageSvcOpts) setOutputFileWriters() error {
 	if err := o.fs.MkdirAll(o.outputDir, 0755); err != nil {
@@ -488,6 +726,20 @@ func (e *errDiffNotAvailable) ExitCode() int {
 	return 2
 }
 
+type errAssertionsFailed struct {
+	failed int
+	total  int
+}
+
+func (e *errAssertionsFailed) Error() string {
+	return fmt.Sprintf("%d of %s failed", e.failed, english.Plural(e.total, "assertion", ""))
+}
+
+// ExitCode returns 1 when any assertion fails.
+func (e *errAssertionsFailed) ExitCode() int {
+	return 1
+}
+
 func contains(s string, items []string) bool {
 	for _, item := range items {
 		if s == item {
@@ -513,7 +765,13 @@ func buildSvcPackageCmd() *cobra.Command {
   $ copilot svc package -n frontend -e test --output-dir ./infrastructure
   $ ls ./infrastructure
   frontend-test.stack.yml      frontend-test.params.json
-  /endcodeblock`,
+  /endcodeblock
+
+  Write a Docker Compose file that runs the containers of the "frontend" service deployed in the "test" environment.
+  /code $ copilot svc package -n frontend -e test --output-format compose --output-dir .
+
+  Fail if the templates of the "frontend" service in the "prod" environment break the assertions of the platform team.
+  /code $ copilot svc package -n frontend -e prod --assert ./assertions.yml --output-dir ./infrastructure`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPackageSvcOpts(vars)
 			if err != nil {
@@ -529,7 +787,11 @@ func buildSvcPackageCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.showConfig, showConfigFlag, false, showConfigFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", svcPackageOutputFormatFlagDescription)
+	cmd.Flags().StringVar(&vars.assertionsFile, assertFlag, "", assertFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, stackOutputDirFlag)
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, uploadAssetsFlag)
@@ -10,9 +10,14 @@ import (
 	"io"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -176,15 +181,17 @@ memory: 512
 count: 1`
 	)
 	testCases := map[string]struct {
-		inVars packageSvcVars
+		inVars       packageSvcVars
+		inAssertions string
 
 		setupMocks func(m *svcPackageExecuteMock)
 
-		wantedStack  string
-		wantedParams string
-		wantedAddons string
-		wantedDiff   string
-		wantedErr    error
+		wantedStack      string
+		wantedParams     string
+		wantedAddons     string
+		wantedDiff       string
+		wantedAssertions string
+		wantedErr        error
 	}{
 		"error out if fail to get version": {
 			inVars: packageSvcVars{
@@ -248,6 +255,34 @@ count: 1`
 			wantedDiff: "mock diff",
 			wantedErr:  &errHasDiff{},
 		},
+		"writes the task configuration changes after the diff": {
+			inVars: packageSvcVars{
+				name:               "api",
+				clientConfigured:   true,
+				showDiff:           true,
+				showConfig:         true,
+				allowWkldDowngrade: true,
+			},
+			setupMocks: func(m *svcPackageExecuteMock) {
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(lbwsMft), nil)
+				m.interpolator.EXPECT().Interpolate(lbwsMft).Return(lbwsMft, nil)
+				m.mft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{}
+					},
+				}
+				m.envFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.envFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{}, nil)
+				m.generator.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template:   "mystack",
+					Parameters: "myparams",
+				}, nil)
+				m.generator.EXPECT().DeployDiff(gomock.Eq("mystack")).Return("mock diff\n", nil)
+				m.generator.EXPECT().ConfigDiff("mystack", "myparams").Return("No configuration changes.\n", nil)
+			},
+			wantedDiff: "mock diff\n\nTask configuration:\nNo configuration changes.\n",
+			wantedErr:  &errHasDiff{},
+		},
 		"writes service template without addons": {
 			inVars: packageSvcVars{
 				appName:            "ecs-kudos",
@@ -293,6 +328,61 @@ count: 1`
 			wantedStack:  "mystack",
 			wantedParams: "myparams",
 		},
+		"fails when the templates don't satisfy the assertions": {
+			inVars: packageSvcVars{
+				appName:            "ecs-kudos",
+				name:               "api",
+				envName:            "test",
+				allowWkldDowngrade: true,
+				clientConfigured:   true,
+				assertionsFile:     "assertions.yml",
+			},
+			inAssertions: `assertions:
+  - name: logs are retained for at most 90 days
+    resourceType: AWS::Logs::LogGroup
+    path: Properties.RetentionInDays
+    require:
+      lessThanOrEqual: 90
+  - name: no public buckets
+    resourceType: AWS::S3::Bucket
+    path: Properties.PublicAccessBlockConfiguration.BlockPublicAcls
+    require:
+      equals: "true"
+`,
+			setupMocks: func(m *svcPackageExecuteMock) {
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(lbwsMft), nil)
+				m.interpolator.EXPECT().Interpolate(lbwsMft).Return(lbwsMft, nil)
+				m.envFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{}
+					},
+				}
+				m.envFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{}, nil)
+				m.generator.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 3653
+`,
+					Parameters: "myparams",
+				}, nil)
+				m.generator.EXPECT().AddonsTemplate().Return(`Resources:
+  AddonsLogGroup:
+    Type: AWS::Logs::LogGroup
+`, nil)
+			},
+			wantedAssertions: `✘ logs are retained for at most 90 days
+    api-test.stack.yml: LogGroup.Properties.RetentionInDays (line 5)
+    - expected: <= 90
+    + actual: 3653
+    api.addons.stack.yml: AddonsLogGroup.Properties.RetentionInDays (line 3)
+    - expected: <= 90
+    + actual: (missing)
+`,
+			wantedErr: errors.New("1 of 2 assertions failed"),
+		},
 		"writes request-driven web service template with custom resource": {
 			inVars: packageSvcVars{
 				appName:            "ecs-kudos",
@@ -338,6 +428,11 @@ count: 1`
 			paramsBuf := new(bytes.Buffer)
 			addonsBuf := new(bytes.Buffer)
 			diffBuff := new(bytes.Buffer)
+			assertionsBuf := new(bytes.Buffer)
+			fs := afero.NewMemMapFs()
+			if tc.inAssertions != "" {
+				require.NoError(t, afero.WriteFile(fs, tc.inVars.assertionsFile, []byte(tc.inAssertions), 0644))
+			}
 
 			m := &svcPackageExecuteMock{
 				ws:                   mocks.NewMockwsWlDirReader(ctrl),
@@ -354,7 +449,9 @@ count: 1`
 				paramsWriter:     mockWriteCloser{w: paramsBuf},
 				addonsWriter:     mockWriteCloser{w: addonsBuf},
 				diffWriter:       mockWriteCloser{w: diffBuff},
+				assertionsWriter: assertionsBuf,
 				svcVersionGetter: m.mockVersionGetter,
+				fs:               fs,
 
 				unmarshal: func(b []byte) (manifest.DynamicWorkload, error) {
 					return m.mft, nil
@@ -368,8 +465,11 @@ count: 1`
 					return m.generator, nil
 				},
 				envFeaturesDescriber: m.envFeaturesDescriber,
-				targetApp:            &config.Application{},
-				targetEnv:            &config.Environment{},
+				getenv: func(string) string {
+					return ""
+				},
+				targetApp: &config.Application{},
+				targetEnv: &config.Environment{},
 			}
 
 			// WHEN
@@ -385,6 +485,266 @@ count: 1`
 			require.Equal(t, paramsBuf.String(), tc.wantedParams)
 			require.Equal(t, addonsBuf.String(), tc.wantedAddons)
 			require.Equal(t, diffBuff.String(), tc.wantedDiff)
+			require.Equal(t, tc.wantedAssertions, assertionsBuf.String())
+		})
+	}
+}
+
+func TestPackageSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars packageSvcVars
+
+		wantedErr error
+	}{
+		"valid without an output format": {},
+		"error if the output format is invalid": {
+			inVars: packageSvcVars{
+				outputFormat: "helm",
+			},
+			wantedErr: errors.New(`invalid output format "helm": must be one of "compose"`),
+		},
+		"error if assets are uploaded with the compose output format": {
+			inVars: packageSvcVars{
+				outputFormat: composeOutputFormat,
+				uploadAssets: true,
+			},
+			wantedErr: errors.New("--upload-assets cannot be specified with --output-format compose"),
+		},
+		"error if the diff is shown with the compose output format": {
+			inVars: packageSvcVars{
+				outputFormat: composeOutputFormat,
+				showDiff:     true,
+			},
+			wantedErr: errors.New("--diff cannot be specified with --output-format compose"),
+		},
+		"error if assertions are checked with the compose output format": {
+			inVars: packageSvcVars{
+				outputFormat:   composeOutputFormat,
+				assertionsFile: "assertions.yml",
+			},
+			wantedErr: errors.New("--assert cannot be specified with --output-format compose"),
+		},
+		"error if the task configuration is shown without the diff": {
+			inVars: packageSvcVars{
+				showConfig: true,
+			},
+			wantedErr: errors.New("--show-config must be specified with --diff"),
+		},
+		"valid with the compose output format": {
+			inVars: packageSvcVars{
+				outputFormat: composeOutputFormat,
+				outputDir:    "./compose",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &packageSvcOpts{
+				packageSvcVars: tc.inVars,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// staticWorkloadMft is a manifest without dynamic content to load.
+type staticWorkloadMft struct {
+	manifest.DynamicWorkload
+}
+
+func (m staticWorkloadMft) ApplyEnv(envName string) (manifest.DynamicWorkload, error) {
+	mft, err := m.DynamicWorkload.ApplyEnv(envName)
+	if err != nil {
+		return nil, err
+	}
+	return staticWorkloadMft{mft}, nil
+}
+
+func (m staticWorkloadMft) Load(_ *session.Session) error {
+	return nil
+}
+
+func TestPackageSvcOpts_ExecuteCompose(t *testing.T) {
+	const (
+		lbwsMft = `name: api
+type: Load Balanced Web Service
+image:
+  build: api/Dockerfile
+  port: 80
+http:
+  path: 'api'
+cpu: 256
+memory: 512
+count: 1`
+		rdwsMft = `name: api
+type: Request-Driven Web Service
+image:
+  build: api/Dockerfile
+  port: 80
+cpu: 256
+memory: 512`
+	)
+	mockTaskDef := &awsecs.TaskDefinition{
+		ContainerDefinitions: []*sdkecs.ContainerDefinition{
+			{
+				Name:  aws.String("api"),
+				Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/app/api:latest"),
+				Environment: []*sdkecs.KeyValuePair{
+					{Name: aws.String("COPILOT_SERVICE_NAME"), Value: aws.String("api")},
+				},
+				Secrets: []*sdkecs.Secret{
+					{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("/copilot/app/test/secrets/db_password")},
+				},
+				PortMappings: []*sdkecs.PortMapping{
+					{ContainerPort: aws.Int64(80)},
+				},
+			},
+		},
+	}
+	const wantedCompose = `name: api
+services:
+  api:
+    build:
+      context: %s
+      dockerfile: Dockerfile
+    environment:
+      COPILOT_SERVICE_NAME: api
+      DB_PASSWORD: ${DB_PASSWORD:?set DB_PASSWORD to the value of the secret /copilot/app/test/secrets/db_password}
+    network_mode: service:pause
+    depends_on:
+      pause:
+        condition: service_started
+  pause:
+    image: %s
+    command:
+      - sleep
+      - infinity
+    ports:
+      - 80:80
+`
+	testCases := map[string]struct {
+		inVars         packageSvcVars
+		inHelperImages map[string]string
+		mft            string
+		setupMocks     func(m *mocks.MocktaskDefinitionGetter)
+
+		wantedStdout string
+		wantedFile   string
+		wantedErr    error
+	}{
+		"error if the service doesn't run in ECS": {
+			inVars: packageSvcVars{
+				name: "api",
+			},
+			mft:        rdwsMft,
+			setupMocks: func(m *mocks.MocktaskDefinitionGetter) {},
+			wantedErr:  errors.New("--output-format compose is not supported for Request-Driven Web Service"),
+		},
+		"error if the task definition can't be retrieved": {
+			inVars: packageSvcVars{
+				name: "api",
+			},
+			mft: lbwsMft,
+			setupMocks: func(m *mocks.MocktaskDefinitionGetter) {
+				m.EXPECT().TaskDefinition("app", "test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get task definition: some error"),
+		},
+		"writes the compose file to stdout": {
+			inVars: packageSvcVars{
+				name: "api",
+			},
+			mft: lbwsMft,
+			setupMocks: func(m *mocks.MocktaskDefinitionGetter) {
+				m.EXPECT().TaskDefinition("app", "test", "api").Return(mockTaskDef, nil)
+			},
+			wantedStdout: fmt.Sprintf(wantedCompose, "api", "public.ecr.aws/amazonlinux/amazonlinux:2023"),
+		},
+		"writes the compose file with the pause image of the application": {
+			inVars: packageSvcVars{
+				name: "api",
+			},
+			inHelperImages: map[string]string{
+				"pause": "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/amazonlinux:2023",
+			},
+			mft: lbwsMft,
+			setupMocks: func(m *mocks.MocktaskDefinitionGetter) {
+				m.EXPECT().TaskDefinition("app", "test", "api").Return(mockTaskDef, nil)
+			},
+			wantedStdout: fmt.Sprintf(wantedCompose, "api", "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/amazonlinux:2023"),
+		},
+		"writes the compose file to the output directory": {
+			inVars: packageSvcVars{
+				name:      "api",
+				outputDir: "/ws/compose",
+			},
+			mft: lbwsMft,
+			setupMocks: func(m *mocks.MocktaskDefinitionGetter) {
+				m.EXPECT().TaskDefinition("app", "test", "api").Return(mockTaskDef, nil)
+			},
+			wantedFile: fmt.Sprintf(wantedCompose, "../api", "public.ecr.aws/amazonlinux/amazonlinux:2023"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsWlDirReader(ctrl)
+			ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(tc.mft), nil)
+			ws.EXPECT().Path().Return("/ws").AnyTimes()
+			mockInterpolator := mocks.NewMockinterpolator(ctrl)
+			mockInterpolator.EXPECT().Interpolate(tc.mft).Return(tc.mft, nil)
+			taskDefGetter := mocks.NewMocktaskDefinitionGetter(ctrl)
+			tc.setupMocks(taskDefGetter)
+			fs := afero.NewMemMapFs()
+			stdout := new(bytes.Buffer)
+
+			tc.inVars.appName = "app"
+			tc.inVars.envName = "test"
+			tc.inVars.outputFormat = composeOutputFormat
+			tc.inVars.clientConfigured = true
+			opts := &packageSvcOpts{
+				packageSvcVars: tc.inVars,
+				ws:             ws,
+				fs:             fs,
+				templateWriter: mockWriteCloser{w: stdout},
+				unmarshal: func(b []byte) (manifest.DynamicWorkload, error) {
+					mft, err := manifest.UnmarshalWorkload(b)
+					if err != nil {
+						return nil, err
+					}
+					return staticWorkloadMft{mft}, nil
+				},
+				newInterpolator: func(_, _ string) interpolator {
+					return mockInterpolator
+				},
+				taskDefGetter: taskDefGetter,
+				targetApp: &config.Application{
+					Name:         "app",
+					HelperImages: tc.inHelperImages,
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStdout, stdout.String())
+			if tc.wantedFile != "" {
+				content, err := afero.ReadFile(fs, "/ws/compose/docker-compose.yml")
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedFile, string(content))
+			}
 		})
 	}
 }
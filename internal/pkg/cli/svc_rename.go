@@ -0,0 +1,472 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssession "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	svcRenameNamePrompt         = "Which service would you like to rename?"
+	fmtSvcRenameNewNamePrompt   = "What is the new %s of service %s?"
+	svcRenameNewNameHelpPrompt  = "The service is redeployed with its new name, and then deleted with its old name."
+	fmtSvcRenameConfirmPrompt   = "Are you sure you want to rename %s to %s in application %s?"
+	fmtSvcRenameConfirmHelp     = "This will deploy a copy of the service with the new name and then delete the service %s."
+	fmtSvcRenameAlreadyExistErr = "service %s already exists with type %q"
+)
+
+var errSvcRenameCancelled = errors.New("svc rename cancelled - no changes made")
+
+type renameSvcVars struct {
+	appName          string
+	name             string
+	newName          string
+	skipConfirmation bool
+}
+
+type renameSvcOpts struct {
+	renameSvcVars
+
+	// Interfaces to dependencies.
+	store       store
+	deployStore deployedEnvironmentLister
+	ws          wsWorkloadRenamer // Nil if the command isn't run in a workspace.
+	sess        sessionProvider
+	prompt      prompter
+	sel         configSelector
+	appCFN      svcRegistrarInApp
+	getECR      func(sess *awssession.Session) imageRemover
+
+	// Cached variables.
+	svc              *config.Workload
+	updatedWorkloads []string // Workloads whose manifests now reference the new name of the service.
+	remainingSteps   []string
+}
+
+func newRenameSvcOpts(vars renameSvcVars) (*renameSvcOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc rename"))
+	defaultSession, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+
+	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	opts := &renameSvcOpts{
+		renameSvcVars: vars,
+
+		store:       store,
+		deployStore: deployStore,
+		sess:        sessProvider,
+		prompt:      prompter,
+		sel:         selector.NewConfigSelector(prompter, store),
+		appCFN:      cloudformation.New(defaultSession, cloudformation.WithProgressTracker(os.Stderr)),
+		getECR: func(sess *awssession.Session) imageRemover {
+			return ecr.New(sess)
+		},
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err == nil {
+		opts.ws = ws
+	} else {
+		var errNoWorkspace *workspace.ErrWorkspaceNotFound
+		if !errors.As(err, &errNoWorkspace) {
+			return nil, err
+		}
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *renameSvcOpts) Validate() error {
+	if o.name != "" && o.name == o.newName {
+		return fmt.Errorf("new name of service %s must be different from its current name", o.name)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *renameSvcOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	} else {
+		name, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application name: %w", err)
+		}
+		o.appName = name
+	}
+
+	if o.name == "" {
+		name, err := o.sel.Service(svcRenameNamePrompt, "", o.appName)
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.name = name
+	}
+	svc, err := o.store.GetService(o.appName, o.name)
+	if err != nil {
+		return err
+	}
+	o.svc = svc
+
+	if err := o.validateOrAskNewName(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtSvcRenameConfirmPrompt, o.name, o.newName, o.appName),
+		fmt.Sprintf(fmtSvcRenameConfirmHelp, o.name),
+		prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("svc rename confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errSvcRenameCancelled
+	}
+	return nil
+}
+
+func (o *renameSvcOpts) validateOrAskNewName() error {
+	validator := func(val interface{}) error {
+		if err := validateSvcName(val, o.svc.Type); err != nil {
+			return err
+		}
+		if val == o.name {
+			return fmt.Errorf("new name of service %s must be different from its current name", o.name)
+		}
+		return nil
+	}
+	if o.newName != "" {
+		return validator(o.newName)
+	}
+	name, err := o.prompt.Get(
+		fmt.Sprintf(fmtSvcRenameNewNamePrompt, color.Emphasize("name"), o.name),
+		svcRenameNewNameHelpPrompt,
+		validator,
+		prompt.WithFinalMessage("New service name:"))
+	if err != nil {
+		return fmt.Errorf("get new service name: %w", err)
+	}
+	o.newName = name
+	return nil
+}
+
+// Execute renames the service. Each step is skipped if it was already completed by a previous run,
+// so that the command can be run again to resume the rename:
+//  1. Add the service with its new name to the application.
+//  2. Rename the service in the workspace: its manifest directory, the pipeline manifests, and the
+//     manifests of the workloads that subscribe to its topics or reference its endpoints.
+//  3. Once the service with its old name is deleted from every environment, remove it from the application.
+//
+// Deploying the service with its new name and deleting it with its old name are left to the user,
+// so that the new deployment can be verified before the old one stops serving traffic.
+func (o *renameSvcOpts) Execute() error {
+	if err := o.addNewNameToApp(); err != nil {
+		return err
+	}
+	if err := o.renameInWorkspace(); err != nil {
+		return err
+	}
+	steps, err := o.deploymentSteps()
+	if err != nil {
+		return err
+	}
+	if len(steps) > 0 {
+		o.remainingSteps = steps
+		log.Infoln()
+		log.Infof("Service %s is still deployed with its old name.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	if err := o.removeOldNameFromApp(); err != nil {
+		return err
+	}
+	log.Infoln()
+	log.Successf("Renamed service %s to %s in application %s.\n", o.name, o.newName, o.appName)
+	return nil
+}
+
+func (o *renameSvcOpts) addNewNameToApp() error {
+	existing, err := o.store.GetService(o.appName, o.newName)
+	if err == nil {
+		if existing.Type != o.svc.Type {
+			return fmt.Errorf(fmtSvcRenameAlreadyExistErr, o.newName, existing.Type)
+		}
+		// Resuming a previous rename.
+		return nil
+	}
+	var errNoSuchSvc *config.ErrNoSuchService
+	if !errors.As(err, &errNoSuchSvc) {
+		return fmt.Errorf("get service %s: %w", o.newName, err)
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	var addOpts []cloudformation.AddWorkloadToAppOpt
+	if o.svc.Type == manifestinfo.StaticSiteType {
+		addOpts = append(addOpts, cloudformation.AddWorkloadToAppOptWithoutECR)
+	}
+	if err := o.appCFN.AddServiceToApp(app, o.newName, addOpts...); err != nil {
+		return fmt.Errorf("add service %s to application %s: %w", o.newName, o.appName, err)
+	}
+	if err := o.store.CreateService(&config.Workload{
+		App:  o.appName,
+		Name: o.newName,
+		Type: o.svc.Type,
+	}); err != nil {
+		return fmt.Errorf("save service %s: %w", o.newName, err)
+	}
+	log.Successf("Added service %s to application %s.\n", o.newName, o.appName)
+	return nil
+}
+
+func (o *renameSvcOpts) renameInWorkspace() error {
+	if o.ws == nil {
+		return nil
+	}
+	wklds, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	if contains(o.name, wklds) && !contains(o.newName, wklds) {
+		path, err := o.ws.RenameWorkload(o.name, o.newName)
+		if err != nil {
+			return fmt.Errorf("rename manifest of service %s: %w", o.name, err)
+		}
+		log.Successf("Moved the manifest of service %s to %s.\n", o.name, displayPath(path))
+	}
+	paths, err := o.ws.RenameWorkloadInPipelines(o.name, o.newName)
+	if err != nil {
+		return fmt.Errorf("rename service %s in pipelines: %w", o.name, err)
+	}
+	for _, path := range paths {
+		log.Successf("Updated the pipeline manifest %s.\n", displayPath(path))
+	}
+	for _, wkld := range wklds {
+		if wkld == o.name || wkld == o.newName {
+			continue
+		}
+		updated, err := o.renameReferences(wkld)
+		if err != nil {
+			return err
+		}
+		if updated {
+			o.updatedWorkloads = append(o.updatedWorkloads, wkld)
+		}
+	}
+	return nil
+}
+
+// renameReferences replaces the name of the service in the topic subscriptions and in the variables that
+// reference its endpoints of a workload's manifest. Only the lines of the values are changed, so that the
+// comments and formatting of the manifest are preserved. It returns true if the manifest was updated.
+func (o *renameSvcOpts) renameReferences(wkld string) (bool, error) {
+	raw, err := o.ws.ReadWorkloadManifest(wkld)
+	if err != nil {
+		return false, fmt.Errorf("read manifest file for %s: %w", wkld, err)
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(raw, &node); err != nil {
+		return false, fmt.Errorf("unmarshal manifest for %s: %w", wkld, err)
+	}
+	endpoint := svcEndpointReference(o.name)
+	lines := strings.Split(string(raw), "\n")
+	var changed bool
+	walkYAMLScalarNodes(&node, "", func(field string, scalar *yaml.Node) {
+		if scalar.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 || scalar.Line < 1 || scalar.Line > len(lines) {
+			return
+		}
+		var renamed string
+		switch {
+		case topicSubscriptionServiceField.MatchString(field) && scalar.Value == o.name:
+			renamed = o.newName
+		case strings.Contains("."+field, ".variables.") && endpoint.MatchString(scalar.Value):
+			renamed = endpoint.ReplaceAllString(scalar.Value, fmt.Sprintf("${1}%s${2}${4}${5}", o.newName))
+		default:
+			return
+		}
+		line := lines[scalar.Line-1]
+		col := scalar.Column - 1
+		if col < 0 || col > len(line) || !strings.Contains(line[col:], scalar.Value) {
+			return
+		}
+		lines[scalar.Line-1] = line[:col] + strings.Replace(line[col:], scalar.Value, renamed, 1)
+		changed = true
+	})
+	if !changed {
+		return false, nil
+	}
+	path, err := o.ws.OverwriteWorkloadManifest(wkld, []byte(strings.Join(lines, "\n")))
+	if err != nil {
+		return false, fmt.Errorf("write manifest file for %s: %w", wkld, err)
+	}
+	log.Successf("Updated the references to service %s in %s.\n", o.name, displayPath(path))
+	return true, nil
+}
+
+// deploymentSteps returns the commands left to move the deployments of the service to its new name.
+// The workloads whose manifests now reference the new name are redeployed before the service is deleted with its old name.
+func (o *renameSvcOpts) deploymentSteps() ([]string, error) {
+	oldEnvs, err := o.deployStore.ListEnvironmentsDeployedTo(o.appName, o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list environments where service %s is deployed: %w", o.name, err)
+	}
+	if len(oldEnvs) == 0 {
+		return nil, nil
+	}
+	newEnvs, err := o.deployStore.ListEnvironmentsDeployedTo(o.appName, o.newName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments where service %s is deployed: %w", o.newName, err)
+	}
+	updatedEnvs := make(map[string][]string, len(o.updatedWorkloads))
+	for _, wkld := range o.updatedWorkloads {
+		envs, err := o.deployStore.ListEnvironmentsDeployedTo(o.appName, wkld)
+		if err != nil {
+			return nil, fmt.Errorf("list environments where workload %s is deployed: %w", wkld, err)
+		}
+		updatedEnvs[wkld] = envs
+	}
+	var steps []string
+	for _, env := range oldEnvs {
+		if !contains(env, newEnvs) {
+			steps = append(steps, fmt.Sprintf("Run %s to deploy the service with its new name.",
+				color.HighlightCode(fmt.Sprintf("copilot svc deploy --name %s --env %s", o.newName, env))))
+		}
+		for _, wkld := range o.updatedWorkloads {
+			if contains(env, updatedEnvs[wkld]) {
+				steps = append(steps, fmt.Sprintf("Run %s to point %s at the new name of the service.",
+					color.HighlightCode(fmt.Sprintf("copilot deploy --name %s --env %s", wkld, env)), wkld))
+			}
+		}
+		steps = append(steps, fmt.Sprintf("Run %s to delete the service with its old name once its replacement is healthy.",
+			color.HighlightCode(fmt.Sprintf("copilot svc delete --name %s --env %s", o.name, env))))
+	}
+	steps = append(steps, fmt.Sprintf("Run %s again to finish the rename.",
+		color.HighlightCode(fmt.Sprintf("copilot svc rename --name %s --new-name %s", o.name, o.newName))))
+	return steps, nil
+}
+
+func (o *renameSvcOpts) removeOldNameFromApp() error {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments: %w", err)
+	}
+	var regions []string
+	for _, env := range envs {
+		if !contains(env.Region, regions) {
+			regions = append(regions, env.Region)
+		}
+	}
+	if o.svc.Type != manifestinfo.StaticSiteType {
+		repoName := clideploy.RepoName(o.appName, o.name)
+		for _, region := range regions {
+			sess, err := o.sess.DefaultWithRegion(region)
+			if err != nil {
+				return err
+			}
+			if err := o.getECR(sess).ClearRepository(repoName); err != nil {
+				return err
+			}
+		}
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if err := o.appCFN.RemoveServiceFromApp(app, o.name); err != nil {
+		if !isStackSetNotExistsErr(err) {
+			return err
+		}
+	}
+	if err := o.store.DeleteService(o.appName, o.name); err != nil {
+		return fmt.Errorf("delete service %s in application %s from config store: %w", o.name, o.appName, err)
+	}
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *renameSvcOpts) RecommendActions() error {
+	if len(o.remainingSteps) > 0 {
+		logRecommendedActions(o.remainingSteps)
+		return nil
+	}
+	actions := []string{
+		fmt.Sprintf("Run %s to deploy the service with its new name if it isn't deployed yet.",
+			color.HighlightCode(fmt.Sprintf("copilot svc deploy --name %s", o.newName))),
+	}
+	for _, wkld := range o.updatedWorkloads {
+		actions = append(actions, fmt.Sprintf("Run %s to point %s at the new name of the service.",
+			color.HighlightCode(fmt.Sprintf("copilot deploy --name %s", wkld)), wkld))
+	}
+	actions = append(actions, fmt.Sprintf("Run %s to update the corresponding pipeline if it exists.",
+		color.HighlightCode("copilot pipeline deploy")))
+	logRecommendedActions(actions)
+	return nil
+}
+
+// buildSvcRenameCmd builds the command to rename a service.
+func buildSvcRenameCmd() *cobra.Command {
+	vars := renameSvcVars{}
+	cmd := &cobra.Command{
+		Use:     "rename",
+		Aliases: []string{"mv"},
+		Short:   "Renames a service in an application.",
+		Long: `Renames a service in an application.
+The service is added to the application with its new name, and its manifest, the pipeline manifests,
+and the references to its endpoints and topics in the workspace are updated.
+The service is then redeployed with its new name and deleted with its old name in each environment.
+Run the command again after each step to resume the rename.`,
+		Example: `
+  Rename the "api" service to "backend".
+  /code $ copilot svc rename --name api --new-name backend
+
+  Rename the "api" service without confirmation prompt.
+  /code $ copilot svc mv --name api --new-name backend --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRenameSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVar(&vars.newName, newNameFlag, "", svcRenameNewNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,314 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	awssession "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type renameSvcMocks struct {
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedEnvironmentLister
+	ws          *mocks.MockwsWorkloadRenamer
+	sess        *mocks.MocksessionProvider
+	prompt      *mocks.Mockprompter
+	sel         *mocks.MockconfigSelector
+	appCFN      *mocks.MocksvcRegistrarInApp
+	ecr         *mocks.MockimageRemover
+}
+
+func TestRenameSvcOpts_Validate(t *testing.T) {
+	opts := &renameSvcOpts{
+		renameSvcVars: renameSvcVars{
+			name:    "api",
+			newName: "api",
+		},
+	}
+
+	err := opts.Validate()
+
+	require.EqualError(t, err, "new name of service api must be different from its current name")
+}
+
+func TestRenameSvcOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName             string
+		inNewName          string
+		inSkipConfirmation bool
+		setupMocks         func(m renameSvcMocks)
+
+		wantedName    string
+		wantedNewName string
+		wantedErr     error
+	}{
+		"error if the new name is invalid for the service type": {
+			inName:    "api",
+			inNewName: "a-very-long-name-for-a-request-driven-web-service",
+			setupMocks: func(m renameSvcMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{Name: "api", Type: manifestinfo.RequestDrivenWebServiceType}, nil)
+			},
+			wantedErr: fmt.Errorf("service name a-very-long-name-for-a-request-driven-web-service is invalid: value must not exceed 40 characters"),
+		},
+		"prompt for the service and its new name": {
+			setupMocks: func(m renameSvcMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().Service(svcRenameNamePrompt, "", "phonetool").Return("api", nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{Name: "api", Type: manifestinfo.BackendServiceType}, nil)
+				m.prompt.EXPECT().Get(gomock.Any(), svcRenameNewNameHelpPrompt, gomock.Any(), gomock.Any()).Return("backend", nil)
+				m.prompt.EXPECT().Confirm("Are you sure you want to rename api to backend in application phonetool?", gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			wantedName:    "api",
+			wantedNewName: "backend",
+		},
+		"error if the rename is cancelled": {
+			inName:    "api",
+			inNewName: "backend",
+			setupMocks: func(m renameSvcMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{Name: "api", Type: manifestinfo.BackendServiceType}, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedErr: errSvcRenameCancelled,
+		},
+		"skip confirmation": {
+			inName:             "api",
+			inNewName:          "backend",
+			inSkipConfirmation: true,
+			setupMocks: func(m renameSvcMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{Name: "api", Type: manifestinfo.BackendServiceType}, nil)
+			},
+			wantedName:    "api",
+			wantedNewName: "backend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := renameSvcMocks{
+				store:  mocks.NewMockstore(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+				sel:    mocks.NewMockconfigSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &renameSvcOpts{
+				renameSvcVars: renameSvcVars{
+					appName:          "phonetool",
+					name:             tc.inName,
+					newName:          tc.inNewName,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:  m.store,
+				prompt: m.prompt,
+				sel:    m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+			require.Equal(t, tc.wantedNewName, opts.newName)
+		})
+	}
+}
+
+func TestRenameSvcOpts_Execute(t *testing.T) {
+	const frontendManifest = `name: frontend
+type: Load Balanced Web Service
+variables:
+  API_URL: http://api.test.phonetool.local:8080/v1 # The backend.
+  LOG_LEVEL: info
+environments:
+  prod:
+    variables:
+      API_URL: "http://api:8080"
+`
+	const renamedFrontendManifest = `name: frontend
+type: Load Balanced Web Service
+variables:
+  API_URL: http://backend.test.phonetool.local:8080/v1 # The backend.
+  LOG_LEVEL: info
+environments:
+  prod:
+    variables:
+      API_URL: "http://backend:8080"
+`
+	const workerManifest = `name: worker
+type: Worker Service
+subscribe:
+  topics:
+    - name: events
+      service: api
+`
+	const renamedWorkerManifest = `name: worker
+type: Worker Service
+subscribe:
+  topics:
+    - name: events
+      service: backend
+`
+	mockApp := &config.Application{Name: "phonetool"}
+	mockSvc := &config.Workload{App: "phonetool", Name: "api", Type: manifestinfo.BackendServiceType}
+	testCases := map[string]struct {
+		inWorkspace bool
+		setupMocks  func(m renameSvcMocks)
+
+		wantedSteps []string
+		wantedErr   error
+	}{
+		"error if the new name is taken by a service with a different type": {
+			setupMocks: func(m renameSvcMocks) {
+				m.store.EXPECT().GetService("phonetool", "backend").Return(&config.Workload{Name: "backend", Type: manifestinfo.WorkerServiceType}, nil)
+			},
+			wantedErr: errors.New(`service backend already exists with type "Worker Service"`),
+		},
+		"error if fail to add the new name to the application": {
+			setupMocks: func(m renameSvcMocks) {
+				m.store.EXPECT().GetService("phonetool", "backend").Return(nil, &config.ErrNoSuchService{})
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.appCFN.EXPECT().AddServiceToApp(mockApp, "backend").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("add service backend to application phonetool: some error"),
+		},
+		"error if fail to list the environments of a workload that references the service": {
+			inWorkspace: true,
+			setupMocks: func(m renameSvcMocks) {
+				m.store.EXPECT().GetService("phonetool", "backend").Return(&config.Workload{Name: "backend", Type: manifestinfo.BackendServiceType}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"backend", "worker"}, nil)
+				m.ws.EXPECT().RenameWorkloadInPipelines("api", "backend").Return(nil, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("worker").Return([]byte(workerManifest), nil)
+				m.ws.EXPECT().OverwriteWorkloadManifest("worker", []byte(renamedWorkerManifest)).Return("/copilot/worker/manifest.yml", nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "api").Return([]string{"test"}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "backend").Return([]string{"test"}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "worker").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments where workload worker is deployed: some error"),
+		},
+		"rename in the workspace and return the remaining deployment steps": {
+			inWorkspace: true,
+			setupMocks: func(m renameSvcMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetService("phonetool", "backend").Return(nil, &config.ErrNoSuchService{}),
+					m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil),
+					m.appCFN.EXPECT().AddServiceToApp(mockApp, "backend").Return(nil),
+					m.store.EXPECT().CreateService(&config.Workload{App: "phonetool", Name: "backend", Type: manifestinfo.BackendServiceType}).Return(nil),
+				)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "frontend", "worker"}, nil)
+				m.ws.EXPECT().RenameWorkload("api", "backend").Return("/copilot/backend/manifest.yml", nil)
+				m.ws.EXPECT().RenameWorkloadInPipelines("api", "backend").Return([]string{"/copilot/pipelines/release/manifest.yml"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(frontendManifest), nil)
+				m.ws.EXPECT().OverwriteWorkloadManifest("frontend", []byte(renamedFrontendManifest)).Return("/copilot/frontend/manifest.yml", nil)
+				m.ws.EXPECT().ReadWorkloadManifest("worker").Return([]byte(workerManifest), nil)
+				m.ws.EXPECT().OverwriteWorkloadManifest("worker", []byte(renamedWorkerManifest)).Return("/copilot/worker/manifest.yml", nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "api").Return([]string{"test", "prod"}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "backend").Return([]string{"test"}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "frontend").Return([]string{"test", "prod"}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "worker").Return([]string{"prod"}, nil)
+			},
+			wantedSteps: []string{
+				"Run `copilot deploy --name frontend --env test` to point frontend at the new name of the service.",
+				"Run `copilot svc delete --name api --env test` to delete the service with its old name once its replacement is healthy.",
+				"Run `copilot svc deploy --name backend --env prod` to deploy the service with its new name.",
+				"Run `copilot deploy --name frontend --env prod` to point frontend at the new name of the service.",
+				"Run `copilot deploy --name worker --env prod` to point worker at the new name of the service.",
+				"Run `copilot svc delete --name api --env prod` to delete the service with its old name once its replacement is healthy.",
+				"Run `copilot svc rename --name api --new-name backend` again to finish the rename.",
+			},
+		},
+		"resume the rename by removing the old name from the application": {
+			inWorkspace: true,
+			setupMocks: func(m renameSvcMocks) {
+				m.store.EXPECT().GetService("phonetool", "backend").Return(&config.Workload{Name: "backend", Type: manifestinfo.BackendServiceType}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"backend"}, nil)
+				m.ws.EXPECT().RenameWorkloadInPipelines("api", "backend").Return(nil, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "api").Return(nil, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test", Region: "us-west-2"},
+					{Name: "prod", Region: "us-west-2"},
+				}, nil)
+				m.sess.EXPECT().DefaultWithRegion("us-west-2").Return(&awssession.Session{}, nil)
+				m.ecr.EXPECT().ClearRepository("phonetool/api").Return(nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.appCFN.EXPECT().RemoveServiceFromApp(mockApp, "api").Return(nil)
+				m.store.EXPECT().DeleteService("phonetool", "api").Return(nil)
+			},
+		},
+		"error if fail to delete the old name from the config store": {
+			setupMocks: func(m renameSvcMocks) {
+				m.store.EXPECT().GetService("phonetool", "backend").Return(&config.Workload{Name: "backend", Type: manifestinfo.BackendServiceType}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "api").Return(nil, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.appCFN.EXPECT().RemoveServiceFromApp(mockApp, "api").Return(nil)
+				m.store.EXPECT().DeleteService("phonetool", "api").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("delete service api in application phonetool from config store: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := renameSvcMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				ws:          mocks.NewMockwsWorkloadRenamer(ctrl),
+				sess:        mocks.NewMocksessionProvider(ctrl),
+				appCFN:      mocks.NewMocksvcRegistrarInApp(ctrl),
+				ecr:         mocks.NewMockimageRemover(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &renameSvcOpts{
+				renameSvcVars: renameSvcVars{
+					appName: "phonetool",
+					name:    "api",
+					newName: "backend",
+				},
+				store:       m.store,
+				deployStore: m.deployStore,
+				sess:        m.sess,
+				appCFN:      m.appCFN,
+				getECR: func(_ *awssession.Session) imageRemover {
+					return m.ecr
+				},
+				svc: mockSvc,
+			}
+			if tc.inWorkspace {
+				opts.ws = m.ws
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSteps, opts.remainingSteps)
+		})
+	}
+}
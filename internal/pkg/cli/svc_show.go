@@ -4,16 +4,22 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 	"unicode"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -23,6 +29,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/topology"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -32,11 +41,14 @@ const (
 )
 
 type showSvcVars struct {
-	appName               string
-	svcName               string
-	shouldOutputJSON      bool
-	shouldOutputResources bool
-	outputManifestForEnv  string
+	appName                string
+	svcName                string
+	shouldOutputJSON       bool
+	outputFormat           outputFormatVars
+	shouldOutputResources  bool
+	shouldOutputProvenance bool
+	outputManifestForEnv   string
+	clientsConfigEnv       string
 }
 
 type showSvcOpts struct {
@@ -48,6 +60,13 @@ type showSvcOpts struct {
 	sel           configSelector
 	initDescriber func() error // Overridden in tests.
 
+	envLister       deployedEnvironmentLister
+	newSvcDescriber func(env string) (serviceDescriber, error)
+
+	wsAppName                 string                                    // Name of the application of the workspace, if any.
+	newResolver               func(withWorkspace bool) topologyResolver // Resolves the manifests of the workspace if withWorkspace is true.
+	newClientsConfigDescriber func(env string, callees []string) clientsConfigDescriber
+
 	// Cached variables.
 	targetSvc *config.Workload
 }
@@ -65,11 +84,53 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
 	}
 
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		var errNoWorkspace *workspace.ErrWorkspaceNotFound
+		if !errors.As(err, &errNoWorkspace) {
+			return nil, err
+		}
+	}
+	var wsAppName string
+	if ws != nil {
+		wsAppName = tryReadingAppName()
+	}
+
 	opts := &showSvcOpts{
 		showSvcVars: vars,
 		store:       ssmStore,
 		w:           log.OutputWriter,
 		sel:         selector.NewConfigSelector(prompt.New(), ssmStore),
+		envLister:   deployStore,
+		wsAppName:   wsAppName,
+		newResolver: func(withWorkspace bool) topologyResolver {
+			if !withWorkspace {
+				return topology.NewResolver(ssmStore, deployStore, nil)
+			}
+			return topology.NewResolver(ssmStore, deployStore, ws)
+		},
+	}
+	opts.newClientsConfigDescriber = func(env string, callees []string) clientsConfigDescriber {
+		return describe.NewClientsConfigDescriber(describe.NewClientsConfigDescriberInput{
+			App:         opts.appName,
+			Env:         env,
+			Svc:         opts.svcName,
+			Callees:     callees,
+			ConfigStore: ssmStore,
+			DeployStore: deployStore,
+			TopicLister: deployStore,
+		})
+	}
+	opts.newSvcDescriber = func(envName string) (serviceDescriber, error) {
+		env, err := ssmStore.GetEnvironment(opts.appName, envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s: %w", envName, err)
+		}
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		return ecs.New(sess), nil
 	}
 	opts.initDescriber = func() error {
 		var d workloadDescriber
@@ -110,7 +171,7 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *showSvcOpts) Validate() error {
-	return nil
+	return o.outputFormat.validate()
 }
 
 // Ask prompts for and validates any required flags.
@@ -126,6 +187,12 @@ func (o *showSvcOpts) Execute() error {
 	if o.svcName == "" {
 		return nil
 	}
+	if o.shouldOutputProvenance {
+		return o.writeProvenance()
+	}
+	if o.clientsConfigEnv != "" {
+		return o.writeClientsConfig()
+	}
 	if err := o.initDescriber(); err != nil {
 		return err
 	}
@@ -138,16 +205,17 @@ func (o *showSvcOpts) Execute() error {
 		return fmt.Errorf("describe service %s: %w", o.svcName, err)
 	}
 
-	if o.shouldOutputJSON {
-		data, err := svc.JSONString()
-		if err != nil {
-			return err
-		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, svc.HumanString())
+	if o.shouldOutputJSON || o.outputFormat.isSet() {
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			data, err := svc.JSONString()
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(w, data)
+			return nil
+		})
 	}
-
+	fmt.Fprint(o.w, svc.HumanString())
 	return nil
 }
 
@@ -205,6 +273,190 @@ func (o *showSvcOpts) writeManifest() error {
 	return nil
 }
 
+func (o *showSvcOpts) writeProvenance() error {
+	svc, err := o.getTargetSvc()
+	if err != nil {
+		return err
+	}
+	if svc.Type == manifestinfo.RequestDrivenWebServiceType || svc.Type == manifestinfo.StaticSiteType {
+		return fmt.Errorf("%s is not supported for services with type %q", color.HighlightCode("--"+provenanceFlag), svc.Type)
+	}
+	envs, err := o.envLister.ListEnvironmentsDeployedTo(o.appName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("list environments that service %s is deployed to: %w", o.svcName, err)
+	}
+	out := make([]envProvenance, 0, len(envs))
+	for _, env := range envs {
+		describer, err := o.newSvcDescriber(env)
+		if err != nil {
+			return err
+		}
+		desc, err := describer.DescribeService(o.appName, env, o.svcName)
+		if err != nil {
+			return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.svcName, env, err)
+		}
+		deployments, err := provenancePerTaskDefinition(desc.Tasks)
+		if err != nil {
+			return err
+		}
+		out = append(out, envProvenance{
+			Name:        env,
+			Deployments: deployments,
+		})
+	}
+	if o.shouldOutputJSON || o.outputFormat.isSet() {
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			data, err := json.Marshal(struct {
+				Environments []envProvenance `json:"environments"`
+			}{
+				Environments: out,
+			})
+			if err != nil {
+				return fmt.Errorf("marshal provenance: %w", err)
+			}
+			fmt.Fprintf(w, "%s\n", data)
+			return nil
+		})
+	}
+	writeProvenance(o.w, out)
+	return nil
+}
+
+func (o *showSvcOpts) writeClientsConfig() error {
+	envs, err := o.envLister.ListEnvironmentsDeployedTo(o.appName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("list environments that service %s is deployed to: %w", o.svcName, err)
+	}
+	if !contains(o.clientsConfigEnv, envs) {
+		return fmt.Errorf("service %s is not deployed to environment %s", o.svcName, o.clientsConfigEnv)
+	}
+	// The services that a service calls are found in its manifest, which only the workspace of the application has.
+	inWorkspace := o.wsAppName != "" && o.wsAppName == o.appName
+	t, err := o.newResolver(inWorkspace).Resolve(o.appName)
+	if err != nil {
+		return fmt.Errorf("resolve topology of application %s: %w", o.appName, err)
+	}
+	if !inWorkspace {
+		log.Warningf("The services that %s calls are listed from its manifest, run %s from the workspace of application %s to include them.\n",
+			o.svcName, color.HighlightCode("svc show --"+clientsConfigFlag), o.appName)
+	}
+	cfg, err := o.newClientsConfigDescriber(o.clientsConfigEnv, t.Callees(o.svcName)).Describe()
+	if err != nil {
+		return fmt.Errorf("describe clients config of service %s in environment %s: %w", o.svcName, o.clientsConfigEnv, err)
+	}
+	if o.shouldOutputJSON || o.outputFormat.isSet() {
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			data, err := cfg.JSONString()
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(w, data)
+			return nil
+		})
+	}
+	data, err := cfg.YAMLString()
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, data)
+	return nil
+}
+
+type envProvenance struct {
+	Name        string                 `json:"name"`
+	Deployments []deploymentProvenance `json:"deployments"`
+}
+
+// deploymentProvenance is the provenance of the running tasks of a task definition revision.
+type deploymentProvenance struct {
+	TaskDefinitionRevision int               `json:"taskDefinitionRevision"`
+	Tasks                  []string          `json:"tasks"`
+	Provenance             deploy.Provenance `json:"provenance"`
+}
+
+// provenancePerTaskDefinition groups the tasks by task definition revision and reads
+// their provenance from the tags propagated from the service or task definition.
+func provenancePerTaskDefinition(tasks []*awsecs.Task) ([]deploymentProvenance, error) {
+	byRevision := make(map[int]*deploymentProvenance)
+	for _, task := range tasks {
+		revision, err := awsecs.TaskDefinitionVersion(aws.StringValue(task.TaskDefinitionArn))
+		if err != nil {
+			return nil, err
+		}
+		id, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := byRevision[revision]; !ok {
+			tags := make(map[string]string, len(task.Tags))
+			for _, tag := range task.Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			byRevision[revision] = &deploymentProvenance{
+				TaskDefinitionRevision: revision,
+				Provenance:             deploy.ProvenanceFromTags(tags),
+			}
+		}
+		byRevision[revision].Tasks = append(byRevision[revision].Tasks, id)
+	}
+	deployments := make([]deploymentProvenance, 0, len(byRevision))
+	for _, deployment := range byRevision {
+		sort.Strings(deployment.Tasks)
+		deployments = append(deployments, *deployment)
+	}
+	// Most recent deployments first.
+	sort.Slice(deployments, func(i, j int) bool {
+		return deployments[i].TaskDefinitionRevision > deployments[j].TaskDefinitionRevision
+	})
+	return deployments, nil
+}
+
+func writeProvenance(w io.Writer, envs []envProvenance) {
+	for i, env := range envs {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "Environment %s\n", env.Name)
+		if len(env.Deployments) == 0 {
+			fmt.Fprintln(w, "  No running tasks.")
+			continue
+		}
+		tw := tabwriter.NewWriter(w, 4, 4, 2, ' ', 0)
+		headers := []string{"Revision", "Tasks", "Commit", "Branch", "Dirty", "Manifest", "Copilot Version"}
+		fmt.Fprintf(tw, "  %s\n", strings.Join(headers, "\t"))
+		separators := make([]string, len(headers))
+		for i, header := range headers {
+			separators[i] = strings.Repeat("-", len(header))
+		}
+		fmt.Fprintf(tw, "  %s\n", strings.Join(separators, "\t"))
+		for _, d := range env.Deployments {
+			p := d.Provenance
+			dirty := "-"
+			if p.GitCommit != "" {
+				dirty = strconv.FormatBool(p.GitDirty)
+			}
+			fmt.Fprintf(tw, "  %d\t%d\t%s\t%s\t%s\t%s\t%s\n", d.TaskDefinitionRevision, len(d.Tasks),
+				valueOrDash(shortHash(p.GitCommit, 7)), valueOrDash(p.GitBranch), dirty,
+				valueOrDash(shortHash(p.ManifestSHA256, 12)), valueOrDash(p.CopilotVersion))
+		}
+		tw.Flush()
+	}
+}
+
+func shortHash(hash string, n int) string {
+	if len(hash) <= n {
+		return hash
+	}
+	return hash[:n]
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
 // buildSvcShowCmd builds the command for showing services in an application.
 func buildSvcShowCmd() *cobra.Command {
 	vars := showSvcVars{}
@@ -217,7 +469,11 @@ func buildSvcShowCmd() *cobra.Command {
   Print service configuration in deployed environments.
   /code $ copilot svc show -n api
   Print manifest file used for deploying service "api" in the "prod" environment.
-  /code $ copilot svc show -n api --manifest prod`,
+  /code $ copilot svc show -n api --manifest prod
+  Print the git commit and manifest that the running tasks of service "api" were deployed from.
+  /code $ copilot svc show -n api --provenance
+  Write the endpoints of the dependencies of service "api" in the "prod" environment to a config file.
+  /code $ copilot svc show -n api --clients-config prod > config/clients.yml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowSvcOpts(vars)
 			if err != nil {
@@ -229,10 +485,21 @@ func buildSvcShowCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.format, formatFlag, "", formatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.query, queryFlag, "", queryFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, svcResourcesFlagDescription)
 	cmd.Flags().StringVar(&vars.outputManifestForEnv, manifestFlag, "", svcManifestFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputProvenance, provenanceFlag, false, svcProvenanceFlagDescription)
+	cmd.Flags().StringVar(&vars.clientsConfigEnv, clientsConfigFlag, "", svcClientsConfigFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(jsonFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(formatFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(queryFlag, manifestFlag)
 	cmd.MarkFlagsMutuallyExclusive(resourcesFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(provenanceFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(provenanceFlag, resourcesFlag)
+	cmd.MarkFlagsMutuallyExclusive(clientsConfigFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(clientsConfigFlag, provenanceFlag)
+	cmd.MarkFlagsMutuallyExclusive(clientsConfigFlag, resourcesFlag)
 	return cmd
 }
@@ -9,11 +9,18 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/topology"
 )
 
 type showSvcMocks struct {
@@ -255,3 +262,267 @@ func TestSvcShow_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcShow_ExecuteProvenance(t *testing.T) {
+	mockTask := func(id string, revision int, tags map[string]string) *awsecs.Task {
+		task := &awsecs.Task{
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-app-test-Cluster/" + id),
+			TaskDefinitionArn: aws.String(fmt.Sprintf("arn:aws:ecs:us-west-2:123456789012:task-definition/my-app-test-my-svc:%d", revision)),
+		}
+		for k, v := range tags {
+			task.Tags = append(task.Tags, &sdkecs.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		return task
+	}
+	provenanceTags := map[string]string{
+		"copilot-git-commit":      "4a5b6c7d8e9f00112233445566778899aabbccdd",
+		"copilot-git-branch":      "main",
+		"copilot-git-dirty":       "false",
+		"copilot-manifest-sha256": "d4f25e94bbb8dc26e3081450f1893638fb66f88d2ad52b71bfc9bcfa8aab846b",
+		"copilot-version":         "v1.32.0",
+	}
+	testCases := map[string]struct {
+		inSvcType        string
+		shouldOutputJSON bool
+		setupMocks       func(lister *mocks.MockdeployedEnvironmentLister, describer *mocks.MockserviceDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"error if the service type is not supported": {
+			inSvcType:   manifestinfo.RequestDrivenWebServiceType,
+			setupMocks:  func(_ *mocks.MockdeployedEnvironmentLister, _ *mocks.MockserviceDescriber) {},
+			wantedError: errors.New("`--provenance` is not supported for services with type \"Request-Driven Web Service\""),
+		},
+		"error if fail to list deployed environments": {
+			inSvcType: manifestinfo.LoadBalancedWebServiceType,
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, _ *mocks.MockserviceDescriber) {
+				lister.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list environments that service my-svc is deployed to: some error"),
+		},
+		"error if fail to describe the ECS service": {
+			inSvcType: manifestinfo.LoadBalancedWebServiceType,
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, describer *mocks.MockserviceDescriber) {
+				lister.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"test"}, nil)
+				describer.EXPECT().DescribeService("my-app", "test", "my-svc").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe ECS service for my-svc in environment test: some error"),
+		},
+		"print the provenance of the running tasks per task definition revision": {
+			inSvcType: manifestinfo.LoadBalancedWebServiceType,
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, describer *mocks.MockserviceDescriber) {
+				lister.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"test", "prod"}, nil)
+				describer.EXPECT().DescribeService("my-app", "test", "my-svc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{
+						mockTask("task2", 7, provenanceTags),
+						mockTask("task1", 6, nil),
+						mockTask("task3", 7, provenanceTags),
+					},
+				}, nil)
+				describer.EXPECT().DescribeService("my-app", "prod", "my-svc").Return(&ecs.ServiceDesc{}, nil)
+			},
+			wantedContent: `Environment test
+  Revision  Tasks  Commit   Branch  Dirty  Manifest      Copilot Version
+  --------  -----  ------   ------  -----  --------      ---------------
+  7         2      4a5b6c7  main    false  d4f25e94bbb8  v1.32.0
+  6         1      -        -       -      -             -
+
+Environment prod
+  No running tasks.
+`,
+		},
+		"print the provenance in JSON": {
+			inSvcType:        manifestinfo.BackendServiceType,
+			shouldOutputJSON: true,
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, describer *mocks.MockserviceDescriber) {
+				lister.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"test"}, nil)
+				describer.EXPECT().DescribeService("my-app", "test", "my-svc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{
+						mockTask("task1", 7, provenanceTags),
+					},
+				}, nil)
+			},
+			wantedContent: `{"environments":[{"name":"test","deployments":[{"taskDefinitionRevision":7,"tasks":["task1"],"provenance":{"gitCommit":"4a5b6c7d8e9f00112233445566778899aabbccdd","gitBranch":"main","gitDirty":false,"manifestSHA256":"d4f25e94bbb8dc26e3081450f1893638fb66f88d2ad52b71bfc9bcfa8aab846b","copilotVersion":"v1.32.0"}}]}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLister := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			mockDescriber := mocks.NewMockserviceDescriber(ctrl)
+			tc.setupMocks(mockLister, mockDescriber)
+			b := &bytes.Buffer{}
+			opts := &showSvcOpts{
+				showSvcVars: showSvcVars{
+					appName:                "my-app",
+					svcName:                "my-svc",
+					shouldOutputJSON:       tc.shouldOutputJSON,
+					shouldOutputProvenance: true,
+				},
+				w: b,
+				targetSvc: &config.Workload{
+					Name: "my-svc",
+					Type: tc.inSvcType,
+				},
+				envLister: mockLister,
+				newSvcDescriber: func(env string) (serviceDescriber, error) {
+					return mockDescriber, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func TestSvcShow_ExecuteClientsConfig(t *testing.T) {
+	mockTopology := &topology.Topology{
+		App: "my-app",
+		Nodes: []topology.Node{
+			{ID: "wkld:my-svc", Kind: topology.NodeKindService, Name: "my-svc"},
+			{ID: "wkld:api", Kind: topology.NodeKindService, Name: "api"},
+		},
+		Edges: []topology.Edge{
+			{From: "wkld:my-svc", To: "wkld:api", Kind: topology.EdgeKindCalls, Label: "API_URL"},
+		},
+	}
+	mockConfig := &describe.ClientsConfig{
+		App:     "my-app",
+		Env:     "test",
+		Service: "my-svc",
+		Services: []*describe.ServiceClient{
+			{
+				Name:           "api",
+				ServiceConnect: []*describe.ServiceConnectEndpoint{{Name: "api", Port: "8080"}},
+			},
+		},
+		Topics: []*describe.TopicClient{},
+		Queues: []*describe.QueueClient{},
+	}
+	testCases := map[string]struct {
+		wsAppName        string
+		shouldOutputJSON bool
+		setupMocks       func(lister *mocks.MockdeployedEnvironmentLister, resolver *mocks.MocktopologyResolver, describer *mocks.MockclientsConfigDescriber)
+
+		wantedCallees []string
+		wantedContent string
+		wantedError   error
+	}{
+		"error if the service is not deployed to the environment": {
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, _ *mocks.MocktopologyResolver, _ *mocks.MockclientsConfigDescriber) {
+				lister.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"prod"}, nil)
+			},
+			wantedError: errors.New("service my-svc is not deployed to environment test"),
+		},
+		"error if fail to describe the clients config": {
+			wsAppName: "my-app",
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, resolver *mocks.MocktopologyResolver, describer *mocks.MockclientsConfigDescriber) {
+				lister.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"test"}, nil)
+				resolver.EXPECT().Resolve("my-app").Return(mockTopology, nil)
+				describer.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedCallees: []string{"api"},
+			wantedError:   errors.New("describe clients config of service my-svc in environment test: some error"),
+		},
+		"print the clients config in YAML": {
+			wsAppName: "my-app",
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, resolver *mocks.MocktopologyResolver, describer *mocks.MockclientsConfigDescriber) {
+				lister.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"test"}, nil)
+				resolver.EXPECT().Resolve("my-app").Return(mockTopology, nil)
+				describer.EXPECT().Describe().Return(mockConfig, nil)
+			},
+			wantedCallees: []string{"api"},
+			wantedContent: `application: my-app
+environment: test
+service: my-svc
+services:
+  - name: api
+    serviceConnect:
+      - name: api
+        port: "8080"
+topics: []
+queues: []
+`,
+		},
+		"print the clients config in JSON without the callees outside of the workspace": {
+			wsAppName:        "other-app",
+			shouldOutputJSON: true,
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, resolver *mocks.MocktopologyResolver, describer *mocks.MockclientsConfigDescriber) {
+				lister.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"test"}, nil)
+				resolver.EXPECT().Resolve("my-app").Return(&topology.Topology{App: "my-app"}, nil)
+				describer.EXPECT().Describe().Return(&describe.ClientsConfig{
+					App:      "my-app",
+					Env:      "test",
+					Service:  "my-svc",
+					Services: []*describe.ServiceClient{},
+					Topics:   []*describe.TopicClient{},
+					Queues:   []*describe.QueueClient{},
+				}, nil)
+			},
+			wantedContent: `{"application":"my-app","environment":"test","service":"my-svc","services":[],"topics":[],"queues":[]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLister := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			mockResolver := mocks.NewMocktopologyResolver(ctrl)
+			mockDescriber := mocks.NewMockclientsConfigDescriber(ctrl)
+			tc.setupMocks(mockLister, mockResolver, mockDescriber)
+			b := &bytes.Buffer{}
+			var gotWithWorkspace bool
+			var gotCallees []string
+			opts := &showSvcOpts{
+				showSvcVars: showSvcVars{
+					appName:          "my-app",
+					svcName:          "my-svc",
+					shouldOutputJSON: tc.shouldOutputJSON,
+					clientsConfigEnv: "test",
+				},
+				w:         b,
+				envLister: mockLister,
+				wsAppName: tc.wsAppName,
+				newResolver: func(withWorkspace bool) topologyResolver {
+					gotWithWorkspace = withWorkspace
+					return mockResolver
+				},
+				newClientsConfigDescriber: func(env string, callees []string) clientsConfigDescriber {
+					require.Equal(t, "test", env)
+					gotCallees = callees
+					return mockDescriber
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.Equal(t, tc.wantedCallees, gotCallees)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wsAppName == "my-app", gotWithWorkspace)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
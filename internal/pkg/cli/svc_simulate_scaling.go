@@ -0,0 +1,275 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcSimulateScalingNamePrompt     = "Which service's autoscaling would you like to simulate?"
+	svcSimulateScalingNameHelpPrompt = "Replays the historical utilization of the service against the autoscaling configuration of its manifest."
+
+	defaultSimulateScalingSince = 24 * time.Hour
+	maxSimulateScalingSince     = 63 * 24 * time.Hour // Retention of the 5-minute datapoints of CloudWatch.
+)
+
+type svcSimulateScalingVars struct {
+	shouldOutputJSON bool
+	svcName          string
+	envName          string
+	appName          string
+	since            time.Duration
+}
+
+type svcSimulateScalingOpts struct {
+	svcSimulateScalingVars
+
+	w               io.Writer
+	store           store
+	ws              manifestReader
+	sel             deploySelector
+	newInterpolator func(app, env string) interpolator
+	unmarshal       func([]byte) (manifest.DynamicWorkload, error)
+	simulator       scalingSimulator
+	initSimulator   func(*svcSimulateScalingOpts) error
+}
+
+func newSvcSimulateScalingOpts(vars svcSimulateScalingVars) (*svcSimulateScalingOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc simulate-scaling"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	return &svcSimulateScalingOpts{
+		svcSimulateScalingVars: vars,
+		store:                  configStore,
+		ws:                     ws,
+		w:                      log.OutputWriter,
+		sel:                    selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		newInterpolator:        newManifestInterpolator,
+		unmarshal:              manifest.UnmarshalWorkload,
+		initSimulator: func(o *svcSimulateScalingOpts) error {
+			s, err := describe.NewScalingSimulator(describe.NewServiceConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create scaling simulator for service %s in application %s: %w", o.svcName, o.appName, err)
+			}
+			o.simulator = s
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcSimulateScalingOpts) Validate() error {
+	if o.since <= 0 {
+		return errors.New("--since must be greater than 0")
+	}
+	if o.since > maxSimulateScalingSince {
+		return fmt.Errorf("--since must be at most %s as older metrics aren't retained at a fine enough resolution", maxSimulateScalingSince)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcSimulateScalingOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// Execute simulates the autoscaling configuration of the local manifest of the service against its historical metrics.
+func (o *svcSimulateScalingOpts) Execute() error {
+	cfg, err := o.scalingConfig()
+	if err != nil {
+		return err
+	}
+	if err := o.initSimulator(o); err != nil {
+		return err
+	}
+	simulation, err := o.simulator.Simulate(*cfg, o.since)
+	if err != nil {
+		return fmt.Errorf("simulate scaling of service %s: %w", o.svcName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := simulation.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, simulation.HumanString())
+	return nil
+}
+
+// scalingConfig returns the autoscaling configuration of the service in the environment from its local manifest.
+func (o *svcSimulateScalingOpts) scalingConfig() (*describe.ScalingConfig, error) {
+	raw, err := o.ws.ReadWorkloadManifest(o.svcName)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest file for %s: %w", o.svcName, err)
+	}
+	interpolated, err := o.newInterpolator(o.appName, o.envName).Interpolate(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", o.svcName, err)
+	}
+	mft, err := o.unmarshal([]byte(interpolated))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal service %s manifest: %w", o.svcName, err)
+	}
+	envMft, err := mft.ApplyEnv(o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override: %w", o.envName, err)
+	}
+	var count manifest.Count
+	switch m := envMft.Manifest().(type) {
+	case *manifest.LoadBalancedWebService:
+		count = m.Count
+	case *manifest.BackendService:
+		count = m.Count
+	case *manifest.WorkerService:
+		count = m.Count
+	default:
+		return nil, fmt.Errorf("scaling simulations are only supported for services deployed to ECS, but %s is not", o.svcName)
+	}
+	adv := count.AdvancedCount
+	if adv.CPU.IsEmpty() && adv.Memory.IsEmpty() {
+		return nil, fmt.Errorf(`service %s doesn't scale on "count.cpu_percentage" or "count.memory_percentage" in environment %s`, o.svcName, o.envName)
+	}
+	min, max, err := adv.Range.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("parse count range of service %s: %w", o.svcName, err)
+	}
+	if !adv.Requests.IsEmpty() || !adv.ResponseTime.IsEmpty() || !adv.Connections.IsEmpty() || !adv.QueueScaling.IsEmpty() ||
+		len(adv.CustomMetrics) > 0 || len(adv.Schedules) > 0 {
+		log.Warningf("Only the cpu_percentage and memory_percentage policies of service %s are simulated.\n", o.svcName)
+	}
+	cfg := &describe.ScalingConfig{
+		MinCount: min,
+		MaxCount: max,
+	}
+	for _, policy := range []struct {
+		metric string
+		config manifest.ScalingConfigOrT[manifest.Percentage]
+	}{
+		{metric: describe.ScalingMetricCPU, config: adv.CPU},
+		{metric: describe.ScalingMetricMemory, config: adv.Memory},
+	} {
+		target := policy.config.Value
+		if target == nil {
+			target = policy.config.ScalingConfig.Value
+		}
+		if target == nil {
+			continue
+		}
+		// The cooldown of a policy takes precedence over the cooldown of the count.
+		cooldown := adv.Cooldown
+		if in := policy.config.ScalingConfig.Cooldown.ScaleInCooldown; in != nil {
+			cooldown.ScaleInCooldown = in
+		}
+		if out := policy.config.ScalingConfig.Cooldown.ScaleOutCooldown; out != nil {
+			cooldown.ScaleOutCooldown = out
+		}
+		cfg.Policies = append(cfg.Policies, describe.NewScalingPolicy(policy.metric, float64(*target), cooldown.ScaleInCooldown, cooldown.ScaleOutCooldown))
+	}
+	return cfg, nil
+}
+
+func (o *svcSimulateScalingOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcSimulateScalingOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcSimulateScalingNamePrompt, svcSimulateScalingNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcSimulateScalingCmd builds the command for simulating the autoscaling of a service with its historical metrics.
+func buildSvcSimulateScalingCmd() *cobra.Command {
+	vars := svcSimulateScalingVars{}
+	cmd := &cobra.Command{
+		Use:   "simulate-scaling",
+		Short: "Simulates how the autoscaling of a service would have behaved with its historical load.",
+		Long: `Simulates how the "count" of the local manifest of a service would have scaled it with its historical load.
+Replays the CPU and memory utilization of the deployed service against the range, targets and cooldowns of the manifest,
+and shows the number of tasks over time, the datapoints above each target, and the scaling events.`,
+
+		Example: `
+  Simulates the autoscaling of "my-svc" in the "prod" environment over the last day.
+  /code $ copilot svc simulate-scaling -n my-svc -e prod
+  Simulates the autoscaling over the last week in JSON.
+  /code $ copilot svc simulate-scaling -n my-svc -e prod --since 168h --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcSimulateScalingOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().DurationVar(&vars.since, sinceFlag, defaultSimulateScalingSince, simulateScalingSinceFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcSimulateScaling_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSince time.Duration
+
+		wantedErr string
+	}{
+		"error if the duration is not positive": {
+			inSince:   -time.Minute,
+			wantedErr: "--since must be greater than 0",
+		},
+		"error if the duration is longer than the retention of the metrics": {
+			inSince:   90 * 24 * time.Hour,
+			wantedErr: "--since must be at most 1512h0m0s as older metrics aren't retained at a fine enough resolution",
+		},
+		"valid duration": {
+			inSince: 168 * time.Hour,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcSimulateScalingOpts{
+				svcSimulateScalingVars: svcSimulateScalingVars{
+					since: tc.inSince,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type svcSimulateScalingMocks struct {
+	ws           *mocks.MockmanifestReader
+	interpolator *mocks.Mockinterpolator
+	simulator    *mocks.MockscalingSimulator
+}
+
+func TestSvcSimulateScaling_Execute(t *testing.T) {
+	const mft = `name: api
+type: Backend Service
+image:
+  location: nginx
+count:
+  range: 1-10
+  cooldown:
+    in: 30s
+  cpu_percentage: 70
+environments:
+  prod:
+    count:
+      range: 2-20
+      memory_percentage:
+        value: 80
+        cooldown:
+          in: 5m
+          out: 10s
+`
+	simulation := &describe.ScalingSimulation{
+		MinCount:      2,
+		MaxCount:      20,
+		PeriodSeconds: 60,
+		Timestamps:    []time.Time{},
+		ObservedTasks: []int{},
+		Tasks:         []int{},
+		Policies:      []*describe.SimulatedScalingPolicy{},
+		Events:        []*describe.ScalingEvent{},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m svcSimulateScalingMocks)
+
+		wantedOutput string
+		wantedErr    string
+	}{
+		"error if the manifest can't be read": {
+			setupMocks: func(m svcSimulateScalingMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "read manifest file for api: some error",
+		},
+		"error if the service doesn't scale on CPU or memory": {
+			setupMocks: func(m svcSimulateScalingMocks) {
+				const noScaling = `name: api
+type: Backend Service
+image:
+  location: nginx
+count: 2
+`
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(noScaling), nil)
+				m.interpolator.EXPECT().Interpolate(noScaling).Return(noScaling, nil)
+			},
+			wantedErr: `service api doesn't scale on "count.cpu_percentage" or "count.memory_percentage" in environment prod`,
+		},
+		"error if the simulation fails": {
+			setupMocks: func(m svcSimulateScalingMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(mft), nil)
+				m.interpolator.EXPECT().Interpolate(mft).Return(mft, nil)
+				m.simulator.EXPECT().Simulate(gomock.Any(), time.Hour).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "simulate scaling of service api: some error",
+		},
+		"simulates the policies of the environment with their cooldowns": {
+			inJSON: true,
+			setupMocks: func(m svcSimulateScalingMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(mft), nil)
+				m.interpolator.EXPECT().Interpolate(mft).Return(mft, nil)
+				m.simulator.EXPECT().Simulate(describe.ScalingConfig{
+					MinCount: 2,
+					MaxCount: 20,
+					Policies: []describe.ScalingPolicy{
+						{Metric: "CPU", Target: 70, ScaleInCooldown: 30 * time.Second, ScaleOutCooldown: time.Minute},
+						{Metric: "Memory", Target: 80, ScaleInCooldown: 5 * time.Minute, ScaleOutCooldown: 10 * time.Second},
+					},
+				}, time.Hour).Return(simulation, nil)
+			},
+			wantedOutput: `{"minCount":2,"maxCount":20,"periodSeconds":60,"timestamps":[],"observedTasks":[],"tasks":[],"policies":[],"events":[],"saturated":0}
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcSimulateScalingMocks{
+				ws:           mocks.NewMockmanifestReader(ctrl),
+				interpolator: mocks.NewMockinterpolator(ctrl),
+				simulator:    mocks.NewMockscalingSimulator(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+
+			opts := &svcSimulateScalingOpts{
+				svcSimulateScalingVars: svcSimulateScalingVars{
+					appName:          "phonetool",
+					envName:          "prod",
+					svcName:          "api",
+					since:            time.Hour,
+					shouldOutputJSON: tc.inJSON,
+				},
+				ws: m.ws,
+				newInterpolator: func(app, env string) interpolator {
+					return m.interpolator
+				},
+				unmarshal:     manifest.UnmarshalWorkload,
+				simulator:     m.simulator,
+				initSimulator: func(*svcSimulateScalingOpts) error { return nil },
+				w:             b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
@@ -29,6 +29,7 @@ const (
 
 type svcStatusVars struct {
 	shouldOutputJSON bool
+	outputFormat     outputFormatVars
 	svcName          string
 	envName          string
 	appName          string
@@ -44,8 +45,7 @@ type svcStatusOpts struct {
 	initStatusDescriber func(*svcStatusOpts) error
 }
 
-func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc status"))
+func newSvcStatusOpts(vars svcStatusVars, sessProvider *sessions.Provider) (*svcStatusOpts, error) {
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %v", err)
@@ -73,6 +73,8 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 					Env:         o.envName,
 					Svc:         o.svcName,
 					ConfigStore: configStore,
+
+					SessionProvider: sessProvider,
 				})
 				if err != nil {
 					return fmt.Errorf("create status describer for App Runner service %s in application %s: %w", o.svcName, o.appName, err)
@@ -84,6 +86,8 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 					Env:         o.envName,
 					Svc:         o.svcName,
 					ConfigStore: configStore,
+
+					SessionProvider: sessProvider,
 				})
 				if err != nil {
 					return fmt.Errorf("create status describer for Static Site service %s in application %s: %w", o.svcName, o.appName, err)
@@ -95,6 +99,8 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 					Env:         o.envName,
 					Svc:         o.svcName,
 					ConfigStore: configStore,
+
+					SessionProvider: sessProvider,
 				})
 				if err != nil {
 					return fmt.Errorf("create status describer for service %s in application %s: %w", o.svcName, o.appName, err)
@@ -108,7 +114,7 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *svcStatusOpts) Validate() error {
-	return nil
+	return o.outputFormat.validate()
 }
 
 // Ask prompts for and validates any required flags.
@@ -129,16 +135,17 @@ func (o *svcStatusOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
 	}
-	if o.shouldOutputJSON {
-		data, err := svcStatus.JSONString()
-		if err != nil {
-			return err
-		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, svcStatus.HumanString())
+	if o.shouldOutputJSON || o.outputFormat.isSet() {
+		return o.outputFormat.writeJSON(o.w, func(w io.Writer) error {
+			data, err := svcStatus.JSONString()
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(w, data)
+			return nil
+		})
 	}
-
+	fmt.Fprint(o.w, svcStatus.HumanString())
 	return nil
 }
 
@@ -190,7 +197,7 @@ func buildSvcStatusCmd() *cobra.Command {
   Shows status of the deployed service "my-svc"
   /code $ copilot svc status -n my-svc`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newSvcStatusOpts(vars)
+			opts, err := newSvcStatusOpts(vars, sessions.ImmutableProvider(sessions.UserAgentExtras("svc status")))
 			if err != nil {
 				return err
 			}
@@ -201,5 +208,7 @@ func buildSvcStatusCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.format, formatFlag, "", formatFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat.query, queryFlag, "", queryFlagDescription)
 	return cmd
 }
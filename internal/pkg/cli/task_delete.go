@@ -6,7 +6,9 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/spf13/afero"
@@ -22,6 +24,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -30,6 +33,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/cobra"
 )
 
@@ -39,6 +43,7 @@ const (
 	taskDeleteEnvPrompt               = "Which environment would you like to delete a task from?"
 	fmtTaskDeleteDefaultConfirmPrompt = "Are you sure you want to delete %s from the default cluster?"
 	fmtTaskDeleteFromEnvConfirmPrompt = "Are you sure you want to delete %s from application %s and environment %s?"
+	fmtTaskDeleteStaleConfirmPrompt   = "Are you sure you want to delete %s inactive for more than %s: %s?"
 	taskDeleteConfirmHelp             = "This will delete the task's stack and stop all current executions."
 )
 
@@ -50,6 +55,8 @@ type deleteTaskVars struct {
 	env              string
 	skipConfirmation bool
 	defaultCluster   bool
+	staleAfter       time.Duration
+	dryRun           bool
 }
 
 type deleteTaskOpts struct {
@@ -69,10 +76,17 @@ type deleteTaskOpts struct {
 	newImageRemover  func(session *session.Session) imageRemover
 	newBucketEmptier func(session *session.Session) bucketEmptier
 	newStackManager  func(session *session.Session) taskStackManager
+	newStackLister   func(session *session.Session) taskStackLister
+	now              func() time.Time
+
+	// Dependencies to list the resources to delete with --dry-run.
+	w                 io.Writer
+	newStackResources func(session *session.Session) stackResourcesLister
 
 	// Cached variables
-	session   *session.Session
-	stackInfo *deploy.TaskStackInfo
+	session    *session.Session
+	stackInfo  *deploy.TaskStackInfo
+	staleTasks []deploy.TaskStackInfo
 }
 
 func newDeleteTaskOpts(vars deleteTaskVars) (*deleteTaskOpts, error) {
@@ -114,11 +128,48 @@ func newDeleteTaskOpts(vars deleteTaskVars) (*deleteTaskOpts, error) {
 		newBucketEmptier: func(session *session.Session) bucketEmptier {
 			return s3.New(session)
 		},
+		newStackLister: func(session *session.Session) taskStackLister {
+			return cloudformation.New(session, cloudformation.WithProgressTracker(os.Stderr))
+		},
+		now: time.Now,
+		w:   os.Stdout,
+		newStackResources: func(session *session.Session) stackResourcesLister {
+			return awscfn.New(session)
+		},
 	}, nil
 }
 
+// newTaskResourcesDeleter returns the options to delete the resources of a task with an existing session without prompting.
+func newTaskResourcesDeleter(vars deleteTaskVars, sess *session.Session, store store, provider sessionProvider, spinner progress) *deleteTaskOpts {
+	vars.skipConfirmation = true
+	return &deleteTaskOpts{
+		deleteTaskVars: vars,
+
+		store:    store,
+		spinner:  spinner,
+		provider: provider,
+		session:  sess,
+		newImageRemover: func(session *session.Session) imageRemover {
+			return ecr.New(session)
+		},
+		newBucketEmptier: func(session *session.Session) bucketEmptier {
+			return s3.New(session)
+		},
+		newStackManager: func(session *session.Session) taskStackManager {
+			return cloudformation.New(session, cloudformation.WithProgressTracker(os.Stderr))
+		},
+	}
+}
+
 // Validate checks that flag inputs are valid.
 func (o *deleteTaskOpts) Validate() error {
+	if o.staleAfter < 0 {
+		return fmt.Errorf("--%s must be a positive duration", staleFlag)
+	}
+
+	if o.staleAfter > 0 && o.name != "" {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", nameFlag, staleFlag)
+	}
 
 	if o.name != "" {
 		if err := basicNameValidation(o.name); err != nil {
@@ -252,11 +303,15 @@ func (o *deleteTaskOpts) Ask() error {
 		return err
 	}
 
+	if o.staleAfter > 0 {
+		return o.askStaleTasks()
+	}
+
 	if err := o.askTaskName(); err != nil {
 		return err
 	}
 
-	if o.skipConfirmation {
+	if o.skipConfirmation || o.dryRun {
 		return nil
 	}
 
@@ -285,6 +340,37 @@ func (o *deleteTaskOpts) Ask() error {
 	return nil
 }
 
+func (o *deleteTaskOpts) askStaleTasks() error {
+	sess, err := o.getSession()
+	if err != nil {
+		return fmt.Errorf("get task list session: %w", err)
+	}
+	tasks, err := listTaskStacks(o.newStackLister(sess), o.app, o.env, o.defaultCluster)
+	if err != nil {
+		return err
+	}
+	o.staleTasks = staleTaskStacks(tasks, o.now().Add(-o.staleAfter))
+	if len(o.staleTasks) == 0 || o.skipConfirmation || o.dryRun {
+		return nil
+	}
+
+	names := make([]string, len(o.staleTasks))
+	for i, task := range o.staleTasks {
+		names[i] = color.HighlightUserInput(task.TaskName())
+	}
+	deleteConfirmed, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtTaskDeleteStaleConfirmPrompt, english.Plural(len(names), "task", ""), o.staleAfter, english.WordSeries(names, "and")),
+		taskDeleteConfirmHelp,
+		prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("task delete confirmation prompt: %w", err)
+	}
+	if !deleteConfirmed {
+		return errTaskDeleteCancelled
+	}
+	return nil
+}
+
 func (o *deleteTaskOpts) getSession() (*session.Session, error) {
 	if o.session != nil {
 		return o.session, nil
@@ -337,18 +423,73 @@ func (o *deleteTaskOpts) askTaskName() error {
 }
 
 func (o *deleteTaskOpts) Execute() error {
+	if o.dryRun {
+		return o.writeDeletionPlan()
+	}
+	if o.staleAfter > 0 {
+		return o.deleteStaleTasks()
+	}
 	if err := o.stopTasks(); err != nil {
 		return err
 	}
-	if err := o.clearECRRepository(); err != nil {
-		return err
+	return o.DeleteResources()
+}
+
+// writeDeletionPlan writes the resources that deleting the task, or the stale tasks, would remove.
+func (o *deleteTaskOpts) writeDeletionPlan() error {
+	stackNames := []string{string(stack.NameForTask(o.name))}
+	title := fmt.Sprintf("Deleting task %s", o.name)
+	if o.staleAfter > 0 {
+		if len(o.staleTasks) == 0 {
+			log.Infof("No tasks have been inactive for more than %s.\n", o.staleAfter)
+			return nil
+		}
+		stackNames = make([]string, len(o.staleTasks))
+		for i, task := range o.staleTasks {
+			stackNames[i] = task.StackName
+		}
+		title = fmt.Sprintf("Deleting %s inactive for more than %s", english.Plural(len(o.staleTasks), "task", ""), o.staleAfter)
 	}
-	if err := o.deleteStack(); err != nil {
-		return err
+	sess, err := o.getSession()
+	if err != nil {
+		return fmt.Errorf("get session: %w", err)
+	}
+	var plan deletionPlan
+	for _, name := range stackNames {
+		if err := plan.addStack(o.newStackResources(sess), name); err != nil {
+			return err
+		}
+	}
+	plan.write(o.w, title)
+	return nil
+}
+
+func (o *deleteTaskOpts) deleteStaleTasks() error {
+	if len(o.staleTasks) == 0 {
+		log.Infof("No tasks have been inactive for more than %s.\n", o.staleAfter)
+		return nil
+	}
+	for _, task := range o.staleTasks {
+		o.name = task.TaskName()
+		o.stackInfo = nil
+		if err := o.stopTasks(); err != nil {
+			return err
+		}
+		if err := o.DeleteResources(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// DeleteResources empties the ECR repository of the task and deletes its stack.
+func (o *deleteTaskOpts) DeleteResources() error {
+	if err := o.clearECRRepository(); err != nil {
+		return err
+	}
+	return o.deleteStack()
+}
+
 func (o *deleteTaskOpts) stopTasks() error {
 	sess, err := o.getSession()
 	if err != nil {
@@ -479,8 +620,9 @@ func (o *deleteTaskOpts) RecommendActions() error {
 func BuildTaskDeleteCmd() *cobra.Command {
 	vars := deleteTaskVars{}
 	cmd := &cobra.Command{
-		Use:   "delete",
-		Short: "Deletes a one-off task from an application or default cluster.",
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Deletes a one-off task from an application or default cluster.",
 		Example: `
   Delete the "test" task from the default cluster.
   /code $ copilot task delete --name test --default
@@ -489,7 +631,13 @@ func BuildTaskDeleteCmd() *cobra.Command {
   /code $ copilot task delete --name db-migrate --env prod
 
   Delete the "test" task without confirmation prompt.
-  /code $ copilot task delete --name test --yes`,
+  /code $ copilot task delete --name test --yes
+
+  Delete the tasks of the prod environment that have not been run in the past week.
+  /code $ copilot task rm --env prod --stale
+
+  List the resources that deleting the "db-migrate" task would remove, without deleting them.
+  /code $ copilot task delete --name db-migrate --env prod --dry-run`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeleteTaskOpts(vars)
 			if err != nil {
@@ -504,5 +652,9 @@ func BuildTaskDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.env, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultCluster, taskDefaultFlag, false, taskDeleteDefaultFlagDescription)
+	cmd.Flags().DurationVar(&vars.staleAfter, staleFlag, 0, taskDeleteStaleFlagDescription)
+	cmd.Flags().Lookup(staleFlag).NoOptDefVal = defaultTaskStaleAfter.String()
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, deleteDryRunFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(dryRunFlag, yesFlag)
 	return cmd
 }
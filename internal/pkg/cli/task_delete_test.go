@@ -6,7 +6,9 @@ package cli
 import (
 	"errors"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 
@@ -33,6 +35,7 @@ func TestDeleteTaskOpts_Validate(t *testing.T) {
 		inEnvName        string
 		inName           string
 		inDefaultCluster bool
+		inStaleAfter     time.Duration
 		setupMocks       func(m validateMocks)
 
 		want error
@@ -107,6 +110,17 @@ func TestDeleteTaskOpts_Validate(t *testing.T) {
 			},
 			want: errors.New("get application: some error"),
 		},
+		"with negative stale duration": {
+			inStaleAfter: -time.Hour,
+			setupMocks:   func(m validateMocks) {},
+			want:         errors.New("--stale must be a positive duration"),
+		},
+		"with both name and stale flags": {
+			inName:       "oneoff",
+			inStaleAfter: time.Hour,
+			setupMocks:   func(m validateMocks) {},
+			want:         errors.New("cannot specify both `--name` and `--stale`"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -131,6 +145,7 @@ func TestDeleteTaskOpts_Validate(t *testing.T) {
 					env:              tc.inEnvName,
 					name:             tc.inName,
 					defaultCluster:   tc.inDefaultCluster,
+					staleAfter:       tc.inStaleAfter,
 				},
 				store: mockstore,
 				newStackManager: func(_ *session.Session) taskStackManager {
@@ -160,14 +175,17 @@ func TestDeleteTaskOpts_Ask(t *testing.T) {
 		inName             string
 		inDefaultCluster   bool
 		inSkipConfirmation bool
+		inStaleAfter       time.Duration
 
 		mockStore      func(m *mocks.Mockstore)
 		mockSel        func(m *mocks.MockwsSelector)
 		mockTaskSelect func(m *mocks.MockcfTaskSelector)
 		mockSess       func(m *mocks.MocksessionProvider)
 		mockPrompter   func(m *mocks.Mockprompter)
+		mockLister     func(m *mocks.MocktaskStackLister)
 
-		wantErr string
+		wantErr        string
+		wantStaleTasks []deploy.TaskStackInfo
 	}{
 		"all flags specified": {
 			inAppName:          "phonetool",
@@ -255,6 +273,49 @@ func TestDeleteTaskOpts_Ask(t *testing.T) {
 				m.EXPECT().Confirm("Are you sure you want to delete abc from application phonetool and environment test?", gomock.Any(), gomock.Any()).Return(true, nil)
 			},
 		},
+		"stale flag specified": {
+			inAppName:    "phonetool",
+			inEnvName:    "test",
+			inStaleAfter: 24 * time.Hour,
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test", App: "phonetool"}, nil)
+			},
+			mockSel:        func(m *mocks.MockwsSelector) {},
+			mockTaskSelect: func(m *mocks.MockcfTaskSelector) {},
+			mockSess: func(m *mocks.MocksessionProvider) {
+				m.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil)
+			},
+			mockLister: func(m *mocks.MocktaskStackLister) {
+				m.EXPECT().ListTaskStacks("phonetool", "test").Return([]deploy.TaskStackInfo{
+					{StackName: "task-recent", LastUpdated: time.Date(2023, time.March, 10, 0, 0, 0, 0, time.UTC)},
+					{StackName: "task-old", LastUpdated: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)},
+				}, nil)
+			},
+			mockPrompter: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm("Are you sure you want to delete 1 task inactive for more than 24h0m0s: old?", gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			wantStaleTasks: []deploy.TaskStackInfo{
+				{StackName: "task-old", LastUpdated: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		"stale flag specified and no stale tasks": {
+			inDefaultCluster: true,
+			inStaleAfter:     24 * time.Hour,
+
+			mockStore:      func(m *mocks.Mockstore) {},
+			mockSel:        func(m *mocks.MockwsSelector) {},
+			mockTaskSelect: func(m *mocks.MockcfTaskSelector) {},
+			mockSess: func(m *mocks.MocksessionProvider) {
+				m.EXPECT().Default().Return(&session.Session{}, nil)
+			},
+			mockLister: func(m *mocks.MocktaskStackLister) {
+				m.EXPECT().ListDefaultTaskStacks().Return([]deploy.TaskStackInfo{
+					{StackName: "task-recent", LastUpdated: time.Date(2023, time.March, 10, 0, 0, 0, 0, time.UTC)},
+				}, nil)
+			},
+			mockPrompter: func(m *mocks.Mockprompter) {},
+		},
 		"no flags specified (default path)": {
 			mockStore: func(m *mocks.Mockstore) {},
 			mockSel: func(m *mocks.MockwsSelector) {
@@ -289,6 +350,10 @@ func TestDeleteTaskOpts_Ask(t *testing.T) {
 			tc.mockSess(mockSess)
 			tc.mockTaskSelect(mockTaskSel)
 			tc.mockPrompter(mockPrompt)
+			mockLister := mocks.NewMocktaskStackLister(ctrl)
+			if tc.mockLister != nil {
+				tc.mockLister(mockLister)
+			}
 
 			opts := deleteTaskOpts{
 				deleteTaskVars: deleteTaskVars{
@@ -297,14 +362,19 @@ func TestDeleteTaskOpts_Ask(t *testing.T) {
 					app:              tc.inAppName,
 					env:              tc.inEnvName,
 					name:             tc.inName,
+					staleAfter:       tc.inStaleAfter,
 				},
 
 				store:    mockStore,
 				sel:      mockSel,
 				provider: mockSess,
 				prompt:   mockPrompt,
+				now: func() time.Time {
+					return time.Date(2023, time.March, 11, 0, 0, 0, 0, time.UTC)
+				},
 
-				newTaskSel: func(sess *session.Session) cfTaskSelector { return mockTaskSel },
+				newTaskSel:     func(sess *session.Session) cfTaskSelector { return mockTaskSel },
+				newStackLister: func(sess *session.Session) taskStackLister { return mockLister },
 			}
 
 			// WHEN
@@ -315,6 +385,7 @@ func TestDeleteTaskOpts_Ask(t *testing.T) {
 				require.EqualError(t, err, tc.wantErr)
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.wantStaleTasks, opts.staleTasks)
 			}
 		})
 	}
@@ -328,6 +399,8 @@ type deleteTaskMocks struct {
 	ecs     *mocks.MocktaskStopper
 	cfn     *mocks.MocktaskStackManager
 	spinner *mocks.Mockprogress
+
+	stackResources *mocks.MockstackResourcesLister
 }
 
 func TestDeleteTaskOpts_Execute(t *testing.T) {
@@ -362,15 +435,41 @@ func TestDeleteTaskOpts_Execute(t *testing.T) {
 	mockError := errors.New("some error")
 
 	testCases := map[string]struct {
-		inDefault bool
-		inApp     string
-		inEnv     string
-		inName    string
+		inDefault    bool
+		inApp        string
+		inEnv        string
+		inName       string
+		inStaleAfter time.Duration
+		inStaleTasks []deploy.TaskStackInfo
+		inDryRun     bool
 
 		setupMocks func(mocks deleteTaskMocks)
 
-		wantedErr error
+		wantedOutput string
+		wantedErr    error
 	}{
+		"list the resources of the stale tasks to delete with --dry-run without deleting them": {
+			inDefault:    true,
+			inStaleAfter: time.Hour,
+			inStaleTasks: []deploy.TaskStackInfo{{StackName: "task-old"}},
+			inDryRun:     true,
+
+			setupMocks: func(m deleteTaskMocks) {
+				m.sess.EXPECT().Default().Return(&session.Session{}, nil)
+				m.stackResources.EXPECT().TemplateBody("task-old").Return("Resources: {}", nil)
+				m.stackResources.EXPECT().StackResources("task-old").Return([]*awscfn.StackResource{
+					stackResource("ECRRepo", "copilot-old", "AWS::ECR::Repository"),
+				}, nil)
+			},
+			wantedOutput: `Deleting 1 task inactive for more than 1h0m0s would remove:
+
+  Type                  Name         Data loss
+  ----                  ----         ---------
+  CloudFormation stack  task-old     -
+  ECR repository        copilot-old  All the images in the repository
+
+`,
+		},
 		"success with app/env": {
 			inApp:  mockApp,
 			inEnv:  mockEnvName,
@@ -615,6 +714,36 @@ func TestDeleteTaskOpts_Execute(t *testing.T) {
 				)
 			},
 		},
+		"success deleting stale tasks": {
+			inDefault:    true,
+			inStaleAfter: time.Hour,
+			inStaleTasks: []deploy.TaskStackInfo{
+				{StackName: "task-old"},
+				{StackName: mockTaskStackName},
+			},
+
+			setupMocks: func(m deleteTaskMocks) {
+				m.spinner.EXPECT().Start(gomock.Any()).AnyTimes()
+				m.spinner.EXPECT().Stop(gomock.Any()).AnyTimes()
+				gomock.InOrder(
+					m.sess.EXPECT().Default().Return(&session.Session{}, nil),
+					m.ecs.EXPECT().StopDefaultClusterTasks("old").Return(nil),
+					m.ecr.EXPECT().ClearRepository("copilot-old").Return(nil),
+					m.cfn.EXPECT().GetTaskStack("old").Return(&deploy.TaskStackInfo{StackName: "task-old"}, nil),
+					m.cfn.EXPECT().DeleteTask(deploy.TaskStackInfo{StackName: "task-old"}).Return(nil),
+					m.ecs.EXPECT().StopDefaultClusterTasks(mockTaskName).Return(nil),
+					m.ecr.EXPECT().ClearRepository(mockTaskRepoName).Return(nil),
+					m.cfn.EXPECT().GetTaskStack(mockTaskName).Return(&mockDefaultTaskNoBucket, nil),
+					m.cfn.EXPECT().DeleteTask(mockDefaultTaskNoBucket).Return(nil),
+				)
+			},
+		},
+		"no stale tasks to delete": {
+			inDefault:    true,
+			inStaleAfter: time.Hour,
+
+			setupMocks: func(m deleteTaskMocks) {},
+		},
 		"error stopping default cluster tasks": {
 			inDefault: true,
 			inName:    mockTaskName,
@@ -668,9 +797,12 @@ func TestDeleteTaskOpts_Execute(t *testing.T) {
 				ecs:     mockECS,
 				cfn:     mockCFN,
 				spinner: mockSpinner,
+
+				stackResources: mocks.NewMockstackResourcesLister(ctrl),
 			}
 
 			tc.setupMocks(mocks)
+			b := new(strings.Builder)
 
 			opts := deleteTaskOpts{
 				deleteTaskVars: deleteTaskVars{
@@ -678,15 +810,24 @@ func TestDeleteTaskOpts_Execute(t *testing.T) {
 					env:            tc.inEnv,
 					name:           tc.inName,
 					defaultCluster: tc.inDefault,
+					staleAfter:     tc.inStaleAfter,
+					dryRun:         tc.inDryRun,
 				},
 				store:    mockstore,
 				provider: mockSession,
 				spinner:  mockSpinner,
 
+				staleTasks: tc.inStaleTasks,
+
 				newImageRemover:  mockGetECR,
 				newBucketEmptier: mockGetS3,
 				newStackManager:  mockGetCFN,
 				newTaskStopper:   mockGetECS,
+
+				w: b,
+				newStackResources: func(_ *session.Session) stackResourcesLister {
+					return mocks.stackResources
+				},
 			}
 
 			// WHEN
@@ -698,6 +839,7 @@ func TestDeleteTaskOpts_Execute(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			require.Equal(t, tc.wantedOutput, b.String())
 
 		})
 	}
@@ -0,0 +1,283 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	taskListAppPrompt = "Which application would you like to list tasks from?"
+	taskListEnvPrompt = "Which environment would you like to list tasks from?"
+
+	// defaultTaskStaleAfter is the duration used when --stale is specified without a value.
+	defaultTaskStaleAfter = 7 * 24 * time.Hour
+)
+
+type listTaskVars struct {
+	app              string
+	env              string
+	defaultCluster   bool
+	staleAfter       time.Duration
+	shouldOutputJSON bool
+}
+
+type listTaskOpts struct {
+	listTaskVars
+	wsAppName string
+
+	store    store
+	sel      appEnvSelector
+	provider sessionProvider
+	w        io.Writer
+	now      func() time.Time
+
+	newTaskStackLister func(session *session.Session) taskStackLister
+}
+
+func newListTaskOpts(vars listTaskVars) (*listTaskOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("task ls"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &listTaskOpts{
+		listTaskVars: vars,
+		wsAppName:    tryReadingAppName(),
+		store:        store,
+		sel:          selector.NewAppEnvSelector(prompter, store),
+		provider:     sessProvider,
+		w:            os.Stdout,
+		now:          time.Now,
+		newTaskStackLister: func(session *session.Session) taskStackLister {
+			return cloudformation.New(session, cloudformation.WithProgressTracker(os.Stderr))
+		},
+	}, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *listTaskOpts) Validate() error {
+	if o.staleAfter < 0 {
+		return fmt.Errorf("--%s must be a positive duration", staleFlag)
+	}
+	if !o.defaultCluster {
+		return nil
+	}
+	// The app flag defaults to the workspace app, so only error if it was set to a different app.
+	if o.app != o.wsAppName {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", appFlag, taskDefaultFlag)
+	}
+	if o.env != "" {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", envFlag, taskDefaultFlag)
+	}
+	return nil
+}
+
+// Ask prompts for the application and environment to list tasks from, if they are not provided.
+func (o *listTaskOpts) Ask() error {
+	if o.defaultCluster {
+		return nil
+	}
+	if o.app == "" {
+		app, err := o.sel.Application(taskListAppPrompt, "", appEnvOptionNone)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		if app == appEnvOptionNone {
+			o.defaultCluster = true
+			return nil
+		}
+		o.app = app
+	}
+	if o.env == "" {
+		env, err := o.sel.Environment(taskListEnvPrompt, "", o.app, prompt.Option{Value: appEnvOptionNone})
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		if env == appEnvOptionNone {
+			o.app = ""
+			o.defaultCluster = true
+			return nil
+		}
+		o.env = env
+	}
+	return nil
+}
+
+// Execute lists the one-off tasks deployed in the environment or the default cluster.
+func (o *listTaskOpts) Execute() error {
+	sess, err := taskSession(o.provider, o.store, o.app, o.env, o.defaultCluster)
+	if err != nil {
+		return err
+	}
+	tasks, err := listTaskStacks(o.newTaskStackLister(sess), o.app, o.env, o.defaultCluster)
+	if err != nil {
+		return err
+	}
+	if o.staleAfter > 0 {
+		tasks = staleTaskStacks(tasks, o.now().Add(-o.staleAfter))
+	}
+	if o.shouldOutputJSON {
+		return o.writeJSON(tasks)
+	}
+	o.writeHuman(tasks)
+	return nil
+}
+
+func (o *listTaskOpts) writeHuman(tasks []deploy.TaskStackInfo) {
+	if len(tasks) == 0 {
+		if o.staleAfter > 0 {
+			fmt.Fprintf(o.w, "No tasks have been inactive for more than %s.\n", o.staleAfter)
+			return
+		}
+		fmt.Fprintln(o.w, "No tasks found.")
+		return
+	}
+	tw := tabwriter.NewWriter(o.w, 4, 4, 2, ' ', 0)
+	headers := []string{"Name", "Last Updated"}
+	fmt.Fprintf(tw, "%s\n", strings.Join(headers, "\t"))
+	separators := make([]string, len(headers))
+	for i, header := range headers {
+		separators[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(tw, "%s\n", strings.Join(separators, "\t"))
+	for _, task := range tasks {
+		fmt.Fprintf(tw, "%s\t%s\n", task.TaskName(), humanize.RelTime(task.LastUpdated, o.now(), "ago", "from now"))
+	}
+	tw.Flush()
+}
+
+func (o *listTaskOpts) writeJSON(tasks []deploy.TaskStackInfo) error {
+	type serializedTask struct {
+		Name        string    `json:"name"`
+		LastUpdated time.Time `json:"lastUpdated"`
+	}
+	out := struct {
+		Tasks []serializedTask `json:"tasks"`
+	}{
+		Tasks: make([]serializedTask, len(tasks)),
+	}
+	for i, task := range tasks {
+		out.Tasks[i] = serializedTask{
+			Name:        task.TaskName(),
+			LastUpdated: task.LastUpdated,
+		}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("marshal tasks: %w", err)
+	}
+	fmt.Fprintf(o.w, "%s\n", b)
+	return nil
+}
+
+// taskSession returns the session to manage the one-off tasks of an environment or of the default cluster with.
+func taskSession(provider sessionProvider, store store, app, env string, defaultCluster bool) (*session.Session, error) {
+	if defaultCluster {
+		sess, err := provider.Default()
+		if err != nil {
+			return nil, fmt.Errorf("get default session: %w", err)
+		}
+		return sess, nil
+	}
+	envConfig, err := store.GetEnvironment(app, env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", env, err)
+	}
+	sess, err := provider.FromRole(envConfig.ManagerRoleARN, envConfig.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get session from role %s and region %s: %w", envConfig.ManagerRoleARN, envConfig.Region, err)
+	}
+	return sess, nil
+}
+
+// listTaskStacks returns the stacks of the one-off tasks deployed in the environment or the default cluster, sorted by name.
+func listTaskStacks(lister taskStackLister, app, env string, defaultCluster bool) ([]deploy.TaskStackInfo, error) {
+	var tasks []deploy.TaskStackInfo
+	var err error
+	if defaultCluster {
+		tasks, err = lister.ListDefaultTaskStacks()
+		if err != nil {
+			return nil, fmt.Errorf("list tasks in the default cluster: %w", err)
+		}
+	} else {
+		tasks, err = lister.ListTaskStacks(app, env)
+		if err != nil {
+			return nil, fmt.Errorf("list tasks in environment %s: %w", env, err)
+		}
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].StackName < tasks[j].StackName
+	})
+	return tasks, nil
+}
+
+// staleTaskStacks returns the task stacks that were last updated before the cutoff.
+func staleTaskStacks(tasks []deploy.TaskStackInfo, cutoff time.Time) []deploy.TaskStackInfo {
+	var stale []deploy.TaskStackInfo
+	for _, task := range tasks {
+		if task.LastUpdated.Before(cutoff) {
+			stale = append(stale, task)
+		}
+	}
+	return stale
+}
+
+// buildTaskListCmd builds the command to list one-off tasks.
+func buildTaskListCmd() *cobra.Command {
+	vars := listTaskVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the one-off tasks of an environment or the default cluster.",
+		Example: `
+  Lists the tasks deployed in the "test" environment.
+  /code $ copilot task ls --env test
+  Lists the tasks of the default cluster that have not been run in the past week.
+  /code $ copilot task ls --default --stale
+  Lists the tasks of the "test" environment that have not been run in the past 3 days.
+  /code $ copilot task ls --env test --stale 72h`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListTaskOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.app, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.env, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.defaultCluster, taskDefaultFlag, false, taskListDefaultFlagDescription)
+	cmd.Flags().DurationVar(&vars.staleAfter, staleFlag, 0, taskListStaleFlagDescription)
+	cmd.Flags().Lookup(staleFlag).NoOptDefVal = defaultTaskStaleAfter.String()
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,234 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTaskOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp        string
+		inEnv        string
+		inDefault    bool
+		inStaleAfter time.Duration
+		wsAppName    string
+
+		wantedErr string
+	}{
+		"negative stale duration": {
+			inStaleAfter: -time.Hour,
+			wantedErr:    "--stale must be a positive duration",
+		},
+		"default cluster with another app": {
+			inApp:     "phonetool",
+			inDefault: true,
+			wantedErr: "cannot specify both `--app` and `--default`",
+		},
+		"default cluster with an env": {
+			inApp:     "phonetool",
+			inEnv:     "test",
+			inDefault: true,
+			wsAppName: "phonetool",
+			wantedErr: "cannot specify both `--env` and `--default`",
+		},
+		"default cluster with the workspace app": {
+			inApp:     "phonetool",
+			inDefault: true,
+			wsAppName: "phonetool",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := listTaskOpts{
+				listTaskVars: listTaskVars{
+					app:            tc.inApp,
+					env:            tc.inEnv,
+					defaultCluster: tc.inDefault,
+					staleAfter:     tc.inStaleAfter,
+				},
+				wsAppName: tc.wsAppName,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestListTaskOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+
+		mockSel func(m *mocks.MockappEnvSelector)
+
+		wantedApp     string
+		wantedEnv     string
+		wantedDefault bool
+		wantedErr     string
+	}{
+		"prompt for the environment": {
+			inApp: "phonetool",
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(taskListEnvPrompt, "", "phonetool", prompt.Option{Value: appEnvOptionNone}).Return("test", nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"select the default cluster": {
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(taskListAppPrompt, "", appEnvOptionNone).Return(appEnvOptionNone, nil)
+			},
+			wantedDefault: true,
+		},
+		"error selecting the application": {
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(taskListAppPrompt, "", appEnvOptionNone).Return("", errors.New("some error"))
+			},
+			wantedErr: "select application: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockappEnvSelector(ctrl)
+			tc.mockSel(mockSel)
+
+			opts := listTaskOpts{
+				listTaskVars: listTaskVars{
+					app: tc.inApp,
+					env: tc.inEnv,
+				},
+				sel: mockSel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.app)
+			require.Equal(t, tc.wantedEnv, opts.env)
+			require.Equal(t, tc.wantedDefault, opts.defaultCluster)
+		})
+	}
+}
+
+func TestListTaskOpts_Execute(t *testing.T) {
+	now := time.Date(2023, time.March, 11, 0, 0, 0, 0, time.UTC)
+	tasks := []deploy.TaskStackInfo{
+		{StackName: "task-report", LastUpdated: now.Add(-2 * time.Hour)},
+		{StackName: "task-db-migrate", LastUpdated: now.Add(-10 * 24 * time.Hour)},
+	}
+	testCases := map[string]struct {
+		inDefault    bool
+		inStaleAfter time.Duration
+		inJSON       bool
+
+		setupMocks func(store *mocks.Mockstore, provider *mocks.MocksessionProvider, lister *mocks.MocktaskStackLister)
+
+		wantedOutput string
+		wantedErr    string
+	}{
+		"list the tasks of an environment": {
+			setupMocks: func(store *mocks.Mockstore, provider *mocks.MocksessionProvider, lister *mocks.MocktaskStackLister) {
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{ManagerRoleARN: "role", Region: "us-west-2"}, nil)
+				provider.EXPECT().FromRole("role", "us-west-2").Return(&session.Session{}, nil)
+				lister.EXPECT().ListTaskStacks("phonetool", "test").Return(tasks, nil)
+			},
+			wantedOutput: `Name        Last Updated
+----        ------------
+db-migrate  1 week ago
+report      2 hours ago
+`,
+		},
+		"list the stale tasks of the default cluster in JSON": {
+			inDefault:    true,
+			inStaleAfter: 7 * 24 * time.Hour,
+			inJSON:       true,
+			setupMocks: func(store *mocks.Mockstore, provider *mocks.MocksessionProvider, lister *mocks.MocktaskStackLister) {
+				provider.EXPECT().Default().Return(&session.Session{}, nil)
+				lister.EXPECT().ListDefaultTaskStacks().Return(tasks, nil)
+			},
+			wantedOutput: `{"tasks":[{"name":"db-migrate","lastUpdated":"2023-03-01T00:00:00Z"}]}
+`,
+		},
+		"no stale tasks": {
+			inDefault:    true,
+			inStaleAfter: 30 * 24 * time.Hour,
+			setupMocks: func(store *mocks.Mockstore, provider *mocks.MocksessionProvider, lister *mocks.MocktaskStackLister) {
+				provider.EXPECT().Default().Return(&session.Session{}, nil)
+				lister.EXPECT().ListDefaultTaskStacks().Return(tasks, nil)
+			},
+			wantedOutput: "No tasks have been inactive for more than 720h0m0s.\n",
+		},
+		"error listing the tasks": {
+			setupMocks: func(store *mocks.Mockstore, provider *mocks.MocksessionProvider, lister *mocks.MocktaskStackLister) {
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				provider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil)
+				lister.EXPECT().ListTaskStacks("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list tasks in environment test: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockProvider := mocks.NewMocksessionProvider(ctrl)
+			mockLister := mocks.NewMocktaskStackLister(ctrl)
+			tc.setupMocks(mockStore, mockProvider, mockLister)
+
+			vars := listTaskVars{
+				defaultCluster:   tc.inDefault,
+				staleAfter:       tc.inStaleAfter,
+				shouldOutputJSON: tc.inJSON,
+			}
+			if !tc.inDefault {
+				vars.app, vars.env = "phonetool", "test"
+			}
+			out := &bytes.Buffer{}
+			opts := listTaskOpts{
+				listTaskVars: vars,
+				store:        mockStore,
+				provider:     mockProvider,
+				w:            out,
+				now:          func() time.Time { return now },
+				newTaskStackLister: func(_ *session.Session) taskStackLister {
+					return mockLister
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, out.String())
+		})
+	}
+}
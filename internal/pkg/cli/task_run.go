@@ -63,6 +63,9 @@ const (
 	defaultDockerfilePath = "Dockerfile"
 	imageTagLatest        = "latest"
 	shortTaskIDLength     = 8
+
+	// maxConcurrentTasksInEnv is the maximum number of one-off tasks running at the same time in an environment.
+	maxConcurrentTasksInEnv = 50
 )
 
 const (
@@ -105,9 +108,12 @@ var (
 )
 
 type runTaskVars struct {
-	count  int
-	cpu    int
-	memory int
+	count    int
+	cpu      int
+	memory   int
+	spreadAZ bool
+	schedule string
+	likeSvc  string
 
 	groupName string
 
@@ -135,6 +141,7 @@ type runTaskVars struct {
 	resourceTags             map[string]string
 
 	follow                bool
+	deleteOnExit          bool
 	generateCommandTarget string
 
 	os   string
@@ -170,6 +177,8 @@ type runTaskOpts struct {
 	configureRepository  func() error
 	// NOTE: configureEventsWriter is only called when tailing logs (i.e. --follow is specified)
 	configureEventsWriter func(tasks []*task.Task)
+	// NOTE: configureTaskResourcesDeleter is only called when the resources are deleted once the tasks stop (i.e. --rm is specified)
+	configureTaskResourcesDeleter func() taskResourcesDeleter
 
 	configureECSServiceDescriber func(session *session.Session) ecs.ECSServiceDescriber
 	configureServiceDescriber    func(session *session.Session) ecs.ServiceDescriber
@@ -182,6 +191,8 @@ type runTaskOpts struct {
 	runTaskRequestFromJob        func(client ecs.JobDescriber, app, env, job string) (*ecs.RunTaskRequest, error)
 
 	// Cached variables.
+	taskSchedule            *deploy.TaskSchedule
+	likeSvcNetwork          *awsecs.NetworkConfiguration
 	ssmParamSecrets         map[string]string
 	secretsManagerSecrets   map[string]string
 	envFileARN              string
@@ -231,6 +242,15 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		opts.eventsWriter = logging.NewTaskClient(opts.sess, opts.groupName, tasks)
 	}
 
+	opts.configureTaskResourcesDeleter = func() taskResourcesDeleter {
+		return newTaskResourcesDeleter(deleteTaskVars{
+			name:           opts.groupName,
+			app:            opts.appName,
+			env:            opts.env,
+			defaultCluster: opts.env == "",
+		}, opts.sess, opts.store, opts.provider, opts.spinner)
+	}
+
 	opts.configureECSServiceDescriber = func(session *session.Session) ecs.ECSServiceDescriber {
 		return awsecs.New(session)
 	}
@@ -282,6 +302,13 @@ func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 			return nil, fmt.Errorf("create describer for environment %s in application %s: %w", o.env, o.appName, err)
 		}
 
+		var subnets []string
+		var assignPublicIP string
+		if o.likeSvcNetwork != nil {
+			subnets = o.likeSvcNetwork.Subnets
+			assignPublicIP = o.likeSvcNetwork.AssignPublicIp
+		}
+
 		ecsClient := ecs.New(o.sess)
 		return &task.EnvRunner{
 			Count:     o.count,
@@ -291,13 +318,19 @@ func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 			Env: o.env,
 
 			SecurityGroups: o.securityGroups,
+			Subnets:        subnets,
+			AssignPublicIP: assignPublicIP,
 
 			OS: o.os,
 
+			SpreadAcrossAZs:    o.spreadAZ,
+			MaxConcurrentTasks: maxConcurrentTasksInEnv,
+
 			VPCGetter:             vpcGetter,
 			ClusterGetter:         ecsClient,
 			Starter:               ecsService,
 			EnvironmentDescriber:  d,
+			RunningTaskLister:     ecsService,
 			NonZeroExitCodeGetter: ecsClient,
 		}, nil
 	}
@@ -305,10 +338,11 @@ func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 		Count:     o.count,
 		GroupName: o.groupName,
 
-		Cluster:        o.cluster,
-		Subnets:        o.subnets,
-		SecurityGroups: o.securityGroups,
-		OS:             o.os,
+		Cluster:         o.cluster,
+		Subnets:         o.subnets,
+		SecurityGroups:  o.securityGroups,
+		SpreadAcrossAZs: o.spreadAZ,
+		OS:              o.os,
 
 		VPCGetter:             vpcGetter,
 		ClusterGetter:         ecsService,
@@ -358,6 +392,18 @@ func (o *runTaskOpts) Validate() error {
 		return errNumNotPositive
 	}
 
+	if err := o.validateFlagsWithSchedule(); err != nil {
+		return err
+	}
+
+	if err := o.validateFlagsWithLikeSvc(); err != nil {
+		return err
+	}
+
+	if o.deleteOnExit && !o.follow {
+		return fmt.Errorf("must specify `--%s` with `--%s`", followFlag, removeTaskFlag)
+	}
+
 	if o.groupName != "" {
 		if err := basicNameValidation(o.groupName); err != nil {
 			return err
@@ -427,6 +473,12 @@ func (o *runTaskOpts) Validate() error {
 		}
 	}
 
+	if o.likeSvc != "" {
+		if _, err := o.store.GetService(o.appName, o.likeSvc); err != nil {
+			return fmt.Errorf("get service %s: %w", o.likeSvc, err)
+		}
+	}
+
 	for _, value := range o.secrets {
 		if !isSSM(value) && !isSecretsManager(value) {
 			return fmt.Errorf("must specify a valid secrets ARN")
@@ -610,6 +662,50 @@ func (o *runTaskOpts) validateFlagsWithSubnets() error {
 	return nil
 }
 
+func (o *runTaskOpts) validateFlagsWithSchedule() error {
+	if o.schedule == "" {
+		return nil
+	}
+
+	if o.follow {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", scheduleFlag, followFlag)
+	}
+
+	if o.spreadAZ {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", scheduleFlag, spreadAZFlag)
+	}
+
+	if o.deleteOnExit {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", scheduleFlag, removeTaskFlag)
+	}
+
+	if o.schedule == "none" {
+		return fmt.Errorf("schedule %q is not valid for `--%s`", o.schedule, scheduleFlag)
+	}
+
+	return nil
+}
+
+func (o *runTaskOpts) validateFlagsWithLikeSvc() error {
+	if o.likeSvc == "" {
+		return nil
+	}
+
+	if o.env == "" {
+		return fmt.Errorf("must specify `--%s` with `--%s`", envFlag, likeSvcFlag)
+	}
+
+	if o.taskRole != "" {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", likeSvcFlag, taskRoleFlag)
+	}
+
+	if o.executionRole != "" {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", likeSvcFlag, executionRoleFlag)
+	}
+
+	return nil
+}
+
 func (o *runTaskOpts) validateFlagsWithWindows() error {
 	if !isWindowsOS(o.os) {
 		return nil
@@ -679,6 +775,12 @@ func (o *runTaskOpts) Execute() error {
 		return err
 	}
 
+	if o.likeSvc != "" {
+		if err := o.configureLikeSvc(); err != nil {
+			return err
+		}
+	}
+
 	if err := o.configureRuntimeOpts(); err != nil {
 		return err
 	}
@@ -698,6 +800,12 @@ func (o *runTaskOpts) Execute() error {
 		}
 	}
 
+	if o.schedule != "" {
+		if err := o.configureSchedule(); err != nil {
+			return err
+		}
+	}
+
 	if err := o.deployTaskResources(); err != nil {
 		return err
 	}
@@ -745,6 +853,12 @@ func (o *runTaskOpts) Execute() error {
 		}
 	}
 
+	if o.schedule != "" {
+		log.Successf("%s %s scheduled to run on %q.\n", english.PluralWord(o.count, "Task", ""), o.groupName, o.schedule)
+		log.Infof("Run %s to stop the schedule.\n", color.HighlightCode(fmt.Sprintf("copilot task delete -n %s", o.groupName)))
+		return nil
+	}
+
 	tasks, err := o.runTask()
 	if err != nil {
 		if strings.Contains(err.Error(), "AccessDeniedException") && strings.Contains(err.Error(), "unable to pull secrets") && o.appName != "" && o.env != "" {
@@ -762,8 +876,14 @@ Did you tag your secrets with the "copilot-application" and "copilot-environment
 		if err := o.displayLogStream(); err != nil {
 			return err
 		}
-		if err := o.runner.CheckNonZeroExitCode(tasks); err != nil {
-			return err
+		exitErr := o.runner.CheckNonZeroExitCode(tasks)
+		if o.deleteOnExit {
+			if err := o.configureTaskResourcesDeleter().DeleteResources(); err != nil {
+				return fmt.Errorf("delete resources of task %s: %w", o.groupName, err)
+			}
+		}
+		if exitErr != nil {
+			return exitErr
 		}
 	}
 	return nil
@@ -913,12 +1033,87 @@ func (o *runTaskOpts) runTask() ([]*task.Task, error) {
 	tasks, err := o.runner.Run()
 	if err != nil {
 		o.spinner.Stop(log.Serrorf("Failed to run %s.\n\n", o.groupName))
+		var errTooManyTasks *task.ErrTooManyConcurrentTasks
+		if errors.As(err, &errTooManyTasks) {
+			log.Warningf("Wait for the one-off tasks running in environment %s to stop, or stop them with %s.\n",
+				o.env, color.HighlightCode("copilot task delete"))
+		}
 		return nil, fmt.Errorf("run task %s: %w", o.groupName, err)
 	}
 	o.spinner.Stop(log.Ssuccessf("%s %s %s running.\n\n", english.PluralWord(o.count, "Task", ""), o.groupName, english.PluralWord(o.count, "is", "are")))
 	return tasks, nil
 }
 
+// configureSchedule resolves the cluster and the network configuration that the scheduled tasks are launched with.
+func (o *runTaskOpts) configureSchedule() error {
+	cfg, err := o.runner.NetworkConfig()
+	if err != nil {
+		return fmt.Errorf("get network configuration for task %s: %w", o.groupName, err)
+	}
+	o.taskSchedule = &deploy.TaskSchedule{
+		Expression:      o.schedule,
+		Count:           o.count,
+		Cluster:         cfg.Cluster,
+		Subnets:         cfg.Subnets,
+		SecurityGroups:  cfg.SecurityGroups,
+		AssignPublicIP:  cfg.AssignPublicIP,
+		PlatformVersion: cfg.PlatformVersion,
+	}
+	return nil
+}
+
+// configureLikeSvc copies the network configuration, roles, environment variables and secrets of the service
+// specified by --like-svc. Values specified with flags take precedence over the ones of the service.
+func (o *runTaskOpts) configureLikeSvc() error {
+	describer := o.configureServiceDescriber(o.sess)
+	networkConfig, err := describer.NetworkConfiguration(o.appName, o.env, o.likeSvc)
+	if err != nil {
+		return fmt.Errorf("retrieve network configuration for service %s: %w", o.likeSvc, err)
+	}
+	taskDef, err := describer.TaskDefinition(o.appName, o.env, o.likeSvc)
+	if err != nil {
+		return fmt.Errorf("retrieve task definition for service %s: %w", o.likeSvc, err)
+	}
+
+	o.likeSvcNetwork = networkConfig
+	existingSGs := make(map[string]bool)
+	for _, sg := range o.securityGroups {
+		existingSGs[sg] = true
+	}
+	for _, sg := range networkConfig.SecurityGroups {
+		if !existingSGs[sg] {
+			o.securityGroups = append(o.securityGroups, sg)
+		}
+	}
+	o.taskRole = aws.StringValue(taskDef.TaskRoleArn)
+	o.executionRole = aws.StringValue(taskDef.ExecutionRoleArn)
+
+	containerName := o.likeSvc // NOTE: refer to workload's CloudFormation template. The container name is set to be the workload's name.
+	for _, envVar := range taskDef.EnvironmentVariables() {
+		if envVar.Container != containerName {
+			continue
+		}
+		if o.envVars == nil {
+			o.envVars = make(map[string]string)
+		}
+		if _, ok := o.envVars[envVar.Name]; !ok {
+			o.envVars[envVar.Name] = envVar.Value
+		}
+	}
+	for _, secret := range taskDef.Secrets() {
+		if secret.Container != containerName {
+			continue
+		}
+		if o.secrets == nil {
+			o.secrets = make(map[string]string)
+		}
+		if _, ok := o.secrets[secret.Name]; !ok {
+			o.secrets[secret.Name] = secret.ValueFrom
+		}
+	}
+	return nil
+}
+
 func (o *runTaskOpts) showPublicIPs(tasks []*task.Task) {
 	publicIPs := make(map[string]string)
 	for _, t := range tasks {
@@ -1035,6 +1230,7 @@ func (o *runTaskOpts) deploy() error {
 		App:                   o.appName,
 		Env:                   o.env,
 		AdditionalTags:        o.resourceTags,
+		Schedule:              o.taskSchedule,
 	}
 	return o.deployer.DeployTask(input, deployOpts...)
 }
@@ -1175,7 +1371,15 @@ func BuildTaskRunCmd() *cobra.Command {
   Run a task using the current workspace with specific subnets and security groups.
   /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
   Run a task with a command.
-  /code $ copilot task run --command "python migrate-script.py"`,
+  /code $ copilot task run --command "python migrate-script.py"
+  Run 6 tasks spread across the Availability Zones of the "test" environment.
+  /code $ copilot task run --env test --count 6 --spread-az
+  Run a task every day at midnight until it is deleted.
+  /code $ copilot task run -n report --env test --schedule "@daily"
+  Run a task with the same network configuration, roles, environment variables and secrets as the "api" service.
+  /code $ copilot task run -n debug --env test --like-svc api
+  Run a task and delete its resources once it stops.
+  /code $ copilot task run -n db-migrate --env test --follow --rm`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
@@ -1206,6 +1410,9 @@ func BuildTaskRunCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.useDefaultSubnetsAndCluster, taskDefaultFlag, false, taskRunDefaultFlagDescription)
 
 	cmd.Flags().IntVar(&vars.count, countFlag, 1, countFlagDescription)
+	cmd.Flags().BoolVar(&vars.spreadAZ, spreadAZFlag, false, spreadAZFlagDescription)
+	cmd.Flags().StringVar(&vars.likeSvc, likeSvcFlag, "", likeSvcFlagDescription)
+	cmd.Flags().StringVar(&vars.schedule, scheduleFlag, "", taskScheduleFlagDescription)
 	cmd.Flags().IntVar(&vars.cpu, cpuFlag, 256, cpuFlagDescription)
 	cmd.Flags().IntVar(&vars.memory, memoryFlag, 512, memoryFlagDescription)
 	cmd.Flags().StringVar(&vars.taskRole, taskRoleFlag, "", taskRoleFlagDescription)
@@ -1220,6 +1427,7 @@ func BuildTaskRunCmd() *cobra.Command {
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().BoolVar(&vars.deleteOnExit, removeTaskFlag, false, removeTaskFlagDescription)
 	cmd.Flags().StringVar(&vars.generateCommandTarget, generateCommandFlag, "", generateCommandFlagDescription)
 
 	// group flags.
@@ -1239,9 +1447,12 @@ func BuildTaskRunCmd() *cobra.Command {
 	placementFlags.AddFlag(cmd.Flags().Lookup(subnetsFlag))
 	placementFlags.AddFlag(cmd.Flags().Lookup(securityGroupsFlag))
 	placementFlags.AddFlag(cmd.Flags().Lookup(taskDefaultFlag))
+	placementFlags.AddFlag(cmd.Flags().Lookup(spreadAZFlag))
+	placementFlags.AddFlag(cmd.Flags().Lookup(likeSvcFlag))
 
 	taskFlags := pflag.NewFlagSet("Task", pflag.ContinueOnError)
 	taskFlags.AddFlag(cmd.Flags().Lookup(countFlag))
+	taskFlags.AddFlag(cmd.Flags().Lookup(scheduleFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(cpuFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(memoryFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(taskRoleFlag))
@@ -1257,6 +1468,7 @@ func BuildTaskRunCmd() *cobra.Command {
 
 	utilityFlags := pflag.NewFlagSet("Utility", pflag.ContinueOnError)
 	utilityFlags.AddFlag(cmd.Flags().Lookup(followFlag))
+	utilityFlags.AddFlag(cmd.Flags().Lookup(removeTaskFlag))
 	utilityFlags.AddFlag(cmd.Flags().Lookup(generateCommandFlag))
 	utilityFlags.AddFlag(cmd.Flags().Lookup(acknowledgeSecretsAccessFlag))
 
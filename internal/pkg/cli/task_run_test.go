@@ -14,6 +14,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
@@ -87,6 +89,11 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 		inDefault               bool
 		inGenerateCommandTarget string
+		inFollow                bool
+		inSpreadAZ              bool
+		inSchedule              string
+		inLikeSvc               string
+		inDeleteOnExit          bool
 
 		appName         string
 		isDockerfileSet bool
@@ -394,6 +401,91 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 			wantedError: nil,
 		},
+		"invalid schedule with follow": {
+			basicOpts: defaultOpts,
+
+			inSchedule: "@daily",
+			inFollow:   true,
+
+			wantedError: errors.New("cannot specify both `--schedule` and `--follow`"),
+		},
+		"invalid schedule with spread-az": {
+			basicOpts: defaultOpts,
+
+			inSchedule: "@daily",
+			inSpreadAZ: true,
+
+			wantedError: errors.New("cannot specify both `--schedule` and `--spread-az`"),
+		},
+		"invalid disabled schedule": {
+			basicOpts: defaultOpts,
+
+			inSchedule: "none",
+
+			wantedError: errors.New("schedule \"none\" is not valid for `--schedule`"),
+		},
+		"valid schedule": {
+			basicOpts: defaultOpts,
+
+			inSchedule: "@every 1h",
+		},
+		"invalid schedule with rm": {
+			basicOpts: defaultOpts,
+
+			inSchedule:     "@daily",
+			inDeleteOnExit: true,
+
+			wantedError: errors.New("cannot specify both `--schedule` and `--rm`"),
+		},
+		"invalid rm without follow": {
+			basicOpts: defaultOpts,
+
+			inDeleteOnExit: true,
+
+			wantedError: errors.New("must specify `--follow` with `--rm`"),
+		},
+		"invalid like-svc without env": {
+			basicOpts: defaultOpts,
+
+			inLikeSvc: "api",
+
+			wantedError: errors.New("must specify `--env` with `--like-svc`"),
+		},
+		"invalid like-svc with task role": {
+			basicOpts: defaultOpts,
+
+			inEnv:      "dev",
+			inLikeSvc:  "api",
+			inTaskRole: "my-role",
+
+			wantedError: errors.New("cannot specify both `--like-svc` and `--task-role`"),
+		},
+		"like-svc service does not exist": {
+			basicOpts: defaultOpts,
+
+			appName:   "my-app",
+			inEnv:     "dev",
+			inLikeSvc: "api",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "dev").Return(&config.Environment{}, nil)
+				m.EXPECT().GetService("my-app", "api").Return(nil, &config.ErrNoSuchService{App: "my-app", Name: "api"})
+			},
+
+			wantedError: errors.New("get service api: couldn't find service api in the application my-app"),
+		},
+		"valid like-svc": {
+			basicOpts: defaultOpts,
+
+			appName:   "my-app",
+			inEnv:     "dev",
+			inLikeSvc: "api",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "dev").Return(&config.Environment{}, nil)
+				m.EXPECT().GetService("my-app", "api").Return(&config.Workload{}, nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -427,6 +519,11 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					generateCommandTarget:       tc.inGenerateCommandTarget,
 					os:                          tc.inOS,
 					arch:                        tc.inArch,
+					follow:                      tc.inFollow,
+					spreadAZ:                    tc.inSpreadAZ,
+					schedule:                    tc.inSchedule,
+					likeSvc:                     tc.inLikeSvc,
+					deleteOnExit:                tc.inDeleteOnExit,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
 				nFlag:           2,
@@ -755,6 +852,8 @@ type runTaskMocks struct {
 	publicIPGetter       *mocks.MockpublicIPGetter
 	provider             *mocks.MocksessionProvider
 	uploader             *mocks.Mockuploader
+	serviceDescriber     *ecsMocks.MockServiceDescriber
+	resourcesDeleter     *mocks.MocktaskResourcesDeleter
 }
 
 func mockHasDefaultCluster(m runTaskMocks) {
@@ -788,6 +887,13 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 		inCommand    string
 		inEntryPoint string
 		inEnvFile    string
+		inSchedule   string
+		inEnvVars    map[string]string
+		inLikeSvc    string
+		inRm         bool
+
+		inSsmParamSecrets       map[string]string
+		inSecretsManagerSecrets map[string]string
 
 		inApp string
 		inEnv string
@@ -968,6 +1074,121 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				mockHasDefaultCluster(m)
 			},
 		},
+		"error getting the network configuration of scheduled tasks": {
+			inImage:    "image",
+			inSchedule: "@daily",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				mockHasDefaultCluster(m)
+				m.runner.EXPECT().NetworkConfig().Return(nil, errors.New("some error"))
+				m.deployer.EXPECT().DeployTask(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("get network configuration for task my-task: some error"),
+		},
+		"schedule the tasks instead of running them": {
+			inImage:    "image",
+			inSchedule: "@daily",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				mockHasDefaultCluster(m)
+				m.runner.EXPECT().NetworkConfig().Return(&task.NetworkConfig{
+					Cluster:         "cluster-1",
+					Subnets:         []string{"subnet-1", "subnet-2"},
+					SecurityGroups:  []string{"sg-1"},
+					PlatformVersion: "LATEST",
+				}, nil)
+				m.deployer.EXPECT().DeployTask(&deploy.CreateTaskResourcesInput{
+					Name:       inGroupName,
+					Image:      "image",
+					Command:    []string{},
+					EntryPoint: []string{},
+					Schedule: &deploy.TaskSchedule{
+						Expression:      "@daily",
+						Count:           1,
+						Cluster:         "cluster-1",
+						Subnets:         []string{"subnet-1", "subnet-2"},
+						SecurityGroups:  []string{"sg-1"},
+						PlatformVersion: "LATEST",
+					},
+				}).Return(nil)
+				m.runner.EXPECT().Run().Times(0)
+			},
+		},
+		"error retrieving the network configuration of the service to run like": {
+			inImage:   "image",
+			inApp:     "my-app",
+			inEnv:     "test",
+			inLikeSvc: "api",
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{}, nil)
+				m.provider.EXPECT().FromRole(gomock.Any(), gomock.Any())
+				m.serviceDescriber.EXPECT().NetworkConfiguration("my-app", "test", "api").Return(nil, errors.New("some error"))
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("retrieve network configuration for service api: some error"),
+		},
+		"run the tasks with the roles, environment variables and secrets of a service": {
+			inImage:   "image",
+			inApp:     "my-app",
+			inEnv:     "test",
+			inLikeSvc: "api",
+			inEnvVars: map[string]string{
+				"LOG_LEVEL": "debug",
+			},
+			inSsmParamSecrets:       map[string]string{},
+			inSecretsManagerSecrets: map[string]string{},
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{}, nil)
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.provider.EXPECT().FromRole(gomock.Any(), gomock.Any())
+				m.serviceDescriber.EXPECT().NetworkConfiguration("my-app", "test", "api").Return(&awsecs.NetworkConfiguration{
+					AssignPublicIp: "DISABLED",
+					SecurityGroups: []string{"sg-svc"},
+					Subnets:        []string{"subnet-private-1"},
+				}, nil)
+				m.serviceDescriber.EXPECT().TaskDefinition("my-app", "test", "api").Return(&awsecs.TaskDefinition{
+					TaskRoleArn:      aws.String("task-role"),
+					ExecutionRoleArn: aws.String("execution-role"),
+					ContainerDefinitions: []*sdkecs.ContainerDefinition{
+						{
+							Name: aws.String("api"),
+							Environment: []*sdkecs.KeyValuePair{
+								{Name: aws.String("LOG_LEVEL"), Value: aws.String("info")},
+								{Name: aws.String("DB_NAME"), Value: aws.String("orders")},
+							},
+							Secrets: []*sdkecs.Secret{
+								{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("/copilot/my-app/test/secrets/db")},
+							},
+						},
+						{
+							Name: aws.String("sidecar"),
+							Environment: []*sdkecs.KeyValuePair{
+								{Name: aws.String("SIDECAR_ONLY"), Value: aws.String("true")},
+							},
+						},
+					},
+				}, nil)
+				m.deployer.EXPECT().DeployTask(&deploy.CreateTaskResourcesInput{
+					Name:          inGroupName,
+					Image:         "image",
+					TaskRole:      "task-role",
+					ExecutionRole: "execution-role",
+					Command:       []string{},
+					EntryPoint:    []string{},
+					EnvVars: map[string]string{
+						"LOG_LEVEL": "debug",
+						"DB_NAME":   "orders",
+					},
+					SSMParamSecrets: map[string]string{
+						"DB_PASSWORD": "/copilot/my-app/test/secrets/db",
+					},
+					SecretsManagerSecrets: map[string]string{},
+					App:                   "my-app",
+					Env:                   "test",
+				}, gomock.Any()).Return(nil)
+				m.runner.EXPECT().Run().Return([]*task.Task{}, nil)
+			},
+		},
 		"fail to get ENI information for some tasks": {
 			setupMocks: func(m runTaskMocks) {
 				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
@@ -1024,6 +1245,44 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			},
 			wantedError: errors.New("write events: error writing events"),
 		},
+		"delete the resources of the task once the tasks stop": {
+			inFollow: true,
+			inRm:     true,
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.runner.EXPECT().CheckNonZeroExitCode(gomock.Any()).Return(errors.New("container exited with code 1"))
+				m.resourcesDeleter.EXPECT().DeleteResources().Return(nil)
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("container exited with code 1"),
+		},
+		"error deleting the resources of the task once the tasks stop": {
+			inFollow: true,
+			inRm:     true,
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.runner.EXPECT().CheckNonZeroExitCode(gomock.Any()).Return(nil)
+				m.resourcesDeleter.EXPECT().DeleteResources().Return(errors.New("some error"))
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("delete resources of task my-task: some error"),
+		},
 		"error getting app config (to look for permissions boundary policy)": {
 			inApp: "my-app",
 			inEnv: "test",
@@ -1166,6 +1425,8 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				publicIPGetter:       mocks.NewMockpublicIPGetter(ctrl),
 				provider:             mocks.NewMocksessionProvider(ctrl),
 				uploader:             mocks.NewMockuploader(ctrl),
+				serviceDescriber:     ecsMocks.NewMockServiceDescriber(ctrl),
+				resourcesDeleter:     mocks.NewMocktaskResourcesDeleter(ctrl),
 			}
 			tc.setupMocks(mocks)
 
@@ -1184,11 +1445,19 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 					command:    tc.inCommand,
 					entrypoint: tc.inEntryPoint,
 					envFile:    tc.inEnvFile,
+					schedule:   tc.inSchedule,
+					envVars:    tc.inEnvVars,
+					likeSvc:    tc.inLikeSvc,
+					count:      1,
+
+					deleteOnExit: tc.inRm,
 				},
-				spinner:  &spinnerTestDouble{},
-				store:    mocks.store,
-				provider: mocks.provider,
-				fs:       fs.Fs,
+				spinner:               &spinnerTestDouble{},
+				store:                 mocks.store,
+				provider:              mocks.provider,
+				fs:                    fs.Fs,
+				ssmParamSecrets:       tc.inSsmParamSecrets,
+				secretsManagerSecrets: tc.inSecretsManagerSecrets,
 			}
 			opts.configureRuntimeOpts = func() error {
 				opts.runner = mocks.runner
@@ -1207,6 +1476,12 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			opts.configureUploader = func(session *session.Session) uploader {
 				return mocks.uploader
 			}
+			opts.configureServiceDescriber = func(session *session.Session) ecs.ServiceDescriber {
+				return mocks.serviceDescriber
+			}
+			opts.configureTaskResourcesDeleter = func() taskResourcesDeleter {
+				return mocks.resourcesDeleter
+			}
 
 			err := opts.Execute()
 			if tc.wantedError != nil {
@@ -0,0 +1,683 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/tui"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+const (
+	uiLogsLimit = 50 // Number of the latest log events of a workload to show.
+
+	uiHelp = "↑/↓ move  →/enter open  ← close  s status  l logs  d deploy  r refresh  q quit"
+)
+
+var errUINotATerminal = errors.New("copilot ui must run in a terminal")
+
+// uiEscapeSequence matches the escape sequences of the output of the commands run from the terminal UI, like the
+// cursor movements of the progress trackers, that would corrupt its screen.
+var uiEscapeSequence = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+type uiOpts struct {
+	store              store
+	deployStore        deployedEnvironmentLister
+	cmd                execRunner
+	executable         func() (string, error)
+	newStatusDescriber func(app, env string, wkld *config.Workload) (statusDescriber, error)
+	newLogsWriter      func(app, env string, wkld *config.Workload) (logEventsWriter, error)
+	program            tuiProgram
+	isTerminal         func() bool
+}
+
+func newUIOpts() (*uiOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("ui"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &uiOpts{
+		store:       configStore,
+		deployStore: deployStore,
+		cmd:         exec.NewCmd(),
+		executable:  os.Executable,
+		newStatusDescriber: func(app, env string, wkld *config.Workload) (statusDescriber, error) {
+			cfg := &describe.NewServiceStatusConfig{
+				App:         app,
+				Env:         env,
+				Svc:         wkld.Name,
+				ConfigStore: configStore,
+			}
+			switch wkld.Type {
+			case manifestinfo.RequestDrivenWebServiceType:
+				return describe.NewAppRunnerStatusDescriber(cfg)
+			case manifestinfo.StaticSiteType:
+				return describe.NewStaticSiteStatusDescriber(cfg)
+			default:
+				return describe.NewECSStatusDescriber(cfg)
+			}
+		},
+		newLogsWriter: func(app, env string, wkld *config.Workload) (logEventsWriter, error) {
+			e, err := configStore.GetEnvironment(app, env)
+			if err != nil {
+				return nil, fmt.Errorf("get environment %s: %w", env, err)
+			}
+			sess, err := sessProvider.FromRole(e.ManagerRoleARN, e.Region)
+			if err != nil {
+				return nil, err
+			}
+			opts := &logging.NewWorkloadLoggerOpts{
+				App:  app,
+				Env:  env,
+				Name: wkld.Name,
+				Sess: sess,
+			}
+			switch {
+			case manifestinfo.IsTypeAJob(wkld.Type):
+				return logging.NewJobLogger(opts), nil
+			case wkld.Type == manifestinfo.RequestDrivenWebServiceType:
+				return logging.NewAppRunnerServiceLogger(&logging.NewAppRunnerServiceLoggerOpts{
+					NewWorkloadLoggerOpts: opts,
+					ConfigStore:           configStore,
+				})
+			default:
+				return logging.NewECSServiceClient(opts), nil
+			}
+		},
+		program: tui.New(os.Stdin, os.Stdout),
+		isTerminal: func() bool {
+			return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+		},
+	}, nil
+}
+
+// Validate returns an error if the input or the output isn't a terminal.
+func (o *uiOpts) Validate() error {
+	if !o.isTerminal() {
+		return errUINotATerminal
+	}
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *uiOpts) Ask() error {
+	return nil
+}
+
+// Execute runs the terminal UI until the user quits.
+func (o *uiOpts) Execute() error {
+	if _, err := o.program.Run(newUIModel(o)); err != nil {
+		return fmt.Errorf("run terminal UI: %w", err)
+	}
+	return nil
+}
+
+// uiNode is an application, an environment of an application, or a workload deployed in an environment.
+type uiNode struct {
+	app      string
+	env      string
+	wkld     *config.Workload
+	depth    int
+	expanded bool
+	loaded   bool
+	children []*uiNode
+}
+
+func (n *uiNode) label() string {
+	switch {
+	case n.wkld != nil:
+		return fmt.Sprintf("%s %s", n.wkld.Name, color.Faint.Sprint(n.wkld.Type))
+	case n.env != "":
+		return n.env
+	default:
+		return color.Emphasize(n.app)
+	}
+}
+
+// uiDetail is the content of the pane below the tree.
+type uiDetail struct {
+	title string
+	lines []string
+	tail  bool // Whether to show the last lines that fit instead of the first ones.
+}
+
+// uiModel is the state of the terminal UI.
+type uiModel struct {
+	opts *uiOpts
+
+	apps      []*uiNode
+	cursor    int
+	detail    uiDetail
+	confirm   bool // Whether the user is asked to confirm the deployment of the selected workload.
+	deploying bool
+}
+
+// Messages of the results of the commands of the terminal UI.
+type (
+	uiAppsMsg struct {
+		apps []*uiNode
+		err  error
+	}
+	uiChildrenMsg struct {
+		node     *uiNode
+		children []*uiNode
+		err      error
+	}
+	uiDetailMsg struct {
+		detail uiDetail
+	}
+	uiDeployOutputMsg struct {
+		line string
+	}
+	uiDeployDoneMsg struct {
+		title string
+		err   error
+	}
+)
+
+func newUIModel(opts *uiOpts) *uiModel {
+	return &uiModel{
+		opts: opts,
+		detail: uiDetail{
+			title: "Loading applications...",
+		},
+	}
+}
+
+// Init lists the applications.
+func (m *uiModel) Init() tui.Cmd {
+	return m.listApps
+}
+
+// Update handles the keys and the results of the commands.
+func (m *uiModel) Update(msg tui.Msg) (tui.Model, tui.Cmd) {
+	switch msg := msg.(type) {
+	case tui.KeyMsg:
+		return m, m.handleKey(msg)
+	case uiAppsMsg:
+		if msg.err != nil {
+			m.detail = errorDetail("Failed to list the applications", msg.err)
+			return m, nil
+		}
+		m.apps = msg.apps
+		m.cursor = 0
+		m.detail = uiDetail{title: fmt.Sprintf("%d applications", len(msg.apps))}
+		if len(msg.apps) == 0 {
+			m.detail.lines = []string{fmt.Sprintf("Run %s to create one.", color.HighlightCode("copilot app init"))}
+		}
+	case uiChildrenMsg:
+		if msg.err != nil {
+			m.detail = errorDetail(fmt.Sprintf("Failed to load %s", strings.TrimSpace(msg.node.env+" "+msg.node.app)), msg.err)
+			msg.node.expanded = false
+			return m, nil
+		}
+		msg.node.children = msg.children
+		msg.node.loaded = true
+	case uiDetailMsg:
+		m.detail = msg.detail
+	case uiDeployOutputMsg:
+		m.detail.lines = append(m.detail.lines, msg.line)
+	case uiDeployDoneMsg:
+		m.deploying = false
+		m.detail.title = msg.title
+		if msg.err != nil {
+			m.detail.lines = append(m.detail.lines, color.Red.Sprint(msg.err.Error()))
+		}
+	}
+	return m, nil
+}
+
+func (m *uiModel) handleKey(key tui.KeyMsg) tui.Cmd {
+	if key == tui.KeyCtrlC {
+		return tui.Quit
+	}
+	if m.confirm {
+		m.confirm = false
+		if key != "y" {
+			m.detail = uiDetail{title: "Deployment canceled"}
+			return nil
+		}
+		return m.deploy(m.selected())
+	}
+	rows := m.rows()
+	node := m.selected()
+	switch key {
+	case "q":
+		if m.deploying {
+			m.detail.title = "A deployment is in progress, press ctrl+c to quit anyway"
+			return nil
+		}
+		return tui.Quit
+	case tui.KeyUp, "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case tui.KeyDown, "j":
+		if m.cursor < len(rows)-1 {
+			m.cursor++
+		}
+	case tui.KeyRight, tui.KeyEnter, "o":
+		if node == nil {
+			return nil
+		}
+		if node.wkld != nil {
+			return m.statusCmd(node)
+		}
+		node.expanded = true
+		if !node.loaded {
+			return m.loadChildren(node)
+		}
+	case tui.KeyLeft:
+		if node == nil {
+			return nil
+		}
+		if node.expanded {
+			node.expanded = false
+			return nil
+		}
+		// Move to the parent of the node.
+		for i := m.cursor - 1; i >= 0; i-- {
+			if rows[i].depth < node.depth {
+				m.cursor = i
+				break
+			}
+		}
+	case "s":
+		if node != nil && node.wkld != nil {
+			return m.statusCmd(node)
+		}
+	case "l":
+		if node != nil && node.wkld != nil {
+			return m.logsCmd(node)
+		}
+	case "d":
+		if node == nil || node.wkld == nil {
+			return nil
+		}
+		if m.deploying {
+			m.detail.title = "A deployment is already in progress"
+			return nil
+		}
+		m.confirm = true
+		m.detail = uiDetail{
+			title: fmt.Sprintf("Deploy %s to %s? (y/n)", node.wkld.Name, node.env),
+			lines: []string{fmt.Sprintf("Runs %s with the manifest and the code of your workspace.", color.HighlightCode(deployCommand(node)))},
+		}
+	case "r":
+		if node == nil {
+			return m.listApps
+		}
+		return m.refresh(node, rows)
+	}
+	return nil
+}
+
+// refresh loads the children of the node again, or the ones of its environment for a workload.
+func (m *uiModel) refresh(node *uiNode, rows []*uiNode) tui.Cmd {
+	if node.wkld != nil {
+		for i := m.cursor - 1; i >= 0; i-- {
+			if rows[i].depth < node.depth {
+				m.cursor = i
+				node = rows[i]
+				break
+			}
+		}
+	}
+	node.expanded = true
+	node.loaded = false
+	node.children = nil
+	return m.loadChildren(node)
+}
+
+// rows returns the visible nodes of the tree, in order.
+func (m *uiModel) rows() []*uiNode {
+	var rows []*uiNode
+	var walk func(nodes []*uiNode)
+	walk = func(nodes []*uiNode) {
+		for _, n := range nodes {
+			rows = append(rows, n)
+			if n.expanded {
+				walk(n.children)
+			}
+		}
+	}
+	walk(m.apps)
+	return rows
+}
+
+func (m *uiModel) selected() *uiNode {
+	rows := m.rows()
+	if m.cursor >= len(rows) {
+		return nil
+	}
+	return rows[m.cursor]
+}
+
+// View renders the help, the tree of the applications, and the detail pane below it.
+func (m *uiModel) View(width, height int) string {
+	rows := m.rows()
+	treeHeight := (height - 4) / 2
+	if treeHeight < 1 {
+		treeHeight = 1
+	}
+	if len(rows) < treeHeight {
+		treeHeight = len(rows)
+	}
+	start := 0
+	if m.cursor >= treeHeight {
+		start = m.cursor - treeHeight + 1
+	}
+
+	lines := []string{color.Faint.Sprint(uiHelp), strings.Repeat("─", width)}
+	for i := start; i < start+treeHeight && i < len(rows); i++ {
+		n := rows[i]
+		marker := " "
+		switch {
+		case n.wkld != nil:
+		case n.expanded:
+			marker = "▾"
+		default:
+			marker = "▸"
+		}
+		line := fmt.Sprintf("%s%s %s", strings.Repeat("  ", n.depth), marker, n.label())
+		if i == m.cursor {
+			line = "> " + line
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, strings.Repeat("─", width), color.Emphasize(m.detail.title))
+
+	detailHeight := height - len(lines)
+	if detailHeight < 0 {
+		detailHeight = 0
+	}
+	detail := m.detail.lines
+	if len(detail) > detailHeight {
+		if m.detail.tail {
+			detail = detail[len(detail)-detailHeight:]
+		} else {
+			detail = detail[:detailHeight]
+		}
+	}
+	lines = append(lines, detail...)
+	for i, line := range lines {
+		lines[i] = tui.Truncate(strings.ReplaceAll(line, "\t", "    "), width)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m *uiModel) listApps() tui.Msg {
+	apps, err := m.opts.store.ListApplications()
+	if err != nil {
+		return uiAppsMsg{err: err}
+	}
+	var nodes []*uiNode
+	for _, app := range apps {
+		nodes = append(nodes, &uiNode{app: app.Name})
+	}
+	return uiAppsMsg{apps: nodes}
+}
+
+// loadChildren lists the environments of an application, or the workloads deployed in an environment.
+func (m *uiModel) loadChildren(node *uiNode) tui.Cmd {
+	return func() tui.Msg {
+		var children []*uiNode
+		var err error
+		if node.env == "" {
+			children, err = m.envNodes(node)
+		} else {
+			children, err = m.workloadNodes(node)
+		}
+		return uiChildrenMsg{node: node, children: children, err: err}
+	}
+}
+
+func (m *uiModel) envNodes(app *uiNode) ([]*uiNode, error) {
+	envs, err := m.opts.store.ListEnvironments(app.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments: %w", err)
+	}
+	var nodes []*uiNode
+	for _, env := range envs {
+		nodes = append(nodes, &uiNode{app: app.app, env: env.Name, depth: app.depth + 1})
+	}
+	return nodes, nil
+}
+
+func (m *uiModel) workloadNodes(env *uiNode) ([]*uiNode, error) {
+	wklds, err := m.opts.store.ListWorkloads(env.app)
+	if err != nil {
+		return nil, fmt.Errorf("list workloads: %w", err)
+	}
+	svcs, err := m.opts.deployStore.ListDeployedServices(env.app, env.env)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed in %s: %w", env.env, err)
+	}
+	jobs, err := m.opts.deployStore.ListDeployedJobs(env.app, env.env)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs deployed in %s: %w", env.env, err)
+	}
+	deployed := make(map[string]bool)
+	for _, name := range append(svcs, jobs...) {
+		deployed[name] = true
+	}
+	var nodes []*uiNode
+	for _, wkld := range wklds {
+		if deployed[wkld.Name] {
+			nodes = append(nodes, &uiNode{app: env.app, env: env.env, wkld: wkld, depth: env.depth + 1})
+		}
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].wkld.Name < nodes[j].wkld.Name
+	})
+	return nodes, nil
+}
+
+func (m *uiModel) statusCmd(node *uiNode) tui.Cmd {
+	if manifestinfo.IsTypeAJob(node.wkld.Type) {
+		m.detail = uiDetail{
+			title: fmt.Sprintf("Status of %s in %s", node.wkld.Name, node.env),
+			lines: []string{"Jobs don't have a status, press l to see the logs of their latest runs."},
+		}
+		return nil
+	}
+	m.detail = uiDetail{title: fmt.Sprintf("Getting the status of %s in %s...", node.wkld.Name, node.env)}
+	return func() tui.Msg {
+		title := fmt.Sprintf("Status of %s in %s", node.wkld.Name, node.env)
+		d, err := m.opts.newStatusDescriber(node.app, node.env, node.wkld)
+		if err != nil {
+			return uiDetailMsg{detail: errorDetail(title, err)}
+		}
+		status, err := d.Describe()
+		if err != nil {
+			return uiDetailMsg{detail: errorDetail(title, err)}
+		}
+		return uiDetailMsg{detail: uiDetail{
+			title: title,
+			lines: strings.Split(strings.TrimRight(status.HumanString(), "\n"), "\n"),
+		}}
+	}
+}
+
+func (m *uiModel) logsCmd(node *uiNode) tui.Cmd {
+	if node.wkld.Type == manifestinfo.StaticSiteType {
+		m.detail = uiDetail{
+			title: fmt.Sprintf("Logs of %s in %s", node.wkld.Name, node.env),
+			lines: []string{"Static sites don't have logs."},
+		}
+		return nil
+	}
+	m.detail = uiDetail{title: fmt.Sprintf("Getting the logs of %s in %s...", node.wkld.Name, node.env)}
+	return func() tui.Msg {
+		title := fmt.Sprintf("Latest logs of %s in %s", node.wkld.Name, node.env)
+		w, err := m.opts.newLogsWriter(node.app, node.env, node.wkld)
+		if err != nil {
+			return uiDetailMsg{detail: errorDetail(title, err)}
+		}
+		var lines []string
+		err = w.WriteLogEvents(logging.WriteLogEventsOpts{
+			Limit:                   aws.Int64(uiLogsLimit),
+			IncludeStateMachineLogs: manifestinfo.IsTypeAJob(node.wkld.Type),
+			OnEvents: func(_ io.Writer, events []logging.HumanJSONStringer) error {
+				for _, event := range events {
+					lines = append(lines, strings.Split(strings.TrimRight(event.HumanString(), "\n"), "\n")...)
+				}
+				return nil
+			},
+		})
+		if err != nil {
+			return uiDetailMsg{detail: errorDetail(title, err)}
+		}
+		if len(lines) == 0 {
+			lines = []string{"No log events."}
+		}
+		return uiDetailMsg{detail: uiDetail{title: title, lines: lines, tail: true}}
+	}
+}
+
+// deploy runs the deploy command of the workload, and streams its output to the detail pane.
+func (m *uiModel) deploy(node *uiNode) tui.Cmd {
+	m.deploying = true
+	m.detail = uiDetail{
+		title: fmt.Sprintf("Deploying %s to %s...", node.wkld.Name, node.env),
+		tail:  true,
+	}
+	return func() tui.Msg {
+		exe, err := m.opts.executable()
+		if err != nil {
+			return uiDeployDoneMsg{
+				title: fmt.Sprintf("Failed to deploy %s to %s", node.wkld.Name, node.env),
+				err:   fmt.Errorf("find the path of the running binary: %w", err),
+			}
+		}
+		out := &uiLineWriter{send: func(line string) {
+			m.opts.program.Send(uiDeployOutputMsg{line: line})
+		}}
+		err = m.opts.cmd.Run(exe, deployArgs(node), exec.Stdout(out), exec.Stderr(out), exec.Env("COLOR=false"))
+		out.flush()
+		if err != nil {
+			return uiDeployDoneMsg{title: fmt.Sprintf("Failed to deploy %s to %s", node.wkld.Name, node.env), err: err}
+		}
+		return uiDeployDoneMsg{title: fmt.Sprintf("Deployed %s to %s", node.wkld.Name, node.env)}
+	}
+}
+
+func deployArgs(node *uiNode) []string {
+	cmd := "svc"
+	if manifestinfo.IsTypeAJob(node.wkld.Type) {
+		cmd = "job"
+	}
+	return []string{cmd, "deploy", "--" + appFlag, node.app, "--" + envFlag, node.env, "--" + nameFlag, node.wkld.Name}
+}
+
+func deployCommand(node *uiNode) string {
+	return "copilot " + strings.Join(deployArgs(node), " ")
+}
+
+func errorDetail(title string, err error) uiDetail {
+	return uiDetail{
+		title: title,
+		lines: []string{color.Red.Sprint(err.Error())},
+	}
+}
+
+// uiLineWriter sends each line written to it, once it's complete, without its escape sequences.
+// A carriage return starts the line over, like a progress tracker that rewrites its line.
+type uiLineWriter struct {
+	send func(line string)
+	buf  []byte
+}
+
+// Write sends the complete lines of p.
+func (w *uiLineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := strings.IndexByte(string(w.buf), '\n')
+		if i == -1 {
+			return len(p), nil
+		}
+		w.sendLine(string(w.buf[:i]))
+		w.buf = w.buf[i+1:]
+	}
+}
+
+func (w *uiLineWriter) flush() {
+	if len(w.buf) > 0 {
+		w.sendLine(string(w.buf))
+		w.buf = nil
+	}
+}
+
+func (w *uiLineWriter) sendLine(line string) {
+	line = uiEscapeSequence.ReplaceAllString(line, "")
+	if i := strings.LastIndexByte(strings.TrimRight(line, "\r"), '\r'); i != -1 {
+		line = line[i+1:]
+	}
+	line = strings.TrimRight(line, "\r")
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	w.send(line)
+}
+
+// BuildUICmd builds the command for the terminal UI.
+func BuildUICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ui",
+		Short: "Opens an interactive terminal UI for your applications.",
+		Long: `Opens an interactive terminal UI for your applications.
+Browse the environments of your applications and the services and jobs deployed in them,
+and see their status and logs, or deploy them, without leaving the terminal.`,
+		Example: `
+  Opens the terminal UI.
+  /code $ copilot ui`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newUIOpts()
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			return nil
+		}),
+		Annotations: map[string]string{
+			"group": group.Develop,
+		},
+	}
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
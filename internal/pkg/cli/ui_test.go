@@ -0,0 +1,407 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	osexec "os/exec"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/tui"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type uiMocks struct {
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedEnvironmentLister
+	cmd         *mocks.MockexecRunner
+	status      *mocks.MockstatusDescriber
+	logs        *mocks.MocklogEventsWriter
+	program     *mocks.MocktuiProgram
+}
+
+func newUITestOpts(ctrl *gomock.Controller) (*uiOpts, *uiMocks) {
+	m := &uiMocks{
+		store:       mocks.NewMockstore(ctrl),
+		deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+		cmd:         mocks.NewMockexecRunner(ctrl),
+		status:      mocks.NewMockstatusDescriber(ctrl),
+		logs:        mocks.NewMocklogEventsWriter(ctrl),
+		program:     mocks.NewMocktuiProgram(ctrl),
+	}
+	return &uiOpts{
+		store:       m.store,
+		deployStore: m.deployStore,
+		cmd:         m.cmd,
+		executable: func() (string, error) {
+			return "/usr/local/bin/copilot", nil
+		},
+		newStatusDescriber: func(_, _ string, _ *config.Workload) (statusDescriber, error) {
+			return m.status, nil
+		},
+		newLogsWriter: func(_, _ string, _ *config.Workload) (logEventsWriter, error) {
+			return m.logs, nil
+		},
+		program: m.program,
+		isTerminal: func() bool {
+			return true
+		},
+	}, m
+}
+
+// update sends the messages to the model, and runs the commands it returns until there are none left.
+func update(m *uiModel, msgs ...tui.Msg) {
+	for _, msg := range msgs {
+		_, cmd := m.Update(msg)
+		for cmd != nil {
+			next := cmd()
+			if next == nil {
+				break
+			}
+			_, cmd = m.Update(next)
+		}
+	}
+}
+
+// expandApp loads the application "phonetool" with its environment "test" that runs the service "api" and the job "report".
+func expandApp(m *uiModel, mocks *uiMocks) {
+	mocks.store.EXPECT().ListApplications().Return([]*config.Application{{Name: "phonetool"}}, nil)
+	mocks.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+	mocks.store.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{
+		{Name: "report", Type: manifestinfo.ScheduledJobType},
+		{Name: "api", Type: manifestinfo.LoadBalancedWebServiceType},
+		{Name: "worker", Type: manifestinfo.WorkerServiceType},
+	}, nil)
+	mocks.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api"}, nil)
+	mocks.deployStore.EXPECT().ListDeployedJobs("phonetool", "test").Return([]string{"report"}, nil)
+
+	update(m, m.Init()())
+	update(m, tui.KeyEnter, tui.KeyDown, tui.KeyEnter)
+}
+
+func TestUIOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		isTerminal bool
+		wantedErr  error
+	}{
+		"error if the command doesn't run in a terminal": {
+			wantedErr: errUINotATerminal,
+		},
+		"success": {
+			isTerminal: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &uiOpts{
+				isTerminal: func() bool {
+					return tc.isTerminal
+				},
+			}
+
+			err := opts.Validate()
+
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestUIOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		runErr    error
+		wantedErr string
+	}{
+		"error if the terminal UI fails": {
+			runErr:    errors.New("some error"),
+			wantedErr: "run terminal UI: some error",
+		},
+		"success": {},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			opts, m := newUITestOpts(ctrl)
+			m.program.EXPECT().Run(gomock.Any()).DoAndReturn(func(model tui.Model) (tui.Model, error) {
+				require.IsType(t, &uiModel{}, model)
+				return model, tc.runErr
+			})
+
+			err := opts.Execute()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUIModel_Tree(t *testing.T) {
+	t.Run("shows the workloads deployed in the environments of the applications", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		opts, mocks := newUITestOpts(ctrl)
+		m := newUIModel(opts)
+
+		expandApp(m, mocks)
+
+		var labels []string
+		for _, row := range m.rows() {
+			labels = append(labels, strings.TrimSpace(row.app+"/"+row.env+"/"+wkldName(row)))
+		}
+		require.Equal(t, []string{"phonetool//", "phonetool/test/", "phonetool/test/api", "phonetool/test/report"}, labels)
+		view := m.View(80, 24)
+		require.Contains(t, view, "> ")
+		require.Contains(t, view, "api")
+		require.Contains(t, view, "report")
+		require.NotContains(t, view, "worker")
+	})
+	t.Run("collapses a node and moves to its parent", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		opts, mocks := newUITestOpts(ctrl)
+		m := newUIModel(opts)
+		expandApp(m, mocks)
+
+		update(m, tui.KeyDown, tui.KeyMsg("j"), tui.KeyLeft)
+		require.Equal(t, "test", m.selected().env)
+		require.Nil(t, m.selected().wkld)
+
+		update(m, tui.KeyLeft)
+		require.Len(t, m.rows(), 2)
+
+		update(m, tui.KeyLeft)
+		require.Equal(t, "phonetool", m.selected().app)
+		require.Equal(t, "", m.selected().env)
+	})
+	t.Run("shows the error if the environments can't be listed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		opts, mocks := newUITestOpts(ctrl)
+		m := newUIModel(opts)
+		mocks.store.EXPECT().ListApplications().Return([]*config.Application{{Name: "phonetool"}}, nil)
+		mocks.store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+
+		update(m, m.Init()(), tui.KeyEnter)
+
+		require.Len(t, m.rows(), 1)
+		require.False(t, m.apps[0].expanded)
+		require.Equal(t, "Failed to load phonetool", m.detail.title)
+		require.Contains(t, m.View(80, 24), "list environments: some error")
+	})
+	t.Run("shows the error if the applications can't be listed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		opts, mocks := newUITestOpts(ctrl)
+		m := newUIModel(opts)
+		mocks.store.EXPECT().ListApplications().Return(nil, errors.New("some error"))
+
+		update(m, m.Init()())
+
+		require.Empty(t, m.rows())
+		require.Contains(t, m.View(80, 24), "some error")
+	})
+}
+
+func wkldName(n *uiNode) string {
+	if n.wkld == nil {
+		return ""
+	}
+	return n.wkld.Name
+}
+
+func TestUIModel_Status(t *testing.T) {
+	testCases := map[string]struct {
+		down        int
+		setupMocks  func(m *uiMocks)
+		wantedTitle string
+		wantedLines []string
+	}{
+		"shows the status of a service": {
+			down: 2,
+			setupMocks: func(m *uiMocks) {
+				m.status.EXPECT().Describe().Return(&mockDescribeData{data: "Task Status\n\n  RUNNING\n"}, nil)
+			},
+			wantedTitle: "Status of api in test",
+			wantedLines: []string{"Task Status", "", "  RUNNING"},
+		},
+		"shows the error if the status can't be described": {
+			down: 2,
+			setupMocks: func(m *uiMocks) {
+				m.status.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedTitle: "Status of api in test",
+			wantedLines: []string{"some error"},
+		},
+		"jobs don't have a status": {
+			down:        3,
+			setupMocks:  func(m *uiMocks) {},
+			wantedTitle: "Status of report in test",
+			wantedLines: []string{"Jobs don't have a status, press l to see the logs of their latest runs."},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			opts, mocks := newUITestOpts(ctrl)
+			m := newUIModel(opts)
+			expandApp(m, mocks)
+			tc.setupMocks(mocks)
+
+			for i := 0; i < tc.down-1; i++ {
+				update(m, tui.KeyDown)
+			}
+			update(m, tui.KeyMsg("s"))
+
+			require.Equal(t, tc.wantedTitle, m.detail.title)
+			require.Equal(t, tc.wantedLines, m.detail.lines)
+		})
+	}
+}
+
+func TestUIModel_Logs(t *testing.T) {
+	testCases := map[string]struct {
+		down        int
+		setupMocks  func(m *uiMocks)
+		wantedTitle string
+		wantedLines []string
+	}{
+		"shows the latest logs of a service": {
+			down: 2,
+			setupMocks: func(m *uiMocks) {
+				m.logs.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(func(opts logging.WriteLogEventsOpts) error {
+					require.Equal(t, int64(uiLogsLimit), *opts.Limit)
+					require.False(t, opts.IncludeStateMachineLogs)
+					return opts.OnEvents(io.Discard, []logging.HumanJSONStringer{
+						&mockDescribeData{data: "copilot/api/1 GET /\n"},
+						&mockDescribeData{data: "copilot/api/1 GET /health\n"},
+					})
+				})
+			},
+			wantedTitle: "Latest logs of api in test",
+			wantedLines: []string{"copilot/api/1 GET /", "copilot/api/1 GET /health"},
+		},
+		"includes the state machine logs of a job": {
+			down: 3,
+			setupMocks: func(m *uiMocks) {
+				m.logs.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(func(opts logging.WriteLogEventsOpts) error {
+					require.True(t, opts.IncludeStateMachineLogs)
+					return nil
+				})
+			},
+			wantedTitle: "Latest logs of report in test",
+			wantedLines: []string{"No log events."},
+		},
+		"shows the error if the logs can't be written": {
+			down: 2,
+			setupMocks: func(m *uiMocks) {
+				m.logs.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedTitle: "Latest logs of api in test",
+			wantedLines: []string{"some error"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			opts, mocks := newUITestOpts(ctrl)
+			m := newUIModel(opts)
+			expandApp(m, mocks)
+			tc.setupMocks(mocks)
+
+			for i := 0; i < tc.down-1; i++ {
+				update(m, tui.KeyDown)
+			}
+			update(m, tui.KeyMsg("l"))
+
+			require.Equal(t, tc.wantedTitle, m.detail.title)
+			require.Equal(t, tc.wantedLines, m.detail.lines)
+		})
+	}
+}
+
+func TestUIModel_Deploy(t *testing.T) {
+	testCases := map[string]struct {
+		down        int
+		keys        []tui.Msg
+		setupMocks  func(m *uiMocks)
+		wantedTitle string
+		wantedLines []string
+	}{
+		"cancels the deployment if it's not confirmed": {
+			down:        2,
+			keys:        []tui.Msg{tui.KeyMsg("d"), tui.KeyMsg("n")},
+			setupMocks:  func(m *uiMocks) {},
+			wantedTitle: "Deployment canceled",
+		},
+		"deploys a service and shows its output": {
+			down: 2,
+			keys: []tui.Msg{tui.KeyMsg("d"), tui.KeyMsg("y")},
+			setupMocks: func(m *uiMocks) {
+				m.cmd.EXPECT().Run("/usr/local/bin/copilot", []string{"svc", "deploy", "--app", "phonetool", "--env", "test", "--name", "api"}, gomock.Any()).
+					DoAndReturn(func(_ string, _ []string, opts ...exec.CmdOption) error {
+						cmd := &osexec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						require.Contains(t, cmd.Env, "COLOR=false")
+						_, err := fmt.Fprint(cmd.Stdout, "Building your container image\n\x1b[1A\x1b[2K- Updating\r✔ Deployed api.")
+						return err
+					})
+				m.program.EXPECT().Send(uiDeployOutputMsg{line: "Building your container image"})
+				m.program.EXPECT().Send(uiDeployOutputMsg{line: "✔ Deployed api."})
+			},
+			wantedTitle: "Deployed api to test",
+		},
+		"deploys a job": {
+			down: 3,
+			keys: []tui.Msg{tui.KeyMsg("d"), tui.KeyMsg("y")},
+			setupMocks: func(m *uiMocks) {
+				m.cmd.EXPECT().Run("/usr/local/bin/copilot", []string{"job", "deploy", "--app", "phonetool", "--env", "test", "--name", "report"}, gomock.Any())
+			},
+			wantedTitle: "Deployed report to test",
+		},
+		"shows the error if the deployment fails": {
+			down: 2,
+			keys: []tui.Msg{tui.KeyMsg("d"), tui.KeyMsg("y")},
+			setupMocks: func(m *uiMocks) {
+				m.cmd.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("exit status 1"))
+			},
+			wantedTitle: "Failed to deploy api to test",
+			wantedLines: []string{"exit status 1"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			opts, mocks := newUITestOpts(ctrl)
+			m := newUIModel(opts)
+			expandApp(m, mocks)
+			tc.setupMocks(mocks)
+
+			for i := 0; i < tc.down-1; i++ {
+				update(m, tui.KeyDown)
+			}
+			update(m, tc.keys...)
+
+			require.False(t, m.deploying)
+			require.Equal(t, tc.wantedTitle, m.detail.title)
+			require.Equal(t, tc.wantedLines, m.detail.lines)
+		})
+	}
+}
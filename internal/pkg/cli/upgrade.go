@@ -0,0 +1,394 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+	"golang.org/x/term"
+)
+
+const (
+	upgradeChannelStable = "stable"
+	upgradeChannelPre    = "pre"
+
+	copilotReleasesAPIURL = "https://api.github.com/repos/aws/copilot-cli/releases"
+	// disableUpgradeNotificationEnvVar turns off the notifications of the releases that fix a bug in the command that just ran.
+	disableUpgradeNotificationEnvVar = "COPILOT_DISABLE_UPGRADE_NOTIFICATION"
+	// releasesCacheTTL is how long the releases cached for the notifications are used before they're listed again.
+	releasesCacheTTL = 24 * time.Hour
+	// releasesFailureCacheTTL is how long to wait before listing the releases again after it failed.
+	releasesFailureCacheTTL = time.Hour
+	// releasesNotificationTimeout bounds the time that listing the releases adds to a command.
+	releasesNotificationTimeout = 2 * time.Second
+)
+
+var (
+	upgradeChannels = []string{upgradeChannelStable, upgradeChannelPre}
+
+	// releasesCacheFileName is the file, relative to the home directory of the user, that caches the releases of Copilot.
+	releasesCacheFileName = filepath.Join(".copilot", "releases.json")
+)
+
+// copilotRelease is a release of Copilot on GitHub.
+type copilotRelease struct {
+	Version    string `json:"tag_name"`
+	Prerelease bool   `json:"prerelease"`
+	Draft      bool   `json:"draft"`
+	Notes      string `json:"body"`
+}
+
+type upgradeVars struct {
+	channel string
+}
+
+type upgradeOpts struct {
+	upgradeVars
+
+	current    string // Version of the running binary.
+	fs         afero.Fs
+	http       httpGetter
+	executable func() (string, error)
+	prog       progress
+}
+
+func newUpgradeOpts(vars upgradeVars) *upgradeOpts {
+	return &upgradeOpts{
+		upgradeVars: vars,
+		current:     version.Version,
+		fs:          afero.NewOsFs(),
+		http:        http.DefaultClient,
+		executable: func() (string, error) {
+			exe, err := os.Executable()
+			if err != nil {
+				return "", err
+			}
+			// Replace the binary itself rather than a symlink to it, like the one of a package manager.
+			return filepath.EvalSymlinks(exe)
+		},
+		prog: termprogress.NewSpinner(log.DiagnosticWriter),
+	}
+}
+
+// Validate returns an error if the release channel isn't supported.
+func (o *upgradeOpts) Validate() error {
+	if !contains(o.channel, upgradeChannels) {
+		return fmt.Errorf("invalid channel %q: must be one of %s",
+			o.channel, english.WordSeries(applyAll(upgradeChannels, strconv.Quote), "or"))
+	}
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *upgradeOpts) Ask() error {
+	return nil
+}
+
+// Execute replaces the running binary with the latest release of Copilot of the channel, if it's newer.
+func (o *upgradeOpts) Execute() error {
+	releases, err := listCopilotReleases(o.http)
+	if err != nil {
+		return err
+	}
+	latest := latestCopilotRelease(releases, o.channel)
+	if latest == nil {
+		return fmt.Errorf("no %s release of copilot found", o.channel)
+	}
+	if semver.Compare(latest.Version, o.current) <= 0 {
+		log.Infof("Copilot %s is up to date with the latest %s release %s.\n", o.current, o.channel, latest.Version)
+		return nil
+	}
+	exe, err := o.executable()
+	if err != nil {
+		return fmt.Errorf("find the path of the running binary: %w", err)
+	}
+	asset, err := copilotReleaseAsset(latest.Version, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return err
+	}
+	o.prog.Start(fmt.Sprintf("Downloading Copilot %s", latest.Version))
+	content, err := downloadCopilotRelease(o.http, latest.Version, asset)
+	if err != nil {
+		o.prog.Stop(log.Serrorf("Failed to download Copilot %s.\n", latest.Version))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf("Downloaded Copilot %s.\n", latest.Version))
+	if err := replaceExecutable(o.fs, exe, content); err != nil {
+		return fmt.Errorf("replace %s with copilot %s: %w", exe, latest.Version, err)
+	}
+	log.Successf("Upgraded Copilot from %s to %s.\n", o.current, latest.Version)
+	return nil
+}
+
+// RecommendActions is a no-op for this command.
+func (o *upgradeOpts) RecommendActions() error {
+	return nil
+}
+
+// listCopilotReleases returns the releases of Copilot, from the most recent.
+func listCopilotReleases(client httpGetter) ([]copilotRelease, error) {
+	content, err := httpGet(client, copilotReleasesAPIURL)
+	if err != nil {
+		return nil, fmt.Errorf("list copilot releases: %w", err)
+	}
+	var releases []copilotRelease
+	if err := json.Unmarshal(content, &releases); err != nil {
+		return nil, fmt.Errorf("unmarshal copilot releases: %w", err)
+	}
+	return releases, nil
+}
+
+// latestCopilotRelease returns the most recent release of the channel, or nil if there is none.
+// The pre-release channel includes the releases, so that it never lags behind the stable channel.
+func latestCopilotRelease(releases []copilotRelease, channel string) *copilotRelease {
+	var latest *copilotRelease
+	for i := range releases {
+		release := &releases[i]
+		if release.Draft || !semver.IsValid(release.Version) {
+			continue
+		}
+		if release.Prerelease && channel != upgradeChannelPre {
+			continue
+		}
+		if latest == nil || semver.Compare(release.Version, latest.Version) > 0 {
+			latest = release
+		}
+	}
+	return latest
+}
+
+// replaceExecutable swaps the binary at path with content. The binary is moved aside first,
+// since Windows doesn't allow the file of a running executable to be overwritten, only renamed.
+func replaceExecutable(fs afero.Fs, path string, content []byte) error {
+	info, err := fs.Stat(path)
+	if err != nil {
+		return fmt.Errorf("stat %s: %w", path, err)
+	}
+	// Write to a temporary file in the same directory first, so that the binary is swapped with a rename.
+	tmp := path + ".download"
+	if err := afero.WriteFile(fs, tmp, content, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("write %s: %w", tmp, err)
+	}
+	old := path + ".old"
+	_ = fs.Remove(old) // Left behind by a previous upgrade on Windows.
+	if err := fs.Rename(path, old); err != nil {
+		_ = fs.Remove(tmp)
+		return fmt.Errorf("move %s aside: %w", path, err)
+	}
+	if err := fs.Rename(tmp, path); err != nil {
+		_ = fs.Rename(old, path)
+		_ = fs.Remove(tmp)
+		return fmt.Errorf("move %s to %s: %w", tmp, path, err)
+	}
+	_ = fs.Remove(old) // Fails on Windows while the old binary runs, it's removed by the next upgrade.
+	return nil
+}
+
+// releasesCache is the content of the cache of the releases of Copilot for the notifications.
+type releasesCache struct {
+	ListedAt time.Time        `json:"listedAt"`
+	FailedAt time.Time        `json:"failedAt,omitempty"` // Last time the releases couldn't be listed.
+	Releases []copilotRelease `json:"releases"`
+	Notified []string         `json:"notified,omitempty"` // The releases and commands that were notified, like "v1.32.1 copilot svc deploy".
+}
+
+// UpgradeNotifier notifies of the stable releases of Copilot that fix a bug in a command.
+type UpgradeNotifier struct {
+	current   string // Version of the running binary.
+	fs        afero.Fs
+	cachePath string
+	http      httpGetter
+	now       func() time.Time
+
+	done  chan struct{} // Closed once the cached releases are refreshed.
+	cache releasesCache // Set by refresh before done is closed.
+}
+
+// StartUpgradeCheck refreshes the cached releases of Copilot in the background while the command runs,
+// so that checking for a release that fixes the command never slows it down. The releases are listed at most once a day,
+// and at most once an hour if listing them fails. It returns nil if the notifications are turned off,
+// the running binary is a development build or a pre-release, or the standard output isn't a terminal.
+func StartUpgradeCheck() *UpgradeNotifier {
+	if os.Getenv(disableUpgradeNotificationEnvVar) == "true" || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return nil
+	}
+	if !semver.IsValid(version.Version) || semver.Prerelease(version.Version) != "" {
+		return nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	n := &UpgradeNotifier{
+		current:   version.Version,
+		fs:        afero.NewOsFs(),
+		cachePath: filepath.Join(home, releasesCacheFileName),
+		http:      &http.Client{Timeout: releasesNotificationTimeout},
+		now:       time.Now,
+		done:      make(chan struct{}),
+	}
+	go n.refresh()
+	return n
+}
+
+// Notify logs a notification, once per release, if a stable release of Copilot newer than the running one
+// fixes a bug in the command at cmdPath that just ran, like "copilot svc deploy". It never fails the command
+// nor waits for the releases: if they aren't refreshed yet, the notification is left to a later command.
+func (n *UpgradeNotifier) Notify(cmdPath string) {
+	if n == nil {
+		return
+	}
+	if msg := n.notification(cmdPath); msg != "" {
+		log.Infoln(msg)
+	}
+}
+
+// refresh lists the releases again if the cached ones are stale. Failures are cached too,
+// so that an unreachable GitHub isn't requested again by every command.
+func (n *UpgradeNotifier) refresh() {
+	defer close(n.done)
+	n.cache = n.readCache()
+	if n.now().Sub(n.cache.ListedAt) <= releasesCacheTTL || n.now().Sub(n.cache.FailedAt) <= releasesFailureCacheTTL {
+		return
+	}
+	releases, err := listCopilotReleases(n.http)
+	if err != nil {
+		n.cache.FailedAt = n.now()
+		n.writeCache(n.cache)
+		return
+	}
+	n.cache.ListedAt, n.cache.FailedAt, n.cache.Releases = n.now(), time.Time{}, releases
+	n.writeCache(n.cache)
+}
+
+// notification returns the notification for the command, or an empty string if there is nothing to notify.
+func (n *UpgradeNotifier) notification(cmdPath string) string {
+	if name := strings.TrimPrefix(cmdPath, "copilot "); strings.HasPrefix(name, "upgrade") || strings.HasPrefix(name, "version") {
+		return ""
+	}
+	select {
+	case <-n.done:
+	default:
+		return ""
+	}
+	fix := bugFixRelease(n.cache.Releases, n.current, cmdPath)
+	if fix == nil {
+		return ""
+	}
+	key := fmt.Sprintf("%s %s", fix.Version, cmdPath)
+	if contains(key, n.cache.Notified) {
+		return ""
+	}
+	n.cache.Notified = append(n.cache.Notified, key)
+	n.writeCache(n.cache)
+	return fmt.Sprintf("Copilot %s fixes a bug in %s. Run %s to upgrade from %s.",
+		fix.Version, color.HighlightCode(cmdPath), color.HighlightCode("copilot upgrade"), n.current)
+}
+
+func (n *UpgradeNotifier) readCache() releasesCache {
+	var cache releasesCache
+	content, err := afero.ReadFile(n.fs, n.cachePath)
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(content, &cache); err != nil {
+		// List the releases again to overwrite a corrupted cache.
+		return releasesCache{}
+	}
+	return cache
+}
+
+func (n *UpgradeNotifier) writeCache(cache releasesCache) {
+	content, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+	if err := n.fs.MkdirAll(filepath.Dir(n.cachePath), 0755); err != nil {
+		return
+	}
+	// Write to a temporary file first, so that a command that exits mid-write doesn't leave a truncated cache.
+	tmp := n.cachePath + ".tmp"
+	if err := afero.WriteFile(n.fs, tmp, content, 0644); err != nil {
+		return
+	}
+	if err := n.fs.Rename(tmp, n.cachePath); err != nil {
+		_ = n.fs.Remove(tmp)
+	}
+}
+
+// bugFixRelease returns the most recent stable release newer than the current version whose notes mention a fix
+// of the command at cmdPath, or nil if there is none.
+func bugFixRelease(releases []copilotRelease, current, cmdPath string) *copilotRelease {
+	// The command shouldn't match the commands it prefixes, like "copilot svc" for "copilot svc deploy".
+	mention := regexp.MustCompile(`(^|[^\w-])` + regexp.QuoteMeta(strings.ToLower(cmdPath)) + `($|[^\w-])`)
+	var latest *copilotRelease
+	for i := range releases {
+		release := &releases[i]
+		if release.Draft || release.Prerelease || !semver.IsValid(release.Version) || semver.Compare(release.Version, current) <= 0 {
+			continue
+		}
+		if !fixesCommand(release.Notes, mention) {
+			continue
+		}
+		if latest == nil || semver.Compare(release.Version, latest.Version) > 0 {
+			latest = release
+		}
+	}
+	return latest
+}
+
+// fixesCommand returns true if a line of the release notes mentions a fix and the command.
+func fixesCommand(notes string, mention *regexp.Regexp) bool {
+	for _, line := range strings.Split(notes, "\n") {
+		line = strings.ToLower(line)
+		if strings.Contains(line, "fix") && mention.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildUpgradeCmd builds the command for upgrading Copilot to its latest release.
+func BuildUpgradeCmd() *cobra.Command {
+	vars := upgradeVars{}
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrades Copilot to its latest release.",
+		Long: `Upgrades Copilot to its latest release.
+Downloads the latest release of the channel, verifies its checksum and signature, and replaces the running binary with it.`,
+		Example: `
+  Upgrades Copilot to the latest release.
+  /code $ copilot upgrade
+  Upgrades Copilot to the latest pre-release, to try out new features.
+  /code $ copilot upgrade --channel pre`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(newUpgradeOpts(vars))
+		}),
+		Annotations: map[string]string{
+			"group": group.Settings,
+		},
+	}
+	cmd.Flags().StringVar(&vars.channel, upgradeChannelFlag, upgradeChannelStable, upgradeChannelFlagDescription)
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,349 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inChannel string
+
+		wantedError error
+	}{
+		"error if the channel is invalid": {
+			inChannel:   "nightly",
+			wantedError: errors.New(`invalid channel "nightly": must be one of "stable" or "pre"`),
+		},
+		"stable channel": {
+			inChannel: "stable",
+		},
+		"pre-release channel": {
+			inChannel: "pre",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := upgradeOpts{
+				upgradeVars: upgradeVars{
+					channel: tc.inChannel,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpgradeOpts_Execute(t *testing.T) {
+	const exe = "/usr/local/bin/copilot"
+	releases, err := json.Marshal([]copilotRelease{
+		{Version: "v1.33.0-rc1", Prerelease: true},
+		{Version: "v1.34.0", Draft: true},
+		{Version: "v1.32.1"},
+		{Version: "v1.32.0"},
+	})
+	require.NoError(t, err)
+	key := pinReleaseKey(t)
+	releaseFiles := func(version string, binary []byte, signature []byte) fakeURLGetter {
+		asset, err := copilotReleaseAsset(version, runtime.GOOS, runtime.GOARCH)
+		if err != nil {
+			t.Skip(err.Error())
+		}
+		url := fmt.Sprintf("https://github.com/aws/copilot-cli/releases/download/%s/%s", version, asset)
+		return fakeURLGetter{
+			copilotReleasesAPIURL: releases,
+			url:                   binary,
+			url + ".asc":          signature,
+		}
+	}
+
+	latestAsset, err := copilotReleaseAsset("v1.32.1", runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		t.Skip(err.Error())
+	}
+	latestURL := fmt.Sprintf("https://github.com/aws/copilot-cli/releases/download/v1.32.1/%s", latestAsset)
+
+	testCases := map[string]struct {
+		inChannel string
+		current   string
+		files     func() fakeURLGetter
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error if the releases can't be listed": {
+			inChannel: "stable",
+			current:   "v1.32.0",
+			files: func() fakeURLGetter {
+				return fakeURLGetter{}
+			},
+			wantedError: fmt.Errorf("list copilot releases: GET %s: unexpected status 404 Not Found", copilotReleasesAPIURL),
+		},
+		"do nothing if the latest release is already running": {
+			inChannel: "stable",
+			current:   "v1.32.1",
+			files: func() fakeURLGetter {
+				return fakeURLGetter{copilotReleasesAPIURL: releases}
+			},
+			wantedBinary: []byte("current"),
+		},
+		"error if the release has no signature": {
+			inChannel: "stable",
+			current:   "v1.32.0",
+			files: func() fakeURLGetter {
+				files := releaseFiles("v1.32.1", []byte("latest"), nil)
+				delete(files, latestURL+".asc")
+				return files
+			},
+			wantedError: fmt.Errorf("download signature of copilot v1.32.1: GET %s.asc: unexpected status 404 Not Found", latestURL),
+		},
+		"error if the download doesn't match the signature of the release": {
+			inChannel: "stable",
+			current:   "v1.32.0",
+			files: func() fakeURLGetter {
+				return releaseFiles("v1.32.1", []byte("tampered"), signRelease(t, key, []byte("latest")))
+			},
+			wantedError: errors.New("verify copilot v1.32.1: signature doesn't match the release signing key: openpgp: invalid signature: hash tag doesn't match"),
+		},
+		"upgrade to the latest stable release": {
+			inChannel: "stable",
+			current:   "v1.32.0",
+			files: func() fakeURLGetter {
+				return releaseFiles("v1.32.1", []byte("latest"), signRelease(t, key, []byte("latest")))
+			},
+			wantedBinary: []byte("latest"),
+		},
+		"upgrade to the latest pre-release": {
+			inChannel: "pre",
+			current:   "v1.32.1",
+			files: func() fakeURLGetter {
+				return releaseFiles("v1.33.0-rc1", []byte("rc"), signRelease(t, key, []byte("rc")))
+			},
+			wantedBinary: []byte("rc"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			prog := mocks.NewMockprogress(ctrl)
+			prog.EXPECT().Start(gomock.Any()).AnyTimes()
+			prog.EXPECT().Stop(gomock.Any()).AnyTimes()
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, exe, []byte("current"), 0755))
+			opts := upgradeOpts{
+				upgradeVars: upgradeVars{
+					channel: tc.inChannel,
+				},
+				current: tc.current,
+				fs:      fs,
+				http:    tc.files(),
+				executable: func() (string, error) {
+					return exe, nil
+				},
+				prog: prog,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				content, err := afero.ReadFile(fs, exe)
+				require.NoError(t, err)
+				require.Equal(t, []byte("current"), content, "the running binary should be left as is")
+				return
+			}
+			require.NoError(t, err)
+			content, err := afero.ReadFile(fs, exe)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBinary, content)
+			info, err := fs.Stat(exe)
+			require.NoError(t, err)
+			require.Equal(t, "-rwxr-xr-x", info.Mode().Perm().String())
+			for _, leftover := range []string{exe + ".download", exe + ".old"} {
+				exists, err := afero.Exists(fs, leftover)
+				require.NoError(t, err)
+				require.False(t, exists, "%s shouldn't be left behind", leftover)
+			}
+		})
+	}
+}
+
+func TestUpgradeNotifier_notification(t *testing.T) {
+	const cachePath = "/home/.copilot/releases.json"
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	releases := []copilotRelease{
+		{
+			Version:    "v1.33.0-rc1",
+			Prerelease: true,
+			Notes:      "- Fix `copilot svc deploy` with sidecars.",
+		},
+		{
+			Version: "v1.32.1",
+			Notes: `## Bug fixes
+- Fix a crash of ` + "`copilot svc deploy`" + ` when the manifest has no image.
+- Fix the help menu of ` + "`copilot env deploy-all`" + `.`,
+		},
+		{
+			Version: "v1.32.0",
+			Notes:   "- Fix `copilot job run` timeouts.",
+		},
+	}
+	listed, err := json.Marshal(releases)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		current string
+		cmdPath string
+		cache   *releasesCache
+		files   fakeURLGetter
+
+		wanted         string
+		wantedNotified []string
+		wantedFailedAt time.Time
+	}{
+		"notify of a newer release that fixes the command": {
+			current:        "v1.32.0",
+			cmdPath:        "copilot svc deploy",
+			files:          fakeURLGetter{copilotReleasesAPIURL: listed},
+			wanted:         "Copilot v1.32.1 fixes a bug in `copilot svc deploy`. Run `copilot upgrade` to upgrade from v1.32.0.",
+			wantedNotified: []string{"v1.32.1 copilot svc deploy"},
+		},
+		"use the cached releases if they were listed recently": {
+			current: "v1.32.0",
+			cmdPath: "copilot svc deploy",
+			cache: &releasesCache{
+				ListedAt: now.Add(-time.Hour),
+				Releases: releases,
+			},
+			files:          fakeURLGetter{},
+			wanted:         "Copilot v1.32.1 fixes a bug in `copilot svc deploy`. Run `copilot upgrade` to upgrade from v1.32.0.",
+			wantedNotified: []string{"v1.32.1 copilot svc deploy"},
+		},
+		"notify of the release only once": {
+			current: "v1.32.0",
+			cmdPath: "copilot svc deploy",
+			cache: &releasesCache{
+				ListedAt: now.Add(-time.Hour),
+				Releases: releases,
+				Notified: []string{"v1.32.1 copilot svc deploy"},
+			},
+			wantedNotified: []string{"v1.32.1 copilot svc deploy"},
+		},
+		"don't notify of the fixes of the commands that the command prefixes": {
+			current: "v1.32.0",
+			cmdPath: "copilot env deploy",
+			files:   fakeURLGetter{copilotReleasesAPIURL: listed},
+		},
+		"don't notify of the fixes of older releases": {
+			current: "v1.32.1",
+			cmdPath: "copilot job run",
+			files:   fakeURLGetter{copilotReleasesAPIURL: listed},
+		},
+		"don't notify if the releases can't be listed, and cache the failure": {
+			current:        "v1.32.0",
+			cmdPath:        "copilot svc deploy",
+			files:          fakeURLGetter{},
+			wantedFailedAt: now,
+		},
+		"don't list the releases again soon after it failed": {
+			current: "v1.32.0",
+			cmdPath: "copilot svc deploy",
+			cache: &releasesCache{
+				FailedAt: now.Add(-time.Minute),
+			},
+			files:          fakeURLGetter{copilotReleasesAPIURL: listed},
+			wantedFailedAt: now.Add(-time.Minute),
+		},
+		"list the releases again an hour after it failed": {
+			current: "v1.32.0",
+			cmdPath: "copilot svc deploy",
+			cache: &releasesCache{
+				FailedAt: now.Add(-2 * time.Hour),
+			},
+			files:          fakeURLGetter{copilotReleasesAPIURL: listed},
+			wanted:         "Copilot v1.32.1 fixes a bug in `copilot svc deploy`. Run `copilot upgrade` to upgrade from v1.32.0.",
+			wantedNotified: []string{"v1.32.1 copilot svc deploy"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			if tc.cache != nil {
+				content, err := json.Marshal(tc.cache)
+				require.NoError(t, err)
+				require.NoError(t, afero.WriteFile(fs, cachePath, content, 0644))
+			}
+			n := &UpgradeNotifier{
+				current:   tc.current,
+				fs:        fs,
+				cachePath: cachePath,
+				http:      tc.files,
+				now: func() time.Time {
+					return now
+				},
+				done: make(chan struct{}),
+			}
+
+			// WHEN
+			n.refresh()
+			got := n.notification(tc.cmdPath)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+			if tc.wantedNotified != nil {
+				require.Equal(t, tc.wantedNotified, n.readCache().Notified)
+			}
+			require.True(t, tc.wantedFailedAt.Equal(n.readCache().FailedAt))
+		})
+	}
+
+	t.Run("don't wait for the releases to be refreshed", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		content, err := json.Marshal(releasesCache{
+			ListedAt: now.Add(-time.Hour),
+			Releases: releases,
+		})
+		require.NoError(t, err)
+		require.NoError(t, afero.WriteFile(fs, cachePath, content, 0644))
+		n := &UpgradeNotifier{
+			current:   "v1.32.0",
+			fs:        fs,
+			cachePath: cachePath,
+			now: func() time.Time {
+				return now
+			},
+			done: make(chan struct{}),
+		}
+
+		require.Empty(t, n.notification("copilot svc deploy"))
+	})
+
+	t.Run("do nothing without a notifier", func(t *testing.T) {
+		var n *UpgradeNotifier
+		n.Notify("copilot svc deploy")
+	})
+}
@@ -11,7 +11,9 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"golang.org/x/net/idna"
 	"gopkg.in/yaml.v3"
 
 	"github.com/robfig/cron/v3"
@@ -21,6 +23,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 )
@@ -180,6 +183,14 @@ func validateAppNameString(val interface{}) error {
 	return nil
 }
 
+// validateNamingConvention returns an error if the name of a new resource violates the naming conventions of its application.
+func validateNamingConvention(app *config.Application, kind, name string) error {
+	if app == nil {
+		return nil
+	}
+	return app.Conventions.Validate(kind, name)
+}
+
 func validateSvcName(val interface{}, svcType string) error {
 	var err error
 	switch svcType {
@@ -320,6 +331,16 @@ func validateDomainName(val interface{}) error {
 	return nil
 }
 
+// domainNameToASCII converts an internationalized domain name to punycode, and returns ASCII domain names as is.
+func domainNameToASCII(domainName string) (string, error) {
+	for _, r := range domainName {
+		if r >= utf8.RuneSelf {
+			return idna.Lookup.ToASCII(domainName)
+		}
+	}
+	return domainName, nil
+}
+
 func validatePath(fs afero.Fs, val interface{}) error {
 	path, ok := val.(string)
 	if !ok {
@@ -21,13 +21,14 @@ func BuildVersionCmd() *cobra.Command {
 		Use:   "version",
 		Short: "Print the version number.",
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			fmt.Printf("version: %s, built for %s\n", version.Version, runtime.GOOS)
+			fmt.Fprintf(cmd.OutOrStdout(), "version: %s, built for %s\n", version.Version, runtime.GOOS)
 			return nil
 		}),
 		Annotations: map[string]string{
 			"group": group.Settings,
 		},
 	}
+	cmd.AddCommand(buildVersionPinCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	return cmd
 }
@@ -0,0 +1,338 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/openpgp"
+)
+
+const (
+	// pinnedVersionFileName is the file, at the root of the workspace, that pins the version of Copilot to run in the workspace.
+	pinnedVersionFileName = ".copilot-version"
+	// ignoreVersionPinEnvVar lets the current version of Copilot run in a workspace that pins another version.
+	// It's set for the pinned version once it runs, so that it doesn't look for a pin again.
+	ignoreVersionPinEnvVar = "COPILOT_IGNORE_VERSION_PIN"
+	copilotReleasesURL     = "https://github.com/aws/copilot-cli/releases/download"
+	checksumFileExt        = ".sha256"
+)
+
+var (
+	// pinnedVersionsDirName is the directory, relative to the home directory of the user, that the pinned versions are downloaded to.
+	pinnedVersionsDirName  = filepath.Join(".copilot", "versions")
+	releasedVersionPattern = regexp.MustCompile(`^v\d+\.\d+\.\d+$`)
+
+	// releaseSigningKey is the armored PGP public key that signs the release assets of Copilot.
+	// It's in the source rather than downloaded, so that a compromised download location can't swap the key.
+	releaseSigningKey = amazonECSPublicKey
+)
+
+type pinVersionVars struct {
+	version string
+}
+
+type pinVersionOpts struct {
+	pinVersionVars
+
+	ws workspacePathGetter
+	fs afero.Fs
+}
+
+func newPinVersionOpts(vars pinVersionVars) (*pinVersionOpts, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+	return &pinVersionOpts{
+		pinVersionVars: vars,
+		ws:             ws,
+		fs:             fs,
+	}, nil
+}
+
+// Validate returns an error if the version to pin isn't a released version of Copilot.
+func (o *pinVersionOpts) Validate() error {
+	if !releasedVersionPattern.MatchString(o.version) {
+		return fmt.Errorf("invalid version %q: must be a released version of Copilot like %q", o.version, "v1.32.0")
+	}
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *pinVersionOpts) Ask() error {
+	return nil
+}
+
+// Execute writes the version to pin to the version file at the root of the workspace.
+func (o *pinVersionOpts) Execute() error {
+	path := filepath.Join(o.ws.Path(), pinnedVersionFileName)
+	if err := afero.WriteFile(o.fs, path, []byte(o.version+"\n"), 0644); err != nil {
+		return fmt.Errorf("write pinned version to %s: %w", path, err)
+	}
+	log.Successf("Pinned the version of Copilot in the workspace to %s.\n", o.version)
+	return nil
+}
+
+// RecommendActions suggests sharing the pinned version with the other users of the workspace.
+func (o *pinVersionOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Commit %s so that everyone working in the workspace runs Copilot %s.", color.HighlightResource(pinnedVersionFileName), o.version),
+	})
+	return nil
+}
+
+// buildVersionPinCmd builds the command for pinning the version of Copilot of the workspace.
+func buildVersionPinCmd() *cobra.Command {
+	vars := pinVersionVars{}
+	cmd := &cobra.Command{
+		Use:   "pin [version]",
+		Short: "Pins the version of Copilot to run in the workspace.",
+		Long: fmt.Sprintf(`Pins the version of Copilot to run in the workspace.
+Writes the version to %s at the root of the workspace. Any other version of Copilot run in the workspace
+downloads the pinned version and runs it instead, so that everyone deploys the application with the same templates.`, pinnedVersionFileName),
+		Example: `
+  Pins the workspace to the version of Copilot that runs the command.
+  /code $ copilot version pin
+  Pins the workspace to Copilot v1.32.0.
+  /code $ copilot version pin v1.32.0`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.version = version.Version
+			if len(args) == 1 {
+				vars.version = args[0]
+			}
+			opts, err := newPinVersionOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	return cmd
+}
+
+// readPinnedVersion returns the version pinned in the workspace at dir, or an empty string if there is none.
+func readPinnedVersion(fs afero.Fs, dir string) (string, error) {
+	path := filepath.Join(dir, pinnedVersionFileName)
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("read pinned version: %w", err)
+	}
+	pinned := strings.TrimSpace(string(content))
+	if !releasedVersionPattern.MatchString(pinned) {
+		return "", fmt.Errorf("invalid version %q pinned in %s: must be a released version of Copilot like %q", pinned, path, "v1.32.0")
+	}
+	return pinned, nil
+}
+
+// copilotReleaseAsset returns the name of the binary of the release of Copilot for the OS and architecture.
+func copilotReleaseAsset(version, goos, goarch string) (string, error) {
+	switch {
+	case goos == "windows" && goarch == "amd64":
+		return fmt.Sprintf("copilot-windows-%s.exe", version), nil
+	case (goos == "darwin" || goos == "linux") && (goarch == "amd64" || goarch == "arm64"):
+		return fmt.Sprintf("copilot-%s-%s-%s", goos, goarch, version), nil
+	default:
+		return "", fmt.Errorf("copilot isn't released for %s/%s", goos, goarch)
+	}
+}
+
+// pinnedVersionRunner runs the version of Copilot pinned in the workspace in place of the current one.
+type pinnedVersionRunner struct {
+	current string // Version of the running binary.
+	fs      afero.Fs
+	wsPath  func() (string, error)
+	dataDir string // Directory that the pinned versions are downloaded to.
+	http    httpGetter
+	cmd     execRunner
+}
+
+// RunPinnedVersion runs the version of Copilot pinned in the workspace of the working directory with the arguments,
+// if it isn't the running version, and returns its exit code. It returns false if the running version should run the
+// command instead, for example outside a workspace, or with a warning if the pinned version can't be downloaded.
+func RunPinnedVersion(args []string) (exitCode int, ran bool) {
+	var dataDir string
+	if home, err := os.UserHomeDir(); err == nil {
+		dataDir = filepath.Join(home, pinnedVersionsDirName)
+	}
+	fs := afero.NewOsFs()
+	r := &pinnedVersionRunner{
+		current: version.Version,
+		fs:      fs,
+		wsPath: func() (string, error) {
+			ws, err := workspace.Use(fs)
+			if err != nil {
+				return "", err
+			}
+			return ws.Path(), nil
+		},
+		dataDir: dataDir,
+		http:    http.DefaultClient,
+		cmd:     exec.NewCmd(),
+	}
+	return r.run(args)
+}
+
+func (r *pinnedVersionRunner) run(args []string) (exitCode int, ran bool) {
+	if os.Getenv(ignoreVersionPinEnvVar) == "true" {
+		return 0, false
+	}
+	if len(args) > 0 && (args[0] == "version" || args[0] == "upgrade") {
+		// The version commands report and pin the running version, and upgrade replaces it.
+		return 0, false
+	}
+	dir, err := r.wsPath()
+	if err != nil {
+		// Only workspaces pin a version.
+		return 0, false
+	}
+	pinned, err := readPinnedVersion(r.fs, dir)
+	if err != nil {
+		log.Warningf("Ignoring the pinned version of Copilot: %v\n", err)
+		return 0, false
+	}
+	if pinned == "" || pinned == r.current {
+		return 0, false
+	}
+	bin, err := r.install(pinned)
+	if err != nil {
+		log.Warningf("The workspace pins Copilot %s, but this is Copilot %s and the pinned version can't be installed: %v\n", pinned, r.current, err)
+		return 0, false
+	}
+	err = r.cmd.Run(bin, args, exec.Stdin(os.Stdin), exec.Stdout(os.Stdout), exec.Stderr(os.Stderr),
+		exec.Env(fmt.Sprintf("%s=true", ignoreVersionPinEnvVar)))
+	var exitErr *osexec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), true
+	}
+	if err != nil {
+		log.Errorf("Run Copilot %s pinned in the workspace: %v\n", pinned, err)
+		return 1, true
+	}
+	return 0, true
+}
+
+// install downloads the release of the version of Copilot into the data directory unless it's already there,
+// verifies its signature, and returns the path to the binary.
+// The SHA-256 checksum of the binary is recorded next to it, so that a binary modified after it was installed is downloaded again.
+func (r *pinnedVersionRunner) install(version string) (string, error) {
+	if r.dataDir == "" {
+		return "", errors.New("find home directory to download the pinned version to")
+	}
+	asset, err := copilotReleaseAsset(version, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return "", err
+	}
+	bin := filepath.Join(r.dataDir, version, asset)
+	if r.installed(bin) {
+		return bin, nil
+	}
+	log.Infof("Downloading Copilot %s pinned in the workspace.\n", version)
+	content, err := downloadCopilotRelease(r.http, version, asset)
+	if err != nil {
+		return "", err
+	}
+	if err := r.fs.MkdirAll(filepath.Dir(bin), 0755); err != nil {
+		return "", fmt.Errorf("create directory %s: %w", filepath.Dir(bin), err)
+	}
+	// Write to a temporary file first, so that an interrupted download isn't run later.
+	tmp := bin + ".download"
+	if err := afero.WriteFile(r.fs, tmp, content, 0755); err != nil {
+		return "", fmt.Errorf("write copilot %s to %s: %w", version, tmp, err)
+	}
+	if err := afero.WriteFile(r.fs, bin+checksumFileExt, []byte(sha256Sum(content)+"\n"), 0644); err != nil {
+		return "", fmt.Errorf("write checksum of copilot %s: %w", version, err)
+	}
+	if err := r.fs.Rename(tmp, bin); err != nil {
+		_ = r.fs.Remove(tmp)
+		return "", fmt.Errorf("install copilot %s to %s: %w", version, bin, err)
+	}
+	return bin, nil
+}
+
+// installed returns true if the binary is in the data directory and its SHA-256 checksum matches the one recorded when it was installed.
+func (r *pinnedVersionRunner) installed(bin string) bool {
+	content, err := afero.ReadFile(r.fs, bin)
+	if err != nil {
+		return false
+	}
+	checksum, err := afero.ReadFile(r.fs, bin+checksumFileExt)
+	if err != nil || strings.TrimSpace(string(checksum)) != sha256Sum(content) {
+		log.Warningf("The checksum of %s doesn't match the downloaded release, downloading it again.\n", bin)
+		return false
+	}
+	return true
+}
+
+// downloadCopilotRelease returns the release asset of the version of Copilot, once verified against its detached PGP signature.
+func downloadCopilotRelease(client httpGetter, version, asset string) ([]byte, error) {
+	url := fmt.Sprintf("%s/%s/%s", copilotReleasesURL, version, asset)
+	content, err := httpGet(client, url)
+	if err != nil {
+		return nil, fmt.Errorf("download copilot %s: %w", version, err)
+	}
+	signature, err := httpGet(client, url+".asc")
+	if err != nil {
+		return nil, fmt.Errorf("download signature of copilot %s: %w", version, err)
+	}
+	if err := verifyReleaseSignature(content, signature); err != nil {
+		return nil, fmt.Errorf("verify copilot %s: %w", version, err)
+	}
+	return content, nil
+}
+
+// verifyReleaseSignature returns nil if signature, the armored detached PGP signature of a release asset,
+// is a signature of content by the release signing key.
+func verifyReleaseSignature(content, signature []byte) error {
+	keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(releaseSigningKey))
+	if err != nil {
+		return fmt.Errorf("read release signing key: %w", err)
+	}
+	if _, err := openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader(content), bytes.NewReader(signature)); err != nil {
+		return fmt.Errorf("signature doesn't match the release signing key: %w", err)
+	}
+	return nil
+}
+
+func sha256Sum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// httpGet returns the body of a successful GET request to the url.
+func httpGet(client httpGetter, url string) ([]byte, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,458 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+// fakeURLGetter serves the content of each url, and 404 for the others.
+type fakeURLGetter map[string][]byte
+
+func (g fakeURLGetter) Get(url string) (*http.Response, error) {
+	r := httptest.NewRecorder()
+	content, ok := g[url]
+	if !ok {
+		r.WriteHeader(http.StatusNotFound)
+		return r.Result(), nil
+	}
+	_, _ = r.Write(content)
+	return r.Result(), nil
+}
+
+// pinReleaseKey replaces the release signing key with a new key for the duration of the test, and returns the key to sign assets with.
+func pinReleaseKey(t *testing.T) *openpgp.Entity {
+	entity, err := openpgp.NewEntity("Copilot Test Release", "", "test@example.com", nil)
+	require.NoError(t, err)
+	var pub bytes.Buffer
+	w, err := armor.Encode(&pub, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+	useReleaseKey(t, pub.String())
+	return entity
+}
+
+// useReleaseKey replaces the release signing key with the armored public key for the duration of the test.
+func useReleaseKey(t *testing.T, key string) {
+	old := releaseSigningKey
+	releaseSigningKey = key
+	t.Cleanup(func() {
+		releaseSigningKey = old
+	})
+}
+
+// signRelease returns the armored detached signature of a release asset.
+func signRelease(t *testing.T, key *openpgp.Entity, content []byte) []byte {
+	var sig bytes.Buffer
+	require.NoError(t, openpgp.ArmoredDetachSign(&sig, key, bytes.NewReader(content), nil))
+	return sig.Bytes()
+}
+
+func TestVerifyReleaseSignature(t *testing.T) {
+	// The fixture is signed with gpg by the test key, like the release assets are signed by the Amazon ECS key.
+	content, err := os.ReadFile(filepath.Join("testdata", "release", "copilot"))
+	require.NoError(t, err)
+	signature, err := os.ReadFile(filepath.Join("testdata", "release", "copilot.asc"))
+	require.NoError(t, err)
+	testKey, err := os.ReadFile(filepath.Join("testdata", "release", "test-release-key.asc"))
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		key       string
+		content   []byte
+		signature []byte
+
+		wantedError error
+	}{
+		"error if the asset isn't signed by the Amazon ECS key": {
+			key:         amazonECSPublicKey,
+			content:     content,
+			signature:   signature,
+			wantedError: errors.New("signature doesn't match the release signing key: openpgp: signature made by unknown entity"),
+		},
+		"error if the signature isn't armored": {
+			key:         string(testKey),
+			content:     content,
+			signature:   []byte("c2lnbmF0dXJl"),
+			wantedError: errors.New("signature doesn't match the release signing key: EOF"),
+		},
+		"error if the signature is of another asset": {
+			key:         string(testKey),
+			content:     []byte("tampered"),
+			signature:   signature,
+			wantedError: errors.New("signature doesn't match the release signing key: openpgp: invalid signature: hash tag doesn't match"),
+		},
+		"verifies the signature of the asset by the signing key": {
+			key:       string(testKey),
+			content:   content,
+			signature: signature,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			useReleaseKey(t, tc.key)
+
+			// WHEN
+			err := verifyReleaseSignature(tc.content, tc.signature)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPinVersionOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVersion string
+
+		wantedError error
+	}{
+		"error if the version isn't released": {
+			inVersion:   "v1.32.0-12-g1a2b3c4",
+			wantedError: errors.New(`invalid version "v1.32.0-12-g1a2b3c4": must be a released version of Copilot like "v1.32.0"`),
+		},
+		"error if the version doesn't start with v": {
+			inVersion:   "1.32.0",
+			wantedError: errors.New(`invalid version "1.32.0": must be a released version of Copilot like "v1.32.0"`),
+		},
+		"valid released version": {
+			inVersion: "v1.32.0",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := pinVersionOpts{
+				pinVersionVars: pinVersionVars{
+					version: tc.inVersion,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPinVersionOpts_Execute(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ws := mocks.NewMockworkspacePathGetter(ctrl)
+	ws.EXPECT().Path().Return("/ws")
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/ws/.copilot-version", []byte("v1.30.0\n"), 0644))
+	opts := pinVersionOpts{
+		pinVersionVars: pinVersionVars{
+			version: "v1.32.0",
+		},
+		ws: ws,
+		fs: fs,
+	}
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+	pinned, err := readPinnedVersion(fs, "/ws")
+	require.NoError(t, err)
+	require.Equal(t, "v1.32.0", pinned)
+}
+
+func TestReadPinnedVersion(t *testing.T) {
+	testCases := map[string]struct {
+		content *string
+
+		wanted      string
+		wantedError error
+	}{
+		"no pinned version": {},
+		"pinned version": {
+			content: aws.String("  v1.32.0\n"),
+			wanted:  "v1.32.0",
+		},
+		"error if the pinned version is invalid": {
+			content:     aws.String("latest\n"),
+			wantedError: fmt.Errorf(`invalid version "latest" pinned in %s: must be a released version of Copilot like "v1.32.0"`, filepath.Join("/ws", ".copilot-version")),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if tc.content != nil {
+				require.NoError(t, afero.WriteFile(fs, filepath.Join("/ws", ".copilot-version"), []byte(*tc.content), 0644))
+			}
+
+			got, err := readPinnedVersion(fs, "/ws")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestCopilotReleaseAsset(t *testing.T) {
+	testCases := map[string]struct {
+		goos   string
+		goarch string
+
+		wanted      string
+		wantedError error
+	}{
+		"linux on arm": {
+			goos:   "linux",
+			goarch: "arm64",
+			wanted: "copilot-linux-arm64-v1.32.0",
+		},
+		"macOS on intel": {
+			goos:   "darwin",
+			goarch: "amd64",
+			wanted: "copilot-darwin-amd64-v1.32.0",
+		},
+		"windows": {
+			goos:   "windows",
+			goarch: "amd64",
+			wanted: "copilot-windows-v1.32.0.exe",
+		},
+		"error if there is no release for the platform": {
+			goos:        "windows",
+			goarch:      "arm64",
+			wantedError: errors.New("copilot isn't released for windows/arm64"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := copilotReleaseAsset("v1.32.0", tc.goos, tc.goarch)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestPinnedVersionRunner_run(t *testing.T) {
+	const pinned = "v1.32.0"
+	asset, err := copilotReleaseAsset(pinned, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		t.Skip(err.Error())
+	}
+	binary := []byte("copilot")
+	key := pinReleaseKey(t)
+	signature := signRelease(t, key, binary)
+	url := fmt.Sprintf("https://github.com/aws/copilot-cli/releases/download/%s/%s", pinned, asset)
+	installed := filepath.Join("/home/.copilot/versions", pinned, asset)
+
+	testCases := map[string]struct {
+		args       []string
+		current    string
+		pin        string
+		notInWs    bool
+		downloaded []byte
+		files      fakeURLGetter
+		setupMocks func(m *mocks.MockexecRunner)
+
+		wantedExitCode int
+		wantedRan      bool
+	}{
+		"run the current version outside a workspace": {
+			args:       []string{"svc", "deploy"},
+			current:    "v1.31.0",
+			notInWs:    true,
+			setupMocks: func(m *mocks.MockexecRunner) {},
+		},
+		"run the current version if the workspace doesn't pin a version": {
+			args:       []string{"svc", "deploy"},
+			current:    "v1.31.0",
+			setupMocks: func(m *mocks.MockexecRunner) {},
+		},
+		"run the current version if it's the pinned version": {
+			args:       []string{"svc", "deploy"},
+			current:    pinned,
+			pin:        pinned,
+			setupMocks: func(m *mocks.MockexecRunner) {},
+		},
+		"run the current version for the version commands": {
+			args:       []string{"version", "pin", "v1.33.0"},
+			current:    "v1.31.0",
+			pin:        pinned,
+			setupMocks: func(m *mocks.MockexecRunner) {},
+		},
+		"run the current version to upgrade it": {
+			args:       []string{"upgrade"},
+			current:    "v1.31.0",
+			pin:        pinned,
+			setupMocks: func(m *mocks.MockexecRunner) {},
+		},
+		"run the current version if the pinned version can't be downloaded": {
+			args:       []string{"svc", "deploy"},
+			current:    "v1.31.0",
+			pin:        pinned,
+			files:      fakeURLGetter{},
+			setupMocks: func(m *mocks.MockexecRunner) {},
+		},
+		"run the current version if the download doesn't match its signature": {
+			args:    []string{"svc", "deploy"},
+			current: "v1.31.0",
+			pin:     pinned,
+			files: fakeURLGetter{
+				url:          []byte("tampered"),
+				url + ".asc": signature,
+			},
+			setupMocks: func(m *mocks.MockexecRunner) {},
+		},
+		"run the current version if the download isn't signed by the release key": {
+			args:    []string{"svc", "deploy"},
+			current: "v1.31.0",
+			pin:     pinned,
+			files: fakeURLGetter{
+				url:          binary,
+				url + ".asc": signRelease(t, key, []byte("another binary")),
+			},
+			setupMocks: func(m *mocks.MockexecRunner) {},
+		},
+		"download and run the pinned version": {
+			args:    []string{"svc", "deploy"},
+			current: "v1.31.0",
+			pin:     pinned,
+			files: fakeURLGetter{
+				url:          binary,
+				url + ".asc": signature,
+			},
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run(installed, []string{"svc", "deploy"}, gomock.Any()).Return(nil)
+			},
+			wantedRan: true,
+		},
+		"run the pinned version that was already downloaded": {
+			args:       []string{"svc", "deploy"},
+			current:    "v1.31.0",
+			pin:        pinned,
+			downloaded: binary,
+			files:      fakeURLGetter{},
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run(installed, []string{"svc", "deploy"}, gomock.Any()).Return(nil)
+			},
+			wantedRan: true,
+		},
+		"download the pinned version again if it was modified after it was installed": {
+			args:       []string{"svc", "deploy"},
+			current:    "v1.31.0",
+			pin:        pinned,
+			downloaded: []byte("modified"),
+			files: fakeURLGetter{
+				url:          binary,
+				url + ".asc": signature,
+			},
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run(installed, []string{"svc", "deploy"}, gomock.Any()).Return(nil)
+			},
+			wantedRan: true,
+		},
+		"exit with an error if the pinned version can't run": {
+			args:       []string{"svc", "deploy"},
+			current:    "v1.31.0",
+			pin:        pinned,
+			downloaded: binary,
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run(installed, []string{"svc", "deploy"}, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedExitCode: 1,
+			wantedRan:      true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			t.Setenv(ignoreVersionPinEnvVar, "")
+			cmd := mocks.NewMockexecRunner(ctrl)
+			tc.setupMocks(cmd)
+			fs := afero.NewMemMapFs()
+			if tc.pin != "" {
+				require.NoError(t, afero.WriteFile(fs, filepath.Join("/ws", ".copilot-version"), []byte(tc.pin+"\n"), 0644))
+			}
+			if tc.downloaded != nil {
+				require.NoError(t, afero.WriteFile(fs, installed, tc.downloaded, 0755))
+				require.NoError(t, afero.WriteFile(fs, installed+".sha256", []byte(sha256Sum(binary)+"\n"), 0644))
+			}
+			r := &pinnedVersionRunner{
+				current: tc.current,
+				fs:      fs,
+				wsPath: func() (string, error) {
+					if tc.notInWs {
+						return "", errors.New("no workspace")
+					}
+					return "/ws", nil
+				},
+				dataDir: "/home/.copilot/versions",
+				http:    tc.files,
+				cmd:     cmd,
+			}
+
+			// WHEN
+			exitCode, ran := r.run(tc.args)
+
+			// THEN
+			require.Equal(t, tc.wantedExitCode, exitCode)
+			require.Equal(t, tc.wantedRan, ran)
+			if tc.wantedRan {
+				content, err := afero.ReadFile(fs, installed)
+				require.NoError(t, err)
+				require.Equal(t, binary, content)
+			}
+		})
+	}
+}
+
+func TestPinnedVersionRunner_run_ignorePin(t *testing.T) {
+	t.Setenv(ignoreVersionPinEnvVar, "true")
+	r := &pinnedVersionRunner{
+		current: "v1.31.0",
+		wsPath: func() (string, error) {
+			require.Fail(t, "the workspace shouldn't be looked up")
+			return "", nil
+		},
+	}
+
+	exitCode, ran := r.run([]string{"svc", "deploy"})
+
+	require.Equal(t, 0, exitCode)
+	require.False(t, ran)
+}
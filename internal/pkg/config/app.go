@@ -14,13 +14,30 @@ import (
 
 // Application is a named collection of environments and services.
 type Application struct {
-	Name                string            `json:"name"`                          // Name of an Application. Must be unique amongst other apps in the same account.
-	AccountID           string            `json:"account"`                       // AccountID this app is mastered in.
-	PermissionsBoundary string            `json:"permissionsBoundary,omitempty"` // Existing IAM permissions boundary.
-	Domain              string            `json:"domain"`                        // Existing domain name in Route53. An empty domain name means the user does not have one.
-	DomainHostedZoneID  string            `json:"domainHostedZoneID"`            // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
-	Version             string            `json:"version"`                       // The version of the app layout in the underlying datastore (e.g. SSM).
-	Tags                map[string]string `json:"tags,omitempty"`                // Labels to apply to resources created within the app.
+	Name                string             `json:"name"`                          // Name of an Application. Must be unique amongst other apps in the same account.
+	AccountID           string             `json:"account"`                       // AccountID this app is mastered in.
+	PermissionsBoundary string             `json:"permissionsBoundary,omitempty"` // Existing IAM permissions boundary.
+	Domain              string             `json:"domain"`                        // Existing domain name in Route53. An empty domain name means the user does not have one.
+	DomainHostedZoneID  string             `json:"domainHostedZoneID"`            // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
+	Version             string             `json:"version"`                       // The version of the app layout in the underlying datastore (e.g. SSM).
+	Tags                map[string]string  `json:"tags,omitempty"`                // Labels to apply to resources created within the app.
+	Conventions         *NamingConventions `json:"conventions,omitempty"`         // Naming rules for the resources created within the app.
+	AppRegistry         *AppRegistry       `json:"appRegistry,omitempty"`         // Registration of the app in AWS Service Catalog AppRegistry. Nil means the app isn't registered.
+	StackMetadata       map[string]string  `json:"stackMetadata,omitempty"`       // Keys added to the Metadata section of every stack, for CloudFormation Hooks or Guard rules.
+	RoleSession         *RoleSession       `json:"roleSession,omitempty"`         // Settings of the sessions of the roles that Copilot assumes. Nil means the sessions aren't tagged.
+	HelperImages        map[string]string  `json:"helperImages,omitempty"`        // Locations of the helper images that Copilot injects into the tasks of workloads. Map keys are image names.
+}
+
+// RoleSession holds the session tags and source identity of the sessions of the environment manager roles,
+// so that CloudTrail records who initiated an operation even through a shared role.
+type RoleSession struct {
+	Tags           map[string]string `json:"tags,omitempty"`           // Session tags passed when a role is assumed.
+	SourceIdentity bool              `json:"sourceIdentity,omitempty"` // Whether the source identity is set to the name of the caller.
+}
+
+// AppRegistry holds the attributes of an application registered in AWS Service Catalog AppRegistry.
+type AppRegistry struct {
+	Attributes map[string]string `json:"attributes,omitempty"` // Attributes of the application, like its owner, tier and repository.
 }
 
 // CreateApplication instantiates a new application, validates its uniqueness and stores it in SSM.
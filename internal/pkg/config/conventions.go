@@ -0,0 +1,100 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Resource kinds that naming conventions apply to.
+const (
+	ApplicationKind = "application"
+	EnvironmentKind = "environment"
+	ServiceKind     = "service"
+	JobKind         = "job"
+	AliasKind       = "alias"
+)
+
+// NamingConventions are the naming rules that the resources of an application must follow when they're created.
+type NamingConventions struct {
+	Applications *NamingRule `json:"applications,omitempty" yaml:"applications,omitempty"`
+	Environments *NamingRule `json:"environments,omitempty" yaml:"environments,omitempty"`
+	Services     *NamingRule `json:"services,omitempty" yaml:"services,omitempty"`
+	Jobs         *NamingRule `json:"jobs,omitempty" yaml:"jobs,omitempty"`
+	Aliases      *NamingRule `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+}
+
+// NamingRule is a regular expression that names must fully match, and a list of names that can't be used.
+type NamingRule struct {
+	Pattern  string   `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Reserved []string `json:"reserved,omitempty" yaml:"reserved,omitempty"`
+}
+
+// ParseNamingConventions unmarshals and validates naming conventions written in YAML.
+func ParseNamingConventions(in []byte) (*NamingConventions, error) {
+	var conventions NamingConventions
+	dec := yaml.NewDecoder(bytes.NewReader(in))
+	dec.KnownFields(true)
+	if err := dec.Decode(&conventions); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unmarshal naming conventions: %w", err)
+	}
+	for kind, rule := range conventions.rules() {
+		if rule == nil || rule.Pattern == "" {
+			continue
+		}
+		if _, err := rule.regexp(); err != nil {
+			return nil, fmt.Errorf("parse %s naming pattern %q: %w", kind, rule.Pattern, err)
+		}
+	}
+	return &conventions, nil
+}
+
+// Validate returns an error if the name of a resource of the given kind is reserved or doesn't match its pattern.
+func (c *NamingConventions) Validate(kind, name string) error {
+	if c == nil {
+		return nil
+	}
+	rule := c.rules()[kind]
+	if rule == nil {
+		return nil
+	}
+	for _, reserved := range rule.Reserved {
+		if strings.EqualFold(name, reserved) {
+			return fmt.Errorf("%s name %q is reserved", kind, name)
+		}
+	}
+	if rule.Pattern == "" {
+		return nil
+	}
+	re, err := rule.regexp()
+	if err != nil {
+		return fmt.Errorf("parse %s naming pattern %q: %w", kind, rule.Pattern, err)
+	}
+	if !re.MatchString(name) {
+		return fmt.Errorf("%s name %q doesn't match the naming convention %q", kind, name, rule.Pattern)
+	}
+	return nil
+}
+
+func (c *NamingConventions) rules() map[string]*NamingRule {
+	return map[string]*NamingRule{
+		ApplicationKind: c.Applications,
+		EnvironmentKind: c.Environments,
+		ServiceKind:     c.Services,
+		JobKind:         c.Jobs,
+		AliasKind:       c.Aliases,
+	}
+}
+
+// regexp compiles the pattern of the rule so that it must match the whole name.
+func (r *NamingRule) regexp() (*regexp.Regexp, error) {
+	return regexp.Compile(fmt.Sprintf("^(?:%s)$", r.Pattern))
+}
@@ -0,0 +1,129 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNamingConventions(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wanted    *NamingConventions
+		wantedErr string
+	}{
+		"empty file": {
+			wanted: &NamingConventions{},
+		},
+		"error on unknown fields": {
+			in:        "service:\n  pattern: svc-.*\n",
+			wantedErr: "unmarshal naming conventions: yaml: unmarshal errors:\n  line 1: field service not found in type config.NamingConventions",
+		},
+		"error on invalid patterns": {
+			in:        "environments:\n  pattern: \"(prod\"\n",
+			wantedErr: "parse environment naming pattern \"(prod\": error parsing regexp: missing closing ): `^(?:(prod)$`",
+		},
+		"success": {
+			in: `applications:
+  pattern: "team-[a-z]+"
+services:
+  pattern: "(api|web|worker)-[a-z0-9-]+"
+  reserved: [admin]
+aliases:
+  reserved: ["www.example.com"]
+`,
+			wanted: &NamingConventions{
+				Applications: &NamingRule{Pattern: "team-[a-z]+"},
+				Services: &NamingRule{
+					Pattern:  "(api|web|worker)-[a-z0-9-]+",
+					Reserved: []string{"admin"},
+				},
+				Aliases: &NamingRule{Reserved: []string{"www.example.com"}},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseNamingConventions([]byte(tc.in))
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestNamingConventions_Validate(t *testing.T) {
+	conventions := &NamingConventions{
+		Environments: &NamingRule{Pattern: "dev|test|prod-[a-z]+"},
+		Services:     &NamingRule{Reserved: []string{"admin", "internal"}},
+		Aliases: &NamingRule{
+			Pattern:  `[a-z0-9.-]+\.example\.com`,
+			Reserved: []string{"www.example.com"},
+		},
+	}
+	testCases := map[string]struct {
+		conventions *NamingConventions
+		kind        string
+		name        string
+
+		wantedErr string
+	}{
+		"no conventions": {
+			kind: ServiceKind,
+			name: "admin",
+		},
+		"no rule for the kind": {
+			conventions: conventions,
+			kind:        JobKind,
+			name:        "anything",
+		},
+		"reserved names are matched regardless of case": {
+			conventions: conventions,
+			kind:        ServiceKind,
+			name:        "Admin",
+			wantedErr:   `service name "Admin" is reserved`,
+		},
+		"patterns must match the whole name": {
+			conventions: conventions,
+			kind:        EnvironmentKind,
+			name:        "staging-dev",
+			wantedErr:   `environment name "staging-dev" doesn't match the naming convention "dev|test|prod-[a-z]+"`,
+		},
+		"name matches the pattern": {
+			conventions: conventions,
+			kind:        EnvironmentKind,
+			name:        "prod-iad",
+		},
+		"reserved alias": {
+			conventions: conventions,
+			kind:        AliasKind,
+			name:        "www.example.com",
+			wantedErr:   `alias name "www.example.com" is reserved`,
+		},
+		"alias doesn't match the pattern": {
+			conventions: conventions,
+			kind:        AliasKind,
+			name:        "api.example.org",
+			wantedErr:   `alias name "api.example.org" doesn't match the naming convention "[a-z0-9.-]+\\.example\\.com"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.conventions.Validate(tc.kind, tc.name)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
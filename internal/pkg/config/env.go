@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -23,6 +24,8 @@ type Environment struct {
 	ExecutionRoleARN string `json:"executionRoleARN"` // ARN used by CloudFormation to make modification to the environment stack.
 	ManagerRoleARN   string `json:"managerRoleARN"`   // ARN for the manager role assumed to manipulate the environment and its services.
 
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"` // Time after which a sandbox environment is deleted by "copilot sandbox down --expired", nil for other environments.
+
 	// Fields that store user configuration is no longer updated, but kept for retrofitting purpose.
 	CustomConfig *CustomizeEnv `json:"customConfig,omitempty"` // Deprecated. Custom environment configuration by users. This configuration is now available in the env manifest.
 	Telemetry    *Telemetry    `json:"telemetry,omitempty"`    // Deprecated. Optional environment telemetry features. This configuration is now available in the env manifest.
@@ -35,6 +38,8 @@ type CustomizeEnv struct {
 	ImportCertARNs              []string   `json:"importCertARNs,omitempty"`
 	InternalALBSubnets          []string   `json:"internalALBSubnets,omitempty"`
 	EnableInternalALBVPCIngress bool       `json:"enableInternalALBVPCIngress,omitempty"`
+	ImportCluster               string     `json:"importCluster,omitempty"`   // Name or ARN of an existing ECS cluster.
+	ImportPublicALB             string     `json:"importPublicALB,omitempty"` // Name or ARN of an existing public Application Load Balancer.
 }
 
 // IsEmpty returns true if CustomizeEnv is an empty struct.
@@ -42,7 +47,8 @@ func (c *CustomizeEnv) IsEmpty() bool {
 	if c == nil {
 		return true
 	}
-	return c.ImportVPC == nil && c.VPCConfig == nil && len(c.ImportCertARNs) == 0 && len(c.InternalALBSubnets) == 0 && !c.EnableInternalALBVPCIngress
+	return c.ImportVPC == nil && c.VPCConfig == nil && len(c.ImportCertARNs) == 0 && len(c.InternalALBSubnets) == 0 && !c.EnableInternalALBVPCIngress &&
+		c.ImportCluster == "" && c.ImportPublicALB == ""
 }
 
 // ImportVPC holds the fields to import VPC resources.
@@ -0,0 +1,217 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+const (
+	fmtLockParamPath      = "/copilot/applications/%s/locks/%s"              // path for a lock in an application
+	fmtLockClaimParamPath = "/copilot/applications/%s/locks/%s-claims/%d-%d" // path for a claim to take over a version of a lock
+)
+
+// Lock represents an application-level mutex that serializes operations across Copilot invocations.
+type Lock struct {
+	Name       string    `json:"name"`       // Name of the lock, must be unique within an App.
+	Owner      string    `json:"owner"`      // Identifier of the holder of the lock.
+	AcquiredAt time.Time `json:"acquiredAt"` // Time at which the lock was acquired.
+
+	version int64 // Version of the parameter that stores the lock.
+}
+
+// ErrLockHeld means that the lock is currently held by another owner.
+type ErrLockHeld struct {
+	App   string
+	Name  string
+	Owner string
+}
+
+func (e *ErrLockHeld) Error() string {
+	return fmt.Sprintf("lock %s in application %s is held by %s", e.Name, e.App, e.Owner)
+}
+
+// AcquireLock creates the lock named name in the application on behalf of owner.
+// If the lock is already held by owner, then it's a no-op. If the lock is held by another owner for longer than ttl,
+// then the lock is considered abandoned and taken over, unless another owner takes it over first. Otherwise, it returns ErrLockHeld.
+func (s *Store) AcquireLock(app, name, owner string, ttl time.Duration) error {
+	err := s.createLock(app, name, owner)
+	if err == nil {
+		return nil
+	}
+	if !isParameterAlreadyExists(err) {
+		return fmt.Errorf("acquire lock %s in application %s: %w", name, app, err)
+	}
+	cur, err := s.getLock(app, name)
+	if err != nil {
+		return err
+	}
+	if cur == nil {
+		// The lock was released in between our calls, try once more.
+		return s.acquireFreeLock(app, name, owner)
+	}
+	if cur.Owner == owner {
+		return nil
+	}
+	if time.Since(cur.AcquiredAt) < ttl {
+		return &ErrLockHeld{
+			App:   app,
+			Name:  name,
+			Owner: cur.Owner,
+		}
+	}
+	return s.takeOverLock(app, name, owner, cur)
+}
+
+// ReleaseLock deletes the lock named name in the application if it's held by owner.
+func (s *Store) ReleaseLock(app, name, owner string) error {
+	cur, err := s.getLock(app, name)
+	if err != nil {
+		return err
+	}
+	if cur == nil || cur.Owner != owner {
+		return nil
+	}
+	return s.deleteLock(app, name)
+}
+
+func (s *Store) acquireFreeLock(app, name, owner string) error {
+	err := s.createLock(app, name, owner)
+	if err == nil {
+		return nil
+	}
+	if !isParameterAlreadyExists(err) {
+		return fmt.Errorf("acquire lock %s in application %s: %w", name, app, err)
+	}
+	return s.errLockHeld(app, name)
+}
+
+// takeOverLock overwrites the abandoned version of the lock on behalf of owner.
+// SSM can't overwrite a parameter only if it's still at a given version, so the owners that try to take over
+// the same version of the lock first create a claim for it without overwriting, and only the one that creates it proceeds.
+func (s *Store) takeOverLock(app, name, owner string, abandoned *Lock) error {
+	claim := fmt.Sprintf(fmtLockClaimParamPath, app, name, abandoned.version, abandoned.AcquiredAt.UnixNano())
+	_, err := s.ssm.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(claim),
+		Description: aws.String(fmt.Sprintf("Claim to take over lock %s", name)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(owner),
+		Overwrite:   aws.Bool(false),
+	})
+	if err != nil {
+		if isParameterAlreadyExists(err) {
+			return s.errLockHeld(app, name)
+		}
+		return fmt.Errorf("claim lock %s in application %s: %w", name, app, err)
+	}
+	defer func() {
+		// Later claims for this version fail the version check below, so the claim isn't needed past the takeover.
+		_ = s.deleteParameter(claim)
+	}()
+	cur, err := s.getLock(app, name)
+	if err != nil {
+		return err
+	}
+	if cur == nil {
+		return s.acquireFreeLock(app, name, owner)
+	}
+	if cur.version != abandoned.version || !cur.AcquiredAt.Equal(abandoned.AcquiredAt) {
+		// The lock was released and acquired again since it was found abandoned.
+		return &ErrLockHeld{
+			App:   app,
+			Name:  name,
+			Owner: cur.Owner,
+		}
+	}
+	if err := s.putLock(app, name, owner, true); err != nil {
+		return fmt.Errorf("take over lock %s in application %s: %w", name, app, err)
+	}
+	return nil
+}
+
+func (s *Store) errLockHeld(app, name string) error {
+	cur, err := s.getLock(app, name)
+	if err != nil {
+		return err
+	}
+	held := &ErrLockHeld{
+		App:  app,
+		Name: name,
+	}
+	if cur != nil {
+		held.Owner = cur.Owner
+	}
+	return held
+}
+
+func (s *Store) createLock(app, name, owner string) error {
+	return s.putLock(app, name, owner, false)
+}
+
+func (s *Store) putLock(app, name, owner string, overwrite bool) error {
+	data, err := marshal(&Lock{
+		Name:       name,
+		Owner:      owner,
+		AcquiredAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("serialize lock %s: %w", name, err)
+	}
+	_, err = s.ssm.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(fmt.Sprintf(fmtLockParamPath, app, name)),
+		Description: aws.String(fmt.Sprintf("Lock %s held by a Copilot deployment", name)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(overwrite),
+	})
+	return err
+}
+
+// getLock returns nil if the lock does not exist.
+func (s *Store) getLock(app, name string) (*Lock, error) {
+	param, err := s.ssm.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(fmt.Sprintf(fmtLockParamPath, app, name)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ssm.ErrCodeParameterNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get lock %s in application %s: %w", name, app, err)
+	}
+	var lock Lock
+	if err := json.Unmarshal([]byte(aws.StringValue(param.Parameter.Value)), &lock); err != nil {
+		return nil, fmt.Errorf("read lock %s in application %s: %w", name, app, err)
+	}
+	lock.version = aws.Int64Value(param.Parameter.Version)
+	return &lock, nil
+}
+
+func (s *Store) deleteLock(app, name string) error {
+	if err := s.deleteParameter(fmt.Sprintf(fmtLockParamPath, app, name)); err != nil {
+		return fmt.Errorf("delete lock %s in application %s: %w", name, app, err)
+	}
+	return nil
+}
+
+// deleteParameter returns nil if the parameter doesn't exist.
+func (s *Store) deleteParameter(name string) error {
+	_, err := s.ssm.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ssm.ErrCodeParameterNotFound {
+		return nil
+	}
+	return err
+}
+
+func isParameterAlreadyExists(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == ssm.ErrCodeParameterAlreadyExists
+}
@@ -0,0 +1,243 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_AcquireLock(t *testing.T) {
+	lockPath := fmt.Sprintf(fmtLockParamPath, "phonetool", "test-load-balancer")
+	abandonedAt := time.Now().Add(-2 * time.Hour).UTC()
+	claimPath := fmt.Sprintf(fmtLockClaimParamPath, "phonetool", "test-load-balancer", 3, abandonedAt.UnixNano())
+	lockParam := func(owner string, acquiredAt time.Time, version int64) *ssm.GetParameterOutput {
+		data, _ := marshal(&Lock{Name: "test-load-balancer", Owner: owner, AcquiredAt: acquiredAt})
+		return &ssm.GetParameterOutput{
+			Parameter: &ssm.Parameter{
+				Name:    aws.String(lockPath),
+				Value:   aws.String(data),
+				Version: aws.Int64(version),
+			},
+		}
+	}
+	errAlreadyExists := awserr.New(ssm.ErrCodeParameterAlreadyExists, "Already exists", nil)
+	testCases := map[string]struct {
+		mockPutParameter    func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		mockGetParameter    func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+		mockDeleteParameter func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+
+		wantedErr error
+	}{
+		"acquires a free lock": {
+			mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, lockPath, aws.StringValue(in.Name))
+				require.False(t, aws.BoolValue(in.Overwrite))
+				require.Contains(t, aws.StringValue(in.Value), `"owner":"frontend"`)
+				return &ssm.PutParameterOutput{}, nil
+			},
+		},
+		"errors if failed to create the lock": {
+			mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("acquire lock test-load-balancer in application phonetool: some error"),
+		},
+		"no-op if the lock is already held by the owner": {
+			mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, errAlreadyExists
+			},
+			mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return lockParam("frontend", time.Now(), 1), nil
+			},
+		},
+		"errors if the lock is held by another owner": {
+			mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, errAlreadyExists
+			},
+			mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return lockParam("backend", time.Now(), 1), nil
+			},
+			wantedErr: &ErrLockHeld{App: "phonetool", Name: "test-load-balancer", Owner: "backend"},
+		},
+		"takes over an abandoned lock": {
+			mockPutParameter: func() func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				var calls int
+				return func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					calls++
+					switch calls {
+					case 1:
+						return nil, errAlreadyExists
+					case 2:
+						require.Equal(t, claimPath, aws.StringValue(in.Name))
+						require.False(t, aws.BoolValue(in.Overwrite))
+						return &ssm.PutParameterOutput{}, nil
+					default:
+						require.Equal(t, lockPath, aws.StringValue(in.Name))
+						require.True(t, aws.BoolValue(in.Overwrite))
+						require.Contains(t, aws.StringValue(in.Value), `"owner":"frontend"`)
+						return &ssm.PutParameterOutput{}, nil
+					}
+				}
+			}(),
+			mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return lockParam("backend", abandonedAt, 3), nil
+			},
+			mockDeleteParameter: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				require.Equal(t, claimPath, aws.StringValue(in.Name))
+				return &ssm.DeleteParameterOutput{}, nil
+			},
+		},
+		"errors if another owner claims the abandoned lock first": {
+			mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, errAlreadyExists
+			},
+			mockGetParameter: func() func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				var calls int
+				return func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					calls++
+					if calls == 1 {
+						return lockParam("backend", abandonedAt, 3), nil
+					}
+					return lockParam("worker", time.Now(), 4), nil
+				}
+			}(),
+			wantedErr: &ErrLockHeld{App: "phonetool", Name: "test-load-balancer", Owner: "worker"},
+		},
+		"errors if the abandoned lock is acquired again before it's taken over": {
+			mockPutParameter: func() func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				var calls int
+				return func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					calls++
+					if calls == 1 {
+						return nil, errAlreadyExists
+					}
+					require.Equal(t, claimPath, aws.StringValue(in.Name))
+					return &ssm.PutParameterOutput{}, nil
+				}
+			}(),
+			mockGetParameter: func() func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				var calls int
+				return func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					calls++
+					if calls == 1 {
+						return lockParam("backend", abandonedAt, 3), nil
+					}
+					return lockParam("worker", time.Now(), 1), nil
+				}
+			}(),
+			mockDeleteParameter: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				require.Equal(t, claimPath, aws.StringValue(in.Name))
+				return &ssm.DeleteParameterOutput{}, nil
+			},
+			wantedErr: &ErrLockHeld{App: "phonetool", Name: "test-load-balancer", Owner: "worker"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssm: &mockSSM{
+					t:                   t,
+					mockPutParameter:    tc.mockPutParameter,
+					mockGetParameter:    tc.mockGetParameter,
+					mockDeleteParameter: tc.mockDeleteParameter,
+				},
+			}
+
+			// WHEN
+			err := store.AcquireLock("phonetool", "test-load-balancer", "frontend", time.Hour)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStore_ReleaseLock(t *testing.T) {
+	lockParam := func(owner string) *ssm.GetParameterOutput {
+		data, _ := marshal(&Lock{Name: "test-load-balancer", Owner: owner, AcquiredAt: time.Now()})
+		return &ssm.GetParameterOutput{
+			Parameter: &ssm.Parameter{
+				Value: aws.String(data),
+			},
+		}
+	}
+	testCases := map[string]struct {
+		mockGetParameter    func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+		mockDeleteParameter func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+
+		wantedErr error
+	}{
+		"no-op if the lock does not exist": {
+			mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "Not found", nil)
+			},
+		},
+		"no-op if the lock is held by another owner": {
+			mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return lockParam("backend"), nil
+			},
+		},
+		"errors if failed to get the lock": {
+			mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("get lock test-load-balancer in application phonetool: some error"),
+		},
+		"errors if failed to delete the lock": {
+			mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return lockParam("frontend"), nil
+			},
+			mockDeleteParameter: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("delete lock test-load-balancer in application phonetool: some error"),
+		},
+		"releases the lock held by the owner": {
+			mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return lockParam("frontend"), nil
+			},
+			mockDeleteParameter: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(fmtLockParamPath, "phonetool", "test-load-balancer"), aws.StringValue(in.Name))
+				return &ssm.DeleteParameterOutput{}, nil
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssm: &mockSSM{
+					t:                   t,
+					mockGetParameter:    tc.mockGetParameter,
+					mockDeleteParameter: tc.mockDeleteParameter,
+				},
+			}
+
+			// WHEN
+			err := store.ReleaseLock("phonetool", "test-load-balancer", "frontend")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
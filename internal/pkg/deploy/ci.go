@@ -0,0 +1,25 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+// CIProviderGitHub is the CI provider for GitHub Actions.
+const CIProviderGitHub = "github"
+
+// CreateCIRoleInput holds the fields required to create the IAM role that an external CI system assumes to deploy workloads.
+type CreateCIRoleInput struct {
+	App             string          // Name of the application.
+	Provider        string          // CI provider that assumes the role, such as "github".
+	Repository      string          // Repository whose CI jobs can assume the role, such as "owner/repo".
+	Branch          string          // Branch whose CI jobs can assume the role. If empty, the jobs of any branch can assume it.
+	OIDCProviderARN string          // ARN of the existing IAM OIDC provider of the CI provider. If empty, the provider is created.
+	Workloads       []string        // Names of the workloads the role can deploy.
+	Environments    []CIEnvironment // Environments the role can deploy to.
+	AdditionalTags  map[string]string
+}
+
+// CIEnvironment is an environment that the CI role can deploy to.
+type CIEnvironment struct {
+	Name      string
+	AccountID string
+}
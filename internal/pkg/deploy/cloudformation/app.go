@@ -90,6 +90,71 @@ func (cf CloudFormation) UpgradeApplication(in *deploy.CreateAppInput) error {
 	return cf.upgradeAppStackSet(appConfig)
 }
 
+// AppStackSetInstances returns the stack instances of the application's stack set, which are all updated
+// when the application is upgraded.
+func (cf CloudFormation) AppStackSetInstances(appName string) ([]stackset.InstanceSummary, error) {
+	ssName := stack.NewAppStackConfig(&deploy.CreateAppInput{Name: appName}).StackSetName()
+	summaries, err := cf.appStackSet.InstanceSummaries(ssName)
+	if err != nil {
+		return nil, fmt.Errorf("get stack instances of stack set %s: %w", ssName, err)
+	}
+	return summaries, nil
+}
+
+// DetectAppStackSetDrift detects drift on the stack instances of the application's stack set and waits for the result,
+// so that the drift status returned by AppStackSetInstances is up to date.
+func (cf CloudFormation) DetectAppStackSetDrift(appName string) error {
+	ssName := stack.NewAppStackConfig(&deploy.CreateAppInput{Name: appName}).StackSetName()
+	if err := cf.appStackSet.WaitForStackSetLastOperationComplete(ssName); err != nil {
+		return fmt.Errorf("wait for stack set %s last operation complete: %w", ssName, err)
+	}
+	spinner := progress.NewSpinner(cf.console)
+	label := fmt.Sprintf("Detecting drift on the stack instances of stack set %s.", ssName)
+	spinner.Start(label)
+	err := cf.appStackSet.DetectDriftAndWait(ssName)
+	stopSpinner(spinner, err, label)
+	return err
+}
+
+// RetryFailedAppStackSetInstances re-deploys the current template of the application's stack set
+// only to the stack instances whose last update failed or was cancelled, and returns the retried instances.
+func (cf CloudFormation) RetryFailedAppStackSetInstances(appName string) ([]stackset.InstanceSummary, error) {
+	ssName := stack.NewAppStackConfig(&deploy.CreateAppInput{Name: appName}).StackSetName()
+	if err := cf.appStackSet.WaitForStackSetLastOperationComplete(ssName); err != nil {
+		return nil, fmt.Errorf("wait for stack set %s last operation complete: %w", ssName, err)
+	}
+	summaries, err := cf.AppStackSetInstances(appName)
+	if err != nil {
+		return nil, err
+	}
+	var failed []stackset.InstanceSummary
+	regionsByAccount := make(map[string][]string)
+	var accounts []string
+	for _, summary := range summaries {
+		// Inoperable instances can't be updated, they need to be deleted with their resources retained.
+		if !summary.Status.IsFailure() || summary.Status.IsInoperable() {
+			continue
+		}
+		failed = append(failed, summary)
+		if _, ok := regionsByAccount[summary.Account]; !ok {
+			accounts = append(accounts, summary.Account)
+		}
+		regionsByAccount[summary.Account] = append(regionsByAccount[summary.Account], summary.Region)
+	}
+	for _, account := range accounts {
+		regions := regionsByAccount[account]
+		spinner := progress.NewSpinner(cf.console)
+		label := fmt.Sprintf("Retrying stack instances of stack set %s in account %s and regions %v.", ssName, account, regions)
+		spinner.Start(label)
+		err := cf.appStackSet.UpdateInstancesAndWait(ssName, []string{account}, regions)
+		stopSpinner(spinner, err, label)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return failed, nil
+}
+
 func (cf CloudFormation) upgradeAppStackSet(config *stack.AppStackConfig) error {
 	for {
 		ssName := config.StackSetName()
@@ -171,6 +236,8 @@ func (cf CloudFormation) removeDNSDelegationAndCrossAccountAccess(appStack *stac
 		DomainHostedZoneID:    appStack.DomainHostedZoneID,
 		PermissionsBoundary:   appStack.PermissionsBoundary,
 		AdditionalTags:        appStack.AdditionalTags,
+		AppRegistry:           appStack.AppRegistry,
+		StackMetadata:         appStack.StackMetadata,
 		Version:               appStack.Version,
 	})
 	// Redeploy the infrastructure roles stack.
@@ -212,6 +279,8 @@ func (cf CloudFormation) DelegateDNSPermissions(app *config.Application, account
 		AccountID:          app.AccountID,
 		DomainName:         app.Domain,
 		DomainHostedZoneID: app.DomainHostedZoneID,
+		AppRegistry:        app.AppRegistry,
+		StackMetadata:      app.StackMetadata,
 		Version:            version.LatestTemplateVersion(),
 	}
 
@@ -329,6 +398,7 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 		Name:           app.Name,
 		AccountID:      app.AccountID,
 		AdditionalTags: app.Tags,
+		StackMetadata:  app.StackMetadata,
 		Version:        version.LatestTemplateVersion(),
 	})
 	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
@@ -421,6 +491,8 @@ func (cf CloudFormation) RemoveEnvFromApp(opts *RemoveEnvFromAppOpts) error {
 		AccountID:          opts.App.AccountID,
 		DomainName:         opts.App.Domain,
 		DomainHostedZoneID: opts.App.DomainHostedZoneID,
+		AppRegistry:        opts.App.AppRegistry,
+		StackMetadata:      opts.App.StackMetadata,
 		Version:            opts.App.Version,
 	})
 
@@ -470,6 +542,7 @@ func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName s
 		Name:           app.Name,
 		AccountID:      app.AccountID,
 		AdditionalTags: app.Tags,
+		StackMetadata:  app.StackMetadata,
 		Version:        app.Version,
 	})
 	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
@@ -522,6 +595,7 @@ func (cf CloudFormation) AddEnvToApp(opts *AddEnvToAppOpts) error {
 		Name:           opts.App.Name,
 		AccountID:      opts.App.AccountID,
 		AdditionalTags: opts.App.Tags,
+		StackMetadata:  opts.App.StackMetadata,
 		Version:        version.LatestTemplateVersion(),
 	})
 	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
@@ -577,9 +651,10 @@ var getRegionFromClient = func(client sdkcloudformationiface.CloudFormationAPI)
 func (cf CloudFormation) AddPipelineResourcesToApp(
 	app *config.Application, appRegion string) error {
 	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
-		Name:      app.Name,
-		AccountID: app.AccountID,
-		Version:   version.LatestTemplateVersion(),
+		Name:          app.Name,
+		AccountID:     app.AccountID,
+		StackMetadata: app.StackMetadata,
+		Version:       version.LatestTemplateVersion(),
 	})
 
 	resourcesConfig, err := cf.getLastDeployedAppConfig(appConfig)
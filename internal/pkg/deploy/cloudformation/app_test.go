@@ -1125,6 +1125,151 @@ func TestCloudFormation_DeleteApp(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DetectAppStackSetDrift(t *testing.T) {
+	tests := map[string]struct {
+		mockStackSet func(ctrl *gomock.Controller) stackSetClient
+
+		wantedErr error
+	}{
+		"errors if the last operation does not complete": {
+			mockStackSet: func(ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().WaitForStackSetLastOperationComplete("testApp-infrastructure").Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("wait for stack set testApp-infrastructure last operation complete: some error"),
+		},
+		"errors if the drift detection fails": {
+			mockStackSet: func(ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().WaitForStackSetLastOperationComplete("testApp-infrastructure").Return(nil)
+				m.EXPECT().DetectDriftAndWait("testApp-infrastructure").Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"detects drift once the last operation completes": {
+			mockStackSet: func(ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				gomock.InOrder(
+					m.EXPECT().WaitForStackSetLastOperationComplete("testApp-infrastructure").Return(nil),
+					m.EXPECT().DetectDriftAndWait("testApp-infrastructure").Return(nil),
+				)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cf := CloudFormation{
+				appStackSet: tc.mockStackSet(ctrl),
+				console:     new(discardFile),
+			}
+
+			// WHEN
+			err := cf.DetectAppStackSetDrift("testApp")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_RetryFailedAppStackSetInstances(t *testing.T) {
+	tests := map[string]struct {
+		mockStackSet func(ctrl *gomock.Controller) stackSetClient
+
+		wantedRetried []stackset.InstanceSummary
+		wantedErr     error
+	}{
+		"errors if the last operation does not complete": {
+			mockStackSet: func(ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().WaitForStackSetLastOperationComplete("testApp-infrastructure").Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("wait for stack set testApp-infrastructure last operation complete: some error"),
+		},
+		"errors if failed to list instances": {
+			mockStackSet: func(ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().WaitForStackSetLastOperationComplete("testApp-infrastructure").Return(nil)
+				m.EXPECT().InstanceSummaries("testApp-infrastructure").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("get stack instances of stack set testApp-infrastructure: some error"),
+		},
+		"retries only failed and cancelled instances grouped by account": {
+			mockStackSet: func(ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().WaitForStackSetLastOperationComplete("testApp-infrastructure").Return(nil)
+				m.EXPECT().InstanceSummaries("testApp-infrastructure").Return([]stackset.InstanceSummary{
+					{Account: "1111", Region: "us-west-2", Status: "SUCCEEDED"},
+					{Account: "1111", Region: "us-east-1", Status: "FAILED"},
+					{Account: "2222", Region: "eu-west-1", Status: "CANCELLED"},
+					{Account: "1111", Region: "eu-west-1", Status: "FAILED"},
+					{Account: "2222", Region: "us-east-1", Status: "INOPERABLE"},
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().UpdateInstancesAndWait("testApp-infrastructure", []string{"1111"}, []string{"us-east-1", "eu-west-1"}).Return(nil),
+					m.EXPECT().UpdateInstancesAndWait("testApp-infrastructure", []string{"2222"}, []string{"eu-west-1"}).Return(nil),
+				)
+				return m
+			},
+			wantedRetried: []stackset.InstanceSummary{
+				{Account: "1111", Region: "us-east-1", Status: "FAILED"},
+				{Account: "2222", Region: "eu-west-1", Status: "CANCELLED"},
+				{Account: "1111", Region: "eu-west-1", Status: "FAILED"},
+			},
+		},
+		"errors if an update fails": {
+			mockStackSet: func(ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				m.EXPECT().WaitForStackSetLastOperationComplete("testApp-infrastructure").Return(nil)
+				m.EXPECT().InstanceSummaries("testApp-infrastructure").Return([]stackset.InstanceSummary{
+					{Account: "1111", Region: "us-east-1", Status: "FAILED"},
+				}, nil)
+				m.EXPECT().UpdateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cf := CloudFormation{
+				appStackSet: tc.mockStackSet(ctrl),
+				console:     new(discardFile),
+			}
+
+			// WHEN
+			got, err := cf.RetryFailedAppStackSetInstances("testApp")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRetried, got)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_RenderStackSet(t *testing.T) {
 	testDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
 	testCases := map[string]struct {
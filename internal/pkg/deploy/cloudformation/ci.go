@@ -0,0 +1,41 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+// DeployCIRole deploys the stack of the role that an external CI system assumes to deploy workloads,
+// renders the deployment until it is done, and returns the ARN of the role.
+// If the stack doesn't have any changes, it returns the ARN of the existing role.
+func (cf CloudFormation) DeployCIRole(in *deploy.CreateCIRoleInput) (string, error) {
+	conf := stack.NewCIRoleStackConfig(in)
+	s, err := toStack(conf)
+	if err != nil {
+		return "", err
+	}
+	if err := cf.executeAndRenderChangeSet(cf.newUpsertChangeSetInput(cf.console, s)); err != nil {
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errChangeSetEmpty) {
+			return "", err
+		}
+	}
+	desc, err := cf.cfnClient.Describe(conf.StackName())
+	if err != nil {
+		return "", fmt.Errorf("describe stack %s: %w", conf.StackName(), err)
+	}
+	for _, out := range desc.Outputs {
+		if aws.StringValue(out.OutputKey) == stack.CIRoleOutputARN {
+			return aws.StringValue(out.OutputValue), nil
+		}
+	}
+	return "", fmt.Errorf("stack %s does not have the output %s", conf.StackName(), stack.CIRoleOutputARN)
+}
@@ -0,0 +1,88 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFormation_DeployCIRole(t *testing.T) {
+	in := &deploy.CreateCIRoleInput{
+		App:          "phonetool",
+		Provider:     deploy.CIProviderGitHub,
+		Repository:   "octocat/hello",
+		Workloads:    []string{"api"},
+		Environments: []deploy.CIEnvironment{{Name: "test", AccountID: "123456789012"}},
+	}
+	testCases := map[string]struct {
+		callMocks func(m *mocks.MockcfnClient)
+
+		wantedARN string
+		wantedErr error
+	}{
+		"returns the error if the stack can't be created": {
+			callMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Create(gomock.Any()).Return("", errors.New("some error"))
+				m.EXPECT().ErrorEvents("phonetool-ci-github").Return(nil, nil)
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns the ARN of the existing role if the stack has no changes": {
+			callMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Create(gomock.Any()).Return("", &cloudformation.ErrStackAlreadyExists{})
+				m.EXPECT().Update(gomock.Any()).Return("", &cloudformation.ErrChangeSetEmpty{})
+				m.EXPECT().ErrorEvents("phonetool-ci-github").Return(nil, nil)
+				m.EXPECT().Describe("phonetool-ci-github").Return(&cloudformation.StackDescription{
+					Outputs: []*sdkcloudformation.Output{
+						{
+							OutputKey:   aws.String("RoleARN"),
+							OutputValue: aws.String("arn:aws:iam::123456789012:role/phonetool-ci-github-Role"),
+						},
+					},
+				}, nil)
+			},
+			wantedARN: "arn:aws:iam::123456789012:role/phonetool-ci-github-Role",
+		},
+		"returns an error if the stack doesn't output the ARN of the role": {
+			callMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Create(gomock.Any()).Return("changeset", nil)
+				m.EXPECT().Describe("phonetool-ci-github").Return(&cloudformation.StackDescription{}, nil)
+			},
+			wantedErr: errors.New("stack phonetool-ci-github does not have the output RoleARN"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.callMocks(m)
+			cf := CloudFormation{
+				cfnClient: m,
+				console:   new(discardFile),
+			}
+
+			// WHEN
+			arn, err := cf.DeployCIRole(in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, arn)
+		})
+	}
+}
@@ -112,11 +112,13 @@ type cfnClient interface {
 	DeleteAndWaitWithRoleARN(stackName, roleARN string) error
 	Describe(stackName string) (*cloudformation.StackDescription, error)
 	DescribeChangeSet(changeSetID, stackName string) (*cloudformation.ChangeSetDescription, error)
+	ExecuteChangeSet(changeSetID string, disableRollback bool) (stackName string, err error)
 	TemplateBody(stackName string) (string, error)
 	TemplateBodyFromChangeSet(changeSetID, stackName string) (string, error)
 	Events(stackName string) ([]cloudformation.StackEvent, error)
 	ListStacksWithTags(tags map[string]string) ([]cloudformation.StackDescription, error)
 	ErrorEvents(stackName string) ([]cloudformation.StackEvent, error)
+	RootCause(stackName string) (*cloudformation.StackFailure, error)
 	Outputs(stack *cloudformation.Stack) (map[string]string, error)
 	StackResources(name string) ([]*cloudformation.StackResource, error)
 	Metadata(opts cloudformation.MetadataOpts) (string, error)
@@ -149,6 +151,8 @@ type stackSetClient interface {
 	CreateInstancesAndWait(name string, accounts, regions []string) error
 	Update(name, template string, opts ...stackset.CreateOrUpdateOption) (string, error)
 	UpdateAndWait(name, template string, opts ...stackset.CreateOrUpdateOption) error
+	UpdateInstancesAndWait(name string, accounts, regions []string) error
+	DetectDriftAndWait(name string) error
 	Describe(name string) (stackset.Description, error)
 	DescribeOperation(name, opID string) (stackset.Operation, error)
 	InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error)
@@ -247,6 +251,19 @@ func (cf CloudFormation) Template(stackName string) (string, error) {
 	return cf.cfnClient.TemplateBody(stackName)
 }
 
+// StackParameters returns the values of the parameters of a deployed stack by name.
+func (cf CloudFormation) StackParameters(stackName string) (map[string]string, error) {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string, len(descr.Parameters))
+	for _, param := range descr.Parameters {
+		params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	return params, nil
+}
+
 // IsEmptyErr returns true if the error occurred because the cloudformation resource does not exist or does not contain any sub-resources.
 func IsEmptyErr(err error) bool {
 	type isEmpty interface {
@@ -265,6 +282,10 @@ func (cf CloudFormation) errorEvents(stackName string) ([]string, error) {
 	}
 	var reasons []string
 	for _, event := range events {
+		if hookType := aws.StringValue(event.HookType); hookType != "" {
+			reasons = append(reasons, fmt.Sprintf("hook %s failed: %s", hookType, aws.StringValue(event.HookStatusReason)))
+			continue
+		}
 		// CFN error messages end with a '. (Service' and only the first sentence is useful, the rest is error codes.
 		reasons = append(reasons, strings.Split(aws.StringValue(event.ResourceStatusReason), ". (Service")[0])
 	}
@@ -277,6 +298,7 @@ type executeAndRenderChangeSetInput struct {
 	createChangeSet  func() (string, error)
 	enableInterrupt  bool
 	detach           bool
+	changeSetOnly    bool
 }
 
 type executeAndRenderChangeSetOption func(in *executeAndRenderChangeSetInput)
@@ -293,6 +315,12 @@ func withDetach(detach bool) executeAndRenderChangeSetOption {
 	}
 }
 
+func withChangeSetOnly(changeSetOnly bool) executeAndRenderChangeSetOption {
+	return func(in *executeAndRenderChangeSetInput) {
+		in.changeSetOnly = changeSetOnly
+	}
+}
+
 func (cf CloudFormation) newCreateChangeSetInput(w progress.FileWriter, stack *cloudformation.Stack) *executeAndRenderChangeSetInput {
 	in := &executeAndRenderChangeSetInput{
 		stackName:        stack.Name,
@@ -364,6 +392,10 @@ func (cf CloudFormation) executeAndRenderChangeSet(in *executeAndRenderChangeSet
 	if err != nil {
 		return err
 	}
+	if in.changeSetOnly {
+		log.Successf("Created change set %s for stack %s without executing it.\n", changeSetID, in.stackName)
+		return nil
+	}
 	if in.detach {
 		return nil
 	}
@@ -391,6 +423,23 @@ func (cf CloudFormation) executeAndRenderChangeSet(in *executeAndRenderChangeSet
 	return g.Wait()
 }
 
+// ExecuteChangeSet executes a change set that was created without being executed,
+// and renders the progress of its stack until the deployment is done.
+func (cf CloudFormation) ExecuteChangeSet(changeSetID string, disableRollback bool) error {
+	stackName, err := cf.cfnClient.ExecuteChangeSet(changeSetID, disableRollback)
+	if err != nil {
+		return err
+	}
+	return cf.executeAndRenderChangeSet(&executeAndRenderChangeSetInput{
+		stackName:        stackName,
+		stackDescription: fmt.Sprintf("Deploying the infrastructure for stack %s", stackName),
+		createChangeSet: func() (string, error) {
+			return changeSetID, nil
+		},
+		enableInterrupt: true,
+	})
+}
+
 func (cf CloudFormation) renderChangeSet(ctx context.Context, changeSetID string, in *executeAndRenderChangeSetInput) error {
 	if _, ok := cf.console.(*discardFile); ok { // If we don't have to render skip the additional network calls.
 		return nil
@@ -770,17 +819,45 @@ type errFailedService struct {
 	stackName    string
 	resourceType string
 	status       string
+	cause        *cloudformation.StackFailure // Nil if the resource that failed is unknown.
 }
 
 func (e *errFailedService) RecommendActions() string {
+	var actions []string
 	if e.resourceType == "AWS::AppRunner::Service" {
-		return fmt.Sprintf("You may fix the error by updating the service code or the manifest configuration.\n"+
-			"You can then retry deploying your service by running %s.", color.HighlightCode("copilot svc deploy"))
+		actions = append(actions, fmt.Sprintf("You may fix the error by updating the service code or the manifest configuration.\n"+
+			"You can then retry deploying your service by running %s.", color.HighlightCode("copilot svc deploy")))
 	}
-	return ""
+	if e.cause != nil && e.cause.RequestID != "" {
+		actions = append(actions, fmt.Sprintf("Look up the request ID %s in the event history of CloudTrail for the details of the call to %s that failed.",
+			color.HighlightUserInput(e.cause.RequestID), e.cause.Service))
+	}
+	return strings.Join(actions, "\n")
 }
+
 func (e *errFailedService) Error() string {
-	return fmt.Sprintf("stack %s did not complete successfully and exited with status %s", e.stackName, e.status)
+	msg := fmt.Sprintf("stack %s did not complete successfully and exited with status %s", e.stackName, e.status)
+	if e.cause == nil {
+		return msg
+	}
+	return fmt.Sprintf("%s: %s", msg, failureDescription(e.stackName, e.cause))
+}
+
+// failureDescription describes the failure of a resource of the stack, or of one of its nested stacks.
+func failureDescription(stackName string, failure *cloudformation.StackFailure) string {
+	resource := fmt.Sprintf("resource %s (%s)", failure.LogicalResourceID, failure.ResourceType)
+	if failure.StackName != stackName {
+		resource = fmt.Sprintf("%s of nested stack %s", resource, failure.StackName)
+	}
+	action := strings.ToLower(strings.TrimSuffix(failure.Status, "_FAILED"))
+	desc := fmt.Sprintf("%s failed to %s", resource, action)
+	if failure.Message != "" {
+		desc = fmt.Sprintf("%s: %s", desc, failure.Message)
+	}
+	if failure.ErrorCode != "" {
+		desc = fmt.Sprintf("%s (%s)", desc, failure.ErrorCode)
+	}
+	return desc
 }
 
 func (cf CloudFormation) errOnFailedStack(stackName string) error {
@@ -790,15 +867,17 @@ func (cf CloudFormation) errOnFailedStack(stackName string) error {
 	}
 	status := aws.StringValue(stack.StackStatus)
 	if cloudformation.StackStatus(status).IsFailure() {
-		events, _ := cf.cfnClient.ErrorEvents(stackName)
+		// The failure is still reported if its cause can't be found.
+		cause, _ := cf.cfnClient.RootCause(stackName)
 		var failedResourceType string
-		if len(events) > 0 {
-			failedResourceType = aws.StringValue(events[0].ResourceType)
+		if cause != nil {
+			failedResourceType = cause.ResourceType
 		}
 		return &errFailedService{
 			stackName:    stackName,
 			resourceType: failedResourceType,
 			status:       status,
+			cause:        cause,
 		}
 	}
 	return nil
@@ -267,16 +267,14 @@ func testDeployWorkload_StreamUntilStackCreationFails(t *testing.T, stackName st
 	m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
 		StackStatus: aws.String("CREATE_FAILED"),
 	}, nil)
-	m.EXPECT().ErrorEvents(stackName).Return(
-		[]cloudformation.StackEvent{
-			{
-				EventId:            aws.String("2"),
-				LogicalResourceId:  aws.String(stackName),
-				PhysicalResourceId: aws.String("AWS::AppRunner::Service"),
-				ResourceStatus:     aws.String("CREATE_FAILED"), // Send failure event for stack.
-				Timestamp:          aws.Time(time.Now()),
-			},
-		}, nil)
+	m.EXPECT().RootCause(stackName).Return(&cloudformation.StackFailure{
+		StackName:         stackName,
+		LogicalResourceID: "Service",
+		ResourceType:      "AWS::AppRunner::Service",
+		Status:            "CREATE_FAILED",
+		Message:           "Service has been marked as unhealthy",
+		ErrorCode:         "GeneralServiceException",
+	}, nil)
 	buf := new(strings.Builder)
 	client := CloudFormation{cfnClient: m, s3Client: mS3Client, console: mockFileWriter{Writer: buf},
 		notifySignals: func() chan os.Signal {
@@ -289,7 +287,7 @@ func testDeployWorkload_StreamUntilStackCreationFails(t *testing.T, stackName st
 	err := when(client)
 
 	// THEN
-	require.EqualError(t, err, fmt.Sprintf("stack %s did not complete successfully and exited with status CREATE_FAILED", stackName))
+	require.EqualError(t, err, fmt.Sprintf("stack %s did not complete successfully and exited with status CREATE_FAILED: resource Service (AWS::AppRunner::Service) failed to create: Service has been marked as unhealthy (GeneralServiceException)", stackName))
 }
 
 func testDeployWorkload_RenderNewlyCreatedStackWithECSService(t *testing.T, stackName string, when func(cf CloudFormation) error) {
@@ -724,16 +722,13 @@ func testDeployTask_StreamUntilStackCreationFails(t *testing.T, stackName string
 	m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
 		StackStatus: aws.String("CREATE_FAILED"),
 	}, nil)
-	m.EXPECT().ErrorEvents(stackName).Return(
-		[]cloudformation.StackEvent{
-			{
-				EventId:            aws.String("2"),
-				LogicalResourceId:  aws.String(stackName),
-				PhysicalResourceId: aws.String("AWS::AppRunner::Service"),
-				ResourceStatus:     aws.String("CREATE_FAILED"), // Send failure event for stack.
-				Timestamp:          aws.Time(time.Now()),
-			},
-		}, nil)
+	m.EXPECT().RootCause(stackName).Return(&cloudformation.StackFailure{
+		StackName:         stackName + "-AddonsStack-ABC",
+		LogicalResourceID: "Bucket",
+		ResourceType:      "AWS::S3::Bucket",
+		Status:            "CREATE_FAILED",
+		Message:           "bucket already exists",
+	}, nil)
 	buf := new(strings.Builder)
 	client := CloudFormation{cfnClient: m, console: mockFileWriter{Writer: buf}}
 
@@ -741,7 +736,7 @@ func testDeployTask_StreamUntilStackCreationFails(t *testing.T, stackName string
 	err := when(client)
 
 	// THEN
-	require.EqualError(t, err, fmt.Sprintf("stack %s did not complete successfully and exited with status CREATE_FAILED", stackName))
+	require.EqualError(t, err, fmt.Sprintf("stack %[1]s did not complete successfully and exited with status CREATE_FAILED: resource Bucket (AWS::S3::Bucket) of nested stack %[1]s-AddonsStack-ABC failed to create: bucket already exists", stackName))
 }
 
 func testDeployTask_RenderNewlyCreatedStackWithAddons(t *testing.T, stackName string, when func(cf CloudFormation) error) {
@@ -910,3 +905,123 @@ func TestCloudFormation_Template(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_StackParameters(t *testing.T) {
+	testCases := map[string]struct {
+		inClient     func(ctrl *gomock.Controller) *mocks.MockcfnClient
+		wantedParams map[string]string
+		wantedError  error
+	}{
+		"error describing the stack": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns the parameters of the stack": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{ParameterKey: aws.String("TaskCPU"), ParameterValue: aws.String("256")},
+						{ParameterKey: aws.String("TaskCount"), ParameterValue: aws.String("2")},
+					},
+				}, nil)
+				return m
+			},
+			wantedParams: map[string]string{
+				"TaskCPU":   "256",
+				"TaskCount": "2",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			got, gotErr := cf.StackParameters("phonetool-test-api")
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedParams, got)
+			}
+		})
+	}
+}
+
+func TestErrFailedService_RecommendActions(t *testing.T) {
+	testCases := map[string]struct {
+		in     *errFailedService
+		wanted string
+	}{
+		"no recommendation if the cause is unknown": {
+			in: &errFailedService{
+				stackName: "phonetool-test-api",
+				status:    "UPDATE_ROLLBACK_COMPLETE",
+			},
+		},
+		"recommends looking up the failed request in CloudTrail": {
+			in: &errFailedService{
+				stackName:    "phonetool-test-api",
+				resourceType: "AWS::ECS::Service",
+				status:       "UPDATE_ROLLBACK_COMPLETE",
+				cause: &cloudformation.StackFailure{
+					StackName: "phonetool-test-api",
+					Service:   "AmazonECS",
+					RequestID: "1a2b",
+				},
+			},
+			wanted: "Look up the request ID 1a2b in the event history of CloudTrail for the details of the call to AmazonECS that failed.",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.RecommendActions())
+		})
+	}
+}
+
+func TestCloudFormation_ExecuteChangeSet(t *testing.T) {
+	t.Run("returns the error if the change set can't be executed", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockcfnClient(ctrl)
+		m.EXPECT().ExecuteChangeSet("arn:aws:cloudformation:us-west-2:1111:changeSet/copilot-1/2", false).Return("", errors.New("some error"))
+		client := CloudFormation{cfnClient: m, console: new(discardFile)}
+
+		// WHEN
+		err := client.ExecuteChangeSet("arn:aws:cloudformation:us-west-2:1111:changeSet/copilot-1/2", false)
+
+		// THEN
+		require.EqualError(t, err, "some error")
+	})
+	t.Run("executes the change set", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockcfnClient(ctrl)
+		m.EXPECT().ExecuteChangeSet("arn:aws:cloudformation:us-west-2:1111:changeSet/copilot-1/2", true).Return("phonetool-test-api", nil)
+		client := CloudFormation{cfnClient: m, console: new(discardFile),
+			notifySignals: func() chan os.Signal {
+				return make(chan os.Signal, 1)
+			},
+		}
+
+		// WHEN
+		err := client.ExecuteChangeSet("arn:aws:cloudformation:us-west-2:1111:changeSet/copilot-1/2", true)
+
+		// THEN
+		require.NoError(t, err)
+	})
+}
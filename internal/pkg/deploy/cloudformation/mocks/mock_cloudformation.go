@@ -414,6 +414,21 @@ func (mr *MockcfnClientMockRecorder) Events(stackName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockcfnClient)(nil).Events), stackName)
 }
 
+// ExecuteChangeSet mocks base method.
+func (m *MockcfnClient) ExecuteChangeSet(changeSetID string, disableRollback bool) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", changeSetID, disableRollback)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet.
+func (mr *MockcfnClientMockRecorder) ExecuteChangeSet(changeSetID, disableRollback interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockcfnClient)(nil).ExecuteChangeSet), changeSetID, disableRollback)
+}
+
 // ListStacksWithTags mocks base method.
 func (m *MockcfnClient) ListStacksWithTags(tags map[string]string) ([]cloudformation0.StackDescription, error) {
 	m.ctrl.T.Helper()
@@ -459,6 +474,21 @@ func (mr *MockcfnClientMockRecorder) Outputs(stack interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockcfnClient)(nil).Outputs), stack)
 }
 
+// RootCause mocks base method.
+func (m *MockcfnClient) RootCause(stackName string) (*cloudformation0.StackFailure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RootCause", stackName)
+	ret0, _ := ret[0].(*cloudformation0.StackFailure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RootCause indicates an expected call of RootCause.
+func (mr *MockcfnClientMockRecorder) RootCause(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RootCause", reflect.TypeOf((*MockcfnClient)(nil).RootCause), stackName)
+}
+
 // StackResources mocks base method.
 func (m *MockcfnClient) StackResources(name string) ([]*cloudformation0.StackResource, error) {
 	m.ctrl.T.Helper()
@@ -869,6 +899,20 @@ func (mr *MockstackSetClientMockRecorder) DescribeOperation(name, opID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOperation", reflect.TypeOf((*MockstackSetClient)(nil).DescribeOperation), name, opID)
 }
 
+// DetectDriftAndWait mocks base method.
+func (m *MockstackSetClient) DetectDriftAndWait(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDriftAndWait", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetectDriftAndWait indicates an expected call of DetectDriftAndWait.
+func (mr *MockstackSetClientMockRecorder) DetectDriftAndWait(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDriftAndWait", reflect.TypeOf((*MockstackSetClient)(nil).DetectDriftAndWait), name)
+}
+
 // InstanceSummaries mocks base method.
 func (m *MockstackSetClient) InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error) {
 	m.ctrl.T.Helper()
@@ -928,6 +972,20 @@ func (mr *MockstackSetClientMockRecorder) UpdateAndWait(name, template interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAndWait", reflect.TypeOf((*MockstackSetClient)(nil).UpdateAndWait), varargs...)
 }
 
+// UpdateInstancesAndWait mocks base method.
+func (m *MockstackSetClient) UpdateInstancesAndWait(name string, accounts, regions []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstancesAndWait", name, accounts, regions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInstancesAndWait indicates an expected call of UpdateInstancesAndWait.
+func (mr *MockstackSetClientMockRecorder) UpdateInstancesAndWait(name, accounts, regions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstancesAndWait", reflect.TypeOf((*MockstackSetClient)(nil).UpdateInstancesAndWait), name, accounts, regions)
+}
+
 // WaitForOperation mocks base method.
 func (m *MockstackSetClient) WaitForOperation(name, opID string) error {
 	m.ctrl.T.Helper()
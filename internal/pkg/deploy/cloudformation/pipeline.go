@@ -79,6 +79,11 @@ func (cf CloudFormation) CreatePipeline(bucketName string, stackConfig StackConf
 	return nil
 }
 
+// PipelineResourceName returns the name of the CodePipeline pipeline created by the stack with the provided config.
+func (cf CloudFormation) PipelineResourceName(stackConfig StackConfiguration) (string, error) {
+	return cf.pipelinePhysicalResourceID(stackConfig.StackName())
+}
+
 func (cf CloudFormation) pipelinePhysicalResourceID(stackName string) (string, error) {
 	stackResources, err := cf.cfnClient.StackResources(stackName)
 	if err != nil {
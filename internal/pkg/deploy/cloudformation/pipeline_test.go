@@ -84,6 +84,61 @@ func TestCloudFormation_PipelineExists(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_PipelineResourceName(t *testing.T) {
+	testCases := map[string]struct {
+		createMock   func(ctrl *gomock.Controller) cfnClient
+		wantedName   string
+		wantedErrMsg string
+	}{
+		"return error if the stack resources can't be retrieved": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().StackResources("mockStackName").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErrMsg: "some error",
+		},
+		"return the physical ID of the pipeline": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().StackResources("mockStackName").Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String(cfnLogicalResourceIDPipeline),
+						ResourceType:       aws.String(cfnResourceTypePipeline),
+						PhysicalResourceId: aws.String("mockPipelineResourceID"),
+					},
+				}, nil)
+				return m
+			},
+			wantedName: "mockPipelineResourceID",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			stackConfig := mocks.NewMockStackConfiguration(ctrl)
+			stackConfig.EXPECT().StackName().Return("mockStackName")
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			got, err := c.PipelineResourceName(stackConfig)
+
+			// THEN
+			if tc.wantedErrMsg != "" {
+				require.EqualError(t, err, tc.wantedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, got)
+		})
+	}
+}
+
 func TestCloudFormation_CreatePipeline(t *testing.T) {
 	mockS3BucketName := "BitterBucket"
 	mockURL := "templateURL"
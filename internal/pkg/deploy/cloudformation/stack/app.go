@@ -6,6 +6,7 @@ package stack
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -129,19 +131,22 @@ func (c *AppStackConfig) Template() (string, error) {
 		Domain                  string
 		Name                    string
 		PermissionsBoundary     string
+		AppRegistry             *config.AppRegistry
 	}{
 		c.Version,
 		c.dnsDelegationAccounts(),
 		c.DomainName,
 		c.Name,
 		c.PermissionsBoundary,
+		c.AppRegistry,
 	}, template.WithFuncs(map[string]any{
-		"join": strings.Join,
+		"join":  strings.Join,
+		"quote": strconv.Quote,
 	}))
 	if err != nil {
 		return "", err
 	}
-	return content.String(), nil
+	return template.AddStackMetadata(content.String(), c.StackMetadata)
 }
 
 // ResourceTemplate generates a StackSet template with all the Application-wide resources (ECR Repos, KMS keys, S3 buckets)
@@ -164,7 +169,7 @@ func (c *AppStackConfig) ResourceTemplate(config *AppResourcesConfig) (string, e
 	if err != nil {
 		return "", err
 	}
-	return content.String(), err
+	return template.AddStackMetadata(content.String(), c.StackMetadata)
 }
 
 // Parameters returns a list of parameters which accompany the app CloudFormation template.
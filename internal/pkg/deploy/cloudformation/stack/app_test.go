@@ -7,10 +7,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
@@ -50,12 +52,14 @@ func TestAppTemplate(t *testing.T) {
 					Domain                  string
 					Name                    string
 					PermissionsBoundary     string
+					AppRegistry             *config.AppRegistry
 				}{
 					"v1.0.0",
 					[]string{"123456"},
 					"",
 					"demo",
 					"",
+					nil,
 				}, gomock.Any()).Return(&template.Content{
 					Buffer: bytes.NewBufferString("template"),
 				}, nil)
@@ -92,6 +96,87 @@ func TestAppTemplate(t *testing.T) {
 	}
 }
 
+func TestAppTemplate_AppRegistry(t *testing.T) {
+	testCases := map[string]struct {
+		inAppRegistry *config.AppRegistry
+
+		wantedResources []string
+	}{
+		"not registered": {},
+		"registered without attributes": {
+			inAppRegistry:   &config.AppRegistry{},
+			wantedResources: []string{"AppRegistryApplication", "AppRegistryStackAssociation"},
+		},
+		"registered with attributes": {
+			inAppRegistry: &config.AppRegistry{
+				Attributes: map[string]string{
+					"owner": "payments",
+					"tier":  "1",
+					"repo":  "github.com/acme/payments",
+				},
+			},
+			wantedResources: []string{"AppRegistryApplication", "AppRegistryAttributeGroup", "AppRegistryAttributeGroupAssociation", "AppRegistryStackAssociation"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			appStack := NewAppStackConfig(&deploy.CreateAppInput{
+				Name:        "demo",
+				AccountID:   "123456",
+				AppRegistry: tc.inAppRegistry,
+				Version:     "v1.0.0",
+			})
+
+			tpl, err := appStack.Template()
+			require.NoError(t, err)
+
+			var parsed struct {
+				Resources map[string]struct {
+					Type       string         `yaml:"Type"`
+					Properties map[string]any `yaml:"Properties"`
+				} `yaml:"Resources"`
+			}
+			require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed))
+			var got []string
+			for name, resource := range parsed.Resources {
+				if strings.HasPrefix(resource.Type, "AWS::ServiceCatalogAppRegistry::") {
+					got = append(got, name)
+				}
+			}
+			require.ElementsMatch(t, tc.wantedResources, got)
+			if tc.inAppRegistry != nil && tc.inAppRegistry.Attributes != nil {
+				require.Equal(t, map[string]any{
+					"owner": "payments",
+					"tier":  "1",
+					"repo":  "github.com/acme/payments",
+				}, parsed.Resources["AppRegistryAttributeGroup"].Properties["Attributes"])
+			}
+		})
+	}
+}
+
+func TestAppTemplate_StackMetadata(t *testing.T) {
+	appStack := NewAppStackConfig(&deploy.CreateAppInput{
+		Name:      "demo",
+		AccountID: "123456",
+		StackMetadata: map[string]string{
+			"CostCenter":      "1234",
+			"TemplateVersion": "v0.0.0",
+		},
+		Version: "v1.0.0",
+	})
+
+	tpl, err := appStack.Template()
+	require.NoError(t, err)
+
+	var parsed struct {
+		Metadata map[string]string `yaml:"Metadata"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed))
+	require.Equal(t, "1234", parsed.Metadata["CostCenter"])
+	require.Equal(t, "v1.0.0", parsed.Metadata["TemplateVersion"], "Copilot's own metadata should not be overridden")
+}
+
 func TestDNSDelegationAccounts(t *testing.T) {
 	testCases := map[string]struct {
 		given *deploy.CreateAppInput
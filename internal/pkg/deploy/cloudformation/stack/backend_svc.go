@@ -6,7 +6,6 @@ package stack
 import (
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -169,12 +168,14 @@ func (s *BackendService) Template() (string, error) {
 		DependsOn:               convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
 		DockerLabels:            s.manifest.ImageConfig.Image.DockerLabels,
 		ExecuteCommand:          convertExecuteCommand(&s.manifest.ExecuteCommand),
-		LogConfig:               convertLogging(s.manifest.Logging),
+		ScaleInProtection:       convertScaleInProtection(&s.manifest.TaskConfig),
+		LogConfig:               convertLogging(s.manifest.Logging, s.rc.helperImage(template.HelperImageFluentBit)),
 		NestedStack:             addonsOutputs,
 		Network:                 convertNetworkConfig(s.manifest.Network),
 		Publish:                 publishers,
 		PermissionsBoundary:     s.permBound,
 		Platform:                convertPlatform(s.manifest.Platform),
+		StopTimeout:             convertTime(s.manifest.ImageConfig.Image.StopTimeout),
 		Storage:                 convertStorageOpts(s.manifest.Name, s.manifest.Storage),
 
 		// ALB configs.
@@ -197,9 +198,8 @@ func (s *BackendService) Template() (string, error) {
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 
 		// Additional options for request driven web service templates.
-		Observability: template.ObservabilityOpts{
-			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
-		},
+		Observability: convertObservability(s.manifest.Observability, s.rc.helperImage(template.HelperImageOTelCollector)),
+		Tags:          s.manifest.Tags,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
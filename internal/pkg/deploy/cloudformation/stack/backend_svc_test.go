@@ -383,6 +383,7 @@ Outputs:
 			StringSlice: []string{"here"},
 		}
 		mft.ExecuteCommand = manifest.ExecuteCommand{Enable: aws.Bool(true)}
+		mft.ImageConfig.Image.StopTimeout = (*time.Duration)(aws.Int64(int64(50 * time.Second)))
 		mft.DeployConfig = manifest.DeploymentConfig{
 			DeploymentControllerConfig: manifest.DeploymentControllerConfig{
 				Rolling: aws.String("recreate"),
@@ -404,6 +405,7 @@ Outputs:
 				},
 				Stickiness:          aws.Bool(true),
 				DeregistrationDelay: (*time.Duration)(aws.Int64(int64(59 * time.Second))),
+				SlowStart:           (*time.Duration)(aws.Int64(int64(45 * time.Second))),
 				AllowedSourceIps:    []manifest.IPNet{"10.0.1.0/24"},
 				TargetContainer:     aws.String("envoy"),
 			},
@@ -550,6 +552,7 @@ Outputs:
 			EntryPoint:  []string{"enter", "from"},
 			Command:     []string{"here"},
 			GracePeriod: aws.Int64(60),
+			StopTimeout: aws.Int64(50),
 			ALBListener: &template.ALBListener{
 				Rules: []template.ALBListenerRule{
 					{
@@ -567,6 +570,7 @@ Outputs:
 							Timeout:            aws.Int64(62),
 						},
 						DeregistrationDelay: aws.Int64(int64(59)),
+						SlowStart:           aws.Int64(int64(45)),
 						AllowedSourceIps: []string{
 							"10.0.1.0/24",
 						},
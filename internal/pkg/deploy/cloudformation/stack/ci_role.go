@@ -0,0 +1,104 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+const (
+	ciRoleTemplatePath = "app/ci-role.yml"
+
+	// CIRoleOutputARN is the CFN stack output logical ID for the ARN of the CI role.
+	CIRoleOutputARN = "RoleARN"
+)
+
+// ciOIDCProviders are the hosts of the OIDC providers of the CI providers.
+var ciOIDCProviders = map[string]string{
+	deploy.CIProviderGitHub: "token.actions.githubusercontent.com",
+}
+
+// CIRoleStackConfig is for providing all the values to set up the stack of the role that an external CI system assumes.
+type CIRoleStackConfig struct {
+	*deploy.CreateCIRoleInput
+	parser template.ReadParser
+}
+
+// NewCIRoleStackConfig sets up a struct that provides the stack configuration of the CI role.
+func NewCIRoleStackConfig(in *deploy.CreateCIRoleInput) *CIRoleStackConfig {
+	return &CIRoleStackConfig{
+		CreateCIRoleInput: in,
+		parser:            template.New(),
+	}
+}
+
+// CIOIDCProviderHost returns the host of the OIDC provider that issues the tokens of the CI provider.
+func CIOIDCProviderHost(provider string) (string, bool) {
+	host, ok := ciOIDCProviders[provider]
+	return host, ok
+}
+
+// StackName returns the name of the CloudFormation stack of the CI role.
+func (c *CIRoleStackConfig) StackName() string {
+	return NameForCIRole(c.App, c.Provider)
+}
+
+// Template returns the CloudFormation template of the CI role.
+func (c *CIRoleStackConfig) Template() (string, error) {
+	host, ok := CIOIDCProviderHost(c.Provider)
+	if !ok {
+		return "", fmt.Errorf("unsupported CI provider %q", c.Provider)
+	}
+	subject := fmt.Sprintf("repo:%s:*", c.Repository)
+	if c.Branch != "" {
+		subject = fmt.Sprintf("repo:%s:ref:refs/heads/%s", c.Repository, c.Branch)
+	}
+	content, err := c.parser.Parse(ciRoleTemplatePath, struct {
+		App             string
+		Provider        string
+		Repository      string
+		OIDCProviderARN string
+		OIDCHost        string
+		Subject         string
+		Workloads       []string
+		Environments    []deploy.CIEnvironment
+	}{
+		App:             c.App,
+		Provider:        c.Provider,
+		Repository:      c.Repository,
+		OIDCProviderARN: c.OIDCProviderARN,
+		OIDCHost:        host,
+		Subject:         subject,
+		Workloads:       c.Workloads,
+		Environments:    c.Environments,
+	})
+	if err != nil {
+		return "", err
+	}
+	return content.String(), nil
+}
+
+// Parameters returns the parameter values to be passed to the CI role template.
+func (c *CIRoleStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
+	return nil, nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized
+// to a YAML document annotated with comments for readability to users.
+func (c *CIRoleStackConfig) SerializedParameters() (string, error) {
+	// No-op for now.
+	return "", nil
+}
+
+// Tags returns the tags that should be applied to the CI role CloudFormation stack.
+func (c *CIRoleStackConfig) Tags() []*cloudformation.Tag {
+	return mergeAndFlattenTags(c.AdditionalTags, map[string]string{
+		deploy.AppTagKey: c.App,
+	})
+}
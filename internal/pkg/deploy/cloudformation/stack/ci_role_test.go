@@ -0,0 +1,129 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestCIRoleStackConfig_Template(t *testing.T) {
+	type role struct {
+		Properties struct {
+			AssumeRolePolicyDocument struct {
+				Statement []struct {
+					Principal struct {
+						Federated yaml.Node `yaml:"Federated"`
+					} `yaml:"Principal"`
+					Condition map[string]map[string]string `yaml:"Condition"`
+				} `yaml:"Statement"`
+			} `yaml:"AssumeRolePolicyDocument"`
+			Policies []struct {
+				PolicyDocument struct {
+					Statement []struct {
+						Sid      string    `yaml:"Sid"`
+						Resource yaml.Node `yaml:"Resource"`
+					} `yaml:"Statement"`
+				} `yaml:"PolicyDocument"`
+			} `yaml:"Policies"`
+		} `yaml:"Properties"`
+	}
+	testCases := map[string]struct {
+		inBranch          string
+		inOIDCProviderARN string
+
+		wantedFederated    string
+		wantedSubject      string
+		wantedOIDCProvider bool
+	}{
+		"creates the OIDC provider and trusts every branch of the repository": {
+			wantedFederated:    "OIDCProvider",
+			wantedSubject:      "repo:octocat/hello:*",
+			wantedOIDCProvider: true,
+		},
+		"trusts the existing OIDC provider and a branch of the repository": {
+			inBranch:          "main",
+			inOIDCProviderARN: "arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com",
+
+			wantedFederated: "arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com",
+			wantedSubject:   "repo:octocat/hello:ref:refs/heads/main",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			conf := NewCIRoleStackConfig(&deploy.CreateCIRoleInput{
+				App:             "phonetool",
+				Provider:        deploy.CIProviderGitHub,
+				Repository:      "octocat/hello",
+				Branch:          tc.inBranch,
+				OIDCProviderARN: tc.inOIDCProviderARN,
+				Workloads:       []string{"api", "fe"},
+				Environments: []deploy.CIEnvironment{
+					{Name: "test", AccountID: "123456789012"},
+					{Name: "prod", AccountID: "210987654321"},
+				},
+			})
+
+			// WHEN
+			tpl, err := conf.Template()
+
+			// THEN
+			require.NoError(t, err)
+			var parsed struct {
+				Resources struct {
+					OIDCProvider *yaml.Node `yaml:"OIDCProvider"`
+					Role         role       `yaml:"Role"`
+				} `yaml:"Resources"`
+				Outputs map[string]interface{} `yaml:"Outputs"`
+			}
+			require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed))
+			require.Equal(t, tc.wantedOIDCProvider, parsed.Resources.OIDCProvider != nil)
+			trust := parsed.Resources.Role.Properties.AssumeRolePolicyDocument.Statement[0]
+			require.Equal(t, tc.wantedFederated, trust.Principal.Federated.Value)
+			require.Equal(t, map[string]map[string]string{
+				"StringEquals": {"token.actions.githubusercontent.com:aud": "sts.amazonaws.com"},
+				"StringLike":   {"token.actions.githubusercontent.com:sub": tc.wantedSubject},
+			}, trust.Condition)
+			resources := make(map[string][]string)
+			for _, statement := range parsed.Resources.Role.Properties.Policies[0].PolicyDocument.Statement {
+				var arns []string
+				if statement.Resource.Kind == yaml.SequenceNode {
+					for _, node := range statement.Resource.Content {
+						arns = append(arns, node.Value)
+					}
+				}
+				resources[statement.Sid] = arns
+			}
+			require.Equal(t, []string{
+				"arn:${AWS::Partition}:iam::123456789012:role/phonetool-test-EnvManagerRole",
+				"arn:${AWS::Partition}:iam::210987654321:role/phonetool-prod-EnvManagerRole",
+			}, resources["AssumeEnvironmentManagerRoles"])
+			require.Equal(t, []string{
+				"arn:${AWS::Partition}:ecr:*:${AWS::AccountId}:repository/phonetool/api",
+				"arn:${AWS::Partition}:ecr:*:${AWS::AccountId}:repository/phonetool/fe",
+			}, resources["PushWorkloadImages"])
+			require.Contains(t, parsed.Outputs, CIRoleOutputARN)
+		})
+	}
+}
+
+func TestCIRoleStackConfig_StackName(t *testing.T) {
+	conf := NewCIRoleStackConfig(&deploy.CreateCIRoleInput{
+		App:      "phonetool",
+		Provider: deploy.CIProviderGitHub,
+	})
+	require.Equal(t, "phonetool-ci-github", conf.StackName())
+	require.Equal(t, []*cloudformation.Tag{
+		{
+			Key:   aws.String(deploy.AppTagKey),
+			Value: aws.String("phonetool"),
+		},
+	}, conf.Tags())
+}
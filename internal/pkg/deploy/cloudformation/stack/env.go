@@ -6,6 +6,7 @@ package stack
 import (
 	"fmt"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
@@ -40,11 +41,13 @@ const (
 
 // Output keys.
 const (
-	EnvOutputVPCID               = "VpcId"
-	EnvOutputPublicSubnets       = "PublicSubnets"
-	EnvOutputPrivateSubnets      = "PrivateSubnets"
-	envOutputCFNExecutionRoleARN = "CFNExecutionRoleARN"
-	envOutputManagerRoleKey      = "EnvironmentManagerRoleARN"
+	EnvOutputVPCID                        = "VpcId"
+	EnvOutputPublicSubnets                = "PublicSubnets"
+	EnvOutputPrivateSubnets               = "PrivateSubnets"
+	EnvOutputPublicLoadBalancerFullName   = "PublicLoadBalancerFullName"
+	EnvOutputInternalLoadBalancerFullName = "InternalLoadBalancerFullName"
+	envOutputCFNExecutionRoleARN          = "CFNExecutionRoleARN"
+	envOutputManagerRoleKey               = "EnvironmentManagerRoleARN"
 )
 
 // Cloudformation stack tag keys.
@@ -111,6 +114,8 @@ type EnvConfig struct {
 	PublicALBSourceIPs  []string              // Optional configuration to specify public security group ingress based on customer given source IPs.
 	InternalLBSourceIPs []string              // Optional configuration to specify private security group ingress based on customer given source IPs.
 	Telemetry           *config.Telemetry     // Optional observability and monitoring configuration.
+	ImportedClusterName string                // Optional name of an existing ECS cluster to use instead of creating a new one.
+	ImportedPublicALB   *elbv2.LoadBalancer   // Optional existing public ALB to use instead of creating a new one.
 	Mft                 *manifest.Environment // Unmarshaled and interpolated manifest object.
 	RawMft              []byte                // Content of the environment manifest without any modifications.
 	ForceUpdate         bool
@@ -202,23 +207,27 @@ func (e *Env) Template() (string, error) {
 		forceUpdateID = id.String()
 	}
 	content, err := e.parser.ParseEnv(&template.EnvOpts{
-		AppName:              e.in.App.Name,
-		EnvName:              e.in.Name,
-		CustomResources:      crs,
-		Addons:               addons,
-		ArtifactBucketARN:    e.in.ArtifactBucketARN,
-		ArtifactBucketKeyARN: e.in.ArtifactBucketKeyARN,
-		PermissionsBoundary:  e.in.PermissionsBoundary,
-		PublicHTTPConfig:     e.publicHTTPConfig(),
-		VPCConfig:            vpcConfig,
-		PrivateHTTPConfig:    e.privateHTTPConfig(),
-		Telemetry:            e.telemetryConfig(),
-		CDNConfig:            e.cdnConfig(),
+		AppName:                e.in.App.Name,
+		EnvName:                e.in.Name,
+		CustomResources:        crs,
+		Addons:                 addons,
+		ArtifactBucketARN:      e.in.ArtifactBucketARN,
+		ArtifactBucketKeyARN:   e.in.ArtifactBucketKeyARN,
+		PermissionsBoundary:    e.in.PermissionsBoundary,
+		PublicHTTPConfig:       e.publicHTTPConfig(),
+		VPCConfig:              vpcConfig,
+		PrivateHTTPConfig:      e.privateHTTPConfig(),
+		Telemetry:              e.telemetryConfig(),
+		CDNConfig:              e.cdnConfig(),
+		CustomResourcesTimeout: e.customResourcesTimeout(),
+		ImportedClusterName:    e.in.ImportedClusterName,
+		ExecConfig:             convertClusterExecConfig(e.in.Mft),
 
 		LatestVersion:      e.in.Version,
 		SerializedManifest: string(e.in.RawMft),
 		ForceUpdateID:      forceUpdateID,
 		DelegateDNS:        e.in.App.Domain != "",
+		AppRegistry:        e.in.App.AppRegistry,
 	})
 	if err != nil {
 		return "", err
@@ -232,6 +241,10 @@ func (e *Env) Parameters() ([]*cloudformation.Parameter, error) {
 	if len(e.importPublicCertARNs()) != 0 || e.in.App.Domain != "" {
 		httpsListener = "true"
 	}
+	if e.in.ImportedPublicALB != nil {
+		// Workloads only add listener rules to the HTTP listener of an imported load balancer.
+		httpsListener = "false"
+	}
 	internalHTTPSListener := "false"
 	if len(e.importPrivateCertARNs()) != 0 {
 		internalHTTPSListener = "true"
@@ -505,10 +518,27 @@ func (e *Env) publicHTTPConfig() template.PublicHTTPConfig {
 		HTTPConfig: template.HTTPConfig{
 			ImportedCertARNs: e.importPublicCertARNs(),
 			SSLPolicy:        e.getPublicSSLPolicy(),
+			IdleTimeout:      convertTimeout(e.in.Mft.EnvironmentConfig.HTTPConfig.Public.IdleTimeout),
 		},
 		PublicALBSourceIPs: e.in.PublicALBSourceIPs,
 		CIDRPrefixListIDs:  e.in.CIDRPrefixListIDs,
 		ELBAccessLogs:      convertELBAccessLogsConfig(e.in.Mft),
+		ImportedALB:        e.importedPublicALB(),
+	}
+}
+
+func (e *Env) importedPublicALB() *template.ImportedALB {
+	lb := e.in.ImportedPublicALB
+	if lb == nil {
+		return nil
+	}
+	listener, _ := lb.Listener(80, elbv2.ProtocolHTTP)
+	return &template.ImportedALB{
+		DNSName:          lb.DNSName,
+		FullName:         lb.FullName,
+		HostedZoneID:     lb.HostedZoneID,
+		SecurityGroupIDs: lb.SecurityGroups,
+		HTTPListenerARN:  listener.ARN,
 	}
 }
 
@@ -517,6 +547,7 @@ func (e *Env) privateHTTPConfig() template.PrivateHTTPConfig {
 		HTTPConfig: template.HTTPConfig{
 			ImportedCertARNs: e.importPrivateCertARNs(),
 			SSLPolicy:        e.getPrivateSSLPolicy(),
+			IdleTimeout:      convertTimeout(e.in.Mft.EnvironmentConfig.HTTPConfig.Private.IdleTimeout),
 		},
 		CustomALBSubnets: e.internalALBSubnets(),
 	}
@@ -565,10 +596,12 @@ func (e *Env) managedVPC() template.ManagedVPC {
 	}
 	// If a manifest is present, it is the only place we look at.
 	if e.in.Mft != nil {
+		managed := defaultManagedVPC
 		if v := e.in.Mft.Network.VPC.ManagedVPC(); v != nil {
-			return *v
+			managed = *v
 		}
-		return defaultManagedVPC
+		managed.SingleNATGateway = e.in.Mft.SingleNATGateway()
+		return managed
 	}
 
 	// Fallthrough to SSM config.
@@ -583,6 +616,13 @@ func (e *Env) managedVPC() template.ManagedVPC {
 	}
 }
 
+func (e *Env) customResourcesTimeout() int {
+	if e.in.Mft == nil || e.in.Mft.CustomResources.Timeout == nil {
+		return 0
+	}
+	return int(e.in.Mft.CustomResources.Timeout.Seconds())
+}
+
 func (e *Env) telemetryConfig() *template.Telemetry {
 	// If a manifest is present, it is the only place we look at.
 	if e.in.Mft != nil {
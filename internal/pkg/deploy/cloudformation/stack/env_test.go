@@ -8,11 +8,13 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/template/templatetest"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
@@ -104,6 +106,30 @@ func TestEnv_Template(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, mockTemplate, got)
 	})
+	t.Run("should set the timeout of the custom resources when it is configured", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// GIVEN
+		inEnvConfig := mockDeployEnvironmentInput()
+		timeout := 5 * time.Minute
+		inEnvConfig.Mft.CustomResources.Timeout = &timeout
+		mockParser := mocks.NewMockembedFS(ctrl)
+		mockParser.EXPECT().Read(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("data")}, nil).AnyTimes()
+		mockParser.EXPECT().ParseEnv(gomock.Any()).DoAndReturn(func(data *template.EnvOpts) (*template.Content, error) {
+			require.Equal(t, 300, data.CustomResourcesTimeout)
+			return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+		})
+		fs = mockParser
+
+		// WHEN
+		envStack, err := NewEnvConfigFromExistingStack(inEnvConfig, "mockPreviousForceUpdateID", nil)
+		require.NoError(t, err)
+		_, err = envStack.Template()
+
+		// THEN
+		require.NoError(t, err)
+	})
 	t.Run("should use new force update ID when asked", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -184,6 +210,88 @@ func TestEnv_Template(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, mockTemplate, got)
 	})
+	t.Run("should use the imported cluster and public load balancer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// GIVEN
+		inEnvConfig := mockDeployEnvironmentInput()
+		inEnvConfig.ImportedClusterName = "shared"
+		inEnvConfig.ImportedPublicALB = &elbv2.LoadBalancer{
+			ARN:            "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188",
+			FullName:       "app/shared/50dc6c495c0c9188",
+			DNSName:        "shared-1234.us-west-2.elb.amazonaws.com",
+			HostedZoneID:   "Z1H1FL5HABSF5",
+			SecurityGroups: []string{"sg-1"},
+			Listeners: []elbv2.Listener{
+				{
+					ARN:      "listener-443",
+					Port:     443,
+					Protocol: "HTTPS",
+				},
+				{
+					ARN:      "listener-80",
+					Port:     80,
+					Protocol: "HTTP",
+				},
+			},
+		}
+		mockParser := mocks.NewMockembedFS(ctrl)
+		mockParser.EXPECT().Read(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("data")}, nil).AnyTimes()
+		mockParser.EXPECT().ParseEnv(gomock.Any()).DoAndReturn(func(data *template.EnvOpts) (*template.Content, error) {
+			require.Equal(t, "shared", data.ImportedClusterName)
+			require.Equal(t, &template.ImportedALB{
+				DNSName:          "shared-1234.us-west-2.elb.amazonaws.com",
+				FullName:         "app/shared/50dc6c495c0c9188",
+				HostedZoneID:     "Z1H1FL5HABSF5",
+				SecurityGroupIDs: []string{"sg-1"},
+				HTTPListenerARN:  "listener-80",
+			}, data.PublicHTTPConfig.ImportedALB)
+			return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+		})
+		fs = mockParser
+
+		// WHEN
+		envStack, err := NewEnvConfigFromExistingStack(inEnvConfig, "mockPreviousForceUpdateID", nil)
+		require.NoError(t, err)
+		got, err := envStack.Template()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, mockTemplate, got)
+	})
+	t.Run("should encrypt and log the exec sessions of the cluster", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// GIVEN
+		inEnvConfig := mockDeployEnvironmentInput()
+		inEnvConfig.Mft.Cluster.Exec = manifest.ClusterExecConfig{
+			Encryption: aws.Bool(true),
+			Logs: manifest.ClusterExecLogs{
+				Retention: aws.Int(30),
+			},
+		}
+		mockParser := mocks.NewMockembedFS(ctrl)
+		mockParser.EXPECT().Read(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("data")}, nil).AnyTimes()
+		mockParser.EXPECT().ParseEnv(gomock.Any()).DoAndReturn(func(data *template.EnvOpts) (*template.Content, error) {
+			require.Equal(t, &template.ExecConfig{
+				Encryption:   true,
+				LogRetention: aws.Int(30),
+			}, data.ExecConfig)
+			return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+		})
+		fs = mockParser
+
+		// WHEN
+		envStack, err := NewEnvConfigFromExistingStack(inEnvConfig, "mockPreviousForceUpdateID", nil)
+		require.NoError(t, err)
+		got, err := envStack.Template()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, mockTemplate, got)
+	})
 	t.Run("should return template body with local custom resources when not uploaded", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -231,6 +339,35 @@ func TestEnv_Template(t *testing.T) {
 	})
 }
 
+func TestEnv_Parameters_ImportedPublicALB(t *testing.T) {
+	t.Cleanup(func() {
+		fs = realEmbedFS
+	})
+	fs = templatetest.Stub{}
+
+	// GIVEN
+	in := mockDeployEnvironmentInput()
+	in.App.Domain = "ecs.aws"
+	in.ImportedPublicALB = &elbv2.LoadBalancer{
+		ARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188",
+	}
+	env, err := NewEnvStackConfig(in)
+	require.NoError(t, err)
+
+	// WHEN
+	params, err := env.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	for _, param := range params {
+		if aws.StringValue(param.ParameterKey) == envParamCreateHTTPSListenerKey {
+			require.Equal(t, "false", aws.StringValue(param.ParameterValue))
+			return
+		}
+	}
+	require.Fail(t, "missing parameter", envParamCreateHTTPSListenerKey)
+}
+
 func TestEnv_Parameters(t *testing.T) {
 	t.Cleanup(func() {
 		fs = realEmbedFS
@@ -6,7 +6,6 @@ package stack
 import (
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -15,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/openapi"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/override"
 )
@@ -24,6 +24,15 @@ const (
 	LBWebServiceDNSDelegatedParamKey = "DNSDelegated"
 	LBWebServiceNLBAliasesParamKey   = "NLBAliases"
 	LBWebServiceNLBPortParamKey      = "NLBPort"
+
+	LBWebServiceDeployedTaskDefinitionParamKey = "DeployedTaskDefinition"
+	LBWebServiceActiveTargetGroupParamKey      = "ActiveTargetGroup"
+)
+
+// Target groups between which CodeDeploy shifts the traffic of a load balanced web service.
+const (
+	BlueTargetGroup  = "blue"  // The target group that the service is created with.
+	GreenTargetGroup = "green" // The target group of the replacement tasks.
 )
 
 // LoadBalancedWebService represents the configuration needed to create a CloudFormation stack from a load balanced web service manifest.
@@ -34,6 +43,9 @@ type LoadBalancedWebService struct {
 	dnsDelegationEnabled   bool
 	publicSubnetCIDRBlocks []string
 	appInfo                deploy.AppInformation
+	deployedTaskDefinition string
+	activeTargetGroup      string
+	openAPISpec            *openapi.Spec
 
 	parser loadBalancedWebSvcReadParser
 }
@@ -48,6 +60,30 @@ func WithNLB(cidrBlocks []string) func(s *LoadBalancedWebService) {
 	}
 }
 
+// WithDeployedTaskDefinition keeps the ECS service of a LoadBalancedWebService deployed with CodeDeploy
+// on the task definition of the last CodeDeploy deployment, as CloudFormation can't update it.
+func WithDeployedTaskDefinition(arn string) func(s *LoadBalancedWebService) {
+	return func(s *LoadBalancedWebService) {
+		s.deployedTaskDefinition = arn
+	}
+}
+
+// WithActiveTargetGroup keeps the listener rule of a LoadBalancedWebService deployed with CodeDeploy
+// forwarding to the target group that CodeDeploy shifted the traffic to, either BlueTargetGroup or GreenTargetGroup.
+func WithActiveTargetGroup(targetGroup string) func(s *LoadBalancedWebService) {
+	return func(s *LoadBalancedWebService) {
+		s.activeTargetGroup = targetGroup
+	}
+}
+
+// WithOpenAPISpec derives the listener rules and the health check path of the main routing rule
+// of a LoadBalancedWebService from the paths of an OpenAPI spec.
+func WithOpenAPISpec(spec *openapi.Spec) func(s *LoadBalancedWebService) {
+	return func(s *LoadBalancedWebService) {
+		s.openAPISpec = spec
+	}
+}
+
 // LoadBalancedWebServiceConfig contains fields to configure LoadBalancedWebService.
 type LoadBalancedWebServiceConfig struct {
 	App                *config.Application
@@ -85,7 +121,7 @@ func NewLoadBalancedWebService(conf LoadBalancedWebServiceConfig,
 		httpsEnabled = true
 		dnsDelegationEnabled = false
 	}
-	if conf.Manifest.HTTPOrBool.Disabled() {
+	if conf.Manifest.HTTPOrBool.Disabled() || conf.EnvManifest.HTTPConfig.Public.ImportedLoadBalancer() != "" {
 		httpsEnabled = false
 	}
 	s := &LoadBalancedWebService{
@@ -179,6 +215,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	trafficMirror := convertTrafficMirror(s.manifest.HTTPOrBool.Mirror, albListenerConfig, s.rc.ServiceDiscoveryEndpoint, s.rc.helperImage(template.HelperImageEnvoy))
 	var scConfig *template.ServiceConnect
 	if s.manifest.Network.Connect.Enabled() {
 		scConfig = convertServiceConnect(s.manifest.Network.Connect)
@@ -190,7 +227,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	}
 
 	// Set container-level feature flag.
-	logConfig := convertLogging(s.manifest.Logging)
+	logConfig := convertLogging(s.manifest.Logging, s.rc.helperImage(template.HelperImageFluentBit))
 	content, err := s.parser.ParseLoadBalancedWebService(template.WorkloadOpts{
 		// Workload parameters.
 		AppName:            s.app,
@@ -216,15 +253,18 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		CredentialsParameter:    aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
 		DesiredCountOnSpot:      desiredCountOnSpot,
 		DeploymentConfiguration: convertDeploymentConfig(s.manifest.DeployConfig),
+		DeploymentStrategy:      convertDeploymentStrategy(s.manifest.DeployConfig.Strategy),
 		DependsOn:               convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
 		DockerLabels:            s.manifest.ImageConfig.Image.DockerLabels,
 		ExecuteCommand:          convertExecuteCommand(&s.manifest.ExecuteCommand),
+		ScaleInProtection:       convertScaleInProtection(&s.manifest.TaskConfig),
 		LogConfig:               logConfig,
 		NestedStack:             addonsOutputs,
 		Network:                 convertNetworkConfig(s.manifest.Network),
 		Publish:                 publishers,
 		PermissionsBoundary:     s.permBound,
 		Platform:                convertPlatform(s.manifest.Platform),
+		StopTimeout:             convertTime(s.manifest.ImageConfig.Image.StopTimeout),
 		Storage:                 convertStorageOpts(s.manifest.Name, s.manifest.Storage),
 
 		// ALB configs.
@@ -233,8 +273,9 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 			Port: targetContainerPort,
 			Name: targetContainer,
 		},
-		GracePeriod: s.convertGracePeriod(),
-		ALBListener: albListenerConfig,
+		GracePeriod:   s.convertGracePeriod(),
+		ALBListener:   albListenerConfig,
+		TrafficMirror: trafficMirror,
 
 		// NLB configs.
 		AppDNSName:           nlbConfig.appDNSName,
@@ -246,9 +287,8 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 
 		// Additional options for request driven web service templates.
-		Observability: template.ObservabilityOpts{
-			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
-		},
+		Observability: convertObservability(s.manifest.Observability, s.rc.helperImage(template.HelperImageOTelCollector)),
+		Tags:          s.manifest.Tags,
 
 		// Sidecar configs.
 		Sidecars: sidecars,
@@ -327,6 +367,22 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 			},
 		}...)
 	}
+	if !s.manifest.DeployConfig.Strategy.IsEmpty() {
+		activeTargetGroup := BlueTargetGroup
+		if s.activeTargetGroup != "" {
+			activeTargetGroup = s.activeTargetGroup
+		}
+		wkldParams = append(wkldParams, []*cloudformation.Parameter{
+			{
+				ParameterKey:   aws.String(LBWebServiceDeployedTaskDefinitionParamKey),
+				ParameterValue: aws.String(s.deployedTaskDefinition),
+			},
+			{
+				ParameterKey:   aws.String(LBWebServiceActiveTargetGroupParamKey),
+				ParameterValue: aws.String(activeTargetGroup),
+			},
+		}...)
+	}
 	return wkldParams, nil
 }
 
@@ -543,9 +543,11 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 		},
 	}
 	testCases := map[string]struct {
-		httpsEnabled         bool
-		dnsDelegationEnabled bool
-		setupManifest        func(*manifest.LoadBalancedWebService)
+		httpsEnabled           bool
+		dnsDelegationEnabled   bool
+		deployedTaskDefinition string
+		activeTargetGroup      string
+		setupManifest          func(*manifest.LoadBalancedWebService)
 
 		expectedParams []*cloudformation.Parameter
 		expectedErr    error
@@ -860,6 +862,92 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 				},
 			}...),
 		},
+		"with a canary deployment": {
+			httpsEnabled: true,
+			setupManifest: func(service *manifest.LoadBalancedWebService) {
+				service.DeployConfig.Strategy = manifest.DeploymentStrategy{
+					Type: aws.String(manifest.CanaryDeploymentStrategy),
+				}
+			},
+			deployedTaskDefinition: "arn:aws:ecs:us-west-2:111111111111:task-definition/phonetool-test-frontend:3",
+			activeTargetGroup:      GreenTargetGroup,
+			expectedParams: append(expectedParams, []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(WorkloadHTTPSParamKey),
+					ParameterValue: aws.String("true"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadTargetPortParamKey),
+					ParameterValue: aws.String("80"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadTaskCountParamKey),
+					ParameterValue: aws.String("1"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadRulePathParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDNSDelegatedParamKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDeployedTaskDefinitionParamKey),
+					ParameterValue: aws.String("arn:aws:ecs:us-west-2:111111111111:task-definition/phonetool-test-frontend:3"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceActiveTargetGroupParamKey),
+					ParameterValue: aws.String("green"),
+				},
+			}...),
+		},
+		"with a blue/green deployment of a new service": {
+			httpsEnabled: true,
+			setupManifest: func(service *manifest.LoadBalancedWebService) {
+				service.DeployConfig.Strategy = manifest.DeploymentStrategy{
+					Type: aws.String(manifest.BlueGreenDeploymentStrategy),
+				}
+			},
+			expectedParams: append(expectedParams, []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(WorkloadHTTPSParamKey),
+					ParameterValue: aws.String("true"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadTargetPortParamKey),
+					ParameterValue: aws.String("80"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadTaskCountParamKey),
+					ParameterValue: aws.String("1"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadRulePathParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDNSDelegatedParamKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDeployedTaskDefinitionParamKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceActiveTargetGroupParamKey),
+					ParameterValue: aws.String("blue"),
+				},
+			}...),
+		},
 		"with bad count": {
 			httpsEnabled: true,
 			setupManifest: func(service *manifest.LoadBalancedWebService) {
@@ -898,9 +986,11 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 					},
 					tc: testManifest.TaskConfig,
 				},
-				manifest:             testManifest,
-				httpsEnabled:         tc.httpsEnabled,
-				dnsDelegationEnabled: tc.dnsDelegationEnabled,
+				manifest:               testManifest,
+				httpsEnabled:           tc.httpsEnabled,
+				dnsDelegationEnabled:   tc.dnsDelegationEnabled,
+				deployedTaskDefinition: tc.deployedTaskDefinition,
+				activeTargetGroup:      tc.activeTargetGroup,
 			}
 
 			// WHEN
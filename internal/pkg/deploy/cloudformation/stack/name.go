@@ -56,6 +56,11 @@ func NameForAppStackSet(app string) string {
 	return fmt.Sprintf("%s-infrastructure", app)
 }
 
+// NameForCIRole returns the stack name for the role that the CI provider assumes to deploy the workloads of an app.
+func NameForCIRole(app, provider string) string {
+	return fmt.Sprintf("%s-ci-%s", app, provider)
+}
+
 // NameForPipeline returns the stack name for a pipeline, depending on whether it has been deployed using the legacy scheme.
 // Note that it doesn't cut name to length of 128 like service stack name. It expects CloudFormation to error out
 // when the name is to long.
@@ -83,6 +83,7 @@ func NewRequestDrivenWebService(cfg RequestDrivenWebServiceConfig) (*RequestDriv
 			},
 			instanceConfig:    cfg.Manifest.InstanceConfig,
 			imageConfig:       cfg.Manifest.ImageConfig,
+			sourceConfig:      cfg.Manifest.Source,
 			healthCheckConfig: cfg.Manifest.HealthCheckConfiguration,
 		},
 		app:      cfg.App,
@@ -115,6 +116,10 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
+	codeRepository, err := s.codeRepository()
+	if err != nil {
+		return "", err
+	}
 	content, err := s.parser.ParseRequestDrivenWebService(template.WorkloadOpts{
 		AppName:            s.wkld.app,
 		EnvName:            s.env,
@@ -147,6 +152,9 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 		Private:              aws.BoolValue(s.manifest.Private.Basic) || s.manifest.Private.Advanced.Endpoint != nil,
 		AppRunnerVPCEndpoint: s.manifest.Private.Advanced.Endpoint,
 		Count:                s.manifest.Count,
+		AutoScaling:          convertAppRunnerScaling(s.manifest.Scaling),
+		CodeRepository:       codeRepository,
+		BackendEndpoints:     convertBackendEndpoints(s.rc.BackendServicePorts, s.rc.ServiceDiscoveryEndpoint),
 		Secrets:              convertSecrets(s.manifest.RequestDrivenWebServiceConfig.Secrets),
 	})
 	if err != nil {
@@ -155,6 +163,36 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 	return content.String(), nil
 }
 
+// codeRepository returns the repository that App Runner builds the service from, or nil if the service is deployed from an image.
+// The repository and branch of the manifest take precedence over the ones of the workspace.
+func (s *RequestDrivenWebService) codeRepository() (*template.AppRunnerCodeRepositoryOpts, error) {
+	src := s.manifest.Source
+	if src.IsEmpty() {
+		return nil, nil
+	}
+	url, branch := s.rc.CodeRepository.URL, s.rc.CodeRepository.Branch
+	if src.Repository != nil {
+		url = aws.StringValue(src.Repository)
+	}
+	if src.Branch != nil {
+		branch = aws.StringValue(src.Branch)
+	}
+	if url == "" {
+		return nil, fmt.Errorf("the repository of service %s is unknown: set the `source.repository` field or add a remote named origin to the git repository of the workspace", s.name)
+	}
+	if branch == "" {
+		return nil, fmt.Errorf("the branch of service %s is unknown: set the `source.branch` field or check out a branch of the git repository of the workspace", s.name)
+	}
+	return &template.AppRunnerCodeRepositoryOpts{
+		URL:           url,
+		Branch:        branch,
+		Directory:     aws.StringValue(src.Directory),
+		ConnectionARN: aws.StringValue(src.Connection),
+		Runtime:       aws.StringValue(src.Runtime),
+		BuildCommand:  aws.StringValue(src.Build),
+	}, nil
+}
+
 // SerializedParameters returns the CloudFormation stack's parameters serialized to a JSON document.
 func (s *RequestDrivenWebService) SerializedParameters() (string, error) {
 	return serializeTemplateConfig(s.wkld.parser, s)
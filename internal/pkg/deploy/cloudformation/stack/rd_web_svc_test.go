@@ -292,6 +292,50 @@ Outputs:
 			},
 			wantedTemplate: "template",
 		},
+		"should parse template of a service deployed from source code": {
+			inManifest: func(mft manifest.RequestDrivenWebService) manifest.RequestDrivenWebService {
+				mft.Source = manifest.AppRunnerSource{
+					Directory:  aws.String("api"),
+					Runtime:    aws.String("NODEJS_18"),
+					Build:      aws.String("npm ci"),
+					Port:       aws.Uint16(8080),
+					Connection: aws.String("arn:aws:apprunner:us-west-2:123456789012:connection/github/abc"),
+					Repository: aws.String("https://github.com/user/repo"),
+					Branch:     aws.String("main"),
+				}
+				return mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
+				mockParser := mocks.NewMockrequestDrivenWebSvcReadParser(ctrl)
+				mockParser.EXPECT().ParseRequestDrivenWebService(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.Equal(t, &template.AppRunnerCodeRepositoryOpts{
+						URL:           "https://github.com/user/repo",
+						Branch:        "main",
+						Directory:     "api",
+						ConnectionARN: "arn:aws:apprunner:us-west-2:123456789012:connection/github/abc",
+						Runtime:       "NODEJS_18",
+						BuildCommand:  "npm ci",
+					}, actual.CodeRepository)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				c.parser = mockParser
+				c.addons = mockAddons{}
+			},
+			wantedTemplate: "template",
+		},
+		"should return an error if the repository of the source code is unknown": {
+			inManifest: func(mft manifest.RequestDrivenWebService) manifest.RequestDrivenWebService {
+				mft.Source = manifest.AppRunnerSource{
+					Runtime: aws.String("NODEJS_18"),
+					Branch:  aws.String("main"),
+				}
+				return mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
+				c.addons = mockAddons{}
+			},
+			wantedError: errors.New("the repository of service frontend is unknown: set the `source.repository` field or add a remote named origin to the git repository of the workspace"),
+		},
 		"should return parsing error": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
 				mockParser := mocks.NewMockrequestDrivenWebSvcReadParser(ctrl)
@@ -371,6 +415,7 @@ Outputs:
 func TestRequestDrivenWebService_Parameters(t *testing.T) {
 	testCases := map[string]struct {
 		imageConfig    manifest.ImageWithPort
+		sourceConfig   manifest.AppRunnerSource
 		instanceConfig manifest.AppRunnerInstanceConfig
 
 		wantedParams []*cloudformation.Parameter
@@ -418,6 +463,54 @@ func TestRequestDrivenWebService_Parameters(t *testing.T) {
 				ParameterValue: aws.String("1024"),
 			}},
 		},
+		"source code without an image": {
+			sourceConfig: manifest.AppRunnerSource{
+				Runtime: aws.String("PYTHON_3"),
+				Port:    aws.Uint16(8080),
+			},
+			instanceConfig: manifest.AppRunnerInstanceConfig{
+				CPU:    aws.Int(1024),
+				Memory: aws.Int(2048),
+			},
+			wantedParams: []*cloudformation.Parameter{{
+				ParameterKey:   aws.String("AppName"),
+				ParameterValue: aws.String("phonetool"),
+			}, {
+				ParameterKey:   aws.String("EnvName"),
+				ParameterValue: aws.String("test"),
+			}, {
+				ParameterKey:   aws.String("WorkloadName"),
+				ParameterValue: aws.String("frontend"),
+			}, {
+				ParameterKey:   aws.String("ContainerImage"),
+				ParameterValue: aws.String(""),
+			}, {
+				ParameterKey:   aws.String("AddonsTemplateURL"),
+				ParameterValue: aws.String(""),
+			}, {
+				ParameterKey:   aws.String(RDWkldImageRepositoryType),
+				ParameterValue: aws.String(""),
+			}, {
+				ParameterKey:   aws.String(WorkloadContainerPortParamKey),
+				ParameterValue: aws.String("8080"),
+			}, {
+				ParameterKey:   aws.String(RDWkldInstanceCPUParamKey),
+				ParameterValue: aws.String("1024"),
+			}, {
+				ParameterKey:   aws.String(RDWkldInstanceMemoryParamKey),
+				ParameterValue: aws.String("2048"),
+			}},
+		},
+		"error when source port unspecified": {
+			sourceConfig: manifest.AppRunnerSource{
+				Runtime: aws.String("PYTHON_3"),
+			},
+			instanceConfig: manifest.AppRunnerInstanceConfig{
+				CPU:    aws.Int(1024),
+				Memory: aws.Int(1024),
+			},
+			wantedError: errors.New("field `source.port` is required for Request Driven Web Services deployed from source code"),
+		},
 		"error when port unspecified": {
 			imageConfig: manifest.ImageWithPort{
 				Image: manifest.Image{
@@ -473,6 +566,7 @@ func TestRequestDrivenWebService_Parameters(t *testing.T) {
 					},
 					instanceConfig: tc.instanceConfig,
 					imageConfig:    tc.imageConfig,
+					sourceConfig:   tc.sourceConfig,
 				},
 				manifest: testRDWebServiceManifest,
 			}
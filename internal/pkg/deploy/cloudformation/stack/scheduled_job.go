@@ -184,8 +184,9 @@ func (j *ScheduledJob) Template() (string, error) {
 		ScheduleExpression:       schedule,
 		StateMachine:             stateMachine,
 		HealthCheck:              convertContainerHealthCheck(j.manifest.ImageConfig.HealthCheck),
-		LogConfig:                convertLogging(j.manifest.Logging),
+		LogConfig:                convertLogging(j.manifest.Logging, j.rc.helperImage(template.HelperImageFluentBit)),
 		DockerLabels:             j.manifest.ImageConfig.Image.DockerLabels,
+		StopTimeout:              convertTime(j.manifest.ImageConfig.Image.StopTimeout),
 		Storage:                  convertStorageOpts(j.manifest.Name, j.manifest.Storage),
 		Network:                  convertNetworkConfig(j.manifest.Network),
 		EntryPoint:               entrypoint,
@@ -248,9 +249,15 @@ func (j *ScheduledJob) awsSchedule() (string, error) {
 	if schedule == "" {
 		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
 	}
+	return toAWSSchedule(schedule)
+}
+
+// toAWSSchedule converts a cron expression, a predefined schedule or an "@every" directive into
+// a CloudWatch Events schedule expression.
+func toAWSSchedule(schedule string) (string, error) {
 	// If the schedule uses default CloudWatch Events syntax, pass it through for server-side validation.
 	if match := awsScheduleRegexp.FindStringSubmatch(schedule); match != nil {
-		return aws.StringValue(j.manifest.On.Schedule), nil
+		return schedule, nil
 	}
 	// Try parsing the string as a cron expression to validate it.
 	if _, err := cron.ParseStandard(schedule); err != nil {
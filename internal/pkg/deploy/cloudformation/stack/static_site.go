@@ -110,7 +110,11 @@ func (s *StaticSite) Template() (string, error) {
 		staticSiteAlias = fmt.Sprintf("%s.%s.%s.%s", s.name, s.env, s.app, s.appInfo.Domain)
 	}
 	if s.manifest.HTTP.Alias != "" {
-		staticSiteAlias = s.manifest.HTTP.Alias
+		alias, err := manifest.AliasToASCII(s.manifest.HTTP.Alias)
+		if err != nil {
+			return "", err
+		}
+		staticSiteAlias = alias
 	}
 	dnsDelegationRole, dnsName := convertAppInformation(s.appInfo)
 	content, err := s.parser.ParseStaticSite(template.WorkloadOpts{
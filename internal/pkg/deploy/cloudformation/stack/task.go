@@ -63,6 +63,10 @@ var cfnFuntion = map[string]interface{}{
 
 // Template returns the task CloudFormation template.
 func (t *taskStackConfig) Template() (string, error) {
+	schedule, err := t.schedule()
+	if err != nil {
+		return "", err
+	}
 	content, err := t.parser.Parse(taskTemplatePath, struct {
 		EnvVars               map[string]string
 		SSMParamSecrets       map[string]string
@@ -71,6 +75,7 @@ func (t *taskStackConfig) Template() (string, error) {
 		Env                   string
 		ExecutionRole         string
 		PermissionsBoundary   string
+		Schedule              *deploy.TaskSchedule
 	}{
 		EnvVars:               t.EnvVars,
 		SSMParamSecrets:       t.SSMParamSecrets,
@@ -79,6 +84,7 @@ func (t *taskStackConfig) Template() (string, error) {
 		Env:                   t.Env,
 		ExecutionRole:         t.ExecutionRole,
 		PermissionsBoundary:   t.PermissionsBoundary,
+		Schedule:              schedule,
 	}, template.WithFuncs(cfnFuntion))
 	if err != nil {
 		return "", fmt.Errorf("read template for task stack: %w", err)
@@ -86,6 +92,20 @@ func (t *taskStackConfig) Template() (string, error) {
 	return content.String(), nil
 }
 
+// schedule returns the schedule of the tasks with the expression converted to a CloudWatch Events schedule expression.
+func (t *taskStackConfig) schedule() (*deploy.TaskSchedule, error) {
+	if t.Schedule == nil {
+		return nil, nil
+	}
+	expression, err := toAWSSchedule(t.Schedule.Expression)
+	if err != nil {
+		return nil, fmt.Errorf("convert schedule %q for task %s: %w", t.Schedule.Expression, t.Name, err)
+	}
+	schedule := *t.Schedule
+	schedule.Expression = expression
+	return &schedule, nil
+}
+
 // Parameters returns the parameter values to be passed to the task CloudFormation template.
 func (t *taskStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 	return []*cloudformation.Parameter{
@@ -24,6 +24,7 @@ const (
 
 func TestTaskStackConfig_Template(t *testing.T) {
 	testCases := map[string]struct {
+		inSchedule     *deploy.TaskSchedule
 		mockReadParser func(m *mocks.MockReadParser)
 
 		wantedTemplate string
@@ -43,6 +44,42 @@ func TestTaskStackConfig_Template(t *testing.T) {
 			},
 			wantedTemplate: "This is the task template",
 		},
+		"should return error if the schedule is invalid": {
+			inSchedule: &deploy.TaskSchedule{
+				Expression: "every 4m",
+			},
+			wantedError: errors.New(`convert schedule "every 4m" for task my-task: schedule is not valid cron, rate, or preset: expected exactly 5 fields, found 2: [every 4m]`),
+		},
+		"should convert the schedule of the tasks": {
+			inSchedule: &deploy.TaskSchedule{
+				Expression: "@every 1h30m",
+				Count:      2,
+				Cluster:    "cluster-1",
+			},
+			mockReadParser: func(m *mocks.MockReadParser) {
+				m.EXPECT().Parse(taskTemplatePath, gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, data interface{}, _ ...template.ParseOption) (*template.Content, error) {
+					schedule := data.(struct {
+						EnvVars               map[string]string
+						SSMParamSecrets       map[string]string
+						SecretsManagerSecrets map[string]string
+						App                   string
+						Env                   string
+						ExecutionRole         string
+						PermissionsBoundary   string
+						Schedule              *deploy.TaskSchedule
+					}).Schedule
+					require.Equal(t, &deploy.TaskSchedule{
+						Expression: "rate(90 minutes)",
+						Count:      2,
+						Cluster:    "cluster-1",
+					}, schedule)
+					return &template.Content{
+						Buffer: bytes.NewBufferString("This is the scheduled task template"),
+					}, nil
+				})
+			},
+			wantedTemplate: "This is the scheduled task template",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -55,7 +92,10 @@ func TestTaskStackConfig_Template(t *testing.T) {
 				tc.mockReadParser(mockReadParser)
 			}
 
-			taskInput := deploy.CreateTaskResourcesInput{}
+			taskInput := deploy.CreateTaskResourcesInput{
+				Name:     testTaskName,
+				Schedule: tc.inSchedule,
+			}
 
 			taskStackConfig := &taskStackConfig{
 				CreateTaskResourcesInput: &taskInput,
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/crc32"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -21,8 +22,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/robfig/cron/v3"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/openapi"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
@@ -35,6 +38,11 @@ const (
 const (
 	sqsDedupeScopeMessageGroup              = "messageGroup"
 	sqsFIFOThroughputLimitPerMessageGroupId = "perMessageGroupId"
+
+	// SQS makes a received message invisible for 30 seconds unless the queue has another visibility timeout.
+	defaultSQSVisibilityTimeout = 30 * time.Second
+	// Processed messages are remembered for a day unless the manifest sets another TTL.
+	defaultDeduplicationTableTTL = 24 * time.Hour
 )
 
 // Default values for EFS options
@@ -60,7 +68,26 @@ const (
 	maxPercentDefault         = 200
 )
 
+// Default traffic shifting configuration of the blue/green and canary deployments.
+const (
+	defaultCanaryPercent      = 10
+	defaultCanaryInterval     = 5 * time.Minute
+	defaultDeploymentBakeTime = 5 * time.Minute
+)
+
+// Default statistic of the CloudWatch metrics that services scale on.
+const defaultCustomMetricStatistic = "Average"
+
+// Default duration of the scale-in protection of a task, which is also the default of ECS.
+const defaultScaleInProtectionExpiresIn = 2 * time.Hour
+
+// maxRegexesPerPatternSet is the maximum number of regular expressions in a WAF regex pattern set.
+const maxRegexesPerPatternSet = 10
+
 var (
+	// Validates that an expression is of the form at(xyz), rate(xyz) or cron(xyz) of Application Auto Scaling.
+	awsScalingScheduleRegexp = regexp.MustCompile(`^(?:at|rate|cron)\(.*\)$`)
+
 	taskDefOverrideRulePrefixes = []string{"Resources", "TaskDefinition", "Properties"}
 	subnetPlacementForTemplate  = map[manifest.PlacementString]string{
 		manifest.PrivateSubnetPlacement: template.PrivateSubnetsPlacement,
@@ -146,20 +173,24 @@ func convertContainerHealthCheck(hc manifest.ContainerHealthCheck) *template.Con
 
 func convertHostedZone(alias manifest.Alias, defaultHostedZone *string) (template.AliasesForHostedZone, error) {
 	aliasesFor := make(map[string][]string)
+	aliases, err := alias.ToStringSlice()
+	if err != nil {
+		return nil, err
+	}
 	if len(alias.AdvancedAliases) != 0 {
-		for _, alias := range alias.AdvancedAliases {
-			if alias.HostedZone != nil {
-				if isDuplicateAliasEntry(aliasesFor[*alias.HostedZone], aws.StringValue(alias.Alias)) {
+		for i, advancedAlias := range alias.AdvancedAliases {
+			if advancedAlias.HostedZone != nil {
+				if isDuplicateAliasEntry(aliasesFor[*advancedAlias.HostedZone], aliases[i]) {
 					continue
 				}
-				aliasesFor[*alias.HostedZone] = append(aliasesFor[*alias.HostedZone], *alias.Alias)
+				aliasesFor[*advancedAlias.HostedZone] = append(aliasesFor[*advancedAlias.HostedZone], aliases[i])
 				continue
 			}
 			if defaultHostedZone != nil {
-				if isDuplicateAliasEntry(aliasesFor[*defaultHostedZone], aws.StringValue(alias.Alias)) {
+				if isDuplicateAliasEntry(aliasesFor[*defaultHostedZone], aliases[i]) {
 					continue
 				}
-				aliasesFor[*defaultHostedZone] = append(aliasesFor[*defaultHostedZone], *alias.Alias)
+				aliasesFor[*defaultHostedZone] = append(aliasesFor[*defaultHostedZone], aliases[i])
 			}
 		}
 		return aliasesFor, nil
@@ -167,10 +198,6 @@ func convertHostedZone(alias manifest.Alias, defaultHostedZone *string) (templat
 	if defaultHostedZone == nil {
 		return aliasesFor, nil
 	}
-	aliases, err := alias.ToStringSlice()
-	if err != nil {
-		return nil, err
-	}
 
 	for _, alias := range aliases {
 		if isDuplicateAliasEntry(aliasesFor[*defaultHostedZone], alias) {
@@ -338,11 +365,18 @@ func convertAutoscaling(a manifest.AdvancedCount) (*template.AutoscalingOpts, er
 		responseTime := float64(*a.ResponseTime.ScalingConfig.Value) / float64(time.Second)
 		autoscalingOpts.ResponseTime = aws.Float64(responseTime)
 	}
+	if a.Connections.Value != nil {
+		autoscalingOpts.Connections = aws.Float64(float64(*a.Connections.Value))
+	}
+	if a.Connections.ScalingConfig.Value != nil {
+		autoscalingOpts.Connections = aws.Float64(float64(*a.Connections.ScalingConfig.Value))
+	}
 
 	autoscalingOpts.CPUCooldown = convertScalingCooldown(a.CPU.ScalingConfig.Cooldown, a.Cooldown)
 	autoscalingOpts.MemCooldown = convertScalingCooldown(a.Memory.ScalingConfig.Cooldown, a.Cooldown)
 	autoscalingOpts.ReqCooldown = convertScalingCooldown(a.Requests.ScalingConfig.Cooldown, a.Cooldown)
 	autoscalingOpts.RespTimeCooldown = convertScalingCooldown(a.ResponseTime.ScalingConfig.Cooldown, a.Cooldown)
+	autoscalingOpts.ConnCooldown = convertScalingCooldown(a.Connections.ScalingConfig.Cooldown, a.Cooldown)
 	autoscalingOpts.QueueDelayCooldown = convertScalingCooldown(a.QueueScaling.Cooldown, a.Cooldown)
 
 	if !a.QueueScaling.IsEmpty() {
@@ -354,9 +388,91 @@ func convertAutoscaling(a manifest.AdvancedCount) (*template.AutoscalingOpts, er
 			AcceptableBacklogPerTask: acceptableBacklog,
 		}
 	}
+	for _, metric := range a.CustomMetrics {
+		autoscalingOpts.CustomMetrics = append(autoscalingOpts.CustomMetrics, convertCustomMetricScaling(metric, a.Cooldown))
+	}
+	for idx, schedule := range a.Schedules {
+		opts, err := convertScheduledScaling(schedule)
+		if err != nil {
+			return nil, fmt.Errorf(`convert "schedules[%d]": %w`, idx, err)
+		}
+		autoscalingOpts.Schedules = append(autoscalingOpts.Schedules, opts)
+	}
 	return &autoscalingOpts, nil
 }
 
+// convertCustomMetricScaling converts a target tracking policy on a CloudWatch metric into a format parsable by the templates pkg.
+func convertCustomMetricScaling(metric manifest.CustomMetricScaling, genCooldown manifest.Cooldown) template.AutoscalingCustomMetricOpts {
+	opts := template.AutoscalingCustomMetricOpts{
+		Namespace:   aws.StringValue(metric.Namespace),
+		MetricName:  aws.StringValue(metric.Name),
+		Statistic:   defaultCustomMetricStatistic,
+		TargetValue: aws.Float64Value(metric.Value),
+		Cooldown:    convertScalingCooldown(metric.Cooldown, genCooldown),
+	}
+	if metric.Statistic != nil {
+		opts.Statistic = aws.StringValue(metric.Statistic)
+	}
+	for name, value := range metric.Dimensions {
+		opts.Dimensions = append(opts.Dimensions, template.AutoscalingMetricDimension{
+			Name:  name,
+			Value: value,
+		})
+	}
+	sort.Slice(opts.Dimensions, func(i, j int) bool {
+		return opts.Dimensions[i].Name < opts.Dimensions[j].Name
+	})
+	return opts
+}
+
+// convertScheduledScaling converts a scheduled change of the range into a format parsable by the templates pkg.
+func convertScheduledScaling(s manifest.ScheduledScaling) (template.AutoscalingScheduleOpts, error) {
+	schedule, err := convertScalingSchedule(aws.StringValue(s.Schedule))
+	if err != nil {
+		return template.AutoscalingScheduleOpts{}, err
+	}
+	min, max, err := s.Range.Parse()
+	if err != nil {
+		return template.AutoscalingScheduleOpts{}, err
+	}
+	return template.AutoscalingScheduleOpts{
+		Schedule:    schedule,
+		Timezone:    aws.StringValue(s.Timezone),
+		MinCapacity: min,
+		MaxCapacity: max,
+	}, nil
+}
+
+// convertScalingSchedule converts a cron expression, a preset or a fixed interval into the schedule expression of
+// Application Auto Scaling. Expressions that already use its syntax are passed through for server-side validation.
+func convertScalingSchedule(schedule string) (string, error) {
+	if awsScalingScheduleRegexp.MatchString(schedule) {
+		return schedule, nil
+	}
+	if _, err := cron.ParseStandard(schedule); err != nil {
+		return "", errScheduleInvalid{reason: err}
+	}
+	switch {
+	case strings.HasPrefix(schedule, every):
+		rate, err := toRate(schedule[len(every):])
+		if err != nil {
+			return "", fmt.Errorf("parse fixed interval: %w", err)
+		}
+		return rate, nil
+	case strings.HasPrefix(schedule, "@"):
+		fixed, err := toFixedSchedule(schedule)
+		if err != nil {
+			return "", fmt.Errorf("parse preset schedule: %w", err)
+		}
+		return fixed, nil
+	}
+	expr, err := toAWSCron(schedule)
+	if err != nil {
+		return "", fmt.Errorf("parse cron schedule: %w", err)
+	}
+	return expr, nil
+}
+
 // convertHTTPHealthCheck converts the ALB health check configuration into a format parsable by the templates pkg.
 func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString) template.HTTPHealthCheckOpts {
 	opts := template.HTTPHealthCheckOpts{
@@ -403,6 +519,10 @@ func convertNLBHealthCheck(nlbHC *manifest.NLBHealthCheckArgs) template.NLBHealt
 	if nlbHC.Port != nil {
 		hc.Port = strconv.Itoa(aws.IntValue(nlbHC.Port))
 	}
+	if nlbHC.Protocol != nil {
+		hc.Protocol = strings.ToUpper(aws.StringValue(nlbHC.Protocol))
+	}
+	hc.Path = aws.StringValue(nlbHC.Path)
 	if nlbHC.Timeout != nil {
 		hc.Timeout = aws.Int64(int64(nlbHC.Timeout.Seconds()))
 	}
@@ -455,6 +575,16 @@ func convertFlowLogsConfig(mft *manifest.Environment) (*template.VPCFlowLogs, er
 
 }
 
+func convertClusterExecConfig(mft *manifest.Environment) *template.ExecConfig {
+	if mft == nil || mft.Cluster.Exec.IsZero() {
+		return nil
+	}
+	return &template.ExecConfig{
+		Encryption:   aws.BoolValue(mft.Cluster.Exec.Encryption),
+		LogRetention: mft.Cluster.Exec.Logs.Retention,
+	}
+}
+
 func convertEnvSecurityGroupCfg(mft *manifest.Environment) (*template.SecurityGroupConfig, error) {
 	securityGroupConfig, isSecurityConfigSet := mft.EnvSecurityGroup()
 	if !isSecurityConfigSet {
@@ -515,13 +645,128 @@ func (s *LoadBalancedWebService) convertALBListener() (*template.ALBListener, er
 			return nil, err
 		}
 	}
+	var webACL *template.OpenAPIWebACL
+	if s.openAPISpec != nil {
+		var err error
+		if webACL, err = convertOpenAPIWebACL(s.openAPISpec, rrConfig.OpenAPI, rules[0].Path); err != nil {
+			return nil, err
+		}
+		rules = convertOpenAPIRules(s.openAPISpec, rrConfig.Main, rules)
+	}
 	return &template.ALBListener{
 		Rules:             rules,
 		IsHTTPS:           s.httpsEnabled,
 		HostedZoneAliases: aliasesFor,
+		AliasHealthChecks: convertAliasHealthChecks(rrConfig.HTTP, rules),
+		OpenAPIWebACL:     webACL,
+	}, nil
+}
+
+// convertOpenAPIRules replaces the path of the main listener rule with the first path prefix of the OpenAPI spec,
+// and appends a copy of the main rule forwarding to its target group for each of the other prefixes.
+// The paths of the spec are relative to the path of the main routing rule.
+func convertOpenAPIRules(spec *openapi.Spec, main manifest.RoutingRule, rules []template.ALBListenerRule) []template.ALBListenerRule {
+	base := rules[0].Path
+	if main.HealthCheck.Path() == nil {
+		if path := spec.HealthCheckPath(base); path != "" {
+			rules[0].HTTPHealthCheck.HealthCheckPath = path
+		}
+	}
+	prefixes := spec.PathPrefixes(base)
+	rules[0].Path = prefixes[0]
+	for _, prefix := range prefixes[1:] {
+		rule := rules[0]
+		rule.Path = prefix
+		rule.SharedTargetGroup = true
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// convertOpenAPIWebACL returns the regex pattern sets of the web ACL that blocks the requests to undefined paths of the spec.
+func convertOpenAPIWebACL(spec *openapi.Spec, cfg manifest.OpenAPI, base string) (*template.OpenAPIWebACL, error) {
+	if !aws.BoolValue(cfg.BlockUndefinedPaths) {
+		return nil, nil
+	}
+	scopes, err := spec.ScopeRegexes(base)
+	if err != nil {
+		return nil, fmt.Errorf(`convert "http.openapi.block_undefined_paths": %w`, err)
+	}
+	paths, err := spec.PathRegexes(base)
+	if err != nil {
+		return nil, fmt.Errorf(`convert "http.openapi.block_undefined_paths": %w`, err)
+	}
+	return &template.OpenAPIWebACL{
+		ScopeRegexSets: chunkRegexes(scopes),
+		PathRegexSets:  chunkRegexes(paths),
 	}, nil
 }
 
+// chunkRegexes splits regular expressions into sets of the maximum size of a WAF regex pattern set.
+func chunkRegexes(regexes []string) [][]string {
+	var sets [][]string
+	for len(regexes) > maxRegexesPerPatternSet {
+		sets = append(sets, regexes[:maxRegexesPerPatternSet])
+		regexes = regexes[maxRegexesPerPatternSet:]
+	}
+	return append(sets, regexes)
+}
+
+// convertTrafficMirror returns the configuration of the sidecar that copies the requests of the main listener rule to another service,
+// and points the rule to the sidecar so that it serves the requests with the rule's original target.
+func convertTrafficMirror(mirror manifest.TrafficMirror, listener *template.ALBListener, sdEndpoint, image string) *template.TrafficMirrorOpts {
+	if mirror.IsEmpty() || listener == nil || len(listener.Rules) == 0 {
+		return nil
+	}
+	main := &listener.Rules[0]
+	opts := &template.TrafficMirrorOpts{
+		Image:      image,
+		TargetPort: main.TargetPort,
+		Host:       fmt.Sprintf("%s.%s", aws.StringValue(mirror.Service), sdEndpoint),
+		Port:       main.TargetPort,
+		Percent:    100,
+	}
+	if mirror.Port != nil {
+		opts.Port = strconv.Itoa(int(aws.Uint16Value(mirror.Port)))
+	}
+	if mirror.Percent != nil {
+		opts.Percent = aws.IntValue(mirror.Percent)
+	}
+	main.TargetContainer = template.TrafficMirrorContainerName
+	main.TargetPort = template.TrafficMirrorListenerPort
+	return opts
+}
+
+// convertAliasHealthChecks returns a Route 53 health check against each alias of the listener rules.
+// Wildcard aliases are skipped since Route 53 can't send requests to them.
+func convertAliasHealthChecks(http manifest.HTTP, rules []template.ALBListenerRule) []template.AliasHealthCheck {
+	if !http.AliasHealthCheckEnabled() {
+		return nil
+	}
+	args := http.AliasHealthCheck.Advanced
+	var checks []template.AliasHealthCheck
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		for _, alias := range rule.Aliases {
+			if seen[alias] || strings.HasPrefix(alias, "*") {
+				continue
+			}
+			seen[alias] = true
+			path := rule.Path
+			if args.Path != nil {
+				path = aws.StringValue(args.Path)
+			}
+			checks = append(checks, template.AliasHealthCheck{
+				Domain:           alias,
+				Path:             path,
+				FailureThreshold: args.FailureThreshold,
+				RequestInterval:  convertTime(args.Interval),
+			})
+		}
+	}
+	return checks
+}
+
 func (s *BackendService) convertALBListener() (*template.ALBListener, error) {
 	rrConfig := s.manifest.HTTP
 	if rrConfig.IsEmpty() {
@@ -621,6 +866,7 @@ func (conv routingRuleConfigConverter) convert() (*template.ALBListenerRule, err
 		HTTPVersion:         aws.StringValue(convertHTTPVersion(conv.rule.ProtocolVersion)),
 		RedirectToHTTPS:     conv.redirectToHTTPS,
 		DeregistrationDelay: convertDeregistrationDelay(conv.rule.DeregistrationDelay),
+		SlowStart:           convertTime(conv.rule.SlowStart),
 	}
 	return config, nil
 }
@@ -723,6 +969,20 @@ func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandO
 	return &template.ExecuteCommandOpts{}
 }
 
+// convertScaleInProtection converts the scale-in protection of the tasks into a format parsable by the templates pkg.
+func convertScaleInProtection(t *manifest.TaskConfig) *template.ScaleInProtectionOpts {
+	if !t.ScaleInProtectionEnabled() {
+		return nil
+	}
+	expiresIn := defaultScaleInProtectionExpiresIn
+	if t.ScaleInProtection.Advanced.ExpiresIn != nil {
+		expiresIn = *t.ScaleInProtection.Advanced.ExpiresIn
+	}
+	return &template.ScaleInProtectionOpts{
+		ExpiresInMinutes: int(expiresIn.Minutes()),
+	}
+}
+
 func convertAllowedSourceIPs(allowedSourceIPs []manifest.IPNet) []string {
 	var sourceIPs []string
 	for _, ipNet := range allowedSourceIPs {
@@ -737,12 +997,17 @@ func convertServiceConnect(s manifest.ServiceConnectBoolOrArgs) *template.Servic
 	}
 }
 
-func convertLogging(lc manifest.Logging) *template.LogConfigOpts {
+// convertLogging returns the configuration of the FireLens sidecar, which runs the image of the manifest or the Fluent Bit image.
+func convertLogging(lc manifest.Logging, fluentBitImage string) *template.LogConfigOpts {
 	if lc.IsEmpty() {
 		return nil
 	}
+	image := lc.Image
+	if image == nil {
+		image = aws.String(fluentBitImage)
+	}
 	return &template.LogConfigOpts{
-		Image:          lc.LogImage(),
+		Image:          image,
 		ConfigFile:     lc.ConfigFile,
 		EnableMetadata: lc.GetEnableMetadata(),
 		Destination:    lc.Destination,
@@ -752,6 +1017,32 @@ func convertLogging(lc manifest.Logging) *template.LogConfigOpts {
 	}
 }
 
+// convertObservability returns the tracing configuration of the ADOT collector sidecar of an ECS service,
+// and the metrics of its load balancer to create anomaly detection alarms on.
+func convertObservability(o manifest.Observability, collectorImage string) template.ObservabilityOpts {
+	opts := template.ObservabilityOpts{
+		Tracing: strings.ToUpper(aws.StringValue(o.Tracing)),
+	}
+	if opts.Tracing != "" {
+		opts.CollectorImage = collectorImage
+	}
+	if opts.Tracing == template.TracingOTLP {
+		opts.OTLPEndpoint = aws.StringValue(o.Endpoint)
+	}
+	if len(o.AnomalyDetection) != 0 {
+		opts.AnomalyDetection = &template.AnomalyDetectionOpts{}
+		for _, metric := range o.AnomalyDetection {
+			switch metric {
+			case "latency":
+				opts.AnomalyDetection.Latency = true
+			case "errors":
+				opts.AnomalyDetection.Errors = true
+			}
+		}
+	}
+	return opts
+}
+
 func convertTaskDefOverrideRules(inRules []manifest.OverrideRule) []override.Rule {
 	var res []override.Rule
 	suffixStr := strings.Join(taskDefOverrideRulePrefixes, override.PathSegmentSeparator)
@@ -1014,6 +1305,38 @@ func convertRDWSNetworkConfig(network manifest.RequestDrivenWebServiceNetworkCon
 	return opts
 }
 
+func convertAppRunnerScaling(scaling manifest.AppRunnerScalingConfig) *template.AppRunnerAutoScalingOpts {
+	if scaling.IsEmpty() {
+		return nil
+	}
+	return &template.AppRunnerAutoScalingOpts{
+		MaxConcurrency: scaling.MaxConcurrency,
+		MinSize:        scaling.MinInstances,
+		MaxSize:        scaling.MaxInstances,
+	}
+}
+
+// convertBackendEndpoints returns the endpoints of the Backend Services in the service discovery namespace,
+// sorted by service name, with the environment variable names under which they are injected such as "COPILOT_API_ENDPOINT".
+func convertBackendEndpoints(ports map[string]string, namespace string) []template.BackendServiceEndpoint {
+	if len(ports) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(ports))
+	for name := range ports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	endpoints := make([]template.BackendServiceEndpoint, len(names))
+	for i, name := range names {
+		endpoints[i] = template.BackendServiceEndpoint{
+			Name:     fmt.Sprintf("COPILOT_%s_ENDPOINT", strings.ToUpper(strings.ReplaceAll(name, "-", "_"))),
+			Endpoint: fmt.Sprintf("%s.%s:%s", name, namespace, ports[name]),
+		}
+	}
+	return endpoints
+}
+
 func convertAlias(alias manifest.Alias) ([]string, error) {
 	out, err := alias.ToStringSlice()
 	if err != nil {
@@ -1052,6 +1375,31 @@ func convertDeploymentConfig(in manifest.DeploymentConfig) template.DeploymentCo
 	return out
 }
 
+// convertDeploymentStrategy returns nil if the service is deployed with rolling updates.
+func convertDeploymentStrategy(in manifest.DeploymentStrategy) *template.DeploymentStrategyOpts {
+	if in.Type == nil {
+		return nil
+	}
+	out := &template.DeploymentStrategyOpts{
+		BakeTime: int(defaultDeploymentBakeTime.Minutes()),
+	}
+	if in.BakeTime != nil {
+		out.BakeTime = int(in.BakeTime.Minutes())
+	}
+	if aws.StringValue(in.Type) != manifest.CanaryDeploymentStrategy {
+		return out
+	}
+	out.CanaryPercent = aws.IntValue(in.Canary.Percent)
+	if in.Canary.Percent == nil {
+		out.CanaryPercent = defaultCanaryPercent
+	}
+	out.CanaryInterval = int(defaultCanaryInterval.Minutes())
+	if in.Canary.Interval != nil {
+		out.CanaryInterval = int(in.Canary.Interval.Minutes())
+	}
+	return out
+}
+
 func convertWorkerDeploymentConfig(in manifest.WorkerDeploymentConfig) template.DeploymentConfigurationOpts {
 	out := convertDeploymentControllerConfig(in.DeploymentControllerConfig)
 	out.Rollback = template.RollingUpdateRollbackConfig{
@@ -1119,9 +1467,43 @@ func convertSubscribe(s *manifest.WorkerService) (*template.SubscribeOpts, error
 		subscriptions.Topics = append(subscriptions.Topics, ts)
 	}
 	subscriptions.Queue = convertQueue(s.Subscribe.Queue)
+	subscriptions.Consumer = convertConsumer(s.Subscribe.Consumer, s.Subscribe.Queue.Timeout)
 	return &subscriptions, nil
 }
 
+// convertConsumer returns the settings of the consumer of the events queue, whose messages are invisible for queueTimeout once received.
+func convertConsumer(in manifest.SQSConsumer, queueTimeout *time.Duration) *template.SQSConsumer {
+	if in.IsEmpty() {
+		return nil
+	}
+	visibilityTimeout := defaultSQSVisibilityTimeout
+	if queueTimeout != nil {
+		visibilityTimeout = *queueTimeout
+	}
+	return &template.SQSConsumer{
+		MaxConcurrentMessages: in.MaxConcurrentMessages,
+		BatchSize:             in.BatchSize,
+		ProcessingTimeout:     convertTime(in.ProcessingTimeout),
+		VisibilityTimeout:     int64(visibilityTimeout.Seconds()),
+		PartialBatchFailures:  aws.BoolValue(in.PartialBatchFailures),
+		MessageGroupStrategy:  in.MessageGroupStrategy,
+		DeduplicationTable:    convertDeduplicationTable(in.DeduplicationTable),
+	}
+}
+
+func convertDeduplicationTable(in manifest.DeduplicationTableOrBool) *template.DeduplicationTable {
+	if !in.IsEnabled() {
+		return nil
+	}
+	ttl := defaultDeduplicationTableTTL
+	if in.Advanced.TTL != nil {
+		ttl = *in.Advanced.TTL
+	}
+	return &template.DeduplicationTable{
+		TTL: int64(ttl.Seconds()),
+	}
+}
+
 func convertTopicSubscription(t manifest.TopicSubscription) (
 	*template.TopicSubscription, error) {
 	filterPolicy, err := convertFilterPolicy(t.FilterPolicy)
@@ -1270,6 +1652,10 @@ func convertEnvVars(variables map[string]manifest.Variable) map[string]template.
 	}
 	m := make(map[string]template.Variable, len(variables))
 	for name, variable := range variables {
+		if name == manifest.VariablesBuildOnly {
+			// Build-only variables are passed to the image build, and never set in the task definition.
+			continue
+		}
 		if variable.RequiresImport() {
 			m[name] = template.ImportedVariable(variable.Value())
 			continue
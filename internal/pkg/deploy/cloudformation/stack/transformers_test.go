@@ -6,11 +6,13 @@ package stack
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/openapi"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/override"
 	"github.com/stretchr/testify/require"
@@ -361,6 +363,10 @@ func Test_convertAdvancedCount(t *testing.T) {
 						ScaleInCooldown:  aws.Float64(60),
 						ScaleOutCooldown: aws.Float64(60),
 					},
+					ConnCooldown: template.Cooldown{
+						ScaleInCooldown:  aws.Float64(60),
+						ScaleOutCooldown: aws.Float64(60),
+					},
 					QueueDelayCooldown: template.Cooldown{
 						ScaleInCooldown:  aws.Float64(60),
 						ScaleOutCooldown: aws.Float64(60),
@@ -662,6 +668,30 @@ func Test_convertAutoscaling(t *testing.T) {
 				ResponseTime: aws.Float64(0.512),
 			},
 		},
+		"success with connections": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					Value: &mockRange,
+				},
+				Connections: manifest.ScalingConfigOrT[int]{
+					ScalingConfig: manifest.AdvancedScalingConfig[int]{
+						Value: aws.Int(500),
+						Cooldown: manifest.Cooldown{
+							ScaleInCooldown: &timeMinute,
+						},
+					},
+				},
+			},
+
+			wanted: &template.AutoscalingOpts{
+				MaxCapacity: aws.Int(100),
+				MinCapacity: aws.Int(1),
+				Connections: aws.Float64(500),
+				ConnCooldown: template.Cooldown{
+					ScaleInCooldown: aws.Float64(60),
+				},
+			},
+		},
 		"success with queue autoscaling": {
 			input: manifest.AdvancedCount{
 				Range: manifest.Range{
@@ -690,6 +720,141 @@ func Test_convertAutoscaling(t *testing.T) {
 				},
 			},
 		},
+		"success with custom metrics and schedules": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					Value: &mockRange,
+				},
+				Cooldown: manifest.Cooldown{
+					ScaleOutCooldown: &timeMinute,
+				},
+				CustomMetrics: []manifest.CustomMetricScaling{
+					{
+						Namespace: aws.String("AWS/SQS"),
+						Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+						Dimensions: map[string]string{
+							"QueueName": "jobs",
+							"Env":       "test",
+						},
+						Value: aws.Float64(50),
+						Cooldown: manifest.Cooldown{
+							ScaleInCooldown: &timeMinute,
+						},
+					},
+					{
+						Namespace: aws.String("MyApp"),
+						Name:      aws.String("ActiveSessions"),
+						Statistic: aws.String("Maximum"),
+						Value:     aws.Float64(0.5),
+					},
+				},
+				Schedules: []manifest.ScheduledScaling{
+					{
+						Schedule: aws.String("0 9 * * 1-5"),
+						Timezone: aws.String("Europe/Paris"),
+						Range: manifest.Range{
+							RangeConfig: manifest.RangeConfig{
+								Min: aws.Int(10),
+								Max: aws.Int(20),
+							},
+						},
+					},
+					{
+						Schedule: aws.String("at(2024-12-31T23:00:00)"),
+						Range: manifest.Range{
+							Value: (*manifest.IntRangeBand)(aws.String("1-2")),
+						},
+					},
+					{
+						Schedule: aws.String("@daily"),
+						Range: manifest.Range{
+							Value: (*manifest.IntRangeBand)(aws.String("1-5")),
+						},
+					},
+				},
+			},
+			wanted: &template.AutoscalingOpts{
+				MaxCapacity: aws.Int(100),
+				MinCapacity: aws.Int(1),
+				CPUCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				MemCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				ReqCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				RespTimeCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				ConnCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				QueueDelayCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				CustomMetrics: []template.AutoscalingCustomMetricOpts{
+					{
+						Namespace:  "AWS/SQS",
+						MetricName: "ApproximateNumberOfMessagesVisible",
+						Statistic:  "Average",
+						Dimensions: []template.AutoscalingMetricDimension{
+							{Name: "Env", Value: "test"},
+							{Name: "QueueName", Value: "jobs"},
+						},
+						TargetValue: 50,
+						Cooldown: template.Cooldown{
+							ScaleInCooldown:  aws.Float64(60),
+							ScaleOutCooldown: aws.Float64(60),
+						},
+					},
+					{
+						Namespace:   "MyApp",
+						MetricName:  "ActiveSessions",
+						Statistic:   "Maximum",
+						TargetValue: 0.5,
+						Cooldown: template.Cooldown{
+							ScaleOutCooldown: aws.Float64(60),
+						},
+					},
+				},
+				Schedules: []template.AutoscalingScheduleOpts{
+					{
+						Schedule:    "cron(0 9 ? * 2-6 *)",
+						Timezone:    "Europe/Paris",
+						MinCapacity: 10,
+						MaxCapacity: 20,
+					},
+					{
+						Schedule:    "at(2024-12-31T23:00:00)",
+						MinCapacity: 1,
+						MaxCapacity: 2,
+					},
+					{
+						Schedule:    "cron(0 0 * * ? *)",
+						MinCapacity: 1,
+						MaxCapacity: 5,
+					},
+				},
+			},
+		},
+		"invalid schedule": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					Value: &mockRange,
+				},
+				Schedules: []manifest.ScheduledScaling{
+					{
+						Schedule: aws.String("every day"),
+						Range: manifest.Range{
+							Value: &mockRange,
+						},
+					},
+				},
+			},
+			wantedErr: errors.New(`convert "schedules[0]": schedule is not valid cron, rate, or preset: expected exactly 5 fields, found 2: [every day]`),
+		},
 		"returns nil if spot specified": {
 			input: manifest.AdvancedCount{
 				Spot: aws.Int(5),
@@ -741,6 +906,358 @@ func Test_convertPath(t *testing.T) {
 	}
 }
 
+func Test_convertAppRunnerScaling(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.AppRunnerScalingConfig
+		wanted *template.AppRunnerAutoScalingOpts
+	}{
+		"nil if the default auto scaling configuration is used": {},
+		"convert the scaling configuration": {
+			in: manifest.AppRunnerScalingConfig{
+				MaxConcurrency: aws.Int(50),
+				MinInstances:   aws.Int(2),
+				MaxInstances:   aws.Int(10),
+			},
+			wanted: &template.AppRunnerAutoScalingOpts{
+				MaxConcurrency: aws.Int(50),
+				MinSize:        aws.Int(2),
+				MaxSize:        aws.Int(10),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertAppRunnerScaling(tc.in))
+		})
+	}
+}
+
+func Test_convertBackendEndpoints(t *testing.T) {
+	testCases := map[string]struct {
+		in     map[string]string
+		wanted []template.BackendServiceEndpoint
+	}{
+		"nil if there are no backend services": {},
+		"sort the endpoints by service name": {
+			in: map[string]string{
+				"orders-api": "8080",
+				"auth":       "443",
+			},
+			wanted: []template.BackendServiceEndpoint{
+				{
+					Name:     "COPILOT_AUTH_ENDPOINT",
+					Endpoint: "auth.test.my-app.local:443",
+				},
+				{
+					Name:     "COPILOT_ORDERS_API_ENDPOINT",
+					Endpoint: "orders-api.test.my-app.local:8080",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertBackendEndpoints(tc.in, "test.my-app.local"))
+		})
+	}
+}
+
+func Test_convertAliasHealthChecks(t *testing.T) {
+	interval := 10 * time.Second
+	rules := []template.ALBListenerRule{
+		{
+			Path:    "/",
+			Aliases: []string{"example.com", "*.example.com"},
+		},
+		{
+			Path:    "/admin",
+			Aliases: []string{"example.com", "admin.example.com"},
+		},
+	}
+	testCases := map[string]struct {
+		in     manifest.HTTP
+		wanted []template.AliasHealthCheck
+	}{
+		"no health checks if disabled": {
+			in: manifest.HTTP{
+				AliasHealthCheck: manifest.BasicToUnion[*bool, manifest.AliasHealthCheckArgs](aws.Bool(false)),
+			},
+		},
+		"target the path of the first rule of each alias": {
+			in: manifest.HTTP{
+				AliasHealthCheck: manifest.BasicToUnion[*bool, manifest.AliasHealthCheckArgs](aws.Bool(true)),
+			},
+			wanted: []template.AliasHealthCheck{
+				{Domain: "example.com", Path: "/"},
+				{Domain: "admin.example.com", Path: "/admin"},
+			},
+		},
+		"advanced configuration": {
+			in: manifest.HTTP{
+				AliasHealthCheck: manifest.AdvancedToUnion[*bool](manifest.AliasHealthCheckArgs{
+					Path:             aws.String("/ping"),
+					FailureThreshold: aws.Int64(2),
+					Interval:         &interval,
+				}),
+			},
+			wanted: []template.AliasHealthCheck{
+				{Domain: "example.com", Path: "/ping", FailureThreshold: aws.Int64(2), RequestInterval: aws.Int64(10)},
+				{Domain: "admin.example.com", Path: "/ping", FailureThreshold: aws.Int64(2), RequestInterval: aws.Int64(10)},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertAliasHealthChecks(tc.in, rules))
+		})
+	}
+}
+
+func Test_convertHostedZone(t *testing.T) {
+	testCases := map[string]struct {
+		in                  manifest.Alias
+		inDefaultHostedZone *string
+
+		wanted    template.AliasesForHostedZone
+		wantedErr string
+	}{
+		"convert internationalized aliases to punycode": {
+			in: manifest.Alias{
+				AdvancedAliases: []manifest.AdvancedAlias{
+					{Alias: aws.String("bücher.example.com"), HostedZone: aws.String("Z1")},
+					{Alias: aws.String("*.bücher.example.com")},
+					{Alias: aws.String("xn--bcher-kva.example.com"), HostedZone: aws.String("Z1")},
+				},
+			},
+			inDefaultHostedZone: aws.String("Z2"),
+			wanted: template.AliasesForHostedZone{
+				"Z1": {"xn--bcher-kva.example.com"},
+				"Z2": {"*.xn--bcher-kva.example.com"},
+			},
+		},
+		"use the default hosted zone for aliases without one": {
+			in: manifest.Alias{
+				StringSliceOrString: manifest.StringSliceOrString{
+					StringSlice: []string{"*.api.example.com", "api.example.com"},
+				},
+			},
+			inDefaultHostedZone: aws.String("Z2"),
+			wanted: template.AliasesForHostedZone{
+				"Z2": {"*.api.example.com", "api.example.com"},
+			},
+		},
+		"error if an alias is invalid": {
+			in: manifest.Alias{
+				StringSliceOrString: manifest.StringSliceOrString{
+					String: aws.String("api.*.example.com"),
+				},
+			},
+			wantedErr: `alias "api.*.example.com" can only use a wildcard as its first label, for example "*.example.com"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertHostedZone(tc.in, tc.inDefaultHostedZone)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertTrafficMirror(t *testing.T) {
+	testCases := map[string]struct {
+		in manifest.TrafficMirror
+
+		wanted      *template.TrafficMirrorOpts
+		wantedRules []template.ALBListenerRule
+	}{
+		"no traffic mirror": {
+			wantedRules: []template.ALBListenerRule{
+				{Path: "/", TargetContainer: "api", TargetPort: "8080"},
+				{Path: "/admin", TargetContainer: "admin", TargetPort: "9090"},
+			},
+		},
+		"mirror the requests to the target port of another service": {
+			in: manifest.TrafficMirror{
+				Service: aws.String("api-v2"),
+			},
+			wanted: &template.TrafficMirrorOpts{
+				Image:      "envoyproxy/envoy:v1.27.2@sha256:abcd",
+				TargetPort: "8080",
+				Host:       "api-v2.test.my-app.local",
+				Port:       "8080",
+				Percent:    100,
+			},
+			wantedRules: []template.ALBListenerRule{
+				{Path: "/", TargetContainer: "traffic-mirror", TargetPort: "10000"},
+				{Path: "/admin", TargetContainer: "admin", TargetPort: "9090"},
+			},
+		},
+		"mirror a percentage of the requests to a custom port": {
+			in: manifest.TrafficMirror{
+				Service: aws.String("api-v2"),
+				Port:    aws.Uint16(80),
+				Percent: aws.Int(10),
+			},
+			wanted: &template.TrafficMirrorOpts{
+				Image:      "envoyproxy/envoy:v1.27.2@sha256:abcd",
+				TargetPort: "8080",
+				Host:       "api-v2.test.my-app.local",
+				Port:       "80",
+				Percent:    10,
+			},
+			wantedRules: []template.ALBListenerRule{
+				{Path: "/", TargetContainer: "traffic-mirror", TargetPort: "10000"},
+				{Path: "/admin", TargetContainer: "admin", TargetPort: "9090"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			listener := &template.ALBListener{
+				Rules: []template.ALBListenerRule{
+					{Path: "/", TargetContainer: "api", TargetPort: "8080"},
+					{Path: "/admin", TargetContainer: "admin", TargetPort: "9090"},
+				},
+			}
+
+			got := convertTrafficMirror(tc.in, listener, "test.my-app.local", "envoyproxy/envoy:v1.27.2@sha256:abcd")
+
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, tc.wantedRules, listener.Rules)
+		})
+	}
+}
+
+func Test_convertOpenAPIRules(t *testing.T) {
+	spec, err := openapi.Parse([]byte(`
+paths:
+  /pets/{petId}:
+    get: {}
+  /stores/{storeId}/orders:
+    get: {}
+  /ready:
+    get:
+      x-copilot-health-check: true
+`))
+	require.NoError(t, err)
+	testCases := map[string]struct {
+		main  manifest.RoutingRule
+		rules []template.ALBListenerRule
+
+		wanted []template.ALBListenerRule
+	}{
+		"derives the paths and health check path from the spec": {
+			rules: []template.ALBListenerRule{
+				{Path: "/api", TargetContainer: "api", TargetPort: "8080", HTTPHealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/"}},
+				{Path: "/admin", TargetContainer: "admin", TargetPort: "9090"},
+			},
+			wanted: []template.ALBListenerRule{
+				{Path: "/api/pets", TargetContainer: "api", TargetPort: "8080", HTTPHealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/api/ready"}},
+				{Path: "/admin", TargetContainer: "admin", TargetPort: "9090"},
+				{Path: "/api/ready", TargetContainer: "api", TargetPort: "8080", HTTPHealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/api/ready"}, SharedTargetGroup: true},
+				{Path: "/api/stores", TargetContainer: "api", TargetPort: "8080", HTTPHealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/api/ready"}, SharedTargetGroup: true},
+			},
+		},
+		"keeps the health check path of the manifest": {
+			main: manifest.RoutingRule{
+				HealthCheck: manifest.HealthCheckArgsOrString{
+					Union: manifest.BasicToUnion[string, manifest.HTTPHealthCheckArgs]("/healthz"),
+				},
+			},
+			rules: []template.ALBListenerRule{
+				{Path: "/", HTTPHealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/healthz"}},
+			},
+			wanted: []template.ALBListenerRule{
+				{Path: "/pets", HTTPHealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/healthz"}},
+				{Path: "/ready", HTTPHealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/healthz"}, SharedTargetGroup: true},
+				{Path: "/stores", HTTPHealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/healthz"}, SharedTargetGroup: true},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertOpenAPIRules(spec, tc.main, tc.rules)
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertOpenAPIWebACL(t *testing.T) {
+	var paths strings.Builder
+	for i := 0; i < 11; i++ {
+		fmt.Fprintf(&paths, "  /pets/v%d/{petId}: {}\n", i)
+	}
+	testCases := map[string]struct {
+		spec string
+		cfg  manifest.OpenAPI
+		base string
+
+		wanted    *template.OpenAPIWebACL
+		wantedErr string
+	}{
+		"undefined paths aren't blocked": {
+			spec: "paths:\n  /pets: {}\n",
+			cfg:  manifest.OpenAPI{Spec: aws.String("openapi.yml")},
+		},
+		"error if the scope is the whole load balancer": {
+			spec:      "paths:\n  /{tenant}/pets: {}\n",
+			cfg:       manifest.OpenAPI{Spec: aws.String("openapi.yml"), BlockUndefinedPaths: aws.Bool(true)},
+			base:      "/",
+			wantedErr: `convert "http.openapi.block_undefined_paths": path "/{tenant}/pets" of the OpenAPI spec must start with a static segment, or "http.path" must be set`,
+		},
+		"splits the regular expressions into sets of 10": {
+			spec: "paths:\n" + paths.String(),
+			cfg:  manifest.OpenAPI{Spec: aws.String("openapi.yml"), BlockUndefinedPaths: aws.Bool(true)},
+			base: "/",
+			wanted: &template.OpenAPIWebACL{
+				ScopeRegexSets: [][]string{{`^/pets/v0(/.*)?$`, `^/pets/v1(/.*)?$`, `^/pets/v10(/.*)?$`, `^/pets/v2(/.*)?$`, `^/pets/v3(/.*)?$`,
+					`^/pets/v4(/.*)?$`, `^/pets/v5(/.*)?$`, `^/pets/v6(/.*)?$`, `^/pets/v7(/.*)?$`, `^/pets/v8(/.*)?$`}, {`^/pets/v9(/.*)?$`}},
+				PathRegexSets: [][]string{{`^/pets/v0/[^/]+$`, `^/pets/v1/[^/]+$`, `^/pets/v10/[^/]+$`, `^/pets/v2/[^/]+$`, `^/pets/v3/[^/]+$`,
+					`^/pets/v4/[^/]+$`, `^/pets/v5/[^/]+$`, `^/pets/v6/[^/]+$`, `^/pets/v7/[^/]+$`, `^/pets/v8/[^/]+$`}, {`^/pets/v9/[^/]+$`}},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			spec, err := openapi.Parse([]byte(tc.spec))
+			require.NoError(t, err)
+
+			got, err := convertOpenAPIWebACL(spec, tc.cfg, tc.base)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertEnvVars(t *testing.T) {
+	in := map[string]manifest.Variable{
+		"LOG_LEVEL": {StringOrFromCFN: manifest.StringOrFromCFN{Plain: aws.String("debug")}},
+		"build_only": {
+			BuildOnly: map[string]manifest.BuildArg{
+				"NPM_REGISTRY": {Plain: aws.String("https://registry.example.com")},
+			},
+		},
+	}
+
+	got := convertEnvVars(in)
+
+	require.Equal(t, map[string]template.Variable{
+		"LOG_LEVEL": template.PlainVariable("debug"),
+	}, got)
+}
+
 func Test_convertTaskDefOverrideRules(t *testing.T) {
 	testCases := map[string]struct {
 		inRule []manifest.OverrideRule
@@ -1384,6 +1901,46 @@ func Test_convertExecuteCommand(t *testing.T) {
 	}
 }
 
+func Test_convertScaleInProtection(t *testing.T) {
+	sixHours := 6 * time.Hour
+	testCases := map[string]struct {
+		inConfig manifest.Union[*bool, manifest.ScaleInProtectionArgs]
+
+		wanted *template.ScaleInProtectionOpts
+	}{
+		"without scale-in protection": {
+			wanted: nil,
+		},
+		"disabled": {
+			inConfig: manifest.BasicToUnion[*bool, manifest.ScaleInProtectionArgs](aws.Bool(false)),
+			wanted:   nil,
+		},
+		"enabled with the default expiration": {
+			inConfig: manifest.BasicToUnion[*bool, manifest.ScaleInProtectionArgs](aws.Bool(true)),
+			wanted: &template.ScaleInProtectionOpts{
+				ExpiresInMinutes: 120,
+			},
+		},
+		"enabled with a custom expiration": {
+			inConfig: manifest.AdvancedToUnion[*bool](manifest.ScaleInProtectionArgs{
+				ExpiresIn: &sixHours,
+			}),
+			wanted: &template.ScaleInProtectionOpts{
+				ExpiresInMinutes: 360,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertScaleInProtection(&manifest.TaskConfig{
+				ScaleInProtection: tc.inConfig,
+			})
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func Test_convertSidecarMountPoints(t *testing.T) {
 	testCases := map[string]struct {
 		inMountPoints  []manifest.SidecarMountPoint
@@ -1607,6 +2164,7 @@ func Test_convertPublish(t *testing.T) {
 
 func Test_convertSubscribe(t *testing.T) {
 	duration111Seconds := 111 * time.Second
+	duration20Seconds := 20 * time.Second
 	mockStruct := map[string]interface{}{
 		"store": []string{"example_corp"},
 	}
@@ -2162,6 +2720,92 @@ func Test_convertSubscribe(t *testing.T) {
 				},
 			},
 		},
+		"consumer with the default visibility timeout": {
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Topics: []manifest.TopicSubscription{
+							{
+								Name:    aws.String("name"),
+								Service: aws.String("svc"),
+							},
+						},
+						Consumer: manifest.SQSConsumer{
+							MaxConcurrentMessages: aws.Int(20),
+							BatchSize:             aws.Int(10),
+							ProcessingTimeout:     &duration20Seconds,
+							PartialBatchFailures:  aws.Bool(true),
+							DeduplicationTable: manifest.DeduplicationTableOrBool{
+								Advanced: manifest.DeduplicationTable{
+									TTL: &duration111Seconds,
+								},
+							},
+						},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Topics: []*template.TopicSubscription{
+					{
+						Name:    aws.String("name"),
+						Service: aws.String("svc"),
+					},
+				},
+				Consumer: &template.SQSConsumer{
+					MaxConcurrentMessages: aws.Int(20),
+					BatchSize:             aws.Int(10),
+					ProcessingTimeout:     aws.Int64(20),
+					VisibilityTimeout:     30,
+					PartialBatchFailures:  true,
+					DeduplicationTable: &template.DeduplicationTable{
+						TTL: 111,
+					},
+				},
+			},
+		},
+		"consumer with the visibility timeout of the queue": {
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Topics: []manifest.TopicSubscription{
+							{
+								Name:    aws.String("name"),
+								Service: aws.String("svc"),
+							},
+						},
+						Queue: manifest.SQSQueue{
+							Timeout: &duration111Seconds,
+						},
+						Consumer: manifest.SQSConsumer{
+							BatchSize:            aws.Int(5),
+							MessageGroupStrategy: aws.String("concurrent"),
+							DeduplicationTable: manifest.DeduplicationTableOrBool{
+								Enabled: aws.Bool(true),
+							},
+						},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Topics: []*template.TopicSubscription{
+					{
+						Name:    aws.String("name"),
+						Service: aws.String("svc"),
+					},
+				},
+				Queue: &template.SQSQueue{
+					Timeout: aws.Int64(111),
+				},
+				Consumer: &template.SQSConsumer{
+					BatchSize:            aws.Int(5),
+					VisibilityTimeout:    111,
+					MessageGroupStrategy: aws.String("concurrent"),
+					DeduplicationTable: &template.DeduplicationTable{
+						TTL: 86400,
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2242,6 +2886,54 @@ func Test_convertDeploymentConfig(t *testing.T) {
 	}
 }
 
+func Test_convertDeploymentStrategy(t *testing.T) {
+	interval, bakeTime := 15*time.Minute, time.Hour
+	testCases := map[string]struct {
+		in  manifest.DeploymentStrategy
+		out *template.DeploymentStrategyOpts
+	}{
+		"nil if the service is deployed with rolling updates": {},
+		"blue/green deployment with the default bake time": {
+			in: manifest.DeploymentStrategy{
+				Type: aws.String(manifest.BlueGreenDeploymentStrategy),
+			},
+			out: &template.DeploymentStrategyOpts{
+				BakeTime: 5,
+			},
+		},
+		"canary deployment with the defaults": {
+			in: manifest.DeploymentStrategy{
+				Type: aws.String(manifest.CanaryDeploymentStrategy),
+			},
+			out: &template.DeploymentStrategyOpts{
+				CanaryPercent:  10,
+				CanaryInterval: 5,
+				BakeTime:       5,
+			},
+		},
+		"canary deployment with custom traffic shifting": {
+			in: manifest.DeploymentStrategy{
+				Type: aws.String(manifest.CanaryDeploymentStrategy),
+				Canary: manifest.CanaryConfig{
+					Percent:  aws.Int(25),
+					Interval: &interval,
+				},
+				BakeTime: &bakeTime,
+			},
+			out: &template.DeploymentStrategyOpts{
+				CanaryPercent:  25,
+				CanaryInterval: 15,
+				BakeTime:       60,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.out, convertDeploymentStrategy(tc.in))
+		})
+	}
+}
+
 func Test_convertWorkerDeploymentConfig(t *testing.T) {
 	testCases := map[string]struct {
 		in  manifest.WorkerDeploymentConfig
@@ -2289,12 +2981,76 @@ func Test_convertWorkerDeploymentConfig(t *testing.T) {
 	}
 }
 
+func Test_convertObservability(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.Observability
+		wanted template.ObservabilityOpts
+	}{
+		"no tracing": {},
+		"tracing with aws-xray": {
+			in: manifest.Observability{
+				Tracing: aws.String("awsxray"),
+			},
+			wanted: template.ObservabilityOpts{
+				Tracing:        template.TracingAWSXRay,
+				CollectorImage: "aws-otel-collector:v1",
+			},
+		},
+		"tracing with otlp exports to the endpoint": {
+			in: manifest.Observability{
+				Tracing:  aws.String("otlp"),
+				Endpoint: aws.String("https://otlp.example.com:4318"),
+			},
+			wanted: template.ObservabilityOpts{
+				Tracing:        template.TracingOTLP,
+				OTLPEndpoint:   "https://otlp.example.com:4318",
+				CollectorImage: "aws-otel-collector:v1",
+			},
+		},
+		"anomaly detection on the latency": {
+			in: manifest.Observability{
+				AnomalyDetection: []string{"latency"},
+			},
+			wanted: template.ObservabilityOpts{
+				AnomalyDetection: &template.AnomalyDetectionOpts{
+					Latency: true,
+				},
+			},
+		},
+		"anomaly detection on the latency and the errors": {
+			in: manifest.Observability{
+				AnomalyDetection: []string{"errors", "latency"},
+			},
+			wanted: template.ObservabilityOpts{
+				AnomalyDetection: &template.AnomalyDetectionOpts{
+					Latency: true,
+					Errors:  true,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertObservability(tc.in, "aws-otel-collector:v1"))
+		})
+	}
+}
+
 func Test_convertPlatform(t *testing.T) {
 	testCases := map[string]struct {
 		in  manifest.PlatformArgsOrString
 		out template.RuntimePlatformOpts
 	}{
 		"should return empty struct if user did not set a platform field in the manifest": {},
+		"should return linux and x86_64 for multiarch images": {
+			in: manifest.PlatformArgsOrString{
+				PlatformString: (*manifest.PlatformString)(aws.String(manifest.PlatformMultiArch)),
+			},
+			out: template.RuntimePlatformOpts{
+				OS:   template.OSLinux,
+				Arch: template.ArchX86,
+			},
+		},
 		"should return windows server 2019 full and x86_64 when advanced config specifies 2019 full": {
 			in: manifest.PlatformArgsOrString{
 				PlatformArgs: manifest.PlatformArgs{
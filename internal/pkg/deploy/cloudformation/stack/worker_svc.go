@@ -5,7 +5,6 @@ package stack
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 
@@ -142,11 +141,13 @@ func (s *WorkerService) Template() (string, error) {
 		CapacityProviders:        capacityProviders,
 		DesiredCountOnSpot:       desiredCountOnSpot,
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
+		ScaleInProtection:        convertScaleInProtection(&s.manifest.TaskConfig),
 		WorkloadType:             manifestinfo.WorkerServiceType,
 		HealthCheck:              convertContainerHealthCheck(s.manifest.WorkerServiceConfig.ImageConfig.HealthCheck),
-		LogConfig:                convertLogging(s.manifest.Logging),
+		LogConfig:                convertLogging(s.manifest.Logging, s.rc.helperImage(template.HelperImageFluentBit)),
 		DockerLabels:             s.manifest.ImageConfig.Image.DockerLabels,
 		CustomResources:          crs,
+		StopTimeout:              convertTime(s.manifest.ImageConfig.Image.StopTimeout),
 		Storage:                  convertStorageOpts(s.manifest.Name, s.manifest.Storage),
 		Network:                  convertNetworkConfig(s.manifest.Network),
 		DeploymentConfiguration:  convertWorkerDeploymentConfig(s.manifest.WorkerServiceConfig.DeployConfig),
@@ -159,10 +160,8 @@ func (s *WorkerService) Template() (string, error) {
 		Subscribe:                subscribe,
 		Publish:                  publishers,
 		Platform:                 convertPlatform(s.manifest.Platform),
-		Observability: template.ObservabilityOpts{
-			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
-		},
-		PermissionsBoundary: s.permBound,
+		Observability:            convertObservability(s.manifest.Observability, s.rc.helperImage(template.HelperImageOTelCollector)),
+		PermissionsBoundary:      s.permBound,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse worker service template: %w", err)
@@ -76,9 +76,12 @@ type RuntimeConfig struct {
 	EnvFileARNs        map[string]string   // Optional. S3 object ARNs for any env files. Map keys are container names.
 	AdditionalTags     map[string]string   // AdditionalTags are labels applied to resources in the workload stack.
 	CustomResourcesURL map[string]string   // Mapping of Custom Resource Function Name to the S3 URL where the function zip file is stored.
+	CodeRepository     CodeRepository      // Optional. Remote repository and branch of the workspace that App Runner builds source code from.
+	HelperImages       map[string]string   // Optional. Locations of the helper images that Copilot injects, pinned by digest. Map keys are image names.
 
 	// The target environment metadata.
-	ServiceDiscoveryEndpoint string // Endpoint for the service discovery namespace in the environment.
+	ServiceDiscoveryEndpoint string            // Endpoint for the service discovery namespace in the environment.
+	BackendServicePorts      map[string]string // Optional. Ports that the Backend Services deployed in the environment register in the namespace. Map keys are service names.
 	AccountID                string
 	Region                   string
 	EnvVersion               string
@@ -95,6 +98,17 @@ func (cfg *RuntimeConfig) loadCustomResourceURLs(bucket string, crs []uploadable
 	}
 }
 
+// helperImage returns the location of the helper image with the name, which defaults to the tag of the image if it isn't pinned.
+func (cfg *RuntimeConfig) helperImage(name string) string {
+	return template.HelperImageURI(name, cfg.HelperImages)
+}
+
+// CodeRepository represents the branch of a remote source code repository.
+type CodeRepository struct {
+	URL    string // URL of the repository, such as "https://github.com/user/repo".
+	Branch string
+}
+
 // ECRImage represents configuration about the pushed ECR image that is needed to
 // create a CloudFormation stack.
 type ECRImage struct {
@@ -397,6 +411,7 @@ type appRunnerWkld struct {
 	*wkld
 	instanceConfig    manifest.AppRunnerInstanceConfig
 	imageConfig       manifest.ImageWithPort
+	sourceConfig      manifest.AppRunnerSource
 	healthCheckConfig manifest.HealthCheckArgsOrString
 }
 
@@ -406,21 +421,31 @@ func (w *appRunnerWkld) Parameters() ([]*cloudformation.Parameter, error) {
 	if err != nil {
 		return nil, err
 	}
-	var img string
-	if w.image != nil {
-		img = w.image.GetLocation()
-	}
-	if w.rc.PushedImages != nil {
-		img = w.rc.PushedImages[w.name].URI()
-	}
+	// Services deployed from source code don't have an image.
+	var imageRepositoryType string
+	port := w.sourceConfig.Port
+	if w.sourceConfig.IsEmpty() {
+		var img string
+		if w.image != nil {
+			img = w.image.GetLocation()
+		}
+		if w.rc.PushedImages != nil {
+			img = w.rc.PushedImages[w.name].URI()
+		}
 
-	imageRepositoryType, err := apprunner.DetermineImageRepositoryType(img)
-	if err != nil {
-		return nil, fmt.Errorf("determine image repository type: %w", err)
+		imageRepositoryType, err = apprunner.DetermineImageRepositoryType(img)
+		if err != nil {
+			return nil, fmt.Errorf("determine image repository type: %w", err)
+		}
+
+		if w.imageConfig.Port == nil {
+			return nil, fmt.Errorf("field `image.port` is required for Request Driven Web Services")
+		}
+		port = w.imageConfig.Port
 	}
 
-	if w.imageConfig.Port == nil {
-		return nil, fmt.Errorf("field `image.port` is required for Request Driven Web Services")
+	if port == nil {
+		return nil, fmt.Errorf("field `source.port` is required for Request Driven Web Services deployed from source code")
 	}
 
 	if w.instanceConfig.CPU == nil {
@@ -438,7 +463,7 @@ func (w *appRunnerWkld) Parameters() ([]*cloudformation.Parameter, error) {
 		},
 		{
 			ParameterKey:   aws.String(WorkloadContainerPortParamKey),
-			ParameterValue: aws.String(strconv.Itoa(int(aws.Uint16Value(w.imageConfig.Port)))),
+			ParameterValue: aws.String(strconv.Itoa(int(aws.Uint16Value(port)))),
 		},
 		{
 			ParameterKey:   aws.String(RDWkldInstanceCPUParamKey),
@@ -6,6 +6,7 @@ package cloudformation
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -57,6 +58,8 @@ func (cf CloudFormation) ListTaskStacks(appName, envName string) ([]deploy.TaskS
 			Env:       envName,
 
 			RoleARN: aws.StringValue(task.RoleARN),
+
+			LastUpdated: lastUpdatedTime(task),
 		})
 	}
 	return outputTaskStacks, nil
@@ -119,7 +122,8 @@ func (cf CloudFormation) ListDefaultTaskStacks() ([]deploy.TaskStackInfo, error)
 			continue
 		}
 		outputTaskStacks = append(outputTaskStacks, deploy.TaskStackInfo{
-			StackName: aws.StringValue(task.StackName),
+			StackName:   aws.StringValue(task.StackName),
+			LastUpdated: lastUpdatedTime(task),
 		})
 	}
 	return outputTaskStacks, nil
@@ -133,3 +137,11 @@ func (cf CloudFormation) DeleteTask(task deploy.TaskStackInfo) error {
 	}
 	return cf.cfnClient.DeleteAndWait(task.StackName)
 }
+
+// lastUpdatedTime returns the last time the stack was updated, or its creation time if it was never updated.
+func lastUpdatedTime(stack cloudformation.StackDescription) time.Time {
+	if stack.LastUpdatedTime != nil {
+		return aws.TimeValue(stack.LastUpdatedTime)
+	}
+	return aws.TimeValue(stack.CreationTime)
+}
@@ -6,6 +6,7 @@ package cloudformation
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -124,6 +125,36 @@ func TestCloudFormation_ListTaskStacks(t *testing.T) {
 				},
 			},
 		},
+		"sets the last updated time of the stacks": {
+			inAppName: "appname",
+			mockClient: func(m *mocks.MockcfnClient) {
+				m.EXPECT().ListStacksWithTags(gomock.Any()).Return([]cloudformation.StackDescription{
+					{
+						StackName:    aws.String("task-created"),
+						CreationTime: aws.Time(time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)),
+					},
+					{
+						StackName:       aws.String("task-updated"),
+						CreationTime:    aws.Time(time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)),
+						LastUpdatedTime: aws.Time(time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)),
+					},
+				}, nil)
+			},
+			wantedTasks: []deploy.TaskStackInfo{
+				{
+					StackName:   "task-created",
+					App:         "appname",
+					Env:         "test",
+					LastUpdated: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					StackName:   "task-updated",
+					App:         "appname",
+					Env:         "test",
+					LastUpdated: time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
 		"error listing stacks": {
 			inAppName: "appname",
 			mockClient: func(m *mocks.MockcfnClient) {
@@ -4,12 +4,16 @@
 package cloudformation
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"golang.org/x/sync/errgroup"
 )
 
 // DeployService deploys a service stack and renders progress updates to out until the deployment is done.
@@ -27,7 +31,64 @@ func (cf CloudFormation) DeployService(conf StackConfiguration, bucketName strin
 	for _, opt := range opts {
 		opt(stack)
 	}
-	return cf.executeAndRenderChangeSet(cf.newUpsertChangeSetInput(cf.console, stack, withEnableInterrupt(), withDetach(detach)))
+	return cf.executeAndRenderChangeSet(cf.newUpsertChangeSetInput(cf.console, stack, withEnableInterrupt(), withDetach(detach), withChangeSetOnly(stack.CreateChangeSetOnly)))
+}
+
+// WaitForWorkloadStacks renders the progress of the workload stacks that are being deployed together until they're all done.
+// It returns the error of each stack that failed to deploy by stack name. Stacks that aren't being deployed are ignored.
+func (cf CloudFormation) WaitForWorkloadStacks(stackNames []string) (map[string]error, error) {
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), waitForStackTimeout)
+	defer cancelWait()
+	g, ctx := errgroup.WithContext(waitCtx)
+	var inProgress []string
+	var renderers []progress.DynamicRenderer
+	for _, name := range stackNames {
+		stack, err := cf.cfnClient.Describe(name)
+		if err != nil {
+			return nil, fmt.Errorf("describe stack %q: %w", name, err)
+		}
+		if !cloudformation.StackStatus(aws.StringValue(stack.StackStatus)).InProgress() {
+			continue
+		}
+		body, err := cf.cfnClient.TemplateBody(name)
+		if err != nil {
+			return nil, fmt.Errorf("get template body of stack %q: %w", name, err)
+		}
+		descriptionFor, err := cloudformation.ParseTemplateDescriptions(body)
+		if err != nil {
+			return nil, fmt.Errorf("parse resource descriptions in template of stack %q: %w", name, err)
+		}
+		startTime := aws.TimeValue(stack.CreationTime)
+		if stack.LastUpdatedTime != nil {
+			startTime = aws.TimeValue(stack.LastUpdatedTime)
+		}
+		renderers = append(renderers, cf.stackRenderer(ctx, renderStackInput{
+			group:          g,
+			stackName:      name,
+			stackID:        aws.StringValue(stack.StackId),
+			description:    fmt.Sprintf("Deploying the infrastructure for stack %s", name),
+			descriptionFor: descriptionFor,
+			startTime:      startTime,
+		}))
+		inProgress = append(inProgress, name)
+	}
+	if len(inProgress) == 0 {
+		return nil, nil
+	}
+	g.Go(func() error {
+		_, err := progress.Render(ctx, progress.NewTabbedFileWriter(cf.console), progress.MultiRenderer(renderers...))
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	failed := make(map[string]error)
+	for _, name := range inProgress {
+		if err := cf.errOnFailedStack(name); err != nil {
+			failed[name] = err
+		}
+	}
+	return failed, nil
 }
 
 type uploadableStack interface {
@@ -4,8 +4,11 @@
 package cloudformation
 
 import (
+	"bytes"
 	"errors"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 
@@ -104,6 +107,140 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	t.Run("renders a stack with addons template if stack creation is successful", func(t *testing.T) {
 		testDeployWorkload_RenderNewlyCreatedStackWithAddons(t, "myapp-myenv-mysvc", when)
 	})
+	t.Run("only creates the change set if it shouldn't be executed", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mS3Client := mocks.NewMocks3Client(ctrl)
+		mS3Client.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockURL", nil)
+		mockCFN := mocks.NewMockcfnClient(ctrl)
+		mockCFN.EXPECT().Create(gomock.Any()).DoAndReturn(func(stack *cloudformation.Stack) (string, error) {
+			require.True(t, stack.CreateChangeSetOnly)
+			return "1234", nil
+		})
+		client := CloudFormation{cfnClient: mockCFN, s3Client: mS3Client, console: new(discardFile),
+			notifySignals: func() chan os.Signal {
+				return make(chan os.Signal, 1)
+			},
+		}
+
+		// WHEN
+		err := client.DeployService(serviceConfig, "mockBucket", false, cloudformation.WithCreateChangeSetOnly())
+
+		// THEN
+		require.NoError(t, err)
+	})
+}
+
+func TestCloudFormation_WaitForWorkloadStacks(t *testing.T) {
+	startTime := time.Now()
+	stackEvents := func(stackName, status string) *sdkcloudformation.DescribeStackEventsOutput {
+		return &sdkcloudformation.DescribeStackEventsOutput{
+			StackEvents: []*sdkcloudformation.StackEvent{
+				{
+					EventId:            aws.String(stackName + "-1"),
+					LogicalResourceId:  aws.String(stackName),
+					PhysicalResourceId: aws.String("AWS::CloudFormation::Stack"),
+					ResourceStatus:     aws.String(status),
+					Timestamp:          aws.Time(startTime.Add(time.Second)),
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		callMocks func(m *mocks.MockcfnClient)
+
+		wantedFailures map[string]string
+		wantedErr      error
+	}{
+		"returns a wrapped error if a stack can't be described": {
+			callMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("app-test-api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New(`describe stack "app-test-api": some error`),
+		},
+		"returns a wrapped error if the template of a stack can't be retrieved": {
+			callMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("app-test-api").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_IN_PROGRESS"),
+				}, nil)
+				m.EXPECT().TemplateBody("app-test-api").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New(`get template body of stack "app-test-api": some error`),
+		},
+		"ignores the stacks that aren't being deployed": {
+			callMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe("app-test-api").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_COMPLETE"),
+				}, nil)
+				m.EXPECT().Describe("app-test-worker").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_ROLLBACK_COMPLETE"),
+				}, nil)
+			},
+		},
+		"waits for the stacks and returns the ones that failed": {
+			callMocks: func(m *mocks.MockcfnClient) {
+				gomock.InOrder(
+					m.EXPECT().Describe("app-test-api").Return(&cloudformation.StackDescription{
+						StackId:         aws.String("arn:aws:cloudformation:us-west-2:1111:stack/app-test-api/1"),
+						StackStatus:     aws.String("UPDATE_IN_PROGRESS"),
+						LastUpdatedTime: aws.Time(startTime),
+					}, nil),
+					m.EXPECT().Describe("app-test-api").Return(&cloudformation.StackDescription{
+						StackStatus: aws.String("UPDATE_COMPLETE"),
+					}, nil),
+				)
+				gomock.InOrder(
+					m.EXPECT().Describe("app-test-worker").Return(&cloudformation.StackDescription{
+						StackId:      aws.String("arn:aws:cloudformation:us-west-2:1111:stack/app-test-worker/1"),
+						StackStatus:  aws.String("CREATE_IN_PROGRESS"),
+						CreationTime: aws.Time(startTime),
+					}, nil),
+					m.EXPECT().Describe("app-test-worker").Return(&cloudformation.StackDescription{
+						StackStatus: aws.String("ROLLBACK_COMPLETE"),
+					}, nil),
+				)
+				m.EXPECT().TemplateBody(gomock.Any()).Return("", nil).Times(2)
+				m.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
+					StackName: aws.String("arn:aws:cloudformation:us-west-2:1111:stack/app-test-api/1"),
+				}).Return(stackEvents("app-test-api", "UPDATE_COMPLETE"), nil).AnyTimes()
+				m.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
+					StackName: aws.String("arn:aws:cloudformation:us-west-2:1111:stack/app-test-worker/1"),
+				}).Return(stackEvents("app-test-worker", "ROLLBACK_COMPLETE"), nil).AnyTimes()
+				m.EXPECT().RootCause("app-test-worker").Return(nil, nil)
+			},
+			wantedFailures: map[string]string{
+				"app-test-worker": "stack app-test-worker did not complete successfully and exited with status ROLLBACK_COMPLETE",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.callMocks(m)
+			cf := CloudFormation{
+				cfnClient: m,
+				console:   mockFileWriter{Writer: new(bytes.Buffer)},
+			}
+
+			// WHEN
+			failed, err := cf.WaitForWorkloadStacks([]string{"app-test-api", "app-test-worker"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, failed, len(tc.wantedFailures))
+			for stackName, wanted := range tc.wantedFailures {
+				require.EqualError(t, failed[stackName], wanted)
+			}
+		})
+	}
 }
 
 func TestCloudFormation_DeleteWorkload(t *testing.T) {
@@ -14,7 +14,9 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
@@ -35,6 +37,12 @@ const (
 	DefaultPipelineBranch = "main"
 	// StageFullNamePrefix is prefix to a pipeline stage name. For example, "DeployTo-test" for a test environment stage.
 	StageFullNamePrefix = "DeployTo-"
+	// PipelineTestCommandsImage is the image of the CodeBuild projects that run the test commands of the stages.
+	PipelineTestCommandsImage = defaultPipelineBuildImage
+
+	// CodePipeline stores the artifacts of a pipeline under the first characters of its name.
+	pipelineArtifactsPrefixMaxLen = 20
+	pipelineArtifactsRulePrefix   = "ExpirePipelineArtifacts-"
 )
 
 // Name of the environment variables injected into the CodeBuild projects that support pre/post-deployment actions.
@@ -123,6 +131,9 @@ func (b *Build) Init(mfBuild *manifest.Build, mfDirPath string) error {
 	b.Image = image
 	b.EnvironmentType = environmentType
 	b.BuildspecPath = filepath.ToSlash(path) // Buildspec path must be with '/' because CloudFormation expects forward-slash separated file path.
+	b.Variables = map[string]string{
+		PipelineBuildspecPathEnvVar: b.BuildspecPath, // Recorded in the provenance of the images built by the pipeline.
+	}
 
 	return nil
 }
@@ -149,6 +160,21 @@ func (a *ArtifactBucket) Region() (string, error) {
 	return parsedArn.Region, nil
 }
 
+// PipelineArtifactsPrefix returns the prefix of the objects that CodePipeline stores in the artifact buckets
+// for the pipeline with the resource name. Pipelines whose names start with the same 20 characters share the prefix.
+func PipelineArtifactsPrefix(pipelineResourceName string) string {
+	if len(pipelineResourceName) > pipelineArtifactsPrefixMaxLen {
+		pipelineResourceName = pipelineResourceName[:pipelineArtifactsPrefixMaxLen]
+	}
+	return pipelineResourceName + "/"
+}
+
+// PipelineArtifactsLifecycleRuleID returns the ID of the lifecycle rule of the artifact buckets that expires
+// the objects under the prefix of the pipeline.
+func PipelineArtifactsLifecycleRuleID(pipelineResourceName string) string {
+	return pipelineArtifactsRulePrefix + strings.TrimSuffix(PipelineArtifactsPrefix(pipelineResourceName), "/")
+}
+
 // GitHubV1Source defines the source of the artifacts to be built and deployed. This version uses personal access tokens
 // and is not recommended. https://docs.aws.amazon.com/codepipeline/latest/userguide/update-github-action-connections.html
 type GitHubV1Source struct {
@@ -492,13 +518,18 @@ type associatedEnvironment struct {
 // test commands, if the user has opted to add any.
 type PipelineStage struct {
 	*associatedEnvironment
-	requiresApproval  bool
+	approvals         int
+	soakTime          time.Duration
+	alarms            []string
 	testCommands      []string
 	execRoleARN       string
 	envManagerRoleARN string
 	preDeployments    manifest.PrePostDeployments
 	deployments       manifest.Deployments
 	postDeployments   manifest.PrePostDeployments
+
+	prevEnv               *associatedEnvironment // Environment of the stage that changes are promoted from.
+	prevEnvManagerRoleARN string
 }
 
 // Init populates the fields in PipelineStage against a target environment,
@@ -521,12 +552,32 @@ func (stg *PipelineStage) Init(env *config.Environment, mftStage *manifest.Pipel
 	stg.preDeployments = mftStage.PreDeployments
 	stg.deployments = deployments
 	stg.postDeployments = mftStage.PostDeployments
-	stg.requiresApproval = mftStage.RequiresApproval
+	stg.approvals = 0
+	if mftStage.RequiresApproval {
+		stg.approvals = 1
+	}
+	stg.soakTime = 0
+	stg.alarms = nil
+	if promotion := mftStage.Promotion; promotion != nil {
+		if promotion.Approvals != nil {
+			stg.approvals = aws.IntValue(promotion.Approvals)
+		}
+		if promotion.SoakTime != nil {
+			stg.soakTime = *promotion.SoakTime
+		}
+		stg.alarms = promotion.Alarms
+	}
 	stg.testCommands = mftStage.TestCommands
 	stg.execRoleARN = env.ExecutionRoleARN
 	stg.envManagerRoleARN = env.ManagerRoleARN
 }
 
+// PromoteFrom sets the stage that the pipeline promotes changes from to this stage.
+func (stg *PipelineStage) PromoteFrom(prev *PipelineStage) {
+	stg.prevEnv = prev.associatedEnvironment
+	stg.prevEnvManagerRoleARN = prev.envManagerRoleARN
+}
+
 // Name returns the stage's name.
 func (stg *PipelineStage) Name() string {
 	return stg.associatedEnvironment.Name
@@ -537,17 +588,51 @@ func (stg *PipelineStage) FullName() string {
 	return StageFullNamePrefix + stg.associatedEnvironment.Name
 }
 
+// PromotionChecks returns an action that waits for the soak time of the stage and checks the alarms of the previous stage.
+// If the stage does not have a soak time or alarms, or if there is no previous stage, then returns nil.
+func (stg *PipelineStage) PromotionChecks() *PromotionChecksAction {
+	if stg.prevEnv == nil || (stg.soakTime == 0 && len(stg.alarms) == 0) {
+		return nil
+	}
+	return &PromotionChecksAction{
+		soakTime:          stg.soakTime,
+		alarms:            stg.alarms,
+		envName:           stg.prevEnv.Name,
+		region:            stg.prevEnv.Region,
+		envManagerRoleARN: stg.prevEnvManagerRoleARN,
+	}
+}
+
 // Approval returns a manual approval action for the stage.
 // If the stage does not require approval, then returns nil.
 func (stg *PipelineStage) Approval() *ManualApprovalAction {
-	if !stg.requiresApproval {
+	if stg.approvals == 0 {
 		return nil
 	}
+	var prevActions []orderedRunner
+	if checks := stg.PromotionChecks(); checks != nil {
+		prevActions = append(prevActions, checks)
+	}
 	return &ManualApprovalAction{
-		name: stg.associatedEnvironment.Name,
+		action: action{
+			prevActions: prevActions,
+		},
+		name:      stg.associatedEnvironment.Name,
+		approvals: stg.approvals,
 	}
 }
 
+// promotionActions returns the last actions that gate the promotion of changes to the stage.
+func (stg *PipelineStage) promotionActions() []orderedRunner {
+	if approval := stg.Approval(); approval != nil {
+		return []orderedRunner{approval}
+	}
+	if checks := stg.PromotionChecks(); checks != nil {
+		return []orderedRunner{checks}
+	}
+	return nil
+}
+
 // Region returns the AWS region name, such as "us-west-2", where the deployments will occur.
 func (stg *PipelineStage) Region() string {
 	return stg.associatedEnvironment.Region
@@ -568,10 +653,7 @@ func (stg *PipelineStage) PreDeployments() ([]PrePostDeployAction, error) {
 	if len(stg.preDeployments) == 0 {
 		return nil, nil
 	}
-	var prevActions []orderedRunner
-	if approval := stg.Approval(); approval != nil {
-		prevActions = append(prevActions, approval)
-	}
+	prevActions := stg.promotionActions()
 
 	var actionGraphNodes []actionGraphNode
 	for name, action := range stg.preDeployments {
@@ -620,10 +702,7 @@ func (stg *PipelineStage) Deployments() ([]DeployAction, error) {
 	if len(stg.deployments) == 0 {
 		return nil, nil
 	}
-	var prevActions []orderedRunner
-	if approval := stg.Approval(); approval != nil {
-		prevActions = append(prevActions, approval)
-	}
+	prevActions := stg.promotionActions()
 	preDeployActions, err := stg.PreDeployments()
 	if err != nil {
 		return nil, err
@@ -674,10 +753,7 @@ func (stg *PipelineStage) PostDeployments() ([]PrePostDeployAction, error) {
 		return nil, nil
 	}
 
-	var prevActions []orderedRunner
-	if approval := stg.Approval(); approval != nil {
-		prevActions = append(prevActions, approval)
-	}
+	prevActions := stg.promotionActions()
 	preDeployActions, err := stg.PreDeployments()
 	if err != nil {
 		return nil, err
@@ -743,10 +819,7 @@ func (stg *PipelineStage) Test() (*TestCommandsAction, error) {
 		return nil, nil
 	}
 
-	var prevActions []orderedRunner
-	if approval := stg.Approval(); approval != nil {
-		prevActions = append(prevActions, approval)
-	}
+	prevActions := stg.promotionActions()
 	preDeployActions, err := stg.PreDeployments()
 	if err != nil {
 		return nil, err
@@ -820,7 +893,8 @@ func (a *action) RunOrder() int {
 // ManualApprovalAction represents a stage approval action.
 type ManualApprovalAction struct {
 	action
-	name string // Name of the stage to approve.
+	name      string // Name of the stage to approve.
+	approvals int    // Number of approvals required, each approval is a separate action.
 }
 
 // Name returns the name of the CodePipeline approval action for the stage.
@@ -828,6 +902,69 @@ func (a *ManualApprovalAction) Name() string {
 	return fmt.Sprintf("ApprovePromotionTo-%s", a.name)
 }
 
+// Names returns the names of the CodePipeline approval actions for the stage, which run in parallel.
+func (a *ManualApprovalAction) Names() []string {
+	if a.approvals <= 1 {
+		return []string{a.Name()}
+	}
+	names := make([]string, a.approvals)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s-%d", a.Name(), i+1)
+	}
+	return names
+}
+
+// PromotionChecksAction represents a CodePipeline action of category "Test" that waits for changes to soak
+// in the previous stage and fails if any of its alarms are firing.
+type PromotionChecksAction struct {
+	action
+	soakTime          time.Duration
+	alarms            []string
+	envName           string // Name of the previous stage's environment.
+	region            string
+	envManagerRoleARN string
+}
+
+// Name returns the name of the promotion checks action.
+func (a *PromotionChecksAction) Name() string {
+	return "PromotionChecks"
+}
+
+// TimeoutInMinutes returns the timeout of the CodeBuild project that runs the checks.
+func (a *PromotionChecksAction) TimeoutInMinutes() int {
+	const checksMinutes = 15 // Time to provision the build container and describe the alarms.
+	return int(a.soakTime.Round(time.Minute).Minutes()) + checksMinutes
+}
+
+// Commands returns the list of commands that wait for the soak time and fail if any of the alarms are firing.
+func (a *PromotionChecksAction) Commands() []string {
+	var cmds []string
+	if a.soakTime > 0 {
+		cmds = append(cmds, fmt.Sprintf("sleep %d", int(a.soakTime.Seconds())))
+	}
+	if len(a.alarms) == 0 {
+		return cmds
+	}
+	alarms := make([]string, len(a.alarms))
+	for i, alarm := range a.alarms {
+		alarms[i] = shellQuote(alarm)
+	}
+	// The alarms are described with the environment manager role of the previous stage, which may be in another account.
+	const profile = "promotion"
+	return append(cmds,
+		fmt.Sprintf("aws configure set profile.%s.role_arn %s", profile, a.envManagerRoleARN),
+		fmt.Sprintf("aws configure set profile.%s.credential_source EcsContainer", profile),
+		fmt.Sprintf(`firing=$(aws cloudwatch describe-alarms --profile %s --region %s --alarm-types MetricAlarm CompositeAlarm --state-value ALARM --alarm-names %s --query "[MetricAlarms[].AlarmName, CompositeAlarms[].AlarmName][]" --output text)`,
+			profile, a.region, strings.Join(alarms, " ")),
+		fmt.Sprintf(`if [ -n "$firing" ]; then echo "Promotion blocked by alarms firing in environment %s: $firing"; exit 1; fi`, a.envName),
+	)
+}
+
+// shellQuote quotes s to be passed as a single argument to a shell command.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
 type ranker interface {
 	Rank(name string) (int, bool)
 }
@@ -899,6 +1036,11 @@ func (a *TestCommandsAction) Commands() []string {
 	return a.commands
 }
 
+// Image returns the image of the CodeBuild project that runs the commands.
+func (a *TestCommandsAction) Image() string {
+	return PipelineTestCommandsImage
+}
+
 // PrePostDeployAction represents a CodePipeline action of category "Build" backed by a CodeBuild project.
 type PrePostDeployAction struct {
 	action
@@ -6,6 +6,7 @@ package deploy
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -228,6 +229,9 @@ func TestPipelineBuild_Init(t *testing.T) {
 				Image:           defaultImage,
 				EnvironmentType: defaultEnvType,
 				BuildspecPath:   "some/path",
+				Variables: map[string]string{
+					"COPILOT_BUILDSPEC_PATH": "some/path",
+				},
 			},
 		},
 		"set image according to manifest": {
@@ -239,6 +243,9 @@ func TestPipelineBuild_Init(t *testing.T) {
 				Image:           "aws/codebuild/standard:3.0",
 				EnvironmentType: defaultEnvType,
 				BuildspecPath:   "some/path",
+				Variables: map[string]string{
+					"COPILOT_BUILDSPEC_PATH": "some/path",
+				},
 			},
 		},
 		"set image according to manifest (ARM based)": {
@@ -250,6 +257,9 @@ func TestPipelineBuild_Init(t *testing.T) {
 				Image:           "aws/codebuild/amazonlinux2-aarch64-standard:2.0",
 				EnvironmentType: "ARM_CONTAINER",
 				BuildspecPath:   "some/path",
+				Variables: map[string]string{
+					"COPILOT_BUILDSPEC_PATH": "some/path",
+				},
 			},
 		},
 		"additional policy is not empty": {
@@ -267,6 +277,9 @@ func TestPipelineBuild_Init(t *testing.T) {
 				EnvironmentType:          "ARM_CONTAINER",
 				BuildspecPath:            "some/path",
 				AdditionalPolicyDocument: "Statement:\n    Action: '*'\n    Effect: Allow\n    Resource: '*'\nVersion: 2012-10-17",
+				Variables: map[string]string{
+					"COPILOT_BUILDSPEC_PATH": "some/path",
+				},
 			},
 		},
 		"additional policy is empty": {
@@ -279,6 +292,9 @@ func TestPipelineBuild_Init(t *testing.T) {
 				EnvironmentType:          "ARM_CONTAINER",
 				BuildspecPath:            "some/path",
 				AdditionalPolicyDocument: "",
+				Variables: map[string]string{
+					"COPILOT_BUILDSPEC_PATH": "some/path",
+				},
 			},
 		},
 		"by default convert legacy manifest path to buildspec path": {
@@ -287,6 +303,9 @@ func TestPipelineBuild_Init(t *testing.T) {
 				Image:           defaultImage,
 				EnvironmentType: defaultEnvType,
 				BuildspecPath:   "copilot/buildspec.yml",
+				Variables: map[string]string{
+					"COPILOT_BUILDSPEC_PATH": "copilot/buildspec.yml",
+				},
 			},
 		},
 		"by default convert non-legacy manifest path to buildspec path": {
@@ -295,6 +314,9 @@ func TestPipelineBuild_Init(t *testing.T) {
 				Image:           defaultImage,
 				EnvironmentType: defaultEnvType,
 				BuildspecPath:   "copilot/pipelines/my-pipeline/buildspec.yml",
+				Variables: map[string]string{
+					"COPILOT_BUILDSPEC_PATH": "copilot/pipelines/my-pipeline/buildspec.yml",
+				},
 			},
 		},
 	}
@@ -353,6 +375,32 @@ func TestParseOwnerAndRepo(t *testing.T) {
 	}
 }
 
+func TestPipelineArtifactsPrefix(t *testing.T) {
+	testCases := map[string]struct {
+		resourceName string
+
+		wantedPrefix string
+		wantedRuleID string
+	}{
+		"keeps a short name": {
+			resourceName: "my-pipeline",
+			wantedPrefix: "my-pipeline/",
+			wantedRuleID: "ExpirePipelineArtifacts-my-pipeline",
+		},
+		"truncates the name to 20 characters": {
+			resourceName: "pipeline-badgoose-honkpipes",
+			wantedPrefix: "pipeline-badgoose-ho/",
+			wantedRuleID: "ExpirePipelineArtifacts-pipeline-badgoose-ho",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedPrefix, PipelineArtifactsPrefix(tc.resourceName))
+			require.Equal(t, tc.wantedRuleID, PipelineArtifactsLifecycleRuleID(tc.resourceName))
+		})
+	}
+}
+
 func TestPipelineStage_Init(t *testing.T) {
 	var stg PipelineStage
 	stg.Init(&config.Environment{
@@ -390,6 +438,7 @@ func TestPipelineStage_Init(t *testing.T) {
 	})
 	t.Run("manual approval button", func(t *testing.T) {
 		require.NotNil(t, stg.Approval(), "should require approval action for stages when the manifest requires it")
+		require.Equal(t, []string{"ApprovePromotionTo-test"}, stg.Approval().Names())
 
 		stg := PipelineStage{}
 		require.Nil(t, stg.Approval(), "should return nil by default")
@@ -725,6 +774,118 @@ func TestManualApprovalAction_Name(t *testing.T) {
 	require.Equal(t, "ApprovePromotionTo-test", action.Name())
 }
 
+func TestManualApprovalAction_Names(t *testing.T) {
+	testCases := map[string]struct {
+		approvals int
+		wanted    []string
+	}{
+		"single approval": {
+			approvals: 1,
+			wanted:    []string{"ApprovePromotionTo-prod"},
+		},
+		"several approvals": {
+			approvals: 3,
+			wanted:    []string{"ApprovePromotionTo-prod-1", "ApprovePromotionTo-prod-2", "ApprovePromotionTo-prod-3"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			action := ManualApprovalAction{
+				name:      "prod",
+				approvals: tc.approvals,
+			}
+
+			require.Equal(t, tc.wanted, action.Names())
+		})
+	}
+}
+
+func TestPipelineStage_PromotionChecks(t *testing.T) {
+	prev := func() *PipelineStage {
+		var stg PipelineStage
+		stg.Init(&config.Environment{
+			Name:           "test",
+			App:            "badgoose",
+			Region:         "us-west-2",
+			ManagerRoleARN: "arn:aws:iam::123456789012:role/badgoose-test-EnvManagerRole",
+		}, &manifest.PipelineStage{
+			Name: "test",
+		}, nil)
+		return &stg
+	}
+	testCases := map[string]struct {
+		promotion *manifest.PromotionPolicy
+		noPrev    bool
+
+		wantedCommands         []string
+		wantedTimeout          int
+		wantedApprovalRunOrder int
+		wantedDeployRunOrder   int
+	}{
+		"no checks without a promotion policy": {
+			wantedDeployRunOrder: 1,
+		},
+		"no checks in the first stage": {
+			promotion: &manifest.PromotionPolicy{
+				SoakTime: durationp(time.Hour),
+			},
+			noPrev:               true,
+			wantedDeployRunOrder: 1,
+		},
+		"waits for the soak time before the deployments": {
+			promotion: &manifest.PromotionPolicy{
+				SoakTime: durationp(90 * time.Minute),
+			},
+			wantedCommands:       []string{"sleep 5400"},
+			wantedTimeout:        105,
+			wantedDeployRunOrder: 2,
+		},
+		"checks the alarms of the previous stage before the approvals": {
+			promotion: &manifest.PromotionPolicy{
+				Approvals: aws.Int(2),
+				Alarms:    []string{"api-latency", "it's-firing"},
+			},
+			wantedCommands: []string{
+				"aws configure set profile.promotion.role_arn arn:aws:iam::123456789012:role/badgoose-test-EnvManagerRole",
+				"aws configure set profile.promotion.credential_source EcsContainer",
+				`firing=$(aws cloudwatch describe-alarms --profile promotion --region us-west-2 --alarm-types MetricAlarm CompositeAlarm --state-value ALARM --alarm-names 'api-latency' 'it'\''s-firing' --query "[MetricAlarms[].AlarmName, CompositeAlarms[].AlarmName][]" --output text)`,
+				`if [ -n "$firing" ]; then echo "Promotion blocked by alarms firing in environment test: $firing"; exit 1; fi`,
+			},
+			wantedTimeout:          15,
+			wantedApprovalRunOrder: 2,
+			wantedDeployRunOrder:   3,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var stg PipelineStage
+			stg.Init(&config.Environment{Name: "prod", App: "badgoose"}, &manifest.PipelineStage{
+				Name:      "prod",
+				Promotion: tc.promotion,
+			}, []string{"api"})
+			if !tc.noPrev {
+				stg.PromoteFrom(prev())
+			}
+
+			checks := stg.PromotionChecks()
+			if tc.wantedCommands == nil {
+				require.Nil(t, checks)
+			} else {
+				require.NotNil(t, checks)
+				require.Equal(t, tc.wantedCommands, checks.Commands())
+				require.Equal(t, tc.wantedTimeout, checks.TimeoutInMinutes())
+				require.Equal(t, 1, checks.RunOrder())
+			}
+			if tc.wantedApprovalRunOrder != 0 {
+				require.Equal(t, tc.wantedApprovalRunOrder, stg.Approval().RunOrder())
+			}
+			deployments, err := stg.Deployments()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployRunOrder, deployments[0].RunOrder())
+		})
+	}
+}
+
 func TestDeployAction_Name(t *testing.T) {
 	action := DeployAction{
 		name:    "frontend",
@@ -850,6 +1011,10 @@ func TestTestCommandsAction_Name(t *testing.T) {
 	require.Equal(t, "TestCommands", (&TestCommandsAction{}).Name())
 }
 
+func TestTestCommandsAction_Image(t *testing.T) {
+	require.Equal(t, "aws/codebuild/amazonlinux2-x86_64-standard:4.0", (&TestCommandsAction{}).Image())
+}
+
 func TestParseRepo(t *testing.T) {
 	testCases := map[string]struct {
 		src           *CodeCommitSource
@@ -892,3 +1057,7 @@ func TestParseRepo(t *testing.T) {
 		})
 	}
 }
+
+func durationp(d time.Duration) *time.Duration {
+	return &d
+}
@@ -0,0 +1,71 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// Tag keys that record where a workload's deployment comes from.
+const (
+	// GitCommitTagKey is tag key for the git commit that a workload is deployed from.
+	GitCommitTagKey = "copilot-git-commit"
+	// GitBranchTagKey is tag key for the git branch that a workload is deployed from.
+	GitBranchTagKey = "copilot-git-branch"
+	// GitDirtyTagKey is tag key for whether the git repository had uncommitted changes.
+	GitDirtyTagKey = "copilot-git-dirty"
+	// ManifestHashTagKey is tag key for the SHA-256 hash of the workload's manifest.
+	ManifestHashTagKey = "copilot-manifest-sha256"
+	// CopilotVersionTagKey is tag key for the version of Copilot that deployed the workload.
+	CopilotVersionTagKey = "copilot-version"
+)
+
+// invalidTagValueChars matches the characters that aren't allowed in tag values.
+var invalidTagValueChars = regexp.MustCompile(`[^\p{L}\p{Z}\p{N}_.:/=+\-@]`)
+
+// Provenance is the origin of the source code and manifest that a workload is deployed from.
+type Provenance struct {
+	GitCommit      string `json:"gitCommit,omitempty"`
+	GitBranch      string `json:"gitBranch,omitempty"`
+	GitDirty       bool   `json:"gitDirty"`
+	ManifestSHA256 string `json:"manifestSHA256,omitempty"`
+	CopilotVersion string `json:"copilotVersion,omitempty"`
+}
+
+// IsEmpty returns true if nothing is known about the provenance.
+func (p Provenance) IsEmpty() bool {
+	return p == Provenance{}
+}
+
+// Tags returns the resource tags that record the provenance.
+func (p Provenance) Tags() map[string]string {
+	tags := make(map[string]string)
+	if p.GitCommit != "" {
+		tags[GitCommitTagKey] = p.GitCommit
+		tags[GitDirtyTagKey] = strconv.FormatBool(p.GitDirty)
+	}
+	if p.GitBranch != "" {
+		tags[GitBranchTagKey] = invalidTagValueChars.ReplaceAllString(p.GitBranch, "_")
+	}
+	if p.ManifestSHA256 != "" {
+		tags[ManifestHashTagKey] = p.ManifestSHA256
+	}
+	if p.CopilotVersion != "" {
+		tags[CopilotVersionTagKey] = p.CopilotVersion
+	}
+	return tags
+}
+
+// ProvenanceFromTags returns the provenance recorded in the resource tags.
+func ProvenanceFromTags(tags map[string]string) Provenance {
+	dirty, _ := strconv.ParseBool(tags[GitDirtyTagKey])
+	return Provenance{
+		GitCommit:      tags[GitCommitTagKey],
+		GitBranch:      tags[GitBranchTagKey],
+		GitDirty:       dirty,
+		ManifestSHA256: tags[ManifestHashTagKey],
+		CopilotVersion: tags[CopilotVersionTagKey],
+	}
+}
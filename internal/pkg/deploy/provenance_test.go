@@ -0,0 +1,68 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvenance_Tags(t *testing.T) {
+	testCases := map[string]struct {
+		in     Provenance
+		wanted map[string]string
+	}{
+		"empty provenance": {
+			wanted: map[string]string{},
+		},
+		"outside of a git repository": {
+			in: Provenance{
+				ManifestSHA256: "5f2b",
+				CopilotVersion: "v1.32.0",
+			},
+			wanted: map[string]string{
+				ManifestHashTagKey:   "5f2b",
+				CopilotVersionTagKey: "v1.32.0",
+			},
+		},
+		"replaces invalid characters in the branch name": {
+			in: Provenance{
+				GitCommit:      "3f2c1a9e",
+				GitBranch:      "feature/#123-add-cache",
+				GitDirty:       true,
+				ManifestSHA256: "5f2b",
+				CopilotVersion: "v1.32.0",
+			},
+			wanted: map[string]string{
+				GitCommitTagKey:      "3f2c1a9e",
+				GitBranchTagKey:      "feature/_123-add-cache",
+				GitDirtyTagKey:       "true",
+				ManifestHashTagKey:   "5f2b",
+				CopilotVersionTagKey: "v1.32.0",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.Tags())
+		})
+	}
+}
+
+func TestProvenanceFromTags(t *testing.T) {
+	in := Provenance{
+		GitCommit:      "3f2c1a9e",
+		GitBranch:      "main",
+		GitDirty:       true,
+		ManifestSHA256: "5f2b",
+		CopilotVersion: "v1.32.0",
+	}
+
+	got := ProvenanceFromTags(in.Tags())
+
+	require.Equal(t, in, got)
+	require.True(t, ProvenanceFromTags(map[string]string{AppTagKey: "phonetool"}).IsEmpty())
+}
@@ -0,0 +1,236 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// Types of the in-toto statements that record the SLSA provenance of the images built by pipelines.
+const (
+	InTotoStatementType         = "https://in-toto.io/Statement/v1"
+	SLSAProvenancePredicateType = "https://slsa.dev/provenance/v1"
+	// PipelineBuildType is the type of the builds that run the buildspec of a Copilot pipeline.
+	PipelineBuildType = "https://aws.github.io/copilot-cli/docs/concepts/pipelines/#provenance"
+)
+
+// Environment variables of the CodeBuild builds that run the buildspec of a pipeline.
+const (
+	codebuildBuildARNEnvVar        = "CODEBUILD_BUILD_ARN"
+	codebuildStartTimeEnvVar       = "CODEBUILD_START_TIME"
+	codebuildSourceRepoURLEnvVar   = "CODEBUILD_SOURCE_REPO_URL"
+	codebuildSourceVersionEnvVar   = "CODEBUILD_RESOLVED_SOURCE_VERSION"
+	codebuildSourceDirEnvVar       = "CODEBUILD_SRC_DIR"
+	PipelineBuildspecPathEnvVar    = "COPILOT_BUILDSPEC_PATH"
+	codebuildBuildResourcePrefix   = "build/"
+	codebuildProjectResourcePrefix = "project/"
+)
+
+// PipelineBuild is the CodeBuild build of a pipeline that images are built in.
+type PipelineBuild struct {
+	BuildARN      string
+	StartedOn     time.Time
+	SourceRepoURL string // Optional. Repository that the source code comes from.
+	SourceVersion string // Optional. Commit that the source code is resolved to.
+	SourceDir     string // Directory that the source code is checked out to.
+	BuildspecPath string // Optional. Path to the buildspec relative to the source directory.
+}
+
+// PipelineBuildFromEnv returns the build of the pipeline that the command runs in.
+// Returns false if the command doesn't run in a CodeBuild build.
+func PipelineBuildFromEnv(getenv func(string) string) (PipelineBuild, bool) {
+	buildARN := getenv(codebuildBuildARNEnvVar)
+	if buildARN == "" {
+		return PipelineBuild{}, false
+	}
+	b := PipelineBuild{
+		BuildARN:      buildARN,
+		SourceRepoURL: getenv(codebuildSourceRepoURLEnvVar),
+		SourceVersion: getenv(codebuildSourceVersionEnvVar),
+		SourceDir:     getenv(codebuildSourceDirEnvVar),
+		BuildspecPath: getenv(PipelineBuildspecPathEnvVar),
+	}
+	// CodeBuild reports the start time in milliseconds since the epoch.
+	if ms, err := strconv.ParseInt(getenv(codebuildStartTimeEnvVar), 10, 64); err == nil {
+		b.StartedOn = time.UnixMilli(ms).UTC()
+	}
+	return b, true
+}
+
+// BuilderID returns the ARN of the CodeBuild project that runs the build.
+func (b PipelineBuild) BuilderID() (string, error) {
+	parsed, err := arn.Parse(b.BuildARN)
+	if err != nil {
+		return "", fmt.Errorf("parse build ARN %s: %w", b.BuildARN, err)
+	}
+	project, _, _ := strings.Cut(strings.TrimPrefix(parsed.Resource, codebuildBuildResourcePrefix), ":")
+	parsed.Resource = codebuildProjectResourcePrefix + project
+	return parsed.String(), nil
+}
+
+// ProvenanceStatement is an in-toto statement that attests the SLSA provenance of its subjects.
+type ProvenanceStatement struct {
+	Type          string              `json:"_type"`
+	Subject       []ProvenanceSubject `json:"subject"`
+	PredicateType string              `json:"predicateType"`
+	Predicate     SLSAProvenance      `json:"predicate"`
+}
+
+// ProvenanceSubject is an artifact that a provenance statement is about.
+type ProvenanceSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// SLSAProvenance is the predicate of a SLSA v1 provenance statement.
+type SLSAProvenance struct {
+	BuildDefinition SLSABuildDefinition `json:"buildDefinition"`
+	RunDetails      SLSARunDetails      `json:"runDetails"`
+}
+
+// SLSABuildDefinition describes the inputs of a build.
+type SLSABuildDefinition struct {
+	BuildType          string                 `json:"buildType"`
+	ExternalParameters SLSAExternalParameters `json:"externalParameters"`
+}
+
+// SLSAExternalParameters are the parameters of a pipeline build that are under the control of the user.
+type SLSAExternalParameters struct {
+	Source    SLSAResourceDescriptor `json:"source"`
+	Buildspec SLSAResourceDescriptor `json:"buildspec"`
+	Workload  string                 `json:"workload"`
+	Container string                 `json:"container"`
+}
+
+// SLSAResourceDescriptor identifies an artifact that a build depends on.
+type SLSAResourceDescriptor struct {
+	URI    string            `json:"uri,omitempty"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// SLSARunDetails describes the builder and the invocation of a build.
+type SLSARunDetails struct {
+	Builder  SLSABuilder       `json:"builder"`
+	Metadata SLSABuildMetadata `json:"metadata"`
+}
+
+// SLSABuilder identifies the platform that runs a build.
+type SLSABuilder struct {
+	ID      string            `json:"id"`
+	Version map[string]string `json:"version,omitempty"`
+}
+
+// SLSABuildMetadata is the metadata of a build invocation.
+type SLSABuildMetadata struct {
+	InvocationID string     `json:"invocationId,omitempty"`
+	StartedOn    *time.Time `json:"startedOn,omitempty"`
+}
+
+// SLSAProvenanceInput holds the fields required to generate the provenance of an image built by a pipeline.
+type SLSAProvenanceInput struct {
+	Build           PipelineBuild
+	BuildspecSHA256 string // Optional. Digest of the buildspec that the build runs.
+	Workload        string
+	Container       string
+	ImageURI        string // URI of the repository of the image without a tag.
+	ImageDigest     string
+	CopilotVersion  string
+}
+
+// NewSLSAProvenance returns the SLSA provenance statement of an image built by a pipeline.
+func NewSLSAProvenance(in SLSAProvenanceInput) (*ProvenanceStatement, error) {
+	builderID, err := in.Build.BuilderID()
+	if err != nil {
+		return nil, err
+	}
+	algorithm, digest, ok := strings.Cut(in.ImageDigest, ":")
+	if !ok {
+		return nil, fmt.Errorf("parse image digest %s", in.ImageDigest)
+	}
+	params := SLSAExternalParameters{
+		Source: SLSAResourceDescriptor{
+			URI: in.Build.SourceRepoURL,
+		},
+		Buildspec: SLSAResourceDescriptor{
+			URI: in.Build.BuildspecPath,
+		},
+		Workload:  in.Workload,
+		Container: in.Container,
+	}
+	if in.Build.SourceVersion != "" {
+		params.Source.Digest = map[string]string{"gitCommit": in.Build.SourceVersion}
+	}
+	if in.BuildspecSHA256 != "" {
+		params.Buildspec.Digest = map[string]string{"sha256": in.BuildspecSHA256}
+	}
+	builder := SLSABuilder{ID: builderID}
+	if in.CopilotVersion != "" {
+		builder.Version = map[string]string{"copilot": in.CopilotVersion}
+	}
+	metadata := SLSABuildMetadata{InvocationID: in.Build.BuildARN}
+	if !in.Build.StartedOn.IsZero() {
+		startedOn := in.Build.StartedOn
+		metadata.StartedOn = &startedOn
+	}
+	return &ProvenanceStatement{
+		Type: InTotoStatementType,
+		Subject: []ProvenanceSubject{
+			{
+				Name:   in.ImageURI,
+				Digest: map[string]string{algorithm: digest},
+			},
+		},
+		PredicateType: SLSAProvenancePredicateType,
+		Predicate: SLSAProvenance{
+			BuildDefinition: SLSABuildDefinition{
+				BuildType:          PipelineBuildType,
+				ExternalParameters: params,
+			},
+			RunDetails: SLSARunDetails{
+				Builder:  builder,
+				Metadata: metadata,
+			},
+		},
+	}, nil
+}
+
+// VerifySLSAProvenance returns the provenance statement if it attests that the image with the digest
+// was built by a pipeline. Otherwise, returns an error explaining why the statement is not valid.
+func VerifySLSAProvenance(raw []byte, imageDigest string) (*ProvenanceStatement, error) {
+	var s ProvenanceStatement
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, fmt.Errorf("unmarshal provenance statement: %w", err)
+	}
+	if s.Type != InTotoStatementType {
+		return nil, fmt.Errorf("statement type %q is not %q", s.Type, InTotoStatementType)
+	}
+	if s.PredicateType != SLSAProvenancePredicateType {
+		return nil, fmt.Errorf("predicate type %q is not %q", s.PredicateType, SLSAProvenancePredicateType)
+	}
+	algorithm, digest, _ := strings.Cut(imageDigest, ":")
+	var isSubject bool
+	for _, subject := range s.Subject {
+		if subject.Digest[algorithm] == digest {
+			isSubject = true
+			break
+		}
+	}
+	if !isSubject {
+		return nil, fmt.Errorf("image %s is not a subject of the statement", imageDigest)
+	}
+	if s.Predicate.BuildDefinition.BuildType != PipelineBuildType {
+		return nil, fmt.Errorf("build type %q is not %q", s.Predicate.BuildDefinition.BuildType, PipelineBuildType)
+	}
+	builder, err := arn.Parse(s.Predicate.RunDetails.Builder.ID)
+	if err != nil || builder.Service != "codebuild" || !strings.HasPrefix(builder.Resource, codebuildProjectResourcePrefix) {
+		return nil, fmt.Errorf("builder %q is not a CodeBuild project", s.Predicate.RunDetails.Builder.ID)
+	}
+	return &s, nil
+}
@@ -0,0 +1,223 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockBuildARN    = "arn:aws:codebuild:us-west-2:123456789012:build/pipeline-my-app-my-pipeline-BuildProject:a1b2c3d4"
+	mockImageDigest = "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49"
+)
+
+func TestPipelineBuildFromEnv(t *testing.T) {
+	testCases := map[string]struct {
+		env map[string]string
+
+		wanted   PipelineBuild
+		wantedOK bool
+	}{
+		"not in a CodeBuild build": {
+			env: map[string]string{
+				"CODEBUILD_SRC_DIR": "/codebuild/output/src",
+			},
+		},
+		"in a CodeBuild build": {
+			env: map[string]string{
+				"CODEBUILD_BUILD_ARN":               mockBuildARN,
+				"CODEBUILD_START_TIME":              "1700000000000",
+				"CODEBUILD_SOURCE_REPO_URL":         "https://github.com/acme/api",
+				"CODEBUILD_RESOLVED_SOURCE_VERSION": "c0ffee",
+				"CODEBUILD_SRC_DIR":                 "/codebuild/output/src",
+				"COPILOT_BUILDSPEC_PATH":            "copilot/pipelines/my-pipeline/buildspec.yml",
+			},
+			wanted: PipelineBuild{
+				BuildARN:      mockBuildARN,
+				StartedOn:     time.Date(2023, time.November, 14, 22, 13, 20, 0, time.UTC),
+				SourceRepoURL: "https://github.com/acme/api",
+				SourceVersion: "c0ffee",
+				SourceDir:     "/codebuild/output/src",
+				BuildspecPath: "copilot/pipelines/my-pipeline/buildspec.yml",
+			},
+			wantedOK: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := PipelineBuildFromEnv(func(key string) string {
+				return tc.env[key]
+			})
+
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestNewSLSAProvenance(t *testing.T) {
+	startedOn := time.Date(2023, time.November, 14, 22, 13, 20, 0, time.UTC)
+	testCases := map[string]struct {
+		in SLSAProvenanceInput
+
+		wanted    *ProvenanceStatement
+		wantedErr string
+	}{
+		"error if the build ARN is malformed": {
+			in: SLSAProvenanceInput{
+				Build: PipelineBuild{BuildARN: "build"},
+			},
+			wantedErr: "parse build ARN build: arn: invalid prefix",
+		},
+		"error if the image digest is malformed": {
+			in: SLSAProvenanceInput{
+				Build:       PipelineBuild{BuildARN: mockBuildARN},
+				ImageDigest: "741d3e95",
+			},
+			wantedErr: "parse image digest 741d3e95",
+		},
+		"record the builder, source and buildspec of the image": {
+			in: SLSAProvenanceInput{
+				Build: PipelineBuild{
+					BuildARN:      mockBuildARN,
+					StartedOn:     startedOn,
+					SourceRepoURL: "https://github.com/acme/api",
+					SourceVersion: "c0ffee",
+					BuildspecPath: "copilot/pipelines/my-pipeline/buildspec.yml",
+				},
+				BuildspecSHA256: "beef",
+				Workload:        "api",
+				Container:       "api",
+				ImageURI:        "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api",
+				ImageDigest:     mockImageDigest,
+				CopilotVersion:  "v1.32.0",
+			},
+			wanted: &ProvenanceStatement{
+				Type: InTotoStatementType,
+				Subject: []ProvenanceSubject{
+					{
+						Name:   "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api",
+						Digest: map[string]string{"sha256": "741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49"},
+					},
+				},
+				PredicateType: SLSAProvenancePredicateType,
+				Predicate: SLSAProvenance{
+					BuildDefinition: SLSABuildDefinition{
+						BuildType: PipelineBuildType,
+						ExternalParameters: SLSAExternalParameters{
+							Source: SLSAResourceDescriptor{
+								URI:    "https://github.com/acme/api",
+								Digest: map[string]string{"gitCommit": "c0ffee"},
+							},
+							Buildspec: SLSAResourceDescriptor{
+								URI:    "copilot/pipelines/my-pipeline/buildspec.yml",
+								Digest: map[string]string{"sha256": "beef"},
+							},
+							Workload:  "api",
+							Container: "api",
+						},
+					},
+					RunDetails: SLSARunDetails{
+						Builder: SLSABuilder{
+							ID:      "arn:aws:codebuild:us-west-2:123456789012:project/pipeline-my-app-my-pipeline-BuildProject",
+							Version: map[string]string{"copilot": "v1.32.0"},
+						},
+						Metadata: SLSABuildMetadata{
+							InvocationID: mockBuildARN,
+							StartedOn:    &startedOn,
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewSLSAProvenance(tc.in)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestVerifySLSAProvenance(t *testing.T) {
+	statement := func(modify func(s *ProvenanceStatement)) []byte {
+		s, err := NewSLSAProvenance(SLSAProvenanceInput{
+			Build:       PipelineBuild{BuildARN: mockBuildARN},
+			ImageURI:    "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api",
+			ImageDigest: mockImageDigest,
+		})
+		require.NoError(t, err)
+		if modify != nil {
+			modify(s)
+		}
+		raw, err := json.Marshal(s)
+		require.NoError(t, err)
+		return raw
+	}
+	testCases := map[string]struct {
+		raw []byte
+
+		wantedErr string
+	}{
+		"error if the statement is not JSON": {
+			raw:       []byte("not json"),
+			wantedErr: "unmarshal provenance statement: invalid character 'o' in literal null (expecting 'u')",
+		},
+		"error if the statement is not an in-toto statement": {
+			raw: statement(func(s *ProvenanceStatement) {
+				s.Type = "https://in-toto.io/Statement/v0.1"
+			}),
+			wantedErr: `statement type "https://in-toto.io/Statement/v0.1" is not "https://in-toto.io/Statement/v1"`,
+		},
+		"error if the predicate is not a SLSA provenance": {
+			raw: statement(func(s *ProvenanceStatement) {
+				s.PredicateType = "https://spdx.dev/Document"
+			}),
+			wantedErr: `predicate type "https://spdx.dev/Document" is not "https://slsa.dev/provenance/v1"`,
+		},
+		"error if the image is not a subject": {
+			raw: statement(func(s *ProvenanceStatement) {
+				s.Subject[0].Digest["sha256"] = "other"
+			}),
+			wantedErr: "image " + mockImageDigest + " is not a subject of the statement",
+		},
+		"error if the image is not built by a pipeline": {
+			raw: statement(func(s *ProvenanceStatement) {
+				s.Predicate.BuildDefinition.BuildType = "https://example.com/laptop"
+			}),
+			wantedErr: `build type "https://example.com/laptop" is not "https://aws.github.io/copilot-cli/docs/concepts/pipelines/#provenance"`,
+		},
+		"error if the builder is not a CodeBuild project": {
+			raw: statement(func(s *ProvenanceStatement) {
+				s.Predicate.RunDetails.Builder.ID = "https://github.com/actions/runner"
+			}),
+			wantedErr: `builder "https://github.com/actions/runner" is not a CodeBuild project`,
+		},
+		"valid provenance": {
+			raw: statement(nil),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := VerifySLSAProvenance(tc.raw, mockImageDigest)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "arn:aws:codebuild:us-west-2:123456789012:project/pipeline-my-app-my-pipeline-BuildProject", got.Predicate.RunDetails.Builder.ID)
+		})
+	}
+}
@@ -8,6 +8,7 @@ package deploy
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 // FmtTaskECRRepoName is the pattern used to generate the ECR repository's name
@@ -37,6 +38,20 @@ type CreateTaskResourcesInput struct {
 	Env string
 
 	AdditionalTags map[string]string
+
+	Schedule *TaskSchedule // Optional. Starts the tasks on a schedule instead of once.
+}
+
+// TaskSchedule holds the fields required to start a task on a schedule.
+type TaskSchedule struct {
+	Expression string // Cron expression, predefined schedule such as "@daily", or "@every" directive.
+	Count      int
+
+	Cluster         string
+	Subnets         []string
+	SecurityGroups  []string
+	AssignPublicIP  string // Defaults to "ENABLED".
+	PlatformVersion string
 }
 
 // TaskStackInfo contains essential information about a Copilot task stack
@@ -48,6 +63,8 @@ type TaskStackInfo struct {
 	RoleARN string
 
 	BucketName string
+
+	LastUpdated time.Time // The last time the stack was created or updated.
 }
 
 // TaskName returns the name of the one-off task. This is the same as the value of the
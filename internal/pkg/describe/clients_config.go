@@ -0,0 +1,258 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"gopkg.in/yaml.v3"
+)
+
+const sqsQueueType = "AWS::SQS::Queue"
+
+// TopicLister lists the SNS topics of the workloads deployed to an environment.
+type TopicLister interface {
+	ListSNSTopics(appName string, envName string) ([]deploy.Topic, error)
+}
+
+// ClientsConfig holds the endpoints of the dependencies of a service in an environment,
+// so that they can be baked into the configuration of the service at build time.
+type ClientsConfig struct {
+	App      string           `json:"application" yaml:"application"`
+	Env      string           `json:"environment" yaml:"environment"`
+	Service  string           `json:"service" yaml:"service"`
+	Services []*ServiceClient `json:"services" yaml:"services"`
+	Topics   []*TopicClient   `json:"topics" yaml:"topics"`
+	Queues   []*QueueClient   `json:"queues" yaml:"queues"`
+}
+
+// ServiceClient holds how to reach a service that the service calls.
+type ServiceClient struct {
+	Name           string                    `json:"name" yaml:"name"`
+	ServiceConnect []*ServiceConnectEndpoint `json:"serviceConnect,omitempty" yaml:"serviceConnect,omitempty"`
+	URI            string                    `json:"uri,omitempty" yaml:"uri,omitempty"` // Set if the service isn't reachable with Service Connect.
+}
+
+// ServiceConnectEndpoint is a Service Connect name of a service, like "api", and its port.
+type ServiceConnectEndpoint struct {
+	Name string `json:"name" yaml:"name"`
+	Port string `json:"port,omitempty" yaml:"port,omitempty"`
+}
+
+// TopicClient is an SNS topic that the service publishes to.
+type TopicClient struct {
+	Name string `json:"name" yaml:"name"`
+	ARN  string `json:"arn" yaml:"arn"`
+}
+
+// QueueClient is an SQS queue that the service consumes, identified by its logical ID in the stack of the service.
+type QueueClient struct {
+	Name string `json:"name" yaml:"name"`
+	URL  string `json:"url" yaml:"url"`
+}
+
+// JSONString returns the stringified ClientsConfig struct with json format.
+func (c *ClientsConfig) JSONString() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal clients config: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// YAMLString returns the stringified ClientsConfig struct with yaml format.
+func (c *ClientsConfig) YAMLString() (string, error) {
+	buf := new(bytes.Buffer)
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(c); err != nil {
+		return "", fmt.Errorf("marshal clients config: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// ClientsConfigDescriber retrieves the endpoints of the dependencies of a service in an environment.
+type ClientsConfigDescriber struct {
+	app     string
+	env     string
+	svc     string
+	callees []string
+
+	configStore             ConfigStoreSvc
+	deployStore             DeployedEnvServicesLister
+	topicLister             TopicLister
+	initECSServiceDescriber func(svc string) (ecsDescriber, error)
+	initReachableService    func(svc string) (ReachableService, error)
+}
+
+// NewClientsConfigDescriberInput holds the fields to instantiate a ClientsConfigDescriber.
+type NewClientsConfigDescriberInput struct {
+	App     string
+	Env     string
+	Svc     string
+	Callees []string // Names of the services that the service calls.
+
+	ConfigStore ConfigStoreSvc
+	DeployStore DeployedEnvServicesLister
+	TopicLister TopicLister
+}
+
+// NewClientsConfigDescriber instantiates a ClientsConfigDescriber.
+func NewClientsConfigDescriber(in NewClientsConfigDescriberInput) *ClientsConfigDescriber {
+	return &ClientsConfigDescriber{
+		app:         in.App,
+		env:         in.Env,
+		svc:         in.Svc,
+		callees:     in.Callees,
+		configStore: in.ConfigStore,
+		deployStore: in.DeployStore,
+		topicLister: in.TopicLister,
+		initECSServiceDescriber: func(svc string) (ecsDescriber, error) {
+			return newECSServiceDescriber(NewServiceConfig{
+				App:         in.App,
+				Env:         in.Env,
+				Svc:         svc,
+				ConfigStore: in.ConfigStore,
+			})
+		},
+		initReachableService: func(svc string) (ReachableService, error) {
+			return NewReachableService(in.App, svc, in.ConfigStore)
+		},
+	}
+}
+
+// Describe returns the endpoints of the services that the service calls and that are deployed to the environment,
+// the topics that the service publishes to, and the queues that it consumes.
+func (d *ClientsConfigDescriber) Describe() (*ClientsConfig, error) {
+	deployed, err := d.deployStore.ListDeployedServices(d.app, d.env)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed to environment %s: %w", d.env, err)
+	}
+	cfg := &ClientsConfig{
+		App:      d.app,
+		Env:      d.env,
+		Service:  d.svc,
+		Services: []*ServiceClient{},
+		Topics:   []*TopicClient{},
+		Queues:   []*QueueClient{},
+	}
+	isDeployed := make(map[string]bool, len(deployed))
+	for _, svc := range deployed {
+		isDeployed[svc] = true
+	}
+	for _, callee := range d.callees {
+		if !isDeployed[callee] {
+			continue
+		}
+		client, err := d.serviceClient(callee)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Services = append(cfg.Services, client)
+	}
+	topics, err := d.topicLister.ListSNSTopics(d.app, d.env)
+	if err != nil {
+		return nil, fmt.Errorf("list SNS topics in environment %s: %w", d.env, err)
+	}
+	for _, topic := range topics {
+		if topic.Workload() != d.svc {
+			continue
+		}
+		cfg.Topics = append(cfg.Topics, &TopicClient{
+			Name: topic.Name(),
+			ARN:  topic.ARN(),
+		})
+	}
+	sort.Slice(cfg.Topics, func(i, j int) bool { return cfg.Topics[i].Name < cfg.Topics[j].Name })
+	if cfg.Queues, err = d.queues(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (d *ClientsConfigDescriber) serviceClient(svc string) (*ServiceClient, error) {
+	wkld, err := d.configStore.GetWorkload(d.app, svc)
+	if err != nil {
+		return nil, fmt.Errorf("get service %s: %w", svc, err)
+	}
+	client := &ServiceClient{
+		Name: svc,
+	}
+	if wkld.Type == manifestinfo.LoadBalancedWebServiceType || wkld.Type == manifestinfo.BackendServiceType {
+		descr, err := d.initECSServiceDescriber(svc)
+		if err != nil {
+			return nil, err
+		}
+		aliases, err := descr.ServiceConnectDNSNames()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service connect DNS names of service %s: %w", svc, err)
+		}
+		for _, alias := range aliases {
+			client.ServiceConnect = append(client.ServiceConnect, serviceConnectEndpoint(alias))
+		}
+		if len(client.ServiceConnect) > 0 {
+			return client, nil
+		}
+	}
+	reachable, err := d.initReachableService(svc)
+	if err != nil {
+		return nil, err
+	}
+	uri, err := reachable.URI(d.env)
+	if err != nil {
+		return nil, fmt.Errorf("get endpoint of service %s: %w", svc, err)
+	}
+	if uri.AccessType != URIAccessTypeNone {
+		client.URI = uri.URI
+	}
+	return client, nil
+}
+
+func (d *ClientsConfigDescriber) queues() ([]*QueueClient, error) {
+	wkld, err := d.configStore.GetWorkload(d.app, d.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get service %s: %w", d.svc, err)
+	}
+	queues := []*QueueClient{}
+	if wkld.Type != manifestinfo.WorkerServiceType {
+		return queues, nil
+	}
+	descr, err := d.initECSServiceDescriber(d.svc)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := descr.StackResources()
+	if err != nil {
+		return nil, fmt.Errorf("get stack resources of service %s: %w", d.svc, err)
+	}
+	for _, resource := range resources {
+		if resource.Type != sqsQueueType {
+			continue
+		}
+		queues = append(queues, &QueueClient{
+			Name: resource.LogicalID,
+			URL:  resource.PhysicalID,
+		})
+	}
+	sort.Slice(queues, func(i, j int) bool { return queues[i].Name < queues[j].Name })
+	return queues, nil
+}
+
+// serviceConnectEndpoint splits a Service Connect alias, like "api:8080", into its name and port.
+func serviceConnectEndpoint(alias string) *ServiceConnectEndpoint {
+	host, port, err := net.SplitHostPort(alias)
+	if err != nil {
+		return &ServiceConnectEndpoint{Name: alias} // The alias has no port.
+	}
+	return &ServiceConnectEndpoint{
+		Name: host,
+		Port: port,
+	}
+}
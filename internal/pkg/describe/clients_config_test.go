@@ -0,0 +1,212 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTopicLister struct {
+	topics []deploy.Topic
+	err    error
+}
+
+func (l fakeTopicLister) ListSNSTopics(_, _ string) ([]deploy.Topic, error) {
+	return l.topics, l.err
+}
+
+type fakeReachableService struct {
+	uri URI
+}
+
+func (s fakeReachableService) URI(_ string) (URI, error) {
+	return s.uri, nil
+}
+
+type clientsConfigDescriberMocks struct {
+	configStore *mocks.MockConfigStoreSvc
+	deployStore *mocks.MockDeployedEnvServicesLister
+	ecs         map[string]*mocks.MockecsDescriber
+}
+
+func TestClientsConfigDescriber_Describe(t *testing.T) {
+	mustTopic := func(arn, wkld string) deploy.Topic {
+		topic, err := deploy.NewTopic(arn, "my-app", "test", wkld)
+		require.NoError(t, err)
+		return *topic
+	}
+	testCases := map[string]struct {
+		svc        string
+		callees    []string
+		topics     fakeTopicLister
+		setupMocks func(m clientsConfigDescriberMocks)
+
+		wanted    *ClientsConfig
+		wantedErr string
+	}{
+		"error if the deployed services can't be listed": {
+			svc: "frontend",
+			setupMocks: func(m clientsConfigDescriberMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list services deployed to environment test: some error",
+		},
+		"error if the topics can't be listed": {
+			svc:    "frontend",
+			topics: fakeTopicLister{err: errors.New("some error")},
+			setupMocks: func(m clientsConfigDescriberMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"frontend"}, nil)
+			},
+			wantedErr: "list SNS topics in environment test: some error",
+		},
+		"describes the services that are called, and the topics of the service": {
+			svc:     "frontend",
+			callees: []string{"api", "orders", "site"},
+			topics: fakeTopicLister{
+				topics: []deploy.Topic{
+					mustTopic("arn:aws:sns:us-west-2:123456789012:my-app-test-frontend-signups", "frontend"),
+					mustTopic("arn:aws:sns:us-west-2:123456789012:my-app-test-frontend-clicks", "frontend"),
+					mustTopic("arn:aws:sns:us-west-2:123456789012:my-app-test-api-orders", "api"),
+				},
+			},
+			setupMocks: func(m clientsConfigDescriberMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"api", "frontend", "site"}, nil)
+				m.configStore.EXPECT().GetWorkload("my-app", "api").Return(&config.Workload{Type: manifestinfo.BackendServiceType}, nil)
+				m.ecs["api"].EXPECT().ServiceConnectDNSNames().Return([]string{"api:8080", "api.internal"}, nil)
+				m.configStore.EXPECT().GetWorkload("my-app", "site").Return(&config.Workload{Type: manifestinfo.RequestDrivenWebServiceType}, nil)
+				m.configStore.EXPECT().GetWorkload("my-app", "frontend").Return(&config.Workload{Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+			},
+			wanted: &ClientsConfig{
+				App:     "my-app",
+				Env:     "test",
+				Service: "frontend",
+				Services: []*ServiceClient{
+					{
+						Name: "api",
+						ServiceConnect: []*ServiceConnectEndpoint{
+							{Name: "api", Port: "8080"},
+							{Name: "api.internal"},
+						},
+					},
+					{
+						Name: "site",
+						URI:  "https://site.example.com",
+					},
+				},
+				Topics: []*TopicClient{
+					{Name: "clicks", ARN: "arn:aws:sns:us-west-2:123456789012:my-app-test-frontend-clicks"},
+					{Name: "signups", ARN: "arn:aws:sns:us-west-2:123456789012:my-app-test-frontend-signups"},
+				},
+				Queues: []*QueueClient{},
+			},
+		},
+		"describes the queues of a worker service": {
+			svc: "worker",
+			setupMocks: func(m clientsConfigDescriberMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"worker"}, nil)
+				m.configStore.EXPECT().GetWorkload("my-app", "worker").Return(&config.Workload{Type: manifestinfo.WorkerServiceType}, nil)
+				m.ecs["worker"].EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::SQS::Queue", LogicalID: "EventsQueue", PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/events"},
+					{Type: "AWS::ECS::Service", LogicalID: "Service", PhysicalID: "worker"},
+					{Type: "AWS::SQS::Queue", LogicalID: "DeadLetterQueue", PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/dlq"},
+				}, nil)
+			},
+			wanted: &ClientsConfig{
+				App:      "my-app",
+				Env:      "test",
+				Service:  "worker",
+				Services: []*ServiceClient{},
+				Topics:   []*TopicClient{},
+				Queues: []*QueueClient{
+					{Name: "DeadLetterQueue", URL: "https://sqs.us-west-2.amazonaws.com/123456789012/dlq"},
+					{Name: "EventsQueue", URL: "https://sqs.us-west-2.amazonaws.com/123456789012/events"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := clientsConfigDescriberMocks{
+				configStore: mocks.NewMockConfigStoreSvc(ctrl),
+				deployStore: mocks.NewMockDeployedEnvServicesLister(ctrl),
+				ecs:         make(map[string]*mocks.MockecsDescriber),
+			}
+			for _, svc := range []string{"api", "frontend", "worker"} {
+				m.ecs[svc] = mocks.NewMockecsDescriber(ctrl)
+			}
+			tc.setupMocks(m)
+			d := &ClientsConfigDescriber{
+				app:         "my-app",
+				env:         "test",
+				svc:         tc.svc,
+				callees:     tc.callees,
+				configStore: m.configStore,
+				deployStore: m.deployStore,
+				topicLister: tc.topics,
+				initECSServiceDescriber: func(svc string) (ecsDescriber, error) {
+					return m.ecs[svc], nil
+				},
+				initReachableService: func(svc string) (ReachableService, error) {
+					return fakeReachableService{
+						uri: URI{
+							URI:        "https://" + svc + ".example.com",
+							AccessType: URIAccessTypeInternet,
+						},
+					}, nil
+				},
+			}
+
+			got, err := d.Describe()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestClientsConfig_YAMLString(t *testing.T) {
+	cfg := &ClientsConfig{
+		App:     "my-app",
+		Env:     "test",
+		Service: "frontend",
+		Services: []*ServiceClient{
+			{
+				Name:           "api",
+				ServiceConnect: []*ServiceConnectEndpoint{{Name: "api", Port: "8080"}},
+			},
+		},
+		Topics: []*TopicClient{},
+		Queues: []*QueueClient{},
+	}
+
+	got, err := cfg.YAMLString()
+
+	require.NoError(t, err)
+	require.Equal(t, `application: my-app
+environment: test
+service: frontend
+services:
+  - name: api
+    serviceConnect:
+      - name: api
+        port: "8080"
+topics: []
+queues: []
+`, got)
+}
@@ -0,0 +1,291 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+)
+
+const (
+	// The cost summary of the dashboard covers this period, up to the day the dashboard is generated.
+	dashboardCostPeriod = 30 * 24 * time.Hour
+	// The most recent deployments of each service that are listed in the dashboard.
+	dashboardDeploymentsLimit = 3
+)
+
+type costGetter interface {
+	CostsByTag(filterKey, filterValue, groupKey string, start, end time.Time) (map[string]costexplorer.Cost, error)
+}
+
+// AppDashboard is a report of the services deployed in each environment of an application, and of the cost of the application.
+type AppDashboard struct {
+	App          string          `json:"app"`
+	GeneratedAt  time.Time       `json:"generatedAt"`
+	Environments []*DashboardEnv `json:"environments"`
+	Costs        *DashboardCosts `json:"costs"`
+}
+
+// DashboardEnv holds the services deployed in an environment.
+type DashboardEnv struct {
+	Name      string              `json:"name"`
+	Region    string              `json:"region"`
+	AccountID string              `json:"accountID"`
+	Services  []*DashboardService `json:"services"`
+}
+
+// DashboardService holds the status of a service deployed in an environment.
+type DashboardService struct {
+	Name        string                `json:"name"`
+	Type        string                `json:"type"`
+	Version     string                `json:"version,omitempty"` // Task definition revision or image of the service.
+	Endpoint    string                `json:"endpoint,omitempty"`
+	Alarms      []DashboardAlarm      `json:"alarms,omitempty"`
+	Deployments []DashboardDeployment `json:"deployments,omitempty"` // Most recent first.
+	Error       string                `json:"error,omitempty"`       // Set if the service couldn't be fully described.
+}
+
+// DashboardAlarm holds the state of an alarm of a service.
+type DashboardAlarm struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+// DashboardDeployment holds the status of a deployment of a service.
+type DashboardDeployment struct {
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// DashboardCosts holds the cost of an application per environment.
+type DashboardCosts struct {
+	Start        time.Time          `json:"start"`
+	End          time.Time          `json:"end"`
+	Environments []DashboardEnvCost `json:"environments,omitempty"`
+	Total        float64            `json:"total"`
+	Unit         string             `json:"unit,omitempty"`
+	Error        string             `json:"error,omitempty"` // Set if the costs couldn't be retrieved.
+}
+
+// DashboardEnvCost holds the cost of the resources of an environment.
+// Resources that belong to the application but not to an environment, such as ECR repositories, have an empty environment.
+type DashboardEnvCost struct {
+	Env    string  `json:"environment"`
+	Amount float64 `json:"amount"`
+}
+
+// AlarmsInState returns the names of the alarms of the service in the given state.
+func (s *DashboardService) AlarmsInState(state string) []string {
+	var names []string
+	for _, alarm := range s.Alarms {
+		if alarm.State == state {
+			names = append(names, alarm.Name)
+		}
+	}
+	return names
+}
+
+// NewAppDashboardDescriberConfig contains fields that initiates AppDashboardDescriber struct.
+type NewAppDashboardDescriberConfig struct {
+	App         string
+	ConfigStore ConfigStoreSvc
+	DeployStore DeployedEnvServicesLister
+}
+
+// AppDashboardDescriber gathers the status of the services of an application into a dashboard.
+type AppDashboardDescriber struct {
+	app         string
+	store       ConfigStoreSvc
+	deployStore DeployedEnvServicesLister
+	costs       costGetter
+
+	describeStatus   func(env, svc, svcType string) (HumanJSONStringer, error)
+	describeEndpoint func(env, svc string) (string, error)
+	now              func() time.Time
+}
+
+// NewAppDashboardDescriber instantiates an AppDashboardDescriber.
+func NewAppDashboardDescriber(opt NewAppDashboardDescriberConfig) (*AppDashboardDescriber, error) {
+	sess, err := sessions.ImmutableProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	return &AppDashboardDescriber{
+		app:         opt.App,
+		store:       opt.ConfigStore,
+		deployStore: opt.DeployStore,
+		costs:       costexplorer.New(sess),
+		describeStatus: func(env, svc, svcType string) (HumanJSONStringer, error) {
+			cfg := &NewServiceStatusConfig{
+				App:         opt.App,
+				Env:         env,
+				Svc:         svc,
+				ConfigStore: opt.ConfigStore,
+			}
+			var d interface {
+				Describe() (HumanJSONStringer, error)
+			}
+			var err error
+			switch svcType {
+			case manifestinfo.RequestDrivenWebServiceType:
+				d, err = NewAppRunnerStatusDescriber(cfg)
+			case manifestinfo.StaticSiteType:
+				d, err = NewStaticSiteStatusDescriber(cfg)
+			default:
+				d, err = NewECSStatusDescriber(cfg)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return d.Describe()
+		},
+		describeEndpoint: func(env, svc string) (string, error) {
+			d, err := NewReachableService(opt.App, svc, opt.ConfigStore)
+			if err != nil {
+				return "", err
+			}
+			uri, err := d.URI(env)
+			if err != nil {
+				return "", err
+			}
+			return uri.URI, nil
+		},
+		now: time.Now,
+	}, nil
+}
+
+// Describe returns the dashboard of the application.
+// Services that can't be described and costs that can't be retrieved are reported in the dashboard instead of failing.
+func (d *AppDashboardDescriber) Describe() (*AppDashboard, error) {
+	envs, err := d.store.ListEnvironments(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments of application %s: %w", d.app, err)
+	}
+	svcs, err := d.store.ListServices(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list services of application %s: %w", d.app, err)
+	}
+	svcTypes := make(map[string]string, len(svcs))
+	for _, svc := range svcs {
+		svcTypes[svc.Name] = svc.Type
+	}
+	dashboard := &AppDashboard{
+		App:         d.app,
+		GeneratedAt: d.now().UTC(),
+	}
+	for _, env := range envs {
+		deployed, err := d.deployStore.ListDeployedServices(d.app, env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list services deployed in environment %s: %w", env.Name, err)
+		}
+		sort.Strings(deployed)
+		dashboardEnv := &DashboardEnv{
+			Name:      env.Name,
+			Region:    env.Region,
+			AccountID: env.AccountID,
+		}
+		for _, svc := range deployed {
+			dashboardEnv.Services = append(dashboardEnv.Services, d.describeService(env.Name, svc, svcTypes[svc]))
+		}
+		dashboard.Environments = append(dashboard.Environments, dashboardEnv)
+	}
+	dashboard.Costs = d.describeCosts(dashboard.GeneratedAt)
+	return dashboard, nil
+}
+
+func (d *AppDashboardDescriber) describeService(env, svc, svcType string) *DashboardService {
+	out := &DashboardService{
+		Name: svc,
+		Type: svcType,
+	}
+	var errs []string
+	endpoint, err := d.describeEndpoint(env, svc)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("describe endpoint: %v", err))
+	}
+	out.Endpoint = endpoint
+	status, err := d.describeStatus(env, svc, svcType)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("describe status: %v", err))
+		out.Error = strings.Join(errs, "; ")
+		return out
+	}
+	switch status := status.(type) {
+	case *ECSServiceStatus:
+		for _, deployment := range status.Service.Deployments {
+			if deployment.Status == "PRIMARY" {
+				out.Version = taskDefinitionRevision(deployment.TaskDefinition)
+			}
+			out.Deployments = append(out.Deployments, DashboardDeployment{
+				Status:    deployment.Status,
+				UpdatedAt: deployment.UpdatedAt,
+			})
+		}
+		for _, alarm := range status.Alarms {
+			out.Alarms = append(out.Alarms, DashboardAlarm{
+				Name:  alarm.Name,
+				State: alarm.Status,
+			})
+		}
+	case *AppRunnerServiceStatus:
+		out.Version = status.Service.ImageID
+		for _, op := range status.Deployments {
+			updatedAt := op.StartedAt
+			if op.EndedAt != nil {
+				updatedAt = *op.EndedAt
+			}
+			out.Deployments = append(out.Deployments, DashboardDeployment{
+				Status:    op.Status,
+				UpdatedAt: updatedAt,
+			})
+		}
+	}
+	sort.SliceStable(out.Deployments, func(i, j int) bool {
+		return out.Deployments[i].UpdatedAt.After(out.Deployments[j].UpdatedAt)
+	})
+	if len(out.Deployments) > dashboardDeploymentsLimit {
+		out.Deployments = out.Deployments[:dashboardDeploymentsLimit]
+	}
+	out.Error = strings.Join(errs, "; ")
+	return out
+}
+
+func (d *AppDashboardDescriber) describeCosts(now time.Time) *DashboardCosts {
+	costs := &DashboardCosts{
+		Start: now.Add(-dashboardCostPeriod).Truncate(24 * time.Hour),
+		End:   now.Truncate(24 * time.Hour),
+	}
+	byEnv, err := d.costs.CostsByTag(deploy.AppTagKey, d.app, deploy.EnvTagKey, costs.Start, costs.End)
+	if err != nil {
+		costs.Error = err.Error()
+		return costs
+	}
+	for env, cost := range byEnv {
+		costs.Environments = append(costs.Environments, DashboardEnvCost{
+			Env:    env,
+			Amount: cost.Amount,
+		})
+		costs.Total += cost.Amount
+		costs.Unit = cost.Unit
+	}
+	sort.Slice(costs.Environments, func(i, j int) bool {
+		return costs.Environments[i].Env < costs.Environments[j].Env
+	})
+	return costs
+}
+
+// taskDefinitionRevision returns the family and revision at the end of the ARN of a task definition.
+func taskDefinitionRevision(arn string) string {
+	if idx := strings.LastIndex(arn, "/"); idx != -1 {
+		return arn[idx+1:]
+	}
+	return arn
+}
@@ -0,0 +1,225 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+)
+
+// Formats in which an AppDashboard can be rendered.
+const (
+	DashboardFormatMarkdown = "markdown"
+	DashboardFormatHTML     = "html"
+	DashboardFormatJSON     = "json"
+)
+
+// DashboardFormats are the formats in which an AppDashboard can be rendered.
+var DashboardFormats = []string{DashboardFormatMarkdown, DashboardFormatHTML, DashboardFormatJSON}
+
+const (
+	dashboardTimeLayout = "2006-01-02 15:04 MST"
+	dashboardDateLayout = "2006-01-02"
+	dashboardAlarmState = "ALARM"
+	// Name of the group of the costs of the resources that belong to the application but not to an environment.
+	dashboardAppCostGroup = "Application-wide"
+)
+
+// Render returns the dashboard in the given format.
+func (d *AppDashboard) Render(format string) (string, error) {
+	switch format {
+	case DashboardFormatMarkdown:
+		return d.Markdown(), nil
+	case DashboardFormatHTML:
+		return d.HTML()
+	case DashboardFormatJSON:
+		return d.JSONString()
+	}
+	return "", fmt.Errorf("unsupported format %q: must be one of %s", format, strings.Join(DashboardFormats, ", "))
+}
+
+// JSONString returns the stringified AppDashboard struct with json format.
+func (d *AppDashboard) JSONString() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("marshal dashboard of application %s: %w", d.App, err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// Markdown returns the dashboard as a Markdown document.
+func (d *AppDashboard) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Application %s\n\n", d.App)
+	fmt.Fprintf(&b, "Generated on %s.\n", d.GeneratedAt.Format(dashboardTimeLayout))
+	for _, env := range d.Environments {
+		fmt.Fprintf(&b, "\n## Environment %s\n\n", env.Name)
+		fmt.Fprintf(&b, "Region %s, account %s.\n\n", env.Region, env.AccountID)
+		if len(env.Services) == 0 {
+			b.WriteString("No services are deployed in this environment.\n")
+			continue
+		}
+		b.WriteString("| Service | Type | Version | Endpoint | Alarms | Recent Deployments |\n")
+		b.WriteString("| --- | --- | --- | --- | --- | --- |\n")
+		for _, svc := range env.Services {
+			fmt.Fprintf(&b, "| %s |\n", strings.Join(applyAll([]string{
+				svc.Name, svc.Type, svc.Version, svc.Endpoint, dashboardAlarmsSummary(svc), dashboardDeploymentsSummary(svc),
+			}, markdownCell), " | "))
+		}
+		var errs []string
+		for _, svc := range env.Services {
+			if svc.Error != "" {
+				errs = append(errs, fmt.Sprintf("- %s: %s\n", svc.Name, svc.Error))
+			}
+		}
+		if len(errs) != 0 {
+			b.WriteString("\nSome services could not be fully described:\n\n")
+			b.WriteString(strings.Join(errs, ""))
+		}
+	}
+	if d.Costs != nil {
+		b.WriteString("\n## Costs\n\n")
+		fmt.Fprintf(&b, "From %s to %s.\n\n", d.Costs.Start.Format(dashboardDateLayout), d.Costs.End.Format(dashboardDateLayout))
+		switch {
+		case d.Costs.Error != "":
+			fmt.Fprintf(&b, "The costs are unavailable: %s\n", d.Costs.Error)
+		case len(d.Costs.Environments) == 0:
+			b.WriteString("No costs were recorded. Activate the \"copilot-application\" and \"copilot-environment\" cost allocation tags to track the costs of the application.\n")
+		default:
+			b.WriteString("| Environment | Cost |\n")
+			b.WriteString("| --- | --- |\n")
+			for _, cost := range d.Costs.Environments {
+				fmt.Fprintf(&b, "| %s | %s |\n", markdownCell(dashboardCostGroup(cost.Env)), formatCost(cost.Amount, d.Costs.Unit))
+			}
+			fmt.Fprintf(&b, "| **Total** | **%s** |\n", formatCost(d.Costs.Total, d.Costs.Unit))
+		}
+	}
+	return b.String()
+}
+
+var dashboardHTMLTemplate = template.Must(template.New("dashboard").Funcs(template.FuncMap{
+	"formatTime":  func(t time.Time) string { return t.Format(dashboardTimeLayout) },
+	"formatDate":  func(t time.Time) string { return t.Format(dashboardDateLayout) },
+	"formatCost":  formatCost,
+	"alarms":      dashboardAlarmsSummary,
+	"deployments": dashboardDeploymentsSummary,
+	"costGroup":   dashboardCostGroup,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Application {{.App}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 0.4em 0.8em; text-align: left; }
+th { background: #f4f4f4; }
+.error { color: #b00020; }
+</style>
+</head>
+<body>
+<h1>Application {{.App}}</h1>
+<p>Generated on {{formatTime .GeneratedAt}}.</p>
+{{- range $env := .Environments}}
+<h2>Environment {{$env.Name}}</h2>
+<p>Region {{$env.Region}}, account {{$env.AccountID}}.</p>
+{{- if not $env.Services}}
+<p>No services are deployed in this environment.</p>
+{{- else}}
+<table>
+<tr><th>Service</th><th>Type</th><th>Version</th><th>Endpoint</th><th>Alarms</th><th>Recent Deployments</th></tr>
+{{- range $svc := $env.Services}}
+<tr><td>{{$svc.Name}}</td><td>{{$svc.Type}}</td><td>{{$svc.Version}}</td><td>{{$svc.Endpoint}}</td><td>{{alarms $svc}}</td><td>{{deployments $svc}}</td></tr>
+{{- end}}
+</table>
+{{- range $svc := $env.Services}}{{if $svc.Error}}
+<p class="error">{{$svc.Name}}: {{$svc.Error}}</p>
+{{- end}}{{end}}
+{{- end}}
+{{- end}}
+{{- with .Costs}}
+<h2>Costs</h2>
+<p>From {{formatDate .Start}} to {{formatDate .End}}.</p>
+{{- if .Error}}
+<p class="error">The costs are unavailable: {{.Error}}</p>
+{{- else if not .Environments}}
+<p>No costs were recorded. Activate the "copilot-application" and "copilot-environment" cost allocation tags to track the costs of the application.</p>
+{{- else}}
+<table>
+<tr><th>Environment</th><th>Cost</th></tr>
+{{- $unit := .Unit}}
+{{- range .Environments}}
+<tr><td>{{costGroup .Env}}</td><td>{{formatCost .Amount $unit}}</td></tr>
+{{- end}}
+<tr><th>Total</th><th>{{formatCost .Total .Unit}}</th></tr>
+</table>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
+
+// HTML returns the dashboard as a standalone HTML page.
+func (d *AppDashboard) HTML() (string, error) {
+	var b bytes.Buffer
+	if err := dashboardHTMLTemplate.Execute(&b, d); err != nil {
+		return "", fmt.Errorf("render dashboard of application %s as HTML: %w", d.App, err)
+	}
+	return b.String(), nil
+}
+
+// dashboardAlarmsSummary returns the number of alarms of a service and the names of those in the ALARM state.
+func dashboardAlarmsSummary(svc *DashboardService) string {
+	if len(svc.Alarms) == 0 {
+		return "-"
+	}
+	inAlarm := svc.AlarmsInState(dashboardAlarmState)
+	if len(inAlarm) == 0 {
+		return fmt.Sprintf("%d OK", len(svc.Alarms))
+	}
+	return fmt.Sprintf("%d of %d in ALARM: %s", len(inAlarm), len(svc.Alarms), strings.Join(inAlarm, ", "))
+}
+
+// dashboardDeploymentsSummary returns the status and time of the recent deployments of a service.
+func dashboardDeploymentsSummary(svc *DashboardService) string {
+	if len(svc.Deployments) == 0 {
+		return "-"
+	}
+	deployments := make([]string, len(svc.Deployments))
+	for i, deployment := range svc.Deployments {
+		deployments[i] = fmt.Sprintf("%s (%s)", deployment.UpdatedAt.UTC().Format(dashboardTimeLayout), deployment.Status)
+	}
+	return strings.Join(deployments, ", ")
+}
+
+func dashboardCostGroup(env string) string {
+	if env == "" {
+		return dashboardAppCostGroup
+	}
+	return env
+}
+
+func formatCost(amount float64, unit string) string {
+	return strings.TrimSpace(fmt.Sprintf("%.2f %s", amount, unit))
+}
+
+// markdownCell escapes the characters of a value that would break a Markdown table cell.
+func markdownCell(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(value)
+}
+
+func applyAll(values []string, fn func(string) string) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = fn(v)
+	}
+	return out
+}
@@ -0,0 +1,357 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppDashboardDescriber_Describe(t *testing.T) {
+	now := time.Date(2023, time.June, 1, 12, 30, 0, 0, time.UTC)
+	costStart := time.Date(2023, time.May, 2, 0, 0, 0, 0, time.UTC)
+	costEnd := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks     func(store *mocks.MockConfigStoreSvc, deployStore *mocks.MockDeployedEnvServicesLister, costs *mocks.MockcostGetter)
+		describeStatus func(env, svc, svcType string) (HumanJSONStringer, error)
+
+		wanted    *AppDashboard
+		wantedErr error
+	}{
+		"error if fail to list environments": {
+			setupMocks: func(store *mocks.MockConfigStoreSvc, _ *mocks.MockDeployedEnvServicesLister, _ *mocks.MockcostGetter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments of application phonetool: some error"),
+		},
+		"error if fail to list the deployed services": {
+			setupMocks: func(store *mocks.MockConfigStoreSvc, deployStore *mocks.MockDeployedEnvServicesLister, _ *mocks.MockcostGetter) {
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				store.EXPECT().ListServices("phonetool").Return(nil, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list services deployed in environment test: some error"),
+		},
+		"describes the services of each environment and the costs": {
+			setupMocks: func(store *mocks.MockConfigStoreSvc, deployStore *mocks.MockDeployedEnvServicesLister, costs *mocks.MockcostGetter) {
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test", Region: "us-west-2", AccountID: "123456789012"},
+					{Name: "prod", Region: "us-east-1", AccountID: "210987654321"},
+				}, nil)
+				store.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+					{Name: "api", Type: "Load Balanced Web Service"},
+					{Name: "frontend", Type: "Request-Driven Web Service"},
+				}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"frontend", "api"}, nil)
+				deployStore.EXPECT().ListDeployedServices("phonetool", "prod").Return(nil, nil)
+				costs.EXPECT().CostsByTag("copilot-application", "phonetool", "copilot-environment", costStart, costEnd).Return(map[string]costexplorer.Cost{
+					"test": {Amount: 12.5, Unit: "USD"},
+					"":     {Amount: 0.5, Unit: "USD"},
+				}, nil)
+			},
+			describeStatus: func(env, svc, svcType string) (HumanJSONStringer, error) {
+				if svc == "frontend" {
+					return nil, errors.New("some error")
+				}
+				return &ECSServiceStatus{
+					Service: awsecs.ServiceStatus{
+						Deployments: []awsecs.Deployment{
+							{Status: "ACTIVE", TaskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:11", UpdatedAt: now.Add(-time.Hour)},
+							{Status: "PRIMARY", TaskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:12", UpdatedAt: now.Add(-time.Minute)},
+						},
+					},
+					Alarms: []cloudwatch.AlarmStatus{
+						{Name: "CPU", Status: "OK"},
+						{Name: "Memory", Status: "ALARM"},
+					},
+				}, nil
+			},
+			wanted: &AppDashboard{
+				App:         "phonetool",
+				GeneratedAt: now,
+				Environments: []*DashboardEnv{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+						Services: []*DashboardService{
+							{
+								Name:     "api",
+								Type:     "Load Balanced Web Service",
+								Version:  "phonetool-test-api:12",
+								Endpoint: "https://api.example.com",
+								Alarms: []DashboardAlarm{
+									{Name: "CPU", State: "OK"},
+									{Name: "Memory", State: "ALARM"},
+								},
+								Deployments: []DashboardDeployment{
+									{Status: "PRIMARY", UpdatedAt: now.Add(-time.Minute)},
+									{Status: "ACTIVE", UpdatedAt: now.Add(-time.Hour)},
+								},
+							},
+							{
+								Name:     "frontend",
+								Type:     "Request-Driven Web Service",
+								Endpoint: "https://frontend.example.com",
+								Error:    "describe status: some error",
+							},
+						},
+					},
+					{
+						Name:      "prod",
+						Region:    "us-east-1",
+						AccountID: "210987654321",
+					},
+				},
+				Costs: &DashboardCosts{
+					Start: costStart,
+					End:   costEnd,
+					Environments: []DashboardEnvCost{
+						{Env: "", Amount: 0.5},
+						{Env: "test", Amount: 12.5},
+					},
+					Total: 13,
+					Unit:  "USD",
+				},
+			},
+		},
+		"reports the costs that can't be retrieved": {
+			setupMocks: func(store *mocks.MockConfigStoreSvc, deployStore *mocks.MockDeployedEnvServicesLister, costs *mocks.MockcostGetter) {
+				store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				store.EXPECT().ListServices("phonetool").Return(nil, nil)
+				costs.EXPECT().CostsByTag(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wanted: &AppDashboard{
+				App:         "phonetool",
+				GeneratedAt: now,
+				Costs: &DashboardCosts{
+					Start: costStart,
+					End:   costEnd,
+					Error: "some error",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockConfigStoreSvc(ctrl)
+			mockDeployStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
+			mockCosts := mocks.NewMockcostGetter(ctrl)
+			tc.setupMocks(mockStore, mockDeployStore, mockCosts)
+			d := &AppDashboardDescriber{
+				app:            "phonetool",
+				store:          mockStore,
+				deployStore:    mockDeployStore,
+				costs:          mockCosts,
+				describeStatus: tc.describeStatus,
+				describeEndpoint: func(env, svc string) (string, error) {
+					return "https://" + svc + ".example.com", nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			got, err := d.Describe()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestAppDashboardDescriber_describeService(t *testing.T) {
+	started := time.Date(2023, time.June, 1, 10, 0, 0, 0, time.UTC)
+	ended := started.Add(5 * time.Minute)
+	d := &AppDashboardDescriber{
+		describeStatus: func(env, svc, svcType string) (HumanJSONStringer, error) {
+			return &AppRunnerServiceStatus{
+				Service: apprunner.Service{ImageID: "public.ecr.aws/nginx/nginx:1.25"},
+				Deployments: []apprunner.Operation{
+					{Status: "IN_PROGRESS", StartedAt: ended.Add(time.Hour)},
+					{Status: "SUCCEEDED", StartedAt: started, EndedAt: &ended},
+				},
+			}, nil
+		},
+		describeEndpoint: func(env, svc string) (string, error) {
+			return "", errors.New("some error")
+		},
+	}
+
+	got := d.describeService("test", "frontend", "Request-Driven Web Service")
+
+	require.Equal(t, &DashboardService{
+		Name:    "frontend",
+		Type:    "Request-Driven Web Service",
+		Version: "public.ecr.aws/nginx/nginx:1.25",
+		Deployments: []DashboardDeployment{
+			{Status: "IN_PROGRESS", UpdatedAt: ended.Add(time.Hour)},
+			{Status: "SUCCEEDED", UpdatedAt: ended},
+		},
+		Error: "describe endpoint: some error",
+	}, got)
+}
+
+func TestAppDashboard_Render(t *testing.T) {
+	dashboard := &AppDashboard{
+		App:         "phonetool",
+		GeneratedAt: time.Date(2023, time.June, 1, 12, 30, 0, 0, time.UTC),
+		Environments: []*DashboardEnv{
+			{
+				Name:      "test",
+				Region:    "us-west-2",
+				AccountID: "123456789012",
+				Services: []*DashboardService{
+					{
+						Name:     "api",
+						Type:     "Load Balanced Web Service",
+						Version:  "phonetool-test-api:12",
+						Endpoint: "https://api.example.com",
+						Alarms: []DashboardAlarm{
+							{Name: "CPU", State: "OK"},
+							{Name: "Memory", State: "ALARM"},
+						},
+						Deployments: []DashboardDeployment{
+							{Status: "PRIMARY", UpdatedAt: time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)},
+						},
+					},
+					{
+						Name:  "frontend",
+						Type:  "Request-Driven Web Service",
+						Error: "describe status: <some error>",
+					},
+				},
+			},
+			{
+				Name:      "prod",
+				Region:    "us-east-1",
+				AccountID: "210987654321",
+			},
+		},
+		Costs: &DashboardCosts{
+			Start: time.Date(2023, time.May, 2, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC),
+			Environments: []DashboardEnvCost{
+				{Env: "", Amount: 0.5},
+				{Env: "test", Amount: 12.5},
+			},
+			Total: 13,
+			Unit:  "USD",
+		},
+	}
+	testCases := map[string]struct {
+		inFormat string
+
+		wanted    string
+		wantedErr error
+	}{
+		"markdown": {
+			inFormat: "markdown",
+			wanted: `# Application phonetool
+
+Generated on 2023-06-01 12:30 UTC.
+
+## Environment test
+
+Region us-west-2, account 123456789012.
+
+| Service | Type | Version | Endpoint | Alarms | Recent Deployments |
+| --- | --- | --- | --- | --- | --- |
+| api | Load Balanced Web Service | phonetool-test-api:12 | https://api.example.com | 1 of 2 in ALARM: Memory | 2023-06-01 12:00 UTC (PRIMARY) |
+| frontend | Request-Driven Web Service | - | - | - | - |
+
+Some services could not be fully described:
+
+- frontend: describe status: <some error>
+
+## Environment prod
+
+Region us-east-1, account 210987654321.
+
+No services are deployed in this environment.
+
+## Costs
+
+From 2023-05-02 to 2023-06-01.
+
+| Environment | Cost |
+| --- | --- |
+| Application-wide | 0.50 USD |
+| test | 12.50 USD |
+| **Total** | **13.00 USD** |
+`,
+		},
+		"html": {
+			inFormat: "html",
+			wanted: `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Application phonetool</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 0.4em 0.8em; text-align: left; }
+th { background: #f4f4f4; }
+.error { color: #b00020; }
+</style>
+</head>
+<body>
+<h1>Application phonetool</h1>
+<p>Generated on 2023-06-01 12:30 UTC.</p>
+<h2>Environment test</h2>
+<p>Region us-west-2, account 123456789012.</p>
+<table>
+<tr><th>Service</th><th>Type</th><th>Version</th><th>Endpoint</th><th>Alarms</th><th>Recent Deployments</th></tr>
+<tr><td>api</td><td>Load Balanced Web Service</td><td>phonetool-test-api:12</td><td>https://api.example.com</td><td>1 of 2 in ALARM: Memory</td><td>2023-06-01 12:00 UTC (PRIMARY)</td></tr>
+<tr><td>frontend</td><td>Request-Driven Web Service</td><td></td><td></td><td>-</td><td>-</td></tr>
+</table>
+<p class="error">frontend: describe status: &lt;some error&gt;</p>
+<h2>Environment prod</h2>
+<p>Region us-east-1, account 210987654321.</p>
+<p>No services are deployed in this environment.</p>
+<h2>Costs</h2>
+<p>From 2023-05-02 to 2023-06-01.</p>
+<table>
+<tr><th>Environment</th><th>Cost</th></tr>
+<tr><td>Application-wide</td><td>0.50 USD</td></tr>
+<tr><td>test</td><td>12.50 USD</td></tr>
+<tr><th>Total</th><th>13.00 USD</th></tr>
+</table>
+</body>
+</html>
+`,
+		},
+		"unsupported format": {
+			inFormat:  "pdf",
+			wantedErr: errors.New(`unsupported format "pdf": must be one of markdown, html, json`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := dashboard.Render(tc.inFormat)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
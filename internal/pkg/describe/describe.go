@@ -8,7 +8,9 @@ import (
 	"io"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 
 	"github.com/dustin/go-humanize"
@@ -36,6 +38,19 @@ type HumanJSONStringer interface {
 	JSONString() (string, error)
 }
 
+// SessionProvider creates the sessions of the describers.
+type SessionProvider interface {
+	FromRole(roleARN string, region string) (*session.Session, error)
+}
+
+// sessionsOrDefault returns p, or the sessions shared by the process if p is nil.
+func sessionsOrDefault(p SessionProvider) SessionProvider {
+	if p == nil {
+		return sessions.ImmutableProvider()
+	}
+	return p
+}
+
 type stackDescriber interface {
 	Describe() (stack.StackDescription, error)
 	Resources() ([]*stack.Resource, error)
@@ -10,16 +10,19 @@ import (
 	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"gopkg.in/yaml.v3"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
-	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
@@ -32,6 +35,10 @@ type vpcSubnetLister interface {
 	ListVPCSubnets(vpcID string) (*ec2.VPCSubnets, error)
 }
 
+type certDescriber interface {
+	Certificate(arn string) (*acm.Certificate, error)
+}
+
 // EnvDescription contains the information about an environment.
 type EnvDescription struct {
 	Environment    *config.Environment `json:"environment"`
@@ -55,10 +62,15 @@ type EnvDescriber struct {
 	env             *config.Environment
 	enableResources bool
 
-	configStore  ConfigStoreSvc
-	deployStore  DeployedEnvServicesLister
-	cfn          stackDescriber
-	subnetLister vpcSubnetLister
+	configStore               ConfigStoreSvc
+	deployStore               DeployedEnvServicesLister
+	cfn                       stackDescriber
+	subnetLister              vpcSubnetLister
+	newCertDescriber          func(region string) (certDescriber, error) // ACM client of the region of the certificates.
+	ecsClient                 ecsClient
+	cw                        metricValuesGetter
+	newWorkloadStackDescriber func(svc string) stackDescriber
+	now                       func() time.Time
 
 	// Cached values for reuse.
 	description *EnvDescription
@@ -71,6 +83,7 @@ type NewEnvDescriberConfig struct {
 	EnableResources bool
 	ConfigStore     ConfigStoreSvc
 	DeployStore     DeployedEnvServicesLister
+	SessionProvider SessionProvider // Optional. Defaults to the sessions shared by the process.
 }
 
 // NewEnvDescriber instantiates an environment describer.
@@ -79,7 +92,8 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get environment: %w", err)
 	}
-	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	sessProvider := sessionsOrDefault(opt.SessionProvider)
+	sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
 	if err != nil {
 		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
 	}
@@ -92,6 +106,22 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 		deployStore:  opt.DeployStore,
 		cfn:          stack.NewStackDescriber(cfnstack.NameForEnv(opt.App, opt.Env), sess),
 		subnetLister: ec2.New(sess),
+		newCertDescriber: func(region string) (certDescriber, error) {
+			if region == env.Region {
+				return acm.New(sess), nil
+			}
+			regionalSess, err := sessProvider.FromRole(env.ManagerRoleARN, region)
+			if err != nil {
+				return nil, fmt.Errorf("assume role for environment %s in region %s: %w", env.ManagerRoleARN, region, err)
+			}
+			return acm.New(regionalSess), nil
+		},
+		ecsClient: ecs.New(sess),
+		cw:        cloudwatch.New(sess),
+		newWorkloadStackDescriber: func(svc string) stackDescriber {
+			return stack.NewStackDescriber(cfnstack.NameForWorkload(opt.App, opt.Env, svc), sess)
+		},
+		now: time.Now,
 	}, nil
 }
 
@@ -0,0 +1,422 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/dustin/go-humanize"
+	"github.com/dustin/go-humanize/english"
+)
+
+const (
+	// AWS reserves the first four and the last IP addresses of each subnet.
+	reservedIPsPerSubnet = 5
+	// Each task takes one network interface, and so one IP address, with the awsvpc network mode.
+	enisPerTask = 1
+
+	subnetsTypePublic  = "public"
+	subnetsTypePrivate = "private"
+
+	natGatewayResourceType     = "AWS::EC2::NatGateway"
+	natGatewayMetricsNamespace = "AWS/NATGateway"
+	// The usage of the NAT gateways is aggregated over this period.
+	natGatewayMetricsPeriod = 24 * time.Hour
+)
+
+// SubnetCapacity describes the IP addresses of a subnet of an environment.
+type SubnetCapacity struct {
+	ID               string `json:"id"`
+	Type             string `json:"type"` // Either "public" or "private".
+	AvailabilityZone string `json:"availabilityZone"`
+	CIDRBlock        string `json:"cidrBlock"`
+	TotalIPs         int    `json:"totalIPs"` // The number of IP addresses that can be assigned in the subnet.
+	AvailableIPs     int    `json:"availableIPs"`
+}
+
+// ServiceCapacity describes the network interfaces that a service takes in the subnets of an environment.
+type ServiceCapacity struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Subnets      string `json:"subnets,omitempty"` // The type of subnets of the tasks, empty if unknown.
+	RunningTasks int    `json:"runningTasks"`
+	MaxTasks     int    `json:"maxTasks"`
+	ENIs         int    `json:"enis"`
+}
+
+// NATGatewayUsage describes the traffic of a NAT gateway of an environment over the last day.
+type NATGatewayUsage struct {
+	ID                   string  `json:"id"`
+	BytesOut             float64 `json:"bytesOutToDestination"`
+	BytesIn              float64 `json:"bytesInFromDestination"`
+	PeakBytesPerSecond   float64 `json:"peakBytesPerSecond"`
+	PortAllocationErrors float64 `json:"portAllocationErrors"`
+}
+
+// SubnetsHeadroom describes the IP addresses left in the public or private subnets of an environment
+// if all the services placed in them scale out to their maximum number of tasks.
+type SubnetsHeadroom struct {
+	Subnets      string `json:"subnets"`
+	AvailableIPs int    `json:"availableIPs"`
+	ScaleOutIPs  int    `json:"scaleOutIPs"` // The IP addresses taken by the tasks that the services would add.
+	Headroom     int    `json:"headroom"`    // Negative if the subnets would run out of IP addresses.
+}
+
+// EnvCapacity describes the IP addresses and network interfaces used in the subnets of an environment,
+// the traffic of its NAT gateways, and the headroom left if its services scale out to their maximum number of tasks.
+type EnvCapacity struct {
+	Subnets     []*SubnetCapacity  `json:"subnets"`
+	Services    []*ServiceCapacity `json:"services"`
+	OtherENIs   int                `json:"otherENIs"` // The network interfaces of load balancers, NAT gateways, VPC endpoints and other resources.
+	NATGateways []*NATGatewayUsage `json:"natGateways,omitempty"`
+	Headroom    []*SubnetsHeadroom `json:"headroom"`
+}
+
+// Capacity returns the IP addresses and network interfaces used in the subnets of the environment, the traffic of its NAT gateways
+// over the last day, and the IP addresses left if the services of the environment scale out to their maximum number of tasks.
+func (d *EnvDescriber) Capacity() (*EnvCapacity, error) {
+	_, envVPC, err := d.loadStackInfo()
+	if err != nil {
+		return nil, err
+	}
+	subnets, err := d.subnetsCapacity(envVPC)
+	if err != nil {
+		return nil, err
+	}
+	svcs, err := d.filterDeployedSvcs()
+	if err != nil {
+		return nil, err
+	}
+	subnetTypes := make(map[string]string)
+	for _, subnet := range subnets {
+		subnetTypes[subnet.ID] = subnet.Type
+	}
+	var services []*ServiceCapacity
+	for _, svc := range svcs {
+		capacity, err := d.serviceCapacity(svc, subnetTypes)
+		if err != nil {
+			return nil, err
+		}
+		if capacity != nil {
+			services = append(services, capacity)
+		}
+	}
+	natGateways, err := d.natGatewaysUsage()
+	if err != nil {
+		return nil, err
+	}
+
+	out := &EnvCapacity{
+		Subnets:     subnets,
+		Services:    services,
+		NATGateways: natGateways,
+	}
+	var usedIPs int
+	for _, subnet := range subnets {
+		usedIPs += subnet.TotalIPs - subnet.AvailableIPs
+	}
+	for _, svc := range services {
+		usedIPs -= svc.ENIs
+	}
+	if usedIPs > 0 {
+		out.OtherENIs = usedIPs
+	}
+	for _, subnetsType := range []string{subnetsTypePublic, subnetsTypePrivate} {
+		headroom := &SubnetsHeadroom{
+			Subnets: subnetsType,
+		}
+		var found bool
+		for _, subnet := range subnets {
+			if subnet.Type == subnetsType {
+				found = true
+				headroom.AvailableIPs += subnet.AvailableIPs
+			}
+		}
+		if !found {
+			continue
+		}
+		for _, svc := range services {
+			if svc.Subnets == subnetsType && svc.MaxTasks > svc.RunningTasks {
+				headroom.ScaleOutIPs += (svc.MaxTasks - svc.RunningTasks) * enisPerTask
+			}
+		}
+		headroom.Headroom = headroom.AvailableIPs - headroom.ScaleOutIPs
+		out.Headroom = append(out.Headroom, headroom)
+	}
+	return out, nil
+}
+
+func (d *EnvDescriber) subnetsCapacity(envVPC EnvironmentVPC) ([]*SubnetCapacity, error) {
+	vpcSubnets, err := d.subnetLister.ListVPCSubnets(envVPC.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list subnets of vpc %s in environment %s: %w", envVPC.ID, d.env.Name, err)
+	}
+	vpcSubnetsByID := make(map[string]ec2.Subnet)
+	for _, subnet := range append(vpcSubnets.Public, vpcSubnets.Private...) {
+		vpcSubnetsByID[subnet.ID] = subnet
+	}
+	var subnets []*SubnetCapacity
+	for _, subnetsOfType := range []struct {
+		typ string
+		ids []string
+	}{
+		{typ: subnetsTypePublic, ids: envVPC.PublicSubnetIDs},
+		{typ: subnetsTypePrivate, ids: envVPC.PrivateSubnetIDs},
+	} {
+		for _, id := range subnetsOfType.ids {
+			subnet, ok := vpcSubnetsByID[id]
+			if !ok {
+				continue
+			}
+			subnets = append(subnets, &SubnetCapacity{
+				ID:               subnet.ID,
+				Type:             subnetsOfType.typ,
+				AvailabilityZone: subnet.AvailabilityZone,
+				CIDRBlock:        subnet.CIDRBlock,
+				TotalIPs:         assignableIPs(subnet.CIDRBlock),
+				AvailableIPs:     subnet.AvailableIPs,
+			})
+		}
+	}
+	return subnets, nil
+}
+
+// serviceCapacity returns the network interfaces of a service, or nil if the service doesn't run ECS tasks in the VPC of the environment.
+func (d *EnvDescriber) serviceCapacity(svc *config.Workload, subnetTypes map[string]string) (*ServiceCapacity, error) {
+	switch svc.Type {
+	case manifestinfo.LoadBalancedWebServiceType, manifestinfo.BackendServiceType, manifestinfo.WorkerServiceType:
+	default:
+		return nil, nil
+	}
+	ecsSvc, err := d.ecsClient.Service(d.app, d.env.Name, svc.Name)
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service of %s: %w", svc.Name, err)
+	}
+	out := &ServiceCapacity{
+		Name:         svc.Name,
+		Type:         svc.Type,
+		RunningTasks: int(aws.Int64Value(ecsSvc.RunningCount)),
+		// Assume that the services deployed without their manifest in their template don't scale beyond their desired count.
+		MaxTasks: int(aws.Int64Value(ecsSvc.DesiredCount)),
+	}
+	out.ENIs = out.RunningTasks * enisPerTask
+
+	raw, err := (&WorkloadStackDescriber{
+		app:  d.app,
+		env:  d.env.Name,
+		name: svc.Name,
+		cfn:  d.newWorkloadStackDescriber(svc.Name),
+	}).Manifest()
+	if err != nil {
+		var errNotFound *ErrManifestNotFoundInTemplate
+		if errors.As(err, &errNotFound) {
+			return out, nil
+		}
+		return nil, err
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest of %s: %w", svc.Name, err)
+	}
+	envMft, err := mft.ApplyEnv(d.env.Name)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s overrides to manifest of %s: %w", d.env.Name, svc.Name, err)
+	}
+	var count manifest.Count
+	var placement manifest.PlacementArgOrString
+	switch mft := envMft.Manifest().(type) {
+	case *manifest.LoadBalancedWebService:
+		count, placement = mft.Count, mft.Network.VPC.Placement
+	case *manifest.BackendService:
+		count, placement = mft.Count, mft.Network.VPC.Placement
+	case *manifest.WorkerService:
+		count, placement = mft.Count, mft.Network.VPC.Placement
+	}
+	maxTasks, err := count.Max()
+	if err != nil {
+		return nil, fmt.Errorf("get maximum count of %s: %w", svc.Name, err)
+	}
+	if maxTasks != nil {
+		out.MaxTasks = aws.IntValue(maxTasks)
+	}
+	switch {
+	case placement.PlacementString != nil:
+		out.Subnets = string(*placement.PlacementString)
+	case len(placement.PlacementArgs.Subnets.IDs) > 0:
+		// Tasks placed in specific subnets are counted in the headroom of the type of these subnets.
+		out.Subnets = subnetTypes[placement.PlacementArgs.Subnets.IDs[0]]
+		for _, id := range placement.PlacementArgs.Subnets.IDs[1:] {
+			if subnetTypes[id] != out.Subnets {
+				out.Subnets = ""
+			}
+		}
+	case len(placement.PlacementArgs.Subnets.FromTags) == 0:
+		out.Subnets = string(manifest.PublicSubnetPlacement)
+	}
+	return out, nil
+}
+
+func (d *EnvDescriber) natGatewaysUsage() ([]*NATGatewayUsage, error) {
+	resources, err := d.cfn.Resources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment resources: %w", err)
+	}
+	var natGateways []*NATGatewayUsage
+	var metrics []cloudwatch.Metric
+	for _, resource := range resources {
+		if resource.Type != natGatewayResourceType || resource.PhysicalID == "" {
+			continue
+		}
+		i := len(natGateways)
+		natGateways = append(natGateways, &NATGatewayUsage{ID: resource.PhysicalID})
+		dimensions := map[string]string{"NatGatewayId": resource.PhysicalID}
+		for _, metric := range []struct {
+			id   string
+			name string
+			stat string
+		}{
+			{id: "bytesOut", name: "BytesOutToDestination", stat: "Sum"},
+			{id: "bytesIn", name: "BytesInFromDestination", stat: "Sum"},
+			// The NAT gateway metrics are published every minute.
+			{id: "peakBytesOut", name: "BytesOutToDestination", stat: "Maximum"},
+			{id: "peakBytesIn", name: "BytesInFromDestination", stat: "Maximum"},
+			{id: "portAllocationErrors", name: "ErrorPortAllocation", stat: "Sum"},
+		} {
+			metrics = append(metrics, cloudwatch.Metric{
+				ID:         metric.id + strconv.Itoa(i),
+				Namespace:  natGatewayMetricsNamespace,
+				Name:       metric.name,
+				Dimensions: dimensions,
+				Stat:       metric.stat,
+				Period:     natGatewayMetricsPeriod,
+			})
+		}
+	}
+	if len(natGateways) == 0 {
+		return nil, nil
+	}
+	end := d.now().Truncate(time.Minute)
+	values, err := d.cw.LatestMetricValues(metrics, end.Add(-natGatewayMetricsPeriod), end)
+	if err != nil {
+		return nil, fmt.Errorf("get metrics of the NAT gateways: %w", err)
+	}
+	for i, natGateway := range natGateways {
+		suffix := strconv.Itoa(i)
+		natGateway.BytesOut = values["bytesOut"+suffix]
+		natGateway.BytesIn = values["bytesIn"+suffix]
+		natGateway.PeakBytesPerSecond = math.Max(values["peakBytesOut"+suffix], values["peakBytesIn"+suffix]) / time.Minute.Seconds()
+		natGateway.PortAllocationErrors = values["portAllocationErrors"+suffix]
+	}
+	return natGateways, nil
+}
+
+// assignableIPs returns the number of IP addresses that can be assigned in a subnet, or 0 if the CIDR block is invalid.
+func assignableIPs(cidrBlock string) int {
+	_, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return 0
+	}
+	ones, bits := ipNet.Mask.Size()
+	if total := 1 << (bits - ones); total > reservedIPsPerSubnet {
+		return total - reservedIPsPerSubnet
+	}
+	return 0
+}
+
+// JSONString returns the stringified EnvCapacity struct with json format.
+func (c *EnvCapacity) JSONString() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal environment capacity: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified EnvCapacity struct with human readable format.
+func (c *EnvCapacity) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	writeTable := func(title string, headers []string, rows [][]string) {
+		fmt.Fprint(writer, color.Bold.Sprintf("%s\n\n", title))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, row := range rows {
+			fmt.Fprintf(writer, "  %s\n", strings.Join(row, "\t"))
+		}
+		writer.Flush()
+	}
+
+	var rows [][]string
+	for _, subnet := range c.Subnets {
+		rows = append(rows, []string{subnet.ID, subnet.Type, subnet.AvailabilityZone, subnet.CIDRBlock,
+			fmt.Sprintf("%d/%d", subnet.AvailableIPs, subnet.TotalIPs)})
+	}
+	writeTable("Subnets", []string{"ID", "Type", "Availability Zone", "CIDR Block", "Available IPs"}, rows)
+
+	rows = nil
+	for _, svc := range c.Services {
+		subnets := svc.Subnets
+		if subnets == "" {
+			subnets = "-"
+		}
+		rows = append(rows, []string{svc.Name, svc.Type, subnets, strconv.Itoa(svc.RunningTasks), strconv.Itoa(svc.MaxTasks), strconv.Itoa(svc.ENIs)})
+	}
+	rows = append(rows, []string{"Other", "-", "-", "-", "-", strconv.Itoa(c.OtherENIs)})
+	fmt.Fprintln(writer)
+	writeTable("Network Interfaces", []string{"Name", "Type", "Subnets", "Running Tasks", "Max Tasks", "ENIs"}, rows)
+
+	if len(c.NATGateways) != 0 {
+		rows = nil
+		for _, natGateway := range c.NATGateways {
+			rows = append(rows, []string{natGateway.ID, humanize.Bytes(uint64(natGateway.BytesOut)), humanize.Bytes(uint64(natGateway.BytesIn)),
+				humanize.Bytes(uint64(natGateway.PeakBytesPerSecond)) + "/s", strconv.Itoa(int(natGateway.PortAllocationErrors))})
+		}
+		fmt.Fprintln(writer)
+		writeTable("NAT Gateways (last 24 hours)", []string{"ID", "Bytes Out", "Bytes In", "Peak Bandwidth", "Port Allocation Errors"}, rows)
+	}
+
+	rows = nil
+	var warnings []string
+	for _, headroom := range c.Headroom {
+		rows = append(rows, []string{headroom.Subnets, strconv.Itoa(headroom.AvailableIPs), strconv.Itoa(headroom.ScaleOutIPs), strconv.Itoa(headroom.Headroom)})
+		if headroom.Headroom < 0 {
+			warnings = append(warnings, fmt.Sprintf("The %s subnets would run out of IP addresses if the services scaled out to their maximum count.", headroom.Subnets))
+		}
+	}
+	for _, natGateway := range c.NATGateways {
+		if natGateway.PortAllocationErrors > 0 {
+			warnings = append(warnings, fmt.Sprintf("NAT gateway %s couldn't allocate a source port to %s.",
+				natGateway.ID, english.Plural(int(natGateway.PortAllocationErrors), "connection", "")))
+		}
+	}
+	fmt.Fprintln(writer)
+	writeTable("Headroom at Maximum Count", []string{"Subnets", "Available IPs", "Scale-out IPs", "Headroom"}, rows)
+
+	if len(warnings) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nWarnings\n\n"))
+		writer.Flush()
+		for _, warning := range warnings {
+			fmt.Fprintf(writer, "  %s\n", color.Yellow.Sprint(warning))
+		}
+		writer.Flush()
+	}
+	return b.String()
+}
@@ -0,0 +1,299 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type envCapacityMocks struct {
+	cfn          *mocks.MockstackDescriber
+	subnetLister *mocks.MockvpcSubnetLister
+	configStore  *mocks.MockConfigStoreSvc
+	deployStore  *mocks.MockDeployedEnvServicesLister
+	ecs          *mocks.MockecsClient
+	cw           *mocks.MockmetricValuesGetter
+	svcStacks    map[string]*mocks.MockstackDescriber
+}
+
+func TestEnvDescriber_Capacity(t *testing.T) {
+	now := time.Date(2023, time.June, 1, 12, 30, 15, 0, time.UTC)
+	metadata := func(mft string) string {
+		return fmt.Sprintf(`{"Version":"1.20.0","Manifest":%q}`, mft)
+	}
+	const apiManifest = `name: api
+type: Load Balanced Web Service
+image:
+  location: nginx
+http:
+  path: /
+count:
+  range: 1-10
+  cpu_percentage: 70
+environments:
+  test:
+    count:
+      range: 1-20
+`
+	const workerManifest = `name: worker
+type: Worker Service
+image:
+  location: worker
+count: 5
+network:
+  vpc:
+    placement: private
+`
+	mockEnvStack := func(m envCapacityMocks) {
+		m.cfn.EXPECT().Describe().Return(stack.StackDescription{
+			Outputs: map[string]string{
+				"VpcId":          "vpc-1",
+				"PublicSubnets":  "subnet-1,subnet-2",
+				"PrivateSubnets": "subnet-3",
+			},
+		}, nil)
+		m.subnetLister.EXPECT().ListVPCSubnets("vpc-1").Return(&ec2.VPCSubnets{
+			Public: []ec2.Subnet{
+				{Resource: ec2.Resource{ID: "subnet-1"}, CIDRBlock: "10.0.0.0/24", AvailabilityZone: "us-west-2a", AvailableIPs: 240},
+				{Resource: ec2.Resource{ID: "subnet-2"}, CIDRBlock: "10.0.1.0/24", AvailabilityZone: "us-west-2b", AvailableIPs: 245},
+				{Resource: ec2.Resource{ID: "subnet-9"}, CIDRBlock: "10.0.9.0/24", AvailabilityZone: "us-west-2a", AvailableIPs: 10},
+			},
+			Private: []ec2.Subnet{
+				{Resource: ec2.Resource{ID: "subnet-3"}, CIDRBlock: "10.0.2.0/24", AvailabilityZone: "us-west-2a", AvailableIPs: 200},
+			},
+		}, nil)
+	}
+	mockServices := func(m envCapacityMocks) {
+		m.configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+			{Name: "api", Type: "Load Balanced Web Service"},
+			{Name: "worker", Type: "Worker Service"},
+			{Name: "frontend", Type: "Request-Driven Web Service"},
+		}, nil)
+		m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api", "worker", "frontend"}, nil)
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m envCapacityMocks)
+
+		wanted    *EnvCapacity
+		wantedErr error
+	}{
+		"error if fail to describe the environment stack": {
+			setupMocks: func(m envCapacityMocks) {
+				m.cfn.EXPECT().Describe().Return(stack.StackDescription{}, errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve environment stack: some error"),
+		},
+		"error if fail to get the ECS service of a service": {
+			setupMocks: func(m envCapacityMocks) {
+				mockEnvStack(m)
+				mockServices(m)
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get ECS service of api: some error"),
+		},
+		"error if fail to get the metrics of the NAT gateways": {
+			setupMocks: func(m envCapacityMocks) {
+				mockEnvStack(m)
+				m.configStore.EXPECT().ListServices("phonetool").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, nil)
+				m.cfn.EXPECT().Resources().Return([]*stack.Resource{
+					{Type: "AWS::EC2::NatGateway", LogicalID: "NatGateway1", PhysicalID: "nat-1"},
+				}, nil)
+				m.cw.EXPECT().LatestMetricValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get metrics of the NAT gateways: some error"),
+		},
+		"describe the capacity of the environment": {
+			setupMocks: func(m envCapacityMocks) {
+				mockEnvStack(m)
+				mockServices(m)
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(&awsecs.Service{
+					RunningCount: aws.Int64(2),
+					DesiredCount: aws.Int64(2),
+				}, nil)
+				m.svcStacks["api"].EXPECT().StackMetadata().Return(metadata(apiManifest), nil)
+				m.ecs.EXPECT().Service("phonetool", "test", "worker").Return(&awsecs.Service{
+					RunningCount: aws.Int64(3),
+					DesiredCount: aws.Int64(3),
+				}, nil)
+				m.svcStacks["worker"].EXPECT().StackMetadata().Return(metadata(workerManifest), nil)
+				m.cfn.EXPECT().Resources().Return([]*stack.Resource{
+					{Type: "AWS::EC2::VPC", LogicalID: "VPC", PhysicalID: "vpc-1"},
+					{Type: "AWS::EC2::NatGateway", LogicalID: "NatGateway1", PhysicalID: "nat-1"},
+				}, nil)
+				m.cw.EXPECT().LatestMetricValues(gomock.Any(), now.Add(-24*time.Hour).Truncate(time.Minute), now.Truncate(time.Minute)).Return(map[string]float64{
+					"bytesOut0":     1e9,
+					"bytesIn0":      2e9,
+					"peakBytesOut0": 6e6,
+					"peakBytesIn0":  1.2e7,
+				}, nil)
+			},
+			wanted: &EnvCapacity{
+				Subnets: []*SubnetCapacity{
+					{ID: "subnet-1", Type: "public", AvailabilityZone: "us-west-2a", CIDRBlock: "10.0.0.0/24", TotalIPs: 251, AvailableIPs: 240},
+					{ID: "subnet-2", Type: "public", AvailabilityZone: "us-west-2b", CIDRBlock: "10.0.1.0/24", TotalIPs: 251, AvailableIPs: 245},
+					{ID: "subnet-3", Type: "private", AvailabilityZone: "us-west-2a", CIDRBlock: "10.0.2.0/24", TotalIPs: 251, AvailableIPs: 200},
+				},
+				Services: []*ServiceCapacity{
+					{Name: "api", Type: "Load Balanced Web Service", Subnets: "public", RunningTasks: 2, MaxTasks: 20, ENIs: 2},
+					{Name: "worker", Type: "Worker Service", Subnets: "private", RunningTasks: 3, MaxTasks: 5, ENIs: 3},
+				},
+				OtherENIs: 63,
+				NATGateways: []*NATGatewayUsage{
+					{ID: "nat-1", BytesOut: 1e9, BytesIn: 2e9, PeakBytesPerSecond: 2e5},
+				},
+				Headroom: []*SubnetsHeadroom{
+					{Subnets: "public", AvailableIPs: 485, ScaleOutIPs: 18, Headroom: 467},
+					{Subnets: "private", AvailableIPs: 200, ScaleOutIPs: 2, Headroom: 198},
+				},
+			},
+		},
+		"use the desired count of the services deployed without their manifest": {
+			setupMocks: func(m envCapacityMocks) {
+				mockEnvStack(m)
+				m.configStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+					{Name: "api", Type: "Load Balanced Web Service"},
+				}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api"}, nil)
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(&awsecs.Service{
+					RunningCount: aws.Int64(1),
+					DesiredCount: aws.Int64(2),
+				}, nil)
+				m.svcStacks["api"].EXPECT().StackMetadata().Return(`{"Version":"v1.0.0"}`, nil)
+				m.cfn.EXPECT().Resources().Return(nil, nil)
+			},
+			wanted: &EnvCapacity{
+				Subnets: []*SubnetCapacity{
+					{ID: "subnet-1", Type: "public", AvailabilityZone: "us-west-2a", CIDRBlock: "10.0.0.0/24", TotalIPs: 251, AvailableIPs: 240},
+					{ID: "subnet-2", Type: "public", AvailabilityZone: "us-west-2b", CIDRBlock: "10.0.1.0/24", TotalIPs: 251, AvailableIPs: 245},
+					{ID: "subnet-3", Type: "private", AvailabilityZone: "us-west-2a", CIDRBlock: "10.0.2.0/24", TotalIPs: 251, AvailableIPs: 200},
+				},
+				Services: []*ServiceCapacity{
+					{Name: "api", Type: "Load Balanced Web Service", RunningTasks: 1, MaxTasks: 2, ENIs: 1},
+				},
+				OtherENIs: 67,
+				Headroom: []*SubnetsHeadroom{
+					{Subnets: "public", AvailableIPs: 485, Headroom: 485},
+					{Subnets: "private", AvailableIPs: 200, Headroom: 200},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := envCapacityMocks{
+				cfn:          mocks.NewMockstackDescriber(ctrl),
+				subnetLister: mocks.NewMockvpcSubnetLister(ctrl),
+				configStore:  mocks.NewMockConfigStoreSvc(ctrl),
+				deployStore:  mocks.NewMockDeployedEnvServicesLister(ctrl),
+				ecs:          mocks.NewMockecsClient(ctrl),
+				cw:           mocks.NewMockmetricValuesGetter(ctrl),
+				svcStacks: map[string]*mocks.MockstackDescriber{
+					"api":    mocks.NewMockstackDescriber(ctrl),
+					"worker": mocks.NewMockstackDescriber(ctrl),
+				},
+			}
+			tc.setupMocks(m)
+			d := &EnvDescriber{
+				app: "phonetool",
+				env: &config.Environment{
+					App:  "phonetool",
+					Name: "test",
+				},
+				configStore:  m.configStore,
+				deployStore:  m.deployStore,
+				cfn:          m.cfn,
+				subnetLister: m.subnetLister,
+				ecsClient:    m.ecs,
+				cw:           m.cw,
+				newWorkloadStackDescriber: func(svc string) stackDescriber {
+					return m.svcStacks[svc]
+				},
+				now: func() time.Time { return now },
+			}
+
+			// WHEN
+			got, err := d.Capacity()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestEnvCapacity_HumanString(t *testing.T) {
+	in := &EnvCapacity{
+		Subnets: []*SubnetCapacity{
+			{ID: "subnet-1", Type: "public", AvailabilityZone: "us-west-2a", CIDRBlock: "10.0.0.0/28", TotalIPs: 11, AvailableIPs: 2},
+			{ID: "subnet-3", Type: "private", AvailabilityZone: "us-west-2a", CIDRBlock: "10.0.2.0/24", TotalIPs: 251, AvailableIPs: 200},
+		},
+		Services: []*ServiceCapacity{
+			{Name: "api", Type: "Load Balanced Web Service", Subnets: "public", RunningTasks: 2, MaxTasks: 10, ENIs: 2},
+			{Name: "worker", Type: "Worker Service", RunningTasks: 3, MaxTasks: 3, ENIs: 3},
+		},
+		OtherENIs: 55,
+		NATGateways: []*NATGatewayUsage{
+			{ID: "nat-1", BytesOut: 1e9, BytesIn: 2e9, PeakBytesPerSecond: 2e5, PortAllocationErrors: 3},
+		},
+		Headroom: []*SubnetsHeadroom{
+			{Subnets: "public", AvailableIPs: 2, ScaleOutIPs: 8, Headroom: -6},
+			{Subnets: "private", AvailableIPs: 200, Headroom: 200},
+		},
+	}
+
+	require.Equal(t, `Subnets
+
+  ID        Type      Availability Zone  CIDR Block   Available IPs
+  --        ----      -----------------  ----------   -------------
+  subnet-1  public    us-west-2a         10.0.0.0/28  2/11
+  subnet-3  private   us-west-2a         10.0.2.0/24  200/251
+
+Network Interfaces
+
+  Name    Type                       Subnets   Running Tasks  Max Tasks  ENIs
+  ----    ----                       -------   -------------  ---------  ----
+  api     Load Balanced Web Service  public    2              10         2
+  worker  Worker Service             -         3              3          3
+  Other   -                          -         -              -          55
+
+NAT Gateways (last 24 hours)
+
+  ID      Bytes Out  Bytes In  Peak Bandwidth  Port Allocation Errors
+  --      ---------  --------  --------------  ----------------------
+  nat-1   1.0 GB     2.0 GB    200 kB/s        3
+
+Headroom at Maximum Count
+
+  Subnets  Available IPs  Scale-out IPs  Headroom
+  -------  -------------  -------------  --------
+  public   2              8              -6
+  private  200            0              200
+
+Warnings
+
+  The public subnets would run out of IP addresses if the services scaled out to their maximum count.
+  NAT gateway nat-1 couldn't allocate a source port to 3 connections.
+`, in.HumanString())
+}
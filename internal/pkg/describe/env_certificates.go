@@ -0,0 +1,215 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	// Places of the environment where a certificate is used.
+	certUsedByPublicLB   = "Public load balancer"
+	certUsedByInternalLB = "Internal load balancer"
+	certUsedByCloudFront = "CloudFront"
+
+	// Logical IDs of the certificates that Copilot requests for the domain of the application.
+	envHTTPSCertLogicalID           = "HTTPSCert"
+	envReplicatedHTTPSCertLogicalID = "CertificateReplicator"
+
+	// Certificates that expire in less than this duration are reported.
+	certExpiryWarningThreshold = 30 * 24 * time.Hour
+	// Certificates that have been pending validation for longer than this duration are reported.
+	certPendingValidationWarningThreshold = time.Hour
+)
+
+// EnvCertificate describes an ACM certificate used by an environment.
+type EnvCertificate struct {
+	ARN       string     `json:"arn"`
+	UsedBy    []string   `json:"usedBy"`
+	Managed   bool       `json:"managed"` // True if Copilot requested the certificate for the domain of the application.
+	Domains   []string   `json:"domains"`
+	Status    string     `json:"status"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Warnings  []string   `json:"warnings,omitempty"`
+}
+
+// EnvCertificates contains the ACM certificates used by an environment.
+type EnvCertificates struct {
+	Certificates []*EnvCertificate `json:"certificates"`
+
+	now time.Time
+}
+
+// Certificates returns the ACM certificates used by the load balancers and the CloudFront distribution of the environment,
+// with warnings for the certificates that expire soon or are stuck in pending validation.
+func (d *EnvDescriber) Certificates() (*EnvCertificates, error) {
+	mft, err := d.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve manifest of environment %s: %w", d.env.Name, err)
+	}
+	env, err := manifest.UnmarshalEnvironment(mft)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := d.cfn.Resources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment resources: %w", err)
+	}
+
+	var certs []*EnvCertificate
+	byARN := make(map[string]*EnvCertificate)
+	add := func(arn, usedBy string, managed bool) {
+		if cert, ok := byARN[arn]; ok {
+			cert.UsedBy = append(cert.UsedBy, usedBy)
+			return
+		}
+		cert := &EnvCertificate{
+			ARN:     arn,
+			UsedBy:  []string{usedBy},
+			Managed: managed,
+		}
+		byARN[arn] = cert
+		certs = append(certs, cert)
+	}
+	for _, cert := range env.HTTPConfig.Public.Certificates {
+		add(cert, certUsedByPublicLB, false)
+	}
+	for _, cert := range env.HTTPConfig.Private.Certificates {
+		add(cert, certUsedByInternalLB, false)
+	}
+	if cert := env.CDNConfig.Config.Certificate; cert != nil {
+		add(*cert, certUsedByCloudFront, false)
+	}
+	for _, resource := range resources {
+		if !strings.HasPrefix(resource.PhysicalID, "arn:") {
+			continue
+		}
+		switch resource.LogicalID {
+		case envHTTPSCertLogicalID:
+			// The public listener only uses the certificate of the domain of the application if no certificate is imported.
+			if len(env.HTTPConfig.Public.Certificates) == 0 {
+				add(resource.PhysicalID, certUsedByPublicLB, true)
+			}
+		case envReplicatedHTTPSCertLogicalID:
+			add(resource.PhysicalID, certUsedByCloudFront, true)
+		}
+	}
+
+	now := d.now()
+	clients := make(map[string]certDescriber)
+	for _, cert := range certs {
+		parsed, err := arn.Parse(cert.ARN)
+		if err != nil {
+			return nil, fmt.Errorf("parse certificate ARN %s: %w", cert.ARN, err)
+		}
+		client, ok := clients[parsed.Region]
+		if !ok {
+			if client, err = d.newCertDescriber(parsed.Region); err != nil {
+				return nil, err
+			}
+			clients[parsed.Region] = client
+		}
+		out, err := client.Certificate(cert.ARN)
+		if err != nil {
+			return nil, err
+		}
+		cert.Domains = out.Domains
+		cert.Status = out.Status
+		if !out.NotAfter.IsZero() {
+			expiresAt := out.NotAfter
+			cert.ExpiresAt = &expiresAt
+		}
+		cert.Warnings = certWarnings(out.Status, out.CreatedAt, out.NotAfter, now)
+	}
+	return &EnvCertificates{
+		Certificates: certs,
+		now:          now,
+	}, nil
+}
+
+func certWarnings(status string, createdAt, notAfter, now time.Time) []string {
+	switch status {
+	case acm.CertificateStatusIssued:
+		if left := notAfter.Sub(now); left <= 0 {
+			return []string{"The certificate has expired."}
+		} else if left < certExpiryWarningThreshold {
+			return []string{fmt.Sprintf("The certificate expires in %s.", strings.TrimSpace(humanize.RelTime(now, notAfter, "", "")))}
+		}
+	case acm.CertificateStatusPendingValidation:
+		if !createdAt.IsZero() && now.Sub(createdAt) > certPendingValidationWarningThreshold {
+			return []string{fmt.Sprintf("The certificate has been pending validation for %s, check that the CNAME records of its domains are created.",
+				strings.TrimSpace(humanize.RelTime(createdAt, now, "", "")))}
+		}
+	default:
+		return []string{fmt.Sprintf("The status of the certificate is %s.", status)}
+	}
+	return nil
+}
+
+// JSONString returns the stringified EnvCertificates struct with json format.
+func (c *EnvCertificates) JSONString() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal environment certificates: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified EnvCertificates struct with human readable format.
+func (c *EnvCertificates) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Certificates\n\n"))
+	writer.Flush()
+	if len(c.Certificates) == 0 {
+		fmt.Fprintln(writer, "  The environment doesn't use any certificate.")
+		writer.Flush()
+		return b.String()
+	}
+	headers := []string{"ID", "Used By", "Domains", "Status", "Expires"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	var warnings []string
+	for _, cert := range c.Certificates {
+		expires := "-"
+		if cert.ExpiresAt != nil {
+			expires = humanize.RelTime(*cert.ExpiresAt, c.now, "ago", "from now")
+		}
+		id := certificateID(cert.ARN)
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", id, strings.Join(cert.UsedBy, ", "), strings.Join(cert.Domains, ", "), cert.Status, expires)
+		for _, warning := range cert.Warnings {
+			warnings = append(warnings, fmt.Sprintf("%s: %s", id, warning))
+		}
+	}
+	writer.Flush()
+	if len(warnings) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nWarnings\n\n"))
+		writer.Flush()
+		for _, warning := range warnings {
+			fmt.Fprintf(writer, "  %s\n", color.Yellow.Sprint(warning))
+		}
+		writer.Flush()
+	}
+	return b.String()
+}
+
+// certificateID returns the ID at the end of the ARN of a certificate.
+func certificateID(certARN string) string {
+	parsed, err := arn.Parse(certARN)
+	if err != nil {
+		return certARN
+	}
+	return strings.TrimPrefix(parsed.Resource, "certificate/")
+}
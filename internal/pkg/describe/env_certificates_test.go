@@ -0,0 +1,278 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDescriber_Certificates(t *testing.T) {
+	const (
+		importedCert = "arn:aws:acm:us-west-2:123456789012:certificate/imported"
+		privateCert  = "arn:aws:acm:us-west-2:123456789012:certificate/private"
+		cdnCert      = "arn:aws:acm:us-east-1:123456789012:certificate/cdn"
+		managedCert  = "arn:aws:acm:us-west-2:123456789012:certificate/managed"
+		replicaCert  = "arn:aws:acm:us-east-1:123456789012:certificate/replica"
+	)
+	now := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	metadata := func(mft string) string {
+		return fmt.Sprintf(`{"Version":"1.20.0","Manifest":%q}`, mft)
+	}
+	testCases := map[string]struct {
+		setupMocks func(cfn *mocks.MockstackDescriber, usWest2, usEast1 *mocks.MockcertDescriber)
+
+		wanted    []*EnvCertificate
+		wantedErr error
+	}{
+		"error if fail to retrieve the manifest": {
+			setupMocks: func(cfn *mocks.MockstackDescriber, _, _ *mocks.MockcertDescriber) {
+				cfn.EXPECT().StackMetadata().Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve manifest of environment test: some error"),
+		},
+		"error if fail to retrieve the resources": {
+			setupMocks: func(cfn *mocks.MockstackDescriber, _, _ *mocks.MockcertDescriber) {
+				cfn.EXPECT().StackMetadata().Return(metadata("name: test\ntype: Environment\n"), nil)
+				cfn.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve environment resources: some error"),
+		},
+		"error if fail to describe a certificate": {
+			setupMocks: func(cfn *mocks.MockstackDescriber, usWest2, _ *mocks.MockcertDescriber) {
+				cfn.EXPECT().StackMetadata().Return(metadata("name: test\ntype: Environment\n"), nil)
+				cfn.EXPECT().Resources().Return([]*stack.Resource{
+					{LogicalID: "HTTPSCert", PhysicalID: managedCert},
+				}, nil)
+				usWest2.EXPECT().Certificate(managedCert).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"no certificates": {
+			setupMocks: func(cfn *mocks.MockstackDescriber, _, _ *mocks.MockcertDescriber) {
+				cfn.EXPECT().StackMetadata().Return(metadata("name: test\ntype: Environment\n"), nil)
+				cfn.EXPECT().Resources().Return([]*stack.Resource{
+					{LogicalID: "PublicLoadBalancer", PhysicalID: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb/1"},
+				}, nil)
+			},
+		},
+		"managed certificates of the domain of the application": {
+			setupMocks: func(cfn *mocks.MockstackDescriber, usWest2, usEast1 *mocks.MockcertDescriber) {
+				cfn.EXPECT().StackMetadata().Return(metadata("name: test\ntype: Environment\n"), nil)
+				cfn.EXPECT().Resources().Return([]*stack.Resource{
+					{LogicalID: "HTTPSCert", PhysicalID: managedCert},
+					{LogicalID: "CertificateReplicator", PhysicalID: replicaCert},
+				}, nil)
+				usWest2.EXPECT().Certificate(managedCert).Return(&acm.Certificate{
+					Domains:   []string{"test.my-app.example.com", "*.test.my-app.example.com"},
+					Status:    "ISSUED",
+					CreatedAt: now.AddDate(-1, 0, 0),
+					NotAfter:  now.AddDate(0, 6, 0),
+				}, nil)
+				usEast1.EXPECT().Certificate(replicaCert).Return(&acm.Certificate{
+					Domains:   []string{"test.my-app.example.com"},
+					Status:    "PENDING_VALIDATION",
+					CreatedAt: now.Add(-2 * time.Hour),
+				}, nil)
+			},
+			wanted: []*EnvCertificate{
+				{
+					ARN:       managedCert,
+					UsedBy:    []string{"Public load balancer"},
+					Managed:   true,
+					Domains:   []string{"test.my-app.example.com", "*.test.my-app.example.com"},
+					Status:    "ISSUED",
+					ExpiresAt: timePtr(now.AddDate(0, 6, 0)),
+				},
+				{
+					ARN:      replicaCert,
+					UsedBy:   []string{"CloudFront"},
+					Managed:  true,
+					Domains:  []string{"test.my-app.example.com"},
+					Status:   "PENDING_VALIDATION",
+					Warnings: []string{"The certificate has been pending validation for 2 hours, check that the CNAME records of its domains are created."},
+				},
+			},
+		},
+		"imported certificates": {
+			setupMocks: func(cfn *mocks.MockstackDescriber, usWest2, usEast1 *mocks.MockcertDescriber) {
+				cfn.EXPECT().StackMetadata().Return(metadata(fmt.Sprintf(`name: test
+type: Environment
+http:
+  public:
+    certificates: [%s]
+  private:
+    certificates: [%s, %s]
+cdn:
+  certificate: %s
+`, importedCert, privateCert, importedCert, cdnCert)), nil)
+				cfn.EXPECT().Resources().Return([]*stack.Resource{
+					{LogicalID: "HTTPSCert", PhysicalID: managedCert},
+				}, nil)
+				usWest2.EXPECT().Certificate(importedCert).Return(&acm.Certificate{
+					Domains:  []string{"example.com"},
+					Status:   "ISSUED",
+					NotAfter: now.AddDate(0, 0, 10),
+				}, nil)
+				usWest2.EXPECT().Certificate(privateCert).Return(&acm.Certificate{
+					Domains:  []string{"internal.example.com"},
+					Status:   "EXPIRED",
+					NotAfter: now.AddDate(0, 0, -1),
+				}, nil)
+				usEast1.EXPECT().Certificate(cdnCert).Return(&acm.Certificate{
+					Domains:  []string{"cdn.example.com"},
+					Status:   "ISSUED",
+					NotAfter: now.AddDate(1, 0, 0),
+				}, nil)
+			},
+			wanted: []*EnvCertificate{
+				{
+					ARN:       importedCert,
+					UsedBy:    []string{"Public load balancer", "Internal load balancer"},
+					Domains:   []string{"example.com"},
+					Status:    "ISSUED",
+					ExpiresAt: timePtr(now.AddDate(0, 0, 10)),
+					Warnings:  []string{"The certificate expires in 1 week."},
+				},
+				{
+					ARN:       privateCert,
+					UsedBy:    []string{"Internal load balancer"},
+					Domains:   []string{"internal.example.com"},
+					Status:    "EXPIRED",
+					ExpiresAt: timePtr(now.AddDate(0, 0, -1)),
+					Warnings:  []string{"The status of the certificate is EXPIRED."},
+				},
+				{
+					ARN:       cdnCert,
+					UsedBy:    []string{"CloudFront"},
+					Domains:   []string{"cdn.example.com"},
+					Status:    "ISSUED",
+					ExpiresAt: timePtr(now.AddDate(1, 0, 0)),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cfn := mocks.NewMockstackDescriber(ctrl)
+			usWest2 := mocks.NewMockcertDescriber(ctrl)
+			usEast1 := mocks.NewMockcertDescriber(ctrl)
+			tc.setupMocks(cfn, usWest2, usEast1)
+			d := &EnvDescriber{
+				app: "my-app",
+				env: &config.Environment{Name: "test", Region: "us-west-2"},
+				cfn: cfn,
+				newCertDescriber: func(region string) (certDescriber, error) {
+					if region == "us-east-1" {
+						return usEast1, nil
+					}
+					return usWest2, nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			// WHEN
+			got, err := d.Certificates()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got.Certificates)
+		})
+	}
+}
+
+func TestEnvCertificates_HumanString(t *testing.T) {
+	now := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		in     *EnvCertificates
+		wanted string
+	}{
+		"no certificates": {
+			in: &EnvCertificates{now: now},
+			wanted: `Certificates
+
+  The environment doesn't use any certificate.
+`,
+		},
+		"certificates with warnings": {
+			in: &EnvCertificates{
+				Certificates: []*EnvCertificate{
+					{
+						ARN:       "arn:aws:acm:us-west-2:123456789012:certificate/imported",
+						UsedBy:    []string{"Public load balancer", "Internal load balancer"},
+						Domains:   []string{"example.com", "*.example.com"},
+						Status:    "ISSUED",
+						ExpiresAt: timePtr(now.AddDate(0, 0, 10)),
+						Warnings:  []string{"The certificate expires in 1 week."},
+					},
+					{
+						ARN:     "arn:aws:acm:us-east-1:123456789012:certificate/replica",
+						UsedBy:  []string{"CloudFront"},
+						Managed: true,
+						Domains: []string{"example.com"},
+						Status:  "PENDING_VALIDATION",
+					},
+				},
+				now: now,
+			},
+			wanted: `Certificates
+
+  ID        Used By                                       Domains                     Status              Expires
+  --        -------                                       -------                     ------              -------
+  imported  Public load balancer, Internal load balancer  example.com, *.example.com  ISSUED              1 week from now
+  replica   CloudFront                                    example.com                 PENDING_VALIDATION  -
+
+Warnings
+
+  imported: The certificate expires in 1 week.
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HumanString())
+		})
+	}
+}
+
+func TestEnvCertificates_JSONString(t *testing.T) {
+	expiresAt := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	in := &EnvCertificates{
+		Certificates: []*EnvCertificate{
+			{
+				ARN:       "arn:aws:acm:us-west-2:123456789012:certificate/imported",
+				UsedBy:    []string{"Public load balancer"},
+				Domains:   []string{"example.com"},
+				Status:    "ISSUED",
+				ExpiresAt: &expiresAt,
+			},
+		},
+	}
+
+	got, err := in.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"certificates":[{"arn":"arn:aws:acm:us-west-2:123456789012:certificate/imported","usedBy":["Public load balancer"],"managed":false,"domains":["example.com"],"status":"ISSUED","expiresAt":"2023-06-01T00:00:00Z"}]}`+"\n", got)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
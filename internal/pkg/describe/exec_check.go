@@ -0,0 +1,424 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Statuses of an ExecCheck.
+const (
+	ExecCheckPassed  = "passed"
+	ExecCheckFailed  = "failed"
+	ExecCheckSkipped = "skipped"
+)
+
+const (
+	execCommandAgentName          = "ExecuteCommandAgent"
+	managedAgentStatusRunning     = "RUNNING"
+	minExecFargatePlatformVersion = "1.4.0"
+	fargateLaunchType             = "FARGATE"
+	execLoggingNone               = "NONE"
+	execLoggingOverride           = "OVERRIDE"
+)
+
+// Names of the checks of an ExecDiagnosis.
+const (
+	execCheckNameEnabled           = "Exec enabled"
+	execCheckNameRunningTasks      = "Running tasks"
+	execCheckNamePlatformVersion   = "Platform version"
+	execCheckNameAgent             = "Exec agent"
+	execCheckNameTaskRole          = "Task role"
+	execCheckNameSessionEncryption = "Session encryption"
+	execCheckNameSessionLogs       = "Session logs"
+)
+
+var (
+	execSSMMessagesActions = []string{
+		"ssmmessages:CreateControlChannel",
+		"ssmmessages:OpenControlChannel",
+		"ssmmessages:CreateDataChannel",
+		"ssmmessages:OpenDataChannel",
+	}
+	execLogsActions = []string{
+		"logs:CreateLogStream",
+		"logs:DescribeLogGroups",
+		"logs:DescribeLogStreams",
+		"logs:PutLogEvents",
+	}
+)
+
+type execCheckerECSClient interface {
+	Service(app, env, svc string) (*awsecs.Service, error)
+	TaskDefinition(app, env, svc string) (*awsecs.TaskDefinition, error)
+	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
+	ClusterExecConfig(app, env string) (*awsecs.ExecConfig, error)
+}
+
+type policySimulator interface {
+	DeniedActions(in iam.SimulatePolicyInput) ([]string, error)
+}
+
+// ExecCheck is the result of checking one of the requirements to execute commands in the containers of a service.
+type ExecCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+	Remedy string `json:"remedy,omitempty"`
+}
+
+// ExecDiagnosis holds the checks of the requirements to execute commands in the containers of a service.
+type ExecDiagnosis struct {
+	Service     string       `json:"service"`
+	Environment string       `json:"environment"`
+	Checks      []*ExecCheck `json:"checks"`
+}
+
+// ExecChecker diagnoses why commands can't be executed in the containers of a service with ECS Exec.
+type ExecChecker struct {
+	app string
+	env string
+	svc string
+
+	ecsClient execCheckerECSClient
+	iamClient policySimulator
+}
+
+// NewExecChecker instantiates a new ExecChecker.
+func NewExecChecker(opt NewServiceConfig) (*ExecChecker, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &ExecChecker{
+		app:       opt.App,
+		env:       opt.Env,
+		svc:       opt.Svc,
+		ecsClient: ecs.New(sess),
+		iamClient: iam.New(sess),
+	}, nil
+}
+
+// Check returns whether the deployed service, its tasks, its task role and its cluster meet the requirements of ECS Exec.
+func (c *ExecChecker) Check() (*ExecDiagnosis, error) {
+	svc, err := c.ecsClient.Service(c.app, c.env, c.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service of %s: %w", c.svc, err)
+	}
+	desc, err := c.ecsClient.DescribeService(c.app, c.env, c.svc)
+	if err != nil {
+		return nil, fmt.Errorf("describe ECS service of %s: %w", c.svc, err)
+	}
+	taskDef, err := c.ecsClient.TaskDefinition(c.app, c.env, c.svc)
+	if err != nil {
+		return nil, err
+	}
+	execCfg, err := c.ecsClient.ClusterExecConfig(c.app, c.env)
+	if err != nil {
+		return nil, err
+	}
+	svcARN, err := arn.Parse(aws.StringValue(svc.ServiceArn))
+	if err != nil {
+		return nil, fmt.Errorf("parse ECS service ARN %s: %w", aws.StringValue(svc.ServiceArn), err)
+	}
+	enabled := aws.BoolValue(svc.EnableExecuteCommand)
+	tasks := awsecs.FilterRunningTasks(desc.Tasks)
+	roleARN := aws.StringValue(taskDef.TaskRoleArn)
+	return &ExecDiagnosis{
+		Service:     c.svc,
+		Environment: c.env,
+		Checks: []*ExecCheck{
+			c.checkEnabled(enabled),
+			c.checkRunningTasks(tasks),
+			checkPlatformVersion(tasks),
+			checkAgent(enabled, tasks),
+			c.checkTaskRole(roleARN),
+			c.checkSessionEncryption(roleARN, execCfg, svcARN),
+			c.checkSessionLogs(roleARN, execCfg, svcARN),
+		},
+	}, nil
+}
+
+func (c *ExecChecker) checkEnabled(enabled bool) *ExecCheck {
+	if enabled {
+		return passedExecCheck(execCheckNameEnabled, "The service allows executing commands in its tasks.")
+	}
+	return &ExecCheck{
+		Name:   execCheckNameEnabled,
+		Status: ExecCheckFailed,
+		Detail: "The service doesn't allow executing commands in its tasks.",
+		Remedy: fmt.Sprintf(`Set "exec: true" in the manifest of %s and run "copilot svc deploy".`, c.svc),
+	}
+}
+
+func (c *ExecChecker) checkRunningTasks(tasks []*awsecs.Task) *ExecCheck {
+	if len(tasks) > 0 {
+		return passedExecCheck(execCheckNameRunningTasks, fmt.Sprintf("%d tasks are running.", len(tasks)))
+	}
+	return &ExecCheck{
+		Name:   execCheckNameRunningTasks,
+		Status: ExecCheckFailed,
+		Detail: "No task of the service is running.",
+		Remedy: fmt.Sprintf(`Run "copilot svc status -n %s -e %s" to find out why the tasks stopped.`, c.svc, c.env),
+	}
+}
+
+func checkPlatformVersion(tasks []*awsecs.Task) *ExecCheck {
+	if len(tasks) == 0 {
+		return skippedExecCheck(execCheckNamePlatformVersion, "No task is running.")
+	}
+	var outdated []string
+	for _, task := range tasks {
+		if aws.StringValue(task.LaunchType) != fargateLaunchType {
+			continue
+		}
+		if version := aws.StringValue(task.PlatformVersion); version != "" && olderVersion(version, minExecFargatePlatformVersion) {
+			outdated = append(outdated, fmt.Sprintf("%s (%s)", execTaskID(task), version))
+		}
+	}
+	if len(outdated) == 0 {
+		return passedExecCheck(execCheckNamePlatformVersion, fmt.Sprintf("The tasks run on EC2 or on Fargate platform version %s or later.", minExecFargatePlatformVersion))
+	}
+	return &ExecCheck{
+		Name:   execCheckNamePlatformVersion,
+		Status: ExecCheckFailed,
+		Detail: fmt.Sprintf("Tasks %s run on a Fargate platform version older than %s.", strings.Join(outdated, ", "), minExecFargatePlatformVersion),
+		Remedy: `Run "copilot svc deploy --force" to replace the tasks with tasks on the latest platform version.`,
+	}
+}
+
+func checkAgent(enabled bool, tasks []*awsecs.Task) *ExecCheck {
+	if !enabled || len(tasks) == 0 {
+		return skippedExecCheck(execCheckNameAgent, "Exec isn't enabled or no task is running.")
+	}
+	var stopped []string
+	for _, task := range tasks {
+		for _, container := range task.Containers {
+			status := "MISSING"
+			for _, agent := range container.ManagedAgents {
+				if aws.StringValue(agent.Name) == execCommandAgentName {
+					status = aws.StringValue(agent.LastStatus)
+				}
+			}
+			if status != managedAgentStatusRunning {
+				stopped = append(stopped, fmt.Sprintf("%s/%s (%s)", execTaskID(task), aws.StringValue(container.Name), status))
+			}
+		}
+	}
+	if len(stopped) == 0 {
+		return passedExecCheck(execCheckNameAgent, "The agent is running in every container.")
+	}
+	return &ExecCheck{
+		Name:   execCheckNameAgent,
+		Status: ExecCheckFailed,
+		Detail: fmt.Sprintf("The agent isn't running in containers %s.", strings.Join(stopped, ", ")),
+		Remedy: `Tasks started before exec was enabled don't run the agent. Run "copilot svc deploy --force" to replace them.`,
+	}
+}
+
+func (c *ExecChecker) checkTaskRole(roleARN string) *ExecCheck {
+	if roleARN == "" {
+		return &ExecCheck{
+			Name:   execCheckNameTaskRole,
+			Status: ExecCheckFailed,
+			Detail: "The task definition of the service doesn't have a task role.",
+			Remedy: fmt.Sprintf(`Set "exec: true" in the manifest of %s and run "copilot svc deploy".`, c.svc),
+		}
+	}
+	denied, err := c.iamClient.DeniedActions(iam.SimulatePolicyInput{
+		PrincipalARN: roleARN,
+		Actions:      execSSMMessagesActions,
+	})
+	if err != nil {
+		return skippedExecCheck(execCheckNameTaskRole, fmt.Sprintf("Couldn't simulate the policies of the task role: %v.", err))
+	}
+	if len(denied) == 0 {
+		return passedExecCheck(execCheckNameTaskRole, "The task role can open the channels of the sessions.")
+	}
+	return &ExecCheck{
+		Name:   execCheckNameTaskRole,
+		Status: ExecCheckFailed,
+		Detail: fmt.Sprintf("The task role isn't allowed to call %s.", strings.Join(denied, ", ")),
+		Remedy: "Remove the statements that deny these actions from the permissions boundary or the addons of the service.",
+	}
+}
+
+func (c *ExecChecker) checkSessionEncryption(roleARN string, cfg *awsecs.ExecConfig, svcARN arn.ARN) *ExecCheck {
+	if cfg.KMSKeyID == "" {
+		return passedExecCheck(execCheckNameSessionEncryption, "The sessions aren't encrypted with a KMS key.")
+	}
+	if roleARN == "" {
+		return skippedExecCheck(execCheckNameSessionEncryption, "The service doesn't have a task role.")
+	}
+	keyARN := cfg.KMSKeyID
+	if !arn.IsARN(keyARN) {
+		keyARN = arn.ARN{
+			Partition: svcARN.Partition,
+			Service:   "kms",
+			Region:    svcARN.Region,
+			AccountID: svcARN.AccountID,
+			Resource:  "key/" + cfg.KMSKeyID,
+		}.String()
+	}
+	denied, err := c.iamClient.DeniedActions(iam.SimulatePolicyInput{
+		PrincipalARN: roleARN,
+		Actions:      []string{"kms:Decrypt"},
+		ResourceARN:  keyARN,
+		ResourceTags: map[string]string{
+			deploy.AppTagKey: c.app,
+			deploy.EnvTagKey: c.env,
+		},
+	})
+	if err != nil {
+		return skippedExecCheck(execCheckNameSessionEncryption, fmt.Sprintf("Couldn't simulate the policies of the task role: %v.", err))
+	}
+	if len(denied) == 0 {
+		return passedExecCheck(execCheckNameSessionEncryption, fmt.Sprintf("The task role can decrypt the sessions with key %s.", keyARN))
+	}
+	return &ExecCheck{
+		Name:   execCheckNameSessionEncryption,
+		Status: ExecCheckFailed,
+		Detail: fmt.Sprintf("The task role isn't allowed to decrypt the sessions with key %s.", keyARN),
+		Remedy: fmt.Sprintf(`Allow the task role to call "kms:Decrypt" on the key, or redeploy %s with the latest version of Copilot.`, c.svc),
+	}
+}
+
+func (c *ExecChecker) checkSessionLogs(roleARN string, cfg *awsecs.ExecConfig, svcARN arn.ARN) *ExecCheck {
+	switch {
+	case cfg.Logging == execLoggingNone:
+		return passedExecCheck(execCheckNameSessionLogs, "The output of the sessions isn't logged.")
+	case cfg.Logging != execLoggingOverride || cfg.LogGroupName == "":
+		return passedExecCheck(execCheckNameSessionLogs, "The output of the sessions is logged with the log configuration of the containers.")
+	case roleARN == "":
+		return skippedExecCheck(execCheckNameSessionLogs, "The service doesn't have a task role.")
+	}
+	logGroupARN := arn.ARN{
+		Partition: svcARN.Partition,
+		Service:   "logs",
+		Region:    svcARN.Region,
+		AccountID: svcARN.AccountID,
+		Resource:  fmt.Sprintf("log-group:%s:*", cfg.LogGroupName),
+	}.String()
+	denied, err := c.iamClient.DeniedActions(iam.SimulatePolicyInput{
+		PrincipalARN: roleARN,
+		Actions:      execLogsActions,
+		ResourceARN:  logGroupARN,
+	})
+	if err != nil {
+		return skippedExecCheck(execCheckNameSessionLogs, fmt.Sprintf("Couldn't simulate the policies of the task role: %v.", err))
+	}
+	if len(denied) == 0 {
+		return passedExecCheck(execCheckNameSessionLogs, fmt.Sprintf("The task role can write the output of the sessions to log group %s.", cfg.LogGroupName))
+	}
+	return &ExecCheck{
+		Name:   execCheckNameSessionLogs,
+		Status: ExecCheckFailed,
+		Detail: fmt.Sprintf("The task role isn't allowed to call %s on log group %s.", strings.Join(denied, ", "), cfg.LogGroupName),
+		Remedy: "Remove the statements that deny these actions from the permissions boundary or the addons of the service.",
+	}
+}
+
+// Failed returns true if any of the checks failed.
+func (d *ExecDiagnosis) Failed() bool {
+	for _, check := range d.Checks {
+		if check.Status == ExecCheckFailed {
+			return true
+		}
+	}
+	return false
+}
+
+// JSONString returns the stringified ExecDiagnosis struct with json format.
+func (d *ExecDiagnosis) JSONString() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("marshal exec diagnosis: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ExecDiagnosis struct with human readable format.
+func (d *ExecDiagnosis) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Exec Checks\n\n"))
+	writer.Flush()
+	headers := []string{"Check", "Status", "Detail"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, check := range d.Checks {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", check.Name, check.Status, check.Detail)
+	}
+	writer.Flush()
+	if !d.Failed() {
+		return b.String()
+	}
+	fmt.Fprint(writer, color.Bold.Sprint("\nRemedies\n\n"))
+	writer.Flush()
+	for _, check := range d.Checks {
+		if check.Status == ExecCheckFailed && check.Remedy != "" {
+			fmt.Fprintf(writer, "  - %s: %s\n", check.Name, check.Remedy)
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func passedExecCheck(name, detail string) *ExecCheck {
+	return &ExecCheck{
+		Name:   name,
+		Status: ExecCheckPassed,
+		Detail: detail,
+	}
+}
+
+func skippedExecCheck(name, detail string) *ExecCheck {
+	return &ExecCheck{
+		Name:   name,
+		Status: ExecCheckSkipped,
+		Detail: detail,
+	}
+}
+
+func execTaskID(task *awsecs.Task) string {
+	id, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return aws.StringValue(task.TaskArn)
+	}
+	return shortTaskID(id)
+}
+
+// olderVersion returns true if the dotted version is older than the other dotted version.
+func olderVersion(version, other string) bool {
+	parts, otherParts := strings.Split(version, "."), strings.Split(other, ".")
+	for i := 0; i < len(otherParts); i++ {
+		var n int
+		if i < len(parts) {
+			n, _ = strconv.Atoi(parts[i])
+		}
+		m, _ := strconv.Atoi(otherParts[i])
+		if n != m {
+			return n < m
+		}
+	}
+	return false
+}
@@ -0,0 +1,290 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type execCheckerMocks struct {
+	ecs *mocks.MockexecCheckerECSClient
+	iam *mocks.MockpolicySimulator
+}
+
+func TestExecChecker_Check(t *testing.T) {
+	const (
+		mockSvcARN  = "arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-api-Service"
+		mockRoleARN = "arn:aws:iam::123456789012:role/phonetool-test-api-TaskRole"
+	)
+	runningTask := func(id, version, agentStatus string) *awsecs.Task {
+		return &awsecs.Task{
+			TaskArn:         aws.String("arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/" + id),
+			LastStatus:      aws.String("RUNNING"),
+			LaunchType:      aws.String("FARGATE"),
+			PlatformVersion: aws.String(version),
+			Containers: []*ecsapi.Container{
+				{
+					Name: aws.String("api"),
+					ManagedAgents: []*ecsapi.ManagedAgent{
+						{Name: aws.String("ExecuteCommandAgent"), LastStatus: aws.String(agentStatus)},
+					},
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m execCheckerMocks)
+
+		wanted    []*ExecCheck
+		wantedErr error
+	}{
+		"error if fail to get the ECS service": {
+			setupMocks: func(m execCheckerMocks) {
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get ECS service of api: some error"),
+		},
+		"error if fail to get the exec configuration of the cluster": {
+			setupMocks: func(m execCheckerMocks) {
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(&awsecs.Service{}, nil)
+				m.ecs.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{}, nil)
+				m.ecs.EXPECT().TaskDefinition("phonetool", "test", "api").Return(&awsecs.TaskDefinition{}, nil)
+				m.ecs.EXPECT().ClusterExecConfig("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"passes every check with encrypted and logged sessions": {
+			setupMocks: func(m execCheckerMocks) {
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(&awsecs.Service{
+					ServiceArn:           aws.String(mockSvcARN),
+					EnableExecuteCommand: aws.Bool(true),
+				}, nil)
+				m.ecs.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{runningTask("4082490ee6c245e09d2145010aa1ba8d", "1.4.0", "RUNNING")},
+				}, nil)
+				m.ecs.EXPECT().TaskDefinition("phonetool", "test", "api").Return(&awsecs.TaskDefinition{
+					TaskRoleArn: aws.String(mockRoleARN),
+				}, nil)
+				m.ecs.EXPECT().ClusterExecConfig("phonetool", "test").Return(&awsecs.ExecConfig{
+					KMSKeyID:     "1234",
+					Logging:      "OVERRIDE",
+					LogGroupName: "/copilot/phonetool-test-exec-sessions",
+				}, nil)
+				m.iam.EXPECT().DeniedActions(iam.SimulatePolicyInput{
+					PrincipalARN: mockRoleARN,
+					Actions:      execSSMMessagesActions,
+				}).Return(nil, nil)
+				m.iam.EXPECT().DeniedActions(iam.SimulatePolicyInput{
+					PrincipalARN: mockRoleARN,
+					Actions:      []string{"kms:Decrypt"},
+					ResourceARN:  "arn:aws:kms:us-west-2:123456789012:key/1234",
+					ResourceTags: map[string]string{
+						"copilot-application": "phonetool",
+						"copilot-environment": "test",
+					},
+				}).Return(nil, nil)
+				m.iam.EXPECT().DeniedActions(iam.SimulatePolicyInput{
+					PrincipalARN: mockRoleARN,
+					Actions:      execLogsActions,
+					ResourceARN:  "arn:aws:logs:us-west-2:123456789012:log-group:/copilot/phonetool-test-exec-sessions:*",
+				}).Return(nil, nil)
+			},
+			wanted: []*ExecCheck{
+				{Name: "Exec enabled", Status: "passed", Detail: "The service allows executing commands in its tasks."},
+				{Name: "Running tasks", Status: "passed", Detail: "1 tasks are running."},
+				{Name: "Platform version", Status: "passed", Detail: "The tasks run on EC2 or on Fargate platform version 1.4.0 or later."},
+				{Name: "Exec agent", Status: "passed", Detail: "The agent is running in every container."},
+				{Name: "Task role", Status: "passed", Detail: "The task role can open the channels of the sessions."},
+				{Name: "Session encryption", Status: "passed", Detail: "The task role can decrypt the sessions with key arn:aws:kms:us-west-2:123456789012:key/1234."},
+				{Name: "Session logs", Status: "passed", Detail: "The task role can write the output of the sessions to log group /copilot/phonetool-test-exec-sessions."},
+			},
+		},
+		"fails the checks of the tasks and the task role": {
+			setupMocks: func(m execCheckerMocks) {
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(&awsecs.Service{
+					ServiceArn:           aws.String(mockSvcARN),
+					EnableExecuteCommand: aws.Bool(true),
+				}, nil)
+				m.ecs.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{
+						runningTask("4082490ee6c245e09d2145010aa1ba8d", "1.3.0", "STOPPED"),
+						runningTask("8c38184a9b7d43f3b8e7d3e1c5e5a2b1", "1.4.0", "RUNNING"),
+					},
+				}, nil)
+				m.ecs.EXPECT().TaskDefinition("phonetool", "test", "api").Return(&awsecs.TaskDefinition{
+					TaskRoleArn: aws.String(mockRoleARN),
+				}, nil)
+				m.ecs.EXPECT().ClusterExecConfig("phonetool", "test").Return(&awsecs.ExecConfig{
+					Logging: "DEFAULT",
+				}, nil)
+				m.iam.EXPECT().DeniedActions(gomock.Any()).Return([]string{"ssmmessages:CreateDataChannel", "ssmmessages:OpenDataChannel"}, nil)
+			},
+			wanted: []*ExecCheck{
+				{Name: "Exec enabled", Status: "passed", Detail: "The service allows executing commands in its tasks."},
+				{Name: "Running tasks", Status: "passed", Detail: "2 tasks are running."},
+				{
+					Name:   "Platform version",
+					Status: "failed",
+					Detail: "Tasks 4082490e (1.3.0) run on a Fargate platform version older than 1.4.0.",
+					Remedy: `Run "copilot svc deploy --force" to replace the tasks with tasks on the latest platform version.`,
+				},
+				{
+					Name:   "Exec agent",
+					Status: "failed",
+					Detail: "The agent isn't running in containers 4082490e/api (STOPPED).",
+					Remedy: `Tasks started before exec was enabled don't run the agent. Run "copilot svc deploy --force" to replace them.`,
+				},
+				{
+					Name:   "Task role",
+					Status: "failed",
+					Detail: "The task role isn't allowed to call ssmmessages:CreateDataChannel, ssmmessages:OpenDataChannel.",
+					Remedy: "Remove the statements that deny these actions from the permissions boundary or the addons of the service.",
+				},
+				{Name: "Session encryption", Status: "passed", Detail: "The sessions aren't encrypted with a KMS key."},
+				{Name: "Session logs", Status: "passed", Detail: "The output of the sessions is logged with the log configuration of the containers."},
+			},
+		},
+		"fails if exec is disabled and skips the checks that depend on it": {
+			setupMocks: func(m execCheckerMocks) {
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(&awsecs.Service{
+					ServiceArn: aws.String(mockSvcARN),
+				}, nil)
+				m.ecs.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{}, nil)
+				m.ecs.EXPECT().TaskDefinition("phonetool", "test", "api").Return(&awsecs.TaskDefinition{}, nil)
+				m.ecs.EXPECT().ClusterExecConfig("phonetool", "test").Return(&awsecs.ExecConfig{
+					KMSKeyID: "arn:aws:kms:us-west-2:123456789012:key/1234",
+					Logging:  "NONE",
+				}, nil)
+			},
+			wanted: []*ExecCheck{
+				{
+					Name:   "Exec enabled",
+					Status: "failed",
+					Detail: "The service doesn't allow executing commands in its tasks.",
+					Remedy: `Set "exec: true" in the manifest of api and run "copilot svc deploy".`,
+				},
+				{
+					Name:   "Running tasks",
+					Status: "failed",
+					Detail: "No task of the service is running.",
+					Remedy: `Run "copilot svc status -n api -e test" to find out why the tasks stopped.`,
+				},
+				{Name: "Platform version", Status: "skipped", Detail: "No task is running."},
+				{Name: "Exec agent", Status: "skipped", Detail: "Exec isn't enabled or no task is running."},
+				{
+					Name:   "Task role",
+					Status: "failed",
+					Detail: "The task definition of the service doesn't have a task role.",
+					Remedy: `Set "exec: true" in the manifest of api and run "copilot svc deploy".`,
+				},
+				{Name: "Session encryption", Status: "skipped", Detail: "The service doesn't have a task role."},
+				{Name: "Session logs", Status: "passed", Detail: "The output of the sessions isn't logged."},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := execCheckerMocks{
+				ecs: mocks.NewMockexecCheckerECSClient(ctrl),
+				iam: mocks.NewMockpolicySimulator(ctrl),
+			}
+			tc.setupMocks(m)
+			checker := &ExecChecker{
+				app:       "phonetool",
+				env:       "test",
+				svc:       "api",
+				ecsClient: m.ecs,
+				iamClient: m.iam,
+			}
+
+			got, err := checker.Check()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "api", got.Service)
+			require.Equal(t, "test", got.Environment)
+			require.Equal(t, tc.wanted, got.Checks)
+		})
+	}
+}
+
+func TestExecDiagnosis_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		in     *ExecDiagnosis
+		wanted string
+	}{
+		"every check passed": {
+			in: &ExecDiagnosis{
+				Service:     "api",
+				Environment: "test",
+				Checks: []*ExecCheck{
+					{Name: "Exec enabled", Status: "passed", Detail: "The service allows executing commands in its tasks."},
+				},
+			},
+			wanted: `Exec Checks
+
+  Check         Status    Detail
+  -----         ------    ------
+  Exec enabled  passed    The service allows executing commands in its tasks.
+`,
+		},
+		"lists the remedies of the failed checks": {
+			in: &ExecDiagnosis{
+				Service:     "api",
+				Environment: "test",
+				Checks: []*ExecCheck{
+					{Name: "Exec enabled", Status: "passed", Detail: "The service allows executing commands in its tasks."},
+					{Name: "Running tasks", Status: "failed", Detail: "No task of the service is running.", Remedy: "Deploy the service."},
+				},
+			},
+			wanted: `Exec Checks
+
+  Check          Status    Detail
+  -----          ------    ------
+  Exec enabled   passed    The service allows executing commands in its tasks.
+  Running tasks  failed    No task of the service is running.
+
+Remedies
+
+  - Running tasks: Deploy the service.
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HumanString())
+		})
+	}
+}
+
+func TestExecDiagnosis_JSONString(t *testing.T) {
+	diagnosis := &ExecDiagnosis{
+		Service:     "api",
+		Environment: "test",
+		Checks: []*ExecCheck{
+			{Name: "Running tasks", Status: "failed", Detail: "No task of the service is running.", Remedy: "Deploy the service."},
+		},
+	}
+
+	got, err := diagnosis.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"service":"api","environment":"test","checks":[{"name":"Running tasks","status":"failed","detail":"No task of the service is running.","remedy":"Deploy the service."}]}
+`, got)
+}
@@ -103,7 +103,7 @@ func runningCapacityProvidersBreakDownByCount(tasks []ecs.TaskStatus) (fargate,
 	return
 }
 
-func (s *ecsServiceStatus) tasksOfRevision(revision int) []ecs.TaskStatus {
+func (s *ECSServiceStatus) tasksOfRevision(revision int) []ecs.TaskStatus {
 	var ret []ecs.TaskStatus
 	for _, t := range s.DesiredRunningTasks {
 		taskRevision, err := ecs.TaskDefinitionVersion(t.TaskDefinition)
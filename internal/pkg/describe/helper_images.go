@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Sources of the location of a helper image.
+const (
+	HelperImageSourceDefault     = "default"
+	HelperImageSourceApplication = "application"
+)
+
+// HelperImage contains the location of an image that Copilot injects into the tasks of the workloads of an application.
+type HelperImage struct {
+	Name       string `json:"name"`
+	Image      string `json:"image"`
+	Source     string `json:"source"`
+	InjectedBy string `json:"injectedBy"`
+}
+
+// HelperImages contains every image that Copilot injects into the tasks of the workloads of an application.
+type HelperImages struct {
+	App    string         `json:"application"`
+	Images []*HelperImage `json:"images"`
+}
+
+// NewHelperImages returns the helper images of the application, with the locations that the application overrides.
+func NewHelperImages(app *config.Application) *HelperImages {
+	images := make([]*HelperImage, len(template.HelperImages))
+	for i, img := range template.HelperImages {
+		source := HelperImageSourceDefault
+		if _, ok := app.HelperImages[img.Name]; ok {
+			source = HelperImageSourceApplication
+		}
+		images[i] = &HelperImage{
+			Name:       img.Name,
+			Image:      template.HelperImageURI(img.Name, app.HelperImages),
+			Source:     source,
+			InjectedBy: img.InjectedBy,
+		}
+	}
+	return &HelperImages{
+		App:    app.Name,
+		Images: images,
+	}
+}
+
+// JSONString returns the stringified HelperImages struct with json format.
+func (h *HelperImages) JSONString() (string, error) {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return "", fmt.Errorf("marshal helper images: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified HelperImages struct with human readable format.
+func (h *HelperImages) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Helper Images\n\n"))
+	writer.Flush()
+	headers := []string{"Name", "Image", "Source", "Injected By"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, img := range h.Images {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", img.Name, img.Image, img.Source, img.InjectedBy)
+	}
+	writer.Flush()
+	fmt.Fprint(writer, "\n  Images without a digest run the image that their tag references when a task starts.\n")
+	writer.Flush()
+	return b.String()
+}
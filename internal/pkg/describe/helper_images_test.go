@@ -0,0 +1,32 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHelperImages_HumanString(t *testing.T) {
+	images := NewHelperImages(&config.Application{
+		Name: "phonetool",
+		HelperImages: map[string]string{
+			"fluent-bit": "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/fluent-bit:2.31.12",
+		},
+	})
+
+	require.Equal(t, `Helper Images
+
+  Name                Image                                                                   Source       Injected By
+  ----                -----                                                                   ------       -----------
+  fluent-bit          123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/fluent-bit:2.31.12  application  logging
+  aws-otel-collector  public.ecr.aws/aws-observability/aws-otel-collector:v0.17.0             default      observability.tracing
+  envoy               envoyproxy/envoy:v1.27.2                                                default      http.mirror
+  pause               public.ecr.aws/amazonlinux/amazonlinux:2023                             default      copilot run local
+
+  Images without a digest run the image that their tag references when a task starts.
+`, images.HumanString())
+}
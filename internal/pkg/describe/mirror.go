@@ -0,0 +1,219 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	fmtWorkloadLogGroupName = "/copilot/%s-%s-%s"
+
+	// Values of the "side" field of the access logs of the traffic mirror.
+	trafficMirrorSideService = "service"
+	trafficMirrorSideMirror  = "mirror"
+)
+
+// TrafficStats holds the response statistics of the requests served by one side of a traffic mirror.
+type TrafficStats struct {
+	Requests     int     `json:"requests"`
+	Rate2xx      float64 `json:"2xxRate"`
+	Rate4xx      float64 `json:"4xxRate"`
+	Rate5xx      float64 `json:"5xxRate"`
+	LatencyP50Ms float64 `json:"p50LatencyMs"`
+	LatencyP90Ms float64 `json:"p90LatencyMs"`
+	LatencyP99Ms float64 `json:"p99LatencyMs"`
+}
+
+// TrafficMirrorComparison compares the responses of a service with the responses to the copies of its requests.
+type TrafficMirrorComparison struct {
+	Service TrafficStats `json:"service"`
+	Mirror  TrafficStats `json:"mirror"`
+}
+
+// TrafficMirrorComparer compares the responses of a service with the responses of the service its requests are mirrored to.
+type TrafficMirrorComparer struct {
+	app string
+	env string
+	svc string
+
+	logGetter logGetter
+	now       func() time.Time
+}
+
+// NewTrafficMirrorComparer instantiates a new TrafficMirrorComparer.
+func NewTrafficMirrorComparer(opt NewServiceConfig) (*TrafficMirrorComparer, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &TrafficMirrorComparer{
+		app:       opt.App,
+		env:       opt.Env,
+		svc:       opt.Svc,
+		logGetter: cloudwatchlogs.New(sess),
+		now:       time.Now,
+	}, nil
+}
+
+// Compare returns the statistics of the responses logged by the traffic mirror of the service over the last period of time.
+func (c *TrafficMirrorComparer) Compare(since time.Duration) (*TrafficMirrorComparison, error) {
+	out, err := c.logGetter.LogEvents(cloudwatchlogs.LogEventsOpts{
+		LogGroup:               fmt.Sprintf(fmtWorkloadLogGroupName, c.app, c.env, c.svc),
+		LogStreamPrefixFilters: []string{fmt.Sprintf("copilot/%s/", template.TrafficMirrorContainerName)},
+		StartTime:              aws.Int64(c.now().Add(-since).UnixMilli()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get traffic mirror logs of service %s: %w", c.svc, err)
+	}
+	responses := map[string][]mirroredResponse{}
+	for _, event := range out.Events {
+		side, resp, ok := parseTrafficMirrorLog(event.Message)
+		if !ok {
+			continue
+		}
+		responses[side] = append(responses[side], resp)
+	}
+	return &TrafficMirrorComparison{
+		Service: trafficStats(responses[trafficMirrorSideService]),
+		Mirror:  trafficStats(responses[trafficMirrorSideMirror]),
+	}, nil
+}
+
+type mirroredResponse struct {
+	status     int
+	durationMs float64
+}
+
+// parseTrafficMirrorLog returns the side and the response of an access log of the traffic mirror.
+// It returns false if the message isn't an access log.
+func parseTrafficMirrorLog(msg string) (string, mirroredResponse, bool) {
+	var entry struct {
+		Side     string          `json:"side"`
+		Status   json.RawMessage `json:"status"`
+		Duration json.RawMessage `json:"duration"`
+	}
+	if err := json.Unmarshal([]byte(msg), &entry); err != nil {
+		return "", mirroredResponse{}, false
+	}
+	if entry.Side != trafficMirrorSideService && entry.Side != trafficMirrorSideMirror {
+		return "", mirroredResponse{}, false
+	}
+	// Envoy writes the numbers of the access logs either as JSON numbers or as strings depending on its version.
+	number := func(raw json.RawMessage) float64 {
+		v, _ := strconv.ParseFloat(strings.Trim(string(raw), `"`), 64)
+		return v
+	}
+	return entry.Side, mirroredResponse{
+		status:     int(number(entry.Status)),
+		durationMs: number(entry.Duration),
+	}, true
+}
+
+func trafficStats(responses []mirroredResponse) TrafficStats {
+	if len(responses) == 0 {
+		return TrafficStats{}
+	}
+	var count2xx, count4xx, count5xx int
+	durations := make([]float64, len(responses))
+	for i, resp := range responses {
+		switch {
+		case resp.status >= 200 && resp.status < 300:
+			count2xx++
+		case resp.status >= 400 && resp.status < 500:
+			count4xx++
+		case resp.status >= 500 || resp.status == 0: // Envoy logs a status of 0 if the upstream didn't respond.
+			count5xx++
+		}
+		durations[i] = resp.durationMs
+	}
+	sort.Float64s(durations)
+	total := float64(len(responses))
+	return TrafficStats{
+		Requests:     len(responses),
+		Rate2xx:      float64(count2xx) / total,
+		Rate4xx:      float64(count4xx) / total,
+		Rate5xx:      float64(count5xx) / total,
+		LatencyP50Ms: percentile(durations, 50),
+		LatencyP90Ms: percentile(durations, 90),
+		LatencyP99Ms: percentile(durations, 99),
+	}
+}
+
+// percentile returns the nearest-rank percentile of sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// JSONString returns the stringified TrafficMirrorComparison struct with json format.
+func (c *TrafficMirrorComparison) JSONString() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal traffic mirror comparison: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified TrafficMirrorComparison struct with human readable format.
+func (c *TrafficMirrorComparison) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Traffic Comparison\n\n"))
+	writer.Flush()
+	if c.Service.Requests == 0 && c.Mirror.Requests == 0 {
+		fmt.Fprintln(writer, "  No requests were served by the traffic mirror.")
+		writer.Flush()
+		return b.String()
+	}
+	headers := []string{"Metric", "Service", "Mirror"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	rows := []struct {
+		name            string
+		service, mirror string
+	}{
+		{"Requests", strconv.Itoa(c.Service.Requests), strconv.Itoa(c.Mirror.Requests)},
+		{"2xx", formatRate(c.Service.Rate2xx), formatRate(c.Mirror.Rate2xx)},
+		{"4xx", formatRate(c.Service.Rate4xx), formatRate(c.Mirror.Rate4xx)},
+		{"5xx", formatRate(c.Service.Rate5xx), formatRate(c.Mirror.Rate5xx)},
+		{"Latency p50", formatLatency(c.Service.LatencyP50Ms), formatLatency(c.Mirror.LatencyP50Ms)},
+		{"Latency p90", formatLatency(c.Service.LatencyP90Ms), formatLatency(c.Mirror.LatencyP90Ms)},
+		{"Latency p99", formatLatency(c.Service.LatencyP99Ms), formatLatency(c.Mirror.LatencyP99Ms)},
+	}
+	for _, row := range rows {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", row.name, row.service, row.mirror)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func formatRate(rate float64) string {
+	return fmt.Sprintf("%.1f%%", rate*100)
+}
+
+func formatLatency(ms float64) string {
+	return fmt.Sprintf("%gms", ms)
+}
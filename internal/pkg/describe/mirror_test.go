@@ -0,0 +1,144 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrafficMirrorComparer_Compare(t *testing.T) {
+	now := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+	wantedOpts := cloudwatchlogs.LogEventsOpts{
+		LogGroup:               "/copilot/phonetool-test-api",
+		LogStreamPrefixFilters: []string{"copilot/traffic-mirror/"},
+		StartTime:              aws.Int64(now.Add(-time.Hour).UnixMilli()),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MocklogGetter)
+
+		wanted    *TrafficMirrorComparison
+		wantedErr error
+	}{
+		"error if fail to get the logs": {
+			setupMocks: func(m *mocks.MocklogGetter) {
+				m.EXPECT().LogEvents(wantedOpts).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get traffic mirror logs of service api: some error"),
+		},
+		"compares the responses of both sides": {
+			setupMocks: func(m *mocks.MocklogGetter) {
+				m.EXPECT().LogEvents(wantedOpts).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{Message: `[2023-06-01 11:30:00.000][1][info][main] starting main dispatch loop`},
+						{Message: `{"side":"service","status":200,"duration":10}`},
+						{Message: `{"side":"service","status":200,"duration":30}`},
+						{Message: `{"side":"service","status":"404","duration":"20"}`},
+						{Message: `{"side":"service","status":200,"duration":40}`},
+						{Message: `{"side":"mirror","status":200,"duration":15}`},
+						{Message: `{"side":"mirror","status":503,"duration":5}`},
+					},
+				}, nil)
+			},
+			wanted: &TrafficMirrorComparison{
+				Service: TrafficStats{
+					Requests:     4,
+					Rate2xx:      0.75,
+					Rate4xx:      0.25,
+					LatencyP50Ms: 20,
+					LatencyP90Ms: 40,
+					LatencyP99Ms: 40,
+				},
+				Mirror: TrafficStats{
+					Requests:     2,
+					Rate2xx:      0.5,
+					Rate5xx:      0.5,
+					LatencyP50Ms: 5,
+					LatencyP90Ms: 15,
+					LatencyP99Ms: 15,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocklogGetter(ctrl)
+			tc.setupMocks(m)
+			comparer := &TrafficMirrorComparer{
+				app:       "phonetool",
+				env:       "test",
+				svc:       "api",
+				logGetter: m,
+				now:       func() time.Time { return now },
+			}
+
+			got, err := comparer.Compare(time.Hour)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestTrafficMirrorComparison_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		in     TrafficMirrorComparison
+		wanted string
+	}{
+		"no requests": {
+			wanted: `Traffic Comparison
+
+  No requests were served by the traffic mirror.
+`,
+		},
+		"compares both sides": {
+			in: TrafficMirrorComparison{
+				Service: TrafficStats{Requests: 4, Rate2xx: 0.75, Rate4xx: 0.25, LatencyP50Ms: 20, LatencyP90Ms: 40, LatencyP99Ms: 40},
+				Mirror:  TrafficStats{Requests: 2, Rate2xx: 0.5, Rate5xx: 0.5, LatencyP50Ms: 5, LatencyP90Ms: 15, LatencyP99Ms: 15},
+			},
+			wanted: `Traffic Comparison
+
+  Metric       Service   Mirror
+  ------       -------   ------
+  Requests     4         2
+  2xx          75.0%     50.0%
+  4xx          25.0%     0.0%
+  5xx          0.0%      50.0%
+  Latency p50  20ms      5ms
+  Latency p90  40ms      15ms
+  Latency p99  40ms      15ms
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HumanString())
+		})
+	}
+}
+
+func TestTrafficMirrorComparison_JSONString(t *testing.T) {
+	in := TrafficMirrorComparison{
+		Service: TrafficStats{Requests: 4, Rate2xx: 1, LatencyP50Ms: 20, LatencyP90Ms: 40, LatencyP99Ms: 40},
+	}
+
+	got, err := in.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"service":{"requests":4,"2xxRate":1,"4xxRate":0,"5xxRate":0,"p50LatencyMs":20,"p90LatencyMs":40,"p99LatencyMs":40},"mirror":{"requests":0,"2xxRate":0,"4xxRate":0,"5xxRate":0,"p50LatencyMs":0,"p90LatencyMs":0,"p99LatencyMs":0}}
+`, got)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/dashboard.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	costexplorer "github.com/aws/copilot-cli/internal/pkg/aws/costexplorer"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockcostGetter is a mock of costGetter interface.
+type MockcostGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockcostGetterMockRecorder
+}
+
+// MockcostGetterMockRecorder is the mock recorder for MockcostGetter.
+type MockcostGetterMockRecorder struct {
+	mock *MockcostGetter
+}
+
+// NewMockcostGetter creates a new mock instance.
+func NewMockcostGetter(ctrl *gomock.Controller) *MockcostGetter {
+	mock := &MockcostGetter{ctrl: ctrl}
+	mock.recorder = &MockcostGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcostGetter) EXPECT() *MockcostGetterMockRecorder {
+	return m.recorder
+}
+
+// CostsByTag mocks base method.
+func (m *MockcostGetter) CostsByTag(filterKey, filterValue, groupKey string, start, end time.Time) (map[string]costexplorer.Cost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CostsByTag", filterKey, filterValue, groupKey, start, end)
+	ret0, _ := ret[0].(map[string]costexplorer.Cost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CostsByTag indicates an expected call of CostsByTag.
+func (mr *MockcostGetterMockRecorder) CostsByTag(filterKey, filterValue, groupKey, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CostsByTag", reflect.TypeOf((*MockcostGetter)(nil).CostsByTag), filterKey, filterValue, groupKey, start, end)
+}
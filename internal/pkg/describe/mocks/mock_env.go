@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	acm "github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -48,3 +49,41 @@ func (mr *MockvpcSubnetListerMockRecorder) ListVPCSubnets(vpcID interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCSubnets", reflect.TypeOf((*MockvpcSubnetLister)(nil).ListVPCSubnets), vpcID)
 }
+
+// MockcertDescriber is a mock of certDescriber interface.
+type MockcertDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockcertDescriberMockRecorder
+}
+
+// MockcertDescriberMockRecorder is the mock recorder for MockcertDescriber.
+type MockcertDescriberMockRecorder struct {
+	mock *MockcertDescriber
+}
+
+// NewMockcertDescriber creates a new mock instance.
+func NewMockcertDescriber(ctrl *gomock.Controller) *MockcertDescriber {
+	mock := &MockcertDescriber{ctrl: ctrl}
+	mock.recorder = &MockcertDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcertDescriber) EXPECT() *MockcertDescriberMockRecorder {
+	return m.recorder
+}
+
+// Certificate mocks base method.
+func (m *MockcertDescriber) Certificate(arn string) (*acm.Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Certificate", arn)
+	ret0, _ := ret[0].(*acm.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Certificate indicates an expected call of Certificate.
+func (mr *MockcertDescriberMockRecorder) Certificate(arn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Certificate", reflect.TypeOf((*MockcertDescriber)(nil).Certificate), arn)
+}
@@ -0,0 +1,135 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/exec_check.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	iam "github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockexecCheckerECSClient is a mock of execCheckerECSClient interface.
+type MockexecCheckerECSClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecCheckerECSClientMockRecorder
+}
+
+// MockexecCheckerECSClientMockRecorder is the mock recorder for MockexecCheckerECSClient.
+type MockexecCheckerECSClientMockRecorder struct {
+	mock *MockexecCheckerECSClient
+}
+
+// NewMockexecCheckerECSClient creates a new mock instance.
+func NewMockexecCheckerECSClient(ctrl *gomock.Controller) *MockexecCheckerECSClient {
+	mock := &MockexecCheckerECSClient{ctrl: ctrl}
+	mock.recorder = &MockexecCheckerECSClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexecCheckerECSClient) EXPECT() *MockexecCheckerECSClientMockRecorder {
+	return m.recorder
+}
+
+// ClusterExecConfig mocks base method.
+func (m *MockexecCheckerECSClient) ClusterExecConfig(app, env string) (*ecs.ExecConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterExecConfig", app, env)
+	ret0, _ := ret[0].(*ecs.ExecConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterExecConfig indicates an expected call of ClusterExecConfig.
+func (mr *MockexecCheckerECSClientMockRecorder) ClusterExecConfig(app, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterExecConfig", reflect.TypeOf((*MockexecCheckerECSClient)(nil).ClusterExecConfig), app, env)
+}
+
+// DescribeService mocks base method.
+func (m *MockexecCheckerECSClient) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
+	ret0, _ := ret[0].(*ecs0.ServiceDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockexecCheckerECSClientMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockexecCheckerECSClient)(nil).DescribeService), app, env, svc)
+}
+
+// Service mocks base method.
+func (m *MockexecCheckerECSClient) Service(app, env, svc string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", app, env, svc)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockexecCheckerECSClientMockRecorder) Service(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockexecCheckerECSClient)(nil).Service), app, env, svc)
+}
+
+// TaskDefinition mocks base method.
+func (m *MockexecCheckerECSClient) TaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", app, env, svc)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MockexecCheckerECSClientMockRecorder) TaskDefinition(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockexecCheckerECSClient)(nil).TaskDefinition), app, env, svc)
+}
+
+// MockpolicySimulator is a mock of policySimulator interface.
+type MockpolicySimulator struct {
+	ctrl     *gomock.Controller
+	recorder *MockpolicySimulatorMockRecorder
+}
+
+// MockpolicySimulatorMockRecorder is the mock recorder for MockpolicySimulator.
+type MockpolicySimulatorMockRecorder struct {
+	mock *MockpolicySimulator
+}
+
+// NewMockpolicySimulator creates a new mock instance.
+func NewMockpolicySimulator(ctrl *gomock.Controller) *MockpolicySimulator {
+	mock := &MockpolicySimulator{ctrl: ctrl}
+	mock.recorder = &MockpolicySimulatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpolicySimulator) EXPECT() *MockpolicySimulatorMockRecorder {
+	return m.recorder
+}
+
+// DeniedActions mocks base method.
+func (m *MockpolicySimulator) DeniedActions(in iam.SimulatePolicyInput) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeniedActions", in)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeniedActions indicates an expected call of DeniedActions.
+func (mr *MockpolicySimulatorMockRecorder) DeniedActions(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeniedActions", reflect.TypeOf((*MockpolicySimulator)(nil).DeniedActions), in)
+}
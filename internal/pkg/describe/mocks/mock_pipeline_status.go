@@ -48,3 +48,56 @@ func (mr *MockpipelineStateGetterMockRecorder) GetPipelineState(pipelineName int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineState", reflect.TypeOf((*MockpipelineStateGetter)(nil).GetPipelineState), pipelineName)
 }
+
+// MockstageDeploymentsGetter is a mock of stageDeploymentsGetter interface.
+type MockstageDeploymentsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstageDeploymentsGetterMockRecorder
+}
+
+// MockstageDeploymentsGetterMockRecorder is the mock recorder for MockstageDeploymentsGetter.
+type MockstageDeploymentsGetterMockRecorder struct {
+	mock *MockstageDeploymentsGetter
+}
+
+// NewMockstageDeploymentsGetter creates a new mock instance.
+func NewMockstageDeploymentsGetter(ctrl *gomock.Controller) *MockstageDeploymentsGetter {
+	mock := &MockstageDeploymentsGetter{ctrl: ctrl}
+	mock.recorder = &MockstageDeploymentsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstageDeploymentsGetter) EXPECT() *MockstageDeploymentsGetterMockRecorder {
+	return m.recorder
+}
+
+// SourceRevision mocks base method.
+func (m *MockstageDeploymentsGetter) SourceRevision(pipelineName, executionID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourceRevision", pipelineName, executionID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SourceRevision indicates an expected call of SourceRevision.
+func (mr *MockstageDeploymentsGetterMockRecorder) SourceRevision(pipelineName, executionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourceRevision", reflect.TypeOf((*MockstageDeploymentsGetter)(nil).SourceRevision), pipelineName, executionID)
+}
+
+// StageDeployments mocks base method.
+func (m *MockstageDeploymentsGetter) StageDeployments(pipelineName string, stageNames []string, limit int) (map[string][]codepipeline.StageDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StageDeployments", pipelineName, stageNames, limit)
+	ret0, _ := ret[0].(map[string][]codepipeline.StageDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StageDeployments indicates an expected call of StageDeployments.
+func (mr *MockstageDeploymentsGetterMockRecorder) StageDeployments(pipelineName, stageNames, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StageDeployments", reflect.TypeOf((*MockstageDeploymentsGetter)(nil).StageDeployments), pipelineName, stageNames, limit)
+}
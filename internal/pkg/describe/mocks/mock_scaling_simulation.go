@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/scaling_simulation.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockmetricSeriesGetter is a mock of metricSeriesGetter interface.
+type MockmetricSeriesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockmetricSeriesGetterMockRecorder
+}
+
+// MockmetricSeriesGetterMockRecorder is the mock recorder for MockmetricSeriesGetter.
+type MockmetricSeriesGetterMockRecorder struct {
+	mock *MockmetricSeriesGetter
+}
+
+// NewMockmetricSeriesGetter creates a new mock instance.
+func NewMockmetricSeriesGetter(ctrl *gomock.Controller) *MockmetricSeriesGetter {
+	mock := &MockmetricSeriesGetter{ctrl: ctrl}
+	mock.recorder = &MockmetricSeriesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmetricSeriesGetter) EXPECT() *MockmetricSeriesGetterMockRecorder {
+	return m.recorder
+}
+
+// MetricValues mocks base method.
+func (m *MockmetricSeriesGetter) MetricValues(metrics []cloudwatch.Metric, start, end time.Time) (map[string]*cloudwatch.MetricSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MetricValues", metrics, start, end)
+	ret0, _ := ret[0].(map[string]*cloudwatch.MetricSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MetricValues indicates an expected call of MetricValues.
+func (mr *MockmetricSeriesGetterMockRecorder) MetricValues(metrics, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetricValues", reflect.TypeOf((*MockmetricSeriesGetter)(nil).MetricValues), metrics, start, end)
+}
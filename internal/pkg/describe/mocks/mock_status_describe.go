@@ -6,11 +6,15 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
+	apprunner "github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	route53 "github.com/aws/copilot-cli/internal/pkg/aws/route53"
+	stack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -276,3 +280,155 @@ func (mr *MockautoscalingAlarmNamesGetterMockRecorder) ECSServiceAlarmNames(clus
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceAlarmNames", reflect.TypeOf((*MockautoscalingAlarmNamesGetter)(nil).ECSServiceAlarmNames), cluster, service)
 }
+
+// MockstackResourcesGetter is a mock of stackResourcesGetter interface.
+type MockstackResourcesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackResourcesGetterMockRecorder
+}
+
+// MockstackResourcesGetterMockRecorder is the mock recorder for MockstackResourcesGetter.
+type MockstackResourcesGetterMockRecorder struct {
+	mock *MockstackResourcesGetter
+}
+
+// NewMockstackResourcesGetter creates a new mock instance.
+func NewMockstackResourcesGetter(ctrl *gomock.Controller) *MockstackResourcesGetter {
+	mock := &MockstackResourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockstackResourcesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackResourcesGetter) EXPECT() *MockstackResourcesGetterMockRecorder {
+	return m.recorder
+}
+
+// Resources mocks base method.
+func (m *MockstackResourcesGetter) Resources() ([]*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources")
+	ret0, _ := ret[0].([]*stack.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockstackResourcesGetterMockRecorder) Resources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockstackResourcesGetter)(nil).Resources))
+}
+
+// MockhealthCheckStatusGetter is a mock of healthCheckStatusGetter interface.
+type MockhealthCheckStatusGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockhealthCheckStatusGetterMockRecorder
+}
+
+// MockhealthCheckStatusGetterMockRecorder is the mock recorder for MockhealthCheckStatusGetter.
+type MockhealthCheckStatusGetterMockRecorder struct {
+	mock *MockhealthCheckStatusGetter
+}
+
+// NewMockhealthCheckStatusGetter creates a new mock instance.
+func NewMockhealthCheckStatusGetter(ctrl *gomock.Controller) *MockhealthCheckStatusGetter {
+	mock := &MockhealthCheckStatusGetter{ctrl: ctrl}
+	mock.recorder = &MockhealthCheckStatusGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhealthCheckStatusGetter) EXPECT() *MockhealthCheckStatusGetterMockRecorder {
+	return m.recorder
+}
+
+// HealthCheckStatus mocks base method.
+func (m *MockhealthCheckStatusGetter) HealthCheckStatus(id string) (*route53.HealthCheckStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthCheckStatus", id)
+	ret0, _ := ret[0].(*route53.HealthCheckStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HealthCheckStatus indicates an expected call of HealthCheckStatus.
+func (mr *MockhealthCheckStatusGetterMockRecorder) HealthCheckStatus(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheckStatus", reflect.TypeOf((*MockhealthCheckStatusGetter)(nil).HealthCheckStatus), id)
+}
+
+// MockoperationsGetter is a mock of operationsGetter interface.
+type MockoperationsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockoperationsGetterMockRecorder
+}
+
+// MockoperationsGetterMockRecorder is the mock recorder for MockoperationsGetter.
+type MockoperationsGetterMockRecorder struct {
+	mock *MockoperationsGetter
+}
+
+// NewMockoperationsGetter creates a new mock instance.
+func NewMockoperationsGetter(ctrl *gomock.Controller) *MockoperationsGetter {
+	mock := &MockoperationsGetter{ctrl: ctrl}
+	mock.recorder = &MockoperationsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoperationsGetter) EXPECT() *MockoperationsGetterMockRecorder {
+	return m.recorder
+}
+
+// Operations mocks base method.
+func (m *MockoperationsGetter) Operations(svcARN string, limit int) ([]apprunner.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Operations", svcARN, limit)
+	ret0, _ := ret[0].([]apprunner.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Operations indicates an expected call of Operations.
+func (mr *MockoperationsGetterMockRecorder) Operations(svcARN, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Operations", reflect.TypeOf((*MockoperationsGetter)(nil).Operations), svcARN, limit)
+}
+
+// MockmetricValuesGetter is a mock of metricValuesGetter interface.
+type MockmetricValuesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockmetricValuesGetterMockRecorder
+}
+
+// MockmetricValuesGetterMockRecorder is the mock recorder for MockmetricValuesGetter.
+type MockmetricValuesGetterMockRecorder struct {
+	mock *MockmetricValuesGetter
+}
+
+// NewMockmetricValuesGetter creates a new mock instance.
+func NewMockmetricValuesGetter(ctrl *gomock.Controller) *MockmetricValuesGetter {
+	mock := &MockmetricValuesGetter{ctrl: ctrl}
+	mock.recorder = &MockmetricValuesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmetricValuesGetter) EXPECT() *MockmetricValuesGetterMockRecorder {
+	return m.recorder
+}
+
+// LatestMetricValues mocks base method.
+func (m *MockmetricValuesGetter) LatestMetricValues(metrics []cloudwatch.Metric, start, end time.Time) (map[string]float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestMetricValues", metrics, start, end)
+	ret0, _ := ret[0].(map[string]float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestMetricValues indicates an expected call of LatestMetricValues.
+func (mr *MockmetricValuesGetterMockRecorder) LatestMetricValues(metrics, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestMetricValues", reflect.TypeOf((*MockmetricValuesGetter)(nil).LatestMetricValues), metrics, start, end)
+}
@@ -20,10 +20,16 @@ type pipelineStateGetter interface {
 	GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error)
 }
 
+type stageDeploymentsGetter interface {
+	StageDeployments(pipelineName string, stageNames []string, limit int) (map[string][]codepipeline.StageDeployment, error)
+	SourceRevision(pipelineName, executionID string) (string, error)
+}
+
 // PipelineStatusDescriber retrieves status of a deployed pipeline.
 type PipelineStatusDescriber struct {
 	pipeline    deploy.Pipeline
 	pipelineSvc pipelineStateGetter
+	deployments stageDeploymentsGetter
 }
 
 // PipelineStatus contains the status for a pipeline.
@@ -43,6 +49,7 @@ func NewPipelineStatusDescriber(pipeline deploy.Pipeline) (*PipelineStatusDescri
 	return &PipelineStatusDescriber{
 		pipeline:    pipeline,
 		pipelineSvc: pipelineSvc,
+		deployments: pipelineSvc,
 	}, nil
 }
 
@@ -52,6 +59,9 @@ func (d *PipelineStatusDescriber) Describe() (HumanJSONStringer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get pipeline status: %w", err)
 	}
+	if err := d.addRevisions(ps); err != nil {
+		return nil, err
+	}
 	pipelineStatus := &PipelineStatus{
 		Name:          d.pipeline.Name,
 		PipelineState: *ps,
@@ -59,6 +69,39 @@ func (d *PipelineStatusDescriber) Describe() (HumanJSONStringer, error) {
 	return pipelineStatus, nil
 }
 
+// addRevisions sets the source revision that each deploy stage last deployed successfully.
+func (d *PipelineStatusDescriber) addRevisions(ps *codepipeline.PipelineState) error {
+	var stageNames []string
+	for _, stage := range ps.StageStates {
+		if strings.HasPrefix(stage.StageName, deploy.StageFullNamePrefix) {
+			stageNames = append(stageNames, stage.StageName)
+		}
+	}
+	if len(stageNames) == 0 {
+		return nil
+	}
+	deployments, err := d.deployments.StageDeployments(d.pipeline.ResourceName, stageNames, 1)
+	if err != nil {
+		return fmt.Errorf("get stage deployments: %w", err)
+	}
+	revisions := make(map[string]string)
+	for _, stage := range ps.StageStates {
+		if len(deployments[stage.StageName]) == 0 {
+			continue
+		}
+		executionID := deployments[stage.StageName][0].PipelineExecutionID
+		if _, ok := revisions[executionID]; !ok {
+			revision, err := d.deployments.SourceRevision(d.pipeline.ResourceName, executionID)
+			if err != nil {
+				return fmt.Errorf("get revision deployed to stage %s: %w", stage.StageName, err)
+			}
+			revisions[executionID] = revision
+		}
+		stage.Revision = revisions[executionID]
+	}
+	return nil
+}
+
 // JSONString returns stringified PipelineStatus struct with json format.
 func (p PipelineStatus) JSONString() (string, error) {
 	b, err := json.Marshal(p)
@@ -74,7 +117,7 @@ func (p PipelineStatus) HumanString() string {
 	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
 	fmt.Fprint(writer, color.Bold.Sprint("Pipeline Status\n\n"))
 	writer.Flush()
-	headers := []string{"Stage", "Transition", "Status"}
+	headers := []string{"Stage", "Transition", "Status", "Revision"}
 	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
 	fmt.Fprintf(writer, "%s\n", strings.Join(underline(headers), "\t"))
 	for _, stage := range p.StageStates {
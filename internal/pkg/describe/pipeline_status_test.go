@@ -19,62 +19,66 @@ import (
 )
 
 type pipelineStatusDescriberMocks struct {
-	pipelineStateGetter *mocks.MockpipelineStateGetter
+	pipelineStateGetter    *mocks.MockpipelineStateGetter
+	stageDeploymentsGetter *mocks.MockstageDeploymentsGetter
 }
 
 var mockParsedTime = func() time.Time {
 	t, _ := time.Parse(time.RFC3339, "2020-02-02T15:04:05+00:00")
 	return t
 }
-var mockPipelineState = &codepipeline.PipelineState{
-	PipelineName: pipelineResourceName,
-	StageStates: []*codepipeline.StageState{
-		{
-			StageName: "Source",
-		},
-		{
-			StageName: "Build",
-			Actions: []codepipeline.StageAction{
-				{
-					Name:   "action1",
-					Status: "Failed",
-				},
-				{
-					Name:   "action2",
-					Status: "InProgress",
-				},
-				{
-					Name:   "action3",
-					Status: "Succeeded",
-				},
+
+func newMockPipelineState() *codepipeline.PipelineState {
+	return &codepipeline.PipelineState{
+		PipelineName: pipelineResourceName,
+		StageStates: []*codepipeline.StageState{
+			{
+				StageName: "Source",
 			},
-			Transition: "ENABLED",
-		},
-		{
-			StageName: "DeployTo-test",
-			Actions: []codepipeline.StageAction{
-				{
-					Name:   "action1",
-					Status: "Succeeded",
+			{
+				StageName: "Build",
+				Actions: []codepipeline.StageAction{
+					{
+						Name:   "action1",
+						Status: "Failed",
+					},
+					{
+						Name:   "action2",
+						Status: "InProgress",
+					},
+					{
+						Name:   "action3",
+						Status: "Succeeded",
+					},
 				},
+				Transition: "ENABLED",
 			},
-			Transition: "DISABLED",
-		},
-		{
-			StageName: "DeployTo-prod",
-			Actions: []codepipeline.StageAction{
-				{
-					Name:   "action1",
-					Status: "Succeeded",
+			{
+				StageName: "DeployTo-test",
+				Actions: []codepipeline.StageAction{
+					{
+						Name:   "action1",
+						Status: "Succeeded",
+					},
 				},
-				{
-					Name:   "TestCommands",
-					Status: "Failed",
+				Transition: "DISABLED",
+			},
+			{
+				StageName: "DeployTo-prod",
+				Actions: []codepipeline.StageAction{
+					{
+						Name:   "action1",
+						Status: "Succeeded",
+					},
+					{
+						Name:   "TestCommands",
+						Status: "Failed",
+					},
 				},
 			},
 		},
-	},
-	UpdatedAt: mockParsedTime(),
+		UpdatedAt: mockParsedTime(),
+	}
 }
 
 func TestPipelineStatusDescriber_Describe(t *testing.T) {
@@ -93,14 +97,42 @@ func TestPipelineStatusDescriber_Describe(t *testing.T) {
 			expectedError:  fmt.Errorf("get pipeline status: %w", mockError),
 			expectedOutput: nil,
 		},
+		"wraps StageDeployments error": {
+			setupMocks: func(m pipelineStatusDescriberMocks) {
+				m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(newMockPipelineState(), nil)
+				m.stageDeploymentsGetter.EXPECT().StageDeployments(pipelineResourceName, []string{"DeployTo-test", "DeployTo-prod"}, 1).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("get stage deployments: %w", mockError),
+		},
+		"wraps SourceRevision error": {
+			setupMocks: func(m pipelineStatusDescriberMocks) {
+				m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(newMockPipelineState(), nil)
+				m.stageDeploymentsGetter.EXPECT().StageDeployments(pipelineResourceName, gomock.Any(), 1).Return(map[string][]codepipeline.StageDeployment{
+					"DeployTo-test": {{PipelineExecutionID: "exec-2"}},
+				}, nil)
+				m.stageDeploymentsGetter.EXPECT().SourceRevision(pipelineResourceName, "exec-2").Return("", mockError)
+			},
+			expectedError: fmt.Errorf("get revision deployed to stage DeployTo-test: %w", mockError),
+		},
 		"success": {
 			setupMocks: func(m pipelineStatusDescriberMocks) {
-				m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(mockPipelineState, nil)
+				m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(newMockPipelineState(), nil)
+				m.stageDeploymentsGetter.EXPECT().StageDeployments(pipelineResourceName, []string{"DeployTo-test", "DeployTo-prod"}, 1).Return(map[string][]codepipeline.StageDeployment{
+					"DeployTo-test": {{PipelineExecutionID: "exec-2"}},
+					"DeployTo-prod": {{PipelineExecutionID: "exec-1"}},
+				}, nil)
+				m.stageDeploymentsGetter.EXPECT().SourceRevision(pipelineResourceName, "exec-2").Return("b8a6e2c0f1d4a3b5c6d7e8f90a1b2c3d4e5f6a7b", nil)
+				m.stageDeploymentsGetter.EXPECT().SourceRevision(pipelineResourceName, "exec-1").Return("0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b", nil)
 			},
 			expectedError: nil,
 			expectedOutput: &PipelineStatus{
-				Name:          pipelineName,
-				PipelineState: *mockPipelineState,
+				Name: pipelineName,
+				PipelineState: func() codepipeline.PipelineState {
+					state := newMockPipelineState()
+					state.StageStates[2].Revision = "b8a6e2c0f1d4a3b5c6d7e8f90a1b2c3d4e5f6a7b"
+					state.StageStates[3].Revision = "0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b"
+					return *state
+				}(),
 			},
 		},
 	}
@@ -110,9 +142,11 @@ func TestPipelineStatusDescriber_Describe(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockPipelineStateGetter := mocks.NewMockpipelineStateGetter(ctrl)
+			mockStageDeploymentsGetter := mocks.NewMockstageDeploymentsGetter(ctrl)
 
 			mocks := pipelineStatusDescriberMocks{
-				pipelineStateGetter: mockPipelineStateGetter,
+				pipelineStateGetter:    mockPipelineStateGetter,
+				stageDeploymentsGetter: mockStageDeploymentsGetter,
 			}
 			tc.setupMocks(mocks)
 
@@ -126,6 +160,7 @@ func TestPipelineStatusDescriber_Describe(t *testing.T) {
 			describer := &PipelineStatusDescriber{
 				pipeline:    mockDeployedPipeline,
 				pipelineSvc: mockPipelineStateGetter,
+				deployments: mockStageDeploymentsGetter,
 			}
 
 			// WHEN
@@ -158,28 +193,33 @@ func TestPipelineStatusDescriber_String(t *testing.T) {
 	}{
 		"correct output with correct aggregate statuses": {
 			testPipelineStatus: &PipelineStatus{
-				Name:          pipelineName,
-				PipelineState: *mockPipelineState},
+				Name: pipelineName,
+				PipelineState: func() codepipeline.PipelineState {
+					state := newMockPipelineState()
+					state.StageStates[2].Revision = "b8a6e2c0f1d4a3b5c6d7e8f90a1b2c3d4e5f6a7b"
+					return *state
+				}(),
+			},
 			expectedHumanString: `Pipeline Status
 
-Stage             Transition  Status
------             ----------  ------
-Source              -           -
-Build             ENABLED     InProgress
-├── action1                   Failed
-├── action2                   InProgress
-└── action3                   Succeeded
-DeployTo-test     DISABLED    Succeeded
-└── action1                   Succeeded
-DeployTo-prod       -         Failed
-├── action1                   Succeeded
-└── TestCommands              Failed
+Stage             Transition  Status      Revision
+-----             ----------  ------      --------
+Source              -           -           -
+Build             ENABLED     InProgress    -
+├── action1                   Failed      
+├── action2                   InProgress  
+└── action3                   Succeeded   
+DeployTo-test     DISABLED    Succeeded   b8a6e2c
+└── action1                   Succeeded   
+DeployTo-prod       -         Failed        -
+├── action1                   Succeeded   
+└── TestCommands              Failed      
 
 Last Deployment
 
   Updated At  4 months ago
 `,
-			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"pipelineName\":\"pipeline-dinder-badgoose-repo-RANDOMSTRING\",\"stageStates\":[{\"stageName\":\"Source\",\"transition\":\"\"},{\"stageName\":\"Build\",\"actions\":[{\"name\":\"action1\",\"status\":\"Failed\"},{\"name\":\"action2\",\"status\":\"InProgress\"},{\"name\":\"action3\",\"status\":\"Succeeded\"}],\"transition\":\"ENABLED\"},{\"stageName\":\"DeployTo-test\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"}],\"transition\":\"DISABLED\"},{\"stageName\":\"DeployTo-prod\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"},{\"name\":\"TestCommands\",\"status\":\"Failed\"}],\"transition\":\"\"}],\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
+			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"pipelineName\":\"pipeline-dinder-badgoose-repo-RANDOMSTRING\",\"stageStates\":[{\"stageName\":\"Source\",\"transition\":\"\"},{\"stageName\":\"Build\",\"actions\":[{\"name\":\"action1\",\"status\":\"Failed\"},{\"name\":\"action2\",\"status\":\"InProgress\"},{\"name\":\"action3\",\"status\":\"Succeeded\"}],\"transition\":\"ENABLED\"},{\"stageName\":\"DeployTo-test\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"}],\"transition\":\"DISABLED\",\"revision\":\"b8a6e2c0f1d4a3b5c6d7e8f90a1b2c3d4e5f6a7b\"},{\"stageName\":\"DeployTo-prod\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"},{\"name\":\"TestCommands\",\"status\":\"Failed\"}],\"transition\":\"\"}],\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
 		},
 	}
 	for _, tc := range testCases {
@@ -68,6 +68,15 @@ func (d *RDWebServiceDescriber) ServiceARN(env string) (string, error) {
 	return describer.ServiceARN()
 }
 
+// Service retrieves the configuration of the app runner service in an environment.
+func (d *RDWebServiceDescriber) Service(env string) (*apprunner.Service, error) {
+	describer, err := d.initAppRunnerDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	return describer.Service()
+}
+
 // Describe returns info for a request-driven web service.
 func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	environments, err := d.store.ListEnvironmentsDeployedTo(d.app, d.svc)
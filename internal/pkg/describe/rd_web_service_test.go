@@ -671,3 +671,63 @@ Resources
 		require.Equal(t, wantedJSONString, json)
 	})
 }
+
+func TestRDWebServiceDescriber_Service(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(mocks apprunnerSvcDescriberMocks)
+
+		wantedService *apprunner.Service
+		wantedError   error
+	}{
+		"return error if fail to describe the service": {
+			setupMocks: func(m apprunnerSvcDescriberMocks) {
+				m.ecsSvcDescriber.EXPECT().Service().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"success": {
+			setupMocks: func(m apprunnerSvcDescriberMocks) {
+				m.ecsSvcDescriber.EXPECT().Service().Return(&apprunner.Service{
+					Name: "testapp-test-testsvc",
+					Port: "80",
+				}, nil)
+			},
+			wantedService: &apprunner.Service{
+				Name: "testapp-test-testsvc",
+				Port: "80",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvcDescriber := mocks.NewMockapprunnerDescriber(ctrl)
+			tc.setupMocks(apprunnerSvcDescriberMocks{
+				ecsSvcDescriber: mockSvcDescriber,
+			})
+
+			d := &RDWebServiceDescriber{
+				app: "testapp",
+				svc: "testsvc",
+				initAppRunnerDescriber: func(env string) (apprunnerDescriber, error) {
+					require.Equal(t, "test", env)
+					return mockSvcDescriber, nil
+				},
+			}
+
+			// WHEN
+			svc, err := d.Service("test")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedService, svc)
+		})
+	}
+}
@@ -0,0 +1,463 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Metrics that a target tracking scaling policy of an ECS service can track.
+const (
+	ScalingMetricCPU    = "CPU"
+	ScalingMetricMemory = "Memory"
+)
+
+const (
+	ecsMetricsNamespace            = "AWS/ECS"
+	containerInsightsNamespace     = "ECS/ContainerInsights"
+	runningTaskCountMetricID       = "tasks"
+	scalingSimulationSparklineSize = 60
+
+	// Target tracking policies create an alarm that scales out after 3 minutes above the target,
+	// and an alarm that scales in after 15 minutes below 90% of the target.
+	scaleOutEvaluationPeriod = 3 * time.Minute
+	scaleInEvaluationPeriod  = 15 * time.Minute
+	scaleInThreshold         = 0.9
+
+	// Cooldowns of the scaling policies when the manifest doesn't specify them.
+	defaultScaleInCooldown  = 120 * time.Second
+	defaultScaleOutCooldown = 60 * time.Second
+
+	// Values of the "action" field of a ScalingEvent.
+	scalingActionOut = "scale out"
+	scalingActionIn  = "scale in"
+)
+
+var sparklineLevels = []rune("▁▂▃▄▅▆▇█")
+
+type metricSeriesGetter interface {
+	MetricValues(metrics []cloudwatch.Metric, start, end time.Time) (map[string]*cloudwatch.MetricSeries, error)
+}
+
+// ScalingPolicy is a target tracking scaling policy of a service.
+type ScalingPolicy struct {
+	Metric           string  // Either ScalingMetricCPU or ScalingMetricMemory.
+	Target           float64 // Target average utilization of the tasks, in percent.
+	ScaleInCooldown  time.Duration
+	ScaleOutCooldown time.Duration
+}
+
+// NewScalingPolicy returns a target tracking scaling policy with the default cooldowns of Copilot when they aren't specified.
+func NewScalingPolicy(metric string, target float64, scaleInCooldown, scaleOutCooldown *time.Duration) ScalingPolicy {
+	policy := ScalingPolicy{
+		Metric:           metric,
+		Target:           target,
+		ScaleInCooldown:  defaultScaleInCooldown,
+		ScaleOutCooldown: defaultScaleOutCooldown,
+	}
+	if scaleInCooldown != nil {
+		policy.ScaleInCooldown = *scaleInCooldown
+	}
+	if scaleOutCooldown != nil {
+		policy.ScaleOutCooldown = *scaleOutCooldown
+	}
+	return policy
+}
+
+// ScalingConfig is the autoscaling configuration of a service to simulate.
+type ScalingConfig struct {
+	MinCount int
+	MaxCount int
+	Policies []ScalingPolicy
+}
+
+// SimulatedScalingPolicy holds how a scaling policy would have behaved.
+type SimulatedScalingPolicy struct {
+	Metric      string    `json:"metric"`
+	Target      float64   `json:"target"`
+	Utilization []float64 `json:"utilization"` // Average utilization of the simulated tasks at each timestamp.
+	Peak        float64   `json:"peak"`
+	Breaches    int       `json:"breaches"` // Number of datapoints above the target.
+}
+
+// ScalingEvent is a change of the number of tasks during a simulation.
+type ScalingEvent struct {
+	Time   time.Time `json:"time"`
+	Action string    `json:"action"`
+	From   int       `json:"from"`
+	To     int       `json:"to"`
+	Metric string    `json:"metric"`
+}
+
+// ScalingSimulation holds how the autoscaling configuration of a service would have behaved with its historical load.
+type ScalingSimulation struct {
+	MinCount      int                       `json:"minCount"`
+	MaxCount      int                       `json:"maxCount"`
+	PeriodSeconds int                       `json:"periodSeconds"`
+	Timestamps    []time.Time               `json:"timestamps"`
+	ObservedTasks []int                     `json:"observedTasks"`
+	Tasks         []int                     `json:"tasks"` // Number of simulated tasks at each timestamp.
+	Policies      []*SimulatedScalingPolicy `json:"policies"`
+	Events        []*ScalingEvent           `json:"events"`
+	Saturated     int                       `json:"saturated"` // Number of datapoints at the maximum count while above a target.
+}
+
+// ScalingSimulator replays the historical load of a service against an autoscaling configuration.
+type ScalingSimulator struct {
+	app string
+	env string
+	svc string
+
+	ecsClient     ecsClient
+	metricsGetter metricSeriesGetter
+	now           func() time.Time
+}
+
+// NewScalingSimulator instantiates a new ScalingSimulator.
+func NewScalingSimulator(opt NewServiceConfig) (*ScalingSimulator, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &ScalingSimulator{
+		app:           opt.App,
+		env:           opt.Env,
+		svc:           opt.Svc,
+		ecsClient:     ecs.New(sess),
+		metricsGetter: cloudwatch.New(sess),
+		now:           time.Now,
+	}, nil
+}
+
+// Simulate returns how the scaling configuration would have scaled the service over the last period of time.
+// The load of each datapoint is the utilization of the service multiplied by its number of running tasks,
+// which is then spread evenly across the simulated tasks.
+func (s *ScalingSimulator) Simulate(cfg ScalingConfig, since time.Duration) (*ScalingSimulation, error) {
+	svc, err := s.ecsClient.Service(s.app, s.env, s.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service of %s: %w", s.svc, err)
+	}
+	svcARN, err := awsecs.ParseServiceArn(aws.StringValue(svc.ServiceArn))
+	if err != nil {
+		return nil, fmt.Errorf("parse ECS service ARN %s: %w", aws.StringValue(svc.ServiceArn), err)
+	}
+	dimensions := map[string]string{
+		"ClusterName": svcARN.ClusterName(),
+		"ServiceName": svcARN.ServiceName(),
+	}
+	period := scalingSimulationPeriod(since)
+	metrics := []cloudwatch.Metric{
+		{
+			ID:         runningTaskCountMetricID,
+			Namespace:  containerInsightsNamespace,
+			Name:       "RunningTaskCount",
+			Dimensions: dimensions,
+			Stat:       "Average",
+			Period:     period,
+		},
+	}
+	for _, policy := range cfg.Policies {
+		metrics = append(metrics, cloudwatch.Metric{
+			ID:         strings.ToLower(policy.Metric),
+			Namespace:  ecsMetricsNamespace,
+			Name:       policy.Metric + "Utilization",
+			Dimensions: dimensions,
+			Stat:       "Average",
+			Period:     period,
+		})
+	}
+	end := s.now().Truncate(period)
+	series, err := s.metricsGetter.MetricValues(metrics, end.Add(-since), end)
+	if err != nil {
+		return nil, fmt.Errorf("get metrics of service %s: %w", s.svc, err)
+	}
+	return simulateScaling(cfg, period, series, int(aws.Int64Value(svc.DesiredCount))), nil
+}
+
+// scalingSimulationPeriod returns the period of the datapoints to simulate so that long simulations stay within
+// the retention of the CloudWatch metrics: 1-minute datapoints are kept for 15 days, and 5-minute datapoints for 63 days.
+func scalingSimulationPeriod(since time.Duration) time.Duration {
+	switch {
+	case since <= 24*time.Hour:
+		return time.Minute
+	case since <= 15*24*time.Hour:
+		return 5 * time.Minute
+	default:
+		return time.Hour
+	}
+}
+
+// simulateScaling replays the utilization of the service against the scaling configuration.
+// The number of tasks that served each datapoint comes from Container Insights, or is the desired count of the service if it isn't enabled.
+func simulateScaling(cfg ScalingConfig, period time.Duration, series map[string]*cloudwatch.MetricSeries, desiredCount int) *ScalingSimulation {
+	sim := &ScalingSimulation{
+		MinCount:      cfg.MinCount,
+		MaxCount:      cfg.MaxCount,
+		PeriodSeconds: int(period.Seconds()),
+		Timestamps:    []time.Time{},
+		ObservedTasks: []int{},
+		Tasks:         []int{},
+		Policies:      []*SimulatedScalingPolicy{},
+		Events:        []*ScalingEvent{},
+	}
+	values := make([]map[time.Time]float64, len(cfg.Policies))
+	seen := make(map[time.Time]bool)
+	for i, policy := range cfg.Policies {
+		sim.Policies = append(sim.Policies, &SimulatedScalingPolicy{
+			Metric:      policy.Metric,
+			Target:      policy.Target,
+			Utilization: []float64{},
+		})
+		values[i] = seriesByTime(series[strings.ToLower(policy.Metric)])
+		// Simulate the timestamps where the service published its utilization, in order.
+		if s, ok := series[strings.ToLower(policy.Metric)]; ok {
+			for _, ts := range s.Timestamps {
+				if !seen[ts] {
+					seen[ts] = true
+					sim.Timestamps = append(sim.Timestamps, ts)
+				}
+			}
+		}
+	}
+	sort.Slice(sim.Timestamps, func(i, j int) bool { return sim.Timestamps[i].Before(sim.Timestamps[j]) })
+	runningTasks := seriesByTime(series[runningTaskCountMetricID])
+
+	scaleOutDatapoints := evaluationDatapoints(scaleOutEvaluationPeriod, period)
+	scaleInDatapoints := evaluationDatapoints(scaleInEvaluationPeriod, period)
+	above := make([]int, len(cfg.Policies))
+	below := make([]int, len(cfg.Policies))
+	lastUtilization := make([]float64, len(cfg.Policies))
+	var lastScaleOut, lastScaleIn time.Time
+	observed := desiredCount
+	tasks := -1
+	for _, ts := range sim.Timestamps {
+		if count, ok := runningTasks[ts]; ok {
+			observed = int(math.Round(count))
+		}
+		if tasks == -1 {
+			tasks = clampInt(observed, cfg.MinCount, cfg.MaxCount)
+		}
+		sim.ObservedTasks = append(sim.ObservedTasks, observed)
+		sim.Tasks = append(sim.Tasks, tasks)
+
+		loads := make([]float64, len(cfg.Policies))
+		var aboveTarget bool
+		for i, policy := range cfg.Policies {
+			if v, ok := values[i][ts]; ok {
+				lastUtilization[i] = v
+			}
+			loads[i] = lastUtilization[i] * float64(observed)
+			utilization := loads[i] / float64(tasks)
+			simulated := sim.Policies[i]
+			simulated.Utilization = append(simulated.Utilization, utilization)
+			simulated.Peak = math.Max(simulated.Peak, utilization)
+			switch {
+			case utilization > policy.Target:
+				simulated.Breaches++
+				aboveTarget = true
+				above[i]++
+				below[i] = 0
+			case utilization < policy.Target*scaleInThreshold:
+				above[i] = 0
+				below[i]++
+			default:
+				above[i], below[i] = 0, 0
+			}
+		}
+		if aboveTarget && tasks == cfg.MaxCount {
+			sim.Saturated++
+		}
+
+		// Scale out as soon as any policy's alarm fires.
+		desired, metric := tasks, ""
+		for i, policy := range cfg.Policies {
+			if above[i] < scaleOutDatapoints || ts.Sub(lastScaleOut) < policy.ScaleOutCooldown {
+				continue
+			}
+			if want := tasksForTarget(loads[i], policy.Target); want > desired {
+				desired, metric = want, policy.Metric
+			}
+		}
+		if desired = clampInt(desired, cfg.MinCount, cfg.MaxCount); desired > tasks {
+			sim.Events = append(sim.Events, &ScalingEvent{Time: ts, Action: scalingActionOut, From: tasks, To: desired, Metric: metric})
+			tasks, lastScaleOut = desired, ts
+			for i := range above {
+				above[i] = 0
+			}
+			continue
+		}
+
+		// Scale in only when the alarms of all the policies fire, to the largest number of tasks that any policy needs.
+		if len(cfg.Policies) == 0 {
+			continue
+		}
+		desired, metric = 0, ""
+		for i, policy := range cfg.Policies {
+			if below[i] < scaleInDatapoints || ts.Sub(lastScaleIn) < policy.ScaleInCooldown {
+				desired = tasks
+				break
+			}
+			if want := tasksForTarget(loads[i], policy.Target); want > desired || metric == "" {
+				desired, metric = want, policy.Metric
+			}
+		}
+		if desired = clampInt(desired, cfg.MinCount, cfg.MaxCount); desired < tasks {
+			sim.Events = append(sim.Events, &ScalingEvent{Time: ts, Action: scalingActionIn, From: tasks, To: desired, Metric: metric})
+			tasks, lastScaleIn = desired, ts
+			for i := range below {
+				below[i] = 0
+			}
+		}
+	}
+	return sim
+}
+
+func seriesByTime(series *cloudwatch.MetricSeries) map[time.Time]float64 {
+	out := make(map[time.Time]float64)
+	if series == nil {
+		return out
+	}
+	for i, ts := range series.Timestamps {
+		if i < len(series.Values) {
+			out[ts] = series.Values[i]
+		}
+	}
+	return out
+}
+
+// evaluationDatapoints returns the number of consecutive datapoints that an alarm needs to evaluate a duration.
+func evaluationDatapoints(duration, period time.Duration) int {
+	if n := int(duration / period); n > 1 {
+		return n
+	}
+	return 1
+}
+
+// tasksForTarget returns the number of tasks that bring the average utilization of a load to the target.
+func tasksForTarget(load, target float64) int {
+	if target <= 0 {
+		return 0
+	}
+	return int(math.Ceil(load / target))
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if max > 0 && v > max {
+		return max
+	}
+	return v
+}
+
+// JSONString returns the stringified ScalingSimulation struct with json format.
+func (s *ScalingSimulation) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal scaling simulation: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ScalingSimulation struct with human readable format.
+func (s *ScalingSimulation) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Scaling Simulation\n\n"))
+	writer.Flush()
+	if len(s.Timestamps) == 0 {
+		fmt.Fprintln(writer, "  No utilization metrics were published by the service over the period.")
+		writer.Flush()
+		return b.String()
+	}
+	fmt.Fprintf(writer, "  From %s to %s, one datapoint every %s.\n\n",
+		s.Timestamps[0].Format(time.RFC3339), s.Timestamps[len(s.Timestamps)-1].Format(time.RFC3339), time.Duration(s.PeriodSeconds)*time.Second)
+	headers := []string{"Series", "Target", "Peak", "Breaches", "Over Time"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	observed := make([]float64, len(s.ObservedTasks))
+	tasks := make([]float64, len(s.Tasks))
+	var observedPeak, tasksPeak float64
+	for i := range s.Tasks {
+		observed[i], tasks[i] = float64(s.ObservedTasks[i]), float64(s.Tasks[i])
+		observedPeak, tasksPeak = math.Max(observedPeak, observed[i]), math.Max(tasksPeak, tasks[i])
+	}
+	taskCeiling := math.Max(float64(s.MaxCount), math.Max(observedPeak, tasksPeak))
+	fmt.Fprintf(writer, "  Observed tasks\t-\t%d\t-\t%s\n", int(observedPeak), sparkline(observed, taskCeiling))
+	fmt.Fprintf(writer, "  Simulated tasks (%d-%d)\t-\t%d\t-\t%s\n", s.MinCount, s.MaxCount, int(tasksPeak), sparkline(tasks, taskCeiling))
+	for _, policy := range s.Policies {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%d\t%s\n", policy.Metric, formatPercent(policy.Target), formatPercent(policy.Peak),
+			policy.Breaches, sparkline(policy.Utilization, math.Max(100, policy.Peak)))
+	}
+	writer.Flush()
+	if s.Saturated > 0 {
+		fmt.Fprintf(writer, "\n  The service would have been above target at its maximum of %d tasks for %d datapoints.\n", s.MaxCount, s.Saturated)
+		writer.Flush()
+	}
+	fmt.Fprint(writer, color.Bold.Sprint("\nScaling Events\n\n"))
+	writer.Flush()
+	if len(s.Events) == 0 {
+		fmt.Fprintln(writer, "  The service wouldn't have scaled.")
+		writer.Flush()
+		return b.String()
+	}
+	headers = []string{"Time", "Action", "Tasks", "Metric"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, event := range s.Events {
+		fmt.Fprintf(writer, "  %s\t%s\t%d -> %d\t%s\n", event.Time.Format(time.RFC3339), event.Action, event.From, event.To, event.Metric)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// sparkline draws values as a line of block characters scaled to the ceiling,
+// where each character shows the largest value of consecutive datapoints if there are too many to fit.
+func sparkline(values []float64, ceiling float64) string {
+	if len(values) == 0 || ceiling <= 0 {
+		return ""
+	}
+	size := int(math.Ceil(float64(len(values)) / scalingSimulationSparklineSize))
+	var b strings.Builder
+	for start := 0; start < len(values); start += size {
+		var peak float64
+		for _, v := range values[start:minInt(start+size, len(values))] {
+			peak = math.Max(peak, v)
+		}
+		level := int(math.Round(peak / ceiling * float64(len(sparklineLevels)-1)))
+		b.WriteRune(sparklineLevels[clampInt(level, 0, len(sparklineLevels)-1)])
+	}
+	return b.String()
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func formatPercent(v float64) string {
+	return strconv.FormatFloat(v, 'f', 1, 64) + "%"
+}
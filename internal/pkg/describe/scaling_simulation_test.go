@@ -0,0 +1,270 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type scalingSimulatorMocks struct {
+	ecs *mocks.MockecsClient
+	cw  *mocks.MockmetricSeriesGetter
+}
+
+func TestScalingSimulator_Simulate(t *testing.T) {
+	now := time.Date(2023, time.June, 1, 12, 0, 30, 0, time.UTC)
+	start := now.Truncate(time.Minute).Add(-time.Hour)
+	minutes := func(n int) []time.Time {
+		var out []time.Time
+		for i := 0; i < n; i++ {
+			out = append(out, start.Add(time.Duration(i)*time.Minute))
+		}
+		return out
+	}
+	repeat := func(v float64, n int) []float64 {
+		var out []float64
+		for i := 0; i < n; i++ {
+			out = append(out, v)
+		}
+		return out
+	}
+	cfg := ScalingConfig{
+		MinCount: 1,
+		MaxCount: 3,
+		Policies: []ScalingPolicy{NewScalingPolicy(ScalingMetricCPU, 50, nil, nil)},
+	}
+	mockService := func(m scalingSimulatorMocks) {
+		m.ecs.EXPECT().Service("phonetool", "test", "api").Return(&awsecs.Service{
+			ServiceArn:   aws.String("arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-api-Service"),
+			DesiredCount: aws.Int64(2),
+		}, nil)
+	}
+	testCases := map[string]struct {
+		setupMocks func(m scalingSimulatorMocks)
+
+		wanted    *ScalingSimulation
+		wantedErr error
+	}{
+		"error if fail to get the ECS service": {
+			setupMocks: func(m scalingSimulatorMocks) {
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get ECS service of api: some error"),
+		},
+		"error if fail to get the metrics": {
+			setupMocks: func(m scalingSimulatorMocks) {
+				mockService(m)
+				m.cw.EXPECT().MetricValues(gomock.Any(), start, now.Truncate(time.Minute)).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get metrics of service api: some error"),
+		},
+		"scales out when the load rises and scales in once it stays low": {
+			setupMocks: func(m scalingSimulatorMocks) {
+				mockService(m)
+				dimensions := map[string]string{
+					"ClusterName": "phonetool-test-Cluster",
+					"ServiceName": "phonetool-test-api-Service",
+				}
+				m.cw.EXPECT().MetricValues([]cloudwatch.Metric{
+					{
+						ID:         "tasks",
+						Namespace:  "ECS/ContainerInsights",
+						Name:       "RunningTaskCount",
+						Dimensions: dimensions,
+						Stat:       "Average",
+						Period:     time.Minute,
+					},
+					{
+						ID:         "cpu",
+						Namespace:  "AWS/ECS",
+						Name:       "CPUUtilization",
+						Dimensions: dimensions,
+						Stat:       "Average",
+						Period:     time.Minute,
+					},
+				}, start, now.Truncate(time.Minute)).Return(map[string]*cloudwatch.MetricSeries{
+					"cpu": {
+						Timestamps: minutes(21),
+						Values:     append(append(repeat(20, 2), repeat(90, 4)...), repeat(10, 15)...),
+					},
+				}, nil)
+			},
+			wanted: &ScalingSimulation{
+				MinCount:      1,
+				MaxCount:      3,
+				PeriodSeconds: 60,
+				Timestamps:    minutes(21),
+				ObservedTasks: []int{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+				Tasks:         []int{2, 2, 2, 2, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+				Policies: []*SimulatedScalingPolicy{
+					{
+						Metric:      "CPU",
+						Target:      50,
+						Utilization: append(append(repeat(20, 2), 90, 90, 90, 60), repeat(20.0/3, 15)...),
+						Peak:        90,
+						Breaches:    4,
+					},
+				},
+				Events: []*ScalingEvent{
+					{Time: start.Add(4 * time.Minute), Action: "scale out", From: 2, To: 3, Metric: "CPU"},
+					{Time: start.Add(20 * time.Minute), Action: "scale in", From: 3, To: 1, Metric: "CPU"},
+				},
+				Saturated: 1,
+			},
+		},
+		"uses the running tasks from Container Insights": {
+			setupMocks: func(m scalingSimulatorMocks) {
+				mockService(m)
+				m.cw.EXPECT().MetricValues(gomock.Any(), start, now.Truncate(time.Minute)).Return(map[string]*cloudwatch.MetricSeries{
+					"tasks": {
+						Timestamps: minutes(2),
+						Values:     []float64{4, 1},
+					},
+					"cpu": {
+						Timestamps: minutes(2),
+						Values:     []float64{30, 30},
+					},
+				}, nil)
+			},
+			wanted: &ScalingSimulation{
+				MinCount:      1,
+				MaxCount:      3,
+				PeriodSeconds: 60,
+				Timestamps:    minutes(2),
+				ObservedTasks: []int{4, 1},
+				Tasks:         []int{3, 3},
+				Policies: []*SimulatedScalingPolicy{
+					{
+						Metric:      "CPU",
+						Target:      50,
+						Utilization: []float64{40, 10},
+						Peak:        40,
+					},
+				},
+				Events: []*ScalingEvent{},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := scalingSimulatorMocks{
+				ecs: mocks.NewMockecsClient(ctrl),
+				cw:  mocks.NewMockmetricSeriesGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			simulator := &ScalingSimulator{
+				app:           "phonetool",
+				env:           "test",
+				svc:           "api",
+				ecsClient:     m.ecs,
+				metricsGetter: m.cw,
+				now:           func() time.Time { return now },
+			}
+
+			got, err := simulator.Simulate(cfg, time.Hour)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestScalingSimulation_HumanString(t *testing.T) {
+	start := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		in     *ScalingSimulation
+		wanted string
+	}{
+		"no datapoints": {
+			in: &ScalingSimulation{MinCount: 1, MaxCount: 3, PeriodSeconds: 60},
+			wanted: `Scaling Simulation
+
+  No utilization metrics were published by the service over the period.
+`,
+		},
+		"draws the series and lists the scaling events": {
+			in: &ScalingSimulation{
+				MinCount:      1,
+				MaxCount:      4,
+				PeriodSeconds: 60,
+				Timestamps:    []time.Time{start, start.Add(time.Minute), start.Add(2 * time.Minute), start.Add(3 * time.Minute)},
+				ObservedTasks: []int{2, 2, 2, 2},
+				Tasks:         []int{1, 1, 4, 4},
+				Policies: []*SimulatedScalingPolicy{
+					{
+						Metric:      "CPU",
+						Target:      70,
+						Utilization: []float64{20, 100, 50, 0},
+						Peak:        100,
+						Breaches:    1,
+					},
+				},
+				Events: []*ScalingEvent{
+					{Time: start.Add(time.Minute), Action: "scale out", From: 1, To: 4, Metric: "CPU"},
+				},
+				Saturated: 1,
+			},
+			wanted: `Scaling Simulation
+
+  From 2023-06-01T12:00:00Z to 2023-06-01T12:03:00Z, one datapoint every 1m0s.
+
+  Series                 Target    Peak      Breaches  Over Time
+  ------                 ------    ----      --------  ---------
+  Observed tasks         -         2         -         ▅▅▅▅
+  Simulated tasks (1-4)  -         4         -         ▃▃██
+  CPU                    70.0%     100.0%    1         ▂█▅▁
+
+  The service would have been above target at its maximum of 4 tasks for 1 datapoints.
+
+Scaling Events
+
+  Time                  Action     Tasks     Metric
+  ----                  ------     -----     ------
+  2023-06-01T12:01:00Z  scale out  1 -> 4    CPU
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HumanString())
+		})
+	}
+}
+
+func TestScalingSimulation_JSONString(t *testing.T) {
+	start := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+	sim := &ScalingSimulation{
+		MinCount:      1,
+		MaxCount:      2,
+		PeriodSeconds: 60,
+		Timestamps:    []time.Time{start},
+		ObservedTasks: []int{1},
+		Tasks:         []int{1},
+		Policies: []*SimulatedScalingPolicy{
+			{Metric: "Memory", Target: 80, Utilization: []float64{90}, Peak: 90, Breaches: 1},
+		},
+		Events: []*ScalingEvent{},
+	}
+
+	got, err := sim.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"minCount":1,"maxCount":2,"periodSeconds":60,"timestamps":["2023-06-01T12:00:00Z"],"observedTasks":[1],"tasks":[1],"policies":[{"metric":"Memory","target":80,"utilization":[90],"peak":90,"breaches":1}],"events":[],"saturated":0}
+`, got)
+}
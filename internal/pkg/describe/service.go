@@ -138,6 +138,7 @@ type NewServiceConfig struct {
 
 	EnableResources bool
 	DeployStore     DeployedEnvServicesLister
+	SessionProvider SessionProvider // Optional. Defaults to the sessions shared by the process.
 }
 
 func newECSServiceDescriber(opt NewServiceConfig) (*ecsServiceDescriber, error) {
@@ -146,6 +147,8 @@ func newECSServiceDescriber(opt NewServiceConfig) (*ecsServiceDescriber, error)
 		Env:         opt.Env,
 		Name:        opt.Svc,
 		ConfigStore: opt.ConfigStore,
+
+		SessionProvider: opt.SessionProvider,
 	})
 	if err != nil {
 		return nil, err
@@ -162,6 +165,8 @@ func newAppRunnerServiceDescriber(opt NewServiceConfig) (*appRunnerServiceDescri
 		Env:         opt.Env,
 		Name:        opt.Svc,
 		ConfigStore: opt.ConfigStore,
+
+		SessionProvider: opt.SessionProvider,
 	})
 	if err != nil {
 		return nil, err
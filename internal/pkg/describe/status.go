@@ -21,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 
 	fcolor "github.com/fatih/color"
@@ -39,23 +40,36 @@ var (
 	summaryBarEmptyRepConfig = summarybar.WithEmptyRep(emptyRep)
 )
 
-// ecsServiceStatus contains the status for an ECS service.
-type ecsServiceStatus struct {
+// ECSServiceStatus contains the status for an ECS service.
+type ECSServiceStatus struct {
 	Service                  awsecs.ServiceStatus
-	DesiredRunningTasks      []awsecs.TaskStatus      `json:"tasks"`
-	Alarms                   []cloudwatch.AlarmStatus `json:"alarms"`
-	StoppedTasks             []awsecs.TaskStatus      `json:"stoppedTasks"`
-	TargetHealthDescriptions []taskTargetHealth       `json:"targetHealthDescriptions"`
+	DesiredRunningTasks      []awsecs.TaskStatus         `json:"tasks"`
+	Alarms                   []cloudwatch.AlarmStatus    `json:"alarms"`
+	StoppedTasks             []awsecs.TaskStatus         `json:"stoppedTasks"`
+	TargetHealthDescriptions []taskTargetHealth          `json:"targetHealthDescriptions"`
+	AliasHealthChecks        []route53.HealthCheckStatus `json:"aliasHealthChecks,omitempty"`
 }
 
-// appRunnerServiceStatus contains the status for an App Runner service.
-type appRunnerServiceStatus struct {
-	Service   apprunner.Service
-	LogEvents []*cloudwatchlogs.Event
+// AppRunnerServiceStatus contains the status for an App Runner service.
+type AppRunnerServiceStatus struct {
+	Service     apprunner.Service
+	LogEvents   []*cloudwatchlogs.Event
+	Deployments []apprunner.Operation
+	Metrics     *appRunnerServiceMetrics
 }
 
-// staticSiteServiceStatus contains the status for a Static Site service.
-type staticSiteServiceStatus struct {
+// appRunnerServiceMetrics contains the number of instances of an App Runner service and the requests it served in the last hour.
+type appRunnerServiceMetrics struct {
+	ActiveInstances int     `json:"activeInstances"`
+	Requests        int     `json:"requests"`
+	Responses2XX    int     `json:"2xxResponses"`
+	Responses4XX    int     `json:"4xxResponses"`
+	Responses5XX    int     `json:"5xxResponses"`
+	P99LatencyMs    float64 `json:"p99LatencyMs"`
+}
+
+// StaticSiteServiceStatus contains the status for a Static Site service.
+type StaticSiteServiceStatus struct {
 	BucketName string `json:"bucketName"`
 	Size       string `json:"totalSize"`
 	Count      int    `json:"totalObjects"`
@@ -67,8 +81,8 @@ type taskTargetHealth struct {
 	TargetGroupARN string             `json:"targetGroup"`
 }
 
-// JSONString returns the stringified ecsServiceStatus struct with json format.
-func (s *ecsServiceStatus) JSONString() (string, error) {
+// JSONString returns the stringified ECSServiceStatus struct with json format.
+func (s *ECSServiceStatus) JSONString() (string, error) {
 	b, err := json.Marshal(s)
 	if err != nil {
 		return "", fmt.Errorf("marshal services: %w", err)
@@ -76,8 +90,8 @@ func (s *ecsServiceStatus) JSONString() (string, error) {
 	return fmt.Sprintf("%s\n", b), nil
 }
 
-// JSONString returns the stringified appRunnerServiceStatus struct with json format.
-func (a *appRunnerServiceStatus) JSONString() (string, error) {
+// JSONString returns the stringified AppRunnerServiceStatus struct with json format.
+func (a *AppRunnerServiceStatus) JSONString() (string, error) {
 	data := struct {
 		ARN       string    `json:"arn"`
 		Status    string    `json:"status"`
@@ -86,6 +100,8 @@ func (a *appRunnerServiceStatus) JSONString() (string, error) {
 		Source    struct {
 			ImageID string `json:"imageId"`
 		} `json:"source"`
+		Deployments []apprunner.Operation    `json:"deployments,omitempty"`
+		Metrics     *appRunnerServiceMetrics `json:"metrics,omitempty"`
 	}{
 		ARN:       a.Service.ServiceARN,
 		Status:    a.Service.Status,
@@ -96,6 +112,8 @@ func (a *appRunnerServiceStatus) JSONString() (string, error) {
 		}{
 			ImageID: a.Service.ImageID,
 		},
+		Deployments: a.Deployments,
+		Metrics:     a.Metrics,
 	}
 	b, err := json.Marshal(data)
 	if err != nil {
@@ -104,8 +122,8 @@ func (a *appRunnerServiceStatus) JSONString() (string, error) {
 	return fmt.Sprintf("%s\n", b), nil
 }
 
-// JSONString returns the stringified staticSiteServiceStatus struct with json format.
-func (s *staticSiteServiceStatus) JSONString() (string, error) {
+// JSONString returns the stringified StaticSiteServiceStatus struct with json format.
+func (s *StaticSiteServiceStatus) JSONString() (string, error) {
 	b, err := json.Marshal(s)
 	if err != nil {
 		return "", fmt.Errorf("marshal services: %w", err)
@@ -113,8 +131,8 @@ func (s *staticSiteServiceStatus) JSONString() (string, error) {
 	return fmt.Sprintf("%s\n", b), nil
 }
 
-// HumanString returns the stringified ecsServiceStatus struct in human-readable format.
-func (s *ecsServiceStatus) HumanString() string {
+// HumanString returns the stringified ECSServiceStatus struct in human-readable format.
+func (s *ECSServiceStatus) HumanString() string {
 	var b bytes.Buffer
 	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
 
@@ -123,6 +141,13 @@ func (s *ecsServiceStatus) HumanString() string {
 	s.writeTaskSummary(writer)
 	writer.Flush()
 
+	if unusual := s.unusualBehavior(); len(unusual) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nUnusual Behavior\n\n"))
+		writer.Flush()
+		writeUnusualBehavior(writer, unusual)
+		writer.Flush()
+	}
+
 	if len(s.StoppedTasks) > 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nStopped Tasks\n\n"))
 		writer.Flush()
@@ -143,11 +168,18 @@ func (s *ecsServiceStatus) HumanString() string {
 		s.writeAlarms(writer)
 		writer.Flush()
 	}
+
+	if len(s.AliasHealthChecks) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAlias Health Checks\n\n"))
+		writer.Flush()
+		s.writeAliasHealthChecks(writer)
+		writer.Flush()
+	}
 	return b.String()
 }
 
-// HumanString returns the stringified appRunnerServiceStatus struct in human-readable format.
-func (a *appRunnerServiceStatus) HumanString() string {
+// HumanString returns the stringified AppRunnerServiceStatus struct in human-readable format.
+func (a *AppRunnerServiceStatus) HumanString() string {
 	var b bytes.Buffer
 	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
 	fmt.Fprint(writer, color.Bold.Sprint("Service Status\n\n"))
@@ -164,6 +196,34 @@ func (a *appRunnerServiceStatus) HumanString() string {
 	}
 	fmt.Fprintf(writer, "  %s\t%s\n", "Source", imageID)
 	writer.Flush()
+	if a.Metrics != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nInstances\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%d\n", "Active", a.Metrics.ActiveInstances)
+		fmt.Fprint(writer, color.Bold.Sprint("\nRequests (last hour)\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%d\n", "Total", a.Metrics.Requests)
+		fmt.Fprintf(writer, "  %s\t%d\n", "2XX", a.Metrics.Responses2XX)
+		fmt.Fprintf(writer, "  %s\t%d\n", "4XX", a.Metrics.Responses4XX)
+		fmt.Fprintf(writer, "  %s\t%d\n", "5XX", a.Metrics.Responses5XX)
+		fmt.Fprintf(writer, "  %s\t%s\n", "P99 Latency", time.Duration(a.Metrics.P99LatencyMs*float64(time.Millisecond)).Round(time.Millisecond))
+		writer.Flush()
+	}
+	if len(a.Deployments) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDeployments\n\n"))
+		writer.Flush()
+		headers := []string{"Operation ID", "Type", "Status", "Started", "Ended"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, d := range a.Deployments {
+			ended := "-"
+			if d.EndedAt != nil {
+				ended = humanizeTime(*d.EndedAt)
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", d.ID, d.Type, d.Status, humanizeTime(d.StartedAt), ended)
+		}
+		writer.Flush()
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nSystem Logs\n\n"))
 	writer.Flush()
 	lo, _ := time.LoadLocation("UTC")
@@ -175,8 +235,8 @@ func (a *appRunnerServiceStatus) HumanString() string {
 	return b.String()
 }
 
-// HumanString returns the stringified staticSiteServiceStatus struct in human-readable format.
-func (s *staticSiteServiceStatus) HumanString() string {
+// HumanString returns the stringified StaticSiteServiceStatus struct in human-readable format.
+func (s *StaticSiteServiceStatus) HumanString() string {
 	var b bytes.Buffer
 	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
 	fmt.Fprint(writer, color.Bold.Sprint("Bucket Summary\n\n"))
@@ -188,7 +248,7 @@ func (s *staticSiteServiceStatus) HumanString() string {
 	return b.String()
 }
 
-func (s *ecsServiceStatus) writeTaskSummary(writer io.Writer) {
+func (s *ECSServiceStatus) writeTaskSummary(writer io.Writer) {
 	// NOTE: all the `bar` need to be fully colored. Observe how all the second parameter for all `summaryBar` function
 	// is a list of strings that are colored (e.g. `[]string{color.Green.Sprint("■"), color.Grey.Sprint("□")}`)
 	// This is because if the some of the bar is partially colored, tab writer will behave unexpectedly.
@@ -209,7 +269,7 @@ func (s *ecsServiceStatus) writeTaskSummary(writer io.Writer) {
 	s.writeCapacityProvidersSummary(writer)
 }
 
-func (s *ecsServiceStatus) writeRunningTasksSummary(writer io.Writer, primaryDeployment awsecs.Deployment, activeDeployments []awsecs.Deployment) {
+func (s *ECSServiceStatus) writeRunningTasksSummary(writer io.Writer, primaryDeployment awsecs.Deployment, activeDeployments []awsecs.Deployment) {
 	// By default, we want to show the primary running task vs. primary desired tasks.
 	data := []summarybar.Datum{
 		{
@@ -246,7 +306,7 @@ func (s *ecsServiceStatus) writeRunningTasksSummary(writer io.Writer, primaryDep
 	fmt.Fprintf(writer, "\t%s\n", stringSummary)
 }
 
-func (s *ecsServiceStatus) writeDeploymentsSummary(writer io.Writer, primaryDeployment awsecs.Deployment, activeDeployments []awsecs.Deployment) {
+func (s *ECSServiceStatus) writeDeploymentsSummary(writer io.Writer, primaryDeployment awsecs.Deployment, activeDeployments []awsecs.Deployment) {
 	if len(activeDeployments) <= 0 {
 		return
 	}
@@ -264,7 +324,7 @@ func (s *ecsServiceStatus) writeDeploymentsSummary(writer io.Writer, primaryDepl
 	}
 }
 
-func (s *ecsServiceStatus) writeDeployment(writer io.Writer, deployment awsecs.Deployment, repColor *fcolor.Color) {
+func (s *ECSServiceStatus) writeDeployment(writer io.Writer, deployment awsecs.Deployment, repColor *fcolor.Color) {
 	var revisionInfo string
 	revision, err := awsecs.TaskDefinitionVersion(deployment.TaskDefinition)
 	if err == nil {
@@ -291,7 +351,7 @@ func (s *ecsServiceStatus) writeDeployment(writer io.Writer, deployment awsecs.D
 	fmt.Fprintf(writer, "\t%s\n", stringSummary)
 }
 
-func (s *ecsServiceStatus) writeHealthSummary(writer io.Writer, primaryDeployment awsecs.Deployment, activeDeployments []awsecs.Deployment) {
+func (s *ECSServiceStatus) writeHealthSummary(writer io.Writer, primaryDeployment awsecs.Deployment, activeDeployments []awsecs.Deployment) {
 	revision, _ := awsecs.TaskDefinitionVersion(primaryDeployment.TaskDefinition)
 	primaryTasks := s.tasksOfRevision(revision)
 
@@ -350,7 +410,7 @@ func (s *ecsServiceStatus) writeHealthSummary(writer io.Writer, primaryDeploymen
 	}
 }
 
-func (s *ecsServiceStatus) writeCapacityProvidersSummary(writer io.Writer) {
+func (s *ECSServiceStatus) writeCapacityProvidersSummary(writer io.Writer) {
 	if !isCapacityProvidersEnabled(s.DesiredRunningTasks) {
 		return
 	}
@@ -381,7 +441,7 @@ func (s *ecsServiceStatus) writeCapacityProvidersSummary(writer io.Writer) {
 	fmt.Fprintf(writer, "\t%s\n", strings.Join(cpSummaries, ", "))
 }
 
-func (s *ecsServiceStatus) writeStoppedTasks(writer io.Writer) {
+func (s *ECSServiceStatus) writeStoppedTasks(writer io.Writer) {
 	headers := []string{"Reason", "Task Count", "Sample Task IDs"}
 	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
 	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
@@ -399,7 +459,7 @@ func (s *ecsServiceStatus) writeStoppedTasks(writer io.Writer) {
 	}
 }
 
-func (s *ecsServiceStatus) writeRunningTasks(writer io.Writer) {
+func (s *ECSServiceStatus) writeRunningTasks(writer io.Writer) {
 	shouldShowHTTPHealth := anyTasksInAnyTargetGroup(s.DesiredRunningTasks, s.TargetHealthDescriptions)
 	shouldShowCapacityProvider := isCapacityProvidersEnabled(s.DesiredRunningTasks)
 	shouldShowContainerHealth := isContainerHealthCheckEnabled(s.DesiredRunningTasks)
@@ -441,7 +501,7 @@ func (s *ecsServiceStatus) writeRunningTasks(writer io.Writer) {
 	}
 }
 
-func (s *ecsServiceStatus) writeAlarms(writer io.Writer) {
+func (s *ECSServiceStatus) writeAlarms(writer io.Writer) {
 	headers := []string{"Name", "Type", "Condition", "Last Updated", "Health"}
 	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
 	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
@@ -452,6 +512,40 @@ func (s *ecsServiceStatus) writeAlarms(writer io.Writer) {
 	}
 }
 
+func (s *ECSServiceStatus) writeAliasHealthChecks(writer io.Writer) {
+	headers := []string{"Alias", "Healthy Checkers", "Last Failure", "Health"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, check := range s.AliasHealthChecks {
+		health := color.Green.Sprint("HEALTHY")
+		if !check.Healthy {
+			health = color.Red.Sprint("UNHEALTHY")
+		}
+		lastFailure := "-"
+		if check.LastFailureReason != "" {
+			lastFailure = check.LastFailureReason
+		}
+		fmt.Fprintf(writer, "  %s\t%d/%d\t%s\t%s\n", check.Domain, check.HealthyCheckers, check.Checkers, lastFailure, health)
+	}
+}
+
+// unusualBehavior returns the anomaly detection alarms of the service that are in alarm.
+func (s *ECSServiceStatus) unusualBehavior() []cloudwatch.AlarmStatus {
+	var alarms []cloudwatch.AlarmStatus
+	for _, alarm := range s.Alarms {
+		if alarm.Type == anomalyAlarmType && alarm.Status == "ALARM" {
+			alarms = append(alarms, alarm)
+		}
+	}
+	return alarms
+}
+
+func writeUnusualBehavior(writer io.Writer, alarms []cloudwatch.AlarmStatus) {
+	for _, alarm := range alarms {
+		fmt.Fprintf(writer, "  %s\t%s\n", alarm.Condition, humanizeTime(alarm.UpdatedTimes))
+	}
+}
+
 type ecsTaskStatus awsecs.TaskStatus
 
 // Example output:
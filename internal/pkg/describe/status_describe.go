@@ -6,18 +6,23 @@ package describe
 import (
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	awsS3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/s3"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
-	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 )
 
@@ -25,6 +30,18 @@ const (
 	fmtAppRunnerSvcLogGroupName = "/aws/apprunner/%s/%s/service"
 	autoscalingAlarmType        = "Auto Scaling"
 	rollbackAlarmType           = "Rollback"
+	anomalyAlarmType            = "Anomaly Detection"
+	aliasHealthCheckAlarmType   = "Alias Health Check"
+
+	route53HealthCheckResourceType = "AWS::Route53::HealthCheck"
+
+	// Number of the most recent operations of an App Runner service to look for deployments in.
+	appRunnerOperationsLimit  = 10
+	appRunnerMetricsNamespace = "AWS/AppRunner"
+	// Request metrics of an App Runner service are aggregated over this period.
+	appRunnerRequestMetricsPeriod = time.Hour
+	// The number of instances of an App Runner service is the maximum over this period.
+	appRunnerInstancesMetricPeriod = 5 * time.Minute
 )
 
 type targetHealthGetter interface {
@@ -53,6 +70,22 @@ type autoscalingAlarmNamesGetter interface {
 	ECSServiceAlarmNames(cluster, service string) ([]string, error)
 }
 
+type stackResourcesGetter interface {
+	Resources() ([]*stack.Resource, error)
+}
+
+type healthCheckStatusGetter interface {
+	HealthCheckStatus(id string) (*route53.HealthCheckStatus, error)
+}
+
+type operationsGetter interface {
+	Operations(svcARN string, limit int) ([]apprunner.Operation, error)
+}
+
+type metricValuesGetter interface {
+	LatestMetricValues(metrics []cloudwatch.Metric, start, end time.Time) (map[string]float64, error)
+}
+
 type ecsStatusDescriber struct {
 	app string
 	env string
@@ -63,6 +96,8 @@ type ecsStatusDescriber struct {
 	cwSvcGetter        alarmStatusGetter
 	aasSvcGetter       autoscalingAlarmNamesGetter
 	targetHealthGetter targetHealthGetter
+	stackResources     stackResourcesGetter
+	healthCheckGetter  healthCheckStatusGetter
 }
 
 type appRunnerStatusDescriber struct {
@@ -70,8 +105,11 @@ type appRunnerStatusDescriber struct {
 	env string
 	svc string
 
-	svcDescriber apprunnerDescriber
-	eventsGetter logGetter
+	svcDescriber     apprunnerDescriber
+	eventsGetter     logGetter
+	operationsGetter operationsGetter
+	metricsGetter    metricValuesGetter
+	now              func() time.Time
 }
 
 type staticSiteStatusDescriber struct {
@@ -88,6 +126,8 @@ type NewServiceStatusConfig struct {
 	Env         string
 	Svc         string
 	ConfigStore ConfigStoreSvc
+
+	SessionProvider SessionProvider // Optional. Defaults to the sessions shared by the process.
 }
 
 // NewECSStatusDescriber instantiates a new ecsStatusDescriber struct.
@@ -96,7 +136,7 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 	if err != nil {
 		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
 	}
-	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	sess, err := sessionsOrDefault(opt.SessionProvider).FromRole(env.ManagerRoleARN, env.Region)
 	if err != nil {
 		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
 	}
@@ -109,27 +149,33 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 		ecsSvcGetter:       awsecs.New(sess),
 		aasSvcGetter:       aas.New(sess),
 		targetHealthGetter: elbv2.New(sess),
+		stackResources:     stack.NewStackDescriber(cfnstack.NameForWorkload(opt.App, opt.Env, opt.Svc), sess),
+		healthCheckGetter:  route53.New(sess),
 	}, nil
 }
 
 // NewAppRunnerStatusDescriber instantiates a new appRunnerStatusDescriber struct.
 func NewAppRunnerStatusDescriber(opt *NewServiceStatusConfig) (*appRunnerStatusDescriber, error) {
 	appRunnerSvcDescriber, err := newAppRunnerServiceDescriber(NewServiceConfig{
-		App:         opt.App,
-		Env:         opt.Env,
-		Svc:         opt.Svc,
-		ConfigStore: opt.ConfigStore,
+		App:             opt.App,
+		Env:             opt.Env,
+		Svc:             opt.Svc,
+		ConfigStore:     opt.ConfigStore,
+		SessionProvider: opt.SessionProvider,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &appRunnerStatusDescriber{
-		app:          opt.App,
-		env:          opt.Env,
-		svc:          opt.Svc,
-		svcDescriber: appRunnerSvcDescriber,
-		eventsGetter: cloudwatchlogs.New(appRunnerSvcDescriber.sess),
+		app:              opt.App,
+		env:              opt.Env,
+		svc:              opt.Svc,
+		svcDescriber:     appRunnerSvcDescriber,
+		eventsGetter:     cloudwatchlogs.New(appRunnerSvcDescriber.sess),
+		operationsGetter: apprunner.New(appRunnerSvcDescriber.sess),
+		metricsGetter:    cloudwatch.New(appRunnerSvcDescriber.sess),
+		now:              time.Now,
 	}, nil
 }
 
@@ -145,7 +191,7 @@ func NewStaticSiteStatusDescriber(opt *NewServiceStatusConfig) (*staticSiteStatu
 		if err != nil {
 			return nil, nil, fmt.Errorf("get environment %s: %w", env, err)
 		}
-		sess, err := sessions.ImmutableProvider().FromRole(environment.ManagerRoleARN, environment.Region)
+		sess, err := sessionsOrDefault(opt.SessionProvider).FromRole(environment.ManagerRoleARN, environment.Region)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -202,11 +248,11 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 	for _, alarm := range autoscalingAlarms {
 		alarms[alarm.Name] = alarm
 	}
-	rollbackAlarms, err := s.ecsServiceRollbackAlarms(s.app, s.env, s.svc)
+	copilotAlarms, err := s.ecsServiceCopilotAlarms(s.app, s.env, s.svc)
 	if err != nil {
 		return nil, err
 	}
-	for _, alarm := range rollbackAlarms {
+	for _, alarm := range copilotAlarms {
 		alarms[alarm.Name] = alarm
 	}
 	alarmList := make([]cloudwatch.AlarmStatus, len(alarms))
@@ -234,15 +280,43 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		return tasksTargetHealth[i].TargetGroupARN < tasksTargetHealth[j].TargetGroupARN
 	})
 
-	return &ecsServiceStatus{
+	aliasHealthChecks, err := s.aliasHealthChecks()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ECSServiceStatus{
 		Service:                  service.ServiceStatus(),
 		DesiredRunningTasks:      taskStatus,
 		Alarms:                   alarmList,
 		StoppedTasks:             stoppedTaskStatus,
 		TargetHealthDescriptions: tasksTargetHealth,
+		AliasHealthChecks:        aliasHealthChecks,
 	}, nil
 }
 
+// aliasHealthChecks returns the status of the Route 53 health checks against the aliases of the service.
+func (s *ecsStatusDescriber) aliasHealthChecks() ([]route53.HealthCheckStatus, error) {
+	resources, err := s.stackResources.Resources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve resources of service %s: %w", s.svc, err)
+	}
+	var checks []route53.HealthCheckStatus
+	for _, resource := range resources {
+		if resource.Type != route53HealthCheckResourceType {
+			continue
+		}
+		// Environments deployed before the health checks were supported can't describe them, so we skip them like target health.
+		status, err := s.healthCheckGetter.HealthCheckStatus(resource.PhysicalID)
+		if err != nil {
+			continue
+		}
+		checks = append(checks, *status)
+	}
+	sort.SliceStable(checks, func(i, j int) bool { return checks[i].Domain < checks[j].Domain })
+	return checks, nil
+}
+
 // Describe returns the status of an AppRunner service.
 func (a *appRunnerStatusDescriber) Describe() (HumanJSONStringer, error) {
 	svc, err := a.svcDescriber.Service()
@@ -258,12 +332,64 @@ func (a *appRunnerStatusDescriber) Describe() (HumanJSONStringer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get log events for log group %s: %w", logGroupName, err)
 	}
-	return &appRunnerServiceStatus{
-		Service:   *svc,
-		LogEvents: logEventsOutput.Events,
+	operations, err := a.operationsGetter.Operations(svc.ServiceARN, appRunnerOperationsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get operations of App Runner service %s: %w", svc.Name, err)
+	}
+	var deployments []apprunner.Operation
+	for _, op := range operations {
+		if op.IsDeployment() {
+			deployments = append(deployments, op)
+		}
+	}
+	return &AppRunnerServiceStatus{
+		Service:     *svc,
+		LogEvents:   logEventsOutput.Events,
+		Deployments: deployments,
+		Metrics:     a.metrics(svc),
 	}, nil
 }
 
+// metrics returns the number of instances and the requests of the App Runner service, or nil if they can't be retrieved.
+func (a *appRunnerStatusDescriber) metrics(svc *apprunner.Service) *appRunnerServiceMetrics {
+	dimensions := map[string]string{
+		"ServiceName": svc.Name,
+		"ServiceID":   svc.ID,
+	}
+	metric := func(id, name, stat string, period time.Duration) cloudwatch.Metric {
+		return cloudwatch.Metric{
+			ID:         id,
+			Namespace:  appRunnerMetricsNamespace,
+			Name:       name,
+			Dimensions: dimensions,
+			Stat:       stat,
+			Period:     period,
+		}
+	}
+	end := a.now()
+	values, err := a.metricsGetter.LatestMetricValues([]cloudwatch.Metric{
+		metric("instances", "ActiveInstances", "Maximum", appRunnerInstancesMetricPeriod),
+		metric("requests", "Requests", "Sum", appRunnerRequestMetricsPeriod),
+		metric("responses2xx", "2xxStatusResponses", "Sum", appRunnerRequestMetricsPeriod),
+		metric("responses4xx", "4xxStatusResponses", "Sum", appRunnerRequestMetricsPeriod),
+		metric("responses5xx", "5xxStatusResponses", "Sum", appRunnerRequestMetricsPeriod),
+		metric("latency", "RequestLatency", "p99", appRunnerRequestMetricsPeriod),
+	}, end.Add(-appRunnerRequestMetricsPeriod), end)
+	if err != nil {
+		// NOTE: swallow the error because the metrics are an optional part of the status of the service.
+		// Example error: when "EnvManagerRole" doesn't have the "cloudwatch:GetMetricData" permission.
+		return nil
+	}
+	return &appRunnerServiceMetrics{
+		ActiveInstances: int(values["instances"]),
+		Requests:        int(values["requests"]),
+		Responses2XX:    int(values["responses2xx"]),
+		Responses4XX:    int(values["responses4xx"]),
+		Responses5XX:    int(values["responses5xx"]),
+		P99LatencyMs:    values["latency"],
+	}
+}
+
 // Describe returns the status of a Static Site service.
 func (d *staticSiteStatusDescriber) Describe() (HumanJSONStringer, error) {
 	dataGetter, nameGetter, err := d.initS3Client(d.env)
@@ -278,7 +404,7 @@ func (d *staticSiteStatusDescriber) Describe() (HumanJSONStringer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get size and count data for %q S3 bucket: %w", bucketName, err)
 	}
-	return &staticSiteServiceStatus{
+	return &StaticSiteServiceStatus{
 		BucketName: bucketName,
 		Size:       size,
 		Count:      count,
@@ -303,14 +429,22 @@ func (s *ecsStatusDescriber) ecsServiceAutoscalingAlarms(cluster, service string
 	return alarms, nil
 }
 
-func (s *ecsStatusDescriber) ecsServiceRollbackAlarms(app, env, svc string) ([]cloudwatch.AlarmStatus, error) {
+// ecsServiceCopilotAlarms returns the rollback and anomaly detection alarms that Copilot created for the service.
+func (s *ecsStatusDescriber) ecsServiceCopilotAlarms(app, env, svc string) ([]cloudwatch.AlarmStatus, error) {
 	// This will not fetch imported alarms, as we filter by the Copilot-generated prefix of alarm names. This will also not fetch Copilot-generated alarms with names exceeding 255 characters, due to the balanced truncating of `TruncateAlarmName`.
-	alarms, err := s.cwSvcGetter.AlarmStatuses(cloudwatch.WithPrefix(fmt.Sprintf("%s-%s-%s-CopilotRollback", app, env, svc)))
+	prefix := fmt.Sprintf("%s-%s-%s-Copilot", app, env, svc)
+	alarms, err := s.cwSvcGetter.AlarmStatuses(cloudwatch.WithPrefix(prefix))
 	if err != nil {
 		return nil, fmt.Errorf("get Copilot-created CloudWatch alarms: %w", err)
 	}
 	for i := range alarms {
 		alarms[i].Type = rollbackAlarmType
+		if strings.HasPrefix(alarms[i].Name, prefix+"Anomaly") {
+			alarms[i].Type = anomalyAlarmType
+		}
+		if strings.HasPrefix(alarms[i].Name, prefix+"AliasHealthCheck") {
+			alarms[i].Type = aliasHealthCheckAlarmType
+		}
 	}
 	return alarms, nil
 }
@@ -17,7 +17,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -31,6 +33,10 @@ type serviceStatusDescriberMocks struct {
 	aas                   *mocks.MockautoscalingAlarmNamesGetter
 	logGetter             *mocks.MocklogGetter
 	targetHealthGetter    *mocks.MocktargetHealthGetter
+	stackResources        *mocks.MockstackResourcesGetter
+	healthCheckGetter     *mocks.MockhealthCheckStatusGetter
+	operationsGetter      *mocks.MockoperationsGetter
+	metricsGetter         *mocks.MockmetricValuesGetter
 	s3Client              *mocks.MockbucketNameGetter
 	bucketDataGetter      *mocks.MockbucketDataGetter
 }
@@ -58,7 +64,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 		setupMocks func(mocks serviceStatusDescriberMocks)
 
 		wantedError   error
-		wantedContent *ecsServiceStatus
+		wantedContent *ECSServiceStatus
 	}{
 		"errors if failed to describe a service": {
 			setupMocks: func(m serviceStatusDescriberMocks) {
@@ -210,10 +216,11 @@ func TestServiceStatus_Describe(t *testing.T) {
 					}).Return(nil, nil),
 					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
 					m.alarmStatusGetter.EXPECT().AlarmStatuses(gomock.Any()).Return(nil, nil),
+					m.stackResources.EXPECT().Resources().Return(nil, nil),
 				)
 			},
 
-			wantedContent: &ecsServiceStatus{
+			wantedContent: &ECSServiceStatus{
 				Service: awsecs.ServiceStatus{
 					DesiredCount: 1,
 					RunningCount: 1,
@@ -246,6 +253,87 @@ func TestServiceStatus_Describe(t *testing.T) {
 				},
 			},
 		},
+		"errors if failed to get the resources of the service": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatuses(gomock.Any()).Return(nil, nil),
+					m.stackResources.EXPECT().Resources().Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("retrieve resources of service mockSvc: some error"),
+		},
+		"retrieve the status of the alias health checks": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: mockCluster,
+						Name:        mockService,
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{
+						Deployments: []*ecsapi.Deployment{
+							{
+								UpdatedAt: aws.Time(startTime),
+							},
+						},
+					}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatuses(gomock.Any()).Return(nil, nil),
+					m.stackResources.EXPECT().Resources().Return([]*stack.Resource{
+						{Type: "AWS::ECS::Service", PhysicalID: "mockService"},
+						{Type: "AWS::Route53::HealthCheck", PhysicalID: "check-1"},
+						{Type: "AWS::Route53::HealthCheck", PhysicalID: "check-2"},
+						{Type: "AWS::Route53::HealthCheck", PhysicalID: "check-3"},
+					}, nil),
+					m.healthCheckGetter.EXPECT().HealthCheckStatus("check-1").Return(&route53.HealthCheckStatus{
+						ID:              "check-1",
+						Domain:          "www.example.com",
+						Healthy:         true,
+						HealthyCheckers: 16,
+						Checkers:        16,
+					}, nil),
+					m.healthCheckGetter.EXPECT().HealthCheckStatus("check-2").Return(nil, mockError),
+					m.healthCheckGetter.EXPECT().HealthCheckStatus("check-3").Return(&route53.HealthCheckStatus{
+						ID:                "check-3",
+						Domain:            "example.com",
+						Checkers:          16,
+						LastFailureReason: "Failure: Connection timed out.",
+					}, nil),
+				)
+			},
+
+			wantedContent: &ECSServiceStatus{
+				Service: awsecs.ServiceStatus{
+					Deployments: []awsecs.Deployment{
+						{
+							UpdatedAt: startTime,
+						},
+					},
+					LastDeploymentAt: startTime,
+				},
+				Alarms: []cloudwatch.AlarmStatus{},
+				AliasHealthChecks: []route53.HealthCheckStatus{
+					{
+						ID:                "check-3",
+						Domain:            "example.com",
+						Checkers:          16,
+						LastFailureReason: "Failure: Connection timed out.",
+					},
+					{
+						ID:              "check-1",
+						Domain:          "www.example.com",
+						Healthy:         true,
+						HealthyCheckers: 16,
+						Checkers:        16,
+					},
+				},
+			},
+		},
 		"do not error out if failed to get a service's target group health": {
 			setupMocks: func(m serviceStatusDescriberMocks) {
 				gomock.InOrder(
@@ -266,9 +354,10 @@ func TestServiceStatus_Describe(t *testing.T) {
 					m.aas.EXPECT().ECSServiceAlarmNames(gomock.Any(), gomock.Any()).Return([]string{}, nil),
 					m.alarmStatusGetter.EXPECT().AlarmStatuses(gomock.Any()).Return(nil, nil),
 					m.targetHealthGetter.EXPECT().TargetsHealth("group-1").Return(nil, errors.New("some error")),
+					m.stackResources.EXPECT().Resources().Return(nil, nil),
 				)
 			},
-			wantedContent: &ecsServiceStatus{
+			wantedContent: &ECSServiceStatus{
 				Service: awsecs.ServiceStatus{
 					Deployments: []awsecs.Deployment{
 						{
@@ -388,10 +477,11 @@ func TestServiceStatus_Describe(t *testing.T) {
 							},
 						},
 					}, nil),
+					m.stackResources.EXPECT().Resources().Return(nil, nil),
 				)
 			},
 
-			wantedContent: &ecsServiceStatus{
+			wantedContent: &ECSServiceStatus{
 				Service: awsecs.ServiceStatus{
 					DesiredCount: 1,
 					RunningCount: 1,
@@ -549,11 +639,20 @@ func TestServiceStatus_Describe(t *testing.T) {
 								Type:         "Metric",
 								UpdatedTimes: updateTime,
 							},
+							{
+								Arn:          "mockAlarmArn4",
+								Name:         "mockApp-mockEnv-mockSvc-CopilotAnomalyLatencyAlarm",
+								Condition:    "mockCondition",
+								Status:       "ALARM",
+								Type:         "Metric",
+								UpdatedTimes: updateTime,
+							},
 						}, nil),
+					m.stackResources.EXPECT().Resources().Return(nil, nil),
 				)
 			},
 
-			wantedContent: &ecsServiceStatus{
+			wantedContent: &ECSServiceStatus{
 				Service: awsecs.ServiceStatus{
 					DesiredCount: 1,
 					RunningCount: 1,
@@ -568,6 +667,14 @@ func TestServiceStatus_Describe(t *testing.T) {
 					TaskDefinition:   "mockTaskDefinition",
 				},
 				Alarms: []cloudwatch.AlarmStatus{
+					{
+						Arn:          "mockAlarmArn4",
+						Name:         "mockApp-mockEnv-mockSvc-CopilotAnomalyLatencyAlarm",
+						Condition:    "mockCondition",
+						Status:       "ALARM",
+						Type:         "Anomaly Detection",
+						UpdatedTimes: updateTime,
+					},
 					{
 						Arn:          "mockAlarmArn2",
 						Condition:    "mockCondition",
@@ -651,12 +758,16 @@ func TestServiceStatus_Describe(t *testing.T) {
 			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
 			mockaasClient := mocks.NewMockautoscalingAlarmNamesGetter(ctrl)
 			mockTargetHealthGetter := mocks.NewMocktargetHealthGetter(ctrl)
+			mockStackResources := mocks.NewMockstackResourcesGetter(ctrl)
+			mockHealthCheckGetter := mocks.NewMockhealthCheckStatusGetter(ctrl)
 			mocks := serviceStatusDescriberMocks{
 				ecsServiceGetter:   mockecsSvc,
 				alarmStatusGetter:  mockcwSvc,
 				serviceDescriber:   mockSvcDescriber,
 				aas:                mockaasClient,
 				targetHealthGetter: mockTargetHealthGetter,
+				stackResources:     mockStackResources,
+				healthCheckGetter:  mockHealthCheckGetter,
 			}
 
 			tc.setupMocks(mocks)
@@ -670,6 +781,8 @@ func TestServiceStatus_Describe(t *testing.T) {
 				svcDescriber:       mockSvcDescriber,
 				aasSvcGetter:       mockaasClient,
 				targetHealthGetter: mockTargetHealthGetter,
+				stackResources:     mockStackResources,
+				healthCheckGetter:  mockHealthCheckGetter,
 			}
 
 			// WHEN
@@ -699,6 +812,13 @@ func TestAppRunnerStatusDescriber_Describe(t *testing.T) {
 		Status:      "RUNNING",
 		DateUpdated: updateTime,
 	}
+	now := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	deployment := apprunner.Operation{
+		ID:        "op2",
+		Type:      "START_DEPLOYMENT",
+		Status:    "SUCCEEDED",
+		StartedAt: now.Add(-10 * time.Minute),
+	}
 	logEvents := []*cloudwatchlogs.Event{
 		{
 			LogStreamName: "events",
@@ -707,10 +827,10 @@ func TestAppRunnerStatusDescriber_Describe(t *testing.T) {
 	}
 	testCases := map[string]struct {
 		setupMocks func(mocks serviceStatusDescriberMocks)
-		desc       *appRunnerServiceStatus
+		desc       *AppRunnerServiceStatus
 
 		wantedError   error
-		wantedContent *appRunnerServiceStatus
+		wantedContent *AppRunnerServiceStatus
 	}{
 		"errors if failed to describe a service": {
 			setupMocks: func(m serviceStatusDescriberMocks) {
@@ -721,16 +841,80 @@ func TestAppRunnerStatusDescriber_Describe(t *testing.T) {
 
 			wantedError: fmt.Errorf("get App Runner service description for App Runner service frontend in environment test: some error"),
 		},
+		"errors if failed to list the operations of the service": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				m.appRunnerSvcDescriber.EXPECT().Service().Return(&mockAppRunnerService, nil)
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+				m.operationsGetter.EXPECT().Operations(mockAppRunnerService.ServiceARN, 10).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get operations of App Runner service testapp-test-frontend: some error"),
+		},
 		"success": {
 			setupMocks: func(m serviceStatusDescriberMocks) {
 				m.appRunnerSvcDescriber.EXPECT().Service().Return(&mockAppRunnerService, nil)
 				m.logGetter.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{LogGroup: "/aws/apprunner/testapp-test-frontend/fc1098ac269245959ba78fd58bdd4bf/service", Limit: aws.Int64(10)}).Return(&cloudwatchlogs.LogEventsOutput{
 					Events: logEvents,
 				}, nil)
+				m.operationsGetter.EXPECT().Operations(mockAppRunnerService.ServiceARN, 10).Return([]apprunner.Operation{
+					deployment,
+					{
+						ID:        "op1",
+						Type:      "PAUSE_SERVICE",
+						Status:    "SUCCEEDED",
+						StartedAt: now.Add(-time.Hour),
+					},
+				}, nil)
+				m.metricsGetter.EXPECT().LatestMetricValues(gomock.Any(), now.Add(-time.Hour), now).DoAndReturn(func(metrics []cloudwatch.Metric, _, _ time.Time) (map[string]float64, error) {
+					require.Len(t, metrics, 6)
+					require.Equal(t, cloudwatch.Metric{
+						ID:        "instances",
+						Namespace: "AWS/AppRunner",
+						Name:      "ActiveInstances",
+						Dimensions: map[string]string{
+							"ServiceName": "testapp-test-frontend",
+							"ServiceID":   "fc1098ac269245959ba78fd58bdd4bf",
+						},
+						Stat:   "Maximum",
+						Period: 5 * time.Minute,
+					}, metrics[0])
+					return map[string]float64{
+						"instances":    2,
+						"requests":     120,
+						"responses2xx": 110,
+						"responses4xx": 8,
+						"responses5xx": 2,
+						"latency":      35.5,
+					}, nil
+				})
+			},
+			wantedContent: &AppRunnerServiceStatus{
+				Service:     mockAppRunnerService,
+				LogEvents:   logEvents,
+				Deployments: []apprunner.Operation{deployment},
+				Metrics: &appRunnerServiceMetrics{
+					ActiveInstances: 2,
+					Requests:        120,
+					Responses2XX:    110,
+					Responses4XX:    8,
+					Responses5XX:    2,
+					P99LatencyMs:    35.5,
+				},
+			},
+		},
+		"omit the metrics if they can't be retrieved": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				m.appRunnerSvcDescriber.EXPECT().Service().Return(&mockAppRunnerService, nil)
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: logEvents,
+				}, nil)
+				m.operationsGetter.EXPECT().Operations(mockAppRunnerService.ServiceARN, 10).Return([]apprunner.Operation{deployment}, nil)
+				m.metricsGetter.EXPECT().LatestMetricValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mockError)
 			},
-			wantedContent: &appRunnerServiceStatus{
-				Service:   mockAppRunnerService,
-				LogEvents: logEvents,
+			wantedContent: &AppRunnerServiceStatus{
+				Service:     mockAppRunnerService,
+				LogEvents:   logEvents,
+				Deployments: []apprunner.Operation{deployment},
 			},
 		},
 	}
@@ -742,18 +926,25 @@ func TestAppRunnerStatusDescriber_Describe(t *testing.T) {
 
 			mockSvcDesc := mocks.NewMockapprunnerDescriber(ctrl)
 			mockLogsSvc := mocks.NewMocklogGetter(ctrl)
+			mockOperations := mocks.NewMockoperationsGetter(ctrl)
+			mockMetrics := mocks.NewMockmetricValuesGetter(ctrl)
 			mocks := serviceStatusDescriberMocks{
 				appRunnerSvcDescriber: mockSvcDesc,
 				logGetter:             mockLogsSvc,
+				operationsGetter:      mockOperations,
+				metricsGetter:         mockMetrics,
 			}
 			tc.setupMocks(mocks)
 
 			svcStatus := &appRunnerStatusDescriber{
-				app:          appName,
-				env:          envName,
-				svc:          svcName,
-				svcDescriber: mockSvcDesc,
-				eventsGetter: mockLogsSvc,
+				app:              appName,
+				env:              envName,
+				svc:              svcName,
+				svcDescriber:     mockSvcDesc,
+				eventsGetter:     mockLogsSvc,
+				operationsGetter: mockOperations,
+				metricsGetter:    mockMetrics,
+				now:              func() time.Time { return now },
 			}
 
 			statusDesc, err := svcStatus.Describe()
@@ -777,17 +968,17 @@ func TestStaticSiteStatusDescriber_Describe(t *testing.T) {
 
 	testCases := map[string]struct {
 		setupMocks func(mocks serviceStatusDescriberMocks)
-		desc       *staticSiteServiceStatus
+		desc       *StaticSiteServiceStatus
 
 		wantedError   error
-		wantedContent *staticSiteServiceStatus
+		wantedContent *StaticSiteServiceStatus
 	}{
 		"success": {
 			setupMocks: func(m serviceStatusDescriberMocks) {
 				m.s3Client.EXPECT().BucketName(appName, envName, svcName).Return(mockBucket, nil)
 				m.bucketDataGetter.EXPECT().BucketSizeAndCount(mockBucket).Return("mockSize", 123, nil)
 			},
-			wantedContent: &staticSiteServiceStatus{
+			wantedContent: &StaticSiteServiceStatus{
 				BucketName: mockBucket,
 				Size:       "mockSize",
 				Count:      123,
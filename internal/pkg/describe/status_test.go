@@ -12,6 +12,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 
 	"github.com/dustin/go-humanize"
@@ -34,13 +35,13 @@ func TestServiceStatusDesc_String(t *testing.T) {
 	stoppedTime, _ := time.Parse(time.RFC3339, "2020-03-13T20:00:30+00:00")
 
 	testCases := map[string]struct {
-		desc                 *ecsServiceStatus
+		desc                 *ECSServiceStatus
 		setUpMockBarRenderer func(length int, data []int, representations []string, emptyRepresentation string) (progress.Renderer, error)
 		human                string
 		json                 string
 	}{
 		"while provisioning (some primary, some active)": {
-			desc: &ecsServiceStatus{
+			desc: &ECSServiceStatus{
 				Service: awsecs.ServiceStatus{
 					DesiredCount: 10,
 					RunningCount: 3,
@@ -143,7 +144,7 @@ Alarms
 `,
 		},
 		"while running with both health check (all primary)": {
-			desc: &ecsServiceStatus{
+			desc: &ECSServiceStatus{
 				Service: awsecs.ServiceStatus{
 					DesiredCount: 3,
 					RunningCount: 3,
@@ -231,7 +232,7 @@ Tasks
 `,
 		},
 		"while some tasks are stopping": {
-			desc: &ecsServiceStatus{
+			desc: &ECSServiceStatus{
 				Service: awsecs.ServiceStatus{
 					DesiredCount: 5,
 					RunningCount: 3,
@@ -335,7 +336,7 @@ Tasks
 `,
 		},
 		"while running without health check": {
-			desc: &ecsServiceStatus{
+			desc: &ECSServiceStatus{
 				Service: awsecs.ServiceStatus{
 					DesiredCount: 3,
 					RunningCount: 2,
@@ -369,7 +370,7 @@ Tasks
 `,
 		},
 		"should hide HTTP health from summary if no primary task has HTTP check": {
-			desc: &ecsServiceStatus{
+			desc: &ECSServiceStatus{
 				Service: awsecs.ServiceStatus{
 					DesiredCount: 10,
 					RunningCount: 3,
@@ -458,7 +459,7 @@ Tasks
 `,
 		},
 		"while running with capacity providers": {
-			desc: &ecsServiceStatus{
+			desc: &ECSServiceStatus{
 				Service: awsecs.ServiceStatus{
 					DesiredCount: 4,
 					RunningCount: 3,
@@ -513,7 +514,7 @@ Tasks
 `,
 		},
 		"hide tasks section if there is no desired running task": {
-			desc: &ecsServiceStatus{
+			desc: &ECSServiceStatus{
 				Service: awsecs.ServiceStatus{
 					DesiredCount: 0,
 					RunningCount: 0,
@@ -535,6 +536,94 @@ Tasks
   Running   ░░░░░░░░░░  0/0 desired tasks are running
 `,
 			json: `{"Service":{"desiredCount":0,"runningCount":0,"status":"ACTIVE","deployments":[{"id":"id-4","desiredCount":0,"runningCount":0,"updatedAt":"0001-01-01T00:00:00Z","launchType":"","taskDefinition":"arn:aws:ecs:us-east-1:000000000000:task-definition/some-task-def:6","status":"PRIMARY"}],"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":[],"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null}
+`,
+		},
+		"show unusual behavior if an anomaly detection alarm is in alarm": {
+			desc: &ECSServiceStatus{
+				Service: awsecs.ServiceStatus{
+					DesiredCount: 0,
+					RunningCount: 0,
+					Status:       "ACTIVE",
+				},
+				Alarms: []cloudwatch.AlarmStatus{
+					{
+						Arn:          "mockAlarmArn1",
+						Name:         "app-env-svc-CopilotAnomalyErrorsAlarm",
+						Condition:    "HTTPCode_Target_5XX_Count > the expected band for 3 datapoints within 5 minutes",
+						Status:       "OK",
+						Type:         "Anomaly Detection",
+						UpdatedTimes: updateTime,
+					},
+					{
+						Arn:          "mockAlarmArn2",
+						Name:         "app-env-svc-CopilotAnomalyLatencyAlarm",
+						Condition:    "TargetResponseTime > the expected band for 3 datapoints within 5 minutes",
+						Status:       "ALARM",
+						Type:         "Anomaly Detection",
+						UpdatedTimes: updateTime,
+					},
+				},
+			},
+			human: `Task Summary
+
+  Running   ░░░░░░░░░░  0/0 desired tasks are running
+
+Unusual Behavior
+
+  TargetResponseTime > the expected band for 3 datapoints within 5 minutes  2 months from now
+
+Alarms
+
+  Name                            Type               Condition                       Last Updated       Health
+  ----                            ----               ---------                       ------------       ------
+  app-env-svc-CopilotAnomalyErro  Anomaly Detection  HTTPCode_Target_5XX_Count > th  2 months from now  OK
+  rsAlarm                                            e expected band for 3 datapoin                     
+                                                     ts within 5 minutes                                
+                                                                                                        
+  app-env-svc-CopilotAnomalyLate  Anomaly Detection  TargetResponseTime > the expec  2 months from now  ALARM
+  ncyAlarm                                           ted band for 3 datapoints with                     
+                                                     in 5 minutes                                       
+                                                                                                        
+`,
+			json: `{"Service":{"desiredCount":0,"runningCount":0,"status":"ACTIVE","deployments":null,"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":null,"alarms":[{"arn":"mockAlarmArn1","name":"app-env-svc-CopilotAnomalyErrorsAlarm","condition":"HTTPCode_Target_5XX_Count \u003e the expected band for 3 datapoints within 5 minutes","status":"OK","type":"Anomaly Detection","updatedTimes":"2020-03-13T19:50:30Z"},{"arn":"mockAlarmArn2","name":"app-env-svc-CopilotAnomalyLatencyAlarm","condition":"TargetResponseTime \u003e the expected band for 3 datapoints within 5 minutes","status":"ALARM","type":"Anomaly Detection","updatedTimes":"2020-03-13T19:50:30Z"}],"stoppedTasks":null,"targetHealthDescriptions":null}
+`,
+		},
+		"show the alias health checks": {
+			desc: &ECSServiceStatus{
+				Service: awsecs.ServiceStatus{
+					DesiredCount: 0,
+					RunningCount: 0,
+					Status:       "ACTIVE",
+				},
+				AliasHealthChecks: []route53.HealthCheckStatus{
+					{
+						ID:              "mockID1",
+						Domain:          "example.com",
+						Healthy:         true,
+						HealthyCheckers: 16,
+						Checkers:        16,
+					},
+					{
+						ID:                "mockID2",
+						Domain:            "v1.example.com",
+						HealthyCheckers:   0,
+						Checkers:          16,
+						LastFailureReason: "Failure: HTTP Status Code 503",
+					},
+				},
+			},
+			human: `Task Summary
+
+  Running   ░░░░░░░░░░  0/0 desired tasks are running
+
+Alias Health Checks
+
+  Alias           Healthy Checkers  Last Failure                   Health
+  -----           ----------------  ------------                   ------
+  example.com     16/16             -                              HEALTHY
+  v1.example.com  0/16              Failure: HTTP Status Code 503  UNHEALTHY
+`,
+			json: `{"Service":{"desiredCount":0,"runningCount":0,"status":"ACTIVE","deployments":null,"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":null,"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null,"aliasHealthChecks":[{"id":"mockID1","domain":"example.com","healthy":true,"healthyCheckers":16,"checkers":16},{"id":"mockID2","domain":"v1.example.com","healthy":false,"healthyCheckers":0,"checkers":16,"lastFailureReason":"Failure: HTTP Status Code 503"}]}
 `,
 		},
 	}
@@ -573,12 +662,12 @@ func TestServiceStatusDesc_AppRunnerServiceString(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		desc  *appRunnerServiceStatus
+		desc  *AppRunnerServiceStatus
 		human string
 		json  string
 	}{
 		"RUNNING": {
-			desc: &appRunnerServiceStatus{
+			desc: &AppRunnerServiceStatus{
 				Service: apprunner.Service{
 					Name:        "frontend",
 					ID:          "8a2b343f658144d885e47d10adb4845e",
@@ -606,6 +695,77 @@ System Logs
 `,
 			json: `{"arn":"arn:aws:apprunner:us-east-1:1111:service/frontend/8a2b343f658144d885e47d10adb4845e","status":"RUNNING","createdAt":"2020-01-01T00:00:00Z","updatedAt":"2020-03-01T00:00:00Z","source":{"imageId":"hello"}}` + "\n",
 		},
+		"with metrics and deployments": {
+			desc: &AppRunnerServiceStatus{
+				Service: apprunner.Service{
+					Name:        "frontend",
+					ID:          "8a2b343f658144d885e47d10adb4845e",
+					ServiceARN:  "arn:aws:apprunner:us-east-1:1111:service/frontend/8a2b343f658144d885e47d10adb4845e",
+					Status:      "RUNNING",
+					DateCreated: createTime,
+					DateUpdated: updateTime,
+					ImageID:     "hello",
+				},
+				LogEvents: logEvents,
+				Deployments: []apprunner.Operation{
+					{
+						ID:        "op2",
+						Type:      "START_DEPLOYMENT",
+						Status:    "IN_PROGRESS",
+						StartedAt: updateTime,
+					},
+					{
+						ID:        "op1",
+						Type:      "CREATE_SERVICE",
+						Status:    "SUCCEEDED",
+						StartedAt: createTime,
+						EndedAt:   &updateTime,
+					},
+				},
+				Metrics: &appRunnerServiceMetrics{
+					ActiveInstances: 2,
+					Requests:        120,
+					Responses2XX:    110,
+					Responses4XX:    8,
+					Responses5XX:    2,
+					P99LatencyMs:    35.5,
+				},
+			},
+			human: `Service Status
+
+ Status RUNNING 
+
+Last deployment
+
+  Updated At  2 months ago
+  Service ID  frontend/8a2b343f658144d885e47d10adb4845e
+  Source      hello
+
+Instances
+
+  Active  2
+
+Requests (last hour)
+
+  Total        120
+  2XX          110
+  4XX          8
+  5XX          2
+  P99 Latency  36ms
+
+Deployments
+
+  Operation ID  Type              Status       Started       Ended
+  ------------  ----              ------       -------       -----
+  op2           START_DEPLOYMENT  IN_PROGRESS  2 months ago  -
+  op1           CREATE_SERVICE    SUCCEEDED    now           2 months ago
+
+System Logs
+
+  2021-05-18T19:26:25Z  [AppRunner] Service creation started.
+`,
+			json: `{"arn":"arn:aws:apprunner:us-east-1:1111:service/frontend/8a2b343f658144d885e47d10adb4845e","status":"RUNNING","createdAt":"2020-01-01T00:00:00Z","updatedAt":"2020-03-01T00:00:00Z","source":{"imageId":"hello"},"deployments":[{"id":"op2","type":"START_DEPLOYMENT","status":"IN_PROGRESS","startedAt":"2020-03-01T00:00:00Z"},{"id":"op1","type":"CREATE_SERVICE","status":"SUCCEEDED","startedAt":"2020-01-01T00:00:00Z","endedAt":"2020-03-01T00:00:00Z"}],"metrics":{"activeInstances":2,"requests":120,"2xxResponses":110,"4xxResponses":8,"5xxResponses":2,"p99LatencyMs":35.5}}` + "\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -620,12 +780,12 @@ System Logs
 
 func TestServiceStatusDesc_StaticSiteServiceString(t *testing.T) {
 	testCases := map[string]struct {
-		desc  *staticSiteServiceStatus
+		desc  *StaticSiteServiceStatus
 		human string
 		json  string
 	}{
 		"success": {
-			desc: &staticSiteServiceStatus{
+			desc: &StaticSiteServiceStatus{
 				BucketName: "Jimmy Buckets",
 				Size:       "999 MB",
 				Count:      22,
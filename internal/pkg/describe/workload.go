@@ -8,7 +8,6 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/version"
@@ -36,6 +35,8 @@ type NewWorkloadConfig struct {
 	Env         string
 	Name        string
 	ConfigStore ConfigStoreSvc
+
+	SessionProvider SessionProvider // Optional. Defaults to the sessions shared by the process.
 }
 
 // NewWorkloadStackDescriber instantiates the core elements of a new workload.
@@ -44,7 +45,7 @@ func NewWorkloadStackDescriber(opt NewWorkloadConfig) (*WorkloadStackDescriber,
 	if err != nil {
 		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
 	}
-	sess, err := sessions.ImmutableProvider().FromRole(environment.ManagerRoleARN, environment.Region)
+	sess, err := sessionsOrDefault(opt.SessionProvider).FromRole(environment.ManagerRoleARN, environment.Region)
 	if err != nil {
 		return nil, err
 	}
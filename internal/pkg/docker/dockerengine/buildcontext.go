@@ -0,0 +1,344 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerengine
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// minLargeMediaFileSize is the size above which media and archive files are likely unintended in a build context.
+const minLargeMediaFileSize = 10 << 20 // 10 MiB.
+
+const dockerignoreFileName = ".dockerignore"
+
+// unintendedDirs are directories that are rarely meant to be sent to the docker daemon, and the reason why.
+var unintendedDirs = map[string]string{
+	".git":         "git history",
+	".terraform":   "Terraform providers and modules",
+	".venv":        "Python virtual environment",
+	"__pycache__":  "Python bytecode cache",
+	"node_modules": "installed Node.js dependencies",
+}
+
+// largeMediaExts are extensions of files that bloat build contexts when they're large.
+var largeMediaExts = map[string]bool{
+	".avi": true, ".dmg": true, ".gz": true, ".iso": true, ".mkv": true, ".mov": true, ".mp3": true,
+	".mp4": true, ".psd": true, ".tar": true, ".tgz": true, ".wav": true, ".webm": true, ".zip": true,
+}
+
+// BuildContext is the summary of the files sent to the docker daemon when building an image.
+type BuildContext struct {
+	Dir              string // Directory of the build context.
+	DockerignorePath string // Path to the .dockerignore file that applies to the build, whether it exists or not.
+	Size             int64  // Total size in bytes of the files that aren't excluded by the .dockerignore file.
+	Files            int    // Number of files that aren't excluded by the .dockerignore file.
+
+	// Suggestions are entries to add to the .dockerignore file, sorted by decreasing size.
+	Suggestions []DockerignoreSuggestion
+}
+
+// DockerignoreSuggestion is a .dockerignore entry that would exclude files that are likely unintended in the build context.
+type DockerignoreSuggestion struct {
+	Pattern string
+	Reason  string
+	Size    int64
+}
+
+// AnalyzeBuildContext measures the files in the build context directory that would be sent to the docker daemon,
+// and suggests .dockerignore entries for the ones that are likely unintended.
+func (c DockerCmdClient) AnalyzeBuildContext(contextDir, dockerfile string) (*BuildContext, error) {
+	ignorePath, patterns, err := readDockerignore(contextDir, dockerfile)
+	if err != nil {
+		return nil, err
+	}
+	bc := &BuildContext{
+		Dir:              contextDir,
+		DockerignorePath: ignorePath,
+	}
+	suggestions := make(map[string]*DockerignoreSuggestion)
+	var flaggedDirs []string
+	err = filepath.WalkDir(contextDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(contextDir, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if patterns.excludes(rel) {
+			if d.IsDir() && !patterns.hasExceptions {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		flaggedDir := enclosingDir(flaggedDirs, rel)
+		if d.IsDir() {
+			reason, ok := unintendedDirs[d.Name()]
+			if ok && flaggedDir == "" {
+				flaggedDirs = append(flaggedDirs, rel)
+				pattern := d.Name()
+				if rel != d.Name() {
+					pattern = "**/" + d.Name()
+				}
+				if _, ok := suggestions[pattern]; !ok {
+					suggestions[pattern] = &DockerignoreSuggestion{Pattern: pattern, Reason: reason}
+				}
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		bc.Size += info.Size()
+		bc.Files++
+		switch {
+		case flaggedDir != "":
+			suggestions[dirPattern(flaggedDir)].Size += info.Size()
+		case info.Size() >= minLargeMediaFileSize && largeMediaExts[strings.ToLower(path.Ext(rel))]:
+			pattern := "**/*" + strings.ToLower(path.Ext(rel))
+			if _, ok := suggestions[pattern]; !ok {
+				suggestions[pattern] = &DockerignoreSuggestion{Pattern: pattern, Reason: "large media and archive files"}
+			}
+			suggestions[pattern].Size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk build context %s: %w", contextDir, err)
+	}
+	for _, suggestion := range suggestions {
+		bc.Suggestions = append(bc.Suggestions, *suggestion)
+	}
+	sort.Slice(bc.Suggestions, func(i, j int) bool {
+		if bc.Suggestions[i].Size != bc.Suggestions[j].Size {
+			return bc.Suggestions[i].Size > bc.Suggestions[j].Size
+		}
+		return bc.Suggestions[i].Pattern < bc.Suggestions[j].Pattern
+	})
+	return bc, nil
+}
+
+// BuildContextDigest returns a digest of the paths, sizes, and modification times of the files in the build context
+// directory that aren't excluded by the .dockerignore file, and of the Dockerfile and the .dockerignore file themselves.
+// The digest changes whenever a file that the image is built from is added, removed, or modified.
+// The ".git" directory is skipped, since git operations modify it without changing the source code.
+func (c DockerCmdClient) BuildContextDigest(contextDir, dockerfile string) (string, error) {
+	ignorePath, patterns, err := readDockerignore(contextDir, dockerfile)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	for _, p := range []string{dockerfile, ignorePath} {
+		if p == "" {
+			continue
+		}
+		info, err := os.Stat(p)
+		if errors.Is(err, fs.ErrNotExist) {
+			fmt.Fprintf(h, "%s\x00\n", p)
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("stat %s: %w", p, err)
+		}
+		fmt.Fprintf(h, "%s\x00%d\x00%d\n", p, info.Size(), info.ModTime().UnixNano())
+	}
+	err = filepath.WalkDir(contextDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(contextDir, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if d.IsDir() && d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if patterns.excludes(rel) {
+			if d.IsDir() && !patterns.hasExceptions {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s\x00%d\x00%d\n", rel, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("walk build context %s: %w", contextDir, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// enclosingDir returns the directory in dirs that contains the slash-separated path, or an empty string.
+func enclosingDir(dirs []string, p string) string {
+	for _, dir := range dirs {
+		if strings.HasPrefix(p, dir+"/") {
+			return dir
+		}
+	}
+	return ""
+}
+
+func dirPattern(dir string) string {
+	if !strings.Contains(dir, "/") {
+		return dir
+	}
+	return "**/" + path.Base(dir)
+}
+
+// readDockerignore returns the path of the .dockerignore file that applies to the build and its patterns.
+// Like BuildKit, a "<Dockerfile>.dockerignore" file next to the Dockerfile takes precedence over
+// the .dockerignore file at the root of the build context.
+func readDockerignore(contextDir, dockerfile string) (string, *dockerignorePatterns, error) {
+	candidates := []string{filepath.Join(contextDir, dockerignoreFileName)}
+	if dockerfile != "" {
+		candidates = append([]string{dockerfile + dockerignoreFileName}, candidates...)
+	}
+	for _, candidate := range candidates {
+		content, err := os.ReadFile(candidate)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", nil, fmt.Errorf("read %s: %w", candidate, err)
+		}
+		patterns, err := parseDockerignore(content)
+		if err != nil {
+			return "", nil, fmt.Errorf("parse %s: %w", candidate, err)
+		}
+		return candidate, patterns, nil
+	}
+	return candidates[len(candidates)-1], &dockerignorePatterns{}, nil
+}
+
+type dockerignorePattern struct {
+	re        *regexp.Regexp
+	exception bool
+}
+
+// dockerignorePatterns are the patterns of a .dockerignore file, in order.
+type dockerignorePatterns struct {
+	patterns      []dockerignorePattern
+	hasExceptions bool
+}
+
+func parseDockerignore(content []byte) (*dockerignorePatterns, error) {
+	out := &dockerignorePatterns{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		exception := strings.HasPrefix(line, "!")
+		line = strings.TrimSpace(strings.TrimPrefix(line, "!"))
+		line = strings.TrimPrefix(path.Clean(filepath.ToSlash(line)), "/")
+		if line == "." {
+			continue
+		}
+		re, err := dockerignoreRegexp(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", line, err)
+		}
+		out.patterns = append(out.patterns, dockerignorePattern{re: re, exception: exception})
+		out.hasExceptions = out.hasExceptions || exception
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// excludes returns true if the slash-separated path relative to the build context is excluded.
+// A pattern matches a path if it matches the path itself or one of its parent directories, and the last
+// matching pattern wins.
+func (p *dockerignorePatterns) excludes(rel string) bool {
+	var excluded bool
+	for _, pattern := range p.patterns {
+		if pattern.matches(rel) {
+			excluded = !pattern.exception
+		}
+	}
+	return excluded
+}
+
+func (p dockerignorePattern) matches(rel string) bool {
+	for candidate := rel; ; candidate = path.Dir(candidate) {
+		if p.re.MatchString(candidate) {
+			return true
+		}
+		if !strings.Contains(candidate, "/") {
+			return false
+		}
+	}
+}
+
+// dockerignoreRegexp converts a .dockerignore pattern to a regular expression, following the rules of
+// https://docs.docker.com/engine/reference/builder/#dockerignore-file.
+func dockerignoreRegexp(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		ch := pattern[i]
+		switch {
+		case ch == '*' && i+1 < len(pattern) && pattern[i+1] == '*':
+			i++
+			// "**/" matches any number of directories, including none.
+			if i+1 < len(pattern) && pattern[i+1] == '/' {
+				i++
+				sb.WriteString("(.*/)?")
+			} else {
+				sb.WriteString(".*")
+			}
+		case ch == '*':
+			sb.WriteString("[^/]*")
+		case ch == '?':
+			sb.WriteString("[^/]")
+		case ch == '[':
+			end := strings.IndexByte(pattern[i:], ']')
+			if end == -1 {
+				return nil, errors.New("unterminated character class")
+			}
+			sb.WriteString(pattern[i : i+end+1])
+			i += end
+		case ch == '\\' && i+1 < len(pattern):
+			i++
+			sb.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
@@ -0,0 +1,222 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerengine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDockerCommand_AnalyzeBuildContext(t *testing.T) {
+	testCases := map[string]struct {
+		inFiles      map[string]int // Path relative to the context directory to the file size.
+		inDockerfile string
+
+		wantedIgnoreFile  string
+		wantedSize        int64
+		wantedFiles       int
+		wantedSuggestions []DockerignoreSuggestion
+	}{
+		"suggests entries for unintended directories and large media files": {
+			inFiles: map[string]int{
+				"Dockerfile":                     10,
+				"main.go":                        100,
+				".git/HEAD":                      20,
+				".git/objects/pack/a.pack":       2000,
+				"node_modules/left-pad/index.js": 300,
+				"node_modules/left-pad/node_modules/a.js": 50,
+				"web/node_modules/react/index.js":         400,
+				"assets/intro.MP4":                        minLargeMediaFileSize,
+				"assets/logo.png":                         minLargeMediaFileSize,
+				"assets/small.mp4":                        1,
+			},
+			inDockerfile:     "Dockerfile",
+			wantedIgnoreFile: ".dockerignore",
+			wantedSize:       10 + 100 + 20 + 2000 + 300 + 50 + 400 + 2*minLargeMediaFileSize + 1,
+			wantedFiles:      10,
+			wantedSuggestions: []DockerignoreSuggestion{
+				{Pattern: "**/*.mp4", Reason: "large media and archive files", Size: minLargeMediaFileSize},
+				{Pattern: ".git", Reason: "git history", Size: 2020},
+				{Pattern: "**/node_modules", Reason: "installed Node.js dependencies", Size: 400},
+				{Pattern: "node_modules", Reason: "installed Node.js dependencies", Size: 350},
+			},
+		},
+		"honors the .dockerignore file": {
+			inFiles: map[string]int{
+				".dockerignore":                    0,
+				"Dockerfile":                       10,
+				"main.go":                          100,
+				"web/node_modules/react/a.js":      400,
+				"docs/README.md":                   30,
+				"docs/keep.md":                     40,
+				"__pycache__/main.cpython-311.pyc": 70,
+			},
+			inDockerfile:     "Dockerfile",
+			wantedIgnoreFile: ".dockerignore",
+			wantedSize:       10 + 100 + 40,
+			wantedFiles:      3,
+		},
+		"prefers the Dockerfile-specific ignore file": {
+			inFiles: map[string]int{
+				".dockerignore":                 0,
+				"build/Dockerfile":              10,
+				"build/Dockerfile.dockerignore": 0,
+				"main.go":                       100,
+			},
+			inDockerfile:     "build/Dockerfile",
+			wantedIgnoreFile: "build/Dockerfile.dockerignore",
+			wantedSize:       100,
+			wantedFiles:      1,
+		},
+	}
+
+	ignoreFiles := map[string]string{
+		".dockerignore": `# Dependencies are installed in the image.
+**/node_modules
+/docs
+!docs/keep.md
+__pycache__
+.dockerignore`,
+		"build/Dockerfile.dockerignore": `build
+.dockerignore`,
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			dir := t.TempDir()
+			for file, size := range tc.inFiles {
+				p := filepath.Join(dir, filepath.FromSlash(file))
+				require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+				content := make([]byte, size)
+				if size == 0 {
+					content = []byte(ignoreFiles[file])
+				}
+				require.NoError(t, os.WriteFile(p, content, 0644))
+			}
+			s := DockerCmdClient{}
+
+			// WHEN
+			got, err := s.AnalyzeBuildContext(dir, filepath.Join(dir, filepath.FromSlash(tc.inDockerfile)))
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, &BuildContext{
+				Dir:              dir,
+				DockerignorePath: filepath.Join(dir, filepath.FromSlash(tc.wantedIgnoreFile)),
+				Size:             tc.wantedSize,
+				Files:            tc.wantedFiles,
+				Suggestions:      tc.wantedSuggestions,
+			}, got)
+		})
+	}
+}
+
+func TestDockerCommand_BuildContextDigest(t *testing.T) {
+	testCases := map[string]struct {
+		change func(dir string) error
+
+		wantedChanged bool
+	}{
+		"unchanged if nothing changes": {
+			change: func(dir string) error {
+				return nil
+			},
+		},
+		"unchanged if an ignored file changes": {
+			change: func(dir string) error {
+				return os.WriteFile(filepath.Join(dir, "docs", "README.md"), []byte("# Docs\n"), 0644)
+			},
+		},
+		"unchanged if the git directory changes": {
+			change: func(dir string) error {
+				return os.WriteFile(filepath.Join(dir, ".git", "index"), []byte("index"), 0644)
+			},
+		},
+		"changed if a source file is modified": {
+			change: func(dir string) error {
+				return os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0644)
+			},
+			wantedChanged: true,
+		},
+		"changed if a source file is removed": {
+			change: func(dir string) error {
+				return os.Remove(filepath.Join(dir, "main.go"))
+			},
+			wantedChanged: true,
+		},
+		"changed if the Dockerfile outside of the context is modified": {
+			change: func(dir string) error {
+				return os.WriteFile(filepath.Join(filepath.Dir(dir), "Dockerfile"), []byte("FROM scratch\nUSER 1000\n"), 0644)
+			},
+			wantedChanged: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			root := t.TempDir()
+			dir := filepath.Join(root, "src")
+			files := map[string]string{
+				"Dockerfile":            "FROM scratch\n",
+				"src/.dockerignore":     "docs\n",
+				"src/main.go":           "package main\n",
+				"src/docs/README.md":    "# README\n",
+				"src/.git/HEAD":         "ref: refs/heads/main\n",
+				"src/internal/pkg/a.go": "package pkg\n",
+			}
+			for file, content := range files {
+				p := filepath.Join(root, filepath.FromSlash(file))
+				require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+				require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+			}
+			s := DockerCmdClient{}
+			before, err := s.BuildContextDigest(dir, filepath.Join(root, "Dockerfile"))
+			require.NoError(t, err)
+
+			// WHEN
+			require.NoError(t, tc.change(dir))
+			after, err := s.BuildContextDigest(dir, filepath.Join(root, "Dockerfile"))
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedChanged, before != after)
+		})
+	}
+}
+
+func TestDockerignorePatterns_excludes(t *testing.T) {
+	patterns, err := parseDockerignore([]byte(`*.md
+!README*.md
+README-secret.md
+temp?
+**/*.log
+src/**/generated
+[ab].txt`))
+	require.NoError(t, err)
+
+	testCases := map[string]bool{
+		"CHANGELOG.md":          true,
+		"docs/CHANGELOG.md":     false,
+		"README.md":             false,
+		"README-secret.md":      true,
+		"temp1/file":            true,
+		"temp12":                false,
+		"app.log":               true,
+		"logs/2023/app.log":     true,
+		"src/generated/a.go":    true,
+		"src/pkg/api/generated": true,
+		"a.txt":                 true,
+		"c.txt":                 false,
+		"main.go":               false,
+	}
+	for rel, wanted := range testCases {
+		t.Run(rel, func(t *testing.T) {
+			require.Equal(t, wanted, patterns.excludes(rel))
+		})
+	}
+}
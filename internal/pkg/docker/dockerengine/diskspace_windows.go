@@ -0,0 +1,11 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerengine
+
+import "errors"
+
+// freeDiskSpace is not supported on Windows, where the docker engine runs inside a VM.
+func freeDiskSpace(path string) (uint64, error) {
+	return 0, errors.New("free disk space is not supported on windows")
+}
@@ -9,14 +9,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	osexec "os/exec"
 	"path/filepath"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/fatih/color"
@@ -42,23 +46,33 @@ const (
 
 const (
 	credStoreECRLogin = "ecr-login" // set on `credStore` attribute in docker configuration file
+
+	buildSecretEnvVarPrefix = "COPILOT_BUILD_SECRET_" // Prefix of the environment variables that hold the values of build secrets.
+	buildArgInlineCache     = "BUILDKIT_INLINE_CACHE" // Build arg that makes BuildKit embed the build cache metadata in the image.
 )
 
+var nonAlphanumeric = regexp.MustCompile("[^A-Za-z0-9]+")
+
 // DockerCmdClient represents the docker client to interact with the server via external commands.
 type DockerCmdClient struct {
-	runner Cmd
+	runner  Cmd
+	runtime string // Command of the container runtime, such as "podman". Empty means docker.
 	// Override in unit tests.
-	buf       *bytes.Buffer
-	homePath  string
-	lookupEnv func(string) (string, bool)
+	buf           *bytes.Buffer
+	homePath      string
+	lookupEnv     func(string) (string, bool)
+	freeDiskSpace func(path string) (uint64, error)
 }
 
 // New returns CmdClient to make requests against the Docker daemon via external commands.
+// The container runtime is selected by the COPILOT_CONTAINER_RUNTIME environment variable, or detected from the installed CLIs.
 func New(cmd Cmd) DockerCmdClient {
 	return DockerCmdClient{
-		runner:    cmd,
-		homePath:  userHomeDirectory(),
-		lookupEnv: os.LookupEnv,
+		runner:        cmd,
+		runtime:       detectRuntime(os.LookupEnv, osexec.LookPath),
+		homePath:      userHomeDirectory(),
+		lookupEnv:     os.LookupEnv,
+		freeDiskSpace: freeDiskSpace,
 	}
 }
 
@@ -73,6 +87,32 @@ type BuildArguments struct {
 	Platform   string            // Optional. OS/Arch to pass to `docker build`.
 	Args       map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
 	Labels     map[string]string // Required. Set metadata for an image.
+	Builder    string            // Optional. Cloud Native Buildpacks builder to build the image with `pack build` instead of the Dockerfile.
+	Buildpacks []string          // Optional. Buildpacks to pass to `pack build` instead of the ones detected by the builder.
+
+	Secrets []BuildSecret // Optional. Secrets to mount in the RUN instructions via `--secret` flags.
+	SSH     []string      // Optional. SSH agent sockets or keys to forward to the RUN instructions via `--ssh` flags.
+	Network string        // Optional. Networking mode of the RUN instructions.
+
+	SOCI bool // Optional. Generate and push a Seekable OCI index of the image once it's pushed.
+
+	// Optional. Use the image of the first tag in the repository as a cache source, and embed the build cache
+	// metadata in the image so that the next build can use it once the image is pushed.
+	CacheFromRepository bool
+}
+
+// BuildSecret is a secret that BuildKit mounts in the RUN instructions of the Dockerfile.
+// Exactly one of Env, Src, or Value is set.
+type BuildSecret struct {
+	ID    string // Required. The id of the secret in the "--mount=type=secret" instruction.
+	Env   string // Environment variable of the current process that holds the secret.
+	Src   string // File that holds the secret.
+	Value string // The secret itself, passed to docker through an environment variable so that it isn't in its arguments.
+}
+
+// envVarName returns the name of the environment variable that holds the value of the secret.
+func (s BuildSecret) envVarName() string {
+	return buildSecretEnvVarPrefix + strings.ToUpper(nonAlphanumeric.ReplaceAllString(s.ID, "_"))
 }
 
 // RunOptions holds the options for running a Docker container.
@@ -82,16 +122,88 @@ type RunOptions struct {
 	EnvVars          map[string]string // Optional. Environment variables to pass to the container.
 	ContainerName    string            // Optional. The name for the container.
 	ContainerPorts   map[string]string // Optional. Contains host and container ports.
+	Entrypoint       []string          // Optional. Overrides the entrypoint of the image.
 	Command          []string          // Optional. The command to run in the container.
 	ContainerNetwork string            // Optional. Network mode for the container.
+	Network          string            // Optional. User-defined network to connect the container to, instead of sharing the network of another container.
+	NetworkAliases   []string          // Optional. Hostnames that resolve to the container in the user-defined network.
+	Hosts            []Host            // Optional. Additional entries for the /etc/hosts file of the container.
+	Capabilities     []string          // Optional. Linux capabilities to add to the container.
+	Sysctls          map[string]string // Optional. Namespaced kernel parameters to set in the container.
+	Mounts           []Mount           // Optional. Directories of the host to bind mount in the container.
+	WorkingDir       string            // Optional. The working directory of the command in the container.
+	Remove           bool              // Optional. Removes the container once it exits.
+	HealthCheck      *HealthCheck      // Optional. The healthcheck of the container.
+	LogDriver        *LogDriver        // Optional. Also sends the output of the container to a logging driver other than the default one.
+	Labels           map[string]string // Optional. Metadata of the container.
+	Platform         string            // Optional. Platform of the image to pull and run, such as "linux/arm64".
 	LogOptions       RunLogOptions
 }
 
+// LogDriver is a logging driver of Docker, such as "fluentd", and its options.
+type LogDriver struct {
+	Name    string
+	Options map[string]string
+}
+
+// Mount is a directory of the host bind mounted in a container.
+type Mount struct {
+	Source   string // Absolute path to the directory on the host.
+	Target   string // Absolute path to the directory in the container.
+	ReadOnly bool
+}
+
+// HealthCheck is the healthcheck of a container, in the format of the healthcheck of an ECS container definition.
+type HealthCheck struct {
+	Command     []string // Either "CMD-SHELL" or "CMD" followed by the command, or "NONE" to disable the healthcheck of the image.
+	Interval    time.Duration
+	Retries     int
+	StartPeriod time.Duration
+	Timeout     time.Duration
+}
+
+func (hc *HealthCheck) runArguments() []string {
+	if len(hc.Command) == 0 {
+		return nil
+	}
+	if hc.Command[0] == "NONE" {
+		return []string{"--no-healthcheck"}
+	}
+	// Docker runs the healthcheck command in a shell, which also runs CMD commands as-is.
+	args := []string{"--health-cmd", strings.Join(hc.Command[1:], " ")}
+	if hc.Interval > 0 {
+		args = append(args, "--health-interval", hc.Interval.String())
+	}
+	if hc.Retries > 0 {
+		args = append(args, "--health-retries", strconv.Itoa(hc.Retries))
+	}
+	if hc.StartPeriod > 0 {
+		args = append(args, "--health-start-period", hc.StartPeriod.String())
+	}
+	if hc.Timeout > 0 {
+		args = append(args, "--health-timeout", hc.Timeout.String())
+	}
+	return args
+}
+
+// Host is an entry of the /etc/hosts file of a container.
+type Host struct {
+	Name string
+	IP   string
+}
+
 // RunLogOptions holds the logging configuration for Run().
 type RunLogOptions struct {
 	Color      *color.Color
 	Output     io.Writer
 	LinePrefix string
+	// Optional. Formats each line before it's written to Output. Only the prefix is colored if set.
+	FormatLine func(line string) string
+	// Optional. Receives each line as is, without the prefix.
+	Tee io.Writer
+	// Optional. Reports whether a line starts a new log entry, like the multiline pattern of the awslogs driver.
+	// The other lines continue the previous entry, and are printed under it without the prefix nor formatting.
+	IsEntryStart func(line string) bool
 }
 
 // GenerateDockerBuildArgs returns command line arguments to be passed to the Docker build command based on the provided BuildArguments.
@@ -117,7 +229,27 @@ func (in *BuildArguments) GenerateDockerBuildArgs(c DockerCmdClient) ([]string,
 	}
 
 	// Add cache from options.
-	for _, imageFrom := range in.CacheFrom {
+	cacheFrom := in.CacheFrom
+	buildArgs := in.Args
+	if in.CacheFromRepository && c.Runtime() != RuntimePodman {
+		// Podman only reads a cache from a repository along with --cache-to, and doesn't embed inline caches.
+		repoImage := imageName(in.URI, in.Tags[0])
+		cached := false
+		for _, image := range cacheFrom {
+			cached = cached || image == repoImage
+		}
+		if !cached {
+			cacheFrom = append(append([]string(nil), cacheFrom...), repoImage)
+		}
+		if _, ok := buildArgs[buildArgInlineCache]; !ok {
+			buildArgs = make(map[string]string, len(in.Args)+1)
+			for k, v := range in.Args {
+				buildArgs[k] = v
+			}
+			buildArgs[buildArgInlineCache] = "1"
+		}
+	}
+	for _, imageFrom := range cacheFrom {
 		args = append(args, "--cache-from", imageFrom)
 	}
 
@@ -131,20 +263,38 @@ func (in *BuildArguments) GenerateDockerBuildArgs(c DockerCmdClient) ([]string,
 		args = append(args, "--platform", in.Platform)
 	}
 
-	// Plain display if we're in a CI environment.
-	if ci, _ := c.lookupEnv("CI"); ci == "true" {
+	// Plain display if we're in a CI environment. Podman doesn't have the option, and always displays plain output.
+	if ci, _ := c.lookupEnv("CI"); ci == "true" && c.Runtime() != RuntimePodman {
 		args = append(args, "--progress", "plain")
 	}
 
+	// Add the BuildKit options.
+	for _, secret := range in.Secrets {
+		switch {
+		case secret.Value != "":
+			args = append(args, "--secret", fmt.Sprintf("id=%s,env=%s", secret.ID, secret.envVarName()))
+		case secret.Env != "":
+			args = append(args, "--secret", fmt.Sprintf("id=%s,env=%s", secret.ID, secret.Env))
+		default:
+			args = append(args, "--secret", fmt.Sprintf("id=%s,src=%s", secret.ID, secret.Src))
+		}
+	}
+	for _, ssh := range in.SSH {
+		args = append(args, "--ssh", ssh)
+	}
+	if in.Network != "" {
+		args = append(args, "--network", in.Network)
+	}
+
 	// Add the "args:" override section from manifest to the docker build call.
 	// Collect the keys in a slice to sort for test stability.
 	var keys []string
-	for k := range in.Args {
+	for k := range buildArgs {
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
 	for _, k := range keys {
-		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", k, in.Args[k]))
+		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", k, buildArgs[k]))
 	}
 
 	// Add Labels to docker build call.
@@ -162,26 +312,141 @@ func (in *BuildArguments) GenerateDockerBuildArgs(c DockerCmdClient) ([]string,
 	return args, nil
 }
 
+// GeneratePackBuildArgs returns command line arguments to be passed to the `pack build` command based on the provided BuildArguments.
+// The args are passed as build-time environment variables to the buildpacks. Labels are not supported by `pack build`.
+// Returns an error if no tags are provided for building an image.
+func (in *BuildArguments) GeneratePackBuildArgs() ([]string, error) {
+	if len(in.Tags) == 0 {
+		return nil, &errEmptyImageTags{
+			uri: in.URI,
+		}
+	}
+	args := []string{"build", imageName(in.URI, in.Tags[0]), "--builder", in.Builder}
+	for _, tag := range in.Tags[1:] {
+		args = append(args, "--tag", imageName(in.URI, tag))
+	}
+	if in.Context != "" {
+		args = append(args, "--path", in.Context)
+	}
+	for _, buildpack := range in.Buildpacks {
+		args = append(args, "--buildpack", buildpack)
+	}
+	if in.Platform != "" {
+		args = append(args, "--platform", in.Platform)
+	}
+	// Collect the keys in a slice to sort for test stability.
+	var keys []string
+	for k := range in.Args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "--env", fmt.Sprintf("%s=%s", k, in.Args[k]))
+	}
+	return args, nil
+}
+
+// BuildCommand returns the command and its arguments that Build runs to build the image.
+func (in *BuildArguments) BuildCommand(c DockerCmdClient) (name string, args []string, err error) {
+	if in.Builder != "" {
+		args, err = in.GeneratePackBuildArgs()
+		return "pack", args, err
+	}
+	args, err = in.GenerateDockerBuildArgs(c)
+	return c.cmd(), args, err
+}
+
+// buildKitEnv returns the environment variables to add to the docker build command, if any.
+// BuildKit must be enabled for the secrets and SSH forwarding, and the values of the secrets are passed
+// through environment variables so that they don't show up in the arguments of the command.
+func (in *BuildArguments) buildKitEnv() []string {
+	if len(in.Secrets) == 0 && len(in.SSH) == 0 {
+		return nil
+	}
+	env := []string{"DOCKER_BUILDKIT=1"}
+	for _, secret := range in.Secrets {
+		if secret.Value != "" {
+			env = append(env, fmt.Sprintf("%s=%s", secret.envVarName(), secret.Value))
+		}
+	}
+	return env
+}
+
+// buildCmdOptions returns the options of the command that builds the image.
+func (in *BuildArguments) buildCmdOptions(w io.Writer) []exec.CmdOption {
+	opts := []exec.CmdOption{exec.Stdout(w), exec.Stderr(w)}
+	if env := in.buildKitEnv(); env != nil {
+		opts = append(opts, exec.Env(env...))
+	}
+	return opts
+}
+
 type dockerConfig struct {
 	CredsStore  string            `json:"credsStore,omitempty"`
 	CredHelpers map[string]string `json:"credHelpers,omitempty"`
 }
 
 // Build will run a `docker build` command for the given ecr repo URI and build arguments.
+// If a builder is specified, it runs `pack build` to build the image with Cloud Native Buildpacks instead.
 func (c DockerCmdClient) Build(ctx context.Context, in *BuildArguments, w io.Writer) error {
-	args, err := in.GenerateDockerBuildArgs(c)
+	if IsMultiPlatform(in.Platform) {
+		return fmt.Errorf("build image for platforms %s: an image for several platforms can only be built while pushing it", in.Platform)
+	}
+	name, args, err := in.BuildCommand(c)
 	if err != nil {
-		return fmt.Errorf("generate docker build args: %w", err)
+		return fmt.Errorf("generate %s build args: %w", name, err)
 	}
-	if err := c.runner.RunWithContext(ctx, "docker", args, exec.Stdout(w), exec.Stderr(w)); err != nil {
+	if err := c.runner.RunWithContext(ctx, name, args, in.buildCmdOptions(w)...); err != nil {
+		if name == "pack" && errors.Is(err, osexec.ErrNotFound) {
+			return &ErrPackCommandNotFound{}
+		}
 		return fmt.Errorf("building image: %w", err)
 	}
 	return nil
 }
 
+// BuildAndPushMultiPlatform builds the image for every platform of the build arguments with `docker buildx build --push`,
+// since the image store of the engine can't hold an image for several platforms, and returns the digest of its manifest list.
+func (c DockerCmdClient) BuildAndPushMultiPlatform(ctx context.Context, in *BuildArguments, w io.Writer) (digest string, err error) {
+	if in.Builder != "" {
+		return "", fmt.Errorf("build image with builder %s: buildpacks can't build an image for several platforms", in.Builder)
+	}
+	if runtime := c.Runtime(); runtime != RuntimeDocker {
+		return "", fmt.Errorf("build image for platforms %s: an image for several platforms can only be built with docker buildx, not %s", in.Platform, runtime)
+	}
+	args, err := in.GenerateDockerBuildArgs(c)
+	if err != nil {
+		return "", fmt.Errorf("generate docker build args: %w", err)
+	}
+	args = append([]string{"buildx", "build", "--push"}, args[1:]...)
+	if err := c.runner.RunWithContext(ctx, c.cmd(), args, in.buildCmdOptions(w)...); err != nil {
+		return "", fmt.Errorf("building and pushing image: %w", err)
+	}
+	// The tags all reference the same manifest list, which references the image of each platform.
+	digest, err = c.manifestDigest(ctx, imageName(in.URI, in.Tags[0]))
+	if err != nil {
+		return "", fmt.Errorf("inspect manifest list digest for %s: %w", in.URI, err)
+	}
+	return digest, nil
+}
+
+func (c DockerCmdClient) manifestDigest(ctx context.Context, uri string) (string, error) {
+	buf := new(strings.Builder)
+	if err := c.runner.RunWithContext(ctx, c.cmd(), []string{"buildx", "imagetools", "inspect", uri, "--format", "{{json .Manifest}}"}, exec.Stdout(buf)); err != nil {
+		return "", err
+	}
+	var manifest struct {
+		Digest string `json:"digest"`
+	}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(buf.String())), &manifest); err != nil {
+		return "", fmt.Errorf("unmarshal manifest of %s: %w", uri, err)
+	}
+	return manifest.Digest, nil
+}
+
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
 func (c DockerCmdClient) Login(uri, username, password string) error {
-	err := c.runner.Run("docker",
+	err := c.runner.Run(c.cmd(),
 		[]string{"login", "-u", username, "--password-stdin", uri},
 		exec.Stdin(strings.NewReader(password)))
 
@@ -204,8 +469,8 @@ func (c DockerCmdClient) Push(ctx context.Context, uri string, w io.Writer, tags
 	}
 
 	for _, img := range images {
-		if err := c.runner.RunWithContext(ctx, "docker", append([]string{"push", img}, args...), exec.Stdout(w), exec.Stderr(w)); err != nil {
-			return "", fmt.Errorf("docker push %s: %w", img, err)
+		if err := c.runner.RunWithContext(ctx, c.cmd(), append([]string{"push", img}, args...), exec.Stdout(w), exec.Stderr(w)); err != nil {
+			return "", fmt.Errorf("%s push %s: %w", c.Runtime(), img, err)
 		}
 	}
 	buf := new(strings.Builder)
@@ -213,7 +478,7 @@ func (c DockerCmdClient) Push(ctx context.Context, uri string, w io.Writer, tags
 	// Pick the first tag and get the image's digest.
 	// For Main container we call  docker inspect --format '{{json (index .RepoDigests 0)}}' uri:latest
 	// For Sidecar container images we call docker inspect --format '{{json (index .RepoDigests 0)}}' uri:<sidecarname>-latest
-	if err := c.runner.RunWithContext(ctx, "docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", imageName(uri, tags[0])}, exec.Stdout(buf)); err != nil {
+	if err := c.runner.RunWithContext(ctx, c.cmd(), []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", imageName(uri, tags[0])}, exec.Stdout(buf)); err != nil {
 		return "", fmt.Errorf("inspect image digest for %s: %w", uri, err)
 	}
 	repoDigest := strings.Trim(strings.TrimSpace(buf.String()), `"'`) // remove new lines and quotes from output
@@ -235,11 +500,74 @@ func (in *RunOptions) generateRunArguments() []string {
 		args = append(args, "--publish", fmt.Sprintf("%s:%s", hostPort, containerPort))
 	}
 
+	if in.Remove {
+		args = append(args, "--rm")
+	}
+
 	// Add network option if it's not a "pause" container.
-	if !strings.HasPrefix(in.ContainerName, "pause") {
+	if !strings.HasPrefix(in.ContainerName, "pause") && in.ContainerNetwork != "" {
 		args = append(args, "--network", fmt.Sprintf("container:%s", in.ContainerNetwork))
 	}
 
+	if in.Network != "" {
+		args = append(args, "--network", in.Network)
+		for _, alias := range in.NetworkAliases {
+			args = append(args, "--network-alias", alias)
+		}
+	}
+
+	for _, host := range in.Hosts {
+		args = append(args, "--add-host", fmt.Sprintf("%s:%s", host.Name, host.IP))
+	}
+
+	for _, capability := range in.Capabilities {
+		args = append(args, "--cap-add", capability)
+	}
+
+	for key, value := range in.Sysctls {
+		args = append(args, "--sysctl", fmt.Sprintf("%s=%s", key, value))
+	}
+
+	for _, mount := range in.Mounts {
+		volume := fmt.Sprintf("%s:%s", mount.Source, mount.Target)
+		if mount.ReadOnly {
+			volume += ":ro"
+		}
+		args = append(args, "--volume", volume)
+	}
+
+	if in.WorkingDir != "" {
+		args = append(args, "--workdir", in.WorkingDir)
+	}
+
+	if in.Platform != "" {
+		args = append(args, "--platform", in.Platform)
+	}
+
+	labelKeys := make([]string, 0, len(in.Labels))
+	for k := range in.Labels {
+		labelKeys = append(labelKeys, k)
+	}
+	sort.Strings(labelKeys)
+	for _, k := range labelKeys {
+		args = append(args, "--label", fmt.Sprintf("%s=%s", k, in.Labels[k]))
+	}
+
+	if in.HealthCheck != nil {
+		args = append(args, in.HealthCheck.runArguments()...)
+	}
+
+	if in.LogDriver != nil {
+		args = append(args, "--log-driver", in.LogDriver.Name)
+		for key, value := range in.LogDriver.Options {
+			args = append(args, "--log-opt", fmt.Sprintf("%s=%s", key, value))
+		}
+	}
+
+	if len(in.Entrypoint) > 0 {
+		args = append(args, "--entrypoint", in.Entrypoint[0])
+	}
+
 	for key, value := range in.Secrets {
 		args = append(args, "--env", fmt.Sprintf("%s=%s", key, value))
 	}
@@ -250,6 +578,10 @@ func (in *RunOptions) generateRunArguments() []string {
 
 	args = append(args, in.ImageURI)
 
+	if len(in.Entrypoint) > 1 {
+		// The arguments of the entrypoint come before the command, like in an ECS container definition.
+		args = append(args, in.Entrypoint[1:]...)
+	}
 	if in.Command != nil && len(in.Command) > 0 {
 		args = append(args, in.Command...)
 	}
@@ -276,7 +608,7 @@ func (c DockerCmdClient) Run(ctx context.Context, options *RunOptions) error {
 			scanner := bufio.NewScanner(pr)
 			for scanner.Scan() {
 				mu.Lock()
-				options.LogOptions.Color.Fprintln(options.LogOptions.Output, options.LogOptions.LinePrefix+scanner.Text())
+				options.LogOptions.writeLine(scanner.Text())
 				mu.Unlock()
 			}
 			return scanner.Err()
@@ -293,7 +625,7 @@ func (c DockerCmdClient) Run(ctx context.Context, options *RunOptions) error {
 		stderr := logger()
 		defer stderr.Close()
 
-		if err := c.runner.RunWithContext(ctx, "docker", options.generateRunArguments(), exec.Stdout(stdout), exec.Stderr(stderr)); err != nil {
+		if err := c.runner.RunWithContext(ctx, c.cmd(), options.generateRunArguments(), exec.Stdout(stdout), exec.Stderr(stderr)); err != nil {
 			return fmt.Errorf("running container: %w", err)
 		}
 		return nil
@@ -302,21 +634,187 @@ func (c DockerCmdClient) Run(ctx context.Context, options *RunOptions) error {
 	return g.Wait()
 }
 
+func (o RunLogOptions) writeLine(line string) {
+	if o.Tee != nil {
+		fmt.Fprintln(o.Tee, line)
+	}
+	if o.IsEntryStart != nil && !o.IsEntryStart(line) {
+		o.Color.Fprintln(o.Output, strings.Repeat(" ", len(o.LinePrefix))+line)
+		return
+	}
+	if o.FormatLine == nil {
+		o.Color.Fprintln(o.Output, o.LinePrefix+line)
+		return
+	}
+	fmt.Fprintln(o.Output, o.Color.Sprint(o.LinePrefix)+o.FormatLine(line))
+}
+
 // IsContainerRunning checks if a specific Docker container is running.
 func (c DockerCmdClient) IsContainerRunning(containerName string) (bool, error) {
 	buf := &bytes.Buffer{}
-	if err := c.runner.Run("docker", []string{"ps", "-q", "--filter", "name=" + containerName}, exec.Stdout(buf)); err != nil {
-		return false, fmt.Errorf("run docker ps: %w", err)
+	// The name filter matches substrings of the names, which start with a slash, so anchor it to not match other containers.
+	if err := c.runner.Run(c.cmd(), []string{"ps", "-q", "--filter", fmt.Sprintf("name=^/?%s$", containerName)}, exec.Stdout(buf)); err != nil {
+		return false, fmt.Errorf("run %s ps: %w", c.Runtime(), err)
 	}
 
 	output := strings.TrimSpace(buf.String())
 	return output != "", nil
 }
 
+// RunningContainer is a running container listed by `docker ps`.
+type RunningContainer struct {
+	Name       string
+	Labels     map[string]string
+	RunningFor string // How long ago the container was created, such as "5 minutes ago".
+}
+
+// RunningContainers calls `docker ps` to list the running containers that have the label.
+func (c DockerCmdClient) RunningContainers(ctx context.Context, label string) ([]RunningContainer, error) {
+	buf := &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, c.cmd(), []string{"ps", "--filter", "label=" + label, "--format", "{{json .}}"}, exec.Stdout(buf)); err != nil {
+		return nil, fmt.Errorf("run %s ps: %w", c.Runtime(), err)
+	}
+	var containers []RunningContainer
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var out struct {
+			Names      json.RawMessage // A string, or a list of strings with podman.
+			Labels     json.RawMessage // Comma separated key=value pairs, or a map with podman.
+			RunningFor string
+		}
+		if err := json.Unmarshal([]byte(line), &out); err != nil {
+			return nil, fmt.Errorf("unmarshal output of %s ps: %w", c.Runtime(), err)
+		}
+		name, err := unmarshalContainerNames(out.Names)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal names of container in output of %s ps: %w", c.Runtime(), err)
+		}
+		labels, err := unmarshalContainerLabels(out.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal labels of container %s in output of %s ps: %w", name, c.Runtime(), err)
+		}
+		containers = append(containers, RunningContainer{
+			Name:       name,
+			Labels:     labels,
+			RunningFor: out.RunningFor,
+		})
+	}
+	return containers, nil
+}
+
+func unmarshalContainerNames(raw json.RawMessage) (string, error) {
+	var names []string
+	if err := json.Unmarshal(raw, &names); err == nil {
+		return strings.Join(names, ","), nil
+	}
+	var name string
+	if err := json.Unmarshal(raw, &name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func unmarshalContainerLabels(raw json.RawMessage) (map[string]string, error) {
+	labels := make(map[string]string)
+	if len(raw) == 0 || string(raw) == "null" {
+		return labels, nil
+	}
+	if err := json.Unmarshal(raw, &labels); err == nil {
+		return labels, nil
+	}
+	var pairs string
+	if err := json.Unmarshal(raw, &pairs); err != nil {
+		return nil, err
+	}
+	for _, pair := range strings.Split(pairs, ",") {
+		if k, v, ok := strings.Cut(pair, "="); ok {
+			labels[k] = v
+		}
+	}
+	return labels, nil
+}
+
+// Status of containers and of their healthchecks reported by `docker inspect`.
+const (
+	ContainerStatusCreated = "created"
+	ContainerStatusExited  = "exited"
+
+	ContainerHealthHealthy   = "healthy"
+	ContainerHealthUnhealthy = "unhealthy"
+)
+
+// ContainerState is the state of a container.
+type ContainerState struct {
+	Status   string
+	ExitCode int
+	Health   *ContainerHealth // Nil if the container doesn't have a healthcheck.
+}
+
+// ContainerHealth is the health of a container with a healthcheck.
+type ContainerHealth struct {
+	Status string
+}
+
+// ContainerState calls `docker inspect` to return the state of a container.
+// If the container doesn't exist, it returns ErrContainerNotExist.
+func (c DockerCmdClient) ContainerState(ctx context.Context, containerName string) (ContainerState, error) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, c.cmd(), []string{"inspect", "--type", "container", "--format", "{{json .State}}", containerName}, exec.Stdout(stdout), exec.Stderr(stderr)); err != nil {
+		// The runtimes capitalize the message differently.
+		if strings.Contains(strings.ToLower(stderr.String()), "no such container") {
+			return ContainerState{}, &ErrContainerNotExist{Name: containerName}
+		}
+		return ContainerState{}, fmt.Errorf("run %s inspect: %w", c.Runtime(), err)
+	}
+	var state ContainerState
+	if err := json.Unmarshal(stdout.Bytes(), &state); err != nil {
+		return ContainerState{}, fmt.Errorf("unmarshal state of container %s: %w", containerName, err)
+	}
+	return state, nil
+}
+
+// Exec calls `docker exec` to run a command in a running container until the command exits or the context is canceled.
+// The output of the command is written to w.
+func (c DockerCmdClient) Exec(ctx context.Context, container string, w io.Writer, cmd string, args ...string) error {
+	if err := c.runner.RunWithContext(ctx, c.cmd(), append([]string{"exec", container, cmd}, args...), exec.Stdout(w), exec.Stderr(w)); err != nil {
+		return fmt.Errorf("run %s exec: %w", c.Runtime(), err)
+	}
+	return nil
+}
+
+// InteractiveExec calls `docker exec` to run a command in a running container, attached to the terminal.
+func (c DockerCmdClient) InteractiveExec(ctx context.Context, container string, cmd []string) error {
+	args := append([]string{"exec", "--interactive", "--tty", container}, cmd...)
+	if err := c.runner.RunWithContext(ctx, c.cmd(), args, exec.Stdin(os.Stdin), exec.Stdout(os.Stdout), exec.Stderr(os.Stderr)); err != nil {
+		return fmt.Errorf("run %s exec: %w", c.Runtime(), err)
+	}
+	return nil
+}
+
+// CopyToContainer calls `docker cp` to copy a file or directory of the host to a path in a container.
+func (c DockerCmdClient) CopyToContainer(ctx context.Context, src, container, dst string) error {
+	buf := &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, c.cmd(), []string{"cp", src, container + ":" + dst}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
+		return fmt.Errorf("%s: %w", strings.TrimSpace(buf.String()), err)
+	}
+	return nil
+}
+
+// Kill calls `docker kill` to send a signal to the main process of a running container.
+func (c DockerCmdClient) Kill(ctx context.Context, container, signal string) error {
+	buf := &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, c.cmd(), []string{"kill", "--signal", signal, container}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
+		return fmt.Errorf("%s: %w", strings.TrimSpace(buf.String()), err)
+	}
+	return nil
+}
+
 // Stop calls `docker stop` to stop a running container.
 func (c DockerCmdClient) Stop(containerID string) error {
 	buf := &bytes.Buffer{}
-	if err := c.runner.Run("docker", []string{"stop", containerID}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
+	if err := c.runner.Run(c.cmd(), []string{"stop", containerID}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
 		return fmt.Errorf("%s: %w", strings.TrimSpace(buf.String()), err)
 	}
 	return nil
@@ -325,7 +823,59 @@ func (c DockerCmdClient) Stop(containerID string) error {
 // Rm calls `docker rm` to remove a stopped container.
 func (c DockerCmdClient) Rm(containerID string) error {
 	buf := &bytes.Buffer{}
-	if err := c.runner.Run("docker", []string{"rm", containerID}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
+	if err := c.runner.Run(c.cmd(), []string{"rm", containerID}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
+		return fmt.Errorf("%s: %w", strings.TrimSpace(buf.String()), err)
+	}
+	return nil
+}
+
+// ImageExists calls `docker image inspect` to return whether the image is available locally.
+func (c DockerCmdClient) ImageExists(ctx context.Context, uri string) (bool, error) {
+	stderr := &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, c.cmd(), []string{"image", "inspect", "--format", "{{.Id}}", uri}, exec.Stdout(io.Discard), exec.Stderr(stderr)); err != nil {
+		// Docker reports "No such image", Podman "image not known".
+		if msg := strings.ToLower(stderr.String()); strings.Contains(msg, "no such image") || strings.Contains(msg, "image not known") {
+			return false, nil
+		}
+		return false, fmt.Errorf("run %s image inspect: %w", c.Runtime(), err)
+	}
+	return true, nil
+}
+
+// SaveImages calls `docker save` to write the local images to w as a tar archive.
+func (c DockerCmdClient) SaveImages(ctx context.Context, w io.Writer, uris ...string) error {
+	stderr := &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, c.cmd(), append([]string{"save"}, uris...), exec.Stdout(w), exec.Stderr(stderr)); err != nil {
+		return fmt.Errorf("%s: %w", strings.TrimSpace(stderr.String()), err)
+	}
+	return nil
+}
+
+// LoadImages calls `docker load` to add the images of the tar archive r, written by SaveImages, to the local images.
+func (c DockerCmdClient) LoadImages(ctx context.Context, r io.Reader) error {
+	buf := &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, c.cmd(), []string{"load"}, exec.Stdin(r), exec.Stdout(buf), exec.Stderr(buf)); err != nil {
+		return fmt.Errorf("%s: %w", strings.TrimSpace(buf.String()), err)
+	}
+	return nil
+}
+
+// CreateNetwork calls `docker network create` to create a user-defined bridge network, unless it already exists.
+func (c DockerCmdClient) CreateNetwork(name string) error {
+	buf := &bytes.Buffer{}
+	if err := c.runner.Run(c.cmd(), []string{"network", "create", name}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
+		if strings.Contains(buf.String(), "already exists") {
+			return nil
+		}
+		return fmt.Errorf("%s: %w", strings.TrimSpace(buf.String()), err)
+	}
+	return nil
+}
+
+// RemoveNetwork calls `docker network rm` to remove a network that no container is connected to.
+func (c DockerCmdClient) RemoveNetwork(name string) error {
+	buf := &bytes.Buffer{}
+	if err := c.runner.Run(c.cmd(), []string{"network", "rm", name}, exec.Stdout(buf), exec.Stderr(buf)); err != nil {
 		return fmt.Errorf("%s: %w", strings.TrimSpace(buf.String()), err)
 	}
 	return nil
@@ -333,39 +883,53 @@ func (c DockerCmdClient) Rm(containerID string) error {
 
 // CheckDockerEngineRunning will run `docker info` command to check if the docker engine is running.
 func (c DockerCmdClient) CheckDockerEngineRunning() error {
-	if _, err := osexec.LookPath("docker"); err != nil {
-		return ErrDockerCommandNotFound
+	_, err := c.info()
+	return err
+}
+
+// info runs `docker info` and returns an error if the docker engine can't be reached.
+func (c DockerCmdClient) info() (*dockerInfo, error) {
+	if _, err := osexec.LookPath(c.cmd()); err != nil {
+		return nil, c.errCommandNotFound()
 	}
 	buf := &bytes.Buffer{}
-	err := c.runner.Run("docker", []string{"info", "-f", "'{{json .}}'"}, exec.Stdout(buf))
+	err := c.runner.Run(c.cmd(), []string{"info", "-f", "'{{json .}}'"}, exec.Stdout(buf))
 	if err != nil {
-		return fmt.Errorf("get docker info: %w", err)
+		return nil, fmt.Errorf("get %s info: %w", c.Runtime(), err)
 	}
 	// Trim redundant prefix and suffix. For example: '{"ServerErrors":["Cannot connect...}'\n returns
 	// {"ServerErrors":["Cannot connect...}
 	out := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(buf.String()), "'"), "'")
-	type dockerEngineNotRunningMsg struct {
-		ServerErrors []string `json:"ServerErrors"`
-	}
-	var msg dockerEngineNotRunningMsg
-	if err := json.Unmarshal([]byte(out), &msg); err != nil {
-		return fmt.Errorf("unmarshal docker info message: %w", err)
+	var info dockerInfo
+	if err := json.Unmarshal([]byte(out), &info); err != nil {
+		return nil, fmt.Errorf("unmarshal docker info message: %w", err)
 	}
-	if len(msg.ServerErrors) == 0 {
-		return nil
+	if len(info.ServerErrors) == 0 {
+		return &info, nil
 	}
-	return &ErrDockerDaemonNotResponsive{
-		msg: strings.Join(msg.ServerErrors, "\n"),
+	dockerHost, _ := c.lookupEnv("DOCKER_HOST")
+	return nil, &ErrDockerDaemonNotResponsive{
+		msg:        strings.Join(info.ServerErrors, "\n"),
+		dockerHost: dockerHost,
+		sockets:    c.detectDockerSockets(),
 	}
 }
 
 // GetPlatform will run the `docker version` command to get the OS/Arch.
+// The other runtimes don't report the platform of their server in `version`, so it is read from `info` instead.
 func (c DockerCmdClient) GetPlatform() (os, arch string, err error) {
-	if _, err := osexec.LookPath("docker"); err != nil {
-		return "", "", ErrDockerCommandNotFound
+	if _, err := osexec.LookPath(c.cmd()); err != nil {
+		return "", "", c.errCommandNotFound()
+	}
+	if c.Runtime() != RuntimeDocker {
+		info, err := c.info()
+		if err != nil {
+			return "", "", err
+		}
+		return info.platform()
 	}
 	buf := &bytes.Buffer{}
-	err = c.runner.Run("docker", []string{"version", "-f", "'{{json .Server}}'"}, exec.Stdout(buf))
+	err = c.runner.Run(c.cmd(), []string{"version", "-f", "'{{json .Server}}'"}, exec.Stdout(buf))
 	if err != nil {
 		return "", "", fmt.Errorf("run docker version: %w", err)
 	}
@@ -396,9 +960,11 @@ func (c DockerCmdClient) IsEcrCredentialHelperEnabled(uri string) bool {
 	}
 
 	// Look into the default locations
-	pathsToTry := []string{filepath.Join(".docker", "config.json"), ".dockercfg"}
-	for _, path := range pathsToTry {
-		content, err := os.ReadFile(filepath.Join(c.homePath, path))
+	for _, path := range c.credentialsConfigPaths() {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(c.homePath, path)
+		}
+		content, err := os.ReadFile(path)
 		if err != nil {
 			// if we can't read the file keep going
 			continue
@@ -422,6 +988,11 @@ func PlatformString(os, arch string) string {
 	return fmt.Sprintf("%s/%s", os, arch)
 }
 
+// IsMultiPlatform returns true if the platform lists several platforms separated by commas, such as "linux/amd64,linux/arm64".
+func IsMultiPlatform(platform string) bool {
+	return strings.Contains(platform, ",")
+}
+
 func parseCredFromDockerConfig(config []byte) (*dockerConfig, error) {
 	/*
 			Sample docker config file
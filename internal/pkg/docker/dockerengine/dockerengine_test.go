@@ -8,10 +8,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	osexec "os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 
@@ -42,8 +44,16 @@ func TestDockerCommand_Build(t *testing.T) {
 		args       map[string]string
 		target     string
 		cacheFrom  []string
+		cacheRepo  bool
+		runtime    string
 		envVars    map[string]string
 		labels     map[string]string
+		builder    string
+		buildpacks []string
+		platform   string
+		secrets    []BuildSecret
+		ssh        []string
+		network    string
 		setupMocks func(controller *gomock.Controller)
 
 		wantedError error
@@ -69,6 +79,15 @@ func TestDockerCommand_Build(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("building image: %w", mockError),
 		},
+		"should error if the image is built for several platforms": {
+			path:     mockPath,
+			tags:     []string{mockTag1},
+			platform: "linux/amd64,linux/arm64",
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+			},
+			wantedError: errors.New("build image for platforms linux/amd64,linux/arm64: an image for several platforms can only be built while pushing it"),
+		},
 		"should succeed in simple case with no context": {
 			path:    mockPath,
 			context: "",
@@ -124,6 +143,37 @@ func TestDockerCommand_Build(t *testing.T) {
 			},
 		},
 
+		"success with BuildKit secrets, ssh forwarding and network": {
+			path: mockPath,
+			tags: []string{mockTag1},
+			secrets: []BuildSecret{
+				{ID: "npmrc", Src: "/home/user/.npmrc"},
+				{ID: "token", Env: "GITHUB_TOKEN"},
+				{ID: "pip-index", Value: "s3cr3t"},
+			},
+			ssh:     []string{"default"},
+			network: "host",
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"build",
+					"-t", mockURI + ":" + mockTag1,
+					"--secret", "id=npmrc,src=/home/user/.npmrc",
+					"--secret", "id=token,env=GITHUB_TOKEN",
+					"--secret", "id=pip-index,env=COPILOT_BUILD_SECRET_PIP_INDEX",
+					"--ssh", "default",
+					"--network", "host",
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any(), gomock.Any()).
+					Do(func(_ context.Context, _ string, _ []string, opts ...exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						require.Contains(t, cmd.Env, "DOCKER_BUILDKIT=1")
+						require.Contains(t, cmd.Env, "COPILOT_BUILD_SECRET_PIP_INDEX=s3cr3t")
+					}).Return(nil)
+			},
+		},
 		"success with additional tags": {
 			path: mockPath,
 			tags: []string{mockTag1, mockTag2, mockTag3},
@@ -193,6 +243,93 @@ func TestDockerCommand_Build(t *testing.T) {
 					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"uses the image in the repository as a cache source and embeds the cache in the new image": {
+			path:      mockPath,
+			tags:      []string{"latest", mockTag1},
+			cacheFrom: []string{"foo/bar:latest"},
+			cacheRepo: true,
+			args: map[string]string{
+				"key": "value",
+			},
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"build",
+					"-t", fmt.Sprintf("%s:%s", mockURI, "latest"),
+					"-t", fmt.Sprintf("%s:%s", mockURI, mockTag1),
+					"--cache-from", "foo/bar:latest",
+					"--cache-from", fmt.Sprintf("%s:%s", mockURI, "latest"),
+					"--build-arg", "BUILDKIT_INLINE_CACHE=1",
+					"--build-arg", "key=value",
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"doesn't duplicate the cache source or override the inline cache build arg": {
+			path:      mockPath,
+			tags:      []string{"latest"},
+			cacheFrom: []string{mockURI + ":latest"},
+			cacheRepo: true,
+			args: map[string]string{
+				"BUILDKIT_INLINE_CACHE": "0",
+			},
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"build",
+					"-t", fmt.Sprintf("%s:%s", mockURI, "latest"),
+					"--cache-from", fmt.Sprintf("%s:%s", mockURI, "latest"),
+					"--build-arg", "BUILDKIT_INLINE_CACHE=0",
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"doesn't use the repository as a cache source with podman": {
+			path:      mockPath,
+			tags:      []string{"latest"},
+			cacheRepo: true,
+			runtime:   "podman",
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "podman", []string{"build",
+					"-t", fmt.Sprintf("%s:%s", mockURI, "latest"),
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"builds with buildpacks if a builder is specified": {
+			context:    mockContext,
+			tags:       []string{mockTag1, mockTag2},
+			builder:    "paketobuildpacks/builder-jammy-base",
+			buildpacks: []string{"paketo-buildpacks/nodejs"},
+			platform:   "linux/amd64",
+			args: map[string]string{
+				"BP_NODE_VERSION": "20",
+			},
+			labels: map[string]string{
+				"com.aws.copilot.image.builder": "copilot-cli",
+			},
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "pack", []string{"build",
+					mockURI + ":" + mockTag1,
+					"--builder", "paketobuildpacks/builder-jammy-base",
+					"--tag", mockURI + ":" + mockTag2,
+					"--path", "mockPath",
+					"--buildpack", "paketo-buildpacks/nodejs",
+					"--platform", "linux/amd64",
+					"--env", "BP_NODE_VERSION=20"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"should return ErrPackCommandNotFound if pack is not installed": {
+			context: mockContext,
+			tags:    []string{mockTag1},
+			builder: "paketobuildpacks/builder-jammy-base",
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "pack", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&osexec.Error{Name: "pack", Err: osexec.ErrNotFound})
+			},
+			wantedError: &ErrPackCommandNotFound{},
+		},
 	}
 
 	for name, tc := range tests {
@@ -200,7 +337,8 @@ func TestDockerCommand_Build(t *testing.T) {
 			controller := gomock.NewController(t)
 			tc.setupMocks(controller)
 			s := DockerCmdClient{
-				runner: mockCmd,
+				runner:  mockCmd,
+				runtime: tc.runtime,
 				lookupEnv: func(key string) (string, bool) {
 					if val, ok := tc.envVars[key]; ok {
 						return val, true
@@ -209,14 +347,21 @@ func TestDockerCommand_Build(t *testing.T) {
 				},
 			}
 			buildInput := BuildArguments{
-				Context:    tc.context,
-				Dockerfile: tc.path,
-				URI:        mockURI,
-				Args:       tc.args,
-				Target:     tc.target,
-				CacheFrom:  tc.cacheFrom,
-				Tags:       tc.tags,
-				Labels:     tc.labels,
+				Context:             tc.context,
+				Dockerfile:          tc.path,
+				URI:                 mockURI,
+				Args:                tc.args,
+				Target:              tc.target,
+				CacheFrom:           tc.cacheFrom,
+				CacheFromRepository: tc.cacheRepo,
+				Tags:                tc.tags,
+				Labels:              tc.labels,
+				Builder:             tc.builder,
+				Buildpacks:          tc.buildpacks,
+				Platform:            tc.platform,
+				Secrets:             tc.secrets,
+				SSH:                 tc.ssh,
+				Network:             tc.network,
 			}
 			buf := new(strings.Builder)
 			got := s.Build(ctx, &buildInput, buf)
@@ -402,6 +547,97 @@ func TestDockerCommand_Push(t *testing.T) {
 	})
 }
 
+func TestDockerCommand_BuildAndPushMultiPlatform(t *testing.T) {
+	emptyLookupEnv := func(key string) (string, bool) {
+		return "", false
+	}
+	ctx := context.Background()
+	in := func() *BuildArguments {
+		return &BuildArguments{
+			URI:        "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app",
+			Tags:       []string{"latest", "g123bfc"},
+			Dockerfile: "web/Dockerfile",
+			Context:    "web",
+			Platform:   "linux/amd64,linux/arm64",
+		}
+	}
+	t.Run("builds and pushes an image for several platforms and returns the digest of its manifest list", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "build", "--push",
+			"-t", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:latest",
+			"-t", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:g123bfc",
+			"--platform", "linux/amd64,linux/arm64",
+			"web", "-f", "web/Dockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+		m.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "imagetools", "inspect", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:latest", "--format", "{{json .Manifest}}"}, gomock.Any()).
+			Do(func(ctx context.Context, _ string, _ []string, opt exec.CmdOption) {
+				cmd := &osexec.Cmd{}
+				opt(cmd)
+				_, _ = cmd.Stdout.Write([]byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.index.v1+json","digest":"sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807","size":855}` + "\n"))
+			}).Return(nil)
+
+		// WHEN
+		cmd := DockerCmdClient{
+			runner:    m,
+			lookupEnv: emptyLookupEnv,
+		}
+		buf := new(strings.Builder)
+		digest, err := cmd.BuildAndPushMultiPlatform(ctx, in(), buf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", digest)
+	})
+	t.Run("returns an error if the image is built with buildpacks", func(t *testing.T) {
+		// GIVEN
+		args := in()
+		args.Builder = "paketobuildpacks/builder-jammy-base"
+
+		// WHEN
+		_, err := DockerCmdClient{}.BuildAndPushMultiPlatform(ctx, args, new(strings.Builder))
+
+		// THEN
+		require.EqualError(t, err, "build image with builder paketobuildpacks/builder-jammy-base: buildpacks can't build an image for several platforms")
+	})
+	t.Run("returns a wrapped error on failed build", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().RunWithContext(ctx, "docker", gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := DockerCmdClient{
+			runner:    m,
+			lookupEnv: emptyLookupEnv,
+		}
+		_, err := cmd.BuildAndPushMultiPlatform(ctx, in(), new(strings.Builder))
+
+		// THEN
+		require.EqualError(t, err, "building and pushing image: some error")
+	})
+	t.Run("returns a wrapped error on failure to inspect the manifest list", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().RunWithContext(ctx, "docker", gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		m.EXPECT().RunWithContext(ctx, "docker", gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := DockerCmdClient{
+			runner:    m,
+			lookupEnv: emptyLookupEnv,
+		}
+		_, err := cmd.BuildAndPushMultiPlatform(ctx, in(), new(strings.Builder))
+
+		// THEN
+		require.EqualError(t, err, "inspect manifest list digest for aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app: some error")
+	})
+}
+
 func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 	mockError := errors.New("some error")
 	var mockCmd *MockCmd
@@ -453,7 +689,8 @@ func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 			controller := gomock.NewController(t)
 			tc.setupMocks(controller)
 			s := DockerCmdClient{
-				runner: mockCmd,
+				runner:    mockCmd,
+				lookupEnv: func(string) (string, bool) { return "", false },
 			}
 
 			err := s.CheckDockerEngineRunning()
@@ -655,12 +892,28 @@ func TestDockerCommand_Run(t *testing.T) {
 		secrets          map[string]string
 		envVars          map[string]string
 		ports            map[string]string
+		entrypoint       []string
 		command          []string
 		containerNetwork string
+		network          string
+		networkAliases   []string
+		hosts            []Host
+		capabilities     []string
+		sysctls          map[string]string
+		mounts           []Mount
+		workingDir       string
+		remove           bool
+		healthCheck      *HealthCheck
+		logDriver        *LogDriver
+		labels           map[string]string
+		platform         string
 		logPrefix        string
+		formatLine       func(string) string
+		isEntryStart     func(string) bool
 		setupMocks       func(controller *gomock.Controller)
 
 		wantedOutput []string
+		wantedTee    []string
 		wantedError  error
 	}{
 		"should error if the docker run command fails": {
@@ -691,6 +944,189 @@ func TestDockerCommand_Run(t *testing.T) {
 					"sleep", "infinity"}), gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"success with proxy options for pause container": {
+			containerName: mockPauseContainer,
+			command:       mockCommand,
+			uri:           mockImageURI,
+			hosts:         []Host{{Name: "db.example.internal", IP: "172.20.0.1"}},
+			capabilities:  []string{"NET_ADMIN"},
+			sysctls:       map[string]string{"net.ipv4.conf.all.route_localnet": "1"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockPauseContainer,
+					"--add-host", "db.example.internal:172.20.0.1",
+					"--cap-add", "NET_ADMIN",
+					"--sysctl", "net.ipv4.conf.all.route_localnet=1",
+					mockImageURI,
+					"sleep", "infinity"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"success with a pause container connected to a user-defined network": {
+			containerName:  mockPauseContainer,
+			command:        mockCommand,
+			uri:            mockImageURI,
+			network:        "copilot-app-env",
+			networkAliases: []string{"api.env.app.local", "api"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockPauseContainer,
+					"--network", "copilot-app-env",
+					"--network-alias", "api.env.app.local",
+					"--network-alias", "api",
+					mockImageURI,
+					"sleep", "infinity"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"success with bind mounts": {
+			containerName:    mockContainerName,
+			containerNetwork: mockPauseContainer,
+			uri:              mockImageURI,
+			mounts: []Mount{
+				{Source: "/ws/.copilot/local/volumes/api/efs", Target: "/data"},
+				{Source: "/ws/config", Target: "/etc/app", ReadOnly: true},
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockContainerName,
+					"--network", "container:pauseContainer",
+					"--volume", "/ws/.copilot/local/volumes/api/efs:/data",
+					"--volume", "/ws/config:/etc/app:ro",
+					mockImageURI}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"success with a container removed on exit outside of the pause container network": {
+			containerName: mockContainerName,
+			uri:           mockImageURI,
+			mounts: []Mount{
+				{Source: "/ws", Target: "/codebuild/output/src"},
+			},
+			workingDir: "/codebuild/output/src",
+			remove:     true,
+			entrypoint: []string{"/bin/sh", "-c", "make test"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockContainerName,
+					"--rm",
+					"--volume", "/ws:/codebuild/output/src",
+					"--workdir", "/codebuild/output/src",
+					"--entrypoint", "/bin/sh",
+					mockImageURI,
+					"-c", "make test"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"success with the labels of the container": {
+			containerName: mockContainerName,
+			uri:           mockImageURI,
+			labels: map[string]string{
+				"com.aws.copilot.local.workload":    "frontend",
+				"com.aws.copilot.local.application": "phonetool",
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockContainerName,
+					"--label", "com.aws.copilot.local.application=phonetool",
+					"--label", "com.aws.copilot.local.workload=frontend",
+					mockImageURI}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"success with the platform of the image": {
+			containerName:    mockContainerName,
+			containerNetwork: mockPauseContainer,
+			uri:              mockImageURI,
+			workingDir:       "/app",
+			platform:         "linux/amd64",
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockContainerName,
+					"--network", "container:pauseContainer",
+					"--workdir", "/app",
+					"--platform", "linux/amd64",
+					mockImageURI}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"success with the healthcheck of the container": {
+			containerName:    mockContainerName,
+			containerNetwork: mockPauseContainer,
+			uri:              mockImageURI,
+			healthCheck: &HealthCheck{
+				Command:     []string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"},
+				Interval:    10 * time.Second,
+				Retries:     2,
+				StartPeriod: 5 * time.Second,
+				Timeout:     3 * time.Second,
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockContainerName,
+					"--network", "container:pauseContainer",
+					"--health-cmd", "curl -f http://localhost/ || exit 1",
+					"--health-interval", "10s",
+					"--health-retries", "2",
+					"--health-start-period", "5s",
+					"--health-timeout", "3s",
+					mockImageURI}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"success with a logging driver": {
+			containerName:    mockContainerName,
+			containerNetwork: mockPauseContainer,
+			uri:              mockImageURI,
+			logDriver: &LogDriver{
+				Name: "fluentd",
+				Options: map[string]string{
+					"fluentd-address": "localhost:24224",
+				},
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockContainerName,
+					"--network", "container:pauseContainer",
+					"--log-driver", "fluentd",
+					"--log-opt", "fluentd-address=localhost:24224",
+					mockImageURI}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"success with the healthcheck of the image disabled": {
+			containerName:    mockContainerName,
+			containerNetwork: mockPauseContainer,
+			uri:              mockImageURI,
+			healthCheck: &HealthCheck{
+				Command: []string{"NONE"},
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockContainerName,
+					"--network", "container:pauseContainer",
+					"--no-healthcheck",
+					mockImageURI}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"success with the entrypoint of the image overridden": {
+			containerName:    mockContainerName,
+			containerNetwork: mockPauseContainer,
+			uri:              mockImageURI,
+			entrypoint:       []string{"dlv", "exec", "--headless", "/app"},
+			command:          []string{"--", "serve"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run",
+					"--name", mockContainerName,
+					"--network", "container:pauseContainer",
+					"--entrypoint", "dlv",
+					mockImageURI,
+					"exec", "--headless", "/app",
+					"--", "serve"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
 		"success with run options for service containers": {
 			containerName:    mockContainerName,
 			containerNetwork: mockPauseContainer,
@@ -745,6 +1181,64 @@ func TestDockerCommand_Run(t *testing.T) {
 				"[asdf] i am stderr!",
 			},
 		},
+		"should format the lines and tee them without a prefix": {
+			containerName:    mockContainerName,
+			containerNetwork: mockPauseContainer,
+			uri:              mockImageURI,
+			logPrefix:        "[asdf] ",
+			formatLine:       strings.ToUpper,
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run", "--name", mockContainerName, "--network", "container:pauseContainer", mockImageURI}, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+						cmd := &osexec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						cmd.Stdout.Write([]byte("i am stdout!"))
+						cmd.Stderr.Write([]byte("i am stderr!"))
+						return nil
+					})
+			},
+			wantedOutput: []string{
+				"[asdf] I AM STDOUT!",
+				"[asdf] I AM STDERR!",
+			},
+			wantedTee: []string{
+				"i am stdout!",
+				"i am stderr!",
+			},
+		},
+		"should print the lines that continue a log entry without the prefix nor formatting": {
+			containerName:    mockContainerName,
+			containerNetwork: mockPauseContainer,
+			uri:              mockImageURI,
+			logPrefix:        "[asdf] ",
+			formatLine:       strings.ToUpper,
+			isEntryStart: func(line string) bool {
+				return !strings.HasPrefix(line, "\t")
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run", "--name", mockContainerName, "--network", "container:pauseContainer", mockImageURI}, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+						cmd := &osexec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						cmd.Stdout.Write([]byte("exception in main\n\tat Main.java:10"))
+						return nil
+					})
+			},
+			wantedOutput: []string{
+				"[asdf] EXCEPTION IN MAIN",
+				"       \tat Main.java:10",
+			},
+			wantedTee: []string{
+				"exception in main",
+				"\tat Main.java:10",
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -766,13 +1260,32 @@ func TestDockerCommand_Run(t *testing.T) {
 				EnvVars:          tc.envVars,
 				ContainerName:    tc.containerName,
 				ContainerNetwork: tc.containerNetwork,
+				Network:          tc.network,
+				NetworkAliases:   tc.networkAliases,
+				Entrypoint:       tc.entrypoint,
 				Command:          tc.command,
 				ContainerPorts:   tc.ports,
+				Hosts:            tc.hosts,
+				Capabilities:     tc.capabilities,
+				Sysctls:          tc.sysctls,
+				Mounts:           tc.mounts,
+				WorkingDir:       tc.workingDir,
+				Remove:           tc.remove,
+				HealthCheck:      tc.healthCheck,
+				LogDriver:        tc.logDriver,
+				Labels:           tc.labels,
+				Platform:         tc.platform,
 				LogOptions: RunLogOptions{
-					LinePrefix: tc.logPrefix,
-					Output:     out,
+					LinePrefix:   tc.logPrefix,
+					Output:       out,
+					FormatLine:   tc.formatLine,
+					IsEntryStart: tc.isEntryStart,
 				},
 			}
+			tee := &bytes.Buffer{}
+			if tc.wantedTee != nil {
+				runInput.LogOptions.Tee = tee
+			}
 			err := s.Run(context.Background(), &runInput)
 
 			if tc.wantedError != nil {
@@ -783,10 +1296,501 @@ func TestDockerCommand_Run(t *testing.T) {
 			require.Nil(t, err)
 			split := strings.Split(out.String(), "\n")
 			require.ElementsMatch(t, tc.wantedOutput, split[:len(split)-1])
+			if tc.wantedTee != nil {
+				split := strings.Split(tee.String(), "\n")
+				require.ElementsMatch(t, tc.wantedTee, split[:len(split)-1])
+			}
 		})
 	}
 }
 
+func TestDockerCommand_Exec(t *testing.T) {
+	t.Run("should run the command in the container", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"exec", "pause", "iptables", "-t", "nat", "-L"}, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+				cmd := &osexec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				cmd.Stdout.Write([]byte("Chain OUTPUT"))
+				return nil
+			})
+		s := DockerCmdClient{
+			runner: mockCmd,
+		}
+		out := &bytes.Buffer{}
+
+		err := s.Exec(context.Background(), "pause", out, "iptables", "-t", "nat", "-L")
+
+		require.NoError(t, err)
+		require.Equal(t, "Chain OUTPUT", out.String())
+	})
+	t.Run("should wrap the error of the command", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"exec", "pause", "true"}, gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+		s := DockerCmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.Exec(context.Background(), "pause", &bytes.Buffer{}, "true")
+
+		require.EqualError(t, err, "run docker exec: some error")
+	})
+}
+
+func TestDockerCommand_InteractiveExec(t *testing.T) {
+	t.Run("should attach the command to the terminal", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"exec", "--interactive", "--tty", "api-app-test-api", "sh", "-l"}, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		s := DockerCmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.InteractiveExec(context.Background(), "api-app-test-api", []string{"sh", "-l"})
+
+		require.NoError(t, err)
+	})
+	t.Run("should wrap the error of the command", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().RunWithContext(gomock.Any(), "podman", []string{"exec", "--interactive", "--tty", "api-app-test-api", "sh"}, gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+		s := DockerCmdClient{
+			runner:  mockCmd,
+			runtime: "podman",
+		}
+
+		err := s.InteractiveExec(context.Background(), "api-app-test-api", []string{"sh"})
+
+		require.EqualError(t, err, "run podman exec: some error")
+	})
+}
+
+func TestDockerCommand_CopyToContainer(t *testing.T) {
+	t.Run("should copy the file to the container", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"cp", "/ws/src/app.py", "api:/app/src/app.py"}, gomock.Any(), gomock.Any()).Return(nil)
+		s := DockerCmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.CopyToContainer(context.Background(), "/ws/src/app.py", "api", "/app/src/app.py")
+
+		require.NoError(t, err)
+	})
+	t.Run("should wrap the output of the command in the error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"cp", "/ws/src/app.py", "api:/app/src/app.py"}, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+				cmd := &osexec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				cmd.Stderr.Write([]byte("Error response from daemon: No such container: api\n"))
+				return errors.New("exit status 1")
+			})
+		s := DockerCmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.CopyToContainer(context.Background(), "/ws/src/app.py", "api", "/app/src/app.py")
+
+		require.EqualError(t, err, "Error response from daemon: No such container: api: exit status 1")
+	})
+}
+
+func TestDockerCommand_Kill(t *testing.T) {
+	t.Run("should send the signal to the container", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"kill", "--signal", "SIGHUP", "api"}, gomock.Any(), gomock.Any()).Return(nil)
+		s := DockerCmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.Kill(context.Background(), "api", "SIGHUP")
+
+		require.NoError(t, err)
+	})
+	t.Run("should wrap the output of the command in the error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"kill", "--signal", "SIGHUP", "api"}, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+				cmd := &osexec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				cmd.Stderr.Write([]byte("Error response from daemon: container api is not running\n"))
+				return errors.New("exit status 1")
+			})
+		s := DockerCmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.Kill(context.Background(), "api", "SIGHUP")
+
+		require.EqualError(t, err, "Error response from daemon: container api is not running: exit status 1")
+	})
+}
+
+func TestDockerCommand_ImageExists(t *testing.T) {
+	inspectError := func(stderr string) func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+		return func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+			cmd := &osexec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			cmd.Stderr.Write([]byte(stderr))
+			return errors.New("exit status 1")
+		}
+	}
+	tests := map[string]struct {
+		setupMocks func(m *MockCmd)
+
+		wanted      bool
+		wantedError string
+	}{
+		"the image exists": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"image", "inspect", "--format", "{{.Id}}", "copilot/pause:v1.32.0"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wanted: true,
+		},
+		"the image doesn't exist": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(inspectError("Error: No such image: copilot/pause:v1.32.0\n"))
+			},
+		},
+		"the image doesn't exist in podman": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(inspectError("Error: copilot/pause:v1.32.0: image not known\n"))
+			},
+		},
+		"error if the image can't be inspected": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(inspectError("Cannot connect to the Docker daemon\n"))
+			},
+			wantedError: "run docker image inspect: exit status 1",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockCmd := NewMockCmd(ctrl)
+			tc.setupMocks(mockCmd)
+			s := DockerCmdClient{
+				runner: mockCmd,
+			}
+
+			got, err := s.ImageExists(context.Background(), "copilot/pause:v1.32.0")
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestDockerCommand_SaveImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCmd := NewMockCmd(ctrl)
+	mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"save", "copilot/pause:v1.32.0", "amazon/dynamodb-local"}, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+			cmd := &osexec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			cmd.Stdout.Write([]byte("archive"))
+			return nil
+		})
+	s := DockerCmdClient{
+		runner: mockCmd,
+	}
+	out := &bytes.Buffer{}
+
+	err := s.SaveImages(context.Background(), out, "copilot/pause:v1.32.0", "amazon/dynamodb-local")
+
+	require.NoError(t, err)
+	require.Equal(t, "archive", out.String())
+}
+
+func TestDockerCommand_LoadImages(t *testing.T) {
+	t.Run("should load the images of the archive", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"load"}, gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+				cmd := &osexec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				content, err := io.ReadAll(cmd.Stdin)
+				require.NoError(t, err)
+				require.Equal(t, "archive", string(content))
+				return nil
+			})
+		s := DockerCmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.LoadImages(context.Background(), strings.NewReader("archive"))
+
+		require.NoError(t, err)
+	})
+	t.Run("should wrap the output of the command in the error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"load"}, gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+				cmd := &osexec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				cmd.Stderr.Write([]byte("invalid tar header\n"))
+				return errors.New("exit status 1")
+			})
+		s := DockerCmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.LoadImages(context.Background(), strings.NewReader("archive"))
+
+		require.EqualError(t, err, "invalid tar header: exit status 1")
+	})
+}
+
+func TestDockerCommand_ContainerState(t *testing.T) {
+	inspectArgs := []string{"inspect", "--type", "container", "--format", "{{json .State}}", "mockContainer"}
+	writeOutput := func(stdout, stderr string, err error) func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+		return func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+			cmd := &osexec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			cmd.Stdout.Write([]byte(stdout))
+			cmd.Stderr.Write([]byte(stderr))
+			return err
+		}
+	}
+	tests := map[string]struct {
+		setupMocks func(m *MockCmd)
+
+		wantedState ContainerState
+		wantedErr   error
+	}{
+		"error if the container does not exist": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", inspectArgs, gomock.Any(), gomock.Any()).
+					DoAndReturn(writeOutput("", "Error: No such container: mockContainer", errors.New("exit status 1")))
+			},
+			wantedErr: &ErrContainerNotExist{Name: "mockContainer"},
+		},
+		"error if docker inspect fails": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", inspectArgs, gomock.Any(), gomock.Any()).
+					DoAndReturn(writeOutput("", "Cannot connect to the Docker daemon", errors.New("exit status 1")))
+			},
+			wantedErr: errors.New("run docker inspect: exit status 1"),
+		},
+		"return the state of a container without a healthcheck": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", inspectArgs, gomock.Any(), gomock.Any()).
+					DoAndReturn(writeOutput(`{"Status":"exited","Running":false,"ExitCode":3}`, "", nil))
+			},
+			wantedState: ContainerState{
+				Status:   ContainerStatusExited,
+				ExitCode: 3,
+			},
+		},
+		"return the state of a container with a healthcheck": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", inspectArgs, gomock.Any(), gomock.Any()).
+					DoAndReturn(writeOutput(`{"Status":"running","Running":true,"ExitCode":0,"Health":{"Status":"healthy","FailingStreak":0}}`, "", nil))
+			},
+			wantedState: ContainerState{
+				Status: "running",
+				Health: &ContainerHealth{
+					Status: ContainerHealthHealthy,
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := NewMockCmd(ctrl)
+			tc.setupMocks(m)
+			s := DockerCmdClient{
+				runner: m,
+			}
+
+			got, err := s.ContainerState(context.Background(), "mockContainer")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedState, got)
+		})
+	}
+}
+
+func TestDockerCommand_RunningContainers(t *testing.T) {
+	psArgs := []string{"ps", "--filter", "label=com.aws.copilot.local.workload", "--format", "{{json .}}"}
+	tests := map[string]struct {
+		stdout string
+		runErr error
+
+		wanted    []RunningContainer
+		wantedErr string
+	}{
+		"error if docker ps fails": {
+			runErr:    errors.New("exit status 1"),
+			wantedErr: "run docker ps: exit status 1",
+		},
+		"error if the output isn't JSON": {
+			stdout:    "CONTAINER ID   IMAGE\n",
+			wantedErr: "unmarshal output of docker ps: invalid character 'C' looking for beginning of value",
+		},
+		"no containers": {},
+		"return the containers with their labels": {
+			stdout: `{"Names":"pause-phonetool-test-frontend","Labels":"com.aws.copilot.local.application=phonetool,com.aws.copilot.local.workload=frontend","RunningFor":"5 minutes ago"}
+{"Names":"pause-phonetool-test-api","Labels":"com.aws.copilot.local.workload=api","RunningFor":"About an hour ago"}
+`,
+			wanted: []RunningContainer{
+				{
+					Name: "pause-phonetool-test-frontend",
+					Labels: map[string]string{
+						"com.aws.copilot.local.application": "phonetool",
+						"com.aws.copilot.local.workload":    "frontend",
+					},
+					RunningFor: "5 minutes ago",
+				},
+				{
+					Name: "pause-phonetool-test-api",
+					Labels: map[string]string{
+						"com.aws.copilot.local.workload": "api",
+					},
+					RunningFor: "About an hour ago",
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := NewMockCmd(ctrl)
+			m.EXPECT().RunWithContext(gomock.Any(), "docker", psArgs, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error {
+					cmd := &osexec.Cmd{}
+					for _, opt := range opts {
+						opt(cmd)
+					}
+					cmd.Stdout.Write([]byte(tc.stdout))
+					return tc.runErr
+				})
+			s := DockerCmdClient{
+				runner: m,
+			}
+
+			got, err := s.RunningContainers(context.Background(), "com.aws.copilot.local.workload")
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestDockerCommand_CreateNetwork(t *testing.T) {
+	tests := map[string]struct {
+		output string
+		runErr error
+
+		wantedErr error
+	}{
+		"creates the network": {},
+		"succeeds if the network already exists": {
+			output: "Error response from daemon: network with name copilot-app-env already exists\n",
+			runErr: errors.New("exit status 1"),
+		},
+		"wraps the output of the command on failure": {
+			output:    "Cannot connect to the Docker daemon\n",
+			runErr:    errors.New("exit status 1"),
+			wantedErr: errors.New("Cannot connect to the Docker daemon: exit status 1"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockCmd := NewMockCmd(ctrl)
+			mockCmd.EXPECT().Run("docker", []string{"network", "create", "copilot-app-env"}, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(name string, args []string, opts ...exec.CmdOption) error {
+					cmd := &osexec.Cmd{}
+					for _, opt := range opts {
+						opt(cmd)
+					}
+					cmd.Stderr.Write([]byte(tc.output))
+					return tc.runErr
+				})
+			s := DockerCmdClient{
+				runner: mockCmd,
+			}
+
+			err := s.CreateNetwork("copilot-app-env")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDockerCommand_RemoveNetwork(t *testing.T) {
+	t.Run("should remove the network", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().Run("docker", []string{"network", "rm", "copilot-app-env"}, gomock.Any(), gomock.Any()).Return(nil)
+		s := DockerCmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.RemoveNetwork("copilot-app-env")
+
+		require.NoError(t, err)
+	})
+	t.Run("should wrap the error of the command", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().Run("docker", []string{"network", "rm", "copilot-app-env"}, gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+		s := DockerCmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.RemoveNetwork("copilot-app-env")
+
+		require.EqualError(t, err, ": some error")
+	})
+}
+
 func TestDockerCommand_IsContainerRunning(t *testing.T) {
 	mockError := errors.New("some error")
 	mockContainerName := "mockContainer"
@@ -803,7 +1807,7 @@ func TestDockerCommand_IsContainerRunning(t *testing.T) {
 			inContainerName: mockUnknownContainerName,
 			setupMocks: func(controller *gomock.Controller) {
 				mockCmd = NewMockCmd(controller)
-				mockCmd.EXPECT().Run("docker", []string{"ps", "-q", "--filter", "name=mockUnknownContainer"}, gomock.Any()).Return(mockError)
+				mockCmd.EXPECT().Run("docker", []string{"ps", "-q", "--filter", "name=^/?mockUnknownContainer$"}, gomock.Any()).Return(mockError)
 			},
 
 			wantedErr: fmt.Errorf("run docker ps: some error"),
@@ -812,7 +1816,7 @@ func TestDockerCommand_IsContainerRunning(t *testing.T) {
 			inContainerName: mockContainerName,
 			setupMocks: func(controller *gomock.Controller) {
 				mockCmd = NewMockCmd(controller)
-				mockCmd.EXPECT().Run("docker", []string{"ps", "-q", "--filter", "name=mockContainer"}, gomock.Any()).Return(nil)
+				mockCmd.EXPECT().Run("docker", []string{"ps", "-q", "--filter", "name=^/?mockContainer$"}, gomock.Any()).Return(nil)
 			},
 		},
 	}
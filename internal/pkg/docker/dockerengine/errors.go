@@ -6,16 +6,77 @@ package dockerengine
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // ErrDockerCommandNotFound means the docker command is not found.
 var ErrDockerCommandNotFound = errors.New("docker: command not found")
 
+// errRuntimeCommandNotFound means the CLI of a container runtime other than docker is not found.
+// It matches ErrDockerCommandNotFound so that callers handle every runtime the same way.
+type errRuntimeCommandNotFound struct {
+	runtime string
+}
+
+func (e *errRuntimeCommandNotFound) Error() string {
+	return fmt.Sprintf("%s: command not found", e.runtime)
+}
+
+// Is returns true if the target is ErrDockerCommandNotFound.
+func (e *errRuntimeCommandNotFound) Is(target error) bool {
+	return target == ErrDockerCommandNotFound
+}
+
+// ErrPackCommandNotFound means the pack command, which builds images with Cloud Native Buildpacks, is not found.
+type ErrPackCommandNotFound struct{}
+
+func (e *ErrPackCommandNotFound) Error() string {
+	return "pack: command not found"
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *ErrPackCommandNotFound) RecommendActions() string {
+	return "Install the pack CLI to build images with a buildpack: https://buildpacks.io/docs/tools/pack/"
+}
+
+// ErrContainerNotExist means that the container doesn't exist.
+type ErrContainerNotExist struct {
+	Name string
+}
+
+func (e *ErrContainerNotExist) Error() string {
+	return fmt.Sprintf("container %s does not exist", e.Name)
+}
+
 // ErrDockerDaemonNotResponsive means the docker daemon is not responsive.
 type ErrDockerDaemonNotResponsive struct {
 	msg string
+
+	dockerHost string         // Value of the DOCKER_HOST environment variable, if set.
+	sockets    []dockerSocket // Sockets of other container runtimes found on the machine.
 }
 
 func (e ErrDockerDaemonNotResponsive) Error() string {
 	return fmt.Sprintf("docker daemon is not responsive: %s", e.msg)
 }
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e ErrDockerDaemonNotResponsive) RecommendActions() string {
+	if e.dockerHost != "" {
+		return fmt.Sprintf(`DOCKER_HOST is set to %q.
+Make sure that the daemon listening on it is running, or unset DOCKER_HOST to use the default socket.`, e.dockerHost)
+	}
+	if len(e.sockets) == 0 {
+		return `Start Docker Desktop or your docker daemon, for example with "sudo systemctl start docker".
+If you use another container runtime like colima or finch, start it and set DOCKER_HOST to its socket.`
+	}
+	msgs := []string{"Found sockets of other container runtimes that the docker CLI is not configured to use:"}
+	for _, socket := range e.sockets {
+		msgs = append(msgs, fmt.Sprintf("  - %s: %s", socket.runtime, socket.path))
+	}
+	msgs = append(msgs, "To use one of them, start the runtime if it's stopped and point the docker CLI to its socket. For example:")
+	msgs = append(msgs, fmt.Sprintf("  export DOCKER_HOST=unix://%s", e.sockets[0].path))
+	return strings.Join(msgs, "\n")
+}
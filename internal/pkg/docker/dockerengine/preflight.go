@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerengine
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// minFreeDiskSpace is the free disk space under which builds are likely to fail.
+const minFreeDiskSpace = 2 << 30 // 2 GiB.
+
+const securityOptionRootless = "name=rootless"
+
+// dockerInfo holds the fields of `docker info` used by preflight checks.
+type dockerInfo struct {
+	ServerErrors    []string `json:"ServerErrors"`
+	OSType          string   `json:"OSType"`
+	Architecture    string   `json:"Architecture"`
+	DockerRootDir   string   `json:"DockerRootDir"`
+	SecurityOptions []string `json:"SecurityOptions"`
+	ClientInfo      *struct {
+		Plugins []struct {
+			Name string `json:"Name"`
+		} `json:"Plugins"`
+	} `json:"ClientInfo"`
+
+	// Podman reports the host of its containers instead of the fields above.
+	Host *struct {
+		OS       string `json:"os"`
+		Arch     string `json:"arch"`
+		Security struct {
+			Rootless bool `json:"rootless"`
+		} `json:"security"`
+	} `json:"host"`
+}
+
+// dockerSocket is the socket of a container runtime with a docker-compatible API.
+type dockerSocket struct {
+	runtime string
+	path    string
+}
+
+// PreflightWarning is a problem with the docker engine that doesn't prevent building images,
+// but that can make builds fail or behave unexpectedly.
+type PreflightWarning struct {
+	Problem     string
+	Remediation string
+}
+
+// String implements the fmt.Stringer interface.
+func (w PreflightWarning) String() string {
+	return fmt.Sprintf("%s\n%s", w.Problem, w.Remediation)
+}
+
+// Preflight checks that the docker engine is running and can build images for the target platforms.
+// It returns an error if images can't be built at all, and warnings for problems that are worth fixing.
+func (c DockerCmdClient) Preflight(platforms []string) ([]PreflightWarning, error) {
+	info, err := c.info()
+	if err != nil {
+		return nil, err
+	}
+	var warnings []PreflightWarning
+	if info.isRootless() {
+		problem := "The docker daemon is running in rootless mode."
+		if runtime := c.Runtime(); runtime != RuntimeDocker {
+			problem = fmt.Sprintf("The %s engine is running in rootless mode.", runtime)
+		}
+		warnings = append(warnings, PreflightWarning{
+			Problem:     problem,
+			Remediation: `Containers can't publish ports below 1024 in rootless mode. To allow them, run "sudo sysctl net.ipv4.ip_unprivileged_port_start=0".`,
+		})
+	}
+	// The other runtimes build images with their own builder instead of a plugin.
+	hasBuildx := c.Runtime() != RuntimeDocker || info.hasPlugin("buildx")
+	if !hasBuildx {
+		warnings = append(warnings, PreflightWarning{
+			Problem:     "The docker buildx plugin is not installed.",
+			Remediation: "Install buildx to build images with BuildKit and for other platforms: https://docs.docker.com/go/buildx/",
+		})
+	}
+	_, engineArch, _ := info.platform()
+	for _, platform := range mismatchedPlatforms(engineArch, platforms) {
+		remediation := `If the build fails with "exec format error", install emulators with "docker run --privileged --rm tonistiigi/binfmt --install all".`
+		if !hasBuildx {
+			remediation = "Install buildx and the QEMU emulators to build images for another architecture, or build on a machine that matches the platform."
+		}
+		warnings = append(warnings, PreflightWarning{
+			Problem:     fmt.Sprintf("Building images for platform %s on a %s engine with architecture %s requires emulation, which can be slow.", platform, c.Runtime(), engineArch),
+			Remediation: remediation,
+		})
+	}
+	// The root directory is only on the local file system when the daemon isn't running inside a VM.
+	if info.DockerRootDir != "" {
+		if free, err := c.freeDiskSpace(info.DockerRootDir); err == nil && free < minFreeDiskSpace {
+			warnings = append(warnings, PreflightWarning{
+				Problem:     fmt.Sprintf("Only %.1f GiB of disk space is left in %s.", float64(free)/(1<<30), info.DockerRootDir),
+				Remediation: fmt.Sprintf(`Free up disk space by removing unused images and build cache with "%s system prune".`, c.Runtime()),
+			})
+		}
+	}
+	return warnings, nil
+}
+
+// platform returns the OS and architecture of the engine, in the format used in platforms.
+func (i *dockerInfo) platform() (os, arch string, err error) {
+	if i.Host != nil {
+		return i.Host.OS, normalizeArch(i.Host.Arch), nil
+	}
+	if i.OSType == "" && i.Architecture == "" {
+		return "", "", errors.New("the engine doesn't report its platform")
+	}
+	return i.OSType, normalizeArch(i.Architecture), nil
+}
+
+func (i *dockerInfo) isRootless() bool {
+	if i.Host != nil {
+		return i.Host.Security.Rootless
+	}
+	for _, opt := range i.SecurityOptions {
+		if opt == securityOptionRootless {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPlugin returns true if the CLI plugin is installed.
+// Older docker CLIs don't report their plugins, in which case the plugin is assumed to be installed.
+func (i *dockerInfo) hasPlugin(name string) bool {
+	if i.ClientInfo == nil {
+		return true
+	}
+	for _, plugin := range i.ClientInfo.Plugins {
+		if plugin.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeArch converts the architecture reported by `docker info`, such as "x86_64", to the one used in platforms.
+func normalizeArch(arch string) string {
+	switch arch {
+	case ArchX86:
+		return ArchAMD64
+	case "aarch64":
+		return ArchARM64
+	}
+	return arch
+}
+
+// mismatchedPlatforms returns the linux platforms whose architecture differs from the engine's,
+// including each of the platforms of a multi-platform build such as "linux/amd64,linux/arm64".
+func mismatchedPlatforms(engineArch string, platforms []string) []string {
+	if engineArch == "" {
+		return nil
+	}
+	var split []string
+	for _, platform := range platforms {
+		split = append(split, strings.Split(platform, ",")...)
+	}
+	var mismatched []string
+	seen := make(map[string]bool)
+	for _, platform := range split {
+		parts := strings.Split(platform, "/")
+		if len(parts) < 2 || parts[0] != OSLinux || seen[platform] {
+			continue
+		}
+		seen[platform] = true
+		if normalizeArch(parts[1]) != engineArch {
+			mismatched = append(mismatched, platform)
+		}
+	}
+	return mismatched
+}
+
+// detectDockerSockets returns the sockets of common docker-compatible runtimes that exist on the machine.
+func (c DockerCmdClient) detectDockerSockets() []dockerSocket {
+	var candidates []dockerSocket
+	if c.homePath != "" {
+		candidates = append(candidates,
+			dockerSocket{runtime: "colima", path: filepath.Join(c.homePath, ".colima", "default", "docker.sock")},
+			dockerSocket{runtime: "Rancher Desktop", path: filepath.Join(c.homePath, ".rd", "docker.sock")},
+		)
+	}
+	if dir, _ := c.lookupEnv("XDG_RUNTIME_DIR"); dir != "" {
+		candidates = append(candidates, dockerSocket{runtime: "rootless docker", path: filepath.Join(dir, "docker.sock")})
+	}
+	candidates = append(candidates, dockerSocket{runtime: "finch", path: "/Applications/Finch/lima/data/finch/sock/finch.sock"})
+
+	var found []dockerSocket
+	for _, candidate := range candidates {
+		if _, err := os.Stat(candidate.path); err == nil {
+			found = append(found, candidate)
+		}
+	}
+	return found
+}
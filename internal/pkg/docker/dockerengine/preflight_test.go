@@ -0,0 +1,173 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerengine
+
+import (
+	"errors"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDockerCommand_Preflight(t *testing.T) {
+	const buildxInfo = `"ClientInfo":{"Plugins":[{"Name":"buildx"},{"Name":"compose"}]}`
+	testCases := map[string]struct {
+		inInfo        string
+		inPlatforms   []string
+		inFreeDisk    uint64
+		inFreeDiskErr error
+
+		wantedWarnings []PreflightWarning
+		wantedErr      error
+	}{
+		"no warnings for a healthy engine": {
+			inInfo:      `'{"Architecture":"x86_64","DockerRootDir":"/var/lib/docker",` + buildxInfo + `}'`,
+			inPlatforms: []string{"linux/amd64", "linux/x86_64", ""},
+			inFreeDisk:  10 << 30,
+		},
+		"error if the daemon is not responsive": {
+			inInfo:    `'{"ServerErrors":["Cannot connect to the Docker daemon at unix:///var/run/docker.sock."]}'`,
+			wantedErr: errors.New("docker daemon is not responsive: Cannot connect to the Docker daemon at unix:///var/run/docker.sock."),
+		},
+		"warns about rootless mode, a missing buildx and low disk space": {
+			inInfo:     `'{"Architecture":"x86_64","DockerRootDir":"/home/user/.local/share/docker","SecurityOptions":["name=seccomp,profile=builtin","name=rootless"],"ClientInfo":{"Plugins":[]}}'`,
+			inFreeDisk: 1 << 29,
+			wantedWarnings: []PreflightWarning{
+				{
+					Problem:     "The docker daemon is running in rootless mode.",
+					Remediation: `Containers can't publish ports below 1024 in rootless mode. To allow them, run "sudo sysctl net.ipv4.ip_unprivileged_port_start=0".`,
+				},
+				{
+					Problem:     "The docker buildx plugin is not installed.",
+					Remediation: "Install buildx to build images with BuildKit and for other platforms: https://docs.docker.com/go/buildx/",
+				},
+				{
+					Problem:     "Only 0.5 GiB of disk space is left in /home/user/.local/share/docker.",
+					Remediation: `Free up disk space by removing unused images and build cache with "docker system prune".`,
+				},
+			},
+		},
+		"warns once per platform that doesn't match the engine architecture": {
+			inInfo:        `'{"Architecture":"aarch64","DockerRootDir":"/var/lib/docker",` + buildxInfo + `}'`,
+			inPlatforms:   []string{"linux/amd64", "linux/arm64", "linux/amd64", "windows/x86_64"},
+			inFreeDiskErr: errors.New("no such file or directory"),
+			wantedWarnings: []PreflightWarning{
+				{
+					Problem:     "Building images for platform linux/amd64 on a docker engine with architecture arm64 requires emulation, which can be slow.",
+					Remediation: `If the build fails with "exec format error", install emulators with "docker run --privileged --rm tonistiigi/binfmt --install all".`,
+				},
+			},
+		},
+		"warns about the platforms of a multi-platform build that don't match the engine architecture": {
+			inInfo:        `'{"Architecture":"x86_64","DockerRootDir":"/var/lib/docker",` + buildxInfo + `}'`,
+			inPlatforms:   []string{"linux/amd64,linux/arm64"},
+			inFreeDiskErr: errors.New("no such file or directory"),
+			wantedWarnings: []PreflightWarning{
+				{
+					Problem:     "Building images for platform linux/arm64 on a docker engine with architecture amd64 requires emulation, which can be slow.",
+					Remediation: `If the build fails with "exec format error", install emulators with "docker run --privileged --rm tonistiigi/binfmt --install all".`,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			mockCmd := NewMockCmd(ctrl)
+			mockCmd.EXPECT().Run("docker", []string{"info", "-f", "'{{json .}}'"}, gomock.Any()).
+				Do(func(_ string, _ []string, opt exec.CmdOption) {
+					cmd := &osexec.Cmd{}
+					opt(cmd)
+					_, _ = cmd.Stdout.Write([]byte(tc.inInfo))
+				}).Return(nil)
+			s := DockerCmdClient{
+				runner:    mockCmd,
+				lookupEnv: func(string) (string, bool) { return "", false },
+				freeDiskSpace: func(string) (uint64, error) {
+					return tc.inFreeDisk, tc.inFreeDiskErr
+				},
+			}
+
+			// WHEN
+			warnings, err := s.Preflight(tc.inPlatforms)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWarnings, warnings)
+		})
+	}
+}
+
+func TestErrDockerDaemonNotResponsive_RecommendActions(t *testing.T) {
+	home := t.TempDir()
+	colimaSocket := filepath.Join(home, ".colima", "default", "docker.sock")
+	require.NoError(t, os.MkdirAll(filepath.Dir(colimaSocket), 0755))
+	require.NoError(t, os.WriteFile(colimaSocket, nil, 0600))
+
+	testCases := map[string]struct {
+		inHome string
+		inEnv  map[string]string
+
+		wanted string
+	}{
+		"DOCKER_HOST is set": {
+			inHome: home,
+			inEnv:  map[string]string{"DOCKER_HOST": "tcp://127.0.0.1:2375"},
+			wanted: `DOCKER_HOST is set to "tcp://127.0.0.1:2375".
+Make sure that the daemon listening on it is running, or unset DOCKER_HOST to use the default socket.`,
+		},
+		"suggests the socket of another runtime": {
+			inHome: home,
+			wanted: `Found sockets of other container runtimes that the docker CLI is not configured to use:
+  - colima: ` + colimaSocket + `
+To use one of them, start the runtime if it's stopped and point the docker CLI to its socket. For example:
+  export DOCKER_HOST=unix://` + colimaSocket,
+		},
+		"no other runtime is found": {
+			inHome: t.TempDir(),
+			inEnv:  map[string]string{"XDG_RUNTIME_DIR": t.TempDir()},
+			wanted: `Start Docker Desktop or your docker daemon, for example with "sudo systemctl start docker".
+If you use another container runtime like colima or finch, start it and set DOCKER_HOST to its socket.`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			if _, err := os.Stat("/Applications/Finch/lima/data/finch/sock/finch.sock"); err == nil {
+				t.Skip("finch is installed")
+			}
+			s := DockerCmdClient{
+				homePath: tc.inHome,
+				lookupEnv: func(key string) (string, bool) {
+					v, ok := tc.inEnv[key]
+					return v, ok
+				},
+			}
+			dockerHost, _ := s.lookupEnv("DOCKER_HOST")
+			err := ErrDockerDaemonNotResponsive{
+				msg:        "some error",
+				dockerHost: dockerHost,
+				sockets:    s.detectDockerSockets(),
+			}
+
+			// WHEN
+			got := err.RecommendActions()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerengine
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Container runtimes with a docker-compatible CLI that the client can run.
+const (
+	RuntimeDocker = "docker"
+	RuntimeFinch  = "finch"
+	RuntimePodman = "podman"
+)
+
+// EnvVarContainerRuntime is the environment variable that selects the container runtime instead of detecting it.
+// Its value is the name of a runtime, such as "podman", or the path to its CLI.
+const EnvVarContainerRuntime = "COPILOT_CONTAINER_RUNTIME"
+
+// runtimesByPreference are the runtimes that are detected, in order, when no runtime is selected.
+var runtimesByPreference = []string{RuntimeDocker, RuntimeFinch, RuntimePodman}
+
+// detectRuntime returns the command of the container runtime selected by EnvVarContainerRuntime.
+// Otherwise, it returns the first runtime whose CLI is installed, and defaults to docker if none is.
+func detectRuntime(lookupEnv func(string) (string, bool), lookPath func(string) (string, error)) string {
+	if runtime, _ := lookupEnv(EnvVarContainerRuntime); strings.TrimSpace(runtime) != "" {
+		return strings.TrimSpace(runtime)
+	}
+	for _, runtime := range runtimesByPreference {
+		if _, err := lookPath(runtime); err == nil {
+			return runtime
+		}
+	}
+	return RuntimeDocker
+}
+
+// cmd returns the command of the container runtime. The zero value of the client runs docker.
+func (c DockerCmdClient) cmd() string {
+	if c.runtime == "" {
+		return RuntimeDocker
+	}
+	return c.runtime
+}
+
+// Runtime returns the name of the container runtime that the client runs, such as "docker" or "podman".
+func (c DockerCmdClient) Runtime() string {
+	name := strings.ToLower(filepath.Base(c.cmd()))
+	return strings.TrimSuffix(name, ".exe")
+}
+
+func (c DockerCmdClient) errCommandNotFound() error {
+	if c.Runtime() == RuntimeDocker {
+		return ErrDockerCommandNotFound
+	}
+	return &errRuntimeCommandNotFound{
+		runtime: c.cmd(),
+	}
+}
+
+// credentialsConfigPaths returns the configuration files of the runtime that can enable credential helpers,
+// relative to the home directory unless they are absolute.
+func (c DockerCmdClient) credentialsConfigPaths() []string {
+	docker := []string{filepath.Join(".docker", "config.json"), ".dockercfg"}
+	switch c.Runtime() {
+	case RuntimeFinch:
+		return []string{filepath.Join(".finch", "config.json")}
+	case RuntimePodman:
+		// Podman reads its own auth file first, and falls back to the configuration of docker.
+		var paths []string
+		if file, _ := c.lookupEnv("REGISTRY_AUTH_FILE"); file != "" {
+			paths = append(paths, file)
+		}
+		if dir, _ := c.lookupEnv("XDG_RUNTIME_DIR"); dir != "" {
+			paths = append(paths, filepath.Join(dir, "containers", "auth.json"))
+		}
+		paths = append(paths, filepath.Join(".config", "containers", "auth.json"))
+		return append(paths, docker...)
+	}
+	return docker
+}
@@ -0,0 +1,335 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerengine
+
+import (
+	"context"
+	"errors"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRuntimeOnPath puts an executable with the name of the runtime on the PATH, so that the client finds its CLI.
+func fakeRuntimeOnPath(t *testing.T, name string) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake executables are shell scripts")
+	}
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), 0755))
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestDetectRuntime(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv       map[string]string
+		inInstalled []string
+
+		wanted string
+	}{
+		"selected by the environment variable": {
+			inEnv: map[string]string{
+				EnvVarContainerRuntime: "podman",
+			},
+			inInstalled: []string{"docker", "podman"},
+			wanted:      "podman",
+		},
+		"selected by the environment variable with the path to its CLI": {
+			inEnv: map[string]string{
+				EnvVarContainerRuntime: " /opt/finch/bin/finch ",
+			},
+			wanted: "/opt/finch/bin/finch",
+		},
+		"prefers docker if several runtimes are installed": {
+			inInstalled: []string{"podman", "finch", "docker"},
+			wanted:      "docker",
+		},
+		"detects finch": {
+			inInstalled: []string{"podman", "finch"},
+			wanted:      "finch",
+		},
+		"detects podman": {
+			inInstalled: []string{"podman"},
+			wanted:      "podman",
+		},
+		"defaults to docker if no runtime is installed": {
+			wanted: "docker",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			lookupEnv := func(key string) (string, bool) {
+				v, ok := tc.inEnv[key]
+				return v, ok
+			}
+			lookPath := func(file string) (string, error) {
+				for _, installed := range tc.inInstalled {
+					if installed == file {
+						return "/usr/bin/" + file, nil
+					}
+				}
+				return "", osexec.ErrNotFound
+			}
+
+			require.Equal(t, tc.wanted, detectRuntime(lookupEnv, lookPath))
+		})
+	}
+}
+
+func TestDockerCmdClient_Runtime(t *testing.T) {
+	require.Equal(t, "docker", DockerCmdClient{}.Runtime())
+	require.Equal(t, "podman", DockerCmdClient{runtime: "podman"}.Runtime())
+	require.Equal(t, "finch", DockerCmdClient{runtime: "/opt/finch/bin/finch"}.Runtime())
+	require.Equal(t, "podman", DockerCmdClient{runtime: "Podman.exe"}.Runtime())
+}
+
+func TestDockerCmdClient_RuntimeCommands(t *testing.T) {
+	ctx := context.Background()
+	t.Run("runs the CLI of the runtime", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("/opt/finch/bin/finch", []string{"stop", "frontend"}, gomock.Any(), gomock.Any()).Return(nil)
+
+		err := DockerCmdClient{runner: m, runtime: "/opt/finch/bin/finch"}.Stop("frontend")
+
+		require.NoError(t, err)
+	})
+	t.Run("podman builds without the progress option in a CI environment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := NewMockCmd(ctrl)
+		m.EXPECT().RunWithContext(ctx, "podman", []string{"build", "-t", "uri:latest", "api", "-f", "api/Dockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+		s := DockerCmdClient{
+			runner:  m,
+			runtime: "podman",
+			lookupEnv: func(key string) (string, bool) {
+				return "true", key == "CI"
+			},
+		}
+
+		err := s.Build(ctx, &BuildArguments{
+			URI:        "uri",
+			Tags:       []string{"latest"},
+			Dockerfile: "api/Dockerfile",
+		}, new(strings.Builder))
+
+		require.NoError(t, err)
+	})
+	t.Run("only docker builds images for several platforms", func(t *testing.T) {
+		_, err := DockerCmdClient{runtime: "podman"}.BuildAndPushMultiPlatform(ctx, &BuildArguments{
+			URI:        "uri",
+			Tags:       []string{"latest"},
+			Dockerfile: "api/Dockerfile",
+			Platform:   "linux/amd64,linux/arm64",
+		}, new(strings.Builder))
+
+		require.EqualError(t, err, "build image for platforms linux/amd64,linux/arm64: an image for several platforms can only be built with docker buildx, not podman")
+	})
+	t.Run("lists the running containers of podman", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := NewMockCmd(ctrl)
+		m.EXPECT().RunWithContext(ctx, "podman", []string{"ps", "--filter", "label=com.aws.copilot.local.workload", "--format", "{{json .}}"}, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []string, opts ...exec.CmdOption) error {
+				cmd := &osexec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				_, _ = cmd.Stdout.Write([]byte(`{"Names":["pause-phonetool-test-api"],"Labels":{"com.aws.copilot.local.workload":"api"},"RunningFor":"5 minutes ago"}
+{"Names":["pause-phonetool-test-web"],"Labels":null}
+`))
+				return nil
+			})
+
+		got, err := DockerCmdClient{runner: m, runtime: "podman"}.RunningContainers(ctx, "com.aws.copilot.local.workload")
+
+		require.NoError(t, err)
+		require.Equal(t, []RunningContainer{
+			{
+				Name: "pause-phonetool-test-api",
+				Labels: map[string]string{
+					"com.aws.copilot.local.workload": "api",
+				},
+				RunningFor: "5 minutes ago",
+			},
+			{
+				Name:   "pause-phonetool-test-web",
+				Labels: map[string]string{},
+			},
+		}, got)
+	})
+	t.Run("reports lowercase missing containers of podman", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := NewMockCmd(ctrl)
+		m.EXPECT().RunWithContext(ctx, "podman", gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []string, opts ...exec.CmdOption) error {
+				cmd := &osexec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				_, _ = cmd.Stderr.Write([]byte("Error: no such container api\n"))
+				return errors.New("exit status 125")
+			})
+
+		_, err := DockerCmdClient{runner: m, runtime: "podman"}.ContainerState(ctx, "api")
+
+		var errNotExist *ErrContainerNotExist
+		require.ErrorAs(t, err, &errNotExist)
+	})
+}
+
+func TestDockerCmdClient_RuntimePlatform(t *testing.T) {
+	testCases := map[string]struct {
+		inRuntime string
+		inInfo    string
+
+		wantedOS   string
+		wantedArch string
+		wantedErr  string
+	}{
+		"podman reports the platform of its host": {
+			inRuntime:  "podman",
+			inInfo:     `{"host":{"arch":"arm64","os":"linux","security":{"rootless":true}},"store":{"graphRoot":"/var/lib/containers/storage"}}`,
+			wantedOS:   "linux",
+			wantedArch: "arm64",
+		},
+		"finch reports the platform of its VM like docker info": {
+			inRuntime:  "finch",
+			inInfo:     `{"OSType":"linux","Architecture":"aarch64"}`,
+			wantedOS:   "linux",
+			wantedArch: "arm64",
+		},
+		"error if the platform isn't reported": {
+			inRuntime: "finch",
+			inInfo:    `{"ID":"abc"}`,
+			wantedErr: "the engine doesn't report its platform",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fakeRuntimeOnPath(t, tc.inRuntime)
+			ctrl := gomock.NewController(t)
+			m := NewMockCmd(ctrl)
+			m.EXPECT().Run(tc.inRuntime, []string{"info", "-f", "'{{json .}}'"}, gomock.Any()).
+				Do(func(_ string, _ []string, opt exec.CmdOption) {
+					cmd := &osexec.Cmd{}
+					opt(cmd)
+					_, _ = cmd.Stdout.Write([]byte(tc.inInfo))
+				}).Return(nil)
+			s := DockerCmdClient{
+				runner:    m,
+				runtime:   tc.inRuntime,
+				lookupEnv: func(string) (string, bool) { return "", false },
+			}
+
+			os, arch, err := s.GetPlatform()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOS, os)
+			require.Equal(t, tc.wantedArch, arch)
+		})
+	}
+}
+
+func TestDockerCmdClient_RuntimePreflight(t *testing.T) {
+	fakeRuntimeOnPath(t, "podman")
+	ctrl := gomock.NewController(t)
+	m := NewMockCmd(ctrl)
+	m.EXPECT().Run("podman", []string{"info", "-f", "'{{json .}}'"}, gomock.Any()).
+		Do(func(_ string, _ []string, opt exec.CmdOption) {
+			cmd := &osexec.Cmd{}
+			opt(cmd)
+			_, _ = cmd.Stdout.Write([]byte(`{"host":{"arch":"amd64","os":"linux","security":{"rootless":true}}}`))
+		}).Return(nil)
+	s := DockerCmdClient{
+		runner:    m,
+		runtime:   "podman",
+		lookupEnv: func(string) (string, bool) { return "", false },
+	}
+
+	warnings, err := s.Preflight([]string{"linux/arm64"})
+
+	require.NoError(t, err)
+	require.Equal(t, []PreflightWarning{
+		{
+			Problem:     "The podman engine is running in rootless mode.",
+			Remediation: `Containers can't publish ports below 1024 in rootless mode. To allow them, run "sudo sysctl net.ipv4.ip_unprivileged_port_start=0".`,
+		},
+		{
+			Problem:     "Building images for platform linux/arm64 on a podman engine with architecture amd64 requires emulation, which can be slow.",
+			Remediation: `If the build fails with "exec format error", install emulators with "docker run --privileged --rm tonistiigi/binfmt --install all".`,
+		},
+	}, warnings)
+}
+
+func TestDockerCmdClient_RuntimeCommandNotFound(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	_, _, err := DockerCmdClient{runtime: "podman"}.GetPlatform()
+
+	require.EqualError(t, err, "podman: command not found")
+	require.ErrorIs(t, err, ErrDockerCommandNotFound)
+}
+
+func TestDockerCmdClient_IsEcrCredentialHelperEnabled_Runtimes(t *testing.T) {
+	const uri = "dummyaccountid.dkr.ecr.region.amazonaws.com/ui/app"
+	ecrLogin := []byte(`{"credHelpers":{"dummyaccountid.dkr.ecr.region.amazonaws.com":"ecr-login"}}`)
+	testCases := map[string]struct {
+		inRuntime    string
+		inConfigPath string // Relative to the home directory.
+		inXDGRuntime bool
+
+		wanted bool
+	}{
+		"finch reads its own configuration": {
+			inRuntime:    "finch",
+			inConfigPath: filepath.Join(".finch", "config.json"),
+			wanted:       true,
+		},
+		"finch ignores the configuration of docker": {
+			inRuntime:    "finch",
+			inConfigPath: filepath.Join(".docker", "config.json"),
+		},
+		"podman reads its auth file in the runtime directory": {
+			inRuntime:    "podman",
+			inConfigPath: filepath.Join("run", "containers", "auth.json"),
+			inXDGRuntime: true,
+			wanted:       true,
+		},
+		"podman falls back to the configuration of docker": {
+			inRuntime:    "podman",
+			inConfigPath: filepath.Join(".docker", "config.json"),
+			wanted:       true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			home := t.TempDir()
+			path := filepath.Join(home, tc.inConfigPath)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+			require.NoError(t, os.WriteFile(path, ecrLogin, 0644))
+			s := DockerCmdClient{
+				runtime:  tc.inRuntime,
+				homePath: home,
+				lookupEnv: func(key string) (string, bool) {
+					if key == "XDG_RUNTIME_DIR" && tc.inXDGRuntime {
+						return filepath.Join(home, "run"), true
+					}
+					return "", false
+				},
+			}
+
+			require.Equal(t, tc.wanted, s.IsEcrCredentialHelperEnabled(uri))
+		})
+	}
+}
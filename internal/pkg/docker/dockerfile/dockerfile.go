@@ -69,6 +69,7 @@ type HealthCheck struct {
 type Dockerfile struct {
 	exposedPorts []Port
 	healthCheck  *HealthCheck
+	baseImages   []string
 	parsed       bool
 	path         string
 
@@ -121,6 +122,17 @@ func (df *Dockerfile) GetHealthCheck() (*HealthCheck, error) {
 	return df.healthCheck, nil
 }
 
+// GetBaseImages returns the images of the FROM instructions in the Dockerfile, in order.
+// Stages that are built from an earlier stage of the Dockerfile are skipped.
+func (df *Dockerfile) GetBaseImages() ([]string, error) {
+	if !df.parsed {
+		if err := df.parse(); err != nil {
+			return nil, err
+		}
+	}
+	return df.baseImages, nil
+}
+
 // parse takes a Dockerfile and fills in struct members based on methods like parseExpose and parseHealthcheck.
 func (df *Dockerfile) parse() error {
 	if df.parsed {
@@ -146,6 +158,7 @@ func (df *Dockerfile) parse() error {
 
 	df.exposedPorts = parsedDockerfile.exposedPorts
 	df.healthCheck = parsedDockerfile.healthCheck
+	df.baseImages = parsedDockerfile.baseImages
 	df.parsed = true
 	return nil
 }
@@ -155,6 +168,7 @@ func parse(name, content string) (*Dockerfile, error) {
 	var df Dockerfile
 	df.exposedPorts = []Port{}
 
+	stages := make(map[string]bool)
 	lexer := lex(strings.NewReader(content))
 	for {
 		instr := lexer.next()
@@ -172,10 +186,37 @@ func parse(name, content string) (*Dockerfile, error) {
 				return nil, err
 			}
 			df.healthCheck = hc
+		case instrFrom:
+			image, stage := parseFrom(instr.args)
+			if image != "" && !stages[strings.ToLower(image)] {
+				df.baseImages = append(df.baseImages, image)
+			}
+			if stage != "" {
+				stages[strings.ToLower(stage)] = true
+			}
 		}
 	}
 }
 
+// parseFrom returns the image and the optional name of the stage of a FROM instruction,
+// in the format of "[--platform=<platform>] <image> [AS <name>]".
+func parseFrom(content string) (image, stage string) {
+	var args []string
+	for _, arg := range strings.Fields(content) {
+		if strings.HasPrefix(arg, "--") {
+			continue
+		}
+		args = append(args, arg)
+	}
+	if len(args) == 0 {
+		return "", ""
+	}
+	if len(args) == 3 && strings.EqualFold(args[1], "as") {
+		return args[0], args[2]
+	}
+	return args[0], ""
+}
+
 func parseExpose(line string) []Port {
 	// group 0: whole match
 	// group 1: port
@@ -277,6 +277,49 @@ HEALTHCHECK   CMD     ["a",    "b"]
 	}
 }
 
+func TestDockerfile_GetBaseImages(t *testing.T) {
+	testCases := map[string]struct {
+		dockerfile []byte
+		wanted     []string
+	}{
+		"no FROM instruction": {
+			dockerfile: []byte(`EXPOSE 80`),
+		},
+		"single stage": {
+			dockerfile: []byte(`
+from public.ecr.aws/docker/library/node:18
+EXPOSE 80
+`),
+			wanted: []string{"public.ecr.aws/docker/library/node:18"},
+		},
+		"multi-stage with platforms and stage names": {
+			dockerfile: []byte(`
+FROM --platform=linux/amd64 golang:1.21 AS build
+RUN go build -o /app .
+
+FROM build AS test
+RUN go test ./...
+
+FROM gcr.io/distroless/base
+COPY --from=build /app /app
+`),
+			wanted: []string{"golang:1.21", "gcr.io/distroless/base"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.Afero{Fs: afero.NewMemMapFs()}
+			require.NoError(t, fs.WriteFile("./Dockerfile", tc.dockerfile, 0644))
+
+			got, err := New(fs, "./Dockerfile").GetBaseImages()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func stringifyPorts(ports []Port) []string {
 	var arr []string
 	for _, p := range ports {
@@ -47,6 +47,7 @@ type ecsClient interface {
 	DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error)
 	ActiveClusters(arns ...string) ([]string, error)
 	ActiveServices(clusterName string, serviceARNs ...string) ([]string, error)
+	ClusterExecConfig(cluster string) (*ecs.ExecConfig, error)
 }
 
 type stepFunctionsClient interface {
@@ -88,6 +89,19 @@ func (c Client) ClusterARN(app, env string) (string, error) {
 	return c.clusterARN(app, env)
 }
 
+// ClusterExecConfig returns the configuration of the sessions started with ECS Exec in the cluster of an environment.
+func (c Client) ClusterExecConfig(app, env string) (*ecs.ExecConfig, error) {
+	clusterARN, err := c.clusterARN(app, env)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := c.ecsClient.ClusterExecConfig(clusterARN)
+	if err != nil {
+		return nil, fmt.Errorf("get exec configuration of cluster %s: %w", clusterARN, err)
+	}
+	return cfg, nil
+}
+
 // ForceUpdateService forces a new update for an ECS service given Copilot service info.
 func (c Client) ForceUpdateService(app, env, svc string) error {
 	clusterName, serviceName, err := c.fetchAndParseServiceARN(app, env, svc)
@@ -438,7 +452,7 @@ func (c Client) serviceARN(app, env, svc string) (*ecs.ServiceArn, error) {
 		return nil, fmt.Errorf("get ECS service with tags %s: %w", tags.String(), err)
 	}
 	if len(services) == 0 {
-		return nil, fmt.Errorf("no ECS service found with tags %s", tags.String())
+		return nil, &ErrNoServiceFound{tags: tags}
 	}
 	arns := make([]string, len(services))
 	for i := range services {
@@ -452,6 +466,9 @@ func (c Client) serviceARN(app, env, svc string) (*ecs.ServiceArn, error) {
 	if err != nil {
 		return nil, fmt.Errorf("check if services are active in the cluster %s: %w", activeCluster, err)
 	}
+	if len(activeSvcs) == 0 {
+		return nil, &ErrNoServiceFound{tags: tags}
+	}
 	if len(activeSvcs) > 1 {
 		return nil, fmt.Errorf("more than one ECS service with tags %s", tags.String())
 	}
@@ -130,6 +130,81 @@ func TestClient_ClusterARN(t *testing.T) {
 	}
 }
 
+
+func TestClient_ClusterExecConfig(t *testing.T) {
+	const (
+		mockApp = "mockApp"
+		mockEnv = "mockEnv"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey: mockApp,
+		deploy.EnvTagKey: mockEnv,
+	}
+	mockCluster := func(m clientMocks) {
+		m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+			Return([]*resourcegroups.Resource{{ARN: "mockARN"}}, nil)
+		m.ecsClient.EXPECT().ActiveClusters("mockARN").Return([]string{"mockARN"}, nil)
+	}
+
+	tests := map[string]struct {
+		setupMocks func(mocks clientMocks)
+
+		wantedError  error
+		wantedConfig *ecs.ExecConfig
+	}{
+		"errors if fail to get the exec configuration": {
+			setupMocks: func(m clientMocks) {
+				mockCluster(m)
+				m.ecsClient.EXPECT().ClusterExecConfig("mockARN").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get exec configuration of cluster mockARN: some error"),
+		},
+		"success": {
+			setupMocks: func(m clientMocks) {
+				mockCluster(m)
+				m.ecsClient.EXPECT().ClusterExecConfig("mockARN").Return(&ecs.ExecConfig{
+					KMSKeyID: "1234",
+					Logging:  "DEFAULT",
+				}, nil)
+			},
+			wantedConfig: &ecs.ExecConfig{
+				KMSKeyID: "1234",
+				Logging:  "DEFAULT",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			mocks := clientMocks{
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+				ecsClient:      mocks.NewMockecsClient(ctrl),
+			}
+
+			test.setupMocks(mocks)
+
+			client := Client{
+				rgGetter:  mocks.resourceGetter,
+				ecsClient: mocks.ecsClient,
+			}
+
+			// WHEN
+			get, err := client.ClusterExecConfig(mockApp, mockEnv)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.wantedConfig, get)
+			}
+		})
+	}
+}
 func TestClient_serviceARN(t *testing.T) {
 	const (
 		mockApp     = "mockApp"
@@ -14,6 +14,15 @@ func (e *ErrMultipleContainersInTaskDef) Error() string {
 	return fmt.Sprintf("found more than one container in task definition: %s", e.taskDefIdentifier)
 }
 
+// ErrNoServiceFound is returned when no active ECS service is deployed for a Copilot service.
+type ErrNoServiceFound struct {
+	tags tags
+}
+
+func (e *ErrNoServiceFound) Error() string {
+	return fmt.Sprintf("no ECS service found with tags %s", e.tags.String())
+}
+
 // ErrExitCode builds custom non-zero exit code error
 type ErrExitCode struct {
 	containerName string
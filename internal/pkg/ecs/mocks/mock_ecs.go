@@ -112,6 +112,21 @@ func (mr *MockecsClientMockRecorder) ActiveServices(clusterName interface{}, ser
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveServices", reflect.TypeOf((*MockecsClient)(nil).ActiveServices), varargs...)
 }
 
+// ClusterExecConfig mocks base method.
+func (m *MockecsClient) ClusterExecConfig(cluster string) (*ecs.ExecConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterExecConfig", cluster)
+	ret0, _ := ret[0].(*ecs.ExecConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterExecConfig indicates an expected call of ClusterExecConfig.
+func (mr *MockecsClientMockRecorder) ClusterExecConfig(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterExecConfig", reflect.TypeOf((*MockecsClient)(nil).ClusterExecConfig), cluster)
+}
+
 // DefaultCluster mocks base method.
 func (m *MockecsClient) DefaultCluster() (string, error) {
 	m.ctrl.T.Helper()
@@ -14,6 +14,7 @@ func (e ErrSSMPluginNotExist) Error() string {
 type ErrOutdatedSSMPlugin struct {
 	CurrentVersion string
 	LatestVersion  string
+	Local          bool // Whether the plugin is the one installed by Copilot in its data directory.
 }
 
 func (e ErrOutdatedSSMPlugin) Error() string {
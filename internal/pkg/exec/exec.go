@@ -71,6 +71,14 @@ func Stderr(writer io.Writer) CmdOption {
 	}
 }
 
+// Env appends the environment variables, in the form "key=value", to the environment of the current process
+// for the internal *exec.Cmd.
+func Env(vars ...string) CmdOption {
+	return func(c *exec.Cmd) {
+		c.Env = append(os.Environ(), vars...)
+	}
+}
+
 // Run starts the named command and waits until it finishes.
 func (c *Cmd) Run(name string, args []string, opts ...CmdOption) error {
 	cmd := c.command(context.Background(), name, args, opts...)
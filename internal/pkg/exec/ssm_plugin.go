@@ -28,7 +28,8 @@ const (
 type SSMPluginCommand struct {
 	sess *session.Session
 	runner
-	http httpClient
+	http    httpClient
+	dataDir string // Directory where Copilot installs the plugin, empty if it can't.
 
 	// facilitate unit test.
 	latestVersionBuffer    bytes.Buffer
@@ -40,9 +41,10 @@ type SSMPluginCommand struct {
 // NewSSMPluginCommand returns a SSMPluginCommand.
 func NewSSMPluginCommand(s *session.Session) SSMPluginCommand {
 	return SSMPluginCommand{
-		runner: NewCmd(),
-		sess:   s,
-		http:   http.DefaultClient,
+		runner:  NewCmd(),
+		sess:    s,
+		http:    http.DefaultClient,
+		dataDir: ssmPluginDataDir(),
 	}
 }
 
@@ -52,7 +54,7 @@ func (s SSMPluginCommand) StartSession(ssmSess *ecs.Session) error {
 	if err != nil {
 		return fmt.Errorf("marshal session response: %w", err)
 	}
-	if err := s.runner.InteractiveRun(ssmPluginBinaryName,
+	if err := s.runner.InteractiveRun(s.binary(),
 		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction}); err != nil {
 		return fmt.Errorf("start session: %w", err)
 	}
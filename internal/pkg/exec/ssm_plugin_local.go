@@ -0,0 +1,283 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// ssmPluginDataDirName is the directory, relative to the home directory of the user, where Copilot installs the ssm plugin.
+var ssmPluginDataDirName = filepath.Join(".copilot", "session-manager-plugin")
+
+const (
+	// ssmPluginBundledMarkerName is the file, next to the ssm plugin installed by Copilot, that marks a plugin installed from a bundle.
+	ssmPluginBundledMarkerName = ".bundled"
+
+	debArchiveMagic   = "!<arch>\n"
+	debArchiveHdrSize = 60
+)
+
+// ssmPluginDataDir returns the directory where Copilot installs the ssm plugin, or an empty string if the user has no home directory.
+func ssmPluginDataDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ssmPluginDataDirName)
+}
+
+// ssmPluginExecutableName returns the file name of the ssm plugin executable on the current OS.
+func ssmPluginExecutableName() string {
+	if runtime.GOOS == "windows" {
+		return ssmPluginBinaryName + ".exe"
+	}
+	return ssmPluginBinaryName
+}
+
+// localBinaryPath returns the path of the ssm plugin installed by Copilot in its data directory.
+func (s SSMPluginCommand) localBinaryPath() string {
+	if s.dataDir == "" {
+		return ""
+	}
+	return filepath.Join(s.dataDir, ssmPluginExecutableName())
+}
+
+// binary returns the ssm plugin to run: the one installed by Copilot if any, otherwise the one in the PATH.
+func (s SSMPluginCommand) binary() string {
+	local := s.localBinaryPath()
+	if local == "" {
+		return ssmPluginBinaryName
+	}
+	if _, err := os.Stat(local); err != nil {
+		return ssmPluginBinaryName
+	}
+	return local
+}
+
+// InstallLocalBinary downloads the pinned ssm plugin for the current OS and architecture into Copilot's data directory,
+// which doesn't require elevated permissions. The downloaded archive must match the pinned checksum before the binary
+// is run to verify that it reports the pinned version.
+func (s SSMPluginCommand) InstallLocalBinary() error {
+	if s.localBinaryPath() == "" {
+		return errors.New("find home directory to install the ssm plugin in")
+	}
+	bin, err := s.DownloadBinary()
+	if err != nil {
+		return err
+	}
+	if err := s.installLocal(bin, ssmPluginPinnedVersion); err != nil {
+		return err
+	}
+	_ = os.Remove(filepath.Join(s.dataDir, ssmPluginBundledMarkerName))
+	return nil
+}
+
+// InstallLocalBinaryFrom installs the ssm plugin executable bin, for example one from a bundle
+// for an air-gapped network, into Copilot's data directory once it verifies that the binary runs.
+func (s SSMPluginCommand) InstallLocalBinaryFrom(bin []byte) error {
+	if s.localBinaryPath() == "" {
+		return errors.New("find home directory to install the ssm plugin in")
+	}
+	if err := s.installLocal(bin, ""); err != nil {
+		return err
+	}
+	marker := filepath.Join(s.dataDir, ssmPluginBundledMarkerName)
+	if err := os.WriteFile(marker, nil, 0644); err != nil {
+		return fmt.Errorf("mark ssm plugin as installed from a bundle: %w", err)
+	}
+	return nil
+}
+
+// installedFromBundle returns true if the ssm plugin to run was installed from a bundle, which can't be compared
+// to the pinned version since the bundle may hold any version.
+func (s SSMPluginCommand) installedFromBundle() bool {
+	local := s.localBinaryPath()
+	if local == "" || s.binary() != local {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(s.dataDir, ssmPluginBundledMarkerName))
+	return err == nil
+}
+
+// DownloadBinary returns the executable of the pinned ssm plugin for the current OS and architecture,
+// once it verifies the checksum of the archive that it's extracted from.
+func (s SSMPluginCommand) DownloadBinary() ([]byte, error) {
+	archive, err := pinnedSSMPluginArchive(runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return nil, err
+	}
+	content, err := get(s.http, archive.url)
+	if err != nil {
+		return nil, fmt.Errorf("download ssm plugin: %w", err)
+	}
+	if err := archive.verify(content); err != nil {
+		return nil, fmt.Errorf("verify ssm plugin archive %s: %w", archive.url, err)
+	}
+	bin, err := extractSSMPluginBinary(archive.url, content)
+	if err != nil {
+		return nil, fmt.Errorf("extract ssm plugin from %s: %w", archive.url, err)
+	}
+	return bin, nil
+}
+
+// installLocal writes the ssm plugin executable to Copilot's data directory, if it runs and reports the wanted version, if any.
+func (s SSMPluginCommand) installLocal(bin []byte, wantedVersion string) error {
+	dst := s.localBinaryPath()
+	if err := os.MkdirAll(s.dataDir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", s.dataDir, err)
+	}
+	// Write to a temporary file first, so that a failed installation doesn't leave a broken plugin behind.
+	tmp := dst + ".download"
+	if err := os.WriteFile(tmp, bin, 0755); err != nil {
+		return fmt.Errorf("write ssm plugin to %s: %w", tmp, err)
+	}
+	var version bytes.Buffer
+	if err := s.runner.Run(tmp, []string{"--version"}, Stdout(&version)); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("verify ssm plugin: run %s --version: %w", tmp, err)
+	}
+	if got := strings.TrimSpace(version.String()); wantedVersion != "" && got != wantedVersion {
+		os.Remove(tmp)
+		return fmt.Errorf("verify ssm plugin: downloaded version %q instead of the pinned version %q", got, wantedVersion)
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("install ssm plugin to %s: %w", dst, err)
+	}
+	return nil
+}
+
+// get returns the body of a successful GET request to the url.
+func get(client httpClient, url string) ([]byte, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// extractSSMPluginBinary returns the ssm plugin executable in the archive downloaded from url,
+// which is either a zip file, possibly nesting other zip files, or a Debian package.
+func extractSSMPluginBinary(url string, archive []byte) ([]byte, error) {
+	name := ssmPluginExecutableName()
+	switch path.Ext(url) {
+	case ".zip":
+		return extractFromZip(archive, name)
+	case ".deb":
+		return extractFromDeb(archive, name)
+	default:
+		return nil, fmt.Errorf("unsupported archive format %q", path.Ext(url))
+	}
+}
+
+// extractFromZip returns the content of the file named name in the zip archive, looking into the zip archives it contains.
+func extractFromZip(archive []byte, name string) ([]byte, error) {
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("read zip archive: %w", err)
+	}
+	var nested []*zip.File
+	for _, f := range r.File {
+		switch {
+		case path.Base(f.Name) == name && !f.FileInfo().IsDir():
+			return readZipFile(f)
+		case path.Ext(f.Name) == ".zip":
+			nested = append(nested, f)
+		}
+	}
+	for _, f := range nested {
+		content, err := readZipFile(f)
+		if err != nil {
+			return nil, err
+		}
+		if bin, err := extractFromZip(content, name); err == nil {
+			return bin, nil
+		}
+	}
+	return nil, fmt.Errorf("file %s not found in zip archive", name)
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+	content, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", f.Name, err)
+	}
+	return content, nil
+}
+
+// extractFromDeb returns the content of the file named name in the gzipped data archive of a Debian package,
+// which is an ar archive, without depending on dpkg.
+func extractFromDeb(archive []byte, name string) ([]byte, error) {
+	if !bytes.HasPrefix(archive, []byte(debArchiveMagic)) {
+		return nil, errors.New("not a Debian package")
+	}
+	rest := archive[len(debArchiveMagic):]
+	for len(rest) >= debArchiveHdrSize {
+		hdr := rest[:debArchiveHdrSize]
+		member := strings.TrimSuffix(strings.TrimSpace(string(hdr[0:16])), "/")
+		size, err := strconv.ParseInt(strings.TrimSpace(string(hdr[48:58])), 10, 64)
+		if err != nil || size < 0 || size > int64(len(rest)-debArchiveHdrSize) {
+			return nil, fmt.Errorf("invalid size of member %q in Debian package", member)
+		}
+		data := rest[debArchiveHdrSize : debArchiveHdrSize+size]
+		if strings.HasPrefix(member, "data.tar") {
+			if member != "data.tar.gz" {
+				return nil, fmt.Errorf("unsupported data archive %s in Debian package", member)
+			}
+			return extractFromTarGz(data, name)
+		}
+		next := debArchiveHdrSize + size
+		if next%2 == 1 { // Members are aligned on even offsets.
+			next++
+		}
+		if next > int64(len(rest)) {
+			break
+		}
+		rest = rest[next:]
+	}
+	return nil, errors.New("data archive not found in Debian package")
+}
+
+func extractFromTarGz(archive []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("read gzip archive: %w", err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("file %s not found in tar archive", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read tar archive: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeReg && path.Base(hdr.Name) == name {
+			return io.ReadAll(tr)
+		}
+	}
+}
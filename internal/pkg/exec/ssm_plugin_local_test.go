@@ -0,0 +1,371 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeURLHTTPClient serves the content of each url, and 404 for the others.
+type fakeURLHTTPClient map[string][]byte
+
+func (c fakeURLHTTPClient) Get(url string) (*http.Response, error) {
+	r := httptest.NewRecorder()
+	content, ok := c[url]
+	if !ok {
+		r.WriteHeader(http.StatusNotFound)
+		return r.Result(), nil
+	}
+	_, _ = r.Write(content)
+	return r.Result(), nil
+}
+
+func zipArchive(t *testing.T, files map[string][]byte) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func debArchive(t *testing.T, dataMember string, files map[string][]byte) []byte {
+	var data bytes.Buffer
+	gz := gzip.NewWriter(&data)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Typeflag: tar.TypeReg,
+			Mode:     0755,
+			Size:     int64(len(content)),
+		}))
+		_, err := tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	var deb bytes.Buffer
+	deb.WriteString(debArchiveMagic)
+	member := func(name string, content []byte) {
+		fmt.Fprintf(&deb, "%-16s%-12s%-6s%-6s%-8s%-10d`\n", name, "0", "0", "0", "100644", len(content))
+		deb.Write(content)
+		if len(content)%2 == 1 {
+			deb.WriteString("\n")
+		}
+	}
+	member("debian-binary", []byte("2.0\n"))
+	member("control.tar.gz", []byte("odd"))
+	member(dataMember, data.Bytes())
+	return deb.Bytes()
+}
+
+// pinSSMPluginArchive pins the archive at url with the checksum of content for the current platform for the duration of the test.
+func pinSSMPluginArchive(t *testing.T, url string, content []byte) {
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+	old, ok := ssmPluginArchives[platform]
+	t.Cleanup(func() {
+		if !ok {
+			delete(ssmPluginArchives, platform)
+			return
+		}
+		ssmPluginArchives[platform] = old
+	})
+	sum := sha256.Sum256(content)
+	ssmPluginArchives[platform] = ssmPluginArchive{
+		url:    url,
+		sha256: hex.EncodeToString(sum[:]),
+	}
+}
+
+func TestPinnedSSMPluginArchive(t *testing.T) {
+	t.Run("error if the platform isn't pinned", func(t *testing.T) {
+		_, err := pinnedSSMPluginArchive("linux", "386")
+
+		require.EqualError(t, err, "copilot doesn't pin a Session Manager plugin for linux/386")
+	})
+	t.Run("pins a versioned archive for each supported platform", func(t *testing.T) {
+		for platform, archive := range ssmPluginArchives {
+			require.Contains(t, archive.url, "/plugin/"+ssmPluginPinnedVersion+"/", "archive of %s", platform)
+		}
+	})
+	t.Run("returns the archive of the platform", func(t *testing.T) {
+		pinSSMPluginArchive(t, "https://example.com/plugin.zip", []byte("archive"))
+
+		got, err := pinnedSSMPluginArchive(runtime.GOOS, runtime.GOARCH)
+
+		require.NoError(t, err)
+		require.Equal(t, "https://example.com/plugin.zip", got.url)
+	})
+}
+
+func TestSSMPluginArchive_verify(t *testing.T) {
+	content := []byte("archive")
+	sum := sha256.Sum256(content)
+	t.Run("error if the checksum of the content doesn't match", func(t *testing.T) {
+		archive := ssmPluginArchive{sha256: "1234"}
+
+		err := archive.verify(content)
+
+		require.EqualError(t, err, fmt.Sprintf("checksum %s doesn't match the pinned checksum 1234", hex.EncodeToString(sum[:])))
+	})
+	t.Run("accepts content matching the checksum regardless of its case", func(t *testing.T) {
+		archive := ssmPluginArchive{sha256: strings.ToUpper(hex.EncodeToString(sum[:]))}
+
+		require.NoError(t, archive.verify(content))
+	})
+}
+
+func TestExtractSSMPluginBinary(t *testing.T) {
+	bin := []byte("plugin")
+	name := ssmPluginExecutableName()
+	tests := map[string]struct {
+		url     string
+		archive func(t *testing.T) []byte
+
+		wanted      []byte
+		wantedError string
+	}{
+		"extracts the binary of a zip bundle": {
+			url: "https://example.com/sessionmanager-bundle.zip",
+			archive: func(t *testing.T) []byte {
+				return zipArchive(t, map[string][]byte{
+					"sessionmanager-bundle/install":           []byte("script"),
+					"sessionmanager-bundle/bin/" + name:       bin,
+					"sessionmanager-bundle/THIRD-PARTY-NOTES": []byte("notes"),
+				})
+			},
+			wanted: bin,
+		},
+		"extracts the binary of a nested zip archive": {
+			url: "https://example.com/SessionManagerPlugin.zip",
+			archive: func(t *testing.T) []byte {
+				return zipArchive(t, map[string][]byte{
+					"SessionManagerPlugin/install.bat": []byte("script"),
+					"SessionManagerPlugin/package.zip": zipArchive(t, map[string][]byte{
+						"bin/" + name: bin,
+					}),
+				})
+			},
+			wanted: bin,
+		},
+		"error if the zip archive doesn't contain the binary": {
+			url: "https://example.com/sessionmanager-bundle.zip",
+			archive: func(t *testing.T) []byte {
+				return zipArchive(t, map[string][]byte{
+					"README": []byte("hello"),
+				})
+			},
+			wantedError: fmt.Sprintf("file %s not found in zip archive", name),
+		},
+		"extracts the binary of a Debian package": {
+			url: "https://example.com/session-manager-plugin.deb",
+			archive: func(t *testing.T) []byte {
+				return debArchive(t, "data.tar.gz", map[string][]byte{
+					"./usr/local/sessionmanagerplugin/seelog.xml.template": []byte("config"),
+					"./usr/local/sessionmanagerplugin/bin/" + name:         bin,
+				})
+			},
+			wanted: bin,
+		},
+		"error if the data of the Debian package isn't gzipped": {
+			url: "https://example.com/session-manager-plugin.deb",
+			archive: func(t *testing.T) []byte {
+				return debArchive(t, "data.tar.xz", nil)
+			},
+			wantedError: "unsupported data archive data.tar.xz in Debian package",
+		},
+		"error if the archive isn't a Debian package": {
+			url: "https://example.com/session-manager-plugin.deb",
+			archive: func(t *testing.T) []byte {
+				return []byte("hello")
+			},
+			wantedError: "not a Debian package",
+		},
+		"error if the archive format is unsupported": {
+			url: "https://example.com/session-manager-plugin.rpm",
+			archive: func(t *testing.T) []byte {
+				return nil
+			},
+			wantedError: `unsupported archive format ".rpm"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := extractSSMPluginBinary(tc.url, tc.archive(t))
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSSMPluginCommand_InstallLocalBinary(t *testing.T) {
+	name := ssmPluginExecutableName()
+	zipURL := "https://example.com/sessionmanager-bundle.zip"
+	archive := zipArchive(t, map[string][]byte{
+		"bin/" + name: []byte("plugin"),
+	})
+	printVersion := func(version string) func(string, []string, ...CmdOption) error {
+		return func(_ string, _ []string, opts ...CmdOption) error {
+			cmd := &osexec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			_, err := cmd.Stdout.Write([]byte(version + "\n"))
+			return err
+		}
+	}
+	tests := map[string]struct {
+		files      fakeURLHTTPClient
+		pinned     []byte
+		setupMocks func(m *Mockrunner, tmp string)
+
+		wantedError string // "{tmp}" is replaced by the path that the plugin is downloaded to.
+	}{
+		"error if the plugin can't be downloaded": {
+			files:       fakeURLHTTPClient{},
+			pinned:      archive,
+			setupMocks:  func(m *Mockrunner, tmp string) {},
+			wantedError: fmt.Sprintf("download ssm plugin: GET %s: unexpected status 404 Not Found", zipURL),
+		},
+		"error without running the plugin if the archive doesn't match the pinned checksum": {
+			files: fakeURLHTTPClient{
+				zipURL: archive,
+			},
+			pinned:     []byte("other archive"),
+			setupMocks: func(m *Mockrunner, tmp string) {},
+			wantedError: fmt.Sprintf("verify ssm plugin archive %s: checksum %s doesn't match the pinned checksum %s",
+				zipURL, func() string {
+					sum := sha256.Sum256(archive)
+					return hex.EncodeToString(sum[:])
+				}(), func() string {
+					sum := sha256.Sum256([]byte("other archive"))
+					return hex.EncodeToString(sum[:])
+				}()),
+		},
+		"error if the downloaded plugin can't run": {
+			files: fakeURLHTTPClient{
+				zipURL: archive,
+			},
+			pinned: archive,
+			setupMocks: func(m *Mockrunner, tmp string) {
+				m.EXPECT().Run(tmp, []string{"--version"}, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: "verify ssm plugin: run {tmp} --version: some error",
+		},
+		"error if the downloaded plugin isn't the pinned version": {
+			files: fakeURLHTTPClient{
+				zipURL: archive,
+			},
+			pinned: archive,
+			setupMocks: func(m *Mockrunner, tmp string) {
+				m.EXPECT().Run(tmp, []string{"--version"}, gomock.Any()).DoAndReturn(printVersion("1.2.7.0"))
+			},
+			wantedError: fmt.Sprintf(`verify ssm plugin: downloaded version "1.2.7.0" instead of the pinned version %q`, ssmPluginPinnedVersion),
+		},
+		"installs the plugin": {
+			files: fakeURLHTTPClient{
+				zipURL: archive,
+			},
+			pinned: archive,
+			setupMocks: func(m *Mockrunner, tmp string) {
+				m.EXPECT().Run(tmp, []string{"--version"}, gomock.Any()).DoAndReturn(printVersion(ssmPluginPinnedVersion))
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			pinSSMPluginArchive(t, zipURL, tc.pinned)
+			dir := filepath.Join(t.TempDir(), "session-manager-plugin")
+			s := SSMPluginCommand{
+				runner:  NewMockrunner(ctrl),
+				http:    tc.files,
+				dataDir: dir,
+			}
+			dst := s.localBinaryPath()
+			tc.setupMocks(s.runner.(*Mockrunner), dst+".download")
+
+			err := s.InstallLocalBinary()
+			if tc.wantedError != "" {
+				require.EqualError(t, err, strings.ReplaceAll(tc.wantedError, "{tmp}", dst+".download"))
+				require.NoFileExists(t, dst)
+				require.NoFileExists(t, dst+".download")
+				return
+			}
+			require.NoError(t, err)
+			content, err := os.ReadFile(dst)
+			require.NoError(t, err)
+			require.Equal(t, []byte("plugin"), content)
+			require.Equal(t, dst, s.binary(), "the installed plugin should be run")
+		})
+	}
+}
+
+func TestSSMPluginCommand_InstallLocalBinaryFrom(t *testing.T) {
+	t.Run("error if the plugin can't run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		s := SSMPluginCommand{
+			runner:  NewMockrunner(ctrl),
+			http:    fakeURLHTTPClient{},
+			dataDir: filepath.Join(t.TempDir(), "session-manager-plugin"),
+		}
+		dst := s.localBinaryPath()
+		s.runner.(*Mockrunner).EXPECT().Run(dst+".download", []string{"--version"}, gomock.Any()).Return(errors.New("some error"))
+
+		err := s.InstallLocalBinaryFrom([]byte("plugin"))
+
+		require.EqualError(t, err, fmt.Sprintf("verify ssm plugin: run %s.download --version: some error", dst))
+		require.NoFileExists(t, dst)
+		require.NoFileExists(t, dst+".download")
+	})
+	t.Run("installs the plugin without downloading it", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		s := SSMPluginCommand{
+			runner:  NewMockrunner(ctrl),
+			http:    fakeURLHTTPClient{},
+			dataDir: filepath.Join(t.TempDir(), "session-manager-plugin"),
+		}
+		dst := s.localBinaryPath()
+		s.runner.(*Mockrunner).EXPECT().Run(dst+".download", []string{"--version"}, gomock.Any()).Return(nil)
+
+		err := s.InstallLocalBinaryFrom([]byte("plugin"))
+
+		require.NoError(t, err)
+		content, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		require.Equal(t, []byte("plugin"), content)
+		require.True(t, s.installedFromBundle())
+	})
+}
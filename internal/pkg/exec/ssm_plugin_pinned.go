@@ -0,0 +1,67 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// ssmPluginPinnedVersion is the version of the ssm plugin that Copilot installs in its data directory.
+// Bump it together with the URLs and the checksums in ssmPluginArchives.
+const ssmPluginPinnedVersion = "1.2.497.0"
+
+const ssmPluginDownloadsURL = "https://s3.amazonaws.com/session-manager-downloads/plugin/" + ssmPluginPinnedVersion
+
+// ssmPluginArchive is a versioned archive of the ssm plugin.
+type ssmPluginArchive struct {
+	url    string
+	sha256 string // Hex-encoded SHA-256 checksum of the archive.
+}
+
+// ssmPluginArchives are the archives of the pinned ssm plugin that Copilot installs in its data directory,
+// keyed by "GOOS/GOARCH". The Debian package is unpacked without dpkg, so it's used on every Linux distribution.
+// An archive is only installed once its checksum, copied from the archive published at the url, is filled in.
+var ssmPluginArchives = map[string]ssmPluginArchive{
+	"darwin/amd64": {
+		url:    ssmPluginDownloadsURL + "/mac/sessionmanager-bundle.zip",
+		sha256: "",
+	},
+	"darwin/arm64": {
+		url:    ssmPluginDownloadsURL + "/mac_arm64/sessionmanager-bundle.zip",
+		sha256: "",
+	},
+	"linux/amd64": {
+		url:    ssmPluginDownloadsURL + "/ubuntu_64bit/session-manager-plugin.deb",
+		sha256: "",
+	},
+	"linux/arm64": {
+		url:    ssmPluginDownloadsURL + "/ubuntu_arm64/session-manager-plugin.deb",
+		sha256: "",
+	},
+	"windows/amd64": {
+		url:    ssmPluginDownloadsURL + "/windows/SessionManagerPlugin.zip",
+		sha256: "",
+	},
+}
+
+// pinnedSSMPluginArchive returns the archive of the pinned ssm plugin for the platform.
+func pinnedSSMPluginArchive(goos, goarch string) (ssmPluginArchive, error) {
+	archive, ok := ssmPluginArchives[goos+"/"+goarch]
+	if !ok || archive.sha256 == "" {
+		return ssmPluginArchive{}, fmt.Errorf("copilot doesn't pin a Session Manager plugin for %s/%s", goos, goarch)
+	}
+	return archive, nil
+}
+
+// verify returns an error if the SHA-256 checksum of content doesn't match the pinned checksum of the archive.
+func (a ssmPluginArchive) verify(content []byte) error {
+	sum := sha256.Sum256(content)
+	if got := hex.EncodeToString(sum[:]); !strings.EqualFold(got, a.sha256) {
+		return fmt.Errorf("checksum %s doesn't match the pinned checksum %s", got, a.sha256)
+	}
+	return nil
+}
@@ -8,17 +8,30 @@ package exec
 
 import (
 	"fmt"
+	"io"
 	"strings"
 )
 
 // ValidateBinary validates if the ssm plugin exists and needs update.
 func (s SSMPluginCommand) ValidateBinary() error {
 	var latestVersion, currentVersion string
-	if err := s.runner.Run("curl", []string{"-s", ssmPluginBinaryLatestVersionURL}, Stdout(&s.latestVersionBuffer)); err != nil {
-		return fmt.Errorf("get ssm plugin latest version: %w", err)
+	if s.installedFromBundle() {
+		// Skip the update check of a plugin installed from a bundle for an air-gapped network, as long as it runs.
+		if err := s.runner.Run(s.binary(), []string{"--version"}, Stdout(io.Discard)); err == nil {
+			return nil
+		}
+	}
+	bin := s.binary()
+	if bin != ssmPluginBinaryName {
+		// The plugin installed by Copilot in its data directory is kept at the pinned version rather than the latest one.
+		latestVersion = ssmPluginPinnedVersion
+	} else {
+		if err := s.runner.Run("curl", []string{"-s", ssmPluginBinaryLatestVersionURL}, Stdout(&s.latestVersionBuffer)); err != nil {
+			return fmt.Errorf("get ssm plugin latest version: %w", err)
+		}
+		latestVersion = strings.TrimSpace(s.latestVersionBuffer.String())
 	}
-	latestVersion = strings.TrimSpace(s.latestVersionBuffer.String())
-	if err := s.runner.Run(ssmPluginBinaryName, []string{"--version"}, Stdout(&s.currentVersionBuffer)); err != nil {
+	if err := s.runner.Run(bin, []string{"--version"}, Stdout(&s.currentVersionBuffer)); err != nil {
 		if !strings.Contains(err.Error(), executableNotExistErrMessage) {
 			return fmt.Errorf("get local ssm plugin version: %w", err)
 		}
@@ -29,6 +42,7 @@ func (s SSMPluginCommand) ValidateBinary() error {
 		return &ErrOutdatedSSMPlugin{
 			CurrentVersion: currentVersion,
 			LatestVersion:  latestVersion,
+			Local:          bin != ssmPluginBinaryName,
 		}
 	}
 	return nil
@@ -9,6 +9,8 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -101,3 +103,67 @@ func TestSSMPluginCommand_ValidateBinary(t *testing.T) {
 		})
 	}
 }
+
+func TestSSMPluginCommand_ValidateBinary_installedFromBundle(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ssmPluginExecutableName()), []byte("plugin"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ssmPluginBundledMarkerName), nil, 0644))
+	m := NewMockrunner(ctrl)
+	m.EXPECT().Run(filepath.Join(dir, ssmPluginExecutableName()), []string{"--version"}, gomock.Any()).Return(nil)
+	s := SSMPluginCommand{
+		runner:  m,
+		dataDir: dir,
+	}
+
+	// WHEN
+	err := s.ValidateBinary()
+
+	// THEN
+	require.NoError(t, err, "the latest version shouldn't be looked up")
+}
+
+func TestSSMPluginCommand_ValidateBinary_installedByCopilot(t *testing.T) {
+	tests := map[string]struct {
+		inCurrentVersion string
+
+		wantedErr error
+	}{
+		"return ErrOutdatedSSMPlugin if the plugin isn't the pinned version": {
+			inCurrentVersion: "1.2.7.0",
+			wantedErr: &ErrOutdatedSSMPlugin{
+				CurrentVersion: "1.2.7.0",
+				LatestVersion:  ssmPluginPinnedVersion,
+				Local:          true,
+			},
+		},
+		"return nil if the plugin is the pinned version": {
+			inCurrentVersion: ssmPluginPinnedVersion,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			dir := t.TempDir()
+			bin := filepath.Join(dir, ssmPluginExecutableName())
+			require.NoError(t, os.WriteFile(bin, []byte("plugin"), 0755))
+			m := NewMockrunner(ctrl)
+			m.EXPECT().Run(bin, []string{"--version"}, gomock.Any()).Return(nil)
+			s := SSMPluginCommand{
+				runner:               m,
+				dataDir:              dir,
+				currentVersionBuffer: *bytes.NewBufferString(tc.inCurrentVersion),
+			}
+
+			// WHEN
+			err := s.ValidateBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err, "the latest version shouldn't be looked up")
+		})
+	}
+}
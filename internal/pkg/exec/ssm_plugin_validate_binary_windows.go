@@ -6,11 +6,18 @@ package exec
 
 import (
 	"bytes"
+	"strings"
 )
 
 // ValidateBinary validates if the ssm plugin exists.
 func (s SSMPluginCommand) ValidateBinary() error {
 	// Hinder output on the screen.
 	var b bytes.Buffer
-	return s.runner.Run(ssmPluginBinaryName, []string{}, Stdout(&b))
+	if err := s.runner.Run(s.binary(), []string{}, Stdout(&b)); err != nil {
+		if strings.Contains(err.Error(), executableNotExistErrMessage) {
+			return &ErrSSMPluginNotExist{}
+		}
+		return err
+	}
+	return nil
 }
@@ -0,0 +1,192 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ical provides functionality to read the events of an iCalendar file.
+package ical
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout        = "20060102"
+	dateTimeLayout    = "20060102T150405"
+	utcDateTimeLayout = "20060102T150405Z"
+)
+
+// Event is an event of a calendar.
+type Event struct {
+	Summary string
+	Start   time.Time
+	End     time.Time // Exclusive.
+}
+
+// property is a content line of an iCalendar file, such as "DTSTART;TZID=Europe/Paris:20241224T180000".
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse returns the events of an iCalendar file, sorted by start time. Cancelled events are ignored.
+// Times without a time zone, and all-day events, are in the location loc.
+func Parse(content []byte, loc *time.Location) ([]Event, error) {
+	var events []Event
+	var current []property
+	inEvent := false
+	for i, line := range unfold(string(content)) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		prop, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("parse line %d: %w", i+1, err)
+		}
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+			inEvent, current = true, nil
+		case prop.name == "END" && strings.EqualFold(prop.value, "VEVENT"):
+			if !inEvent {
+				return nil, errors.New("END:VEVENT without a matching BEGIN:VEVENT")
+			}
+			inEvent = false
+			event, ok, err := newEvent(current, loc)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				events = append(events, event)
+			}
+		case inEvent:
+			current = append(current, prop)
+		}
+	}
+	if inEvent {
+		return nil, errors.New("BEGIN:VEVENT without a matching END:VEVENT")
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Start.Before(events[j].Start)
+	})
+	return events, nil
+}
+
+// unfold joins the lines that RFC 5545 folded with a leading space or tab.
+func unfold(content string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func parseProperty(line string) (property, error) {
+	colon := -1
+	quoted := false
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon == -1 {
+		return property{}, fmt.Errorf("missing a : in %q", line)
+	}
+	parts := strings.Split(line[:colon], ";")
+	prop := property{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string),
+		value:  line[colon+1:],
+	}
+	for _, param := range parts[1:] {
+		name, value, _ := strings.Cut(param, "=")
+		prop.params[strings.ToUpper(name)] = strings.Trim(value, `"`)
+	}
+	return prop, nil
+}
+
+// newEvent returns the event made of the properties, or false if the event is cancelled.
+func newEvent(props []property, loc *time.Location) (Event, bool, error) {
+	var event Event
+	var start, end *property
+	var recurring bool
+	for i, prop := range props {
+		switch prop.name {
+		case "SUMMARY":
+			event.Summary = prop.value
+		case "DTSTART":
+			start = &props[i]
+		case "DTEND":
+			end = &props[i]
+		case "STATUS":
+			if strings.EqualFold(prop.value, "CANCELLED") {
+				return Event{}, false, nil
+			}
+		case "RRULE", "RDATE":
+			recurring = true
+		}
+	}
+	if recurring {
+		return Event{}, false, fmt.Errorf("recurring event %q is not supported: list each occurrence as its own event", event.name())
+	}
+	if start == nil {
+		return Event{}, false, fmt.Errorf("event %q must have a DTSTART", event.name())
+	}
+	var allDay bool
+	var err error
+	if event.Start, allDay, err = parseTime(*start, loc); err != nil {
+		return Event{}, false, fmt.Errorf("parse DTSTART of event %q: %w", event.name(), err)
+	}
+	switch {
+	case end != nil:
+		if event.End, _, err = parseTime(*end, loc); err != nil {
+			return Event{}, false, fmt.Errorf("parse DTEND of event %q: %w", event.name(), err)
+		}
+	case allDay:
+		event.End = event.Start.AddDate(0, 0, 1)
+	default:
+		return Event{}, false, fmt.Errorf("event %q must have a DTEND", event.name())
+	}
+	if !event.End.After(event.Start) {
+		return Event{}, false, fmt.Errorf("event %q must end after it starts", event.name())
+	}
+	return event, true, nil
+}
+
+// parseTime returns the time of a DTSTART or DTEND property, and whether it is a date without a time.
+func parseTime(prop property, loc *time.Location) (time.Time, bool, error) {
+	if tzid, ok := prop.params["TZID"]; ok {
+		var err error
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, false, fmt.Errorf("load time zone %q: %w", tzid, err)
+		}
+	}
+	switch {
+	case prop.params["VALUE"] == "DATE" || len(prop.value) == len(dateLayout):
+		t, err := time.ParseInLocation(dateLayout, prop.value, loc)
+		return t, true, err
+	case strings.HasSuffix(prop.value, "Z"):
+		t, err := time.Parse(utcDateTimeLayout, prop.value)
+		return t, false, err
+	default:
+		t, err := time.ParseInLocation(dateTimeLayout, prop.value, loc)
+		return t, false, err
+	}
+}
+
+func (e Event) name() string {
+	if e.Summary == "" {
+		return "(no summary)"
+	}
+	return e.Summary
+}
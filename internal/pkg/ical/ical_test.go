@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ical
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		in string
+
+		wanted    []Event
+		wantedErr string
+	}{
+		"events sorted by start time": {
+			in: "BEGIN:VCALENDAR\r\n" +
+				"VERSION:2.0\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"SUMMARY:Cyber Monday\r\n" +
+				"DTSTART;VALUE=DATE:20241202\r\n" +
+				"END:VEVENT\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"SUMMARY:Black Friday \r\n" +
+				" sale\r\n" +
+				"DTSTART;TZID=Europe/Paris:20241129T060000\r\n" +
+				"DTEND:20241130T000000Z\r\n" +
+				"END:VEVENT\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"SUMMARY:Launch\r\n" +
+				"DTSTART:20241101T090000\r\n" +
+				"DTEND:20241101T170000\r\n" +
+				"END:VEVENT\r\n" +
+				"END:VCALENDAR\r\n",
+			wanted: []Event{
+				{
+					Summary: "Launch",
+					Start:   time.Date(2024, 11, 1, 9, 0, 0, 0, newYork),
+					End:     time.Date(2024, 11, 1, 17, 0, 0, 0, newYork),
+				},
+				{
+					Summary: "Black Friday sale",
+					Start:   time.Date(2024, 11, 29, 6, 0, 0, 0, paris),
+					End:     time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Summary: "Cyber Monday",
+					Start:   time.Date(2024, 12, 2, 0, 0, 0, 0, newYork),
+					End:     time.Date(2024, 12, 3, 0, 0, 0, 0, newYork),
+				},
+			},
+		},
+		"cancelled events are ignored": {
+			in: `BEGIN:VEVENT
+SUMMARY:Flash sale
+STATUS:CANCELLED
+DTSTART:20241101T090000
+END:VEVENT
+`,
+		},
+		"error on recurring events": {
+			in: `BEGIN:VEVENT
+RRULE:FREQ=YEARLY
+DTSTART;VALUE=DATE:20241225
+SUMMARY:Christmas
+END:VEVENT
+`,
+			wantedErr: `recurring event "Christmas" is not supported: list each occurrence as its own event`,
+		},
+		"error if an event with a time has no end": {
+			in: `BEGIN:VEVENT
+DTSTART:20241101T090000
+END:VEVENT
+`,
+			wantedErr: `event "(no summary)" must have a DTEND`,
+		},
+		"error if an event ends before it starts": {
+			in: `BEGIN:VEVENT
+SUMMARY:Launch
+DTSTART:20241101T090000
+DTEND:20241101T080000
+END:VEVENT
+`,
+			wantedErr: `event "Launch" must end after it starts`,
+		},
+		"error on unknown time zones": {
+			in: `BEGIN:VEVENT
+SUMMARY:Launch
+DTSTART;TZID=Mars/Olympus_Mons:20241101T090000
+DTEND:20241101T170000
+END:VEVENT
+`,
+			wantedErr: `parse DTSTART of event "Launch": load time zone "Mars/Olympus_Mons": unknown time zone Mars/Olympus_Mons`,
+		},
+		"error on unterminated events": {
+			in:        "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20241225\n",
+			wantedErr: "BEGIN:VEVENT without a matching END:VEVENT",
+		},
+		"error on malformed lines": {
+			in:        "BEGIN:VEVENT\nSUMMARY\n",
+			wantedErr: `parse line 2: missing a : in "SUMMARY"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Parse([]byte(tc.in), newYork)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, len(tc.wanted), len(got))
+			for i := range tc.wanted {
+				require.Equal(t, tc.wanted[i].Summary, got[i].Summary)
+				require.True(t, tc.wanted[i].Start.Equal(got[i].Start), "start of %s: wanted %s, got %s", tc.wanted[i].Summary, tc.wanted[i].Start, got[i].Start)
+				require.True(t, tc.wanted[i].End.Equal(got[i].End), "end of %s: wanted %s, got %s", tc.wanted[i].Summary, tc.wanted[i].End, got[i].End)
+			}
+		})
+	}
+}
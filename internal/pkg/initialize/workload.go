@@ -85,6 +85,7 @@ type ServiceProps struct {
 	Private     bool
 	appDomain   *string
 	FileUploads []manifest.FileUpload
+	Preset      string // Preset of the manifest of a Load Balanced Web Service.
 }
 
 // WorkloadInitializer holds the clients necessary to initialize either a
@@ -337,6 +338,7 @@ func (w *WorkloadInitializer) newLoadBalancedWebServiceManifest(inProps *Service
 		Port:        inProps.Port,
 		HealthCheck: inProps.HealthCheck,
 		Platform:    inProps.Platform,
+		Preset:      inProps.Preset,
 	}
 	existingSvcs, err := w.Store.ListServices(inProps.App)
 	if err != nil {
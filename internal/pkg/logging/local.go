@@ -0,0 +1,326 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
+)
+
+const (
+	localLogFileExt      = ".log"
+	localLogsPollPeriod  = time.Second
+	localLogLevelPadding = 5
+)
+
+var (
+	// LocalLogsDirName is the directory, relative to the workspace, that stores the logs of the workloads run locally.
+	LocalLogsDirName = filepath.Join(".copilot", "logs")
+
+	// Keys of the fields of JSON log lines, in the order to look them up.
+	jsonLogLevelKeys   = []string{"level", "lvl", "severity", "log.level"}
+	jsonLogMessageKeys = []string{"msg", "message"}
+	jsonLogTimeKeys    = []string{"time", "ts", "timestamp", "@timestamp"}
+
+	// awslogsDatetimeDirectives converts the strftime directives of the "awslogs-datetime-format" option
+	// to regular expressions, like the awslogs logging driver of Docker.
+	awslogsDatetimeDirectives = strings.NewReplacer(
+		"%a", `\b(?:Mon|Tue|Wed|Thu|Fri|Sat|Sun)\b`,
+		"%A", `\b(?:Monday|Tuesday|Wednesday|Thursday|Friday|Saturday|Sunday)\b`,
+		"%b", `\b(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)\b`,
+		"%B", `\b(?:January|February|March|April|May|June|July|August|September|October|November|December)\b`,
+		"%d", `(?:0[1-9]|[1,2][0-9]|3[0,1])`,
+		"%H", `(?:[0,1][0-9]|2[0-3])`,
+		"%I", `(?:0[0-9]|1[0-2])`,
+		"%j", `(?:[0,1][0-9][0-9]|2[0-9][0-9]|3[0-5][0-9]|36[0-6])`,
+		"%m", `(?:0[1-9]|1[0-2])`,
+		"%M", `[0-5][0-9]`,
+		"%p", `[A,P]M`,
+		"%S", `[0-5][0-9]`,
+		"%y", `\d{2}`,
+		"%Y", `\d{4}`,
+		"%z", `[+-]\d{4}`,
+		"%Z", `[A-Z]{1,4}T`,
+		"%f", `\d{1,9}`,
+		"%%", `%`,
+	)
+)
+
+// Options of the awslogs logging driver that group the lines of the output of a container into multiline log events.
+const (
+	AWSLogsMultilinePatternOption = "awslogs-multiline-pattern"
+	AWSLogsDatetimeFormatOption   = "awslogs-datetime-format"
+)
+
+// FormatJSONLogLine formats a JSON log line as its level, colored by severity, its message and its other fields
+// as key=value pairs sorted by key. Lines that aren't JSON objects are returned as is.
+func FormatJSONLogLine(line string) string {
+	dec := json.NewDecoder(strings.NewReader(line))
+	dec.UseNumber()
+	var fields map[string]interface{}
+	if err := dec.Decode(&fields); err != nil || dec.More() {
+		return line
+	}
+	level := popJSONLogField(fields, jsonLogLevelKeys)
+	msg := popJSONLogField(fields, jsonLogMessageKeys)
+	popJSONLogField(fields, jsonLogTimeKeys) // The lines are printed as they're logged, so the time is redundant.
+
+	var parts []string
+	if level != "" {
+		parts = append(parts, colorLogLevel(level))
+	}
+	if msg != "" {
+		parts = append(parts, msg)
+	}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%s", color.Faint.Sprint(key), jsonLogFieldValue(fields[key])))
+	}
+	return strings.Join(parts, " ")
+}
+
+func popJSONLogField(fields map[string]interface{}, keys []string) string {
+	for _, key := range keys {
+		val, ok := fields[key]
+		if !ok {
+			continue
+		}
+		if s, ok := val.(string); ok {
+			delete(fields, key)
+			return s
+		}
+	}
+	return ""
+}
+
+func jsonLogFieldValue(val interface{}) string {
+	if s, ok := val.(string); ok {
+		if strings.ContainsAny(s, " \t\"=") {
+			return fmt.Sprintf("%q", s)
+		}
+		return s
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprint(val)
+	}
+	return string(b)
+}
+
+func colorLogLevel(level string) string {
+	level = strings.ToUpper(level)
+	padded := fmt.Sprintf("%-*s", localLogLevelPadding, level)
+	switch level {
+	case "ERROR", "ERR", "FATAL", "PANIC", "CRITICAL", "CRIT":
+		return color.Red.Sprint(padded)
+	case "WARN", "WARNING":
+		return color.Yellow.Sprint(padded)
+	case "INFO":
+		return color.Green.Sprint(padded)
+	default:
+		return color.Grey.Sprint(padded)
+	}
+}
+
+// AWSLogsEntryStart returns a function that reports whether a line starts a new log event, given the options of the
+// awslogs logging driver of a container. It returns nil if the options don't group lines into multiline events.
+func AWSLogsEntryStart(options map[string]*string) (func(line string) bool, error) {
+	pattern, hasPattern := options[AWSLogsMultilinePatternOption]
+	format, hasFormat := options[AWSLogsDatetimeFormatOption]
+	if hasPattern && hasFormat {
+		return nil, fmt.Errorf("options %q and %q can't be set together", AWSLogsMultilinePatternOption, AWSLogsDatetimeFormatOption)
+	}
+	var expr string
+	switch {
+	case hasPattern:
+		expr = aws.StringValue(pattern)
+	case hasFormat:
+		expr = "^" + awslogsDatetimeDirectives.Replace(aws.StringValue(format))
+	default:
+		return nil, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("compile the multiline pattern %q: %w", expr, err)
+	}
+	return re.MatchString, nil
+}
+
+// LocalLogsDir returns the path to the directory that stores the logs of the containers of the workload run locally.
+func LocalLogsDir(wsPath, workload string) string {
+	return filepath.Join(wsPath, LocalLogsDirName, workload)
+}
+
+// NewLocalLogFile creates, or truncates, the file in the directory that stores the logs of the container.
+// Each line written to the file is prefixed with the time it was written at.
+func NewLocalLogFile(fs afero.Fs, dir, container string) (io.WriteCloser, error) {
+	if err := fs.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create directory %s: %w", dir, err)
+	}
+	path := filepath.Join(dir, container+localLogFileExt)
+	f, err := fs.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("create file %s: %w", path, err)
+	}
+	return &localLogFile{
+		File: f,
+		now:  time.Now,
+	}, nil
+}
+
+type localLogFile struct {
+	afero.File
+	now func() time.Time
+}
+
+// Write writes the line prefixed with the current time. It expects p to hold a single line.
+func (f *localLogFile) Write(p []byte) (int, error) {
+	if _, err := fmt.Fprintf(f.File, "%s %s", f.now().UTC().Format(time.RFC3339Nano), p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// LocalWorkloadLogger retrieves the logs that the containers of a workload wrote while it was run locally.
+type LocalWorkloadLogger struct {
+	fs  afero.Fs
+	dir string
+
+	w     io.Writer
+	now   func() time.Time
+	sleep func()
+}
+
+// NewLocalWorkloadLogger returns a LocalWorkloadLogger that reads the log files in the directory.
+func NewLocalWorkloadLogger(fs afero.Fs, dir string) *LocalWorkloadLogger {
+	return &LocalWorkloadLogger{
+		fs:  fs,
+		dir: dir,
+		w:   log.OutputWriter,
+		now: time.Now,
+		sleep: func() {
+			time.Sleep(localLogsPollPeriod)
+		},
+	}
+}
+
+// WriteLogEvents writes the logs of the containers of the workload in the order they were logged.
+func (l *LocalWorkloadLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
+	offsets := make(map[string]int64)
+	startTime := opts.startTime(l.now)
+	limit := opts.limit()
+	for {
+		events, err := l.readEvents(offsets, opts.ContainerName)
+		if err != nil {
+			return err
+		}
+		events = filterLocalEvents(events, startTime, opts.EndTime)
+		if limit != nil && int64(len(events)) > aws.Int64Value(limit) {
+			events = events[int64(len(events))-aws.Int64Value(limit):]
+		}
+		if err := opts.OnEvents(l.w, cwEventsToHumanJSONStringers(events)); err != nil {
+			return err
+		}
+		if !opts.Follow {
+			return nil
+		}
+		limit = nil
+		l.sleep()
+	}
+}
+
+// readEvents returns the events logged in the files after their offsets, sorted by time, and moves the offsets forward.
+func (l *LocalWorkloadLogger) readEvents(offsets map[string]int64, container string) ([]*cloudwatchlogs.Event, error) {
+	files, err := afero.ReadDir(l.fs, l.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read directory %s: %w", l.dir, err)
+	}
+	var events []*cloudwatchlogs.Event
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || filepath.Ext(name) != localLogFileExt {
+			continue
+		}
+		ctr := strings.TrimSuffix(name, localLogFileExt)
+		if container != "" && ctr != container {
+			continue
+		}
+		path := filepath.Join(l.dir, name)
+		content, err := afero.ReadFile(l.fs, path)
+		if err != nil {
+			return nil, fmt.Errorf("read file %s: %w", path, err)
+		}
+		if offsets[path] > int64(len(content)) {
+			offsets[path] = 0 // The file was truncated by a new run.
+		}
+		content = content[offsets[path]:]
+		// Only read complete lines, the last one may still be written.
+		end := bytes.LastIndexByte(content, '\n') + 1
+		offsets[path] += int64(end)
+		events = append(events, parseLocalLogLines(ctr, content[:end])...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp < events[j].Timestamp
+	})
+	return events, nil
+}
+
+func parseLocalLogLines(container string, content []byte) []*cloudwatchlogs.Event {
+	var events []*cloudwatchlogs.Event
+	var last int64
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		msg := line
+		timestamp := last
+		if prefix, rest, ok := strings.Cut(line, " "); ok {
+			if t, err := time.Parse(time.RFC3339Nano, prefix); err == nil {
+				msg, timestamp = rest, t.UnixMilli()
+			}
+		}
+		last = timestamp
+		events = append(events, &cloudwatchlogs.Event{
+			LogStreamName: container,
+			IngestionTime: timestamp,
+			Message:       msg,
+			Timestamp:     timestamp,
+		})
+	}
+	return events
+}
+
+func filterLocalEvents(events []*cloudwatchlogs.Event, startTime, endTime *int64) []*cloudwatchlogs.Event {
+	if startTime == nil && endTime == nil {
+		return events
+	}
+	var filtered []*cloudwatchlogs.Event
+	for _, event := range events {
+		if startTime != nil && event.Timestamp < aws.Int64Value(startTime) {
+			continue
+		}
+		if endTime != nil && event.Timestamp > aws.Int64Value(endTime) {
+			continue
+		}
+		filtered = append(filtered, event)
+	}
+	return filtered
+}
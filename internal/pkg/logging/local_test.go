@@ -0,0 +1,261 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatJSONLogLine(t *testing.T) {
+	testCases := map[string]struct {
+		line   string
+		wanted string
+	}{
+		"returns lines that aren't JSON as is": {
+			line:   "listening on :8080",
+			wanted: "listening on :8080",
+		},
+		"returns JSON values that aren't objects as is": {
+			line:   `["a","b"]`,
+			wanted: `["a","b"]`,
+		},
+		"returns lines with several JSON objects as is": {
+			line:   `{"msg":"a"} {"msg":"b"}`,
+			wanted: `{"msg":"a"} {"msg":"b"}`,
+		},
+		"formats the level, the message and the sorted fields, and drops the time": {
+			line:   `{"time":"2023-01-01T00:00:00Z","level":"info","msg":"request served","status":200,"path":"/api","user agent":"curl 8.0","ok":true}`,
+			wanted: `INFO  request served ok=true path=/api status=200 user agent="curl 8.0"`,
+		},
+		"keeps the precision of numbers": {
+			line:   `{"message":"done","elapsed":12345678901234567890}`,
+			wanted: `done elapsed=12345678901234567890`,
+		},
+		"supports other level keys": {
+			line:   `{"severity":"WARNING","message":"disk almost full"}`,
+			wanted: `WARNING disk almost full`,
+		},
+		"keeps a level key that isn't a string as a field": {
+			line:   `{"level":30,"msg":"hello"}`,
+			wanted: `hello level=30`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, FormatJSONLogLine(tc.line))
+		})
+	}
+}
+
+func TestAWSLogsEntryStart(t *testing.T) {
+	testCases := map[string]struct {
+		options map[string]*string
+
+		wantedEntryStarts []bool // Whether each of the lines starts an entry, nil if the lines aren't grouped.
+		wantedErr         error
+	}{
+		"returns nil if the lines aren't grouped": {
+			options: map[string]*string{
+				"awslogs-group": aws.String("/copilot/app-env-svc"),
+			},
+		},
+		"errors if both options are set": {
+			options: map[string]*string{
+				AWSLogsMultilinePatternOption: aws.String(`^\[`),
+				AWSLogsDatetimeFormatOption:   aws.String(`%Y-%m-%d`),
+			},
+			wantedErr: errors.New(`options "awslogs-multiline-pattern" and "awslogs-datetime-format" can't be set together`),
+		},
+		"errors if the multiline pattern is invalid": {
+			options: map[string]*string{
+				AWSLogsMultilinePatternOption: aws.String(`^[`),
+			},
+			wantedErr: errors.New("compile the multiline pattern \"^[\": error parsing regexp: missing closing ]: `[`"),
+		},
+		"starts an entry at each line that matches the multiline pattern": {
+			options: map[string]*string{
+				AWSLogsMultilinePatternOption: aws.String(`^\[(INFO|ERROR)\]`),
+			},
+			wantedEntryStarts: []bool{true, true, false, false},
+		},
+		"starts an entry at each line that begins with the datetime format": {
+			options: map[string]*string{
+				AWSLogsDatetimeFormatOption: aws.String(`%Y-%m-%d %H:%M:%S`),
+			},
+			wantedEntryStarts: []bool{false, false, false, true},
+		},
+	}
+	lines := []string{
+		"[INFO] listening on :8080",
+		"[ERROR] panic: runtime error",
+		"	at main.go:10",
+		"2023-01-01 10:00:00 shutting down",
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			isEntryStart, err := AWSLogsEntryStart(tc.options)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedEntryStarts == nil {
+				require.Nil(t, isEntryStart)
+				return
+			}
+			for i, line := range lines {
+				require.Equal(t, tc.wantedEntryStarts[i], isEntryStart(line), line)
+			}
+		})
+	}
+}
+
+func TestNewLocalLogFile(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	dir := LocalLogsDir("/ws", "api")
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "api.log"), []byte("previous run\n"), 0644))
+
+	f, err := NewLocalLogFile(fs, dir, "api")
+	require.NoError(t, err)
+	f.(*localLogFile).now = func() time.Time {
+		return time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	_, err = fmt.Fprintln(f, "hello")
+	require.NoError(t, err)
+	_, err = fmt.Fprintln(f, "world")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	content, err := afero.ReadFile(fs, "/ws/.copilot/logs/api/api.log")
+	require.NoError(t, err)
+	require.Equal(t, "2023-01-01T00:00:00Z hello\n2023-01-01T00:00:00Z world\n", string(content))
+}
+
+func TestLocalWorkloadLogger_WriteLogEvents(t *testing.T) {
+	const dir = "/ws/.copilot/logs/api"
+	files := map[string]string{
+		"api.log": `2023-01-01T00:00:01Z starting
+2023-01-01T00:00:03Z listening on :8080
+not a timestamped line
+`,
+		"sidecar.log": `2023-01-01T00:00:02Z sidecar ready
+2023-01-01T00:00:04Z partial`, // The last line isn't complete yet.
+		"notes.txt": "ignored\n",
+	}
+	mockNow := time.Date(2023, 1, 1, 0, 0, 10, 0, time.UTC)
+	testCases := map[string]struct {
+		limit         *int64
+		startTime     *int64
+		endTime       *int64
+		containerName string
+		jsonOutput    bool
+
+		wantedContent string
+	}{
+		"writes the complete lines of all containers sorted by time": {
+			wantedContent: `api starting
+sidecar sidecar ready
+api listening on :8080
+api not a timestamped line
+`,
+		},
+		"writes the lines between the start and end time": {
+			startTime: aws.Int64(time.Date(2023, 1, 1, 0, 0, 2, 0, time.UTC).UnixMilli()),
+			endTime:   aws.Int64(time.Date(2023, 1, 1, 0, 0, 2, 0, time.UTC).UnixMilli()),
+			wantedContent: `sidecar sidecar ready
+`,
+		},
+		"writes the last lines up to the limit": {
+			limit: aws.Int64(2),
+			wantedContent: `api listening on :8080
+api not a timestamped line
+`,
+		},
+		"writes only the lines of the container": {
+			limit:         aws.Int64(10),
+			containerName: "sidecar",
+			wantedContent: `sidecar sidecar ready
+`,
+		},
+		"writes the lines in json": {
+			limit:         aws.Int64(1),
+			containerName: "sidecar",
+			jsonOutput:    true,
+			wantedContent: fmt.Sprintf(`{"logStreamName":"sidecar","ingestionTime":%[1]d,"message":"sidecar ready","timestamp":%[1]d}`+"\n",
+				time.Date(2023, 1, 1, 0, 0, 2, 0, time.UTC).UnixMilli()),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			for name, content := range files {
+				require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, name), []byte(content), 0644))
+			}
+			b := &bytes.Buffer{}
+			logger := NewLocalWorkloadLogger(fs, dir)
+			logger.w = b
+			logger.now = func() time.Time { return mockNow }
+			onEvents := WriteHumanLogs
+			if tc.jsonOutput {
+				onEvents = WriteJSONLogs
+			}
+
+			err := logger.WriteLogEvents(WriteLogEventsOpts{
+				Limit:         tc.limit,
+				StartTime:     tc.startTime,
+				EndTime:       tc.endTime,
+				ContainerName: tc.containerName,
+				OnEvents:      onEvents,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func TestLocalWorkloadLogger_WriteLogEvents_Follow(t *testing.T) {
+	const dir = "/ws/.copilot/logs/api"
+	fs := afero.NewMemMapFs()
+	path := filepath.Join(dir, "api.log")
+	require.NoError(t, afero.WriteFile(fs, path, []byte("2023-01-01T00:00:01Z starting\n2023-01-01T00:00:02Z list"), 0644))
+	b := &bytes.Buffer{}
+	logger := NewLocalWorkloadLogger(fs, dir)
+	logger.w = b
+	logger.now = func() time.Time { return time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC) }
+	polls := 0
+	logger.sleep = func() {
+		polls++
+		if polls == 1 {
+			require.NoError(t, afero.WriteFile(fs, path, []byte("2023-01-01T00:00:01Z starting\n2023-01-01T00:00:02Z listening\n"), 0644))
+		}
+	}
+	errStop := fmt.Errorf("stop")
+
+	err := logger.WriteLogEvents(WriteLogEventsOpts{
+		Follow: true,
+		OnEvents: func(w io.Writer, logs []HumanJSONStringer) error {
+			if polls == 2 {
+				return errStop
+			}
+			return WriteHumanLogs(w, logs)
+		},
+	})
+
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, "api starting\napi listening\n", b.String())
+}
@@ -7,7 +7,10 @@ package mocks
 import (
 	reflect "reflect"
 
+	apprunner "github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -86,3 +89,94 @@ func (mr *MockserviceARNGetterMockRecorder) ServiceARN(env interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceARN", reflect.TypeOf((*MockserviceARNGetter)(nil).ServiceARN), env)
 }
+
+// MockoperationsGetter is a mock of operationsGetter interface.
+type MockoperationsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockoperationsGetterMockRecorder
+}
+
+// MockoperationsGetterMockRecorder is the mock recorder for MockoperationsGetter.
+type MockoperationsGetterMockRecorder struct {
+	mock *MockoperationsGetter
+}
+
+// NewMockoperationsGetter creates a new mock instance.
+func NewMockoperationsGetter(ctrl *gomock.Controller) *MockoperationsGetter {
+	mock := &MockoperationsGetter{ctrl: ctrl}
+	mock.recorder = &MockoperationsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoperationsGetter) EXPECT() *MockoperationsGetterMockRecorder {
+	return m.recorder
+}
+
+// Operations mocks base method.
+func (m *MockoperationsGetter) Operations(svcARN string, limit int) ([]apprunner.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Operations", svcARN, limit)
+	ret0, _ := ret[0].([]apprunner.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Operations indicates an expected call of Operations.
+func (mr *MockoperationsGetterMockRecorder) Operations(svcARN, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Operations", reflect.TypeOf((*MockoperationsGetter)(nil).Operations), svcARN, limit)
+}
+
+// MockserviceDescriber is a mock of serviceDescriber interface.
+type MockserviceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceDescriberMockRecorder
+}
+
+// MockserviceDescriberMockRecorder is the mock recorder for MockserviceDescriber.
+type MockserviceDescriberMockRecorder struct {
+	mock *MockserviceDescriber
+}
+
+// NewMockserviceDescriber creates a new mock instance.
+func NewMockserviceDescriber(ctrl *gomock.Controller) *MockserviceDescriber {
+	mock := &MockserviceDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceDescriber) EXPECT() *MockserviceDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeService mocks base method.
+func (m *MockserviceDescriber) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
+	ret0, _ := ret[0].(*ecs0.ServiceDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockserviceDescriberMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockserviceDescriber)(nil).DescribeService), app, env, svc)
+}
+
+// Service mocks base method.
+func (m *MockserviceDescriber) Service(app, env, svc string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", app, env, svc)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockserviceDescriberMockRecorder) Service(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockserviceDescriber)(nil).Service), app, env, svc)
+}
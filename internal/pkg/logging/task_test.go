@@ -133,8 +133,9 @@ func TestEventsWriter_WriteEventsUntilStopped(t *testing.T) {
 			tc.setUpMocks(mocks)
 
 			ew := &TaskClient{
-				groupName: groupName,
-				tasks:     tc.tasks,
+				logGroup:        "/copilot/" + groupName,
+				logStreamPrefix: "copilot-task/" + groupName,
+				tasks:           tc.tasks,
 
 				eventsWriter:  mockWriter{},
 				eventsLogger:  mocks.logGetter,
@@ -7,6 +7,7 @@ package logging
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,12 +15,16 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
 
 const (
 	defaultServiceLogsLimit = 10
+	// Number of the most recent operations of an App Runner service to look for the latest deployment in.
+	appRunnerOperationsLimit = 20
 
 	fmtWkldLogGroupName         = "/copilot/%s-%s-%s"
 	wkldLogStreamPrefix         = "copilot"
@@ -34,6 +39,15 @@ type serviceARNGetter interface {
 	ServiceARN(env string) (string, error)
 }
 
+type operationsGetter interface {
+	Operations(svcARN string, limit int) ([]apprunner.Operation, error)
+}
+
+type serviceDescriber interface {
+	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
+	Service(app, env, svc string) (*awsecs.Service, error)
+}
+
 // NewWorkloadLoggerOpts contains fields that initiate workloadLogger struct.
 type NewWorkloadLoggerOpts struct {
 	App  string
@@ -107,13 +121,23 @@ func ecsLogStreamPrefixes(taskIDs []string, service, container string) []string
 // NewECSServiceClient returns an ECSServiceClient for the service under env and app.
 func NewECSServiceClient(opts *NewWorkloadLoggerOpts) *ECSServiceLogger {
 	return &ECSServiceLogger{
-		workloadLogger: newWorkloadLogger(opts),
+		workloadLogger:   newWorkloadLogger(opts),
+		serviceDescriber: ecs.New(opts.Sess),
+		annotations:      log.DiagnosticWriter,
+		sleep: func() {
+			time.Sleep(cloudwatchlogs.SleepDuration)
+		},
 	}
 }
 
 // ECSServiceLogger retrieves the logs of an Amazon ECS service.
 type ECSServiceLogger struct {
 	*workloadLogger
+	serviceDescriber serviceDescriber
+	annotations      io.Writer // Receives the tasks that start and stop while following the logs.
+
+	// Replaced in tests.
+	sleep func()
 }
 
 // WriteLogEvents writes service logs.
@@ -131,6 +155,26 @@ func (s *ECSServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		LogStreamLimit:         opts.LogStreamLimit,
 		LogStreamPrefixFilters: s.logStreamPrefixes(opts.TaskIDs, opts.ContainerName),
 	}
+	if opts.Follow && len(opts.TaskIDs) == 0 && opts.LogGroup == "" {
+		// The log streams of the tasks are named after them, so the tasks that replace the ones
+		// of a deployment are followed as soon as they are discovered.
+		return s.followServiceTasks(logEventsOpts, opts)
+	}
+	if opts.LatestDeployment {
+		running, stopped, err := s.serviceTasks(true)
+		if err != nil {
+			return err
+		}
+		tasks, err := newFollowedTasks(append(running, stopped...))
+		if err != nil {
+			return err
+		}
+		if len(tasks) == 0 {
+			fmt.Fprintln(s.annotations, "No task of the latest deployment was found.")
+			return nil
+		}
+		logEventsOpts.LogStreamPrefixFilters = tasks.logStreamPrefixes(opts.ContainerName)
+	}
 	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow)
 }
 
@@ -138,6 +182,152 @@ func (s *ECSServiceLogger) logStreamPrefixes(taskIDs []string, container string)
 	return ecsLogStreamPrefixes(taskIDs, s.name, container)
 }
 
+// followServiceTasks writes the log events of the running tasks of the service until an error occurs.
+// The tasks are discovered again every numCWLogsCallsPerRound calls, and each task that starts or stops is annotated.
+// The log streams of a task that stopped are read for one more round, so that its last events are written.
+func (s *ECSServiceLogger) followServiceTasks(logEventsOpts cloudwatchlogs.LogEventsOpts, opts WriteLogEventsOpts) error {
+	var followed followedTasks
+	for discovered := false; ; discovered = true {
+		running, stopped, err := s.serviceTasks(opts.LatestDeployment)
+		if err != nil {
+			return err
+		}
+		current, err := newFollowedTasks(running)
+		if err != nil {
+			return err
+		}
+		stoppedReasons := make(map[string]string)
+		for _, task := range stopped {
+			if id, err := awsecs.TaskID(aws.StringValue(task.TaskArn)); err == nil {
+				stoppedReasons[id] = aws.StringValue(task.StoppedReason)
+			}
+		}
+		reading := make(followedTasks)
+		for _, id := range current.ids() {
+			reading[id] = current[id]
+			if _, ok := followed[id]; ok {
+				continue
+			}
+			if !discovered {
+				fmt.Fprintf(s.annotations, "Following the logs of task %s.\n", taskDescription(current[id]))
+				continue
+			}
+			fmt.Fprintf(s.annotations, "Task %s started, following its logs.\n", taskDescription(current[id]))
+		}
+		for _, id := range followed.ids() {
+			if _, ok := current[id]; ok {
+				continue
+			}
+			reading[id] = followed[id]
+			reason, ok := stoppedReasons[id]
+			switch {
+			case !ok && opts.LatestDeployment:
+				fmt.Fprintf(s.annotations, "Task %s is not part of the latest deployment anymore.\n", id)
+			case reason != "":
+				fmt.Fprintf(s.annotations, "Task %s stopped: %s.\n", id, strings.TrimSuffix(reason, "."))
+			default:
+				fmt.Fprintf(s.annotations, "Task %s stopped.\n", id)
+			}
+		}
+		followed = current
+
+		logEventsOpts.LogStreamPrefixFilters = reading.logStreamPrefixes(opts.ContainerName)
+		for i := 0; i < numCWLogsCallsPerRound; i++ {
+			if len(logEventsOpts.LogStreamPrefixFilters) > 0 {
+				out, err := s.eventsGetter.LogEvents(logEventsOpts)
+				if err != nil {
+					return fmt.Errorf("get log events for log group %s: %w", logEventsOpts.LogGroup, err)
+				}
+				if err := opts.OnEvents(s.w, cwEventsToHumanJSONStringers(out.Events)); err != nil {
+					return err
+				}
+				logEventsOpts.StreamLastEventTime = out.StreamLastEventTime
+			}
+			s.sleep()
+		}
+	}
+}
+
+// serviceTasks returns the running and the stopped tasks of the service.
+// If latestDeployment is true, it only returns the tasks started by the primary deployment of the service.
+func (s *ECSServiceLogger) serviceTasks(latestDeployment bool) (running, stopped []*awsecs.Task, err error) {
+	desc, err := s.serviceDescriber.DescribeService(s.app, s.env, s.name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("describe service %s: %w", s.name, err)
+	}
+	if !latestDeployment {
+		return desc.Tasks, desc.StoppedTasks, nil
+	}
+	svc, err := s.serviceDescriber.Service(s.app, s.env, s.name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get service %s: %w", s.name, err)
+	}
+	var deploymentID string
+	for _, deployment := range svc.Deployments {
+		if aws.StringValue(deployment.Status) == awsecs.ServiceDeploymentStatusPrimary {
+			deploymentID = aws.StringValue(deployment.Id)
+		}
+	}
+	return tasksStartedBy(desc.Tasks, deploymentID), tasksStartedBy(desc.StoppedTasks, deploymentID), nil
+}
+
+func tasksStartedBy(tasks []*awsecs.Task, deploymentID string) []*awsecs.Task {
+	var started []*awsecs.Task
+	for _, task := range tasks {
+		if aws.StringValue(task.StartedBy) == deploymentID {
+			started = append(started, task)
+		}
+	}
+	return started
+}
+
+// followedTasks are the tasks whose log streams are read, by ID.
+type followedTasks map[string]*awsecs.Task
+
+func newFollowedTasks(tasks []*awsecs.Task) (followedTasks, error) {
+	followed := make(followedTasks)
+	for _, task := range tasks {
+		id, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return nil, err
+		}
+		followed[id] = task
+	}
+	return followed, nil
+}
+
+func (t followedTasks) ids() []string {
+	ids := make([]string, 0, len(t))
+	for id := range t {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// logStreamPrefixes returns the log streams of the container of each task, or of all its containers if container is empty.
+func (t followedTasks) logStreamPrefixes(container string) []string {
+	var prefixes []string
+	for _, id := range t.ids() {
+		if container != "" {
+			prefixes = append(prefixes, fmt.Sprintf("%s/%s/%s", wkldLogStreamPrefix, container, id))
+			continue
+		}
+		for _, ctr := range t[id].Containers {
+			prefixes = append(prefixes, fmt.Sprintf("%s/%s/%s", wkldLogStreamPrefix, aws.StringValue(ctr.Name), id))
+		}
+	}
+	return prefixes
+}
+
+func taskDescription(task *awsecs.Task) string {
+	id, _ := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if deployment := aws.StringValue(task.StartedBy); deployment != "" {
+		return fmt.Sprintf("%s of deployment %s", id, deployment)
+	}
+	return id
+}
+
 // NewAppRunnerServiceLoggerOpts contains fields that initiate AppRunnerServiceLoggerOpts struct.
 type NewAppRunnerServiceLoggerOpts struct {
 	*NewWorkloadLoggerOpts
@@ -157,6 +347,7 @@ func NewAppRunnerServiceLogger(opts *NewAppRunnerServiceLoggerOpts) (*AppRunnerS
 	return &AppRunnerServiceLogger{
 		workloadLogger:   newWorkloadLogger(opts.NewWorkloadLoggerOpts),
 		serviceARNGetter: serviceDescriber,
+		operationsGetter: apprunner.New(opts.Sess),
 	}, nil
 }
 
@@ -164,11 +355,13 @@ func NewAppRunnerServiceLogger(opts *NewAppRunnerServiceLoggerOpts) (*AppRunnerS
 type AppRunnerServiceLogger struct {
 	*workloadLogger
 	serviceARNGetter serviceARNGetter
+	operationsGetter operationsGetter
 }
 
 // WriteLogEvents writes service logs.
 func (s *AppRunnerServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 	var logGroup string
+	var logStreamPrefixes []string
 	switch strings.ToLower(opts.LogGroup) {
 	case "system":
 		serviceArn, err := s.serviceARNGetter.ServiceARN(s.env)
@@ -184,6 +377,18 @@ func (s *AppRunnerServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		if err != nil {
 			return fmt.Errorf("get service ARN for %s: %w", s.name, err)
 		}
+		if opts.LatestDeployment {
+			// App Runner writes the logs of each deployment to a dedicated stream of the service log group.
+			if logGroup, err = apprunner.SystemLogGroupName(serviceArn); err != nil {
+				return fmt.Errorf("get system log group name: %w", err)
+			}
+			id, err := s.latestDeploymentID(serviceArn)
+			if err != nil {
+				return err
+			}
+			logStreamPrefixes = []string{apprunner.DeploymentLogStreamName(id)}
+			break
+		}
 		logGroup, err = apprunner.LogGroupName(serviceArn)
 		if err != nil {
 			return fmt.Errorf("get log group name: %w", err)
@@ -192,16 +397,31 @@ func (s *AppRunnerServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		logGroup = opts.LogGroup
 	}
 	logEventsOpts := cloudwatchlogs.LogEventsOpts{
-		LogGroup:            logGroup,
-		Limit:               opts.limit(),
-		StartTime:           opts.startTime(s.now),
-		EndTime:             opts.EndTime,
-		StreamLastEventTime: nil,
-		LogStreamLimit:      opts.LogStreamLimit,
+		LogGroup:               logGroup,
+		LogStreamPrefixFilters: logStreamPrefixes,
+		Limit:                  opts.limit(),
+		StartTime:              opts.startTime(s.now),
+		EndTime:                opts.EndTime,
+		StreamLastEventTime:    nil,
+		LogStreamLimit:         opts.LogStreamLimit,
 	}
 	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow)
 }
 
+// latestDeploymentID returns the ID of the most recent deployment operation of the service.
+func (s *AppRunnerServiceLogger) latestDeploymentID(serviceARN string) (string, error) {
+	operations, err := s.operationsGetter.Operations(serviceARN, appRunnerOperationsLimit)
+	if err != nil {
+		return "", fmt.Errorf("get operations of service %s: %w", s.name, err)
+	}
+	for _, op := range operations {
+		if op.IsDeployment() {
+			return op.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no deployment found for service %s", s.name)
+}
+
 // NewJobLogger returns an JobLogger for the job under env and app.
 func NewJobLogger(opts *NewWorkloadLoggerOpts) *JobLogger {
 	return &JobLogger{
@@ -268,6 +488,9 @@ type WriteLogEventsOpts struct {
 	// ECS specific options.
 	ContainerName string
 	TaskIDs       []string
+	// LatestDeployment only keeps the tasks started by the primary deployment of an ECS service,
+	// or the logs of the most recent deployment of an App Runner service.
+	LatestDeployment bool
 }
 
 func (o WriteLogEventsOpts) limit() *int64 {
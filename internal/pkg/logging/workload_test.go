@@ -7,11 +7,17 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/logging/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -20,6 +26,7 @@ import (
 type workloadLogsMocks struct {
 	logGetter        *mocks.MocklogGetter
 	serviceARNGetter *mocks.MockserviceARNGetter
+	operationsGetter *mocks.MockoperationsGetter
 }
 
 func TestECSServiceLogger_WriteLogEvents(t *testing.T) {
@@ -245,6 +252,172 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 	}
 }
 
+func TestECSServiceLogger_WriteLogEvents_tasks(t *testing.T) {
+	newTask := func(id, deployment, reason string) *awsecs.Task {
+		return &awsecs.Task{
+			TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-cluster/" + id),
+			StartedBy:     aws.String(deployment),
+			StoppedReason: aws.String(reason),
+			Containers: []*sdkecs.Container{
+				{Name: aws.String("mockSvc")},
+				{Name: aws.String("nginx")},
+			},
+		}
+	}
+	eventsOf := func(stream, message string) *cloudwatchlogs.LogEventsOutput {
+		return &cloudwatchlogs.LogEventsOutput{
+			Events: []*cloudwatchlogs.Event{{LogStreamName: stream, Message: message}},
+		}
+	}
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		follow           bool
+		latestDeployment bool
+		containerName    string
+		setupMocks       func(logGetter *mocks.MocklogGetter, describer *mocks.MockserviceDescriber)
+
+		wantedError       error
+		wantedContent     string
+		wantedAnnotations string
+	}{
+		"error if fail to describe the service": {
+			follow: true,
+			setupMocks: func(logGetter *mocks.MocklogGetter, describer *mocks.MockserviceDescriber) {
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(nil, testErr)
+			},
+			wantedError: errors.New("describe service mockSvc: some error"),
+		},
+		"follow the tasks that replace the stopped ones": {
+			follow: true,
+			setupMocks: func(logGetter *mocks.MocklogGetter, describer *mocks.MockserviceDescriber) {
+				gomock.InOrder(
+					describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						Tasks: []*awsecs.Task{newTask("old", "ecs-svc/1", "")},
+					}, nil),
+					logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, []string{"copilot/mockSvc/old", "copilot/nginx/old"}, param.LogStreamPrefixFilters)
+						}).
+						Return(eventsOf("copilot/mockSvc/old", "hello"), nil).Times(numCWLogsCallsPerRound),
+					describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						Tasks:        []*awsecs.Task{newTask("new", "ecs-svc/2", "")},
+						StoppedTasks: []*awsecs.Task{newTask("old", "ecs-svc/1", "Scaling activity initiated by deployment ecs-svc/2")},
+					}, nil),
+					logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, []string{"copilot/mockSvc/new", "copilot/nginx/new", "copilot/mockSvc/old", "copilot/nginx/old"}, param.LogStreamPrefixFilters)
+						}).
+						Return(eventsOf("copilot/mockSvc/new", "world"), nil).Times(numCWLogsCallsPerRound),
+					describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(nil, testErr),
+				)
+			},
+			wantedError:   errors.New("describe service mockSvc: some error"),
+			wantedContent: strings.Repeat("copilot/mockSvc/old hello\n", numCWLogsCallsPerRound) + strings.Repeat("copilot/mockSvc/new world\n", numCWLogsCallsPerRound),
+			wantedAnnotations: `Following the logs of task old of deployment ecs-svc/1.
+Task new of deployment ecs-svc/2 started, following its logs.
+Task old stopped: Scaling activity initiated by deployment ecs-svc/2.
+`,
+		},
+		"only follow the tasks of the latest deployment": {
+			follow:           true,
+			latestDeployment: true,
+			containerName:    "nginx",
+			setupMocks: func(logGetter *mocks.MocklogGetter, describer *mocks.MockserviceDescriber) {
+				gomock.InOrder(
+					describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						Tasks: []*awsecs.Task{newTask("old", "ecs-svc/1", ""), newTask("new", "ecs-svc/2", "")},
+					}, nil),
+					describer.EXPECT().Service("mockApp", "mockEnv", "mockSvc").Return(&awsecs.Service{
+						Deployments: []*sdkecs.Deployment{
+							{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY")},
+							{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE")},
+						},
+					}, nil),
+					logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, []string{"copilot/nginx/new"}, param.LogStreamPrefixFilters)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{}, nil).Times(numCWLogsCallsPerRound),
+					describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{}, nil),
+					describer.EXPECT().Service("mockApp", "mockEnv", "mockSvc").Return(nil, testErr),
+				)
+			},
+			wantedError:       errors.New("get service mockSvc: some error"),
+			wantedAnnotations: "Following the logs of task new of deployment ecs-svc/2.\n",
+		},
+		"read the logs of the tasks of the latest deployment without following them": {
+			latestDeployment: true,
+			setupMocks: func(logGetter *mocks.MocklogGetter, describer *mocks.MockserviceDescriber) {
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks:        []*awsecs.Task{newTask("old", "ecs-svc/1", "")},
+					StoppedTasks: []*awsecs.Task{newTask("crashed", "ecs-svc/2", "Essential container in task exited")},
+				}, nil)
+				describer.EXPECT().Service("mockApp", "mockEnv", "mockSvc").Return(&awsecs.Service{
+					Deployments: []*sdkecs.Deployment{{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY")}},
+				}, nil)
+				logGetter.EXPECT().LogEvents(gomock.Any()).
+					Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, []string{"copilot/mockSvc/crashed", "copilot/nginx/crashed"}, param.LogStreamPrefixFilters)
+					}).
+					Return(eventsOf("copilot/mockSvc/crashed", "panic"), nil)
+			},
+			wantedContent: "copilot/mockSvc/crashed panic\n",
+		},
+		"nothing to read if the latest deployment has no tasks": {
+			latestDeployment: true,
+			setupMocks: func(logGetter *mocks.MocklogGetter, describer *mocks.MockserviceDescriber) {
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{}, nil)
+				describer.EXPECT().Service("mockApp", "mockEnv", "mockSvc").Return(&awsecs.Service{}, nil)
+			},
+			wantedAnnotations: "No task of the latest deployment was found.\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			logGetter := mocks.NewMocklogGetter(ctrl)
+			describer := mocks.NewMockserviceDescriber(ctrl)
+			tc.setupMocks(logGetter, describer)
+			out, annotations := &bytes.Buffer{}, &bytes.Buffer{}
+			svcLogs := &ECSServiceLogger{
+				workloadLogger: &workloadLogger{
+					app:          "mockApp",
+					env:          "mockEnv",
+					name:         "mockSvc",
+					eventsGetter: logGetter,
+					w:            out,
+					now:          time.Now,
+				},
+				serviceDescriber: describer,
+				annotations:      annotations,
+				sleep:            func() {},
+			}
+
+			err := svcLogs.WriteLogEvents(WriteLogEventsOpts{
+				Follow:           tc.follow,
+				LatestDeployment: tc.latestDeployment,
+				ContainerName:    tc.containerName,
+				OnEvents: func(w io.Writer, logs []HumanJSONStringer) error {
+					for _, l := range logs {
+						event := l.(*cloudwatchlogs.Event)
+						fmt.Fprintf(w, "%s %s\n", event.LogStreamName, event.Message)
+					}
+					return nil
+				},
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedContent, out.String())
+			require.Equal(t, tc.wantedAnnotations, annotations.String())
+		})
+	}
+}
+
 func TestAppRunnerServiceLogger_WriteLogEvents(t *testing.T) {
 	const (
 		logEventsHumanString = `instance/85372273718e4806 web-server@1.0.0 start /app
@@ -266,12 +439,13 @@ instance/4e66ee07f2034a7c Server is running on port 4055
 	var mockNilLimit *int64
 	mockStartTime := aws.Int64(123456789)
 	testCases := map[string]struct {
-		follow       bool
-		limit        *int64
-		startTime    *int64
-		jsonOutput   bool
-		logGroupName string
-		setupMocks   func(mocks workloadLogsMocks)
+		follow           bool
+		limit            *int64
+		startTime        *int64
+		jsonOutput       bool
+		logGroupName     string
+		latestDeployment bool
+		setupMocks       func(mocks workloadLogsMocks)
 
 		wantedError   error
 		wantedContent string
@@ -346,6 +520,44 @@ instance/4e66ee07f2034a7c Server is running on port 4055
 			},
 			wantedContent: logEventsHumanString,
 		},
+		"failed to get the operations of the service": {
+			latestDeployment: true,
+			setupMocks: func(m workloadLogsMocks) {
+				m.serviceARNGetter.EXPECT().ServiceARN("mockEnv").Return("arn:aws:apprunner:us-east-1:11111111111:service/mockSvc/mockSvcID", nil)
+				m.operationsGetter.EXPECT().Operations("arn:aws:apprunner:us-east-1:11111111111:service/mockSvc/mockSvcID", 20).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get operations of service mockSvc: some error"),
+		},
+		"error if the service has no deployment": {
+			latestDeployment: true,
+			setupMocks: func(m workloadLogsMocks) {
+				m.serviceARNGetter.EXPECT().ServiceARN("mockEnv").Return("arn:aws:apprunner:us-east-1:11111111111:service/mockSvc/mockSvcID", nil)
+				m.operationsGetter.EXPECT().Operations(gomock.Any(), gomock.Any()).Return([]apprunner.Operation{
+					{ID: "op1", Type: "PAUSE_SERVICE"},
+				}, nil)
+			},
+			wantedError: fmt.Errorf("no deployment found for service mockSvc"),
+		},
+		"success with the logs of the latest deployment": {
+			latestDeployment: true,
+			setupMocks: func(m workloadLogsMocks) {
+				gomock.InOrder(
+					m.serviceARNGetter.EXPECT().ServiceARN("mockEnv").Return("arn:aws:apprunner:us-east-1:11111111111:service/mockSvc/mockSvcID", nil),
+					m.operationsGetter.EXPECT().Operations(gomock.Any(), gomock.Any()).Return([]apprunner.Operation{
+						{ID: "op2", Type: "RESUME_SERVICE"},
+						{ID: "op1", Type: "START_DEPLOYMENT"},
+					}, nil),
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, "/aws/apprunner/mockSvc/mockSvcID/service", param.LogGroup)
+							require.Equal(t, []string{"deployment/op1"}, param.LogStreamPrefixFilters)
+						}).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: logEvents,
+					}, nil),
+				)
+			},
+			wantedContent: logEventsHumanString,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -356,6 +568,7 @@ instance/4e66ee07f2034a7c Server is running on port 4055
 			m := workloadLogsMocks{
 				logGetter:        mocks.NewMocklogGetter(ctrl),
 				serviceARNGetter: mocks.NewMockserviceARNGetter(ctrl),
+				operationsGetter: mocks.NewMockoperationsGetter(ctrl),
 			}
 
 			tc.setupMocks(m)
@@ -370,6 +583,7 @@ instance/4e66ee07f2034a7c Server is running on port 4055
 					w:            b,
 				},
 				serviceARNGetter: m.serviceARNGetter,
+				operationsGetter: m.operationsGetter,
 			}
 
 			// WHEN
@@ -378,11 +592,12 @@ instance/4e66ee07f2034a7c Server is running on port 4055
 				logWriter = WriteJSONLogs
 			}
 			err := svcLogs.WriteLogEvents(WriteLogEventsOpts{
-				Follow:    tc.follow,
-				Limit:     tc.limit,
-				StartTime: tc.startTime,
-				LogGroup:  tc.logGroupName,
-				OnEvents:  logWriter,
+				Follow:           tc.follow,
+				Limit:            tc.limit,
+				StartTime:        tc.startTime,
+				LogGroup:         tc.logGroupName,
+				OnEvents:         logWriter,
+				LatestDeployment: tc.latestDeployment,
 			})
 
 			// THEN
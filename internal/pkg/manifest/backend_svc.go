@@ -36,6 +36,8 @@ type BackendServiceConfig struct {
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
 	DeployConfig     DeploymentConfig          `yaml:"deployment"`
 	Observability    Observability             `yaml:"observability"`
+	Tags             map[string]string         `yaml:"tags"`
+	Stack            StackSettings             `yaml:"stack"`
 }
 
 // BackendServiceProps represents the configuration needed to create a backend service.
@@ -116,6 +118,11 @@ func (s *BackendService) Publish() []Topic {
 	return s.BackendServiceConfig.PublishConfig.publishedTopics()
 }
 
+// StackSettings returns the protections of the CloudFormation stack of the service.
+func (s *BackendService) StackSettings() StackSettings {
+	return s.Stack
+}
+
 // BuildArgs returns a docker.BuildArguments object for the service given a context directory.
 func (s *BackendService) BuildArgs(contextDir string) (map[string]*DockerBuildArgs, error) {
 	required, err := requiresBuild(s.ImageConfig.Image)
@@ -125,11 +132,23 @@ func (s *BackendService) BuildArgs(contextDir string) (map[string]*DockerBuildAr
 	// Creating an map to store buildArgs of all sidecar images and main container image.
 	buildArgsPerContainer := make(map[string]*DockerBuildArgs, len(s.Sidecars)+1)
 	if required {
-		buildArgsPerContainer[aws.StringValue(s.Name)] = s.ImageConfig.Image.BuildConfig(contextDir)
+		buildArgsPerContainer[aws.StringValue(s.Name)] = withBuildOnlyVariables(s.ImageConfig.Image.BuildConfig(contextDir), s.TaskConfig.Variables)
 	}
 	return buildArgs(contextDir, buildArgsPerContainer, s.Sidecars)
 }
 
+// ImageLocations returns the locations of the existing images that the containers of the service run.
+// The keys of the map are container names, and the values are image URIs.
+func (s *BackendService) ImageLocations() map[string]string {
+	return imageLocations(s.Name, s.ImageConfig.Image, s.Sidecars)
+}
+
+// FileSyncs returns the files of the workspace that are copied into the containers of the service run locally when they change.
+// The keys of the map are container names.
+func (s *BackendService) FileSyncs() map[string]ImageSync {
+	return fileSyncs(s.Name, s.ImageConfig.Image, s.Sidecars)
+}
+
 // EnvFiles returns the locations of all env files against the ws root directory.
 // This method returns a map[string]string where the keys are container names
 // and the values are either env file paths or empty strings.
@@ -486,7 +486,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 					CPU: aws.Int(512),
 					Variables: map[string]Variable{
 						"LOG_LEVEL": {
-							StringOrFromCFN{
+							StringOrFromCFN: StringOrFromCFN{
 								Plain: stringP(""),
 							},
 						},
@@ -701,7 +701,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 						},
 						Variables: map[string]Variable{
 							"LOG_LEVEL": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									Plain: stringP(""),
 								},
 							},
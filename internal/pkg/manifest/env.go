@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -37,17 +38,22 @@ type Environment struct {
 // EnvironmentProps contains properties for creating a new environment manifest.
 type EnvironmentProps struct {
 	Name         string
+	Profile      string // Optional. One of EnvProfiles.
 	CustomConfig *config.CustomizeEnv
 	Telemetry    *config.Telemetry
 }
 
 // NewEnvironment creates a new environment manifest object.
 func NewEnvironment(props *EnvironmentProps) *Environment {
-	return FromEnvConfig(&config.Environment{
+	env := FromEnvConfig(&config.Environment{
 		Name:         props.Name,
 		CustomConfig: props.CustomConfig,
 		Telemetry:    props.Telemetry,
 	}, template.New())
+	if props.Profile != "" {
+		env.Profile = stringP(props.Profile)
+	}
+	return env
 }
 
 // FromEnvConfig transforms an environment configuration into a manifest.
@@ -61,6 +67,9 @@ func FromEnvConfig(cfg *config.Environment, parser template.Parser) *Environment
 	var obs environmentObservability
 	obs.loadObsConfig(cfg.Telemetry)
 
+	var cluster environmentClusterConfig
+	cluster.loadClusterConfig(cfg.CustomConfig)
+
 	return &Environment{
 		Workload: Workload{
 			Name: stringP(cfg.Name),
@@ -70,6 +79,7 @@ func FromEnvConfig(cfg *config.Environment, parser template.Parser) *Environment
 			Network: environmentNetworkConfig{
 				VPC: vpc,
 			},
+			Cluster:       cluster,
 			HTTPConfig:    http,
 			Observability: obs,
 		},
@@ -89,12 +99,26 @@ func (e *Environment) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
+// StackSettings returns the protections of the CloudFormation stack of the environment.
+// The termination protection defaults to the one of the profile of the environment.
+func (e *Environment) StackSettings() StackSettings {
+	settings := e.Stack
+	if settings.TerminationProtection == nil && envProfileDefaultsByName[aws.StringValue(e.Profile)].terminationProtection {
+		settings.TerminationProtection = aws.Bool(true)
+	}
+	return settings
+}
+
 // EnvironmentConfig defines the configuration settings for an environment manifest
 type EnvironmentConfig struct {
-	Network       environmentNetworkConfig `yaml:"network,omitempty,flow"`
-	Observability environmentObservability `yaml:"observability,omitempty,flow"`
-	HTTPConfig    EnvironmentHTTPConfig    `yaml:"http,omitempty,flow"`
-	CDNConfig     EnvironmentCDNConfig     `yaml:"cdn,omitempty,flow"`
+	Profile         *string                    `yaml:"profile,omitempty"` // One of EnvProfiles, which changes the defaults of the environment and of its workloads.
+	Network         environmentNetworkConfig   `yaml:"network,omitempty,flow"`
+	Cluster         environmentClusterConfig   `yaml:"cluster,omitempty"`
+	Observability   environmentObservability   `yaml:"observability,omitempty,flow"`
+	HTTPConfig      EnvironmentHTTPConfig      `yaml:"http,omitempty,flow"`
+	CDNConfig       EnvironmentCDNConfig       `yaml:"cdn,omitempty,flow"`
+	Stack           StackSettings              `yaml:"stack,omitempty"`
+	CustomResources EnvironmentCustomResources `yaml:"custom_resources,omitempty"`
 }
 
 // IsPublicLBIngressRestrictedToCDN returns whether an environment has its
@@ -438,6 +462,55 @@ func (o *environmentObservability) loadObsConfig(tele *config.Telemetry) {
 	o.ContainerInsights = &tele.EnableContainerInsights
 }
 
+// environmentClusterConfig holds the configuration of the ECS cluster of an environment.
+type environmentClusterConfig struct {
+	ID   *string           `yaml:"id,omitempty"` // Name or ARN of an existing cluster to use instead of creating a new one.
+	Exec ClusterExecConfig `yaml:"exec,omitempty"`
+}
+
+// ClusterExecConfig holds the configuration of the sessions started with ECS Exec in the containers of the cluster.
+type ClusterExecConfig struct {
+	Encryption *bool           `yaml:"encryption,omitempty"` // If true, encrypts the sessions with a KMS key of the environment.
+	Logs       ClusterExecLogs `yaml:"logs,omitempty"`
+}
+
+// ClusterExecLogs holds the configuration of the log group that records the output of the sessions.
+type ClusterExecLogs struct {
+	Retention *int `yaml:"retention,omitempty"` // Number of days to retain the logs of the sessions.
+}
+
+// IsZero implements yaml.IsZeroer.
+func (c ClusterExecConfig) IsZero() bool {
+	return c.Encryption == nil && c.Logs.IsZero()
+}
+
+// IsZero implements yaml.IsZeroer.
+func (l ClusterExecLogs) IsZero() bool {
+	return l.Retention == nil
+}
+
+// IsEmpty returns true if no existing cluster is imported.
+func (c environmentClusterConfig) IsEmpty() bool {
+	return c.ID == nil
+}
+
+func (c *environmentClusterConfig) loadClusterConfig(env *config.CustomizeEnv) {
+	if env.IsEmpty() || env.ImportCluster == "" {
+		return
+	}
+	c.ID = aws.String(env.ImportCluster)
+}
+
+// EnvironmentCustomResources holds the settings of the Lambda functions backing the custom resources of an environment.
+type EnvironmentCustomResources struct {
+	Timeout *time.Duration `yaml:"timeout,omitempty"`
+}
+
+// IsEmpty returns true if there is no configuration to the environment's custom resources.
+func (c *EnvironmentCustomResources) IsEmpty() bool {
+	return c == nil || c.Timeout == nil
+}
+
 // EnvironmentHTTPConfig defines the configuration settings for an environment group's HTTP connections.
 type EnvironmentHTTPConfig struct {
 	Public  PublicHTTPConfig  `yaml:"public,omitempty"`
@@ -453,6 +526,9 @@ func (cfg *EnvironmentHTTPConfig) loadLBConfig(env *config.CustomizeEnv) {
 	if env.IsEmpty() {
 		return
 	}
+	if env.ImportPublicALB != "" {
+		cfg.Public.LoadBalancer = aws.String(env.ImportPublicALB)
+	}
 
 	if env.ImportVPC != nil && len(env.ImportVPC.PublicSubnetIDs) == 0 {
 		cfg.Private.InternalALBSubnets = env.InternalALBSubnets
@@ -472,6 +548,8 @@ type PublicHTTPConfig struct {
 	ELBAccessLogs ELBAccessLogsArgsOrBool           `yaml:"access_logs,omitempty"`
 	Ingress       RestrictiveIngress                `yaml:"ingress,omitempty"`
 	SSLPolicy     *string                           `yaml:"ssl_policy,omitempty"`
+	LoadBalancer  *string                           `yaml:"load_balancer,omitempty"` // Name or ARN of an existing public ALB to use instead of creating a new one.
+	IdleTimeout   *time.Duration                    `yaml:"idle_timeout,omitempty"`  // Time a connection can be idle before the ALB closes it.
 }
 
 // ELBAccessLogsArgsOrBool is a custom type which supports unmarshaling yaml which
@@ -557,7 +635,14 @@ func (i RestrictiveIngress) IsEmpty() bool {
 
 // IsEmpty returns true if there is no customization to the public ALB.
 func (cfg PublicHTTPConfig) IsEmpty() bool {
-	return len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.ELBAccessLogs.isEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil
+	return len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.ELBAccessLogs.isEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil && cfg.LoadBalancer == nil &&
+		cfg.IdleTimeout == nil
+}
+
+// ImportedLoadBalancer returns the name or ARN of the existing public ALB imported in the environment,
+// or an empty string if Copilot manages the public ALB.
+func (cfg PublicHTTPConfig) ImportedLoadBalancer() string {
+	return aws.StringValue(cfg.LoadBalancer)
 }
 
 type privateHTTPConfig struct {
@@ -566,11 +651,13 @@ type privateHTTPConfig struct {
 	DeprecatedSG       DeprecatedALBSecurityGroupsConfig `yaml:"security_groups,omitempty"` // Deprecated. This field is now available in Ingress.
 	Ingress            RelaxedIngress                    `yaml:"ingress,omitempty"`
 	SSLPolicy          *string                           `yaml:"ssl_policy,omitempty"`
+	IdleTimeout        *time.Duration                    `yaml:"idle_timeout,omitempty"` // Time a connection can be idle before the ALB closes it.
 }
 
 // IsEmpty returns true if there is no customization to the internal ALB.
 func (cfg privateHTTPConfig) IsEmpty() bool {
-	return len(cfg.InternalALBSubnets) == 0 && len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil
+	return len(cfg.InternalALBSubnets) == 0 && len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil &&
+		cfg.IdleTimeout == nil
 }
 
 // HasVPCIngress returns true if the private ALB allows ingress from within the VPC.
@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// Profiles of an environment, which change the defaults of the environment and of the workloads deployed to it.
+const (
+	EnvProfileDev     = "dev"
+	EnvProfileStaging = "staging"
+	EnvProfileProd    = "prod"
+	EnvProfileSandbox = "sandbox"
+)
+
+// EnvProfiles are the valid profiles of an environment.
+var EnvProfiles = []string{EnvProfileDev, EnvProfileStaging, EnvProfileProd, EnvProfileSandbox}
+
+// envProfileDefaults holds the defaults that a profile applies to the fields that the manifests leave unset.
+type envProfileDefaults struct {
+	terminationProtection bool // Whether the environment stack is protected from deletion.
+	singleNATGateway      bool // Whether the private subnets share a single NAT gateway instead of one per availability zone.
+
+	logRetention   int        // Number of days to retain the logs of workloads.
+	spot           bool       // Whether services place their tasks on Fargate Spot capacity.
+	rollbackAlarms *AlarmArgs // Alarms that roll back the deployments of services.
+}
+
+var envProfileDefaultsByName = map[string]envProfileDefaults{
+	EnvProfileDev: {
+		singleNATGateway: true,
+		logRetention:     7,
+		spot:             true,
+	},
+	EnvProfileStaging: {
+		logRetention: 30,
+		rollbackAlarms: &AlarmArgs{
+			CPUUtilization:    aws.Float64(90),
+			MemoryUtilization: aws.Float64(90),
+		},
+	},
+	EnvProfileProd: {
+		terminationProtection: true,
+		logRetention:          365,
+		rollbackAlarms: &AlarmArgs{
+			CPUUtilization:    aws.Float64(80),
+			MemoryUtilization: aws.Float64(80),
+		},
+	},
+	EnvProfileSandbox: {
+		singleNATGateway: true,
+		logRetention:     1,
+		spot:             true,
+	},
+}
+
+// SingleNATGateway returns true if the profile of the environment shares a single NAT gateway between its private subnets.
+func (e *EnvironmentConfig) SingleNATGateway() bool {
+	return envProfileDefaultsByName[aws.StringValue(e.Profile)].singleNATGateway
+}
+
+// ApplyEnvProfile sets the defaults of the profile of the environment on the fields that the workload manifest leaves unset.
+// Workloads that aren't deployed on ECS, and environments without a profile, are left unchanged.
+func ApplyEnvProfile(mft interface{}, env *Environment) {
+	defaults, ok := envProfileDefaultsByName[aws.StringValue(env.Profile)]
+	if !ok {
+		return
+	}
+	switch m := mft.(type) {
+	case *LoadBalancedWebService:
+		defaults.applyToLogging(&m.Logging)
+		defaults.applyToCount(&m.TaskConfig)
+		defaults.applyToRollbackAlarms(&m.DeployConfig.RollbackAlarms)
+	case *BackendService:
+		defaults.applyToLogging(&m.Logging)
+		defaults.applyToCount(&m.TaskConfig)
+		defaults.applyToRollbackAlarms(&m.DeployConfig.RollbackAlarms)
+	case *WorkerService:
+		defaults.applyToLogging(&m.Logging)
+		defaults.applyToCount(&m.TaskConfig)
+		if defaults.rollbackAlarms != nil && m.DeployConfig.WorkerRollbackAlarms.IsZero() {
+			m.DeployConfig.WorkerRollbackAlarms = AdvancedToUnion[[]string](WorkerAlarmArgs{
+				AlarmArgs: *defaults.rollbackAlarms,
+			})
+		}
+	case *ScheduledJob:
+		defaults.applyToLogging(&m.Logging)
+	}
+}
+
+func (d envProfileDefaults) applyToLogging(logging *Logging) {
+	if logging.Retention == nil {
+		logging.Retention = aws.Int(d.logRetention)
+	}
+}
+
+// applyToCount places the tasks of a service with a fixed count on Fargate Spot.
+// Spot capacity isn't available for Windows and ARM tasks, nor for services that autoscale.
+func (d envProfileDefaults) applyToCount(tc *TaskConfig) {
+	if !d.spot || tc.IsWindows() || tc.IsARM() || tc.Count.Value == nil || !tc.Count.AdvancedCount.IsEmpty() {
+		return
+	}
+	tc.Count.AdvancedCount.Spot = tc.Count.Value
+	tc.Count.Value = nil
+}
+
+func (d envProfileDefaults) applyToRollbackAlarms(alarms *Union[[]string, AlarmArgs]) {
+	if d.rollbackAlarms != nil && alarms.IsZero() {
+		*alarms = AdvancedToUnion[[]string](*d.rollbackAlarms)
+	}
+}
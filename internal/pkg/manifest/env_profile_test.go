@@ -0,0 +1,180 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyEnvProfile(t *testing.T) {
+	envWithProfile := func(profile string) *Environment {
+		return &Environment{
+			EnvironmentConfig: EnvironmentConfig{
+				Profile: aws.String(profile),
+			},
+		}
+	}
+	testCases := map[string]struct {
+		mft interface{}
+		env *Environment
+
+		wanted interface{}
+	}{
+		"no changes without a profile": {
+			mft: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{Count: Count{Value: aws.Int(1)}},
+				},
+			},
+			env: &Environment{},
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{Count: Count{Value: aws.Int(1)}},
+				},
+			},
+		},
+		"dev services retain logs for a week and run on spot": {
+			mft: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					TaskConfig: TaskConfig{Count: Count{Value: aws.Int(2)}},
+				},
+			},
+			env: envWithProfile(EnvProfileDev),
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					TaskConfig: TaskConfig{Count: Count{AdvancedCount: AdvancedCount{Spot: aws.Int(2)}}},
+					Logging:    Logging{Retention: aws.Int(7)},
+				},
+			},
+		},
+		"dev ARM services and services that autoscale don't run on spot": {
+			mft: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{
+						Count:    Count{Value: aws.Int(1)},
+						Platform: PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("linux/arm64"))},
+					},
+				},
+			},
+			env: envWithProfile(EnvProfileDev),
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{
+						Count:    Count{Value: aws.Int(1)},
+						Platform: PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("linux/arm64"))},
+					},
+					Logging: Logging{Retention: aws.Int(7)},
+				},
+			},
+		},
+		"prod services keep the fields of the manifest and get strict rollback alarms": {
+			mft: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{Count: Count{Value: aws.Int(3)}},
+					Logging:    Logging{Retention: aws.Int(90)},
+				},
+			},
+			env: envWithProfile(EnvProfileProd),
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{Count: Count{Value: aws.Int(3)}},
+					Logging:    Logging{Retention: aws.Int(90)},
+					DeployConfig: DeploymentConfig{
+						RollbackAlarms: AdvancedToUnion[[]string](AlarmArgs{
+							CPUUtilization:    aws.Float64(80),
+							MemoryUtilization: aws.Float64(80),
+						}),
+					},
+				},
+			},
+		},
+		"prod worker services get rollback alarms without a messages delayed threshold": {
+			mft: &WorkerService{},
+			env: envWithProfile(EnvProfileProd),
+			wanted: &WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					Logging: Logging{Retention: aws.Int(365)},
+					DeployConfig: WorkerDeploymentConfig{
+						WorkerRollbackAlarms: AdvancedToUnion[[]string](WorkerAlarmArgs{
+							AlarmArgs: AlarmArgs{
+								CPUUtilization:    aws.Float64(80),
+								MemoryUtilization: aws.Float64(80),
+							},
+						}),
+					},
+				},
+			},
+		},
+		"sandbox services run on Fargate Spot and keep their logs for a day": {
+			mft: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					TaskConfig: TaskConfig{Count: Count{Value: aws.Int(1)}},
+				},
+			},
+			env: envWithProfile(EnvProfileSandbox),
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					TaskConfig: TaskConfig{Count: Count{AdvancedCount: AdvancedCount{Spot: aws.Int(1)}}},
+					Logging:    Logging{Retention: aws.Int(1)},
+				},
+			},
+		},
+		"staging jobs only change their log retention": {
+			mft: &ScheduledJob{},
+			env: envWithProfile(EnvProfileStaging),
+			wanted: &ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					Logging: Logging{Retention: aws.Int(30)},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ApplyEnvProfile(tc.mft, tc.env)
+
+			require.Equal(t, tc.wanted, tc.mft)
+		})
+	}
+}
+
+func TestEnvironment_StackSettings(t *testing.T) {
+	testCases := map[string]struct {
+		in     *Environment
+		wanted StackSettings
+	}{
+		"prod environments are protected from deletion by default": {
+			in: &Environment{
+				EnvironmentConfig: EnvironmentConfig{
+					Profile: aws.String(EnvProfileProd),
+				},
+			},
+			wanted: StackSettings{TerminationProtection: aws.Bool(true)},
+		},
+		"the termination protection of the manifest takes precedence over the profile": {
+			in: &Environment{
+				EnvironmentConfig: EnvironmentConfig{
+					Profile: aws.String(EnvProfileProd),
+					Stack:   StackSettings{TerminationProtection: aws.Bool(false)},
+				},
+			},
+			wanted: StackSettings{TerminationProtection: aws.Bool(false)},
+		},
+		"dev environments aren't protected by default": {
+			in: &Environment{
+				EnvironmentConfig: EnvironmentConfig{
+					Profile: aws.String(EnvProfileDev),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.StackSettings())
+		})
+	}
+}
@@ -5,6 +5,7 @@ package manifest
 
 import (
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 
@@ -177,6 +178,52 @@ func TestFromEnvConfig(t *testing.T) {
 				},
 			},
 		},
+		"converts an imported cluster and public load balancer": {
+			in: &config.Environment{
+				App:  "phonetool",
+				Name: "test",
+				CustomConfig: &config.CustomizeEnv{
+					ImportVPC: &config.ImportVPC{
+						ID:               "vpc-3f139646",
+						PrivateSubnetIDs: []string{"priv1", "priv2"},
+					},
+					ImportCluster:   "existing-cluster",
+					ImportPublicALB: "existing-alb",
+				},
+			},
+			wanted: &Environment{
+				Workload: Workload{
+					Name: stringP("test"),
+					Type: stringP("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: environmentNetworkConfig{
+						VPC: environmentVPCConfig{
+							ID: stringP("vpc-3f139646"),
+							Subnets: subnetsConfiguration{
+								Public: []subnetConfiguration{},
+								Private: []subnetConfiguration{
+									{
+										SubnetID: stringP("priv1"),
+									},
+									{
+										SubnetID: stringP("priv2"),
+									},
+								},
+							},
+						},
+					},
+					Cluster: environmentClusterConfig{
+						ID: stringP("existing-cluster"),
+					},
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							LoadBalancer: stringP("existing-alb"),
+						},
+					},
+				},
+			},
+		},
 		"converts imported certificates for a public load balancer": {
 			in: &config.Environment{
 				App:  "phonetool",
@@ -640,6 +687,32 @@ http:
 				},
 			},
 		},
+		"unmarshal with exec session encryption and logs": {
+			inContent: `name: prod
+type: Environment
+cluster:
+  exec:
+    encryption: true
+    logs:
+      retention: 30
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Cluster: environmentClusterConfig{
+						Exec: ClusterExecConfig{
+							Encryption: aws.Bool(true),
+							Logs: ClusterExecLogs{
+								Retention: aws.Int(30),
+							},
+						},
+					},
+				},
+			},
+		},
 		"fail to unmarshal": {
 			inContent:       `watermelon in easter hay`,
 			wantedErrPrefix: "unmarshal environment manifest: ",
@@ -974,6 +1047,11 @@ func TestPublicHTTPConfig_IsEmpty(t *testing.T) {
 				SSLPolicy: aws.String("mock-ELB-ELBSecurityPolicy"),
 			},
 		},
+		"not empty when the idle timeout is present": {
+			in: PublicHTTPConfig{
+				IdleTimeout: durationp(15 * time.Minute),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1001,6 +1079,11 @@ func TestPrivateHTTPConfig_IsEmpty(t *testing.T) {
 				SSLPolicy: aws.String("mock-ELB-ELBSecurityPolicy"),
 			},
 		},
+		"not empty when the idle timeout is present": {
+			in: privateHTTPConfig{
+				IdleTimeout: durationp(15 * time.Minute),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1035,6 +1118,32 @@ func TestEnvironmentObservability_IsEmpty(t *testing.T) {
 	}
 }
 
+func TestEnvironmentCustomResources_IsEmpty(t *testing.T) {
+	timeout := 5 * time.Minute
+	testCases := map[string]struct {
+		in     EnvironmentCustomResources
+		wanted bool
+	}{
+		"empty": {
+			in:     EnvironmentCustomResources{},
+			wanted: true,
+		},
+		"not empty": {
+			in: EnvironmentCustomResources{
+				Timeout: &timeout,
+			},
+			wanted: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.in.IsEmpty()
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestEnvironmentCDNConfig_IsEmpty(t *testing.T) {
 	testCases := map[string]struct {
 		in     EnvironmentCDNConfig
@@ -5,13 +5,18 @@ package manifest
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"golang.org/x/net/idna"
 	"gopkg.in/yaml.v3"
 )
 
+const wildcardLabel = "*."
+
 // HTTPOrBool holds advanced configuration for routing rule or a boolean switch.
 type HTTPOrBool struct {
 	HTTP
@@ -58,6 +63,12 @@ type HTTP struct {
 	Main                     RoutingRule   `yaml:",inline"`
 	TargetContainerCamelCase *string       `yaml:"targetContainer"` // Deprecated. Maintained for backwards compatibility, use [RoutingRule.TargetContainer] instead.
 	AdditionalRoutingRules   []RoutingRule `yaml:"additional_rules"`
+	// AliasHealthCheck creates a Route 53 health check against each alias of the routing rules.
+	AliasHealthCheck Union[*bool, AliasHealthCheckArgs] `yaml:"alias_health_check"`
+	// Mirror sends a copy of the requests of the main routing rule to another service, whose responses are discarded.
+	Mirror TrafficMirror `yaml:"mirror"`
+	// OpenAPI derives the listener rules of the main routing rule from the paths of an OpenAPI spec.
+	OpenAPI OpenAPI `yaml:"openapi"`
 }
 
 // RoutingRules returns main as well as additional routing rules as a list of RoutingRule.
@@ -70,7 +81,43 @@ func (cfg HTTP) RoutingRules() []RoutingRule {
 
 // IsEmpty returns true if HTTP has empty configuration.
 func (r *HTTP) IsEmpty() bool {
-	return r.Main.IsEmpty() && r.TargetContainerCamelCase == nil && len(r.AdditionalRoutingRules) == 0
+	return r.Main.IsEmpty() && r.TargetContainerCamelCase == nil && len(r.AdditionalRoutingRules) == 0 && r.AliasHealthCheck.IsZero() &&
+		r.Mirror.IsEmpty() && r.OpenAPI.IsEmpty()
+}
+
+// AliasHealthCheckEnabled returns true if Route 53 health checks should be created against the aliases.
+func (r *HTTP) AliasHealthCheckEnabled() bool {
+	return r.AliasHealthCheck.IsAdvanced() || aws.BoolValue(r.AliasHealthCheck.Basic)
+}
+
+// AliasHealthCheckArgs holds the configuration of the Route 53 health checks against the aliases.
+type AliasHealthCheckArgs struct {
+	Path             *string        `yaml:"path"`
+	FailureThreshold *int64         `yaml:"failure_threshold"`
+	Interval         *time.Duration `yaml:"interval"`
+}
+
+// TrafficMirror holds the configuration of the service that receives a copy of the requests of the load balancer.
+type TrafficMirror struct {
+	Service *string `yaml:"service"` // Name of a service of the same environment.
+	Port    *uint16 `yaml:"port"`    // Port of the service to send the requests to. Defaults to the target port of the main routing rule.
+	Percent *int    `yaml:"percent"` // Percentage of the requests to copy. Defaults to 100.
+}
+
+// IsEmpty returns true if no traffic is mirrored.
+func (m TrafficMirror) IsEmpty() bool {
+	return m.Service == nil && m.Port == nil && m.Percent == nil
+}
+
+// OpenAPI holds the configuration of the OpenAPI spec that the routing of the service is derived from.
+type OpenAPI struct {
+	Spec                *string `yaml:"spec"`                  // Path to the spec, relative to the workspace root.
+	BlockUndefinedPaths *bool   `yaml:"block_undefined_paths"` // Block the requests to paths that the spec doesn't define with a web ACL.
+}
+
+// IsEmpty returns true if the routing isn't derived from an OpenAPI spec.
+func (o OpenAPI) IsEmpty() bool {
+	return o.Spec == nil && o.BlockUndefinedPaths == nil
 }
 
 // RoutingRule holds listener rule configuration for ALB.
@@ -81,6 +128,7 @@ type RoutingRule struct {
 	Stickiness          *bool                   `yaml:"stickiness"`
 	Alias               Alias                   `yaml:"alias"`
 	DeregistrationDelay *time.Duration          `yaml:"deregistration_delay"`
+	SlowStart           *time.Duration          `yaml:"slow_start"`
 	// TargetContainer is the container load balancer routes traffic to.
 	TargetContainer  *string `yaml:"target_container"`
 	TargetPort       *uint16 `yaml:"target_port"`
@@ -93,7 +141,7 @@ type RoutingRule struct {
 // IsEmpty returns true if RoutingRule has empty configuration.
 func (r *RoutingRule) IsEmpty() bool {
 	return r.Path == nil && r.ProtocolVersion == nil && r.HealthCheck.IsZero() && r.Stickiness == nil && r.Alias.IsEmpty() &&
-		r.DeregistrationDelay == nil && r.TargetContainer == nil && r.TargetPort == nil && r.AllowedSourceIps == nil &&
+		r.DeregistrationDelay == nil && r.SlowStart == nil && r.TargetContainer == nil && r.TargetPort == nil && r.AllowedSourceIps == nil &&
 		r.HostedZone == nil && r.RedirectToHTTPS == nil
 }
 
@@ -162,28 +210,64 @@ func (a *Alias) UnmarshalYAML(value *yaml.Node) error {
 }
 
 // ToStringSlice converts an Alias to a slice of string.
+// Internationalized domain names are converted to punycode.
 func (a *Alias) ToStringSlice() ([]string, error) {
-	if len(a.AdvancedAliases) == 0 {
-		return a.StringSliceOrString.ToStringSlice(), nil
+	aliases := a.StringSliceOrString.ToStringSlice()
+	if len(a.AdvancedAliases) != 0 {
+		aliases = make([]string, len(a.AdvancedAliases))
+		for i, advancedAlias := range a.AdvancedAliases {
+			aliases[i] = aws.StringValue(advancedAlias.Alias)
+		}
+	}
+	if aliases == nil {
+		return nil, nil
 	}
-	aliases := make([]string, len(a.AdvancedAliases))
-	for i, advancedAlias := range a.AdvancedAliases {
-		aliases[i] = aws.StringValue(advancedAlias.Alias)
+	out := make([]string, len(aliases))
+	for i, alias := range aliases {
+		ascii, err := AliasToASCII(alias)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = ascii
 	}
-	return aliases, nil
+	return out, nil
 }
 
 // ToString converts an Alias to a string.
 func (a *Alias) ToString() string {
-	if len(a.AdvancedAliases) != 0 {
-		aliases := make([]string, len(a.AdvancedAliases))
-		for i, advancedAlias := range a.AdvancedAliases {
-			aliases[i] = aws.StringValue(advancedAlias.Alias)
-		}
-		return strings.Join(aliases, ",")
+	aliases, err := a.ToStringSlice()
+	if err != nil {
+		// The aliases are validated with the manifest, so this is unexpected.
+		return ""
+	}
+	return strings.Join(aliases, ",")
+}
+
+// AliasToASCII returns the ASCII form of a domain name alias. Internationalized labels are converted
+// to punycode, and a wildcard alias can only have "*" as its first label, for example "*.api.example.com".
+func AliasToASCII(alias string) (string, error) {
+	name, isWildcard := strings.CutPrefix(alias, wildcardLabel)
+	if strings.Contains(name, "*") {
+		return "", fmt.Errorf(`alias %q can only use a wildcard as its first label, for example "*.example.com"`, alias)
+	}
+	if isASCII(name) {
+		return alias, nil
+	}
+	ascii, err := idna.Lookup.ToASCII(name)
+	if err != nil {
+		return "", fmt.Errorf("convert alias %q to punycode: %w", alias, err)
 	}
-	if a.StringSliceOrString.String != nil {
-		return aws.StringValue(a.StringSliceOrString.String)
+	if isWildcard {
+		return wildcardLabel + ascii, nil
+	}
+	return ascii, nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
 	}
-	return strings.Join(a.StringSliceOrString.StringSlice, ",")
+	return true
 }
@@ -5,6 +5,7 @@ package manifest
 
 import (
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
@@ -52,6 +53,56 @@ func TestHTTPOrBool_Disabled(t *testing.T) {
 	}
 }
 
+func TestHTTP_AliasHealthCheckEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted HTTP
+	}{
+		"disabled implicitly": {
+			in: `path: /`,
+			wanted: HTTP{
+				Main: RoutingRule{Path: aws.String("/")},
+			},
+		},
+		"enabled with a boolean": {
+			in: `path: /
+alias_health_check: true`,
+			wanted: HTTP{
+				Main:             RoutingRule{Path: aws.String("/")},
+				AliasHealthCheck: BasicToUnion[*bool, AliasHealthCheckArgs](aws.Bool(true)),
+			},
+		},
+		"enabled with advanced configuration": {
+			in: `path: /
+alias_health_check:
+  path: /ping
+  failure_threshold: 2
+  interval: 10s`,
+			wanted: HTTP{
+				Main: RoutingRule{Path: aws.String("/")},
+				AliasHealthCheck: AdvancedToUnion[*bool](AliasHealthCheckArgs{
+					Path:             aws.String("/ping"),
+					FailureThreshold: aws.Int64(2),
+					Interval:         durationp(10 * time.Second),
+				}),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			var got HTTP
+			err := yaml.Unmarshal([]byte(tc.in), &got)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, !tc.wanted.AliasHealthCheck.IsZero(), got.AliasHealthCheckEnabled())
+		})
+	}
+}
+
 func TestAlias_HostedZones(t *testing.T) {
 	testCases := map[string]struct {
 		in     Alias
@@ -276,6 +327,14 @@ func TestAlias_ToStringSlice(t *testing.T) {
 			},
 			wanted: []string{"example.com", "v1.example.com"},
 		},
+		"convert internationalized aliases to punycode": {
+			inAlias: Alias{
+				StringSliceOrString: StringSliceOrString{
+					StringSlice: []string{"*.api.example.com", "bücher.example.com", "*.bücher.example.com"},
+				},
+			},
+			wanted: []string{"*.api.example.com", "xn--bcher-kva.example.com", "*.xn--bcher-kva.example.com"},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -287,3 +346,53 @@ func TestAlias_ToStringSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestAliasToASCII(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wanted    string
+		wantedErr string
+	}{
+		"ASCII alias is returned as is": {
+			in:     "v1.example.com",
+			wanted: "v1.example.com",
+		},
+		"wildcard alias": {
+			in:     "*.api.example.com",
+			wanted: "*.api.example.com",
+		},
+		"internationalized alias": {
+			in:     "bücher.example.com",
+			wanted: "xn--bcher-kva.example.com",
+		},
+		"internationalized wildcard alias": {
+			in:     "*.bücher.example.com",
+			wanted: "*.xn--bcher-kva.example.com",
+		},
+		"error if the wildcard isn't the first label": {
+			in:        "api.*.example.com",
+			wantedErr: `alias "api.*.example.com" can only use a wildcard as its first label, for example "*.example.com"`,
+		},
+		"error if the wildcard is part of a label": {
+			in:        "*api.example.com",
+			wantedErr: `alias "*api.example.com" can only use a wildcard as its first label, for example "*.example.com"`,
+		},
+		"error if the internationalized alias is invalid": {
+			in:        "bücher_shop.example.com",
+			wantedErr: `convert alias "bücher_shop.example.com" to punycode: idna: disallowed rune U+005F`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := AliasToASCII(tc.in)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -39,6 +39,7 @@ type ScheduledJobConfig struct {
 	Network                 NetworkConfig  `yaml:"network"`
 	PublishConfig           PublishConfig  `yaml:"publish"`
 	TaskDefOverrides        []OverrideRule `yaml:"taskdef_overrides"`
+	Stack                   StackSettings  `yaml:"stack"`
 }
 
 // JobTriggerConfig represents the configuration for the event that triggers the job.
@@ -136,6 +137,11 @@ func (j *ScheduledJob) Publish() []Topic {
 	return j.ScheduledJobConfig.PublishConfig.publishedTopics()
 }
 
+// StackSettings returns the protections of the CloudFormation stack of the job.
+func (j *ScheduledJob) StackSettings() StackSettings {
+	return j.Stack
+}
+
 // BuildArgs returns a docker.BuildArguments object for the job given a context directory.
 func (j *ScheduledJob) BuildArgs(contextDir string) (map[string]*DockerBuildArgs, error) {
 	required, err := requiresBuild(j.ImageConfig.Image)
@@ -145,11 +151,23 @@ func (j *ScheduledJob) BuildArgs(contextDir string) (map[string]*DockerBuildArgs
 	// Creating an map to store buildArgs of all sidecar images and main container image.
 	buildArgsPerContainer := make(map[string]*DockerBuildArgs, len(j.Sidecars)+1)
 	if required {
-		buildArgsPerContainer[aws.StringValue(j.Name)] = j.ImageConfig.Image.BuildConfig(contextDir)
+		buildArgsPerContainer[aws.StringValue(j.Name)] = withBuildOnlyVariables(j.ImageConfig.Image.BuildConfig(contextDir), j.TaskConfig.Variables)
 	}
 	return buildArgs(contextDir, buildArgsPerContainer, j.Sidecars)
 }
 
+// ImageLocations returns the locations of the existing images that the containers of the job run.
+// The keys of the map are container names, and the values are image URIs.
+func (j *ScheduledJob) ImageLocations() map[string]string {
+	return imageLocations(j.Name, j.ImageConfig.Image, j.Sidecars)
+}
+
+// FileSyncs returns the files of the workspace that are copied into the containers of the job run locally when they change.
+// The keys of the map are container names.
+func (j *ScheduledJob) FileSyncs() map[string]ImageSync {
+	return fileSyncs(j.Name, j.ImageConfig.Image, j.Sidecars)
+}
+
 // EnvFiles returns the locations of all env files against the ws root directory.
 // This method returns a map[string]string where the keys are container names
 // and the values are either env file paths or empty strings.
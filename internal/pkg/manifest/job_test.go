@@ -67,7 +67,7 @@ func TestScheduledJob_ApplyEnv(t *testing.T) {
 						TaskConfig: TaskConfig{
 							Variables: map[string]Variable{
 								"LOG_LEVEL": {
-									StringOrFromCFN{
+									StringOrFromCFN: StringOrFromCFN{
 										Plain: stringP("prod"),
 									},
 								},
@@ -106,7 +106,7 @@ func TestScheduledJob_ApplyEnv(t *testing.T) {
 						},
 						Variables: map[string]Variable{
 							"LOG_LEVEL": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									Plain: stringP("prod"),
 								},
 							},
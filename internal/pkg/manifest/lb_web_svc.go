@@ -34,6 +34,21 @@ const (
 	commonGRPCPort = uint16(50051)
 )
 
+// Presets of the manifest of a load balanced web service.
+const (
+	WebSocketPreset = "websocket" // WebSocketPreset tunes the service for long-lived connections such as WebSockets.
+)
+
+// LoadBalancedWebServicePresets are the presets of the manifest of a load balanced web service.
+var LoadBalancedWebServicePresets = []string{WebSocketPreset}
+
+// Defaults of the websocket preset.
+const (
+	webSocketDrainTimeout    = 2 * time.Minute // Clients are given as long as possible to reconnect to another task during deployments.
+	webSocketCountRange      = "1-10"
+	webSocketConnectionCount = 1000
+)
+
 // durationp is a utility function used to convert a time.Duration to a pointer. Useful for YAML unmarshaling
 // and template execution.
 func durationp(v time.Duration) *time.Duration {
@@ -64,6 +79,8 @@ type LoadBalancedWebServiceConfig struct {
 	NLBConfig        NetworkLoadBalancerConfiguration `yaml:"nlb"`
 	DeployConfig     DeploymentConfig                 `yaml:"deployment"`
 	Observability    Observability                    `yaml:"observability"`
+	Tags             map[string]string                `yaml:"tags"`
+	Stack            StackSettings                    `yaml:"stack"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -74,6 +91,7 @@ type LoadBalancedWebServiceProps struct {
 
 	HealthCheck ContainerHealthCheck // Optional healthcheck configuration.
 	Platform    PlatformArgsOrString // Optional platform configuration.
+	Preset      string               // Optional preset that tunes the manifest for a kind of traffic.
 }
 
 // NewLoadBalancedWebService creates a new public load balanced web service, receives all the requests from the load balancer,
@@ -98,6 +116,9 @@ func NewLoadBalancedWebService(props *LoadBalancedWebServiceProps) *LoadBalanced
 		svc.HTTPOrBool.Main.ProtocolVersion = aws.String(GRPCProtocol)
 	}
 	svc.HTTPOrBool.Main.Path = aws.String(props.Path)
+	if props.Preset == WebSocketPreset {
+		svc.applyWebSocketPreset()
+	}
 	svc.parser = template.New()
 	for _, envName := range props.PrivateOnlyEnvironments {
 		svc.Environments[envName] = &LoadBalancedWebServiceConfig{
@@ -113,6 +134,26 @@ func NewLoadBalancedWebService(props *LoadBalancedWebServiceProps) *LoadBalanced
 	return svc
 }
 
+// applyWebSocketPreset tunes the service for long-lived connections: the connections of a client stick to the same task,
+// the tasks drain their connections for as long as they can before they're stopped, and the service scales on the number
+// of active connections per task instead of CPU or requests.
+func (s *LoadBalancedWebService) applyWebSocketPreset() {
+	s.HTTPOrBool.Main.Stickiness = aws.Bool(true)
+	s.HTTPOrBool.Main.DeregistrationDelay = durationp(webSocketDrainTimeout)
+	s.ImageConfig.Image.StopTimeout = durationp(webSocketDrainTimeout)
+	s.Count = Count{
+		AdvancedCount: AdvancedCount{
+			Range: Range{
+				Value: (*IntRangeBand)(aws.String(webSocketCountRange)),
+			},
+			Connections: ScalingConfigOrT[int]{
+				Value: aws.Int(webSocketConnectionCount),
+			},
+			workloadType: manifestinfo.LoadBalancedWebServiceType,
+		},
+	}
+}
+
 // newDefaultHTTPLoadBalancedWebService returns an empty LoadBalancedWebService with only the default values set, including default HTTP configurations.
 func newDefaultHTTPLoadBalancedWebService() *LoadBalancedWebService {
 	lbws := newDefaultLoadBalancedWebService()
@@ -192,6 +233,11 @@ func (s *LoadBalancedWebService) Publish() []Topic {
 	return s.LoadBalancedWebServiceConfig.PublishConfig.publishedTopics()
 }
 
+// StackSettings returns the protections of the CloudFormation stack of the service.
+func (s *LoadBalancedWebService) StackSettings() StackSettings {
+	return s.Stack
+}
+
 // BuildArgs returns a docker.BuildArguments object given a context directory.
 func (s *LoadBalancedWebService) BuildArgs(contextDir string) (map[string]*DockerBuildArgs, error) {
 	required, err := requiresBuild(s.ImageConfig.Image)
@@ -201,11 +247,23 @@ func (s *LoadBalancedWebService) BuildArgs(contextDir string) (map[string]*Docke
 	// Creating an map to store buildArgs of all sidecar images and main container image.
 	buildArgsPerContainer := make(map[string]*DockerBuildArgs, len(s.Sidecars)+1)
 	if required {
-		buildArgsPerContainer[aws.StringValue(s.Name)] = s.ImageConfig.Image.BuildConfig(contextDir)
+		buildArgsPerContainer[aws.StringValue(s.Name)] = withBuildOnlyVariables(s.ImageConfig.Image.BuildConfig(contextDir), s.TaskConfig.Variables)
 	}
 	return buildArgs(contextDir, buildArgsPerContainer, s.Sidecars)
 }
 
+// ImageLocations returns the locations of the existing images that the containers of the service run.
+// The keys of the map are container names, and the values are image URIs.
+func (s *LoadBalancedWebService) ImageLocations() map[string]string {
+	return imageLocations(s.Name, s.ImageConfig.Image, s.Sidecars)
+}
+
+// FileSyncs returns the files of the workspace that are copied into the containers of the service run locally when they change.
+// The keys of the map are container names.
+func (s *LoadBalancedWebService) FileSyncs() map[string]ImageSync {
+	return fileSyncs(s.Name, s.ImageConfig.Image, s.Sidecars)
+}
+
 // EnvFiles returns the locations of all env files against the ws root directory.
 // This method returns a map[string]string where the keys are container names
 // and the values are either env file paths or empty strings.
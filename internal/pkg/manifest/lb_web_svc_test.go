@@ -86,6 +86,79 @@ func TestNewHTTPLoadBalancedWebService(t *testing.T) {
 				Environments: map[string]*LoadBalancedWebServiceConfig{},
 			},
 		},
+		"tunes the service for long-lived connections with the websocket preset": {
+			props: LoadBalancedWebServiceProps{
+				WorkloadProps: &WorkloadProps{
+					Name:       "chat",
+					Dockerfile: "./Dockerfile",
+				},
+				Path:   "/",
+				Port:   8080,
+				Preset: WebSocketPreset,
+			},
+
+			wanted: &LoadBalancedWebService{
+				Workload: Workload{
+					Name: stringP("chat"),
+					Type: stringP("Load Balanced Web Service"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								ImageLocationOrBuild: ImageLocationOrBuild{
+									Build: BuildArgsOrString{
+										BuildArgs: DockerBuildArgs{
+											Dockerfile: stringP("./Dockerfile"),
+										},
+									},
+								},
+								StopTimeout: durationp(2 * time.Minute),
+							},
+							Port: aws.Uint16(8080),
+						},
+					},
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path: stringP("/"),
+								HealthCheck: HealthCheckArgsOrString{
+									Union: BasicToUnion[string, HTTPHealthCheckArgs]("/"),
+								},
+								Stickiness:          aws.Bool(true),
+								DeregistrationDelay: durationp(2 * time.Minute),
+							},
+						},
+					},
+					TaskConfig: TaskConfig{
+						CPU:    aws.Int(256),
+						Memory: aws.Int(512),
+						Count: Count{
+							AdvancedCount: AdvancedCount{
+								Range: Range{
+									Value: (*IntRangeBand)(aws.String("1-10")),
+								},
+								Connections: ScalingConfigOrT[int]{
+									Value: aws.Int(1000),
+								},
+								workloadType: "Load Balanced Web Service",
+							},
+						},
+						ExecuteCommand: ExecuteCommand{
+							Enable: aws.Bool(false),
+						},
+					},
+					Network: NetworkConfig{
+						VPC: vpcConfig{
+							Placement: PlacementArgOrString{
+								PlacementString: placementStringP(PublicSubnetPlacement),
+							},
+						},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{},
+			},
+		},
 		"overrides default settings when optional configuration is provided": {
 			props: LoadBalancedWebServiceProps{
 				WorkloadProps: &WorkloadProps{
@@ -294,12 +367,12 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 						},
 						Variables: map[string]Variable{
 							"VAR1": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									Plain: stringP("var1"),
 								},
 							},
 							"VAR2": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									FromCFN: fromCFN{
 										Name: stringP("import-var2"),
 									},
@@ -364,12 +437,12 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 						},
 						Variables: map[string]Variable{
 							"VAR1": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									Plain: stringP("var1"),
 								},
 							},
 							"VAR2": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									FromCFN: fromCFN{
 										Name: stringP("import-var2"),
 									},
@@ -434,24 +507,24 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 						},
 						Variables: map[string]Variable{
 							"LOG_LEVEL": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									Plain: stringP("DEBUG"),
 								},
 							},
 							"S3_TABLE_NAME": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									Plain: stringP("doggo"),
 								},
 							},
 							"RDS_TABLE_NAME": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									FromCFN: fromCFN{
 										Name: stringP("duckling"),
 									},
 								},
 							},
 							"DDB_TABLE_NAME": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									FromCFN: fromCFN{
 										Name: stringP("awards"),
 									},
@@ -545,20 +618,20 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 							},
 							Variables: map[string]Variable{
 								"LOG_LEVEL": {
-									StringOrFromCFN{
+									StringOrFromCFN: StringOrFromCFN{
 										Plain: stringP("ERROR"),
 									},
 								},
 								"S3_TABLE_NAME": {
-									StringOrFromCFN{
+									StringOrFromCFN: StringOrFromCFN{
 										FromCFN: fromCFN{Name: stringP("prod-doggo")},
 									},
 								},
 								"RDS_TABLE_NAME": {
-									StringOrFromCFN{Plain: stringP("duckling-prod")},
+									StringOrFromCFN: StringOrFromCFN{Plain: stringP("duckling-prod")},
 								},
 								"DDB_TABLE_NAME": {
-									StringOrFromCFN{
+									StringOrFromCFN: StringOrFromCFN{
 										FromCFN: fromCFN{Name: stringP("awards-prod")},
 									},
 								},
@@ -659,22 +732,22 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 						},
 						Variables: map[string]Variable{
 							"LOG_LEVEL": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									Plain: stringP("ERROR"),
 								},
 							},
 							"S3_TABLE_NAME": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									FromCFN: fromCFN{Name: stringP("prod-doggo")},
 								},
 							},
 							"RDS_TABLE_NAME": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									Plain: stringP("duckling-prod"),
 								},
 							},
 							"DDB_TABLE_NAME": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									FromCFN: fromCFN{Name: stringP("awards-prod")},
 								},
 							},
@@ -771,12 +844,12 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 						},
 						Variables: map[string]Variable{
 							"VAR1": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									Plain: stringP("var1"),
 								},
 							},
 							"VAR2": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									FromCFN: fromCFN{Name: stringP("import-var2")},
 								},
 							},
@@ -809,12 +882,12 @@ func TestLoadBalancedWebService_ApplyEnv(t *testing.T) {
 						},
 						Variables: map[string]Variable{
 							"VAR1": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									Plain: stringP("var1"),
 								},
 							},
 							"VAR2": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									FromCFN: fromCFN{Name: stringP("import-var2")},
 								},
 							},
@@ -2683,6 +2756,75 @@ func TestLoadBalancedWebService_ExposedPorts(t *testing.T) {
 				},
 			},
 		},
+		"nlb exposing both a tcp and a udp port for tcp_udp listeners": {
+			mft: &LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("frontend"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: ImageWithPort{
+							Port: aws.Uint16(8080),
+						},
+					},
+					HTTPOrBool: HTTPOrBool{
+						Enabled: aws.Bool(false),
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"xray": {
+							Port: aws.String("80"),
+							Image: Union[*string, ImageLocationOrBuild]{
+								Basic: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+							},
+						},
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Listener: NetworkLoadBalancerListener{
+							Port: aws.String("8080/tcp_udp"),
+						},
+						AdditionalListeners: []NetworkLoadBalancerListener{
+							{
+								Port:            aws.String("27015/TCP_UDP"),
+								TargetContainer: aws.String("xray"),
+							},
+						},
+					},
+				},
+			},
+			wantedExposedPorts: map[string][]ExposedPort{
+				"frontend": {
+					{
+						Port:                 8080,
+						ContainerName:        "frontend",
+						Protocol:             "tcp",
+						isDefinedByContainer: true,
+					},
+					{
+						Port:          8080,
+						ContainerName: "frontend",
+						Protocol:      "udp",
+					},
+				},
+				"xray": {
+					{
+						Port:                 80,
+						ContainerName:        "xray",
+						Protocol:             "tcp",
+						isDefinedByContainer: true,
+					},
+					{
+						Port:          27015,
+						ContainerName: "xray",
+						Protocol:      "tcp",
+					},
+					{
+						Port:          27015,
+						ContainerName: "xray",
+						Protocol:      "udp",
+					},
+				},
+			},
+		},
 		"nlb exposing new ports of the main and sidecar containers through main and additional listeners without mentioning the target_port or target_container": {
 			mft: &LoadBalancedWebService{
 				Workload: Workload{
@@ -2823,6 +2965,53 @@ func TestLoadBalancedWebService_BuildArgs(t *testing.T) {
 				},
 			},
 		},
+		"build-only variables are build args of the main container": {
+			in: &LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mock-svc"),
+					Type: aws.String(manifestinfo.LoadBalancedWebServiceType),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								ImageLocationOrBuild: ImageLocationOrBuild{
+									Build: BuildArgsOrString{
+										BuildArgs: DockerBuildArgs{
+											Dockerfile: aws.String("web/Dockerfile"),
+											Args: map[string]BuildArg{
+												"NODE_ENV": {Plain: aws.String("production")},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					TaskConfig: TaskConfig{
+						Variables: map[string]Variable{
+							"LOG_LEVEL": {StringOrFromCFN: StringOrFromCFN{Plain: aws.String("debug")}},
+							"build_only": {
+								BuildOnly: map[string]BuildArg{
+									"NODE_ENV":     {Plain: aws.String("development")},
+									"NPM_REGISTRY": {Plain: aws.String("https://registry.example.com")},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedBuildArgs: map[string]*DockerBuildArgs{
+				"mock-svc": {
+					Dockerfile: aws.String(filepath.Join(mockContextDir, "web/Dockerfile")),
+					Context:    aws.String(filepath.Join(mockContextDir, "web")),
+					Args: map[string]BuildArg{
+						"NODE_ENV":     {Plain: aws.String("production")},
+						"NPM_REGISTRY": {Plain: aws.String("https://registry.example.com")},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2838,6 +3027,114 @@ func TestLoadBalancedWebService_BuildArgs(t *testing.T) {
 	}
 }
 
+func TestLoadBalancedWebService_ImageLocations(t *testing.T) {
+	in := &LoadBalancedWebService{
+		Workload: Workload{
+			Name: aws.String("mock-svc"),
+			Type: aws.String(manifestinfo.LoadBalancedWebServiceType),
+		},
+		LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+			ImageConfig: ImageWithPortAndHealthcheck{
+				ImageWithPort: ImageWithPort{
+					Image: Image{
+						ImageLocationOrBuild: ImageLocationOrBuild{
+							Location: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/app/api:v1"),
+						},
+					},
+				},
+			},
+			Sidecars: map[string]*SidecarConfig{
+				"nginx": {
+					Image: Union[*string, ImageLocationOrBuild]{
+						Basic: aws.String("public.ecr.aws/nginx/nginx"),
+					},
+				},
+				"envoy": {
+					Image: Union[*string, ImageLocationOrBuild]{
+						Advanced: ImageLocationOrBuild{
+							Location: aws.String("envoyproxy/envoy:v1.28"),
+						},
+					},
+				},
+				"builder": {
+					Image: Union[*string, ImageLocationOrBuild]{
+						Advanced: ImageLocationOrBuild{
+							Build: BuildArgsOrString{
+								BuildString: aws.String("builder/Dockerfile"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := in.ImageLocations()
+
+	require.Equal(t, map[string]string{
+		"mock-svc": "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/api:v1",
+		"nginx":    "public.ecr.aws/nginx/nginx",
+		"envoy":    "envoyproxy/envoy:v1.28",
+	}, got)
+}
+
+func TestLoadBalancedWebService_FileSyncs(t *testing.T) {
+	sync := ImageSync{
+		Paths: []SyncPath{
+			{Source: aws.String("src"), Target: aws.String("/app/src")},
+		},
+		Signal: aws.String("SIGHUP"),
+	}
+	in := &LoadBalancedWebService{
+		Workload: Workload{
+			Name: aws.String("mock-svc"),
+			Type: aws.String(manifestinfo.LoadBalancedWebServiceType),
+		},
+		LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+			ImageConfig: ImageWithPortAndHealthcheck{
+				ImageWithPort: ImageWithPort{
+					Image: Image{
+						ImageLocationOrBuild: ImageLocationOrBuild{
+							Build: BuildArgsOrString{BuildString: aws.String("Dockerfile")},
+							Sync:  sync,
+						},
+					},
+				},
+			},
+			Sidecars: map[string]*SidecarConfig{
+				"nginx": {
+					Image: Union[*string, ImageLocationOrBuild]{
+						Basic: aws.String("public.ecr.aws/nginx/nginx"),
+					},
+				},
+				"worker": {
+					Image: Union[*string, ImageLocationOrBuild]{
+						Advanced: ImageLocationOrBuild{
+							Build: BuildArgsOrString{BuildString: aws.String("worker/Dockerfile")},
+							Sync: ImageSync{
+								Paths: []SyncPath{
+									{Source: aws.String("worker"), Target: aws.String("/worker")},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := in.FileSyncs()
+
+	require.Equal(t, map[string]ImageSync{
+		"mock-svc": sync,
+		"worker": {
+			Paths: []SyncPath{
+				{Source: aws.String("worker"), Target: aws.String("/worker")},
+			},
+		},
+	}, got)
+}
+
 func TestNetworkLoadBalancerConfiguration_NLBListeners(t *testing.T) {
 	testCases := map[string]struct {
 		in     NetworkLoadBalancerConfiguration
@@ -54,6 +54,21 @@ func TestLoadBalancedWebService_InitialManifestIntegration(t *testing.T) {
 			},
 			wantedTestdata: "lb-svc-placement-private.yml",
 		},
+		"with websocket preset": {
+			inProps: LoadBalancedWebServiceProps{
+				WorkloadProps: &WorkloadProps{
+					Name:       "chat",
+					Dockerfile: "./chat/Dockerfile",
+				},
+				Platform: PlatformArgsOrString{
+					PlatformString: nil,
+					PlatformArgs:   PlatformArgs{},
+				},
+				Port:   8080,
+				Preset: WebSocketPreset,
+			},
+			wantedTestdata: "lb-svc-websocket.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -379,12 +394,36 @@ func TestEnvironment_InitialManifestIntegration(t *testing.T) {
 			},
 			wantedTestData: "environment-import-vpc.yml",
 		},
+		"with an imported cluster and public load balancer": {
+			inProps: EnvironmentProps{
+				Name: "test",
+				CustomConfig: &config.CustomizeEnv{
+					ImportVPC: &config.ImportVPC{
+						ID:               "mock-vpc-id",
+						PrivateSubnetIDs: []string{"mock-subnet-id-3", "mock-subnet-id-4"},
+					},
+					ImportCluster:   "mock-cluster",
+					ImportPublicALB: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/mock-alb/50dc6c495c0c9188",
+				},
+				Telemetry: &config.Telemetry{
+					EnableContainerInsights: false,
+				},
+			},
+			wantedTestData: "environment-import-cluster-alb.yml",
+		},
 		"basic manifest": {
 			inProps: EnvironmentProps{
 				Name: "test",
 			},
 			wantedTestData: "environment-default.yml",
 		},
+		"with a profile": {
+			inProps: EnvironmentProps{
+				Name:    "test",
+				Profile: "sandbox",
+			},
+			wantedTestData: "environment-profile.yml",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
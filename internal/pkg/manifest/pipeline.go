@@ -6,6 +6,7 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/fatih/structs"
@@ -162,11 +163,13 @@ const (
 // and deployment ordering of your environments.
 type Pipeline struct {
 	// Name of the pipeline
-	Name    string                     `yaml:"name"`
-	Version PipelineSchemaMajorVersion `yaml:"version"`
-	Source  *Source                    `yaml:"source"`
-	Build   *Build                     `yaml:"build"`
-	Stages  []PipelineStage            `yaml:"stages"`
+	Name      string                     `yaml:"name"`
+	Version   PipelineSchemaMajorVersion `yaml:"version"`
+	Source    *Source                    `yaml:"source"`
+	Build     *Build                     `yaml:"build"`
+	Stages    []PipelineStage            `yaml:"stages"`
+	Artifacts *PipelineArtifacts         `yaml:"artifacts,omitempty"`
+	Filters   *PipelineFilters           `yaml:"filters,omitempty"`
 
 	parser template.Parser
 }
@@ -186,6 +189,16 @@ type Build struct {
 	} `yaml:"additional_policy,omitempty"`
 }
 
+// PipelineArtifacts defines how long the artifacts of the pipeline are kept in the artifact buckets.
+type PipelineArtifacts struct {
+	Retention *int `yaml:"retention"` // Number of days.
+}
+
+// PipelineFilters limits the workloads that the pipeline deploys in the stages that don't list their deployments.
+type PipelineFilters struct {
+	Workloads []string `yaml:"workloads"`
+}
+
 // PipelineStage represents a stage in the pipeline manifest
 type PipelineStage struct {
 	Name             string             `yaml:"name"`
@@ -194,6 +207,14 @@ type PipelineStage struct {
 	Deployments      Deployments        `yaml:"deployments,omitempty"`
 	PreDeployments   PrePostDeployments `yaml:"pre_deployments,omitempty"`
 	PostDeployments  PrePostDeployments `yaml:"post_deployments,omitempty"`
+	Promotion        *PromotionPolicy   `yaml:"promotion,omitempty"`
+}
+
+// PromotionPolicy holds the conditions to meet before the pipeline promotes changes from the previous stage to the stage.
+type PromotionPolicy struct {
+	Approvals *int           `yaml:"approvals,omitempty"` // Number of manual approvals required.
+	SoakTime  *time.Duration `yaml:"soak_time,omitempty"` // Minimum time that changes spend in the previous stage.
+	Alarms    []string       `yaml:"alarms,omitempty"`    // Alarms of the previous stage's environment that block the promotion while firing.
 }
 
 // Deployments represent a directed graph of cloudformation deployments.
@@ -269,6 +290,24 @@ func UnmarshalPipeline(in []byte) (*Pipeline, error) {
 	return nil, errors.New("unexpected error occurs while unmarshalling manifest.yml")
 }
 
+// Workloads returns the workloads, among the workloads of the workspace, that the pipeline deploys
+// in the stages that don't list their deployments.
+func (m *Pipeline) Workloads(wsWorkloads []string) []string {
+	if m.Filters == nil {
+		return wsWorkloads
+	}
+	var workloads []string
+	for _, workload := range wsWorkloads {
+		for _, filtered := range m.Filters.Workloads {
+			if workload == filtered {
+				workloads = append(workloads, workload)
+				break
+			}
+		}
+	}
+	return workloads
+}
+
 // IsCodeStarConnection indicates to the manifest if this source requires a CSC connection.
 func (s Source) IsCodeStarConnection() bool {
 	switch s.ProviderName {
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/fatih/structs"
@@ -303,6 +305,92 @@ stages:
 				},
 			},
 		},
+		"with workload filters": {
+			inContent: `
+name: services
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+filters:
+  workloads: [frontend, api]
+
+stages:
+    -
+      name: test
+`,
+			expectedManifest: &Pipeline{
+				Name:    "services",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     "main",
+					},
+				},
+				Filters: &PipelineFilters{
+					Workloads: []string{"frontend", "api"},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+					},
+				},
+			},
+		},
+		"with promotion policies": {
+			inContent: `
+name: services
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+      test_commands: [make test]
+    -
+      name: prod
+      promotion:
+        approvals: 2
+        soak_time: 1h30m
+        alarms: [api-latency-slo]
+`,
+			expectedManifest: &Pipeline{
+				Name:    "services",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     "main",
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name:         "test",
+						TestCommands: []string{"make test"},
+					},
+					{
+						Name: "prod",
+						Promotion: &PromotionPolicy{
+							Approvals: aws.Int(2),
+							SoakTime:  durationp(90 * time.Minute),
+							Alarms:    []string{"api-latency-slo"},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -317,3 +405,28 @@ stages:
 		})
 	}
 }
+
+func TestPipeline_Workloads(t *testing.T) {
+	testCases := map[string]struct {
+		filters *PipelineFilters
+
+		wanted []string
+	}{
+		"returns all the workloads of the workspace without filters": {
+			wanted: []string{"api", "frontend", "worker"},
+		},
+		"returns the workloads of the workspace in the filters": {
+			filters: &PipelineFilters{
+				Workloads: []string{"worker", "api", "db-migrations"},
+			},
+			wanted: []string{"api", "worker"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Pipeline{Filters: tc.filters}
+
+			require.Equal(t, tc.wanted, p.Workloads([]string{"api", "frontend", "worker"}))
+		})
+	}
+}
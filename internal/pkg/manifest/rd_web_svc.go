@@ -32,6 +32,7 @@ type RequestDrivenWebServiceConfig struct {
 	RequestDrivenWebServiceHttpConfig `yaml:"http,flow"`
 	InstanceConfig                    AppRunnerInstanceConfig              `yaml:",inline"`
 	ImageConfig                       ImageWithPort                        `yaml:"image"`
+	Source                            AppRunnerSource                      `yaml:"source"`
 	Variables                         map[string]Variable                  `yaml:"variables"`
 	Secrets                           map[string]Secret                    `yaml:"secrets"`
 	StartCommand                      *string                              `yaml:"command"`
@@ -40,15 +41,32 @@ type RequestDrivenWebServiceConfig struct {
 	Network                           RequestDrivenWebServiceNetworkConfig `yaml:"network"`
 	Observability                     Observability                        `yaml:"observability"`
 	Count                             *string                              `yaml:"count"`
+	Scaling                           AppRunnerScalingConfig               `yaml:"scaling"`
+	Stack                             StackSettings                        `yaml:"stack"`
 }
 
 // Observability holds configuration for observability to the service.
 type Observability struct {
-	Tracing *string `yaml:"tracing"`
+	Tracing  *string `yaml:"tracing"`
+	Endpoint *string `yaml:"endpoint"` // OTLP endpoint that the collector exports the traces to with the "otlp" vendor.
+
+	AnomalyDetection []string `yaml:"anomaly_detection"` // Metrics of the load balancer that CloudWatch alarms on unusual values of, "latency" or "errors".
 }
 
 func (o *Observability) isEmpty() bool {
-	return o.Tracing == nil
+	return o.Tracing == nil && o.Endpoint == nil && len(o.AnomalyDetection) == 0
+}
+
+// AppRunnerScalingConfig represents the auto scaling configuration of a Request-Driven Web Service.
+type AppRunnerScalingConfig struct {
+	MaxConcurrency *int `yaml:"max_concurrency"` // Number of concurrent requests that an instance processes before App Runner scales out.
+	MinInstances   *int `yaml:"min_instances"`   // Number of instances that App Runner keeps provisioned.
+	MaxInstances   *int `yaml:"max_instances"`
+}
+
+// IsEmpty returns true if the service uses the default auto scaling configuration of App Runner.
+func (c *AppRunnerScalingConfig) IsEmpty() bool {
+	return c.MaxConcurrency == nil && c.MinInstances == nil && c.MaxInstances == nil
 }
 
 // ImageWithPort represents a container image with an exposed port.
@@ -57,6 +75,24 @@ type ImageWithPort struct {
 	Port  *uint16 `yaml:"port"`
 }
 
+// AppRunnerSource represents a directory of a source code repository that App Runner builds
+// with a managed runtime and deploys instead of a container image.
+type AppRunnerSource struct {
+	Directory  *string `yaml:"directory"`  // Path of the source code relative to the root of the repository.
+	Runtime    *string `yaml:"runtime"`    // Managed runtime that builds and runs the code, such as PYTHON_3 or NODEJS_18.
+	Build      *string `yaml:"build"`      // Command that builds the code.
+	Port       *uint16 `yaml:"port"`       // Port that the application listens on.
+	Connection *string `yaml:"connection"` // ARN of the App Runner connection to the repository provider.
+	Repository *string `yaml:"repository"` // URL of the repository. Defaults to the remote "origin" of the workspace.
+	Branch     *string `yaml:"branch"`     // Defaults to the branch checked out in the workspace.
+}
+
+// IsEmpty returns true if the service isn't deployed from source code.
+func (s *AppRunnerSource) IsEmpty() bool {
+	return s.Directory == nil && s.Runtime == nil && s.Build == nil && s.Port == nil &&
+		s.Connection == nil && s.Repository == nil && s.Branch == nil
+}
+
 // RequestDrivenWebServiceNetworkConfig represents options for network connection to AWS resources for a Request-Driven Web Service.
 type RequestDrivenWebServiceNetworkConfig struct {
 	VPC rdwsVpcConfig `yaml:"vpc"`
@@ -138,6 +174,9 @@ func (s *RequestDrivenWebService) MarshalBinary() ([]byte, error) {
 // Port returns the exposed the exposed port in the manifest.
 // A RequestDrivenWebService always has a port exposed therefore the boolean is always true.
 func (s *RequestDrivenWebService) Port() (port uint16, ok bool) {
+	if !s.Source.IsEmpty() {
+		return aws.Uint16Value(s.Source.Port), true
+	}
 	return aws.Uint16Value(s.ImageConfig.Port), true
 }
 
@@ -146,6 +185,11 @@ func (s *RequestDrivenWebService) Publish() []Topic {
 	return s.RequestDrivenWebServiceConfig.PublishConfig.publishedTopics()
 }
 
+// StackSettings returns the protections of the CloudFormation stack of the service.
+func (s *RequestDrivenWebService) StackSettings() StackSettings {
+	return s.Stack
+}
+
 // ContainerPlatform returns the platform for the service.
 func (s *RequestDrivenWebService) ContainerPlatform() string {
 	if s.InstanceConfig.Platform.IsEmpty() {
@@ -154,8 +198,23 @@ func (s *RequestDrivenWebService) ContainerPlatform() string {
 	return platformString(s.InstanceConfig.Platform.OS(), s.InstanceConfig.Platform.Arch())
 }
 
+// SSMParameterPath returns the path of the SSM parameters injected as environment variables, or an empty string if none is set.
+func (s *RequestDrivenWebService) SSMParameterPath() string {
+	return ssmParameterPath(s.Variables)
+}
+
+// InjectSSMParameters replaces the "variables.from_ssm_path" field with a secret for each SSM parameter name.
+// Variables and secrets of the manifest take precedence over the parameters with the same environment variable name.
+func (s *RequestDrivenWebService) InjectSSMParameters(names []string) {
+	s.Secrets = injectSSMParameters(s.Variables, s.Secrets, names)
+}
+
 // BuildArgs returns a docker.BuildArguments object given a context directory.
+// Services deployed from source code are built by App Runner, and don't have any image to build.
 func (s *RequestDrivenWebService) BuildArgs(contextDir string) (map[string]*DockerBuildArgs, error) {
+	if !s.Source.IsEmpty() {
+		return map[string]*DockerBuildArgs{}, nil
+	}
 	required, err := requiresBuild(s.ImageConfig.Image)
 	if err != nil {
 		return nil, err
@@ -163,11 +222,23 @@ func (s *RequestDrivenWebService) BuildArgs(contextDir string) (map[string]*Dock
 	// Creating an map to store buildArgs of all sidecar images and main container image.
 	buildArgsPerContainer := make(map[string]*DockerBuildArgs, 1)
 	if required {
-		buildArgsPerContainer[aws.StringValue(s.Name)] = s.ImageConfig.Image.BuildConfig(contextDir)
+		buildArgsPerContainer[aws.StringValue(s.Name)] = withBuildOnlyVariables(s.ImageConfig.Image.BuildConfig(contextDir), s.Variables)
 	}
 	return buildArgsPerContainer, nil
 }
 
+// ImageLocations returns the locations of the existing images that the containers of the service run.
+// The keys of the map are container names, and the values are image URIs.
+func (s *RequestDrivenWebService) ImageLocations() map[string]string {
+	return imageLocations(s.Name, s.ImageConfig.Image, nil)
+}
+
+// FileSyncs returns the files of the workspace that are copied into the containers of the service run locally when they change.
+// The keys of the map are container names.
+func (s *RequestDrivenWebService) FileSyncs() map[string]ImageSync {
+	return fileSyncs(s.Name, s.ImageConfig.Image, nil)
+}
+
 func (s RequestDrivenWebService) applyEnv(envName string) (workloadManifest, error) {
 	overrideConfig, ok := s.Environments[envName]
 	if !ok {
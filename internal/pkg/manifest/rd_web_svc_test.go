@@ -135,6 +135,32 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 				},
 			},
 		},
+		"should unmarshal source code configuration": {
+			inContent: []byte(
+				"command: npm start\n" +
+					"source:\n" +
+					"  directory: api\n" +
+					"  runtime: NODEJS_18\n" +
+					"  build: npm ci\n" +
+					"  port: 8080\n" +
+					"  connection: arn:aws:apprunner:us-west-2:123456789012:connection/github/abc\n" +
+					"  branch: main\n",
+			),
+
+			wantedStruct: RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					StartCommand: aws.String("npm start"),
+					Source: AppRunnerSource{
+						Directory:  aws.String("api"),
+						Runtime:    aws.String("NODEJS_18"),
+						Build:      aws.String("npm ci"),
+						Port:       aws.Uint16(8080),
+						Connection: aws.String("arn:aws:apprunner:us-west-2:123456789012:connection/github/abc"),
+						Branch:     aws.String("main"),
+					},
+				},
+			},
+		},
 		"should unmarshal image build configuration": {
 			inContent: []byte(
 				"image:\n" +
@@ -160,7 +186,7 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 										Dockerfile: aws.String("./Dockerfile"),
 										Target:     aws.String("build-stage"),
 										CacheFrom:  []string{"image:tag"},
-										Args:       map[string]string{"a": "1", "b": "2"},
+										Args:       map[string]BuildArg{"a": {Plain: aws.String("1")}, "b": {Plain: aws.String("2")}},
 									},
 								},
 							},
@@ -180,12 +206,12 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
 					Variables: map[string]Variable{
 						"LOG_LEVEL": {
-							StringOrFromCFN{
+							StringOrFromCFN: StringOrFromCFN{
 								Plain: stringP("info"),
 							},
 						},
 						"NODE_ENV": {
-							StringOrFromCFN{
+							StringOrFromCFN: StringOrFromCFN{
 								Plain: stringP("development"),
 							},
 						},
@@ -238,6 +264,24 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 				},
 			},
 		},
+		"should unmarshal scaling configuration": {
+			inContent: []byte(
+				"scaling:\n" +
+					"  max_concurrency: 50\n" +
+					"  min_instances: 2\n" +
+					"  max_instances: 10\n",
+			),
+
+			wantedStruct: RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Scaling: AppRunnerScalingConfig{
+						MaxConcurrency: aws.Int(50),
+						MinInstances:   aws.Int(2),
+						MaxInstances:   aws.Int(10),
+					},
+				},
+			},
+		},
 		"should unmarshal healthcheck shorthand": {
 			inContent: []byte(
 				"http:\n" +
@@ -333,6 +377,31 @@ func TestRequestDrivenWebService_Port(t *testing.T) {
 	require.Equal(t, uint16(80), actual)
 }
 
+func TestRequestDrivenWebService_Source(t *testing.T) {
+	// GIVEN
+	mft := RequestDrivenWebService{
+		Workload: Workload{
+			Name: aws.String("api"),
+		},
+		RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+			Source: AppRunnerSource{
+				Runtime: aws.String("PYTHON_3"),
+				Port:    uint16P(8080),
+			},
+		},
+	}
+
+	// WHEN
+	port, ok := mft.Port()
+	buildArgs, err := mft.BuildArgs("/ws")
+
+	// THEN
+	require.True(t, ok)
+	require.Equal(t, uint16(8080), port)
+	require.NoError(t, err)
+	require.Empty(t, buildArgs)
+}
+
 func TestRequestDrivenWebService_ContainerPlatform(t *testing.T) {
 	t.Run("should return platform string with values found in args", func(t *testing.T) {
 		// GIVEN
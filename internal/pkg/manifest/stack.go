@@ -0,0 +1,39 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// StackSettings holds the protections of the CloudFormation stack deployed from a manifest.
+type StackSettings struct {
+	TerminationProtection *bool                  `yaml:"termination_protection"`
+	Policy                map[string]interface{} `yaml:"policy"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (s *StackSettings) IsEmpty() bool {
+	return s.TerminationProtection == nil && s.Policy == nil
+}
+
+// IsTerminationProtected returns true if the stack can't be deleted until its termination protection is disabled.
+func (s *StackSettings) IsTerminationProtected() bool {
+	return aws.BoolValue(s.TerminationProtection)
+}
+
+// StackPolicy returns the JSON document of the stack policy, or an empty string if there is no policy.
+func (s *StackSettings) StackPolicy() (string, error) {
+	if s.Policy == nil {
+		return "", nil
+	}
+	out, err := json.Marshal(s.Policy)
+	if err != nil {
+		return "", fmt.Errorf("marshal stack policy: %w", err)
+	}
+	return string(out), nil
+}
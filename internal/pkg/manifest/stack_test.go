@@ -0,0 +1,45 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestStackSettings_StackPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedPolicy string
+	}{
+		"no policy": {
+			inContent: `termination_protection: true`,
+		},
+		"policy that prevents the replacement of a resource": {
+			inContent: `
+policy:
+  Statement:
+    - Effect: Deny
+      Action: Update:Replace
+      Principal: "*"
+      Resource: LogicalResourceId/Database
+`,
+			wantedPolicy: `{"Statement":[{"Action":"Update:Replace","Effect":"Deny","Principal":"*","Resource":"LogicalResourceId/Database"}]}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var s StackSettings
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inContent), &s))
+
+			got, err := s.StackPolicy()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPolicy, got)
+		})
+	}
+}
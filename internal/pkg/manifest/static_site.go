@@ -28,6 +28,7 @@ type StaticSite struct {
 type StaticSiteConfig struct {
 	HTTP        StaticSiteHTTP `yaml:"http"`
 	FileUploads []FileUpload   `yaml:"files"`
+	Stack       StackSettings  `yaml:"stack"`
 }
 
 // StaticSiteHTTP defines the http configuration for the static site.
@@ -101,6 +102,11 @@ func (s *StaticSite) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
+// StackSettings returns the protections of the CloudFormation stack of the static site.
+func (s *StaticSite) StackSettings() StackSettings {
+	return s.Stack
+}
+
 // To implement workloadManifest.
 func (s *StaticSite) subnets() *SubnetListOrArgs {
 	return nil
@@ -197,6 +197,28 @@ func (c *Count) Desired() (*int, error) {
 	return aws.Int(min), nil
 }
 
+// Max returns the maximum number of tasks that the service can scale out to, including with its scheduled scaling.
+// It returns nil if the count isn't specified.
+func (c *Count) Max() (*int, error) {
+	if c.AdvancedCount.IsEmpty() || c.AdvancedCount.IgnoreRange() {
+		return c.Desired()
+	}
+	_, max, err := c.AdvancedCount.Range.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("parse task count value %s: %w", aws.StringValue((*string)(c.AdvancedCount.Range.Value)), err)
+	}
+	for _, schedule := range c.AdvancedCount.Schedules {
+		_, scheduledMax, err := schedule.Range.Parse()
+		if err != nil {
+			return nil, fmt.Errorf("parse task count value %s: %w", aws.StringValue((*string)(schedule.Range.Value)), err)
+		}
+		if scheduledMax > max {
+			max = scheduledMax
+		}
+	}
+	return aws.Int(max), nil
+}
+
 // Percentage represents a valid percentage integer ranging from 0 to 100.
 type Percentage int
 
@@ -228,11 +250,45 @@ type AdvancedCount struct {
 	Memory       ScalingConfigOrT[Percentage]    `yaml:"memory_percentage"`
 	Requests     ScalingConfigOrT[int]           `yaml:"requests"`
 	ResponseTime ScalingConfigOrT[time.Duration] `yaml:"response_time"`
+	Connections  ScalingConfigOrT[int]           `yaml:"connections"` // Active connections per task, for long-lived connections.
 	QueueScaling QueueScaling                    `yaml:"queue_delay"`
 
+	CustomMetrics []CustomMetricScaling `yaml:"custom_metrics"` // Target tracking of arbitrary CloudWatch metrics.
+	Schedules     []ScheduledScaling    `yaml:"schedules"`      // Scheduled changes of the range.
+
 	workloadType string
 }
 
+// CustomMetricScaling represents a target tracking scaling policy on a CloudWatch metric.
+type CustomMetricScaling struct {
+	Namespace  *string           `yaml:"namespace"`
+	Name       *string           `yaml:"name"`
+	Dimensions map[string]string `yaml:"dimensions"`
+	Statistic  *string           `yaml:"statistic"`
+	Value      *float64          `yaml:"value"`
+	Cooldown   Cooldown          `yaml:"cooldown"`
+}
+
+// ScheduledScaling represents a scheduled change of the minimum and maximum number of tasks.
+type ScheduledScaling struct {
+	Schedule *string         `yaml:"schedule"`
+	Calendar ScalingCalendar `yaml:"calendar"` // Mutually exclusive with Schedule.
+	Timezone *string         `yaml:"timezone"`
+	Range    Range           `yaml:"range"`
+}
+
+// ScalingCalendar represents an iCalendar whose events change the minimum and maximum number of tasks
+// while they last.
+type ScalingCalendar struct {
+	File        *string `yaml:"file"`         // Path relative to the workspace root.
+	SSMDocument *string `yaml:"ssm_document"` // Name or ARN of an AWS Systems Manager Change Calendar document.
+}
+
+// IsEmpty returns whether ScalingCalendar is empty.
+func (c *ScalingCalendar) IsEmpty() bool {
+	return c.File == nil && c.SSMDocument == nil
+}
+
 // IsEmpty returns whether ScalingConfigOrT is empty
 func (r *ScalingConfigOrT[_]) IsEmpty() bool {
 	return r.ScalingConfig.IsEmpty() && r.Value == nil
@@ -251,7 +307,8 @@ func (c *Cooldown) IsEmpty() bool {
 // IsEmpty returns whether AdvancedCount is empty.
 func (a *AdvancedCount) IsEmpty() bool {
 	return a.Range.IsEmpty() && a.CPU.IsEmpty() && a.Memory.IsEmpty() && a.Cooldown.IsEmpty() &&
-		a.Requests.IsEmpty() && a.ResponseTime.IsEmpty() && a.Spot == nil && a.QueueScaling.IsEmpty()
+		a.Requests.IsEmpty() && a.ResponseTime.IsEmpty() && a.Connections.IsEmpty() && a.Spot == nil && a.QueueScaling.IsEmpty() &&
+		len(a.CustomMetrics) == 0 && len(a.Schedules) == 0
 }
 
 // IgnoreRange returns whether desiredCount is specified on spot capacity
@@ -266,26 +323,30 @@ func (a *AdvancedCount) hasAutoscaling() bool {
 func (a *AdvancedCount) validScalingFields() []string {
 	switch a.workloadType {
 	case manifestinfo.LoadBalancedWebServiceType:
-		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time"}
+		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "custom_metrics", "schedules"}
 	case manifestinfo.BackendServiceType:
-		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time"}
+		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "custom_metrics", "schedules"}
 	case manifestinfo.WorkerServiceType:
-		return []string{"cpu_percentage", "memory_percentage", "queue_delay"}
+		return []string{"cpu_percentage", "memory_percentage", "queue_delay", "custom_metrics", "schedules"}
 	default:
 		return nil
 	}
 }
 
 func (a *AdvancedCount) hasScalingFieldsSet() bool {
+	if len(a.CustomMetrics) > 0 || len(a.Schedules) > 0 {
+		return true
+	}
 	switch a.workloadType {
 	case manifestinfo.LoadBalancedWebServiceType:
-		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty()
+		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty() || !a.Connections.IsEmpty()
 	case manifestinfo.BackendServiceType:
-		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty()
+		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty() || !a.Connections.IsEmpty()
 	case manifestinfo.WorkerServiceType:
 		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.QueueScaling.IsEmpty()
 	default:
-		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty() || !a.QueueScaling.IsEmpty()
+		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty() || !a.Connections.IsEmpty() ||
+			!a.QueueScaling.IsEmpty()
 	}
 }
 
@@ -308,6 +369,9 @@ func (a *AdvancedCount) getInvalidFieldsSet() []string {
 		if !a.ResponseTime.IsEmpty() {
 			invalidFields = append(invalidFields, "response_time")
 		}
+		if !a.Connections.IsEmpty() {
+			invalidFields = append(invalidFields, "connections")
+		}
 	}
 	return invalidFields
 }
@@ -319,7 +383,10 @@ func (a *AdvancedCount) unsetAutoscaling() {
 	a.Memory = ScalingConfigOrT[Percentage]{}
 	a.Requests = ScalingConfigOrT[int]{}
 	a.ResponseTime = ScalingConfigOrT[time.Duration]{}
+	a.Connections = ScalingConfigOrT[int]{}
 	a.QueueScaling = QueueScaling{}
+	a.CustomMetrics = nil
+	a.Schedules = nil
 }
 
 // QueueScaling represents the configuration to scale a service based on a SQS queue.
@@ -377,6 +444,8 @@ func (hc *HealthCheckArgsOrString) Path() *string {
 // See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-elasticloadbalancingv2-targetgroup.html.
 type NLBHealthCheckArgs struct {
 	Port               *int           `yaml:"port"`
+	Protocol           *string        `yaml:"protocol"`
+	Path               *string        `yaml:"path"`
 	HealthyThreshold   *int64         `yaml:"healthy_threshold"`
 	UnhealthyThreshold *int64         `yaml:"unhealthy_threshold"`
 	Timeout            *time.Duration `yaml:"timeout"`
@@ -385,7 +454,7 @@ type NLBHealthCheckArgs struct {
 }
 
 func (h *NLBHealthCheckArgs) isEmpty() bool {
-	return h.Port == nil && h.HealthyThreshold == nil && h.UnhealthyThreshold == nil && h.Timeout == nil && h.Interval == nil
+	return h.Port == nil && h.Protocol == nil && h.Path == nil && h.HealthyThreshold == nil && h.UnhealthyThreshold == nil && h.Timeout == nil && h.Interval == nil
 }
 
 // ParsePortMapping parses port-protocol string into individual port and protocol strings.
@@ -514,31 +583,45 @@ func (cfg NetworkLoadBalancerListener) exposedPorts(exposedPorts []ExposedPort,
 	if cfg.TargetPort != nil {
 		targetPort = uint16(aws.IntValue(cfg.TargetPort))
 	}
-	targetProtocol := TCP
+	targetProtocols := []string{TCP}
 	if nlbProtocol != nil {
-		// Expose TCP port for TLS listeners.
-		if protocol := aws.StringValue(nlbProtocol); !strings.EqualFold(protocol, TLS) {
-			targetProtocol = protocol
-		}
-	}
-	targetProtocol = strings.ToLower(targetProtocol)
-	for _, exposedPort := range exposedPorts {
-		if targetPort == exposedPort.Port && targetProtocol == exposedPort.Protocol {
-			return nil, nil
+		switch protocol := aws.StringValue(nlbProtocol); {
+		case strings.EqualFold(protocol, TLS):
+			// Expose TCP port for TLS listeners.
+		case strings.EqualFold(protocol, tcpUDP):
+			// Expose both a TCP and a UDP port for TCP_UDP listeners.
+			targetProtocols = []string{TCP, udp}
+		default:
+			targetProtocols = []string{protocol}
 		}
 	}
 	targetContainer := workloadName
 	if cfg.TargetContainer != nil {
 		targetContainer = aws.StringValue(cfg.TargetContainer)
 	}
-
-	return []ExposedPort{
-		{
+	var out []ExposedPort
+	for _, targetProtocol := range targetProtocols {
+		targetProtocol = strings.ToLower(targetProtocol)
+		if isExposed(exposedPorts, targetPort, targetProtocol) {
+			continue
+		}
+		out = append(out, ExposedPort{
 			Port:          targetPort,
 			Protocol:      targetProtocol,
 			ContainerName: targetContainer,
-		},
-	}, nil
+		})
+	}
+	return out, nil
+}
+
+// isExposed returns true if the port is already exposed over the protocol.
+func isExposed(exposedPorts []ExposedPort, port uint16, protocol string) bool {
+	for _, exposedPort := range exposedPorts {
+		if port == exposedPort.Port && protocol == exposedPort.Protocol {
+			return true
+		}
+	}
+	return false
 }
 
 func (sidecar SidecarConfig) exposedPorts(sidecarName string) ([]ExposedPort, error) {
@@ -569,7 +652,7 @@ func (sidecar SidecarConfig) exposedPorts(sidecarName string) ([]ExposedPort, er
 
 func sortExposedPorts(exposedPorts []ExposedPort) []ExposedPort {
 	// Sort the exposed ports so that the order is consistent and the integration test won't be flaky.
-	sort.Slice(exposedPorts, func(i, j int) bool {
+	sort.SliceStable(exposedPorts, func(i, j int) bool {
 		return exposedPorts[i].Port < exposedPorts[j].Port
 	})
 	return exposedPorts
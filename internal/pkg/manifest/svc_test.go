@@ -147,7 +147,7 @@ environments:
 							},
 							Variables: map[string]Variable{
 								"LOG_LEVEL": {
-									StringOrFromCFN{
+									StringOrFromCFN: StringOrFromCFN{
 										Plain: stringP("WARN"),
 									},
 								},
@@ -175,8 +175,8 @@ environments:
 												Context:    aws.String("pathto/Dockerfile"),
 												Target:     aws.String("build-stage"),
 												CacheFrom:  []string{"foo/bar:latest"},
-												Args: map[string]string{
-													"arg1": "value1",
+												Args: map[string]BuildArg{
+													"arg1": {Plain: aws.String("value1")},
 												},
 											},
 										},
@@ -473,7 +473,7 @@ func TestStringOrFromCFN_UnmarshalYAML(t *testing.T) {
 			in: []byte(`mock_field: hey`),
 			wanted: mockParentField{
 				MockField: mockField{
-					StringOrFromCFN{
+					StringOrFromCFN: StringOrFromCFN{
 						Plain: aws.String("hey"),
 					},
 				},
@@ -484,7 +484,7 @@ func TestStringOrFromCFN_UnmarshalYAML(t *testing.T) {
   from_cfn: yo`),
 			wanted: mockParentField{
 				MockField: mockField{
-					StringOrFromCFN{
+					StringOrFromCFN: StringOrFromCFN{
 						FromCFN: fromCFN{
 							Name: aws.String("yo"),
 						},
@@ -633,6 +633,70 @@ func TestCount_UnmarshalYAML(t *testing.T) {
 				},
 			},
 		},
+		"With custom metrics and schedules": {
+			inContent: []byte(`count:
+  range: 1-10
+  custom_metrics:
+    - namespace: AWS/SQS
+      name: ApproximateNumberOfMessagesVisible
+      dimensions:
+        QueueName: jobs
+      value: 100
+      cooldown:
+        in: 1m
+        out: 1m
+  schedules:
+    - schedule: "0 9 * * 1-5"
+      timezone: Europe/Paris
+      range:
+        min: 5
+        max: 10
+    - calendar:
+        file: calendars/holidays.ics
+      range:
+        min: 10
+        max: 50
+`),
+			wantedStruct: Count{
+				AdvancedCount: AdvancedCount{
+					Range: Range{Value: &mockRange},
+					CustomMetrics: []CustomMetricScaling{
+						{
+							Namespace: aws.String("AWS/SQS"),
+							Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+							Dimensions: map[string]string{
+								"QueueName": "jobs",
+							},
+							Value:    aws.Float64(100),
+							Cooldown: mockCooldown,
+						},
+					},
+					Schedules: []ScheduledScaling{
+						{
+							Schedule: aws.String("0 9 * * 1-5"),
+							Timezone: aws.String("Europe/Paris"),
+							Range: Range{
+								RangeConfig: RangeConfig{
+									Min: aws.Int(5),
+									Max: aws.Int(10),
+								},
+							},
+						},
+						{
+							Calendar: ScalingCalendar{
+								File: aws.String("calendars/holidays.ics"),
+							},
+							Range: Range{
+								RangeConfig: RangeConfig{
+									Min: aws.Int(10),
+									Max: aws.Int(50),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"Error if unmarshalable": {
 			inContent: []byte(`count: badNumber
 `),
@@ -656,6 +720,8 @@ func TestCount_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.AdvancedCount.Requests, b.Count.AdvancedCount.Requests)
 				require.Equal(t, tc.wantedStruct.AdvancedCount.ResponseTime, b.Count.AdvancedCount.ResponseTime)
 				require.Equal(t, tc.wantedStruct.AdvancedCount.Spot, b.Count.AdvancedCount.Spot)
+				require.Equal(t, tc.wantedStruct.AdvancedCount.CustomMetrics, b.Count.AdvancedCount.CustomMetrics)
+				require.Equal(t, tc.wantedStruct.AdvancedCount.Schedules, b.Count.AdvancedCount.Schedules)
 			}
 		})
 	}
@@ -809,6 +875,80 @@ func TestCount_Desired(t *testing.T) {
 	}
 }
 
+func TestCount_Max(t *testing.T) {
+	mockRange := IntRangeBand("1-10")
+	mockScheduledRange := IntRangeBand("5-20")
+	testCases := map[string]struct {
+		input *Count
+
+		expected    *int
+		expectedErr error
+	}{
+		"without a count": {
+			input: &Count{},
+		},
+		"with value": {
+			input: &Count{
+				Value: aws.Int(42),
+			},
+			expected: aws.Int(42),
+		},
+		"with spot count": {
+			input: &Count{
+				AdvancedCount: AdvancedCount{
+					Spot: aws.Int(31),
+				},
+			},
+			expected: aws.Int(31),
+		},
+		"with autoscaling range": {
+			input: &Count{
+				AdvancedCount: AdvancedCount{
+					Range: Range{
+						Value: &mockRange,
+					},
+				},
+			},
+			expected: aws.Int(10),
+		},
+		"with a scheduled range above the autoscaling range": {
+			input: &Count{
+				AdvancedCount: AdvancedCount{
+					Range: Range{
+						RangeConfig: RangeConfig{
+							Min: aws.Int(1),
+							Max: aws.Int(10),
+						},
+					},
+					Schedules: []ScheduledScaling{
+						{
+							Schedule: aws.String("cron(0 8 * * ? *)"),
+							Range: Range{
+								Value: &mockScheduledRange,
+							},
+						},
+					},
+				},
+			},
+			expected: aws.Int(20),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual, err := tc.input.Max()
+
+			// THEN
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
 func TestHealthCheckArgsOrString_IsEmpty(t *testing.T) {
 	testCases := map[string]struct {
 		hc     HealthCheckArgsOrString
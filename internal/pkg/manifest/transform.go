@@ -37,6 +37,7 @@ var defaultTransformers = []mergo.Transformers{
 	efsConfigOrBoolTransformer{},
 	efsVolumeConfigurationTransformer{},
 	sqsQueueOrBoolTransformer{},
+	deduplicationTableOrBoolTransformer{},
 	httpOrBoolTransformer{},
 	secretTransformer{},
 	environmentCDNConfigTransformer{},
@@ -513,6 +514,31 @@ func (q sqsQueueOrBoolTransformer) Transformer(typ reflect.Type) func(dst, src r
 	}
 }
 
+type deduplicationTableOrBoolTransformer struct{}
+
+// Transformer returns custom merge logic for DeduplicationTableOrBool's fields.
+func (t deduplicationTableOrBoolTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(DeduplicationTableOrBool{}) {
+		return nil
+	}
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(DeduplicationTableOrBool), src.Interface().(DeduplicationTableOrBool)
+
+		if !srcStruct.Advanced.IsEmpty() {
+			dstStruct.Enabled = nil
+		}
+
+		if srcStruct.Enabled != nil {
+			dstStruct.Advanced = DeduplicationTable{}
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(dstStruct))
+		}
+		return nil
+	}
+}
+
 type httpOrBoolTransformer struct{}
 
 // Transformer returns custom merge logic for HTTPOrBool's fields.
@@ -1178,6 +1178,63 @@ func TestSQSQueueOrBoolTransformer_Transformer(t *testing.T) {
 	}
 }
 
+func TestDeduplicationTableOrBoolTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(e *DeduplicationTableOrBool)
+		override func(e *DeduplicationTableOrBool)
+		wanted   func(e *DeduplicationTableOrBool)
+	}{
+		"bool set to empty if config is not nil": {
+			original: func(e *DeduplicationTableOrBool) {
+				e.Enabled = aws.Bool(true)
+			},
+			override: func(e *DeduplicationTableOrBool) {
+				e.Advanced = DeduplicationTable{
+					TTL: durationp(time.Hour),
+				}
+			},
+			wanted: func(e *DeduplicationTableOrBool) {
+				e.Advanced = DeduplicationTable{
+					TTL: durationp(time.Hour),
+				}
+			},
+		},
+		"config set to empty if bool is not nil": {
+			original: func(e *DeduplicationTableOrBool) {
+				e.Advanced = DeduplicationTable{
+					TTL: durationp(time.Hour),
+				}
+			},
+			override: func(e *DeduplicationTableOrBool) {
+				e.Enabled = aws.Bool(false)
+			},
+			wanted: func(e *DeduplicationTableOrBool) {
+				e.Enabled = aws.Bool(false)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted DeduplicationTableOrBool
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use custom transformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(deduplicationTableOrBoolTransformer{}))
+			require.NoError(t, err)
+
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
+
 func TestHTTPOrBoolTransformer_Transformer(t *testing.T) {
 	testCases := map[string]struct {
 		original func(r *HTTPOrBool)
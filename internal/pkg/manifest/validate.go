@@ -6,17 +6,23 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net"
+	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/dustin/go-humanize/english"
 )
 
@@ -32,6 +38,11 @@ const (
 	ephemeralMaxValueGiB = 200
 
 	envFileExt = ".env"
+
+	secretsManagerServiceName = "secretsmanager"
+
+	// CodeDeploy waits at most two days between the steps of a deployment.
+	maxDeploymentWaitTime = 2880 * time.Minute
 )
 
 const (
@@ -41,9 +52,16 @@ const (
 	// TLS is the tls protocol for NLB.
 	TLS = "TLS"
 	udp = "UDP"
+	// tcpUDP is the protocol of NLB listeners that accept both TCP and UDP traffic on the same port.
+	tcpUDP = "TCP_UDP"
 
 	// Tracing vendors.
 	awsXRAY = "awsxray"
+	otlp    = "otlp"
+
+	// Metrics with anomaly detection alarms.
+	anomalyDetectionLatency = "latency"
+	anomalyDetectionErrors  = "errors"
 )
 
 const (
@@ -51,6 +69,45 @@ const (
 	// Please refer to https://docs.aws.amazon.com/elasticloadbalancing/latest/application/load-balancer-limits.html.
 	maxConditionsPerRule = 5
 	rootPath             = "/"
+
+	// Limits of the promotion policy of a pipeline stage.
+	maxPromotionApprovals = 10
+	// The soak time is spent in a CodeBuild action, whose timeout is at most 8 hours.
+	maxPromotionSoakTime = 7 * time.Hour
+
+	// Limits of the attributes of a target group of an Application Load Balancer.
+	maxDeregistrationDelay = time.Hour
+	minSlowStart           = 30 * time.Second
+	maxSlowStart           = 15 * time.Minute
+	// Fargate kills a container at most two minutes after it's sent SIGTERM.
+	maxStopTimeout = 2 * time.Minute
+
+	// Limits of the idle timeout of an Application Load Balancer.
+	minALBIdleTimeout = time.Second
+	maxALBIdleTimeout = 4000 * time.Second
+
+	// Limits of the attributes of a Route 53 health check.
+	minAliasHealthCheckFailureThreshold = 1
+	maxAliasHealthCheckFailureThreshold = 10
+
+	// Limits of the expiration of the scale-in protection of a task.
+	minScaleInProtectionExpiresIn = time.Minute
+	maxScaleInProtectionExpiresIn = 48 * time.Hour
+
+	// Limits of the percentage of requests sent to a mirror.
+	minTrafficMirrorPercent = 1
+	maxTrafficMirrorPercent = 100
+
+	// Limits of an App Runner auto scaling configuration.
+	maxAppRunnerConcurrency = 200
+	maxAppRunnerInstances   = 25
+
+	// An SQS ReceiveMessage request returns at most 10 messages.
+	maxSQSBatchSize = 10
+	// SQS makes a received message invisible for 30 seconds unless the queue has another visibility timeout.
+	defaultSQSVisibilityTimeout = 30 * time.Second
+	// SNS and SQS FIFO already drop the duplicates sent within 5 minutes, a shorter TTL would record messages for nothing.
+	minDeduplicationTableTTL = 5 * time.Minute
 )
 
 var (
@@ -63,16 +120,32 @@ var (
 
 	essentialContainerDependsOnValidStatuses = []string{dependsOnStart, dependsOnHealthy}
 	dependsOnValidStatuses                   = []string{dependsOnStart, dependsOnComplete, dependsOnSuccess, dependsOnHealthy}
-	nlbValidProtocols                        = []string{TCP, udp, TLS}
+	nlbValidProtocols                        = []string{TCP, udp, TLS, tcpUDP}
+	nlbValidHealthCheckProtocols             = []string{TCP, "HTTP", "HTTPS"}
 	validContainerProtocols                  = []string{TCP, udp}
-	tracingValidVendors                      = []string{awsXRAY}
+	tracingValidVendors                      = []string{awsXRAY, otlp}
+	appRunnerTracingValidVendors             = []string{awsXRAY}
+	anomalyDetectionValidMetrics             = []string{anomalyDetectionLatency, anomalyDetectionErrors}
 	ecsRollingUpdateStrategies               = []string{ECSDefaultRollingUpdateStrategy, ECSRecreateRollingUpdateStrategy}
+	codeDeployDeploymentStrategies           = []string{BlueGreenDeploymentStrategy, CanaryDeploymentStrategy}
 
 	httpProtocolVersions = []string{"GRPC", "HTTP1", "HTTP2"}
 
+	// CloudWatch statistics of the metrics that target tracking policies can scale on.
+	customMetricStatistics = []string{"Average", "Minimum", "Maximum", "SampleCount", "Sum"}
+
+	// Managed runtimes of App Runner that build and run source code.
+	appRunnerSourceRuntimes = []string{"PYTHON_3", "PYTHON_311", "NODEJS_12", "NODEJS_14", "NODEJS_16", "NODEJS_18",
+		"CORRETTO_8", "CORRETTO_11", "GO_1", "DOTNET_6", "PHP_81", "RUBY_31"}
+
+	signalRegexp = regexp.MustCompile(`^(SIG[A-Z0-9+\-]+|\d+)$`) // Validates that an expression is the name of a signal, such as SIGHUP, or its number.
+
+	secretARNRegexp = regexp.MustCompile(`^arn:[^:]+:(secretsmanager|ssm):`) // Validates that an expression is the ARN of a Secrets Manager secret or SSM parameter.
+
 	invalidTaskDefOverridePathRegexp  = []string{`Family`, `ContainerDefinitions\[\d+\].Name`}
 	validSQSDeduplicationScopeValues  = []string{sqsDeduplicationScopeMessageGroup, sqsDeduplicationScopeQueue}
 	validSQSFIFOThroughputLimitValues = []string{sqsFIFOThroughputLimitPerMessageGroupID, sqsFIFOThroughputLimitPerQueue}
+	validMessageGroupStrategies       = []string{messageGroupStrategyConcurrent, messageGroupStrategySequential}
 )
 
 // Validate returns nil if DynamicLoadBalancedWebService is configured correctly.
@@ -125,6 +198,17 @@ func (l LoadBalancedWebService) validate() error {
 			return fmt.Errorf(`validate target for "nlb.additional_listeners[%d]": %w`, idx, err)
 		}
 	}
+	if err = validateTrafficMirror(l.HTTPOrBool.HTTP, aws.StringValue(l.Name), l.Sidecars); err != nil {
+		return fmt.Errorf(`validate "http.mirror": %w`, err)
+	}
+	for _, rule := range l.HTTPOrBool.RoutingRules() {
+		if err = validateHTTPTargetProtocol(rule, l.Sidecars); err != nil {
+			return fmt.Errorf(`validate load balancer target for "http": %w`, err)
+		}
+		if err = validateDeregistrationDelay(rule, aws.StringValue(l.Name), l.ImageConfig.Image.StopTimeout); err != nil {
+			return fmt.Errorf(`validate "http": %w`, err)
+		}
+	}
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     l.Sidecars,
 		imageConfig:       l.ImageConfig.Image,
@@ -133,15 +217,22 @@ func (l LoadBalancedWebService) validate() error {
 	}); err != nil {
 		return fmt.Errorf("validate container dependencies: %w", err)
 	}
-	if err = validateExposedPorts(validateExposedPortsOpts{
+	portsOpts := validateExposedPortsOpts{
 		mainContainerName: aws.StringValue(l.Name),
 		mainContainerPort: l.ImageConfig.Port,
 		sidecarConfig:     l.Sidecars,
 		alb:               &l.HTTPOrBool.HTTP,
 		nlb:               &l.NLBConfig,
-	}); err != nil {
+	}
+	if err = validateExposedPorts(portsOpts); err != nil {
 		return fmt.Errorf("validate unique exposed ports: %w", err)
 	}
+	for _, warning := range healthCheckPortWarnings(portsOpts) {
+		log.Warningln(warning)
+	}
+	if err = validateServiceConnectPort(l.Network.Connect, portsOpts); err != nil {
+		return fmt.Errorf(`validate "network.connect": %w`, err)
+	}
 	return nil
 }
 
@@ -155,6 +246,66 @@ func (d DeploymentConfig) validate() error {
 	if err := d.DeploymentControllerConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "rolling": %w`, err)
 	}
+	if err := d.Strategy.validate(); err != nil {
+		return fmt.Errorf(`validate "strategy": %w`, err)
+	}
+	if d.Rolling != nil && !d.Strategy.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "rolling",
+			secondField: "strategy",
+		}
+	}
+	return nil
+}
+
+func (s DeploymentStrategy) validate() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	if s.Type == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "type",
+		}
+	}
+	if !contains(aws.StringValue(s.Type), codeDeployDeploymentStrategies) {
+		return fmt.Errorf("invalid deployment strategy %q, must be one of %s",
+			aws.StringValue(s.Type),
+			english.WordSeries(codeDeployDeploymentStrategies, "or"))
+	}
+	if aws.StringValue(s.Type) != CanaryDeploymentStrategy && !s.Canary.IsEmpty() {
+		return fmt.Errorf(`"canary" can only be specified with the %q strategy`, CanaryDeploymentStrategy)
+	}
+	if err := s.Canary.validate(); err != nil {
+		return fmt.Errorf(`validate "canary": %w`, err)
+	}
+	if s.BakeTime != nil {
+		if err := validateWholeMinutes(*s.BakeTime, 0, maxDeploymentWaitTime); err != nil {
+			return fmt.Errorf(`validate "bake_time": %w`, err)
+		}
+	}
+	return nil
+}
+
+func (c CanaryConfig) validate() error {
+	if c.Percent != nil && (*c.Percent < 1 || *c.Percent > 99) {
+		return fmt.Errorf(`"percent" must be between 1 and 99, got %d`, *c.Percent)
+	}
+	if c.Interval != nil {
+		if err := validateWholeMinutes(*c.Interval, time.Minute, maxDeploymentWaitTime); err != nil {
+			return fmt.Errorf(`validate "interval": %w`, err)
+		}
+	}
+	return nil
+}
+
+// validateWholeMinutes returns nil if the duration is a number of minutes between min and max, as CodeDeploy expects.
+func validateWholeMinutes(d, min, max time.Duration) error {
+	if d%time.Minute != 0 {
+		return fmt.Errorf("duration %s must be a whole number of minutes", d)
+	}
+	if d < min || d > max {
+		return fmt.Errorf("duration %s must be between %s and %s", d, min, max)
+	}
 	return nil
 }
 
@@ -207,6 +358,12 @@ func (l LoadBalancedWebServiceConfig) validate() error {
 	if l.HTTPOrBool.Disabled() && (!l.Count.AdvancedCount.Requests.IsEmpty() || !l.Count.AdvancedCount.ResponseTime.IsEmpty()) {
 		return errors.New(`scaling based on "nlb" requests or response time is not supported`)
 	}
+	if l.HTTPOrBool.Disabled() && !l.Count.AdvancedCount.Connections.IsEmpty() {
+		return errors.New(`scaling based on "nlb" connections is not supported`)
+	}
+	if l.HTTPOrBool.Disabled() && len(l.Observability.AnomalyDetection) != 0 {
+		return errors.New(`anomaly detection on "nlb" metrics is not supported`)
+	}
 	if err = l.ImageConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "image": %w`, err)
 	}
@@ -233,6 +390,9 @@ func (l LoadBalancedWebServiceConfig) validate() error {
 	if err = l.PublishConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if err = l.Observability.validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
 	for ind, taskDefOverride := range l.TaskDefOverrides {
 		if err = taskDefOverride.validate(); err != nil {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
@@ -260,6 +420,12 @@ func (l LoadBalancedWebServiceConfig) validate() error {
 	if err = l.DeployConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if err = l.validateDeploymentStrategy(); err != nil {
+		return fmt.Errorf(`validate "deployment.strategy": %w`, err)
+	}
+	if err = l.Stack.validate(); err != nil {
+		return fmt.Errorf(`validate "stack": %w`, err)
+	}
 	return nil
 }
 
@@ -269,6 +435,9 @@ func (b BackendService) validate() error {
 	if err = b.DeployConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if !b.DeployConfig.Strategy.IsEmpty() {
+		return fmt.Errorf(`"deployment.strategy" is only supported for %s`, manifestinfo.LoadBalancedWebServiceType)
+	}
 	if err = b.BackendServiceConfig.validate(); err != nil {
 		return err
 	}
@@ -293,6 +462,14 @@ func (b BackendService) validate() error {
 			return fmt.Errorf(`validate load balancer target for "http.additional_rules[%d]": %w`, idx, err)
 		}
 	}
+	for _, rule := range b.HTTP.RoutingRules() {
+		if err = validateHTTPTargetProtocol(rule, b.Sidecars); err != nil {
+			return fmt.Errorf(`validate load balancer target for "http": %w`, err)
+		}
+		if err = validateDeregistrationDelay(rule, aws.StringValue(b.Name), b.ImageConfig.Image.StopTimeout); err != nil {
+			return fmt.Errorf(`validate "http": %w`, err)
+		}
+	}
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     b.Sidecars,
 		imageConfig:       b.ImageConfig.Image,
@@ -301,14 +478,21 @@ func (b BackendService) validate() error {
 	}); err != nil {
 		return fmt.Errorf("validate container dependencies: %w", err)
 	}
-	if err = validateExposedPorts(validateExposedPortsOpts{
+	portsOpts := validateExposedPortsOpts{
 		mainContainerName: aws.StringValue(b.Name),
 		mainContainerPort: b.ImageConfig.Port,
 		sidecarConfig:     b.Sidecars,
 		alb:               &b.HTTP,
-	}); err != nil {
+	}
+	if err = validateExposedPorts(portsOpts); err != nil {
 		return fmt.Errorf("validate unique exposed ports: %w", err)
 	}
+	for _, warning := range healthCheckPortWarnings(portsOpts) {
+		log.Warningln(warning)
+	}
+	if err = validateServiceConnectPort(b.Network.Connect, portsOpts); err != nil {
+		return fmt.Errorf(`validate "network.connect": %w`, err)
+	}
 	return nil
 }
 
@@ -321,6 +505,15 @@ func (b BackendServiceConfig) validate() error {
 	if err = b.ImageOverride.validate(); err != nil {
 		return err
 	}
+	if !b.HTTP.AliasHealthCheck.IsZero() {
+		return errors.New(`"http.alias_health_check" is not supported for Backend Service`)
+	}
+	if !b.HTTP.Mirror.IsEmpty() {
+		return errors.New(`"http.mirror" is not supported for Backend Service`)
+	}
+	if !b.HTTP.OpenAPI.IsEmpty() {
+		return errors.New(`"http.openapi" is not supported for Backend Service`)
+	}
 	if err = b.HTTP.validate(); err != nil {
 		return fmt.Errorf(`validate "http": %w`, err)
 	}
@@ -330,6 +523,18 @@ func (b BackendServiceConfig) validate() error {
 			conditionalFields: []string{"count.requests", "count.response_time"},
 		}
 	}
+	if b.HTTP.IsEmpty() && !b.Count.AdvancedCount.Connections.IsEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField:      "http",
+			conditionalFields: []string{"count.connections"},
+		}
+	}
+	if b.HTTP.IsEmpty() && len(b.Observability.AnomalyDetection) != 0 {
+		return &errFieldMustBeSpecified{
+			missingField:      "http",
+			conditionalFields: []string{"observability.anomaly_detection"},
+		}
+	}
 	if err = b.TaskConfig.validate(); err != nil {
 		return err
 	}
@@ -352,6 +557,9 @@ func (b BackendServiceConfig) validate() error {
 	if err = b.PublishConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if err = b.Observability.validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
 	for ind, taskDefOverride := range b.TaskDefOverrides {
 		if err = taskDefOverride.validate(); err != nil {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
@@ -373,6 +581,9 @@ func (b BackendServiceConfig) validate() error {
 			return fmt.Errorf("validate ARM: %w", err)
 		}
 	}
+	if err = b.Stack.validate(); err != nil {
+		return fmt.Errorf(`validate "stack": %w`, err)
+	}
 	return nil
 }
 
@@ -387,12 +598,27 @@ func (r RequestDrivenWebService) validate() error {
 // validate returns nil if RequestDrivenWebServiceConfig is configured correctly.
 func (r RequestDrivenWebServiceConfig) validate() error {
 	var err error
-	if err = r.ImageConfig.validate(); err != nil {
-		return fmt.Errorf(`validate "image": %w`, err)
+	if r.Source.IsEmpty() {
+		if err = r.ImageConfig.validate(); err != nil {
+			return fmt.Errorf(`validate "image": %w`, err)
+		}
+	} else {
+		if !r.ImageConfig.Image.Build.isEmpty() || r.ImageConfig.Image.Location != nil || r.ImageConfig.Port != nil {
+			return &errFieldMutualExclusive{
+				firstField:  "image",
+				secondField: "source",
+			}
+		}
+		if err = r.Source.validate(); err != nil {
+			return fmt.Errorf(`validate "source": %w`, err)
+		}
 	}
 	if err = r.InstanceConfig.validate(); err != nil {
 		return err
 	}
+	if err = validateVariables(r.Variables, r.Secrets); err != nil {
+		return err
+	}
 	if err = r.RequestDrivenWebServiceHttpConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "http": %w`, err)
 	}
@@ -407,9 +633,21 @@ func (r RequestDrivenWebServiceConfig) validate() error {
 		return fmt.Errorf(`placement %q is not supported for %s`,
 			*r.Network.VPC.Placement.PlacementString, manifestinfo.RequestDrivenWebServiceType)
 	}
-	if err = r.Observability.validate(); err != nil {
+	if err = r.Observability.validateAppRunner(); err != nil {
 		return fmt.Errorf(`validate "observability": %w`, err)
 	}
+	if r.Count != nil && !r.Scaling.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "count",
+			secondField: "scaling",
+		}
+	}
+	if err = r.Scaling.validate(); err != nil {
+		return fmt.Errorf(`validate "scaling": %w`, err)
+	}
+	if err = r.Stack.validate(); err != nil {
+		return fmt.Errorf(`validate "stack": %w`, err)
+	}
 	return nil
 }
 
@@ -473,6 +711,12 @@ func (w WorkerServiceConfig) validate() error {
 	if err = w.PublishConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if len(w.Observability.AnomalyDetection) != 0 {
+		return fmt.Errorf(`validate "observability": "anomaly_detection" is not supported for %s`, manifestinfo.WorkerServiceType)
+	}
+	if err = w.Observability.validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
 	for ind, taskDefOverride := range w.TaskDefOverrides {
 		if err = taskDefOverride.validate(); err != nil {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
@@ -494,6 +738,9 @@ func (w WorkerServiceConfig) validate() error {
 			return fmt.Errorf("validate ARM: %w", err)
 		}
 	}
+	if err = w.Stack.validate(); err != nil {
+		return fmt.Errorf(`validate "stack": %w`, err)
+	}
 	return nil
 }
 
@@ -534,6 +781,9 @@ func (s ScheduledJobConfig) validate() error {
 	if err = s.TaskConfig.validate(); err != nil {
 		return err
 	}
+	if !s.ScaleInProtection.IsZero() {
+		return errors.New(`"scale_in_protection" is not supported for Scheduled Job`)
+	}
 	if err = s.Logging.validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
@@ -575,6 +825,9 @@ func (s ScheduledJobConfig) validate() error {
 			return fmt.Errorf("validate ARM: %w", err)
 		}
 	}
+	if err = s.Stack.validate(); err != nil {
+		return fmt.Errorf(`validate "stack": %w`, err)
+	}
 	return nil
 }
 
@@ -587,11 +840,19 @@ func (s StaticSite) validate() error {
 }
 
 func (s StaticSiteConfig) validate() error {
+	if s.HTTP.Alias != "" {
+		if _, err := AliasToASCII(s.HTTP.Alias); err != nil {
+			return fmt.Errorf(`validate "http.alias": %w`, err)
+		}
+	}
 	for idx, fileupload := range s.FileUploads {
 		if err := fileupload.validate(); err != nil {
 			return fmt.Errorf(`validate "files[%d]": %w`, idx, err)
 		}
 	}
+	if err := s.Stack.validate(); err != nil {
+		return fmt.Errorf(`validate "stack": %w`, err)
+	}
 	return nil
 }
 
@@ -614,14 +875,52 @@ func (p Pipeline) Validate() error {
 	if len(p.Name) > 100 {
 		return fmt.Errorf(`pipeline name '%s' must be shorter than 100 characters`, p.Name)
 	}
-	for _, stg := range p.Stages {
+	for i, stg := range p.Stages {
 		if err := stg.validate(); err != nil {
 			return fmt.Errorf(`validate stage %q for pipeline %q: %w`, stg.Name, p.Name, err)
 		}
+		if i == 0 && stg.Promotion != nil && (stg.Promotion.SoakTime != nil || len(stg.Promotion.Alarms) != 0) {
+			return fmt.Errorf(`validate stage %q for pipeline %q: "promotion.soak_time" and "promotion.alarms" cannot be specified in the first stage`, stg.Name, p.Name)
+		}
 		if err := stg.Deployments.validate(); err != nil {
 			return fmt.Errorf(`validate "deployments" for pipeline stage %s: %w`, stg.Name, err)
 		}
 	}
+	if p.Artifacts != nil {
+		if err := p.Artifacts.validate(); err != nil {
+			return fmt.Errorf(`validate "artifacts": %w`, err)
+		}
+	}
+	if p.Filters != nil {
+		if err := p.Filters.validate(); err != nil {
+			return fmt.Errorf(`validate "filters": %w`, err)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if PipelineFilters is configured correctly.
+func (f PipelineFilters) validate() error {
+	if len(f.Workloads) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "workloads",
+		}
+	}
+	seen := make(map[string]bool)
+	for _, workload := range f.Workloads {
+		if seen[workload] {
+			return fmt.Errorf(`workload %q is listed more than once in "workloads"`, workload)
+		}
+		seen[workload] = true
+	}
+	return nil
+}
+
+// validate returns nil if PipelineArtifacts is configured correctly.
+func (a PipelineArtifacts) validate() error {
+	if a.Retention != nil && aws.IntValue(a.Retention) < 1 {
+		return errors.New(`"retention" must be at least 1 day`)
+	}
 	return nil
 }
 
@@ -654,6 +953,42 @@ func (s PipelineStage) validate() error {
 		}
 
 	}
+	if s.Promotion != nil {
+		if s.RequiresApproval && s.Promotion.Approvals != nil {
+			return &errFieldMutualExclusive{
+				firstField:  "requires_approval",
+				secondField: "promotion.approvals",
+			}
+		}
+		if err := s.Promotion.validate(); err != nil {
+			return fmt.Errorf(`validate "promotion": %w`, err)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if PromotionPolicy is configured correctly.
+func (p PromotionPolicy) validate() error {
+	if p.Approvals != nil {
+		if approvals := aws.IntValue(p.Approvals); approvals < 1 || approvals > maxPromotionApprovals {
+			return fmt.Errorf(`"approvals" must be between 1 and %d`, maxPromotionApprovals)
+		}
+	}
+	if p.SoakTime != nil {
+		if soak := *p.SoakTime; soak < time.Minute || soak > maxPromotionSoakTime {
+			return fmt.Errorf(`"soak_time" must be between 1m and %s`, maxPromotionSoakTime)
+		}
+	}
+	seen := make(map[string]bool)
+	for _, alarm := range p.Alarms {
+		if alarm == "" {
+			return errors.New(`"alarms" cannot contain empty names`)
+		}
+		if seen[alarm] {
+			return fmt.Errorf(`alarm %q is listed more than once in "alarms"`, alarm)
+		}
+		seen[alarm] = true
+	}
 	return nil
 }
 
@@ -749,6 +1084,9 @@ func (i Image) validate() error {
 	if err = i.DependsOn.validate(); err != nil {
 		return fmt.Errorf(`validate "depends_on": %w`, err)
 	}
+	if timeout := i.StopTimeout; timeout != nil && (*timeout < time.Second || *timeout > maxStopTimeout) {
+		return fmt.Errorf(`"stop_timeout" %s must be between 1s and %s`, *timeout, maxStopTimeout)
+	}
 	return nil
 }
 
@@ -785,7 +1123,106 @@ func (b BuildArgsOrString) validate() error {
 }
 
 // validate returns nil if DockerBuildArgs is configured correctly.
-func (DockerBuildArgs) validate() error {
+func (b DockerBuildArgs) validate() error {
+	for name, arg := range b.Args {
+		if err := arg.validate(); err != nil {
+			return fmt.Errorf(`validate "args[%s]": %w`, name, err)
+		}
+	}
+	for id, secret := range b.Secrets {
+		if err := secret.validate(); err != nil {
+			return fmt.Errorf(`validate "secrets[%s]": %w`, id, err)
+		}
+	}
+	if b.Buildpack.isEmpty() {
+		return nil
+	}
+	if b.Dockerfile != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "dockerfile",
+			secondField: "buildpack",
+		}
+	}
+	if b.Target != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "target",
+			secondField: "buildpack",
+		}
+	}
+	if b.CacheFrom != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "cache_from",
+			secondField: "buildpack",
+		}
+	}
+	if b.Secrets != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "secrets",
+			secondField: "buildpack",
+		}
+	}
+	if b.SSH != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "ssh",
+			secondField: "buildpack",
+		}
+	}
+	if b.Network != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "network",
+			secondField: "buildpack",
+		}
+	}
+	if err := b.Buildpack.validate(); err != nil {
+		return fmt.Errorf(`validate "buildpack": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if BuildArg is configured correctly.
+func (a BuildArg) validate() error {
+	if a.FromEnv != nil && a.FromSSM != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "from_env",
+			secondField: "from_ssm",
+		}
+	}
+	return nil
+}
+
+// validate returns nil if BuildSecret is configured correctly.
+func (s BuildSecret) validate() error {
+	var sources []string
+	if s.FromEnv != nil {
+		sources = append(sources, "from_env")
+	}
+	if s.FromFile != nil {
+		sources = append(sources, "from_file")
+	}
+	if s.FromSSM != nil {
+		sources = append(sources, "from_ssm")
+	}
+	switch len(sources) {
+	case 0:
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"from_env", "from_file", "from_ssm"},
+		}
+	case 1:
+		return nil
+	}
+	return &errFieldMutualExclusive{
+		firstField:  sources[0],
+		secondField: sources[1],
+	}
+}
+
+// validate returns nil if BuildpackArgs is configured correctly.
+func (b BuildpackArgs) validate() error {
+	if b.Builder == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "builder",
+		}
+	}
 	return nil
 }
 
@@ -837,6 +1274,95 @@ func (r HTTP) validate() error {
 			return fmt.Errorf(`validate "additional_rules[%d]": %w`, idx, err)
 		}
 	}
+	if err := r.AliasHealthCheck.validate(); err != nil {
+		return fmt.Errorf(`validate "alias_health_check": %w`, err)
+	}
+	if r.AliasHealthCheckEnabled() && !r.hasAlias() {
+		return &errFieldMustBeSpecified{
+			missingField:      "alias",
+			conditionalFields: []string{"alias_health_check"},
+		}
+	}
+	if err := r.Mirror.validate(); err != nil {
+		return fmt.Errorf(`validate "mirror": %w`, err)
+	}
+	if err := r.OpenAPI.validate(); err != nil {
+		return fmt.Errorf(`validate "openapi": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if OpenAPI is configured correctly.
+func (o OpenAPI) validate() error {
+	if o.IsEmpty() {
+		return nil
+	}
+	if o.Spec == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "spec",
+			conditionalFields: []string{"block_undefined_paths"},
+		}
+	}
+	return nil
+}
+
+// validate returns nil if TrafficMirror is configured correctly.
+func (m TrafficMirror) validate() error {
+	if m.IsEmpty() {
+		return nil
+	}
+	if m.Service == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "service",
+			conditionalFields: []string{"port", "percent"},
+		}
+	}
+	if p := m.Percent; p != nil && (*p < minTrafficMirrorPercent || *p > maxTrafficMirrorPercent) {
+		return fmt.Errorf(`"percent" %d must be between %d and %d`, *p, minTrafficMirrorPercent, maxTrafficMirrorPercent)
+	}
+	return nil
+}
+
+// validateTrafficMirror returns nil if the requests of the service can be mirrored to the service of the "mirror" field.
+func validateTrafficMirror(http HTTP, svcName string, sidecars map[string]*SidecarConfig) error {
+	if http.Mirror.IsEmpty() {
+		return nil
+	}
+	if aws.StringValue(http.Mirror.Service) == svcName {
+		return fmt.Errorf(`"service" must be a different service than %s`, svcName)
+	}
+	if _, ok := sidecars[template.TrafficMirrorContainerName]; ok {
+		return fmt.Errorf(`sidecar name %q is reserved for the traffic mirror`, template.TrafficMirrorContainerName)
+	}
+	if version := aws.StringValue(http.Main.ProtocolVersion); version != "" && !strings.EqualFold(version, "http1") {
+		return fmt.Errorf(`mirroring requests of protocol version %s is not supported`, version)
+	}
+	return nil
+}
+
+func (r HTTP) hasAlias() bool {
+	for _, rule := range r.RoutingRules() {
+		if !rule.Alias.IsEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+// validate returns nil if AliasHealthCheckArgs is configured correctly.
+func (a AliasHealthCheckArgs) validate() error {
+	if a.Path != nil {
+		if err := validateHealthCheckPath(aws.StringValue(a.Path)); err != nil {
+			return err
+		}
+	}
+	if t := a.FailureThreshold; t != nil && (*t < minAliasHealthCheckFailureThreshold || *t > maxAliasHealthCheckFailureThreshold) {
+		return fmt.Errorf(`"failure_threshold" %d must be between %d and %d`, *t, minAliasHealthCheckFailureThreshold, maxAliasHealthCheckFailureThreshold)
+	}
+	// Route 53 sends a health check request from each of its checkers either every 10 seconds or every 30 seconds.
+	if i := a.Interval; i != nil && *i != 10*time.Second && *i != 30*time.Second {
+		return fmt.Errorf(`"interval" %s must be 10s or 30s`, *i)
+	}
 	return nil
 }
 
@@ -849,6 +1375,27 @@ func (r HTTPOrBool) validate() error {
 	return r.HTTP.validate()
 }
 
+// validateDeploymentStrategy returns nil if the service can be deployed by CodeDeploy,
+// which shifts the traffic of a single load balancer listener between two target groups.
+func (l LoadBalancedWebServiceConfig) validateDeploymentStrategy() error {
+	if l.DeployConfig.Strategy.IsEmpty() {
+		return nil
+	}
+	if l.HTTPOrBool.Disabled() {
+		return errors.New(`"http" must be enabled`)
+	}
+	if len(l.HTTPOrBool.AdditionalRoutingRules) != 0 {
+		return errors.New(`"http.additional_rules" are not supported`)
+	}
+	if !l.NLBConfig.IsEmpty() {
+		return errors.New(`"nlb" is not supported`)
+	}
+	if l.Network.Connect.Enabled() {
+		return errors.New(`"network.connect" is not supported`)
+	}
+	return nil
+}
+
 func (l LoadBalancedWebServiceConfig) validateGracePeriod() error {
 	gracePeriodForALB, err := l.validateGracePeriodForALB()
 	if err != nil {
@@ -931,15 +1478,35 @@ func (r RoutingRule) validate() error {
 			conditionalFields: []string{"hosted_zone"},
 		}
 	}
+	if delay := r.DeregistrationDelay; delay != nil && (*delay < 0 || *delay > maxDeregistrationDelay) {
+		return fmt.Errorf(`"deregistration_delay" %s must be between 0s and %s`, *delay, maxDeregistrationDelay)
+	}
+	// A slow start of zero disables it.
+	if slowStart := r.SlowStart; slowStart != nil && *slowStart != 0 && (*slowStart < minSlowStart || *slowStart > maxSlowStart) {
+		return fmt.Errorf(`"slow_start" %s must be 0s to disable it, or between %s and %s`, *slowStart, minSlowStart, maxSlowStart)
+	}
 	if err := r.validateConditionValuesPerRule(); err != nil {
 		return fmt.Errorf("validate condition values per listener rule: %w", err)
 	}
 	return nil
 }
 
+// validate returns nil if HealthCheckArgsOrString is configured correctly.
+func (hc HealthCheckArgsOrString) validate() error {
+	if hc.IsBasic() {
+		return validateHealthCheckPath(hc.Basic)
+	}
+	return hc.Union.validate()
+}
+
 // validate returns nil if HTTPHealthCheckArgs is configured correctly.
 func (h HTTPHealthCheckArgs) validate() error {
-	return nil
+	if h.Path != nil {
+		if err := validateHealthCheckPath(aws.StringValue(h.Path)); err != nil {
+			return err
+		}
+	}
+	return validateHealthCheckPort(h.Port)
 }
 
 // validate returns nil if NLBHealthCheckArgs is configured correctly.
@@ -947,6 +1514,45 @@ func (h NLBHealthCheckArgs) validate() error {
 	if h.isEmpty() {
 		return nil
 	}
+	if h.Protocol != nil {
+		var isValidProtocol bool
+		for _, valid := range nlbValidHealthCheckProtocols {
+			if strings.EqualFold(aws.StringValue(h.Protocol), valid) {
+				isValidProtocol = true
+				break
+			}
+		}
+		if !isValidProtocol {
+			return fmt.Errorf(`invalid protocol %s; valid protocols include %s`, aws.StringValue(h.Protocol), english.WordSeries(nlbValidHealthCheckProtocols, "and"))
+		}
+	}
+	if h.Path != nil {
+		if h.Protocol == nil || strings.EqualFold(aws.StringValue(h.Protocol), TCP) {
+			return fmt.Errorf(`"path" can only be specified with "protocol" HTTP or HTTPS`)
+		}
+		if err := validateHealthCheckPath(aws.StringValue(h.Path)); err != nil {
+			return err
+		}
+	}
+	return validateHealthCheckPort(h.Port)
+}
+
+// validateHealthCheckPath returns nil if the load balancer accepts the path of the health check.
+func validateHealthCheckPath(path string) error {
+	if !strings.HasPrefix(path, "/") {
+		return fmt.Errorf(`path %q must start with "/"`, path)
+	}
+	return nil
+}
+
+// validateHealthCheckPort returns nil if the port of the health check is a valid port number.
+func validateHealthCheckPort(port *int) error {
+	if port == nil {
+		return nil
+	}
+	if p := aws.IntValue(port); p < 1 || p > math.MaxUint16 {
+		return fmt.Errorf(`port %d must be between 1 and %d`, p, math.MaxUint16)
+	}
 	return nil
 }
 
@@ -963,7 +1569,8 @@ func (a Alias) validate() error {
 			return err
 		}
 	}
-	return nil
+	_, err := a.ToStringSlice()
+	return err
 }
 
 // validate returns nil if AdvancedAlias is configured correctly.
@@ -1067,14 +1674,15 @@ func (t TaskConfig) validate() error {
 	if err = t.Storage.validate(); err != nil {
 		return fmt.Errorf(`validate "storage": %w`, err)
 	}
-	for n, v := range t.Variables {
-		if err := v.validate(); err != nil {
-			return fmt.Errorf(`validate %q "variables": %w`, n, err)
-		}
+	if err = t.ScaleInProtection.validate(); err != nil {
+		return fmt.Errorf(`validate "scale_in_protection": %w`, err)
 	}
-	for _, v := range t.Secrets {
+	if err = validateVariables(t.Variables, t.Secrets); err != nil {
+		return err
+	}
+	for n, v := range t.Secrets {
 		if err := v.validate(); err != nil {
-			return fmt.Errorf(`validate "secret": %w`, err)
+			return fmt.Errorf(`validate %q "secrets": %w`, n, err)
 		}
 	}
 	if t.EnvFile != nil {
@@ -1123,6 +1731,9 @@ func (p PlatformArgs) validate() error {
 
 // validate returns nil if PlatformString is configured correctly.
 func (p PlatformString) validate() error {
+	if strings.ToLower(string(p)) == PlatformMultiArch {
+		return nil
+	}
 	args := strings.Split(string(p), "/")
 	if len(args) != 2 {
 		return fmt.Errorf("platform '%s' must be in the format [OS]/[Arch]", string(p))
@@ -1181,26 +1792,108 @@ func (a AdvancedCount) validate() error {
 			conditionalField: "range",
 		}
 	}
-
-	// validate combinations with cooldown
-	if !a.Cooldown.IsEmpty() && !a.hasScalingFieldsSet() {
-		return &errAtLeastOneFieldMustBeSpecified{
-			missingFields:    a.validScalingFields(),
-			conditionalField: "cooldown",
+
+	// validate combinations with cooldown
+	if !a.Cooldown.IsEmpty() && !a.hasScalingFieldsSet() {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields:    a.validScalingFields(),
+			conditionalField: "cooldown",
+		}
+	}
+
+	// validate individual custom autoscaling options.
+	if err := a.QueueScaling.validate(); err != nil {
+		return fmt.Errorf(`validate "queue_delay": %w`, err)
+	}
+	if err := a.CPU.validate(); err != nil {
+		return fmt.Errorf(`validate "cpu_percentage": %w`, err)
+	}
+	if err := a.Memory.validate(); err != nil {
+		return fmt.Errorf(`validate "memory_percentage": %w`, err)
+	}
+	for idx, metric := range a.CustomMetrics {
+		if err := metric.validate(); err != nil {
+			return fmt.Errorf(`validate "custom_metrics[%d]": %w`, idx, err)
+		}
+	}
+	for idx, schedule := range a.Schedules {
+		if err := schedule.validate(); err != nil {
+			return fmt.Errorf(`validate "schedules[%d]": %w`, idx, err)
+		}
+	}
+
+	return nil
+}
+
+// validate returns nil if CustomMetricScaling is configured correctly.
+func (c CustomMetricScaling) validate() error {
+	if aws.StringValue(c.Namespace) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "namespace",
+		}
+	}
+	if aws.StringValue(c.Name) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if c.Value == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "value",
 		}
 	}
+	if c.Statistic != nil && !contains(aws.StringValue(c.Statistic), customMetricStatistics) {
+		return fmt.Errorf(`invalid statistic %q: must be one of %s`, aws.StringValue(c.Statistic), english.WordSeries(customMetricStatistics, "or"))
+	}
+	return c.Cooldown.validate()
+}
 
-	// validate individual custom autoscaling options.
-	if err := a.QueueScaling.validate(); err != nil {
-		return fmt.Errorf(`validate "queue_delay": %w`, err)
+// validate returns nil if ScheduledScaling is configured correctly.
+func (s ScheduledScaling) validate() error {
+	if s.Schedule != nil && !s.Calendar.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "schedule",
+			secondField: "calendar",
+		}
 	}
-	if err := a.CPU.validate(); err != nil {
-		return fmt.Errorf(`validate "cpu_percentage": %w`, err)
+	if !s.Calendar.IsEmpty() {
+		if err := s.Calendar.validate(); err != nil {
+			return fmt.Errorf(`validate "calendar": %w`, err)
+		}
+	} else if aws.StringValue(s.Schedule) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "schedule",
+		}
 	}
-	if err := a.Memory.validate(); err != nil {
-		return fmt.Errorf(`validate "memory_percentage": %w`, err)
+	if s.Range.IsEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField: "range",
+		}
 	}
+	if s.Range.RangeConfig.SpotFrom != nil {
+		return errors.New(`"spot_from" cannot be specified in the range of a schedule`)
+	}
+	if err := s.Range.validate(); err != nil {
+		return fmt.Errorf(`validate "range": %w`, err)
+	}
+	return nil
+}
 
+// validate returns nil if ScalingCalendar is configured correctly.
+func (c ScalingCalendar) validate() error {
+	if c.File != nil && c.SSMDocument != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "file",
+			secondField: "ssm_document",
+		}
+	}
+	if aws.StringValue(c.File) == "" && aws.StringValue(c.SSMDocument) == "" {
+		return &errFieldMutualExclusive{
+			firstField:  "file",
+			secondField: "ssm_document",
+			mustExist:   true,
+		}
+	}
 	return nil
 }
 
@@ -1350,6 +2043,17 @@ func (r RangeConfig) validate() error {
 	}
 }
 
+// validate returns nil if ScaleInProtectionArgs is configured correctly.
+func (a ScaleInProtectionArgs) validate() error {
+	if a.ExpiresIn == nil {
+		return nil
+	}
+	if d := *a.ExpiresIn; d < minScaleInProtectionExpiresIn || d > maxScaleInProtectionExpiresIn || d%time.Minute != 0 {
+		return fmt.Errorf(`"expires_in" %s must be a whole number of minutes between %s and %s`, d, minScaleInProtectionExpiresIn, maxScaleInProtectionExpiresIn)
+	}
+	return nil
+}
+
 // validate returns nil if ExecuteCommand is configured correctly.
 func (e ExecuteCommand) validate() error {
 	if !e.Config.IsEmpty() {
@@ -1490,6 +2194,9 @@ func (s SidecarConfig) validate() error {
 	if err := s.validateImage(); err != nil {
 		return err
 	}
+	if _, ok := s.Variables[VariablesBuildOnly]; ok {
+		return fmt.Errorf(`"variables.%s" is only supported for the main container`, VariablesBuildOnly)
+	}
 	for ind, mp := range s.MountPoints {
 		if err := mp.validate(); err != nil {
 			return fmt.Errorf(`validate "mount_points[%d]": %w`, ind, err)
@@ -1518,6 +2225,11 @@ func (s SidecarConfig) validate() error {
 	if err := s.DependsOn.validate(); err != nil {
 		return fmt.Errorf(`validate "depends_on": %w`, err)
 	}
+	for n, v := range s.Secrets {
+		if err := v.validate(); err != nil {
+			return fmt.Errorf(`validate %q "secrets": %w`, n, err)
+		}
+	}
 	if s.EnvFile != nil {
 		envFile := aws.StringValue(s.EnvFile)
 		if filepath.Ext(envFile) != envFileExt {
@@ -1687,6 +2399,49 @@ func (r AppRunnerInstanceConfig) validate() error {
 	return nil
 }
 
+// validate returns nil if AppRunnerScalingConfig is configured correctly.
+func (c AppRunnerScalingConfig) validate() error {
+	if v := c.MaxConcurrency; v != nil && (*v < 1 || *v > maxAppRunnerConcurrency) {
+		return fmt.Errorf(`"max_concurrency" %d must be between 1 and %d`, *v, maxAppRunnerConcurrency)
+	}
+	if v := c.MinInstances; v != nil && (*v < 1 || *v > maxAppRunnerInstances) {
+		return fmt.Errorf(`"min_instances" %d must be between 1 and %d`, *v, maxAppRunnerInstances)
+	}
+	if v := c.MaxInstances; v != nil && (*v < 1 || *v > maxAppRunnerInstances) {
+		return fmt.Errorf(`"max_instances" %d must be between 1 and %d`, *v, maxAppRunnerInstances)
+	}
+	if c.MinInstances != nil && c.MaxInstances != nil && *c.MinInstances > *c.MaxInstances {
+		return fmt.Errorf(`"min_instances" %d must be less than or equal to "max_instances" %d`, *c.MinInstances, *c.MaxInstances)
+	}
+	return nil
+}
+
+// validate returns nil if AppRunnerSource is configured correctly.
+func (s AppRunnerSource) validate() error {
+	if s.Connection == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "connection",
+		}
+	}
+	if s.Port == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "port",
+		}
+	}
+	if s.Runtime == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "runtime",
+		}
+	}
+	if !contains(aws.StringValue(s.Runtime), appRunnerSourceRuntimes) {
+		return fmt.Errorf(`"runtime" field value '%s' must be one of %s`, aws.StringValue(s.Runtime), english.WordSeries(appRunnerSourceRuntimes, "or"))
+	}
+	if s.Directory != nil && filepath.IsAbs(aws.StringValue(s.Directory)) {
+		return fmt.Errorf(`"directory" field value '%s' must be relative to the root of the repository`, aws.StringValue(s.Directory))
+	}
+	return nil
+}
+
 // validate returns nil if RequestDrivenWebServiceHttpConfig is configured correctly.
 func (r RequestDrivenWebServiceHttpConfig) validate() error {
 	if err := r.HealthCheckConfiguration.validate(); err != nil {
@@ -1699,20 +2454,85 @@ func (v VPCEndpoint) validate() error {
 	return nil
 }
 
-// validate returns nil if Observability is configured correctly.
+// validate returns nil if StackSettings is configured correctly.
+func (s StackSettings) validate() error {
+	if s.Policy == nil {
+		return nil
+	}
+	statements, ok := s.Policy["Statement"].([]interface{})
+	if !ok || len(statements) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "policy.Statement",
+		}
+	}
+	if _, err := s.StackPolicy(); err != nil {
+		return fmt.Errorf(`validate "policy": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if Observability is configured correctly for an ECS service.
 func (o Observability) validate() error {
 	if o.isEmpty() {
 		return nil
 	}
-	for _, validVendor := range tracingValidVendors {
-		if strings.EqualFold(aws.StringValue(o.Tracing), validVendor) {
+	if err := o.validateAnomalyDetection(); err != nil {
+		return err
+	}
+	if o.Tracing == nil && o.Endpoint == nil {
+		return nil
+	}
+	if err := validateTracingVendor(aws.StringValue(o.Tracing), tracingValidVendors); err != nil {
+		return err
+	}
+	isOTLP := strings.EqualFold(aws.StringValue(o.Tracing), otlp)
+	if isOTLP && aws.StringValue(o.Endpoint) == "" {
+		return fmt.Errorf(`"endpoint" must be specified with the tracing vendor %q`, otlp)
+	}
+	if !isOTLP && o.Endpoint != nil {
+		return fmt.Errorf(`"endpoint" can only be specified with the tracing vendor %q`, otlp)
+	}
+	return nil
+}
+
+func (o Observability) validateAnomalyDetection() error {
+	seen := make(map[string]bool)
+	for _, metric := range o.AnomalyDetection {
+		if !contains(metric, anomalyDetectionValidMetrics) {
+			return fmt.Errorf(`invalid "anomaly_detection" metric %q: valid metrics are %s`, metric, english.WordSeries(anomalyDetectionValidMetrics, "and"))
+		}
+		if seen[metric] {
+			return fmt.Errorf(`"anomaly_detection" metric %q is specified more than once`, metric)
+		}
+		seen[metric] = true
+	}
+	return nil
+}
+
+// validateAppRunner returns nil if Observability is configured correctly for an App Runner service.
+func (o Observability) validateAppRunner() error {
+	if o.isEmpty() {
+		return nil
+	}
+	if o.Endpoint != nil {
+		return fmt.Errorf(`"endpoint" is not supported for %s`, manifestinfo.RequestDrivenWebServiceType)
+	}
+	if len(o.AnomalyDetection) != 0 {
+		return fmt.Errorf(`"anomaly_detection" is not supported for %s`, manifestinfo.RequestDrivenWebServiceType)
+	}
+	return validateTracingVendor(aws.StringValue(o.Tracing), appRunnerTracingValidVendors)
+}
+
+func validateTracingVendor(vendor string, validVendors []string) error {
+	for _, validVendor := range validVendors {
+		if strings.EqualFold(vendor, validVendor) {
 			return nil
 		}
 	}
 	return fmt.Errorf("invalid tracing vendor %s: %s %s",
-		aws.StringValue(o.Tracing),
-		english.PluralWord(len(tracingValidVendors), "the valid vendor is", "valid vendors are"),
-		english.WordSeries(tracingValidVendors, "and"))
+		vendor,
+		english.PluralWord(len(validVendors), "the valid vendor is", "valid vendors are"),
+		english.WordSeries(validVendors, "and"))
 }
 
 // validate returns nil if JobTriggerConfig is configured correctly.
@@ -1774,6 +2594,72 @@ func (s SubscribeConfig) validate() error {
 	if err := s.Queue.validate(); err != nil {
 		return fmt.Errorf(`validate "queue": %w`, err)
 	}
+	if err := s.Consumer.validate(); err != nil {
+		return fmt.Errorf(`validate "consumer": %w`, err)
+	}
+	if s.Consumer.MessageGroupStrategy != nil && !s.Queue.FIFO.IsEnabled() {
+		return fmt.Errorf(`"consumer.message_group_strategy" requires "queue.fifo" to be enabled`)
+	}
+	if s.Consumer.ProcessingTimeout == nil {
+		return nil
+	}
+	visibilityTimeout := defaultSQSVisibilityTimeout
+	if s.Queue.Timeout != nil {
+		visibilityTimeout = *s.Queue.Timeout
+	}
+	if *s.Consumer.ProcessingTimeout >= visibilityTimeout {
+		// Otherwise, messages become visible again and are received by another task while they're still being processed.
+		return fmt.Errorf(`"consumer.processing_timeout" %s must be less than "queue.timeout" %s, the visibility timeout of the messages`,
+			s.Consumer.ProcessingTimeout, visibilityTimeout)
+	}
+	return nil
+}
+
+// validate returns nil if SQSConsumer is configured correctly.
+func (c SQSConsumer) validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+	if v := c.MaxConcurrentMessages; v != nil && *v < 1 {
+		return fmt.Errorf(`"max_concurrent_messages" %d must be at least 1`, *v)
+	}
+	if v := c.BatchSize; v != nil && (*v < 1 || *v > maxSQSBatchSize) {
+		return fmt.Errorf(`"batch_size" %d must be between 1 and %d`, *v, maxSQSBatchSize)
+	}
+	if c.BatchSize != nil && c.MaxConcurrentMessages != nil && *c.BatchSize > *c.MaxConcurrentMessages {
+		return fmt.Errorf(`"batch_size" %d must not be greater than "max_concurrent_messages" %d`, *c.BatchSize, *c.MaxConcurrentMessages)
+	}
+	if t := c.ProcessingTimeout; t != nil && *t < time.Second {
+		return fmt.Errorf(`"processing_timeout" %s must be at least 1s`, *t)
+	}
+	if c.MessageGroupStrategy != nil {
+		strategy := aws.StringValue(c.MessageGroupStrategy)
+		if !contains(strategy, validMessageGroupStrategies) {
+			return fmt.Errorf(`"message_group_strategy" %q must be one of %s`, strategy, english.WordSeries(quoteStringSlice(validMessageGroupStrategies), "or"))
+		}
+		if v := aws.IntValue(c.MaxConcurrentMessages); strategy == messageGroupStrategySequential && v > 1 {
+			return fmt.Errorf(`"max_concurrent_messages" %d must be 1 when "message_group_strategy" is %q`, v, strategy)
+		}
+	}
+	if err := c.DeduplicationTable.validate(); err != nil {
+		return fmt.Errorf(`validate "deduplication_table": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if DeduplicationTableOrBool is configured correctly.
+func (t DeduplicationTableOrBool) validate() error {
+	if t.IsEmpty() {
+		return nil
+	}
+	return t.Advanced.validate()
+}
+
+// validate returns nil if DeduplicationTable is configured correctly.
+func (t DeduplicationTable) validate() error {
+	if ttl := t.TTL; ttl != nil && *ttl < minDeduplicationTableTTL {
+		return fmt.Errorf(`"ttl" %s must be at least %s`, *ttl, minDeduplicationTableTTL)
+	}
 	return nil
 }
 
@@ -1900,6 +2786,62 @@ func (r OverrideRule) validate() error {
 	return nil
 }
 
+// validateVariables returns nil if the environment variables of a container are configured correctly.
+func validateVariables(variables map[string]Variable, secrets map[string]Secret) error {
+	for n, v := range variables {
+		if n == VariablesFromSSMPath {
+			if err := v.validateSSMParameterPath(); err != nil {
+				return fmt.Errorf(`validate "variables.%s": %w`, n, err)
+			}
+			continue
+		}
+		if n == VariablesBuildOnly {
+			if err := v.validateBuildOnly(secrets); err != nil {
+				return fmt.Errorf(`validate "variables.%s": %w`, n, err)
+			}
+			continue
+		}
+		if v.BuildOnly != nil {
+			return fmt.Errorf(`validate %q "variables": only "%s" can be a map of build args`, n, VariablesBuildOnly)
+		}
+		if err := v.validate(); err != nil {
+			return fmt.Errorf(`validate %q "variables": %w`, n, err)
+		}
+	}
+	return nil
+}
+
+// validateBuildOnly returns nil if the variable is a map of build args that don't hold secrets.
+// Build args are stored in the history of the image, so secrets must be mounted with "image.build.secrets" instead.
+func (v Variable) validateBuildOnly(secrets map[string]Secret) error {
+	if v.BuildOnly == nil {
+		return errors.New("must be a map of build args")
+	}
+	for name, arg := range v.BuildOnly {
+		if err := arg.validate(); err != nil {
+			return fmt.Errorf(`validate %q: %w`, name, err)
+		}
+		if _, ok := secrets[name]; ok {
+			return fmt.Errorf(`%q is also a secret: build args are stored in the image history, use "image.build.secrets" instead`, name)
+		}
+		if arg.FromSSM != nil || secretARNRegexp.MatchString(aws.StringValue(arg.Plain)) {
+			return fmt.Errorf(`%q can't reference a secret: build args are stored in the image history, use "image.build.secrets" instead`, name)
+		}
+	}
+	return nil
+}
+
+// validateSSMParameterPath returns nil if the variable is the path of SSM parameters.
+func (v Variable) validateSSMParameterPath() error {
+	if v.RequiresImport() {
+		return errors.New("the path of SSM parameters cannot be imported from a CloudFormation stack")
+	}
+	if path := aws.StringValue(v.Plain); !strings.HasPrefix(path, "/") {
+		return fmt.Errorf("path %q must start with a forward slash", path)
+	}
+	return nil
+}
+
 // validate returns nil if Variable is configured correctly.
 func (v Variable) validate() error {
 	if err := v.FromCFN.validate(); err != nil {
@@ -1927,8 +2869,60 @@ func (cfg fromCFN) validate() error {
 	return nil
 }
 
-// validate is a no-op for Secrets.
+// validate returns nil if Secret is configured correctly.
 func (s Secret) validate() error {
+	if !s.fromSecretsManager.IsEmpty() {
+		return s.fromSecretsManager.validate()
+	}
+	if s.RequiresImport() {
+		return nil
+	}
+	parsed, err := arn.Parse(aws.StringValue(s.from.Plain))
+	if err != nil || parsed.Service != secretsManagerServiceName {
+		// SSM parameter names and ARNs do not reference JSON keys.
+		return nil
+	}
+	name, found := strings.CutPrefix(parsed.Resource, "secret:")
+	if !found {
+		return fmt.Errorf("secret ARN %s must reference a secret resource", aws.StringValue(s.from.Plain))
+	}
+	return validateSecretsManagerKeySpecifiers(name)
+}
+
+// validate returns nil if secretsManagerSecret is configured correctly.
+func (s secretsManagerSecret) validate() error {
+	if s.Name == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "secretsmanager",
+			conditionalFields: []string{"from_json_key"},
+		}
+	}
+	if s.FromJSONKey == nil {
+		return validateSecretsManagerKeySpecifiers(aws.StringValue(s.Name))
+	}
+	if strings.Contains(aws.StringValue(s.Name), ":") {
+		return errors.New(`"secretsmanager" cannot reference a JSON key if "from_json_key" is specified`)
+	}
+	key := aws.StringValue(s.FromJSONKey)
+	if key == "" || strings.Contains(key, ":") {
+		return errors.New(`"from_json_key" must be a non-empty string without colons`)
+	}
+	return nil
+}
+
+// validateSecretsManagerKeySpecifiers validates the optional "<json-key>:<version-stage>:<version-id>" suffix
+// that ECS accepts after the name of a SecretsManager secret.
+func validateSecretsManagerKeySpecifiers(name string) error {
+	specifiers := strings.Split(name, ":")
+	if len(specifiers) == 1 {
+		return nil
+	}
+	if len(specifiers) != 4 {
+		return fmt.Errorf("secret %s must be in the format <name>:<json-key>:<version-stage>:<version-id>, with empty values for unused specifiers", name)
+	}
+	if specifiers[2] != "" && specifiers[3] != "" {
+		return fmt.Errorf("secret %s cannot specify both a version stage and a version ID", name)
+	}
 	return nil
 }
 
@@ -1990,6 +2984,42 @@ func validateTargetContainer(opts validateTargetContainerOpts) error {
 	return nil
 }
 
+// validateDeregistrationDelay returns an error if the rule drains the connections to the main container for less time than
+// the container is given to stop, since the load balancer would drop the requests that it can still complete during deployments.
+func validateDeregistrationDelay(rule RoutingRule, mainContainerName string, stopTimeout *time.Duration) error {
+	if rule.DeregistrationDelay == nil || stopTimeout == nil {
+		return nil
+	}
+	if target := aws.StringValue(rule.TargetContainer); target != "" && target != mainContainerName {
+		return nil
+	}
+	if delay := *rule.DeregistrationDelay; delay < *stopTimeout {
+		return fmt.Errorf(`"deregistration_delay" %s must not be shorter than the "image.stop_timeout" %s of the target container`, delay, *stopTimeout)
+	}
+	return nil
+}
+
+// validateHTTPTargetProtocol returns an error if the load balancer routes the HTTP traffic of the rule
+// to a port that the target container exposes over UDP, since the targets would never become healthy.
+func validateHTTPTargetProtocol(rule RoutingRule, sidecarConfig map[string]*SidecarConfig) error {
+	targetContainer := aws.StringValue(rule.TargetContainer)
+	sidecar, ok := sidecarConfig[targetContainer]
+	if !ok || sidecar.Port == nil {
+		return nil
+	}
+	port, protocol, err := ParsePortMapping(sidecar.Port)
+	if err != nil {
+		return err
+	}
+	if rule.TargetPort != nil && strconv.Itoa(int(aws.Uint16Value(rule.TargetPort))) != aws.StringValue(port) {
+		return nil
+	}
+	if strings.EqualFold(aws.StringValue(protocol), udp) {
+		return fmt.Errorf("target container %q exposes port %s over UDP, which can't receive HTTP traffic", targetContainer, aws.StringValue(port))
+	}
+	return nil
+}
+
 func validateContainerDeps(opts validateDependenciesOpts) error {
 	containerDependencies := make(map[string]containerDependency)
 	containerDependencies[opts.mainContainerName] = containerDependency{
@@ -2040,6 +3070,85 @@ func validateExposedPorts(opts validateExposedPortsOpts) error {
 	return nil
 }
 
+// exposedContainerPorts returns the name of the container that exposes each port of the task.
+// It assumes that validateExposedPorts succeeds for opts.
+func exposedContainerPorts(opts validateExposedPortsOpts) map[uint16]string {
+	containerNameFor := make(map[uint16]string)
+	populateMainContainerPort(containerNameFor, opts)
+	_ = populateSidecarContainerPortsAndValidate(containerNameFor, opts)
+	_ = populateALBPortsAndValidate(containerNameFor, opts)
+	_ = populateNLBPortsAndValidate(containerNameFor, opts)
+	return containerNameFor
+}
+
+// healthCheckPortWarnings returns a warning for each load balancer health check on a port that no container of the task
+// exposes in the manifest. The targets would never become healthy unless a container listens on the port without exposing it,
+// which awsvpc tasks allow, so the port isn't rejected.
+func healthCheckPortWarnings(opts validateExposedPortsOpts) []string {
+	exposed := exposedContainerPorts(opts)
+	var warnings []string
+	warn := func(field string, port *int) {
+		if port == nil {
+			return
+		}
+		if _, ok := exposed[uint16(aws.IntValue(port))]; ok {
+			return
+		}
+		warnings = append(warnings, fmt.Sprintf(`%q checks the health of the targets on port %d, which no container exposes: make sure a container listens on it, or expose it with "image.port", "sidecars.port" or "target_port"`, field, aws.IntValue(port)))
+	}
+	if opts.alb != nil && !opts.alb.IsEmpty() {
+		warn("http.healthcheck", opts.alb.Main.HealthCheck.Advanced.Port)
+		for idx, rule := range opts.alb.AdditionalRoutingRules {
+			warn(fmt.Sprintf("http.additional_rules[%d].healthcheck", idx), rule.HealthCheck.Advanced.Port)
+		}
+	}
+	if opts.nlb != nil && !opts.nlb.IsEmpty() {
+		warn("nlb.healthcheck", opts.nlb.Listener.HealthCheck.Port)
+		for idx, listener := range opts.nlb.AdditionalListeners {
+			warn(fmt.Sprintf("nlb.additional_listeners[%d].healthcheck", idx), listener.HealthCheck.Port)
+		}
+	}
+	return warnings
+}
+
+// validateServiceConnectPort returns an error if Service Connect can't publish the service under its alias,
+// which requires the target container of "http", or else the main container, to expose the target port over TCP.
+func validateServiceConnectPort(connect ServiceConnectBoolOrArgs, opts validateExposedPortsOpts) error {
+	if connect.Alias == nil {
+		return nil
+	}
+	target, port := opts.mainContainerName, opts.mainContainerPort
+	if opts.alb != nil {
+		if targetContainer := aws.StringValue(opts.alb.Main.TargetContainer); targetContainer != "" && targetContainer != target {
+			target, port = targetContainer, nil
+		}
+		if opts.alb.Main.TargetPort != nil {
+			port = opts.alb.Main.TargetPort
+		}
+	}
+	var protocol string
+	if sidecar, ok := opts.sidecarConfig[target]; ok && sidecar.Port != nil {
+		sidecarPort, sidecarProtocol, err := ParsePortMapping(sidecar.Port)
+		if err != nil {
+			return err
+		}
+		parsed, err := strconv.ParseUint(aws.StringValue(sidecarPort), 10, 16)
+		if err != nil {
+			return err
+		}
+		if port == nil || aws.Uint16Value(port) == uint16(parsed) {
+			port, protocol = aws.Uint16(uint16(parsed)), aws.StringValue(sidecarProtocol)
+		}
+	}
+	if port == nil {
+		return fmt.Errorf(`"alias" requires target container %q to expose a port`, target)
+	}
+	if strings.EqualFold(protocol, udp) {
+		return fmt.Errorf(`"alias" requires target container %q to expose port %d over TCP, not UDP`, target, aws.Uint16Value(port))
+	}
+	return nil
+}
+
 func populateMainContainerPort(containerNameFor map[uint16]string, opts validateExposedPortsOpts) {
 	if opts.mainContainerPort == nil {
 		return
@@ -2276,6 +3385,59 @@ func (i ImageLocationOrBuild) validate() error {
 			mustExist:   true,
 		}
 	}
+	if aws.BoolValue(i.SOCI) && i.Build.isEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField:      "build",
+			conditionalFields: []string{"soci"},
+		}
+	}
+	if err := i.Sync.validate(); err != nil {
+		return fmt.Errorf(`validate "sync": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if ImageSync is configured correctly.
+func (s ImageSync) validate() error {
+	if s.isEmpty() {
+		return nil
+	}
+	if len(s.Paths) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "paths",
+		}
+	}
+	for idx, p := range s.Paths {
+		if err := p.validate(); err != nil {
+			return fmt.Errorf(`validate "paths[%d]": %w`, idx, err)
+		}
+	}
+	if s.Signal != nil && !signalRegexp.MatchString(aws.StringValue(s.Signal)) {
+		return fmt.Errorf(`"signal" %q must be the name of a signal, such as "SIGHUP", or its number`, aws.StringValue(s.Signal))
+	}
+	return nil
+}
+
+// validate returns nil if SyncPath is configured correctly.
+func (p SyncPath) validate() error {
+	if p.Source == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "source",
+		}
+	}
+	if p.Target == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "target",
+		}
+	}
+	if !path.IsAbs(aws.StringValue(p.Target)) {
+		return fmt.Errorf(`"target" %q must be an absolute path`, aws.StringValue(p.Target))
+	}
+	for _, pattern := range p.Exclude {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf(`"exclude" pattern %q is invalid: %w`, pattern, err)
+		}
+	}
 	return nil
 }
 
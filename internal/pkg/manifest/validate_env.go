@@ -6,16 +6,22 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudfront"
+	"github.com/dustin/go-humanize/english"
 )
 
 var (
 	errAZsNotEqual = errors.New("public subnets and private subnets do not span the same availability zones")
 
 	minAZs = 2
+
+	// The Lambda functions backing custom resources can run for up to 15 minutes.
+	minCustomResourceTimeout = time.Minute
+	maxCustomResourceTimeout = 15 * time.Minute
 )
 
 // Validate returns nil if Environment is configured correctly.
@@ -28,9 +34,15 @@ func (e Environment) Validate() error {
 
 // validate returns nil if EnvironmentConfig is configured correctly.
 func (e EnvironmentConfig) validate() error {
+	if e.Profile != nil && !contains(aws.StringValue(e.Profile), EnvProfiles) {
+		return fmt.Errorf(`"profile" %q must be one of %s`, aws.StringValue(e.Profile), english.WordSeries(EnvProfiles, "or"))
+	}
 	if err := e.Network.validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if err := e.Cluster.validate(); err != nil {
+		return fmt.Errorf(`validate "cluster": %w`, err)
+	}
 	if err := e.Observability.validate(); err != nil {
 		return fmt.Errorf(`validate "observability": %w`, err)
 	}
@@ -43,6 +55,23 @@ func (e EnvironmentConfig) validate() error {
 	if err := e.CDNConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "cdn": %w`, err)
 	}
+	if err := e.Stack.validate(); err != nil {
+		return fmt.Errorf(`validate "stack": %w`, err)
+	}
+	if err := e.CustomResources.validate(); err != nil {
+		return fmt.Errorf(`validate "custom_resources": %w`, err)
+	}
+	if !e.Cluster.IsEmpty() && aws.BoolValue(e.Observability.ContainerInsights) {
+		return errors.New(`"observability.container_insights" cannot be enabled when an existing cluster is imported with "cluster.id"`)
+	}
+	if e.HTTPConfig.Public.LoadBalancer != nil {
+		if !e.Network.VPC.imported() {
+			return errors.New(`"network.vpc.id" must be specified to import the VPC of the public load balancer "http.public.load_balancer"`)
+		}
+		if e.CDNEnabled() {
+			return errors.New(`"cdn" cannot be enabled when an existing public load balancer is imported with "http.public.load_balancer"`)
+		}
+	}
 	if e.IsPublicLBIngressRestrictedToCDN() && !e.CDNEnabled() {
 		return errors.New("CDN must be enabled to limit security group ingress to CloudFront")
 	}
@@ -277,11 +306,55 @@ func (fl VPCFlowLogsArgs) validate() error {
 	return nil
 }
 
+// validate returns nil if environmentClusterConfig is configured correctly.
+func (c environmentClusterConfig) validate() error {
+	if c.ID != nil && aws.StringValue(c.ID) == "" {
+		return errors.New(`"id" cannot be empty`)
+	}
+	if err := c.Exec.validate(); err != nil {
+		return fmt.Errorf(`validate "exec": %w`, err)
+	}
+	if c.ID != nil && !c.Exec.IsZero() {
+		return errors.New(`"exec" cannot be configured when an existing cluster is imported with "id"`)
+	}
+	return nil
+}
+
+// validate returns nil if ClusterExecConfig is configured correctly.
+func (c ClusterExecConfig) validate() error {
+	if err := c.Logs.validate(); err != nil {
+		return fmt.Errorf(`validate "logs": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if ClusterExecLogs is configured correctly.
+func (l ClusterExecLogs) validate() error {
+	if l.Retention != nil && aws.IntValue(l.Retention) < 1 {
+		return errors.New(`"retention" must be at least 1 day`)
+	}
+	return nil
+}
+
 // validate returns nil if environmentObservability is configured correctly.
 func (o environmentObservability) validate() error {
 	return nil
 }
 
+// validate returns nil if EnvironmentCustomResources is configured correctly.
+func (c EnvironmentCustomResources) validate() error {
+	if c.Timeout == nil {
+		return nil
+	}
+	if *c.Timeout%time.Second != 0 {
+		return fmt.Errorf(`"timeout" %s must be a whole number of seconds`, *c.Timeout)
+	}
+	if *c.Timeout < minCustomResourceTimeout || *c.Timeout > maxCustomResourceTimeout {
+		return fmt.Errorf(`"timeout" %s must be between %s and %s`, *c.Timeout, minCustomResourceTimeout, maxCustomResourceTimeout)
+	}
+	return nil
+}
+
 // validate returns nil if EnvironmentHTTPConfig is configured correctly.
 func (cfg EnvironmentHTTPConfig) validate() error {
 	if err := cfg.Public.validate(); err != nil {
@@ -315,9 +388,45 @@ func (cfg PublicHTTPConfig) validate() error {
 	if err := cfg.DeprecatedSG.validate(); err != nil {
 		return err
 	}
+	if err := validateALBIdleTimeout(cfg.IdleTimeout); err != nil {
+		return err
+	}
+	if err := cfg.validateImportedLoadBalancer(); err != nil {
+		return err
+	}
 	return cfg.Ingress.validate()
 }
 
+// validateImportedLoadBalancer returns nil if none of the fields that configure a Copilot-managed
+// public load balancer are set alongside an imported load balancer.
+func (cfg PublicHTTPConfig) validateImportedLoadBalancer() error {
+	if cfg.LoadBalancer == nil {
+		return nil
+	}
+	if aws.StringValue(cfg.LoadBalancer) == "" {
+		return errors.New(`"load_balancer" cannot be empty`)
+	}
+	for _, field := range []struct {
+		name  string
+		isSet bool
+	}{
+		{name: "certificates", isSet: len(cfg.Certificates) != 0},
+		{name: "ssl_policy", isSet: cfg.SSLPolicy != nil},
+		{name: "idle_timeout", isSet: cfg.IdleTimeout != nil},
+		{name: "ingress", isSet: !cfg.Ingress.IsEmpty()},
+		{name: "security_groups", isSet: !cfg.DeprecatedSG.IsEmpty()},
+		{name: "access_logs", isSet: !cfg.ELBAccessLogs.isEmpty()},
+	} {
+		if field.isSet {
+			return &errFieldMutualExclusive{
+				firstField:  "load_balancer",
+				secondField: field.name,
+			}
+		}
+	}
+	return nil
+}
+
 // validate returns nil if ELBAccessLogsArgsOrBool is configured correctly.
 func (al ELBAccessLogsArgsOrBool) validate() error {
 	if al.isEmpty() {
@@ -355,9 +464,26 @@ func (cfg privateHTTPConfig) validate() error {
 	if err := cfg.DeprecatedSG.validate(); err != nil {
 		return fmt.Errorf(`validate "security_groups: %w`, err)
 	}
+	if err := validateALBIdleTimeout(cfg.IdleTimeout); err != nil {
+		return err
+	}
 	return cfg.Ingress.validate()
 }
 
+// validateALBIdleTimeout returns nil if the idle timeout of a load balancer is within the limits of ALBs.
+func validateALBIdleTimeout(timeout *time.Duration) error {
+	if timeout == nil {
+		return nil
+	}
+	if *timeout < minALBIdleTimeout || *timeout > maxALBIdleTimeout {
+		return fmt.Errorf(`"idle_timeout" %s must be between %s and %s`, *timeout, minALBIdleTimeout, maxALBIdleTimeout)
+	}
+	if *timeout%time.Second != 0 {
+		return fmt.Errorf(`"idle_timeout" %s must be a whole number of seconds`, *timeout)
+	}
+	return nil
+}
+
 // validate returns nil if environmentCDNConfig is configured correctly.
 func (cfg EnvironmentCDNConfig) validate() error {
 	if cfg.Config.isEmpty() {
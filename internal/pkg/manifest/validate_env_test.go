@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
@@ -55,6 +56,12 @@ func TestEnvironmentConfig_validate(t *testing.T) {
 		in          EnvironmentConfig
 		wantedError string
 	}{
+		"error if the profile is invalid": {
+			in: EnvironmentConfig{
+				Profile: aws.String("qa"),
+			},
+			wantedError: `"profile" "qa" must be one of dev, staging, prod or sandbox`,
+		},
 		"error if internal ALB subnet placement specified with adjusted vpc": {
 			in: EnvironmentConfig{
 				Network: environmentNetworkConfig{
@@ -93,6 +100,112 @@ func TestEnvironmentConfig_validate(t *testing.T) {
 			},
 			wantedError: "in order to specify internal ALB subnet placement, subnets must be imported",
 		},
+		"error if container insights are enabled on an imported cluster": {
+			in: EnvironmentConfig{
+				Cluster: environmentClusterConfig{
+					ID: aws.String("existing"),
+				},
+				Observability: environmentObservability{
+					ContainerInsights: aws.Bool(true),
+				},
+			},
+			wantedError: `"observability.container_insights" cannot be enabled when an existing cluster is imported with "cluster.id"`,
+		},
+		"error if exec is configured on an imported cluster": {
+			in: EnvironmentConfig{
+				Cluster: environmentClusterConfig{
+					ID: aws.String("existing"),
+					Exec: ClusterExecConfig{
+						Encryption: aws.Bool(true),
+					},
+				},
+			},
+			wantedError: `validate "cluster": "exec" cannot be configured when an existing cluster is imported with "id"`,
+		},
+		"error if the retention of the exec session logs is less than a day": {
+			in: EnvironmentConfig{
+				Cluster: environmentClusterConfig{
+					Exec: ClusterExecConfig{
+						Logs: ClusterExecLogs{
+							Retention: aws.Int(0),
+						},
+					},
+				},
+			},
+			wantedError: `validate "cluster": validate "exec": validate "logs": "retention" must be at least 1 day`,
+		},
+		"valid exec session encryption and logs": {
+			in: EnvironmentConfig{
+				Cluster: environmentClusterConfig{
+					Exec: ClusterExecConfig{
+						Encryption: aws.Bool(true),
+						Logs: ClusterExecLogs{
+							Retention: aws.Int(30),
+						},
+					},
+				},
+			},
+		},
+		"error if the vpc is not imported with the public load balancer": {
+			in: EnvironmentConfig{
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						LoadBalancer: aws.String("existing"),
+					},
+				},
+			},
+			wantedError: `"network.vpc.id" must be specified to import the VPC of the public load balancer "http.public.load_balancer"`,
+		},
+		"error if cdn is enabled with an imported public load balancer": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						ID: aws.String("vpc-1234"),
+						Subnets: subnetsConfiguration{
+							Private: []subnetConfiguration{
+								{SubnetID: aws.String("subnet-1")},
+								{SubnetID: aws.String("subnet-2")},
+							},
+						},
+					},
+				},
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						LoadBalancer: aws.String("existing"),
+					},
+				},
+				CDNConfig: EnvironmentCDNConfig{
+					Enabled: aws.Bool(true),
+				},
+			},
+			wantedError: `"cdn" cannot be enabled when an existing public load balancer is imported with "http.public.load_balancer"`,
+		},
+		"valid imported cluster and public load balancer": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						ID: aws.String("vpc-1234"),
+						Subnets: subnetsConfiguration{
+							Private: []subnetConfiguration{
+								{SubnetID: aws.String("subnet-1")},
+								{SubnetID: aws.String("subnet-2")},
+							},
+						},
+					},
+				},
+				Cluster: environmentClusterConfig{
+					ID: aws.String("existing"),
+				},
+				Observability: environmentObservability{
+					ContainerInsights: aws.Bool(false),
+				},
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						LoadBalancer: aws.String("existing"),
+					},
+				},
+			},
+		},
 		"error if invalid security group config": {
 			in: EnvironmentConfig{
 				Network: environmentNetworkConfig{
@@ -945,6 +1058,52 @@ func TestCDNStaticConfig_validate(t *testing.T) {
 	}
 }
 
+func TestEnvironmentCustomResources_validate(t *testing.T) {
+	durationp := func(d time.Duration) *time.Duration { return &d }
+	testCases := map[string]struct {
+		in          EnvironmentCustomResources
+		wantedError error
+	}{
+		"valid if empty": {
+			in: EnvironmentCustomResources{},
+		},
+		"invalid if the timeout is not a whole number of seconds": {
+			in: EnvironmentCustomResources{
+				Timeout: durationp(90*time.Second + 500*time.Millisecond),
+			},
+			wantedError: fmt.Errorf(`"timeout" 1m30.5s must be a whole number of seconds`),
+		},
+		"invalid if the timeout is too short": {
+			in: EnvironmentCustomResources{
+				Timeout: durationp(30 * time.Second),
+			},
+			wantedError: fmt.Errorf(`"timeout" 30s must be between 1m0s and 15m0s`),
+		},
+		"invalid if the timeout is too long": {
+			in: EnvironmentCustomResources{
+				Timeout: durationp(time.Hour),
+			},
+			wantedError: fmt.Errorf(`"timeout" 1h0m0s must be between 1m0s and 15m0s`),
+		},
+		"success": {
+			in: EnvironmentCustomResources{
+				Timeout: durationp(5 * time.Minute),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.validate()
+			if tc.wantedError != nil {
+				require.Error(t, gotErr)
+				require.EqualError(t, tc.wantedError, gotErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestSubnetConfiguration_validate(t *testing.T) {
 	mockCIDR := IPNet("10.0.0.0/24")
 	testCases := map[string]struct {
@@ -1044,6 +1203,69 @@ func TestEnvironmentHTTPConfig_validate(t *testing.T) {
 				},
 			},
 		},
+		"error if an imported public load balancer is empty": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					LoadBalancer: aws.String(""),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": "load_balancer" cannot be empty`),
+		},
+		"error if certificates are specified with an imported public load balancer": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					LoadBalancer: aws.String("existing"),
+					Certificates: []string{"arn:aws:acm:us-east-1:1111111:certificate/look-like-a-good-arn"},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": must specify one, not both, of "load_balancer" and "certificates"`),
+		},
+		"error if access logs are specified with an imported public load balancer": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					LoadBalancer: aws.String("existing"),
+					ELBAccessLogs: ELBAccessLogsArgsOrBool{
+						Enabled: aws.Bool(true),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": must specify one, not both, of "load_balancer" and "access_logs"`),
+		},
+		"success with idle timeouts": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					IdleTimeout: durationp(15 * time.Minute),
+				},
+				Private: privateHTTPConfig{
+					IdleTimeout: durationp(time.Hour),
+				},
+			},
+		},
+		"error if the public idle timeout is too long": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					IdleTimeout: durationp(2 * time.Hour),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": "idle_timeout" 2h0m0s must be between 1s and 1h6m40s`),
+		},
+		"error if the private idle timeout isn't a whole number of seconds": {
+			in: EnvironmentHTTPConfig{
+				Private: privateHTTPConfig{
+					IdleTimeout: durationp(1500 * time.Millisecond),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "private": "idle_timeout" 1.5s must be a whole number of seconds`),
+		},
+		"error if the idle timeout is specified with an imported public load balancer": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					LoadBalancer: aws.String("existing"),
+					IdleTimeout:  durationp(15 * time.Minute),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": must specify one, not both, of "load_balancer" and "idle_timeout"`),
+		},
 		"public http config with invalid security group ingress": {
 			in: EnvironmentHTTPConfig{
 				Public: PublicHTTPConfig{
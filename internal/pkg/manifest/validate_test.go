@@ -48,6 +48,98 @@ func TestLoadBalancedWebService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "image": `,
 		},
+		"error if a CodeDeploy strategy is used with additional listener rules": {
+			lbConfig: LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path: stringP("/"),
+							},
+							AdditionalRoutingRules: []RoutingRule{
+								{
+									Path: stringP("/admin"),
+								},
+							},
+						},
+					},
+					DeployConfig: DeploymentConfig{
+						Strategy: DeploymentStrategy{Type: aws.String("blue-green")},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "deployment.strategy": "http.additional_rules" are not supported`,
+		},
+		"error if the requests are mirrored to the service itself": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path: stringP("/"),
+							},
+							Mirror: TrafficMirror{
+								Service: aws.String("api"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "http.mirror": "service" must be a different service than api`),
+		},
+		"error if a sidecar uses the name of the traffic mirror": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path: stringP("/"),
+							},
+							Mirror: TrafficMirror{
+								Service: aws.String("api-v2"),
+							},
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"traffic-mirror": {
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("envoyproxy/envoy")),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "http.mirror": sidecar name "traffic-mirror" is reserved for the traffic mirror`),
+		},
+		"error if requests of a gRPC service are mirrored": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path:            stringP("/"),
+								ProtocolVersion: aws.String("gRPC"),
+							},
+							Mirror: TrafficMirror{
+								Service: aws.String("api-v2"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "http.mirror": mirroring requests of protocol version gRPC is not supported`),
+		},
 		"error if fail to validate grace_period when specified in the additional listener rules of ALB": {
 			lbConfig: LoadBalancedWebService{
 				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
@@ -296,6 +388,137 @@ func TestLoadBalancedWebService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate target for "nlb": `,
 		},
+		"error if the HTTP traffic is routed to a port exposed over UDP": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path:            stringP("/"),
+								TargetContainer: aws.String("dns"),
+							},
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"dns": {
+							Port:  aws.String("53/udp"),
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("coredns/coredns")),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate load balancer target for "http": target container "dns" exposes port 53 over UDP, which can't receive HTTP traffic`),
+		},
+		"no error if the load balancer checks the health of the targets on a port that isn't exposed": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path: stringP("/"),
+								HealthCheck: HealthCheckArgsOrString{
+									Union: AdvancedToUnion[string](HTTPHealthCheckArgs{
+										Path: aws.String("/healthz"),
+										Port: aws.Int(8081),
+									}),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"no error if the load balancer checks the health of the targets on the port of a sidecar": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					Sidecars: map[string]*SidecarConfig{
+						"envoy": {
+							Port:  aws.String("9901"),
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("envoyproxy/envoy")),
+						},
+					},
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path: stringP("/"),
+								HealthCheck: HealthCheckArgsOrString{
+									Union: AdvancedToUnion[string](HTTPHealthCheckArgs{
+										Path: aws.String("/ready"),
+										Port: aws.Int(9901),
+									}),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"error if the deregistration delay is shorter than the stop timeout of the main container": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								ImageLocationOrBuild: ImageLocationOrBuild{
+									Build: BuildArgsOrString{BuildString: aws.String("mockBuild")},
+								},
+								StopTimeout: durationp(60 * time.Second),
+							},
+							Port: uint16P(80),
+						},
+					},
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path:                stringP("/"),
+								DeregistrationDelay: durationp(30 * time.Second),
+							},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": "deregistration_delay" 30s must not be shorter than the "image.stop_timeout" 1m0s of the target container`),
+		},
+		"valid if the deregistration delay applies to a sidecar": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								ImageLocationOrBuild: ImageLocationOrBuild{
+									Build: BuildArgsOrString{BuildString: aws.String("mockBuild")},
+								},
+								StopTimeout: durationp(60 * time.Second),
+							},
+							Port: uint16P(80),
+						},
+					},
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path:                stringP("/"),
+								TargetContainer:     aws.String("nginx"),
+								DeregistrationDelay: durationp(30 * time.Second),
+							},
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"nginx": {
+							Port:  aws.String("8080"),
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("nginx")),
+						},
+					},
+				},
+			},
+		},
 		"error if fail to validate network load balancer target for additional listener": {
 			lbConfig: LoadBalancedWebService{
 				Workload: Workload{Name: aws.String("mockName")},
@@ -478,6 +701,55 @@ func TestLoadBalancedWebService_validate(t *testing.T) {
 			},
 			wantedError: errors.New(`scaling based on "nlb" requests or response time is not supported`),
 		},
+		"error if scaling based on nlb connections": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{
+						Count: Count{
+							AdvancedCount: AdvancedCount{
+								Connections: ScalingConfigOrT[int]{
+									Value: aws.Int(1000),
+								},
+							},
+						},
+					},
+					HTTPOrBool: HTTPOrBool{
+						Enabled: aws.Bool(false),
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Listener: NetworkLoadBalancerListener{
+							Port: aws.String("80"),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`scaling based on "nlb" connections is not supported`),
+		},
+		"error if anomaly detection without http": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						Enabled: aws.Bool(false),
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Listener: NetworkLoadBalancerListener{
+							Port: aws.String("80"),
+						},
+					},
+					Observability: Observability{
+						AnomalyDetection: []string{"latency"},
+					},
+				},
+			},
+			wantedError: errors.New(`anomaly detection on "nlb" metrics is not supported`),
+		},
 		"error if fail to validate deployment": {
 			lbConfig: LoadBalancedWebService{
 				Workload: Workload{
@@ -735,6 +1007,64 @@ func TestBackendService_validate(t *testing.T) {
 			},
 			wantedError: errors.New(`"http" must be specified if "count.requests" or "count.response_time" are specified`),
 		},
+		"error if anomaly detection without http": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					Observability: Observability{
+						AnomalyDetection: []string{"errors"},
+					},
+				},
+			},
+			wantedError: errors.New(`"http" must be specified if "observability.anomaly_detection" is specified`),
+		},
+		"error if alias health checks are enabled": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTP: HTTP{
+						Main: RoutingRule{
+							Path:  stringP("/"),
+							Alias: Alias{StringSliceOrString: StringSliceOrString{String: aws.String("api.example.com")}},
+						},
+						AliasHealthCheck: BasicToUnion[*bool, AliasHealthCheckArgs](aws.Bool(true)),
+					},
+				},
+			},
+			wantedError: errors.New(`"http.alias_health_check" is not supported for Backend Service`),
+		},
+		"error if traffic mirroring is configured": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTP: HTTP{
+						Main: RoutingRule{
+							Path: stringP("/"),
+						},
+						Mirror: TrafficMirror{
+							Service: aws.String("api-v2"),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`"http.mirror" is not supported for Backend Service`),
+		},
+		"error if the routing is derived from an OpenAPI spec": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTP: HTTP{
+						Main: RoutingRule{
+							Path: stringP("/"),
+						},
+						OpenAPI: OpenAPI{
+							Spec: aws.String("api/openapi.yml"),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`"http.openapi" is not supported for Backend Service`),
+		},
 		"error if invalid topic is defined": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -785,8 +1115,40 @@ func TestBackendService_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`cannot set "network.connect.alias" when no ports are exposed`),
 		},
-	}
-	for name, tc := range testCases {
+		"no error if the load balancer checks the health of an additional rule on a port that isn't exposed": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: testImageConfig.Image,
+							Port:  aws.Uint16(8080),
+						},
+					},
+					HTTP: HTTP{
+						Main: RoutingRule{
+							Path: aws.String("/"),
+						},
+						AdditionalRoutingRules: []RoutingRule{
+							{
+								Path:       aws.String("/admin"),
+								TargetPort: aws.Uint16(8081),
+								HealthCheck: HealthCheckArgsOrString{
+									Union: AdvancedToUnion[string](HTTPHealthCheckArgs{
+										Path: aws.String("/healthz"),
+										Port: aws.Int(8082),
+									}),
+								},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			gotErr := tc.config.validate()
 
@@ -900,6 +1262,114 @@ func TestRequestDrivenWebService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "observability": `,
 		},
+		"error if both count and scaling are set": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Count: aws.String("high-availability/3"),
+					Scaling: AppRunnerScalingConfig{
+						MaxInstances: aws.Int(4),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "count" and "scaling"`),
+		},
+		"error if max_concurrency is out of range": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Scaling: AppRunnerScalingConfig{
+						MaxConcurrency: aws.Int(201),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "scaling": "max_concurrency" 201 must be between 1 and 200`),
+		},
+		"error if max_instances is out of range": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Scaling: AppRunnerScalingConfig{
+						MaxInstances: aws.Int(0),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "scaling": "max_instances" 0 must be between 1 and 25`),
+		},
+		"error if min_instances is greater than max_instances": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Scaling: AppRunnerScalingConfig{
+						MinInstances: aws.Int(5),
+						MaxInstances: aws.Int(2),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "scaling": "min_instances" 5 must be less than or equal to "max_instances" 2`),
+		},
+		"valid scaling": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Scaling: AppRunnerScalingConfig{
+						MaxConcurrency: aws.Int(50),
+						MinInstances:   aws.Int(2),
+						MaxInstances:   aws.Int(10),
+					},
+				},
+			},
+		},
 		"error if name is not set": {
 			config: RequestDrivenWebService{
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
@@ -940,6 +1410,88 @@ func TestRequestDrivenWebService_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`placement "public" is not supported for Request-Driven Web Service`),
 		},
+		"error if both image and source are set": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Source: AppRunnerSource{
+						Runtime: aws.String("PYTHON_3"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "image" and "source"`),
+		},
+		"error if source connection is not set": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Source: AppRunnerSource{
+						Runtime: aws.String("PYTHON_3"),
+						Port:    uint16P(8080),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "source": "connection" must be specified`),
+		},
+		"error if source runtime is not supported": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Source: AppRunnerSource{
+						Runtime:    aws.String("PYTHON_2"),
+						Port:       uint16P(8080),
+						Connection: aws.String("arn:aws:apprunner:us-west-2:123456789012:connection/github/abc"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "source": "runtime" field value 'PYTHON_2' must be one of PYTHON_3, PYTHON_311,`,
+		},
+		"error if source directory is absolute": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Source: AppRunnerSource{
+						Directory:  aws.String("/api"),
+						Runtime:    aws.String("NODEJS_18"),
+						Port:       uint16P(8080),
+						Connection: aws.String("arn:aws:apprunner:us-west-2:123456789012:connection/github/abc"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "source": "directory" field value '/api' must be relative to the root of the repository`),
+		},
+		"valid source without image": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Source: AppRunnerSource{
+						Directory:  aws.String("api"),
+						Runtime:    aws.String("NODEJS_18"),
+						Build:      aws.String("npm ci"),
+						Port:       uint16P(8080),
+						Connection: aws.String("arn:aws:apprunner:us-west-2:123456789012:connection/github/abc"),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1218,6 +1770,17 @@ func TestScheduledJob_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "image": `,
 		},
+		"error if scale-in protection is enabled": {
+			config: ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						ScaleInProtection: BasicToUnion[*bool, ScaleInProtectionArgs](aws.Bool(true)),
+					},
+				},
+			},
+			wantedError: errors.New(`"scale_in_protection" is not supported for Scheduled Job`),
+		},
 		"error if fail to validate sidecars": {
 			config: ScheduledJob{
 				ScheduledJobConfig: ScheduledJobConfig{
@@ -1430,79 +1993,218 @@ func TestPipelineManifest_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployments" for pipeline stage test:`,
 		},
-	}
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			gotErr := tc.Pipeline.Validate()
-
-			switch {
-			case tc.wantedError != nil:
-				require.EqualError(t, gotErr, tc.wantedError.Error())
-			case tc.wantedErrorMsgPrefix != "":
-				require.Error(t, gotErr)
-				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
-			default:
-				require.NoError(t, gotErr)
-			}
-		})
-	}
-}
-
-func TestDeployments_validate(t *testing.T) {
-	testCases := map[string]struct {
-		in     Deployments
-		wanted error
-	}{
-		"should return nil on empty deployments": {},
-		"should return an error when a dependency does not exist": {
-			in: map[string]*Deployment{
-				"frontend": {
-					DependsOn: []string{"backend"},
+		"error if artifact retention is less than a day": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Artifacts: &PipelineArtifacts{
+					Retention: aws.Int(0),
 				},
 			},
-			wanted: errors.New("dependency deployment named 'backend' of 'frontend' does not exist"),
+			wantedError: errors.New(`validate "artifacts": "retention" must be at least 1 day`),
 		},
-		"should return nil when all dependencies are present": {
-			in: map[string]*Deployment{
-				"frontend": {
-					DependsOn: []string{"backend"},
+		"success with artifact retention": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Artifacts: &PipelineArtifacts{
+					Retention: aws.Int(30),
 				},
-				"backend": nil,
 			},
 		},
-	}
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			actual := tc.in.validate()
-
-			if tc.wanted == nil {
-				require.NoError(t, actual)
-			} else {
-				require.EqualError(t, actual, tc.wanted.Error())
-			}
-		})
-	}
-}
-
-func TestImageWithPort_validate(t *testing.T) {
-	testCases := map[string]struct {
-		ImageWithPort ImageWithPort
-
-		wantedError error
-	}{
-		"error if port is not specified": {
-			ImageWithPort: ImageWithPort{
-				Image: Image{
-					ImageLocationOrBuild: ImageLocationOrBuild{
-						Location: aws.String("mockLocation"),
+		"error if filters don't list workloads": {
+			Pipeline: Pipeline{
+				Name:    "release",
+				Filters: &PipelineFilters{},
+			},
+			wantedError: errors.New(`validate "filters": "workloads" must be specified`),
+		},
+		"error if filters list a workload twice": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Filters: &PipelineFilters{
+					Workloads: []string{"api", "worker", "api"},
+				},
+			},
+			wantedError: errors.New(`validate "filters": workload "api" is listed more than once in "workloads"`),
+		},
+		"success with filters": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Filters: &PipelineFilters{
+					Workloads: []string{"api", "worker"},
+				},
+			},
+		},
+		"error if a stage requires approval and approvals": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name:             "test",
+						RequiresApproval: true,
+						Promotion: &PromotionPolicy{
+							Approvals: aws.Int(2),
+						},
 					},
 				},
 			},
-			wantedError: fmt.Errorf(`"port" must be specified`),
+			wantedError: errors.New(`validate stage "test" for pipeline "release": must specify one, not both, of "requires_approval" and "promotion.approvals"`),
 		},
-	}
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
+		"error if the number of approvals is out of range": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Promotion: &PromotionPolicy{
+							Approvals: aws.Int(0),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate stage "test" for pipeline "release": validate "promotion": "approvals" must be between 1 and 10`),
+		},
+		"error if the soak time is out of range": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+					},
+					{
+						Name: "prod",
+						Promotion: &PromotionPolicy{
+							SoakTime: durationp(8 * time.Hour),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate stage "prod" for pipeline "release": validate "promotion": "soak_time" must be between 1m and 7h0m0s`),
+		},
+		"error if an alarm is listed twice": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+					},
+					{
+						Name: "prod",
+						Promotion: &PromotionPolicy{
+							Alarms: []string{"latency", "latency"},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate stage "prod" for pipeline "release": validate "promotion": alarm "latency" is listed more than once in "alarms"`),
+		},
+		"error if the first stage has a soak time": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Promotion: &PromotionPolicy{
+							SoakTime: durationp(time.Hour),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate stage "test" for pipeline "release": "promotion.soak_time" and "promotion.alarms" cannot be specified in the first stage`),
+		},
+		"success with promotion policies": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Promotion: &PromotionPolicy{
+							Approvals: aws.Int(1),
+						},
+					},
+					{
+						Name: "prod",
+						Promotion: &PromotionPolicy{
+							Approvals: aws.Int(2),
+							SoakTime:  durationp(time.Hour),
+							Alarms:    []string{"latency", "errors"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.Pipeline.Validate()
+
+			switch {
+			case tc.wantedError != nil:
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			case tc.wantedErrorMsgPrefix != "":
+				require.Error(t, gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
+			default:
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestDeployments_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     Deployments
+		wanted error
+	}{
+		"should return nil on empty deployments": {},
+		"should return an error when a dependency does not exist": {
+			in: map[string]*Deployment{
+				"frontend": {
+					DependsOn: []string{"backend"},
+				},
+			},
+			wanted: errors.New("dependency deployment named 'backend' of 'frontend' does not exist"),
+		},
+		"should return nil when all dependencies are present": {
+			in: map[string]*Deployment{
+				"frontend": {
+					DependsOn: []string{"backend"},
+				},
+				"backend": nil,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.in.validate()
+
+			if tc.wanted == nil {
+				require.NoError(t, actual)
+			} else {
+				require.EqualError(t, actual, tc.wanted.Error())
+			}
+		})
+	}
+}
+
+func TestImageWithPort_validate(t *testing.T) {
+	testCases := map[string]struct {
+		ImageWithPort ImageWithPort
+
+		wantedError error
+	}{
+		"error if port is not specified": {
+			ImageWithPort: ImageWithPort{
+				Image: Image{
+					ImageLocationOrBuild: ImageLocationOrBuild{
+						Location: aws.String("mockLocation"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"port" must be specified`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
 			gotErr := tc.ImageWithPort.validate()
 
 			if tc.wantedError != nil {
@@ -1548,6 +2250,15 @@ func TestImage_validate(t *testing.T) {
 
 			wantedErrorMsgPrefix: `validate "depends_on":`,
 		},
+		"error if stop_timeout is longer than two minutes": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Location: aws.String("mockLocation"),
+				},
+				StopTimeout: durationp(3 * time.Minute),
+			},
+			wantedError: fmt.Errorf(`"stop_timeout" 3m0s must be between 1s and 2m0s`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1623,6 +2334,27 @@ func TestRoutingRule_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `"path" must be specified`,
 		},
+		"error if the health check path is relative": {
+			RoutingRule: RoutingRule{
+				Path: stringP("/"),
+				HealthCheck: HealthCheckArgsOrString{
+					Union: BasicToUnion[string, HTTPHealthCheckArgs]("healthz"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "healthcheck": path "healthz" must start with "/"`),
+		},
+		"error if the health check port is out of range": {
+			RoutingRule: RoutingRule{
+				Path: stringP("/"),
+				HealthCheck: HealthCheckArgsOrString{
+					Union: AdvancedToUnion[string](HTTPHealthCheckArgs{
+						Path: aws.String("/healthz"),
+						Port: aws.Int(70000),
+					}),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "healthcheck": port 70000 must be between 1 and 65535`),
+		},
 		"should not error if protocol version is not uppercase": {
 			RoutingRule: RoutingRule{
 				Path:            stringP("/"),
@@ -1691,6 +2423,26 @@ func TestRoutingRule_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`validate condition values per listener rule: listener rule has more than five conditions example.com, v1.example.com, v2.example.com, v3.example.com and v4.example.com `),
 		},
+		"error if deregistration_delay is longer than an hour": {
+			RoutingRule: RoutingRule{
+				Path:                stringP("/"),
+				DeregistrationDelay: durationp(2 * time.Hour),
+			},
+			wantedError: fmt.Errorf(`"deregistration_delay" 2h0m0s must be between 0s and 1h0m0s`),
+		},
+		"error if slow_start is shorter than 30 seconds": {
+			RoutingRule: RoutingRule{
+				Path:      stringP("/"),
+				SlowStart: durationp(10 * time.Second),
+			},
+			wantedError: fmt.Errorf(`"slow_start" 10s must be 0s to disable it, or between 30s and 15m0s`),
+		},
+		"valid if slow_start is disabled": {
+			RoutingRule: RoutingRule{
+				Path:      stringP("/"),
+				SlowStart: durationp(0),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1749,6 +2501,134 @@ func TestHTTP_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`validate "additional_rules[0]": "path" must be specified`),
 		},
+		"error if alias health checks are enabled without aliases": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				AliasHealthCheck: BasicToUnion[*bool, AliasHealthCheckArgs](aws.Bool(true)),
+			},
+			wantedError: fmt.Errorf(`"alias" must be specified if "alias_health_check" is specified`),
+		},
+		"no error if alias health checks are disabled without aliases": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				AliasHealthCheck: BasicToUnion[*bool, AliasHealthCheckArgs](aws.Bool(false)),
+			},
+		},
+		"no error if an additional routing rule has an alias": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				AdditionalRoutingRules: []RoutingRule{
+					{
+						Path:  stringP("/admin"),
+						Alias: Alias{StringSliceOrString: StringSliceOrString{String: aws.String("admin.example.com")}},
+					},
+				},
+				AliasHealthCheck: AdvancedToUnion[*bool](AliasHealthCheckArgs{
+					Path:             stringP("/admin/ping"),
+					FailureThreshold: aws.Int64(3),
+					Interval:         durationp(10 * time.Second),
+				}),
+			},
+		},
+		"error if the path of the alias health check is invalid": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path:  stringP("/"),
+					Alias: Alias{StringSliceOrString: StringSliceOrString{String: aws.String("api.example.com")}},
+				},
+				AliasHealthCheck: AdvancedToUnion[*bool](AliasHealthCheckArgs{
+					Path: stringP("ping"),
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "alias_health_check": path "ping" must start with "/"`),
+		},
+		"error if the failure threshold of the alias health check is out of range": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path:  stringP("/"),
+					Alias: Alias{StringSliceOrString: StringSliceOrString{String: aws.String("api.example.com")}},
+				},
+				AliasHealthCheck: AdvancedToUnion[*bool](AliasHealthCheckArgs{
+					FailureThreshold: aws.Int64(11),
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "alias_health_check": "failure_threshold" 11 must be between 1 and 10`),
+		},
+		"error if the mirror has a percent but no service": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				Mirror: TrafficMirror{
+					Percent: aws.Int(10),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "mirror": "service" must be specified if "port" or "percent" are specified`),
+		},
+		"error if the percent of mirrored requests is out of range": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				Mirror: TrafficMirror{
+					Service: aws.String("api-v2"),
+					Percent: aws.Int(150),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "mirror": "percent" 150 must be between 1 and 100`),
+		},
+		"error if undefined paths are blocked without an OpenAPI spec": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				OpenAPI: OpenAPI{
+					BlockUndefinedPaths: aws.Bool(true),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "openapi": "spec" must be specified if "block_undefined_paths" is specified`),
+		},
+		"no error if the routing is derived from an OpenAPI spec": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/api"),
+				},
+				OpenAPI: OpenAPI{
+					Spec:                aws.String("api/openapi.yml"),
+					BlockUndefinedPaths: aws.Bool(true),
+				},
+			},
+		},
+		"no error if the requests are mirrored to another service": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				Mirror: TrafficMirror{
+					Service: aws.String("api-v2"),
+					Port:    uint16P(8080),
+					Percent: aws.Int(25),
+				},
+			},
+		},
+		"error if the interval of the alias health check is unsupported": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path:  stringP("/"),
+					Alias: Alias{StringSliceOrString: StringSliceOrString{String: aws.String("api.example.com")}},
+				},
+				AliasHealthCheck: AdvancedToUnion[*bool](AliasHealthCheckArgs{
+					Interval: durationp(time.Minute),
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "alias_health_check": "interval" 1m0s must be 10s or 30s`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1778,6 +2658,17 @@ func TestNetworkLoadBalancerConfiguration_validate(t *testing.T) {
 		"success if empty": {
 			nlb: NetworkLoadBalancerConfiguration{},
 		},
+		"error if the health check port is out of range": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Listener: NetworkLoadBalancerListener{
+					Port: aws.String("443/tls"),
+					HealthCheck: NLBHealthCheckArgs{
+						Port: aws.Int(0),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "healthcheck": port 0 must be between 1 and 65535`),
+		},
 		"error if port unspecified": {
 			nlb: NetworkLoadBalancerConfiguration{
 				Listener: NetworkLoadBalancerListener{
@@ -1852,7 +2743,7 @@ func TestNetworkLoadBalancerConfiguration_validate(t *testing.T) {
 				},
 			},
 			wantedErrorMsgPrefix: `validate "nlb": `,
-			wantedError:          fmt.Errorf(`validate "port": invalid protocol tps; valid protocols include TCP, UDP and TLS`),
+			wantedError:          fmt.Errorf(`validate "port": invalid protocol tps; valid protocols include TCP, UDP, TLS and TCP_UDP`),
 		},
 		"fail if protocol is not recognized in additional listeners": {
 			nlb: NetworkLoadBalancerConfiguration{
@@ -1866,7 +2757,7 @@ func TestNetworkLoadBalancerConfiguration_validate(t *testing.T) {
 				},
 			},
 			wantedErrorMsgPrefix: `validate "nlb": `,
-			wantedError:          fmt.Errorf(`validate "additional_listeners[0]": validate "port": invalid protocol tps; valid protocols include TCP, UDP and TLS`),
+			wantedError:          fmt.Errorf(`validate "additional_listeners[0]": validate "port": invalid protocol tps; valid protocols include TCP, UDP, TLS and TCP_UDP`),
 		},
 		"success if tcp": {
 			nlb: NetworkLoadBalancerConfiguration{
@@ -1923,66 +2814,116 @@ func TestNetworkLoadBalancerConfiguration_validate(t *testing.T) {
 				},
 			},
 		},
-		"error if tcp_udp": {
+		"success if tcp_udp": {
 			nlb: NetworkLoadBalancerConfiguration{
 				Listener: NetworkLoadBalancerListener{
 					Port: aws.String("443/TCP_udp"),
 				},
 			},
-			wantedError: fmt.Errorf(`validate "port": invalid protocol TCP_udp; valid protocols include TCP, UDP and TLS`),
 		},
-		"error if tcp_udp in additional listeners": {
+		"success if tcp_udp in additional listeners": {
 			nlb: NetworkLoadBalancerConfiguration{
 				Listener: NetworkLoadBalancerListener{
 					Port: aws.String("443/tcp"),
 				},
 				AdditionalListeners: []NetworkLoadBalancerListener{
 					{
-						Port: aws.String("443/TCP_udp"),
+						Port: aws.String("27015/TCP_udp"),
 					},
 				},
 			},
-			wantedError: fmt.Errorf(`validate "additional_listeners[0]": validate "port": invalid protocol TCP_udp; valid protocols include TCP, UDP and TLS`),
 		},
-		"error if hosted zone is set": {
+		"success with an HTTP health check": {
 			nlb: NetworkLoadBalancerConfiguration{
 				Listener: NetworkLoadBalancerListener{
-					Port: aws.String("443/tcp"),
+					Port: aws.String("514/udp"),
+					HealthCheck: NLBHealthCheckArgs{
+						Port:     aws.Int(8080),
+						Protocol: aws.String("http"),
+						Path:     aws.String("/healthz"),
+					},
 				},
-				Aliases: Alias{
-					AdvancedAliases: []AdvancedAlias{
-						{
-							Alias:      aws.String("mockAlias"),
-							HostedZone: aws.String("mockHostedZone"),
-						},
+			},
+		},
+		"error if the health check protocol is not recognized": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Listener: NetworkLoadBalancerListener{
+					Port: aws.String("514/udp"),
+					HealthCheck: NLBHealthCheckArgs{
+						Protocol: aws.String("udp"),
 					},
 				},
 			},
-			wantedError: fmt.Errorf(`"hosted_zone" is not supported for Network Load Balancer`),
+			wantedError: fmt.Errorf(`validate "healthcheck": invalid protocol udp; valid protocols include TCP, HTTP and HTTPS`),
 		},
-		"error if hosted zone is set in additional listeners": {
+		"error if the health check path is set without an HTTP protocol": {
 			nlb: NetworkLoadBalancerConfiguration{
 				Listener: NetworkLoadBalancerListener{
 					Port: aws.String("443/tcp"),
 				},
 				AdditionalListeners: []NetworkLoadBalancerListener{
 					{
-						Port: aws.String("80/tcp"),
-					},
-				},
-				Aliases: Alias{
-					AdvancedAliases: []AdvancedAlias{
-						{
-							Alias:      aws.String("mockAlias"),
-							HostedZone: aws.String("mockHostedZone"),
+						Port: aws.String("514/udp"),
+						HealthCheck: NLBHealthCheckArgs{
+							Port: aws.Int(8080),
+							Path: aws.String("/healthz"),
 						},
 					},
 				},
 			},
-			wantedError: fmt.Errorf(`"hosted_zone" is not supported for Network Load Balancer`),
+			wantedError: fmt.Errorf(`validate "additional_listeners[0]": validate "healthcheck": "path" can only be specified with "protocol" HTTP or HTTPS`),
 		},
-	}
-
+		"error if the health check path doesn't start with a slash": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Listener: NetworkLoadBalancerListener{
+					Port: aws.String("443/tcp"),
+					HealthCheck: NLBHealthCheckArgs{
+						Protocol: aws.String("HTTPS"),
+						Path:     aws.String("healthz"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "healthcheck": path "healthz" must start with "/"`),
+		},
+		"error if hosted zone is set": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Listener: NetworkLoadBalancerListener{
+					Port: aws.String("443/tcp"),
+				},
+				Aliases: Alias{
+					AdvancedAliases: []AdvancedAlias{
+						{
+							Alias:      aws.String("mockAlias"),
+							HostedZone: aws.String("mockHostedZone"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"hosted_zone" is not supported for Network Load Balancer`),
+		},
+		"error if hosted zone is set in additional listeners": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Listener: NetworkLoadBalancerListener{
+					Port: aws.String("443/tcp"),
+				},
+				AdditionalListeners: []NetworkLoadBalancerListener{
+					{
+						Port: aws.String("80/tcp"),
+					},
+				},
+				Aliases: Alias{
+					AdvancedAliases: []AdvancedAlias{
+						{
+							Alias:      aws.String("mockAlias"),
+							HostedZone: aws.String("mockHostedZone"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"hosted_zone" is not supported for Network Load Balancer`),
+		},
+	}
+
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			gotErr := tc.nlb.validate()
@@ -2026,6 +2967,50 @@ func TestAdvancedAlias_validate(t *testing.T) {
 	}
 }
 
+func TestAlias_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     Alias
+		wanted error
+	}{
+		"should return an error if a wildcard isn't the first label": {
+			in: Alias{
+				StringSliceOrString: StringSliceOrString{
+					String: aws.String("api.*.example.com"),
+				},
+			},
+			wanted: errors.New(`alias "api.*.example.com" can only use a wildcard as its first label, for example "*.example.com"`),
+		},
+		"should return an error if an advanced alias can't be converted to punycode": {
+			in: Alias{
+				AdvancedAliases: []AdvancedAlias{
+					{
+						Alias: aws.String("bücher_shop.example.com"),
+					},
+				},
+			},
+			wanted: errors.New(`convert alias "bücher_shop.example.com" to punycode: idna: disallowed rune U+005F`),
+		},
+		"success with wildcard and internationalized aliases": {
+			in: Alias{
+				StringSliceOrString: StringSliceOrString{
+					StringSlice: []string{"*.api.example.com", "bücher.example.com"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestIPNet_validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     IPNet
@@ -2079,6 +3064,29 @@ func TestTaskConfig_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "count": `,
 		},
+		"error if the expiration of the scale-in protection is too long": {
+			TaskConfig: TaskConfig{
+				ScaleInProtection: AdvancedToUnion[*bool](ScaleInProtectionArgs{
+					ExpiresIn: durationp(72 * time.Hour),
+				}),
+			},
+			wantedError: errors.New(`validate "scale_in_protection": "expires_in" 72h0m0s must be a whole number of minutes between 1m0s and 48h0m0s`),
+		},
+		"error if the expiration of the scale-in protection isn't a whole number of minutes": {
+			TaskConfig: TaskConfig{
+				ScaleInProtection: AdvancedToUnion[*bool](ScaleInProtectionArgs{
+					ExpiresIn: durationp(90 * time.Second),
+				}),
+			},
+			wantedError: errors.New(`validate "scale_in_protection": "expires_in" 1m30s must be a whole number of minutes between 1m0s and 48h0m0s`),
+		},
+		"valid scale-in protection": {
+			TaskConfig: TaskConfig{
+				ScaleInProtection: AdvancedToUnion[*bool](ScaleInProtectionArgs{
+					ExpiresIn: durationp(4 * time.Hour),
+				}),
+			},
+		},
 		"error if fail to validate storage": {
 			TaskConfig: TaskConfig{
 				Storage: Storage{
@@ -2102,6 +3110,110 @@ func TestTaskConfig_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("environment file foo must have a .env file extension"),
 		},
+		"error if fail to validate secrets": {
+			TaskConfig: TaskConfig{
+				Secrets: map[string]Secret{
+					"DB_USER": {
+						fromSecretsManager: secretsManagerSecret{FromJSONKey: aws.String("username")},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "DB_USER" "secrets": `,
+		},
+		"error if the path of SSM parameters is relative": {
+			TaskConfig: TaskConfig{
+				Variables: map[string]Variable{
+					"from_ssm_path": {
+						StringOrFromCFN: StringOrFromCFN{Plain: aws.String("myapp/test")},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "variables.from_ssm_path": path "myapp/test" must start with a forward slash`),
+		},
+		"error if the path of SSM parameters is imported": {
+			TaskConfig: TaskConfig{
+				Variables: map[string]Variable{
+					"from_ssm_path": {
+						StringOrFromCFN: StringOrFromCFN{FromCFN: fromCFN{Name: aws.String("stack-ParamsPath")}},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "variables.from_ssm_path": the path of SSM parameters cannot be imported from a CloudFormation stack`),
+		},
+		"error if a variable other than build_only is a map": {
+			TaskConfig: TaskConfig{
+				Variables: map[string]Variable{
+					"LOG_LEVEL": {
+						BuildOnly: map[string]BuildArg{"level": {Plain: aws.String("debug")}},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "LOG_LEVEL" "variables": only "build_only" can be a map of build args`),
+		},
+		"error if build_only is not a map": {
+			TaskConfig: TaskConfig{
+				Variables: map[string]Variable{
+					"build_only": {
+						StringOrFromCFN: StringOrFromCFN{Plain: aws.String("NPM_TOKEN")},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "variables.build_only": must be a map of build args`),
+		},
+		"error if a build-only variable is read from SSM": {
+			TaskConfig: TaskConfig{
+				Variables: map[string]Variable{
+					"build_only": {
+						BuildOnly: map[string]BuildArg{"NPM_TOKEN": {FromSSM: aws.String("/myapp/npm-token")}},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "variables.build_only": "NPM_TOKEN" can't reference a secret: build args are stored in the image history, use "image.build.secrets" instead`),
+		},
+		"error if a build-only variable is the ARN of a secret": {
+			TaskConfig: TaskConfig{
+				Variables: map[string]Variable{
+					"build_only": {
+						BuildOnly: map[string]BuildArg{"NPM_TOKEN": {Plain: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:npm-token")}},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "variables.build_only": "NPM_TOKEN" can't reference a secret: build args are stored in the image history, use "image.build.secrets" instead`),
+		},
+		"error if a build-only variable is also a secret": {
+			TaskConfig: TaskConfig{
+				Variables: map[string]Variable{
+					"build_only": {
+						BuildOnly: map[string]BuildArg{"NPM_TOKEN": {Plain: aws.String("token")}},
+					},
+				},
+				Secrets: map[string]Secret{
+					"NPM_TOKEN": {from: StringOrFromCFN{Plain: aws.String("NPM_TOKEN")}},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "variables.build_only": "NPM_TOKEN" is also a secret: build args are stored in the image history, use "image.build.secrets" instead`),
+		},
+		"valid build-only variables": {
+			TaskConfig: TaskConfig{
+				Variables: map[string]Variable{
+					"build_only": {
+						BuildOnly: map[string]BuildArg{
+							"NPM_REGISTRY": {Plain: aws.String("https://registry.example.com")},
+							"GIT_SHA":      {FromEnv: aws.String("GITHUB_SHA")},
+						},
+					},
+				},
+			},
+		},
+		"valid path of SSM parameters": {
+			TaskConfig: TaskConfig{
+				Variables: map[string]Variable{
+					"from_ssm_path": {
+						StringOrFromCFN: StringOrFromCFN{Plain: aws.String("/myapp/test/")},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2171,6 +3283,9 @@ func TestPlatformArgsOrString_validate(t *testing.T) {
 		"return nil if platform string valid": {
 			in: PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("linux/amd64"))},
 		},
+		"return nil if platform is multiarch": {
+			in: PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("multiarch"))},
+		},
 		"return nil if platform args valid": {
 			in: PlatformArgsOrString{
 				PlatformArgs: PlatformArgs{
@@ -2336,7 +3451,7 @@ func TestAdvancedCount_validate(t *testing.T) {
 				CPU:          mockConfig,
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify one, not both, of "spot" and "range/cpu_percentage/memory_percentage/requests/response_time"`),
+			wantedError: fmt.Errorf(`must specify one, not both, of "spot" and "range/cpu_percentage/memory_percentage/requests/response_time/connections/custom_metrics/schedules"`),
 		},
 		"error if fail to validate range": {
 			AdvancedCount: AdvancedCount{
@@ -2354,7 +3469,7 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests" or "response_time" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "custom_metrics" or "schedules" are specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Load Balanced Web Service": {
 			AdvancedCount: AdvancedCount{
@@ -2363,7 +3478,7 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "custom_metrics" or "schedules" if "range" is specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Backend Service": {
 			AdvancedCount: AdvancedCount{
@@ -2372,7 +3487,7 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.BackendServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "custom_metrics" or "schedules" if "range" is specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Worker Service": {
 			AdvancedCount: AdvancedCount{
@@ -2381,42 +3496,42 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.WorkerServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage" or "queue_delay" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "queue_delay", "custom_metrics" or "schedules" if "range" is specified`),
 		},
 		"error if cooldown is specified but no autoscaling fields are specified for a Load Balanced Web Service": {
 			AdvancedCount: AdvancedCount{
 				Cooldown:     mockCooldown,
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "cooldown" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "custom_metrics" or "schedules" if "cooldown" is specified`),
 		},
 		"error if cooldown is specified but no autoscaling fields are specified for a Backend Service": {
 			AdvancedCount: AdvancedCount{
 				Cooldown:     mockCooldown,
 				workloadType: manifestinfo.BackendServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "cooldown" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "custom_metrics" or "schedules" if "cooldown" is specified`),
 		},
 		"error if cooldown is specified but no autoscaling fields are specified for a Worker Service": {
 			AdvancedCount: AdvancedCount{
 				Cooldown:     mockCooldown,
 				workloadType: manifestinfo.WorkerServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage" or "queue_delay" if "cooldown" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "queue_delay", "custom_metrics" or "schedules" if "cooldown" is specified`),
 		},
 		"error if range is missing when autoscaling fields are set for Backend Service": {
 			AdvancedCount: AdvancedCount{
 				CPU:          mockConfig,
 				workloadType: manifestinfo.BackendServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests" or "response_time" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "custom_metrics" or "schedules" are specified`),
 		},
 		"error if range is missing when autoscaling fields are set for Worker Service": {
 			AdvancedCount: AdvancedCount{
 				CPU:          mockConfig,
 				workloadType: manifestinfo.WorkerServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage" or "queue_delay" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "queue_delay", "custom_metrics" or "schedules" are specified`),
 		},
 		"wrap error from queue_delay on failure": {
 			AdvancedCount: AdvancedCount{
@@ -2465,6 +3580,197 @@ func TestAdvancedCount_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "memory_percentage": `,
 		},
+		"valid with custom metrics and schedules": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				CustomMetrics: []CustomMetricScaling{
+					{
+						Namespace: aws.String("AWS/SQS"),
+						Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+						Dimensions: map[string]string{
+							"QueueName": "jobs",
+						},
+						Statistic: aws.String("Sum"),
+						Value:     aws.Float64(100),
+					},
+				},
+				Schedules: []ScheduledScaling{
+					{
+						Schedule: aws.String("0 9 * * 1-5"),
+						Range: Range{
+							RangeConfig: RangeConfig{
+								Min: aws.Int(5),
+								Max: aws.Int(10),
+							},
+						},
+					},
+				},
+				workloadType: manifestinfo.WorkerServiceType,
+			},
+		},
+		"error if a custom metric is missing its value": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				CustomMetrics: []CustomMetricScaling{
+					{
+						Namespace: aws.String("AWS/SQS"),
+						Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+					},
+				},
+				workloadType: manifestinfo.BackendServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "custom_metrics[0]": "value" must be specified`),
+		},
+		"error if a custom metric has an invalid statistic": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				CustomMetrics: []CustomMetricScaling{
+					{
+						Namespace: aws.String("AWS/SQS"),
+						Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+						Statistic: aws.String("p99"),
+						Value:     aws.Float64(100),
+					},
+				},
+				workloadType: manifestinfo.BackendServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "custom_metrics[0]": invalid statistic "p99": must be one of Average, Minimum, Maximum, SampleCount or Sum`),
+		},
+		"error if a schedule is missing its range": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Schedules: []ScheduledScaling{
+					{
+						Schedule: aws.String("@daily"),
+					},
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "schedules[0]": "range" must be specified`),
+		},
+		"error if a schedule has both a schedule and a calendar": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Schedules: []ScheduledScaling{
+					{
+						Schedule: aws.String("@daily"),
+						Calendar: ScalingCalendar{
+							File: aws.String("holidays.ics"),
+						},
+						Range: Range{
+							Value: (*IntRangeBand)(stringP("10-50")),
+						},
+					},
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "schedules[0]": must specify one, not both, of "schedule" and "calendar"`),
+		},
+		"error if a calendar has both a file and an SSM document": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Schedules: []ScheduledScaling{
+					{
+						Calendar: ScalingCalendar{
+							File:        aws.String("holidays.ics"),
+							SSMDocument: aws.String("holidays"),
+						},
+						Range: Range{
+							Value: (*IntRangeBand)(stringP("10-50")),
+						},
+					},
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "schedules[0]": validate "calendar": must specify one, not both, of "file" and "ssm_document"`),
+		},
+		"error if a calendar has an empty file": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Schedules: []ScheduledScaling{
+					{
+						Calendar: ScalingCalendar{
+							File: aws.String(""),
+						},
+						Range: Range{
+							Value: (*IntRangeBand)(stringP("10-50")),
+						},
+					},
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "schedules[0]": validate "calendar": must specify one of "file" and "ssm_document"`),
+		},
+		"valid with a calendar": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Schedules: []ScheduledScaling{
+					{
+						Calendar: ScalingCalendar{
+							SSMDocument: aws.String("holidays"),
+						},
+						Range: Range{
+							Value: (*IntRangeBand)(stringP("10-50")),
+						},
+					},
+				},
+				workloadType: manifestinfo.WorkerServiceType,
+			},
+		},
+		"error if the range of a schedule uses spot": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Schedules: []ScheduledScaling{
+					{
+						Schedule: aws.String("@daily"),
+						Range: Range{
+							RangeConfig: RangeConfig{
+								Min:      aws.Int(1),
+								Max:      aws.Int(10),
+								SpotFrom: aws.Int(2),
+							},
+						},
+					},
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "schedules[0]": "spot_from" cannot be specified in the range of a schedule`),
+		},
+		"error if the range of a schedule is invalid": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Schedules: []ScheduledScaling{
+					{
+						Schedule: aws.String("@daily"),
+						Range: Range{
+							Value: (*IntRangeBand)(stringP("5-2")),
+						},
+					},
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedErrorMsgPrefix: `validate "schedules[0]": validate "range": `,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2833,6 +4139,17 @@ func TestSidecarConfig_validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "image": `,
 		},
+		"error if build-only variables are set": {
+			config: SidecarConfig{
+				Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")),
+				Variables: map[string]Variable{
+					"build_only": {
+						BuildOnly: map[string]BuildArg{"VERSION": {Plain: aws.String("3.x")}},
+					},
+				},
+			},
+			wantedErrorPrefix: `"variables.build_only" is only supported for the main container`,
+		},
 		"error if fail to validate mount_points": {
 			config: SidecarConfig{
 				Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")),
@@ -2895,13 +4212,73 @@ func TestSidecarMountPoint_validate(t *testing.T) {
 	}
 }
 
-func TestMountPointOpts_validate(t *testing.T) {
+func TestSecret_validate(t *testing.T) {
 	testCases := map[string]struct {
-		in     MountPointOpts
+		in     Secret
 		wanted error
 	}{
-		"should return an error if path is not set": {
-			in:     MountPointOpts{},
+		"valid SSM parameter name": {
+			in: Secret{from: StringOrFromCFN{Plain: aws.String("/copilot/app/env/secrets/db_password")}},
+		},
+		"valid SecretsManager ARN": {
+			in: Secret{from: StringOrFromCFN{Plain: aws.String("arn:aws:secretsmanager:us-west-2:111122223333:secret:mysql-1a2b3c")}},
+		},
+		"valid SecretsManager ARN with a JSON key": {
+			in: Secret{from: StringOrFromCFN{Plain: aws.String("arn:aws:secretsmanager:us-west-2:111122223333:secret:mysql-1a2b3c:username::")}},
+		},
+		"error if a SecretsManager ARN has incomplete key specifiers": {
+			in:     Secret{from: StringOrFromCFN{Plain: aws.String("arn:aws:secretsmanager:us-west-2:111122223333:secret:mysql-1a2b3c:username")}},
+			wanted: errors.New("secret mysql-1a2b3c:username must be in the format <name>:<json-key>:<version-stage>:<version-id>, with empty values for unused specifiers"),
+		},
+		"error if a SecretsManager ARN specifies both a version stage and a version ID": {
+			in:     Secret{from: StringOrFromCFN{Plain: aws.String("arn:aws:secretsmanager:us-west-2:111122223333:secret:mysql-1a2b3c:username:AWSCURRENT:1234")}},
+			wanted: errors.New("secret mysql-1a2b3c:username:AWSCURRENT:1234 cannot specify both a version stage and a version ID"),
+		},
+		"valid SecretsManager name with a JSON key": {
+			in: Secret{fromSecretsManager: secretsManagerSecret{
+				Name:        aws.String("demo/test/mysql"),
+				FromJSONKey: aws.String("username"),
+			}},
+		},
+		"error if from_json_key is specified without secretsmanager": {
+			in:     Secret{fromSecretsManager: secretsManagerSecret{FromJSONKey: aws.String("username")}},
+			wanted: errors.New(`"secretsmanager" must be specified if "from_json_key" is specified`),
+		},
+		"error if from_json_key is specified with a secretsmanager name that references a JSON key": {
+			in: Secret{fromSecretsManager: secretsManagerSecret{
+				Name:        aws.String("demo/test/mysql:password::"),
+				FromJSONKey: aws.String("username"),
+			}},
+			wanted: errors.New(`"secretsmanager" cannot reference a JSON key if "from_json_key" is specified`),
+		},
+		"error if from_json_key is empty": {
+			in: Secret{fromSecretsManager: secretsManagerSecret{
+				Name:        aws.String("demo/test/mysql"),
+				FromJSONKey: aws.String(""),
+			}},
+			wanted: errors.New(`"from_json_key" must be a non-empty string without colons`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMountPointOpts_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     MountPointOpts
+		wanted error
+	}{
+		"should return an error if path is not set": {
+			in:     MountPointOpts{},
 			wanted: errors.New(`"path" must be specified`),
 		},
 		"should return an error if path is invalid": {
@@ -3155,10 +4532,139 @@ func TestObservability_validate(t *testing.T) {
 				Tracing: aws.String("awsxray"),
 			},
 		},
+		"error if the endpoint is missing with otlp": {
+			config: Observability{
+				Tracing: aws.String("otlp"),
+			},
+			wantedErrorPrefix: `"endpoint" must be specified with the tracing vendor "otlp"`,
+		},
+		"error if the endpoint is specified with aws-xray": {
+			config: Observability{
+				Tracing:  aws.String("awsxray"),
+				Endpoint: aws.String("https://otlp.example.com:4318"),
+			},
+			wantedErrorPrefix: `"endpoint" can only be specified with the tracing vendor "otlp"`,
+		},
+		"ok if tracing is otlp with an endpoint": {
+			config: Observability{
+				Tracing:  aws.String("otlp"),
+				Endpoint: aws.String("https://otlp.example.com:4318"),
+			},
+		},
+		"ok if observability is empty": {
+			config: Observability{},
+		},
+		"ok with anomaly detection only": {
+			config: Observability{
+				AnomalyDetection: []string{"latency", "errors"},
+			},
+		},
+		"error if anomaly detection has an invalid metric": {
+			config: Observability{
+				AnomalyDetection: []string{"latency", "cpu"},
+			},
+			wantedErrorPrefix: `invalid "anomaly_detection" metric "cpu": valid metrics are latency and errors`,
+		},
+		"error if anomaly detection has a duplicated metric": {
+			config: Observability{
+				AnomalyDetection: []string{"errors", "errors"},
+			},
+			wantedErrorPrefix: `"anomaly_detection" metric "errors" is specified more than once`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validate()
+
+			if tc.wantedErrorPrefix != "" {
+				require.NotNil(t, gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantedErrorPrefix)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestObservability_validateAppRunner(t *testing.T) {
+	testCases := map[string]struct {
+		config            Observability
+		wantedErrorPrefix string
+	}{
+		"error if an endpoint is specified": {
+			config: Observability{
+				Tracing:  aws.String("otlp"),
+				Endpoint: aws.String("https://otlp.example.com:4318"),
+			},
+			wantedErrorPrefix: `"endpoint" is not supported for Request-Driven Web Service`,
+		},
+		"error if tracing has a vendor that App Runner doesn't support": {
+			config: Observability{
+				Tracing: aws.String("otlp"),
+			},
+			wantedErrorPrefix: `invalid tracing vendor otlp: the valid vendor is awsxray`,
+		},
+		"error if anomaly detection is specified": {
+			config: Observability{
+				AnomalyDetection: []string{"latency"},
+			},
+			wantedErrorPrefix: `"anomaly_detection" is not supported for Request-Driven Web Service`,
+		},
+		"ok if tracing is aws-xray": {
+			config: Observability{
+				Tracing: aws.String("awsxray"),
+			},
+		},
 		"ok if observability is empty": {
 			config: Observability{},
 		},
 	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validateAppRunner()
+
+			if tc.wantedErrorPrefix != "" {
+				require.NotNil(t, gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantedErrorPrefix)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestStackSettings_validate(t *testing.T) {
+	testCases := map[string]struct {
+		config            StackSettings
+		wantedErrorPrefix string
+	}{
+		"error if the policy has no statement": {
+			config: StackSettings{
+				Policy: map[string]interface{}{
+					"Statements": []interface{}{},
+				},
+			},
+			wantedErrorPrefix: `"policy.Statement" must be specified`,
+		},
+		"ok if the policy has statements": {
+			config: StackSettings{
+				TerminationProtection: aws.Bool(true),
+				Policy: map[string]interface{}{
+					"Statement": []interface{}{
+						map[string]interface{}{
+							"Effect":    "Deny",
+							"Action":    "Update:Replace",
+							"Principal": "*",
+							"Resource":  "LogicalResourceId/Database",
+						},
+					},
+				},
+			},
+		},
+		"ok if the stack settings are empty": {
+			config: StackSettings{},
+		},
+	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			gotErr := tc.config.validate()
@@ -3315,6 +4821,118 @@ func TestSubscribeConfig_validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "topics[0]": `,
 		},
+		"valid consumer": {
+			config: SubscribeConfig{
+				Queue: SQSQueue{
+					Timeout: durationp(5 * time.Minute),
+				},
+				Consumer: SQSConsumer{
+					MaxConcurrentMessages: aws.Int(20),
+					BatchSize:             aws.Int(10),
+					ProcessingTimeout:     durationp(4 * time.Minute),
+					PartialBatchFailures:  aws.Bool(true),
+				},
+			},
+		},
+		"valid FIFO consumer": {
+			config: SubscribeConfig{
+				Queue: SQSQueue{
+					FIFO: FIFOAdvanceConfigOrBool{
+						Enable: aws.Bool(true),
+					},
+				},
+				Consumer: SQSConsumer{
+					MaxConcurrentMessages: aws.Int(1),
+					MessageGroupStrategy:  aws.String("sequential"),
+					DeduplicationTable: DeduplicationTableOrBool{
+						Advanced: DeduplicationTable{
+							TTL: durationp(time.Hour),
+						},
+					},
+				},
+			},
+		},
+		"error if the message group strategy is set without a FIFO queue": {
+			config: SubscribeConfig{
+				Consumer: SQSConsumer{
+					MessageGroupStrategy: aws.String("concurrent"),
+				},
+			},
+			wantedErrorPrefix: `"consumer.message_group_strategy" requires "queue.fifo" to be enabled`,
+		},
+		"error if the message group strategy is invalid": {
+			config: SubscribeConfig{
+				Consumer: SQSConsumer{
+					MessageGroupStrategy: aws.String("random"),
+				},
+			},
+			wantedErrorPrefix: `validate "consumer": "message_group_strategy" "random" must be one of "concurrent" or "sequential"`,
+		},
+		"error if messages are processed concurrently with the sequential strategy": {
+			config: SubscribeConfig{
+				Consumer: SQSConsumer{
+					MaxConcurrentMessages: aws.Int(5),
+					MessageGroupStrategy:  aws.String("sequential"),
+				},
+			},
+			wantedErrorPrefix: `validate "consumer": "max_concurrent_messages" 5 must be 1 when "message_group_strategy" is "sequential"`,
+		},
+		"error if the deduplication table TTL is too short": {
+			config: SubscribeConfig{
+				Consumer: SQSConsumer{
+					DeduplicationTable: DeduplicationTableOrBool{
+						Advanced: DeduplicationTable{
+							TTL: durationp(time.Minute),
+						},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "consumer": validate "deduplication_table": "ttl" 1m0s must be at least 5m0s`,
+		},
+		"error if the batch size is greater than the SQS limit": {
+			config: SubscribeConfig{
+				Consumer: SQSConsumer{
+					BatchSize: aws.Int(20),
+				},
+			},
+			wantedErrorPrefix: `validate "consumer": "batch_size" 20 must be between 1 and 10`,
+		},
+		"error if the batch size is greater than the concurrency": {
+			config: SubscribeConfig{
+				Consumer: SQSConsumer{
+					MaxConcurrentMessages: aws.Int(5),
+					BatchSize:             aws.Int(10),
+				},
+			},
+			wantedErrorPrefix: `validate "consumer": "batch_size" 10 must not be greater than "max_concurrent_messages" 5`,
+		},
+		"error if the concurrency is not positive": {
+			config: SubscribeConfig{
+				Consumer: SQSConsumer{
+					MaxConcurrentMessages: aws.Int(0),
+				},
+			},
+			wantedErrorPrefix: `validate "consumer": "max_concurrent_messages" 0 must be at least 1`,
+		},
+		"error if messages are processed for longer than the default visibility timeout": {
+			config: SubscribeConfig{
+				Consumer: SQSConsumer{
+					ProcessingTimeout: durationp(time.Minute),
+				},
+			},
+			wantedErrorPrefix: `"consumer.processing_timeout" 1m0s must be less than "queue.timeout" 30s, the visibility timeout of the messages`,
+		},
+		"error if messages are processed for as long as the visibility timeout of the queue": {
+			config: SubscribeConfig{
+				Queue: SQSQueue{
+					Timeout: durationp(2 * time.Minute),
+				},
+				Consumer: SQSConsumer{
+					ProcessingTimeout: durationp(2 * time.Minute),
+				},
+			},
+			wantedErrorPrefix: `"consumer.processing_timeout" 2m0s must be less than "queue.timeout" 2m0s, the visibility timeout of the messages`,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -3924,6 +5542,67 @@ func TestDeploymentConfig_validate(t *testing.T) {
 			deployConfig: DeploymentConfig{
 				RollbackAlarms: BasicToUnion[[]string, AlarmArgs]([]string{"alarmName"})},
 		},
+		"error if both rolling and a CodeDeploy strategy are specified": {
+			deployConfig: DeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{
+					Rolling: aws.String("default"),
+				},
+				Strategy: DeploymentStrategy{Type: aws.String("blue-green")},
+			},
+			wanted: `must specify one, not both, of "rolling" and "strategy"`,
+		},
+		"error if the strategy has no type": {
+			deployConfig: DeploymentConfig{
+				Strategy: DeploymentStrategy{BakeTime: durationp(5 * time.Minute)},
+			},
+			wanted: `validate "strategy": "type" must be specified`,
+		},
+		"error if the strategy is unknown": {
+			deployConfig: DeploymentConfig{
+				Strategy: DeploymentStrategy{Type: aws.String("linear")},
+			},
+			wanted: `invalid deployment strategy "linear", must be one of blue-green or canary`,
+		},
+		"error if canary is configured for a blue-green deployment": {
+			deployConfig: DeploymentConfig{
+				Strategy: DeploymentStrategy{
+					Type:   aws.String("blue-green"),
+					Canary: CanaryConfig{Percent: aws.Int(10)},
+				},
+			},
+			wanted: `"canary" can only be specified with the "canary" strategy`,
+		},
+		"error if the canary percent is out of range": {
+			deployConfig: DeploymentConfig{
+				Strategy: DeploymentStrategy{
+					Type:   aws.String("canary"),
+					Canary: CanaryConfig{Percent: aws.Int(100)},
+				},
+			},
+			wanted: `"percent" must be between 1 and 99, got 100`,
+		},
+		"error if the bake time is not a number of minutes": {
+			deployConfig: DeploymentConfig{
+				Strategy: DeploymentStrategy{
+					Type:     aws.String("blue-green"),
+					BakeTime: durationp(90 * time.Second),
+				},
+			},
+			wanted: `validate "bake_time": duration 1m30s must be a whole number of minutes`,
+		},
+		"ok if the canary deployment is configured": {
+			deployConfig: DeploymentConfig{
+				Strategy: DeploymentStrategy{
+					Type: aws.String("canary"),
+					Canary: CanaryConfig{
+						Percent:  aws.Int(10),
+						Interval: durationp(5 * time.Minute),
+					},
+					BakeTime: durationp(10 * time.Minute),
+				},
+				RollbackAlarms: BasicToUnion[[]string, AlarmArgs]([]string{"HighLatency"}),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -4278,6 +5957,247 @@ func TestValidateExposedPorts(t *testing.T) {
 	}
 }
 
+func TestHealthCheckPortWarnings(t *testing.T) {
+	healthCheckOn := func(port int) HealthCheckArgsOrString {
+		return HealthCheckArgsOrString{
+			Union: AdvancedToUnion[string](HTTPHealthCheckArgs{
+				Path: aws.String("/healthz"),
+				Port: aws.Int(port),
+			}),
+		}
+	}
+	testCases := map[string]struct {
+		in     validateExposedPortsOpts
+		wanted []string
+	}{
+		"no warning without a health check port": {
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				mainContainerPort: aws.Uint16(8080),
+				alb: &HTTP{
+					Main: RoutingRule{
+						Path:        aws.String("/"),
+						HealthCheck: HealthCheckArgsOrString{Union: BasicToUnion[string, HTTPHealthCheckArgs]("/healthz")},
+					},
+				},
+			},
+		},
+		"no warning if the health check port is the port of the main container": {
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				mainContainerPort: aws.Uint16(8080),
+				alb: &HTTP{
+					Main: RoutingRule{
+						Path:        aws.String("/"),
+						HealthCheck: healthCheckOn(8080),
+					},
+				},
+			},
+		},
+		"no warning if the health check port is exposed by a sidecar": {
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				mainContainerPort: aws.Uint16(8080),
+				sidecarConfig: map[string]*SidecarConfig{
+					"envoy": {
+						Port: aws.String("9901"),
+					},
+				},
+				alb: &HTTP{
+					Main: RoutingRule{
+						Path:        aws.String("/"),
+						HealthCheck: healthCheckOn(9901),
+					},
+				},
+			},
+		},
+		"no warning if the health check port is the target port of a rule": {
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				mainContainerPort: aws.Uint16(8080),
+				alb: &HTTP{
+					Main: RoutingRule{
+						Path:        aws.String("/"),
+						TargetPort:  aws.Uint16(8081),
+						HealthCheck: healthCheckOn(8081),
+					},
+				},
+			},
+		},
+		"warn if the health check port of the main rule isn't exposed": {
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				mainContainerPort: aws.Uint16(8080),
+				alb: &HTTP{
+					Main: RoutingRule{
+						Path:        aws.String("/"),
+						HealthCheck: healthCheckOn(8081),
+					},
+				},
+			},
+			wanted: []string{
+				`"http.healthcheck" checks the health of the targets on port 8081, which no container exposes: make sure a container listens on it, or expose it with "image.port", "sidecars.port" or "target_port"`,
+			},
+		},
+		"no warning if the health check port of the nlb is the port of its listener": {
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				mainContainerPort: aws.Uint16(8080),
+				nlb: &NetworkLoadBalancerConfiguration{
+					Listener: NetworkLoadBalancerListener{
+						Port: aws.String("443/tls"),
+						HealthCheck: NLBHealthCheckArgs{
+							Port: aws.Int(443),
+						},
+					},
+				},
+			},
+		},
+		"warn if the health check port of an additional nlb listener isn't exposed": {
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				mainContainerPort: aws.Uint16(8080),
+				nlb: &NetworkLoadBalancerConfiguration{
+					Listener: NetworkLoadBalancerListener{
+						Port: aws.String("443/tls"),
+					},
+					AdditionalListeners: []NetworkLoadBalancerListener{
+						{
+							Port:       aws.String("8443/tls"),
+							TargetPort: aws.Int(8080),
+							HealthCheck: NLBHealthCheckArgs{
+								Port: aws.Int(8444),
+							},
+						},
+					},
+				},
+			},
+			wanted: []string{
+				`"nlb.additional_listeners[0].healthcheck" checks the health of the targets on port 8444, which no container exposes: make sure a container listens on it, or expose it with "image.port", "sidecars.port" or "target_port"`,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, healthCheckPortWarnings(tc.in))
+		})
+	}
+}
+
+func TestValidateServiceConnectPort(t *testing.T) {
+	alias := ServiceConnectBoolOrArgs{
+		ServiceConnectArgs: ServiceConnectArgs{
+			Alias: aws.String("api"),
+		},
+	}
+	testCases := map[string]struct {
+		connect ServiceConnectBoolOrArgs
+		in      validateExposedPortsOpts
+		wanted  error
+	}{
+		"no error without an alias": {
+			connect: ServiceConnectBoolOrArgs{EnableServiceConnect: aws.Bool(true)},
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+			},
+		},
+		"no error if the main container exposes a port": {
+			connect: alias,
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				mainContainerPort: aws.Uint16(8080),
+			},
+		},
+		"no error if the target container exposes a port over TCP": {
+			connect: alias,
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				sidecarConfig: map[string]*SidecarConfig{
+					"nginx": {
+						Port: aws.String("80/tcp"),
+					},
+				},
+				alb: &HTTP{
+					Main: RoutingRule{
+						Path:            aws.String("/"),
+						TargetContainer: aws.String("nginx"),
+					},
+				},
+			},
+		},
+		"no error if the target port is another port than the one the target container exposes over UDP": {
+			connect: alias,
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				sidecarConfig: map[string]*SidecarConfig{
+					"dns": {
+						Port: aws.String("53/udp"),
+					},
+				},
+				alb: &HTTP{
+					Main: RoutingRule{
+						Path:            aws.String("/"),
+						TargetContainer: aws.String("dns"),
+						TargetPort:      aws.Uint16(8053),
+					},
+				},
+			},
+		},
+		"error if no container exposes a port": {
+			connect: alias,
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+			},
+			wanted: errors.New(`"alias" requires target container "api" to expose a port`),
+		},
+		"error if the target container doesn't expose a port even though the main container does": {
+			connect: alias,
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				mainContainerPort: aws.Uint16(8080),
+				sidecarConfig: map[string]*SidecarConfig{
+					"envoy": {},
+				},
+				alb: &HTTP{
+					Main: RoutingRule{
+						Path:            aws.String("/"),
+						TargetContainer: aws.String("envoy"),
+					},
+				},
+			},
+			wanted: errors.New(`"alias" requires target container "envoy" to expose a port`),
+		},
+		"error if the target container exposes the port over UDP": {
+			connect: alias,
+			in: validateExposedPortsOpts{
+				mainContainerName: "api",
+				sidecarConfig: map[string]*SidecarConfig{
+					"dns": {
+						Port: aws.String("53/udp"),
+					},
+				},
+				alb: &HTTP{
+					Main: RoutingRule{
+						Path:            aws.String("/"),
+						TargetContainer: aws.String("dns"),
+					},
+				},
+			},
+			wanted: errors.New(`"alias" requires target container "dns" to expose port 53 over TCP, not UDP`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateServiceConnectPort(tc.connect, tc.in)
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestImageLocationOrBuild_validate(t *testing.T) {
 	testCases := map[string]struct {
 		in          ImageLocationOrBuild
@@ -4300,6 +6220,193 @@ func TestImageLocationOrBuild_validate(t *testing.T) {
 				Location: aws.String("mockLocation"),
 			},
 		},
+		"should return error if a SOCI index is requested for an existing image": {
+			in: ImageLocationOrBuild{
+				Location: aws.String("mockLocation"),
+				SOCI:     aws.Bool(true),
+			},
+			wantedError: fmt.Errorf(`"build" must be specified if "soci" is specified`),
+		},
+		"should return error if the paths to sync are missing": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{BuildString: aws.String("web/Dockerfile")},
+				Sync: ImageSync{
+					Signal: aws.String("SIGHUP"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "sync": "paths" must be specified`),
+		},
+		"should return error if the target of a path to sync is missing": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{BuildString: aws.String("web/Dockerfile")},
+				Sync: ImageSync{
+					Paths: []SyncPath{
+						{Source: aws.String("src")},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "sync": validate "paths[0]": "target" must be specified`),
+		},
+		"should return error if the target of a path to sync is relative": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{BuildString: aws.String("web/Dockerfile")},
+				Sync: ImageSync{
+					Paths: []SyncPath{
+						{Source: aws.String("src"), Target: aws.String("/app/src")},
+						{Source: aws.String("static"), Target: aws.String("app/static")},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "sync": validate "paths[1]": "target" "app/static" must be an absolute path`),
+		},
+		"should return error if an exclude pattern is invalid": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{BuildString: aws.String("web/Dockerfile")},
+				Sync: ImageSync{
+					Paths: []SyncPath{
+						{Source: aws.String("src"), Target: aws.String("/app/src"), Exclude: []string{"[a-"}},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "sync": validate "paths[0]": "exclude" pattern "[a-" is invalid: syntax error in pattern`),
+		},
+		"should return error if the signal is invalid": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{BuildString: aws.String("web/Dockerfile")},
+				Sync: ImageSync{
+					Paths: []SyncPath{
+						{Source: aws.String("src"), Target: aws.String("/app/src")},
+					},
+					Signal: aws.String("reload"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "sync": "signal" "reload" must be the name of a signal, such as "SIGHUP", or its number`),
+		},
+		"return nil if the files to sync are configured": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{BuildString: aws.String("web/Dockerfile")},
+				Sync: ImageSync{
+					Paths: []SyncPath{
+						{Source: aws.String("src"), Target: aws.String("/app/src"), Exclude: []string{"*.pyc", "__pycache__"}},
+					},
+					Signal: aws.String("SIGHUP"),
+				},
+			},
+		},
+		"should return error if both dockerfile and buildpack are specified": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Dockerfile: aws.String("web/Dockerfile"),
+						Buildpack: BuildpackArgs{
+							Builder: aws.String("paketobuildpacks/builder-jammy-base"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "build": must specify one, not both, of "dockerfile" and "buildpack"`),
+		},
+		"should return error if both cache_from and buildpack are specified": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						CacheFrom: []string{"foo/bar:latest"},
+						Buildpack: BuildpackArgs{
+							Builder: aws.String("paketobuildpacks/builder-jammy-base"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "build": must specify one, not both, of "cache_from" and "buildpack"`),
+		},
+		"should return error if both secrets and buildpack are specified": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Secrets: map[string]BuildSecret{
+							"npmrc": {FromFile: aws.String(".npmrc")},
+						},
+						Buildpack: BuildpackArgs{
+							Builder: aws.String("paketobuildpacks/builder-jammy-base"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "build": must specify one, not both, of "secrets" and "buildpack"`),
+		},
+		"should return error if both ssh and buildpack are specified": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						SSH: []string{"default"},
+						Buildpack: BuildpackArgs{
+							Builder: aws.String("paketobuildpacks/builder-jammy-base"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "build": must specify one, not both, of "ssh" and "buildpack"`),
+		},
+		"should return error if a build arg has several sources": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Args: map[string]BuildArg{
+							"TOKEN": {FromEnv: aws.String("TOKEN"), FromSSM: aws.String("/token")},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "build": validate "args[TOKEN]": must specify one, not both, of "from_env" and "from_ssm"`),
+		},
+		"should return error if a build secret has no source": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Secrets: map[string]BuildSecret{
+							"npmrc": {},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "build": validate "secrets[npmrc]": must specify at least one of "from_env", "from_file" or "from_ssm"`),
+		},
+		"should return error if a build secret has several sources": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Secrets: map[string]BuildSecret{
+							"npmrc": {FromEnv: aws.String("NPMRC"), FromFile: aws.String(".npmrc")},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "build": validate "secrets[npmrc]": must specify one, not both, of "from_env" and "from_file"`),
+		},
+		"should return error if the builder of the buildpack is missing": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Buildpack: BuildpackArgs{
+							Buildpacks: []string{"paketo-buildpacks/nodejs"},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "build": validate "buildpack": "builder" must be specified`),
+		},
+		"return nil if only buildpack is specified": {
+			in: ImageLocationOrBuild{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Context: aws.String("web"),
+						Buildpack: BuildpackArgs{
+							Builder: aws.String("paketobuildpacks/builder-jammy-base"),
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
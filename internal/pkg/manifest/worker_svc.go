@@ -20,8 +20,9 @@ const (
 )
 
 var (
-	errUnmarshalQueueOpts  = errors.New(`cannot unmarshal "queue" field into bool or map`)
-	errUnmarshalFifoConfig = errors.New(`unable to unmarshal "fifo" field into boolean or compose-style map`)
+	errUnmarshalQueueOpts          = errors.New(`cannot unmarshal "queue" field into bool or map`)
+	errUnmarshalFifoConfig         = errors.New(`unable to unmarshal "fifo" field into boolean or compose-style map`)
+	errUnmarshalDeduplicationTable = errors.New(`cannot unmarshal "deduplication_table" field into bool or map`)
 )
 
 // WorkerService holds the configuration to create a worker service.
@@ -38,6 +39,11 @@ func (s *WorkerService) Publish() []Topic {
 	return s.WorkerServiceConfig.PublishConfig.publishedTopics()
 }
 
+// StackSettings returns the protections of the CloudFormation stack of the service.
+func (s *WorkerService) StackSettings() StackSettings {
+	return s.Stack
+}
+
 func (s *WorkerService) subnets() *SubnetListOrArgs {
 	return &s.Network.VPC.Placement.Subnets
 }
@@ -55,17 +61,83 @@ type WorkerServiceConfig struct {
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
 	DeployConfig     WorkerDeploymentConfig    `yaml:"deployment"`
 	Observability    Observability             `yaml:"observability"`
+	Stack            StackSettings             `yaml:"stack"`
 }
 
 // SubscribeConfig represents the configurable options for setting up subscriptions.
 type SubscribeConfig struct {
-	Topics []TopicSubscription `yaml:"topics"`
-	Queue  SQSQueue            `yaml:"queue"`
+	Topics   []TopicSubscription `yaml:"topics"`
+	Queue    SQSQueue            `yaml:"queue"`
+	Consumer SQSConsumer         `yaml:"consumer"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (s *SubscribeConfig) IsEmpty() bool {
-	return s.Topics == nil && s.Queue.IsEmpty()
+	return s.Topics == nil && s.Queue.IsEmpty() && s.Consumer.IsEmpty()
+}
+
+// SQSConsumer represents how each task of the service consumes the messages of the events queue.
+// The settings are injected as environment variables for the code of the service to read.
+type SQSConsumer struct {
+	MaxConcurrentMessages *int                     `yaml:"max_concurrent_messages"`
+	BatchSize             *int                     `yaml:"batch_size"`
+	ProcessingTimeout     *time.Duration           `yaml:"processing_timeout"`
+	PartialBatchFailures  *bool                    `yaml:"partial_batch_failures"`
+	MessageGroupStrategy  *string                  `yaml:"message_group_strategy"`
+	DeduplicationTable    DeduplicationTableOrBool `yaml:"deduplication_table"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (c *SQSConsumer) IsEmpty() bool {
+	return c.MaxConcurrentMessages == nil && c.BatchSize == nil && c.ProcessingTimeout == nil && c.PartialBatchFailures == nil &&
+		c.MessageGroupStrategy == nil && c.DeduplicationTable.IsEmpty()
+}
+
+// DeduplicationTableOrBool is a custom type which supports unmarshaling yaml which
+// can either be of type bool or type DeduplicationTable.
+type DeduplicationTableOrBool struct {
+	Enabled  *bool
+	Advanced DeduplicationTable
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (t *DeduplicationTableOrBool) IsEmpty() bool {
+	return t.Enabled == nil && t.Advanced.IsEmpty()
+}
+
+// IsEnabled returns true if the table is enabled either with a boolean or with its advanced configuration.
+func (t *DeduplicationTableOrBool) IsEnabled() bool {
+	return aws.BoolValue(t.Enabled) || !t.Advanced.IsEmpty()
+}
+
+// UnmarshalYAML implements the yaml(v3) interface. It allows DeduplicationTableOrBool to be specified as a
+// bool or a struct alternately.
+func (t *DeduplicationTableOrBool) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode(&t.Advanced); err != nil {
+		var yamlTypeErr *yaml.TypeError
+		if !errors.As(err, &yamlTypeErr) {
+			return err
+		}
+	}
+	if !t.Advanced.IsEmpty() {
+		// Unmarshaled successfully to t.Advanced, unset t.Enabled, and return.
+		t.Enabled = nil
+		return nil
+	}
+	if err := value.Decode(&t.Enabled); err != nil {
+		return errUnmarshalDeduplicationTable
+	}
+	return nil
+}
+
+// DeduplicationTable represents the configurable options of the DynamoDB table that records the processed messages.
+type DeduplicationTable struct {
+	TTL *time.Duration `yaml:"ttl"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (t *DeduplicationTable) IsEmpty() bool {
+	return t.TTL == nil
 }
 
 // TopicSubscription represents the configurable options for setting up a SNS Topic Subscription.
@@ -280,11 +352,23 @@ func (s *WorkerService) BuildArgs(contextDir string) (map[string]*DockerBuildArg
 	// Creating an map to store buildArgs of all sidecar images and main container image.
 	buildArgsPerContainer := make(map[string]*DockerBuildArgs, len(s.Sidecars)+1)
 	if required {
-		buildArgsPerContainer[aws.StringValue(s.Name)] = s.ImageConfig.Image.BuildConfig(contextDir)
+		buildArgsPerContainer[aws.StringValue(s.Name)] = withBuildOnlyVariables(s.ImageConfig.Image.BuildConfig(contextDir), s.TaskConfig.Variables)
 	}
 	return buildArgs(contextDir, buildArgsPerContainer, s.Sidecars)
 }
 
+// ImageLocations returns the locations of the existing images that the containers of the service run.
+// The keys of the map are container names, and the values are image URIs.
+func (s *WorkerService) ImageLocations() map[string]string {
+	return imageLocations(s.Name, s.ImageConfig.Image, s.Sidecars)
+}
+
+// FileSyncs returns the files of the workspace that are copied into the containers of the service run locally when they change.
+// The keys of the map are container names.
+func (s *WorkerService) FileSyncs() map[string]ImageSync {
+	return fileSyncs(s.Name, s.ImageConfig.Image, s.Sidecars)
+}
+
 // EnvFiles returns the locations of all env files against the ws root directory.
 // This method returns a map[string]string where the keys are container names
 // and the values are either env file paths or empty strings.
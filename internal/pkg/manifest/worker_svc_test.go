@@ -521,7 +521,7 @@ func TestWorkerSvc_ApplyEnv(t *testing.T) {
 					CPU: aws.Int(512),
 					Variables: map[string]Variable{
 						"LOG_LEVEL": {
-							StringOrFromCFN{
+							StringOrFromCFN: StringOrFromCFN{
 								Plain: stringP(""),
 							},
 						},
@@ -915,7 +915,7 @@ func TestWorkerSvc_ApplyEnv(t *testing.T) {
 						},
 						Variables: map[string]Variable{
 							"LOG_LEVEL": {
-								StringOrFromCFN{
+								StringOrFromCFN: StringOrFromCFN{
 									Plain: stringP(""),
 								},
 							},
@@ -1496,6 +1496,50 @@ func TestSQSQueueOrBool_UnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestDeduplicationTableOrBool_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct DeduplicationTableOrBool
+		wantedError  error
+	}{
+		"with boolean": {
+			inContent: []byte(`deduplication_table: true`),
+
+			wantedStruct: DeduplicationTableOrBool{
+				Enabled: aws.Bool(true),
+			},
+		},
+		"with advanced case": {
+			inContent: []byte(`deduplication_table:
+  ttl: 72h`),
+
+			wantedStruct: DeduplicationTableOrBool{
+				Advanced: DeduplicationTable{
+					TTL: durationp(72 * time.Hour),
+				},
+			},
+		},
+		"invalid type": {
+			inContent: []byte(`deduplication_table: 10`),
+
+			wantedError: errUnmarshalDeduplicationTable,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var c SQSConsumer
+			err := yaml.Unmarshal(tc.inContent, &c)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStruct, c.DeduplicationTable)
+		})
+	}
+}
+
 func TestWorkerService_RequiredEnvironmentFeatures(t *testing.T) {
 	testCases := map[string]struct {
 		mft    func(svc *WorkerService)
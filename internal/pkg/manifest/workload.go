@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
@@ -33,6 +34,12 @@ const (
 	sqsDeduplicationScopeQueue              = "queue"
 )
 
+// Strategies to consume the message groups of a FIFO queue.
+const (
+	messageGroupStrategyConcurrent = "concurrent" // Messages of different groups are processed at once, each group in order.
+	messageGroupStrategySequential = "sequential" // Messages are processed one at a time, in the order of the queue.
+)
+
 // AWS VPC subnet placement options.
 const (
 	PublicSubnetPlacement  = PlacementString("public")
@@ -49,6 +56,7 @@ var (
 	ErrAppRunnerInvalidPlatformWindows = errors.New("Windows is not supported for App Runner services")
 
 	errUnmarshalBuildOpts          = errors.New("unable to unmarshal build field into string or compose-style map")
+	errUnmarshalBuildArg           = errors.New(`unable to unmarshal build arg into string or map with "from_env" or "from_ssm"`)
 	errUnmarshalPlatformOpts       = errors.New("unable to unmarshal platform field into string or compose-style map")
 	errUnmarshalSecurityGroupOpts  = errors.New(`unable to unmarshal "security_groups" field into slice of strings or compose-style map`)
 	errUnmarshalPlacementOpts      = errors.New("unable to unmarshal placement field into string or compose-style map")
@@ -131,12 +139,34 @@ type Image struct {
 	Credentials          *string           `yaml:"credentials"`     // ARN of the secret containing the private repository credentials.
 	DockerLabels         map[string]string `yaml:"labels,flow"`     // Apply Docker labels to the container at runtime.
 	DependsOn            DependsOn         `yaml:"depends_on,flow"` // Add any sidecar dependencies.
+
+	StopTimeout *time.Duration `yaml:"stop_timeout"` // Time to wait for the container to exit on its own after SIGTERM before it's killed.
 }
 
 // ImageLocationOrBuild represents the docker build arguments and location of the existing image.
 type ImageLocationOrBuild struct {
 	Build    BuildArgsOrString `yaml:"build"`    // Build an image from a Dockerfile.
 	Location *string           `yaml:"location"` // Use an existing image instead.
+	Sync     ImageSync         `yaml:"sync"`     // Copy changed files into the container run with "copilot run local --sync".
+
+	SOCI *bool `yaml:"soci"` // Push a Seekable OCI index of the built image so that Fargate lazy loads it.
+}
+
+// ImageSync represents the files of the workspace that are copied into the running container when they change.
+type ImageSync struct {
+	Paths  []SyncPath `yaml:"paths"`
+	Signal *string    `yaml:"signal"` // Signal sent to the main process of the container once the files are copied.
+}
+
+// SyncPath represents a file or directory of the workspace and where it's copied in the container.
+type SyncPath struct {
+	Source  *string  `yaml:"source"`  // Path relative to the workspace root.
+	Target  *string  `yaml:"target"`  // Absolute path in the container.
+	Exclude []string `yaml:"exclude"` // Patterns of the paths relative to the source that aren't copied.
+}
+
+func (s *ImageSync) isEmpty() bool {
+	return len(s.Paths) == 0 && s.Signal == nil
 }
 
 // DependsOn represents container dependency for a container.
@@ -166,7 +196,8 @@ func (i Image) GetLocation() string {
 }
 
 // BuildConfig populates a docker.BuildArguments struct from the fields available in the manifest.
-// Prefer the following hierarchy:
+// Images built with Cloud Native Buildpacks don't have a Dockerfile, and default their context to the ws root.
+// Otherwise, prefer the following hierarchy:
 // 1. Specific dockerfile, specific context
 // 2. Specific dockerfile, context = dockerfile dir
 // 3. "Dockerfile" located in context dir
@@ -174,6 +205,14 @@ func (i Image) GetLocation() string {
 func (i *ImageLocationOrBuild) BuildConfig(rootDirectory string) *DockerBuildArgs {
 	df := i.dockerfile()
 	ctx := i.context()
+	if !i.Build.BuildArgs.Buildpack.isEmpty() {
+		return &DockerBuildArgs{
+			Context:   aws.String(filepath.Join(rootDirectory, ctx)),
+			Args:      i.args(),
+			Buildpack: i.Build.BuildArgs.Buildpack,
+			SOCI:      i.SOCI,
+		}
+	}
 	dockerfile := aws.String(filepath.Join(rootDirectory, defaultDockerfileName))
 	context := aws.String(rootDirectory)
 
@@ -195,6 +234,10 @@ func (i *ImageLocationOrBuild) BuildConfig(rootDirectory string) *DockerBuildArg
 		Args:       i.args(),
 		Target:     i.target(),
 		CacheFrom:  i.cacheFrom(),
+		Secrets:    i.Build.BuildArgs.Secrets,
+		SSH:        i.Build.BuildArgs.SSH,
+		Network:    i.Build.BuildArgs.Network,
+		SOCI:       i.SOCI,
 	}
 }
 
@@ -222,7 +265,7 @@ func (i *ImageLocationOrBuild) context() string {
 
 // args returns the args section, if it exists, to override args in the dockerfile.
 // Otherwise it returns an empty map.
-func (i *ImageLocationOrBuild) args() map[string]string {
+func (i *ImageLocationOrBuild) args() map[string]BuildArg {
 	return i.Build.BuildArgs.Args
 }
 
@@ -392,20 +435,75 @@ func (b *BuildArgsOrString) UnmarshalYAML(value *yaml.Node) error {
 // of Docker Compose services. For more information, see:
 // https://docs.docker.com/compose/compose-file/#build
 type DockerBuildArgs struct {
-	Context    *string           `yaml:"context,omitempty"`
-	Dockerfile *string           `yaml:"dockerfile,omitempty"`
-	Args       map[string]string `yaml:"args,omitempty"`
-	Target     *string           `yaml:"target,omitempty"`
-	CacheFrom  []string          `yaml:"cache_from,omitempty"`
+	Context    *string                `yaml:"context,omitempty"`
+	Dockerfile *string                `yaml:"dockerfile,omitempty"`
+	Args       map[string]BuildArg    `yaml:"args,omitempty"`
+	Target     *string                `yaml:"target,omitempty"`
+	CacheFrom  []string               `yaml:"cache_from,omitempty"`
+	Secrets    map[string]BuildSecret `yaml:"secrets,omitempty"` // Secrets that BuildKit mounts in the RUN instructions, by ID.
+	SSH        []string               `yaml:"ssh,omitempty"`     // SSH agent sockets or keys forwarded to the RUN instructions, such as "default".
+	Network    *string                `yaml:"network,omitempty"` // Networking mode of the RUN instructions.
+	Buildpack  BuildpackArgs          `yaml:"buildpack,omitempty"`
+
+	SOCI *bool `yaml:"-"` // Set from "image.soci" rather than the build map.
 }
 
 func (b *DockerBuildArgs) isEmpty() bool {
-	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil {
+	if b.Context == nil && b.Dockerfile == nil && b.Args == nil && b.Target == nil && b.CacheFrom == nil &&
+		b.Secrets == nil && b.SSH == nil && b.Network == nil && b.Buildpack.isEmpty() {
 		return true
 	}
 	return false
 }
 
+// BuildArg is the value of a build arg, either a plain string or read when the image is built
+// from an environment variable or an SSM parameter.
+type BuildArg struct {
+	Plain   *string
+	FromEnv *string `yaml:"from_env,omitempty"`
+	FromSSM *string `yaml:"from_ssm,omitempty"`
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the BuildArg
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v3) interface.
+func (a *BuildArg) UnmarshalYAML(value *yaml.Node) error {
+	type sources BuildArg
+	var src sources
+	if err := value.Decode(&src); err != nil {
+		var yamlTypeErr *yaml.TypeError
+		if !errors.As(err, &yamlTypeErr) {
+			return err
+		}
+	}
+	if src.FromEnv != nil || src.FromSSM != nil {
+		*a = BuildArg(src)
+		return nil
+	}
+	if err := value.Decode(&a.Plain); err != nil {
+		return errUnmarshalBuildArg
+	}
+	return nil
+}
+
+// BuildSecret is a secret that BuildKit mounts in the RUN instructions of the Dockerfile with --mount=type=secret,
+// read when the image is built from an environment variable, a file, or an SSM parameter.
+type BuildSecret struct {
+	FromEnv  *string `yaml:"from_env,omitempty"`
+	FromFile *string `yaml:"from_file,omitempty"`
+	FromSSM  *string `yaml:"from_ssm,omitempty"`
+}
+
+// BuildpackArgs represents the options to build an image with Cloud Native Buildpacks instead of a Dockerfile.
+type BuildpackArgs struct {
+	Builder    *string  `yaml:"builder,omitempty"`    // Builder image that provides the buildpacks and the base images.
+	Buildpacks []string `yaml:"buildpacks,omitempty"` // Optional. Buildpacks to use instead of the ones detected by the builder.
+}
+
+func (b *BuildpackArgs) isEmpty() bool {
+	return b.Builder == nil && b.Buildpacks == nil
+}
+
 // PublishConfig represents the configurable options for setting up publishers.
 type PublishConfig struct {
 	Topics []Topic `yaml:"topics"`
@@ -740,6 +838,9 @@ func (p *PlatformArgsOrString) UnmarshalYAML(value *yaml.Node) error {
 
 // OS returns the operating system family.
 func (p *PlatformArgsOrString) OS() string {
+	if p.IsMultiArch() {
+		return OSLinux
+	}
 	if p := aws.StringValue((*string)(p.PlatformString)); p != "" {
 		args := strings.Split(p, "/")
 		return strings.ToLower(args[0])
@@ -748,7 +849,11 @@ func (p *PlatformArgsOrString) OS() string {
 }
 
 // Arch returns the architecture of PlatformArgsOrString.
+// The tasks of a "multiarch" workload run on x86-64, like the ones without a platform.
 func (p *PlatformArgsOrString) Arch() string {
+	if p.IsMultiArch() {
+		return ArchAMD64
+	}
 	if p := aws.StringValue((*string)(p.PlatformString)); p != "" {
 		args := strings.Split(p, "/")
 		return strings.ToLower(args[1])
@@ -770,6 +875,11 @@ func (p *PlatformArgs) String() string {
 	return fmt.Sprintf("('%s', '%s')", aws.StringValue(p.OSFamily), aws.StringValue(p.Arch))
 }
 
+// IsMultiArch returns true if the images are built for several architectures with "platform: multiarch".
+func (p *PlatformArgsOrString) IsMultiArch() bool {
+	return strings.ToLower(aws.StringValue((*string)(p.PlatformString))) == PlatformMultiArch
+}
+
 // IsEmpty returns if the platform field is empty.
 func (p *PlatformArgsOrString) IsEmpty() bool {
 	return p.PlatformString == nil && p.PlatformArgs.isEmpty()
@@ -6,18 +6,21 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"gopkg.in/yaml.v3"
 )
 
 // Defaults for Firelens configuration.
 const (
 	FirelensContainerName = "firelens_log_router"
-	defaultFluentbitImage = "public.ecr.aws/aws-observability/aws-for-fluent-bit:stable"
+	defaultFluentbitImage = template.DefaultFluentBitImage
 )
 
 // Platform related settings.
@@ -34,6 +37,9 @@ const (
 	ArchARM   = dockerengine.ArchARM
 	ArchARM64 = dockerengine.ArchARM64
 
+	// PlatformMultiArch builds the images for every architecture in multiArchPlatforms, pushed as a single manifest list.
+	PlatformMultiArch = "multiarch"
+
 	// Minimum CPU and mem values required for Windows-based tasks.
 	MinWindowsTaskCPU    = 1024
 	MinWindowsTaskMemory = 2048
@@ -41,6 +47,10 @@ const (
 	// deployment strategies
 	ECSDefaultRollingUpdateStrategy  = "default"
 	ECSRecreateRollingUpdateStrategy = "recreate"
+
+	// CodeDeploy deployment strategies of a Load Balanced Web Service.
+	BlueGreenDeploymentStrategy = "blue-green"
+	CanaryDeploymentStrategy    = "canary"
 )
 
 // Platform related settings.
@@ -55,6 +65,10 @@ var (
 		dockerengine.PlatformString(OSWindows, ArchAMD64),
 		dockerengine.PlatformString(OSWindows, ArchX86),
 	}
+	multiArchPlatforms = []string{ // The platforms that the images of a "multiarch" workload are built for.
+		dockerengine.PlatformString(OSLinux, ArchAMD64),
+		dockerengine.PlatformString(OSLinux, ArchARM64),
+	}
 	validAdvancedPlatforms = []PlatformArgs{ // All of the OsFamily/Arch combinations that the PlatformArgs field may accept.
 		{OSFamily: aws.String(OSLinux), Arch: aws.String(ArchX86)},
 		{OSFamily: aws.String(OSLinux), Arch: aws.String(ArchAMD64)},
@@ -106,6 +120,30 @@ type DeploymentControllerConfig struct {
 type DeploymentConfig struct {
 	DeploymentControllerConfig `yaml:",inline"`
 	RollbackAlarms             Union[[]string, AlarmArgs] `yaml:"rollback_alarms"`
+	Strategy                   DeploymentStrategy         `yaml:"strategy"`
+}
+
+// DeploymentStrategy represents a blue/green or canary deployment of a service with CodeDeploy.
+type DeploymentStrategy struct {
+	Type     *string        `yaml:"type"`
+	Canary   CanaryConfig   `yaml:"canary"`
+	BakeTime *time.Duration `yaml:"bake_time"` // Time to wait before the original tasks are terminated.
+}
+
+// CanaryConfig represents the traffic shifted to the new tasks before all the traffic is.
+type CanaryConfig struct {
+	Percent  *int           `yaml:"percent"`
+	Interval *time.Duration `yaml:"interval"` // Time to wait before shifting the rest of the traffic.
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (s *DeploymentStrategy) IsEmpty() bool {
+	return s.Type == nil && s.Canary.IsEmpty() && s.BakeTime == nil
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (c *CanaryConfig) IsEmpty() bool {
+	return c.Percent == nil && c.Interval == nil
 }
 
 // WorkerDeploymentConfig represents the deployment strategies for a worker service.
@@ -115,7 +153,7 @@ type WorkerDeploymentConfig struct {
 }
 
 func (d *DeploymentConfig) isEmpty() bool {
-	return d == nil || (d.DeploymentControllerConfig.isEmpty() && d.RollbackAlarms.IsZero())
+	return d == nil || (d.DeploymentControllerConfig.isEmpty() && d.RollbackAlarms.IsZero() && d.Strategy.IsEmpty())
 }
 
 func (d *DeploymentControllerConfig) isEmpty() bool {
@@ -157,19 +195,30 @@ type TaskConfig struct {
 	EnvFile        *string              `yaml:"env_file"`
 	Secrets        map[string]Secret    `yaml:"secrets"`
 	Storage        Storage              `yaml:"storage"`
+	// ScaleInProtection lets the tasks of a service protect themselves from being stopped by scale-in events and deployments.
+	ScaleInProtection Union[*bool, ScaleInProtectionArgs] `yaml:"scale_in_protection"`
 }
 
 // Variable represents an identifier for the value of an environment variable.
 type Variable struct {
 	StringOrFromCFN
+	// BuildOnly holds the build args of the "variables.build_only" key, which are never set in the task definition.
+	BuildOnly map[string]BuildArg
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler (v3) interface to override the default YAML unmarshalling logic.
 func (v *Variable) UnmarshalYAML(value *yaml.Node) error {
-	if err := v.StringOrFromCFN.UnmarshalYAML(value); err != nil {
-		return fmt.Errorf(`unmarshal "variables": %w`, err)
+	err := v.StringOrFromCFN.UnmarshalYAML(value)
+	if err == nil {
+		return nil
 	}
-	return nil
+	if value.Kind == yaml.MappingNode {
+		v.StringOrFromCFN = StringOrFromCFN{}
+		if buildOnlyErr := value.Decode(&v.BuildOnly); buildOnlyErr == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf(`unmarshal "variables": %w`, err)
 }
 
 // RequiresImport returns true if the value is imported from an environment.
@@ -185,11 +234,81 @@ func (v *Variable) Value() string {
 	return aws.StringValue(v.Plain)
 }
 
+// VariablesFromSSMPath is the key of "variables" whose value is a path of SSM parameters.
+// Every parameter under the path is injected in the container as an environment variable at deploy time.
+const VariablesFromSSMPath = "from_ssm_path"
+
+// VariablesBuildOnly is the key of "variables" whose value is a map of build args of the main container.
+// They are passed to the build of the image, but never set in the task definition.
+const VariablesBuildOnly = "build_only"
+
+// envVarNameInvalidCharsRegexp matches the characters of an SSM parameter name that can't be in the name of an environment variable.
+var envVarNameInvalidCharsRegexp = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// SSMParameterPath returns the path of the SSM parameters injected as environment variables, or an empty string if none is set.
+func (t *TaskConfig) SSMParameterPath() string {
+	return ssmParameterPath(t.Variables)
+}
+
+// InjectSSMParameters replaces the "variables.from_ssm_path" field with a secret for each SSM parameter name.
+// Variables and secrets of the manifest take precedence over the parameters with the same environment variable name.
+func (t *TaskConfig) InjectSSMParameters(names []string) {
+	t.Secrets = injectSSMParameters(t.Variables, t.Secrets, names)
+}
+
+func ssmParameterPath(variables map[string]Variable) string {
+	v, ok := variables[VariablesFromSSMPath]
+	if !ok {
+		return ""
+	}
+	return aws.StringValue(v.Plain)
+}
+
+// injectSSMParameters removes the "from_ssm_path" key from the variables, and returns the secrets with the parameters under its path.
+func injectSSMParameters(variables map[string]Variable, secrets map[string]Secret, names []string) map[string]Secret {
+	path := ssmParameterPath(variables)
+	delete(variables, VariablesFromSSMPath)
+	if len(names) == 0 {
+		return secrets
+	}
+	if secrets == nil {
+		secrets = make(map[string]Secret, len(names))
+	}
+	for _, name := range names {
+		envVar := ssmParameterEnvVarName(path, name)
+		if envVar == "" {
+			continue
+		}
+		if _, ok := variables[envVar]; ok {
+			continue
+		}
+		if _, ok := secrets[envVar]; ok {
+			continue
+		}
+		secrets[envVar] = Secret{
+			from: StringOrFromCFN{
+				Plain: aws.String(name),
+			},
+		}
+	}
+	return secrets
+}
+
+// ssmParameterEnvVarName returns the name of the environment variable for a parameter under the path.
+// For example, the parameter "/myapp/test/db-host" under the path "/myapp/test/" is injected as "DB_HOST".
+func ssmParameterEnvVarName(path, name string) string {
+	rel := strings.TrimPrefix(strings.TrimPrefix(name, strings.TrimSuffix(path, "/")), "/")
+	return strings.ToUpper(envVarNameInvalidCharsRegexp.ReplaceAllString(rel, "_"))
+}
+
 // ContainerPlatform returns the platform for the service.
 func (t *TaskConfig) ContainerPlatform() string {
 	if t.Platform.IsEmpty() {
 		return ""
 	}
+	if t.Platform.IsMultiArch() {
+		return strings.Join(multiArchPlatforms, ",")
+	}
 	if t.IsWindows() {
 		return platformString(OSWindows, t.Platform.Arch())
 	}
@@ -242,8 +361,12 @@ func (s *Secret) RequiresImport() bool {
 }
 
 // Value returns the secret value provided by clients.
+// If a JSON key of a SecretsManager secret is referenced, the value is in the "<name>:<json-key>::" format expected by ECS.
 func (s *Secret) Value() string {
 	if !s.fromSecretsManager.IsEmpty() {
+		if s.fromSecretsManager.FromJSONKey != nil {
+			return fmt.Sprintf("%s:%s::", aws.StringValue(s.fromSecretsManager.Name), aws.StringValue(s.fromSecretsManager.FromJSONKey))
+		}
 		return aws.StringValue(s.fromSecretsManager.Name)
 	} else if s.RequiresImport() {
 		return aws.StringValue(s.from.FromCFN.Name)
@@ -251,14 +374,15 @@ func (s *Secret) Value() string {
 	return aws.StringValue(s.from.Plain)
 }
 
-// secretsManagerSecret represents the name of a secret stored in SecretsManager.
+// secretsManagerSecret represents the name of a secret stored in SecretsManager, and optionally a key of its JSON value.
 type secretsManagerSecret struct {
-	Name *string `yaml:"secretsmanager"`
+	Name        *string `yaml:"secretsmanager"`
+	FromJSONKey *string `yaml:"from_json_key"`
 }
 
 // IsEmpty returns true if all the fields in secretsManagerSecret have the zero value.
 func (s secretsManagerSecret) IsEmpty() bool {
-	return s.Name == nil
+	return s.Name == nil && s.FromJSONKey == nil
 }
 
 // Logging holds configuration for Firelens to route your logs.
@@ -288,6 +412,35 @@ func (lc *Logging) LogImage() *string {
 	return lc.Image
 }
 
+// InjectedHelperImages returns the names of the helper images that Copilot injects into the tasks of the workload:
+// the Fluent Bit image of the FireLens sidecar, the ADOT collector of the tracing sidecar, and the Envoy traffic mirror.
+func InjectedHelperImages(mft interface{}) []string {
+	var logging Logging
+	var observability Observability
+	var mirror TrafficMirror
+	switch m := mft.(type) {
+	case *LoadBalancedWebService:
+		logging, observability, mirror = m.Logging, m.Observability, m.HTTPOrBool.Mirror
+	case *BackendService:
+		logging, observability = m.Logging, m.Observability
+	case *WorkerService:
+		logging, observability = m.Logging, m.Observability
+	case *ScheduledJob:
+		logging = m.Logging
+	}
+	var names []string
+	if !logging.IsEmpty() && logging.Image == nil {
+		names = append(names, template.HelperImageFluentBit)
+	}
+	if observability.Tracing != nil {
+		names = append(names, template.HelperImageOTelCollector)
+	}
+	if !mirror.IsEmpty() {
+		names = append(names, template.HelperImageEnvoy)
+	}
+	return names
+}
+
 // GetEnableMetadata returns the configuration values and sane default for the EnableMEtadata field
 func (lc *Logging) GetEnableMetadata() *string {
 	if lc.EnableMetadata == nil {
@@ -371,6 +524,16 @@ func (e ExecuteCommandConfig) IsEmpty() bool {
 	return e.Enable == nil
 }
 
+// ScaleInProtectionArgs holds the configuration of the scale-in protection of the tasks of a service.
+type ScaleInProtectionArgs struct {
+	ExpiresIn *time.Duration `yaml:"expires_in"` // Default duration of the protection when a task enables it.
+}
+
+// ScaleInProtectionEnabled returns true if the tasks can protect themselves from scale-in events.
+func (t *TaskConfig) ScaleInProtectionEnabled() bool {
+	return t.ScaleInProtection.IsAdvanced() || aws.BoolValue(t.ScaleInProtection.Basic)
+}
+
 // ContainerHealthCheck holds the configuration to determine if the service container is healthy.
 // See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-ecs-taskdefinition-healthcheck.html
 type ContainerHealthCheck struct {
@@ -430,6 +593,23 @@ func envFiles(name *string, tc TaskConfig, lc Logging, sc map[string]*SidecarCon
 	return envFiles
 }
 
+// withBuildOnlyVariables returns the build args of the main container with the "variables.build_only" entries added.
+func withBuildOnlyVariables(args *DockerBuildArgs, variables map[string]Variable) *DockerBuildArgs {
+	buildOnly := variables[VariablesBuildOnly].BuildOnly
+	if len(buildOnly) == 0 {
+		return args
+	}
+	merged := make(map[string]BuildArg, len(args.Args)+len(buildOnly))
+	for name, arg := range buildOnly {
+		merged[name] = arg
+	}
+	for name, arg := range args.Args {
+		merged[name] = arg
+	}
+	args.Args = merged
+	return args
+}
+
 func buildArgs(contextDir string, buildArgs map[string]*DockerBuildArgs, sc map[string]*SidecarConfig) (map[string]*DockerBuildArgs, error) {
 	for name, config := range sc {
 		if _, ok := config.ImageURI(); !ok {
@@ -438,3 +618,29 @@ func buildArgs(contextDir string, buildArgs map[string]*DockerBuildArgs, sc map[
 	}
 	return buildArgs, nil
 }
+
+func fileSyncs(name *string, image Image, sc map[string]*SidecarConfig) map[string]ImageSync {
+	syncs := make(map[string]ImageSync)
+	if !image.Sync.isEmpty() {
+		syncs[aws.StringValue(name)] = image.Sync
+	}
+	for container, config := range sc {
+		if !config.Image.Advanced.Sync.isEmpty() {
+			syncs[container] = config.Image.Advanced.Sync
+		}
+	}
+	return syncs
+}
+
+func imageLocations(name *string, image Image, sc map[string]*SidecarConfig) map[string]string {
+	locations := make(map[string]string, len(sc)+1)
+	if image.Location != nil {
+		locations[aws.StringValue(name)] = aws.StringValue(image.Location)
+	}
+	for container, config := range sc {
+		if uri, ok := config.ImageURI(); ok {
+			locations[container] = uri
+		}
+	}
+	return locations
+}
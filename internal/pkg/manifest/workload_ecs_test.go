@@ -95,7 +95,7 @@ variables:
 			wanted: mockParentField{
 				Variables: map[string]Variable{
 					"LOG_LEVEL": {
-						StringOrFromCFN{
+						StringOrFromCFN: StringOrFromCFN{
 							Plain: stringP("DEBUG"),
 						},
 					},
@@ -111,7 +111,7 @@ variables:
 			wanted: mockParentField{
 				Variables: map[string]Variable{
 					"DB_NAME": {
-						StringOrFromCFN{
+						StringOrFromCFN: StringOrFromCFN{
 							FromCFN: fromCFN{
 								Name: stringP("MyUserDB"),
 							},
@@ -123,11 +123,30 @@ variables:
 		"nothing to unmarshal": {
 			in: []byte(`other_field: yo`),
 		},
+		"unmarshal build-only variables": {
+			in: []byte(`
+variables:
+  build_only:
+    NPM_REGISTRY: https://registry.example.com
+    GIT_SHA:
+      from_env: GITHUB_SHA
+`),
+			wanted: mockParentField{
+				Variables: map[string]Variable{
+					"build_only": {
+						BuildOnly: map[string]BuildArg{
+							"NPM_REGISTRY": {Plain: stringP("https://registry.example.com")},
+							"GIT_SHA":      {FromEnv: stringP("GITHUB_SHA")},
+						},
+					},
+				},
+			},
+		},
 		"fail to unmarshal": {
 			in: []byte(`
 variables:
   erroneous: 
-    big_mistake: being made`),
+    - big_mistake: being made`),
 			wantedError: errors.New(`unmarshal "variables": cannot unmarshal field to a string or into a map`),
 		},
 	}
@@ -152,7 +171,7 @@ func TestVariable_RequiresImport(t *testing.T) {
 	}{
 		"requires import": {
 			in: Variable{
-				StringOrFromCFN{
+				StringOrFromCFN: StringOrFromCFN{
 					FromCFN: fromCFN{
 						Name: stringP("prod-MyDB"),
 					},
@@ -162,7 +181,7 @@ func TestVariable_RequiresImport(t *testing.T) {
 		},
 		"does not require import if it is a plain value": {
 			in: Variable{
-				StringOrFromCFN{
+				StringOrFromCFN: StringOrFromCFN{
 					Plain: stringP("plain"),
 				},
 			},
@@ -182,7 +201,7 @@ func TestVariable_Value(t *testing.T) {
 	}{
 		"requires import": {
 			in: Variable{
-				StringOrFromCFN{
+				StringOrFromCFN: StringOrFromCFN{
 					FromCFN: fromCFN{
 						Name: stringP("prod-MyDB"),
 					},
@@ -192,7 +211,7 @@ func TestVariable_Value(t *testing.T) {
 		},
 		"does not require import if it is a plain value": {
 			in: Variable{
-				StringOrFromCFN{
+				StringOrFromCFN: StringOrFromCFN{
 					Plain: stringP("plain"),
 				},
 			},
@@ -247,6 +266,14 @@ func TestSecret_UnmarshalYAML(t *testing.T) {
 			in:     "secretsmanager: aes128-1a2b3c",
 			wanted: Secret{fromSecretsManager: secretsManagerSecret{Name: aws.String("aes128-1a2b3c")}},
 		},
+		"should be able to unmarshal a JSON key of a SecretsManager secret": {
+			in: `secretsmanager: demo/test/mysql
+from_json_key: username`,
+			wanted: Secret{fromSecretsManager: secretsManagerSecret{
+				Name:        aws.String("demo/test/mysql"),
+				FromJSONKey: aws.String("username"),
+			}},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -363,6 +390,13 @@ func TestSecret_Value(t *testing.T) {
 			in:     Secret{fromSecretsManager: secretsManagerSecret{Name: aws.String("aes128-1a2b3c")}},
 			wanted: "aes128-1a2b3c",
 		},
+		"should return the SecretsManager secret name with the JSON key specifier": {
+			in: Secret{fromSecretsManager: secretsManagerSecret{
+				Name:        aws.String("demo/test/mysql"),
+				FromJSONKey: aws.String("username"),
+			}},
+			wanted: "demo/test/mysql:username::",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -371,6 +405,55 @@ func TestSecret_Value(t *testing.T) {
 	}
 }
 
+func TestTaskConfig_InjectSSMParameters(t *testing.T) {
+	testCases := map[string]struct {
+		inVariables map[string]Variable
+		inSecrets   map[string]Secret
+		inNames     []string
+
+		wantedVariables map[string]Variable
+		wantedSecrets   map[string]Secret
+	}{
+		"removes the path without any parameter under it": {
+			inVariables: map[string]Variable{
+				"from_ssm_path": {StringOrFromCFN: StringOrFromCFN{Plain: aws.String("/myapp/test")}},
+			},
+			wantedVariables: map[string]Variable{},
+		},
+		"manifest variables and secrets take precedence over parameters": {
+			inVariables: map[string]Variable{
+				"from_ssm_path": {StringOrFromCFN: StringOrFromCFN{Plain: aws.String("/myapp/test")}},
+				"LOG_LEVEL":     {StringOrFromCFN: StringOrFromCFN{Plain: aws.String("debug")}},
+			},
+			inSecrets: map[string]Secret{
+				"DB_PASSWORD": {fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/test/mysql")}},
+			},
+			inNames: []string{"/myapp/test/db/password", "/myapp/test/db/user", "/myapp/test/log-level", "/myapp/test/api.key"},
+			wantedVariables: map[string]Variable{
+				"LOG_LEVEL": {StringOrFromCFN: StringOrFromCFN{Plain: aws.String("debug")}},
+			},
+			wantedSecrets: map[string]Secret{
+				"DB_PASSWORD": {fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/test/mysql")}},
+				"DB_USER":     {from: StringOrFromCFN{Plain: aws.String("/myapp/test/db/user")}},
+				"API_KEY":     {from: StringOrFromCFN{Plain: aws.String("/myapp/test/api.key")}},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := TaskConfig{
+				Variables: tc.inVariables,
+				Secrets:   tc.inSecrets,
+			}
+
+			in.InjectSSMParameters(tc.inNames)
+
+			require.Equal(t, tc.wantedVariables, in.Variables)
+			require.Equal(t, tc.wantedSecrets, in.Secrets)
+		})
+	}
+}
+
 func TestSecretsManagerSecret_IsEmpty(t *testing.T) {
 	testCases := map[string]struct {
 		in     secretsManagerSecret
@@ -423,6 +506,61 @@ func TestLogging_IsEmpty(t *testing.T) {
 	}
 }
 
+func TestInjectedHelperImages(t *testing.T) {
+	testCases := map[string]struct {
+		in     interface{}
+		wanted []string
+	}{
+		"no helper images": {
+			in: &BackendService{},
+		},
+		"logging with a custom image doesn't inject fluent bit": {
+			in: &WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					Logging: Logging{
+						Image: aws.String("my-fluent-bit:latest"),
+					},
+				},
+			},
+		},
+		"every helper image of a load balanced web service": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Mirror: TrafficMirror{
+								Service: aws.String("api-v2"),
+							},
+						},
+					},
+					Logging: Logging{
+						Destination: map[string]string{"Name": "cloudwatch"},
+					},
+					Observability: Observability{
+						Tracing: aws.String("awsxray"),
+					},
+				},
+			},
+			wanted: []string{"fluent-bit", "aws-otel-collector", "envoy"},
+		},
+		"logging of a scheduled job": {
+			in: &ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					Logging: Logging{
+						ConfigFile: aws.String("fluent-bit.conf"),
+					},
+				},
+			},
+			wanted: []string{"fluent-bit"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, InjectedHelperImages(tc.in))
+		})
+	}
+}
+
 func TestLogging_LogImage(t *testing.T) {
 	testCases := map[string]struct {
 		inputImage  *string
@@ -518,3 +656,35 @@ func Test_ImageURI(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskConfig_ContainerPlatform(t *testing.T) {
+	testCases := map[string]struct {
+		in     PlatformArgsOrString
+		wanted string
+	}{
+		"empty if the platform is not set": {},
+		"returns the os and arch of the platform": {
+			in:     PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("linux/arm64"))},
+			wanted: "linux/arm64",
+		},
+		"returns windows for windows os families": {
+			in: PlatformArgsOrString{
+				PlatformArgs: PlatformArgs{
+					OSFamily: aws.String("windows_server_2022_core"),
+					Arch:     aws.String("x86_64"),
+				},
+			},
+			wanted: "windows/x86_64",
+		},
+		"returns every platform of multiarch separated by commas": {
+			in:     PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("multiarch"))},
+			wanted: "linux/amd64,linux/arm64",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cfg := TaskConfig{Platform: tc.in}
+			require.Equal(t, tc.wanted, cfg.ContainerPlatform())
+		})
+	}
+}
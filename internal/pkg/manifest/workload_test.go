@@ -262,9 +262,9 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				BuildArgs: DockerBuildArgs{
 					Dockerfile: aws.String("path/to/Dockerfile"),
 					Context:    aws.String("path/to/source"),
-					Args: map[string]string{
-						"arg1":    "value1",
-						"bestdog": "bowie",
+					Args: map[string]BuildArg{
+						"arg1":    {Plain: aws.String("value1")},
+						"bestdog": {Plain: aws.String("bowie")},
 					},
 				},
 				BuildString: nil,
@@ -287,6 +287,57 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				BuildString: nil,
 			},
 		},
+		"args from env and SSM, secrets, ssh and network specified in build opts": {
+			inContent: []byte(`build:
+  args:
+    NODE_ENV: production
+    NPM_TOKEN:
+      from_env: NPM_TOKEN
+    REGISTRY:
+      from_ssm: /myapp/registry
+  secrets:
+    npmrc:
+      from_file: .npmrc
+    pip:
+      from_ssm: /myapp/pip-token
+  ssh:
+    - default
+  network: host`),
+			wantedStruct: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Args: map[string]BuildArg{
+						"NODE_ENV":  {Plain: aws.String("production")},
+						"NPM_TOKEN": {FromEnv: aws.String("NPM_TOKEN")},
+						"REGISTRY":  {FromSSM: aws.String("/myapp/registry")},
+					},
+					Secrets: map[string]BuildSecret{
+						"npmrc": {FromFile: aws.String(".npmrc")},
+						"pip":   {FromSSM: aws.String("/myapp/pip-token")},
+					},
+					SSH:     []string{"default"},
+					Network: aws.String("host"),
+				},
+				BuildString: nil,
+			},
+		},
+		"Buildpack specified in build opts": {
+			inContent: []byte(`build:
+  context: api
+  buildpack:
+    builder: paketobuildpacks/builder-jammy-base
+    buildpacks:
+      - paketo-buildpacks/nodejs`),
+			wantedStruct: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Context: aws.String("api"),
+					Buildpack: BuildpackArgs{
+						Builder:    aws.String("paketobuildpacks/builder-jammy-base"),
+						Buildpacks: []string{"paketo-buildpacks/nodejs"},
+					},
+				},
+				BuildString: nil,
+			},
+		},
 		"Error if unmarshalable": {
 			inContent: []byte(`build:
   badfield: OH NOES
@@ -315,6 +366,7 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.BuildArgs.Args, b.Build.BuildArgs.Args)
 				require.Equal(t, tc.wantedStruct.BuildArgs.Target, b.Build.BuildArgs.Target)
 				require.Equal(t, tc.wantedStruct.BuildArgs.CacheFrom, b.Build.BuildArgs.CacheFrom)
+				require.Equal(t, tc.wantedStruct.BuildArgs.Buildpack, b.Build.BuildArgs.Buildpack)
 			}
 		})
 	}
@@ -549,6 +601,12 @@ func TestPlatformArgsOrString_OS(t *testing.T) {
 			},
 			wanted: "linux",
 		},
+		"should return linux when platform is multiarch": {
+			in: &PlatformArgsOrString{
+				PlatformString: (*PlatformString)(aws.String("multiarch")),
+			},
+			wanted: "linux",
+		},
 		"should return OS when platform is a map 2019 core": {
 			in: &PlatformArgsOrString{
 				PlatformArgs: PlatformArgs{
@@ -640,6 +698,12 @@ func TestPlatformArgsOrString_Arch(t *testing.T) {
 			},
 			wanted: "arm",
 		},
+		"should return amd64 when platform is multiarch": {
+			in: &PlatformArgsOrString{
+				PlatformString: (*PlatformString)(aws.String("multiarch")),
+			},
+			wanted: "amd64",
+		},
 		"should return arch when platform is a map 2019 core": {
 			in: &PlatformArgsOrString{
 				PlatformArgs: PlatformArgs{
@@ -748,6 +812,7 @@ func TestBuildConfig(t *testing.T) {
 	mockWsRoot := "/root/dir"
 	testCases := map[string]struct {
 		inBuild     BuildArgsOrString
+		inSOCI      *bool
 		wantedBuild DockerBuildArgs
 	}{
 		"simple case: BuildString path to dockerfile": {
@@ -785,16 +850,16 @@ func TestBuildConfig(t *testing.T) {
 		"no dockerfile or context specified": {
 			inBuild: BuildArgsOrString{
 				BuildArgs: DockerBuildArgs{
-					Args: map[string]string{
-						"goodDog": "bowie",
+					Args: map[string]BuildArg{
+						"goodDog": {Plain: aws.String("bowie")},
 					},
 				},
 			},
 			wantedBuild: DockerBuildArgs{
 				Dockerfile: aws.String(filepath.Join(mockWsRoot, "Dockerfile")),
 				Context:    aws.String(mockWsRoot),
-				Args: map[string]string{
-					"goodDog": "bowie",
+				Args: map[string]BuildArg{
+					"goodDog": {Plain: aws.String("bowie")},
 				},
 			},
 		},
@@ -802,18 +867,18 @@ func TestBuildConfig(t *testing.T) {
 			inBuild: BuildArgsOrString{
 				BuildArgs: DockerBuildArgs{
 					Dockerfile: aws.String("my/Dockerfile"),
-					Args: map[string]string{
-						"goodDog":  "bowie",
-						"badGoose": "HONK",
+					Args: map[string]BuildArg{
+						"goodDog":  {Plain: aws.String("bowie")},
+						"badGoose": {Plain: aws.String("HONK")},
 					},
 				},
 			},
 			wantedBuild: DockerBuildArgs{
 				Dockerfile: aws.String(filepath.Join(mockWsRoot, "my/Dockerfile")),
 				Context:    aws.String(filepath.Join(mockWsRoot, "my")),
-				Args: map[string]string{
-					"goodDog":  "bowie",
-					"badGoose": "HONK",
+				Args: map[string]BuildArg{
+					"goodDog":  {Plain: aws.String("bowie")},
+					"badGoose": {Plain: aws.String("HONK")},
 				},
 			},
 		},
@@ -837,12 +902,63 @@ func TestBuildConfig(t *testing.T) {
 				},
 			},
 		},
+		"buildpack without a context": {
+			inBuild: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Args: map[string]BuildArg{
+						"BP_NODE_VERSION": {Plain: aws.String("20")},
+					},
+					Buildpack: BuildpackArgs{
+						Builder: aws.String("paketobuildpacks/builder-jammy-base"),
+					},
+				},
+			},
+			wantedBuild: DockerBuildArgs{
+				Context: aws.String(mockWsRoot),
+				Args: map[string]BuildArg{
+					"BP_NODE_VERSION": {Plain: aws.String("20")},
+				},
+				Buildpack: BuildpackArgs{
+					Builder: aws.String("paketobuildpacks/builder-jammy-base"),
+				},
+			},
+		},
+		"buildpack with a context": {
+			inBuild: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Context: aws.String("cmd/main"),
+					Buildpack: BuildpackArgs{
+						Builder:    aws.String("paketobuildpacks/builder-jammy-base"),
+						Buildpacks: []string{"paketo-buildpacks/go"},
+					},
+				},
+			},
+			wantedBuild: DockerBuildArgs{
+				Context: aws.String(filepath.Join(mockWsRoot, "cmd", "main")),
+				Buildpack: BuildpackArgs{
+					Builder:    aws.String("paketobuildpacks/builder-jammy-base"),
+					Buildpacks: []string{"paketo-buildpacks/go"},
+				},
+			},
+		},
+		"SOCI index": {
+			inBuild: BuildArgsOrString{
+				BuildString: aws.String("my/Dockerfile"),
+			},
+			inSOCI: aws.Bool(true),
+			wantedBuild: DockerBuildArgs{
+				Dockerfile: aws.String(filepath.Join(mockWsRoot, "my/Dockerfile")),
+				Context:    aws.String(filepath.Join(mockWsRoot, "my")),
+				SOCI:       aws.Bool(true),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			s := Image{
 				ImageLocationOrBuild: ImageLocationOrBuild{
 					Build: tc.inBuild,
+					SOCI:  tc.inSOCI,
 				},
 			}
 			got := s.BuildConfig(mockWsRoot)
@@ -0,0 +1,205 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package openapi provides functionality to derive load balancer routing from an OpenAPI specification.
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxRegexLength is the longest regular expression that a WAF regex pattern set accepts.
+const MaxRegexLength = 200
+
+// wellKnownHealthCheckPaths are the paths used as the health check path if no operation is marked with the extension.
+var wellKnownHealthCheckPaths = []string{"/health", "/healthz", "/healthcheck", "/ping", "/status"}
+
+var templatedSegment = regexp.MustCompile(`\{[^{}/]*\}`)
+
+// Spec is the subset of an OpenAPI specification needed to route requests to a service.
+type Spec struct {
+	Paths map[string]PathItem `yaml:"paths"`
+}
+
+// PathItem holds the operations of a path.
+type PathItem struct {
+	Get     *Operation `yaml:"get"`
+	Put     *Operation `yaml:"put"`
+	Post    *Operation `yaml:"post"`
+	Delete  *Operation `yaml:"delete"`
+	Options *Operation `yaml:"options"`
+	Head    *Operation `yaml:"head"`
+	Patch   *Operation `yaml:"patch"`
+	Trace   *Operation `yaml:"trace"`
+}
+
+// Operation holds the fields of an operation that Copilot reads.
+// The "x-copilot-health-check" extension marks the operation that the load balancer sends health checks to.
+type Operation struct {
+	HealthCheck bool `yaml:"x-copilot-health-check"`
+}
+
+// Parse unmarshals an OpenAPI specification written in either YAML or JSON.
+func Parse(content []byte) (*Spec, error) {
+	var spec Spec
+	if err := yaml.Unmarshal(content, &spec); err != nil {
+		return nil, fmt.Errorf("unmarshal OpenAPI spec: %w", err)
+	}
+	if len(spec.Paths) == 0 {
+		return nil, errors.New(`OpenAPI spec must define at least one path under "paths"`)
+	}
+	for p := range spec.Paths {
+		if !strings.HasPrefix(p, "/") {
+			return nil, fmt.Errorf("path %q of the OpenAPI spec must start with a /", p)
+		}
+	}
+	return &spec, nil
+}
+
+// PathPrefixes returns the static prefixes of the paths of the spec under the base path, sorted.
+// A prefix ends before the first templated segment of a path, and prefixes covered by a shorter one are dropped
+// since a listener rule for a path also matches the requests under it.
+func (s *Spec) PathPrefixes(base string) []string {
+	unique := make(map[string]bool)
+	for p := range s.Paths {
+		unique[staticPrefix(base, p)] = true
+	}
+	var prefixes []string
+	for prefix := range unique {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	var out []string
+	for _, prefix := range prefixes {
+		if !coveredBy(prefix, out) {
+			out = append(out, prefix)
+		}
+	}
+	return out
+}
+
+// HealthCheckPath returns the path under the base path of the GET operation marked with "x-copilot-health-check: true".
+// Otherwise, it returns a well-known health check path defined by the spec, or an empty string if there is none.
+func (s *Spec) HealthCheckPath(base string) string {
+	var marked []string
+	for p, item := range s.Paths {
+		if item.Get != nil && item.Get.HealthCheck && !templatedSegment.MatchString(p) {
+			marked = append(marked, p)
+		}
+	}
+	if len(marked) > 0 {
+		sort.Strings(marked)
+		return join(base, marked[0])
+	}
+	for _, p := range wellKnownHealthCheckPaths {
+		if item, ok := s.Paths[p]; ok && item.Get != nil {
+			return join(base, p)
+		}
+	}
+	return ""
+}
+
+// PathRegexes returns a regular expression for each path of the spec under the base path, sorted.
+// Templated segments such as "{id}" match any value in a single segment.
+func (s *Spec) PathRegexes(base string) ([]string, error) {
+	var regexes []string
+	for p := range s.Paths {
+		full := join(base, p)
+		var segments []string
+		for _, segment := range strings.Split(full, "/") {
+			segments = append(segments, segmentRegex(segment))
+		}
+		regex := "^" + strings.Join(segments, "/") + "$"
+		if len(regex) > MaxRegexLength {
+			return nil, fmt.Errorf("regular expression %q for path %q is longer than %d characters", regex, full, MaxRegexLength)
+		}
+		regexes = append(regexes, regex)
+	}
+	sort.Strings(regexes)
+	return regexes, nil
+}
+
+// ScopeRegexes returns a regular expression matching the requests under each prefix of the spec, sorted.
+// A root path is matched exactly. It returns an error if a path starts with a templated segment,
+// since every request of the load balancer would then be in scope.
+func (s *Spec) ScopeRegexes(base string) ([]string, error) {
+	unique := make(map[string]bool)
+	root := false
+	for p := range s.Paths {
+		prefix := staticPrefix(base, p)
+		if prefix != "/" {
+			unique[prefix] = true
+			continue
+		}
+		if join(base, p) != "/" {
+			return nil, fmt.Errorf(`path %q of the OpenAPI spec must start with a static segment, or "http.path" must be set`, p)
+		}
+		root = true
+	}
+	var prefixes []string
+	for prefix := range unique {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	var regexes []string
+	if root {
+		regexes = append(regexes, "^/$")
+	}
+	var scoped []string
+	for _, prefix := range prefixes {
+		if coveredBy(prefix, scoped) {
+			continue
+		}
+		scoped = append(scoped, prefix)
+		regex := "^" + regexp.QuoteMeta(prefix) + "(/.*)?$"
+		if len(regex) > MaxRegexLength {
+			return nil, fmt.Errorf("regular expression %q for prefix %q is longer than %d characters", regex, prefix, MaxRegexLength)
+		}
+		regexes = append(regexes, regex)
+	}
+	return regexes, nil
+}
+
+func staticPrefix(base, p string) string {
+	var static []string
+	for _, segment := range strings.Split(p, "/") {
+		if templatedSegment.MatchString(segment) {
+			break
+		}
+		static = append(static, segment)
+	}
+	return join(base, strings.Join(static, "/"))
+}
+
+func coveredBy(prefix string, shorter []string) bool {
+	for _, s := range shorter {
+		if s == "/" || prefix == s || strings.HasPrefix(prefix, s+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func segmentRegex(segment string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range templatedSegment.FindAllStringIndex(segment, -1) {
+		b.WriteString(regexp.QuoteMeta(segment[last:loc[0]]))
+		b.WriteString("[^/]+")
+		last = loc[1]
+	}
+	b.WriteString(regexp.QuoteMeta(segment[last:]))
+	return b.String()
+}
+
+// join returns the path p under the base path, without a trailing slash.
+func join(base, p string) string {
+	return path.Join("/", base, p)
+}
@@ -0,0 +1,247 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const petstore = `
+openapi: 3.0.0
+info:
+  title: Petstore
+  version: 1.0.0
+paths:
+  /:
+    get:
+      summary: Welcome page.
+  /pets:
+    get:
+      summary: List pets.
+    post:
+      summary: Create a pet.
+  /pets/{petId}:
+    get:
+      summary: Get a pet.
+  /pets/{petId}/photos.{format}:
+    get:
+      summary: Get the photo of a pet.
+  /stores/{storeId}/orders:
+    get:
+      summary: List the orders of a store.
+  /ready:
+    get:
+      summary: Readiness.
+      x-copilot-health-check: true
+`
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedPaths []string
+		wantedErr   string
+	}{
+		"error on invalid syntax": {
+			in:        "paths: [",
+			wantedErr: "unmarshal OpenAPI spec: yaml: line 1: did not find expected node content",
+		},
+		"error if there are no paths": {
+			in:        "openapi: 3.0.0\n",
+			wantedErr: `OpenAPI spec must define at least one path under "paths"`,
+		},
+		"error if a path is relative": {
+			in:        "paths:\n  pets:\n    get: {}\n",
+			wantedErr: `path "pets" of the OpenAPI spec must start with a /`,
+		},
+		"parses json": {
+			in:          `{"openapi": "3.0.0", "paths": {"/pets": {"get": {}}, "/users": {"post": {}}}}`,
+			wantedPaths: []string{"/pets", "/users"},
+		},
+		"parses yaml": {
+			in:          petstore,
+			wantedPaths: []string{"/", "/pets", "/pets/{petId}", "/pets/{petId}/photos.{format}", "/ready", "/stores/{storeId}/orders"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			spec, err := Parse([]byte(tc.in))
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			var paths []string
+			for p := range spec.Paths {
+				paths = append(paths, p)
+			}
+			require.ElementsMatch(t, tc.wantedPaths, paths)
+		})
+	}
+}
+
+func TestSpec_PathPrefixes(t *testing.T) {
+	testCases := map[string]struct {
+		in   string
+		base string
+
+		wanted []string
+	}{
+		"root path covers every other path": {
+			in:     petstore,
+			base:   "/",
+			wanted: []string{"/"},
+		},
+		"root path is under the base path": {
+			in:     petstore,
+			base:   "/api/",
+			wanted: []string{"/api"},
+		},
+		"prefixes end before templated segments": {
+			in: `paths:
+  /pets/{petId}: {}
+  /pets/{petId}/photos: {}
+  /stores/{storeId}/orders: {}
+  /users/me: {}
+  /users/me/settings: {}
+`,
+			wanted: []string{"/pets", "/stores", "/users/me"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			spec, err := Parse([]byte(tc.in))
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wanted, spec.PathPrefixes(tc.base))
+		})
+	}
+}
+
+func TestSpec_HealthCheckPath(t *testing.T) {
+	testCases := map[string]struct {
+		in   string
+		base string
+
+		wanted string
+	}{
+		"operation marked with the extension": {
+			in:     petstore,
+			base:   "/api",
+			wanted: "/api/ready",
+		},
+		"well-known path": {
+			in: `paths:
+  /status:
+    get: {}
+  /healthz:
+    get: {}
+`,
+			wanted: "/healthz",
+		},
+		"well-known paths without a GET operation are ignored": {
+			in:     "paths:\n  /health:\n    post: {}\n",
+			wanted: "",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			spec, err := Parse([]byte(tc.in))
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wanted, spec.HealthCheckPath(tc.base))
+		})
+	}
+}
+
+func TestSpec_PathRegexes(t *testing.T) {
+	testCases := map[string]struct {
+		in   string
+		base string
+
+		wanted    []string
+		wantedErr string
+	}{
+		"templated segments match a single segment": {
+			in:   petstore,
+			base: "/api",
+			wanted: []string{
+				`^/api$`,
+				`^/api/pets$`,
+				`^/api/pets/[^/]+$`,
+				`^/api/pets/[^/]+/photos\.[^/]+$`,
+				`^/api/ready$`,
+				`^/api/stores/[^/]+/orders$`,
+			},
+		},
+		"error if a regular expression is too long": {
+			in:        fmt.Sprintf("paths:\n  /%s: {}\n", strings.Repeat("a", 200)),
+			wantedErr: fmt.Sprintf(`regular expression "^/%[1]s$" for path "/%[1]s" is longer than 200 characters`, strings.Repeat("a", 200)),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			spec, err := Parse([]byte(tc.in))
+			require.NoError(t, err)
+
+			got, err := spec.PathRegexes(tc.base)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSpec_ScopeRegexes(t *testing.T) {
+	testCases := map[string]struct {
+		in   string
+		base string
+
+		wanted    []string
+		wantedErr string
+	}{
+		"root path is matched exactly": {
+			in: petstore,
+			wanted: []string{
+				`^/$`,
+				`^/pets(/.*)?$`,
+				`^/ready(/.*)?$`,
+				`^/stores(/.*)?$`,
+			},
+		},
+		"base path": {
+			in:     petstore,
+			base:   "/api",
+			wanted: []string{`^/api(/.*)?$`},
+		},
+		"error if a path starts with a templated segment": {
+			in:        "paths:\n  /{tenant}/users: {}\n",
+			wantedErr: `path "/{tenant}/users" of the OpenAPI spec must start with a static segment, or "http.path" must be set`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			spec, err := Parse([]byte(tc.in))
+			require.NoError(t, err)
+
+			got, err := spec.ScopeRegexes(tc.base)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -5,12 +5,16 @@ package override
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -25,9 +29,39 @@ const (
 	maxNumberOfLevelsChecked = 5
 )
 
+// Languages supported for CDK overrides.
+const (
+	cdkLangTypeScript = "typescript"
+	cdkLangGo         = "go"
+	cdkLangPython     = "python"
+)
+
+const (
+	cdkStackName      = "Stack"            // Name of the stack synthesized by the scaffolded CDK applications.
+	cdkOutDirName     = "cdk.out"          // Directory under .build/ where non-Node CDK applications write their cloud assembly.
+	pythonVenvDirName = "venv"             // Directory under .build/ holding the virtual environment of Python CDK applications.
+	goModFile         = "go.mod"           // File that marks a Go CDK application.
+	pythonReqsFile    = "requirements.txt" // File that marks a Python CDK application.
+	cacheDirName      = "cache"            // Directory under .build/ holding previously synthesized templates.
+	cacheEnvKeyLen    = 16                 // Number of hex characters of the environment variables digest prefixing a cached template.
+)
+
+// Directories under the overrides/ directory that are generated by the toolchains, and therefore
+// do not count as the source of the CDK application when computing the cache key.
+var cdkCacheIgnoredDirs = map[string]struct{}{
+	".build":       {},
+	".cdk.staging": {},
+	".venv":        {},
+	"__pycache__":  {},
+	"cdk.out":      {},
+	"node_modules": {},
+}
+
 // CDK is an Overrider that can transform a CloudFormation template with the Cloud Development Kit.
 type CDK struct {
-	rootAbsPath string // Absolute path to the overrides/ directory.
+	rootAbsPath  string            // Absolute path to the overrides/ directory.
+	envVars      map[string]string // Environment variables passed to the CDK application.
+	disableCache bool              // If true, always synthesize the CDK application.
 
 	execWriter io.Writer // Writer to pipe stdout and stderr content from os/exec calls.
 	fs         afero.Fs  // OS file system.
@@ -46,6 +80,10 @@ type CDKOpts struct {
 	EnvVars    map[string]string                           // Environment variables key value pairs to pass to the "cdk synth" command.
 	LookPathFn func(executable string) (string, error)     // Search for the executable under $PATH. Defaults to exec.LookPath.
 	CommandFn  func(name string, args ...string) *exec.Cmd // Create a new executable command. Defaults to exec.Command rooted at the overrides/ dir.
+
+	// DisableCache forces the CDK application to be synthesized even if the output for the same
+	// override source and input template was previously cached under .build/cache/.
+	DisableCache bool
 }
 
 // WithCDK instantiates a new CDK Overrider with root being the path to the overrides/ directory.
@@ -80,9 +118,11 @@ func WithCDK(root string, opts CDKOpts) *CDK {
 		cmdFn = opts.CommandFn
 	}
 	return &CDK{
-		rootAbsPath: root,
-		execWriter:  writer,
-		fs:          fs,
+		rootAbsPath:  root,
+		envVars:      opts.EnvVars,
+		disableCache: opts.DisableCache,
+		execWriter:   writer,
+		fs:           fs,
 		exec: struct {
 			LookPath func(file string) (string, error)
 			Command  func(name string, args ...string) *exec.Cmd
@@ -100,23 +140,168 @@ func WithCDK(root string, opts CDKOpts) *CDK {
 // Override returns the extended CloudFormation template body using the CDK.
 // In order to ensure the CDK transformations can be applied, Copilot first installs any CDK dependencies
 // as well as the toolkit itself.
+// For Go and Python CDK applications, the application is executed directly with its own toolchain
+// instead of through the Node.js CDK toolkit.
+// Unless the cache is disabled, the transformed template is cached under .build/cache/ keyed by the hash of
+// the CDK application source and body, and subsequent calls with the same inputs skip the synth.
+// Only the latest template is kept for each set of environment variables, so that the cache doesn't grow
+// with every deployment.
 func (cdk *CDK) Override(body []byte) ([]byte, error) {
-	if err := cdk.install(); err != nil {
+	var cachePath string
+	if !cdk.disableCache {
+		key, err := cdk.cacheKey(body)
+		if err != nil {
+			return nil, err
+		}
+		cachePath = filepath.Join(cdk.rootAbsPath, ".build", cacheDirName, fmt.Sprintf("%s-%s.yml", cdk.cacheEnvKey(), key))
+		if cached, err := afero.ReadFile(cdk.fs, cachePath); err == nil {
+			return cached, nil
+		}
+	}
+
+	lang, err := cdk.language()
+	if err != nil {
+		return nil, err
+	}
+	if err := cdk.install(lang); err != nil {
+		return nil, err
+	}
+	out, err := cdk.transform(lang, body)
+	if err != nil {
 		return nil, err
 	}
-	out, err := cdk.transform(body)
+	out, err = cdk.cleanUp(out)
 	if err != nil {
 		return nil, err
 	}
-	return cdk.cleanUp(out)
+	if cachePath != "" {
+		if err := cdk.writeCache(cachePath, out); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// cacheEnvKey returns a digest of the environment variables passed to the CDK application.
+// It prefixes the names of the cached templates, so that a new template replaces the stale ones synthesized
+// for the same workload and environment.
+func (cdk *CDK) cacheEnvKey() string {
+	h := sha256.New()
+	keys := make([]string, 0, len(cdk.envVars))
+	for k := range cdk.envVars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%s\x00", k, cdk.envVars[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))[:cacheEnvKeyLen]
 }
 
-func (cdk *CDK) install() error {
-	manager, err := cdk.packageManager()
+// cacheKey returns a digest of the CDK application source files and the input body.
+func (cdk *CDK) cacheKey(body []byte) (string, error) {
+	h := sha256.New()
+	err := afero.Walk(cdk.fs, cdk.rootAbsPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if _, ok := cdkCacheIgnoredDirs[info.Name()]; ok && path != cdk.rootAbsPath {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		content, err := afero.ReadFile(cdk.fs, path)
+		if err != nil {
+			return fmt.Errorf("read file %s: %w", path, err)
+		}
+		rel, err := filepath.Rel(cdk.rootAbsPath, path)
+		if err != nil {
+			return err
+		}
+		// Separate each entry with a NUL byte so that file boundaries are part of the digest.
+		fmt.Fprintf(h, "%s\x00%d\x00", filepath.ToSlash(rel), len(content))
+		h.Write(content)
+		return nil
+	})
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("hash CDK application under %s: %w", cdk.rootAbsPath, err)
+	}
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeCache writes the template at path and removes the other cached templates that share its environment key.
+func (cdk *CDK) writeCache(path string, out []byte) error {
+	dir := filepath.Dir(path)
+	if err := cdk.fs.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create cache directory %s: %w", dir, err)
+	}
+	if err := afero.WriteFile(cdk.fs, path, out, 0644); err != nil {
+		return fmt.Errorf("write cached template at %s: %w", path, err)
+	}
+	stale, err := afero.Glob(cdk.fs, filepath.Join(dir, cdk.cacheEnvKey()+"-*.yml"))
 	if err != nil {
-		return err
+		return fmt.Errorf("list cached templates under %s: %w", dir, err)
 	}
-	cmd := cdk.exec.Command(manager, "install")
+	for _, f := range stale {
+		if f == path {
+			continue
+		}
+		if err := cdk.fs.Remove(f); err != nil {
+			return fmt.Errorf("remove stale cached template %s: %w", f, err)
+		}
+	}
+	return nil
+}
+
+// language returns the programming language of the CDK application under the overrides/ directory.
+func (cdk *CDK) language() (string, error) {
+	markers := []struct {
+		lang string
+		file string
+	}{
+		{lang: cdkLangGo, file: goModFile},
+		{lang: cdkLangPython, file: pythonReqsFile},
+	}
+	for _, marker := range markers {
+		exists, err := afero.Exists(cdk.fs, filepath.Join(cdk.rootAbsPath, marker.file))
+		if err != nil {
+			return "", fmt.Errorf("check if %s exists under %s: %w", marker.file, cdk.rootAbsPath, err)
+		}
+		if exists {
+			return marker.lang, nil
+		}
+	}
+	return cdkLangTypeScript, nil
+}
+
+func (cdk *CDK) install(lang string) error {
+	switch lang {
+	case cdkLangGo:
+		if err := cdk.lookPathToolchain("go", lang); err != nil {
+			return err
+		}
+		return cdk.run(cdk.exec.Command("go", "mod", "tidy"))
+	case cdkLangPython:
+		if err := cdk.lookPathToolchain("python3", lang); err != nil {
+			return err
+		}
+		// Install dependencies in a virtual environment so that the synth is isolated from the user's site-packages.
+		if err := cdk.run(cdk.exec.Command("python3", "-m", "venv", filepath.Join(".build", pythonVenvDirName))); err != nil {
+			return err
+		}
+		return cdk.run(cdk.exec.Command(pythonVenvBin("pip"), "install", "--quiet", "-r", pythonReqsFile))
+	default:
+		manager, err := cdk.packageManager()
+		if err != nil {
+			return err
+		}
+		return cdk.run(cdk.exec.Command(manager, "install"))
+	}
+}
+
+func (cdk *CDK) run(cmd *exec.Cmd) error {
 	cmd.Stdout = cdk.execWriter
 	cmd.Stderr = cdk.execWriter
 
@@ -126,7 +311,17 @@ func (cdk *CDK) install() error {
 	return nil
 }
 
-func (cdk *CDK) transform(body []byte) ([]byte, error) {
+func (cdk *CDK) lookPathToolchain(executable, lang string) error {
+	if _, err := cdk.exec.LookPath(executable); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return &errToolchainUnavailable{lang: lang, executable: executable}
+		}
+		return err
+	}
+	return nil
+}
+
+func (cdk *CDK) transform(lang string, body []byte) ([]byte, error) {
 	buildPath := filepath.Join(cdk.rootAbsPath, ".build")
 	if err := cdk.fs.MkdirAll(buildPath, 0755); err != nil {
 		return nil, fmt.Errorf("create %s directory to store the CloudFormation template body: %w", buildPath, err)
@@ -136,6 +331,13 @@ func (cdk *CDK) transform(body []byte) ([]byte, error) {
 		return nil, fmt.Errorf("write CloudFormation template body content at %s: %w", inputPath, err)
 	}
 
+	switch lang {
+	case cdkLangGo:
+		return cdk.synthCloudAssembly(cdk.exec.Command("go", "run", "."))
+	case cdkLangPython:
+		return cdk.synthCloudAssembly(cdk.exec.Command(pythonVenvBin("python"), "app.py"))
+	}
+
 	// We assume that a node_modules/ dir is present with the CDK downloaded after running "npm install".
 	// This way clients don't need to install the CDK toolkit separately.
 	cmd := cdk.exec.Command(filepath.Join("node_modules", ".bin", "cdk"), "synth", "--no-version-reporting")
@@ -148,6 +350,33 @@ func (cdk *CDK) transform(body []byte) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// synthCloudAssembly runs the CDK application with cmd and returns the template of the synthesized stack.
+// The CDK application writes its cloud assembly to the directory set in the CDK_OUTDIR environment variable.
+func (cdk *CDK) synthCloudAssembly(cmd *exec.Cmd) ([]byte, error) {
+	outDir := filepath.Join(cdk.rootAbsPath, ".build", cdkOutDirName)
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, fmt.Sprintf("CDK_OUTDIR=%s", outDir))
+	if err := cdk.run(cmd); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(outDir, fmt.Sprintf("%s.template.json", cdkStackName))
+	out, err := afero.ReadFile(cdk.fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("read synthesized template at %s: %w", path, err)
+	}
+	return out, nil
+}
+
+// pythonVenvBin returns the path, relative to the overrides/ directory, of an executable in the Python virtual environment.
+func pythonVenvBin(name string) string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(".build", pythonVenvDirName, "Scripts", name)
+	}
+	return filepath.Join(".build", pythonVenvDirName, "bin", name)
+}
+
 // cleanUp removes YAML additions that get injected by the CDK that are unnecessary,
 // and transforms the Description string of the CloudFormation template to highlight the template is now overridden with the CDK.
 func (cdk *CDK) cleanUp(in []byte) ([]byte, error) {
@@ -269,9 +498,10 @@ func (cdk *CDK) packageManager() (string, error) {
 	return defaultPackageManager, nil
 }
 
-// ScaffoldWithCDK bootstraps a CDK application under dir/ to override the seed CloudFormation resources.
+// ScaffoldWithCDK bootstraps a CDK application written in lang under dir/ to override the seed CloudFormation resources.
+// Supported languages are "typescript", "go", and "python". If lang is empty, defaults to "typescript".
 // If the directory is not empty, then returns an error.
-func ScaffoldWithCDK(fs afero.Fs, dir string, seeds []template.CFNResource, requiresEnv bool) error {
+func ScaffoldWithCDK(fs afero.Fs, dir string, seeds []template.CFNResource, requiresEnv bool, lang string) error {
 	// If the directory does not exist, [afero.IsEmpty] returns false and an error.
 	// Therefore, we only want to check if a directory is empty only if it also exists.
 	exists, _ := afero.Exists(fs, dir)
@@ -280,7 +510,16 @@ func ScaffoldWithCDK(fs afero.Fs, dir string, seeds []template.CFNResource, requ
 		return fmt.Errorf("directory %q is not empty", dir)
 	}
 
-	return templates.WalkOverridesCDKDir(seeds, writeFilesToDir(dir, fs), requiresEnv)
+	switch lang {
+	case cdkLangGo:
+		return templates.WalkOverridesCDKGoDir(seeds, writeFilesToDir(dir, fs), requiresEnv)
+	case cdkLangPython:
+		return templates.WalkOverridesCDKPythonDir(seeds, writeFilesToDir(dir, fs), requiresEnv)
+	case cdkLangTypeScript, "":
+		return templates.WalkOverridesCDKDir(seeds, writeFilesToDir(dir, fs), requiresEnv)
+	default:
+		return fmt.Errorf("unsupported CDK language %q", lang)
+	}
 }
 
 func writeFilesToDir(dir string, fs afero.Fs) template.WalkDirFunc {
@@ -312,6 +312,106 @@ func TestCDK_Override(t *testing.T) {
 		require.Contains(t, buf.String(), "npm install")
 		require.Contains(t, string(out), fmt.Sprintf("%s synth --no-version-reporting", filepath.Join("node_modules", ".bin", "cdk")))
 	})
+	t.Run("on install: should return a wrapped error if go is not installed for a Go CDK application", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_, _ = fs.Create("go.mod")
+		cdk := WithCDK("", CDKOpts{
+			FS: fs,
+			LookPathFn: func(file string) (string, error) {
+				return "", &exec.Error{Name: file, Err: exec.ErrNotFound}
+			},
+		})
+
+		// WHEN
+		_, err := cdk.Override(nil)
+
+		// THEN
+		require.EqualError(t, err, `cannot find "go" to override with the Cloud Development Kit in go`)
+	})
+	t.Run("should invoke go mod tidy and go run, and read the synthesized template for a Go CDK application", func(t *testing.T) {
+		// GIVEN
+		buf := new(strings.Builder)
+		fs := afero.NewMemMapFs()
+		_, _ = fs.Create("go.mod")
+		_ = fs.MkdirAll(filepath.Join(".build", "cdk.out"), 0755)
+		_ = afero.WriteFile(fs, filepath.Join(".build", "cdk.out", "Stack.template.json"), []byte(`{"Description": "Go template."}`), 0644)
+		var synthCmd *exec.Cmd
+		cdk := WithCDK("", CDKOpts{
+			ExecWriter: buf,
+			FS:         fs,
+			LookPathFn: func(file string) (string, error) {
+				return fmt.Sprintf("/bin/%s", file), nil
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				cmd := exec.Command("echo", strings.Join(append([]string{name}, args...), " "))
+				if len(args) > 0 && args[0] == "run" {
+					cmd.Env = []string{"COPILOT_APPLICATION_NAME=demo"}
+					synthCmd = cmd
+				}
+				return cmd
+			},
+		})
+
+		// WHEN
+		out, err := cdk.Override(nil)
+
+		// THEN
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), "go mod tidy")
+		require.Contains(t, buf.String(), "go run .")
+		require.Contains(t, string(out), "Description: Go template using AWS Copilot and CDK.")
+		require.Equal(t, []string{"COPILOT_APPLICATION_NAME=demo", fmt.Sprintf("CDK_OUTDIR=%s", filepath.Join(".build", "cdk.out"))}, synthCmd.Env)
+	})
+	t.Run("should create a virtual environment, install requirements and run app.py for a Python CDK application", func(t *testing.T) {
+		// GIVEN
+		buf := new(strings.Builder)
+		fs := afero.NewMemMapFs()
+		_, _ = fs.Create("requirements.txt")
+		_ = fs.MkdirAll(filepath.Join(".build", "cdk.out"), 0755)
+		_ = afero.WriteFile(fs, filepath.Join(".build", "cdk.out", "Stack.template.json"), []byte(`{"Description": "Python template."}`), 0644)
+		cdk := WithCDK("", CDKOpts{
+			ExecWriter: buf,
+			FS:         fs,
+			LookPathFn: func(file string) (string, error) {
+				return fmt.Sprintf("/bin/%s", file), nil
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				return exec.Command("echo", strings.Join(append([]string{name}, args...), " "))
+			},
+		})
+
+		// WHEN
+		out, err := cdk.Override(nil)
+
+		// THEN
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), fmt.Sprintf("python3 -m venv %s", filepath.Join(".build", "venv")))
+		require.Contains(t, buf.String(), fmt.Sprintf("%s install --quiet -r requirements.txt", pythonVenvBin("pip")))
+		require.Contains(t, buf.String(), fmt.Sprintf("%s app.py", pythonVenvBin("python")))
+		require.Contains(t, string(out), "Description: Python template using AWS Copilot and CDK.")
+	})
+	t.Run("should return a wrapped error if the synthesized template cannot be found", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_, _ = fs.Create("go.mod")
+		cdk := WithCDK("", CDKOpts{
+			ExecWriter: new(bytes.Buffer),
+			FS:         fs,
+			LookPathFn: func(file string) (string, error) {
+				return "/bin/go", nil
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				return exec.Command("echo")
+			},
+		})
+
+		// WHEN
+		_, err := cdk.Override(nil)
+
+		// THEN
+		require.ErrorContains(t, err, fmt.Sprintf("read synthesized template at %s", filepath.Join(".build", "cdk.out", "Stack.template.json")))
+	})
 	t.Run("should return the transformed document with CDK metadata stripped and description updated", func(t *testing.T) {
 		buf := new(strings.Builder)
 		cdk := WithCDK("", CDKOpts{
@@ -395,6 +495,142 @@ Outputs:
 	})
 }
 
+func TestCDK_OverrideCache(t *testing.T) {
+	newCDK := func(fs afero.Fs, numCalls *int, disableCache bool) *CDK {
+		return WithCDK("overrides", CDKOpts{
+			ExecWriter: new(bytes.Buffer),
+			FS:         fs,
+			EnvVars: map[string]string{
+				"COPILOT_APPLICATION_NAME": "demo",
+			},
+			LookPathFn: func(file string) (string, error) {
+				return "/bin/npm", nil
+			},
+			CommandFn: func(name string, args ...string) *exec.Cmd {
+				*numCalls += 1
+				return exec.Command("echo", fmt.Sprintf("Description: call %d", *numCalls))
+			},
+			DisableCache: disableCache,
+		})
+	}
+	t.Run("should return the cached template if the source and input did not change", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, filepath.Join("overrides", "stack.ts"), []byte("stack"), 0644)
+		var numCalls int
+		cdk := newCDK(fs, &numCalls, false)
+
+		// WHEN
+		first, err := cdk.Override([]byte("body"))
+		require.NoError(t, err)
+		second, err := cdk.Override([]byte("body"))
+		require.NoError(t, err)
+
+		// THEN
+		require.Equal(t, 2, numCalls, "expected install and synth to run only once")
+		require.Equal(t, first, second)
+	})
+	t.Run("should synthesize again if the input body changes", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, filepath.Join("overrides", "stack.ts"), []byte("stack"), 0644)
+		var numCalls int
+		cdk := newCDK(fs, &numCalls, false)
+
+		// WHEN
+		_, err := cdk.Override([]byte("first"))
+		require.NoError(t, err)
+		_, err = cdk.Override([]byte("second"))
+		require.NoError(t, err)
+
+		// THEN
+		require.Equal(t, 4, numCalls)
+	})
+	t.Run("should synthesize again if the CDK application source changes", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, filepath.Join("overrides", "stack.ts"), []byte("stack"), 0644)
+		var numCalls int
+		cdk := newCDK(fs, &numCalls, false)
+
+		// WHEN
+		_, err := cdk.Override([]byte("body"))
+		require.NoError(t, err)
+		_ = afero.WriteFile(fs, filepath.Join("overrides", "stack.ts"), []byte("updated stack"), 0644)
+		_, err = cdk.Override([]byte("body"))
+		require.NoError(t, err)
+
+		// THEN
+		require.Equal(t, 4, numCalls)
+	})
+	t.Run("should only keep the latest template for the same environment variables", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, filepath.Join("overrides", "stack.ts"), []byte("stack"), 0644)
+		var numCalls int
+		cdk := newCDK(fs, &numCalls, false)
+		other := newCDK(fs, &numCalls, false)
+		other.envVars = map[string]string{
+			"COPILOT_APPLICATION_NAME": "demo",
+			"COPILOT_ENVIRONMENT_NAME": "prod",
+		}
+
+		// WHEN
+		_, err := other.Override([]byte("body"))
+		require.NoError(t, err)
+		_, err = cdk.Override([]byte("first"))
+		require.NoError(t, err)
+		_, err = cdk.Override([]byte("second"))
+		require.NoError(t, err)
+		_, err = cdk.Override([]byte("second"))
+		require.NoError(t, err)
+		_, err = other.Override([]byte("body"))
+		require.NoError(t, err)
+
+		// THEN
+		require.Equal(t, 6, numCalls, "expected the cached templates of both sets of environment variables to be reused")
+		entries, err := afero.ReadDir(fs, filepath.Join("overrides", ".build", "cache"))
+		require.NoError(t, err)
+		require.Len(t, entries, 2, "expected the template of the first body to be pruned")
+	})
+	t.Run("should ignore changes to generated directories", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, filepath.Join("overrides", "stack.ts"), []byte("stack"), 0644)
+		var numCalls int
+		cdk := newCDK(fs, &numCalls, false)
+
+		// WHEN
+		_, err := cdk.Override([]byte("body"))
+		require.NoError(t, err)
+		_ = afero.WriteFile(fs, filepath.Join("overrides", "node_modules", "aws-cdk", "index.js"), []byte("js"), 0644)
+		_, err = cdk.Override([]byte("body"))
+		require.NoError(t, err)
+
+		// THEN
+		require.Equal(t, 2, numCalls)
+	})
+	t.Run("should always synthesize if the cache is disabled", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, filepath.Join("overrides", "stack.ts"), []byte("stack"), 0644)
+		var numCalls int
+		cdk := newCDK(fs, &numCalls, true)
+
+		// WHEN
+		_, err := cdk.Override([]byte("body"))
+		require.NoError(t, err)
+		out, err := cdk.Override([]byte("body"))
+		require.NoError(t, err)
+
+		// THEN
+		require.Equal(t, 4, numCalls)
+		require.Contains(t, string(out), "call 4")
+		exists, _ := afero.DirExists(fs, filepath.Join("overrides", ".build", "cache"))
+		require.False(t, exists, "should not write to the cache")
+	})
+}
+
 func TestScaffoldWithCDK(t *testing.T) {
 	t.Run("scaffolds files in an empty directory", func(t *testing.T) {
 		// GIVEN
@@ -407,7 +643,7 @@ func TestScaffoldWithCDK(t *testing.T) {
 				Type:      "AWS::ECS::Service",
 				LogicalID: "Service",
 			},
-		}, true)
+		}, true, "")
 
 		// THEN
 		require.NoError(t, err)
@@ -424,6 +660,59 @@ func TestScaffoldWithCDK(t *testing.T) {
 		ok, _ = afero.Exists(fs, filepath.Join(dir, "bin", "override.ts"))
 		require.True(t, ok, "bin/override.ts should exist")
 	})
+	t.Run("scaffolds a Go CDK application in an empty directory", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		dir := filepath.Join("copilot", "frontend", "overrides")
+
+		// WHEN
+		err := ScaffoldWithCDK(fs, dir, []template.CFNResource{
+			{
+				Type:      "AWS::ECS::Service",
+				LogicalID: "Service",
+			},
+		}, true, "go")
+
+		// THEN
+		require.NoError(t, err)
+		for _, name := range []string{"cdk.json", "go.mod", "override.go", "stack.go"} {
+			ok, _ := afero.Exists(fs, filepath.Join(dir, name))
+			require.True(t, ok, "%s should exist", name)
+		}
+		stack, _ := afero.ReadFile(fs, filepath.Join(dir, "stack.go"))
+		require.Contains(t, string(stack), `"github.com/aws/aws-cdk-go/awscdk/v2/awsecs"`)
+		require.Contains(t, string(stack), `.(awsecs.CfnService)`)
+	})
+	t.Run("scaffolds a Python CDK application in an empty directory", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		dir := filepath.Join("copilot", "frontend", "overrides")
+
+		// WHEN
+		err := ScaffoldWithCDK(fs, dir, []template.CFNResource{
+			{
+				Type:      "AWS::ECS::Service",
+				LogicalID: "Service",
+			},
+		}, false, "python")
+
+		// THEN
+		require.NoError(t, err)
+		for _, name := range []string{"cdk.json", "requirements.txt", "app.py", "stack.py"} {
+			ok, _ := afero.Exists(fs, filepath.Join(dir, name))
+			require.True(t, ok, "%s should exist", name)
+		}
+		stack, _ := afero.ReadFile(fs, filepath.Join(dir, "stack.py"))
+		require.Contains(t, string(stack), "from aws_cdk import aws_ecs as ecs")
+		require.NotContains(t, string(stack), "env_name")
+	})
+	t.Run("should return an error if the language is not supported", func(t *testing.T) {
+		// WHEN
+		err := ScaffoldWithCDK(afero.NewMemMapFs(), "overrides", nil, true, "java")
+
+		// THEN
+		require.EqualError(t, err, `unsupported CDK language "java"`)
+	})
 	t.Run("should return an error if the directory is not empty", func(t *testing.T) {
 		// GIVEN
 		fs := afero.NewMemMapFs()
@@ -432,7 +721,7 @@ func TestScaffoldWithCDK(t *testing.T) {
 		_ = afero.WriteFile(fs, filepath.Join(dir, "cdk.json"), []byte("content"), 0644)
 
 		// WHEN
-		err := ScaffoldWithCDK(fs, dir, nil, true)
+		err := ScaffoldWithCDK(fs, dir, nil, true, "")
 
 		// THEN
 		require.EqualError(t, err, fmt.Sprintf("directory %q is not empty", dir))
@@ -22,6 +22,24 @@ func (err *errPackageManagerUnavailable) RecommendActions() string {
 		"yarn", "https://yarnpkg.com/getting-started/install")
 }
 
+type errToolchainUnavailable struct {
+	lang       string
+	executable string
+}
+
+func (err *errToolchainUnavailable) Error() string {
+	return fmt.Sprintf("cannot find %q to override with the Cloud Development Kit in %s", err.executable, err.lang)
+}
+
+// RecommendActions implements the cli.actionRecommender interface.
+func (err *errToolchainUnavailable) RecommendActions() string {
+	urls := map[string]string{
+		cdkLangGo:     "https://go.dev/doc/install",
+		cdkLangPython: "https://www.python.org/downloads/",
+	}
+	return fmt.Sprintf("Please install %q and make sure it is in your $PATH: %q", err.executable, urls[err.lang])
+}
+
 // ErrNotExist occurs when the path of the file associated with an Overrider does not exist.
 type ErrNotExist struct {
 	parent error
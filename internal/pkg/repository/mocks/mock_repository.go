@@ -50,6 +50,21 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Build(ctx, args, w interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Build), ctx, args, w)
 }
 
+// BuildAndPushMultiPlatform mocks base method.
+func (m *MockContainerLoginBuildPusher) BuildAndPushMultiPlatform(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildAndPushMultiPlatform", ctx, args, w)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildAndPushMultiPlatform indicates an expected call of BuildAndPushMultiPlatform.
+func (mr *MockContainerLoginBuildPusherMockRecorder) BuildAndPushMultiPlatform(ctx, args, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPushMultiPlatform", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).BuildAndPushMultiPlatform), ctx, args, w)
+}
+
 // IsEcrCredentialHelperEnabled mocks base method.
 func (m *MockContainerLoginBuildPusher) IsEcrCredentialHelperEnabled(uri string) bool {
 	m.ctrl.T.Helper()
@@ -98,6 +113,43 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Push(ctx, uri, w interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Push), varargs...)
 }
 
+// MockSOCIIndexPusher is a mock of SOCIIndexPusher interface.
+type MockSOCIIndexPusher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSOCIIndexPusherMockRecorder
+}
+
+// MockSOCIIndexPusherMockRecorder is the mock recorder for MockSOCIIndexPusher.
+type MockSOCIIndexPusherMockRecorder struct {
+	mock *MockSOCIIndexPusher
+}
+
+// NewMockSOCIIndexPusher creates a new mock instance.
+func NewMockSOCIIndexPusher(ctrl *gomock.Controller) *MockSOCIIndexPusher {
+	mock := &MockSOCIIndexPusher{ctrl: ctrl}
+	mock.recorder = &MockSOCIIndexPusherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSOCIIndexPusher) EXPECT() *MockSOCIIndexPusherMockRecorder {
+	return m.recorder
+}
+
+// CreateAndPushIndex mocks base method.
+func (m *MockSOCIIndexPusher) CreateAndPushIndex(ctx context.Context, image string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAndPushIndex", ctx, image, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAndPushIndex indicates an expected call of CreateAndPushIndex.
+func (mr *MockSOCIIndexPusherMockRecorder) CreateAndPushIndex(ctx, image, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndPushIndex", reflect.TypeOf((*MockSOCIIndexPusher)(nil).CreateAndPushIndex), ctx, image, w)
+}
+
 // MockRegistry is a mock of Registry interface.
 type MockRegistry struct {
 	ctrl     *gomock.Controller
@@ -151,3 +203,76 @@ func (mr *MockRegistryMockRecorder) RepositoryURI(name interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepositoryURI", reflect.TypeOf((*MockRegistry)(nil).RepositoryURI), name)
 }
+
+// MockCache is a mock of Cache interface.
+type MockCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder struct {
+	mock *MockCache
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache(ctrl *gomock.Controller) *MockCache {
+	mock := &MockCache{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache) EXPECT() *MockCacheMockRecorder {
+	return m.recorder
+}
+
+// ForgetLogin mocks base method.
+func (m *MockCache) ForgetLogin(registry string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ForgetLogin", registry)
+}
+
+// ForgetLogin indicates an expected call of ForgetLogin.
+func (mr *MockCacheMockRecorder) ForgetLogin(registry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgetLogin", reflect.TypeOf((*MockCache)(nil).ForgetLogin), registry)
+}
+
+// LoggedIn mocks base method.
+func (m *MockCache) LoggedIn(registry string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoggedIn", registry)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// LoggedIn indicates an expected call of LoggedIn.
+func (mr *MockCacheMockRecorder) LoggedIn(registry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoggedIn", reflect.TypeOf((*MockCache)(nil).LoggedIn), registry)
+}
+
+// SetDigest mocks base method.
+func (m *MockCache) SetDigest(image, digest string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDigest", image, digest)
+}
+
+// SetDigest indicates an expected call of SetDigest.
+func (mr *MockCacheMockRecorder) SetDigest(image, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDigest", reflect.TypeOf((*MockCache)(nil).SetDigest), image, digest)
+}
+
+// SetLoggedIn mocks base method.
+func (m *MockCache) SetLoggedIn(registry string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLoggedIn", registry)
+}
+
+// SetLoggedIn indicates an expected call of SetLoggedIn.
+func (mr *MockCacheMockRecorder) SetLoggedIn(registry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoggedIn", reflect.TypeOf((*MockCache)(nil).SetLoggedIn), registry)
+}
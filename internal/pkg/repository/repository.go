@@ -8,7 +8,9 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
@@ -17,41 +19,63 @@ import (
 // ContainerLoginBuildPusher provides support for logging in to repositories, building images and pushing images to repositories.
 type ContainerLoginBuildPusher interface {
 	Build(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) error
+	BuildAndPushMultiPlatform(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (digest string, err error)
 	Login(uri, username, password string) error
 	Push(ctx context.Context, uri string, w io.Writer, tags ...string) (digest string, err error)
 	IsEcrCredentialHelperEnabled(uri string) bool
 }
 
+// SOCIIndexPusher generates and pushes the Seekable OCI indexes of images to their repositories.
+type SOCIIndexPusher interface {
+	CreateAndPushIndex(ctx context.Context, image string, w io.Writer) error
+}
+
 // Registry gets information of repositories.
 type Registry interface {
 	RepositoryURI(name string) (string, error)
 	Auth() (string, string, error)
 }
 
+// Cache remembers the registries that Docker recently logged in to and the digests of the images pushed to them.
+type Cache interface {
+	LoggedIn(registry string) bool
+	SetLoggedIn(registry string)
+	ForgetLogin(registry string)
+	SetDigest(image, digest string)
+}
+
 // Repository builds and pushes images to a repository.
 type Repository struct {
 	name     string
 	registry Registry
 	uri      string
 	docker   ContainerLoginBuildPusher
+	soci     SOCIIndexPusher
+	cache    Cache
 }
 
 // New instantiates a new Repository.
 func New(registry Registry, name string) *Repository {
+	docker := dockerengine.New(exec.NewCmd())
 	return &Repository{
 		name:     name,
 		registry: registry,
-		docker:   dockerengine.New(exec.NewCmd()),
+		docker:   docker,
+		soci:     NewSOCICmdClient(exec.NewCmd(), docker.Runtime()),
+		cache:    ecr.NewCache(),
 	}
 }
 
 // NewWithURI instantiates a new Repository with uri being set.
 func NewWithURI(registry Registry, name, uri string) *Repository {
+	docker := dockerengine.New(exec.NewCmd())
 	return &Repository{
 		name:     name,
 		registry: registry,
 		uri:      uri,
-		docker:   dockerengine.New(exec.NewCmd()),
+		docker:   docker,
+		soci:     NewSOCICmdClient(exec.NewCmd(), docker.Runtime()),
+		cache:    ecr.NewCache(),
 	}
 }
 
@@ -65,6 +89,7 @@ func (r *Repository) Build(ctx context.Context, args *dockerengine.BuildArgument
 }
 
 // BuildAndPush builds the image from Dockerfile and pushes it to the repository with tags.
+// An image for several platforms is pushed as it's built, as a manifest list that references the image of each platform.
 func (r *Repository) BuildAndPush(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (digest string, err error) {
 	if args.URI == "" {
 		uri, err := r.repositoryURI()
@@ -73,14 +98,34 @@ func (r *Repository) BuildAndPush(ctx context.Context, args *dockerengine.BuildA
 		}
 		args.URI = uri
 	}
+	if dockerengine.IsMultiPlatform(args.Platform) {
+		if args.SOCI {
+			return "", fmt.Errorf("generate a SOCI index of the image for platforms %s: an index can only be generated for an image of a single platform", args.Platform)
+		}
+		digest, err = r.docker.BuildAndPushMultiPlatform(ctx, args, w)
+		if err != nil {
+			r.forgetLogin(args.URI)
+			return "", fmt.Errorf("build and push Dockerfile at %s to repo %s for platforms %s: %w", args.Dockerfile, r.name, args.Platform, err)
+		}
+		r.cacheDigest(args.URI, args.Tags, digest)
+		return digest, nil
+	}
 	if err := r.docker.Build(ctx, args, w); err != nil {
 		return "", fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
 	}
 
 	digest, err = r.docker.Push(ctx, args.URI, w, args.Tags...)
 	if err != nil {
+		r.forgetLogin(args.URI)
 		return "", fmt.Errorf("push to repo %s: %w", r.name, err)
 	}
+	r.cacheDigest(args.URI, args.Tags, digest)
+	if args.SOCI {
+		// The index is associated with the digest of the image, so any of its tags is enough.
+		if err := r.soci.CreateAndPushIndex(ctx, fmt.Sprintf("%s:%s", args.URI, args.Tags[0]), w); err != nil {
+			return "", fmt.Errorf("push SOCI index to repo %s: %w", r.name, err)
+		}
+	}
 	return digest, nil
 }
 
@@ -100,21 +145,53 @@ func (r *Repository) repositoryURI() (string, error) {
 // Login authenticates with a ECR registry by performing a Docker login,
 // but only if the `credStore` attribute value is not set to `ecr-login`.
 // If the `credStore` value is `ecr-login`, no login is performed.
+// No login is performed either if Docker logged in to the registry recently, as the login is still valid.
 // Returns uri of the repository or an error, if any occurs during the login process.
 func (r *Repository) Login() (string, error) {
 	uri, err := r.repositoryURI()
 	if err != nil {
 		return "", fmt.Errorf("retrieve URI for repository: %w", err)
 	}
-	if !r.docker.IsEcrCredentialHelperEnabled(uri) {
-		username, password, err := r.registry.Auth()
-		if err != nil {
-			return "", fmt.Errorf("get auth: %w", err)
-		}
+	if r.docker.IsEcrCredentialHelperEnabled(uri) {
+		return uri, nil
+	}
+	if r.cache != nil && r.cache.LoggedIn(registryOf(uri)) {
+		return uri, nil
+	}
+	username, password, err := r.registry.Auth()
+	if err != nil {
+		return "", fmt.Errorf("get auth: %w", err)
+	}
 
-		if err := r.docker.Login(uri, username, password); err != nil {
-			return "", fmt.Errorf("docker login %s: %w", uri, err)
-		}
+	if err := r.docker.Login(uri, username, password); err != nil {
+		return "", fmt.Errorf("docker login %s: %w", uri, err)
+	}
+	if r.cache != nil {
+		r.cache.SetLoggedIn(registryOf(uri))
 	}
 	return uri, nil
 }
+
+// forgetLogin drops the cached login to the registry of the repository, so that the next command logs in again
+// in case the push failed because of the login.
+func (r *Repository) forgetLogin(uri string) {
+	if r.cache != nil {
+		r.cache.ForgetLogin(registryOf(uri))
+	}
+}
+
+// cacheDigest records the digest of the pushed tags so that the next commands don't look it up.
+func (r *Repository) cacheDigest(uri string, tags []string, digest string) {
+	if r.cache == nil || digest == "" {
+		return
+	}
+	for _, tag := range tags {
+		r.cache.SetDigest(fmt.Sprintf("%s:%s", uri, tag), digest)
+	}
+}
+
+// registryOf returns the registry of the repository uri, like "123456789012.dkr.ecr.us-west-2.amazonaws.com".
+func registryOf(uri string) string {
+	registry, _, _ := strings.Cut(uri, "/")
+	return registry
+}
@@ -86,10 +86,16 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		Context:    filepath.Dir(inDockerfilePath),
 		Tags:       []string{mockTag1, mockTag2, mockTag3},
 	}
+	multiPlatformDockerArguments := defaultDockerArguments
+	multiPlatformDockerArguments.Platform = "linux/amd64,linux/arm64"
 
 	testCases := map[string]struct {
 		inURI        string
+		inPlatform   string
+		inSOCI       bool
 		inMockDocker func(m *mocks.MockContainerLoginBuildPusher)
+		inMockSOCI   func(m *mocks.MockSOCIIndexPusher)
+		inMockCache  func(m *mocks.MockCache)
 
 		mockRegistry func(m *mocks.MockRegistry)
 
@@ -122,6 +128,30 @@ func TestRepository_BuildAndPush(t *testing.T) {
 			},
 			wantedError: errors.New("push to repo my-repo: error pushing image"),
 		},
+		"forget the login to the registry if the push fails": {
+			inURI: defaultDockerArguments.URI,
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(ctx, &defaultDockerArguments, gomock.Any()).Times(1)
+				m.EXPECT().Push(ctx, mockRepoURI, gomock.Any(), mockTag1, mockTag2, mockTag3).Return("", errors.New("error pushing image"))
+			},
+			inMockCache: func(m *mocks.MockCache) {
+				m.EXPECT().ForgetLogin(mockRepoURI)
+			},
+			wantedError: errors.New("push to repo my-repo: error pushing image"),
+		},
+		"cache the digest of the pushed tags": {
+			inURI: defaultDockerArguments.URI,
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(ctx, &defaultDockerArguments, gomock.Any()).Return(nil)
+				m.EXPECT().Push(ctx, mockRepoURI, gomock.Any(), mockTag1, mockTag2, mockTag3).Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil)
+			},
+			inMockCache: func(m *mocks.MockCache) {
+				m.EXPECT().SetDigest("mockRepoURI:tag1", "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807")
+				m.EXPECT().SetDigest("mockRepoURI:tag2", "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807")
+				m.EXPECT().SetDigest("mockRepoURI:tag3", "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807")
+			},
+			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
 		"push with ecr-login": {
 			inURI: defaultDockerArguments.URI,
 			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
@@ -130,6 +160,57 @@ func TestRepository_BuildAndPush(t *testing.T) {
 			},
 			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
 		},
+		"failed to build and push image for several platforms": {
+			inURI:      defaultDockerArguments.URI,
+			inPlatform: "linux/amd64,linux/arm64",
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().BuildAndPushMultiPlatform(ctx, &multiPlatformDockerArguments, gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("build and push Dockerfile at %s to repo my-repo for platforms linux/amd64,linux/arm64: some error", inDockerfilePath),
+		},
+		"build and push image for several platforms": {
+			inURI:      defaultDockerArguments.URI,
+			inPlatform: "linux/amd64,linux/arm64",
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().BuildAndPushMultiPlatform(ctx, &multiPlatformDockerArguments, gomock.Any()).Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil)
+				m.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().Push(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
+		"failed to push SOCI index": {
+			inURI:  defaultDockerArguments.URI,
+			inSOCI: true,
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().Push(ctx, mockRepoURI, gomock.Any(), mockTag1, mockTag2, mockTag3).Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil)
+			},
+			inMockSOCI: func(m *mocks.MockSOCIIndexPusher) {
+				m.EXPECT().CreateAndPushIndex(ctx, "mockRepoURI:tag1", gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("push SOCI index to repo my-repo: some error"),
+		},
+		"push SOCI index after the image": {
+			inURI:  defaultDockerArguments.URI,
+			inSOCI: true,
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().Push(ctx, mockRepoURI, gomock.Any(), mockTag1, mockTag2, mockTag3).Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil)
+			},
+			inMockSOCI: func(m *mocks.MockSOCIIndexPusher) {
+				m.EXPECT().CreateAndPushIndex(ctx, "mockRepoURI:tag1", gomock.Any()).Return(nil)
+			},
+			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
+		"error if a SOCI index is requested for several platforms": {
+			inURI:      defaultDockerArguments.URI,
+			inPlatform: "linux/amd64,linux/arm64",
+			inSOCI:     true,
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().BuildAndPushMultiPlatform(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("generate a SOCI index of the image for platforms linux/amd64,linux/arm64: an index can only be generated for an image of a single platform"),
+		},
 		"success": {
 			mockRegistry: func(m *mocks.MockRegistry) {
 				m.EXPECT().RepositoryURI(inRepoName).Return(defaultDockerArguments.URI, nil)
@@ -148,6 +229,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 
 			mockRepoGetter := mocks.NewMockRegistry(ctrl)
 			mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+			mockSOCI := mocks.NewMockSOCIIndexPusher(ctrl)
 
 			if tc.mockRegistry != nil {
 				tc.mockRegistry(mockRepoGetter)
@@ -155,18 +237,29 @@ func TestRepository_BuildAndPush(t *testing.T) {
 			if tc.inMockDocker != nil {
 				tc.inMockDocker(mockDocker)
 			}
+			if tc.inMockSOCI != nil {
+				tc.inMockSOCI(mockSOCI)
+			}
 
 			repo := &Repository{
 				name:     inRepoName,
 				registry: mockRepoGetter,
 				uri:      tc.inURI,
 				docker:   mockDocker,
+				soci:     mockSOCI,
+			}
+			if tc.inMockCache != nil {
+				mockCache := mocks.NewMockCache(ctrl)
+				tc.inMockCache(mockCache)
+				repo.cache = mockCache
 			}
 			buf := new(strings.Builder)
 			digest, err := repo.BuildAndPush(ctx, &dockerengine.BuildArguments{
 				Dockerfile: inDockerfilePath,
 				Context:    filepath.Dir(inDockerfilePath),
 				Tags:       []string{mockTag1, mockTag2, mockTag3},
+				Platform:   tc.inPlatform,
+				SOCI:       tc.inSOCI,
 			}, buf)
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
@@ -183,6 +276,7 @@ func Test_Login(t *testing.T) {
 	testCases := map[string]struct {
 		inMockDocker func(m *mocks.MockContainerLoginBuildPusher)
 		mockRegistry func(m *mocks.MockRegistry)
+		mockCache    func(m *mocks.MockCache)
 		wantedURI    string
 		wantedError  error
 	}{
@@ -216,6 +310,33 @@ func Test_Login(t *testing.T) {
 			},
 			wantedURI: mockRepoURI,
 		},
+		"skip login if docker logged in to the registry recently": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Times(0)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled("mockRepoURI").Return(false)
+				m.EXPECT().Login(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockCache: func(m *mocks.MockCache) {
+				m.EXPECT().LoggedIn("mockRepoURI").Return(true)
+			},
+			wantedURI: mockRepoURI,
+		},
+		"cache the login to the registry": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled("mockRepoURI").Return(false)
+				m.EXPECT().Login("mockRepoURI", "my-name", "my-pwd").Return(nil)
+			},
+			mockCache: func(m *mocks.MockCache) {
+				m.EXPECT().LoggedIn("mockRepoURI").Return(false)
+				m.EXPECT().SetLoggedIn("mockRepoURI")
+			},
+			wantedURI: mockRepoURI,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -236,6 +357,11 @@ func Test_Login(t *testing.T) {
 				uri:      mockRepoURI,
 				docker:   mockDocker,
 			}
+			if tc.mockCache != nil {
+				mockCache := mocks.NewMockCache(ctrl)
+				tc.mockCache(mockCache)
+				repo.cache = mockCache
+			}
 
 			gotURI, gotErr := repo.Login()
 			if tc.wantedError != nil {
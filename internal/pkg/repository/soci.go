@@ -0,0 +1,60 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+const sociCmd = "soci"
+
+// containerdNamespaces are the namespaces of containerd where the container runtimes store their images.
+// Docker stores its images in containerd only when the containerd image store is enabled.
+var containerdNamespaces = map[string]string{
+	dockerengine.RuntimeDocker: "moby",
+	dockerengine.RuntimeFinch:  "finch",
+}
+
+type runner interface {
+	RunWithContext(ctx context.Context, name string, args []string, opts ...exec.CmdOption) error
+}
+
+// SOCICmdClient generates and pushes Seekable OCI (SOCI) indexes of images with the soci CLI,
+// so that Fargate lazy loads the images instead of downloading them before starting the tasks.
+type SOCICmdClient struct {
+	runner  runner
+	runtime string // Name of the container runtime that built the images, such as "docker".
+}
+
+// NewSOCICmdClient returns a client for the images built by the container runtime.
+func NewSOCICmdClient(runner runner, runtime string) *SOCICmdClient {
+	return &SOCICmdClient{
+		runner:  runner,
+		runtime: runtime,
+	}
+}
+
+// CreateAndPushIndex generates the SOCI index of the image in the local image store,
+// and pushes it to the repository of the image, using the credentials of the container runtime.
+func (c *SOCICmdClient) CreateAndPushIndex(ctx context.Context, image string, w io.Writer) error {
+	namespace, ok := containerdNamespaces[c.runtime]
+	if !ok {
+		return fmt.Errorf("a SOCI index can only be generated for an image in the containerd image store of docker or finch, not %s", c.runtime)
+	}
+	if err := c.runner.RunWithContext(ctx, sociCmd, []string{"--namespace", namespace, "create", image}, exec.Stdout(w), exec.Stderr(w)); err != nil {
+		if c.runtime == dockerengine.RuntimeDocker {
+			return fmt.Errorf("soci create %s: %w; check that the containerd image store of docker is enabled", image, err)
+		}
+		return fmt.Errorf("soci create %s: %w", image, err)
+	}
+	if err := c.runner.RunWithContext(ctx, sociCmd, []string{"--namespace", namespace, "push", image}, exec.Stdout(w), exec.Stderr(w)); err != nil {
+		return fmt.Errorf("soci push %s: %w", image, err)
+	}
+	return nil
+}
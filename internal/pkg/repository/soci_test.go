@@ -0,0 +1,76 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/repository/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSOCICmdClient_CreateAndPushIndex(t *testing.T) {
+	const mockImage = "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/api:latest"
+	ctx := context.Background()
+	testCases := map[string]struct {
+		runtime   string
+		setupMock func(m *mocks.Mockrunner)
+
+		wantedErr error
+	}{
+		"error for a runtime without a containerd image store": {
+			runtime:   "podman",
+			setupMock: func(m *mocks.Mockrunner) {},
+			wantedErr: errors.New("a SOCI index can only be generated for an image in the containerd image store of docker or finch, not podman"),
+		},
+		"error if the index can't be created from the image store of docker": {
+			runtime: "docker",
+			setupMock: func(m *mocks.Mockrunner) {
+				m.EXPECT().RunWithContext(ctx, "soci", []string{"--namespace", "moby", "create", mockImage}, gomock.Any(), gomock.Any()).Return(errors.New("image not found"))
+			},
+			wantedErr: errors.New("soci create " + mockImage + ": image not found; check that the containerd image store of docker is enabled"),
+		},
+		"error if the index can't be pushed": {
+			runtime: "finch",
+			setupMock: func(m *mocks.Mockrunner) {
+				m.EXPECT().RunWithContext(ctx, "soci", []string{"--namespace", "finch", "create", mockImage}, gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().RunWithContext(ctx, "soci", []string{"--namespace", "finch", "push", mockImage}, gomock.Any(), gomock.Any()).Return(errors.New("unauthorized"))
+			},
+			wantedErr: errors.New("soci push " + mockImage + ": unauthorized"),
+		},
+		"create and push the index of an image built by docker": {
+			runtime: "docker",
+			setupMock: func(m *mocks.Mockrunner) {
+				gomock.InOrder(
+					m.EXPECT().RunWithContext(ctx, "soci", []string{"--namespace", "moby", "create", mockImage}, gomock.Any(), gomock.Any()).Return(nil),
+					m.EXPECT().RunWithContext(ctx, "soci", []string{"--namespace", "moby", "push", mockImage}, gomock.Any(), gomock.Any()).Return(nil),
+				)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			tc.setupMock(m)
+			client := NewSOCICmdClient(m, tc.runtime)
+
+			// WHEN
+			err := client.CreateAndPushIndex(ctx, mockImage, new(strings.Builder))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -5,18 +5,35 @@
 package jobrunner
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
 
+const (
+	runTaskState = "Run Fargate Task" // Name of the state that runs the task of the job in its state machine.
+	startedBy    = "copilot-job"
+)
+
 // StateMachineExecutor is the interface that implements the Execute method to invoke a state machine.
 type StateMachineExecutor interface {
 	Execute(stateMachineARN string) error
 }
 
+// StateMachineDescriber is the interface that implements the StateMachineDefinition method to describe a state machine.
+type StateMachineDescriber interface {
+	StateMachineDefinition(stateMachineARN string) (string, error)
+}
+
+// TaskRunner is the interface that implements the RunTask method to run ECS tasks.
+type TaskRunner interface {
+	RunTask(input ecs.RunTaskInput) ([]*ecs.Task, error)
+}
+
 // CFNStackResourceLister is the interface to list CloudFormation stack resources.
 type CFNStackResourceLister interface {
 	StackResources(name string) ([]*cloudformation.StackResource, error)
@@ -28,8 +45,10 @@ type JobRunner struct {
 	env string
 	job string
 
-	cfn          CFNStackResourceLister
-	stateMachine StateMachineExecutor
+	cfn                   CFNStackResourceLister
+	stateMachine          StateMachineExecutor
+	stateMachineDescriber StateMachineDescriber
+	taskRunner            TaskRunner
 }
 
 // Config hold the data needed to create a JobRunner.
@@ -41,6 +60,10 @@ type Config struct {
 	// Dependencies to invoke a job.
 	CFN          CFNStackResourceLister // CloudFormation client to list stack resources.
 	StateMachine StateMachineExecutor   // StepFunction client to execute a state machine.
+
+	// Dependencies to run the task of a job outside of its state machine.
+	StateMachineDescriber StateMachineDescriber // StepFunction client to get the definition of a state machine.
+	TaskRunner            TaskRunner            // ECS client to run a task.
 }
 
 // New creates a new JobRunner.
@@ -51,6 +74,9 @@ func New(cfg *Config) *JobRunner {
 		job:          cfg.Job,
 		cfn:          cfg.CFN,
 		stateMachine: cfg.StateMachine,
+
+		stateMachineDescriber: cfg.StateMachineDescriber,
+		taskRunner:            cfg.TaskRunner,
 	}
 
 }
@@ -58,23 +84,90 @@ func New(cfg *Config) *JobRunner {
 // Run invokes a job.
 // An error is returned if the state machine's ARN can not be derived from the job, or the execution fails.
 func (job *JobRunner) Run() error {
+	arn, err := job.stateMachineARN()
+	if err != nil {
+		return err
+	}
+	if err := job.stateMachine.Execute(arn); err != nil {
+		return fmt.Errorf("execute state machine %q: %v", arn, err)
+	}
+	return nil
+}
+
+// RunTask starts the task of the job in its cluster with the same configuration as its state machine, and returns it once it's running.
+// Unlike Run, the task isn't retried nor stopped by the state machine, and envVars override the environment variables of its main container.
+func (job *JobRunner) RunTask(envVars map[string]string) (*ecs.Task, error) {
+	arn, err := job.stateMachineARN()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := job.stateMachineDescriber.StateMachineDefinition(arn)
+	if err != nil {
+		return nil, fmt.Errorf("get definition of state machine %q: %v", arn, err)
+	}
+	var definition stateMachineDefinition
+	if err := json.Unmarshal([]byte(raw), &definition); err != nil {
+		return nil, fmt.Errorf("unmarshal definition of state machine %q: %v", arn, err)
+	}
+	params := definition.States[runTaskState].Parameters
+	if params.TaskDefinition == "" {
+		return nil, fmt.Errorf("state machine %q doesn't run the task of job %q", arn, job.job)
+	}
+
+	in := ecs.RunTaskInput{
+		Cluster:         params.Cluster,
+		Count:           1,
+		Subnets:         params.NetworkConfiguration.AwsvpcConfiguration.Subnets,
+		SecurityGroups:  params.NetworkConfiguration.AwsvpcConfiguration.SecurityGroups,
+		AssignPublicIP:  params.NetworkConfiguration.AwsvpcConfiguration.AssignPublicIp,
+		TaskFamilyName:  params.TaskDefinition,
+		StartedBy:       startedBy,
+		PlatformVersion: params.PlatformVersion,
+	}
+	if len(envVars) > 0 {
+		// NOTE: refer to workload's CloudFormation template. The main container is named after the workload.
+		in.EnvVarOverrides = map[string]map[string]string{
+			job.job: envVars,
+		}
+	}
+	tasks, err := job.taskRunner.RunTask(in)
+	if err != nil {
+		return nil, fmt.Errorf("run task of job %q: %w", job.job, err)
+	}
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no task of job %q was started", job.job)
+	}
+	return tasks[0], nil
+}
+
+func (job *JobRunner) stateMachineARN() (string, error) {
 	resources, err := job.cfn.StackResources(stack.NameForWorkload(job.app, job.env, job.job))
 	if err != nil {
-		return fmt.Errorf("describe stack %q: %v", stack.NameForWorkload(job.app, job.env, job.job), err)
+		return "", fmt.Errorf("describe stack %q: %v", stack.NameForWorkload(job.app, job.env, job.job), err)
 	}
 
-	var arn string
 	for _, resource := range resources {
 		if aws.StringValue(resource.ResourceType) == "AWS::StepFunctions::StateMachine" {
-			arn = aws.StringValue(resource.PhysicalResourceId)
-			break
+			return aws.StringValue(resource.PhysicalResourceId), nil
 		}
 	}
-	if arn == "" {
-		return fmt.Errorf("state machine for job %q is not found in environment %q and application %q", job.job, job.env, job.app)
-	}
-	if err := job.stateMachine.Execute(arn); err != nil {
-		return fmt.Errorf("execute state machine %q: %v", arn, err)
+	return "", fmt.Errorf("state machine for job %q is not found in environment %q and application %q", job.job, job.env, job.app)
+}
+
+// stateMachineDefinition holds the fields of the definition of the state machine of a job needed to run its task.
+type stateMachineDefinition struct {
+	States map[string]struct {
+		Parameters struct {
+			Cluster              string
+			TaskDefinition       string
+			PlatformVersion      string
+			NetworkConfiguration struct {
+				AwsvpcConfiguration struct {
+					Subnets        []string
+					SecurityGroups []string
+					AssignPublicIp string
+				}
+			}
+		}
 	}
-	return nil
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/runner/jobrunner/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -125,3 +126,141 @@ func TestJobRunner_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestJobRunner_RunTask(t *testing.T) {
+	const (
+		stateMachineARN = "arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job"
+		definition      = `{
+  "Version": "1.0",
+  "Comment": "Run AWS Fargate task",
+  "StartAt": "Run Fargate Task",
+  "States": {
+    "Run Fargate Task": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::ecs:runTask.sync",
+      "Parameters": {
+        "LaunchType": "FARGATE",
+        "PlatformVersion": "LATEST",
+        "Cluster": "arn:aws:ecs:us-east-1:111111111111:cluster/app-env-Cluster",
+        "TaskDefinition": "arn:aws:ecs:us-east-1:111111111111:task-definition/app-env-job:3",
+        "PropagateTags": "TASK_DEFINITION",
+        "Group.$": "$$.Execution.Name",
+        "NetworkConfiguration": {
+          "AwsvpcConfiguration": {
+            "Subnets": ["subnet-1", "subnet-2"],
+            "AssignPublicIp": "DISABLED",
+            "SecurityGroups": ["sg-1"]
+          }
+        }
+      },
+      "End": true
+    }
+  }
+}`
+	)
+	stateMachineResources := []*cloudformation.StackResource{
+		{
+			ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+			PhysicalResourceId: aws.String(stateMachineARN),
+		},
+	}
+	wantedInput := ecs.RunTaskInput{
+		Cluster:         "arn:aws:ecs:us-east-1:111111111111:cluster/app-env-Cluster",
+		Count:           1,
+		Subnets:         []string{"subnet-1", "subnet-2"},
+		SecurityGroups:  []string{"sg-1"},
+		AssignPublicIP:  "DISABLED",
+		TaskFamilyName:  "arn:aws:ecs:us-east-1:111111111111:task-definition/app-env-job:3",
+		StartedBy:       "copilot-job",
+		PlatformVersion: "LATEST",
+	}
+
+	testCases := map[string]struct {
+		envVars   map[string]string
+		setupMock func(cfn *mocks.MockCFNStackResourceLister, sfn *mocks.MockStateMachineDescriber, r *mocks.MockTaskRunner)
+
+		wantedTask  *ecs.Task
+		wantedError error
+	}{
+		"missing statemachine resource": {
+			setupMock: func(cfn *mocks.MockCFNStackResourceLister, _ *mocks.MockStateMachineDescriber, _ *mocks.MockTaskRunner) {
+				cfn.EXPECT().StackResources("app-env-job").Return(nil, nil)
+			},
+			wantedError: errors.New(`state machine for job "job" is not found in environment "env" and application "app"`),
+		},
+		"error getting the definition of the state machine": {
+			setupMock: func(cfn *mocks.MockCFNStackResourceLister, sfn *mocks.MockStateMachineDescriber, _ *mocks.MockTaskRunner) {
+				cfn.EXPECT().StackResources("app-env-job").Return(stateMachineResources, nil)
+				sfn.EXPECT().StateMachineDefinition(stateMachineARN).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf(`get definition of state machine "%s": some error`, stateMachineARN),
+		},
+		"error if the state machine doesn't run a task": {
+			setupMock: func(cfn *mocks.MockCFNStackResourceLister, sfn *mocks.MockStateMachineDescriber, _ *mocks.MockTaskRunner) {
+				cfn.EXPECT().StackResources("app-env-job").Return(stateMachineResources, nil)
+				sfn.EXPECT().StateMachineDefinition(stateMachineARN).Return(`{"States": {}}`, nil)
+			},
+			wantedError: fmt.Errorf(`state machine "%s" doesn't run the task of job "job"`, stateMachineARN),
+		},
+		"error running the task": {
+			setupMock: func(cfn *mocks.MockCFNStackResourceLister, sfn *mocks.MockStateMachineDescriber, r *mocks.MockTaskRunner) {
+				cfn.EXPECT().StackResources("app-env-job").Return(stateMachineResources, nil)
+				sfn.EXPECT().StateMachineDefinition(stateMachineARN).Return(definition, nil)
+				r.EXPECT().RunTask(wantedInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`run task of job "job": some error`),
+		},
+		"runs the task with the network configuration of the state machine": {
+			setupMock: func(cfn *mocks.MockCFNStackResourceLister, sfn *mocks.MockStateMachineDescriber, r *mocks.MockTaskRunner) {
+				cfn.EXPECT().StackResources("app-env-job").Return(stateMachineResources, nil)
+				sfn.EXPECT().StateMachineDefinition(stateMachineARN).Return(definition, nil)
+				r.EXPECT().RunTask(wantedInput).Return([]*ecs.Task{{TaskArn: aws.String("task-1")}}, nil)
+			},
+			wantedTask: &ecs.Task{TaskArn: aws.String("task-1")},
+		},
+		"overrides the environment variables of the main container": {
+			envVars: map[string]string{"DRY_RUN": "true"},
+			setupMock: func(cfn *mocks.MockCFNStackResourceLister, sfn *mocks.MockStateMachineDescriber, r *mocks.MockTaskRunner) {
+				cfn.EXPECT().StackResources("app-env-job").Return(stateMachineResources, nil)
+				sfn.EXPECT().StateMachineDefinition(stateMachineARN).Return(definition, nil)
+				in := wantedInput
+				in.EnvVarOverrides = map[string]map[string]string{
+					"job": {"DRY_RUN": "true"},
+				}
+				r.EXPECT().RunTask(in).Return([]*ecs.Task{{TaskArn: aws.String("task-1")}}, nil)
+			},
+			wantedTask: &ecs.Task{TaskArn: aws.String("task-1")},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfn := mocks.NewMockCFNStackResourceLister(ctrl)
+			sfn := mocks.NewMockStateMachineDescriber(ctrl)
+			taskRunner := mocks.NewMockTaskRunner(ctrl)
+			tc.setupMock(cfn, sfn, taskRunner)
+
+			jobRunner := JobRunner{
+				app: "app",
+				env: "env",
+				job: "job",
+
+				cfn:                   cfn,
+				stateMachineDescriber: sfn,
+				taskRunner:            taskRunner,
+			}
+
+			task, err := jobRunner.RunTask(tc.envVars)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTask, task)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -48,6 +49,82 @@ func (mr *MockStateMachineExecutorMockRecorder) Execute(stateMachineARN interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStateMachineExecutor)(nil).Execute), stateMachineARN)
 }
 
+// MockStateMachineDescriber is a mock of StateMachineDescriber interface.
+type MockStateMachineDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMachineDescriberMockRecorder
+}
+
+// MockStateMachineDescriberMockRecorder is the mock recorder for MockStateMachineDescriber.
+type MockStateMachineDescriberMockRecorder struct {
+	mock *MockStateMachineDescriber
+}
+
+// NewMockStateMachineDescriber creates a new mock instance.
+func NewMockStateMachineDescriber(ctrl *gomock.Controller) *MockStateMachineDescriber {
+	mock := &MockStateMachineDescriber{ctrl: ctrl}
+	mock.recorder = &MockStateMachineDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStateMachineDescriber) EXPECT() *MockStateMachineDescriberMockRecorder {
+	return m.recorder
+}
+
+// StateMachineDefinition mocks base method.
+func (m *MockStateMachineDescriber) StateMachineDefinition(stateMachineARN string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateMachineDefinition", stateMachineARN)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateMachineDefinition indicates an expected call of StateMachineDefinition.
+func (mr *MockStateMachineDescriberMockRecorder) StateMachineDefinition(stateMachineARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMachineDefinition", reflect.TypeOf((*MockStateMachineDescriber)(nil).StateMachineDefinition), stateMachineARN)
+}
+
+// MockTaskRunner is a mock of TaskRunner interface.
+type MockTaskRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskRunnerMockRecorder
+}
+
+// MockTaskRunnerMockRecorder is the mock recorder for MockTaskRunner.
+type MockTaskRunnerMockRecorder struct {
+	mock *MockTaskRunner
+}
+
+// NewMockTaskRunner creates a new mock instance.
+func NewMockTaskRunner(ctrl *gomock.Controller) *MockTaskRunner {
+	mock := &MockTaskRunner{ctrl: ctrl}
+	mock.recorder = &MockTaskRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskRunner) EXPECT() *MockTaskRunnerMockRecorder {
+	return m.recorder
+}
+
+// RunTask mocks base method.
+func (m *MockTaskRunner) RunTask(input ecs.RunTaskInput) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunTask", input)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunTask indicates an expected call of RunTask.
+func (mr *MockTaskRunnerMockRecorder) RunTask(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MockTaskRunner)(nil).RunTask), input)
+}
+
 // MockCFNStackResourceLister is a mock of CFNStackResourceLister interface.
 type MockCFNStackResourceLister struct {
 	ctrl     *gomock.Controller
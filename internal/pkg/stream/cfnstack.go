@@ -31,6 +31,12 @@ type StackEvent struct {
 	ResourceStatus       string
 	ResourceStatusReason string
 	Timestamp            time.Time
+
+	// Set only if the event is about a CloudFormation Hook invoked on the resource.
+	HookType         string
+	HookStatus       string
+	HookStatusReason string
+	HookFailureMode  string
 }
 
 type clock interface {
@@ -156,6 +162,10 @@ func (s *StackStreamer) Fetch() (next time.Time, done bool, err error) {
 				ResourceStatus:       resourceStatus,
 				ResourceStatusReason: aws.StringValue(event.ResourceStatusReason),
 				Timestamp:            aws.TimeValue(event.Timestamp),
+				HookType:             aws.StringValue(event.HookType),
+				HookStatus:           aws.StringValue(event.HookStatus),
+				HookStatusReason:     aws.StringValue(event.HookStatusReason),
+				HookFailureMode:      aws.StringValue(event.HookFailureMode),
 			})
 			s.pastEventIDs[aws.StringValue(event.EventId)] = true
 		}
@@ -28,6 +28,9 @@ type ConfigRunner struct {
 	Subnets        []string
 	SecurityGroups []string
 
+	// Spread the tasks evenly across the subnets.
+	SpreadAcrossAZs bool
+
 	// Interfaces to interact with dependencies. Must not be nil.
 	ClusterGetter DefaultClusterGetter
 	Starter       Runner
@@ -46,6 +49,35 @@ type ConfigRunner struct {
 // If subnets are not provided, it uses the default subnets.
 // If cluster is not provided, it uses the default cluster.
 func (r *ConfigRunner) Run() ([]*Task, error) {
+	cfg, err := r.NetworkConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	ecsTasks, err := startTasks(r.Starter, ecs.RunTaskInput{
+		Cluster:         cfg.Cluster,
+		Count:           r.Count,
+		Subnets:         cfg.Subnets,
+		SecurityGroups:  cfg.SecurityGroups,
+		TaskFamilyName:  taskFamilyName(r.GroupName),
+		StartedBy:       startedBy,
+		PlatformVersion: cfg.PlatformVersion,
+		EnableExec:      true,
+	}, r.SpreadAcrossAZs)
+	if err != nil {
+		return nil, &errRunTask{
+			groupName: r.GroupName,
+			parentErr: err,
+		}
+	}
+
+	return convertECSTasks(ecsTasks), nil
+}
+
+// NetworkConfig returns the cluster, the subnets and the security groups that the tasks are launched with.
+// If subnets are not provided, it uses the default subnets.
+// If cluster is not provided, it uses the default cluster.
+func (r *ConfigRunner) NetworkConfig() (*NetworkConfig, error) {
 	if err := r.validateDependencies(); err != nil {
 		return nil, err
 	}
@@ -70,29 +102,13 @@ func (r *ConfigRunner) Run() ([]*Task, error) {
 		}
 		r.Subnets = subnets
 	}
-	platformVersion := "LATEST"
-	if IsValidWindowsOS(r.OS) {
-		platformVersion = "1.0.0"
-	}
 
-	ecsTasks, err := r.Starter.RunTask(ecs.RunTaskInput{
+	return &NetworkConfig{
 		Cluster:         r.Cluster,
-		Count:           r.Count,
 		Subnets:         r.Subnets,
 		SecurityGroups:  r.SecurityGroups,
-		TaskFamilyName:  taskFamilyName(r.GroupName),
-		StartedBy:       startedBy,
-		PlatformVersion: platformVersion,
-		EnableExec:      true,
-	})
-	if err != nil {
-		return nil, &errRunTask{
-			groupName: r.GroupName,
-			parentErr: err,
-		}
-	}
-
-	return convertECSTasks(ecsTasks), nil
+		PlatformVersion: platformVersion(r.OS),
+	}, nil
 }
 
 func (r *ConfigRunner) validateDependencies() error {
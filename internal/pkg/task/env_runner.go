@@ -41,15 +41,29 @@ type EnvRunner struct {
 	// Extra security groups to use.
 	SecurityGroups []string
 
+	// Optional. Subnets to launch the tasks in instead of the public subnets of the environment.
+	Subnets []string
+	// Optional. Whether the tasks receive a public IP address, either "ENABLED" or "DISABLED". Defaults to "ENABLED".
+	AssignPublicIP string
+
 	// Platform configuration.
 	OS string
 
+	// Spread the tasks evenly across the subnets of the environment.
+	SpreadAcrossAZs bool
+
+	// Maximum number of one-off tasks running at the same time in the environment. No limit if zero.
+	MaxConcurrentTasks int
+
 	// Interfaces to interact with dependencies. Must not be nil.
 	VPCGetter            VPCGetter
 	ClusterGetter        ClusterGetter
 	Starter              Runner
 	EnvironmentDescriber environmentDescriber
 
+	// Must not be nil if MaxConcurrentTasks is set.
+	RunningTaskLister RunningTaskLister
+
 	// Figures non-zero exit code of the task
 	NonZeroExitCodeGetter NonZeroExitCodeGetter
 }
@@ -59,21 +73,56 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 	if err := r.validateDependencies(); err != nil {
 		return nil, err
 	}
-
-	cluster, err := r.ClusterGetter.ClusterARN(r.App, r.Env)
+	cfg, err := r.NetworkConfig()
 	if err != nil {
-		return nil, fmt.Errorf("get cluster for environment %s: %w", r.Env, err)
+		return nil, err
+	}
+	if err := r.checkConcurrentTasks(cfg.Cluster); err != nil {
+		return nil, err
 	}
 
-	description, err := r.EnvironmentDescriber.Describe()
+	ecsTasks, err := startTasks(r.Starter, ecs.RunTaskInput{
+		Cluster:         cfg.Cluster,
+		Count:           r.Count,
+		Subnets:         cfg.Subnets,
+		SecurityGroups:  cfg.SecurityGroups,
+		TaskFamilyName:  taskFamilyName(r.GroupName),
+		StartedBy:       startedBy,
+		PlatformVersion: cfg.PlatformVersion,
+		EnableExec:      true,
+		AssignPublicIP:  cfg.AssignPublicIP,
+	}, r.SpreadAcrossAZs)
 	if err != nil {
-		return nil, fmt.Errorf(fmtErrDescribeEnvironment, r.Env, err)
+		return nil, &errRunTask{
+			groupName: r.GroupName,
+			parentErr: err,
+		}
+	}
+	return convertECSTasks(ecsTasks), nil
+}
+
+// NetworkConfig returns the cluster, the subnets and the security groups of the environment that the tasks are launched with.
+func (r *EnvRunner) NetworkConfig() (*NetworkConfig, error) {
+	if err := r.validateDependencies(); err != nil {
+		return nil, err
 	}
-	if len(description.EnvironmentVPC.PublicSubnetIDs) == 0 {
-		return nil, errNoSubnetFound
+
+	cluster, err := r.ClusterGetter.ClusterARN(r.App, r.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get cluster for environment %s: %w", r.Env, err)
 	}
 
-	subnets := description.EnvironmentVPC.PublicSubnetIDs
+	subnets := r.Subnets
+	if len(subnets) == 0 {
+		description, err := r.EnvironmentDescriber.Describe()
+		if err != nil {
+			return nil, fmt.Errorf(fmtErrDescribeEnvironment, r.Env, err)
+		}
+		if len(description.EnvironmentVPC.PublicSubnetIDs) == 0 {
+			return nil, errNoSubnetFound
+		}
+		subnets = description.EnvironmentVPC.PublicSubnetIDs
+	}
 
 	filters := r.filtersForVPCFromAppEnv()
 	// Use only environment security group https://github.com/aws/copilot-cli/issues/1882.
@@ -89,28 +138,33 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 		return nil, fmt.Errorf(fmtErrNumSecurityGroups, numSGs, strings.Join(securityGroups, ","))
 	}
 
-	platformVersion := "LATEST"
-	if IsValidWindowsOS(r.OS) {
-		platformVersion = "1.0.0"
-	}
-
-	ecsTasks, err := r.Starter.RunTask(ecs.RunTaskInput{
+	return &NetworkConfig{
 		Cluster:         cluster,
-		Count:           r.Count,
 		Subnets:         subnets,
 		SecurityGroups:  securityGroups,
-		TaskFamilyName:  taskFamilyName(r.GroupName),
-		StartedBy:       startedBy,
-		PlatformVersion: platformVersion,
-		EnableExec:      true,
-	})
+		AssignPublicIP:  r.AssignPublicIP,
+		PlatformVersion: platformVersion(r.OS),
+	}, nil
+}
+
+// checkConcurrentTasks returns ErrTooManyConcurrentTasks if running the tasks would exceed the maximum
+// number of one-off tasks running at the same time in the cluster.
+func (r *EnvRunner) checkConcurrentTasks(cluster string) error {
+	if r.MaxConcurrentTasks <= 0 {
+		return nil
+	}
+	running, err := r.RunningTaskLister.RunningTasksStartedBy(cluster, startedBy)
 	if err != nil {
-		return nil, &errRunTask{
-			groupName: r.GroupName,
-			parentErr: err,
+		return fmt.Errorf("list one-off tasks running in environment %s: %w", r.Env, err)
+	}
+	if len(running)+r.Count > r.MaxConcurrentTasks {
+		return &ErrTooManyConcurrentTasks{
+			Running:   len(running),
+			Requested: r.Count,
+			Max:       r.MaxConcurrentTasks,
 		}
 	}
-	return convertECSTasks(ecsTasks), nil
+	return nil
 }
 
 func (r *EnvRunner) filtersForVPCFromAppEnv() []ec2.Filter {
@@ -139,6 +193,10 @@ func (r *EnvRunner) validateDependencies() error {
 		return errStarterNil
 	}
 
+	if r.MaxConcurrentTasks > 0 && r.RunningTaskLister == nil {
+		return errRunningTaskListerNil
+	}
+
 	return nil
 }
 
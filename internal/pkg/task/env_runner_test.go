@@ -85,11 +85,16 @@ func TestEnvRunner_Run(t *testing.T) {
 		os             string
 		arch           string
 		securityGroups []string
+		subnets        []string
+		assignPublicIP string
+		spread         bool
+		maxConcurrent  int
 
 		MockVPCGetter            func(m *mocks.MockVPCGetter)
 		MockClusterGetter        func(m *mocks.MockClusterGetter)
 		mockStarter              func(m *mocks.MockRunner)
 		mockEnvironmentDescriber func(m *mocks.MockenvironmentDescriber)
+		mockRunningTaskLister    func(m *mocks.MockRunningTaskLister)
 
 		wantedError error
 		wantedTasks []*Task
@@ -355,6 +360,125 @@ func TestEnvRunner_Run(t *testing.T) {
 				},
 			},
 		},
+		"run in the given subnets instead of the public subnets of the environment": {
+			count:          1,
+			groupName:      "my-task",
+			subnets:        []string{"subnet-023ff", "subnet-04af"},
+			assignPublicIP: "DISABLED",
+
+			MockClusterGetter: mockClusterGetter,
+			MockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().SecurityGroups(filtersForSecurityGroup).Return([]string{"sg-1"}, nil)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:         "cluster-1",
+					Count:           1,
+					Subnets:         []string{"subnet-023ff", "subnet-04af"},
+					SecurityGroups:  []string{"sg-1"},
+					TaskFamilyName:  taskFamilyName("my-task"),
+					StartedBy:       startedBy,
+					PlatformVersion: "LATEST",
+					EnableExec:      true,
+					AssignPublicIP:  "DISABLED",
+				}).Return([]*ecs.Task{&taskWithNoENI}, nil)
+			},
+			mockEnvironmentDescriber: func(m *mocks.MockenvironmentDescriber) {
+				m.EXPECT().Describe().Times(0)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-2",
+				},
+			},
+		},
+		"failed to list the running one-off tasks": {
+			count:         1,
+			groupName:     "my-task",
+			maxConcurrent: 5,
+
+			MockClusterGetter: mockClusterGetter,
+			MockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().SecurityGroups(filtersForSecurityGroup).Return([]string{"sg-1"}, nil)
+			},
+			mockStarter:              mockStarterNotRun,
+			mockEnvironmentDescriber: mockEnvironmentDescriberValid,
+			mockRunningTaskLister: func(m *mocks.MockRunningTaskLister) {
+				m.EXPECT().RunningTasksStartedBy("cluster-1", startedBy).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("list one-off tasks running in environment my-env: some error"),
+		},
+		"failed if running the tasks would exceed the limit of concurrent tasks": {
+			count:         3,
+			groupName:     "my-task",
+			maxConcurrent: 5,
+
+			MockClusterGetter: mockClusterGetter,
+			MockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().SecurityGroups(filtersForSecurityGroup).Return([]string{"sg-1"}, nil)
+			},
+			mockStarter:              mockStarterNotRun,
+			mockEnvironmentDescriber: mockEnvironmentDescriberValid,
+			mockRunningTaskLister: func(m *mocks.MockRunningTaskLister) {
+				m.EXPECT().RunningTasksStartedBy("cluster-1", startedBy).Return([]*ecs.Task{{}, {}, {}}, nil)
+			},
+			wantedError: &ErrTooManyConcurrentTasks{
+				Running:   3,
+				Requested: 3,
+				Max:       5,
+			},
+		},
+		"spread the tasks across the subnets of the environment": {
+			count:         3,
+			groupName:     "my-task",
+			spread:        true,
+			maxConcurrent: 5,
+
+			MockClusterGetter: mockClusterGetter,
+			MockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().SecurityGroups(filtersForSecurityGroup).Return([]string{"sg-1"}, nil)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				gomock.InOrder(
+					m.EXPECT().RunTask(ecs.RunTaskInput{
+						Cluster:         "cluster-1",
+						Count:           2,
+						Subnets:         []string{"subnet-0789ab"},
+						SecurityGroups:  []string{"sg-1"},
+						TaskFamilyName:  taskFamilyName("my-task"),
+						StartedBy:       startedBy,
+						PlatformVersion: "LATEST",
+						EnableExec:      true,
+					}).Return([]*ecs.Task{&taskWithENI, &taskWithNoENI}, nil),
+					m.EXPECT().RunTask(ecs.RunTaskInput{
+						Cluster:         "cluster-1",
+						Count:           1,
+						Subnets:         []string{"subnet-0123cd"},
+						SecurityGroups:  []string{"sg-1"},
+						TaskFamilyName:  taskFamilyName("my-task"),
+						StartedBy:       startedBy,
+						PlatformVersion: "LATEST",
+						EnableExec:      true,
+					}).Return([]*ecs.Task{&taskWithNoENI}, nil),
+				)
+			},
+			mockEnvironmentDescriber: mockEnvironmentDescriberValid,
+			mockRunningTaskLister: func(m *mocks.MockRunningTaskLister) {
+				m.EXPECT().RunningTasksStartedBy("cluster-1", startedBy).Return([]*ecs.Task{{}, {}}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+					ENI:     "eni-1",
+				},
+				{
+					TaskARN: "task-2",
+				},
+				{
+					TaskARN: "task-2",
+				},
+			},
+		},
 		"eni information not found for several tasks": {
 			count:     1,
 			groupName: "my-task",
@@ -403,11 +527,15 @@ func TestEnvRunner_Run(t *testing.T) {
 			MockClusterGetter := mocks.NewMockClusterGetter(ctrl)
 			mockStarter := mocks.NewMockRunner(ctrl)
 			mockEnvironmentDescriber := mocks.NewMockenvironmentDescriber(ctrl)
+			mockRunningTaskLister := mocks.NewMockRunningTaskLister(ctrl)
 
 			tc.MockVPCGetter(MockVPCGetter)
 			tc.MockClusterGetter(MockClusterGetter)
 			tc.mockStarter(mockStarter)
 			tc.mockEnvironmentDescriber(mockEnvironmentDescriber)
+			if tc.mockRunningTaskLister != nil {
+				tc.mockRunningTaskLister(mockRunningTaskLister)
+			}
 
 			task := &EnvRunner{
 				Count:     tc.count,
@@ -419,11 +547,17 @@ func TestEnvRunner_Run(t *testing.T) {
 				OS: tc.os,
 
 				SecurityGroups: tc.securityGroups,
+				Subnets:        tc.subnets,
+				AssignPublicIP: tc.assignPublicIP,
+
+				SpreadAcrossAZs:    tc.spread,
+				MaxConcurrentTasks: tc.maxConcurrent,
 
 				VPCGetter:            MockVPCGetter,
 				ClusterGetter:        MockClusterGetter,
 				Starter:              mockStarter,
 				EnvironmentDescriber: mockEnvironmentDescriber,
+				RunningTaskLister:    mockRunningTaskLister,
 			}
 
 			tasks, err := task.Run()
@@ -14,6 +14,8 @@ var (
 	errVPCGetterNil     = errors.New("vpc getter is not set")
 	errClusterGetterNil = errors.New("cluster getter is not set")
 	errStarterNil       = errors.New("starter is not set")
+
+	errRunningTaskListerNil = errors.New("running task lister is not set")
 )
 
 type errRunTask struct {
@@ -32,3 +34,15 @@ type errGetDefaultCluster struct {
 func (e *errGetDefaultCluster) Error() string {
 	return fmt.Sprintf("get default cluster: %v", e.parentErr)
 }
+
+// ErrTooManyConcurrentTasks occurs when running the tasks would exceed the maximum number of one-off tasks
+// allowed to run at the same time.
+type ErrTooManyConcurrentTasks struct {
+	Running   int
+	Requested int
+	Max       int
+}
+
+func (e *ErrTooManyConcurrentTasks) Error() string {
+	return fmt.Sprintf("running %d more tasks would exceed the limit of %d concurrent one-off tasks: %d already running", e.Requested, e.Max, e.Running)
+}
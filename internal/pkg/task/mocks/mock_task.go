@@ -262,3 +262,41 @@ func (mr *MockRunnerMockRecorder) RunTask(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MockRunner)(nil).RunTask), input)
 }
+
+// MockRunningTaskLister is a mock of RunningTaskLister interface.
+type MockRunningTaskLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockRunningTaskListerMockRecorder
+}
+
+// MockRunningTaskListerMockRecorder is the mock recorder for MockRunningTaskLister.
+type MockRunningTaskListerMockRecorder struct {
+	mock *MockRunningTaskLister
+}
+
+// NewMockRunningTaskLister creates a new mock instance.
+func NewMockRunningTaskLister(ctrl *gomock.Controller) *MockRunningTaskLister {
+	mock := &MockRunningTaskLister{ctrl: ctrl}
+	mock.recorder = &MockRunningTaskListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRunningTaskLister) EXPECT() *MockRunningTaskListerMockRecorder {
+	return m.recorder
+}
+
+// RunningTasksStartedBy mocks base method.
+func (m *MockRunningTaskLister) RunningTasksStartedBy(cluster, startedBy string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTasksStartedBy", cluster, startedBy)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTasksStartedBy indicates an expected call of RunningTasksStartedBy.
+func (mr *MockRunningTaskListerMockRecorder) RunningTasksStartedBy(cluster, startedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasksStartedBy", reflect.TypeOf((*MockRunningTaskLister)(nil).RunningTasksStartedBy), cluster, startedBy)
+}
@@ -47,6 +47,11 @@ type Runner interface {
 	RunTask(input ecs.RunTaskInput) ([]*ecs.Task, error)
 }
 
+// RunningTaskLister wraps the method of listing the running tasks started by an identifier.
+type RunningTaskLister interface {
+	RunningTasksStartedBy(cluster, startedBy string) ([]*ecs.Task, error)
+}
+
 // Task represents a one-off workload that runs until completed or an error occurs.
 type Task struct {
 	TaskARN    string
@@ -55,9 +60,21 @@ type Task struct {
 	ENI        string
 }
 
+// NetworkConfig holds the cluster and the network configuration that tasks are launched with.
+type NetworkConfig struct {
+	Cluster         string
+	Subnets         []string
+	SecurityGroups  []string
+	AssignPublicIP  string
+	PlatformVersion string
+}
+
 const (
 	startedBy = "copilot-task"
 
+	platformVersionLinux   = "LATEST"
+	platformVersionWindows = "1.0.0"
+
 	// Platform options.
 	osLinux                 = template.OSLinux
 	osWindowsServer2019Full = template.OSWindowsServer2019Full
@@ -98,6 +115,40 @@ func taskFamilyName(groupName string) string {
 	return fmt.Sprintf(fmtTaskFamilyName, groupName)
 }
 
+func platformVersion(os string) string {
+	if IsValidWindowsOS(os) {
+		return platformVersionWindows
+	}
+	return platformVersionLinux
+}
+
+// startTasks runs the tasks. If spread is true, the tasks are distributed evenly across the subnets, and
+// therefore across the Availability Zones, with one RunTask call per subnet.
+func startTasks(starter Runner, in ecs.RunTaskInput, spread bool) ([]*ecs.Task, error) {
+	if !spread || len(in.Subnets) < 2 {
+		return starter.RunTask(in)
+	}
+	var tasks []*ecs.Task
+	for i, subnet := range in.Subnets {
+		count := in.Count / len(in.Subnets)
+		if i < in.Count%len(in.Subnets) {
+			count++
+		}
+		if count == 0 {
+			break
+		}
+		input := in
+		input.Count = count
+		input.Subnets = []string{subnet}
+		started, err := starter.RunTask(input)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, started...)
+	}
+	return tasks, nil
+}
+
 func newTaskFromECS(ecsTask *ecs.Task) *Task {
 	taskARN := aws.StringValue(ecsTask.TaskArn)
 	eni, _ := ecsTask.ENI() //  Best-effort parse the ENI. If we can't find an IP address, we won't show it to the customers instead of erroring.
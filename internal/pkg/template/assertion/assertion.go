@@ -0,0 +1,393 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package assertion checks the resources of CloudFormation templates against assertions that teams write in YAML,
+// like "no security group allows 0.0.0.0/0 on port 22" or "logs are retained for at most 90 days".
+package assertion
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Suite is a set of assertions on the resources of CloudFormation templates.
+type Suite struct {
+	Assertions []Assertion `yaml:"assertions"`
+}
+
+// Assertion selects values of the resources of a template and requires them to, or forbids them to, meet a condition.
+type Assertion struct {
+	Name         string     `yaml:"name"`
+	ResourceType string     `yaml:"resourceType"` // Type of the resources to check, like "AWS::Logs::LogGroup". Empty means all resources.
+	Path         string     `yaml:"path"`         // Path to the values in each resource, like "Properties.SecurityGroupIngress[*]". Empty means the resource.
+	Require      *Condition `yaml:"require"`      // Condition that every value must meet.
+	Forbid       *Condition `yaml:"forbid"`       // Condition that no value may meet.
+}
+
+// Condition is met by a value if all of its fields are.
+type Condition struct {
+	Exists             *bool                `yaml:"exists"`
+	Equals             *string              `yaml:"equals"`
+	NotEquals          *string              `yaml:"notEquals"`
+	OneOf              []string             `yaml:"oneOf"`
+	LessThanOrEqual    *float64             `yaml:"lessThanOrEqual"`
+	GreaterThanOrEqual *float64             `yaml:"greaterThanOrEqual"`
+	Matches            *string              `yaml:"matches"` // Regular expression that the whole value must match.
+	Fields             map[string]Condition `yaml:"fields"`  // Conditions on the fields of a mapping.
+
+	pattern *regexp.Regexp
+}
+
+// Failure is a value that doesn't satisfy an assertion.
+type Failure struct {
+	Assertion string
+	Template  string
+	Resource  string // Logical ID of the resource.
+	Path      string
+	Line      int    // Line of the value in the template, or of the resource if the value is missing.
+	Expected  string // Description of the condition the value had to meet, or not to meet.
+	Actual    string // Value in YAML, or empty if the value is missing.
+}
+
+// ParseSuite unmarshals and validates the content of an assertions file.
+func ParseSuite(content []byte) (*Suite, error) {
+	var suite Suite
+	if err := yaml.Unmarshal(content, &suite); err != nil {
+		return nil, fmt.Errorf("unmarshal assertions: %w", err)
+	}
+	if len(suite.Assertions) == 0 {
+		return nil, errors.New(`"assertions" must contain at least one assertion`)
+	}
+	for i := range suite.Assertions {
+		if err := suite.Assertions[i].validate(); err != nil {
+			if name := suite.Assertions[i].Name; name != "" {
+				return nil, fmt.Errorf("validate assertion %q: %w", name, err)
+			}
+			return nil, fmt.Errorf("validate assertion %d: %w", i+1, err)
+		}
+	}
+	return &suite, nil
+}
+
+func (a *Assertion) validate() error {
+	if a.Name == "" {
+		return errors.New(`"name" must be specified`)
+	}
+	if (a.Require == nil) == (a.Forbid == nil) {
+		return errors.New(`must specify one, and only one, of "require" and "forbid"`)
+	}
+	if _, err := parsePath(a.Path); err != nil {
+		return err
+	}
+	if a.Require != nil {
+		return a.Require.validate()
+	}
+	return a.Forbid.validate()
+}
+
+func (c *Condition) validate() error {
+	if c.Exists == nil && c.Equals == nil && c.NotEquals == nil && c.OneOf == nil &&
+		c.LessThanOrEqual == nil && c.GreaterThanOrEqual == nil && c.Matches == nil && c.Fields == nil {
+		return errors.New("condition must not be empty")
+	}
+	if c.Matches != nil {
+		pattern, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", *c.Matches))
+		if err != nil {
+			return fmt.Errorf("parse regular expression %q: %w", *c.Matches, err)
+		}
+		c.pattern = pattern
+	}
+	for key, field := range c.Fields {
+		if err := field.validate(); err != nil {
+			return fmt.Errorf("field %q: %w", key, err)
+		}
+		c.Fields[key] = field
+	}
+	return nil
+}
+
+// Check returns the values of the resources in the CloudFormation template that don't satisfy the assertions.
+// The name of the template is recorded in the failures.
+func (s *Suite) Check(name, tpl string) ([]Failure, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(tpl), &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal template %s: %w", name, err)
+	}
+	resources := mappingValue(documentRoot(&doc), "Resources")
+	if resources == nil || resources.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	var failures []Failure
+	for _, assertion := range s.Assertions {
+		steps, _ := parsePath(assertion.Path) // The path was validated when the suite was parsed.
+		for i := 0; i+1 < len(resources.Content); i += 2 {
+			logicalID, resource := resources.Content[i].Value, resources.Content[i+1]
+			if assertion.ResourceType != "" && literal(mappingValue(resource, "Type")) != assertion.ResourceType {
+				continue
+			}
+			for _, m := range resolve(resource, steps, "") {
+				failure := Failure{
+					Assertion: assertion.Name,
+					Template:  name,
+					Resource:  logicalID,
+					Path:      m.path,
+					Line:      resource.Line,
+				}
+				if m.node != nil {
+					failure.Line = m.node.Line
+					failure.Actual = marshal(m.node)
+				}
+				switch {
+				case assertion.Require != nil && !assertion.Require.metBy(m.node):
+					failure.Expected = assertion.Require.String()
+				case assertion.Forbid != nil && assertion.Forbid.metBy(m.node):
+					failure.Expected = fmt.Sprintf("not %s", assertion.Forbid.String())
+				default:
+					continue
+				}
+				failures = append(failures, failure)
+			}
+		}
+	}
+	return failures, nil
+}
+
+// metBy returns true if the node, which is nil if the value is missing, meets the condition.
+func (c *Condition) metBy(node *yaml.Node) bool {
+	if c.Exists != nil && (node != nil) != *c.Exists {
+		return false
+	}
+	if node == nil {
+		// A missing value only meets conditions on its existence.
+		return c.Equals == nil && c.NotEquals == nil && c.OneOf == nil &&
+			c.LessThanOrEqual == nil && c.GreaterThanOrEqual == nil && c.Matches == nil && c.Fields == nil
+	}
+	value, isLiteral := literal(node), isLiteralScalar(node)
+	if c.Equals != nil && (!isLiteral || value != *c.Equals) {
+		return false
+	}
+	if c.NotEquals != nil && isLiteral && value == *c.NotEquals {
+		return false
+	}
+	if c.OneOf != nil && (!isLiteral || !contains(c.OneOf, value)) {
+		return false
+	}
+	if c.LessThanOrEqual != nil || c.GreaterThanOrEqual != nil {
+		f, err := strconv.ParseFloat(value, 64)
+		if !isLiteral || err != nil {
+			return false
+		}
+		if c.LessThanOrEqual != nil && f > *c.LessThanOrEqual {
+			return false
+		}
+		if c.GreaterThanOrEqual != nil && f < *c.GreaterThanOrEqual {
+			return false
+		}
+	}
+	if c.pattern != nil && (!isLiteral || !c.pattern.MatchString(value)) {
+		return false
+	}
+	if c.Fields != nil && node.Kind != yaml.MappingNode {
+		return false
+	}
+	for key, field := range c.Fields {
+		if !field.metBy(mappingValue(node, key)) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a description of the condition, like "<= 90" or "CidrIp = 0.0.0.0/0 and FromPort <= 22".
+func (c Condition) String() string {
+	var parts []string
+	if c.Exists != nil {
+		if *c.Exists {
+			parts = append(parts, "exists")
+		} else {
+			parts = append(parts, "is absent")
+		}
+	}
+	if c.Equals != nil {
+		parts = append(parts, fmt.Sprintf("= %s", *c.Equals))
+	}
+	if c.NotEquals != nil {
+		parts = append(parts, fmt.Sprintf("!= %s", *c.NotEquals))
+	}
+	if c.OneOf != nil {
+		parts = append(parts, fmt.Sprintf("one of [%s]", strings.Join(c.OneOf, ", ")))
+	}
+	if c.LessThanOrEqual != nil {
+		parts = append(parts, fmt.Sprintf("<= %s", strconv.FormatFloat(*c.LessThanOrEqual, 'f', -1, 64)))
+	}
+	if c.GreaterThanOrEqual != nil {
+		parts = append(parts, fmt.Sprintf(">= %s", strconv.FormatFloat(*c.GreaterThanOrEqual, 'f', -1, 64)))
+	}
+	if c.Matches != nil {
+		parts = append(parts, fmt.Sprintf("matches /%s/", *c.Matches))
+	}
+	keys := make([]string, 0, len(c.Fields))
+	for key := range c.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s %s", key, c.Fields[key].String()))
+	}
+	return strings.Join(parts, " and ")
+}
+
+// step is a key of a mapping, or an index of a sequence, in a path.
+type step struct {
+	key   string
+	index int // Index of the item if the key is empty, or anyIndex for all the items.
+}
+
+const anyIndex = -1
+
+var pathSegmentPattern = regexp.MustCompile(`^([^\[\]]*)((?:\[(?:\*|\d+)\])*)$`)
+
+// parsePath splits a path like "Properties.SecurityGroupIngress[*].CidrIp" into steps.
+func parsePath(path string) ([]step, error) {
+	if path == "" {
+		return nil, nil
+	}
+	var steps []step
+	for _, segment := range strings.Split(path, ".") {
+		matches := pathSegmentPattern.FindStringSubmatch(segment)
+		if matches == nil || (matches[1] == "" && matches[2] == "") {
+			return nil, fmt.Errorf("invalid path %q: segment %q must be a key followed by optional indexes like [0] or [*]", path, segment)
+		}
+		if matches[1] != "" {
+			steps = append(steps, step{key: matches[1]})
+		}
+		for _, index := range strings.Split(strings.TrimSuffix(matches[2], "]"), "]") {
+			switch index = strings.TrimPrefix(index, "["); index {
+			case "":
+			case "*":
+				steps = append(steps, step{index: anyIndex})
+			default:
+				i, _ := strconv.Atoi(index)
+				steps = append(steps, step{index: i})
+			}
+		}
+	}
+	return steps, nil
+}
+
+// match is a value selected by a path. The node is nil if the value is missing.
+type match struct {
+	path string
+	node *yaml.Node
+}
+
+func resolve(node *yaml.Node, steps []step, path string) []match {
+	if len(steps) == 0 {
+		return []match{{path: path, node: node}}
+	}
+	next, rest := steps[0], steps[1:]
+	if next.key != "" {
+		if path != "" {
+			path += "."
+		}
+		path += next.key
+		child := mappingValue(node, next.key)
+		if child == nil {
+			return []match{{path: path + pathOf(rest), node: nil}}
+		}
+		return resolve(child, rest, path)
+	}
+	if node == nil || node.Kind != yaml.SequenceNode {
+		if next.index == anyIndex {
+			return nil // There are no items to check.
+		}
+		return []match{{path: path + pathOf(steps), node: nil}}
+	}
+	if next.index != anyIndex {
+		if next.index >= len(node.Content) {
+			return []match{{path: path + pathOf(steps), node: nil}}
+		}
+		return resolve(node.Content[next.index], rest, fmt.Sprintf("%s[%d]", path, next.index))
+	}
+	var matches []match
+	for i, item := range node.Content {
+		matches = append(matches, resolve(item, rest, fmt.Sprintf("%s[%d]", path, i))...)
+	}
+	return matches
+}
+
+// pathOf returns the path of the steps that couldn't be resolved.
+func pathOf(steps []step) string {
+	var b strings.Builder
+	for _, s := range steps {
+		switch {
+		case s.key != "":
+			b.WriteString("." + s.key)
+		case s.index == anyIndex:
+			b.WriteString("[*]")
+		default:
+			b.WriteString(fmt.Sprintf("[%d]", s.index))
+		}
+	}
+	return b.String()
+}
+
+func documentRoot(doc *yaml.Node) *yaml.Node {
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		return doc.Content[0]
+	}
+	return doc
+}
+
+// mappingValue returns the value of the key in the mapping node, or nil if the node isn't a mapping or doesn't have the key.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// isLiteralScalar returns false for scalars whose value is only known at deployment, like "!Ref Param" or "!Sub ${AWS::Region}".
+func isLiteralScalar(node *yaml.Node) bool {
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return false
+	}
+	return !strings.HasPrefix(node.Tag, "!") || strings.HasPrefix(node.Tag, "!!")
+}
+
+func literal(node *yaml.Node) string {
+	if !isLiteralScalar(node) {
+		return ""
+	}
+	return node.Value
+}
+
+func marshal(node *yaml.Node) string {
+	var b strings.Builder
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return node.Value
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func contains(items []string, s string) bool {
+	for _, item := range items {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
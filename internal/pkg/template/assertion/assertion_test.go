@@ -0,0 +1,232 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package assertion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSuite(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedErr string
+	}{
+		"valid suite": {
+			in: `
+assertions:
+  - name: no SSH from the internet
+    resourceType: AWS::EC2::SecurityGroup
+    path: Properties.SecurityGroupIngress[*]
+    forbid:
+      fields:
+        CidrIp:
+          equals: 0.0.0.0/0
+        FromPort:
+          lessThanOrEqual: 22
+  - name: no default ports
+    path: Properties.PortMappings[*].ContainerPort
+    forbid:
+      oneOf: [80, 8080]
+  - name: images come from ECR
+    path: Properties.ContainerDefinitions[0].Image
+    require:
+      matches: '\d+\.dkr\.ecr\..+'
+`,
+		},
+		"error if there are no assertions": {
+			in:        "assertions: []",
+			wantedErr: `"assertions" must contain at least one assertion`,
+		},
+		"error if an assertion has no name": {
+			in: `
+assertions:
+  - require:
+      exists: true
+`,
+			wantedErr: `validate assertion 1: "name" must be specified`,
+		},
+		"error if an assertion both requires and forbids": {
+			in: `
+assertions:
+  - name: logs
+    require:
+      exists: true
+    forbid:
+      exists: false
+`,
+			wantedErr: `validate assertion "logs": must specify one, and only one, of "require" and "forbid"`,
+		},
+		"error on invalid paths": {
+			in: `
+assertions:
+  - name: logs
+    path: Properties.Tags[first]
+    require:
+      exists: true
+`,
+			wantedErr: `validate assertion "logs": invalid path "Properties.Tags[first]": segment "Tags[first]" must be a key followed by optional indexes like [0] or [*]`,
+		},
+		"error on empty nested conditions": {
+			in: `
+assertions:
+  - name: logs
+    require:
+      fields:
+        Type: {}
+`,
+			wantedErr: `validate assertion "logs": field "Type": condition must not be empty`,
+		},
+		"error on invalid regular expressions": {
+			in: `
+assertions:
+  - name: logs
+    require:
+      matches: "("
+`,
+			wantedErr: "validate assertion \"logs\": parse regular expression \"(\": error parsing regexp: missing closing ): `^(?:()$`",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseSuite([]byte(tc.in))
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSuite_Check(t *testing.T) {
+	const tpl = `
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 3653
+  AddonsLogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: !Ref Retention
+  ShortLogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 30
+  ForeverLogGroup:
+    Type: AWS::Logs::LogGroup
+  SecurityGroup:
+    Type: AWS::EC2::SecurityGroup
+    Properties:
+      SecurityGroupIngress:
+        - CidrIp: 10.0.0.0/16
+          FromPort: 22
+          ToPort: 22
+        - CidrIp: 0.0.0.0/0
+          FromPort: 443
+          ToPort: 443
+        - CidrIp: 0.0.0.0/0
+          FromPort: 0
+          ToPort: 65535
+`
+	testCases := map[string]struct {
+		in string
+
+		wanted []Failure
+	}{
+		"requires values to meet a condition": {
+			in: `
+assertions:
+  - name: logs are retained for at most 90 days
+    resourceType: AWS::Logs::LogGroup
+    path: Properties.RetentionInDays
+    require:
+      lessThanOrEqual: 90
+`,
+			wanted: []Failure{
+				{
+					Assertion: "logs are retained for at most 90 days",
+					Template:  "svc.stack.yml",
+					Resource:  "LogGroup",
+					Path:      "Properties.RetentionInDays",
+					Line:      6,
+					Expected:  "<= 90",
+					Actual:    "3653",
+				},
+				{
+					Assertion: "logs are retained for at most 90 days",
+					Template:  "svc.stack.yml",
+					Resource:  "AddonsLogGroup",
+					Path:      "Properties.RetentionInDays",
+					Line:      10,
+					Expected:  "<= 90",
+					Actual:    "!Ref Retention",
+				},
+				{
+					Assertion: "logs are retained for at most 90 days",
+					Template:  "svc.stack.yml",
+					Resource:  "ForeverLogGroup",
+					Path:      "Properties.RetentionInDays",
+					Line:      16,
+					Expected:  "<= 90",
+				},
+			},
+		},
+		"forbids items of a list to meet a condition": {
+			in: `
+assertions:
+  - name: no SSH from the internet
+    resourceType: AWS::EC2::SecurityGroup
+    path: Properties.SecurityGroupIngress[*]
+    forbid:
+      fields:
+        CidrIp:
+          equals: 0.0.0.0/0
+        FromPort:
+          lessThanOrEqual: 22
+        ToPort:
+          greaterThanOrEqual: 22
+`,
+			wanted: []Failure{
+				{
+					Assertion: "no SSH from the internet",
+					Template:  "svc.stack.yml",
+					Resource:  "SecurityGroup",
+					Path:      "Properties.SecurityGroupIngress[2]",
+					Line:      27,
+					Expected:  "not CidrIp = 0.0.0.0/0 and FromPort <= 22 and ToPort >= 22",
+					Actual:    "CidrIp: 0.0.0.0/0\nFromPort: 0\nToPort: 65535",
+				},
+			},
+		},
+		"checks all resources without a type": {
+			in: `
+assertions:
+  - name: only logs and security groups
+    path: Type
+    require:
+      oneOf: [AWS::Logs::LogGroup, AWS::EC2::SecurityGroup]
+  - name: first ingress rule is private
+    path: Properties.SecurityGroupIngress[0].CidrIp
+    forbid:
+      matches: '0\.0\.0\.0/0'
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			suite, err := ParseSuite([]byte(tc.in))
+			require.NoError(t, err)
+
+			got, err := suite.Check("svc.stack.yml", tpl)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
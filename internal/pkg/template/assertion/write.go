@@ -0,0 +1,53 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package assertion
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	prefixExpected = "-"
+	prefixActual   = "+"
+	indent         = "    "
+)
+
+// WriteFailures writes the failures, grouped by assertion, as a diff between the expected and the actual values.
+func WriteFailures(w io.Writer, failures []Failure) error {
+	var b strings.Builder
+	for i, failure := range failures {
+		if i == 0 || failures[i-1].Assertion != failure.Assertion {
+			b.WriteString(fmt.Sprintf("%s %s\n", color.Red.Sprint("✘"), color.Bold.Sprint(failure.Assertion)))
+		}
+		location := failure.Resource
+		if failure.Path != "" {
+			location += "." + failure.Path
+		}
+		b.WriteString(color.Faint.Sprintf("%s%s: %s (line %d)\n", indent, failure.Template, location, failure.Line))
+		b.WriteString(color.Green.Sprintf("%s%s expected: %s\n", indent, prefixExpected, failure.Expected))
+		b.WriteString(color.Red.Sprint(formatActual(failure.Actual)))
+	}
+	_, err := w.Write([]byte(b.String()))
+	return err
+}
+
+func formatActual(actual string) string {
+	switch lines := strings.Split(actual, "\n"); {
+	case actual == "":
+		return fmt.Sprintf("%s%s actual: (missing)\n", indent, prefixActual)
+	case len(lines) == 1:
+		return fmt.Sprintf("%s%s actual: %s\n", indent, prefixActual, actual)
+	default:
+		var b strings.Builder
+		b.WriteString(fmt.Sprintf("%s%s actual:\n", indent, prefixActual))
+		for _, line := range lines {
+			b.WriteString(fmt.Sprintf("%s%s   %s\n", indent, prefixActual, line))
+		}
+		return b.String()
+	}
+}
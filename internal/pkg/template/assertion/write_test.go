@@ -0,0 +1,62 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package assertion
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFailures(t *testing.T) {
+	failures := []Failure{
+		{
+			Assertion: "logs are retained for at most 90 days",
+			Template:  "api-test.stack.yml",
+			Resource:  "LogGroup",
+			Path:      "Properties.RetentionInDays",
+			Line:      6,
+			Expected:  "<= 90",
+			Actual:    "3653",
+		},
+		{
+			Assertion: "logs are retained for at most 90 days",
+			Template:  "api-test.addons.stack.yml",
+			Resource:  "ForeverLogGroup",
+			Path:      "Properties.RetentionInDays",
+			Line:      16,
+			Expected:  "<= 90",
+		},
+		{
+			Assertion: "no SSH from the internet",
+			Template:  "api-test.stack.yml",
+			Resource:  "SecurityGroup",
+			Path:      "Properties.SecurityGroupIngress[2]",
+			Line:      27,
+			Expected:  "not CidrIp = 0.0.0.0/0 and FromPort <= 22",
+			Actual:    "CidrIp: 0.0.0.0/0\nFromPort: 0",
+		},
+	}
+	wanted := `✘ logs are retained for at most 90 days
+    api-test.stack.yml: LogGroup.Properties.RetentionInDays (line 6)
+    - expected: <= 90
+    + actual: 3653
+    api-test.addons.stack.yml: ForeverLogGroup.Properties.RetentionInDays (line 16)
+    - expected: <= 90
+    + actual: (missing)
+✘ no SSH from the internet
+    api-test.stack.yml: SecurityGroup.Properties.SecurityGroupIngress[2] (line 27)
+    - expected: not CidrIp = 0.0.0.0/0 and FromPort <= 22
+    + actual:
+    +   CidrIp: 0.0.0.0/0
+    +   FromPort: 0
+`
+
+	var b strings.Builder
+	err := WriteFailures(&b, failures)
+
+	require.NoError(t, err)
+	require.Equal(t, wanted, b.String())
+}
@@ -114,6 +114,7 @@ type EnvOpts struct {
 	DNSCertValidatorLambda    string
 	EnableLongARNFormatLambda string
 	CustomDomainLambda        string
+	CustomResourcesTimeout    int // Timeout in seconds of the Lambda functions, the defaults of each function are used if zero.
 
 	Addons               *Addons
 	ScriptBucketName     string
@@ -127,10 +128,14 @@ type EnvOpts struct {
 	Telemetry         *Telemetry
 	CDNConfig         *CDNConfig
 
+	ImportedClusterName string      // If not empty, use the existing ECS cluster instead of creating a new one.
+	ExecConfig          *ExecConfig // If not nil, configure the sessions started with ECS Exec in the cluster.
+
 	SerializedManifest string // Serialized manifest used to render the environment template.
 	ForceUpdateID      string
 
 	DelegateDNS bool
+	AppRegistry bool // True means the stack is associated with the application in AWS Service Catalog AppRegistry.
 }
 
 // PublicHTTPConfig represents configuration for a public facing Load Balancer.
@@ -139,6 +144,16 @@ type PublicHTTPConfig struct {
 	PublicALBSourceIPs []string
 	CIDRPrefixListIDs  []string
 	ELBAccessLogs      *ELBAccessLogs
+	ImportedALB        *ImportedALB // If not-nil, use the existing load balancer instead of creating a new one.
+}
+
+// ImportedALB holds the fields of an existing public Application Load Balancer imported to an environment.
+type ImportedALB struct {
+	DNSName          string
+	FullName         string
+	HostedZoneID     string
+	SecurityGroupIDs []string
+	HTTPListenerARN  string
 }
 
 // PrivateHTTPConfig represents configuration for an internal Load Balancer.
@@ -159,6 +174,7 @@ func (e *EnvOpts) HasImportedCerts() bool {
 type HTTPConfig struct {
 	SSLPolicy        *string
 	ImportedCertARNs []string
+	IdleTimeout      *int64 // In seconds.
 }
 
 // ELBAccessLogs represents configuration for ELB access logs S3 bucket.
@@ -211,6 +227,7 @@ type ManagedVPC struct {
 	AZs                []string
 	PublicSubnetCIDRs  []string
 	PrivateSubnetCIDRs []string
+	SingleNATGateway   bool // If true, the private subnets share the NAT gateway of the first public subnet.
 }
 
 // Telemetry represents optional observability and monitoring configuration.
@@ -237,6 +254,12 @@ type VPCFlowLogs struct {
 	Retention *int
 }
 
+// ExecConfig holds the fields to encrypt and log the sessions started with ECS Exec in the cluster of an environment.
+type ExecConfig struct {
+	Encryption   bool // If true, create a KMS key to encrypt the sessions.
+	LogRetention *int // If not nil, create a log group that retains the output of the sessions for that many days.
+}
+
 // ParseEnv parses an environment's CloudFormation template with the specified data object and returns its content.
 func (t *Template) ParseEnv(data *EnvOpts) (*Content, error) {
 	tpl, err := t.parse("base", envCFTemplatePath, withEnvParsingFuncs())
@@ -0,0 +1,79 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize/english"
+)
+
+// Names of the helper images that Copilot injects next to the images of a workload.
+const (
+	HelperImageFluentBit     = "fluent-bit"
+	HelperImageOTelCollector = "aws-otel-collector"
+	HelperImageEnvoy         = "envoy"
+	HelperImagePause         = "pause"
+)
+
+// Digests that the tags of the default helper images reference, appended to the images as "@sha256:<hex>"
+// so that the tasks that replace each other run the same images. Bump a tag together with its digest.
+// An image is only pinned once its digest, copied from the manifest list published in the registry, is filled in.
+const (
+	fluentBitImageDigest     = ""
+	otelCollectorImageDigest = ""
+	envoyImageDigest         = ""
+	pauseImageDigest         = ""
+)
+
+// Default locations of the helper images.
+const (
+	DefaultFluentBitImage     = "public.ecr.aws/aws-observability/aws-for-fluent-bit:stable" + fluentBitImageDigest
+	DefaultOTelCollectorImage = "public.ecr.aws/aws-observability/aws-otel-collector:v0.17.0" + otelCollectorImageDigest
+	DefaultEnvoyImage         = "envoyproxy/envoy:v1.27.2" + envoyImageDigest
+	DefaultPauseImage         = "public.ecr.aws/amazonlinux/amazonlinux:2023" + pauseImageDigest
+)
+
+// HelperImage is an image that Copilot injects into the tasks of a workload, such as a sidecar.
+type HelperImage struct {
+	Name       string // Name of the image, used to override its location in an application.
+	URI        string // Default location of the image.
+	InjectedBy string // Manifest field or command that injects the image.
+}
+
+// HelperImages are all the images that Copilot can inject.
+var HelperImages = []HelperImage{
+	{Name: HelperImageFluentBit, URI: DefaultFluentBitImage, InjectedBy: "logging"},
+	{Name: HelperImageOTelCollector, URI: DefaultOTelCollectorImage, InjectedBy: "observability.tracing"},
+	{Name: HelperImageEnvoy, URI: DefaultEnvoyImage, InjectedBy: "http.mirror"},
+	{Name: HelperImagePause, URI: DefaultPauseImage, InjectedBy: "copilot run local"},
+}
+
+// ValidateHelperImageName returns an error if Copilot doesn't inject an image with the name.
+func ValidateHelperImageName(name string) error {
+	for _, img := range HelperImages {
+		if img.Name == name {
+			return nil
+		}
+	}
+	names := make([]string, len(HelperImages))
+	for i, img := range HelperImages {
+		names[i] = fmt.Sprintf("%q", img.Name)
+	}
+	return fmt.Errorf("unknown helper image %q: must be one of %s", name, english.WordSeries(names, "or"))
+}
+
+// HelperImageURI returns the location of the helper image with the name,
+// from the overrides if they have one, or its default location otherwise.
+func HelperImageURI(name string, overrides map[string]string) string {
+	if uri, ok := overrides[name]; ok && uri != "" {
+		return uri
+	}
+	for _, img := range HelperImages {
+		if img.Name == name {
+			return img.URI
+		}
+	}
+	return ""
+}
@@ -0,0 +1,49 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHelperImageURI(t *testing.T) {
+	testCases := map[string]struct {
+		inName      string
+		inOverrides map[string]string
+		wanted      string
+	}{
+		"default image": {
+			inName: HelperImageEnvoy,
+			wanted: "envoyproxy/envoy:v1.27.2",
+		},
+		"image overridden by the application": {
+			inName: HelperImageFluentBit,
+			inOverrides: map[string]string{
+				"fluent-bit": "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/fluent-bit:2.31.12",
+			},
+			wanted: "123456789012.dkr.ecr.us-west-2.amazonaws.com/mirror/fluent-bit:2.31.12",
+		},
+		"unknown image": {
+			inName: "nginx",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, HelperImageURI(tc.inName, tc.inOverrides))
+		})
+	}
+}
+
+func TestHelperImageDigests(t *testing.T) {
+	digest := regexp.MustCompile(`^@sha256:[0-9a-f]{64}$`)
+	for _, d := range []string{fluentBitImageDigest, otelCollectorImageDigest, envoyImageDigest, pauseImageDigest} {
+		if d == "" {
+			continue
+		}
+		require.Regexp(t, digest, d)
+	}
+}
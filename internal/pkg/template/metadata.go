@@ -0,0 +1,75 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AddStackMetadata adds the keys to the top-level Metadata section of the CloudFormation template,
+// so that organizations can validate stacks with CloudFormation Hooks or Guard rules.
+// Keys that the template already defines, like Version or Manifest, are kept as they are.
+func AddStackMetadata(tpl string, metadata map[string]string) (string, error) {
+	if len(metadata) == 0 {
+		return tpl, nil
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(tpl), &doc); err != nil {
+		return "", fmt.Errorf("unmarshal template: %w", err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return "", errors.New("template must be a YAML mapping")
+	}
+	root := doc.Content[0]
+	section := metadataSection(root)
+	if section.Kind != yaml.MappingNode {
+		return "", errors.New(`"Metadata" section of the template must be a map`)
+	}
+	existing := make(map[string]bool)
+	for i := 0; i+1 < len(section.Content); i += 2 {
+		existing[section.Content[i].Value] = true
+	}
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if existing[key] {
+			continue
+		}
+		section.Content = append(section.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: metadata[key]})
+	}
+	var out strings.Builder
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return "", fmt.Errorf("marshal template: %w", err)
+	}
+	return out.String(), nil
+}
+
+// metadataSection returns the "Metadata" section of the template, and adds an empty one after the description if there's none.
+func metadataSection(root *yaml.Node) *yaml.Node {
+	insertAt := 0
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		switch root.Content[i].Value {
+		case "Metadata":
+			return root.Content[i+1]
+		case "AWSTemplateFormatVersion", "Description":
+			insertAt = i + 2
+		}
+	}
+	section := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "Metadata"}
+	root.Content = append(root.Content[:insertAt], append([]*yaml.Node{key, section}, root.Content[insertAt:]...)...)
+	return section
+}
@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddStackMetadata(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+		inMetadata map[string]string
+
+		wanted    string
+		wantedErr string
+	}{
+		"no metadata": {
+			inTemplate: "Resources: {}\n",
+			wanted:     "Resources: {}\n",
+		},
+		"add to the existing metadata without overwriting keys": {
+			inTemplate: `AWSTemplateFormatVersion: '2010-09-09'
+Metadata:
+  Version: v1.29.0
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !Sub '${AWS::StackName}-bucket'
+`,
+			inMetadata: map[string]string{
+				"Version":    "v0.0.1",
+				"CostCenter": "1234",
+				"Owner":      "payments",
+			},
+			wanted: `AWSTemplateFormatVersion: '2010-09-09'
+Metadata:
+  Version: v1.29.0
+  CostCenter: "1234"
+  Owner: payments
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !Sub '${AWS::StackName}-bucket'
+`,
+		},
+		"add a metadata section after the description": {
+			inTemplate: `AWSTemplateFormatVersion: '2010-09-09'
+Description: CloudFormation template that represents a backend service on Amazon ECS.
+Resources: {}
+`,
+			inMetadata: map[string]string{
+				"Owner": "payments",
+			},
+			wanted: `AWSTemplateFormatVersion: '2010-09-09'
+Description: CloudFormation template that represents a backend service on Amazon ECS.
+Metadata:
+  Owner: payments
+Resources: {}
+`,
+		},
+		"error if the metadata section isn't a map": {
+			inTemplate: "Metadata: []\n",
+			inMetadata: map[string]string{
+				"Owner": "payments",
+			},
+			wantedErr: `"Metadata" section of the template must be a map`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := AddStackMetadata(tc.inTemplate, tc.inMetadata)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -14,6 +14,9 @@ const (
 	cdkVersion              = "2.56.0"
 	cdkConstructsMinVersion = "10.0.0"
 	cdkTemplatesPath        = "overrides/cdk"
+	cdkGoTemplatesPath      = "overrides/cdk-go"
+	cdkPythonTemplatesPath  = "overrides/cdk-python"
+	goTemplateFileSuffix    = ".tmpl"
 
 	yamlPatchTemplatesPath = "overrides/yamlpatch"
 )
@@ -107,13 +110,31 @@ func (t CFNType) L1ConstructName() string {
 
 // WalkOverridesCDKDir walks through the overrides/cdk templates and calls fn for each parsed template file.
 func (t *Template) WalkOverridesCDKDir(resources []CFNResource, fn WalkDirFunc, requiresEnv bool) error {
+	return t.walkOverridesCDKDir(cdkTemplatesPath, resources, fn, requiresEnv)
+}
+
+// WalkOverridesCDKGoDir walks through the overrides/cdk-go templates and calls fn for each parsed template file.
+// Go source and module files are stored with a ".tmpl" suffix so that they're not compiled with Copilot,
+// the suffix is trimmed from the name passed to fn.
+func (t *Template) WalkOverridesCDKGoDir(resources []CFNResource, fn WalkDirFunc, requiresEnv bool) error {
+	return t.walkOverridesCDKDir(cdkGoTemplatesPath, resources, func(name string, content *Content) error {
+		return fn(strings.TrimSuffix(name, goTemplateFileSuffix), content)
+	}, requiresEnv)
+}
+
+// WalkOverridesCDKPythonDir walks through the overrides/cdk-python templates and calls fn for each parsed template file.
+func (t *Template) WalkOverridesCDKPythonDir(resources []CFNResource, fn WalkDirFunc, requiresEnv bool) error {
+	return t.walkOverridesCDKDir(cdkPythonTemplatesPath, resources, fn, requiresEnv)
+}
+
+func (t *Template) walkOverridesCDKDir(path string, resources []CFNResource, fn WalkDirFunc, requiresEnv bool) error {
 	type metadata struct {
 		Version           string
 		ConstructsVersion string
 		Resources         cfnResources
 		RequiresEnv       bool
 	}
-	return t.walkDir(cdkTemplatesPath, cdkTemplatesPath, metadata{
+	return t.walkDir(path, path, metadata{
 		Version:           cdkVersion,
 		ConstructsVersion: cdkConstructsMinVersion,
 		Resources:         resources,
@@ -131,6 +152,10 @@ func (t *Template) WalkOverridesCDKDir(resources []CFNResource, fn WalkDirFunc,
 				}
 				return strings.ToLower(serviceName[:firstSmall]) + serviceName[firstSmall:]
 			},
+			// transform an import name like "aws_ecs" into the Go CDK package name "awsecs".
+			"goPackageName": func(importName string) string {
+				return strings.ReplaceAll(importName, "_", "")
+			},
 		},
 	))
 }
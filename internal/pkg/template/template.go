@@ -18,7 +18,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 )
 
-//go:embed templates templates/overrides/cdk/.gitignore
+//go:embed templates templates/overrides/cdk/.gitignore templates/overrides/cdk-go/.gitignore templates/overrides/cdk-python/.gitignore
 var templateFS embed.FS
 
 // File names under "templates/".
@@ -173,6 +173,69 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				Version:         "v1.28.0",
 			},
 		},
+		"renders a valid template with routing derived from an OpenAPI spec": {
+			opts: template.WorkloadOpts{
+				ALBListener: &template.ALBListener{
+					Rules: []template.ALBListenerRule{
+						{
+							Path:            "/pets",
+							TargetPort:      "8080",
+							TargetContainer: "main",
+							HTTPHealthCheck: defaultHttpHealthCheck,
+							Stickiness:      "false",
+						},
+						{
+							Path:              "/stores",
+							TargetPort:        "8080",
+							TargetContainer:   "main",
+							HTTPHealthCheck:   defaultHttpHealthCheck,
+							Stickiness:        "false",
+							SharedTargetGroup: true,
+						},
+					},
+					OpenAPIWebACL: &template.OpenAPIWebACL{
+						ScopeRegexSets: [][]string{{`^/pets(/.*)?$`}, {`^/stores(/.*)?$`}},
+						PathRegexSets:  [][]string{{`^/pets$`, `^/pets/[^/]+$`, `^/stores/[^/]+/orders$`}},
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				ALBEnabled:      true,
+				CustomResources: customResources,
+				EnvVersion:      "v1.42.0",
+				Version:         "v1.28.0",
+			},
+		},
+		"renders a valid template with scale-in protection": {
+			opts: template.WorkloadOpts{
+				ALBListener: &template.ALBListener{
+					Rules: []template.ALBListenerRule{
+						{
+							Path:            "/",
+							TargetPort:      "8080",
+							TargetContainer: "main",
+							HTTPHealthCheck: defaultHttpHealthCheck,
+							Stickiness:      "false",
+						},
+					},
+				},
+				ScaleInProtection: &template.ScaleInProtectionOpts{
+					ExpiresInMinutes: 120,
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				ALBEnabled:      true,
+				CustomResources: customResources,
+				EnvVersion:      "v1.42.0",
+				Version:         "v1.28.0",
+			},
+		},
 		"renders a valid grpc template by default": {
 			opts: template.WorkloadOpts{
 				ALBListener: &template.ALBListener{
@@ -467,6 +530,71 @@ DiscoveryServiceArn:
 				Version:         "v1.28.0",
 			},
 		},
+		"renders a valid template with anomaly detection alarms": {
+			opts: template.WorkloadOpts{
+				ALBListener: &template.ALBListener{
+					Rules: []template.ALBListenerRule{
+						{
+							Path:            "/",
+							TargetPort:      "8080",
+							TargetContainer: "main",
+							HTTPVersion:     "GRPC",
+							HTTPHealthCheck: defaultHttpHealthCheck,
+							Stickiness:      "false",
+						},
+					},
+				},
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				Observability: template.ObservabilityOpts{
+					AnomalyDetection: &template.AnomalyDetectionOpts{
+						Latency: true,
+						Errors:  true,
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+				CustomResources:          customResources,
+				EnvVersion:               "v1.42.0",
+				Version:                  "v1.28.0",
+			},
+		},
+		"renders a valid template with alias health checks": {
+			opts: template.WorkloadOpts{
+				ALBListener: &template.ALBListener{
+					Rules: []template.ALBListenerRule{
+						{
+							Path:            "/",
+							TargetPort:      "8080",
+							TargetContainer: "main",
+							HTTPVersion:     "GRPC",
+							HTTPHealthCheck: defaultHttpHealthCheck,
+							Stickiness:      "false",
+							Aliases:         []string{"example.com"},
+						},
+					},
+					IsHTTPS: true,
+					AliasHealthChecks: []template.AliasHealthCheck{
+						{
+							Domain:           "example.com",
+							Path:             "/",
+							FailureThreshold: aws.Int64(2),
+						},
+					},
+				},
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+				CustomResources:          customResources,
+				EnvVersion:               "v1.42.0",
+				Version:                  "v1.28.0",
+			},
+		},
 		"renders a valid template with Windows platform": {
 			opts: template.WorkloadOpts{
 				ALBListener: &template.ALBListener{
@@ -0,0 +1,29 @@
+import os
+
+import aws_cdk as cdk
+from aws_cdk import cloudformation_include
+{{- range $import := .Resources.Imports }}
+from aws_cdk import {{$import.ImportName}} as {{$import.ImportShortRename}}
+{{- end }}
+
+
+class TransformedStack(cdk.Stack):
+    def __init__(self, scope: cdk.App, construct_id: str, *, app_name: str,{{- if .RequiresEnv }} env_name: str,{{- end }} **kwargs) -> None:
+        super().__init__(scope, construct_id, **kwargs)
+        self.template = cloudformation_include.CfnInclude(self, "Template",
+            template_file=os.path.join(".build", "in.yml"),
+        )
+        self.app_name = app_name
+        {{- if .RequiresEnv }}
+        self.env_name = env_name
+        {{- end }}
+
+        {{- range $resource := .Resources }}
+        self.transform_{{lowerInitialLetters $resource.LogicalID}}()
+        {{- end }}
+    {{range $resource := .Resources}}
+    # TODO: implement me.
+    def transform_{{lowerInitialLetters $resource.LogicalID}}(self):
+        {{lowerInitialLetters $resource.LogicalID}}: {{$resource.Type.ImportShortRename}}.{{$resource.Type.L1ConstructName}} = self.template.get_resource("{{$resource.LogicalID}}")
+        raise NotImplementedError
+    {{end }}
@@ -6,8 +6,10 @@ package template
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
@@ -74,6 +76,13 @@ const (
 	NoExposedContainerPort = "-1"
 )
 
+const (
+	// TrafficMirrorContainerName is the name of the sidecar that copies the requests of the load balancer to another service.
+	TrafficMirrorContainerName = "traffic-mirror"
+	// TrafficMirrorListenerPort is the port of the traffic mirror sidecar that the load balancer sends the requests to.
+	TrafficMirrorListenerPort = "10000"
+)
+
 var (
 	// Template names under "workloads/partials/cf/".
 	partialsWorkloadCFTemplateNames = []string{
@@ -110,6 +119,11 @@ var (
 		"vpc-connector",
 		"alb",
 		"rollback-alarms",
+		"anomaly-alarms",
+		"alias-health-checks",
+		"openapi-waf",
+		"codedeploy",
+		"apprunner-environment",
 	}
 
 	// Operating systems to determine Fargate platform versions.
@@ -454,6 +468,8 @@ type NetworkLoadBalancerListener struct {
 // NLBHealthCheck holds configuration for Network Load Balancer health check.
 type NLBHealthCheck struct {
 	Port               string // The port to which health check requests made from Network Load Balancer are routed to.
+	Protocol           string // The protocol of the health check requests, TCP if empty.
+	Path               string // The destination of HTTP and HTTPS health check requests.
 	HealthyThreshold   *int64
 	UnhealthyThreshold *int64
 	Timeout            *int64
@@ -485,6 +501,9 @@ type ALBListenerRule struct {
 	HTTPVersion         string
 	RedirectToHTTPS     bool // Only relevant if HTTPSListener is true.
 	DeregistrationDelay *int64
+	SlowStart           *int64
+	// SharedTargetGroup is true if the rule forwards to the target group of the first rule instead of its own.
+	SharedTargetGroup bool
 }
 
 // ALBListener holds configuration that's needed for an Application Load Balancer Listener.
@@ -493,6 +512,38 @@ type ALBListener struct {
 	HostedZoneAliases AliasesForHostedZone
 	IsHTTPS           bool // True if the listener listening on port 443.
 	MainContainerPort string
+	AliasHealthChecks []AliasHealthCheck
+	OpenAPIWebACL     *OpenAPIWebACL
+}
+
+// OpenAPIWebACL holds the regex pattern sets of a web ACL that blocks the requests under the paths of an OpenAPI spec
+// that don't match any of its paths. Each set holds at most 10 regular expressions.
+type OpenAPIWebACL struct {
+	ScopeRegexSets [][]string // Requests under the paths of the spec.
+	PathRegexSets  [][]string // Requests to the paths defined by the spec.
+}
+
+// TrafficMirrorOpts holds configuration for the Envoy sidecar that serves the requests of the load balancer with the target container,
+// and sends a copy of a percentage of them to another service without returning its responses.
+type TrafficMirrorOpts struct {
+	Image      string // Envoy image of the sidecar.
+	TargetPort string // Port of the container that serves the requests.
+	Host       string // DNS name of the service that receives the copies of the requests.
+	Port       string
+	Percent    int
+}
+
+// AliasHealthCheck holds configuration for a Route 53 health check against an alias of the load balancer.
+type AliasHealthCheck struct {
+	Domain           string
+	Path             string
+	FailureThreshold *int64
+	RequestInterval  *int64
+}
+
+// TruncateAlarmName ensures that alarm names don't exceed the 255 character limit.
+func (c AliasHealthCheck) TruncateAlarmName(app, env, svc string) string {
+	return truncateAlarmName(app, env, svc, "CopilotAliasHealthCheck-"+c.Domain)
 }
 
 // Aliases return all the unique aliases specified across all the routing rules in ALB.
@@ -564,12 +615,41 @@ type AutoscalingOpts struct {
 	Memory             *float64
 	Requests           *float64
 	ResponseTime       *float64
+	Connections        *float64
 	CPUCooldown        Cooldown
 	MemCooldown        Cooldown
 	ReqCooldown        Cooldown
 	RespTimeCooldown   Cooldown
+	ConnCooldown       Cooldown
 	QueueDelayCooldown Cooldown
 	QueueDelay         *AutoscalingQueueDelayOpts
+
+	CustomMetrics []AutoscalingCustomMetricOpts
+	Schedules     []AutoscalingScheduleOpts
+}
+
+// AutoscalingCustomMetricOpts holds configuration to track a target value of a CloudWatch metric.
+type AutoscalingCustomMetricOpts struct {
+	Namespace   string
+	MetricName  string
+	Statistic   string
+	Dimensions  []AutoscalingMetricDimension // Sorted by name.
+	TargetValue float64
+	Cooldown    Cooldown
+}
+
+// AutoscalingMetricDimension is a dimension of a CloudWatch metric.
+type AutoscalingMetricDimension struct {
+	Name  string
+	Value string
+}
+
+// AutoscalingScheduleOpts holds configuration to change the capacity of a service on a schedule.
+type AutoscalingScheduleOpts struct {
+	Schedule    string // An "at", "cron" or "rate" expression of Application Auto Scaling.
+	Timezone    string
+	MinCapacity int
+	MaxCapacity int
 }
 
 // AliasesForHostedZone maps hosted zone IDs to aliases that belong to it.
@@ -580,9 +660,54 @@ type AutoscalingQueueDelayOpts struct {
 	AcceptableBacklogPerTask int
 }
 
+// Tracing vendors of the ADOT collector sidecar.
+const (
+	TracingAWSXRay = "AWSXRAY"
+	TracingOTLP    = "OTLP"
+)
+
 // ObservabilityOpts holds configurations for observability.
 type ObservabilityOpts struct {
-	Tracing string // The name of the vendor used for tracing.
+	Tracing        string // The name of the vendor used for tracing.
+	OTLPEndpoint   string // Endpoint that the collector exports the traces to with the OTLP vendor.
+	CollectorImage string // ADOT collector image of the sidecar.
+
+	AnomalyDetection *AnomalyDetectionOpts
+}
+
+// AnomalyDetectionOpts holds configuration for the CloudWatch alarms on unusual values of the load balancer metrics of a service.
+type AnomalyDetectionOpts struct {
+	Latency bool // Alarm when the response time of the targets is higher than expected.
+	Errors  bool // Alarm when the targets return more 5XX responses than expected.
+}
+
+// TruncateAlarmName ensures that alarm names don't exceed the 255 character limit.
+func (AnomalyDetectionOpts) TruncateAlarmName(app, env, svc, alarmType string) string {
+	return truncateAlarmName(app, env, svc, alarmType)
+}
+
+// AppRunnerCodeRepositoryOpts holds configuration for an App Runner service that builds and runs
+// source code from a repository instead of an image.
+type AppRunnerCodeRepositoryOpts struct {
+	URL           string
+	Branch        string
+	Directory     string // Path of the source code relative to the root of the repository.
+	ConnectionARN string
+	Runtime       string
+	BuildCommand  string
+}
+
+// AppRunnerAutoScalingOpts holds configuration for the auto scaling configuration of an App Runner service.
+type AppRunnerAutoScalingOpts struct {
+	MaxConcurrency *int // Number of concurrent requests that an instance processes before App Runner scales out.
+	MinSize        *int
+	MaxSize        *int
+}
+
+// BackendServiceEndpoint holds the private endpoint of a Backend Service that an App Runner service can call through its VPC connector.
+type BackendServiceEndpoint struct {
+	Name     string // Name of the environment variable holding the endpoint.
+	Endpoint string // Endpoint registered in the service discovery namespace, such as "api.test.my-app.local:8080".
 }
 
 // DeploymentConfigurationOpts holds configuration for rolling deployments.
@@ -594,6 +719,18 @@ type DeploymentConfigurationOpts struct {
 	Rollback   RollingUpdateRollbackConfig
 }
 
+// DeploymentStrategyOpts holds configuration for blue/green and canary deployments with CodeDeploy.
+type DeploymentStrategyOpts struct {
+	CanaryPercent  int // Percentage of the traffic shifted to the new tasks first, 0 for blue/green deployments.
+	CanaryInterval int // Minutes before the rest of the traffic is shifted to the new tasks.
+	BakeTime       int // Minutes before the old tasks are terminated once all the traffic is shifted.
+}
+
+// IsCanary returns true if the traffic is shifted to the new tasks in two increments.
+func (s DeploymentStrategyOpts) IsCanary() bool {
+	return s.CanaryPercent > 0
+}
+
 // RollingUpdateRollbackConfig holds config for rollback alarms.
 type RollingUpdateRollbackConfig struct {
 	AlarmNames []string // Names of existing alarms.
@@ -616,6 +753,10 @@ func (cfg RollingUpdateRollbackConfig) HasCustomAlarms() bool {
 
 // TruncateAlarmName ensures that alarm names don't exceed the 255 character limit.
 func (cfg RollingUpdateRollbackConfig) TruncateAlarmName(app, env, svc, alarmType string) string {
+	return truncateAlarmName(app, env, svc, alarmType)
+}
+
+func truncateAlarmName(app, env, svc, alarmType string) string {
 	if len(app)+len(env)+len(svc)+len(alarmType) <= 255 {
 		return fmt.Sprintf("%s-%s-%s-%s", app, env, svc, alarmType)
 	}
@@ -626,6 +767,11 @@ func (cfg RollingUpdateRollbackConfig) TruncateAlarmName(app, env, svc, alarmTyp
 // ExecuteCommandOpts holds configuration that's needed for ECS Execute Command.
 type ExecuteCommandOpts struct{}
 
+// ScaleInProtectionOpts holds configuration that's needed for the tasks to protect themselves from scale-in events.
+type ScaleInProtectionOpts struct {
+	ExpiresInMinutes int
+}
+
 // StateMachineOpts holds configuration needed for State Machine retries and timeout.
 type StateMachineOpts struct {
 	Timeout *int
@@ -657,8 +803,9 @@ type FIFOTopicConfig struct {
 
 // SubscribeOpts holds configuration needed if the service has subscriptions.
 type SubscribeOpts struct {
-	Topics []*TopicSubscription
-	Queue  *SQSQueue
+	Topics   []*TopicSubscription
+	Queue    *SQSQueue
+	Consumer *SQSConsumer
 }
 
 // HasTopicQueues returns true if any individual subscription has a dedicated queue.
@@ -688,6 +835,22 @@ type SQSQueue struct {
 	FIFOQueueConfig *FIFOQueueConfig
 }
 
+// SQSConsumer holds the settings of the consumer of the events queue, rendered as environment variables.
+type SQSConsumer struct {
+	MaxConcurrentMessages *int
+	BatchSize             *int
+	ProcessingTimeout     *int64
+	VisibilityTimeout     int64
+	PartialBatchFailures  bool
+	MessageGroupStrategy  *string
+	DeduplicationTable    *DeduplicationTable
+}
+
+// DeduplicationTable holds the settings of the DynamoDB table that records the messages that were already processed.
+type DeduplicationTable struct {
+	TTL int64 // In seconds.
+}
+
 // FIFOQueueConfig holds information needed to render a FIFO SQS Queue in a container definition.
 type FIFOQueueConfig struct {
 	FIFOThroughputLimit       *string
@@ -801,7 +964,7 @@ type WorkloadOpts struct {
 	Command      []string
 
 	// Additional options that are common between **all** workload templates.
-	Tags                     map[string]string        // Used by App Runner workloads to tag App Runner service resources
+	Tags                     map[string]string        // Used to tag the App Runner service resources, and the target groups and Cloud Map services of ECS services.
 	NestedStack              *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
 	AddonsExtraParams        string                   // Additional user defined Parameters for the addons stack.
 	Sidecars                 []*SidecarOpts
@@ -812,6 +975,7 @@ type WorkloadOpts struct {
 	Storage                  *StorageOpts
 	Network                  NetworkOpts
 	ExecuteCommand           *ExecuteCommandOpts
+	ScaleInProtection        *ScaleInProtectionOpts
 	Platform                 RuntimePlatformOpts
 	DockerLabels             map[string]string
 	DependsOn                map[string]string
@@ -820,6 +984,7 @@ type WorkloadOpts struct {
 	ALBEnabled               bool
 	CredentialsParameter     string
 	PermissionsBoundary      string
+	StopTimeout              *int64 // Seconds to wait for the main container to exit after SIGTERM before it's killed.
 
 	// Additional options for service templates.
 	WorkloadType            string
@@ -829,7 +994,9 @@ type WorkloadOpts struct {
 	NLB                     *NetworkLoadBalancer
 	ALBListener             *ALBListener
 	DeploymentConfiguration DeploymentConfigurationOpts
+	DeploymentStrategy      *DeploymentStrategyOpts
 	ServiceConnect          *ServiceConnect
+	TrafficMirror           *TrafficMirrorOpts
 
 	// Custom Resources backed by Lambda functions.
 	CustomResources map[string]S3ObjectLocation
@@ -845,6 +1012,9 @@ type WorkloadOpts struct {
 	Private              bool
 	AppRunnerVPCEndpoint *string
 	Count                *string
+	AutoScaling          *AppRunnerAutoScalingOpts
+	CodeRepository       *AppRunnerCodeRepositoryOpts
+	BackendEndpoints     []BackendServiceEndpoint
 
 	// Input needed for the custom resource that adds a custom domain to the service.
 	Alias                *string
@@ -861,6 +1031,114 @@ type WorkloadOpts struct {
 	StaticSiteAlias        string
 }
 
+// TargetGroupLogicalIDs returns the logical IDs of the target groups of the i-th listener rule.
+// The first rule gets a second target group, "TargetGroupGreen", to which CodeDeploy shifts the traffic
+// during blue/green and canary deployments.
+func (o WorkloadOpts) TargetGroupLogicalIDs(i int) []string {
+	if i != 0 {
+		return []string{fmt.Sprintf("TargetGroup%d", i)}
+	}
+	if o.DeploymentStrategy != nil {
+		return []string{"TargetGroup", "TargetGroupGreen"}
+	}
+	return []string{"TargetGroup"}
+}
+
+// ALBTargetGroupLogicalIDs returns the logical IDs of the target groups of all the listener rules of the service.
+func (o WorkloadOpts) ALBTargetGroupLogicalIDs() []string {
+	if o.ALBListener == nil {
+		return nil
+	}
+	var ids []string
+	for i, rule := range o.ALBListener.Rules {
+		if rule.SharedTargetGroup {
+			continue
+		}
+		ids = append(ids, o.TargetGroupLogicalIDs(i)...)
+	}
+	return ids
+}
+
+// metricDataQuery is a CloudWatch metric data query, as accepted by the GetMetricData API and dashboards.
+type metricDataQuery struct {
+	ID         string      `json:"Id"`
+	Expression string      `json:"Expression,omitempty"`
+	MetricStat *metricStat `json:"MetricStat,omitempty"`
+	Label      string      `json:"Label,omitempty"`
+	ReturnData bool        `json:"ReturnData"`
+}
+
+type metricStat struct {
+	Metric struct {
+		Namespace  string            `json:"Namespace"`
+		MetricName string            `json:"MetricName"`
+		Dimensions []metricDimension `json:"Dimensions"`
+	} `json:"Metric"`
+	Period int    `json:"Period"`
+	Stat   string `json:"Stat"`
+}
+
+type metricDimension struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// RequestAttributionMetricQueries returns the JSON CloudWatch metric data queries of the number of requests
+// served by the target groups of the service, and of their share of all the requests of the load balancer.
+// The full names of the load balancer and of the target groups are left as "${LoadBalancer}" and "${<logical ID>}"
+// variables for Fn::Sub.
+func (o WorkloadOpts) RequestAttributionMetricQueries() (string, error) {
+	requestCount := func(id string, dimensions ...string) metricDataQuery {
+		stat := &metricStat{
+			Period: 300,
+			Stat:   "Sum",
+		}
+		stat.Metric.Namespace = "AWS/ApplicationELB"
+		stat.Metric.MetricName = "RequestCount"
+		for _, dimension := range dimensions {
+			stat.Metric.Dimensions = append(stat.Metric.Dimensions, metricDimension{
+				Name:  dimension,
+				Value: fmt.Sprintf("${%s}", dimension),
+			})
+		}
+		return metricDataQuery{
+			ID:         id,
+			MetricStat: stat,
+		}
+	}
+	var queries []metricDataQuery
+	var ids []string
+	for i, logicalID := range o.ALBTargetGroupLogicalIDs() {
+		id := fmt.Sprintf("tg%d", i)
+		query := requestCount(id, "LoadBalancer")
+		query.MetricStat.Metric.Dimensions = append(query.MetricStat.Metric.Dimensions, metricDimension{
+			Name:  "TargetGroup",
+			Value: fmt.Sprintf("${%s}", logicalID),
+		})
+		queries = append(queries, query)
+		ids = append(ids, id)
+	}
+	queries = append(queries,
+		requestCount("lb", "LoadBalancer"),
+		metricDataQuery{
+			ID:         "requests",
+			Expression: fmt.Sprintf("SUM([%s])", strings.Join(ids, ",")),
+			Label:      "Requests served by the service",
+			ReturnData: true,
+		},
+		metricDataQuery{
+			ID:         "share",
+			Expression: "100*requests/lb",
+			Label:      "Share of the requests of the load balancer (%)",
+			ReturnData: true,
+		})
+	out, err := json.Marshal(queries)
+	if err != nil {
+		return "", fmt.Errorf("marshal request attribution metric queries: %w", err)
+	}
+	return string(out), nil
+}
+
 // HealthCheckProtocol returns the protocol for the Load Balancer health check,
 // or an empty string if it shouldn't be configured, defaulting to the
 // target protocol. (which is what happens, even if it isn't documented as such :))
@@ -66,6 +66,11 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/vpc-connector.yml", []byte("vpc-connector"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/alb.yml", []byte("alb"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/rollback-alarms.yml", []byte("rollback-alarms"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/anomaly-alarms.yml", []byte("anomaly-alarms"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/alias-health-checks.yml", []byte("alias-health-checks"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/openapi-waf.yml", []byte("openapi-waf"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/codedeploy.yml", []byte("codedeploy"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/apprunner-environment.yml", []byte("apprunner-environment"), 0644)
 
 				return fs
 			},
@@ -102,6 +107,11 @@ func TestTemplate_ParseSvc(t *testing.T) {
   vpc-connector
   alb
   rollback-alarms
+  anomaly-alarms
+  alias-health-checks
+  openapi-waf
+  codedeploy
+  apprunner-environment
 `,
 		},
 	}
@@ -466,6 +476,118 @@ func TestRollingUpdateRollbackConfig_TruncateAlarmName(t *testing.T) {
 	}
 }
 
+func TestWorkloadOpts_TargetGroupLogicalIDs(t *testing.T) {
+	testCases := map[string]struct {
+		opts     WorkloadOpts
+		inRule   int
+		expected []string
+	}{
+		"first rule of a service deployed with rolling updates": {
+			expected: []string{"TargetGroup"},
+		},
+		"first rule of a service deployed with CodeDeploy": {
+			opts: WorkloadOpts{
+				DeploymentStrategy: &DeploymentStrategyOpts{},
+			},
+			expected: []string{"TargetGroup", "TargetGroupGreen"},
+		},
+		"additional rule of a service deployed with CodeDeploy": {
+			opts: WorkloadOpts{
+				DeploymentStrategy: &DeploymentStrategyOpts{},
+			},
+			inRule:   2,
+			expected: []string{"TargetGroup2"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.opts.TargetGroupLogicalIDs(tc.inRule))
+		})
+	}
+}
+
+func TestWorkloadOpts_ALBTargetGroupLogicalIDs(t *testing.T) {
+	testCases := map[string]struct {
+		opts     WorkloadOpts
+		expected []string
+	}{
+		"no target groups without a load balancer": {},
+		"skips the rules that share the target group of the first rule": {
+			opts: WorkloadOpts{
+				DeploymentStrategy: &DeploymentStrategyOpts{},
+				ALBListener: &ALBListener{
+					Rules: []ALBListenerRule{
+						{},
+						{SharedTargetGroup: true},
+						{},
+					},
+				},
+			},
+			expected: []string{"TargetGroup", "TargetGroupGreen", "TargetGroup2"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.opts.ALBTargetGroupLogicalIDs())
+		})
+	}
+}
+
+func TestWorkloadOpts_RequestAttributionMetricQueries(t *testing.T) {
+	opts := WorkloadOpts{
+		ALBListener: &ALBListener{
+			Rules: []ALBListenerRule{{}, {}},
+		},
+	}
+
+	got, err := opts.RequestAttributionMetricQueries()
+
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+  {
+    "Id": "tg0",
+    "MetricStat": {
+      "Metric": {
+        "Namespace": "AWS/ApplicationELB",
+        "MetricName": "RequestCount",
+        "Dimensions": [{"Name": "LoadBalancer", "Value": "${LoadBalancer}"}, {"Name": "TargetGroup", "Value": "${TargetGroup}"}]
+      },
+      "Period": 300,
+      "Stat": "Sum"
+    },
+    "ReturnData": false
+  },
+  {
+    "Id": "tg1",
+    "MetricStat": {
+      "Metric": {
+        "Namespace": "AWS/ApplicationELB",
+        "MetricName": "RequestCount",
+        "Dimensions": [{"Name": "LoadBalancer", "Value": "${LoadBalancer}"}, {"Name": "TargetGroup", "Value": "${TargetGroup1}"}]
+      },
+      "Period": 300,
+      "Stat": "Sum"
+    },
+    "ReturnData": false
+  },
+  {
+    "Id": "lb",
+    "MetricStat": {
+      "Metric": {
+        "Namespace": "AWS/ApplicationELB",
+        "MetricName": "RequestCount",
+        "Dimensions": [{"Name": "LoadBalancer", "Value": "${LoadBalancer}"}]
+      },
+      "Period": 300,
+      "Stat": "Sum"
+    },
+    "ReturnData": false
+  },
+  {"Id": "requests", "Expression": "SUM([tg0,tg1])", "Label": "Requests served by the service", "ReturnData": true},
+  {"Id": "share", "Expression": "100*requests/lb", "Label": "Share of the requests of the load balancer (%)", "ReturnData": true}
+]`, got)
+}
+
 func TestApplicationLoadBalancer_Aliases(t *testing.T) {
 	tests := map[string]struct {
 		opts     ALBListener
@@ -64,6 +64,12 @@ func DisableColorBasedOnEnvVar() {
 	}
 }
 
+// DisableColor turns off colored output, regardless of the environment and the terminal.
+func DisableColor() {
+	core.DisableColor = true
+	color.NoColor = true
+}
+
 // Help colors the string to denote that it's auxiliary helpful information, and returns it.
 func Help(s string) string {
 	return Faint.Sprint(s)
@@ -143,11 +143,7 @@ func listeningResourceComponent(streamer StackSubscriber, logicalID, description
 		separator:   '\t',
 	}
 	if startEvent := opts.StartEvent; startEvent != nil {
-		updateComponentStatus(&comp.mu, &comp.statuses, cfnStatus{
-			value:  cloudformation.StackStatus(startEvent.ResourceStatus),
-			reason: startEvent.ResourceStatusReason,
-		})
-		updateComponentTimer(&comp.mu, comp.statuses, comp.stopWatch)
+		comp.update(*startEvent)
 	}
 	go comp.Listen()
 	return comp
@@ -159,15 +155,56 @@ func (c *regularResourceComponent) Listen() {
 		if c.logicalID != ev.LogicalResourceID {
 			continue
 		}
-		updateComponentStatus(&c.mu, &c.statuses, cfnStatus{
-			value:  cloudformation.StackStatus(ev.ResourceStatus),
-			reason: ev.ResourceStatusReason,
-		})
-		updateComponentTimer(&c.mu, c.statuses, c.stopWatch)
+		c.update(ev)
 	}
 	close(c.done) // No more events will be processed.
 }
 
+func (c *regularResourceComponent) update(ev stream.StackEvent) {
+	if ev.HookType != "" {
+		c.updateHook(ev)
+		return
+	}
+	updateComponentStatus(&c.mu, &c.statuses, cfnStatus{
+		value:  cloudformation.StackStatus(ev.ResourceStatus),
+		reason: ev.ResourceStatusReason,
+	})
+	updateComponentTimer(&c.mu, c.statuses, c.stopWatch)
+	_, logGroup, logStream := customResourceLogs(ev.ResourceStatusReason)
+	Emit(Event{
+		Type:        EventTypeResource,
+		Timestamp:   ev.Timestamp,
+		Resource:    c.logicalID,
+		Description: c.description,
+		Status:      ev.ResourceStatus,
+		Reason:      ev.ResourceStatusReason,
+		LogGroup:    logGroup,
+		LogStream:   logStream,
+	})
+}
+
+// updateHook records the failures of the CloudFormation Hooks invoked on the resource, so that the rule that
+// blocked the deployment is rendered. The other hook events don't change the status of the resource.
+func (c *regularResourceComponent) updateHook(ev stream.StackEvent) {
+	if !cloudformation.IsHookFailure(ev.HookStatus, ev.HookFailureMode) {
+		return
+	}
+	reason := fmt.Sprintf("hook %s failed: %s", ev.HookType, ev.HookStatusReason)
+	updateComponentStatus(&c.mu, &c.statuses, cfnStatus{
+		value:  hookFailedResult{},
+		reason: reason,
+	})
+	updateComponentTimer(&c.mu, c.statuses, c.stopWatch)
+	Emit(Event{
+		Type:        EventTypeResource,
+		Timestamp:   ev.Timestamp,
+		Resource:    c.logicalID,
+		Description: c.description,
+		Status:      ev.HookStatus,
+		Reason:      reason,
+	})
+}
+
 // Render prints the resource as a singleLineComponent and returns the number of lines written and the error if any.
 func (c *regularResourceComponent) Render(out io.Writer) (numLines int, err error) {
 	c.mu.Lock()
@@ -296,6 +333,13 @@ func (c *stackSetComponent) Listen() {
 			reason: ev.Operation.Reason,
 		})
 		updateComponentTimer(&c.mu, c.statuses, c.stopWatch)
+		Emit(Event{
+			Type:        EventTypeResource,
+			Resource:    ev.Name,
+			Description: c.title,
+			Status:      string(ev.Operation.Status),
+			Reason:      ev.Operation.Reason,
+		})
 	}
 	close(c.done)
 }
@@ -458,12 +502,20 @@ func cfnLineItemComponents(description string, separator rune, statuses []cfnSta
 	}
 
 	for _, failureReason := range failureReasons(statuses) {
-		for _, text := range splitByLength(failureReason, maxCellLength) {
+		msg, logGroup, logStream := customResourceLogs(failureReason)
+		for _, text := range splitByLength(msg, maxCellLength) {
 			components = append(components, &singleLineComponent{
 				Text:    strings.Join([]string{colorFailureReason(text), "", ""}, string(separator)),
 				Padding: padding + nestedComponentPadding,
 			})
 		}
+		if logGroup != "" {
+			// Don't split the hint so that the command can be copied.
+			components = append(components, &singleLineComponent{
+				Text:    strings.Join([]string{fmtLogsHint(logGroup, logStream), "", ""}, string(separator)),
+				Padding: padding + nestedComponentPadding,
+			})
+		}
 	}
 	return components
 }
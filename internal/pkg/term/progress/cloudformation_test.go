@@ -343,6 +343,67 @@ func TestRegularResourceComponent_Listen(t *testing.T) {
 		require.True(t, hasStarted, "the stopwatch should have started when an event was received")
 		require.Equal(t, 2, fc.numCalls, "stop watch should retrieve the current time only twice, start should not be called twice")
 	})
+	t.Run("should add status only for hooks that fail the resource", func(t *testing.T) {
+		// GIVEN
+		ch := make(chan stream.StackEvent)
+		done := make(chan struct{})
+		comp := &regularResourceComponent{
+			logicalID: "Bucket",
+			statuses:  []cfnStatus{notStartedStackStatus},
+			stopWatch: &stopWatch{
+				clock: &fakeClock{
+					wantedValues: []time.Time{testDate, testDate.Add(10 * time.Second)},
+				},
+			},
+			stream: ch,
+			done:   done,
+		}
+
+		// WHEN
+		go comp.Listen()
+		go func() {
+			ch <- stream.StackEvent{
+				LogicalResourceID: "Bucket",
+				ResourceStatus:    "CREATE_IN_PROGRESS",
+			}
+			ch <- stream.StackEvent{
+				LogicalResourceID: "Bucket",
+				HookType:          "Acme::Guard::RequiredMetadata",
+				HookStatus:        "HOOK_IN_PROGRESS",
+				HookFailureMode:   "FAIL",
+			}
+			ch <- stream.StackEvent{
+				LogicalResourceID: "Bucket",
+				HookType:          "Acme::Guard::Encryption",
+				HookStatus:        "HOOK_COMPLETE_FAILED",
+				HookStatusReason:  "Bucket is not encrypted.",
+				HookFailureMode:   "WARN",
+			}
+			ch <- stream.StackEvent{
+				LogicalResourceID: "Bucket",
+				HookType:          "Acme::Guard::RequiredMetadata",
+				HookStatus:        "HOOK_COMPLETE_FAILED",
+				HookStatusReason:  "Rule [stack_has_cost_center] failed.",
+				HookFailureMode:   "FAIL",
+			}
+			close(ch) // Close to notify that no more events will be sent.
+		}()
+
+		// THEN
+		<-done // Wait for listen to exit.
+		require.Equal(t, []cfnStatus{
+			notStartedStackStatus,
+			{
+				value: cloudformation.StackStatus("CREATE_IN_PROGRESS"),
+			},
+			{
+				value:  hookFailedResult{},
+				reason: "hook Acme::Guard::RequiredMetadata failed: Rule [stack_has_cost_center] failed.",
+			},
+		}, comp.statuses)
+		elapsed, _ := comp.stopWatch.elapsed()
+		require.Equal(t, 10*time.Second, elapsed, "the stopwatch should stop when the hook fails")
+	})
 }
 
 func TestRegularResourceComponent_Render(t *testing.T) {
@@ -482,6 +543,37 @@ func TestRegularResourceComponent_Render(t *testing.T) {
 			"  Resource creation cancelled\t\t\n"+
 			"  Resource cannot be deleted\t\t\n", buf.String())
 	})
+	t.Run("renders the logs of a failed custom resource on their own line", func(t *testing.T) {
+		// GIVEN
+		comp := &regularResourceComponent{
+			description: "Request and validate an ACM certificate for your domain",
+			statuses: []cfnStatus{
+				notStartedStackStatus,
+				{
+					value:  cloudformation.StackStatus("CREATE_FAILED"),
+					reason: "Lambda took longer than 870 seconds to validate the certificate (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)",
+				},
+			},
+			stopWatch: &stopWatch{
+				startTime: testDate,
+				stopTime:  testDate,
+				started:   true,
+				stopped:   true,
+			},
+			separator: '\t',
+		}
+		buf := new(strings.Builder)
+
+		// WHEN
+		nl, err := comp.Render(buf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 3, nl, "expected 3 entries to be printed to the terminal")
+		require.Equal(t, "- Request and validate an ACM certificate for your domain\t[create failed]\t[0.0s]\n"+
+			"  Lambda took longer than 870 seconds to validate the certificate\t\t\n"+
+			"  View the logs with: `aws logs tail /aws/lambda/testLambda --log-stream-names '2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd' --since 1h`\t\t\n", buf.String())
+	})
 }
 
 func TestEcsServiceResourceComponent_Listen(t *testing.T) {
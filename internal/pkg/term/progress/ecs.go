@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
@@ -56,6 +57,7 @@ type rollingUpdateComponent struct {
 // Listen updates the deployment statuses and failure event messages as events are streamed.
 func (c *rollingUpdateComponent) Listen() {
 	for ev := range c.stream {
+		c.emit(ev)
 		c.mu.Lock()
 		c.deployments = ev.Deployments
 		c.failureMsgs = append(c.failureMsgs, ev.LatestFailureEvents...)
@@ -68,6 +70,37 @@ func (c *rollingUpdateComponent) Listen() {
 	close(c.done)
 }
 
+// emit emits an event for each deployment whose tasks changed, and for each new failure message of the service.
+func (c *rollingUpdateComponent) emit(ev stream.ECSService) {
+	if !JSONOutputEnabled() {
+		return
+	}
+	c.mu.Lock()
+	prev := make(map[string]stream.ECSDeployment)
+	for _, d := range c.deployments {
+		prev[d.TaskDefRevision] = d
+	}
+	c.mu.Unlock()
+	for _, d := range ev.Deployments {
+		if p, ok := prev[d.TaskDefRevision]; ok && p.RolloutState == d.RolloutState && p.RunningCount == d.RunningCount && p.FailedCount == d.FailedCount {
+			continue
+		}
+		Emit(Event{
+			Type:      EventTypeDeployment,
+			Timestamp: d.UpdatedAt,
+			Status:    d.RolloutState,
+			Message:   fmt.Sprintf("%s deployment of revision %s: %d/%d running, %d pending, %d failed", strings.ToLower(d.Status), d.TaskDefRevision, d.RunningCount, d.DesiredCount, d.PendingCount, d.FailedCount),
+		})
+	}
+	for _, msg := range ev.LatestFailureEvents {
+		Emit(Event{
+			Type:   EventTypeDeployment,
+			Status: StatusFailed,
+			Reason: msg,
+		})
+	}
+}
+
 // Render prints first the deployments as a tableComponent and then the failure messages as singleLineComponents.
 func (c *rollingUpdateComponent) Render(out io.Writer) (numLines int, err error) {
 	c.mu.Lock()
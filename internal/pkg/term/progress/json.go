@@ -0,0 +1,164 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+// Types of events written when the JSON output is enabled.
+const (
+	EventTypePhase      = "phase"      // A step of a command, displayed with a spinner in the terminal.
+	EventTypeResource   = "resource"   // A change of the status of a CloudFormation resource.
+	EventTypeDeployment = "deployment" // A change of the ECS deployment of a service.
+	EventTypeAlarm      = "alarm"      // A change of the state of a CloudWatch alarm.
+	EventTypeLog        = "log"        // A line of diagnostic output.
+	EventTypeResult     = "result"     // The outcome of the command, always the last event.
+)
+
+// Statuses of the phase and result events.
+const (
+	StatusStarted   = "started"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// Event is a progress event written as a line of JSON when the JSON output is enabled.
+type Event struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+
+	Phase       string `json:"phase,omitempty"`
+	Resource    string `json:"resource,omitempty"`    // Logical ID of the resource in its stack.
+	Description string `json:"description,omitempty"` // Human friendly description of the resource.
+	Label       string `json:"label,omitempty"`       // Source of a log line, such as the image being built.
+	Level       string `json:"level,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+	LogGroup    string `json:"logGroup,omitempty"` // CloudWatch logs of the custom resource that reported the reason.
+	LogStream   string `json:"logStream,omitempty"`
+	Message     string `json:"message,omitempty"`
+
+	// Fields of the result event.
+	Command string          `json:"command,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Output  json.RawMessage `json:"output,omitempty"`
+	Elapsed string          `json:"elapsed,omitempty"`
+}
+
+var (
+	jsonMu  sync.Mutex
+	jsonOut io.Writer // Set when the JSON output is enabled.
+	now     = time.Now
+
+	ansiEscapePattern = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+)
+
+// EnableJSONOutput replaces the spinners and the renderers of the package with newline-delimited JSON events written to w.
+func EnableJSONOutput(w io.Writer) {
+	jsonMu.Lock()
+	defer jsonMu.Unlock()
+	jsonOut = w
+}
+
+// JSONOutputEnabled returns true if the progress is written as JSON events instead of being rendered.
+func JSONOutputEnabled() bool {
+	jsonMu.Lock()
+	defer jsonMu.Unlock()
+	return jsonOut != nil
+}
+
+// Emit writes the event as a line of JSON if the JSON output is enabled, and does nothing otherwise.
+// The terminal escape sequences are removed from the text of the event.
+func Emit(e Event) {
+	jsonMu.Lock()
+	defer jsonMu.Unlock()
+	if jsonOut == nil {
+		return
+	}
+	if e.Timestamp.IsZero() {
+		e.Timestamp = now().UTC()
+	}
+	for _, s := range []*string{&e.Phase, &e.Description, &e.Label, &e.Reason, &e.Message, &e.Error} {
+		*s = strings.TrimSpace(stripANSI(*s))
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return // All the fields of an Event can be marshaled.
+	}
+	_, _ = jsonOut.Write(append(data, '\n'))
+}
+
+// JSONLogWriter is an io.Writer that emits each line written to it as a log event.
+// The level of the event is derived from the prefix of the messages of the log package.
+type JSONLogWriter struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+// Write emits a log event for each complete line in p, and holds on to the rest until its line is complete.
+func (w *JSONLogWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.buf.Write(p)
+	for {
+		line, err := w.buf.ReadString('\n')
+		if err != nil {
+			w.buf.Reset()
+			w.buf.WriteString(line)
+			break
+		}
+		emitLogLine(line)
+	}
+	return len(p), nil
+}
+
+// Flush emits the last line written to w even if it doesn't end with a new line.
+func (w *JSONLogWriter) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	emitLogLine(w.buf.String())
+	w.buf.Reset()
+}
+
+func emitLogLine(line string) {
+	msg := strings.TrimSpace(stripANSI(line))
+	if msg == "" {
+		return
+	}
+	Emit(Event{
+		Type:    EventTypeLog,
+		Level:   logLevel(msg),
+		Message: msg,
+	})
+}
+
+func logLevel(msg string) string {
+	switch {
+	case hasLogPrefix(msg, log.Serror("")):
+		return "error"
+	case strings.HasPrefix(msg, "Note:"): // Prefix of the messages written with log.Warning.
+		return "warning"
+	case hasLogPrefix(msg, log.Ssuccess("")):
+		return "success"
+	default:
+		return "info"
+	}
+}
+
+func hasLogPrefix(msg, emptyLog string) bool {
+	return strings.HasPrefix(msg, strings.TrimSpace(stripANSI(emptyLog)))
+}
+
+func stripANSI(s string) string {
+	return ansiEscapePattern.ReplaceAllString(s, "")
+}
@@ -0,0 +1,167 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// withJSONOutput enables the JSON output for the duration of the test and returns the buffer that receives the events.
+func withJSONOutput(t *testing.T) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	EnableJSONOutput(buf)
+	prevNow := now
+	now = func() time.Time { return testDate }
+	t.Cleanup(func() {
+		EnableJSONOutput(nil)
+		now = prevNow
+	})
+	return buf
+}
+
+func emittedEvents(t *testing.T, buf *bytes.Buffer) []Event {
+	var events []Event
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var e Event
+		require.NoError(t, json.Unmarshal([]byte(line), &e))
+		events = append(events, e)
+	}
+	return events
+}
+
+func TestEmit(t *testing.T) {
+	t.Run("does nothing if the JSON output is disabled", func(t *testing.T) {
+		require.False(t, JSONOutputEnabled())
+		Emit(Event{Type: EventTypeLog, Message: "hello"})
+	})
+	t.Run("writes a line of JSON without terminal escape sequences", func(t *testing.T) {
+		buf := withJSONOutput(t)
+
+		Emit(Event{Type: EventTypePhase, Phase: "\x1b[92m✔\x1b[0m Proposing infrastructure changes ", Status: StatusSucceeded})
+
+		require.Equal(t, `{"type":"phase","timestamp":"2021-01-06T00:00:00Z","phase":"✔ Proposing infrastructure changes","status":"succeeded"}`+"\n", buf.String())
+	})
+}
+
+func TestJSONLogWriter(t *testing.T) {
+	buf := withJSONOutput(t)
+	w := &JSONLogWriter{}
+
+	fmt.Fprint(w, log.Ssuccessln("Deployed service api."))
+	fmt.Fprint(w, "Note: the image ")
+	fmt.Fprint(w, "is large.\n\n")
+	fmt.Fprint(w, log.Serrorln("Failed to deploy."))
+	fmt.Fprint(w, "Recommended follow-up action")
+	w.Flush()
+
+	require.Equal(t, []Event{
+		{Type: EventTypeLog, Timestamp: testDate, Level: "success", Message: "✔ Deployed service api."},
+		{Type: EventTypeLog, Timestamp: testDate, Level: "warning", Message: "Note: the image is large."},
+		{Type: EventTypeLog, Timestamp: testDate, Level: "error", Message: "✘ Failed to deploy."},
+		{Type: EventTypeLog, Timestamp: testDate, Level: "info", Message: "Recommended follow-up action"},
+	}, emittedEvents(t, buf))
+}
+
+func TestSpinner_JSONOutput(t *testing.T) {
+	buf := withJSONOutput(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := &Spinner{spin: mocks.NewMockstartStopper(ctrl)} // The terminal spinner should never start.
+
+	s.Start("Uploading resources")
+	s.Stop(log.Ssuccess("Uploaded resources"))
+	s.Start("Pushing image")
+	s.Stop(log.Serror("Failed to push image"))
+
+	require.Equal(t, []Event{
+		{Type: EventTypePhase, Timestamp: testDate, Phase: "Uploading resources", Status: StatusStarted},
+		{Type: EventTypePhase, Timestamp: testDate, Phase: "✔ Uploaded resources", Status: StatusSucceeded},
+		{Type: EventTypePhase, Timestamp: testDate, Phase: "Pushing image", Status: StatusStarted},
+		{Type: EventTypePhase, Timestamp: testDate, Phase: "✘ Failed to push image", Status: StatusFailed},
+	}, emittedEvents(t, buf))
+}
+
+func TestRender_JSONOutput(t *testing.T) {
+	buf := withJSONOutput(t)
+	ch := make(chan stream.StackEvent)
+	comp := &regularResourceComponent{
+		logicalID:   "EnvironmentManagerRole",
+		description: "An IAM Role to manage the environment",
+		statuses:    []cfnStatus{notStartedStackStatus},
+		stopWatch:   newStopWatch(),
+		stream:      ch,
+		done:        make(chan struct{}),
+	}
+	go comp.Listen()
+	go func() {
+		ch <- stream.StackEvent{
+			LogicalResourceID: "EnvironmentManagerRole",
+			ResourceStatus:    "CREATE_IN_PROGRESS",
+			Timestamp:         testDate,
+		}
+		ch <- stream.StackEvent{
+			LogicalResourceID:    "EnvironmentManagerRole",
+			ResourceStatus:       "CREATE_FAILED",
+			ResourceStatusReason: "This IAM role already exists.",
+			Timestamp:            testDate.Add(time.Second),
+		}
+		close(ch)
+	}()
+	out := &mockFileWriteFlusher{}
+
+	// WHEN
+	nl, err := Render(context.Background(), out, comp)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, 0, nl)
+	require.Empty(t, out.buf.String(), "nothing should be rendered to the terminal")
+	require.Equal(t, []Event{
+		{Type: EventTypeResource, Timestamp: testDate, Resource: "EnvironmentManagerRole", Description: "An IAM Role to manage the environment", Status: "CREATE_IN_PROGRESS"},
+		{Type: EventTypeResource, Timestamp: testDate.Add(time.Second), Resource: "EnvironmentManagerRole", Description: "An IAM Role to manage the environment", Status: "CREATE_FAILED", Reason: "This IAM role already exists."},
+	}, emittedEvents(t, buf))
+}
+
+func TestRegularResourceComponent_JSONOutputWithLogs(t *testing.T) {
+	buf := withJSONOutput(t)
+	comp := &regularResourceComponent{
+		logicalID: "DelegateDNSAction",
+		statuses:  []cfnStatus{notStartedStackStatus},
+		stopWatch: newStopWatch(),
+	}
+
+	comp.update(stream.StackEvent{
+		LogicalResourceID:    "DelegateDNSAction",
+		ResourceStatus:       "CREATE_FAILED",
+		ResourceStatusReason: "Lambda took longer than 570 seconds to delegate the subdomain (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)",
+		Timestamp:            testDate,
+	})
+
+	require.Equal(t, []Event{
+		{
+			Type:      EventTypeResource,
+			Timestamp: testDate,
+			Resource:  "DelegateDNSAction",
+			Status:    "CREATE_FAILED",
+			Reason:    "Lambda took longer than 570 seconds to delegate the subdomain (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)",
+			LogGroup:  "/aws/lambda/testLambda",
+			LogStream: "2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd",
+		},
+	}, emittedEvents(t, buf))
+}
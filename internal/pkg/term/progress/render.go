@@ -39,7 +39,16 @@ func NestedRenderOptions(opts RenderOptions) RenderOptions {
 // Render renders r periodically to out and returns the last number of lines written to out.
 // Render stops when there the ctx is canceled or r is done listening to new events.
 // While Render is executing, the terminal cursor is hidden and updates are written in-place.
+// If the JSON output is enabled, nothing is written to out: the components emit their own events.
 func Render(ctx context.Context, out FileWriteFlusher, r DynamicRenderer) (int, error) {
+	if JSONOutputEnabled() {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-r.Done():
+			return 0, nil
+		}
+	}
 	defer out.Flush() // Make sure every buffered text in out is written before exiting.
 
 	cursor := cursor.NewWithWriter(out)
@@ -64,7 +73,11 @@ func Render(ctx context.Context, out FileWriteFlusher, r DynamicRenderer) (int,
 }
 
 // EraseAndRender erases prevNumLines from out and then renders r.
+// If the JSON output is enabled, nothing is written to out.
 func EraseAndRender(out FileWriteFlusher, r Renderer, prevNumLines int) (int, error) {
+	if JSONOutputEnabled() {
+		return 0, nil
+	}
 	cursor.EraseLinesAbove(out, prevNumLines)
 	if err := out.Flush(); err != nil {
 		return 0, err
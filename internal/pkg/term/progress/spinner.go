@@ -9,6 +9,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/briandowns/spinner"
 )
 
@@ -50,13 +51,27 @@ func NewSpinner(w io.Writer) *Spinner {
 }
 
 // Start starts the spinner suffixed with a label.
+// If the JSON output is enabled, it emits a phase event instead.
 func (s *Spinner) Start(label string) {
+	if JSONOutputEnabled() {
+		Emit(Event{Type: EventTypePhase, Phase: label, Status: StatusStarted})
+		return
+	}
 	s.suffix(fmt.Sprintf(" %s", label))
 	s.spin.Start()
 }
 
 // Stop stops the spinner and replaces it with a label.
+// If the JSON output is enabled, it emits a phase event instead, which failed if the label is an error message.
 func (s *Spinner) Stop(label string) {
+	if JSONOutputEnabled() {
+		status := StatusSucceeded
+		if hasLogPrefix(stripANSI(label), log.Serror("")) {
+			status = StatusFailed
+		}
+		Emit(Event{Type: EventTypePhase, Phase: label, Status: status})
+		return
+	}
 	s.finalMSG(fmt.Sprint(label))
 	s.spin.Stop()
 }
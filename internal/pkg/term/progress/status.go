@@ -5,6 +5,7 @@ package progress
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -16,6 +17,9 @@ var (
 	}
 	alarmOKState = "OK"
 	inAlarmState = "ALARM"
+
+	// customResourceLogPattern matches the CloudWatch logs location that custom resources append to their failure reasons.
+	customResourceLogPattern = regexp.MustCompile(`\s*\(Log: (/aws/lambda/[^/\s]+)/([^)]+)\)$`)
 )
 
 type result interface {
@@ -48,6 +52,29 @@ func (r notStartedResult) String() string {
 	return "not started"
 }
 
+// hookFailedResult represents a CloudFormation Hook that failed the operation on a resource.
+type hookFailedResult struct{}
+
+// IsSuccess is false for a failed hook.
+func (r hookFailedResult) IsSuccess() bool {
+	return false
+}
+
+// IsFailure is true for a failed hook.
+func (r hookFailedResult) IsFailure() bool {
+	return true
+}
+
+// InProgress is false for a failed hook.
+func (r hookFailedResult) InProgress() bool {
+	return false
+}
+
+// String implements the fmt.Stringer interface.
+func (r hookFailedResult) String() string {
+	return "hook failed"
+}
+
 type cfnStatus struct {
 	value  result
 	reason string
@@ -101,6 +128,21 @@ func failureReasons(statuses []cfnStatus) []string {
 	return reasons
 }
 
+// customResourceLogs splits the failure reason of a custom resource into the message and the log group and stream
+// of the Lambda function that reported it. If the reason doesn't reference any logs, the group and stream are empty.
+func customResourceLogs(reason string) (msg, group, stream string) {
+	matches := customResourceLogPattern.FindStringSubmatch(reason)
+	if matches == nil {
+		return reason, "", ""
+	}
+	return strings.TrimSuffix(reason, matches[0]), matches[1], matches[2]
+}
+
+// fmtLogsHint returns the command to view the logs of the Lambda function that backs a custom resource.
+func fmtLogsHint(group, stream string) string {
+	return fmt.Sprintf("View the logs with: %s", color.HighlightCode(fmt.Sprintf("aws logs tail %s --log-stream-names '%s' --since 1h", group, stream)))
+}
+
 func splitByLength(s string, maxLength int) []string {
 	numItems := len(s)/maxLength + 1
 	var ss []string
@@ -0,0 +1,201 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package prompt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"gopkg.in/yaml.v3"
+)
+
+// Answers holds the answers to the prompts of a command, keyed by the message of each prompt.
+// An answer is a string, a bool, or a list of strings for multi-select prompts.
+type Answers map[string]interface{}
+
+// History holds the answers to the prompts of each command, keyed by the name of the command.
+type History map[string]Answers
+
+// ParseHistory unmarshals the YAML document of a History.
+func ParseHistory(data []byte) (History, error) {
+	history := make(History)
+	if err := yaml.Unmarshal(data, &history); err != nil {
+		return nil, fmt.Errorf("unmarshal prompt history: %w", err)
+	}
+	return history, nil
+}
+
+// MarshalBinary serializes the history into a YAML document.
+func (h History) MarshalBinary() ([]byte, error) {
+	return yaml.Marshal(h)
+}
+
+// WithHistory returns a Prompt that records the answer to each of its prompts in answers, and calls save after each new answer.
+// If replay is true, the prompts that already have an answer aren't asked again and their previous answer is used instead,
+// unless the answer isn't one of the options of the prompt anymore or is rejected by the validators of the prompt.
+// The answers to secret prompts are never recorded.
+func (p Prompt) WithHistory(answers Answers, replay bool, save func(Answers) error) Prompt {
+	asked := make(map[string]int) // Number of times each prompt was asked, to tell apart the answers to a prompt asked in a loop.
+	return func(sp survey.Prompt, out interface{}, opts ...survey.AskOpt) error {
+		key, ok := historyKey(sp)
+		if !ok {
+			return p(sp, out, opts...)
+		}
+		asked[key]++
+		if n := asked[key]; n > 1 {
+			key = fmt.Sprintf("%s (%d)", key, n)
+		}
+		if prev, ok := answers[key]; ok && replay {
+			if replayed := replayAnswer(sp, prev, out, opts); replayed != "" {
+				log.Infof("%s %s\n", historyMessage(sp), color.HighlightUserInput(replayed))
+				return nil
+			}
+		}
+		if err := p(sp, out, opts...); err != nil {
+			return err
+		}
+		switch out := out.(type) {
+		case *string:
+			answers[key] = *out
+		case *bool:
+			answers[key] = *out
+		case *[]string:
+			answers[key] = *out
+		default:
+			return nil
+		}
+		return save(answers)
+	}
+}
+
+// historyMessage returns the message displayed for a replayed answer.
+func historyMessage(sp survey.Prompt) string {
+	if p := sp.(*prompt); p.FinalMessage != "" {
+		return p.FinalMessage
+	}
+	msg, _ := historyKey(sp)
+	return msg
+}
+
+// historyKey returns the message of a prompt, which identifies its answer in the history.
+func historyKey(sp survey.Prompt) (string, bool) {
+	p, ok := sp.(*prompt)
+	if !ok {
+		return "", false
+	}
+	switch typedPrompt := p.prompter.(type) {
+	case *survey.Select:
+		return typedPrompt.Message, true
+	case *survey.MultiSelect:
+		return typedPrompt.Message, true
+	case *survey.Input:
+		return typedPrompt.Message, true
+	case *survey.Confirm:
+		return typedPrompt.Message, true
+	}
+	return "", false
+}
+
+// replayAnswer writes the previous answer to out if it's still a valid answer to the prompt.
+// It returns the answer as displayed to the user, or an empty string if the answer can't be replayed.
+func replayAnswer(sp survey.Prompt, prev interface{}, out interface{}, opts []survey.AskOpt) string {
+	var options survey.AskOptions
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return ""
+		}
+	}
+	valid := func(ans interface{}) bool {
+		for _, validate := range options.Validators {
+			if err := validate(ans); err != nil {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch out := out.(type) {
+	case *string:
+		ans, ok := prev.(string)
+		if !ok {
+			return ""
+		}
+		var validated interface{} = ans
+		if sel, ok := sp.(*prompt).prompter.(*survey.Select); ok {
+			idx := indexOf(sel.Options, ans)
+			if idx == -1 {
+				return ""
+			}
+			validated = core.OptionAnswer{Value: ans, Index: idx}
+		}
+		if !valid(validated) {
+			return ""
+		}
+		*out = ans
+		return parseValueFromOptionFmt(ans)
+	case *bool:
+		ans, ok := prev.(bool)
+		if !ok || !valid(ans) {
+			return ""
+		}
+		*out = ans
+		if ans {
+			return "Yes"
+		}
+		return "No"
+	case *[]string:
+		ans, ok := stringSlice(prev)
+		if !ok {
+			return ""
+		}
+		var validated []core.OptionAnswer
+		if sel, ok := sp.(*prompt).prompter.(*survey.MultiSelect); ok {
+			for _, a := range ans {
+				idx := indexOf(sel.Options, a)
+				if idx == -1 {
+					return ""
+				}
+				validated = append(validated, core.OptionAnswer{Value: a, Index: idx})
+			}
+		}
+		if len(ans) == 0 || !valid(validated) {
+			return ""
+		}
+		*out = ans
+		return parseValuesFromOptions(strings.Join(ans, ", "))
+	}
+	return ""
+}
+
+// stringSlice converts a list of strings unmarshaled from YAML into a []string.
+func stringSlice(v interface{}) ([]string, bool) {
+	switch v := v.(type) {
+	case []string:
+		return v, true
+	case []interface{}:
+		out := make([]string, len(v))
+		for i, elem := range v {
+			s, ok := elem.(string)
+			if !ok {
+				return nil, false
+			}
+			out[i] = s
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+func indexOf(options []string, value string) int {
+	for i, option := range options {
+		if option == value {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package prompt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory_MarshalBinary(t *testing.T) {
+	history := History{
+		"storage init": Answers{
+			"What type of storage would you like to create?": "DynamoDB",
+			"Would you like to add a sort key to this table?": true,
+			"Which workloads should access the storage?":      []string{"api", "worker"},
+		},
+	}
+
+	data, err := history.MarshalBinary()
+	require.NoError(t, err)
+	got, err := ParseHistory(data)
+	require.NoError(t, err)
+
+	answers := got["storage init"]
+	require.Equal(t, "DynamoDB", answers["What type of storage would you like to create?"])
+	require.Equal(t, true, answers["Would you like to add a sort key to this table?"])
+	wkld, ok := stringSlice(answers["Which workloads should access the storage?"])
+	require.True(t, ok)
+	require.Equal(t, []string{"api", "worker"}, wkld)
+}
+
+func TestPrompt_WithHistory(t *testing.T) {
+	testCases := map[string]struct {
+		inAnswers Answers
+		inReplay  bool
+		ask       func(p Prompt) (interface{}, error)
+
+		wantedAsked   bool
+		wantedResult  interface{}
+		wantedAnswers Answers
+		wantedSaved   bool
+	}{
+		"records the answer to an input prompt": {
+			inAnswers: Answers{},
+			ask: func(p Prompt) (interface{}, error) {
+				return p.Get("Name?", "", nil)
+			},
+			wantedAsked:   true,
+			wantedResult:  "answer",
+			wantedAnswers: Answers{"Name?": "answer"},
+			wantedSaved:   true,
+		},
+		"asks again without replay": {
+			inAnswers: Answers{"Name?": "previous"},
+			ask: func(p Prompt) (interface{}, error) {
+				return p.Get("Name?", "", nil)
+			},
+			wantedAsked:   true,
+			wantedResult:  "answer",
+			wantedAnswers: Answers{"Name?": "answer"},
+			wantedSaved:   true,
+		},
+		"replays the previous answer to an input prompt": {
+			inAnswers: Answers{"Name?": "previous"},
+			inReplay:  true,
+			ask: func(p Prompt) (interface{}, error) {
+				return p.Get("Name?", "", nil)
+			},
+			wantedResult:  "previous",
+			wantedAnswers: Answers{"Name?": "previous"},
+		},
+		"asks again if the previous answer is rejected by the validator": {
+			inAnswers: Answers{"Name?": "previous"},
+			inReplay:  true,
+			ask: func(p Prompt) (interface{}, error) {
+				return p.Get("Name?", "", func(interface{}) error { return errors.New("taken") })
+			},
+			wantedAsked:   true,
+			wantedResult:  "answer",
+			wantedAnswers: Answers{"Name?": "answer"},
+			wantedSaved:   true,
+		},
+		"replays the previous selection": {
+			inAnswers: Answers{"Type?": "b"},
+			inReplay:  true,
+			ask: func(p Prompt) (interface{}, error) {
+				return p.SelectOne("Type?", "", []string{"a", "b"})
+			},
+			wantedResult:  "b",
+			wantedAnswers: Answers{"Type?": "b"},
+		},
+		"asks again if the previous selection isn't an option anymore": {
+			inAnswers: Answers{"Type?": "c"},
+			inReplay:  true,
+			ask: func(p Prompt) (interface{}, error) {
+				return p.SelectOne("Type?", "", []string{"a", "b"})
+			},
+			wantedAsked:   true,
+			wantedResult:  "a",
+			wantedAnswers: Answers{"Type?": "a"},
+			wantedSaved:   true,
+		},
+		"replays the previous selections of a multi-select prompt": {
+			inAnswers: Answers{"Workloads?": []interface{}{"a", "c"}},
+			inReplay:  true,
+			ask: func(p Prompt) (interface{}, error) {
+				return p.MultiSelect("Workloads?", "", []string{"a", "b", "c"}, RequireMinItems(1))
+			},
+			wantedResult:  []string{"a", "c"},
+			wantedAnswers: Answers{"Workloads?": []interface{}{"a", "c"}},
+		},
+		"replays the previous confirmation": {
+			inAnswers: Answers{"Sure?": false},
+			inReplay:  true,
+			ask: func(p Prompt) (interface{}, error) {
+				return p.Confirm("Sure?", "")
+			},
+			wantedResult:  false,
+			wantedAnswers: Answers{"Sure?": false},
+		},
+		"tells apart the answers to a prompt asked in a loop": {
+			inAnswers: Answers{"Env?": "test", "Env? (2)": "prod"},
+			inReplay:  true,
+			ask: func(p Prompt) (interface{}, error) {
+				var envs []string
+				for i := 0; i < 2; i++ {
+					env, err := p.SelectOne("Env?", "", []string{"test", "prod"})
+					if err != nil {
+						return nil, err
+					}
+					envs = append(envs, env)
+				}
+				return envs, nil
+			},
+			wantedResult:  []string{"test", "prod"},
+			wantedAnswers: Answers{"Env?": "test", "Env? (2)": "prod"},
+		},
+		"never records secrets": {
+			inAnswers: Answers{},
+			inReplay:  true,
+			ask: func(p Prompt) (interface{}, error) {
+				return p.GetSecret("Token?", "")
+			},
+			wantedAsked:   true,
+			wantedResult:  "answer",
+			wantedAnswers: Answers{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var asked, saved bool
+			var p Prompt = func(sp survey.Prompt, out interface{}, _ ...survey.AskOpt) error {
+				asked = true
+				switch out := out.(type) {
+				case *string:
+					*out = "answer"
+					if sel, ok := sp.(*prompt).prompter.(*survey.Select); ok {
+						*out = sel.Options[0]
+					}
+				case *bool:
+					*out = true
+				case *[]string:
+					*out = []string{"answer"}
+				}
+				return nil
+			}
+
+			got, err := tc.ask(p.WithHistory(tc.inAnswers, tc.inReplay, func(Answers) error {
+				saved = true
+				return nil
+			}))
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAsked, asked)
+			require.Equal(t, tc.wantedResult, got)
+			require.Equal(t, tc.wantedAnswers, tc.inAnswers)
+			require.Equal(t, tc.wantedSaved, saved)
+		})
+	}
+}
@@ -118,7 +118,7 @@ func (p Prompt) SelectOne(message, help string, options []string, promptCfgs ...
 	}
 
 	var result string
-	err := p(prompt, &result, stdio(), icons())
+	err := p(prompt, &result, stdio(), icons(), fuzzyFilter())
 	return result, err
 }
 
@@ -147,13 +147,47 @@ func (p Prompt) MultiSelect(message, help string, options []string, validator Va
 	var result []string
 	var err error
 	if validator == nil {
-		err = p(prompt, &result, stdio(), icons())
+		err = p(prompt, &result, stdio(), icons(), fuzzyFilter())
 	} else {
-		err = p(prompt, &result, stdio(), validators(validator), icons())
+		err = p(prompt, &result, stdio(), validators(validator), icons(), fuzzyFilter())
 	}
 	return result, err
 }
 
+// fuzzyFilter filters the options of a select prompt as the user types, so that long lists can be narrowed down quickly.
+func fuzzyFilter() survey.AskOpt {
+	return survey.WithFilter(func(filter, value string, _ int) bool {
+		return fuzzyMatch(filter, value)
+	})
+}
+
+// fuzzyMatch returns true if each word of the filter appears in the value with its characters in order,
+// but not necessarily next to each other. For example, "frntapi" and "front api" both match "frontend-api".
+// The match is case-insensitive and ignores the colors of the value.
+func fuzzyMatch(filter, value string) bool {
+	value = strings.ToLower(regexpSGR.ReplaceAllString(value, ""))
+	for _, word := range strings.Fields(strings.ToLower(filter)) {
+		if !isSubsequence(word, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// isSubsequence returns true if the characters of sub appear in s in the same order.
+func isSubsequence(sub, s string) bool {
+	rest := []rune(sub)
+	for _, r := range s {
+		if len(rest) == 0 {
+			break
+		}
+		if r == rest[0] {
+			rest = rest[1:]
+		}
+	}
+	return len(rest) == 0
+}
+
 type prettyOptions struct {
 	choices      []string
 	choice2Value map[string]string
@@ -255,7 +255,7 @@ func TestPrompt_SelectOne(t *testing.T) {
 
 				*result = sel.Options[0]
 
-				require.Equal(t, 2, len(opts))
+				require.Equal(t, 3, len(opts))
 
 				return nil
 			},
@@ -316,7 +316,7 @@ func TestPrompt_MultiSelect(t *testing.T) {
 
 				*result = sel.Options
 
-				require.Equal(t, 2, len(opts))
+				require.Equal(t, 3, len(opts))
 
 				return nil
 			},
@@ -346,3 +346,53 @@ func TestPrompt_MultiSelect(t *testing.T) {
 		})
 	}
 }
+
+func TestFuzzyMatch(t *testing.T) {
+	testCases := map[string]struct {
+		inFilter string
+		inValue  string
+
+		wanted bool
+	}{
+		"empty filter matches everything": {
+			inFilter: "",
+			inValue:  "frontend",
+			wanted:   true,
+		},
+		"matches characters that are not next to each other": {
+			inFilter: "frntapi",
+			inValue:  "frontend-api",
+			wanted:   true,
+		},
+		"matches each word of the filter": {
+			inFilter: "api front",
+			inValue:  "frontend-api",
+			wanted:   true,
+		},
+		"does not match characters out of order": {
+			inFilter: "ipa",
+			inValue:  "frontend-api",
+			wanted:   false,
+		},
+		"does not match if one of the words doesn't match": {
+			inFilter: "front worker",
+			inValue:  "frontend-api",
+			wanted:   false,
+		},
+		"ignores case": {
+			inFilter: "LBWS",
+			inValue:  "Load Balanced Web Service",
+			wanted:   true,
+		},
+		"ignores colors": {
+			inFilter: "api(u",
+			inValue:  "api\t\x1b[2m(uninitialized)\x1b[0m",
+			wanted:   true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, fuzzyMatch(tc.inFilter, tc.inValue))
+		})
+	}
+}
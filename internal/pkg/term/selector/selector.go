@@ -78,6 +78,7 @@ const (
 	deployedWkldFinalMsg = "Workload:"
 	taskFinalMsg         = "Task:"
 	workloadFinalMsg     = "Name:"
+	workloadsFinalMsg    = "Names:"
 	dockerfileFinalMsg   = "Dockerfile:"
 	topicFinalMsg        = "Topic subscriptions:"
 	pipelineFinalMsg     = "Pipeline:"
@@ -868,33 +869,57 @@ var OnlyInitializedWorkloads WorkloadSelectOption = func(s *LocalWorkloadSelecto
 // or list all workloads for which there are manifests in the workspace.
 // The latter behavior can be specified by passing the LocalWorkloads
 func (s *LocalWorkloadSelector) Workload(msg, help string) (wl string, err error) {
-	summary, err := s.ws.Summary()
+	options, err := s.workloadOptions()
 	if err != nil {
-		return "", fmt.Errorf("read workspace summary: %w", err)
+		return "", err
 	}
-	wsWlNames, err := s.retrieveWorkspaceWorkloads()
-	if err != nil {
-		return "", fmt.Errorf("retrieve jobs and services from workspace: %w", err)
+
+	if len(options) == 1 {
+		log.Infof("Found only one workload, defaulting to: %s\n", color.HighlightUserInput(options[0].Value))
+		return options[0].Value, nil
 	}
-	storeWls, err := s.ConfigSelector.workloadLister.ListWorkloads(summary.Application)
+	selectedWlName, err := s.prompt.SelectOption(msg, help, options, prompt.WithFinalMessage(workloadFinalMsg))
 	if err != nil {
-		return "", fmt.Errorf("retrieve jobs and services from store: %w", err)
+		return "", fmt.Errorf("select workload: %w", err)
 	}
+	return selectedWlName, nil
+}
 
-	options, err := s.getWorkloadSelectOptions(storeWls, wsWlNames, anyWorkloadType)
+// Workloads fetches all jobs and services in a workspace and prompts the user to select one or more of them.
+func (s *LocalWorkloadSelector) Workloads(msg, help string) ([]string, error) {
+	options, err := s.workloadOptions()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if len(options) == 1 {
 		log.Infof("Found only one workload, defaulting to: %s\n", color.HighlightUserInput(options[0].Value))
-		return options[0].Value, nil
+		return []string{options[0].Value}, nil
 	}
-	selectedWlName, err := s.prompt.SelectOption(msg, help, options, prompt.WithFinalMessage(workloadFinalMsg))
+	selectedWlNames, err := s.prompt.MultiSelectOptions(msg, help, options, prompt.WithFinalMessage(workloadsFinalMsg))
 	if err != nil {
-		return "", fmt.Errorf("select workload: %w", err)
+		return nil, fmt.Errorf("select workloads: %w", err)
 	}
-	return selectedWlName, nil
+	if len(selectedWlNames) == 0 {
+		return nil, errors.New("no workloads selected")
+	}
+	return selectedWlNames, nil
+}
+
+func (s *LocalWorkloadSelector) workloadOptions() ([]prompt.Option, error) {
+	summary, err := s.ws.Summary()
+	if err != nil {
+		return nil, fmt.Errorf("read workspace summary: %w", err)
+	}
+	wsWlNames, err := s.retrieveWorkspaceWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve jobs and services from workspace: %w", err)
+	}
+	storeWls, err := s.ConfigSelector.workloadLister.ListWorkloads(summary.Application)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve jobs and services from store: %w", err)
+	}
+	return s.getWorkloadSelectOptions(storeWls, wsWlNames, anyWorkloadType)
 }
 
 func filterStrings(allStrings []string, wantedStrings []string) []string {
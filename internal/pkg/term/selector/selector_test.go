@@ -1832,6 +1832,104 @@ func TestWorkspaceSelect_Workload(t *testing.T) {
 	}
 }
 
+func TestWorkspaceSelect_Workloads(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(mocks workspaceSelectMocks)
+
+		wantErr error
+		want    []string
+	}{
+		"with one workspace workload": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "app-name"}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"service1"}, nil)
+				m.configLister.EXPECT().ListWorkloads("app-name").Return([]*config.Workload{{Name: "service1"}}, nil)
+				m.prompt.EXPECT().MultiSelectOptions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			want: []string{"service1"},
+		},
+		"select multiple workloads": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "app-name"}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"service1", "job2", "worker"}, nil)
+				m.configLister.EXPECT().ListWorkloads("app-name").Return([]*config.Workload{
+					{Name: "service1"},
+					{Name: "job2"},
+				}, nil)
+				m.prompt.EXPECT().MultiSelectOptions("Select workloads", "Help text", []prompt.Option{
+					{Value: "service1"},
+					{Value: "job2"},
+					{
+						Value: "worker",
+						Hint:  "uninitialized",
+					},
+				}, gomock.Any()).Return([]string{"service1", "worker"}, nil)
+			},
+			want: []string{"service1", "worker"},
+		},
+		"fails retrieving store workloads": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "app-name"}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"wkld"}, nil)
+				m.configLister.EXPECT().ListWorkloads("app-name").Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("retrieve jobs and services from store: some error"),
+		},
+		"fails selecting workloads": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "app-name"}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"service1", "job2"}, nil)
+				m.configLister.EXPECT().ListWorkloads("app-name").Return(nil, nil)
+				m.prompt.EXPECT().MultiSelectOptions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("select workloads: some error"),
+		},
+		"error if no workloads are selected": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "app-name"}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"service1", "job2"}, nil)
+				m.configLister.EXPECT().ListWorkloads("app-name").Return(nil, nil)
+				m.prompt.EXPECT().MultiSelectOptions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantErr: errors.New("no workloads selected"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockwsRetriever := mocks.NewMockworkspaceRetriever(ctrl)
+			MockconfigLister := mocks.NewMockconfigLister(ctrl)
+			mockprompt := mocks.NewMockPrompter(ctrl)
+			mocks := workspaceSelectMocks{
+				ws:           mockwsRetriever,
+				configLister: MockconfigLister,
+				prompt:       mockprompt,
+			}
+			tc.setupMocks(mocks)
+
+			sel := LocalWorkloadSelector{
+				ConfigSelector: &ConfigSelector{
+					AppEnvSelector: &AppEnvSelector{
+						prompt:       mockprompt,
+						appEnvLister: MockconfigLister,
+					},
+					workloadLister: MockconfigLister,
+				},
+				ws: mockwsRetriever,
+			}
+			got, err := sel.Workloads("Select workloads", "Help text")
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestSelectOption(t *testing.T) {
 	testCases := map[string]struct {
 		optionToTest   WorkloadSelectOption
@@ -7,9 +7,11 @@ package syncbuffer
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
 	"strings"
 	"sync"
+	"time"
 )
 
 // SyncBuffer is a synchronized buffer that can be used to store output data and coordinate between multiple goroutines.
@@ -17,6 +19,10 @@ type SyncBuffer struct {
 	bufMu sync.Mutex    // bufMu is a mutex protects buf.
 	buf   bytes.Buffer  // buf is the buffer that stores the data.
 	done  chan struct{} // is closed after MarkDone() is called.
+
+	timeMu    sync.Mutex // timeMu protects startedAt and doneAt.
+	startedAt time.Time  // is set by Start(), if the time taken until MarkDone() is reported.
+	doneAt    time.Time
 }
 
 // New creates and returns a new SyncBuffer object with an initialized 'done' channel.
@@ -45,9 +51,34 @@ func (b *SyncBuffer) IsDone() bool {
 
 // MarkDone closes the Done channel.
 func (b *SyncBuffer) MarkDone() {
+	b.timeMu.Lock()
+	b.doneAt = time.Now()
+	b.timeMu.Unlock()
 	close(b.done)
 }
 
+// Start records the start of the work whose output is written to the buffer,
+// so that the label of the buffer reports the time taken until the buffer is done.
+func (b *SyncBuffer) Start() {
+	b.timeMu.Lock()
+	defer b.timeMu.Unlock()
+	b.startedAt = time.Now()
+}
+
+// elapsed returns the time since Start() was called, up to when the buffer is done,
+// and false if Start() wasn't called.
+func (b *SyncBuffer) elapsed() (time.Duration, bool) {
+	b.timeMu.Lock()
+	defer b.timeMu.Unlock()
+	if b.startedAt.IsZero() {
+		return 0, false
+	}
+	if b.doneAt.IsZero() {
+		return time.Since(b.startedAt), true
+	}
+	return b.doneAt.Sub(b.startedAt), true
+}
+
 // LabeledSyncBuffer is a struct that combines a SyncBuffer with a string label.
 type LabeledSyncBuffer struct {
 	label string
@@ -62,6 +93,18 @@ func (buf *SyncBuffer) WithLabel(label string) *LabeledSyncBuffer {
 	}
 }
 
+// title returns the label followed by the time taken so far, if the buffer was started.
+func (buf *LabeledSyncBuffer) title() string {
+	elapsed, ok := buf.elapsed()
+	if !ok {
+		return buf.label
+	}
+	if buf.IsDone() {
+		return fmt.Sprintf("%s (done in %s)", buf.label, elapsed.Round(100*time.Millisecond))
+	}
+	return fmt.Sprintf("%s (%s)", buf.label, elapsed.Round(time.Second))
+}
+
 // Copy reads all the content of an io.Reader into a SyncBuffer and an error if copy is failed.
 func (buf *SyncBuffer) Copy(r io.Reader) error {
 	defer buf.MarkDone()
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -144,3 +145,39 @@ func TestSyncBuffer_WithLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestLabeledSyncBuffer_title(t *testing.T) {
+	startedAt := time.Date(2023, time.September, 1, 10, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		startedAt time.Time
+		doneAt    time.Time
+		wanted    string
+	}{
+		"only the label if the buffer wasn't started": {
+			wanted: "Building your container image",
+		},
+		"the time taken once the buffer is done": {
+			startedAt: startedAt,
+			doneAt:    startedAt.Add(83*time.Second + 420*time.Millisecond),
+			wanted:    "Building your container image (done in 1m23.4s)",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := New()
+			buf.startedAt = tc.startedAt
+			if !tc.doneAt.IsZero() {
+				close(buf.done)
+				buf.doneAt = tc.doneAt
+			}
+
+			require.Equal(t, tc.wanted, buf.WithLabel("Building your container image").title())
+		})
+	}
+	t.Run("the time elapsed so far while the buffer isn't done", func(t *testing.T) {
+		buf := New()
+		buf.Start()
+
+		require.Regexp(t, `^Building your container image \(\d+s\)$`, buf.WithLabel("Building your container image").title())
+	})
+}
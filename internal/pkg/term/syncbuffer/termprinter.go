@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/cursor"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"golang.org/x/term"
 )
 
@@ -36,6 +37,7 @@ type LabeledTermPrinter struct {
 	numLines         int                  // number of lines that has to be written from each buffer.
 	padding          int                  // Leading spaces before rendering to terminal.
 	prevWrittenLines int                  // number of lines written from all the buffers.
+	emittedLines     []int                // number of lines of each buffer emitted as events when the JSON output is enabled.
 }
 
 // LabeledTermPrinterOption is a type alias to configure LabeledTermPrinter.
@@ -44,9 +46,10 @@ type LabeledTermPrinterOption func(ltp *LabeledTermPrinter)
 // NewLabeledTermPrinter returns a LabeledTermPrinter that can print to the terminal filewriter from buffers.
 func NewLabeledTermPrinter(fw FileWriter, bufs []*LabeledSyncBuffer, opts ...LabeledTermPrinterOption) *LabeledTermPrinter {
 	ltp := &LabeledTermPrinter{
-		term:     fw,
-		buffers:  bufs,
-		numLines: printAllLinesInBuf, // By default set numlines to -1 to print all from buffers.
+		term:         fw,
+		buffers:      bufs,
+		numLines:     printAllLinesInBuf, // By default set numlines to -1 to print all from buffers.
+		emittedLines: make([]int, len(bufs)),
 	}
 	for _, opt := range opts {
 		opt(ltp)
@@ -81,7 +84,12 @@ func (ltp *LabeledTermPrinter) IsDone() bool {
 // Print prints the label and the last N lines of logs from each buffer
 // to the LabeledTermPrinter fileWriter and erases the previous output.
 // If numLines is -1 then print all the values from buffers.
+// If the JSON output of the progress package is enabled, the new lines of the buffers are emitted as log events instead.
 func (ltp *LabeledTermPrinter) Print() {
+	if progress.JSONOutputEnabled() {
+		ltp.emitNewLines()
+		return
+	}
 	if ltp.numLines == printAllLinesInBuf {
 		ltp.printAll()
 		return
@@ -93,7 +101,7 @@ func (ltp *LabeledTermPrinter) Print() {
 	for _, buf := range ltp.buffers {
 		logs := buf.lines()
 		outputLogs := ltp.lastNLines(logs)
-		ltp.prevWrittenLines += ltp.writeLines(buf.label, outputLogs)
+		ltp.prevWrittenLines += ltp.writeLines(buf.title(), outputLogs)
 	}
 }
 
@@ -106,12 +114,38 @@ func (ltp *LabeledTermPrinter) printAll() {
 			continue
 		}
 		outputLogs := ltp.buffers[idx].lines()
-		ltp.writeLines(ltp.buffers[idx].label, outputLogs)
+		ltp.writeLines(ltp.buffers[idx].title(), outputLogs)
 		ltp.buffers = append(ltp.buffers[:idx], ltp.buffers[idx+1:]...)
 		idx--
 	}
 }
 
+// emitNewLines emits a log event labeled with the buffer for each complete line that wasn't emitted yet.
+func (ltp *LabeledTermPrinter) emitNewLines() {
+	for len(ltp.emittedLines) < len(ltp.buffers) {
+		ltp.emittedLines = append(ltp.emittedLines, 0)
+	}
+	for idx, buf := range ltp.buffers {
+		done := buf.IsDone()
+		lines := buf.lines()
+		if !done && len(lines) > 0 {
+			lines = lines[:len(lines)-1] // The last line is still being written.
+		}
+		for _, line := range lines[ltp.emittedLines[idx]:] {
+			if line == "" {
+				continue
+			}
+			progress.Emit(progress.Event{
+				Type:    progress.EventTypeLog,
+				Level:   "info",
+				Label:   buf.label,
+				Message: line,
+			})
+		}
+		ltp.emittedLines[idx] = len(lines)
+	}
+}
+
 // lastNLines returns the last N lines of the given logs where N is the value of tp.numLines.
 // If the logs slice contains fewer than N lines, all lines are returned.
 // If the given input logs are empty then return slice of empty strings.
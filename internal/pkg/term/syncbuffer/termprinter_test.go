@@ -5,9 +5,12 @@ package syncbuffer
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
+	"strings"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/stretchr/testify/require"
 )
 
@@ -135,3 +138,31 @@ func TestLabeledTermPrinter_IsDone(t *testing.T) {
 		})
 	}
 }
+
+func TestLabeledTermPrinter_Print_JSONOutput(t *testing.T) {
+	// GIVEN
+	events := &bytes.Buffer{}
+	progress.EnableJSONOutput(events)
+	defer progress.EnableJSONOutput(nil)
+
+	buf := New()
+	ltp := NewLabeledTermPrinter(mockFileWriter{&bytes.Buffer{}}, []*LabeledSyncBuffer{buf.WithLabel("Building your container image")})
+
+	// WHEN
+	buf.Write([]byte("line1\nline2\nline"))
+	ltp.Print()
+	buf.Write([]byte("3\n"))
+	buf.MarkDone()
+	ltp.Print()
+
+	// THEN
+	var messages []string
+	for _, line := range strings.Split(strings.TrimSpace(events.String()), "\n") {
+		var e progress.Event
+		require.NoError(t, json.Unmarshal([]byte(line), &e))
+		require.Equal(t, progress.EventTypeLog, e.Type)
+		require.Equal(t, "Building your container image", e.Label)
+		messages = append(messages, e.Message)
+	}
+	require.Equal(t, []string{"line1", "line2", "line3"}, messages, "each complete line should be emitted once")
+}
@@ -0,0 +1,93 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// KeyMsg is a key pressed by the user: either one of the named keys, or the character typed.
+type KeyMsg string
+
+// Named keys.
+const (
+	KeyUp        KeyMsg = "up"
+	KeyDown      KeyMsg = "down"
+	KeyRight     KeyMsg = "right"
+	KeyLeft      KeyMsg = "left"
+	KeyEnter     KeyMsg = "enter"
+	KeyEsc       KeyMsg = "esc"
+	KeyBackspace KeyMsg = "backspace"
+	KeyTab       KeyMsg = "tab"
+	KeyCtrlC     KeyMsg = "ctrl+c"
+)
+
+var sequences = map[string]KeyMsg{
+	"\x1b[A": KeyUp,
+	"\x1b[B": KeyDown,
+	"\x1b[C": KeyRight,
+	"\x1b[D": KeyLeft,
+	"\x1bOA": KeyUp,
+	"\x1bOB": KeyDown,
+	"\x1bOC": KeyRight,
+	"\x1bOD": KeyLeft,
+	"\r":     KeyEnter,
+	"\n":     KeyEnter,
+	"\x7f":   KeyBackspace,
+	"\x08":   KeyBackspace,
+	"\t":     KeyTab,
+	"\x03":   KeyCtrlC,
+}
+
+// parseKeys returns the keys of the input read from the terminal.
+func parseKeys(in []byte) []KeyMsg {
+	var keys []KeyMsg
+	s := string(in)
+	for len(s) > 0 {
+		if key, n, ok := parseSequence(s); ok {
+			keys = append(keys, key)
+			s = s[n:]
+			continue
+		}
+		if s[0] == '\x1b' {
+			// Escape alone, or a sequence of a key that isn't handled.
+			keys = append(keys, KeyEsc)
+			s = skipUnknownSequence(s)
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(s)
+		s = s[n:]
+		if r < ' ' {
+			// Control characters that aren't handled.
+			continue
+		}
+		keys = append(keys, KeyMsg(r))
+	}
+	return keys
+}
+
+func parseSequence(s string) (KeyMsg, int, bool) {
+	for seq, key := range sequences {
+		if strings.HasPrefix(s, seq) {
+			return key, len(seq), true
+		}
+	}
+	return "", 0, false
+}
+
+// skipUnknownSequence returns the input after the escape sequence that starts it.
+func skipUnknownSequence(s string) string {
+	s = s[1:]
+	if !strings.HasPrefix(s, "[") && !strings.HasPrefix(s, "O") {
+		return s
+	}
+	// Control sequences end with a letter or "~", like "\x1b[3~" for the delete key.
+	for i := 1; i < len(s); i++ {
+		if c := s[i]; (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || c == '~' {
+			return s[i+1:]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,47 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeys(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted []KeyMsg
+	}{
+		"characters": {
+			in:     "jké",
+			wanted: []KeyMsg{"j", "k", "é"},
+		},
+		"arrows": {
+			in:     "\x1b[A\x1b[B\x1bOC\x1b[D",
+			wanted: []KeyMsg{KeyUp, KeyDown, KeyRight, KeyLeft},
+		},
+		"named keys": {
+			in:     "\r\x7f\t\x03",
+			wanted: []KeyMsg{KeyEnter, KeyBackspace, KeyTab, KeyCtrlC},
+		},
+		"escape alone": {
+			in:     "\x1b",
+			wanted: []KeyMsg{KeyEsc},
+		},
+		"skip the sequences of the keys that aren't handled": {
+			in:     "\x1b[3~q",
+			wanted: []KeyMsg{KeyEsc, "q"},
+		},
+		"skip the control characters that aren't handled": {
+			in:     "\x01d",
+			wanted: []KeyMsg{"d"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, parseKeys([]byte(tc.in)))
+		})
+	}
+}
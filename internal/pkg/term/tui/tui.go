@@ -0,0 +1,186 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tui provides a minimal framework for full screen terminal user interfaces.
+// A Model handles the messages of the program, like key presses or the results of its commands, by returning
+// its next state, and renders its state as a view that the program redraws after each message.
+package tui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+// Sizes of the screen when the output isn't a terminal.
+const (
+	defaultWidth  = 80
+	defaultHeight = 24
+)
+
+// Escape sequences of the terminal.
+const (
+	enterAltScreen = "\x1b[?1049h"
+	exitAltScreen  = "\x1b[?1049l"
+	hideCursor     = "\x1b[?25l"
+	showCursor     = "\x1b[?25h"
+	clearScreen    = "\x1b[H\x1b[2J"
+)
+
+// Msg is an event that the model handles, like a KeyMsg or the result of a Cmd.
+type Msg interface{}
+
+// Cmd is run in its own goroutine and returns a Msg for the model once it's done, or nil.
+type Cmd func() Msg
+
+// Model is the state of a program.
+type Model interface {
+	// Init returns the first command to run, if any.
+	Init() Cmd
+	// Update handles the message, and returns the next state and a command to run, if any.
+	Update(msg Msg) (Model, Cmd)
+	// View renders the state on a screen of width columns and height lines.
+	View(width, height int) string
+}
+
+type quitMsg struct{}
+
+// Quit is a Cmd that stops the program.
+func Quit() Msg {
+	return quitMsg{}
+}
+
+// Program runs a model in the terminal until it quits.
+type Program struct {
+	in  io.Reader
+	out io.Writer
+	fd  int // File descriptor of the terminal, or -1 if the input isn't a terminal.
+
+	msgs chan Msg
+	once sync.Once
+}
+
+// New returns a program that reads the keys from in, and draws on out.
+func New(in io.Reader, out io.Writer) *Program {
+	fd := -1
+	if f, ok := in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		fd = int(f.Fd())
+	}
+	return &Program{
+		in:   in,
+		out:  out,
+		fd:   fd,
+		msgs: make(chan Msg),
+	}
+}
+
+// Send sends the message to the model of the running program, for example from a Cmd that reports its progress.
+func (p *Program) Send(msg Msg) {
+	p.msgs <- msg
+}
+
+// Run draws the model on the alternate screen of the terminal, and updates it with the keys pressed and the results of
+// its commands until it quits. It returns the last state of the model.
+func (p *Program) Run(m Model) (Model, error) {
+	if p.fd != -1 {
+		state, err := term.MakeRaw(p.fd)
+		if err != nil {
+			return m, fmt.Errorf("set terminal to raw mode: %w", err)
+		}
+		defer term.Restore(p.fd, state)
+	}
+	fmt.Fprint(p.out, enterAltScreen+hideCursor)
+	defer fmt.Fprint(p.out, showCursor+exitAltScreen)
+
+	p.once.Do(func() {
+		// The goroutine can't be interrupted while it waits for a key, so it's started only once for the program.
+		go p.readKeys()
+	})
+	p.run(m.Init())
+	p.render(m)
+	for msg := range p.msgs {
+		if _, ok := msg.(quitMsg); ok {
+			return m, nil
+		}
+		var cmd Cmd
+		m, cmd = m.Update(msg)
+		p.run(cmd)
+		p.render(m)
+	}
+	return m, nil
+}
+
+func (p *Program) run(cmd Cmd) {
+	if cmd == nil {
+		return
+	}
+	go func() {
+		if msg := cmd(); msg != nil {
+			p.msgs <- msg
+		}
+	}()
+}
+
+func (p *Program) render(m Model) {
+	width, height := defaultWidth, defaultHeight
+	if p.fd != -1 {
+		if w, h, err := term.GetSize(p.fd); err == nil {
+			width, height = w, h
+		}
+	}
+	view := m.View(width, height)
+	// Raw mode doesn't return to the start of the line on new lines.
+	fmt.Fprint(p.out, clearScreen+strings.ReplaceAll(view, "\n", "\r\n"))
+}
+
+func (p *Program) readKeys() {
+	buf := make([]byte, 64)
+	for {
+		n, err := p.in.Read(buf)
+		for _, key := range parseKeys(buf[:n]) {
+			p.msgs <- key
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Truncate shortens the line to width columns, keeping the color escape sequences of the line.
+func Truncate(line string, width int) string {
+	var b strings.Builder
+	cols := 0
+	for i := 0; i < len(line); {
+		if line[i] == '\x1b' {
+			// Copy the control sequence as is, up to its final letter.
+			end := i + 1
+			for end < len(line) && !isFinalByte(line[end]) {
+				end++
+			}
+			if end < len(line) {
+				end++
+			}
+			b.WriteString(line[i:end])
+			i = end
+			continue
+		}
+		if cols == width {
+			i++
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(line[i:])
+		b.WriteRune(r)
+		cols++
+		i += n
+	}
+	return b.String()
+}
+
+func isFinalByte(c byte) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z')
+}
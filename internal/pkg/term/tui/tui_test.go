@@ -0,0 +1,151 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// counter counts the "+" keys, and quits once it gets the result of the command that it runs for the "c" key.
+type counter struct {
+	keys    int
+	results int
+}
+
+type resultMsg struct{}
+
+func (c counter) Init() Cmd {
+	return nil
+}
+
+func (c counter) Update(msg Msg) (Model, Cmd) {
+	switch msg := msg.(type) {
+	case KeyMsg:
+		switch msg {
+		case "+":
+			c.keys++
+		case "c":
+			return c, func() Msg {
+				return resultMsg{}
+			}
+		case "q":
+			return c, Quit
+		}
+	case resultMsg:
+		c.results++
+		return c, Quit
+	}
+	return c, nil
+}
+
+func (c counter) View(width, height int) string {
+	return fmt.Sprintf("keys: %d\nresults: %d", c.keys, c.results)
+}
+
+// slowReader returns one key per read, so that the commands complete before the next key.
+type slowReader struct {
+	keys []string
+	done chan struct{}
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	if len(r.keys) == 0 {
+		<-r.done
+		return 0, nil
+	}
+	key := r.keys[0]
+	r.keys = r.keys[1:]
+	return copy(p, key), nil
+}
+
+func TestProgram_Run(t *testing.T) {
+	// GIVEN
+	out := &bytes.Buffer{}
+	in := &slowReader{
+		keys: []string{"+", "+", "q"},
+		done: make(chan struct{}),
+	}
+	defer close(in.done)
+	p := New(in, out)
+
+	// WHEN
+	m, err := p.Run(counter{})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, counter{keys: 2}, m)
+	require.True(t, strings.HasPrefix(out.String(), enterAltScreen+hideCursor), "the program draws on the alternate screen")
+	require.True(t, strings.HasSuffix(out.String(), showCursor+exitAltScreen), "the program restores the screen")
+	require.Contains(t, out.String(), clearScreen+"keys: 2\r\nresults: 0")
+}
+
+func TestProgram_Run_cmd(t *testing.T) {
+	// GIVEN
+	in := &slowReader{
+		keys: []string{"+c"},
+		done: make(chan struct{}),
+	}
+	defer close(in.done)
+	p := New(in, &bytes.Buffer{})
+
+	// WHEN
+	m, err := p.Run(counter{})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, counter{keys: 1, results: 1}, m)
+}
+
+func TestProgram_Send(t *testing.T) {
+	// GIVEN
+	out := &bytes.Buffer{}
+	in := &slowReader{
+		done: make(chan struct{}),
+	}
+	defer close(in.done)
+	p := New(in, out)
+	go p.Send(resultMsg{})
+
+	// WHEN
+	m, err := p.Run(counter{})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, counter{results: 1}, m)
+}
+
+func TestTruncate(t *testing.T) {
+	testCases := map[string]struct {
+		line  string
+		width int
+
+		wanted string
+	}{
+		"short line": {
+			line:   "api",
+			width:  10,
+			wanted: "api",
+		},
+		"long line": {
+			line:   "frontend-service",
+			width:  8,
+			wanted: "frontend",
+		},
+		"keep the color sequences": {
+			line:   "\x1b[1mfrontend\x1b[0m-service",
+			width:  5,
+			wanted: "\x1b[1mfront\x1b[0m",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, Truncate(tc.line, tc.width))
+		})
+	}
+}
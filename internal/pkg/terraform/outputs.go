@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package terraform provides functionality to read the resources that Terraform manages from its outputs.
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Names of the outputs that hold each environment resource, in the order they're looked up.
+// They include the outputs of the popular terraform-aws-modules, like "vpc" and "acm".
+var (
+	VPCIDOutputs              = []string{"vpc_id"}
+	PublicSubnetsOutputs      = []string{"public_subnets", "public_subnet_ids"}
+	PrivateSubnetsOutputs     = []string{"private_subnets", "private_subnet_ids"}
+	CertificatesOutputs       = []string{"certificate_arns", "certificate_arn", "acm_certificate_arn"}
+	ClusterOutputs            = []string{"ecs_cluster_arn", "ecs_cluster_name", "cluster_arn", "cluster_name"}
+	PublicLoadBalancerOutputs = []string{"public_alb_arn", "alb_arn", "lb_arn"}
+	HostedZoneOutputs         = []string{"hosted_zone_id", "route53_zone_id"}
+)
+
+// EnvResources are the existing resources of an environment that Terraform manages.
+type EnvResources struct {
+	VPCID              string
+	PublicSubnetIDs    []string
+	PrivateSubnetIDs   []string
+	CertificateARNs    []string
+	Cluster            string // Name or ARN of the ECS cluster.
+	PublicLoadBalancer string // Name or ARN of the internet-facing Application Load Balancer.
+	HostedZoneID       string
+}
+
+// output is an output of `terraform output -json`, like {"value": "vpc-123", "type": "string", "sensitive": false}.
+type output struct {
+	Value interface{} `json:"value"`
+}
+
+// ParseEnvResources returns the environment resources in the content of `terraform output -json`.
+// Outputs that don't hold an environment resource are ignored.
+func ParseEnvResources(content []byte) (EnvResources, error) {
+	var outputs map[string]output
+	if err := json.Unmarshal(content, &outputs); err != nil {
+		return EnvResources{}, fmt.Errorf("unmarshal Terraform outputs: %w", err)
+	}
+	var res EnvResources
+	var err error
+	if res.VPCID, err = stringOutput(outputs, VPCIDOutputs); err != nil {
+		return EnvResources{}, err
+	}
+	if res.PublicSubnetIDs, err = listOutput(outputs, PublicSubnetsOutputs); err != nil {
+		return EnvResources{}, err
+	}
+	if res.PrivateSubnetIDs, err = listOutput(outputs, PrivateSubnetsOutputs); err != nil {
+		return EnvResources{}, err
+	}
+	if res.CertificateARNs, err = listOutput(outputs, CertificatesOutputs); err != nil {
+		return EnvResources{}, err
+	}
+	if res.Cluster, err = stringOutput(outputs, ClusterOutputs); err != nil {
+		return EnvResources{}, err
+	}
+	if res.PublicLoadBalancer, err = stringOutput(outputs, PublicLoadBalancerOutputs); err != nil {
+		return EnvResources{}, err
+	}
+	if res.HostedZoneID, err = stringOutput(outputs, HostedZoneOutputs); err != nil {
+		return EnvResources{}, err
+	}
+	return res, nil
+}
+
+// lookup returns the name and value of the first of the outputs that is set.
+func lookup(outputs map[string]output, names []string) (string, interface{}) {
+	for _, name := range names {
+		if out, ok := outputs[name]; ok && out.Value != nil {
+			return name, out.Value
+		}
+	}
+	return "", nil
+}
+
+func stringOutput(outputs map[string]output, names []string) (string, error) {
+	name, value := lookup(outputs, names)
+	if value == nil {
+		return "", nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("output %q must be a string", name)
+	}
+	return s, nil
+}
+
+// listOutput returns the strings of the first of the outputs that is set, which can be either a string or a list or set of strings.
+func listOutput(outputs map[string]output, names []string) ([]string, error) {
+	name, value := lookup(outputs, names)
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, elem := range v {
+			s, ok := elem.(string)
+			if !ok {
+				return nil, fmt.Errorf("output %q must be a list of strings", name)
+			}
+			list = append(list, s)
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("output %q must be a string or a list of strings", name)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnvResources(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wanted    EnvResources
+		wantedErr string
+	}{
+		"error on invalid json": {
+			in:        "{",
+			wantedErr: "unmarshal Terraform outputs: unexpected end of JSON input",
+		},
+		"outputs of terraform-aws-modules": {
+			in: `{
+  "vpc_id": {"sensitive": false, "type": "string", "value": "vpc-123"},
+  "public_subnets": {"sensitive": false, "type": ["list", "string"], "value": ["subnet-1", "subnet-2"]},
+  "private_subnets": {"sensitive": false, "type": ["list", "string"], "value": ["subnet-3", "subnet-4"]},
+  "acm_certificate_arn": {"sensitive": false, "type": "string", "value": "arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+  "cluster_name": {"sensitive": false, "type": "string", "value": "shared"},
+  "route53_zone_id": {"sensitive": false, "type": "string", "value": "Z123"},
+  "database_url": {"sensitive": true, "type": "string", "value": "postgres://db"}
+}`,
+			wanted: EnvResources{
+				VPCID:            "vpc-123",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+				CertificateARNs:  []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+				Cluster:          "shared",
+				HostedZoneID:     "Z123",
+			},
+		},
+		"first output of the aliases wins": {
+			in: `{
+  "vpc_id": {"value": "vpc-123"},
+  "private_subnet_ids": {"value": ["subnet-5", "subnet-6"]},
+  "private_subnets": {"value": ["subnet-3", "subnet-4"]},
+  "ecs_cluster_arn": {"value": "arn:aws:ecs:us-west-2:123456789012:cluster/shared"},
+  "cluster_name": {"value": "shared"},
+  "public_alb_arn": {"value": "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/123"}
+}`,
+			wanted: EnvResources{
+				VPCID:              "vpc-123",
+				PrivateSubnetIDs:   []string{"subnet-3", "subnet-4"},
+				Cluster:            "arn:aws:ecs:us-west-2:123456789012:cluster/shared",
+				PublicLoadBalancer: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/123",
+			},
+		},
+		"error if a string output is a list": {
+			in:        `{"vpc_id": {"value": ["vpc-123"]}}`,
+			wantedErr: `output "vpc_id" must be a string`,
+		},
+		"error if a list output holds other values": {
+			in:        `{"public_subnets": {"value": [{"id": "subnet-1"}]}}`,
+			wantedErr: `output "public_subnets" must be a list of strings`,
+		},
+		"error if a list output is a map": {
+			in:        `{"certificate_arns": {"value": {"api": "arn:aws:acm:us-west-2:123456789012:certificate/abc"}}}`,
+			wantedErr: `output "certificate_arns" must be a string or a list of strings`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseEnvResources([]byte(tc.in))
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
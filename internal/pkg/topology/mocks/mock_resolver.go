@@ -0,0 +1,228 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/topology/resolver.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	config "github.com/aws/copilot-cli/internal/pkg/config"
+	workspace "github.com/aws/copilot-cli/internal/pkg/workspace"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockConfigStoreReader is a mock of ConfigStoreReader interface.
+type MockConfigStoreReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigStoreReaderMockRecorder
+}
+
+// MockConfigStoreReaderMockRecorder is the mock recorder for MockConfigStoreReader.
+type MockConfigStoreReaderMockRecorder struct {
+	mock *MockConfigStoreReader
+}
+
+// NewMockConfigStoreReader creates a new mock instance.
+func NewMockConfigStoreReader(ctrl *gomock.Controller) *MockConfigStoreReader {
+	mock := &MockConfigStoreReader{ctrl: ctrl}
+	mock.recorder = &MockConfigStoreReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigStoreReader) EXPECT() *MockConfigStoreReaderMockRecorder {
+	return m.recorder
+}
+
+// ListEnvironments mocks base method.
+func (m *MockConfigStoreReader) ListEnvironments(appName string) ([]*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments", appName)
+	ret0, _ := ret[0].([]*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockConfigStoreReaderMockRecorder) ListEnvironments(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockConfigStoreReader)(nil).ListEnvironments), appName)
+}
+
+// ListWorkloads mocks base method.
+func (m *MockConfigStoreReader) ListWorkloads(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockConfigStoreReaderMockRecorder) ListWorkloads(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockConfigStoreReader)(nil).ListWorkloads), appName)
+}
+
+// MockDeployedWorkloadLister is a mock of DeployedWorkloadLister interface.
+type MockDeployedWorkloadLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeployedWorkloadListerMockRecorder
+}
+
+// MockDeployedWorkloadListerMockRecorder is the mock recorder for MockDeployedWorkloadLister.
+type MockDeployedWorkloadListerMockRecorder struct {
+	mock *MockDeployedWorkloadLister
+}
+
+// NewMockDeployedWorkloadLister creates a new mock instance.
+func NewMockDeployedWorkloadLister(ctrl *gomock.Controller) *MockDeployedWorkloadLister {
+	mock := &MockDeployedWorkloadLister{ctrl: ctrl}
+	mock.recorder = &MockDeployedWorkloadListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeployedWorkloadLister) EXPECT() *MockDeployedWorkloadListerMockRecorder {
+	return m.recorder
+}
+
+// ListDeployedWorkloads mocks base method.
+func (m *MockDeployedWorkloadLister) ListDeployedWorkloads(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedWorkloads", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedWorkloads indicates an expected call of ListDeployedWorkloads.
+func (mr *MockDeployedWorkloadListerMockRecorder) ListDeployedWorkloads(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedWorkloads", reflect.TypeOf((*MockDeployedWorkloadLister)(nil).ListDeployedWorkloads), appName, envName)
+}
+
+// MockWorkspaceReader is a mock of WorkspaceReader interface.
+type MockWorkspaceReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceReaderMockRecorder
+}
+
+// MockWorkspaceReaderMockRecorder is the mock recorder for MockWorkspaceReader.
+type MockWorkspaceReaderMockRecorder struct {
+	mock *MockWorkspaceReader
+}
+
+// NewMockWorkspaceReader creates a new mock instance.
+func NewMockWorkspaceReader(ctrl *gomock.Controller) *MockWorkspaceReader {
+	mock := &MockWorkspaceReader{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceReader) EXPECT() *MockWorkspaceReaderMockRecorder {
+	return m.recorder
+}
+
+// EnvAddonFileAbsPath mocks base method.
+func (m *MockWorkspaceReader) EnvAddonFileAbsPath(fName string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvAddonFileAbsPath", fName)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EnvAddonFileAbsPath indicates an expected call of EnvAddonFileAbsPath.
+func (mr *MockWorkspaceReaderMockRecorder) EnvAddonFileAbsPath(fName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonFileAbsPath", reflect.TypeOf((*MockWorkspaceReader)(nil).EnvAddonFileAbsPath), fName)
+}
+
+// EnvAddonsAbsPath mocks base method.
+func (m *MockWorkspaceReader) EnvAddonsAbsPath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvAddonsAbsPath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EnvAddonsAbsPath indicates an expected call of EnvAddonsAbsPath.
+func (mr *MockWorkspaceReaderMockRecorder) EnvAddonsAbsPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonsAbsPath", reflect.TypeOf((*MockWorkspaceReader)(nil).EnvAddonsAbsPath))
+}
+
+// ListFiles mocks base method.
+func (m *MockWorkspaceReader) ListFiles(dirPath string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFiles", dirPath)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFiles indicates an expected call of ListFiles.
+func (mr *MockWorkspaceReaderMockRecorder) ListFiles(dirPath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockWorkspaceReader)(nil).ListFiles), dirPath)
+}
+
+// ReadFile mocks base method.
+func (m *MockWorkspaceReader) ReadFile(fPath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFile", fPath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFile indicates an expected call of ReadFile.
+func (mr *MockWorkspaceReaderMockRecorder) ReadFile(fPath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockWorkspaceReader)(nil).ReadFile), fPath)
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockWorkspaceReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockWorkspaceReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockWorkspaceReader)(nil).ReadWorkloadManifest), name)
+}
+
+// WorkloadAddonFileAbsPath mocks base method.
+func (m *MockWorkspaceReader) WorkloadAddonFileAbsPath(wkldName, fName string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadAddonFileAbsPath", wkldName, fName)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// WorkloadAddonFileAbsPath indicates an expected call of WorkloadAddonFileAbsPath.
+func (mr *MockWorkspaceReaderMockRecorder) WorkloadAddonFileAbsPath(wkldName, fName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadAddonFileAbsPath", reflect.TypeOf((*MockWorkspaceReader)(nil).WorkloadAddonFileAbsPath), wkldName, fName)
+}
+
+// WorkloadAddonsAbsPath mocks base method.
+func (m *MockWorkspaceReader) WorkloadAddonsAbsPath(name string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadAddonsAbsPath", name)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// WorkloadAddonsAbsPath indicates an expected call of WorkloadAddonsAbsPath.
+func (mr *MockWorkspaceReaderMockRecorder) WorkloadAddonsAbsPath(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadAddonsAbsPath", reflect.TypeOf((*MockWorkspaceReader)(nil).WorkloadAddonsAbsPath), name)
+}
@@ -0,0 +1,109 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Formats in which a Topology can be rendered.
+const (
+	FormatDOT     = "dot"
+	FormatMermaid = "mermaid"
+	FormatJSON    = "json"
+)
+
+// Formats are the formats in which a Topology can be rendered.
+var Formats = []string{FormatDOT, FormatMermaid, FormatJSON}
+
+var dotShapes = map[NodeKind]string{
+	NodeKindEnvironment: "box3d",
+	NodeKindService:     "box",
+	NodeKindJob:         "octagon",
+	NodeKindSidecar:     "component",
+	NodeKindTopic:       "cds",
+	NodeKindQueue:       "cylinder",
+	NodeKindAddon:       "hexagon",
+}
+
+// mermaidShapes holds the opening and closing brackets of the shape of each kind of node.
+var mermaidShapes = map[NodeKind][2]string{
+	NodeKindEnvironment: {"[[", "]]"},
+	NodeKindService:     {"[", "]"},
+	NodeKindJob:         {"([", "])"},
+	NodeKindSidecar:     {"(", ")"},
+	NodeKindTopic:       {">", "]"},
+	NodeKindQueue:       {"[(", ")]"},
+	NodeKindAddon:       {"{{", "}}"},
+}
+
+// Render returns the topology in the given format.
+func (t *Topology) Render(format string) (string, error) {
+	switch format {
+	case FormatDOT:
+		return t.DOT(), nil
+	case FormatMermaid:
+		return t.Mermaid(), nil
+	case FormatJSON:
+		return t.JSONString()
+	}
+	return "", fmt.Errorf("unsupported format %q: must be one of %s", format, strings.Join(Formats, ", "))
+}
+
+// DOT returns the topology as a Graphviz DOT digraph.
+func (t *Topology) DOT() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", strconv.Quote(t.App))
+	b.WriteString("  rankdir=LR;\n")
+	for _, n := range t.Nodes {
+		fmt.Fprintf(&b, "  %s [label=%s, shape=%s];\n", strconv.Quote(n.ID), strconv.Quote(n.label()), dotShapes[n.Kind])
+	}
+	for _, e := range t.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", strconv.Quote(e.From), strconv.Quote(e.To), strconv.Quote(e.label()))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid returns the topology as a Mermaid flowchart.
+func (t *Topology) Mermaid() string {
+	// Mermaid IDs can't contain most punctuation, so refer to the nodes by their position instead.
+	ids := make(map[string]string, len(t.Nodes))
+	for i, n := range t.Nodes {
+		ids[n.ID] = fmt.Sprintf("n%d", i)
+	}
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, n := range t.Nodes {
+		shape := mermaidShapes[n.Kind]
+		fmt.Fprintf(&b, "  %s%s\"%s\"%s\n", ids[n.ID], shape[0], mermaidEscape(n.label()), shape[1])
+	}
+	for _, e := range t.Edges {
+		fmt.Fprintf(&b, "  %s -->|\"%s\"| %s\n", ids[e.From], mermaidEscape(e.label()), ids[e.To])
+	}
+	return b.String()
+}
+
+// label returns the name of the node, followed by its type if there is one.
+func (n Node) label() string {
+	if n.Type == "" {
+		return n.Name
+	}
+	return fmt.Sprintf("%s\n%s", n.Name, n.Type)
+}
+
+// label returns the kind of the edge, followed by its label if there is one.
+func (e Edge) label() string {
+	if e.Label == "" {
+		return string(e.Kind)
+	}
+	return fmt.Sprintf("%s: %s", e.Kind, e.Label)
+}
+
+// mermaidEscape replaces the characters that can't appear in a quoted Mermaid label.
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "\n", "<br/>").Replace(s)
+}
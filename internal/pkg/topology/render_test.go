@@ -0,0 +1,80 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testTopology() *Topology {
+	t := newTopology("my-app")
+	t.addNode(Node{ID: "env:test", Kind: NodeKindEnvironment, Name: "test"})
+	t.addNode(Node{ID: "wkld:api", Kind: NodeKindService, Name: "api", Type: "Load Balanced Web Service"})
+	t.addNode(Node{ID: "topic:api/orders", Kind: NodeKindTopic, Name: "orders"})
+	t.addNode(Node{ID: "sidecar:api/nginx", Kind: NodeKindSidecar, Name: "nginx"})
+	t.addEdge(Edge{From: "env:test", To: "wkld:api", Kind: EdgeKindDeploys})
+	t.addEdge(Edge{From: "wkld:api", To: "topic:api/orders", Kind: EdgeKindPublishes})
+	t.addEdge(Edge{From: "wkld:api", To: "sidecar:api/nginx", Kind: EdgeKindDependsOn, Label: "start"})
+	t.addEdge(Edge{From: "wkld:api", To: "sidecar:api/nginx", Kind: EdgeKindDependsOn, Label: "start"}) // Duplicates are ignored.
+	t.addEdge(Edge{From: "wkld:api", To: "wkld:unknown", Kind: EdgeKindCalls})                          // Edges to unknown nodes are ignored.
+	return t
+}
+
+func TestTopology_Render(t *testing.T) {
+	testCases := map[string]struct {
+		format string
+
+		wanted    string
+		wantedErr error
+	}{
+		"dot": {
+			format: FormatDOT,
+			wanted: `digraph "my-app" {
+  rankdir=LR;
+  "env:test" [label="test", shape=box3d];
+  "wkld:api" [label="api\nLoad Balanced Web Service", shape=box];
+  "topic:api/orders" [label="orders", shape=cds];
+  "sidecar:api/nginx" [label="nginx", shape=component];
+  "env:test" -> "wkld:api" [label="deploys"];
+  "wkld:api" -> "topic:api/orders" [label="publishes"];
+  "wkld:api" -> "sidecar:api/nginx" [label="depends_on: start"];
+}
+`,
+		},
+		"mermaid": {
+			format: FormatMermaid,
+			wanted: `flowchart LR
+  n0[["test"]]
+  n1["api<br/>Load Balanced Web Service"]
+  n2>"orders"]
+  n3("nginx")
+  n0 -->|"deploys"| n1
+  n1 -->|"publishes"| n2
+  n1 -->|"depends_on: start"| n3
+`,
+		},
+		"json": {
+			format: FormatJSON,
+			wanted: `{"app":"my-app","nodes":[{"id":"env:test","kind":"environment","name":"test"},{"id":"wkld:api","kind":"service","name":"api","type":"Load Balanced Web Service"},{"id":"topic:api/orders","kind":"topic","name":"orders"},{"id":"sidecar:api/nginx","kind":"sidecar","name":"nginx"}],"edges":[{"from":"env:test","to":"wkld:api","kind":"deploys"},{"from":"wkld:api","to":"topic:api/orders","kind":"publishes"},{"from":"wkld:api","to":"sidecar:api/nginx","kind":"depends_on","label":"start"}]}` + "\n",
+		},
+		"unsupported format": {
+			format:    "svg",
+			wantedErr: errors.New(`unsupported format "svg": must be one of dot, mermaid, json`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := testTopology().Render(tc.format)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,398 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+// ConfigStoreReader lists the environments and workloads of an application.
+type ConfigStoreReader interface {
+	ListEnvironments(appName string) ([]*config.Environment, error)
+	ListWorkloads(appName string) ([]*config.Workload, error)
+}
+
+// DeployedWorkloadLister lists the workloads deployed to an environment.
+type DeployedWorkloadLister interface {
+	ListDeployedWorkloads(appName string, envName string) ([]string, error)
+}
+
+// WorkspaceReader reads the manifests and addons of the workloads in a workspace.
+type WorkspaceReader interface {
+	addon.WorkspaceAddonsReader
+	ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error)
+}
+
+// Resolver resolves the topology of an application.
+type Resolver struct {
+	store       ConfigStoreReader
+	deployStore DeployedWorkloadLister
+	ws          WorkspaceReader
+}
+
+// NewResolver returns a Resolver that aggregates the environments and workloads in the config store,
+// the workloads deployed to each environment, and, if ws is not nil, the manifests and addons of the workspace.
+func NewResolver(store ConfigStoreReader, deployStore DeployedWorkloadLister, ws WorkspaceReader) *Resolver {
+	return &Resolver{
+		store:       store,
+		deployStore: deployStore,
+		ws:          ws,
+	}
+}
+
+// Resolve returns the topology of the application.
+// The connections between workloads are only resolved for the workloads whose manifest is in the workspace.
+func (r *Resolver) Resolve(app string) (*Topology, error) {
+	envs, err := r.store.ListEnvironments(app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", app, err)
+	}
+	wklds, err := r.store.ListWorkloads(app)
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in application %s: %w", app, err)
+	}
+	sort.Slice(wklds, func(i, j int) bool { return wklds[i].Name < wklds[j].Name })
+
+	t := newTopology(app)
+	for _, env := range envs {
+		t.addNode(Node{
+			ID:   environmentID(env.Name),
+			Kind: NodeKindEnvironment,
+			Name: env.Name,
+		})
+	}
+	for _, wkld := range wklds {
+		kind := NodeKindService
+		if manifestinfo.IsTypeAJob(wkld.Type) {
+			kind = NodeKindJob
+		}
+		t.addNode(Node{
+			ID:   workloadID(wkld.Name),
+			Kind: kind,
+			Name: wkld.Name,
+			Type: wkld.Type,
+		})
+	}
+	for _, env := range envs {
+		deployed, err := r.deployStore.ListDeployedWorkloads(app, env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list workloads deployed to environment %s: %w", env.Name, err)
+		}
+		sort.Strings(deployed)
+		for _, wkld := range deployed {
+			t.addEdge(Edge{
+				From: environmentID(env.Name),
+				To:   workloadID(wkld),
+				Kind: EdgeKindDeploys,
+			})
+		}
+	}
+	if r.ws == nil {
+		return t, nil
+	}
+
+	var svcs []string
+	for _, wkld := range wklds {
+		if manifestinfo.IsTypeAService(wkld.Type) {
+			svcs = append(svcs, wkld.Name)
+		}
+	}
+	for _, wkld := range wklds {
+		if err := r.resolveWorkload(t, wkld.Name, svcs); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.resolveEnvironmentAddons(t, envs); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *Resolver) resolveWorkload(t *Topology, name string, svcs []string) error {
+	raw, err := r.ws.ReadWorkloadManifest(name)
+	if err != nil {
+		var errNotExist *workspace.ErrFileNotExists
+		if errors.As(err, &errNotExist) {
+			return nil // The workload is not in this workspace.
+		}
+		return fmt.Errorf("read manifest of %s: %w", name, err)
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return fmt.Errorf("unmarshal manifest of %s: %w", name, err)
+	}
+	cfg := workloadConfigOf(mft.Manifest())
+	resolveContainers(t, name, cfg)
+	resolveServiceCalls(t, name, cfg, svcs)
+	resolveTopics(t, name, cfg)
+
+	stack, err := addon.ParseFromWorkload(name, r.ws)
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("parse addons of %s: %w", name, err)
+	}
+	for _, resource := range stack.Resources() {
+		t.addNode(Node{
+			ID:   addonID(name, resource.LogicalID),
+			Kind: NodeKindAddon,
+			Name: resource.LogicalID,
+			Type: resource.Type,
+		})
+		t.addEdge(Edge{
+			From: workloadID(name),
+			To:   addonID(name, resource.LogicalID),
+			Kind: EdgeKindOwns,
+		})
+	}
+	return nil
+}
+
+func (r *Resolver) resolveEnvironmentAddons(t *Topology, envs []*config.Environment) error {
+	stack, err := addon.ParseFromEnv(r.ws)
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("parse environment addons: %w", err)
+	}
+	// The environment addons are deployed to every environment.
+	const owner = "environments"
+	for _, resource := range stack.Resources() {
+		t.addNode(Node{
+			ID:   addonID(owner, resource.LogicalID),
+			Kind: NodeKindAddon,
+			Name: resource.LogicalID,
+			Type: resource.Type,
+		})
+		for _, env := range envs {
+			t.addEdge(Edge{
+				From: environmentID(env.Name),
+				To:   addonID(owner, resource.LogicalID),
+				Kind: EdgeKindOwns,
+			})
+		}
+	}
+	return nil
+}
+
+// workloadConfig holds the fields of a workload manifest that connect the workload to other nodes.
+type workloadConfig struct {
+	dependsOn manifest.DependsOn
+	variables map[string]manifest.Variable
+	sidecars  map[string]*manifest.SidecarConfig
+	publish   manifest.PublishConfig
+	subscribe manifest.SubscribeConfig
+}
+
+func workloadConfigOf(mft any) workloadConfig {
+	switch mft := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		return workloadConfig{
+			dependsOn: mft.ImageConfig.Image.DependsOn,
+			variables: mft.TaskConfig.Variables,
+			sidecars:  mft.Sidecars,
+			publish:   mft.PublishConfig,
+		}
+	case *manifest.BackendService:
+		return workloadConfig{
+			dependsOn: mft.ImageConfig.Image.DependsOn,
+			variables: mft.TaskConfig.Variables,
+			sidecars:  mft.Sidecars,
+			publish:   mft.PublishConfig,
+		}
+	case *manifest.WorkerService:
+		return workloadConfig{
+			dependsOn: mft.ImageConfig.Image.DependsOn,
+			variables: mft.TaskConfig.Variables,
+			sidecars:  mft.Sidecars,
+			publish:   mft.PublishConfig,
+			subscribe: mft.Subscribe,
+		}
+	case *manifest.ScheduledJob:
+		return workloadConfig{
+			dependsOn: mft.ImageConfig.Image.DependsOn,
+			variables: mft.TaskConfig.Variables,
+			sidecars:  mft.Sidecars,
+			publish:   mft.PublishConfig,
+		}
+	case *manifest.RequestDrivenWebService:
+		return workloadConfig{
+			variables: mft.Variables,
+			publish:   mft.PublishConfig,
+		}
+	}
+	return workloadConfig{}
+}
+
+// resolveContainers adds the sidecars of the workload, and the dependencies between its containers.
+func resolveContainers(t *Topology, wkld string, cfg workloadConfig) {
+	containerID := func(container string) string {
+		if container == wkld {
+			return workloadID(wkld)
+		}
+		return sidecarID(wkld, container)
+	}
+	for _, name := range sortedKeys(cfg.sidecars) {
+		t.addNode(Node{
+			ID:   sidecarID(wkld, name),
+			Kind: NodeKindSidecar,
+			Name: name,
+		})
+	}
+	for _, name := range sortedKeys(cfg.sidecars) {
+		if _, ok := cfg.dependsOn[name]; ok {
+			continue // The "depends_on" edge already connects the workload to the sidecar.
+		}
+		t.addEdge(Edge{
+			From: workloadID(wkld),
+			To:   sidecarID(wkld, name),
+			Kind: EdgeKindRuns,
+		})
+	}
+	addDependencies := func(from string, deps manifest.DependsOn) {
+		for _, container := range sortedKeys(deps) {
+			t.addEdge(Edge{
+				From:  from,
+				To:    containerID(container),
+				Kind:  EdgeKindDependsOn,
+				Label: deps[container],
+			})
+		}
+	}
+	addDependencies(workloadID(wkld), cfg.dependsOn)
+	for _, name := range sortedKeys(cfg.sidecars) {
+		if sidecar := cfg.sidecars[name]; sidecar != nil {
+			addDependencies(sidecarID(wkld, name), sidecar.DependsOn)
+		}
+	}
+}
+
+// resolveServiceCalls adds an edge to each service whose name is the host of an environment variable of the workload.
+func resolveServiceCalls(t *Topology, wkld string, cfg workloadConfig, svcs []string) {
+	vars := make(map[string]manifest.Variable)
+	for name, v := range cfg.variables {
+		vars[name] = v
+	}
+	for _, sidecar := range cfg.sidecars {
+		if sidecar == nil {
+			continue
+		}
+		for name, v := range sidecar.Variables {
+			if _, ok := vars[name]; !ok {
+				vars[name] = v
+			}
+		}
+	}
+	for _, name := range sortedKeys(vars) {
+		host, isAddr := hostOf(aws.StringValue(vars[name].Plain))
+		if host == "" {
+			continue
+		}
+		for _, svc := range svcs {
+			if svc == wkld || !isServiceHost(host, isAddr, svc) {
+				continue
+			}
+			t.addEdge(Edge{
+				From:  workloadID(wkld),
+				To:    workloadID(svc),
+				Kind:  EdgeKindCalls,
+				Label: name,
+			})
+		}
+	}
+}
+
+// hostOf returns the host of a URL or of an address like "host:port".
+// isAddr is false if the value is a single word rather than a URL or an address.
+func hostOf(value string) (host string, isAddr bool) {
+	if idx := strings.Index(value, "://"); idx != -1 {
+		value, isAddr = value[idx+len("://"):], true
+	}
+	if idx := strings.IndexAny(value, ":/?#"); idx != -1 {
+		value, isAddr = value[:idx], true
+	}
+	return value, isAddr
+}
+
+// isServiceHost returns true if host is the Service Connect name of svc in an address, such as "http://api:8080",
+// or its service discovery name, such as "api.test.my-app.local".
+func isServiceHost(host string, isAddr bool, svc string) bool {
+	if host == svc {
+		return isAddr // Values like "api" are more likely to be names than addresses.
+	}
+	return strings.HasPrefix(host, svc+".") && strings.HasSuffix(host, ".local")
+}
+
+// resolveTopics adds the topics that the workload publishes to, and the queues through which it consumes topics.
+func resolveTopics(t *Topology, wkld string, cfg workloadConfig) {
+	for _, topic := range cfg.publish.Topics {
+		name := aws.StringValue(topic.Name)
+		t.addNode(Node{
+			ID:   topicID(wkld, name),
+			Kind: NodeKindTopic,
+			Name: name,
+		})
+		t.addEdge(Edge{
+			From: workloadID(wkld),
+			To:   topicID(wkld, name),
+			Kind: EdgeKindPublishes,
+		})
+	}
+	for _, topic := range cfg.subscribe.Topics {
+		name, publisher := aws.StringValue(topic.Name), aws.StringValue(topic.Service)
+		t.addNode(Node{
+			ID:   topicID(publisher, name),
+			Kind: NodeKindTopic,
+			Name: name,
+		})
+		t.addEdge(Edge{
+			From: workloadID(publisher),
+			To:   topicID(publisher, name),
+			Kind: EdgeKindPublishes,
+		})
+		// Topics without their own queue are subscribed to the default queue of the worker service.
+		queue := fmt.Sprintf("%s-events", wkld)
+		if aws.BoolValue(topic.Queue.Enabled) || !topic.Queue.Advanced.IsEmpty() {
+			queue = fmt.Sprintf("%s-%s-%s", wkld, publisher, name)
+		}
+		t.addNode(Node{
+			ID:   queueID(queue),
+			Kind: NodeKindQueue,
+			Name: queue,
+		})
+		t.addEdge(Edge{
+			From: topicID(publisher, name),
+			To:   queueID(queue),
+			Kind: EdgeKindSubscribes,
+		})
+		t.addEdge(Edge{
+			From: queueID(queue),
+			To:   workloadID(wkld),
+			Kind: EdgeKindConsumes,
+		})
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
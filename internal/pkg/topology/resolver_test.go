@@ -0,0 +1,288 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/topology/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type resolverMocks struct {
+	store       *mocks.MockConfigStoreReader
+	deployStore *mocks.MockDeployedWorkloadLister
+	ws          *mocks.MockWorkspaceReader
+}
+
+const (
+	testAPIManifest = `name: api
+type: Load Balanced Web Service
+image:
+  build: Dockerfile
+  port: 80
+  depends_on:
+    nginx: start
+variables:
+  SEARCH_URL: http://search:8080
+sidecars:
+  nginx:
+    image: nginx
+  logger:
+    image: public.ecr.aws/aws-observability/aws-for-fluent-bit:stable
+    depends_on:
+      nginx: start
+publish:
+  topics:
+    - name: orders
+`
+	testWorkerManifest = `name: worker
+type: Worker Service
+image:
+  build: Dockerfile
+variables:
+  SEARCH_ENDPOINT: search.test.my-app.local:8080
+  SEARCH_NAME: search
+subscribe:
+  topics:
+    - name: orders
+      service: api
+    - name: refunds
+      service: api
+      queue: true
+`
+	testSearchManifest = `name: search
+type: Backend Service
+image:
+  build: Dockerfile
+  port: 8080
+`
+	testAPIAddon = `Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+  Name:
+    Type: String
+Resources:
+  OrdersTable:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      BillingMode: PAY_PER_REQUEST
+`
+)
+
+func TestResolver_Resolve(t *testing.T) {
+	testErr := errors.New("some error")
+	mockEnvs := []*config.Environment{{Name: "test"}, {Name: "prod"}}
+	mockWorkloads := []*config.Workload{
+		{Name: "worker", Type: manifestinfo.WorkerServiceType},
+		{Name: "api", Type: manifestinfo.LoadBalancedWebServiceType},
+		{Name: "search", Type: manifestinfo.BackendServiceType},
+		{Name: "report", Type: manifestinfo.ScheduledJobType},
+	}
+	noAddons := func(m resolverMocks) {
+		m.ws.EXPECT().WorkloadAddonsAbsPath(gomock.Any()).Return("addons").AnyTimes()
+		m.ws.EXPECT().EnvAddonsAbsPath().Return("addons").AnyTimes()
+		m.ws.EXPECT().ListFiles("addons").Return(nil, &workspace.ErrFileNotExists{FileName: "addons"}).AnyTimes()
+	}
+	testCases := map[string]struct {
+		withoutWorkspace bool
+		setupMocks       func(m resolverMocks)
+
+		wantedNodes []Node
+		wantedEdges []Edge
+		wantedErr   error
+	}{
+		"error if fail to list environments": {
+			setupMocks: func(m resolverMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(nil, testErr)
+			},
+			wantedErr: fmt.Errorf("list environments in application my-app: some error"),
+		},
+		"error if fail to list workloads": {
+			setupMocks: func(m resolverMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(nil, testErr)
+			},
+			wantedErr: fmt.Errorf("list workloads in application my-app: some error"),
+		},
+		"error if fail to list deployed workloads": {
+			setupMocks: func(m resolverMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(mockWorkloads, nil)
+				m.deployStore.EXPECT().ListDeployedWorkloads("my-app", "test").Return(nil, testErr)
+			},
+			wantedErr: fmt.Errorf("list workloads deployed to environment test: some error"),
+		},
+		"error if fail to read a manifest": {
+			setupMocks: func(m resolverMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(mockWorkloads, nil)
+				m.deployStore.EXPECT().ListDeployedWorkloads("my-app", gomock.Any()).Return(nil, nil).Times(2)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(nil, testErr)
+			},
+			wantedErr: fmt.Errorf("read manifest of api: some error"),
+		},
+		"error if fail to unmarshal a manifest": {
+			setupMocks: func(m resolverMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(mockWorkloads, nil)
+				m.deployStore.EXPECT().ListDeployedWorkloads("my-app", gomock.Any()).Return(nil, nil).Times(2)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte("name: api\ntype: Unknown"), nil)
+			},
+			wantedErr: fmt.Errorf(`unmarshal manifest of api: invalid manifest type: Unknown`),
+		},
+		"only environments and workloads without a workspace": {
+			withoutWorkspace: true,
+			setupMocks: func(m resolverMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(mockWorkloads, nil)
+				m.deployStore.EXPECT().ListDeployedWorkloads("my-app", "test").Return([]string{"worker", "api"}, nil)
+				m.deployStore.EXPECT().ListDeployedWorkloads("my-app", "prod").Return([]string{"api"}, nil)
+			},
+			wantedNodes: []Node{
+				{ID: "env:test", Kind: NodeKindEnvironment, Name: "test"},
+				{ID: "env:prod", Kind: NodeKindEnvironment, Name: "prod"},
+				{ID: "wkld:api", Kind: NodeKindService, Name: "api", Type: manifestinfo.LoadBalancedWebServiceType},
+				{ID: "wkld:report", Kind: NodeKindJob, Name: "report", Type: manifestinfo.ScheduledJobType},
+				{ID: "wkld:search", Kind: NodeKindService, Name: "search", Type: manifestinfo.BackendServiceType},
+				{ID: "wkld:worker", Kind: NodeKindService, Name: "worker", Type: manifestinfo.WorkerServiceType},
+			},
+			wantedEdges: []Edge{
+				{From: "env:test", To: "wkld:api", Kind: EdgeKindDeploys},
+				{From: "env:test", To: "wkld:worker", Kind: EdgeKindDeploys},
+				{From: "env:prod", To: "wkld:api", Kind: EdgeKindDeploys},
+			},
+		},
+		"resolves the connections of the workloads in the workspace": {
+			setupMocks: func(m resolverMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(mockWorkloads, nil)
+				m.deployStore.EXPECT().ListDeployedWorkloads("my-app", "test").Return([]string{"worker", "api", "search"}, nil)
+				m.deployStore.EXPECT().ListDeployedWorkloads("my-app", "prod").Return([]string{"api"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(testAPIManifest), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("report").Return(nil, &workspace.ErrFileNotExists{FileName: "report"})
+				m.ws.EXPECT().ReadWorkloadManifest("search").Return([]byte(testSearchManifest), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("worker").Return([]byte(testWorkerManifest), nil)
+				m.ws.EXPECT().WorkloadAddonsAbsPath("api").Return("api/addons")
+				m.ws.EXPECT().ListFiles("api/addons").Return([]string{"table.yml"}, nil)
+				m.ws.EXPECT().WorkloadAddonFileAbsPath("api", "table.yml").Return("api/addons/table.yml")
+				m.ws.EXPECT().ReadFile("api/addons/table.yml").Return([]byte(testAPIAddon), nil)
+				noAddons(m)
+			},
+			wantedNodes: []Node{
+				{ID: "env:test", Kind: NodeKindEnvironment, Name: "test"},
+				{ID: "env:prod", Kind: NodeKindEnvironment, Name: "prod"},
+				{ID: "wkld:api", Kind: NodeKindService, Name: "api", Type: manifestinfo.LoadBalancedWebServiceType},
+				{ID: "wkld:report", Kind: NodeKindJob, Name: "report", Type: manifestinfo.ScheduledJobType},
+				{ID: "wkld:search", Kind: NodeKindService, Name: "search", Type: manifestinfo.BackendServiceType},
+				{ID: "wkld:worker", Kind: NodeKindService, Name: "worker", Type: manifestinfo.WorkerServiceType},
+				{ID: "sidecar:api/logger", Kind: NodeKindSidecar, Name: "logger"},
+				{ID: "sidecar:api/nginx", Kind: NodeKindSidecar, Name: "nginx"},
+				{ID: "topic:api/orders", Kind: NodeKindTopic, Name: "orders"},
+				{ID: "addon:api/OrdersTable", Kind: NodeKindAddon, Name: "OrdersTable", Type: "AWS::DynamoDB::Table"},
+				{ID: "queue:worker-events", Kind: NodeKindQueue, Name: "worker-events"},
+				{ID: "topic:api/refunds", Kind: NodeKindTopic, Name: "refunds"},
+				{ID: "queue:worker-api-refunds", Kind: NodeKindQueue, Name: "worker-api-refunds"},
+			},
+			wantedEdges: []Edge{
+				{From: "env:test", To: "wkld:api", Kind: EdgeKindDeploys},
+				{From: "env:test", To: "wkld:search", Kind: EdgeKindDeploys},
+				{From: "env:test", To: "wkld:worker", Kind: EdgeKindDeploys},
+				{From: "env:prod", To: "wkld:api", Kind: EdgeKindDeploys},
+				{From: "wkld:api", To: "sidecar:api/logger", Kind: EdgeKindRuns},
+				{From: "wkld:api", To: "sidecar:api/nginx", Kind: EdgeKindDependsOn, Label: "start"},
+				{From: "sidecar:api/logger", To: "sidecar:api/nginx", Kind: EdgeKindDependsOn, Label: "start"},
+				{From: "wkld:api", To: "wkld:search", Kind: EdgeKindCalls, Label: "SEARCH_URL"},
+				{From: "wkld:api", To: "topic:api/orders", Kind: EdgeKindPublishes},
+				{From: "wkld:api", To: "addon:api/OrdersTable", Kind: EdgeKindOwns},
+				{From: "wkld:worker", To: "wkld:search", Kind: EdgeKindCalls, Label: "SEARCH_ENDPOINT"},
+				{From: "topic:api/orders", To: "queue:worker-events", Kind: EdgeKindSubscribes},
+				{From: "queue:worker-events", To: "wkld:worker", Kind: EdgeKindConsumes},
+				{From: "wkld:api", To: "topic:api/refunds", Kind: EdgeKindPublishes},
+				{From: "topic:api/refunds", To: "queue:worker-api-refunds", Kind: EdgeKindSubscribes},
+				{From: "queue:worker-api-refunds", To: "wkld:worker", Kind: EdgeKindConsumes},
+			},
+		},
+		"connects environment addons to every environment": {
+			setupMocks: func(m resolverMocks) {
+				m.store.EXPECT().ListEnvironments("my-app").Return(mockEnvs, nil)
+				m.store.EXPECT().ListWorkloads("my-app").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedWorkloads("my-app", gomock.Any()).Return(nil, nil).Times(2)
+				m.ws.EXPECT().EnvAddonsAbsPath().Return("environments/addons")
+				m.ws.EXPECT().ListFiles("environments/addons").Return([]string{"bucket.yml"}, nil)
+				m.ws.EXPECT().EnvAddonFileAbsPath("bucket.yml").Return("environments/addons/bucket.yml")
+				m.ws.EXPECT().ReadFile("environments/addons/bucket.yml").Return([]byte("Parameters:\n  App:\n    Type: String\n  Env:\n    Type: String\nResources:\n  Bucket:\n    Type: AWS::S3::Bucket\n"), nil)
+			},
+			wantedNodes: []Node{
+				{ID: "env:test", Kind: NodeKindEnvironment, Name: "test"},
+				{ID: "env:prod", Kind: NodeKindEnvironment, Name: "prod"},
+				{ID: "addon:environments/Bucket", Kind: NodeKindAddon, Name: "Bucket", Type: "AWS::S3::Bucket"},
+			},
+			wantedEdges: []Edge{
+				{From: "env:test", To: "addon:environments/Bucket", Kind: EdgeKindOwns},
+				{From: "env:prod", To: "addon:environments/Bucket", Kind: EdgeKindOwns},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := resolverMocks{
+				store:       mocks.NewMockConfigStoreReader(ctrl),
+				deployStore: mocks.NewMockDeployedWorkloadLister(ctrl),
+				ws:          mocks.NewMockWorkspaceReader(ctrl),
+			}
+			tc.setupMocks(m)
+			r := NewResolver(m.store, m.deployStore, m.ws)
+			if tc.withoutWorkspace {
+				r = NewResolver(m.store, m.deployStore, nil)
+			}
+
+			// WHEN
+			got, err := r.Resolve("my-app")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "my-app", got.App)
+			require.Equal(t, tc.wantedNodes, got.Nodes)
+			require.Equal(t, tc.wantedEdges, got.Edges)
+		})
+	}
+}
+
+func Test_hostOf(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedHost   string
+		wantedIsAddr bool
+	}{
+		"word":            {in: "api", wantedHost: "api"},
+		"host and port":   {in: "api:8080", wantedHost: "api", wantedIsAddr: true},
+		"url with path":   {in: "https://api.test.my-app.local/v1?q=1", wantedHost: "api.test.my-app.local", wantedIsAddr: true},
+		"url with scheme": {in: "http://api", wantedHost: "api", wantedIsAddr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			host, isAddr := hostOf(tc.in)
+			require.Equal(t, tc.wantedHost, host)
+			require.Equal(t, tc.wantedIsAddr, isAddr)
+		})
+	}
+}
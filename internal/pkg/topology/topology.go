@@ -0,0 +1,158 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package topology resolves how the environments, workloads, and resources of an application are connected.
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// NodeKind is the kind of element of an application that a Node represents.
+type NodeKind string
+
+// Kinds of nodes in a Topology.
+const (
+	NodeKindEnvironment NodeKind = "environment"
+	NodeKindService     NodeKind = "service"
+	NodeKindJob         NodeKind = "job"
+	NodeKindSidecar     NodeKind = "sidecar"
+	NodeKindTopic       NodeKind = "topic"
+	NodeKindQueue       NodeKind = "queue"
+	NodeKindAddon       NodeKind = "addon"
+)
+
+// EdgeKind is the kind of relationship between the two nodes of an Edge.
+type EdgeKind string
+
+// Kinds of edges in a Topology.
+const (
+	EdgeKindDeploys    EdgeKind = "deploys"    // An environment runs a deployed workload.
+	EdgeKindRuns       EdgeKind = "runs"       // A workload runs a sidecar container.
+	EdgeKindDependsOn  EdgeKind = "depends_on" // A container waits for another container of the same task to start.
+	EdgeKindCalls      EdgeKind = "calls"      // A workload refers to a service by its service discovery or Service Connect name.
+	EdgeKindPublishes  EdgeKind = "publishes"  // A workload publishes to a topic.
+	EdgeKindSubscribes EdgeKind = "subscribes" // A queue is subscribed to a topic.
+	EdgeKindConsumes   EdgeKind = "consumes"   // A worker service consumes the messages of a queue.
+	EdgeKindOwns       EdgeKind = "owns"       // A workload or an environment owns an addon resource.
+)
+
+// Node is an element of an application.
+type Node struct {
+	ID   string   `json:"id"`
+	Kind NodeKind `json:"kind"`
+	Name string   `json:"name"`
+	Type string   `json:"type,omitempty"` // The workload type, or the CloudFormation type of an addon resource.
+}
+
+// Edge is a directed relationship between two nodes.
+type Edge struct {
+	From  string   `json:"from"`
+	To    string   `json:"to"`
+	Kind  EdgeKind `json:"kind"`
+	Label string   `json:"label,omitempty"`
+}
+
+// Topology is the graph of the nodes of an application and the edges between them.
+type Topology struct {
+	App   string `json:"app"`
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+
+	nodes map[string]bool // Set of the IDs of the nodes that were added.
+	edges map[Edge]bool   // Set of the edges that were added.
+}
+
+func newTopology(app string) *Topology {
+	return &Topology{
+		App:   app,
+		Nodes: []Node{},
+		Edges: []Edge{},
+		nodes: make(map[string]bool),
+		edges: make(map[Edge]bool),
+	}
+}
+
+// addNode adds a node to the topology if there is no node with the same ID yet.
+func (t *Topology) addNode(n Node) {
+	if t.nodes[n.ID] {
+		return
+	}
+	t.nodes[n.ID] = true
+	t.Nodes = append(t.Nodes, n)
+}
+
+// addEdge adds an edge to the topology if both of its nodes exist and it wasn't added yet.
+func (t *Topology) addEdge(e Edge) {
+	if !t.nodes[e.From] || !t.nodes[e.To] {
+		return
+	}
+	if t.edges[e] {
+		return
+	}
+	t.edges[e] = true
+	t.Edges = append(t.Edges, e)
+}
+
+// Callees returns the names of the services that the workload calls, sorted by name.
+func (t *Topology) Callees(wkld string) []string {
+	names := make(map[string]string, len(t.Nodes))
+	for _, n := range t.Nodes {
+		names[n.ID] = n.Name
+	}
+	var callees []string
+	for _, e := range t.Edges {
+		if e.Kind != EdgeKindCalls || e.From != workloadID(wkld) {
+			continue
+		}
+		if name := names[e.To]; !contains(callees, name) {
+			callees = append(callees, name)
+		}
+	}
+	sort.Strings(callees)
+	return callees
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// JSONString returns the stringified Topology struct with json format.
+func (t *Topology) JSONString() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("marshal topology: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+func environmentID(name string) string {
+	return "env:" + name
+}
+
+func workloadID(name string) string {
+	return "wkld:" + name
+}
+
+func sidecarID(wkld, name string) string {
+	return fmt.Sprintf("sidecar:%s/%s", wkld, name)
+}
+
+func topicID(publisher, name string) string {
+	return fmt.Sprintf("topic:%s/%s", publisher, name)
+}
+
+func queueID(name string) string {
+	return "queue:" + name
+}
+
+func addonID(owner, logicalID string) string {
+	return fmt.Sprintf("addon:%s/%s", owner, logicalID)
+}
@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopology_Callees(t *testing.T) {
+	topo := newTopology("my-app")
+	topo.addNode(Node{ID: "wkld:frontend", Kind: NodeKindService, Name: "frontend"})
+	topo.addNode(Node{ID: "wkld:orders", Kind: NodeKindService, Name: "orders"})
+	topo.addNode(Node{ID: "wkld:api", Kind: NodeKindService, Name: "api"})
+	topo.addNode(Node{ID: "topic:frontend/events", Kind: NodeKindTopic, Name: "events"})
+	topo.addEdge(Edge{From: "wkld:frontend", To: "wkld:orders", Kind: EdgeKindCalls, Label: "ORDERS_URL"})
+	topo.addEdge(Edge{From: "wkld:frontend", To: "wkld:orders", Kind: EdgeKindCalls, Label: "ORDERS_HOST"})
+	topo.addEdge(Edge{From: "wkld:frontend", To: "wkld:api", Kind: EdgeKindCalls, Label: "API_URL"})
+	topo.addEdge(Edge{From: "wkld:frontend", To: "topic:frontend/events", Kind: EdgeKindPublishes})
+	topo.addEdge(Edge{From: "wkld:api", To: "wkld:orders", Kind: EdgeKindCalls, Label: "ORDERS_URL"})
+
+	require.Equal(t, []string{"api", "orders"}, topo.Callees("frontend"))
+	require.Equal(t, []string{"orders"}, topo.Callees("api"))
+	require.Empty(t, topo.Callees("orders"))
+}
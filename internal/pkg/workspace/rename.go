@@ -0,0 +1,149 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RenameWorkload moves the workload's directory under copilot/ from one name to another, and updates
+// the name in its manifest. It returns the path to the renamed manifest.
+func (ws *Workspace) RenameWorkload(from, to string) (string, error) {
+	raw, err := ws.ReadWorkloadManifest(from)
+	if err != nil {
+		return "", err
+	}
+	src, dst := filepath.Join(ws.CopilotDirAbs, from), filepath.Join(ws.CopilotDirAbs, to)
+	exists, err := ws.fs.Exists(dst)
+	if err != nil {
+		return "", fmt.Errorf("check if directory %s exists: %w", dst, err)
+	}
+	if exists {
+		return "", &ErrFileExists{FileName: dst}
+	}
+	nameField := regexp.MustCompile(fmt.Sprintf(`(?m)^(name:[ \t]*)(["']?)%s(["']?)([ \t]*(#.*)?)$`, regexp.QuoteMeta(from)))
+	if !nameField.Match(raw) {
+		return "", fmt.Errorf(`find "name: %s" in the manifest of %s`, from, from)
+	}
+	if err := ws.fs.Rename(src, dst); err != nil {
+		return "", fmt.Errorf("rename directory %s to %s: %w", src, dst, err)
+	}
+	mftPath := filepath.Join(dst, manifestFileName)
+	renamed := nameField.ReplaceAll(raw, []byte(fmt.Sprintf("${1}${2}%s${3}${4}", to)))
+	if err := ws.fs.WriteFile(mftPath, renamed, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write manifest file %s: %w", mftPath, err)
+	}
+	return mftPath, nil
+}
+
+// UpdateApplication associates the workspace with another application.
+func (ws *Workspace) UpdateApplication(name string) error {
+	summary, err := ws.writeSummary(name)
+	if err != nil {
+		return fmt.Errorf("write workspace summary: %w", err)
+	}
+	ws.summarizeOnce.Do(func() {})
+	ws.summary, ws.summaryErr = summary, nil
+	return nil
+}
+
+// OverwriteWorkloadManifest replaces the content of an existing workload's manifest.
+// It returns the path to the manifest.
+func (ws *Workspace) OverwriteWorkloadManifest(name string, raw []byte) (string, error) {
+	if _, err := ws.ReadWorkloadManifest(name); err != nil {
+		return "", err
+	}
+	mftPath := filepath.Join(ws.CopilotDirAbs, name, manifestFileName)
+	if err := ws.fs.WriteFile(mftPath, raw, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write manifest file %s: %w", mftPath, err)
+	}
+	return mftPath, nil
+}
+
+// RenameWorkloadInPipelines replaces the deployments of a workload in the stages of the pipeline manifests,
+// and in the workloads that the deployments depend on. It returns the paths to the updated manifests.
+func (ws *Workspace) RenameWorkloadInPipelines(from, to string) ([]string, error) {
+	pipelines, err := ws.ListPipelines()
+	if err != nil {
+		return nil, err
+	}
+	var updated []string
+	for _, pipeline := range pipelines {
+		raw, err := ws.fs.ReadFile(pipeline.Path)
+		if err != nil {
+			return nil, fmt.Errorf("read pipeline manifest %s: %w", pipeline.Path, err)
+		}
+		var doc yaml.Node
+		if err := yaml.Unmarshal(raw, &doc); err != nil {
+			return nil, fmt.Errorf("unmarshal pipeline manifest %s: %w", pipeline.Path, err)
+		}
+		if !renameDeployments(&doc, from, to) {
+			continue
+		}
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(&doc); err != nil {
+			return nil, fmt.Errorf("marshal pipeline manifest %s: %w", pipeline.Path, err)
+		}
+		if err := ws.fs.WriteFile(pipeline.Path, buf.Bytes(), 0644 /* -rw-r--r-- */); err != nil {
+			return nil, fmt.Errorf("write pipeline manifest %s: %w", pipeline.Path, err)
+		}
+		updated = append(updated, pipeline.Path)
+	}
+	return updated, nil
+}
+
+// renameDeployments renames the workload in the "stages[].deployments" of a pipeline manifest,
+// and returns true if the manifest changed.
+func renameDeployments(doc *yaml.Node, from, to string) bool {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return false
+	}
+	stages := mappingValue(doc.Content[0], "stages")
+	if stages == nil || stages.Kind != yaml.SequenceNode {
+		return false
+	}
+	var changed bool
+	for _, stage := range stages.Content {
+		deployments := mappingValue(stage, "deployments")
+		if deployments == nil || deployments.Kind != yaml.MappingNode {
+			continue
+		}
+		for i := 0; i+1 < len(deployments.Content); i += 2 {
+			if deployments.Content[i].Value == from {
+				deployments.Content[i].Value = to
+				changed = true
+			}
+			dependsOn := mappingValue(deployments.Content[i+1], "depends_on")
+			if dependsOn == nil || dependsOn.Kind != yaml.SequenceNode {
+				continue
+			}
+			for _, dep := range dependsOn.Content {
+				if dep.Value == from {
+					dep.Value = to
+					changed = true
+				}
+			}
+		}
+	}
+	return changed
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspace_RenameWorkload(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedPath     string
+		wantedManifest string
+		wantedErr      error
+	}{
+		"error if the destination already exists": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte("name: api\ntype: Backend Service\n"), 0644)
+				_ = fs.MkdirAll("/copilot/backend", 0755)
+				return fs
+			},
+			wantedErr: &ErrFileExists{FileName: filepath.FromSlash("/copilot/backend")},
+		},
+		"error if the manifest does not exist": {
+			fs: func() afero.Fs {
+				return afero.NewMemMapFs()
+			},
+			wantedErr: &ErrFileNotExists{FileName: filepath.FromSlash("/copilot/api/manifest.yml")},
+		},
+		"move the directory and update the name in the manifest": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte(`# The manifest for the "api" service.
+name: "api" # Keep in sync with the directory.
+type: Backend Service
+
+image:
+  build: api/Dockerfile
+`), 0644)
+				_ = afero.WriteFile(fs, "/copilot/api/addons/table.yml", []byte("Resources: {}\n"), 0644)
+				return fs
+			},
+			wantedPath: filepath.FromSlash("/copilot/backend/manifest.yml"),
+			wantedManifest: `# The manifest for the "api" service.
+name: "backend" # Keep in sync with the directory.
+type: Backend Service
+
+image:
+  build: api/Dockerfile
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := tc.fs()
+			ws := &Workspace{
+				CopilotDirAbs: "/copilot",
+				fs:            &afero.Afero{Fs: fs},
+			}
+
+			// WHEN
+			got, err := ws.RenameWorkload("api", "backend")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPath, got)
+			content, err := afero.ReadFile(fs, got)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedManifest, string(content))
+			exists, err := afero.Exists(fs, "/copilot/backend/addons/table.yml")
+			require.NoError(t, err)
+			require.True(t, exists)
+			exists, err = afero.DirExists(fs, "/copilot/api")
+			require.NoError(t, err)
+			require.False(t, exists)
+		})
+	}
+}
+
+func TestWorkspace_UpdateApplication(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, "/copilot/.workspace", []byte("application: my-app\n"), 0644)
+	ws := &Workspace{
+		CopilotDirAbs: "/copilot",
+		fs:            &afero.Afero{Fs: fs},
+	}
+	summary, err := ws.Summary()
+	require.NoError(t, err)
+	require.Equal(t, "my-app", summary.Application)
+
+	// WHEN
+	err = ws.UpdateApplication("my-new-app")
+
+	// THEN
+	require.NoError(t, err)
+	content, err := afero.ReadFile(fs, "/copilot/.workspace")
+	require.NoError(t, err)
+	require.Equal(t, "application: my-new-app\n", string(content))
+	summary, err = ws.Summary()
+	require.NoError(t, err)
+	require.Equal(t, "my-new-app", summary.Application)
+}
+
+func TestWorkspace_OverwriteWorkloadManifest(t *testing.T) {
+	t.Run("error if the manifest does not exist", func(t *testing.T) {
+		ws := &Workspace{
+			CopilotDirAbs: "/copilot",
+			fs:            &afero.Afero{Fs: afero.NewMemMapFs()},
+		}
+
+		_, err := ws.OverwriteWorkloadManifest("api", []byte("name: api\n"))
+
+		var errNotExists *ErrFileNotExists
+		require.True(t, errors.As(err, &errNotExists))
+	})
+	t.Run("replace the content of the manifest", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte("name: api\ntype: Backend Service\n"), 0644)
+		ws := &Workspace{
+			CopilotDirAbs: "/copilot",
+			fs:            &afero.Afero{Fs: fs},
+		}
+
+		got, err := ws.OverwriteWorkloadManifest("api", []byte("name: api\ntype: Worker Service\n"))
+
+		require.NoError(t, err)
+		require.Equal(t, filepath.FromSlash("/copilot/api/manifest.yml"), got)
+		content, err := afero.ReadFile(fs, got)
+		require.NoError(t, err)
+		require.Equal(t, "name: api\ntype: Worker Service\n", string(content))
+	})
+}
+
+func TestWorkspace_RenameWorkloadInPipelines(t *testing.T) {
+	const fmtPipeline = `name: %s
+version: 1
+source:
+  provider: GitHub
+  properties:
+    branch: main
+    repository: https://github.com/badgoose/backend
+stages:
+  - name: test
+    deployments:
+      api:
+      frontend:
+        depends_on: [api]
+  - name: prod
+    requires_approval: true
+`
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, "/copilot/pipelines/release/manifest.yml", []byte(fmt.Sprintf(fmtPipeline, "release")), 0644)
+	_ = afero.WriteFile(fs, "/copilot/pipelines/other/manifest.yml", []byte(`name: other
+version: 1
+source:
+  provider: GitHub
+  properties:
+    branch: main
+    repository: https://github.com/badgoose/other
+stages:
+  - name: test
+`), 0644)
+	ws := &Workspace{
+		CopilotDirAbs: "/copilot",
+		fs:            &afero.Afero{Fs: fs},
+		logger:        func(format string, args ...interface{}) {},
+	}
+
+	// WHEN
+	got, err := ws.RenameWorkloadInPipelines("api", "backend")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.FromSlash("/copilot/pipelines/release/manifest.yml")}, got)
+	content, err := afero.ReadFile(fs, "/copilot/pipelines/release/manifest.yml")
+	require.NoError(t, err)
+	require.Equal(t, `name: release
+version: 1
+source:
+  provider: GitHub
+  properties:
+    branch: main
+    repository: https://github.com/badgoose/backend
+stages:
+  - name: test
+    deployments:
+      backend:
+      frontend:
+        depends_on: [backend]
+  - name: prod
+    requires_approval: true
+`, string(content))
+}
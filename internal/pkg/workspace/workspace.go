@@ -46,6 +46,8 @@ const (
 	SummaryFileName = ".workspace"
 	// AddonsParametersFileName is the name of the file that define extra parameters for an addon.
 	AddonsParametersFileName = "addons.parameters.yml"
+	// PromptHistoryFileName is the name of the file that holds the answers to the prompts of the commands run in the workspace.
+	PromptHistoryFileName = ".prompts.yml"
 
 	addonsDirName             = "addons"
 	overridesDirName          = "overrides"
@@ -483,6 +485,33 @@ func (ws *Workspace) DeleteWorkspaceFile() error {
 	return ws.fs.Remove(filepath.Join(ws.CopilotDirAbs, SummaryFileName))
 }
 
+// ReadPromptHistory returns the answers to the prompts of the commands previously run in the workspace.
+// It returns nil if no command saved its answers yet.
+func (ws *Workspace) ReadPromptHistory() ([]byte, error) {
+	data, err := ws.read(PromptHistoryFileName)
+	if err != nil {
+		var errNotExist *ErrFileNotExists
+		if errors.As(err, &errNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// WritePromptHistory writes the answers to the prompts of the commands run in the workspace, overwriting the previous answers.
+func (ws *Workspace) WritePromptHistory(marshaler encoding.BinaryMarshaler) (string, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal prompt history to binary: %w", err)
+	}
+	path := filepath.Join(ws.CopilotDirAbs, PromptHistoryFileName)
+	if err := ws.fs.WriteFile(path, data, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write prompt history file: %w", err)
+	}
+	return path, nil
+}
+
 // EnvAddonsAbsPath returns the absolute path for the addons/ directory of environments.
 func (ws *Workspace) EnvAddonsAbsPath() string {
 	return filepath.Join(ws.CopilotDirAbs, environmentsDirName, addonsDirName)
@@ -1581,6 +1581,49 @@ func TestWorkspace_DeleteWorkspaceFile(t *testing.T) {
 	}
 }
 
+func TestWorkspace_PromptHistory(t *testing.T) {
+	t.Run("returns nil if no answers were saved", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, fs.MkdirAll("/copilot", 0755))
+		ws := &Workspace{
+			CopilotDirAbs: "/copilot",
+			fs:            &afero.Afero{Fs: fs},
+		}
+
+		data, err := ws.ReadPromptHistory()
+
+		require.NoError(t, err)
+		require.Nil(t, data)
+	})
+	t.Run("overwrites the previous answers", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, fs.MkdirAll("/copilot", 0755))
+		require.NoError(t, afero.WriteFile(fs, "/copilot/.prompts.yml", []byte("old"), 0644))
+		ws := &Workspace{
+			CopilotDirAbs: "/copilot",
+			fs:            &afero.Afero{Fs: fs},
+		}
+
+		path, err := ws.WritePromptHistory(mockBinaryMarshaler{content: []byte("new")})
+		require.NoError(t, err)
+		require.Equal(t, "/copilot/.prompts.yml", path)
+		data, err := ws.ReadPromptHistory()
+
+		require.NoError(t, err)
+		require.Equal(t, "new", string(data))
+	})
+	t.Run("error if the answers can't be marshaled", func(t *testing.T) {
+		ws := &Workspace{
+			CopilotDirAbs: "/copilot",
+			fs:            &afero.Afero{Fs: afero.NewMemMapFs()},
+		}
+
+		_, err := ws.WritePromptHistory(mockBinaryMarshaler{err: errors.New("some error")})
+
+		require.EqualError(t, err, "marshal prompt history to binary: some error")
+	})
+}
+
 func TestWorkspace_read(t *testing.T) {
 	testCases := map[string]struct {
 		elems []string
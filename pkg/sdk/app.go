@@ -0,0 +1,107 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+)
+
+// InitAppInput holds the configuration of a new application.
+type InitAppInput struct {
+	Name                string
+	Domain              string            // Optional. Domain name of a Route 53 hosted zone in the account for the load balanced services.
+	PermissionsBoundary string            // Optional. Name of an IAM policy to use as the permissions boundary of the roles of the application.
+	Tags                map[string]string // Optional. Tags applied to all the resources of the application.
+}
+
+// AppStatus is the description of an application.
+type AppStatus struct {
+	Name                string
+	Version             string // Version of the template of the application.
+	URI                 string // Domain name of the application, if any.
+	PermissionsBoundary string
+	Environments        []EnvSummary
+	Services            []WorkloadSummary
+	Jobs                []WorkloadSummary
+	Pipelines           []string // Names of the pipelines of the application in CodePipeline.
+}
+
+// EnvSummary identifies an environment and where it's deployed.
+type EnvSummary struct {
+	Name      string
+	AccountID string
+	Region    string
+}
+
+// WorkloadSummary identifies a service or a job and its type, such as "Load Balanced Web Service".
+type WorkloadSummary struct {
+	Name string
+	Type string
+}
+
+// InitApp creates an application, like `copilot app init`.
+// It creates a workspace for the application in the current working directory if there isn't one already.
+func (c *Client) InitApp(ctx context.Context, in InitAppInput) error {
+	if in.Name == "" {
+		return errors.New("application name is required")
+	}
+	return c.do(ctx, OpInitApp, in.Name, func(ctx context.Context) error {
+		return c.ops.InitApp(ctx, in.Name, in.Domain, in.PermissionsBoundary, in.Tags)
+	})
+}
+
+// DeleteApp deletes an application along with all of its environments and workloads, like `copilot app delete --yes`.
+func (c *Client) DeleteApp(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("application name is required")
+	}
+	return c.do(ctx, OpDeleteApp, name, func(ctx context.Context) error {
+		return c.ops.DeleteApp(ctx, name)
+	})
+}
+
+// AppStatus returns the description of an application, like `copilot app show`.
+func (c *Client) AppStatus(ctx context.Context, name string) (*AppStatus, error) {
+	if name == "" {
+		return nil, errors.New("application name is required")
+	}
+	var status *AppStatus
+	err := c.do(ctx, OpAppStatus, name, func(ctx context.Context) error {
+		app, err := c.ops.DescribeApp(ctx, name)
+		if err != nil {
+			return err
+		}
+		status = newAppStatus(app)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func newAppStatus(app *describe.App) *AppStatus {
+	status := &AppStatus{
+		Name:                app.Name,
+		Version:             app.Version,
+		URI:                 app.URI,
+		PermissionsBoundary: app.PermissionsBoundary,
+		Services:            newWorkloadSummaries(app.Services),
+		Jobs:                newWorkloadSummaries(app.Jobs),
+	}
+	for _, env := range app.Envs {
+		status.Environments = append(status.Environments, EnvSummary{
+			Name:      env.Name,
+			AccountID: env.AccountID,
+			Region:    env.Region,
+		})
+	}
+	for _, pipeline := range app.Pipelines {
+		status.Pipelines = append(status.Pipelines, pipeline.Name)
+	}
+	return status
+}
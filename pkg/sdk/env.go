@@ -0,0 +1,145 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+)
+
+// EnvInput identifies an environment of an application.
+type EnvInput struct {
+	App  string
+	Name string
+}
+
+func (in EnvInput) validate() error {
+	if in.App == "" {
+		return errors.New("application name is required")
+	}
+	if in.Name == "" {
+		return errors.New("environment name is required")
+	}
+	return nil
+}
+
+// InitEnvInput holds the configuration of a new environment, which gets the default network configuration.
+type InitEnvInput struct {
+	EnvInput
+	Profile string // Named profile of the AWS account that hosts the environment.
+	Region  string // Optional. Region of the environment, defaults to the region of the profile.
+}
+
+// DeployEnvInput holds the configuration of the deployment of an environment from its manifest in the workspace.
+type DeployEnvInput struct {
+	EnvInput
+	Force           bool // Optional. Deploy the environment even if its template didn't change.
+	DisableRollback bool // Optional. Keep the stack in its failed state instead of rolling it back.
+}
+
+// EnvStatus is the description of an environment.
+type EnvStatus struct {
+	EnvSummary
+	App      string
+	Services []WorkloadSummary // Services deployed to the environment.
+	Jobs     []WorkloadSummary // Jobs deployed to the environment.
+	Tags     map[string]string
+	VPC      VPC
+}
+
+// VPC is the network of an environment.
+type VPC struct {
+	ID               string
+	PublicSubnetIDs  []string
+	PrivateSubnetIDs []string
+}
+
+// InitEnv creates an environment, like `copilot env init --default-config`.
+// It writes the manifest of the environment in the workspace; run DeployEnv to deploy it.
+func (c *Client) InitEnv(ctx context.Context, in InitEnvInput) error {
+	if err := in.validate(); err != nil {
+		return err
+	}
+	if in.Profile == "" {
+		return errors.New("profile of the environment is required")
+	}
+	return c.do(ctx, OpInitEnv, in.Name, func(ctx context.Context) error {
+		return c.ops.InitEnv(ctx, in.App, in.Name, in.Profile, in.Region)
+	})
+}
+
+// DeployEnv deploys an environment, like `copilot env deploy`.
+func (c *Client) DeployEnv(ctx context.Context, in DeployEnvInput) error {
+	if err := in.validate(); err != nil {
+		return err
+	}
+	return c.do(ctx, OpDeployEnv, in.Name, func(ctx context.Context) error {
+		return c.ops.DeployEnv(ctx, in.App, in.Name, in.Force, in.DisableRollback)
+	})
+}
+
+// DeleteEnv deletes an environment, like `copilot env delete --yes`.
+// Like the command without --delete-workloads, it fails if services or jobs are still deployed to the environment:
+// delete them with DeleteWorkload first.
+func (c *Client) DeleteEnv(ctx context.Context, in EnvInput) error {
+	if err := in.validate(); err != nil {
+		return err
+	}
+	return c.do(ctx, OpDeleteEnv, in.Name, func(ctx context.Context) error {
+		return c.ops.DeleteEnv(ctx, in.App, in.Name)
+	})
+}
+
+// EnvStatus returns the description of an environment, like `copilot env show`.
+func (c *Client) EnvStatus(ctx context.Context, in EnvInput) (*EnvStatus, error) {
+	if err := in.validate(); err != nil {
+		return nil, err
+	}
+	var status *EnvStatus
+	err := c.do(ctx, OpEnvStatus, in.Name, func(ctx context.Context) error {
+		env, err := c.ops.DescribeEnv(ctx, in.App, in.Name)
+		if err != nil {
+			return err
+		}
+		status = newEnvStatus(env)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func newEnvStatus(env *describe.EnvDescription) *EnvStatus {
+	return &EnvStatus{
+		EnvSummary: EnvSummary{
+			Name:      env.Environment.Name,
+			AccountID: env.Environment.AccountID,
+			Region:    env.Environment.Region,
+		},
+		App:      env.Environment.App,
+		Services: newWorkloadSummaries(env.Services),
+		Jobs:     newWorkloadSummaries(env.Jobs),
+		Tags:     env.Tags,
+		VPC: VPC{
+			ID:               env.EnvironmentVPC.ID,
+			PublicSubnetIDs:  env.EnvironmentVPC.PublicSubnetIDs,
+			PrivateSubnetIDs: env.EnvironmentVPC.PrivateSubnetIDs,
+		},
+	}
+}
+
+func newWorkloadSummaries(wklds []*config.Workload) []WorkloadSummary {
+	var summaries []WorkloadSummary
+	for _, wkld := range wklds {
+		summaries = append(summaries, WorkloadSummary{
+			Name: wkld.Name,
+			Type: wkld.Type,
+		})
+	}
+	return summaries
+}
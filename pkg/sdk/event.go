@@ -0,0 +1,52 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import "time"
+
+// Operation is the name of an operation run by the Client, such as "deploy workload".
+type Operation string
+
+// Operations run by the Client.
+const (
+	OpInitApp        Operation = "initialize application"
+	OpDeleteApp      Operation = "delete application"
+	OpAppStatus      Operation = "show application"
+	OpInitEnv        Operation = "initialize environment"
+	OpDeployEnv      Operation = "deploy environment"
+	OpDeleteEnv      Operation = "delete environment"
+	OpEnvStatus      Operation = "show environment"
+	OpDeployWorkload Operation = "deploy workload"
+	OpDeleteWorkload Operation = "delete workload"
+	OpWorkloadStatus Operation = "show status of workload"
+)
+
+// Event is a progress event of an operation. It is one of OperationStarted, OperationSucceeded or OperationFailed.
+type Event interface {
+	isEvent()
+}
+
+// OperationStarted is sent when an operation starts.
+type OperationStarted struct {
+	Operation Operation
+	Target    string // Name of the application, environment or workload the operation is run on.
+}
+
+// OperationSucceeded is sent when an operation completes successfully.
+type OperationSucceeded struct {
+	Operation Operation
+	Target    string
+	Elapsed   time.Duration
+}
+
+// OperationFailed is sent when an operation fails.
+type OperationFailed struct {
+	Operation Operation
+	Target    string
+	Err       error
+}
+
+func (OperationStarted) isEvent()   {}
+func (OperationSucceeded) isEvent() {}
+func (OperationFailed) isEvent()    {}
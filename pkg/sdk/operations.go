@@ -0,0 +1,68 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+)
+
+// operations runs the commands behind the operations of the Client.
+type operations interface {
+	InitApp(ctx context.Context, name, domain, permissionsBoundary string, tags map[string]string) error
+	DeleteApp(ctx context.Context, name string) error
+	DescribeApp(ctx context.Context, name string) (*describe.App, error)
+	InitEnv(ctx context.Context, app, name, profile, region string) error
+	DeployEnv(ctx context.Context, app, name string, force, disableRollback bool) error
+	DeleteEnv(ctx context.Context, app, name string) error
+	DescribeEnv(ctx context.Context, app, name string) (*describe.EnvDescription, error)
+	DeployWorkload(ctx context.Context, app, name, env, imageTag string, tags map[string]string, force, disableRollback bool) error
+	DeleteWorkload(ctx context.Context, app, name, env string, isJob bool) error
+	DescribeServiceStatus(ctx context.Context, app, name, env string) (describe.HumanJSONStringer, error)
+}
+
+// cliOperations runs the commands of the CLI.
+type cliOperations struct{}
+
+func (cliOperations) InitApp(ctx context.Context, name, domain, permissionsBoundary string, tags map[string]string) error {
+	return cli.InitApp(ctx, name, domain, permissionsBoundary, tags)
+}
+
+func (cliOperations) DeleteApp(ctx context.Context, name string) error {
+	return cli.DeleteApp(ctx, name)
+}
+
+func (cliOperations) DescribeApp(ctx context.Context, name string) (*describe.App, error) {
+	return cli.DescribeApp(ctx, name)
+}
+
+func (cliOperations) InitEnv(ctx context.Context, app, name, profile, region string) error {
+	return cli.InitEnv(ctx, app, name, profile, region)
+}
+
+func (cliOperations) DeployEnv(ctx context.Context, app, name string, force, disableRollback bool) error {
+	return cli.DeployEnv(ctx, app, name, force, disableRollback)
+}
+
+func (cliOperations) DeleteEnv(ctx context.Context, app, name string) error {
+	return cli.DeleteEnv(ctx, app, name)
+}
+
+func (cliOperations) DescribeEnv(ctx context.Context, app, name string) (*describe.EnvDescription, error) {
+	return cli.DescribeEnv(ctx, app, name)
+}
+
+func (cliOperations) DeployWorkload(ctx context.Context, app, name, env, imageTag string, tags map[string]string, force, disableRollback bool) error {
+	return cli.DeployWorkload(ctx, app, name, env, imageTag, tags, force, disableRollback)
+}
+
+func (cliOperations) DeleteWorkload(ctx context.Context, app, name, env string, isJob bool) error {
+	return cli.DeleteWorkload(ctx, app, name, env, isJob)
+}
+
+func (cliOperations) DescribeServiceStatus(ctx context.Context, app, name, env string) (describe.HumanJSONStringer, error) {
+	return cli.DescribeServiceStatus(ctx, app, name, env)
+}
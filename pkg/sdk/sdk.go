@@ -0,0 +1,67 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sdk lets Go programs run Copilot operations on applications, environments and workloads
+// without shelling out to the copilot binary.
+//
+// Every operation runs the same code as the matching copilot command, with all of its inputs set so that it never prompts.
+// As with the CLI, the operations use the default AWS credentials chain and read the Copilot workspace of the current working directory,
+// and the progress of the CloudFormation stacks is rendered on standard error.
+//
+// The AWS requests of an operation are made with its context: once the context is done, the requests fail and the operation returns an error.
+// A stack update that CloudFormation already started keeps running, and can be inspected with the status operations.
+// Each operation creates its own AWS sessions, so operations can run concurrently as long as they don't change the same resources.
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Client runs Copilot operations.
+type Client struct {
+	onEvent func(Event)
+	ops     operations
+	now     func() time.Time
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithEventHandler sets the function that receives the progress events of the operations.
+// The function is called synchronously from the goroutine that runs the operation, so it should return quickly.
+func WithEventHandler(fn func(Event)) Option {
+	return func(c *Client) {
+		c.onEvent = fn
+	}
+}
+
+// New returns a Client that uses the default AWS credentials chain, like the copilot commands.
+func New(opts ...Option) *Client {
+	c := &Client{
+		onEvent: func(Event) {},
+		ops:     cliOperations{},
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// do runs fn as an operation on the target, and sends its progress events.
+func (c *Client) do(ctx context.Context, op Operation, target string, fn func(ctx context.Context) error) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%s %s: %w", op, target, err)
+	}
+	start := c.now()
+	c.onEvent(OperationStarted{Operation: op, Target: target})
+	if err := fn(ctx); err != nil {
+		err = fmt.Errorf("%s %s: %w", op, target, err)
+		c.onEvent(OperationFailed{Operation: op, Target: target, Err: err})
+		return err
+	}
+	c.onEvent(OperationSucceeded{Operation: op, Target: target, Elapsed: c.now().Sub(start)})
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOperations records the calls to the commands as strings, and returns the configured results.
+type fakeOperations struct {
+	calls []string
+
+	app       *describe.App
+	env       *describe.EnvDescription
+	svcStatus describe.HumanJSONStringer
+	err       error
+}
+
+func (f *fakeOperations) record(ctx context.Context, format string, args ...any) error {
+	if ctx == nil {
+		return errors.New("no context")
+	}
+	f.calls = append(f.calls, fmt.Sprintf(format, args...))
+	return f.err
+}
+
+func (f *fakeOperations) InitApp(ctx context.Context, name, domain, permissionsBoundary string, tags map[string]string) error {
+	return f.record(ctx, "InitApp %s %s %s %v", name, domain, permissionsBoundary, tags)
+}
+
+func (f *fakeOperations) DeleteApp(ctx context.Context, name string) error {
+	return f.record(ctx, "DeleteApp %s", name)
+}
+
+func (f *fakeOperations) DescribeApp(ctx context.Context, name string) (*describe.App, error) {
+	return f.app, f.record(ctx, "DescribeApp %s", name)
+}
+
+func (f *fakeOperations) InitEnv(ctx context.Context, app, name, profile, region string) error {
+	return f.record(ctx, "InitEnv %s %s %s %s", app, name, profile, region)
+}
+
+func (f *fakeOperations) DeployEnv(ctx context.Context, app, name string, force, disableRollback bool) error {
+	return f.record(ctx, "DeployEnv %s %s %t %t", app, name, force, disableRollback)
+}
+
+func (f *fakeOperations) DeleteEnv(ctx context.Context, app, name string) error {
+	return f.record(ctx, "DeleteEnv %s %s", app, name)
+}
+
+func (f *fakeOperations) DescribeEnv(ctx context.Context, app, name string) (*describe.EnvDescription, error) {
+	return f.env, f.record(ctx, "DescribeEnv %s %s", app, name)
+}
+
+func (f *fakeOperations) DeployWorkload(ctx context.Context, app, name, env, imageTag string, tags map[string]string, force, disableRollback bool) error {
+	return f.record(ctx, "DeployWorkload %s %s %s %s %v %t %t", app, name, env, imageTag, tags, force, disableRollback)
+}
+
+func (f *fakeOperations) DeleteWorkload(ctx context.Context, app, name, env string, isJob bool) error {
+	return f.record(ctx, "DeleteWorkload %s %s %s %t", app, name, env, isJob)
+}
+
+func (f *fakeOperations) DescribeServiceStatus(ctx context.Context, app, name, env string) (describe.HumanJSONStringer, error) {
+	return f.svcStatus, f.record(ctx, "DescribeServiceStatus %s %s %s", app, name, env)
+}
+
+func TestClient_do(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	testCases := map[string]struct {
+		inCtx context.Context
+		inErr error
+
+		wantedCalls  []string
+		wantedEvents []Event
+		wantedStatus *AppStatus
+		wantedErr    error
+	}{
+		"does not start the operation if the context is done": {
+			inCtx:     canceled,
+			wantedErr: errors.New("show application phonetool: context canceled"),
+		},
+		"sends a failure event if the operation fails": {
+			inCtx:       context.Background(),
+			inErr:       errors.New("some error"),
+			wantedCalls: []string{"DescribeApp phonetool"},
+			wantedEvents: []Event{
+				OperationStarted{Operation: OpAppStatus, Target: "phonetool"},
+				OperationFailed{Operation: OpAppStatus, Target: "phonetool", Err: errors.New("show application phonetool: some error")},
+			},
+			wantedErr: errors.New("show application phonetool: some error"),
+		},
+		"returns the typed status of the application": {
+			inCtx:       context.Background(),
+			wantedCalls: []string{"DescribeApp phonetool"},
+			wantedEvents: []Event{
+				OperationStarted{Operation: OpAppStatus, Target: "phonetool"},
+				OperationSucceeded{Operation: OpAppStatus, Target: "phonetool", Elapsed: time.Second},
+			},
+			wantedStatus: &AppStatus{
+				Name:    "phonetool",
+				Version: "v1.2.0",
+				URI:     "phonetool.example.com",
+				Environments: []EnvSummary{
+					{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+				},
+				Services:  []WorkloadSummary{{Name: "api", Type: "Load Balanced Web Service"}},
+				Pipelines: []string{"pipeline-phonetool-release"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var gotEvents []Event
+			clock := time.Unix(1700000000, 0)
+			ops := &fakeOperations{
+				app: &describe.App{
+					Name:    "phonetool",
+					Version: "v1.2.0",
+					URI:     "phonetool.example.com",
+					Envs: []*config.Environment{
+						{App: "phonetool", Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+					},
+					Services:  []*config.Workload{{App: "phonetool", Name: "api", Type: "Load Balanced Web Service"}},
+					Pipelines: []*codepipeline.Pipeline{{Name: "pipeline-phonetool-release"}},
+				},
+				err: tc.inErr,
+			}
+			c := New(WithEventHandler(func(e Event) {
+				if failed, ok := e.(OperationFailed); ok {
+					// Compare the message of the error rather than its wrapped chain.
+					failed.Err = errors.New(failed.Err.Error())
+					e = failed
+				}
+				gotEvents = append(gotEvents, e)
+			}))
+			c.ops = ops
+			c.now = func() time.Time {
+				clock = clock.Add(time.Second)
+				return clock
+			}
+
+			// WHEN
+			status, err := c.AppStatus(tc.inCtx, "phonetool")
+
+			// THEN
+			require.Equal(t, tc.wantedCalls, ops.calls)
+			require.Equal(t, tc.wantedEvents, gotEvents)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStatus, status)
+		})
+	}
+}
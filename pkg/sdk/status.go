@@ -0,0 +1,195 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"fmt"
+	"time"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+)
+
+// WorkloadStatus is the status of a service in an environment.
+// Only the field that matches the platform of the service is set.
+type WorkloadStatus struct {
+	ECS        *ECSServiceStatus       // Set for the services that run on Amazon ECS.
+	AppRunner  *AppRunnerServiceStatus // Set for Request-Driven Web Services.
+	StaticSite *StaticSiteStatus       // Set for Static Sites.
+}
+
+// ECSServiceStatus is the status of a service that runs on Amazon ECS.
+type ECSServiceStatus struct {
+	Status       string // Status of the ECS service, such as "ACTIVE".
+	DesiredCount int64
+	RunningCount int64
+	Deployments  []ECSDeployment
+	Tasks        []TaskStatus // Tasks that run towards the desired count.
+	StoppedTasks []TaskStatus // Tasks that stopped recently.
+	Alarms       []AlarmStatus
+}
+
+// ECSDeployment is a deployment of an ECS service.
+type ECSDeployment struct {
+	ID             string
+	Status         string // Status of the deployment, such as "PRIMARY".
+	TaskDefinition string
+	DesiredCount   int64
+	RunningCount   int64
+	UpdatedAt      time.Time
+}
+
+// TaskStatus is the status of an ECS task.
+type TaskStatus struct {
+	ID             string
+	LastStatus     string // Last known status of the task, such as "RUNNING".
+	Health         string
+	TaskDefinition string
+	StartedAt      time.Time
+	StoppedAt      time.Time
+	StoppedReason  string
+}
+
+// AlarmStatus is the state of a CloudWatch alarm of a service.
+type AlarmStatus struct {
+	Name      string
+	Type      string // Type of the alarm, either "Metric" or "Composite".
+	Condition string
+	Status    string // State of the alarm, such as "OK" or "ALARM".
+	UpdatedAt time.Time
+}
+
+// AppRunnerServiceStatus is the status of a Request-Driven Web Service.
+type AppRunnerServiceStatus struct {
+	ARN         string
+	Status      string // Status of the App Runner service, such as "RUNNING".
+	URL         string
+	ImageID     string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Deployments []AppRunnerDeployment
+	Metrics     *AppRunnerMetrics // Nil if the metrics couldn't be retrieved.
+}
+
+// AppRunnerDeployment is a deployment of an App Runner service.
+type AppRunnerDeployment struct {
+	ID        string
+	Type      string
+	Status    string
+	StartedAt time.Time
+	EndedAt   *time.Time // Nil if the deployment is in progress.
+}
+
+// AppRunnerMetrics holds the number of instances of an App Runner service and the requests it served in the last hour.
+type AppRunnerMetrics struct {
+	ActiveInstances int
+	Requests        int
+	Responses2XX    int
+	Responses4XX    int
+	Responses5XX    int
+	P99LatencyMs    float64
+}
+
+// StaticSiteStatus is the status of a Static Site.
+type StaticSiteStatus struct {
+	BucketName string
+	Size       string // Total size of the objects in the bucket in a human readable format, such as "1.2 MB".
+	Objects    int
+}
+
+func newWorkloadStatus(desc describe.HumanJSONStringer) (*WorkloadStatus, error) {
+	switch status := desc.(type) {
+	case *describe.ECSServiceStatus:
+		return &WorkloadStatus{ECS: newECSServiceStatus(status)}, nil
+	case *describe.AppRunnerServiceStatus:
+		return &WorkloadStatus{AppRunner: newAppRunnerServiceStatus(status)}, nil
+	case *describe.StaticSiteServiceStatus:
+		return &WorkloadStatus{
+			StaticSite: &StaticSiteStatus{
+				BucketName: status.BucketName,
+				Size:       status.Size,
+				Objects:    status.Count,
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unexpected status of type %T", desc)
+	}
+}
+
+func newECSServiceStatus(status *describe.ECSServiceStatus) *ECSServiceStatus {
+	out := &ECSServiceStatus{
+		Status:       status.Service.Status,
+		DesiredCount: status.Service.DesiredCount,
+		RunningCount: status.Service.RunningCount,
+		Tasks:        newTaskStatuses(status.DesiredRunningTasks),
+		StoppedTasks: newTaskStatuses(status.StoppedTasks),
+	}
+	for _, dp := range status.Service.Deployments {
+		out.Deployments = append(out.Deployments, ECSDeployment{
+			ID:             dp.Id,
+			Status:         dp.Status,
+			TaskDefinition: dp.TaskDefinition,
+			DesiredCount:   dp.DesiredCount,
+			RunningCount:   dp.RunningCount,
+			UpdatedAt:      dp.UpdatedAt,
+		})
+	}
+	for _, alarm := range status.Alarms {
+		out.Alarms = append(out.Alarms, AlarmStatus{
+			Name:      alarm.Name,
+			Type:      alarm.Type,
+			Condition: alarm.Condition,
+			Status:    alarm.Status,
+			UpdatedAt: alarm.UpdatedTimes,
+		})
+	}
+	return out
+}
+
+func newTaskStatuses(tasks []awsecs.TaskStatus) []TaskStatus {
+	var out []TaskStatus
+	for _, task := range tasks {
+		out = append(out, TaskStatus{
+			ID:             task.ID,
+			LastStatus:     task.LastStatus,
+			Health:         task.Health,
+			TaskDefinition: task.TaskDefinition,
+			StartedAt:      task.StartedAt,
+			StoppedAt:      task.StoppedAt,
+			StoppedReason:  task.StoppedReason,
+		})
+	}
+	return out
+}
+
+func newAppRunnerServiceStatus(status *describe.AppRunnerServiceStatus) *AppRunnerServiceStatus {
+	out := &AppRunnerServiceStatus{
+		ARN:       status.Service.ServiceARN,
+		Status:    status.Service.Status,
+		URL:       status.Service.ServiceURL,
+		ImageID:   status.Service.ImageID,
+		CreatedAt: status.Service.DateCreated,
+		UpdatedAt: status.Service.DateUpdated,
+	}
+	for _, op := range status.Deployments {
+		out.Deployments = append(out.Deployments, AppRunnerDeployment{
+			ID:        op.ID,
+			Type:      op.Type,
+			Status:    op.Status,
+			StartedAt: op.StartedAt,
+			EndedAt:   op.EndedAt,
+		})
+	}
+	if m := status.Metrics; m != nil {
+		out.Metrics = &AppRunnerMetrics{
+			ActiveInstances: m.ActiveInstances,
+			Requests:        m.Requests,
+			Responses2XX:    m.Responses2XX,
+			Responses4XX:    m.Responses4XX,
+			Responses5XX:    m.Responses5XX,
+			P99LatencyMs:    m.P99LatencyMs,
+		}
+	}
+	return out
+}
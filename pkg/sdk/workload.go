@@ -0,0 +1,92 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// WorkloadInput identifies a service or a job of an application.
+type WorkloadInput struct {
+	App  string
+	Name string
+	Env  string
+	Job  bool // Set if the workload is a job rather than a service.
+}
+
+func (in WorkloadInput) validate() error {
+	if in.App == "" {
+		return errors.New("application name is required")
+	}
+	if in.Name == "" {
+		return errors.New("workload name is required")
+	}
+	return nil
+}
+
+func (in WorkloadInput) validateWithEnv() error {
+	if err := in.validate(); err != nil {
+		return err
+	}
+	if in.Env == "" {
+		return errors.New("environment name is required")
+	}
+	return nil
+}
+
+// DeployWorkloadInput holds the configuration of the deployment of a workload from its manifest in the workspace.
+type DeployWorkloadInput struct {
+	WorkloadInput
+	ImageTag        string            // Optional. Tag of the images built for the deployment.
+	Tags            map[string]string // Optional. Additional tags applied to the resources of the workload.
+	Force           bool              // Optional. Deploy the workload even if its template didn't change.
+	DisableRollback bool              // Optional. Keep the stack in its failed state instead of rolling it back.
+}
+
+// DeployWorkload builds, pushes and deploys a service or a job to an environment, like `copilot deploy`.
+// The environment and the workload must already be initialized: unlike the command, it never initializes them.
+func (c *Client) DeployWorkload(ctx context.Context, in DeployWorkloadInput) error {
+	if err := in.validateWithEnv(); err != nil {
+		return err
+	}
+	return c.do(ctx, OpDeployWorkload, in.Name, func(ctx context.Context) error {
+		return c.ops.DeployWorkload(ctx, in.App, in.Name, in.Env, in.ImageTag, in.Tags, in.Force, in.DisableRollback)
+	})
+}
+
+// DeleteWorkload deletes a service or a job, like `copilot svc delete --yes` or `copilot job delete --yes`.
+// If the environment is empty, the workload is deleted from all the environments and from the application.
+func (c *Client) DeleteWorkload(ctx context.Context, in WorkloadInput) error {
+	if err := in.validate(); err != nil {
+		return err
+	}
+	return c.do(ctx, OpDeleteWorkload, in.Name, func(ctx context.Context) error {
+		return c.ops.DeleteWorkload(ctx, in.App, in.Name, in.Env, in.Job)
+	})
+}
+
+// WorkloadStatus returns the status of a service in an environment, like `copilot svc status`.
+func (c *Client) WorkloadStatus(ctx context.Context, in WorkloadInput) (*WorkloadStatus, error) {
+	if err := in.validateWithEnv(); err != nil {
+		return nil, err
+	}
+	if in.Job {
+		return nil, fmt.Errorf("status of job %s is not supported: only services have a status", in.Name)
+	}
+	var status *WorkloadStatus
+	err := c.do(ctx, OpWorkloadStatus, in.Name, func(ctx context.Context) error {
+		desc, err := c.ops.DescribeServiceStatus(ctx, in.App, in.Name, in.Env)
+		if err != nil {
+			return err
+		}
+		status, err = newWorkloadStatus(desc)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
@@ -0,0 +1,258 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Operations(t *testing.T) {
+	testCases := map[string]struct {
+		call func(c *Client) error
+
+		wantedCalls []string
+		wantedErr   error
+	}{
+		"init app with a domain and tags": {
+			call: func(c *Client) error {
+				return c.InitApp(context.Background(), InitAppInput{
+					Name:   "phonetool",
+					Domain: "example.com",
+					Tags:   map[string]string{"team": "platform"},
+				})
+			},
+			wantedCalls: []string{"InitApp phonetool example.com  map[team:platform]"},
+		},
+		"error if the app has no name": {
+			call: func(c *Client) error {
+				return c.InitApp(context.Background(), InitAppInput{})
+			},
+			wantedErr: errors.New("application name is required"),
+		},
+		"init env requires a profile": {
+			call: func(c *Client) error {
+				return c.InitEnv(context.Background(), InitEnvInput{EnvInput: EnvInput{App: "phonetool", Name: "test"}})
+			},
+			wantedErr: errors.New("profile of the environment is required"),
+		},
+		"init env with the default configuration": {
+			call: func(c *Client) error {
+				return c.InitEnv(context.Background(), InitEnvInput{
+					EnvInput: EnvInput{App: "phonetool", Name: "test"},
+					Profile:  "default",
+					Region:   "us-west-2",
+				})
+			},
+			wantedCalls: []string{"InitEnv phonetool test default us-west-2"},
+		},
+		"deploy env without rollback": {
+			call: func(c *Client) error {
+				return c.DeployEnv(context.Background(), DeployEnvInput{
+					EnvInput:        EnvInput{App: "phonetool", Name: "test"},
+					DisableRollback: true,
+				})
+			},
+			wantedCalls: []string{"DeployEnv phonetool test false true"},
+		},
+		"deploy workload": {
+			call: func(c *Client) error {
+				return c.DeployWorkload(context.Background(), DeployWorkloadInput{
+					WorkloadInput: WorkloadInput{App: "phonetool", Name: "api", Env: "test"},
+					ImageTag:      "v1.2.0",
+					Force:         true,
+				})
+			},
+			wantedCalls: []string{"DeployWorkload phonetool api test v1.2.0 map[] true false"},
+		},
+		"error if the deployment has no env": {
+			call: func(c *Client) error {
+				return c.DeployWorkload(context.Background(), DeployWorkloadInput{
+					WorkloadInput: WorkloadInput{App: "phonetool", Name: "api"},
+				})
+			},
+			wantedErr: errors.New("environment name is required"),
+		},
+		"delete a job from every env": {
+			call: func(c *Client) error {
+				return c.DeleteWorkload(context.Background(), WorkloadInput{App: "phonetool", Name: "mailer", Job: true})
+			},
+			wantedCalls: []string{"DeleteWorkload phonetool mailer  true"},
+		},
+		"error on the status of a job": {
+			call: func(c *Client) error {
+				_, err := c.WorkloadStatus(context.Background(), WorkloadInput{App: "phonetool", Name: "mailer", Env: "test", Job: true})
+				return err
+			},
+			wantedErr: errors.New("status of job mailer is not supported: only services have a status"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ops := &fakeOperations{}
+			c := New()
+			c.ops = ops
+
+			// WHEN
+			err := tc.call(c)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				require.Empty(t, ops.calls)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCalls, ops.calls)
+		})
+	}
+}
+
+func TestClient_EnvStatus(t *testing.T) {
+	// GIVEN
+	ops := &fakeOperations{
+		env: &describe.EnvDescription{
+			Environment: &config.Environment{App: "phonetool", Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+			Jobs:        []*config.Workload{{App: "phonetool", Name: "mailer", Type: "Scheduled Job"}},
+			Tags:        map[string]string{"team": "platform"},
+			EnvironmentVPC: describe.EnvironmentVPC{
+				ID:               "vpc-1234",
+				PublicSubnetIDs:  []string{"subnet-1"},
+				PrivateSubnetIDs: []string{"subnet-2"},
+			},
+		},
+	}
+	c := New()
+	c.ops = ops
+
+	// WHEN
+	status, err := c.EnvStatus(context.Background(), EnvInput{App: "phonetool", Name: "test"})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []string{"DescribeEnv phonetool test"}, ops.calls)
+	require.Equal(t, &EnvStatus{
+		EnvSummary: EnvSummary{Name: "test", AccountID: "123456789012", Region: "us-west-2"},
+		App:        "phonetool",
+		Jobs:       []WorkloadSummary{{Name: "mailer", Type: "Scheduled Job"}},
+		Tags:       map[string]string{"team": "platform"},
+		VPC: VPC{
+			ID:               "vpc-1234",
+			PublicSubnetIDs:  []string{"subnet-1"},
+			PrivateSubnetIDs: []string{"subnet-2"},
+		},
+	}, status)
+}
+
+func TestClient_WorkloadStatus(t *testing.T) {
+	startedAt := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inStatus describe.HumanJSONStringer
+
+		wantedStatus *WorkloadStatus
+	}{
+		"status of a service on ECS": {
+			inStatus: &describe.ECSServiceStatus{
+				Service: awsecs.ServiceStatus{
+					Status:       "ACTIVE",
+					DesiredCount: 1,
+					RunningCount: 1,
+					Deployments: []awsecs.Deployment{
+						{Id: "ecs-svc/1", Status: "PRIMARY", TaskDefinition: "api:2", DesiredCount: 1, RunningCount: 1, UpdatedAt: startedAt},
+					},
+				},
+				DesiredRunningTasks: []awsecs.TaskStatus{
+					{ID: "1234", LastStatus: "RUNNING", Health: "HEALTHY", TaskDefinition: "api:2", StartedAt: startedAt},
+				},
+				Alarms: []cloudwatch.AlarmStatus{
+					{Name: "cpu", Type: "Metric", Condition: "CPUUtilization > 70.00 for 1 datapoints within 1 minute", Status: "OK", UpdatedTimes: startedAt},
+				},
+			},
+			wantedStatus: &WorkloadStatus{
+				ECS: &ECSServiceStatus{
+					Status:       "ACTIVE",
+					DesiredCount: 1,
+					RunningCount: 1,
+					Deployments: []ECSDeployment{
+						{ID: "ecs-svc/1", Status: "PRIMARY", TaskDefinition: "api:2", DesiredCount: 1, RunningCount: 1, UpdatedAt: startedAt},
+					},
+					Tasks: []TaskStatus{
+						{ID: "1234", LastStatus: "RUNNING", Health: "HEALTHY", TaskDefinition: "api:2", StartedAt: startedAt},
+					},
+					Alarms: []AlarmStatus{
+						{Name: "cpu", Type: "Metric", Condition: "CPUUtilization > 70.00 for 1 datapoints within 1 minute", Status: "OK", UpdatedAt: startedAt},
+					},
+				},
+			},
+		},
+		"status of a Request-Driven Web Service": {
+			inStatus: &describe.AppRunnerServiceStatus{
+				Service: apprunner.Service{
+					ServiceARN:  "arn:aws:apprunner:us-west-2:123456789012:service/phonetool-test-api",
+					Status:      "RUNNING",
+					ServiceURL:  "abcd.us-west-2.awsapprunner.com",
+					ImageID:     "nginx",
+					DateCreated: startedAt,
+					DateUpdated: startedAt,
+				},
+				Deployments: []apprunner.Operation{
+					{ID: "op-1", Type: "START_DEPLOYMENT", Status: "IN_PROGRESS", StartedAt: startedAt},
+				},
+			},
+			wantedStatus: &WorkloadStatus{
+				AppRunner: &AppRunnerServiceStatus{
+					ARN:       "arn:aws:apprunner:us-west-2:123456789012:service/phonetool-test-api",
+					Status:    "RUNNING",
+					URL:       "abcd.us-west-2.awsapprunner.com",
+					ImageID:   "nginx",
+					CreatedAt: startedAt,
+					UpdatedAt: startedAt,
+					Deployments: []AppRunnerDeployment{
+						{ID: "op-1", Type: "START_DEPLOYMENT", Status: "IN_PROGRESS", StartedAt: startedAt},
+					},
+				},
+			},
+		},
+		"status of a Static Site": {
+			inStatus: &describe.StaticSiteServiceStatus{
+				BucketName: "phonetool-test-site",
+				Size:       "1.2 MB",
+				Count:      3,
+			},
+			wantedStatus: &WorkloadStatus{
+				StaticSite: &StaticSiteStatus{
+					BucketName: "phonetool-test-site",
+					Size:       "1.2 MB",
+					Objects:    3,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ops := &fakeOperations{svcStatus: tc.inStatus}
+			c := New()
+			c.ops = ops
+
+			// WHEN
+			status, err := c.WorkloadStatus(context.Background(), WorkloadInput{App: "phonetool", Name: "api", Env: "test"})
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, []string{"DescribeServiceStatus phonetool api test"}, ops.calls)
+			require.Equal(t, tc.wantedStatus, status)
+		})
+	}
+}